@@ -29,6 +29,8 @@ type Account struct {
 	Balance decimal.Decimal `json:"balance,omitempty"`
 	// IsIntercompany holds the value of the "is_intercompany" field.
 	IsIntercompany bool `json:"is_intercompany,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -48,9 +50,15 @@ type AccountEdges struct {
 	JournalEntries []*JournalEntry `json:"journal_entries,omitempty"`
 	// RecurringInvoices holds the value of the recurring_invoices edge.
 	RecurringInvoices []*RecurringInvoice `json:"recurring_invoices,omitempty"`
+	// BalanceSnapshots holds the value of the balance_snapshots edge.
+	BalanceSnapshots []*AccountBalanceSnapshot `json:"balance_snapshots,omitempty"`
+	// BankStatements holds the value of the bank_statements edge.
+	BankStatements []*BankStatement `json:"bank_statements,omitempty"`
+	// BankStatementLines holds the value of the bank_statement_lines edge.
+	BankStatementLines []*BankStatementLine `json:"bank_statement_lines,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -91,6 +99,33 @@ func (e AccountEdges) RecurringInvoicesOrErr() ([]*RecurringInvoice, error) {
 	return nil, &NotLoadedError{edge: "recurring_invoices"}
 }
 
+// BalanceSnapshotsOrErr returns the BalanceSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BalanceSnapshotsOrErr() ([]*AccountBalanceSnapshot, error) {
+	if e.loadedTypes[4] {
+		return e.BalanceSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "balance_snapshots"}
+}
+
+// BankStatementsOrErr returns the BankStatements value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BankStatementsOrErr() ([]*BankStatement, error) {
+	if e.loadedTypes[5] {
+		return e.BankStatements, nil
+	}
+	return nil, &NotLoadedError{edge: "bank_statements"}
+}
+
+// BankStatementLinesOrErr returns the BankStatementLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BankStatementLinesOrErr() ([]*BankStatementLine, error) {
+	if e.loadedTypes[6] {
+		return e.BankStatementLines, nil
+	}
+	return nil, &NotLoadedError{edge: "bank_statement_lines"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Account) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -102,7 +137,7 @@ func (*Account) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case account.FieldID:
 			values[i] = new(sql.NullInt64)
-		case account.FieldName, account.FieldNumber, account.FieldType:
+		case account.FieldName, account.FieldNumber, account.FieldType, account.FieldCurrency:
 			values[i] = new(sql.NullString)
 		case account.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -159,6 +194,12 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsIntercompany = value.Bool
 			}
+		case account.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
 		case account.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -205,6 +246,21 @@ func (_m *Account) QueryRecurringInvoices() *RecurringInvoiceQuery {
 	return NewAccountClient(_m.config).QueryRecurringInvoices(_m)
 }
 
+// QueryBalanceSnapshots queries the "balance_snapshots" edge of the Account entity.
+func (_m *Account) QueryBalanceSnapshots() *AccountBalanceSnapshotQuery {
+	return NewAccountClient(_m.config).QueryBalanceSnapshots(_m)
+}
+
+// QueryBankStatements queries the "bank_statements" edge of the Account entity.
+func (_m *Account) QueryBankStatements() *BankStatementQuery {
+	return NewAccountClient(_m.config).QueryBankStatements(_m)
+}
+
+// QueryBankStatementLines queries the "bank_statement_lines" edge of the Account entity.
+func (_m *Account) QueryBankStatementLines() *BankStatementLineQuery {
+	return NewAccountClient(_m.config).QueryBankStatementLines(_m)
+}
+
 // Update returns a builder for updating this Account.
 // Note that you need to call Account.Unwrap() before calling this method if this Account
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -243,6 +299,9 @@ func (_m *Account) String() string {
 	builder.WriteString("is_intercompany=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsIntercompany))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
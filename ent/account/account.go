@@ -26,6 +26,8 @@ const (
 	FieldBalance = "balance"
 	// FieldIsIntercompany holds the string denoting the is_intercompany field in the database.
 	FieldIsIntercompany = "is_intercompany"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
@@ -36,6 +38,12 @@ const (
 	EdgeJournalEntries = "journal_entries"
 	// EdgeRecurringInvoices holds the string denoting the recurring_invoices edge name in mutations.
 	EdgeRecurringInvoices = "recurring_invoices"
+	// EdgeBalanceSnapshots holds the string denoting the balance_snapshots edge name in mutations.
+	EdgeBalanceSnapshots = "balance_snapshots"
+	// EdgeBankStatements holds the string denoting the bank_statements edge name in mutations.
+	EdgeBankStatements = "bank_statements"
+	// EdgeBankStatementLines holds the string denoting the bank_statement_lines edge name in mutations.
+	EdgeBankStatementLines = "bank_statement_lines"
 	// Table holds the table name of the account in the database.
 	Table = "accounts"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -66,6 +74,27 @@ const (
 	RecurringInvoicesInverseTable = "recurring_invoices"
 	// RecurringInvoicesColumn is the table column denoting the recurring_invoices relation/edge.
 	RecurringInvoicesColumn = "account_recurring_invoices"
+	// BalanceSnapshotsTable is the table that holds the balance_snapshots relation/edge.
+	BalanceSnapshotsTable = "account_balance_snapshots"
+	// BalanceSnapshotsInverseTable is the table name for the AccountBalanceSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "accountbalancesnapshot" package.
+	BalanceSnapshotsInverseTable = "account_balance_snapshots"
+	// BalanceSnapshotsColumn is the table column denoting the balance_snapshots relation/edge.
+	BalanceSnapshotsColumn = "account_balance_snapshots"
+	// BankStatementsTable is the table that holds the bank_statements relation/edge.
+	BankStatementsTable = "bank_statements"
+	// BankStatementsInverseTable is the table name for the BankStatement entity.
+	// It exists in this package in order to avoid circular dependency with the "bankstatement" package.
+	BankStatementsInverseTable = "bank_statements"
+	// BankStatementsColumn is the table column denoting the bank_statements relation/edge.
+	BankStatementsColumn = "account_bank_statements"
+	// BankStatementLinesTable is the table that holds the bank_statement_lines relation/edge.
+	BankStatementLinesTable = "bank_statement_lines"
+	// BankStatementLinesInverseTable is the table name for the BankStatementLine entity.
+	// It exists in this package in order to avoid circular dependency with the "bankstatementline" package.
+	BankStatementLinesInverseTable = "bank_statement_lines"
+	// BankStatementLinesColumn is the table column denoting the bank_statement_lines relation/edge.
+	BankStatementLinesColumn = "account_bank_statement_lines"
 )
 
 // Columns holds all SQL columns for account fields.
@@ -76,6 +105,7 @@ var Columns = []string{
 	FieldType,
 	FieldBalance,
 	FieldIsIntercompany,
+	FieldCurrency,
 	FieldCreatedAt,
 }
 
@@ -168,6 +198,11 @@ func ByIsIntercompany(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsIntercompany, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -221,6 +256,48 @@ func ByRecurringInvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newRecurringInvoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBalanceSnapshotsCount orders the results by balance_snapshots count.
+func ByBalanceSnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBalanceSnapshotsStep(), opts...)
+	}
+}
+
+// ByBalanceSnapshots orders the results by balance_snapshots terms.
+func ByBalanceSnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBalanceSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBankStatementsCount orders the results by bank_statements count.
+func ByBankStatementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBankStatementsStep(), opts...)
+	}
+}
+
+// ByBankStatements orders the results by bank_statements terms.
+func ByBankStatements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBankStatementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBankStatementLinesCount orders the results by bank_statement_lines count.
+func ByBankStatementLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBankStatementLinesStep(), opts...)
+	}
+}
+
+// ByBankStatementLines orders the results by bank_statement_lines terms.
+func ByBankStatementLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBankStatementLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -249,3 +326,24 @@ func newRecurringInvoicesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RecurringInvoicesTable, RecurringInvoicesColumn),
 	)
 }
+func newBalanceSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BalanceSnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+	)
+}
+func newBankStatementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BankStatementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BankStatementsTable, BankStatementsColumn),
+	)
+}
+func newBankStatementLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BankStatementLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BankStatementLinesTable, BankStatementLinesColumn),
+	)
+}
@@ -7,6 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
 	"sent/ent/journalentry"
 	"sent/ent/ledgerentry"
 	"sent/ent/recurringinvoice"
@@ -71,6 +74,20 @@ func (_c *AccountCreate) SetNillableIsIntercompany(v *bool) *AccountCreate {
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *AccountCreate) SetCurrency(v string) *AccountCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableCurrency(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AccountCreate) SetCreatedAt(v time.Time) *AccountCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -141,6 +158,51 @@ func (_c *AccountCreate) AddRecurringInvoices(v ...*RecurringInvoice) *AccountCr
 	return _c.AddRecurringInvoiceIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the AccountBalanceSnapshot entity by IDs.
+func (_c *AccountCreate) AddBalanceSnapshotIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddBalanceSnapshotIDs(ids...)
+	return _c
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_c *AccountCreate) AddBalanceSnapshots(v ...*AccountBalanceSnapshot) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBalanceSnapshotIDs(ids...)
+}
+
+// AddBankStatementIDs adds the "bank_statements" edge to the BankStatement entity by IDs.
+func (_c *AccountCreate) AddBankStatementIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddBankStatementIDs(ids...)
+	return _c
+}
+
+// AddBankStatements adds the "bank_statements" edges to the BankStatement entity.
+func (_c *AccountCreate) AddBankStatements(v ...*BankStatement) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBankStatementIDs(ids...)
+}
+
+// AddBankStatementLineIDs adds the "bank_statement_lines" edge to the BankStatementLine entity by IDs.
+func (_c *AccountCreate) AddBankStatementLineIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddBankStatementLineIDs(ids...)
+	return _c
+}
+
+// AddBankStatementLines adds the "bank_statement_lines" edges to the BankStatementLine entity.
+func (_c *AccountCreate) AddBankStatementLines(v ...*BankStatementLine) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBankStatementLineIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_c *AccountCreate) Mutation() *AccountMutation {
 	return _c.mutation
@@ -264,6 +326,10 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldIsIntercompany, field.TypeBool, value)
 		_node.IsIntercompany = value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(account.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -333,6 +399,54 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BankStatementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementsTable,
+			Columns: []string{account.BankStatementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BankStatementLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementLinesTable,
+			Columns: []string{account.BankStatementLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"math"
 	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
 	"sent/ent/journalentry"
 	"sent/ent/ledgerentry"
 	"sent/ent/predicate"
@@ -23,16 +26,19 @@ import (
 // AccountQuery is the builder for querying Account entities.
 type AccountQuery struct {
 	config
-	ctx                   *QueryContext
-	order                 []account.OrderOption
-	inters                []Interceptor
-	predicates            []predicate.Account
-	withTenant            *TenantQuery
-	withEntries           *LedgerEntryQuery
-	withJournalEntries    *JournalEntryQuery
-	withRecurringInvoices *RecurringInvoiceQuery
-	withFKs               bool
-	modifiers             []func(*sql.Selector)
+	ctx                    *QueryContext
+	order                  []account.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Account
+	withTenant             *TenantQuery
+	withEntries            *LedgerEntryQuery
+	withJournalEntries     *JournalEntryQuery
+	withRecurringInvoices  *RecurringInvoiceQuery
+	withBalanceSnapshots   *AccountBalanceSnapshotQuery
+	withBankStatements     *BankStatementQuery
+	withBankStatementLines *BankStatementLineQuery
+	withFKs                bool
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -157,6 +163,72 @@ func (_q *AccountQuery) QueryRecurringInvoices() *RecurringInvoiceQuery {
 	return query
 }
 
+// QueryBalanceSnapshots chains the current query on the "balance_snapshots" edge.
+func (_q *AccountQuery) QueryBalanceSnapshots() *AccountBalanceSnapshotQuery {
+	query := (&AccountBalanceSnapshotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountbalancesnapshot.Table, accountbalancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BalanceSnapshotsTable, account.BalanceSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBankStatements chains the current query on the "bank_statements" edge.
+func (_q *AccountQuery) QueryBankStatements() *BankStatementQuery {
+	query := (&BankStatementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(bankstatement.Table, bankstatement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BankStatementsTable, account.BankStatementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBankStatementLines chains the current query on the "bank_statement_lines" edge.
+func (_q *AccountQuery) QueryBankStatementLines() *BankStatementLineQuery {
+	query := (&BankStatementLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(bankstatementline.Table, bankstatementline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BankStatementLinesTable, account.BankStatementLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Account entity from the query.
 // Returns a *NotFoundError when no Account was found.
 func (_q *AccountQuery) First(ctx context.Context) (*Account, error) {
@@ -344,15 +416,18 @@ func (_q *AccountQuery) Clone() *AccountQuery {
 		return nil
 	}
 	return &AccountQuery{
-		config:                _q.config,
-		ctx:                   _q.ctx.Clone(),
-		order:                 append([]account.OrderOption{}, _q.order...),
-		inters:                append([]Interceptor{}, _q.inters...),
-		predicates:            append([]predicate.Account{}, _q.predicates...),
-		withTenant:            _q.withTenant.Clone(),
-		withEntries:           _q.withEntries.Clone(),
-		withJournalEntries:    _q.withJournalEntries.Clone(),
-		withRecurringInvoices: _q.withRecurringInvoices.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]account.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.Account{}, _q.predicates...),
+		withTenant:             _q.withTenant.Clone(),
+		withEntries:            _q.withEntries.Clone(),
+		withJournalEntries:     _q.withJournalEntries.Clone(),
+		withRecurringInvoices:  _q.withRecurringInvoices.Clone(),
+		withBalanceSnapshots:   _q.withBalanceSnapshots.Clone(),
+		withBankStatements:     _q.withBankStatements.Clone(),
+		withBankStatementLines: _q.withBankStatementLines.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -404,6 +479,39 @@ func (_q *AccountQuery) WithRecurringInvoices(opts ...func(*RecurringInvoiceQuer
 	return _q
 }
 
+// WithBalanceSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "balance_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBalanceSnapshots(opts ...func(*AccountBalanceSnapshotQuery)) *AccountQuery {
+	query := (&AccountBalanceSnapshotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBalanceSnapshots = query
+	return _q
+}
+
+// WithBankStatements tells the query-builder to eager-load the nodes that are connected to
+// the "bank_statements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBankStatements(opts ...func(*BankStatementQuery)) *AccountQuery {
+	query := (&BankStatementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBankStatements = query
+	return _q
+}
+
+// WithBankStatementLines tells the query-builder to eager-load the nodes that are connected to
+// the "bank_statement_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBankStatementLines(opts ...func(*BankStatementLineQuery)) *AccountQuery {
+	query := (&BankStatementLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBankStatementLines = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -483,11 +591,14 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 		nodes       = []*Account{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			_q.withTenant != nil,
 			_q.withEntries != nil,
 			_q.withJournalEntries != nil,
 			_q.withRecurringInvoices != nil,
+			_q.withBalanceSnapshots != nil,
+			_q.withBankStatements != nil,
+			_q.withBankStatementLines != nil,
 		}
 	)
 	if _q.withTenant != nil {
@@ -546,6 +657,31 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			return nil, err
 		}
 	}
+	if query := _q.withBalanceSnapshots; query != nil {
+		if err := _q.loadBalanceSnapshots(ctx, query, nodes,
+			func(n *Account) { n.Edges.BalanceSnapshots = []*AccountBalanceSnapshot{} },
+			func(n *Account, e *AccountBalanceSnapshot) {
+				n.Edges.BalanceSnapshots = append(n.Edges.BalanceSnapshots, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBankStatements; query != nil {
+		if err := _q.loadBankStatements(ctx, query, nodes,
+			func(n *Account) { n.Edges.BankStatements = []*BankStatement{} },
+			func(n *Account, e *BankStatement) { n.Edges.BankStatements = append(n.Edges.BankStatements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBankStatementLines; query != nil {
+		if err := _q.loadBankStatementLines(ctx, query, nodes,
+			func(n *Account) { n.Edges.BankStatementLines = []*BankStatementLine{} },
+			func(n *Account, e *BankStatementLine) {
+				n.Edges.BankStatementLines = append(n.Edges.BankStatementLines, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -674,6 +810,99 @@ func (_q *AccountQuery) loadRecurringInvoices(ctx context.Context, query *Recurr
 	}
 	return nil
 }
+func (_q *AccountQuery) loadBalanceSnapshots(ctx context.Context, query *AccountBalanceSnapshotQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountBalanceSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AccountBalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BalanceSnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_balance_snapshots
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_balance_snapshots" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_balance_snapshots" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBankStatements(ctx context.Context, query *BankStatementQuery, nodes []*Account, init func(*Account), assign func(*Account, *BankStatement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BankStatement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BankStatementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_bank_statements
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_bank_statements" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_bank_statements" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBankStatementLines(ctx context.Context, query *BankStatementLineQuery, nodes []*Account, init func(*Account), assign func(*Account, *BankStatementLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BankStatementLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BankStatementLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.account_bank_statement_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_bank_statement_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_bank_statement_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -7,6 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
 	"sent/ent/journalentry"
 	"sent/ent/ledgerentry"
 	"sent/ent/predicate"
@@ -104,6 +107,26 @@ func (_u *AccountUpdate) SetNillableIsIntercompany(v *bool) *AccountUpdate {
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *AccountUpdate) SetCurrency(v string) *AccountUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableCurrency(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *AccountUpdate) ClearCurrency() *AccountUpdate {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AccountUpdate) SetCreatedAt(v time.Time) *AccountUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -174,6 +197,51 @@ func (_u *AccountUpdate) AddRecurringInvoices(v ...*RecurringInvoice) *AccountUp
 	return _u.AddRecurringInvoiceIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the AccountBalanceSnapshot entity by IDs.
+func (_u *AccountUpdate) AddBalanceSnapshotIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_u *AccountUpdate) AddBalanceSnapshots(v ...*AccountBalanceSnapshot) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBalanceSnapshotIDs(ids...)
+}
+
+// AddBankStatementIDs adds the "bank_statements" edge to the BankStatement entity by IDs.
+func (_u *AccountUpdate) AddBankStatementIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddBankStatementIDs(ids...)
+	return _u
+}
+
+// AddBankStatements adds the "bank_statements" edges to the BankStatement entity.
+func (_u *AccountUpdate) AddBankStatements(v ...*BankStatement) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBankStatementIDs(ids...)
+}
+
+// AddBankStatementLineIDs adds the "bank_statement_lines" edge to the BankStatementLine entity by IDs.
+func (_u *AccountUpdate) AddBankStatementLineIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddBankStatementLineIDs(ids...)
+	return _u
+}
+
+// AddBankStatementLines adds the "bank_statement_lines" edges to the BankStatementLine entity.
+func (_u *AccountUpdate) AddBankStatementLines(v ...*BankStatementLine) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBankStatementLineIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_u *AccountUpdate) Mutation() *AccountMutation {
 	return _u.mutation
@@ -248,6 +316,69 @@ func (_u *AccountUpdate) RemoveRecurringInvoices(v ...*RecurringInvoice) *Accoun
 	return _u.RemoveRecurringInvoiceIDs(ids...)
 }
 
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_u *AccountUpdate) ClearBalanceSnapshots() *AccountUpdate {
+	_u.mutation.ClearBalanceSnapshots()
+	return _u
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to AccountBalanceSnapshot entities by IDs.
+func (_u *AccountUpdate) RemoveBalanceSnapshotIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to AccountBalanceSnapshot entities.
+func (_u *AccountUpdate) RemoveBalanceSnapshots(v ...*AccountBalanceSnapshot) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearBankStatements clears all "bank_statements" edges to the BankStatement entity.
+func (_u *AccountUpdate) ClearBankStatements() *AccountUpdate {
+	_u.mutation.ClearBankStatements()
+	return _u
+}
+
+// RemoveBankStatementIDs removes the "bank_statements" edge to BankStatement entities by IDs.
+func (_u *AccountUpdate) RemoveBankStatementIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveBankStatementIDs(ids...)
+	return _u
+}
+
+// RemoveBankStatements removes "bank_statements" edges to BankStatement entities.
+func (_u *AccountUpdate) RemoveBankStatements(v ...*BankStatement) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankStatementIDs(ids...)
+}
+
+// ClearBankStatementLines clears all "bank_statement_lines" edges to the BankStatementLine entity.
+func (_u *AccountUpdate) ClearBankStatementLines() *AccountUpdate {
+	_u.mutation.ClearBankStatementLines()
+	return _u
+}
+
+// RemoveBankStatementLineIDs removes the "bank_statement_lines" edge to BankStatementLine entities by IDs.
+func (_u *AccountUpdate) RemoveBankStatementLineIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveBankStatementLineIDs(ids...)
+	return _u
+}
+
+// RemoveBankStatementLines removes "bank_statement_lines" edges to BankStatementLine entities.
+func (_u *AccountUpdate) RemoveBankStatementLines(v ...*BankStatementLine) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankStatementLineIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -321,6 +452,12 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsIntercompany(); ok {
 		_spec.SetField(account.FieldIsIntercompany, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(account.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(account.FieldCurrency, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -488,6 +625,141 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BankStatementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementsTable,
+			Columns: []string{account.BankStatementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBankStatementsIDs(); len(nodes) > 0 && !_u.mutation.BankStatementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementsTable,
+			Columns: []string{account.BankStatementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BankStatementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementsTable,
+			Columns: []string{account.BankStatementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BankStatementLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementLinesTable,
+			Columns: []string{account.BankStatementLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBankStatementLinesIDs(); len(nodes) > 0 && !_u.mutation.BankStatementLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementLinesTable,
+			Columns: []string{account.BankStatementLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BankStatementLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementLinesTable,
+			Columns: []string{account.BankStatementLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -580,6 +852,26 @@ func (_u *AccountUpdateOne) SetNillableIsIntercompany(v *bool) *AccountUpdateOne
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *AccountUpdateOne) SetCurrency(v string) *AccountUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableCurrency(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *AccountUpdateOne) ClearCurrency() *AccountUpdateOne {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AccountUpdateOne) SetCreatedAt(v time.Time) *AccountUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -650,6 +942,51 @@ func (_u *AccountUpdateOne) AddRecurringInvoices(v ...*RecurringInvoice) *Accoun
 	return _u.AddRecurringInvoiceIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the AccountBalanceSnapshot entity by IDs.
+func (_u *AccountUpdateOne) AddBalanceSnapshotIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_u *AccountUpdateOne) AddBalanceSnapshots(v ...*AccountBalanceSnapshot) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBalanceSnapshotIDs(ids...)
+}
+
+// AddBankStatementIDs adds the "bank_statements" edge to the BankStatement entity by IDs.
+func (_u *AccountUpdateOne) AddBankStatementIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddBankStatementIDs(ids...)
+	return _u
+}
+
+// AddBankStatements adds the "bank_statements" edges to the BankStatement entity.
+func (_u *AccountUpdateOne) AddBankStatements(v ...*BankStatement) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBankStatementIDs(ids...)
+}
+
+// AddBankStatementLineIDs adds the "bank_statement_lines" edge to the BankStatementLine entity by IDs.
+func (_u *AccountUpdateOne) AddBankStatementLineIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddBankStatementLineIDs(ids...)
+	return _u
+}
+
+// AddBankStatementLines adds the "bank_statement_lines" edges to the BankStatementLine entity.
+func (_u *AccountUpdateOne) AddBankStatementLines(v ...*BankStatementLine) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBankStatementLineIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_u *AccountUpdateOne) Mutation() *AccountMutation {
 	return _u.mutation
@@ -724,6 +1061,69 @@ func (_u *AccountUpdateOne) RemoveRecurringInvoices(v ...*RecurringInvoice) *Acc
 	return _u.RemoveRecurringInvoiceIDs(ids...)
 }
 
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_u *AccountUpdateOne) ClearBalanceSnapshots() *AccountUpdateOne {
+	_u.mutation.ClearBalanceSnapshots()
+	return _u
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to AccountBalanceSnapshot entities by IDs.
+func (_u *AccountUpdateOne) RemoveBalanceSnapshotIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to AccountBalanceSnapshot entities.
+func (_u *AccountUpdateOne) RemoveBalanceSnapshots(v ...*AccountBalanceSnapshot) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearBankStatements clears all "bank_statements" edges to the BankStatement entity.
+func (_u *AccountUpdateOne) ClearBankStatements() *AccountUpdateOne {
+	_u.mutation.ClearBankStatements()
+	return _u
+}
+
+// RemoveBankStatementIDs removes the "bank_statements" edge to BankStatement entities by IDs.
+func (_u *AccountUpdateOne) RemoveBankStatementIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveBankStatementIDs(ids...)
+	return _u
+}
+
+// RemoveBankStatements removes "bank_statements" edges to BankStatement entities.
+func (_u *AccountUpdateOne) RemoveBankStatements(v ...*BankStatement) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankStatementIDs(ids...)
+}
+
+// ClearBankStatementLines clears all "bank_statement_lines" edges to the BankStatementLine entity.
+func (_u *AccountUpdateOne) ClearBankStatementLines() *AccountUpdateOne {
+	_u.mutation.ClearBankStatementLines()
+	return _u
+}
+
+// RemoveBankStatementLineIDs removes the "bank_statement_lines" edge to BankStatementLine entities by IDs.
+func (_u *AccountUpdateOne) RemoveBankStatementLineIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveBankStatementLineIDs(ids...)
+	return _u
+}
+
+// RemoveBankStatementLines removes "bank_statement_lines" edges to BankStatementLine entities.
+func (_u *AccountUpdateOne) RemoveBankStatementLines(v ...*BankStatementLine) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankStatementLineIDs(ids...)
+}
+
 // Where appends a list predicates to the AccountUpdate builder.
 func (_u *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
 	_u.mutation.Where(ps...)
@@ -827,6 +1227,12 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 	if value, ok := _u.mutation.IsIntercompany(); ok {
 		_spec.SetField(account.FieldIsIntercompany, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(account.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(account.FieldCurrency, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -994,6 +1400,141 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BankStatementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementsTable,
+			Columns: []string{account.BankStatementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBankStatementsIDs(); len(nodes) > 0 && !_u.mutation.BankStatementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementsTable,
+			Columns: []string{account.BankStatementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BankStatementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementsTable,
+			Columns: []string{account.BankStatementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BankStatementLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementLinesTable,
+			Columns: []string{account.BankStatementLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBankStatementLinesIDs(); len(nodes) > 0 && !_u.mutation.BankStatementLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementLinesTable,
+			Columns: []string{account.BankStatementLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BankStatementLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BankStatementLinesTable,
+			Columns: []string{account.BankStatementLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Account{config: _u.config}
 	_spec.Assign = _node.assignValues
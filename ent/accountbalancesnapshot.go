@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// AccountBalanceSnapshot is the model entity for the AccountBalanceSnapshot schema.
+type AccountBalanceSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// PeriodEnd holds the value of the "period_end" field.
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// DebitTotal holds the value of the "debit_total" field.
+	DebitTotal decimal.Decimal `json:"debit_total,omitempty"`
+	// CreditTotal holds the value of the "credit_total" field.
+	CreditTotal decimal.Decimal `json:"credit_total,omitempty"`
+	// ClosingBalance holds the value of the "closing_balance" field.
+	ClosingBalance decimal.Decimal `json:"closing_balance,omitempty"`
+	// ComputedAt holds the value of the "computed_at" field.
+	ComputedAt time.Time `json:"computed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountBalanceSnapshotQuery when eager-loading is set.
+	Edges                     AccountBalanceSnapshotEdges `json:"edges"`
+	account_balance_snapshots *int
+	tenant_balance_snapshots  *int
+	selectValues              sql.SelectValues
+}
+
+// AccountBalanceSnapshotEdges holds the relations/edges for other nodes in the graph.
+type AccountBalanceSnapshotEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountBalanceSnapshotEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountBalanceSnapshotEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountBalanceSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountbalancesnapshot.FieldDebitTotal, accountbalancesnapshot.FieldCreditTotal, accountbalancesnapshot.FieldClosingBalance:
+			values[i] = new(decimal.Decimal)
+		case accountbalancesnapshot.FieldID:
+			values[i] = new(sql.NullInt64)
+		case accountbalancesnapshot.FieldPeriodStart, accountbalancesnapshot.FieldPeriodEnd, accountbalancesnapshot.FieldComputedAt:
+			values[i] = new(sql.NullTime)
+		case accountbalancesnapshot.ForeignKeys[0]: // account_balance_snapshots
+			values[i] = new(sql.NullInt64)
+		case accountbalancesnapshot.ForeignKeys[1]: // tenant_balance_snapshots
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountBalanceSnapshot fields.
+func (_m *AccountBalanceSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountbalancesnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case accountbalancesnapshot.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case accountbalancesnapshot.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				_m.PeriodEnd = value.Time
+			}
+		case accountbalancesnapshot.FieldDebitTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field debit_total", values[i])
+			} else if value != nil {
+				_m.DebitTotal = *value
+			}
+		case accountbalancesnapshot.FieldCreditTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_total", values[i])
+			} else if value != nil {
+				_m.CreditTotal = *value
+			}
+		case accountbalancesnapshot.FieldClosingBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field closing_balance", values[i])
+			} else if value != nil {
+				_m.ClosingBalance = *value
+			}
+		case accountbalancesnapshot.FieldComputedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field computed_at", values[i])
+			} else if value.Valid {
+				_m.ComputedAt = value.Time
+			}
+		case accountbalancesnapshot.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field account_balance_snapshots", value)
+			} else if value.Valid {
+				_m.account_balance_snapshots = new(int)
+				*_m.account_balance_snapshots = int(value.Int64)
+			}
+		case accountbalancesnapshot.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_balance_snapshots", value)
+			} else if value.Valid {
+				_m.tenant_balance_snapshots = new(int)
+				*_m.tenant_balance_snapshots = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountBalanceSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccountBalanceSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the AccountBalanceSnapshot entity.
+func (_m *AccountBalanceSnapshot) QueryTenant() *TenantQuery {
+	return NewAccountBalanceSnapshotClient(_m.config).QueryTenant(_m)
+}
+
+// QueryAccount queries the "account" edge of the AccountBalanceSnapshot entity.
+func (_m *AccountBalanceSnapshot) QueryAccount() *AccountQuery {
+	return NewAccountBalanceSnapshotClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this AccountBalanceSnapshot.
+// Note that you need to call AccountBalanceSnapshot.Unwrap() before calling this method if this AccountBalanceSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccountBalanceSnapshot) Update() *AccountBalanceSnapshotUpdateOne {
+	return NewAccountBalanceSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccountBalanceSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccountBalanceSnapshot) Unwrap() *AccountBalanceSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountBalanceSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccountBalanceSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountBalanceSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(_m.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("debit_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DebitTotal))
+	builder.WriteString(", ")
+	builder.WriteString("credit_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditTotal))
+	builder.WriteString(", ")
+	builder.WriteString("closing_balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClosingBalance))
+	builder.WriteString(", ")
+	builder.WriteString("computed_at=")
+	builder.WriteString(_m.ComputedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountBalanceSnapshots is a parsable slice of AccountBalanceSnapshot.
+type AccountBalanceSnapshots []*AccountBalanceSnapshot
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountbalancesnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the accountbalancesnapshot type in the database.
+	Label = "account_balance_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldDebitTotal holds the string denoting the debit_total field in the database.
+	FieldDebitTotal = "debit_total"
+	// FieldCreditTotal holds the string denoting the credit_total field in the database.
+	FieldCreditTotal = "credit_total"
+	// FieldClosingBalance holds the string denoting the closing_balance field in the database.
+	FieldClosingBalance = "closing_balance"
+	// FieldComputedAt holds the string denoting the computed_at field in the database.
+	FieldComputedAt = "computed_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the accountbalancesnapshot in the database.
+	Table = "account_balance_snapshots"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "account_balance_snapshots"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_balance_snapshots"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "account_balance_snapshots"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_balance_snapshots"
+)
+
+// Columns holds all SQL columns for accountbalancesnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldDebitTotal,
+	FieldCreditTotal,
+	FieldClosingBalance,
+	FieldComputedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "account_balance_snapshots"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"account_balance_snapshots",
+	"tenant_balance_snapshots",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDebitTotal holds the default value on creation for the "debit_total" field.
+	DefaultDebitTotal decimal.Decimal
+	// DefaultCreditTotal holds the default value on creation for the "credit_total" field.
+	DefaultCreditTotal decimal.Decimal
+	// DefaultClosingBalance holds the default value on creation for the "closing_balance" field.
+	DefaultClosingBalance decimal.Decimal
+	// DefaultComputedAt holds the default value on creation for the "computed_at" field.
+	DefaultComputedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AccountBalanceSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByDebitTotal orders the results by the debit_total field.
+func ByDebitTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDebitTotal, opts...).ToFunc()
+}
+
+// ByCreditTotal orders the results by the credit_total field.
+func ByCreditTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditTotal, opts...).ToFunc()
+}
+
+// ByClosingBalance orders the results by the closing_balance field.
+func ByClosingBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosingBalance, opts...).ToFunc()
+}
+
+// ByComputedAt orders the results by the computed_at field.
+func ByComputedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComputedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountbalancesnapshot
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// DebitTotal applies equality check predicate on the "debit_total" field. It's identical to DebitTotalEQ.
+func DebitTotal(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldDebitTotal, v))
+}
+
+// CreditTotal applies equality check predicate on the "credit_total" field. It's identical to CreditTotalEQ.
+func CreditTotal(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldCreditTotal, v))
+}
+
+// ClosingBalance applies equality check predicate on the "closing_balance" field. It's identical to ClosingBalanceEQ.
+func ClosingBalance(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldClosingBalance, v))
+}
+
+// ComputedAt applies equality check predicate on the "computed_at" field. It's identical to ComputedAtEQ.
+func ComputedAt(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// DebitTotalEQ applies the EQ predicate on the "debit_total" field.
+func DebitTotalEQ(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldDebitTotal, v))
+}
+
+// DebitTotalNEQ applies the NEQ predicate on the "debit_total" field.
+func DebitTotalNEQ(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNEQ(FieldDebitTotal, v))
+}
+
+// DebitTotalIn applies the In predicate on the "debit_total" field.
+func DebitTotalIn(vs ...decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldIn(FieldDebitTotal, vs...))
+}
+
+// DebitTotalNotIn applies the NotIn predicate on the "debit_total" field.
+func DebitTotalNotIn(vs ...decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNotIn(FieldDebitTotal, vs...))
+}
+
+// DebitTotalGT applies the GT predicate on the "debit_total" field.
+func DebitTotalGT(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGT(FieldDebitTotal, v))
+}
+
+// DebitTotalGTE applies the GTE predicate on the "debit_total" field.
+func DebitTotalGTE(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGTE(FieldDebitTotal, v))
+}
+
+// DebitTotalLT applies the LT predicate on the "debit_total" field.
+func DebitTotalLT(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLT(FieldDebitTotal, v))
+}
+
+// DebitTotalLTE applies the LTE predicate on the "debit_total" field.
+func DebitTotalLTE(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLTE(FieldDebitTotal, v))
+}
+
+// CreditTotalEQ applies the EQ predicate on the "credit_total" field.
+func CreditTotalEQ(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldCreditTotal, v))
+}
+
+// CreditTotalNEQ applies the NEQ predicate on the "credit_total" field.
+func CreditTotalNEQ(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNEQ(FieldCreditTotal, v))
+}
+
+// CreditTotalIn applies the In predicate on the "credit_total" field.
+func CreditTotalIn(vs ...decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldIn(FieldCreditTotal, vs...))
+}
+
+// CreditTotalNotIn applies the NotIn predicate on the "credit_total" field.
+func CreditTotalNotIn(vs ...decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNotIn(FieldCreditTotal, vs...))
+}
+
+// CreditTotalGT applies the GT predicate on the "credit_total" field.
+func CreditTotalGT(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGT(FieldCreditTotal, v))
+}
+
+// CreditTotalGTE applies the GTE predicate on the "credit_total" field.
+func CreditTotalGTE(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGTE(FieldCreditTotal, v))
+}
+
+// CreditTotalLT applies the LT predicate on the "credit_total" field.
+func CreditTotalLT(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLT(FieldCreditTotal, v))
+}
+
+// CreditTotalLTE applies the LTE predicate on the "credit_total" field.
+func CreditTotalLTE(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLTE(FieldCreditTotal, v))
+}
+
+// ClosingBalanceEQ applies the EQ predicate on the "closing_balance" field.
+func ClosingBalanceEQ(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldClosingBalance, v))
+}
+
+// ClosingBalanceNEQ applies the NEQ predicate on the "closing_balance" field.
+func ClosingBalanceNEQ(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNEQ(FieldClosingBalance, v))
+}
+
+// ClosingBalanceIn applies the In predicate on the "closing_balance" field.
+func ClosingBalanceIn(vs ...decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldIn(FieldClosingBalance, vs...))
+}
+
+// ClosingBalanceNotIn applies the NotIn predicate on the "closing_balance" field.
+func ClosingBalanceNotIn(vs ...decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNotIn(FieldClosingBalance, vs...))
+}
+
+// ClosingBalanceGT applies the GT predicate on the "closing_balance" field.
+func ClosingBalanceGT(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGT(FieldClosingBalance, v))
+}
+
+// ClosingBalanceGTE applies the GTE predicate on the "closing_balance" field.
+func ClosingBalanceGTE(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGTE(FieldClosingBalance, v))
+}
+
+// ClosingBalanceLT applies the LT predicate on the "closing_balance" field.
+func ClosingBalanceLT(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLT(FieldClosingBalance, v))
+}
+
+// ClosingBalanceLTE applies the LTE predicate on the "closing_balance" field.
+func ClosingBalanceLTE(v decimal.Decimal) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLTE(FieldClosingBalance, v))
+}
+
+// ComputedAtEQ applies the EQ predicate on the "computed_at" field.
+func ComputedAtEQ(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// ComputedAtNEQ applies the NEQ predicate on the "computed_at" field.
+func ComputedAtNEQ(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNEQ(FieldComputedAt, v))
+}
+
+// ComputedAtIn applies the In predicate on the "computed_at" field.
+func ComputedAtIn(vs ...time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtNotIn applies the NotIn predicate on the "computed_at" field.
+func ComputedAtNotIn(vs ...time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldNotIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtGT applies the GT predicate on the "computed_at" field.
+func ComputedAtGT(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGT(FieldComputedAt, v))
+}
+
+// ComputedAtGTE applies the GTE predicate on the "computed_at" field.
+func ComputedAtGTE(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldGTE(FieldComputedAt, v))
+}
+
+// ComputedAtLT applies the LT predicate on the "computed_at" field.
+func ComputedAtLT(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLT(FieldComputedAt, v))
+}
+
+// ComputedAtLTE applies the LTE predicate on the "computed_at" field.
+func ComputedAtLTE(v time.Time) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.FieldLTE(FieldComputedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountBalanceSnapshot) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountBalanceSnapshot) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountBalanceSnapshot) predicate.AccountBalanceSnapshot {
+	return predicate.AccountBalanceSnapshot(sql.NotPredicates(p))
+}
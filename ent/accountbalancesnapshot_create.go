@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// AccountBalanceSnapshotCreate is the builder for creating a AccountBalanceSnapshot entity.
+type AccountBalanceSnapshotCreate struct {
+	config
+	mutation *AccountBalanceSnapshotMutation
+	hooks    []Hook
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *AccountBalanceSnapshotCreate) SetPeriodStart(v time.Time) *AccountBalanceSnapshotCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_c *AccountBalanceSnapshotCreate) SetPeriodEnd(v time.Time) *AccountBalanceSnapshotCreate {
+	_c.mutation.SetPeriodEnd(v)
+	return _c
+}
+
+// SetDebitTotal sets the "debit_total" field.
+func (_c *AccountBalanceSnapshotCreate) SetDebitTotal(v decimal.Decimal) *AccountBalanceSnapshotCreate {
+	_c.mutation.SetDebitTotal(v)
+	return _c
+}
+
+// SetNillableDebitTotal sets the "debit_total" field if the given value is not nil.
+func (_c *AccountBalanceSnapshotCreate) SetNillableDebitTotal(v *decimal.Decimal) *AccountBalanceSnapshotCreate {
+	if v != nil {
+		_c.SetDebitTotal(*v)
+	}
+	return _c
+}
+
+// SetCreditTotal sets the "credit_total" field.
+func (_c *AccountBalanceSnapshotCreate) SetCreditTotal(v decimal.Decimal) *AccountBalanceSnapshotCreate {
+	_c.mutation.SetCreditTotal(v)
+	return _c
+}
+
+// SetNillableCreditTotal sets the "credit_total" field if the given value is not nil.
+func (_c *AccountBalanceSnapshotCreate) SetNillableCreditTotal(v *decimal.Decimal) *AccountBalanceSnapshotCreate {
+	if v != nil {
+		_c.SetCreditTotal(*v)
+	}
+	return _c
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_c *AccountBalanceSnapshotCreate) SetClosingBalance(v decimal.Decimal) *AccountBalanceSnapshotCreate {
+	_c.mutation.SetClosingBalance(v)
+	return _c
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_c *AccountBalanceSnapshotCreate) SetNillableClosingBalance(v *decimal.Decimal) *AccountBalanceSnapshotCreate {
+	if v != nil {
+		_c.SetClosingBalance(*v)
+	}
+	return _c
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_c *AccountBalanceSnapshotCreate) SetComputedAt(v time.Time) *AccountBalanceSnapshotCreate {
+	_c.mutation.SetComputedAt(v)
+	return _c
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_c *AccountBalanceSnapshotCreate) SetNillableComputedAt(v *time.Time) *AccountBalanceSnapshotCreate {
+	if v != nil {
+		_c.SetComputedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *AccountBalanceSnapshotCreate) SetTenantID(id int) *AccountBalanceSnapshotCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *AccountBalanceSnapshotCreate) SetTenant(v *Tenant) *AccountBalanceSnapshotCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *AccountBalanceSnapshotCreate) SetAccountID(id int) *AccountBalanceSnapshotCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *AccountBalanceSnapshotCreate) SetAccount(v *Account) *AccountBalanceSnapshotCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the AccountBalanceSnapshotMutation object of the builder.
+func (_c *AccountBalanceSnapshotCreate) Mutation() *AccountBalanceSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountBalanceSnapshot in the database.
+func (_c *AccountBalanceSnapshotCreate) Save(ctx context.Context) (*AccountBalanceSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountBalanceSnapshotCreate) SaveX(ctx context.Context) *AccountBalanceSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountBalanceSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountBalanceSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountBalanceSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.DebitTotal(); !ok {
+		v := accountbalancesnapshot.DefaultDebitTotal
+		_c.mutation.SetDebitTotal(v)
+	}
+	if _, ok := _c.mutation.CreditTotal(); !ok {
+		v := accountbalancesnapshot.DefaultCreditTotal
+		_c.mutation.SetCreditTotal(v)
+	}
+	if _, ok := _c.mutation.ClosingBalance(); !ok {
+		v := accountbalancesnapshot.DefaultClosingBalance
+		_c.mutation.SetClosingBalance(v)
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		v := accountbalancesnapshot.DefaultComputedAt()
+		_c.mutation.SetComputedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountBalanceSnapshotCreate) check() error {
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "AccountBalanceSnapshot.period_start"`)}
+	}
+	if _, ok := _c.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "AccountBalanceSnapshot.period_end"`)}
+	}
+	if _, ok := _c.mutation.DebitTotal(); !ok {
+		return &ValidationError{Name: "debit_total", err: errors.New(`ent: missing required field "AccountBalanceSnapshot.debit_total"`)}
+	}
+	if _, ok := _c.mutation.CreditTotal(); !ok {
+		return &ValidationError{Name: "credit_total", err: errors.New(`ent: missing required field "AccountBalanceSnapshot.credit_total"`)}
+	}
+	if _, ok := _c.mutation.ClosingBalance(); !ok {
+		return &ValidationError{Name: "closing_balance", err: errors.New(`ent: missing required field "AccountBalanceSnapshot.closing_balance"`)}
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		return &ValidationError{Name: "computed_at", err: errors.New(`ent: missing required field "AccountBalanceSnapshot.computed_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "AccountBalanceSnapshot.tenant"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "AccountBalanceSnapshot.account"`)}
+	}
+	return nil
+}
+
+func (_c *AccountBalanceSnapshotCreate) sqlSave(ctx context.Context) (*AccountBalanceSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountBalanceSnapshotCreate) createSpec() (*AccountBalanceSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountBalanceSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountbalancesnapshot.Table, sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.PeriodEnd(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := _c.mutation.DebitTotal(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldDebitTotal, field.TypeOther, value)
+		_node.DebitTotal = value
+	}
+	if value, ok := _c.mutation.CreditTotal(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldCreditTotal, field.TypeOther, value)
+		_node.CreditTotal = value
+	}
+	if value, ok := _c.mutation.ClosingBalance(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldClosingBalance, field.TypeOther, value)
+		_node.ClosingBalance = value
+	}
+	if value, ok := _c.mutation.ComputedAt(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldComputedAt, field.TypeTime, value)
+		_node.ComputedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.TenantTable,
+			Columns: []string{accountbalancesnapshot.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_balance_snapshots = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.AccountTable,
+			Columns: []string{accountbalancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_balance_snapshots = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccountBalanceSnapshotCreateBulk is the builder for creating many AccountBalanceSnapshot entities in bulk.
+type AccountBalanceSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*AccountBalanceSnapshotCreate
+}
+
+// Save creates the AccountBalanceSnapshot entities in the database.
+func (_c *AccountBalanceSnapshotCreateBulk) Save(ctx context.Context) ([]*AccountBalanceSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountBalanceSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountBalanceSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountBalanceSnapshotCreateBulk) SaveX(ctx context.Context) []*AccountBalanceSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountBalanceSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountBalanceSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sent/ent/accountbalancesnapshot"
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountBalanceSnapshotDelete is the builder for deleting a AccountBalanceSnapshot entity.
+type AccountBalanceSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *AccountBalanceSnapshotMutation
+}
+
+// Where appends a list predicates to the AccountBalanceSnapshotDelete builder.
+func (_d *AccountBalanceSnapshotDelete) Where(ps ...predicate.AccountBalanceSnapshot) *AccountBalanceSnapshotDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AccountBalanceSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AccountBalanceSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AccountBalanceSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(accountbalancesnapshot.Table, sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AccountBalanceSnapshotDeleteOne is the builder for deleting a single AccountBalanceSnapshot entity.
+type AccountBalanceSnapshotDeleteOne struct {
+	_d *AccountBalanceSnapshotDelete
+}
+
+// Where appends a list predicates to the AccountBalanceSnapshotDelete builder.
+func (_d *AccountBalanceSnapshotDeleteOne) Where(ps ...predicate.AccountBalanceSnapshot) *AccountBalanceSnapshotDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AccountBalanceSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{accountbalancesnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AccountBalanceSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
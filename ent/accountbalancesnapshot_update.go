@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// AccountBalanceSnapshotUpdate is the builder for updating AccountBalanceSnapshot entities.
+type AccountBalanceSnapshotUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccountBalanceSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccountBalanceSnapshotUpdate builder.
+func (_u *AccountBalanceSnapshotUpdate) Where(ps ...predicate.AccountBalanceSnapshot) *AccountBalanceSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *AccountBalanceSnapshotUpdate) SetPeriodStart(v time.Time) *AccountBalanceSnapshotUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdate) SetNillablePeriodStart(v *time.Time) *AccountBalanceSnapshotUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *AccountBalanceSnapshotUpdate) SetPeriodEnd(v time.Time) *AccountBalanceSnapshotUpdate {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdate) SetNillablePeriodEnd(v *time.Time) *AccountBalanceSnapshotUpdate {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetDebitTotal sets the "debit_total" field.
+func (_u *AccountBalanceSnapshotUpdate) SetDebitTotal(v decimal.Decimal) *AccountBalanceSnapshotUpdate {
+	_u.mutation.SetDebitTotal(v)
+	return _u
+}
+
+// SetNillableDebitTotal sets the "debit_total" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdate) SetNillableDebitTotal(v *decimal.Decimal) *AccountBalanceSnapshotUpdate {
+	if v != nil {
+		_u.SetDebitTotal(*v)
+	}
+	return _u
+}
+
+// SetCreditTotal sets the "credit_total" field.
+func (_u *AccountBalanceSnapshotUpdate) SetCreditTotal(v decimal.Decimal) *AccountBalanceSnapshotUpdate {
+	_u.mutation.SetCreditTotal(v)
+	return _u
+}
+
+// SetNillableCreditTotal sets the "credit_total" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdate) SetNillableCreditTotal(v *decimal.Decimal) *AccountBalanceSnapshotUpdate {
+	if v != nil {
+		_u.SetCreditTotal(*v)
+	}
+	return _u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_u *AccountBalanceSnapshotUpdate) SetClosingBalance(v decimal.Decimal) *AccountBalanceSnapshotUpdate {
+	_u.mutation.SetClosingBalance(v)
+	return _u
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdate) SetNillableClosingBalance(v *decimal.Decimal) *AccountBalanceSnapshotUpdate {
+	if v != nil {
+		_u.SetClosingBalance(*v)
+	}
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *AccountBalanceSnapshotUpdate) SetComputedAt(v time.Time) *AccountBalanceSnapshotUpdate {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdate) SetNillableComputedAt(v *time.Time) *AccountBalanceSnapshotUpdate {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AccountBalanceSnapshotUpdate) SetTenantID(id int) *AccountBalanceSnapshotUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AccountBalanceSnapshotUpdate) SetTenant(v *Tenant) *AccountBalanceSnapshotUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *AccountBalanceSnapshotUpdate) SetAccountID(id int) *AccountBalanceSnapshotUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountBalanceSnapshotUpdate) SetAccount(v *Account) *AccountBalanceSnapshotUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the AccountBalanceSnapshotMutation object of the builder.
+func (_u *AccountBalanceSnapshotUpdate) Mutation() *AccountBalanceSnapshotMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *AccountBalanceSnapshotUpdate) ClearTenant() *AccountBalanceSnapshotUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountBalanceSnapshotUpdate) ClearAccount() *AccountBalanceSnapshotUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountBalanceSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountBalanceSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountBalanceSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountBalanceSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountBalanceSnapshotUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBalanceSnapshot.tenant"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBalanceSnapshot.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountBalanceSnapshotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountBalanceSnapshotUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountBalanceSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountbalancesnapshot.Table, accountbalancesnapshot.Columns, sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DebitTotal(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldDebitTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CreditTotal(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldCreditTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ClosingBalance(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldClosingBalance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldComputedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.TenantTable,
+			Columns: []string{accountbalancesnapshot.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.TenantTable,
+			Columns: []string{accountbalancesnapshot.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.AccountTable,
+			Columns: []string{accountbalancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.AccountTable,
+			Columns: []string{accountbalancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountbalancesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountBalanceSnapshotUpdateOne is the builder for updating a single AccountBalanceSnapshot entity.
+type AccountBalanceSnapshotUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountBalanceSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *AccountBalanceSnapshotUpdateOne) SetPeriodStart(v time.Time) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdateOne) SetNillablePeriodStart(v *time.Time) *AccountBalanceSnapshotUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *AccountBalanceSnapshotUpdateOne) SetPeriodEnd(v time.Time) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdateOne) SetNillablePeriodEnd(v *time.Time) *AccountBalanceSnapshotUpdateOne {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetDebitTotal sets the "debit_total" field.
+func (_u *AccountBalanceSnapshotUpdateOne) SetDebitTotal(v decimal.Decimal) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.SetDebitTotal(v)
+	return _u
+}
+
+// SetNillableDebitTotal sets the "debit_total" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdateOne) SetNillableDebitTotal(v *decimal.Decimal) *AccountBalanceSnapshotUpdateOne {
+	if v != nil {
+		_u.SetDebitTotal(*v)
+	}
+	return _u
+}
+
+// SetCreditTotal sets the "credit_total" field.
+func (_u *AccountBalanceSnapshotUpdateOne) SetCreditTotal(v decimal.Decimal) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.SetCreditTotal(v)
+	return _u
+}
+
+// SetNillableCreditTotal sets the "credit_total" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdateOne) SetNillableCreditTotal(v *decimal.Decimal) *AccountBalanceSnapshotUpdateOne {
+	if v != nil {
+		_u.SetCreditTotal(*v)
+	}
+	return _u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_u *AccountBalanceSnapshotUpdateOne) SetClosingBalance(v decimal.Decimal) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.SetClosingBalance(v)
+	return _u
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdateOne) SetNillableClosingBalance(v *decimal.Decimal) *AccountBalanceSnapshotUpdateOne {
+	if v != nil {
+		_u.SetClosingBalance(*v)
+	}
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *AccountBalanceSnapshotUpdateOne) SetComputedAt(v time.Time) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *AccountBalanceSnapshotUpdateOne) SetNillableComputedAt(v *time.Time) *AccountBalanceSnapshotUpdateOne {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AccountBalanceSnapshotUpdateOne) SetTenantID(id int) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AccountBalanceSnapshotUpdateOne) SetTenant(v *Tenant) *AccountBalanceSnapshotUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *AccountBalanceSnapshotUpdateOne) SetAccountID(id int) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountBalanceSnapshotUpdateOne) SetAccount(v *Account) *AccountBalanceSnapshotUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the AccountBalanceSnapshotMutation object of the builder.
+func (_u *AccountBalanceSnapshotUpdateOne) Mutation() *AccountBalanceSnapshotMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *AccountBalanceSnapshotUpdateOne) ClearTenant() *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountBalanceSnapshotUpdateOne) ClearAccount() *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the AccountBalanceSnapshotUpdate builder.
+func (_u *AccountBalanceSnapshotUpdateOne) Where(ps ...predicate.AccountBalanceSnapshot) *AccountBalanceSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountBalanceSnapshotUpdateOne) Select(field string, fields ...string) *AccountBalanceSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountBalanceSnapshot entity.
+func (_u *AccountBalanceSnapshotUpdateOne) Save(ctx context.Context) (*AccountBalanceSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountBalanceSnapshotUpdateOne) SaveX(ctx context.Context) *AccountBalanceSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountBalanceSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountBalanceSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountBalanceSnapshotUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBalanceSnapshot.tenant"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBalanceSnapshot.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountBalanceSnapshotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountBalanceSnapshotUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountBalanceSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *AccountBalanceSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountbalancesnapshot.Table, accountbalancesnapshot.Columns, sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountBalanceSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountbalancesnapshot.FieldID)
+		for _, f := range fields {
+			if !accountbalancesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountbalancesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DebitTotal(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldDebitTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CreditTotal(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldCreditTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ClosingBalance(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldClosingBalance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(accountbalancesnapshot.FieldComputedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.TenantTable,
+			Columns: []string{accountbalancesnapshot.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.TenantTable,
+			Columns: []string{accountbalancesnapshot.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.AccountTable,
+			Columns: []string{accountbalancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbalancesnapshot.AccountTable,
+			Columns: []string{accountbalancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AccountBalanceSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountbalancesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
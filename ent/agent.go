@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"sent/ent/agent"
+	"sent/ent/agentenrollmenttoken"
 	"sent/ent/tenant"
 	"strings"
 	"time"
@@ -34,15 +36,34 @@ type Agent struct {
 	Status agent.Status `json:"status,omitempty"`
 	// LastSeen holds the value of the "last_seen" field.
 	LastSeen time.Time `json:"last_seen,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey string `json:"public_key,omitempty"`
+	// Certificate holds the value of the "certificate" field.
+	Certificate string `json:"certificate,omitempty"`
+	// CertificateSerial holds the value of the "certificate_serial" field.
+	CertificateSerial string `json:"certificate_serial,omitempty"`
+	// CertificateExpiresAt holds the value of the "certificate_expires_at" field.
+	CertificateExpiresAt *time.Time `json:"certificate_expires_at,omitempty"`
+	// EnrolledAt holds the value of the "enrolled_at" field.
+	EnrolledAt *time.Time `json:"enrolled_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// RekeyRequested holds the value of the "rekey_requested" field.
+	RekeyRequested bool `json:"rekey_requested,omitempty"`
+	// InventoryAt holds the value of the "inventory_at" field.
+	InventoryAt *time.Time `json:"inventory_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AgentQuery when eager-loading is set.
-	Edges         AgentEdges `json:"edges"`
-	tenant_agents *int
-	selectValues  sql.SelectValues
+	Edges                        AgentEdges `json:"edges"`
+	agent_enrollment_token_agent *int
+	tenant_agents                *int
+	selectValues                 sql.SelectValues
 }
 
 // AgentEdges holds the relations/edges for other nodes in the graph.
@@ -51,9 +72,19 @@ type AgentEdges struct {
 	Tenant *Tenant `json:"tenant,omitempty"`
 	// JobExecutions holds the value of the job_executions edge.
 	JobExecutions []*JobExecution `json:"job_executions,omitempty"`
+	// EnrollmentToken holds the value of the enrollment_token edge.
+	EnrollmentToken *AgentEnrollmentToken `json:"enrollment_token,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*DeviceGroup `json:"groups,omitempty"`
+	// Inventory holds the value of the inventory edge.
+	Inventory []*AgentInventory `json:"inventory,omitempty"`
+	// Software holds the value of the software edge.
+	Software []*AgentSoftware `json:"software,omitempty"`
+	// Alerts holds the value of the alerts edge.
+	Alerts []*AgentAlert `json:"alerts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [7]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -76,18 +107,71 @@ func (e AgentEdges) JobExecutionsOrErr() ([]*JobExecution, error) {
 	return nil, &NotLoadedError{edge: "job_executions"}
 }
 
+// EnrollmentTokenOrErr returns the EnrollmentToken value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentEdges) EnrollmentTokenOrErr() (*AgentEnrollmentToken, error) {
+	if e.EnrollmentToken != nil {
+		return e.EnrollmentToken, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: agentenrollmenttoken.Label}
+	}
+	return nil, &NotLoadedError{edge: "enrollment_token"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentEdges) GroupsOrErr() ([]*DeviceGroup, error) {
+	if e.loadedTypes[3] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// InventoryOrErr returns the Inventory value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentEdges) InventoryOrErr() ([]*AgentInventory, error) {
+	if e.loadedTypes[4] {
+		return e.Inventory, nil
+	}
+	return nil, &NotLoadedError{edge: "inventory"}
+}
+
+// SoftwareOrErr returns the Software value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentEdges) SoftwareOrErr() ([]*AgentSoftware, error) {
+	if e.loadedTypes[5] {
+		return e.Software, nil
+	}
+	return nil, &NotLoadedError{edge: "software"}
+}
+
+// AlertsOrErr returns the Alerts value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentEdges) AlertsOrErr() ([]*AgentAlert, error) {
+	if e.loadedTypes[6] {
+		return e.Alerts, nil
+	}
+	return nil, &NotLoadedError{edge: "alerts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Agent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case agent.FieldTags:
+			values[i] = new([]byte)
+		case agent.FieldRekeyRequested:
+			values[i] = new(sql.NullBool)
 		case agent.FieldID:
 			values[i] = new(sql.NullInt64)
-		case agent.FieldHostname, agent.FieldOs, agent.FieldArch, agent.FieldIP, agent.FieldMAC, agent.FieldVersion, agent.FieldStatus:
+		case agent.FieldHostname, agent.FieldOs, agent.FieldArch, agent.FieldIP, agent.FieldMAC, agent.FieldVersion, agent.FieldStatus, agent.FieldPublicKey, agent.FieldCertificate, agent.FieldCertificateSerial:
 			values[i] = new(sql.NullString)
-		case agent.FieldLastSeen, agent.FieldCreatedAt, agent.FieldUpdatedAt:
+		case agent.FieldLastSeen, agent.FieldCertificateExpiresAt, agent.FieldEnrolledAt, agent.FieldRevokedAt, agent.FieldInventoryAt, agent.FieldCreatedAt, agent.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case agent.ForeignKeys[0]: // tenant_agents
+		case agent.ForeignKeys[0]: // agent_enrollment_token_agent
+			values[i] = new(sql.NullInt64)
+		case agent.ForeignKeys[1]: // tenant_agents
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -158,6 +242,66 @@ func (_m *Agent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LastSeen = value.Time
 			}
+		case agent.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case agent.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				_m.PublicKey = value.String
+			}
+		case agent.FieldCertificate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate", values[i])
+			} else if value.Valid {
+				_m.Certificate = value.String
+			}
+		case agent.FieldCertificateSerial:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_serial", values[i])
+			} else if value.Valid {
+				_m.CertificateSerial = value.String
+			}
+		case agent.FieldCertificateExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_expires_at", values[i])
+			} else if value.Valid {
+				_m.CertificateExpiresAt = new(time.Time)
+				*_m.CertificateExpiresAt = value.Time
+			}
+		case agent.FieldEnrolledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field enrolled_at", values[i])
+			} else if value.Valid {
+				_m.EnrolledAt = new(time.Time)
+				*_m.EnrolledAt = value.Time
+			}
+		case agent.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		case agent.FieldRekeyRequested:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field rekey_requested", values[i])
+			} else if value.Valid {
+				_m.RekeyRequested = value.Bool
+			}
+		case agent.FieldInventoryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field inventory_at", values[i])
+			} else if value.Valid {
+				_m.InventoryAt = new(time.Time)
+				*_m.InventoryAt = value.Time
+			}
 		case agent.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -171,6 +315,13 @@ func (_m *Agent) assignValues(columns []string, values []any) error {
 				_m.UpdatedAt = value.Time
 			}
 		case agent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field agent_enrollment_token_agent", value)
+			} else if value.Valid {
+				_m.agent_enrollment_token_agent = new(int)
+				*_m.agent_enrollment_token_agent = int(value.Int64)
+			}
+		case agent.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field tenant_agents", value)
 			} else if value.Valid {
@@ -200,6 +351,31 @@ func (_m *Agent) QueryJobExecutions() *JobExecutionQuery {
 	return NewAgentClient(_m.config).QueryJobExecutions(_m)
 }
 
+// QueryEnrollmentToken queries the "enrollment_token" edge of the Agent entity.
+func (_m *Agent) QueryEnrollmentToken() *AgentEnrollmentTokenQuery {
+	return NewAgentClient(_m.config).QueryEnrollmentToken(_m)
+}
+
+// QueryGroups queries the "groups" edge of the Agent entity.
+func (_m *Agent) QueryGroups() *DeviceGroupQuery {
+	return NewAgentClient(_m.config).QueryGroups(_m)
+}
+
+// QueryInventory queries the "inventory" edge of the Agent entity.
+func (_m *Agent) QueryInventory() *AgentInventoryQuery {
+	return NewAgentClient(_m.config).QueryInventory(_m)
+}
+
+// QuerySoftware queries the "software" edge of the Agent entity.
+func (_m *Agent) QuerySoftware() *AgentSoftwareQuery {
+	return NewAgentClient(_m.config).QuerySoftware(_m)
+}
+
+// QueryAlerts queries the "alerts" edge of the Agent entity.
+func (_m *Agent) QueryAlerts() *AgentAlertQuery {
+	return NewAgentClient(_m.config).QueryAlerts(_m)
+}
+
 // Update returns a builder for updating this Agent.
 // Note that you need to call Agent.Unwrap() before calling this method if this Agent
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -247,6 +423,41 @@ func (_m *Agent) String() string {
 	builder.WriteString("last_seen=")
 	builder.WriteString(_m.LastSeen.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(_m.PublicKey)
+	builder.WriteString(", ")
+	builder.WriteString("certificate=")
+	builder.WriteString(_m.Certificate)
+	builder.WriteString(", ")
+	builder.WriteString("certificate_serial=")
+	builder.WriteString(_m.CertificateSerial)
+	builder.WriteString(", ")
+	if v := _m.CertificateExpiresAt; v != nil {
+		builder.WriteString("certificate_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EnrolledAt; v != nil {
+		builder.WriteString("enrolled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rekey_requested=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RekeyRequested))
+	builder.WriteString(", ")
+	if v := _m.InventoryAt; v != nil {
+		builder.WriteString("inventory_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -31,6 +31,24 @@ const (
 	FieldStatus = "status"
 	// FieldLastSeen holds the string denoting the last_seen field in the database.
 	FieldLastSeen = "last_seen"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldPublicKey holds the string denoting the public_key field in the database.
+	FieldPublicKey = "public_key"
+	// FieldCertificate holds the string denoting the certificate field in the database.
+	FieldCertificate = "certificate"
+	// FieldCertificateSerial holds the string denoting the certificate_serial field in the database.
+	FieldCertificateSerial = "certificate_serial"
+	// FieldCertificateExpiresAt holds the string denoting the certificate_expires_at field in the database.
+	FieldCertificateExpiresAt = "certificate_expires_at"
+	// FieldEnrolledAt holds the string denoting the enrolled_at field in the database.
+	FieldEnrolledAt = "enrolled_at"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// FieldRekeyRequested holds the string denoting the rekey_requested field in the database.
+	FieldRekeyRequested = "rekey_requested"
+	// FieldInventoryAt holds the string denoting the inventory_at field in the database.
+	FieldInventoryAt = "inventory_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -39,6 +57,16 @@ const (
 	EdgeTenant = "tenant"
 	// EdgeJobExecutions holds the string denoting the job_executions edge name in mutations.
 	EdgeJobExecutions = "job_executions"
+	// EdgeEnrollmentToken holds the string denoting the enrollment_token edge name in mutations.
+	EdgeEnrollmentToken = "enrollment_token"
+	// EdgeGroups holds the string denoting the groups edge name in mutations.
+	EdgeGroups = "groups"
+	// EdgeInventory holds the string denoting the inventory edge name in mutations.
+	EdgeInventory = "inventory"
+	// EdgeSoftware holds the string denoting the software edge name in mutations.
+	EdgeSoftware = "software"
+	// EdgeAlerts holds the string denoting the alerts edge name in mutations.
+	EdgeAlerts = "alerts"
 	// Table holds the table name of the agent in the database.
 	Table = "agents"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -55,6 +83,39 @@ const (
 	JobExecutionsInverseTable = "job_executions"
 	// JobExecutionsColumn is the table column denoting the job_executions relation/edge.
 	JobExecutionsColumn = "agent_job_executions"
+	// EnrollmentTokenTable is the table that holds the enrollment_token relation/edge.
+	EnrollmentTokenTable = "agents"
+	// EnrollmentTokenInverseTable is the table name for the AgentEnrollmentToken entity.
+	// It exists in this package in order to avoid circular dependency with the "agentenrollmenttoken" package.
+	EnrollmentTokenInverseTable = "agent_enrollment_tokens"
+	// EnrollmentTokenColumn is the table column denoting the enrollment_token relation/edge.
+	EnrollmentTokenColumn = "agent_enrollment_token_agent"
+	// GroupsTable is the table that holds the groups relation/edge. The primary key declared below.
+	GroupsTable = "device_group_agents"
+	// GroupsInverseTable is the table name for the DeviceGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "devicegroup" package.
+	GroupsInverseTable = "device_groups"
+	// InventoryTable is the table that holds the inventory relation/edge.
+	InventoryTable = "agent_inventories"
+	// InventoryInverseTable is the table name for the AgentInventory entity.
+	// It exists in this package in order to avoid circular dependency with the "agentinventory" package.
+	InventoryInverseTable = "agent_inventories"
+	// InventoryColumn is the table column denoting the inventory relation/edge.
+	InventoryColumn = "agent_inventory"
+	// SoftwareTable is the table that holds the software relation/edge.
+	SoftwareTable = "agent_softwares"
+	// SoftwareInverseTable is the table name for the AgentSoftware entity.
+	// It exists in this package in order to avoid circular dependency with the "agentsoftware" package.
+	SoftwareInverseTable = "agent_softwares"
+	// SoftwareColumn is the table column denoting the software relation/edge.
+	SoftwareColumn = "agent_software"
+	// AlertsTable is the table that holds the alerts relation/edge.
+	AlertsTable = "agent_alerts"
+	// AlertsInverseTable is the table name for the AgentAlert entity.
+	// It exists in this package in order to avoid circular dependency with the "agentalert" package.
+	AlertsInverseTable = "agent_alerts"
+	// AlertsColumn is the table column denoting the alerts relation/edge.
+	AlertsColumn = "agent_alerts"
 )
 
 // Columns holds all SQL columns for agent fields.
@@ -68,6 +129,15 @@ var Columns = []string{
 	FieldVersion,
 	FieldStatus,
 	FieldLastSeen,
+	FieldTags,
+	FieldPublicKey,
+	FieldCertificate,
+	FieldCertificateSerial,
+	FieldCertificateExpiresAt,
+	FieldEnrolledAt,
+	FieldRevokedAt,
+	FieldRekeyRequested,
+	FieldInventoryAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -75,9 +145,16 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "agents"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"agent_enrollment_token_agent",
 	"tenant_agents",
 }
 
+var (
+	// GroupsPrimaryKey and GroupsColumn2 are the table columns denoting the
+	// primary key for the groups relation (M2M).
+	GroupsPrimaryKey = []string{"device_group_id", "agent_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -108,6 +185,8 @@ var (
 	VersionValidator func(string) error
 	// DefaultLastSeen holds the default value on creation for the "last_seen" field.
 	DefaultLastSeen func() time.Time
+	// DefaultRekeyRequested holds the default value on creation for the "rekey_requested" field.
+	DefaultRekeyRequested bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -191,6 +270,46 @@ func ByLastSeen(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastSeen, opts...).ToFunc()
 }
 
+// ByPublicKey orders the results by the public_key field.
+func ByPublicKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicKey, opts...).ToFunc()
+}
+
+// ByCertificate orders the results by the certificate field.
+func ByCertificate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificate, opts...).ToFunc()
+}
+
+// ByCertificateSerial orders the results by the certificate_serial field.
+func ByCertificateSerial(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateSerial, opts...).ToFunc()
+}
+
+// ByCertificateExpiresAt orders the results by the certificate_expires_at field.
+func ByCertificateExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateExpiresAt, opts...).ToFunc()
+}
+
+// ByEnrolledAt orders the results by the enrolled_at field.
+func ByEnrolledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrolledAt, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
+
+// ByRekeyRequested orders the results by the rekey_requested field.
+func ByRekeyRequested(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRekeyRequested, opts...).ToFunc()
+}
+
+// ByInventoryAt orders the results by the inventory_at field.
+func ByInventoryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInventoryAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -221,6 +340,69 @@ func ByJobExecutions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newJobExecutionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByEnrollmentTokenField orders the results by enrollment_token field.
+func ByEnrollmentTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnrollmentTokenStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGroupsCount orders the results by groups count.
+func ByGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupsStep(), opts...)
+	}
+}
+
+// ByGroups orders the results by groups terms.
+func ByGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInventoryCount orders the results by inventory count.
+func ByInventoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInventoryStep(), opts...)
+	}
+}
+
+// ByInventory orders the results by inventory terms.
+func ByInventory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInventoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySoftwareCount orders the results by software count.
+func BySoftwareCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSoftwareStep(), opts...)
+	}
+}
+
+// BySoftware orders the results by software terms.
+func BySoftware(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSoftwareStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAlertsCount orders the results by alerts count.
+func ByAlertsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAlertsStep(), opts...)
+	}
+}
+
+// ByAlerts orders the results by alerts terms.
+func ByAlerts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAlertsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -235,3 +417,38 @@ func newJobExecutionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, JobExecutionsTable, JobExecutionsColumn),
 	)
 }
+func newEnrollmentTokenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnrollmentTokenInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, EnrollmentTokenTable, EnrollmentTokenColumn),
+	)
+}
+func newGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, GroupsTable, GroupsPrimaryKey...),
+	)
+}
+func newInventoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InventoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InventoryTable, InventoryColumn),
+	)
+}
+func newSoftwareStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SoftwareInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SoftwareTable, SoftwareColumn),
+	)
+}
+func newAlertsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AlertsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AlertsTable, AlertsColumn),
+	)
+}
@@ -90,6 +90,46 @@ func LastSeen(v time.Time) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldLastSeen, v))
 }
 
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// Certificate applies equality check predicate on the "certificate" field. It's identical to CertificateEQ.
+func Certificate(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldCertificate, v))
+}
+
+// CertificateSerial applies equality check predicate on the "certificate_serial" field. It's identical to CertificateSerialEQ.
+func CertificateSerial(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldCertificateSerial, v))
+}
+
+// CertificateExpiresAt applies equality check predicate on the "certificate_expires_at" field. It's identical to CertificateExpiresAtEQ.
+func CertificateExpiresAt(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldCertificateExpiresAt, v))
+}
+
+// EnrolledAt applies equality check predicate on the "enrolled_at" field. It's identical to EnrolledAtEQ.
+func EnrolledAt(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldEnrolledAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RekeyRequested applies equality check predicate on the "rekey_requested" field. It's identical to RekeyRequestedEQ.
+func RekeyRequested(v bool) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldRekeyRequested, v))
+}
+
+// InventoryAt applies equality check predicate on the "inventory_at" field. It's identical to InventoryAtEQ.
+func InventoryAt(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldInventoryAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldCreatedAt, v))
@@ -550,6 +590,451 @@ func LastSeenLTE(v time.Time) predicate.Agent {
 	return predicate.Agent(sql.FieldLTE(FieldLastSeen, v))
 }
 
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldTags))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// PublicKeyContains applies the Contains predicate on the "public_key" field.
+func PublicKeyContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldPublicKey, v))
+}
+
+// PublicKeyHasPrefix applies the HasPrefix predicate on the "public_key" field.
+func PublicKeyHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldPublicKey, v))
+}
+
+// PublicKeyHasSuffix applies the HasSuffix predicate on the "public_key" field.
+func PublicKeyHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldPublicKey, v))
+}
+
+// PublicKeyIsNil applies the IsNil predicate on the "public_key" field.
+func PublicKeyIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldPublicKey))
+}
+
+// PublicKeyNotNil applies the NotNil predicate on the "public_key" field.
+func PublicKeyNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldPublicKey))
+}
+
+// PublicKeyEqualFold applies the EqualFold predicate on the "public_key" field.
+func PublicKeyEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldPublicKey, v))
+}
+
+// PublicKeyContainsFold applies the ContainsFold predicate on the "public_key" field.
+func PublicKeyContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldPublicKey, v))
+}
+
+// CertificateEQ applies the EQ predicate on the "certificate" field.
+func CertificateEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldCertificate, v))
+}
+
+// CertificateNEQ applies the NEQ predicate on the "certificate" field.
+func CertificateNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldCertificate, v))
+}
+
+// CertificateIn applies the In predicate on the "certificate" field.
+func CertificateIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldCertificate, vs...))
+}
+
+// CertificateNotIn applies the NotIn predicate on the "certificate" field.
+func CertificateNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldCertificate, vs...))
+}
+
+// CertificateGT applies the GT predicate on the "certificate" field.
+func CertificateGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldCertificate, v))
+}
+
+// CertificateGTE applies the GTE predicate on the "certificate" field.
+func CertificateGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldCertificate, v))
+}
+
+// CertificateLT applies the LT predicate on the "certificate" field.
+func CertificateLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldCertificate, v))
+}
+
+// CertificateLTE applies the LTE predicate on the "certificate" field.
+func CertificateLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldCertificate, v))
+}
+
+// CertificateContains applies the Contains predicate on the "certificate" field.
+func CertificateContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldCertificate, v))
+}
+
+// CertificateHasPrefix applies the HasPrefix predicate on the "certificate" field.
+func CertificateHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldCertificate, v))
+}
+
+// CertificateHasSuffix applies the HasSuffix predicate on the "certificate" field.
+func CertificateHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldCertificate, v))
+}
+
+// CertificateIsNil applies the IsNil predicate on the "certificate" field.
+func CertificateIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldCertificate))
+}
+
+// CertificateNotNil applies the NotNil predicate on the "certificate" field.
+func CertificateNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldCertificate))
+}
+
+// CertificateEqualFold applies the EqualFold predicate on the "certificate" field.
+func CertificateEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldCertificate, v))
+}
+
+// CertificateContainsFold applies the ContainsFold predicate on the "certificate" field.
+func CertificateContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldCertificate, v))
+}
+
+// CertificateSerialEQ applies the EQ predicate on the "certificate_serial" field.
+func CertificateSerialEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldCertificateSerial, v))
+}
+
+// CertificateSerialNEQ applies the NEQ predicate on the "certificate_serial" field.
+func CertificateSerialNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldCertificateSerial, v))
+}
+
+// CertificateSerialIn applies the In predicate on the "certificate_serial" field.
+func CertificateSerialIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldCertificateSerial, vs...))
+}
+
+// CertificateSerialNotIn applies the NotIn predicate on the "certificate_serial" field.
+func CertificateSerialNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldCertificateSerial, vs...))
+}
+
+// CertificateSerialGT applies the GT predicate on the "certificate_serial" field.
+func CertificateSerialGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldCertificateSerial, v))
+}
+
+// CertificateSerialGTE applies the GTE predicate on the "certificate_serial" field.
+func CertificateSerialGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldCertificateSerial, v))
+}
+
+// CertificateSerialLT applies the LT predicate on the "certificate_serial" field.
+func CertificateSerialLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldCertificateSerial, v))
+}
+
+// CertificateSerialLTE applies the LTE predicate on the "certificate_serial" field.
+func CertificateSerialLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldCertificateSerial, v))
+}
+
+// CertificateSerialContains applies the Contains predicate on the "certificate_serial" field.
+func CertificateSerialContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldCertificateSerial, v))
+}
+
+// CertificateSerialHasPrefix applies the HasPrefix predicate on the "certificate_serial" field.
+func CertificateSerialHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldCertificateSerial, v))
+}
+
+// CertificateSerialHasSuffix applies the HasSuffix predicate on the "certificate_serial" field.
+func CertificateSerialHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldCertificateSerial, v))
+}
+
+// CertificateSerialIsNil applies the IsNil predicate on the "certificate_serial" field.
+func CertificateSerialIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldCertificateSerial))
+}
+
+// CertificateSerialNotNil applies the NotNil predicate on the "certificate_serial" field.
+func CertificateSerialNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldCertificateSerial))
+}
+
+// CertificateSerialEqualFold applies the EqualFold predicate on the "certificate_serial" field.
+func CertificateSerialEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldCertificateSerial, v))
+}
+
+// CertificateSerialContainsFold applies the ContainsFold predicate on the "certificate_serial" field.
+func CertificateSerialContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldCertificateSerial, v))
+}
+
+// CertificateExpiresAtEQ applies the EQ predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtEQ(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldCertificateExpiresAt, v))
+}
+
+// CertificateExpiresAtNEQ applies the NEQ predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtNEQ(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldCertificateExpiresAt, v))
+}
+
+// CertificateExpiresAtIn applies the In predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtIn(vs ...time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldCertificateExpiresAt, vs...))
+}
+
+// CertificateExpiresAtNotIn applies the NotIn predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtNotIn(vs ...time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldCertificateExpiresAt, vs...))
+}
+
+// CertificateExpiresAtGT applies the GT predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtGT(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldCertificateExpiresAt, v))
+}
+
+// CertificateExpiresAtGTE applies the GTE predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtGTE(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldCertificateExpiresAt, v))
+}
+
+// CertificateExpiresAtLT applies the LT predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtLT(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldCertificateExpiresAt, v))
+}
+
+// CertificateExpiresAtLTE applies the LTE predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtLTE(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldCertificateExpiresAt, v))
+}
+
+// CertificateExpiresAtIsNil applies the IsNil predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldCertificateExpiresAt))
+}
+
+// CertificateExpiresAtNotNil applies the NotNil predicate on the "certificate_expires_at" field.
+func CertificateExpiresAtNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldCertificateExpiresAt))
+}
+
+// EnrolledAtEQ applies the EQ predicate on the "enrolled_at" field.
+func EnrolledAtEQ(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldEnrolledAt, v))
+}
+
+// EnrolledAtNEQ applies the NEQ predicate on the "enrolled_at" field.
+func EnrolledAtNEQ(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldEnrolledAt, v))
+}
+
+// EnrolledAtIn applies the In predicate on the "enrolled_at" field.
+func EnrolledAtIn(vs ...time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldEnrolledAt, vs...))
+}
+
+// EnrolledAtNotIn applies the NotIn predicate on the "enrolled_at" field.
+func EnrolledAtNotIn(vs ...time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldEnrolledAt, vs...))
+}
+
+// EnrolledAtGT applies the GT predicate on the "enrolled_at" field.
+func EnrolledAtGT(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldEnrolledAt, v))
+}
+
+// EnrolledAtGTE applies the GTE predicate on the "enrolled_at" field.
+func EnrolledAtGTE(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldEnrolledAt, v))
+}
+
+// EnrolledAtLT applies the LT predicate on the "enrolled_at" field.
+func EnrolledAtLT(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldEnrolledAt, v))
+}
+
+// EnrolledAtLTE applies the LTE predicate on the "enrolled_at" field.
+func EnrolledAtLTE(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldEnrolledAt, v))
+}
+
+// EnrolledAtIsNil applies the IsNil predicate on the "enrolled_at" field.
+func EnrolledAtIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldEnrolledAt))
+}
+
+// EnrolledAtNotNil applies the NotNil predicate on the "enrolled_at" field.
+func EnrolledAtNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldEnrolledAt))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// RekeyRequestedEQ applies the EQ predicate on the "rekey_requested" field.
+func RekeyRequestedEQ(v bool) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldRekeyRequested, v))
+}
+
+// RekeyRequestedNEQ applies the NEQ predicate on the "rekey_requested" field.
+func RekeyRequestedNEQ(v bool) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldRekeyRequested, v))
+}
+
+// InventoryAtEQ applies the EQ predicate on the "inventory_at" field.
+func InventoryAtEQ(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldInventoryAt, v))
+}
+
+// InventoryAtNEQ applies the NEQ predicate on the "inventory_at" field.
+func InventoryAtNEQ(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldInventoryAt, v))
+}
+
+// InventoryAtIn applies the In predicate on the "inventory_at" field.
+func InventoryAtIn(vs ...time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldInventoryAt, vs...))
+}
+
+// InventoryAtNotIn applies the NotIn predicate on the "inventory_at" field.
+func InventoryAtNotIn(vs ...time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldInventoryAt, vs...))
+}
+
+// InventoryAtGT applies the GT predicate on the "inventory_at" field.
+func InventoryAtGT(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldInventoryAt, v))
+}
+
+// InventoryAtGTE applies the GTE predicate on the "inventory_at" field.
+func InventoryAtGTE(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldInventoryAt, v))
+}
+
+// InventoryAtLT applies the LT predicate on the "inventory_at" field.
+func InventoryAtLT(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldInventoryAt, v))
+}
+
+// InventoryAtLTE applies the LTE predicate on the "inventory_at" field.
+func InventoryAtLTE(v time.Time) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldInventoryAt, v))
+}
+
+// InventoryAtIsNil applies the IsNil predicate on the "inventory_at" field.
+func InventoryAtIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldInventoryAt))
+}
+
+// InventoryAtNotNil applies the NotNil predicate on the "inventory_at" field.
+func InventoryAtNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldInventoryAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldCreatedAt, v))
@@ -676,6 +1161,121 @@ func HasJobExecutionsWith(preds ...predicate.JobExecution) predicate.Agent {
 	})
 }
 
+// HasEnrollmentToken applies the HasEdge predicate on the "enrollment_token" edge.
+func HasEnrollmentToken() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, EnrollmentTokenTable, EnrollmentTokenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnrollmentTokenWith applies the HasEdge predicate on the "enrollment_token" edge with a given conditions (other predicates).
+func HasEnrollmentTokenWith(preds ...predicate.AgentEnrollmentToken) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newEnrollmentTokenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroups applies the HasEdge predicate on the "groups" edge.
+func HasGroups() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, GroupsTable, GroupsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupsWith applies the HasEdge predicate on the "groups" edge with a given conditions (other predicates).
+func HasGroupsWith(preds ...predicate.DeviceGroup) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInventory applies the HasEdge predicate on the "inventory" edge.
+func HasInventory() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InventoryTable, InventoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInventoryWith applies the HasEdge predicate on the "inventory" edge with a given conditions (other predicates).
+func HasInventoryWith(preds ...predicate.AgentInventory) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newInventoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSoftware applies the HasEdge predicate on the "software" edge.
+func HasSoftware() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SoftwareTable, SoftwareColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSoftwareWith applies the HasEdge predicate on the "software" edge with a given conditions (other predicates).
+func HasSoftwareWith(preds ...predicate.AgentSoftware) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newSoftwareStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAlerts applies the HasEdge predicate on the "alerts" edge.
+func HasAlerts() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AlertsTable, AlertsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAlertsWith applies the HasEdge predicate on the "alerts" edge with a given conditions (other predicates).
+func HasAlertsWith(preds ...predicate.AgentAlert) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newAlertsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Agent) predicate.Agent {
 	return predicate.Agent(sql.AndPredicates(predicates...))
@@ -7,6 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/agentinventory"
+	"sent/ent/agentsoftware"
+	"sent/ent/devicegroup"
 	"sent/ent/jobexecution"
 	"sent/ent/tenant"
 	"time"
@@ -86,6 +91,124 @@ func (_c *AgentCreate) SetNillableLastSeen(v *time.Time) *AgentCreate {
 	return _c
 }
 
+// SetTags sets the "tags" field.
+func (_c *AgentCreate) SetTags(v []string) *AgentCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *AgentCreate) SetPublicKey(v string) *AgentCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (_c *AgentCreate) SetNillablePublicKey(v *string) *AgentCreate {
+	if v != nil {
+		_c.SetPublicKey(*v)
+	}
+	return _c
+}
+
+// SetCertificate sets the "certificate" field.
+func (_c *AgentCreate) SetCertificate(v string) *AgentCreate {
+	_c.mutation.SetCertificate(v)
+	return _c
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_c *AgentCreate) SetNillableCertificate(v *string) *AgentCreate {
+	if v != nil {
+		_c.SetCertificate(*v)
+	}
+	return _c
+}
+
+// SetCertificateSerial sets the "certificate_serial" field.
+func (_c *AgentCreate) SetCertificateSerial(v string) *AgentCreate {
+	_c.mutation.SetCertificateSerial(v)
+	return _c
+}
+
+// SetNillableCertificateSerial sets the "certificate_serial" field if the given value is not nil.
+func (_c *AgentCreate) SetNillableCertificateSerial(v *string) *AgentCreate {
+	if v != nil {
+		_c.SetCertificateSerial(*v)
+	}
+	return _c
+}
+
+// SetCertificateExpiresAt sets the "certificate_expires_at" field.
+func (_c *AgentCreate) SetCertificateExpiresAt(v time.Time) *AgentCreate {
+	_c.mutation.SetCertificateExpiresAt(v)
+	return _c
+}
+
+// SetNillableCertificateExpiresAt sets the "certificate_expires_at" field if the given value is not nil.
+func (_c *AgentCreate) SetNillableCertificateExpiresAt(v *time.Time) *AgentCreate {
+	if v != nil {
+		_c.SetCertificateExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetEnrolledAt sets the "enrolled_at" field.
+func (_c *AgentCreate) SetEnrolledAt(v time.Time) *AgentCreate {
+	_c.mutation.SetEnrolledAt(v)
+	return _c
+}
+
+// SetNillableEnrolledAt sets the "enrolled_at" field if the given value is not nil.
+func (_c *AgentCreate) SetNillableEnrolledAt(v *time.Time) *AgentCreate {
+	if v != nil {
+		_c.SetEnrolledAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *AgentCreate) SetRevokedAt(v time.Time) *AgentCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *AgentCreate) SetNillableRevokedAt(v *time.Time) *AgentCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetRekeyRequested sets the "rekey_requested" field.
+func (_c *AgentCreate) SetRekeyRequested(v bool) *AgentCreate {
+	_c.mutation.SetRekeyRequested(v)
+	return _c
+}
+
+// SetNillableRekeyRequested sets the "rekey_requested" field if the given value is not nil.
+func (_c *AgentCreate) SetNillableRekeyRequested(v *bool) *AgentCreate {
+	if v != nil {
+		_c.SetRekeyRequested(*v)
+	}
+	return _c
+}
+
+// SetInventoryAt sets the "inventory_at" field.
+func (_c *AgentCreate) SetInventoryAt(v time.Time) *AgentCreate {
+	_c.mutation.SetInventoryAt(v)
+	return _c
+}
+
+// SetNillableInventoryAt sets the "inventory_at" field if the given value is not nil.
+func (_c *AgentCreate) SetNillableInventoryAt(v *time.Time) *AgentCreate {
+	if v != nil {
+		_c.SetInventoryAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AgentCreate) SetCreatedAt(v time.Time) *AgentCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -148,6 +271,85 @@ func (_c *AgentCreate) AddJobExecutions(v ...*JobExecution) *AgentCreate {
 	return _c.AddJobExecutionIDs(ids...)
 }
 
+// SetEnrollmentTokenID sets the "enrollment_token" edge to the AgentEnrollmentToken entity by ID.
+func (_c *AgentCreate) SetEnrollmentTokenID(id int) *AgentCreate {
+	_c.mutation.SetEnrollmentTokenID(id)
+	return _c
+}
+
+// SetNillableEnrollmentTokenID sets the "enrollment_token" edge to the AgentEnrollmentToken entity by ID if the given value is not nil.
+func (_c *AgentCreate) SetNillableEnrollmentTokenID(id *int) *AgentCreate {
+	if id != nil {
+		_c = _c.SetEnrollmentTokenID(*id)
+	}
+	return _c
+}
+
+// SetEnrollmentToken sets the "enrollment_token" edge to the AgentEnrollmentToken entity.
+func (_c *AgentCreate) SetEnrollmentToken(v *AgentEnrollmentToken) *AgentCreate {
+	return _c.SetEnrollmentTokenID(v.ID)
+}
+
+// AddGroupIDs adds the "groups" edge to the DeviceGroup entity by IDs.
+func (_c *AgentCreate) AddGroupIDs(ids ...int) *AgentCreate {
+	_c.mutation.AddGroupIDs(ids...)
+	return _c
+}
+
+// AddGroups adds the "groups" edges to the DeviceGroup entity.
+func (_c *AgentCreate) AddGroups(v ...*DeviceGroup) *AgentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGroupIDs(ids...)
+}
+
+// AddInventoryIDs adds the "inventory" edge to the AgentInventory entity by IDs.
+func (_c *AgentCreate) AddInventoryIDs(ids ...int) *AgentCreate {
+	_c.mutation.AddInventoryIDs(ids...)
+	return _c
+}
+
+// AddInventory adds the "inventory" edges to the AgentInventory entity.
+func (_c *AgentCreate) AddInventory(v ...*AgentInventory) *AgentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInventoryIDs(ids...)
+}
+
+// AddSoftwareIDs adds the "software" edge to the AgentSoftware entity by IDs.
+func (_c *AgentCreate) AddSoftwareIDs(ids ...int) *AgentCreate {
+	_c.mutation.AddSoftwareIDs(ids...)
+	return _c
+}
+
+// AddSoftware adds the "software" edges to the AgentSoftware entity.
+func (_c *AgentCreate) AddSoftware(v ...*AgentSoftware) *AgentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSoftwareIDs(ids...)
+}
+
+// AddAlertIDs adds the "alerts" edge to the AgentAlert entity by IDs.
+func (_c *AgentCreate) AddAlertIDs(ids ...int) *AgentCreate {
+	_c.mutation.AddAlertIDs(ids...)
+	return _c
+}
+
+// AddAlerts adds the "alerts" edges to the AgentAlert entity.
+func (_c *AgentCreate) AddAlerts(v ...*AgentAlert) *AgentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAlertIDs(ids...)
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (_c *AgentCreate) Mutation() *AgentMutation {
 	return _c.mutation
@@ -191,6 +393,10 @@ func (_c *AgentCreate) defaults() {
 		v := agent.DefaultLastSeen()
 		_c.mutation.SetLastSeen(v)
 	}
+	if _, ok := _c.mutation.RekeyRequested(); !ok {
+		v := agent.DefaultRekeyRequested
+		_c.mutation.SetRekeyRequested(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := agent.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -262,6 +468,9 @@ func (_c *AgentCreate) check() error {
 	if _, ok := _c.mutation.LastSeen(); !ok {
 		return &ValidationError{Name: "last_seen", err: errors.New(`ent: missing required field "Agent.last_seen"`)}
 	}
+	if _, ok := _c.mutation.RekeyRequested(); !ok {
+		return &ValidationError{Name: "rekey_requested", err: errors.New(`ent: missing required field "Agent.rekey_requested"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Agent.created_at"`)}
 	}
@@ -326,6 +535,42 @@ func (_c *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_spec.SetField(agent.FieldLastSeen, field.TypeTime, value)
 		_node.LastSeen = value
 	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(agent.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(agent.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.Certificate(); ok {
+		_spec.SetField(agent.FieldCertificate, field.TypeString, value)
+		_node.Certificate = value
+	}
+	if value, ok := _c.mutation.CertificateSerial(); ok {
+		_spec.SetField(agent.FieldCertificateSerial, field.TypeString, value)
+		_node.CertificateSerial = value
+	}
+	if value, ok := _c.mutation.CertificateExpiresAt(); ok {
+		_spec.SetField(agent.FieldCertificateExpiresAt, field.TypeTime, value)
+		_node.CertificateExpiresAt = &value
+	}
+	if value, ok := _c.mutation.EnrolledAt(); ok {
+		_spec.SetField(agent.FieldEnrolledAt, field.TypeTime, value)
+		_node.EnrolledAt = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(agent.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.RekeyRequested(); ok {
+		_spec.SetField(agent.FieldRekeyRequested, field.TypeBool, value)
+		_node.RekeyRequested = value
+	}
+	if value, ok := _c.mutation.InventoryAt(); ok {
+		_spec.SetField(agent.FieldInventoryAt, field.TypeTime, value)
+		_node.InventoryAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(agent.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -367,6 +612,87 @@ func (_c *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.EnrollmentTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agent.EnrollmentTokenTable,
+			Columns: []string{agent.EnrollmentTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.agent_enrollment_token_agent = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agent.GroupsTable,
+			Columns: agent.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InventoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.InventoryTable,
+			Columns: []string{agent.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SoftwareIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.SoftwareTable,
+			Columns: []string{agent.SoftwareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.AlertsTable,
+			Columns: []string{agent.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
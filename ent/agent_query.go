@@ -8,6 +8,11 @@ import (
 	"fmt"
 	"math"
 	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/agentinventory"
+	"sent/ent/agentsoftware"
+	"sent/ent/devicegroup"
 	"sent/ent/jobexecution"
 	"sent/ent/predicate"
 	"sent/ent/tenant"
@@ -21,14 +26,19 @@ import (
 // AgentQuery is the builder for querying Agent entities.
 type AgentQuery struct {
 	config
-	ctx               *QueryContext
-	order             []agent.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Agent
-	withTenant        *TenantQuery
-	withJobExecutions *JobExecutionQuery
-	withFKs           bool
-	modifiers         []func(*sql.Selector)
+	ctx                 *QueryContext
+	order               []agent.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Agent
+	withTenant          *TenantQuery
+	withJobExecutions   *JobExecutionQuery
+	withEnrollmentToken *AgentEnrollmentTokenQuery
+	withGroups          *DeviceGroupQuery
+	withInventory       *AgentInventoryQuery
+	withSoftware        *AgentSoftwareQuery
+	withAlerts          *AgentAlertQuery
+	withFKs             bool
+	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,6 +119,116 @@ func (_q *AgentQuery) QueryJobExecutions() *JobExecutionQuery {
 	return query
 }
 
+// QueryEnrollmentToken chains the current query on the "enrollment_token" edge.
+func (_q *AgentQuery) QueryEnrollmentToken() *AgentEnrollmentTokenQuery {
+	query := (&AgentEnrollmentTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(agentenrollmenttoken.Table, agentenrollmenttoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, agent.EnrollmentTokenTable, agent.EnrollmentTokenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroups chains the current query on the "groups" edge.
+func (_q *AgentQuery) QueryGroups() *DeviceGroupQuery {
+	query := (&DeviceGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(devicegroup.Table, devicegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, agent.GroupsTable, agent.GroupsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInventory chains the current query on the "inventory" edge.
+func (_q *AgentQuery) QueryInventory() *AgentInventoryQuery {
+	query := (&AgentInventoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(agentinventory.Table, agentinventory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, agent.InventoryTable, agent.InventoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySoftware chains the current query on the "software" edge.
+func (_q *AgentQuery) QuerySoftware() *AgentSoftwareQuery {
+	query := (&AgentSoftwareClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(agentsoftware.Table, agentsoftware.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, agent.SoftwareTable, agent.SoftwareColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAlerts chains the current query on the "alerts" edge.
+func (_q *AgentQuery) QueryAlerts() *AgentAlertQuery {
+	query := (&AgentAlertClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(agentalert.Table, agentalert.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, agent.AlertsTable, agent.AlertsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Agent entity from the query.
 // Returns a *NotFoundError when no Agent was found.
 func (_q *AgentQuery) First(ctx context.Context) (*Agent, error) {
@@ -296,13 +416,18 @@ func (_q *AgentQuery) Clone() *AgentQuery {
 		return nil
 	}
 	return &AgentQuery{
-		config:            _q.config,
-		ctx:               _q.ctx.Clone(),
-		order:             append([]agent.OrderOption{}, _q.order...),
-		inters:            append([]Interceptor{}, _q.inters...),
-		predicates:        append([]predicate.Agent{}, _q.predicates...),
-		withTenant:        _q.withTenant.Clone(),
-		withJobExecutions: _q.withJobExecutions.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]agent.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Agent{}, _q.predicates...),
+		withTenant:          _q.withTenant.Clone(),
+		withJobExecutions:   _q.withJobExecutions.Clone(),
+		withEnrollmentToken: _q.withEnrollmentToken.Clone(),
+		withGroups:          _q.withGroups.Clone(),
+		withInventory:       _q.withInventory.Clone(),
+		withSoftware:        _q.withSoftware.Clone(),
+		withAlerts:          _q.withAlerts.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -332,6 +457,61 @@ func (_q *AgentQuery) WithJobExecutions(opts ...func(*JobExecutionQuery)) *Agent
 	return _q
 }
 
+// WithEnrollmentToken tells the query-builder to eager-load the nodes that are connected to
+// the "enrollment_token" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AgentQuery) WithEnrollmentToken(opts ...func(*AgentEnrollmentTokenQuery)) *AgentQuery {
+	query := (&AgentEnrollmentTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnrollmentToken = query
+	return _q
+}
+
+// WithGroups tells the query-builder to eager-load the nodes that are connected to
+// the "groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AgentQuery) WithGroups(opts ...func(*DeviceGroupQuery)) *AgentQuery {
+	query := (&DeviceGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroups = query
+	return _q
+}
+
+// WithInventory tells the query-builder to eager-load the nodes that are connected to
+// the "inventory" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AgentQuery) WithInventory(opts ...func(*AgentInventoryQuery)) *AgentQuery {
+	query := (&AgentInventoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInventory = query
+	return _q
+}
+
+// WithSoftware tells the query-builder to eager-load the nodes that are connected to
+// the "software" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AgentQuery) WithSoftware(opts ...func(*AgentSoftwareQuery)) *AgentQuery {
+	query := (&AgentSoftwareClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSoftware = query
+	return _q
+}
+
+// WithAlerts tells the query-builder to eager-load the nodes that are connected to
+// the "alerts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AgentQuery) WithAlerts(opts ...func(*AgentAlertQuery)) *AgentQuery {
+	query := (&AgentAlertClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAlerts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -411,12 +591,17 @@ func (_q *AgentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Agent,
 		nodes       = []*Agent{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [7]bool{
 			_q.withTenant != nil,
 			_q.withJobExecutions != nil,
+			_q.withEnrollmentToken != nil,
+			_q.withGroups != nil,
+			_q.withInventory != nil,
+			_q.withSoftware != nil,
+			_q.withAlerts != nil,
 		}
 	)
-	if _q.withTenant != nil {
+	if _q.withTenant != nil || _q.withEnrollmentToken != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -456,6 +641,40 @@ func (_q *AgentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Agent,
 			return nil, err
 		}
 	}
+	if query := _q.withEnrollmentToken; query != nil {
+		if err := _q.loadEnrollmentToken(ctx, query, nodes, nil,
+			func(n *Agent, e *AgentEnrollmentToken) { n.Edges.EnrollmentToken = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGroups; query != nil {
+		if err := _q.loadGroups(ctx, query, nodes,
+			func(n *Agent) { n.Edges.Groups = []*DeviceGroup{} },
+			func(n *Agent, e *DeviceGroup) { n.Edges.Groups = append(n.Edges.Groups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInventory; query != nil {
+		if err := _q.loadInventory(ctx, query, nodes,
+			func(n *Agent) { n.Edges.Inventory = []*AgentInventory{} },
+			func(n *Agent, e *AgentInventory) { n.Edges.Inventory = append(n.Edges.Inventory, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSoftware; query != nil {
+		if err := _q.loadSoftware(ctx, query, nodes,
+			func(n *Agent) { n.Edges.Software = []*AgentSoftware{} },
+			func(n *Agent, e *AgentSoftware) { n.Edges.Software = append(n.Edges.Software, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAlerts; query != nil {
+		if err := _q.loadAlerts(ctx, query, nodes,
+			func(n *Agent) { n.Edges.Alerts = []*AgentAlert{} },
+			func(n *Agent, e *AgentAlert) { n.Edges.Alerts = append(n.Edges.Alerts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -522,6 +741,192 @@ func (_q *AgentQuery) loadJobExecutions(ctx context.Context, query *JobExecution
 	}
 	return nil
 }
+func (_q *AgentQuery) loadEnrollmentToken(ctx context.Context, query *AgentEnrollmentTokenQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *AgentEnrollmentToken)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Agent)
+	for i := range nodes {
+		if nodes[i].agent_enrollment_token_agent == nil {
+			continue
+		}
+		fk := *nodes[i].agent_enrollment_token_agent
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(agentenrollmenttoken.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "agent_enrollment_token_agent" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *AgentQuery) loadGroups(ctx context.Context, query *DeviceGroupQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *DeviceGroup)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Agent)
+	nids := make(map[int]map[*Agent]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(agent.GroupsTable)
+		s.Join(joinT).On(s.C(devicegroup.FieldID), joinT.C(agent.GroupsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(agent.GroupsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(agent.GroupsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Agent]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DeviceGroup](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "groups" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *AgentQuery) loadInventory(ctx context.Context, query *AgentInventoryQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *AgentInventory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Agent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AgentInventory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(agent.InventoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.agent_inventory
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "agent_inventory" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "agent_inventory" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AgentQuery) loadSoftware(ctx context.Context, query *AgentSoftwareQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *AgentSoftware)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Agent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AgentSoftware(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(agent.SoftwareColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.agent_software
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "agent_software" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "agent_software" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AgentQuery) loadAlerts(ctx context.Context, query *AgentAlertQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *AgentAlert)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Agent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AgentAlert(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(agent.AlertsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.agent_alerts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "agent_alerts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "agent_alerts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AgentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
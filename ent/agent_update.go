@@ -7,6 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/agentinventory"
+	"sent/ent/agentsoftware"
+	"sent/ent/devicegroup"
 	"sent/ent/jobexecution"
 	"sent/ent/predicate"
 	"sent/ent/tenant"
@@ -14,6 +19,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -143,6 +149,178 @@ func (_u *AgentUpdate) SetNillableLastSeen(v *time.Time) *AgentUpdate {
 	return _u
 }
 
+// SetTags sets the "tags" field.
+func (_u *AgentUpdate) SetTags(v []string) *AgentUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *AgentUpdate) AppendTags(v []string) *AgentUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *AgentUpdate) ClearTags() *AgentUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *AgentUpdate) SetPublicKey(v string) *AgentUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (_u *AgentUpdate) SetNillablePublicKey(v *string) *AgentUpdate {
+	if v != nil {
+		_u.SetPublicKey(*v)
+	}
+	return _u
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (_u *AgentUpdate) ClearPublicKey() *AgentUpdate {
+	_u.mutation.ClearPublicKey()
+	return _u
+}
+
+// SetCertificate sets the "certificate" field.
+func (_u *AgentUpdate) SetCertificate(v string) *AgentUpdate {
+	_u.mutation.SetCertificate(v)
+	return _u
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_u *AgentUpdate) SetNillableCertificate(v *string) *AgentUpdate {
+	if v != nil {
+		_u.SetCertificate(*v)
+	}
+	return _u
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (_u *AgentUpdate) ClearCertificate() *AgentUpdate {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// SetCertificateSerial sets the "certificate_serial" field.
+func (_u *AgentUpdate) SetCertificateSerial(v string) *AgentUpdate {
+	_u.mutation.SetCertificateSerial(v)
+	return _u
+}
+
+// SetNillableCertificateSerial sets the "certificate_serial" field if the given value is not nil.
+func (_u *AgentUpdate) SetNillableCertificateSerial(v *string) *AgentUpdate {
+	if v != nil {
+		_u.SetCertificateSerial(*v)
+	}
+	return _u
+}
+
+// ClearCertificateSerial clears the value of the "certificate_serial" field.
+func (_u *AgentUpdate) ClearCertificateSerial() *AgentUpdate {
+	_u.mutation.ClearCertificateSerial()
+	return _u
+}
+
+// SetCertificateExpiresAt sets the "certificate_expires_at" field.
+func (_u *AgentUpdate) SetCertificateExpiresAt(v time.Time) *AgentUpdate {
+	_u.mutation.SetCertificateExpiresAt(v)
+	return _u
+}
+
+// SetNillableCertificateExpiresAt sets the "certificate_expires_at" field if the given value is not nil.
+func (_u *AgentUpdate) SetNillableCertificateExpiresAt(v *time.Time) *AgentUpdate {
+	if v != nil {
+		_u.SetCertificateExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearCertificateExpiresAt clears the value of the "certificate_expires_at" field.
+func (_u *AgentUpdate) ClearCertificateExpiresAt() *AgentUpdate {
+	_u.mutation.ClearCertificateExpiresAt()
+	return _u
+}
+
+// SetEnrolledAt sets the "enrolled_at" field.
+func (_u *AgentUpdate) SetEnrolledAt(v time.Time) *AgentUpdate {
+	_u.mutation.SetEnrolledAt(v)
+	return _u
+}
+
+// SetNillableEnrolledAt sets the "enrolled_at" field if the given value is not nil.
+func (_u *AgentUpdate) SetNillableEnrolledAt(v *time.Time) *AgentUpdate {
+	if v != nil {
+		_u.SetEnrolledAt(*v)
+	}
+	return _u
+}
+
+// ClearEnrolledAt clears the value of the "enrolled_at" field.
+func (_u *AgentUpdate) ClearEnrolledAt() *AgentUpdate {
+	_u.mutation.ClearEnrolledAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *AgentUpdate) SetRevokedAt(v time.Time) *AgentUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *AgentUpdate) SetNillableRevokedAt(v *time.Time) *AgentUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *AgentUpdate) ClearRevokedAt() *AgentUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRekeyRequested sets the "rekey_requested" field.
+func (_u *AgentUpdate) SetRekeyRequested(v bool) *AgentUpdate {
+	_u.mutation.SetRekeyRequested(v)
+	return _u
+}
+
+// SetNillableRekeyRequested sets the "rekey_requested" field if the given value is not nil.
+func (_u *AgentUpdate) SetNillableRekeyRequested(v *bool) *AgentUpdate {
+	if v != nil {
+		_u.SetRekeyRequested(*v)
+	}
+	return _u
+}
+
+// SetInventoryAt sets the "inventory_at" field.
+func (_u *AgentUpdate) SetInventoryAt(v time.Time) *AgentUpdate {
+	_u.mutation.SetInventoryAt(v)
+	return _u
+}
+
+// SetNillableInventoryAt sets the "inventory_at" field if the given value is not nil.
+func (_u *AgentUpdate) SetNillableInventoryAt(v *time.Time) *AgentUpdate {
+	if v != nil {
+		_u.SetInventoryAt(*v)
+	}
+	return _u
+}
+
+// ClearInventoryAt clears the value of the "inventory_at" field.
+func (_u *AgentUpdate) ClearInventoryAt() *AgentUpdate {
+	_u.mutation.ClearInventoryAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *AgentUpdate) SetUpdatedAt(v time.Time) *AgentUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -183,6 +361,85 @@ func (_u *AgentUpdate) AddJobExecutions(v ...*JobExecution) *AgentUpdate {
 	return _u.AddJobExecutionIDs(ids...)
 }
 
+// SetEnrollmentTokenID sets the "enrollment_token" edge to the AgentEnrollmentToken entity by ID.
+func (_u *AgentUpdate) SetEnrollmentTokenID(id int) *AgentUpdate {
+	_u.mutation.SetEnrollmentTokenID(id)
+	return _u
+}
+
+// SetNillableEnrollmentTokenID sets the "enrollment_token" edge to the AgentEnrollmentToken entity by ID if the given value is not nil.
+func (_u *AgentUpdate) SetNillableEnrollmentTokenID(id *int) *AgentUpdate {
+	if id != nil {
+		_u = _u.SetEnrollmentTokenID(*id)
+	}
+	return _u
+}
+
+// SetEnrollmentToken sets the "enrollment_token" edge to the AgentEnrollmentToken entity.
+func (_u *AgentUpdate) SetEnrollmentToken(v *AgentEnrollmentToken) *AgentUpdate {
+	return _u.SetEnrollmentTokenID(v.ID)
+}
+
+// AddGroupIDs adds the "groups" edge to the DeviceGroup entity by IDs.
+func (_u *AgentUpdate) AddGroupIDs(ids ...int) *AgentUpdate {
+	_u.mutation.AddGroupIDs(ids...)
+	return _u
+}
+
+// AddGroups adds the "groups" edges to the DeviceGroup entity.
+func (_u *AgentUpdate) AddGroups(v ...*DeviceGroup) *AgentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGroupIDs(ids...)
+}
+
+// AddInventoryIDs adds the "inventory" edge to the AgentInventory entity by IDs.
+func (_u *AgentUpdate) AddInventoryIDs(ids ...int) *AgentUpdate {
+	_u.mutation.AddInventoryIDs(ids...)
+	return _u
+}
+
+// AddInventory adds the "inventory" edges to the AgentInventory entity.
+func (_u *AgentUpdate) AddInventory(v ...*AgentInventory) *AgentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInventoryIDs(ids...)
+}
+
+// AddSoftwareIDs adds the "software" edge to the AgentSoftware entity by IDs.
+func (_u *AgentUpdate) AddSoftwareIDs(ids ...int) *AgentUpdate {
+	_u.mutation.AddSoftwareIDs(ids...)
+	return _u
+}
+
+// AddSoftware adds the "software" edges to the AgentSoftware entity.
+func (_u *AgentUpdate) AddSoftware(v ...*AgentSoftware) *AgentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSoftwareIDs(ids...)
+}
+
+// AddAlertIDs adds the "alerts" edge to the AgentAlert entity by IDs.
+func (_u *AgentUpdate) AddAlertIDs(ids ...int) *AgentUpdate {
+	_u.mutation.AddAlertIDs(ids...)
+	return _u
+}
+
+// AddAlerts adds the "alerts" edges to the AgentAlert entity.
+func (_u *AgentUpdate) AddAlerts(v ...*AgentAlert) *AgentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAlertIDs(ids...)
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (_u *AgentUpdate) Mutation() *AgentMutation {
 	return _u.mutation
@@ -215,6 +472,96 @@ func (_u *AgentUpdate) RemoveJobExecutions(v ...*JobExecution) *AgentUpdate {
 	return _u.RemoveJobExecutionIDs(ids...)
 }
 
+// ClearEnrollmentToken clears the "enrollment_token" edge to the AgentEnrollmentToken entity.
+func (_u *AgentUpdate) ClearEnrollmentToken() *AgentUpdate {
+	_u.mutation.ClearEnrollmentToken()
+	return _u
+}
+
+// ClearGroups clears all "groups" edges to the DeviceGroup entity.
+func (_u *AgentUpdate) ClearGroups() *AgentUpdate {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// RemoveGroupIDs removes the "groups" edge to DeviceGroup entities by IDs.
+func (_u *AgentUpdate) RemoveGroupIDs(ids ...int) *AgentUpdate {
+	_u.mutation.RemoveGroupIDs(ids...)
+	return _u
+}
+
+// RemoveGroups removes "groups" edges to DeviceGroup entities.
+func (_u *AgentUpdate) RemoveGroups(v ...*DeviceGroup) *AgentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGroupIDs(ids...)
+}
+
+// ClearInventory clears all "inventory" edges to the AgentInventory entity.
+func (_u *AgentUpdate) ClearInventory() *AgentUpdate {
+	_u.mutation.ClearInventory()
+	return _u
+}
+
+// RemoveInventoryIDs removes the "inventory" edge to AgentInventory entities by IDs.
+func (_u *AgentUpdate) RemoveInventoryIDs(ids ...int) *AgentUpdate {
+	_u.mutation.RemoveInventoryIDs(ids...)
+	return _u
+}
+
+// RemoveInventory removes "inventory" edges to AgentInventory entities.
+func (_u *AgentUpdate) RemoveInventory(v ...*AgentInventory) *AgentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInventoryIDs(ids...)
+}
+
+// ClearSoftware clears all "software" edges to the AgentSoftware entity.
+func (_u *AgentUpdate) ClearSoftware() *AgentUpdate {
+	_u.mutation.ClearSoftware()
+	return _u
+}
+
+// RemoveSoftwareIDs removes the "software" edge to AgentSoftware entities by IDs.
+func (_u *AgentUpdate) RemoveSoftwareIDs(ids ...int) *AgentUpdate {
+	_u.mutation.RemoveSoftwareIDs(ids...)
+	return _u
+}
+
+// RemoveSoftware removes "software" edges to AgentSoftware entities.
+func (_u *AgentUpdate) RemoveSoftware(v ...*AgentSoftware) *AgentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSoftwareIDs(ids...)
+}
+
+// ClearAlerts clears all "alerts" edges to the AgentAlert entity.
+func (_u *AgentUpdate) ClearAlerts() *AgentUpdate {
+	_u.mutation.ClearAlerts()
+	return _u
+}
+
+// RemoveAlertIDs removes the "alerts" edge to AgentAlert entities by IDs.
+func (_u *AgentUpdate) RemoveAlertIDs(ids ...int) *AgentUpdate {
+	_u.mutation.RemoveAlertIDs(ids...)
+	return _u
+}
+
+// RemoveAlerts removes "alerts" edges to AgentAlert entities.
+func (_u *AgentUpdate) RemoveAlerts(v ...*AgentAlert) *AgentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAlertIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AgentUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -333,6 +680,62 @@ func (_u *AgentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.LastSeen(); ok {
 		_spec.SetField(agent.FieldLastSeen, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(agent.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(agent.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(agent.FieldPublicKey, field.TypeString, value)
+	}
+	if _u.mutation.PublicKeyCleared() {
+		_spec.ClearField(agent.FieldPublicKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Certificate(); ok {
+		_spec.SetField(agent.FieldCertificate, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCleared() {
+		_spec.ClearField(agent.FieldCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.CertificateSerial(); ok {
+		_spec.SetField(agent.FieldCertificateSerial, field.TypeString, value)
+	}
+	if _u.mutation.CertificateSerialCleared() {
+		_spec.ClearField(agent.FieldCertificateSerial, field.TypeString)
+	}
+	if value, ok := _u.mutation.CertificateExpiresAt(); ok {
+		_spec.SetField(agent.FieldCertificateExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.CertificateExpiresAtCleared() {
+		_spec.ClearField(agent.FieldCertificateExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EnrolledAt(); ok {
+		_spec.SetField(agent.FieldEnrolledAt, field.TypeTime, value)
+	}
+	if _u.mutation.EnrolledAtCleared() {
+		_spec.ClearField(agent.FieldEnrolledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(agent.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(agent.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RekeyRequested(); ok {
+		_spec.SetField(agent.FieldRekeyRequested, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.InventoryAt(); ok {
+		_spec.SetField(agent.FieldInventoryAt, field.TypeTime, value)
+	}
+	if _u.mutation.InventoryAtCleared() {
+		_spec.ClearField(agent.FieldInventoryAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agent.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -410,6 +813,215 @@ func (_u *AgentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EnrollmentTokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agent.EnrollmentTokenTable,
+			Columns: []string{agent.EnrollmentTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrollmentTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agent.EnrollmentTokenTable,
+			Columns: []string{agent.EnrollmentTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agent.GroupsTable,
+			Columns: agent.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !_u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agent.GroupsTable,
+			Columns: agent.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agent.GroupsTable,
+			Columns: agent.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InventoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.InventoryTable,
+			Columns: []string{agent.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInventoryIDs(); len(nodes) > 0 && !_u.mutation.InventoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.InventoryTable,
+			Columns: []string{agent.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InventoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.InventoryTable,
+			Columns: []string{agent.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SoftwareCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.SoftwareTable,
+			Columns: []string{agent.SoftwareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSoftwareIDs(); len(nodes) > 0 && !_u.mutation.SoftwareCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.SoftwareTable,
+			Columns: []string{agent.SoftwareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SoftwareIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.SoftwareTable,
+			Columns: []string{agent.SoftwareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.AlertsTable,
+			Columns: []string{agent.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAlertsIDs(); len(nodes) > 0 && !_u.mutation.AlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.AlertsTable,
+			Columns: []string{agent.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.AlertsTable,
+			Columns: []string{agent.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -544,44 +1156,295 @@ func (_u *AgentUpdateOne) SetNillableLastSeen(v *time.Time) *AgentUpdateOne {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *AgentUpdateOne) SetUpdatedAt(v time.Time) *AgentUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetTags sets the "tags" field.
+func (_u *AgentUpdateOne) SetTags(v []string) *AgentUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *AgentUpdateOne) AppendTags(v []string) *AgentUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *AgentUpdateOne) ClearTags() *AgentUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *AgentUpdateOne) SetPublicKey(v string) *AgentUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillablePublicKey(v *string) *AgentUpdateOne {
+	if v != nil {
+		_u.SetPublicKey(*v)
+	}
+	return _u
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (_u *AgentUpdateOne) ClearPublicKey() *AgentUpdateOne {
+	_u.mutation.ClearPublicKey()
+	return _u
+}
+
+// SetCertificate sets the "certificate" field.
+func (_u *AgentUpdateOne) SetCertificate(v string) *AgentUpdateOne {
+	_u.mutation.SetCertificate(v)
+	return _u
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableCertificate(v *string) *AgentUpdateOne {
+	if v != nil {
+		_u.SetCertificate(*v)
+	}
+	return _u
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (_u *AgentUpdateOne) ClearCertificate() *AgentUpdateOne {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// SetCertificateSerial sets the "certificate_serial" field.
+func (_u *AgentUpdateOne) SetCertificateSerial(v string) *AgentUpdateOne {
+	_u.mutation.SetCertificateSerial(v)
+	return _u
+}
+
+// SetNillableCertificateSerial sets the "certificate_serial" field if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableCertificateSerial(v *string) *AgentUpdateOne {
+	if v != nil {
+		_u.SetCertificateSerial(*v)
+	}
+	return _u
+}
+
+// ClearCertificateSerial clears the value of the "certificate_serial" field.
+func (_u *AgentUpdateOne) ClearCertificateSerial() *AgentUpdateOne {
+	_u.mutation.ClearCertificateSerial()
+	return _u
+}
+
+// SetCertificateExpiresAt sets the "certificate_expires_at" field.
+func (_u *AgentUpdateOne) SetCertificateExpiresAt(v time.Time) *AgentUpdateOne {
+	_u.mutation.SetCertificateExpiresAt(v)
+	return _u
+}
+
+// SetNillableCertificateExpiresAt sets the "certificate_expires_at" field if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableCertificateExpiresAt(v *time.Time) *AgentUpdateOne {
+	if v != nil {
+		_u.SetCertificateExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearCertificateExpiresAt clears the value of the "certificate_expires_at" field.
+func (_u *AgentUpdateOne) ClearCertificateExpiresAt() *AgentUpdateOne {
+	_u.mutation.ClearCertificateExpiresAt()
+	return _u
+}
+
+// SetEnrolledAt sets the "enrolled_at" field.
+func (_u *AgentUpdateOne) SetEnrolledAt(v time.Time) *AgentUpdateOne {
+	_u.mutation.SetEnrolledAt(v)
+	return _u
+}
+
+// SetNillableEnrolledAt sets the "enrolled_at" field if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableEnrolledAt(v *time.Time) *AgentUpdateOne {
+	if v != nil {
+		_u.SetEnrolledAt(*v)
+	}
+	return _u
+}
+
+// ClearEnrolledAt clears the value of the "enrolled_at" field.
+func (_u *AgentUpdateOne) ClearEnrolledAt() *AgentUpdateOne {
+	_u.mutation.ClearEnrolledAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *AgentUpdateOne) SetRevokedAt(v time.Time) *AgentUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableRevokedAt(v *time.Time) *AgentUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *AgentUpdateOne) ClearRevokedAt() *AgentUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRekeyRequested sets the "rekey_requested" field.
+func (_u *AgentUpdateOne) SetRekeyRequested(v bool) *AgentUpdateOne {
+	_u.mutation.SetRekeyRequested(v)
+	return _u
+}
+
+// SetNillableRekeyRequested sets the "rekey_requested" field if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableRekeyRequested(v *bool) *AgentUpdateOne {
+	if v != nil {
+		_u.SetRekeyRequested(*v)
+	}
+	return _u
+}
+
+// SetInventoryAt sets the "inventory_at" field.
+func (_u *AgentUpdateOne) SetInventoryAt(v time.Time) *AgentUpdateOne {
+	_u.mutation.SetInventoryAt(v)
+	return _u
+}
+
+// SetNillableInventoryAt sets the "inventory_at" field if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableInventoryAt(v *time.Time) *AgentUpdateOne {
+	if v != nil {
+		_u.SetInventoryAt(*v)
+	}
+	return _u
+}
+
+// ClearInventoryAt clears the value of the "inventory_at" field.
+func (_u *AgentUpdateOne) ClearInventoryAt() *AgentUpdateOne {
+	_u.mutation.ClearInventoryAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AgentUpdateOne) SetUpdatedAt(v time.Time) *AgentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AgentUpdateOne) SetTenantID(id int) *AgentUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant" edge to the Tenant entity by ID if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableTenantID(id *int) *AgentUpdateOne {
+	if id != nil {
+		_u = _u.SetTenantID(*id)
+	}
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AgentUpdateOne) SetTenant(v *Tenant) *AgentUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddJobExecutionIDs adds the "job_executions" edge to the JobExecution entity by IDs.
+func (_u *AgentUpdateOne) AddJobExecutionIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.AddJobExecutionIDs(ids...)
+	return _u
+}
+
+// AddJobExecutions adds the "job_executions" edges to the JobExecution entity.
+func (_u *AgentUpdateOne) AddJobExecutions(v ...*JobExecution) *AgentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobExecutionIDs(ids...)
+}
+
+// SetEnrollmentTokenID sets the "enrollment_token" edge to the AgentEnrollmentToken entity by ID.
+func (_u *AgentUpdateOne) SetEnrollmentTokenID(id int) *AgentUpdateOne {
+	_u.mutation.SetEnrollmentTokenID(id)
+	return _u
+}
+
+// SetNillableEnrollmentTokenID sets the "enrollment_token" edge to the AgentEnrollmentToken entity by ID if the given value is not nil.
+func (_u *AgentUpdateOne) SetNillableEnrollmentTokenID(id *int) *AgentUpdateOne {
+	if id != nil {
+		_u = _u.SetEnrollmentTokenID(*id)
+	}
+	return _u
+}
+
+// SetEnrollmentToken sets the "enrollment_token" edge to the AgentEnrollmentToken entity.
+func (_u *AgentUpdateOne) SetEnrollmentToken(v *AgentEnrollmentToken) *AgentUpdateOne {
+	return _u.SetEnrollmentTokenID(v.ID)
+}
+
+// AddGroupIDs adds the "groups" edge to the DeviceGroup entity by IDs.
+func (_u *AgentUpdateOne) AddGroupIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.AddGroupIDs(ids...)
 	return _u
 }
 
-// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
-func (_u *AgentUpdateOne) SetTenantID(id int) *AgentUpdateOne {
-	_u.mutation.SetTenantID(id)
+// AddGroups adds the "groups" edges to the DeviceGroup entity.
+func (_u *AgentUpdateOne) AddGroups(v ...*DeviceGroup) *AgentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGroupIDs(ids...)
+}
+
+// AddInventoryIDs adds the "inventory" edge to the AgentInventory entity by IDs.
+func (_u *AgentUpdateOne) AddInventoryIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.AddInventoryIDs(ids...)
 	return _u
 }
 
-// SetNillableTenantID sets the "tenant" edge to the Tenant entity by ID if the given value is not nil.
-func (_u *AgentUpdateOne) SetNillableTenantID(id *int) *AgentUpdateOne {
-	if id != nil {
-		_u = _u.SetTenantID(*id)
+// AddInventory adds the "inventory" edges to the AgentInventory entity.
+func (_u *AgentUpdateOne) AddInventory(v ...*AgentInventory) *AgentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddInventoryIDs(ids...)
+}
+
+// AddSoftwareIDs adds the "software" edge to the AgentSoftware entity by IDs.
+func (_u *AgentUpdateOne) AddSoftwareIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.AddSoftwareIDs(ids...)
 	return _u
 }
 
-// SetTenant sets the "tenant" edge to the Tenant entity.
-func (_u *AgentUpdateOne) SetTenant(v *Tenant) *AgentUpdateOne {
-	return _u.SetTenantID(v.ID)
+// AddSoftware adds the "software" edges to the AgentSoftware entity.
+func (_u *AgentUpdateOne) AddSoftware(v ...*AgentSoftware) *AgentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSoftwareIDs(ids...)
 }
 
-// AddJobExecutionIDs adds the "job_executions" edge to the JobExecution entity by IDs.
-func (_u *AgentUpdateOne) AddJobExecutionIDs(ids ...int) *AgentUpdateOne {
-	_u.mutation.AddJobExecutionIDs(ids...)
+// AddAlertIDs adds the "alerts" edge to the AgentAlert entity by IDs.
+func (_u *AgentUpdateOne) AddAlertIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.AddAlertIDs(ids...)
 	return _u
 }
 
-// AddJobExecutions adds the "job_executions" edges to the JobExecution entity.
-func (_u *AgentUpdateOne) AddJobExecutions(v ...*JobExecution) *AgentUpdateOne {
+// AddAlerts adds the "alerts" edges to the AgentAlert entity.
+func (_u *AgentUpdateOne) AddAlerts(v ...*AgentAlert) *AgentUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddJobExecutionIDs(ids...)
+	return _u.AddAlertIDs(ids...)
 }
 
 // Mutation returns the AgentMutation object of the builder.
@@ -616,6 +1479,96 @@ func (_u *AgentUpdateOne) RemoveJobExecutions(v ...*JobExecution) *AgentUpdateOn
 	return _u.RemoveJobExecutionIDs(ids...)
 }
 
+// ClearEnrollmentToken clears the "enrollment_token" edge to the AgentEnrollmentToken entity.
+func (_u *AgentUpdateOne) ClearEnrollmentToken() *AgentUpdateOne {
+	_u.mutation.ClearEnrollmentToken()
+	return _u
+}
+
+// ClearGroups clears all "groups" edges to the DeviceGroup entity.
+func (_u *AgentUpdateOne) ClearGroups() *AgentUpdateOne {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// RemoveGroupIDs removes the "groups" edge to DeviceGroup entities by IDs.
+func (_u *AgentUpdateOne) RemoveGroupIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.RemoveGroupIDs(ids...)
+	return _u
+}
+
+// RemoveGroups removes "groups" edges to DeviceGroup entities.
+func (_u *AgentUpdateOne) RemoveGroups(v ...*DeviceGroup) *AgentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGroupIDs(ids...)
+}
+
+// ClearInventory clears all "inventory" edges to the AgentInventory entity.
+func (_u *AgentUpdateOne) ClearInventory() *AgentUpdateOne {
+	_u.mutation.ClearInventory()
+	return _u
+}
+
+// RemoveInventoryIDs removes the "inventory" edge to AgentInventory entities by IDs.
+func (_u *AgentUpdateOne) RemoveInventoryIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.RemoveInventoryIDs(ids...)
+	return _u
+}
+
+// RemoveInventory removes "inventory" edges to AgentInventory entities.
+func (_u *AgentUpdateOne) RemoveInventory(v ...*AgentInventory) *AgentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInventoryIDs(ids...)
+}
+
+// ClearSoftware clears all "software" edges to the AgentSoftware entity.
+func (_u *AgentUpdateOne) ClearSoftware() *AgentUpdateOne {
+	_u.mutation.ClearSoftware()
+	return _u
+}
+
+// RemoveSoftwareIDs removes the "software" edge to AgentSoftware entities by IDs.
+func (_u *AgentUpdateOne) RemoveSoftwareIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.RemoveSoftwareIDs(ids...)
+	return _u
+}
+
+// RemoveSoftware removes "software" edges to AgentSoftware entities.
+func (_u *AgentUpdateOne) RemoveSoftware(v ...*AgentSoftware) *AgentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSoftwareIDs(ids...)
+}
+
+// ClearAlerts clears all "alerts" edges to the AgentAlert entity.
+func (_u *AgentUpdateOne) ClearAlerts() *AgentUpdateOne {
+	_u.mutation.ClearAlerts()
+	return _u
+}
+
+// RemoveAlertIDs removes the "alerts" edge to AgentAlert entities by IDs.
+func (_u *AgentUpdateOne) RemoveAlertIDs(ids ...int) *AgentUpdateOne {
+	_u.mutation.RemoveAlertIDs(ids...)
+	return _u
+}
+
+// RemoveAlerts removes "alerts" edges to AgentAlert entities.
+func (_u *AgentUpdateOne) RemoveAlerts(v ...*AgentAlert) *AgentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAlertIDs(ids...)
+}
+
 // Where appends a list predicates to the AgentUpdate builder.
 func (_u *AgentUpdateOne) Where(ps ...predicate.Agent) *AgentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -764,6 +1717,62 @@ func (_u *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error)
 	if value, ok := _u.mutation.LastSeen(); ok {
 		_spec.SetField(agent.FieldLastSeen, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(agent.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(agent.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(agent.FieldPublicKey, field.TypeString, value)
+	}
+	if _u.mutation.PublicKeyCleared() {
+		_spec.ClearField(agent.FieldPublicKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Certificate(); ok {
+		_spec.SetField(agent.FieldCertificate, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCleared() {
+		_spec.ClearField(agent.FieldCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.CertificateSerial(); ok {
+		_spec.SetField(agent.FieldCertificateSerial, field.TypeString, value)
+	}
+	if _u.mutation.CertificateSerialCleared() {
+		_spec.ClearField(agent.FieldCertificateSerial, field.TypeString)
+	}
+	if value, ok := _u.mutation.CertificateExpiresAt(); ok {
+		_spec.SetField(agent.FieldCertificateExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.CertificateExpiresAtCleared() {
+		_spec.ClearField(agent.FieldCertificateExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EnrolledAt(); ok {
+		_spec.SetField(agent.FieldEnrolledAt, field.TypeTime, value)
+	}
+	if _u.mutation.EnrolledAtCleared() {
+		_spec.ClearField(agent.FieldEnrolledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(agent.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(agent.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RekeyRequested(); ok {
+		_spec.SetField(agent.FieldRekeyRequested, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.InventoryAt(); ok {
+		_spec.SetField(agent.FieldInventoryAt, field.TypeTime, value)
+	}
+	if _u.mutation.InventoryAtCleared() {
+		_spec.ClearField(agent.FieldInventoryAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agent.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -841,6 +1850,215 @@ func (_u *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EnrollmentTokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agent.EnrollmentTokenTable,
+			Columns: []string{agent.EnrollmentTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrollmentTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agent.EnrollmentTokenTable,
+			Columns: []string{agent.EnrollmentTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agent.GroupsTable,
+			Columns: agent.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !_u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agent.GroupsTable,
+			Columns: agent.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agent.GroupsTable,
+			Columns: agent.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InventoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.InventoryTable,
+			Columns: []string{agent.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInventoryIDs(); len(nodes) > 0 && !_u.mutation.InventoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.InventoryTable,
+			Columns: []string{agent.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InventoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.InventoryTable,
+			Columns: []string{agent.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SoftwareCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.SoftwareTable,
+			Columns: []string{agent.SoftwareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSoftwareIDs(); len(nodes) > 0 && !_u.mutation.SoftwareCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.SoftwareTable,
+			Columns: []string{agent.SoftwareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SoftwareIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.SoftwareTable,
+			Columns: []string{agent.SoftwareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.AlertsTable,
+			Columns: []string{agent.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAlertsIDs(); len(nodes) > 0 && !_u.mutation.AlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.AlertsTable,
+			Columns: []string{agent.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   agent.AlertsTable,
+			Columns: []string{agent.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Agent{config: _u.config}
 	_spec.Assign = _node.assignValues
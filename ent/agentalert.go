@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/alertrule"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentAlert is the model entity for the AgentAlert schema.
+type AgentAlert struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Severity holds the value of the "severity" field.
+	Severity agentalert.Severity `json:"severity,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// Value holds the value of the "value" field.
+	Value float64 `json:"value,omitempty"`
+	// RaisedAt holds the value of the "raised_at" field.
+	RaisedAt time.Time `json:"raised_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// AcknowledgedAt holds the value of the "acknowledged_at" field.
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	// AcknowledgedBy holds the value of the "acknowledged_by" field.
+	AcknowledgedBy string `json:"acknowledged_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AgentAlertQuery when eager-loading is set.
+	Edges             AgentAlertEdges `json:"edges"`
+	agent_alerts      *int
+	alert_rule_alerts *int
+	selectValues      sql.SelectValues
+}
+
+// AgentAlertEdges holds the relations/edges for other nodes in the graph.
+type AgentAlertEdges struct {
+	// Rule holds the value of the rule edge.
+	Rule *AlertRule `json:"rule,omitempty"`
+	// Agent holds the value of the agent edge.
+	Agent *Agent `json:"agent,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RuleOrErr returns the Rule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentAlertEdges) RuleOrErr() (*AlertRule, error) {
+	if e.Rule != nil {
+		return e.Rule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: alertrule.Label}
+	}
+	return nil, &NotLoadedError{edge: "rule"}
+}
+
+// AgentOrErr returns the Agent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentAlertEdges) AgentOrErr() (*Agent, error) {
+	if e.Agent != nil {
+		return e.Agent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: agent.Label}
+	}
+	return nil, &NotLoadedError{edge: "agent"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentAlert) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentalert.FieldValue:
+			values[i] = new(sql.NullFloat64)
+		case agentalert.FieldID:
+			values[i] = new(sql.NullInt64)
+		case agentalert.FieldSeverity, agentalert.FieldMessage, agentalert.FieldAcknowledgedBy:
+			values[i] = new(sql.NullString)
+		case agentalert.FieldRaisedAt, agentalert.FieldResolvedAt, agentalert.FieldAcknowledgedAt:
+			values[i] = new(sql.NullTime)
+		case agentalert.ForeignKeys[0]: // agent_alerts
+			values[i] = new(sql.NullInt64)
+		case agentalert.ForeignKeys[1]: // alert_rule_alerts
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentAlert fields.
+func (_m *AgentAlert) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentalert.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case agentalert.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = agentalert.Severity(value.String)
+			}
+		case agentalert.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case agentalert.FieldValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.Float64
+			}
+		case agentalert.FieldRaisedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field raised_at", values[i])
+			} else if value.Valid {
+				_m.RaisedAt = value.Time
+			}
+		case agentalert.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case agentalert.FieldAcknowledgedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_at", values[i])
+			} else if value.Valid {
+				_m.AcknowledgedAt = new(time.Time)
+				*_m.AcknowledgedAt = value.Time
+			}
+		case agentalert.FieldAcknowledgedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_by", values[i])
+			} else if value.Valid {
+				_m.AcknowledgedBy = value.String
+			}
+		case agentalert.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field agent_alerts", value)
+			} else if value.Valid {
+				_m.agent_alerts = new(int)
+				*_m.agent_alerts = int(value.Int64)
+			}
+		case agentalert.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field alert_rule_alerts", value)
+			} else if value.Valid {
+				_m.alert_rule_alerts = new(int)
+				*_m.alert_rule_alerts = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the AgentAlert.
+// This includes values selected through modifiers, order, etc.
+func (_m *AgentAlert) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRule queries the "rule" edge of the AgentAlert entity.
+func (_m *AgentAlert) QueryRule() *AlertRuleQuery {
+	return NewAgentAlertClient(_m.config).QueryRule(_m)
+}
+
+// QueryAgent queries the "agent" edge of the AgentAlert entity.
+func (_m *AgentAlert) QueryAgent() *AgentQuery {
+	return NewAgentAlertClient(_m.config).QueryAgent(_m)
+}
+
+// Update returns a builder for updating this AgentAlert.
+// Note that you need to call AgentAlert.Unwrap() before calling this method if this AgentAlert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AgentAlert) Update() *AgentAlertUpdateOne {
+	return NewAgentAlertClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AgentAlert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AgentAlert) Unwrap() *AgentAlert {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentAlert is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AgentAlert) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentAlert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("severity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Severity))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Value))
+	builder.WriteString(", ")
+	builder.WriteString("raised_at=")
+	builder.WriteString(_m.RaisedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AcknowledgedAt; v != nil {
+		builder.WriteString("acknowledged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("acknowledged_by=")
+	builder.WriteString(_m.AcknowledgedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AgentAlerts is a parsable slice of AgentAlert.
+type AgentAlerts []*AgentAlert
@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package agentalert
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLTE(FieldID, id))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldMessage, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldValue, v))
+}
+
+// RaisedAt applies equality check predicate on the "raised_at" field. It's identical to RaisedAtEQ.
+func RaisedAt(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldRaisedAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// AcknowledgedAt applies equality check predicate on the "acknowledged_at" field. It's identical to AcknowledgedAtEQ.
+func AcknowledgedAt(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedBy applies equality check predicate on the "acknowledged_by" field. It's identical to AcknowledgedByEQ.
+func AcknowledgedBy(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldAcknowledgedBy, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v Severity) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v Severity) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...Severity) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...Severity) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v float64) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLTE(FieldValue, v))
+}
+
+// RaisedAtEQ applies the EQ predicate on the "raised_at" field.
+func RaisedAtEQ(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldRaisedAt, v))
+}
+
+// RaisedAtNEQ applies the NEQ predicate on the "raised_at" field.
+func RaisedAtNEQ(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNEQ(FieldRaisedAt, v))
+}
+
+// RaisedAtIn applies the In predicate on the "raised_at" field.
+func RaisedAtIn(vs ...time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIn(FieldRaisedAt, vs...))
+}
+
+// RaisedAtNotIn applies the NotIn predicate on the "raised_at" field.
+func RaisedAtNotIn(vs ...time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotIn(FieldRaisedAt, vs...))
+}
+
+// RaisedAtGT applies the GT predicate on the "raised_at" field.
+func RaisedAtGT(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGT(FieldRaisedAt, v))
+}
+
+// RaisedAtGTE applies the GTE predicate on the "raised_at" field.
+func RaisedAtGTE(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGTE(FieldRaisedAt, v))
+}
+
+// RaisedAtLT applies the LT predicate on the "raised_at" field.
+func RaisedAtLT(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLT(FieldRaisedAt, v))
+}
+
+// RaisedAtLTE applies the LTE predicate on the "raised_at" field.
+func RaisedAtLTE(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLTE(FieldRaisedAt, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// AcknowledgedAtEQ applies the EQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtEQ(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtNEQ applies the NEQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtNEQ(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIn applies the In predicate on the "acknowledged_at" field.
+func AcknowledgedAtIn(vs ...time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtNotIn applies the NotIn predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotIn(vs ...time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtGT applies the GT predicate on the "acknowledged_at" field.
+func AcknowledgedAtGT(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtGTE applies the GTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtGTE(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLT applies the LT predicate on the "acknowledged_at" field.
+func AcknowledgedAtLT(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLTE applies the LTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtLTE(v time.Time) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIsNil applies the IsNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtIsNil() predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIsNull(FieldAcknowledgedAt))
+}
+
+// AcknowledgedAtNotNil applies the NotNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotNil() predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotNull(FieldAcknowledgedAt))
+}
+
+// AcknowledgedByEQ applies the EQ predicate on the "acknowledged_by" field.
+func AcknowledgedByEQ(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByNEQ applies the NEQ predicate on the "acknowledged_by" field.
+func AcknowledgedByNEQ(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByIn applies the In predicate on the "acknowledged_by" field.
+func AcknowledgedByIn(vs ...string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIn(FieldAcknowledgedBy, vs...))
+}
+
+// AcknowledgedByNotIn applies the NotIn predicate on the "acknowledged_by" field.
+func AcknowledgedByNotIn(vs ...string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotIn(FieldAcknowledgedBy, vs...))
+}
+
+// AcknowledgedByGT applies the GT predicate on the "acknowledged_by" field.
+func AcknowledgedByGT(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGT(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByGTE applies the GTE predicate on the "acknowledged_by" field.
+func AcknowledgedByGTE(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldGTE(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByLT applies the LT predicate on the "acknowledged_by" field.
+func AcknowledgedByLT(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLT(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByLTE applies the LTE predicate on the "acknowledged_by" field.
+func AcknowledgedByLTE(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldLTE(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByContains applies the Contains predicate on the "acknowledged_by" field.
+func AcknowledgedByContains(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldContains(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByHasPrefix applies the HasPrefix predicate on the "acknowledged_by" field.
+func AcknowledgedByHasPrefix(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldHasPrefix(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByHasSuffix applies the HasSuffix predicate on the "acknowledged_by" field.
+func AcknowledgedByHasSuffix(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldHasSuffix(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByIsNil applies the IsNil predicate on the "acknowledged_by" field.
+func AcknowledgedByIsNil() predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldIsNull(FieldAcknowledgedBy))
+}
+
+// AcknowledgedByNotNil applies the NotNil predicate on the "acknowledged_by" field.
+func AcknowledgedByNotNil() predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldNotNull(FieldAcknowledgedBy))
+}
+
+// AcknowledgedByEqualFold applies the EqualFold predicate on the "acknowledged_by" field.
+func AcknowledgedByEqualFold(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldEqualFold(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByContainsFold applies the ContainsFold predicate on the "acknowledged_by" field.
+func AcknowledgedByContainsFold(v string) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.FieldContainsFold(FieldAcknowledgedBy, v))
+}
+
+// HasRule applies the HasEdge predicate on the "rule" edge.
+func HasRule() predicate.AgentAlert {
+	return predicate.AgentAlert(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RuleTable, RuleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRuleWith applies the HasEdge predicate on the "rule" edge with a given conditions (other predicates).
+func HasRuleWith(preds ...predicate.AlertRule) predicate.AgentAlert {
+	return predicate.AgentAlert(func(s *sql.Selector) {
+		step := newRuleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAgent applies the HasEdge predicate on the "agent" edge.
+func HasAgent() predicate.AgentAlert {
+	return predicate.AgentAlert(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AgentTable, AgentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAgentWith applies the HasEdge predicate on the "agent" edge with a given conditions (other predicates).
+func HasAgentWith(preds ...predicate.Agent) predicate.AgentAlert {
+	return predicate.AgentAlert(func(s *sql.Selector) {
+		step := newAgentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AgentAlert) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AgentAlert) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AgentAlert) predicate.AgentAlert {
+	return predicate.AgentAlert(sql.NotPredicates(p))
+}
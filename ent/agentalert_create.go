@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/alertrule"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentAlertCreate is the builder for creating a AgentAlert entity.
+type AgentAlertCreate struct {
+	config
+	mutation *AgentAlertMutation
+	hooks    []Hook
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *AgentAlertCreate) SetSeverity(v agentalert.Severity) *AgentAlertCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *AgentAlertCreate) SetMessage(v string) *AgentAlertCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *AgentAlertCreate) SetValue(v float64) *AgentAlertCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetRaisedAt sets the "raised_at" field.
+func (_c *AgentAlertCreate) SetRaisedAt(v time.Time) *AgentAlertCreate {
+	_c.mutation.SetRaisedAt(v)
+	return _c
+}
+
+// SetNillableRaisedAt sets the "raised_at" field if the given value is not nil.
+func (_c *AgentAlertCreate) SetNillableRaisedAt(v *time.Time) *AgentAlertCreate {
+	if v != nil {
+		_c.SetRaisedAt(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *AgentAlertCreate) SetResolvedAt(v time.Time) *AgentAlertCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *AgentAlertCreate) SetNillableResolvedAt(v *time.Time) *AgentAlertCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_c *AgentAlertCreate) SetAcknowledgedAt(v time.Time) *AgentAlertCreate {
+	_c.mutation.SetAcknowledgedAt(v)
+	return _c
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_c *AgentAlertCreate) SetNillableAcknowledgedAt(v *time.Time) *AgentAlertCreate {
+	if v != nil {
+		_c.SetAcknowledgedAt(*v)
+	}
+	return _c
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_c *AgentAlertCreate) SetAcknowledgedBy(v string) *AgentAlertCreate {
+	_c.mutation.SetAcknowledgedBy(v)
+	return _c
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_c *AgentAlertCreate) SetNillableAcknowledgedBy(v *string) *AgentAlertCreate {
+	if v != nil {
+		_c.SetAcknowledgedBy(*v)
+	}
+	return _c
+}
+
+// SetRuleID sets the "rule" edge to the AlertRule entity by ID.
+func (_c *AgentAlertCreate) SetRuleID(id int) *AgentAlertCreate {
+	_c.mutation.SetRuleID(id)
+	return _c
+}
+
+// SetRule sets the "rule" edge to the AlertRule entity.
+func (_c *AgentAlertCreate) SetRule(v *AlertRule) *AgentAlertCreate {
+	return _c.SetRuleID(v.ID)
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_c *AgentAlertCreate) SetAgentID(id int) *AgentAlertCreate {
+	_c.mutation.SetAgentID(id)
+	return _c
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_c *AgentAlertCreate) SetAgent(v *Agent) *AgentAlertCreate {
+	return _c.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentAlertMutation object of the builder.
+func (_c *AgentAlertCreate) Mutation() *AgentAlertMutation {
+	return _c.mutation
+}
+
+// Save creates the AgentAlert in the database.
+func (_c *AgentAlertCreate) Save(ctx context.Context) (*AgentAlert, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AgentAlertCreate) SaveX(ctx context.Context) *AgentAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentAlertCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentAlertCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AgentAlertCreate) defaults() {
+	if _, ok := _c.mutation.RaisedAt(); !ok {
+		v := agentalert.DefaultRaisedAt()
+		_c.mutation.SetRaisedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AgentAlertCreate) check() error {
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "AgentAlert.severity"`)}
+	}
+	if v, ok := _c.mutation.Severity(); ok {
+		if err := agentalert.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AgentAlert.severity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "AgentAlert.message"`)}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "AgentAlert.value"`)}
+	}
+	if _, ok := _c.mutation.RaisedAt(); !ok {
+		return &ValidationError{Name: "raised_at", err: errors.New(`ent: missing required field "AgentAlert.raised_at"`)}
+	}
+	if len(_c.mutation.RuleIDs()) == 0 {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required edge "AgentAlert.rule"`)}
+	}
+	if len(_c.mutation.AgentIDs()) == 0 {
+		return &ValidationError{Name: "agent", err: errors.New(`ent: missing required edge "AgentAlert.agent"`)}
+	}
+	return nil
+}
+
+func (_c *AgentAlertCreate) sqlSave(ctx context.Context) (*AgentAlert, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AgentAlertCreate) createSpec() (*AgentAlert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentAlert{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(agentalert.Table, sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(agentalert.FieldSeverity, field.TypeEnum, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(agentalert.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(agentalert.FieldValue, field.TypeFloat64, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.RaisedAt(); ok {
+		_spec.SetField(agentalert.FieldRaisedAt, field.TypeTime, value)
+		_node.RaisedAt = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(agentalert.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(agentalert.FieldAcknowledgedAt, field.TypeTime, value)
+		_node.AcknowledgedAt = &value
+	}
+	if value, ok := _c.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(agentalert.FieldAcknowledgedBy, field.TypeString, value)
+		_node.AcknowledgedBy = value
+	}
+	if nodes := _c.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.RuleTable,
+			Columns: []string{agentalert.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.alert_rule_alerts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.AgentTable,
+			Columns: []string{agentalert.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.agent_alerts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AgentAlertCreateBulk is the builder for creating many AgentAlert entities in bulk.
+type AgentAlertCreateBulk struct {
+	config
+	err      error
+	builders []*AgentAlertCreate
+}
+
+// Save creates the AgentAlert entities in the database.
+func (_c *AgentAlertCreateBulk) Save(ctx context.Context) ([]*AgentAlert, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AgentAlert, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentAlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AgentAlertCreateBulk) SaveX(ctx context.Context) []*AgentAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentAlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentAlertCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
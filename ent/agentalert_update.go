@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/alertrule"
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentAlertUpdate is the builder for updating AgentAlert entities.
+type AgentAlertUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AgentAlertMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AgentAlertUpdate builder.
+func (_u *AgentAlertUpdate) Where(ps ...predicate.AgentAlert) *AgentAlertUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *AgentAlertUpdate) SetSeverity(v agentalert.Severity) *AgentAlertUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *AgentAlertUpdate) SetNillableSeverity(v *agentalert.Severity) *AgentAlertUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *AgentAlertUpdate) SetMessage(v string) *AgentAlertUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *AgentAlertUpdate) SetNillableMessage(v *string) *AgentAlertUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *AgentAlertUpdate) SetValue(v float64) *AgentAlertUpdate {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *AgentAlertUpdate) SetNillableValue(v *float64) *AgentAlertUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *AgentAlertUpdate) AddValue(v float64) *AgentAlertUpdate {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *AgentAlertUpdate) SetResolvedAt(v time.Time) *AgentAlertUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *AgentAlertUpdate) SetNillableResolvedAt(v *time.Time) *AgentAlertUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *AgentAlertUpdate) ClearResolvedAt() *AgentAlertUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *AgentAlertUpdate) SetAcknowledgedAt(v time.Time) *AgentAlertUpdate {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *AgentAlertUpdate) SetNillableAcknowledgedAt(v *time.Time) *AgentAlertUpdate {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *AgentAlertUpdate) ClearAcknowledgedAt() *AgentAlertUpdate {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_u *AgentAlertUpdate) SetAcknowledgedBy(v string) *AgentAlertUpdate {
+	_u.mutation.SetAcknowledgedBy(v)
+	return _u
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_u *AgentAlertUpdate) SetNillableAcknowledgedBy(v *string) *AgentAlertUpdate {
+	if v != nil {
+		_u.SetAcknowledgedBy(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (_u *AgentAlertUpdate) ClearAcknowledgedBy() *AgentAlertUpdate {
+	_u.mutation.ClearAcknowledgedBy()
+	return _u
+}
+
+// SetRuleID sets the "rule" edge to the AlertRule entity by ID.
+func (_u *AgentAlertUpdate) SetRuleID(id int) *AgentAlertUpdate {
+	_u.mutation.SetRuleID(id)
+	return _u
+}
+
+// SetRule sets the "rule" edge to the AlertRule entity.
+func (_u *AgentAlertUpdate) SetRule(v *AlertRule) *AgentAlertUpdate {
+	return _u.SetRuleID(v.ID)
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_u *AgentAlertUpdate) SetAgentID(id int) *AgentAlertUpdate {
+	_u.mutation.SetAgentID(id)
+	return _u
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_u *AgentAlertUpdate) SetAgent(v *Agent) *AgentAlertUpdate {
+	return _u.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentAlertMutation object of the builder.
+func (_u *AgentAlertUpdate) Mutation() *AgentAlertMutation {
+	return _u.mutation
+}
+
+// ClearRule clears the "rule" edge to the AlertRule entity.
+func (_u *AgentAlertUpdate) ClearRule() *AgentAlertUpdate {
+	_u.mutation.ClearRule()
+	return _u
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (_u *AgentAlertUpdate) ClearAgent() *AgentAlertUpdate {
+	_u.mutation.ClearAgent()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AgentAlertUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentAlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AgentAlertUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentAlertUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentAlertUpdate) check() error {
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := agentalert.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AgentAlert.severity": %w`, err)}
+		}
+	}
+	if _u.mutation.RuleCleared() && len(_u.mutation.RuleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentAlert.rule"`)
+	}
+	if _u.mutation.AgentCleared() && len(_u.mutation.AgentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentAlert.agent"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentAlertUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentAlertUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentAlertUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentalert.Table, agentalert.Columns, sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(agentalert.FieldSeverity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(agentalert.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(agentalert.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(agentalert.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(agentalert.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(agentalert.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(agentalert.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(agentalert.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(agentalert.FieldAcknowledgedBy, field.TypeString, value)
+	}
+	if _u.mutation.AcknowledgedByCleared() {
+		_spec.ClearField(agentalert.FieldAcknowledgedBy, field.TypeString)
+	}
+	if _u.mutation.RuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.RuleTable,
+			Columns: []string{agentalert.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.RuleTable,
+			Columns: []string{agentalert.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AgentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.AgentTable,
+			Columns: []string{agentalert.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.AgentTable,
+			Columns: []string{agentalert.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AgentAlertUpdateOne is the builder for updating a single AgentAlert entity.
+type AgentAlertUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentAlertMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *AgentAlertUpdateOne) SetSeverity(v agentalert.Severity) *AgentAlertUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *AgentAlertUpdateOne) SetNillableSeverity(v *agentalert.Severity) *AgentAlertUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *AgentAlertUpdateOne) SetMessage(v string) *AgentAlertUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *AgentAlertUpdateOne) SetNillableMessage(v *string) *AgentAlertUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *AgentAlertUpdateOne) SetValue(v float64) *AgentAlertUpdateOne {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *AgentAlertUpdateOne) SetNillableValue(v *float64) *AgentAlertUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *AgentAlertUpdateOne) AddValue(v float64) *AgentAlertUpdateOne {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *AgentAlertUpdateOne) SetResolvedAt(v time.Time) *AgentAlertUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *AgentAlertUpdateOne) SetNillableResolvedAt(v *time.Time) *AgentAlertUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *AgentAlertUpdateOne) ClearResolvedAt() *AgentAlertUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *AgentAlertUpdateOne) SetAcknowledgedAt(v time.Time) *AgentAlertUpdateOne {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *AgentAlertUpdateOne) SetNillableAcknowledgedAt(v *time.Time) *AgentAlertUpdateOne {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *AgentAlertUpdateOne) ClearAcknowledgedAt() *AgentAlertUpdateOne {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_u *AgentAlertUpdateOne) SetAcknowledgedBy(v string) *AgentAlertUpdateOne {
+	_u.mutation.SetAcknowledgedBy(v)
+	return _u
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_u *AgentAlertUpdateOne) SetNillableAcknowledgedBy(v *string) *AgentAlertUpdateOne {
+	if v != nil {
+		_u.SetAcknowledgedBy(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (_u *AgentAlertUpdateOne) ClearAcknowledgedBy() *AgentAlertUpdateOne {
+	_u.mutation.ClearAcknowledgedBy()
+	return _u
+}
+
+// SetRuleID sets the "rule" edge to the AlertRule entity by ID.
+func (_u *AgentAlertUpdateOne) SetRuleID(id int) *AgentAlertUpdateOne {
+	_u.mutation.SetRuleID(id)
+	return _u
+}
+
+// SetRule sets the "rule" edge to the AlertRule entity.
+func (_u *AgentAlertUpdateOne) SetRule(v *AlertRule) *AgentAlertUpdateOne {
+	return _u.SetRuleID(v.ID)
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_u *AgentAlertUpdateOne) SetAgentID(id int) *AgentAlertUpdateOne {
+	_u.mutation.SetAgentID(id)
+	return _u
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_u *AgentAlertUpdateOne) SetAgent(v *Agent) *AgentAlertUpdateOne {
+	return _u.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentAlertMutation object of the builder.
+func (_u *AgentAlertUpdateOne) Mutation() *AgentAlertMutation {
+	return _u.mutation
+}
+
+// ClearRule clears the "rule" edge to the AlertRule entity.
+func (_u *AgentAlertUpdateOne) ClearRule() *AgentAlertUpdateOne {
+	_u.mutation.ClearRule()
+	return _u
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (_u *AgentAlertUpdateOne) ClearAgent() *AgentAlertUpdateOne {
+	_u.mutation.ClearAgent()
+	return _u
+}
+
+// Where appends a list predicates to the AgentAlertUpdate builder.
+func (_u *AgentAlertUpdateOne) Where(ps ...predicate.AgentAlert) *AgentAlertUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AgentAlertUpdateOne) Select(field string, fields ...string) *AgentAlertUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AgentAlert entity.
+func (_u *AgentAlertUpdateOne) Save(ctx context.Context) (*AgentAlert, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentAlertUpdateOne) SaveX(ctx context.Context) *AgentAlert {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AgentAlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentAlertUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentAlertUpdateOne) check() error {
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := agentalert.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AgentAlert.severity": %w`, err)}
+		}
+	}
+	if _u.mutation.RuleCleared() && len(_u.mutation.RuleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentAlert.rule"`)
+	}
+	if _u.mutation.AgentCleared() && len(_u.mutation.AgentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentAlert.agent"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentAlertUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentAlertUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentAlertUpdateOne) sqlSave(ctx context.Context) (_node *AgentAlert, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentalert.Table, agentalert.Columns, sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentAlert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentalert.FieldID)
+		for _, f := range fields {
+			if !agentalert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentalert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(agentalert.FieldSeverity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(agentalert.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(agentalert.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(agentalert.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(agentalert.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(agentalert.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(agentalert.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(agentalert.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(agentalert.FieldAcknowledgedBy, field.TypeString, value)
+	}
+	if _u.mutation.AcknowledgedByCleared() {
+		_spec.ClearField(agentalert.FieldAcknowledgedBy, field.TypeString)
+	}
+	if _u.mutation.RuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.RuleTable,
+			Columns: []string{agentalert.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.RuleTable,
+			Columns: []string{agentalert.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AgentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.AgentTable,
+			Columns: []string{agentalert.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentalert.AgentTable,
+			Columns: []string{agentalert.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AgentAlert{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
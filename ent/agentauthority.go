@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/agentauthority"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentAuthority is the model entity for the AgentAuthority schema.
+type AgentAuthority struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Certificate holds the value of the "certificate" field.
+	Certificate string `json:"certificate,omitempty"`
+	// PrivateKeyEncrypted holds the value of the "private_key_encrypted" field.
+	PrivateKeyEncrypted string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AgentAuthorityQuery when eager-loading is set.
+	Edges                  AgentAuthorityEdges `json:"edges"`
+	tenant_agent_authority *int
+	selectValues           sql.SelectValues
+}
+
+// AgentAuthorityEdges holds the relations/edges for other nodes in the graph.
+type AgentAuthorityEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentAuthorityEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentAuthority) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentauthority.FieldID:
+			values[i] = new(sql.NullInt64)
+		case agentauthority.FieldCertificate, agentauthority.FieldPrivateKeyEncrypted:
+			values[i] = new(sql.NullString)
+		case agentauthority.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case agentauthority.ForeignKeys[0]: // tenant_agent_authority
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentAuthority fields.
+func (_m *AgentAuthority) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentauthority.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case agentauthority.FieldCertificate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate", values[i])
+			} else if value.Valid {
+				_m.Certificate = value.String
+			}
+		case agentauthority.FieldPrivateKeyEncrypted:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key_encrypted", values[i])
+			} else if value.Valid {
+				_m.PrivateKeyEncrypted = value.String
+			}
+		case agentauthority.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case agentauthority.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_agent_authority", value)
+			} else if value.Valid {
+				_m.tenant_agent_authority = new(int)
+				*_m.tenant_agent_authority = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AgentAuthority.
+// This includes values selected through modifiers, order, etc.
+func (_m *AgentAuthority) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the AgentAuthority entity.
+func (_m *AgentAuthority) QueryTenant() *TenantQuery {
+	return NewAgentAuthorityClient(_m.config).QueryTenant(_m)
+}
+
+// Update returns a builder for updating this AgentAuthority.
+// Note that you need to call AgentAuthority.Unwrap() before calling this method if this AgentAuthority
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AgentAuthority) Update() *AgentAuthorityUpdateOne {
+	return NewAgentAuthorityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AgentAuthority entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AgentAuthority) Unwrap() *AgentAuthority {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentAuthority is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AgentAuthority) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentAuthority(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("certificate=")
+	builder.WriteString(_m.Certificate)
+	builder.WriteString(", ")
+	builder.WriteString("private_key_encrypted=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AgentAuthorities is a parsable slice of AgentAuthority.
+type AgentAuthorities []*AgentAuthority
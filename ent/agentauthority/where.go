@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package agentauthority
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldLTE(FieldID, id))
+}
+
+// Certificate applies equality check predicate on the "certificate" field. It's identical to CertificateEQ.
+func Certificate(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEQ(FieldCertificate, v))
+}
+
+// PrivateKeyEncrypted applies equality check predicate on the "private_key_encrypted" field. It's identical to PrivateKeyEncryptedEQ.
+func PrivateKeyEncrypted(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEQ(FieldPrivateKeyEncrypted, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CertificateEQ applies the EQ predicate on the "certificate" field.
+func CertificateEQ(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEQ(FieldCertificate, v))
+}
+
+// CertificateNEQ applies the NEQ predicate on the "certificate" field.
+func CertificateNEQ(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldNEQ(FieldCertificate, v))
+}
+
+// CertificateIn applies the In predicate on the "certificate" field.
+func CertificateIn(vs ...string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldIn(FieldCertificate, vs...))
+}
+
+// CertificateNotIn applies the NotIn predicate on the "certificate" field.
+func CertificateNotIn(vs ...string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldNotIn(FieldCertificate, vs...))
+}
+
+// CertificateGT applies the GT predicate on the "certificate" field.
+func CertificateGT(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldGT(FieldCertificate, v))
+}
+
+// CertificateGTE applies the GTE predicate on the "certificate" field.
+func CertificateGTE(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldGTE(FieldCertificate, v))
+}
+
+// CertificateLT applies the LT predicate on the "certificate" field.
+func CertificateLT(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldLT(FieldCertificate, v))
+}
+
+// CertificateLTE applies the LTE predicate on the "certificate" field.
+func CertificateLTE(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldLTE(FieldCertificate, v))
+}
+
+// CertificateContains applies the Contains predicate on the "certificate" field.
+func CertificateContains(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldContains(FieldCertificate, v))
+}
+
+// CertificateHasPrefix applies the HasPrefix predicate on the "certificate" field.
+func CertificateHasPrefix(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldHasPrefix(FieldCertificate, v))
+}
+
+// CertificateHasSuffix applies the HasSuffix predicate on the "certificate" field.
+func CertificateHasSuffix(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldHasSuffix(FieldCertificate, v))
+}
+
+// CertificateEqualFold applies the EqualFold predicate on the "certificate" field.
+func CertificateEqualFold(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEqualFold(FieldCertificate, v))
+}
+
+// CertificateContainsFold applies the ContainsFold predicate on the "certificate" field.
+func CertificateContainsFold(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldContainsFold(FieldCertificate, v))
+}
+
+// PrivateKeyEncryptedEQ applies the EQ predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedEQ(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEQ(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedNEQ applies the NEQ predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedNEQ(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldNEQ(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedIn applies the In predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedIn(vs ...string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldIn(FieldPrivateKeyEncrypted, vs...))
+}
+
+// PrivateKeyEncryptedNotIn applies the NotIn predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedNotIn(vs ...string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldNotIn(FieldPrivateKeyEncrypted, vs...))
+}
+
+// PrivateKeyEncryptedGT applies the GT predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedGT(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldGT(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedGTE applies the GTE predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedGTE(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldGTE(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedLT applies the LT predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedLT(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldLT(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedLTE applies the LTE predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedLTE(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldLTE(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedContains applies the Contains predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedContains(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldContains(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedHasPrefix applies the HasPrefix predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedHasPrefix(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldHasPrefix(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedHasSuffix applies the HasSuffix predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedHasSuffix(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldHasSuffix(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedEqualFold applies the EqualFold predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedEqualFold(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEqualFold(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedContainsFold applies the ContainsFold predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedContainsFold(v string) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldContainsFold(FieldPrivateKeyEncrypted, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.AgentAuthority {
+	return predicate.AgentAuthority(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.AgentAuthority {
+	return predicate.AgentAuthority(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AgentAuthority) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AgentAuthority) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AgentAuthority) predicate.AgentAuthority {
+	return predicate.AgentAuthority(sql.NotPredicates(p))
+}
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agentauthority"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentAuthorityCreate is the builder for creating a AgentAuthority entity.
+type AgentAuthorityCreate struct {
+	config
+	mutation *AgentAuthorityMutation
+	hooks    []Hook
+}
+
+// SetCertificate sets the "certificate" field.
+func (_c *AgentAuthorityCreate) SetCertificate(v string) *AgentAuthorityCreate {
+	_c.mutation.SetCertificate(v)
+	return _c
+}
+
+// SetPrivateKeyEncrypted sets the "private_key_encrypted" field.
+func (_c *AgentAuthorityCreate) SetPrivateKeyEncrypted(v string) *AgentAuthorityCreate {
+	_c.mutation.SetPrivateKeyEncrypted(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AgentAuthorityCreate) SetCreatedAt(v time.Time) *AgentAuthorityCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AgentAuthorityCreate) SetNillableCreatedAt(v *time.Time) *AgentAuthorityCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *AgentAuthorityCreate) SetTenantID(id int) *AgentAuthorityCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *AgentAuthorityCreate) SetTenant(v *Tenant) *AgentAuthorityCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// Mutation returns the AgentAuthorityMutation object of the builder.
+func (_c *AgentAuthorityCreate) Mutation() *AgentAuthorityMutation {
+	return _c.mutation
+}
+
+// Save creates the AgentAuthority in the database.
+func (_c *AgentAuthorityCreate) Save(ctx context.Context) (*AgentAuthority, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AgentAuthorityCreate) SaveX(ctx context.Context) *AgentAuthority {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentAuthorityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentAuthorityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AgentAuthorityCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := agentauthority.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AgentAuthorityCreate) check() error {
+	if _, ok := _c.mutation.Certificate(); !ok {
+		return &ValidationError{Name: "certificate", err: errors.New(`ent: missing required field "AgentAuthority.certificate"`)}
+	}
+	if _, ok := _c.mutation.PrivateKeyEncrypted(); !ok {
+		return &ValidationError{Name: "private_key_encrypted", err: errors.New(`ent: missing required field "AgentAuthority.private_key_encrypted"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AgentAuthority.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "AgentAuthority.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *AgentAuthorityCreate) sqlSave(ctx context.Context) (*AgentAuthority, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AgentAuthorityCreate) createSpec() (*AgentAuthority, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentAuthority{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(agentauthority.Table, sqlgraph.NewFieldSpec(agentauthority.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Certificate(); ok {
+		_spec.SetField(agentauthority.FieldCertificate, field.TypeString, value)
+		_node.Certificate = value
+	}
+	if value, ok := _c.mutation.PrivateKeyEncrypted(); ok {
+		_spec.SetField(agentauthority.FieldPrivateKeyEncrypted, field.TypeString, value)
+		_node.PrivateKeyEncrypted = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(agentauthority.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agentauthority.TenantTable,
+			Columns: []string{agentauthority.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_agent_authority = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AgentAuthorityCreateBulk is the builder for creating many AgentAuthority entities in bulk.
+type AgentAuthorityCreateBulk struct {
+	config
+	err      error
+	builders []*AgentAuthorityCreate
+}
+
+// Save creates the AgentAuthority entities in the database.
+func (_c *AgentAuthorityCreateBulk) Save(ctx context.Context) ([]*AgentAuthority, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AgentAuthority, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentAuthorityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AgentAuthorityCreateBulk) SaveX(ctx context.Context) []*AgentAuthority {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentAuthorityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentAuthorityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
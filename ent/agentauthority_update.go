@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agentauthority"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentAuthorityUpdate is the builder for updating AgentAuthority entities.
+type AgentAuthorityUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AgentAuthorityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AgentAuthorityUpdate builder.
+func (_u *AgentAuthorityUpdate) Where(ps ...predicate.AgentAuthority) *AgentAuthorityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCertificate sets the "certificate" field.
+func (_u *AgentAuthorityUpdate) SetCertificate(v string) *AgentAuthorityUpdate {
+	_u.mutation.SetCertificate(v)
+	return _u
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_u *AgentAuthorityUpdate) SetNillableCertificate(v *string) *AgentAuthorityUpdate {
+	if v != nil {
+		_u.SetCertificate(*v)
+	}
+	return _u
+}
+
+// SetPrivateKeyEncrypted sets the "private_key_encrypted" field.
+func (_u *AgentAuthorityUpdate) SetPrivateKeyEncrypted(v string) *AgentAuthorityUpdate {
+	_u.mutation.SetPrivateKeyEncrypted(v)
+	return _u
+}
+
+// SetNillablePrivateKeyEncrypted sets the "private_key_encrypted" field if the given value is not nil.
+func (_u *AgentAuthorityUpdate) SetNillablePrivateKeyEncrypted(v *string) *AgentAuthorityUpdate {
+	if v != nil {
+		_u.SetPrivateKeyEncrypted(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AgentAuthorityUpdate) SetTenantID(id int) *AgentAuthorityUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AgentAuthorityUpdate) SetTenant(v *Tenant) *AgentAuthorityUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the AgentAuthorityMutation object of the builder.
+func (_u *AgentAuthorityUpdate) Mutation() *AgentAuthorityMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *AgentAuthorityUpdate) ClearTenant() *AgentAuthorityUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AgentAuthorityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentAuthorityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AgentAuthorityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentAuthorityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentAuthorityUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentAuthority.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentAuthorityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentAuthorityUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentAuthorityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentauthority.Table, agentauthority.Columns, sqlgraph.NewFieldSpec(agentauthority.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Certificate(); ok {
+		_spec.SetField(agentauthority.FieldCertificate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrivateKeyEncrypted(); ok {
+		_spec.SetField(agentauthority.FieldPrivateKeyEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agentauthority.TenantTable,
+			Columns: []string{agentauthority.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agentauthority.TenantTable,
+			Columns: []string{agentauthority.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentauthority.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AgentAuthorityUpdateOne is the builder for updating a single AgentAuthority entity.
+type AgentAuthorityUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentAuthorityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCertificate sets the "certificate" field.
+func (_u *AgentAuthorityUpdateOne) SetCertificate(v string) *AgentAuthorityUpdateOne {
+	_u.mutation.SetCertificate(v)
+	return _u
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_u *AgentAuthorityUpdateOne) SetNillableCertificate(v *string) *AgentAuthorityUpdateOne {
+	if v != nil {
+		_u.SetCertificate(*v)
+	}
+	return _u
+}
+
+// SetPrivateKeyEncrypted sets the "private_key_encrypted" field.
+func (_u *AgentAuthorityUpdateOne) SetPrivateKeyEncrypted(v string) *AgentAuthorityUpdateOne {
+	_u.mutation.SetPrivateKeyEncrypted(v)
+	return _u
+}
+
+// SetNillablePrivateKeyEncrypted sets the "private_key_encrypted" field if the given value is not nil.
+func (_u *AgentAuthorityUpdateOne) SetNillablePrivateKeyEncrypted(v *string) *AgentAuthorityUpdateOne {
+	if v != nil {
+		_u.SetPrivateKeyEncrypted(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AgentAuthorityUpdateOne) SetTenantID(id int) *AgentAuthorityUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AgentAuthorityUpdateOne) SetTenant(v *Tenant) *AgentAuthorityUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the AgentAuthorityMutation object of the builder.
+func (_u *AgentAuthorityUpdateOne) Mutation() *AgentAuthorityMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *AgentAuthorityUpdateOne) ClearTenant() *AgentAuthorityUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Where appends a list predicates to the AgentAuthorityUpdate builder.
+func (_u *AgentAuthorityUpdateOne) Where(ps ...predicate.AgentAuthority) *AgentAuthorityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AgentAuthorityUpdateOne) Select(field string, fields ...string) *AgentAuthorityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AgentAuthority entity.
+func (_u *AgentAuthorityUpdateOne) Save(ctx context.Context) (*AgentAuthority, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentAuthorityUpdateOne) SaveX(ctx context.Context) *AgentAuthority {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AgentAuthorityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentAuthorityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentAuthorityUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentAuthority.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentAuthorityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentAuthorityUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentAuthorityUpdateOne) sqlSave(ctx context.Context) (_node *AgentAuthority, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentauthority.Table, agentauthority.Columns, sqlgraph.NewFieldSpec(agentauthority.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentAuthority.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentauthority.FieldID)
+		for _, f := range fields {
+			if !agentauthority.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentauthority.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Certificate(); ok {
+		_spec.SetField(agentauthority.FieldCertificate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrivateKeyEncrypted(); ok {
+		_spec.SetField(agentauthority.FieldPrivateKeyEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agentauthority.TenantTable,
+			Columns: []string{agentauthority.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   agentauthority.TenantTable,
+			Columns: []string{agentauthority.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AgentAuthority{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentauthority.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentEnrollmentToken is the model entity for the AgentEnrollmentToken schema.
+type AgentEnrollmentToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AgentEnrollmentTokenQuery when eager-loading is set.
+	Edges                          AgentEnrollmentTokenEdges `json:"edges"`
+	tenant_agent_enrollment_tokens *int
+	selectValues                   sql.SelectValues
+}
+
+// AgentEnrollmentTokenEdges holds the relations/edges for other nodes in the graph.
+type AgentEnrollmentTokenEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Agent holds the value of the agent edge.
+	Agent *Agent `json:"agent,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentEnrollmentTokenEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// AgentOrErr returns the Agent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentEnrollmentTokenEdges) AgentOrErr() (*Agent, error) {
+	if e.Agent != nil {
+		return e.Agent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: agent.Label}
+	}
+	return nil, &NotLoadedError{edge: "agent"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentEnrollmentToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentenrollmenttoken.FieldID:
+			values[i] = new(sql.NullInt64)
+		case agentenrollmenttoken.FieldTokenHash, agentenrollmenttoken.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case agentenrollmenttoken.FieldExpiresAt, agentenrollmenttoken.FieldUsedAt, agentenrollmenttoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case agentenrollmenttoken.ForeignKeys[0]: // tenant_agent_enrollment_tokens
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentEnrollmentToken fields.
+func (_m *AgentEnrollmentToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentenrollmenttoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case agentenrollmenttoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case agentenrollmenttoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case agentenrollmenttoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		case agentenrollmenttoken.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case agentenrollmenttoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case agentenrollmenttoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_agent_enrollment_tokens", value)
+			} else if value.Valid {
+				_m.tenant_agent_enrollment_tokens = new(int)
+				*_m.tenant_agent_enrollment_tokens = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AgentEnrollmentToken.
+// This includes values selected through modifiers, order, etc.
+func (_m *AgentEnrollmentToken) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the AgentEnrollmentToken entity.
+func (_m *AgentEnrollmentToken) QueryTenant() *TenantQuery {
+	return NewAgentEnrollmentTokenClient(_m.config).QueryTenant(_m)
+}
+
+// QueryAgent queries the "agent" edge of the AgentEnrollmentToken entity.
+func (_m *AgentEnrollmentToken) QueryAgent() *AgentQuery {
+	return NewAgentEnrollmentTokenClient(_m.config).QueryAgent(_m)
+}
+
+// Update returns a builder for updating this AgentEnrollmentToken.
+// Note that you need to call AgentEnrollmentToken.Unwrap() before calling this method if this AgentEnrollmentToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AgentEnrollmentToken) Update() *AgentEnrollmentTokenUpdateOne {
+	return NewAgentEnrollmentTokenClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AgentEnrollmentToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AgentEnrollmentToken) Unwrap() *AgentEnrollmentToken {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentEnrollmentToken is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AgentEnrollmentToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentEnrollmentToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AgentEnrollmentTokens is a parsable slice of AgentEnrollmentToken.
+type AgentEnrollmentTokens []*AgentEnrollmentToken
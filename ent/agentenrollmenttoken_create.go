@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentEnrollmentTokenCreate is the builder for creating a AgentEnrollmentToken entity.
+type AgentEnrollmentTokenCreate struct {
+	config
+	mutation *AgentEnrollmentTokenMutation
+	hooks    []Hook
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *AgentEnrollmentTokenCreate) SetTokenHash(v string) *AgentEnrollmentTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AgentEnrollmentTokenCreate) SetExpiresAt(v time.Time) *AgentEnrollmentTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *AgentEnrollmentTokenCreate) SetUsedAt(v time.Time) *AgentEnrollmentTokenCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *AgentEnrollmentTokenCreate) SetNillableUsedAt(v *time.Time) *AgentEnrollmentTokenCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *AgentEnrollmentTokenCreate) SetCreatedBy(v string) *AgentEnrollmentTokenCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *AgentEnrollmentTokenCreate) SetNillableCreatedBy(v *string) *AgentEnrollmentTokenCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AgentEnrollmentTokenCreate) SetCreatedAt(v time.Time) *AgentEnrollmentTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AgentEnrollmentTokenCreate) SetNillableCreatedAt(v *time.Time) *AgentEnrollmentTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *AgentEnrollmentTokenCreate) SetTenantID(id int) *AgentEnrollmentTokenCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *AgentEnrollmentTokenCreate) SetTenant(v *Tenant) *AgentEnrollmentTokenCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_c *AgentEnrollmentTokenCreate) SetAgentID(id int) *AgentEnrollmentTokenCreate {
+	_c.mutation.SetAgentID(id)
+	return _c
+}
+
+// SetNillableAgentID sets the "agent" edge to the Agent entity by ID if the given value is not nil.
+func (_c *AgentEnrollmentTokenCreate) SetNillableAgentID(id *int) *AgentEnrollmentTokenCreate {
+	if id != nil {
+		_c = _c.SetAgentID(*id)
+	}
+	return _c
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_c *AgentEnrollmentTokenCreate) SetAgent(v *Agent) *AgentEnrollmentTokenCreate {
+	return _c.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentEnrollmentTokenMutation object of the builder.
+func (_c *AgentEnrollmentTokenCreate) Mutation() *AgentEnrollmentTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the AgentEnrollmentToken in the database.
+func (_c *AgentEnrollmentTokenCreate) Save(ctx context.Context) (*AgentEnrollmentToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AgentEnrollmentTokenCreate) SaveX(ctx context.Context) *AgentEnrollmentToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentEnrollmentTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentEnrollmentTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AgentEnrollmentTokenCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := agentenrollmenttoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AgentEnrollmentTokenCreate) check() error {
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "AgentEnrollmentToken.token_hash"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AgentEnrollmentToken.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AgentEnrollmentToken.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "AgentEnrollmentToken.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *AgentEnrollmentTokenCreate) sqlSave(ctx context.Context) (*AgentEnrollmentToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AgentEnrollmentTokenCreate) createSpec() (*AgentEnrollmentToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentEnrollmentToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(agentenrollmenttoken.Table, sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentenrollmenttoken.TenantTable,
+			Columns: []string{agentenrollmenttoken.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_agent_enrollment_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   agentenrollmenttoken.AgentTable,
+			Columns: []string{agentenrollmenttoken.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AgentEnrollmentTokenCreateBulk is the builder for creating many AgentEnrollmentToken entities in bulk.
+type AgentEnrollmentTokenCreateBulk struct {
+	config
+	err      error
+	builders []*AgentEnrollmentTokenCreate
+}
+
+// Save creates the AgentEnrollmentToken entities in the database.
+func (_c *AgentEnrollmentTokenCreateBulk) Save(ctx context.Context) ([]*AgentEnrollmentToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AgentEnrollmentToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentEnrollmentTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AgentEnrollmentTokenCreateBulk) SaveX(ctx context.Context) []*AgentEnrollmentToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentEnrollmentTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentEnrollmentTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentEnrollmentTokenDelete is the builder for deleting a AgentEnrollmentToken entity.
+type AgentEnrollmentTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *AgentEnrollmentTokenMutation
+}
+
+// Where appends a list predicates to the AgentEnrollmentTokenDelete builder.
+func (_d *AgentEnrollmentTokenDelete) Where(ps ...predicate.AgentEnrollmentToken) *AgentEnrollmentTokenDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AgentEnrollmentTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AgentEnrollmentTokenDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AgentEnrollmentTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(agentenrollmenttoken.Table, sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AgentEnrollmentTokenDeleteOne is the builder for deleting a single AgentEnrollmentToken entity.
+type AgentEnrollmentTokenDeleteOne struct {
+	_d *AgentEnrollmentTokenDelete
+}
+
+// Where appends a list predicates to the AgentEnrollmentTokenDelete builder.
+func (_d *AgentEnrollmentTokenDeleteOne) Where(ps ...predicate.AgentEnrollmentToken) *AgentEnrollmentTokenDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AgentEnrollmentTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{agentenrollmenttoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AgentEnrollmentTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentEnrollmentTokenUpdate is the builder for updating AgentEnrollmentToken entities.
+type AgentEnrollmentTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AgentEnrollmentTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AgentEnrollmentTokenUpdate builder.
+func (_u *AgentEnrollmentTokenUpdate) Where(ps ...predicate.AgentEnrollmentToken) *AgentEnrollmentTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *AgentEnrollmentTokenUpdate) SetUsedAt(v time.Time) *AgentEnrollmentTokenUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *AgentEnrollmentTokenUpdate) SetNillableUsedAt(v *time.Time) *AgentEnrollmentTokenUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *AgentEnrollmentTokenUpdate) ClearUsedAt() *AgentEnrollmentTokenUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AgentEnrollmentTokenUpdate) SetCreatedBy(v string) *AgentEnrollmentTokenUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AgentEnrollmentTokenUpdate) SetNillableCreatedBy(v *string) *AgentEnrollmentTokenUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AgentEnrollmentTokenUpdate) ClearCreatedBy() *AgentEnrollmentTokenUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AgentEnrollmentTokenUpdate) SetTenantID(id int) *AgentEnrollmentTokenUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AgentEnrollmentTokenUpdate) SetTenant(v *Tenant) *AgentEnrollmentTokenUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_u *AgentEnrollmentTokenUpdate) SetAgentID(id int) *AgentEnrollmentTokenUpdate {
+	_u.mutation.SetAgentID(id)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent" edge to the Agent entity by ID if the given value is not nil.
+func (_u *AgentEnrollmentTokenUpdate) SetNillableAgentID(id *int) *AgentEnrollmentTokenUpdate {
+	if id != nil {
+		_u = _u.SetAgentID(*id)
+	}
+	return _u
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_u *AgentEnrollmentTokenUpdate) SetAgent(v *Agent) *AgentEnrollmentTokenUpdate {
+	return _u.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentEnrollmentTokenMutation object of the builder.
+func (_u *AgentEnrollmentTokenUpdate) Mutation() *AgentEnrollmentTokenMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *AgentEnrollmentTokenUpdate) ClearTenant() *AgentEnrollmentTokenUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (_u *AgentEnrollmentTokenUpdate) ClearAgent() *AgentEnrollmentTokenUpdate {
+	_u.mutation.ClearAgent()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AgentEnrollmentTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentEnrollmentTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AgentEnrollmentTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentEnrollmentTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentEnrollmentTokenUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentEnrollmentToken.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentEnrollmentTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentEnrollmentTokenUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentEnrollmentTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentenrollmenttoken.Table, agentenrollmenttoken.Columns, sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(agentenrollmenttoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(agentenrollmenttoken.FieldCreatedBy, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentenrollmenttoken.TenantTable,
+			Columns: []string{agentenrollmenttoken.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentenrollmenttoken.TenantTable,
+			Columns: []string{agentenrollmenttoken.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AgentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   agentenrollmenttoken.AgentTable,
+			Columns: []string{agentenrollmenttoken.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   agentenrollmenttoken.AgentTable,
+			Columns: []string{agentenrollmenttoken.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentenrollmenttoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AgentEnrollmentTokenUpdateOne is the builder for updating a single AgentEnrollmentToken entity.
+type AgentEnrollmentTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentEnrollmentTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *AgentEnrollmentTokenUpdateOne) SetUsedAt(v time.Time) *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *AgentEnrollmentTokenUpdateOne) SetNillableUsedAt(v *time.Time) *AgentEnrollmentTokenUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *AgentEnrollmentTokenUpdateOne) ClearUsedAt() *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AgentEnrollmentTokenUpdateOne) SetCreatedBy(v string) *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AgentEnrollmentTokenUpdateOne) SetNillableCreatedBy(v *string) *AgentEnrollmentTokenUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AgentEnrollmentTokenUpdateOne) ClearCreatedBy() *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AgentEnrollmentTokenUpdateOne) SetTenantID(id int) *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AgentEnrollmentTokenUpdateOne) SetTenant(v *Tenant) *AgentEnrollmentTokenUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_u *AgentEnrollmentTokenUpdateOne) SetAgentID(id int) *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.SetAgentID(id)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent" edge to the Agent entity by ID if the given value is not nil.
+func (_u *AgentEnrollmentTokenUpdateOne) SetNillableAgentID(id *int) *AgentEnrollmentTokenUpdateOne {
+	if id != nil {
+		_u = _u.SetAgentID(*id)
+	}
+	return _u
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_u *AgentEnrollmentTokenUpdateOne) SetAgent(v *Agent) *AgentEnrollmentTokenUpdateOne {
+	return _u.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentEnrollmentTokenMutation object of the builder.
+func (_u *AgentEnrollmentTokenUpdateOne) Mutation() *AgentEnrollmentTokenMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *AgentEnrollmentTokenUpdateOne) ClearTenant() *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (_u *AgentEnrollmentTokenUpdateOne) ClearAgent() *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.ClearAgent()
+	return _u
+}
+
+// Where appends a list predicates to the AgentEnrollmentTokenUpdate builder.
+func (_u *AgentEnrollmentTokenUpdateOne) Where(ps ...predicate.AgentEnrollmentToken) *AgentEnrollmentTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AgentEnrollmentTokenUpdateOne) Select(field string, fields ...string) *AgentEnrollmentTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AgentEnrollmentToken entity.
+func (_u *AgentEnrollmentTokenUpdateOne) Save(ctx context.Context) (*AgentEnrollmentToken, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentEnrollmentTokenUpdateOne) SaveX(ctx context.Context) *AgentEnrollmentToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AgentEnrollmentTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentEnrollmentTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentEnrollmentTokenUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentEnrollmentToken.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentEnrollmentTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentEnrollmentTokenUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentEnrollmentTokenUpdateOne) sqlSave(ctx context.Context) (_node *AgentEnrollmentToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentenrollmenttoken.Table, agentenrollmenttoken.Columns, sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentEnrollmentToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentenrollmenttoken.FieldID)
+		for _, f := range fields {
+			if !agentenrollmenttoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentenrollmenttoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(agentenrollmenttoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(agentenrollmenttoken.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(agentenrollmenttoken.FieldCreatedBy, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentenrollmenttoken.TenantTable,
+			Columns: []string{agentenrollmenttoken.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentenrollmenttoken.TenantTable,
+			Columns: []string{agentenrollmenttoken.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AgentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   agentenrollmenttoken.AgentTable,
+			Columns: []string{agentenrollmenttoken.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   agentenrollmenttoken.AgentTable,
+			Columns: []string{agentenrollmenttoken.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AgentEnrollmentToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentenrollmenttoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
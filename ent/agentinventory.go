@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentinventory"
+	"sent/pkg/pulse/common"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentInventory is the model entity for the AgentInventory schema.
+type AgentInventory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// ConfirmedAt holds the value of the "confirmed_at" field.
+	ConfirmedAt time.Time `json:"confirmed_at,omitempty"`
+	// Host holds the value of the "host" field.
+	Host common.HostInfo `json:"host,omitempty"`
+	// Hardware holds the value of the "hardware" field.
+	Hardware common.HardwareInfo `json:"hardware,omitempty"`
+	// Software holds the value of the "software" field.
+	Software []common.SoftwareInfo `json:"software,omitempty"`
+	// Services holds the value of the "services" field.
+	Services []common.ServiceInfo `json:"services,omitempty"`
+	// Patches holds the value of the "patches" field.
+	Patches []common.PatchInfo `json:"patches,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes common.InventoryChanges `json:"changes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AgentInventoryQuery when eager-loading is set.
+	Edges           AgentInventoryEdges `json:"edges"`
+	agent_inventory *int
+	selectValues    sql.SelectValues
+}
+
+// AgentInventoryEdges holds the relations/edges for other nodes in the graph.
+type AgentInventoryEdges struct {
+	// Agent holds the value of the agent edge.
+	Agent *Agent `json:"agent,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AgentOrErr returns the Agent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentInventoryEdges) AgentOrErr() (*Agent, error) {
+	if e.Agent != nil {
+		return e.Agent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: agent.Label}
+	}
+	return nil, &NotLoadedError{edge: "agent"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentInventory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentinventory.FieldHost, agentinventory.FieldHardware, agentinventory.FieldSoftware, agentinventory.FieldServices, agentinventory.FieldPatches, agentinventory.FieldChanges:
+			values[i] = new([]byte)
+		case agentinventory.FieldID, agentinventory.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case agentinventory.FieldHash:
+			values[i] = new(sql.NullString)
+		case agentinventory.FieldCollectedAt, agentinventory.FieldConfirmedAt, agentinventory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case agentinventory.ForeignKeys[0]: // agent_inventory
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentInventory fields.
+func (_m *AgentInventory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentinventory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case agentinventory.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case agentinventory.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case agentinventory.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case agentinventory.FieldConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed_at", values[i])
+			} else if value.Valid {
+				_m.ConfirmedAt = value.Time
+			}
+		case agentinventory.FieldHost:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field host", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Host); err != nil {
+					return fmt.Errorf("unmarshal field host: %w", err)
+				}
+			}
+		case agentinventory.FieldHardware:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Hardware); err != nil {
+					return fmt.Errorf("unmarshal field hardware: %w", err)
+				}
+			}
+		case agentinventory.FieldSoftware:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field software", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Software); err != nil {
+					return fmt.Errorf("unmarshal field software: %w", err)
+				}
+			}
+		case agentinventory.FieldServices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field services", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Services); err != nil {
+					return fmt.Errorf("unmarshal field services: %w", err)
+				}
+			}
+		case agentinventory.FieldPatches:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field patches", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Patches); err != nil {
+					return fmt.Errorf("unmarshal field patches: %w", err)
+				}
+			}
+		case agentinventory.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case agentinventory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case agentinventory.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field agent_inventory", value)
+			} else if value.Valid {
+				_m.agent_inventory = new(int)
+				*_m.agent_inventory = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AgentInventory.
+// This includes values selected through modifiers, order, etc.
+func (_m *AgentInventory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAgent queries the "agent" edge of the AgentInventory entity.
+func (_m *AgentInventory) QueryAgent() *AgentQuery {
+	return NewAgentInventoryClient(_m.config).QueryAgent(_m)
+}
+
+// Update returns a builder for updating this AgentInventory.
+// Note that you need to call AgentInventory.Unwrap() before calling this method if this AgentInventory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AgentInventory) Update() *AgentInventoryUpdateOne {
+	return NewAgentInventoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AgentInventory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AgentInventory) Unwrap() *AgentInventory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentInventory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AgentInventory) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentInventory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("confirmed_at=")
+	builder.WriteString(_m.ConfirmedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("host=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Host))
+	builder.WriteString(", ")
+	builder.WriteString("hardware=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hardware))
+	builder.WriteString(", ")
+	builder.WriteString("software=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Software))
+	builder.WriteString(", ")
+	builder.WriteString("services=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Services))
+	builder.WriteString(", ")
+	builder.WriteString("patches=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Patches))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AgentInventories is a parsable slice of AgentInventory.
+type AgentInventories []*AgentInventory
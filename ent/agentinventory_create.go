@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentinventory"
+	"sent/pkg/pulse/common"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentInventoryCreate is the builder for creating a AgentInventory entity.
+type AgentInventoryCreate struct {
+	config
+	mutation *AgentInventoryMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (_c *AgentInventoryCreate) SetVersion(v int) *AgentInventoryCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *AgentInventoryCreate) SetHash(v string) *AgentInventoryCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *AgentInventoryCreate) SetCollectedAt(v time.Time) *AgentInventoryCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_c *AgentInventoryCreate) SetConfirmedAt(v time.Time) *AgentInventoryCreate {
+	_c.mutation.SetConfirmedAt(v)
+	return _c
+}
+
+// SetHost sets the "host" field.
+func (_c *AgentInventoryCreate) SetHost(v common.HostInfo) *AgentInventoryCreate {
+	_c.mutation.SetHost(v)
+	return _c
+}
+
+// SetHardware sets the "hardware" field.
+func (_c *AgentInventoryCreate) SetHardware(v common.HardwareInfo) *AgentInventoryCreate {
+	_c.mutation.SetHardware(v)
+	return _c
+}
+
+// SetSoftware sets the "software" field.
+func (_c *AgentInventoryCreate) SetSoftware(v []common.SoftwareInfo) *AgentInventoryCreate {
+	_c.mutation.SetSoftware(v)
+	return _c
+}
+
+// SetServices sets the "services" field.
+func (_c *AgentInventoryCreate) SetServices(v []common.ServiceInfo) *AgentInventoryCreate {
+	_c.mutation.SetServices(v)
+	return _c
+}
+
+// SetPatches sets the "patches" field.
+func (_c *AgentInventoryCreate) SetPatches(v []common.PatchInfo) *AgentInventoryCreate {
+	_c.mutation.SetPatches(v)
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *AgentInventoryCreate) SetChanges(v common.InventoryChanges) *AgentInventoryCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_c *AgentInventoryCreate) SetNillableChanges(v *common.InventoryChanges) *AgentInventoryCreate {
+	if v != nil {
+		_c.SetChanges(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AgentInventoryCreate) SetCreatedAt(v time.Time) *AgentInventoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AgentInventoryCreate) SetNillableCreatedAt(v *time.Time) *AgentInventoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_c *AgentInventoryCreate) SetAgentID(id int) *AgentInventoryCreate {
+	_c.mutation.SetAgentID(id)
+	return _c
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_c *AgentInventoryCreate) SetAgent(v *Agent) *AgentInventoryCreate {
+	return _c.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentInventoryMutation object of the builder.
+func (_c *AgentInventoryCreate) Mutation() *AgentInventoryMutation {
+	return _c.mutation
+}
+
+// Save creates the AgentInventory in the database.
+func (_c *AgentInventoryCreate) Save(ctx context.Context) (*AgentInventory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AgentInventoryCreate) SaveX(ctx context.Context) *AgentInventory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentInventoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentInventoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AgentInventoryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := agentinventory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AgentInventoryCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "AgentInventory.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := agentinventory.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "AgentInventory.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "AgentInventory.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := agentinventory.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AgentInventory.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ent: missing required field "AgentInventory.collected_at"`)}
+	}
+	if _, ok := _c.mutation.ConfirmedAt(); !ok {
+		return &ValidationError{Name: "confirmed_at", err: errors.New(`ent: missing required field "AgentInventory.confirmed_at"`)}
+	}
+	if _, ok := _c.mutation.Host(); !ok {
+		return &ValidationError{Name: "host", err: errors.New(`ent: missing required field "AgentInventory.host"`)}
+	}
+	if _, ok := _c.mutation.Hardware(); !ok {
+		return &ValidationError{Name: "hardware", err: errors.New(`ent: missing required field "AgentInventory.hardware"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AgentInventory.created_at"`)}
+	}
+	if len(_c.mutation.AgentIDs()) == 0 {
+		return &ValidationError{Name: "agent", err: errors.New(`ent: missing required edge "AgentInventory.agent"`)}
+	}
+	return nil
+}
+
+func (_c *AgentInventoryCreate) sqlSave(ctx context.Context) (*AgentInventory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AgentInventoryCreate) createSpec() (*AgentInventory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentInventory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(agentinventory.Table, sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(agentinventory.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(agentinventory.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(agentinventory.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.ConfirmedAt(); ok {
+		_spec.SetField(agentinventory.FieldConfirmedAt, field.TypeTime, value)
+		_node.ConfirmedAt = value
+	}
+	if value, ok := _c.mutation.Host(); ok {
+		_spec.SetField(agentinventory.FieldHost, field.TypeJSON, value)
+		_node.Host = value
+	}
+	if value, ok := _c.mutation.Hardware(); ok {
+		_spec.SetField(agentinventory.FieldHardware, field.TypeJSON, value)
+		_node.Hardware = value
+	}
+	if value, ok := _c.mutation.Software(); ok {
+		_spec.SetField(agentinventory.FieldSoftware, field.TypeJSON, value)
+		_node.Software = value
+	}
+	if value, ok := _c.mutation.Services(); ok {
+		_spec.SetField(agentinventory.FieldServices, field.TypeJSON, value)
+		_node.Services = value
+	}
+	if value, ok := _c.mutation.Patches(); ok {
+		_spec.SetField(agentinventory.FieldPatches, field.TypeJSON, value)
+		_node.Patches = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(agentinventory.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(agentinventory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentinventory.AgentTable,
+			Columns: []string{agentinventory.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.agent_inventory = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AgentInventoryCreateBulk is the builder for creating many AgentInventory entities in bulk.
+type AgentInventoryCreateBulk struct {
+	config
+	err      error
+	builders []*AgentInventoryCreate
+}
+
+// Save creates the AgentInventory entities in the database.
+func (_c *AgentInventoryCreateBulk) Save(ctx context.Context) ([]*AgentInventory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AgentInventory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentInventoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AgentInventoryCreateBulk) SaveX(ctx context.Context) []*AgentInventory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentInventoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentInventoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,779 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentinventory"
+	"sent/ent/predicate"
+	"sent/pkg/pulse/common"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentInventoryUpdate is the builder for updating AgentInventory entities.
+type AgentInventoryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AgentInventoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AgentInventoryUpdate builder.
+func (_u *AgentInventoryUpdate) Where(ps ...predicate.AgentInventory) *AgentInventoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *AgentInventoryUpdate) SetVersion(v int) *AgentInventoryUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *AgentInventoryUpdate) SetNillableVersion(v *int) *AgentInventoryUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *AgentInventoryUpdate) AddVersion(v int) *AgentInventoryUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AgentInventoryUpdate) SetHash(v string) *AgentInventoryUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AgentInventoryUpdate) SetNillableHash(v *string) *AgentInventoryUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *AgentInventoryUpdate) SetCollectedAt(v time.Time) *AgentInventoryUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *AgentInventoryUpdate) SetNillableCollectedAt(v *time.Time) *AgentInventoryUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_u *AgentInventoryUpdate) SetConfirmedAt(v time.Time) *AgentInventoryUpdate {
+	_u.mutation.SetConfirmedAt(v)
+	return _u
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_u *AgentInventoryUpdate) SetNillableConfirmedAt(v *time.Time) *AgentInventoryUpdate {
+	if v != nil {
+		_u.SetConfirmedAt(*v)
+	}
+	return _u
+}
+
+// SetHost sets the "host" field.
+func (_u *AgentInventoryUpdate) SetHost(v common.HostInfo) *AgentInventoryUpdate {
+	_u.mutation.SetHost(v)
+	return _u
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (_u *AgentInventoryUpdate) SetNillableHost(v *common.HostInfo) *AgentInventoryUpdate {
+	if v != nil {
+		_u.SetHost(*v)
+	}
+	return _u
+}
+
+// SetHardware sets the "hardware" field.
+func (_u *AgentInventoryUpdate) SetHardware(v common.HardwareInfo) *AgentInventoryUpdate {
+	_u.mutation.SetHardware(v)
+	return _u
+}
+
+// SetNillableHardware sets the "hardware" field if the given value is not nil.
+func (_u *AgentInventoryUpdate) SetNillableHardware(v *common.HardwareInfo) *AgentInventoryUpdate {
+	if v != nil {
+		_u.SetHardware(*v)
+	}
+	return _u
+}
+
+// SetSoftware sets the "software" field.
+func (_u *AgentInventoryUpdate) SetSoftware(v []common.SoftwareInfo) *AgentInventoryUpdate {
+	_u.mutation.SetSoftware(v)
+	return _u
+}
+
+// AppendSoftware appends value to the "software" field.
+func (_u *AgentInventoryUpdate) AppendSoftware(v []common.SoftwareInfo) *AgentInventoryUpdate {
+	_u.mutation.AppendSoftware(v)
+	return _u
+}
+
+// ClearSoftware clears the value of the "software" field.
+func (_u *AgentInventoryUpdate) ClearSoftware() *AgentInventoryUpdate {
+	_u.mutation.ClearSoftware()
+	return _u
+}
+
+// SetServices sets the "services" field.
+func (_u *AgentInventoryUpdate) SetServices(v []common.ServiceInfo) *AgentInventoryUpdate {
+	_u.mutation.SetServices(v)
+	return _u
+}
+
+// AppendServices appends value to the "services" field.
+func (_u *AgentInventoryUpdate) AppendServices(v []common.ServiceInfo) *AgentInventoryUpdate {
+	_u.mutation.AppendServices(v)
+	return _u
+}
+
+// ClearServices clears the value of the "services" field.
+func (_u *AgentInventoryUpdate) ClearServices() *AgentInventoryUpdate {
+	_u.mutation.ClearServices()
+	return _u
+}
+
+// SetPatches sets the "patches" field.
+func (_u *AgentInventoryUpdate) SetPatches(v []common.PatchInfo) *AgentInventoryUpdate {
+	_u.mutation.SetPatches(v)
+	return _u
+}
+
+// AppendPatches appends value to the "patches" field.
+func (_u *AgentInventoryUpdate) AppendPatches(v []common.PatchInfo) *AgentInventoryUpdate {
+	_u.mutation.AppendPatches(v)
+	return _u
+}
+
+// ClearPatches clears the value of the "patches" field.
+func (_u *AgentInventoryUpdate) ClearPatches() *AgentInventoryUpdate {
+	_u.mutation.ClearPatches()
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *AgentInventoryUpdate) SetChanges(v common.InventoryChanges) *AgentInventoryUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_u *AgentInventoryUpdate) SetNillableChanges(v *common.InventoryChanges) *AgentInventoryUpdate {
+	if v != nil {
+		_u.SetChanges(*v)
+	}
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *AgentInventoryUpdate) ClearChanges() *AgentInventoryUpdate {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_u *AgentInventoryUpdate) SetAgentID(id int) *AgentInventoryUpdate {
+	_u.mutation.SetAgentID(id)
+	return _u
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_u *AgentInventoryUpdate) SetAgent(v *Agent) *AgentInventoryUpdate {
+	return _u.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentInventoryMutation object of the builder.
+func (_u *AgentInventoryUpdate) Mutation() *AgentInventoryMutation {
+	return _u.mutation
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (_u *AgentInventoryUpdate) ClearAgent() *AgentInventoryUpdate {
+	_u.mutation.ClearAgent()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AgentInventoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentInventoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AgentInventoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentInventoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentInventoryUpdate) check() error {
+	if v, ok := _u.mutation.Version(); ok {
+		if err := agentinventory.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "AgentInventory.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := agentinventory.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AgentInventory.hash": %w`, err)}
+		}
+	}
+	if _u.mutation.AgentCleared() && len(_u.mutation.AgentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentInventory.agent"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentInventoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentInventoryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentInventoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentinventory.Table, agentinventory.Columns, sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(agentinventory.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(agentinventory.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(agentinventory.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(agentinventory.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ConfirmedAt(); ok {
+		_spec.SetField(agentinventory.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Host(); ok {
+		_spec.SetField(agentinventory.FieldHost, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Hardware(); ok {
+		_spec.SetField(agentinventory.FieldHardware, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Software(); ok {
+		_spec.SetField(agentinventory.FieldSoftware, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSoftware(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agentinventory.FieldSoftware, value)
+		})
+	}
+	if _u.mutation.SoftwareCleared() {
+		_spec.ClearField(agentinventory.FieldSoftware, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Services(); ok {
+		_spec.SetField(agentinventory.FieldServices, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedServices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agentinventory.FieldServices, value)
+		})
+	}
+	if _u.mutation.ServicesCleared() {
+		_spec.ClearField(agentinventory.FieldServices, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Patches(); ok {
+		_spec.SetField(agentinventory.FieldPatches, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPatches(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agentinventory.FieldPatches, value)
+		})
+	}
+	if _u.mutation.PatchesCleared() {
+		_spec.ClearField(agentinventory.FieldPatches, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(agentinventory.FieldChanges, field.TypeJSON, value)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(agentinventory.FieldChanges, field.TypeJSON)
+	}
+	if _u.mutation.AgentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentinventory.AgentTable,
+			Columns: []string{agentinventory.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentinventory.AgentTable,
+			Columns: []string{agentinventory.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentinventory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AgentInventoryUpdateOne is the builder for updating a single AgentInventory entity.
+type AgentInventoryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentInventoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetVersion sets the "version" field.
+func (_u *AgentInventoryUpdateOne) SetVersion(v int) *AgentInventoryUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *AgentInventoryUpdateOne) SetNillableVersion(v *int) *AgentInventoryUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *AgentInventoryUpdateOne) AddVersion(v int) *AgentInventoryUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AgentInventoryUpdateOne) SetHash(v string) *AgentInventoryUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AgentInventoryUpdateOne) SetNillableHash(v *string) *AgentInventoryUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *AgentInventoryUpdateOne) SetCollectedAt(v time.Time) *AgentInventoryUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *AgentInventoryUpdateOne) SetNillableCollectedAt(v *time.Time) *AgentInventoryUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_u *AgentInventoryUpdateOne) SetConfirmedAt(v time.Time) *AgentInventoryUpdateOne {
+	_u.mutation.SetConfirmedAt(v)
+	return _u
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_u *AgentInventoryUpdateOne) SetNillableConfirmedAt(v *time.Time) *AgentInventoryUpdateOne {
+	if v != nil {
+		_u.SetConfirmedAt(*v)
+	}
+	return _u
+}
+
+// SetHost sets the "host" field.
+func (_u *AgentInventoryUpdateOne) SetHost(v common.HostInfo) *AgentInventoryUpdateOne {
+	_u.mutation.SetHost(v)
+	return _u
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (_u *AgentInventoryUpdateOne) SetNillableHost(v *common.HostInfo) *AgentInventoryUpdateOne {
+	if v != nil {
+		_u.SetHost(*v)
+	}
+	return _u
+}
+
+// SetHardware sets the "hardware" field.
+func (_u *AgentInventoryUpdateOne) SetHardware(v common.HardwareInfo) *AgentInventoryUpdateOne {
+	_u.mutation.SetHardware(v)
+	return _u
+}
+
+// SetNillableHardware sets the "hardware" field if the given value is not nil.
+func (_u *AgentInventoryUpdateOne) SetNillableHardware(v *common.HardwareInfo) *AgentInventoryUpdateOne {
+	if v != nil {
+		_u.SetHardware(*v)
+	}
+	return _u
+}
+
+// SetSoftware sets the "software" field.
+func (_u *AgentInventoryUpdateOne) SetSoftware(v []common.SoftwareInfo) *AgentInventoryUpdateOne {
+	_u.mutation.SetSoftware(v)
+	return _u
+}
+
+// AppendSoftware appends value to the "software" field.
+func (_u *AgentInventoryUpdateOne) AppendSoftware(v []common.SoftwareInfo) *AgentInventoryUpdateOne {
+	_u.mutation.AppendSoftware(v)
+	return _u
+}
+
+// ClearSoftware clears the value of the "software" field.
+func (_u *AgentInventoryUpdateOne) ClearSoftware() *AgentInventoryUpdateOne {
+	_u.mutation.ClearSoftware()
+	return _u
+}
+
+// SetServices sets the "services" field.
+func (_u *AgentInventoryUpdateOne) SetServices(v []common.ServiceInfo) *AgentInventoryUpdateOne {
+	_u.mutation.SetServices(v)
+	return _u
+}
+
+// AppendServices appends value to the "services" field.
+func (_u *AgentInventoryUpdateOne) AppendServices(v []common.ServiceInfo) *AgentInventoryUpdateOne {
+	_u.mutation.AppendServices(v)
+	return _u
+}
+
+// ClearServices clears the value of the "services" field.
+func (_u *AgentInventoryUpdateOne) ClearServices() *AgentInventoryUpdateOne {
+	_u.mutation.ClearServices()
+	return _u
+}
+
+// SetPatches sets the "patches" field.
+func (_u *AgentInventoryUpdateOne) SetPatches(v []common.PatchInfo) *AgentInventoryUpdateOne {
+	_u.mutation.SetPatches(v)
+	return _u
+}
+
+// AppendPatches appends value to the "patches" field.
+func (_u *AgentInventoryUpdateOne) AppendPatches(v []common.PatchInfo) *AgentInventoryUpdateOne {
+	_u.mutation.AppendPatches(v)
+	return _u
+}
+
+// ClearPatches clears the value of the "patches" field.
+func (_u *AgentInventoryUpdateOne) ClearPatches() *AgentInventoryUpdateOne {
+	_u.mutation.ClearPatches()
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *AgentInventoryUpdateOne) SetChanges(v common.InventoryChanges) *AgentInventoryUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_u *AgentInventoryUpdateOne) SetNillableChanges(v *common.InventoryChanges) *AgentInventoryUpdateOne {
+	if v != nil {
+		_u.SetChanges(*v)
+	}
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *AgentInventoryUpdateOne) ClearChanges() *AgentInventoryUpdateOne {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_u *AgentInventoryUpdateOne) SetAgentID(id int) *AgentInventoryUpdateOne {
+	_u.mutation.SetAgentID(id)
+	return _u
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_u *AgentInventoryUpdateOne) SetAgent(v *Agent) *AgentInventoryUpdateOne {
+	return _u.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentInventoryMutation object of the builder.
+func (_u *AgentInventoryUpdateOne) Mutation() *AgentInventoryMutation {
+	return _u.mutation
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (_u *AgentInventoryUpdateOne) ClearAgent() *AgentInventoryUpdateOne {
+	_u.mutation.ClearAgent()
+	return _u
+}
+
+// Where appends a list predicates to the AgentInventoryUpdate builder.
+func (_u *AgentInventoryUpdateOne) Where(ps ...predicate.AgentInventory) *AgentInventoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AgentInventoryUpdateOne) Select(field string, fields ...string) *AgentInventoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AgentInventory entity.
+func (_u *AgentInventoryUpdateOne) Save(ctx context.Context) (*AgentInventory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentInventoryUpdateOne) SaveX(ctx context.Context) *AgentInventory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AgentInventoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentInventoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentInventoryUpdateOne) check() error {
+	if v, ok := _u.mutation.Version(); ok {
+		if err := agentinventory.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "AgentInventory.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := agentinventory.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AgentInventory.hash": %w`, err)}
+		}
+	}
+	if _u.mutation.AgentCleared() && len(_u.mutation.AgentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentInventory.agent"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentInventoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentInventoryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentInventoryUpdateOne) sqlSave(ctx context.Context) (_node *AgentInventory, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentinventory.Table, agentinventory.Columns, sqlgraph.NewFieldSpec(agentinventory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentInventory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentinventory.FieldID)
+		for _, f := range fields {
+			if !agentinventory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentinventory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(agentinventory.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(agentinventory.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(agentinventory.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(agentinventory.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ConfirmedAt(); ok {
+		_spec.SetField(agentinventory.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Host(); ok {
+		_spec.SetField(agentinventory.FieldHost, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Hardware(); ok {
+		_spec.SetField(agentinventory.FieldHardware, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Software(); ok {
+		_spec.SetField(agentinventory.FieldSoftware, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSoftware(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agentinventory.FieldSoftware, value)
+		})
+	}
+	if _u.mutation.SoftwareCleared() {
+		_spec.ClearField(agentinventory.FieldSoftware, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Services(); ok {
+		_spec.SetField(agentinventory.FieldServices, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedServices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agentinventory.FieldServices, value)
+		})
+	}
+	if _u.mutation.ServicesCleared() {
+		_spec.ClearField(agentinventory.FieldServices, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Patches(); ok {
+		_spec.SetField(agentinventory.FieldPatches, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPatches(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agentinventory.FieldPatches, value)
+		})
+	}
+	if _u.mutation.PatchesCleared() {
+		_spec.ClearField(agentinventory.FieldPatches, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(agentinventory.FieldChanges, field.TypeJSON, value)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(agentinventory.FieldChanges, field.TypeJSON)
+	}
+	if _u.mutation.AgentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentinventory.AgentTable,
+			Columns: []string{agentinventory.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentinventory.AgentTable,
+			Columns: []string{agentinventory.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AgentInventory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentinventory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
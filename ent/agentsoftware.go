@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentsoftware"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentSoftware is the model entity for the AgentSoftware schema.
+type AgentSoftware struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// Publisher holds the value of the "publisher" field.
+	Publisher string `json:"publisher,omitempty"`
+	// InstallDate holds the value of the "install_date" field.
+	InstallDate string `json:"install_date,omitempty"`
+	// FirstSeen holds the value of the "first_seen" field.
+	FirstSeen time.Time `json:"first_seen,omitempty"`
+	// RemovedAt holds the value of the "removed_at" field.
+	RemovedAt *time.Time `json:"removed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AgentSoftwareQuery when eager-loading is set.
+	Edges          AgentSoftwareEdges `json:"edges"`
+	agent_software *int
+	selectValues   sql.SelectValues
+}
+
+// AgentSoftwareEdges holds the relations/edges for other nodes in the graph.
+type AgentSoftwareEdges struct {
+	// Agent holds the value of the agent edge.
+	Agent *Agent `json:"agent,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AgentOrErr returns the Agent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentSoftwareEdges) AgentOrErr() (*Agent, error) {
+	if e.Agent != nil {
+		return e.Agent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: agent.Label}
+	}
+	return nil, &NotLoadedError{edge: "agent"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentSoftware) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentsoftware.FieldID:
+			values[i] = new(sql.NullInt64)
+		case agentsoftware.FieldName, agentsoftware.FieldVersion, agentsoftware.FieldPublisher, agentsoftware.FieldInstallDate:
+			values[i] = new(sql.NullString)
+		case agentsoftware.FieldFirstSeen, agentsoftware.FieldRemovedAt:
+			values[i] = new(sql.NullTime)
+		case agentsoftware.ForeignKeys[0]: // agent_software
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentSoftware fields.
+func (_m *AgentSoftware) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentsoftware.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case agentsoftware.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case agentsoftware.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.String
+			}
+		case agentsoftware.FieldPublisher:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher", values[i])
+			} else if value.Valid {
+				_m.Publisher = value.String
+			}
+		case agentsoftware.FieldInstallDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field install_date", values[i])
+			} else if value.Valid {
+				_m.InstallDate = value.String
+			}
+		case agentsoftware.FieldFirstSeen:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen", values[i])
+			} else if value.Valid {
+				_m.FirstSeen = value.Time
+			}
+		case agentsoftware.FieldRemovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field removed_at", values[i])
+			} else if value.Valid {
+				_m.RemovedAt = new(time.Time)
+				*_m.RemovedAt = value.Time
+			}
+		case agentsoftware.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field agent_software", value)
+			} else if value.Valid {
+				_m.agent_software = new(int)
+				*_m.agent_software = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AgentSoftware.
+// This includes values selected through modifiers, order, etc.
+func (_m *AgentSoftware) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAgent queries the "agent" edge of the AgentSoftware entity.
+func (_m *AgentSoftware) QueryAgent() *AgentQuery {
+	return NewAgentSoftwareClient(_m.config).QueryAgent(_m)
+}
+
+// Update returns a builder for updating this AgentSoftware.
+// Note that you need to call AgentSoftware.Unwrap() before calling this method if this AgentSoftware
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AgentSoftware) Update() *AgentSoftwareUpdateOne {
+	return NewAgentSoftwareClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AgentSoftware entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AgentSoftware) Unwrap() *AgentSoftware {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentSoftware is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AgentSoftware) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentSoftware(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(_m.Version)
+	builder.WriteString(", ")
+	builder.WriteString("publisher=")
+	builder.WriteString(_m.Publisher)
+	builder.WriteString(", ")
+	builder.WriteString("install_date=")
+	builder.WriteString(_m.InstallDate)
+	builder.WriteString(", ")
+	builder.WriteString("first_seen=")
+	builder.WriteString(_m.FirstSeen.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RemovedAt; v != nil {
+		builder.WriteString("removed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AgentSoftwares is a parsable slice of AgentSoftware.
+type AgentSoftwares []*AgentSoftware
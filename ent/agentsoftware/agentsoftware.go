@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package agentsoftware
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the agentsoftware type in the database.
+	Label = "agent_software"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldPublisher holds the string denoting the publisher field in the database.
+	FieldPublisher = "publisher"
+	// FieldInstallDate holds the string denoting the install_date field in the database.
+	FieldInstallDate = "install_date"
+	// FieldFirstSeen holds the string denoting the first_seen field in the database.
+	FieldFirstSeen = "first_seen"
+	// FieldRemovedAt holds the string denoting the removed_at field in the database.
+	FieldRemovedAt = "removed_at"
+	// EdgeAgent holds the string denoting the agent edge name in mutations.
+	EdgeAgent = "agent"
+	// Table holds the table name of the agentsoftware in the database.
+	Table = "agent_softwares"
+	// AgentTable is the table that holds the agent relation/edge.
+	AgentTable = "agent_softwares"
+	// AgentInverseTable is the table name for the Agent entity.
+	// It exists in this package in order to avoid circular dependency with the "agent" package.
+	AgentInverseTable = "agents"
+	// AgentColumn is the table column denoting the agent relation/edge.
+	AgentColumn = "agent_software"
+)
+
+// Columns holds all SQL columns for agentsoftware fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldVersion,
+	FieldPublisher,
+	FieldInstallDate,
+	FieldFirstSeen,
+	FieldRemovedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "agent_softwares"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"agent_software",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultFirstSeen holds the default value on creation for the "first_seen" field.
+	DefaultFirstSeen func() time.Time
+)
+
+// OrderOption defines the ordering options for the AgentSoftware queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByPublisher orders the results by the publisher field.
+func ByPublisher(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublisher, opts...).ToFunc()
+}
+
+// ByInstallDate orders the results by the install_date field.
+func ByInstallDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstallDate, opts...).ToFunc()
+}
+
+// ByFirstSeen orders the results by the first_seen field.
+func ByFirstSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeen, opts...).ToFunc()
+}
+
+// ByRemovedAt orders the results by the removed_at field.
+func ByRemovedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemovedAt, opts...).ToFunc()
+}
+
+// ByAgentField orders the results by agent field.
+func ByAgentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAgentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAgentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AgentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AgentTable, AgentColumn),
+	)
+}
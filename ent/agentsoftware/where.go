@@ -0,0 +1,504 @@
+// Code generated by ent, DO NOT EDIT.
+
+package agentsoftware
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldVersion, v))
+}
+
+// Publisher applies equality check predicate on the "publisher" field. It's identical to PublisherEQ.
+func Publisher(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldPublisher, v))
+}
+
+// InstallDate applies equality check predicate on the "install_date" field. It's identical to InstallDateEQ.
+func InstallDate(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldInstallDate, v))
+}
+
+// FirstSeen applies equality check predicate on the "first_seen" field. It's identical to FirstSeenEQ.
+func FirstSeen(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldFirstSeen, v))
+}
+
+// RemovedAt applies equality check predicate on the "removed_at" field. It's identical to RemovedAtEQ.
+func RemovedAt(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldRemovedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionIsNil applies the IsNil predicate on the "version" field.
+func VersionIsNil() predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIsNull(FieldVersion))
+}
+
+// VersionNotNil applies the NotNil predicate on the "version" field.
+func VersionNotNil() predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotNull(FieldVersion))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// PublisherEQ applies the EQ predicate on the "publisher" field.
+func PublisherEQ(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldPublisher, v))
+}
+
+// PublisherNEQ applies the NEQ predicate on the "publisher" field.
+func PublisherNEQ(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNEQ(FieldPublisher, v))
+}
+
+// PublisherIn applies the In predicate on the "publisher" field.
+func PublisherIn(vs ...string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIn(FieldPublisher, vs...))
+}
+
+// PublisherNotIn applies the NotIn predicate on the "publisher" field.
+func PublisherNotIn(vs ...string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotIn(FieldPublisher, vs...))
+}
+
+// PublisherGT applies the GT predicate on the "publisher" field.
+func PublisherGT(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGT(FieldPublisher, v))
+}
+
+// PublisherGTE applies the GTE predicate on the "publisher" field.
+func PublisherGTE(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGTE(FieldPublisher, v))
+}
+
+// PublisherLT applies the LT predicate on the "publisher" field.
+func PublisherLT(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLT(FieldPublisher, v))
+}
+
+// PublisherLTE applies the LTE predicate on the "publisher" field.
+func PublisherLTE(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLTE(FieldPublisher, v))
+}
+
+// PublisherContains applies the Contains predicate on the "publisher" field.
+func PublisherContains(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldContains(FieldPublisher, v))
+}
+
+// PublisherHasPrefix applies the HasPrefix predicate on the "publisher" field.
+func PublisherHasPrefix(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldHasPrefix(FieldPublisher, v))
+}
+
+// PublisherHasSuffix applies the HasSuffix predicate on the "publisher" field.
+func PublisherHasSuffix(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldHasSuffix(FieldPublisher, v))
+}
+
+// PublisherIsNil applies the IsNil predicate on the "publisher" field.
+func PublisherIsNil() predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIsNull(FieldPublisher))
+}
+
+// PublisherNotNil applies the NotNil predicate on the "publisher" field.
+func PublisherNotNil() predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotNull(FieldPublisher))
+}
+
+// PublisherEqualFold applies the EqualFold predicate on the "publisher" field.
+func PublisherEqualFold(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEqualFold(FieldPublisher, v))
+}
+
+// PublisherContainsFold applies the ContainsFold predicate on the "publisher" field.
+func PublisherContainsFold(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldContainsFold(FieldPublisher, v))
+}
+
+// InstallDateEQ applies the EQ predicate on the "install_date" field.
+func InstallDateEQ(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldInstallDate, v))
+}
+
+// InstallDateNEQ applies the NEQ predicate on the "install_date" field.
+func InstallDateNEQ(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNEQ(FieldInstallDate, v))
+}
+
+// InstallDateIn applies the In predicate on the "install_date" field.
+func InstallDateIn(vs ...string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIn(FieldInstallDate, vs...))
+}
+
+// InstallDateNotIn applies the NotIn predicate on the "install_date" field.
+func InstallDateNotIn(vs ...string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotIn(FieldInstallDate, vs...))
+}
+
+// InstallDateGT applies the GT predicate on the "install_date" field.
+func InstallDateGT(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGT(FieldInstallDate, v))
+}
+
+// InstallDateGTE applies the GTE predicate on the "install_date" field.
+func InstallDateGTE(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGTE(FieldInstallDate, v))
+}
+
+// InstallDateLT applies the LT predicate on the "install_date" field.
+func InstallDateLT(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLT(FieldInstallDate, v))
+}
+
+// InstallDateLTE applies the LTE predicate on the "install_date" field.
+func InstallDateLTE(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLTE(FieldInstallDate, v))
+}
+
+// InstallDateContains applies the Contains predicate on the "install_date" field.
+func InstallDateContains(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldContains(FieldInstallDate, v))
+}
+
+// InstallDateHasPrefix applies the HasPrefix predicate on the "install_date" field.
+func InstallDateHasPrefix(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldHasPrefix(FieldInstallDate, v))
+}
+
+// InstallDateHasSuffix applies the HasSuffix predicate on the "install_date" field.
+func InstallDateHasSuffix(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldHasSuffix(FieldInstallDate, v))
+}
+
+// InstallDateIsNil applies the IsNil predicate on the "install_date" field.
+func InstallDateIsNil() predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIsNull(FieldInstallDate))
+}
+
+// InstallDateNotNil applies the NotNil predicate on the "install_date" field.
+func InstallDateNotNil() predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotNull(FieldInstallDate))
+}
+
+// InstallDateEqualFold applies the EqualFold predicate on the "install_date" field.
+func InstallDateEqualFold(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEqualFold(FieldInstallDate, v))
+}
+
+// InstallDateContainsFold applies the ContainsFold predicate on the "install_date" field.
+func InstallDateContainsFold(v string) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldContainsFold(FieldInstallDate, v))
+}
+
+// FirstSeenEQ applies the EQ predicate on the "first_seen" field.
+func FirstSeenEQ(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldFirstSeen, v))
+}
+
+// FirstSeenNEQ applies the NEQ predicate on the "first_seen" field.
+func FirstSeenNEQ(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNEQ(FieldFirstSeen, v))
+}
+
+// FirstSeenIn applies the In predicate on the "first_seen" field.
+func FirstSeenIn(vs ...time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIn(FieldFirstSeen, vs...))
+}
+
+// FirstSeenNotIn applies the NotIn predicate on the "first_seen" field.
+func FirstSeenNotIn(vs ...time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotIn(FieldFirstSeen, vs...))
+}
+
+// FirstSeenGT applies the GT predicate on the "first_seen" field.
+func FirstSeenGT(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGT(FieldFirstSeen, v))
+}
+
+// FirstSeenGTE applies the GTE predicate on the "first_seen" field.
+func FirstSeenGTE(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGTE(FieldFirstSeen, v))
+}
+
+// FirstSeenLT applies the LT predicate on the "first_seen" field.
+func FirstSeenLT(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLT(FieldFirstSeen, v))
+}
+
+// FirstSeenLTE applies the LTE predicate on the "first_seen" field.
+func FirstSeenLTE(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLTE(FieldFirstSeen, v))
+}
+
+// RemovedAtEQ applies the EQ predicate on the "removed_at" field.
+func RemovedAtEQ(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldEQ(FieldRemovedAt, v))
+}
+
+// RemovedAtNEQ applies the NEQ predicate on the "removed_at" field.
+func RemovedAtNEQ(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNEQ(FieldRemovedAt, v))
+}
+
+// RemovedAtIn applies the In predicate on the "removed_at" field.
+func RemovedAtIn(vs ...time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIn(FieldRemovedAt, vs...))
+}
+
+// RemovedAtNotIn applies the NotIn predicate on the "removed_at" field.
+func RemovedAtNotIn(vs ...time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotIn(FieldRemovedAt, vs...))
+}
+
+// RemovedAtGT applies the GT predicate on the "removed_at" field.
+func RemovedAtGT(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGT(FieldRemovedAt, v))
+}
+
+// RemovedAtGTE applies the GTE predicate on the "removed_at" field.
+func RemovedAtGTE(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldGTE(FieldRemovedAt, v))
+}
+
+// RemovedAtLT applies the LT predicate on the "removed_at" field.
+func RemovedAtLT(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLT(FieldRemovedAt, v))
+}
+
+// RemovedAtLTE applies the LTE predicate on the "removed_at" field.
+func RemovedAtLTE(v time.Time) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldLTE(FieldRemovedAt, v))
+}
+
+// RemovedAtIsNil applies the IsNil predicate on the "removed_at" field.
+func RemovedAtIsNil() predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldIsNull(FieldRemovedAt))
+}
+
+// RemovedAtNotNil applies the NotNil predicate on the "removed_at" field.
+func RemovedAtNotNil() predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.FieldNotNull(FieldRemovedAt))
+}
+
+// HasAgent applies the HasEdge predicate on the "agent" edge.
+func HasAgent() predicate.AgentSoftware {
+	return predicate.AgentSoftware(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AgentTable, AgentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAgentWith applies the HasEdge predicate on the "agent" edge with a given conditions (other predicates).
+func HasAgentWith(preds ...predicate.Agent) predicate.AgentSoftware {
+	return predicate.AgentSoftware(func(s *sql.Selector) {
+		step := newAgentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AgentSoftware) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AgentSoftware) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AgentSoftware) predicate.AgentSoftware {
+	return predicate.AgentSoftware(sql.NotPredicates(p))
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentsoftware"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentSoftwareCreate is the builder for creating a AgentSoftware entity.
+type AgentSoftwareCreate struct {
+	config
+	mutation *AgentSoftwareMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *AgentSoftwareCreate) SetName(v string) *AgentSoftwareCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *AgentSoftwareCreate) SetVersion(v string) *AgentSoftwareCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *AgentSoftwareCreate) SetNillableVersion(v *string) *AgentSoftwareCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetPublisher sets the "publisher" field.
+func (_c *AgentSoftwareCreate) SetPublisher(v string) *AgentSoftwareCreate {
+	_c.mutation.SetPublisher(v)
+	return _c
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_c *AgentSoftwareCreate) SetNillablePublisher(v *string) *AgentSoftwareCreate {
+	if v != nil {
+		_c.SetPublisher(*v)
+	}
+	return _c
+}
+
+// SetInstallDate sets the "install_date" field.
+func (_c *AgentSoftwareCreate) SetInstallDate(v string) *AgentSoftwareCreate {
+	_c.mutation.SetInstallDate(v)
+	return _c
+}
+
+// SetNillableInstallDate sets the "install_date" field if the given value is not nil.
+func (_c *AgentSoftwareCreate) SetNillableInstallDate(v *string) *AgentSoftwareCreate {
+	if v != nil {
+		_c.SetInstallDate(*v)
+	}
+	return _c
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (_c *AgentSoftwareCreate) SetFirstSeen(v time.Time) *AgentSoftwareCreate {
+	_c.mutation.SetFirstSeen(v)
+	return _c
+}
+
+// SetNillableFirstSeen sets the "first_seen" field if the given value is not nil.
+func (_c *AgentSoftwareCreate) SetNillableFirstSeen(v *time.Time) *AgentSoftwareCreate {
+	if v != nil {
+		_c.SetFirstSeen(*v)
+	}
+	return _c
+}
+
+// SetRemovedAt sets the "removed_at" field.
+func (_c *AgentSoftwareCreate) SetRemovedAt(v time.Time) *AgentSoftwareCreate {
+	_c.mutation.SetRemovedAt(v)
+	return _c
+}
+
+// SetNillableRemovedAt sets the "removed_at" field if the given value is not nil.
+func (_c *AgentSoftwareCreate) SetNillableRemovedAt(v *time.Time) *AgentSoftwareCreate {
+	if v != nil {
+		_c.SetRemovedAt(*v)
+	}
+	return _c
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_c *AgentSoftwareCreate) SetAgentID(id int) *AgentSoftwareCreate {
+	_c.mutation.SetAgentID(id)
+	return _c
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_c *AgentSoftwareCreate) SetAgent(v *Agent) *AgentSoftwareCreate {
+	return _c.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentSoftwareMutation object of the builder.
+func (_c *AgentSoftwareCreate) Mutation() *AgentSoftwareMutation {
+	return _c.mutation
+}
+
+// Save creates the AgentSoftware in the database.
+func (_c *AgentSoftwareCreate) Save(ctx context.Context) (*AgentSoftware, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AgentSoftwareCreate) SaveX(ctx context.Context) *AgentSoftware {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentSoftwareCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentSoftwareCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AgentSoftwareCreate) defaults() {
+	if _, ok := _c.mutation.FirstSeen(); !ok {
+		v := agentsoftware.DefaultFirstSeen()
+		_c.mutation.SetFirstSeen(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AgentSoftwareCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AgentSoftware.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := agentsoftware.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AgentSoftware.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FirstSeen(); !ok {
+		return &ValidationError{Name: "first_seen", err: errors.New(`ent: missing required field "AgentSoftware.first_seen"`)}
+	}
+	if len(_c.mutation.AgentIDs()) == 0 {
+		return &ValidationError{Name: "agent", err: errors.New(`ent: missing required edge "AgentSoftware.agent"`)}
+	}
+	return nil
+}
+
+func (_c *AgentSoftwareCreate) sqlSave(ctx context.Context) (*AgentSoftware, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AgentSoftwareCreate) createSpec() (*AgentSoftware, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentSoftware{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(agentsoftware.Table, sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(agentsoftware.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(agentsoftware.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Publisher(); ok {
+		_spec.SetField(agentsoftware.FieldPublisher, field.TypeString, value)
+		_node.Publisher = value
+	}
+	if value, ok := _c.mutation.InstallDate(); ok {
+		_spec.SetField(agentsoftware.FieldInstallDate, field.TypeString, value)
+		_node.InstallDate = value
+	}
+	if value, ok := _c.mutation.FirstSeen(); ok {
+		_spec.SetField(agentsoftware.FieldFirstSeen, field.TypeTime, value)
+		_node.FirstSeen = value
+	}
+	if value, ok := _c.mutation.RemovedAt(); ok {
+		_spec.SetField(agentsoftware.FieldRemovedAt, field.TypeTime, value)
+		_node.RemovedAt = &value
+	}
+	if nodes := _c.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentsoftware.AgentTable,
+			Columns: []string{agentsoftware.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.agent_software = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AgentSoftwareCreateBulk is the builder for creating many AgentSoftware entities in bulk.
+type AgentSoftwareCreateBulk struct {
+	config
+	err      error
+	builders []*AgentSoftwareCreate
+}
+
+// Save creates the AgentSoftware entities in the database.
+func (_c *AgentSoftwareCreateBulk) Save(ctx context.Context) ([]*AgentSoftware, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AgentSoftware, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentSoftwareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AgentSoftwareCreateBulk) SaveX(ctx context.Context) []*AgentSoftware {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AgentSoftwareCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AgentSoftwareCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
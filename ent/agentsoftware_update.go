@@ -0,0 +1,593 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agent"
+	"sent/ent/agentsoftware"
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentSoftwareUpdate is the builder for updating AgentSoftware entities.
+type AgentSoftwareUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AgentSoftwareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AgentSoftwareUpdate builder.
+func (_u *AgentSoftwareUpdate) Where(ps ...predicate.AgentSoftware) *AgentSoftwareUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AgentSoftwareUpdate) SetName(v string) *AgentSoftwareUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AgentSoftwareUpdate) SetNillableName(v *string) *AgentSoftwareUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *AgentSoftwareUpdate) SetVersion(v string) *AgentSoftwareUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *AgentSoftwareUpdate) SetNillableVersion(v *string) *AgentSoftwareUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (_u *AgentSoftwareUpdate) ClearVersion() *AgentSoftwareUpdate {
+	_u.mutation.ClearVersion()
+	return _u
+}
+
+// SetPublisher sets the "publisher" field.
+func (_u *AgentSoftwareUpdate) SetPublisher(v string) *AgentSoftwareUpdate {
+	_u.mutation.SetPublisher(v)
+	return _u
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_u *AgentSoftwareUpdate) SetNillablePublisher(v *string) *AgentSoftwareUpdate {
+	if v != nil {
+		_u.SetPublisher(*v)
+	}
+	return _u
+}
+
+// ClearPublisher clears the value of the "publisher" field.
+func (_u *AgentSoftwareUpdate) ClearPublisher() *AgentSoftwareUpdate {
+	_u.mutation.ClearPublisher()
+	return _u
+}
+
+// SetInstallDate sets the "install_date" field.
+func (_u *AgentSoftwareUpdate) SetInstallDate(v string) *AgentSoftwareUpdate {
+	_u.mutation.SetInstallDate(v)
+	return _u
+}
+
+// SetNillableInstallDate sets the "install_date" field if the given value is not nil.
+func (_u *AgentSoftwareUpdate) SetNillableInstallDate(v *string) *AgentSoftwareUpdate {
+	if v != nil {
+		_u.SetInstallDate(*v)
+	}
+	return _u
+}
+
+// ClearInstallDate clears the value of the "install_date" field.
+func (_u *AgentSoftwareUpdate) ClearInstallDate() *AgentSoftwareUpdate {
+	_u.mutation.ClearInstallDate()
+	return _u
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (_u *AgentSoftwareUpdate) SetFirstSeen(v time.Time) *AgentSoftwareUpdate {
+	_u.mutation.SetFirstSeen(v)
+	return _u
+}
+
+// SetNillableFirstSeen sets the "first_seen" field if the given value is not nil.
+func (_u *AgentSoftwareUpdate) SetNillableFirstSeen(v *time.Time) *AgentSoftwareUpdate {
+	if v != nil {
+		_u.SetFirstSeen(*v)
+	}
+	return _u
+}
+
+// SetRemovedAt sets the "removed_at" field.
+func (_u *AgentSoftwareUpdate) SetRemovedAt(v time.Time) *AgentSoftwareUpdate {
+	_u.mutation.SetRemovedAt(v)
+	return _u
+}
+
+// SetNillableRemovedAt sets the "removed_at" field if the given value is not nil.
+func (_u *AgentSoftwareUpdate) SetNillableRemovedAt(v *time.Time) *AgentSoftwareUpdate {
+	if v != nil {
+		_u.SetRemovedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemovedAt clears the value of the "removed_at" field.
+func (_u *AgentSoftwareUpdate) ClearRemovedAt() *AgentSoftwareUpdate {
+	_u.mutation.ClearRemovedAt()
+	return _u
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_u *AgentSoftwareUpdate) SetAgentID(id int) *AgentSoftwareUpdate {
+	_u.mutation.SetAgentID(id)
+	return _u
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_u *AgentSoftwareUpdate) SetAgent(v *Agent) *AgentSoftwareUpdate {
+	return _u.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentSoftwareMutation object of the builder.
+func (_u *AgentSoftwareUpdate) Mutation() *AgentSoftwareMutation {
+	return _u.mutation
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (_u *AgentSoftwareUpdate) ClearAgent() *AgentSoftwareUpdate {
+	_u.mutation.ClearAgent()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AgentSoftwareUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentSoftwareUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AgentSoftwareUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentSoftwareUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentSoftwareUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := agentsoftware.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AgentSoftware.name": %w`, err)}
+		}
+	}
+	if _u.mutation.AgentCleared() && len(_u.mutation.AgentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentSoftware.agent"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSoftwareUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSoftwareUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentSoftwareUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentsoftware.Table, agentsoftware.Columns, sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(agentsoftware.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(agentsoftware.FieldVersion, field.TypeString, value)
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(agentsoftware.FieldVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Publisher(); ok {
+		_spec.SetField(agentsoftware.FieldPublisher, field.TypeString, value)
+	}
+	if _u.mutation.PublisherCleared() {
+		_spec.ClearField(agentsoftware.FieldPublisher, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstallDate(); ok {
+		_spec.SetField(agentsoftware.FieldInstallDate, field.TypeString, value)
+	}
+	if _u.mutation.InstallDateCleared() {
+		_spec.ClearField(agentsoftware.FieldInstallDate, field.TypeString)
+	}
+	if value, ok := _u.mutation.FirstSeen(); ok {
+		_spec.SetField(agentsoftware.FieldFirstSeen, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RemovedAt(); ok {
+		_spec.SetField(agentsoftware.FieldRemovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemovedAtCleared() {
+		_spec.ClearField(agentsoftware.FieldRemovedAt, field.TypeTime)
+	}
+	if _u.mutation.AgentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentsoftware.AgentTable,
+			Columns: []string{agentsoftware.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentsoftware.AgentTable,
+			Columns: []string{agentsoftware.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentsoftware.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AgentSoftwareUpdateOne is the builder for updating a single AgentSoftware entity.
+type AgentSoftwareUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentSoftwareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *AgentSoftwareUpdateOne) SetName(v string) *AgentSoftwareUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AgentSoftwareUpdateOne) SetNillableName(v *string) *AgentSoftwareUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *AgentSoftwareUpdateOne) SetVersion(v string) *AgentSoftwareUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *AgentSoftwareUpdateOne) SetNillableVersion(v *string) *AgentSoftwareUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (_u *AgentSoftwareUpdateOne) ClearVersion() *AgentSoftwareUpdateOne {
+	_u.mutation.ClearVersion()
+	return _u
+}
+
+// SetPublisher sets the "publisher" field.
+func (_u *AgentSoftwareUpdateOne) SetPublisher(v string) *AgentSoftwareUpdateOne {
+	_u.mutation.SetPublisher(v)
+	return _u
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_u *AgentSoftwareUpdateOne) SetNillablePublisher(v *string) *AgentSoftwareUpdateOne {
+	if v != nil {
+		_u.SetPublisher(*v)
+	}
+	return _u
+}
+
+// ClearPublisher clears the value of the "publisher" field.
+func (_u *AgentSoftwareUpdateOne) ClearPublisher() *AgentSoftwareUpdateOne {
+	_u.mutation.ClearPublisher()
+	return _u
+}
+
+// SetInstallDate sets the "install_date" field.
+func (_u *AgentSoftwareUpdateOne) SetInstallDate(v string) *AgentSoftwareUpdateOne {
+	_u.mutation.SetInstallDate(v)
+	return _u
+}
+
+// SetNillableInstallDate sets the "install_date" field if the given value is not nil.
+func (_u *AgentSoftwareUpdateOne) SetNillableInstallDate(v *string) *AgentSoftwareUpdateOne {
+	if v != nil {
+		_u.SetInstallDate(*v)
+	}
+	return _u
+}
+
+// ClearInstallDate clears the value of the "install_date" field.
+func (_u *AgentSoftwareUpdateOne) ClearInstallDate() *AgentSoftwareUpdateOne {
+	_u.mutation.ClearInstallDate()
+	return _u
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (_u *AgentSoftwareUpdateOne) SetFirstSeen(v time.Time) *AgentSoftwareUpdateOne {
+	_u.mutation.SetFirstSeen(v)
+	return _u
+}
+
+// SetNillableFirstSeen sets the "first_seen" field if the given value is not nil.
+func (_u *AgentSoftwareUpdateOne) SetNillableFirstSeen(v *time.Time) *AgentSoftwareUpdateOne {
+	if v != nil {
+		_u.SetFirstSeen(*v)
+	}
+	return _u
+}
+
+// SetRemovedAt sets the "removed_at" field.
+func (_u *AgentSoftwareUpdateOne) SetRemovedAt(v time.Time) *AgentSoftwareUpdateOne {
+	_u.mutation.SetRemovedAt(v)
+	return _u
+}
+
+// SetNillableRemovedAt sets the "removed_at" field if the given value is not nil.
+func (_u *AgentSoftwareUpdateOne) SetNillableRemovedAt(v *time.Time) *AgentSoftwareUpdateOne {
+	if v != nil {
+		_u.SetRemovedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemovedAt clears the value of the "removed_at" field.
+func (_u *AgentSoftwareUpdateOne) ClearRemovedAt() *AgentSoftwareUpdateOne {
+	_u.mutation.ClearRemovedAt()
+	return _u
+}
+
+// SetAgentID sets the "agent" edge to the Agent entity by ID.
+func (_u *AgentSoftwareUpdateOne) SetAgentID(id int) *AgentSoftwareUpdateOne {
+	_u.mutation.SetAgentID(id)
+	return _u
+}
+
+// SetAgent sets the "agent" edge to the Agent entity.
+func (_u *AgentSoftwareUpdateOne) SetAgent(v *Agent) *AgentSoftwareUpdateOne {
+	return _u.SetAgentID(v.ID)
+}
+
+// Mutation returns the AgentSoftwareMutation object of the builder.
+func (_u *AgentSoftwareUpdateOne) Mutation() *AgentSoftwareMutation {
+	return _u.mutation
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (_u *AgentSoftwareUpdateOne) ClearAgent() *AgentSoftwareUpdateOne {
+	_u.mutation.ClearAgent()
+	return _u
+}
+
+// Where appends a list predicates to the AgentSoftwareUpdate builder.
+func (_u *AgentSoftwareUpdateOne) Where(ps ...predicate.AgentSoftware) *AgentSoftwareUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AgentSoftwareUpdateOne) Select(field string, fields ...string) *AgentSoftwareUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AgentSoftware entity.
+func (_u *AgentSoftwareUpdateOne) Save(ctx context.Context) (*AgentSoftware, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AgentSoftwareUpdateOne) SaveX(ctx context.Context) *AgentSoftware {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AgentSoftwareUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AgentSoftwareUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AgentSoftwareUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := agentsoftware.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AgentSoftware.name": %w`, err)}
+		}
+	}
+	if _u.mutation.AgentCleared() && len(_u.mutation.AgentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AgentSoftware.agent"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSoftwareUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSoftwareUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AgentSoftwareUpdateOne) sqlSave(ctx context.Context) (_node *AgentSoftware, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentsoftware.Table, agentsoftware.Columns, sqlgraph.NewFieldSpec(agentsoftware.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentSoftware.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentsoftware.FieldID)
+		for _, f := range fields {
+			if !agentsoftware.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentsoftware.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(agentsoftware.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(agentsoftware.FieldVersion, field.TypeString, value)
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(agentsoftware.FieldVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Publisher(); ok {
+		_spec.SetField(agentsoftware.FieldPublisher, field.TypeString, value)
+	}
+	if _u.mutation.PublisherCleared() {
+		_spec.ClearField(agentsoftware.FieldPublisher, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstallDate(); ok {
+		_spec.SetField(agentsoftware.FieldInstallDate, field.TypeString, value)
+	}
+	if _u.mutation.InstallDateCleared() {
+		_spec.ClearField(agentsoftware.FieldInstallDate, field.TypeString)
+	}
+	if value, ok := _u.mutation.FirstSeen(); ok {
+		_spec.SetField(agentsoftware.FieldFirstSeen, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RemovedAt(); ok {
+		_spec.SetField(agentsoftware.FieldRemovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemovedAtCleared() {
+		_spec.ClearField(agentsoftware.FieldRemovedAt, field.TypeTime)
+	}
+	if _u.mutation.AgentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentsoftware.AgentTable,
+			Columns: []string{agentsoftware.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   agentsoftware.AgentTable,
+			Columns: []string{agentsoftware.AgentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AgentSoftware{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentsoftware.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sent/ent/alertrule"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AlertRule is the model entity for the AlertRule schema.
+type AlertRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Targets holds the value of the "targets" field.
+	Targets []string `json:"targets,omitempty"`
+	// Metric holds the value of the "metric" field.
+	Metric alertrule.Metric `json:"metric,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold float64 `json:"threshold,omitempty"`
+	// Severity holds the value of the "severity" field.
+	Severity alertrule.Severity `json:"severity,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AlertRuleQuery when eager-loading is set.
+	Edges              AlertRuleEdges `json:"edges"`
+	tenant_alert_rules *int
+	selectValues       sql.SelectValues
+}
+
+// AlertRuleEdges holds the relations/edges for other nodes in the graph.
+type AlertRuleEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Alerts holds the value of the alerts edge.
+	Alerts []*AgentAlert `json:"alerts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AlertRuleEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// AlertsOrErr returns the Alerts value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlertRuleEdges) AlertsOrErr() ([]*AgentAlert, error) {
+	if e.loadedTypes[1] {
+		return e.Alerts, nil
+	}
+	return nil, &NotLoadedError{edge: "alerts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AlertRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case alertrule.FieldTargets:
+			values[i] = new([]byte)
+		case alertrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case alertrule.FieldThreshold:
+			values[i] = new(sql.NullFloat64)
+		case alertrule.FieldID:
+			values[i] = new(sql.NullInt64)
+		case alertrule.FieldName, alertrule.FieldMetric, alertrule.FieldSeverity, alertrule.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case alertrule.FieldCreatedAt, alertrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case alertrule.ForeignKeys[0]: // tenant_alert_rules
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AlertRule fields.
+func (_m *AlertRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case alertrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case alertrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case alertrule.FieldTargets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field targets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Targets); err != nil {
+					return fmt.Errorf("unmarshal field targets: %w", err)
+				}
+			}
+		case alertrule.FieldMetric:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric", values[i])
+			} else if value.Valid {
+				_m.Metric = alertrule.Metric(value.String)
+			}
+		case alertrule.FieldThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				_m.Threshold = value.Float64
+			}
+		case alertrule.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = alertrule.Severity(value.String)
+			}
+		case alertrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case alertrule.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case alertrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case alertrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case alertrule.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_alert_rules", value)
+			} else if value.Valid {
+				_m.tenant_alert_rules = new(int)
+				*_m.tenant_alert_rules = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AlertRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *AlertRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the AlertRule entity.
+func (_m *AlertRule) QueryTenant() *TenantQuery {
+	return NewAlertRuleClient(_m.config).QueryTenant(_m)
+}
+
+// QueryAlerts queries the "alerts" edge of the AlertRule entity.
+func (_m *AlertRule) QueryAlerts() *AgentAlertQuery {
+	return NewAlertRuleClient(_m.config).QueryAlerts(_m)
+}
+
+// Update returns a builder for updating this AlertRule.
+// Note that you need to call AlertRule.Unwrap() before calling this method if this AlertRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AlertRule) Update() *AlertRuleUpdateOne {
+	return NewAlertRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AlertRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AlertRule) Unwrap() *AlertRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AlertRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AlertRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AlertRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("targets=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Targets))
+	builder.WriteString(", ")
+	builder.WriteString("metric=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metric))
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Threshold))
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Severity))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AlertRules is a parsable slice of AlertRule.
+type AlertRules []*AlertRule
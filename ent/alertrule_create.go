@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agentalert"
+	"sent/ent/alertrule"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertRuleCreate is the builder for creating a AlertRule entity.
+type AlertRuleCreate struct {
+	config
+	mutation *AlertRuleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *AlertRuleCreate) SetName(v string) *AlertRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTargets sets the "targets" field.
+func (_c *AlertRuleCreate) SetTargets(v []string) *AlertRuleCreate {
+	_c.mutation.SetTargets(v)
+	return _c
+}
+
+// SetMetric sets the "metric" field.
+func (_c *AlertRuleCreate) SetMetric(v alertrule.Metric) *AlertRuleCreate {
+	_c.mutation.SetMetric(v)
+	return _c
+}
+
+// SetThreshold sets the "threshold" field.
+func (_c *AlertRuleCreate) SetThreshold(v float64) *AlertRuleCreate {
+	_c.mutation.SetThreshold(v)
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *AlertRuleCreate) SetSeverity(v alertrule.Severity) *AlertRuleCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_c *AlertRuleCreate) SetNillableSeverity(v *alertrule.Severity) *AlertRuleCreate {
+	if v != nil {
+		_c.SetSeverity(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *AlertRuleCreate) SetEnabled(v bool) *AlertRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *AlertRuleCreate) SetNillableEnabled(v *bool) *AlertRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *AlertRuleCreate) SetCreatedBy(v string) *AlertRuleCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *AlertRuleCreate) SetNillableCreatedBy(v *string) *AlertRuleCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AlertRuleCreate) SetCreatedAt(v time.Time) *AlertRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AlertRuleCreate) SetNillableCreatedAt(v *time.Time) *AlertRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AlertRuleCreate) SetUpdatedAt(v time.Time) *AlertRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AlertRuleCreate) SetNillableUpdatedAt(v *time.Time) *AlertRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *AlertRuleCreate) SetTenantID(id int) *AlertRuleCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *AlertRuleCreate) SetTenant(v *Tenant) *AlertRuleCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// AddAlertIDs adds the "alerts" edge to the AgentAlert entity by IDs.
+func (_c *AlertRuleCreate) AddAlertIDs(ids ...int) *AlertRuleCreate {
+	_c.mutation.AddAlertIDs(ids...)
+	return _c
+}
+
+// AddAlerts adds the "alerts" edges to the AgentAlert entity.
+func (_c *AlertRuleCreate) AddAlerts(v ...*AgentAlert) *AlertRuleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAlertIDs(ids...)
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (_c *AlertRuleCreate) Mutation() *AlertRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the AlertRule in the database.
+func (_c *AlertRuleCreate) Save(ctx context.Context) (*AlertRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AlertRuleCreate) SaveX(ctx context.Context) *AlertRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlertRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlertRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AlertRuleCreate) defaults() {
+	if _, ok := _c.mutation.Severity(); !ok {
+		v := alertrule.DefaultSeverity
+		_c.mutation.SetSeverity(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := alertrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := alertrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := alertrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AlertRuleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AlertRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := alertrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Targets(); !ok {
+		return &ValidationError{Name: "targets", err: errors.New(`ent: missing required field "AlertRule.targets"`)}
+	}
+	if _, ok := _c.mutation.Metric(); !ok {
+		return &ValidationError{Name: "metric", err: errors.New(`ent: missing required field "AlertRule.metric"`)}
+	}
+	if v, ok := _c.mutation.Metric(); ok {
+		if err := alertrule.MetricValidator(v); err != nil {
+			return &ValidationError{Name: "metric", err: fmt.Errorf(`ent: validator failed for field "AlertRule.metric": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "AlertRule.threshold"`)}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "AlertRule.severity"`)}
+	}
+	if v, ok := _c.mutation.Severity(); ok {
+		if err := alertrule.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AlertRule.severity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "AlertRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AlertRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AlertRule.updated_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "AlertRule.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *AlertRuleCreate) sqlSave(ctx context.Context) (*AlertRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AlertRuleCreate) createSpec() (*AlertRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AlertRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(alertrule.Table, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(alertrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Targets(); ok {
+		_spec.SetField(alertrule.FieldTargets, field.TypeJSON, value)
+		_node.Targets = value
+	}
+	if value, ok := _c.mutation.Metric(); ok {
+		_spec.SetField(alertrule.FieldMetric, field.TypeEnum, value)
+		_node.Metric = value
+	}
+	if value, ok := _c.mutation.Threshold(); ok {
+		_spec.SetField(alertrule.FieldThreshold, field.TypeFloat64, value)
+		_node.Threshold = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(alertrule.FieldSeverity, field.TypeEnum, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(alertrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(alertrule.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(alertrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   alertrule.TenantTable,
+			Columns: []string{alertrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_alert_rules = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertrule.AlertsTable,
+			Columns: []string{alertrule.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AlertRuleCreateBulk is the builder for creating many AlertRule entities in bulk.
+type AlertRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AlertRuleCreate
+}
+
+// Save creates the AlertRule entities in the database.
+func (_c *AlertRuleCreateBulk) Save(ctx context.Context) ([]*AlertRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AlertRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlertRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AlertRuleCreateBulk) SaveX(ctx context.Context) []*AlertRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlertRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlertRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
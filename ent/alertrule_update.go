@@ -0,0 +1,819 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/agentalert"
+	"sent/ent/alertrule"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertRuleUpdate is the builder for updating AlertRule entities.
+type AlertRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AlertRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AlertRuleUpdate builder.
+func (_u *AlertRuleUpdate) Where(ps ...predicate.AlertRule) *AlertRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AlertRuleUpdate) SetName(v string) *AlertRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AlertRuleUpdate) SetNillableName(v *string) *AlertRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTargets sets the "targets" field.
+func (_u *AlertRuleUpdate) SetTargets(v []string) *AlertRuleUpdate {
+	_u.mutation.SetTargets(v)
+	return _u
+}
+
+// AppendTargets appends value to the "targets" field.
+func (_u *AlertRuleUpdate) AppendTargets(v []string) *AlertRuleUpdate {
+	_u.mutation.AppendTargets(v)
+	return _u
+}
+
+// SetMetric sets the "metric" field.
+func (_u *AlertRuleUpdate) SetMetric(v alertrule.Metric) *AlertRuleUpdate {
+	_u.mutation.SetMetric(v)
+	return _u
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (_u *AlertRuleUpdate) SetNillableMetric(v *alertrule.Metric) *AlertRuleUpdate {
+	if v != nil {
+		_u.SetMetric(*v)
+	}
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *AlertRuleUpdate) SetThreshold(v float64) *AlertRuleUpdate {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *AlertRuleUpdate) SetNillableThreshold(v *float64) *AlertRuleUpdate {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *AlertRuleUpdate) AddThreshold(v float64) *AlertRuleUpdate {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *AlertRuleUpdate) SetSeverity(v alertrule.Severity) *AlertRuleUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *AlertRuleUpdate) SetNillableSeverity(v *alertrule.Severity) *AlertRuleUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AlertRuleUpdate) SetEnabled(v bool) *AlertRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AlertRuleUpdate) SetNillableEnabled(v *bool) *AlertRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AlertRuleUpdate) SetCreatedBy(v string) *AlertRuleUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AlertRuleUpdate) SetNillableCreatedBy(v *string) *AlertRuleUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AlertRuleUpdate) ClearCreatedBy() *AlertRuleUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlertRuleUpdate) SetUpdatedAt(v time.Time) *AlertRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AlertRuleUpdate) SetTenantID(id int) *AlertRuleUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AlertRuleUpdate) SetTenant(v *Tenant) *AlertRuleUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddAlertIDs adds the "alerts" edge to the AgentAlert entity by IDs.
+func (_u *AlertRuleUpdate) AddAlertIDs(ids ...int) *AlertRuleUpdate {
+	_u.mutation.AddAlertIDs(ids...)
+	return _u
+}
+
+// AddAlerts adds the "alerts" edges to the AgentAlert entity.
+func (_u *AlertRuleUpdate) AddAlerts(v ...*AgentAlert) *AlertRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAlertIDs(ids...)
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (_u *AlertRuleUpdate) Mutation() *AlertRuleMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *AlertRuleUpdate) ClearTenant() *AlertRuleUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAlerts clears all "alerts" edges to the AgentAlert entity.
+func (_u *AlertRuleUpdate) ClearAlerts() *AlertRuleUpdate {
+	_u.mutation.ClearAlerts()
+	return _u
+}
+
+// RemoveAlertIDs removes the "alerts" edge to AgentAlert entities by IDs.
+func (_u *AlertRuleUpdate) RemoveAlertIDs(ids ...int) *AlertRuleUpdate {
+	_u.mutation.RemoveAlertIDs(ids...)
+	return _u
+}
+
+// RemoveAlerts removes "alerts" edges to AgentAlert entities.
+func (_u *AlertRuleUpdate) RemoveAlerts(v ...*AgentAlert) *AlertRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAlertIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AlertRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlertRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AlertRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlertRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AlertRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := alertrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlertRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := alertrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Metric(); ok {
+		if err := alertrule.MetricValidator(v); err != nil {
+			return &ValidationError{Name: "metric", err: fmt.Errorf(`ent: validator failed for field "AlertRule.metric": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := alertrule.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AlertRule.severity": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AlertRule.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AlertRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AlertRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AlertRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertrule.Table, alertrule.Columns, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(alertrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Targets(); ok {
+		_spec.SetField(alertrule.FieldTargets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, alertrule.FieldTargets, value)
+		})
+	}
+	if value, ok := _u.mutation.Metric(); ok {
+		_spec.SetField(alertrule.FieldMetric, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(alertrule.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(alertrule.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(alertrule.FieldSeverity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(alertrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(alertrule.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(alertrule.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   alertrule.TenantTable,
+			Columns: []string{alertrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   alertrule.TenantTable,
+			Columns: []string{alertrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertrule.AlertsTable,
+			Columns: []string{alertrule.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAlertsIDs(); len(nodes) > 0 && !_u.mutation.AlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertrule.AlertsTable,
+			Columns: []string{alertrule.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertrule.AlertsTable,
+			Columns: []string{alertrule.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AlertRuleUpdateOne is the builder for updating a single AlertRule entity.
+type AlertRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AlertRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *AlertRuleUpdateOne) SetName(v string) *AlertRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AlertRuleUpdateOne) SetNillableName(v *string) *AlertRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTargets sets the "targets" field.
+func (_u *AlertRuleUpdateOne) SetTargets(v []string) *AlertRuleUpdateOne {
+	_u.mutation.SetTargets(v)
+	return _u
+}
+
+// AppendTargets appends value to the "targets" field.
+func (_u *AlertRuleUpdateOne) AppendTargets(v []string) *AlertRuleUpdateOne {
+	_u.mutation.AppendTargets(v)
+	return _u
+}
+
+// SetMetric sets the "metric" field.
+func (_u *AlertRuleUpdateOne) SetMetric(v alertrule.Metric) *AlertRuleUpdateOne {
+	_u.mutation.SetMetric(v)
+	return _u
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (_u *AlertRuleUpdateOne) SetNillableMetric(v *alertrule.Metric) *AlertRuleUpdateOne {
+	if v != nil {
+		_u.SetMetric(*v)
+	}
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *AlertRuleUpdateOne) SetThreshold(v float64) *AlertRuleUpdateOne {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *AlertRuleUpdateOne) SetNillableThreshold(v *float64) *AlertRuleUpdateOne {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *AlertRuleUpdateOne) AddThreshold(v float64) *AlertRuleUpdateOne {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *AlertRuleUpdateOne) SetSeverity(v alertrule.Severity) *AlertRuleUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *AlertRuleUpdateOne) SetNillableSeverity(v *alertrule.Severity) *AlertRuleUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AlertRuleUpdateOne) SetEnabled(v bool) *AlertRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AlertRuleUpdateOne) SetNillableEnabled(v *bool) *AlertRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AlertRuleUpdateOne) SetCreatedBy(v string) *AlertRuleUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AlertRuleUpdateOne) SetNillableCreatedBy(v *string) *AlertRuleUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AlertRuleUpdateOne) ClearCreatedBy() *AlertRuleUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlertRuleUpdateOne) SetUpdatedAt(v time.Time) *AlertRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *AlertRuleUpdateOne) SetTenantID(id int) *AlertRuleUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *AlertRuleUpdateOne) SetTenant(v *Tenant) *AlertRuleUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddAlertIDs adds the "alerts" edge to the AgentAlert entity by IDs.
+func (_u *AlertRuleUpdateOne) AddAlertIDs(ids ...int) *AlertRuleUpdateOne {
+	_u.mutation.AddAlertIDs(ids...)
+	return _u
+}
+
+// AddAlerts adds the "alerts" edges to the AgentAlert entity.
+func (_u *AlertRuleUpdateOne) AddAlerts(v ...*AgentAlert) *AlertRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAlertIDs(ids...)
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (_u *AlertRuleUpdateOne) Mutation() *AlertRuleMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *AlertRuleUpdateOne) ClearTenant() *AlertRuleUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAlerts clears all "alerts" edges to the AgentAlert entity.
+func (_u *AlertRuleUpdateOne) ClearAlerts() *AlertRuleUpdateOne {
+	_u.mutation.ClearAlerts()
+	return _u
+}
+
+// RemoveAlertIDs removes the "alerts" edge to AgentAlert entities by IDs.
+func (_u *AlertRuleUpdateOne) RemoveAlertIDs(ids ...int) *AlertRuleUpdateOne {
+	_u.mutation.RemoveAlertIDs(ids...)
+	return _u
+}
+
+// RemoveAlerts removes "alerts" edges to AgentAlert entities.
+func (_u *AlertRuleUpdateOne) RemoveAlerts(v ...*AgentAlert) *AlertRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAlertIDs(ids...)
+}
+
+// Where appends a list predicates to the AlertRuleUpdate builder.
+func (_u *AlertRuleUpdateOne) Where(ps ...predicate.AlertRule) *AlertRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AlertRuleUpdateOne) Select(field string, fields ...string) *AlertRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AlertRule entity.
+func (_u *AlertRuleUpdateOne) Save(ctx context.Context) (*AlertRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlertRuleUpdateOne) SaveX(ctx context.Context) *AlertRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AlertRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlertRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AlertRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := alertrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlertRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := alertrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Metric(); ok {
+		if err := alertrule.MetricValidator(v); err != nil {
+			return &ValidationError{Name: "metric", err: fmt.Errorf(`ent: validator failed for field "AlertRule.metric": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := alertrule.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AlertRule.severity": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AlertRule.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AlertRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AlertRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AlertRuleUpdateOne) sqlSave(ctx context.Context) (_node *AlertRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertrule.Table, alertrule.Columns, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AlertRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, alertrule.FieldID)
+		for _, f := range fields {
+			if !alertrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != alertrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(alertrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Targets(); ok {
+		_spec.SetField(alertrule.FieldTargets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, alertrule.FieldTargets, value)
+		})
+	}
+	if value, ok := _u.mutation.Metric(); ok {
+		_spec.SetField(alertrule.FieldMetric, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(alertrule.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(alertrule.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(alertrule.FieldSeverity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(alertrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(alertrule.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(alertrule.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   alertrule.TenantTable,
+			Columns: []string{alertrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   alertrule.TenantTable,
+			Columns: []string{alertrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertrule.AlertsTable,
+			Columns: []string{alertrule.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAlertsIDs(); len(nodes) > 0 && !_u.mutation.AlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertrule.AlertsTable,
+			Columns: []string{alertrule.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertrule.AlertsTable,
+			Columns: []string{alertrule.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AlertRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankrule"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BankRule is the model entity for the BankRule schema.
+type BankRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// MatchField holds the value of the "match_field" field.
+	MatchField bankrule.MatchField `json:"match_field,omitempty"`
+	// Pattern holds the value of the "pattern" field.
+	Pattern string `json:"pattern,omitempty"`
+	// Direction holds the value of the "direction" field.
+	Direction bankrule.Direction `json:"direction,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BankRuleQuery when eager-loading is set.
+	Edges             BankRuleEdges `json:"edges"`
+	bank_rule_account *int
+	tenant_bank_rules *int
+	selectValues      sql.SelectValues
+}
+
+// BankRuleEdges holds the relations/edges for other nodes in the graph.
+type BankRuleEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankRuleEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankRuleEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BankRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bankrule.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case bankrule.FieldID, bankrule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case bankrule.FieldName, bankrule.FieldMatchField, bankrule.FieldPattern, bankrule.FieldDirection:
+			values[i] = new(sql.NullString)
+		case bankrule.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case bankrule.ForeignKeys[0]: // bank_rule_account
+			values[i] = new(sql.NullInt64)
+		case bankrule.ForeignKeys[1]: // tenant_bank_rules
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BankRule fields.
+func (_m *BankRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bankrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case bankrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bankrule.FieldMatchField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field match_field", values[i])
+			} else if value.Valid {
+				_m.MatchField = bankrule.MatchField(value.String)
+			}
+		case bankrule.FieldPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern", values[i])
+			} else if value.Valid {
+				_m.Pattern = value.String
+			}
+		case bankrule.FieldDirection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direction", values[i])
+			} else if value.Valid {
+				_m.Direction = bankrule.Direction(value.String)
+			}
+		case bankrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case bankrule.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case bankrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bankrule.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bank_rule_account", value)
+			} else if value.Valid {
+				_m.bank_rule_account = new(int)
+				*_m.bank_rule_account = int(value.Int64)
+			}
+		case bankrule.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_bank_rules", value)
+			} else if value.Valid {
+				_m.tenant_bank_rules = new(int)
+				*_m.tenant_bank_rules = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BankRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *BankRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the BankRule entity.
+func (_m *BankRule) QueryTenant() *TenantQuery {
+	return NewBankRuleClient(_m.config).QueryTenant(_m)
+}
+
+// QueryAccount queries the "account" edge of the BankRule entity.
+func (_m *BankRule) QueryAccount() *AccountQuery {
+	return NewBankRuleClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this BankRule.
+// Note that you need to call BankRule.Unwrap() before calling this method if this BankRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BankRule) Update() *BankRuleUpdateOne {
+	return NewBankRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BankRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BankRule) Unwrap() *BankRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BankRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BankRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("BankRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("match_field=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MatchField))
+	builder.WriteString(", ")
+	builder.WriteString("pattern=")
+	builder.WriteString(_m.Pattern)
+	builder.WriteString(", ")
+	builder.WriteString("direction=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Direction))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BankRules is a parsable slice of BankRule.
+type BankRules []*BankRule
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankrule"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BankRuleCreate is the builder for creating a BankRule entity.
+type BankRuleCreate struct {
+	config
+	mutation *BankRuleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *BankRuleCreate) SetName(v string) *BankRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetMatchField sets the "match_field" field.
+func (_c *BankRuleCreate) SetMatchField(v bankrule.MatchField) *BankRuleCreate {
+	_c.mutation.SetMatchField(v)
+	return _c
+}
+
+// SetNillableMatchField sets the "match_field" field if the given value is not nil.
+func (_c *BankRuleCreate) SetNillableMatchField(v *bankrule.MatchField) *BankRuleCreate {
+	if v != nil {
+		_c.SetMatchField(*v)
+	}
+	return _c
+}
+
+// SetPattern sets the "pattern" field.
+func (_c *BankRuleCreate) SetPattern(v string) *BankRuleCreate {
+	_c.mutation.SetPattern(v)
+	return _c
+}
+
+// SetDirection sets the "direction" field.
+func (_c *BankRuleCreate) SetDirection(v bankrule.Direction) *BankRuleCreate {
+	_c.mutation.SetDirection(v)
+	return _c
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (_c *BankRuleCreate) SetNillableDirection(v *bankrule.Direction) *BankRuleCreate {
+	if v != nil {
+		_c.SetDirection(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *BankRuleCreate) SetPriority(v int) *BankRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *BankRuleCreate) SetNillablePriority(v *int) *BankRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *BankRuleCreate) SetIsActive(v bool) *BankRuleCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *BankRuleCreate) SetNillableIsActive(v *bool) *BankRuleCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BankRuleCreate) SetCreatedAt(v time.Time) *BankRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BankRuleCreate) SetNillableCreatedAt(v *time.Time) *BankRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *BankRuleCreate) SetTenantID(id int) *BankRuleCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *BankRuleCreate) SetTenant(v *Tenant) *BankRuleCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *BankRuleCreate) SetAccountID(id int) *BankRuleCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *BankRuleCreate) SetAccount(v *Account) *BankRuleCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the BankRuleMutation object of the builder.
+func (_c *BankRuleCreate) Mutation() *BankRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the BankRule in the database.
+func (_c *BankRuleCreate) Save(ctx context.Context) (*BankRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BankRuleCreate) SaveX(ctx context.Context) *BankRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BankRuleCreate) defaults() {
+	if _, ok := _c.mutation.MatchField(); !ok {
+		v := bankrule.DefaultMatchField
+		_c.mutation.SetMatchField(v)
+	}
+	if _, ok := _c.mutation.Direction(); !ok {
+		v := bankrule.DefaultDirection
+		_c.mutation.SetDirection(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := bankrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := bankrule.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bankrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BankRuleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BankRule.name"`)}
+	}
+	if _, ok := _c.mutation.MatchField(); !ok {
+		return &ValidationError{Name: "match_field", err: errors.New(`ent: missing required field "BankRule.match_field"`)}
+	}
+	if v, ok := _c.mutation.MatchField(); ok {
+		if err := bankrule.MatchFieldValidator(v); err != nil {
+			return &ValidationError{Name: "match_field", err: fmt.Errorf(`ent: validator failed for field "BankRule.match_field": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Pattern(); !ok {
+		return &ValidationError{Name: "pattern", err: errors.New(`ent: missing required field "BankRule.pattern"`)}
+	}
+	if _, ok := _c.mutation.Direction(); !ok {
+		return &ValidationError{Name: "direction", err: errors.New(`ent: missing required field "BankRule.direction"`)}
+	}
+	if v, ok := _c.mutation.Direction(); ok {
+		if err := bankrule.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "BankRule.direction": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "BankRule.priority"`)}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "BankRule.is_active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BankRule.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "BankRule.tenant"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "BankRule.account"`)}
+	}
+	return nil
+}
+
+func (_c *BankRuleCreate) sqlSave(ctx context.Context) (*BankRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BankRuleCreate) createSpec() (*BankRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BankRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bankrule.Table, sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bankrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.MatchField(); ok {
+		_spec.SetField(bankrule.FieldMatchField, field.TypeEnum, value)
+		_node.MatchField = value
+	}
+	if value, ok := _c.mutation.Pattern(); ok {
+		_spec.SetField(bankrule.FieldPattern, field.TypeString, value)
+		_node.Pattern = value
+	}
+	if value, ok := _c.mutation.Direction(); ok {
+		_spec.SetField(bankrule.FieldDirection, field.TypeEnum, value)
+		_node.Direction = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(bankrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(bankrule.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bankrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankrule.TenantTable,
+			Columns: []string{bankrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_bank_rules = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankrule.AccountTable,
+			Columns: []string{bankrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bank_rule_account = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BankRuleCreateBulk is the builder for creating many BankRule entities in bulk.
+type BankRuleCreateBulk struct {
+	config
+	err      error
+	builders []*BankRuleCreate
+}
+
+// Save creates the BankRule entities in the database.
+func (_c *BankRuleCreateBulk) Save(ctx context.Context) ([]*BankRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BankRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BankRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BankRuleCreateBulk) SaveX(ctx context.Context) []*BankRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
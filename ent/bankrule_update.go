@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankrule"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BankRuleUpdate is the builder for updating BankRule entities.
+type BankRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BankRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BankRuleUpdate builder.
+func (_u *BankRuleUpdate) Where(ps ...predicate.BankRule) *BankRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BankRuleUpdate) SetName(v string) *BankRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BankRuleUpdate) SetNillableName(v *string) *BankRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMatchField sets the "match_field" field.
+func (_u *BankRuleUpdate) SetMatchField(v bankrule.MatchField) *BankRuleUpdate {
+	_u.mutation.SetMatchField(v)
+	return _u
+}
+
+// SetNillableMatchField sets the "match_field" field if the given value is not nil.
+func (_u *BankRuleUpdate) SetNillableMatchField(v *bankrule.MatchField) *BankRuleUpdate {
+	if v != nil {
+		_u.SetMatchField(*v)
+	}
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *BankRuleUpdate) SetPattern(v string) *BankRuleUpdate {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *BankRuleUpdate) SetNillablePattern(v *string) *BankRuleUpdate {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// SetDirection sets the "direction" field.
+func (_u *BankRuleUpdate) SetDirection(v bankrule.Direction) *BankRuleUpdate {
+	_u.mutation.SetDirection(v)
+	return _u
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (_u *BankRuleUpdate) SetNillableDirection(v *bankrule.Direction) *BankRuleUpdate {
+	if v != nil {
+		_u.SetDirection(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *BankRuleUpdate) SetPriority(v int) *BankRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *BankRuleUpdate) SetNillablePriority(v *int) *BankRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *BankRuleUpdate) AddPriority(v int) *BankRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *BankRuleUpdate) SetIsActive(v bool) *BankRuleUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *BankRuleUpdate) SetNillableIsActive(v *bool) *BankRuleUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *BankRuleUpdate) SetTenantID(id int) *BankRuleUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *BankRuleUpdate) SetTenant(v *Tenant) *BankRuleUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *BankRuleUpdate) SetAccountID(id int) *BankRuleUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *BankRuleUpdate) SetAccount(v *Account) *BankRuleUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the BankRuleMutation object of the builder.
+func (_u *BankRuleUpdate) Mutation() *BankRuleMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *BankRuleUpdate) ClearTenant() *BankRuleUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *BankRuleUpdate) ClearAccount() *BankRuleUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BankRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BankRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankRuleUpdate) check() error {
+	if v, ok := _u.mutation.MatchField(); ok {
+		if err := bankrule.MatchFieldValidator(v); err != nil {
+			return &ValidationError{Name: "match_field", err: fmt.Errorf(`ent: validator failed for field "BankRule.match_field": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Direction(); ok {
+		if err := bankrule.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "BankRule.direction": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankRule.tenant"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankRule.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BankRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BankRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BankRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankrule.Table, bankrule.Columns, sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bankrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MatchField(); ok {
+		_spec.SetField(bankrule.FieldMatchField, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(bankrule.FieldPattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Direction(); ok {
+		_spec.SetField(bankrule.FieldDirection, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(bankrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(bankrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(bankrule.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankrule.TenantTable,
+			Columns: []string{bankrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankrule.TenantTable,
+			Columns: []string{bankrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankrule.AccountTable,
+			Columns: []string{bankrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankrule.AccountTable,
+			Columns: []string{bankrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BankRuleUpdateOne is the builder for updating a single BankRule entity.
+type BankRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BankRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *BankRuleUpdateOne) SetName(v string) *BankRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BankRuleUpdateOne) SetNillableName(v *string) *BankRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMatchField sets the "match_field" field.
+func (_u *BankRuleUpdateOne) SetMatchField(v bankrule.MatchField) *BankRuleUpdateOne {
+	_u.mutation.SetMatchField(v)
+	return _u
+}
+
+// SetNillableMatchField sets the "match_field" field if the given value is not nil.
+func (_u *BankRuleUpdateOne) SetNillableMatchField(v *bankrule.MatchField) *BankRuleUpdateOne {
+	if v != nil {
+		_u.SetMatchField(*v)
+	}
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *BankRuleUpdateOne) SetPattern(v string) *BankRuleUpdateOne {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *BankRuleUpdateOne) SetNillablePattern(v *string) *BankRuleUpdateOne {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// SetDirection sets the "direction" field.
+func (_u *BankRuleUpdateOne) SetDirection(v bankrule.Direction) *BankRuleUpdateOne {
+	_u.mutation.SetDirection(v)
+	return _u
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (_u *BankRuleUpdateOne) SetNillableDirection(v *bankrule.Direction) *BankRuleUpdateOne {
+	if v != nil {
+		_u.SetDirection(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *BankRuleUpdateOne) SetPriority(v int) *BankRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *BankRuleUpdateOne) SetNillablePriority(v *int) *BankRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *BankRuleUpdateOne) AddPriority(v int) *BankRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *BankRuleUpdateOne) SetIsActive(v bool) *BankRuleUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *BankRuleUpdateOne) SetNillableIsActive(v *bool) *BankRuleUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *BankRuleUpdateOne) SetTenantID(id int) *BankRuleUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *BankRuleUpdateOne) SetTenant(v *Tenant) *BankRuleUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *BankRuleUpdateOne) SetAccountID(id int) *BankRuleUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *BankRuleUpdateOne) SetAccount(v *Account) *BankRuleUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the BankRuleMutation object of the builder.
+func (_u *BankRuleUpdateOne) Mutation() *BankRuleMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *BankRuleUpdateOne) ClearTenant() *BankRuleUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *BankRuleUpdateOne) ClearAccount() *BankRuleUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the BankRuleUpdate builder.
+func (_u *BankRuleUpdateOne) Where(ps ...predicate.BankRule) *BankRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BankRuleUpdateOne) Select(field string, fields ...string) *BankRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BankRule entity.
+func (_u *BankRuleUpdateOne) Save(ctx context.Context) (*BankRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankRuleUpdateOne) SaveX(ctx context.Context) *BankRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BankRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.MatchField(); ok {
+		if err := bankrule.MatchFieldValidator(v); err != nil {
+			return &ValidationError{Name: "match_field", err: fmt.Errorf(`ent: validator failed for field "BankRule.match_field": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Direction(); ok {
+		if err := bankrule.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "BankRule.direction": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankRule.tenant"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankRule.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BankRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BankRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BankRuleUpdateOne) sqlSave(ctx context.Context) (_node *BankRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankrule.Table, bankrule.Columns, sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BankRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bankrule.FieldID)
+		for _, f := range fields {
+			if !bankrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bankrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bankrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MatchField(); ok {
+		_spec.SetField(bankrule.FieldMatchField, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(bankrule.FieldPattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Direction(); ok {
+		_spec.SetField(bankrule.FieldDirection, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(bankrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(bankrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(bankrule.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankrule.TenantTable,
+			Columns: []string{bankrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankrule.TenantTable,
+			Columns: []string{bankrule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankrule.AccountTable,
+			Columns: []string{bankrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankrule.AccountTable,
+			Columns: []string{bankrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &BankRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankstatement"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatement is the model entity for the BankStatement schema.
+type BankStatement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format bankstatement.Format `json:"format,omitempty"`
+	// StatementRef holds the value of the "statement_ref" field.
+	StatementRef string `json:"statement_ref,omitempty"`
+	// FileName holds the value of the "file_name" field.
+	FileName string `json:"file_name,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart *time.Time `json:"period_start,omitempty"`
+	// PeriodEnd holds the value of the "period_end" field.
+	PeriodEnd *time.Time `json:"period_end,omitempty"`
+	// OpeningBalance holds the value of the "opening_balance" field.
+	OpeningBalance *decimal.Decimal `json:"opening_balance,omitempty"`
+	// ClosingBalance holds the value of the "closing_balance" field.
+	ClosingBalance *decimal.Decimal `json:"closing_balance,omitempty"`
+	// ImportedAt holds the value of the "imported_at" field.
+	ImportedAt time.Time `json:"imported_at,omitempty"`
+	// ImportedBy holds the value of the "imported_by" field.
+	ImportedBy string `json:"imported_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BankStatementQuery when eager-loading is set.
+	Edges                   BankStatementEdges `json:"edges"`
+	account_bank_statements *int
+	tenant_bank_statements  *int
+	selectValues            sql.SelectValues
+}
+
+// BankStatementEdges holds the relations/edges for other nodes in the graph.
+type BankStatementEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Lines holds the value of the lines edge.
+	Lines []*BankStatementLine `json:"lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankStatementEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankStatementEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e BankStatementEdges) LinesOrErr() ([]*BankStatementLine, error) {
+	if e.loadedTypes[2] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BankStatement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bankstatement.FieldOpeningBalance, bankstatement.FieldClosingBalance:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case bankstatement.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bankstatement.FieldFormat, bankstatement.FieldStatementRef, bankstatement.FieldFileName, bankstatement.FieldCurrency, bankstatement.FieldImportedBy:
+			values[i] = new(sql.NullString)
+		case bankstatement.FieldPeriodStart, bankstatement.FieldPeriodEnd, bankstatement.FieldImportedAt:
+			values[i] = new(sql.NullTime)
+		case bankstatement.ForeignKeys[0]: // account_bank_statements
+			values[i] = new(sql.NullInt64)
+		case bankstatement.ForeignKeys[1]: // tenant_bank_statements
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BankStatement fields.
+func (_m *BankStatement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bankstatement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case bankstatement.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = bankstatement.Format(value.String)
+			}
+		case bankstatement.FieldStatementRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_ref", values[i])
+			} else if value.Valid {
+				_m.StatementRef = value.String
+			}
+		case bankstatement.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				_m.FileName = value.String
+			}
+		case bankstatement.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case bankstatement.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = new(time.Time)
+				*_m.PeriodStart = value.Time
+			}
+		case bankstatement.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				_m.PeriodEnd = new(time.Time)
+				*_m.PeriodEnd = value.Time
+			}
+		case bankstatement.FieldOpeningBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field opening_balance", values[i])
+			} else if value.Valid {
+				_m.OpeningBalance = new(decimal.Decimal)
+				*_m.OpeningBalance = *value.S.(*decimal.Decimal)
+			}
+		case bankstatement.FieldClosingBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field closing_balance", values[i])
+			} else if value.Valid {
+				_m.ClosingBalance = new(decimal.Decimal)
+				*_m.ClosingBalance = *value.S.(*decimal.Decimal)
+			}
+		case bankstatement.FieldImportedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field imported_at", values[i])
+			} else if value.Valid {
+				_m.ImportedAt = value.Time
+			}
+		case bankstatement.FieldImportedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field imported_by", values[i])
+			} else if value.Valid {
+				_m.ImportedBy = value.String
+			}
+		case bankstatement.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field account_bank_statements", value)
+			} else if value.Valid {
+				_m.account_bank_statements = new(int)
+				*_m.account_bank_statements = int(value.Int64)
+			}
+		case bankstatement.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_bank_statements", value)
+			} else if value.Valid {
+				_m.tenant_bank_statements = new(int)
+				*_m.tenant_bank_statements = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BankStatement.
+// This includes values selected through modifiers, order, etc.
+func (_m *BankStatement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the BankStatement entity.
+func (_m *BankStatement) QueryTenant() *TenantQuery {
+	return NewBankStatementClient(_m.config).QueryTenant(_m)
+}
+
+// QueryAccount queries the "account" edge of the BankStatement entity.
+func (_m *BankStatement) QueryAccount() *AccountQuery {
+	return NewBankStatementClient(_m.config).QueryAccount(_m)
+}
+
+// QueryLines queries the "lines" edge of the BankStatement entity.
+func (_m *BankStatement) QueryLines() *BankStatementLineQuery {
+	return NewBankStatementClient(_m.config).QueryLines(_m)
+}
+
+// Update returns a builder for updating this BankStatement.
+// Note that you need to call BankStatement.Unwrap() before calling this method if this BankStatement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BankStatement) Update() *BankStatementUpdateOne {
+	return NewBankStatementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BankStatement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BankStatement) Unwrap() *BankStatement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BankStatement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BankStatement) String() string {
+	var builder strings.Builder
+	builder.WriteString("BankStatement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("statement_ref=")
+	builder.WriteString(_m.StatementRef)
+	builder.WriteString(", ")
+	builder.WriteString("file_name=")
+	builder.WriteString(_m.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	if v := _m.PeriodStart; v != nil {
+		builder.WriteString("period_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PeriodEnd; v != nil {
+		builder.WriteString("period_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.OpeningBalance; v != nil {
+		builder.WriteString("opening_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosingBalance; v != nil {
+		builder.WriteString("closing_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("imported_at=")
+	builder.WriteString(_m.ImportedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("imported_by=")
+	builder.WriteString(_m.ImportedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BankStatements is a parsable slice of BankStatement.
+type BankStatements []*BankStatement
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankstatement
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the bankstatement type in the database.
+	Label = "bank_statement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldStatementRef holds the string denoting the statement_ref field in the database.
+	FieldStatementRef = "statement_ref"
+	// FieldFileName holds the string denoting the file_name field in the database.
+	FieldFileName = "file_name"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldOpeningBalance holds the string denoting the opening_balance field in the database.
+	FieldOpeningBalance = "opening_balance"
+	// FieldClosingBalance holds the string denoting the closing_balance field in the database.
+	FieldClosingBalance = "closing_balance"
+	// FieldImportedAt holds the string denoting the imported_at field in the database.
+	FieldImportedAt = "imported_at"
+	// FieldImportedBy holds the string denoting the imported_by field in the database.
+	FieldImportedBy = "imported_by"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
+	// Table holds the table name of the bankstatement in the database.
+	Table = "bank_statements"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "bank_statements"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_bank_statements"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "bank_statements"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_bank_statements"
+	// LinesTable is the table that holds the lines relation/edge.
+	LinesTable = "bank_statement_lines"
+	// LinesInverseTable is the table name for the BankStatementLine entity.
+	// It exists in this package in order to avoid circular dependency with the "bankstatementline" package.
+	LinesInverseTable = "bank_statement_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "bank_statement_lines"
+)
+
+// Columns holds all SQL columns for bankstatement fields.
+var Columns = []string{
+	FieldID,
+	FieldFormat,
+	FieldStatementRef,
+	FieldFileName,
+	FieldCurrency,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldOpeningBalance,
+	FieldClosingBalance,
+	FieldImportedAt,
+	FieldImportedBy,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "bank_statements"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"account_bank_statements",
+	"tenant_bank_statements",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultImportedAt holds the default value on creation for the "imported_at" field.
+	DefaultImportedAt func() time.Time
+)
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// Format values.
+const (
+	FormatCsv     Format = "csv"
+	FormatOfx     Format = "ofx"
+	FormatCamt053 Format = "camt053"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatCsv, FormatOfx, FormatCamt053:
+		return nil
+	default:
+		return fmt.Errorf("bankstatement: invalid enum value for format field: %q", f)
+	}
+}
+
+// OrderOption defines the ordering options for the BankStatement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByStatementRef orders the results by the statement_ref field.
+func ByStatementRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatementRef, opts...).ToFunc()
+}
+
+// ByFileName orders the results by the file_name field.
+func ByFileName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileName, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByOpeningBalance orders the results by the opening_balance field.
+func ByOpeningBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpeningBalance, opts...).ToFunc()
+}
+
+// ByClosingBalance orders the results by the closing_balance field.
+func ByClosingBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosingBalance, opts...).ToFunc()
+}
+
+// ByImportedAt orders the results by the imported_at field.
+func ByImportedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportedAt, opts...).ToFunc()
+}
+
+// ByImportedBy orders the results by the imported_by field.
+func ByImportedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportedBy, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLinesCount orders the results by lines count.
+func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinesStep(), opts...)
+	}
+}
+
+// ByLines orders the results by lines terms.
+func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
+func newLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+	)
+}
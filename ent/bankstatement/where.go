@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankstatement
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldID, id))
+}
+
+// StatementRef applies equality check predicate on the "statement_ref" field. It's identical to StatementRefEQ.
+func StatementRef(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldStatementRef, v))
+}
+
+// FileName applies equality check predicate on the "file_name" field. It's identical to FileNameEQ.
+func FileName(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFileName, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldCurrency, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// OpeningBalance applies equality check predicate on the "opening_balance" field. It's identical to OpeningBalanceEQ.
+func OpeningBalance(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldOpeningBalance, v))
+}
+
+// ClosingBalance applies equality check predicate on the "closing_balance" field. It's identical to ClosingBalanceEQ.
+func ClosingBalance(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldClosingBalance, v))
+}
+
+// ImportedAt applies equality check predicate on the "imported_at" field. It's identical to ImportedAtEQ.
+func ImportedAt(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldImportedAt, v))
+}
+
+// ImportedBy applies equality check predicate on the "imported_by" field. It's identical to ImportedByEQ.
+func ImportedBy(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldImportedBy, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// StatementRefEQ applies the EQ predicate on the "statement_ref" field.
+func StatementRefEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldStatementRef, v))
+}
+
+// StatementRefNEQ applies the NEQ predicate on the "statement_ref" field.
+func StatementRefNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldStatementRef, v))
+}
+
+// StatementRefIn applies the In predicate on the "statement_ref" field.
+func StatementRefIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldStatementRef, vs...))
+}
+
+// StatementRefNotIn applies the NotIn predicate on the "statement_ref" field.
+func StatementRefNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldStatementRef, vs...))
+}
+
+// StatementRefGT applies the GT predicate on the "statement_ref" field.
+func StatementRefGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldStatementRef, v))
+}
+
+// StatementRefGTE applies the GTE predicate on the "statement_ref" field.
+func StatementRefGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldStatementRef, v))
+}
+
+// StatementRefLT applies the LT predicate on the "statement_ref" field.
+func StatementRefLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldStatementRef, v))
+}
+
+// StatementRefLTE applies the LTE predicate on the "statement_ref" field.
+func StatementRefLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldStatementRef, v))
+}
+
+// StatementRefContains applies the Contains predicate on the "statement_ref" field.
+func StatementRefContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldStatementRef, v))
+}
+
+// StatementRefHasPrefix applies the HasPrefix predicate on the "statement_ref" field.
+func StatementRefHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldStatementRef, v))
+}
+
+// StatementRefHasSuffix applies the HasSuffix predicate on the "statement_ref" field.
+func StatementRefHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldStatementRef, v))
+}
+
+// StatementRefIsNil applies the IsNil predicate on the "statement_ref" field.
+func StatementRefIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldStatementRef))
+}
+
+// StatementRefNotNil applies the NotNil predicate on the "statement_ref" field.
+func StatementRefNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldStatementRef))
+}
+
+// StatementRefEqualFold applies the EqualFold predicate on the "statement_ref" field.
+func StatementRefEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldStatementRef, v))
+}
+
+// StatementRefContainsFold applies the ContainsFold predicate on the "statement_ref" field.
+func StatementRefContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldStatementRef, v))
+}
+
+// FileNameEQ applies the EQ predicate on the "file_name" field.
+func FileNameEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFileName, v))
+}
+
+// FileNameNEQ applies the NEQ predicate on the "file_name" field.
+func FileNameNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldFileName, v))
+}
+
+// FileNameIn applies the In predicate on the "file_name" field.
+func FileNameIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldFileName, vs...))
+}
+
+// FileNameNotIn applies the NotIn predicate on the "file_name" field.
+func FileNameNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldFileName, vs...))
+}
+
+// FileNameGT applies the GT predicate on the "file_name" field.
+func FileNameGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldFileName, v))
+}
+
+// FileNameGTE applies the GTE predicate on the "file_name" field.
+func FileNameGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldFileName, v))
+}
+
+// FileNameLT applies the LT predicate on the "file_name" field.
+func FileNameLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldFileName, v))
+}
+
+// FileNameLTE applies the LTE predicate on the "file_name" field.
+func FileNameLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldFileName, v))
+}
+
+// FileNameContains applies the Contains predicate on the "file_name" field.
+func FileNameContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldFileName, v))
+}
+
+// FileNameHasPrefix applies the HasPrefix predicate on the "file_name" field.
+func FileNameHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldFileName, v))
+}
+
+// FileNameHasSuffix applies the HasSuffix predicate on the "file_name" field.
+func FileNameHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldFileName, v))
+}
+
+// FileNameIsNil applies the IsNil predicate on the "file_name" field.
+func FileNameIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldFileName))
+}
+
+// FileNameNotNil applies the NotNil predicate on the "file_name" field.
+func FileNameNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldFileName))
+}
+
+// FileNameEqualFold applies the EqualFold predicate on the "file_name" field.
+func FileNameEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldFileName, v))
+}
+
+// FileNameContainsFold applies the ContainsFold predicate on the "file_name" field.
+func FileNameContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldFileName, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodStartIsNil applies the IsNil predicate on the "period_start" field.
+func PeriodStartIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldPeriodStart))
+}
+
+// PeriodStartNotNil applies the NotNil predicate on the "period_start" field.
+func PeriodStartNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldPeriodStart))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndIsNil applies the IsNil predicate on the "period_end" field.
+func PeriodEndIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldPeriodEnd))
+}
+
+// PeriodEndNotNil applies the NotNil predicate on the "period_end" field.
+func PeriodEndNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldPeriodEnd))
+}
+
+// OpeningBalanceEQ applies the EQ predicate on the "opening_balance" field.
+func OpeningBalanceEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceNEQ applies the NEQ predicate on the "opening_balance" field.
+func OpeningBalanceNEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceIn applies the In predicate on the "opening_balance" field.
+func OpeningBalanceIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldOpeningBalance, vs...))
+}
+
+// OpeningBalanceNotIn applies the NotIn predicate on the "opening_balance" field.
+func OpeningBalanceNotIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldOpeningBalance, vs...))
+}
+
+// OpeningBalanceGT applies the GT predicate on the "opening_balance" field.
+func OpeningBalanceGT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceGTE applies the GTE predicate on the "opening_balance" field.
+func OpeningBalanceGTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceLT applies the LT predicate on the "opening_balance" field.
+func OpeningBalanceLT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceLTE applies the LTE predicate on the "opening_balance" field.
+func OpeningBalanceLTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceIsNil applies the IsNil predicate on the "opening_balance" field.
+func OpeningBalanceIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldOpeningBalance))
+}
+
+// OpeningBalanceNotNil applies the NotNil predicate on the "opening_balance" field.
+func OpeningBalanceNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldOpeningBalance))
+}
+
+// ClosingBalanceEQ applies the EQ predicate on the "closing_balance" field.
+func ClosingBalanceEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldClosingBalance, v))
+}
+
+// ClosingBalanceNEQ applies the NEQ predicate on the "closing_balance" field.
+func ClosingBalanceNEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldClosingBalance, v))
+}
+
+// ClosingBalanceIn applies the In predicate on the "closing_balance" field.
+func ClosingBalanceIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldClosingBalance, vs...))
+}
+
+// ClosingBalanceNotIn applies the NotIn predicate on the "closing_balance" field.
+func ClosingBalanceNotIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldClosingBalance, vs...))
+}
+
+// ClosingBalanceGT applies the GT predicate on the "closing_balance" field.
+func ClosingBalanceGT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldClosingBalance, v))
+}
+
+// ClosingBalanceGTE applies the GTE predicate on the "closing_balance" field.
+func ClosingBalanceGTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldClosingBalance, v))
+}
+
+// ClosingBalanceLT applies the LT predicate on the "closing_balance" field.
+func ClosingBalanceLT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldClosingBalance, v))
+}
+
+// ClosingBalanceLTE applies the LTE predicate on the "closing_balance" field.
+func ClosingBalanceLTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldClosingBalance, v))
+}
+
+// ClosingBalanceIsNil applies the IsNil predicate on the "closing_balance" field.
+func ClosingBalanceIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldClosingBalance))
+}
+
+// ClosingBalanceNotNil applies the NotNil predicate on the "closing_balance" field.
+func ClosingBalanceNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldClosingBalance))
+}
+
+// ImportedAtEQ applies the EQ predicate on the "imported_at" field.
+func ImportedAtEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldImportedAt, v))
+}
+
+// ImportedAtNEQ applies the NEQ predicate on the "imported_at" field.
+func ImportedAtNEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldImportedAt, v))
+}
+
+// ImportedAtIn applies the In predicate on the "imported_at" field.
+func ImportedAtIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldImportedAt, vs...))
+}
+
+// ImportedAtNotIn applies the NotIn predicate on the "imported_at" field.
+func ImportedAtNotIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldImportedAt, vs...))
+}
+
+// ImportedAtGT applies the GT predicate on the "imported_at" field.
+func ImportedAtGT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldImportedAt, v))
+}
+
+// ImportedAtGTE applies the GTE predicate on the "imported_at" field.
+func ImportedAtGTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldImportedAt, v))
+}
+
+// ImportedAtLT applies the LT predicate on the "imported_at" field.
+func ImportedAtLT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldImportedAt, v))
+}
+
+// ImportedAtLTE applies the LTE predicate on the "imported_at" field.
+func ImportedAtLTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldImportedAt, v))
+}
+
+// ImportedByEQ applies the EQ predicate on the "imported_by" field.
+func ImportedByEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldImportedBy, v))
+}
+
+// ImportedByNEQ applies the NEQ predicate on the "imported_by" field.
+func ImportedByNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldImportedBy, v))
+}
+
+// ImportedByIn applies the In predicate on the "imported_by" field.
+func ImportedByIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldImportedBy, vs...))
+}
+
+// ImportedByNotIn applies the NotIn predicate on the "imported_by" field.
+func ImportedByNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldImportedBy, vs...))
+}
+
+// ImportedByGT applies the GT predicate on the "imported_by" field.
+func ImportedByGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldImportedBy, v))
+}
+
+// ImportedByGTE applies the GTE predicate on the "imported_by" field.
+func ImportedByGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldImportedBy, v))
+}
+
+// ImportedByLT applies the LT predicate on the "imported_by" field.
+func ImportedByLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldImportedBy, v))
+}
+
+// ImportedByLTE applies the LTE predicate on the "imported_by" field.
+func ImportedByLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldImportedBy, v))
+}
+
+// ImportedByContains applies the Contains predicate on the "imported_by" field.
+func ImportedByContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldImportedBy, v))
+}
+
+// ImportedByHasPrefix applies the HasPrefix predicate on the "imported_by" field.
+func ImportedByHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldImportedBy, v))
+}
+
+// ImportedByHasSuffix applies the HasSuffix predicate on the "imported_by" field.
+func ImportedByHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldImportedBy, v))
+}
+
+// ImportedByIsNil applies the IsNil predicate on the "imported_by" field.
+func ImportedByIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldImportedBy))
+}
+
+// ImportedByNotNil applies the NotNil predicate on the "imported_by" field.
+func ImportedByNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldImportedBy))
+}
+
+// ImportedByEqualFold applies the EqualFold predicate on the "imported_by" field.
+func ImportedByEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldImportedBy, v))
+}
+
+// ImportedByContainsFold applies the ContainsFold predicate on the "imported_by" field.
+func ImportedByContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldImportedBy, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.BankStatement {
+	return predicate.BankStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.BankStatement {
+	return predicate.BankStatement(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.BankStatement {
+	return predicate.BankStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.BankStatement {
+	return predicate.BankStatement(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLines applies the HasEdge predicate on the "lines" edge.
+func HasLines() predicate.BankStatement {
+	return predicate.BankStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinesWith applies the HasEdge predicate on the "lines" edge with a given conditions (other predicates).
+func HasLinesWith(preds ...predicate.BankStatementLine) predicate.BankStatement {
+	return predicate.BankStatement(func(s *sql.Selector) {
+		step := newLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BankStatement) predicate.BankStatement {
+	return predicate.BankStatement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BankStatement) predicate.BankStatement {
+	return predicate.BankStatement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BankStatement) predicate.BankStatement {
+	return predicate.BankStatement(sql.NotPredicates(p))
+}
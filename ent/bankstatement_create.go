@@ -0,0 +1,461 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatementCreate is the builder for creating a BankStatement entity.
+type BankStatementCreate struct {
+	config
+	mutation *BankStatementMutation
+	hooks    []Hook
+}
+
+// SetFormat sets the "format" field.
+func (_c *BankStatementCreate) SetFormat(v bankstatement.Format) *BankStatementCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetStatementRef sets the "statement_ref" field.
+func (_c *BankStatementCreate) SetStatementRef(v string) *BankStatementCreate {
+	_c.mutation.SetStatementRef(v)
+	return _c
+}
+
+// SetNillableStatementRef sets the "statement_ref" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableStatementRef(v *string) *BankStatementCreate {
+	if v != nil {
+		_c.SetStatementRef(*v)
+	}
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *BankStatementCreate) SetFileName(v string) *BankStatementCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableFileName(v *string) *BankStatementCreate {
+	if v != nil {
+		_c.SetFileName(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *BankStatementCreate) SetCurrency(v string) *BankStatementCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableCurrency(v *string) *BankStatementCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *BankStatementCreate) SetPeriodStart(v time.Time) *BankStatementCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillablePeriodStart(v *time.Time) *BankStatementCreate {
+	if v != nil {
+		_c.SetPeriodStart(*v)
+	}
+	return _c
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_c *BankStatementCreate) SetPeriodEnd(v time.Time) *BankStatementCreate {
+	_c.mutation.SetPeriodEnd(v)
+	return _c
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillablePeriodEnd(v *time.Time) *BankStatementCreate {
+	if v != nil {
+		_c.SetPeriodEnd(*v)
+	}
+	return _c
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_c *BankStatementCreate) SetOpeningBalance(v decimal.Decimal) *BankStatementCreate {
+	_c.mutation.SetOpeningBalance(v)
+	return _c
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableOpeningBalance(v *decimal.Decimal) *BankStatementCreate {
+	if v != nil {
+		_c.SetOpeningBalance(*v)
+	}
+	return _c
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_c *BankStatementCreate) SetClosingBalance(v decimal.Decimal) *BankStatementCreate {
+	_c.mutation.SetClosingBalance(v)
+	return _c
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableClosingBalance(v *decimal.Decimal) *BankStatementCreate {
+	if v != nil {
+		_c.SetClosingBalance(*v)
+	}
+	return _c
+}
+
+// SetImportedAt sets the "imported_at" field.
+func (_c *BankStatementCreate) SetImportedAt(v time.Time) *BankStatementCreate {
+	_c.mutation.SetImportedAt(v)
+	return _c
+}
+
+// SetNillableImportedAt sets the "imported_at" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableImportedAt(v *time.Time) *BankStatementCreate {
+	if v != nil {
+		_c.SetImportedAt(*v)
+	}
+	return _c
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (_c *BankStatementCreate) SetImportedBy(v string) *BankStatementCreate {
+	_c.mutation.SetImportedBy(v)
+	return _c
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableImportedBy(v *string) *BankStatementCreate {
+	if v != nil {
+		_c.SetImportedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *BankStatementCreate) SetTenantID(id int) *BankStatementCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *BankStatementCreate) SetTenant(v *Tenant) *BankStatementCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *BankStatementCreate) SetAccountID(id int) *BankStatementCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *BankStatementCreate) SetAccount(v *Account) *BankStatementCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the BankStatementLine entity by IDs.
+func (_c *BankStatementCreate) AddLineIDs(ids ...int) *BankStatementCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the BankStatementLine entity.
+func (_c *BankStatementCreate) AddLines(v ...*BankStatementLine) *BankStatementCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// Mutation returns the BankStatementMutation object of the builder.
+func (_c *BankStatementCreate) Mutation() *BankStatementMutation {
+	return _c.mutation
+}
+
+// Save creates the BankStatement in the database.
+func (_c *BankStatementCreate) Save(ctx context.Context) (*BankStatement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BankStatementCreate) SaveX(ctx context.Context) *BankStatement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankStatementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankStatementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BankStatementCreate) defaults() {
+	if _, ok := _c.mutation.ImportedAt(); !ok {
+		v := bankstatement.DefaultImportedAt()
+		_c.mutation.SetImportedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BankStatementCreate) check() error {
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "BankStatement.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := bankstatement.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "BankStatement.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ImportedAt(); !ok {
+		return &ValidationError{Name: "imported_at", err: errors.New(`ent: missing required field "BankStatement.imported_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "BankStatement.tenant"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "BankStatement.account"`)}
+	}
+	return nil
+}
+
+func (_c *BankStatementCreate) sqlSave(ctx context.Context) (*BankStatement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BankStatementCreate) createSpec() (*BankStatement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BankStatement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bankstatement.Table, sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(bankstatement.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.StatementRef(); ok {
+		_spec.SetField(bankstatement.FieldStatementRef, field.TypeString, value)
+		_node.StatementRef = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(bankstatement.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(bankstatement.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(bankstatement.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = &value
+	}
+	if value, ok := _c.mutation.PeriodEnd(); ok {
+		_spec.SetField(bankstatement.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = &value
+	}
+	if value, ok := _c.mutation.OpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldOpeningBalance, field.TypeOther, value)
+		_node.OpeningBalance = &value
+	}
+	if value, ok := _c.mutation.ClosingBalance(); ok {
+		_spec.SetField(bankstatement.FieldClosingBalance, field.TypeOther, value)
+		_node.ClosingBalance = &value
+	}
+	if value, ok := _c.mutation.ImportedAt(); ok {
+		_spec.SetField(bankstatement.FieldImportedAt, field.TypeTime, value)
+		_node.ImportedAt = value
+	}
+	if value, ok := _c.mutation.ImportedBy(); ok {
+		_spec.SetField(bankstatement.FieldImportedBy, field.TypeString, value)
+		_node.ImportedBy = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.TenantTable,
+			Columns: []string{bankstatement.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_bank_statements = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.AccountTable,
+			Columns: []string{bankstatement.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_bank_statements = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.LinesTable,
+			Columns: []string{bankstatement.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BankStatementCreateBulk is the builder for creating many BankStatement entities in bulk.
+type BankStatementCreateBulk struct {
+	config
+	err      error
+	builders []*BankStatementCreate
+}
+
+// Save creates the BankStatement entities in the database.
+func (_c *BankStatementCreateBulk) Save(ctx context.Context) ([]*BankStatement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BankStatement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BankStatementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BankStatementCreateBulk) SaveX(ctx context.Context) []*BankStatement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankStatementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankStatementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1030 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatementUpdate is the builder for updating BankStatement entities.
+type BankStatementUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BankStatementMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BankStatementUpdate builder.
+func (_u *BankStatementUpdate) Where(ps ...predicate.BankStatement) *BankStatementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *BankStatementUpdate) SetFormat(v bankstatement.Format) *BankStatementUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableFormat(v *bankstatement.Format) *BankStatementUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetStatementRef sets the "statement_ref" field.
+func (_u *BankStatementUpdate) SetStatementRef(v string) *BankStatementUpdate {
+	_u.mutation.SetStatementRef(v)
+	return _u
+}
+
+// SetNillableStatementRef sets the "statement_ref" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableStatementRef(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetStatementRef(*v)
+	}
+	return _u
+}
+
+// ClearStatementRef clears the value of the "statement_ref" field.
+func (_u *BankStatementUpdate) ClearStatementRef() *BankStatementUpdate {
+	_u.mutation.ClearStatementRef()
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *BankStatementUpdate) SetFileName(v string) *BankStatementUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableFileName(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (_u *BankStatementUpdate) ClearFileName() *BankStatementUpdate {
+	_u.mutation.ClearFileName()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankStatementUpdate) SetCurrency(v string) *BankStatementUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableCurrency(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *BankStatementUpdate) ClearCurrency() *BankStatementUpdate {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BankStatementUpdate) SetPeriodStart(v time.Time) *BankStatementUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillablePeriodStart(v *time.Time) *BankStatementUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (_u *BankStatementUpdate) ClearPeriodStart() *BankStatementUpdate {
+	_u.mutation.ClearPeriodStart()
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *BankStatementUpdate) SetPeriodEnd(v time.Time) *BankStatementUpdate {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillablePeriodEnd(v *time.Time) *BankStatementUpdate {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (_u *BankStatementUpdate) ClearPeriodEnd() *BankStatementUpdate {
+	_u.mutation.ClearPeriodEnd()
+	return _u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_u *BankStatementUpdate) SetOpeningBalance(v decimal.Decimal) *BankStatementUpdate {
+	_u.mutation.SetOpeningBalance(v)
+	return _u
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableOpeningBalance(v *decimal.Decimal) *BankStatementUpdate {
+	if v != nil {
+		_u.SetOpeningBalance(*v)
+	}
+	return _u
+}
+
+// ClearOpeningBalance clears the value of the "opening_balance" field.
+func (_u *BankStatementUpdate) ClearOpeningBalance() *BankStatementUpdate {
+	_u.mutation.ClearOpeningBalance()
+	return _u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_u *BankStatementUpdate) SetClosingBalance(v decimal.Decimal) *BankStatementUpdate {
+	_u.mutation.SetClosingBalance(v)
+	return _u
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableClosingBalance(v *decimal.Decimal) *BankStatementUpdate {
+	if v != nil {
+		_u.SetClosingBalance(*v)
+	}
+	return _u
+}
+
+// ClearClosingBalance clears the value of the "closing_balance" field.
+func (_u *BankStatementUpdate) ClearClosingBalance() *BankStatementUpdate {
+	_u.mutation.ClearClosingBalance()
+	return _u
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (_u *BankStatementUpdate) SetImportedBy(v string) *BankStatementUpdate {
+	_u.mutation.SetImportedBy(v)
+	return _u
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableImportedBy(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetImportedBy(*v)
+	}
+	return _u
+}
+
+// ClearImportedBy clears the value of the "imported_by" field.
+func (_u *BankStatementUpdate) ClearImportedBy() *BankStatementUpdate {
+	_u.mutation.ClearImportedBy()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *BankStatementUpdate) SetTenantID(id int) *BankStatementUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *BankStatementUpdate) SetTenant(v *Tenant) *BankStatementUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *BankStatementUpdate) SetAccountID(id int) *BankStatementUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *BankStatementUpdate) SetAccount(v *Account) *BankStatementUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the BankStatementLine entity by IDs.
+func (_u *BankStatementUpdate) AddLineIDs(ids ...int) *BankStatementUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the BankStatementLine entity.
+func (_u *BankStatementUpdate) AddLines(v ...*BankStatementLine) *BankStatementUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// Mutation returns the BankStatementMutation object of the builder.
+func (_u *BankStatementUpdate) Mutation() *BankStatementMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *BankStatementUpdate) ClearTenant() *BankStatementUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *BankStatementUpdate) ClearAccount() *BankStatementUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the BankStatementLine entity.
+func (_u *BankStatementUpdate) ClearLines() *BankStatementUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to BankStatementLine entities by IDs.
+func (_u *BankStatementUpdate) RemoveLineIDs(ids ...int) *BankStatementUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to BankStatementLine entities.
+func (_u *BankStatementUpdate) RemoveLines(v ...*BankStatementLine) *BankStatementUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BankStatementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankStatementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BankStatementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankStatementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankStatementUpdate) check() error {
+	if v, ok := _u.mutation.Format(); ok {
+		if err := bankstatement.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "BankStatement.format": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatement.tenant"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatement.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BankStatementUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BankStatementUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BankStatementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankstatement.Table, bankstatement.Columns, sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(bankstatement.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatementRef(); ok {
+		_spec.SetField(bankstatement.FieldStatementRef, field.TypeString, value)
+	}
+	if _u.mutation.StatementRefCleared() {
+		_spec.ClearField(bankstatement.FieldStatementRef, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(bankstatement.FieldFileName, field.TypeString, value)
+	}
+	if _u.mutation.FileNameCleared() {
+		_spec.ClearField(bankstatement.FieldFileName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(bankstatement.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(bankstatement.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(bankstatement.FieldPeriodStart, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodStartCleared() {
+		_spec.ClearField(bankstatement.FieldPeriodStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(bankstatement.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodEndCleared() {
+		_spec.ClearField(bankstatement.FieldPeriodEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldOpeningBalance, field.TypeOther, value)
+	}
+	if _u.mutation.OpeningBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldOpeningBalance, field.TypeOther)
+	}
+	if value, ok := _u.mutation.ClosingBalance(); ok {
+		_spec.SetField(bankstatement.FieldClosingBalance, field.TypeOther, value)
+	}
+	if _u.mutation.ClosingBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldClosingBalance, field.TypeOther)
+	}
+	if value, ok := _u.mutation.ImportedBy(); ok {
+		_spec.SetField(bankstatement.FieldImportedBy, field.TypeString, value)
+	}
+	if _u.mutation.ImportedByCleared() {
+		_spec.ClearField(bankstatement.FieldImportedBy, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.TenantTable,
+			Columns: []string{bankstatement.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.TenantTable,
+			Columns: []string{bankstatement.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.AccountTable,
+			Columns: []string{bankstatement.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.AccountTable,
+			Columns: []string{bankstatement.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.LinesTable,
+			Columns: []string{bankstatement.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.LinesTable,
+			Columns: []string{bankstatement.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.LinesTable,
+			Columns: []string{bankstatement.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BankStatementUpdateOne is the builder for updating a single BankStatement entity.
+type BankStatementUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BankStatementMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFormat sets the "format" field.
+func (_u *BankStatementUpdateOne) SetFormat(v bankstatement.Format) *BankStatementUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableFormat(v *bankstatement.Format) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetStatementRef sets the "statement_ref" field.
+func (_u *BankStatementUpdateOne) SetStatementRef(v string) *BankStatementUpdateOne {
+	_u.mutation.SetStatementRef(v)
+	return _u
+}
+
+// SetNillableStatementRef sets the "statement_ref" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableStatementRef(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetStatementRef(*v)
+	}
+	return _u
+}
+
+// ClearStatementRef clears the value of the "statement_ref" field.
+func (_u *BankStatementUpdateOne) ClearStatementRef() *BankStatementUpdateOne {
+	_u.mutation.ClearStatementRef()
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *BankStatementUpdateOne) SetFileName(v string) *BankStatementUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableFileName(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (_u *BankStatementUpdateOne) ClearFileName() *BankStatementUpdateOne {
+	_u.mutation.ClearFileName()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankStatementUpdateOne) SetCurrency(v string) *BankStatementUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableCurrency(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *BankStatementUpdateOne) ClearCurrency() *BankStatementUpdateOne {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BankStatementUpdateOne) SetPeriodStart(v time.Time) *BankStatementUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillablePeriodStart(v *time.Time) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (_u *BankStatementUpdateOne) ClearPeriodStart() *BankStatementUpdateOne {
+	_u.mutation.ClearPeriodStart()
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *BankStatementUpdateOne) SetPeriodEnd(v time.Time) *BankStatementUpdateOne {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillablePeriodEnd(v *time.Time) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (_u *BankStatementUpdateOne) ClearPeriodEnd() *BankStatementUpdateOne {
+	_u.mutation.ClearPeriodEnd()
+	return _u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_u *BankStatementUpdateOne) SetOpeningBalance(v decimal.Decimal) *BankStatementUpdateOne {
+	_u.mutation.SetOpeningBalance(v)
+	return _u
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableOpeningBalance(v *decimal.Decimal) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetOpeningBalance(*v)
+	}
+	return _u
+}
+
+// ClearOpeningBalance clears the value of the "opening_balance" field.
+func (_u *BankStatementUpdateOne) ClearOpeningBalance() *BankStatementUpdateOne {
+	_u.mutation.ClearOpeningBalance()
+	return _u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_u *BankStatementUpdateOne) SetClosingBalance(v decimal.Decimal) *BankStatementUpdateOne {
+	_u.mutation.SetClosingBalance(v)
+	return _u
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableClosingBalance(v *decimal.Decimal) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetClosingBalance(*v)
+	}
+	return _u
+}
+
+// ClearClosingBalance clears the value of the "closing_balance" field.
+func (_u *BankStatementUpdateOne) ClearClosingBalance() *BankStatementUpdateOne {
+	_u.mutation.ClearClosingBalance()
+	return _u
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (_u *BankStatementUpdateOne) SetImportedBy(v string) *BankStatementUpdateOne {
+	_u.mutation.SetImportedBy(v)
+	return _u
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableImportedBy(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetImportedBy(*v)
+	}
+	return _u
+}
+
+// ClearImportedBy clears the value of the "imported_by" field.
+func (_u *BankStatementUpdateOne) ClearImportedBy() *BankStatementUpdateOne {
+	_u.mutation.ClearImportedBy()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *BankStatementUpdateOne) SetTenantID(id int) *BankStatementUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *BankStatementUpdateOne) SetTenant(v *Tenant) *BankStatementUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *BankStatementUpdateOne) SetAccountID(id int) *BankStatementUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *BankStatementUpdateOne) SetAccount(v *Account) *BankStatementUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the BankStatementLine entity by IDs.
+func (_u *BankStatementUpdateOne) AddLineIDs(ids ...int) *BankStatementUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the BankStatementLine entity.
+func (_u *BankStatementUpdateOne) AddLines(v ...*BankStatementLine) *BankStatementUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// Mutation returns the BankStatementMutation object of the builder.
+func (_u *BankStatementUpdateOne) Mutation() *BankStatementMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *BankStatementUpdateOne) ClearTenant() *BankStatementUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *BankStatementUpdateOne) ClearAccount() *BankStatementUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the BankStatementLine entity.
+func (_u *BankStatementUpdateOne) ClearLines() *BankStatementUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to BankStatementLine entities by IDs.
+func (_u *BankStatementUpdateOne) RemoveLineIDs(ids ...int) *BankStatementUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to BankStatementLine entities.
+func (_u *BankStatementUpdateOne) RemoveLines(v ...*BankStatementLine) *BankStatementUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// Where appends a list predicates to the BankStatementUpdate builder.
+func (_u *BankStatementUpdateOne) Where(ps ...predicate.BankStatement) *BankStatementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BankStatementUpdateOne) Select(field string, fields ...string) *BankStatementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BankStatement entity.
+func (_u *BankStatementUpdateOne) Save(ctx context.Context) (*BankStatement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankStatementUpdateOne) SaveX(ctx context.Context) *BankStatement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BankStatementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankStatementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankStatementUpdateOne) check() error {
+	if v, ok := _u.mutation.Format(); ok {
+		if err := bankstatement.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "BankStatement.format": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatement.tenant"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatement.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BankStatementUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BankStatementUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BankStatementUpdateOne) sqlSave(ctx context.Context) (_node *BankStatement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankstatement.Table, bankstatement.Columns, sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BankStatement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bankstatement.FieldID)
+		for _, f := range fields {
+			if !bankstatement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bankstatement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(bankstatement.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatementRef(); ok {
+		_spec.SetField(bankstatement.FieldStatementRef, field.TypeString, value)
+	}
+	if _u.mutation.StatementRefCleared() {
+		_spec.ClearField(bankstatement.FieldStatementRef, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(bankstatement.FieldFileName, field.TypeString, value)
+	}
+	if _u.mutation.FileNameCleared() {
+		_spec.ClearField(bankstatement.FieldFileName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(bankstatement.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(bankstatement.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(bankstatement.FieldPeriodStart, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodStartCleared() {
+		_spec.ClearField(bankstatement.FieldPeriodStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(bankstatement.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodEndCleared() {
+		_spec.ClearField(bankstatement.FieldPeriodEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldOpeningBalance, field.TypeOther, value)
+	}
+	if _u.mutation.OpeningBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldOpeningBalance, field.TypeOther)
+	}
+	if value, ok := _u.mutation.ClosingBalance(); ok {
+		_spec.SetField(bankstatement.FieldClosingBalance, field.TypeOther, value)
+	}
+	if _u.mutation.ClosingBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldClosingBalance, field.TypeOther)
+	}
+	if value, ok := _u.mutation.ImportedBy(); ok {
+		_spec.SetField(bankstatement.FieldImportedBy, field.TypeString, value)
+	}
+	if _u.mutation.ImportedByCleared() {
+		_spec.ClearField(bankstatement.FieldImportedBy, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.TenantTable,
+			Columns: []string{bankstatement.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.TenantTable,
+			Columns: []string{bankstatement.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.AccountTable,
+			Columns: []string{bankstatement.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatement.AccountTable,
+			Columns: []string{bankstatement.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.LinesTable,
+			Columns: []string{bankstatement.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.LinesTable,
+			Columns: []string{bankstatement.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.LinesTable,
+			Columns: []string{bankstatement.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &BankStatement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
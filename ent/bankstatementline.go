@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
+	"sent/ent/ledgerentry"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatementLine is the model entity for the BankStatementLine schema.
+type BankStatementLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID string `json:"external_id,omitempty"`
+	// BookingDate holds the value of the "booking_date" field.
+	BookingDate time.Time `json:"booking_date,omitempty"`
+	// ValueDate holds the value of the "value_date" field.
+	ValueDate *time.Time `json:"value_date,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// Counterparty holds the value of the "counterparty" field.
+	Counterparty string `json:"counterparty,omitempty"`
+	// Status holds the value of the "status" field.
+	Status bankstatementline.Status `json:"status,omitempty"`
+	// MatchScore holds the value of the "match_score" field.
+	MatchScore int `json:"match_score,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BankStatementLineQuery when eager-loading is set.
+	Edges                                 BankStatementLineEdges `json:"edges"`
+	account_bank_statement_lines          *int
+	bank_statement_lines                  *int
+	bank_statement_line_suggested_account *int
+	bank_statement_line_suggested_rule    *int
+	tenant_bank_statement_lines           *int
+	selectValues                          sql.SelectValues
+}
+
+// BankStatementLineEdges holds the relations/edges for other nodes in the graph.
+type BankStatementLineEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Statement holds the value of the statement edge.
+	Statement *BankStatement `json:"statement,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// LedgerEntry holds the value of the ledger_entry edge.
+	LedgerEntry *LedgerEntry `json:"ledger_entry,omitempty"`
+	// SuggestedAccount holds the value of the suggested_account edge.
+	SuggestedAccount *Account `json:"suggested_account,omitempty"`
+	// SuggestedRule holds the value of the suggested_rule edge.
+	SuggestedRule *BankRule `json:"suggested_rule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankStatementLineEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// StatementOrErr returns the Statement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankStatementLineEdges) StatementOrErr() (*BankStatement, error) {
+	if e.Statement != nil {
+		return e.Statement, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: bankstatement.Label}
+	}
+	return nil, &NotLoadedError{edge: "statement"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankStatementLineEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// LedgerEntryOrErr returns the LedgerEntry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankStatementLineEdges) LedgerEntryOrErr() (*LedgerEntry, error) {
+	if e.LedgerEntry != nil {
+		return e.LedgerEntry, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: ledgerentry.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger_entry"}
+}
+
+// SuggestedAccountOrErr returns the SuggestedAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankStatementLineEdges) SuggestedAccountOrErr() (*Account, error) {
+	if e.SuggestedAccount != nil {
+		return e.SuggestedAccount, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "suggested_account"}
+}
+
+// SuggestedRuleOrErr returns the SuggestedRule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankStatementLineEdges) SuggestedRuleOrErr() (*BankRule, error) {
+	if e.SuggestedRule != nil {
+		return e.SuggestedRule, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: bankrule.Label}
+	}
+	return nil, &NotLoadedError{edge: "suggested_rule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BankStatementLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bankstatementline.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case bankstatementline.FieldID, bankstatementline.FieldMatchScore:
+			values[i] = new(sql.NullInt64)
+		case bankstatementline.FieldExternalID, bankstatementline.FieldCurrency, bankstatementline.FieldDescription, bankstatementline.FieldReference, bankstatementline.FieldCounterparty, bankstatementline.FieldStatus:
+			values[i] = new(sql.NullString)
+		case bankstatementline.FieldBookingDate, bankstatementline.FieldValueDate, bankstatementline.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case bankstatementline.ForeignKeys[0]: // account_bank_statement_lines
+			values[i] = new(sql.NullInt64)
+		case bankstatementline.ForeignKeys[1]: // bank_statement_lines
+			values[i] = new(sql.NullInt64)
+		case bankstatementline.ForeignKeys[2]: // bank_statement_line_suggested_account
+			values[i] = new(sql.NullInt64)
+		case bankstatementline.ForeignKeys[3]: // bank_statement_line_suggested_rule
+			values[i] = new(sql.NullInt64)
+		case bankstatementline.ForeignKeys[4]: // tenant_bank_statement_lines
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BankStatementLine fields.
+func (_m *BankStatementLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bankstatementline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case bankstatementline.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = value.String
+			}
+		case bankstatementline.FieldBookingDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field booking_date", values[i])
+			} else if value.Valid {
+				_m.BookingDate = value.Time
+			}
+		case bankstatementline.FieldValueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field value_date", values[i])
+			} else if value.Valid {
+				_m.ValueDate = new(time.Time)
+				*_m.ValueDate = value.Time
+			}
+		case bankstatementline.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case bankstatementline.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case bankstatementline.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bankstatementline.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case bankstatementline.FieldCounterparty:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field counterparty", values[i])
+			} else if value.Valid {
+				_m.Counterparty = value.String
+			}
+		case bankstatementline.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = bankstatementline.Status(value.String)
+			}
+		case bankstatementline.FieldMatchScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field match_score", values[i])
+			} else if value.Valid {
+				_m.MatchScore = int(value.Int64)
+			}
+		case bankstatementline.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bankstatementline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field account_bank_statement_lines", value)
+			} else if value.Valid {
+				_m.account_bank_statement_lines = new(int)
+				*_m.account_bank_statement_lines = int(value.Int64)
+			}
+		case bankstatementline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bank_statement_lines", value)
+			} else if value.Valid {
+				_m.bank_statement_lines = new(int)
+				*_m.bank_statement_lines = int(value.Int64)
+			}
+		case bankstatementline.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bank_statement_line_suggested_account", value)
+			} else if value.Valid {
+				_m.bank_statement_line_suggested_account = new(int)
+				*_m.bank_statement_line_suggested_account = int(value.Int64)
+			}
+		case bankstatementline.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bank_statement_line_suggested_rule", value)
+			} else if value.Valid {
+				_m.bank_statement_line_suggested_rule = new(int)
+				*_m.bank_statement_line_suggested_rule = int(value.Int64)
+			}
+		case bankstatementline.ForeignKeys[4]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_bank_statement_lines", value)
+			} else if value.Valid {
+				_m.tenant_bank_statement_lines = new(int)
+				*_m.tenant_bank_statement_lines = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BankStatementLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *BankStatementLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the BankStatementLine entity.
+func (_m *BankStatementLine) QueryTenant() *TenantQuery {
+	return NewBankStatementLineClient(_m.config).QueryTenant(_m)
+}
+
+// QueryStatement queries the "statement" edge of the BankStatementLine entity.
+func (_m *BankStatementLine) QueryStatement() *BankStatementQuery {
+	return NewBankStatementLineClient(_m.config).QueryStatement(_m)
+}
+
+// QueryAccount queries the "account" edge of the BankStatementLine entity.
+func (_m *BankStatementLine) QueryAccount() *AccountQuery {
+	return NewBankStatementLineClient(_m.config).QueryAccount(_m)
+}
+
+// QueryLedgerEntry queries the "ledger_entry" edge of the BankStatementLine entity.
+func (_m *BankStatementLine) QueryLedgerEntry() *LedgerEntryQuery {
+	return NewBankStatementLineClient(_m.config).QueryLedgerEntry(_m)
+}
+
+// QuerySuggestedAccount queries the "suggested_account" edge of the BankStatementLine entity.
+func (_m *BankStatementLine) QuerySuggestedAccount() *AccountQuery {
+	return NewBankStatementLineClient(_m.config).QuerySuggestedAccount(_m)
+}
+
+// QuerySuggestedRule queries the "suggested_rule" edge of the BankStatementLine entity.
+func (_m *BankStatementLine) QuerySuggestedRule() *BankRuleQuery {
+	return NewBankStatementLineClient(_m.config).QuerySuggestedRule(_m)
+}
+
+// Update returns a builder for updating this BankStatementLine.
+// Note that you need to call BankStatementLine.Unwrap() before calling this method if this BankStatementLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BankStatementLine) Update() *BankStatementLineUpdateOne {
+	return NewBankStatementLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BankStatementLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BankStatementLine) Unwrap() *BankStatementLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BankStatementLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BankStatementLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("BankStatementLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
+	builder.WriteString(", ")
+	builder.WriteString("booking_date=")
+	builder.WriteString(_m.BookingDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValueDate; v != nil {
+		builder.WriteString("value_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("counterparty=")
+	builder.WriteString(_m.Counterparty)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("match_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MatchScore))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BankStatementLines is a parsable slice of BankStatementLine.
+type BankStatementLines []*BankStatementLine
@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankstatementline
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the bankstatementline type in the database.
+	Label = "bank_statement_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
+	// FieldBookingDate holds the string denoting the booking_date field in the database.
+	FieldBookingDate = "booking_date"
+	// FieldValueDate holds the string denoting the value_date field in the database.
+	FieldValueDate = "value_date"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldCounterparty holds the string denoting the counterparty field in the database.
+	FieldCounterparty = "counterparty"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMatchScore holds the string denoting the match_score field in the database.
+	FieldMatchScore = "match_score"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeStatement holds the string denoting the statement edge name in mutations.
+	EdgeStatement = "statement"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeLedgerEntry holds the string denoting the ledger_entry edge name in mutations.
+	EdgeLedgerEntry = "ledger_entry"
+	// EdgeSuggestedAccount holds the string denoting the suggested_account edge name in mutations.
+	EdgeSuggestedAccount = "suggested_account"
+	// EdgeSuggestedRule holds the string denoting the suggested_rule edge name in mutations.
+	EdgeSuggestedRule = "suggested_rule"
+	// Table holds the table name of the bankstatementline in the database.
+	Table = "bank_statement_lines"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "bank_statement_lines"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_bank_statement_lines"
+	// StatementTable is the table that holds the statement relation/edge.
+	StatementTable = "bank_statement_lines"
+	// StatementInverseTable is the table name for the BankStatement entity.
+	// It exists in this package in order to avoid circular dependency with the "bankstatement" package.
+	StatementInverseTable = "bank_statements"
+	// StatementColumn is the table column denoting the statement relation/edge.
+	StatementColumn = "bank_statement_lines"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "bank_statement_lines"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_bank_statement_lines"
+	// LedgerEntryTable is the table that holds the ledger_entry relation/edge.
+	LedgerEntryTable = "ledger_entries"
+	// LedgerEntryInverseTable is the table name for the LedgerEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgerentry" package.
+	LedgerEntryInverseTable = "ledger_entries"
+	// LedgerEntryColumn is the table column denoting the ledger_entry relation/edge.
+	LedgerEntryColumn = "bank_statement_line_ledger_entry"
+	// SuggestedAccountTable is the table that holds the suggested_account relation/edge.
+	SuggestedAccountTable = "bank_statement_lines"
+	// SuggestedAccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	SuggestedAccountInverseTable = "accounts"
+	// SuggestedAccountColumn is the table column denoting the suggested_account relation/edge.
+	SuggestedAccountColumn = "bank_statement_line_suggested_account"
+	// SuggestedRuleTable is the table that holds the suggested_rule relation/edge.
+	SuggestedRuleTable = "bank_statement_lines"
+	// SuggestedRuleInverseTable is the table name for the BankRule entity.
+	// It exists in this package in order to avoid circular dependency with the "bankrule" package.
+	SuggestedRuleInverseTable = "bank_rules"
+	// SuggestedRuleColumn is the table column denoting the suggested_rule relation/edge.
+	SuggestedRuleColumn = "bank_statement_line_suggested_rule"
+)
+
+// Columns holds all SQL columns for bankstatementline fields.
+var Columns = []string{
+	FieldID,
+	FieldExternalID,
+	FieldBookingDate,
+	FieldValueDate,
+	FieldAmount,
+	FieldCurrency,
+	FieldDescription,
+	FieldReference,
+	FieldCounterparty,
+	FieldStatus,
+	FieldMatchScore,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "bank_statement_lines"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"account_bank_statement_lines",
+	"bank_statement_lines",
+	"bank_statement_line_suggested_account",
+	"bank_statement_line_suggested_rule",
+	"tenant_bank_statement_lines",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAmount holds the default value on creation for the "amount" field.
+	DefaultAmount decimal.Decimal
+	// DefaultMatchScore holds the default value on creation for the "match_score" field.
+	DefaultMatchScore int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusUnmatched is the default value of the Status enum.
+const DefaultStatus = StatusUnmatched
+
+// Status values.
+const (
+	StatusUnmatched Status = "unmatched"
+	StatusMatched   Status = "matched"
+	StatusSuggested Status = "suggested"
+	StatusPosted    Status = "posted"
+	StatusIgnored   Status = "ignored"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusUnmatched, StatusMatched, StatusSuggested, StatusPosted, StatusIgnored:
+		return nil
+	default:
+		return fmt.Errorf("bankstatementline: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the BankStatementLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
+}
+
+// ByBookingDate orders the results by the booking_date field.
+func ByBookingDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBookingDate, opts...).ToFunc()
+}
+
+// ByValueDate orders the results by the value_date field.
+func ByValueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValueDate, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByCounterparty orders the results by the counterparty field.
+func ByCounterparty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCounterparty, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMatchScore orders the results by the match_score field.
+func ByMatchScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchScore, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByStatementField orders the results by statement field.
+func ByStatementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatementStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLedgerEntryField orders the results by ledger_entry field.
+func ByLedgerEntryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerEntryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySuggestedAccountField orders the results by suggested_account field.
+func BySuggestedAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSuggestedAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySuggestedRuleField orders the results by suggested_rule field.
+func BySuggestedRuleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSuggestedRuleStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newStatementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StatementTable, StatementColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
+func newLedgerEntryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerEntryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, LedgerEntryTable, LedgerEntryColumn),
+	)
+}
+func newSuggestedAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SuggestedAccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SuggestedAccountTable, SuggestedAccountColumn),
+	)
+}
+func newSuggestedRuleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SuggestedRuleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SuggestedRuleTable, SuggestedRuleColumn),
+	)
+}
@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankstatementline
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldID, id))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldExternalID, v))
+}
+
+// BookingDate applies equality check predicate on the "booking_date" field. It's identical to BookingDateEQ.
+func BookingDate(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldBookingDate, v))
+}
+
+// ValueDate applies equality check predicate on the "value_date" field. It's identical to ValueDateEQ.
+func ValueDate(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldValueDate, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldReference, v))
+}
+
+// Counterparty applies equality check predicate on the "counterparty" field. It's identical to CounterpartyEQ.
+func Counterparty(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldCounterparty, v))
+}
+
+// MatchScore applies equality check predicate on the "match_score" field. It's identical to MatchScoreEQ.
+func MatchScore(v int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldMatchScore, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// BookingDateEQ applies the EQ predicate on the "booking_date" field.
+func BookingDateEQ(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldBookingDate, v))
+}
+
+// BookingDateNEQ applies the NEQ predicate on the "booking_date" field.
+func BookingDateNEQ(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldBookingDate, v))
+}
+
+// BookingDateIn applies the In predicate on the "booking_date" field.
+func BookingDateIn(vs ...time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldBookingDate, vs...))
+}
+
+// BookingDateNotIn applies the NotIn predicate on the "booking_date" field.
+func BookingDateNotIn(vs ...time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldBookingDate, vs...))
+}
+
+// BookingDateGT applies the GT predicate on the "booking_date" field.
+func BookingDateGT(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldBookingDate, v))
+}
+
+// BookingDateGTE applies the GTE predicate on the "booking_date" field.
+func BookingDateGTE(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldBookingDate, v))
+}
+
+// BookingDateLT applies the LT predicate on the "booking_date" field.
+func BookingDateLT(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldBookingDate, v))
+}
+
+// BookingDateLTE applies the LTE predicate on the "booking_date" field.
+func BookingDateLTE(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldBookingDate, v))
+}
+
+// ValueDateEQ applies the EQ predicate on the "value_date" field.
+func ValueDateEQ(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldValueDate, v))
+}
+
+// ValueDateNEQ applies the NEQ predicate on the "value_date" field.
+func ValueDateNEQ(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldValueDate, v))
+}
+
+// ValueDateIn applies the In predicate on the "value_date" field.
+func ValueDateIn(vs ...time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldValueDate, vs...))
+}
+
+// ValueDateNotIn applies the NotIn predicate on the "value_date" field.
+func ValueDateNotIn(vs ...time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldValueDate, vs...))
+}
+
+// ValueDateGT applies the GT predicate on the "value_date" field.
+func ValueDateGT(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldValueDate, v))
+}
+
+// ValueDateGTE applies the GTE predicate on the "value_date" field.
+func ValueDateGTE(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldValueDate, v))
+}
+
+// ValueDateLT applies the LT predicate on the "value_date" field.
+func ValueDateLT(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldValueDate, v))
+}
+
+// ValueDateLTE applies the LTE predicate on the "value_date" field.
+func ValueDateLTE(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldValueDate, v))
+}
+
+// ValueDateIsNil applies the IsNil predicate on the "value_date" field.
+func ValueDateIsNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIsNull(FieldValueDate))
+}
+
+// ValueDateNotNil applies the NotNil predicate on the "value_date" field.
+func ValueDateNotNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotNull(FieldValueDate))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContainsFold(FieldReference, v))
+}
+
+// CounterpartyEQ applies the EQ predicate on the "counterparty" field.
+func CounterpartyEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldCounterparty, v))
+}
+
+// CounterpartyNEQ applies the NEQ predicate on the "counterparty" field.
+func CounterpartyNEQ(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldCounterparty, v))
+}
+
+// CounterpartyIn applies the In predicate on the "counterparty" field.
+func CounterpartyIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldCounterparty, vs...))
+}
+
+// CounterpartyNotIn applies the NotIn predicate on the "counterparty" field.
+func CounterpartyNotIn(vs ...string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldCounterparty, vs...))
+}
+
+// CounterpartyGT applies the GT predicate on the "counterparty" field.
+func CounterpartyGT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldCounterparty, v))
+}
+
+// CounterpartyGTE applies the GTE predicate on the "counterparty" field.
+func CounterpartyGTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldCounterparty, v))
+}
+
+// CounterpartyLT applies the LT predicate on the "counterparty" field.
+func CounterpartyLT(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldCounterparty, v))
+}
+
+// CounterpartyLTE applies the LTE predicate on the "counterparty" field.
+func CounterpartyLTE(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldCounterparty, v))
+}
+
+// CounterpartyContains applies the Contains predicate on the "counterparty" field.
+func CounterpartyContains(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContains(FieldCounterparty, v))
+}
+
+// CounterpartyHasPrefix applies the HasPrefix predicate on the "counterparty" field.
+func CounterpartyHasPrefix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasPrefix(FieldCounterparty, v))
+}
+
+// CounterpartyHasSuffix applies the HasSuffix predicate on the "counterparty" field.
+func CounterpartyHasSuffix(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldHasSuffix(FieldCounterparty, v))
+}
+
+// CounterpartyIsNil applies the IsNil predicate on the "counterparty" field.
+func CounterpartyIsNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIsNull(FieldCounterparty))
+}
+
+// CounterpartyNotNil applies the NotNil predicate on the "counterparty" field.
+func CounterpartyNotNil() predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotNull(FieldCounterparty))
+}
+
+// CounterpartyEqualFold applies the EqualFold predicate on the "counterparty" field.
+func CounterpartyEqualFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEqualFold(FieldCounterparty, v))
+}
+
+// CounterpartyContainsFold applies the ContainsFold predicate on the "counterparty" field.
+func CounterpartyContainsFold(v string) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldContainsFold(FieldCounterparty, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MatchScoreEQ applies the EQ predicate on the "match_score" field.
+func MatchScoreEQ(v int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldMatchScore, v))
+}
+
+// MatchScoreNEQ applies the NEQ predicate on the "match_score" field.
+func MatchScoreNEQ(v int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldMatchScore, v))
+}
+
+// MatchScoreIn applies the In predicate on the "match_score" field.
+func MatchScoreIn(vs ...int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldMatchScore, vs...))
+}
+
+// MatchScoreNotIn applies the NotIn predicate on the "match_score" field.
+func MatchScoreNotIn(vs ...int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldMatchScore, vs...))
+}
+
+// MatchScoreGT applies the GT predicate on the "match_score" field.
+func MatchScoreGT(v int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldMatchScore, v))
+}
+
+// MatchScoreGTE applies the GTE predicate on the "match_score" field.
+func MatchScoreGTE(v int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldMatchScore, v))
+}
+
+// MatchScoreLT applies the LT predicate on the "match_score" field.
+func MatchScoreLT(v int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldMatchScore, v))
+}
+
+// MatchScoreLTE applies the LTE predicate on the "match_score" field.
+func MatchScoreLTE(v int) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldMatchScore, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatement applies the HasEdge predicate on the "statement" edge.
+func HasStatement() predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StatementTable, StatementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatementWith applies the HasEdge predicate on the "statement" edge with a given conditions (other predicates).
+func HasStatementWith(preds ...predicate.BankStatement) predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := newStatementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLedgerEntry applies the HasEdge predicate on the "ledger_entry" edge.
+func HasLedgerEntry() predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, LedgerEntryTable, LedgerEntryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerEntryWith applies the HasEdge predicate on the "ledger_entry" edge with a given conditions (other predicates).
+func HasLedgerEntryWith(preds ...predicate.LedgerEntry) predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := newLedgerEntryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSuggestedAccount applies the HasEdge predicate on the "suggested_account" edge.
+func HasSuggestedAccount() predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SuggestedAccountTable, SuggestedAccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSuggestedAccountWith applies the HasEdge predicate on the "suggested_account" edge with a given conditions (other predicates).
+func HasSuggestedAccountWith(preds ...predicate.Account) predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := newSuggestedAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSuggestedRule applies the HasEdge predicate on the "suggested_rule" edge.
+func HasSuggestedRule() predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SuggestedRuleTable, SuggestedRuleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSuggestedRuleWith applies the HasEdge predicate on the "suggested_rule" edge with a given conditions (other predicates).
+func HasSuggestedRuleWith(preds ...predicate.BankRule) predicate.BankStatementLine {
+	return predicate.BankStatementLine(func(s *sql.Selector) {
+		step := newSuggestedRuleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BankStatementLine) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BankStatementLine) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BankStatementLine) predicate.BankStatementLine {
+	return predicate.BankStatementLine(sql.NotPredicates(p))
+}
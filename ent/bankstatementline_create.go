@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
+	"sent/ent/ledgerentry"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatementLineCreate is the builder for creating a BankStatementLine entity.
+type BankStatementLineCreate struct {
+	config
+	mutation *BankStatementLineMutation
+	hooks    []Hook
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *BankStatementLineCreate) SetExternalID(v string) *BankStatementLineCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetBookingDate sets the "booking_date" field.
+func (_c *BankStatementLineCreate) SetBookingDate(v time.Time) *BankStatementLineCreate {
+	_c.mutation.SetBookingDate(v)
+	return _c
+}
+
+// SetValueDate sets the "value_date" field.
+func (_c *BankStatementLineCreate) SetValueDate(v time.Time) *BankStatementLineCreate {
+	_c.mutation.SetValueDate(v)
+	return _c
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableValueDate(v *time.Time) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetValueDate(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BankStatementLineCreate) SetAmount(v decimal.Decimal) *BankStatementLineCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableAmount(v *decimal.Decimal) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *BankStatementLineCreate) SetCurrency(v string) *BankStatementLineCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableCurrency(v *string) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BankStatementLineCreate) SetDescription(v string) *BankStatementLineCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableDescription(v *string) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *BankStatementLineCreate) SetReference(v string) *BankStatementLineCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableReference(v *string) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (_c *BankStatementLineCreate) SetCounterparty(v string) *BankStatementLineCreate {
+	_c.mutation.SetCounterparty(v)
+	return _c
+}
+
+// SetNillableCounterparty sets the "counterparty" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableCounterparty(v *string) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetCounterparty(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BankStatementLineCreate) SetStatus(v bankstatementline.Status) *BankStatementLineCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableStatus(v *bankstatementline.Status) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMatchScore sets the "match_score" field.
+func (_c *BankStatementLineCreate) SetMatchScore(v int) *BankStatementLineCreate {
+	_c.mutation.SetMatchScore(v)
+	return _c
+}
+
+// SetNillableMatchScore sets the "match_score" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableMatchScore(v *int) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetMatchScore(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BankStatementLineCreate) SetCreatedAt(v time.Time) *BankStatementLineCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableCreatedAt(v *time.Time) *BankStatementLineCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *BankStatementLineCreate) SetTenantID(id int) *BankStatementLineCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *BankStatementLineCreate) SetTenant(v *Tenant) *BankStatementLineCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetStatementID sets the "statement" edge to the BankStatement entity by ID.
+func (_c *BankStatementLineCreate) SetStatementID(id int) *BankStatementLineCreate {
+	_c.mutation.SetStatementID(id)
+	return _c
+}
+
+// SetStatement sets the "statement" edge to the BankStatement entity.
+func (_c *BankStatementLineCreate) SetStatement(v *BankStatement) *BankStatementLineCreate {
+	return _c.SetStatementID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *BankStatementLineCreate) SetAccountID(id int) *BankStatementLineCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *BankStatementLineCreate) SetAccount(v *Account) *BankStatementLineCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetLedgerEntryID sets the "ledger_entry" edge to the LedgerEntry entity by ID.
+func (_c *BankStatementLineCreate) SetLedgerEntryID(id int) *BankStatementLineCreate {
+	_c.mutation.SetLedgerEntryID(id)
+	return _c
+}
+
+// SetNillableLedgerEntryID sets the "ledger_entry" edge to the LedgerEntry entity by ID if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableLedgerEntryID(id *int) *BankStatementLineCreate {
+	if id != nil {
+		_c = _c.SetLedgerEntryID(*id)
+	}
+	return _c
+}
+
+// SetLedgerEntry sets the "ledger_entry" edge to the LedgerEntry entity.
+func (_c *BankStatementLineCreate) SetLedgerEntry(v *LedgerEntry) *BankStatementLineCreate {
+	return _c.SetLedgerEntryID(v.ID)
+}
+
+// SetSuggestedAccountID sets the "suggested_account" edge to the Account entity by ID.
+func (_c *BankStatementLineCreate) SetSuggestedAccountID(id int) *BankStatementLineCreate {
+	_c.mutation.SetSuggestedAccountID(id)
+	return _c
+}
+
+// SetNillableSuggestedAccountID sets the "suggested_account" edge to the Account entity by ID if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableSuggestedAccountID(id *int) *BankStatementLineCreate {
+	if id != nil {
+		_c = _c.SetSuggestedAccountID(*id)
+	}
+	return _c
+}
+
+// SetSuggestedAccount sets the "suggested_account" edge to the Account entity.
+func (_c *BankStatementLineCreate) SetSuggestedAccount(v *Account) *BankStatementLineCreate {
+	return _c.SetSuggestedAccountID(v.ID)
+}
+
+// SetSuggestedRuleID sets the "suggested_rule" edge to the BankRule entity by ID.
+func (_c *BankStatementLineCreate) SetSuggestedRuleID(id int) *BankStatementLineCreate {
+	_c.mutation.SetSuggestedRuleID(id)
+	return _c
+}
+
+// SetNillableSuggestedRuleID sets the "suggested_rule" edge to the BankRule entity by ID if the given value is not nil.
+func (_c *BankStatementLineCreate) SetNillableSuggestedRuleID(id *int) *BankStatementLineCreate {
+	if id != nil {
+		_c = _c.SetSuggestedRuleID(*id)
+	}
+	return _c
+}
+
+// SetSuggestedRule sets the "suggested_rule" edge to the BankRule entity.
+func (_c *BankStatementLineCreate) SetSuggestedRule(v *BankRule) *BankStatementLineCreate {
+	return _c.SetSuggestedRuleID(v.ID)
+}
+
+// Mutation returns the BankStatementLineMutation object of the builder.
+func (_c *BankStatementLineCreate) Mutation() *BankStatementLineMutation {
+	return _c.mutation
+}
+
+// Save creates the BankStatementLine in the database.
+func (_c *BankStatementLineCreate) Save(ctx context.Context) (*BankStatementLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BankStatementLineCreate) SaveX(ctx context.Context) *BankStatementLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankStatementLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankStatementLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BankStatementLineCreate) defaults() {
+	if _, ok := _c.mutation.Amount(); !ok {
+		v := bankstatementline.DefaultAmount
+		_c.mutation.SetAmount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := bankstatementline.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.MatchScore(); !ok {
+		v := bankstatementline.DefaultMatchScore
+		_c.mutation.SetMatchScore(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bankstatementline.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BankStatementLineCreate) check() error {
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "BankStatementLine.external_id"`)}
+	}
+	if _, ok := _c.mutation.BookingDate(); !ok {
+		return &ValidationError{Name: "booking_date", err: errors.New(`ent: missing required field "BankStatementLine.booking_date"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BankStatementLine.amount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BankStatementLine.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := bankstatementline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BankStatementLine.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MatchScore(); !ok {
+		return &ValidationError{Name: "match_score", err: errors.New(`ent: missing required field "BankStatementLine.match_score"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BankStatementLine.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "BankStatementLine.tenant"`)}
+	}
+	if len(_c.mutation.StatementIDs()) == 0 {
+		return &ValidationError{Name: "statement", err: errors.New(`ent: missing required edge "BankStatementLine.statement"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "BankStatementLine.account"`)}
+	}
+	return nil
+}
+
+func (_c *BankStatementLineCreate) sqlSave(ctx context.Context) (*BankStatementLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BankStatementLineCreate) createSpec() (*BankStatementLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BankStatementLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bankstatementline.Table, sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(bankstatementline.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.BookingDate(); ok {
+		_spec.SetField(bankstatementline.FieldBookingDate, field.TypeTime, value)
+		_node.BookingDate = value
+	}
+	if value, ok := _c.mutation.ValueDate(); ok {
+		_spec.SetField(bankstatementline.FieldValueDate, field.TypeTime, value)
+		_node.ValueDate = &value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(bankstatementline.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(bankstatementline.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bankstatementline.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(bankstatementline.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.Counterparty(); ok {
+		_spec.SetField(bankstatementline.FieldCounterparty, field.TypeString, value)
+		_node.Counterparty = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bankstatementline.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.MatchScore(); ok {
+		_spec.SetField(bankstatementline.FieldMatchScore, field.TypeInt, value)
+		_node.MatchScore = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bankstatementline.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.TenantTable,
+			Columns: []string{bankstatementline.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_bank_statement_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.StatementTable,
+			Columns: []string{bankstatementline.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bank_statement_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.AccountTable,
+			Columns: []string{bankstatementline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_bank_statement_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LedgerEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   bankstatementline.LedgerEntryTable,
+			Columns: []string{bankstatementline.LedgerEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SuggestedAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedAccountTable,
+			Columns: []string{bankstatementline.SuggestedAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bank_statement_line_suggested_account = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SuggestedRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedRuleTable,
+			Columns: []string{bankstatementline.SuggestedRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bank_statement_line_suggested_rule = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BankStatementLineCreateBulk is the builder for creating many BankStatementLine entities in bulk.
+type BankStatementLineCreateBulk struct {
+	config
+	err      error
+	builders []*BankStatementLineCreate
+}
+
+// Save creates the BankStatementLine entities in the database.
+func (_c *BankStatementLineCreateBulk) Save(ctx context.Context) ([]*BankStatementLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BankStatementLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BankStatementLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BankStatementLineCreateBulk) SaveX(ctx context.Context) []*BankStatementLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankStatementLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankStatementLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
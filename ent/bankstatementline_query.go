@@ -0,0 +1,1008 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"sent/ent/account"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
+	"sent/ent/ledgerentry"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BankStatementLineQuery is the builder for querying BankStatementLine entities.
+type BankStatementLineQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []bankstatementline.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.BankStatementLine
+	withTenant           *TenantQuery
+	withStatement        *BankStatementQuery
+	withAccount          *AccountQuery
+	withLedgerEntry      *LedgerEntryQuery
+	withSuggestedAccount *AccountQuery
+	withSuggestedRule    *BankRuleQuery
+	withFKs              bool
+	modifiers            []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BankStatementLineQuery builder.
+func (_q *BankStatementLineQuery) Where(ps ...predicate.BankStatementLine) *BankStatementLineQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BankStatementLineQuery) Limit(limit int) *BankStatementLineQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BankStatementLineQuery) Offset(offset int) *BankStatementLineQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BankStatementLineQuery) Unique(unique bool) *BankStatementLineQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BankStatementLineQuery) Order(o ...bankstatementline.OrderOption) *BankStatementLineQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryTenant chains the current query on the "tenant" edge.
+func (_q *BankStatementLineQuery) QueryTenant() *TenantQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bankstatementline.Table, bankstatementline.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bankstatementline.TenantTable, bankstatementline.TenantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatement chains the current query on the "statement" edge.
+func (_q *BankStatementLineQuery) QueryStatement() *BankStatementQuery {
+	query := (&BankStatementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bankstatementline.Table, bankstatementline.FieldID, selector),
+			sqlgraph.To(bankstatement.Table, bankstatement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bankstatementline.StatementTable, bankstatementline.StatementColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (_q *BankStatementLineQuery) QueryAccount() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bankstatementline.Table, bankstatementline.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bankstatementline.AccountTable, bankstatementline.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLedgerEntry chains the current query on the "ledger_entry" edge.
+func (_q *BankStatementLineQuery) QueryLedgerEntry() *LedgerEntryQuery {
+	query := (&LedgerEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bankstatementline.Table, bankstatementline.FieldID, selector),
+			sqlgraph.To(ledgerentry.Table, ledgerentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, bankstatementline.LedgerEntryTable, bankstatementline.LedgerEntryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySuggestedAccount chains the current query on the "suggested_account" edge.
+func (_q *BankStatementLineQuery) QuerySuggestedAccount() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bankstatementline.Table, bankstatementline.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bankstatementline.SuggestedAccountTable, bankstatementline.SuggestedAccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySuggestedRule chains the current query on the "suggested_rule" edge.
+func (_q *BankStatementLineQuery) QuerySuggestedRule() *BankRuleQuery {
+	query := (&BankRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bankstatementline.Table, bankstatementline.FieldID, selector),
+			sqlgraph.To(bankrule.Table, bankrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bankstatementline.SuggestedRuleTable, bankstatementline.SuggestedRuleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BankStatementLine entity from the query.
+// Returns a *NotFoundError when no BankStatementLine was found.
+func (_q *BankStatementLineQuery) First(ctx context.Context) (*BankStatementLine, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bankstatementline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BankStatementLineQuery) FirstX(ctx context.Context) *BankStatementLine {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BankStatementLine ID from the query.
+// Returns a *NotFoundError when no BankStatementLine ID was found.
+func (_q *BankStatementLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bankstatementline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BankStatementLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BankStatementLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BankStatementLine entity is found.
+// Returns a *NotFoundError when no BankStatementLine entities are found.
+func (_q *BankStatementLineQuery) Only(ctx context.Context) (*BankStatementLine, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bankstatementline.Label}
+	default:
+		return nil, &NotSingularError{bankstatementline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BankStatementLineQuery) OnlyX(ctx context.Context) *BankStatementLine {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BankStatementLine ID in the query.
+// Returns a *NotSingularError when more than one BankStatementLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BankStatementLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bankstatementline.Label}
+	default:
+		err = &NotSingularError{bankstatementline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BankStatementLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BankStatementLines.
+func (_q *BankStatementLineQuery) All(ctx context.Context) ([]*BankStatementLine, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BankStatementLine, *BankStatementLineQuery]()
+	return withInterceptors[[]*BankStatementLine](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BankStatementLineQuery) AllX(ctx context.Context) []*BankStatementLine {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BankStatementLine IDs.
+func (_q *BankStatementLineQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bankstatementline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BankStatementLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BankStatementLineQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BankStatementLineQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BankStatementLineQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BankStatementLineQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BankStatementLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BankStatementLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BankStatementLineQuery) Clone() *BankStatementLineQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BankStatementLineQuery{
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]bankstatementline.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.BankStatementLine{}, _q.predicates...),
+		withTenant:           _q.withTenant.Clone(),
+		withStatement:        _q.withStatement.Clone(),
+		withAccount:          _q.withAccount.Clone(),
+		withLedgerEntry:      _q.withLedgerEntry.Clone(),
+		withSuggestedAccount: _q.withSuggestedAccount.Clone(),
+		withSuggestedRule:    _q.withSuggestedRule.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithTenant tells the query-builder to eager-load the nodes that are connected to
+// the "tenant" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BankStatementLineQuery) WithTenant(opts ...func(*TenantQuery)) *BankStatementLineQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTenant = query
+	return _q
+}
+
+// WithStatement tells the query-builder to eager-load the nodes that are connected to
+// the "statement" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BankStatementLineQuery) WithStatement(opts ...func(*BankStatementQuery)) *BankStatementLineQuery {
+	query := (&BankStatementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatement = query
+	return _q
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BankStatementLineQuery) WithAccount(opts ...func(*AccountQuery)) *BankStatementLineQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccount = query
+	return _q
+}
+
+// WithLedgerEntry tells the query-builder to eager-load the nodes that are connected to
+// the "ledger_entry" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BankStatementLineQuery) WithLedgerEntry(opts ...func(*LedgerEntryQuery)) *BankStatementLineQuery {
+	query := (&LedgerEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLedgerEntry = query
+	return _q
+}
+
+// WithSuggestedAccount tells the query-builder to eager-load the nodes that are connected to
+// the "suggested_account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BankStatementLineQuery) WithSuggestedAccount(opts ...func(*AccountQuery)) *BankStatementLineQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSuggestedAccount = query
+	return _q
+}
+
+// WithSuggestedRule tells the query-builder to eager-load the nodes that are connected to
+// the "suggested_rule" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BankStatementLineQuery) WithSuggestedRule(opts ...func(*BankRuleQuery)) *BankStatementLineQuery {
+	query := (&BankRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSuggestedRule = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ExternalID string `json:"external_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BankStatementLine.Query().
+//		GroupBy(bankstatementline.FieldExternalID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *BankStatementLineQuery) GroupBy(field string, fields ...string) *BankStatementLineGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BankStatementLineGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bankstatementline.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ExternalID string `json:"external_id,omitempty"`
+//	}
+//
+//	client.BankStatementLine.Query().
+//		Select(bankstatementline.FieldExternalID).
+//		Scan(ctx, &v)
+func (_q *BankStatementLineQuery) Select(fields ...string) *BankStatementLineSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BankStatementLineSelect{BankStatementLineQuery: _q}
+	sbuild.label = bankstatementline.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BankStatementLineSelect configured with the given aggregations.
+func (_q *BankStatementLineQuery) Aggregate(fns ...AggregateFunc) *BankStatementLineSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BankStatementLineQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bankstatementline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BankStatementLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BankStatementLine, error) {
+	var (
+		nodes       = []*BankStatementLine{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [6]bool{
+			_q.withTenant != nil,
+			_q.withStatement != nil,
+			_q.withAccount != nil,
+			_q.withLedgerEntry != nil,
+			_q.withSuggestedAccount != nil,
+			_q.withSuggestedRule != nil,
+		}
+	)
+	if _q.withTenant != nil || _q.withStatement != nil || _q.withAccount != nil || _q.withSuggestedAccount != nil || _q.withSuggestedRule != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, bankstatementline.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BankStatementLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BankStatementLine{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withTenant; query != nil {
+		if err := _q.loadTenant(ctx, query, nodes, nil,
+			func(n *BankStatementLine, e *Tenant) { n.Edges.Tenant = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStatement; query != nil {
+		if err := _q.loadStatement(ctx, query, nodes, nil,
+			func(n *BankStatementLine, e *BankStatement) { n.Edges.Statement = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccount; query != nil {
+		if err := _q.loadAccount(ctx, query, nodes, nil,
+			func(n *BankStatementLine, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLedgerEntry; query != nil {
+		if err := _q.loadLedgerEntry(ctx, query, nodes, nil,
+			func(n *BankStatementLine, e *LedgerEntry) { n.Edges.LedgerEntry = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSuggestedAccount; query != nil {
+		if err := _q.loadSuggestedAccount(ctx, query, nodes, nil,
+			func(n *BankStatementLine, e *Account) { n.Edges.SuggestedAccount = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSuggestedRule; query != nil {
+		if err := _q.loadSuggestedRule(ctx, query, nodes, nil,
+			func(n *BankStatementLine, e *BankRule) { n.Edges.SuggestedRule = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *BankStatementLineQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []*BankStatementLine, init func(*BankStatementLine), assign func(*BankStatementLine, *Tenant)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BankStatementLine)
+	for i := range nodes {
+		if nodes[i].tenant_bank_statement_lines == nil {
+			continue
+		}
+		fk := *nodes[i].tenant_bank_statement_lines
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tenant_bank_statement_lines" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BankStatementLineQuery) loadStatement(ctx context.Context, query *BankStatementQuery, nodes []*BankStatementLine, init func(*BankStatementLine), assign func(*BankStatementLine, *BankStatement)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BankStatementLine)
+	for i := range nodes {
+		if nodes[i].bank_statement_lines == nil {
+			continue
+		}
+		fk := *nodes[i].bank_statement_lines
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bankstatement.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "bank_statement_lines" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BankStatementLineQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*BankStatementLine, init func(*BankStatementLine), assign func(*BankStatementLine, *Account)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BankStatementLine)
+	for i := range nodes {
+		if nodes[i].account_bank_statement_lines == nil {
+			continue
+		}
+		fk := *nodes[i].account_bank_statement_lines
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_bank_statement_lines" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BankStatementLineQuery) loadLedgerEntry(ctx context.Context, query *LedgerEntryQuery, nodes []*BankStatementLine, init func(*BankStatementLine), assign func(*BankStatementLine, *LedgerEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BankStatementLine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.LedgerEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bankstatementline.LedgerEntryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.bank_statement_line_ledger_entry
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "bank_statement_line_ledger_entry" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "bank_statement_line_ledger_entry" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BankStatementLineQuery) loadSuggestedAccount(ctx context.Context, query *AccountQuery, nodes []*BankStatementLine, init func(*BankStatementLine), assign func(*BankStatementLine, *Account)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BankStatementLine)
+	for i := range nodes {
+		if nodes[i].bank_statement_line_suggested_account == nil {
+			continue
+		}
+		fk := *nodes[i].bank_statement_line_suggested_account
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "bank_statement_line_suggested_account" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BankStatementLineQuery) loadSuggestedRule(ctx context.Context, query *BankRuleQuery, nodes []*BankStatementLine, init func(*BankStatementLine), assign func(*BankStatementLine, *BankRule)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BankStatementLine)
+	for i := range nodes {
+		if nodes[i].bank_statement_line_suggested_rule == nil {
+			continue
+		}
+		fk := *nodes[i].bank_statement_line_suggested_rule
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bankrule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "bank_statement_line_suggested_rule" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *BankStatementLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BankStatementLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bankstatementline.Table, bankstatementline.Columns, sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bankstatementline.FieldID)
+		for i := range fields {
+			if fields[i] != bankstatementline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BankStatementLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bankstatementline.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bankstatementline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *BankStatementLineQuery) Modify(modifiers ...func(s *sql.Selector)) *BankStatementLineSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// BankStatementLineGroupBy is the group-by builder for BankStatementLine entities.
+type BankStatementLineGroupBy struct {
+	selector
+	build *BankStatementLineQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BankStatementLineGroupBy) Aggregate(fns ...AggregateFunc) *BankStatementLineGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BankStatementLineGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BankStatementLineQuery, *BankStatementLineGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BankStatementLineGroupBy) sqlScan(ctx context.Context, root *BankStatementLineQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BankStatementLineSelect is the builder for selecting fields of BankStatementLine entities.
+type BankStatementLineSelect struct {
+	*BankStatementLineQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BankStatementLineSelect) Aggregate(fns ...AggregateFunc) *BankStatementLineSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BankStatementLineSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BankStatementLineQuery, *BankStatementLineSelect](ctx, _s.BankStatementLineQuery, _s, _s.inters, v)
+}
+
+func (_s *BankStatementLineSelect) sqlScan(ctx context.Context, root *BankStatementLineQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *BankStatementLineSelect) Modify(modifiers ...func(s *sql.Selector)) *BankStatementLineSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
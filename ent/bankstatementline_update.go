@@ -0,0 +1,1292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
+	"sent/ent/ledgerentry"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatementLineUpdate is the builder for updating BankStatementLine entities.
+type BankStatementLineUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BankStatementLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BankStatementLineUpdate builder.
+func (_u *BankStatementLineUpdate) Where(ps ...predicate.BankStatementLine) *BankStatementLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *BankStatementLineUpdate) SetExternalID(v string) *BankStatementLineUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableExternalID(v *string) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetBookingDate sets the "booking_date" field.
+func (_u *BankStatementLineUpdate) SetBookingDate(v time.Time) *BankStatementLineUpdate {
+	_u.mutation.SetBookingDate(v)
+	return _u
+}
+
+// SetNillableBookingDate sets the "booking_date" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableBookingDate(v *time.Time) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetBookingDate(*v)
+	}
+	return _u
+}
+
+// SetValueDate sets the "value_date" field.
+func (_u *BankStatementLineUpdate) SetValueDate(v time.Time) *BankStatementLineUpdate {
+	_u.mutation.SetValueDate(v)
+	return _u
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableValueDate(v *time.Time) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetValueDate(*v)
+	}
+	return _u
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (_u *BankStatementLineUpdate) ClearValueDate() *BankStatementLineUpdate {
+	_u.mutation.ClearValueDate()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BankStatementLineUpdate) SetAmount(v decimal.Decimal) *BankStatementLineUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableAmount(v *decimal.Decimal) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankStatementLineUpdate) SetCurrency(v string) *BankStatementLineUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableCurrency(v *string) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *BankStatementLineUpdate) ClearCurrency() *BankStatementLineUpdate {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BankStatementLineUpdate) SetDescription(v string) *BankStatementLineUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableDescription(v *string) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BankStatementLineUpdate) ClearDescription() *BankStatementLineUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *BankStatementLineUpdate) SetReference(v string) *BankStatementLineUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableReference(v *string) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *BankStatementLineUpdate) ClearReference() *BankStatementLineUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (_u *BankStatementLineUpdate) SetCounterparty(v string) *BankStatementLineUpdate {
+	_u.mutation.SetCounterparty(v)
+	return _u
+}
+
+// SetNillableCounterparty sets the "counterparty" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableCounterparty(v *string) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetCounterparty(*v)
+	}
+	return _u
+}
+
+// ClearCounterparty clears the value of the "counterparty" field.
+func (_u *BankStatementLineUpdate) ClearCounterparty() *BankStatementLineUpdate {
+	_u.mutation.ClearCounterparty()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BankStatementLineUpdate) SetStatus(v bankstatementline.Status) *BankStatementLineUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableStatus(v *bankstatementline.Status) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMatchScore sets the "match_score" field.
+func (_u *BankStatementLineUpdate) SetMatchScore(v int) *BankStatementLineUpdate {
+	_u.mutation.ResetMatchScore()
+	_u.mutation.SetMatchScore(v)
+	return _u
+}
+
+// SetNillableMatchScore sets the "match_score" field if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableMatchScore(v *int) *BankStatementLineUpdate {
+	if v != nil {
+		_u.SetMatchScore(*v)
+	}
+	return _u
+}
+
+// AddMatchScore adds value to the "match_score" field.
+func (_u *BankStatementLineUpdate) AddMatchScore(v int) *BankStatementLineUpdate {
+	_u.mutation.AddMatchScore(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *BankStatementLineUpdate) SetTenantID(id int) *BankStatementLineUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *BankStatementLineUpdate) SetTenant(v *Tenant) *BankStatementLineUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetStatementID sets the "statement" edge to the BankStatement entity by ID.
+func (_u *BankStatementLineUpdate) SetStatementID(id int) *BankStatementLineUpdate {
+	_u.mutation.SetStatementID(id)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the BankStatement entity.
+func (_u *BankStatementLineUpdate) SetStatement(v *BankStatement) *BankStatementLineUpdate {
+	return _u.SetStatementID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *BankStatementLineUpdate) SetAccountID(id int) *BankStatementLineUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *BankStatementLineUpdate) SetAccount(v *Account) *BankStatementLineUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetLedgerEntryID sets the "ledger_entry" edge to the LedgerEntry entity by ID.
+func (_u *BankStatementLineUpdate) SetLedgerEntryID(id int) *BankStatementLineUpdate {
+	_u.mutation.SetLedgerEntryID(id)
+	return _u
+}
+
+// SetNillableLedgerEntryID sets the "ledger_entry" edge to the LedgerEntry entity by ID if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableLedgerEntryID(id *int) *BankStatementLineUpdate {
+	if id != nil {
+		_u = _u.SetLedgerEntryID(*id)
+	}
+	return _u
+}
+
+// SetLedgerEntry sets the "ledger_entry" edge to the LedgerEntry entity.
+func (_u *BankStatementLineUpdate) SetLedgerEntry(v *LedgerEntry) *BankStatementLineUpdate {
+	return _u.SetLedgerEntryID(v.ID)
+}
+
+// SetSuggestedAccountID sets the "suggested_account" edge to the Account entity by ID.
+func (_u *BankStatementLineUpdate) SetSuggestedAccountID(id int) *BankStatementLineUpdate {
+	_u.mutation.SetSuggestedAccountID(id)
+	return _u
+}
+
+// SetNillableSuggestedAccountID sets the "suggested_account" edge to the Account entity by ID if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableSuggestedAccountID(id *int) *BankStatementLineUpdate {
+	if id != nil {
+		_u = _u.SetSuggestedAccountID(*id)
+	}
+	return _u
+}
+
+// SetSuggestedAccount sets the "suggested_account" edge to the Account entity.
+func (_u *BankStatementLineUpdate) SetSuggestedAccount(v *Account) *BankStatementLineUpdate {
+	return _u.SetSuggestedAccountID(v.ID)
+}
+
+// SetSuggestedRuleID sets the "suggested_rule" edge to the BankRule entity by ID.
+func (_u *BankStatementLineUpdate) SetSuggestedRuleID(id int) *BankStatementLineUpdate {
+	_u.mutation.SetSuggestedRuleID(id)
+	return _u
+}
+
+// SetNillableSuggestedRuleID sets the "suggested_rule" edge to the BankRule entity by ID if the given value is not nil.
+func (_u *BankStatementLineUpdate) SetNillableSuggestedRuleID(id *int) *BankStatementLineUpdate {
+	if id != nil {
+		_u = _u.SetSuggestedRuleID(*id)
+	}
+	return _u
+}
+
+// SetSuggestedRule sets the "suggested_rule" edge to the BankRule entity.
+func (_u *BankStatementLineUpdate) SetSuggestedRule(v *BankRule) *BankStatementLineUpdate {
+	return _u.SetSuggestedRuleID(v.ID)
+}
+
+// Mutation returns the BankStatementLineMutation object of the builder.
+func (_u *BankStatementLineUpdate) Mutation() *BankStatementLineMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *BankStatementLineUpdate) ClearTenant() *BankStatementLineUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearStatement clears the "statement" edge to the BankStatement entity.
+func (_u *BankStatementLineUpdate) ClearStatement() *BankStatementLineUpdate {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *BankStatementLineUpdate) ClearAccount() *BankStatementLineUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearLedgerEntry clears the "ledger_entry" edge to the LedgerEntry entity.
+func (_u *BankStatementLineUpdate) ClearLedgerEntry() *BankStatementLineUpdate {
+	_u.mutation.ClearLedgerEntry()
+	return _u
+}
+
+// ClearSuggestedAccount clears the "suggested_account" edge to the Account entity.
+func (_u *BankStatementLineUpdate) ClearSuggestedAccount() *BankStatementLineUpdate {
+	_u.mutation.ClearSuggestedAccount()
+	return _u
+}
+
+// ClearSuggestedRule clears the "suggested_rule" edge to the BankRule entity.
+func (_u *BankStatementLineUpdate) ClearSuggestedRule() *BankStatementLineUpdate {
+	_u.mutation.ClearSuggestedRule()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BankStatementLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankStatementLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BankStatementLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankStatementLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankStatementLineUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := bankstatementline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BankStatementLine.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatementLine.tenant"`)
+	}
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatementLine.statement"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatementLine.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BankStatementLineUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BankStatementLineUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BankStatementLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankstatementline.Table, bankstatementline.Columns, sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(bankstatementline.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BookingDate(); ok {
+		_spec.SetField(bankstatementline.FieldBookingDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValueDate(); ok {
+		_spec.SetField(bankstatementline.FieldValueDate, field.TypeTime, value)
+	}
+	if _u.mutation.ValueDateCleared() {
+		_spec.ClearField(bankstatementline.FieldValueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(bankstatementline.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(bankstatementline.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(bankstatementline.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bankstatementline.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bankstatementline.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(bankstatementline.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(bankstatementline.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Counterparty(); ok {
+		_spec.SetField(bankstatementline.FieldCounterparty, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartyCleared() {
+		_spec.ClearField(bankstatementline.FieldCounterparty, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bankstatementline.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MatchScore(); ok {
+		_spec.SetField(bankstatementline.FieldMatchScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatchScore(); ok {
+		_spec.AddField(bankstatementline.FieldMatchScore, field.TypeInt, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.TenantTable,
+			Columns: []string{bankstatementline.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.TenantTable,
+			Columns: []string{bankstatementline.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.StatementTable,
+			Columns: []string{bankstatementline.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.StatementTable,
+			Columns: []string{bankstatementline.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.AccountTable,
+			Columns: []string{bankstatementline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.AccountTable,
+			Columns: []string{bankstatementline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedgerEntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   bankstatementline.LedgerEntryTable,
+			Columns: []string{bankstatementline.LedgerEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   bankstatementline.LedgerEntryTable,
+			Columns: []string{bankstatementline.LedgerEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SuggestedAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedAccountTable,
+			Columns: []string{bankstatementline.SuggestedAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SuggestedAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedAccountTable,
+			Columns: []string{bankstatementline.SuggestedAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SuggestedRuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedRuleTable,
+			Columns: []string{bankstatementline.SuggestedRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SuggestedRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedRuleTable,
+			Columns: []string{bankstatementline.SuggestedRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankstatementline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BankStatementLineUpdateOne is the builder for updating a single BankStatementLine entity.
+type BankStatementLineUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BankStatementLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *BankStatementLineUpdateOne) SetExternalID(v string) *BankStatementLineUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableExternalID(v *string) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetBookingDate sets the "booking_date" field.
+func (_u *BankStatementLineUpdateOne) SetBookingDate(v time.Time) *BankStatementLineUpdateOne {
+	_u.mutation.SetBookingDate(v)
+	return _u
+}
+
+// SetNillableBookingDate sets the "booking_date" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableBookingDate(v *time.Time) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetBookingDate(*v)
+	}
+	return _u
+}
+
+// SetValueDate sets the "value_date" field.
+func (_u *BankStatementLineUpdateOne) SetValueDate(v time.Time) *BankStatementLineUpdateOne {
+	_u.mutation.SetValueDate(v)
+	return _u
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableValueDate(v *time.Time) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetValueDate(*v)
+	}
+	return _u
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (_u *BankStatementLineUpdateOne) ClearValueDate() *BankStatementLineUpdateOne {
+	_u.mutation.ClearValueDate()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BankStatementLineUpdateOne) SetAmount(v decimal.Decimal) *BankStatementLineUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableAmount(v *decimal.Decimal) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankStatementLineUpdateOne) SetCurrency(v string) *BankStatementLineUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableCurrency(v *string) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *BankStatementLineUpdateOne) ClearCurrency() *BankStatementLineUpdateOne {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BankStatementLineUpdateOne) SetDescription(v string) *BankStatementLineUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableDescription(v *string) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BankStatementLineUpdateOne) ClearDescription() *BankStatementLineUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *BankStatementLineUpdateOne) SetReference(v string) *BankStatementLineUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableReference(v *string) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *BankStatementLineUpdateOne) ClearReference() *BankStatementLineUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (_u *BankStatementLineUpdateOne) SetCounterparty(v string) *BankStatementLineUpdateOne {
+	_u.mutation.SetCounterparty(v)
+	return _u
+}
+
+// SetNillableCounterparty sets the "counterparty" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableCounterparty(v *string) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetCounterparty(*v)
+	}
+	return _u
+}
+
+// ClearCounterparty clears the value of the "counterparty" field.
+func (_u *BankStatementLineUpdateOne) ClearCounterparty() *BankStatementLineUpdateOne {
+	_u.mutation.ClearCounterparty()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BankStatementLineUpdateOne) SetStatus(v bankstatementline.Status) *BankStatementLineUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableStatus(v *bankstatementline.Status) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMatchScore sets the "match_score" field.
+func (_u *BankStatementLineUpdateOne) SetMatchScore(v int) *BankStatementLineUpdateOne {
+	_u.mutation.ResetMatchScore()
+	_u.mutation.SetMatchScore(v)
+	return _u
+}
+
+// SetNillableMatchScore sets the "match_score" field if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableMatchScore(v *int) *BankStatementLineUpdateOne {
+	if v != nil {
+		_u.SetMatchScore(*v)
+	}
+	return _u
+}
+
+// AddMatchScore adds value to the "match_score" field.
+func (_u *BankStatementLineUpdateOne) AddMatchScore(v int) *BankStatementLineUpdateOne {
+	_u.mutation.AddMatchScore(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *BankStatementLineUpdateOne) SetTenantID(id int) *BankStatementLineUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *BankStatementLineUpdateOne) SetTenant(v *Tenant) *BankStatementLineUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetStatementID sets the "statement" edge to the BankStatement entity by ID.
+func (_u *BankStatementLineUpdateOne) SetStatementID(id int) *BankStatementLineUpdateOne {
+	_u.mutation.SetStatementID(id)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the BankStatement entity.
+func (_u *BankStatementLineUpdateOne) SetStatement(v *BankStatement) *BankStatementLineUpdateOne {
+	return _u.SetStatementID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *BankStatementLineUpdateOne) SetAccountID(id int) *BankStatementLineUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *BankStatementLineUpdateOne) SetAccount(v *Account) *BankStatementLineUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetLedgerEntryID sets the "ledger_entry" edge to the LedgerEntry entity by ID.
+func (_u *BankStatementLineUpdateOne) SetLedgerEntryID(id int) *BankStatementLineUpdateOne {
+	_u.mutation.SetLedgerEntryID(id)
+	return _u
+}
+
+// SetNillableLedgerEntryID sets the "ledger_entry" edge to the LedgerEntry entity by ID if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableLedgerEntryID(id *int) *BankStatementLineUpdateOne {
+	if id != nil {
+		_u = _u.SetLedgerEntryID(*id)
+	}
+	return _u
+}
+
+// SetLedgerEntry sets the "ledger_entry" edge to the LedgerEntry entity.
+func (_u *BankStatementLineUpdateOne) SetLedgerEntry(v *LedgerEntry) *BankStatementLineUpdateOne {
+	return _u.SetLedgerEntryID(v.ID)
+}
+
+// SetSuggestedAccountID sets the "suggested_account" edge to the Account entity by ID.
+func (_u *BankStatementLineUpdateOne) SetSuggestedAccountID(id int) *BankStatementLineUpdateOne {
+	_u.mutation.SetSuggestedAccountID(id)
+	return _u
+}
+
+// SetNillableSuggestedAccountID sets the "suggested_account" edge to the Account entity by ID if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableSuggestedAccountID(id *int) *BankStatementLineUpdateOne {
+	if id != nil {
+		_u = _u.SetSuggestedAccountID(*id)
+	}
+	return _u
+}
+
+// SetSuggestedAccount sets the "suggested_account" edge to the Account entity.
+func (_u *BankStatementLineUpdateOne) SetSuggestedAccount(v *Account) *BankStatementLineUpdateOne {
+	return _u.SetSuggestedAccountID(v.ID)
+}
+
+// SetSuggestedRuleID sets the "suggested_rule" edge to the BankRule entity by ID.
+func (_u *BankStatementLineUpdateOne) SetSuggestedRuleID(id int) *BankStatementLineUpdateOne {
+	_u.mutation.SetSuggestedRuleID(id)
+	return _u
+}
+
+// SetNillableSuggestedRuleID sets the "suggested_rule" edge to the BankRule entity by ID if the given value is not nil.
+func (_u *BankStatementLineUpdateOne) SetNillableSuggestedRuleID(id *int) *BankStatementLineUpdateOne {
+	if id != nil {
+		_u = _u.SetSuggestedRuleID(*id)
+	}
+	return _u
+}
+
+// SetSuggestedRule sets the "suggested_rule" edge to the BankRule entity.
+func (_u *BankStatementLineUpdateOne) SetSuggestedRule(v *BankRule) *BankStatementLineUpdateOne {
+	return _u.SetSuggestedRuleID(v.ID)
+}
+
+// Mutation returns the BankStatementLineMutation object of the builder.
+func (_u *BankStatementLineUpdateOne) Mutation() *BankStatementLineMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *BankStatementLineUpdateOne) ClearTenant() *BankStatementLineUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearStatement clears the "statement" edge to the BankStatement entity.
+func (_u *BankStatementLineUpdateOne) ClearStatement() *BankStatementLineUpdateOne {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *BankStatementLineUpdateOne) ClearAccount() *BankStatementLineUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearLedgerEntry clears the "ledger_entry" edge to the LedgerEntry entity.
+func (_u *BankStatementLineUpdateOne) ClearLedgerEntry() *BankStatementLineUpdateOne {
+	_u.mutation.ClearLedgerEntry()
+	return _u
+}
+
+// ClearSuggestedAccount clears the "suggested_account" edge to the Account entity.
+func (_u *BankStatementLineUpdateOne) ClearSuggestedAccount() *BankStatementLineUpdateOne {
+	_u.mutation.ClearSuggestedAccount()
+	return _u
+}
+
+// ClearSuggestedRule clears the "suggested_rule" edge to the BankRule entity.
+func (_u *BankStatementLineUpdateOne) ClearSuggestedRule() *BankStatementLineUpdateOne {
+	_u.mutation.ClearSuggestedRule()
+	return _u
+}
+
+// Where appends a list predicates to the BankStatementLineUpdate builder.
+func (_u *BankStatementLineUpdateOne) Where(ps ...predicate.BankStatementLine) *BankStatementLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BankStatementLineUpdateOne) Select(field string, fields ...string) *BankStatementLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BankStatementLine entity.
+func (_u *BankStatementLineUpdateOne) Save(ctx context.Context) (*BankStatementLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankStatementLineUpdateOne) SaveX(ctx context.Context) *BankStatementLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BankStatementLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankStatementLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankStatementLineUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := bankstatementline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BankStatementLine.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatementLine.tenant"`)
+	}
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatementLine.statement"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankStatementLine.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BankStatementLineUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BankStatementLineUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BankStatementLineUpdateOne) sqlSave(ctx context.Context) (_node *BankStatementLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankstatementline.Table, bankstatementline.Columns, sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BankStatementLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bankstatementline.FieldID)
+		for _, f := range fields {
+			if !bankstatementline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bankstatementline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(bankstatementline.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BookingDate(); ok {
+		_spec.SetField(bankstatementline.FieldBookingDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValueDate(); ok {
+		_spec.SetField(bankstatementline.FieldValueDate, field.TypeTime, value)
+	}
+	if _u.mutation.ValueDateCleared() {
+		_spec.ClearField(bankstatementline.FieldValueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(bankstatementline.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(bankstatementline.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(bankstatementline.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bankstatementline.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bankstatementline.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(bankstatementline.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(bankstatementline.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Counterparty(); ok {
+		_spec.SetField(bankstatementline.FieldCounterparty, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartyCleared() {
+		_spec.ClearField(bankstatementline.FieldCounterparty, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bankstatementline.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MatchScore(); ok {
+		_spec.SetField(bankstatementline.FieldMatchScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatchScore(); ok {
+		_spec.AddField(bankstatementline.FieldMatchScore, field.TypeInt, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.TenantTable,
+			Columns: []string{bankstatementline.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.TenantTable,
+			Columns: []string{bankstatementline.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.StatementTable,
+			Columns: []string{bankstatementline.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.StatementTable,
+			Columns: []string{bankstatementline.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.AccountTable,
+			Columns: []string{bankstatementline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bankstatementline.AccountTable,
+			Columns: []string{bankstatementline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedgerEntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   bankstatementline.LedgerEntryTable,
+			Columns: []string{bankstatementline.LedgerEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   bankstatementline.LedgerEntryTable,
+			Columns: []string{bankstatementline.LedgerEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SuggestedAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedAccountTable,
+			Columns: []string{bankstatementline.SuggestedAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SuggestedAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedAccountTable,
+			Columns: []string{bankstatementline.SuggestedAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SuggestedRuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedRuleTable,
+			Columns: []string{bankstatementline.SuggestedRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SuggestedRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bankstatementline.SuggestedRuleTable,
+			Columns: []string{bankstatementline.SuggestedRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &BankStatementLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankstatementline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
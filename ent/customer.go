@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/customer"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Customer is the model entity for the Customer schema.
+type Customer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// TaxID holds the value of the "tax_id" field.
+	TaxID string `json:"tax_id,omitempty"`
+	// CountryCode holds the value of the "country_code" field.
+	CountryCode string `json:"country_code,omitempty"`
+	// TaxExempt holds the value of the "tax_exempt" field.
+	TaxExempt bool `json:"tax_exempt,omitempty"`
+	// ExemptionReference holds the value of the "exemption_reference" field.
+	ExemptionReference string `json:"exemption_reference,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// PaymentTermsDays holds the value of the "payment_terms_days" field.
+	PaymentTermsDays int `json:"payment_terms_days,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CustomerQuery when eager-loading is set.
+	Edges            CustomerEdges `json:"edges"`
+	tenant_customers *int
+	selectValues     sql.SelectValues
+}
+
+// CustomerEdges holds the relations/edges for other nodes in the graph.
+type CustomerEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*CustomerPayment `json:"payments,omitempty"`
+	// Sales holds the value of the sales edge.
+	Sales []*Transaction `json:"sales,omitempty"`
+	// RecurringInvoices holds the value of the recurring_invoices edge.
+	RecurringInvoices []*RecurringInvoice `json:"recurring_invoices,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomerEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e CustomerEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[1] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e CustomerEdges) PaymentsOrErr() ([]*CustomerPayment, error) {
+	if e.loadedTypes[2] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// SalesOrErr returns the Sales value or an error if the edge
+// was not loaded in eager-loading.
+func (e CustomerEdges) SalesOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[3] {
+		return e.Sales, nil
+	}
+	return nil, &NotLoadedError{edge: "sales"}
+}
+
+// RecurringInvoicesOrErr returns the RecurringInvoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e CustomerEdges) RecurringInvoicesOrErr() ([]*RecurringInvoice, error) {
+	if e.loadedTypes[4] {
+		return e.RecurringInvoices, nil
+	}
+	return nil, &NotLoadedError{edge: "recurring_invoices"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Customer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customer.FieldTaxExempt, customer.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case customer.FieldID, customer.FieldPaymentTermsDays:
+			values[i] = new(sql.NullInt64)
+		case customer.FieldCode, customer.FieldName, customer.FieldEmail, customer.FieldPhone, customer.FieldAddress, customer.FieldTaxID, customer.FieldCountryCode, customer.FieldExemptionReference, customer.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case customer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case customer.ForeignKeys[0]: // tenant_customers
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Customer fields.
+func (_m *Customer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case customer.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case customer.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case customer.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case customer.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = value.String
+			}
+		case customer.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case customer.FieldTaxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_id", values[i])
+			} else if value.Valid {
+				_m.TaxID = value.String
+			}
+		case customer.FieldCountryCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country_code", values[i])
+			} else if value.Valid {
+				_m.CountryCode = value.String
+			}
+		case customer.FieldTaxExempt:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_exempt", values[i])
+			} else if value.Valid {
+				_m.TaxExempt = value.Bool
+			}
+		case customer.FieldExemptionReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exemption_reference", values[i])
+			} else if value.Valid {
+				_m.ExemptionReference = value.String
+			}
+		case customer.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case customer.FieldPaymentTermsDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_terms_days", values[i])
+			} else if value.Valid {
+				_m.PaymentTermsDays = int(value.Int64)
+			}
+		case customer.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case customer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case customer.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_customers", value)
+			} else if value.Valid {
+				_m.tenant_customers = new(int)
+				*_m.tenant_customers = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Customer.
+// This includes values selected through modifiers, order, etc.
+func (_m *Customer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the Customer entity.
+func (_m *Customer) QueryTenant() *TenantQuery {
+	return NewCustomerClient(_m.config).QueryTenant(_m)
+}
+
+// QueryInvoices queries the "invoices" edge of the Customer entity.
+func (_m *Customer) QueryInvoices() *InvoiceQuery {
+	return NewCustomerClient(_m.config).QueryInvoices(_m)
+}
+
+// QueryPayments queries the "payments" edge of the Customer entity.
+func (_m *Customer) QueryPayments() *CustomerPaymentQuery {
+	return NewCustomerClient(_m.config).QueryPayments(_m)
+}
+
+// QuerySales queries the "sales" edge of the Customer entity.
+func (_m *Customer) QuerySales() *TransactionQuery {
+	return NewCustomerClient(_m.config).QuerySales(_m)
+}
+
+// QueryRecurringInvoices queries the "recurring_invoices" edge of the Customer entity.
+func (_m *Customer) QueryRecurringInvoices() *RecurringInvoiceQuery {
+	return NewCustomerClient(_m.config).QueryRecurringInvoices(_m)
+}
+
+// Update returns a builder for updating this Customer.
+// Note that you need to call Customer.Unwrap() before calling this method if this Customer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Customer) Update() *CustomerUpdateOne {
+	return NewCustomerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Customer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Customer) Unwrap() *Customer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Customer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Customer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Customer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(_m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("tax_id=")
+	builder.WriteString(_m.TaxID)
+	builder.WriteString(", ")
+	builder.WriteString("country_code=")
+	builder.WriteString(_m.CountryCode)
+	builder.WriteString(", ")
+	builder.WriteString("tax_exempt=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxExempt))
+	builder.WriteString(", ")
+	builder.WriteString("exemption_reference=")
+	builder.WriteString(_m.ExemptionReference)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("payment_terms_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentTermsDays))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Customers is a parsable slice of Customer.
+type Customers []*Customer
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerPayment is the model entity for the CustomerPayment schema.
+type CustomerPayment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate decimal.Decimal `json:"exchange_rate,omitempty"`
+	// Unallocated holds the value of the "unallocated" field.
+	Unallocated decimal.Decimal `json:"unallocated,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CustomerPaymentQuery when eager-loading is set.
+	Edges                            CustomerPaymentEdges `json:"edges"`
+	customer_payments                *int
+	customer_payment_deposit_account *int
+	customer_payment_transaction     *int
+	tenant_customer_payments         *int
+	selectValues                     sql.SelectValues
+}
+
+// CustomerPaymentEdges holds the relations/edges for other nodes in the graph.
+type CustomerPaymentEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Customer holds the value of the customer edge.
+	Customer *Customer `json:"customer,omitempty"`
+	// DepositAccount holds the value of the deposit_account edge.
+	DepositAccount *Account `json:"deposit_account,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// Allocations holds the value of the allocations edge.
+	Allocations []*PaymentAllocation `json:"allocations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomerPaymentEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// CustomerOrErr returns the Customer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomerPaymentEdges) CustomerOrErr() (*Customer, error) {
+	if e.Customer != nil {
+		return e.Customer, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: customer.Label}
+	}
+	return nil, &NotLoadedError{edge: "customer"}
+}
+
+// DepositAccountOrErr returns the DepositAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomerPaymentEdges) DepositAccountOrErr() (*Account, error) {
+	if e.DepositAccount != nil {
+		return e.DepositAccount, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "deposit_account"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomerPaymentEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// AllocationsOrErr returns the Allocations value or an error if the edge
+// was not loaded in eager-loading.
+func (e CustomerPaymentEdges) AllocationsOrErr() ([]*PaymentAllocation, error) {
+	if e.loadedTypes[4] {
+		return e.Allocations, nil
+	}
+	return nil, &NotLoadedError{edge: "allocations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CustomerPayment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customerpayment.FieldAmount, customerpayment.FieldExchangeRate, customerpayment.FieldUnallocated:
+			values[i] = new(decimal.Decimal)
+		case customerpayment.FieldID:
+			values[i] = new(sql.NullInt64)
+		case customerpayment.FieldCurrency, customerpayment.FieldMethod, customerpayment.FieldReference:
+			values[i] = new(sql.NullString)
+		case customerpayment.FieldDate, customerpayment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case customerpayment.ForeignKeys[0]: // customer_payments
+			values[i] = new(sql.NullInt64)
+		case customerpayment.ForeignKeys[1]: // customer_payment_deposit_account
+			values[i] = new(sql.NullInt64)
+		case customerpayment.ForeignKeys[2]: // customer_payment_transaction
+			values[i] = new(sql.NullInt64)
+		case customerpayment.ForeignKeys[3]: // tenant_customer_payments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CustomerPayment fields.
+func (_m *CustomerPayment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customerpayment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case customerpayment.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case customerpayment.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case customerpayment.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case customerpayment.FieldExchangeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value != nil {
+				_m.ExchangeRate = *value
+			}
+		case customerpayment.FieldUnallocated:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unallocated", values[i])
+			} else if value != nil {
+				_m.Unallocated = *value
+			}
+		case customerpayment.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = value.String
+			}
+		case customerpayment.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case customerpayment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case customerpayment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field customer_payments", value)
+			} else if value.Valid {
+				_m.customer_payments = new(int)
+				*_m.customer_payments = int(value.Int64)
+			}
+		case customerpayment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field customer_payment_deposit_account", value)
+			} else if value.Valid {
+				_m.customer_payment_deposit_account = new(int)
+				*_m.customer_payment_deposit_account = int(value.Int64)
+			}
+		case customerpayment.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field customer_payment_transaction", value)
+			} else if value.Valid {
+				_m.customer_payment_transaction = new(int)
+				*_m.customer_payment_transaction = int(value.Int64)
+			}
+		case customerpayment.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_customer_payments", value)
+			} else if value.Valid {
+				_m.tenant_customer_payments = new(int)
+				*_m.tenant_customer_payments = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CustomerPayment.
+// This includes values selected through modifiers, order, etc.
+func (_m *CustomerPayment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the CustomerPayment entity.
+func (_m *CustomerPayment) QueryTenant() *TenantQuery {
+	return NewCustomerPaymentClient(_m.config).QueryTenant(_m)
+}
+
+// QueryCustomer queries the "customer" edge of the CustomerPayment entity.
+func (_m *CustomerPayment) QueryCustomer() *CustomerQuery {
+	return NewCustomerPaymentClient(_m.config).QueryCustomer(_m)
+}
+
+// QueryDepositAccount queries the "deposit_account" edge of the CustomerPayment entity.
+func (_m *CustomerPayment) QueryDepositAccount() *AccountQuery {
+	return NewCustomerPaymentClient(_m.config).QueryDepositAccount(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the CustomerPayment entity.
+func (_m *CustomerPayment) QueryTransaction() *TransactionQuery {
+	return NewCustomerPaymentClient(_m.config).QueryTransaction(_m)
+}
+
+// QueryAllocations queries the "allocations" edge of the CustomerPayment entity.
+func (_m *CustomerPayment) QueryAllocations() *PaymentAllocationQuery {
+	return NewCustomerPaymentClient(_m.config).QueryAllocations(_m)
+}
+
+// Update returns a builder for updating this CustomerPayment.
+// Note that you need to call CustomerPayment.Unwrap() before calling this method if this CustomerPayment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CustomerPayment) Update() *CustomerPaymentUpdateOne {
+	return NewCustomerPaymentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CustomerPayment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CustomerPayment) Unwrap() *CustomerPayment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CustomerPayment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CustomerPayment) String() string {
+	var builder strings.Builder
+	builder.WriteString("CustomerPayment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExchangeRate))
+	builder.WriteString(", ")
+	builder.WriteString("unallocated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Unallocated))
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(_m.Method)
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CustomerPayments is a parsable slice of CustomerPayment.
+type CustomerPayments []*CustomerPayment
@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customerpayment
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldID, id))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldDate, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// Unallocated applies equality check predicate on the "unallocated" field. It's identical to UnallocatedEQ.
+func Unallocated(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldUnallocated, v))
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldMethod, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldReference, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldDate, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldExchangeRate, v))
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldExchangeRate, v))
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldExchangeRate, v))
+}
+
+// UnallocatedEQ applies the EQ predicate on the "unallocated" field.
+func UnallocatedEQ(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldUnallocated, v))
+}
+
+// UnallocatedNEQ applies the NEQ predicate on the "unallocated" field.
+func UnallocatedNEQ(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldUnallocated, v))
+}
+
+// UnallocatedIn applies the In predicate on the "unallocated" field.
+func UnallocatedIn(vs ...decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldUnallocated, vs...))
+}
+
+// UnallocatedNotIn applies the NotIn predicate on the "unallocated" field.
+func UnallocatedNotIn(vs ...decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldUnallocated, vs...))
+}
+
+// UnallocatedGT applies the GT predicate on the "unallocated" field.
+func UnallocatedGT(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldUnallocated, v))
+}
+
+// UnallocatedGTE applies the GTE predicate on the "unallocated" field.
+func UnallocatedGTE(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldUnallocated, v))
+}
+
+// UnallocatedLT applies the LT predicate on the "unallocated" field.
+func UnallocatedLT(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldUnallocated, v))
+}
+
+// UnallocatedLTE applies the LTE predicate on the "unallocated" field.
+func UnallocatedLTE(v decimal.Decimal) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldUnallocated, v))
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldMethod, v))
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldMethod, v))
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldMethod, vs...))
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldMethod, vs...))
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldMethod, v))
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldMethod, v))
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldMethod, v))
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldMethod, v))
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldContains(FieldMethod, v))
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldHasPrefix(FieldMethod, v))
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldHasSuffix(FieldMethod, v))
+}
+
+// MethodIsNil applies the IsNil predicate on the "method" field.
+func MethodIsNil() predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIsNull(FieldMethod))
+}
+
+// MethodNotNil applies the NotNil predicate on the "method" field.
+func MethodNotNil() predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotNull(FieldMethod))
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEqualFold(FieldMethod, v))
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldContainsFold(FieldMethod, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldContainsFold(FieldReference, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomer applies the HasEdge predicate on the "customer" edge.
+func HasCustomer() predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomerWith applies the HasEdge predicate on the "customer" edge with a given conditions (other predicates).
+func HasCustomerWith(preds ...predicate.Customer) predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := newCustomerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDepositAccount applies the HasEdge predicate on the "deposit_account" edge.
+func HasDepositAccount() predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, DepositAccountTable, DepositAccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDepositAccountWith applies the HasEdge predicate on the "deposit_account" edge with a given conditions (other predicates).
+func HasDepositAccountWith(preds ...predicate.Account) predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := newDepositAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAllocations applies the HasEdge predicate on the "allocations" edge.
+func HasAllocations() predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AllocationsTable, AllocationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAllocationsWith applies the HasEdge predicate on the "allocations" edge with a given conditions (other predicates).
+func HasAllocationsWith(preds ...predicate.PaymentAllocation) predicate.CustomerPayment {
+	return predicate.CustomerPayment(func(s *sql.Selector) {
+		step := newAllocationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CustomerPayment) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CustomerPayment) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CustomerPayment) predicate.CustomerPayment {
+	return predicate.CustomerPayment(sql.NotPredicates(p))
+}
@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
+	"sent/ent/paymentallocation"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerPaymentCreate is the builder for creating a CustomerPayment entity.
+type CustomerPaymentCreate struct {
+	config
+	mutation *CustomerPaymentMutation
+	hooks    []Hook
+}
+
+// SetDate sets the "date" field.
+func (_c *CustomerPaymentCreate) SetDate(v time.Time) *CustomerPaymentCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableDate(v *time.Time) *CustomerPaymentCreate {
+	if v != nil {
+		_c.SetDate(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *CustomerPaymentCreate) SetAmount(v decimal.Decimal) *CustomerPaymentCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableAmount(v *decimal.Decimal) *CustomerPaymentCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *CustomerPaymentCreate) SetCurrency(v string) *CustomerPaymentCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableCurrency(v *string) *CustomerPaymentCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *CustomerPaymentCreate) SetExchangeRate(v decimal.Decimal) *CustomerPaymentCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableExchangeRate(v *decimal.Decimal) *CustomerPaymentCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
+// SetUnallocated sets the "unallocated" field.
+func (_c *CustomerPaymentCreate) SetUnallocated(v decimal.Decimal) *CustomerPaymentCreate {
+	_c.mutation.SetUnallocated(v)
+	return _c
+}
+
+// SetNillableUnallocated sets the "unallocated" field if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableUnallocated(v *decimal.Decimal) *CustomerPaymentCreate {
+	if v != nil {
+		_c.SetUnallocated(*v)
+	}
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *CustomerPaymentCreate) SetMethod(v string) *CustomerPaymentCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableMethod(v *string) *CustomerPaymentCreate {
+	if v != nil {
+		_c.SetMethod(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *CustomerPaymentCreate) SetReference(v string) *CustomerPaymentCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableReference(v *string) *CustomerPaymentCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CustomerPaymentCreate) SetCreatedAt(v time.Time) *CustomerPaymentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableCreatedAt(v *time.Time) *CustomerPaymentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *CustomerPaymentCreate) SetTenantID(id int) *CustomerPaymentCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *CustomerPaymentCreate) SetTenant(v *Tenant) *CustomerPaymentCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_c *CustomerPaymentCreate) SetCustomerID(id int) *CustomerPaymentCreate {
+	_c.mutation.SetCustomerID(id)
+	return _c
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_c *CustomerPaymentCreate) SetCustomer(v *Customer) *CustomerPaymentCreate {
+	return _c.SetCustomerID(v.ID)
+}
+
+// SetDepositAccountID sets the "deposit_account" edge to the Account entity by ID.
+func (_c *CustomerPaymentCreate) SetDepositAccountID(id int) *CustomerPaymentCreate {
+	_c.mutation.SetDepositAccountID(id)
+	return _c
+}
+
+// SetDepositAccount sets the "deposit_account" edge to the Account entity.
+func (_c *CustomerPaymentCreate) SetDepositAccount(v *Account) *CustomerPaymentCreate {
+	return _c.SetDepositAccountID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *CustomerPaymentCreate) SetTransactionID(id int) *CustomerPaymentCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_c *CustomerPaymentCreate) SetNillableTransactionID(id *int) *CustomerPaymentCreate {
+	if id != nil {
+		_c = _c.SetTransactionID(*id)
+	}
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *CustomerPaymentCreate) SetTransaction(v *Transaction) *CustomerPaymentCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// AddAllocationIDs adds the "allocations" edge to the PaymentAllocation entity by IDs.
+func (_c *CustomerPaymentCreate) AddAllocationIDs(ids ...int) *CustomerPaymentCreate {
+	_c.mutation.AddAllocationIDs(ids...)
+	return _c
+}
+
+// AddAllocations adds the "allocations" edges to the PaymentAllocation entity.
+func (_c *CustomerPaymentCreate) AddAllocations(v ...*PaymentAllocation) *CustomerPaymentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAllocationIDs(ids...)
+}
+
+// Mutation returns the CustomerPaymentMutation object of the builder.
+func (_c *CustomerPaymentCreate) Mutation() *CustomerPaymentMutation {
+	return _c.mutation
+}
+
+// Save creates the CustomerPayment in the database.
+func (_c *CustomerPaymentCreate) Save(ctx context.Context) (*CustomerPayment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CustomerPaymentCreate) SaveX(ctx context.Context) *CustomerPayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CustomerPaymentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CustomerPaymentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CustomerPaymentCreate) defaults() {
+	if _, ok := _c.mutation.Date(); !ok {
+		v := customerpayment.DefaultDate()
+		_c.mutation.SetDate(v)
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		v := customerpayment.DefaultAmount
+		_c.mutation.SetAmount(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := customerpayment.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		v := customerpayment.DefaultExchangeRate
+		_c.mutation.SetExchangeRate(v)
+	}
+	if _, ok := _c.mutation.Unallocated(); !ok {
+		v := customerpayment.DefaultUnallocated
+		_c.mutation.SetUnallocated(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := customerpayment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CustomerPaymentCreate) check() error {
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "CustomerPayment.date"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "CustomerPayment.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "CustomerPayment.currency"`)}
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "CustomerPayment.exchange_rate"`)}
+	}
+	if _, ok := _c.mutation.Unallocated(); !ok {
+		return &ValidationError{Name: "unallocated", err: errors.New(`ent: missing required field "CustomerPayment.unallocated"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CustomerPayment.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "CustomerPayment.tenant"`)}
+	}
+	if len(_c.mutation.CustomerIDs()) == 0 {
+		return &ValidationError{Name: "customer", err: errors.New(`ent: missing required edge "CustomerPayment.customer"`)}
+	}
+	if len(_c.mutation.DepositAccountIDs()) == 0 {
+		return &ValidationError{Name: "deposit_account", err: errors.New(`ent: missing required edge "CustomerPayment.deposit_account"`)}
+	}
+	return nil
+}
+
+func (_c *CustomerPaymentCreate) sqlSave(ctx context.Context) (*CustomerPayment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CustomerPaymentCreate) createSpec() (*CustomerPayment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CustomerPayment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(customerpayment.Table, sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(customerpayment.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(customerpayment.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(customerpayment.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(customerpayment.FieldExchangeRate, field.TypeOther, value)
+		_node.ExchangeRate = value
+	}
+	if value, ok := _c.mutation.Unallocated(); ok {
+		_spec.SetField(customerpayment.FieldUnallocated, field.TypeOther, value)
+		_node.Unallocated = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(customerpayment.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(customerpayment.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(customerpayment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.TenantTable,
+			Columns: []string{customerpayment.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_customer_payments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.CustomerTable,
+			Columns: []string{customerpayment.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.customer_payments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DepositAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.DepositAccountTable,
+			Columns: []string{customerpayment.DepositAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.customer_payment_deposit_account = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.TransactionTable,
+			Columns: []string{customerpayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.customer_payment_transaction = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customerpayment.AllocationsTable,
+			Columns: []string{customerpayment.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CustomerPaymentCreateBulk is the builder for creating many CustomerPayment entities in bulk.
+type CustomerPaymentCreateBulk struct {
+	config
+	err      error
+	builders []*CustomerPaymentCreate
+}
+
+// Save creates the CustomerPayment entities in the database.
+func (_c *CustomerPaymentCreateBulk) Save(ctx context.Context) ([]*CustomerPayment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CustomerPayment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomerPaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CustomerPaymentCreateBulk) SaveX(ctx context.Context) []*CustomerPayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CustomerPaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CustomerPaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
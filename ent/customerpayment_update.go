@@ -0,0 +1,1052 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
+	"sent/ent/paymentallocation"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerPaymentUpdate is the builder for updating CustomerPayment entities.
+type CustomerPaymentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CustomerPaymentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CustomerPaymentUpdate builder.
+func (_u *CustomerPaymentUpdate) Where(ps ...predicate.CustomerPayment) *CustomerPaymentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *CustomerPaymentUpdate) SetDate(v time.Time) *CustomerPaymentUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *CustomerPaymentUpdate) SetNillableDate(v *time.Time) *CustomerPaymentUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *CustomerPaymentUpdate) SetAmount(v decimal.Decimal) *CustomerPaymentUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *CustomerPaymentUpdate) SetNillableAmount(v *decimal.Decimal) *CustomerPaymentUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *CustomerPaymentUpdate) SetCurrency(v string) *CustomerPaymentUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *CustomerPaymentUpdate) SetNillableCurrency(v *string) *CustomerPaymentUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *CustomerPaymentUpdate) SetExchangeRate(v decimal.Decimal) *CustomerPaymentUpdate {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *CustomerPaymentUpdate) SetNillableExchangeRate(v *decimal.Decimal) *CustomerPaymentUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// SetUnallocated sets the "unallocated" field.
+func (_u *CustomerPaymentUpdate) SetUnallocated(v decimal.Decimal) *CustomerPaymentUpdate {
+	_u.mutation.SetUnallocated(v)
+	return _u
+}
+
+// SetNillableUnallocated sets the "unallocated" field if the given value is not nil.
+func (_u *CustomerPaymentUpdate) SetNillableUnallocated(v *decimal.Decimal) *CustomerPaymentUpdate {
+	if v != nil {
+		_u.SetUnallocated(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *CustomerPaymentUpdate) SetMethod(v string) *CustomerPaymentUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *CustomerPaymentUpdate) SetNillableMethod(v *string) *CustomerPaymentUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// ClearMethod clears the value of the "method" field.
+func (_u *CustomerPaymentUpdate) ClearMethod() *CustomerPaymentUpdate {
+	_u.mutation.ClearMethod()
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *CustomerPaymentUpdate) SetReference(v string) *CustomerPaymentUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *CustomerPaymentUpdate) SetNillableReference(v *string) *CustomerPaymentUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *CustomerPaymentUpdate) ClearReference() *CustomerPaymentUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *CustomerPaymentUpdate) SetTenantID(id int) *CustomerPaymentUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *CustomerPaymentUpdate) SetTenant(v *Tenant) *CustomerPaymentUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_u *CustomerPaymentUpdate) SetCustomerID(id int) *CustomerPaymentUpdate {
+	_u.mutation.SetCustomerID(id)
+	return _u
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_u *CustomerPaymentUpdate) SetCustomer(v *Customer) *CustomerPaymentUpdate {
+	return _u.SetCustomerID(v.ID)
+}
+
+// SetDepositAccountID sets the "deposit_account" edge to the Account entity by ID.
+func (_u *CustomerPaymentUpdate) SetDepositAccountID(id int) *CustomerPaymentUpdate {
+	_u.mutation.SetDepositAccountID(id)
+	return _u
+}
+
+// SetDepositAccount sets the "deposit_account" edge to the Account entity.
+func (_u *CustomerPaymentUpdate) SetDepositAccount(v *Account) *CustomerPaymentUpdate {
+	return _u.SetDepositAccountID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *CustomerPaymentUpdate) SetTransactionID(id int) *CustomerPaymentUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *CustomerPaymentUpdate) SetNillableTransactionID(id *int) *CustomerPaymentUpdate {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *CustomerPaymentUpdate) SetTransaction(v *Transaction) *CustomerPaymentUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// AddAllocationIDs adds the "allocations" edge to the PaymentAllocation entity by IDs.
+func (_u *CustomerPaymentUpdate) AddAllocationIDs(ids ...int) *CustomerPaymentUpdate {
+	_u.mutation.AddAllocationIDs(ids...)
+	return _u
+}
+
+// AddAllocations adds the "allocations" edges to the PaymentAllocation entity.
+func (_u *CustomerPaymentUpdate) AddAllocations(v ...*PaymentAllocation) *CustomerPaymentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllocationIDs(ids...)
+}
+
+// Mutation returns the CustomerPaymentMutation object of the builder.
+func (_u *CustomerPaymentUpdate) Mutation() *CustomerPaymentMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *CustomerPaymentUpdate) ClearTenant() *CustomerPaymentUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (_u *CustomerPaymentUpdate) ClearCustomer() *CustomerPaymentUpdate {
+	_u.mutation.ClearCustomer()
+	return _u
+}
+
+// ClearDepositAccount clears the "deposit_account" edge to the Account entity.
+func (_u *CustomerPaymentUpdate) ClearDepositAccount() *CustomerPaymentUpdate {
+	_u.mutation.ClearDepositAccount()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *CustomerPaymentUpdate) ClearTransaction() *CustomerPaymentUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearAllocations clears all "allocations" edges to the PaymentAllocation entity.
+func (_u *CustomerPaymentUpdate) ClearAllocations() *CustomerPaymentUpdate {
+	_u.mutation.ClearAllocations()
+	return _u
+}
+
+// RemoveAllocationIDs removes the "allocations" edge to PaymentAllocation entities by IDs.
+func (_u *CustomerPaymentUpdate) RemoveAllocationIDs(ids ...int) *CustomerPaymentUpdate {
+	_u.mutation.RemoveAllocationIDs(ids...)
+	return _u
+}
+
+// RemoveAllocations removes "allocations" edges to PaymentAllocation entities.
+func (_u *CustomerPaymentUpdate) RemoveAllocations(v ...*PaymentAllocation) *CustomerPaymentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllocationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CustomerPaymentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomerPaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CustomerPaymentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomerPaymentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CustomerPaymentUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomerPayment.tenant"`)
+	}
+	if _u.mutation.CustomerCleared() && len(_u.mutation.CustomerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomerPayment.customer"`)
+	}
+	if _u.mutation.DepositAccountCleared() && len(_u.mutation.DepositAccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomerPayment.deposit_account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CustomerPaymentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CustomerPaymentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CustomerPaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customerpayment.Table, customerpayment.Columns, sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(customerpayment.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(customerpayment.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(customerpayment.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(customerpayment.FieldExchangeRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Unallocated(); ok {
+		_spec.SetField(customerpayment.FieldUnallocated, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(customerpayment.FieldMethod, field.TypeString, value)
+	}
+	if _u.mutation.MethodCleared() {
+		_spec.ClearField(customerpayment.FieldMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(customerpayment.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(customerpayment.FieldReference, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.TenantTable,
+			Columns: []string{customerpayment.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.TenantTable,
+			Columns: []string{customerpayment.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.CustomerTable,
+			Columns: []string{customerpayment.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.CustomerTable,
+			Columns: []string{customerpayment.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DepositAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.DepositAccountTable,
+			Columns: []string{customerpayment.DepositAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DepositAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.DepositAccountTable,
+			Columns: []string{customerpayment.DepositAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.TransactionTable,
+			Columns: []string{customerpayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.TransactionTable,
+			Columns: []string{customerpayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customerpayment.AllocationsTable,
+			Columns: []string{customerpayment.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllocationsIDs(); len(nodes) > 0 && !_u.mutation.AllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customerpayment.AllocationsTable,
+			Columns: []string{customerpayment.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customerpayment.AllocationsTable,
+			Columns: []string{customerpayment.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customerpayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CustomerPaymentUpdateOne is the builder for updating a single CustomerPayment entity.
+type CustomerPaymentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CustomerPaymentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDate sets the "date" field.
+func (_u *CustomerPaymentUpdateOne) SetDate(v time.Time) *CustomerPaymentUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *CustomerPaymentUpdateOne) SetNillableDate(v *time.Time) *CustomerPaymentUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *CustomerPaymentUpdateOne) SetAmount(v decimal.Decimal) *CustomerPaymentUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *CustomerPaymentUpdateOne) SetNillableAmount(v *decimal.Decimal) *CustomerPaymentUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *CustomerPaymentUpdateOne) SetCurrency(v string) *CustomerPaymentUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *CustomerPaymentUpdateOne) SetNillableCurrency(v *string) *CustomerPaymentUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *CustomerPaymentUpdateOne) SetExchangeRate(v decimal.Decimal) *CustomerPaymentUpdateOne {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *CustomerPaymentUpdateOne) SetNillableExchangeRate(v *decimal.Decimal) *CustomerPaymentUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// SetUnallocated sets the "unallocated" field.
+func (_u *CustomerPaymentUpdateOne) SetUnallocated(v decimal.Decimal) *CustomerPaymentUpdateOne {
+	_u.mutation.SetUnallocated(v)
+	return _u
+}
+
+// SetNillableUnallocated sets the "unallocated" field if the given value is not nil.
+func (_u *CustomerPaymentUpdateOne) SetNillableUnallocated(v *decimal.Decimal) *CustomerPaymentUpdateOne {
+	if v != nil {
+		_u.SetUnallocated(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *CustomerPaymentUpdateOne) SetMethod(v string) *CustomerPaymentUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *CustomerPaymentUpdateOne) SetNillableMethod(v *string) *CustomerPaymentUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// ClearMethod clears the value of the "method" field.
+func (_u *CustomerPaymentUpdateOne) ClearMethod() *CustomerPaymentUpdateOne {
+	_u.mutation.ClearMethod()
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *CustomerPaymentUpdateOne) SetReference(v string) *CustomerPaymentUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *CustomerPaymentUpdateOne) SetNillableReference(v *string) *CustomerPaymentUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *CustomerPaymentUpdateOne) ClearReference() *CustomerPaymentUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *CustomerPaymentUpdateOne) SetTenantID(id int) *CustomerPaymentUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *CustomerPaymentUpdateOne) SetTenant(v *Tenant) *CustomerPaymentUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_u *CustomerPaymentUpdateOne) SetCustomerID(id int) *CustomerPaymentUpdateOne {
+	_u.mutation.SetCustomerID(id)
+	return _u
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_u *CustomerPaymentUpdateOne) SetCustomer(v *Customer) *CustomerPaymentUpdateOne {
+	return _u.SetCustomerID(v.ID)
+}
+
+// SetDepositAccountID sets the "deposit_account" edge to the Account entity by ID.
+func (_u *CustomerPaymentUpdateOne) SetDepositAccountID(id int) *CustomerPaymentUpdateOne {
+	_u.mutation.SetDepositAccountID(id)
+	return _u
+}
+
+// SetDepositAccount sets the "deposit_account" edge to the Account entity.
+func (_u *CustomerPaymentUpdateOne) SetDepositAccount(v *Account) *CustomerPaymentUpdateOne {
+	return _u.SetDepositAccountID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *CustomerPaymentUpdateOne) SetTransactionID(id int) *CustomerPaymentUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *CustomerPaymentUpdateOne) SetNillableTransactionID(id *int) *CustomerPaymentUpdateOne {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *CustomerPaymentUpdateOne) SetTransaction(v *Transaction) *CustomerPaymentUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// AddAllocationIDs adds the "allocations" edge to the PaymentAllocation entity by IDs.
+func (_u *CustomerPaymentUpdateOne) AddAllocationIDs(ids ...int) *CustomerPaymentUpdateOne {
+	_u.mutation.AddAllocationIDs(ids...)
+	return _u
+}
+
+// AddAllocations adds the "allocations" edges to the PaymentAllocation entity.
+func (_u *CustomerPaymentUpdateOne) AddAllocations(v ...*PaymentAllocation) *CustomerPaymentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllocationIDs(ids...)
+}
+
+// Mutation returns the CustomerPaymentMutation object of the builder.
+func (_u *CustomerPaymentUpdateOne) Mutation() *CustomerPaymentMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *CustomerPaymentUpdateOne) ClearTenant() *CustomerPaymentUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (_u *CustomerPaymentUpdateOne) ClearCustomer() *CustomerPaymentUpdateOne {
+	_u.mutation.ClearCustomer()
+	return _u
+}
+
+// ClearDepositAccount clears the "deposit_account" edge to the Account entity.
+func (_u *CustomerPaymentUpdateOne) ClearDepositAccount() *CustomerPaymentUpdateOne {
+	_u.mutation.ClearDepositAccount()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *CustomerPaymentUpdateOne) ClearTransaction() *CustomerPaymentUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearAllocations clears all "allocations" edges to the PaymentAllocation entity.
+func (_u *CustomerPaymentUpdateOne) ClearAllocations() *CustomerPaymentUpdateOne {
+	_u.mutation.ClearAllocations()
+	return _u
+}
+
+// RemoveAllocationIDs removes the "allocations" edge to PaymentAllocation entities by IDs.
+func (_u *CustomerPaymentUpdateOne) RemoveAllocationIDs(ids ...int) *CustomerPaymentUpdateOne {
+	_u.mutation.RemoveAllocationIDs(ids...)
+	return _u
+}
+
+// RemoveAllocations removes "allocations" edges to PaymentAllocation entities.
+func (_u *CustomerPaymentUpdateOne) RemoveAllocations(v ...*PaymentAllocation) *CustomerPaymentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllocationIDs(ids...)
+}
+
+// Where appends a list predicates to the CustomerPaymentUpdate builder.
+func (_u *CustomerPaymentUpdateOne) Where(ps ...predicate.CustomerPayment) *CustomerPaymentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CustomerPaymentUpdateOne) Select(field string, fields ...string) *CustomerPaymentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CustomerPayment entity.
+func (_u *CustomerPaymentUpdateOne) Save(ctx context.Context) (*CustomerPayment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomerPaymentUpdateOne) SaveX(ctx context.Context) *CustomerPayment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CustomerPaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomerPaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CustomerPaymentUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomerPayment.tenant"`)
+	}
+	if _u.mutation.CustomerCleared() && len(_u.mutation.CustomerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomerPayment.customer"`)
+	}
+	if _u.mutation.DepositAccountCleared() && len(_u.mutation.DepositAccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomerPayment.deposit_account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CustomerPaymentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CustomerPaymentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CustomerPaymentUpdateOne) sqlSave(ctx context.Context) (_node *CustomerPayment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customerpayment.Table, customerpayment.Columns, sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomerPayment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customerpayment.FieldID)
+		for _, f := range fields {
+			if !customerpayment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customerpayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(customerpayment.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(customerpayment.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(customerpayment.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(customerpayment.FieldExchangeRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Unallocated(); ok {
+		_spec.SetField(customerpayment.FieldUnallocated, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(customerpayment.FieldMethod, field.TypeString, value)
+	}
+	if _u.mutation.MethodCleared() {
+		_spec.ClearField(customerpayment.FieldMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(customerpayment.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(customerpayment.FieldReference, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.TenantTable,
+			Columns: []string{customerpayment.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.TenantTable,
+			Columns: []string{customerpayment.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.CustomerTable,
+			Columns: []string{customerpayment.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customerpayment.CustomerTable,
+			Columns: []string{customerpayment.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DepositAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.DepositAccountTable,
+			Columns: []string{customerpayment.DepositAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DepositAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.DepositAccountTable,
+			Columns: []string{customerpayment.DepositAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.TransactionTable,
+			Columns: []string{customerpayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customerpayment.TransactionTable,
+			Columns: []string{customerpayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customerpayment.AllocationsTable,
+			Columns: []string{customerpayment.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllocationsIDs(); len(nodes) > 0 && !_u.mutation.AllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customerpayment.AllocationsTable,
+			Columns: []string{customerpayment.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customerpayment.AllocationsTable,
+			Columns: []string{customerpayment.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CustomerPayment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customerpayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
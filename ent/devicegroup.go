@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/devicegroup"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DeviceGroup is the model entity for the DeviceGroup schema.
+type DeviceGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Filter holds the value of the "filter" field.
+	Filter string `json:"filter,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeviceGroupQuery when eager-loading is set.
+	Edges                DeviceGroupEdges `json:"edges"`
+	tenant_device_groups *int
+	selectValues         sql.SelectValues
+}
+
+// DeviceGroupEdges holds the relations/edges for other nodes in the graph.
+type DeviceGroupEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Agents holds the value of the agents edge.
+	Agents []*Agent `json:"agents,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceGroupEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// AgentsOrErr returns the Agents value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceGroupEdges) AgentsOrErr() ([]*Agent, error) {
+	if e.loadedTypes[1] {
+		return e.Agents, nil
+	}
+	return nil, &NotLoadedError{edge: "agents"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeviceGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case devicegroup.FieldID:
+			values[i] = new(sql.NullInt64)
+		case devicegroup.FieldName, devicegroup.FieldDescription, devicegroup.FieldFilter, devicegroup.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case devicegroup.FieldCreatedAt, devicegroup.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case devicegroup.ForeignKeys[0]: // tenant_device_groups
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeviceGroup fields.
+func (_m *DeviceGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case devicegroup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case devicegroup.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case devicegroup.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case devicegroup.FieldFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filter", values[i])
+			} else if value.Valid {
+				_m.Filter = value.String
+			}
+		case devicegroup.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case devicegroup.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case devicegroup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case devicegroup.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_device_groups", value)
+			} else if value.Valid {
+				_m.tenant_device_groups = new(int)
+				*_m.tenant_device_groups = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeviceGroup.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeviceGroup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the DeviceGroup entity.
+func (_m *DeviceGroup) QueryTenant() *TenantQuery {
+	return NewDeviceGroupClient(_m.config).QueryTenant(_m)
+}
+
+// QueryAgents queries the "agents" edge of the DeviceGroup entity.
+func (_m *DeviceGroup) QueryAgents() *AgentQuery {
+	return NewDeviceGroupClient(_m.config).QueryAgents(_m)
+}
+
+// Update returns a builder for updating this DeviceGroup.
+// Note that you need to call DeviceGroup.Unwrap() before calling this method if this DeviceGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeviceGroup) Update() *DeviceGroupUpdateOne {
+	return NewDeviceGroupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeviceGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeviceGroup) Unwrap() *DeviceGroup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceGroup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeviceGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeviceGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("filter=")
+	builder.WriteString(_m.Filter)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeviceGroups is a parsable slice of DeviceGroup.
+type DeviceGroups []*DeviceGroup
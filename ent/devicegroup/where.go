@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package devicegroup
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldDescription, v))
+}
+
+// Filter applies equality check predicate on the "filter" field. It's identical to FilterEQ.
+func Filter(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldFilter, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// FilterEQ applies the EQ predicate on the "filter" field.
+func FilterEQ(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldFilter, v))
+}
+
+// FilterNEQ applies the NEQ predicate on the "filter" field.
+func FilterNEQ(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNEQ(FieldFilter, v))
+}
+
+// FilterIn applies the In predicate on the "filter" field.
+func FilterIn(vs ...string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIn(FieldFilter, vs...))
+}
+
+// FilterNotIn applies the NotIn predicate on the "filter" field.
+func FilterNotIn(vs ...string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotIn(FieldFilter, vs...))
+}
+
+// FilterGT applies the GT predicate on the "filter" field.
+func FilterGT(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGT(FieldFilter, v))
+}
+
+// FilterGTE applies the GTE predicate on the "filter" field.
+func FilterGTE(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGTE(FieldFilter, v))
+}
+
+// FilterLT applies the LT predicate on the "filter" field.
+func FilterLT(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLT(FieldFilter, v))
+}
+
+// FilterLTE applies the LTE predicate on the "filter" field.
+func FilterLTE(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLTE(FieldFilter, v))
+}
+
+// FilterContains applies the Contains predicate on the "filter" field.
+func FilterContains(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldContains(FieldFilter, v))
+}
+
+// FilterHasPrefix applies the HasPrefix predicate on the "filter" field.
+func FilterHasPrefix(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldHasPrefix(FieldFilter, v))
+}
+
+// FilterHasSuffix applies the HasSuffix predicate on the "filter" field.
+func FilterHasSuffix(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldHasSuffix(FieldFilter, v))
+}
+
+// FilterIsNil applies the IsNil predicate on the "filter" field.
+func FilterIsNil() predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIsNull(FieldFilter))
+}
+
+// FilterNotNil applies the NotNil predicate on the "filter" field.
+func FilterNotNil() predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotNull(FieldFilter))
+}
+
+// FilterEqualFold applies the EqualFold predicate on the "filter" field.
+func FilterEqualFold(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEqualFold(FieldFilter, v))
+}
+
+// FilterContainsFold applies the ContainsFold predicate on the "filter" field.
+func FilterContainsFold(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldContainsFold(FieldFilter, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.DeviceGroup {
+	return predicate.DeviceGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.DeviceGroup {
+	return predicate.DeviceGroup(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAgents applies the HasEdge predicate on the "agents" edge.
+func HasAgents() predicate.DeviceGroup {
+	return predicate.DeviceGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, AgentsTable, AgentsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAgentsWith applies the HasEdge predicate on the "agents" edge with a given conditions (other predicates).
+func HasAgentsWith(preds ...predicate.Agent) predicate.DeviceGroup {
+	return predicate.DeviceGroup(func(s *sql.Selector) {
+		step := newAgentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceGroup) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceGroup) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceGroup) predicate.DeviceGroup {
+	return predicate.DeviceGroup(sql.NotPredicates(p))
+}
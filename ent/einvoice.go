@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
+	"sent/ent/invoice"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EInvoice is the model entity for the EInvoice schema.
+type EInvoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Authority holds the value of the "authority" field.
+	Authority einvoice.Authority `json:"authority,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind einvoice.Kind `json:"kind,omitempty"`
+	// Profile holds the value of the "profile" field.
+	Profile einvoice.Profile `json:"profile,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// UUID holds the value of the "uuid" field.
+	UUID string `json:"uuid,omitempty"`
+	// Counter holds the value of the "counter" field.
+	Counter int `json:"counter,omitempty"`
+	// InvoiceHash holds the value of the "invoice_hash" field.
+	InvoiceHash string `json:"invoice_hash,omitempty"`
+	// PreviousHash holds the value of the "previous_hash" field.
+	PreviousHash string `json:"previous_hash,omitempty"`
+	// XML holds the value of the "xml" field.
+	XML string `json:"xml,omitempty"`
+	// Qr holds the value of the "qr" field.
+	Qr string `json:"qr,omitempty"`
+	// ClearedXML holds the value of the "cleared_xml" field.
+	ClearedXML string `json:"cleared_xml,omitempty"`
+	// SignedAt holds the value of the "signed_at" field.
+	SignedAt time.Time `json:"signed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EInvoiceQuery when eager-loading is set.
+	Edges                         EInvoiceEdges `json:"edges"`
+	einvoice_invoice              *int
+	einvoice_transaction          *int
+	einvoice_credential_einvoices *int
+	tenant_einvoices              *int
+	selectValues                  sql.SelectValues
+}
+
+// EInvoiceEdges holds the relations/edges for other nodes in the graph.
+type EInvoiceEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Credential holds the value of the credential edge.
+	Credential *EInvoiceCredential `json:"credential,omitempty"`
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EInvoiceEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// CredentialOrErr returns the Credential value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EInvoiceEdges) CredentialOrErr() (*EInvoiceCredential, error) {
+	if e.Credential != nil {
+		return e.Credential, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: einvoicecredential.Label}
+	}
+	return nil, &NotLoadedError{edge: "credential"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EInvoiceEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EInvoiceEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EInvoice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case einvoice.FieldID, einvoice.FieldCounter:
+			values[i] = new(sql.NullInt64)
+		case einvoice.FieldAuthority, einvoice.FieldKind, einvoice.FieldProfile, einvoice.FieldNumber, einvoice.FieldUUID, einvoice.FieldInvoiceHash, einvoice.FieldPreviousHash, einvoice.FieldXML, einvoice.FieldQr, einvoice.FieldClearedXML:
+			values[i] = new(sql.NullString)
+		case einvoice.FieldSignedAt:
+			values[i] = new(sql.NullTime)
+		case einvoice.ForeignKeys[0]: // einvoice_invoice
+			values[i] = new(sql.NullInt64)
+		case einvoice.ForeignKeys[1]: // einvoice_transaction
+			values[i] = new(sql.NullInt64)
+		case einvoice.ForeignKeys[2]: // einvoice_credential_einvoices
+			values[i] = new(sql.NullInt64)
+		case einvoice.ForeignKeys[3]: // tenant_einvoices
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EInvoice fields.
+func (_m *EInvoice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case einvoice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case einvoice.FieldAuthority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field authority", values[i])
+			} else if value.Valid {
+				_m.Authority = einvoice.Authority(value.String)
+			}
+		case einvoice.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = einvoice.Kind(value.String)
+			}
+		case einvoice.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				_m.Profile = einvoice.Profile(value.String)
+			}
+		case einvoice.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case einvoice.FieldUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uuid", values[i])
+			} else if value.Valid {
+				_m.UUID = value.String
+			}
+		case einvoice.FieldCounter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field counter", values[i])
+			} else if value.Valid {
+				_m.Counter = int(value.Int64)
+			}
+		case einvoice.FieldInvoiceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_hash", values[i])
+			} else if value.Valid {
+				_m.InvoiceHash = value.String
+			}
+		case einvoice.FieldPreviousHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_hash", values[i])
+			} else if value.Valid {
+				_m.PreviousHash = value.String
+			}
+		case einvoice.FieldXML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field xml", values[i])
+			} else if value.Valid {
+				_m.XML = value.String
+			}
+		case einvoice.FieldQr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field qr", values[i])
+			} else if value.Valid {
+				_m.Qr = value.String
+			}
+		case einvoice.FieldClearedXML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cleared_xml", values[i])
+			} else if value.Valid {
+				_m.ClearedXML = value.String
+			}
+		case einvoice.FieldSignedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field signed_at", values[i])
+			} else if value.Valid {
+				_m.SignedAt = value.Time
+			}
+		case einvoice.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field einvoice_invoice", value)
+			} else if value.Valid {
+				_m.einvoice_invoice = new(int)
+				*_m.einvoice_invoice = int(value.Int64)
+			}
+		case einvoice.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field einvoice_transaction", value)
+			} else if value.Valid {
+				_m.einvoice_transaction = new(int)
+				*_m.einvoice_transaction = int(value.Int64)
+			}
+		case einvoice.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field einvoice_credential_einvoices", value)
+			} else if value.Valid {
+				_m.einvoice_credential_einvoices = new(int)
+				*_m.einvoice_credential_einvoices = int(value.Int64)
+			}
+		case einvoice.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_einvoices", value)
+			} else if value.Valid {
+				_m.tenant_einvoices = new(int)
+				*_m.tenant_einvoices = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EInvoice.
+// This includes values selected through modifiers, order, etc.
+func (_m *EInvoice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the EInvoice entity.
+func (_m *EInvoice) QueryTenant() *TenantQuery {
+	return NewEInvoiceClient(_m.config).QueryTenant(_m)
+}
+
+// QueryCredential queries the "credential" edge of the EInvoice entity.
+func (_m *EInvoice) QueryCredential() *EInvoiceCredentialQuery {
+	return NewEInvoiceClient(_m.config).QueryCredential(_m)
+}
+
+// QueryInvoice queries the "invoice" edge of the EInvoice entity.
+func (_m *EInvoice) QueryInvoice() *InvoiceQuery {
+	return NewEInvoiceClient(_m.config).QueryInvoice(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the EInvoice entity.
+func (_m *EInvoice) QueryTransaction() *TransactionQuery {
+	return NewEInvoiceClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this EInvoice.
+// Note that you need to call EInvoice.Unwrap() before calling this method if this EInvoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EInvoice) Update() *EInvoiceUpdateOne {
+	return NewEInvoiceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EInvoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EInvoice) Unwrap() *EInvoice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EInvoice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EInvoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("EInvoice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("authority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Authority))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("profile=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Profile))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("uuid=")
+	builder.WriteString(_m.UUID)
+	builder.WriteString(", ")
+	builder.WriteString("counter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Counter))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_hash=")
+	builder.WriteString(_m.InvoiceHash)
+	builder.WriteString(", ")
+	builder.WriteString("previous_hash=")
+	builder.WriteString(_m.PreviousHash)
+	builder.WriteString(", ")
+	builder.WriteString("xml=")
+	builder.WriteString(_m.XML)
+	builder.WriteString(", ")
+	builder.WriteString("qr=")
+	builder.WriteString(_m.Qr)
+	builder.WriteString(", ")
+	builder.WriteString("cleared_xml=")
+	builder.WriteString(_m.ClearedXML)
+	builder.WriteString(", ")
+	builder.WriteString("signed_at=")
+	builder.WriteString(_m.SignedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EInvoices is a parsable slice of EInvoice.
+type EInvoices []*EInvoice
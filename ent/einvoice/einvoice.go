@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package einvoice
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the einvoice type in the database.
+	Label = "einvoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAuthority holds the string denoting the authority field in the database.
+	FieldAuthority = "authority"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldProfile holds the string denoting the profile field in the database.
+	FieldProfile = "profile"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldUUID holds the string denoting the uuid field in the database.
+	FieldUUID = "uuid"
+	// FieldCounter holds the string denoting the counter field in the database.
+	FieldCounter = "counter"
+	// FieldInvoiceHash holds the string denoting the invoice_hash field in the database.
+	FieldInvoiceHash = "invoice_hash"
+	// FieldPreviousHash holds the string denoting the previous_hash field in the database.
+	FieldPreviousHash = "previous_hash"
+	// FieldXML holds the string denoting the xml field in the database.
+	FieldXML = "xml"
+	// FieldQr holds the string denoting the qr field in the database.
+	FieldQr = "qr"
+	// FieldClearedXML holds the string denoting the cleared_xml field in the database.
+	FieldClearedXML = "cleared_xml"
+	// FieldSignedAt holds the string denoting the signed_at field in the database.
+	FieldSignedAt = "signed_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeCredential holds the string denoting the credential edge name in mutations.
+	EdgeCredential = "credential"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+	// EdgeTransaction holds the string denoting the transaction edge name in mutations.
+	EdgeTransaction = "transaction"
+	// Table holds the table name of the einvoice in the database.
+	Table = "einvoices"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "einvoices"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_einvoices"
+	// CredentialTable is the table that holds the credential relation/edge.
+	CredentialTable = "einvoices"
+	// CredentialInverseTable is the table name for the EInvoiceCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "einvoicecredential" package.
+	CredentialInverseTable = "einvoice_credentials"
+	// CredentialColumn is the table column denoting the credential relation/edge.
+	CredentialColumn = "einvoice_credential_einvoices"
+	// InvoiceTable is the table that holds the invoice relation/edge.
+	InvoiceTable = "einvoices"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "einvoice_invoice"
+	// TransactionTable is the table that holds the transaction relation/edge.
+	TransactionTable = "einvoices"
+	// TransactionInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionInverseTable = "transactions"
+	// TransactionColumn is the table column denoting the transaction relation/edge.
+	TransactionColumn = "einvoice_transaction"
+)
+
+// Columns holds all SQL columns for einvoice fields.
+var Columns = []string{
+	FieldID,
+	FieldAuthority,
+	FieldKind,
+	FieldProfile,
+	FieldNumber,
+	FieldUUID,
+	FieldCounter,
+	FieldInvoiceHash,
+	FieldPreviousHash,
+	FieldXML,
+	FieldQr,
+	FieldClearedXML,
+	FieldSignedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "einvoices"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"einvoice_invoice",
+	"einvoice_transaction",
+	"einvoice_credential_einvoices",
+	"tenant_einvoices",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CounterValidator is a validator for the "counter" field. It is called by the builders before save.
+	CounterValidator func(int) error
+	// DefaultSignedAt holds the default value on creation for the "signed_at" field.
+	DefaultSignedAt func() time.Time
+)
+
+// Authority defines the type for the "authority" enum field.
+type Authority string
+
+// AuthorityZatca is the default value of the Authority enum.
+const DefaultAuthority = AuthorityZatca
+
+// Authority values.
+const (
+	AuthorityZatca    Authority = "zatca"
+	AuthorityJofotara Authority = "jofotara"
+)
+
+func (a Authority) String() string {
+	return string(a)
+}
+
+// AuthorityValidator is a validator for the "authority" field enum values. It is called by the builders before save.
+func AuthorityValidator(a Authority) error {
+	switch a {
+	case AuthorityZatca, AuthorityJofotara:
+		return nil
+	default:
+		return fmt.Errorf("einvoice: invalid enum value for authority field: %q", a)
+	}
+}
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindInvoice    Kind = "invoice"
+	KindCreditNote Kind = "credit_note"
+	KindDebitNote  Kind = "debit_note"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindInvoice, KindCreditNote, KindDebitNote:
+		return nil
+	default:
+		return fmt.Errorf("einvoice: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Profile defines the type for the "profile" enum field.
+type Profile string
+
+// Profile values.
+const (
+	ProfileStandard   Profile = "standard"
+	ProfileSimplified Profile = "simplified"
+)
+
+func (pr Profile) String() string {
+	return string(pr)
+}
+
+// ProfileValidator is a validator for the "profile" field enum values. It is called by the builders before save.
+func ProfileValidator(pr Profile) error {
+	switch pr {
+	case ProfileStandard, ProfileSimplified:
+		return nil
+	default:
+		return fmt.Errorf("einvoice: invalid enum value for profile field: %q", pr)
+	}
+}
+
+// OrderOption defines the ordering options for the EInvoice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAuthority orders the results by the authority field.
+func ByAuthority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthority, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByProfile orders the results by the profile field.
+func ByProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfile, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByUUID orders the results by the uuid field.
+func ByUUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUUID, opts...).ToFunc()
+}
+
+// ByCounter orders the results by the counter field.
+func ByCounter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCounter, opts...).ToFunc()
+}
+
+// ByInvoiceHash orders the results by the invoice_hash field.
+func ByInvoiceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceHash, opts...).ToFunc()
+}
+
+// ByPreviousHash orders the results by the previous_hash field.
+func ByPreviousHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousHash, opts...).ToFunc()
+}
+
+// ByXML orders the results by the xml field.
+func ByXML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldXML, opts...).ToFunc()
+}
+
+// ByQr orders the results by the qr field.
+func ByQr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQr, opts...).ToFunc()
+}
+
+// ByClearedXML orders the results by the cleared_xml field.
+func ByClearedXML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClearedXML, opts...).ToFunc()
+}
+
+// BySignedAt orders the results by the signed_at field.
+func BySignedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCredentialField orders the results by credential field.
+func ByCredentialField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCredentialStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvoiceField orders the results by invoice field.
+func ByInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoiceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransactionField orders the results by transaction field.
+func ByTransactionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newCredentialStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CredentialInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CredentialTable, CredentialColumn),
+	)
+}
+func newInvoiceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoiceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, InvoiceTable, InvoiceColumn),
+	)
+}
+func newTransactionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+	)
+}
@@ -0,0 +1,813 @@
+// Code generated by ent, DO NOT EDIT.
+
+package einvoice
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldID, id))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldNumber, v))
+}
+
+// UUID applies equality check predicate on the "uuid" field. It's identical to UUIDEQ.
+func UUID(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldUUID, v))
+}
+
+// Counter applies equality check predicate on the "counter" field. It's identical to CounterEQ.
+func Counter(v int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldCounter, v))
+}
+
+// InvoiceHash applies equality check predicate on the "invoice_hash" field. It's identical to InvoiceHashEQ.
+func InvoiceHash(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldInvoiceHash, v))
+}
+
+// PreviousHash applies equality check predicate on the "previous_hash" field. It's identical to PreviousHashEQ.
+func PreviousHash(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldPreviousHash, v))
+}
+
+// XML applies equality check predicate on the "xml" field. It's identical to XMLEQ.
+func XML(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldXML, v))
+}
+
+// Qr applies equality check predicate on the "qr" field. It's identical to QrEQ.
+func Qr(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldQr, v))
+}
+
+// ClearedXML applies equality check predicate on the "cleared_xml" field. It's identical to ClearedXMLEQ.
+func ClearedXML(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldClearedXML, v))
+}
+
+// SignedAt applies equality check predicate on the "signed_at" field. It's identical to SignedAtEQ.
+func SignedAt(v time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldSignedAt, v))
+}
+
+// AuthorityEQ applies the EQ predicate on the "authority" field.
+func AuthorityEQ(v Authority) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldAuthority, v))
+}
+
+// AuthorityNEQ applies the NEQ predicate on the "authority" field.
+func AuthorityNEQ(v Authority) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldAuthority, v))
+}
+
+// AuthorityIn applies the In predicate on the "authority" field.
+func AuthorityIn(vs ...Authority) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldAuthority, vs...))
+}
+
+// AuthorityNotIn applies the NotIn predicate on the "authority" field.
+func AuthorityNotIn(vs ...Authority) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldAuthority, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v Profile) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldProfile, v))
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v Profile) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldProfile, v))
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...Profile) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldProfile, vs...))
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...Profile) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldProfile, vs...))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldNumber, v))
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContains(FieldNumber, v))
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasPrefix(FieldNumber, v))
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasSuffix(FieldNumber, v))
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEqualFold(FieldNumber, v))
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContainsFold(FieldNumber, v))
+}
+
+// UUIDEQ applies the EQ predicate on the "uuid" field.
+func UUIDEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldUUID, v))
+}
+
+// UUIDNEQ applies the NEQ predicate on the "uuid" field.
+func UUIDNEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldUUID, v))
+}
+
+// UUIDIn applies the In predicate on the "uuid" field.
+func UUIDIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldUUID, vs...))
+}
+
+// UUIDNotIn applies the NotIn predicate on the "uuid" field.
+func UUIDNotIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldUUID, vs...))
+}
+
+// UUIDGT applies the GT predicate on the "uuid" field.
+func UUIDGT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldUUID, v))
+}
+
+// UUIDGTE applies the GTE predicate on the "uuid" field.
+func UUIDGTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldUUID, v))
+}
+
+// UUIDLT applies the LT predicate on the "uuid" field.
+func UUIDLT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldUUID, v))
+}
+
+// UUIDLTE applies the LTE predicate on the "uuid" field.
+func UUIDLTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldUUID, v))
+}
+
+// UUIDContains applies the Contains predicate on the "uuid" field.
+func UUIDContains(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContains(FieldUUID, v))
+}
+
+// UUIDHasPrefix applies the HasPrefix predicate on the "uuid" field.
+func UUIDHasPrefix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasPrefix(FieldUUID, v))
+}
+
+// UUIDHasSuffix applies the HasSuffix predicate on the "uuid" field.
+func UUIDHasSuffix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasSuffix(FieldUUID, v))
+}
+
+// UUIDEqualFold applies the EqualFold predicate on the "uuid" field.
+func UUIDEqualFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEqualFold(FieldUUID, v))
+}
+
+// UUIDContainsFold applies the ContainsFold predicate on the "uuid" field.
+func UUIDContainsFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContainsFold(FieldUUID, v))
+}
+
+// CounterEQ applies the EQ predicate on the "counter" field.
+func CounterEQ(v int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldCounter, v))
+}
+
+// CounterNEQ applies the NEQ predicate on the "counter" field.
+func CounterNEQ(v int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldCounter, v))
+}
+
+// CounterIn applies the In predicate on the "counter" field.
+func CounterIn(vs ...int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldCounter, vs...))
+}
+
+// CounterNotIn applies the NotIn predicate on the "counter" field.
+func CounterNotIn(vs ...int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldCounter, vs...))
+}
+
+// CounterGT applies the GT predicate on the "counter" field.
+func CounterGT(v int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldCounter, v))
+}
+
+// CounterGTE applies the GTE predicate on the "counter" field.
+func CounterGTE(v int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldCounter, v))
+}
+
+// CounterLT applies the LT predicate on the "counter" field.
+func CounterLT(v int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldCounter, v))
+}
+
+// CounterLTE applies the LTE predicate on the "counter" field.
+func CounterLTE(v int) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldCounter, v))
+}
+
+// InvoiceHashEQ applies the EQ predicate on the "invoice_hash" field.
+func InvoiceHashEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldInvoiceHash, v))
+}
+
+// InvoiceHashNEQ applies the NEQ predicate on the "invoice_hash" field.
+func InvoiceHashNEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldInvoiceHash, v))
+}
+
+// InvoiceHashIn applies the In predicate on the "invoice_hash" field.
+func InvoiceHashIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldInvoiceHash, vs...))
+}
+
+// InvoiceHashNotIn applies the NotIn predicate on the "invoice_hash" field.
+func InvoiceHashNotIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldInvoiceHash, vs...))
+}
+
+// InvoiceHashGT applies the GT predicate on the "invoice_hash" field.
+func InvoiceHashGT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldInvoiceHash, v))
+}
+
+// InvoiceHashGTE applies the GTE predicate on the "invoice_hash" field.
+func InvoiceHashGTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldInvoiceHash, v))
+}
+
+// InvoiceHashLT applies the LT predicate on the "invoice_hash" field.
+func InvoiceHashLT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldInvoiceHash, v))
+}
+
+// InvoiceHashLTE applies the LTE predicate on the "invoice_hash" field.
+func InvoiceHashLTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldInvoiceHash, v))
+}
+
+// InvoiceHashContains applies the Contains predicate on the "invoice_hash" field.
+func InvoiceHashContains(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContains(FieldInvoiceHash, v))
+}
+
+// InvoiceHashHasPrefix applies the HasPrefix predicate on the "invoice_hash" field.
+func InvoiceHashHasPrefix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasPrefix(FieldInvoiceHash, v))
+}
+
+// InvoiceHashHasSuffix applies the HasSuffix predicate on the "invoice_hash" field.
+func InvoiceHashHasSuffix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasSuffix(FieldInvoiceHash, v))
+}
+
+// InvoiceHashEqualFold applies the EqualFold predicate on the "invoice_hash" field.
+func InvoiceHashEqualFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEqualFold(FieldInvoiceHash, v))
+}
+
+// InvoiceHashContainsFold applies the ContainsFold predicate on the "invoice_hash" field.
+func InvoiceHashContainsFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContainsFold(FieldInvoiceHash, v))
+}
+
+// PreviousHashEQ applies the EQ predicate on the "previous_hash" field.
+func PreviousHashEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldPreviousHash, v))
+}
+
+// PreviousHashNEQ applies the NEQ predicate on the "previous_hash" field.
+func PreviousHashNEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldPreviousHash, v))
+}
+
+// PreviousHashIn applies the In predicate on the "previous_hash" field.
+func PreviousHashIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldPreviousHash, vs...))
+}
+
+// PreviousHashNotIn applies the NotIn predicate on the "previous_hash" field.
+func PreviousHashNotIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldPreviousHash, vs...))
+}
+
+// PreviousHashGT applies the GT predicate on the "previous_hash" field.
+func PreviousHashGT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldPreviousHash, v))
+}
+
+// PreviousHashGTE applies the GTE predicate on the "previous_hash" field.
+func PreviousHashGTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldPreviousHash, v))
+}
+
+// PreviousHashLT applies the LT predicate on the "previous_hash" field.
+func PreviousHashLT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldPreviousHash, v))
+}
+
+// PreviousHashLTE applies the LTE predicate on the "previous_hash" field.
+func PreviousHashLTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldPreviousHash, v))
+}
+
+// PreviousHashContains applies the Contains predicate on the "previous_hash" field.
+func PreviousHashContains(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContains(FieldPreviousHash, v))
+}
+
+// PreviousHashHasPrefix applies the HasPrefix predicate on the "previous_hash" field.
+func PreviousHashHasPrefix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasPrefix(FieldPreviousHash, v))
+}
+
+// PreviousHashHasSuffix applies the HasSuffix predicate on the "previous_hash" field.
+func PreviousHashHasSuffix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasSuffix(FieldPreviousHash, v))
+}
+
+// PreviousHashEqualFold applies the EqualFold predicate on the "previous_hash" field.
+func PreviousHashEqualFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEqualFold(FieldPreviousHash, v))
+}
+
+// PreviousHashContainsFold applies the ContainsFold predicate on the "previous_hash" field.
+func PreviousHashContainsFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContainsFold(FieldPreviousHash, v))
+}
+
+// XMLEQ applies the EQ predicate on the "xml" field.
+func XMLEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldXML, v))
+}
+
+// XMLNEQ applies the NEQ predicate on the "xml" field.
+func XMLNEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldXML, v))
+}
+
+// XMLIn applies the In predicate on the "xml" field.
+func XMLIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldXML, vs...))
+}
+
+// XMLNotIn applies the NotIn predicate on the "xml" field.
+func XMLNotIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldXML, vs...))
+}
+
+// XMLGT applies the GT predicate on the "xml" field.
+func XMLGT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldXML, v))
+}
+
+// XMLGTE applies the GTE predicate on the "xml" field.
+func XMLGTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldXML, v))
+}
+
+// XMLLT applies the LT predicate on the "xml" field.
+func XMLLT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldXML, v))
+}
+
+// XMLLTE applies the LTE predicate on the "xml" field.
+func XMLLTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldXML, v))
+}
+
+// XMLContains applies the Contains predicate on the "xml" field.
+func XMLContains(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContains(FieldXML, v))
+}
+
+// XMLHasPrefix applies the HasPrefix predicate on the "xml" field.
+func XMLHasPrefix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasPrefix(FieldXML, v))
+}
+
+// XMLHasSuffix applies the HasSuffix predicate on the "xml" field.
+func XMLHasSuffix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasSuffix(FieldXML, v))
+}
+
+// XMLEqualFold applies the EqualFold predicate on the "xml" field.
+func XMLEqualFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEqualFold(FieldXML, v))
+}
+
+// XMLContainsFold applies the ContainsFold predicate on the "xml" field.
+func XMLContainsFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContainsFold(FieldXML, v))
+}
+
+// QrEQ applies the EQ predicate on the "qr" field.
+func QrEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldQr, v))
+}
+
+// QrNEQ applies the NEQ predicate on the "qr" field.
+func QrNEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldQr, v))
+}
+
+// QrIn applies the In predicate on the "qr" field.
+func QrIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldQr, vs...))
+}
+
+// QrNotIn applies the NotIn predicate on the "qr" field.
+func QrNotIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldQr, vs...))
+}
+
+// QrGT applies the GT predicate on the "qr" field.
+func QrGT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldQr, v))
+}
+
+// QrGTE applies the GTE predicate on the "qr" field.
+func QrGTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldQr, v))
+}
+
+// QrLT applies the LT predicate on the "qr" field.
+func QrLT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldQr, v))
+}
+
+// QrLTE applies the LTE predicate on the "qr" field.
+func QrLTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldQr, v))
+}
+
+// QrContains applies the Contains predicate on the "qr" field.
+func QrContains(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContains(FieldQr, v))
+}
+
+// QrHasPrefix applies the HasPrefix predicate on the "qr" field.
+func QrHasPrefix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasPrefix(FieldQr, v))
+}
+
+// QrHasSuffix applies the HasSuffix predicate on the "qr" field.
+func QrHasSuffix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasSuffix(FieldQr, v))
+}
+
+// QrEqualFold applies the EqualFold predicate on the "qr" field.
+func QrEqualFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEqualFold(FieldQr, v))
+}
+
+// QrContainsFold applies the ContainsFold predicate on the "qr" field.
+func QrContainsFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContainsFold(FieldQr, v))
+}
+
+// ClearedXMLEQ applies the EQ predicate on the "cleared_xml" field.
+func ClearedXMLEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldClearedXML, v))
+}
+
+// ClearedXMLNEQ applies the NEQ predicate on the "cleared_xml" field.
+func ClearedXMLNEQ(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldClearedXML, v))
+}
+
+// ClearedXMLIn applies the In predicate on the "cleared_xml" field.
+func ClearedXMLIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldClearedXML, vs...))
+}
+
+// ClearedXMLNotIn applies the NotIn predicate on the "cleared_xml" field.
+func ClearedXMLNotIn(vs ...string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldClearedXML, vs...))
+}
+
+// ClearedXMLGT applies the GT predicate on the "cleared_xml" field.
+func ClearedXMLGT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldClearedXML, v))
+}
+
+// ClearedXMLGTE applies the GTE predicate on the "cleared_xml" field.
+func ClearedXMLGTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldClearedXML, v))
+}
+
+// ClearedXMLLT applies the LT predicate on the "cleared_xml" field.
+func ClearedXMLLT(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldClearedXML, v))
+}
+
+// ClearedXMLLTE applies the LTE predicate on the "cleared_xml" field.
+func ClearedXMLLTE(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldClearedXML, v))
+}
+
+// ClearedXMLContains applies the Contains predicate on the "cleared_xml" field.
+func ClearedXMLContains(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContains(FieldClearedXML, v))
+}
+
+// ClearedXMLHasPrefix applies the HasPrefix predicate on the "cleared_xml" field.
+func ClearedXMLHasPrefix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasPrefix(FieldClearedXML, v))
+}
+
+// ClearedXMLHasSuffix applies the HasSuffix predicate on the "cleared_xml" field.
+func ClearedXMLHasSuffix(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldHasSuffix(FieldClearedXML, v))
+}
+
+// ClearedXMLIsNil applies the IsNil predicate on the "cleared_xml" field.
+func ClearedXMLIsNil() predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIsNull(FieldClearedXML))
+}
+
+// ClearedXMLNotNil applies the NotNil predicate on the "cleared_xml" field.
+func ClearedXMLNotNil() predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotNull(FieldClearedXML))
+}
+
+// ClearedXMLEqualFold applies the EqualFold predicate on the "cleared_xml" field.
+func ClearedXMLEqualFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEqualFold(FieldClearedXML, v))
+}
+
+// ClearedXMLContainsFold applies the ContainsFold predicate on the "cleared_xml" field.
+func ClearedXMLContainsFold(v string) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldContainsFold(FieldClearedXML, v))
+}
+
+// SignedAtEQ applies the EQ predicate on the "signed_at" field.
+func SignedAtEQ(v time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldEQ(FieldSignedAt, v))
+}
+
+// SignedAtNEQ applies the NEQ predicate on the "signed_at" field.
+func SignedAtNEQ(v time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNEQ(FieldSignedAt, v))
+}
+
+// SignedAtIn applies the In predicate on the "signed_at" field.
+func SignedAtIn(vs ...time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldIn(FieldSignedAt, vs...))
+}
+
+// SignedAtNotIn applies the NotIn predicate on the "signed_at" field.
+func SignedAtNotIn(vs ...time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldNotIn(FieldSignedAt, vs...))
+}
+
+// SignedAtGT applies the GT predicate on the "signed_at" field.
+func SignedAtGT(v time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGT(FieldSignedAt, v))
+}
+
+// SignedAtGTE applies the GTE predicate on the "signed_at" field.
+func SignedAtGTE(v time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldGTE(FieldSignedAt, v))
+}
+
+// SignedAtLT applies the LT predicate on the "signed_at" field.
+func SignedAtLT(v time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLT(FieldSignedAt, v))
+}
+
+// SignedAtLTE applies the LTE predicate on the "signed_at" field.
+func SignedAtLTE(v time.Time) predicate.EInvoice {
+	return predicate.EInvoice(sql.FieldLTE(FieldSignedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.EInvoice {
+	return predicate.EInvoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.EInvoice {
+	return predicate.EInvoice(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCredential applies the HasEdge predicate on the "credential" edge.
+func HasCredential() predicate.EInvoice {
+	return predicate.EInvoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CredentialTable, CredentialColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCredentialWith applies the HasEdge predicate on the "credential" edge with a given conditions (other predicates).
+func HasCredentialWith(preds ...predicate.EInvoiceCredential) predicate.EInvoice {
+	return predicate.EInvoice(func(s *sql.Selector) {
+		step := newCredentialStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.EInvoice {
+	return predicate.EInvoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.EInvoice {
+	return predicate.EInvoice(func(s *sql.Selector) {
+		step := newInvoiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.EInvoice {
+	return predicate.EInvoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.EInvoice {
+	return predicate.EInvoice(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EInvoice) predicate.EInvoice {
+	return predicate.EInvoice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EInvoice) predicate.EInvoice {
+	return predicate.EInvoice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EInvoice) predicate.EInvoice {
+	return predicate.EInvoice(sql.NotPredicates(p))
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
+	"sent/ent/invoice"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EInvoiceCreate is the builder for creating a EInvoice entity.
+type EInvoiceCreate struct {
+	config
+	mutation *EInvoiceMutation
+	hooks    []Hook
+}
+
+// SetAuthority sets the "authority" field.
+func (_c *EInvoiceCreate) SetAuthority(v einvoice.Authority) *EInvoiceCreate {
+	_c.mutation.SetAuthority(v)
+	return _c
+}
+
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_c *EInvoiceCreate) SetNillableAuthority(v *einvoice.Authority) *EInvoiceCreate {
+	if v != nil {
+		_c.SetAuthority(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *EInvoiceCreate) SetKind(v einvoice.Kind) *EInvoiceCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetProfile sets the "profile" field.
+func (_c *EInvoiceCreate) SetProfile(v einvoice.Profile) *EInvoiceCreate {
+	_c.mutation.SetProfile(v)
+	return _c
+}
+
+// SetNumber sets the "number" field.
+func (_c *EInvoiceCreate) SetNumber(v string) *EInvoiceCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetUUID sets the "uuid" field.
+func (_c *EInvoiceCreate) SetUUID(v string) *EInvoiceCreate {
+	_c.mutation.SetUUID(v)
+	return _c
+}
+
+// SetCounter sets the "counter" field.
+func (_c *EInvoiceCreate) SetCounter(v int) *EInvoiceCreate {
+	_c.mutation.SetCounter(v)
+	return _c
+}
+
+// SetInvoiceHash sets the "invoice_hash" field.
+func (_c *EInvoiceCreate) SetInvoiceHash(v string) *EInvoiceCreate {
+	_c.mutation.SetInvoiceHash(v)
+	return _c
+}
+
+// SetPreviousHash sets the "previous_hash" field.
+func (_c *EInvoiceCreate) SetPreviousHash(v string) *EInvoiceCreate {
+	_c.mutation.SetPreviousHash(v)
+	return _c
+}
+
+// SetXML sets the "xml" field.
+func (_c *EInvoiceCreate) SetXML(v string) *EInvoiceCreate {
+	_c.mutation.SetXML(v)
+	return _c
+}
+
+// SetQr sets the "qr" field.
+func (_c *EInvoiceCreate) SetQr(v string) *EInvoiceCreate {
+	_c.mutation.SetQr(v)
+	return _c
+}
+
+// SetClearedXML sets the "cleared_xml" field.
+func (_c *EInvoiceCreate) SetClearedXML(v string) *EInvoiceCreate {
+	_c.mutation.SetClearedXML(v)
+	return _c
+}
+
+// SetNillableClearedXML sets the "cleared_xml" field if the given value is not nil.
+func (_c *EInvoiceCreate) SetNillableClearedXML(v *string) *EInvoiceCreate {
+	if v != nil {
+		_c.SetClearedXML(*v)
+	}
+	return _c
+}
+
+// SetSignedAt sets the "signed_at" field.
+func (_c *EInvoiceCreate) SetSignedAt(v time.Time) *EInvoiceCreate {
+	_c.mutation.SetSignedAt(v)
+	return _c
+}
+
+// SetNillableSignedAt sets the "signed_at" field if the given value is not nil.
+func (_c *EInvoiceCreate) SetNillableSignedAt(v *time.Time) *EInvoiceCreate {
+	if v != nil {
+		_c.SetSignedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *EInvoiceCreate) SetTenantID(id int) *EInvoiceCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *EInvoiceCreate) SetTenant(v *Tenant) *EInvoiceCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetCredentialID sets the "credential" edge to the EInvoiceCredential entity by ID.
+func (_c *EInvoiceCreate) SetCredentialID(id int) *EInvoiceCreate {
+	_c.mutation.SetCredentialID(id)
+	return _c
+}
+
+// SetCredential sets the "credential" edge to the EInvoiceCredential entity.
+func (_c *EInvoiceCreate) SetCredential(v *EInvoiceCredential) *EInvoiceCreate {
+	return _c.SetCredentialID(v.ID)
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_c *EInvoiceCreate) SetInvoiceID(id int) *EInvoiceCreate {
+	_c.mutation.SetInvoiceID(id)
+	return _c
+}
+
+// SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_c *EInvoiceCreate) SetNillableInvoiceID(id *int) *EInvoiceCreate {
+	if id != nil {
+		_c = _c.SetInvoiceID(*id)
+	}
+	return _c
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *EInvoiceCreate) SetInvoice(v *Invoice) *EInvoiceCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *EInvoiceCreate) SetTransactionID(id int) *EInvoiceCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_c *EInvoiceCreate) SetNillableTransactionID(id *int) *EInvoiceCreate {
+	if id != nil {
+		_c = _c.SetTransactionID(*id)
+	}
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *EInvoiceCreate) SetTransaction(v *Transaction) *EInvoiceCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// Mutation returns the EInvoiceMutation object of the builder.
+func (_c *EInvoiceCreate) Mutation() *EInvoiceMutation {
+	return _c.mutation
+}
+
+// Save creates the EInvoice in the database.
+func (_c *EInvoiceCreate) Save(ctx context.Context) (*EInvoice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EInvoiceCreate) SaveX(ctx context.Context) *EInvoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EInvoiceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EInvoiceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EInvoiceCreate) defaults() {
+	if _, ok := _c.mutation.Authority(); !ok {
+		v := einvoice.DefaultAuthority
+		_c.mutation.SetAuthority(v)
+	}
+	if _, ok := _c.mutation.SignedAt(); !ok {
+		v := einvoice.DefaultSignedAt()
+		_c.mutation.SetSignedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EInvoiceCreate) check() error {
+	if _, ok := _c.mutation.Authority(); !ok {
+		return &ValidationError{Name: "authority", err: errors.New(`ent: missing required field "EInvoice.authority"`)}
+	}
+	if v, ok := _c.mutation.Authority(); ok {
+		if err := einvoice.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`ent: validator failed for field "EInvoice.authority": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "EInvoice.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := einvoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EInvoice.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Profile(); !ok {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required field "EInvoice.profile"`)}
+	}
+	if v, ok := _c.mutation.Profile(); ok {
+		if err := einvoice.ProfileValidator(v); err != nil {
+			return &ValidationError{Name: "profile", err: fmt.Errorf(`ent: validator failed for field "EInvoice.profile": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "EInvoice.number"`)}
+	}
+	if _, ok := _c.mutation.UUID(); !ok {
+		return &ValidationError{Name: "uuid", err: errors.New(`ent: missing required field "EInvoice.uuid"`)}
+	}
+	if _, ok := _c.mutation.Counter(); !ok {
+		return &ValidationError{Name: "counter", err: errors.New(`ent: missing required field "EInvoice.counter"`)}
+	}
+	if v, ok := _c.mutation.Counter(); ok {
+		if err := einvoice.CounterValidator(v); err != nil {
+			return &ValidationError{Name: "counter", err: fmt.Errorf(`ent: validator failed for field "EInvoice.counter": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InvoiceHash(); !ok {
+		return &ValidationError{Name: "invoice_hash", err: errors.New(`ent: missing required field "EInvoice.invoice_hash"`)}
+	}
+	if _, ok := _c.mutation.PreviousHash(); !ok {
+		return &ValidationError{Name: "previous_hash", err: errors.New(`ent: missing required field "EInvoice.previous_hash"`)}
+	}
+	if _, ok := _c.mutation.XML(); !ok {
+		return &ValidationError{Name: "xml", err: errors.New(`ent: missing required field "EInvoice.xml"`)}
+	}
+	if _, ok := _c.mutation.Qr(); !ok {
+		return &ValidationError{Name: "qr", err: errors.New(`ent: missing required field "EInvoice.qr"`)}
+	}
+	if _, ok := _c.mutation.SignedAt(); !ok {
+		return &ValidationError{Name: "signed_at", err: errors.New(`ent: missing required field "EInvoice.signed_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "EInvoice.tenant"`)}
+	}
+	if len(_c.mutation.CredentialIDs()) == 0 {
+		return &ValidationError{Name: "credential", err: errors.New(`ent: missing required edge "EInvoice.credential"`)}
+	}
+	return nil
+}
+
+func (_c *EInvoiceCreate) sqlSave(ctx context.Context) (*EInvoice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EInvoiceCreate) createSpec() (*EInvoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EInvoice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(einvoice.Table, sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Authority(); ok {
+		_spec.SetField(einvoice.FieldAuthority, field.TypeEnum, value)
+		_node.Authority = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(einvoice.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Profile(); ok {
+		_spec.SetField(einvoice.FieldProfile, field.TypeEnum, value)
+		_node.Profile = value
+	}
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(einvoice.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.UUID(); ok {
+		_spec.SetField(einvoice.FieldUUID, field.TypeString, value)
+		_node.UUID = value
+	}
+	if value, ok := _c.mutation.Counter(); ok {
+		_spec.SetField(einvoice.FieldCounter, field.TypeInt, value)
+		_node.Counter = value
+	}
+	if value, ok := _c.mutation.InvoiceHash(); ok {
+		_spec.SetField(einvoice.FieldInvoiceHash, field.TypeString, value)
+		_node.InvoiceHash = value
+	}
+	if value, ok := _c.mutation.PreviousHash(); ok {
+		_spec.SetField(einvoice.FieldPreviousHash, field.TypeString, value)
+		_node.PreviousHash = value
+	}
+	if value, ok := _c.mutation.XML(); ok {
+		_spec.SetField(einvoice.FieldXML, field.TypeString, value)
+		_node.XML = value
+	}
+	if value, ok := _c.mutation.Qr(); ok {
+		_spec.SetField(einvoice.FieldQr, field.TypeString, value)
+		_node.Qr = value
+	}
+	if value, ok := _c.mutation.ClearedXML(); ok {
+		_spec.SetField(einvoice.FieldClearedXML, field.TypeString, value)
+		_node.ClearedXML = value
+	}
+	if value, ok := _c.mutation.SignedAt(); ok {
+		_spec.SetField(einvoice.FieldSignedAt, field.TypeTime, value)
+		_node.SignedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.TenantTable,
+			Columns: []string{einvoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_einvoices = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.CredentialTable,
+			Columns: []string{einvoice.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.einvoice_credential_einvoices = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.InvoiceTable,
+			Columns: []string{einvoice.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.einvoice_invoice = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.TransactionTable,
+			Columns: []string{einvoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.einvoice_transaction = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EInvoiceCreateBulk is the builder for creating many EInvoice entities in bulk.
+type EInvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*EInvoiceCreate
+}
+
+// Save creates the EInvoice entities in the database.
+func (_c *EInvoiceCreateBulk) Save(ctx context.Context) ([]*EInvoice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EInvoice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EInvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EInvoiceCreateBulk) SaveX(ctx context.Context) []*EInvoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EInvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EInvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
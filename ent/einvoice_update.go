@@ -0,0 +1,1075 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
+	"sent/ent/invoice"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EInvoiceUpdate is the builder for updating EInvoice entities.
+type EInvoiceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EInvoiceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EInvoiceUpdate builder.
+func (_u *EInvoiceUpdate) Where(ps ...predicate.EInvoice) *EInvoiceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAuthority sets the "authority" field.
+func (_u *EInvoiceUpdate) SetAuthority(v einvoice.Authority) *EInvoiceUpdate {
+	_u.mutation.SetAuthority(v)
+	return _u
+}
+
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableAuthority(v *einvoice.Authority) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetAuthority(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EInvoiceUpdate) SetKind(v einvoice.Kind) *EInvoiceUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableKind(v *einvoice.Kind) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" field.
+func (_u *EInvoiceUpdate) SetProfile(v einvoice.Profile) *EInvoiceUpdate {
+	_u.mutation.SetProfile(v)
+	return _u
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableProfile(v *einvoice.Profile) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetProfile(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *EInvoiceUpdate) SetNumber(v string) *EInvoiceUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableNumber(v *string) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetUUID sets the "uuid" field.
+func (_u *EInvoiceUpdate) SetUUID(v string) *EInvoiceUpdate {
+	_u.mutation.SetUUID(v)
+	return _u
+}
+
+// SetNillableUUID sets the "uuid" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableUUID(v *string) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetUUID(*v)
+	}
+	return _u
+}
+
+// SetCounter sets the "counter" field.
+func (_u *EInvoiceUpdate) SetCounter(v int) *EInvoiceUpdate {
+	_u.mutation.ResetCounter()
+	_u.mutation.SetCounter(v)
+	return _u
+}
+
+// SetNillableCounter sets the "counter" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableCounter(v *int) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetCounter(*v)
+	}
+	return _u
+}
+
+// AddCounter adds value to the "counter" field.
+func (_u *EInvoiceUpdate) AddCounter(v int) *EInvoiceUpdate {
+	_u.mutation.AddCounter(v)
+	return _u
+}
+
+// SetInvoiceHash sets the "invoice_hash" field.
+func (_u *EInvoiceUpdate) SetInvoiceHash(v string) *EInvoiceUpdate {
+	_u.mutation.SetInvoiceHash(v)
+	return _u
+}
+
+// SetNillableInvoiceHash sets the "invoice_hash" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableInvoiceHash(v *string) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetInvoiceHash(*v)
+	}
+	return _u
+}
+
+// SetPreviousHash sets the "previous_hash" field.
+func (_u *EInvoiceUpdate) SetPreviousHash(v string) *EInvoiceUpdate {
+	_u.mutation.SetPreviousHash(v)
+	return _u
+}
+
+// SetNillablePreviousHash sets the "previous_hash" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillablePreviousHash(v *string) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetPreviousHash(*v)
+	}
+	return _u
+}
+
+// SetXML sets the "xml" field.
+func (_u *EInvoiceUpdate) SetXML(v string) *EInvoiceUpdate {
+	_u.mutation.SetXML(v)
+	return _u
+}
+
+// SetNillableXML sets the "xml" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableXML(v *string) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetXML(*v)
+	}
+	return _u
+}
+
+// SetQr sets the "qr" field.
+func (_u *EInvoiceUpdate) SetQr(v string) *EInvoiceUpdate {
+	_u.mutation.SetQr(v)
+	return _u
+}
+
+// SetNillableQr sets the "qr" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableQr(v *string) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetQr(*v)
+	}
+	return _u
+}
+
+// SetClearedXML sets the "cleared_xml" field.
+func (_u *EInvoiceUpdate) SetClearedXML(v string) *EInvoiceUpdate {
+	_u.mutation.SetClearedXML(v)
+	return _u
+}
+
+// SetNillableClearedXML sets the "cleared_xml" field if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableClearedXML(v *string) *EInvoiceUpdate {
+	if v != nil {
+		_u.SetClearedXML(*v)
+	}
+	return _u
+}
+
+// ClearClearedXML clears the value of the "cleared_xml" field.
+func (_u *EInvoiceUpdate) ClearClearedXML() *EInvoiceUpdate {
+	_u.mutation.ClearClearedXML()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *EInvoiceUpdate) SetTenantID(id int) *EInvoiceUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *EInvoiceUpdate) SetTenant(v *Tenant) *EInvoiceUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetCredentialID sets the "credential" edge to the EInvoiceCredential entity by ID.
+func (_u *EInvoiceUpdate) SetCredentialID(id int) *EInvoiceUpdate {
+	_u.mutation.SetCredentialID(id)
+	return _u
+}
+
+// SetCredential sets the "credential" edge to the EInvoiceCredential entity.
+func (_u *EInvoiceUpdate) SetCredential(v *EInvoiceCredential) *EInvoiceUpdate {
+	return _u.SetCredentialID(v.ID)
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *EInvoiceUpdate) SetInvoiceID(id int) *EInvoiceUpdate {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableInvoiceID(id *int) *EInvoiceUpdate {
+	if id != nil {
+		_u = _u.SetInvoiceID(*id)
+	}
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *EInvoiceUpdate) SetInvoice(v *Invoice) *EInvoiceUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *EInvoiceUpdate) SetTransactionID(id int) *EInvoiceUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *EInvoiceUpdate) SetNillableTransactionID(id *int) *EInvoiceUpdate {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *EInvoiceUpdate) SetTransaction(v *Transaction) *EInvoiceUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the EInvoiceMutation object of the builder.
+func (_u *EInvoiceUpdate) Mutation() *EInvoiceMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *EInvoiceUpdate) ClearTenant() *EInvoiceUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCredential clears the "credential" edge to the EInvoiceCredential entity.
+func (_u *EInvoiceUpdate) ClearCredential() *EInvoiceUpdate {
+	_u.mutation.ClearCredential()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *EInvoiceUpdate) ClearInvoice() *EInvoiceUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *EInvoiceUpdate) ClearTransaction() *EInvoiceUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EInvoiceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EInvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EInvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EInvoiceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EInvoiceUpdate) check() error {
+	if v, ok := _u.mutation.Authority(); ok {
+		if err := einvoice.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`ent: validator failed for field "EInvoice.authority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := einvoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EInvoice.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Profile(); ok {
+		if err := einvoice.ProfileValidator(v); err != nil {
+			return &ValidationError{Name: "profile", err: fmt.Errorf(`ent: validator failed for field "EInvoice.profile": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Counter(); ok {
+		if err := einvoice.CounterValidator(v); err != nil {
+			return &ValidationError{Name: "counter", err: fmt.Errorf(`ent: validator failed for field "EInvoice.counter": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EInvoice.tenant"`)
+	}
+	if _u.mutation.CredentialCleared() && len(_u.mutation.CredentialIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EInvoice.credential"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EInvoiceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EInvoiceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EInvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(einvoice.Table, einvoice.Columns, sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Authority(); ok {
+		_spec.SetField(einvoice.FieldAuthority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(einvoice.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Profile(); ok {
+		_spec.SetField(einvoice.FieldProfile, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(einvoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UUID(); ok {
+		_spec.SetField(einvoice.FieldUUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Counter(); ok {
+		_spec.SetField(einvoice.FieldCounter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCounter(); ok {
+		_spec.AddField(einvoice.FieldCounter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InvoiceHash(); ok {
+		_spec.SetField(einvoice.FieldInvoiceHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PreviousHash(); ok {
+		_spec.SetField(einvoice.FieldPreviousHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.XML(); ok {
+		_spec.SetField(einvoice.FieldXML, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Qr(); ok {
+		_spec.SetField(einvoice.FieldQr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClearedXML(); ok {
+		_spec.SetField(einvoice.FieldClearedXML, field.TypeString, value)
+	}
+	if _u.mutation.ClearedXMLCleared() {
+		_spec.ClearField(einvoice.FieldClearedXML, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.TenantTable,
+			Columns: []string{einvoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.TenantTable,
+			Columns: []string{einvoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.CredentialTable,
+			Columns: []string{einvoice.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.CredentialTable,
+			Columns: []string{einvoice.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.InvoiceTable,
+			Columns: []string{einvoice.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.InvoiceTable,
+			Columns: []string{einvoice.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.TransactionTable,
+			Columns: []string{einvoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.TransactionTable,
+			Columns: []string{einvoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{einvoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EInvoiceUpdateOne is the builder for updating a single EInvoice entity.
+type EInvoiceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EInvoiceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAuthority sets the "authority" field.
+func (_u *EInvoiceUpdateOne) SetAuthority(v einvoice.Authority) *EInvoiceUpdateOne {
+	_u.mutation.SetAuthority(v)
+	return _u
+}
+
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableAuthority(v *einvoice.Authority) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetAuthority(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EInvoiceUpdateOne) SetKind(v einvoice.Kind) *EInvoiceUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableKind(v *einvoice.Kind) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" field.
+func (_u *EInvoiceUpdateOne) SetProfile(v einvoice.Profile) *EInvoiceUpdateOne {
+	_u.mutation.SetProfile(v)
+	return _u
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableProfile(v *einvoice.Profile) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetProfile(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *EInvoiceUpdateOne) SetNumber(v string) *EInvoiceUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableNumber(v *string) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetUUID sets the "uuid" field.
+func (_u *EInvoiceUpdateOne) SetUUID(v string) *EInvoiceUpdateOne {
+	_u.mutation.SetUUID(v)
+	return _u
+}
+
+// SetNillableUUID sets the "uuid" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableUUID(v *string) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetUUID(*v)
+	}
+	return _u
+}
+
+// SetCounter sets the "counter" field.
+func (_u *EInvoiceUpdateOne) SetCounter(v int) *EInvoiceUpdateOne {
+	_u.mutation.ResetCounter()
+	_u.mutation.SetCounter(v)
+	return _u
+}
+
+// SetNillableCounter sets the "counter" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableCounter(v *int) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetCounter(*v)
+	}
+	return _u
+}
+
+// AddCounter adds value to the "counter" field.
+func (_u *EInvoiceUpdateOne) AddCounter(v int) *EInvoiceUpdateOne {
+	_u.mutation.AddCounter(v)
+	return _u
+}
+
+// SetInvoiceHash sets the "invoice_hash" field.
+func (_u *EInvoiceUpdateOne) SetInvoiceHash(v string) *EInvoiceUpdateOne {
+	_u.mutation.SetInvoiceHash(v)
+	return _u
+}
+
+// SetNillableInvoiceHash sets the "invoice_hash" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableInvoiceHash(v *string) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetInvoiceHash(*v)
+	}
+	return _u
+}
+
+// SetPreviousHash sets the "previous_hash" field.
+func (_u *EInvoiceUpdateOne) SetPreviousHash(v string) *EInvoiceUpdateOne {
+	_u.mutation.SetPreviousHash(v)
+	return _u
+}
+
+// SetNillablePreviousHash sets the "previous_hash" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillablePreviousHash(v *string) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetPreviousHash(*v)
+	}
+	return _u
+}
+
+// SetXML sets the "xml" field.
+func (_u *EInvoiceUpdateOne) SetXML(v string) *EInvoiceUpdateOne {
+	_u.mutation.SetXML(v)
+	return _u
+}
+
+// SetNillableXML sets the "xml" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableXML(v *string) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetXML(*v)
+	}
+	return _u
+}
+
+// SetQr sets the "qr" field.
+func (_u *EInvoiceUpdateOne) SetQr(v string) *EInvoiceUpdateOne {
+	_u.mutation.SetQr(v)
+	return _u
+}
+
+// SetNillableQr sets the "qr" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableQr(v *string) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetQr(*v)
+	}
+	return _u
+}
+
+// SetClearedXML sets the "cleared_xml" field.
+func (_u *EInvoiceUpdateOne) SetClearedXML(v string) *EInvoiceUpdateOne {
+	_u.mutation.SetClearedXML(v)
+	return _u
+}
+
+// SetNillableClearedXML sets the "cleared_xml" field if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableClearedXML(v *string) *EInvoiceUpdateOne {
+	if v != nil {
+		_u.SetClearedXML(*v)
+	}
+	return _u
+}
+
+// ClearClearedXML clears the value of the "cleared_xml" field.
+func (_u *EInvoiceUpdateOne) ClearClearedXML() *EInvoiceUpdateOne {
+	_u.mutation.ClearClearedXML()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *EInvoiceUpdateOne) SetTenantID(id int) *EInvoiceUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *EInvoiceUpdateOne) SetTenant(v *Tenant) *EInvoiceUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetCredentialID sets the "credential" edge to the EInvoiceCredential entity by ID.
+func (_u *EInvoiceUpdateOne) SetCredentialID(id int) *EInvoiceUpdateOne {
+	_u.mutation.SetCredentialID(id)
+	return _u
+}
+
+// SetCredential sets the "credential" edge to the EInvoiceCredential entity.
+func (_u *EInvoiceUpdateOne) SetCredential(v *EInvoiceCredential) *EInvoiceUpdateOne {
+	return _u.SetCredentialID(v.ID)
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *EInvoiceUpdateOne) SetInvoiceID(id int) *EInvoiceUpdateOne {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableInvoiceID(id *int) *EInvoiceUpdateOne {
+	if id != nil {
+		_u = _u.SetInvoiceID(*id)
+	}
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *EInvoiceUpdateOne) SetInvoice(v *Invoice) *EInvoiceUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *EInvoiceUpdateOne) SetTransactionID(id int) *EInvoiceUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *EInvoiceUpdateOne) SetNillableTransactionID(id *int) *EInvoiceUpdateOne {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *EInvoiceUpdateOne) SetTransaction(v *Transaction) *EInvoiceUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the EInvoiceMutation object of the builder.
+func (_u *EInvoiceUpdateOne) Mutation() *EInvoiceMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *EInvoiceUpdateOne) ClearTenant() *EInvoiceUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCredential clears the "credential" edge to the EInvoiceCredential entity.
+func (_u *EInvoiceUpdateOne) ClearCredential() *EInvoiceUpdateOne {
+	_u.mutation.ClearCredential()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *EInvoiceUpdateOne) ClearInvoice() *EInvoiceUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *EInvoiceUpdateOne) ClearTransaction() *EInvoiceUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Where appends a list predicates to the EInvoiceUpdate builder.
+func (_u *EInvoiceUpdateOne) Where(ps ...predicate.EInvoice) *EInvoiceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EInvoiceUpdateOne) Select(field string, fields ...string) *EInvoiceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EInvoice entity.
+func (_u *EInvoiceUpdateOne) Save(ctx context.Context) (*EInvoice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EInvoiceUpdateOne) SaveX(ctx context.Context) *EInvoice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EInvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EInvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EInvoiceUpdateOne) check() error {
+	if v, ok := _u.mutation.Authority(); ok {
+		if err := einvoice.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`ent: validator failed for field "EInvoice.authority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := einvoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EInvoice.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Profile(); ok {
+		if err := einvoice.ProfileValidator(v); err != nil {
+			return &ValidationError{Name: "profile", err: fmt.Errorf(`ent: validator failed for field "EInvoice.profile": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Counter(); ok {
+		if err := einvoice.CounterValidator(v); err != nil {
+			return &ValidationError{Name: "counter", err: fmt.Errorf(`ent: validator failed for field "EInvoice.counter": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EInvoice.tenant"`)
+	}
+	if _u.mutation.CredentialCleared() && len(_u.mutation.CredentialIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EInvoice.credential"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EInvoiceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EInvoiceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EInvoiceUpdateOne) sqlSave(ctx context.Context) (_node *EInvoice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(einvoice.Table, einvoice.Columns, sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EInvoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, einvoice.FieldID)
+		for _, f := range fields {
+			if !einvoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != einvoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Authority(); ok {
+		_spec.SetField(einvoice.FieldAuthority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(einvoice.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Profile(); ok {
+		_spec.SetField(einvoice.FieldProfile, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(einvoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UUID(); ok {
+		_spec.SetField(einvoice.FieldUUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Counter(); ok {
+		_spec.SetField(einvoice.FieldCounter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCounter(); ok {
+		_spec.AddField(einvoice.FieldCounter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InvoiceHash(); ok {
+		_spec.SetField(einvoice.FieldInvoiceHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PreviousHash(); ok {
+		_spec.SetField(einvoice.FieldPreviousHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.XML(); ok {
+		_spec.SetField(einvoice.FieldXML, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Qr(); ok {
+		_spec.SetField(einvoice.FieldQr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClearedXML(); ok {
+		_spec.SetField(einvoice.FieldClearedXML, field.TypeString, value)
+	}
+	if _u.mutation.ClearedXMLCleared() {
+		_spec.ClearField(einvoice.FieldClearedXML, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.TenantTable,
+			Columns: []string{einvoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.TenantTable,
+			Columns: []string{einvoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.CredentialTable,
+			Columns: []string{einvoice.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoice.CredentialTable,
+			Columns: []string{einvoice.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.InvoiceTable,
+			Columns: []string{einvoice.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.InvoiceTable,
+			Columns: []string{einvoice.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.TransactionTable,
+			Columns: []string{einvoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   einvoice.TransactionTable,
+			Columns: []string{einvoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EInvoice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{einvoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
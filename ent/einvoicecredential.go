@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/einvoicecredential"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EInvoiceCredential is the model entity for the EInvoiceCredential schema.
+type EInvoiceCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Authority holds the value of the "authority" field.
+	Authority einvoicecredential.Authority `json:"authority,omitempty"`
+	// Environment holds the value of the "environment" field.
+	Environment einvoicecredential.Environment `json:"environment,omitempty"`
+	// Certificate holds the value of the "certificate" field.
+	Certificate string `json:"certificate,omitempty"`
+	// PrivateKeyEncrypted holds the value of the "private_key_encrypted" field.
+	PrivateKeyEncrypted string `json:"-"`
+	// SecretEncrypted holds the value of the "secret_encrypted" field.
+	SecretEncrypted string `json:"-"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// ComplianceRequestID holds the value of the "compliance_request_id" field.
+	ComplianceRequestID string `json:"compliance_request_id,omitempty"`
+	// RegistrationNumber holds the value of the "registration_number" field.
+	RegistrationNumber string `json:"registration_number,omitempty"`
+	// Street holds the value of the "street" field.
+	Street string `json:"street,omitempty"`
+	// BuildingNumber holds the value of the "building_number" field.
+	BuildingNumber string `json:"building_number,omitempty"`
+	// District holds the value of the "district" field.
+	District string `json:"district,omitempty"`
+	// City holds the value of the "city" field.
+	City string `json:"city,omitempty"`
+	// PostalCode holds the value of the "postal_code" field.
+	PostalCode string `json:"postal_code,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EInvoiceCredentialQuery when eager-loading is set.
+	Edges                       EInvoiceCredentialEdges `json:"edges"`
+	tenant_einvoice_credentials *int
+	selectValues                sql.SelectValues
+}
+
+// EInvoiceCredentialEdges holds the relations/edges for other nodes in the graph.
+type EInvoiceCredentialEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Einvoices holds the value of the einvoices edge.
+	Einvoices []*EInvoice `json:"einvoices,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EInvoiceCredentialEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// EinvoicesOrErr returns the Einvoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e EInvoiceCredentialEdges) EinvoicesOrErr() ([]*EInvoice, error) {
+	if e.loadedTypes[1] {
+		return e.Einvoices, nil
+	}
+	return nil, &NotLoadedError{edge: "einvoices"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EInvoiceCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case einvoicecredential.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case einvoicecredential.FieldID:
+			values[i] = new(sql.NullInt64)
+		case einvoicecredential.FieldAuthority, einvoicecredential.FieldEnvironment, einvoicecredential.FieldCertificate, einvoicecredential.FieldPrivateKeyEncrypted, einvoicecredential.FieldSecretEncrypted, einvoicecredential.FieldClientID, einvoicecredential.FieldComplianceRequestID, einvoicecredential.FieldRegistrationNumber, einvoicecredential.FieldStreet, einvoicecredential.FieldBuildingNumber, einvoicecredential.FieldDistrict, einvoicecredential.FieldCity, einvoicecredential.FieldPostalCode:
+			values[i] = new(sql.NullString)
+		case einvoicecredential.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case einvoicecredential.ForeignKeys[0]: // tenant_einvoice_credentials
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EInvoiceCredential fields.
+func (_m *EInvoiceCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case einvoicecredential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case einvoicecredential.FieldAuthority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field authority", values[i])
+			} else if value.Valid {
+				_m.Authority = einvoicecredential.Authority(value.String)
+			}
+		case einvoicecredential.FieldEnvironment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment", values[i])
+			} else if value.Valid {
+				_m.Environment = einvoicecredential.Environment(value.String)
+			}
+		case einvoicecredential.FieldCertificate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate", values[i])
+			} else if value.Valid {
+				_m.Certificate = value.String
+			}
+		case einvoicecredential.FieldPrivateKeyEncrypted:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key_encrypted", values[i])
+			} else if value.Valid {
+				_m.PrivateKeyEncrypted = value.String
+			}
+		case einvoicecredential.FieldSecretEncrypted:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_encrypted", values[i])
+			} else if value.Valid {
+				_m.SecretEncrypted = value.String
+			}
+		case einvoicecredential.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case einvoicecredential.FieldComplianceRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field compliance_request_id", values[i])
+			} else if value.Valid {
+				_m.ComplianceRequestID = value.String
+			}
+		case einvoicecredential.FieldRegistrationNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registration_number", values[i])
+			} else if value.Valid {
+				_m.RegistrationNumber = value.String
+			}
+		case einvoicecredential.FieldStreet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field street", values[i])
+			} else if value.Valid {
+				_m.Street = value.String
+			}
+		case einvoicecredential.FieldBuildingNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field building_number", values[i])
+			} else if value.Valid {
+				_m.BuildingNumber = value.String
+			}
+		case einvoicecredential.FieldDistrict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field district", values[i])
+			} else if value.Valid {
+				_m.District = value.String
+			}
+		case einvoicecredential.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				_m.City = value.String
+			}
+		case einvoicecredential.FieldPostalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field postal_code", values[i])
+			} else if value.Valid {
+				_m.PostalCode = value.String
+			}
+		case einvoicecredential.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case einvoicecredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case einvoicecredential.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_einvoice_credentials", value)
+			} else if value.Valid {
+				_m.tenant_einvoice_credentials = new(int)
+				*_m.tenant_einvoice_credentials = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EInvoiceCredential.
+// This includes values selected through modifiers, order, etc.
+func (_m *EInvoiceCredential) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the EInvoiceCredential entity.
+func (_m *EInvoiceCredential) QueryTenant() *TenantQuery {
+	return NewEInvoiceCredentialClient(_m.config).QueryTenant(_m)
+}
+
+// QueryEinvoices queries the "einvoices" edge of the EInvoiceCredential entity.
+func (_m *EInvoiceCredential) QueryEinvoices() *EInvoiceQuery {
+	return NewEInvoiceCredentialClient(_m.config).QueryEinvoices(_m)
+}
+
+// Update returns a builder for updating this EInvoiceCredential.
+// Note that you need to call EInvoiceCredential.Unwrap() before calling this method if this EInvoiceCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EInvoiceCredential) Update() *EInvoiceCredentialUpdateOne {
+	return NewEInvoiceCredentialClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EInvoiceCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EInvoiceCredential) Unwrap() *EInvoiceCredential {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EInvoiceCredential is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EInvoiceCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("EInvoiceCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("authority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Authority))
+	builder.WriteString(", ")
+	builder.WriteString("environment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Environment))
+	builder.WriteString(", ")
+	builder.WriteString("certificate=")
+	builder.WriteString(_m.Certificate)
+	builder.WriteString(", ")
+	builder.WriteString("private_key_encrypted=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("secret_encrypted=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("compliance_request_id=")
+	builder.WriteString(_m.ComplianceRequestID)
+	builder.WriteString(", ")
+	builder.WriteString("registration_number=")
+	builder.WriteString(_m.RegistrationNumber)
+	builder.WriteString(", ")
+	builder.WriteString("street=")
+	builder.WriteString(_m.Street)
+	builder.WriteString(", ")
+	builder.WriteString("building_number=")
+	builder.WriteString(_m.BuildingNumber)
+	builder.WriteString(", ")
+	builder.WriteString("district=")
+	builder.WriteString(_m.District)
+	builder.WriteString(", ")
+	builder.WriteString("city=")
+	builder.WriteString(_m.City)
+	builder.WriteString(", ")
+	builder.WriteString("postal_code=")
+	builder.WriteString(_m.PostalCode)
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EInvoiceCredentials is a parsable slice of EInvoiceCredential.
+type EInvoiceCredentials []*EInvoiceCredential
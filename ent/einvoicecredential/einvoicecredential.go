@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package einvoicecredential
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the einvoicecredential type in the database.
+	Label = "einvoice_credential"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAuthority holds the string denoting the authority field in the database.
+	FieldAuthority = "authority"
+	// FieldEnvironment holds the string denoting the environment field in the database.
+	FieldEnvironment = "environment"
+	// FieldCertificate holds the string denoting the certificate field in the database.
+	FieldCertificate = "certificate"
+	// FieldPrivateKeyEncrypted holds the string denoting the private_key_encrypted field in the database.
+	FieldPrivateKeyEncrypted = "private_key_encrypted"
+	// FieldSecretEncrypted holds the string denoting the secret_encrypted field in the database.
+	FieldSecretEncrypted = "secret_encrypted"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldComplianceRequestID holds the string denoting the compliance_request_id field in the database.
+	FieldComplianceRequestID = "compliance_request_id"
+	// FieldRegistrationNumber holds the string denoting the registration_number field in the database.
+	FieldRegistrationNumber = "registration_number"
+	// FieldStreet holds the string denoting the street field in the database.
+	FieldStreet = "street"
+	// FieldBuildingNumber holds the string denoting the building_number field in the database.
+	FieldBuildingNumber = "building_number"
+	// FieldDistrict holds the string denoting the district field in the database.
+	FieldDistrict = "district"
+	// FieldCity holds the string denoting the city field in the database.
+	FieldCity = "city"
+	// FieldPostalCode holds the string denoting the postal_code field in the database.
+	FieldPostalCode = "postal_code"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeEinvoices holds the string denoting the einvoices edge name in mutations.
+	EdgeEinvoices = "einvoices"
+	// Table holds the table name of the einvoicecredential in the database.
+	Table = "einvoice_credentials"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "einvoice_credentials"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_einvoice_credentials"
+	// EinvoicesTable is the table that holds the einvoices relation/edge.
+	EinvoicesTable = "einvoices"
+	// EinvoicesInverseTable is the table name for the EInvoice entity.
+	// It exists in this package in order to avoid circular dependency with the "einvoice" package.
+	EinvoicesInverseTable = "einvoices"
+	// EinvoicesColumn is the table column denoting the einvoices relation/edge.
+	EinvoicesColumn = "einvoice_credential_einvoices"
+)
+
+// Columns holds all SQL columns for einvoicecredential fields.
+var Columns = []string{
+	FieldID,
+	FieldAuthority,
+	FieldEnvironment,
+	FieldCertificate,
+	FieldPrivateKeyEncrypted,
+	FieldSecretEncrypted,
+	FieldClientID,
+	FieldComplianceRequestID,
+	FieldRegistrationNumber,
+	FieldStreet,
+	FieldBuildingNumber,
+	FieldDistrict,
+	FieldCity,
+	FieldPostalCode,
+	FieldIsActive,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "einvoice_credentials"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tenant_einvoice_credentials",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Authority defines the type for the "authority" enum field.
+type Authority string
+
+// AuthorityZatca is the default value of the Authority enum.
+const DefaultAuthority = AuthorityZatca
+
+// Authority values.
+const (
+	AuthorityZatca    Authority = "zatca"
+	AuthorityJofotara Authority = "jofotara"
+)
+
+func (a Authority) String() string {
+	return string(a)
+}
+
+// AuthorityValidator is a validator for the "authority" field enum values. It is called by the builders before save.
+func AuthorityValidator(a Authority) error {
+	switch a {
+	case AuthorityZatca, AuthorityJofotara:
+		return nil
+	default:
+		return fmt.Errorf("einvoicecredential: invalid enum value for authority field: %q", a)
+	}
+}
+
+// Environment defines the type for the "environment" enum field.
+type Environment string
+
+// EnvironmentProduction is the default value of the Environment enum.
+const DefaultEnvironment = EnvironmentProduction
+
+// Environment values.
+const (
+	EnvironmentLocal      Environment = "local"
+	EnvironmentSandbox    Environment = "sandbox"
+	EnvironmentSimulation Environment = "simulation"
+	EnvironmentProduction Environment = "production"
+)
+
+func (e Environment) String() string {
+	return string(e)
+}
+
+// EnvironmentValidator is a validator for the "environment" field enum values. It is called by the builders before save.
+func EnvironmentValidator(e Environment) error {
+	switch e {
+	case EnvironmentLocal, EnvironmentSandbox, EnvironmentSimulation, EnvironmentProduction:
+		return nil
+	default:
+		return fmt.Errorf("einvoicecredential: invalid enum value for environment field: %q", e)
+	}
+}
+
+// OrderOption defines the ordering options for the EInvoiceCredential queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAuthority orders the results by the authority field.
+func ByAuthority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthority, opts...).ToFunc()
+}
+
+// ByEnvironment orders the results by the environment field.
+func ByEnvironment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironment, opts...).ToFunc()
+}
+
+// ByCertificate orders the results by the certificate field.
+func ByCertificate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificate, opts...).ToFunc()
+}
+
+// ByPrivateKeyEncrypted orders the results by the private_key_encrypted field.
+func ByPrivateKeyEncrypted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrivateKeyEncrypted, opts...).ToFunc()
+}
+
+// BySecretEncrypted orders the results by the secret_encrypted field.
+func BySecretEncrypted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecretEncrypted, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByComplianceRequestID orders the results by the compliance_request_id field.
+func ByComplianceRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComplianceRequestID, opts...).ToFunc()
+}
+
+// ByRegistrationNumber orders the results by the registration_number field.
+func ByRegistrationNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistrationNumber, opts...).ToFunc()
+}
+
+// ByStreet orders the results by the street field.
+func ByStreet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStreet, opts...).ToFunc()
+}
+
+// ByBuildingNumber orders the results by the building_number field.
+func ByBuildingNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildingNumber, opts...).ToFunc()
+}
+
+// ByDistrict orders the results by the district field.
+func ByDistrict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDistrict, opts...).ToFunc()
+}
+
+// ByCity orders the results by the city field.
+func ByCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCity, opts...).ToFunc()
+}
+
+// ByPostalCode orders the results by the postal_code field.
+func ByPostalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostalCode, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEinvoicesCount orders the results by einvoices count.
+func ByEinvoicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEinvoicesStep(), opts...)
+	}
+}
+
+// ByEinvoices orders the results by einvoices terms.
+func ByEinvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEinvoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newEinvoicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EinvoicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EinvoicesTable, EinvoicesColumn),
+	)
+}
@@ -0,0 +1,1097 @@
+// Code generated by ent, DO NOT EDIT.
+
+package einvoicecredential
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldID, id))
+}
+
+// Certificate applies equality check predicate on the "certificate" field. It's identical to CertificateEQ.
+func Certificate(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldCertificate, v))
+}
+
+// PrivateKeyEncrypted applies equality check predicate on the "private_key_encrypted" field. It's identical to PrivateKeyEncryptedEQ.
+func PrivateKeyEncrypted(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldPrivateKeyEncrypted, v))
+}
+
+// SecretEncrypted applies equality check predicate on the "secret_encrypted" field. It's identical to SecretEncryptedEQ.
+func SecretEncrypted(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldSecretEncrypted, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldClientID, v))
+}
+
+// ComplianceRequestID applies equality check predicate on the "compliance_request_id" field. It's identical to ComplianceRequestIDEQ.
+func ComplianceRequestID(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldComplianceRequestID, v))
+}
+
+// RegistrationNumber applies equality check predicate on the "registration_number" field. It's identical to RegistrationNumberEQ.
+func RegistrationNumber(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldRegistrationNumber, v))
+}
+
+// Street applies equality check predicate on the "street" field. It's identical to StreetEQ.
+func Street(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldStreet, v))
+}
+
+// BuildingNumber applies equality check predicate on the "building_number" field. It's identical to BuildingNumberEQ.
+func BuildingNumber(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldBuildingNumber, v))
+}
+
+// District applies equality check predicate on the "district" field. It's identical to DistrictEQ.
+func District(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldDistrict, v))
+}
+
+// City applies equality check predicate on the "city" field. It's identical to CityEQ.
+func City(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldCity, v))
+}
+
+// PostalCode applies equality check predicate on the "postal_code" field. It's identical to PostalCodeEQ.
+func PostalCode(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldPostalCode, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AuthorityEQ applies the EQ predicate on the "authority" field.
+func AuthorityEQ(v Authority) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldAuthority, v))
+}
+
+// AuthorityNEQ applies the NEQ predicate on the "authority" field.
+func AuthorityNEQ(v Authority) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldAuthority, v))
+}
+
+// AuthorityIn applies the In predicate on the "authority" field.
+func AuthorityIn(vs ...Authority) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldAuthority, vs...))
+}
+
+// AuthorityNotIn applies the NotIn predicate on the "authority" field.
+func AuthorityNotIn(vs ...Authority) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldAuthority, vs...))
+}
+
+// EnvironmentEQ applies the EQ predicate on the "environment" field.
+func EnvironmentEQ(v Environment) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldEnvironment, v))
+}
+
+// EnvironmentNEQ applies the NEQ predicate on the "environment" field.
+func EnvironmentNEQ(v Environment) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldEnvironment, v))
+}
+
+// EnvironmentIn applies the In predicate on the "environment" field.
+func EnvironmentIn(vs ...Environment) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldEnvironment, vs...))
+}
+
+// EnvironmentNotIn applies the NotIn predicate on the "environment" field.
+func EnvironmentNotIn(vs ...Environment) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldEnvironment, vs...))
+}
+
+// CertificateEQ applies the EQ predicate on the "certificate" field.
+func CertificateEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldCertificate, v))
+}
+
+// CertificateNEQ applies the NEQ predicate on the "certificate" field.
+func CertificateNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldCertificate, v))
+}
+
+// CertificateIn applies the In predicate on the "certificate" field.
+func CertificateIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldCertificate, vs...))
+}
+
+// CertificateNotIn applies the NotIn predicate on the "certificate" field.
+func CertificateNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldCertificate, vs...))
+}
+
+// CertificateGT applies the GT predicate on the "certificate" field.
+func CertificateGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldCertificate, v))
+}
+
+// CertificateGTE applies the GTE predicate on the "certificate" field.
+func CertificateGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldCertificate, v))
+}
+
+// CertificateLT applies the LT predicate on the "certificate" field.
+func CertificateLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldCertificate, v))
+}
+
+// CertificateLTE applies the LTE predicate on the "certificate" field.
+func CertificateLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldCertificate, v))
+}
+
+// CertificateContains applies the Contains predicate on the "certificate" field.
+func CertificateContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldCertificate, v))
+}
+
+// CertificateHasPrefix applies the HasPrefix predicate on the "certificate" field.
+func CertificateHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldCertificate, v))
+}
+
+// CertificateHasSuffix applies the HasSuffix predicate on the "certificate" field.
+func CertificateHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldCertificate, v))
+}
+
+// CertificateIsNil applies the IsNil predicate on the "certificate" field.
+func CertificateIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldCertificate))
+}
+
+// CertificateNotNil applies the NotNil predicate on the "certificate" field.
+func CertificateNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldCertificate))
+}
+
+// CertificateEqualFold applies the EqualFold predicate on the "certificate" field.
+func CertificateEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldCertificate, v))
+}
+
+// CertificateContainsFold applies the ContainsFold predicate on the "certificate" field.
+func CertificateContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldCertificate, v))
+}
+
+// PrivateKeyEncryptedEQ applies the EQ predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedNEQ applies the NEQ predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedIn applies the In predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldPrivateKeyEncrypted, vs...))
+}
+
+// PrivateKeyEncryptedNotIn applies the NotIn predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldPrivateKeyEncrypted, vs...))
+}
+
+// PrivateKeyEncryptedGT applies the GT predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedGTE applies the GTE predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedLT applies the LT predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedLTE applies the LTE predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedContains applies the Contains predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedHasPrefix applies the HasPrefix predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedHasSuffix applies the HasSuffix predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedIsNil applies the IsNil predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldPrivateKeyEncrypted))
+}
+
+// PrivateKeyEncryptedNotNil applies the NotNil predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldPrivateKeyEncrypted))
+}
+
+// PrivateKeyEncryptedEqualFold applies the EqualFold predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldPrivateKeyEncrypted, v))
+}
+
+// PrivateKeyEncryptedContainsFold applies the ContainsFold predicate on the "private_key_encrypted" field.
+func PrivateKeyEncryptedContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldPrivateKeyEncrypted, v))
+}
+
+// SecretEncryptedEQ applies the EQ predicate on the "secret_encrypted" field.
+func SecretEncryptedEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedNEQ applies the NEQ predicate on the "secret_encrypted" field.
+func SecretEncryptedNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedIn applies the In predicate on the "secret_encrypted" field.
+func SecretEncryptedIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldSecretEncrypted, vs...))
+}
+
+// SecretEncryptedNotIn applies the NotIn predicate on the "secret_encrypted" field.
+func SecretEncryptedNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldSecretEncrypted, vs...))
+}
+
+// SecretEncryptedGT applies the GT predicate on the "secret_encrypted" field.
+func SecretEncryptedGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedGTE applies the GTE predicate on the "secret_encrypted" field.
+func SecretEncryptedGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedLT applies the LT predicate on the "secret_encrypted" field.
+func SecretEncryptedLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedLTE applies the LTE predicate on the "secret_encrypted" field.
+func SecretEncryptedLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedContains applies the Contains predicate on the "secret_encrypted" field.
+func SecretEncryptedContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedHasPrefix applies the HasPrefix predicate on the "secret_encrypted" field.
+func SecretEncryptedHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedHasSuffix applies the HasSuffix predicate on the "secret_encrypted" field.
+func SecretEncryptedHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedIsNil applies the IsNil predicate on the "secret_encrypted" field.
+func SecretEncryptedIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldSecretEncrypted))
+}
+
+// SecretEncryptedNotNil applies the NotNil predicate on the "secret_encrypted" field.
+func SecretEncryptedNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldSecretEncrypted))
+}
+
+// SecretEncryptedEqualFold applies the EqualFold predicate on the "secret_encrypted" field.
+func SecretEncryptedEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldSecretEncrypted, v))
+}
+
+// SecretEncryptedContainsFold applies the ContainsFold predicate on the "secret_encrypted" field.
+func SecretEncryptedContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldSecretEncrypted, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDIsNil applies the IsNil predicate on the "client_id" field.
+func ClientIDIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldClientID))
+}
+
+// ClientIDNotNil applies the NotNil predicate on the "client_id" field.
+func ClientIDNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldClientID))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ComplianceRequestIDEQ applies the EQ predicate on the "compliance_request_id" field.
+func ComplianceRequestIDEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDNEQ applies the NEQ predicate on the "compliance_request_id" field.
+func ComplianceRequestIDNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDIn applies the In predicate on the "compliance_request_id" field.
+func ComplianceRequestIDIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldComplianceRequestID, vs...))
+}
+
+// ComplianceRequestIDNotIn applies the NotIn predicate on the "compliance_request_id" field.
+func ComplianceRequestIDNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldComplianceRequestID, vs...))
+}
+
+// ComplianceRequestIDGT applies the GT predicate on the "compliance_request_id" field.
+func ComplianceRequestIDGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDGTE applies the GTE predicate on the "compliance_request_id" field.
+func ComplianceRequestIDGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDLT applies the LT predicate on the "compliance_request_id" field.
+func ComplianceRequestIDLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDLTE applies the LTE predicate on the "compliance_request_id" field.
+func ComplianceRequestIDLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDContains applies the Contains predicate on the "compliance_request_id" field.
+func ComplianceRequestIDContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDHasPrefix applies the HasPrefix predicate on the "compliance_request_id" field.
+func ComplianceRequestIDHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDHasSuffix applies the HasSuffix predicate on the "compliance_request_id" field.
+func ComplianceRequestIDHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDIsNil applies the IsNil predicate on the "compliance_request_id" field.
+func ComplianceRequestIDIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldComplianceRequestID))
+}
+
+// ComplianceRequestIDNotNil applies the NotNil predicate on the "compliance_request_id" field.
+func ComplianceRequestIDNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldComplianceRequestID))
+}
+
+// ComplianceRequestIDEqualFold applies the EqualFold predicate on the "compliance_request_id" field.
+func ComplianceRequestIDEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldComplianceRequestID, v))
+}
+
+// ComplianceRequestIDContainsFold applies the ContainsFold predicate on the "compliance_request_id" field.
+func ComplianceRequestIDContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldComplianceRequestID, v))
+}
+
+// RegistrationNumberEQ applies the EQ predicate on the "registration_number" field.
+func RegistrationNumberEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberNEQ applies the NEQ predicate on the "registration_number" field.
+func RegistrationNumberNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberIn applies the In predicate on the "registration_number" field.
+func RegistrationNumberIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldRegistrationNumber, vs...))
+}
+
+// RegistrationNumberNotIn applies the NotIn predicate on the "registration_number" field.
+func RegistrationNumberNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldRegistrationNumber, vs...))
+}
+
+// RegistrationNumberGT applies the GT predicate on the "registration_number" field.
+func RegistrationNumberGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberGTE applies the GTE predicate on the "registration_number" field.
+func RegistrationNumberGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberLT applies the LT predicate on the "registration_number" field.
+func RegistrationNumberLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberLTE applies the LTE predicate on the "registration_number" field.
+func RegistrationNumberLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberContains applies the Contains predicate on the "registration_number" field.
+func RegistrationNumberContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberHasPrefix applies the HasPrefix predicate on the "registration_number" field.
+func RegistrationNumberHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberHasSuffix applies the HasSuffix predicate on the "registration_number" field.
+func RegistrationNumberHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberIsNil applies the IsNil predicate on the "registration_number" field.
+func RegistrationNumberIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldRegistrationNumber))
+}
+
+// RegistrationNumberNotNil applies the NotNil predicate on the "registration_number" field.
+func RegistrationNumberNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldRegistrationNumber))
+}
+
+// RegistrationNumberEqualFold applies the EqualFold predicate on the "registration_number" field.
+func RegistrationNumberEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldRegistrationNumber, v))
+}
+
+// RegistrationNumberContainsFold applies the ContainsFold predicate on the "registration_number" field.
+func RegistrationNumberContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldRegistrationNumber, v))
+}
+
+// StreetEQ applies the EQ predicate on the "street" field.
+func StreetEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldStreet, v))
+}
+
+// StreetNEQ applies the NEQ predicate on the "street" field.
+func StreetNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldStreet, v))
+}
+
+// StreetIn applies the In predicate on the "street" field.
+func StreetIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldStreet, vs...))
+}
+
+// StreetNotIn applies the NotIn predicate on the "street" field.
+func StreetNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldStreet, vs...))
+}
+
+// StreetGT applies the GT predicate on the "street" field.
+func StreetGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldStreet, v))
+}
+
+// StreetGTE applies the GTE predicate on the "street" field.
+func StreetGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldStreet, v))
+}
+
+// StreetLT applies the LT predicate on the "street" field.
+func StreetLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldStreet, v))
+}
+
+// StreetLTE applies the LTE predicate on the "street" field.
+func StreetLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldStreet, v))
+}
+
+// StreetContains applies the Contains predicate on the "street" field.
+func StreetContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldStreet, v))
+}
+
+// StreetHasPrefix applies the HasPrefix predicate on the "street" field.
+func StreetHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldStreet, v))
+}
+
+// StreetHasSuffix applies the HasSuffix predicate on the "street" field.
+func StreetHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldStreet, v))
+}
+
+// StreetIsNil applies the IsNil predicate on the "street" field.
+func StreetIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldStreet))
+}
+
+// StreetNotNil applies the NotNil predicate on the "street" field.
+func StreetNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldStreet))
+}
+
+// StreetEqualFold applies the EqualFold predicate on the "street" field.
+func StreetEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldStreet, v))
+}
+
+// StreetContainsFold applies the ContainsFold predicate on the "street" field.
+func StreetContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldStreet, v))
+}
+
+// BuildingNumberEQ applies the EQ predicate on the "building_number" field.
+func BuildingNumberEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldBuildingNumber, v))
+}
+
+// BuildingNumberNEQ applies the NEQ predicate on the "building_number" field.
+func BuildingNumberNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldBuildingNumber, v))
+}
+
+// BuildingNumberIn applies the In predicate on the "building_number" field.
+func BuildingNumberIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldBuildingNumber, vs...))
+}
+
+// BuildingNumberNotIn applies the NotIn predicate on the "building_number" field.
+func BuildingNumberNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldBuildingNumber, vs...))
+}
+
+// BuildingNumberGT applies the GT predicate on the "building_number" field.
+func BuildingNumberGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldBuildingNumber, v))
+}
+
+// BuildingNumberGTE applies the GTE predicate on the "building_number" field.
+func BuildingNumberGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldBuildingNumber, v))
+}
+
+// BuildingNumberLT applies the LT predicate on the "building_number" field.
+func BuildingNumberLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldBuildingNumber, v))
+}
+
+// BuildingNumberLTE applies the LTE predicate on the "building_number" field.
+func BuildingNumberLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldBuildingNumber, v))
+}
+
+// BuildingNumberContains applies the Contains predicate on the "building_number" field.
+func BuildingNumberContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldBuildingNumber, v))
+}
+
+// BuildingNumberHasPrefix applies the HasPrefix predicate on the "building_number" field.
+func BuildingNumberHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldBuildingNumber, v))
+}
+
+// BuildingNumberHasSuffix applies the HasSuffix predicate on the "building_number" field.
+func BuildingNumberHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldBuildingNumber, v))
+}
+
+// BuildingNumberIsNil applies the IsNil predicate on the "building_number" field.
+func BuildingNumberIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldBuildingNumber))
+}
+
+// BuildingNumberNotNil applies the NotNil predicate on the "building_number" field.
+func BuildingNumberNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldBuildingNumber))
+}
+
+// BuildingNumberEqualFold applies the EqualFold predicate on the "building_number" field.
+func BuildingNumberEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldBuildingNumber, v))
+}
+
+// BuildingNumberContainsFold applies the ContainsFold predicate on the "building_number" field.
+func BuildingNumberContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldBuildingNumber, v))
+}
+
+// DistrictEQ applies the EQ predicate on the "district" field.
+func DistrictEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldDistrict, v))
+}
+
+// DistrictNEQ applies the NEQ predicate on the "district" field.
+func DistrictNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldDistrict, v))
+}
+
+// DistrictIn applies the In predicate on the "district" field.
+func DistrictIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldDistrict, vs...))
+}
+
+// DistrictNotIn applies the NotIn predicate on the "district" field.
+func DistrictNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldDistrict, vs...))
+}
+
+// DistrictGT applies the GT predicate on the "district" field.
+func DistrictGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldDistrict, v))
+}
+
+// DistrictGTE applies the GTE predicate on the "district" field.
+func DistrictGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldDistrict, v))
+}
+
+// DistrictLT applies the LT predicate on the "district" field.
+func DistrictLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldDistrict, v))
+}
+
+// DistrictLTE applies the LTE predicate on the "district" field.
+func DistrictLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldDistrict, v))
+}
+
+// DistrictContains applies the Contains predicate on the "district" field.
+func DistrictContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldDistrict, v))
+}
+
+// DistrictHasPrefix applies the HasPrefix predicate on the "district" field.
+func DistrictHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldDistrict, v))
+}
+
+// DistrictHasSuffix applies the HasSuffix predicate on the "district" field.
+func DistrictHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldDistrict, v))
+}
+
+// DistrictIsNil applies the IsNil predicate on the "district" field.
+func DistrictIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldDistrict))
+}
+
+// DistrictNotNil applies the NotNil predicate on the "district" field.
+func DistrictNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldDistrict))
+}
+
+// DistrictEqualFold applies the EqualFold predicate on the "district" field.
+func DistrictEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldDistrict, v))
+}
+
+// DistrictContainsFold applies the ContainsFold predicate on the "district" field.
+func DistrictContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldDistrict, v))
+}
+
+// CityEQ applies the EQ predicate on the "city" field.
+func CityEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldCity, v))
+}
+
+// CityNEQ applies the NEQ predicate on the "city" field.
+func CityNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldCity, v))
+}
+
+// CityIn applies the In predicate on the "city" field.
+func CityIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldCity, vs...))
+}
+
+// CityNotIn applies the NotIn predicate on the "city" field.
+func CityNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldCity, vs...))
+}
+
+// CityGT applies the GT predicate on the "city" field.
+func CityGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldCity, v))
+}
+
+// CityGTE applies the GTE predicate on the "city" field.
+func CityGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldCity, v))
+}
+
+// CityLT applies the LT predicate on the "city" field.
+func CityLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldCity, v))
+}
+
+// CityLTE applies the LTE predicate on the "city" field.
+func CityLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldCity, v))
+}
+
+// CityContains applies the Contains predicate on the "city" field.
+func CityContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldCity, v))
+}
+
+// CityHasPrefix applies the HasPrefix predicate on the "city" field.
+func CityHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldCity, v))
+}
+
+// CityHasSuffix applies the HasSuffix predicate on the "city" field.
+func CityHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldCity, v))
+}
+
+// CityIsNil applies the IsNil predicate on the "city" field.
+func CityIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldCity))
+}
+
+// CityNotNil applies the NotNil predicate on the "city" field.
+func CityNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldCity))
+}
+
+// CityEqualFold applies the EqualFold predicate on the "city" field.
+func CityEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldCity, v))
+}
+
+// CityContainsFold applies the ContainsFold predicate on the "city" field.
+func CityContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldCity, v))
+}
+
+// PostalCodeEQ applies the EQ predicate on the "postal_code" field.
+func PostalCodeEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldPostalCode, v))
+}
+
+// PostalCodeNEQ applies the NEQ predicate on the "postal_code" field.
+func PostalCodeNEQ(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldPostalCode, v))
+}
+
+// PostalCodeIn applies the In predicate on the "postal_code" field.
+func PostalCodeIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldPostalCode, vs...))
+}
+
+// PostalCodeNotIn applies the NotIn predicate on the "postal_code" field.
+func PostalCodeNotIn(vs ...string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldPostalCode, vs...))
+}
+
+// PostalCodeGT applies the GT predicate on the "postal_code" field.
+func PostalCodeGT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldPostalCode, v))
+}
+
+// PostalCodeGTE applies the GTE predicate on the "postal_code" field.
+func PostalCodeGTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldPostalCode, v))
+}
+
+// PostalCodeLT applies the LT predicate on the "postal_code" field.
+func PostalCodeLT(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldPostalCode, v))
+}
+
+// PostalCodeLTE applies the LTE predicate on the "postal_code" field.
+func PostalCodeLTE(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldPostalCode, v))
+}
+
+// PostalCodeContains applies the Contains predicate on the "postal_code" field.
+func PostalCodeContains(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContains(FieldPostalCode, v))
+}
+
+// PostalCodeHasPrefix applies the HasPrefix predicate on the "postal_code" field.
+func PostalCodeHasPrefix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasPrefix(FieldPostalCode, v))
+}
+
+// PostalCodeHasSuffix applies the HasSuffix predicate on the "postal_code" field.
+func PostalCodeHasSuffix(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldHasSuffix(FieldPostalCode, v))
+}
+
+// PostalCodeIsNil applies the IsNil predicate on the "postal_code" field.
+func PostalCodeIsNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIsNull(FieldPostalCode))
+}
+
+// PostalCodeNotNil applies the NotNil predicate on the "postal_code" field.
+func PostalCodeNotNil() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotNull(FieldPostalCode))
+}
+
+// PostalCodeEqualFold applies the EqualFold predicate on the "postal_code" field.
+func PostalCodeEqualFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEqualFold(FieldPostalCode, v))
+}
+
+// PostalCodeContainsFold applies the ContainsFold predicate on the "postal_code" field.
+func PostalCodeContainsFold(v string) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldContainsFold(FieldPostalCode, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEinvoices applies the HasEdge predicate on the "einvoices" edge.
+func HasEinvoices() predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EinvoicesTable, EinvoicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEinvoicesWith applies the HasEdge predicate on the "einvoices" edge with a given conditions (other predicates).
+func HasEinvoicesWith(preds ...predicate.EInvoice) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(func(s *sql.Selector) {
+		step := newEinvoicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EInvoiceCredential) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EInvoiceCredential) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EInvoiceCredential) predicate.EInvoiceCredential {
+	return predicate.EInvoiceCredential(sql.NotPredicates(p))
+}
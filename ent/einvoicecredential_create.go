@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EInvoiceCredentialCreate is the builder for creating a EInvoiceCredential entity.
+type EInvoiceCredentialCreate struct {
+	config
+	mutation *EInvoiceCredentialMutation
+	hooks    []Hook
+}
+
+// SetAuthority sets the "authority" field.
+func (_c *EInvoiceCredentialCreate) SetAuthority(v einvoicecredential.Authority) *EInvoiceCredentialCreate {
+	_c.mutation.SetAuthority(v)
+	return _c
+}
+
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableAuthority(v *einvoicecredential.Authority) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetAuthority(*v)
+	}
+	return _c
+}
+
+// SetEnvironment sets the "environment" field.
+func (_c *EInvoiceCredentialCreate) SetEnvironment(v einvoicecredential.Environment) *EInvoiceCredentialCreate {
+	_c.mutation.SetEnvironment(v)
+	return _c
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableEnvironment(v *einvoicecredential.Environment) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetEnvironment(*v)
+	}
+	return _c
+}
+
+// SetCertificate sets the "certificate" field.
+func (_c *EInvoiceCredentialCreate) SetCertificate(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetCertificate(v)
+	return _c
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableCertificate(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetCertificate(*v)
+	}
+	return _c
+}
+
+// SetPrivateKeyEncrypted sets the "private_key_encrypted" field.
+func (_c *EInvoiceCredentialCreate) SetPrivateKeyEncrypted(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetPrivateKeyEncrypted(v)
+	return _c
+}
+
+// SetNillablePrivateKeyEncrypted sets the "private_key_encrypted" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillablePrivateKeyEncrypted(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetPrivateKeyEncrypted(*v)
+	}
+	return _c
+}
+
+// SetSecretEncrypted sets the "secret_encrypted" field.
+func (_c *EInvoiceCredentialCreate) SetSecretEncrypted(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetSecretEncrypted(v)
+	return _c
+}
+
+// SetNillableSecretEncrypted sets the "secret_encrypted" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableSecretEncrypted(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetSecretEncrypted(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *EInvoiceCredentialCreate) SetClientID(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableClientID(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetClientID(*v)
+	}
+	return _c
+}
+
+// SetComplianceRequestID sets the "compliance_request_id" field.
+func (_c *EInvoiceCredentialCreate) SetComplianceRequestID(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetComplianceRequestID(v)
+	return _c
+}
+
+// SetNillableComplianceRequestID sets the "compliance_request_id" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableComplianceRequestID(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetComplianceRequestID(*v)
+	}
+	return _c
+}
+
+// SetRegistrationNumber sets the "registration_number" field.
+func (_c *EInvoiceCredentialCreate) SetRegistrationNumber(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetRegistrationNumber(v)
+	return _c
+}
+
+// SetNillableRegistrationNumber sets the "registration_number" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableRegistrationNumber(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetRegistrationNumber(*v)
+	}
+	return _c
+}
+
+// SetStreet sets the "street" field.
+func (_c *EInvoiceCredentialCreate) SetStreet(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetStreet(v)
+	return _c
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableStreet(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetStreet(*v)
+	}
+	return _c
+}
+
+// SetBuildingNumber sets the "building_number" field.
+func (_c *EInvoiceCredentialCreate) SetBuildingNumber(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetBuildingNumber(v)
+	return _c
+}
+
+// SetNillableBuildingNumber sets the "building_number" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableBuildingNumber(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetBuildingNumber(*v)
+	}
+	return _c
+}
+
+// SetDistrict sets the "district" field.
+func (_c *EInvoiceCredentialCreate) SetDistrict(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetDistrict(v)
+	return _c
+}
+
+// SetNillableDistrict sets the "district" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableDistrict(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetDistrict(*v)
+	}
+	return _c
+}
+
+// SetCity sets the "city" field.
+func (_c *EInvoiceCredentialCreate) SetCity(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetCity(v)
+	return _c
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableCity(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetCity(*v)
+	}
+	return _c
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_c *EInvoiceCredentialCreate) SetPostalCode(v string) *EInvoiceCredentialCreate {
+	_c.mutation.SetPostalCode(v)
+	return _c
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillablePostalCode(v *string) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetPostalCode(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *EInvoiceCredentialCreate) SetIsActive(v bool) *EInvoiceCredentialCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableIsActive(v *bool) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EInvoiceCredentialCreate) SetCreatedAt(v time.Time) *EInvoiceCredentialCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EInvoiceCredentialCreate) SetNillableCreatedAt(v *time.Time) *EInvoiceCredentialCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *EInvoiceCredentialCreate) SetTenantID(id int) *EInvoiceCredentialCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *EInvoiceCredentialCreate) SetTenant(v *Tenant) *EInvoiceCredentialCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// AddEinvoiceIDs adds the "einvoices" edge to the EInvoice entity by IDs.
+func (_c *EInvoiceCredentialCreate) AddEinvoiceIDs(ids ...int) *EInvoiceCredentialCreate {
+	_c.mutation.AddEinvoiceIDs(ids...)
+	return _c
+}
+
+// AddEinvoices adds the "einvoices" edges to the EInvoice entity.
+func (_c *EInvoiceCredentialCreate) AddEinvoices(v ...*EInvoice) *EInvoiceCredentialCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEinvoiceIDs(ids...)
+}
+
+// Mutation returns the EInvoiceCredentialMutation object of the builder.
+func (_c *EInvoiceCredentialCreate) Mutation() *EInvoiceCredentialMutation {
+	return _c.mutation
+}
+
+// Save creates the EInvoiceCredential in the database.
+func (_c *EInvoiceCredentialCreate) Save(ctx context.Context) (*EInvoiceCredential, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EInvoiceCredentialCreate) SaveX(ctx context.Context) *EInvoiceCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EInvoiceCredentialCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EInvoiceCredentialCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EInvoiceCredentialCreate) defaults() {
+	if _, ok := _c.mutation.Authority(); !ok {
+		v := einvoicecredential.DefaultAuthority
+		_c.mutation.SetAuthority(v)
+	}
+	if _, ok := _c.mutation.Environment(); !ok {
+		v := einvoicecredential.DefaultEnvironment
+		_c.mutation.SetEnvironment(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := einvoicecredential.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := einvoicecredential.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EInvoiceCredentialCreate) check() error {
+	if _, ok := _c.mutation.Authority(); !ok {
+		return &ValidationError{Name: "authority", err: errors.New(`ent: missing required field "EInvoiceCredential.authority"`)}
+	}
+	if v, ok := _c.mutation.Authority(); ok {
+		if err := einvoicecredential.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`ent: validator failed for field "EInvoiceCredential.authority": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Environment(); !ok {
+		return &ValidationError{Name: "environment", err: errors.New(`ent: missing required field "EInvoiceCredential.environment"`)}
+	}
+	if v, ok := _c.mutation.Environment(); ok {
+		if err := einvoicecredential.EnvironmentValidator(v); err != nil {
+			return &ValidationError{Name: "environment", err: fmt.Errorf(`ent: validator failed for field "EInvoiceCredential.environment": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "EInvoiceCredential.is_active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EInvoiceCredential.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "EInvoiceCredential.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *EInvoiceCredentialCreate) sqlSave(ctx context.Context) (*EInvoiceCredential, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EInvoiceCredentialCreate) createSpec() (*EInvoiceCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EInvoiceCredential{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(einvoicecredential.Table, sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Authority(); ok {
+		_spec.SetField(einvoicecredential.FieldAuthority, field.TypeEnum, value)
+		_node.Authority = value
+	}
+	if value, ok := _c.mutation.Environment(); ok {
+		_spec.SetField(einvoicecredential.FieldEnvironment, field.TypeEnum, value)
+		_node.Environment = value
+	}
+	if value, ok := _c.mutation.Certificate(); ok {
+		_spec.SetField(einvoicecredential.FieldCertificate, field.TypeString, value)
+		_node.Certificate = value
+	}
+	if value, ok := _c.mutation.PrivateKeyEncrypted(); ok {
+		_spec.SetField(einvoicecredential.FieldPrivateKeyEncrypted, field.TypeString, value)
+		_node.PrivateKeyEncrypted = value
+	}
+	if value, ok := _c.mutation.SecretEncrypted(); ok {
+		_spec.SetField(einvoicecredential.FieldSecretEncrypted, field.TypeString, value)
+		_node.SecretEncrypted = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(einvoicecredential.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.ComplianceRequestID(); ok {
+		_spec.SetField(einvoicecredential.FieldComplianceRequestID, field.TypeString, value)
+		_node.ComplianceRequestID = value
+	}
+	if value, ok := _c.mutation.RegistrationNumber(); ok {
+		_spec.SetField(einvoicecredential.FieldRegistrationNumber, field.TypeString, value)
+		_node.RegistrationNumber = value
+	}
+	if value, ok := _c.mutation.Street(); ok {
+		_spec.SetField(einvoicecredential.FieldStreet, field.TypeString, value)
+		_node.Street = value
+	}
+	if value, ok := _c.mutation.BuildingNumber(); ok {
+		_spec.SetField(einvoicecredential.FieldBuildingNumber, field.TypeString, value)
+		_node.BuildingNumber = value
+	}
+	if value, ok := _c.mutation.District(); ok {
+		_spec.SetField(einvoicecredential.FieldDistrict, field.TypeString, value)
+		_node.District = value
+	}
+	if value, ok := _c.mutation.City(); ok {
+		_spec.SetField(einvoicecredential.FieldCity, field.TypeString, value)
+		_node.City = value
+	}
+	if value, ok := _c.mutation.PostalCode(); ok {
+		_spec.SetField(einvoicecredential.FieldPostalCode, field.TypeString, value)
+		_node.PostalCode = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(einvoicecredential.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(einvoicecredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoicecredential.TenantTable,
+			Columns: []string{einvoicecredential.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_einvoice_credentials = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EinvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   einvoicecredential.EinvoicesTable,
+			Columns: []string{einvoicecredential.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EInvoiceCredentialCreateBulk is the builder for creating many EInvoiceCredential entities in bulk.
+type EInvoiceCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*EInvoiceCredentialCreate
+}
+
+// Save creates the EInvoiceCredential entities in the database.
+func (_c *EInvoiceCredentialCreateBulk) Save(ctx context.Context) ([]*EInvoiceCredential, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EInvoiceCredential, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EInvoiceCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EInvoiceCredentialCreateBulk) SaveX(ctx context.Context) []*EInvoiceCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EInvoiceCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EInvoiceCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EInvoiceCredentialUpdate is the builder for updating EInvoiceCredential entities.
+type EInvoiceCredentialUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EInvoiceCredentialMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EInvoiceCredentialUpdate builder.
+func (_u *EInvoiceCredentialUpdate) Where(ps ...predicate.EInvoiceCredential) *EInvoiceCredentialUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAuthority sets the "authority" field.
+func (_u *EInvoiceCredentialUpdate) SetAuthority(v einvoicecredential.Authority) *EInvoiceCredentialUpdate {
+	_u.mutation.SetAuthority(v)
+	return _u
+}
+
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableAuthority(v *einvoicecredential.Authority) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetAuthority(*v)
+	}
+	return _u
+}
+
+// SetEnvironment sets the "environment" field.
+func (_u *EInvoiceCredentialUpdate) SetEnvironment(v einvoicecredential.Environment) *EInvoiceCredentialUpdate {
+	_u.mutation.SetEnvironment(v)
+	return _u
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableEnvironment(v *einvoicecredential.Environment) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetEnvironment(*v)
+	}
+	return _u
+}
+
+// SetCertificate sets the "certificate" field.
+func (_u *EInvoiceCredentialUpdate) SetCertificate(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetCertificate(v)
+	return _u
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableCertificate(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetCertificate(*v)
+	}
+	return _u
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (_u *EInvoiceCredentialUpdate) ClearCertificate() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// SetPrivateKeyEncrypted sets the "private_key_encrypted" field.
+func (_u *EInvoiceCredentialUpdate) SetPrivateKeyEncrypted(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetPrivateKeyEncrypted(v)
+	return _u
+}
+
+// SetNillablePrivateKeyEncrypted sets the "private_key_encrypted" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillablePrivateKeyEncrypted(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetPrivateKeyEncrypted(*v)
+	}
+	return _u
+}
+
+// ClearPrivateKeyEncrypted clears the value of the "private_key_encrypted" field.
+func (_u *EInvoiceCredentialUpdate) ClearPrivateKeyEncrypted() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearPrivateKeyEncrypted()
+	return _u
+}
+
+// SetSecretEncrypted sets the "secret_encrypted" field.
+func (_u *EInvoiceCredentialUpdate) SetSecretEncrypted(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetSecretEncrypted(v)
+	return _u
+}
+
+// SetNillableSecretEncrypted sets the "secret_encrypted" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableSecretEncrypted(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetSecretEncrypted(*v)
+	}
+	return _u
+}
+
+// ClearSecretEncrypted clears the value of the "secret_encrypted" field.
+func (_u *EInvoiceCredentialUpdate) ClearSecretEncrypted() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearSecretEncrypted()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *EInvoiceCredentialUpdate) SetClientID(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableClientID(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (_u *EInvoiceCredentialUpdate) ClearClientID() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearClientID()
+	return _u
+}
+
+// SetComplianceRequestID sets the "compliance_request_id" field.
+func (_u *EInvoiceCredentialUpdate) SetComplianceRequestID(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetComplianceRequestID(v)
+	return _u
+}
+
+// SetNillableComplianceRequestID sets the "compliance_request_id" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableComplianceRequestID(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetComplianceRequestID(*v)
+	}
+	return _u
+}
+
+// ClearComplianceRequestID clears the value of the "compliance_request_id" field.
+func (_u *EInvoiceCredentialUpdate) ClearComplianceRequestID() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearComplianceRequestID()
+	return _u
+}
+
+// SetRegistrationNumber sets the "registration_number" field.
+func (_u *EInvoiceCredentialUpdate) SetRegistrationNumber(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetRegistrationNumber(v)
+	return _u
+}
+
+// SetNillableRegistrationNumber sets the "registration_number" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableRegistrationNumber(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetRegistrationNumber(*v)
+	}
+	return _u
+}
+
+// ClearRegistrationNumber clears the value of the "registration_number" field.
+func (_u *EInvoiceCredentialUpdate) ClearRegistrationNumber() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearRegistrationNumber()
+	return _u
+}
+
+// SetStreet sets the "street" field.
+func (_u *EInvoiceCredentialUpdate) SetStreet(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetStreet(v)
+	return _u
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableStreet(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetStreet(*v)
+	}
+	return _u
+}
+
+// ClearStreet clears the value of the "street" field.
+func (_u *EInvoiceCredentialUpdate) ClearStreet() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearStreet()
+	return _u
+}
+
+// SetBuildingNumber sets the "building_number" field.
+func (_u *EInvoiceCredentialUpdate) SetBuildingNumber(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetBuildingNumber(v)
+	return _u
+}
+
+// SetNillableBuildingNumber sets the "building_number" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableBuildingNumber(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetBuildingNumber(*v)
+	}
+	return _u
+}
+
+// ClearBuildingNumber clears the value of the "building_number" field.
+func (_u *EInvoiceCredentialUpdate) ClearBuildingNumber() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearBuildingNumber()
+	return _u
+}
+
+// SetDistrict sets the "district" field.
+func (_u *EInvoiceCredentialUpdate) SetDistrict(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetDistrict(v)
+	return _u
+}
+
+// SetNillableDistrict sets the "district" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableDistrict(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetDistrict(*v)
+	}
+	return _u
+}
+
+// ClearDistrict clears the value of the "district" field.
+func (_u *EInvoiceCredentialUpdate) ClearDistrict() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearDistrict()
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *EInvoiceCredentialUpdate) SetCity(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableCity(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// ClearCity clears the value of the "city" field.
+func (_u *EInvoiceCredentialUpdate) ClearCity() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearCity()
+	return _u
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_u *EInvoiceCredentialUpdate) SetPostalCode(v string) *EInvoiceCredentialUpdate {
+	_u.mutation.SetPostalCode(v)
+	return _u
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillablePostalCode(v *string) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetPostalCode(*v)
+	}
+	return _u
+}
+
+// ClearPostalCode clears the value of the "postal_code" field.
+func (_u *EInvoiceCredentialUpdate) ClearPostalCode() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearPostalCode()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *EInvoiceCredentialUpdate) SetIsActive(v bool) *EInvoiceCredentialUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdate) SetNillableIsActive(v *bool) *EInvoiceCredentialUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *EInvoiceCredentialUpdate) SetTenantID(id int) *EInvoiceCredentialUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *EInvoiceCredentialUpdate) SetTenant(v *Tenant) *EInvoiceCredentialUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddEinvoiceIDs adds the "einvoices" edge to the EInvoice entity by IDs.
+func (_u *EInvoiceCredentialUpdate) AddEinvoiceIDs(ids ...int) *EInvoiceCredentialUpdate {
+	_u.mutation.AddEinvoiceIDs(ids...)
+	return _u
+}
+
+// AddEinvoices adds the "einvoices" edges to the EInvoice entity.
+func (_u *EInvoiceCredentialUpdate) AddEinvoices(v ...*EInvoice) *EInvoiceCredentialUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEinvoiceIDs(ids...)
+}
+
+// Mutation returns the EInvoiceCredentialMutation object of the builder.
+func (_u *EInvoiceCredentialUpdate) Mutation() *EInvoiceCredentialMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *EInvoiceCredentialUpdate) ClearTenant() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearEinvoices clears all "einvoices" edges to the EInvoice entity.
+func (_u *EInvoiceCredentialUpdate) ClearEinvoices() *EInvoiceCredentialUpdate {
+	_u.mutation.ClearEinvoices()
+	return _u
+}
+
+// RemoveEinvoiceIDs removes the "einvoices" edge to EInvoice entities by IDs.
+func (_u *EInvoiceCredentialUpdate) RemoveEinvoiceIDs(ids ...int) *EInvoiceCredentialUpdate {
+	_u.mutation.RemoveEinvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveEinvoices removes "einvoices" edges to EInvoice entities.
+func (_u *EInvoiceCredentialUpdate) RemoveEinvoices(v ...*EInvoice) *EInvoiceCredentialUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEinvoiceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EInvoiceCredentialUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EInvoiceCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EInvoiceCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EInvoiceCredentialUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EInvoiceCredentialUpdate) check() error {
+	if v, ok := _u.mutation.Authority(); ok {
+		if err := einvoicecredential.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`ent: validator failed for field "EInvoiceCredential.authority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Environment(); ok {
+		if err := einvoicecredential.EnvironmentValidator(v); err != nil {
+			return &ValidationError{Name: "environment", err: fmt.Errorf(`ent: validator failed for field "EInvoiceCredential.environment": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EInvoiceCredential.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EInvoiceCredentialUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EInvoiceCredentialUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EInvoiceCredentialUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(einvoicecredential.Table, einvoicecredential.Columns, sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Authority(); ok {
+		_spec.SetField(einvoicecredential.FieldAuthority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Environment(); ok {
+		_spec.SetField(einvoicecredential.FieldEnvironment, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Certificate(); ok {
+		_spec.SetField(einvoicecredential.FieldCertificate, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCleared() {
+		_spec.ClearField(einvoicecredential.FieldCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateKeyEncrypted(); ok {
+		_spec.SetField(einvoicecredential.FieldPrivateKeyEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.PrivateKeyEncryptedCleared() {
+		_spec.ClearField(einvoicecredential.FieldPrivateKeyEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecretEncrypted(); ok {
+		_spec.SetField(einvoicecredential.FieldSecretEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.SecretEncryptedCleared() {
+		_spec.ClearField(einvoicecredential.FieldSecretEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(einvoicecredential.FieldClientID, field.TypeString, value)
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(einvoicecredential.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ComplianceRequestID(); ok {
+		_spec.SetField(einvoicecredential.FieldComplianceRequestID, field.TypeString, value)
+	}
+	if _u.mutation.ComplianceRequestIDCleared() {
+		_spec.ClearField(einvoicecredential.FieldComplianceRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RegistrationNumber(); ok {
+		_spec.SetField(einvoicecredential.FieldRegistrationNumber, field.TypeString, value)
+	}
+	if _u.mutation.RegistrationNumberCleared() {
+		_spec.ClearField(einvoicecredential.FieldRegistrationNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.Street(); ok {
+		_spec.SetField(einvoicecredential.FieldStreet, field.TypeString, value)
+	}
+	if _u.mutation.StreetCleared() {
+		_spec.ClearField(einvoicecredential.FieldStreet, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildingNumber(); ok {
+		_spec.SetField(einvoicecredential.FieldBuildingNumber, field.TypeString, value)
+	}
+	if _u.mutation.BuildingNumberCleared() {
+		_spec.ClearField(einvoicecredential.FieldBuildingNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.District(); ok {
+		_spec.SetField(einvoicecredential.FieldDistrict, field.TypeString, value)
+	}
+	if _u.mutation.DistrictCleared() {
+		_spec.ClearField(einvoicecredential.FieldDistrict, field.TypeString)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(einvoicecredential.FieldCity, field.TypeString, value)
+	}
+	if _u.mutation.CityCleared() {
+		_spec.ClearField(einvoicecredential.FieldCity, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostalCode(); ok {
+		_spec.SetField(einvoicecredential.FieldPostalCode, field.TypeString, value)
+	}
+	if _u.mutation.PostalCodeCleared() {
+		_spec.ClearField(einvoicecredential.FieldPostalCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(einvoicecredential.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoicecredential.TenantTable,
+			Columns: []string{einvoicecredential.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoicecredential.TenantTable,
+			Columns: []string{einvoicecredential.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EinvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   einvoicecredential.EinvoicesTable,
+			Columns: []string{einvoicecredential.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEinvoicesIDs(); len(nodes) > 0 && !_u.mutation.EinvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   einvoicecredential.EinvoicesTable,
+			Columns: []string{einvoicecredential.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EinvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   einvoicecredential.EinvoicesTable,
+			Columns: []string{einvoicecredential.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{einvoicecredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EInvoiceCredentialUpdateOne is the builder for updating a single EInvoiceCredential entity.
+type EInvoiceCredentialUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EInvoiceCredentialMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAuthority sets the "authority" field.
+func (_u *EInvoiceCredentialUpdateOne) SetAuthority(v einvoicecredential.Authority) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetAuthority(v)
+	return _u
+}
+
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableAuthority(v *einvoicecredential.Authority) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetAuthority(*v)
+	}
+	return _u
+}
+
+// SetEnvironment sets the "environment" field.
+func (_u *EInvoiceCredentialUpdateOne) SetEnvironment(v einvoicecredential.Environment) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetEnvironment(v)
+	return _u
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableEnvironment(v *einvoicecredential.Environment) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetEnvironment(*v)
+	}
+	return _u
+}
+
+// SetCertificate sets the "certificate" field.
+func (_u *EInvoiceCredentialUpdateOne) SetCertificate(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetCertificate(v)
+	return _u
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableCertificate(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetCertificate(*v)
+	}
+	return _u
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearCertificate() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// SetPrivateKeyEncrypted sets the "private_key_encrypted" field.
+func (_u *EInvoiceCredentialUpdateOne) SetPrivateKeyEncrypted(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetPrivateKeyEncrypted(v)
+	return _u
+}
+
+// SetNillablePrivateKeyEncrypted sets the "private_key_encrypted" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillablePrivateKeyEncrypted(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetPrivateKeyEncrypted(*v)
+	}
+	return _u
+}
+
+// ClearPrivateKeyEncrypted clears the value of the "private_key_encrypted" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearPrivateKeyEncrypted() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearPrivateKeyEncrypted()
+	return _u
+}
+
+// SetSecretEncrypted sets the "secret_encrypted" field.
+func (_u *EInvoiceCredentialUpdateOne) SetSecretEncrypted(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetSecretEncrypted(v)
+	return _u
+}
+
+// SetNillableSecretEncrypted sets the "secret_encrypted" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableSecretEncrypted(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetSecretEncrypted(*v)
+	}
+	return _u
+}
+
+// ClearSecretEncrypted clears the value of the "secret_encrypted" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearSecretEncrypted() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearSecretEncrypted()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *EInvoiceCredentialUpdateOne) SetClientID(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableClientID(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearClientID() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearClientID()
+	return _u
+}
+
+// SetComplianceRequestID sets the "compliance_request_id" field.
+func (_u *EInvoiceCredentialUpdateOne) SetComplianceRequestID(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetComplianceRequestID(v)
+	return _u
+}
+
+// SetNillableComplianceRequestID sets the "compliance_request_id" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableComplianceRequestID(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetComplianceRequestID(*v)
+	}
+	return _u
+}
+
+// ClearComplianceRequestID clears the value of the "compliance_request_id" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearComplianceRequestID() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearComplianceRequestID()
+	return _u
+}
+
+// SetRegistrationNumber sets the "registration_number" field.
+func (_u *EInvoiceCredentialUpdateOne) SetRegistrationNumber(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetRegistrationNumber(v)
+	return _u
+}
+
+// SetNillableRegistrationNumber sets the "registration_number" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableRegistrationNumber(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetRegistrationNumber(*v)
+	}
+	return _u
+}
+
+// ClearRegistrationNumber clears the value of the "registration_number" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearRegistrationNumber() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearRegistrationNumber()
+	return _u
+}
+
+// SetStreet sets the "street" field.
+func (_u *EInvoiceCredentialUpdateOne) SetStreet(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetStreet(v)
+	return _u
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableStreet(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetStreet(*v)
+	}
+	return _u
+}
+
+// ClearStreet clears the value of the "street" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearStreet() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearStreet()
+	return _u
+}
+
+// SetBuildingNumber sets the "building_number" field.
+func (_u *EInvoiceCredentialUpdateOne) SetBuildingNumber(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetBuildingNumber(v)
+	return _u
+}
+
+// SetNillableBuildingNumber sets the "building_number" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableBuildingNumber(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetBuildingNumber(*v)
+	}
+	return _u
+}
+
+// ClearBuildingNumber clears the value of the "building_number" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearBuildingNumber() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearBuildingNumber()
+	return _u
+}
+
+// SetDistrict sets the "district" field.
+func (_u *EInvoiceCredentialUpdateOne) SetDistrict(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetDistrict(v)
+	return _u
+}
+
+// SetNillableDistrict sets the "district" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableDistrict(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetDistrict(*v)
+	}
+	return _u
+}
+
+// ClearDistrict clears the value of the "district" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearDistrict() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearDistrict()
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *EInvoiceCredentialUpdateOne) SetCity(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableCity(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// ClearCity clears the value of the "city" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearCity() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearCity()
+	return _u
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_u *EInvoiceCredentialUpdateOne) SetPostalCode(v string) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetPostalCode(v)
+	return _u
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillablePostalCode(v *string) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetPostalCode(*v)
+	}
+	return _u
+}
+
+// ClearPostalCode clears the value of the "postal_code" field.
+func (_u *EInvoiceCredentialUpdateOne) ClearPostalCode() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearPostalCode()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *EInvoiceCredentialUpdateOne) SetIsActive(v bool) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *EInvoiceCredentialUpdateOne) SetNillableIsActive(v *bool) *EInvoiceCredentialUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *EInvoiceCredentialUpdateOne) SetTenantID(id int) *EInvoiceCredentialUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *EInvoiceCredentialUpdateOne) SetTenant(v *Tenant) *EInvoiceCredentialUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddEinvoiceIDs adds the "einvoices" edge to the EInvoice entity by IDs.
+func (_u *EInvoiceCredentialUpdateOne) AddEinvoiceIDs(ids ...int) *EInvoiceCredentialUpdateOne {
+	_u.mutation.AddEinvoiceIDs(ids...)
+	return _u
+}
+
+// AddEinvoices adds the "einvoices" edges to the EInvoice entity.
+func (_u *EInvoiceCredentialUpdateOne) AddEinvoices(v ...*EInvoice) *EInvoiceCredentialUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEinvoiceIDs(ids...)
+}
+
+// Mutation returns the EInvoiceCredentialMutation object of the builder.
+func (_u *EInvoiceCredentialUpdateOne) Mutation() *EInvoiceCredentialMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *EInvoiceCredentialUpdateOne) ClearTenant() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearEinvoices clears all "einvoices" edges to the EInvoice entity.
+func (_u *EInvoiceCredentialUpdateOne) ClearEinvoices() *EInvoiceCredentialUpdateOne {
+	_u.mutation.ClearEinvoices()
+	return _u
+}
+
+// RemoveEinvoiceIDs removes the "einvoices" edge to EInvoice entities by IDs.
+func (_u *EInvoiceCredentialUpdateOne) RemoveEinvoiceIDs(ids ...int) *EInvoiceCredentialUpdateOne {
+	_u.mutation.RemoveEinvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveEinvoices removes "einvoices" edges to EInvoice entities.
+func (_u *EInvoiceCredentialUpdateOne) RemoveEinvoices(v ...*EInvoice) *EInvoiceCredentialUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEinvoiceIDs(ids...)
+}
+
+// Where appends a list predicates to the EInvoiceCredentialUpdate builder.
+func (_u *EInvoiceCredentialUpdateOne) Where(ps ...predicate.EInvoiceCredential) *EInvoiceCredentialUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EInvoiceCredentialUpdateOne) Select(field string, fields ...string) *EInvoiceCredentialUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EInvoiceCredential entity.
+func (_u *EInvoiceCredentialUpdateOne) Save(ctx context.Context) (*EInvoiceCredential, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EInvoiceCredentialUpdateOne) SaveX(ctx context.Context) *EInvoiceCredential {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EInvoiceCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EInvoiceCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EInvoiceCredentialUpdateOne) check() error {
+	if v, ok := _u.mutation.Authority(); ok {
+		if err := einvoicecredential.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`ent: validator failed for field "EInvoiceCredential.authority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Environment(); ok {
+		if err := einvoicecredential.EnvironmentValidator(v); err != nil {
+			return &ValidationError{Name: "environment", err: fmt.Errorf(`ent: validator failed for field "EInvoiceCredential.environment": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EInvoiceCredential.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EInvoiceCredentialUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EInvoiceCredentialUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EInvoiceCredentialUpdateOne) sqlSave(ctx context.Context) (_node *EInvoiceCredential, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(einvoicecredential.Table, einvoicecredential.Columns, sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EInvoiceCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, einvoicecredential.FieldID)
+		for _, f := range fields {
+			if !einvoicecredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != einvoicecredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Authority(); ok {
+		_spec.SetField(einvoicecredential.FieldAuthority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Environment(); ok {
+		_spec.SetField(einvoicecredential.FieldEnvironment, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Certificate(); ok {
+		_spec.SetField(einvoicecredential.FieldCertificate, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCleared() {
+		_spec.ClearField(einvoicecredential.FieldCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateKeyEncrypted(); ok {
+		_spec.SetField(einvoicecredential.FieldPrivateKeyEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.PrivateKeyEncryptedCleared() {
+		_spec.ClearField(einvoicecredential.FieldPrivateKeyEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecretEncrypted(); ok {
+		_spec.SetField(einvoicecredential.FieldSecretEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.SecretEncryptedCleared() {
+		_spec.ClearField(einvoicecredential.FieldSecretEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(einvoicecredential.FieldClientID, field.TypeString, value)
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(einvoicecredential.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ComplianceRequestID(); ok {
+		_spec.SetField(einvoicecredential.FieldComplianceRequestID, field.TypeString, value)
+	}
+	if _u.mutation.ComplianceRequestIDCleared() {
+		_spec.ClearField(einvoicecredential.FieldComplianceRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RegistrationNumber(); ok {
+		_spec.SetField(einvoicecredential.FieldRegistrationNumber, field.TypeString, value)
+	}
+	if _u.mutation.RegistrationNumberCleared() {
+		_spec.ClearField(einvoicecredential.FieldRegistrationNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.Street(); ok {
+		_spec.SetField(einvoicecredential.FieldStreet, field.TypeString, value)
+	}
+	if _u.mutation.StreetCleared() {
+		_spec.ClearField(einvoicecredential.FieldStreet, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildingNumber(); ok {
+		_spec.SetField(einvoicecredential.FieldBuildingNumber, field.TypeString, value)
+	}
+	if _u.mutation.BuildingNumberCleared() {
+		_spec.ClearField(einvoicecredential.FieldBuildingNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.District(); ok {
+		_spec.SetField(einvoicecredential.FieldDistrict, field.TypeString, value)
+	}
+	if _u.mutation.DistrictCleared() {
+		_spec.ClearField(einvoicecredential.FieldDistrict, field.TypeString)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(einvoicecredential.FieldCity, field.TypeString, value)
+	}
+	if _u.mutation.CityCleared() {
+		_spec.ClearField(einvoicecredential.FieldCity, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostalCode(); ok {
+		_spec.SetField(einvoicecredential.FieldPostalCode, field.TypeString, value)
+	}
+	if _u.mutation.PostalCodeCleared() {
+		_spec.ClearField(einvoicecredential.FieldPostalCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(einvoicecredential.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoicecredential.TenantTable,
+			Columns: []string{einvoicecredential.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   einvoicecredential.TenantTable,
+			Columns: []string{einvoicecredential.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EinvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   einvoicecredential.EinvoicesTable,
+			Columns: []string{einvoicecredential.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEinvoicesIDs(); len(nodes) > 0 && !_u.mutation.EinvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   einvoicecredential.EinvoicesTable,
+			Columns: []string{einvoicecredential.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EinvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   einvoicecredential.EinvoicesTable,
+			Columns: []string{einvoicecredential.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EInvoiceCredential{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{einvoicecredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,12 +8,22 @@ import (
 	"fmt"
 	"reflect"
 	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
 	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/agentauthority"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/agentinventory"
+	"sent/ent/agentsoftware"
+	"sent/ent/alertrule"
 	"sent/ent/application"
 	"sent/ent/asset"
 	"sent/ent/assetassignment"
 	"sent/ent/assettype"
 	"sent/ent/auditlog"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
 	"sent/ent/benefitenrollment"
 	"sent/ent/benefitplan"
 	"sent/ent/budgetforecast"
@@ -25,20 +35,33 @@ import (
 	"sent/ent/contact"
 	"sent/ent/contract"
 	"sent/ent/credential"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
 	"sent/ent/department"
 	"sent/ent/detectionevent"
+	"sent/ent/devicegroup"
 	"sent/ent/discoveryentry"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
 	"sent/ent/employee"
+	"sent/ent/exchangerate"
+	"sent/ent/fiscalperiod"
 	"sent/ent/goal"
+	"sent/ent/goodsreceipt"
+	"sent/ent/goodsreceiptline"
 	"sent/ent/healthscoresnapshot"
 	"sent/ent/interview"
 	"sent/ent/inventorycount"
 	"sent/ent/inventoryreservation"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+	"sent/ent/invoicetaxline"
 	"sent/ent/ivrflow"
 	"sent/ent/job"
 	"sent/ent/jobexecution"
 	"sent/ent/jobposting"
 	"sent/ent/journalentry"
+	"sent/ent/labeltemplate"
 	"sent/ent/ledgerentry"
 	"sent/ent/legalhold"
 	"sent/ent/maintenanceschedule"
@@ -48,8 +71,15 @@ import (
 	"sent/ent/networkport"
 	"sent/ent/nexusaudit"
 	"sent/ent/onetimelink"
+	"sent/ent/patchpolicy"
+	"sent/ent/paymentallocation"
+	"sent/ent/paymentrun"
+	"sent/ent/paymentrunline"
 	"sent/ent/performancereview"
 	"sent/ent/permission"
+	"sent/ent/possaleline"
+	"sent/ent/posshift"
+	"sent/ent/postender"
 	"sent/ent/product"
 	"sent/ent/productvariant"
 	"sent/ent/purchaseorder"
@@ -68,10 +98,15 @@ import (
 	"sent/ent/sop"
 	"sent/ent/stockalert"
 	"sent/ent/stockauditlog"
+	"sent/ent/stocklevel"
 	"sent/ent/stockmovement"
 	"sent/ent/strategicroadmap"
 	"sent/ent/successionmap"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
 	"sent/ent/tenant"
 	"sent/ent/ticket"
 	"sent/ent/timeentry"
@@ -79,6 +114,8 @@ import (
 	"sent/ent/timeoffpolicy"
 	"sent/ent/timeoffrequest"
 	"sent/ent/transaction"
+	"sent/ent/transferorder"
+	"sent/ent/transferorderline"
 	"sent/ent/user"
 	"sent/ent/vaultcomment"
 	"sent/ent/vaultfavorite"
@@ -154,88 +191,125 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table:               account.ValidColumn,
-			agent.Table:                 agent.ValidColumn,
-			application.Table:           application.ValidColumn,
-			asset.Table:                 asset.ValidColumn,
-			assetassignment.Table:       assetassignment.ValidColumn,
-			assettype.Table:             assettype.ValidColumn,
-			auditlog.Table:              auditlog.ValidColumn,
-			benefitenrollment.Table:     benefitenrollment.ValidColumn,
-			benefitplan.Table:           benefitplan.ValidColumn,
-			budgetforecast.Table:        budgetforecast.ValidColumn,
-			calllog.Table:               calllog.ValidColumn,
-			camera.Table:                camera.ValidColumn,
-			candidate.Table:             candidate.ValidColumn,
-			category.Table:              category.ValidColumn,
-			compensationagreement.Table: compensationagreement.ValidColumn,
-			contact.Table:               contact.ValidColumn,
-			contract.Table:              contract.ValidColumn,
-			credential.Table:            credential.ValidColumn,
-			department.Table:            department.ValidColumn,
-			detectionevent.Table:        detectionevent.ValidColumn,
-			discoveryentry.Table:        discoveryentry.ValidColumn,
-			employee.Table:              employee.ValidColumn,
-			goal.Table:                  goal.ValidColumn,
-			healthscoresnapshot.Table:   healthscoresnapshot.ValidColumn,
-			ivrflow.Table:               ivrflow.ValidColumn,
-			interview.Table:             interview.ValidColumn,
-			inventorycount.Table:        inventorycount.ValidColumn,
-			inventoryreservation.Table:  inventoryreservation.ValidColumn,
-			job.Table:                   job.ValidColumn,
-			jobexecution.Table:          jobexecution.ValidColumn,
-			jobposting.Table:            jobposting.ValidColumn,
-			journalentry.Table:          journalentry.ValidColumn,
-			ledgerentry.Table:           ledgerentry.ValidColumn,
-			legalhold.Table:             legalhold.ValidColumn,
-			maintenanceschedule.Table:   maintenanceschedule.ValidColumn,
-			networkbackup.Table:         networkbackup.ValidColumn,
-			networkdevice.Table:         networkdevice.ValidColumn,
-			networklink.Table:           networklink.ValidColumn,
-			networkport.Table:           networkport.ValidColumn,
-			nexusaudit.Table:            nexusaudit.ValidColumn,
-			onetimelink.Table:           onetimelink.ValidColumn,
-			performancereview.Table:     performancereview.ValidColumn,
-			permission.Table:            permission.ValidColumn,
-			product.Table:               product.ValidColumn,
-			productvariant.Table:        productvariant.ValidColumn,
-			purchaseorder.Table:         purchaseorder.ValidColumn,
-			purchaseorderline.Table:     purchaseorderline.ValidColumn,
-			recording.Table:             recording.ValidColumn,
-			recurringinvoice.Table:      recurringinvoice.ValidColumn,
-			remediationstep.Table:       remediationstep.ValidColumn,
-			retentionpolicy.Table:       retentionpolicy.ValidColumn,
-			reviewcycle.Table:           reviewcycle.ValidColumn,
-			sop.Table:                   sop.ValidColumn,
-			saasapp.Table:               saasapp.ValidColumn,
-			saasfilter.Table:            saasfilter.ValidColumn,
-			saasidentity.Table:          saasidentity.ValidColumn,
-			saasusage.Table:             saasusage.ValidColumn,
-			script.Table:                script.ValidColumn,
-			servicerate.Table:           servicerate.ValidColumn,
-			stockalert.Table:            stockalert.ValidColumn,
-			stockauditlog.Table:         stockauditlog.ValidColumn,
-			stockmovement.Table:         stockmovement.ValidColumn,
-			strategicroadmap.Table:      strategicroadmap.ValidColumn,
-			successionmap.Table:         successionmap.ValidColumn,
-			supplier.Table:              supplier.ValidColumn,
-			tenant.Table:                tenant.ValidColumn,
-			ticket.Table:                ticket.ValidColumn,
-			timeentry.Table:             timeentry.ValidColumn,
-			timeoffbalance.Table:        timeoffbalance.ValidColumn,
-			timeoffpolicy.Table:         timeoffpolicy.ValidColumn,
-			timeoffrequest.Table:        timeoffrequest.ValidColumn,
-			transaction.Table:           transaction.ValidColumn,
-			user.Table:                  user.ValidColumn,
-			vaultcomment.Table:          vaultcomment.ValidColumn,
-			vaultfavorite.Table:         vaultfavorite.ValidColumn,
-			vaultitem.Table:             vaultitem.ValidColumn,
-			vaultsharelink.Table:        vaultsharelink.ValidColumn,
-			vaulttemplate.Table:         vaulttemplate.ValidColumn,
-			vaultversion.Table:          vaultversion.ValidColumn,
-			voicemail.Table:             voicemail.ValidColumn,
-			warehouse.Table:             warehouse.ValidColumn,
-			worklog.Table:               worklog.ValidColumn,
+			account.Table:                account.ValidColumn,
+			accountbalancesnapshot.Table: accountbalancesnapshot.ValidColumn,
+			agent.Table:                  agent.ValidColumn,
+			agentalert.Table:             agentalert.ValidColumn,
+			agentauthority.Table:         agentauthority.ValidColumn,
+			agentenrollmenttoken.Table:   agentenrollmenttoken.ValidColumn,
+			agentinventory.Table:         agentinventory.ValidColumn,
+			agentsoftware.Table:          agentsoftware.ValidColumn,
+			alertrule.Table:              alertrule.ValidColumn,
+			application.Table:            application.ValidColumn,
+			asset.Table:                  asset.ValidColumn,
+			assetassignment.Table:        assetassignment.ValidColumn,
+			assettype.Table:              assettype.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			bankrule.Table:               bankrule.ValidColumn,
+			bankstatement.Table:          bankstatement.ValidColumn,
+			bankstatementline.Table:      bankstatementline.ValidColumn,
+			benefitenrollment.Table:      benefitenrollment.ValidColumn,
+			benefitplan.Table:            benefitplan.ValidColumn,
+			budgetforecast.Table:         budgetforecast.ValidColumn,
+			calllog.Table:                calllog.ValidColumn,
+			camera.Table:                 camera.ValidColumn,
+			candidate.Table:              candidate.ValidColumn,
+			category.Table:               category.ValidColumn,
+			compensationagreement.Table:  compensationagreement.ValidColumn,
+			contact.Table:                contact.ValidColumn,
+			contract.Table:               contract.ValidColumn,
+			credential.Table:             credential.ValidColumn,
+			customer.Table:               customer.ValidColumn,
+			customerpayment.Table:        customerpayment.ValidColumn,
+			department.Table:             department.ValidColumn,
+			detectionevent.Table:         detectionevent.ValidColumn,
+			devicegroup.Table:            devicegroup.ValidColumn,
+			discoveryentry.Table:         discoveryentry.ValidColumn,
+			einvoice.Table:               einvoice.ValidColumn,
+			einvoicecredential.Table:     einvoicecredential.ValidColumn,
+			employee.Table:               employee.ValidColumn,
+			exchangerate.Table:           exchangerate.ValidColumn,
+			fiscalperiod.Table:           fiscalperiod.ValidColumn,
+			goal.Table:                   goal.ValidColumn,
+			goodsreceipt.Table:           goodsreceipt.ValidColumn,
+			goodsreceiptline.Table:       goodsreceiptline.ValidColumn,
+			healthscoresnapshot.Table:    healthscoresnapshot.ValidColumn,
+			ivrflow.Table:                ivrflow.ValidColumn,
+			interview.Table:              interview.ValidColumn,
+			inventorycount.Table:         inventorycount.ValidColumn,
+			inventoryreservation.Table:   inventoryreservation.ValidColumn,
+			invoice.Table:                invoice.ValidColumn,
+			invoiceline.Table:            invoiceline.ValidColumn,
+			invoicetaxline.Table:         invoicetaxline.ValidColumn,
+			job.Table:                    job.ValidColumn,
+			jobexecution.Table:           jobexecution.ValidColumn,
+			jobposting.Table:             jobposting.ValidColumn,
+			journalentry.Table:           journalentry.ValidColumn,
+			labeltemplate.Table:          labeltemplate.ValidColumn,
+			ledgerentry.Table:            ledgerentry.ValidColumn,
+			legalhold.Table:              legalhold.ValidColumn,
+			maintenanceschedule.Table:    maintenanceschedule.ValidColumn,
+			networkbackup.Table:          networkbackup.ValidColumn,
+			networkdevice.Table:          networkdevice.ValidColumn,
+			networklink.Table:            networklink.ValidColumn,
+			networkport.Table:            networkport.ValidColumn,
+			nexusaudit.Table:             nexusaudit.ValidColumn,
+			onetimelink.Table:            onetimelink.ValidColumn,
+			patchpolicy.Table:            patchpolicy.ValidColumn,
+			paymentallocation.Table:      paymentallocation.ValidColumn,
+			paymentrun.Table:             paymentrun.ValidColumn,
+			paymentrunline.Table:         paymentrunline.ValidColumn,
+			performancereview.Table:      performancereview.ValidColumn,
+			permission.Table:             permission.ValidColumn,
+			possaleline.Table:            possaleline.ValidColumn,
+			posshift.Table:               posshift.ValidColumn,
+			postender.Table:              postender.ValidColumn,
+			product.Table:                product.ValidColumn,
+			productvariant.Table:         productvariant.ValidColumn,
+			purchaseorder.Table:          purchaseorder.ValidColumn,
+			purchaseorderline.Table:      purchaseorderline.ValidColumn,
+			recording.Table:              recording.ValidColumn,
+			recurringinvoice.Table:       recurringinvoice.ValidColumn,
+			remediationstep.Table:        remediationstep.ValidColumn,
+			retentionpolicy.Table:        retentionpolicy.ValidColumn,
+			reviewcycle.Table:            reviewcycle.ValidColumn,
+			sop.Table:                    sop.ValidColumn,
+			saasapp.Table:                saasapp.ValidColumn,
+			saasfilter.Table:             saasfilter.ValidColumn,
+			saasidentity.Table:           saasidentity.ValidColumn,
+			saasusage.Table:              saasusage.ValidColumn,
+			script.Table:                 script.ValidColumn,
+			servicerate.Table:            servicerate.ValidColumn,
+			stockalert.Table:             stockalert.ValidColumn,
+			stockauditlog.Table:          stockauditlog.ValidColumn,
+			stocklevel.Table:             stocklevel.ValidColumn,
+			stockmovement.Table:          stockmovement.ValidColumn,
+			strategicroadmap.Table:       strategicroadmap.ValidColumn,
+			successionmap.Table:          successionmap.ValidColumn,
+			supplier.Table:               supplier.ValidColumn,
+			supplierbill.Table:           supplierbill.ValidColumn,
+			supplierbillline.Table:       supplierbillline.ValidColumn,
+			taxjurisdiction.Table:        taxjurisdiction.ValidColumn,
+			taxrate.Table:                taxrate.ValidColumn,
+			tenant.Table:                 tenant.ValidColumn,
+			ticket.Table:                 ticket.ValidColumn,
+			timeentry.Table:              timeentry.ValidColumn,
+			timeoffbalance.Table:         timeoffbalance.ValidColumn,
+			timeoffpolicy.Table:          timeoffpolicy.ValidColumn,
+			timeoffrequest.Table:         timeoffrequest.ValidColumn,
+			transaction.Table:            transaction.ValidColumn,
+			transferorder.Table:          transferorder.ValidColumn,
+			transferorderline.Table:      transferorderline.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			vaultcomment.Table:           vaultcomment.ValidColumn,
+			vaultfavorite.Table:          vaultfavorite.ValidColumn,
+			vaultitem.Table:              vaultitem.ValidColumn,
+			vaultsharelink.Table:         vaultsharelink.ValidColumn,
+			vaulttemplate.Table:          vaulttemplate.ValidColumn,
+			vaultversion.Table:           vaultversion.ValidColumn,
+			voicemail.Table:              voicemail.ValidColumn,
+			warehouse.Table:              warehouse.ValidColumn,
+			worklog.Table:                worklog.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/exchangerate"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate is the model entity for the ExchangeRate schema.
+type ExchangeRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BaseCurrency holds the value of the "base_currency" field.
+	BaseCurrency string `json:"base_currency,omitempty"`
+	// QuoteCurrency holds the value of the "quote_currency" field.
+	QuoteCurrency string `json:"quote_currency,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// EffectiveDate holds the value of the "effective_date" field.
+	EffectiveDate time.Time `json:"effective_date,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExchangeRateQuery when eager-loading is set.
+	Edges                 ExchangeRateEdges `json:"edges"`
+	tenant_exchange_rates *int
+	selectValues          sql.SelectValues
+}
+
+// ExchangeRateEdges holds the relations/edges for other nodes in the graph.
+type ExchangeRateEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExchangeRateEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExchangeRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldRate:
+			values[i] = new(decimal.Decimal)
+		case exchangerate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case exchangerate.FieldBaseCurrency, exchangerate.FieldQuoteCurrency, exchangerate.FieldSource:
+			values[i] = new(sql.NullString)
+		case exchangerate.FieldEffectiveDate, exchangerate.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case exchangerate.ForeignKeys[0]: // tenant_exchange_rates
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExchangeRate fields.
+func (_m *ExchangeRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case exchangerate.FieldBaseCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_currency", values[i])
+			} else if value.Valid {
+				_m.BaseCurrency = value.String
+			}
+		case exchangerate.FieldQuoteCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quote_currency", values[i])
+			} else if value.Valid {
+				_m.QuoteCurrency = value.String
+			}
+		case exchangerate.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				_m.Rate = *value
+			}
+		case exchangerate.FieldEffectiveDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_date", values[i])
+			} else if value.Valid {
+				_m.EffectiveDate = value.Time
+			}
+		case exchangerate.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case exchangerate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case exchangerate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_exchange_rates", value)
+			} else if value.Valid {
+				_m.tenant_exchange_rates = new(int)
+				*_m.tenant_exchange_rates = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExchangeRate.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExchangeRate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the ExchangeRate entity.
+func (_m *ExchangeRate) QueryTenant() *TenantQuery {
+	return NewExchangeRateClient(_m.config).QueryTenant(_m)
+}
+
+// Update returns a builder for updating this ExchangeRate.
+// Note that you need to call ExchangeRate.Unwrap() before calling this method if this ExchangeRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExchangeRate) Update() *ExchangeRateUpdateOne {
+	return NewExchangeRateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExchangeRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExchangeRate) Unwrap() *ExchangeRate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExchangeRate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExchangeRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExchangeRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("base_currency=")
+	builder.WriteString(_m.BaseCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("quote_currency=")
+	builder.WriteString(_m.QuoteCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("effective_date=")
+	builder.WriteString(_m.EffectiveDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExchangeRates is a parsable slice of ExchangeRate.
+type ExchangeRates []*ExchangeRate
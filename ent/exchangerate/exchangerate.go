@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the exchangerate type in the database.
+	Label = "exchange_rate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBaseCurrency holds the string denoting the base_currency field in the database.
+	FieldBaseCurrency = "base_currency"
+	// FieldQuoteCurrency holds the string denoting the quote_currency field in the database.
+	FieldQuoteCurrency = "quote_currency"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldEffectiveDate holds the string denoting the effective_date field in the database.
+	FieldEffectiveDate = "effective_date"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// Table holds the table name of the exchangerate in the database.
+	Table = "exchange_rates"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "exchange_rates"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_exchange_rates"
+)
+
+// Columns holds all SQL columns for exchangerate fields.
+var Columns = []string{
+	FieldID,
+	FieldBaseCurrency,
+	FieldQuoteCurrency,
+	FieldRate,
+	FieldEffectiveDate,
+	FieldSource,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "exchange_rates"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tenant_exchange_rates",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ExchangeRate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBaseCurrency orders the results by the base_currency field.
+func ByBaseCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseCurrency, opts...).ToFunc()
+}
+
+// ByQuoteCurrency orders the results by the quote_currency field.
+func ByQuoteCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuoteCurrency, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByEffectiveDate orders the results by the effective_date field.
+func ByEffectiveDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveDate, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldID, id))
+}
+
+// BaseCurrency applies equality check predicate on the "base_currency" field. It's identical to BaseCurrencyEQ.
+func BaseCurrency(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldBaseCurrency, v))
+}
+
+// QuoteCurrency applies equality check predicate on the "quote_currency" field. It's identical to QuoteCurrencyEQ.
+func QuoteCurrency(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldQuoteCurrency, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRate, v))
+}
+
+// EffectiveDate applies equality check predicate on the "effective_date" field. It's identical to EffectiveDateEQ.
+func EffectiveDate(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldSource, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BaseCurrencyEQ applies the EQ predicate on the "base_currency" field.
+func BaseCurrencyEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyNEQ applies the NEQ predicate on the "base_currency" field.
+func BaseCurrencyNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyIn applies the In predicate on the "base_currency" field.
+func BaseCurrencyIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldBaseCurrency, vs...))
+}
+
+// BaseCurrencyNotIn applies the NotIn predicate on the "base_currency" field.
+func BaseCurrencyNotIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldBaseCurrency, vs...))
+}
+
+// BaseCurrencyGT applies the GT predicate on the "base_currency" field.
+func BaseCurrencyGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyGTE applies the GTE predicate on the "base_currency" field.
+func BaseCurrencyGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyLT applies the LT predicate on the "base_currency" field.
+func BaseCurrencyLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyLTE applies the LTE predicate on the "base_currency" field.
+func BaseCurrencyLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyContains applies the Contains predicate on the "base_currency" field.
+func BaseCurrencyContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContains(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyHasPrefix applies the HasPrefix predicate on the "base_currency" field.
+func BaseCurrencyHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasPrefix(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyHasSuffix applies the HasSuffix predicate on the "base_currency" field.
+func BaseCurrencyHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasSuffix(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyEqualFold applies the EqualFold predicate on the "base_currency" field.
+func BaseCurrencyEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEqualFold(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyContainsFold applies the ContainsFold predicate on the "base_currency" field.
+func BaseCurrencyContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContainsFold(FieldBaseCurrency, v))
+}
+
+// QuoteCurrencyEQ applies the EQ predicate on the "quote_currency" field.
+func QuoteCurrencyEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyNEQ applies the NEQ predicate on the "quote_currency" field.
+func QuoteCurrencyNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyIn applies the In predicate on the "quote_currency" field.
+func QuoteCurrencyIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldQuoteCurrency, vs...))
+}
+
+// QuoteCurrencyNotIn applies the NotIn predicate on the "quote_currency" field.
+func QuoteCurrencyNotIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldQuoteCurrency, vs...))
+}
+
+// QuoteCurrencyGT applies the GT predicate on the "quote_currency" field.
+func QuoteCurrencyGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyGTE applies the GTE predicate on the "quote_currency" field.
+func QuoteCurrencyGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyLT applies the LT predicate on the "quote_currency" field.
+func QuoteCurrencyLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyLTE applies the LTE predicate on the "quote_currency" field.
+func QuoteCurrencyLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyContains applies the Contains predicate on the "quote_currency" field.
+func QuoteCurrencyContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContains(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyHasPrefix applies the HasPrefix predicate on the "quote_currency" field.
+func QuoteCurrencyHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasPrefix(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyHasSuffix applies the HasSuffix predicate on the "quote_currency" field.
+func QuoteCurrencyHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasSuffix(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyEqualFold applies the EqualFold predicate on the "quote_currency" field.
+func QuoteCurrencyEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEqualFold(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyContainsFold applies the ContainsFold predicate on the "quote_currency" field.
+func QuoteCurrencyContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContainsFold(FieldQuoteCurrency, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldRate, v))
+}
+
+// EffectiveDateEQ applies the EQ predicate on the "effective_date" field.
+func EffectiveDateEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateNEQ applies the NEQ predicate on the "effective_date" field.
+func EffectiveDateNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateIn applies the In predicate on the "effective_date" field.
+func EffectiveDateIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateNotIn applies the NotIn predicate on the "effective_date" field.
+func EffectiveDateNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateGT applies the GT predicate on the "effective_date" field.
+func EffectiveDateGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateGTE applies the GTE predicate on the "effective_date" field.
+func EffectiveDateGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLT applies the LT predicate on the "effective_date" field.
+func EffectiveDateLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLTE applies the LTE predicate on the "effective_date" field.
+func EffectiveDateLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldEffectiveDate, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContainsFold(FieldSource, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.NotPredicates(p))
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/exchangerate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateCreate is the builder for creating a ExchangeRate entity.
+type ExchangeRateCreate struct {
+	config
+	mutation *ExchangeRateMutation
+	hooks    []Hook
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (_c *ExchangeRateCreate) SetBaseCurrency(v string) *ExchangeRateCreate {
+	_c.mutation.SetBaseCurrency(v)
+	return _c
+}
+
+// SetQuoteCurrency sets the "quote_currency" field.
+func (_c *ExchangeRateCreate) SetQuoteCurrency(v string) *ExchangeRateCreate {
+	_c.mutation.SetQuoteCurrency(v)
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *ExchangeRateCreate) SetRate(v decimal.Decimal) *ExchangeRateCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (_c *ExchangeRateCreate) SetEffectiveDate(v time.Time) *ExchangeRateCreate {
+	_c.mutation.SetEffectiveDate(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ExchangeRateCreate) SetSource(v string) *ExchangeRateCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *ExchangeRateCreate) SetNillableSource(v *string) *ExchangeRateCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExchangeRateCreate) SetCreatedAt(v time.Time) *ExchangeRateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExchangeRateCreate) SetNillableCreatedAt(v *time.Time) *ExchangeRateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *ExchangeRateCreate) SetTenantID(id int) *ExchangeRateCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *ExchangeRateCreate) SetTenant(v *Tenant) *ExchangeRateCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (_c *ExchangeRateCreate) Mutation() *ExchangeRateMutation {
+	return _c.mutation
+}
+
+// Save creates the ExchangeRate in the database.
+func (_c *ExchangeRateCreate) Save(ctx context.Context) (*ExchangeRate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExchangeRateCreate) SaveX(ctx context.Context) *ExchangeRate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExchangeRateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExchangeRateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExchangeRateCreate) defaults() {
+	if _, ok := _c.mutation.Source(); !ok {
+		v := exchangerate.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := exchangerate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExchangeRateCreate) check() error {
+	if _, ok := _c.mutation.BaseCurrency(); !ok {
+		return &ValidationError{Name: "base_currency", err: errors.New(`ent: missing required field "ExchangeRate.base_currency"`)}
+	}
+	if _, ok := _c.mutation.QuoteCurrency(); !ok {
+		return &ValidationError{Name: "quote_currency", err: errors.New(`ent: missing required field "ExchangeRate.quote_currency"`)}
+	}
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "ExchangeRate.rate"`)}
+	}
+	if _, ok := _c.mutation.EffectiveDate(); !ok {
+		return &ValidationError{Name: "effective_date", err: errors.New(`ent: missing required field "ExchangeRate.effective_date"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ExchangeRate.source"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExchangeRate.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "ExchangeRate.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *ExchangeRateCreate) sqlSave(ctx context.Context) (*ExchangeRate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExchangeRateCreate) createSpec() (*ExchangeRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExchangeRate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(exchangerate.Table, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.BaseCurrency(); ok {
+		_spec.SetField(exchangerate.FieldBaseCurrency, field.TypeString, value)
+		_node.BaseCurrency = value
+	}
+	if value, ok := _c.mutation.QuoteCurrency(); ok {
+		_spec.SetField(exchangerate.FieldQuoteCurrency, field.TypeString, value)
+		_node.QuoteCurrency = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeOther, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.EffectiveDate(); ok {
+		_spec.SetField(exchangerate.FieldEffectiveDate, field.TypeTime, value)
+		_node.EffectiveDate = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(exchangerate.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(exchangerate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exchangerate.TenantTable,
+			Columns: []string{exchangerate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_exchange_rates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExchangeRateCreateBulk is the builder for creating many ExchangeRate entities in bulk.
+type ExchangeRateCreateBulk struct {
+	config
+	err      error
+	builders []*ExchangeRateCreate
+}
+
+// Save creates the ExchangeRate entities in the database.
+func (_c *ExchangeRateCreateBulk) Save(ctx context.Context) ([]*ExchangeRate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExchangeRate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExchangeRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExchangeRateCreateBulk) SaveX(ctx context.Context) []*ExchangeRate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExchangeRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExchangeRateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,478 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/exchangerate"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateUpdate is the builder for updating ExchangeRate entities.
+type ExchangeRateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ExchangeRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (_u *ExchangeRateUpdate) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (_u *ExchangeRateUpdate) SetBaseCurrency(v string) *ExchangeRateUpdate {
+	_u.mutation.SetBaseCurrency(v)
+	return _u
+}
+
+// SetNillableBaseCurrency sets the "base_currency" field if the given value is not nil.
+func (_u *ExchangeRateUpdate) SetNillableBaseCurrency(v *string) *ExchangeRateUpdate {
+	if v != nil {
+		_u.SetBaseCurrency(*v)
+	}
+	return _u
+}
+
+// SetQuoteCurrency sets the "quote_currency" field.
+func (_u *ExchangeRateUpdate) SetQuoteCurrency(v string) *ExchangeRateUpdate {
+	_u.mutation.SetQuoteCurrency(v)
+	return _u
+}
+
+// SetNillableQuoteCurrency sets the "quote_currency" field if the given value is not nil.
+func (_u *ExchangeRateUpdate) SetNillableQuoteCurrency(v *string) *ExchangeRateUpdate {
+	if v != nil {
+		_u.SetQuoteCurrency(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *ExchangeRateUpdate) SetRate(v decimal.Decimal) *ExchangeRateUpdate {
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *ExchangeRateUpdate) SetNillableRate(v *decimal.Decimal) *ExchangeRateUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (_u *ExchangeRateUpdate) SetEffectiveDate(v time.Time) *ExchangeRateUpdate {
+	_u.mutation.SetEffectiveDate(v)
+	return _u
+}
+
+// SetNillableEffectiveDate sets the "effective_date" field if the given value is not nil.
+func (_u *ExchangeRateUpdate) SetNillableEffectiveDate(v *time.Time) *ExchangeRateUpdate {
+	if v != nil {
+		_u.SetEffectiveDate(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ExchangeRateUpdate) SetSource(v string) *ExchangeRateUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ExchangeRateUpdate) SetNillableSource(v *string) *ExchangeRateUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *ExchangeRateUpdate) SetTenantID(id int) *ExchangeRateUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ExchangeRateUpdate) SetTenant(v *Tenant) *ExchangeRateUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (_u *ExchangeRateUpdate) Mutation() *ExchangeRateMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ExchangeRateUpdate) ClearTenant() *ExchangeRateUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExchangeRateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExchangeRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExchangeRateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExchangeRateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExchangeRateUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExchangeRate.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExchangeRateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExchangeRateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExchangeRateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exchangerate.Table, exchangerate.Columns, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BaseCurrency(); ok {
+		_spec.SetField(exchangerate.FieldBaseCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuoteCurrency(); ok {
+		_spec.SetField(exchangerate.FieldQuoteCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.EffectiveDate(); ok {
+		_spec.SetField(exchangerate.FieldEffectiveDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(exchangerate.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exchangerate.TenantTable,
+			Columns: []string{exchangerate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exchangerate.TenantTable,
+			Columns: []string{exchangerate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExchangeRateUpdateOne is the builder for updating a single ExchangeRate entity.
+type ExchangeRateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ExchangeRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (_u *ExchangeRateUpdateOne) SetBaseCurrency(v string) *ExchangeRateUpdateOne {
+	_u.mutation.SetBaseCurrency(v)
+	return _u
+}
+
+// SetNillableBaseCurrency sets the "base_currency" field if the given value is not nil.
+func (_u *ExchangeRateUpdateOne) SetNillableBaseCurrency(v *string) *ExchangeRateUpdateOne {
+	if v != nil {
+		_u.SetBaseCurrency(*v)
+	}
+	return _u
+}
+
+// SetQuoteCurrency sets the "quote_currency" field.
+func (_u *ExchangeRateUpdateOne) SetQuoteCurrency(v string) *ExchangeRateUpdateOne {
+	_u.mutation.SetQuoteCurrency(v)
+	return _u
+}
+
+// SetNillableQuoteCurrency sets the "quote_currency" field if the given value is not nil.
+func (_u *ExchangeRateUpdateOne) SetNillableQuoteCurrency(v *string) *ExchangeRateUpdateOne {
+	if v != nil {
+		_u.SetQuoteCurrency(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *ExchangeRateUpdateOne) SetRate(v decimal.Decimal) *ExchangeRateUpdateOne {
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *ExchangeRateUpdateOne) SetNillableRate(v *decimal.Decimal) *ExchangeRateUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (_u *ExchangeRateUpdateOne) SetEffectiveDate(v time.Time) *ExchangeRateUpdateOne {
+	_u.mutation.SetEffectiveDate(v)
+	return _u
+}
+
+// SetNillableEffectiveDate sets the "effective_date" field if the given value is not nil.
+func (_u *ExchangeRateUpdateOne) SetNillableEffectiveDate(v *time.Time) *ExchangeRateUpdateOne {
+	if v != nil {
+		_u.SetEffectiveDate(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ExchangeRateUpdateOne) SetSource(v string) *ExchangeRateUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ExchangeRateUpdateOne) SetNillableSource(v *string) *ExchangeRateUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *ExchangeRateUpdateOne) SetTenantID(id int) *ExchangeRateUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ExchangeRateUpdateOne) SetTenant(v *Tenant) *ExchangeRateUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (_u *ExchangeRateUpdateOne) Mutation() *ExchangeRateMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ExchangeRateUpdateOne) ClearTenant() *ExchangeRateUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (_u *ExchangeRateUpdateOne) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExchangeRateUpdateOne) Select(field string, fields ...string) *ExchangeRateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExchangeRate entity.
+func (_u *ExchangeRateUpdateOne) Save(ctx context.Context) (*ExchangeRate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExchangeRateUpdateOne) SaveX(ctx context.Context) *ExchangeRate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExchangeRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExchangeRateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExchangeRateUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExchangeRate.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExchangeRateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExchangeRateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExchangeRateUpdateOne) sqlSave(ctx context.Context) (_node *ExchangeRate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exchangerate.Table, exchangerate.Columns, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExchangeRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exchangerate.FieldID)
+		for _, f := range fields {
+			if !exchangerate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exchangerate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BaseCurrency(); ok {
+		_spec.SetField(exchangerate.FieldBaseCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuoteCurrency(); ok {
+		_spec.SetField(exchangerate.FieldQuoteCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.EffectiveDate(); ok {
+		_spec.SetField(exchangerate.FieldEffectiveDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(exchangerate.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exchangerate.TenantTable,
+			Columns: []string{exchangerate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exchangerate.TenantTable,
+			Columns: []string{exchangerate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ExchangeRate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/fiscalperiod"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FiscalPeriod is the model entity for the FiscalPeriod schema.
+type FiscalPeriod struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// FiscalYear holds the value of the "fiscal_year" field.
+	FiscalYear int `json:"fiscal_year,omitempty"`
+	// PeriodNumber holds the value of the "period_number" field.
+	PeriodNumber int `json:"period_number,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate time.Time `json:"start_date,omitempty"`
+	// EndDate holds the value of the "end_date" field.
+	EndDate time.Time `json:"end_date,omitempty"`
+	// Status holds the value of the "status" field.
+	Status fiscalperiod.Status `json:"status,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// ClosedBy holds the value of the "closed_by" field.
+	ClosedBy string `json:"closed_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FiscalPeriodQuery when eager-loading is set.
+	Edges                 FiscalPeriodEdges `json:"edges"`
+	tenant_fiscal_periods *int
+	selectValues          sql.SelectValues
+}
+
+// FiscalPeriodEdges holds the relations/edges for other nodes in the graph.
+type FiscalPeriodEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FiscalPeriodEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FiscalPeriod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fiscalperiod.FieldID, fiscalperiod.FieldFiscalYear, fiscalperiod.FieldPeriodNumber:
+			values[i] = new(sql.NullInt64)
+		case fiscalperiod.FieldName, fiscalperiod.FieldStatus, fiscalperiod.FieldClosedBy:
+			values[i] = new(sql.NullString)
+		case fiscalperiod.FieldStartDate, fiscalperiod.FieldEndDate, fiscalperiod.FieldClosedAt, fiscalperiod.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case fiscalperiod.ForeignKeys[0]: // tenant_fiscal_periods
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FiscalPeriod fields.
+func (_m *FiscalPeriod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fiscalperiod.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case fiscalperiod.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case fiscalperiod.FieldFiscalYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fiscal_year", values[i])
+			} else if value.Valid {
+				_m.FiscalYear = int(value.Int64)
+			}
+		case fiscalperiod.FieldPeriodNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field period_number", values[i])
+			} else if value.Valid {
+				_m.PeriodNumber = int(value.Int64)
+			}
+		case fiscalperiod.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				_m.StartDate = value.Time
+			}
+		case fiscalperiod.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				_m.EndDate = value.Time
+			}
+		case fiscalperiod.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = fiscalperiod.Status(value.String)
+			}
+		case fiscalperiod.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case fiscalperiod.FieldClosedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_by", values[i])
+			} else if value.Valid {
+				_m.ClosedBy = value.String
+			}
+		case fiscalperiod.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case fiscalperiod.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_fiscal_periods", value)
+			} else if value.Valid {
+				_m.tenant_fiscal_periods = new(int)
+				*_m.tenant_fiscal_periods = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FiscalPeriod.
+// This includes values selected through modifiers, order, etc.
+func (_m *FiscalPeriod) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the FiscalPeriod entity.
+func (_m *FiscalPeriod) QueryTenant() *TenantQuery {
+	return NewFiscalPeriodClient(_m.config).QueryTenant(_m)
+}
+
+// Update returns a builder for updating this FiscalPeriod.
+// Note that you need to call FiscalPeriod.Unwrap() before calling this method if this FiscalPeriod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FiscalPeriod) Update() *FiscalPeriodUpdateOne {
+	return NewFiscalPeriodClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FiscalPeriod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FiscalPeriod) Unwrap() *FiscalPeriod {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FiscalPeriod is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FiscalPeriod) String() string {
+	var builder strings.Builder
+	builder.WriteString("FiscalPeriod(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("fiscal_year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FiscalYear))
+	builder.WriteString(", ")
+	builder.WriteString("period_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeriodNumber))
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(_m.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(_m.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("closed_by=")
+	builder.WriteString(_m.ClosedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FiscalPeriods is a parsable slice of FiscalPeriod.
+type FiscalPeriods []*FiscalPeriod
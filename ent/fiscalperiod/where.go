@@ -0,0 +1,544 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fiscalperiod
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldName, v))
+}
+
+// FiscalYear applies equality check predicate on the "fiscal_year" field. It's identical to FiscalYearEQ.
+func FiscalYear(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldFiscalYear, v))
+}
+
+// PeriodNumber applies equality check predicate on the "period_number" field. It's identical to PeriodNumberEQ.
+func PeriodNumber(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldPeriodNumber, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldEndDate, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedBy applies equality check predicate on the "closed_by" field. It's identical to ClosedByEQ.
+func ClosedBy(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldClosedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldContainsFold(FieldName, v))
+}
+
+// FiscalYearEQ applies the EQ predicate on the "fiscal_year" field.
+func FiscalYearEQ(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldFiscalYear, v))
+}
+
+// FiscalYearNEQ applies the NEQ predicate on the "fiscal_year" field.
+func FiscalYearNEQ(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldFiscalYear, v))
+}
+
+// FiscalYearIn applies the In predicate on the "fiscal_year" field.
+func FiscalYearIn(vs ...int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldFiscalYear, vs...))
+}
+
+// FiscalYearNotIn applies the NotIn predicate on the "fiscal_year" field.
+func FiscalYearNotIn(vs ...int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldFiscalYear, vs...))
+}
+
+// FiscalYearGT applies the GT predicate on the "fiscal_year" field.
+func FiscalYearGT(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldFiscalYear, v))
+}
+
+// FiscalYearGTE applies the GTE predicate on the "fiscal_year" field.
+func FiscalYearGTE(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldFiscalYear, v))
+}
+
+// FiscalYearLT applies the LT predicate on the "fiscal_year" field.
+func FiscalYearLT(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldFiscalYear, v))
+}
+
+// FiscalYearLTE applies the LTE predicate on the "fiscal_year" field.
+func FiscalYearLTE(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldFiscalYear, v))
+}
+
+// PeriodNumberEQ applies the EQ predicate on the "period_number" field.
+func PeriodNumberEQ(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldPeriodNumber, v))
+}
+
+// PeriodNumberNEQ applies the NEQ predicate on the "period_number" field.
+func PeriodNumberNEQ(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldPeriodNumber, v))
+}
+
+// PeriodNumberIn applies the In predicate on the "period_number" field.
+func PeriodNumberIn(vs ...int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldPeriodNumber, vs...))
+}
+
+// PeriodNumberNotIn applies the NotIn predicate on the "period_number" field.
+func PeriodNumberNotIn(vs ...int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldPeriodNumber, vs...))
+}
+
+// PeriodNumberGT applies the GT predicate on the "period_number" field.
+func PeriodNumberGT(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldPeriodNumber, v))
+}
+
+// PeriodNumberGTE applies the GTE predicate on the "period_number" field.
+func PeriodNumberGTE(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldPeriodNumber, v))
+}
+
+// PeriodNumberLT applies the LT predicate on the "period_number" field.
+func PeriodNumberLT(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldPeriodNumber, v))
+}
+
+// PeriodNumberLTE applies the LTE predicate on the "period_number" field.
+func PeriodNumberLTE(v int) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldPeriodNumber, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldEndDate, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotNull(FieldClosedAt))
+}
+
+// ClosedByEQ applies the EQ predicate on the "closed_by" field.
+func ClosedByEQ(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldClosedBy, v))
+}
+
+// ClosedByNEQ applies the NEQ predicate on the "closed_by" field.
+func ClosedByNEQ(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldClosedBy, v))
+}
+
+// ClosedByIn applies the In predicate on the "closed_by" field.
+func ClosedByIn(vs ...string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldClosedBy, vs...))
+}
+
+// ClosedByNotIn applies the NotIn predicate on the "closed_by" field.
+func ClosedByNotIn(vs ...string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldClosedBy, vs...))
+}
+
+// ClosedByGT applies the GT predicate on the "closed_by" field.
+func ClosedByGT(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldClosedBy, v))
+}
+
+// ClosedByGTE applies the GTE predicate on the "closed_by" field.
+func ClosedByGTE(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldClosedBy, v))
+}
+
+// ClosedByLT applies the LT predicate on the "closed_by" field.
+func ClosedByLT(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldClosedBy, v))
+}
+
+// ClosedByLTE applies the LTE predicate on the "closed_by" field.
+func ClosedByLTE(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldClosedBy, v))
+}
+
+// ClosedByContains applies the Contains predicate on the "closed_by" field.
+func ClosedByContains(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldContains(FieldClosedBy, v))
+}
+
+// ClosedByHasPrefix applies the HasPrefix predicate on the "closed_by" field.
+func ClosedByHasPrefix(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldHasPrefix(FieldClosedBy, v))
+}
+
+// ClosedByHasSuffix applies the HasSuffix predicate on the "closed_by" field.
+func ClosedByHasSuffix(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldHasSuffix(FieldClosedBy, v))
+}
+
+// ClosedByIsNil applies the IsNil predicate on the "closed_by" field.
+func ClosedByIsNil() predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIsNull(FieldClosedBy))
+}
+
+// ClosedByNotNil applies the NotNil predicate on the "closed_by" field.
+func ClosedByNotNil() predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotNull(FieldClosedBy))
+}
+
+// ClosedByEqualFold applies the EqualFold predicate on the "closed_by" field.
+func ClosedByEqualFold(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEqualFold(FieldClosedBy, v))
+}
+
+// ClosedByContainsFold applies the ContainsFold predicate on the "closed_by" field.
+func ClosedByContainsFold(v string) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldContainsFold(FieldClosedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FiscalPeriod) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FiscalPeriod) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FiscalPeriod) predicate.FiscalPeriod {
+	return predicate.FiscalPeriod(sql.NotPredicates(p))
+}
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/fiscalperiod"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FiscalPeriodCreate is the builder for creating a FiscalPeriod entity.
+type FiscalPeriodCreate struct {
+	config
+	mutation *FiscalPeriodMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *FiscalPeriodCreate) SetName(v string) *FiscalPeriodCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetFiscalYear sets the "fiscal_year" field.
+func (_c *FiscalPeriodCreate) SetFiscalYear(v int) *FiscalPeriodCreate {
+	_c.mutation.SetFiscalYear(v)
+	return _c
+}
+
+// SetPeriodNumber sets the "period_number" field.
+func (_c *FiscalPeriodCreate) SetPeriodNumber(v int) *FiscalPeriodCreate {
+	_c.mutation.SetPeriodNumber(v)
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *FiscalPeriodCreate) SetStartDate(v time.Time) *FiscalPeriodCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *FiscalPeriodCreate) SetEndDate(v time.Time) *FiscalPeriodCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *FiscalPeriodCreate) SetStatus(v fiscalperiod.Status) *FiscalPeriodCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *FiscalPeriodCreate) SetNillableStatus(v *fiscalperiod.Status) *FiscalPeriodCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *FiscalPeriodCreate) SetClosedAt(v time.Time) *FiscalPeriodCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *FiscalPeriodCreate) SetNillableClosedAt(v *time.Time) *FiscalPeriodCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_c *FiscalPeriodCreate) SetClosedBy(v string) *FiscalPeriodCreate {
+	_c.mutation.SetClosedBy(v)
+	return _c
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_c *FiscalPeriodCreate) SetNillableClosedBy(v *string) *FiscalPeriodCreate {
+	if v != nil {
+		_c.SetClosedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FiscalPeriodCreate) SetCreatedAt(v time.Time) *FiscalPeriodCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FiscalPeriodCreate) SetNillableCreatedAt(v *time.Time) *FiscalPeriodCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *FiscalPeriodCreate) SetTenantID(id int) *FiscalPeriodCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *FiscalPeriodCreate) SetTenant(v *Tenant) *FiscalPeriodCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// Mutation returns the FiscalPeriodMutation object of the builder.
+func (_c *FiscalPeriodCreate) Mutation() *FiscalPeriodMutation {
+	return _c.mutation
+}
+
+// Save creates the FiscalPeriod in the database.
+func (_c *FiscalPeriodCreate) Save(ctx context.Context) (*FiscalPeriod, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FiscalPeriodCreate) SaveX(ctx context.Context) *FiscalPeriod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FiscalPeriodCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FiscalPeriodCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FiscalPeriodCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := fiscalperiod.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := fiscalperiod.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FiscalPeriodCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FiscalPeriod.name"`)}
+	}
+	if _, ok := _c.mutation.FiscalYear(); !ok {
+		return &ValidationError{Name: "fiscal_year", err: errors.New(`ent: missing required field "FiscalPeriod.fiscal_year"`)}
+	}
+	if _, ok := _c.mutation.PeriodNumber(); !ok {
+		return &ValidationError{Name: "period_number", err: errors.New(`ent: missing required field "FiscalPeriod.period_number"`)}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "FiscalPeriod.start_date"`)}
+	}
+	if _, ok := _c.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "FiscalPeriod.end_date"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "FiscalPeriod.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := fiscalperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FiscalPeriod.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FiscalPeriod.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "FiscalPeriod.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *FiscalPeriodCreate) sqlSave(ctx context.Context) (*FiscalPeriod, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FiscalPeriodCreate) createSpec() (*FiscalPeriod, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FiscalPeriod{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fiscalperiod.Table, sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(fiscalperiod.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.FiscalYear(); ok {
+		_spec.SetField(fiscalperiod.FieldFiscalYear, field.TypeInt, value)
+		_node.FiscalYear = value
+	}
+	if value, ok := _c.mutation.PeriodNumber(); ok {
+		_spec.SetField(fiscalperiod.FieldPeriodNumber, field.TypeInt, value)
+		_node.PeriodNumber = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(fiscalperiod.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(fiscalperiod.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(fiscalperiod.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(fiscalperiod.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.ClosedBy(); ok {
+		_spec.SetField(fiscalperiod.FieldClosedBy, field.TypeString, value)
+		_node.ClosedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(fiscalperiod.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fiscalperiod.TenantTable,
+			Columns: []string{fiscalperiod.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_fiscal_periods = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FiscalPeriodCreateBulk is the builder for creating many FiscalPeriod entities in bulk.
+type FiscalPeriodCreateBulk struct {
+	config
+	err      error
+	builders []*FiscalPeriodCreate
+}
+
+// Save creates the FiscalPeriod entities in the database.
+func (_c *FiscalPeriodCreateBulk) Save(ctx context.Context) ([]*FiscalPeriod, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FiscalPeriod, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FiscalPeriodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FiscalPeriodCreateBulk) SaveX(ctx context.Context) []*FiscalPeriod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FiscalPeriodCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FiscalPeriodCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
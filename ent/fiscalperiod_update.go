@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/fiscalperiod"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FiscalPeriodUpdate is the builder for updating FiscalPeriod entities.
+type FiscalPeriodUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FiscalPeriodMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FiscalPeriodUpdate builder.
+func (_u *FiscalPeriodUpdate) Where(ps ...predicate.FiscalPeriod) *FiscalPeriodUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *FiscalPeriodUpdate) SetName(v string) *FiscalPeriodUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *FiscalPeriodUpdate) SetNillableName(v *string) *FiscalPeriodUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFiscalYear sets the "fiscal_year" field.
+func (_u *FiscalPeriodUpdate) SetFiscalYear(v int) *FiscalPeriodUpdate {
+	_u.mutation.ResetFiscalYear()
+	_u.mutation.SetFiscalYear(v)
+	return _u
+}
+
+// SetNillableFiscalYear sets the "fiscal_year" field if the given value is not nil.
+func (_u *FiscalPeriodUpdate) SetNillableFiscalYear(v *int) *FiscalPeriodUpdate {
+	if v != nil {
+		_u.SetFiscalYear(*v)
+	}
+	return _u
+}
+
+// AddFiscalYear adds value to the "fiscal_year" field.
+func (_u *FiscalPeriodUpdate) AddFiscalYear(v int) *FiscalPeriodUpdate {
+	_u.mutation.AddFiscalYear(v)
+	return _u
+}
+
+// SetPeriodNumber sets the "period_number" field.
+func (_u *FiscalPeriodUpdate) SetPeriodNumber(v int) *FiscalPeriodUpdate {
+	_u.mutation.ResetPeriodNumber()
+	_u.mutation.SetPeriodNumber(v)
+	return _u
+}
+
+// SetNillablePeriodNumber sets the "period_number" field if the given value is not nil.
+func (_u *FiscalPeriodUpdate) SetNillablePeriodNumber(v *int) *FiscalPeriodUpdate {
+	if v != nil {
+		_u.SetPeriodNumber(*v)
+	}
+	return _u
+}
+
+// AddPeriodNumber adds value to the "period_number" field.
+func (_u *FiscalPeriodUpdate) AddPeriodNumber(v int) *FiscalPeriodUpdate {
+	_u.mutation.AddPeriodNumber(v)
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *FiscalPeriodUpdate) SetStartDate(v time.Time) *FiscalPeriodUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *FiscalPeriodUpdate) SetNillableStartDate(v *time.Time) *FiscalPeriodUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *FiscalPeriodUpdate) SetEndDate(v time.Time) *FiscalPeriodUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *FiscalPeriodUpdate) SetNillableEndDate(v *time.Time) *FiscalPeriodUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *FiscalPeriodUpdate) SetStatus(v fiscalperiod.Status) *FiscalPeriodUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *FiscalPeriodUpdate) SetNillableStatus(v *fiscalperiod.Status) *FiscalPeriodUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *FiscalPeriodUpdate) SetClosedAt(v time.Time) *FiscalPeriodUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *FiscalPeriodUpdate) SetNillableClosedAt(v *time.Time) *FiscalPeriodUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *FiscalPeriodUpdate) ClearClosedAt() *FiscalPeriodUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_u *FiscalPeriodUpdate) SetClosedBy(v string) *FiscalPeriodUpdate {
+	_u.mutation.SetClosedBy(v)
+	return _u
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_u *FiscalPeriodUpdate) SetNillableClosedBy(v *string) *FiscalPeriodUpdate {
+	if v != nil {
+		_u.SetClosedBy(*v)
+	}
+	return _u
+}
+
+// ClearClosedBy clears the value of the "closed_by" field.
+func (_u *FiscalPeriodUpdate) ClearClosedBy() *FiscalPeriodUpdate {
+	_u.mutation.ClearClosedBy()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *FiscalPeriodUpdate) SetTenantID(id int) *FiscalPeriodUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *FiscalPeriodUpdate) SetTenant(v *Tenant) *FiscalPeriodUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the FiscalPeriodMutation object of the builder.
+func (_u *FiscalPeriodUpdate) Mutation() *FiscalPeriodMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *FiscalPeriodUpdate) ClearTenant() *FiscalPeriodUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FiscalPeriodUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FiscalPeriodUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FiscalPeriodUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FiscalPeriodUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FiscalPeriodUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := fiscalperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FiscalPeriod.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FiscalPeriod.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FiscalPeriodUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FiscalPeriodUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FiscalPeriodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fiscalperiod.Table, fiscalperiod.Columns, sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(fiscalperiod.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FiscalYear(); ok {
+		_spec.SetField(fiscalperiod.FieldFiscalYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFiscalYear(); ok {
+		_spec.AddField(fiscalperiod.FieldFiscalYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PeriodNumber(); ok {
+		_spec.SetField(fiscalperiod.FieldPeriodNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPeriodNumber(); ok {
+		_spec.AddField(fiscalperiod.FieldPeriodNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(fiscalperiod.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(fiscalperiod.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(fiscalperiod.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(fiscalperiod.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(fiscalperiod.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedBy(); ok {
+		_spec.SetField(fiscalperiod.FieldClosedBy, field.TypeString, value)
+	}
+	if _u.mutation.ClosedByCleared() {
+		_spec.ClearField(fiscalperiod.FieldClosedBy, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fiscalperiod.TenantTable,
+			Columns: []string{fiscalperiod.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fiscalperiod.TenantTable,
+			Columns: []string{fiscalperiod.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fiscalperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FiscalPeriodUpdateOne is the builder for updating a single FiscalPeriod entity.
+type FiscalPeriodUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FiscalPeriodMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *FiscalPeriodUpdateOne) SetName(v string) *FiscalPeriodUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *FiscalPeriodUpdateOne) SetNillableName(v *string) *FiscalPeriodUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFiscalYear sets the "fiscal_year" field.
+func (_u *FiscalPeriodUpdateOne) SetFiscalYear(v int) *FiscalPeriodUpdateOne {
+	_u.mutation.ResetFiscalYear()
+	_u.mutation.SetFiscalYear(v)
+	return _u
+}
+
+// SetNillableFiscalYear sets the "fiscal_year" field if the given value is not nil.
+func (_u *FiscalPeriodUpdateOne) SetNillableFiscalYear(v *int) *FiscalPeriodUpdateOne {
+	if v != nil {
+		_u.SetFiscalYear(*v)
+	}
+	return _u
+}
+
+// AddFiscalYear adds value to the "fiscal_year" field.
+func (_u *FiscalPeriodUpdateOne) AddFiscalYear(v int) *FiscalPeriodUpdateOne {
+	_u.mutation.AddFiscalYear(v)
+	return _u
+}
+
+// SetPeriodNumber sets the "period_number" field.
+func (_u *FiscalPeriodUpdateOne) SetPeriodNumber(v int) *FiscalPeriodUpdateOne {
+	_u.mutation.ResetPeriodNumber()
+	_u.mutation.SetPeriodNumber(v)
+	return _u
+}
+
+// SetNillablePeriodNumber sets the "period_number" field if the given value is not nil.
+func (_u *FiscalPeriodUpdateOne) SetNillablePeriodNumber(v *int) *FiscalPeriodUpdateOne {
+	if v != nil {
+		_u.SetPeriodNumber(*v)
+	}
+	return _u
+}
+
+// AddPeriodNumber adds value to the "period_number" field.
+func (_u *FiscalPeriodUpdateOne) AddPeriodNumber(v int) *FiscalPeriodUpdateOne {
+	_u.mutation.AddPeriodNumber(v)
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *FiscalPeriodUpdateOne) SetStartDate(v time.Time) *FiscalPeriodUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *FiscalPeriodUpdateOne) SetNillableStartDate(v *time.Time) *FiscalPeriodUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *FiscalPeriodUpdateOne) SetEndDate(v time.Time) *FiscalPeriodUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *FiscalPeriodUpdateOne) SetNillableEndDate(v *time.Time) *FiscalPeriodUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *FiscalPeriodUpdateOne) SetStatus(v fiscalperiod.Status) *FiscalPeriodUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *FiscalPeriodUpdateOne) SetNillableStatus(v *fiscalperiod.Status) *FiscalPeriodUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *FiscalPeriodUpdateOne) SetClosedAt(v time.Time) *FiscalPeriodUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *FiscalPeriodUpdateOne) SetNillableClosedAt(v *time.Time) *FiscalPeriodUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *FiscalPeriodUpdateOne) ClearClosedAt() *FiscalPeriodUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_u *FiscalPeriodUpdateOne) SetClosedBy(v string) *FiscalPeriodUpdateOne {
+	_u.mutation.SetClosedBy(v)
+	return _u
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_u *FiscalPeriodUpdateOne) SetNillableClosedBy(v *string) *FiscalPeriodUpdateOne {
+	if v != nil {
+		_u.SetClosedBy(*v)
+	}
+	return _u
+}
+
+// ClearClosedBy clears the value of the "closed_by" field.
+func (_u *FiscalPeriodUpdateOne) ClearClosedBy() *FiscalPeriodUpdateOne {
+	_u.mutation.ClearClosedBy()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *FiscalPeriodUpdateOne) SetTenantID(id int) *FiscalPeriodUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *FiscalPeriodUpdateOne) SetTenant(v *Tenant) *FiscalPeriodUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the FiscalPeriodMutation object of the builder.
+func (_u *FiscalPeriodUpdateOne) Mutation() *FiscalPeriodMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *FiscalPeriodUpdateOne) ClearTenant() *FiscalPeriodUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Where appends a list predicates to the FiscalPeriodUpdate builder.
+func (_u *FiscalPeriodUpdateOne) Where(ps ...predicate.FiscalPeriod) *FiscalPeriodUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FiscalPeriodUpdateOne) Select(field string, fields ...string) *FiscalPeriodUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FiscalPeriod entity.
+func (_u *FiscalPeriodUpdateOne) Save(ctx context.Context) (*FiscalPeriod, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FiscalPeriodUpdateOne) SaveX(ctx context.Context) *FiscalPeriod {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FiscalPeriodUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FiscalPeriodUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FiscalPeriodUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := fiscalperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FiscalPeriod.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FiscalPeriod.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FiscalPeriodUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FiscalPeriodUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FiscalPeriodUpdateOne) sqlSave(ctx context.Context) (_node *FiscalPeriod, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fiscalperiod.Table, fiscalperiod.Columns, sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FiscalPeriod.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fiscalperiod.FieldID)
+		for _, f := range fields {
+			if !fiscalperiod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fiscalperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(fiscalperiod.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FiscalYear(); ok {
+		_spec.SetField(fiscalperiod.FieldFiscalYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFiscalYear(); ok {
+		_spec.AddField(fiscalperiod.FieldFiscalYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PeriodNumber(); ok {
+		_spec.SetField(fiscalperiod.FieldPeriodNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPeriodNumber(); ok {
+		_spec.AddField(fiscalperiod.FieldPeriodNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(fiscalperiod.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(fiscalperiod.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(fiscalperiod.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(fiscalperiod.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(fiscalperiod.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedBy(); ok {
+		_spec.SetField(fiscalperiod.FieldClosedBy, field.TypeString, value)
+	}
+	if _u.mutation.ClosedByCleared() {
+		_spec.ClearField(fiscalperiod.FieldClosedBy, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fiscalperiod.TenantTable,
+			Columns: []string{fiscalperiod.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fiscalperiod.TenantTable,
+			Columns: []string{fiscalperiod.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FiscalPeriod{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fiscalperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
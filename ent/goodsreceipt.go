@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/goodsreceipt"
+	"sent/ent/purchaseorder"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/warehouse"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceipt is the model entity for the GoodsReceipt schema.
+type GoodsReceipt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// ReceivedBy holds the value of the "received_by" field.
+	ReceivedBy string `json:"received_by,omitempty"`
+	// Freight holds the value of the "freight" field.
+	Freight decimal.Decimal `json:"freight,omitempty"`
+	// Duty holds the value of the "duty" field.
+	Duty decimal.Decimal `json:"duty,omitempty"`
+	// Insurance holds the value of the "insurance" field.
+	Insurance decimal.Decimal `json:"insurance,omitempty"`
+	// AllocationMethod holds the value of the "allocation_method" field.
+	AllocationMethod goodsreceipt.AllocationMethod `json:"allocation_method,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoodsReceiptQuery when eager-loading is set.
+	Edges                     GoodsReceiptEdges `json:"edges"`
+	goods_receipt_transaction *int
+	purchase_order_receipts   *int
+	tenant_goods_receipts     *int
+	warehouse_goods_receipts  *int
+	selectValues              sql.SelectValues
+}
+
+// GoodsReceiptEdges holds the relations/edges for other nodes in the graph.
+type GoodsReceiptEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// PurchaseOrder holds the value of the purchase_order edge.
+	PurchaseOrder *PurchaseOrder `json:"purchase_order,omitempty"`
+	// Warehouse holds the value of the warehouse edge.
+	Warehouse *Warehouse `json:"warehouse,omitempty"`
+	// Lines holds the value of the lines edge.
+	Lines []*GoodsReceiptLine `json:"lines,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoodsReceiptEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// PurchaseOrderOrErr returns the PurchaseOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoodsReceiptEdges) PurchaseOrderOrErr() (*PurchaseOrder, error) {
+	if e.PurchaseOrder != nil {
+		return e.PurchaseOrder, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: purchaseorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "purchase_order"}
+}
+
+// WarehouseOrErr returns the Warehouse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoodsReceiptEdges) WarehouseOrErr() (*Warehouse, error) {
+	if e.Warehouse != nil {
+		return e.Warehouse, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "warehouse"}
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e GoodsReceiptEdges) LinesOrErr() ([]*GoodsReceiptLine, error) {
+	if e.loadedTypes[3] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoodsReceiptEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoodsReceipt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goodsreceipt.FieldFreight, goodsreceipt.FieldDuty, goodsreceipt.FieldInsurance:
+			values[i] = new(decimal.Decimal)
+		case goodsreceipt.FieldID:
+			values[i] = new(sql.NullInt64)
+		case goodsreceipt.FieldNumber, goodsreceipt.FieldReference, goodsreceipt.FieldReceivedBy, goodsreceipt.FieldAllocationMethod, goodsreceipt.FieldNotes:
+			values[i] = new(sql.NullString)
+		case goodsreceipt.FieldReceivedAt, goodsreceipt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case goodsreceipt.ForeignKeys[0]: // goods_receipt_transaction
+			values[i] = new(sql.NullInt64)
+		case goodsreceipt.ForeignKeys[1]: // purchase_order_receipts
+			values[i] = new(sql.NullInt64)
+		case goodsreceipt.ForeignKeys[2]: // tenant_goods_receipts
+			values[i] = new(sql.NullInt64)
+		case goodsreceipt.ForeignKeys[3]: // warehouse_goods_receipts
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoodsReceipt fields.
+func (_m *GoodsReceipt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goodsreceipt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case goodsreceipt.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case goodsreceipt.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case goodsreceipt.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = value.Time
+			}
+		case goodsreceipt.FieldReceivedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field received_by", values[i])
+			} else if value.Valid {
+				_m.ReceivedBy = value.String
+			}
+		case goodsreceipt.FieldFreight:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field freight", values[i])
+			} else if value != nil {
+				_m.Freight = *value
+			}
+		case goodsreceipt.FieldDuty:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field duty", values[i])
+			} else if value != nil {
+				_m.Duty = *value
+			}
+		case goodsreceipt.FieldInsurance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field insurance", values[i])
+			} else if value != nil {
+				_m.Insurance = *value
+			}
+		case goodsreceipt.FieldAllocationMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field allocation_method", values[i])
+			} else if value.Valid {
+				_m.AllocationMethod = goodsreceipt.AllocationMethod(value.String)
+			}
+		case goodsreceipt.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case goodsreceipt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case goodsreceipt.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field goods_receipt_transaction", value)
+			} else if value.Valid {
+				_m.goods_receipt_transaction = new(int)
+				*_m.goods_receipt_transaction = int(value.Int64)
+			}
+		case goodsreceipt.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field purchase_order_receipts", value)
+			} else if value.Valid {
+				_m.purchase_order_receipts = new(int)
+				*_m.purchase_order_receipts = int(value.Int64)
+			}
+		case goodsreceipt.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_goods_receipts", value)
+			} else if value.Valid {
+				_m.tenant_goods_receipts = new(int)
+				*_m.tenant_goods_receipts = int(value.Int64)
+			}
+		case goodsreceipt.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_goods_receipts", value)
+			} else if value.Valid {
+				_m.warehouse_goods_receipts = new(int)
+				*_m.warehouse_goods_receipts = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoodsReceipt.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoodsReceipt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the GoodsReceipt entity.
+func (_m *GoodsReceipt) QueryTenant() *TenantQuery {
+	return NewGoodsReceiptClient(_m.config).QueryTenant(_m)
+}
+
+// QueryPurchaseOrder queries the "purchase_order" edge of the GoodsReceipt entity.
+func (_m *GoodsReceipt) QueryPurchaseOrder() *PurchaseOrderQuery {
+	return NewGoodsReceiptClient(_m.config).QueryPurchaseOrder(_m)
+}
+
+// QueryWarehouse queries the "warehouse" edge of the GoodsReceipt entity.
+func (_m *GoodsReceipt) QueryWarehouse() *WarehouseQuery {
+	return NewGoodsReceiptClient(_m.config).QueryWarehouse(_m)
+}
+
+// QueryLines queries the "lines" edge of the GoodsReceipt entity.
+func (_m *GoodsReceipt) QueryLines() *GoodsReceiptLineQuery {
+	return NewGoodsReceiptClient(_m.config).QueryLines(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the GoodsReceipt entity.
+func (_m *GoodsReceipt) QueryTransaction() *TransactionQuery {
+	return NewGoodsReceiptClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this GoodsReceipt.
+// Note that you need to call GoodsReceipt.Unwrap() before calling this method if this GoodsReceipt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoodsReceipt) Update() *GoodsReceiptUpdateOne {
+	return NewGoodsReceiptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoodsReceipt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoodsReceipt) Unwrap() *GoodsReceipt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GoodsReceipt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoodsReceipt) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoodsReceipt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(_m.ReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("received_by=")
+	builder.WriteString(_m.ReceivedBy)
+	builder.WriteString(", ")
+	builder.WriteString("freight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Freight))
+	builder.WriteString(", ")
+	builder.WriteString("duty=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Duty))
+	builder.WriteString(", ")
+	builder.WriteString("insurance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Insurance))
+	builder.WriteString(", ")
+	builder.WriteString("allocation_method=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllocationMethod))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoodsReceipts is a parsable slice of GoodsReceipt.
+type GoodsReceipts []*GoodsReceipt
@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goodsreceipt
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the goodsreceipt type in the database.
+	Label = "goods_receipt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldReceivedBy holds the string denoting the received_by field in the database.
+	FieldReceivedBy = "received_by"
+	// FieldFreight holds the string denoting the freight field in the database.
+	FieldFreight = "freight"
+	// FieldDuty holds the string denoting the duty field in the database.
+	FieldDuty = "duty"
+	// FieldInsurance holds the string denoting the insurance field in the database.
+	FieldInsurance = "insurance"
+	// FieldAllocationMethod holds the string denoting the allocation_method field in the database.
+	FieldAllocationMethod = "allocation_method"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgePurchaseOrder holds the string denoting the purchase_order edge name in mutations.
+	EdgePurchaseOrder = "purchase_order"
+	// EdgeWarehouse holds the string denoting the warehouse edge name in mutations.
+	EdgeWarehouse = "warehouse"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
+	// EdgeTransaction holds the string denoting the transaction edge name in mutations.
+	EdgeTransaction = "transaction"
+	// Table holds the table name of the goodsreceipt in the database.
+	Table = "goods_receipts"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "goods_receipts"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_goods_receipts"
+	// PurchaseOrderTable is the table that holds the purchase_order relation/edge.
+	PurchaseOrderTable = "goods_receipts"
+	// PurchaseOrderInverseTable is the table name for the PurchaseOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "purchaseorder" package.
+	PurchaseOrderInverseTable = "purchase_orders"
+	// PurchaseOrderColumn is the table column denoting the purchase_order relation/edge.
+	PurchaseOrderColumn = "purchase_order_receipts"
+	// WarehouseTable is the table that holds the warehouse relation/edge.
+	WarehouseTable = "goods_receipts"
+	// WarehouseInverseTable is the table name for the Warehouse entity.
+	// It exists in this package in order to avoid circular dependency with the "warehouse" package.
+	WarehouseInverseTable = "warehouses"
+	// WarehouseColumn is the table column denoting the warehouse relation/edge.
+	WarehouseColumn = "warehouse_goods_receipts"
+	// LinesTable is the table that holds the lines relation/edge.
+	LinesTable = "goods_receipt_lines"
+	// LinesInverseTable is the table name for the GoodsReceiptLine entity.
+	// It exists in this package in order to avoid circular dependency with the "goodsreceiptline" package.
+	LinesInverseTable = "goods_receipt_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "goods_receipt_lines"
+	// TransactionTable is the table that holds the transaction relation/edge.
+	TransactionTable = "goods_receipts"
+	// TransactionInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionInverseTable = "transactions"
+	// TransactionColumn is the table column denoting the transaction relation/edge.
+	TransactionColumn = "goods_receipt_transaction"
+)
+
+// Columns holds all SQL columns for goodsreceipt fields.
+var Columns = []string{
+	FieldID,
+	FieldNumber,
+	FieldReference,
+	FieldReceivedAt,
+	FieldReceivedBy,
+	FieldFreight,
+	FieldDuty,
+	FieldInsurance,
+	FieldAllocationMethod,
+	FieldNotes,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "goods_receipts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"goods_receipt_transaction",
+	"purchase_order_receipts",
+	"tenant_goods_receipts",
+	"warehouse_goods_receipts",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// DefaultFreight holds the default value on creation for the "freight" field.
+	DefaultFreight decimal.Decimal
+	// DefaultDuty holds the default value on creation for the "duty" field.
+	DefaultDuty decimal.Decimal
+	// DefaultInsurance holds the default value on creation for the "insurance" field.
+	DefaultInsurance decimal.Decimal
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// AllocationMethod defines the type for the "allocation_method" enum field.
+type AllocationMethod string
+
+// AllocationMethodValue is the default value of the AllocationMethod enum.
+const DefaultAllocationMethod = AllocationMethodValue
+
+// AllocationMethod values.
+const (
+	AllocationMethodValue  AllocationMethod = "value"
+	AllocationMethodWeight AllocationMethod = "weight"
+)
+
+func (am AllocationMethod) String() string {
+	return string(am)
+}
+
+// AllocationMethodValidator is a validator for the "allocation_method" field enum values. It is called by the builders before save.
+func AllocationMethodValidator(am AllocationMethod) error {
+	switch am {
+	case AllocationMethodValue, AllocationMethodWeight:
+		return nil
+	default:
+		return fmt.Errorf("goodsreceipt: invalid enum value for allocation_method field: %q", am)
+	}
+}
+
+// OrderOption defines the ordering options for the GoodsReceipt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByReceivedBy orders the results by the received_by field.
+func ByReceivedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedBy, opts...).ToFunc()
+}
+
+// ByFreight orders the results by the freight field.
+func ByFreight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreight, opts...).ToFunc()
+}
+
+// ByDuty orders the results by the duty field.
+func ByDuty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuty, opts...).ToFunc()
+}
+
+// ByInsurance orders the results by the insurance field.
+func ByInsurance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInsurance, opts...).ToFunc()
+}
+
+// ByAllocationMethod orders the results by the allocation_method field.
+func ByAllocationMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllocationMethod, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPurchaseOrderField orders the results by purchase_order field.
+func ByPurchaseOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPurchaseOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByWarehouseField orders the results by warehouse field.
+func ByWarehouseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWarehouseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLinesCount orders the results by lines count.
+func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinesStep(), opts...)
+	}
+}
+
+// ByLines orders the results by lines terms.
+func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransactionField orders the results by transaction field.
+func ByTransactionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newPurchaseOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PurchaseOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PurchaseOrderTable, PurchaseOrderColumn),
+	)
+}
+func newWarehouseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WarehouseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+	)
+}
+func newLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+	)
+}
+func newTransactionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+	)
+}
@@ -0,0 +1,742 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goodsreceipt
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldID, id))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldNumber, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldReference, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedBy applies equality check predicate on the "received_by" field. It's identical to ReceivedByEQ.
+func ReceivedBy(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldReceivedBy, v))
+}
+
+// Freight applies equality check predicate on the "freight" field. It's identical to FreightEQ.
+func Freight(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldFreight, v))
+}
+
+// Duty applies equality check predicate on the "duty" field. It's identical to DutyEQ.
+func Duty(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldDuty, v))
+}
+
+// Insurance applies equality check predicate on the "insurance" field. It's identical to InsuranceEQ.
+func Insurance(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldInsurance, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldNumber, v))
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldNumber, v))
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldNumber, v))
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldNumber, v))
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldNumber, v))
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldNumber, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldReference, v))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// ReceivedByEQ applies the EQ predicate on the "received_by" field.
+func ReceivedByEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldReceivedBy, v))
+}
+
+// ReceivedByNEQ applies the NEQ predicate on the "received_by" field.
+func ReceivedByNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldReceivedBy, v))
+}
+
+// ReceivedByIn applies the In predicate on the "received_by" field.
+func ReceivedByIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldReceivedBy, vs...))
+}
+
+// ReceivedByNotIn applies the NotIn predicate on the "received_by" field.
+func ReceivedByNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldReceivedBy, vs...))
+}
+
+// ReceivedByGT applies the GT predicate on the "received_by" field.
+func ReceivedByGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldReceivedBy, v))
+}
+
+// ReceivedByGTE applies the GTE predicate on the "received_by" field.
+func ReceivedByGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldReceivedBy, v))
+}
+
+// ReceivedByLT applies the LT predicate on the "received_by" field.
+func ReceivedByLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldReceivedBy, v))
+}
+
+// ReceivedByLTE applies the LTE predicate on the "received_by" field.
+func ReceivedByLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldReceivedBy, v))
+}
+
+// ReceivedByContains applies the Contains predicate on the "received_by" field.
+func ReceivedByContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldReceivedBy, v))
+}
+
+// ReceivedByHasPrefix applies the HasPrefix predicate on the "received_by" field.
+func ReceivedByHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldReceivedBy, v))
+}
+
+// ReceivedByHasSuffix applies the HasSuffix predicate on the "received_by" field.
+func ReceivedByHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldReceivedBy, v))
+}
+
+// ReceivedByIsNil applies the IsNil predicate on the "received_by" field.
+func ReceivedByIsNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIsNull(FieldReceivedBy))
+}
+
+// ReceivedByNotNil applies the NotNil predicate on the "received_by" field.
+func ReceivedByNotNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotNull(FieldReceivedBy))
+}
+
+// ReceivedByEqualFold applies the EqualFold predicate on the "received_by" field.
+func ReceivedByEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldReceivedBy, v))
+}
+
+// ReceivedByContainsFold applies the ContainsFold predicate on the "received_by" field.
+func ReceivedByContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldReceivedBy, v))
+}
+
+// FreightEQ applies the EQ predicate on the "freight" field.
+func FreightEQ(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldFreight, v))
+}
+
+// FreightNEQ applies the NEQ predicate on the "freight" field.
+func FreightNEQ(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldFreight, v))
+}
+
+// FreightIn applies the In predicate on the "freight" field.
+func FreightIn(vs ...decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldFreight, vs...))
+}
+
+// FreightNotIn applies the NotIn predicate on the "freight" field.
+func FreightNotIn(vs ...decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldFreight, vs...))
+}
+
+// FreightGT applies the GT predicate on the "freight" field.
+func FreightGT(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldFreight, v))
+}
+
+// FreightGTE applies the GTE predicate on the "freight" field.
+func FreightGTE(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldFreight, v))
+}
+
+// FreightLT applies the LT predicate on the "freight" field.
+func FreightLT(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldFreight, v))
+}
+
+// FreightLTE applies the LTE predicate on the "freight" field.
+func FreightLTE(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldFreight, v))
+}
+
+// DutyEQ applies the EQ predicate on the "duty" field.
+func DutyEQ(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldDuty, v))
+}
+
+// DutyNEQ applies the NEQ predicate on the "duty" field.
+func DutyNEQ(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldDuty, v))
+}
+
+// DutyIn applies the In predicate on the "duty" field.
+func DutyIn(vs ...decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldDuty, vs...))
+}
+
+// DutyNotIn applies the NotIn predicate on the "duty" field.
+func DutyNotIn(vs ...decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldDuty, vs...))
+}
+
+// DutyGT applies the GT predicate on the "duty" field.
+func DutyGT(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldDuty, v))
+}
+
+// DutyGTE applies the GTE predicate on the "duty" field.
+func DutyGTE(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldDuty, v))
+}
+
+// DutyLT applies the LT predicate on the "duty" field.
+func DutyLT(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldDuty, v))
+}
+
+// DutyLTE applies the LTE predicate on the "duty" field.
+func DutyLTE(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldDuty, v))
+}
+
+// InsuranceEQ applies the EQ predicate on the "insurance" field.
+func InsuranceEQ(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldInsurance, v))
+}
+
+// InsuranceNEQ applies the NEQ predicate on the "insurance" field.
+func InsuranceNEQ(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldInsurance, v))
+}
+
+// InsuranceIn applies the In predicate on the "insurance" field.
+func InsuranceIn(vs ...decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldInsurance, vs...))
+}
+
+// InsuranceNotIn applies the NotIn predicate on the "insurance" field.
+func InsuranceNotIn(vs ...decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldInsurance, vs...))
+}
+
+// InsuranceGT applies the GT predicate on the "insurance" field.
+func InsuranceGT(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldInsurance, v))
+}
+
+// InsuranceGTE applies the GTE predicate on the "insurance" field.
+func InsuranceGTE(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldInsurance, v))
+}
+
+// InsuranceLT applies the LT predicate on the "insurance" field.
+func InsuranceLT(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldInsurance, v))
+}
+
+// InsuranceLTE applies the LTE predicate on the "insurance" field.
+func InsuranceLTE(v decimal.Decimal) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldInsurance, v))
+}
+
+// AllocationMethodEQ applies the EQ predicate on the "allocation_method" field.
+func AllocationMethodEQ(v AllocationMethod) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldAllocationMethod, v))
+}
+
+// AllocationMethodNEQ applies the NEQ predicate on the "allocation_method" field.
+func AllocationMethodNEQ(v AllocationMethod) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldAllocationMethod, v))
+}
+
+// AllocationMethodIn applies the In predicate on the "allocation_method" field.
+func AllocationMethodIn(vs ...AllocationMethod) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldAllocationMethod, vs...))
+}
+
+// AllocationMethodNotIn applies the NotIn predicate on the "allocation_method" field.
+func AllocationMethodNotIn(vs ...AllocationMethod) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldAllocationMethod, vs...))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPurchaseOrder applies the HasEdge predicate on the "purchase_order" edge.
+func HasPurchaseOrder() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PurchaseOrderTable, PurchaseOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPurchaseOrderWith applies the HasEdge predicate on the "purchase_order" edge with a given conditions (other predicates).
+func HasPurchaseOrderWith(preds ...predicate.PurchaseOrder) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := newPurchaseOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWarehouse applies the HasEdge predicate on the "warehouse" edge.
+func HasWarehouse() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarehouseWith applies the HasEdge predicate on the "warehouse" edge with a given conditions (other predicates).
+func HasWarehouseWith(preds ...predicate.Warehouse) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := newWarehouseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLines applies the HasEdge predicate on the "lines" edge.
+func HasLines() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinesWith applies the HasEdge predicate on the "lines" edge with a given conditions (other predicates).
+func HasLinesWith(preds ...predicate.GoodsReceiptLine) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := newLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoodsReceipt) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoodsReceipt) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoodsReceipt) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.NotPredicates(p))
+}
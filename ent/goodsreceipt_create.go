@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/goodsreceipt"
+	"sent/ent/goodsreceiptline"
+	"sent/ent/purchaseorder"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/warehouse"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptCreate is the builder for creating a GoodsReceipt entity.
+type GoodsReceiptCreate struct {
+	config
+	mutation *GoodsReceiptMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (_c *GoodsReceiptCreate) SetNumber(v string) *GoodsReceiptCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *GoodsReceiptCreate) SetReference(v string) *GoodsReceiptCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableReference(v *string) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *GoodsReceiptCreate) SetReceivedAt(v time.Time) *GoodsReceiptCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableReceivedAt(v *time.Time) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetReceivedBy sets the "received_by" field.
+func (_c *GoodsReceiptCreate) SetReceivedBy(v string) *GoodsReceiptCreate {
+	_c.mutation.SetReceivedBy(v)
+	return _c
+}
+
+// SetNillableReceivedBy sets the "received_by" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableReceivedBy(v *string) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetReceivedBy(*v)
+	}
+	return _c
+}
+
+// SetFreight sets the "freight" field.
+func (_c *GoodsReceiptCreate) SetFreight(v decimal.Decimal) *GoodsReceiptCreate {
+	_c.mutation.SetFreight(v)
+	return _c
+}
+
+// SetNillableFreight sets the "freight" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableFreight(v *decimal.Decimal) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetFreight(*v)
+	}
+	return _c
+}
+
+// SetDuty sets the "duty" field.
+func (_c *GoodsReceiptCreate) SetDuty(v decimal.Decimal) *GoodsReceiptCreate {
+	_c.mutation.SetDuty(v)
+	return _c
+}
+
+// SetNillableDuty sets the "duty" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableDuty(v *decimal.Decimal) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetDuty(*v)
+	}
+	return _c
+}
+
+// SetInsurance sets the "insurance" field.
+func (_c *GoodsReceiptCreate) SetInsurance(v decimal.Decimal) *GoodsReceiptCreate {
+	_c.mutation.SetInsurance(v)
+	return _c
+}
+
+// SetNillableInsurance sets the "insurance" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableInsurance(v *decimal.Decimal) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetInsurance(*v)
+	}
+	return _c
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (_c *GoodsReceiptCreate) SetAllocationMethod(v goodsreceipt.AllocationMethod) *GoodsReceiptCreate {
+	_c.mutation.SetAllocationMethod(v)
+	return _c
+}
+
+// SetNillableAllocationMethod sets the "allocation_method" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableAllocationMethod(v *goodsreceipt.AllocationMethod) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetAllocationMethod(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *GoodsReceiptCreate) SetNotes(v string) *GoodsReceiptCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableNotes(v *string) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoodsReceiptCreate) SetCreatedAt(v time.Time) *GoodsReceiptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableCreatedAt(v *time.Time) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *GoodsReceiptCreate) SetTenantID(id int) *GoodsReceiptCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *GoodsReceiptCreate) SetTenant(v *Tenant) *GoodsReceiptCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
+func (_c *GoodsReceiptCreate) SetPurchaseOrderID(id int) *GoodsReceiptCreate {
+	_c.mutation.SetPurchaseOrderID(id)
+	return _c
+}
+
+// SetPurchaseOrder sets the "purchase_order" edge to the PurchaseOrder entity.
+func (_c *GoodsReceiptCreate) SetPurchaseOrder(v *PurchaseOrder) *GoodsReceiptCreate {
+	return _c.SetPurchaseOrderID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_c *GoodsReceiptCreate) SetWarehouseID(id int) *GoodsReceiptCreate {
+	_c.mutation.SetWarehouseID(id)
+	return _c
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_c *GoodsReceiptCreate) SetWarehouse(v *Warehouse) *GoodsReceiptCreate {
+	return _c.SetWarehouseID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the GoodsReceiptLine entity by IDs.
+func (_c *GoodsReceiptCreate) AddLineIDs(ids ...int) *GoodsReceiptCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the GoodsReceiptLine entity.
+func (_c *GoodsReceiptCreate) AddLines(v ...*GoodsReceiptLine) *GoodsReceiptCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *GoodsReceiptCreate) SetTransactionID(id int) *GoodsReceiptCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableTransactionID(id *int) *GoodsReceiptCreate {
+	if id != nil {
+		_c = _c.SetTransactionID(*id)
+	}
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *GoodsReceiptCreate) SetTransaction(v *Transaction) *GoodsReceiptCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptMutation object of the builder.
+func (_c *GoodsReceiptCreate) Mutation() *GoodsReceiptMutation {
+	return _c.mutation
+}
+
+// Save creates the GoodsReceipt in the database.
+func (_c *GoodsReceiptCreate) Save(ctx context.Context) (*GoodsReceipt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoodsReceiptCreate) SaveX(ctx context.Context) *GoodsReceipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoodsReceiptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoodsReceiptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoodsReceiptCreate) defaults() {
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		v := goodsreceipt.DefaultReceivedAt()
+		_c.mutation.SetReceivedAt(v)
+	}
+	if _, ok := _c.mutation.Freight(); !ok {
+		v := goodsreceipt.DefaultFreight
+		_c.mutation.SetFreight(v)
+	}
+	if _, ok := _c.mutation.Duty(); !ok {
+		v := goodsreceipt.DefaultDuty
+		_c.mutation.SetDuty(v)
+	}
+	if _, ok := _c.mutation.Insurance(); !ok {
+		v := goodsreceipt.DefaultInsurance
+		_c.mutation.SetInsurance(v)
+	}
+	if _, ok := _c.mutation.AllocationMethod(); !ok {
+		v := goodsreceipt.DefaultAllocationMethod
+		_c.mutation.SetAllocationMethod(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := goodsreceipt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoodsReceiptCreate) check() error {
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "GoodsReceipt.number"`)}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "GoodsReceipt.received_at"`)}
+	}
+	if _, ok := _c.mutation.Freight(); !ok {
+		return &ValidationError{Name: "freight", err: errors.New(`ent: missing required field "GoodsReceipt.freight"`)}
+	}
+	if _, ok := _c.mutation.Duty(); !ok {
+		return &ValidationError{Name: "duty", err: errors.New(`ent: missing required field "GoodsReceipt.duty"`)}
+	}
+	if _, ok := _c.mutation.Insurance(); !ok {
+		return &ValidationError{Name: "insurance", err: errors.New(`ent: missing required field "GoodsReceipt.insurance"`)}
+	}
+	if _, ok := _c.mutation.AllocationMethod(); !ok {
+		return &ValidationError{Name: "allocation_method", err: errors.New(`ent: missing required field "GoodsReceipt.allocation_method"`)}
+	}
+	if v, ok := _c.mutation.AllocationMethod(); ok {
+		if err := goodsreceipt.AllocationMethodValidator(v); err != nil {
+			return &ValidationError{Name: "allocation_method", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.allocation_method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GoodsReceipt.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "GoodsReceipt.tenant"`)}
+	}
+	if len(_c.mutation.PurchaseOrderIDs()) == 0 {
+		return &ValidationError{Name: "purchase_order", err: errors.New(`ent: missing required edge "GoodsReceipt.purchase_order"`)}
+	}
+	if len(_c.mutation.WarehouseIDs()) == 0 {
+		return &ValidationError{Name: "warehouse", err: errors.New(`ent: missing required edge "GoodsReceipt.warehouse"`)}
+	}
+	return nil
+}
+
+func (_c *GoodsReceiptCreate) sqlSave(ctx context.Context) (*GoodsReceipt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoodsReceiptCreate) createSpec() (*GoodsReceipt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoodsReceipt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goodsreceipt.Table, sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(goodsreceipt.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(goodsreceipt.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := _c.mutation.ReceivedBy(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedBy, field.TypeString, value)
+		_node.ReceivedBy = value
+	}
+	if value, ok := _c.mutation.Freight(); ok {
+		_spec.SetField(goodsreceipt.FieldFreight, field.TypeOther, value)
+		_node.Freight = value
+	}
+	if value, ok := _c.mutation.Duty(); ok {
+		_spec.SetField(goodsreceipt.FieldDuty, field.TypeOther, value)
+		_node.Duty = value
+	}
+	if value, ok := _c.mutation.Insurance(); ok {
+		_spec.SetField(goodsreceipt.FieldInsurance, field.TypeOther, value)
+		_node.Insurance = value
+	}
+	if value, ok := _c.mutation.AllocationMethod(); ok {
+		_spec.SetField(goodsreceipt.FieldAllocationMethod, field.TypeEnum, value)
+		_node.AllocationMethod = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(goodsreceipt.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goodsreceipt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.TenantTable,
+			Columns: []string{goodsreceipt.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_goods_receipts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PurchaseOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.PurchaseOrderTable,
+			Columns: []string{goodsreceipt.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.purchase_order_receipts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.WarehouseTable,
+			Columns: []string{goodsreceipt.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_goods_receipts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceipt.TransactionTable,
+			Columns: []string{goodsreceipt.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.goods_receipt_transaction = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GoodsReceiptCreateBulk is the builder for creating many GoodsReceipt entities in bulk.
+type GoodsReceiptCreateBulk struct {
+	config
+	err      error
+	builders []*GoodsReceiptCreate
+}
+
+// Save creates the GoodsReceipt entities in the database.
+func (_c *GoodsReceiptCreateBulk) Save(ctx context.Context) ([]*GoodsReceipt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoodsReceipt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoodsReceiptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoodsReceiptCreateBulk) SaveX(ctx context.Context) []*GoodsReceipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoodsReceiptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoodsReceiptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
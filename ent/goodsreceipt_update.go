@@ -0,0 +1,1148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/goodsreceipt"
+	"sent/ent/goodsreceiptline"
+	"sent/ent/predicate"
+	"sent/ent/purchaseorder"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/warehouse"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptUpdate is the builder for updating GoodsReceipt entities.
+type GoodsReceiptUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GoodsReceiptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GoodsReceiptUpdate builder.
+func (_u *GoodsReceiptUpdate) Where(ps ...predicate.GoodsReceipt) *GoodsReceiptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *GoodsReceiptUpdate) SetNumber(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableNumber(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *GoodsReceiptUpdate) SetReference(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableReference(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *GoodsReceiptUpdate) ClearReference() *GoodsReceiptUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *GoodsReceiptUpdate) SetReceivedAt(v time.Time) *GoodsReceiptUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableReceivedAt(v *time.Time) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetReceivedBy sets the "received_by" field.
+func (_u *GoodsReceiptUpdate) SetReceivedBy(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetReceivedBy(v)
+	return _u
+}
+
+// SetNillableReceivedBy sets the "received_by" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableReceivedBy(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetReceivedBy(*v)
+	}
+	return _u
+}
+
+// ClearReceivedBy clears the value of the "received_by" field.
+func (_u *GoodsReceiptUpdate) ClearReceivedBy() *GoodsReceiptUpdate {
+	_u.mutation.ClearReceivedBy()
+	return _u
+}
+
+// SetFreight sets the "freight" field.
+func (_u *GoodsReceiptUpdate) SetFreight(v decimal.Decimal) *GoodsReceiptUpdate {
+	_u.mutation.SetFreight(v)
+	return _u
+}
+
+// SetNillableFreight sets the "freight" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableFreight(v *decimal.Decimal) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetFreight(*v)
+	}
+	return _u
+}
+
+// SetDuty sets the "duty" field.
+func (_u *GoodsReceiptUpdate) SetDuty(v decimal.Decimal) *GoodsReceiptUpdate {
+	_u.mutation.SetDuty(v)
+	return _u
+}
+
+// SetNillableDuty sets the "duty" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableDuty(v *decimal.Decimal) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetDuty(*v)
+	}
+	return _u
+}
+
+// SetInsurance sets the "insurance" field.
+func (_u *GoodsReceiptUpdate) SetInsurance(v decimal.Decimal) *GoodsReceiptUpdate {
+	_u.mutation.SetInsurance(v)
+	return _u
+}
+
+// SetNillableInsurance sets the "insurance" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableInsurance(v *decimal.Decimal) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetInsurance(*v)
+	}
+	return _u
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (_u *GoodsReceiptUpdate) SetAllocationMethod(v goodsreceipt.AllocationMethod) *GoodsReceiptUpdate {
+	_u.mutation.SetAllocationMethod(v)
+	return _u
+}
+
+// SetNillableAllocationMethod sets the "allocation_method" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableAllocationMethod(v *goodsreceipt.AllocationMethod) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetAllocationMethod(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *GoodsReceiptUpdate) SetNotes(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableNotes(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *GoodsReceiptUpdate) ClearNotes() *GoodsReceiptUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *GoodsReceiptUpdate) SetTenantID(id int) *GoodsReceiptUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *GoodsReceiptUpdate) SetTenant(v *Tenant) *GoodsReceiptUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
+func (_u *GoodsReceiptUpdate) SetPurchaseOrderID(id int) *GoodsReceiptUpdate {
+	_u.mutation.SetPurchaseOrderID(id)
+	return _u
+}
+
+// SetPurchaseOrder sets the "purchase_order" edge to the PurchaseOrder entity.
+func (_u *GoodsReceiptUpdate) SetPurchaseOrder(v *PurchaseOrder) *GoodsReceiptUpdate {
+	return _u.SetPurchaseOrderID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *GoodsReceiptUpdate) SetWarehouseID(id int) *GoodsReceiptUpdate {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *GoodsReceiptUpdate) SetWarehouse(v *Warehouse) *GoodsReceiptUpdate {
+	return _u.SetWarehouseID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the GoodsReceiptLine entity by IDs.
+func (_u *GoodsReceiptUpdate) AddLineIDs(ids ...int) *GoodsReceiptUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the GoodsReceiptLine entity.
+func (_u *GoodsReceiptUpdate) AddLines(v ...*GoodsReceiptLine) *GoodsReceiptUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *GoodsReceiptUpdate) SetTransactionID(id int) *GoodsReceiptUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableTransactionID(id *int) *GoodsReceiptUpdate {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *GoodsReceiptUpdate) SetTransaction(v *Transaction) *GoodsReceiptUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptMutation object of the builder.
+func (_u *GoodsReceiptUpdate) Mutation() *GoodsReceiptMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *GoodsReceiptUpdate) ClearTenant() *GoodsReceiptUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearPurchaseOrder clears the "purchase_order" edge to the PurchaseOrder entity.
+func (_u *GoodsReceiptUpdate) ClearPurchaseOrder() *GoodsReceiptUpdate {
+	_u.mutation.ClearPurchaseOrder()
+	return _u
+}
+
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *GoodsReceiptUpdate) ClearWarehouse() *GoodsReceiptUpdate {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the GoodsReceiptLine entity.
+func (_u *GoodsReceiptUpdate) ClearLines() *GoodsReceiptUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to GoodsReceiptLine entities by IDs.
+func (_u *GoodsReceiptUpdate) RemoveLineIDs(ids ...int) *GoodsReceiptUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to GoodsReceiptLine entities.
+func (_u *GoodsReceiptUpdate) RemoveLines(v ...*GoodsReceiptLine) *GoodsReceiptUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *GoodsReceiptUpdate) ClearTransaction() *GoodsReceiptUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoodsReceiptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoodsReceiptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoodsReceiptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoodsReceiptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoodsReceiptUpdate) check() error {
+	if v, ok := _u.mutation.AllocationMethod(); ok {
+		if err := goodsreceipt.AllocationMethodValidator(v); err != nil {
+			return &ValidationError{Name: "allocation_method", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.allocation_method": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceipt.tenant"`)
+	}
+	if _u.mutation.PurchaseOrderCleared() && len(_u.mutation.PurchaseOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceipt.purchase_order"`)
+	}
+	if _u.mutation.WarehouseCleared() && len(_u.mutation.WarehouseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceipt.warehouse"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoodsReceiptUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoodsReceiptUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GoodsReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goodsreceipt.Table, goodsreceipt.Columns, sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(goodsreceipt.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(goodsreceipt.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(goodsreceipt.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReceivedBy(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedBy, field.TypeString, value)
+	}
+	if _u.mutation.ReceivedByCleared() {
+		_spec.ClearField(goodsreceipt.FieldReceivedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Freight(); ok {
+		_spec.SetField(goodsreceipt.FieldFreight, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Duty(); ok {
+		_spec.SetField(goodsreceipt.FieldDuty, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Insurance(); ok {
+		_spec.SetField(goodsreceipt.FieldInsurance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AllocationMethod(); ok {
+		_spec.SetField(goodsreceipt.FieldAllocationMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(goodsreceipt.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(goodsreceipt.FieldNotes, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.TenantTable,
+			Columns: []string{goodsreceipt.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.TenantTable,
+			Columns: []string{goodsreceipt.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchaseOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.PurchaseOrderTable,
+			Columns: []string{goodsreceipt.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchaseOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.PurchaseOrderTable,
+			Columns: []string{goodsreceipt.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.WarehouseTable,
+			Columns: []string{goodsreceipt.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.WarehouseTable,
+			Columns: []string{goodsreceipt.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceipt.TransactionTable,
+			Columns: []string{goodsreceipt.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceipt.TransactionTable,
+			Columns: []string{goodsreceipt.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goodsreceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoodsReceiptUpdateOne is the builder for updating a single GoodsReceipt entity.
+type GoodsReceiptUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GoodsReceiptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetNumber sets the "number" field.
+func (_u *GoodsReceiptUpdateOne) SetNumber(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableNumber(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *GoodsReceiptUpdateOne) SetReference(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableReference(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *GoodsReceiptUpdateOne) ClearReference() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *GoodsReceiptUpdateOne) SetReceivedAt(v time.Time) *GoodsReceiptUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableReceivedAt(v *time.Time) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetReceivedBy sets the "received_by" field.
+func (_u *GoodsReceiptUpdateOne) SetReceivedBy(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetReceivedBy(v)
+	return _u
+}
+
+// SetNillableReceivedBy sets the "received_by" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableReceivedBy(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetReceivedBy(*v)
+	}
+	return _u
+}
+
+// ClearReceivedBy clears the value of the "received_by" field.
+func (_u *GoodsReceiptUpdateOne) ClearReceivedBy() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearReceivedBy()
+	return _u
+}
+
+// SetFreight sets the "freight" field.
+func (_u *GoodsReceiptUpdateOne) SetFreight(v decimal.Decimal) *GoodsReceiptUpdateOne {
+	_u.mutation.SetFreight(v)
+	return _u
+}
+
+// SetNillableFreight sets the "freight" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableFreight(v *decimal.Decimal) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetFreight(*v)
+	}
+	return _u
+}
+
+// SetDuty sets the "duty" field.
+func (_u *GoodsReceiptUpdateOne) SetDuty(v decimal.Decimal) *GoodsReceiptUpdateOne {
+	_u.mutation.SetDuty(v)
+	return _u
+}
+
+// SetNillableDuty sets the "duty" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableDuty(v *decimal.Decimal) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetDuty(*v)
+	}
+	return _u
+}
+
+// SetInsurance sets the "insurance" field.
+func (_u *GoodsReceiptUpdateOne) SetInsurance(v decimal.Decimal) *GoodsReceiptUpdateOne {
+	_u.mutation.SetInsurance(v)
+	return _u
+}
+
+// SetNillableInsurance sets the "insurance" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableInsurance(v *decimal.Decimal) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetInsurance(*v)
+	}
+	return _u
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (_u *GoodsReceiptUpdateOne) SetAllocationMethod(v goodsreceipt.AllocationMethod) *GoodsReceiptUpdateOne {
+	_u.mutation.SetAllocationMethod(v)
+	return _u
+}
+
+// SetNillableAllocationMethod sets the "allocation_method" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableAllocationMethod(v *goodsreceipt.AllocationMethod) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetAllocationMethod(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *GoodsReceiptUpdateOne) SetNotes(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableNotes(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *GoodsReceiptUpdateOne) ClearNotes() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *GoodsReceiptUpdateOne) SetTenantID(id int) *GoodsReceiptUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *GoodsReceiptUpdateOne) SetTenant(v *Tenant) *GoodsReceiptUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
+func (_u *GoodsReceiptUpdateOne) SetPurchaseOrderID(id int) *GoodsReceiptUpdateOne {
+	_u.mutation.SetPurchaseOrderID(id)
+	return _u
+}
+
+// SetPurchaseOrder sets the "purchase_order" edge to the PurchaseOrder entity.
+func (_u *GoodsReceiptUpdateOne) SetPurchaseOrder(v *PurchaseOrder) *GoodsReceiptUpdateOne {
+	return _u.SetPurchaseOrderID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *GoodsReceiptUpdateOne) SetWarehouseID(id int) *GoodsReceiptUpdateOne {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *GoodsReceiptUpdateOne) SetWarehouse(v *Warehouse) *GoodsReceiptUpdateOne {
+	return _u.SetWarehouseID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the GoodsReceiptLine entity by IDs.
+func (_u *GoodsReceiptUpdateOne) AddLineIDs(ids ...int) *GoodsReceiptUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the GoodsReceiptLine entity.
+func (_u *GoodsReceiptUpdateOne) AddLines(v ...*GoodsReceiptLine) *GoodsReceiptUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *GoodsReceiptUpdateOne) SetTransactionID(id int) *GoodsReceiptUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableTransactionID(id *int) *GoodsReceiptUpdateOne {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *GoodsReceiptUpdateOne) SetTransaction(v *Transaction) *GoodsReceiptUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptMutation object of the builder.
+func (_u *GoodsReceiptUpdateOne) Mutation() *GoodsReceiptMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *GoodsReceiptUpdateOne) ClearTenant() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearPurchaseOrder clears the "purchase_order" edge to the PurchaseOrder entity.
+func (_u *GoodsReceiptUpdateOne) ClearPurchaseOrder() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearPurchaseOrder()
+	return _u
+}
+
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *GoodsReceiptUpdateOne) ClearWarehouse() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the GoodsReceiptLine entity.
+func (_u *GoodsReceiptUpdateOne) ClearLines() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to GoodsReceiptLine entities by IDs.
+func (_u *GoodsReceiptUpdateOne) RemoveLineIDs(ids ...int) *GoodsReceiptUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to GoodsReceiptLine entities.
+func (_u *GoodsReceiptUpdateOne) RemoveLines(v ...*GoodsReceiptLine) *GoodsReceiptUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *GoodsReceiptUpdateOne) ClearTransaction() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Where appends a list predicates to the GoodsReceiptUpdate builder.
+func (_u *GoodsReceiptUpdateOne) Where(ps ...predicate.GoodsReceipt) *GoodsReceiptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoodsReceiptUpdateOne) Select(field string, fields ...string) *GoodsReceiptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoodsReceipt entity.
+func (_u *GoodsReceiptUpdateOne) Save(ctx context.Context) (*GoodsReceipt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoodsReceiptUpdateOne) SaveX(ctx context.Context) *GoodsReceipt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoodsReceiptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoodsReceiptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoodsReceiptUpdateOne) check() error {
+	if v, ok := _u.mutation.AllocationMethod(); ok {
+		if err := goodsreceipt.AllocationMethodValidator(v); err != nil {
+			return &ValidationError{Name: "allocation_method", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.allocation_method": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceipt.tenant"`)
+	}
+	if _u.mutation.PurchaseOrderCleared() && len(_u.mutation.PurchaseOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceipt.purchase_order"`)
+	}
+	if _u.mutation.WarehouseCleared() && len(_u.mutation.WarehouseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceipt.warehouse"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoodsReceiptUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoodsReceiptUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GoodsReceiptUpdateOne) sqlSave(ctx context.Context) (_node *GoodsReceipt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goodsreceipt.Table, goodsreceipt.Columns, sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GoodsReceipt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goodsreceipt.FieldID)
+		for _, f := range fields {
+			if !goodsreceipt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goodsreceipt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(goodsreceipt.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(goodsreceipt.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(goodsreceipt.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReceivedBy(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedBy, field.TypeString, value)
+	}
+	if _u.mutation.ReceivedByCleared() {
+		_spec.ClearField(goodsreceipt.FieldReceivedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Freight(); ok {
+		_spec.SetField(goodsreceipt.FieldFreight, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Duty(); ok {
+		_spec.SetField(goodsreceipt.FieldDuty, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Insurance(); ok {
+		_spec.SetField(goodsreceipt.FieldInsurance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AllocationMethod(); ok {
+		_spec.SetField(goodsreceipt.FieldAllocationMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(goodsreceipt.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(goodsreceipt.FieldNotes, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.TenantTable,
+			Columns: []string{goodsreceipt.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.TenantTable,
+			Columns: []string{goodsreceipt.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchaseOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.PurchaseOrderTable,
+			Columns: []string{goodsreceipt.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchaseOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.PurchaseOrderTable,
+			Columns: []string{goodsreceipt.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.WarehouseTable,
+			Columns: []string{goodsreceipt.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceipt.WarehouseTable,
+			Columns: []string{goodsreceipt.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceipt.TransactionTable,
+			Columns: []string{goodsreceipt.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceipt.TransactionTable,
+			Columns: []string{goodsreceipt.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &GoodsReceipt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goodsreceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/goodsreceipt"
+	"sent/ent/goodsreceiptline"
+	"sent/ent/purchaseorderline"
+	"sent/ent/stockmovement"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptLine is the model entity for the GoodsReceiptLine schema.
+type GoodsReceiptLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// UnitCost holds the value of the "unit_cost" field.
+	UnitCost decimal.Decimal `json:"unit_cost,omitempty"`
+	// Weight holds the value of the "weight" field.
+	Weight decimal.Decimal `json:"weight,omitempty"`
+	// LandedCost holds the value of the "landed_cost" field.
+	LandedCost decimal.Decimal `json:"landed_cost,omitempty"`
+	// LandedUnitCost holds the value of the "landed_unit_cost" field.
+	LandedUnitCost decimal.Decimal `json:"landed_unit_cost,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoodsReceiptLineQuery when eager-loading is set.
+	Edges                             GoodsReceiptLineEdges `json:"edges"`
+	goods_receipt_lines               *int
+	goods_receipt_line_stock_movement *int
+	purchase_order_line_receipt_lines *int
+	selectValues                      sql.SelectValues
+}
+
+// GoodsReceiptLineEdges holds the relations/edges for other nodes in the graph.
+type GoodsReceiptLineEdges struct {
+	// Receipt holds the value of the receipt edge.
+	Receipt *GoodsReceipt `json:"receipt,omitempty"`
+	// PurchaseOrderLine holds the value of the purchase_order_line edge.
+	PurchaseOrderLine *PurchaseOrderLine `json:"purchase_order_line,omitempty"`
+	// StockMovement holds the value of the stock_movement edge.
+	StockMovement *StockMovement `json:"stock_movement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ReceiptOrErr returns the Receipt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoodsReceiptLineEdges) ReceiptOrErr() (*GoodsReceipt, error) {
+	if e.Receipt != nil {
+		return e.Receipt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: goodsreceipt.Label}
+	}
+	return nil, &NotLoadedError{edge: "receipt"}
+}
+
+// PurchaseOrderLineOrErr returns the PurchaseOrderLine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoodsReceiptLineEdges) PurchaseOrderLineOrErr() (*PurchaseOrderLine, error) {
+	if e.PurchaseOrderLine != nil {
+		return e.PurchaseOrderLine, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: purchaseorderline.Label}
+	}
+	return nil, &NotLoadedError{edge: "purchase_order_line"}
+}
+
+// StockMovementOrErr returns the StockMovement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoodsReceiptLineEdges) StockMovementOrErr() (*StockMovement, error) {
+	if e.StockMovement != nil {
+		return e.StockMovement, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: stockmovement.Label}
+	}
+	return nil, &NotLoadedError{edge: "stock_movement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoodsReceiptLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goodsreceiptline.FieldUnitCost, goodsreceiptline.FieldWeight, goodsreceiptline.FieldLandedCost, goodsreceiptline.FieldLandedUnitCost:
+			values[i] = new(decimal.Decimal)
+		case goodsreceiptline.FieldID, goodsreceiptline.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case goodsreceiptline.ForeignKeys[0]: // goods_receipt_lines
+			values[i] = new(sql.NullInt64)
+		case goodsreceiptline.ForeignKeys[1]: // goods_receipt_line_stock_movement
+			values[i] = new(sql.NullInt64)
+		case goodsreceiptline.ForeignKeys[2]: // purchase_order_line_receipt_lines
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoodsReceiptLine fields.
+func (_m *GoodsReceiptLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goodsreceiptline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case goodsreceiptline.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case goodsreceiptline.FieldUnitCost:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
+			} else if value != nil {
+				_m.UnitCost = *value
+			}
+		case goodsreceiptline.FieldWeight:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value != nil {
+				_m.Weight = *value
+			}
+		case goodsreceiptline.FieldLandedCost:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field landed_cost", values[i])
+			} else if value != nil {
+				_m.LandedCost = *value
+			}
+		case goodsreceiptline.FieldLandedUnitCost:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field landed_unit_cost", values[i])
+			} else if value != nil {
+				_m.LandedUnitCost = *value
+			}
+		case goodsreceiptline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field goods_receipt_lines", value)
+			} else if value.Valid {
+				_m.goods_receipt_lines = new(int)
+				*_m.goods_receipt_lines = int(value.Int64)
+			}
+		case goodsreceiptline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field goods_receipt_line_stock_movement", value)
+			} else if value.Valid {
+				_m.goods_receipt_line_stock_movement = new(int)
+				*_m.goods_receipt_line_stock_movement = int(value.Int64)
+			}
+		case goodsreceiptline.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field purchase_order_line_receipt_lines", value)
+			} else if value.Valid {
+				_m.purchase_order_line_receipt_lines = new(int)
+				*_m.purchase_order_line_receipt_lines = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoodsReceiptLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoodsReceiptLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReceipt queries the "receipt" edge of the GoodsReceiptLine entity.
+func (_m *GoodsReceiptLine) QueryReceipt() *GoodsReceiptQuery {
+	return NewGoodsReceiptLineClient(_m.config).QueryReceipt(_m)
+}
+
+// QueryPurchaseOrderLine queries the "purchase_order_line" edge of the GoodsReceiptLine entity.
+func (_m *GoodsReceiptLine) QueryPurchaseOrderLine() *PurchaseOrderLineQuery {
+	return NewGoodsReceiptLineClient(_m.config).QueryPurchaseOrderLine(_m)
+}
+
+// QueryStockMovement queries the "stock_movement" edge of the GoodsReceiptLine entity.
+func (_m *GoodsReceiptLine) QueryStockMovement() *StockMovementQuery {
+	return NewGoodsReceiptLineClient(_m.config).QueryStockMovement(_m)
+}
+
+// Update returns a builder for updating this GoodsReceiptLine.
+// Note that you need to call GoodsReceiptLine.Unwrap() before calling this method if this GoodsReceiptLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoodsReceiptLine) Update() *GoodsReceiptLineUpdateOne {
+	return NewGoodsReceiptLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoodsReceiptLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoodsReceiptLine) Unwrap() *GoodsReceiptLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GoodsReceiptLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoodsReceiptLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoodsReceiptLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitCost))
+	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("landed_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LandedCost))
+	builder.WriteString(", ")
+	builder.WriteString("landed_unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LandedUnitCost))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoodsReceiptLines is a parsable slice of GoodsReceiptLine.
+type GoodsReceiptLines []*GoodsReceiptLine
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goodsreceiptline
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the goodsreceiptline type in the database.
+	Label = "goods_receipt_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitCost holds the string denoting the unit_cost field in the database.
+	FieldUnitCost = "unit_cost"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldLandedCost holds the string denoting the landed_cost field in the database.
+	FieldLandedCost = "landed_cost"
+	// FieldLandedUnitCost holds the string denoting the landed_unit_cost field in the database.
+	FieldLandedUnitCost = "landed_unit_cost"
+	// EdgeReceipt holds the string denoting the receipt edge name in mutations.
+	EdgeReceipt = "receipt"
+	// EdgePurchaseOrderLine holds the string denoting the purchase_order_line edge name in mutations.
+	EdgePurchaseOrderLine = "purchase_order_line"
+	// EdgeStockMovement holds the string denoting the stock_movement edge name in mutations.
+	EdgeStockMovement = "stock_movement"
+	// Table holds the table name of the goodsreceiptline in the database.
+	Table = "goods_receipt_lines"
+	// ReceiptTable is the table that holds the receipt relation/edge.
+	ReceiptTable = "goods_receipt_lines"
+	// ReceiptInverseTable is the table name for the GoodsReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "goodsreceipt" package.
+	ReceiptInverseTable = "goods_receipts"
+	// ReceiptColumn is the table column denoting the receipt relation/edge.
+	ReceiptColumn = "goods_receipt_lines"
+	// PurchaseOrderLineTable is the table that holds the purchase_order_line relation/edge.
+	PurchaseOrderLineTable = "goods_receipt_lines"
+	// PurchaseOrderLineInverseTable is the table name for the PurchaseOrderLine entity.
+	// It exists in this package in order to avoid circular dependency with the "purchaseorderline" package.
+	PurchaseOrderLineInverseTable = "purchase_order_lines"
+	// PurchaseOrderLineColumn is the table column denoting the purchase_order_line relation/edge.
+	PurchaseOrderLineColumn = "purchase_order_line_receipt_lines"
+	// StockMovementTable is the table that holds the stock_movement relation/edge.
+	StockMovementTable = "goods_receipt_lines"
+	// StockMovementInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	StockMovementInverseTable = "stock_movements"
+	// StockMovementColumn is the table column denoting the stock_movement relation/edge.
+	StockMovementColumn = "goods_receipt_line_stock_movement"
+)
+
+// Columns holds all SQL columns for goodsreceiptline fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+	FieldUnitCost,
+	FieldWeight,
+	FieldLandedCost,
+	FieldLandedUnitCost,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "goods_receipt_lines"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"goods_receipt_lines",
+	"goods_receipt_line_stock_movement",
+	"purchase_order_line_receipt_lines",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// DefaultUnitCost holds the default value on creation for the "unit_cost" field.
+	DefaultUnitCost decimal.Decimal
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight decimal.Decimal
+	// DefaultLandedCost holds the default value on creation for the "landed_cost" field.
+	DefaultLandedCost decimal.Decimal
+	// DefaultLandedUnitCost holds the default value on creation for the "landed_unit_cost" field.
+	DefaultLandedUnitCost decimal.Decimal
+)
+
+// OrderOption defines the ordering options for the GoodsReceiptLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByUnitCost orders the results by the unit_cost field.
+func ByUnitCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitCost, opts...).ToFunc()
+}
+
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
+// ByLandedCost orders the results by the landed_cost field.
+func ByLandedCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLandedCost, opts...).ToFunc()
+}
+
+// ByLandedUnitCost orders the results by the landed_unit_cost field.
+func ByLandedUnitCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLandedUnitCost, opts...).ToFunc()
+}
+
+// ByReceiptField orders the results by receipt field.
+func ByReceiptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiptStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPurchaseOrderLineField orders the results by purchase_order_line field.
+func ByPurchaseOrderLineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPurchaseOrderLineStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByStockMovementField orders the results by stock_movement field.
+func ByStockMovementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockMovementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReceiptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReceiptTable, ReceiptColumn),
+	)
+}
+func newPurchaseOrderLineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PurchaseOrderLineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PurchaseOrderLineTable, PurchaseOrderLineColumn),
+	)
+}
+func newStockMovementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockMovementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, StockMovementTable, StockMovementColumn),
+	)
+}
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goodsreceiptline
+
+import (
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldID, id))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
+func UnitCost(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldUnitCost, v))
+}
+
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldWeight, v))
+}
+
+// LandedCost applies equality check predicate on the "landed_cost" field. It's identical to LandedCostEQ.
+func LandedCost(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldLandedCost, v))
+}
+
+// LandedUnitCost applies equality check predicate on the "landed_unit_cost" field. It's identical to LandedUnitCostEQ.
+func LandedUnitCost(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldLandedUnitCost, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UnitCostEQ applies the EQ predicate on the "unit_cost" field.
+func UnitCostEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldUnitCost, v))
+}
+
+// UnitCostNEQ applies the NEQ predicate on the "unit_cost" field.
+func UnitCostNEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldUnitCost, v))
+}
+
+// UnitCostIn applies the In predicate on the "unit_cost" field.
+func UnitCostIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldUnitCost, vs...))
+}
+
+// UnitCostNotIn applies the NotIn predicate on the "unit_cost" field.
+func UnitCostNotIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldUnitCost, vs...))
+}
+
+// UnitCostGT applies the GT predicate on the "unit_cost" field.
+func UnitCostGT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldUnitCost, v))
+}
+
+// UnitCostGTE applies the GTE predicate on the "unit_cost" field.
+func UnitCostGTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldUnitCost, v))
+}
+
+// UnitCostLT applies the LT predicate on the "unit_cost" field.
+func UnitCostLT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldUnitCost, v))
+}
+
+// UnitCostLTE applies the LTE predicate on the "unit_cost" field.
+func UnitCostLTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldUnitCost, v))
+}
+
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldWeight, v))
+}
+
+// LandedCostEQ applies the EQ predicate on the "landed_cost" field.
+func LandedCostEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldLandedCost, v))
+}
+
+// LandedCostNEQ applies the NEQ predicate on the "landed_cost" field.
+func LandedCostNEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldLandedCost, v))
+}
+
+// LandedCostIn applies the In predicate on the "landed_cost" field.
+func LandedCostIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldLandedCost, vs...))
+}
+
+// LandedCostNotIn applies the NotIn predicate on the "landed_cost" field.
+func LandedCostNotIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldLandedCost, vs...))
+}
+
+// LandedCostGT applies the GT predicate on the "landed_cost" field.
+func LandedCostGT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldLandedCost, v))
+}
+
+// LandedCostGTE applies the GTE predicate on the "landed_cost" field.
+func LandedCostGTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldLandedCost, v))
+}
+
+// LandedCostLT applies the LT predicate on the "landed_cost" field.
+func LandedCostLT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldLandedCost, v))
+}
+
+// LandedCostLTE applies the LTE predicate on the "landed_cost" field.
+func LandedCostLTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldLandedCost, v))
+}
+
+// LandedUnitCostEQ applies the EQ predicate on the "landed_unit_cost" field.
+func LandedUnitCostEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldLandedUnitCost, v))
+}
+
+// LandedUnitCostNEQ applies the NEQ predicate on the "landed_unit_cost" field.
+func LandedUnitCostNEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldLandedUnitCost, v))
+}
+
+// LandedUnitCostIn applies the In predicate on the "landed_unit_cost" field.
+func LandedUnitCostIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldLandedUnitCost, vs...))
+}
+
+// LandedUnitCostNotIn applies the NotIn predicate on the "landed_unit_cost" field.
+func LandedUnitCostNotIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldLandedUnitCost, vs...))
+}
+
+// LandedUnitCostGT applies the GT predicate on the "landed_unit_cost" field.
+func LandedUnitCostGT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldLandedUnitCost, v))
+}
+
+// LandedUnitCostGTE applies the GTE predicate on the "landed_unit_cost" field.
+func LandedUnitCostGTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldLandedUnitCost, v))
+}
+
+// LandedUnitCostLT applies the LT predicate on the "landed_unit_cost" field.
+func LandedUnitCostLT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldLandedUnitCost, v))
+}
+
+// LandedUnitCostLTE applies the LTE predicate on the "landed_unit_cost" field.
+func LandedUnitCostLTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldLandedUnitCost, v))
+}
+
+// HasReceipt applies the HasEdge predicate on the "receipt" edge.
+func HasReceipt() predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReceiptTable, ReceiptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiptWith applies the HasEdge predicate on the "receipt" edge with a given conditions (other predicates).
+func HasReceiptWith(preds ...predicate.GoodsReceipt) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		step := newReceiptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPurchaseOrderLine applies the HasEdge predicate on the "purchase_order_line" edge.
+func HasPurchaseOrderLine() predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PurchaseOrderLineTable, PurchaseOrderLineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPurchaseOrderLineWith applies the HasEdge predicate on the "purchase_order_line" edge with a given conditions (other predicates).
+func HasPurchaseOrderLineWith(preds ...predicate.PurchaseOrderLine) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		step := newPurchaseOrderLineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStockMovement applies the HasEdge predicate on the "stock_movement" edge.
+func HasStockMovement() predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, StockMovementTable, StockMovementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStockMovementWith applies the HasEdge predicate on the "stock_movement" edge with a given conditions (other predicates).
+func HasStockMovementWith(preds ...predicate.StockMovement) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		step := newStockMovementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoodsReceiptLine) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoodsReceiptLine) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoodsReceiptLine) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.NotPredicates(p))
+}
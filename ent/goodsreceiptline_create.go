@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/goodsreceipt"
+	"sent/ent/goodsreceiptline"
+	"sent/ent/purchaseorderline"
+	"sent/ent/stockmovement"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptLineCreate is the builder for creating a GoodsReceiptLine entity.
+type GoodsReceiptLineCreate struct {
+	config
+	mutation *GoodsReceiptLineMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *GoodsReceiptLineCreate) SetQuantity(v int) *GoodsReceiptLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (_c *GoodsReceiptLineCreate) SetUnitCost(v decimal.Decimal) *GoodsReceiptLineCreate {
+	_c.mutation.SetUnitCost(v)
+	return _c
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (_c *GoodsReceiptLineCreate) SetNillableUnitCost(v *decimal.Decimal) *GoodsReceiptLineCreate {
+	if v != nil {
+		_c.SetUnitCost(*v)
+	}
+	return _c
+}
+
+// SetWeight sets the "weight" field.
+func (_c *GoodsReceiptLineCreate) SetWeight(v decimal.Decimal) *GoodsReceiptLineCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *GoodsReceiptLineCreate) SetNillableWeight(v *decimal.Decimal) *GoodsReceiptLineCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetLandedCost sets the "landed_cost" field.
+func (_c *GoodsReceiptLineCreate) SetLandedCost(v decimal.Decimal) *GoodsReceiptLineCreate {
+	_c.mutation.SetLandedCost(v)
+	return _c
+}
+
+// SetNillableLandedCost sets the "landed_cost" field if the given value is not nil.
+func (_c *GoodsReceiptLineCreate) SetNillableLandedCost(v *decimal.Decimal) *GoodsReceiptLineCreate {
+	if v != nil {
+		_c.SetLandedCost(*v)
+	}
+	return _c
+}
+
+// SetLandedUnitCost sets the "landed_unit_cost" field.
+func (_c *GoodsReceiptLineCreate) SetLandedUnitCost(v decimal.Decimal) *GoodsReceiptLineCreate {
+	_c.mutation.SetLandedUnitCost(v)
+	return _c
+}
+
+// SetNillableLandedUnitCost sets the "landed_unit_cost" field if the given value is not nil.
+func (_c *GoodsReceiptLineCreate) SetNillableLandedUnitCost(v *decimal.Decimal) *GoodsReceiptLineCreate {
+	if v != nil {
+		_c.SetLandedUnitCost(*v)
+	}
+	return _c
+}
+
+// SetReceiptID sets the "receipt" edge to the GoodsReceipt entity by ID.
+func (_c *GoodsReceiptLineCreate) SetReceiptID(id int) *GoodsReceiptLineCreate {
+	_c.mutation.SetReceiptID(id)
+	return _c
+}
+
+// SetReceipt sets the "receipt" edge to the GoodsReceipt entity.
+func (_c *GoodsReceiptLineCreate) SetReceipt(v *GoodsReceipt) *GoodsReceiptLineCreate {
+	return _c.SetReceiptID(v.ID)
+}
+
+// SetPurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID.
+func (_c *GoodsReceiptLineCreate) SetPurchaseOrderLineID(id int) *GoodsReceiptLineCreate {
+	_c.mutation.SetPurchaseOrderLineID(id)
+	return _c
+}
+
+// SetPurchaseOrderLine sets the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_c *GoodsReceiptLineCreate) SetPurchaseOrderLine(v *PurchaseOrderLine) *GoodsReceiptLineCreate {
+	return _c.SetPurchaseOrderLineID(v.ID)
+}
+
+// SetStockMovementID sets the "stock_movement" edge to the StockMovement entity by ID.
+func (_c *GoodsReceiptLineCreate) SetStockMovementID(id int) *GoodsReceiptLineCreate {
+	_c.mutation.SetStockMovementID(id)
+	return _c
+}
+
+// SetNillableStockMovementID sets the "stock_movement" edge to the StockMovement entity by ID if the given value is not nil.
+func (_c *GoodsReceiptLineCreate) SetNillableStockMovementID(id *int) *GoodsReceiptLineCreate {
+	if id != nil {
+		_c = _c.SetStockMovementID(*id)
+	}
+	return _c
+}
+
+// SetStockMovement sets the "stock_movement" edge to the StockMovement entity.
+func (_c *GoodsReceiptLineCreate) SetStockMovement(v *StockMovement) *GoodsReceiptLineCreate {
+	return _c.SetStockMovementID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptLineMutation object of the builder.
+func (_c *GoodsReceiptLineCreate) Mutation() *GoodsReceiptLineMutation {
+	return _c.mutation
+}
+
+// Save creates the GoodsReceiptLine in the database.
+func (_c *GoodsReceiptLineCreate) Save(ctx context.Context) (*GoodsReceiptLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoodsReceiptLineCreate) SaveX(ctx context.Context) *GoodsReceiptLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoodsReceiptLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoodsReceiptLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoodsReceiptLineCreate) defaults() {
+	if _, ok := _c.mutation.UnitCost(); !ok {
+		v := goodsreceiptline.DefaultUnitCost
+		_c.mutation.SetUnitCost(v)
+	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := goodsreceiptline.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
+	if _, ok := _c.mutation.LandedCost(); !ok {
+		v := goodsreceiptline.DefaultLandedCost
+		_c.mutation.SetLandedCost(v)
+	}
+	if _, ok := _c.mutation.LandedUnitCost(); !ok {
+		v := goodsreceiptline.DefaultLandedUnitCost
+		_c.mutation.SetLandedUnitCost(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoodsReceiptLineCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "GoodsReceiptLine.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := goodsreceiptline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "GoodsReceiptLine.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UnitCost(); !ok {
+		return &ValidationError{Name: "unit_cost", err: errors.New(`ent: missing required field "GoodsReceiptLine.unit_cost"`)}
+	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "GoodsReceiptLine.weight"`)}
+	}
+	if _, ok := _c.mutation.LandedCost(); !ok {
+		return &ValidationError{Name: "landed_cost", err: errors.New(`ent: missing required field "GoodsReceiptLine.landed_cost"`)}
+	}
+	if _, ok := _c.mutation.LandedUnitCost(); !ok {
+		return &ValidationError{Name: "landed_unit_cost", err: errors.New(`ent: missing required field "GoodsReceiptLine.landed_unit_cost"`)}
+	}
+	if len(_c.mutation.ReceiptIDs()) == 0 {
+		return &ValidationError{Name: "receipt", err: errors.New(`ent: missing required edge "GoodsReceiptLine.receipt"`)}
+	}
+	if len(_c.mutation.PurchaseOrderLineIDs()) == 0 {
+		return &ValidationError{Name: "purchase_order_line", err: errors.New(`ent: missing required edge "GoodsReceiptLine.purchase_order_line"`)}
+	}
+	return nil
+}
+
+func (_c *GoodsReceiptLineCreate) sqlSave(ctx context.Context) (*GoodsReceiptLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoodsReceiptLineCreate) createSpec() (*GoodsReceiptLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoodsReceiptLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goodsreceiptline.Table, sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UnitCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldUnitCost, field.TypeOther, value)
+		_node.UnitCost = value
+	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(goodsreceiptline.FieldWeight, field.TypeOther, value)
+		_node.Weight = value
+	}
+	if value, ok := _c.mutation.LandedCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldLandedCost, field.TypeOther, value)
+		_node.LandedCost = value
+	}
+	if value, ok := _c.mutation.LandedUnitCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldLandedUnitCost, field.TypeOther, value)
+		_node.LandedUnitCost = value
+	}
+	if nodes := _c.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.goods_receipt_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PurchaseOrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.PurchaseOrderLineTable,
+			Columns: []string{goodsreceiptline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.purchase_order_line_receipt_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StockMovementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceiptline.StockMovementTable,
+			Columns: []string{goodsreceiptline.StockMovementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.goods_receipt_line_stock_movement = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GoodsReceiptLineCreateBulk is the builder for creating many GoodsReceiptLine entities in bulk.
+type GoodsReceiptLineCreateBulk struct {
+	config
+	err      error
+	builders []*GoodsReceiptLineCreate
+}
+
+// Save creates the GoodsReceiptLine entities in the database.
+func (_c *GoodsReceiptLineCreateBulk) Save(ctx context.Context) ([]*GoodsReceiptLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoodsReceiptLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoodsReceiptLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoodsReceiptLineCreateBulk) SaveX(ctx context.Context) []*GoodsReceiptLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoodsReceiptLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoodsReceiptLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sent/ent/goodsreceiptline"
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoodsReceiptLineDelete is the builder for deleting a GoodsReceiptLine entity.
+type GoodsReceiptLineDelete struct {
+	config
+	hooks    []Hook
+	mutation *GoodsReceiptLineMutation
+}
+
+// Where appends a list predicates to the GoodsReceiptLineDelete builder.
+func (_d *GoodsReceiptLineDelete) Where(ps ...predicate.GoodsReceiptLine) *GoodsReceiptLineDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GoodsReceiptLineDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoodsReceiptLineDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GoodsReceiptLineDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(goodsreceiptline.Table, sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GoodsReceiptLineDeleteOne is the builder for deleting a single GoodsReceiptLine entity.
+type GoodsReceiptLineDeleteOne struct {
+	_d *GoodsReceiptLineDelete
+}
+
+// Where appends a list predicates to the GoodsReceiptLineDelete builder.
+func (_d *GoodsReceiptLineDeleteOne) Where(ps ...predicate.GoodsReceiptLine) *GoodsReceiptLineDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GoodsReceiptLineDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{goodsreceiptline.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoodsReceiptLineDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/goodsreceipt"
+	"sent/ent/goodsreceiptline"
+	"sent/ent/predicate"
+	"sent/ent/purchaseorderline"
+	"sent/ent/stockmovement"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptLineUpdate is the builder for updating GoodsReceiptLine entities.
+type GoodsReceiptLineUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GoodsReceiptLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GoodsReceiptLineUpdate builder.
+func (_u *GoodsReceiptLineUpdate) Where(ps ...predicate.GoodsReceiptLine) *GoodsReceiptLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *GoodsReceiptLineUpdate) SetQuantity(v int) *GoodsReceiptLineUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableQuantity(v *int) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *GoodsReceiptLineUpdate) AddQuantity(v int) *GoodsReceiptLineUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (_u *GoodsReceiptLineUpdate) SetUnitCost(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.SetUnitCost(v)
+	return _u
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableUnitCost(v *decimal.Decimal) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetUnitCost(*v)
+	}
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *GoodsReceiptLineUpdate) SetWeight(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableWeight(v *decimal.Decimal) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// SetLandedCost sets the "landed_cost" field.
+func (_u *GoodsReceiptLineUpdate) SetLandedCost(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.SetLandedCost(v)
+	return _u
+}
+
+// SetNillableLandedCost sets the "landed_cost" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableLandedCost(v *decimal.Decimal) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetLandedCost(*v)
+	}
+	return _u
+}
+
+// SetLandedUnitCost sets the "landed_unit_cost" field.
+func (_u *GoodsReceiptLineUpdate) SetLandedUnitCost(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.SetLandedUnitCost(v)
+	return _u
+}
+
+// SetNillableLandedUnitCost sets the "landed_unit_cost" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableLandedUnitCost(v *decimal.Decimal) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetLandedUnitCost(*v)
+	}
+	return _u
+}
+
+// SetReceiptID sets the "receipt" edge to the GoodsReceipt entity by ID.
+func (_u *GoodsReceiptLineUpdate) SetReceiptID(id int) *GoodsReceiptLineUpdate {
+	_u.mutation.SetReceiptID(id)
+	return _u
+}
+
+// SetReceipt sets the "receipt" edge to the GoodsReceipt entity.
+func (_u *GoodsReceiptLineUpdate) SetReceipt(v *GoodsReceipt) *GoodsReceiptLineUpdate {
+	return _u.SetReceiptID(v.ID)
+}
+
+// SetPurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID.
+func (_u *GoodsReceiptLineUpdate) SetPurchaseOrderLineID(id int) *GoodsReceiptLineUpdate {
+	_u.mutation.SetPurchaseOrderLineID(id)
+	return _u
+}
+
+// SetPurchaseOrderLine sets the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_u *GoodsReceiptLineUpdate) SetPurchaseOrderLine(v *PurchaseOrderLine) *GoodsReceiptLineUpdate {
+	return _u.SetPurchaseOrderLineID(v.ID)
+}
+
+// SetStockMovementID sets the "stock_movement" edge to the StockMovement entity by ID.
+func (_u *GoodsReceiptLineUpdate) SetStockMovementID(id int) *GoodsReceiptLineUpdate {
+	_u.mutation.SetStockMovementID(id)
+	return _u
+}
+
+// SetNillableStockMovementID sets the "stock_movement" edge to the StockMovement entity by ID if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableStockMovementID(id *int) *GoodsReceiptLineUpdate {
+	if id != nil {
+		_u = _u.SetStockMovementID(*id)
+	}
+	return _u
+}
+
+// SetStockMovement sets the "stock_movement" edge to the StockMovement entity.
+func (_u *GoodsReceiptLineUpdate) SetStockMovement(v *StockMovement) *GoodsReceiptLineUpdate {
+	return _u.SetStockMovementID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptLineMutation object of the builder.
+func (_u *GoodsReceiptLineUpdate) Mutation() *GoodsReceiptLineMutation {
+	return _u.mutation
+}
+
+// ClearReceipt clears the "receipt" edge to the GoodsReceipt entity.
+func (_u *GoodsReceiptLineUpdate) ClearReceipt() *GoodsReceiptLineUpdate {
+	_u.mutation.ClearReceipt()
+	return _u
+}
+
+// ClearPurchaseOrderLine clears the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_u *GoodsReceiptLineUpdate) ClearPurchaseOrderLine() *GoodsReceiptLineUpdate {
+	_u.mutation.ClearPurchaseOrderLine()
+	return _u
+}
+
+// ClearStockMovement clears the "stock_movement" edge to the StockMovement entity.
+func (_u *GoodsReceiptLineUpdate) ClearStockMovement() *GoodsReceiptLineUpdate {
+	_u.mutation.ClearStockMovement()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoodsReceiptLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoodsReceiptLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoodsReceiptLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoodsReceiptLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoodsReceiptLineUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := goodsreceiptline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "GoodsReceiptLine.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.ReceiptCleared() && len(_u.mutation.ReceiptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceiptLine.receipt"`)
+	}
+	if _u.mutation.PurchaseOrderLineCleared() && len(_u.mutation.PurchaseOrderLineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceiptLine.purchase_order_line"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoodsReceiptLineUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoodsReceiptLineUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GoodsReceiptLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goodsreceiptline.Table, goodsreceiptline.Columns, sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(goodsreceiptline.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UnitCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldUnitCost, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(goodsreceiptline.FieldWeight, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.LandedCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldLandedCost, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.LandedUnitCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldLandedUnitCost, field.TypeOther, value)
+	}
+	if _u.mutation.ReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchaseOrderLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.PurchaseOrderLineTable,
+			Columns: []string{goodsreceiptline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchaseOrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.PurchaseOrderLineTable,
+			Columns: []string{goodsreceiptline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StockMovementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceiptline.StockMovementTable,
+			Columns: []string{goodsreceiptline.StockMovementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockMovementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceiptline.StockMovementTable,
+			Columns: []string{goodsreceiptline.StockMovementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goodsreceiptline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoodsReceiptLineUpdateOne is the builder for updating a single GoodsReceiptLine entity.
+type GoodsReceiptLineUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GoodsReceiptLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *GoodsReceiptLineUpdateOne) SetQuantity(v int) *GoodsReceiptLineUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableQuantity(v *int) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *GoodsReceiptLineUpdateOne) AddQuantity(v int) *GoodsReceiptLineUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (_u *GoodsReceiptLineUpdateOne) SetUnitCost(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetUnitCost(v)
+	return _u
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableUnitCost(v *decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetUnitCost(*v)
+	}
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *GoodsReceiptLineUpdateOne) SetWeight(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableWeight(v *decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// SetLandedCost sets the "landed_cost" field.
+func (_u *GoodsReceiptLineUpdateOne) SetLandedCost(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetLandedCost(v)
+	return _u
+}
+
+// SetNillableLandedCost sets the "landed_cost" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableLandedCost(v *decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetLandedCost(*v)
+	}
+	return _u
+}
+
+// SetLandedUnitCost sets the "landed_unit_cost" field.
+func (_u *GoodsReceiptLineUpdateOne) SetLandedUnitCost(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetLandedUnitCost(v)
+	return _u
+}
+
+// SetNillableLandedUnitCost sets the "landed_unit_cost" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableLandedUnitCost(v *decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetLandedUnitCost(*v)
+	}
+	return _u
+}
+
+// SetReceiptID sets the "receipt" edge to the GoodsReceipt entity by ID.
+func (_u *GoodsReceiptLineUpdateOne) SetReceiptID(id int) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetReceiptID(id)
+	return _u
+}
+
+// SetReceipt sets the "receipt" edge to the GoodsReceipt entity.
+func (_u *GoodsReceiptLineUpdateOne) SetReceipt(v *GoodsReceipt) *GoodsReceiptLineUpdateOne {
+	return _u.SetReceiptID(v.ID)
+}
+
+// SetPurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID.
+func (_u *GoodsReceiptLineUpdateOne) SetPurchaseOrderLineID(id int) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetPurchaseOrderLineID(id)
+	return _u
+}
+
+// SetPurchaseOrderLine sets the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_u *GoodsReceiptLineUpdateOne) SetPurchaseOrderLine(v *PurchaseOrderLine) *GoodsReceiptLineUpdateOne {
+	return _u.SetPurchaseOrderLineID(v.ID)
+}
+
+// SetStockMovementID sets the "stock_movement" edge to the StockMovement entity by ID.
+func (_u *GoodsReceiptLineUpdateOne) SetStockMovementID(id int) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetStockMovementID(id)
+	return _u
+}
+
+// SetNillableStockMovementID sets the "stock_movement" edge to the StockMovement entity by ID if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableStockMovementID(id *int) *GoodsReceiptLineUpdateOne {
+	if id != nil {
+		_u = _u.SetStockMovementID(*id)
+	}
+	return _u
+}
+
+// SetStockMovement sets the "stock_movement" edge to the StockMovement entity.
+func (_u *GoodsReceiptLineUpdateOne) SetStockMovement(v *StockMovement) *GoodsReceiptLineUpdateOne {
+	return _u.SetStockMovementID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptLineMutation object of the builder.
+func (_u *GoodsReceiptLineUpdateOne) Mutation() *GoodsReceiptLineMutation {
+	return _u.mutation
+}
+
+// ClearReceipt clears the "receipt" edge to the GoodsReceipt entity.
+func (_u *GoodsReceiptLineUpdateOne) ClearReceipt() *GoodsReceiptLineUpdateOne {
+	_u.mutation.ClearReceipt()
+	return _u
+}
+
+// ClearPurchaseOrderLine clears the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_u *GoodsReceiptLineUpdateOne) ClearPurchaseOrderLine() *GoodsReceiptLineUpdateOne {
+	_u.mutation.ClearPurchaseOrderLine()
+	return _u
+}
+
+// ClearStockMovement clears the "stock_movement" edge to the StockMovement entity.
+func (_u *GoodsReceiptLineUpdateOne) ClearStockMovement() *GoodsReceiptLineUpdateOne {
+	_u.mutation.ClearStockMovement()
+	return _u
+}
+
+// Where appends a list predicates to the GoodsReceiptLineUpdate builder.
+func (_u *GoodsReceiptLineUpdateOne) Where(ps ...predicate.GoodsReceiptLine) *GoodsReceiptLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoodsReceiptLineUpdateOne) Select(field string, fields ...string) *GoodsReceiptLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoodsReceiptLine entity.
+func (_u *GoodsReceiptLineUpdateOne) Save(ctx context.Context) (*GoodsReceiptLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoodsReceiptLineUpdateOne) SaveX(ctx context.Context) *GoodsReceiptLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoodsReceiptLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoodsReceiptLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoodsReceiptLineUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := goodsreceiptline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "GoodsReceiptLine.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.ReceiptCleared() && len(_u.mutation.ReceiptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceiptLine.receipt"`)
+	}
+	if _u.mutation.PurchaseOrderLineCleared() && len(_u.mutation.PurchaseOrderLineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceiptLine.purchase_order_line"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoodsReceiptLineUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoodsReceiptLineUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GoodsReceiptLineUpdateOne) sqlSave(ctx context.Context) (_node *GoodsReceiptLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goodsreceiptline.Table, goodsreceiptline.Columns, sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GoodsReceiptLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goodsreceiptline.FieldID)
+		for _, f := range fields {
+			if !goodsreceiptline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goodsreceiptline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(goodsreceiptline.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UnitCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldUnitCost, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(goodsreceiptline.FieldWeight, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.LandedCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldLandedCost, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.LandedUnitCost(); ok {
+		_spec.SetField(goodsreceiptline.FieldLandedUnitCost, field.TypeOther, value)
+	}
+	if _u.mutation.ReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchaseOrderLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.PurchaseOrderLineTable,
+			Columns: []string{goodsreceiptline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchaseOrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.PurchaseOrderLineTable,
+			Columns: []string{goodsreceiptline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StockMovementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceiptline.StockMovementTable,
+			Columns: []string{goodsreceiptline.StockMovementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockMovementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goodsreceiptline.StockMovementTable,
+			Columns: []string{goodsreceiptline.StockMovementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &GoodsReceiptLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goodsreceiptline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
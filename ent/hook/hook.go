@@ -20,6 +20,18 @@ func (f AccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountMutation", m)
 }
 
+// The AccountBalanceSnapshotFunc type is an adapter to allow the use of ordinary
+// function as AccountBalanceSnapshot mutator.
+type AccountBalanceSnapshotFunc func(context.Context, *ent.AccountBalanceSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountBalanceSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccountBalanceSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountBalanceSnapshotMutation", m)
+}
+
 // The AgentFunc type is an adapter to allow the use of ordinary
 // function as Agent mutator.
 type AgentFunc func(context.Context, *ent.AgentMutation) (ent.Value, error)
@@ -32,6 +44,78 @@ func (f AgentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AgentMutation", m)
 }
 
+// The AgentAlertFunc type is an adapter to allow the use of ordinary
+// function as AgentAlert mutator.
+type AgentAlertFunc func(context.Context, *ent.AgentAlertMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AgentAlertFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AgentAlertMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AgentAlertMutation", m)
+}
+
+// The AgentAuthorityFunc type is an adapter to allow the use of ordinary
+// function as AgentAuthority mutator.
+type AgentAuthorityFunc func(context.Context, *ent.AgentAuthorityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AgentAuthorityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AgentAuthorityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AgentAuthorityMutation", m)
+}
+
+// The AgentEnrollmentTokenFunc type is an adapter to allow the use of ordinary
+// function as AgentEnrollmentToken mutator.
+type AgentEnrollmentTokenFunc func(context.Context, *ent.AgentEnrollmentTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AgentEnrollmentTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AgentEnrollmentTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AgentEnrollmentTokenMutation", m)
+}
+
+// The AgentInventoryFunc type is an adapter to allow the use of ordinary
+// function as AgentInventory mutator.
+type AgentInventoryFunc func(context.Context, *ent.AgentInventoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AgentInventoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AgentInventoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AgentInventoryMutation", m)
+}
+
+// The AgentSoftwareFunc type is an adapter to allow the use of ordinary
+// function as AgentSoftware mutator.
+type AgentSoftwareFunc func(context.Context, *ent.AgentSoftwareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AgentSoftwareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AgentSoftwareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AgentSoftwareMutation", m)
+}
+
+// The AlertRuleFunc type is an adapter to allow the use of ordinary
+// function as AlertRule mutator.
+type AlertRuleFunc func(context.Context, *ent.AlertRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AlertRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AlertRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AlertRuleMutation", m)
+}
+
 // The ApplicationFunc type is an adapter to allow the use of ordinary
 // function as Application mutator.
 type ApplicationFunc func(context.Context, *ent.ApplicationMutation) (ent.Value, error)
@@ -92,6 +176,42 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The BankRuleFunc type is an adapter to allow the use of ordinary
+// function as BankRule mutator.
+type BankRuleFunc func(context.Context, *ent.BankRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BankRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BankRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BankRuleMutation", m)
+}
+
+// The BankStatementFunc type is an adapter to allow the use of ordinary
+// function as BankStatement mutator.
+type BankStatementFunc func(context.Context, *ent.BankStatementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BankStatementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BankStatementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BankStatementMutation", m)
+}
+
+// The BankStatementLineFunc type is an adapter to allow the use of ordinary
+// function as BankStatementLine mutator.
+type BankStatementLineFunc func(context.Context, *ent.BankStatementLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BankStatementLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BankStatementLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BankStatementLineMutation", m)
+}
+
 // The BenefitEnrollmentFunc type is an adapter to allow the use of ordinary
 // function as BenefitEnrollment mutator.
 type BenefitEnrollmentFunc func(context.Context, *ent.BenefitEnrollmentMutation) (ent.Value, error)
@@ -224,6 +344,30 @@ func (f CredentialFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CredentialMutation", m)
 }
 
+// The CustomerFunc type is an adapter to allow the use of ordinary
+// function as Customer mutator.
+type CustomerFunc func(context.Context, *ent.CustomerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CustomerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomerMutation", m)
+}
+
+// The CustomerPaymentFunc type is an adapter to allow the use of ordinary
+// function as CustomerPayment mutator.
+type CustomerPaymentFunc func(context.Context, *ent.CustomerPaymentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomerPaymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CustomerPaymentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomerPaymentMutation", m)
+}
+
 // The DepartmentFunc type is an adapter to allow the use of ordinary
 // function as Department mutator.
 type DepartmentFunc func(context.Context, *ent.DepartmentMutation) (ent.Value, error)
@@ -248,6 +392,18 @@ func (f DetectionEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DetectionEventMutation", m)
 }
 
+// The DeviceGroupFunc type is an adapter to allow the use of ordinary
+// function as DeviceGroup mutator.
+type DeviceGroupFunc func(context.Context, *ent.DeviceGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceGroupMutation", m)
+}
+
 // The DiscoveryEntryFunc type is an adapter to allow the use of ordinary
 // function as DiscoveryEntry mutator.
 type DiscoveryEntryFunc func(context.Context, *ent.DiscoveryEntryMutation) (ent.Value, error)
@@ -260,6 +416,30 @@ func (f DiscoveryEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscoveryEntryMutation", m)
 }
 
+// The EInvoiceFunc type is an adapter to allow the use of ordinary
+// function as EInvoice mutator.
+type EInvoiceFunc func(context.Context, *ent.EInvoiceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EInvoiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EInvoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EInvoiceMutation", m)
+}
+
+// The EInvoiceCredentialFunc type is an adapter to allow the use of ordinary
+// function as EInvoiceCredential mutator.
+type EInvoiceCredentialFunc func(context.Context, *ent.EInvoiceCredentialMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EInvoiceCredentialFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EInvoiceCredentialMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EInvoiceCredentialMutation", m)
+}
+
 // The EmployeeFunc type is an adapter to allow the use of ordinary
 // function as Employee mutator.
 type EmployeeFunc func(context.Context, *ent.EmployeeMutation) (ent.Value, error)
@@ -272,6 +452,30 @@ func (f EmployeeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmployeeMutation", m)
 }
 
+// The ExchangeRateFunc type is an adapter to allow the use of ordinary
+// function as ExchangeRate mutator.
+type ExchangeRateFunc func(context.Context, *ent.ExchangeRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExchangeRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExchangeRateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExchangeRateMutation", m)
+}
+
+// The FiscalPeriodFunc type is an adapter to allow the use of ordinary
+// function as FiscalPeriod mutator.
+type FiscalPeriodFunc func(context.Context, *ent.FiscalPeriodMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FiscalPeriodFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FiscalPeriodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FiscalPeriodMutation", m)
+}
+
 // The GoalFunc type is an adapter to allow the use of ordinary
 // function as Goal mutator.
 type GoalFunc func(context.Context, *ent.GoalMutation) (ent.Value, error)
@@ -284,6 +488,30 @@ func (f GoalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GoalMutation", m)
 }
 
+// The GoodsReceiptFunc type is an adapter to allow the use of ordinary
+// function as GoodsReceipt mutator.
+type GoodsReceiptFunc func(context.Context, *ent.GoodsReceiptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GoodsReceiptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GoodsReceiptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GoodsReceiptMutation", m)
+}
+
+// The GoodsReceiptLineFunc type is an adapter to allow the use of ordinary
+// function as GoodsReceiptLine mutator.
+type GoodsReceiptLineFunc func(context.Context, *ent.GoodsReceiptLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GoodsReceiptLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GoodsReceiptLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GoodsReceiptLineMutation", m)
+}
+
 // The HealthScoreSnapshotFunc type is an adapter to allow the use of ordinary
 // function as HealthScoreSnapshot mutator.
 type HealthScoreSnapshotFunc func(context.Context, *ent.HealthScoreSnapshotMutation) (ent.Value, error)
@@ -344,6 +572,42 @@ func (f InventoryReservationFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InventoryReservationMutation", m)
 }
 
+// The InvoiceFunc type is an adapter to allow the use of ordinary
+// function as Invoice mutator.
+type InvoiceFunc func(context.Context, *ent.InvoiceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceMutation", m)
+}
+
+// The InvoiceLineFunc type is an adapter to allow the use of ordinary
+// function as InvoiceLine mutator.
+type InvoiceLineFunc func(context.Context, *ent.InvoiceLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceLineMutation", m)
+}
+
+// The InvoiceTaxLineFunc type is an adapter to allow the use of ordinary
+// function as InvoiceTaxLine mutator.
+type InvoiceTaxLineFunc func(context.Context, *ent.InvoiceTaxLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceTaxLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceTaxLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceTaxLineMutation", m)
+}
+
 // The JobFunc type is an adapter to allow the use of ordinary
 // function as Job mutator.
 type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
@@ -392,6 +656,18 @@ func (f JournalEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JournalEntryMutation", m)
 }
 
+// The LabelTemplateFunc type is an adapter to allow the use of ordinary
+// function as LabelTemplate mutator.
+type LabelTemplateFunc func(context.Context, *ent.LabelTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LabelTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LabelTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LabelTemplateMutation", m)
+}
+
 // The LedgerEntryFunc type is an adapter to allow the use of ordinary
 // function as LedgerEntry mutator.
 type LedgerEntryFunc func(context.Context, *ent.LedgerEntryMutation) (ent.Value, error)
@@ -500,6 +776,54 @@ func (f OneTimeLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OneTimeLinkMutation", m)
 }
 
+// The PatchPolicyFunc type is an adapter to allow the use of ordinary
+// function as PatchPolicy mutator.
+type PatchPolicyFunc func(context.Context, *ent.PatchPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PatchPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PatchPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PatchPolicyMutation", m)
+}
+
+// The PaymentAllocationFunc type is an adapter to allow the use of ordinary
+// function as PaymentAllocation mutator.
+type PaymentAllocationFunc func(context.Context, *ent.PaymentAllocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentAllocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentAllocationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentAllocationMutation", m)
+}
+
+// The PaymentRunFunc type is an adapter to allow the use of ordinary
+// function as PaymentRun mutator.
+type PaymentRunFunc func(context.Context, *ent.PaymentRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentRunMutation", m)
+}
+
+// The PaymentRunLineFunc type is an adapter to allow the use of ordinary
+// function as PaymentRunLine mutator.
+type PaymentRunLineFunc func(context.Context, *ent.PaymentRunLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentRunLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentRunLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentRunLineMutation", m)
+}
+
 // The PerformanceReviewFunc type is an adapter to allow the use of ordinary
 // function as PerformanceReview mutator.
 type PerformanceReviewFunc func(context.Context, *ent.PerformanceReviewMutation) (ent.Value, error)
@@ -524,6 +848,42 @@ func (f PermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PermissionMutation", m)
 }
 
+// The PosSaleLineFunc type is an adapter to allow the use of ordinary
+// function as PosSaleLine mutator.
+type PosSaleLineFunc func(context.Context, *ent.PosSaleLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PosSaleLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PosSaleLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PosSaleLineMutation", m)
+}
+
+// The PosShiftFunc type is an adapter to allow the use of ordinary
+// function as PosShift mutator.
+type PosShiftFunc func(context.Context, *ent.PosShiftMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PosShiftFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PosShiftMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PosShiftMutation", m)
+}
+
+// The PosTenderFunc type is an adapter to allow the use of ordinary
+// function as PosTender mutator.
+type PosTenderFunc func(context.Context, *ent.PosTenderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PosTenderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PosTenderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PosTenderMutation", m)
+}
+
 // The ProductFunc type is an adapter to allow the use of ordinary
 // function as Product mutator.
 type ProductFunc func(context.Context, *ent.ProductMutation) (ent.Value, error)
@@ -740,6 +1100,18 @@ func (f StockAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockAuditLogMutation", m)
 }
 
+// The StockLevelFunc type is an adapter to allow the use of ordinary
+// function as StockLevel mutator.
+type StockLevelFunc func(context.Context, *ent.StockLevelMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockLevelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StockLevelMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockLevelMutation", m)
+}
+
 // The StockMovementFunc type is an adapter to allow the use of ordinary
 // function as StockMovement mutator.
 type StockMovementFunc func(context.Context, *ent.StockMovementMutation) (ent.Value, error)
@@ -788,6 +1160,54 @@ func (f SupplierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SupplierMutation", m)
 }
 
+// The SupplierBillFunc type is an adapter to allow the use of ordinary
+// function as SupplierBill mutator.
+type SupplierBillFunc func(context.Context, *ent.SupplierBillMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SupplierBillFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SupplierBillMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SupplierBillMutation", m)
+}
+
+// The SupplierBillLineFunc type is an adapter to allow the use of ordinary
+// function as SupplierBillLine mutator.
+type SupplierBillLineFunc func(context.Context, *ent.SupplierBillLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SupplierBillLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SupplierBillLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SupplierBillLineMutation", m)
+}
+
+// The TaxJurisdictionFunc type is an adapter to allow the use of ordinary
+// function as TaxJurisdiction mutator.
+type TaxJurisdictionFunc func(context.Context, *ent.TaxJurisdictionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaxJurisdictionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaxJurisdictionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaxJurisdictionMutation", m)
+}
+
+// The TaxRateFunc type is an adapter to allow the use of ordinary
+// function as TaxRate mutator.
+type TaxRateFunc func(context.Context, *ent.TaxRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaxRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaxRateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaxRateMutation", m)
+}
+
 // The TenantFunc type is an adapter to allow the use of ordinary
 // function as Tenant mutator.
 type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
@@ -872,6 +1292,30 @@ func (f TransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransactionMutation", m)
 }
 
+// The TransferOrderFunc type is an adapter to allow the use of ordinary
+// function as TransferOrder mutator.
+type TransferOrderFunc func(context.Context, *ent.TransferOrderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TransferOrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TransferOrderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransferOrderMutation", m)
+}
+
+// The TransferOrderLineFunc type is an adapter to allow the use of ordinary
+// function as TransferOrderLine mutator.
+type TransferOrderLineFunc func(context.Context, *ent.TransferOrderLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TransferOrderLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TransferOrderLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransferOrderLineMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -3,10 +3,13 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"sent/ent/inventoryreservation"
 	"sent/ent/product"
+	"sent/ent/schema"
 	"sent/ent/tenant"
+	"sent/ent/warehouse"
 	"strings"
 	"time"
 
@@ -26,6 +29,8 @@ type InventoryReservation struct {
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
 	// Status holds the value of the "status" field.
 	Status inventoryreservation.Status `json:"status,omitempty"`
+	// Takes holds the value of the "takes" field.
+	Takes []schema.StockTake `json:"takes,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -33,6 +38,7 @@ type InventoryReservation struct {
 	Edges                         InventoryReservationEdges `json:"edges"`
 	product_reservations          *int
 	tenant_inventory_reservations *int
+	warehouse_reservations        *int
 	selectValues                  sql.SelectValues
 }
 
@@ -42,9 +48,11 @@ type InventoryReservationEdges struct {
 	Product *Product `json:"product,omitempty"`
 	// Tenant holds the value of the tenant edge.
 	Tenant *Tenant `json:"tenant,omitempty"`
+	// Warehouse holds the value of the warehouse edge.
+	Warehouse *Warehouse `json:"warehouse,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ProductOrErr returns the Product value or an error if the edge
@@ -69,11 +77,24 @@ func (e InventoryReservationEdges) TenantOrErr() (*Tenant, error) {
 	return nil, &NotLoadedError{edge: "tenant"}
 }
 
+// WarehouseOrErr returns the Warehouse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InventoryReservationEdges) WarehouseOrErr() (*Warehouse, error) {
+	if e.Warehouse != nil {
+		return e.Warehouse, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "warehouse"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*InventoryReservation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case inventoryreservation.FieldTakes:
+			values[i] = new([]byte)
 		case inventoryreservation.FieldQuantity:
 			values[i] = new(decimal.Decimal)
 		case inventoryreservation.FieldID:
@@ -86,6 +107,8 @@ func (*InventoryReservation) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case inventoryreservation.ForeignKeys[1]: // tenant_inventory_reservations
 			values[i] = new(sql.NullInt64)
+		case inventoryreservation.ForeignKeys[2]: // warehouse_reservations
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -125,6 +148,14 @@ func (_m *InventoryReservation) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				_m.Status = inventoryreservation.Status(value.String)
 			}
+		case inventoryreservation.FieldTakes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field takes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Takes); err != nil {
+					return fmt.Errorf("unmarshal field takes: %w", err)
+				}
+			}
 		case inventoryreservation.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -145,6 +176,13 @@ func (_m *InventoryReservation) assignValues(columns []string, values []any) err
 				_m.tenant_inventory_reservations = new(int)
 				*_m.tenant_inventory_reservations = int(value.Int64)
 			}
+		case inventoryreservation.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_reservations", value)
+			} else if value.Valid {
+				_m.warehouse_reservations = new(int)
+				*_m.warehouse_reservations = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -168,6 +206,11 @@ func (_m *InventoryReservation) QueryTenant() *TenantQuery {
 	return NewInventoryReservationClient(_m.config).QueryTenant(_m)
 }
 
+// QueryWarehouse queries the "warehouse" edge of the InventoryReservation entity.
+func (_m *InventoryReservation) QueryWarehouse() *WarehouseQuery {
+	return NewInventoryReservationClient(_m.config).QueryWarehouse(_m)
+}
+
 // Update returns a builder for updating this InventoryReservation.
 // Note that you need to call InventoryReservation.Unwrap() before calling this method if this InventoryReservation
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -200,6 +243,9 @@ func (_m *InventoryReservation) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("takes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Takes))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -22,12 +22,16 @@ const (
 	FieldExpiresAt = "expires_at"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldTakes holds the string denoting the takes field in the database.
+	FieldTakes = "takes"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
 	EdgeProduct = "product"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
+	// EdgeWarehouse holds the string denoting the warehouse edge name in mutations.
+	EdgeWarehouse = "warehouse"
 	// Table holds the table name of the inventoryreservation in the database.
 	Table = "inventory_reservations"
 	// ProductTable is the table that holds the product relation/edge.
@@ -44,6 +48,13 @@ const (
 	TenantInverseTable = "tenants"
 	// TenantColumn is the table column denoting the tenant relation/edge.
 	TenantColumn = "tenant_inventory_reservations"
+	// WarehouseTable is the table that holds the warehouse relation/edge.
+	WarehouseTable = "inventory_reservations"
+	// WarehouseInverseTable is the table name for the Warehouse entity.
+	// It exists in this package in order to avoid circular dependency with the "warehouse" package.
+	WarehouseInverseTable = "warehouses"
+	// WarehouseColumn is the table column denoting the warehouse relation/edge.
+	WarehouseColumn = "warehouse_reservations"
 )
 
 // Columns holds all SQL columns for inventoryreservation fields.
@@ -52,6 +63,7 @@ var Columns = []string{
 	FieldQuantity,
 	FieldExpiresAt,
 	FieldStatus,
+	FieldTakes,
 	FieldCreatedAt,
 }
 
@@ -60,6 +72,7 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"product_reservations",
 	"tenant_inventory_reservations",
+	"warehouse_reservations",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -152,6 +165,13 @@ func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByWarehouseField orders the results by warehouse field.
+func ByWarehouseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWarehouseStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newProductStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -166,3 +186,10 @@ func newTenantStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
 	)
 }
+func newWarehouseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WarehouseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+	)
+}
@@ -171,6 +171,16 @@ func StatusNotIn(vs ...Status) predicate.InventoryReservation {
 	return predicate.InventoryReservation(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// TakesIsNil applies the IsNil predicate on the "takes" field.
+func TakesIsNil() predicate.InventoryReservation {
+	return predicate.InventoryReservation(sql.FieldIsNull(FieldTakes))
+}
+
+// TakesNotNil applies the NotNil predicate on the "takes" field.
+func TakesNotNil() predicate.InventoryReservation {
+	return predicate.InventoryReservation(sql.FieldNotNull(FieldTakes))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.InventoryReservation {
 	return predicate.InventoryReservation(sql.FieldEQ(FieldCreatedAt, v))
@@ -257,6 +267,29 @@ func HasTenantWith(preds ...predicate.Tenant) predicate.InventoryReservation {
 	})
 }
 
+// HasWarehouse applies the HasEdge predicate on the "warehouse" edge.
+func HasWarehouse() predicate.InventoryReservation {
+	return predicate.InventoryReservation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarehouseWith applies the HasEdge predicate on the "warehouse" edge with a given conditions (other predicates).
+func HasWarehouseWith(preds ...predicate.Warehouse) predicate.InventoryReservation {
+	return predicate.InventoryReservation(func(s *sql.Selector) {
+		step := newWarehouseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.InventoryReservation) predicate.InventoryReservation {
 	return predicate.InventoryReservation(sql.AndPredicates(predicates...))
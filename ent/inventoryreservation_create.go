@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"sent/ent/inventoryreservation"
 	"sent/ent/product"
+	"sent/ent/schema"
 	"sent/ent/tenant"
+	"sent/ent/warehouse"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -57,6 +59,12 @@ func (_c *InventoryReservationCreate) SetNillableStatus(v *inventoryreservation.
 	return _c
 }
 
+// SetTakes sets the "takes" field.
+func (_c *InventoryReservationCreate) SetTakes(v []schema.StockTake) *InventoryReservationCreate {
+	_c.mutation.SetTakes(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *InventoryReservationCreate) SetCreatedAt(v time.Time) *InventoryReservationCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -93,6 +101,25 @@ func (_c *InventoryReservationCreate) SetTenant(v *Tenant) *InventoryReservation
 	return _c.SetTenantID(v.ID)
 }
 
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_c *InventoryReservationCreate) SetWarehouseID(id int) *InventoryReservationCreate {
+	_c.mutation.SetWarehouseID(id)
+	return _c
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_c *InventoryReservationCreate) SetNillableWarehouseID(id *int) *InventoryReservationCreate {
+	if id != nil {
+		_c = _c.SetWarehouseID(*id)
+	}
+	return _c
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_c *InventoryReservationCreate) SetWarehouse(v *Warehouse) *InventoryReservationCreate {
+	return _c.SetWarehouseID(v.ID)
+}
+
 // Mutation returns the InventoryReservationMutation object of the builder.
 func (_c *InventoryReservationCreate) Mutation() *InventoryReservationMutation {
 	return _c.mutation
@@ -205,6 +232,10 @@ func (_c *InventoryReservationCreate) createSpec() (*InventoryReservation, *sqlg
 		_spec.SetField(inventoryreservation.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.Takes(); ok {
+		_spec.SetField(inventoryreservation.FieldTakes, field.TypeJSON, value)
+		_node.Takes = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(inventoryreservation.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -243,6 +274,23 @@ func (_c *InventoryReservationCreate) createSpec() (*InventoryReservation, *sqlg
 		_node.tenant_inventory_reservations = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryreservation.WarehouseTable,
+			Columns: []string{inventoryreservation.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_reservations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
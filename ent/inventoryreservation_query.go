@@ -10,6 +10,7 @@ import (
 	"sent/ent/predicate"
 	"sent/ent/product"
 	"sent/ent/tenant"
+	"sent/ent/warehouse"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,14 +21,15 @@ import (
 // InventoryReservationQuery is the builder for querying InventoryReservation entities.
 type InventoryReservationQuery struct {
 	config
-	ctx         *QueryContext
-	order       []inventoryreservation.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.InventoryReservation
-	withProduct *ProductQuery
-	withTenant  *TenantQuery
-	withFKs     bool
-	modifiers   []func(*sql.Selector)
+	ctx           *QueryContext
+	order         []inventoryreservation.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.InventoryReservation
+	withProduct   *ProductQuery
+	withTenant    *TenantQuery
+	withWarehouse *WarehouseQuery
+	withFKs       bool
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +110,28 @@ func (_q *InventoryReservationQuery) QueryTenant() *TenantQuery {
 	return query
 }
 
+// QueryWarehouse chains the current query on the "warehouse" edge.
+func (_q *InventoryReservationQuery) QueryWarehouse() *WarehouseQuery {
+	query := (&WarehouseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventoryreservation.Table, inventoryreservation.FieldID, selector),
+			sqlgraph.To(warehouse.Table, warehouse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, inventoryreservation.WarehouseTable, inventoryreservation.WarehouseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first InventoryReservation entity from the query.
 // Returns a *NotFoundError when no InventoryReservation was found.
 func (_q *InventoryReservationQuery) First(ctx context.Context) (*InventoryReservation, error) {
@@ -295,13 +319,14 @@ func (_q *InventoryReservationQuery) Clone() *InventoryReservationQuery {
 		return nil
 	}
 	return &InventoryReservationQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]inventoryreservation.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.InventoryReservation{}, _q.predicates...),
-		withProduct: _q.withProduct.Clone(),
-		withTenant:  _q.withTenant.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]inventoryreservation.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.InventoryReservation{}, _q.predicates...),
+		withProduct:   _q.withProduct.Clone(),
+		withTenant:    _q.withTenant.Clone(),
+		withWarehouse: _q.withWarehouse.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -331,6 +356,17 @@ func (_q *InventoryReservationQuery) WithTenant(opts ...func(*TenantQuery)) *Inv
 	return _q
 }
 
+// WithWarehouse tells the query-builder to eager-load the nodes that are connected to
+// the "warehouse" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InventoryReservationQuery) WithWarehouse(opts ...func(*WarehouseQuery)) *InventoryReservationQuery {
+	query := (&WarehouseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWarehouse = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,12 +446,13 @@ func (_q *InventoryReservationQuery) sqlAll(ctx context.Context, hooks ...queryH
 		nodes       = []*InventoryReservation{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withProduct != nil,
 			_q.withTenant != nil,
+			_q.withWarehouse != nil,
 		}
 	)
-	if _q.withProduct != nil || _q.withTenant != nil {
+	if _q.withProduct != nil || _q.withTenant != nil || _q.withWarehouse != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -454,6 +491,12 @@ func (_q *InventoryReservationQuery) sqlAll(ctx context.Context, hooks ...queryH
 			return nil, err
 		}
 	}
+	if query := _q.withWarehouse; query != nil {
+		if err := _q.loadWarehouse(ctx, query, nodes, nil,
+			func(n *InventoryReservation, e *Warehouse) { n.Edges.Warehouse = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -521,6 +564,38 @@ func (_q *InventoryReservationQuery) loadTenant(ctx context.Context, query *Tena
 	}
 	return nil
 }
+func (_q *InventoryReservationQuery) loadWarehouse(ctx context.Context, query *WarehouseQuery, nodes []*InventoryReservation, init func(*InventoryReservation), assign func(*InventoryReservation, *Warehouse)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*InventoryReservation)
+	for i := range nodes {
+		if nodes[i].warehouse_reservations == nil {
+			continue
+		}
+		fk := *nodes[i].warehouse_reservations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(warehouse.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "warehouse_reservations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *InventoryReservationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -9,11 +9,14 @@ import (
 	"sent/ent/inventoryreservation"
 	"sent/ent/predicate"
 	"sent/ent/product"
+	"sent/ent/schema"
 	"sent/ent/tenant"
+	"sent/ent/warehouse"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/shopspring/decimal"
 )
@@ -74,6 +77,24 @@ func (_u *InventoryReservationUpdate) SetNillableStatus(v *inventoryreservation.
 	return _u
 }
 
+// SetTakes sets the "takes" field.
+func (_u *InventoryReservationUpdate) SetTakes(v []schema.StockTake) *InventoryReservationUpdate {
+	_u.mutation.SetTakes(v)
+	return _u
+}
+
+// AppendTakes appends value to the "takes" field.
+func (_u *InventoryReservationUpdate) AppendTakes(v []schema.StockTake) *InventoryReservationUpdate {
+	_u.mutation.AppendTakes(v)
+	return _u
+}
+
+// ClearTakes clears the value of the "takes" field.
+func (_u *InventoryReservationUpdate) ClearTakes() *InventoryReservationUpdate {
+	_u.mutation.ClearTakes()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *InventoryReservationUpdate) SetCreatedAt(v time.Time) *InventoryReservationUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -110,6 +131,25 @@ func (_u *InventoryReservationUpdate) SetTenant(v *Tenant) *InventoryReservation
 	return _u.SetTenantID(v.ID)
 }
 
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *InventoryReservationUpdate) SetWarehouseID(id int) *InventoryReservationUpdate {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *InventoryReservationUpdate) SetNillableWarehouseID(id *int) *InventoryReservationUpdate {
+	if id != nil {
+		_u = _u.SetWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *InventoryReservationUpdate) SetWarehouse(v *Warehouse) *InventoryReservationUpdate {
+	return _u.SetWarehouseID(v.ID)
+}
+
 // Mutation returns the InventoryReservationMutation object of the builder.
 func (_u *InventoryReservationUpdate) Mutation() *InventoryReservationMutation {
 	return _u.mutation
@@ -127,6 +167,12 @@ func (_u *InventoryReservationUpdate) ClearTenant() *InventoryReservationUpdate
 	return _u
 }
 
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *InventoryReservationUpdate) ClearWarehouse() *InventoryReservationUpdate {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *InventoryReservationUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -197,6 +243,17 @@ func (_u *InventoryReservationUpdate) sqlSave(ctx context.Context) (_node int, e
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(inventoryreservation.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Takes(); ok {
+		_spec.SetField(inventoryreservation.FieldTakes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTakes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryreservation.FieldTakes, value)
+		})
+	}
+	if _u.mutation.TakesCleared() {
+		_spec.ClearField(inventoryreservation.FieldTakes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(inventoryreservation.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -258,6 +315,35 @@ func (_u *InventoryReservationUpdate) sqlSave(ctx context.Context) (_node int, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryreservation.WarehouseTable,
+			Columns: []string{inventoryreservation.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryreservation.WarehouseTable,
+			Columns: []string{inventoryreservation.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -322,6 +408,24 @@ func (_u *InventoryReservationUpdateOne) SetNillableStatus(v *inventoryreservati
 	return _u
 }
 
+// SetTakes sets the "takes" field.
+func (_u *InventoryReservationUpdateOne) SetTakes(v []schema.StockTake) *InventoryReservationUpdateOne {
+	_u.mutation.SetTakes(v)
+	return _u
+}
+
+// AppendTakes appends value to the "takes" field.
+func (_u *InventoryReservationUpdateOne) AppendTakes(v []schema.StockTake) *InventoryReservationUpdateOne {
+	_u.mutation.AppendTakes(v)
+	return _u
+}
+
+// ClearTakes clears the value of the "takes" field.
+func (_u *InventoryReservationUpdateOne) ClearTakes() *InventoryReservationUpdateOne {
+	_u.mutation.ClearTakes()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *InventoryReservationUpdateOne) SetCreatedAt(v time.Time) *InventoryReservationUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -358,6 +462,25 @@ func (_u *InventoryReservationUpdateOne) SetTenant(v *Tenant) *InventoryReservat
 	return _u.SetTenantID(v.ID)
 }
 
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *InventoryReservationUpdateOne) SetWarehouseID(id int) *InventoryReservationUpdateOne {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *InventoryReservationUpdateOne) SetNillableWarehouseID(id *int) *InventoryReservationUpdateOne {
+	if id != nil {
+		_u = _u.SetWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *InventoryReservationUpdateOne) SetWarehouse(v *Warehouse) *InventoryReservationUpdateOne {
+	return _u.SetWarehouseID(v.ID)
+}
+
 // Mutation returns the InventoryReservationMutation object of the builder.
 func (_u *InventoryReservationUpdateOne) Mutation() *InventoryReservationMutation {
 	return _u.mutation
@@ -375,6 +498,12 @@ func (_u *InventoryReservationUpdateOne) ClearTenant() *InventoryReservationUpda
 	return _u
 }
 
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *InventoryReservationUpdateOne) ClearWarehouse() *InventoryReservationUpdateOne {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
 // Where appends a list predicates to the InventoryReservationUpdate builder.
 func (_u *InventoryReservationUpdateOne) Where(ps ...predicate.InventoryReservation) *InventoryReservationUpdateOne {
 	_u.mutation.Where(ps...)
@@ -475,6 +604,17 @@ func (_u *InventoryReservationUpdateOne) sqlSave(ctx context.Context) (_node *In
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(inventoryreservation.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Takes(); ok {
+		_spec.SetField(inventoryreservation.FieldTakes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTakes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryreservation.FieldTakes, value)
+		})
+	}
+	if _u.mutation.TakesCleared() {
+		_spec.ClearField(inventoryreservation.FieldTakes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(inventoryreservation.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -536,6 +676,35 @@ func (_u *InventoryReservationUpdateOne) sqlSave(ctx context.Context) (_node *In
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryreservation.WarehouseTable,
+			Columns: []string{inventoryreservation.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryreservation.WarehouseTable,
+			Columns: []string{inventoryreservation.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &InventoryReservation{config: _u.config}
 	_spec.Assign = _node.assignValues
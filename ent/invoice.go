@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/customer"
+	"sent/ent/invoice"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind invoice.Kind `json:"kind,omitempty"`
+	// Status holds the value of the "status" field.
+	Status invoice.Status `json:"status,omitempty"`
+	// IssueDate holds the value of the "issue_date" field.
+	IssueDate time.Time `json:"issue_date,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate decimal.Decimal `json:"exchange_rate,omitempty"`
+	// Subtotal holds the value of the "subtotal" field.
+	Subtotal decimal.Decimal `json:"subtotal,omitempty"`
+	// TaxTotal holds the value of the "tax_total" field.
+	TaxTotal decimal.Decimal `json:"tax_total,omitempty"`
+	// Total holds the value of the "total" field.
+	Total decimal.Decimal `json:"total,omitempty"`
+	// AmountSettled holds the value of the "amount_settled" field.
+	AmountSettled decimal.Decimal `json:"amount_settled,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// PdfPath holds the value of the "pdf_path" field.
+	PdfPath string `json:"pdf_path,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceQuery when eager-loading is set.
+	Edges                InvoiceEdges `json:"edges"`
+	customer_invoices    *int
+	invoice_transaction  *int
+	invoice_credit_notes *int
+	tenant_invoices      *int
+	selectValues         sql.SelectValues
+}
+
+// InvoiceEdges holds the relations/edges for other nodes in the graph.
+type InvoiceEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Customer holds the value of the customer edge.
+	Customer *Customer `json:"customer,omitempty"`
+	// Lines holds the value of the lines edge.
+	Lines []*InvoiceLine `json:"lines,omitempty"`
+	// TaxLines holds the value of the tax_lines edge.
+	TaxLines []*InvoiceTaxLine `json:"tax_lines,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// OriginalInvoice holds the value of the original_invoice edge.
+	OriginalInvoice *Invoice `json:"original_invoice,omitempty"`
+	// CreditNotes holds the value of the credit_notes edge.
+	CreditNotes []*Invoice `json:"credit_notes,omitempty"`
+	// Allocations holds the value of the allocations edge.
+	Allocations []*PaymentAllocation `json:"allocations,omitempty"`
+	// CreditApplications holds the value of the credit_applications edge.
+	CreditApplications []*PaymentAllocation `json:"credit_applications,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [9]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// CustomerOrErr returns the Customer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) CustomerOrErr() (*Customer, error) {
+	if e.Customer != nil {
+		return e.Customer, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: customer.Label}
+	}
+	return nil, &NotLoadedError{edge: "customer"}
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) LinesOrErr() ([]*InvoiceLine, error) {
+	if e.loadedTypes[2] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// TaxLinesOrErr returns the TaxLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) TaxLinesOrErr() ([]*InvoiceTaxLine, error) {
+	if e.loadedTypes[3] {
+		return e.TaxLines, nil
+	}
+	return nil, &NotLoadedError{edge: "tax_lines"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// OriginalInvoiceOrErr returns the OriginalInvoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) OriginalInvoiceOrErr() (*Invoice, error) {
+	if e.OriginalInvoice != nil {
+		return e.OriginalInvoice, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "original_invoice"}
+}
+
+// CreditNotesOrErr returns the CreditNotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) CreditNotesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[6] {
+		return e.CreditNotes, nil
+	}
+	return nil, &NotLoadedError{edge: "credit_notes"}
+}
+
+// AllocationsOrErr returns the Allocations value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) AllocationsOrErr() ([]*PaymentAllocation, error) {
+	if e.loadedTypes[7] {
+		return e.Allocations, nil
+	}
+	return nil, &NotLoadedError{edge: "allocations"}
+}
+
+// CreditApplicationsOrErr returns the CreditApplications value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) CreditApplicationsOrErr() ([]*PaymentAllocation, error) {
+	if e.loadedTypes[8] {
+		return e.CreditApplications, nil
+	}
+	return nil, &NotLoadedError{edge: "credit_applications"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldExchangeRate, invoice.FieldSubtotal, invoice.FieldTaxTotal, invoice.FieldTotal, invoice.FieldAmountSettled:
+			values[i] = new(decimal.Decimal)
+		case invoice.FieldID:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldNumber, invoice.FieldKind, invoice.FieldStatus, invoice.FieldCurrency, invoice.FieldNotes, invoice.FieldPdfPath:
+			values[i] = new(sql.NullString)
+		case invoice.FieldIssueDate, invoice.FieldDueDate, invoice.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case invoice.ForeignKeys[0]: // customer_invoices
+			values[i] = new(sql.NullInt64)
+		case invoice.ForeignKeys[1]: // invoice_transaction
+			values[i] = new(sql.NullInt64)
+		case invoice.ForeignKeys[2]: // invoice_credit_notes
+			values[i] = new(sql.NullInt64)
+		case invoice.ForeignKeys[3]: // tenant_invoices
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (_m *Invoice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case invoice.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case invoice.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = invoice.Kind(value.String)
+			}
+		case invoice.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = invoice.Status(value.String)
+			}
+		case invoice.FieldIssueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issue_date", values[i])
+			} else if value.Valid {
+				_m.IssueDate = value.Time
+			}
+		case invoice.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				_m.DueDate = value.Time
+			}
+		case invoice.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case invoice.FieldExchangeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value != nil {
+				_m.ExchangeRate = *value
+			}
+		case invoice.FieldSubtotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value != nil {
+				_m.Subtotal = *value
+			}
+		case invoice.FieldTaxTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_total", values[i])
+			} else if value != nil {
+				_m.TaxTotal = *value
+			}
+		case invoice.FieldTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value != nil {
+				_m.Total = *value
+			}
+		case invoice.FieldAmountSettled:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_settled", values[i])
+			} else if value != nil {
+				_m.AmountSettled = *value
+			}
+		case invoice.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case invoice.FieldPdfPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_path", values[i])
+			} else if value.Valid {
+				_m.PdfPath = value.String
+			}
+		case invoice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case invoice.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field customer_invoices", value)
+			} else if value.Valid {
+				_m.customer_invoices = new(int)
+				*_m.customer_invoices = int(value.Int64)
+			}
+		case invoice.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_transaction", value)
+			} else if value.Valid {
+				_m.invoice_transaction = new(int)
+				*_m.invoice_transaction = int(value.Int64)
+			}
+		case invoice.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_credit_notes", value)
+			} else if value.Valid {
+				_m.invoice_credit_notes = new(int)
+				*_m.invoice_credit_notes = int(value.Int64)
+			}
+		case invoice.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_invoices", value)
+			} else if value.Valid {
+				_m.tenant_invoices = new(int)
+				*_m.tenant_invoices = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invoice.
+// This includes values selected through modifiers, order, etc.
+func (_m *Invoice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the Invoice entity.
+func (_m *Invoice) QueryTenant() *TenantQuery {
+	return NewInvoiceClient(_m.config).QueryTenant(_m)
+}
+
+// QueryCustomer queries the "customer" edge of the Invoice entity.
+func (_m *Invoice) QueryCustomer() *CustomerQuery {
+	return NewInvoiceClient(_m.config).QueryCustomer(_m)
+}
+
+// QueryLines queries the "lines" edge of the Invoice entity.
+func (_m *Invoice) QueryLines() *InvoiceLineQuery {
+	return NewInvoiceClient(_m.config).QueryLines(_m)
+}
+
+// QueryTaxLines queries the "tax_lines" edge of the Invoice entity.
+func (_m *Invoice) QueryTaxLines() *InvoiceTaxLineQuery {
+	return NewInvoiceClient(_m.config).QueryTaxLines(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the Invoice entity.
+func (_m *Invoice) QueryTransaction() *TransactionQuery {
+	return NewInvoiceClient(_m.config).QueryTransaction(_m)
+}
+
+// QueryOriginalInvoice queries the "original_invoice" edge of the Invoice entity.
+func (_m *Invoice) QueryOriginalInvoice() *InvoiceQuery {
+	return NewInvoiceClient(_m.config).QueryOriginalInvoice(_m)
+}
+
+// QueryCreditNotes queries the "credit_notes" edge of the Invoice entity.
+func (_m *Invoice) QueryCreditNotes() *InvoiceQuery {
+	return NewInvoiceClient(_m.config).QueryCreditNotes(_m)
+}
+
+// QueryAllocations queries the "allocations" edge of the Invoice entity.
+func (_m *Invoice) QueryAllocations() *PaymentAllocationQuery {
+	return NewInvoiceClient(_m.config).QueryAllocations(_m)
+}
+
+// QueryCreditApplications queries the "credit_applications" edge of the Invoice entity.
+func (_m *Invoice) QueryCreditApplications() *PaymentAllocationQuery {
+	return NewInvoiceClient(_m.config).QueryCreditApplications(_m)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Invoice) Update() *InvoiceUpdateOne {
+	return NewInvoiceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Invoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Invoice) Unwrap() *Invoice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invoice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("issue_date=")
+	builder.WriteString(_m.IssueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(_m.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExchangeRate))
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("tax_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxTotal))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("amount_settled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountSettled))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("pdf_path=")
+	builder.WriteString(_m.PdfPath)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
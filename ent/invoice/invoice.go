@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldIssueDate holds the string denoting the issue_date field in the database.
+	FieldIssueDate = "issue_date"
+	// FieldDueDate holds the string denoting the due_date field in the database.
+	FieldDueDate = "due_date"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldTaxTotal holds the string denoting the tax_total field in the database.
+	FieldTaxTotal = "tax_total"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldAmountSettled holds the string denoting the amount_settled field in the database.
+	FieldAmountSettled = "amount_settled"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldPdfPath holds the string denoting the pdf_path field in the database.
+	FieldPdfPath = "pdf_path"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeCustomer holds the string denoting the customer edge name in mutations.
+	EdgeCustomer = "customer"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
+	// EdgeTaxLines holds the string denoting the tax_lines edge name in mutations.
+	EdgeTaxLines = "tax_lines"
+	// EdgeTransaction holds the string denoting the transaction edge name in mutations.
+	EdgeTransaction = "transaction"
+	// EdgeOriginalInvoice holds the string denoting the original_invoice edge name in mutations.
+	EdgeOriginalInvoice = "original_invoice"
+	// EdgeCreditNotes holds the string denoting the credit_notes edge name in mutations.
+	EdgeCreditNotes = "credit_notes"
+	// EdgeAllocations holds the string denoting the allocations edge name in mutations.
+	EdgeAllocations = "allocations"
+	// EdgeCreditApplications holds the string denoting the credit_applications edge name in mutations.
+	EdgeCreditApplications = "credit_applications"
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "invoices"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_invoices"
+	// CustomerTable is the table that holds the customer relation/edge.
+	CustomerTable = "invoices"
+	// CustomerInverseTable is the table name for the Customer entity.
+	// It exists in this package in order to avoid circular dependency with the "customer" package.
+	CustomerInverseTable = "customers"
+	// CustomerColumn is the table column denoting the customer relation/edge.
+	CustomerColumn = "customer_invoices"
+	// LinesTable is the table that holds the lines relation/edge.
+	LinesTable = "invoice_lines"
+	// LinesInverseTable is the table name for the InvoiceLine entity.
+	// It exists in this package in order to avoid circular dependency with the "invoiceline" package.
+	LinesInverseTable = "invoice_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "invoice_lines"
+	// TaxLinesTable is the table that holds the tax_lines relation/edge.
+	TaxLinesTable = "invoice_tax_lines"
+	// TaxLinesInverseTable is the table name for the InvoiceTaxLine entity.
+	// It exists in this package in order to avoid circular dependency with the "invoicetaxline" package.
+	TaxLinesInverseTable = "invoice_tax_lines"
+	// TaxLinesColumn is the table column denoting the tax_lines relation/edge.
+	TaxLinesColumn = "invoice_tax_lines"
+	// TransactionTable is the table that holds the transaction relation/edge.
+	TransactionTable = "invoices"
+	// TransactionInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionInverseTable = "transactions"
+	// TransactionColumn is the table column denoting the transaction relation/edge.
+	TransactionColumn = "invoice_transaction"
+	// OriginalInvoiceTable is the table that holds the original_invoice relation/edge.
+	OriginalInvoiceTable = "invoices"
+	// OriginalInvoiceColumn is the table column denoting the original_invoice relation/edge.
+	OriginalInvoiceColumn = "invoice_credit_notes"
+	// CreditNotesTable is the table that holds the credit_notes relation/edge.
+	CreditNotesTable = "invoices"
+	// CreditNotesColumn is the table column denoting the credit_notes relation/edge.
+	CreditNotesColumn = "invoice_credit_notes"
+	// AllocationsTable is the table that holds the allocations relation/edge.
+	AllocationsTable = "payment_allocations"
+	// AllocationsInverseTable is the table name for the PaymentAllocation entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentallocation" package.
+	AllocationsInverseTable = "payment_allocations"
+	// AllocationsColumn is the table column denoting the allocations relation/edge.
+	AllocationsColumn = "invoice_allocations"
+	// CreditApplicationsTable is the table that holds the credit_applications relation/edge.
+	CreditApplicationsTable = "payment_allocations"
+	// CreditApplicationsInverseTable is the table name for the PaymentAllocation entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentallocation" package.
+	CreditApplicationsInverseTable = "payment_allocations"
+	// CreditApplicationsColumn is the table column denoting the credit_applications relation/edge.
+	CreditApplicationsColumn = "invoice_credit_applications"
+)
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldID,
+	FieldNumber,
+	FieldKind,
+	FieldStatus,
+	FieldIssueDate,
+	FieldDueDate,
+	FieldCurrency,
+	FieldExchangeRate,
+	FieldSubtotal,
+	FieldTaxTotal,
+	FieldTotal,
+	FieldAmountSettled,
+	FieldNotes,
+	FieldPdfPath,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "invoices"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"customer_invoices",
+	"invoice_transaction",
+	"invoice_credit_notes",
+	"tenant_invoices",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIssueDate holds the default value on creation for the "issue_date" field.
+	DefaultIssueDate func() time.Time
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate decimal.Decimal
+	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
+	DefaultSubtotal decimal.Decimal
+	// DefaultTaxTotal holds the default value on creation for the "tax_total" field.
+	DefaultTaxTotal decimal.Decimal
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal decimal.Decimal
+	// DefaultAmountSettled holds the default value on creation for the "amount_settled" field.
+	DefaultAmountSettled decimal.Decimal
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindInvoice is the default value of the Kind enum.
+const DefaultKind = KindInvoice
+
+// Kind values.
+const (
+	KindInvoice    Kind = "invoice"
+	KindCreditNote Kind = "credit_note"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindInvoice, KindCreditNote:
+		return nil
+	default:
+		return fmt.Errorf("invoice: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusDraft is the default value of the Status enum.
+const DefaultStatus = StatusDraft
+
+// Status values.
+const (
+	StatusDraft         Status = "draft"
+	StatusIssued        Status = "issued"
+	StatusPartiallyPaid Status = "partially_paid"
+	StatusPaid          Status = "paid"
+	StatusVoid          Status = "void"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusDraft, StatusIssued, StatusPartiallyPaid, StatusPaid, StatusVoid:
+		return nil
+	default:
+		return fmt.Errorf("invoice: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Invoice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByIssueDate orders the results by the issue_date field.
+func ByIssueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssueDate, opts...).ToFunc()
+}
+
+// ByDueDate orders the results by the due_date field.
+func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
+// BySubtotal orders the results by the subtotal field.
+func BySubtotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotal, opts...).ToFunc()
+}
+
+// ByTaxTotal orders the results by the tax_total field.
+func ByTaxTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxTotal, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByAmountSettled orders the results by the amount_settled field.
+func ByAmountSettled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountSettled, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByPdfPath orders the results by the pdf_path field.
+func ByPdfPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPdfPath, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCustomerField orders the results by customer field.
+func ByCustomerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLinesCount orders the results by lines count.
+func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinesStep(), opts...)
+	}
+}
+
+// ByLines orders the results by lines terms.
+func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTaxLinesCount orders the results by tax_lines count.
+func ByTaxLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaxLinesStep(), opts...)
+	}
+}
+
+// ByTaxLines orders the results by tax_lines terms.
+func ByTaxLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaxLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransactionField orders the results by transaction field.
+func ByTransactionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOriginalInvoiceField orders the results by original_invoice field.
+func ByOriginalInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOriginalInvoiceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCreditNotesCount orders the results by credit_notes count.
+func ByCreditNotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCreditNotesStep(), opts...)
+	}
+}
+
+// ByCreditNotes orders the results by credit_notes terms.
+func ByCreditNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreditNotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAllocationsCount orders the results by allocations count.
+func ByAllocationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAllocationsStep(), opts...)
+	}
+}
+
+// ByAllocations orders the results by allocations terms.
+func ByAllocations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAllocationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCreditApplicationsCount orders the results by credit_applications count.
+func ByCreditApplicationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCreditApplicationsStep(), opts...)
+	}
+}
+
+// ByCreditApplications orders the results by credit_applications terms.
+func ByCreditApplications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreditApplicationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newCustomerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+	)
+}
+func newLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+	)
+}
+func newTaxLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaxLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TaxLinesTable, TaxLinesColumn),
+	)
+}
+func newTransactionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+	)
+}
+func newOriginalInvoiceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OriginalInvoiceTable, OriginalInvoiceColumn),
+	)
+}
+func newCreditNotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CreditNotesTable, CreditNotesColumn),
+	)
+}
+func newAllocationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AllocationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AllocationsTable, AllocationsColumn),
+	)
+}
+func newCreditApplicationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreditApplicationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CreditApplicationsTable, CreditApplicationsColumn),
+	)
+}
@@ -0,0 +1,795 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/customer"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+	"sent/ent/invoicetaxline"
+	"sent/ent/paymentallocation"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (_c *InvoiceCreate) SetNumber(v string) *InvoiceCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *InvoiceCreate) SetKind(v invoice.Kind) *InvoiceCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableKind(v *invoice.Kind) *InvoiceCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *InvoiceCreate) SetStatus(v invoice.Status) *InvoiceCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableStatus(v *invoice.Status) *InvoiceCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetIssueDate sets the "issue_date" field.
+func (_c *InvoiceCreate) SetIssueDate(v time.Time) *InvoiceCreate {
+	_c.mutation.SetIssueDate(v)
+	return _c
+}
+
+// SetNillableIssueDate sets the "issue_date" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableIssueDate(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetIssueDate(*v)
+	}
+	return _c
+}
+
+// SetDueDate sets the "due_date" field.
+func (_c *InvoiceCreate) SetDueDate(v time.Time) *InvoiceCreate {
+	_c.mutation.SetDueDate(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *InvoiceCreate) SetCurrency(v string) *InvoiceCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCurrency(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *InvoiceCreate) SetExchangeRate(v decimal.Decimal) *InvoiceCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableExchangeRate(v *decimal.Decimal) *InvoiceCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_c *InvoiceCreate) SetSubtotal(v decimal.Decimal) *InvoiceCreate {
+	_c.mutation.SetSubtotal(v)
+	return _c
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableSubtotal(v *decimal.Decimal) *InvoiceCreate {
+	if v != nil {
+		_c.SetSubtotal(*v)
+	}
+	return _c
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_c *InvoiceCreate) SetTaxTotal(v decimal.Decimal) *InvoiceCreate {
+	_c.mutation.SetTaxTotal(v)
+	return _c
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableTaxTotal(v *decimal.Decimal) *InvoiceCreate {
+	if v != nil {
+		_c.SetTaxTotal(*v)
+	}
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *InvoiceCreate) SetTotal(v decimal.Decimal) *InvoiceCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableTotal(v *decimal.Decimal) *InvoiceCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetAmountSettled sets the "amount_settled" field.
+func (_c *InvoiceCreate) SetAmountSettled(v decimal.Decimal) *InvoiceCreate {
+	_c.mutation.SetAmountSettled(v)
+	return _c
+}
+
+// SetNillableAmountSettled sets the "amount_settled" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableAmountSettled(v *decimal.Decimal) *InvoiceCreate {
+	if v != nil {
+		_c.SetAmountSettled(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *InvoiceCreate) SetNotes(v string) *InvoiceCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableNotes(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetPdfPath sets the "pdf_path" field.
+func (_c *InvoiceCreate) SetPdfPath(v string) *InvoiceCreate {
+	_c.mutation.SetPdfPath(v)
+	return _c
+}
+
+// SetNillablePdfPath sets the "pdf_path" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillablePdfPath(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetPdfPath(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvoiceCreate) SetCreatedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCreatedAt(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *InvoiceCreate) SetTenantID(id int) *InvoiceCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *InvoiceCreate) SetTenant(v *Tenant) *InvoiceCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_c *InvoiceCreate) SetCustomerID(id int) *InvoiceCreate {
+	_c.mutation.SetCustomerID(id)
+	return _c
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_c *InvoiceCreate) SetCustomer(v *Customer) *InvoiceCreate {
+	return _c.SetCustomerID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the InvoiceLine entity by IDs.
+func (_c *InvoiceCreate) AddLineIDs(ids ...int) *InvoiceCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the InvoiceLine entity.
+func (_c *InvoiceCreate) AddLines(v ...*InvoiceLine) *InvoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// AddTaxLineIDs adds the "tax_lines" edge to the InvoiceTaxLine entity by IDs.
+func (_c *InvoiceCreate) AddTaxLineIDs(ids ...int) *InvoiceCreate {
+	_c.mutation.AddTaxLineIDs(ids...)
+	return _c
+}
+
+// AddTaxLines adds the "tax_lines" edges to the InvoiceTaxLine entity.
+func (_c *InvoiceCreate) AddTaxLines(v ...*InvoiceTaxLine) *InvoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTaxLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *InvoiceCreate) SetTransactionID(id int) *InvoiceCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableTransactionID(id *int) *InvoiceCreate {
+	if id != nil {
+		_c = _c.SetTransactionID(*id)
+	}
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *InvoiceCreate) SetTransaction(v *Transaction) *InvoiceCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// SetOriginalInvoiceID sets the "original_invoice" edge to the Invoice entity by ID.
+func (_c *InvoiceCreate) SetOriginalInvoiceID(id int) *InvoiceCreate {
+	_c.mutation.SetOriginalInvoiceID(id)
+	return _c
+}
+
+// SetNillableOriginalInvoiceID sets the "original_invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableOriginalInvoiceID(id *int) *InvoiceCreate {
+	if id != nil {
+		_c = _c.SetOriginalInvoiceID(*id)
+	}
+	return _c
+}
+
+// SetOriginalInvoice sets the "original_invoice" edge to the Invoice entity.
+func (_c *InvoiceCreate) SetOriginalInvoice(v *Invoice) *InvoiceCreate {
+	return _c.SetOriginalInvoiceID(v.ID)
+}
+
+// AddCreditNoteIDs adds the "credit_notes" edge to the Invoice entity by IDs.
+func (_c *InvoiceCreate) AddCreditNoteIDs(ids ...int) *InvoiceCreate {
+	_c.mutation.AddCreditNoteIDs(ids...)
+	return _c
+}
+
+// AddCreditNotes adds the "credit_notes" edges to the Invoice entity.
+func (_c *InvoiceCreate) AddCreditNotes(v ...*Invoice) *InvoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCreditNoteIDs(ids...)
+}
+
+// AddAllocationIDs adds the "allocations" edge to the PaymentAllocation entity by IDs.
+func (_c *InvoiceCreate) AddAllocationIDs(ids ...int) *InvoiceCreate {
+	_c.mutation.AddAllocationIDs(ids...)
+	return _c
+}
+
+// AddAllocations adds the "allocations" edges to the PaymentAllocation entity.
+func (_c *InvoiceCreate) AddAllocations(v ...*PaymentAllocation) *InvoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAllocationIDs(ids...)
+}
+
+// AddCreditApplicationIDs adds the "credit_applications" edge to the PaymentAllocation entity by IDs.
+func (_c *InvoiceCreate) AddCreditApplicationIDs(ids ...int) *InvoiceCreate {
+	_c.mutation.AddCreditApplicationIDs(ids...)
+	return _c
+}
+
+// AddCreditApplications adds the "credit_applications" edges to the PaymentAllocation entity.
+func (_c *InvoiceCreate) AddCreditApplications(v ...*PaymentAllocation) *InvoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCreditApplicationIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_c *InvoiceCreate) Mutation() *InvoiceMutation {
+	return _c.mutation
+}
+
+// Save creates the Invoice in the database.
+func (_c *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceCreate) defaults() {
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := invoice.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := invoice.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.IssueDate(); !ok {
+		v := invoice.DefaultIssueDate()
+		_c.mutation.SetIssueDate(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := invoice.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		v := invoice.DefaultExchangeRate
+		_c.mutation.SetExchangeRate(v)
+	}
+	if _, ok := _c.mutation.Subtotal(); !ok {
+		v := invoice.DefaultSubtotal
+		_c.mutation.SetSubtotal(v)
+	}
+	if _, ok := _c.mutation.TaxTotal(); !ok {
+		v := invoice.DefaultTaxTotal
+		_c.mutation.SetTaxTotal(v)
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		v := invoice.DefaultTotal
+		_c.mutation.SetTotal(v)
+	}
+	if _, ok := _c.mutation.AmountSettled(); !ok {
+		v := invoice.DefaultAmountSettled
+		_c.mutation.SetAmountSettled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invoice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceCreate) check() error {
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Invoice.number"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Invoice.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invoice.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IssueDate(); !ok {
+		return &ValidationError{Name: "issue_date", err: errors.New(`ent: missing required field "Invoice.issue_date"`)}
+	}
+	if _, ok := _c.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "Invoice.due_date"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Invoice.currency"`)}
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "Invoice.exchange_rate"`)}
+	}
+	if _, ok := _c.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "Invoice.subtotal"`)}
+	}
+	if _, ok := _c.mutation.TaxTotal(); !ok {
+		return &ValidationError{Name: "tax_total", err: errors.New(`ent: missing required field "Invoice.tax_total"`)}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Invoice.total"`)}
+	}
+	if _, ok := _c.mutation.AmountSettled(); !ok {
+		return &ValidationError{Name: "amount_settled", err: errors.New(`ent: missing required field "Invoice.amount_settled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invoice.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "Invoice.tenant"`)}
+	}
+	if len(_c.mutation.CustomerIDs()) == 0 {
+		return &ValidationError{Name: "customer", err: errors.New(`ent: missing required edge "Invoice.customer"`)}
+	}
+	return nil
+}
+
+func (_c *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.IssueDate(); ok {
+		_spec.SetField(invoice.FieldIssueDate, field.TypeTime, value)
+		_node.IssueDate = value
+	}
+	if value, ok := _c.mutation.DueDate(); ok {
+		_spec.SetField(invoice.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(invoice.FieldExchangeRate, field.TypeOther, value)
+		_node.ExchangeRate = value
+	}
+	if value, ok := _c.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeOther, value)
+		_node.Subtotal = value
+	}
+	if value, ok := _c.mutation.TaxTotal(); ok {
+		_spec.SetField(invoice.FieldTaxTotal, field.TypeOther, value)
+		_node.TaxTotal = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeOther, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.AmountSettled(); ok {
+		_spec.SetField(invoice.FieldAmountSettled, field.TypeOther, value)
+		_node.AmountSettled = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(invoice.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.PdfPath(); ok {
+		_spec.SetField(invoice.FieldPdfPath, field.TypeString, value)
+		_node.PdfPath = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invoice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.TenantTable,
+			Columns: []string{invoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_invoices = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.customer_invoices = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TaxLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.TaxLinesTable,
+			Columns: []string{invoice.TaxLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.TransactionTable,
+			Columns: []string{invoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_transaction = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OriginalInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalInvoiceTable,
+			Columns: []string{invoice.OriginalInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_credit_notes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AllocationsTable,
+			Columns: []string{invoice.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreditApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditApplicationsTable,
+			Columns: []string{invoice.CreditApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceCreate
+}
+
+// Save creates the Invoice entities in the database.
+func (_c *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Invoice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1935 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/customer"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+	"sent/ent/invoicetaxline"
+	"sent/ent/paymentallocation"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *InvoiceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *InvoiceUpdate) SetNumber(v string) *InvoiceUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableNumber(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvoiceUpdate) SetKind(v invoice.Kind) *InvoiceUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableKind(v *invoice.Kind) *InvoiceUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InvoiceUpdate) SetStatus(v invoice.Status) *InvoiceUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableStatus(v *invoice.Status) *InvoiceUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetIssueDate sets the "issue_date" field.
+func (_u *InvoiceUpdate) SetIssueDate(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetIssueDate(v)
+	return _u
+}
+
+// SetNillableIssueDate sets the "issue_date" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableIssueDate(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetIssueDate(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *InvoiceUpdate) SetDueDate(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableDueDate(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *InvoiceUpdate) SetCurrency(v string) *InvoiceUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableCurrency(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *InvoiceUpdate) SetExchangeRate(v decimal.Decimal) *InvoiceUpdate {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableExchangeRate(v *decimal.Decimal) *InvoiceUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_u *InvoiceUpdate) SetSubtotal(v decimal.Decimal) *InvoiceUpdate {
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSubtotal(v *decimal.Decimal) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_u *InvoiceUpdate) SetTaxTotal(v decimal.Decimal) *InvoiceUpdate {
+	_u.mutation.SetTaxTotal(v)
+	return _u
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableTaxTotal(v *decimal.Decimal) *InvoiceUpdate {
+	if v != nil {
+		_u.SetTaxTotal(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *InvoiceUpdate) SetTotal(v decimal.Decimal) *InvoiceUpdate {
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableTotal(v *decimal.Decimal) *InvoiceUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// SetAmountSettled sets the "amount_settled" field.
+func (_u *InvoiceUpdate) SetAmountSettled(v decimal.Decimal) *InvoiceUpdate {
+	_u.mutation.SetAmountSettled(v)
+	return _u
+}
+
+// SetNillableAmountSettled sets the "amount_settled" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableAmountSettled(v *decimal.Decimal) *InvoiceUpdate {
+	if v != nil {
+		_u.SetAmountSettled(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *InvoiceUpdate) SetNotes(v string) *InvoiceUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableNotes(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *InvoiceUpdate) ClearNotes() *InvoiceUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetPdfPath sets the "pdf_path" field.
+func (_u *InvoiceUpdate) SetPdfPath(v string) *InvoiceUpdate {
+	_u.mutation.SetPdfPath(v)
+	return _u
+}
+
+// SetNillablePdfPath sets the "pdf_path" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillablePdfPath(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetPdfPath(*v)
+	}
+	return _u
+}
+
+// ClearPdfPath clears the value of the "pdf_path" field.
+func (_u *InvoiceUpdate) ClearPdfPath() *InvoiceUpdate {
+	_u.mutation.ClearPdfPath()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *InvoiceUpdate) SetTenantID(id int) *InvoiceUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *InvoiceUpdate) SetTenant(v *Tenant) *InvoiceUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_u *InvoiceUpdate) SetCustomerID(id int) *InvoiceUpdate {
+	_u.mutation.SetCustomerID(id)
+	return _u
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_u *InvoiceUpdate) SetCustomer(v *Customer) *InvoiceUpdate {
+	return _u.SetCustomerID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the InvoiceLine entity by IDs.
+func (_u *InvoiceUpdate) AddLineIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the InvoiceLine entity.
+func (_u *InvoiceUpdate) AddLines(v ...*InvoiceLine) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// AddTaxLineIDs adds the "tax_lines" edge to the InvoiceTaxLine entity by IDs.
+func (_u *InvoiceUpdate) AddTaxLineIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.AddTaxLineIDs(ids...)
+	return _u
+}
+
+// AddTaxLines adds the "tax_lines" edges to the InvoiceTaxLine entity.
+func (_u *InvoiceUpdate) AddTaxLines(v ...*InvoiceTaxLine) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaxLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *InvoiceUpdate) SetTransactionID(id int) *InvoiceUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableTransactionID(id *int) *InvoiceUpdate {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *InvoiceUpdate) SetTransaction(v *Transaction) *InvoiceUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetOriginalInvoiceID sets the "original_invoice" edge to the Invoice entity by ID.
+func (_u *InvoiceUpdate) SetOriginalInvoiceID(id int) *InvoiceUpdate {
+	_u.mutation.SetOriginalInvoiceID(id)
+	return _u
+}
+
+// SetNillableOriginalInvoiceID sets the "original_invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableOriginalInvoiceID(id *int) *InvoiceUpdate {
+	if id != nil {
+		_u = _u.SetOriginalInvoiceID(*id)
+	}
+	return _u
+}
+
+// SetOriginalInvoice sets the "original_invoice" edge to the Invoice entity.
+func (_u *InvoiceUpdate) SetOriginalInvoice(v *Invoice) *InvoiceUpdate {
+	return _u.SetOriginalInvoiceID(v.ID)
+}
+
+// AddCreditNoteIDs adds the "credit_notes" edge to the Invoice entity by IDs.
+func (_u *InvoiceUpdate) AddCreditNoteIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.AddCreditNoteIDs(ids...)
+	return _u
+}
+
+// AddCreditNotes adds the "credit_notes" edges to the Invoice entity.
+func (_u *InvoiceUpdate) AddCreditNotes(v ...*Invoice) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCreditNoteIDs(ids...)
+}
+
+// AddAllocationIDs adds the "allocations" edge to the PaymentAllocation entity by IDs.
+func (_u *InvoiceUpdate) AddAllocationIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.AddAllocationIDs(ids...)
+	return _u
+}
+
+// AddAllocations adds the "allocations" edges to the PaymentAllocation entity.
+func (_u *InvoiceUpdate) AddAllocations(v ...*PaymentAllocation) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllocationIDs(ids...)
+}
+
+// AddCreditApplicationIDs adds the "credit_applications" edge to the PaymentAllocation entity by IDs.
+func (_u *InvoiceUpdate) AddCreditApplicationIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.AddCreditApplicationIDs(ids...)
+	return _u
+}
+
+// AddCreditApplications adds the "credit_applications" edges to the PaymentAllocation entity.
+func (_u *InvoiceUpdate) AddCreditApplications(v ...*PaymentAllocation) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCreditApplicationIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *InvoiceUpdate) ClearTenant() *InvoiceUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (_u *InvoiceUpdate) ClearCustomer() *InvoiceUpdate {
+	_u.mutation.ClearCustomer()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the InvoiceLine entity.
+func (_u *InvoiceUpdate) ClearLines() *InvoiceUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to InvoiceLine entities by IDs.
+func (_u *InvoiceUpdate) RemoveLineIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to InvoiceLine entities.
+func (_u *InvoiceUpdate) RemoveLines(v ...*InvoiceLine) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearTaxLines clears all "tax_lines" edges to the InvoiceTaxLine entity.
+func (_u *InvoiceUpdate) ClearTaxLines() *InvoiceUpdate {
+	_u.mutation.ClearTaxLines()
+	return _u
+}
+
+// RemoveTaxLineIDs removes the "tax_lines" edge to InvoiceTaxLine entities by IDs.
+func (_u *InvoiceUpdate) RemoveTaxLineIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.RemoveTaxLineIDs(ids...)
+	return _u
+}
+
+// RemoveTaxLines removes "tax_lines" edges to InvoiceTaxLine entities.
+func (_u *InvoiceUpdate) RemoveTaxLines(v ...*InvoiceTaxLine) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaxLineIDs(ids...)
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *InvoiceUpdate) ClearTransaction() *InvoiceUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearOriginalInvoice clears the "original_invoice" edge to the Invoice entity.
+func (_u *InvoiceUpdate) ClearOriginalInvoice() *InvoiceUpdate {
+	_u.mutation.ClearOriginalInvoice()
+	return _u
+}
+
+// ClearCreditNotes clears all "credit_notes" edges to the Invoice entity.
+func (_u *InvoiceUpdate) ClearCreditNotes() *InvoiceUpdate {
+	_u.mutation.ClearCreditNotes()
+	return _u
+}
+
+// RemoveCreditNoteIDs removes the "credit_notes" edge to Invoice entities by IDs.
+func (_u *InvoiceUpdate) RemoveCreditNoteIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.RemoveCreditNoteIDs(ids...)
+	return _u
+}
+
+// RemoveCreditNotes removes "credit_notes" edges to Invoice entities.
+func (_u *InvoiceUpdate) RemoveCreditNotes(v ...*Invoice) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreditNoteIDs(ids...)
+}
+
+// ClearAllocations clears all "allocations" edges to the PaymentAllocation entity.
+func (_u *InvoiceUpdate) ClearAllocations() *InvoiceUpdate {
+	_u.mutation.ClearAllocations()
+	return _u
+}
+
+// RemoveAllocationIDs removes the "allocations" edge to PaymentAllocation entities by IDs.
+func (_u *InvoiceUpdate) RemoveAllocationIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.RemoveAllocationIDs(ids...)
+	return _u
+}
+
+// RemoveAllocations removes "allocations" edges to PaymentAllocation entities.
+func (_u *InvoiceUpdate) RemoveAllocations(v ...*PaymentAllocation) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllocationIDs(ids...)
+}
+
+// ClearCreditApplications clears all "credit_applications" edges to the PaymentAllocation entity.
+func (_u *InvoiceUpdate) ClearCreditApplications() *InvoiceUpdate {
+	_u.mutation.ClearCreditApplications()
+	return _u
+}
+
+// RemoveCreditApplicationIDs removes the "credit_applications" edge to PaymentAllocation entities by IDs.
+func (_u *InvoiceUpdate) RemoveCreditApplicationIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.RemoveCreditApplicationIDs(ids...)
+	return _u
+}
+
+// RemoveCreditApplications removes "credit_applications" edges to PaymentAllocation entities.
+func (_u *InvoiceUpdate) RemoveCreditApplications(v ...*PaymentAllocation) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreditApplicationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.tenant"`)
+	}
+	if _u.mutation.CustomerCleared() && len(_u.mutation.CustomerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.customer"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InvoiceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoiceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IssueDate(); ok {
+		_spec.SetField(invoice.FieldIssueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(invoice.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(invoice.FieldExchangeRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxTotal(); ok {
+		_spec.SetField(invoice.FieldTaxTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AmountSettled(); ok {
+		_spec.SetField(invoice.FieldAmountSettled, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(invoice.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(invoice.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.PdfPath(); ok {
+		_spec.SetField(invoice.FieldPdfPath, field.TypeString, value)
+	}
+	if _u.mutation.PdfPathCleared() {
+		_spec.ClearField(invoice.FieldPdfPath, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.TenantTable,
+			Columns: []string{invoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.TenantTable,
+			Columns: []string{invoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TaxLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.TaxLinesTable,
+			Columns: []string{invoice.TaxLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTaxLinesIDs(); len(nodes) > 0 && !_u.mutation.TaxLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.TaxLinesTable,
+			Columns: []string{invoice.TaxLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaxLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.TaxLinesTable,
+			Columns: []string{invoice.TaxLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.TransactionTable,
+			Columns: []string{invoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.TransactionTable,
+			Columns: []string{invoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OriginalInvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalInvoiceTable,
+			Columns: []string{invoice.OriginalInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OriginalInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalInvoiceTable,
+			Columns: []string{invoice.OriginalInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreditNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCreditNotesIDs(); len(nodes) > 0 && !_u.mutation.CreditNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AllocationsTable,
+			Columns: []string{invoice.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllocationsIDs(); len(nodes) > 0 && !_u.mutation.AllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AllocationsTable,
+			Columns: []string{invoice.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AllocationsTable,
+			Columns: []string{invoice.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreditApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditApplicationsTable,
+			Columns: []string{invoice.CreditApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCreditApplicationsIDs(); len(nodes) > 0 && !_u.mutation.CreditApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditApplicationsTable,
+			Columns: []string{invoice.CreditApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreditApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditApplicationsTable,
+			Columns: []string{invoice.CreditApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InvoiceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetNumber sets the "number" field.
+func (_u *InvoiceUpdateOne) SetNumber(v string) *InvoiceUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableNumber(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvoiceUpdateOne) SetKind(v invoice.Kind) *InvoiceUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableKind(v *invoice.Kind) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InvoiceUpdateOne) SetStatus(v invoice.Status) *InvoiceUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableStatus(v *invoice.Status) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetIssueDate sets the "issue_date" field.
+func (_u *InvoiceUpdateOne) SetIssueDate(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetIssueDate(v)
+	return _u
+}
+
+// SetNillableIssueDate sets the "issue_date" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableIssueDate(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetIssueDate(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *InvoiceUpdateOne) SetDueDate(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableDueDate(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *InvoiceUpdateOne) SetCurrency(v string) *InvoiceUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableCurrency(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *InvoiceUpdateOne) SetExchangeRate(v decimal.Decimal) *InvoiceUpdateOne {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableExchangeRate(v *decimal.Decimal) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_u *InvoiceUpdateOne) SetSubtotal(v decimal.Decimal) *InvoiceUpdateOne {
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSubtotal(v *decimal.Decimal) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_u *InvoiceUpdateOne) SetTaxTotal(v decimal.Decimal) *InvoiceUpdateOne {
+	_u.mutation.SetTaxTotal(v)
+	return _u
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableTaxTotal(v *decimal.Decimal) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetTaxTotal(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *InvoiceUpdateOne) SetTotal(v decimal.Decimal) *InvoiceUpdateOne {
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableTotal(v *decimal.Decimal) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// SetAmountSettled sets the "amount_settled" field.
+func (_u *InvoiceUpdateOne) SetAmountSettled(v decimal.Decimal) *InvoiceUpdateOne {
+	_u.mutation.SetAmountSettled(v)
+	return _u
+}
+
+// SetNillableAmountSettled sets the "amount_settled" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableAmountSettled(v *decimal.Decimal) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetAmountSettled(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *InvoiceUpdateOne) SetNotes(v string) *InvoiceUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableNotes(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *InvoiceUpdateOne) ClearNotes() *InvoiceUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetPdfPath sets the "pdf_path" field.
+func (_u *InvoiceUpdateOne) SetPdfPath(v string) *InvoiceUpdateOne {
+	_u.mutation.SetPdfPath(v)
+	return _u
+}
+
+// SetNillablePdfPath sets the "pdf_path" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillablePdfPath(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetPdfPath(*v)
+	}
+	return _u
+}
+
+// ClearPdfPath clears the value of the "pdf_path" field.
+func (_u *InvoiceUpdateOne) ClearPdfPath() *InvoiceUpdateOne {
+	_u.mutation.ClearPdfPath()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *InvoiceUpdateOne) SetTenantID(id int) *InvoiceUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *InvoiceUpdateOne) SetTenant(v *Tenant) *InvoiceUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_u *InvoiceUpdateOne) SetCustomerID(id int) *InvoiceUpdateOne {
+	_u.mutation.SetCustomerID(id)
+	return _u
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_u *InvoiceUpdateOne) SetCustomer(v *Customer) *InvoiceUpdateOne {
+	return _u.SetCustomerID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the InvoiceLine entity by IDs.
+func (_u *InvoiceUpdateOne) AddLineIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the InvoiceLine entity.
+func (_u *InvoiceUpdateOne) AddLines(v ...*InvoiceLine) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// AddTaxLineIDs adds the "tax_lines" edge to the InvoiceTaxLine entity by IDs.
+func (_u *InvoiceUpdateOne) AddTaxLineIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.AddTaxLineIDs(ids...)
+	return _u
+}
+
+// AddTaxLines adds the "tax_lines" edges to the InvoiceTaxLine entity.
+func (_u *InvoiceUpdateOne) AddTaxLines(v ...*InvoiceTaxLine) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaxLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *InvoiceUpdateOne) SetTransactionID(id int) *InvoiceUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableTransactionID(id *int) *InvoiceUpdateOne {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *InvoiceUpdateOne) SetTransaction(v *Transaction) *InvoiceUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetOriginalInvoiceID sets the "original_invoice" edge to the Invoice entity by ID.
+func (_u *InvoiceUpdateOne) SetOriginalInvoiceID(id int) *InvoiceUpdateOne {
+	_u.mutation.SetOriginalInvoiceID(id)
+	return _u
+}
+
+// SetNillableOriginalInvoiceID sets the "original_invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableOriginalInvoiceID(id *int) *InvoiceUpdateOne {
+	if id != nil {
+		_u = _u.SetOriginalInvoiceID(*id)
+	}
+	return _u
+}
+
+// SetOriginalInvoice sets the "original_invoice" edge to the Invoice entity.
+func (_u *InvoiceUpdateOne) SetOriginalInvoice(v *Invoice) *InvoiceUpdateOne {
+	return _u.SetOriginalInvoiceID(v.ID)
+}
+
+// AddCreditNoteIDs adds the "credit_notes" edge to the Invoice entity by IDs.
+func (_u *InvoiceUpdateOne) AddCreditNoteIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.AddCreditNoteIDs(ids...)
+	return _u
+}
+
+// AddCreditNotes adds the "credit_notes" edges to the Invoice entity.
+func (_u *InvoiceUpdateOne) AddCreditNotes(v ...*Invoice) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCreditNoteIDs(ids...)
+}
+
+// AddAllocationIDs adds the "allocations" edge to the PaymentAllocation entity by IDs.
+func (_u *InvoiceUpdateOne) AddAllocationIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.AddAllocationIDs(ids...)
+	return _u
+}
+
+// AddAllocations adds the "allocations" edges to the PaymentAllocation entity.
+func (_u *InvoiceUpdateOne) AddAllocations(v ...*PaymentAllocation) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllocationIDs(ids...)
+}
+
+// AddCreditApplicationIDs adds the "credit_applications" edge to the PaymentAllocation entity by IDs.
+func (_u *InvoiceUpdateOne) AddCreditApplicationIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.AddCreditApplicationIDs(ids...)
+	return _u
+}
+
+// AddCreditApplications adds the "credit_applications" edges to the PaymentAllocation entity.
+func (_u *InvoiceUpdateOne) AddCreditApplications(v ...*PaymentAllocation) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCreditApplicationIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *InvoiceUpdateOne) ClearTenant() *InvoiceUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (_u *InvoiceUpdateOne) ClearCustomer() *InvoiceUpdateOne {
+	_u.mutation.ClearCustomer()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the InvoiceLine entity.
+func (_u *InvoiceUpdateOne) ClearLines() *InvoiceUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to InvoiceLine entities by IDs.
+func (_u *InvoiceUpdateOne) RemoveLineIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to InvoiceLine entities.
+func (_u *InvoiceUpdateOne) RemoveLines(v ...*InvoiceLine) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearTaxLines clears all "tax_lines" edges to the InvoiceTaxLine entity.
+func (_u *InvoiceUpdateOne) ClearTaxLines() *InvoiceUpdateOne {
+	_u.mutation.ClearTaxLines()
+	return _u
+}
+
+// RemoveTaxLineIDs removes the "tax_lines" edge to InvoiceTaxLine entities by IDs.
+func (_u *InvoiceUpdateOne) RemoveTaxLineIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.RemoveTaxLineIDs(ids...)
+	return _u
+}
+
+// RemoveTaxLines removes "tax_lines" edges to InvoiceTaxLine entities.
+func (_u *InvoiceUpdateOne) RemoveTaxLines(v ...*InvoiceTaxLine) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaxLineIDs(ids...)
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *InvoiceUpdateOne) ClearTransaction() *InvoiceUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearOriginalInvoice clears the "original_invoice" edge to the Invoice entity.
+func (_u *InvoiceUpdateOne) ClearOriginalInvoice() *InvoiceUpdateOne {
+	_u.mutation.ClearOriginalInvoice()
+	return _u
+}
+
+// ClearCreditNotes clears all "credit_notes" edges to the Invoice entity.
+func (_u *InvoiceUpdateOne) ClearCreditNotes() *InvoiceUpdateOne {
+	_u.mutation.ClearCreditNotes()
+	return _u
+}
+
+// RemoveCreditNoteIDs removes the "credit_notes" edge to Invoice entities by IDs.
+func (_u *InvoiceUpdateOne) RemoveCreditNoteIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.RemoveCreditNoteIDs(ids...)
+	return _u
+}
+
+// RemoveCreditNotes removes "credit_notes" edges to Invoice entities.
+func (_u *InvoiceUpdateOne) RemoveCreditNotes(v ...*Invoice) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreditNoteIDs(ids...)
+}
+
+// ClearAllocations clears all "allocations" edges to the PaymentAllocation entity.
+func (_u *InvoiceUpdateOne) ClearAllocations() *InvoiceUpdateOne {
+	_u.mutation.ClearAllocations()
+	return _u
+}
+
+// RemoveAllocationIDs removes the "allocations" edge to PaymentAllocation entities by IDs.
+func (_u *InvoiceUpdateOne) RemoveAllocationIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.RemoveAllocationIDs(ids...)
+	return _u
+}
+
+// RemoveAllocations removes "allocations" edges to PaymentAllocation entities.
+func (_u *InvoiceUpdateOne) RemoveAllocations(v ...*PaymentAllocation) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllocationIDs(ids...)
+}
+
+// ClearCreditApplications clears all "credit_applications" edges to the PaymentAllocation entity.
+func (_u *InvoiceUpdateOne) ClearCreditApplications() *InvoiceUpdateOne {
+	_u.mutation.ClearCreditApplications()
+	return _u
+}
+
+// RemoveCreditApplicationIDs removes the "credit_applications" edge to PaymentAllocation entities by IDs.
+func (_u *InvoiceUpdateOne) RemoveCreditApplicationIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.RemoveCreditApplicationIDs(ids...)
+	return _u
+}
+
+// RemoveCreditApplications removes "credit_applications" edges to PaymentAllocation entities.
+func (_u *InvoiceUpdateOne) RemoveCreditApplications(v ...*PaymentAllocation) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreditApplicationIDs(ids...)
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (_u *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.tenant"`)
+	}
+	if _u.mutation.CustomerCleared() && len(_u.mutation.CustomerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.customer"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InvoiceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoiceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IssueDate(); ok {
+		_spec.SetField(invoice.FieldIssueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(invoice.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(invoice.FieldExchangeRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxTotal(); ok {
+		_spec.SetField(invoice.FieldTaxTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AmountSettled(); ok {
+		_spec.SetField(invoice.FieldAmountSettled, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(invoice.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(invoice.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.PdfPath(); ok {
+		_spec.SetField(invoice.FieldPdfPath, field.TypeString, value)
+	}
+	if _u.mutation.PdfPathCleared() {
+		_spec.ClearField(invoice.FieldPdfPath, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.TenantTable,
+			Columns: []string{invoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.TenantTable,
+			Columns: []string{invoice.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TaxLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.TaxLinesTable,
+			Columns: []string{invoice.TaxLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTaxLinesIDs(); len(nodes) > 0 && !_u.mutation.TaxLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.TaxLinesTable,
+			Columns: []string{invoice.TaxLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaxLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.TaxLinesTable,
+			Columns: []string{invoice.TaxLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.TransactionTable,
+			Columns: []string{invoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.TransactionTable,
+			Columns: []string{invoice.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OriginalInvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalInvoiceTable,
+			Columns: []string{invoice.OriginalInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OriginalInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalInvoiceTable,
+			Columns: []string{invoice.OriginalInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreditNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCreditNotesIDs(); len(nodes) > 0 && !_u.mutation.CreditNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AllocationsTable,
+			Columns: []string{invoice.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllocationsIDs(); len(nodes) > 0 && !_u.mutation.AllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AllocationsTable,
+			Columns: []string{invoice.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AllocationsTable,
+			Columns: []string{invoice.AllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreditApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditApplicationsTable,
+			Columns: []string{invoice.CreditApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCreditApplicationsIDs(); len(nodes) > 0 && !_u.mutation.CreditApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditApplicationsTable,
+			Columns: []string{invoice.CreditApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreditApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditApplicationsTable,
+			Columns: []string{invoice.CreditApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Invoice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceLine is the model entity for the InvoiceLine schema.
+type InvoiceLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice decimal.Decimal `json:"unit_price,omitempty"`
+	// TaxCode holds the value of the "tax_code" field.
+	TaxCode string `json:"tax_code,omitempty"`
+	// TaxRate holds the value of the "tax_rate" field.
+	TaxRate decimal.Decimal `json:"tax_rate,omitempty"`
+	// NetAmount holds the value of the "net_amount" field.
+	NetAmount decimal.Decimal `json:"net_amount,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceLineQuery when eager-loading is set.
+	Edges                InvoiceLineEdges `json:"edges"`
+	invoice_lines        *int
+	invoice_line_account *int
+	selectValues         sql.SelectValues
+}
+
+// InvoiceLineEdges holds the relations/edges for other nodes in the graph.
+type InvoiceLineEdges struct {
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceLineEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceLineEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoiceline.FieldQuantity, invoiceline.FieldUnitPrice, invoiceline.FieldTaxRate, invoiceline.FieldNetAmount:
+			values[i] = new(decimal.Decimal)
+		case invoiceline.FieldID, invoiceline.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case invoiceline.FieldDescription, invoiceline.FieldTaxCode:
+			values[i] = new(sql.NullString)
+		case invoiceline.ForeignKeys[0]: // invoice_lines
+			values[i] = new(sql.NullInt64)
+		case invoiceline.ForeignKeys[1]: // invoice_line_account
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceLine fields.
+func (_m *InvoiceLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoiceline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case invoiceline.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case invoiceline.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case invoiceline.FieldUnitPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil {
+				_m.UnitPrice = *value
+			}
+		case invoiceline.FieldTaxCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_code", values[i])
+			} else if value.Valid {
+				_m.TaxCode = value.String
+			}
+		case invoiceline.FieldTaxRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value != nil {
+				_m.TaxRate = *value
+			}
+		case invoiceline.FieldNetAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field net_amount", values[i])
+			} else if value != nil {
+				_m.NetAmount = *value
+			}
+		case invoiceline.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case invoiceline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_lines", value)
+			} else if value.Valid {
+				_m.invoice_lines = new(int)
+				*_m.invoice_lines = int(value.Int64)
+			}
+		case invoiceline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_line_account", value)
+			} else if value.Valid {
+				_m.invoice_line_account = new(int)
+				*_m.invoice_line_account = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvoiceLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *InvoiceLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryInvoice queries the "invoice" edge of the InvoiceLine entity.
+func (_m *InvoiceLine) QueryInvoice() *InvoiceQuery {
+	return NewInvoiceLineClient(_m.config).QueryInvoice(_m)
+}
+
+// QueryAccount queries the "account" edge of the InvoiceLine entity.
+func (_m *InvoiceLine) QueryAccount() *AccountQuery {
+	return NewInvoiceLineClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this InvoiceLine.
+// Note that you need to call InvoiceLine.Unwrap() before calling this method if this InvoiceLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InvoiceLine) Update() *InvoiceLineUpdateOne {
+	return NewInvoiceLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InvoiceLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InvoiceLine) Unwrap() *InvoiceLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InvoiceLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteString(", ")
+	builder.WriteString("tax_code=")
+	builder.WriteString(_m.TaxCode)
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("net_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceLines is a parsable slice of InvoiceLine.
+type InvoiceLines []*InvoiceLine
@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceLineCreate is the builder for creating a InvoiceLine entity.
+type InvoiceLineCreate struct {
+	config
+	mutation *InvoiceLineMutation
+	hooks    []Hook
+}
+
+// SetDescription sets the "description" field.
+func (_c *InvoiceLineCreate) SetDescription(v string) *InvoiceLineCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *InvoiceLineCreate) SetQuantity(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableQuantity(v *decimal.Decimal) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *InvoiceLineCreate) SetUnitPrice(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableUnitPrice(v *decimal.Decimal) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_c *InvoiceLineCreate) SetTaxCode(v string) *InvoiceLineCreate {
+	_c.mutation.SetTaxCode(v)
+	return _c
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableTaxCode(v *string) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetTaxCode(*v)
+	}
+	return _c
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_c *InvoiceLineCreate) SetTaxRate(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetTaxRate(v)
+	return _c
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableTaxRate(v *decimal.Decimal) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetTaxRate(*v)
+	}
+	return _c
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_c *InvoiceLineCreate) SetNetAmount(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetNetAmount(v)
+	return _c
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableNetAmount(v *decimal.Decimal) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetNetAmount(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *InvoiceLineCreate) SetPosition(v int) *InvoiceLineCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillablePosition(v *int) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_c *InvoiceLineCreate) SetInvoiceID(id int) *InvoiceLineCreate {
+	_c.mutation.SetInvoiceID(id)
+	return _c
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *InvoiceLineCreate) SetInvoice(v *Invoice) *InvoiceLineCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *InvoiceLineCreate) SetAccountID(id int) *InvoiceLineCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *InvoiceLineCreate) SetAccount(v *Account) *InvoiceLineCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (_c *InvoiceLineCreate) Mutation() *InvoiceLineMutation {
+	return _c.mutation
+}
+
+// Save creates the InvoiceLine in the database.
+func (_c *InvoiceLineCreate) Save(ctx context.Context) (*InvoiceLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceLineCreate) SaveX(ctx context.Context) *InvoiceLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceLineCreate) defaults() {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := invoiceline.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		v := invoiceline.DefaultUnitPrice
+		_c.mutation.SetUnitPrice(v)
+	}
+	if _, ok := _c.mutation.TaxRate(); !ok {
+		v := invoiceline.DefaultTaxRate
+		_c.mutation.SetTaxRate(v)
+	}
+	if _, ok := _c.mutation.NetAmount(); !ok {
+		v := invoiceline.DefaultNetAmount
+		_c.mutation.SetNetAmount(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := invoiceline.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceLineCreate) check() error {
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "InvoiceLine.description"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "InvoiceLine.quantity"`)}
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "InvoiceLine.unit_price"`)}
+	}
+	if _, ok := _c.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "InvoiceLine.tax_rate"`)}
+	}
+	if _, ok := _c.mutation.NetAmount(); !ok {
+		return &ValidationError{Name: "net_amount", err: errors.New(`ent: missing required field "InvoiceLine.net_amount"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "InvoiceLine.position"`)}
+	}
+	if len(_c.mutation.InvoiceIDs()) == 0 {
+		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "InvoiceLine.invoice"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "InvoiceLine.account"`)}
+	}
+	return nil
+}
+
+func (_c *InvoiceLineCreate) sqlSave(ctx context.Context) (*InvoiceLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceLineCreate) createSpec() (*InvoiceLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoiceline.Table, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(invoiceline.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(invoiceline.FieldUnitPrice, field.TypeOther, value)
+		_node.UnitPrice = value
+	}
+	if value, ok := _c.mutation.TaxCode(); ok {
+		_spec.SetField(invoiceline.FieldTaxCode, field.TypeString, value)
+		_node.TaxCode = value
+	}
+	if value, ok := _c.mutation.TaxRate(); ok {
+		_spec.SetField(invoiceline.FieldTaxRate, field.TypeOther, value)
+		_node.TaxRate = value
+	}
+	if value, ok := _c.mutation.NetAmount(); ok {
+		_spec.SetField(invoiceline.FieldNetAmount, field.TypeOther, value)
+		_node.NetAmount = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(invoiceline.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.AccountTable,
+			Columns: []string{invoiceline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_line_account = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InvoiceLineCreateBulk is the builder for creating many InvoiceLine entities in bulk.
+type InvoiceLineCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceLineCreate
+}
+
+// Save creates the InvoiceLine entities in the database.
+func (_c *InvoiceLineCreateBulk) Save(ctx context.Context) ([]*InvoiceLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvoiceLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceLineCreateBulk) SaveX(ctx context.Context) []*InvoiceLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
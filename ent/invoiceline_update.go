@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceLineUpdate is the builder for updating InvoiceLine entities.
+type InvoiceLineUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *InvoiceLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the InvoiceLineUpdate builder.
+func (_u *InvoiceLineUpdate) Where(ps ...predicate.InvoiceLine) *InvoiceLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *InvoiceLineUpdate) SetDescription(v string) *InvoiceLineUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableDescription(v *string) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *InvoiceLineUpdate) SetQuantity(v decimal.Decimal) *InvoiceLineUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableQuantity(v *decimal.Decimal) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *InvoiceLineUpdate) SetUnitPrice(v decimal.Decimal) *InvoiceLineUpdate {
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableUnitPrice(v *decimal.Decimal) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *InvoiceLineUpdate) SetTaxCode(v string) *InvoiceLineUpdate {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableTaxCode(v *string) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (_u *InvoiceLineUpdate) ClearTaxCode() *InvoiceLineUpdate {
+	_u.mutation.ClearTaxCode()
+	return _u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_u *InvoiceLineUpdate) SetTaxRate(v decimal.Decimal) *InvoiceLineUpdate {
+	_u.mutation.SetTaxRate(v)
+	return _u
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableTaxRate(v *decimal.Decimal) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetTaxRate(*v)
+	}
+	return _u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_u *InvoiceLineUpdate) SetNetAmount(v decimal.Decimal) *InvoiceLineUpdate {
+	_u.mutation.SetNetAmount(v)
+	return _u
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableNetAmount(v *decimal.Decimal) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetNetAmount(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *InvoiceLineUpdate) SetPosition(v int) *InvoiceLineUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillablePosition(v *int) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *InvoiceLineUpdate) AddPosition(v int) *InvoiceLineUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *InvoiceLineUpdate) SetInvoiceID(id int) *InvoiceLineUpdate {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *InvoiceLineUpdate) SetInvoice(v *Invoice) *InvoiceLineUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *InvoiceLineUpdate) SetAccountID(id int) *InvoiceLineUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *InvoiceLineUpdate) SetAccount(v *Account) *InvoiceLineUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (_u *InvoiceLineUpdate) Mutation() *InvoiceLineMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *InvoiceLineUpdate) ClearInvoice() *InvoiceLineUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *InvoiceLineUpdate) ClearAccount() *InvoiceLineUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoiceLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoiceLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceLineUpdate) check() error {
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoiceLine.invoice"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoiceLine.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InvoiceLineUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoiceLineUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *InvoiceLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoiceline.Table, invoiceline.Columns, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(invoiceline.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(invoiceline.FieldUnitPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(invoiceline.FieldTaxCode, field.TypeString, value)
+	}
+	if _u.mutation.TaxCodeCleared() {
+		_spec.ClearField(invoiceline.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxRate(); ok {
+		_spec.SetField(invoiceline.FieldTaxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.NetAmount(); ok {
+		_spec.SetField(invoiceline.FieldNetAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(invoiceline.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(invoiceline.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.AccountTable,
+			Columns: []string{invoiceline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.AccountTable,
+			Columns: []string{invoiceline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoiceLineUpdateOne is the builder for updating a single InvoiceLine entity.
+type InvoiceLineUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InvoiceLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDescription sets the "description" field.
+func (_u *InvoiceLineUpdateOne) SetDescription(v string) *InvoiceLineUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableDescription(v *string) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *InvoiceLineUpdateOne) SetQuantity(v decimal.Decimal) *InvoiceLineUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableQuantity(v *decimal.Decimal) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *InvoiceLineUpdateOne) SetUnitPrice(v decimal.Decimal) *InvoiceLineUpdateOne {
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableUnitPrice(v *decimal.Decimal) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *InvoiceLineUpdateOne) SetTaxCode(v string) *InvoiceLineUpdateOne {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableTaxCode(v *string) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (_u *InvoiceLineUpdateOne) ClearTaxCode() *InvoiceLineUpdateOne {
+	_u.mutation.ClearTaxCode()
+	return _u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_u *InvoiceLineUpdateOne) SetTaxRate(v decimal.Decimal) *InvoiceLineUpdateOne {
+	_u.mutation.SetTaxRate(v)
+	return _u
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableTaxRate(v *decimal.Decimal) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetTaxRate(*v)
+	}
+	return _u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_u *InvoiceLineUpdateOne) SetNetAmount(v decimal.Decimal) *InvoiceLineUpdateOne {
+	_u.mutation.SetNetAmount(v)
+	return _u
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableNetAmount(v *decimal.Decimal) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetNetAmount(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *InvoiceLineUpdateOne) SetPosition(v int) *InvoiceLineUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillablePosition(v *int) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *InvoiceLineUpdateOne) AddPosition(v int) *InvoiceLineUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *InvoiceLineUpdateOne) SetInvoiceID(id int) *InvoiceLineUpdateOne {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *InvoiceLineUpdateOne) SetInvoice(v *Invoice) *InvoiceLineUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *InvoiceLineUpdateOne) SetAccountID(id int) *InvoiceLineUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *InvoiceLineUpdateOne) SetAccount(v *Account) *InvoiceLineUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (_u *InvoiceLineUpdateOne) Mutation() *InvoiceLineMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *InvoiceLineUpdateOne) ClearInvoice() *InvoiceLineUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *InvoiceLineUpdateOne) ClearAccount() *InvoiceLineUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the InvoiceLineUpdate builder.
+func (_u *InvoiceLineUpdateOne) Where(ps ...predicate.InvoiceLine) *InvoiceLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoiceLineUpdateOne) Select(field string, fields ...string) *InvoiceLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InvoiceLine entity.
+func (_u *InvoiceLineUpdateOne) Save(ctx context.Context) (*InvoiceLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceLineUpdateOne) SaveX(ctx context.Context) *InvoiceLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoiceLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceLineUpdateOne) check() error {
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoiceLine.invoice"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoiceLine.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InvoiceLineUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoiceLineUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *InvoiceLineUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoiceline.Table, invoiceline.Columns, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoiceLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoiceline.FieldID)
+		for _, f := range fields {
+			if !invoiceline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoiceline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(invoiceline.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(invoiceline.FieldUnitPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(invoiceline.FieldTaxCode, field.TypeString, value)
+	}
+	if _u.mutation.TaxCodeCleared() {
+		_spec.ClearField(invoiceline.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxRate(); ok {
+		_spec.SetField(invoiceline.FieldTaxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.NetAmount(); ok {
+		_spec.SetField(invoiceline.FieldNetAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(invoiceline.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(invoiceline.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.AccountTable,
+			Columns: []string{invoiceline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.AccountTable,
+			Columns: []string{invoiceline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &InvoiceLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
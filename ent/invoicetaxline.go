@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/invoice"
+	"sent/ent/invoicetaxline"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceTaxLine is the model entity for the InvoiceTaxLine schema.
+type InvoiceTaxLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaxCode holds the value of the "tax_code" field.
+	TaxCode string `json:"tax_code,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// TaxableAmount holds the value of the "taxable_amount" field.
+	TaxableAmount decimal.Decimal `json:"taxable_amount,omitempty"`
+	// TaxAmount holds the value of the "tax_amount" field.
+	TaxAmount decimal.Decimal `json:"tax_amount,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceTaxLineQuery when eager-loading is set.
+	Edges                    InvoiceTaxLineEdges `json:"edges"`
+	invoice_tax_lines        *int
+	invoice_tax_line_account *int
+	selectValues             sql.SelectValues
+}
+
+// InvoiceTaxLineEdges holds the relations/edges for other nodes in the graph.
+type InvoiceTaxLineEdges struct {
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceTaxLineEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceTaxLineEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceTaxLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoicetaxline.FieldRate, invoicetaxline.FieldTaxableAmount, invoicetaxline.FieldTaxAmount:
+			values[i] = new(decimal.Decimal)
+		case invoicetaxline.FieldID:
+			values[i] = new(sql.NullInt64)
+		case invoicetaxline.FieldTaxCode:
+			values[i] = new(sql.NullString)
+		case invoicetaxline.ForeignKeys[0]: // invoice_tax_lines
+			values[i] = new(sql.NullInt64)
+		case invoicetaxline.ForeignKeys[1]: // invoice_tax_line_account
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceTaxLine fields.
+func (_m *InvoiceTaxLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoicetaxline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case invoicetaxline.FieldTaxCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_code", values[i])
+			} else if value.Valid {
+				_m.TaxCode = value.String
+			}
+		case invoicetaxline.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				_m.Rate = *value
+			}
+		case invoicetaxline.FieldTaxableAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field taxable_amount", values[i])
+			} else if value != nil {
+				_m.TaxableAmount = *value
+			}
+		case invoicetaxline.FieldTaxAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value != nil {
+				_m.TaxAmount = *value
+			}
+		case invoicetaxline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_tax_lines", value)
+			} else if value.Valid {
+				_m.invoice_tax_lines = new(int)
+				*_m.invoice_tax_lines = int(value.Int64)
+			}
+		case invoicetaxline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_tax_line_account", value)
+			} else if value.Valid {
+				_m.invoice_tax_line_account = new(int)
+				*_m.invoice_tax_line_account = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvoiceTaxLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *InvoiceTaxLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryInvoice queries the "invoice" edge of the InvoiceTaxLine entity.
+func (_m *InvoiceTaxLine) QueryInvoice() *InvoiceQuery {
+	return NewInvoiceTaxLineClient(_m.config).QueryInvoice(_m)
+}
+
+// QueryAccount queries the "account" edge of the InvoiceTaxLine entity.
+func (_m *InvoiceTaxLine) QueryAccount() *AccountQuery {
+	return NewInvoiceTaxLineClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this InvoiceTaxLine.
+// Note that you need to call InvoiceTaxLine.Unwrap() before calling this method if this InvoiceTaxLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InvoiceTaxLine) Update() *InvoiceTaxLineUpdateOne {
+	return NewInvoiceTaxLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InvoiceTaxLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InvoiceTaxLine) Unwrap() *InvoiceTaxLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceTaxLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InvoiceTaxLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceTaxLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tax_code=")
+	builder.WriteString(_m.TaxCode)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("taxable_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxableAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxAmount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceTaxLines is a parsable slice of InvoiceTaxLine.
+type InvoiceTaxLines []*InvoiceTaxLine
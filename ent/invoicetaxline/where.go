@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicetaxline
+
+import (
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLTE(FieldID, id))
+}
+
+// TaxCode applies equality check predicate on the "tax_code" field. It's identical to TaxCodeEQ.
+func TaxCode(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldTaxCode, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldRate, v))
+}
+
+// TaxableAmount applies equality check predicate on the "taxable_amount" field. It's identical to TaxableAmountEQ.
+func TaxableAmount(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldTaxableAmount, v))
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TaxCodeEQ applies the EQ predicate on the "tax_code" field.
+func TaxCodeEQ(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldTaxCode, v))
+}
+
+// TaxCodeNEQ applies the NEQ predicate on the "tax_code" field.
+func TaxCodeNEQ(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNEQ(FieldTaxCode, v))
+}
+
+// TaxCodeIn applies the In predicate on the "tax_code" field.
+func TaxCodeIn(vs ...string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldIn(FieldTaxCode, vs...))
+}
+
+// TaxCodeNotIn applies the NotIn predicate on the "tax_code" field.
+func TaxCodeNotIn(vs ...string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNotIn(FieldTaxCode, vs...))
+}
+
+// TaxCodeGT applies the GT predicate on the "tax_code" field.
+func TaxCodeGT(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGT(FieldTaxCode, v))
+}
+
+// TaxCodeGTE applies the GTE predicate on the "tax_code" field.
+func TaxCodeGTE(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGTE(FieldTaxCode, v))
+}
+
+// TaxCodeLT applies the LT predicate on the "tax_code" field.
+func TaxCodeLT(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLT(FieldTaxCode, v))
+}
+
+// TaxCodeLTE applies the LTE predicate on the "tax_code" field.
+func TaxCodeLTE(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLTE(FieldTaxCode, v))
+}
+
+// TaxCodeContains applies the Contains predicate on the "tax_code" field.
+func TaxCodeContains(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldContains(FieldTaxCode, v))
+}
+
+// TaxCodeHasPrefix applies the HasPrefix predicate on the "tax_code" field.
+func TaxCodeHasPrefix(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldHasPrefix(FieldTaxCode, v))
+}
+
+// TaxCodeHasSuffix applies the HasSuffix predicate on the "tax_code" field.
+func TaxCodeHasSuffix(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldHasSuffix(FieldTaxCode, v))
+}
+
+// TaxCodeEqualFold applies the EqualFold predicate on the "tax_code" field.
+func TaxCodeEqualFold(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEqualFold(FieldTaxCode, v))
+}
+
+// TaxCodeContainsFold applies the ContainsFold predicate on the "tax_code" field.
+func TaxCodeContainsFold(v string) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldContainsFold(FieldTaxCode, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLTE(FieldRate, v))
+}
+
+// TaxableAmountEQ applies the EQ predicate on the "taxable_amount" field.
+func TaxableAmountEQ(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldTaxableAmount, v))
+}
+
+// TaxableAmountNEQ applies the NEQ predicate on the "taxable_amount" field.
+func TaxableAmountNEQ(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNEQ(FieldTaxableAmount, v))
+}
+
+// TaxableAmountIn applies the In predicate on the "taxable_amount" field.
+func TaxableAmountIn(vs ...decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldIn(FieldTaxableAmount, vs...))
+}
+
+// TaxableAmountNotIn applies the NotIn predicate on the "taxable_amount" field.
+func TaxableAmountNotIn(vs ...decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNotIn(FieldTaxableAmount, vs...))
+}
+
+// TaxableAmountGT applies the GT predicate on the "taxable_amount" field.
+func TaxableAmountGT(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGT(FieldTaxableAmount, v))
+}
+
+// TaxableAmountGTE applies the GTE predicate on the "taxable_amount" field.
+func TaxableAmountGTE(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGTE(FieldTaxableAmount, v))
+}
+
+// TaxableAmountLT applies the LT predicate on the "taxable_amount" field.
+func TaxableAmountLT(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLT(FieldTaxableAmount, v))
+}
+
+// TaxableAmountLTE applies the LTE predicate on the "taxable_amount" field.
+func TaxableAmountLTE(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLTE(FieldTaxableAmount, v))
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldNotIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGT(FieldTaxAmount, v))
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldGTE(FieldTaxAmount, v))
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLT(FieldTaxAmount, v))
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v decimal.Decimal) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.FieldLTE(FieldTaxAmount, v))
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(func(s *sql.Selector) {
+		step := newInvoiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceTaxLine) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceTaxLine) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceTaxLine) predicate.InvoiceTaxLine {
+	return predicate.InvoiceTaxLine(sql.NotPredicates(p))
+}
@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/invoice"
+	"sent/ent/invoicetaxline"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceTaxLineCreate is the builder for creating a InvoiceTaxLine entity.
+type InvoiceTaxLineCreate struct {
+	config
+	mutation *InvoiceTaxLineMutation
+	hooks    []Hook
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_c *InvoiceTaxLineCreate) SetTaxCode(v string) *InvoiceTaxLineCreate {
+	_c.mutation.SetTaxCode(v)
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *InvoiceTaxLineCreate) SetRate(v decimal.Decimal) *InvoiceTaxLineCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_c *InvoiceTaxLineCreate) SetNillableRate(v *decimal.Decimal) *InvoiceTaxLineCreate {
+	if v != nil {
+		_c.SetRate(*v)
+	}
+	return _c
+}
+
+// SetTaxableAmount sets the "taxable_amount" field.
+func (_c *InvoiceTaxLineCreate) SetTaxableAmount(v decimal.Decimal) *InvoiceTaxLineCreate {
+	_c.mutation.SetTaxableAmount(v)
+	return _c
+}
+
+// SetNillableTaxableAmount sets the "taxable_amount" field if the given value is not nil.
+func (_c *InvoiceTaxLineCreate) SetNillableTaxableAmount(v *decimal.Decimal) *InvoiceTaxLineCreate {
+	if v != nil {
+		_c.SetTaxableAmount(*v)
+	}
+	return _c
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_c *InvoiceTaxLineCreate) SetTaxAmount(v decimal.Decimal) *InvoiceTaxLineCreate {
+	_c.mutation.SetTaxAmount(v)
+	return _c
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_c *InvoiceTaxLineCreate) SetNillableTaxAmount(v *decimal.Decimal) *InvoiceTaxLineCreate {
+	if v != nil {
+		_c.SetTaxAmount(*v)
+	}
+	return _c
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_c *InvoiceTaxLineCreate) SetInvoiceID(id int) *InvoiceTaxLineCreate {
+	_c.mutation.SetInvoiceID(id)
+	return _c
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *InvoiceTaxLineCreate) SetInvoice(v *Invoice) *InvoiceTaxLineCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *InvoiceTaxLineCreate) SetAccountID(id int) *InvoiceTaxLineCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *InvoiceTaxLineCreate) SetAccount(v *Account) *InvoiceTaxLineCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoiceTaxLineMutation object of the builder.
+func (_c *InvoiceTaxLineCreate) Mutation() *InvoiceTaxLineMutation {
+	return _c.mutation
+}
+
+// Save creates the InvoiceTaxLine in the database.
+func (_c *InvoiceTaxLineCreate) Save(ctx context.Context) (*InvoiceTaxLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceTaxLineCreate) SaveX(ctx context.Context) *InvoiceTaxLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceTaxLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceTaxLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceTaxLineCreate) defaults() {
+	if _, ok := _c.mutation.Rate(); !ok {
+		v := invoicetaxline.DefaultRate
+		_c.mutation.SetRate(v)
+	}
+	if _, ok := _c.mutation.TaxableAmount(); !ok {
+		v := invoicetaxline.DefaultTaxableAmount
+		_c.mutation.SetTaxableAmount(v)
+	}
+	if _, ok := _c.mutation.TaxAmount(); !ok {
+		v := invoicetaxline.DefaultTaxAmount
+		_c.mutation.SetTaxAmount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceTaxLineCreate) check() error {
+	if _, ok := _c.mutation.TaxCode(); !ok {
+		return &ValidationError{Name: "tax_code", err: errors.New(`ent: missing required field "InvoiceTaxLine.tax_code"`)}
+	}
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "InvoiceTaxLine.rate"`)}
+	}
+	if _, ok := _c.mutation.TaxableAmount(); !ok {
+		return &ValidationError{Name: "taxable_amount", err: errors.New(`ent: missing required field "InvoiceTaxLine.taxable_amount"`)}
+	}
+	if _, ok := _c.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "InvoiceTaxLine.tax_amount"`)}
+	}
+	if len(_c.mutation.InvoiceIDs()) == 0 {
+		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "InvoiceTaxLine.invoice"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "InvoiceTaxLine.account"`)}
+	}
+	return nil
+}
+
+func (_c *InvoiceTaxLineCreate) sqlSave(ctx context.Context) (*InvoiceTaxLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceTaxLineCreate) createSpec() (*InvoiceTaxLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceTaxLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoicetaxline.Table, sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TaxCode(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxCode, field.TypeString, value)
+		_node.TaxCode = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(invoicetaxline.FieldRate, field.TypeOther, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.TaxableAmount(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxableAmount, field.TypeOther, value)
+		_node.TaxableAmount = value
+	}
+	if value, ok := _c.mutation.TaxAmount(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxAmount, field.TypeOther, value)
+		_node.TaxAmount = value
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoicetaxline.InvoiceTable,
+			Columns: []string{invoicetaxline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_tax_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicetaxline.AccountTable,
+			Columns: []string{invoicetaxline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_tax_line_account = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InvoiceTaxLineCreateBulk is the builder for creating many InvoiceTaxLine entities in bulk.
+type InvoiceTaxLineCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceTaxLineCreate
+}
+
+// Save creates the InvoiceTaxLine entities in the database.
+func (_c *InvoiceTaxLineCreateBulk) Save(ctx context.Context) ([]*InvoiceTaxLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvoiceTaxLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceTaxLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceTaxLineCreateBulk) SaveX(ctx context.Context) []*InvoiceTaxLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceTaxLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceTaxLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/invoice"
+	"sent/ent/invoicetaxline"
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceTaxLineUpdate is the builder for updating InvoiceTaxLine entities.
+type InvoiceTaxLineUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *InvoiceTaxLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the InvoiceTaxLineUpdate builder.
+func (_u *InvoiceTaxLineUpdate) Where(ps ...predicate.InvoiceTaxLine) *InvoiceTaxLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *InvoiceTaxLineUpdate) SetTaxCode(v string) *InvoiceTaxLineUpdate {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *InvoiceTaxLineUpdate) SetNillableTaxCode(v *string) *InvoiceTaxLineUpdate {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *InvoiceTaxLineUpdate) SetRate(v decimal.Decimal) *InvoiceTaxLineUpdate {
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *InvoiceTaxLineUpdate) SetNillableRate(v *decimal.Decimal) *InvoiceTaxLineUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// SetTaxableAmount sets the "taxable_amount" field.
+func (_u *InvoiceTaxLineUpdate) SetTaxableAmount(v decimal.Decimal) *InvoiceTaxLineUpdate {
+	_u.mutation.SetTaxableAmount(v)
+	return _u
+}
+
+// SetNillableTaxableAmount sets the "taxable_amount" field if the given value is not nil.
+func (_u *InvoiceTaxLineUpdate) SetNillableTaxableAmount(v *decimal.Decimal) *InvoiceTaxLineUpdate {
+	if v != nil {
+		_u.SetTaxableAmount(*v)
+	}
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *InvoiceTaxLineUpdate) SetTaxAmount(v decimal.Decimal) *InvoiceTaxLineUpdate {
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *InvoiceTaxLineUpdate) SetNillableTaxAmount(v *decimal.Decimal) *InvoiceTaxLineUpdate {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *InvoiceTaxLineUpdate) SetInvoiceID(id int) *InvoiceTaxLineUpdate {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *InvoiceTaxLineUpdate) SetInvoice(v *Invoice) *InvoiceTaxLineUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *InvoiceTaxLineUpdate) SetAccountID(id int) *InvoiceTaxLineUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *InvoiceTaxLineUpdate) SetAccount(v *Account) *InvoiceTaxLineUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoiceTaxLineMutation object of the builder.
+func (_u *InvoiceTaxLineUpdate) Mutation() *InvoiceTaxLineMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *InvoiceTaxLineUpdate) ClearInvoice() *InvoiceTaxLineUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *InvoiceTaxLineUpdate) ClearAccount() *InvoiceTaxLineUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoiceTaxLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceTaxLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoiceTaxLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceTaxLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceTaxLineUpdate) check() error {
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoiceTaxLine.invoice"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoiceTaxLine.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InvoiceTaxLineUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoiceTaxLineUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *InvoiceTaxLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicetaxline.Table, invoicetaxline.Columns, sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(invoicetaxline.FieldRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxableAmount(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxableAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxAmount, field.TypeOther, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoicetaxline.InvoiceTable,
+			Columns: []string{invoicetaxline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoicetaxline.InvoiceTable,
+			Columns: []string{invoicetaxline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicetaxline.AccountTable,
+			Columns: []string{invoicetaxline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicetaxline.AccountTable,
+			Columns: []string{invoicetaxline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicetaxline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoiceTaxLineUpdateOne is the builder for updating a single InvoiceTaxLine entity.
+type InvoiceTaxLineUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InvoiceTaxLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *InvoiceTaxLineUpdateOne) SetTaxCode(v string) *InvoiceTaxLineUpdateOne {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *InvoiceTaxLineUpdateOne) SetNillableTaxCode(v *string) *InvoiceTaxLineUpdateOne {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *InvoiceTaxLineUpdateOne) SetRate(v decimal.Decimal) *InvoiceTaxLineUpdateOne {
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *InvoiceTaxLineUpdateOne) SetNillableRate(v *decimal.Decimal) *InvoiceTaxLineUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// SetTaxableAmount sets the "taxable_amount" field.
+func (_u *InvoiceTaxLineUpdateOne) SetTaxableAmount(v decimal.Decimal) *InvoiceTaxLineUpdateOne {
+	_u.mutation.SetTaxableAmount(v)
+	return _u
+}
+
+// SetNillableTaxableAmount sets the "taxable_amount" field if the given value is not nil.
+func (_u *InvoiceTaxLineUpdateOne) SetNillableTaxableAmount(v *decimal.Decimal) *InvoiceTaxLineUpdateOne {
+	if v != nil {
+		_u.SetTaxableAmount(*v)
+	}
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *InvoiceTaxLineUpdateOne) SetTaxAmount(v decimal.Decimal) *InvoiceTaxLineUpdateOne {
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *InvoiceTaxLineUpdateOne) SetNillableTaxAmount(v *decimal.Decimal) *InvoiceTaxLineUpdateOne {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *InvoiceTaxLineUpdateOne) SetInvoiceID(id int) *InvoiceTaxLineUpdateOne {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *InvoiceTaxLineUpdateOne) SetInvoice(v *Invoice) *InvoiceTaxLineUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *InvoiceTaxLineUpdateOne) SetAccountID(id int) *InvoiceTaxLineUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *InvoiceTaxLineUpdateOne) SetAccount(v *Account) *InvoiceTaxLineUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoiceTaxLineMutation object of the builder.
+func (_u *InvoiceTaxLineUpdateOne) Mutation() *InvoiceTaxLineMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *InvoiceTaxLineUpdateOne) ClearInvoice() *InvoiceTaxLineUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *InvoiceTaxLineUpdateOne) ClearAccount() *InvoiceTaxLineUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the InvoiceTaxLineUpdate builder.
+func (_u *InvoiceTaxLineUpdateOne) Where(ps ...predicate.InvoiceTaxLine) *InvoiceTaxLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoiceTaxLineUpdateOne) Select(field string, fields ...string) *InvoiceTaxLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InvoiceTaxLine entity.
+func (_u *InvoiceTaxLineUpdateOne) Save(ctx context.Context) (*InvoiceTaxLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceTaxLineUpdateOne) SaveX(ctx context.Context) *InvoiceTaxLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoiceTaxLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceTaxLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceTaxLineUpdateOne) check() error {
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoiceTaxLine.invoice"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoiceTaxLine.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InvoiceTaxLineUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoiceTaxLineUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *InvoiceTaxLineUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceTaxLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicetaxline.Table, invoicetaxline.Columns, sqlgraph.NewFieldSpec(invoicetaxline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoiceTaxLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicetaxline.FieldID)
+		for _, f := range fields {
+			if !invoicetaxline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoicetaxline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(invoicetaxline.FieldRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxableAmount(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxableAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(invoicetaxline.FieldTaxAmount, field.TypeOther, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoicetaxline.InvoiceTable,
+			Columns: []string{invoicetaxline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoicetaxline.InvoiceTable,
+			Columns: []string{invoicetaxline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicetaxline.AccountTable,
+			Columns: []string{invoicetaxline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicetaxline.AccountTable,
+			Columns: []string{invoicetaxline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &InvoiceTaxLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicetaxline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -30,6 +30,10 @@ type Job struct {
 	LastRun time.Time `json:"last_run,omitempty"`
 	// Targets holds the value of the "targets" field.
 	Targets []string `json:"targets,omitempty"`
+	// Parameters holds the value of the "parameters" field.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// TimeoutSeconds holds the value of the "timeout_seconds" field.
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -91,9 +95,9 @@ func (*Job) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case job.FieldTargets:
+		case job.FieldTargets, job.FieldParameters:
 			values[i] = new([]byte)
-		case job.FieldID:
+		case job.FieldID, job.FieldTimeoutSeconds:
 			values[i] = new(sql.NullInt64)
 		case job.FieldName, job.FieldCronSchedule:
 			values[i] = new(sql.NullString)
@@ -156,6 +160,20 @@ func (_m *Job) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field targets: %w", err)
 				}
 			}
+		case job.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
+		case job.FieldTimeoutSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_seconds", values[i])
+			} else if value.Valid {
+				_m.TimeoutSeconds = int(value.Int64)
+			}
 		case job.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -248,6 +266,12 @@ func (_m *Job) String() string {
 	builder.WriteString("targets=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Targets))
 	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Parameters))
+	builder.WriteString(", ")
+	builder.WriteString("timeout_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeoutSeconds))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -24,6 +24,10 @@ const (
 	FieldLastRun = "last_run"
 	// FieldTargets holds the string denoting the targets field in the database.
 	FieldTargets = "targets"
+	// FieldParameters holds the string denoting the parameters field in the database.
+	FieldParameters = "parameters"
+	// FieldTimeoutSeconds holds the string denoting the timeout_seconds field in the database.
+	FieldTimeoutSeconds = "timeout_seconds"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -67,6 +71,8 @@ var Columns = []string{
 	FieldNextRun,
 	FieldLastRun,
 	FieldTargets,
+	FieldParameters,
+	FieldTimeoutSeconds,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -94,6 +100,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTimeoutSeconds holds the default value on creation for the "timeout_seconds" field.
+	DefaultTimeoutSeconds int
+	// TimeoutSecondsValidator is a validator for the "timeout_seconds" field. It is called by the builders before save.
+	TimeoutSecondsValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -130,6 +140,11 @@ func ByLastRun(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastRun, opts...).ToFunc()
 }
 
+// ByTimeoutSeconds orders the results by the timeout_seconds field.
+func ByTimeoutSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeoutSeconds, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -77,6 +77,26 @@ func (_c *JobCreate) SetTargets(v []string) *JobCreate {
 	return _c
 }
 
+// SetParameters sets the "parameters" field.
+func (_c *JobCreate) SetParameters(v map[string]string) *JobCreate {
+	_c.mutation.SetParameters(v)
+	return _c
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_c *JobCreate) SetTimeoutSeconds(v int) *JobCreate {
+	_c.mutation.SetTimeoutSeconds(v)
+	return _c
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_c *JobCreate) SetNillableTimeoutSeconds(v *int) *JobCreate {
+	if v != nil {
+		_c.SetTimeoutSeconds(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *JobCreate) SetCreatedAt(v time.Time) *JobCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -177,6 +197,10 @@ func (_c *JobCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *JobCreate) defaults() {
+	if _, ok := _c.mutation.TimeoutSeconds(); !ok {
+		v := job.DefaultTimeoutSeconds
+		_c.mutation.SetTimeoutSeconds(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := job.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -195,6 +219,14 @@ func (_c *JobCreate) check() error {
 	if _, ok := _c.mutation.Targets(); !ok {
 		return &ValidationError{Name: "targets", err: errors.New(`ent: missing required field "Job.targets"`)}
 	}
+	if _, ok := _c.mutation.TimeoutSeconds(); !ok {
+		return &ValidationError{Name: "timeout_seconds", err: errors.New(`ent: missing required field "Job.timeout_seconds"`)}
+	}
+	if v, ok := _c.mutation.TimeoutSeconds(); ok {
+		if err := job.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "Job.timeout_seconds": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
 	}
@@ -253,6 +285,14 @@ func (_c *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		_spec.SetField(job.FieldTargets, field.TypeJSON, value)
 		_node.Targets = value
 	}
+	if value, ok := _c.mutation.Parameters(); ok {
+		_spec.SetField(job.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
+	if value, ok := _c.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(job.FieldTimeoutSeconds, field.TypeInt, value)
+		_node.TimeoutSeconds = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -119,6 +119,39 @@ func (_u *JobUpdate) AppendTargets(v []string) *JobUpdate {
 	return _u
 }
 
+// SetParameters sets the "parameters" field.
+func (_u *JobUpdate) SetParameters(v map[string]string) *JobUpdate {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *JobUpdate) ClearParameters() *JobUpdate {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_u *JobUpdate) SetTimeoutSeconds(v int) *JobUpdate {
+	_u.mutation.ResetTimeoutSeconds()
+	_u.mutation.SetTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableTimeoutSeconds(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeoutSeconds adds value to the "timeout_seconds" field.
+func (_u *JobUpdate) AddTimeoutSeconds(v int) *JobUpdate {
+	_u.mutation.AddTimeoutSeconds(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *JobUpdate) SetCreatedAt(v time.Time) *JobUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -252,6 +285,11 @@ func (_u *JobUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *JobUpdate) check() error {
+	if v, ok := _u.mutation.TimeoutSeconds(); ok {
+		if err := job.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "Job.timeout_seconds": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Job.tenant"`)
 	}
@@ -308,6 +346,18 @@ func (_u *JobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			sqljson.Append(u, job.FieldTargets, value)
 		})
 	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(job.FieldParameters, field.TypeJSON, value)
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(job.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(job.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(job.FieldTimeoutSeconds, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -525,6 +575,39 @@ func (_u *JobUpdateOne) AppendTargets(v []string) *JobUpdateOne {
 	return _u
 }
 
+// SetParameters sets the "parameters" field.
+func (_u *JobUpdateOne) SetParameters(v map[string]string) *JobUpdateOne {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *JobUpdateOne) ClearParameters() *JobUpdateOne {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_u *JobUpdateOne) SetTimeoutSeconds(v int) *JobUpdateOne {
+	_u.mutation.ResetTimeoutSeconds()
+	_u.mutation.SetTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableTimeoutSeconds(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeoutSeconds adds value to the "timeout_seconds" field.
+func (_u *JobUpdateOne) AddTimeoutSeconds(v int) *JobUpdateOne {
+	_u.mutation.AddTimeoutSeconds(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *JobUpdateOne) SetCreatedAt(v time.Time) *JobUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -671,6 +754,11 @@ func (_u *JobUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *JobUpdateOne) check() error {
+	if v, ok := _u.mutation.TimeoutSeconds(); ok {
+		if err := job.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "Job.timeout_seconds": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Job.tenant"`)
 	}
@@ -744,6 +832,18 @@ func (_u *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
 			sqljson.Append(u, job.FieldTargets, value)
 		})
 	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(job.FieldParameters, field.TypeJSON, value)
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(job.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(job.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(job.FieldTimeoutSeconds, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
 	}
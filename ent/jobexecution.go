@@ -23,6 +23,10 @@ type JobExecution struct {
 	Status jobexecution.Status `json:"status,omitempty"`
 	// Output holds the value of the "output" field.
 	Output string `json:"output,omitempty"`
+	// ExitCode holds the value of the "exit_code" field.
+	ExitCode *int `json:"exit_code,omitempty"`
+	// OutputTruncated holds the value of the "output_truncated" field.
+	OutputTruncated bool `json:"output_truncated,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
 	StartedAt time.Time `json:"started_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
@@ -75,7 +79,9 @@ func (*JobExecution) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case jobexecution.FieldID:
+		case jobexecution.FieldOutputTruncated:
+			values[i] = new(sql.NullBool)
+		case jobexecution.FieldID, jobexecution.FieldExitCode:
 			values[i] = new(sql.NullInt64)
 		case jobexecution.FieldStatus, jobexecution.FieldOutput:
 			values[i] = new(sql.NullString)
@@ -118,6 +124,19 @@ func (_m *JobExecution) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Output = value.String
 			}
+		case jobexecution.FieldExitCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exit_code", values[i])
+			} else if value.Valid {
+				_m.ExitCode = new(int)
+				*_m.ExitCode = int(value.Int64)
+			}
+		case jobexecution.FieldOutputTruncated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field output_truncated", values[i])
+			} else if value.Valid {
+				_m.OutputTruncated = value.Bool
+			}
 		case jobexecution.FieldStartedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field started_at", values[i])
@@ -202,6 +221,14 @@ func (_m *JobExecution) String() string {
 	builder.WriteString("output=")
 	builder.WriteString(_m.Output)
 	builder.WriteString(", ")
+	if v := _m.ExitCode; v != nil {
+		builder.WriteString("exit_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("output_truncated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutputTruncated))
+	builder.WriteString(", ")
 	builder.WriteString("started_at=")
 	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -19,6 +19,10 @@ const (
 	FieldStatus = "status"
 	// FieldOutput holds the string denoting the output field in the database.
 	FieldOutput = "output"
+	// FieldExitCode holds the string denoting the exit_code field in the database.
+	FieldExitCode = "exit_code"
+	// FieldOutputTruncated holds the string denoting the output_truncated field in the database.
+	FieldOutputTruncated = "output_truncated"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
 	FieldStartedAt = "started_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
@@ -52,6 +56,8 @@ var Columns = []string{
 	FieldID,
 	FieldStatus,
 	FieldOutput,
+	FieldExitCode,
+	FieldOutputTruncated,
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldCreatedAt,
@@ -80,6 +86,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultOutputTruncated holds the default value on creation for the "output_truncated" field.
+	DefaultOutputTruncated bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -92,10 +100,12 @@ const DefaultStatus = StatusPending
 
 // Status values.
 const (
-	StatusPending Status = "pending"
-	StatusRunning Status = "running"
-	StatusSuccess Status = "success"
-	StatusFailed  Status = "failed"
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusSuccess   Status = "success"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+	StatusTimeout   Status = "timeout"
 )
 
 func (s Status) String() string {
@@ -105,7 +115,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusRunning, StatusSuccess, StatusFailed:
+	case StatusPending, StatusRunning, StatusSuccess, StatusFailed, StatusCancelled, StatusTimeout:
 		return nil
 	default:
 		return fmt.Errorf("jobexecution: invalid enum value for status field: %q", s)
@@ -130,6 +140,16 @@ func ByOutput(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOutput, opts...).ToFunc()
 }
 
+// ByExitCode orders the results by the exit_code field.
+func ByExitCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExitCode, opts...).ToFunc()
+}
+
+// ByOutputTruncated orders the results by the output_truncated field.
+func ByOutputTruncated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputTruncated, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
@@ -60,6 +60,16 @@ func Output(v string) predicate.JobExecution {
 	return predicate.JobExecution(sql.FieldEQ(FieldOutput, v))
 }
 
+// ExitCode applies equality check predicate on the "exit_code" field. It's identical to ExitCodeEQ.
+func ExitCode(v int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldEQ(FieldExitCode, v))
+}
+
+// OutputTruncated applies equality check predicate on the "output_truncated" field. It's identical to OutputTruncatedEQ.
+func OutputTruncated(v bool) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldEQ(FieldOutputTruncated, v))
+}
+
 // StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
 func StartedAt(v time.Time) predicate.JobExecution {
 	return predicate.JobExecution(sql.FieldEQ(FieldStartedAt, v))
@@ -170,6 +180,66 @@ func OutputContainsFold(v string) predicate.JobExecution {
 	return predicate.JobExecution(sql.FieldContainsFold(FieldOutput, v))
 }
 
+// ExitCodeEQ applies the EQ predicate on the "exit_code" field.
+func ExitCodeEQ(v int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldEQ(FieldExitCode, v))
+}
+
+// ExitCodeNEQ applies the NEQ predicate on the "exit_code" field.
+func ExitCodeNEQ(v int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldNEQ(FieldExitCode, v))
+}
+
+// ExitCodeIn applies the In predicate on the "exit_code" field.
+func ExitCodeIn(vs ...int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldIn(FieldExitCode, vs...))
+}
+
+// ExitCodeNotIn applies the NotIn predicate on the "exit_code" field.
+func ExitCodeNotIn(vs ...int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldNotIn(FieldExitCode, vs...))
+}
+
+// ExitCodeGT applies the GT predicate on the "exit_code" field.
+func ExitCodeGT(v int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldGT(FieldExitCode, v))
+}
+
+// ExitCodeGTE applies the GTE predicate on the "exit_code" field.
+func ExitCodeGTE(v int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldGTE(FieldExitCode, v))
+}
+
+// ExitCodeLT applies the LT predicate on the "exit_code" field.
+func ExitCodeLT(v int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldLT(FieldExitCode, v))
+}
+
+// ExitCodeLTE applies the LTE predicate on the "exit_code" field.
+func ExitCodeLTE(v int) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldLTE(FieldExitCode, v))
+}
+
+// ExitCodeIsNil applies the IsNil predicate on the "exit_code" field.
+func ExitCodeIsNil() predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldIsNull(FieldExitCode))
+}
+
+// ExitCodeNotNil applies the NotNil predicate on the "exit_code" field.
+func ExitCodeNotNil() predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldNotNull(FieldExitCode))
+}
+
+// OutputTruncatedEQ applies the EQ predicate on the "output_truncated" field.
+func OutputTruncatedEQ(v bool) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldEQ(FieldOutputTruncated, v))
+}
+
+// OutputTruncatedNEQ applies the NEQ predicate on the "output_truncated" field.
+func OutputTruncatedNEQ(v bool) predicate.JobExecution {
+	return predicate.JobExecution(sql.FieldNEQ(FieldOutputTruncated, v))
+}
+
 // StartedAtEQ applies the EQ predicate on the "started_at" field.
 func StartedAtEQ(v time.Time) predicate.JobExecution {
 	return predicate.JobExecution(sql.FieldEQ(FieldStartedAt, v))
@@ -50,6 +50,34 @@ func (_c *JobExecutionCreate) SetNillableOutput(v *string) *JobExecutionCreate {
 	return _c
 }
 
+// SetExitCode sets the "exit_code" field.
+func (_c *JobExecutionCreate) SetExitCode(v int) *JobExecutionCreate {
+	_c.mutation.SetExitCode(v)
+	return _c
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_c *JobExecutionCreate) SetNillableExitCode(v *int) *JobExecutionCreate {
+	if v != nil {
+		_c.SetExitCode(*v)
+	}
+	return _c
+}
+
+// SetOutputTruncated sets the "output_truncated" field.
+func (_c *JobExecutionCreate) SetOutputTruncated(v bool) *JobExecutionCreate {
+	_c.mutation.SetOutputTruncated(v)
+	return _c
+}
+
+// SetNillableOutputTruncated sets the "output_truncated" field if the given value is not nil.
+func (_c *JobExecutionCreate) SetNillableOutputTruncated(v *bool) *JobExecutionCreate {
+	if v != nil {
+		_c.SetOutputTruncated(*v)
+	}
+	return _c
+}
+
 // SetStartedAt sets the "started_at" field.
 func (_c *JobExecutionCreate) SetStartedAt(v time.Time) *JobExecutionCreate {
 	_c.mutation.SetStartedAt(v)
@@ -153,6 +181,10 @@ func (_c *JobExecutionCreate) defaults() {
 		v := jobexecution.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.OutputTruncated(); !ok {
+		v := jobexecution.DefaultOutputTruncated
+		_c.mutation.SetOutputTruncated(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := jobexecution.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -169,6 +201,9 @@ func (_c *JobExecutionCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobExecution.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.OutputTruncated(); !ok {
+		return &ValidationError{Name: "output_truncated", err: errors.New(`ent: missing required field "JobExecution.output_truncated"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JobExecution.created_at"`)}
 	}
@@ -212,6 +247,14 @@ func (_c *JobExecutionCreate) createSpec() (*JobExecution, *sqlgraph.CreateSpec)
 		_spec.SetField(jobexecution.FieldOutput, field.TypeString, value)
 		_node.Output = value
 	}
+	if value, ok := _c.mutation.ExitCode(); ok {
+		_spec.SetField(jobexecution.FieldExitCode, field.TypeInt, value)
+		_node.ExitCode = &value
+	}
+	if value, ok := _c.mutation.OutputTruncated(); ok {
+		_spec.SetField(jobexecution.FieldOutputTruncated, field.TypeBool, value)
+		_node.OutputTruncated = value
+	}
 	if value, ok := _c.mutation.StartedAt(); ok {
 		_spec.SetField(jobexecution.FieldStartedAt, field.TypeTime, value)
 		_node.StartedAt = value
@@ -65,6 +65,47 @@ func (_u *JobExecutionUpdate) ClearOutput() *JobExecutionUpdate {
 	return _u
 }
 
+// SetExitCode sets the "exit_code" field.
+func (_u *JobExecutionUpdate) SetExitCode(v int) *JobExecutionUpdate {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *JobExecutionUpdate) SetNillableExitCode(v *int) *JobExecutionUpdate {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *JobExecutionUpdate) AddExitCode(v int) *JobExecutionUpdate {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (_u *JobExecutionUpdate) ClearExitCode() *JobExecutionUpdate {
+	_u.mutation.ClearExitCode()
+	return _u
+}
+
+// SetOutputTruncated sets the "output_truncated" field.
+func (_u *JobExecutionUpdate) SetOutputTruncated(v bool) *JobExecutionUpdate {
+	_u.mutation.SetOutputTruncated(v)
+	return _u
+}
+
+// SetNillableOutputTruncated sets the "output_truncated" field if the given value is not nil.
+func (_u *JobExecutionUpdate) SetNillableOutputTruncated(v *bool) *JobExecutionUpdate {
+	if v != nil {
+		_u.SetOutputTruncated(*v)
+	}
+	return _u
+}
+
 // SetStartedAt sets the "started_at" field.
 func (_u *JobExecutionUpdate) SetStartedAt(v time.Time) *JobExecutionUpdate {
 	_u.mutation.SetStartedAt(v)
@@ -228,6 +269,18 @@ func (_u *JobExecutionUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.OutputCleared() {
 		_spec.ClearField(jobexecution.FieldOutput, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExitCode(); ok {
+		_spec.SetField(jobexecution.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitCode(); ok {
+		_spec.AddField(jobexecution.FieldExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.ExitCodeCleared() {
+		_spec.ClearField(jobexecution.FieldExitCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OutputTruncated(); ok {
+		_spec.SetField(jobexecution.FieldOutputTruncated, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.StartedAt(); ok {
 		_spec.SetField(jobexecution.FieldStartedAt, field.TypeTime, value)
 	}
@@ -357,6 +410,47 @@ func (_u *JobExecutionUpdateOne) ClearOutput() *JobExecutionUpdateOne {
 	return _u
 }
 
+// SetExitCode sets the "exit_code" field.
+func (_u *JobExecutionUpdateOne) SetExitCode(v int) *JobExecutionUpdateOne {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *JobExecutionUpdateOne) SetNillableExitCode(v *int) *JobExecutionUpdateOne {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *JobExecutionUpdateOne) AddExitCode(v int) *JobExecutionUpdateOne {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (_u *JobExecutionUpdateOne) ClearExitCode() *JobExecutionUpdateOne {
+	_u.mutation.ClearExitCode()
+	return _u
+}
+
+// SetOutputTruncated sets the "output_truncated" field.
+func (_u *JobExecutionUpdateOne) SetOutputTruncated(v bool) *JobExecutionUpdateOne {
+	_u.mutation.SetOutputTruncated(v)
+	return _u
+}
+
+// SetNillableOutputTruncated sets the "output_truncated" field if the given value is not nil.
+func (_u *JobExecutionUpdateOne) SetNillableOutputTruncated(v *bool) *JobExecutionUpdateOne {
+	if v != nil {
+		_u.SetOutputTruncated(*v)
+	}
+	return _u
+}
+
 // SetStartedAt sets the "started_at" field.
 func (_u *JobExecutionUpdateOne) SetStartedAt(v time.Time) *JobExecutionUpdateOne {
 	_u.mutation.SetStartedAt(v)
@@ -550,6 +644,18 @@ func (_u *JobExecutionUpdateOne) sqlSave(ctx context.Context) (_node *JobExecuti
 	if _u.mutation.OutputCleared() {
 		_spec.ClearField(jobexecution.FieldOutput, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExitCode(); ok {
+		_spec.SetField(jobexecution.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitCode(); ok {
+		_spec.AddField(jobexecution.FieldExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.ExitCodeCleared() {
+		_spec.ClearField(jobexecution.FieldExitCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OutputTruncated(); ok {
+		_spec.SetField(jobexecution.FieldOutputTruncated, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.StartedAt(); ok {
 		_spec.SetField(jobexecution.FieldStartedAt, field.TypeTime, value)
 	}
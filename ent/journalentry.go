@@ -26,6 +26,12 @@ type JournalEntry struct {
 	Amount decimal.Decimal `json:"amount,omitempty"`
 	// Direction holds the value of the "direction" field.
 	Direction journalentry.Direction `json:"direction,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// CurrencyAmount holds the value of the "currency_amount" field.
+	CurrencyAmount decimal.Decimal `json:"currency_amount,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate decimal.Decimal `json:"exchange_rate,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Description holds the value of the "description" field.
@@ -107,11 +113,11 @@ func (*JournalEntry) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case journalentry.FieldAmount:
+		case journalentry.FieldAmount, journalentry.FieldCurrencyAmount, journalentry.FieldExchangeRate:
 			values[i] = new(decimal.Decimal)
 		case journalentry.FieldID, journalentry.FieldApprovedByID:
 			values[i] = new(sql.NullInt64)
-		case journalentry.FieldDirection, journalentry.FieldDescription, journalentry.FieldApprovalStatus:
+		case journalentry.FieldDirection, journalentry.FieldCurrency, journalentry.FieldDescription, journalentry.FieldApprovalStatus:
 			values[i] = new(sql.NullString)
 		case journalentry.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -154,6 +160,24 @@ func (_m *JournalEntry) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Direction = journalentry.Direction(value.String)
 			}
+		case journalentry.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case journalentry.FieldCurrencyAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field currency_amount", values[i])
+			} else if value != nil {
+				_m.CurrencyAmount = *value
+			}
+		case journalentry.FieldExchangeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value != nil {
+				_m.ExchangeRate = *value
+			}
 		case journalentry.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -262,6 +286,15 @@ func (_m *JournalEntry) String() string {
 	builder.WriteString("direction=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Direction))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("currency_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CurrencyAmount))
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExchangeRate))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -20,6 +20,12 @@ const (
 	FieldAmount = "amount"
 	// FieldDirection holds the string denoting the direction field in the database.
 	FieldDirection = "direction"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldCurrencyAmount holds the string denoting the currency_amount field in the database.
+	FieldCurrencyAmount = "currency_amount"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -73,6 +79,9 @@ var Columns = []string{
 	FieldID,
 	FieldAmount,
 	FieldDirection,
+	FieldCurrency,
+	FieldCurrencyAmount,
+	FieldExchangeRate,
 	FieldCreatedAt,
 	FieldDescription,
 	FieldApprovalStatus,
@@ -105,6 +114,12 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultAmount holds the default value on creation for the "amount" field.
 	DefaultAmount decimal.Decimal
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultCurrencyAmount holds the default value on creation for the "currency_amount" field.
+	DefaultCurrencyAmount decimal.Decimal
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate decimal.Decimal
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -177,6 +192,21 @@ func ByDirection(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDirection, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByCurrencyAmount orders the results by the currency_amount field.
+func ByCurrencyAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrencyAmount, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
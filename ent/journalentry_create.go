@@ -45,6 +45,48 @@ func (_c *JournalEntryCreate) SetDirection(v journalentry.Direction) *JournalEnt
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *JournalEntryCreate) SetCurrency(v string) *JournalEntryCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableCurrency(v *string) *JournalEntryCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetCurrencyAmount sets the "currency_amount" field.
+func (_c *JournalEntryCreate) SetCurrencyAmount(v decimal.Decimal) *JournalEntryCreate {
+	_c.mutation.SetCurrencyAmount(v)
+	return _c
+}
+
+// SetNillableCurrencyAmount sets the "currency_amount" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableCurrencyAmount(v *decimal.Decimal) *JournalEntryCreate {
+	if v != nil {
+		_c.SetCurrencyAmount(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *JournalEntryCreate) SetExchangeRate(v decimal.Decimal) *JournalEntryCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableExchangeRate(v *decimal.Decimal) *JournalEntryCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *JournalEntryCreate) SetCreatedAt(v time.Time) *JournalEntryCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -186,6 +228,18 @@ func (_c *JournalEntryCreate) defaults() {
 		v := journalentry.DefaultAmount
 		_c.mutation.SetAmount(v)
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := journalentry.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.CurrencyAmount(); !ok {
+		v := journalentry.DefaultCurrencyAmount
+		_c.mutation.SetCurrencyAmount(v)
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		v := journalentry.DefaultExchangeRate
+		_c.mutation.SetExchangeRate(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := journalentry.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -209,6 +263,15 @@ func (_c *JournalEntryCreate) check() error {
 			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "JournalEntry.direction": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "JournalEntry.currency"`)}
+	}
+	if _, ok := _c.mutation.CurrencyAmount(); !ok {
+		return &ValidationError{Name: "currency_amount", err: errors.New(`ent: missing required field "JournalEntry.currency_amount"`)}
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "JournalEntry.exchange_rate"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JournalEntry.created_at"`)}
 	}
@@ -260,6 +323,18 @@ func (_c *JournalEntryCreate) createSpec() (*JournalEntry, *sqlgraph.CreateSpec)
 		_spec.SetField(journalentry.FieldDirection, field.TypeEnum, value)
 		_node.Direction = value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(journalentry.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.CurrencyAmount(); ok {
+		_spec.SetField(journalentry.FieldCurrencyAmount, field.TypeOther, value)
+		_node.CurrencyAmount = value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(journalentry.FieldExchangeRate, field.TypeOther, value)
+		_node.ExchangeRate = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(journalentry.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -62,6 +62,48 @@ func (_u *JournalEntryUpdate) SetNillableDirection(v *journalentry.Direction) *J
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *JournalEntryUpdate) SetCurrency(v string) *JournalEntryUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillableCurrency(v *string) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCurrencyAmount sets the "currency_amount" field.
+func (_u *JournalEntryUpdate) SetCurrencyAmount(v decimal.Decimal) *JournalEntryUpdate {
+	_u.mutation.SetCurrencyAmount(v)
+	return _u
+}
+
+// SetNillableCurrencyAmount sets the "currency_amount" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillableCurrencyAmount(v *decimal.Decimal) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetCurrencyAmount(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *JournalEntryUpdate) SetExchangeRate(v decimal.Decimal) *JournalEntryUpdate {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillableExchangeRate(v *decimal.Decimal) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *JournalEntryUpdate) SetCreatedAt(v time.Time) *JournalEntryUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -277,6 +319,15 @@ func (_u *JournalEntryUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.Direction(); ok {
 		_spec.SetField(journalentry.FieldDirection, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(journalentry.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CurrencyAmount(); ok {
+		_spec.SetField(journalentry.FieldCurrencyAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(journalentry.FieldExchangeRate, field.TypeOther, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(journalentry.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -455,6 +506,48 @@ func (_u *JournalEntryUpdateOne) SetNillableDirection(v *journalentry.Direction)
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *JournalEntryUpdateOne) SetCurrency(v string) *JournalEntryUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillableCurrency(v *string) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCurrencyAmount sets the "currency_amount" field.
+func (_u *JournalEntryUpdateOne) SetCurrencyAmount(v decimal.Decimal) *JournalEntryUpdateOne {
+	_u.mutation.SetCurrencyAmount(v)
+	return _u
+}
+
+// SetNillableCurrencyAmount sets the "currency_amount" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillableCurrencyAmount(v *decimal.Decimal) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetCurrencyAmount(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *JournalEntryUpdateOne) SetExchangeRate(v decimal.Decimal) *JournalEntryUpdateOne {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillableExchangeRate(v *decimal.Decimal) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *JournalEntryUpdateOne) SetCreatedAt(v time.Time) *JournalEntryUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -700,6 +793,15 @@ func (_u *JournalEntryUpdateOne) sqlSave(ctx context.Context) (_node *JournalEnt
 	if value, ok := _u.mutation.Direction(); ok {
 		_spec.SetField(journalentry.FieldDirection, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(journalentry.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CurrencyAmount(); ok {
+		_spec.SetField(journalentry.FieldCurrencyAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(journalentry.FieldExchangeRate, field.TypeOther, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(journalentry.FieldCreatedAt, field.TypeTime, value)
 	}
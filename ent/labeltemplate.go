@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sent/ent/labeltemplate"
+	"sent/ent/schema"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LabelTemplate is the model entity for the LabelTemplate schema.
+type LabelTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// WidthMm holds the value of the "width_mm" field.
+	WidthMm float64 `json:"width_mm,omitempty"`
+	// HeightMm holds the value of the "height_mm" field.
+	HeightMm float64 `json:"height_mm,omitempty"`
+	// Elements holds the value of the "elements" field.
+	Elements []schema.LabelElement `json:"elements,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LabelTemplateQuery when eager-loading is set.
+	Edges                  LabelTemplateEdges `json:"edges"`
+	tenant_label_templates *int
+	selectValues           sql.SelectValues
+}
+
+// LabelTemplateEdges holds the relations/edges for other nodes in the graph.
+type LabelTemplateEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LabelTemplateEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LabelTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case labeltemplate.FieldElements:
+			values[i] = new([]byte)
+		case labeltemplate.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case labeltemplate.FieldWidthMm, labeltemplate.FieldHeightMm:
+			values[i] = new(sql.NullFloat64)
+		case labeltemplate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case labeltemplate.FieldName:
+			values[i] = new(sql.NullString)
+		case labeltemplate.FieldCreatedAt, labeltemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case labeltemplate.ForeignKeys[0]: // tenant_label_templates
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LabelTemplate fields.
+func (_m *LabelTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case labeltemplate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case labeltemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case labeltemplate.FieldWidthMm:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field width_mm", values[i])
+			} else if value.Valid {
+				_m.WidthMm = value.Float64
+			}
+		case labeltemplate.FieldHeightMm:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field height_mm", values[i])
+			} else if value.Valid {
+				_m.HeightMm = value.Float64
+			}
+		case labeltemplate.FieldElements:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field elements", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Elements); err != nil {
+					return fmt.Errorf("unmarshal field elements: %w", err)
+				}
+			}
+		case labeltemplate.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case labeltemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case labeltemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case labeltemplate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_label_templates", value)
+			} else if value.Valid {
+				_m.tenant_label_templates = new(int)
+				*_m.tenant_label_templates = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LabelTemplate.
+// This includes values selected through modifiers, order, etc.
+func (_m *LabelTemplate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the LabelTemplate entity.
+func (_m *LabelTemplate) QueryTenant() *TenantQuery {
+	return NewLabelTemplateClient(_m.config).QueryTenant(_m)
+}
+
+// Update returns a builder for updating this LabelTemplate.
+// Note that you need to call LabelTemplate.Unwrap() before calling this method if this LabelTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LabelTemplate) Update() *LabelTemplateUpdateOne {
+	return NewLabelTemplateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LabelTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LabelTemplate) Unwrap() *LabelTemplate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LabelTemplate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LabelTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("LabelTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("width_mm=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WidthMm))
+	builder.WriteString(", ")
+	builder.WriteString("height_mm=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeightMm))
+	builder.WriteString(", ")
+	builder.WriteString("elements=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Elements))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LabelTemplates is a parsable slice of LabelTemplate.
+type LabelTemplates []*LabelTemplate
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package labeltemplate
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// WidthMm applies equality check predicate on the "width_mm" field. It's identical to WidthMmEQ.
+func WidthMm(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldWidthMm, v))
+}
+
+// HeightMm applies equality check predicate on the "height_mm" field. It's identical to HeightMmEQ.
+func HeightMm(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldHeightMm, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// WidthMmEQ applies the EQ predicate on the "width_mm" field.
+func WidthMmEQ(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldWidthMm, v))
+}
+
+// WidthMmNEQ applies the NEQ predicate on the "width_mm" field.
+func WidthMmNEQ(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldWidthMm, v))
+}
+
+// WidthMmIn applies the In predicate on the "width_mm" field.
+func WidthMmIn(vs ...float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldWidthMm, vs...))
+}
+
+// WidthMmNotIn applies the NotIn predicate on the "width_mm" field.
+func WidthMmNotIn(vs ...float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldWidthMm, vs...))
+}
+
+// WidthMmGT applies the GT predicate on the "width_mm" field.
+func WidthMmGT(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldWidthMm, v))
+}
+
+// WidthMmGTE applies the GTE predicate on the "width_mm" field.
+func WidthMmGTE(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldWidthMm, v))
+}
+
+// WidthMmLT applies the LT predicate on the "width_mm" field.
+func WidthMmLT(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldWidthMm, v))
+}
+
+// WidthMmLTE applies the LTE predicate on the "width_mm" field.
+func WidthMmLTE(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldWidthMm, v))
+}
+
+// HeightMmEQ applies the EQ predicate on the "height_mm" field.
+func HeightMmEQ(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldHeightMm, v))
+}
+
+// HeightMmNEQ applies the NEQ predicate on the "height_mm" field.
+func HeightMmNEQ(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldHeightMm, v))
+}
+
+// HeightMmIn applies the In predicate on the "height_mm" field.
+func HeightMmIn(vs ...float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldHeightMm, vs...))
+}
+
+// HeightMmNotIn applies the NotIn predicate on the "height_mm" field.
+func HeightMmNotIn(vs ...float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldHeightMm, vs...))
+}
+
+// HeightMmGT applies the GT predicate on the "height_mm" field.
+func HeightMmGT(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldHeightMm, v))
+}
+
+// HeightMmGTE applies the GTE predicate on the "height_mm" field.
+func HeightMmGTE(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldHeightMm, v))
+}
+
+// HeightMmLT applies the LT predicate on the "height_mm" field.
+func HeightMmLT(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldHeightMm, v))
+}
+
+// HeightMmLTE applies the LTE predicate on the "height_mm" field.
+func HeightMmLTE(v float64) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldHeightMm, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.LabelTemplate {
+	return predicate.LabelTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.LabelTemplate {
+	return predicate.LabelTemplate(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LabelTemplate) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LabelTemplate) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LabelTemplate) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.NotPredicates(p))
+}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/labeltemplate"
+	"sent/ent/schema"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LabelTemplateCreate is the builder for creating a LabelTemplate entity.
+type LabelTemplateCreate struct {
+	config
+	mutation *LabelTemplateMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *LabelTemplateCreate) SetName(v string) *LabelTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetWidthMm sets the "width_mm" field.
+func (_c *LabelTemplateCreate) SetWidthMm(v float64) *LabelTemplateCreate {
+	_c.mutation.SetWidthMm(v)
+	return _c
+}
+
+// SetHeightMm sets the "height_mm" field.
+func (_c *LabelTemplateCreate) SetHeightMm(v float64) *LabelTemplateCreate {
+	_c.mutation.SetHeightMm(v)
+	return _c
+}
+
+// SetElements sets the "elements" field.
+func (_c *LabelTemplateCreate) SetElements(v []schema.LabelElement) *LabelTemplateCreate {
+	_c.mutation.SetElements(v)
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *LabelTemplateCreate) SetIsDefault(v bool) *LabelTemplateCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *LabelTemplateCreate) SetNillableIsDefault(v *bool) *LabelTemplateCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LabelTemplateCreate) SetCreatedAt(v time.Time) *LabelTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LabelTemplateCreate) SetNillableCreatedAt(v *time.Time) *LabelTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LabelTemplateCreate) SetUpdatedAt(v time.Time) *LabelTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LabelTemplateCreate) SetNillableUpdatedAt(v *time.Time) *LabelTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *LabelTemplateCreate) SetTenantID(id int) *LabelTemplateCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *LabelTemplateCreate) SetTenant(v *Tenant) *LabelTemplateCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// Mutation returns the LabelTemplateMutation object of the builder.
+func (_c *LabelTemplateCreate) Mutation() *LabelTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the LabelTemplate in the database.
+func (_c *LabelTemplateCreate) Save(ctx context.Context) (*LabelTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LabelTemplateCreate) SaveX(ctx context.Context) *LabelTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LabelTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LabelTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LabelTemplateCreate) defaults() {
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := labeltemplate.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := labeltemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := labeltemplate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LabelTemplateCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LabelTemplate.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := labeltemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WidthMm(); !ok {
+		return &ValidationError{Name: "width_mm", err: errors.New(`ent: missing required field "LabelTemplate.width_mm"`)}
+	}
+	if v, ok := _c.mutation.WidthMm(); ok {
+		if err := labeltemplate.WidthMmValidator(v); err != nil {
+			return &ValidationError{Name: "width_mm", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.width_mm": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HeightMm(); !ok {
+		return &ValidationError{Name: "height_mm", err: errors.New(`ent: missing required field "LabelTemplate.height_mm"`)}
+	}
+	if v, ok := _c.mutation.HeightMm(); ok {
+		if err := labeltemplate.HeightMmValidator(v); err != nil {
+			return &ValidationError{Name: "height_mm", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.height_mm": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Elements(); !ok {
+		return &ValidationError{Name: "elements", err: errors.New(`ent: missing required field "LabelTemplate.elements"`)}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "LabelTemplate.is_default"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LabelTemplate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LabelTemplate.updated_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "LabelTemplate.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *LabelTemplateCreate) sqlSave(ctx context.Context) (*LabelTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LabelTemplateCreate) createSpec() (*LabelTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LabelTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(labeltemplate.Table, sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(labeltemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.WidthMm(); ok {
+		_spec.SetField(labeltemplate.FieldWidthMm, field.TypeFloat64, value)
+		_node.WidthMm = value
+	}
+	if value, ok := _c.mutation.HeightMm(); ok {
+		_spec.SetField(labeltemplate.FieldHeightMm, field.TypeFloat64, value)
+		_node.HeightMm = value
+	}
+	if value, ok := _c.mutation.Elements(); ok {
+		_spec.SetField(labeltemplate.FieldElements, field.TypeJSON, value)
+		_node.Elements = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(labeltemplate.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(labeltemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(labeltemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.TenantTable,
+			Columns: []string{labeltemplate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_label_templates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LabelTemplateCreateBulk is the builder for creating many LabelTemplate entities in bulk.
+type LabelTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*LabelTemplateCreate
+}
+
+// Save creates the LabelTemplate entities in the database.
+func (_c *LabelTemplateCreateBulk) Save(ctx context.Context) ([]*LabelTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LabelTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LabelTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LabelTemplateCreateBulk) SaveX(ctx context.Context) []*LabelTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LabelTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LabelTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/labeltemplate"
+	"sent/ent/predicate"
+	"sent/ent/schema"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// LabelTemplateUpdate is the builder for updating LabelTemplate entities.
+type LabelTemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LabelTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LabelTemplateUpdate builder.
+func (_u *LabelTemplateUpdate) Where(ps ...predicate.LabelTemplate) *LabelTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LabelTemplateUpdate) SetName(v string) *LabelTemplateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableName(v *string) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetWidthMm sets the "width_mm" field.
+func (_u *LabelTemplateUpdate) SetWidthMm(v float64) *LabelTemplateUpdate {
+	_u.mutation.ResetWidthMm()
+	_u.mutation.SetWidthMm(v)
+	return _u
+}
+
+// SetNillableWidthMm sets the "width_mm" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableWidthMm(v *float64) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetWidthMm(*v)
+	}
+	return _u
+}
+
+// AddWidthMm adds value to the "width_mm" field.
+func (_u *LabelTemplateUpdate) AddWidthMm(v float64) *LabelTemplateUpdate {
+	_u.mutation.AddWidthMm(v)
+	return _u
+}
+
+// SetHeightMm sets the "height_mm" field.
+func (_u *LabelTemplateUpdate) SetHeightMm(v float64) *LabelTemplateUpdate {
+	_u.mutation.ResetHeightMm()
+	_u.mutation.SetHeightMm(v)
+	return _u
+}
+
+// SetNillableHeightMm sets the "height_mm" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableHeightMm(v *float64) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetHeightMm(*v)
+	}
+	return _u
+}
+
+// AddHeightMm adds value to the "height_mm" field.
+func (_u *LabelTemplateUpdate) AddHeightMm(v float64) *LabelTemplateUpdate {
+	_u.mutation.AddHeightMm(v)
+	return _u
+}
+
+// SetElements sets the "elements" field.
+func (_u *LabelTemplateUpdate) SetElements(v []schema.LabelElement) *LabelTemplateUpdate {
+	_u.mutation.SetElements(v)
+	return _u
+}
+
+// AppendElements appends value to the "elements" field.
+func (_u *LabelTemplateUpdate) AppendElements(v []schema.LabelElement) *LabelTemplateUpdate {
+	_u.mutation.AppendElements(v)
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *LabelTemplateUpdate) SetIsDefault(v bool) *LabelTemplateUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableIsDefault(v *bool) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LabelTemplateUpdate) SetUpdatedAt(v time.Time) *LabelTemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *LabelTemplateUpdate) SetTenantID(id int) *LabelTemplateUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *LabelTemplateUpdate) SetTenant(v *Tenant) *LabelTemplateUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the LabelTemplateMutation object of the builder.
+func (_u *LabelTemplateUpdate) Mutation() *LabelTemplateMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *LabelTemplateUpdate) ClearTenant() *LabelTemplateUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LabelTemplateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LabelTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LabelTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LabelTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LabelTemplateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := labeltemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LabelTemplateUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := labeltemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WidthMm(); ok {
+		if err := labeltemplate.WidthMmValidator(v); err != nil {
+			return &ValidationError{Name: "width_mm", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.width_mm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HeightMm(); ok {
+		if err := labeltemplate.HeightMmValidator(v); err != nil {
+			return &ValidationError{Name: "height_mm", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.height_mm": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LabelTemplate.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LabelTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LabelTemplateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LabelTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(labeltemplate.Table, labeltemplate.Columns, sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(labeltemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WidthMm(); ok {
+		_spec.SetField(labeltemplate.FieldWidthMm, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWidthMm(); ok {
+		_spec.AddField(labeltemplate.FieldWidthMm, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.HeightMm(); ok {
+		_spec.SetField(labeltemplate.FieldHeightMm, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeightMm(); ok {
+		_spec.AddField(labeltemplate.FieldHeightMm, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Elements(); ok {
+		_spec.SetField(labeltemplate.FieldElements, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedElements(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, labeltemplate.FieldElements, value)
+		})
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(labeltemplate.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(labeltemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.TenantTable,
+			Columns: []string{labeltemplate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.TenantTable,
+			Columns: []string{labeltemplate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{labeltemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LabelTemplateUpdateOne is the builder for updating a single LabelTemplate entity.
+type LabelTemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LabelTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *LabelTemplateUpdateOne) SetName(v string) *LabelTemplateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableName(v *string) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetWidthMm sets the "width_mm" field.
+func (_u *LabelTemplateUpdateOne) SetWidthMm(v float64) *LabelTemplateUpdateOne {
+	_u.mutation.ResetWidthMm()
+	_u.mutation.SetWidthMm(v)
+	return _u
+}
+
+// SetNillableWidthMm sets the "width_mm" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableWidthMm(v *float64) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetWidthMm(*v)
+	}
+	return _u
+}
+
+// AddWidthMm adds value to the "width_mm" field.
+func (_u *LabelTemplateUpdateOne) AddWidthMm(v float64) *LabelTemplateUpdateOne {
+	_u.mutation.AddWidthMm(v)
+	return _u
+}
+
+// SetHeightMm sets the "height_mm" field.
+func (_u *LabelTemplateUpdateOne) SetHeightMm(v float64) *LabelTemplateUpdateOne {
+	_u.mutation.ResetHeightMm()
+	_u.mutation.SetHeightMm(v)
+	return _u
+}
+
+// SetNillableHeightMm sets the "height_mm" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableHeightMm(v *float64) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetHeightMm(*v)
+	}
+	return _u
+}
+
+// AddHeightMm adds value to the "height_mm" field.
+func (_u *LabelTemplateUpdateOne) AddHeightMm(v float64) *LabelTemplateUpdateOne {
+	_u.mutation.AddHeightMm(v)
+	return _u
+}
+
+// SetElements sets the "elements" field.
+func (_u *LabelTemplateUpdateOne) SetElements(v []schema.LabelElement) *LabelTemplateUpdateOne {
+	_u.mutation.SetElements(v)
+	return _u
+}
+
+// AppendElements appends value to the "elements" field.
+func (_u *LabelTemplateUpdateOne) AppendElements(v []schema.LabelElement) *LabelTemplateUpdateOne {
+	_u.mutation.AppendElements(v)
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *LabelTemplateUpdateOne) SetIsDefault(v bool) *LabelTemplateUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableIsDefault(v *bool) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LabelTemplateUpdateOne) SetUpdatedAt(v time.Time) *LabelTemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *LabelTemplateUpdateOne) SetTenantID(id int) *LabelTemplateUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *LabelTemplateUpdateOne) SetTenant(v *Tenant) *LabelTemplateUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the LabelTemplateMutation object of the builder.
+func (_u *LabelTemplateUpdateOne) Mutation() *LabelTemplateMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *LabelTemplateUpdateOne) ClearTenant() *LabelTemplateUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Where appends a list predicates to the LabelTemplateUpdate builder.
+func (_u *LabelTemplateUpdateOne) Where(ps ...predicate.LabelTemplate) *LabelTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LabelTemplateUpdateOne) Select(field string, fields ...string) *LabelTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LabelTemplate entity.
+func (_u *LabelTemplateUpdateOne) Save(ctx context.Context) (*LabelTemplate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LabelTemplateUpdateOne) SaveX(ctx context.Context) *LabelTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LabelTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LabelTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LabelTemplateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := labeltemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LabelTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := labeltemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WidthMm(); ok {
+		if err := labeltemplate.WidthMmValidator(v); err != nil {
+			return &ValidationError{Name: "width_mm", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.width_mm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HeightMm(); ok {
+		if err := labeltemplate.HeightMmValidator(v); err != nil {
+			return &ValidationError{Name: "height_mm", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.height_mm": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LabelTemplate.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LabelTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LabelTemplateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LabelTemplateUpdateOne) sqlSave(ctx context.Context) (_node *LabelTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(labeltemplate.Table, labeltemplate.Columns, sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LabelTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, labeltemplate.FieldID)
+		for _, f := range fields {
+			if !labeltemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != labeltemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(labeltemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WidthMm(); ok {
+		_spec.SetField(labeltemplate.FieldWidthMm, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWidthMm(); ok {
+		_spec.AddField(labeltemplate.FieldWidthMm, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.HeightMm(); ok {
+		_spec.SetField(labeltemplate.FieldHeightMm, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeightMm(); ok {
+		_spec.AddField(labeltemplate.FieldHeightMm, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Elements(); ok {
+		_spec.SetField(labeltemplate.FieldElements, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedElements(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, labeltemplate.FieldElements, value)
+		})
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(labeltemplate.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(labeltemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.TenantTable,
+			Columns: []string{labeltemplate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.TenantTable,
+			Columns: []string{labeltemplate.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LabelTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{labeltemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
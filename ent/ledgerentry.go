@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/bankstatementline"
 	"sent/ent/ledgerentry"
 	"sent/ent/tenant"
 	"sent/ent/transaction"
@@ -25,15 +26,22 @@ type LedgerEntry struct {
 	Amount decimal.Decimal `json:"amount,omitempty"`
 	// Direction holds the value of the "direction" field.
 	Direction ledgerentry.Direction `json:"direction,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// CurrencyAmount holds the value of the "currency_amount" field.
+	CurrencyAmount decimal.Decimal `json:"currency_amount,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate decimal.Decimal `json:"exchange_rate,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LedgerEntryQuery when eager-loading is set.
-	Edges                      LedgerEntryEdges `json:"edges"`
-	account_entries            *int
-	tenant_ledger_entries      *int
-	transaction_ledger_entries *int
-	selectValues               sql.SelectValues
+	Edges                            LedgerEntryEdges `json:"edges"`
+	account_entries                  *int
+	bank_statement_line_ledger_entry *int
+	tenant_ledger_entries            *int
+	transaction_ledger_entries       *int
+	selectValues                     sql.SelectValues
 }
 
 // LedgerEntryEdges holds the relations/edges for other nodes in the graph.
@@ -44,9 +52,11 @@ type LedgerEntryEdges struct {
 	Account *Account `json:"account,omitempty"`
 	// Tenant holds the value of the tenant edge.
 	Tenant *Tenant `json:"tenant,omitempty"`
+	// BankStatementLine holds the value of the bank_statement_line edge.
+	BankStatementLine *BankStatementLine `json:"bank_statement_line,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // TransactionOrErr returns the Transaction value or an error if the edge
@@ -82,24 +92,37 @@ func (e LedgerEntryEdges) TenantOrErr() (*Tenant, error) {
 	return nil, &NotLoadedError{edge: "tenant"}
 }
 
+// BankStatementLineOrErr returns the BankStatementLine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerEntryEdges) BankStatementLineOrErr() (*BankStatementLine, error) {
+	if e.BankStatementLine != nil {
+		return e.BankStatementLine, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: bankstatementline.Label}
+	}
+	return nil, &NotLoadedError{edge: "bank_statement_line"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*LedgerEntry) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case ledgerentry.FieldAmount:
+		case ledgerentry.FieldAmount, ledgerentry.FieldCurrencyAmount, ledgerentry.FieldExchangeRate:
 			values[i] = new(decimal.Decimal)
 		case ledgerentry.FieldID:
 			values[i] = new(sql.NullInt64)
-		case ledgerentry.FieldDirection:
+		case ledgerentry.FieldDirection, ledgerentry.FieldCurrency:
 			values[i] = new(sql.NullString)
 		case ledgerentry.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case ledgerentry.ForeignKeys[0]: // account_entries
 			values[i] = new(sql.NullInt64)
-		case ledgerentry.ForeignKeys[1]: // tenant_ledger_entries
+		case ledgerentry.ForeignKeys[1]: // bank_statement_line_ledger_entry
+			values[i] = new(sql.NullInt64)
+		case ledgerentry.ForeignKeys[2]: // tenant_ledger_entries
 			values[i] = new(sql.NullInt64)
-		case ledgerentry.ForeignKeys[2]: // transaction_ledger_entries
+		case ledgerentry.ForeignKeys[3]: // transaction_ledger_entries
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -134,6 +157,24 @@ func (_m *LedgerEntry) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Direction = ledgerentry.Direction(value.String)
 			}
+		case ledgerentry.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case ledgerentry.FieldCurrencyAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field currency_amount", values[i])
+			} else if value != nil {
+				_m.CurrencyAmount = *value
+			}
+		case ledgerentry.FieldExchangeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value != nil {
+				_m.ExchangeRate = *value
+			}
 		case ledgerentry.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -148,13 +189,20 @@ func (_m *LedgerEntry) assignValues(columns []string, values []any) error {
 				*_m.account_entries = int(value.Int64)
 			}
 		case ledgerentry.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bank_statement_line_ledger_entry", value)
+			} else if value.Valid {
+				_m.bank_statement_line_ledger_entry = new(int)
+				*_m.bank_statement_line_ledger_entry = int(value.Int64)
+			}
+		case ledgerentry.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field tenant_ledger_entries", value)
 			} else if value.Valid {
 				_m.tenant_ledger_entries = new(int)
 				*_m.tenant_ledger_entries = int(value.Int64)
 			}
-		case ledgerentry.ForeignKeys[2]:
+		case ledgerentry.ForeignKeys[3]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field transaction_ledger_entries", value)
 			} else if value.Valid {
@@ -189,6 +237,11 @@ func (_m *LedgerEntry) QueryTenant() *TenantQuery {
 	return NewLedgerEntryClient(_m.config).QueryTenant(_m)
 }
 
+// QueryBankStatementLine queries the "bank_statement_line" edge of the LedgerEntry entity.
+func (_m *LedgerEntry) QueryBankStatementLine() *BankStatementLineQuery {
+	return NewLedgerEntryClient(_m.config).QueryBankStatementLine(_m)
+}
+
 // Update returns a builder for updating this LedgerEntry.
 // Note that you need to call LedgerEntry.Unwrap() before calling this method if this LedgerEntry
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -218,6 +271,15 @@ func (_m *LedgerEntry) String() string {
 	builder.WriteString("direction=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Direction))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("currency_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CurrencyAmount))
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExchangeRate))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -20,6 +20,12 @@ const (
 	FieldAmount = "amount"
 	// FieldDirection holds the string denoting the direction field in the database.
 	FieldDirection = "direction"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldCurrencyAmount holds the string denoting the currency_amount field in the database.
+	FieldCurrencyAmount = "currency_amount"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeTransaction holds the string denoting the transaction edge name in mutations.
@@ -28,6 +34,8 @@ const (
 	EdgeAccount = "account"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
+	// EdgeBankStatementLine holds the string denoting the bank_statement_line edge name in mutations.
+	EdgeBankStatementLine = "bank_statement_line"
 	// Table holds the table name of the ledgerentry in the database.
 	Table = "ledger_entries"
 	// TransactionTable is the table that holds the transaction relation/edge.
@@ -51,6 +59,13 @@ const (
 	TenantInverseTable = "tenants"
 	// TenantColumn is the table column denoting the tenant relation/edge.
 	TenantColumn = "tenant_ledger_entries"
+	// BankStatementLineTable is the table that holds the bank_statement_line relation/edge.
+	BankStatementLineTable = "ledger_entries"
+	// BankStatementLineInverseTable is the table name for the BankStatementLine entity.
+	// It exists in this package in order to avoid circular dependency with the "bankstatementline" package.
+	BankStatementLineInverseTable = "bank_statement_lines"
+	// BankStatementLineColumn is the table column denoting the bank_statement_line relation/edge.
+	BankStatementLineColumn = "bank_statement_line_ledger_entry"
 )
 
 // Columns holds all SQL columns for ledgerentry fields.
@@ -58,6 +73,9 @@ var Columns = []string{
 	FieldID,
 	FieldAmount,
 	FieldDirection,
+	FieldCurrency,
+	FieldCurrencyAmount,
+	FieldExchangeRate,
 	FieldCreatedAt,
 }
 
@@ -65,6 +83,7 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"account_entries",
+	"bank_statement_line_ledger_entry",
 	"tenant_ledger_entries",
 	"transaction_ledger_entries",
 }
@@ -87,6 +106,12 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultAmount holds the default value on creation for the "amount" field.
 	DefaultAmount decimal.Decimal
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultCurrencyAmount holds the default value on creation for the "currency_amount" field.
+	DefaultCurrencyAmount decimal.Decimal
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate decimal.Decimal
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -132,6 +157,21 @@ func ByDirection(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDirection, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByCurrencyAmount orders the results by the currency_amount field.
+func ByCurrencyAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrencyAmount, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -157,6 +197,13 @@ func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByBankStatementLineField orders the results by bank_statement_line field.
+func ByBankStatementLineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBankStatementLineStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newTransactionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -178,3 +225,10 @@ func newTenantStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
 	)
 }
+func newBankStatementLineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BankStatementLineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, BankStatementLineTable, BankStatementLineColumn),
+	)
+}
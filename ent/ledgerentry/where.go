@@ -61,6 +61,21 @@ func Amount(v decimal.Decimal) predicate.LedgerEntry {
 	return predicate.LedgerEntry(sql.FieldEQ(FieldAmount, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyAmount applies equality check predicate on the "currency_amount" field. It's identical to CurrencyAmountEQ.
+func CurrencyAmount(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCurrencyAmount, v))
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldExchangeRate, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.LedgerEntry {
 	return predicate.LedgerEntry(sql.FieldEQ(FieldCreatedAt, v))
@@ -126,6 +141,151 @@ func DirectionNotIn(vs ...Direction) predicate.LedgerEntry {
 	return predicate.LedgerEntry(sql.FieldNotIn(FieldDirection, vs...))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// CurrencyAmountEQ applies the EQ predicate on the "currency_amount" field.
+func CurrencyAmountEQ(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCurrencyAmount, v))
+}
+
+// CurrencyAmountNEQ applies the NEQ predicate on the "currency_amount" field.
+func CurrencyAmountNEQ(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldCurrencyAmount, v))
+}
+
+// CurrencyAmountIn applies the In predicate on the "currency_amount" field.
+func CurrencyAmountIn(vs ...decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldCurrencyAmount, vs...))
+}
+
+// CurrencyAmountNotIn applies the NotIn predicate on the "currency_amount" field.
+func CurrencyAmountNotIn(vs ...decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldCurrencyAmount, vs...))
+}
+
+// CurrencyAmountGT applies the GT predicate on the "currency_amount" field.
+func CurrencyAmountGT(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldCurrencyAmount, v))
+}
+
+// CurrencyAmountGTE applies the GTE predicate on the "currency_amount" field.
+func CurrencyAmountGTE(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldCurrencyAmount, v))
+}
+
+// CurrencyAmountLT applies the LT predicate on the "currency_amount" field.
+func CurrencyAmountLT(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldCurrencyAmount, v))
+}
+
+// CurrencyAmountLTE applies the LTE predicate on the "currency_amount" field.
+func CurrencyAmountLTE(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldCurrencyAmount, v))
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldExchangeRate, v))
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldExchangeRate, v))
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v decimal.Decimal) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldExchangeRate, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LedgerEntry {
 	return predicate.LedgerEntry(sql.FieldEQ(FieldCreatedAt, v))
@@ -235,6 +395,29 @@ func HasTenantWith(preds ...predicate.Tenant) predicate.LedgerEntry {
 	})
 }
 
+// HasBankStatementLine applies the HasEdge predicate on the "bank_statement_line" edge.
+func HasBankStatementLine() predicate.LedgerEntry {
+	return predicate.LedgerEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, BankStatementLineTable, BankStatementLineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBankStatementLineWith applies the HasEdge predicate on the "bank_statement_line" edge with a given conditions (other predicates).
+func HasBankStatementLineWith(preds ...predicate.BankStatementLine) predicate.LedgerEntry {
+	return predicate.LedgerEntry(func(s *sql.Selector) {
+		step := newBankStatementLineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.LedgerEntry) predicate.LedgerEntry {
 	return predicate.LedgerEntry(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/bankstatementline"
 	"sent/ent/ledgerentry"
 	"sent/ent/tenant"
 	"sent/ent/transaction"
@@ -44,6 +45,48 @@ func (_c *LedgerEntryCreate) SetDirection(v ledgerentry.Direction) *LedgerEntryC
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *LedgerEntryCreate) SetCurrency(v string) *LedgerEntryCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableCurrency(v *string) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetCurrencyAmount sets the "currency_amount" field.
+func (_c *LedgerEntryCreate) SetCurrencyAmount(v decimal.Decimal) *LedgerEntryCreate {
+	_c.mutation.SetCurrencyAmount(v)
+	return _c
+}
+
+// SetNillableCurrencyAmount sets the "currency_amount" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableCurrencyAmount(v *decimal.Decimal) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetCurrencyAmount(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *LedgerEntryCreate) SetExchangeRate(v decimal.Decimal) *LedgerEntryCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableExchangeRate(v *decimal.Decimal) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *LedgerEntryCreate) SetCreatedAt(v time.Time) *LedgerEntryCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -91,6 +134,25 @@ func (_c *LedgerEntryCreate) SetTenant(v *Tenant) *LedgerEntryCreate {
 	return _c.SetTenantID(v.ID)
 }
 
+// SetBankStatementLineID sets the "bank_statement_line" edge to the BankStatementLine entity by ID.
+func (_c *LedgerEntryCreate) SetBankStatementLineID(id int) *LedgerEntryCreate {
+	_c.mutation.SetBankStatementLineID(id)
+	return _c
+}
+
+// SetNillableBankStatementLineID sets the "bank_statement_line" edge to the BankStatementLine entity by ID if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableBankStatementLineID(id *int) *LedgerEntryCreate {
+	if id != nil {
+		_c = _c.SetBankStatementLineID(*id)
+	}
+	return _c
+}
+
+// SetBankStatementLine sets the "bank_statement_line" edge to the BankStatementLine entity.
+func (_c *LedgerEntryCreate) SetBankStatementLine(v *BankStatementLine) *LedgerEntryCreate {
+	return _c.SetBankStatementLineID(v.ID)
+}
+
 // Mutation returns the LedgerEntryMutation object of the builder.
 func (_c *LedgerEntryCreate) Mutation() *LedgerEntryMutation {
 	return _c.mutation
@@ -130,6 +192,18 @@ func (_c *LedgerEntryCreate) defaults() {
 		v := ledgerentry.DefaultAmount
 		_c.mutation.SetAmount(v)
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := ledgerentry.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.CurrencyAmount(); !ok {
+		v := ledgerentry.DefaultCurrencyAmount
+		_c.mutation.SetCurrencyAmount(v)
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		v := ledgerentry.DefaultExchangeRate
+		_c.mutation.SetExchangeRate(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := ledgerentry.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -149,6 +223,15 @@ func (_c *LedgerEntryCreate) check() error {
 			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "LedgerEntry.direction": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "LedgerEntry.currency"`)}
+	}
+	if _, ok := _c.mutation.CurrencyAmount(); !ok {
+		return &ValidationError{Name: "currency_amount", err: errors.New(`ent: missing required field "LedgerEntry.currency_amount"`)}
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "LedgerEntry.exchange_rate"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LedgerEntry.created_at"`)}
 	}
@@ -195,6 +278,18 @@ func (_c *LedgerEntryCreate) createSpec() (*LedgerEntry, *sqlgraph.CreateSpec) {
 		_spec.SetField(ledgerentry.FieldDirection, field.TypeEnum, value)
 		_node.Direction = value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(ledgerentry.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.CurrencyAmount(); ok {
+		_spec.SetField(ledgerentry.FieldCurrencyAmount, field.TypeOther, value)
+		_node.CurrencyAmount = value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(ledgerentry.FieldExchangeRate, field.TypeOther, value)
+		_node.ExchangeRate = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(ledgerentry.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -250,6 +345,23 @@ func (_c *LedgerEntryCreate) createSpec() (*LedgerEntry, *sqlgraph.CreateSpec) {
 		_node.tenant_ledger_entries = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BankStatementLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ledgerentry.BankStatementLineTable,
+			Columns: []string{ledgerentry.BankStatementLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bank_statement_line_ledger_entry = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"sent/ent/account"
+	"sent/ent/bankstatementline"
 	"sent/ent/ledgerentry"
 	"sent/ent/predicate"
 	"sent/ent/tenant"
@@ -21,15 +22,16 @@ import (
 // LedgerEntryQuery is the builder for querying LedgerEntry entities.
 type LedgerEntryQuery struct {
 	config
-	ctx             *QueryContext
-	order           []ledgerentry.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.LedgerEntry
-	withTransaction *TransactionQuery
-	withAccount     *AccountQuery
-	withTenant      *TenantQuery
-	withFKs         bool
-	modifiers       []func(*sql.Selector)
+	ctx                   *QueryContext
+	order                 []ledgerentry.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.LedgerEntry
+	withTransaction       *TransactionQuery
+	withAccount           *AccountQuery
+	withTenant            *TenantQuery
+	withBankStatementLine *BankStatementLineQuery
+	withFKs               bool
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +134,28 @@ func (_q *LedgerEntryQuery) QueryTenant() *TenantQuery {
 	return query
 }
 
+// QueryBankStatementLine chains the current query on the "bank_statement_line" edge.
+func (_q *LedgerEntryQuery) QueryBankStatementLine() *BankStatementLineQuery {
+	query := (&BankStatementLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgerentry.Table, ledgerentry.FieldID, selector),
+			sqlgraph.To(bankstatementline.Table, bankstatementline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ledgerentry.BankStatementLineTable, ledgerentry.BankStatementLineColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first LedgerEntry entity from the query.
 // Returns a *NotFoundError when no LedgerEntry was found.
 func (_q *LedgerEntryQuery) First(ctx context.Context) (*LedgerEntry, error) {
@@ -319,14 +343,15 @@ func (_q *LedgerEntryQuery) Clone() *LedgerEntryQuery {
 		return nil
 	}
 	return &LedgerEntryQuery{
-		config:          _q.config,
-		ctx:             _q.ctx.Clone(),
-		order:           append([]ledgerentry.OrderOption{}, _q.order...),
-		inters:          append([]Interceptor{}, _q.inters...),
-		predicates:      append([]predicate.LedgerEntry{}, _q.predicates...),
-		withTransaction: _q.withTransaction.Clone(),
-		withAccount:     _q.withAccount.Clone(),
-		withTenant:      _q.withTenant.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]ledgerentry.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.LedgerEntry{}, _q.predicates...),
+		withTransaction:       _q.withTransaction.Clone(),
+		withAccount:           _q.withAccount.Clone(),
+		withTenant:            _q.withTenant.Clone(),
+		withBankStatementLine: _q.withBankStatementLine.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -367,6 +392,17 @@ func (_q *LedgerEntryQuery) WithTenant(opts ...func(*TenantQuery)) *LedgerEntryQ
 	return _q
 }
 
+// WithBankStatementLine tells the query-builder to eager-load the nodes that are connected to
+// the "bank_statement_line" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LedgerEntryQuery) WithBankStatementLine(opts ...func(*BankStatementLineQuery)) *LedgerEntryQuery {
+	query := (&BankStatementLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBankStatementLine = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -446,13 +482,14 @@ func (_q *LedgerEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*LedgerEntry{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withTransaction != nil,
 			_q.withAccount != nil,
 			_q.withTenant != nil,
+			_q.withBankStatementLine != nil,
 		}
 	)
-	if _q.withTransaction != nil || _q.withAccount != nil || _q.withTenant != nil {
+	if _q.withTransaction != nil || _q.withAccount != nil || _q.withTenant != nil || _q.withBankStatementLine != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -497,6 +534,12 @@ func (_q *LedgerEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withBankStatementLine; query != nil {
+		if err := _q.loadBankStatementLine(ctx, query, nodes, nil,
+			func(n *LedgerEntry, e *BankStatementLine) { n.Edges.BankStatementLine = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -596,6 +639,38 @@ func (_q *LedgerEntryQuery) loadTenant(ctx context.Context, query *TenantQuery,
 	}
 	return nil
 }
+func (_q *LedgerEntryQuery) loadBankStatementLine(ctx context.Context, query *BankStatementLineQuery, nodes []*LedgerEntry, init func(*LedgerEntry), assign func(*LedgerEntry, *BankStatementLine)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*LedgerEntry)
+	for i := range nodes {
+		if nodes[i].bank_statement_line_ledger_entry == nil {
+			continue
+		}
+		fk := *nodes[i].bank_statement_line_ledger_entry
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bankstatementline.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "bank_statement_line_ledger_entry" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *LedgerEntryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
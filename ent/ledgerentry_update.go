@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/bankstatementline"
 	"sent/ent/ledgerentry"
 	"sent/ent/predicate"
 	"sent/ent/tenant"
@@ -61,6 +62,48 @@ func (_u *LedgerEntryUpdate) SetNillableDirection(v *ledgerentry.Direction) *Led
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *LedgerEntryUpdate) SetCurrency(v string) *LedgerEntryUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *LedgerEntryUpdate) SetNillableCurrency(v *string) *LedgerEntryUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCurrencyAmount sets the "currency_amount" field.
+func (_u *LedgerEntryUpdate) SetCurrencyAmount(v decimal.Decimal) *LedgerEntryUpdate {
+	_u.mutation.SetCurrencyAmount(v)
+	return _u
+}
+
+// SetNillableCurrencyAmount sets the "currency_amount" field if the given value is not nil.
+func (_u *LedgerEntryUpdate) SetNillableCurrencyAmount(v *decimal.Decimal) *LedgerEntryUpdate {
+	if v != nil {
+		_u.SetCurrencyAmount(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *LedgerEntryUpdate) SetExchangeRate(v decimal.Decimal) *LedgerEntryUpdate {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *LedgerEntryUpdate) SetNillableExchangeRate(v *decimal.Decimal) *LedgerEntryUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *LedgerEntryUpdate) SetCreatedAt(v time.Time) *LedgerEntryUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -108,6 +151,25 @@ func (_u *LedgerEntryUpdate) SetTenant(v *Tenant) *LedgerEntryUpdate {
 	return _u.SetTenantID(v.ID)
 }
 
+// SetBankStatementLineID sets the "bank_statement_line" edge to the BankStatementLine entity by ID.
+func (_u *LedgerEntryUpdate) SetBankStatementLineID(id int) *LedgerEntryUpdate {
+	_u.mutation.SetBankStatementLineID(id)
+	return _u
+}
+
+// SetNillableBankStatementLineID sets the "bank_statement_line" edge to the BankStatementLine entity by ID if the given value is not nil.
+func (_u *LedgerEntryUpdate) SetNillableBankStatementLineID(id *int) *LedgerEntryUpdate {
+	if id != nil {
+		_u = _u.SetBankStatementLineID(*id)
+	}
+	return _u
+}
+
+// SetBankStatementLine sets the "bank_statement_line" edge to the BankStatementLine entity.
+func (_u *LedgerEntryUpdate) SetBankStatementLine(v *BankStatementLine) *LedgerEntryUpdate {
+	return _u.SetBankStatementLineID(v.ID)
+}
+
 // Mutation returns the LedgerEntryMutation object of the builder.
 func (_u *LedgerEntryUpdate) Mutation() *LedgerEntryMutation {
 	return _u.mutation
@@ -131,6 +193,12 @@ func (_u *LedgerEntryUpdate) ClearTenant() *LedgerEntryUpdate {
 	return _u
 }
 
+// ClearBankStatementLine clears the "bank_statement_line" edge to the BankStatementLine entity.
+func (_u *LedgerEntryUpdate) ClearBankStatementLine() *LedgerEntryUpdate {
+	_u.mutation.ClearBankStatementLine()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LedgerEntryUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -201,6 +269,15 @@ func (_u *LedgerEntryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Direction(); ok {
 		_spec.SetField(ledgerentry.FieldDirection, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(ledgerentry.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CurrencyAmount(); ok {
+		_spec.SetField(ledgerentry.FieldCurrencyAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(ledgerentry.FieldExchangeRate, field.TypeOther, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(ledgerentry.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -291,6 +368,35 @@ func (_u *LedgerEntryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BankStatementLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ledgerentry.BankStatementLineTable,
+			Columns: []string{ledgerentry.BankStatementLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BankStatementLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ledgerentry.BankStatementLineTable,
+			Columns: []string{ledgerentry.BankStatementLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -341,6 +447,48 @@ func (_u *LedgerEntryUpdateOne) SetNillableDirection(v *ledgerentry.Direction) *
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *LedgerEntryUpdateOne) SetCurrency(v string) *LedgerEntryUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *LedgerEntryUpdateOne) SetNillableCurrency(v *string) *LedgerEntryUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCurrencyAmount sets the "currency_amount" field.
+func (_u *LedgerEntryUpdateOne) SetCurrencyAmount(v decimal.Decimal) *LedgerEntryUpdateOne {
+	_u.mutation.SetCurrencyAmount(v)
+	return _u
+}
+
+// SetNillableCurrencyAmount sets the "currency_amount" field if the given value is not nil.
+func (_u *LedgerEntryUpdateOne) SetNillableCurrencyAmount(v *decimal.Decimal) *LedgerEntryUpdateOne {
+	if v != nil {
+		_u.SetCurrencyAmount(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *LedgerEntryUpdateOne) SetExchangeRate(v decimal.Decimal) *LedgerEntryUpdateOne {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *LedgerEntryUpdateOne) SetNillableExchangeRate(v *decimal.Decimal) *LedgerEntryUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *LedgerEntryUpdateOne) SetCreatedAt(v time.Time) *LedgerEntryUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -388,6 +536,25 @@ func (_u *LedgerEntryUpdateOne) SetTenant(v *Tenant) *LedgerEntryUpdateOne {
 	return _u.SetTenantID(v.ID)
 }
 
+// SetBankStatementLineID sets the "bank_statement_line" edge to the BankStatementLine entity by ID.
+func (_u *LedgerEntryUpdateOne) SetBankStatementLineID(id int) *LedgerEntryUpdateOne {
+	_u.mutation.SetBankStatementLineID(id)
+	return _u
+}
+
+// SetNillableBankStatementLineID sets the "bank_statement_line" edge to the BankStatementLine entity by ID if the given value is not nil.
+func (_u *LedgerEntryUpdateOne) SetNillableBankStatementLineID(id *int) *LedgerEntryUpdateOne {
+	if id != nil {
+		_u = _u.SetBankStatementLineID(*id)
+	}
+	return _u
+}
+
+// SetBankStatementLine sets the "bank_statement_line" edge to the BankStatementLine entity.
+func (_u *LedgerEntryUpdateOne) SetBankStatementLine(v *BankStatementLine) *LedgerEntryUpdateOne {
+	return _u.SetBankStatementLineID(v.ID)
+}
+
 // Mutation returns the LedgerEntryMutation object of the builder.
 func (_u *LedgerEntryUpdateOne) Mutation() *LedgerEntryMutation {
 	return _u.mutation
@@ -411,6 +578,12 @@ func (_u *LedgerEntryUpdateOne) ClearTenant() *LedgerEntryUpdateOne {
 	return _u
 }
 
+// ClearBankStatementLine clears the "bank_statement_line" edge to the BankStatementLine entity.
+func (_u *LedgerEntryUpdateOne) ClearBankStatementLine() *LedgerEntryUpdateOne {
+	_u.mutation.ClearBankStatementLine()
+	return _u
+}
+
 // Where appends a list predicates to the LedgerEntryUpdate builder.
 func (_u *LedgerEntryUpdateOne) Where(ps ...predicate.LedgerEntry) *LedgerEntryUpdateOne {
 	_u.mutation.Where(ps...)
@@ -511,6 +684,15 @@ func (_u *LedgerEntryUpdateOne) sqlSave(ctx context.Context) (_node *LedgerEntry
 	if value, ok := _u.mutation.Direction(); ok {
 		_spec.SetField(ledgerentry.FieldDirection, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(ledgerentry.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CurrencyAmount(); ok {
+		_spec.SetField(ledgerentry.FieldCurrencyAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(ledgerentry.FieldExchangeRate, field.TypeOther, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(ledgerentry.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -601,6 +783,35 @@ func (_u *LedgerEntryUpdateOne) sqlSave(ctx context.Context) (_node *LedgerEntry
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BankStatementLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ledgerentry.BankStatementLineTable,
+			Columns: []string{ledgerentry.BankStatementLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BankStatementLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ledgerentry.BankStatementLineTable,
+			Columns: []string{ledgerentry.BankStatementLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &LedgerEntry{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -15,8 +15,9 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "number", Type: field.TypeString},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"asset", "liability", "equity", "revenue", "expense"}},
-		{Name: "balance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "balance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "is_intercompany", Type: field.TypeBool, Default: false},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "tenant_accounts", Type: field.TypeInt},
 	}
@@ -28,7 +29,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "accounts_tenants_accounts",
-				Columns:    []*schema.Column{AccountsColumns[7]},
+				Columns:    []*schema.Column{AccountsColumns[8]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -37,7 +38,7 @@ var (
 			{
 				Name:    "account_number_tenant_accounts",
 				Unique:  true,
-				Columns: []*schema.Column{AccountsColumns[2], AccountsColumns[7]},
+				Columns: []*schema.Column{AccountsColumns[2], AccountsColumns[8]},
 			},
 			{
 				Name:    "account_type",
@@ -51,6 +52,50 @@ var (
 			},
 		},
 	}
+	// AccountBalanceSnapshotsColumns holds the columns for the "account_balance_snapshots" table.
+	AccountBalanceSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "debit_total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "credit_total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "closing_balance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "computed_at", Type: field.TypeTime},
+		{Name: "account_balance_snapshots", Type: field.TypeInt},
+		{Name: "tenant_balance_snapshots", Type: field.TypeInt},
+	}
+	// AccountBalanceSnapshotsTable holds the schema information for the "account_balance_snapshots" table.
+	AccountBalanceSnapshotsTable = &schema.Table{
+		Name:       "account_balance_snapshots",
+		Columns:    AccountBalanceSnapshotsColumns,
+		PrimaryKey: []*schema.Column{AccountBalanceSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_balance_snapshots_accounts_balance_snapshots",
+				Columns:    []*schema.Column{AccountBalanceSnapshotsColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "account_balance_snapshots_tenants_balance_snapshots",
+				Columns:    []*schema.Column{AccountBalanceSnapshotsColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountbalancesnapshot_period_end_account_balance_snapshots",
+				Unique:  true,
+				Columns: []*schema.Column{AccountBalanceSnapshotsColumns[2], AccountBalanceSnapshotsColumns[7]},
+			},
+			{
+				Name:    "accountbalancesnapshot_period_end_tenant_balance_snapshots",
+				Unique:  false,
+				Columns: []*schema.Column{AccountBalanceSnapshotsColumns[2], AccountBalanceSnapshotsColumns[8]},
+			},
+		},
+	}
 	// AgentsColumns holds the columns for the "agents" table.
 	AgentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -62,8 +107,18 @@ var (
 		{Name: "version", Type: field.TypeString},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"online", "offline", "warning"}, Default: "offline"},
 		{Name: "last_seen", Type: field.TypeTime},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "public_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "certificate", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "certificate_serial", Type: field.TypeString, Nullable: true},
+		{Name: "certificate_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "enrolled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rekey_requested", Type: field.TypeBool, Default: false},
+		{Name: "inventory_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "agent_enrollment_token_agent", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "tenant_agents", Type: field.TypeInt, Nullable: true},
 	}
 	// AgentsTable holds the schema information for the "agents" table.
@@ -72,9 +127,15 @@ var (
 		Columns:    AgentsColumns,
 		PrimaryKey: []*schema.Column{AgentsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "agents_agent_enrollment_tokens_agent",
+				Columns:    []*schema.Column{AgentsColumns[20]},
+				RefColumns: []*schema.Column{AgentEnrollmentTokensColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "agents_tenants_agents",
-				Columns:    []*schema.Column{AgentsColumns[11]},
+				Columns:    []*schema.Column{AgentsColumns[21]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -87,6 +148,199 @@ var (
 			},
 		},
 	}
+	// AgentAlertsColumns holds the columns for the "agent_alerts" table.
+	AgentAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "severity", Type: field.TypeEnum, Enums: []string{"info", "warning", "critical"}},
+		{Name: "message", Type: field.TypeString},
+		{Name: "value", Type: field.TypeFloat64},
+		{Name: "raised_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "acknowledged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "acknowledged_by", Type: field.TypeString, Nullable: true},
+		{Name: "agent_alerts", Type: field.TypeInt},
+		{Name: "alert_rule_alerts", Type: field.TypeInt},
+	}
+	// AgentAlertsTable holds the schema information for the "agent_alerts" table.
+	AgentAlertsTable = &schema.Table{
+		Name:       "agent_alerts",
+		Columns:    AgentAlertsColumns,
+		PrimaryKey: []*schema.Column{AgentAlertsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "agent_alerts_agents_alerts",
+				Columns:    []*schema.Column{AgentAlertsColumns[8]},
+				RefColumns: []*schema.Column{AgentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "agent_alerts_alert_rules_alerts",
+				Columns:    []*schema.Column{AgentAlertsColumns[9]},
+				RefColumns: []*schema.Column{AlertRulesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AgentAuthoritiesColumns holds the columns for the "agent_authorities" table.
+	AgentAuthoritiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "certificate", Type: field.TypeString, Size: 2147483647},
+		{Name: "private_key_encrypted", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_agent_authority", Type: field.TypeInt, Unique: true},
+	}
+	// AgentAuthoritiesTable holds the schema information for the "agent_authorities" table.
+	AgentAuthoritiesTable = &schema.Table{
+		Name:       "agent_authorities",
+		Columns:    AgentAuthoritiesColumns,
+		PrimaryKey: []*schema.Column{AgentAuthoritiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "agent_authorities_tenants_agent_authority",
+				Columns:    []*schema.Column{AgentAuthoritiesColumns[4]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AgentEnrollmentTokensColumns holds the columns for the "agent_enrollment_tokens" table.
+	AgentEnrollmentTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_agent_enrollment_tokens", Type: field.TypeInt},
+	}
+	// AgentEnrollmentTokensTable holds the schema information for the "agent_enrollment_tokens" table.
+	AgentEnrollmentTokensTable = &schema.Table{
+		Name:       "agent_enrollment_tokens",
+		Columns:    AgentEnrollmentTokensColumns,
+		PrimaryKey: []*schema.Column{AgentEnrollmentTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "agent_enrollment_tokens_tenants_agent_enrollment_tokens",
+				Columns:    []*schema.Column{AgentEnrollmentTokensColumns[6]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AgentInventoriesColumns holds the columns for the "agent_inventories" table.
+	AgentInventoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "confirmed_at", Type: field.TypeTime},
+		{Name: "host", Type: field.TypeJSON},
+		{Name: "hardware", Type: field.TypeJSON},
+		{Name: "software", Type: field.TypeJSON, Nullable: true},
+		{Name: "services", Type: field.TypeJSON, Nullable: true},
+		{Name: "patches", Type: field.TypeJSON, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "agent_inventory", Type: field.TypeInt},
+	}
+	// AgentInventoriesTable holds the schema information for the "agent_inventories" table.
+	AgentInventoriesTable = &schema.Table{
+		Name:       "agent_inventories",
+		Columns:    AgentInventoriesColumns,
+		PrimaryKey: []*schema.Column{AgentInventoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "agent_inventories_agents_inventory",
+				Columns:    []*schema.Column{AgentInventoriesColumns[12]},
+				RefColumns: []*schema.Column{AgentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "agentinventory_version_agent_inventory",
+				Unique:  true,
+				Columns: []*schema.Column{AgentInventoriesColumns[1], AgentInventoriesColumns[12]},
+			},
+		},
+	}
+	// AgentSoftwaresColumns holds the columns for the "agent_softwares" table.
+	AgentSoftwaresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString, Nullable: true},
+		{Name: "publisher", Type: field.TypeString, Nullable: true},
+		{Name: "install_date", Type: field.TypeString, Nullable: true},
+		{Name: "first_seen", Type: field.TypeTime},
+		{Name: "removed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "agent_software", Type: field.TypeInt},
+	}
+	// AgentSoftwaresTable holds the schema information for the "agent_softwares" table.
+	AgentSoftwaresTable = &schema.Table{
+		Name:       "agent_softwares",
+		Columns:    AgentSoftwaresColumns,
+		PrimaryKey: []*schema.Column{AgentSoftwaresColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "agent_softwares_agents_software",
+				Columns:    []*schema.Column{AgentSoftwaresColumns[7]},
+				RefColumns: []*schema.Column{AgentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "agentsoftware_name",
+				Unique:  false,
+				Columns: []*schema.Column{AgentSoftwaresColumns[1]},
+			},
+			{
+				Name:    "agentsoftware_first_seen",
+				Unique:  false,
+				Columns: []*schema.Column{AgentSoftwaresColumns[5]},
+			},
+			{
+				Name:    "agentsoftware_removed_at",
+				Unique:  false,
+				Columns: []*schema.Column{AgentSoftwaresColumns[6]},
+			},
+		},
+	}
+	// AlertRulesColumns holds the columns for the "alert_rules" table.
+	AlertRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "targets", Type: field.TypeJSON},
+		{Name: "metric", Type: field.TypeEnum, Enums: []string{"cpu", "memory", "offline"}},
+		{Name: "threshold", Type: field.TypeFloat64},
+		{Name: "severity", Type: field.TypeEnum, Enums: []string{"info", "warning", "critical"}, Default: "warning"},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_alert_rules", Type: field.TypeInt},
+	}
+	// AlertRulesTable holds the schema information for the "alert_rules" table.
+	AlertRulesTable = &schema.Table{
+		Name:       "alert_rules",
+		Columns:    AlertRulesColumns,
+		PrimaryKey: []*schema.Column{AlertRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "alert_rules_tenants_alert_rules",
+				Columns:    []*schema.Column{AlertRulesColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "alertrule_name_tenant_alert_rules",
+				Unique:  true,
+				Columns: []*schema.Column{AlertRulesColumns[1], AlertRulesColumns[10]},
+			},
+		},
+	}
 	// ApplicationsColumns holds the columns for the "applications" table.
 	ApplicationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -306,6 +560,162 @@ var (
 			},
 		},
 	}
+	// BankRulesColumns holds the columns for the "bank_rules" table.
+	BankRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "match_field", Type: field.TypeEnum, Enums: []string{"description", "reference", "counterparty"}, Default: "description"},
+		{Name: "pattern", Type: field.TypeString},
+		{Name: "direction", Type: field.TypeEnum, Enums: []string{"any", "inflow", "outflow"}, Default: "any"},
+		{Name: "priority", Type: field.TypeInt, Default: 100},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "bank_rule_account", Type: field.TypeInt},
+		{Name: "tenant_bank_rules", Type: field.TypeInt},
+	}
+	// BankRulesTable holds the schema information for the "bank_rules" table.
+	BankRulesTable = &schema.Table{
+		Name:       "bank_rules",
+		Columns:    BankRulesColumns,
+		PrimaryKey: []*schema.Column{BankRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bank_rules_accounts_account",
+				Columns:    []*schema.Column{BankRulesColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "bank_rules_tenants_bank_rules",
+				Columns:    []*schema.Column{BankRulesColumns[9]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// BankStatementsColumns holds the columns for the "bank_statements" table.
+	BankStatementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "ofx", "camt053"}},
+		{Name: "statement_ref", Type: field.TypeString, Nullable: true},
+		{Name: "file_name", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "period_start", Type: field.TypeTime, Nullable: true},
+		{Name: "period_end", Type: field.TypeTime, Nullable: true},
+		{Name: "opening_balance", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "closing_balance", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "imported_at", Type: field.TypeTime},
+		{Name: "imported_by", Type: field.TypeString, Nullable: true},
+		{Name: "account_bank_statements", Type: field.TypeInt},
+		{Name: "tenant_bank_statements", Type: field.TypeInt},
+	}
+	// BankStatementsTable holds the schema information for the "bank_statements" table.
+	BankStatementsTable = &schema.Table{
+		Name:       "bank_statements",
+		Columns:    BankStatementsColumns,
+		PrimaryKey: []*schema.Column{BankStatementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bank_statements_accounts_bank_statements",
+				Columns:    []*schema.Column{BankStatementsColumns[11]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "bank_statements_tenants_bank_statements",
+				Columns:    []*schema.Column{BankStatementsColumns[12]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bankstatement_tenant_bank_statements",
+				Unique:  false,
+				Columns: []*schema.Column{BankStatementsColumns[12]},
+			},
+			{
+				Name:    "bankstatement_account_bank_statements",
+				Unique:  false,
+				Columns: []*schema.Column{BankStatementsColumns[11]},
+			},
+		},
+	}
+	// BankStatementLinesColumns holds the columns for the "bank_statement_lines" table.
+	BankStatementLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "external_id", Type: field.TypeString},
+		{Name: "booking_date", Type: field.TypeTime},
+		{Name: "value_date", Type: field.TypeTime, Nullable: true},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "counterparty", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"unmatched", "matched", "suggested", "posted", "ignored"}, Default: "unmatched"},
+		{Name: "match_score", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_bank_statement_lines", Type: field.TypeInt},
+		{Name: "bank_statement_lines", Type: field.TypeInt},
+		{Name: "bank_statement_line_suggested_account", Type: field.TypeInt, Nullable: true},
+		{Name: "bank_statement_line_suggested_rule", Type: field.TypeInt, Nullable: true},
+		{Name: "tenant_bank_statement_lines", Type: field.TypeInt},
+	}
+	// BankStatementLinesTable holds the schema information for the "bank_statement_lines" table.
+	BankStatementLinesTable = &schema.Table{
+		Name:       "bank_statement_lines",
+		Columns:    BankStatementLinesColumns,
+		PrimaryKey: []*schema.Column{BankStatementLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bank_statement_lines_accounts_bank_statement_lines",
+				Columns:    []*schema.Column{BankStatementLinesColumns[12]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "bank_statement_lines_bank_statements_lines",
+				Columns:    []*schema.Column{BankStatementLinesColumns[13]},
+				RefColumns: []*schema.Column{BankStatementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "bank_statement_lines_accounts_suggested_account",
+				Columns:    []*schema.Column{BankStatementLinesColumns[14]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "bank_statement_lines_bank_rules_suggested_rule",
+				Columns:    []*schema.Column{BankStatementLinesColumns[15]},
+				RefColumns: []*schema.Column{BankRulesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "bank_statement_lines_tenants_bank_statement_lines",
+				Columns:    []*schema.Column{BankStatementLinesColumns[16]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bankstatementline_external_id_account_bank_statement_lines",
+				Unique:  true,
+				Columns: []*schema.Column{BankStatementLinesColumns[1], BankStatementLinesColumns[12]},
+			},
+			{
+				Name:    "bankstatementline_status",
+				Unique:  false,
+				Columns: []*schema.Column{BankStatementLinesColumns[9]},
+			},
+			{
+				Name:    "bankstatementline_booking_date",
+				Unique:  false,
+				Columns: []*schema.Column{BankStatementLinesColumns[2]},
+			},
+		},
+	}
 	// BenefitEnrollmentsColumns holds the columns for the "benefit_enrollments" table.
 	BenefitEnrollmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -408,8 +818,8 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "year", Type: field.TypeInt},
 		{Name: "month", Type: field.TypeInt},
-		{Name: "projected_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
-		{Name: "actual_spent", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "projected_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "actual_spent", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "forecast_data", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "tenant_budget_forecasts", Type: field.TypeInt},
@@ -554,7 +964,7 @@ var (
 	// CompensationAgreementsColumns holds the columns for the "compensation_agreements" table.
 	CompensationAgreementsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "base_salary", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "base_salary", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "currency", Type: field.TypeString, Default: "USD"},
 		{Name: "effective_date", Type: field.TypeTime},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"ACTIVE", "ARCHIVED"}, Default: "ACTIVE"},
@@ -591,7 +1001,7 @@ var (
 		{Name: "address", Type: field.TypeString, Nullable: true},
 		{Name: "type", Type: field.TypeString, Default: "customer"},
 		{Name: "loyalty_points", Type: field.TypeInt, Default: 0},
-		{Name: "lifetime_value", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "lifetime_value", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "tenant_contacts", Type: field.TypeInt},
@@ -681,6 +1091,98 @@ var (
 			},
 		},
 	}
+	// CustomersColumns holds the columns for the "customers" table.
+	CustomersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true},
+		{Name: "tax_id", Type: field.TypeString, Nullable: true},
+		{Name: "country_code", Type: field.TypeString, Nullable: true},
+		{Name: "tax_exempt", Type: field.TypeBool, Default: false},
+		{Name: "exemption_reference", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "payment_terms_days", Type: field.TypeInt, Default: 30},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_customers", Type: field.TypeInt},
+	}
+	// CustomersTable holds the schema information for the "customers" table.
+	CustomersTable = &schema.Table{
+		Name:       "customers",
+		Columns:    CustomersColumns,
+		PrimaryKey: []*schema.Column{CustomersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "customers_tenants_customers",
+				Columns:    []*schema.Column{CustomersColumns[14]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customer_code_tenant_customers",
+				Unique:  true,
+				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[14]},
+			},
+			{
+				Name:    "customer_name",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[2]},
+			},
+		},
+	}
+	// CustomerPaymentsColumns holds the columns for the "customer_payments" table.
+	CustomerPaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "exchange_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,8)", "sqlite3": "numeric"}},
+		{Name: "unallocated", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "method", Type: field.TypeString, Nullable: true},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "customer_payments", Type: field.TypeInt},
+		{Name: "customer_payment_deposit_account", Type: field.TypeInt},
+		{Name: "customer_payment_transaction", Type: field.TypeInt, Nullable: true},
+		{Name: "tenant_customer_payments", Type: field.TypeInt},
+	}
+	// CustomerPaymentsTable holds the schema information for the "customer_payments" table.
+	CustomerPaymentsTable = &schema.Table{
+		Name:       "customer_payments",
+		Columns:    CustomerPaymentsColumns,
+		PrimaryKey: []*schema.Column{CustomerPaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "customer_payments_customers_payments",
+				Columns:    []*schema.Column{CustomerPaymentsColumns[9]},
+				RefColumns: []*schema.Column{CustomersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "customer_payments_accounts_deposit_account",
+				Columns:    []*schema.Column{CustomerPaymentsColumns[10]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "customer_payments_transactions_transaction",
+				Columns:    []*schema.Column{CustomerPaymentsColumns[11]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "customer_payments_tenants_customer_payments",
+				Columns:    []*schema.Column{CustomerPaymentsColumns[12]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// DepartmentsColumns holds the columns for the "departments" table.
 	DepartmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -761,6 +1263,38 @@ var (
 			},
 		},
 	}
+	// DeviceGroupsColumns holds the columns for the "device_groups" table.
+	DeviceGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "filter", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_device_groups", Type: field.TypeInt},
+	}
+	// DeviceGroupsTable holds the schema information for the "device_groups" table.
+	DeviceGroupsTable = &schema.Table{
+		Name:       "device_groups",
+		Columns:    DeviceGroupsColumns,
+		PrimaryKey: []*schema.Column{DeviceGroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_groups_tenants_device_groups",
+				Columns:    []*schema.Column{DeviceGroupsColumns[7]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "devicegroup_name_tenant_device_groups",
+				Unique:  true,
+				Columns: []*schema.Column{DeviceGroupsColumns[1], DeviceGroupsColumns[7]},
+			},
+		},
+	}
 	// DiscoveryEntriesColumns holds the columns for the "discovery_entries" table.
 	DiscoveryEntriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -789,14 +1323,112 @@ var (
 			},
 		},
 	}
-	// EmployeesColumns holds the columns for the "employees" table.
-	EmployeesColumns = []*schema.Column{
+	// EinvoicesColumns holds the columns for the "einvoices" table.
+	EinvoicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "zitadel_id", Type: field.TypeString, Unique: true},
-		{Name: "employee_id", Type: field.TypeString},
-		{Name: "first_name", Type: field.TypeString},
-		{Name: "last_name", Type: field.TypeString},
-		{Name: "email", Type: field.TypeString},
+		{Name: "authority", Type: field.TypeEnum, Enums: []string{"zatca", "jofotara"}, Default: "zatca"},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"invoice", "credit_note", "debit_note"}},
+		{Name: "profile", Type: field.TypeEnum, Enums: []string{"standard", "simplified"}},
+		{Name: "number", Type: field.TypeString},
+		{Name: "uuid", Type: field.TypeString},
+		{Name: "counter", Type: field.TypeInt},
+		{Name: "invoice_hash", Type: field.TypeString},
+		{Name: "previous_hash", Type: field.TypeString},
+		{Name: "xml", Type: field.TypeString, Size: 2147483647},
+		{Name: "qr", Type: field.TypeString, Size: 2147483647},
+		{Name: "cleared_xml", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "signed_at", Type: field.TypeTime},
+		{Name: "einvoice_invoice", Type: field.TypeInt, Nullable: true},
+		{Name: "einvoice_transaction", Type: field.TypeInt, Nullable: true},
+		{Name: "einvoice_credential_einvoices", Type: field.TypeInt},
+		{Name: "tenant_einvoices", Type: field.TypeInt},
+	}
+	// EinvoicesTable holds the schema information for the "einvoices" table.
+	EinvoicesTable = &schema.Table{
+		Name:       "einvoices",
+		Columns:    EinvoicesColumns,
+		PrimaryKey: []*schema.Column{EinvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "einvoices_invoices_invoice",
+				Columns:    []*schema.Column{EinvoicesColumns[13]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "einvoices_transactions_transaction",
+				Columns:    []*schema.Column{EinvoicesColumns[14]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "einvoices_einvoice_credentials_einvoices",
+				Columns:    []*schema.Column{EinvoicesColumns[15]},
+				RefColumns: []*schema.Column{EinvoiceCredentialsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "einvoices_tenants_einvoices",
+				Columns:    []*schema.Column{EinvoicesColumns[16]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "einvoice_counter_tenant_einvoices",
+				Unique:  true,
+				Columns: []*schema.Column{EinvoicesColumns[6], EinvoicesColumns[16]},
+			},
+			{
+				Name:    "einvoice_uuid",
+				Unique:  false,
+				Columns: []*schema.Column{EinvoicesColumns[5]},
+			},
+		},
+	}
+	// EinvoiceCredentialsColumns holds the columns for the "einvoice_credentials" table.
+	EinvoiceCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "authority", Type: field.TypeEnum, Enums: []string{"zatca", "jofotara"}, Default: "zatca"},
+		{Name: "environment", Type: field.TypeEnum, Enums: []string{"local", "sandbox", "simulation", "production"}, Default: "production"},
+		{Name: "certificate", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "private_key_encrypted", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "secret_encrypted", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "client_id", Type: field.TypeString, Nullable: true},
+		{Name: "compliance_request_id", Type: field.TypeString, Nullable: true},
+		{Name: "registration_number", Type: field.TypeString, Nullable: true},
+		{Name: "street", Type: field.TypeString, Nullable: true},
+		{Name: "building_number", Type: field.TypeString, Nullable: true},
+		{Name: "district", Type: field.TypeString, Nullable: true},
+		{Name: "city", Type: field.TypeString, Nullable: true},
+		{Name: "postal_code", Type: field.TypeString, Nullable: true},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_einvoice_credentials", Type: field.TypeInt},
+	}
+	// EinvoiceCredentialsTable holds the schema information for the "einvoice_credentials" table.
+	EinvoiceCredentialsTable = &schema.Table{
+		Name:       "einvoice_credentials",
+		Columns:    EinvoiceCredentialsColumns,
+		PrimaryKey: []*schema.Column{EinvoiceCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "einvoice_credentials_tenants_einvoice_credentials",
+				Columns:    []*schema.Column{EinvoiceCredentialsColumns[16]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// EmployeesColumns holds the columns for the "employees" table.
+	EmployeesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "zitadel_id", Type: field.TypeString, Unique: true},
+		{Name: "employee_id", Type: field.TypeString},
+		{Name: "first_name", Type: field.TypeString},
+		{Name: "last_name", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString},
 		{Name: "phone", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"STAGED", "ACTIVE", "TERMINATED"}, Default: "STAGED"},
 		{Name: "salary_encrypted", Type: field.TypeString},
@@ -865,6 +1497,83 @@ var (
 			},
 		},
 	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "base_currency", Type: field.TypeString},
+		{Name: "quote_currency", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,8)", "sqlite3": "numeric"}},
+		{Name: "effective_date", Type: field.TypeTime},
+		{Name: "source", Type: field.TypeString, Default: "manual"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_exchange_rates", Type: field.TypeInt},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "exchange_rates_tenants_exchange_rates",
+				Columns:    []*schema.Column{ExchangeRatesColumns[7]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exchangerate_base_currency_quote_currency_effective_date_tenant_exchange_rates",
+				Unique:  true,
+				Columns: []*schema.Column{ExchangeRatesColumns[1], ExchangeRatesColumns[2], ExchangeRatesColumns[4], ExchangeRatesColumns[7]},
+			},
+			{
+				Name:    "exchangerate_effective_date",
+				Unique:  false,
+				Columns: []*schema.Column{ExchangeRatesColumns[4]},
+			},
+		},
+	}
+	// FiscalPeriodsColumns holds the columns for the "fiscal_periods" table.
+	FiscalPeriodsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "fiscal_year", Type: field.TypeInt},
+		{Name: "period_number", Type: field.TypeInt},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "soft_closed", "hard_closed"}, Default: "open"},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_fiscal_periods", Type: field.TypeInt},
+	}
+	// FiscalPeriodsTable holds the schema information for the "fiscal_periods" table.
+	FiscalPeriodsTable = &schema.Table{
+		Name:       "fiscal_periods",
+		Columns:    FiscalPeriodsColumns,
+		PrimaryKey: []*schema.Column{FiscalPeriodsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "fiscal_periods_tenants_fiscal_periods",
+				Columns:    []*schema.Column{FiscalPeriodsColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fiscalperiod_fiscal_year_period_number_tenant_fiscal_periods",
+				Unique:  true,
+				Columns: []*schema.Column{FiscalPeriodsColumns[2], FiscalPeriodsColumns[3], FiscalPeriodsColumns[10]},
+			},
+			{
+				Name:    "fiscalperiod_start_date_end_date",
+				Unique:  false,
+				Columns: []*schema.Column{FiscalPeriodsColumns[4], FiscalPeriodsColumns[5]},
+			},
+		},
+	}
 	// GoalsColumns holds the columns for the "goals" table.
 	GoalsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -919,6 +1628,101 @@ var (
 			},
 		},
 	}
+	// GoodsReceiptsColumns holds the columns for the "goods_receipts" table.
+	GoodsReceiptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "received_by", Type: field.TypeString, Nullable: true},
+		{Name: "freight", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "duty", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "insurance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "allocation_method", Type: field.TypeEnum, Enums: []string{"value", "weight"}, Default: "value"},
+		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "goods_receipt_transaction", Type: field.TypeInt, Nullable: true},
+		{Name: "purchase_order_receipts", Type: field.TypeInt},
+		{Name: "tenant_goods_receipts", Type: field.TypeInt},
+		{Name: "warehouse_goods_receipts", Type: field.TypeInt},
+	}
+	// GoodsReceiptsTable holds the schema information for the "goods_receipts" table.
+	GoodsReceiptsTable = &schema.Table{
+		Name:       "goods_receipts",
+		Columns:    GoodsReceiptsColumns,
+		PrimaryKey: []*schema.Column{GoodsReceiptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goods_receipts_transactions_transaction",
+				Columns:    []*schema.Column{GoodsReceiptsColumns[11]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "goods_receipts_purchase_orders_receipts",
+				Columns:    []*schema.Column{GoodsReceiptsColumns[12]},
+				RefColumns: []*schema.Column{PurchaseOrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "goods_receipts_tenants_goods_receipts",
+				Columns:    []*schema.Column{GoodsReceiptsColumns[13]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "goods_receipts_warehouses_goods_receipts",
+				Columns:    []*schema.Column{GoodsReceiptsColumns[14]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goodsreceipt_number_tenant_goods_receipts",
+				Unique:  true,
+				Columns: []*schema.Column{GoodsReceiptsColumns[1], GoodsReceiptsColumns[13]},
+			},
+		},
+	}
+	// GoodsReceiptLinesColumns holds the columns for the "goods_receipt_lines" table.
+	GoodsReceiptLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "unit_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "weight", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "landed_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "landed_unit_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "goods_receipt_lines", Type: field.TypeInt},
+		{Name: "goods_receipt_line_stock_movement", Type: field.TypeInt, Nullable: true},
+		{Name: "purchase_order_line_receipt_lines", Type: field.TypeInt},
+	}
+	// GoodsReceiptLinesTable holds the schema information for the "goods_receipt_lines" table.
+	GoodsReceiptLinesTable = &schema.Table{
+		Name:       "goods_receipt_lines",
+		Columns:    GoodsReceiptLinesColumns,
+		PrimaryKey: []*schema.Column{GoodsReceiptLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goods_receipt_lines_goods_receipts_lines",
+				Columns:    []*schema.Column{GoodsReceiptLinesColumns[6]},
+				RefColumns: []*schema.Column{GoodsReceiptsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "goods_receipt_lines_stock_movements_stock_movement",
+				Columns:    []*schema.Column{GoodsReceiptLinesColumns[7]},
+				RefColumns: []*schema.Column{StockMovementsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "goods_receipt_lines_purchase_order_lines_receipt_lines",
+				Columns:    []*schema.Column{GoodsReceiptLinesColumns[8]},
+				RefColumns: []*schema.Column{PurchaseOrderLinesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// HealthScoreSnapshotsColumns holds the columns for the "health_score_snapshots" table.
 	HealthScoreSnapshotsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1017,9 +1821,9 @@ var (
 	// InventoryCountsColumns holds the columns for the "inventory_counts" table.
 	InventoryCountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "counted_qty", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
-		{Name: "system_qty", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
-		{Name: "variance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "counted_qty", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "system_qty", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "variance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "counted_at", Type: field.TypeTime},
 		{Name: "counted_by", Type: field.TypeString, Nullable: true},
 		{Name: "notes", Type: field.TypeString, Nullable: true},
@@ -1049,12 +1853,14 @@ var (
 	// InventoryReservationsColumns holds the columns for the "inventory_reservations" table.
 	InventoryReservationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)", "sqlite3": "numeric"}},
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "released", "completed"}, Default: "active"},
+		{Name: "takes", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "product_reservations", Type: field.TypeInt},
 		{Name: "tenant_inventory_reservations", Type: field.TypeInt},
+		{Name: "warehouse_reservations", Type: field.TypeInt, Nullable: true},
 	}
 	// InventoryReservationsTable holds the schema information for the "inventory_reservations" table.
 	InventoryReservationsTable = &schema.Table{
@@ -1064,17 +1870,152 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "inventory_reservations_products_reservations",
-				Columns:    []*schema.Column{InventoryReservationsColumns[5]},
+				Columns:    []*schema.Column{InventoryReservationsColumns[6]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "inventory_reservations_tenants_inventory_reservations",
-				Columns:    []*schema.Column{InventoryReservationsColumns[6]},
+				Columns:    []*schema.Column{InventoryReservationsColumns[7]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "inventory_reservations_warehouses_reservations",
+				Columns:    []*schema.Column{InventoryReservationsColumns[8]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"invoice", "credit_note"}, Default: "invoice"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "issued", "partially_paid", "paid", "void"}, Default: "draft"},
+		{Name: "issue_date", Type: field.TypeTime},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "exchange_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,8)", "sqlite3": "numeric"}},
+		{Name: "subtotal", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "tax_total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "amount_settled", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "pdf_path", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "customer_invoices", Type: field.TypeInt},
+		{Name: "invoice_transaction", Type: field.TypeInt, Nullable: true},
+		{Name: "invoice_credit_notes", Type: field.TypeInt, Nullable: true},
+		{Name: "tenant_invoices", Type: field.TypeInt},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_customers_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[15]},
+				RefColumns: []*schema.Column{CustomersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "invoices_transactions_transaction",
+				Columns:    []*schema.Column{InvoicesColumns[16]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "invoices_invoices_credit_notes",
+				Columns:    []*schema.Column{InvoicesColumns[17]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "invoices_tenants_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[18]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_number_tenant_invoices",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[1], InvoicesColumns[18]},
+			},
+			{
+				Name:    "invoice_status_due_date",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[3], InvoicesColumns[5]},
+			},
+		},
+	}
+	// InvoiceLinesColumns holds the columns for the "invoice_lines" table.
+	InvoiceLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "description", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "unit_price", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "tax_code", Type: field.TypeString, Nullable: true},
+		{Name: "tax_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(9,6)", "sqlite3": "numeric"}},
+		{Name: "net_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "invoice_lines", Type: field.TypeInt},
+		{Name: "invoice_line_account", Type: field.TypeInt},
+	}
+	// InvoiceLinesTable holds the schema information for the "invoice_lines" table.
+	InvoiceLinesTable = &schema.Table{
+		Name:       "invoice_lines",
+		Columns:    InvoiceLinesColumns,
+		PrimaryKey: []*schema.Column{InvoiceLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoice_lines_invoices_lines",
+				Columns:    []*schema.Column{InvoiceLinesColumns[8]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "invoice_lines_accounts_account",
+				Columns:    []*schema.Column{InvoiceLinesColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// InvoiceTaxLinesColumns holds the columns for the "invoice_tax_lines" table.
+	InvoiceTaxLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tax_code", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(9,6)", "sqlite3": "numeric"}},
+		{Name: "taxable_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "tax_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "invoice_tax_lines", Type: field.TypeInt},
+		{Name: "invoice_tax_line_account", Type: field.TypeInt},
+	}
+	// InvoiceTaxLinesTable holds the schema information for the "invoice_tax_lines" table.
+	InvoiceTaxLinesTable = &schema.Table{
+		Name:       "invoice_tax_lines",
+		Columns:    InvoiceTaxLinesColumns,
+		PrimaryKey: []*schema.Column{InvoiceTaxLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoice_tax_lines_invoices_tax_lines",
+				Columns:    []*schema.Column{InvoiceTaxLinesColumns[5]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "invoice_tax_lines_accounts_account",
+				Columns:    []*schema.Column{InvoiceTaxLinesColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// JobsColumns holds the columns for the "jobs" table.
 	JobsColumns = []*schema.Column{
@@ -1084,6 +2025,8 @@ var (
 		{Name: "next_run", Type: field.TypeTime, Nullable: true},
 		{Name: "last_run", Type: field.TypeTime, Nullable: true},
 		{Name: "targets", Type: field.TypeJSON},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
+		{Name: "timeout_seconds", Type: field.TypeInt, Default: 600},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "script_jobs", Type: field.TypeInt},
@@ -1097,13 +2040,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "jobs_scripts_jobs",
-				Columns:    []*schema.Column{JobsColumns[8]},
+				Columns:    []*schema.Column{JobsColumns[10]},
 				RefColumns: []*schema.Column{ScriptsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "jobs_tenants_jobs",
-				Columns:    []*schema.Column{JobsColumns[9]},
+				Columns:    []*schema.Column{JobsColumns[11]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1112,8 +2055,10 @@ var (
 	// JobExecutionsColumns holds the columns for the "job_executions" table.
 	JobExecutionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "success", "failed"}, Default: "pending"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "success", "failed", "cancelled", "timeout"}, Default: "pending"},
 		{Name: "output", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "exit_code", Type: field.TypeInt, Nullable: true},
+		{Name: "output_truncated", Type: field.TypeBool, Default: false},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -1128,13 +2073,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "job_executions_agents_job_executions",
-				Columns:    []*schema.Column{JobExecutionsColumns[6]},
+				Columns:    []*schema.Column{JobExecutionsColumns[8]},
 				RefColumns: []*schema.Column{AgentsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "job_executions_jobs_executions",
-				Columns:    []*schema.Column{JobExecutionsColumns[7]},
+				Columns:    []*schema.Column{JobExecutionsColumns[9]},
 				RefColumns: []*schema.Column{JobsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1182,8 +2127,11 @@ var (
 	// JournalEntriesColumns holds the columns for the "journal_entries" table.
 	JournalEntriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "direction", Type: field.TypeEnum, Enums: []string{"debit", "credit"}},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "currency_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "exchange_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,8)", "sqlite3": "numeric"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "approval_status", Type: field.TypeEnum, Enums: []string{"STAGED", "APPROVED", "REJECTED"}, Default: "APPROVED"},
@@ -1200,37 +2148,74 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "journal_entries_accounts_journal_entries",
-				Columns:    []*schema.Column{JournalEntriesColumns[6]},
+				Columns:    []*schema.Column{JournalEntriesColumns[9]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "journal_entries_users_approved_by",
-				Columns:    []*schema.Column{JournalEntriesColumns[7]},
+				Columns:    []*schema.Column{JournalEntriesColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "journal_entries_tenants_journal_entries",
-				Columns:    []*schema.Column{JournalEntriesColumns[8]},
+				Columns:    []*schema.Column{JournalEntriesColumns[11]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "journal_entries_transactions_journal_entries",
-				Columns:    []*schema.Column{JournalEntriesColumns[9]},
+				Columns:    []*schema.Column{JournalEntriesColumns[12]},
 				RefColumns: []*schema.Column{TransactionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// LabelTemplatesColumns holds the columns for the "label_templates" table.
+	LabelTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "width_mm", Type: field.TypeFloat64},
+		{Name: "height_mm", Type: field.TypeFloat64},
+		{Name: "elements", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_label_templates", Type: field.TypeInt},
+	}
+	// LabelTemplatesTable holds the schema information for the "label_templates" table.
+	LabelTemplatesTable = &schema.Table{
+		Name:       "label_templates",
+		Columns:    LabelTemplatesColumns,
+		PrimaryKey: []*schema.Column{LabelTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "label_templates_tenants_label_templates",
+				Columns:    []*schema.Column{LabelTemplatesColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "labeltemplate_name_tenant_label_templates",
+				Unique:  true,
+				Columns: []*schema.Column{LabelTemplatesColumns[1], LabelTemplatesColumns[8]},
+			},
+		},
+	}
 	// LedgerEntriesColumns holds the columns for the "ledger_entries" table.
 	LedgerEntriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "direction", Type: field.TypeEnum, Enums: []string{"debit", "credit"}},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "currency_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "exchange_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,8)", "sqlite3": "numeric"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "account_entries", Type: field.TypeInt},
+		{Name: "bank_statement_line_ledger_entry", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "tenant_ledger_entries", Type: field.TypeInt},
 		{Name: "transaction_ledger_entries", Type: field.TypeInt},
 	}
@@ -1242,19 +2227,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ledger_entries_accounts_entries",
-				Columns:    []*schema.Column{LedgerEntriesColumns[4]},
+				Columns:    []*schema.Column{LedgerEntriesColumns[7]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "ledger_entries_bank_statement_lines_ledger_entry",
+				Columns:    []*schema.Column{LedgerEntriesColumns[8]},
+				RefColumns: []*schema.Column{BankStatementLinesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "ledger_entries_tenants_ledger_entries",
-				Columns:    []*schema.Column{LedgerEntriesColumns[5]},
+				Columns:    []*schema.Column{LedgerEntriesColumns[9]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "ledger_entries_transactions_ledger_entries",
-				Columns:    []*schema.Column{LedgerEntriesColumns[6]},
+				Columns:    []*schema.Column{LedgerEntriesColumns[10]},
 				RefColumns: []*schema.Column{TransactionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1263,22 +2254,22 @@ var (
 			{
 				Name:    "ledgerentry_transaction_ledger_entries",
 				Unique:  false,
-				Columns: []*schema.Column{LedgerEntriesColumns[6]},
+				Columns: []*schema.Column{LedgerEntriesColumns[10]},
 			},
 			{
 				Name:    "ledgerentry_account_entries",
 				Unique:  false,
-				Columns: []*schema.Column{LedgerEntriesColumns[4]},
+				Columns: []*schema.Column{LedgerEntriesColumns[7]},
 			},
 			{
 				Name:    "ledgerentry_tenant_ledger_entries",
 				Unique:  false,
-				Columns: []*schema.Column{LedgerEntriesColumns[5]},
+				Columns: []*schema.Column{LedgerEntriesColumns[9]},
 			},
 			{
 				Name:    "ledgerentry_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LedgerEntriesColumns[3]},
+				Columns: []*schema.Column{LedgerEntriesColumns[6]},
 			},
 		},
 	}
@@ -1534,11 +2525,155 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "one_time_links_tenants_one_time_links",
-				Columns:    []*schema.Column{OneTimeLinksColumns[8]},
-				RefColumns: []*schema.Column{TenantsColumns[0]},
+				Symbol:     "one_time_links_tenants_one_time_links",
+				Columns:    []*schema.Column{OneTimeLinksColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PatchPoliciesColumns holds the columns for the "patch_policies" table.
+	PatchPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "targets", Type: field.TypeJSON},
+		{Name: "cron_schedule", Type: field.TypeString, Nullable: true},
+		{Name: "severities", Type: field.TypeJSON, Nullable: true},
+		{Name: "reboot", Type: field.TypeBool, Default: false},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_patch_policies", Type: field.TypeInt},
+	}
+	// PatchPoliciesTable holds the schema information for the "patch_policies" table.
+	PatchPoliciesTable = &schema.Table{
+		Name:       "patch_policies",
+		Columns:    PatchPoliciesColumns,
+		PrimaryKey: []*schema.Column{PatchPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "patch_policies_tenants_patch_policies",
+				Columns:    []*schema.Column{PatchPoliciesColumns[13]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "patchpolicy_name_tenant_patch_policies",
+				Unique:  true,
+				Columns: []*schema.Column{PatchPoliciesColumns[1], PatchPoliciesColumns[13]},
+			},
+		},
+	}
+	// PaymentAllocationsColumns holds the columns for the "payment_allocations" table.
+	PaymentAllocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "fx_difference", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "customer_payment_allocations", Type: field.TypeInt, Nullable: true},
+		{Name: "invoice_allocations", Type: field.TypeInt},
+		{Name: "invoice_credit_applications", Type: field.TypeInt, Nullable: true},
+	}
+	// PaymentAllocationsTable holds the schema information for the "payment_allocations" table.
+	PaymentAllocationsTable = &schema.Table{
+		Name:       "payment_allocations",
+		Columns:    PaymentAllocationsColumns,
+		PrimaryKey: []*schema.Column{PaymentAllocationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_allocations_customer_payments_allocations",
+				Columns:    []*schema.Column{PaymentAllocationsColumns[4]},
+				RefColumns: []*schema.Column{CustomerPaymentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "payment_allocations_invoices_allocations",
+				Columns:    []*schema.Column{PaymentAllocationsColumns[5]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "payment_allocations_invoices_credit_applications",
+				Columns:    []*schema.Column{PaymentAllocationsColumns[6]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PaymentRunsColumns holds the columns for the "payment_runs" table.
+	PaymentRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"proposed", "completed", "cancelled"}, Default: "proposed"},
+		{Name: "payment_date", Type: field.TypeTime},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "file_path", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "payment_run_bank_account", Type: field.TypeInt},
+		{Name: "tenant_payment_runs", Type: field.TypeInt},
+	}
+	// PaymentRunsTable holds the schema information for the "payment_runs" table.
+	PaymentRunsTable = &schema.Table{
+		Name:       "payment_runs",
+		Columns:    PaymentRunsColumns,
+		PrimaryKey: []*schema.Column{PaymentRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_runs_accounts_bank_account",
+				Columns:    []*schema.Column{PaymentRunsColumns[10]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "payment_runs_tenants_payment_runs",
+				Columns:    []*schema.Column{PaymentRunsColumns[11]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PaymentRunLinesColumns holds the columns for the "payment_run_lines" table.
+	PaymentRunLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "fx_difference", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "payment_run_lines", Type: field.TypeInt},
+		{Name: "payment_run_line_transaction", Type: field.TypeInt, Nullable: true},
+		{Name: "supplier_bill_payment_lines", Type: field.TypeInt},
+	}
+	// PaymentRunLinesTable holds the schema information for the "payment_run_lines" table.
+	PaymentRunLinesTable = &schema.Table{
+		Name:       "payment_run_lines",
+		Columns:    PaymentRunLinesColumns,
+		PrimaryKey: []*schema.Column{PaymentRunLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_run_lines_payment_runs_lines",
+				Columns:    []*schema.Column{PaymentRunLinesColumns[3]},
+				RefColumns: []*schema.Column{PaymentRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "payment_run_lines_transactions_transaction",
+				Columns:    []*schema.Column{PaymentRunLinesColumns[4]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "payment_run_lines_supplier_bills_payment_lines",
+				Columns:    []*schema.Column{PaymentRunLinesColumns[5]},
+				RefColumns: []*schema.Column{SupplierBillsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 		},
 	}
 	// PerformanceReviewsColumns holds the columns for the "performance_reviews" table.
@@ -1638,25 +2773,152 @@ var (
 			},
 		},
 	}
+	// PosSaleLinesColumns holds the columns for the "pos_sale_lines" table.
+	PosSaleLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "unit_price", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "discount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "net_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "tax_code", Type: field.TypeString, Nullable: true},
+		{Name: "tax_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(9,6)", "sqlite3": "numeric"}},
+		{Name: "tax_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "returned_quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "pos_sale_line_returns", Type: field.TypeInt, Nullable: true},
+		{Name: "product_pos_sale_lines", Type: field.TypeInt},
+		{Name: "transaction_pos_lines", Type: field.TypeInt},
+	}
+	// PosSaleLinesTable holds the schema information for the "pos_sale_lines" table.
+	PosSaleLinesTable = &schema.Table{
+		Name:       "pos_sale_lines",
+		Columns:    PosSaleLinesColumns,
+		PrimaryKey: []*schema.Column{PosSaleLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pos_sale_lines_pos_sale_lines_returns",
+				Columns:    []*schema.Column{PosSaleLinesColumns[10]},
+				RefColumns: []*schema.Column{PosSaleLinesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "pos_sale_lines_products_pos_sale_lines",
+				Columns:    []*schema.Column{PosSaleLinesColumns[11]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pos_sale_lines_transactions_pos_lines",
+				Columns:    []*schema.Column{PosSaleLinesColumns[12]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PosShiftsColumns holds the columns for the "pos_shifts" table.
+	PosShiftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "cashier", Type: field.TypeString},
+		{Name: "cashier_email", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "closed"}, Default: "open"},
+		{Name: "opening_float", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "expected_cash", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "counted_cash", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "over_short", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "opened_at", Type: field.TypeTime},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_pos_shifts", Type: field.TypeInt},
+		{Name: "warehouse_pos_shifts", Type: field.TypeInt, Nullable: true},
+	}
+	// PosShiftsTable holds the schema information for the "pos_shifts" table.
+	PosShiftsTable = &schema.Table{
+		Name:       "pos_shifts",
+		Columns:    PosShiftsColumns,
+		PrimaryKey: []*schema.Column{PosShiftsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pos_shifts_tenants_pos_shifts",
+				Columns:    []*schema.Column{PosShiftsColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pos_shifts_warehouses_pos_shifts",
+				Columns:    []*schema.Column{PosShiftsColumns[11]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "posshift_opened_at",
+				Unique:  false,
+				Columns: []*schema.Column{PosShiftsColumns[8]},
+			},
+			{
+				Name:    "posshift_status",
+				Unique:  false,
+				Columns: []*schema.Column{PosShiftsColumns[3]},
+			},
+		},
+	}
+	// PosTendersColumns holds the columns for the "pos_tenders" table.
+	PosTendersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "method", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "pos_tender_account", Type: field.TypeInt},
+		{Name: "transaction_tenders", Type: field.TypeInt},
+	}
+	// PosTendersTable holds the schema information for the "pos_tenders" table.
+	PosTendersTable = &schema.Table{
+		Name:       "pos_tenders",
+		Columns:    PosTendersColumns,
+		PrimaryKey: []*schema.Column{PosTendersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pos_tenders_accounts_account",
+				Columns:    []*schema.Column{PosTendersColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pos_tenders_transactions_tenders",
+				Columns:    []*schema.Column{PosTendersColumns[5]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// ProductsColumns holds the columns for the "products" table.
 	ProductsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "sku", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "unit_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
-		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "unit_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "attributes", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "min_stock_level", Type: field.TypeInt, Default: 0},
 		{Name: "max_stock_level", Type: field.TypeInt, Default: 0},
+		{Name: "reorder_point", Type: field.TypeInt, Default: 0},
 		{Name: "barcode", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "location", Type: field.TypeString, Nullable: true},
 		{Name: "is_variant_parent", Type: field.TypeBool, Default: false},
+		{Name: "weight", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "sold_by_weight", Type: field.TypeBool, Default: false},
+		{Name: "price_per_kg", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "tare_weight", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "plu", Type: field.TypeString, Nullable: true},
+		{Name: "tax_category", Type: field.TypeEnum, Enums: []string{"standard", "zero_rated", "exempt"}, Default: "standard"},
 		{Name: "serial_number", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "track_lots", Type: field.TypeBool, Default: false},
+		{Name: "expiry_alert_days", Type: field.TypeInt, Default: 30},
 		{Name: "purchase_date", Type: field.TypeTime, Nullable: true},
-		{Name: "purchase_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "purchase_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "useful_life_months", Type: field.TypeInt, Nullable: true},
 		{Name: "warranty_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "disposal_date", Type: field.TypeTime, Nullable: true},
@@ -1676,31 +2938,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "products_categories_products",
-				Columns:    []*schema.Column{ProductsColumns[22]},
+				Columns:    []*schema.Column{ProductsColumns[31]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "products_accounts_vendor",
-				Columns:    []*schema.Column{ProductsColumns[23]},
+				Columns:    []*schema.Column{ProductsColumns[32]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "products_suppliers_products",
-				Columns:    []*schema.Column{ProductsColumns[24]},
+				Columns:    []*schema.Column{ProductsColumns[33]},
 				RefColumns: []*schema.Column{SuppliersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "products_tenants_products",
-				Columns:    []*schema.Column{ProductsColumns[25]},
+				Columns:    []*schema.Column{ProductsColumns[34]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "products_warehouses_products",
-				Columns:    []*schema.Column{ProductsColumns[26]},
+				Columns:    []*schema.Column{ProductsColumns[35]},
 				RefColumns: []*schema.Column{WarehousesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1709,7 +2971,12 @@ var (
 			{
 				Name:    "product_sku_tenant_products",
 				Unique:  true,
-				Columns: []*schema.Column{ProductsColumns[1], ProductsColumns[25]},
+				Columns: []*schema.Column{ProductsColumns[1], ProductsColumns[34]},
+			},
+			{
+				Name:    "product_plu_tenant_products",
+				Unique:  true,
+				Columns: []*schema.Column{ProductsColumns[19], ProductsColumns[34]},
 			},
 			{
 				Name:    "product_name",
@@ -1753,11 +3020,12 @@ var (
 	PurchaseOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "po_number", Type: field.TypeString, Unique: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "submitted", "received", "cancelled"}, Default: "draft"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "submitted", "partially_received", "received", "cancelled"}, Default: "draft"},
 		{Name: "order_date", Type: field.TypeTime},
 		{Name: "expected_date", Type: field.TypeTime, Nullable: true},
-		{Name: "total_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "total_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "auto_generated", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "supplier_purchase_orders", Type: field.TypeInt},
@@ -1771,13 +3039,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "purchase_orders_suppliers_purchase_orders",
-				Columns:    []*schema.Column{PurchaseOrdersColumns[9]},
+				Columns:    []*schema.Column{PurchaseOrdersColumns[10]},
 				RefColumns: []*schema.Column{SuppliersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "purchase_orders_tenants_purchase_orders",
-				Columns:    []*schema.Column{PurchaseOrdersColumns[10]},
+				Columns:    []*schema.Column{PurchaseOrdersColumns[11]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1787,8 +3055,9 @@ var (
 	PurchaseOrderLinesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "quantity", Type: field.TypeInt},
-		{Name: "unit_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "unit_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "received_qty", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "partially_received", "closed"}, Default: "open"},
 		{Name: "product_purchase_order_lines", Type: field.TypeInt},
 		{Name: "purchase_order_lines", Type: field.TypeInt},
 	}
@@ -1800,13 +3069,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "purchase_order_lines_products_purchase_order_lines",
-				Columns:    []*schema.Column{PurchaseOrderLinesColumns[4]},
+				Columns:    []*schema.Column{PurchaseOrderLinesColumns[5]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "purchase_order_lines_purchase_orders_lines",
-				Columns:    []*schema.Column{PurchaseOrderLinesColumns[5]},
+				Columns:    []*schema.Column{PurchaseOrderLinesColumns[6]},
 				RefColumns: []*schema.Column{PurchaseOrdersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1847,7 +3116,7 @@ var (
 	RecurringInvoicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "description", Type: field.TypeString},
-		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "currency", Type: field.TypeString, Default: "USD"},
 		{Name: "frequency", Type: field.TypeString, Default: "monthly"},
 		{Name: "next_run_date", Type: field.TypeTime},
@@ -1855,6 +3124,7 @@ var (
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "account_recurring_invoices", Type: field.TypeInt},
+		{Name: "recurring_invoice_customer", Type: field.TypeInt, Nullable: true},
 		{Name: "tenant_recurring_invoices", Type: field.TypeInt},
 	}
 	// RecurringInvoicesTable holds the schema information for the "recurring_invoices" table.
@@ -1870,8 +3140,14 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "recurring_invoices_tenants_recurring_invoices",
+				Symbol:     "recurring_invoices_customers_customer",
 				Columns:    []*schema.Column{RecurringInvoicesColumns[10]},
+				RefColumns: []*schema.Column{CustomersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "recurring_invoices_tenants_recurring_invoices",
+				Columns:    []*schema.Column{RecurringInvoicesColumns[11]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -2021,7 +3297,7 @@ var (
 		{Name: "url", Type: field.TypeString, Nullable: true},
 		{Name: "is_managed", Type: field.TypeBool, Default: false},
 		{Name: "config", Type: field.TypeJSON},
-		{Name: "monthly_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "monthly_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "tenant_saas_apps", Type: field.TypeInt},
@@ -2187,7 +3463,7 @@ var (
 	ServiceRatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "work_type", Type: field.TypeString, Unique: true},
-		{Name: "rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "tenant_service_rates", Type: field.TypeInt},
 	}
@@ -2208,8 +3484,9 @@ var (
 	// StockAlertsColumns holds the columns for the "stock_alerts" table.
 	StockAlertsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "alert_type", Type: field.TypeEnum, Enums: []string{"low_stock", "warranty_expiring", "maintenance_due"}},
+		{Name: "alert_type", Type: field.TypeEnum, Enums: []string{"low_stock", "warranty_expiring", "maintenance_due", "lot_expiring", "lot_expired"}},
 		{Name: "message", Type: field.TypeString},
+		{Name: "lot_number", Type: field.TypeString, Nullable: true},
 		{Name: "is_read", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "product_alerts", Type: field.TypeInt, Nullable: true},
@@ -2223,13 +3500,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "stock_alerts_products_alerts",
-				Columns:    []*schema.Column{StockAlertsColumns[5]},
+				Columns:    []*schema.Column{StockAlertsColumns[6]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "stock_alerts_tenants_stock_alerts",
-				Columns:    []*schema.Column{StockAlertsColumns[6]},
+				Columns:    []*schema.Column{StockAlertsColumns[7]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -2261,19 +3538,75 @@ var (
 			},
 		},
 	}
+	// StockLevelsColumns holds the columns for the "stock_levels" table.
+	StockLevelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "bin", Type: field.TypeString, Default: ""},
+		{Name: "lot_number", Type: field.TypeString, Default: ""},
+		{Name: "expiry_date", Type: field.TypeTime, Nullable: true},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_stock_levels", Type: field.TypeInt},
+		{Name: "tenant_stock_levels", Type: field.TypeInt},
+		{Name: "warehouse_stock_levels", Type: field.TypeInt},
+	}
+	// StockLevelsTable holds the schema information for the "stock_levels" table.
+	StockLevelsTable = &schema.Table{
+		Name:       "stock_levels",
+		Columns:    StockLevelsColumns,
+		PrimaryKey: []*schema.Column{StockLevelsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stock_levels_products_stock_levels",
+				Columns:    []*schema.Column{StockLevelsColumns[6]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "stock_levels_tenants_stock_levels",
+				Columns:    []*schema.Column{StockLevelsColumns[7]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "stock_levels_warehouses_stock_levels",
+				Columns:    []*schema.Column{StockLevelsColumns[8]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stocklevel_bin_lot_number_product_stock_levels_warehouse_stock_levels",
+				Unique:  true,
+				Columns: []*schema.Column{StockLevelsColumns[1], StockLevelsColumns[2], StockLevelsColumns[6], StockLevelsColumns[8]},
+			},
+			{
+				Name:    "stocklevel_expiry_date",
+				Unique:  false,
+				Columns: []*schema.Column{StockLevelsColumns[3]},
+			},
+		},
+	}
 	// StockMovementsColumns holds the columns for the "stock_movements" table.
 	StockMovementsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "movement_type", Type: field.TypeEnum, Enums: []string{"incoming", "outgoing", "manual"}},
 		{Name: "reason", Type: field.TypeString, Nullable: true},
-		{Name: "unit_cost", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
-		{Name: "remaining_quantity", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
-		{Name: "calculated_cogs", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "bin", Type: field.TypeString, Nullable: true},
+		{Name: "lot_number", Type: field.TypeString, Nullable: true},
+		{Name: "expiry_date", Type: field.TypeTime, Nullable: true},
+		{Name: "unit_cost", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "remaining_quantity", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "calculated_cogs", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "product_movements", Type: field.TypeInt},
 		{Name: "tenant_stock_movements", Type: field.TypeInt},
+		{Name: "transaction_stock_movements", Type: field.TypeInt, Nullable: true},
+		{Name: "transfer_order_movements", Type: field.TypeInt, Nullable: true},
+		{Name: "warehouse_movements", Type: field.TypeInt, Nullable: true},
 	}
 	// StockMovementsTable holds the schema information for the "stock_movements" table.
 	StockMovementsTable = &schema.Table{
@@ -2283,38 +3616,61 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "stock_movements_products_movements",
-				Columns:    []*schema.Column{StockMovementsColumns[9]},
+				Columns:    []*schema.Column{StockMovementsColumns[12]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "stock_movements_tenants_stock_movements",
-				Columns:    []*schema.Column{StockMovementsColumns[10]},
+				Columns:    []*schema.Column{StockMovementsColumns[13]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "stock_movements_transactions_stock_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[14]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "stock_movements_transfer_orders_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[15]},
+				RefColumns: []*schema.Column{TransferOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "stock_movements_warehouses_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[16]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "stockmovement_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{StockMovementsColumns[8]},
+				Columns: []*schema.Column{StockMovementsColumns[11]},
 			},
 			{
 				Name:    "stockmovement_product_movements",
 				Unique:  false,
-				Columns: []*schema.Column{StockMovementsColumns[9]},
+				Columns: []*schema.Column{StockMovementsColumns[12]},
 			},
 			{
 				Name:    "stockmovement_tenant_stock_movements",
 				Unique:  false,
-				Columns: []*schema.Column{StockMovementsColumns[10]},
+				Columns: []*schema.Column{StockMovementsColumns[13]},
 			},
 			{
 				Name:    "stockmovement_movement_type",
 				Unique:  false,
 				Columns: []*schema.Column{StockMovementsColumns[2]},
 			},
+			{
+				Name:    "stockmovement_lot_number",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementsColumns[5]},
+			},
 		},
 	}
 	// StrategicRoadmapsColumns holds the columns for the "strategic_roadmaps" table.
@@ -2324,7 +3680,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "priority", Type: field.TypeEnum, Enums: []string{"LOW", "MEDIUM", "HIGH", "CRITICAL"}, Default: "MEDIUM"},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"PLANNED", "APPROVED", "IN_PROGRESS", "COMPLETED"}, Default: "PLANNED"},
-		{Name: "estimated_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "estimated_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
 		{Name: "target_date", Type: field.TypeTime},
 		{Name: "strategic_commentary", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -2390,6 +3746,15 @@ var (
 		{Name: "phone", Type: field.TypeString, Nullable: true},
 		{Name: "address", Type: field.TypeString, Nullable: true},
 		{Name: "website", Type: field.TypeString, Nullable: true},
+		{Name: "tax_id", Type: field.TypeString, Nullable: true},
+		{Name: "country_code", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "payment_terms_days", Type: field.TypeInt, Default: 30},
+		{Name: "lead_time_days", Type: field.TypeInt, Default: 7},
+		{Name: "bank_name", Type: field.TypeString, Nullable: true},
+		{Name: "bank_account", Type: field.TypeString, Nullable: true},
+		{Name: "price_tolerance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(9,4)", "sqlite3": "numeric"}},
+		{Name: "quantity_tolerance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(9,4)", "sqlite3": "numeric"}},
 		{Name: "tenant_suppliers", Type: field.TypeInt},
 	}
 	// SuppliersTable holds the schema information for the "suppliers" table.
@@ -2400,11 +3765,183 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "suppliers_tenants_suppliers",
-				Columns:    []*schema.Column{SuppliersColumns[7]},
+				Columns:    []*schema.Column{SuppliersColumns[16]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SupplierBillsColumns holds the columns for the "supplier_bills" table.
+	SupplierBillsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString},
+		{Name: "supplier_reference", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"exception", "matched", "approved", "paid", "void"}, Default: "exception"},
+		{Name: "bill_date", Type: field.TypeTime},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "exchange_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,8)", "sqlite3": "numeric"}},
+		{Name: "subtotal", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "tax_total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "amount_paid", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "approved_by", Type: field.TypeString, Nullable: true},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "purchase_order_bills", Type: field.TypeInt, Nullable: true},
+		{Name: "supplier_bills", Type: field.TypeInt},
+		{Name: "supplier_bill_transaction", Type: field.TypeInt, Nullable: true},
+		{Name: "tenant_supplier_bills", Type: field.TypeInt},
+	}
+	// SupplierBillsTable holds the schema information for the "supplier_bills" table.
+	SupplierBillsTable = &schema.Table{
+		Name:       "supplier_bills",
+		Columns:    SupplierBillsColumns,
+		PrimaryKey: []*schema.Column{SupplierBillsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "supplier_bills_purchase_orders_bills",
+				Columns:    []*schema.Column{SupplierBillsColumns[16]},
+				RefColumns: []*schema.Column{PurchaseOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "supplier_bills_suppliers_bills",
+				Columns:    []*schema.Column{SupplierBillsColumns[17]},
+				RefColumns: []*schema.Column{SuppliersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "supplier_bills_transactions_transaction",
+				Columns:    []*schema.Column{SupplierBillsColumns[18]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "supplier_bills_tenants_supplier_bills",
+				Columns:    []*schema.Column{SupplierBillsColumns[19]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "supplierbill_number_tenant_supplier_bills",
+				Unique:  true,
+				Columns: []*schema.Column{SupplierBillsColumns[1], SupplierBillsColumns[19]},
+			},
+			{
+				Name:    "supplierbill_status",
+				Unique:  false,
+				Columns: []*schema.Column{SupplierBillsColumns[3]},
+			},
+		},
+	}
+	// SupplierBillLinesColumns holds the columns for the "supplier_bill_lines" table.
+	SupplierBillLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "description", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "unit_price", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "tax_code", Type: field.TypeString, Nullable: true},
+		{Name: "tax_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(9,6)", "sqlite3": "numeric"}},
+		{Name: "net_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "match_status", Type: field.TypeEnum, Enums: []string{"matched", "variance", "no_order"}, Default: "no_order"},
+		{Name: "quantity_variance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "price_variance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "match_note", Type: field.TypeString, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "purchase_order_line_bill_lines", Type: field.TypeInt, Nullable: true},
+		{Name: "supplier_bill_lines", Type: field.TypeInt},
+		{Name: "supplier_bill_line_account", Type: field.TypeInt, Nullable: true},
+	}
+	// SupplierBillLinesTable holds the schema information for the "supplier_bill_lines" table.
+	SupplierBillLinesTable = &schema.Table{
+		Name:       "supplier_bill_lines",
+		Columns:    SupplierBillLinesColumns,
+		PrimaryKey: []*schema.Column{SupplierBillLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "supplier_bill_lines_purchase_order_lines_bill_lines",
+				Columns:    []*schema.Column{SupplierBillLinesColumns[12]},
+				RefColumns: []*schema.Column{PurchaseOrderLinesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "supplier_bill_lines_supplier_bills_lines",
+				Columns:    []*schema.Column{SupplierBillLinesColumns[13]},
+				RefColumns: []*schema.Column{SupplierBillsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "supplier_bill_lines_accounts_account",
+				Columns:    []*schema.Column{SupplierBillLinesColumns[14]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TaxJurisdictionsColumns holds the columns for the "tax_jurisdictions" table.
+	TaxJurisdictionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "rounding", Type: field.TypeEnum, Enums: []string{"half_up", "half_even"}, Default: "half_up"},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_tax_jurisdictions", Type: field.TypeInt},
+	}
+	// TaxJurisdictionsTable holds the schema information for the "tax_jurisdictions" table.
+	TaxJurisdictionsTable = &schema.Table{
+		Name:       "tax_jurisdictions",
+		Columns:    TaxJurisdictionsColumns,
+		PrimaryKey: []*schema.Column{TaxJurisdictionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tax_jurisdictions_tenants_tax_jurisdictions",
+				Columns:    []*schema.Column{TaxJurisdictionsColumns[6]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taxjurisdiction_code_tenant_tax_jurisdictions",
+				Unique:  true,
+				Columns: []*schema.Column{TaxJurisdictionsColumns[1], TaxJurisdictionsColumns[6]},
+			},
+		},
+	}
+	// TaxRatesColumns holds the columns for the "tax_rates" table.
+	TaxRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(9,6)", "sqlite3": "numeric"}},
+		{Name: "effective_from", Type: field.TypeTime},
+		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tax_jurisdiction_rates", Type: field.TypeInt},
+	}
+	// TaxRatesTable holds the schema information for the "tax_rates" table.
+	TaxRatesTable = &schema.Table{
+		Name:       "tax_rates",
+		Columns:    TaxRatesColumns,
+		PrimaryKey: []*schema.Column{TaxRatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tax_rates_tax_jurisdictions_rates",
+				Columns:    []*schema.Column{TaxRatesColumns[5]},
+				RefColumns: []*schema.Column{TaxJurisdictionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taxrate_effective_from_tax_jurisdiction_rates",
+				Unique:  true,
+				Columns: []*schema.Column{TaxRatesColumns[2], TaxRatesColumns[5]},
+			},
+		},
 	}
 	// TenantsColumns holds the columns for the "tenants" table.
 	TenantsColumns = []*schema.Column{
@@ -2413,7 +3950,11 @@ var (
 		{Name: "domain", Type: field.TypeString, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "active", Type: field.TypeBool, Default: true},
-		{Name: "transaction_limit", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "transaction_limit", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "functional_currency", Type: field.TypeString, Default: "USD"},
+		{Name: "fiscal_year_start_month", Type: field.TypeInt, Default: 1},
+		{Name: "tax_number", Type: field.TypeString, Nullable: true},
+		{Name: "logo", Type: field.TypeBytes, Nullable: true},
 		{Name: "tenant_children", Type: field.TypeInt, Nullable: true},
 		{Name: "tenant_customer_account", Type: field.TypeInt, Nullable: true},
 	}
@@ -2425,13 +3966,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tenants_tenants_children",
-				Columns:    []*schema.Column{TenantsColumns[6]},
+				Columns:    []*schema.Column{TenantsColumns[10]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tenants_accounts_customer_account",
-				Columns:    []*schema.Column{TenantsColumns[7]},
+				Columns:    []*schema.Column{TenantsColumns[11]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -2690,16 +4231,24 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "description", Type: field.TypeString},
 		{Name: "date", Type: field.TypeTime},
-		{Name: "total_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
-		{Name: "tax_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "total_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "tax_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "exchange_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,8)", "sqlite3": "numeric"}},
 		{Name: "type", Type: field.TypeString, Nullable: true},
 		{Name: "reference", Type: field.TypeString, Nullable: true},
 		{Name: "uuid", Type: field.TypeString, Unique: true},
 		{Name: "approval_status", Type: field.TypeEnum, Enums: []string{"PENDING", "STAGED", "APPROVED", "REJECTED"}, Default: "APPROVED"},
 		{Name: "is_intercompany", Type: field.TypeBool, Default: false},
+		{Name: "clearance_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "cleared", "reported", "rejected", "failed"}},
+		{Name: "clearance_messages", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "cleared_at", Type: field.TypeTime, Nullable: true},
+		{Name: "customer_sales", Type: field.TypeInt, Nullable: true},
+		{Name: "pos_shift_transactions", Type: field.TypeInt, Nullable: true},
 		{Name: "tenant_transactions", Type: field.TypeInt},
 		{Name: "recording_id", Type: field.TypeInt, Nullable: true},
 		{Name: "transaction_approved_by", Type: field.TypeInt, Nullable: true},
+		{Name: "transaction_refunds", Type: field.TypeInt, Nullable: true},
 	}
 	// TransactionsTable holds the schema information for the "transactions" table.
 	TransactionsTable = &schema.Table{
@@ -2707,30 +4256,48 @@ var (
 		Columns:    TransactionsColumns,
 		PrimaryKey: []*schema.Column{TransactionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transactions_customers_sales",
+				Columns:    []*schema.Column{TransactionsColumns[15]},
+				RefColumns: []*schema.Column{CustomersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_pos_shifts_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[16]},
+				RefColumns: []*schema.Column{PosShiftsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "transactions_tenants_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[10]},
+				Columns:    []*schema.Column{TransactionsColumns[17]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "transactions_recordings_recording",
-				Columns:    []*schema.Column{TransactionsColumns[11]},
+				Columns:    []*schema.Column{TransactionsColumns[18]},
 				RefColumns: []*schema.Column{RecordingsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "transactions_users_approved_by",
-				Columns:    []*schema.Column{TransactionsColumns[12]},
+				Columns:    []*schema.Column{TransactionsColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "transactions_transactions_refunds",
+				Columns:    []*schema.Column{TransactionsColumns[20]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "transaction_reference_tenant_transactions",
 				Unique:  true,
-				Columns: []*schema.Column{TransactionsColumns[6], TransactionsColumns[10]},
+				Columns: []*schema.Column{TransactionsColumns[8], TransactionsColumns[17]},
 			},
 			{
 				Name:    "transaction_date",
@@ -2740,12 +4307,94 @@ var (
 			{
 				Name:    "transaction_type",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[5]},
+				Columns: []*schema.Column{TransactionsColumns[7]},
 			},
 			{
 				Name:    "transaction_approval_status",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[8]},
+				Columns: []*schema.Column{TransactionsColumns[10]},
+			},
+			{
+				Name:    "transaction_clearance_status",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[12]},
+			},
+		},
+	}
+	// TransferOrdersColumns holds the columns for the "transfer_orders" table.
+	TransferOrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "in_transit", "received", "cancelled"}, Default: "draft"},
+		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "shipped_at", Type: field.TypeTime, Nullable: true},
+		{Name: "received_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_transfer_orders", Type: field.TypeInt},
+		{Name: "warehouse_transfers_out", Type: field.TypeInt},
+		{Name: "warehouse_transfers_in", Type: field.TypeInt},
+	}
+	// TransferOrdersTable holds the schema information for the "transfer_orders" table.
+	TransferOrdersTable = &schema.Table{
+		Name:       "transfer_orders",
+		Columns:    TransferOrdersColumns,
+		PrimaryKey: []*schema.Column{TransferOrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transfer_orders_tenants_transfer_orders",
+				Columns:    []*schema.Column{TransferOrdersColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "transfer_orders_warehouses_transfers_out",
+				Columns:    []*schema.Column{TransferOrdersColumns[9]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "transfer_orders_warehouses_transfers_in",
+				Columns:    []*schema.Column{TransferOrdersColumns[10]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transferorder_number_tenant_transfer_orders",
+				Unique:  true,
+				Columns: []*schema.Column{TransferOrdersColumns[1], TransferOrdersColumns[8]},
+			},
+		},
+	}
+	// TransferOrderLinesColumns holds the columns for the "transfer_order_lines" table.
+	TransferOrderLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(19,4)", "sqlite3": "numeric"}},
+		{Name: "from_bin", Type: field.TypeString, Nullable: true},
+		{Name: "to_bin", Type: field.TypeString, Nullable: true},
+		{Name: "lot_number", Type: field.TypeString, Nullable: true},
+		{Name: "product_transfer_lines", Type: field.TypeInt},
+		{Name: "transfer_order_lines", Type: field.TypeInt},
+	}
+	// TransferOrderLinesTable holds the schema information for the "transfer_order_lines" table.
+	TransferOrderLinesTable = &schema.Table{
+		Name:       "transfer_order_lines",
+		Columns:    TransferOrderLinesColumns,
+		PrimaryKey: []*schema.Column{TransferOrderLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transfer_order_lines_products_transfer_lines",
+				Columns:    []*schema.Column{TransferOrderLinesColumns[5]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "transfer_order_lines_transfer_orders_lines",
+				Columns:    []*schema.Column{TransferOrderLinesColumns[6]},
+				RefColumns: []*schema.Column{TransferOrdersColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
 		},
 	}
@@ -3036,7 +4685,7 @@ var (
 	WarehousesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
-		{Name: "location_code", Type: field.TypeString, Unique: true},
+		{Name: "location_code", Type: field.TypeString},
 		{Name: "address", Type: field.TypeString, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "tenant_warehouses", Type: field.TypeInt},
@@ -3054,6 +4703,13 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warehouse_location_code_tenant_warehouses",
+				Unique:  true,
+				Columns: []*schema.Column{WarehousesColumns[2], WarehousesColumns[5]},
+			},
+		},
 	}
 	// WorkLogsColumns holds the columns for the "work_logs" table.
 	WorkLogsColumns = []*schema.Column{
@@ -3113,6 +4769,31 @@ var (
 			},
 		},
 	}
+	// DeviceGroupAgentsColumns holds the columns for the "device_group_agents" table.
+	DeviceGroupAgentsColumns = []*schema.Column{
+		{Name: "device_group_id", Type: field.TypeInt},
+		{Name: "agent_id", Type: field.TypeInt},
+	}
+	// DeviceGroupAgentsTable holds the schema information for the "device_group_agents" table.
+	DeviceGroupAgentsTable = &schema.Table{
+		Name:       "device_group_agents",
+		Columns:    DeviceGroupAgentsColumns,
+		PrimaryKey: []*schema.Column{DeviceGroupAgentsColumns[0], DeviceGroupAgentsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_group_agents_device_group_id",
+				Columns:    []*schema.Column{DeviceGroupAgentsColumns[0]},
+				RefColumns: []*schema.Column{DeviceGroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "device_group_agents_agent_id",
+				Columns:    []*schema.Column{DeviceGroupAgentsColumns[1]},
+				RefColumns: []*schema.Column{AgentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// EmployeeConductedInterviewsColumns holds the columns for the "employee_conducted_interviews" table.
 	EmployeeConductedInterviewsColumns = []*schema.Column{
 		{Name: "employee_id", Type: field.TypeInt},
@@ -3191,12 +4872,22 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
+		AccountBalanceSnapshotsTable,
 		AgentsTable,
+		AgentAlertsTable,
+		AgentAuthoritiesTable,
+		AgentEnrollmentTokensTable,
+		AgentInventoriesTable,
+		AgentSoftwaresTable,
+		AlertRulesTable,
 		ApplicationsTable,
 		AssetsTable,
 		AssetAssignmentsTable,
 		AssetTypesTable,
 		AuditLogsTable,
+		BankRulesTable,
+		BankStatementsTable,
+		BankStatementLinesTable,
 		BenefitEnrollmentsTable,
 		BenefitPlansTable,
 		BudgetForecastsTable,
@@ -3208,20 +4899,33 @@ var (
 		ContactsTable,
 		ContractsTable,
 		CredentialsTable,
+		CustomersTable,
+		CustomerPaymentsTable,
 		DepartmentsTable,
 		DetectionEventsTable,
+		DeviceGroupsTable,
 		DiscoveryEntriesTable,
+		EinvoicesTable,
+		EinvoiceCredentialsTable,
 		EmployeesTable,
+		ExchangeRatesTable,
+		FiscalPeriodsTable,
 		GoalsTable,
+		GoodsReceiptsTable,
+		GoodsReceiptLinesTable,
 		HealthScoreSnapshotsTable,
 		IvrFlowsTable,
 		InterviewsTable,
 		InventoryCountsTable,
 		InventoryReservationsTable,
+		InvoicesTable,
+		InvoiceLinesTable,
+		InvoiceTaxLinesTable,
 		JobsTable,
 		JobExecutionsTable,
 		JobPostingsTable,
 		JournalEntriesTable,
+		LabelTemplatesTable,
 		LedgerEntriesTable,
 		LegalHoldsTable,
 		MaintenanceSchedulesTable,
@@ -3231,8 +4935,15 @@ var (
 		NetworkPortsTable,
 		NexusAuditsTable,
 		OneTimeLinksTable,
+		PatchPoliciesTable,
+		PaymentAllocationsTable,
+		PaymentRunsTable,
+		PaymentRunLinesTable,
 		PerformanceReviewsTable,
 		PermissionsTable,
+		PosSaleLinesTable,
+		PosShiftsTable,
+		PosTendersTable,
 		ProductsTable,
 		ProductVariantsTable,
 		PurchaseOrdersTable,
@@ -3251,10 +4962,15 @@ var (
 		ServiceRatesTable,
 		StockAlertsTable,
 		StockAuditLogsTable,
+		StockLevelsTable,
 		StockMovementsTable,
 		StrategicRoadmapsTable,
 		SuccessionMapsTable,
 		SuppliersTable,
+		SupplierBillsTable,
+		SupplierBillLinesTable,
+		TaxJurisdictionsTable,
+		TaxRatesTable,
 		TenantsTable,
 		TicketsTable,
 		TimeEntriesTable,
@@ -3262,6 +4978,8 @@ var (
 		TimeOffPoliciesTable,
 		TimeOffRequestsTable,
 		TransactionsTable,
+		TransferOrdersTable,
+		TransferOrderLinesTable,
 		UsersTable,
 		VaultCommentsTable,
 		VaultFavoritesTable,
@@ -3273,6 +4991,7 @@ var (
 		WarehousesTable,
 		WorkLogsTable,
 		AssetDependsOnTable,
+		DeviceGroupAgentsTable,
 		EmployeeConductedInterviewsTable,
 		LegalHoldItemsTable,
 		UserPermissionsTable,
@@ -3281,7 +5000,17 @@ var (
 
 func init() {
 	AccountsTable.ForeignKeys[0].RefTable = TenantsTable
-	AgentsTable.ForeignKeys[0].RefTable = TenantsTable
+	AccountBalanceSnapshotsTable.ForeignKeys[0].RefTable = AccountsTable
+	AccountBalanceSnapshotsTable.ForeignKeys[1].RefTable = TenantsTable
+	AgentsTable.ForeignKeys[0].RefTable = AgentEnrollmentTokensTable
+	AgentsTable.ForeignKeys[1].RefTable = TenantsTable
+	AgentAlertsTable.ForeignKeys[0].RefTable = AgentsTable
+	AgentAlertsTable.ForeignKeys[1].RefTable = AlertRulesTable
+	AgentAuthoritiesTable.ForeignKeys[0].RefTable = TenantsTable
+	AgentEnrollmentTokensTable.ForeignKeys[0].RefTable = TenantsTable
+	AgentInventoriesTable.ForeignKeys[0].RefTable = AgentsTable
+	AgentSoftwaresTable.ForeignKeys[0].RefTable = AgentsTable
+	AlertRulesTable.ForeignKeys[0].RefTable = TenantsTable
 	ApplicationsTable.ForeignKeys[0].RefTable = CandidatesTable
 	ApplicationsTable.ForeignKeys[1].RefTable = JobPostingsTable
 	ApplicationsTable.ForeignKeys[2].RefTable = TenantsTable
@@ -3295,6 +5024,15 @@ func init() {
 	AssetAssignmentsTable.ForeignKeys[2].RefTable = TenantsTable
 	AssetTypesTable.ForeignKeys[0].RefTable = TenantsTable
 	AuditLogsTable.ForeignKeys[0].RefTable = TenantsTable
+	BankRulesTable.ForeignKeys[0].RefTable = AccountsTable
+	BankRulesTable.ForeignKeys[1].RefTable = TenantsTable
+	BankStatementsTable.ForeignKeys[0].RefTable = AccountsTable
+	BankStatementsTable.ForeignKeys[1].RefTable = TenantsTable
+	BankStatementLinesTable.ForeignKeys[0].RefTable = AccountsTable
+	BankStatementLinesTable.ForeignKeys[1].RefTable = BankStatementsTable
+	BankStatementLinesTable.ForeignKeys[2].RefTable = AccountsTable
+	BankStatementLinesTable.ForeignKeys[3].RefTable = BankRulesTable
+	BankStatementLinesTable.ForeignKeys[4].RefTable = TenantsTable
 	BenefitEnrollmentsTable.ForeignKeys[0].RefTable = BenefitPlansTable
 	BenefitEnrollmentsTable.ForeignKeys[1].RefTable = EmployeesTable
 	BenefitEnrollmentsTable.ForeignKeys[2].RefTable = TenantsTable
@@ -3311,18 +5049,38 @@ func init() {
 	ContractsTable.ForeignKeys[0].RefTable = TenantsTable
 	CredentialsTable.ForeignKeys[0].RefTable = AssetsTable
 	CredentialsTable.ForeignKeys[1].RefTable = TenantsTable
+	CustomersTable.ForeignKeys[0].RefTable = TenantsTable
+	CustomerPaymentsTable.ForeignKeys[0].RefTable = CustomersTable
+	CustomerPaymentsTable.ForeignKeys[1].RefTable = AccountsTable
+	CustomerPaymentsTable.ForeignKeys[2].RefTable = TransactionsTable
+	CustomerPaymentsTable.ForeignKeys[3].RefTable = TenantsTable
 	DepartmentsTable.ForeignKeys[0].RefTable = DepartmentsTable
 	DepartmentsTable.ForeignKeys[1].RefTable = EmployeesTable
 	DepartmentsTable.ForeignKeys[2].RefTable = TenantsTable
 	DetectionEventsTable.ForeignKeys[0].RefTable = CamerasTable
 	DetectionEventsTable.ForeignKeys[1].RefTable = TenantsTable
+	DeviceGroupsTable.ForeignKeys[0].RefTable = TenantsTable
 	DiscoveryEntriesTable.ForeignKeys[0].RefTable = TenantsTable
+	EinvoicesTable.ForeignKeys[0].RefTable = InvoicesTable
+	EinvoicesTable.ForeignKeys[1].RefTable = TransactionsTable
+	EinvoicesTable.ForeignKeys[2].RefTable = EinvoiceCredentialsTable
+	EinvoicesTable.ForeignKeys[3].RefTable = TenantsTable
+	EinvoiceCredentialsTable.ForeignKeys[0].RefTable = TenantsTable
 	EmployeesTable.ForeignKeys[0].RefTable = DepartmentsTable
 	EmployeesTable.ForeignKeys[1].RefTable = EmployeesTable
 	EmployeesTable.ForeignKeys[2].RefTable = AccountsTable
 	EmployeesTable.ForeignKeys[3].RefTable = TenantsTable
+	ExchangeRatesTable.ForeignKeys[0].RefTable = TenantsTable
+	FiscalPeriodsTable.ForeignKeys[0].RefTable = TenantsTable
 	GoalsTable.ForeignKeys[0].RefTable = EmployeesTable
 	GoalsTable.ForeignKeys[1].RefTable = TenantsTable
+	GoodsReceiptsTable.ForeignKeys[0].RefTable = TransactionsTable
+	GoodsReceiptsTable.ForeignKeys[1].RefTable = PurchaseOrdersTable
+	GoodsReceiptsTable.ForeignKeys[2].RefTable = TenantsTable
+	GoodsReceiptsTable.ForeignKeys[3].RefTable = WarehousesTable
+	GoodsReceiptLinesTable.ForeignKeys[0].RefTable = GoodsReceiptsTable
+	GoodsReceiptLinesTable.ForeignKeys[1].RefTable = StockMovementsTable
+	GoodsReceiptLinesTable.ForeignKeys[2].RefTable = PurchaseOrderLinesTable
 	HealthScoreSnapshotsTable.ForeignKeys[0].RefTable = TenantsTable
 	IvrFlowsTable.ForeignKeys[0].RefTable = TenantsTable
 	InterviewsTable.ForeignKeys[0].RefTable = ApplicationsTable
@@ -3331,6 +5089,15 @@ func init() {
 	InventoryCountsTable.ForeignKeys[1].RefTable = TenantsTable
 	InventoryReservationsTable.ForeignKeys[0].RefTable = ProductsTable
 	InventoryReservationsTable.ForeignKeys[1].RefTable = TenantsTable
+	InventoryReservationsTable.ForeignKeys[2].RefTable = WarehousesTable
+	InvoicesTable.ForeignKeys[0].RefTable = CustomersTable
+	InvoicesTable.ForeignKeys[1].RefTable = TransactionsTable
+	InvoicesTable.ForeignKeys[2].RefTable = InvoicesTable
+	InvoicesTable.ForeignKeys[3].RefTable = TenantsTable
+	InvoiceLinesTable.ForeignKeys[0].RefTable = InvoicesTable
+	InvoiceLinesTable.ForeignKeys[1].RefTable = AccountsTable
+	InvoiceTaxLinesTable.ForeignKeys[0].RefTable = InvoicesTable
+	InvoiceTaxLinesTable.ForeignKeys[1].RefTable = AccountsTable
 	JobsTable.ForeignKeys[0].RefTable = ScriptsTable
 	JobsTable.ForeignKeys[1].RefTable = TenantsTable
 	JobExecutionsTable.ForeignKeys[0].RefTable = AgentsTable
@@ -3340,9 +5107,11 @@ func init() {
 	JournalEntriesTable.ForeignKeys[1].RefTable = UsersTable
 	JournalEntriesTable.ForeignKeys[2].RefTable = TenantsTable
 	JournalEntriesTable.ForeignKeys[3].RefTable = TransactionsTable
+	LabelTemplatesTable.ForeignKeys[0].RefTable = TenantsTable
 	LedgerEntriesTable.ForeignKeys[0].RefTable = AccountsTable
-	LedgerEntriesTable.ForeignKeys[1].RefTable = TenantsTable
-	LedgerEntriesTable.ForeignKeys[2].RefTable = TransactionsTable
+	LedgerEntriesTable.ForeignKeys[1].RefTable = BankStatementLinesTable
+	LedgerEntriesTable.ForeignKeys[2].RefTable = TenantsTable
+	LedgerEntriesTable.ForeignKeys[3].RefTable = TransactionsTable
 	LegalHoldsTable.ForeignKeys[0].RefTable = TenantsTable
 	LegalHoldsTable.ForeignKeys[1].RefTable = UsersTable
 	MaintenanceSchedulesTable.ForeignKeys[0].RefTable = ProductsTable
@@ -3360,11 +5129,27 @@ func init() {
 	OneTimeLinksTable.ForeignKeys[1].RefTable = CredentialsTable
 	OneTimeLinksTable.ForeignKeys[2].RefTable = TenantsTable
 	OneTimeLinksTable.ForeignKeys[3].RefTable = TenantsTable
+	PatchPoliciesTable.ForeignKeys[0].RefTable = TenantsTable
+	PaymentAllocationsTable.ForeignKeys[0].RefTable = CustomerPaymentsTable
+	PaymentAllocationsTable.ForeignKeys[1].RefTable = InvoicesTable
+	PaymentAllocationsTable.ForeignKeys[2].RefTable = InvoicesTable
+	PaymentRunsTable.ForeignKeys[0].RefTable = AccountsTable
+	PaymentRunsTable.ForeignKeys[1].RefTable = TenantsTable
+	PaymentRunLinesTable.ForeignKeys[0].RefTable = PaymentRunsTable
+	PaymentRunLinesTable.ForeignKeys[1].RefTable = TransactionsTable
+	PaymentRunLinesTable.ForeignKeys[2].RefTable = SupplierBillsTable
 	PerformanceReviewsTable.ForeignKeys[0].RefTable = EmployeesTable
 	PerformanceReviewsTable.ForeignKeys[1].RefTable = EmployeesTable
 	PerformanceReviewsTable.ForeignKeys[2].RefTable = ReviewCyclesTable
 	PerformanceReviewsTable.ForeignKeys[3].RefTable = TenantsTable
 	PermissionsTable.ForeignKeys[0].RefTable = TenantsTable
+	PosSaleLinesTable.ForeignKeys[0].RefTable = PosSaleLinesTable
+	PosSaleLinesTable.ForeignKeys[1].RefTable = ProductsTable
+	PosSaleLinesTable.ForeignKeys[2].RefTable = TransactionsTable
+	PosShiftsTable.ForeignKeys[0].RefTable = TenantsTable
+	PosShiftsTable.ForeignKeys[1].RefTable = WarehousesTable
+	PosTendersTable.ForeignKeys[0].RefTable = AccountsTable
+	PosTendersTable.ForeignKeys[1].RefTable = TransactionsTable
 	ProductsTable.ForeignKeys[0].RefTable = CategoriesTable
 	ProductsTable.ForeignKeys[1].RefTable = AccountsTable
 	ProductsTable.ForeignKeys[2].RefTable = SuppliersTable
@@ -3378,7 +5163,8 @@ func init() {
 	RecordingsTable.ForeignKeys[0].RefTable = CamerasTable
 	RecordingsTable.ForeignKeys[1].RefTable = TenantsTable
 	RecurringInvoicesTable.ForeignKeys[0].RefTable = AccountsTable
-	RecurringInvoicesTable.ForeignKeys[1].RefTable = TenantsTable
+	RecurringInvoicesTable.ForeignKeys[1].RefTable = CustomersTable
+	RecurringInvoicesTable.ForeignKeys[2].RefTable = TenantsTable
 	RemediationStepsTable.ForeignKeys[0].RefTable = TicketsTable
 	RetentionPoliciesTable.ForeignKeys[0].RefTable = TenantsTable
 	ReviewCyclesTable.ForeignKeys[0].RefTable = TenantsTable
@@ -3398,13 +5184,28 @@ func init() {
 	StockAlertsTable.ForeignKeys[0].RefTable = ProductsTable
 	StockAlertsTable.ForeignKeys[1].RefTable = TenantsTable
 	StockAuditLogsTable.ForeignKeys[0].RefTable = TenantsTable
+	StockLevelsTable.ForeignKeys[0].RefTable = ProductsTable
+	StockLevelsTable.ForeignKeys[1].RefTable = TenantsTable
+	StockLevelsTable.ForeignKeys[2].RefTable = WarehousesTable
 	StockMovementsTable.ForeignKeys[0].RefTable = ProductsTable
 	StockMovementsTable.ForeignKeys[1].RefTable = TenantsTable
+	StockMovementsTable.ForeignKeys[2].RefTable = TransactionsTable
+	StockMovementsTable.ForeignKeys[3].RefTable = TransferOrdersTable
+	StockMovementsTable.ForeignKeys[4].RefTable = WarehousesTable
 	StrategicRoadmapsTable.ForeignKeys[0].RefTable = TenantsTable
 	SuccessionMapsTable.ForeignKeys[0].RefTable = EmployeesTable
 	SuccessionMapsTable.ForeignKeys[1].RefTable = EmployeesTable
 	SuccessionMapsTable.ForeignKeys[2].RefTable = TenantsTable
 	SuppliersTable.ForeignKeys[0].RefTable = TenantsTable
+	SupplierBillsTable.ForeignKeys[0].RefTable = PurchaseOrdersTable
+	SupplierBillsTable.ForeignKeys[1].RefTable = SuppliersTable
+	SupplierBillsTable.ForeignKeys[2].RefTable = TransactionsTable
+	SupplierBillsTable.ForeignKeys[3].RefTable = TenantsTable
+	SupplierBillLinesTable.ForeignKeys[0].RefTable = PurchaseOrderLinesTable
+	SupplierBillLinesTable.ForeignKeys[1].RefTable = SupplierBillsTable
+	SupplierBillLinesTable.ForeignKeys[2].RefTable = AccountsTable
+	TaxJurisdictionsTable.ForeignKeys[0].RefTable = TenantsTable
+	TaxRatesTable.ForeignKeys[0].RefTable = TaxJurisdictionsTable
 	TenantsTable.ForeignKeys[0].RefTable = TenantsTable
 	TenantsTable.ForeignKeys[1].RefTable = AccountsTable
 	TicketsTable.ForeignKeys[0].RefTable = AssetsTable
@@ -3420,9 +5221,17 @@ func init() {
 	TimeOffRequestsTable.ForeignKeys[0].RefTable = EmployeesTable
 	TimeOffRequestsTable.ForeignKeys[1].RefTable = EmployeesTable
 	TimeOffRequestsTable.ForeignKeys[2].RefTable = TenantsTable
-	TransactionsTable.ForeignKeys[0].RefTable = TenantsTable
-	TransactionsTable.ForeignKeys[1].RefTable = RecordingsTable
-	TransactionsTable.ForeignKeys[2].RefTable = UsersTable
+	TransactionsTable.ForeignKeys[0].RefTable = CustomersTable
+	TransactionsTable.ForeignKeys[1].RefTable = PosShiftsTable
+	TransactionsTable.ForeignKeys[2].RefTable = TenantsTable
+	TransactionsTable.ForeignKeys[3].RefTable = RecordingsTable
+	TransactionsTable.ForeignKeys[4].RefTable = UsersTable
+	TransactionsTable.ForeignKeys[5].RefTable = TransactionsTable
+	TransferOrdersTable.ForeignKeys[0].RefTable = TenantsTable
+	TransferOrdersTable.ForeignKeys[1].RefTable = WarehousesTable
+	TransferOrdersTable.ForeignKeys[2].RefTable = WarehousesTable
+	TransferOrderLinesTable.ForeignKeys[0].RefTable = ProductsTable
+	TransferOrderLinesTable.ForeignKeys[1].RefTable = TransferOrdersTable
 	UsersTable.ForeignKeys[0].RefTable = TenantsTable
 	VaultCommentsTable.ForeignKeys[0].RefTable = UsersTable
 	VaultCommentsTable.ForeignKeys[1].RefTable = VaultItemsTable
@@ -3442,6 +5251,8 @@ func init() {
 	WorkLogsTable.ForeignKeys[1].RefTable = UsersTable
 	AssetDependsOnTable.ForeignKeys[0].RefTable = AssetsTable
 	AssetDependsOnTable.ForeignKeys[1].RefTable = AssetsTable
+	DeviceGroupAgentsTable.ForeignKeys[0].RefTable = DeviceGroupsTable
+	DeviceGroupAgentsTable.ForeignKeys[1].RefTable = AgentsTable
 	EmployeeConductedInterviewsTable.ForeignKeys[0].RefTable = EmployeesTable
 	EmployeeConductedInterviewsTable.ForeignKeys[1].RefTable = InterviewsTable
 	LegalHoldItemsTable.ForeignKeys[0].RefTable = LegalHoldsTable
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sent/ent/patchpolicy"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PatchPolicy is the model entity for the PatchPolicy schema.
+type PatchPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Targets holds the value of the "targets" field.
+	Targets []string `json:"targets,omitempty"`
+	// CronSchedule holds the value of the "cron_schedule" field.
+	CronSchedule string `json:"cron_schedule,omitempty"`
+	// Severities holds the value of the "severities" field.
+	Severities []string `json:"severities,omitempty"`
+	// Reboot holds the value of the "reboot" field.
+	Reboot bool `json:"reboot,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// NextRun holds the value of the "next_run" field.
+	NextRun *time.Time `json:"next_run,omitempty"`
+	// LastRun holds the value of the "last_run" field.
+	LastRun *time.Time `json:"last_run,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PatchPolicyQuery when eager-loading is set.
+	Edges                 PatchPolicyEdges `json:"edges"`
+	tenant_patch_policies *int
+	selectValues          sql.SelectValues
+}
+
+// PatchPolicyEdges holds the relations/edges for other nodes in the graph.
+type PatchPolicyEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PatchPolicyEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PatchPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case patchpolicy.FieldTargets, patchpolicy.FieldSeverities:
+			values[i] = new([]byte)
+		case patchpolicy.FieldReboot, patchpolicy.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case patchpolicy.FieldID:
+			values[i] = new(sql.NullInt64)
+		case patchpolicy.FieldName, patchpolicy.FieldDescription, patchpolicy.FieldCronSchedule, patchpolicy.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case patchpolicy.FieldNextRun, patchpolicy.FieldLastRun, patchpolicy.FieldCreatedAt, patchpolicy.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case patchpolicy.ForeignKeys[0]: // tenant_patch_policies
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PatchPolicy fields.
+func (_m *PatchPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case patchpolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case patchpolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case patchpolicy.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case patchpolicy.FieldTargets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field targets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Targets); err != nil {
+					return fmt.Errorf("unmarshal field targets: %w", err)
+				}
+			}
+		case patchpolicy.FieldCronSchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_schedule", values[i])
+			} else if value.Valid {
+				_m.CronSchedule = value.String
+			}
+		case patchpolicy.FieldSeverities:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field severities", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Severities); err != nil {
+					return fmt.Errorf("unmarshal field severities: %w", err)
+				}
+			}
+		case patchpolicy.FieldReboot:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field reboot", values[i])
+			} else if value.Valid {
+				_m.Reboot = value.Bool
+			}
+		case patchpolicy.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case patchpolicy.FieldNextRun:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run", values[i])
+			} else if value.Valid {
+				_m.NextRun = new(time.Time)
+				*_m.NextRun = value.Time
+			}
+		case patchpolicy.FieldLastRun:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run", values[i])
+			} else if value.Valid {
+				_m.LastRun = new(time.Time)
+				*_m.LastRun = value.Time
+			}
+		case patchpolicy.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case patchpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case patchpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case patchpolicy.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_patch_policies", value)
+			} else if value.Valid {
+				_m.tenant_patch_policies = new(int)
+				*_m.tenant_patch_policies = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PatchPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *PatchPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the PatchPolicy entity.
+func (_m *PatchPolicy) QueryTenant() *TenantQuery {
+	return NewPatchPolicyClient(_m.config).QueryTenant(_m)
+}
+
+// Update returns a builder for updating this PatchPolicy.
+// Note that you need to call PatchPolicy.Unwrap() before calling this method if this PatchPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PatchPolicy) Update() *PatchPolicyUpdateOne {
+	return NewPatchPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PatchPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PatchPolicy) Unwrap() *PatchPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PatchPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PatchPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("PatchPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("targets=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Targets))
+	builder.WriteString(", ")
+	builder.WriteString("cron_schedule=")
+	builder.WriteString(_m.CronSchedule)
+	builder.WriteString(", ")
+	builder.WriteString("severities=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Severities))
+	builder.WriteString(", ")
+	builder.WriteString("reboot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reboot))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.NextRun; v != nil {
+		builder.WriteString("next_run=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastRun; v != nil {
+		builder.WriteString("last_run=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PatchPolicies is a parsable slice of PatchPolicy.
+type PatchPolicies []*PatchPolicy
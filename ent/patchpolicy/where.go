@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package patchpolicy
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// CronSchedule applies equality check predicate on the "cron_schedule" field. It's identical to CronScheduleEQ.
+func CronSchedule(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldCronSchedule, v))
+}
+
+// Reboot applies equality check predicate on the "reboot" field. It's identical to RebootEQ.
+func Reboot(v bool) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldReboot, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextRun applies equality check predicate on the "next_run" field. It's identical to NextRunEQ.
+func NextRun(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldNextRun, v))
+}
+
+// LastRun applies equality check predicate on the "last_run" field. It's identical to LastRunEQ.
+func LastRun(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldLastRun, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CronScheduleEQ applies the EQ predicate on the "cron_schedule" field.
+func CronScheduleEQ(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldCronSchedule, v))
+}
+
+// CronScheduleNEQ applies the NEQ predicate on the "cron_schedule" field.
+func CronScheduleNEQ(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldCronSchedule, v))
+}
+
+// CronScheduleIn applies the In predicate on the "cron_schedule" field.
+func CronScheduleIn(vs ...string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldCronSchedule, vs...))
+}
+
+// CronScheduleNotIn applies the NotIn predicate on the "cron_schedule" field.
+func CronScheduleNotIn(vs ...string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldCronSchedule, vs...))
+}
+
+// CronScheduleGT applies the GT predicate on the "cron_schedule" field.
+func CronScheduleGT(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldCronSchedule, v))
+}
+
+// CronScheduleGTE applies the GTE predicate on the "cron_schedule" field.
+func CronScheduleGTE(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldCronSchedule, v))
+}
+
+// CronScheduleLT applies the LT predicate on the "cron_schedule" field.
+func CronScheduleLT(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldCronSchedule, v))
+}
+
+// CronScheduleLTE applies the LTE predicate on the "cron_schedule" field.
+func CronScheduleLTE(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldCronSchedule, v))
+}
+
+// CronScheduleContains applies the Contains predicate on the "cron_schedule" field.
+func CronScheduleContains(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldContains(FieldCronSchedule, v))
+}
+
+// CronScheduleHasPrefix applies the HasPrefix predicate on the "cron_schedule" field.
+func CronScheduleHasPrefix(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldHasPrefix(FieldCronSchedule, v))
+}
+
+// CronScheduleHasSuffix applies the HasSuffix predicate on the "cron_schedule" field.
+func CronScheduleHasSuffix(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldHasSuffix(FieldCronSchedule, v))
+}
+
+// CronScheduleIsNil applies the IsNil predicate on the "cron_schedule" field.
+func CronScheduleIsNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIsNull(FieldCronSchedule))
+}
+
+// CronScheduleNotNil applies the NotNil predicate on the "cron_schedule" field.
+func CronScheduleNotNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotNull(FieldCronSchedule))
+}
+
+// CronScheduleEqualFold applies the EqualFold predicate on the "cron_schedule" field.
+func CronScheduleEqualFold(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEqualFold(FieldCronSchedule, v))
+}
+
+// CronScheduleContainsFold applies the ContainsFold predicate on the "cron_schedule" field.
+func CronScheduleContainsFold(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldContainsFold(FieldCronSchedule, v))
+}
+
+// SeveritiesIsNil applies the IsNil predicate on the "severities" field.
+func SeveritiesIsNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIsNull(FieldSeverities))
+}
+
+// SeveritiesNotNil applies the NotNil predicate on the "severities" field.
+func SeveritiesNotNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotNull(FieldSeverities))
+}
+
+// RebootEQ applies the EQ predicate on the "reboot" field.
+func RebootEQ(v bool) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldReboot, v))
+}
+
+// RebootNEQ applies the NEQ predicate on the "reboot" field.
+func RebootNEQ(v bool) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldReboot, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// NextRunEQ applies the EQ predicate on the "next_run" field.
+func NextRunEQ(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldNextRun, v))
+}
+
+// NextRunNEQ applies the NEQ predicate on the "next_run" field.
+func NextRunNEQ(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldNextRun, v))
+}
+
+// NextRunIn applies the In predicate on the "next_run" field.
+func NextRunIn(vs ...time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldNextRun, vs...))
+}
+
+// NextRunNotIn applies the NotIn predicate on the "next_run" field.
+func NextRunNotIn(vs ...time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldNextRun, vs...))
+}
+
+// NextRunGT applies the GT predicate on the "next_run" field.
+func NextRunGT(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldNextRun, v))
+}
+
+// NextRunGTE applies the GTE predicate on the "next_run" field.
+func NextRunGTE(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldNextRun, v))
+}
+
+// NextRunLT applies the LT predicate on the "next_run" field.
+func NextRunLT(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldNextRun, v))
+}
+
+// NextRunLTE applies the LTE predicate on the "next_run" field.
+func NextRunLTE(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldNextRun, v))
+}
+
+// NextRunIsNil applies the IsNil predicate on the "next_run" field.
+func NextRunIsNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIsNull(FieldNextRun))
+}
+
+// NextRunNotNil applies the NotNil predicate on the "next_run" field.
+func NextRunNotNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotNull(FieldNextRun))
+}
+
+// LastRunEQ applies the EQ predicate on the "last_run" field.
+func LastRunEQ(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldLastRun, v))
+}
+
+// LastRunNEQ applies the NEQ predicate on the "last_run" field.
+func LastRunNEQ(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldLastRun, v))
+}
+
+// LastRunIn applies the In predicate on the "last_run" field.
+func LastRunIn(vs ...time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldLastRun, vs...))
+}
+
+// LastRunNotIn applies the NotIn predicate on the "last_run" field.
+func LastRunNotIn(vs ...time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldLastRun, vs...))
+}
+
+// LastRunGT applies the GT predicate on the "last_run" field.
+func LastRunGT(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldLastRun, v))
+}
+
+// LastRunGTE applies the GTE predicate on the "last_run" field.
+func LastRunGTE(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldLastRun, v))
+}
+
+// LastRunLT applies the LT predicate on the "last_run" field.
+func LastRunLT(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldLastRun, v))
+}
+
+// LastRunLTE applies the LTE predicate on the "last_run" field.
+func LastRunLTE(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldLastRun, v))
+}
+
+// LastRunIsNil applies the IsNil predicate on the "last_run" field.
+func LastRunIsNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIsNull(FieldLastRun))
+}
+
+// LastRunNotNil applies the NotNil predicate on the "last_run" field.
+func LastRunNotNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotNull(FieldLastRun))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.PatchPolicy {
+	return predicate.PatchPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.PatchPolicy {
+	return predicate.PatchPolicy(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PatchPolicy) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PatchPolicy) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PatchPolicy) predicate.PatchPolicy {
+	return predicate.PatchPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/patchpolicy"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PatchPolicyCreate is the builder for creating a PatchPolicy entity.
+type PatchPolicyCreate struct {
+	config
+	mutation *PatchPolicyMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *PatchPolicyCreate) SetName(v string) *PatchPolicyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *PatchPolicyCreate) SetDescription(v string) *PatchPolicyCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableDescription(v *string) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTargets sets the "targets" field.
+func (_c *PatchPolicyCreate) SetTargets(v []string) *PatchPolicyCreate {
+	_c.mutation.SetTargets(v)
+	return _c
+}
+
+// SetCronSchedule sets the "cron_schedule" field.
+func (_c *PatchPolicyCreate) SetCronSchedule(v string) *PatchPolicyCreate {
+	_c.mutation.SetCronSchedule(v)
+	return _c
+}
+
+// SetNillableCronSchedule sets the "cron_schedule" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableCronSchedule(v *string) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetCronSchedule(*v)
+	}
+	return _c
+}
+
+// SetSeverities sets the "severities" field.
+func (_c *PatchPolicyCreate) SetSeverities(v []string) *PatchPolicyCreate {
+	_c.mutation.SetSeverities(v)
+	return _c
+}
+
+// SetReboot sets the "reboot" field.
+func (_c *PatchPolicyCreate) SetReboot(v bool) *PatchPolicyCreate {
+	_c.mutation.SetReboot(v)
+	return _c
+}
+
+// SetNillableReboot sets the "reboot" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableReboot(v *bool) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetReboot(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *PatchPolicyCreate) SetEnabled(v bool) *PatchPolicyCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableEnabled(v *bool) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetNextRun sets the "next_run" field.
+func (_c *PatchPolicyCreate) SetNextRun(v time.Time) *PatchPolicyCreate {
+	_c.mutation.SetNextRun(v)
+	return _c
+}
+
+// SetNillableNextRun sets the "next_run" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableNextRun(v *time.Time) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetNextRun(*v)
+	}
+	return _c
+}
+
+// SetLastRun sets the "last_run" field.
+func (_c *PatchPolicyCreate) SetLastRun(v time.Time) *PatchPolicyCreate {
+	_c.mutation.SetLastRun(v)
+	return _c
+}
+
+// SetNillableLastRun sets the "last_run" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableLastRun(v *time.Time) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetLastRun(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PatchPolicyCreate) SetCreatedBy(v string) *PatchPolicyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableCreatedBy(v *string) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PatchPolicyCreate) SetCreatedAt(v time.Time) *PatchPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableCreatedAt(v *time.Time) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PatchPolicyCreate) SetUpdatedAt(v time.Time) *PatchPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PatchPolicyCreate) SetNillableUpdatedAt(v *time.Time) *PatchPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *PatchPolicyCreate) SetTenantID(id int) *PatchPolicyCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *PatchPolicyCreate) SetTenant(v *Tenant) *PatchPolicyCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// Mutation returns the PatchPolicyMutation object of the builder.
+func (_c *PatchPolicyCreate) Mutation() *PatchPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the PatchPolicy in the database.
+func (_c *PatchPolicyCreate) Save(ctx context.Context) (*PatchPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PatchPolicyCreate) SaveX(ctx context.Context) *PatchPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PatchPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PatchPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PatchPolicyCreate) defaults() {
+	if _, ok := _c.mutation.Reboot(); !ok {
+		v := patchpolicy.DefaultReboot
+		_c.mutation.SetReboot(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := patchpolicy.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := patchpolicy.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := patchpolicy.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PatchPolicyCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PatchPolicy.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := patchpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PatchPolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Targets(); !ok {
+		return &ValidationError{Name: "targets", err: errors.New(`ent: missing required field "PatchPolicy.targets"`)}
+	}
+	if _, ok := _c.mutation.Reboot(); !ok {
+		return &ValidationError{Name: "reboot", err: errors.New(`ent: missing required field "PatchPolicy.reboot"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "PatchPolicy.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PatchPolicy.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PatchPolicy.updated_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "PatchPolicy.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *PatchPolicyCreate) sqlSave(ctx context.Context) (*PatchPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PatchPolicyCreate) createSpec() (*PatchPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PatchPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(patchpolicy.Table, sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(patchpolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(patchpolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Targets(); ok {
+		_spec.SetField(patchpolicy.FieldTargets, field.TypeJSON, value)
+		_node.Targets = value
+	}
+	if value, ok := _c.mutation.CronSchedule(); ok {
+		_spec.SetField(patchpolicy.FieldCronSchedule, field.TypeString, value)
+		_node.CronSchedule = value
+	}
+	if value, ok := _c.mutation.Severities(); ok {
+		_spec.SetField(patchpolicy.FieldSeverities, field.TypeJSON, value)
+		_node.Severities = value
+	}
+	if value, ok := _c.mutation.Reboot(); ok {
+		_spec.SetField(patchpolicy.FieldReboot, field.TypeBool, value)
+		_node.Reboot = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(patchpolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.NextRun(); ok {
+		_spec.SetField(patchpolicy.FieldNextRun, field.TypeTime, value)
+		_node.NextRun = &value
+	}
+	if value, ok := _c.mutation.LastRun(); ok {
+		_spec.SetField(patchpolicy.FieldLastRun, field.TypeTime, value)
+		_node.LastRun = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(patchpolicy.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(patchpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(patchpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   patchpolicy.TenantTable,
+			Columns: []string{patchpolicy.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_patch_policies = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PatchPolicyCreateBulk is the builder for creating many PatchPolicy entities in bulk.
+type PatchPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*PatchPolicyCreate
+}
+
+// Save creates the PatchPolicy entities in the database.
+func (_c *PatchPolicyCreateBulk) Save(ctx context.Context) ([]*PatchPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PatchPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PatchPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PatchPolicyCreateBulk) SaveX(ctx context.Context) []*PatchPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PatchPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PatchPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
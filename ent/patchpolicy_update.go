@@ -0,0 +1,814 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/patchpolicy"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PatchPolicyUpdate is the builder for updating PatchPolicy entities.
+type PatchPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PatchPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PatchPolicyUpdate builder.
+func (_u *PatchPolicyUpdate) Where(ps ...predicate.PatchPolicy) *PatchPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PatchPolicyUpdate) SetName(v string) *PatchPolicyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PatchPolicyUpdate) SetNillableName(v *string) *PatchPolicyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PatchPolicyUpdate) SetDescription(v string) *PatchPolicyUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PatchPolicyUpdate) SetNillableDescription(v *string) *PatchPolicyUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PatchPolicyUpdate) ClearDescription() *PatchPolicyUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTargets sets the "targets" field.
+func (_u *PatchPolicyUpdate) SetTargets(v []string) *PatchPolicyUpdate {
+	_u.mutation.SetTargets(v)
+	return _u
+}
+
+// AppendTargets appends value to the "targets" field.
+func (_u *PatchPolicyUpdate) AppendTargets(v []string) *PatchPolicyUpdate {
+	_u.mutation.AppendTargets(v)
+	return _u
+}
+
+// SetCronSchedule sets the "cron_schedule" field.
+func (_u *PatchPolicyUpdate) SetCronSchedule(v string) *PatchPolicyUpdate {
+	_u.mutation.SetCronSchedule(v)
+	return _u
+}
+
+// SetNillableCronSchedule sets the "cron_schedule" field if the given value is not nil.
+func (_u *PatchPolicyUpdate) SetNillableCronSchedule(v *string) *PatchPolicyUpdate {
+	if v != nil {
+		_u.SetCronSchedule(*v)
+	}
+	return _u
+}
+
+// ClearCronSchedule clears the value of the "cron_schedule" field.
+func (_u *PatchPolicyUpdate) ClearCronSchedule() *PatchPolicyUpdate {
+	_u.mutation.ClearCronSchedule()
+	return _u
+}
+
+// SetSeverities sets the "severities" field.
+func (_u *PatchPolicyUpdate) SetSeverities(v []string) *PatchPolicyUpdate {
+	_u.mutation.SetSeverities(v)
+	return _u
+}
+
+// AppendSeverities appends value to the "severities" field.
+func (_u *PatchPolicyUpdate) AppendSeverities(v []string) *PatchPolicyUpdate {
+	_u.mutation.AppendSeverities(v)
+	return _u
+}
+
+// ClearSeverities clears the value of the "severities" field.
+func (_u *PatchPolicyUpdate) ClearSeverities() *PatchPolicyUpdate {
+	_u.mutation.ClearSeverities()
+	return _u
+}
+
+// SetReboot sets the "reboot" field.
+func (_u *PatchPolicyUpdate) SetReboot(v bool) *PatchPolicyUpdate {
+	_u.mutation.SetReboot(v)
+	return _u
+}
+
+// SetNillableReboot sets the "reboot" field if the given value is not nil.
+func (_u *PatchPolicyUpdate) SetNillableReboot(v *bool) *PatchPolicyUpdate {
+	if v != nil {
+		_u.SetReboot(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *PatchPolicyUpdate) SetEnabled(v bool) *PatchPolicyUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *PatchPolicyUpdate) SetNillableEnabled(v *bool) *PatchPolicyUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRun sets the "next_run" field.
+func (_u *PatchPolicyUpdate) SetNextRun(v time.Time) *PatchPolicyUpdate {
+	_u.mutation.SetNextRun(v)
+	return _u
+}
+
+// SetNillableNextRun sets the "next_run" field if the given value is not nil.
+func (_u *PatchPolicyUpdate) SetNillableNextRun(v *time.Time) *PatchPolicyUpdate {
+	if v != nil {
+		_u.SetNextRun(*v)
+	}
+	return _u
+}
+
+// ClearNextRun clears the value of the "next_run" field.
+func (_u *PatchPolicyUpdate) ClearNextRun() *PatchPolicyUpdate {
+	_u.mutation.ClearNextRun()
+	return _u
+}
+
+// SetLastRun sets the "last_run" field.
+func (_u *PatchPolicyUpdate) SetLastRun(v time.Time) *PatchPolicyUpdate {
+	_u.mutation.SetLastRun(v)
+	return _u
+}
+
+// SetNillableLastRun sets the "last_run" field if the given value is not nil.
+func (_u *PatchPolicyUpdate) SetNillableLastRun(v *time.Time) *PatchPolicyUpdate {
+	if v != nil {
+		_u.SetLastRun(*v)
+	}
+	return _u
+}
+
+// ClearLastRun clears the value of the "last_run" field.
+func (_u *PatchPolicyUpdate) ClearLastRun() *PatchPolicyUpdate {
+	_u.mutation.ClearLastRun()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PatchPolicyUpdate) SetCreatedBy(v string) *PatchPolicyUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PatchPolicyUpdate) SetNillableCreatedBy(v *string) *PatchPolicyUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PatchPolicyUpdate) ClearCreatedBy() *PatchPolicyUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PatchPolicyUpdate) SetUpdatedAt(v time.Time) *PatchPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *PatchPolicyUpdate) SetTenantID(id int) *PatchPolicyUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *PatchPolicyUpdate) SetTenant(v *Tenant) *PatchPolicyUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the PatchPolicyMutation object of the builder.
+func (_u *PatchPolicyUpdate) Mutation() *PatchPolicyMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *PatchPolicyUpdate) ClearTenant() *PatchPolicyUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PatchPolicyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PatchPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PatchPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PatchPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PatchPolicyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := patchpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PatchPolicyUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := patchpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PatchPolicy.name": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PatchPolicy.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PatchPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PatchPolicyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PatchPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(patchpolicy.Table, patchpolicy.Columns, sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(patchpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(patchpolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(patchpolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Targets(); ok {
+		_spec.SetField(patchpolicy.FieldTargets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, patchpolicy.FieldTargets, value)
+		})
+	}
+	if value, ok := _u.mutation.CronSchedule(); ok {
+		_spec.SetField(patchpolicy.FieldCronSchedule, field.TypeString, value)
+	}
+	if _u.mutation.CronScheduleCleared() {
+		_spec.ClearField(patchpolicy.FieldCronSchedule, field.TypeString)
+	}
+	if value, ok := _u.mutation.Severities(); ok {
+		_spec.SetField(patchpolicy.FieldSeverities, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSeverities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, patchpolicy.FieldSeverities, value)
+		})
+	}
+	if _u.mutation.SeveritiesCleared() {
+		_spec.ClearField(patchpolicy.FieldSeverities, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Reboot(); ok {
+		_spec.SetField(patchpolicy.FieldReboot, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(patchpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRun(); ok {
+		_spec.SetField(patchpolicy.FieldNextRun, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunCleared() {
+		_spec.ClearField(patchpolicy.FieldNextRun, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRun(); ok {
+		_spec.SetField(patchpolicy.FieldLastRun, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunCleared() {
+		_spec.ClearField(patchpolicy.FieldLastRun, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(patchpolicy.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(patchpolicy.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(patchpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   patchpolicy.TenantTable,
+			Columns: []string{patchpolicy.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   patchpolicy.TenantTable,
+			Columns: []string{patchpolicy.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{patchpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PatchPolicyUpdateOne is the builder for updating a single PatchPolicy entity.
+type PatchPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PatchPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *PatchPolicyUpdateOne) SetName(v string) *PatchPolicyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PatchPolicyUpdateOne) SetNillableName(v *string) *PatchPolicyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PatchPolicyUpdateOne) SetDescription(v string) *PatchPolicyUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PatchPolicyUpdateOne) SetNillableDescription(v *string) *PatchPolicyUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PatchPolicyUpdateOne) ClearDescription() *PatchPolicyUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTargets sets the "targets" field.
+func (_u *PatchPolicyUpdateOne) SetTargets(v []string) *PatchPolicyUpdateOne {
+	_u.mutation.SetTargets(v)
+	return _u
+}
+
+// AppendTargets appends value to the "targets" field.
+func (_u *PatchPolicyUpdateOne) AppendTargets(v []string) *PatchPolicyUpdateOne {
+	_u.mutation.AppendTargets(v)
+	return _u
+}
+
+// SetCronSchedule sets the "cron_schedule" field.
+func (_u *PatchPolicyUpdateOne) SetCronSchedule(v string) *PatchPolicyUpdateOne {
+	_u.mutation.SetCronSchedule(v)
+	return _u
+}
+
+// SetNillableCronSchedule sets the "cron_schedule" field if the given value is not nil.
+func (_u *PatchPolicyUpdateOne) SetNillableCronSchedule(v *string) *PatchPolicyUpdateOne {
+	if v != nil {
+		_u.SetCronSchedule(*v)
+	}
+	return _u
+}
+
+// ClearCronSchedule clears the value of the "cron_schedule" field.
+func (_u *PatchPolicyUpdateOne) ClearCronSchedule() *PatchPolicyUpdateOne {
+	_u.mutation.ClearCronSchedule()
+	return _u
+}
+
+// SetSeverities sets the "severities" field.
+func (_u *PatchPolicyUpdateOne) SetSeverities(v []string) *PatchPolicyUpdateOne {
+	_u.mutation.SetSeverities(v)
+	return _u
+}
+
+// AppendSeverities appends value to the "severities" field.
+func (_u *PatchPolicyUpdateOne) AppendSeverities(v []string) *PatchPolicyUpdateOne {
+	_u.mutation.AppendSeverities(v)
+	return _u
+}
+
+// ClearSeverities clears the value of the "severities" field.
+func (_u *PatchPolicyUpdateOne) ClearSeverities() *PatchPolicyUpdateOne {
+	_u.mutation.ClearSeverities()
+	return _u
+}
+
+// SetReboot sets the "reboot" field.
+func (_u *PatchPolicyUpdateOne) SetReboot(v bool) *PatchPolicyUpdateOne {
+	_u.mutation.SetReboot(v)
+	return _u
+}
+
+// SetNillableReboot sets the "reboot" field if the given value is not nil.
+func (_u *PatchPolicyUpdateOne) SetNillableReboot(v *bool) *PatchPolicyUpdateOne {
+	if v != nil {
+		_u.SetReboot(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *PatchPolicyUpdateOne) SetEnabled(v bool) *PatchPolicyUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *PatchPolicyUpdateOne) SetNillableEnabled(v *bool) *PatchPolicyUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRun sets the "next_run" field.
+func (_u *PatchPolicyUpdateOne) SetNextRun(v time.Time) *PatchPolicyUpdateOne {
+	_u.mutation.SetNextRun(v)
+	return _u
+}
+
+// SetNillableNextRun sets the "next_run" field if the given value is not nil.
+func (_u *PatchPolicyUpdateOne) SetNillableNextRun(v *time.Time) *PatchPolicyUpdateOne {
+	if v != nil {
+		_u.SetNextRun(*v)
+	}
+	return _u
+}
+
+// ClearNextRun clears the value of the "next_run" field.
+func (_u *PatchPolicyUpdateOne) ClearNextRun() *PatchPolicyUpdateOne {
+	_u.mutation.ClearNextRun()
+	return _u
+}
+
+// SetLastRun sets the "last_run" field.
+func (_u *PatchPolicyUpdateOne) SetLastRun(v time.Time) *PatchPolicyUpdateOne {
+	_u.mutation.SetLastRun(v)
+	return _u
+}
+
+// SetNillableLastRun sets the "last_run" field if the given value is not nil.
+func (_u *PatchPolicyUpdateOne) SetNillableLastRun(v *time.Time) *PatchPolicyUpdateOne {
+	if v != nil {
+		_u.SetLastRun(*v)
+	}
+	return _u
+}
+
+// ClearLastRun clears the value of the "last_run" field.
+func (_u *PatchPolicyUpdateOne) ClearLastRun() *PatchPolicyUpdateOne {
+	_u.mutation.ClearLastRun()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PatchPolicyUpdateOne) SetCreatedBy(v string) *PatchPolicyUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PatchPolicyUpdateOne) SetNillableCreatedBy(v *string) *PatchPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PatchPolicyUpdateOne) ClearCreatedBy() *PatchPolicyUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PatchPolicyUpdateOne) SetUpdatedAt(v time.Time) *PatchPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *PatchPolicyUpdateOne) SetTenantID(id int) *PatchPolicyUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *PatchPolicyUpdateOne) SetTenant(v *Tenant) *PatchPolicyUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the PatchPolicyMutation object of the builder.
+func (_u *PatchPolicyUpdateOne) Mutation() *PatchPolicyMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *PatchPolicyUpdateOne) ClearTenant() *PatchPolicyUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Where appends a list predicates to the PatchPolicyUpdate builder.
+func (_u *PatchPolicyUpdateOne) Where(ps ...predicate.PatchPolicy) *PatchPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PatchPolicyUpdateOne) Select(field string, fields ...string) *PatchPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PatchPolicy entity.
+func (_u *PatchPolicyUpdateOne) Save(ctx context.Context) (*PatchPolicy, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PatchPolicyUpdateOne) SaveX(ctx context.Context) *PatchPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PatchPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PatchPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PatchPolicyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := patchpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PatchPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := patchpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PatchPolicy.name": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PatchPolicy.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PatchPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PatchPolicyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PatchPolicyUpdateOne) sqlSave(ctx context.Context) (_node *PatchPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(patchpolicy.Table, patchpolicy.Columns, sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PatchPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, patchpolicy.FieldID)
+		for _, f := range fields {
+			if !patchpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != patchpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(patchpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(patchpolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(patchpolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Targets(); ok {
+		_spec.SetField(patchpolicy.FieldTargets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, patchpolicy.FieldTargets, value)
+		})
+	}
+	if value, ok := _u.mutation.CronSchedule(); ok {
+		_spec.SetField(patchpolicy.FieldCronSchedule, field.TypeString, value)
+	}
+	if _u.mutation.CronScheduleCleared() {
+		_spec.ClearField(patchpolicy.FieldCronSchedule, field.TypeString)
+	}
+	if value, ok := _u.mutation.Severities(); ok {
+		_spec.SetField(patchpolicy.FieldSeverities, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSeverities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, patchpolicy.FieldSeverities, value)
+		})
+	}
+	if _u.mutation.SeveritiesCleared() {
+		_spec.ClearField(patchpolicy.FieldSeverities, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Reboot(); ok {
+		_spec.SetField(patchpolicy.FieldReboot, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(patchpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRun(); ok {
+		_spec.SetField(patchpolicy.FieldNextRun, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunCleared() {
+		_spec.ClearField(patchpolicy.FieldNextRun, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRun(); ok {
+		_spec.SetField(patchpolicy.FieldLastRun, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunCleared() {
+		_spec.ClearField(patchpolicy.FieldLastRun, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(patchpolicy.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(patchpolicy.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(patchpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   patchpolicy.TenantTable,
+			Columns: []string{patchpolicy.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   patchpolicy.TenantTable,
+			Columns: []string{patchpolicy.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PatchPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{patchpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/customerpayment"
+	"sent/ent/invoice"
+	"sent/ent/paymentallocation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentAllocation is the model entity for the PaymentAllocation schema.
+type PaymentAllocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// FxDifference holds the value of the "fx_difference" field.
+	FxDifference decimal.Decimal `json:"fx_difference,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentAllocationQuery when eager-loading is set.
+	Edges                        PaymentAllocationEdges `json:"edges"`
+	customer_payment_allocations *int
+	invoice_allocations          *int
+	invoice_credit_applications  *int
+	selectValues                 sql.SelectValues
+}
+
+// PaymentAllocationEdges holds the relations/edges for other nodes in the graph.
+type PaymentAllocationEdges struct {
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Payment holds the value of the payment edge.
+	Payment *CustomerPayment `json:"payment,omitempty"`
+	// CreditNote holds the value of the credit_note edge.
+	CreditNote *Invoice `json:"credit_note,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentAllocationEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// PaymentOrErr returns the Payment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentAllocationEdges) PaymentOrErr() (*CustomerPayment, error) {
+	if e.Payment != nil {
+		return e.Payment, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: customerpayment.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment"}
+}
+
+// CreditNoteOrErr returns the CreditNote value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentAllocationEdges) CreditNoteOrErr() (*Invoice, error) {
+	if e.CreditNote != nil {
+		return e.CreditNote, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "credit_note"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentAllocation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentallocation.FieldAmount, paymentallocation.FieldFxDifference:
+			values[i] = new(decimal.Decimal)
+		case paymentallocation.FieldID:
+			values[i] = new(sql.NullInt64)
+		case paymentallocation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case paymentallocation.ForeignKeys[0]: // customer_payment_allocations
+			values[i] = new(sql.NullInt64)
+		case paymentallocation.ForeignKeys[1]: // invoice_allocations
+			values[i] = new(sql.NullInt64)
+		case paymentallocation.ForeignKeys[2]: // invoice_credit_applications
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentAllocation fields.
+func (_m *PaymentAllocation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentallocation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case paymentallocation.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case paymentallocation.FieldFxDifference:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_difference", values[i])
+			} else if value != nil {
+				_m.FxDifference = *value
+			}
+		case paymentallocation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case paymentallocation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field customer_payment_allocations", value)
+			} else if value.Valid {
+				_m.customer_payment_allocations = new(int)
+				*_m.customer_payment_allocations = int(value.Int64)
+			}
+		case paymentallocation.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_allocations", value)
+			} else if value.Valid {
+				_m.invoice_allocations = new(int)
+				*_m.invoice_allocations = int(value.Int64)
+			}
+		case paymentallocation.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_credit_applications", value)
+			} else if value.Valid {
+				_m.invoice_credit_applications = new(int)
+				*_m.invoice_credit_applications = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentAllocation.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentAllocation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryInvoice queries the "invoice" edge of the PaymentAllocation entity.
+func (_m *PaymentAllocation) QueryInvoice() *InvoiceQuery {
+	return NewPaymentAllocationClient(_m.config).QueryInvoice(_m)
+}
+
+// QueryPayment queries the "payment" edge of the PaymentAllocation entity.
+func (_m *PaymentAllocation) QueryPayment() *CustomerPaymentQuery {
+	return NewPaymentAllocationClient(_m.config).QueryPayment(_m)
+}
+
+// QueryCreditNote queries the "credit_note" edge of the PaymentAllocation entity.
+func (_m *PaymentAllocation) QueryCreditNote() *InvoiceQuery {
+	return NewPaymentAllocationClient(_m.config).QueryCreditNote(_m)
+}
+
+// Update returns a builder for updating this PaymentAllocation.
+// Note that you need to call PaymentAllocation.Unwrap() before calling this method if this PaymentAllocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentAllocation) Update() *PaymentAllocationUpdateOne {
+	return NewPaymentAllocationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentAllocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentAllocation) Unwrap() *PaymentAllocation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentAllocation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentAllocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentAllocation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("fx_difference=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FxDifference))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentAllocations is a parsable slice of PaymentAllocation.
+type PaymentAllocations []*PaymentAllocation
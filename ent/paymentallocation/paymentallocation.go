@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentallocation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the paymentallocation type in the database.
+	Label = "payment_allocation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldFxDifference holds the string denoting the fx_difference field in the database.
+	FieldFxDifference = "fx_difference"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+	// EdgePayment holds the string denoting the payment edge name in mutations.
+	EdgePayment = "payment"
+	// EdgeCreditNote holds the string denoting the credit_note edge name in mutations.
+	EdgeCreditNote = "credit_note"
+	// Table holds the table name of the paymentallocation in the database.
+	Table = "payment_allocations"
+	// InvoiceTable is the table that holds the invoice relation/edge.
+	InvoiceTable = "payment_allocations"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "invoice_allocations"
+	// PaymentTable is the table that holds the payment relation/edge.
+	PaymentTable = "payment_allocations"
+	// PaymentInverseTable is the table name for the CustomerPayment entity.
+	// It exists in this package in order to avoid circular dependency with the "customerpayment" package.
+	PaymentInverseTable = "customer_payments"
+	// PaymentColumn is the table column denoting the payment relation/edge.
+	PaymentColumn = "customer_payment_allocations"
+	// CreditNoteTable is the table that holds the credit_note relation/edge.
+	CreditNoteTable = "payment_allocations"
+	// CreditNoteInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	CreditNoteInverseTable = "invoices"
+	// CreditNoteColumn is the table column denoting the credit_note relation/edge.
+	CreditNoteColumn = "invoice_credit_applications"
+)
+
+// Columns holds all SQL columns for paymentallocation fields.
+var Columns = []string{
+	FieldID,
+	FieldAmount,
+	FieldFxDifference,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "payment_allocations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"customer_payment_allocations",
+	"invoice_allocations",
+	"invoice_credit_applications",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAmount holds the default value on creation for the "amount" field.
+	DefaultAmount decimal.Decimal
+	// DefaultFxDifference holds the default value on creation for the "fx_difference" field.
+	DefaultFxDifference decimal.Decimal
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PaymentAllocation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByFxDifference orders the results by the fx_difference field.
+func ByFxDifference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxDifference, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByInvoiceField orders the results by invoice field.
+func ByInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoiceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentField orders the results by payment field.
+func ByPaymentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCreditNoteField orders the results by credit_note field.
+func ByCreditNoteField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreditNoteStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newInvoiceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoiceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+	)
+}
+func newPaymentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PaymentTable, PaymentColumn),
+	)
+}
+func newCreditNoteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreditNoteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CreditNoteTable, CreditNoteColumn),
+	)
+}
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentallocation
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldLTE(FieldID, id))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldEQ(FieldAmount, v))
+}
+
+// FxDifference applies equality check predicate on the "fx_difference" field. It's identical to FxDifferenceEQ.
+func FxDifference(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldEQ(FieldFxDifference, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldLTE(FieldAmount, v))
+}
+
+// FxDifferenceEQ applies the EQ predicate on the "fx_difference" field.
+func FxDifferenceEQ(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldEQ(FieldFxDifference, v))
+}
+
+// FxDifferenceNEQ applies the NEQ predicate on the "fx_difference" field.
+func FxDifferenceNEQ(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldNEQ(FieldFxDifference, v))
+}
+
+// FxDifferenceIn applies the In predicate on the "fx_difference" field.
+func FxDifferenceIn(vs ...decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldIn(FieldFxDifference, vs...))
+}
+
+// FxDifferenceNotIn applies the NotIn predicate on the "fx_difference" field.
+func FxDifferenceNotIn(vs ...decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldNotIn(FieldFxDifference, vs...))
+}
+
+// FxDifferenceGT applies the GT predicate on the "fx_difference" field.
+func FxDifferenceGT(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldGT(FieldFxDifference, v))
+}
+
+// FxDifferenceGTE applies the GTE predicate on the "fx_difference" field.
+func FxDifferenceGTE(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldGTE(FieldFxDifference, v))
+}
+
+// FxDifferenceLT applies the LT predicate on the "fx_difference" field.
+func FxDifferenceLT(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldLT(FieldFxDifference, v))
+}
+
+// FxDifferenceLTE applies the LTE predicate on the "fx_difference" field.
+func FxDifferenceLTE(v decimal.Decimal) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldLTE(FieldFxDifference, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(func(s *sql.Selector) {
+		step := newInvoiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPayment applies the HasEdge predicate on the "payment" edge.
+func HasPayment() predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PaymentTable, PaymentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentWith applies the HasEdge predicate on the "payment" edge with a given conditions (other predicates).
+func HasPaymentWith(preds ...predicate.CustomerPayment) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(func(s *sql.Selector) {
+		step := newPaymentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreditNote applies the HasEdge predicate on the "credit_note" edge.
+func HasCreditNote() predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreditNoteTable, CreditNoteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditNoteWith applies the HasEdge predicate on the "credit_note" edge with a given conditions (other predicates).
+func HasCreditNoteWith(preds ...predicate.Invoice) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(func(s *sql.Selector) {
+		step := newCreditNoteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentAllocation) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentAllocation) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentAllocation) predicate.PaymentAllocation {
+	return predicate.PaymentAllocation(sql.NotPredicates(p))
+}
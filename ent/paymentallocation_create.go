@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/customerpayment"
+	"sent/ent/invoice"
+	"sent/ent/paymentallocation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentAllocationCreate is the builder for creating a PaymentAllocation entity.
+type PaymentAllocationCreate struct {
+	config
+	mutation *PaymentAllocationMutation
+	hooks    []Hook
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PaymentAllocationCreate) SetAmount(v decimal.Decimal) *PaymentAllocationCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *PaymentAllocationCreate) SetNillableAmount(v *decimal.Decimal) *PaymentAllocationCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetFxDifference sets the "fx_difference" field.
+func (_c *PaymentAllocationCreate) SetFxDifference(v decimal.Decimal) *PaymentAllocationCreate {
+	_c.mutation.SetFxDifference(v)
+	return _c
+}
+
+// SetNillableFxDifference sets the "fx_difference" field if the given value is not nil.
+func (_c *PaymentAllocationCreate) SetNillableFxDifference(v *decimal.Decimal) *PaymentAllocationCreate {
+	if v != nil {
+		_c.SetFxDifference(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentAllocationCreate) SetCreatedAt(v time.Time) *PaymentAllocationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaymentAllocationCreate) SetNillableCreatedAt(v *time.Time) *PaymentAllocationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_c *PaymentAllocationCreate) SetInvoiceID(id int) *PaymentAllocationCreate {
+	_c.mutation.SetInvoiceID(id)
+	return _c
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *PaymentAllocationCreate) SetInvoice(v *Invoice) *PaymentAllocationCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// SetPaymentID sets the "payment" edge to the CustomerPayment entity by ID.
+func (_c *PaymentAllocationCreate) SetPaymentID(id int) *PaymentAllocationCreate {
+	_c.mutation.SetPaymentID(id)
+	return _c
+}
+
+// SetNillablePaymentID sets the "payment" edge to the CustomerPayment entity by ID if the given value is not nil.
+func (_c *PaymentAllocationCreate) SetNillablePaymentID(id *int) *PaymentAllocationCreate {
+	if id != nil {
+		_c = _c.SetPaymentID(*id)
+	}
+	return _c
+}
+
+// SetPayment sets the "payment" edge to the CustomerPayment entity.
+func (_c *PaymentAllocationCreate) SetPayment(v *CustomerPayment) *PaymentAllocationCreate {
+	return _c.SetPaymentID(v.ID)
+}
+
+// SetCreditNoteID sets the "credit_note" edge to the Invoice entity by ID.
+func (_c *PaymentAllocationCreate) SetCreditNoteID(id int) *PaymentAllocationCreate {
+	_c.mutation.SetCreditNoteID(id)
+	return _c
+}
+
+// SetNillableCreditNoteID sets the "credit_note" edge to the Invoice entity by ID if the given value is not nil.
+func (_c *PaymentAllocationCreate) SetNillableCreditNoteID(id *int) *PaymentAllocationCreate {
+	if id != nil {
+		_c = _c.SetCreditNoteID(*id)
+	}
+	return _c
+}
+
+// SetCreditNote sets the "credit_note" edge to the Invoice entity.
+func (_c *PaymentAllocationCreate) SetCreditNote(v *Invoice) *PaymentAllocationCreate {
+	return _c.SetCreditNoteID(v.ID)
+}
+
+// Mutation returns the PaymentAllocationMutation object of the builder.
+func (_c *PaymentAllocationCreate) Mutation() *PaymentAllocationMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentAllocation in the database.
+func (_c *PaymentAllocationCreate) Save(ctx context.Context) (*PaymentAllocation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentAllocationCreate) SaveX(ctx context.Context) *PaymentAllocation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentAllocationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentAllocationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentAllocationCreate) defaults() {
+	if _, ok := _c.mutation.Amount(); !ok {
+		v := paymentallocation.DefaultAmount
+		_c.mutation.SetAmount(v)
+	}
+	if _, ok := _c.mutation.FxDifference(); !ok {
+		v := paymentallocation.DefaultFxDifference
+		_c.mutation.SetFxDifference(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paymentallocation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentAllocationCreate) check() error {
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PaymentAllocation.amount"`)}
+	}
+	if _, ok := _c.mutation.FxDifference(); !ok {
+		return &ValidationError{Name: "fx_difference", err: errors.New(`ent: missing required field "PaymentAllocation.fx_difference"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentAllocation.created_at"`)}
+	}
+	if len(_c.mutation.InvoiceIDs()) == 0 {
+		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "PaymentAllocation.invoice"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentAllocationCreate) sqlSave(ctx context.Context) (*PaymentAllocation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentAllocationCreate) createSpec() (*PaymentAllocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentAllocation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentallocation.Table, sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(paymentallocation.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.FxDifference(); ok {
+		_spec.SetField(paymentallocation.FieldFxDifference, field.TypeOther, value)
+		_node.FxDifference = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentallocation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.InvoiceTable,
+			Columns: []string{paymentallocation.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_allocations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.PaymentTable,
+			Columns: []string{paymentallocation.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.customer_payment_allocations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreditNoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.CreditNoteTable,
+			Columns: []string{paymentallocation.CreditNoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_credit_applications = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaymentAllocationCreateBulk is the builder for creating many PaymentAllocation entities in bulk.
+type PaymentAllocationCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentAllocationCreate
+}
+
+// Save creates the PaymentAllocation entities in the database.
+func (_c *PaymentAllocationCreateBulk) Save(ctx context.Context) ([]*PaymentAllocation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentAllocation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentAllocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentAllocationCreateBulk) SaveX(ctx context.Context) []*PaymentAllocation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentAllocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentAllocationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
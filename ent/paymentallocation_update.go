@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/customerpayment"
+	"sent/ent/invoice"
+	"sent/ent/paymentallocation"
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentAllocationUpdate is the builder for updating PaymentAllocation entities.
+type PaymentAllocationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PaymentAllocationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PaymentAllocationUpdate builder.
+func (_u *PaymentAllocationUpdate) Where(ps ...predicate.PaymentAllocation) *PaymentAllocationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentAllocationUpdate) SetAmount(v decimal.Decimal) *PaymentAllocationUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentAllocationUpdate) SetNillableAmount(v *decimal.Decimal) *PaymentAllocationUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetFxDifference sets the "fx_difference" field.
+func (_u *PaymentAllocationUpdate) SetFxDifference(v decimal.Decimal) *PaymentAllocationUpdate {
+	_u.mutation.SetFxDifference(v)
+	return _u
+}
+
+// SetNillableFxDifference sets the "fx_difference" field if the given value is not nil.
+func (_u *PaymentAllocationUpdate) SetNillableFxDifference(v *decimal.Decimal) *PaymentAllocationUpdate {
+	if v != nil {
+		_u.SetFxDifference(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *PaymentAllocationUpdate) SetInvoiceID(id int) *PaymentAllocationUpdate {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *PaymentAllocationUpdate) SetInvoice(v *Invoice) *PaymentAllocationUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetPaymentID sets the "payment" edge to the CustomerPayment entity by ID.
+func (_u *PaymentAllocationUpdate) SetPaymentID(id int) *PaymentAllocationUpdate {
+	_u.mutation.SetPaymentID(id)
+	return _u
+}
+
+// SetNillablePaymentID sets the "payment" edge to the CustomerPayment entity by ID if the given value is not nil.
+func (_u *PaymentAllocationUpdate) SetNillablePaymentID(id *int) *PaymentAllocationUpdate {
+	if id != nil {
+		_u = _u.SetPaymentID(*id)
+	}
+	return _u
+}
+
+// SetPayment sets the "payment" edge to the CustomerPayment entity.
+func (_u *PaymentAllocationUpdate) SetPayment(v *CustomerPayment) *PaymentAllocationUpdate {
+	return _u.SetPaymentID(v.ID)
+}
+
+// SetCreditNoteID sets the "credit_note" edge to the Invoice entity by ID.
+func (_u *PaymentAllocationUpdate) SetCreditNoteID(id int) *PaymentAllocationUpdate {
+	_u.mutation.SetCreditNoteID(id)
+	return _u
+}
+
+// SetNillableCreditNoteID sets the "credit_note" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *PaymentAllocationUpdate) SetNillableCreditNoteID(id *int) *PaymentAllocationUpdate {
+	if id != nil {
+		_u = _u.SetCreditNoteID(*id)
+	}
+	return _u
+}
+
+// SetCreditNote sets the "credit_note" edge to the Invoice entity.
+func (_u *PaymentAllocationUpdate) SetCreditNote(v *Invoice) *PaymentAllocationUpdate {
+	return _u.SetCreditNoteID(v.ID)
+}
+
+// Mutation returns the PaymentAllocationMutation object of the builder.
+func (_u *PaymentAllocationUpdate) Mutation() *PaymentAllocationMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *PaymentAllocationUpdate) ClearInvoice() *PaymentAllocationUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearPayment clears the "payment" edge to the CustomerPayment entity.
+func (_u *PaymentAllocationUpdate) ClearPayment() *PaymentAllocationUpdate {
+	_u.mutation.ClearPayment()
+	return _u
+}
+
+// ClearCreditNote clears the "credit_note" edge to the Invoice entity.
+func (_u *PaymentAllocationUpdate) ClearCreditNote() *PaymentAllocationUpdate {
+	_u.mutation.ClearCreditNote()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentAllocationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentAllocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentAllocationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentAllocationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentAllocationUpdate) check() error {
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentAllocation.invoice"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PaymentAllocationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PaymentAllocationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PaymentAllocationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentallocation.Table, paymentallocation.Columns, sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentallocation.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FxDifference(); ok {
+		_spec.SetField(paymentallocation.FieldFxDifference, field.TypeOther, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.InvoiceTable,
+			Columns: []string{paymentallocation.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.InvoiceTable,
+			Columns: []string{paymentallocation.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.PaymentTable,
+			Columns: []string{paymentallocation.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.PaymentTable,
+			Columns: []string{paymentallocation.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreditNoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.CreditNoteTable,
+			Columns: []string{paymentallocation.CreditNoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreditNoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.CreditNoteTable,
+			Columns: []string{paymentallocation.CreditNoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentAllocationUpdateOne is the builder for updating a single PaymentAllocation entity.
+type PaymentAllocationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PaymentAllocationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentAllocationUpdateOne) SetAmount(v decimal.Decimal) *PaymentAllocationUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentAllocationUpdateOne) SetNillableAmount(v *decimal.Decimal) *PaymentAllocationUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetFxDifference sets the "fx_difference" field.
+func (_u *PaymentAllocationUpdateOne) SetFxDifference(v decimal.Decimal) *PaymentAllocationUpdateOne {
+	_u.mutation.SetFxDifference(v)
+	return _u
+}
+
+// SetNillableFxDifference sets the "fx_difference" field if the given value is not nil.
+func (_u *PaymentAllocationUpdateOne) SetNillableFxDifference(v *decimal.Decimal) *PaymentAllocationUpdateOne {
+	if v != nil {
+		_u.SetFxDifference(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *PaymentAllocationUpdateOne) SetInvoiceID(id int) *PaymentAllocationUpdateOne {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *PaymentAllocationUpdateOne) SetInvoice(v *Invoice) *PaymentAllocationUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetPaymentID sets the "payment" edge to the CustomerPayment entity by ID.
+func (_u *PaymentAllocationUpdateOne) SetPaymentID(id int) *PaymentAllocationUpdateOne {
+	_u.mutation.SetPaymentID(id)
+	return _u
+}
+
+// SetNillablePaymentID sets the "payment" edge to the CustomerPayment entity by ID if the given value is not nil.
+func (_u *PaymentAllocationUpdateOne) SetNillablePaymentID(id *int) *PaymentAllocationUpdateOne {
+	if id != nil {
+		_u = _u.SetPaymentID(*id)
+	}
+	return _u
+}
+
+// SetPayment sets the "payment" edge to the CustomerPayment entity.
+func (_u *PaymentAllocationUpdateOne) SetPayment(v *CustomerPayment) *PaymentAllocationUpdateOne {
+	return _u.SetPaymentID(v.ID)
+}
+
+// SetCreditNoteID sets the "credit_note" edge to the Invoice entity by ID.
+func (_u *PaymentAllocationUpdateOne) SetCreditNoteID(id int) *PaymentAllocationUpdateOne {
+	_u.mutation.SetCreditNoteID(id)
+	return _u
+}
+
+// SetNillableCreditNoteID sets the "credit_note" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *PaymentAllocationUpdateOne) SetNillableCreditNoteID(id *int) *PaymentAllocationUpdateOne {
+	if id != nil {
+		_u = _u.SetCreditNoteID(*id)
+	}
+	return _u
+}
+
+// SetCreditNote sets the "credit_note" edge to the Invoice entity.
+func (_u *PaymentAllocationUpdateOne) SetCreditNote(v *Invoice) *PaymentAllocationUpdateOne {
+	return _u.SetCreditNoteID(v.ID)
+}
+
+// Mutation returns the PaymentAllocationMutation object of the builder.
+func (_u *PaymentAllocationUpdateOne) Mutation() *PaymentAllocationMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *PaymentAllocationUpdateOne) ClearInvoice() *PaymentAllocationUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearPayment clears the "payment" edge to the CustomerPayment entity.
+func (_u *PaymentAllocationUpdateOne) ClearPayment() *PaymentAllocationUpdateOne {
+	_u.mutation.ClearPayment()
+	return _u
+}
+
+// ClearCreditNote clears the "credit_note" edge to the Invoice entity.
+func (_u *PaymentAllocationUpdateOne) ClearCreditNote() *PaymentAllocationUpdateOne {
+	_u.mutation.ClearCreditNote()
+	return _u
+}
+
+// Where appends a list predicates to the PaymentAllocationUpdate builder.
+func (_u *PaymentAllocationUpdateOne) Where(ps ...predicate.PaymentAllocation) *PaymentAllocationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentAllocationUpdateOne) Select(field string, fields ...string) *PaymentAllocationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentAllocation entity.
+func (_u *PaymentAllocationUpdateOne) Save(ctx context.Context) (*PaymentAllocation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentAllocationUpdateOne) SaveX(ctx context.Context) *PaymentAllocation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentAllocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentAllocationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentAllocationUpdateOne) check() error {
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentAllocation.invoice"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PaymentAllocationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PaymentAllocationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PaymentAllocationUpdateOne) sqlSave(ctx context.Context) (_node *PaymentAllocation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentallocation.Table, paymentallocation.Columns, sqlgraph.NewFieldSpec(paymentallocation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentAllocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentallocation.FieldID)
+		for _, f := range fields {
+			if !paymentallocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentallocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentallocation.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FxDifference(); ok {
+		_spec.SetField(paymentallocation.FieldFxDifference, field.TypeOther, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.InvoiceTable,
+			Columns: []string{paymentallocation.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.InvoiceTable,
+			Columns: []string{paymentallocation.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.PaymentTable,
+			Columns: []string{paymentallocation.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.PaymentTable,
+			Columns: []string{paymentallocation.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreditNoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.CreditNoteTable,
+			Columns: []string{paymentallocation.CreditNoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreditNoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentallocation.CreditNoteTable,
+			Columns: []string{paymentallocation.CreditNoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PaymentAllocation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
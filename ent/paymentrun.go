@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/paymentrun"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRun is the model entity for the PaymentRun schema.
+type PaymentRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// Status holds the value of the "status" field.
+	Status paymentrun.Status `json:"status,omitempty"`
+	// PaymentDate holds the value of the "payment_date" field.
+	PaymentDate time.Time `json:"payment_date,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Total holds the value of the "total" field.
+	Total decimal.Decimal `json:"total,omitempty"`
+	// FilePath holds the value of the "file_path" field.
+	FilePath string `json:"file_path,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentRunQuery when eager-loading is set.
+	Edges                    PaymentRunEdges `json:"edges"`
+	payment_run_bank_account *int
+	tenant_payment_runs      *int
+	selectValues             sql.SelectValues
+}
+
+// PaymentRunEdges holds the relations/edges for other nodes in the graph.
+type PaymentRunEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// BankAccount holds the value of the bank_account edge.
+	BankAccount *Account `json:"bank_account,omitempty"`
+	// Lines holds the value of the lines edge.
+	Lines []*PaymentRunLine `json:"lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentRunEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// BankAccountOrErr returns the BankAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentRunEdges) BankAccountOrErr() (*Account, error) {
+	if e.BankAccount != nil {
+		return e.BankAccount, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "bank_account"}
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentRunEdges) LinesOrErr() ([]*PaymentRunLine, error) {
+	if e.loadedTypes[2] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentrun.FieldTotal:
+			values[i] = new(decimal.Decimal)
+		case paymentrun.FieldID:
+			values[i] = new(sql.NullInt64)
+		case paymentrun.FieldNumber, paymentrun.FieldStatus, paymentrun.FieldCurrency, paymentrun.FieldFilePath, paymentrun.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case paymentrun.FieldPaymentDate, paymentrun.FieldCreatedAt, paymentrun.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case paymentrun.ForeignKeys[0]: // payment_run_bank_account
+			values[i] = new(sql.NullInt64)
+		case paymentrun.ForeignKeys[1]: // tenant_payment_runs
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentRun fields.
+func (_m *PaymentRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case paymentrun.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case paymentrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = paymentrun.Status(value.String)
+			}
+		case paymentrun.FieldPaymentDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_date", values[i])
+			} else if value.Valid {
+				_m.PaymentDate = value.Time
+			}
+		case paymentrun.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case paymentrun.FieldTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value != nil {
+				_m.Total = *value
+			}
+		case paymentrun.FieldFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_path", values[i])
+			} else if value.Valid {
+				_m.FilePath = value.String
+			}
+		case paymentrun.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case paymentrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case paymentrun.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case paymentrun.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field payment_run_bank_account", value)
+			} else if value.Valid {
+				_m.payment_run_bank_account = new(int)
+				*_m.payment_run_bank_account = int(value.Int64)
+			}
+		case paymentrun.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_payment_runs", value)
+			} else if value.Valid {
+				_m.tenant_payment_runs = new(int)
+				*_m.tenant_payment_runs = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the PaymentRun entity.
+func (_m *PaymentRun) QueryTenant() *TenantQuery {
+	return NewPaymentRunClient(_m.config).QueryTenant(_m)
+}
+
+// QueryBankAccount queries the "bank_account" edge of the PaymentRun entity.
+func (_m *PaymentRun) QueryBankAccount() *AccountQuery {
+	return NewPaymentRunClient(_m.config).QueryBankAccount(_m)
+}
+
+// QueryLines queries the "lines" edge of the PaymentRun entity.
+func (_m *PaymentRun) QueryLines() *PaymentRunLineQuery {
+	return NewPaymentRunClient(_m.config).QueryLines(_m)
+}
+
+// Update returns a builder for updating this PaymentRun.
+// Note that you need to call PaymentRun.Unwrap() before calling this method if this PaymentRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentRun) Update() *PaymentRunUpdateOne {
+	return NewPaymentRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentRun) Unwrap() *PaymentRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("payment_date=")
+	builder.WriteString(_m.PaymentDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("file_path=")
+	builder.WriteString(_m.FilePath)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentRuns is a parsable slice of PaymentRun.
+type PaymentRuns []*PaymentRun
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/paymentrun"
+	"sent/ent/paymentrunline"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunCreate is the builder for creating a PaymentRun entity.
+type PaymentRunCreate struct {
+	config
+	mutation *PaymentRunMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (_c *PaymentRunCreate) SetNumber(v string) *PaymentRunCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PaymentRunCreate) SetStatus(v paymentrun.Status) *PaymentRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableStatus(v *paymentrun.Status) *PaymentRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_c *PaymentRunCreate) SetPaymentDate(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetPaymentDate(v)
+	return _c
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillablePaymentDate(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetPaymentDate(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PaymentRunCreate) SetCurrency(v string) *PaymentRunCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableCurrency(v *string) *PaymentRunCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *PaymentRunCreate) SetTotal(v decimal.Decimal) *PaymentRunCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableTotal(v *decimal.Decimal) *PaymentRunCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetFilePath sets the "file_path" field.
+func (_c *PaymentRunCreate) SetFilePath(v string) *PaymentRunCreate {
+	_c.mutation.SetFilePath(v)
+	return _c
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableFilePath(v *string) *PaymentRunCreate {
+	if v != nil {
+		_c.SetFilePath(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PaymentRunCreate) SetCreatedBy(v string) *PaymentRunCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableCreatedBy(v *string) *PaymentRunCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentRunCreate) SetCreatedAt(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableCreatedAt(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *PaymentRunCreate) SetCompletedAt(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableCompletedAt(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *PaymentRunCreate) SetTenantID(id int) *PaymentRunCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *PaymentRunCreate) SetTenant(v *Tenant) *PaymentRunCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetBankAccountID sets the "bank_account" edge to the Account entity by ID.
+func (_c *PaymentRunCreate) SetBankAccountID(id int) *PaymentRunCreate {
+	_c.mutation.SetBankAccountID(id)
+	return _c
+}
+
+// SetBankAccount sets the "bank_account" edge to the Account entity.
+func (_c *PaymentRunCreate) SetBankAccount(v *Account) *PaymentRunCreate {
+	return _c.SetBankAccountID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the PaymentRunLine entity by IDs.
+func (_c *PaymentRunCreate) AddLineIDs(ids ...int) *PaymentRunCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the PaymentRunLine entity.
+func (_c *PaymentRunCreate) AddLines(v ...*PaymentRunLine) *PaymentRunCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// Mutation returns the PaymentRunMutation object of the builder.
+func (_c *PaymentRunCreate) Mutation() *PaymentRunMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentRun in the database.
+func (_c *PaymentRunCreate) Save(ctx context.Context) (*PaymentRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentRunCreate) SaveX(ctx context.Context) *PaymentRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentRunCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := paymentrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.PaymentDate(); !ok {
+		v := paymentrun.DefaultPaymentDate()
+		_c.mutation.SetPaymentDate(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := paymentrun.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		v := paymentrun.DefaultTotal
+		_c.mutation.SetTotal(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paymentrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentRunCreate) check() error {
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "PaymentRun.number"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PaymentRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := paymentrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentRun.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaymentDate(); !ok {
+		return &ValidationError{Name: "payment_date", err: errors.New(`ent: missing required field "PaymentRun.payment_date"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PaymentRun.currency"`)}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "PaymentRun.total"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentRun.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "PaymentRun.tenant"`)}
+	}
+	if len(_c.mutation.BankAccountIDs()) == 0 {
+		return &ValidationError{Name: "bank_account", err: errors.New(`ent: missing required edge "PaymentRun.bank_account"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentRunCreate) sqlSave(ctx context.Context) (*PaymentRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentRunCreate) createSpec() (*PaymentRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentrun.Table, sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(paymentrun.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(paymentrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PaymentDate(); ok {
+		_spec.SetField(paymentrun.FieldPaymentDate, field.TypeTime, value)
+		_node.PaymentDate = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(paymentrun.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(paymentrun.FieldTotal, field.TypeOther, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.FilePath(); ok {
+		_spec.SetField(paymentrun.FieldFilePath, field.TypeString, value)
+		_node.FilePath = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentrun.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(paymentrun.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrun.TenantTable,
+			Columns: []string{paymentrun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_payment_runs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BankAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrun.BankAccountTable,
+			Columns: []string{paymentrun.BankAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_run_bank_account = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.LinesTable,
+			Columns: []string{paymentrun.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaymentRunCreateBulk is the builder for creating many PaymentRun entities in bulk.
+type PaymentRunCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentRunCreate
+}
+
+// Save creates the PaymentRun entities in the database.
+func (_c *PaymentRunCreateBulk) Save(ctx context.Context) ([]*PaymentRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentRunCreateBulk) SaveX(ctx context.Context) []*PaymentRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,906 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/paymentrun"
+	"sent/ent/paymentrunline"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunUpdate is the builder for updating PaymentRun entities.
+type PaymentRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PaymentRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PaymentRunUpdate builder.
+func (_u *PaymentRunUpdate) Where(ps ...predicate.PaymentRun) *PaymentRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *PaymentRunUpdate) SetNumber(v string) *PaymentRunUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableNumber(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentRunUpdate) SetStatus(v paymentrun.Status) *PaymentRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableStatus(v *paymentrun.Status) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *PaymentRunUpdate) SetPaymentDate(v time.Time) *PaymentRunUpdate {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillablePaymentDate(v *time.Time) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentRunUpdate) SetCurrency(v string) *PaymentRunUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableCurrency(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *PaymentRunUpdate) SetTotal(v decimal.Decimal) *PaymentRunUpdate {
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableTotal(v *decimal.Decimal) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *PaymentRunUpdate) SetFilePath(v string) *PaymentRunUpdate {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableFilePath(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (_u *PaymentRunUpdate) ClearFilePath() *PaymentRunUpdate {
+	_u.mutation.ClearFilePath()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PaymentRunUpdate) SetCreatedBy(v string) *PaymentRunUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableCreatedBy(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PaymentRunUpdate) ClearCreatedBy() *PaymentRunUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *PaymentRunUpdate) SetCompletedAt(v time.Time) *PaymentRunUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableCompletedAt(v *time.Time) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *PaymentRunUpdate) ClearCompletedAt() *PaymentRunUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *PaymentRunUpdate) SetTenantID(id int) *PaymentRunUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *PaymentRunUpdate) SetTenant(v *Tenant) *PaymentRunUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetBankAccountID sets the "bank_account" edge to the Account entity by ID.
+func (_u *PaymentRunUpdate) SetBankAccountID(id int) *PaymentRunUpdate {
+	_u.mutation.SetBankAccountID(id)
+	return _u
+}
+
+// SetBankAccount sets the "bank_account" edge to the Account entity.
+func (_u *PaymentRunUpdate) SetBankAccount(v *Account) *PaymentRunUpdate {
+	return _u.SetBankAccountID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the PaymentRunLine entity by IDs.
+func (_u *PaymentRunUpdate) AddLineIDs(ids ...int) *PaymentRunUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the PaymentRunLine entity.
+func (_u *PaymentRunUpdate) AddLines(v ...*PaymentRunLine) *PaymentRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// Mutation returns the PaymentRunMutation object of the builder.
+func (_u *PaymentRunUpdate) Mutation() *PaymentRunMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *PaymentRunUpdate) ClearTenant() *PaymentRunUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearBankAccount clears the "bank_account" edge to the Account entity.
+func (_u *PaymentRunUpdate) ClearBankAccount() *PaymentRunUpdate {
+	_u.mutation.ClearBankAccount()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the PaymentRunLine entity.
+func (_u *PaymentRunUpdate) ClearLines() *PaymentRunUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to PaymentRunLine entities by IDs.
+func (_u *PaymentRunUpdate) RemoveLineIDs(ids ...int) *PaymentRunUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to PaymentRunLine entities.
+func (_u *PaymentRunUpdate) RemoveLines(v ...*PaymentRunLine) *PaymentRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRunUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := paymentrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentRun.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRun.tenant"`)
+	}
+	if _u.mutation.BankAccountCleared() && len(_u.mutation.BankAccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRun.bank_account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PaymentRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PaymentRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PaymentRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrun.Table, paymentrun.Columns, sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(paymentrun.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(paymentrun.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(paymentrun.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(paymentrun.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FilePath(); ok {
+		_spec.SetField(paymentrun.FieldFilePath, field.TypeString, value)
+	}
+	if _u.mutation.FilePathCleared() {
+		_spec.ClearField(paymentrun.FieldFilePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentrun.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(paymentrun.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(paymentrun.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(paymentrun.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrun.TenantTable,
+			Columns: []string{paymentrun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrun.TenantTable,
+			Columns: []string{paymentrun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BankAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrun.BankAccountTable,
+			Columns: []string{paymentrun.BankAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BankAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrun.BankAccountTable,
+			Columns: []string{paymentrun.BankAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.LinesTable,
+			Columns: []string{paymentrun.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.LinesTable,
+			Columns: []string{paymentrun.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.LinesTable,
+			Columns: []string{paymentrun.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentRunUpdateOne is the builder for updating a single PaymentRun entity.
+type PaymentRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PaymentRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetNumber sets the "number" field.
+func (_u *PaymentRunUpdateOne) SetNumber(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableNumber(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentRunUpdateOne) SetStatus(v paymentrun.Status) *PaymentRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableStatus(v *paymentrun.Status) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *PaymentRunUpdateOne) SetPaymentDate(v time.Time) *PaymentRunUpdateOne {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillablePaymentDate(v *time.Time) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentRunUpdateOne) SetCurrency(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableCurrency(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *PaymentRunUpdateOne) SetTotal(v decimal.Decimal) *PaymentRunUpdateOne {
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableTotal(v *decimal.Decimal) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *PaymentRunUpdateOne) SetFilePath(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableFilePath(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (_u *PaymentRunUpdateOne) ClearFilePath() *PaymentRunUpdateOne {
+	_u.mutation.ClearFilePath()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PaymentRunUpdateOne) SetCreatedBy(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableCreatedBy(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PaymentRunUpdateOne) ClearCreatedBy() *PaymentRunUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *PaymentRunUpdateOne) SetCompletedAt(v time.Time) *PaymentRunUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableCompletedAt(v *time.Time) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *PaymentRunUpdateOne) ClearCompletedAt() *PaymentRunUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *PaymentRunUpdateOne) SetTenantID(id int) *PaymentRunUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *PaymentRunUpdateOne) SetTenant(v *Tenant) *PaymentRunUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetBankAccountID sets the "bank_account" edge to the Account entity by ID.
+func (_u *PaymentRunUpdateOne) SetBankAccountID(id int) *PaymentRunUpdateOne {
+	_u.mutation.SetBankAccountID(id)
+	return _u
+}
+
+// SetBankAccount sets the "bank_account" edge to the Account entity.
+func (_u *PaymentRunUpdateOne) SetBankAccount(v *Account) *PaymentRunUpdateOne {
+	return _u.SetBankAccountID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the PaymentRunLine entity by IDs.
+func (_u *PaymentRunUpdateOne) AddLineIDs(ids ...int) *PaymentRunUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the PaymentRunLine entity.
+func (_u *PaymentRunUpdateOne) AddLines(v ...*PaymentRunLine) *PaymentRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// Mutation returns the PaymentRunMutation object of the builder.
+func (_u *PaymentRunUpdateOne) Mutation() *PaymentRunMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *PaymentRunUpdateOne) ClearTenant() *PaymentRunUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearBankAccount clears the "bank_account" edge to the Account entity.
+func (_u *PaymentRunUpdateOne) ClearBankAccount() *PaymentRunUpdateOne {
+	_u.mutation.ClearBankAccount()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the PaymentRunLine entity.
+func (_u *PaymentRunUpdateOne) ClearLines() *PaymentRunUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to PaymentRunLine entities by IDs.
+func (_u *PaymentRunUpdateOne) RemoveLineIDs(ids ...int) *PaymentRunUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to PaymentRunLine entities.
+func (_u *PaymentRunUpdateOne) RemoveLines(v ...*PaymentRunLine) *PaymentRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// Where appends a list predicates to the PaymentRunUpdate builder.
+func (_u *PaymentRunUpdateOne) Where(ps ...predicate.PaymentRun) *PaymentRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentRunUpdateOne) Select(field string, fields ...string) *PaymentRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentRun entity.
+func (_u *PaymentRunUpdateOne) Save(ctx context.Context) (*PaymentRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRunUpdateOne) SaveX(ctx context.Context) *PaymentRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRunUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := paymentrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentRun.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRun.tenant"`)
+	}
+	if _u.mutation.BankAccountCleared() && len(_u.mutation.BankAccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRun.bank_account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PaymentRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PaymentRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PaymentRunUpdateOne) sqlSave(ctx context.Context) (_node *PaymentRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrun.Table, paymentrun.Columns, sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentrun.FieldID)
+		for _, f := range fields {
+			if !paymentrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(paymentrun.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(paymentrun.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(paymentrun.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(paymentrun.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FilePath(); ok {
+		_spec.SetField(paymentrun.FieldFilePath, field.TypeString, value)
+	}
+	if _u.mutation.FilePathCleared() {
+		_spec.ClearField(paymentrun.FieldFilePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentrun.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(paymentrun.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(paymentrun.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(paymentrun.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrun.TenantTable,
+			Columns: []string{paymentrun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrun.TenantTable,
+			Columns: []string{paymentrun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BankAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrun.BankAccountTable,
+			Columns: []string{paymentrun.BankAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BankAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrun.BankAccountTable,
+			Columns: []string{paymentrun.BankAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.LinesTable,
+			Columns: []string{paymentrun.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.LinesTable,
+			Columns: []string{paymentrun.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.LinesTable,
+			Columns: []string{paymentrun.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PaymentRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
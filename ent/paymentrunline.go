@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/paymentrun"
+	"sent/ent/paymentrunline"
+	"sent/ent/supplierbill"
+	"sent/ent/transaction"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunLine is the model entity for the PaymentRunLine schema.
+type PaymentRunLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// FxDifference holds the value of the "fx_difference" field.
+	FxDifference decimal.Decimal `json:"fx_difference,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentRunLineQuery when eager-loading is set.
+	Edges                        PaymentRunLineEdges `json:"edges"`
+	payment_run_lines            *int
+	payment_run_line_transaction *int
+	supplier_bill_payment_lines  *int
+	selectValues                 sql.SelectValues
+}
+
+// PaymentRunLineEdges holds the relations/edges for other nodes in the graph.
+type PaymentRunLineEdges struct {
+	// Run holds the value of the run edge.
+	Run *PaymentRun `json:"run,omitempty"`
+	// Bill holds the value of the bill edge.
+	Bill *SupplierBill `json:"bill,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentRunLineEdges) RunOrErr() (*PaymentRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// BillOrErr returns the Bill value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentRunLineEdges) BillOrErr() (*SupplierBill, error) {
+	if e.Bill != nil {
+		return e.Bill, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: supplierbill.Label}
+	}
+	return nil, &NotLoadedError{edge: "bill"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentRunLineEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentRunLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentrunline.FieldAmount, paymentrunline.FieldFxDifference:
+			values[i] = new(decimal.Decimal)
+		case paymentrunline.FieldID:
+			values[i] = new(sql.NullInt64)
+		case paymentrunline.ForeignKeys[0]: // payment_run_lines
+			values[i] = new(sql.NullInt64)
+		case paymentrunline.ForeignKeys[1]: // payment_run_line_transaction
+			values[i] = new(sql.NullInt64)
+		case paymentrunline.ForeignKeys[2]: // supplier_bill_payment_lines
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentRunLine fields.
+func (_m *PaymentRunLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentrunline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case paymentrunline.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case paymentrunline.FieldFxDifference:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_difference", values[i])
+			} else if value != nil {
+				_m.FxDifference = *value
+			}
+		case paymentrunline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field payment_run_lines", value)
+			} else if value.Valid {
+				_m.payment_run_lines = new(int)
+				*_m.payment_run_lines = int(value.Int64)
+			}
+		case paymentrunline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field payment_run_line_transaction", value)
+			} else if value.Valid {
+				_m.payment_run_line_transaction = new(int)
+				*_m.payment_run_line_transaction = int(value.Int64)
+			}
+		case paymentrunline.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field supplier_bill_payment_lines", value)
+			} else if value.Valid {
+				_m.supplier_bill_payment_lines = new(int)
+				*_m.supplier_bill_payment_lines = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentRunLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentRunLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the PaymentRunLine entity.
+func (_m *PaymentRunLine) QueryRun() *PaymentRunQuery {
+	return NewPaymentRunLineClient(_m.config).QueryRun(_m)
+}
+
+// QueryBill queries the "bill" edge of the PaymentRunLine entity.
+func (_m *PaymentRunLine) QueryBill() *SupplierBillQuery {
+	return NewPaymentRunLineClient(_m.config).QueryBill(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the PaymentRunLine entity.
+func (_m *PaymentRunLine) QueryTransaction() *TransactionQuery {
+	return NewPaymentRunLineClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this PaymentRunLine.
+// Note that you need to call PaymentRunLine.Unwrap() before calling this method if this PaymentRunLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentRunLine) Update() *PaymentRunLineUpdateOne {
+	return NewPaymentRunLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentRunLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentRunLine) Unwrap() *PaymentRunLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentRunLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentRunLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentRunLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("fx_difference=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FxDifference))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentRunLines is a parsable slice of PaymentRunLine.
+type PaymentRunLines []*PaymentRunLine
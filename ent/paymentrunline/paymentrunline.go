@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentrunline
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the paymentrunline type in the database.
+	Label = "payment_run_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldFxDifference holds the string denoting the fx_difference field in the database.
+	FieldFxDifference = "fx_difference"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// EdgeBill holds the string denoting the bill edge name in mutations.
+	EdgeBill = "bill"
+	// EdgeTransaction holds the string denoting the transaction edge name in mutations.
+	EdgeTransaction = "transaction"
+	// Table holds the table name of the paymentrunline in the database.
+	Table = "payment_run_lines"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "payment_run_lines"
+	// RunInverseTable is the table name for the PaymentRun entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentrun" package.
+	RunInverseTable = "payment_runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "payment_run_lines"
+	// BillTable is the table that holds the bill relation/edge.
+	BillTable = "payment_run_lines"
+	// BillInverseTable is the table name for the SupplierBill entity.
+	// It exists in this package in order to avoid circular dependency with the "supplierbill" package.
+	BillInverseTable = "supplier_bills"
+	// BillColumn is the table column denoting the bill relation/edge.
+	BillColumn = "supplier_bill_payment_lines"
+	// TransactionTable is the table that holds the transaction relation/edge.
+	TransactionTable = "payment_run_lines"
+	// TransactionInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionInverseTable = "transactions"
+	// TransactionColumn is the table column denoting the transaction relation/edge.
+	TransactionColumn = "payment_run_line_transaction"
+)
+
+// Columns holds all SQL columns for paymentrunline fields.
+var Columns = []string{
+	FieldID,
+	FieldAmount,
+	FieldFxDifference,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "payment_run_lines"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"payment_run_lines",
+	"payment_run_line_transaction",
+	"supplier_bill_payment_lines",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAmount holds the default value on creation for the "amount" field.
+	DefaultAmount decimal.Decimal
+	// DefaultFxDifference holds the default value on creation for the "fx_difference" field.
+	DefaultFxDifference decimal.Decimal
+)
+
+// OrderOption defines the ordering options for the PaymentRunLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByFxDifference orders the results by the fx_difference field.
+func ByFxDifference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxDifference, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBillField orders the results by bill field.
+func ByBillField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransactionField orders the results by transaction field.
+func ByTransactionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
+func newBillStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BillTable, BillColumn),
+	)
+}
+func newTransactionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+	)
+}
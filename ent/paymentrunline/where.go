@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentrunline
+
+import (
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldLTE(FieldID, id))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldEQ(FieldAmount, v))
+}
+
+// FxDifference applies equality check predicate on the "fx_difference" field. It's identical to FxDifferenceEQ.
+func FxDifference(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldEQ(FieldFxDifference, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldLTE(FieldAmount, v))
+}
+
+// FxDifferenceEQ applies the EQ predicate on the "fx_difference" field.
+func FxDifferenceEQ(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldEQ(FieldFxDifference, v))
+}
+
+// FxDifferenceNEQ applies the NEQ predicate on the "fx_difference" field.
+func FxDifferenceNEQ(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldNEQ(FieldFxDifference, v))
+}
+
+// FxDifferenceIn applies the In predicate on the "fx_difference" field.
+func FxDifferenceIn(vs ...decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldIn(FieldFxDifference, vs...))
+}
+
+// FxDifferenceNotIn applies the NotIn predicate on the "fx_difference" field.
+func FxDifferenceNotIn(vs ...decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldNotIn(FieldFxDifference, vs...))
+}
+
+// FxDifferenceGT applies the GT predicate on the "fx_difference" field.
+func FxDifferenceGT(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldGT(FieldFxDifference, v))
+}
+
+// FxDifferenceGTE applies the GTE predicate on the "fx_difference" field.
+func FxDifferenceGTE(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldGTE(FieldFxDifference, v))
+}
+
+// FxDifferenceLT applies the LT predicate on the "fx_difference" field.
+func FxDifferenceLT(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldLT(FieldFxDifference, v))
+}
+
+// FxDifferenceLTE applies the LTE predicate on the "fx_difference" field.
+func FxDifferenceLTE(v decimal.Decimal) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.FieldLTE(FieldFxDifference, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.PaymentRun) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBill applies the HasEdge predicate on the "bill" edge.
+func HasBill() predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BillTable, BillColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillWith applies the HasEdge predicate on the "bill" edge with a given conditions (other predicates).
+func HasBillWith(preds ...predicate.SupplierBill) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(func(s *sql.Selector) {
+		step := newBillStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentRunLine) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentRunLine) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentRunLine) predicate.PaymentRunLine {
+	return predicate.PaymentRunLine(sql.NotPredicates(p))
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/paymentrun"
+	"sent/ent/paymentrunline"
+	"sent/ent/supplierbill"
+	"sent/ent/transaction"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunLineCreate is the builder for creating a PaymentRunLine entity.
+type PaymentRunLineCreate struct {
+	config
+	mutation *PaymentRunLineMutation
+	hooks    []Hook
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PaymentRunLineCreate) SetAmount(v decimal.Decimal) *PaymentRunLineCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *PaymentRunLineCreate) SetNillableAmount(v *decimal.Decimal) *PaymentRunLineCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetFxDifference sets the "fx_difference" field.
+func (_c *PaymentRunLineCreate) SetFxDifference(v decimal.Decimal) *PaymentRunLineCreate {
+	_c.mutation.SetFxDifference(v)
+	return _c
+}
+
+// SetNillableFxDifference sets the "fx_difference" field if the given value is not nil.
+func (_c *PaymentRunLineCreate) SetNillableFxDifference(v *decimal.Decimal) *PaymentRunLineCreate {
+	if v != nil {
+		_c.SetFxDifference(*v)
+	}
+	return _c
+}
+
+// SetRunID sets the "run" edge to the PaymentRun entity by ID.
+func (_c *PaymentRunLineCreate) SetRunID(id int) *PaymentRunLineCreate {
+	_c.mutation.SetRunID(id)
+	return _c
+}
+
+// SetRun sets the "run" edge to the PaymentRun entity.
+func (_c *PaymentRunLineCreate) SetRun(v *PaymentRun) *PaymentRunLineCreate {
+	return _c.SetRunID(v.ID)
+}
+
+// SetBillID sets the "bill" edge to the SupplierBill entity by ID.
+func (_c *PaymentRunLineCreate) SetBillID(id int) *PaymentRunLineCreate {
+	_c.mutation.SetBillID(id)
+	return _c
+}
+
+// SetBill sets the "bill" edge to the SupplierBill entity.
+func (_c *PaymentRunLineCreate) SetBill(v *SupplierBill) *PaymentRunLineCreate {
+	return _c.SetBillID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *PaymentRunLineCreate) SetTransactionID(id int) *PaymentRunLineCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_c *PaymentRunLineCreate) SetNillableTransactionID(id *int) *PaymentRunLineCreate {
+	if id != nil {
+		_c = _c.SetTransactionID(*id)
+	}
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *PaymentRunLineCreate) SetTransaction(v *Transaction) *PaymentRunLineCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// Mutation returns the PaymentRunLineMutation object of the builder.
+func (_c *PaymentRunLineCreate) Mutation() *PaymentRunLineMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentRunLine in the database.
+func (_c *PaymentRunLineCreate) Save(ctx context.Context) (*PaymentRunLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentRunLineCreate) SaveX(ctx context.Context) *PaymentRunLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRunLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRunLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentRunLineCreate) defaults() {
+	if _, ok := _c.mutation.Amount(); !ok {
+		v := paymentrunline.DefaultAmount
+		_c.mutation.SetAmount(v)
+	}
+	if _, ok := _c.mutation.FxDifference(); !ok {
+		v := paymentrunline.DefaultFxDifference
+		_c.mutation.SetFxDifference(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentRunLineCreate) check() error {
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PaymentRunLine.amount"`)}
+	}
+	if _, ok := _c.mutation.FxDifference(); !ok {
+		return &ValidationError{Name: "fx_difference", err: errors.New(`ent: missing required field "PaymentRunLine.fx_difference"`)}
+	}
+	if len(_c.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "PaymentRunLine.run"`)}
+	}
+	if len(_c.mutation.BillIDs()) == 0 {
+		return &ValidationError{Name: "bill", err: errors.New(`ent: missing required edge "PaymentRunLine.bill"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentRunLineCreate) sqlSave(ctx context.Context) (*PaymentRunLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentRunLineCreate) createSpec() (*PaymentRunLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentRunLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentrunline.Table, sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(paymentrunline.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.FxDifference(); ok {
+		_spec.SetField(paymentrunline.FieldFxDifference, field.TypeOther, value)
+		_node.FxDifference = value
+	}
+	if nodes := _c.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.RunTable,
+			Columns: []string{paymentrunline.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_run_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.BillTable,
+			Columns: []string{paymentrunline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.supplier_bill_payment_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrunline.TransactionTable,
+			Columns: []string{paymentrunline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_run_line_transaction = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaymentRunLineCreateBulk is the builder for creating many PaymentRunLine entities in bulk.
+type PaymentRunLineCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentRunLineCreate
+}
+
+// Save creates the PaymentRunLine entities in the database.
+func (_c *PaymentRunLineCreateBulk) Save(ctx context.Context) ([]*PaymentRunLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentRunLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentRunLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentRunLineCreateBulk) SaveX(ctx context.Context) []*PaymentRunLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRunLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRunLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
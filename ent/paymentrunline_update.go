@@ -0,0 +1,583 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/paymentrun"
+	"sent/ent/paymentrunline"
+	"sent/ent/predicate"
+	"sent/ent/supplierbill"
+	"sent/ent/transaction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunLineUpdate is the builder for updating PaymentRunLine entities.
+type PaymentRunLineUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PaymentRunLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PaymentRunLineUpdate builder.
+func (_u *PaymentRunLineUpdate) Where(ps ...predicate.PaymentRunLine) *PaymentRunLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentRunLineUpdate) SetAmount(v decimal.Decimal) *PaymentRunLineUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentRunLineUpdate) SetNillableAmount(v *decimal.Decimal) *PaymentRunLineUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetFxDifference sets the "fx_difference" field.
+func (_u *PaymentRunLineUpdate) SetFxDifference(v decimal.Decimal) *PaymentRunLineUpdate {
+	_u.mutation.SetFxDifference(v)
+	return _u
+}
+
+// SetNillableFxDifference sets the "fx_difference" field if the given value is not nil.
+func (_u *PaymentRunLineUpdate) SetNillableFxDifference(v *decimal.Decimal) *PaymentRunLineUpdate {
+	if v != nil {
+		_u.SetFxDifference(*v)
+	}
+	return _u
+}
+
+// SetRunID sets the "run" edge to the PaymentRun entity by ID.
+func (_u *PaymentRunLineUpdate) SetRunID(id int) *PaymentRunLineUpdate {
+	_u.mutation.SetRunID(id)
+	return _u
+}
+
+// SetRun sets the "run" edge to the PaymentRun entity.
+func (_u *PaymentRunLineUpdate) SetRun(v *PaymentRun) *PaymentRunLineUpdate {
+	return _u.SetRunID(v.ID)
+}
+
+// SetBillID sets the "bill" edge to the SupplierBill entity by ID.
+func (_u *PaymentRunLineUpdate) SetBillID(id int) *PaymentRunLineUpdate {
+	_u.mutation.SetBillID(id)
+	return _u
+}
+
+// SetBill sets the "bill" edge to the SupplierBill entity.
+func (_u *PaymentRunLineUpdate) SetBill(v *SupplierBill) *PaymentRunLineUpdate {
+	return _u.SetBillID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *PaymentRunLineUpdate) SetTransactionID(id int) *PaymentRunLineUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *PaymentRunLineUpdate) SetNillableTransactionID(id *int) *PaymentRunLineUpdate {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *PaymentRunLineUpdate) SetTransaction(v *Transaction) *PaymentRunLineUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the PaymentRunLineMutation object of the builder.
+func (_u *PaymentRunLineUpdate) Mutation() *PaymentRunLineMutation {
+	return _u.mutation
+}
+
+// ClearRun clears the "run" edge to the PaymentRun entity.
+func (_u *PaymentRunLineUpdate) ClearRun() *PaymentRunLineUpdate {
+	_u.mutation.ClearRun()
+	return _u
+}
+
+// ClearBill clears the "bill" edge to the SupplierBill entity.
+func (_u *PaymentRunLineUpdate) ClearBill() *PaymentRunLineUpdate {
+	_u.mutation.ClearBill()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *PaymentRunLineUpdate) ClearTransaction() *PaymentRunLineUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentRunLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRunLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentRunLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRunLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRunLineUpdate) check() error {
+	if _u.mutation.RunCleared() && len(_u.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRunLine.run"`)
+	}
+	if _u.mutation.BillCleared() && len(_u.mutation.BillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRunLine.bill"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PaymentRunLineUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PaymentRunLineUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PaymentRunLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrunline.Table, paymentrunline.Columns, sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentrunline.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FxDifference(); ok {
+		_spec.SetField(paymentrunline.FieldFxDifference, field.TypeOther, value)
+	}
+	if _u.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.RunTable,
+			Columns: []string{paymentrunline.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.RunTable,
+			Columns: []string{paymentrunline.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.BillTable,
+			Columns: []string{paymentrunline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.BillTable,
+			Columns: []string{paymentrunline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrunline.TransactionTable,
+			Columns: []string{paymentrunline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrunline.TransactionTable,
+			Columns: []string{paymentrunline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrunline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentRunLineUpdateOne is the builder for updating a single PaymentRunLine entity.
+type PaymentRunLineUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PaymentRunLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentRunLineUpdateOne) SetAmount(v decimal.Decimal) *PaymentRunLineUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentRunLineUpdateOne) SetNillableAmount(v *decimal.Decimal) *PaymentRunLineUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetFxDifference sets the "fx_difference" field.
+func (_u *PaymentRunLineUpdateOne) SetFxDifference(v decimal.Decimal) *PaymentRunLineUpdateOne {
+	_u.mutation.SetFxDifference(v)
+	return _u
+}
+
+// SetNillableFxDifference sets the "fx_difference" field if the given value is not nil.
+func (_u *PaymentRunLineUpdateOne) SetNillableFxDifference(v *decimal.Decimal) *PaymentRunLineUpdateOne {
+	if v != nil {
+		_u.SetFxDifference(*v)
+	}
+	return _u
+}
+
+// SetRunID sets the "run" edge to the PaymentRun entity by ID.
+func (_u *PaymentRunLineUpdateOne) SetRunID(id int) *PaymentRunLineUpdateOne {
+	_u.mutation.SetRunID(id)
+	return _u
+}
+
+// SetRun sets the "run" edge to the PaymentRun entity.
+func (_u *PaymentRunLineUpdateOne) SetRun(v *PaymentRun) *PaymentRunLineUpdateOne {
+	return _u.SetRunID(v.ID)
+}
+
+// SetBillID sets the "bill" edge to the SupplierBill entity by ID.
+func (_u *PaymentRunLineUpdateOne) SetBillID(id int) *PaymentRunLineUpdateOne {
+	_u.mutation.SetBillID(id)
+	return _u
+}
+
+// SetBill sets the "bill" edge to the SupplierBill entity.
+func (_u *PaymentRunLineUpdateOne) SetBill(v *SupplierBill) *PaymentRunLineUpdateOne {
+	return _u.SetBillID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *PaymentRunLineUpdateOne) SetTransactionID(id int) *PaymentRunLineUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *PaymentRunLineUpdateOne) SetNillableTransactionID(id *int) *PaymentRunLineUpdateOne {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *PaymentRunLineUpdateOne) SetTransaction(v *Transaction) *PaymentRunLineUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the PaymentRunLineMutation object of the builder.
+func (_u *PaymentRunLineUpdateOne) Mutation() *PaymentRunLineMutation {
+	return _u.mutation
+}
+
+// ClearRun clears the "run" edge to the PaymentRun entity.
+func (_u *PaymentRunLineUpdateOne) ClearRun() *PaymentRunLineUpdateOne {
+	_u.mutation.ClearRun()
+	return _u
+}
+
+// ClearBill clears the "bill" edge to the SupplierBill entity.
+func (_u *PaymentRunLineUpdateOne) ClearBill() *PaymentRunLineUpdateOne {
+	_u.mutation.ClearBill()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *PaymentRunLineUpdateOne) ClearTransaction() *PaymentRunLineUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Where appends a list predicates to the PaymentRunLineUpdate builder.
+func (_u *PaymentRunLineUpdateOne) Where(ps ...predicate.PaymentRunLine) *PaymentRunLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentRunLineUpdateOne) Select(field string, fields ...string) *PaymentRunLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentRunLine entity.
+func (_u *PaymentRunLineUpdateOne) Save(ctx context.Context) (*PaymentRunLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRunLineUpdateOne) SaveX(ctx context.Context) *PaymentRunLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentRunLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRunLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRunLineUpdateOne) check() error {
+	if _u.mutation.RunCleared() && len(_u.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRunLine.run"`)
+	}
+	if _u.mutation.BillCleared() && len(_u.mutation.BillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRunLine.bill"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PaymentRunLineUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PaymentRunLineUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PaymentRunLineUpdateOne) sqlSave(ctx context.Context) (_node *PaymentRunLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrunline.Table, paymentrunline.Columns, sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentRunLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentrunline.FieldID)
+		for _, f := range fields {
+			if !paymentrunline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentrunline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentrunline.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FxDifference(); ok {
+		_spec.SetField(paymentrunline.FieldFxDifference, field.TypeOther, value)
+	}
+	if _u.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.RunTable,
+			Columns: []string{paymentrunline.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.RunTable,
+			Columns: []string{paymentrunline.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.BillTable,
+			Columns: []string{paymentrunline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunline.BillTable,
+			Columns: []string{paymentrunline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrunline.TransactionTable,
+			Columns: []string{paymentrunline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentrunline.TransactionTable,
+			Columns: []string{paymentrunline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PaymentRunLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrunline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/possaleline"
+	"sent/ent/product"
+	"sent/ent/transaction"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// PosSaleLine is the model entity for the PosSaleLine schema.
+type PosSaleLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice decimal.Decimal `json:"unit_price,omitempty"`
+	// Discount holds the value of the "discount" field.
+	Discount decimal.Decimal `json:"discount,omitempty"`
+	// Total holds the value of the "total" field.
+	Total decimal.Decimal `json:"total,omitempty"`
+	// NetAmount holds the value of the "net_amount" field.
+	NetAmount decimal.Decimal `json:"net_amount,omitempty"`
+	// TaxCode holds the value of the "tax_code" field.
+	TaxCode string `json:"tax_code,omitempty"`
+	// TaxRate holds the value of the "tax_rate" field.
+	TaxRate decimal.Decimal `json:"tax_rate,omitempty"`
+	// TaxAmount holds the value of the "tax_amount" field.
+	TaxAmount decimal.Decimal `json:"tax_amount,omitempty"`
+	// ReturnedQuantity holds the value of the "returned_quantity" field.
+	ReturnedQuantity decimal.Decimal `json:"returned_quantity,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PosSaleLineQuery when eager-loading is set.
+	Edges                  PosSaleLineEdges `json:"edges"`
+	pos_sale_line_returns  *int
+	product_pos_sale_lines *int
+	transaction_pos_lines  *int
+	selectValues           sql.SelectValues
+}
+
+// PosSaleLineEdges holds the relations/edges for other nodes in the graph.
+type PosSaleLineEdges struct {
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// ReturnedLine holds the value of the returned_line edge.
+	ReturnedLine *PosSaleLine `json:"returned_line,omitempty"`
+	// Returns holds the value of the returns edge.
+	Returns []*PosSaleLine `json:"returns,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosSaleLineEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosSaleLineEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// ReturnedLineOrErr returns the ReturnedLine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosSaleLineEdges) ReturnedLineOrErr() (*PosSaleLine, error) {
+	if e.ReturnedLine != nil {
+		return e.ReturnedLine, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: possaleline.Label}
+	}
+	return nil, &NotLoadedError{edge: "returned_line"}
+}
+
+// ReturnsOrErr returns the Returns value or an error if the edge
+// was not loaded in eager-loading.
+func (e PosSaleLineEdges) ReturnsOrErr() ([]*PosSaleLine, error) {
+	if e.loadedTypes[3] {
+		return e.Returns, nil
+	}
+	return nil, &NotLoadedError{edge: "returns"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PosSaleLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case possaleline.FieldQuantity, possaleline.FieldUnitPrice, possaleline.FieldDiscount, possaleline.FieldTotal, possaleline.FieldNetAmount, possaleline.FieldTaxRate, possaleline.FieldTaxAmount, possaleline.FieldReturnedQuantity:
+			values[i] = new(decimal.Decimal)
+		case possaleline.FieldID:
+			values[i] = new(sql.NullInt64)
+		case possaleline.FieldTaxCode:
+			values[i] = new(sql.NullString)
+		case possaleline.ForeignKeys[0]: // pos_sale_line_returns
+			values[i] = new(sql.NullInt64)
+		case possaleline.ForeignKeys[1]: // product_pos_sale_lines
+			values[i] = new(sql.NullInt64)
+		case possaleline.ForeignKeys[2]: // transaction_pos_lines
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PosSaleLine fields.
+func (_m *PosSaleLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case possaleline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case possaleline.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case possaleline.FieldUnitPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil {
+				_m.UnitPrice = *value
+			}
+		case possaleline.FieldDiscount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field discount", values[i])
+			} else if value != nil {
+				_m.Discount = *value
+			}
+		case possaleline.FieldTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value != nil {
+				_m.Total = *value
+			}
+		case possaleline.FieldNetAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field net_amount", values[i])
+			} else if value != nil {
+				_m.NetAmount = *value
+			}
+		case possaleline.FieldTaxCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_code", values[i])
+			} else if value.Valid {
+				_m.TaxCode = value.String
+			}
+		case possaleline.FieldTaxRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value != nil {
+				_m.TaxRate = *value
+			}
+		case possaleline.FieldTaxAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value != nil {
+				_m.TaxAmount = *value
+			}
+		case possaleline.FieldReturnedQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field returned_quantity", values[i])
+			} else if value != nil {
+				_m.ReturnedQuantity = *value
+			}
+		case possaleline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field pos_sale_line_returns", value)
+			} else if value.Valid {
+				_m.pos_sale_line_returns = new(int)
+				*_m.pos_sale_line_returns = int(value.Int64)
+			}
+		case possaleline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field product_pos_sale_lines", value)
+			} else if value.Valid {
+				_m.product_pos_sale_lines = new(int)
+				*_m.product_pos_sale_lines = int(value.Int64)
+			}
+		case possaleline.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field transaction_pos_lines", value)
+			} else if value.Valid {
+				_m.transaction_pos_lines = new(int)
+				*_m.transaction_pos_lines = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PosSaleLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *PosSaleLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTransaction queries the "transaction" edge of the PosSaleLine entity.
+func (_m *PosSaleLine) QueryTransaction() *TransactionQuery {
+	return NewPosSaleLineClient(_m.config).QueryTransaction(_m)
+}
+
+// QueryProduct queries the "product" edge of the PosSaleLine entity.
+func (_m *PosSaleLine) QueryProduct() *ProductQuery {
+	return NewPosSaleLineClient(_m.config).QueryProduct(_m)
+}
+
+// QueryReturnedLine queries the "returned_line" edge of the PosSaleLine entity.
+func (_m *PosSaleLine) QueryReturnedLine() *PosSaleLineQuery {
+	return NewPosSaleLineClient(_m.config).QueryReturnedLine(_m)
+}
+
+// QueryReturns queries the "returns" edge of the PosSaleLine entity.
+func (_m *PosSaleLine) QueryReturns() *PosSaleLineQuery {
+	return NewPosSaleLineClient(_m.config).QueryReturns(_m)
+}
+
+// Update returns a builder for updating this PosSaleLine.
+// Note that you need to call PosSaleLine.Unwrap() before calling this method if this PosSaleLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PosSaleLine) Update() *PosSaleLineUpdateOne {
+	return NewPosSaleLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PosSaleLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PosSaleLine) Unwrap() *PosSaleLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PosSaleLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PosSaleLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("PosSaleLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteString(", ")
+	builder.WriteString("discount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Discount))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("net_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_code=")
+	builder.WriteString(_m.TaxCode)
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("returned_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReturnedQuantity))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PosSaleLines is a parsable slice of PosSaleLine.
+type PosSaleLines []*PosSaleLine
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/possaleline"
+	"sent/ent/product"
+	"sent/ent/transaction"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PosSaleLineCreate is the builder for creating a PosSaleLine entity.
+type PosSaleLineCreate struct {
+	config
+	mutation *PosSaleLineMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *PosSaleLineCreate) SetQuantity(v decimal.Decimal) *PosSaleLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableQuantity(v *decimal.Decimal) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *PosSaleLineCreate) SetUnitPrice(v decimal.Decimal) *PosSaleLineCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableUnitPrice(v *decimal.Decimal) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetDiscount sets the "discount" field.
+func (_c *PosSaleLineCreate) SetDiscount(v decimal.Decimal) *PosSaleLineCreate {
+	_c.mutation.SetDiscount(v)
+	return _c
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableDiscount(v *decimal.Decimal) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetDiscount(*v)
+	}
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *PosSaleLineCreate) SetTotal(v decimal.Decimal) *PosSaleLineCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableTotal(v *decimal.Decimal) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_c *PosSaleLineCreate) SetNetAmount(v decimal.Decimal) *PosSaleLineCreate {
+	_c.mutation.SetNetAmount(v)
+	return _c
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableNetAmount(v *decimal.Decimal) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetNetAmount(*v)
+	}
+	return _c
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_c *PosSaleLineCreate) SetTaxCode(v string) *PosSaleLineCreate {
+	_c.mutation.SetTaxCode(v)
+	return _c
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableTaxCode(v *string) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetTaxCode(*v)
+	}
+	return _c
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_c *PosSaleLineCreate) SetTaxRate(v decimal.Decimal) *PosSaleLineCreate {
+	_c.mutation.SetTaxRate(v)
+	return _c
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableTaxRate(v *decimal.Decimal) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetTaxRate(*v)
+	}
+	return _c
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_c *PosSaleLineCreate) SetTaxAmount(v decimal.Decimal) *PosSaleLineCreate {
+	_c.mutation.SetTaxAmount(v)
+	return _c
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableTaxAmount(v *decimal.Decimal) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetTaxAmount(*v)
+	}
+	return _c
+}
+
+// SetReturnedQuantity sets the "returned_quantity" field.
+func (_c *PosSaleLineCreate) SetReturnedQuantity(v decimal.Decimal) *PosSaleLineCreate {
+	_c.mutation.SetReturnedQuantity(v)
+	return _c
+}
+
+// SetNillableReturnedQuantity sets the "returned_quantity" field if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableReturnedQuantity(v *decimal.Decimal) *PosSaleLineCreate {
+	if v != nil {
+		_c.SetReturnedQuantity(*v)
+	}
+	return _c
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *PosSaleLineCreate) SetTransactionID(id int) *PosSaleLineCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *PosSaleLineCreate) SetTransaction(v *Transaction) *PosSaleLineCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_c *PosSaleLineCreate) SetProductID(id int) *PosSaleLineCreate {
+	_c.mutation.SetProductID(id)
+	return _c
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_c *PosSaleLineCreate) SetProduct(v *Product) *PosSaleLineCreate {
+	return _c.SetProductID(v.ID)
+}
+
+// SetReturnedLineID sets the "returned_line" edge to the PosSaleLine entity by ID.
+func (_c *PosSaleLineCreate) SetReturnedLineID(id int) *PosSaleLineCreate {
+	_c.mutation.SetReturnedLineID(id)
+	return _c
+}
+
+// SetNillableReturnedLineID sets the "returned_line" edge to the PosSaleLine entity by ID if the given value is not nil.
+func (_c *PosSaleLineCreate) SetNillableReturnedLineID(id *int) *PosSaleLineCreate {
+	if id != nil {
+		_c = _c.SetReturnedLineID(*id)
+	}
+	return _c
+}
+
+// SetReturnedLine sets the "returned_line" edge to the PosSaleLine entity.
+func (_c *PosSaleLineCreate) SetReturnedLine(v *PosSaleLine) *PosSaleLineCreate {
+	return _c.SetReturnedLineID(v.ID)
+}
+
+// AddReturnIDs adds the "returns" edge to the PosSaleLine entity by IDs.
+func (_c *PosSaleLineCreate) AddReturnIDs(ids ...int) *PosSaleLineCreate {
+	_c.mutation.AddReturnIDs(ids...)
+	return _c
+}
+
+// AddReturns adds the "returns" edges to the PosSaleLine entity.
+func (_c *PosSaleLineCreate) AddReturns(v ...*PosSaleLine) *PosSaleLineCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReturnIDs(ids...)
+}
+
+// Mutation returns the PosSaleLineMutation object of the builder.
+func (_c *PosSaleLineCreate) Mutation() *PosSaleLineMutation {
+	return _c.mutation
+}
+
+// Save creates the PosSaleLine in the database.
+func (_c *PosSaleLineCreate) Save(ctx context.Context) (*PosSaleLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PosSaleLineCreate) SaveX(ctx context.Context) *PosSaleLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PosSaleLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PosSaleLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PosSaleLineCreate) defaults() {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := possaleline.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		v := possaleline.DefaultUnitPrice
+		_c.mutation.SetUnitPrice(v)
+	}
+	if _, ok := _c.mutation.Discount(); !ok {
+		v := possaleline.DefaultDiscount
+		_c.mutation.SetDiscount(v)
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		v := possaleline.DefaultTotal
+		_c.mutation.SetTotal(v)
+	}
+	if _, ok := _c.mutation.NetAmount(); !ok {
+		v := possaleline.DefaultNetAmount
+		_c.mutation.SetNetAmount(v)
+	}
+	if _, ok := _c.mutation.TaxRate(); !ok {
+		v := possaleline.DefaultTaxRate
+		_c.mutation.SetTaxRate(v)
+	}
+	if _, ok := _c.mutation.TaxAmount(); !ok {
+		v := possaleline.DefaultTaxAmount
+		_c.mutation.SetTaxAmount(v)
+	}
+	if _, ok := _c.mutation.ReturnedQuantity(); !ok {
+		v := possaleline.DefaultReturnedQuantity
+		_c.mutation.SetReturnedQuantity(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PosSaleLineCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "PosSaleLine.quantity"`)}
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "PosSaleLine.unit_price"`)}
+	}
+	if _, ok := _c.mutation.Discount(); !ok {
+		return &ValidationError{Name: "discount", err: errors.New(`ent: missing required field "PosSaleLine.discount"`)}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "PosSaleLine.total"`)}
+	}
+	if _, ok := _c.mutation.NetAmount(); !ok {
+		return &ValidationError{Name: "net_amount", err: errors.New(`ent: missing required field "PosSaleLine.net_amount"`)}
+	}
+	if _, ok := _c.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "PosSaleLine.tax_rate"`)}
+	}
+	if _, ok := _c.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "PosSaleLine.tax_amount"`)}
+	}
+	if _, ok := _c.mutation.ReturnedQuantity(); !ok {
+		return &ValidationError{Name: "returned_quantity", err: errors.New(`ent: missing required field "PosSaleLine.returned_quantity"`)}
+	}
+	if len(_c.mutation.TransactionIDs()) == 0 {
+		return &ValidationError{Name: "transaction", err: errors.New(`ent: missing required edge "PosSaleLine.transaction"`)}
+	}
+	if len(_c.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "PosSaleLine.product"`)}
+	}
+	return nil
+}
+
+func (_c *PosSaleLineCreate) sqlSave(ctx context.Context) (*PosSaleLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PosSaleLineCreate) createSpec() (*PosSaleLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PosSaleLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(possaleline.Table, sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(possaleline.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(possaleline.FieldUnitPrice, field.TypeOther, value)
+		_node.UnitPrice = value
+	}
+	if value, ok := _c.mutation.Discount(); ok {
+		_spec.SetField(possaleline.FieldDiscount, field.TypeOther, value)
+		_node.Discount = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(possaleline.FieldTotal, field.TypeOther, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.NetAmount(); ok {
+		_spec.SetField(possaleline.FieldNetAmount, field.TypeOther, value)
+		_node.NetAmount = value
+	}
+	if value, ok := _c.mutation.TaxCode(); ok {
+		_spec.SetField(possaleline.FieldTaxCode, field.TypeString, value)
+		_node.TaxCode = value
+	}
+	if value, ok := _c.mutation.TaxRate(); ok {
+		_spec.SetField(possaleline.FieldTaxRate, field.TypeOther, value)
+		_node.TaxRate = value
+	}
+	if value, ok := _c.mutation.TaxAmount(); ok {
+		_spec.SetField(possaleline.FieldTaxAmount, field.TypeOther, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := _c.mutation.ReturnedQuantity(); ok {
+		_spec.SetField(possaleline.FieldReturnedQuantity, field.TypeOther, value)
+		_node.ReturnedQuantity = value
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.TransactionTable,
+			Columns: []string{possaleline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transaction_pos_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ProductTable,
+			Columns: []string{possaleline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.product_pos_sale_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReturnedLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ReturnedLineTable,
+			Columns: []string{possaleline.ReturnedLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pos_sale_line_returns = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   possaleline.ReturnsTable,
+			Columns: []string{possaleline.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PosSaleLineCreateBulk is the builder for creating many PosSaleLine entities in bulk.
+type PosSaleLineCreateBulk struct {
+	config
+	err      error
+	builders []*PosSaleLineCreate
+}
+
+// Save creates the PosSaleLine entities in the database.
+func (_c *PosSaleLineCreateBulk) Save(ctx context.Context) ([]*PosSaleLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PosSaleLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PosSaleLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PosSaleLineCreateBulk) SaveX(ctx context.Context) []*PosSaleLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PosSaleLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PosSaleLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,861 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"sent/ent/possaleline"
+	"sent/ent/predicate"
+	"sent/ent/product"
+	"sent/ent/transaction"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosSaleLineQuery is the builder for querying PosSaleLine entities.
+type PosSaleLineQuery struct {
+	config
+	ctx              *QueryContext
+	order            []possaleline.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.PosSaleLine
+	withTransaction  *TransactionQuery
+	withProduct      *ProductQuery
+	withReturnedLine *PosSaleLineQuery
+	withReturns      *PosSaleLineQuery
+	withFKs          bool
+	modifiers        []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PosSaleLineQuery builder.
+func (_q *PosSaleLineQuery) Where(ps ...predicate.PosSaleLine) *PosSaleLineQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *PosSaleLineQuery) Limit(limit int) *PosSaleLineQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *PosSaleLineQuery) Offset(offset int) *PosSaleLineQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *PosSaleLineQuery) Unique(unique bool) *PosSaleLineQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *PosSaleLineQuery) Order(o ...possaleline.OrderOption) *PosSaleLineQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryTransaction chains the current query on the "transaction" edge.
+func (_q *PosSaleLineQuery) QueryTransaction() *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(possaleline.Table, possaleline.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, possaleline.TransactionTable, possaleline.TransactionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (_q *PosSaleLineQuery) QueryProduct() *ProductQuery {
+	query := (&ProductClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(possaleline.Table, possaleline.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, possaleline.ProductTable, possaleline.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReturnedLine chains the current query on the "returned_line" edge.
+func (_q *PosSaleLineQuery) QueryReturnedLine() *PosSaleLineQuery {
+	query := (&PosSaleLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(possaleline.Table, possaleline.FieldID, selector),
+			sqlgraph.To(possaleline.Table, possaleline.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, possaleline.ReturnedLineTable, possaleline.ReturnedLineColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReturns chains the current query on the "returns" edge.
+func (_q *PosSaleLineQuery) QueryReturns() *PosSaleLineQuery {
+	query := (&PosSaleLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(possaleline.Table, possaleline.FieldID, selector),
+			sqlgraph.To(possaleline.Table, possaleline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, possaleline.ReturnsTable, possaleline.ReturnsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PosSaleLine entity from the query.
+// Returns a *NotFoundError when no PosSaleLine was found.
+func (_q *PosSaleLineQuery) First(ctx context.Context) (*PosSaleLine, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{possaleline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *PosSaleLineQuery) FirstX(ctx context.Context) *PosSaleLine {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PosSaleLine ID from the query.
+// Returns a *NotFoundError when no PosSaleLine ID was found.
+func (_q *PosSaleLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{possaleline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *PosSaleLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PosSaleLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PosSaleLine entity is found.
+// Returns a *NotFoundError when no PosSaleLine entities are found.
+func (_q *PosSaleLineQuery) Only(ctx context.Context) (*PosSaleLine, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{possaleline.Label}
+	default:
+		return nil, &NotSingularError{possaleline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *PosSaleLineQuery) OnlyX(ctx context.Context) *PosSaleLine {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PosSaleLine ID in the query.
+// Returns a *NotSingularError when more than one PosSaleLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *PosSaleLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{possaleline.Label}
+	default:
+		err = &NotSingularError{possaleline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *PosSaleLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PosSaleLines.
+func (_q *PosSaleLineQuery) All(ctx context.Context) ([]*PosSaleLine, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PosSaleLine, *PosSaleLineQuery]()
+	return withInterceptors[[]*PosSaleLine](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *PosSaleLineQuery) AllX(ctx context.Context) []*PosSaleLine {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PosSaleLine IDs.
+func (_q *PosSaleLineQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(possaleline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *PosSaleLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *PosSaleLineQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*PosSaleLineQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *PosSaleLineQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *PosSaleLineQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *PosSaleLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PosSaleLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *PosSaleLineQuery) Clone() *PosSaleLineQuery {
+	if _q == nil {
+		return nil
+	}
+	return &PosSaleLineQuery{
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]possaleline.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.PosSaleLine{}, _q.predicates...),
+		withTransaction:  _q.withTransaction.Clone(),
+		withProduct:      _q.withProduct.Clone(),
+		withReturnedLine: _q.withReturnedLine.Clone(),
+		withReturns:      _q.withReturns.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithTransaction tells the query-builder to eager-load the nodes that are connected to
+// the "transaction" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PosSaleLineQuery) WithTransaction(opts ...func(*TransactionQuery)) *PosSaleLineQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransaction = query
+	return _q
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PosSaleLineQuery) WithProduct(opts ...func(*ProductQuery)) *PosSaleLineQuery {
+	query := (&ProductClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withProduct = query
+	return _q
+}
+
+// WithReturnedLine tells the query-builder to eager-load the nodes that are connected to
+// the "returned_line" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PosSaleLineQuery) WithReturnedLine(opts ...func(*PosSaleLineQuery)) *PosSaleLineQuery {
+	query := (&PosSaleLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReturnedLine = query
+	return _q
+}
+
+// WithReturns tells the query-builder to eager-load the nodes that are connected to
+// the "returns" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PosSaleLineQuery) WithReturns(opts ...func(*PosSaleLineQuery)) *PosSaleLineQuery {
+	query := (&PosSaleLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReturns = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Quantity decimal.Decimal `json:"quantity,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PosSaleLine.Query().
+//		GroupBy(possaleline.FieldQuantity).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *PosSaleLineQuery) GroupBy(field string, fields ...string) *PosSaleLineGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PosSaleLineGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = possaleline.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Quantity decimal.Decimal `json:"quantity,omitempty"`
+//	}
+//
+//	client.PosSaleLine.Query().
+//		Select(possaleline.FieldQuantity).
+//		Scan(ctx, &v)
+func (_q *PosSaleLineQuery) Select(fields ...string) *PosSaleLineSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &PosSaleLineSelect{PosSaleLineQuery: _q}
+	sbuild.label = possaleline.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PosSaleLineSelect configured with the given aggregations.
+func (_q *PosSaleLineQuery) Aggregate(fns ...AggregateFunc) *PosSaleLineSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *PosSaleLineQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !possaleline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *PosSaleLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PosSaleLine, error) {
+	var (
+		nodes       = []*PosSaleLine{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [4]bool{
+			_q.withTransaction != nil,
+			_q.withProduct != nil,
+			_q.withReturnedLine != nil,
+			_q.withReturns != nil,
+		}
+	)
+	if _q.withTransaction != nil || _q.withProduct != nil || _q.withReturnedLine != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, possaleline.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PosSaleLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PosSaleLine{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withTransaction; query != nil {
+		if err := _q.loadTransaction(ctx, query, nodes, nil,
+			func(n *PosSaleLine, e *Transaction) { n.Edges.Transaction = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withProduct; query != nil {
+		if err := _q.loadProduct(ctx, query, nodes, nil,
+			func(n *PosSaleLine, e *Product) { n.Edges.Product = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReturnedLine; query != nil {
+		if err := _q.loadReturnedLine(ctx, query, nodes, nil,
+			func(n *PosSaleLine, e *PosSaleLine) { n.Edges.ReturnedLine = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReturns; query != nil {
+		if err := _q.loadReturns(ctx, query, nodes,
+			func(n *PosSaleLine) { n.Edges.Returns = []*PosSaleLine{} },
+			func(n *PosSaleLine, e *PosSaleLine) { n.Edges.Returns = append(n.Edges.Returns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *PosSaleLineQuery) loadTransaction(ctx context.Context, query *TransactionQuery, nodes []*PosSaleLine, init func(*PosSaleLine), assign func(*PosSaleLine, *Transaction)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PosSaleLine)
+	for i := range nodes {
+		if nodes[i].transaction_pos_lines == nil {
+			continue
+		}
+		fk := *nodes[i].transaction_pos_lines
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transaction_pos_lines" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *PosSaleLineQuery) loadProduct(ctx context.Context, query *ProductQuery, nodes []*PosSaleLine, init func(*PosSaleLine), assign func(*PosSaleLine, *Product)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PosSaleLine)
+	for i := range nodes {
+		if nodes[i].product_pos_sale_lines == nil {
+			continue
+		}
+		fk := *nodes[i].product_pos_sale_lines
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(product.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "product_pos_sale_lines" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *PosSaleLineQuery) loadReturnedLine(ctx context.Context, query *PosSaleLineQuery, nodes []*PosSaleLine, init func(*PosSaleLine), assign func(*PosSaleLine, *PosSaleLine)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PosSaleLine)
+	for i := range nodes {
+		if nodes[i].pos_sale_line_returns == nil {
+			continue
+		}
+		fk := *nodes[i].pos_sale_line_returns
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(possaleline.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pos_sale_line_returns" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *PosSaleLineQuery) loadReturns(ctx context.Context, query *PosSaleLineQuery, nodes []*PosSaleLine, init func(*PosSaleLine), assign func(*PosSaleLine, *PosSaleLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PosSaleLine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PosSaleLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(possaleline.ReturnsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.pos_sale_line_returns
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "pos_sale_line_returns" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "pos_sale_line_returns" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *PosSaleLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *PosSaleLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(possaleline.Table, possaleline.Columns, sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, possaleline.FieldID)
+		for i := range fields {
+			if fields[i] != possaleline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *PosSaleLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(possaleline.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = possaleline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *PosSaleLineQuery) Modify(modifiers ...func(s *sql.Selector)) *PosSaleLineSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// PosSaleLineGroupBy is the group-by builder for PosSaleLine entities.
+type PosSaleLineGroupBy struct {
+	selector
+	build *PosSaleLineQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *PosSaleLineGroupBy) Aggregate(fns ...AggregateFunc) *PosSaleLineGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *PosSaleLineGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PosSaleLineQuery, *PosSaleLineGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *PosSaleLineGroupBy) sqlScan(ctx context.Context, root *PosSaleLineQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PosSaleLineSelect is the builder for selecting fields of PosSaleLine entities.
+type PosSaleLineSelect struct {
+	*PosSaleLineQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *PosSaleLineSelect) Aggregate(fns ...AggregateFunc) *PosSaleLineSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *PosSaleLineSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PosSaleLineQuery, *PosSaleLineSelect](ctx, _s.PosSaleLineQuery, _s, _s.inters, v)
+}
+
+func (_s *PosSaleLineSelect) sqlScan(ctx context.Context, root *PosSaleLineQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *PosSaleLineSelect) Modify(modifiers ...func(s *sql.Selector)) *PosSaleLineSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,1000 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/possaleline"
+	"sent/ent/predicate"
+	"sent/ent/product"
+	"sent/ent/transaction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PosSaleLineUpdate is the builder for updating PosSaleLine entities.
+type PosSaleLineUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PosSaleLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PosSaleLineUpdate builder.
+func (_u *PosSaleLineUpdate) Where(ps ...predicate.PosSaleLine) *PosSaleLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PosSaleLineUpdate) SetQuantity(v decimal.Decimal) *PosSaleLineUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableQuantity(v *decimal.Decimal) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *PosSaleLineUpdate) SetUnitPrice(v decimal.Decimal) *PosSaleLineUpdate {
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableUnitPrice(v *decimal.Decimal) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// SetDiscount sets the "discount" field.
+func (_u *PosSaleLineUpdate) SetDiscount(v decimal.Decimal) *PosSaleLineUpdate {
+	_u.mutation.SetDiscount(v)
+	return _u
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableDiscount(v *decimal.Decimal) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetDiscount(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *PosSaleLineUpdate) SetTotal(v decimal.Decimal) *PosSaleLineUpdate {
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableTotal(v *decimal.Decimal) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_u *PosSaleLineUpdate) SetNetAmount(v decimal.Decimal) *PosSaleLineUpdate {
+	_u.mutation.SetNetAmount(v)
+	return _u
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableNetAmount(v *decimal.Decimal) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetNetAmount(*v)
+	}
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *PosSaleLineUpdate) SetTaxCode(v string) *PosSaleLineUpdate {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableTaxCode(v *string) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (_u *PosSaleLineUpdate) ClearTaxCode() *PosSaleLineUpdate {
+	_u.mutation.ClearTaxCode()
+	return _u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_u *PosSaleLineUpdate) SetTaxRate(v decimal.Decimal) *PosSaleLineUpdate {
+	_u.mutation.SetTaxRate(v)
+	return _u
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableTaxRate(v *decimal.Decimal) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetTaxRate(*v)
+	}
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *PosSaleLineUpdate) SetTaxAmount(v decimal.Decimal) *PosSaleLineUpdate {
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableTaxAmount(v *decimal.Decimal) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// SetReturnedQuantity sets the "returned_quantity" field.
+func (_u *PosSaleLineUpdate) SetReturnedQuantity(v decimal.Decimal) *PosSaleLineUpdate {
+	_u.mutation.SetReturnedQuantity(v)
+	return _u
+}
+
+// SetNillableReturnedQuantity sets the "returned_quantity" field if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableReturnedQuantity(v *decimal.Decimal) *PosSaleLineUpdate {
+	if v != nil {
+		_u.SetReturnedQuantity(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *PosSaleLineUpdate) SetTransactionID(id int) *PosSaleLineUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *PosSaleLineUpdate) SetTransaction(v *Transaction) *PosSaleLineUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_u *PosSaleLineUpdate) SetProductID(id int) *PosSaleLineUpdate {
+	_u.mutation.SetProductID(id)
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *PosSaleLineUpdate) SetProduct(v *Product) *PosSaleLineUpdate {
+	return _u.SetProductID(v.ID)
+}
+
+// SetReturnedLineID sets the "returned_line" edge to the PosSaleLine entity by ID.
+func (_u *PosSaleLineUpdate) SetReturnedLineID(id int) *PosSaleLineUpdate {
+	_u.mutation.SetReturnedLineID(id)
+	return _u
+}
+
+// SetNillableReturnedLineID sets the "returned_line" edge to the PosSaleLine entity by ID if the given value is not nil.
+func (_u *PosSaleLineUpdate) SetNillableReturnedLineID(id *int) *PosSaleLineUpdate {
+	if id != nil {
+		_u = _u.SetReturnedLineID(*id)
+	}
+	return _u
+}
+
+// SetReturnedLine sets the "returned_line" edge to the PosSaleLine entity.
+func (_u *PosSaleLineUpdate) SetReturnedLine(v *PosSaleLine) *PosSaleLineUpdate {
+	return _u.SetReturnedLineID(v.ID)
+}
+
+// AddReturnIDs adds the "returns" edge to the PosSaleLine entity by IDs.
+func (_u *PosSaleLineUpdate) AddReturnIDs(ids ...int) *PosSaleLineUpdate {
+	_u.mutation.AddReturnIDs(ids...)
+	return _u
+}
+
+// AddReturns adds the "returns" edges to the PosSaleLine entity.
+func (_u *PosSaleLineUpdate) AddReturns(v ...*PosSaleLine) *PosSaleLineUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReturnIDs(ids...)
+}
+
+// Mutation returns the PosSaleLineMutation object of the builder.
+func (_u *PosSaleLineUpdate) Mutation() *PosSaleLineMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *PosSaleLineUpdate) ClearTransaction() *PosSaleLineUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *PosSaleLineUpdate) ClearProduct() *PosSaleLineUpdate {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// ClearReturnedLine clears the "returned_line" edge to the PosSaleLine entity.
+func (_u *PosSaleLineUpdate) ClearReturnedLine() *PosSaleLineUpdate {
+	_u.mutation.ClearReturnedLine()
+	return _u
+}
+
+// ClearReturns clears all "returns" edges to the PosSaleLine entity.
+func (_u *PosSaleLineUpdate) ClearReturns() *PosSaleLineUpdate {
+	_u.mutation.ClearReturns()
+	return _u
+}
+
+// RemoveReturnIDs removes the "returns" edge to PosSaleLine entities by IDs.
+func (_u *PosSaleLineUpdate) RemoveReturnIDs(ids ...int) *PosSaleLineUpdate {
+	_u.mutation.RemoveReturnIDs(ids...)
+	return _u
+}
+
+// RemoveReturns removes "returns" edges to PosSaleLine entities.
+func (_u *PosSaleLineUpdate) RemoveReturns(v ...*PosSaleLine) *PosSaleLineUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReturnIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PosSaleLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PosSaleLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PosSaleLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PosSaleLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PosSaleLineUpdate) check() error {
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosSaleLine.transaction"`)
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosSaleLine.product"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PosSaleLineUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosSaleLineUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PosSaleLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(possaleline.Table, possaleline.Columns, sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(possaleline.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(possaleline.FieldUnitPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Discount(); ok {
+		_spec.SetField(possaleline.FieldDiscount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(possaleline.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.NetAmount(); ok {
+		_spec.SetField(possaleline.FieldNetAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(possaleline.FieldTaxCode, field.TypeString, value)
+	}
+	if _u.mutation.TaxCodeCleared() {
+		_spec.ClearField(possaleline.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxRate(); ok {
+		_spec.SetField(possaleline.FieldTaxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(possaleline.FieldTaxAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ReturnedQuantity(); ok {
+		_spec.SetField(possaleline.FieldReturnedQuantity, field.TypeOther, value)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.TransactionTable,
+			Columns: []string{possaleline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.TransactionTable,
+			Columns: []string{possaleline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ProductTable,
+			Columns: []string{possaleline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ProductTable,
+			Columns: []string{possaleline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnedLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ReturnedLineTable,
+			Columns: []string{possaleline.ReturnedLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnedLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ReturnedLineTable,
+			Columns: []string{possaleline.ReturnedLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   possaleline.ReturnsTable,
+			Columns: []string{possaleline.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReturnsIDs(); len(nodes) > 0 && !_u.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   possaleline.ReturnsTable,
+			Columns: []string{possaleline.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   possaleline.ReturnsTable,
+			Columns: []string{possaleline.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{possaleline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PosSaleLineUpdateOne is the builder for updating a single PosSaleLine entity.
+type PosSaleLineUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PosSaleLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PosSaleLineUpdateOne) SetQuantity(v decimal.Decimal) *PosSaleLineUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableQuantity(v *decimal.Decimal) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *PosSaleLineUpdateOne) SetUnitPrice(v decimal.Decimal) *PosSaleLineUpdateOne {
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableUnitPrice(v *decimal.Decimal) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// SetDiscount sets the "discount" field.
+func (_u *PosSaleLineUpdateOne) SetDiscount(v decimal.Decimal) *PosSaleLineUpdateOne {
+	_u.mutation.SetDiscount(v)
+	return _u
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableDiscount(v *decimal.Decimal) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetDiscount(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *PosSaleLineUpdateOne) SetTotal(v decimal.Decimal) *PosSaleLineUpdateOne {
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableTotal(v *decimal.Decimal) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_u *PosSaleLineUpdateOne) SetNetAmount(v decimal.Decimal) *PosSaleLineUpdateOne {
+	_u.mutation.SetNetAmount(v)
+	return _u
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableNetAmount(v *decimal.Decimal) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetNetAmount(*v)
+	}
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *PosSaleLineUpdateOne) SetTaxCode(v string) *PosSaleLineUpdateOne {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableTaxCode(v *string) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (_u *PosSaleLineUpdateOne) ClearTaxCode() *PosSaleLineUpdateOne {
+	_u.mutation.ClearTaxCode()
+	return _u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_u *PosSaleLineUpdateOne) SetTaxRate(v decimal.Decimal) *PosSaleLineUpdateOne {
+	_u.mutation.SetTaxRate(v)
+	return _u
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableTaxRate(v *decimal.Decimal) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetTaxRate(*v)
+	}
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *PosSaleLineUpdateOne) SetTaxAmount(v decimal.Decimal) *PosSaleLineUpdateOne {
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableTaxAmount(v *decimal.Decimal) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// SetReturnedQuantity sets the "returned_quantity" field.
+func (_u *PosSaleLineUpdateOne) SetReturnedQuantity(v decimal.Decimal) *PosSaleLineUpdateOne {
+	_u.mutation.SetReturnedQuantity(v)
+	return _u
+}
+
+// SetNillableReturnedQuantity sets the "returned_quantity" field if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableReturnedQuantity(v *decimal.Decimal) *PosSaleLineUpdateOne {
+	if v != nil {
+		_u.SetReturnedQuantity(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *PosSaleLineUpdateOne) SetTransactionID(id int) *PosSaleLineUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *PosSaleLineUpdateOne) SetTransaction(v *Transaction) *PosSaleLineUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_u *PosSaleLineUpdateOne) SetProductID(id int) *PosSaleLineUpdateOne {
+	_u.mutation.SetProductID(id)
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *PosSaleLineUpdateOne) SetProduct(v *Product) *PosSaleLineUpdateOne {
+	return _u.SetProductID(v.ID)
+}
+
+// SetReturnedLineID sets the "returned_line" edge to the PosSaleLine entity by ID.
+func (_u *PosSaleLineUpdateOne) SetReturnedLineID(id int) *PosSaleLineUpdateOne {
+	_u.mutation.SetReturnedLineID(id)
+	return _u
+}
+
+// SetNillableReturnedLineID sets the "returned_line" edge to the PosSaleLine entity by ID if the given value is not nil.
+func (_u *PosSaleLineUpdateOne) SetNillableReturnedLineID(id *int) *PosSaleLineUpdateOne {
+	if id != nil {
+		_u = _u.SetReturnedLineID(*id)
+	}
+	return _u
+}
+
+// SetReturnedLine sets the "returned_line" edge to the PosSaleLine entity.
+func (_u *PosSaleLineUpdateOne) SetReturnedLine(v *PosSaleLine) *PosSaleLineUpdateOne {
+	return _u.SetReturnedLineID(v.ID)
+}
+
+// AddReturnIDs adds the "returns" edge to the PosSaleLine entity by IDs.
+func (_u *PosSaleLineUpdateOne) AddReturnIDs(ids ...int) *PosSaleLineUpdateOne {
+	_u.mutation.AddReturnIDs(ids...)
+	return _u
+}
+
+// AddReturns adds the "returns" edges to the PosSaleLine entity.
+func (_u *PosSaleLineUpdateOne) AddReturns(v ...*PosSaleLine) *PosSaleLineUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReturnIDs(ids...)
+}
+
+// Mutation returns the PosSaleLineMutation object of the builder.
+func (_u *PosSaleLineUpdateOne) Mutation() *PosSaleLineMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *PosSaleLineUpdateOne) ClearTransaction() *PosSaleLineUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *PosSaleLineUpdateOne) ClearProduct() *PosSaleLineUpdateOne {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// ClearReturnedLine clears the "returned_line" edge to the PosSaleLine entity.
+func (_u *PosSaleLineUpdateOne) ClearReturnedLine() *PosSaleLineUpdateOne {
+	_u.mutation.ClearReturnedLine()
+	return _u
+}
+
+// ClearReturns clears all "returns" edges to the PosSaleLine entity.
+func (_u *PosSaleLineUpdateOne) ClearReturns() *PosSaleLineUpdateOne {
+	_u.mutation.ClearReturns()
+	return _u
+}
+
+// RemoveReturnIDs removes the "returns" edge to PosSaleLine entities by IDs.
+func (_u *PosSaleLineUpdateOne) RemoveReturnIDs(ids ...int) *PosSaleLineUpdateOne {
+	_u.mutation.RemoveReturnIDs(ids...)
+	return _u
+}
+
+// RemoveReturns removes "returns" edges to PosSaleLine entities.
+func (_u *PosSaleLineUpdateOne) RemoveReturns(v ...*PosSaleLine) *PosSaleLineUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReturnIDs(ids...)
+}
+
+// Where appends a list predicates to the PosSaleLineUpdate builder.
+func (_u *PosSaleLineUpdateOne) Where(ps ...predicate.PosSaleLine) *PosSaleLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PosSaleLineUpdateOne) Select(field string, fields ...string) *PosSaleLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PosSaleLine entity.
+func (_u *PosSaleLineUpdateOne) Save(ctx context.Context) (*PosSaleLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PosSaleLineUpdateOne) SaveX(ctx context.Context) *PosSaleLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PosSaleLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PosSaleLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PosSaleLineUpdateOne) check() error {
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosSaleLine.transaction"`)
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosSaleLine.product"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PosSaleLineUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosSaleLineUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PosSaleLineUpdateOne) sqlSave(ctx context.Context) (_node *PosSaleLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(possaleline.Table, possaleline.Columns, sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PosSaleLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, possaleline.FieldID)
+		for _, f := range fields {
+			if !possaleline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != possaleline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(possaleline.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(possaleline.FieldUnitPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Discount(); ok {
+		_spec.SetField(possaleline.FieldDiscount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(possaleline.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.NetAmount(); ok {
+		_spec.SetField(possaleline.FieldNetAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(possaleline.FieldTaxCode, field.TypeString, value)
+	}
+	if _u.mutation.TaxCodeCleared() {
+		_spec.ClearField(possaleline.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxRate(); ok {
+		_spec.SetField(possaleline.FieldTaxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(possaleline.FieldTaxAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ReturnedQuantity(); ok {
+		_spec.SetField(possaleline.FieldReturnedQuantity, field.TypeOther, value)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.TransactionTable,
+			Columns: []string{possaleline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.TransactionTable,
+			Columns: []string{possaleline.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ProductTable,
+			Columns: []string{possaleline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ProductTable,
+			Columns: []string{possaleline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnedLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ReturnedLineTable,
+			Columns: []string{possaleline.ReturnedLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnedLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   possaleline.ReturnedLineTable,
+			Columns: []string{possaleline.ReturnedLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   possaleline.ReturnsTable,
+			Columns: []string{possaleline.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReturnsIDs(); len(nodes) > 0 && !_u.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   possaleline.ReturnsTable,
+			Columns: []string{possaleline.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   possaleline.ReturnsTable,
+			Columns: []string{possaleline.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PosSaleLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{possaleline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
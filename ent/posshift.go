@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/posshift"
+	"sent/ent/tenant"
+	"sent/ent/warehouse"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// PosShift is the model entity for the PosShift schema.
+type PosShift struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Cashier holds the value of the "cashier" field.
+	Cashier string `json:"cashier,omitempty"`
+	// CashierEmail holds the value of the "cashier_email" field.
+	CashierEmail string `json:"cashier_email,omitempty"`
+	// Status holds the value of the "status" field.
+	Status posshift.Status `json:"status,omitempty"`
+	// OpeningFloat holds the value of the "opening_float" field.
+	OpeningFloat decimal.Decimal `json:"opening_float,omitempty"`
+	// ExpectedCash holds the value of the "expected_cash" field.
+	ExpectedCash decimal.Decimal `json:"expected_cash,omitempty"`
+	// CountedCash holds the value of the "counted_cash" field.
+	CountedCash *decimal.Decimal `json:"counted_cash,omitempty"`
+	// OverShort holds the value of the "over_short" field.
+	OverShort decimal.Decimal `json:"over_short,omitempty"`
+	// OpenedAt holds the value of the "opened_at" field.
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PosShiftQuery when eager-loading is set.
+	Edges                PosShiftEdges `json:"edges"`
+	tenant_pos_shifts    *int
+	warehouse_pos_shifts *int
+	selectValues         sql.SelectValues
+}
+
+// PosShiftEdges holds the relations/edges for other nodes in the graph.
+type PosShiftEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Warehouse holds the value of the warehouse edge.
+	Warehouse *Warehouse `json:"warehouse,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosShiftEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// WarehouseOrErr returns the Warehouse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosShiftEdges) WarehouseOrErr() (*Warehouse, error) {
+	if e.Warehouse != nil {
+		return e.Warehouse, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "warehouse"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PosShiftEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[2] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PosShift) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case posshift.FieldCountedCash:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case posshift.FieldOpeningFloat, posshift.FieldExpectedCash, posshift.FieldOverShort:
+			values[i] = new(decimal.Decimal)
+		case posshift.FieldID:
+			values[i] = new(sql.NullInt64)
+		case posshift.FieldCashier, posshift.FieldCashierEmail, posshift.FieldStatus:
+			values[i] = new(sql.NullString)
+		case posshift.FieldOpenedAt, posshift.FieldClosedAt:
+			values[i] = new(sql.NullTime)
+		case posshift.ForeignKeys[0]: // tenant_pos_shifts
+			values[i] = new(sql.NullInt64)
+		case posshift.ForeignKeys[1]: // warehouse_pos_shifts
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PosShift fields.
+func (_m *PosShift) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case posshift.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case posshift.FieldCashier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cashier", values[i])
+			} else if value.Valid {
+				_m.Cashier = value.String
+			}
+		case posshift.FieldCashierEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cashier_email", values[i])
+			} else if value.Valid {
+				_m.CashierEmail = value.String
+			}
+		case posshift.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = posshift.Status(value.String)
+			}
+		case posshift.FieldOpeningFloat:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field opening_float", values[i])
+			} else if value != nil {
+				_m.OpeningFloat = *value
+			}
+		case posshift.FieldExpectedCash:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_cash", values[i])
+			} else if value != nil {
+				_m.ExpectedCash = *value
+			}
+		case posshift.FieldCountedCash:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field counted_cash", values[i])
+			} else if value.Valid {
+				_m.CountedCash = new(decimal.Decimal)
+				*_m.CountedCash = *value.S.(*decimal.Decimal)
+			}
+		case posshift.FieldOverShort:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field over_short", values[i])
+			} else if value != nil {
+				_m.OverShort = *value
+			}
+		case posshift.FieldOpenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_at", values[i])
+			} else if value.Valid {
+				_m.OpenedAt = value.Time
+			}
+		case posshift.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case posshift.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_pos_shifts", value)
+			} else if value.Valid {
+				_m.tenant_pos_shifts = new(int)
+				*_m.tenant_pos_shifts = int(value.Int64)
+			}
+		case posshift.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_pos_shifts", value)
+			} else if value.Valid {
+				_m.warehouse_pos_shifts = new(int)
+				*_m.warehouse_pos_shifts = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PosShift.
+// This includes values selected through modifiers, order, etc.
+func (_m *PosShift) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the PosShift entity.
+func (_m *PosShift) QueryTenant() *TenantQuery {
+	return NewPosShiftClient(_m.config).QueryTenant(_m)
+}
+
+// QueryWarehouse queries the "warehouse" edge of the PosShift entity.
+func (_m *PosShift) QueryWarehouse() *WarehouseQuery {
+	return NewPosShiftClient(_m.config).QueryWarehouse(_m)
+}
+
+// QueryTransactions queries the "transactions" edge of the PosShift entity.
+func (_m *PosShift) QueryTransactions() *TransactionQuery {
+	return NewPosShiftClient(_m.config).QueryTransactions(_m)
+}
+
+// Update returns a builder for updating this PosShift.
+// Note that you need to call PosShift.Unwrap() before calling this method if this PosShift
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PosShift) Update() *PosShiftUpdateOne {
+	return NewPosShiftClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PosShift entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PosShift) Unwrap() *PosShift {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PosShift is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PosShift) String() string {
+	var builder strings.Builder
+	builder.WriteString("PosShift(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("cashier=")
+	builder.WriteString(_m.Cashier)
+	builder.WriteString(", ")
+	builder.WriteString("cashier_email=")
+	builder.WriteString(_m.CashierEmail)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("opening_float=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpeningFloat))
+	builder.WriteString(", ")
+	builder.WriteString("expected_cash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpectedCash))
+	builder.WriteString(", ")
+	if v := _m.CountedCash; v != nil {
+		builder.WriteString("counted_cash=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("over_short=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OverShort))
+	builder.WriteString(", ")
+	builder.WriteString("opened_at=")
+	builder.WriteString(_m.OpenedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PosShifts is a parsable slice of PosShift.
+type PosShifts []*PosShift
@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posshift
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the posshift type in the database.
+	Label = "pos_shift"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCashier holds the string denoting the cashier field in the database.
+	FieldCashier = "cashier"
+	// FieldCashierEmail holds the string denoting the cashier_email field in the database.
+	FieldCashierEmail = "cashier_email"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldOpeningFloat holds the string denoting the opening_float field in the database.
+	FieldOpeningFloat = "opening_float"
+	// FieldExpectedCash holds the string denoting the expected_cash field in the database.
+	FieldExpectedCash = "expected_cash"
+	// FieldCountedCash holds the string denoting the counted_cash field in the database.
+	FieldCountedCash = "counted_cash"
+	// FieldOverShort holds the string denoting the over_short field in the database.
+	FieldOverShort = "over_short"
+	// FieldOpenedAt holds the string denoting the opened_at field in the database.
+	FieldOpenedAt = "opened_at"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeWarehouse holds the string denoting the warehouse edge name in mutations.
+	EdgeWarehouse = "warehouse"
+	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
+	EdgeTransactions = "transactions"
+	// Table holds the table name of the posshift in the database.
+	Table = "pos_shifts"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "pos_shifts"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_pos_shifts"
+	// WarehouseTable is the table that holds the warehouse relation/edge.
+	WarehouseTable = "pos_shifts"
+	// WarehouseInverseTable is the table name for the Warehouse entity.
+	// It exists in this package in order to avoid circular dependency with the "warehouse" package.
+	WarehouseInverseTable = "warehouses"
+	// WarehouseColumn is the table column denoting the warehouse relation/edge.
+	WarehouseColumn = "warehouse_pos_shifts"
+	// TransactionsTable is the table that holds the transactions relation/edge.
+	TransactionsTable = "transactions"
+	// TransactionsInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionsInverseTable = "transactions"
+	// TransactionsColumn is the table column denoting the transactions relation/edge.
+	TransactionsColumn = "pos_shift_transactions"
+)
+
+// Columns holds all SQL columns for posshift fields.
+var Columns = []string{
+	FieldID,
+	FieldCashier,
+	FieldCashierEmail,
+	FieldStatus,
+	FieldOpeningFloat,
+	FieldExpectedCash,
+	FieldCountedCash,
+	FieldOverShort,
+	FieldOpenedAt,
+	FieldClosedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "pos_shifts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tenant_pos_shifts",
+	"warehouse_pos_shifts",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultOpeningFloat holds the default value on creation for the "opening_float" field.
+	DefaultOpeningFloat decimal.Decimal
+	// DefaultExpectedCash holds the default value on creation for the "expected_cash" field.
+	DefaultExpectedCash decimal.Decimal
+	// DefaultOverShort holds the default value on creation for the "over_short" field.
+	DefaultOverShort decimal.Decimal
+	// DefaultOpenedAt holds the default value on creation for the "opened_at" field.
+	DefaultOpenedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen   Status = "open"
+	StatusClosed Status = "closed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("posshift: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PosShift queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCashier orders the results by the cashier field.
+func ByCashier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCashier, opts...).ToFunc()
+}
+
+// ByCashierEmail orders the results by the cashier_email field.
+func ByCashierEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCashierEmail, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByOpeningFloat orders the results by the opening_float field.
+func ByOpeningFloat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpeningFloat, opts...).ToFunc()
+}
+
+// ByExpectedCash orders the results by the expected_cash field.
+func ByExpectedCash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedCash, opts...).ToFunc()
+}
+
+// ByCountedCash orders the results by the counted_cash field.
+func ByCountedCash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountedCash, opts...).ToFunc()
+}
+
+// ByOverShort orders the results by the over_short field.
+func ByOverShort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverShort, opts...).ToFunc()
+}
+
+// ByOpenedAt orders the results by the opened_at field.
+func ByOpenedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedAt, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByWarehouseField orders the results by warehouse field.
+func ByWarehouseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWarehouseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransactionsCount orders the results by transactions count.
+func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransactionsStep(), opts...)
+	}
+}
+
+// ByTransactions orders the results by transactions terms.
+func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newWarehouseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WarehouseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+	)
+}
+func newTransactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+	)
+}
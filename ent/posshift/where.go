@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posshift
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldID, id))
+}
+
+// Cashier applies equality check predicate on the "cashier" field. It's identical to CashierEQ.
+func Cashier(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldCashier, v))
+}
+
+// CashierEmail applies equality check predicate on the "cashier_email" field. It's identical to CashierEmailEQ.
+func CashierEmail(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldCashierEmail, v))
+}
+
+// OpeningFloat applies equality check predicate on the "opening_float" field. It's identical to OpeningFloatEQ.
+func OpeningFloat(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldOpeningFloat, v))
+}
+
+// ExpectedCash applies equality check predicate on the "expected_cash" field. It's identical to ExpectedCashEQ.
+func ExpectedCash(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldExpectedCash, v))
+}
+
+// CountedCash applies equality check predicate on the "counted_cash" field. It's identical to CountedCashEQ.
+func CountedCash(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldCountedCash, v))
+}
+
+// OverShort applies equality check predicate on the "over_short" field. It's identical to OverShortEQ.
+func OverShort(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldOverShort, v))
+}
+
+// OpenedAt applies equality check predicate on the "opened_at" field. It's identical to OpenedAtEQ.
+func OpenedAt(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// CashierEQ applies the EQ predicate on the "cashier" field.
+func CashierEQ(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldCashier, v))
+}
+
+// CashierNEQ applies the NEQ predicate on the "cashier" field.
+func CashierNEQ(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldCashier, v))
+}
+
+// CashierIn applies the In predicate on the "cashier" field.
+func CashierIn(vs ...string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldCashier, vs...))
+}
+
+// CashierNotIn applies the NotIn predicate on the "cashier" field.
+func CashierNotIn(vs ...string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldCashier, vs...))
+}
+
+// CashierGT applies the GT predicate on the "cashier" field.
+func CashierGT(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldCashier, v))
+}
+
+// CashierGTE applies the GTE predicate on the "cashier" field.
+func CashierGTE(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldCashier, v))
+}
+
+// CashierLT applies the LT predicate on the "cashier" field.
+func CashierLT(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldCashier, v))
+}
+
+// CashierLTE applies the LTE predicate on the "cashier" field.
+func CashierLTE(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldCashier, v))
+}
+
+// CashierContains applies the Contains predicate on the "cashier" field.
+func CashierContains(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldContains(FieldCashier, v))
+}
+
+// CashierHasPrefix applies the HasPrefix predicate on the "cashier" field.
+func CashierHasPrefix(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldHasPrefix(FieldCashier, v))
+}
+
+// CashierHasSuffix applies the HasSuffix predicate on the "cashier" field.
+func CashierHasSuffix(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldHasSuffix(FieldCashier, v))
+}
+
+// CashierEqualFold applies the EqualFold predicate on the "cashier" field.
+func CashierEqualFold(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEqualFold(FieldCashier, v))
+}
+
+// CashierContainsFold applies the ContainsFold predicate on the "cashier" field.
+func CashierContainsFold(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldContainsFold(FieldCashier, v))
+}
+
+// CashierEmailEQ applies the EQ predicate on the "cashier_email" field.
+func CashierEmailEQ(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldCashierEmail, v))
+}
+
+// CashierEmailNEQ applies the NEQ predicate on the "cashier_email" field.
+func CashierEmailNEQ(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldCashierEmail, v))
+}
+
+// CashierEmailIn applies the In predicate on the "cashier_email" field.
+func CashierEmailIn(vs ...string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldCashierEmail, vs...))
+}
+
+// CashierEmailNotIn applies the NotIn predicate on the "cashier_email" field.
+func CashierEmailNotIn(vs ...string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldCashierEmail, vs...))
+}
+
+// CashierEmailGT applies the GT predicate on the "cashier_email" field.
+func CashierEmailGT(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldCashierEmail, v))
+}
+
+// CashierEmailGTE applies the GTE predicate on the "cashier_email" field.
+func CashierEmailGTE(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldCashierEmail, v))
+}
+
+// CashierEmailLT applies the LT predicate on the "cashier_email" field.
+func CashierEmailLT(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldCashierEmail, v))
+}
+
+// CashierEmailLTE applies the LTE predicate on the "cashier_email" field.
+func CashierEmailLTE(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldCashierEmail, v))
+}
+
+// CashierEmailContains applies the Contains predicate on the "cashier_email" field.
+func CashierEmailContains(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldContains(FieldCashierEmail, v))
+}
+
+// CashierEmailHasPrefix applies the HasPrefix predicate on the "cashier_email" field.
+func CashierEmailHasPrefix(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldHasPrefix(FieldCashierEmail, v))
+}
+
+// CashierEmailHasSuffix applies the HasSuffix predicate on the "cashier_email" field.
+func CashierEmailHasSuffix(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldHasSuffix(FieldCashierEmail, v))
+}
+
+// CashierEmailIsNil applies the IsNil predicate on the "cashier_email" field.
+func CashierEmailIsNil() predicate.PosShift {
+	return predicate.PosShift(sql.FieldIsNull(FieldCashierEmail))
+}
+
+// CashierEmailNotNil applies the NotNil predicate on the "cashier_email" field.
+func CashierEmailNotNil() predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotNull(FieldCashierEmail))
+}
+
+// CashierEmailEqualFold applies the EqualFold predicate on the "cashier_email" field.
+func CashierEmailEqualFold(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEqualFold(FieldCashierEmail, v))
+}
+
+// CashierEmailContainsFold applies the ContainsFold predicate on the "cashier_email" field.
+func CashierEmailContainsFold(v string) predicate.PosShift {
+	return predicate.PosShift(sql.FieldContainsFold(FieldCashierEmail, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// OpeningFloatEQ applies the EQ predicate on the "opening_float" field.
+func OpeningFloatEQ(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldOpeningFloat, v))
+}
+
+// OpeningFloatNEQ applies the NEQ predicate on the "opening_float" field.
+func OpeningFloatNEQ(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldOpeningFloat, v))
+}
+
+// OpeningFloatIn applies the In predicate on the "opening_float" field.
+func OpeningFloatIn(vs ...decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldOpeningFloat, vs...))
+}
+
+// OpeningFloatNotIn applies the NotIn predicate on the "opening_float" field.
+func OpeningFloatNotIn(vs ...decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldOpeningFloat, vs...))
+}
+
+// OpeningFloatGT applies the GT predicate on the "opening_float" field.
+func OpeningFloatGT(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldOpeningFloat, v))
+}
+
+// OpeningFloatGTE applies the GTE predicate on the "opening_float" field.
+func OpeningFloatGTE(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldOpeningFloat, v))
+}
+
+// OpeningFloatLT applies the LT predicate on the "opening_float" field.
+func OpeningFloatLT(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldOpeningFloat, v))
+}
+
+// OpeningFloatLTE applies the LTE predicate on the "opening_float" field.
+func OpeningFloatLTE(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldOpeningFloat, v))
+}
+
+// ExpectedCashEQ applies the EQ predicate on the "expected_cash" field.
+func ExpectedCashEQ(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldExpectedCash, v))
+}
+
+// ExpectedCashNEQ applies the NEQ predicate on the "expected_cash" field.
+func ExpectedCashNEQ(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldExpectedCash, v))
+}
+
+// ExpectedCashIn applies the In predicate on the "expected_cash" field.
+func ExpectedCashIn(vs ...decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldExpectedCash, vs...))
+}
+
+// ExpectedCashNotIn applies the NotIn predicate on the "expected_cash" field.
+func ExpectedCashNotIn(vs ...decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldExpectedCash, vs...))
+}
+
+// ExpectedCashGT applies the GT predicate on the "expected_cash" field.
+func ExpectedCashGT(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldExpectedCash, v))
+}
+
+// ExpectedCashGTE applies the GTE predicate on the "expected_cash" field.
+func ExpectedCashGTE(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldExpectedCash, v))
+}
+
+// ExpectedCashLT applies the LT predicate on the "expected_cash" field.
+func ExpectedCashLT(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldExpectedCash, v))
+}
+
+// ExpectedCashLTE applies the LTE predicate on the "expected_cash" field.
+func ExpectedCashLTE(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldExpectedCash, v))
+}
+
+// CountedCashEQ applies the EQ predicate on the "counted_cash" field.
+func CountedCashEQ(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldCountedCash, v))
+}
+
+// CountedCashNEQ applies the NEQ predicate on the "counted_cash" field.
+func CountedCashNEQ(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldCountedCash, v))
+}
+
+// CountedCashIn applies the In predicate on the "counted_cash" field.
+func CountedCashIn(vs ...decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldCountedCash, vs...))
+}
+
+// CountedCashNotIn applies the NotIn predicate on the "counted_cash" field.
+func CountedCashNotIn(vs ...decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldCountedCash, vs...))
+}
+
+// CountedCashGT applies the GT predicate on the "counted_cash" field.
+func CountedCashGT(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldCountedCash, v))
+}
+
+// CountedCashGTE applies the GTE predicate on the "counted_cash" field.
+func CountedCashGTE(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldCountedCash, v))
+}
+
+// CountedCashLT applies the LT predicate on the "counted_cash" field.
+func CountedCashLT(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldCountedCash, v))
+}
+
+// CountedCashLTE applies the LTE predicate on the "counted_cash" field.
+func CountedCashLTE(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldCountedCash, v))
+}
+
+// CountedCashIsNil applies the IsNil predicate on the "counted_cash" field.
+func CountedCashIsNil() predicate.PosShift {
+	return predicate.PosShift(sql.FieldIsNull(FieldCountedCash))
+}
+
+// CountedCashNotNil applies the NotNil predicate on the "counted_cash" field.
+func CountedCashNotNil() predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotNull(FieldCountedCash))
+}
+
+// OverShortEQ applies the EQ predicate on the "over_short" field.
+func OverShortEQ(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldOverShort, v))
+}
+
+// OverShortNEQ applies the NEQ predicate on the "over_short" field.
+func OverShortNEQ(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldOverShort, v))
+}
+
+// OverShortIn applies the In predicate on the "over_short" field.
+func OverShortIn(vs ...decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldOverShort, vs...))
+}
+
+// OverShortNotIn applies the NotIn predicate on the "over_short" field.
+func OverShortNotIn(vs ...decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldOverShort, vs...))
+}
+
+// OverShortGT applies the GT predicate on the "over_short" field.
+func OverShortGT(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldOverShort, v))
+}
+
+// OverShortGTE applies the GTE predicate on the "over_short" field.
+func OverShortGTE(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldOverShort, v))
+}
+
+// OverShortLT applies the LT predicate on the "over_short" field.
+func OverShortLT(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldOverShort, v))
+}
+
+// OverShortLTE applies the LTE predicate on the "over_short" field.
+func OverShortLTE(v decimal.Decimal) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldOverShort, v))
+}
+
+// OpenedAtEQ applies the EQ predicate on the "opened_at" field.
+func OpenedAtEQ(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtNEQ applies the NEQ predicate on the "opened_at" field.
+func OpenedAtNEQ(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtIn applies the In predicate on the "opened_at" field.
+func OpenedAtIn(vs ...time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtNotIn applies the NotIn predicate on the "opened_at" field.
+func OpenedAtNotIn(vs ...time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtGT applies the GT predicate on the "opened_at" field.
+func OpenedAtGT(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldOpenedAt, v))
+}
+
+// OpenedAtGTE applies the GTE predicate on the "opened_at" field.
+func OpenedAtGTE(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldOpenedAt, v))
+}
+
+// OpenedAtLT applies the LT predicate on the "opened_at" field.
+func OpenedAtLT(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldOpenedAt, v))
+}
+
+// OpenedAtLTE applies the LTE predicate on the "opened_at" field.
+func OpenedAtLTE(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldOpenedAt, v))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.PosShift {
+	return predicate.PosShift(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.PosShift {
+	return predicate.PosShift(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.PosShift {
+	return predicate.PosShift(sql.FieldNotNull(FieldClosedAt))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.PosShift {
+	return predicate.PosShift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.PosShift {
+	return predicate.PosShift(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWarehouse applies the HasEdge predicate on the "warehouse" edge.
+func HasWarehouse() predicate.PosShift {
+	return predicate.PosShift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarehouseWith applies the HasEdge predicate on the "warehouse" edge with a given conditions (other predicates).
+func HasWarehouseWith(preds ...predicate.Warehouse) predicate.PosShift {
+	return predicate.PosShift(func(s *sql.Selector) {
+		step := newWarehouseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransactions applies the HasEdge predicate on the "transactions" edge.
+func HasTransactions() predicate.PosShift {
+	return predicate.PosShift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionsWith applies the HasEdge predicate on the "transactions" edge with a given conditions (other predicates).
+func HasTransactionsWith(preds ...predicate.Transaction) predicate.PosShift {
+	return predicate.PosShift(func(s *sql.Selector) {
+		step := newTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PosShift) predicate.PosShift {
+	return predicate.PosShift(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PosShift) predicate.PosShift {
+	return predicate.PosShift(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PosShift) predicate.PosShift {
+	return predicate.PosShift(sql.NotPredicates(p))
+}
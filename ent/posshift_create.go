@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/posshift"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/warehouse"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PosShiftCreate is the builder for creating a PosShift entity.
+type PosShiftCreate struct {
+	config
+	mutation *PosShiftMutation
+	hooks    []Hook
+}
+
+// SetCashier sets the "cashier" field.
+func (_c *PosShiftCreate) SetCashier(v string) *PosShiftCreate {
+	_c.mutation.SetCashier(v)
+	return _c
+}
+
+// SetCashierEmail sets the "cashier_email" field.
+func (_c *PosShiftCreate) SetCashierEmail(v string) *PosShiftCreate {
+	_c.mutation.SetCashierEmail(v)
+	return _c
+}
+
+// SetNillableCashierEmail sets the "cashier_email" field if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableCashierEmail(v *string) *PosShiftCreate {
+	if v != nil {
+		_c.SetCashierEmail(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PosShiftCreate) SetStatus(v posshift.Status) *PosShiftCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableStatus(v *posshift.Status) *PosShiftCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetOpeningFloat sets the "opening_float" field.
+func (_c *PosShiftCreate) SetOpeningFloat(v decimal.Decimal) *PosShiftCreate {
+	_c.mutation.SetOpeningFloat(v)
+	return _c
+}
+
+// SetNillableOpeningFloat sets the "opening_float" field if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableOpeningFloat(v *decimal.Decimal) *PosShiftCreate {
+	if v != nil {
+		_c.SetOpeningFloat(*v)
+	}
+	return _c
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_c *PosShiftCreate) SetExpectedCash(v decimal.Decimal) *PosShiftCreate {
+	_c.mutation.SetExpectedCash(v)
+	return _c
+}
+
+// SetNillableExpectedCash sets the "expected_cash" field if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableExpectedCash(v *decimal.Decimal) *PosShiftCreate {
+	if v != nil {
+		_c.SetExpectedCash(*v)
+	}
+	return _c
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_c *PosShiftCreate) SetCountedCash(v decimal.Decimal) *PosShiftCreate {
+	_c.mutation.SetCountedCash(v)
+	return _c
+}
+
+// SetNillableCountedCash sets the "counted_cash" field if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableCountedCash(v *decimal.Decimal) *PosShiftCreate {
+	if v != nil {
+		_c.SetCountedCash(*v)
+	}
+	return _c
+}
+
+// SetOverShort sets the "over_short" field.
+func (_c *PosShiftCreate) SetOverShort(v decimal.Decimal) *PosShiftCreate {
+	_c.mutation.SetOverShort(v)
+	return _c
+}
+
+// SetNillableOverShort sets the "over_short" field if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableOverShort(v *decimal.Decimal) *PosShiftCreate {
+	if v != nil {
+		_c.SetOverShort(*v)
+	}
+	return _c
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_c *PosShiftCreate) SetOpenedAt(v time.Time) *PosShiftCreate {
+	_c.mutation.SetOpenedAt(v)
+	return _c
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableOpenedAt(v *time.Time) *PosShiftCreate {
+	if v != nil {
+		_c.SetOpenedAt(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *PosShiftCreate) SetClosedAt(v time.Time) *PosShiftCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableClosedAt(v *time.Time) *PosShiftCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *PosShiftCreate) SetTenantID(id int) *PosShiftCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *PosShiftCreate) SetTenant(v *Tenant) *PosShiftCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_c *PosShiftCreate) SetWarehouseID(id int) *PosShiftCreate {
+	_c.mutation.SetWarehouseID(id)
+	return _c
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_c *PosShiftCreate) SetNillableWarehouseID(id *int) *PosShiftCreate {
+	if id != nil {
+		_c = _c.SetWarehouseID(*id)
+	}
+	return _c
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_c *PosShiftCreate) SetWarehouse(v *Warehouse) *PosShiftCreate {
+	return _c.SetWarehouseID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_c *PosShiftCreate) AddTransactionIDs(ids ...int) *PosShiftCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_c *PosShiftCreate) AddTransactions(v ...*Transaction) *PosShiftCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the PosShiftMutation object of the builder.
+func (_c *PosShiftCreate) Mutation() *PosShiftMutation {
+	return _c.mutation
+}
+
+// Save creates the PosShift in the database.
+func (_c *PosShiftCreate) Save(ctx context.Context) (*PosShift, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PosShiftCreate) SaveX(ctx context.Context) *PosShift {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PosShiftCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PosShiftCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PosShiftCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := posshift.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.OpeningFloat(); !ok {
+		v := posshift.DefaultOpeningFloat
+		_c.mutation.SetOpeningFloat(v)
+	}
+	if _, ok := _c.mutation.ExpectedCash(); !ok {
+		v := posshift.DefaultExpectedCash
+		_c.mutation.SetExpectedCash(v)
+	}
+	if _, ok := _c.mutation.OverShort(); !ok {
+		v := posshift.DefaultOverShort
+		_c.mutation.SetOverShort(v)
+	}
+	if _, ok := _c.mutation.OpenedAt(); !ok {
+		v := posshift.DefaultOpenedAt()
+		_c.mutation.SetOpenedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PosShiftCreate) check() error {
+	if _, ok := _c.mutation.Cashier(); !ok {
+		return &ValidationError{Name: "cashier", err: errors.New(`ent: missing required field "PosShift.cashier"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PosShift.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := posshift.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PosShift.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OpeningFloat(); !ok {
+		return &ValidationError{Name: "opening_float", err: errors.New(`ent: missing required field "PosShift.opening_float"`)}
+	}
+	if _, ok := _c.mutation.ExpectedCash(); !ok {
+		return &ValidationError{Name: "expected_cash", err: errors.New(`ent: missing required field "PosShift.expected_cash"`)}
+	}
+	if _, ok := _c.mutation.OverShort(); !ok {
+		return &ValidationError{Name: "over_short", err: errors.New(`ent: missing required field "PosShift.over_short"`)}
+	}
+	if _, ok := _c.mutation.OpenedAt(); !ok {
+		return &ValidationError{Name: "opened_at", err: errors.New(`ent: missing required field "PosShift.opened_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "PosShift.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *PosShiftCreate) sqlSave(ctx context.Context) (*PosShift, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PosShiftCreate) createSpec() (*PosShift, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PosShift{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(posshift.Table, sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Cashier(); ok {
+		_spec.SetField(posshift.FieldCashier, field.TypeString, value)
+		_node.Cashier = value
+	}
+	if value, ok := _c.mutation.CashierEmail(); ok {
+		_spec.SetField(posshift.FieldCashierEmail, field.TypeString, value)
+		_node.CashierEmail = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(posshift.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.OpeningFloat(); ok {
+		_spec.SetField(posshift.FieldOpeningFloat, field.TypeOther, value)
+		_node.OpeningFloat = value
+	}
+	if value, ok := _c.mutation.ExpectedCash(); ok {
+		_spec.SetField(posshift.FieldExpectedCash, field.TypeOther, value)
+		_node.ExpectedCash = value
+	}
+	if value, ok := _c.mutation.CountedCash(); ok {
+		_spec.SetField(posshift.FieldCountedCash, field.TypeOther, value)
+		_node.CountedCash = &value
+	}
+	if value, ok := _c.mutation.OverShort(); ok {
+		_spec.SetField(posshift.FieldOverShort, field.TypeOther, value)
+		_node.OverShort = value
+	}
+	if value, ok := _c.mutation.OpenedAt(); ok {
+		_spec.SetField(posshift.FieldOpenedAt, field.TypeTime, value)
+		_node.OpenedAt = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(posshift.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.TenantTable,
+			Columns: []string{posshift.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_pos_shifts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.WarehouseTable,
+			Columns: []string{posshift.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_pos_shifts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posshift.TransactionsTable,
+			Columns: []string{posshift.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PosShiftCreateBulk is the builder for creating many PosShift entities in bulk.
+type PosShiftCreateBulk struct {
+	config
+	err      error
+	builders []*PosShiftCreate
+}
+
+// Save creates the PosShift entities in the database.
+func (_c *PosShiftCreateBulk) Save(ctx context.Context) ([]*PosShift, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PosShift, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PosShiftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PosShiftCreateBulk) SaveX(ctx context.Context) []*PosShift {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PosShiftCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PosShiftCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
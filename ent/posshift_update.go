@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/posshift"
+	"sent/ent/predicate"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/warehouse"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PosShiftUpdate is the builder for updating PosShift entities.
+type PosShiftUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PosShiftMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PosShiftUpdate builder.
+func (_u *PosShiftUpdate) Where(ps ...predicate.PosShift) *PosShiftUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCashier sets the "cashier" field.
+func (_u *PosShiftUpdate) SetCashier(v string) *PosShiftUpdate {
+	_u.mutation.SetCashier(v)
+	return _u
+}
+
+// SetNillableCashier sets the "cashier" field if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableCashier(v *string) *PosShiftUpdate {
+	if v != nil {
+		_u.SetCashier(*v)
+	}
+	return _u
+}
+
+// SetCashierEmail sets the "cashier_email" field.
+func (_u *PosShiftUpdate) SetCashierEmail(v string) *PosShiftUpdate {
+	_u.mutation.SetCashierEmail(v)
+	return _u
+}
+
+// SetNillableCashierEmail sets the "cashier_email" field if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableCashierEmail(v *string) *PosShiftUpdate {
+	if v != nil {
+		_u.SetCashierEmail(*v)
+	}
+	return _u
+}
+
+// ClearCashierEmail clears the value of the "cashier_email" field.
+func (_u *PosShiftUpdate) ClearCashierEmail() *PosShiftUpdate {
+	_u.mutation.ClearCashierEmail()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PosShiftUpdate) SetStatus(v posshift.Status) *PosShiftUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableStatus(v *posshift.Status) *PosShiftUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetOpeningFloat sets the "opening_float" field.
+func (_u *PosShiftUpdate) SetOpeningFloat(v decimal.Decimal) *PosShiftUpdate {
+	_u.mutation.SetOpeningFloat(v)
+	return _u
+}
+
+// SetNillableOpeningFloat sets the "opening_float" field if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableOpeningFloat(v *decimal.Decimal) *PosShiftUpdate {
+	if v != nil {
+		_u.SetOpeningFloat(*v)
+	}
+	return _u
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_u *PosShiftUpdate) SetExpectedCash(v decimal.Decimal) *PosShiftUpdate {
+	_u.mutation.SetExpectedCash(v)
+	return _u
+}
+
+// SetNillableExpectedCash sets the "expected_cash" field if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableExpectedCash(v *decimal.Decimal) *PosShiftUpdate {
+	if v != nil {
+		_u.SetExpectedCash(*v)
+	}
+	return _u
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_u *PosShiftUpdate) SetCountedCash(v decimal.Decimal) *PosShiftUpdate {
+	_u.mutation.SetCountedCash(v)
+	return _u
+}
+
+// SetNillableCountedCash sets the "counted_cash" field if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableCountedCash(v *decimal.Decimal) *PosShiftUpdate {
+	if v != nil {
+		_u.SetCountedCash(*v)
+	}
+	return _u
+}
+
+// ClearCountedCash clears the value of the "counted_cash" field.
+func (_u *PosShiftUpdate) ClearCountedCash() *PosShiftUpdate {
+	_u.mutation.ClearCountedCash()
+	return _u
+}
+
+// SetOverShort sets the "over_short" field.
+func (_u *PosShiftUpdate) SetOverShort(v decimal.Decimal) *PosShiftUpdate {
+	_u.mutation.SetOverShort(v)
+	return _u
+}
+
+// SetNillableOverShort sets the "over_short" field if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableOverShort(v *decimal.Decimal) *PosShiftUpdate {
+	if v != nil {
+		_u.SetOverShort(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *PosShiftUpdate) SetClosedAt(v time.Time) *PosShiftUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableClosedAt(v *time.Time) *PosShiftUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *PosShiftUpdate) ClearClosedAt() *PosShiftUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *PosShiftUpdate) SetTenantID(id int) *PosShiftUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *PosShiftUpdate) SetTenant(v *Tenant) *PosShiftUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *PosShiftUpdate) SetWarehouseID(id int) *PosShiftUpdate {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *PosShiftUpdate) SetNillableWarehouseID(id *int) *PosShiftUpdate {
+	if id != nil {
+		_u = _u.SetWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *PosShiftUpdate) SetWarehouse(v *Warehouse) *PosShiftUpdate {
+	return _u.SetWarehouseID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *PosShiftUpdate) AddTransactionIDs(ids ...int) *PosShiftUpdate {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *PosShiftUpdate) AddTransactions(v ...*Transaction) *PosShiftUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the PosShiftMutation object of the builder.
+func (_u *PosShiftUpdate) Mutation() *PosShiftMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *PosShiftUpdate) ClearTenant() *PosShiftUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *PosShiftUpdate) ClearWarehouse() *PosShiftUpdate {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *PosShiftUpdate) ClearTransactions() *PosShiftUpdate {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *PosShiftUpdate) RemoveTransactionIDs(ids ...int) *PosShiftUpdate {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *PosShiftUpdate) RemoveTransactions(v ...*Transaction) *PosShiftUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PosShiftUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PosShiftUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PosShiftUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PosShiftUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PosShiftUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := posshift.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PosShift.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosShift.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PosShiftUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosShiftUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PosShiftUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posshift.Table, posshift.Columns, sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Cashier(); ok {
+		_spec.SetField(posshift.FieldCashier, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CashierEmail(); ok {
+		_spec.SetField(posshift.FieldCashierEmail, field.TypeString, value)
+	}
+	if _u.mutation.CashierEmailCleared() {
+		_spec.ClearField(posshift.FieldCashierEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(posshift.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OpeningFloat(); ok {
+		_spec.SetField(posshift.FieldOpeningFloat, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExpectedCash(); ok {
+		_spec.SetField(posshift.FieldExpectedCash, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CountedCash(); ok {
+		_spec.SetField(posshift.FieldCountedCash, field.TypeOther, value)
+	}
+	if _u.mutation.CountedCashCleared() {
+		_spec.ClearField(posshift.FieldCountedCash, field.TypeOther)
+	}
+	if value, ok := _u.mutation.OverShort(); ok {
+		_spec.SetField(posshift.FieldOverShort, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(posshift.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(posshift.FieldClosedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.TenantTable,
+			Columns: []string{posshift.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.TenantTable,
+			Columns: []string{posshift.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.WarehouseTable,
+			Columns: []string{posshift.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.WarehouseTable,
+			Columns: []string{posshift.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posshift.TransactionsTable,
+			Columns: []string{posshift.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posshift.TransactionsTable,
+			Columns: []string{posshift.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posshift.TransactionsTable,
+			Columns: []string{posshift.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posshift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PosShiftUpdateOne is the builder for updating a single PosShift entity.
+type PosShiftUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PosShiftMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCashier sets the "cashier" field.
+func (_u *PosShiftUpdateOne) SetCashier(v string) *PosShiftUpdateOne {
+	_u.mutation.SetCashier(v)
+	return _u
+}
+
+// SetNillableCashier sets the "cashier" field if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableCashier(v *string) *PosShiftUpdateOne {
+	if v != nil {
+		_u.SetCashier(*v)
+	}
+	return _u
+}
+
+// SetCashierEmail sets the "cashier_email" field.
+func (_u *PosShiftUpdateOne) SetCashierEmail(v string) *PosShiftUpdateOne {
+	_u.mutation.SetCashierEmail(v)
+	return _u
+}
+
+// SetNillableCashierEmail sets the "cashier_email" field if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableCashierEmail(v *string) *PosShiftUpdateOne {
+	if v != nil {
+		_u.SetCashierEmail(*v)
+	}
+	return _u
+}
+
+// ClearCashierEmail clears the value of the "cashier_email" field.
+func (_u *PosShiftUpdateOne) ClearCashierEmail() *PosShiftUpdateOne {
+	_u.mutation.ClearCashierEmail()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PosShiftUpdateOne) SetStatus(v posshift.Status) *PosShiftUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableStatus(v *posshift.Status) *PosShiftUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetOpeningFloat sets the "opening_float" field.
+func (_u *PosShiftUpdateOne) SetOpeningFloat(v decimal.Decimal) *PosShiftUpdateOne {
+	_u.mutation.SetOpeningFloat(v)
+	return _u
+}
+
+// SetNillableOpeningFloat sets the "opening_float" field if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableOpeningFloat(v *decimal.Decimal) *PosShiftUpdateOne {
+	if v != nil {
+		_u.SetOpeningFloat(*v)
+	}
+	return _u
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_u *PosShiftUpdateOne) SetExpectedCash(v decimal.Decimal) *PosShiftUpdateOne {
+	_u.mutation.SetExpectedCash(v)
+	return _u
+}
+
+// SetNillableExpectedCash sets the "expected_cash" field if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableExpectedCash(v *decimal.Decimal) *PosShiftUpdateOne {
+	if v != nil {
+		_u.SetExpectedCash(*v)
+	}
+	return _u
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_u *PosShiftUpdateOne) SetCountedCash(v decimal.Decimal) *PosShiftUpdateOne {
+	_u.mutation.SetCountedCash(v)
+	return _u
+}
+
+// SetNillableCountedCash sets the "counted_cash" field if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableCountedCash(v *decimal.Decimal) *PosShiftUpdateOne {
+	if v != nil {
+		_u.SetCountedCash(*v)
+	}
+	return _u
+}
+
+// ClearCountedCash clears the value of the "counted_cash" field.
+func (_u *PosShiftUpdateOne) ClearCountedCash() *PosShiftUpdateOne {
+	_u.mutation.ClearCountedCash()
+	return _u
+}
+
+// SetOverShort sets the "over_short" field.
+func (_u *PosShiftUpdateOne) SetOverShort(v decimal.Decimal) *PosShiftUpdateOne {
+	_u.mutation.SetOverShort(v)
+	return _u
+}
+
+// SetNillableOverShort sets the "over_short" field if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableOverShort(v *decimal.Decimal) *PosShiftUpdateOne {
+	if v != nil {
+		_u.SetOverShort(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *PosShiftUpdateOne) SetClosedAt(v time.Time) *PosShiftUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableClosedAt(v *time.Time) *PosShiftUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *PosShiftUpdateOne) ClearClosedAt() *PosShiftUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *PosShiftUpdateOne) SetTenantID(id int) *PosShiftUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *PosShiftUpdateOne) SetTenant(v *Tenant) *PosShiftUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *PosShiftUpdateOne) SetWarehouseID(id int) *PosShiftUpdateOne {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *PosShiftUpdateOne) SetNillableWarehouseID(id *int) *PosShiftUpdateOne {
+	if id != nil {
+		_u = _u.SetWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *PosShiftUpdateOne) SetWarehouse(v *Warehouse) *PosShiftUpdateOne {
+	return _u.SetWarehouseID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *PosShiftUpdateOne) AddTransactionIDs(ids ...int) *PosShiftUpdateOne {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *PosShiftUpdateOne) AddTransactions(v ...*Transaction) *PosShiftUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the PosShiftMutation object of the builder.
+func (_u *PosShiftUpdateOne) Mutation() *PosShiftMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *PosShiftUpdateOne) ClearTenant() *PosShiftUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *PosShiftUpdateOne) ClearWarehouse() *PosShiftUpdateOne {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *PosShiftUpdateOne) ClearTransactions() *PosShiftUpdateOne {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *PosShiftUpdateOne) RemoveTransactionIDs(ids ...int) *PosShiftUpdateOne {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *PosShiftUpdateOne) RemoveTransactions(v ...*Transaction) *PosShiftUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// Where appends a list predicates to the PosShiftUpdate builder.
+func (_u *PosShiftUpdateOne) Where(ps ...predicate.PosShift) *PosShiftUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PosShiftUpdateOne) Select(field string, fields ...string) *PosShiftUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PosShift entity.
+func (_u *PosShiftUpdateOne) Save(ctx context.Context) (*PosShift, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PosShiftUpdateOne) SaveX(ctx context.Context) *PosShift {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PosShiftUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PosShiftUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PosShiftUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := posshift.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PosShift.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosShift.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PosShiftUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosShiftUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PosShiftUpdateOne) sqlSave(ctx context.Context) (_node *PosShift, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posshift.Table, posshift.Columns, sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PosShift.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, posshift.FieldID)
+		for _, f := range fields {
+			if !posshift.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != posshift.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Cashier(); ok {
+		_spec.SetField(posshift.FieldCashier, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CashierEmail(); ok {
+		_spec.SetField(posshift.FieldCashierEmail, field.TypeString, value)
+	}
+	if _u.mutation.CashierEmailCleared() {
+		_spec.ClearField(posshift.FieldCashierEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(posshift.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OpeningFloat(); ok {
+		_spec.SetField(posshift.FieldOpeningFloat, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExpectedCash(); ok {
+		_spec.SetField(posshift.FieldExpectedCash, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CountedCash(); ok {
+		_spec.SetField(posshift.FieldCountedCash, field.TypeOther, value)
+	}
+	if _u.mutation.CountedCashCleared() {
+		_spec.ClearField(posshift.FieldCountedCash, field.TypeOther)
+	}
+	if value, ok := _u.mutation.OverShort(); ok {
+		_spec.SetField(posshift.FieldOverShort, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(posshift.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(posshift.FieldClosedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.TenantTable,
+			Columns: []string{posshift.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.TenantTable,
+			Columns: []string{posshift.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.WarehouseTable,
+			Columns: []string{posshift.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posshift.WarehouseTable,
+			Columns: []string{posshift.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posshift.TransactionsTable,
+			Columns: []string{posshift.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posshift.TransactionsTable,
+			Columns: []string{posshift.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posshift.TransactionsTable,
+			Columns: []string{posshift.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PosShift{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posshift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
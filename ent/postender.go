@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/postender"
+	"sent/ent/transaction"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// PosTender is the model entity for the PosTender schema.
+type PosTender struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PosTenderQuery when eager-loading is set.
+	Edges               PosTenderEdges `json:"edges"`
+	pos_tender_account  *int
+	transaction_tenders *int
+	selectValues        sql.SelectValues
+}
+
+// PosTenderEdges holds the relations/edges for other nodes in the graph.
+type PosTenderEdges struct {
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosTenderEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosTenderEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PosTender) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case postender.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case postender.FieldID:
+			values[i] = new(sql.NullInt64)
+		case postender.FieldMethod, postender.FieldReference:
+			values[i] = new(sql.NullString)
+		case postender.ForeignKeys[0]: // pos_tender_account
+			values[i] = new(sql.NullInt64)
+		case postender.ForeignKeys[1]: // transaction_tenders
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PosTender fields.
+func (_m *PosTender) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case postender.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case postender.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = value.String
+			}
+		case postender.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case postender.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case postender.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field pos_tender_account", value)
+			} else if value.Valid {
+				_m.pos_tender_account = new(int)
+				*_m.pos_tender_account = int(value.Int64)
+			}
+		case postender.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field transaction_tenders", value)
+			} else if value.Valid {
+				_m.transaction_tenders = new(int)
+				*_m.transaction_tenders = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PosTender.
+// This includes values selected through modifiers, order, etc.
+func (_m *PosTender) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTransaction queries the "transaction" edge of the PosTender entity.
+func (_m *PosTender) QueryTransaction() *TransactionQuery {
+	return NewPosTenderClient(_m.config).QueryTransaction(_m)
+}
+
+// QueryAccount queries the "account" edge of the PosTender entity.
+func (_m *PosTender) QueryAccount() *AccountQuery {
+	return NewPosTenderClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this PosTender.
+// Note that you need to call PosTender.Unwrap() before calling this method if this PosTender
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PosTender) Update() *PosTenderUpdateOne {
+	return NewPosTenderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PosTender entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PosTender) Unwrap() *PosTender {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PosTender is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PosTender) String() string {
+	var builder strings.Builder
+	builder.WriteString("PosTender(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("method=")
+	builder.WriteString(_m.Method)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PosTenders is a parsable slice of PosTender.
+type PosTenders []*PosTender
@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/postender"
+	"sent/ent/transaction"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PosTenderCreate is the builder for creating a PosTender entity.
+type PosTenderCreate struct {
+	config
+	mutation *PosTenderMutation
+	hooks    []Hook
+}
+
+// SetMethod sets the "method" field.
+func (_c *PosTenderCreate) SetMethod(v string) *PosTenderCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PosTenderCreate) SetAmount(v decimal.Decimal) *PosTenderCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *PosTenderCreate) SetNillableAmount(v *decimal.Decimal) *PosTenderCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *PosTenderCreate) SetReference(v string) *PosTenderCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *PosTenderCreate) SetNillableReference(v *string) *PosTenderCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *PosTenderCreate) SetTransactionID(id int) *PosTenderCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *PosTenderCreate) SetTransaction(v *Transaction) *PosTenderCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *PosTenderCreate) SetAccountID(id int) *PosTenderCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *PosTenderCreate) SetAccount(v *Account) *PosTenderCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the PosTenderMutation object of the builder.
+func (_c *PosTenderCreate) Mutation() *PosTenderMutation {
+	return _c.mutation
+}
+
+// Save creates the PosTender in the database.
+func (_c *PosTenderCreate) Save(ctx context.Context) (*PosTender, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PosTenderCreate) SaveX(ctx context.Context) *PosTender {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PosTenderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PosTenderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PosTenderCreate) defaults() {
+	if _, ok := _c.mutation.Amount(); !ok {
+		v := postender.DefaultAmount
+		_c.mutation.SetAmount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PosTenderCreate) check() error {
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "PosTender.method"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PosTender.amount"`)}
+	}
+	if len(_c.mutation.TransactionIDs()) == 0 {
+		return &ValidationError{Name: "transaction", err: errors.New(`ent: missing required edge "PosTender.transaction"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "PosTender.account"`)}
+	}
+	return nil
+}
+
+func (_c *PosTenderCreate) sqlSave(ctx context.Context) (*PosTender, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PosTenderCreate) createSpec() (*PosTender, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PosTender{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(postender.Table, sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(postender.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(postender.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(postender.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postender.TransactionTable,
+			Columns: []string{postender.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transaction_tenders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postender.AccountTable,
+			Columns: []string{postender.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pos_tender_account = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PosTenderCreateBulk is the builder for creating many PosTender entities in bulk.
+type PosTenderCreateBulk struct {
+	config
+	err      error
+	builders []*PosTenderCreate
+}
+
+// Save creates the PosTender entities in the database.
+func (_c *PosTenderCreateBulk) Save(ctx context.Context) ([]*PosTender, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PosTender, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PosTenderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PosTenderCreateBulk) SaveX(ctx context.Context) []*PosTender {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PosTenderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PosTenderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/postender"
+	"sent/ent/predicate"
+	"sent/ent/transaction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PosTenderUpdate is the builder for updating PosTender entities.
+type PosTenderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PosTenderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PosTenderUpdate builder.
+func (_u *PosTenderUpdate) Where(ps ...predicate.PosTender) *PosTenderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *PosTenderUpdate) SetMethod(v string) *PosTenderUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *PosTenderUpdate) SetNillableMethod(v *string) *PosTenderUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PosTenderUpdate) SetAmount(v decimal.Decimal) *PosTenderUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PosTenderUpdate) SetNillableAmount(v *decimal.Decimal) *PosTenderUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *PosTenderUpdate) SetReference(v string) *PosTenderUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *PosTenderUpdate) SetNillableReference(v *string) *PosTenderUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *PosTenderUpdate) ClearReference() *PosTenderUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *PosTenderUpdate) SetTransactionID(id int) *PosTenderUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *PosTenderUpdate) SetTransaction(v *Transaction) *PosTenderUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *PosTenderUpdate) SetAccountID(id int) *PosTenderUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *PosTenderUpdate) SetAccount(v *Account) *PosTenderUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the PosTenderMutation object of the builder.
+func (_u *PosTenderUpdate) Mutation() *PosTenderMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *PosTenderUpdate) ClearTransaction() *PosTenderUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *PosTenderUpdate) ClearAccount() *PosTenderUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PosTenderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PosTenderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PosTenderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PosTenderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PosTenderUpdate) check() error {
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosTender.transaction"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosTender.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PosTenderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosTenderUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PosTenderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postender.Table, postender.Columns, sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(postender.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(postender.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(postender.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(postender.FieldReference, field.TypeString)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postender.TransactionTable,
+			Columns: []string{postender.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postender.TransactionTable,
+			Columns: []string{postender.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postender.AccountTable,
+			Columns: []string{postender.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postender.AccountTable,
+			Columns: []string{postender.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postender.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PosTenderUpdateOne is the builder for updating a single PosTender entity.
+type PosTenderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PosTenderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMethod sets the "method" field.
+func (_u *PosTenderUpdateOne) SetMethod(v string) *PosTenderUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *PosTenderUpdateOne) SetNillableMethod(v *string) *PosTenderUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PosTenderUpdateOne) SetAmount(v decimal.Decimal) *PosTenderUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PosTenderUpdateOne) SetNillableAmount(v *decimal.Decimal) *PosTenderUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *PosTenderUpdateOne) SetReference(v string) *PosTenderUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *PosTenderUpdateOne) SetNillableReference(v *string) *PosTenderUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *PosTenderUpdateOne) ClearReference() *PosTenderUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *PosTenderUpdateOne) SetTransactionID(id int) *PosTenderUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *PosTenderUpdateOne) SetTransaction(v *Transaction) *PosTenderUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *PosTenderUpdateOne) SetAccountID(id int) *PosTenderUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *PosTenderUpdateOne) SetAccount(v *Account) *PosTenderUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the PosTenderMutation object of the builder.
+func (_u *PosTenderUpdateOne) Mutation() *PosTenderMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *PosTenderUpdateOne) ClearTransaction() *PosTenderUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *PosTenderUpdateOne) ClearAccount() *PosTenderUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the PosTenderUpdate builder.
+func (_u *PosTenderUpdateOne) Where(ps ...predicate.PosTender) *PosTenderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PosTenderUpdateOne) Select(field string, fields ...string) *PosTenderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PosTender entity.
+func (_u *PosTenderUpdateOne) Save(ctx context.Context) (*PosTender, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PosTenderUpdateOne) SaveX(ctx context.Context) *PosTender {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PosTenderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PosTenderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PosTenderUpdateOne) check() error {
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosTender.transaction"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosTender.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PosTenderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosTenderUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PosTenderUpdateOne) sqlSave(ctx context.Context) (_node *PosTender, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postender.Table, postender.Columns, sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PosTender.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postender.FieldID)
+		for _, f := range fields {
+			if !postender.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postender.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(postender.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(postender.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(postender.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(postender.FieldReference, field.TypeString)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postender.TransactionTable,
+			Columns: []string{postender.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postender.TransactionTable,
+			Columns: []string{postender.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postender.AccountTable,
+			Columns: []string{postender.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postender.AccountTable,
+			Columns: []string{postender.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PosTender{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postender.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
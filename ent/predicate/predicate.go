@@ -9,9 +9,30 @@ import (
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// AccountBalanceSnapshot is the predicate function for accountbalancesnapshot builders.
+type AccountBalanceSnapshot func(*sql.Selector)
+
 // Agent is the predicate function for agent builders.
 type Agent func(*sql.Selector)
 
+// AgentAlert is the predicate function for agentalert builders.
+type AgentAlert func(*sql.Selector)
+
+// AgentAuthority is the predicate function for agentauthority builders.
+type AgentAuthority func(*sql.Selector)
+
+// AgentEnrollmentToken is the predicate function for agentenrollmenttoken builders.
+type AgentEnrollmentToken func(*sql.Selector)
+
+// AgentInventory is the predicate function for agentinventory builders.
+type AgentInventory func(*sql.Selector)
+
+// AgentSoftware is the predicate function for agentsoftware builders.
+type AgentSoftware func(*sql.Selector)
+
+// AlertRule is the predicate function for alertrule builders.
+type AlertRule func(*sql.Selector)
+
 // Application is the predicate function for application builders.
 type Application func(*sql.Selector)
 
@@ -27,6 +48,15 @@ type AssetType func(*sql.Selector)
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// BankRule is the predicate function for bankrule builders.
+type BankRule func(*sql.Selector)
+
+// BankStatement is the predicate function for bankstatement builders.
+type BankStatement func(*sql.Selector)
+
+// BankStatementLine is the predicate function for bankstatementline builders.
+type BankStatementLine func(*sql.Selector)
+
 // BenefitEnrollment is the predicate function for benefitenrollment builders.
 type BenefitEnrollment func(*sql.Selector)
 
@@ -60,21 +90,48 @@ type Contract func(*sql.Selector)
 // Credential is the predicate function for credential builders.
 type Credential func(*sql.Selector)
 
+// Customer is the predicate function for customer builders.
+type Customer func(*sql.Selector)
+
+// CustomerPayment is the predicate function for customerpayment builders.
+type CustomerPayment func(*sql.Selector)
+
 // Department is the predicate function for department builders.
 type Department func(*sql.Selector)
 
 // DetectionEvent is the predicate function for detectionevent builders.
 type DetectionEvent func(*sql.Selector)
 
+// DeviceGroup is the predicate function for devicegroup builders.
+type DeviceGroup func(*sql.Selector)
+
 // DiscoveryEntry is the predicate function for discoveryentry builders.
 type DiscoveryEntry func(*sql.Selector)
 
+// EInvoice is the predicate function for einvoice builders.
+type EInvoice func(*sql.Selector)
+
+// EInvoiceCredential is the predicate function for einvoicecredential builders.
+type EInvoiceCredential func(*sql.Selector)
+
 // Employee is the predicate function for employee builders.
 type Employee func(*sql.Selector)
 
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
+// FiscalPeriod is the predicate function for fiscalperiod builders.
+type FiscalPeriod func(*sql.Selector)
+
 // Goal is the predicate function for goal builders.
 type Goal func(*sql.Selector)
 
+// GoodsReceipt is the predicate function for goodsreceipt builders.
+type GoodsReceipt func(*sql.Selector)
+
+// GoodsReceiptLine is the predicate function for goodsreceiptline builders.
+type GoodsReceiptLine func(*sql.Selector)
+
 // HealthScoreSnapshot is the predicate function for healthscoresnapshot builders.
 type HealthScoreSnapshot func(*sql.Selector)
 
@@ -90,6 +147,15 @@ type InventoryCount func(*sql.Selector)
 // InventoryReservation is the predicate function for inventoryreservation builders.
 type InventoryReservation func(*sql.Selector)
 
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
+// InvoiceLine is the predicate function for invoiceline builders.
+type InvoiceLine func(*sql.Selector)
+
+// InvoiceTaxLine is the predicate function for invoicetaxline builders.
+type InvoiceTaxLine func(*sql.Selector)
+
 // Job is the predicate function for job builders.
 type Job func(*sql.Selector)
 
@@ -102,6 +168,9 @@ type JobPosting func(*sql.Selector)
 // JournalEntry is the predicate function for journalentry builders.
 type JournalEntry func(*sql.Selector)
 
+// LabelTemplate is the predicate function for labeltemplate builders.
+type LabelTemplate func(*sql.Selector)
+
 // LedgerEntry is the predicate function for ledgerentry builders.
 type LedgerEntry func(*sql.Selector)
 
@@ -129,12 +198,33 @@ type NexusAudit func(*sql.Selector)
 // OneTimeLink is the predicate function for onetimelink builders.
 type OneTimeLink func(*sql.Selector)
 
+// PatchPolicy is the predicate function for patchpolicy builders.
+type PatchPolicy func(*sql.Selector)
+
+// PaymentAllocation is the predicate function for paymentallocation builders.
+type PaymentAllocation func(*sql.Selector)
+
+// PaymentRun is the predicate function for paymentrun builders.
+type PaymentRun func(*sql.Selector)
+
+// PaymentRunLine is the predicate function for paymentrunline builders.
+type PaymentRunLine func(*sql.Selector)
+
 // PerformanceReview is the predicate function for performancereview builders.
 type PerformanceReview func(*sql.Selector)
 
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
+// PosSaleLine is the predicate function for possaleline builders.
+type PosSaleLine func(*sql.Selector)
+
+// PosShift is the predicate function for posshift builders.
+type PosShift func(*sql.Selector)
+
+// PosTender is the predicate function for postender builders.
+type PosTender func(*sql.Selector)
+
 // Product is the predicate function for product builders.
 type Product func(*sql.Selector)
 
@@ -189,6 +279,9 @@ type StockAlert func(*sql.Selector)
 // StockAuditLog is the predicate function for stockauditlog builders.
 type StockAuditLog func(*sql.Selector)
 
+// StockLevel is the predicate function for stocklevel builders.
+type StockLevel func(*sql.Selector)
+
 // StockMovement is the predicate function for stockmovement builders.
 type StockMovement func(*sql.Selector)
 
@@ -201,6 +294,18 @@ type SuccessionMap func(*sql.Selector)
 // Supplier is the predicate function for supplier builders.
 type Supplier func(*sql.Selector)
 
+// SupplierBill is the predicate function for supplierbill builders.
+type SupplierBill func(*sql.Selector)
+
+// SupplierBillLine is the predicate function for supplierbillline builders.
+type SupplierBillLine func(*sql.Selector)
+
+// TaxJurisdiction is the predicate function for taxjurisdiction builders.
+type TaxJurisdiction func(*sql.Selector)
+
+// TaxRate is the predicate function for taxrate builders.
+type TaxRate func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
@@ -222,6 +327,12 @@ type TimeOffRequest func(*sql.Selector)
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
 
+// TransferOrder is the predicate function for transferorder builders.
+type TransferOrder func(*sql.Selector)
+
+// TransferOrderLine is the predicate function for transferorderline builders.
+type TransferOrderLine func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
@@ -44,14 +44,32 @@ type Product struct {
 	MinStockLevel int `json:"min_stock_level,omitempty"`
 	// MaxStockLevel holds the value of the "max_stock_level" field.
 	MaxStockLevel int `json:"max_stock_level,omitempty"`
+	// ReorderPoint holds the value of the "reorder_point" field.
+	ReorderPoint int `json:"reorder_point,omitempty"`
 	// Barcode holds the value of the "barcode" field.
 	Barcode string `json:"barcode,omitempty"`
 	// Location holds the value of the "location" field.
 	Location string `json:"location,omitempty"`
 	// IsVariantParent holds the value of the "is_variant_parent" field.
 	IsVariantParent bool `json:"is_variant_parent,omitempty"`
+	// Weight holds the value of the "weight" field.
+	Weight decimal.Decimal `json:"weight,omitempty"`
+	// SoldByWeight holds the value of the "sold_by_weight" field.
+	SoldByWeight bool `json:"sold_by_weight,omitempty"`
+	// PricePerKg holds the value of the "price_per_kg" field.
+	PricePerKg decimal.Decimal `json:"price_per_kg,omitempty"`
+	// TareWeight holds the value of the "tare_weight" field.
+	TareWeight decimal.Decimal `json:"tare_weight,omitempty"`
+	// Plu holds the value of the "plu" field.
+	Plu string `json:"plu,omitempty"`
+	// TaxCategory holds the value of the "tax_category" field.
+	TaxCategory product.TaxCategory `json:"tax_category,omitempty"`
 	// SerialNumber holds the value of the "serial_number" field.
 	SerialNumber string `json:"serial_number,omitempty"`
+	// TrackLots holds the value of the "track_lots" field.
+	TrackLots bool `json:"track_lots,omitempty"`
+	// ExpiryAlertDays holds the value of the "expiry_alert_days" field.
+	ExpiryAlertDays int `json:"expiry_alert_days,omitempty"`
 	// PurchaseDate holds the value of the "purchase_date" field.
 	PurchaseDate time.Time `json:"purchase_date,omitempty"`
 	// PurchasePrice holds the value of the "purchase_price" field.
@@ -105,9 +123,15 @@ type ProductEdges struct {
 	PurchaseOrderLines []*PurchaseOrderLine `json:"purchase_order_lines,omitempty"`
 	// InventoryCounts holds the value of the inventory_counts edge.
 	InventoryCounts []*InventoryCount `json:"inventory_counts,omitempty"`
+	// StockLevels holds the value of the stock_levels edge.
+	StockLevels []*StockLevel `json:"stock_levels,omitempty"`
+	// TransferLines holds the value of the transfer_lines edge.
+	TransferLines []*TransferOrderLine `json:"transfer_lines,omitempty"`
+	// PosSaleLines holds the value of the pos_sale_lines edge.
+	PosSaleLines []*PosSaleLine `json:"pos_sale_lines,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [13]bool
+	loadedTypes [16]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -237,6 +261,33 @@ func (e ProductEdges) InventoryCountsOrErr() ([]*InventoryCount, error) {
 	return nil, &NotLoadedError{edge: "inventory_counts"}
 }
 
+// StockLevelsOrErr returns the StockLevels value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) StockLevelsOrErr() ([]*StockLevel, error) {
+	if e.loadedTypes[13] {
+		return e.StockLevels, nil
+	}
+	return nil, &NotLoadedError{edge: "stock_levels"}
+}
+
+// TransferLinesOrErr returns the TransferLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) TransferLinesOrErr() ([]*TransferOrderLine, error) {
+	if e.loadedTypes[14] {
+		return e.TransferLines, nil
+	}
+	return nil, &NotLoadedError{edge: "transfer_lines"}
+}
+
+// PosSaleLinesOrErr returns the PosSaleLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) PosSaleLinesOrErr() ([]*PosSaleLine, error) {
+	if e.loadedTypes[15] {
+		return e.PosSaleLines, nil
+	}
+	return nil, &NotLoadedError{edge: "pos_sale_lines"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Product) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -244,13 +295,13 @@ func (*Product) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case product.FieldAttributes:
 			values[i] = new([]byte)
-		case product.FieldUnitCost, product.FieldQuantity, product.FieldPurchasePrice:
+		case product.FieldUnitCost, product.FieldQuantity, product.FieldWeight, product.FieldPricePerKg, product.FieldTareWeight, product.FieldPurchasePrice:
 			values[i] = new(decimal.Decimal)
-		case product.FieldIsVariantParent, product.FieldIsDisposed:
+		case product.FieldIsVariantParent, product.FieldSoldByWeight, product.FieldTrackLots, product.FieldIsDisposed:
 			values[i] = new(sql.NullBool)
-		case product.FieldID, product.FieldMinStockLevel, product.FieldMaxStockLevel, product.FieldUsefulLifeMonths:
+		case product.FieldID, product.FieldMinStockLevel, product.FieldMaxStockLevel, product.FieldReorderPoint, product.FieldExpiryAlertDays, product.FieldUsefulLifeMonths:
 			values[i] = new(sql.NullInt64)
-		case product.FieldSku, product.FieldName, product.FieldDescription, product.FieldBarcode, product.FieldLocation, product.FieldSerialNumber, product.FieldDisposalReason:
+		case product.FieldSku, product.FieldName, product.FieldDescription, product.FieldBarcode, product.FieldLocation, product.FieldPlu, product.FieldTaxCategory, product.FieldSerialNumber, product.FieldDisposalReason:
 			values[i] = new(sql.NullString)
 		case product.FieldCreatedAt, product.FieldUpdatedAt, product.FieldPurchaseDate, product.FieldWarrantyExpiresAt, product.FieldDisposalDate:
 			values[i] = new(sql.NullTime)
@@ -347,6 +398,12 @@ func (_m *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MaxStockLevel = int(value.Int64)
 			}
+		case product.FieldReorderPoint:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reorder_point", values[i])
+			} else if value.Valid {
+				_m.ReorderPoint = int(value.Int64)
+			}
 		case product.FieldBarcode:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field barcode", values[i])
@@ -365,12 +422,60 @@ func (_m *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsVariantParent = value.Bool
 			}
+		case product.FieldWeight:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value != nil {
+				_m.Weight = *value
+			}
+		case product.FieldSoldByWeight:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sold_by_weight", values[i])
+			} else if value.Valid {
+				_m.SoldByWeight = value.Bool
+			}
+		case product.FieldPricePerKg:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price_per_kg", values[i])
+			} else if value != nil {
+				_m.PricePerKg = *value
+			}
+		case product.FieldTareWeight:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tare_weight", values[i])
+			} else if value != nil {
+				_m.TareWeight = *value
+			}
+		case product.FieldPlu:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plu", values[i])
+			} else if value.Valid {
+				_m.Plu = value.String
+			}
+		case product.FieldTaxCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_category", values[i])
+			} else if value.Valid {
+				_m.TaxCategory = product.TaxCategory(value.String)
+			}
 		case product.FieldSerialNumber:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field serial_number", values[i])
 			} else if value.Valid {
 				_m.SerialNumber = value.String
 			}
+		case product.FieldTrackLots:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field track_lots", values[i])
+			} else if value.Valid {
+				_m.TrackLots = value.Bool
+			}
+		case product.FieldExpiryAlertDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_alert_days", values[i])
+			} else if value.Valid {
+				_m.ExpiryAlertDays = int(value.Int64)
+			}
 		case product.FieldPurchaseDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field purchase_date", values[i])
@@ -526,6 +631,21 @@ func (_m *Product) QueryInventoryCounts() *InventoryCountQuery {
 	return NewProductClient(_m.config).QueryInventoryCounts(_m)
 }
 
+// QueryStockLevels queries the "stock_levels" edge of the Product entity.
+func (_m *Product) QueryStockLevels() *StockLevelQuery {
+	return NewProductClient(_m.config).QueryStockLevels(_m)
+}
+
+// QueryTransferLines queries the "transfer_lines" edge of the Product entity.
+func (_m *Product) QueryTransferLines() *TransferOrderLineQuery {
+	return NewProductClient(_m.config).QueryTransferLines(_m)
+}
+
+// QueryPosSaleLines queries the "pos_sale_lines" edge of the Product entity.
+func (_m *Product) QueryPosSaleLines() *PosSaleLineQuery {
+	return NewProductClient(_m.config).QueryPosSaleLines(_m)
+}
+
 // Update returns a builder for updating this Product.
 // Note that you need to call Product.Unwrap() before calling this method if this Product
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -579,6 +699,9 @@ func (_m *Product) String() string {
 	builder.WriteString("max_stock_level=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MaxStockLevel))
 	builder.WriteString(", ")
+	builder.WriteString("reorder_point=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReorderPoint))
+	builder.WriteString(", ")
 	builder.WriteString("barcode=")
 	builder.WriteString(_m.Barcode)
 	builder.WriteString(", ")
@@ -588,9 +711,33 @@ func (_m *Product) String() string {
 	builder.WriteString("is_variant_parent=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsVariantParent))
 	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("sold_by_weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SoldByWeight))
+	builder.WriteString(", ")
+	builder.WriteString("price_per_kg=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PricePerKg))
+	builder.WriteString(", ")
+	builder.WriteString("tare_weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TareWeight))
+	builder.WriteString(", ")
+	builder.WriteString("plu=")
+	builder.WriteString(_m.Plu)
+	builder.WriteString(", ")
+	builder.WriteString("tax_category=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxCategory))
+	builder.WriteString(", ")
 	builder.WriteString("serial_number=")
 	builder.WriteString(_m.SerialNumber)
 	builder.WriteString(", ")
+	builder.WriteString("track_lots=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackLots))
+	builder.WriteString(", ")
+	builder.WriteString("expiry_alert_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpiryAlertDays))
+	builder.WriteString(", ")
 	builder.WriteString("purchase_date=")
 	builder.WriteString(_m.PurchaseDate.Format(time.ANSIC))
 	builder.WriteString(", ")
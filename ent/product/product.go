@@ -3,6 +3,7 @@
 package product
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -35,14 +36,32 @@ const (
 	FieldMinStockLevel = "min_stock_level"
 	// FieldMaxStockLevel holds the string denoting the max_stock_level field in the database.
 	FieldMaxStockLevel = "max_stock_level"
+	// FieldReorderPoint holds the string denoting the reorder_point field in the database.
+	FieldReorderPoint = "reorder_point"
 	// FieldBarcode holds the string denoting the barcode field in the database.
 	FieldBarcode = "barcode"
 	// FieldLocation holds the string denoting the location field in the database.
 	FieldLocation = "location"
 	// FieldIsVariantParent holds the string denoting the is_variant_parent field in the database.
 	FieldIsVariantParent = "is_variant_parent"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldSoldByWeight holds the string denoting the sold_by_weight field in the database.
+	FieldSoldByWeight = "sold_by_weight"
+	// FieldPricePerKg holds the string denoting the price_per_kg field in the database.
+	FieldPricePerKg = "price_per_kg"
+	// FieldTareWeight holds the string denoting the tare_weight field in the database.
+	FieldTareWeight = "tare_weight"
+	// FieldPlu holds the string denoting the plu field in the database.
+	FieldPlu = "plu"
+	// FieldTaxCategory holds the string denoting the tax_category field in the database.
+	FieldTaxCategory = "tax_category"
 	// FieldSerialNumber holds the string denoting the serial_number field in the database.
 	FieldSerialNumber = "serial_number"
+	// FieldTrackLots holds the string denoting the track_lots field in the database.
+	FieldTrackLots = "track_lots"
+	// FieldExpiryAlertDays holds the string denoting the expiry_alert_days field in the database.
+	FieldExpiryAlertDays = "expiry_alert_days"
 	// FieldPurchaseDate holds the string denoting the purchase_date field in the database.
 	FieldPurchaseDate = "purchase_date"
 	// FieldPurchasePrice holds the string denoting the purchase_price field in the database.
@@ -83,6 +102,12 @@ const (
 	EdgePurchaseOrderLines = "purchase_order_lines"
 	// EdgeInventoryCounts holds the string denoting the inventory_counts edge name in mutations.
 	EdgeInventoryCounts = "inventory_counts"
+	// EdgeStockLevels holds the string denoting the stock_levels edge name in mutations.
+	EdgeStockLevels = "stock_levels"
+	// EdgeTransferLines holds the string denoting the transfer_lines edge name in mutations.
+	EdgeTransferLines = "transfer_lines"
+	// EdgePosSaleLines holds the string denoting the pos_sale_lines edge name in mutations.
+	EdgePosSaleLines = "pos_sale_lines"
 	// Table holds the table name of the product in the database.
 	Table = "products"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -176,6 +201,27 @@ const (
 	InventoryCountsInverseTable = "inventory_counts"
 	// InventoryCountsColumn is the table column denoting the inventory_counts relation/edge.
 	InventoryCountsColumn = "product_inventory_counts"
+	// StockLevelsTable is the table that holds the stock_levels relation/edge.
+	StockLevelsTable = "stock_levels"
+	// StockLevelsInverseTable is the table name for the StockLevel entity.
+	// It exists in this package in order to avoid circular dependency with the "stocklevel" package.
+	StockLevelsInverseTable = "stock_levels"
+	// StockLevelsColumn is the table column denoting the stock_levels relation/edge.
+	StockLevelsColumn = "product_stock_levels"
+	// TransferLinesTable is the table that holds the transfer_lines relation/edge.
+	TransferLinesTable = "transfer_order_lines"
+	// TransferLinesInverseTable is the table name for the TransferOrderLine entity.
+	// It exists in this package in order to avoid circular dependency with the "transferorderline" package.
+	TransferLinesInverseTable = "transfer_order_lines"
+	// TransferLinesColumn is the table column denoting the transfer_lines relation/edge.
+	TransferLinesColumn = "product_transfer_lines"
+	// PosSaleLinesTable is the table that holds the pos_sale_lines relation/edge.
+	PosSaleLinesTable = "pos_sale_lines"
+	// PosSaleLinesInverseTable is the table name for the PosSaleLine entity.
+	// It exists in this package in order to avoid circular dependency with the "possaleline" package.
+	PosSaleLinesInverseTable = "pos_sale_lines"
+	// PosSaleLinesColumn is the table column denoting the pos_sale_lines relation/edge.
+	PosSaleLinesColumn = "product_pos_sale_lines"
 )
 
 // Columns holds all SQL columns for product fields.
@@ -191,10 +237,19 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldMinStockLevel,
 	FieldMaxStockLevel,
+	FieldReorderPoint,
 	FieldBarcode,
 	FieldLocation,
 	FieldIsVariantParent,
+	FieldWeight,
+	FieldSoldByWeight,
+	FieldPricePerKg,
+	FieldTareWeight,
+	FieldPlu,
+	FieldTaxCategory,
 	FieldSerialNumber,
+	FieldTrackLots,
+	FieldExpiryAlertDays,
 	FieldPurchaseDate,
 	FieldPurchasePrice,
 	FieldUsefulLifeMonths,
@@ -244,12 +299,55 @@ var (
 	DefaultMinStockLevel int
 	// DefaultMaxStockLevel holds the default value on creation for the "max_stock_level" field.
 	DefaultMaxStockLevel int
+	// DefaultReorderPoint holds the default value on creation for the "reorder_point" field.
+	DefaultReorderPoint int
 	// DefaultIsVariantParent holds the default value on creation for the "is_variant_parent" field.
 	DefaultIsVariantParent bool
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight decimal.Decimal
+	// DefaultSoldByWeight holds the default value on creation for the "sold_by_weight" field.
+	DefaultSoldByWeight bool
+	// DefaultPricePerKg holds the default value on creation for the "price_per_kg" field.
+	DefaultPricePerKg decimal.Decimal
+	// DefaultTareWeight holds the default value on creation for the "tare_weight" field.
+	DefaultTareWeight decimal.Decimal
+	// DefaultTrackLots holds the default value on creation for the "track_lots" field.
+	DefaultTrackLots bool
+	// DefaultExpiryAlertDays holds the default value on creation for the "expiry_alert_days" field.
+	DefaultExpiryAlertDays int
+	// ExpiryAlertDaysValidator is a validator for the "expiry_alert_days" field. It is called by the builders before save.
+	ExpiryAlertDaysValidator func(int) error
 	// DefaultIsDisposed holds the default value on creation for the "is_disposed" field.
 	DefaultIsDisposed bool
 )
 
+// TaxCategory defines the type for the "tax_category" enum field.
+type TaxCategory string
+
+// TaxCategoryStandard is the default value of the TaxCategory enum.
+const DefaultTaxCategory = TaxCategoryStandard
+
+// TaxCategory values.
+const (
+	TaxCategoryStandard  TaxCategory = "standard"
+	TaxCategoryZeroRated TaxCategory = "zero_rated"
+	TaxCategoryExempt    TaxCategory = "exempt"
+)
+
+func (tc TaxCategory) String() string {
+	return string(tc)
+}
+
+// TaxCategoryValidator is a validator for the "tax_category" field enum values. It is called by the builders before save.
+func TaxCategoryValidator(tc TaxCategory) error {
+	switch tc {
+	case TaxCategoryStandard, TaxCategoryZeroRated, TaxCategoryExempt:
+		return nil
+	default:
+		return fmt.Errorf("product: invalid enum value for tax_category field: %q", tc)
+	}
+}
+
 // OrderOption defines the ordering options for the Product queries.
 type OrderOption func(*sql.Selector)
 
@@ -303,6 +401,11 @@ func ByMaxStockLevel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxStockLevel, opts...).ToFunc()
 }
 
+// ByReorderPoint orders the results by the reorder_point field.
+func ByReorderPoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReorderPoint, opts...).ToFunc()
+}
+
 // ByBarcode orders the results by the barcode field.
 func ByBarcode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBarcode, opts...).ToFunc()
@@ -318,11 +421,51 @@ func ByIsVariantParent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsVariantParent, opts...).ToFunc()
 }
 
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
+// BySoldByWeight orders the results by the sold_by_weight field.
+func BySoldByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoldByWeight, opts...).ToFunc()
+}
+
+// ByPricePerKg orders the results by the price_per_kg field.
+func ByPricePerKg(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPricePerKg, opts...).ToFunc()
+}
+
+// ByTareWeight orders the results by the tare_weight field.
+func ByTareWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTareWeight, opts...).ToFunc()
+}
+
+// ByPlu orders the results by the plu field.
+func ByPlu(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlu, opts...).ToFunc()
+}
+
+// ByTaxCategory orders the results by the tax_category field.
+func ByTaxCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxCategory, opts...).ToFunc()
+}
+
 // BySerialNumber orders the results by the serial_number field.
 func BySerialNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSerialNumber, opts...).ToFunc()
 }
 
+// ByTrackLots orders the results by the track_lots field.
+func ByTrackLots(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackLots, opts...).ToFunc()
+}
+
+// ByExpiryAlertDays orders the results by the expiry_alert_days field.
+func ByExpiryAlertDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryAlertDays, opts...).ToFunc()
+}
+
 // ByPurchaseDate orders the results by the purchase_date field.
 func ByPurchaseDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPurchaseDate, opts...).ToFunc()
@@ -504,6 +647,48 @@ func ByInventoryCounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInventoryCountsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByStockLevelsCount orders the results by stock_levels count.
+func ByStockLevelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStockLevelsStep(), opts...)
+	}
+}
+
+// ByStockLevels orders the results by stock_levels terms.
+func ByStockLevels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockLevelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransferLinesCount orders the results by transfer_lines count.
+func ByTransferLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransferLinesStep(), opts...)
+	}
+}
+
+// ByTransferLines orders the results by transfer_lines terms.
+func ByTransferLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransferLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPosSaleLinesCount orders the results by pos_sale_lines count.
+func ByPosSaleLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPosSaleLinesStep(), opts...)
+	}
+}
+
+// ByPosSaleLines orders the results by pos_sale_lines terms.
+func ByPosSaleLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPosSaleLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -595,3 +780,24 @@ func newInventoryCountsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, InventoryCountsTable, InventoryCountsColumn),
 	)
 }
+func newStockLevelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockLevelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StockLevelsTable, StockLevelsColumn),
+	)
+}
+func newTransferLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransferLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransferLinesTable, TransferLinesColumn),
+	)
+}
+func newPosSaleLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PosSaleLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PosSaleLinesTable, PosSaleLinesColumn),
+	)
+}
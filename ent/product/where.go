@@ -101,6 +101,11 @@ func MaxStockLevel(v int) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldMaxStockLevel, v))
 }
 
+// ReorderPoint applies equality check predicate on the "reorder_point" field. It's identical to ReorderPointEQ.
+func ReorderPoint(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldReorderPoint, v))
+}
+
 // Barcode applies equality check predicate on the "barcode" field. It's identical to BarcodeEQ.
 func Barcode(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldBarcode, v))
@@ -116,11 +121,46 @@ func IsVariantParent(v bool) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldIsVariantParent, v))
 }
 
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldWeight, v))
+}
+
+// SoldByWeight applies equality check predicate on the "sold_by_weight" field. It's identical to SoldByWeightEQ.
+func SoldByWeight(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSoldByWeight, v))
+}
+
+// PricePerKg applies equality check predicate on the "price_per_kg" field. It's identical to PricePerKgEQ.
+func PricePerKg(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPricePerKg, v))
+}
+
+// TareWeight applies equality check predicate on the "tare_weight" field. It's identical to TareWeightEQ.
+func TareWeight(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldTareWeight, v))
+}
+
+// Plu applies equality check predicate on the "plu" field. It's identical to PluEQ.
+func Plu(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPlu, v))
+}
+
 // SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
 func SerialNumber(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldSerialNumber, v))
 }
 
+// TrackLots applies equality check predicate on the "track_lots" field. It's identical to TrackLotsEQ.
+func TrackLots(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldTrackLots, v))
+}
+
+// ExpiryAlertDays applies equality check predicate on the "expiry_alert_days" field. It's identical to ExpiryAlertDaysEQ.
+func ExpiryAlertDays(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldExpiryAlertDays, v))
+}
+
 // PurchaseDate applies equality check predicate on the "purchase_date" field. It's identical to PurchaseDateEQ.
 func PurchaseDate(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldPurchaseDate, v))
@@ -611,6 +651,46 @@ func MaxStockLevelLTE(v int) predicate.Product {
 	return predicate.Product(sql.FieldLTE(FieldMaxStockLevel, v))
 }
 
+// ReorderPointEQ applies the EQ predicate on the "reorder_point" field.
+func ReorderPointEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldReorderPoint, v))
+}
+
+// ReorderPointNEQ applies the NEQ predicate on the "reorder_point" field.
+func ReorderPointNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldReorderPoint, v))
+}
+
+// ReorderPointIn applies the In predicate on the "reorder_point" field.
+func ReorderPointIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldReorderPoint, vs...))
+}
+
+// ReorderPointNotIn applies the NotIn predicate on the "reorder_point" field.
+func ReorderPointNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldReorderPoint, vs...))
+}
+
+// ReorderPointGT applies the GT predicate on the "reorder_point" field.
+func ReorderPointGT(v int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldReorderPoint, v))
+}
+
+// ReorderPointGTE applies the GTE predicate on the "reorder_point" field.
+func ReorderPointGTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldReorderPoint, v))
+}
+
+// ReorderPointLT applies the LT predicate on the "reorder_point" field.
+func ReorderPointLT(v int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldReorderPoint, v))
+}
+
+// ReorderPointLTE applies the LTE predicate on the "reorder_point" field.
+func ReorderPointLTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldReorderPoint, v))
+}
+
 // BarcodeEQ applies the EQ predicate on the "barcode" field.
 func BarcodeEQ(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldBarcode, v))
@@ -771,6 +851,231 @@ func IsVariantParentNEQ(v bool) predicate.Product {
 	return predicate.Product(sql.FieldNEQ(FieldIsVariantParent, v))
 }
 
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldWeight, v))
+}
+
+// SoldByWeightEQ applies the EQ predicate on the "sold_by_weight" field.
+func SoldByWeightEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSoldByWeight, v))
+}
+
+// SoldByWeightNEQ applies the NEQ predicate on the "sold_by_weight" field.
+func SoldByWeightNEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldSoldByWeight, v))
+}
+
+// PricePerKgEQ applies the EQ predicate on the "price_per_kg" field.
+func PricePerKgEQ(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPricePerKg, v))
+}
+
+// PricePerKgNEQ applies the NEQ predicate on the "price_per_kg" field.
+func PricePerKgNEQ(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldPricePerKg, v))
+}
+
+// PricePerKgIn applies the In predicate on the "price_per_kg" field.
+func PricePerKgIn(vs ...decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldPricePerKg, vs...))
+}
+
+// PricePerKgNotIn applies the NotIn predicate on the "price_per_kg" field.
+func PricePerKgNotIn(vs ...decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldPricePerKg, vs...))
+}
+
+// PricePerKgGT applies the GT predicate on the "price_per_kg" field.
+func PricePerKgGT(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldPricePerKg, v))
+}
+
+// PricePerKgGTE applies the GTE predicate on the "price_per_kg" field.
+func PricePerKgGTE(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldPricePerKg, v))
+}
+
+// PricePerKgLT applies the LT predicate on the "price_per_kg" field.
+func PricePerKgLT(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldPricePerKg, v))
+}
+
+// PricePerKgLTE applies the LTE predicate on the "price_per_kg" field.
+func PricePerKgLTE(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldPricePerKg, v))
+}
+
+// TareWeightEQ applies the EQ predicate on the "tare_weight" field.
+func TareWeightEQ(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldTareWeight, v))
+}
+
+// TareWeightNEQ applies the NEQ predicate on the "tare_weight" field.
+func TareWeightNEQ(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldTareWeight, v))
+}
+
+// TareWeightIn applies the In predicate on the "tare_weight" field.
+func TareWeightIn(vs ...decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldTareWeight, vs...))
+}
+
+// TareWeightNotIn applies the NotIn predicate on the "tare_weight" field.
+func TareWeightNotIn(vs ...decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldTareWeight, vs...))
+}
+
+// TareWeightGT applies the GT predicate on the "tare_weight" field.
+func TareWeightGT(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldTareWeight, v))
+}
+
+// TareWeightGTE applies the GTE predicate on the "tare_weight" field.
+func TareWeightGTE(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldTareWeight, v))
+}
+
+// TareWeightLT applies the LT predicate on the "tare_weight" field.
+func TareWeightLT(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldTareWeight, v))
+}
+
+// TareWeightLTE applies the LTE predicate on the "tare_weight" field.
+func TareWeightLTE(v decimal.Decimal) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldTareWeight, v))
+}
+
+// PluEQ applies the EQ predicate on the "plu" field.
+func PluEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPlu, v))
+}
+
+// PluNEQ applies the NEQ predicate on the "plu" field.
+func PluNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldPlu, v))
+}
+
+// PluIn applies the In predicate on the "plu" field.
+func PluIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldPlu, vs...))
+}
+
+// PluNotIn applies the NotIn predicate on the "plu" field.
+func PluNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldPlu, vs...))
+}
+
+// PluGT applies the GT predicate on the "plu" field.
+func PluGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldPlu, v))
+}
+
+// PluGTE applies the GTE predicate on the "plu" field.
+func PluGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldPlu, v))
+}
+
+// PluLT applies the LT predicate on the "plu" field.
+func PluLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldPlu, v))
+}
+
+// PluLTE applies the LTE predicate on the "plu" field.
+func PluLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldPlu, v))
+}
+
+// PluContains applies the Contains predicate on the "plu" field.
+func PluContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldPlu, v))
+}
+
+// PluHasPrefix applies the HasPrefix predicate on the "plu" field.
+func PluHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldPlu, v))
+}
+
+// PluHasSuffix applies the HasSuffix predicate on the "plu" field.
+func PluHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldPlu, v))
+}
+
+// PluIsNil applies the IsNil predicate on the "plu" field.
+func PluIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldPlu))
+}
+
+// PluNotNil applies the NotNil predicate on the "plu" field.
+func PluNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldPlu))
+}
+
+// PluEqualFold applies the EqualFold predicate on the "plu" field.
+func PluEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldPlu, v))
+}
+
+// PluContainsFold applies the ContainsFold predicate on the "plu" field.
+func PluContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldPlu, v))
+}
+
+// TaxCategoryEQ applies the EQ predicate on the "tax_category" field.
+func TaxCategoryEQ(v TaxCategory) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldTaxCategory, v))
+}
+
+// TaxCategoryNEQ applies the NEQ predicate on the "tax_category" field.
+func TaxCategoryNEQ(v TaxCategory) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldTaxCategory, v))
+}
+
+// TaxCategoryIn applies the In predicate on the "tax_category" field.
+func TaxCategoryIn(vs ...TaxCategory) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldTaxCategory, vs...))
+}
+
+// TaxCategoryNotIn applies the NotIn predicate on the "tax_category" field.
+func TaxCategoryNotIn(vs ...TaxCategory) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldTaxCategory, vs...))
+}
+
 // SerialNumberEQ applies the EQ predicate on the "serial_number" field.
 func SerialNumberEQ(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldSerialNumber, v))
@@ -846,6 +1151,56 @@ func SerialNumberContainsFold(v string) predicate.Product {
 	return predicate.Product(sql.FieldContainsFold(FieldSerialNumber, v))
 }
 
+// TrackLotsEQ applies the EQ predicate on the "track_lots" field.
+func TrackLotsEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldTrackLots, v))
+}
+
+// TrackLotsNEQ applies the NEQ predicate on the "track_lots" field.
+func TrackLotsNEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldTrackLots, v))
+}
+
+// ExpiryAlertDaysEQ applies the EQ predicate on the "expiry_alert_days" field.
+func ExpiryAlertDaysEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldExpiryAlertDays, v))
+}
+
+// ExpiryAlertDaysNEQ applies the NEQ predicate on the "expiry_alert_days" field.
+func ExpiryAlertDaysNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldExpiryAlertDays, v))
+}
+
+// ExpiryAlertDaysIn applies the In predicate on the "expiry_alert_days" field.
+func ExpiryAlertDaysIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldExpiryAlertDays, vs...))
+}
+
+// ExpiryAlertDaysNotIn applies the NotIn predicate on the "expiry_alert_days" field.
+func ExpiryAlertDaysNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldExpiryAlertDays, vs...))
+}
+
+// ExpiryAlertDaysGT applies the GT predicate on the "expiry_alert_days" field.
+func ExpiryAlertDaysGT(v int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldExpiryAlertDays, v))
+}
+
+// ExpiryAlertDaysGTE applies the GTE predicate on the "expiry_alert_days" field.
+func ExpiryAlertDaysGTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldExpiryAlertDays, v))
+}
+
+// ExpiryAlertDaysLT applies the LT predicate on the "expiry_alert_days" field.
+func ExpiryAlertDaysLT(v int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldExpiryAlertDays, v))
+}
+
+// ExpiryAlertDaysLTE applies the LTE predicate on the "expiry_alert_days" field.
+func ExpiryAlertDaysLTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldExpiryAlertDays, v))
+}
+
 // PurchaseDateEQ applies the EQ predicate on the "purchase_date" field.
 func PurchaseDateEQ(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldPurchaseDate, v))
@@ -1480,6 +1835,75 @@ func HasInventoryCountsWith(preds ...predicate.InventoryCount) predicate.Product
 	})
 }
 
+// HasStockLevels applies the HasEdge predicate on the "stock_levels" edge.
+func HasStockLevels() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StockLevelsTable, StockLevelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStockLevelsWith applies the HasEdge predicate on the "stock_levels" edge with a given conditions (other predicates).
+func HasStockLevelsWith(preds ...predicate.StockLevel) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newStockLevelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransferLines applies the HasEdge predicate on the "transfer_lines" edge.
+func HasTransferLines() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransferLinesTable, TransferLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransferLinesWith applies the HasEdge predicate on the "transfer_lines" edge with a given conditions (other predicates).
+func HasTransferLinesWith(preds ...predicate.TransferOrderLine) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newTransferLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPosSaleLines applies the HasEdge predicate on the "pos_sale_lines" edge.
+func HasPosSaleLines() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PosSaleLinesTable, PosSaleLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPosSaleLinesWith applies the HasEdge predicate on the "pos_sale_lines" edge with a given conditions (other predicates).
+func HasPosSaleLinesWith(preds ...predicate.PosSaleLine) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newPosSaleLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Product) predicate.Product {
 	return predicate.Product(sql.AndPredicates(predicates...))
@@ -12,13 +12,16 @@ import (
 	"sent/ent/inventorycount"
 	"sent/ent/inventoryreservation"
 	"sent/ent/maintenanceschedule"
+	"sent/ent/possaleline"
 	"sent/ent/product"
 	"sent/ent/productvariant"
 	"sent/ent/purchaseorderline"
 	"sent/ent/stockalert"
+	"sent/ent/stocklevel"
 	"sent/ent/stockmovement"
 	"sent/ent/supplier"
 	"sent/ent/tenant"
+	"sent/ent/transferorderline"
 	"sent/ent/warehouse"
 	"time"
 
@@ -150,6 +153,20 @@ func (_c *ProductCreate) SetNillableMaxStockLevel(v *int) *ProductCreate {
 	return _c
 }
 
+// SetReorderPoint sets the "reorder_point" field.
+func (_c *ProductCreate) SetReorderPoint(v int) *ProductCreate {
+	_c.mutation.SetReorderPoint(v)
+	return _c
+}
+
+// SetNillableReorderPoint sets the "reorder_point" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableReorderPoint(v *int) *ProductCreate {
+	if v != nil {
+		_c.SetReorderPoint(*v)
+	}
+	return _c
+}
+
 // SetBarcode sets the "barcode" field.
 func (_c *ProductCreate) SetBarcode(v string) *ProductCreate {
 	_c.mutation.SetBarcode(v)
@@ -192,6 +209,90 @@ func (_c *ProductCreate) SetNillableIsVariantParent(v *bool) *ProductCreate {
 	return _c
 }
 
+// SetWeight sets the "weight" field.
+func (_c *ProductCreate) SetWeight(v decimal.Decimal) *ProductCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableWeight(v *decimal.Decimal) *ProductCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetSoldByWeight sets the "sold_by_weight" field.
+func (_c *ProductCreate) SetSoldByWeight(v bool) *ProductCreate {
+	_c.mutation.SetSoldByWeight(v)
+	return _c
+}
+
+// SetNillableSoldByWeight sets the "sold_by_weight" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableSoldByWeight(v *bool) *ProductCreate {
+	if v != nil {
+		_c.SetSoldByWeight(*v)
+	}
+	return _c
+}
+
+// SetPricePerKg sets the "price_per_kg" field.
+func (_c *ProductCreate) SetPricePerKg(v decimal.Decimal) *ProductCreate {
+	_c.mutation.SetPricePerKg(v)
+	return _c
+}
+
+// SetNillablePricePerKg sets the "price_per_kg" field if the given value is not nil.
+func (_c *ProductCreate) SetNillablePricePerKg(v *decimal.Decimal) *ProductCreate {
+	if v != nil {
+		_c.SetPricePerKg(*v)
+	}
+	return _c
+}
+
+// SetTareWeight sets the "tare_weight" field.
+func (_c *ProductCreate) SetTareWeight(v decimal.Decimal) *ProductCreate {
+	_c.mutation.SetTareWeight(v)
+	return _c
+}
+
+// SetNillableTareWeight sets the "tare_weight" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableTareWeight(v *decimal.Decimal) *ProductCreate {
+	if v != nil {
+		_c.SetTareWeight(*v)
+	}
+	return _c
+}
+
+// SetPlu sets the "plu" field.
+func (_c *ProductCreate) SetPlu(v string) *ProductCreate {
+	_c.mutation.SetPlu(v)
+	return _c
+}
+
+// SetNillablePlu sets the "plu" field if the given value is not nil.
+func (_c *ProductCreate) SetNillablePlu(v *string) *ProductCreate {
+	if v != nil {
+		_c.SetPlu(*v)
+	}
+	return _c
+}
+
+// SetTaxCategory sets the "tax_category" field.
+func (_c *ProductCreate) SetTaxCategory(v product.TaxCategory) *ProductCreate {
+	_c.mutation.SetTaxCategory(v)
+	return _c
+}
+
+// SetNillableTaxCategory sets the "tax_category" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableTaxCategory(v *product.TaxCategory) *ProductCreate {
+	if v != nil {
+		_c.SetTaxCategory(*v)
+	}
+	return _c
+}
+
 // SetSerialNumber sets the "serial_number" field.
 func (_c *ProductCreate) SetSerialNumber(v string) *ProductCreate {
 	_c.mutation.SetSerialNumber(v)
@@ -206,6 +307,34 @@ func (_c *ProductCreate) SetNillableSerialNumber(v *string) *ProductCreate {
 	return _c
 }
 
+// SetTrackLots sets the "track_lots" field.
+func (_c *ProductCreate) SetTrackLots(v bool) *ProductCreate {
+	_c.mutation.SetTrackLots(v)
+	return _c
+}
+
+// SetNillableTrackLots sets the "track_lots" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableTrackLots(v *bool) *ProductCreate {
+	if v != nil {
+		_c.SetTrackLots(*v)
+	}
+	return _c
+}
+
+// SetExpiryAlertDays sets the "expiry_alert_days" field.
+func (_c *ProductCreate) SetExpiryAlertDays(v int) *ProductCreate {
+	_c.mutation.SetExpiryAlertDays(v)
+	return _c
+}
+
+// SetNillableExpiryAlertDays sets the "expiry_alert_days" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableExpiryAlertDays(v *int) *ProductCreate {
+	if v != nil {
+		_c.SetExpiryAlertDays(*v)
+	}
+	return _c
+}
+
 // SetPurchaseDate sets the "purchase_date" field.
 func (_c *ProductCreate) SetPurchaseDate(v time.Time) *ProductCreate {
 	_c.mutation.SetPurchaseDate(v)
@@ -511,6 +640,51 @@ func (_c *ProductCreate) AddInventoryCounts(v ...*InventoryCount) *ProductCreate
 	return _c.AddInventoryCountIDs(ids...)
 }
 
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_c *ProductCreate) AddStockLevelIDs(ids ...int) *ProductCreate {
+	_c.mutation.AddStockLevelIDs(ids...)
+	return _c
+}
+
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_c *ProductCreate) AddStockLevels(v ...*StockLevel) *ProductCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStockLevelIDs(ids...)
+}
+
+// AddTransferLineIDs adds the "transfer_lines" edge to the TransferOrderLine entity by IDs.
+func (_c *ProductCreate) AddTransferLineIDs(ids ...int) *ProductCreate {
+	_c.mutation.AddTransferLineIDs(ids...)
+	return _c
+}
+
+// AddTransferLines adds the "transfer_lines" edges to the TransferOrderLine entity.
+func (_c *ProductCreate) AddTransferLines(v ...*TransferOrderLine) *ProductCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransferLineIDs(ids...)
+}
+
+// AddPosSaleLineIDs adds the "pos_sale_lines" edge to the PosSaleLine entity by IDs.
+func (_c *ProductCreate) AddPosSaleLineIDs(ids ...int) *ProductCreate {
+	_c.mutation.AddPosSaleLineIDs(ids...)
+	return _c
+}
+
+// AddPosSaleLines adds the "pos_sale_lines" edges to the PosSaleLine entity.
+func (_c *ProductCreate) AddPosSaleLines(v ...*PosSaleLine) *ProductCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPosSaleLineIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (_c *ProductCreate) Mutation() *ProductMutation {
 	return _c.mutation
@@ -570,10 +744,42 @@ func (_c *ProductCreate) defaults() {
 		v := product.DefaultMaxStockLevel
 		_c.mutation.SetMaxStockLevel(v)
 	}
+	if _, ok := _c.mutation.ReorderPoint(); !ok {
+		v := product.DefaultReorderPoint
+		_c.mutation.SetReorderPoint(v)
+	}
 	if _, ok := _c.mutation.IsVariantParent(); !ok {
 		v := product.DefaultIsVariantParent
 		_c.mutation.SetIsVariantParent(v)
 	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := product.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
+	if _, ok := _c.mutation.SoldByWeight(); !ok {
+		v := product.DefaultSoldByWeight
+		_c.mutation.SetSoldByWeight(v)
+	}
+	if _, ok := _c.mutation.PricePerKg(); !ok {
+		v := product.DefaultPricePerKg
+		_c.mutation.SetPricePerKg(v)
+	}
+	if _, ok := _c.mutation.TareWeight(); !ok {
+		v := product.DefaultTareWeight
+		_c.mutation.SetTareWeight(v)
+	}
+	if _, ok := _c.mutation.TaxCategory(); !ok {
+		v := product.DefaultTaxCategory
+		_c.mutation.SetTaxCategory(v)
+	}
+	if _, ok := _c.mutation.TrackLots(); !ok {
+		v := product.DefaultTrackLots
+		_c.mutation.SetTrackLots(v)
+	}
+	if _, ok := _c.mutation.ExpiryAlertDays(); !ok {
+		v := product.DefaultExpiryAlertDays
+		_c.mutation.SetExpiryAlertDays(v)
+	}
 	if _, ok := _c.mutation.IsDisposed(); !ok {
 		v := product.DefaultIsDisposed
 		_c.mutation.SetIsDisposed(v)
@@ -606,9 +812,43 @@ func (_c *ProductCreate) check() error {
 	if _, ok := _c.mutation.MaxStockLevel(); !ok {
 		return &ValidationError{Name: "max_stock_level", err: errors.New(`ent: missing required field "Product.max_stock_level"`)}
 	}
+	if _, ok := _c.mutation.ReorderPoint(); !ok {
+		return &ValidationError{Name: "reorder_point", err: errors.New(`ent: missing required field "Product.reorder_point"`)}
+	}
 	if _, ok := _c.mutation.IsVariantParent(); !ok {
 		return &ValidationError{Name: "is_variant_parent", err: errors.New(`ent: missing required field "Product.is_variant_parent"`)}
 	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "Product.weight"`)}
+	}
+	if _, ok := _c.mutation.SoldByWeight(); !ok {
+		return &ValidationError{Name: "sold_by_weight", err: errors.New(`ent: missing required field "Product.sold_by_weight"`)}
+	}
+	if _, ok := _c.mutation.PricePerKg(); !ok {
+		return &ValidationError{Name: "price_per_kg", err: errors.New(`ent: missing required field "Product.price_per_kg"`)}
+	}
+	if _, ok := _c.mutation.TareWeight(); !ok {
+		return &ValidationError{Name: "tare_weight", err: errors.New(`ent: missing required field "Product.tare_weight"`)}
+	}
+	if _, ok := _c.mutation.TaxCategory(); !ok {
+		return &ValidationError{Name: "tax_category", err: errors.New(`ent: missing required field "Product.tax_category"`)}
+	}
+	if v, ok := _c.mutation.TaxCategory(); ok {
+		if err := product.TaxCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "tax_category", err: fmt.Errorf(`ent: validator failed for field "Product.tax_category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TrackLots(); !ok {
+		return &ValidationError{Name: "track_lots", err: errors.New(`ent: missing required field "Product.track_lots"`)}
+	}
+	if _, ok := _c.mutation.ExpiryAlertDays(); !ok {
+		return &ValidationError{Name: "expiry_alert_days", err: errors.New(`ent: missing required field "Product.expiry_alert_days"`)}
+	}
+	if v, ok := _c.mutation.ExpiryAlertDays(); ok {
+		if err := product.ExpiryAlertDaysValidator(v); err != nil {
+			return &ValidationError{Name: "expiry_alert_days", err: fmt.Errorf(`ent: validator failed for field "Product.expiry_alert_days": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.IsDisposed(); !ok {
 		return &ValidationError{Name: "is_disposed", err: errors.New(`ent: missing required field "Product.is_disposed"`)}
 	}
@@ -681,6 +921,10 @@ func (_c *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldMaxStockLevel, field.TypeInt, value)
 		_node.MaxStockLevel = value
 	}
+	if value, ok := _c.mutation.ReorderPoint(); ok {
+		_spec.SetField(product.FieldReorderPoint, field.TypeInt, value)
+		_node.ReorderPoint = value
+	}
 	if value, ok := _c.mutation.Barcode(); ok {
 		_spec.SetField(product.FieldBarcode, field.TypeString, value)
 		_node.Barcode = value
@@ -693,10 +937,42 @@ func (_c *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldIsVariantParent, field.TypeBool, value)
 		_node.IsVariantParent = value
 	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(product.FieldWeight, field.TypeOther, value)
+		_node.Weight = value
+	}
+	if value, ok := _c.mutation.SoldByWeight(); ok {
+		_spec.SetField(product.FieldSoldByWeight, field.TypeBool, value)
+		_node.SoldByWeight = value
+	}
+	if value, ok := _c.mutation.PricePerKg(); ok {
+		_spec.SetField(product.FieldPricePerKg, field.TypeOther, value)
+		_node.PricePerKg = value
+	}
+	if value, ok := _c.mutation.TareWeight(); ok {
+		_spec.SetField(product.FieldTareWeight, field.TypeOther, value)
+		_node.TareWeight = value
+	}
+	if value, ok := _c.mutation.Plu(); ok {
+		_spec.SetField(product.FieldPlu, field.TypeString, value)
+		_node.Plu = value
+	}
+	if value, ok := _c.mutation.TaxCategory(); ok {
+		_spec.SetField(product.FieldTaxCategory, field.TypeEnum, value)
+		_node.TaxCategory = value
+	}
 	if value, ok := _c.mutation.SerialNumber(); ok {
 		_spec.SetField(product.FieldSerialNumber, field.TypeString, value)
 		_node.SerialNumber = value
 	}
+	if value, ok := _c.mutation.TrackLots(); ok {
+		_spec.SetField(product.FieldTrackLots, field.TypeBool, value)
+		_node.TrackLots = value
+	}
+	if value, ok := _c.mutation.ExpiryAlertDays(); ok {
+		_spec.SetField(product.FieldExpiryAlertDays, field.TypeInt, value)
+		_node.ExpiryAlertDays = value
+	}
 	if value, ok := _c.mutation.PurchaseDate(); ok {
 		_spec.SetField(product.FieldPurchaseDate, field.TypeTime, value)
 		_node.PurchaseDate = value
@@ -938,6 +1214,54 @@ func (_c *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.StockLevelsTable,
+			Columns: []string{product.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransferLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.TransferLinesTable,
+			Columns: []string{product.TransferLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PosSaleLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PosSaleLinesTable,
+			Columns: []string{product.PosSaleLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
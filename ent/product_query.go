@@ -13,14 +13,17 @@ import (
 	"sent/ent/inventorycount"
 	"sent/ent/inventoryreservation"
 	"sent/ent/maintenanceschedule"
+	"sent/ent/possaleline"
 	"sent/ent/predicate"
 	"sent/ent/product"
 	"sent/ent/productvariant"
 	"sent/ent/purchaseorderline"
 	"sent/ent/stockalert"
+	"sent/ent/stocklevel"
 	"sent/ent/stockmovement"
 	"sent/ent/supplier"
 	"sent/ent/tenant"
+	"sent/ent/transferorderline"
 	"sent/ent/warehouse"
 
 	"entgo.io/ent"
@@ -49,6 +52,9 @@ type ProductQuery struct {
 	withAlerts               *StockAlertQuery
 	withPurchaseOrderLines   *PurchaseOrderLineQuery
 	withInventoryCounts      *InventoryCountQuery
+	withStockLevels          *StockLevelQuery
+	withTransferLines        *TransferOrderLineQuery
+	withPosSaleLines         *PosSaleLineQuery
 	withFKs                  bool
 	modifiers                []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -373,6 +379,72 @@ func (_q *ProductQuery) QueryInventoryCounts() *InventoryCountQuery {
 	return query
 }
 
+// QueryStockLevels chains the current query on the "stock_levels" edge.
+func (_q *ProductQuery) QueryStockLevels() *StockLevelQuery {
+	query := (&StockLevelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(stocklevel.Table, stocklevel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.StockLevelsTable, product.StockLevelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransferLines chains the current query on the "transfer_lines" edge.
+func (_q *ProductQuery) QueryTransferLines() *TransferOrderLineQuery {
+	query := (&TransferOrderLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(transferorderline.Table, transferorderline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.TransferLinesTable, product.TransferLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPosSaleLines chains the current query on the "pos_sale_lines" edge.
+func (_q *ProductQuery) QueryPosSaleLines() *PosSaleLineQuery {
+	query := (&PosSaleLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(possaleline.Table, possaleline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.PosSaleLinesTable, product.PosSaleLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Product entity from the query.
 // Returns a *NotFoundError when no Product was found.
 func (_q *ProductQuery) First(ctx context.Context) (*Product, error) {
@@ -578,6 +650,9 @@ func (_q *ProductQuery) Clone() *ProductQuery {
 		withAlerts:               _q.withAlerts.Clone(),
 		withPurchaseOrderLines:   _q.withPurchaseOrderLines.Clone(),
 		withInventoryCounts:      _q.withInventoryCounts.Clone(),
+		withStockLevels:          _q.withStockLevels.Clone(),
+		withTransferLines:        _q.withTransferLines.Clone(),
+		withPosSaleLines:         _q.withPosSaleLines.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -728,6 +803,39 @@ func (_q *ProductQuery) WithInventoryCounts(opts ...func(*InventoryCountQuery))
 	return _q
 }
 
+// WithStockLevels tells the query-builder to eager-load the nodes that are connected to
+// the "stock_levels" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProductQuery) WithStockLevels(opts ...func(*StockLevelQuery)) *ProductQuery {
+	query := (&StockLevelClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStockLevels = query
+	return _q
+}
+
+// WithTransferLines tells the query-builder to eager-load the nodes that are connected to
+// the "transfer_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProductQuery) WithTransferLines(opts ...func(*TransferOrderLineQuery)) *ProductQuery {
+	query := (&TransferOrderLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransferLines = query
+	return _q
+}
+
+// WithPosSaleLines tells the query-builder to eager-load the nodes that are connected to
+// the "pos_sale_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProductQuery) WithPosSaleLines(opts ...func(*PosSaleLineQuery)) *ProductQuery {
+	query := (&PosSaleLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPosSaleLines = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -807,7 +915,7 @@ func (_q *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 		nodes       = []*Product{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [13]bool{
+		loadedTypes = [16]bool{
 			_q.withTenant != nil,
 			_q.withMovements != nil,
 			_q.withReservations != nil,
@@ -821,6 +929,9 @@ func (_q *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 			_q.withAlerts != nil,
 			_q.withPurchaseOrderLines != nil,
 			_q.withInventoryCounts != nil,
+			_q.withStockLevels != nil,
+			_q.withTransferLines != nil,
+			_q.withPosSaleLines != nil,
 		}
 	)
 	if _q.withTenant != nil || _q.withVendor != nil || _q.withSupplier != nil || _q.withCategory != nil || _q.withWarehouse != nil {
@@ -940,6 +1051,27 @@ func (_q *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 			return nil, err
 		}
 	}
+	if query := _q.withStockLevels; query != nil {
+		if err := _q.loadStockLevels(ctx, query, nodes,
+			func(n *Product) { n.Edges.StockLevels = []*StockLevel{} },
+			func(n *Product, e *StockLevel) { n.Edges.StockLevels = append(n.Edges.StockLevels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransferLines; query != nil {
+		if err := _q.loadTransferLines(ctx, query, nodes,
+			func(n *Product) { n.Edges.TransferLines = []*TransferOrderLine{} },
+			func(n *Product, e *TransferOrderLine) { n.Edges.TransferLines = append(n.Edges.TransferLines, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPosSaleLines; query != nil {
+		if err := _q.loadPosSaleLines(ctx, query, nodes,
+			func(n *Product) { n.Edges.PosSaleLines = []*PosSaleLine{} },
+			func(n *Product, e *PosSaleLine) { n.Edges.PosSaleLines = append(n.Edges.PosSaleLines, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1351,6 +1483,99 @@ func (_q *ProductQuery) loadInventoryCounts(ctx context.Context, query *Inventor
 	}
 	return nil
 }
+func (_q *ProductQuery) loadStockLevels(ctx context.Context, query *StockLevelQuery, nodes []*Product, init func(*Product), assign func(*Product, *StockLevel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StockLevel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.StockLevelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.product_stock_levels
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "product_stock_levels" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_stock_levels" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProductQuery) loadTransferLines(ctx context.Context, query *TransferOrderLineQuery, nodes []*Product, init func(*Product), assign func(*Product, *TransferOrderLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TransferOrderLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.TransferLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.product_transfer_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "product_transfer_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_transfer_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProductQuery) loadPosSaleLines(ctx context.Context, query *PosSaleLineQuery, nodes []*Product, init func(*Product), assign func(*Product, *PosSaleLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PosSaleLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.PosSaleLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.product_pos_sale_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "product_pos_sale_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_pos_sale_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ProductQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,14 +12,17 @@ import (
 	"sent/ent/inventorycount"
 	"sent/ent/inventoryreservation"
 	"sent/ent/maintenanceschedule"
+	"sent/ent/possaleline"
 	"sent/ent/predicate"
 	"sent/ent/product"
 	"sent/ent/productvariant"
 	"sent/ent/purchaseorderline"
 	"sent/ent/stockalert"
+	"sent/ent/stocklevel"
 	"sent/ent/stockmovement"
 	"sent/ent/supplier"
 	"sent/ent/tenant"
+	"sent/ent/transferorderline"
 	"sent/ent/warehouse"
 	"time"
 
@@ -179,6 +182,27 @@ func (_u *ProductUpdate) AddMaxStockLevel(v int) *ProductUpdate {
 	return _u
 }
 
+// SetReorderPoint sets the "reorder_point" field.
+func (_u *ProductUpdate) SetReorderPoint(v int) *ProductUpdate {
+	_u.mutation.ResetReorderPoint()
+	_u.mutation.SetReorderPoint(v)
+	return _u
+}
+
+// SetNillableReorderPoint sets the "reorder_point" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableReorderPoint(v *int) *ProductUpdate {
+	if v != nil {
+		_u.SetReorderPoint(*v)
+	}
+	return _u
+}
+
+// AddReorderPoint adds value to the "reorder_point" field.
+func (_u *ProductUpdate) AddReorderPoint(v int) *ProductUpdate {
+	_u.mutation.AddReorderPoint(v)
+	return _u
+}
+
 // SetBarcode sets the "barcode" field.
 func (_u *ProductUpdate) SetBarcode(v string) *ProductUpdate {
 	_u.mutation.SetBarcode(v)
@@ -233,6 +257,96 @@ func (_u *ProductUpdate) SetNillableIsVariantParent(v *bool) *ProductUpdate {
 	return _u
 }
 
+// SetWeight sets the "weight" field.
+func (_u *ProductUpdate) SetWeight(v decimal.Decimal) *ProductUpdate {
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableWeight(v *decimal.Decimal) *ProductUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// SetSoldByWeight sets the "sold_by_weight" field.
+func (_u *ProductUpdate) SetSoldByWeight(v bool) *ProductUpdate {
+	_u.mutation.SetSoldByWeight(v)
+	return _u
+}
+
+// SetNillableSoldByWeight sets the "sold_by_weight" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableSoldByWeight(v *bool) *ProductUpdate {
+	if v != nil {
+		_u.SetSoldByWeight(*v)
+	}
+	return _u
+}
+
+// SetPricePerKg sets the "price_per_kg" field.
+func (_u *ProductUpdate) SetPricePerKg(v decimal.Decimal) *ProductUpdate {
+	_u.mutation.SetPricePerKg(v)
+	return _u
+}
+
+// SetNillablePricePerKg sets the "price_per_kg" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillablePricePerKg(v *decimal.Decimal) *ProductUpdate {
+	if v != nil {
+		_u.SetPricePerKg(*v)
+	}
+	return _u
+}
+
+// SetTareWeight sets the "tare_weight" field.
+func (_u *ProductUpdate) SetTareWeight(v decimal.Decimal) *ProductUpdate {
+	_u.mutation.SetTareWeight(v)
+	return _u
+}
+
+// SetNillableTareWeight sets the "tare_weight" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableTareWeight(v *decimal.Decimal) *ProductUpdate {
+	if v != nil {
+		_u.SetTareWeight(*v)
+	}
+	return _u
+}
+
+// SetPlu sets the "plu" field.
+func (_u *ProductUpdate) SetPlu(v string) *ProductUpdate {
+	_u.mutation.SetPlu(v)
+	return _u
+}
+
+// SetNillablePlu sets the "plu" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillablePlu(v *string) *ProductUpdate {
+	if v != nil {
+		_u.SetPlu(*v)
+	}
+	return _u
+}
+
+// ClearPlu clears the value of the "plu" field.
+func (_u *ProductUpdate) ClearPlu() *ProductUpdate {
+	_u.mutation.ClearPlu()
+	return _u
+}
+
+// SetTaxCategory sets the "tax_category" field.
+func (_u *ProductUpdate) SetTaxCategory(v product.TaxCategory) *ProductUpdate {
+	_u.mutation.SetTaxCategory(v)
+	return _u
+}
+
+// SetNillableTaxCategory sets the "tax_category" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableTaxCategory(v *product.TaxCategory) *ProductUpdate {
+	if v != nil {
+		_u.SetTaxCategory(*v)
+	}
+	return _u
+}
+
 // SetSerialNumber sets the "serial_number" field.
 func (_u *ProductUpdate) SetSerialNumber(v string) *ProductUpdate {
 	_u.mutation.SetSerialNumber(v)
@@ -253,6 +367,41 @@ func (_u *ProductUpdate) ClearSerialNumber() *ProductUpdate {
 	return _u
 }
 
+// SetTrackLots sets the "track_lots" field.
+func (_u *ProductUpdate) SetTrackLots(v bool) *ProductUpdate {
+	_u.mutation.SetTrackLots(v)
+	return _u
+}
+
+// SetNillableTrackLots sets the "track_lots" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableTrackLots(v *bool) *ProductUpdate {
+	if v != nil {
+		_u.SetTrackLots(*v)
+	}
+	return _u
+}
+
+// SetExpiryAlertDays sets the "expiry_alert_days" field.
+func (_u *ProductUpdate) SetExpiryAlertDays(v int) *ProductUpdate {
+	_u.mutation.ResetExpiryAlertDays()
+	_u.mutation.SetExpiryAlertDays(v)
+	return _u
+}
+
+// SetNillableExpiryAlertDays sets the "expiry_alert_days" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableExpiryAlertDays(v *int) *ProductUpdate {
+	if v != nil {
+		_u.SetExpiryAlertDays(*v)
+	}
+	return _u
+}
+
+// AddExpiryAlertDays adds value to the "expiry_alert_days" field.
+func (_u *ProductUpdate) AddExpiryAlertDays(v int) *ProductUpdate {
+	_u.mutation.AddExpiryAlertDays(v)
+	return _u
+}
+
 // SetPurchaseDate sets the "purchase_date" field.
 func (_u *ProductUpdate) SetPurchaseDate(v time.Time) *ProductUpdate {
 	_u.mutation.SetPurchaseDate(v)
@@ -601,6 +750,51 @@ func (_u *ProductUpdate) AddInventoryCounts(v ...*InventoryCount) *ProductUpdate
 	return _u.AddInventoryCountIDs(ids...)
 }
 
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_u *ProductUpdate) AddStockLevelIDs(ids ...int) *ProductUpdate {
+	_u.mutation.AddStockLevelIDs(ids...)
+	return _u
+}
+
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_u *ProductUpdate) AddStockLevels(v ...*StockLevel) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockLevelIDs(ids...)
+}
+
+// AddTransferLineIDs adds the "transfer_lines" edge to the TransferOrderLine entity by IDs.
+func (_u *ProductUpdate) AddTransferLineIDs(ids ...int) *ProductUpdate {
+	_u.mutation.AddTransferLineIDs(ids...)
+	return _u
+}
+
+// AddTransferLines adds the "transfer_lines" edges to the TransferOrderLine entity.
+func (_u *ProductUpdate) AddTransferLines(v ...*TransferOrderLine) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransferLineIDs(ids...)
+}
+
+// AddPosSaleLineIDs adds the "pos_sale_lines" edge to the PosSaleLine entity by IDs.
+func (_u *ProductUpdate) AddPosSaleLineIDs(ids ...int) *ProductUpdate {
+	_u.mutation.AddPosSaleLineIDs(ids...)
+	return _u
+}
+
+// AddPosSaleLines adds the "pos_sale_lines" edges to the PosSaleLine entity.
+func (_u *ProductUpdate) AddPosSaleLines(v ...*PosSaleLine) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPosSaleLineIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (_u *ProductUpdate) Mutation() *ProductMutation {
 	return _u.mutation
@@ -804,6 +998,69 @@ func (_u *ProductUpdate) RemoveInventoryCounts(v ...*InventoryCount) *ProductUpd
 	return _u.RemoveInventoryCountIDs(ids...)
 }
 
+// ClearStockLevels clears all "stock_levels" edges to the StockLevel entity.
+func (_u *ProductUpdate) ClearStockLevels() *ProductUpdate {
+	_u.mutation.ClearStockLevels()
+	return _u
+}
+
+// RemoveStockLevelIDs removes the "stock_levels" edge to StockLevel entities by IDs.
+func (_u *ProductUpdate) RemoveStockLevelIDs(ids ...int) *ProductUpdate {
+	_u.mutation.RemoveStockLevelIDs(ids...)
+	return _u
+}
+
+// RemoveStockLevels removes "stock_levels" edges to StockLevel entities.
+func (_u *ProductUpdate) RemoveStockLevels(v ...*StockLevel) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockLevelIDs(ids...)
+}
+
+// ClearTransferLines clears all "transfer_lines" edges to the TransferOrderLine entity.
+func (_u *ProductUpdate) ClearTransferLines() *ProductUpdate {
+	_u.mutation.ClearTransferLines()
+	return _u
+}
+
+// RemoveTransferLineIDs removes the "transfer_lines" edge to TransferOrderLine entities by IDs.
+func (_u *ProductUpdate) RemoveTransferLineIDs(ids ...int) *ProductUpdate {
+	_u.mutation.RemoveTransferLineIDs(ids...)
+	return _u
+}
+
+// RemoveTransferLines removes "transfer_lines" edges to TransferOrderLine entities.
+func (_u *ProductUpdate) RemoveTransferLines(v ...*TransferOrderLine) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransferLineIDs(ids...)
+}
+
+// ClearPosSaleLines clears all "pos_sale_lines" edges to the PosSaleLine entity.
+func (_u *ProductUpdate) ClearPosSaleLines() *ProductUpdate {
+	_u.mutation.ClearPosSaleLines()
+	return _u
+}
+
+// RemovePosSaleLineIDs removes the "pos_sale_lines" edge to PosSaleLine entities by IDs.
+func (_u *ProductUpdate) RemovePosSaleLineIDs(ids ...int) *ProductUpdate {
+	_u.mutation.RemovePosSaleLineIDs(ids...)
+	return _u
+}
+
+// RemovePosSaleLines removes "pos_sale_lines" edges to PosSaleLine entities.
+func (_u *ProductUpdate) RemovePosSaleLines(v ...*PosSaleLine) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePosSaleLineIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ProductUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -842,6 +1099,16 @@ func (_u *ProductUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ProductUpdate) check() error {
+	if v, ok := _u.mutation.TaxCategory(); ok {
+		if err := product.TaxCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "tax_category", err: fmt.Errorf(`ent: validator failed for field "Product.tax_category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExpiryAlertDays(); ok {
+		if err := product.ExpiryAlertDaysValidator(v); err != nil {
+			return &ValidationError{Name: "expiry_alert_days", err: fmt.Errorf(`ent: validator failed for field "Product.expiry_alert_days": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Product.tenant"`)
 	}
@@ -905,6 +1172,12 @@ func (_u *ProductUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedMaxStockLevel(); ok {
 		_spec.AddField(product.FieldMaxStockLevel, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ReorderPoint(); ok {
+		_spec.SetField(product.FieldReorderPoint, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReorderPoint(); ok {
+		_spec.AddField(product.FieldReorderPoint, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Barcode(); ok {
 		_spec.SetField(product.FieldBarcode, field.TypeString, value)
 	}
@@ -920,12 +1193,42 @@ func (_u *ProductUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsVariantParent(); ok {
 		_spec.SetField(product.FieldIsVariantParent, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(product.FieldWeight, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.SoldByWeight(); ok {
+		_spec.SetField(product.FieldSoldByWeight, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PricePerKg(); ok {
+		_spec.SetField(product.FieldPricePerKg, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TareWeight(); ok {
+		_spec.SetField(product.FieldTareWeight, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Plu(); ok {
+		_spec.SetField(product.FieldPlu, field.TypeString, value)
+	}
+	if _u.mutation.PluCleared() {
+		_spec.ClearField(product.FieldPlu, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxCategory(); ok {
+		_spec.SetField(product.FieldTaxCategory, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.SerialNumber(); ok {
 		_spec.SetField(product.FieldSerialNumber, field.TypeString, value)
 	}
 	if _u.mutation.SerialNumberCleared() {
 		_spec.ClearField(product.FieldSerialNumber, field.TypeString)
 	}
+	if value, ok := _u.mutation.TrackLots(); ok {
+		_spec.SetField(product.FieldTrackLots, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiryAlertDays(); ok {
+		_spec.SetField(product.FieldExpiryAlertDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExpiryAlertDays(); ok {
+		_spec.AddField(product.FieldExpiryAlertDays, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.PurchaseDate(); ok {
 		_spec.SetField(product.FieldPurchaseDate, field.TypeTime, value)
 	}
@@ -1473,6 +1776,141 @@ func (_u *ProductUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StockLevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.StockLevelsTable,
+			Columns: []string{product.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStockLevelsIDs(); len(nodes) > 0 && !_u.mutation.StockLevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.StockLevelsTable,
+			Columns: []string{product.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.StockLevelsTable,
+			Columns: []string{product.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransferLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.TransferLinesTable,
+			Columns: []string{product.TransferLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransferLinesIDs(); len(nodes) > 0 && !_u.mutation.TransferLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.TransferLinesTable,
+			Columns: []string{product.TransferLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.TransferLinesTable,
+			Columns: []string{product.TransferLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PosSaleLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PosSaleLinesTable,
+			Columns: []string{product.PosSaleLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPosSaleLinesIDs(); len(nodes) > 0 && !_u.mutation.PosSaleLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PosSaleLinesTable,
+			Columns: []string{product.PosSaleLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PosSaleLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PosSaleLinesTable,
+			Columns: []string{product.PosSaleLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1617,17 +2055,38 @@ func (_u *ProductUpdateOne) SetMaxStockLevel(v int) *ProductUpdateOne {
 	return _u
 }
 
-// SetNillableMaxStockLevel sets the "max_stock_level" field if the given value is not nil.
-func (_u *ProductUpdateOne) SetNillableMaxStockLevel(v *int) *ProductUpdateOne {
+// SetNillableMaxStockLevel sets the "max_stock_level" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableMaxStockLevel(v *int) *ProductUpdateOne {
+	if v != nil {
+		_u.SetMaxStockLevel(*v)
+	}
+	return _u
+}
+
+// AddMaxStockLevel adds value to the "max_stock_level" field.
+func (_u *ProductUpdateOne) AddMaxStockLevel(v int) *ProductUpdateOne {
+	_u.mutation.AddMaxStockLevel(v)
+	return _u
+}
+
+// SetReorderPoint sets the "reorder_point" field.
+func (_u *ProductUpdateOne) SetReorderPoint(v int) *ProductUpdateOne {
+	_u.mutation.ResetReorderPoint()
+	_u.mutation.SetReorderPoint(v)
+	return _u
+}
+
+// SetNillableReorderPoint sets the "reorder_point" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableReorderPoint(v *int) *ProductUpdateOne {
 	if v != nil {
-		_u.SetMaxStockLevel(*v)
+		_u.SetReorderPoint(*v)
 	}
 	return _u
 }
 
-// AddMaxStockLevel adds value to the "max_stock_level" field.
-func (_u *ProductUpdateOne) AddMaxStockLevel(v int) *ProductUpdateOne {
-	_u.mutation.AddMaxStockLevel(v)
+// AddReorderPoint adds value to the "reorder_point" field.
+func (_u *ProductUpdateOne) AddReorderPoint(v int) *ProductUpdateOne {
+	_u.mutation.AddReorderPoint(v)
 	return _u
 }
 
@@ -1685,6 +2144,96 @@ func (_u *ProductUpdateOne) SetNillableIsVariantParent(v *bool) *ProductUpdateOn
 	return _u
 }
 
+// SetWeight sets the "weight" field.
+func (_u *ProductUpdateOne) SetWeight(v decimal.Decimal) *ProductUpdateOne {
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableWeight(v *decimal.Decimal) *ProductUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// SetSoldByWeight sets the "sold_by_weight" field.
+func (_u *ProductUpdateOne) SetSoldByWeight(v bool) *ProductUpdateOne {
+	_u.mutation.SetSoldByWeight(v)
+	return _u
+}
+
+// SetNillableSoldByWeight sets the "sold_by_weight" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableSoldByWeight(v *bool) *ProductUpdateOne {
+	if v != nil {
+		_u.SetSoldByWeight(*v)
+	}
+	return _u
+}
+
+// SetPricePerKg sets the "price_per_kg" field.
+func (_u *ProductUpdateOne) SetPricePerKg(v decimal.Decimal) *ProductUpdateOne {
+	_u.mutation.SetPricePerKg(v)
+	return _u
+}
+
+// SetNillablePricePerKg sets the "price_per_kg" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillablePricePerKg(v *decimal.Decimal) *ProductUpdateOne {
+	if v != nil {
+		_u.SetPricePerKg(*v)
+	}
+	return _u
+}
+
+// SetTareWeight sets the "tare_weight" field.
+func (_u *ProductUpdateOne) SetTareWeight(v decimal.Decimal) *ProductUpdateOne {
+	_u.mutation.SetTareWeight(v)
+	return _u
+}
+
+// SetNillableTareWeight sets the "tare_weight" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableTareWeight(v *decimal.Decimal) *ProductUpdateOne {
+	if v != nil {
+		_u.SetTareWeight(*v)
+	}
+	return _u
+}
+
+// SetPlu sets the "plu" field.
+func (_u *ProductUpdateOne) SetPlu(v string) *ProductUpdateOne {
+	_u.mutation.SetPlu(v)
+	return _u
+}
+
+// SetNillablePlu sets the "plu" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillablePlu(v *string) *ProductUpdateOne {
+	if v != nil {
+		_u.SetPlu(*v)
+	}
+	return _u
+}
+
+// ClearPlu clears the value of the "plu" field.
+func (_u *ProductUpdateOne) ClearPlu() *ProductUpdateOne {
+	_u.mutation.ClearPlu()
+	return _u
+}
+
+// SetTaxCategory sets the "tax_category" field.
+func (_u *ProductUpdateOne) SetTaxCategory(v product.TaxCategory) *ProductUpdateOne {
+	_u.mutation.SetTaxCategory(v)
+	return _u
+}
+
+// SetNillableTaxCategory sets the "tax_category" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableTaxCategory(v *product.TaxCategory) *ProductUpdateOne {
+	if v != nil {
+		_u.SetTaxCategory(*v)
+	}
+	return _u
+}
+
 // SetSerialNumber sets the "serial_number" field.
 func (_u *ProductUpdateOne) SetSerialNumber(v string) *ProductUpdateOne {
 	_u.mutation.SetSerialNumber(v)
@@ -1705,6 +2254,41 @@ func (_u *ProductUpdateOne) ClearSerialNumber() *ProductUpdateOne {
 	return _u
 }
 
+// SetTrackLots sets the "track_lots" field.
+func (_u *ProductUpdateOne) SetTrackLots(v bool) *ProductUpdateOne {
+	_u.mutation.SetTrackLots(v)
+	return _u
+}
+
+// SetNillableTrackLots sets the "track_lots" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableTrackLots(v *bool) *ProductUpdateOne {
+	if v != nil {
+		_u.SetTrackLots(*v)
+	}
+	return _u
+}
+
+// SetExpiryAlertDays sets the "expiry_alert_days" field.
+func (_u *ProductUpdateOne) SetExpiryAlertDays(v int) *ProductUpdateOne {
+	_u.mutation.ResetExpiryAlertDays()
+	_u.mutation.SetExpiryAlertDays(v)
+	return _u
+}
+
+// SetNillableExpiryAlertDays sets the "expiry_alert_days" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableExpiryAlertDays(v *int) *ProductUpdateOne {
+	if v != nil {
+		_u.SetExpiryAlertDays(*v)
+	}
+	return _u
+}
+
+// AddExpiryAlertDays adds value to the "expiry_alert_days" field.
+func (_u *ProductUpdateOne) AddExpiryAlertDays(v int) *ProductUpdateOne {
+	_u.mutation.AddExpiryAlertDays(v)
+	return _u
+}
+
 // SetPurchaseDate sets the "purchase_date" field.
 func (_u *ProductUpdateOne) SetPurchaseDate(v time.Time) *ProductUpdateOne {
 	_u.mutation.SetPurchaseDate(v)
@@ -2053,6 +2637,51 @@ func (_u *ProductUpdateOne) AddInventoryCounts(v ...*InventoryCount) *ProductUpd
 	return _u.AddInventoryCountIDs(ids...)
 }
 
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_u *ProductUpdateOne) AddStockLevelIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.AddStockLevelIDs(ids...)
+	return _u
+}
+
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_u *ProductUpdateOne) AddStockLevels(v ...*StockLevel) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockLevelIDs(ids...)
+}
+
+// AddTransferLineIDs adds the "transfer_lines" edge to the TransferOrderLine entity by IDs.
+func (_u *ProductUpdateOne) AddTransferLineIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.AddTransferLineIDs(ids...)
+	return _u
+}
+
+// AddTransferLines adds the "transfer_lines" edges to the TransferOrderLine entity.
+func (_u *ProductUpdateOne) AddTransferLines(v ...*TransferOrderLine) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransferLineIDs(ids...)
+}
+
+// AddPosSaleLineIDs adds the "pos_sale_lines" edge to the PosSaleLine entity by IDs.
+func (_u *ProductUpdateOne) AddPosSaleLineIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.AddPosSaleLineIDs(ids...)
+	return _u
+}
+
+// AddPosSaleLines adds the "pos_sale_lines" edges to the PosSaleLine entity.
+func (_u *ProductUpdateOne) AddPosSaleLines(v ...*PosSaleLine) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPosSaleLineIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (_u *ProductUpdateOne) Mutation() *ProductMutation {
 	return _u.mutation
@@ -2256,6 +2885,69 @@ func (_u *ProductUpdateOne) RemoveInventoryCounts(v ...*InventoryCount) *Product
 	return _u.RemoveInventoryCountIDs(ids...)
 }
 
+// ClearStockLevels clears all "stock_levels" edges to the StockLevel entity.
+func (_u *ProductUpdateOne) ClearStockLevels() *ProductUpdateOne {
+	_u.mutation.ClearStockLevels()
+	return _u
+}
+
+// RemoveStockLevelIDs removes the "stock_levels" edge to StockLevel entities by IDs.
+func (_u *ProductUpdateOne) RemoveStockLevelIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.RemoveStockLevelIDs(ids...)
+	return _u
+}
+
+// RemoveStockLevels removes "stock_levels" edges to StockLevel entities.
+func (_u *ProductUpdateOne) RemoveStockLevels(v ...*StockLevel) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockLevelIDs(ids...)
+}
+
+// ClearTransferLines clears all "transfer_lines" edges to the TransferOrderLine entity.
+func (_u *ProductUpdateOne) ClearTransferLines() *ProductUpdateOne {
+	_u.mutation.ClearTransferLines()
+	return _u
+}
+
+// RemoveTransferLineIDs removes the "transfer_lines" edge to TransferOrderLine entities by IDs.
+func (_u *ProductUpdateOne) RemoveTransferLineIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.RemoveTransferLineIDs(ids...)
+	return _u
+}
+
+// RemoveTransferLines removes "transfer_lines" edges to TransferOrderLine entities.
+func (_u *ProductUpdateOne) RemoveTransferLines(v ...*TransferOrderLine) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransferLineIDs(ids...)
+}
+
+// ClearPosSaleLines clears all "pos_sale_lines" edges to the PosSaleLine entity.
+func (_u *ProductUpdateOne) ClearPosSaleLines() *ProductUpdateOne {
+	_u.mutation.ClearPosSaleLines()
+	return _u
+}
+
+// RemovePosSaleLineIDs removes the "pos_sale_lines" edge to PosSaleLine entities by IDs.
+func (_u *ProductUpdateOne) RemovePosSaleLineIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.RemovePosSaleLineIDs(ids...)
+	return _u
+}
+
+// RemovePosSaleLines removes "pos_sale_lines" edges to PosSaleLine entities.
+func (_u *ProductUpdateOne) RemovePosSaleLines(v ...*PosSaleLine) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePosSaleLineIDs(ids...)
+}
+
 // Where appends a list predicates to the ProductUpdate builder.
 func (_u *ProductUpdateOne) Where(ps ...predicate.Product) *ProductUpdateOne {
 	_u.mutation.Where(ps...)
@@ -2307,6 +2999,16 @@ func (_u *ProductUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ProductUpdateOne) check() error {
+	if v, ok := _u.mutation.TaxCategory(); ok {
+		if err := product.TaxCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "tax_category", err: fmt.Errorf(`ent: validator failed for field "Product.tax_category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExpiryAlertDays(); ok {
+		if err := product.ExpiryAlertDaysValidator(v); err != nil {
+			return &ValidationError{Name: "expiry_alert_days", err: fmt.Errorf(`ent: validator failed for field "Product.expiry_alert_days": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Product.tenant"`)
 	}
@@ -2387,6 +3089,12 @@ func (_u *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err er
 	if value, ok := _u.mutation.AddedMaxStockLevel(); ok {
 		_spec.AddField(product.FieldMaxStockLevel, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ReorderPoint(); ok {
+		_spec.SetField(product.FieldReorderPoint, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReorderPoint(); ok {
+		_spec.AddField(product.FieldReorderPoint, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Barcode(); ok {
 		_spec.SetField(product.FieldBarcode, field.TypeString, value)
 	}
@@ -2402,12 +3110,42 @@ func (_u *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err er
 	if value, ok := _u.mutation.IsVariantParent(); ok {
 		_spec.SetField(product.FieldIsVariantParent, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(product.FieldWeight, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.SoldByWeight(); ok {
+		_spec.SetField(product.FieldSoldByWeight, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PricePerKg(); ok {
+		_spec.SetField(product.FieldPricePerKg, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TareWeight(); ok {
+		_spec.SetField(product.FieldTareWeight, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Plu(); ok {
+		_spec.SetField(product.FieldPlu, field.TypeString, value)
+	}
+	if _u.mutation.PluCleared() {
+		_spec.ClearField(product.FieldPlu, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxCategory(); ok {
+		_spec.SetField(product.FieldTaxCategory, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.SerialNumber(); ok {
 		_spec.SetField(product.FieldSerialNumber, field.TypeString, value)
 	}
 	if _u.mutation.SerialNumberCleared() {
 		_spec.ClearField(product.FieldSerialNumber, field.TypeString)
 	}
+	if value, ok := _u.mutation.TrackLots(); ok {
+		_spec.SetField(product.FieldTrackLots, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiryAlertDays(); ok {
+		_spec.SetField(product.FieldExpiryAlertDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExpiryAlertDays(); ok {
+		_spec.AddField(product.FieldExpiryAlertDays, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.PurchaseDate(); ok {
 		_spec.SetField(product.FieldPurchaseDate, field.TypeTime, value)
 	}
@@ -2955,6 +3693,141 @@ func (_u *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StockLevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.StockLevelsTable,
+			Columns: []string{product.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStockLevelsIDs(); len(nodes) > 0 && !_u.mutation.StockLevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.StockLevelsTable,
+			Columns: []string{product.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.StockLevelsTable,
+			Columns: []string{product.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransferLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.TransferLinesTable,
+			Columns: []string{product.TransferLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransferLinesIDs(); len(nodes) > 0 && !_u.mutation.TransferLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.TransferLinesTable,
+			Columns: []string{product.TransferLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.TransferLinesTable,
+			Columns: []string{product.TransferLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PosSaleLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PosSaleLinesTable,
+			Columns: []string{product.PosSaleLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPosSaleLinesIDs(); len(nodes) > 0 && !_u.mutation.PosSaleLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PosSaleLinesTable,
+			Columns: []string{product.PosSaleLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PosSaleLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PosSaleLinesTable,
+			Columns: []string{product.PosSaleLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Product{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -32,6 +32,8 @@ type PurchaseOrder struct {
 	TotalAmount decimal.Decimal `json:"total_amount,omitempty"`
 	// Notes holds the value of the "notes" field.
 	Notes string `json:"notes,omitempty"`
+	// AutoGenerated holds the value of the "auto_generated" field.
+	AutoGenerated bool `json:"auto_generated,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -52,9 +54,13 @@ type PurchaseOrderEdges struct {
 	Supplier *Supplier `json:"supplier,omitempty"`
 	// Lines holds the value of the lines edge.
 	Lines []*PurchaseOrderLine `json:"lines,omitempty"`
+	// Bills holds the value of the bills edge.
+	Bills []*SupplierBill `json:"bills,omitempty"`
+	// Receipts holds the value of the receipts edge.
+	Receipts []*GoodsReceipt `json:"receipts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -88,6 +94,24 @@ func (e PurchaseOrderEdges) LinesOrErr() ([]*PurchaseOrderLine, error) {
 	return nil, &NotLoadedError{edge: "lines"}
 }
 
+// BillsOrErr returns the Bills value or an error if the edge
+// was not loaded in eager-loading.
+func (e PurchaseOrderEdges) BillsOrErr() ([]*SupplierBill, error) {
+	if e.loadedTypes[3] {
+		return e.Bills, nil
+	}
+	return nil, &NotLoadedError{edge: "bills"}
+}
+
+// ReceiptsOrErr returns the Receipts value or an error if the edge
+// was not loaded in eager-loading.
+func (e PurchaseOrderEdges) ReceiptsOrErr() ([]*GoodsReceipt, error) {
+	if e.loadedTypes[4] {
+		return e.Receipts, nil
+	}
+	return nil, &NotLoadedError{edge: "receipts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PurchaseOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -95,6 +119,8 @@ func (*PurchaseOrder) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case purchaseorder.FieldTotalAmount:
 			values[i] = new(decimal.Decimal)
+		case purchaseorder.FieldAutoGenerated:
+			values[i] = new(sql.NullBool)
 		case purchaseorder.FieldID:
 			values[i] = new(sql.NullInt64)
 		case purchaseorder.FieldPoNumber, purchaseorder.FieldStatus, purchaseorder.FieldNotes:
@@ -162,6 +188,12 @@ func (_m *PurchaseOrder) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Notes = value.String
 			}
+		case purchaseorder.FieldAutoGenerated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_generated", values[i])
+			} else if value.Valid {
+				_m.AutoGenerated = value.Bool
+			}
 		case purchaseorder.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -216,6 +248,16 @@ func (_m *PurchaseOrder) QueryLines() *PurchaseOrderLineQuery {
 	return NewPurchaseOrderClient(_m.config).QueryLines(_m)
 }
 
+// QueryBills queries the "bills" edge of the PurchaseOrder entity.
+func (_m *PurchaseOrder) QueryBills() *SupplierBillQuery {
+	return NewPurchaseOrderClient(_m.config).QueryBills(_m)
+}
+
+// QueryReceipts queries the "receipts" edge of the PurchaseOrder entity.
+func (_m *PurchaseOrder) QueryReceipts() *GoodsReceiptQuery {
+	return NewPurchaseOrderClient(_m.config).QueryReceipts(_m)
+}
+
 // Update returns a builder for updating this PurchaseOrder.
 // Note that you need to call PurchaseOrder.Unwrap() before calling this method if this PurchaseOrder
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -257,6 +299,9 @@ func (_m *PurchaseOrder) String() string {
 	builder.WriteString("notes=")
 	builder.WriteString(_m.Notes)
 	builder.WriteString(", ")
+	builder.WriteString("auto_generated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoGenerated))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -28,6 +28,8 @@ const (
 	FieldTotalAmount = "total_amount"
 	// FieldNotes holds the string denoting the notes field in the database.
 	FieldNotes = "notes"
+	// FieldAutoGenerated holds the string denoting the auto_generated field in the database.
+	FieldAutoGenerated = "auto_generated"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -38,6 +40,10 @@ const (
 	EdgeSupplier = "supplier"
 	// EdgeLines holds the string denoting the lines edge name in mutations.
 	EdgeLines = "lines"
+	// EdgeBills holds the string denoting the bills edge name in mutations.
+	EdgeBills = "bills"
+	// EdgeReceipts holds the string denoting the receipts edge name in mutations.
+	EdgeReceipts = "receipts"
 	// Table holds the table name of the purchaseorder in the database.
 	Table = "purchase_orders"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -61,6 +67,20 @@ const (
 	LinesInverseTable = "purchase_order_lines"
 	// LinesColumn is the table column denoting the lines relation/edge.
 	LinesColumn = "purchase_order_lines"
+	// BillsTable is the table that holds the bills relation/edge.
+	BillsTable = "supplier_bills"
+	// BillsInverseTable is the table name for the SupplierBill entity.
+	// It exists in this package in order to avoid circular dependency with the "supplierbill" package.
+	BillsInverseTable = "supplier_bills"
+	// BillsColumn is the table column denoting the bills relation/edge.
+	BillsColumn = "purchase_order_bills"
+	// ReceiptsTable is the table that holds the receipts relation/edge.
+	ReceiptsTable = "goods_receipts"
+	// ReceiptsInverseTable is the table name for the GoodsReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "goodsreceipt" package.
+	ReceiptsInverseTable = "goods_receipts"
+	// ReceiptsColumn is the table column denoting the receipts relation/edge.
+	ReceiptsColumn = "purchase_order_receipts"
 )
 
 // Columns holds all SQL columns for purchaseorder fields.
@@ -72,6 +92,7 @@ var Columns = []string{
 	FieldExpectedDate,
 	FieldTotalAmount,
 	FieldNotes,
+	FieldAutoGenerated,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -103,6 +124,8 @@ var (
 	DefaultOrderDate func() time.Time
 	// DefaultTotalAmount holds the default value on creation for the "total_amount" field.
 	DefaultTotalAmount decimal.Decimal
+	// DefaultAutoGenerated holds the default value on creation for the "auto_generated" field.
+	DefaultAutoGenerated bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -119,10 +142,11 @@ const DefaultStatus = StatusDraft
 
 // Status values.
 const (
-	StatusDraft     Status = "draft"
-	StatusSubmitted Status = "submitted"
-	StatusReceived  Status = "received"
-	StatusCancelled Status = "cancelled"
+	StatusDraft             Status = "draft"
+	StatusSubmitted         Status = "submitted"
+	StatusPartiallyReceived Status = "partially_received"
+	StatusReceived          Status = "received"
+	StatusCancelled         Status = "cancelled"
 )
 
 func (s Status) String() string {
@@ -132,7 +156,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusDraft, StatusSubmitted, StatusReceived, StatusCancelled:
+	case StatusDraft, StatusSubmitted, StatusPartiallyReceived, StatusReceived, StatusCancelled:
 		return nil
 	default:
 		return fmt.Errorf("purchaseorder: invalid enum value for status field: %q", s)
@@ -177,6 +201,11 @@ func ByNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNotes, opts...).ToFunc()
 }
 
+// ByAutoGenerated orders the results by the auto_generated field.
+func ByAutoGenerated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoGenerated, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -214,6 +243,34 @@ func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBillsCount orders the results by bills count.
+func ByBillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBillsStep(), opts...)
+	}
+}
+
+// ByBills orders the results by bills terms.
+func ByBills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceiptsCount orders the results by receipts count.
+func ByReceiptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceiptsStep(), opts...)
+	}
+}
+
+// ByReceipts orders the results by receipts terms.
+func ByReceipts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -235,3 +292,17 @@ func newLinesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
 	)
 }
+func newBillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BillsTable, BillsColumn),
+	)
+}
+func newReceiptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceiptsTable, ReceiptsColumn),
+	)
+}
@@ -81,6 +81,11 @@ func Notes(v string) predicate.PurchaseOrder {
 	return predicate.PurchaseOrder(sql.FieldEQ(FieldNotes, v))
 }
 
+// AutoGenerated applies equality check predicate on the "auto_generated" field. It's identical to AutoGeneratedEQ.
+func AutoGenerated(v bool) predicate.PurchaseOrder {
+	return predicate.PurchaseOrder(sql.FieldEQ(FieldAutoGenerated, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.PurchaseOrder {
 	return predicate.PurchaseOrder(sql.FieldEQ(FieldCreatedAt, v))
@@ -381,6 +386,16 @@ func NotesContainsFold(v string) predicate.PurchaseOrder {
 	return predicate.PurchaseOrder(sql.FieldContainsFold(FieldNotes, v))
 }
 
+// AutoGeneratedEQ applies the EQ predicate on the "auto_generated" field.
+func AutoGeneratedEQ(v bool) predicate.PurchaseOrder {
+	return predicate.PurchaseOrder(sql.FieldEQ(FieldAutoGenerated, v))
+}
+
+// AutoGeneratedNEQ applies the NEQ predicate on the "auto_generated" field.
+func AutoGeneratedNEQ(v bool) predicate.PurchaseOrder {
+	return predicate.PurchaseOrder(sql.FieldNEQ(FieldAutoGenerated, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PurchaseOrder {
 	return predicate.PurchaseOrder(sql.FieldEQ(FieldCreatedAt, v))
@@ -530,6 +545,52 @@ func HasLinesWith(preds ...predicate.PurchaseOrderLine) predicate.PurchaseOrder
 	})
 }
 
+// HasBills applies the HasEdge predicate on the "bills" edge.
+func HasBills() predicate.PurchaseOrder {
+	return predicate.PurchaseOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BillsTable, BillsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillsWith applies the HasEdge predicate on the "bills" edge with a given conditions (other predicates).
+func HasBillsWith(preds ...predicate.SupplierBill) predicate.PurchaseOrder {
+	return predicate.PurchaseOrder(func(s *sql.Selector) {
+		step := newBillsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceipts applies the HasEdge predicate on the "receipts" edge.
+func HasReceipts() predicate.PurchaseOrder {
+	return predicate.PurchaseOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceiptsTable, ReceiptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiptsWith applies the HasEdge predicate on the "receipts" edge with a given conditions (other predicates).
+func HasReceiptsWith(preds ...predicate.GoodsReceipt) predicate.PurchaseOrder {
+	return predicate.PurchaseOrder(func(s *sql.Selector) {
+		step := newReceiptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PurchaseOrder) predicate.PurchaseOrder {
 	return predicate.PurchaseOrder(sql.AndPredicates(predicates...))
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sent/ent/goodsreceipt"
 	"sent/ent/purchaseorder"
 	"sent/ent/purchaseorderline"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
 	"sent/ent/tenant"
 	"time"
 
@@ -100,6 +102,20 @@ func (_c *PurchaseOrderCreate) SetNillableNotes(v *string) *PurchaseOrderCreate
 	return _c
 }
 
+// SetAutoGenerated sets the "auto_generated" field.
+func (_c *PurchaseOrderCreate) SetAutoGenerated(v bool) *PurchaseOrderCreate {
+	_c.mutation.SetAutoGenerated(v)
+	return _c
+}
+
+// SetNillableAutoGenerated sets the "auto_generated" field if the given value is not nil.
+func (_c *PurchaseOrderCreate) SetNillableAutoGenerated(v *bool) *PurchaseOrderCreate {
+	if v != nil {
+		_c.SetAutoGenerated(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PurchaseOrderCreate) SetCreatedAt(v time.Time) *PurchaseOrderCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -165,6 +181,36 @@ func (_c *PurchaseOrderCreate) AddLines(v ...*PurchaseOrderLine) *PurchaseOrderC
 	return _c.AddLineIDs(ids...)
 }
 
+// AddBillIDs adds the "bills" edge to the SupplierBill entity by IDs.
+func (_c *PurchaseOrderCreate) AddBillIDs(ids ...int) *PurchaseOrderCreate {
+	_c.mutation.AddBillIDs(ids...)
+	return _c
+}
+
+// AddBills adds the "bills" edges to the SupplierBill entity.
+func (_c *PurchaseOrderCreate) AddBills(v ...*SupplierBill) *PurchaseOrderCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBillIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the GoodsReceipt entity by IDs.
+func (_c *PurchaseOrderCreate) AddReceiptIDs(ids ...int) *PurchaseOrderCreate {
+	_c.mutation.AddReceiptIDs(ids...)
+	return _c
+}
+
+// AddReceipts adds the "receipts" edges to the GoodsReceipt entity.
+func (_c *PurchaseOrderCreate) AddReceipts(v ...*GoodsReceipt) *PurchaseOrderCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceiptIDs(ids...)
+}
+
 // Mutation returns the PurchaseOrderMutation object of the builder.
 func (_c *PurchaseOrderCreate) Mutation() *PurchaseOrderMutation {
 	return _c.mutation
@@ -212,6 +258,10 @@ func (_c *PurchaseOrderCreate) defaults() {
 		v := purchaseorder.DefaultTotalAmount
 		_c.mutation.SetTotalAmount(v)
 	}
+	if _, ok := _c.mutation.AutoGenerated(); !ok {
+		v := purchaseorder.DefaultAutoGenerated
+		_c.mutation.SetAutoGenerated(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := purchaseorder.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -241,6 +291,9 @@ func (_c *PurchaseOrderCreate) check() error {
 	if _, ok := _c.mutation.TotalAmount(); !ok {
 		return &ValidationError{Name: "total_amount", err: errors.New(`ent: missing required field "PurchaseOrder.total_amount"`)}
 	}
+	if _, ok := _c.mutation.AutoGenerated(); !ok {
+		return &ValidationError{Name: "auto_generated", err: errors.New(`ent: missing required field "PurchaseOrder.auto_generated"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PurchaseOrder.created_at"`)}
 	}
@@ -303,6 +356,10 @@ func (_c *PurchaseOrderCreate) createSpec() (*PurchaseOrder, *sqlgraph.CreateSpe
 		_spec.SetField(purchaseorder.FieldNotes, field.TypeString, value)
 		_node.Notes = value
 	}
+	if value, ok := _c.mutation.AutoGenerated(); ok {
+		_spec.SetField(purchaseorder.FieldAutoGenerated, field.TypeBool, value)
+		_node.AutoGenerated = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(purchaseorder.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -361,6 +418,38 @@ func (_c *PurchaseOrderCreate) createSpec() (*PurchaseOrder, *sqlgraph.CreateSpe
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.BillsTable,
+			Columns: []string{purchaseorder.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.ReceiptsTable,
+			Columns: []string{purchaseorder.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
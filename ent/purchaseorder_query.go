@@ -7,10 +7,12 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"sent/ent/goodsreceipt"
 	"sent/ent/predicate"
 	"sent/ent/purchaseorder"
 	"sent/ent/purchaseorderline"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
 	"sent/ent/tenant"
 
 	"entgo.io/ent"
@@ -29,6 +31,8 @@ type PurchaseOrderQuery struct {
 	withTenant   *TenantQuery
 	withSupplier *SupplierQuery
 	withLines    *PurchaseOrderLineQuery
+	withBills    *SupplierBillQuery
+	withReceipts *GoodsReceiptQuery
 	withFKs      bool
 	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -133,6 +137,50 @@ func (_q *PurchaseOrderQuery) QueryLines() *PurchaseOrderLineQuery {
 	return query
 }
 
+// QueryBills chains the current query on the "bills" edge.
+func (_q *PurchaseOrderQuery) QueryBills() *SupplierBillQuery {
+	query := (&SupplierBillClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(purchaseorder.Table, purchaseorder.FieldID, selector),
+			sqlgraph.To(supplierbill.Table, supplierbill.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, purchaseorder.BillsTable, purchaseorder.BillsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceipts chains the current query on the "receipts" edge.
+func (_q *PurchaseOrderQuery) QueryReceipts() *GoodsReceiptQuery {
+	query := (&GoodsReceiptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(purchaseorder.Table, purchaseorder.FieldID, selector),
+			sqlgraph.To(goodsreceipt.Table, goodsreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, purchaseorder.ReceiptsTable, purchaseorder.ReceiptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PurchaseOrder entity from the query.
 // Returns a *NotFoundError when no PurchaseOrder was found.
 func (_q *PurchaseOrderQuery) First(ctx context.Context) (*PurchaseOrder, error) {
@@ -328,6 +376,8 @@ func (_q *PurchaseOrderQuery) Clone() *PurchaseOrderQuery {
 		withTenant:   _q.withTenant.Clone(),
 		withSupplier: _q.withSupplier.Clone(),
 		withLines:    _q.withLines.Clone(),
+		withBills:    _q.withBills.Clone(),
+		withReceipts: _q.withReceipts.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -368,6 +418,28 @@ func (_q *PurchaseOrderQuery) WithLines(opts ...func(*PurchaseOrderLineQuery)) *
 	return _q
 }
 
+// WithBills tells the query-builder to eager-load the nodes that are connected to
+// the "bills" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PurchaseOrderQuery) WithBills(opts ...func(*SupplierBillQuery)) *PurchaseOrderQuery {
+	query := (&SupplierBillClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBills = query
+	return _q
+}
+
+// WithReceipts tells the query-builder to eager-load the nodes that are connected to
+// the "receipts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PurchaseOrderQuery) WithReceipts(opts ...func(*GoodsReceiptQuery)) *PurchaseOrderQuery {
+	query := (&GoodsReceiptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReceipts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -447,10 +519,12 @@ func (_q *PurchaseOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*PurchaseOrder{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withTenant != nil,
 			_q.withSupplier != nil,
 			_q.withLines != nil,
+			_q.withBills != nil,
+			_q.withReceipts != nil,
 		}
 	)
 	if _q.withTenant != nil || _q.withSupplier != nil {
@@ -499,6 +573,20 @@ func (_q *PurchaseOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withBills; query != nil {
+		if err := _q.loadBills(ctx, query, nodes,
+			func(n *PurchaseOrder) { n.Edges.Bills = []*SupplierBill{} },
+			func(n *PurchaseOrder, e *SupplierBill) { n.Edges.Bills = append(n.Edges.Bills, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReceipts; query != nil {
+		if err := _q.loadReceipts(ctx, query, nodes,
+			func(n *PurchaseOrder) { n.Edges.Receipts = []*GoodsReceipt{} },
+			func(n *PurchaseOrder, e *GoodsReceipt) { n.Edges.Receipts = append(n.Edges.Receipts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -597,6 +685,68 @@ func (_q *PurchaseOrderQuery) loadLines(ctx context.Context, query *PurchaseOrde
 	}
 	return nil
 }
+func (_q *PurchaseOrderQuery) loadBills(ctx context.Context, query *SupplierBillQuery, nodes []*PurchaseOrder, init func(*PurchaseOrder), assign func(*PurchaseOrder, *SupplierBill)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PurchaseOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SupplierBill(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(purchaseorder.BillsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.purchase_order_bills
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "purchase_order_bills" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "purchase_order_bills" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PurchaseOrderQuery) loadReceipts(ctx context.Context, query *GoodsReceiptQuery, nodes []*PurchaseOrder, init func(*PurchaseOrder), assign func(*PurchaseOrder, *GoodsReceipt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PurchaseOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GoodsReceipt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(purchaseorder.ReceiptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.purchase_order_receipts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "purchase_order_receipts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "purchase_order_receipts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PurchaseOrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,10 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sent/ent/goodsreceipt"
 	"sent/ent/predicate"
 	"sent/ent/purchaseorder"
 	"sent/ent/purchaseorderline"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
 	"sent/ent/tenant"
 	"time"
 
@@ -129,6 +131,20 @@ func (_u *PurchaseOrderUpdate) ClearNotes() *PurchaseOrderUpdate {
 	return _u
 }
 
+// SetAutoGenerated sets the "auto_generated" field.
+func (_u *PurchaseOrderUpdate) SetAutoGenerated(v bool) *PurchaseOrderUpdate {
+	_u.mutation.SetAutoGenerated(v)
+	return _u
+}
+
+// SetNillableAutoGenerated sets the "auto_generated" field if the given value is not nil.
+func (_u *PurchaseOrderUpdate) SetNillableAutoGenerated(v *bool) *PurchaseOrderUpdate {
+	if v != nil {
+		_u.SetAutoGenerated(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PurchaseOrderUpdate) SetUpdatedAt(v time.Time) *PurchaseOrderUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -172,6 +188,36 @@ func (_u *PurchaseOrderUpdate) AddLines(v ...*PurchaseOrderLine) *PurchaseOrderU
 	return _u.AddLineIDs(ids...)
 }
 
+// AddBillIDs adds the "bills" edge to the SupplierBill entity by IDs.
+func (_u *PurchaseOrderUpdate) AddBillIDs(ids ...int) *PurchaseOrderUpdate {
+	_u.mutation.AddBillIDs(ids...)
+	return _u
+}
+
+// AddBills adds the "bills" edges to the SupplierBill entity.
+func (_u *PurchaseOrderUpdate) AddBills(v ...*SupplierBill) *PurchaseOrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the GoodsReceipt entity by IDs.
+func (_u *PurchaseOrderUpdate) AddReceiptIDs(ids ...int) *PurchaseOrderUpdate {
+	_u.mutation.AddReceiptIDs(ids...)
+	return _u
+}
+
+// AddReceipts adds the "receipts" edges to the GoodsReceipt entity.
+func (_u *PurchaseOrderUpdate) AddReceipts(v ...*GoodsReceipt) *PurchaseOrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptIDs(ids...)
+}
+
 // Mutation returns the PurchaseOrderMutation object of the builder.
 func (_u *PurchaseOrderUpdate) Mutation() *PurchaseOrderMutation {
 	return _u.mutation
@@ -210,6 +256,48 @@ func (_u *PurchaseOrderUpdate) RemoveLines(v ...*PurchaseOrderLine) *PurchaseOrd
 	return _u.RemoveLineIDs(ids...)
 }
 
+// ClearBills clears all "bills" edges to the SupplierBill entity.
+func (_u *PurchaseOrderUpdate) ClearBills() *PurchaseOrderUpdate {
+	_u.mutation.ClearBills()
+	return _u
+}
+
+// RemoveBillIDs removes the "bills" edge to SupplierBill entities by IDs.
+func (_u *PurchaseOrderUpdate) RemoveBillIDs(ids ...int) *PurchaseOrderUpdate {
+	_u.mutation.RemoveBillIDs(ids...)
+	return _u
+}
+
+// RemoveBills removes "bills" edges to SupplierBill entities.
+func (_u *PurchaseOrderUpdate) RemoveBills(v ...*SupplierBill) *PurchaseOrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillIDs(ids...)
+}
+
+// ClearReceipts clears all "receipts" edges to the GoodsReceipt entity.
+func (_u *PurchaseOrderUpdate) ClearReceipts() *PurchaseOrderUpdate {
+	_u.mutation.ClearReceipts()
+	return _u
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to GoodsReceipt entities by IDs.
+func (_u *PurchaseOrderUpdate) RemoveReceiptIDs(ids ...int) *PurchaseOrderUpdate {
+	_u.mutation.RemoveReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveReceipts removes "receipts" edges to GoodsReceipt entities.
+func (_u *PurchaseOrderUpdate) RemoveReceipts(v ...*GoodsReceipt) *PurchaseOrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PurchaseOrderUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -304,6 +392,9 @@ func (_u *PurchaseOrderUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.NotesCleared() {
 		_spec.ClearField(purchaseorder.FieldNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.AutoGenerated(); ok {
+		_spec.SetField(purchaseorder.FieldAutoGenerated, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(purchaseorder.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -410,6 +501,96 @@ func (_u *PurchaseOrderUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.BillsTable,
+			Columns: []string{purchaseorder.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillsIDs(); len(nodes) > 0 && !_u.mutation.BillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.BillsTable,
+			Columns: []string{purchaseorder.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.BillsTable,
+			Columns: []string{purchaseorder.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.ReceiptsTable,
+			Columns: []string{purchaseorder.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !_u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.ReceiptsTable,
+			Columns: []string{purchaseorder.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.ReceiptsTable,
+			Columns: []string{purchaseorder.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -528,6 +709,20 @@ func (_u *PurchaseOrderUpdateOne) ClearNotes() *PurchaseOrderUpdateOne {
 	return _u
 }
 
+// SetAutoGenerated sets the "auto_generated" field.
+func (_u *PurchaseOrderUpdateOne) SetAutoGenerated(v bool) *PurchaseOrderUpdateOne {
+	_u.mutation.SetAutoGenerated(v)
+	return _u
+}
+
+// SetNillableAutoGenerated sets the "auto_generated" field if the given value is not nil.
+func (_u *PurchaseOrderUpdateOne) SetNillableAutoGenerated(v *bool) *PurchaseOrderUpdateOne {
+	if v != nil {
+		_u.SetAutoGenerated(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PurchaseOrderUpdateOne) SetUpdatedAt(v time.Time) *PurchaseOrderUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -571,6 +766,36 @@ func (_u *PurchaseOrderUpdateOne) AddLines(v ...*PurchaseOrderLine) *PurchaseOrd
 	return _u.AddLineIDs(ids...)
 }
 
+// AddBillIDs adds the "bills" edge to the SupplierBill entity by IDs.
+func (_u *PurchaseOrderUpdateOne) AddBillIDs(ids ...int) *PurchaseOrderUpdateOne {
+	_u.mutation.AddBillIDs(ids...)
+	return _u
+}
+
+// AddBills adds the "bills" edges to the SupplierBill entity.
+func (_u *PurchaseOrderUpdateOne) AddBills(v ...*SupplierBill) *PurchaseOrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the GoodsReceipt entity by IDs.
+func (_u *PurchaseOrderUpdateOne) AddReceiptIDs(ids ...int) *PurchaseOrderUpdateOne {
+	_u.mutation.AddReceiptIDs(ids...)
+	return _u
+}
+
+// AddReceipts adds the "receipts" edges to the GoodsReceipt entity.
+func (_u *PurchaseOrderUpdateOne) AddReceipts(v ...*GoodsReceipt) *PurchaseOrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptIDs(ids...)
+}
+
 // Mutation returns the PurchaseOrderMutation object of the builder.
 func (_u *PurchaseOrderUpdateOne) Mutation() *PurchaseOrderMutation {
 	return _u.mutation
@@ -609,6 +834,48 @@ func (_u *PurchaseOrderUpdateOne) RemoveLines(v ...*PurchaseOrderLine) *Purchase
 	return _u.RemoveLineIDs(ids...)
 }
 
+// ClearBills clears all "bills" edges to the SupplierBill entity.
+func (_u *PurchaseOrderUpdateOne) ClearBills() *PurchaseOrderUpdateOne {
+	_u.mutation.ClearBills()
+	return _u
+}
+
+// RemoveBillIDs removes the "bills" edge to SupplierBill entities by IDs.
+func (_u *PurchaseOrderUpdateOne) RemoveBillIDs(ids ...int) *PurchaseOrderUpdateOne {
+	_u.mutation.RemoveBillIDs(ids...)
+	return _u
+}
+
+// RemoveBills removes "bills" edges to SupplierBill entities.
+func (_u *PurchaseOrderUpdateOne) RemoveBills(v ...*SupplierBill) *PurchaseOrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillIDs(ids...)
+}
+
+// ClearReceipts clears all "receipts" edges to the GoodsReceipt entity.
+func (_u *PurchaseOrderUpdateOne) ClearReceipts() *PurchaseOrderUpdateOne {
+	_u.mutation.ClearReceipts()
+	return _u
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to GoodsReceipt entities by IDs.
+func (_u *PurchaseOrderUpdateOne) RemoveReceiptIDs(ids ...int) *PurchaseOrderUpdateOne {
+	_u.mutation.RemoveReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveReceipts removes "receipts" edges to GoodsReceipt entities.
+func (_u *PurchaseOrderUpdateOne) RemoveReceipts(v ...*GoodsReceipt) *PurchaseOrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
 // Where appends a list predicates to the PurchaseOrderUpdate builder.
 func (_u *PurchaseOrderUpdateOne) Where(ps ...predicate.PurchaseOrder) *PurchaseOrderUpdateOne {
 	_u.mutation.Where(ps...)
@@ -733,6 +1000,9 @@ func (_u *PurchaseOrderUpdateOne) sqlSave(ctx context.Context) (_node *PurchaseO
 	if _u.mutation.NotesCleared() {
 		_spec.ClearField(purchaseorder.FieldNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.AutoGenerated(); ok {
+		_spec.SetField(purchaseorder.FieldAutoGenerated, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(purchaseorder.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -839,6 +1109,96 @@ func (_u *PurchaseOrderUpdateOne) sqlSave(ctx context.Context) (_node *PurchaseO
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.BillsTable,
+			Columns: []string{purchaseorder.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillsIDs(); len(nodes) > 0 && !_u.mutation.BillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.BillsTable,
+			Columns: []string{purchaseorder.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.BillsTable,
+			Columns: []string{purchaseorder.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.ReceiptsTable,
+			Columns: []string{purchaseorder.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !_u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.ReceiptsTable,
+			Columns: []string{purchaseorder.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorder.ReceiptsTable,
+			Columns: []string{purchaseorder.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &PurchaseOrder{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -25,6 +25,8 @@ type PurchaseOrderLine struct {
 	UnitCost decimal.Decimal `json:"unit_cost,omitempty"`
 	// ReceivedQty holds the value of the "received_qty" field.
 	ReceivedQty int `json:"received_qty,omitempty"`
+	// Status holds the value of the "status" field.
+	Status purchaseorderline.Status `json:"status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PurchaseOrderLineQuery when eager-loading is set.
 	Edges                        PurchaseOrderLineEdges `json:"edges"`
@@ -39,9 +41,13 @@ type PurchaseOrderLineEdges struct {
 	PurchaseOrder *PurchaseOrder `json:"purchase_order,omitempty"`
 	// Product holds the value of the product edge.
 	Product *Product `json:"product,omitempty"`
+	// BillLines holds the value of the bill_lines edge.
+	BillLines []*SupplierBillLine `json:"bill_lines,omitempty"`
+	// ReceiptLines holds the value of the receipt_lines edge.
+	ReceiptLines []*GoodsReceiptLine `json:"receipt_lines,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // PurchaseOrderOrErr returns the PurchaseOrder value or an error if the edge
@@ -66,6 +72,24 @@ func (e PurchaseOrderLineEdges) ProductOrErr() (*Product, error) {
 	return nil, &NotLoadedError{edge: "product"}
 }
 
+// BillLinesOrErr returns the BillLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e PurchaseOrderLineEdges) BillLinesOrErr() ([]*SupplierBillLine, error) {
+	if e.loadedTypes[2] {
+		return e.BillLines, nil
+	}
+	return nil, &NotLoadedError{edge: "bill_lines"}
+}
+
+// ReceiptLinesOrErr returns the ReceiptLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e PurchaseOrderLineEdges) ReceiptLinesOrErr() ([]*GoodsReceiptLine, error) {
+	if e.loadedTypes[3] {
+		return e.ReceiptLines, nil
+	}
+	return nil, &NotLoadedError{edge: "receipt_lines"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PurchaseOrderLine) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -75,6 +99,8 @@ func (*PurchaseOrderLine) scanValues(columns []string) ([]any, error) {
 			values[i] = new(decimal.Decimal)
 		case purchaseorderline.FieldID, purchaseorderline.FieldQuantity, purchaseorderline.FieldReceivedQty:
 			values[i] = new(sql.NullInt64)
+		case purchaseorderline.FieldStatus:
+			values[i] = new(sql.NullString)
 		case purchaseorderline.ForeignKeys[0]: // product_purchase_order_lines
 			values[i] = new(sql.NullInt64)
 		case purchaseorderline.ForeignKeys[1]: // purchase_order_lines
@@ -118,6 +144,12 @@ func (_m *PurchaseOrderLine) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.ReceivedQty = int(value.Int64)
 			}
+		case purchaseorderline.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = purchaseorderline.Status(value.String)
+			}
 		case purchaseorderline.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field product_purchase_order_lines", value)
@@ -155,6 +187,16 @@ func (_m *PurchaseOrderLine) QueryProduct() *ProductQuery {
 	return NewPurchaseOrderLineClient(_m.config).QueryProduct(_m)
 }
 
+// QueryBillLines queries the "bill_lines" edge of the PurchaseOrderLine entity.
+func (_m *PurchaseOrderLine) QueryBillLines() *SupplierBillLineQuery {
+	return NewPurchaseOrderLineClient(_m.config).QueryBillLines(_m)
+}
+
+// QueryReceiptLines queries the "receipt_lines" edge of the PurchaseOrderLine entity.
+func (_m *PurchaseOrderLine) QueryReceiptLines() *GoodsReceiptLineQuery {
+	return NewPurchaseOrderLineClient(_m.config).QueryReceiptLines(_m)
+}
+
 // Update returns a builder for updating this PurchaseOrderLine.
 // Note that you need to call PurchaseOrderLine.Unwrap() before calling this method if this PurchaseOrderLine
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -186,6 +228,9 @@ func (_m *PurchaseOrderLine) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("received_qty=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ReceivedQty))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package purchaseorderline
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/shopspring/decimal"
@@ -19,10 +21,16 @@ const (
 	FieldUnitCost = "unit_cost"
 	// FieldReceivedQty holds the string denoting the received_qty field in the database.
 	FieldReceivedQty = "received_qty"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// EdgePurchaseOrder holds the string denoting the purchase_order edge name in mutations.
 	EdgePurchaseOrder = "purchase_order"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
 	EdgeProduct = "product"
+	// EdgeBillLines holds the string denoting the bill_lines edge name in mutations.
+	EdgeBillLines = "bill_lines"
+	// EdgeReceiptLines holds the string denoting the receipt_lines edge name in mutations.
+	EdgeReceiptLines = "receipt_lines"
 	// Table holds the table name of the purchaseorderline in the database.
 	Table = "purchase_order_lines"
 	// PurchaseOrderTable is the table that holds the purchase_order relation/edge.
@@ -39,6 +47,20 @@ const (
 	ProductInverseTable = "products"
 	// ProductColumn is the table column denoting the product relation/edge.
 	ProductColumn = "product_purchase_order_lines"
+	// BillLinesTable is the table that holds the bill_lines relation/edge.
+	BillLinesTable = "supplier_bill_lines"
+	// BillLinesInverseTable is the table name for the SupplierBillLine entity.
+	// It exists in this package in order to avoid circular dependency with the "supplierbillline" package.
+	BillLinesInverseTable = "supplier_bill_lines"
+	// BillLinesColumn is the table column denoting the bill_lines relation/edge.
+	BillLinesColumn = "purchase_order_line_bill_lines"
+	// ReceiptLinesTable is the table that holds the receipt_lines relation/edge.
+	ReceiptLinesTable = "goods_receipt_lines"
+	// ReceiptLinesInverseTable is the table name for the GoodsReceiptLine entity.
+	// It exists in this package in order to avoid circular dependency with the "goodsreceiptline" package.
+	ReceiptLinesInverseTable = "goods_receipt_lines"
+	// ReceiptLinesColumn is the table column denoting the receipt_lines relation/edge.
+	ReceiptLinesColumn = "purchase_order_line_receipt_lines"
 )
 
 // Columns holds all SQL columns for purchaseorderline fields.
@@ -47,6 +69,7 @@ var Columns = []string{
 	FieldQuantity,
 	FieldUnitCost,
 	FieldReceivedQty,
+	FieldStatus,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "purchase_order_lines"
@@ -78,6 +101,33 @@ var (
 	DefaultReceivedQty int
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen              Status = "open"
+	StatusPartiallyReceived Status = "partially_received"
+	StatusClosed            Status = "closed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusPartiallyReceived, StatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("purchaseorderline: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the PurchaseOrderLine queries.
 type OrderOption func(*sql.Selector)
 
@@ -101,6 +151,11 @@ func ByReceivedQty(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReceivedQty, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
 // ByPurchaseOrderField orders the results by purchase_order field.
 func ByPurchaseOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -114,6 +169,34 @@ func ByProductField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProductStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByBillLinesCount orders the results by bill_lines count.
+func ByBillLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBillLinesStep(), opts...)
+	}
+}
+
+// ByBillLines orders the results by bill_lines terms.
+func ByBillLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceiptLinesCount orders the results by receipt_lines count.
+func ByReceiptLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceiptLinesStep(), opts...)
+	}
+}
+
+// ByReceiptLines orders the results by receipt_lines terms.
+func ByReceiptLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiptLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPurchaseOrderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -128,3 +211,17 @@ func newProductStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
 	)
 }
+func newBillLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BillLinesTable, BillLinesColumn),
+	)
+}
+func newReceiptLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiptLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceiptLinesTable, ReceiptLinesColumn),
+	)
+}
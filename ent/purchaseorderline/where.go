@@ -190,6 +190,26 @@ func ReceivedQtyLTE(v int) predicate.PurchaseOrderLine {
 	return predicate.PurchaseOrderLine(sql.FieldLTE(FieldReceivedQty, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PurchaseOrderLine {
+	return predicate.PurchaseOrderLine(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PurchaseOrderLine {
+	return predicate.PurchaseOrderLine(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PurchaseOrderLine {
+	return predicate.PurchaseOrderLine(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PurchaseOrderLine {
+	return predicate.PurchaseOrderLine(sql.FieldNotIn(FieldStatus, vs...))
+}
+
 // HasPurchaseOrder applies the HasEdge predicate on the "purchase_order" edge.
 func HasPurchaseOrder() predicate.PurchaseOrderLine {
 	return predicate.PurchaseOrderLine(func(s *sql.Selector) {
@@ -236,6 +256,52 @@ func HasProductWith(preds ...predicate.Product) predicate.PurchaseOrderLine {
 	})
 }
 
+// HasBillLines applies the HasEdge predicate on the "bill_lines" edge.
+func HasBillLines() predicate.PurchaseOrderLine {
+	return predicate.PurchaseOrderLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BillLinesTable, BillLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillLinesWith applies the HasEdge predicate on the "bill_lines" edge with a given conditions (other predicates).
+func HasBillLinesWith(preds ...predicate.SupplierBillLine) predicate.PurchaseOrderLine {
+	return predicate.PurchaseOrderLine(func(s *sql.Selector) {
+		step := newBillLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceiptLines applies the HasEdge predicate on the "receipt_lines" edge.
+func HasReceiptLines() predicate.PurchaseOrderLine {
+	return predicate.PurchaseOrderLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceiptLinesTable, ReceiptLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiptLinesWith applies the HasEdge predicate on the "receipt_lines" edge with a given conditions (other predicates).
+func HasReceiptLinesWith(preds ...predicate.GoodsReceiptLine) predicate.PurchaseOrderLine {
+	return predicate.PurchaseOrderLine(func(s *sql.Selector) {
+		step := newReceiptLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PurchaseOrderLine) predicate.PurchaseOrderLine {
 	return predicate.PurchaseOrderLine(sql.AndPredicates(predicates...))
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sent/ent/goodsreceiptline"
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
 	"sent/ent/purchaseorderline"
+	"sent/ent/supplierbillline"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -56,6 +58,20 @@ func (_c *PurchaseOrderLineCreate) SetNillableReceivedQty(v *int) *PurchaseOrder
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *PurchaseOrderLineCreate) SetStatus(v purchaseorderline.Status) *PurchaseOrderLineCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PurchaseOrderLineCreate) SetNillableStatus(v *purchaseorderline.Status) *PurchaseOrderLineCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
 // SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
 func (_c *PurchaseOrderLineCreate) SetPurchaseOrderID(id int) *PurchaseOrderLineCreate {
 	_c.mutation.SetPurchaseOrderID(id)
@@ -78,6 +94,36 @@ func (_c *PurchaseOrderLineCreate) SetProduct(v *Product) *PurchaseOrderLineCrea
 	return _c.SetProductID(v.ID)
 }
 
+// AddBillLineIDs adds the "bill_lines" edge to the SupplierBillLine entity by IDs.
+func (_c *PurchaseOrderLineCreate) AddBillLineIDs(ids ...int) *PurchaseOrderLineCreate {
+	_c.mutation.AddBillLineIDs(ids...)
+	return _c
+}
+
+// AddBillLines adds the "bill_lines" edges to the SupplierBillLine entity.
+func (_c *PurchaseOrderLineCreate) AddBillLines(v ...*SupplierBillLine) *PurchaseOrderLineCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBillLineIDs(ids...)
+}
+
+// AddReceiptLineIDs adds the "receipt_lines" edge to the GoodsReceiptLine entity by IDs.
+func (_c *PurchaseOrderLineCreate) AddReceiptLineIDs(ids ...int) *PurchaseOrderLineCreate {
+	_c.mutation.AddReceiptLineIDs(ids...)
+	return _c
+}
+
+// AddReceiptLines adds the "receipt_lines" edges to the GoodsReceiptLine entity.
+func (_c *PurchaseOrderLineCreate) AddReceiptLines(v ...*GoodsReceiptLine) *PurchaseOrderLineCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceiptLineIDs(ids...)
+}
+
 // Mutation returns the PurchaseOrderLineMutation object of the builder.
 func (_c *PurchaseOrderLineCreate) Mutation() *PurchaseOrderLineMutation {
 	return _c.mutation
@@ -121,6 +167,10 @@ func (_c *PurchaseOrderLineCreate) defaults() {
 		v := purchaseorderline.DefaultReceivedQty
 		_c.mutation.SetReceivedQty(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := purchaseorderline.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -134,6 +184,14 @@ func (_c *PurchaseOrderLineCreate) check() error {
 	if _, ok := _c.mutation.ReceivedQty(); !ok {
 		return &ValidationError{Name: "received_qty", err: errors.New(`ent: missing required field "PurchaseOrderLine.received_qty"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PurchaseOrderLine.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := purchaseorderline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PurchaseOrderLine.status": %w`, err)}
+		}
+	}
 	if len(_c.mutation.PurchaseOrderIDs()) == 0 {
 		return &ValidationError{Name: "purchase_order", err: errors.New(`ent: missing required edge "PurchaseOrderLine.purchase_order"`)}
 	}
@@ -178,6 +236,10 @@ func (_c *PurchaseOrderLineCreate) createSpec() (*PurchaseOrderLine, *sqlgraph.C
 		_spec.SetField(purchaseorderline.FieldReceivedQty, field.TypeInt, value)
 		_node.ReceivedQty = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(purchaseorderline.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
 	if nodes := _c.mutation.PurchaseOrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -212,6 +274,38 @@ func (_c *PurchaseOrderLineCreate) createSpec() (*PurchaseOrderLine, *sqlgraph.C
 		_node.product_purchase_order_lines = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BillLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.BillLinesTable,
+			Columns: []string{purchaseorderline.BillLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReceiptLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.ReceiptLinesTable,
+			Columns: []string{purchaseorderline.ReceiptLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
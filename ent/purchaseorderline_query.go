@@ -4,12 +4,15 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
+	"sent/ent/goodsreceiptline"
 	"sent/ent/predicate"
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
 	"sent/ent/purchaseorderline"
+	"sent/ent/supplierbillline"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -26,6 +29,8 @@ type PurchaseOrderLineQuery struct {
 	predicates        []predicate.PurchaseOrderLine
 	withPurchaseOrder *PurchaseOrderQuery
 	withProduct       *ProductQuery
+	withBillLines     *SupplierBillLineQuery
+	withReceiptLines  *GoodsReceiptLineQuery
 	withFKs           bool
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -108,6 +113,50 @@ func (_q *PurchaseOrderLineQuery) QueryProduct() *ProductQuery {
 	return query
 }
 
+// QueryBillLines chains the current query on the "bill_lines" edge.
+func (_q *PurchaseOrderLineQuery) QueryBillLines() *SupplierBillLineQuery {
+	query := (&SupplierBillLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(purchaseorderline.Table, purchaseorderline.FieldID, selector),
+			sqlgraph.To(supplierbillline.Table, supplierbillline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, purchaseorderline.BillLinesTable, purchaseorderline.BillLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceiptLines chains the current query on the "receipt_lines" edge.
+func (_q *PurchaseOrderLineQuery) QueryReceiptLines() *GoodsReceiptLineQuery {
+	query := (&GoodsReceiptLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(purchaseorderline.Table, purchaseorderline.FieldID, selector),
+			sqlgraph.To(goodsreceiptline.Table, goodsreceiptline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, purchaseorderline.ReceiptLinesTable, purchaseorderline.ReceiptLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PurchaseOrderLine entity from the query.
 // Returns a *NotFoundError when no PurchaseOrderLine was found.
 func (_q *PurchaseOrderLineQuery) First(ctx context.Context) (*PurchaseOrderLine, error) {
@@ -302,6 +351,8 @@ func (_q *PurchaseOrderLineQuery) Clone() *PurchaseOrderLineQuery {
 		predicates:        append([]predicate.PurchaseOrderLine{}, _q.predicates...),
 		withPurchaseOrder: _q.withPurchaseOrder.Clone(),
 		withProduct:       _q.withProduct.Clone(),
+		withBillLines:     _q.withBillLines.Clone(),
+		withReceiptLines:  _q.withReceiptLines.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -331,6 +382,28 @@ func (_q *PurchaseOrderLineQuery) WithProduct(opts ...func(*ProductQuery)) *Purc
 	return _q
 }
 
+// WithBillLines tells the query-builder to eager-load the nodes that are connected to
+// the "bill_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PurchaseOrderLineQuery) WithBillLines(opts ...func(*SupplierBillLineQuery)) *PurchaseOrderLineQuery {
+	query := (&SupplierBillLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBillLines = query
+	return _q
+}
+
+// WithReceiptLines tells the query-builder to eager-load the nodes that are connected to
+// the "receipt_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PurchaseOrderLineQuery) WithReceiptLines(opts ...func(*GoodsReceiptLineQuery)) *PurchaseOrderLineQuery {
+	query := (&GoodsReceiptLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReceiptLines = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,9 +483,11 @@ func (_q *PurchaseOrderLineQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		nodes       = []*PurchaseOrderLine{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withPurchaseOrder != nil,
 			_q.withProduct != nil,
+			_q.withBillLines != nil,
+			_q.withReceiptLines != nil,
 		}
 	)
 	if _q.withPurchaseOrder != nil || _q.withProduct != nil {
@@ -454,6 +529,22 @@ func (_q *PurchaseOrderLineQuery) sqlAll(ctx context.Context, hooks ...queryHook
 			return nil, err
 		}
 	}
+	if query := _q.withBillLines; query != nil {
+		if err := _q.loadBillLines(ctx, query, nodes,
+			func(n *PurchaseOrderLine) { n.Edges.BillLines = []*SupplierBillLine{} },
+			func(n *PurchaseOrderLine, e *SupplierBillLine) { n.Edges.BillLines = append(n.Edges.BillLines, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReceiptLines; query != nil {
+		if err := _q.loadReceiptLines(ctx, query, nodes,
+			func(n *PurchaseOrderLine) { n.Edges.ReceiptLines = []*GoodsReceiptLine{} },
+			func(n *PurchaseOrderLine, e *GoodsReceiptLine) {
+				n.Edges.ReceiptLines = append(n.Edges.ReceiptLines, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -521,6 +612,68 @@ func (_q *PurchaseOrderLineQuery) loadProduct(ctx context.Context, query *Produc
 	}
 	return nil
 }
+func (_q *PurchaseOrderLineQuery) loadBillLines(ctx context.Context, query *SupplierBillLineQuery, nodes []*PurchaseOrderLine, init func(*PurchaseOrderLine), assign func(*PurchaseOrderLine, *SupplierBillLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PurchaseOrderLine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SupplierBillLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(purchaseorderline.BillLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.purchase_order_line_bill_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "purchase_order_line_bill_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "purchase_order_line_bill_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PurchaseOrderLineQuery) loadReceiptLines(ctx context.Context, query *GoodsReceiptLineQuery, nodes []*PurchaseOrderLine, init func(*PurchaseOrderLine), assign func(*PurchaseOrderLine, *GoodsReceiptLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PurchaseOrderLine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(purchaseorderline.ReceiptLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.purchase_order_line_receipt_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "purchase_order_line_receipt_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "purchase_order_line_receipt_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PurchaseOrderLineQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,10 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sent/ent/goodsreceiptline"
 	"sent/ent/predicate"
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
 	"sent/ent/purchaseorderline"
+	"sent/ent/supplierbillline"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -87,6 +89,20 @@ func (_u *PurchaseOrderLineUpdate) AddReceivedQty(v int) *PurchaseOrderLineUpdat
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *PurchaseOrderLineUpdate) SetStatus(v purchaseorderline.Status) *PurchaseOrderLineUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PurchaseOrderLineUpdate) SetNillableStatus(v *purchaseorderline.Status) *PurchaseOrderLineUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
 func (_u *PurchaseOrderLineUpdate) SetPurchaseOrderID(id int) *PurchaseOrderLineUpdate {
 	_u.mutation.SetPurchaseOrderID(id)
@@ -109,6 +125,36 @@ func (_u *PurchaseOrderLineUpdate) SetProduct(v *Product) *PurchaseOrderLineUpda
 	return _u.SetProductID(v.ID)
 }
 
+// AddBillLineIDs adds the "bill_lines" edge to the SupplierBillLine entity by IDs.
+func (_u *PurchaseOrderLineUpdate) AddBillLineIDs(ids ...int) *PurchaseOrderLineUpdate {
+	_u.mutation.AddBillLineIDs(ids...)
+	return _u
+}
+
+// AddBillLines adds the "bill_lines" edges to the SupplierBillLine entity.
+func (_u *PurchaseOrderLineUpdate) AddBillLines(v ...*SupplierBillLine) *PurchaseOrderLineUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillLineIDs(ids...)
+}
+
+// AddReceiptLineIDs adds the "receipt_lines" edge to the GoodsReceiptLine entity by IDs.
+func (_u *PurchaseOrderLineUpdate) AddReceiptLineIDs(ids ...int) *PurchaseOrderLineUpdate {
+	_u.mutation.AddReceiptLineIDs(ids...)
+	return _u
+}
+
+// AddReceiptLines adds the "receipt_lines" edges to the GoodsReceiptLine entity.
+func (_u *PurchaseOrderLineUpdate) AddReceiptLines(v ...*GoodsReceiptLine) *PurchaseOrderLineUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptLineIDs(ids...)
+}
+
 // Mutation returns the PurchaseOrderLineMutation object of the builder.
 func (_u *PurchaseOrderLineUpdate) Mutation() *PurchaseOrderLineMutation {
 	return _u.mutation
@@ -126,6 +172,48 @@ func (_u *PurchaseOrderLineUpdate) ClearProduct() *PurchaseOrderLineUpdate {
 	return _u
 }
 
+// ClearBillLines clears all "bill_lines" edges to the SupplierBillLine entity.
+func (_u *PurchaseOrderLineUpdate) ClearBillLines() *PurchaseOrderLineUpdate {
+	_u.mutation.ClearBillLines()
+	return _u
+}
+
+// RemoveBillLineIDs removes the "bill_lines" edge to SupplierBillLine entities by IDs.
+func (_u *PurchaseOrderLineUpdate) RemoveBillLineIDs(ids ...int) *PurchaseOrderLineUpdate {
+	_u.mutation.RemoveBillLineIDs(ids...)
+	return _u
+}
+
+// RemoveBillLines removes "bill_lines" edges to SupplierBillLine entities.
+func (_u *PurchaseOrderLineUpdate) RemoveBillLines(v ...*SupplierBillLine) *PurchaseOrderLineUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillLineIDs(ids...)
+}
+
+// ClearReceiptLines clears all "receipt_lines" edges to the GoodsReceiptLine entity.
+func (_u *PurchaseOrderLineUpdate) ClearReceiptLines() *PurchaseOrderLineUpdate {
+	_u.mutation.ClearReceiptLines()
+	return _u
+}
+
+// RemoveReceiptLineIDs removes the "receipt_lines" edge to GoodsReceiptLine entities by IDs.
+func (_u *PurchaseOrderLineUpdate) RemoveReceiptLineIDs(ids ...int) *PurchaseOrderLineUpdate {
+	_u.mutation.RemoveReceiptLineIDs(ids...)
+	return _u
+}
+
+// RemoveReceiptLines removes "receipt_lines" edges to GoodsReceiptLine entities.
+func (_u *PurchaseOrderLineUpdate) RemoveReceiptLines(v ...*GoodsReceiptLine) *PurchaseOrderLineUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptLineIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PurchaseOrderLineUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -155,6 +243,11 @@ func (_u *PurchaseOrderLineUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *PurchaseOrderLineUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := purchaseorderline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PurchaseOrderLine.status": %w`, err)}
+		}
+	}
 	if _u.mutation.PurchaseOrderCleared() && len(_u.mutation.PurchaseOrderIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PurchaseOrderLine.purchase_order"`)
 	}
@@ -197,6 +290,9 @@ func (_u *PurchaseOrderLineUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.AddedReceivedQty(); ok {
 		_spec.AddField(purchaseorderline.FieldReceivedQty, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(purchaseorderline.FieldStatus, field.TypeEnum, value)
+	}
 	if _u.mutation.PurchaseOrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -255,6 +351,96 @@ func (_u *PurchaseOrderLineUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BillLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.BillLinesTable,
+			Columns: []string{purchaseorderline.BillLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillLinesIDs(); len(nodes) > 0 && !_u.mutation.BillLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.BillLinesTable,
+			Columns: []string{purchaseorderline.BillLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.BillLinesTable,
+			Columns: []string{purchaseorderline.BillLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceiptLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.ReceiptLinesTable,
+			Columns: []string{purchaseorderline.ReceiptLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptLinesIDs(); len(nodes) > 0 && !_u.mutation.ReceiptLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.ReceiptLinesTable,
+			Columns: []string{purchaseorderline.ReceiptLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.ReceiptLinesTable,
+			Columns: []string{purchaseorderline.ReceiptLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -333,6 +519,20 @@ func (_u *PurchaseOrderLineUpdateOne) AddReceivedQty(v int) *PurchaseOrderLineUp
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *PurchaseOrderLineUpdateOne) SetStatus(v purchaseorderline.Status) *PurchaseOrderLineUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PurchaseOrderLineUpdateOne) SetNillableStatus(v *purchaseorderline.Status) *PurchaseOrderLineUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
 func (_u *PurchaseOrderLineUpdateOne) SetPurchaseOrderID(id int) *PurchaseOrderLineUpdateOne {
 	_u.mutation.SetPurchaseOrderID(id)
@@ -355,6 +555,36 @@ func (_u *PurchaseOrderLineUpdateOne) SetProduct(v *Product) *PurchaseOrderLineU
 	return _u.SetProductID(v.ID)
 }
 
+// AddBillLineIDs adds the "bill_lines" edge to the SupplierBillLine entity by IDs.
+func (_u *PurchaseOrderLineUpdateOne) AddBillLineIDs(ids ...int) *PurchaseOrderLineUpdateOne {
+	_u.mutation.AddBillLineIDs(ids...)
+	return _u
+}
+
+// AddBillLines adds the "bill_lines" edges to the SupplierBillLine entity.
+func (_u *PurchaseOrderLineUpdateOne) AddBillLines(v ...*SupplierBillLine) *PurchaseOrderLineUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillLineIDs(ids...)
+}
+
+// AddReceiptLineIDs adds the "receipt_lines" edge to the GoodsReceiptLine entity by IDs.
+func (_u *PurchaseOrderLineUpdateOne) AddReceiptLineIDs(ids ...int) *PurchaseOrderLineUpdateOne {
+	_u.mutation.AddReceiptLineIDs(ids...)
+	return _u
+}
+
+// AddReceiptLines adds the "receipt_lines" edges to the GoodsReceiptLine entity.
+func (_u *PurchaseOrderLineUpdateOne) AddReceiptLines(v ...*GoodsReceiptLine) *PurchaseOrderLineUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptLineIDs(ids...)
+}
+
 // Mutation returns the PurchaseOrderLineMutation object of the builder.
 func (_u *PurchaseOrderLineUpdateOne) Mutation() *PurchaseOrderLineMutation {
 	return _u.mutation
@@ -372,6 +602,48 @@ func (_u *PurchaseOrderLineUpdateOne) ClearProduct() *PurchaseOrderLineUpdateOne
 	return _u
 }
 
+// ClearBillLines clears all "bill_lines" edges to the SupplierBillLine entity.
+func (_u *PurchaseOrderLineUpdateOne) ClearBillLines() *PurchaseOrderLineUpdateOne {
+	_u.mutation.ClearBillLines()
+	return _u
+}
+
+// RemoveBillLineIDs removes the "bill_lines" edge to SupplierBillLine entities by IDs.
+func (_u *PurchaseOrderLineUpdateOne) RemoveBillLineIDs(ids ...int) *PurchaseOrderLineUpdateOne {
+	_u.mutation.RemoveBillLineIDs(ids...)
+	return _u
+}
+
+// RemoveBillLines removes "bill_lines" edges to SupplierBillLine entities.
+func (_u *PurchaseOrderLineUpdateOne) RemoveBillLines(v ...*SupplierBillLine) *PurchaseOrderLineUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillLineIDs(ids...)
+}
+
+// ClearReceiptLines clears all "receipt_lines" edges to the GoodsReceiptLine entity.
+func (_u *PurchaseOrderLineUpdateOne) ClearReceiptLines() *PurchaseOrderLineUpdateOne {
+	_u.mutation.ClearReceiptLines()
+	return _u
+}
+
+// RemoveReceiptLineIDs removes the "receipt_lines" edge to GoodsReceiptLine entities by IDs.
+func (_u *PurchaseOrderLineUpdateOne) RemoveReceiptLineIDs(ids ...int) *PurchaseOrderLineUpdateOne {
+	_u.mutation.RemoveReceiptLineIDs(ids...)
+	return _u
+}
+
+// RemoveReceiptLines removes "receipt_lines" edges to GoodsReceiptLine entities.
+func (_u *PurchaseOrderLineUpdateOne) RemoveReceiptLines(v ...*GoodsReceiptLine) *PurchaseOrderLineUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptLineIDs(ids...)
+}
+
 // Where appends a list predicates to the PurchaseOrderLineUpdate builder.
 func (_u *PurchaseOrderLineUpdateOne) Where(ps ...predicate.PurchaseOrderLine) *PurchaseOrderLineUpdateOne {
 	_u.mutation.Where(ps...)
@@ -414,6 +686,11 @@ func (_u *PurchaseOrderLineUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *PurchaseOrderLineUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := purchaseorderline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PurchaseOrderLine.status": %w`, err)}
+		}
+	}
 	if _u.mutation.PurchaseOrderCleared() && len(_u.mutation.PurchaseOrderIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PurchaseOrderLine.purchase_order"`)
 	}
@@ -473,6 +750,9 @@ func (_u *PurchaseOrderLineUpdateOne) sqlSave(ctx context.Context) (_node *Purch
 	if value, ok := _u.mutation.AddedReceivedQty(); ok {
 		_spec.AddField(purchaseorderline.FieldReceivedQty, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(purchaseorderline.FieldStatus, field.TypeEnum, value)
+	}
 	if _u.mutation.PurchaseOrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -531,6 +811,96 @@ func (_u *PurchaseOrderLineUpdateOne) sqlSave(ctx context.Context) (_node *Purch
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BillLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.BillLinesTable,
+			Columns: []string{purchaseorderline.BillLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillLinesIDs(); len(nodes) > 0 && !_u.mutation.BillLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.BillLinesTable,
+			Columns: []string{purchaseorderline.BillLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.BillLinesTable,
+			Columns: []string{purchaseorderline.BillLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceiptLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.ReceiptLinesTable,
+			Columns: []string{purchaseorderline.ReceiptLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptLinesIDs(); len(nodes) > 0 && !_u.mutation.ReceiptLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.ReceiptLinesTable,
+			Columns: []string{purchaseorderline.ReceiptLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   purchaseorderline.ReceiptLinesTable,
+			Columns: []string{purchaseorderline.ReceiptLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &PurchaseOrderLine{config: _u.config}
 	_spec.Assign = _node.assignValues
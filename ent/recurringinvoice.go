@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/customer"
 	"sent/ent/recurringinvoice"
 	"sent/ent/tenant"
 	"strings"
@@ -40,6 +41,7 @@ type RecurringInvoice struct {
 	// The values are being populated by the RecurringInvoiceQuery when eager-loading is set.
 	Edges                      RecurringInvoiceEdges `json:"edges"`
 	account_recurring_invoices *int
+	recurring_invoice_customer *int
 	tenant_recurring_invoices  *int
 	selectValues               sql.SelectValues
 }
@@ -50,9 +52,11 @@ type RecurringInvoiceEdges struct {
 	Tenant *Tenant `json:"tenant,omitempty"`
 	// Account holds the value of the account edge.
 	Account *Account `json:"account,omitempty"`
+	// Customer holds the value of the customer edge.
+	Customer *Customer `json:"customer,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -77,6 +81,17 @@ func (e RecurringInvoiceEdges) AccountOrErr() (*Account, error) {
 	return nil, &NotLoadedError{edge: "account"}
 }
 
+// CustomerOrErr returns the Customer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurringInvoiceEdges) CustomerOrErr() (*Customer, error) {
+	if e.Customer != nil {
+		return e.Customer, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: customer.Label}
+	}
+	return nil, &NotLoadedError{edge: "customer"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*RecurringInvoice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -94,7 +109,9 @@ func (*RecurringInvoice) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullTime)
 		case recurringinvoice.ForeignKeys[0]: // account_recurring_invoices
 			values[i] = new(sql.NullInt64)
-		case recurringinvoice.ForeignKeys[1]: // tenant_recurring_invoices
+		case recurringinvoice.ForeignKeys[1]: // recurring_invoice_customer
+			values[i] = new(sql.NullInt64)
+		case recurringinvoice.ForeignKeys[2]: // tenant_recurring_invoices
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -174,6 +191,13 @@ func (_m *RecurringInvoice) assignValues(columns []string, values []any) error {
 				*_m.account_recurring_invoices = int(value.Int64)
 			}
 		case recurringinvoice.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field recurring_invoice_customer", value)
+			} else if value.Valid {
+				_m.recurring_invoice_customer = new(int)
+				*_m.recurring_invoice_customer = int(value.Int64)
+			}
+		case recurringinvoice.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field tenant_recurring_invoices", value)
 			} else if value.Valid {
@@ -203,6 +227,11 @@ func (_m *RecurringInvoice) QueryAccount() *AccountQuery {
 	return NewRecurringInvoiceClient(_m.config).QueryAccount(_m)
 }
 
+// QueryCustomer queries the "customer" edge of the RecurringInvoice entity.
+func (_m *RecurringInvoice) QueryCustomer() *CustomerQuery {
+	return NewRecurringInvoiceClient(_m.config).QueryCustomer(_m)
+}
+
 // Update returns a builder for updating this RecurringInvoice.
 // Note that you need to call RecurringInvoice.Unwrap() before calling this method if this RecurringInvoice
 // was returned from a transaction, and the transaction was committed or rolled back.
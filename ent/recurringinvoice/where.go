@@ -517,6 +517,29 @@ func HasAccountWith(preds ...predicate.Account) predicate.RecurringInvoice {
 	})
 }
 
+// HasCustomer applies the HasEdge predicate on the "customer" edge.
+func HasCustomer() predicate.RecurringInvoice {
+	return predicate.RecurringInvoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CustomerTable, CustomerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomerWith applies the HasEdge predicate on the "customer" edge with a given conditions (other predicates).
+func HasCustomerWith(preds ...predicate.Customer) predicate.RecurringInvoice {
+	return predicate.RecurringInvoice(func(s *sql.Selector) {
+		step := newCustomerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.RecurringInvoice) predicate.RecurringInvoice {
 	return predicate.RecurringInvoice(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/customer"
 	"sent/ent/recurringinvoice"
 	"sent/ent/tenant"
 	"time"
@@ -149,6 +150,25 @@ func (_c *RecurringInvoiceCreate) SetAccount(v *Account) *RecurringInvoiceCreate
 	return _c.SetAccountID(v.ID)
 }
 
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_c *RecurringInvoiceCreate) SetCustomerID(id int) *RecurringInvoiceCreate {
+	_c.mutation.SetCustomerID(id)
+	return _c
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (_c *RecurringInvoiceCreate) SetNillableCustomerID(id *int) *RecurringInvoiceCreate {
+	if id != nil {
+		_c = _c.SetCustomerID(*id)
+	}
+	return _c
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_c *RecurringInvoiceCreate) SetCustomer(v *Customer) *RecurringInvoiceCreate {
+	return _c.SetCustomerID(v.ID)
+}
+
 // Mutation returns the RecurringInvoiceMutation object of the builder.
 func (_c *RecurringInvoiceCreate) Mutation() *RecurringInvoiceMutation {
 	return _c.mutation
@@ -331,6 +351,23 @@ func (_c *RecurringInvoiceCreate) createSpec() (*RecurringInvoice, *sqlgraph.Cre
 		_node.account_recurring_invoices = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringinvoice.CustomerTable,
+			Columns: []string{recurringinvoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.recurring_invoice_customer = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"sent/ent/account"
+	"sent/ent/customer"
 	"sent/ent/predicate"
 	"sent/ent/recurringinvoice"
 	"sent/ent/tenant"
@@ -20,14 +21,15 @@ import (
 // RecurringInvoiceQuery is the builder for querying RecurringInvoice entities.
 type RecurringInvoiceQuery struct {
 	config
-	ctx         *QueryContext
-	order       []recurringinvoice.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.RecurringInvoice
-	withTenant  *TenantQuery
-	withAccount *AccountQuery
-	withFKs     bool
-	modifiers   []func(*sql.Selector)
+	ctx          *QueryContext
+	order        []recurringinvoice.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.RecurringInvoice
+	withTenant   *TenantQuery
+	withAccount  *AccountQuery
+	withCustomer *CustomerQuery
+	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +110,28 @@ func (_q *RecurringInvoiceQuery) QueryAccount() *AccountQuery {
 	return query
 }
 
+// QueryCustomer chains the current query on the "customer" edge.
+func (_q *RecurringInvoiceQuery) QueryCustomer() *CustomerQuery {
+	query := (&CustomerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurringinvoice.Table, recurringinvoice.FieldID, selector),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, recurringinvoice.CustomerTable, recurringinvoice.CustomerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first RecurringInvoice entity from the query.
 // Returns a *NotFoundError when no RecurringInvoice was found.
 func (_q *RecurringInvoiceQuery) First(ctx context.Context) (*RecurringInvoice, error) {
@@ -295,13 +319,14 @@ func (_q *RecurringInvoiceQuery) Clone() *RecurringInvoiceQuery {
 		return nil
 	}
 	return &RecurringInvoiceQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]recurringinvoice.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.RecurringInvoice{}, _q.predicates...),
-		withTenant:  _q.withTenant.Clone(),
-		withAccount: _q.withAccount.Clone(),
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]recurringinvoice.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.RecurringInvoice{}, _q.predicates...),
+		withTenant:   _q.withTenant.Clone(),
+		withAccount:  _q.withAccount.Clone(),
+		withCustomer: _q.withCustomer.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -331,6 +356,17 @@ func (_q *RecurringInvoiceQuery) WithAccount(opts ...func(*AccountQuery)) *Recur
 	return _q
 }
 
+// WithCustomer tells the query-builder to eager-load the nodes that are connected to
+// the "customer" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurringInvoiceQuery) WithCustomer(opts ...func(*CustomerQuery)) *RecurringInvoiceQuery {
+	query := (&CustomerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCustomer = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,12 +446,13 @@ func (_q *RecurringInvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*RecurringInvoice{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withTenant != nil,
 			_q.withAccount != nil,
+			_q.withCustomer != nil,
 		}
 	)
-	if _q.withTenant != nil || _q.withAccount != nil {
+	if _q.withTenant != nil || _q.withAccount != nil || _q.withCustomer != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -454,6 +491,12 @@ func (_q *RecurringInvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withCustomer; query != nil {
+		if err := _q.loadCustomer(ctx, query, nodes, nil,
+			func(n *RecurringInvoice, e *Customer) { n.Edges.Customer = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -521,6 +564,38 @@ func (_q *RecurringInvoiceQuery) loadAccount(ctx context.Context, query *Account
 	}
 	return nil
 }
+func (_q *RecurringInvoiceQuery) loadCustomer(ctx context.Context, query *CustomerQuery, nodes []*RecurringInvoice, init func(*RecurringInvoice), assign func(*RecurringInvoice, *Customer)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurringInvoice)
+	for i := range nodes {
+		if nodes[i].recurring_invoice_customer == nil {
+			continue
+		}
+		fk := *nodes[i].recurring_invoice_customer
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(customer.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "recurring_invoice_customer" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *RecurringInvoiceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/customer"
 	"sent/ent/predicate"
 	"sent/ent/recurringinvoice"
 	"sent/ent/tenant"
@@ -172,6 +173,25 @@ func (_u *RecurringInvoiceUpdate) SetAccount(v *Account) *RecurringInvoiceUpdate
 	return _u.SetAccountID(v.ID)
 }
 
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_u *RecurringInvoiceUpdate) SetCustomerID(id int) *RecurringInvoiceUpdate {
+	_u.mutation.SetCustomerID(id)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (_u *RecurringInvoiceUpdate) SetNillableCustomerID(id *int) *RecurringInvoiceUpdate {
+	if id != nil {
+		_u = _u.SetCustomerID(*id)
+	}
+	return _u
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_u *RecurringInvoiceUpdate) SetCustomer(v *Customer) *RecurringInvoiceUpdate {
+	return _u.SetCustomerID(v.ID)
+}
+
 // Mutation returns the RecurringInvoiceMutation object of the builder.
 func (_u *RecurringInvoiceUpdate) Mutation() *RecurringInvoiceMutation {
 	return _u.mutation
@@ -189,6 +209,12 @@ func (_u *RecurringInvoiceUpdate) ClearAccount() *RecurringInvoiceUpdate {
 	return _u
 }
 
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (_u *RecurringInvoiceUpdate) ClearCustomer() *RecurringInvoiceUpdate {
+	_u.mutation.ClearCustomer()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *RecurringInvoiceUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -330,6 +356,35 @@ func (_u *RecurringInvoiceUpdate) sqlSave(ctx context.Context) (_node int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringinvoice.CustomerTable,
+			Columns: []string{recurringinvoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringinvoice.CustomerTable,
+			Columns: []string{recurringinvoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -492,6 +547,25 @@ func (_u *RecurringInvoiceUpdateOne) SetAccount(v *Account) *RecurringInvoiceUpd
 	return _u.SetAccountID(v.ID)
 }
 
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_u *RecurringInvoiceUpdateOne) SetCustomerID(id int) *RecurringInvoiceUpdateOne {
+	_u.mutation.SetCustomerID(id)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (_u *RecurringInvoiceUpdateOne) SetNillableCustomerID(id *int) *RecurringInvoiceUpdateOne {
+	if id != nil {
+		_u = _u.SetCustomerID(*id)
+	}
+	return _u
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_u *RecurringInvoiceUpdateOne) SetCustomer(v *Customer) *RecurringInvoiceUpdateOne {
+	return _u.SetCustomerID(v.ID)
+}
+
 // Mutation returns the RecurringInvoiceMutation object of the builder.
 func (_u *RecurringInvoiceUpdateOne) Mutation() *RecurringInvoiceMutation {
 	return _u.mutation
@@ -509,6 +583,12 @@ func (_u *RecurringInvoiceUpdateOne) ClearAccount() *RecurringInvoiceUpdateOne {
 	return _u
 }
 
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (_u *RecurringInvoiceUpdateOne) ClearCustomer() *RecurringInvoiceUpdateOne {
+	_u.mutation.ClearCustomer()
+	return _u
+}
+
 // Where appends a list predicates to the RecurringInvoiceUpdate builder.
 func (_u *RecurringInvoiceUpdateOne) Where(ps ...predicate.RecurringInvoice) *RecurringInvoiceUpdateOne {
 	_u.mutation.Where(ps...)
@@ -680,6 +760,35 @@ func (_u *RecurringInvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Recurr
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringinvoice.CustomerTable,
+			Columns: []string{recurringinvoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringinvoice.CustomerTable,
+			Columns: []string{recurringinvoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &RecurringInvoice{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -4,11 +4,21 @@ package ent
 
 import (
 	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
 	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/agentauthority"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/agentinventory"
+	"sent/ent/agentsoftware"
+	"sent/ent/alertrule"
 	"sent/ent/application"
 	"sent/ent/asset"
 	"sent/ent/assetassignment"
 	"sent/ent/auditlog"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
 	"sent/ent/benefitenrollment"
 	"sent/ent/benefitplan"
 	"sent/ent/budgetforecast"
@@ -20,19 +30,32 @@ import (
 	"sent/ent/contact"
 	"sent/ent/contract"
 	"sent/ent/credential"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
 	"sent/ent/detectionevent"
+	"sent/ent/devicegroup"
 	"sent/ent/discoveryentry"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
 	"sent/ent/employee"
+	"sent/ent/exchangerate"
+	"sent/ent/fiscalperiod"
 	"sent/ent/goal"
+	"sent/ent/goodsreceipt"
+	"sent/ent/goodsreceiptline"
 	"sent/ent/healthscoresnapshot"
 	"sent/ent/interview"
 	"sent/ent/inventorycount"
 	"sent/ent/inventoryreservation"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+	"sent/ent/invoicetaxline"
 	"sent/ent/ivrflow"
 	"sent/ent/job"
 	"sent/ent/jobexecution"
 	"sent/ent/jobposting"
 	"sent/ent/journalentry"
+	"sent/ent/labeltemplate"
 	"sent/ent/ledgerentry"
 	"sent/ent/legalhold"
 	"sent/ent/maintenanceschedule"
@@ -42,7 +65,14 @@ import (
 	"sent/ent/networkport"
 	"sent/ent/nexusaudit"
 	"sent/ent/onetimelink"
+	"sent/ent/patchpolicy"
+	"sent/ent/paymentallocation"
+	"sent/ent/paymentrun"
+	"sent/ent/paymentrunline"
 	"sent/ent/performancereview"
+	"sent/ent/possaleline"
+	"sent/ent/posshift"
+	"sent/ent/postender"
 	"sent/ent/product"
 	"sent/ent/productvariant"
 	"sent/ent/purchaseorder"
@@ -61,10 +91,15 @@ import (
 	"sent/ent/sop"
 	"sent/ent/stockalert"
 	"sent/ent/stockauditlog"
+	"sent/ent/stocklevel"
 	"sent/ent/stockmovement"
 	"sent/ent/strategicroadmap"
 	"sent/ent/successionmap"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
 	"sent/ent/tenant"
 	"sent/ent/ticket"
 	"sent/ent/timeentry"
@@ -72,6 +107,8 @@ import (
 	"sent/ent/timeoffpolicy"
 	"sent/ent/timeoffrequest"
 	"sent/ent/transaction"
+	"sent/ent/transferorder"
+	"sent/ent/transferorderline"
 	"sent/ent/user"
 	"sent/ent/vaultcomment"
 	"sent/ent/vaultfavorite"
@@ -102,9 +139,27 @@ func init() {
 	// account.DefaultIsIntercompany holds the default value on creation for the is_intercompany field.
 	account.DefaultIsIntercompany = accountDescIsIntercompany.Default.(bool)
 	// accountDescCreatedAt is the schema descriptor for created_at field.
-	accountDescCreatedAt := accountFields[5].Descriptor()
+	accountDescCreatedAt := accountFields[6].Descriptor()
 	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
 	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
+	accountbalancesnapshotFields := schema.AccountBalanceSnapshot{}.Fields()
+	_ = accountbalancesnapshotFields
+	// accountbalancesnapshotDescDebitTotal is the schema descriptor for debit_total field.
+	accountbalancesnapshotDescDebitTotal := accountbalancesnapshotFields[2].Descriptor()
+	// accountbalancesnapshot.DefaultDebitTotal holds the default value on creation for the debit_total field.
+	accountbalancesnapshot.DefaultDebitTotal = accountbalancesnapshotDescDebitTotal.Default.(decimal.Decimal)
+	// accountbalancesnapshotDescCreditTotal is the schema descriptor for credit_total field.
+	accountbalancesnapshotDescCreditTotal := accountbalancesnapshotFields[3].Descriptor()
+	// accountbalancesnapshot.DefaultCreditTotal holds the default value on creation for the credit_total field.
+	accountbalancesnapshot.DefaultCreditTotal = accountbalancesnapshotDescCreditTotal.Default.(decimal.Decimal)
+	// accountbalancesnapshotDescClosingBalance is the schema descriptor for closing_balance field.
+	accountbalancesnapshotDescClosingBalance := accountbalancesnapshotFields[4].Descriptor()
+	// accountbalancesnapshot.DefaultClosingBalance holds the default value on creation for the closing_balance field.
+	accountbalancesnapshot.DefaultClosingBalance = accountbalancesnapshotDescClosingBalance.Default.(decimal.Decimal)
+	// accountbalancesnapshotDescComputedAt is the schema descriptor for computed_at field.
+	accountbalancesnapshotDescComputedAt := accountbalancesnapshotFields[5].Descriptor()
+	// accountbalancesnapshot.DefaultComputedAt holds the default value on creation for the computed_at field.
+	accountbalancesnapshot.DefaultComputedAt = accountbalancesnapshotDescComputedAt.Default.(func() time.Time)
 	agentFields := schema.Agent{}.Fields()
 	_ = agentFields
 	// agentDescHostname is the schema descriptor for hostname field.
@@ -135,16 +190,82 @@ func init() {
 	agentDescLastSeen := agentFields[7].Descriptor()
 	// agent.DefaultLastSeen holds the default value on creation for the last_seen field.
 	agent.DefaultLastSeen = agentDescLastSeen.Default.(func() time.Time)
+	// agentDescRekeyRequested is the schema descriptor for rekey_requested field.
+	agentDescRekeyRequested := agentFields[15].Descriptor()
+	// agent.DefaultRekeyRequested holds the default value on creation for the rekey_requested field.
+	agent.DefaultRekeyRequested = agentDescRekeyRequested.Default.(bool)
 	// agentDescCreatedAt is the schema descriptor for created_at field.
-	agentDescCreatedAt := agentFields[8].Descriptor()
+	agentDescCreatedAt := agentFields[17].Descriptor()
 	// agent.DefaultCreatedAt holds the default value on creation for the created_at field.
 	agent.DefaultCreatedAt = agentDescCreatedAt.Default.(func() time.Time)
 	// agentDescUpdatedAt is the schema descriptor for updated_at field.
-	agentDescUpdatedAt := agentFields[9].Descriptor()
+	agentDescUpdatedAt := agentFields[18].Descriptor()
 	// agent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	agent.DefaultUpdatedAt = agentDescUpdatedAt.Default.(func() time.Time)
 	// agent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	agent.UpdateDefaultUpdatedAt = agentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	agentalertFields := schema.AgentAlert{}.Fields()
+	_ = agentalertFields
+	// agentalertDescRaisedAt is the schema descriptor for raised_at field.
+	agentalertDescRaisedAt := agentalertFields[3].Descriptor()
+	// agentalert.DefaultRaisedAt holds the default value on creation for the raised_at field.
+	agentalert.DefaultRaisedAt = agentalertDescRaisedAt.Default.(func() time.Time)
+	agentauthorityFields := schema.AgentAuthority{}.Fields()
+	_ = agentauthorityFields
+	// agentauthorityDescCreatedAt is the schema descriptor for created_at field.
+	agentauthorityDescCreatedAt := agentauthorityFields[2].Descriptor()
+	// agentauthority.DefaultCreatedAt holds the default value on creation for the created_at field.
+	agentauthority.DefaultCreatedAt = agentauthorityDescCreatedAt.Default.(func() time.Time)
+	agentenrollmenttokenFields := schema.AgentEnrollmentToken{}.Fields()
+	_ = agentenrollmenttokenFields
+	// agentenrollmenttokenDescCreatedAt is the schema descriptor for created_at field.
+	agentenrollmenttokenDescCreatedAt := agentenrollmenttokenFields[4].Descriptor()
+	// agentenrollmenttoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	agentenrollmenttoken.DefaultCreatedAt = agentenrollmenttokenDescCreatedAt.Default.(func() time.Time)
+	agentinventoryFields := schema.AgentInventory{}.Fields()
+	_ = agentinventoryFields
+	// agentinventoryDescVersion is the schema descriptor for version field.
+	agentinventoryDescVersion := agentinventoryFields[0].Descriptor()
+	// agentinventory.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	agentinventory.VersionValidator = agentinventoryDescVersion.Validators[0].(func(int) error)
+	// agentinventoryDescHash is the schema descriptor for hash field.
+	agentinventoryDescHash := agentinventoryFields[1].Descriptor()
+	// agentinventory.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	agentinventory.HashValidator = agentinventoryDescHash.Validators[0].(func(string) error)
+	// agentinventoryDescCreatedAt is the schema descriptor for created_at field.
+	agentinventoryDescCreatedAt := agentinventoryFields[10].Descriptor()
+	// agentinventory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	agentinventory.DefaultCreatedAt = agentinventoryDescCreatedAt.Default.(func() time.Time)
+	agentsoftwareFields := schema.AgentSoftware{}.Fields()
+	_ = agentsoftwareFields
+	// agentsoftwareDescName is the schema descriptor for name field.
+	agentsoftwareDescName := agentsoftwareFields[0].Descriptor()
+	// agentsoftware.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	agentsoftware.NameValidator = agentsoftwareDescName.Validators[0].(func(string) error)
+	// agentsoftwareDescFirstSeen is the schema descriptor for first_seen field.
+	agentsoftwareDescFirstSeen := agentsoftwareFields[4].Descriptor()
+	// agentsoftware.DefaultFirstSeen holds the default value on creation for the first_seen field.
+	agentsoftware.DefaultFirstSeen = agentsoftwareDescFirstSeen.Default.(func() time.Time)
+	alertruleFields := schema.AlertRule{}.Fields()
+	_ = alertruleFields
+	// alertruleDescName is the schema descriptor for name field.
+	alertruleDescName := alertruleFields[0].Descriptor()
+	// alertrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	alertrule.NameValidator = alertruleDescName.Validators[0].(func(string) error)
+	// alertruleDescEnabled is the schema descriptor for enabled field.
+	alertruleDescEnabled := alertruleFields[5].Descriptor()
+	// alertrule.DefaultEnabled holds the default value on creation for the enabled field.
+	alertrule.DefaultEnabled = alertruleDescEnabled.Default.(bool)
+	// alertruleDescCreatedAt is the schema descriptor for created_at field.
+	alertruleDescCreatedAt := alertruleFields[7].Descriptor()
+	// alertrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alertrule.DefaultCreatedAt = alertruleDescCreatedAt.Default.(func() time.Time)
+	// alertruleDescUpdatedAt is the schema descriptor for updated_at field.
+	alertruleDescUpdatedAt := alertruleFields[8].Descriptor()
+	// alertrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	alertrule.DefaultUpdatedAt = alertruleDescUpdatedAt.Default.(func() time.Time)
+	// alertrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	alertrule.UpdateDefaultUpdatedAt = alertruleDescUpdatedAt.UpdateDefault.(func() time.Time)
 	applicationFields := schema.Application{}.Fields()
 	_ = applicationFields
 	// applicationDescAppliedAt is the schema descriptor for applied_at field.
@@ -185,6 +306,40 @@ func init() {
 	auditlogDescTimestamp := auditlogFields[5].Descriptor()
 	// auditlog.DefaultTimestamp holds the default value on creation for the timestamp field.
 	auditlog.DefaultTimestamp = auditlogDescTimestamp.Default.(func() time.Time)
+	bankruleFields := schema.BankRule{}.Fields()
+	_ = bankruleFields
+	// bankruleDescPriority is the schema descriptor for priority field.
+	bankruleDescPriority := bankruleFields[4].Descriptor()
+	// bankrule.DefaultPriority holds the default value on creation for the priority field.
+	bankrule.DefaultPriority = bankruleDescPriority.Default.(int)
+	// bankruleDescIsActive is the schema descriptor for is_active field.
+	bankruleDescIsActive := bankruleFields[5].Descriptor()
+	// bankrule.DefaultIsActive holds the default value on creation for the is_active field.
+	bankrule.DefaultIsActive = bankruleDescIsActive.Default.(bool)
+	// bankruleDescCreatedAt is the schema descriptor for created_at field.
+	bankruleDescCreatedAt := bankruleFields[6].Descriptor()
+	// bankrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bankrule.DefaultCreatedAt = bankruleDescCreatedAt.Default.(func() time.Time)
+	bankstatementFields := schema.BankStatement{}.Fields()
+	_ = bankstatementFields
+	// bankstatementDescImportedAt is the schema descriptor for imported_at field.
+	bankstatementDescImportedAt := bankstatementFields[8].Descriptor()
+	// bankstatement.DefaultImportedAt holds the default value on creation for the imported_at field.
+	bankstatement.DefaultImportedAt = bankstatementDescImportedAt.Default.(func() time.Time)
+	bankstatementlineFields := schema.BankStatementLine{}.Fields()
+	_ = bankstatementlineFields
+	// bankstatementlineDescAmount is the schema descriptor for amount field.
+	bankstatementlineDescAmount := bankstatementlineFields[3].Descriptor()
+	// bankstatementline.DefaultAmount holds the default value on creation for the amount field.
+	bankstatementline.DefaultAmount = bankstatementlineDescAmount.Default.(decimal.Decimal)
+	// bankstatementlineDescMatchScore is the schema descriptor for match_score field.
+	bankstatementlineDescMatchScore := bankstatementlineFields[9].Descriptor()
+	// bankstatementline.DefaultMatchScore holds the default value on creation for the match_score field.
+	bankstatementline.DefaultMatchScore = bankstatementlineDescMatchScore.Default.(int)
+	// bankstatementlineDescCreatedAt is the schema descriptor for created_at field.
+	bankstatementlineDescCreatedAt := bankstatementlineFields[10].Descriptor()
+	// bankstatementline.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bankstatementline.DefaultCreatedAt = bankstatementlineDescCreatedAt.Default.(func() time.Time)
 	benefitenrollmentFields := schema.BenefitEnrollment{}.Fields()
 	_ = benefitenrollmentFields
 	// benefitenrollmentDescCreatedAt is the schema descriptor for created_at field.
@@ -337,18 +492,104 @@ func init() {
 	credentialDescCreatedAt := credentialFields[5].Descriptor()
 	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
 	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	customerFields := schema.Customer{}.Fields()
+	_ = customerFields
+	// customerDescName is the schema descriptor for name field.
+	customerDescName := customerFields[1].Descriptor()
+	// customer.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	customer.NameValidator = customerDescName.Validators[0].(func(string) error)
+	// customerDescTaxExempt is the schema descriptor for tax_exempt field.
+	customerDescTaxExempt := customerFields[7].Descriptor()
+	// customer.DefaultTaxExempt holds the default value on creation for the tax_exempt field.
+	customer.DefaultTaxExempt = customerDescTaxExempt.Default.(bool)
+	// customerDescPaymentTermsDays is the schema descriptor for payment_terms_days field.
+	customerDescPaymentTermsDays := customerFields[10].Descriptor()
+	// customer.DefaultPaymentTermsDays holds the default value on creation for the payment_terms_days field.
+	customer.DefaultPaymentTermsDays = customerDescPaymentTermsDays.Default.(int)
+	// customer.PaymentTermsDaysValidator is a validator for the "payment_terms_days" field. It is called by the builders before save.
+	customer.PaymentTermsDaysValidator = customerDescPaymentTermsDays.Validators[0].(func(int) error)
+	// customerDescIsActive is the schema descriptor for is_active field.
+	customerDescIsActive := customerFields[11].Descriptor()
+	// customer.DefaultIsActive holds the default value on creation for the is_active field.
+	customer.DefaultIsActive = customerDescIsActive.Default.(bool)
+	// customerDescCreatedAt is the schema descriptor for created_at field.
+	customerDescCreatedAt := customerFields[12].Descriptor()
+	// customer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customer.DefaultCreatedAt = customerDescCreatedAt.Default.(func() time.Time)
+	customerpaymentFields := schema.CustomerPayment{}.Fields()
+	_ = customerpaymentFields
+	// customerpaymentDescDate is the schema descriptor for date field.
+	customerpaymentDescDate := customerpaymentFields[0].Descriptor()
+	// customerpayment.DefaultDate holds the default value on creation for the date field.
+	customerpayment.DefaultDate = customerpaymentDescDate.Default.(func() time.Time)
+	// customerpaymentDescAmount is the schema descriptor for amount field.
+	customerpaymentDescAmount := customerpaymentFields[1].Descriptor()
+	// customerpayment.DefaultAmount holds the default value on creation for the amount field.
+	customerpayment.DefaultAmount = customerpaymentDescAmount.Default.(decimal.Decimal)
+	// customerpaymentDescCurrency is the schema descriptor for currency field.
+	customerpaymentDescCurrency := customerpaymentFields[2].Descriptor()
+	// customerpayment.DefaultCurrency holds the default value on creation for the currency field.
+	customerpayment.DefaultCurrency = customerpaymentDescCurrency.Default.(string)
+	// customerpaymentDescExchangeRate is the schema descriptor for exchange_rate field.
+	customerpaymentDescExchangeRate := customerpaymentFields[3].Descriptor()
+	// customerpayment.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	customerpayment.DefaultExchangeRate = customerpaymentDescExchangeRate.Default.(decimal.Decimal)
+	// customerpaymentDescUnallocated is the schema descriptor for unallocated field.
+	customerpaymentDescUnallocated := customerpaymentFields[4].Descriptor()
+	// customerpayment.DefaultUnallocated holds the default value on creation for the unallocated field.
+	customerpayment.DefaultUnallocated = customerpaymentDescUnallocated.Default.(decimal.Decimal)
+	// customerpaymentDescCreatedAt is the schema descriptor for created_at field.
+	customerpaymentDescCreatedAt := customerpaymentFields[7].Descriptor()
+	// customerpayment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customerpayment.DefaultCreatedAt = customerpaymentDescCreatedAt.Default.(func() time.Time)
 	detectioneventFields := schema.DetectionEvent{}.Fields()
 	_ = detectioneventFields
 	// detectioneventDescTimestamp is the schema descriptor for timestamp field.
 	detectioneventDescTimestamp := detectioneventFields[3].Descriptor()
 	// detectionevent.DefaultTimestamp holds the default value on creation for the timestamp field.
 	detectionevent.DefaultTimestamp = detectioneventDescTimestamp.Default.(func() time.Time)
+	devicegroupFields := schema.DeviceGroup{}.Fields()
+	_ = devicegroupFields
+	// devicegroupDescName is the schema descriptor for name field.
+	devicegroupDescName := devicegroupFields[0].Descriptor()
+	// devicegroup.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	devicegroup.NameValidator = devicegroupDescName.Validators[0].(func(string) error)
+	// devicegroupDescCreatedAt is the schema descriptor for created_at field.
+	devicegroupDescCreatedAt := devicegroupFields[4].Descriptor()
+	// devicegroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	devicegroup.DefaultCreatedAt = devicegroupDescCreatedAt.Default.(func() time.Time)
+	// devicegroupDescUpdatedAt is the schema descriptor for updated_at field.
+	devicegroupDescUpdatedAt := devicegroupFields[5].Descriptor()
+	// devicegroup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	devicegroup.DefaultUpdatedAt = devicegroupDescUpdatedAt.Default.(func() time.Time)
+	// devicegroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	devicegroup.UpdateDefaultUpdatedAt = devicegroupDescUpdatedAt.UpdateDefault.(func() time.Time)
 	discoveryentryFields := schema.DiscoveryEntry{}.Fields()
 	_ = discoveryentryFields
 	// discoveryentryDescDiscoveredAt is the schema descriptor for discovered_at field.
 	discoveryentryDescDiscoveredAt := discoveryentryFields[8].Descriptor()
 	// discoveryentry.DefaultDiscoveredAt holds the default value on creation for the discovered_at field.
 	discoveryentry.DefaultDiscoveredAt = discoveryentryDescDiscoveredAt.Default.(func() time.Time)
+	einvoiceFields := schema.EInvoice{}.Fields()
+	_ = einvoiceFields
+	// einvoiceDescCounter is the schema descriptor for counter field.
+	einvoiceDescCounter := einvoiceFields[5].Descriptor()
+	// einvoice.CounterValidator is a validator for the "counter" field. It is called by the builders before save.
+	einvoice.CounterValidator = einvoiceDescCounter.Validators[0].(func(int) error)
+	// einvoiceDescSignedAt is the schema descriptor for signed_at field.
+	einvoiceDescSignedAt := einvoiceFields[11].Descriptor()
+	// einvoice.DefaultSignedAt holds the default value on creation for the signed_at field.
+	einvoice.DefaultSignedAt = einvoiceDescSignedAt.Default.(func() time.Time)
+	einvoicecredentialFields := schema.EInvoiceCredential{}.Fields()
+	_ = einvoicecredentialFields
+	// einvoicecredentialDescIsActive is the schema descriptor for is_active field.
+	einvoicecredentialDescIsActive := einvoicecredentialFields[13].Descriptor()
+	// einvoicecredential.DefaultIsActive holds the default value on creation for the is_active field.
+	einvoicecredential.DefaultIsActive = einvoicecredentialDescIsActive.Default.(bool)
+	// einvoicecredentialDescCreatedAt is the schema descriptor for created_at field.
+	einvoicecredentialDescCreatedAt := einvoicecredentialFields[14].Descriptor()
+	// einvoicecredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	einvoicecredential.DefaultCreatedAt = einvoicecredentialDescCreatedAt.Default.(func() time.Time)
 	employeeFields := schema.Employee{}.Fields()
 	_ = employeeFields
 	// employeeDescHipoStatus is the schema descriptor for hipo_status field.
@@ -365,6 +606,22 @@ func init() {
 	employee.DefaultUpdatedAt = employeeDescUpdatedAt.Default.(func() time.Time)
 	// employee.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	employee.UpdateDefaultUpdatedAt = employeeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	exchangerateFields := schema.ExchangeRate{}.Fields()
+	_ = exchangerateFields
+	// exchangerateDescSource is the schema descriptor for source field.
+	exchangerateDescSource := exchangerateFields[4].Descriptor()
+	// exchangerate.DefaultSource holds the default value on creation for the source field.
+	exchangerate.DefaultSource = exchangerateDescSource.Default.(string)
+	// exchangerateDescCreatedAt is the schema descriptor for created_at field.
+	exchangerateDescCreatedAt := exchangerateFields[5].Descriptor()
+	// exchangerate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exchangerate.DefaultCreatedAt = exchangerateDescCreatedAt.Default.(func() time.Time)
+	fiscalperiodFields := schema.FiscalPeriod{}.Fields()
+	_ = fiscalperiodFields
+	// fiscalperiodDescCreatedAt is the schema descriptor for created_at field.
+	fiscalperiodDescCreatedAt := fiscalperiodFields[8].Descriptor()
+	// fiscalperiod.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fiscalperiod.DefaultCreatedAt = fiscalperiodDescCreatedAt.Default.(func() time.Time)
 	goalFields := schema.Goal{}.Fields()
 	_ = goalFields
 	// goalDescProgress is the schema descriptor for progress field.
@@ -397,6 +654,50 @@ func init() {
 	goal.DefaultUpdatedAt = goalDescUpdatedAt.Default.(func() time.Time)
 	// goal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	goal.UpdateDefaultUpdatedAt = goalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	goodsreceiptFields := schema.GoodsReceipt{}.Fields()
+	_ = goodsreceiptFields
+	// goodsreceiptDescReceivedAt is the schema descriptor for received_at field.
+	goodsreceiptDescReceivedAt := goodsreceiptFields[2].Descriptor()
+	// goodsreceipt.DefaultReceivedAt holds the default value on creation for the received_at field.
+	goodsreceipt.DefaultReceivedAt = goodsreceiptDescReceivedAt.Default.(func() time.Time)
+	// goodsreceiptDescFreight is the schema descriptor for freight field.
+	goodsreceiptDescFreight := goodsreceiptFields[4].Descriptor()
+	// goodsreceipt.DefaultFreight holds the default value on creation for the freight field.
+	goodsreceipt.DefaultFreight = goodsreceiptDescFreight.Default.(decimal.Decimal)
+	// goodsreceiptDescDuty is the schema descriptor for duty field.
+	goodsreceiptDescDuty := goodsreceiptFields[5].Descriptor()
+	// goodsreceipt.DefaultDuty holds the default value on creation for the duty field.
+	goodsreceipt.DefaultDuty = goodsreceiptDescDuty.Default.(decimal.Decimal)
+	// goodsreceiptDescInsurance is the schema descriptor for insurance field.
+	goodsreceiptDescInsurance := goodsreceiptFields[6].Descriptor()
+	// goodsreceipt.DefaultInsurance holds the default value on creation for the insurance field.
+	goodsreceipt.DefaultInsurance = goodsreceiptDescInsurance.Default.(decimal.Decimal)
+	// goodsreceiptDescCreatedAt is the schema descriptor for created_at field.
+	goodsreceiptDescCreatedAt := goodsreceiptFields[9].Descriptor()
+	// goodsreceipt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goodsreceipt.DefaultCreatedAt = goodsreceiptDescCreatedAt.Default.(func() time.Time)
+	goodsreceiptlineFields := schema.GoodsReceiptLine{}.Fields()
+	_ = goodsreceiptlineFields
+	// goodsreceiptlineDescQuantity is the schema descriptor for quantity field.
+	goodsreceiptlineDescQuantity := goodsreceiptlineFields[0].Descriptor()
+	// goodsreceiptline.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	goodsreceiptline.QuantityValidator = goodsreceiptlineDescQuantity.Validators[0].(func(int) error)
+	// goodsreceiptlineDescUnitCost is the schema descriptor for unit_cost field.
+	goodsreceiptlineDescUnitCost := goodsreceiptlineFields[1].Descriptor()
+	// goodsreceiptline.DefaultUnitCost holds the default value on creation for the unit_cost field.
+	goodsreceiptline.DefaultUnitCost = goodsreceiptlineDescUnitCost.Default.(decimal.Decimal)
+	// goodsreceiptlineDescWeight is the schema descriptor for weight field.
+	goodsreceiptlineDescWeight := goodsreceiptlineFields[2].Descriptor()
+	// goodsreceiptline.DefaultWeight holds the default value on creation for the weight field.
+	goodsreceiptline.DefaultWeight = goodsreceiptlineDescWeight.Default.(decimal.Decimal)
+	// goodsreceiptlineDescLandedCost is the schema descriptor for landed_cost field.
+	goodsreceiptlineDescLandedCost := goodsreceiptlineFields[3].Descriptor()
+	// goodsreceiptline.DefaultLandedCost holds the default value on creation for the landed_cost field.
+	goodsreceiptline.DefaultLandedCost = goodsreceiptlineDescLandedCost.Default.(decimal.Decimal)
+	// goodsreceiptlineDescLandedUnitCost is the schema descriptor for landed_unit_cost field.
+	goodsreceiptlineDescLandedUnitCost := goodsreceiptlineFields[4].Descriptor()
+	// goodsreceiptline.DefaultLandedUnitCost holds the default value on creation for the landed_unit_cost field.
+	goodsreceiptline.DefaultLandedUnitCost = goodsreceiptlineDescLandedUnitCost.Default.(decimal.Decimal)
 	healthscoresnapshotFields := schema.HealthScoreSnapshot{}.Fields()
 	_ = healthscoresnapshotFields
 	// healthscoresnapshotDescTimestamp is the schema descriptor for timestamp field.
@@ -448,25 +749,105 @@ func init() {
 	// inventoryreservation.DefaultQuantity holds the default value on creation for the quantity field.
 	inventoryreservation.DefaultQuantity = inventoryreservationDescQuantity.Default.(decimal.Decimal)
 	// inventoryreservationDescCreatedAt is the schema descriptor for created_at field.
-	inventoryreservationDescCreatedAt := inventoryreservationFields[3].Descriptor()
+	inventoryreservationDescCreatedAt := inventoryreservationFields[4].Descriptor()
 	// inventoryreservation.DefaultCreatedAt holds the default value on creation for the created_at field.
 	inventoryreservation.DefaultCreatedAt = inventoryreservationDescCreatedAt.Default.(func() time.Time)
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescIssueDate is the schema descriptor for issue_date field.
+	invoiceDescIssueDate := invoiceFields[3].Descriptor()
+	// invoice.DefaultIssueDate holds the default value on creation for the issue_date field.
+	invoice.DefaultIssueDate = invoiceDescIssueDate.Default.(func() time.Time)
+	// invoiceDescCurrency is the schema descriptor for currency field.
+	invoiceDescCurrency := invoiceFields[5].Descriptor()
+	// invoice.DefaultCurrency holds the default value on creation for the currency field.
+	invoice.DefaultCurrency = invoiceDescCurrency.Default.(string)
+	// invoiceDescExchangeRate is the schema descriptor for exchange_rate field.
+	invoiceDescExchangeRate := invoiceFields[6].Descriptor()
+	// invoice.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	invoice.DefaultExchangeRate = invoiceDescExchangeRate.Default.(decimal.Decimal)
+	// invoiceDescSubtotal is the schema descriptor for subtotal field.
+	invoiceDescSubtotal := invoiceFields[7].Descriptor()
+	// invoice.DefaultSubtotal holds the default value on creation for the subtotal field.
+	invoice.DefaultSubtotal = invoiceDescSubtotal.Default.(decimal.Decimal)
+	// invoiceDescTaxTotal is the schema descriptor for tax_total field.
+	invoiceDescTaxTotal := invoiceFields[8].Descriptor()
+	// invoice.DefaultTaxTotal holds the default value on creation for the tax_total field.
+	invoice.DefaultTaxTotal = invoiceDescTaxTotal.Default.(decimal.Decimal)
+	// invoiceDescTotal is the schema descriptor for total field.
+	invoiceDescTotal := invoiceFields[9].Descriptor()
+	// invoice.DefaultTotal holds the default value on creation for the total field.
+	invoice.DefaultTotal = invoiceDescTotal.Default.(decimal.Decimal)
+	// invoiceDescAmountSettled is the schema descriptor for amount_settled field.
+	invoiceDescAmountSettled := invoiceFields[10].Descriptor()
+	// invoice.DefaultAmountSettled holds the default value on creation for the amount_settled field.
+	invoice.DefaultAmountSettled = invoiceDescAmountSettled.Default.(decimal.Decimal)
+	// invoiceDescCreatedAt is the schema descriptor for created_at field.
+	invoiceDescCreatedAt := invoiceFields[13].Descriptor()
+	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
+	invoicelineFields := schema.InvoiceLine{}.Fields()
+	_ = invoicelineFields
+	// invoicelineDescQuantity is the schema descriptor for quantity field.
+	invoicelineDescQuantity := invoicelineFields[1].Descriptor()
+	// invoiceline.DefaultQuantity holds the default value on creation for the quantity field.
+	invoiceline.DefaultQuantity = invoicelineDescQuantity.Default.(decimal.Decimal)
+	// invoicelineDescUnitPrice is the schema descriptor for unit_price field.
+	invoicelineDescUnitPrice := invoicelineFields[2].Descriptor()
+	// invoiceline.DefaultUnitPrice holds the default value on creation for the unit_price field.
+	invoiceline.DefaultUnitPrice = invoicelineDescUnitPrice.Default.(decimal.Decimal)
+	// invoicelineDescTaxRate is the schema descriptor for tax_rate field.
+	invoicelineDescTaxRate := invoicelineFields[4].Descriptor()
+	// invoiceline.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	invoiceline.DefaultTaxRate = invoicelineDescTaxRate.Default.(decimal.Decimal)
+	// invoicelineDescNetAmount is the schema descriptor for net_amount field.
+	invoicelineDescNetAmount := invoicelineFields[5].Descriptor()
+	// invoiceline.DefaultNetAmount holds the default value on creation for the net_amount field.
+	invoiceline.DefaultNetAmount = invoicelineDescNetAmount.Default.(decimal.Decimal)
+	// invoicelineDescPosition is the schema descriptor for position field.
+	invoicelineDescPosition := invoicelineFields[6].Descriptor()
+	// invoiceline.DefaultPosition holds the default value on creation for the position field.
+	invoiceline.DefaultPosition = invoicelineDescPosition.Default.(int)
+	invoicetaxlineFields := schema.InvoiceTaxLine{}.Fields()
+	_ = invoicetaxlineFields
+	// invoicetaxlineDescRate is the schema descriptor for rate field.
+	invoicetaxlineDescRate := invoicetaxlineFields[1].Descriptor()
+	// invoicetaxline.DefaultRate holds the default value on creation for the rate field.
+	invoicetaxline.DefaultRate = invoicetaxlineDescRate.Default.(decimal.Decimal)
+	// invoicetaxlineDescTaxableAmount is the schema descriptor for taxable_amount field.
+	invoicetaxlineDescTaxableAmount := invoicetaxlineFields[2].Descriptor()
+	// invoicetaxline.DefaultTaxableAmount holds the default value on creation for the taxable_amount field.
+	invoicetaxline.DefaultTaxableAmount = invoicetaxlineDescTaxableAmount.Default.(decimal.Decimal)
+	// invoicetaxlineDescTaxAmount is the schema descriptor for tax_amount field.
+	invoicetaxlineDescTaxAmount := invoicetaxlineFields[3].Descriptor()
+	// invoicetaxline.DefaultTaxAmount holds the default value on creation for the tax_amount field.
+	invoicetaxline.DefaultTaxAmount = invoicetaxlineDescTaxAmount.Default.(decimal.Decimal)
 	jobFields := schema.Job{}.Fields()
 	_ = jobFields
+	// jobDescTimeoutSeconds is the schema descriptor for timeout_seconds field.
+	jobDescTimeoutSeconds := jobFields[6].Descriptor()
+	// job.DefaultTimeoutSeconds holds the default value on creation for the timeout_seconds field.
+	job.DefaultTimeoutSeconds = jobDescTimeoutSeconds.Default.(int)
+	// job.TimeoutSecondsValidator is a validator for the "timeout_seconds" field. It is called by the builders before save.
+	job.TimeoutSecondsValidator = jobDescTimeoutSeconds.Validators[0].(func(int) error)
 	// jobDescCreatedAt is the schema descriptor for created_at field.
-	jobDescCreatedAt := jobFields[5].Descriptor()
+	jobDescCreatedAt := jobFields[7].Descriptor()
 	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
 	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
 	// jobDescUpdatedAt is the schema descriptor for updated_at field.
-	jobDescUpdatedAt := jobFields[6].Descriptor()
+	jobDescUpdatedAt := jobFields[8].Descriptor()
 	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
 	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	jobexecutionFields := schema.JobExecution{}.Fields()
 	_ = jobexecutionFields
+	// jobexecutionDescOutputTruncated is the schema descriptor for output_truncated field.
+	jobexecutionDescOutputTruncated := jobexecutionFields[3].Descriptor()
+	// jobexecution.DefaultOutputTruncated holds the default value on creation for the output_truncated field.
+	jobexecution.DefaultOutputTruncated = jobexecutionDescOutputTruncated.Default.(bool)
 	// jobexecutionDescCreatedAt is the schema descriptor for created_at field.
-	jobexecutionDescCreatedAt := jobexecutionFields[4].Descriptor()
+	jobexecutionDescCreatedAt := jobexecutionFields[6].Descriptor()
 	// jobexecution.DefaultCreatedAt holds the default value on creation for the created_at field.
 	jobexecution.DefaultCreatedAt = jobexecutionDescCreatedAt.Default.(func() time.Time)
 	jobpostingFields := schema.JobPosting{}.Fields()
@@ -487,18 +868,70 @@ func init() {
 	journalentryDescAmount := journalentryFields[0].Descriptor()
 	// journalentry.DefaultAmount holds the default value on creation for the amount field.
 	journalentry.DefaultAmount = journalentryDescAmount.Default.(decimal.Decimal)
+	// journalentryDescCurrency is the schema descriptor for currency field.
+	journalentryDescCurrency := journalentryFields[2].Descriptor()
+	// journalentry.DefaultCurrency holds the default value on creation for the currency field.
+	journalentry.DefaultCurrency = journalentryDescCurrency.Default.(string)
+	// journalentryDescCurrencyAmount is the schema descriptor for currency_amount field.
+	journalentryDescCurrencyAmount := journalentryFields[3].Descriptor()
+	// journalentry.DefaultCurrencyAmount holds the default value on creation for the currency_amount field.
+	journalentry.DefaultCurrencyAmount = journalentryDescCurrencyAmount.Default.(decimal.Decimal)
+	// journalentryDescExchangeRate is the schema descriptor for exchange_rate field.
+	journalentryDescExchangeRate := journalentryFields[4].Descriptor()
+	// journalentry.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	journalentry.DefaultExchangeRate = journalentryDescExchangeRate.Default.(decimal.Decimal)
 	// journalentryDescCreatedAt is the schema descriptor for created_at field.
-	journalentryDescCreatedAt := journalentryFields[2].Descriptor()
+	journalentryDescCreatedAt := journalentryFields[5].Descriptor()
 	// journalentry.DefaultCreatedAt holds the default value on creation for the created_at field.
 	journalentry.DefaultCreatedAt = journalentryDescCreatedAt.Default.(func() time.Time)
+	labeltemplateFields := schema.LabelTemplate{}.Fields()
+	_ = labeltemplateFields
+	// labeltemplateDescName is the schema descriptor for name field.
+	labeltemplateDescName := labeltemplateFields[0].Descriptor()
+	// labeltemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	labeltemplate.NameValidator = labeltemplateDescName.Validators[0].(func(string) error)
+	// labeltemplateDescWidthMm is the schema descriptor for width_mm field.
+	labeltemplateDescWidthMm := labeltemplateFields[1].Descriptor()
+	// labeltemplate.WidthMmValidator is a validator for the "width_mm" field. It is called by the builders before save.
+	labeltemplate.WidthMmValidator = labeltemplateDescWidthMm.Validators[0].(func(float64) error)
+	// labeltemplateDescHeightMm is the schema descriptor for height_mm field.
+	labeltemplateDescHeightMm := labeltemplateFields[2].Descriptor()
+	// labeltemplate.HeightMmValidator is a validator for the "height_mm" field. It is called by the builders before save.
+	labeltemplate.HeightMmValidator = labeltemplateDescHeightMm.Validators[0].(func(float64) error)
+	// labeltemplateDescIsDefault is the schema descriptor for is_default field.
+	labeltemplateDescIsDefault := labeltemplateFields[4].Descriptor()
+	// labeltemplate.DefaultIsDefault holds the default value on creation for the is_default field.
+	labeltemplate.DefaultIsDefault = labeltemplateDescIsDefault.Default.(bool)
+	// labeltemplateDescCreatedAt is the schema descriptor for created_at field.
+	labeltemplateDescCreatedAt := labeltemplateFields[5].Descriptor()
+	// labeltemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	labeltemplate.DefaultCreatedAt = labeltemplateDescCreatedAt.Default.(func() time.Time)
+	// labeltemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	labeltemplateDescUpdatedAt := labeltemplateFields[6].Descriptor()
+	// labeltemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	labeltemplate.DefaultUpdatedAt = labeltemplateDescUpdatedAt.Default.(func() time.Time)
+	// labeltemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	labeltemplate.UpdateDefaultUpdatedAt = labeltemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
 	ledgerentryFields := schema.LedgerEntry{}.Fields()
 	_ = ledgerentryFields
 	// ledgerentryDescAmount is the schema descriptor for amount field.
 	ledgerentryDescAmount := ledgerentryFields[0].Descriptor()
 	// ledgerentry.DefaultAmount holds the default value on creation for the amount field.
 	ledgerentry.DefaultAmount = ledgerentryDescAmount.Default.(decimal.Decimal)
+	// ledgerentryDescCurrency is the schema descriptor for currency field.
+	ledgerentryDescCurrency := ledgerentryFields[2].Descriptor()
+	// ledgerentry.DefaultCurrency holds the default value on creation for the currency field.
+	ledgerentry.DefaultCurrency = ledgerentryDescCurrency.Default.(string)
+	// ledgerentryDescCurrencyAmount is the schema descriptor for currency_amount field.
+	ledgerentryDescCurrencyAmount := ledgerentryFields[3].Descriptor()
+	// ledgerentry.DefaultCurrencyAmount holds the default value on creation for the currency_amount field.
+	ledgerentry.DefaultCurrencyAmount = ledgerentryDescCurrencyAmount.Default.(decimal.Decimal)
+	// ledgerentryDescExchangeRate is the schema descriptor for exchange_rate field.
+	ledgerentryDescExchangeRate := ledgerentryFields[4].Descriptor()
+	// ledgerentry.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	ledgerentry.DefaultExchangeRate = ledgerentryDescExchangeRate.Default.(decimal.Decimal)
 	// ledgerentryDescCreatedAt is the schema descriptor for created_at field.
-	ledgerentryDescCreatedAt := ledgerentryFields[2].Descriptor()
+	ledgerentryDescCreatedAt := ledgerentryFields[5].Descriptor()
 	// ledgerentry.DefaultCreatedAt holds the default value on creation for the created_at field.
 	ledgerentry.DefaultCreatedAt = ledgerentryDescCreatedAt.Default.(func() time.Time)
 	legalholdFields := schema.LegalHold{}.Fields()
@@ -595,6 +1028,72 @@ func init() {
 	onetimelinkDescCreatedAt := onetimelinkFields[5].Descriptor()
 	// onetimelink.DefaultCreatedAt holds the default value on creation for the created_at field.
 	onetimelink.DefaultCreatedAt = onetimelinkDescCreatedAt.Default.(func() time.Time)
+	patchpolicyFields := schema.PatchPolicy{}.Fields()
+	_ = patchpolicyFields
+	// patchpolicyDescName is the schema descriptor for name field.
+	patchpolicyDescName := patchpolicyFields[0].Descriptor()
+	// patchpolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	patchpolicy.NameValidator = patchpolicyDescName.Validators[0].(func(string) error)
+	// patchpolicyDescReboot is the schema descriptor for reboot field.
+	patchpolicyDescReboot := patchpolicyFields[5].Descriptor()
+	// patchpolicy.DefaultReboot holds the default value on creation for the reboot field.
+	patchpolicy.DefaultReboot = patchpolicyDescReboot.Default.(bool)
+	// patchpolicyDescEnabled is the schema descriptor for enabled field.
+	patchpolicyDescEnabled := patchpolicyFields[6].Descriptor()
+	// patchpolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	patchpolicy.DefaultEnabled = patchpolicyDescEnabled.Default.(bool)
+	// patchpolicyDescCreatedAt is the schema descriptor for created_at field.
+	patchpolicyDescCreatedAt := patchpolicyFields[10].Descriptor()
+	// patchpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	patchpolicy.DefaultCreatedAt = patchpolicyDescCreatedAt.Default.(func() time.Time)
+	// patchpolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	patchpolicyDescUpdatedAt := patchpolicyFields[11].Descriptor()
+	// patchpolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	patchpolicy.DefaultUpdatedAt = patchpolicyDescUpdatedAt.Default.(func() time.Time)
+	// patchpolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	patchpolicy.UpdateDefaultUpdatedAt = patchpolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	paymentallocationFields := schema.PaymentAllocation{}.Fields()
+	_ = paymentallocationFields
+	// paymentallocationDescAmount is the schema descriptor for amount field.
+	paymentallocationDescAmount := paymentallocationFields[0].Descriptor()
+	// paymentallocation.DefaultAmount holds the default value on creation for the amount field.
+	paymentallocation.DefaultAmount = paymentallocationDescAmount.Default.(decimal.Decimal)
+	// paymentallocationDescFxDifference is the schema descriptor for fx_difference field.
+	paymentallocationDescFxDifference := paymentallocationFields[1].Descriptor()
+	// paymentallocation.DefaultFxDifference holds the default value on creation for the fx_difference field.
+	paymentallocation.DefaultFxDifference = paymentallocationDescFxDifference.Default.(decimal.Decimal)
+	// paymentallocationDescCreatedAt is the schema descriptor for created_at field.
+	paymentallocationDescCreatedAt := paymentallocationFields[2].Descriptor()
+	// paymentallocation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentallocation.DefaultCreatedAt = paymentallocationDescCreatedAt.Default.(func() time.Time)
+	paymentrunFields := schema.PaymentRun{}.Fields()
+	_ = paymentrunFields
+	// paymentrunDescPaymentDate is the schema descriptor for payment_date field.
+	paymentrunDescPaymentDate := paymentrunFields[2].Descriptor()
+	// paymentrun.DefaultPaymentDate holds the default value on creation for the payment_date field.
+	paymentrun.DefaultPaymentDate = paymentrunDescPaymentDate.Default.(func() time.Time)
+	// paymentrunDescCurrency is the schema descriptor for currency field.
+	paymentrunDescCurrency := paymentrunFields[3].Descriptor()
+	// paymentrun.DefaultCurrency holds the default value on creation for the currency field.
+	paymentrun.DefaultCurrency = paymentrunDescCurrency.Default.(string)
+	// paymentrunDescTotal is the schema descriptor for total field.
+	paymentrunDescTotal := paymentrunFields[4].Descriptor()
+	// paymentrun.DefaultTotal holds the default value on creation for the total field.
+	paymentrun.DefaultTotal = paymentrunDescTotal.Default.(decimal.Decimal)
+	// paymentrunDescCreatedAt is the schema descriptor for created_at field.
+	paymentrunDescCreatedAt := paymentrunFields[7].Descriptor()
+	// paymentrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentrun.DefaultCreatedAt = paymentrunDescCreatedAt.Default.(func() time.Time)
+	paymentrunlineFields := schema.PaymentRunLine{}.Fields()
+	_ = paymentrunlineFields
+	// paymentrunlineDescAmount is the schema descriptor for amount field.
+	paymentrunlineDescAmount := paymentrunlineFields[0].Descriptor()
+	// paymentrunline.DefaultAmount holds the default value on creation for the amount field.
+	paymentrunline.DefaultAmount = paymentrunlineDescAmount.Default.(decimal.Decimal)
+	// paymentrunlineDescFxDifference is the schema descriptor for fx_difference field.
+	paymentrunlineDescFxDifference := paymentrunlineFields[1].Descriptor()
+	// paymentrunline.DefaultFxDifference holds the default value on creation for the fx_difference field.
+	paymentrunline.DefaultFxDifference = paymentrunlineDescFxDifference.Default.(decimal.Decimal)
 	performancereviewFields := schema.PerformanceReview{}.Fields()
 	_ = performancereviewFields
 	// performancereviewDescCreatedAt is the schema descriptor for created_at field.
@@ -607,6 +1106,64 @@ func init() {
 	performancereview.DefaultUpdatedAt = performancereviewDescUpdatedAt.Default.(func() time.Time)
 	// performancereview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	performancereview.UpdateDefaultUpdatedAt = performancereviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	possalelineFields := schema.PosSaleLine{}.Fields()
+	_ = possalelineFields
+	// possalelineDescQuantity is the schema descriptor for quantity field.
+	possalelineDescQuantity := possalelineFields[0].Descriptor()
+	// possaleline.DefaultQuantity holds the default value on creation for the quantity field.
+	possaleline.DefaultQuantity = possalelineDescQuantity.Default.(decimal.Decimal)
+	// possalelineDescUnitPrice is the schema descriptor for unit_price field.
+	possalelineDescUnitPrice := possalelineFields[1].Descriptor()
+	// possaleline.DefaultUnitPrice holds the default value on creation for the unit_price field.
+	possaleline.DefaultUnitPrice = possalelineDescUnitPrice.Default.(decimal.Decimal)
+	// possalelineDescDiscount is the schema descriptor for discount field.
+	possalelineDescDiscount := possalelineFields[2].Descriptor()
+	// possaleline.DefaultDiscount holds the default value on creation for the discount field.
+	possaleline.DefaultDiscount = possalelineDescDiscount.Default.(decimal.Decimal)
+	// possalelineDescTotal is the schema descriptor for total field.
+	possalelineDescTotal := possalelineFields[3].Descriptor()
+	// possaleline.DefaultTotal holds the default value on creation for the total field.
+	possaleline.DefaultTotal = possalelineDescTotal.Default.(decimal.Decimal)
+	// possalelineDescNetAmount is the schema descriptor for net_amount field.
+	possalelineDescNetAmount := possalelineFields[4].Descriptor()
+	// possaleline.DefaultNetAmount holds the default value on creation for the net_amount field.
+	possaleline.DefaultNetAmount = possalelineDescNetAmount.Default.(decimal.Decimal)
+	// possalelineDescTaxRate is the schema descriptor for tax_rate field.
+	possalelineDescTaxRate := possalelineFields[6].Descriptor()
+	// possaleline.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	possaleline.DefaultTaxRate = possalelineDescTaxRate.Default.(decimal.Decimal)
+	// possalelineDescTaxAmount is the schema descriptor for tax_amount field.
+	possalelineDescTaxAmount := possalelineFields[7].Descriptor()
+	// possaleline.DefaultTaxAmount holds the default value on creation for the tax_amount field.
+	possaleline.DefaultTaxAmount = possalelineDescTaxAmount.Default.(decimal.Decimal)
+	// possalelineDescReturnedQuantity is the schema descriptor for returned_quantity field.
+	possalelineDescReturnedQuantity := possalelineFields[8].Descriptor()
+	// possaleline.DefaultReturnedQuantity holds the default value on creation for the returned_quantity field.
+	possaleline.DefaultReturnedQuantity = possalelineDescReturnedQuantity.Default.(decimal.Decimal)
+	posshiftFields := schema.PosShift{}.Fields()
+	_ = posshiftFields
+	// posshiftDescOpeningFloat is the schema descriptor for opening_float field.
+	posshiftDescOpeningFloat := posshiftFields[3].Descriptor()
+	// posshift.DefaultOpeningFloat holds the default value on creation for the opening_float field.
+	posshift.DefaultOpeningFloat = posshiftDescOpeningFloat.Default.(decimal.Decimal)
+	// posshiftDescExpectedCash is the schema descriptor for expected_cash field.
+	posshiftDescExpectedCash := posshiftFields[4].Descriptor()
+	// posshift.DefaultExpectedCash holds the default value on creation for the expected_cash field.
+	posshift.DefaultExpectedCash = posshiftDescExpectedCash.Default.(decimal.Decimal)
+	// posshiftDescOverShort is the schema descriptor for over_short field.
+	posshiftDescOverShort := posshiftFields[6].Descriptor()
+	// posshift.DefaultOverShort holds the default value on creation for the over_short field.
+	posshift.DefaultOverShort = posshiftDescOverShort.Default.(decimal.Decimal)
+	// posshiftDescOpenedAt is the schema descriptor for opened_at field.
+	posshiftDescOpenedAt := posshiftFields[7].Descriptor()
+	// posshift.DefaultOpenedAt holds the default value on creation for the opened_at field.
+	posshift.DefaultOpenedAt = posshiftDescOpenedAt.Default.(func() time.Time)
+	postenderFields := schema.PosTender{}.Fields()
+	_ = postenderFields
+	// postenderDescAmount is the schema descriptor for amount field.
+	postenderDescAmount := postenderFields[1].Descriptor()
+	// postender.DefaultAmount holds the default value on creation for the amount field.
+	postender.DefaultAmount = postenderDescAmount.Default.(decimal.Decimal)
 	productFields := schema.Product{}.Fields()
 	_ = productFields
 	// productDescUnitCost is the schema descriptor for unit_cost field.
@@ -635,12 +1192,42 @@ func init() {
 	productDescMaxStockLevel := productFields[9].Descriptor()
 	// product.DefaultMaxStockLevel holds the default value on creation for the max_stock_level field.
 	product.DefaultMaxStockLevel = productDescMaxStockLevel.Default.(int)
+	// productDescReorderPoint is the schema descriptor for reorder_point field.
+	productDescReorderPoint := productFields[10].Descriptor()
+	// product.DefaultReorderPoint holds the default value on creation for the reorder_point field.
+	product.DefaultReorderPoint = productDescReorderPoint.Default.(int)
 	// productDescIsVariantParent is the schema descriptor for is_variant_parent field.
-	productDescIsVariantParent := productFields[12].Descriptor()
+	productDescIsVariantParent := productFields[13].Descriptor()
 	// product.DefaultIsVariantParent holds the default value on creation for the is_variant_parent field.
 	product.DefaultIsVariantParent = productDescIsVariantParent.Default.(bool)
+	// productDescWeight is the schema descriptor for weight field.
+	productDescWeight := productFields[14].Descriptor()
+	// product.DefaultWeight holds the default value on creation for the weight field.
+	product.DefaultWeight = productDescWeight.Default.(decimal.Decimal)
+	// productDescSoldByWeight is the schema descriptor for sold_by_weight field.
+	productDescSoldByWeight := productFields[15].Descriptor()
+	// product.DefaultSoldByWeight holds the default value on creation for the sold_by_weight field.
+	product.DefaultSoldByWeight = productDescSoldByWeight.Default.(bool)
+	// productDescPricePerKg is the schema descriptor for price_per_kg field.
+	productDescPricePerKg := productFields[16].Descriptor()
+	// product.DefaultPricePerKg holds the default value on creation for the price_per_kg field.
+	product.DefaultPricePerKg = productDescPricePerKg.Default.(decimal.Decimal)
+	// productDescTareWeight is the schema descriptor for tare_weight field.
+	productDescTareWeight := productFields[17].Descriptor()
+	// product.DefaultTareWeight holds the default value on creation for the tare_weight field.
+	product.DefaultTareWeight = productDescTareWeight.Default.(decimal.Decimal)
+	// productDescTrackLots is the schema descriptor for track_lots field.
+	productDescTrackLots := productFields[21].Descriptor()
+	// product.DefaultTrackLots holds the default value on creation for the track_lots field.
+	product.DefaultTrackLots = productDescTrackLots.Default.(bool)
+	// productDescExpiryAlertDays is the schema descriptor for expiry_alert_days field.
+	productDescExpiryAlertDays := productFields[22].Descriptor()
+	// product.DefaultExpiryAlertDays holds the default value on creation for the expiry_alert_days field.
+	product.DefaultExpiryAlertDays = productDescExpiryAlertDays.Default.(int)
+	// product.ExpiryAlertDaysValidator is a validator for the "expiry_alert_days" field. It is called by the builders before save.
+	product.ExpiryAlertDaysValidator = productDescExpiryAlertDays.Validators[0].(func(int) error)
 	// productDescIsDisposed is the schema descriptor for is_disposed field.
-	productDescIsDisposed := productFields[20].Descriptor()
+	productDescIsDisposed := productFields[29].Descriptor()
 	// product.DefaultIsDisposed holds the default value on creation for the is_disposed field.
 	product.DefaultIsDisposed = productDescIsDisposed.Default.(bool)
 	productvariantFields := schema.ProductVariant{}.Fields()
@@ -673,12 +1260,16 @@ func init() {
 	purchaseorderDescTotalAmount := purchaseorderFields[4].Descriptor()
 	// purchaseorder.DefaultTotalAmount holds the default value on creation for the total_amount field.
 	purchaseorder.DefaultTotalAmount = purchaseorderDescTotalAmount.Default.(decimal.Decimal)
+	// purchaseorderDescAutoGenerated is the schema descriptor for auto_generated field.
+	purchaseorderDescAutoGenerated := purchaseorderFields[6].Descriptor()
+	// purchaseorder.DefaultAutoGenerated holds the default value on creation for the auto_generated field.
+	purchaseorder.DefaultAutoGenerated = purchaseorderDescAutoGenerated.Default.(bool)
 	// purchaseorderDescCreatedAt is the schema descriptor for created_at field.
-	purchaseorderDescCreatedAt := purchaseorderFields[6].Descriptor()
+	purchaseorderDescCreatedAt := purchaseorderFields[7].Descriptor()
 	// purchaseorder.DefaultCreatedAt holds the default value on creation for the created_at field.
 	purchaseorder.DefaultCreatedAt = purchaseorderDescCreatedAt.Default.(func() time.Time)
 	// purchaseorderDescUpdatedAt is the schema descriptor for updated_at field.
-	purchaseorderDescUpdatedAt := purchaseorderFields[7].Descriptor()
+	purchaseorderDescUpdatedAt := purchaseorderFields[8].Descriptor()
 	// purchaseorder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	purchaseorder.DefaultUpdatedAt = purchaseorderDescUpdatedAt.Default.(func() time.Time)
 	// purchaseorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -896,11 +1487,11 @@ func init() {
 	stockalertFields := schema.StockAlert{}.Fields()
 	_ = stockalertFields
 	// stockalertDescIsRead is the schema descriptor for is_read field.
-	stockalertDescIsRead := stockalertFields[2].Descriptor()
+	stockalertDescIsRead := stockalertFields[3].Descriptor()
 	// stockalert.DefaultIsRead holds the default value on creation for the is_read field.
 	stockalert.DefaultIsRead = stockalertDescIsRead.Default.(bool)
 	// stockalertDescCreatedAt is the schema descriptor for created_at field.
-	stockalertDescCreatedAt := stockalertFields[3].Descriptor()
+	stockalertDescCreatedAt := stockalertFields[4].Descriptor()
 	// stockalert.DefaultCreatedAt holds the default value on creation for the created_at field.
 	stockalert.DefaultCreatedAt = stockalertDescCreatedAt.Default.(func() time.Time)
 	stockauditlogFields := schema.StockAuditLog{}.Fields()
@@ -909,6 +1500,26 @@ func init() {
 	stockauditlogDescCreatedAt := stockauditlogFields[6].Descriptor()
 	// stockauditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	stockauditlog.DefaultCreatedAt = stockauditlogDescCreatedAt.Default.(func() time.Time)
+	stocklevelFields := schema.StockLevel{}.Fields()
+	_ = stocklevelFields
+	// stocklevelDescBin is the schema descriptor for bin field.
+	stocklevelDescBin := stocklevelFields[0].Descriptor()
+	// stocklevel.DefaultBin holds the default value on creation for the bin field.
+	stocklevel.DefaultBin = stocklevelDescBin.Default.(string)
+	// stocklevelDescLotNumber is the schema descriptor for lot_number field.
+	stocklevelDescLotNumber := stocklevelFields[1].Descriptor()
+	// stocklevel.DefaultLotNumber holds the default value on creation for the lot_number field.
+	stocklevel.DefaultLotNumber = stocklevelDescLotNumber.Default.(string)
+	// stocklevelDescQuantity is the schema descriptor for quantity field.
+	stocklevelDescQuantity := stocklevelFields[3].Descriptor()
+	// stocklevel.DefaultQuantity holds the default value on creation for the quantity field.
+	stocklevel.DefaultQuantity = stocklevelDescQuantity.Default.(decimal.Decimal)
+	// stocklevelDescUpdatedAt is the schema descriptor for updated_at field.
+	stocklevelDescUpdatedAt := stocklevelFields[4].Descriptor()
+	// stocklevel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	stocklevel.DefaultUpdatedAt = stocklevelDescUpdatedAt.Default.(func() time.Time)
+	// stocklevel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	stocklevel.UpdateDefaultUpdatedAt = stocklevelDescUpdatedAt.UpdateDefault.(func() time.Time)
 	stockmovementFields := schema.StockMovement{}.Fields()
 	_ = stockmovementFields
 	// stockmovementDescQuantity is the schema descriptor for quantity field.
@@ -916,7 +1527,7 @@ func init() {
 	// stockmovement.DefaultQuantity holds the default value on creation for the quantity field.
 	stockmovement.DefaultQuantity = stockmovementDescQuantity.Default.(decimal.Decimal)
 	// stockmovementDescCreatedAt is the schema descriptor for created_at field.
-	stockmovementDescCreatedAt := stockmovementFields[7].Descriptor()
+	stockmovementDescCreatedAt := stockmovementFields[10].Descriptor()
 	// stockmovement.DefaultCreatedAt holds the default value on creation for the created_at field.
 	stockmovement.DefaultCreatedAt = stockmovementDescCreatedAt.Default.(func() time.Time)
 	strategicroadmapFields := schema.StrategicRoadmap{}.Fields()
@@ -947,6 +1558,116 @@ func init() {
 	supplierDescName := supplierFields[0].Descriptor()
 	// supplier.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	supplier.NameValidator = supplierDescName.Validators[0].(func(string) error)
+	// supplierDescPaymentTermsDays is the schema descriptor for payment_terms_days field.
+	supplierDescPaymentTermsDays := supplierFields[9].Descriptor()
+	// supplier.DefaultPaymentTermsDays holds the default value on creation for the payment_terms_days field.
+	supplier.DefaultPaymentTermsDays = supplierDescPaymentTermsDays.Default.(int)
+	// supplierDescLeadTimeDays is the schema descriptor for lead_time_days field.
+	supplierDescLeadTimeDays := supplierFields[10].Descriptor()
+	// supplier.DefaultLeadTimeDays holds the default value on creation for the lead_time_days field.
+	supplier.DefaultLeadTimeDays = supplierDescLeadTimeDays.Default.(int)
+	// supplier.LeadTimeDaysValidator is a validator for the "lead_time_days" field. It is called by the builders before save.
+	supplier.LeadTimeDaysValidator = supplierDescLeadTimeDays.Validators[0].(func(int) error)
+	// supplierDescPriceTolerance is the schema descriptor for price_tolerance field.
+	supplierDescPriceTolerance := supplierFields[13].Descriptor()
+	// supplier.DefaultPriceTolerance holds the default value on creation for the price_tolerance field.
+	supplier.DefaultPriceTolerance = supplierDescPriceTolerance.Default.(decimal.Decimal)
+	// supplierDescQuantityTolerance is the schema descriptor for quantity_tolerance field.
+	supplierDescQuantityTolerance := supplierFields[14].Descriptor()
+	// supplier.DefaultQuantityTolerance holds the default value on creation for the quantity_tolerance field.
+	supplier.DefaultQuantityTolerance = supplierDescQuantityTolerance.Default.(decimal.Decimal)
+	supplierbillFields := schema.SupplierBill{}.Fields()
+	_ = supplierbillFields
+	// supplierbillDescBillDate is the schema descriptor for bill_date field.
+	supplierbillDescBillDate := supplierbillFields[3].Descriptor()
+	// supplierbill.DefaultBillDate holds the default value on creation for the bill_date field.
+	supplierbill.DefaultBillDate = supplierbillDescBillDate.Default.(func() time.Time)
+	// supplierbillDescCurrency is the schema descriptor for currency field.
+	supplierbillDescCurrency := supplierbillFields[5].Descriptor()
+	// supplierbill.DefaultCurrency holds the default value on creation for the currency field.
+	supplierbill.DefaultCurrency = supplierbillDescCurrency.Default.(string)
+	// supplierbillDescExchangeRate is the schema descriptor for exchange_rate field.
+	supplierbillDescExchangeRate := supplierbillFields[6].Descriptor()
+	// supplierbill.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	supplierbill.DefaultExchangeRate = supplierbillDescExchangeRate.Default.(decimal.Decimal)
+	// supplierbillDescSubtotal is the schema descriptor for subtotal field.
+	supplierbillDescSubtotal := supplierbillFields[7].Descriptor()
+	// supplierbill.DefaultSubtotal holds the default value on creation for the subtotal field.
+	supplierbill.DefaultSubtotal = supplierbillDescSubtotal.Default.(decimal.Decimal)
+	// supplierbillDescTaxTotal is the schema descriptor for tax_total field.
+	supplierbillDescTaxTotal := supplierbillFields[8].Descriptor()
+	// supplierbill.DefaultTaxTotal holds the default value on creation for the tax_total field.
+	supplierbill.DefaultTaxTotal = supplierbillDescTaxTotal.Default.(decimal.Decimal)
+	// supplierbillDescTotal is the schema descriptor for total field.
+	supplierbillDescTotal := supplierbillFields[9].Descriptor()
+	// supplierbill.DefaultTotal holds the default value on creation for the total field.
+	supplierbill.DefaultTotal = supplierbillDescTotal.Default.(decimal.Decimal)
+	// supplierbillDescAmountPaid is the schema descriptor for amount_paid field.
+	supplierbillDescAmountPaid := supplierbillFields[10].Descriptor()
+	// supplierbill.DefaultAmountPaid holds the default value on creation for the amount_paid field.
+	supplierbill.DefaultAmountPaid = supplierbillDescAmountPaid.Default.(decimal.Decimal)
+	// supplierbillDescCreatedAt is the schema descriptor for created_at field.
+	supplierbillDescCreatedAt := supplierbillFields[14].Descriptor()
+	// supplierbill.DefaultCreatedAt holds the default value on creation for the created_at field.
+	supplierbill.DefaultCreatedAt = supplierbillDescCreatedAt.Default.(func() time.Time)
+	supplierbilllineFields := schema.SupplierBillLine{}.Fields()
+	_ = supplierbilllineFields
+	// supplierbilllineDescQuantity is the schema descriptor for quantity field.
+	supplierbilllineDescQuantity := supplierbilllineFields[1].Descriptor()
+	// supplierbillline.DefaultQuantity holds the default value on creation for the quantity field.
+	supplierbillline.DefaultQuantity = supplierbilllineDescQuantity.Default.(decimal.Decimal)
+	// supplierbilllineDescUnitPrice is the schema descriptor for unit_price field.
+	supplierbilllineDescUnitPrice := supplierbilllineFields[2].Descriptor()
+	// supplierbillline.DefaultUnitPrice holds the default value on creation for the unit_price field.
+	supplierbillline.DefaultUnitPrice = supplierbilllineDescUnitPrice.Default.(decimal.Decimal)
+	// supplierbilllineDescTaxRate is the schema descriptor for tax_rate field.
+	supplierbilllineDescTaxRate := supplierbilllineFields[4].Descriptor()
+	// supplierbillline.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	supplierbillline.DefaultTaxRate = supplierbilllineDescTaxRate.Default.(decimal.Decimal)
+	// supplierbilllineDescNetAmount is the schema descriptor for net_amount field.
+	supplierbilllineDescNetAmount := supplierbilllineFields[5].Descriptor()
+	// supplierbillline.DefaultNetAmount holds the default value on creation for the net_amount field.
+	supplierbillline.DefaultNetAmount = supplierbilllineDescNetAmount.Default.(decimal.Decimal)
+	// supplierbilllineDescQuantityVariance is the schema descriptor for quantity_variance field.
+	supplierbilllineDescQuantityVariance := supplierbilllineFields[7].Descriptor()
+	// supplierbillline.DefaultQuantityVariance holds the default value on creation for the quantity_variance field.
+	supplierbillline.DefaultQuantityVariance = supplierbilllineDescQuantityVariance.Default.(decimal.Decimal)
+	// supplierbilllineDescPriceVariance is the schema descriptor for price_variance field.
+	supplierbilllineDescPriceVariance := supplierbilllineFields[8].Descriptor()
+	// supplierbillline.DefaultPriceVariance holds the default value on creation for the price_variance field.
+	supplierbillline.DefaultPriceVariance = supplierbilllineDescPriceVariance.Default.(decimal.Decimal)
+	// supplierbilllineDescPosition is the schema descriptor for position field.
+	supplierbilllineDescPosition := supplierbilllineFields[10].Descriptor()
+	// supplierbillline.DefaultPosition holds the default value on creation for the position field.
+	supplierbillline.DefaultPosition = supplierbilllineDescPosition.Default.(int)
+	taxjurisdictionFields := schema.TaxJurisdiction{}.Fields()
+	_ = taxjurisdictionFields
+	// taxjurisdictionDescCode is the schema descriptor for code field.
+	taxjurisdictionDescCode := taxjurisdictionFields[0].Descriptor()
+	// taxjurisdiction.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	taxjurisdiction.CodeValidator = taxjurisdictionDescCode.Validators[0].(func(string) error)
+	// taxjurisdictionDescName is the schema descriptor for name field.
+	taxjurisdictionDescName := taxjurisdictionFields[1].Descriptor()
+	// taxjurisdiction.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	taxjurisdiction.NameValidator = taxjurisdictionDescName.Validators[0].(func(string) error)
+	// taxjurisdictionDescIsActive is the schema descriptor for is_active field.
+	taxjurisdictionDescIsActive := taxjurisdictionFields[3].Descriptor()
+	// taxjurisdiction.DefaultIsActive holds the default value on creation for the is_active field.
+	taxjurisdiction.DefaultIsActive = taxjurisdictionDescIsActive.Default.(bool)
+	// taxjurisdictionDescCreatedAt is the schema descriptor for created_at field.
+	taxjurisdictionDescCreatedAt := taxjurisdictionFields[4].Descriptor()
+	// taxjurisdiction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taxjurisdiction.DefaultCreatedAt = taxjurisdictionDescCreatedAt.Default.(func() time.Time)
+	taxrateFields := schema.TaxRate{}.Fields()
+	_ = taxrateFields
+	// taxrateDescRate is the schema descriptor for rate field.
+	taxrateDescRate := taxrateFields[0].Descriptor()
+	// taxrate.DefaultRate holds the default value on creation for the rate field.
+	taxrate.DefaultRate = taxrateDescRate.Default.(decimal.Decimal)
+	// taxrateDescCreatedAt is the schema descriptor for created_at field.
+	taxrateDescCreatedAt := taxrateFields[3].Descriptor()
+	// taxrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taxrate.DefaultCreatedAt = taxrateDescCreatedAt.Default.(func() time.Time)
 	tenantFields := schema.Tenant{}.Fields()
 	_ = tenantFields
 	// tenantDescCreatedAt is the schema descriptor for created_at field.
@@ -961,6 +1682,30 @@ func init() {
 	tenantDescTransactionLimit := tenantFields[4].Descriptor()
 	// tenant.DefaultTransactionLimit holds the default value on creation for the transaction_limit field.
 	tenant.DefaultTransactionLimit = tenantDescTransactionLimit.Default.(decimal.Decimal)
+	// tenantDescFunctionalCurrency is the schema descriptor for functional_currency field.
+	tenantDescFunctionalCurrency := tenantFields[5].Descriptor()
+	// tenant.DefaultFunctionalCurrency holds the default value on creation for the functional_currency field.
+	tenant.DefaultFunctionalCurrency = tenantDescFunctionalCurrency.Default.(string)
+	// tenantDescFiscalYearStartMonth is the schema descriptor for fiscal_year_start_month field.
+	tenantDescFiscalYearStartMonth := tenantFields[6].Descriptor()
+	// tenant.DefaultFiscalYearStartMonth holds the default value on creation for the fiscal_year_start_month field.
+	tenant.DefaultFiscalYearStartMonth = tenantDescFiscalYearStartMonth.Default.(int)
+	// tenant.FiscalYearStartMonthValidator is a validator for the "fiscal_year_start_month" field. It is called by the builders before save.
+	tenant.FiscalYearStartMonthValidator = func() func(int) error {
+		validators := tenantDescFiscalYearStartMonth.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(fiscal_year_start_month int) error {
+			for _, fn := range fns {
+				if err := fn(fiscal_year_start_month); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	ticketFields := schema.Ticket{}.Fields()
 	_ = ticketFields
 	// ticketDescCreatedAt is the schema descriptor for created_at field.
@@ -1077,14 +1822,34 @@ func init() {
 	transactionDescTaxAmount := transactionFields[3].Descriptor()
 	// transaction.DefaultTaxAmount holds the default value on creation for the tax_amount field.
 	transaction.DefaultTaxAmount = transactionDescTaxAmount.Default.(decimal.Decimal)
+	// transactionDescCurrency is the schema descriptor for currency field.
+	transactionDescCurrency := transactionFields[4].Descriptor()
+	// transaction.DefaultCurrency holds the default value on creation for the currency field.
+	transaction.DefaultCurrency = transactionDescCurrency.Default.(string)
+	// transactionDescExchangeRate is the schema descriptor for exchange_rate field.
+	transactionDescExchangeRate := transactionFields[5].Descriptor()
+	// transaction.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	transaction.DefaultExchangeRate = transactionDescExchangeRate.Default.(decimal.Decimal)
 	// transactionDescUUID is the schema descriptor for uuid field.
-	transactionDescUUID := transactionFields[6].Descriptor()
+	transactionDescUUID := transactionFields[8].Descriptor()
 	// transaction.DefaultUUID holds the default value on creation for the uuid field.
 	transaction.DefaultUUID = transactionDescUUID.Default.(func() string)
 	// transactionDescIsIntercompany is the schema descriptor for is_intercompany field.
-	transactionDescIsIntercompany := transactionFields[9].Descriptor()
+	transactionDescIsIntercompany := transactionFields[11].Descriptor()
 	// transaction.DefaultIsIntercompany holds the default value on creation for the is_intercompany field.
 	transaction.DefaultIsIntercompany = transactionDescIsIntercompany.Default.(bool)
+	transferorderFields := schema.TransferOrder{}.Fields()
+	_ = transferorderFields
+	// transferorderDescCreatedAt is the schema descriptor for created_at field.
+	transferorderDescCreatedAt := transferorderFields[6].Descriptor()
+	// transferorder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transferorder.DefaultCreatedAt = transferorderDescCreatedAt.Default.(func() time.Time)
+	transferorderlineFields := schema.TransferOrderLine{}.Fields()
+	_ = transferorderlineFields
+	// transferorderlineDescQuantity is the schema descriptor for quantity field.
+	transferorderlineDescQuantity := transferorderlineFields[0].Descriptor()
+	// transferorderline.DefaultQuantity holds the default value on creation for the quantity field.
+	transferorderline.DefaultQuantity = transferorderlineDescQuantity.Default.(decimal.Decimal)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescCreatedAt is the schema descriptor for created_at field.
@@ -21,12 +21,15 @@ func (Account) Fields() []ent.Field {
 		field.String("name"),
 		field.String("number"),
 		field.Enum("type").Values("asset", "liability", "equity", "revenue", "expense"),
+		// balance is a cache of the ledger on the account's normal side; the JournalEntry rows are authoritative.
 		field.Other("balance", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Bool("is_intercompany").Default(false),
+		field.String("currency").Optional(), // Denomination of a monetary account; empty means functional currency
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -47,5 +50,8 @@ func (Account) Edges() []ent.Edge {
 		edge.To("entries", LedgerEntry.Type),
 		edge.To("journal_entries", JournalEntry.Type),
 		edge.To("recurring_invoices", RecurringInvoice.Type),
+		edge.To("balance_snapshots", AccountBalanceSnapshot.Type),
+		edge.To("bank_statements", BankStatement.Type),
+		edge.To("bank_statement_lines", BankStatementLine.Type),
 	}
 }
\ No newline at end of file
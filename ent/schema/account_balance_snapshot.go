@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// AccountBalanceSnapshot holds the schema definition for the AccountBalanceSnapshot entity.
+// It materializes an account's activity and closing balance (on its normal side) for one
+// period, so balances can be derived from the last snapshot plus the entries posted after it.
+type AccountBalanceSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the AccountBalanceSnapshot.
+func (AccountBalanceSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("period_start"),
+		field.Time("period_end"),
+		field.Other("debit_total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("credit_total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("closing_balance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Time("computed_at").Default(time.Now),
+	}
+}
+
+// Indexes of the AccountBalanceSnapshot.
+func (AccountBalanceSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("period_end").Edges("account").Unique(),
+		index.Fields("period_end").Edges("tenant"),
+	}
+}
+
+// Edges of the AccountBalanceSnapshot.
+func (AccountBalanceSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("balance_snapshots").Unique().Required(),
+		edge.From("account", Account.Type).Ref("balance_snapshots").Unique().Required(),
+	}
+}
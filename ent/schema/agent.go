@@ -17,13 +17,24 @@ type Agent struct {
 func (Agent) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("hostname").NotEmpty(),
-		field.String("os").NotEmpty(), // e.g., "linux", "windows"
+		field.String("os").NotEmpty(),   // e.g., "linux", "windows"
 		field.String("arch").NotEmpty(), // e.g., "amd64", "arm64"
 		field.String("ip").NotEmpty(),
 		field.String("mac").NotEmpty(),
 		field.String("version").NotEmpty(), // Agent version
 		field.Enum("status").Values("online", "offline", "warning").Default("offline"),
 		field.Time("last_seen").Default(time.Now),
+		field.JSON("tags", []string{}).Optional(), // Free-form labels jobs can target, e.g. "pos"
+		// Identity issued at enrollment: the agent's own key, and the client certificate the
+		// tenant's authority signed for it. Re-keying replaces both.
+		field.Text("public_key").Optional(),  // PEM
+		field.Text("certificate").Optional(), // PEM
+		field.String("certificate_serial").Optional(),
+		field.Time("certificate_expires_at").Optional().Nillable(),
+		field.Time("enrolled_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),   // Revoked agents get no hub tokens
+		field.Bool("rekey_requested").Default(false),     // The agent replaces its key on its next token request
+		field.Time("inventory_at").Optional().Nillable(), // When the agent last reported its inventory
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -36,6 +47,14 @@ func (Agent) Edges() []ent.Edge {
 			Ref("agents").
 			Unique(),
 		edge.To("job_executions", JobExecution.Type),
+		edge.From("enrollment_token", AgentEnrollmentToken.Type).
+			Ref("agent").
+			Unique(),
+		edge.From("groups", DeviceGroup.Type).
+			Ref("agents"),
+		edge.To("inventory", AgentInventory.Type),
+		edge.To("software", AgentSoftware.Type),
+		edge.To("alerts", AgentAlert.Type),
 	}
 }
 
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// AgentAlert holds the schema definition for the AgentAlert entity.
+// An alert rule firing for one agent. It stays open while the condition holds and is
+// resolved once it clears or the agent is no longer targeted.
+type AgentAlert struct {
+	ent.Schema
+}
+
+// Fields of the AgentAlert.
+func (AgentAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("severity").Values("info", "warning", "critical"),
+		field.String("message"),
+		field.Float("value"), // The metric when the alert was raised
+		field.Time("raised_at").Default(time.Now).Immutable(),
+		field.Time("resolved_at").Optional().Nillable(),
+		field.Time("acknowledged_at").Optional().Nillable(),
+		field.String("acknowledged_by").Optional(),
+	}
+}
+
+// Edges of the AgentAlert.
+func (AgentAlert) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("rule", AlertRule.Type).Ref("alerts").Unique().Required(),
+		edge.From("agent", Agent.Type).Ref("alerts").Unique().Required(),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// AgentAuthority holds the schema definition for the AgentAuthority entity.
+// The certificate authority that signs the client certificates of a tenant's agents, which
+// they present to the hub and the Pulse API. One per tenant, created with its first agent.
+type AgentAuthority struct {
+	ent.Schema
+}
+
+// Fields of the AgentAuthority.
+func (AgentAuthority) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("certificate"),                       // PEM
+		field.Text("private_key_encrypted").Sensitive(), // pkg/crypto ciphertext of the PEM key
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AgentAuthority.
+func (AgentAuthority) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("agent_authority").Unique().Required(),
+	}
+}
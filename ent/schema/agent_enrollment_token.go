@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// AgentEnrollmentToken holds the schema definition for the AgentEnrollmentToken entity.
+// A token an admin hands to an installer so a new machine can enrol as an agent of the
+// tenant. Only its hash is kept; it enrols one agent and expires.
+type AgentEnrollmentToken struct {
+	ent.Schema
+}
+
+// Fields of the AgentEnrollmentToken.
+func (AgentEnrollmentToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").Unique().Immutable().Sensitive(), // SHA-256 of the token, hex
+		field.Time("expires_at").Immutable(),
+		field.Time("used_at").Optional().Nillable(),
+		field.String("created_by").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AgentEnrollmentToken.
+func (AgentEnrollmentToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("agent_enrollment_tokens").Unique().Required(),
+		edge.To("agent", Agent.Type).Unique(), // The agent enrolled with it
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"sent/pkg/pulse/common"
+	"time"
+)
+
+// AgentInventory holds the schema definition for the AgentInventory entity.
+// One version of an agent's inventory: hardware, software, services and pending patches as
+// the agent reported them. A report identical to the latest version only moves its
+// confirmed_at; a different one becomes the next version, with what changed.
+type AgentInventory struct {
+	ent.Schema
+}
+
+// Fields of the AgentInventory.
+func (AgentInventory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").Positive(),
+		field.String("hash").NotEmpty(), // Of the report's contents, to spot unchanged reports
+		field.Time("collected_at"),
+		field.Time("confirmed_at"), // Last time the agent reported this same inventory
+		field.JSON("host", common.HostInfo{}),
+		field.JSON("hardware", common.HardwareInfo{}),
+		field.JSON("software", []common.SoftwareInfo{}).Optional(),
+		field.JSON("services", []common.ServiceInfo{}).Optional(),
+		field.JSON("patches", []common.PatchInfo{}).Optional(),      // Pending
+		field.JSON("changes", common.InventoryChanges{}).Optional(), // Since the previous version
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AgentInventory.
+func (AgentInventory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("agent", Agent.Type).Ref("inventory").Unique().Required(),
+	}
+}
+
+// Indexes of the AgentInventory.
+func (AgentInventory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("version").Edges("agent").Unique(),
+	}
+}
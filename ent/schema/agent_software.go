@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AgentSoftware holds the schema definition for the AgentSoftware entity.
+// A package version an agent has, from the inventory report it first appeared in until the
+// one it was gone from. An upgrade removes the old version's row and adds one for the new
+// version, so the rows answer what was installed or removed since a time, fleet-wide.
+type AgentSoftware struct {
+	ent.Schema
+}
+
+// Fields of the AgentSoftware.
+func (AgentSoftware) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("version").Optional(),
+		field.String("publisher").Optional(),
+		field.String("install_date").Optional(), // As the agent's OS reports it
+		field.Time("first_seen").Default(time.Now),
+		field.Time("removed_at").Optional().Nillable(), // Unset while installed
+	}
+}
+
+// Edges of the AgentSoftware.
+func (AgentSoftware) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("agent", Agent.Type).Ref("software").Unique().Required(),
+	}
+}
+
+// Indexes of the AgentSoftware.
+func (AgentSoftware) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name"),
+		index.Fields("first_seen"),
+		index.Fields("removed_at"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AlertRule holds the schema definition for the AlertRule entity.
+// Raises an alert for each agent it targets whose metric is past its threshold. Its targets
+// are resolved each time the rules are evaluated.
+type AlertRule struct {
+	ent.Schema
+}
+
+// Fields of the AlertRule.
+func (AlertRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		// "all", "agent:<id>", "group:<id>" or "tag:<name>"
+		field.JSON("targets", []string{}),
+		field.Enum("metric").Values("cpu", "memory", "offline"),
+		field.Float("threshold"), // Percent for cpu and memory; minutes unseen for offline
+		field.Enum("severity").Values("info", "warning", "critical").Default("warning"),
+		field.Bool("enabled").Default(true),
+		field.String("created_by").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the AlertRule.
+func (AlertRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("alert_rules").Unique().Required(),
+		edge.To("alerts", AgentAlert.Type),
+	}
+}
+
+// Indexes of the AlertRule.
+func (AlertRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("tenant").Unique(),
+	}
+}
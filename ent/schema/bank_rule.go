@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// BankRule holds the schema definition for the BankRule entity.
+// A rule suggests the contra account for statement lines that match no ledger entry,
+// e.g. description contains "AWS" -> 6100 Cloud expense.
+type BankRule struct {
+	ent.Schema
+}
+
+// Fields of the BankRule.
+func (BankRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Enum("match_field").Values("description", "reference", "counterparty").Default("description"),
+		field.String("pattern"), // Case-insensitive substring
+		field.Enum("direction").Values("any", "inflow", "outflow").Default("any"),
+		field.Int("priority").Default(100), // Lower runs first
+		field.Bool("is_active").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the BankRule.
+func (BankRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("bank_rules").Unique().Required(),
+		edge.To("account", Account.Type).Unique().Required(), // Contra account for the suggested posting
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// BankStatement holds the schema definition for the BankStatement entity.
+// One row per imported statement file for a cash account.
+type BankStatement struct {
+	ent.Schema
+}
+
+// Fields of the BankStatement.
+func (BankStatement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("format").Values("csv", "ofx", "camt053"),
+		field.String("statement_ref").Optional(), // Bank-assigned statement id, if any
+		field.String("file_name").Optional(),
+		field.String("currency").Optional(),
+		field.Time("period_start").Optional().Nillable(),
+		field.Time("period_end").Optional().Nillable(),
+		field.Other("opening_balance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Optional().
+			Nillable(),
+		field.Other("closing_balance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("imported_at").Default(time.Now).Immutable(),
+		field.String("imported_by").Optional(),
+	}
+}
+
+// Indexes of the BankStatement.
+func (BankStatement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("tenant"),
+		index.Edges("account"),
+	}
+}
+
+// Edges of the BankStatement.
+func (BankStatement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("bank_statements").Unique().Required(),
+		edge.From("account", Account.Type).Ref("bank_statements").Unique().Required(), // The cash account the statement belongs to
+		edge.To("lines", BankStatementLine.Type),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// BankStatementLine holds the schema definition for the BankStatementLine entity.
+// It is the staging table for imported bank transactions awaiting reconciliation.
+type BankStatementLine struct {
+	ent.Schema
+}
+
+// Fields of the BankStatementLine.
+func (BankStatementLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("external_id"), // FITID, AcctSvcrRef or a hash of the CSV row; used for de-duplication
+		field.Time("booking_date"),
+		field.Time("value_date").Optional().Nillable(),
+		// amount is signed from the account holder's view: positive is money in, negative is money out.
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("currency").Optional(),
+		field.String("description").Optional(),
+		field.String("reference").Optional(),
+		field.String("counterparty").Optional(),
+		field.Enum("status").
+			Values("unmatched", "matched", "suggested", "posted", "ignored").
+			Default("unmatched"),
+		field.Int("match_score").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the BankStatementLine.
+func (BankStatementLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("external_id").Edges("account").Unique(),
+		index.Fields("status"),
+		index.Fields("booking_date"),
+	}
+}
+
+// Edges of the BankStatementLine.
+func (BankStatementLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("bank_statement_lines").Unique().Required(),
+		edge.From("statement", BankStatement.Type).Ref("lines").Unique().Required(),
+		edge.From("account", Account.Type).Ref("bank_statement_lines").Unique().Required(),
+		edge.To("ledger_entry", LedgerEntry.Type).Unique(), // Cash-side entry the line is reconciled against
+		edge.To("suggested_account", Account.Type).Unique(),
+		edge.To("suggested_rule", BankRule.Type).Unique(),
+	}
+}
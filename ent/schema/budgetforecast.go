@@ -22,11 +22,13 @@ func (BudgetForecast) Fields() []ent.Field {
 		field.Other("projected_amount", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Other("actual_spent", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.JSON("forecast_data", map[string]interface{}{}).Optional(), // breakdown of refresh vs recurring
@@ -20,6 +20,7 @@ func (CompensationAgreement) Fields() []ent.Field {
 		field.Other("base_salary", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.String("currency").Default("USD"),
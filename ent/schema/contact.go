@@ -28,6 +28,7 @@ func (Contact) Fields() []ent.Field {
 		field.Other("lifetime_value", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				"postgres": "numeric(19,4)",
+				"sqlite3":  "numeric",
 			}).
 			Default(decimal.Zero).
 			Comment("Total value of all purchases made by this contact"),
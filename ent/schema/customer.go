@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Customer holds the schema definition for the Customer entity.
+// Customers are the accounts receivable subledger parties invoices are issued to.
+type Customer struct {
+	ent.Schema
+}
+
+// Fields of the Customer.
+func (Customer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code"), // Short customer number shown on documents
+		field.String("name").NotEmpty(),
+		field.String("email").Optional(),
+		field.String("phone").Optional(),
+		field.String("address").Optional(),
+		field.String("tax_id").Optional(),              // VAT registration number
+		field.String("country_code").Optional(),        // ISO 3166 alpha-2; selects the default tax rate
+		field.Bool("tax_exempt").Default(false),        // Invoiced under the exempt code of its country
+		field.String("exemption_reference").Optional(), // Certificate or decree the exemption rests on
+		field.String("currency").Optional(),            // Billing currency; empty means functional currency
+		field.Int("payment_terms_days").Default(30).NonNegative(),
+		field.Bool("is_active").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the Customer.
+func (Customer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").Edges("tenant").Unique(),
+		index.Fields("name"),
+	}
+}
+
+// Edges of the Customer.
+func (Customer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("customers").Unique().Required(),
+		edge.To("invoices", Invoice.Type),
+		edge.To("payments", CustomerPayment.Type),
+		edge.To("sales", Transaction.Type),
+		edge.From("recurring_invoices", RecurringInvoice.Type).Ref("customer"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// CustomerPayment holds the schema definition for the CustomerPayment entity.
+// A payment is posted to AR in full when received and then allocated to invoices.
+type CustomerPayment struct {
+	ent.Schema
+}
+
+// Fields of the CustomerPayment.
+func (CustomerPayment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("date").Default(time.Now),
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("currency").Default("USD"),
+		field.Other("exchange_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,8)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(1)),
+		field.Other("unallocated", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("method").Optional(), // bank_transfer, card, cash, cheque
+		field.String("reference").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the CustomerPayment.
+func (CustomerPayment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("customer_payments").Unique().Required(),
+		edge.From("customer", Customer.Type).Ref("payments").Unique().Required(),
+		edge.To("deposit_account", Account.Type).Unique().Required(),
+		edge.To("transaction", Transaction.Type).Unique(),
+		edge.To("allocations", PaymentAllocation.Type),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// DeviceGroup holds the schema definition for the DeviceGroup entity.
+// A named set of a tenant's agents that jobs target. A static group lists its members; a
+// dynamic one has a filter (e.g. os = linux AND hostname ~ "pos-*") and its members are
+// whichever agents match it when a job runs.
+type DeviceGroup struct {
+	ent.Schema
+}
+
+// Fields of the DeviceGroup.
+func (DeviceGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("description").Optional(),
+		field.Text("filter").Optional(), // Set for dynamic groups
+		field.String("created_by").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DeviceGroup.
+func (DeviceGroup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("device_groups").Unique().Required(),
+		edge.To("agents", Agent.Type), // Members of a static group
+	}
+}
+
+// Indexes of the DeviceGroup.
+func (DeviceGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("tenant").Unique(),
+	}
+}
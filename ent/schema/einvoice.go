@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// EInvoice holds the schema definition for the EInvoice entity.
+// The signed XML of an invoice, credit note or POS sale as the tax authority receives it.
+// E-invoices form a chain per tenant: each carries the next counter and the hash of the one
+// before it, so they are never edited or deleted.
+type EInvoice struct {
+	ent.Schema
+}
+
+// Fields of the EInvoice.
+func (EInvoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("authority").Values("zatca", "jofotara").Default("zatca"),
+		field.Enum("kind").Values("invoice", "credit_note", "debit_note"),
+		field.Enum("profile").Values("standard", "simplified"), // Standard (B2B) invoices are cleared, simplified ones reported
+		field.String("number"), // The document number
+		field.String("uuid"),
+		field.Int("counter").Positive(), // ICV
+		field.String("invoice_hash"),    // Base64 SHA-256; the next e-invoice's previous_hash
+		field.String("previous_hash"),   // PIH
+		field.Text("xml"),
+		field.Text("qr"),                     // Base64 TLV payload printed on the document
+		field.Text("cleared_xml").Optional(), // The document as the authority cleared it; what the buyer gets
+		field.Time("signed_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the EInvoice.
+func (EInvoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("counter").Edges("tenant").Unique(),
+		index.Fields("uuid"),
+	}
+}
+
+// Edges of the EInvoice.
+func (EInvoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("einvoices").Unique().Required(),
+		edge.From("credential", EInvoiceCredential.Type).Ref("einvoices").Unique().Required(), // The CSID that signed it
+		edge.To("invoice", Invoice.Type).Unique(),                                             // AR invoices and credit notes
+		edge.To("transaction", Transaction.Type).Unique(),                                     // Its posting: a POS sale or refund, or the invoice's; clearance is tracked there
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// EInvoiceCredential holds the schema definition for the EInvoiceCredential entity.
+// The cryptographic stamp (CSID) a tax authority issued to the tenant's e-invoicing unit,
+// with the key it was requested with and the seller details registered alongside it; for
+// JoFotara, the client ID and secret the portal generated. Renewing adds a credential; only
+// the active one of an authority signs and submits.
+type EInvoiceCredential struct {
+	ent.Schema
+}
+
+// Fields of the EInvoiceCredential.
+func (EInvoiceCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("authority").Values("zatca", "jofotara").Default("zatca"),
+		// Which portal documents go to; local is the in-process sandbox.
+		field.Enum("environment").Values("local", "sandbox", "simulation", "production").Default("production"),
+		field.Text("certificate").Optional(),                       // ZATCA: base64 DER, as it goes into X509Certificate
+		field.Text("private_key_encrypted").Optional().Sensitive(), // ZATCA: pkg/crypto ciphertext of the PEM key
+		field.Text("secret_encrypted").Optional().Sensitive(),      // API secret issued with the CSID, or JoFotara's secret key
+		field.String("client_id").Optional(),                       // JoFotara client ID
+		field.String("compliance_request_id").Optional(),           // ZATCA compliance request the production CSID was issued for
+		field.String("registration_number").Optional(),             // Commercial registration (CRN)
+		field.String("street").Optional(),
+		field.String("building_number").Optional(),
+		field.String("district").Optional(),
+		field.String("city").Optional(),
+		field.String("postal_code").Optional(),
+		field.Bool("is_active").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the EInvoiceCredential.
+func (EInvoiceCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("einvoice_credentials").Unique().Required(),
+		edge.To("einvoices", EInvoice.Type),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity.
+// A row states that one unit of base_currency buys rate units of quote_currency
+// from effective_date until a newer quote for the same pair exists.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("base_currency"),
+		field.String("quote_currency"),
+		field.Other("rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,8)",
+				dialect.SQLite:   "numeric",
+			}),
+		field.Time("effective_date"),
+		field.String("source").Default("manual"), // manual, csv, ecb
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the ExchangeRate.
+func (ExchangeRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("base_currency", "quote_currency", "effective_date").Edges("tenant").Unique(),
+		index.Fields("effective_date"),
+	}
+}
+
+// Edges of the ExchangeRate.
+func (ExchangeRate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("exchange_rates").Unique().Required(),
+	}
+}
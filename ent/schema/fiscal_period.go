@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// FiscalPeriod holds the schema definition for the FiscalPeriod entity.
+// Periods make up a tenant's fiscal calendar and control which posting dates are accepted:
+// open periods take any posting, soft-closed periods only take adjustments by finance
+// managers, and hard-closed periods take nothing.
+type FiscalPeriod struct {
+	ent.Schema
+}
+
+// Fields of the FiscalPeriod.
+func (FiscalPeriod) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"), // e.g. "FY2024-03"
+		field.Int("fiscal_year"),
+		field.Int("period_number"), // 1-12 within the fiscal year
+		field.Time("start_date"),
+		field.Time("end_date"),
+		field.Enum("status").
+			Values("open", "soft_closed", "hard_closed").
+			Default("open"),
+		field.Time("closed_at").Optional().Nillable(),
+		field.String("closed_by").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the FiscalPeriod.
+func (FiscalPeriod) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("fiscal_year", "period_number").Edges("tenant").Unique(),
+		index.Fields("start_date", "end_date"),
+	}
+}
+
+// Edges of the FiscalPeriod.
+func (FiscalPeriod) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("fiscal_periods").Unique().Required(),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// GoodsReceipt holds the schema definition for the GoodsReceipt entity.
+// Each shipment received against a purchase order is one receipt into one warehouse. Freight,
+// duty and insurance on the shipment are allocated over its lines as landed cost.
+type GoodsReceipt struct {
+	ent.Schema
+}
+
+// Fields of the GoodsReceipt.
+func (GoodsReceipt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number"),               // GRN-00001
+		field.String("reference").Optional(), // Supplier delivery note or waybill
+		field.Time("received_at").Default(time.Now),
+		field.String("received_by").Optional(),
+		field.Other("freight", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("duty", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("insurance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Enum("allocation_method").Values("value", "weight").Default("value"),
+		field.String("notes").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the GoodsReceipt.
+func (GoodsReceipt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("number").Edges("tenant").Unique(),
+	}
+}
+
+// Edges of the GoodsReceipt.
+func (GoodsReceipt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("goods_receipts").Unique().Required(),
+		edge.From("purchase_order", PurchaseOrder.Type).Ref("receipts").Unique().Required(),
+		edge.From("warehouse", Warehouse.Type).Ref("goods_receipts").Unique().Required(),
+		edge.To("lines", GoodsReceiptLine.Type),
+		edge.To("transaction", Transaction.Type).Unique(),
+	}
+}
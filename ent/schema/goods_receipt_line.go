@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptLine holds the schema definition for the GoodsReceiptLine entity.
+type GoodsReceiptLine struct {
+	ent.Schema
+}
+
+// Fields of the GoodsReceiptLine.
+func (GoodsReceiptLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("quantity").Positive(),
+		field.Other("unit_cost", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Ordered cost per unit
+		field.Other("weight", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Total kg on this line, for weight allocation
+		field.Other("landed_cost", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Share of freight, duty and insurance
+		field.Other("landed_unit_cost", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Ordered cost plus landed cost, per unit; the FIFO batch cost
+	}
+}
+
+// Edges of the GoodsReceiptLine.
+func (GoodsReceiptLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("receipt", GoodsReceipt.Type).Ref("lines").Unique().Required(),
+		edge.From("purchase_order_line", PurchaseOrderLine.Type).Ref("receipt_lines").Unique().Required(),
+		edge.To("stock_movement", StockMovement.Type).Unique(),
+	}
+}
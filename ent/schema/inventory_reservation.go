@@ -14,18 +14,28 @@ type InventoryReservation struct {
 	ent.Schema
 }
 
+// StockTake is a quantity taken from one bin and lot of a warehouse.
+type StockTake struct {
+	Bin        string          `json:"bin,omitempty"`
+	LotNumber  string          `json:"lotNumber,omitempty"`
+	ExpiryDate *time.Time      `json:"expiryDate,omitempty"`
+	Quantity   decimal.Decimal `json:"quantity"`
+}
+
 // Fields of the InventoryReservation.
 func (InventoryReservation) Fields() []ent.Field {
 	return []ent.Field{
 		field.Other("quantity", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(15,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Time("expires_at"),
 		field.Enum("status").
 			Values("active", "released", "completed").
 			Default("active"),
+		field.JSON("takes", []StockTake{}).Optional(), // Where the stock came from, restored on release
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -35,5 +45,6 @@ func (InventoryReservation) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("product", Product.Type).Ref("reservations").Unique().Required(),
 		edge.From("tenant", Tenant.Type).Ref("inventory_reservations").Unique().Required(),
+		edge.From("warehouse", Warehouse.Type).Ref("reservations").Unique(),
 	}
 }
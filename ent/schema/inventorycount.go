@@ -19,14 +19,17 @@ func (InventoryCount) Fields() []ent.Field {
 		field.Other("counted_qty", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}),
 		field.Other("system_qty", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}),
 		field.Other("variance", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}),
 		field.Time("counted_at").Default(time.Now),
 		field.String("counted_by").Optional(),
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+// Credit notes are invoices of kind credit_note that point at the invoice they reverse.
+// All amounts are in the document currency.
+type Invoice struct {
+	ent.Schema
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number"),
+		field.Enum("kind").Values("invoice", "credit_note").Default("invoice"),
+		field.Enum("status").Values("draft", "issued", "partially_paid", "paid", "void").Default("draft"),
+		field.Time("issue_date").Default(time.Now),
+		field.Time("due_date"),
+		field.String("currency").Default("USD"),
+		field.Other("exchange_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,8)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(1)), // Document currency to functional at issue date
+		field.Other("subtotal", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("tax_total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		// amount_settled is what payments and credit notes have allocated to an invoice,
+		// or what a credit note has applied to invoices.
+		field.Other("amount_settled", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("notes").Optional(),
+		field.String("pdf_path").Optional(), // SENTvault path of the rendered document
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the Invoice.
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("number").Edges("tenant").Unique(),
+		index.Fields("status", "due_date"),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("invoices").Unique().Required(),
+		edge.From("customer", Customer.Type).Ref("invoices").Unique().Required(),
+		edge.To("lines", InvoiceLine.Type),
+		edge.To("tax_lines", InvoiceTaxLine.Type),
+		edge.To("transaction", Transaction.Type).Unique(), // The AR posting
+		edge.To("credit_notes", Invoice.Type).
+			From("original_invoice").
+			Unique(),
+		edge.To("allocations", PaymentAllocation.Type),         // Settlements of this invoice
+		edge.To("credit_applications", PaymentAllocation.Type), // Applications of this credit note
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceLine holds the schema definition for the InvoiceLine entity.
+type InvoiceLine struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceLine.
+func (InvoiceLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("description"),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(1)),
+		field.Other("unit_price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("tax_code").Optional(), // Tax code from pkg/tax, e.g. VAT-SA; empty means no tax
+		field.Other("tax_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(9,6)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("net_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Int("position").Default(0),
+	}
+}
+
+// Edges of the InvoiceLine.
+func (InvoiceLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("invoice", Invoice.Type).Ref("lines").Unique().Required(),
+		edge.To("account", Account.Type).Unique().Required(), // Revenue account credited
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceTaxLine holds the schema definition for the InvoiceTaxLine entity.
+// There is one tax line per tax code and rate on the invoice.
+type InvoiceTaxLine struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceTaxLine.
+func (InvoiceTaxLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tax_code"),
+		field.Other("rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(9,6)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("taxable_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("tax_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+	}
+}
+
+// Edges of the InvoiceTaxLine.
+func (InvoiceTaxLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("invoice", Invoice.Type).Ref("tax_lines").Unique().Required(),
+		edge.To("account", Account.Type).Unique().Required(), // Output tax liability account
+	}
+}
@@ -20,9 +20,12 @@ func (Job) Fields() []ent.Field {
 		field.String("cron_schedule").Optional(), // If empty, it's a one-time job (or manual)
 		field.Time("next_run").Optional(),
 		field.Time("last_run").Optional(),
-        // For MVP, we'll store targets as a JSON list of Agent IDs. 
-        // In future, this could be a relation to a 'Tag' or 'Group' entity.
-		field.JSON("targets", []string{}), 
+		// What the job runs on, resolved to agents each time it runs: "all", "agent:<id>"
+		// (or a bare agent ID), "group:<id>" or "tag:<name>".
+		field.JSON("targets", []string{}),
+		// Values for the script's parameters, by name.
+		field.JSON("parameters", map[string]string{}).Optional(),
+		field.Int("timeout_seconds").Default(600).Positive(),
         field.Time("created_at").Default(time.Now),
         field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
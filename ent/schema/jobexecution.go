@@ -16,8 +16,10 @@ type JobExecution struct {
 // Fields of the JobExecution.
 func (JobExecution) Fields() []ent.Field {
 	return []ent.Field{
-		field.Enum("status").Values("pending", "running", "success", "failed").Default("pending"),
+		field.Enum("status").Values("pending", "running", "success", "failed", "cancelled", "timeout").Default("pending"),
 		field.Text("output").Optional(), // Stdout/Stderr from the agent
+		field.Int("exit_code").Optional().Nillable(),
+		field.Bool("output_truncated").Default(false),
 		field.Time("started_at").Optional(),
 		field.Time("completed_at").Optional(),
         field.Time("created_at").Default(time.Now),
@@ -17,12 +17,28 @@ type JournalEntry struct {
 // Fields of the JournalEntry.
 func (JournalEntry) Fields() []ent.Field {
 	return []ent.Field{
+		// amount is always in the tenant's functional currency; the original
+		// transaction-currency figures are kept in currency/currency_amount.
 		field.Other("amount", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Enum("direction").Values("debit", "credit"),
+		field.String("currency").Default("USD"),
+		field.Other("currency_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("exchange_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,8)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(1)),
 		field.Time("created_at").Default(time.Now),
 		field.String("description").Optional(),
 		field.Enum("approval_status").
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// LabelTemplate holds the schema definition for the LabelTemplate entity.
+// A product label laid out in millimetres, rendered in the language of the printer it is sent
+// to or as a PDF sheet of A4 label stock.
+type LabelTemplate struct {
+	ent.Schema
+}
+
+// LabelElement is one text or barcode on a label. Content may name product fields in
+// placeholders, e.g. "{{name}}", "{{price}}", "{{lot}}" or "{{attr.color}}".
+type LabelElement struct {
+	Kind      string  `json:"kind"`                // text or barcode
+	X         float64 `json:"x"`                   // mm from the left edge
+	Y         float64 `json:"y"`                   // mm from the top edge
+	Height    float64 `json:"height"`              // Text height, barcode height, or side of a 2D code, in mm
+	Symbology string  `json:"symbology,omitempty"` // Barcodes: code128, ean13, qr or datamatrix
+	Content   string  `json:"content"`
+}
+
+// Fields of the LabelTemplate.
+func (LabelTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.Float("width_mm").Positive(),
+		field.Float("height_mm").Positive(),
+		field.JSON("elements", []LabelElement{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "jsonb",
+			}),
+		field.Bool("is_default").Default(false), // Used when a print job names no template
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the LabelTemplate.
+func (LabelTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("tenant").Unique(),
+	}
+}
+
+// Edges of the LabelTemplate.
+func (LabelTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("label_templates").Unique().Required(),
+	}
+}
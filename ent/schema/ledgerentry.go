@@ -18,12 +18,28 @@ type LedgerEntry struct {
 // Fields of the LedgerEntry.
 func (LedgerEntry) Fields() []ent.Field {
 	return []ent.Field{
+		// amount is always in the tenant's functional currency; the original
+		// transaction-currency figures are kept in currency/currency_amount.
 		field.Other("amount", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Enum("direction").Values("debit", "credit"),
+		field.String("currency").Default("USD"),
+		field.Other("currency_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("exchange_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,8)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(1)),
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -44,5 +60,6 @@ func (LedgerEntry) Edges() []ent.Edge {
 		edge.From("transaction", Transaction.Type).Ref("ledger_entries").Unique().Required(),
 		edge.From("account", Account.Type).Ref("entries").Unique().Required(),
 		edge.From("tenant", Tenant.Type).Ref("ledger_entries").Unique().Required(),
+		edge.From("bank_statement_line", BankStatementLine.Type).Ref("ledger_entry").Unique(),
 	}
 }
\ No newline at end of file
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// PatchPolicy holds the schema definition for the PatchPolicy entity.
+// Installs the pending patches of the agents it targets on a schedule. Its targets are
+// resolved each time it runs, like a job's.
+type PatchPolicy struct {
+	ent.Schema
+}
+
+// Fields of the PatchPolicy.
+func (PatchPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("description").Optional(),
+		// "all", "agent:<id>", "group:<id>" or "tag:<name>"
+		field.JSON("targets", []string{}),
+		field.String("cron_schedule").Optional(),        // Empty or "manual" to run only from the console
+		field.JSON("severities", []string{}).Optional(), // Only patches of these severities; all when empty
+		field.Bool("reboot").Default(false),             // Restart agents that installed patches
+		field.Bool("enabled").Default(true),
+		field.Time("next_run").Optional().Nillable(), // Unset while disabled or run by hand
+		field.Time("last_run").Optional().Nillable(),
+		field.String("created_by").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the PatchPolicy.
+func (PatchPolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("patch_policies").Unique().Required(),
+	}
+}
+
+// Indexes of the PatchPolicy.
+func (PatchPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("tenant").Unique(),
+	}
+}
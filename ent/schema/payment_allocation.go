@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// PaymentAllocation holds the schema definition for the PaymentAllocation entity.
+// It settles part of an invoice from either a customer payment or a credit note.
+type PaymentAllocation struct {
+	ent.Schema
+}
+
+// Fields of the PaymentAllocation.
+func (PaymentAllocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // In the invoice currency
+		field.Other("fx_difference", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Realized gain (+) or loss (-) in functional currency
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PaymentAllocation.
+func (PaymentAllocation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("invoice", Invoice.Type).Ref("allocations").Unique().Required(),
+		edge.From("payment", CustomerPayment.Type).Ref("allocations").Unique(),
+		edge.From("credit_note", Invoice.Type).Ref("credit_applications").Unique(),
+	}
+}
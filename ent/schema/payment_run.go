@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// PaymentRun holds the schema definition for the PaymentRun entity.
+// A run groups approved supplier bills paid together from one bank account.
+type PaymentRun struct {
+	ent.Schema
+}
+
+// Fields of the PaymentRun.
+func (PaymentRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number"),
+		field.Enum("status").
+			Values("proposed", "completed", "cancelled").
+			Default("proposed"),
+		field.Time("payment_date").Default(time.Now),
+		field.String("currency").Default("USD"),
+		field.Other("total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("file_path").Optional(), // Payment file in SENTvault
+		field.String("created_by").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("completed_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the PaymentRun.
+func (PaymentRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("payment_runs").Unique().Required(),
+		edge.To("bank_account", Account.Type).Unique().Required(),
+		edge.To("lines", PaymentRunLine.Type),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunLine holds the schema definition for the PaymentRunLine entity.
+type PaymentRunLine struct {
+	ent.Schema
+}
+
+// Fields of the PaymentRunLine.
+func (PaymentRunLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // In the bill currency
+		field.Other("fx_difference", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Realized gain (+) or loss (-) in functional currency
+	}
+}
+
+// Edges of the PaymentRunLine.
+func (PaymentRunLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", PaymentRun.Type).Ref("lines").Unique().Required(),
+		edge.From("bill", SupplierBill.Type).Ref("payment_lines").Unique().Required(),
+		edge.To("transaction", Transaction.Type).Unique(),
+	}
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PosSaleLine holds the schema definition for the PosSaleLine entity.
+// Prices are tax-inclusive; total is what the customer paid for the line after discounts,
+// split into net_amount and tax_amount. Lines of a refund point back at the line returned.
+type PosSaleLine struct {
+	ent.Schema
+}
+
+// Fields of the PosSaleLine.
+func (PosSaleLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("unit_price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("discount", decimal.Decimal{}). // Line discount plus its share of the basket discount
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("net_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("tax_code").Optional(), // Tax code from pkg/tax, e.g. VAT-JO; empty means no tax
+		field.Other("tax_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(9,6)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("tax_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("returned_quantity", decimal.Decimal{}). // On sale lines: how much has come back
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+	}
+}
+
+// Edges of the PosSaleLine.
+func (PosSaleLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("transaction", Transaction.Type).Ref("pos_lines").Unique().Required(),
+		edge.From("product", Product.Type).Ref("pos_sale_lines").Unique().Required(),
+		edge.To("returns", PosSaleLine.Type).From("returned_line").Unique(),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// PosShift holds the schema definition for the PosShift entity.
+// A cashier's session on a till, from the opening float to the blind count at close.
+type PosShift struct {
+	ent.Schema
+}
+
+// Fields of the PosShift.
+func (PosShift) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("cashier"),
+		field.String("cashier_email").Optional(),
+		field.Enum("status").
+			Values("open", "closed").
+			Default("open"),
+		field.Other("opening_float", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("expected_cash", decimal.Decimal{}). // Float plus cash taken less cash refunded, worked out at close
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("counted_cash", decimal.Decimal{}). // Blind count entered by the cashier
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Optional().
+			Nillable(),
+		field.Other("over_short", decimal.Decimal{}). // Counted less expected; negative is short
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Time("opened_at").Default(time.Now).Immutable(),
+		field.Time("closed_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the PosShift.
+func (PosShift) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("opened_at"),
+		index.Fields("status"),
+	}
+}
+
+// Edges of the PosShift.
+func (PosShift) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("pos_shifts").Unique().Required(),
+		edge.From("warehouse", Warehouse.Type).Ref("pos_shifts").Unique(),
+		edge.To("transactions", Transaction.Type), // Sales, refunds and the over/short posting
+	}
+}
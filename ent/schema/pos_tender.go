@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PosTender holds the schema definition for the PosTender entity.
+// One payment towards a POS sale, or one refund paid out.
+type PosTender struct {
+	ent.Schema
+}
+
+// Fields of the PosTender.
+func (PosTender) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("method"), // cash, card, mobile
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("reference").Optional(), // Card authorization or wallet payment ID
+	}
+}
+
+// Edges of the PosTender.
+func (PosTender) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("transaction", Transaction.Type).Ref("tenders").Unique().Required(),
+		edge.To("account", Account.Type).Unique().Required(), // Clearing account debited
+	}
+}
@@ -25,11 +25,13 @@ func (Product) Fields() []ent.Field {
 		field.Other("unit_cost", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Other("quantity", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.JSON("attributes", map[string]interface{}{}).
@@ -41,16 +43,44 @@ func (Product) Fields() []ent.Field {
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Int("min_stock_level").Default(0),
 		field.Int("max_stock_level").Default(0),
+		field.Int("reorder_point").Default(0), // Last computed by the reorder job
 		field.String("barcode").Optional().Unique(),
-		field.String("location").Optional(), // e.g. "Aisle 1, Bin B"
+		field.String("location").Optional(), // Free-text label; per-warehouse bins are on StockLevel
 		field.Bool("is_variant_parent").Default(false),
+		field.Other("weight", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // kg per unit, used to allocate landed costs by weight
+		// Goods sold loose by weight: quantities are kg
+		field.Bool("sold_by_weight").Default(false),
+		field.Other("price_per_kg", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Shelf price, tax included
+		field.Other("tare_weight", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // kg of the container weighed with the goods
+		field.String("plu").Optional(), // Item code in price- and weight-embedded barcodes
+		// VAT treatment: zero-rated and exempt goods carry no tax but are reported apart
+		field.Enum("tax_category").Values("standard", "zero_rated", "exempt").Default("standard"),
 		// Serial number tracking
 		field.String("serial_number").Optional().Unique(),
+		// Lot and expiry tracking
+		field.Bool("track_lots").Default(false),                  // Receipts must carry a lot number
+		field.Int("expiry_alert_days").Default(30).NonNegative(), // Alert this many days before a lot expires
 		// Depreciation fields
 		field.Time("purchase_date").Optional(),
 		field.Other("purchase_price", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Optional(),
 		field.Int("useful_life_months").Optional(),
@@ -67,6 +97,7 @@ func (Product) Fields() []ent.Field {
 func (Product) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("sku").Edges("tenant").Unique(),
+		index.Fields("plu").Edges("tenant").Unique(),
 		index.Fields("name"),
 		index.Fields("attributes").
 			Annotations(
@@ -92,5 +123,8 @@ func (Product) Edges() []ent.Edge {
 		edge.To("alerts", StockAlert.Type),
 		edge.To("purchase_order_lines", PurchaseOrderLine.Type),
 		edge.To("inventory_counts", InventoryCount.Type),
+		edge.To("stock_levels", StockLevel.Type),
+		edge.To("transfer_lines", TransferOrderLine.Type),
+		edge.To("pos_sale_lines", PosSaleLine.Type),
 	}
 }
\ No newline at end of file
@@ -17,15 +17,17 @@ type PurchaseOrder struct {
 func (PurchaseOrder) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("po_number").Unique(),
-		field.Enum("status").Values("draft", "submitted", "received", "cancelled").Default("draft"),
+		field.Enum("status").Values("draft", "submitted", "partially_received", "received", "cancelled").Default("draft"),
 		field.Time("order_date").Default(time.Now),
 		field.Time("expected_date").Optional(),
 		field.Other("total_amount", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.String("notes").Optional(),
+		field.Bool("auto_generated").Default(false), // Drafted by the reorder job for a buyer to review
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -36,5 +38,7 @@ func (PurchaseOrder) Edges() []ent.Edge {
 		edge.From("tenant", Tenant.Type).Ref("purchase_orders").Unique().Required(),
 		edge.From("supplier", Supplier.Type).Ref("purchase_orders").Unique().Required(),
 		edge.To("lines", PurchaseOrderLine.Type),
+		edge.To("bills", SupplierBill.Type),
+		edge.To("receipts", GoodsReceipt.Type),
 	}
 }
@@ -19,9 +19,11 @@ func (PurchaseOrderLine) Fields() []ent.Field {
 		field.Other("unit_cost", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Int("received_qty").Default(0),
+		field.Enum("status").Values("open", "partially_received", "closed").Default("open"),
 	}
 }
 
@@ -29,5 +31,7 @@ func (PurchaseOrderLine) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("purchase_order", PurchaseOrder.Type).Ref("lines").Unique().Required(),
 		edge.From("product", Product.Type).Ref("purchase_order_lines").Unique().Required(),
+		edge.To("bill_lines", SupplierBillLine.Type),
+		edge.To("receipt_lines", GoodsReceiptLine.Type),
 	}
 }
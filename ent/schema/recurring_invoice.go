@@ -21,6 +21,7 @@ func (RecurringInvoice) Fields() []ent.Field {
 		field.Other("amount", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.String("currency").Default("USD"),
@@ -37,5 +38,6 @@ func (RecurringInvoice) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("tenant", Tenant.Type).Ref("recurring_invoices").Unique().Required(),
 		edge.From("account", Account.Type).Ref("recurring_invoices").Unique().Required(), // The revenue account
+		edge.To("customer", Customer.Type).Unique(),                                     // Billed customer; issues a full AR invoice when set
 	}
 }
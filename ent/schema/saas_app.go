@@ -26,6 +26,7 @@ func (SaaSApp) Fields() []ent.Field {
 		field.Other("monthly_price", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero).
 			Optional(),
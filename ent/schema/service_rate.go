@@ -20,6 +20,7 @@ func (ServiceRate) Fields() []ent.Field {
 		field.Other("rate", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.String("description").Optional(),
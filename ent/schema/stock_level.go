@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// StockLevel holds the schema definition for the StockLevel entity.
+// It is the quantity of a product on hand in one bin of one warehouse. Product.quantity is the
+// company-wide total: the sum of all levels plus stock in transit between warehouses.
+// Lot-tracked products have one level per lot so picking can go first-expired-first-out.
+type StockLevel struct {
+	ent.Schema
+}
+
+// Fields of the StockLevel.
+func (StockLevel) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("bin").Default(""), // Empty is the warehouse's unassigned area
+		field.String("lot_number").Default(""),
+		field.Time("expiry_date").Optional().Nillable(),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the StockLevel.
+func (StockLevel) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bin", "lot_number").Edges("product", "warehouse").Unique(),
+		index.Fields("expiry_date"),
+	}
+}
+
+// Edges of the StockLevel.
+func (StockLevel) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("stock_levels").Unique().Required(),
+		edge.From("product", Product.Type).Ref("stock_levels").Unique().Required(),
+		edge.From("warehouse", Warehouse.Type).Ref("stock_levels").Unique().Required(),
+	}
+}
@@ -14,8 +14,9 @@ type StockAlert struct {
 
 func (StockAlert) Fields() []ent.Field {
 	return []ent.Field{
-		field.Enum("alert_type").Values("low_stock", "warranty_expiring", "maintenance_due"),
+		field.Enum("alert_type").Values("low_stock", "warranty_expiring", "maintenance_due", "lot_expiring", "lot_expired"),
 		field.String("message"),
+		field.String("lot_number").Optional(),
 		field.Bool("is_read").Default(false),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -21,23 +21,30 @@ func (StockMovement) Fields() []ent.Field {
 		field.Other("quantity", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Enum("movement_type").Values("incoming", "outgoing", "manual"),
 		field.String("reason").Optional(),
+		field.String("bin").Optional(),
+		field.String("lot_number").Optional(),
+		field.Time("expiry_date").Optional().Nillable(),
 		field.Other("unit_cost", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Optional(),
 		field.Other("remaining_quantity", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Optional(),
 		field.Other("calculated_cogs", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Optional(),
 		field.JSON("metadata", map[string]interface{}{}).Optional(),
@@ -52,6 +59,7 @@ func (StockMovement) Indexes() []ent.Index {
 		index.Edges("product"),
 		index.Edges("tenant"),
 		index.Fields("movement_type"),
+		index.Fields("lot_number"),
 	}
 }
 
@@ -60,5 +68,8 @@ func (StockMovement) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("product", Product.Type).Ref("movements").Unique().Required(),
 		edge.From("tenant", Tenant.Type).Ref("stock_movements").Unique().Required(),
+		edge.From("warehouse", Warehouse.Type).Ref("movements").Unique(),
+		edge.From("transfer", TransferOrder.Type).Ref("movements").Unique(), // Set on transfer legs, which FIFO costing ignores
+		edge.From("transaction", Transaction.Type).Ref("stock_movements").Unique(), // The sale that moved the stock
 	}
 }
\ No newline at end of file
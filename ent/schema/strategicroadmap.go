@@ -24,6 +24,7 @@ func (StrategicRoadmap) Fields() []ent.Field {
 		field.Other("estimated_cost", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Time("target_date"),
@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // Supplier holds the schema definition for the Supplier entity.
@@ -20,6 +22,25 @@ func (Supplier) Fields() []ent.Field {
 		field.String("phone").Optional(),
 		field.String("address").Optional(),
 		field.String("website").Optional(),
+		field.String("tax_id").Optional(),
+		field.String("country_code").Optional(), // ISO 3166-1 alpha-2, drives the default input tax code
+		field.String("currency").Optional(),     // Billing currency; empty means the tenant's functional currency
+		field.Int("payment_terms_days").Default(30),
+		field.Int("lead_time_days").Default(7).NonNegative(), // Order to delivery, used for reorder points
+		field.String("bank_name").Optional(),
+		field.String("bank_account").Optional(), // IBAN or account number used in payment files
+		field.Other("price_tolerance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(9,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(2)), // Percent a billed unit price may exceed the ordered cost
+		field.Other("quantity_tolerance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(9,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Percent billed quantity may exceed the received quantity
 	}
 }
 
@@ -29,5 +50,6 @@ func (Supplier) Edges() []ent.Edge {
 		edge.From("tenant", Tenant.Type).Ref("suppliers").Unique().Required(),
 		edge.To("products", Product.Type),
 		edge.To("purchase_orders", PurchaseOrder.Type),
+		edge.To("bills", SupplierBill.Type),
 	}
 }
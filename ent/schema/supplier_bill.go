@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// SupplierBill holds the schema definition for the SupplierBill entity.
+// A bill is matched against its purchase order and goods receipts before it may be approved
+// and posted to accounts payable.
+type SupplierBill struct {
+	ent.Schema
+}
+
+// Fields of the SupplierBill.
+func (SupplierBill) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number"),             // Internal reference, BILL-00001
+		field.String("supplier_reference"), // The supplier's invoice number
+		field.Enum("status").
+			Values("exception", "matched", "approved", "paid", "void").
+			Default("exception"),
+		field.Time("bill_date").Default(time.Now),
+		field.Time("due_date"),
+		field.String("currency").Default("USD"),
+		field.Other("exchange_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,8)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(1)),
+		field.Other("subtotal", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("tax_total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("amount_paid", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("notes").Optional(),
+		field.String("approved_by").Optional(),
+		field.Time("approved_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the SupplierBill.
+func (SupplierBill) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("number").Edges("tenant").Unique(),
+		index.Fields("status"),
+	}
+}
+
+// Edges of the SupplierBill.
+func (SupplierBill) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("supplier_bills").Unique().Required(),
+		edge.From("supplier", Supplier.Type).Ref("bills").Unique().Required(),
+		edge.From("purchase_order", PurchaseOrder.Type).Ref("bills").Unique(),
+		edge.To("lines", SupplierBillLine.Type),
+		edge.To("transaction", Transaction.Type).Unique(),
+		edge.To("payment_lines", PaymentRunLine.Type),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierBillLine holds the schema definition for the SupplierBillLine entity.
+// Lines referencing a purchase order line carry the outcome of the three-way match.
+type SupplierBillLine struct {
+	ent.Schema
+}
+
+// Fields of the SupplierBillLine.
+func (SupplierBillLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("description"),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(1)),
+		field.Other("unit_price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("tax_code").Optional(),
+		field.Other("tax_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(9,6)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Other("net_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.Enum("match_status").
+			Values("matched", "variance", "no_order").
+			Default("no_order"),
+		field.Other("quantity_variance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // Billed beyond received, in units
+		field.Other("price_variance", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // (billed - ordered unit cost) x quantity, in functional currency
+		field.String("match_note").Optional(),
+		field.Int("position").Default(0),
+	}
+}
+
+// Edges of the SupplierBillLine.
+func (SupplierBillLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("bill", SupplierBill.Type).Ref("lines").Unique().Required(),
+		edge.From("purchase_order_line", PurchaseOrderLine.Type).Ref("bill_lines").Unique(),
+		edge.To("account", Account.Type).Unique(), // Expense account for lines without an order
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// TaxJurisdiction holds the schema definition for the TaxJurisdiction entity.
+// A tax authority the tenant charges VAT for, usually a country. Its code is the one in tax
+// codes, e.g. JO in VAT-JO, and its rates change over time.
+type TaxJurisdiction struct {
+	ent.Schema
+}
+
+// Fields of the TaxJurisdiction.
+func (TaxJurisdiction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").NotEmpty(), // ISO 3166 alpha-2, upper case
+		field.String("name").NotEmpty(),
+		// How the authority rounds tax to the currency's smallest unit
+		field.Enum("rounding").Values("half_up", "half_even").Default("half_up"),
+		field.Bool("is_active").Default(true), // Inactive jurisdictions reject new documents
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the TaxJurisdiction.
+func (TaxJurisdiction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").Edges("tenant").Unique(),
+	}
+}
+
+// Edges of the TaxJurisdiction.
+func (TaxJurisdiction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("tax_jurisdictions").Unique().Required(),
+		edge.To("rates", TaxRate.Type),
+	}
+}
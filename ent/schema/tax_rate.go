@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// TaxRate holds the schema definition for the TaxRate entity.
+// The standard rate of a jurisdiction from a date until the next rate takes over. Zero-rated
+// and exempt goods carry no tax whatever the rate.
+type TaxRate struct {
+	ent.Schema
+}
+
+// Fields of the TaxRate.
+func (TaxRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Other("rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(9,6)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero), // 0.16 for 16%
+		field.Time("effective_from"),
+		field.String("notes").Optional(), // E.g. the decree that set the rate
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the TaxRate.
+func (TaxRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("effective_from").Edges("jurisdiction").Unique(),
+	}
+}
+
+// Edges of the TaxRate.
+func (TaxRate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("jurisdiction", TaxJurisdiction.Type).Ref("rates").Unique().Required(),
+	}
+}
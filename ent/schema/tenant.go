@@ -24,8 +24,13 @@ func (Tenant) Fields() []ent.Field {
 		field.Other("transaction_limit", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.NewFromFloat(1000.0)),
+		field.String("functional_currency").Default("USD"), // ISO 4217 code all ledger amounts are reported in
+		field.Int("fiscal_year_start_month").Default(1).Min(1).Max(12),
+		field.String("tax_number").Optional(), // VAT registration number printed on invoices and receipts
+		field.Bytes("logo").Optional(),        // PNG or JPEG printed at the top of receipts
 	}
 }
 
@@ -68,6 +73,20 @@ func (Tenant) Edges() []ent.Edge {
 		edge.To("vault_share_links", VaultShareLink.Type),
 		edge.To("journal_entries", JournalEntry.Type),
 		edge.To("recurring_invoices", RecurringInvoice.Type),
+		edge.To("exchange_rates", ExchangeRate.Type),
+		edge.To("fiscal_periods", FiscalPeriod.Type),
+		edge.To("balance_snapshots", AccountBalanceSnapshot.Type),
+		edge.To("bank_statements", BankStatement.Type),
+		edge.To("bank_statement_lines", BankStatementLine.Type),
+		edge.To("bank_rules", BankRule.Type),
+		edge.To("customers", Customer.Type),
+		edge.To("invoices", Invoice.Type),
+		edge.To("customer_payments", CustomerPayment.Type),
+		edge.To("supplier_bills", SupplierBill.Type),
+		edge.To("payment_runs", PaymentRun.Type),
+		edge.To("goods_receipts", GoodsReceipt.Type),
+		edge.To("stock_levels", StockLevel.Type),
+		edge.To("transfer_orders", TransferOrder.Type),
 		edge.To("inventory_reservations", InventoryReservation.Type),
 		edge.To("departments", Department.Type),
 		edge.To("permissions", Permission.Type),
@@ -109,5 +128,15 @@ func (Tenant) Edges() []ent.Edge {
 		edge.To("interviews", Interview.Type),
 		edge.To("benefit_plans", BenefitPlan.Type),
 		edge.To("benefit_enrollments", BenefitEnrollment.Type),
+		edge.To("pos_shifts", PosShift.Type),
+		edge.To("label_templates", LabelTemplate.Type),
+		edge.To("tax_jurisdictions", TaxJurisdiction.Type),
+		edge.To("einvoice_credentials", EInvoiceCredential.Type),
+		edge.To("einvoices", EInvoice.Type),
+		edge.To("agent_enrollment_tokens", AgentEnrollmentToken.Type),
+		edge.To("agent_authority", AgentAuthority.Type).Unique(),
+		edge.To("device_groups", DeviceGroup.Type),
+		edge.To("patch_policies", PatchPolicy.Type),
+		edge.To("alert_rules", AlertRule.Type),
 	}
 }
@@ -16,6 +16,15 @@ type Transaction struct {
 	ent.Schema
 }
 
+// ClearanceMessage is a validation result a tax authority's portal returned for the
+// e-invoice of a transaction.
+type ClearanceMessage struct {
+	Type     string `json:"type"` // error, warning or info
+	Code     string `json:"code"`
+	Category string `json:"category,omitempty"`
+	Message  string `json:"message"`
+}
+
 // Fields of the Transaction.
 func (Transaction) Fields() []ent.Field {
 	return []ent.Field{
@@ -24,13 +33,22 @@ func (Transaction) Fields() []ent.Field {
 		field.Other("total_amount", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
 		field.Other("tax_amount", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
 			}).
 			Default(decimal.Zero),
+		field.String("currency").Default("USD"),
+		field.Other("exchange_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,8)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.NewFromInt(1)),
 		field.String("type").Optional(), // sale, refund, manual_adjustment
 		field.String("reference").Optional(),
 		field.String("uuid").DefaultFunc(func() string { return uuid.New().String() }).Unique(),
@@ -39,6 +57,16 @@ func (Transaction) Fields() []ent.Field {
 			Values("PENDING", "STAGED", "APPROVED", "REJECTED").
 			Default("APPROVED"),
 		field.Bool("is_intercompany").Default(false),
+		field.Enum("clearance_status").
+			Values("pending", "cleared", "reported", "rejected", "failed").
+			Optional().
+			Nillable(), // E-invoice submission; nil when the transaction has no e-invoice
+		field.JSON("clearance_messages", []ClearanceMessage{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+		field.Time("cleared_at").Optional().Nillable(),
 	}
 }
 
@@ -49,6 +77,7 @@ func (Transaction) Indexes() []ent.Index {
 		index.Fields("date"),
 		index.Fields("type"),
 		index.Fields("approval_status"),
+		index.Fields("clearance_status"),
 	}
 }
 
@@ -60,5 +89,11 @@ func (Transaction) Edges() []ent.Edge {
 		edge.To("journal_entries", JournalEntry.Type),
 		edge.To("recording", Recording.Type).Unique().Field("recording_id"),
 		edge.To("approved_by", User.Type).Unique(),
+		edge.To("stock_movements", StockMovement.Type),
+		edge.From("customer", Customer.Type).Ref("sales").Unique(), // Known customer on a POS sale
+		edge.To("pos_lines", PosSaleLine.Type),
+		edge.To("tenders", PosTender.Type),
+		edge.From("shift", PosShift.Type).Ref("transactions").Unique(),
+		edge.To("refunds", Transaction.Type).From("refunded_sale").Unique(), // POS refunds of this sale
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// TransferOrder holds the schema definition for the TransferOrder entity.
+// Shipping takes the stock out of the source warehouse; it is in transit until received.
+type TransferOrder struct {
+	ent.Schema
+}
+
+// Fields of the TransferOrder.
+func (TransferOrder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number"), // TRF-00001
+		field.Enum("status").
+			Values("draft", "in_transit", "received", "cancelled").
+			Default("draft"),
+		field.String("notes").Optional(),
+		field.String("created_by").Optional(),
+		field.Time("shipped_at").Optional().Nillable(),
+		field.Time("received_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the TransferOrder.
+func (TransferOrder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("number").Edges("tenant").Unique(),
+	}
+}
+
+// Edges of the TransferOrder.
+func (TransferOrder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("transfer_orders").Unique().Required(),
+		edge.From("source", Warehouse.Type).Ref("transfers_out").Unique().Required(),
+		edge.From("destination", Warehouse.Type).Ref("transfers_in").Unique().Required(),
+		edge.To("lines", TransferOrderLine.Type),
+		edge.To("movements", StockMovement.Type),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// TransferOrderLine holds the schema definition for the TransferOrderLine entity.
+type TransferOrderLine struct {
+	ent.Schema
+}
+
+// Fields of the TransferOrderLine.
+func (TransferOrderLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "numeric(19,4)",
+				dialect.SQLite:   "numeric",
+			}).
+			Default(decimal.Zero),
+		field.String("from_bin").Optional(), // Empty means any bin, unassigned area first
+		field.String("to_bin").Optional(),
+		field.String("lot_number").Optional(), // Ship only this lot; empty picks first-expired-first-out
+	}
+}
+
+// Edges of the TransferOrderLine.
+func (TransferOrderLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("transfer", TransferOrder.Type).Ref("lines").Unique().Required(),
+		edge.From("product", Product.Type).Ref("transfer_lines").Unique().Required(),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Warehouse holds the schema definition for the Warehouse entity.
@@ -15,16 +16,30 @@ type Warehouse struct {
 func (Warehouse) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").NotEmpty(),
-		field.String("location_code").Unique(), // e.g. WH-01
+		field.String("location_code"), // e.g. WH-01, unique per tenant
 		field.String("address").Optional(),
 		field.Bool("is_active").Default(true),
 	}
 }
 
+// Indexes of the Warehouse.
+func (Warehouse) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("location_code").Edges("tenant").Unique(),
+	}
+}
+
 // Edges of the Warehouse.
 func (Warehouse) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("tenant", Tenant.Type).Ref("warehouses").Unique().Required(),
 		edge.To("products", Product.Type),
+		edge.To("goods_receipts", GoodsReceipt.Type),
+		edge.To("stock_levels", StockLevel.Type),
+		edge.To("movements", StockMovement.Type),
+		edge.To("reservations", InventoryReservation.Type),
+		edge.To("transfers_out", TransferOrder.Type),
+		edge.To("transfers_in", TransferOrder.Type),
+		edge.To("pos_shifts", PosShift.Type),
 	}
 }
@@ -23,6 +23,8 @@ type StockAlert struct {
 	AlertType stockalert.AlertType `json:"alert_type,omitempty"`
 	// Message holds the value of the "message" field.
 	Message string `json:"message,omitempty"`
+	// LotNumber holds the value of the "lot_number" field.
+	LotNumber string `json:"lot_number,omitempty"`
 	// IsRead holds the value of the "is_read" field.
 	IsRead bool `json:"is_read,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -77,7 +79,7 @@ func (*StockAlert) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case stockalert.FieldID:
 			values[i] = new(sql.NullInt64)
-		case stockalert.FieldAlertType, stockalert.FieldMessage:
+		case stockalert.FieldAlertType, stockalert.FieldMessage, stockalert.FieldLotNumber:
 			values[i] = new(sql.NullString)
 		case stockalert.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -118,6 +120,12 @@ func (_m *StockAlert) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Message = value.String
 			}
+		case stockalert.FieldLotNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_number", values[i])
+			} else if value.Valid {
+				_m.LotNumber = value.String
+			}
 		case stockalert.FieldIsRead:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_read", values[i])
@@ -196,6 +204,9 @@ func (_m *StockAlert) String() string {
 	builder.WriteString("message=")
 	builder.WriteString(_m.Message)
 	builder.WriteString(", ")
+	builder.WriteString("lot_number=")
+	builder.WriteString(_m.LotNumber)
+	builder.WriteString(", ")
 	builder.WriteString("is_read=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsRead))
 	builder.WriteString(", ")
@@ -19,6 +19,8 @@ const (
 	FieldAlertType = "alert_type"
 	// FieldMessage holds the string denoting the message field in the database.
 	FieldMessage = "message"
+	// FieldLotNumber holds the string denoting the lot_number field in the database.
+	FieldLotNumber = "lot_number"
 	// FieldIsRead holds the string denoting the is_read field in the database.
 	FieldIsRead = "is_read"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -50,6 +52,7 @@ var Columns = []string{
 	FieldID,
 	FieldAlertType,
 	FieldMessage,
+	FieldLotNumber,
 	FieldIsRead,
 	FieldCreatedAt,
 }
@@ -91,6 +94,8 @@ const (
 	AlertTypeLowStock         AlertType = "low_stock"
 	AlertTypeWarrantyExpiring AlertType = "warranty_expiring"
 	AlertTypeMaintenanceDue   AlertType = "maintenance_due"
+	AlertTypeLotExpiring      AlertType = "lot_expiring"
+	AlertTypeLotExpired       AlertType = "lot_expired"
 )
 
 func (at AlertType) String() string {
@@ -100,7 +105,7 @@ func (at AlertType) String() string {
 // AlertTypeValidator is a validator for the "alert_type" field enum values. It is called by the builders before save.
 func AlertTypeValidator(at AlertType) error {
 	switch at {
-	case AlertTypeLowStock, AlertTypeWarrantyExpiring, AlertTypeMaintenanceDue:
+	case AlertTypeLowStock, AlertTypeWarrantyExpiring, AlertTypeMaintenanceDue, AlertTypeLotExpiring, AlertTypeLotExpired:
 		return nil
 	default:
 		return fmt.Errorf("stockalert: invalid enum value for alert_type field: %q", at)
@@ -125,6 +130,11 @@ func ByMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessage, opts...).ToFunc()
 }
 
+// ByLotNumber orders the results by the lot_number field.
+func ByLotNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLotNumber, opts...).ToFunc()
+}
+
 // ByIsRead orders the results by the is_read field.
 func ByIsRead(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsRead, opts...).ToFunc()
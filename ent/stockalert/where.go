@@ -60,6 +60,11 @@ func Message(v string) predicate.StockAlert {
 	return predicate.StockAlert(sql.FieldEQ(FieldMessage, v))
 }
 
+// LotNumber applies equality check predicate on the "lot_number" field. It's identical to LotNumberEQ.
+func LotNumber(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldEQ(FieldLotNumber, v))
+}
+
 // IsRead applies equality check predicate on the "is_read" field. It's identical to IsReadEQ.
 func IsRead(v bool) predicate.StockAlert {
 	return predicate.StockAlert(sql.FieldEQ(FieldIsRead, v))
@@ -155,6 +160,81 @@ func MessageContainsFold(v string) predicate.StockAlert {
 	return predicate.StockAlert(sql.FieldContainsFold(FieldMessage, v))
 }
 
+// LotNumberEQ applies the EQ predicate on the "lot_number" field.
+func LotNumberEQ(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldEQ(FieldLotNumber, v))
+}
+
+// LotNumberNEQ applies the NEQ predicate on the "lot_number" field.
+func LotNumberNEQ(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldNEQ(FieldLotNumber, v))
+}
+
+// LotNumberIn applies the In predicate on the "lot_number" field.
+func LotNumberIn(vs ...string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldIn(FieldLotNumber, vs...))
+}
+
+// LotNumberNotIn applies the NotIn predicate on the "lot_number" field.
+func LotNumberNotIn(vs ...string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldNotIn(FieldLotNumber, vs...))
+}
+
+// LotNumberGT applies the GT predicate on the "lot_number" field.
+func LotNumberGT(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldGT(FieldLotNumber, v))
+}
+
+// LotNumberGTE applies the GTE predicate on the "lot_number" field.
+func LotNumberGTE(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldGTE(FieldLotNumber, v))
+}
+
+// LotNumberLT applies the LT predicate on the "lot_number" field.
+func LotNumberLT(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldLT(FieldLotNumber, v))
+}
+
+// LotNumberLTE applies the LTE predicate on the "lot_number" field.
+func LotNumberLTE(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldLTE(FieldLotNumber, v))
+}
+
+// LotNumberContains applies the Contains predicate on the "lot_number" field.
+func LotNumberContains(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldContains(FieldLotNumber, v))
+}
+
+// LotNumberHasPrefix applies the HasPrefix predicate on the "lot_number" field.
+func LotNumberHasPrefix(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldHasPrefix(FieldLotNumber, v))
+}
+
+// LotNumberHasSuffix applies the HasSuffix predicate on the "lot_number" field.
+func LotNumberHasSuffix(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldHasSuffix(FieldLotNumber, v))
+}
+
+// LotNumberIsNil applies the IsNil predicate on the "lot_number" field.
+func LotNumberIsNil() predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldIsNull(FieldLotNumber))
+}
+
+// LotNumberNotNil applies the NotNil predicate on the "lot_number" field.
+func LotNumberNotNil() predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldNotNull(FieldLotNumber))
+}
+
+// LotNumberEqualFold applies the EqualFold predicate on the "lot_number" field.
+func LotNumberEqualFold(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldEqualFold(FieldLotNumber, v))
+}
+
+// LotNumberContainsFold applies the ContainsFold predicate on the "lot_number" field.
+func LotNumberContainsFold(v string) predicate.StockAlert {
+	return predicate.StockAlert(sql.FieldContainsFold(FieldLotNumber, v))
+}
+
 // IsReadEQ applies the EQ predicate on the "is_read" field.
 func IsReadEQ(v bool) predicate.StockAlert {
 	return predicate.StockAlert(sql.FieldEQ(FieldIsRead, v))
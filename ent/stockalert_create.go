@@ -34,6 +34,20 @@ func (_c *StockAlertCreate) SetMessage(v string) *StockAlertCreate {
 	return _c
 }
 
+// SetLotNumber sets the "lot_number" field.
+func (_c *StockAlertCreate) SetLotNumber(v string) *StockAlertCreate {
+	_c.mutation.SetLotNumber(v)
+	return _c
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_c *StockAlertCreate) SetNillableLotNumber(v *string) *StockAlertCreate {
+	if v != nil {
+		_c.SetLotNumber(*v)
+	}
+	return _c
+}
+
 // SetIsRead sets the "is_read" field.
 func (_c *StockAlertCreate) SetIsRead(v bool) *StockAlertCreate {
 	_c.mutation.SetIsRead(v)
@@ -193,6 +207,10 @@ func (_c *StockAlertCreate) createSpec() (*StockAlert, *sqlgraph.CreateSpec) {
 		_spec.SetField(stockalert.FieldMessage, field.TypeString, value)
 		_node.Message = value
 	}
+	if value, ok := _c.mutation.LotNumber(); ok {
+		_spec.SetField(stockalert.FieldLotNumber, field.TypeString, value)
+		_node.LotNumber = value
+	}
 	if value, ok := _c.mutation.IsRead(); ok {
 		_spec.SetField(stockalert.FieldIsRead, field.TypeBool, value)
 		_node.IsRead = value
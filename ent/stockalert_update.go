@@ -58,6 +58,26 @@ func (_u *StockAlertUpdate) SetNillableMessage(v *string) *StockAlertUpdate {
 	return _u
 }
 
+// SetLotNumber sets the "lot_number" field.
+func (_u *StockAlertUpdate) SetLotNumber(v string) *StockAlertUpdate {
+	_u.mutation.SetLotNumber(v)
+	return _u
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_u *StockAlertUpdate) SetNillableLotNumber(v *string) *StockAlertUpdate {
+	if v != nil {
+		_u.SetLotNumber(*v)
+	}
+	return _u
+}
+
+// ClearLotNumber clears the value of the "lot_number" field.
+func (_u *StockAlertUpdate) ClearLotNumber() *StockAlertUpdate {
+	_u.mutation.ClearLotNumber()
+	return _u
+}
+
 // SetIsRead sets the "is_read" field.
 func (_u *StockAlertUpdate) SetIsRead(v bool) *StockAlertUpdate {
 	_u.mutation.SetIsRead(v)
@@ -183,6 +203,12 @@ func (_u *StockAlertUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(stockalert.FieldMessage, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.LotNumber(); ok {
+		_spec.SetField(stockalert.FieldLotNumber, field.TypeString, value)
+	}
+	if _u.mutation.LotNumberCleared() {
+		_spec.ClearField(stockalert.FieldLotNumber, field.TypeString)
+	}
 	if value, ok := _u.mutation.IsRead(); ok {
 		_spec.SetField(stockalert.FieldIsRead, field.TypeBool, value)
 	}
@@ -294,6 +320,26 @@ func (_u *StockAlertUpdateOne) SetNillableMessage(v *string) *StockAlertUpdateOn
 	return _u
 }
 
+// SetLotNumber sets the "lot_number" field.
+func (_u *StockAlertUpdateOne) SetLotNumber(v string) *StockAlertUpdateOne {
+	_u.mutation.SetLotNumber(v)
+	return _u
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_u *StockAlertUpdateOne) SetNillableLotNumber(v *string) *StockAlertUpdateOne {
+	if v != nil {
+		_u.SetLotNumber(*v)
+	}
+	return _u
+}
+
+// ClearLotNumber clears the value of the "lot_number" field.
+func (_u *StockAlertUpdateOne) ClearLotNumber() *StockAlertUpdateOne {
+	_u.mutation.ClearLotNumber()
+	return _u
+}
+
 // SetIsRead sets the "is_read" field.
 func (_u *StockAlertUpdateOne) SetIsRead(v bool) *StockAlertUpdateOne {
 	_u.mutation.SetIsRead(v)
@@ -449,6 +495,12 @@ func (_u *StockAlertUpdateOne) sqlSave(ctx context.Context) (_node *StockAlert,
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(stockalert.FieldMessage, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.LotNumber(); ok {
+		_spec.SetField(stockalert.FieldLotNumber, field.TypeString, value)
+	}
+	if _u.mutation.LotNumberCleared() {
+		_spec.ClearField(stockalert.FieldLotNumber, field.TypeString)
+	}
 	if value, ok := _u.mutation.IsRead(); ok {
 		_spec.SetField(stockalert.FieldIsRead, field.TypeBool, value)
 	}
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/product"
+	"sent/ent/stocklevel"
+	"sent/ent/tenant"
+	"sent/ent/warehouse"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// StockLevel is the model entity for the StockLevel schema.
+type StockLevel struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Bin holds the value of the "bin" field.
+	Bin string `json:"bin,omitempty"`
+	// LotNumber holds the value of the "lot_number" field.
+	LotNumber string `json:"lot_number,omitempty"`
+	// ExpiryDate holds the value of the "expiry_date" field.
+	ExpiryDate *time.Time `json:"expiry_date,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StockLevelQuery when eager-loading is set.
+	Edges                  StockLevelEdges `json:"edges"`
+	product_stock_levels   *int
+	tenant_stock_levels    *int
+	warehouse_stock_levels *int
+	selectValues           sql.SelectValues
+}
+
+// StockLevelEdges holds the relations/edges for other nodes in the graph.
+type StockLevelEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// Warehouse holds the value of the warehouse edge.
+	Warehouse *Warehouse `json:"warehouse,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockLevelEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockLevelEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// WarehouseOrErr returns the Warehouse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockLevelEdges) WarehouseOrErr() (*Warehouse, error) {
+	if e.Warehouse != nil {
+		return e.Warehouse, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "warehouse"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockLevel) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stocklevel.FieldQuantity:
+			values[i] = new(decimal.Decimal)
+		case stocklevel.FieldID:
+			values[i] = new(sql.NullInt64)
+		case stocklevel.FieldBin, stocklevel.FieldLotNumber:
+			values[i] = new(sql.NullString)
+		case stocklevel.FieldExpiryDate, stocklevel.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case stocklevel.ForeignKeys[0]: // product_stock_levels
+			values[i] = new(sql.NullInt64)
+		case stocklevel.ForeignKeys[1]: // tenant_stock_levels
+			values[i] = new(sql.NullInt64)
+		case stocklevel.ForeignKeys[2]: // warehouse_stock_levels
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockLevel fields.
+func (_m *StockLevel) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stocklevel.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case stocklevel.FieldBin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bin", values[i])
+			} else if value.Valid {
+				_m.Bin = value.String
+			}
+		case stocklevel.FieldLotNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_number", values[i])
+			} else if value.Valid {
+				_m.LotNumber = value.String
+			}
+		case stocklevel.FieldExpiryDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_date", values[i])
+			} else if value.Valid {
+				_m.ExpiryDate = new(time.Time)
+				*_m.ExpiryDate = value.Time
+			}
+		case stocklevel.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case stocklevel.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case stocklevel.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field product_stock_levels", value)
+			} else if value.Valid {
+				_m.product_stock_levels = new(int)
+				*_m.product_stock_levels = int(value.Int64)
+			}
+		case stocklevel.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_stock_levels", value)
+			} else if value.Valid {
+				_m.tenant_stock_levels = new(int)
+				*_m.tenant_stock_levels = int(value.Int64)
+			}
+		case stocklevel.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_stock_levels", value)
+			} else if value.Valid {
+				_m.warehouse_stock_levels = new(int)
+				*_m.warehouse_stock_levels = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StockLevel.
+// This includes values selected through modifiers, order, etc.
+func (_m *StockLevel) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the StockLevel entity.
+func (_m *StockLevel) QueryTenant() *TenantQuery {
+	return NewStockLevelClient(_m.config).QueryTenant(_m)
+}
+
+// QueryProduct queries the "product" edge of the StockLevel entity.
+func (_m *StockLevel) QueryProduct() *ProductQuery {
+	return NewStockLevelClient(_m.config).QueryProduct(_m)
+}
+
+// QueryWarehouse queries the "warehouse" edge of the StockLevel entity.
+func (_m *StockLevel) QueryWarehouse() *WarehouseQuery {
+	return NewStockLevelClient(_m.config).QueryWarehouse(_m)
+}
+
+// Update returns a builder for updating this StockLevel.
+// Note that you need to call StockLevel.Unwrap() before calling this method if this StockLevel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StockLevel) Update() *StockLevelUpdateOne {
+	return NewStockLevelClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StockLevel entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StockLevel) Unwrap() *StockLevel {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockLevel is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StockLevel) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockLevel(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("bin=")
+	builder.WriteString(_m.Bin)
+	builder.WriteString(", ")
+	builder.WriteString("lot_number=")
+	builder.WriteString(_m.LotNumber)
+	builder.WriteString(", ")
+	if v := _m.ExpiryDate; v != nil {
+		builder.WriteString("expiry_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockLevels is a parsable slice of StockLevel.
+type StockLevels []*StockLevel
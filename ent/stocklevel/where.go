@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stocklevel
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLTE(FieldID, id))
+}
+
+// Bin applies equality check predicate on the "bin" field. It's identical to BinEQ.
+func Bin(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldBin, v))
+}
+
+// LotNumber applies equality check predicate on the "lot_number" field. It's identical to LotNumberEQ.
+func LotNumber(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldLotNumber, v))
+}
+
+// ExpiryDate applies equality check predicate on the "expiry_date" field. It's identical to ExpiryDateEQ.
+func ExpiryDate(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldExpiryDate, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// BinEQ applies the EQ predicate on the "bin" field.
+func BinEQ(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldBin, v))
+}
+
+// BinNEQ applies the NEQ predicate on the "bin" field.
+func BinNEQ(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNEQ(FieldBin, v))
+}
+
+// BinIn applies the In predicate on the "bin" field.
+func BinIn(vs ...string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldIn(FieldBin, vs...))
+}
+
+// BinNotIn applies the NotIn predicate on the "bin" field.
+func BinNotIn(vs ...string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNotIn(FieldBin, vs...))
+}
+
+// BinGT applies the GT predicate on the "bin" field.
+func BinGT(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGT(FieldBin, v))
+}
+
+// BinGTE applies the GTE predicate on the "bin" field.
+func BinGTE(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGTE(FieldBin, v))
+}
+
+// BinLT applies the LT predicate on the "bin" field.
+func BinLT(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLT(FieldBin, v))
+}
+
+// BinLTE applies the LTE predicate on the "bin" field.
+func BinLTE(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLTE(FieldBin, v))
+}
+
+// BinContains applies the Contains predicate on the "bin" field.
+func BinContains(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldContains(FieldBin, v))
+}
+
+// BinHasPrefix applies the HasPrefix predicate on the "bin" field.
+func BinHasPrefix(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldHasPrefix(FieldBin, v))
+}
+
+// BinHasSuffix applies the HasSuffix predicate on the "bin" field.
+func BinHasSuffix(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldHasSuffix(FieldBin, v))
+}
+
+// BinEqualFold applies the EqualFold predicate on the "bin" field.
+func BinEqualFold(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEqualFold(FieldBin, v))
+}
+
+// BinContainsFold applies the ContainsFold predicate on the "bin" field.
+func BinContainsFold(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldContainsFold(FieldBin, v))
+}
+
+// LotNumberEQ applies the EQ predicate on the "lot_number" field.
+func LotNumberEQ(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldLotNumber, v))
+}
+
+// LotNumberNEQ applies the NEQ predicate on the "lot_number" field.
+func LotNumberNEQ(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNEQ(FieldLotNumber, v))
+}
+
+// LotNumberIn applies the In predicate on the "lot_number" field.
+func LotNumberIn(vs ...string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldIn(FieldLotNumber, vs...))
+}
+
+// LotNumberNotIn applies the NotIn predicate on the "lot_number" field.
+func LotNumberNotIn(vs ...string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNotIn(FieldLotNumber, vs...))
+}
+
+// LotNumberGT applies the GT predicate on the "lot_number" field.
+func LotNumberGT(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGT(FieldLotNumber, v))
+}
+
+// LotNumberGTE applies the GTE predicate on the "lot_number" field.
+func LotNumberGTE(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGTE(FieldLotNumber, v))
+}
+
+// LotNumberLT applies the LT predicate on the "lot_number" field.
+func LotNumberLT(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLT(FieldLotNumber, v))
+}
+
+// LotNumberLTE applies the LTE predicate on the "lot_number" field.
+func LotNumberLTE(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLTE(FieldLotNumber, v))
+}
+
+// LotNumberContains applies the Contains predicate on the "lot_number" field.
+func LotNumberContains(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldContains(FieldLotNumber, v))
+}
+
+// LotNumberHasPrefix applies the HasPrefix predicate on the "lot_number" field.
+func LotNumberHasPrefix(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldHasPrefix(FieldLotNumber, v))
+}
+
+// LotNumberHasSuffix applies the HasSuffix predicate on the "lot_number" field.
+func LotNumberHasSuffix(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldHasSuffix(FieldLotNumber, v))
+}
+
+// LotNumberEqualFold applies the EqualFold predicate on the "lot_number" field.
+func LotNumberEqualFold(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEqualFold(FieldLotNumber, v))
+}
+
+// LotNumberContainsFold applies the ContainsFold predicate on the "lot_number" field.
+func LotNumberContainsFold(v string) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldContainsFold(FieldLotNumber, v))
+}
+
+// ExpiryDateEQ applies the EQ predicate on the "expiry_date" field.
+func ExpiryDateEQ(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldExpiryDate, v))
+}
+
+// ExpiryDateNEQ applies the NEQ predicate on the "expiry_date" field.
+func ExpiryDateNEQ(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNEQ(FieldExpiryDate, v))
+}
+
+// ExpiryDateIn applies the In predicate on the "expiry_date" field.
+func ExpiryDateIn(vs ...time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldIn(FieldExpiryDate, vs...))
+}
+
+// ExpiryDateNotIn applies the NotIn predicate on the "expiry_date" field.
+func ExpiryDateNotIn(vs ...time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNotIn(FieldExpiryDate, vs...))
+}
+
+// ExpiryDateGT applies the GT predicate on the "expiry_date" field.
+func ExpiryDateGT(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGT(FieldExpiryDate, v))
+}
+
+// ExpiryDateGTE applies the GTE predicate on the "expiry_date" field.
+func ExpiryDateGTE(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGTE(FieldExpiryDate, v))
+}
+
+// ExpiryDateLT applies the LT predicate on the "expiry_date" field.
+func ExpiryDateLT(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLT(FieldExpiryDate, v))
+}
+
+// ExpiryDateLTE applies the LTE predicate on the "expiry_date" field.
+func ExpiryDateLTE(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLTE(FieldExpiryDate, v))
+}
+
+// ExpiryDateIsNil applies the IsNil predicate on the "expiry_date" field.
+func ExpiryDateIsNil() predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldIsNull(FieldExpiryDate))
+}
+
+// ExpiryDateNotNil applies the NotNil predicate on the "expiry_date" field.
+func ExpiryDateNotNil() predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNotNull(FieldExpiryDate))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.StockLevel {
+	return predicate.StockLevel(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.StockLevel {
+	return predicate.StockLevel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.StockLevel {
+	return predicate.StockLevel(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.StockLevel {
+	return predicate.StockLevel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.StockLevel {
+	return predicate.StockLevel(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWarehouse applies the HasEdge predicate on the "warehouse" edge.
+func HasWarehouse() predicate.StockLevel {
+	return predicate.StockLevel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarehouseWith applies the HasEdge predicate on the "warehouse" edge with a given conditions (other predicates).
+func HasWarehouseWith(preds ...predicate.Warehouse) predicate.StockLevel {
+	return predicate.StockLevel(func(s *sql.Selector) {
+		step := newWarehouseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StockLevel) predicate.StockLevel {
+	return predicate.StockLevel(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StockLevel) predicate.StockLevel {
+	return predicate.StockLevel(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StockLevel) predicate.StockLevel {
+	return predicate.StockLevel(sql.NotPredicates(p))
+}
@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/product"
+	"sent/ent/stocklevel"
+	"sent/ent/tenant"
+	"sent/ent/warehouse"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// StockLevelCreate is the builder for creating a StockLevel entity.
+type StockLevelCreate struct {
+	config
+	mutation *StockLevelMutation
+	hooks    []Hook
+}
+
+// SetBin sets the "bin" field.
+func (_c *StockLevelCreate) SetBin(v string) *StockLevelCreate {
+	_c.mutation.SetBin(v)
+	return _c
+}
+
+// SetNillableBin sets the "bin" field if the given value is not nil.
+func (_c *StockLevelCreate) SetNillableBin(v *string) *StockLevelCreate {
+	if v != nil {
+		_c.SetBin(*v)
+	}
+	return _c
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_c *StockLevelCreate) SetLotNumber(v string) *StockLevelCreate {
+	_c.mutation.SetLotNumber(v)
+	return _c
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_c *StockLevelCreate) SetNillableLotNumber(v *string) *StockLevelCreate {
+	if v != nil {
+		_c.SetLotNumber(*v)
+	}
+	return _c
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (_c *StockLevelCreate) SetExpiryDate(v time.Time) *StockLevelCreate {
+	_c.mutation.SetExpiryDate(v)
+	return _c
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (_c *StockLevelCreate) SetNillableExpiryDate(v *time.Time) *StockLevelCreate {
+	if v != nil {
+		_c.SetExpiryDate(*v)
+	}
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *StockLevelCreate) SetQuantity(v decimal.Decimal) *StockLevelCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *StockLevelCreate) SetNillableQuantity(v *decimal.Decimal) *StockLevelCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StockLevelCreate) SetUpdatedAt(v time.Time) *StockLevelCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StockLevelCreate) SetNillableUpdatedAt(v *time.Time) *StockLevelCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *StockLevelCreate) SetTenantID(id int) *StockLevelCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *StockLevelCreate) SetTenant(v *Tenant) *StockLevelCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_c *StockLevelCreate) SetProductID(id int) *StockLevelCreate {
+	_c.mutation.SetProductID(id)
+	return _c
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_c *StockLevelCreate) SetProduct(v *Product) *StockLevelCreate {
+	return _c.SetProductID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_c *StockLevelCreate) SetWarehouseID(id int) *StockLevelCreate {
+	_c.mutation.SetWarehouseID(id)
+	return _c
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_c *StockLevelCreate) SetWarehouse(v *Warehouse) *StockLevelCreate {
+	return _c.SetWarehouseID(v.ID)
+}
+
+// Mutation returns the StockLevelMutation object of the builder.
+func (_c *StockLevelCreate) Mutation() *StockLevelMutation {
+	return _c.mutation
+}
+
+// Save creates the StockLevel in the database.
+func (_c *StockLevelCreate) Save(ctx context.Context) (*StockLevel, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StockLevelCreate) SaveX(ctx context.Context) *StockLevel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockLevelCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockLevelCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StockLevelCreate) defaults() {
+	if _, ok := _c.mutation.Bin(); !ok {
+		v := stocklevel.DefaultBin
+		_c.mutation.SetBin(v)
+	}
+	if _, ok := _c.mutation.LotNumber(); !ok {
+		v := stocklevel.DefaultLotNumber
+		_c.mutation.SetLotNumber(v)
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := stocklevel.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := stocklevel.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StockLevelCreate) check() error {
+	if _, ok := _c.mutation.Bin(); !ok {
+		return &ValidationError{Name: "bin", err: errors.New(`ent: missing required field "StockLevel.bin"`)}
+	}
+	if _, ok := _c.mutation.LotNumber(); !ok {
+		return &ValidationError{Name: "lot_number", err: errors.New(`ent: missing required field "StockLevel.lot_number"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "StockLevel.quantity"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "StockLevel.updated_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "StockLevel.tenant"`)}
+	}
+	if len(_c.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "StockLevel.product"`)}
+	}
+	if len(_c.mutation.WarehouseIDs()) == 0 {
+		return &ValidationError{Name: "warehouse", err: errors.New(`ent: missing required edge "StockLevel.warehouse"`)}
+	}
+	return nil
+}
+
+func (_c *StockLevelCreate) sqlSave(ctx context.Context) (*StockLevel, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StockLevelCreate) createSpec() (*StockLevel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockLevel{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stocklevel.Table, sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Bin(); ok {
+		_spec.SetField(stocklevel.FieldBin, field.TypeString, value)
+		_node.Bin = value
+	}
+	if value, ok := _c.mutation.LotNumber(); ok {
+		_spec.SetField(stocklevel.FieldLotNumber, field.TypeString, value)
+		_node.LotNumber = value
+	}
+	if value, ok := _c.mutation.ExpiryDate(); ok {
+		_spec.SetField(stocklevel.FieldExpiryDate, field.TypeTime, value)
+		_node.ExpiryDate = &value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(stocklevel.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocklevel.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.TenantTable,
+			Columns: []string{stocklevel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_stock_levels = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.ProductTable,
+			Columns: []string{stocklevel.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.product_stock_levels = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.WarehouseTable,
+			Columns: []string{stocklevel.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_stock_levels = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StockLevelCreateBulk is the builder for creating many StockLevel entities in bulk.
+type StockLevelCreateBulk struct {
+	config
+	err      error
+	builders []*StockLevelCreate
+}
+
+// Save creates the StockLevel entities in the database.
+func (_c *StockLevelCreateBulk) Save(ctx context.Context) ([]*StockLevel, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StockLevel, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockLevelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StockLevelCreateBulk) SaveX(ctx context.Context) []*StockLevel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockLevelCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockLevelCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
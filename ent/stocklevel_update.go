@@ -0,0 +1,696 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/predicate"
+	"sent/ent/product"
+	"sent/ent/stocklevel"
+	"sent/ent/tenant"
+	"sent/ent/warehouse"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// StockLevelUpdate is the builder for updating StockLevel entities.
+type StockLevelUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StockLevelMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StockLevelUpdate builder.
+func (_u *StockLevelUpdate) Where(ps ...predicate.StockLevel) *StockLevelUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBin sets the "bin" field.
+func (_u *StockLevelUpdate) SetBin(v string) *StockLevelUpdate {
+	_u.mutation.SetBin(v)
+	return _u
+}
+
+// SetNillableBin sets the "bin" field if the given value is not nil.
+func (_u *StockLevelUpdate) SetNillableBin(v *string) *StockLevelUpdate {
+	if v != nil {
+		_u.SetBin(*v)
+	}
+	return _u
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_u *StockLevelUpdate) SetLotNumber(v string) *StockLevelUpdate {
+	_u.mutation.SetLotNumber(v)
+	return _u
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_u *StockLevelUpdate) SetNillableLotNumber(v *string) *StockLevelUpdate {
+	if v != nil {
+		_u.SetLotNumber(*v)
+	}
+	return _u
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (_u *StockLevelUpdate) SetExpiryDate(v time.Time) *StockLevelUpdate {
+	_u.mutation.SetExpiryDate(v)
+	return _u
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (_u *StockLevelUpdate) SetNillableExpiryDate(v *time.Time) *StockLevelUpdate {
+	if v != nil {
+		_u.SetExpiryDate(*v)
+	}
+	return _u
+}
+
+// ClearExpiryDate clears the value of the "expiry_date" field.
+func (_u *StockLevelUpdate) ClearExpiryDate() *StockLevelUpdate {
+	_u.mutation.ClearExpiryDate()
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *StockLevelUpdate) SetQuantity(v decimal.Decimal) *StockLevelUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *StockLevelUpdate) SetNillableQuantity(v *decimal.Decimal) *StockLevelUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StockLevelUpdate) SetUpdatedAt(v time.Time) *StockLevelUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *StockLevelUpdate) SetTenantID(id int) *StockLevelUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *StockLevelUpdate) SetTenant(v *Tenant) *StockLevelUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_u *StockLevelUpdate) SetProductID(id int) *StockLevelUpdate {
+	_u.mutation.SetProductID(id)
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *StockLevelUpdate) SetProduct(v *Product) *StockLevelUpdate {
+	return _u.SetProductID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *StockLevelUpdate) SetWarehouseID(id int) *StockLevelUpdate {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *StockLevelUpdate) SetWarehouse(v *Warehouse) *StockLevelUpdate {
+	return _u.SetWarehouseID(v.ID)
+}
+
+// Mutation returns the StockLevelMutation object of the builder.
+func (_u *StockLevelUpdate) Mutation() *StockLevelMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *StockLevelUpdate) ClearTenant() *StockLevelUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *StockLevelUpdate) ClearProduct() *StockLevelUpdate {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *StockLevelUpdate) ClearWarehouse() *StockLevelUpdate {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StockLevelUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StockLevelUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StockLevelUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StockLevelUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StockLevelUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := stocklevel.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StockLevelUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockLevel.tenant"`)
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockLevel.product"`)
+	}
+	if _u.mutation.WarehouseCleared() && len(_u.mutation.WarehouseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockLevel.warehouse"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StockLevelUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StockLevelUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StockLevelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stocklevel.Table, stocklevel.Columns, sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Bin(); ok {
+		_spec.SetField(stocklevel.FieldBin, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LotNumber(); ok {
+		_spec.SetField(stocklevel.FieldLotNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiryDate(); ok {
+		_spec.SetField(stocklevel.FieldExpiryDate, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryDateCleared() {
+		_spec.ClearField(stocklevel.FieldExpiryDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(stocklevel.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocklevel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.TenantTable,
+			Columns: []string{stocklevel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.TenantTable,
+			Columns: []string{stocklevel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.ProductTable,
+			Columns: []string{stocklevel.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.ProductTable,
+			Columns: []string{stocklevel.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.WarehouseTable,
+			Columns: []string{stocklevel.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.WarehouseTable,
+			Columns: []string{stocklevel.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stocklevel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StockLevelUpdateOne is the builder for updating a single StockLevel entity.
+type StockLevelUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StockLevelMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetBin sets the "bin" field.
+func (_u *StockLevelUpdateOne) SetBin(v string) *StockLevelUpdateOne {
+	_u.mutation.SetBin(v)
+	return _u
+}
+
+// SetNillableBin sets the "bin" field if the given value is not nil.
+func (_u *StockLevelUpdateOne) SetNillableBin(v *string) *StockLevelUpdateOne {
+	if v != nil {
+		_u.SetBin(*v)
+	}
+	return _u
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_u *StockLevelUpdateOne) SetLotNumber(v string) *StockLevelUpdateOne {
+	_u.mutation.SetLotNumber(v)
+	return _u
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_u *StockLevelUpdateOne) SetNillableLotNumber(v *string) *StockLevelUpdateOne {
+	if v != nil {
+		_u.SetLotNumber(*v)
+	}
+	return _u
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (_u *StockLevelUpdateOne) SetExpiryDate(v time.Time) *StockLevelUpdateOne {
+	_u.mutation.SetExpiryDate(v)
+	return _u
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (_u *StockLevelUpdateOne) SetNillableExpiryDate(v *time.Time) *StockLevelUpdateOne {
+	if v != nil {
+		_u.SetExpiryDate(*v)
+	}
+	return _u
+}
+
+// ClearExpiryDate clears the value of the "expiry_date" field.
+func (_u *StockLevelUpdateOne) ClearExpiryDate() *StockLevelUpdateOne {
+	_u.mutation.ClearExpiryDate()
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *StockLevelUpdateOne) SetQuantity(v decimal.Decimal) *StockLevelUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *StockLevelUpdateOne) SetNillableQuantity(v *decimal.Decimal) *StockLevelUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StockLevelUpdateOne) SetUpdatedAt(v time.Time) *StockLevelUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *StockLevelUpdateOne) SetTenantID(id int) *StockLevelUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *StockLevelUpdateOne) SetTenant(v *Tenant) *StockLevelUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_u *StockLevelUpdateOne) SetProductID(id int) *StockLevelUpdateOne {
+	_u.mutation.SetProductID(id)
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *StockLevelUpdateOne) SetProduct(v *Product) *StockLevelUpdateOne {
+	return _u.SetProductID(v.ID)
+}
+
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *StockLevelUpdateOne) SetWarehouseID(id int) *StockLevelUpdateOne {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *StockLevelUpdateOne) SetWarehouse(v *Warehouse) *StockLevelUpdateOne {
+	return _u.SetWarehouseID(v.ID)
+}
+
+// Mutation returns the StockLevelMutation object of the builder.
+func (_u *StockLevelUpdateOne) Mutation() *StockLevelMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *StockLevelUpdateOne) ClearTenant() *StockLevelUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *StockLevelUpdateOne) ClearProduct() *StockLevelUpdateOne {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *StockLevelUpdateOne) ClearWarehouse() *StockLevelUpdateOne {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
+// Where appends a list predicates to the StockLevelUpdate builder.
+func (_u *StockLevelUpdateOne) Where(ps ...predicate.StockLevel) *StockLevelUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StockLevelUpdateOne) Select(field string, fields ...string) *StockLevelUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StockLevel entity.
+func (_u *StockLevelUpdateOne) Save(ctx context.Context) (*StockLevel, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StockLevelUpdateOne) SaveX(ctx context.Context) *StockLevel {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StockLevelUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StockLevelUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StockLevelUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := stocklevel.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StockLevelUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockLevel.tenant"`)
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockLevel.product"`)
+	}
+	if _u.mutation.WarehouseCleared() && len(_u.mutation.WarehouseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockLevel.warehouse"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StockLevelUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StockLevelUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StockLevelUpdateOne) sqlSave(ctx context.Context) (_node *StockLevel, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stocklevel.Table, stocklevel.Columns, sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockLevel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stocklevel.FieldID)
+		for _, f := range fields {
+			if !stocklevel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stocklevel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Bin(); ok {
+		_spec.SetField(stocklevel.FieldBin, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LotNumber(); ok {
+		_spec.SetField(stocklevel.FieldLotNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiryDate(); ok {
+		_spec.SetField(stocklevel.FieldExpiryDate, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryDateCleared() {
+		_spec.ClearField(stocklevel.FieldExpiryDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(stocklevel.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocklevel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.TenantTable,
+			Columns: []string{stocklevel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.TenantTable,
+			Columns: []string{stocklevel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.ProductTable,
+			Columns: []string{stocklevel.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.ProductTable,
+			Columns: []string{stocklevel.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.WarehouseTable,
+			Columns: []string{stocklevel.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.WarehouseTable,
+			Columns: []string{stocklevel.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &StockLevel{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stocklevel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,9 @@ import (
 	"sent/ent/product"
 	"sent/ent/stockmovement"
 	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/transferorder"
+	"sent/ent/warehouse"
 	"strings"
 	"time"
 
@@ -27,6 +30,12 @@ type StockMovement struct {
 	MovementType stockmovement.MovementType `json:"movement_type,omitempty"`
 	// Reason holds the value of the "reason" field.
 	Reason string `json:"reason,omitempty"`
+	// Bin holds the value of the "bin" field.
+	Bin string `json:"bin,omitempty"`
+	// LotNumber holds the value of the "lot_number" field.
+	LotNumber string `json:"lot_number,omitempty"`
+	// ExpiryDate holds the value of the "expiry_date" field.
+	ExpiryDate *time.Time `json:"expiry_date,omitempty"`
 	// UnitCost holds the value of the "unit_cost" field.
 	UnitCost decimal.Decimal `json:"unit_cost,omitempty"`
 	// RemainingQuantity holds the value of the "remaining_quantity" field.
@@ -39,10 +48,13 @@ type StockMovement struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the StockMovementQuery when eager-loading is set.
-	Edges                  StockMovementEdges `json:"edges"`
-	product_movements      *int
-	tenant_stock_movements *int
-	selectValues           sql.SelectValues
+	Edges                       StockMovementEdges `json:"edges"`
+	product_movements           *int
+	tenant_stock_movements      *int
+	transaction_stock_movements *int
+	transfer_order_movements    *int
+	warehouse_movements         *int
+	selectValues                sql.SelectValues
 }
 
 // StockMovementEdges holds the relations/edges for other nodes in the graph.
@@ -51,9 +63,15 @@ type StockMovementEdges struct {
 	Product *Product `json:"product,omitempty"`
 	// Tenant holds the value of the tenant edge.
 	Tenant *Tenant `json:"tenant,omitempty"`
+	// Warehouse holds the value of the warehouse edge.
+	Warehouse *Warehouse `json:"warehouse,omitempty"`
+	// Transfer holds the value of the transfer edge.
+	Transfer *TransferOrder `json:"transfer,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // ProductOrErr returns the Product value or an error if the edge
@@ -78,6 +96,39 @@ func (e StockMovementEdges) TenantOrErr() (*Tenant, error) {
 	return nil, &NotLoadedError{edge: "tenant"}
 }
 
+// WarehouseOrErr returns the Warehouse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) WarehouseOrErr() (*Warehouse, error) {
+	if e.Warehouse != nil {
+		return e.Warehouse, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "warehouse"}
+}
+
+// TransferOrErr returns the Transfer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) TransferOrErr() (*TransferOrder, error) {
+	if e.Transfer != nil {
+		return e.Transfer, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: transferorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "transfer"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*StockMovement) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -89,14 +140,20 @@ func (*StockMovement) scanValues(columns []string) ([]any, error) {
 			values[i] = new(decimal.Decimal)
 		case stockmovement.FieldID:
 			values[i] = new(sql.NullInt64)
-		case stockmovement.FieldMovementType, stockmovement.FieldReason:
+		case stockmovement.FieldMovementType, stockmovement.FieldReason, stockmovement.FieldBin, stockmovement.FieldLotNumber:
 			values[i] = new(sql.NullString)
-		case stockmovement.FieldCreatedAt:
+		case stockmovement.FieldExpiryDate, stockmovement.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case stockmovement.ForeignKeys[0]: // product_movements
 			values[i] = new(sql.NullInt64)
 		case stockmovement.ForeignKeys[1]: // tenant_stock_movements
 			values[i] = new(sql.NullInt64)
+		case stockmovement.ForeignKeys[2]: // transaction_stock_movements
+			values[i] = new(sql.NullInt64)
+		case stockmovement.ForeignKeys[3]: // transfer_order_movements
+			values[i] = new(sql.NullInt64)
+		case stockmovement.ForeignKeys[4]: // warehouse_movements
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -136,6 +193,25 @@ func (_m *StockMovement) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Reason = value.String
 			}
+		case stockmovement.FieldBin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bin", values[i])
+			} else if value.Valid {
+				_m.Bin = value.String
+			}
+		case stockmovement.FieldLotNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_number", values[i])
+			} else if value.Valid {
+				_m.LotNumber = value.String
+			}
+		case stockmovement.FieldExpiryDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_date", values[i])
+			} else if value.Valid {
+				_m.ExpiryDate = new(time.Time)
+				*_m.ExpiryDate = value.Time
+			}
 		case stockmovement.FieldUnitCost:
 			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
@@ -182,6 +258,27 @@ func (_m *StockMovement) assignValues(columns []string, values []any) error {
 				_m.tenant_stock_movements = new(int)
 				*_m.tenant_stock_movements = int(value.Int64)
 			}
+		case stockmovement.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field transaction_stock_movements", value)
+			} else if value.Valid {
+				_m.transaction_stock_movements = new(int)
+				*_m.transaction_stock_movements = int(value.Int64)
+			}
+		case stockmovement.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field transfer_order_movements", value)
+			} else if value.Valid {
+				_m.transfer_order_movements = new(int)
+				*_m.transfer_order_movements = int(value.Int64)
+			}
+		case stockmovement.ForeignKeys[4]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_movements", value)
+			} else if value.Valid {
+				_m.warehouse_movements = new(int)
+				*_m.warehouse_movements = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -205,6 +302,21 @@ func (_m *StockMovement) QueryTenant() *TenantQuery {
 	return NewStockMovementClient(_m.config).QueryTenant(_m)
 }
 
+// QueryWarehouse queries the "warehouse" edge of the StockMovement entity.
+func (_m *StockMovement) QueryWarehouse() *WarehouseQuery {
+	return NewStockMovementClient(_m.config).QueryWarehouse(_m)
+}
+
+// QueryTransfer queries the "transfer" edge of the StockMovement entity.
+func (_m *StockMovement) QueryTransfer() *TransferOrderQuery {
+	return NewStockMovementClient(_m.config).QueryTransfer(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the StockMovement entity.
+func (_m *StockMovement) QueryTransaction() *TransactionQuery {
+	return NewStockMovementClient(_m.config).QueryTransaction(_m)
+}
+
 // Update returns a builder for updating this StockMovement.
 // Note that you need to call StockMovement.Unwrap() before calling this method if this StockMovement
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -237,6 +349,17 @@ func (_m *StockMovement) String() string {
 	builder.WriteString("reason=")
 	builder.WriteString(_m.Reason)
 	builder.WriteString(", ")
+	builder.WriteString("bin=")
+	builder.WriteString(_m.Bin)
+	builder.WriteString(", ")
+	builder.WriteString("lot_number=")
+	builder.WriteString(_m.LotNumber)
+	builder.WriteString(", ")
+	if v := _m.ExpiryDate; v != nil {
+		builder.WriteString("expiry_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("unit_cost=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UnitCost))
 	builder.WriteString(", ")
@@ -22,6 +22,12 @@ const (
 	FieldMovementType = "movement_type"
 	// FieldReason holds the string denoting the reason field in the database.
 	FieldReason = "reason"
+	// FieldBin holds the string denoting the bin field in the database.
+	FieldBin = "bin"
+	// FieldLotNumber holds the string denoting the lot_number field in the database.
+	FieldLotNumber = "lot_number"
+	// FieldExpiryDate holds the string denoting the expiry_date field in the database.
+	FieldExpiryDate = "expiry_date"
 	// FieldUnitCost holds the string denoting the unit_cost field in the database.
 	FieldUnitCost = "unit_cost"
 	// FieldRemainingQuantity holds the string denoting the remaining_quantity field in the database.
@@ -36,6 +42,12 @@ const (
 	EdgeProduct = "product"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
+	// EdgeWarehouse holds the string denoting the warehouse edge name in mutations.
+	EdgeWarehouse = "warehouse"
+	// EdgeTransfer holds the string denoting the transfer edge name in mutations.
+	EdgeTransfer = "transfer"
+	// EdgeTransaction holds the string denoting the transaction edge name in mutations.
+	EdgeTransaction = "transaction"
 	// Table holds the table name of the stockmovement in the database.
 	Table = "stock_movements"
 	// ProductTable is the table that holds the product relation/edge.
@@ -52,6 +64,27 @@ const (
 	TenantInverseTable = "tenants"
 	// TenantColumn is the table column denoting the tenant relation/edge.
 	TenantColumn = "tenant_stock_movements"
+	// WarehouseTable is the table that holds the warehouse relation/edge.
+	WarehouseTable = "stock_movements"
+	// WarehouseInverseTable is the table name for the Warehouse entity.
+	// It exists in this package in order to avoid circular dependency with the "warehouse" package.
+	WarehouseInverseTable = "warehouses"
+	// WarehouseColumn is the table column denoting the warehouse relation/edge.
+	WarehouseColumn = "warehouse_movements"
+	// TransferTable is the table that holds the transfer relation/edge.
+	TransferTable = "stock_movements"
+	// TransferInverseTable is the table name for the TransferOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "transferorder" package.
+	TransferInverseTable = "transfer_orders"
+	// TransferColumn is the table column denoting the transfer relation/edge.
+	TransferColumn = "transfer_order_movements"
+	// TransactionTable is the table that holds the transaction relation/edge.
+	TransactionTable = "stock_movements"
+	// TransactionInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionInverseTable = "transactions"
+	// TransactionColumn is the table column denoting the transaction relation/edge.
+	TransactionColumn = "transaction_stock_movements"
 )
 
 // Columns holds all SQL columns for stockmovement fields.
@@ -60,6 +93,9 @@ var Columns = []string{
 	FieldQuantity,
 	FieldMovementType,
 	FieldReason,
+	FieldBin,
+	FieldLotNumber,
+	FieldExpiryDate,
 	FieldUnitCost,
 	FieldRemainingQuantity,
 	FieldCalculatedCogs,
@@ -72,6 +108,9 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"product_movements",
 	"tenant_stock_movements",
+	"transaction_stock_movements",
+	"transfer_order_movements",
+	"warehouse_movements",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -143,6 +182,21 @@ func ByReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReason, opts...).ToFunc()
 }
 
+// ByBin orders the results by the bin field.
+func ByBin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBin, opts...).ToFunc()
+}
+
+// ByLotNumber orders the results by the lot_number field.
+func ByLotNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLotNumber, opts...).ToFunc()
+}
+
+// ByExpiryDate orders the results by the expiry_date field.
+func ByExpiryDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryDate, opts...).ToFunc()
+}
+
 // ByUnitCost orders the results by the unit_cost field.
 func ByUnitCost(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUnitCost, opts...).ToFunc()
@@ -176,6 +230,27 @@ func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByWarehouseField orders the results by warehouse field.
+func ByWarehouseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWarehouseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransferField orders the results by transfer field.
+func ByTransferField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransferStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransactionField orders the results by transaction field.
+func ByTransactionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newProductStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -190,3 +265,24 @@ func newTenantStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
 	)
 }
+func newWarehouseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WarehouseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+	)
+}
+func newTransferStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransferInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TransferTable, TransferColumn),
+	)
+}
+func newTransactionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TransactionTable, TransactionColumn),
+	)
+}
@@ -66,6 +66,21 @@ func Reason(v string) predicate.StockMovement {
 	return predicate.StockMovement(sql.FieldEQ(FieldReason, v))
 }
 
+// Bin applies equality check predicate on the "bin" field. It's identical to BinEQ.
+func Bin(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldBin, v))
+}
+
+// LotNumber applies equality check predicate on the "lot_number" field. It's identical to LotNumberEQ.
+func LotNumber(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldLotNumber, v))
+}
+
+// ExpiryDate applies equality check predicate on the "expiry_date" field. It's identical to ExpiryDateEQ.
+func ExpiryDate(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldExpiryDate, v))
+}
+
 // UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
 func UnitCost(v decimal.Decimal) predicate.StockMovement {
 	return predicate.StockMovement(sql.FieldEQ(FieldUnitCost, v))
@@ -221,6 +236,206 @@ func ReasonContainsFold(v string) predicate.StockMovement {
 	return predicate.StockMovement(sql.FieldContainsFold(FieldReason, v))
 }
 
+// BinEQ applies the EQ predicate on the "bin" field.
+func BinEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldBin, v))
+}
+
+// BinNEQ applies the NEQ predicate on the "bin" field.
+func BinNEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldBin, v))
+}
+
+// BinIn applies the In predicate on the "bin" field.
+func BinIn(vs ...string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldBin, vs...))
+}
+
+// BinNotIn applies the NotIn predicate on the "bin" field.
+func BinNotIn(vs ...string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldBin, vs...))
+}
+
+// BinGT applies the GT predicate on the "bin" field.
+func BinGT(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldBin, v))
+}
+
+// BinGTE applies the GTE predicate on the "bin" field.
+func BinGTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldBin, v))
+}
+
+// BinLT applies the LT predicate on the "bin" field.
+func BinLT(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldBin, v))
+}
+
+// BinLTE applies the LTE predicate on the "bin" field.
+func BinLTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldBin, v))
+}
+
+// BinContains applies the Contains predicate on the "bin" field.
+func BinContains(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldContains(FieldBin, v))
+}
+
+// BinHasPrefix applies the HasPrefix predicate on the "bin" field.
+func BinHasPrefix(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldHasPrefix(FieldBin, v))
+}
+
+// BinHasSuffix applies the HasSuffix predicate on the "bin" field.
+func BinHasSuffix(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldHasSuffix(FieldBin, v))
+}
+
+// BinIsNil applies the IsNil predicate on the "bin" field.
+func BinIsNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIsNull(FieldBin))
+}
+
+// BinNotNil applies the NotNil predicate on the "bin" field.
+func BinNotNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotNull(FieldBin))
+}
+
+// BinEqualFold applies the EqualFold predicate on the "bin" field.
+func BinEqualFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEqualFold(FieldBin, v))
+}
+
+// BinContainsFold applies the ContainsFold predicate on the "bin" field.
+func BinContainsFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldContainsFold(FieldBin, v))
+}
+
+// LotNumberEQ applies the EQ predicate on the "lot_number" field.
+func LotNumberEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldLotNumber, v))
+}
+
+// LotNumberNEQ applies the NEQ predicate on the "lot_number" field.
+func LotNumberNEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldLotNumber, v))
+}
+
+// LotNumberIn applies the In predicate on the "lot_number" field.
+func LotNumberIn(vs ...string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldLotNumber, vs...))
+}
+
+// LotNumberNotIn applies the NotIn predicate on the "lot_number" field.
+func LotNumberNotIn(vs ...string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldLotNumber, vs...))
+}
+
+// LotNumberGT applies the GT predicate on the "lot_number" field.
+func LotNumberGT(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldLotNumber, v))
+}
+
+// LotNumberGTE applies the GTE predicate on the "lot_number" field.
+func LotNumberGTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldLotNumber, v))
+}
+
+// LotNumberLT applies the LT predicate on the "lot_number" field.
+func LotNumberLT(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldLotNumber, v))
+}
+
+// LotNumberLTE applies the LTE predicate on the "lot_number" field.
+func LotNumberLTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldLotNumber, v))
+}
+
+// LotNumberContains applies the Contains predicate on the "lot_number" field.
+func LotNumberContains(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldContains(FieldLotNumber, v))
+}
+
+// LotNumberHasPrefix applies the HasPrefix predicate on the "lot_number" field.
+func LotNumberHasPrefix(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldHasPrefix(FieldLotNumber, v))
+}
+
+// LotNumberHasSuffix applies the HasSuffix predicate on the "lot_number" field.
+func LotNumberHasSuffix(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldHasSuffix(FieldLotNumber, v))
+}
+
+// LotNumberIsNil applies the IsNil predicate on the "lot_number" field.
+func LotNumberIsNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIsNull(FieldLotNumber))
+}
+
+// LotNumberNotNil applies the NotNil predicate on the "lot_number" field.
+func LotNumberNotNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotNull(FieldLotNumber))
+}
+
+// LotNumberEqualFold applies the EqualFold predicate on the "lot_number" field.
+func LotNumberEqualFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEqualFold(FieldLotNumber, v))
+}
+
+// LotNumberContainsFold applies the ContainsFold predicate on the "lot_number" field.
+func LotNumberContainsFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldContainsFold(FieldLotNumber, v))
+}
+
+// ExpiryDateEQ applies the EQ predicate on the "expiry_date" field.
+func ExpiryDateEQ(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldExpiryDate, v))
+}
+
+// ExpiryDateNEQ applies the NEQ predicate on the "expiry_date" field.
+func ExpiryDateNEQ(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldExpiryDate, v))
+}
+
+// ExpiryDateIn applies the In predicate on the "expiry_date" field.
+func ExpiryDateIn(vs ...time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldExpiryDate, vs...))
+}
+
+// ExpiryDateNotIn applies the NotIn predicate on the "expiry_date" field.
+func ExpiryDateNotIn(vs ...time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldExpiryDate, vs...))
+}
+
+// ExpiryDateGT applies the GT predicate on the "expiry_date" field.
+func ExpiryDateGT(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldExpiryDate, v))
+}
+
+// ExpiryDateGTE applies the GTE predicate on the "expiry_date" field.
+func ExpiryDateGTE(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldExpiryDate, v))
+}
+
+// ExpiryDateLT applies the LT predicate on the "expiry_date" field.
+func ExpiryDateLT(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldExpiryDate, v))
+}
+
+// ExpiryDateLTE applies the LTE predicate on the "expiry_date" field.
+func ExpiryDateLTE(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldExpiryDate, v))
+}
+
+// ExpiryDateIsNil applies the IsNil predicate on the "expiry_date" field.
+func ExpiryDateIsNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIsNull(FieldExpiryDate))
+}
+
+// ExpiryDateNotNil applies the NotNil predicate on the "expiry_date" field.
+func ExpiryDateNotNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotNull(FieldExpiryDate))
+}
+
 // UnitCostEQ applies the EQ predicate on the "unit_cost" field.
 func UnitCostEQ(v decimal.Decimal) predicate.StockMovement {
 	return predicate.StockMovement(sql.FieldEQ(FieldUnitCost, v))
@@ -467,6 +682,75 @@ func HasTenantWith(preds ...predicate.Tenant) predicate.StockMovement {
 	})
 }
 
+// HasWarehouse applies the HasEdge predicate on the "warehouse" edge.
+func HasWarehouse() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WarehouseTable, WarehouseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarehouseWith applies the HasEdge predicate on the "warehouse" edge with a given conditions (other predicates).
+func HasWarehouseWith(preds ...predicate.Warehouse) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := newWarehouseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransfer applies the HasEdge predicate on the "transfer" edge.
+func HasTransfer() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TransferTable, TransferColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransferWith applies the HasEdge predicate on the "transfer" edge with a given conditions (other predicates).
+func HasTransferWith(preds ...predicate.TransferOrder) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := newTransferStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.StockMovement) predicate.StockMovement {
 	return predicate.StockMovement(sql.AndPredicates(predicates...))
@@ -9,6 +9,9 @@ import (
 	"sent/ent/product"
 	"sent/ent/stockmovement"
 	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/transferorder"
+	"sent/ent/warehouse"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -57,6 +60,48 @@ func (_c *StockMovementCreate) SetNillableReason(v *string) *StockMovementCreate
 	return _c
 }
 
+// SetBin sets the "bin" field.
+func (_c *StockMovementCreate) SetBin(v string) *StockMovementCreate {
+	_c.mutation.SetBin(v)
+	return _c
+}
+
+// SetNillableBin sets the "bin" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableBin(v *string) *StockMovementCreate {
+	if v != nil {
+		_c.SetBin(*v)
+	}
+	return _c
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_c *StockMovementCreate) SetLotNumber(v string) *StockMovementCreate {
+	_c.mutation.SetLotNumber(v)
+	return _c
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableLotNumber(v *string) *StockMovementCreate {
+	if v != nil {
+		_c.SetLotNumber(*v)
+	}
+	return _c
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (_c *StockMovementCreate) SetExpiryDate(v time.Time) *StockMovementCreate {
+	_c.mutation.SetExpiryDate(v)
+	return _c
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableExpiryDate(v *time.Time) *StockMovementCreate {
+	if v != nil {
+		_c.SetExpiryDate(*v)
+	}
+	return _c
+}
+
 // SetUnitCost sets the "unit_cost" field.
 func (_c *StockMovementCreate) SetUnitCost(v decimal.Decimal) *StockMovementCreate {
 	_c.mutation.SetUnitCost(v)
@@ -141,6 +186,63 @@ func (_c *StockMovementCreate) SetTenant(v *Tenant) *StockMovementCreate {
 	return _c.SetTenantID(v.ID)
 }
 
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_c *StockMovementCreate) SetWarehouseID(id int) *StockMovementCreate {
+	_c.mutation.SetWarehouseID(id)
+	return _c
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableWarehouseID(id *int) *StockMovementCreate {
+	if id != nil {
+		_c = _c.SetWarehouseID(*id)
+	}
+	return _c
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_c *StockMovementCreate) SetWarehouse(v *Warehouse) *StockMovementCreate {
+	return _c.SetWarehouseID(v.ID)
+}
+
+// SetTransferID sets the "transfer" edge to the TransferOrder entity by ID.
+func (_c *StockMovementCreate) SetTransferID(id int) *StockMovementCreate {
+	_c.mutation.SetTransferID(id)
+	return _c
+}
+
+// SetNillableTransferID sets the "transfer" edge to the TransferOrder entity by ID if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableTransferID(id *int) *StockMovementCreate {
+	if id != nil {
+		_c = _c.SetTransferID(*id)
+	}
+	return _c
+}
+
+// SetTransfer sets the "transfer" edge to the TransferOrder entity.
+func (_c *StockMovementCreate) SetTransfer(v *TransferOrder) *StockMovementCreate {
+	return _c.SetTransferID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *StockMovementCreate) SetTransactionID(id int) *StockMovementCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableTransactionID(id *int) *StockMovementCreate {
+	if id != nil {
+		_c = _c.SetTransactionID(*id)
+	}
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *StockMovementCreate) SetTransaction(v *Transaction) *StockMovementCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
 // Mutation returns the StockMovementMutation object of the builder.
 func (_c *StockMovementCreate) Mutation() *StockMovementMutation {
 	return _c.mutation
@@ -246,6 +348,18 @@ func (_c *StockMovementCreate) createSpec() (*StockMovement, *sqlgraph.CreateSpe
 		_spec.SetField(stockmovement.FieldReason, field.TypeString, value)
 		_node.Reason = value
 	}
+	if value, ok := _c.mutation.Bin(); ok {
+		_spec.SetField(stockmovement.FieldBin, field.TypeString, value)
+		_node.Bin = value
+	}
+	if value, ok := _c.mutation.LotNumber(); ok {
+		_spec.SetField(stockmovement.FieldLotNumber, field.TypeString, value)
+		_node.LotNumber = value
+	}
+	if value, ok := _c.mutation.ExpiryDate(); ok {
+		_spec.SetField(stockmovement.FieldExpiryDate, field.TypeTime, value)
+		_node.ExpiryDate = &value
+	}
 	if value, ok := _c.mutation.UnitCost(); ok {
 		_spec.SetField(stockmovement.FieldUnitCost, field.TypeOther, value)
 		_node.UnitCost = value
@@ -300,6 +414,57 @@ func (_c *StockMovementCreate) createSpec() (*StockMovement, *sqlgraph.CreateSpe
 		_node.tenant_stock_movements = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.WarehouseTable,
+			Columns: []string{stockmovement.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_movements = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransferTable,
+			Columns: []string{stockmovement.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transfer_order_movements = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransactionTable,
+			Columns: []string{stockmovement.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transaction_stock_movements = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -10,6 +10,9 @@ import (
 	"sent/ent/product"
 	"sent/ent/stockmovement"
 	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/transferorder"
+	"sent/ent/warehouse"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,14 +23,17 @@ import (
 // StockMovementQuery is the builder for querying StockMovement entities.
 type StockMovementQuery struct {
 	config
-	ctx         *QueryContext
-	order       []stockmovement.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.StockMovement
-	withProduct *ProductQuery
-	withTenant  *TenantQuery
-	withFKs     bool
-	modifiers   []func(*sql.Selector)
+	ctx             *QueryContext
+	order           []stockmovement.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.StockMovement
+	withProduct     *ProductQuery
+	withTenant      *TenantQuery
+	withWarehouse   *WarehouseQuery
+	withTransfer    *TransferOrderQuery
+	withTransaction *TransactionQuery
+	withFKs         bool
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +114,72 @@ func (_q *StockMovementQuery) QueryTenant() *TenantQuery {
 	return query
 }
 
+// QueryWarehouse chains the current query on the "warehouse" edge.
+func (_q *StockMovementQuery) QueryWarehouse() *WarehouseQuery {
+	query := (&WarehouseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, selector),
+			sqlgraph.To(warehouse.Table, warehouse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.WarehouseTable, stockmovement.WarehouseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransfer chains the current query on the "transfer" edge.
+func (_q *StockMovementQuery) QueryTransfer() *TransferOrderQuery {
+	query := (&TransferOrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, selector),
+			sqlgraph.To(transferorder.Table, transferorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.TransferTable, stockmovement.TransferColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransaction chains the current query on the "transaction" edge.
+func (_q *StockMovementQuery) QueryTransaction() *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.TransactionTable, stockmovement.TransactionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first StockMovement entity from the query.
 // Returns a *NotFoundError when no StockMovement was found.
 func (_q *StockMovementQuery) First(ctx context.Context) (*StockMovement, error) {
@@ -295,13 +367,16 @@ func (_q *StockMovementQuery) Clone() *StockMovementQuery {
 		return nil
 	}
 	return &StockMovementQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]stockmovement.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.StockMovement{}, _q.predicates...),
-		withProduct: _q.withProduct.Clone(),
-		withTenant:  _q.withTenant.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]stockmovement.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.StockMovement{}, _q.predicates...),
+		withProduct:     _q.withProduct.Clone(),
+		withTenant:      _q.withTenant.Clone(),
+		withWarehouse:   _q.withWarehouse.Clone(),
+		withTransfer:    _q.withTransfer.Clone(),
+		withTransaction: _q.withTransaction.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -331,6 +406,39 @@ func (_q *StockMovementQuery) WithTenant(opts ...func(*TenantQuery)) *StockMovem
 	return _q
 }
 
+// WithWarehouse tells the query-builder to eager-load the nodes that are connected to
+// the "warehouse" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StockMovementQuery) WithWarehouse(opts ...func(*WarehouseQuery)) *StockMovementQuery {
+	query := (&WarehouseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWarehouse = query
+	return _q
+}
+
+// WithTransfer tells the query-builder to eager-load the nodes that are connected to
+// the "transfer" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StockMovementQuery) WithTransfer(opts ...func(*TransferOrderQuery)) *StockMovementQuery {
+	query := (&TransferOrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransfer = query
+	return _q
+}
+
+// WithTransaction tells the query-builder to eager-load the nodes that are connected to
+// the "transaction" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StockMovementQuery) WithTransaction(opts ...func(*TransactionQuery)) *StockMovementQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransaction = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,12 +518,15 @@ func (_q *StockMovementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*StockMovement{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			_q.withProduct != nil,
 			_q.withTenant != nil,
+			_q.withWarehouse != nil,
+			_q.withTransfer != nil,
+			_q.withTransaction != nil,
 		}
 	)
-	if _q.withProduct != nil || _q.withTenant != nil {
+	if _q.withProduct != nil || _q.withTenant != nil || _q.withWarehouse != nil || _q.withTransfer != nil || _q.withTransaction != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -454,6 +565,24 @@ func (_q *StockMovementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withWarehouse; query != nil {
+		if err := _q.loadWarehouse(ctx, query, nodes, nil,
+			func(n *StockMovement, e *Warehouse) { n.Edges.Warehouse = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransfer; query != nil {
+		if err := _q.loadTransfer(ctx, query, nodes, nil,
+			func(n *StockMovement, e *TransferOrder) { n.Edges.Transfer = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransaction; query != nil {
+		if err := _q.loadTransaction(ctx, query, nodes, nil,
+			func(n *StockMovement, e *Transaction) { n.Edges.Transaction = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -521,6 +650,102 @@ func (_q *StockMovementQuery) loadTenant(ctx context.Context, query *TenantQuery
 	}
 	return nil
 }
+func (_q *StockMovementQuery) loadWarehouse(ctx context.Context, query *WarehouseQuery, nodes []*StockMovement, init func(*StockMovement), assign func(*StockMovement, *Warehouse)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StockMovement)
+	for i := range nodes {
+		if nodes[i].warehouse_movements == nil {
+			continue
+		}
+		fk := *nodes[i].warehouse_movements
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(warehouse.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "warehouse_movements" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *StockMovementQuery) loadTransfer(ctx context.Context, query *TransferOrderQuery, nodes []*StockMovement, init func(*StockMovement), assign func(*StockMovement, *TransferOrder)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StockMovement)
+	for i := range nodes {
+		if nodes[i].transfer_order_movements == nil {
+			continue
+		}
+		fk := *nodes[i].transfer_order_movements
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transferorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transfer_order_movements" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *StockMovementQuery) loadTransaction(ctx context.Context, query *TransactionQuery, nodes []*StockMovement, init func(*StockMovement), assign func(*StockMovement, *Transaction)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StockMovement)
+	for i := range nodes {
+		if nodes[i].transaction_stock_movements == nil {
+			continue
+		}
+		fk := *nodes[i].transaction_stock_movements
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transaction_stock_movements" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *StockMovementQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,6 +10,10 @@ import (
 	"sent/ent/product"
 	"sent/ent/stockmovement"
 	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/transferorder"
+	"sent/ent/warehouse"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -79,6 +83,66 @@ func (_u *StockMovementUpdate) ClearReason() *StockMovementUpdate {
 	return _u
 }
 
+// SetBin sets the "bin" field.
+func (_u *StockMovementUpdate) SetBin(v string) *StockMovementUpdate {
+	_u.mutation.SetBin(v)
+	return _u
+}
+
+// SetNillableBin sets the "bin" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableBin(v *string) *StockMovementUpdate {
+	if v != nil {
+		_u.SetBin(*v)
+	}
+	return _u
+}
+
+// ClearBin clears the value of the "bin" field.
+func (_u *StockMovementUpdate) ClearBin() *StockMovementUpdate {
+	_u.mutation.ClearBin()
+	return _u
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_u *StockMovementUpdate) SetLotNumber(v string) *StockMovementUpdate {
+	_u.mutation.SetLotNumber(v)
+	return _u
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableLotNumber(v *string) *StockMovementUpdate {
+	if v != nil {
+		_u.SetLotNumber(*v)
+	}
+	return _u
+}
+
+// ClearLotNumber clears the value of the "lot_number" field.
+func (_u *StockMovementUpdate) ClearLotNumber() *StockMovementUpdate {
+	_u.mutation.ClearLotNumber()
+	return _u
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (_u *StockMovementUpdate) SetExpiryDate(v time.Time) *StockMovementUpdate {
+	_u.mutation.SetExpiryDate(v)
+	return _u
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableExpiryDate(v *time.Time) *StockMovementUpdate {
+	if v != nil {
+		_u.SetExpiryDate(*v)
+	}
+	return _u
+}
+
+// ClearExpiryDate clears the value of the "expiry_date" field.
+func (_u *StockMovementUpdate) ClearExpiryDate() *StockMovementUpdate {
+	_u.mutation.ClearExpiryDate()
+	return _u
+}
+
 // SetUnitCost sets the "unit_cost" field.
 func (_u *StockMovementUpdate) SetUnitCost(v decimal.Decimal) *StockMovementUpdate {
 	_u.mutation.SetUnitCost(v)
@@ -173,6 +237,63 @@ func (_u *StockMovementUpdate) SetTenant(v *Tenant) *StockMovementUpdate {
 	return _u.SetTenantID(v.ID)
 }
 
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *StockMovementUpdate) SetWarehouseID(id int) *StockMovementUpdate {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableWarehouseID(id *int) *StockMovementUpdate {
+	if id != nil {
+		_u = _u.SetWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *StockMovementUpdate) SetWarehouse(v *Warehouse) *StockMovementUpdate {
+	return _u.SetWarehouseID(v.ID)
+}
+
+// SetTransferID sets the "transfer" edge to the TransferOrder entity by ID.
+func (_u *StockMovementUpdate) SetTransferID(id int) *StockMovementUpdate {
+	_u.mutation.SetTransferID(id)
+	return _u
+}
+
+// SetNillableTransferID sets the "transfer" edge to the TransferOrder entity by ID if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableTransferID(id *int) *StockMovementUpdate {
+	if id != nil {
+		_u = _u.SetTransferID(*id)
+	}
+	return _u
+}
+
+// SetTransfer sets the "transfer" edge to the TransferOrder entity.
+func (_u *StockMovementUpdate) SetTransfer(v *TransferOrder) *StockMovementUpdate {
+	return _u.SetTransferID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *StockMovementUpdate) SetTransactionID(id int) *StockMovementUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableTransactionID(id *int) *StockMovementUpdate {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *StockMovementUpdate) SetTransaction(v *Transaction) *StockMovementUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
 // Mutation returns the StockMovementMutation object of the builder.
 func (_u *StockMovementUpdate) Mutation() *StockMovementMutation {
 	return _u.mutation
@@ -190,6 +311,24 @@ func (_u *StockMovementUpdate) ClearTenant() *StockMovementUpdate {
 	return _u
 }
 
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *StockMovementUpdate) ClearWarehouse() *StockMovementUpdate {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
+// ClearTransfer clears the "transfer" edge to the TransferOrder entity.
+func (_u *StockMovementUpdate) ClearTransfer() *StockMovementUpdate {
+	_u.mutation.ClearTransfer()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *StockMovementUpdate) ClearTransaction() *StockMovementUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *StockMovementUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -263,6 +402,24 @@ func (_u *StockMovementUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.ReasonCleared() {
 		_spec.ClearField(stockmovement.FieldReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.Bin(); ok {
+		_spec.SetField(stockmovement.FieldBin, field.TypeString, value)
+	}
+	if _u.mutation.BinCleared() {
+		_spec.ClearField(stockmovement.FieldBin, field.TypeString)
+	}
+	if value, ok := _u.mutation.LotNumber(); ok {
+		_spec.SetField(stockmovement.FieldLotNumber, field.TypeString, value)
+	}
+	if _u.mutation.LotNumberCleared() {
+		_spec.ClearField(stockmovement.FieldLotNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiryDate(); ok {
+		_spec.SetField(stockmovement.FieldExpiryDate, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryDateCleared() {
+		_spec.ClearField(stockmovement.FieldExpiryDate, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UnitCost(); ok {
 		_spec.SetField(stockmovement.FieldUnitCost, field.TypeOther, value)
 	}
@@ -345,6 +502,93 @@ func (_u *StockMovementUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.WarehouseTable,
+			Columns: []string{stockmovement.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.WarehouseTable,
+			Columns: []string{stockmovement.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransferTable,
+			Columns: []string{stockmovement.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransferTable,
+			Columns: []string{stockmovement.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransactionTable,
+			Columns: []string{stockmovement.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransactionTable,
+			Columns: []string{stockmovement.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -415,6 +659,66 @@ func (_u *StockMovementUpdateOne) ClearReason() *StockMovementUpdateOne {
 	return _u
 }
 
+// SetBin sets the "bin" field.
+func (_u *StockMovementUpdateOne) SetBin(v string) *StockMovementUpdateOne {
+	_u.mutation.SetBin(v)
+	return _u
+}
+
+// SetNillableBin sets the "bin" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableBin(v *string) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetBin(*v)
+	}
+	return _u
+}
+
+// ClearBin clears the value of the "bin" field.
+func (_u *StockMovementUpdateOne) ClearBin() *StockMovementUpdateOne {
+	_u.mutation.ClearBin()
+	return _u
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_u *StockMovementUpdateOne) SetLotNumber(v string) *StockMovementUpdateOne {
+	_u.mutation.SetLotNumber(v)
+	return _u
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableLotNumber(v *string) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetLotNumber(*v)
+	}
+	return _u
+}
+
+// ClearLotNumber clears the value of the "lot_number" field.
+func (_u *StockMovementUpdateOne) ClearLotNumber() *StockMovementUpdateOne {
+	_u.mutation.ClearLotNumber()
+	return _u
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (_u *StockMovementUpdateOne) SetExpiryDate(v time.Time) *StockMovementUpdateOne {
+	_u.mutation.SetExpiryDate(v)
+	return _u
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableExpiryDate(v *time.Time) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetExpiryDate(*v)
+	}
+	return _u
+}
+
+// ClearExpiryDate clears the value of the "expiry_date" field.
+func (_u *StockMovementUpdateOne) ClearExpiryDate() *StockMovementUpdateOne {
+	_u.mutation.ClearExpiryDate()
+	return _u
+}
+
 // SetUnitCost sets the "unit_cost" field.
 func (_u *StockMovementUpdateOne) SetUnitCost(v decimal.Decimal) *StockMovementUpdateOne {
 	_u.mutation.SetUnitCost(v)
@@ -509,6 +813,63 @@ func (_u *StockMovementUpdateOne) SetTenant(v *Tenant) *StockMovementUpdateOne {
 	return _u.SetTenantID(v.ID)
 }
 
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by ID.
+func (_u *StockMovementUpdateOne) SetWarehouseID(id int) *StockMovementUpdateOne {
+	_u.mutation.SetWarehouseID(id)
+	return _u
+}
+
+// SetNillableWarehouseID sets the "warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableWarehouseID(id *int) *StockMovementUpdateOne {
+	if id != nil {
+		_u = _u.SetWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetWarehouse sets the "warehouse" edge to the Warehouse entity.
+func (_u *StockMovementUpdateOne) SetWarehouse(v *Warehouse) *StockMovementUpdateOne {
+	return _u.SetWarehouseID(v.ID)
+}
+
+// SetTransferID sets the "transfer" edge to the TransferOrder entity by ID.
+func (_u *StockMovementUpdateOne) SetTransferID(id int) *StockMovementUpdateOne {
+	_u.mutation.SetTransferID(id)
+	return _u
+}
+
+// SetNillableTransferID sets the "transfer" edge to the TransferOrder entity by ID if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableTransferID(id *int) *StockMovementUpdateOne {
+	if id != nil {
+		_u = _u.SetTransferID(*id)
+	}
+	return _u
+}
+
+// SetTransfer sets the "transfer" edge to the TransferOrder entity.
+func (_u *StockMovementUpdateOne) SetTransfer(v *TransferOrder) *StockMovementUpdateOne {
+	return _u.SetTransferID(v.ID)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *StockMovementUpdateOne) SetTransactionID(id int) *StockMovementUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableTransactionID(id *int) *StockMovementUpdateOne {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *StockMovementUpdateOne) SetTransaction(v *Transaction) *StockMovementUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
 // Mutation returns the StockMovementMutation object of the builder.
 func (_u *StockMovementUpdateOne) Mutation() *StockMovementMutation {
 	return _u.mutation
@@ -526,6 +887,24 @@ func (_u *StockMovementUpdateOne) ClearTenant() *StockMovementUpdateOne {
 	return _u
 }
 
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (_u *StockMovementUpdateOne) ClearWarehouse() *StockMovementUpdateOne {
+	_u.mutation.ClearWarehouse()
+	return _u
+}
+
+// ClearTransfer clears the "transfer" edge to the TransferOrder entity.
+func (_u *StockMovementUpdateOne) ClearTransfer() *StockMovementUpdateOne {
+	_u.mutation.ClearTransfer()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *StockMovementUpdateOne) ClearTransaction() *StockMovementUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
 // Where appends a list predicates to the StockMovementUpdate builder.
 func (_u *StockMovementUpdateOne) Where(ps ...predicate.StockMovement) *StockMovementUpdateOne {
 	_u.mutation.Where(ps...)
@@ -629,6 +1008,24 @@ func (_u *StockMovementUpdateOne) sqlSave(ctx context.Context) (_node *StockMove
 	if _u.mutation.ReasonCleared() {
 		_spec.ClearField(stockmovement.FieldReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.Bin(); ok {
+		_spec.SetField(stockmovement.FieldBin, field.TypeString, value)
+	}
+	if _u.mutation.BinCleared() {
+		_spec.ClearField(stockmovement.FieldBin, field.TypeString)
+	}
+	if value, ok := _u.mutation.LotNumber(); ok {
+		_spec.SetField(stockmovement.FieldLotNumber, field.TypeString, value)
+	}
+	if _u.mutation.LotNumberCleared() {
+		_spec.ClearField(stockmovement.FieldLotNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiryDate(); ok {
+		_spec.SetField(stockmovement.FieldExpiryDate, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryDateCleared() {
+		_spec.ClearField(stockmovement.FieldExpiryDate, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UnitCost(); ok {
 		_spec.SetField(stockmovement.FieldUnitCost, field.TypeOther, value)
 	}
@@ -711,6 +1108,93 @@ func (_u *StockMovementUpdateOne) sqlSave(ctx context.Context) (_node *StockMove
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.WarehouseTable,
+			Columns: []string{stockmovement.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.WarehouseTable,
+			Columns: []string{stockmovement.WarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransferTable,
+			Columns: []string{stockmovement.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransferTable,
+			Columns: []string{stockmovement.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransactionTable,
+			Columns: []string{stockmovement.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.TransactionTable,
+			Columns: []string{stockmovement.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &StockMovement{config: _u.config}
 	_spec.Assign = _node.assignValues
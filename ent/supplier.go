@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
 )
 
 // Supplier is the model entity for the Supplier schema.
@@ -29,6 +30,24 @@ type Supplier struct {
 	Address string `json:"address,omitempty"`
 	// Website holds the value of the "website" field.
 	Website string `json:"website,omitempty"`
+	// TaxID holds the value of the "tax_id" field.
+	TaxID string `json:"tax_id,omitempty"`
+	// CountryCode holds the value of the "country_code" field.
+	CountryCode string `json:"country_code,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// PaymentTermsDays holds the value of the "payment_terms_days" field.
+	PaymentTermsDays int `json:"payment_terms_days,omitempty"`
+	// LeadTimeDays holds the value of the "lead_time_days" field.
+	LeadTimeDays int `json:"lead_time_days,omitempty"`
+	// BankName holds the value of the "bank_name" field.
+	BankName string `json:"bank_name,omitempty"`
+	// BankAccount holds the value of the "bank_account" field.
+	BankAccount string `json:"bank_account,omitempty"`
+	// PriceTolerance holds the value of the "price_tolerance" field.
+	PriceTolerance decimal.Decimal `json:"price_tolerance,omitempty"`
+	// QuantityTolerance holds the value of the "quantity_tolerance" field.
+	QuantityTolerance decimal.Decimal `json:"quantity_tolerance,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SupplierQuery when eager-loading is set.
 	Edges            SupplierEdges `json:"edges"`
@@ -44,9 +63,11 @@ type SupplierEdges struct {
 	Products []*Product `json:"products,omitempty"`
 	// PurchaseOrders holds the value of the purchase_orders edge.
 	PurchaseOrders []*PurchaseOrder `json:"purchase_orders,omitempty"`
+	// Bills holds the value of the bills edge.
+	Bills []*SupplierBill `json:"bills,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -78,14 +99,25 @@ func (e SupplierEdges) PurchaseOrdersOrErr() ([]*PurchaseOrder, error) {
 	return nil, &NotLoadedError{edge: "purchase_orders"}
 }
 
+// BillsOrErr returns the Bills value or an error if the edge
+// was not loaded in eager-loading.
+func (e SupplierEdges) BillsOrErr() ([]*SupplierBill, error) {
+	if e.loadedTypes[3] {
+		return e.Bills, nil
+	}
+	return nil, &NotLoadedError{edge: "bills"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Supplier) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case supplier.FieldID:
+		case supplier.FieldPriceTolerance, supplier.FieldQuantityTolerance:
+			values[i] = new(decimal.Decimal)
+		case supplier.FieldID, supplier.FieldPaymentTermsDays, supplier.FieldLeadTimeDays:
 			values[i] = new(sql.NullInt64)
-		case supplier.FieldName, supplier.FieldContactPerson, supplier.FieldEmail, supplier.FieldPhone, supplier.FieldAddress, supplier.FieldWebsite:
+		case supplier.FieldName, supplier.FieldContactPerson, supplier.FieldEmail, supplier.FieldPhone, supplier.FieldAddress, supplier.FieldWebsite, supplier.FieldTaxID, supplier.FieldCountryCode, supplier.FieldCurrency, supplier.FieldBankName, supplier.FieldBankAccount:
 			values[i] = new(sql.NullString)
 		case supplier.ForeignKeys[0]: // tenant_suppliers
 			values[i] = new(sql.NullInt64)
@@ -146,6 +178,60 @@ func (_m *Supplier) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Website = value.String
 			}
+		case supplier.FieldTaxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_id", values[i])
+			} else if value.Valid {
+				_m.TaxID = value.String
+			}
+		case supplier.FieldCountryCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country_code", values[i])
+			} else if value.Valid {
+				_m.CountryCode = value.String
+			}
+		case supplier.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case supplier.FieldPaymentTermsDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_terms_days", values[i])
+			} else if value.Valid {
+				_m.PaymentTermsDays = int(value.Int64)
+			}
+		case supplier.FieldLeadTimeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_time_days", values[i])
+			} else if value.Valid {
+				_m.LeadTimeDays = int(value.Int64)
+			}
+		case supplier.FieldBankName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_name", values[i])
+			} else if value.Valid {
+				_m.BankName = value.String
+			}
+		case supplier.FieldBankAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_account", values[i])
+			} else if value.Valid {
+				_m.BankAccount = value.String
+			}
+		case supplier.FieldPriceTolerance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price_tolerance", values[i])
+			} else if value != nil {
+				_m.PriceTolerance = *value
+			}
+		case supplier.FieldQuantityTolerance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity_tolerance", values[i])
+			} else if value != nil {
+				_m.QuantityTolerance = *value
+			}
 		case supplier.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field tenant_suppliers", value)
@@ -181,6 +267,11 @@ func (_m *Supplier) QueryPurchaseOrders() *PurchaseOrderQuery {
 	return NewSupplierClient(_m.config).QueryPurchaseOrders(_m)
 }
 
+// QueryBills queries the "bills" edge of the Supplier entity.
+func (_m *Supplier) QueryBills() *SupplierBillQuery {
+	return NewSupplierClient(_m.config).QueryBills(_m)
+}
+
 // Update returns a builder for updating this Supplier.
 // Note that you need to call Supplier.Unwrap() before calling this method if this Supplier
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -221,6 +312,33 @@ func (_m *Supplier) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("website=")
 	builder.WriteString(_m.Website)
+	builder.WriteString(", ")
+	builder.WriteString("tax_id=")
+	builder.WriteString(_m.TaxID)
+	builder.WriteString(", ")
+	builder.WriteString("country_code=")
+	builder.WriteString(_m.CountryCode)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("payment_terms_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentTermsDays))
+	builder.WriteString(", ")
+	builder.WriteString("lead_time_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeadTimeDays))
+	builder.WriteString(", ")
+	builder.WriteString("bank_name=")
+	builder.WriteString(_m.BankName)
+	builder.WriteString(", ")
+	builder.WriteString("bank_account=")
+	builder.WriteString(_m.BankAccount)
+	builder.WriteString(", ")
+	builder.WriteString("price_tolerance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceTolerance))
+	builder.WriteString(", ")
+	builder.WriteString("quantity_tolerance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuantityTolerance))
 	builder.WriteByte(')')
 	return builder.String()
 }
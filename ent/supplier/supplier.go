@@ -5,6 +5,7 @@ package supplier
 import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -24,12 +25,32 @@ const (
 	FieldAddress = "address"
 	// FieldWebsite holds the string denoting the website field in the database.
 	FieldWebsite = "website"
+	// FieldTaxID holds the string denoting the tax_id field in the database.
+	FieldTaxID = "tax_id"
+	// FieldCountryCode holds the string denoting the country_code field in the database.
+	FieldCountryCode = "country_code"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldPaymentTermsDays holds the string denoting the payment_terms_days field in the database.
+	FieldPaymentTermsDays = "payment_terms_days"
+	// FieldLeadTimeDays holds the string denoting the lead_time_days field in the database.
+	FieldLeadTimeDays = "lead_time_days"
+	// FieldBankName holds the string denoting the bank_name field in the database.
+	FieldBankName = "bank_name"
+	// FieldBankAccount holds the string denoting the bank_account field in the database.
+	FieldBankAccount = "bank_account"
+	// FieldPriceTolerance holds the string denoting the price_tolerance field in the database.
+	FieldPriceTolerance = "price_tolerance"
+	// FieldQuantityTolerance holds the string denoting the quantity_tolerance field in the database.
+	FieldQuantityTolerance = "quantity_tolerance"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
 	// EdgeProducts holds the string denoting the products edge name in mutations.
 	EdgeProducts = "products"
 	// EdgePurchaseOrders holds the string denoting the purchase_orders edge name in mutations.
 	EdgePurchaseOrders = "purchase_orders"
+	// EdgeBills holds the string denoting the bills edge name in mutations.
+	EdgeBills = "bills"
 	// Table holds the table name of the supplier in the database.
 	Table = "suppliers"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -53,6 +74,13 @@ const (
 	PurchaseOrdersInverseTable = "purchase_orders"
 	// PurchaseOrdersColumn is the table column denoting the purchase_orders relation/edge.
 	PurchaseOrdersColumn = "supplier_purchase_orders"
+	// BillsTable is the table that holds the bills relation/edge.
+	BillsTable = "supplier_bills"
+	// BillsInverseTable is the table name for the SupplierBill entity.
+	// It exists in this package in order to avoid circular dependency with the "supplierbill" package.
+	BillsInverseTable = "supplier_bills"
+	// BillsColumn is the table column denoting the bills relation/edge.
+	BillsColumn = "supplier_bills"
 )
 
 // Columns holds all SQL columns for supplier fields.
@@ -64,6 +92,15 @@ var Columns = []string{
 	FieldPhone,
 	FieldAddress,
 	FieldWebsite,
+	FieldTaxID,
+	FieldCountryCode,
+	FieldCurrency,
+	FieldPaymentTermsDays,
+	FieldLeadTimeDays,
+	FieldBankName,
+	FieldBankAccount,
+	FieldPriceTolerance,
+	FieldQuantityTolerance,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "suppliers"
@@ -90,6 +127,16 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultPaymentTermsDays holds the default value on creation for the "payment_terms_days" field.
+	DefaultPaymentTermsDays int
+	// DefaultLeadTimeDays holds the default value on creation for the "lead_time_days" field.
+	DefaultLeadTimeDays int
+	// LeadTimeDaysValidator is a validator for the "lead_time_days" field. It is called by the builders before save.
+	LeadTimeDaysValidator func(int) error
+	// DefaultPriceTolerance holds the default value on creation for the "price_tolerance" field.
+	DefaultPriceTolerance decimal.Decimal
+	// DefaultQuantityTolerance holds the default value on creation for the "quantity_tolerance" field.
+	DefaultQuantityTolerance decimal.Decimal
 )
 
 // OrderOption defines the ordering options for the Supplier queries.
@@ -130,6 +177,51 @@ func ByWebsite(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWebsite, opts...).ToFunc()
 }
 
+// ByTaxID orders the results by the tax_id field.
+func ByTaxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxID, opts...).ToFunc()
+}
+
+// ByCountryCode orders the results by the country_code field.
+func ByCountryCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountryCode, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByPaymentTermsDays orders the results by the payment_terms_days field.
+func ByPaymentTermsDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentTermsDays, opts...).ToFunc()
+}
+
+// ByLeadTimeDays orders the results by the lead_time_days field.
+func ByLeadTimeDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeadTimeDays, opts...).ToFunc()
+}
+
+// ByBankName orders the results by the bank_name field.
+func ByBankName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankName, opts...).ToFunc()
+}
+
+// ByBankAccount orders the results by the bank_account field.
+func ByBankAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankAccount, opts...).ToFunc()
+}
+
+// ByPriceTolerance orders the results by the price_tolerance field.
+func ByPriceTolerance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceTolerance, opts...).ToFunc()
+}
+
+// ByQuantityTolerance orders the results by the quantity_tolerance field.
+func ByQuantityTolerance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantityTolerance, opts...).ToFunc()
+}
+
 // ByTenantField orders the results by tenant field.
 func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -164,6 +256,20 @@ func ByPurchaseOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPurchaseOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBillsCount orders the results by bills count.
+func ByBillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBillsStep(), opts...)
+	}
+}
+
+// ByBills orders the results by bills terms.
+func ByBills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -185,3 +291,10 @@ func newPurchaseOrdersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PurchaseOrdersTable, PurchaseOrdersColumn),
 	)
 }
+func newBillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BillsTable, BillsColumn),
+	)
+}
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -84,6 +85,51 @@ func Website(v string) predicate.Supplier {
 	return predicate.Supplier(sql.FieldEQ(FieldWebsite, v))
 }
 
+// TaxID applies equality check predicate on the "tax_id" field. It's identical to TaxIDEQ.
+func TaxID(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldTaxID, v))
+}
+
+// CountryCode applies equality check predicate on the "country_code" field. It's identical to CountryCodeEQ.
+func CountryCode(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldCountryCode, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldCurrency, v))
+}
+
+// PaymentTermsDays applies equality check predicate on the "payment_terms_days" field. It's identical to PaymentTermsDaysEQ.
+func PaymentTermsDays(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldPaymentTermsDays, v))
+}
+
+// LeadTimeDays applies equality check predicate on the "lead_time_days" field. It's identical to LeadTimeDaysEQ.
+func LeadTimeDays(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldLeadTimeDays, v))
+}
+
+// BankName applies equality check predicate on the "bank_name" field. It's identical to BankNameEQ.
+func BankName(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldBankName, v))
+}
+
+// BankAccount applies equality check predicate on the "bank_account" field. It's identical to BankAccountEQ.
+func BankAccount(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldBankAccount, v))
+}
+
+// PriceTolerance applies equality check predicate on the "price_tolerance" field. It's identical to PriceToleranceEQ.
+func PriceTolerance(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldPriceTolerance, v))
+}
+
+// QuantityTolerance applies equality check predicate on the "quantity_tolerance" field. It's identical to QuantityToleranceEQ.
+func QuantityTolerance(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldQuantityTolerance, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Supplier {
 	return predicate.Supplier(sql.FieldEQ(FieldName, v))
@@ -524,6 +570,541 @@ func WebsiteContainsFold(v string) predicate.Supplier {
 	return predicate.Supplier(sql.FieldContainsFold(FieldWebsite, v))
 }
 
+// TaxIDEQ applies the EQ predicate on the "tax_id" field.
+func TaxIDEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldTaxID, v))
+}
+
+// TaxIDNEQ applies the NEQ predicate on the "tax_id" field.
+func TaxIDNEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldTaxID, v))
+}
+
+// TaxIDIn applies the In predicate on the "tax_id" field.
+func TaxIDIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldTaxID, vs...))
+}
+
+// TaxIDNotIn applies the NotIn predicate on the "tax_id" field.
+func TaxIDNotIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldTaxID, vs...))
+}
+
+// TaxIDGT applies the GT predicate on the "tax_id" field.
+func TaxIDGT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldTaxID, v))
+}
+
+// TaxIDGTE applies the GTE predicate on the "tax_id" field.
+func TaxIDGTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldTaxID, v))
+}
+
+// TaxIDLT applies the LT predicate on the "tax_id" field.
+func TaxIDLT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldTaxID, v))
+}
+
+// TaxIDLTE applies the LTE predicate on the "tax_id" field.
+func TaxIDLTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldTaxID, v))
+}
+
+// TaxIDContains applies the Contains predicate on the "tax_id" field.
+func TaxIDContains(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContains(FieldTaxID, v))
+}
+
+// TaxIDHasPrefix applies the HasPrefix predicate on the "tax_id" field.
+func TaxIDHasPrefix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasPrefix(FieldTaxID, v))
+}
+
+// TaxIDHasSuffix applies the HasSuffix predicate on the "tax_id" field.
+func TaxIDHasSuffix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasSuffix(FieldTaxID, v))
+}
+
+// TaxIDIsNil applies the IsNil predicate on the "tax_id" field.
+func TaxIDIsNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldIsNull(FieldTaxID))
+}
+
+// TaxIDNotNil applies the NotNil predicate on the "tax_id" field.
+func TaxIDNotNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotNull(FieldTaxID))
+}
+
+// TaxIDEqualFold applies the EqualFold predicate on the "tax_id" field.
+func TaxIDEqualFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEqualFold(FieldTaxID, v))
+}
+
+// TaxIDContainsFold applies the ContainsFold predicate on the "tax_id" field.
+func TaxIDContainsFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContainsFold(FieldTaxID, v))
+}
+
+// CountryCodeEQ applies the EQ predicate on the "country_code" field.
+func CountryCodeEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldCountryCode, v))
+}
+
+// CountryCodeNEQ applies the NEQ predicate on the "country_code" field.
+func CountryCodeNEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldCountryCode, v))
+}
+
+// CountryCodeIn applies the In predicate on the "country_code" field.
+func CountryCodeIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldCountryCode, vs...))
+}
+
+// CountryCodeNotIn applies the NotIn predicate on the "country_code" field.
+func CountryCodeNotIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldCountryCode, vs...))
+}
+
+// CountryCodeGT applies the GT predicate on the "country_code" field.
+func CountryCodeGT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldCountryCode, v))
+}
+
+// CountryCodeGTE applies the GTE predicate on the "country_code" field.
+func CountryCodeGTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldCountryCode, v))
+}
+
+// CountryCodeLT applies the LT predicate on the "country_code" field.
+func CountryCodeLT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldCountryCode, v))
+}
+
+// CountryCodeLTE applies the LTE predicate on the "country_code" field.
+func CountryCodeLTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldCountryCode, v))
+}
+
+// CountryCodeContains applies the Contains predicate on the "country_code" field.
+func CountryCodeContains(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContains(FieldCountryCode, v))
+}
+
+// CountryCodeHasPrefix applies the HasPrefix predicate on the "country_code" field.
+func CountryCodeHasPrefix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasPrefix(FieldCountryCode, v))
+}
+
+// CountryCodeHasSuffix applies the HasSuffix predicate on the "country_code" field.
+func CountryCodeHasSuffix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasSuffix(FieldCountryCode, v))
+}
+
+// CountryCodeIsNil applies the IsNil predicate on the "country_code" field.
+func CountryCodeIsNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldIsNull(FieldCountryCode))
+}
+
+// CountryCodeNotNil applies the NotNil predicate on the "country_code" field.
+func CountryCodeNotNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotNull(FieldCountryCode))
+}
+
+// CountryCodeEqualFold applies the EqualFold predicate on the "country_code" field.
+func CountryCodeEqualFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEqualFold(FieldCountryCode, v))
+}
+
+// CountryCodeContainsFold applies the ContainsFold predicate on the "country_code" field.
+func CountryCodeContainsFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContainsFold(FieldCountryCode, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PaymentTermsDaysEQ applies the EQ predicate on the "payment_terms_days" field.
+func PaymentTermsDaysEQ(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysNEQ applies the NEQ predicate on the "payment_terms_days" field.
+func PaymentTermsDaysNEQ(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysIn applies the In predicate on the "payment_terms_days" field.
+func PaymentTermsDaysIn(vs ...int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldPaymentTermsDays, vs...))
+}
+
+// PaymentTermsDaysNotIn applies the NotIn predicate on the "payment_terms_days" field.
+func PaymentTermsDaysNotIn(vs ...int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldPaymentTermsDays, vs...))
+}
+
+// PaymentTermsDaysGT applies the GT predicate on the "payment_terms_days" field.
+func PaymentTermsDaysGT(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysGTE applies the GTE predicate on the "payment_terms_days" field.
+func PaymentTermsDaysGTE(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysLT applies the LT predicate on the "payment_terms_days" field.
+func PaymentTermsDaysLT(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysLTE applies the LTE predicate on the "payment_terms_days" field.
+func PaymentTermsDaysLTE(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldPaymentTermsDays, v))
+}
+
+// LeadTimeDaysEQ applies the EQ predicate on the "lead_time_days" field.
+func LeadTimeDaysEQ(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysNEQ applies the NEQ predicate on the "lead_time_days" field.
+func LeadTimeDaysNEQ(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysIn applies the In predicate on the "lead_time_days" field.
+func LeadTimeDaysIn(vs ...int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldLeadTimeDays, vs...))
+}
+
+// LeadTimeDaysNotIn applies the NotIn predicate on the "lead_time_days" field.
+func LeadTimeDaysNotIn(vs ...int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldLeadTimeDays, vs...))
+}
+
+// LeadTimeDaysGT applies the GT predicate on the "lead_time_days" field.
+func LeadTimeDaysGT(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysGTE applies the GTE predicate on the "lead_time_days" field.
+func LeadTimeDaysGTE(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysLT applies the LT predicate on the "lead_time_days" field.
+func LeadTimeDaysLT(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysLTE applies the LTE predicate on the "lead_time_days" field.
+func LeadTimeDaysLTE(v int) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldLeadTimeDays, v))
+}
+
+// BankNameEQ applies the EQ predicate on the "bank_name" field.
+func BankNameEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldBankName, v))
+}
+
+// BankNameNEQ applies the NEQ predicate on the "bank_name" field.
+func BankNameNEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldBankName, v))
+}
+
+// BankNameIn applies the In predicate on the "bank_name" field.
+func BankNameIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldBankName, vs...))
+}
+
+// BankNameNotIn applies the NotIn predicate on the "bank_name" field.
+func BankNameNotIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldBankName, vs...))
+}
+
+// BankNameGT applies the GT predicate on the "bank_name" field.
+func BankNameGT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldBankName, v))
+}
+
+// BankNameGTE applies the GTE predicate on the "bank_name" field.
+func BankNameGTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldBankName, v))
+}
+
+// BankNameLT applies the LT predicate on the "bank_name" field.
+func BankNameLT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldBankName, v))
+}
+
+// BankNameLTE applies the LTE predicate on the "bank_name" field.
+func BankNameLTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldBankName, v))
+}
+
+// BankNameContains applies the Contains predicate on the "bank_name" field.
+func BankNameContains(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContains(FieldBankName, v))
+}
+
+// BankNameHasPrefix applies the HasPrefix predicate on the "bank_name" field.
+func BankNameHasPrefix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasPrefix(FieldBankName, v))
+}
+
+// BankNameHasSuffix applies the HasSuffix predicate on the "bank_name" field.
+func BankNameHasSuffix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasSuffix(FieldBankName, v))
+}
+
+// BankNameIsNil applies the IsNil predicate on the "bank_name" field.
+func BankNameIsNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldIsNull(FieldBankName))
+}
+
+// BankNameNotNil applies the NotNil predicate on the "bank_name" field.
+func BankNameNotNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotNull(FieldBankName))
+}
+
+// BankNameEqualFold applies the EqualFold predicate on the "bank_name" field.
+func BankNameEqualFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEqualFold(FieldBankName, v))
+}
+
+// BankNameContainsFold applies the ContainsFold predicate on the "bank_name" field.
+func BankNameContainsFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContainsFold(FieldBankName, v))
+}
+
+// BankAccountEQ applies the EQ predicate on the "bank_account" field.
+func BankAccountEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldBankAccount, v))
+}
+
+// BankAccountNEQ applies the NEQ predicate on the "bank_account" field.
+func BankAccountNEQ(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldBankAccount, v))
+}
+
+// BankAccountIn applies the In predicate on the "bank_account" field.
+func BankAccountIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldBankAccount, vs...))
+}
+
+// BankAccountNotIn applies the NotIn predicate on the "bank_account" field.
+func BankAccountNotIn(vs ...string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldBankAccount, vs...))
+}
+
+// BankAccountGT applies the GT predicate on the "bank_account" field.
+func BankAccountGT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldBankAccount, v))
+}
+
+// BankAccountGTE applies the GTE predicate on the "bank_account" field.
+func BankAccountGTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldBankAccount, v))
+}
+
+// BankAccountLT applies the LT predicate on the "bank_account" field.
+func BankAccountLT(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldBankAccount, v))
+}
+
+// BankAccountLTE applies the LTE predicate on the "bank_account" field.
+func BankAccountLTE(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldBankAccount, v))
+}
+
+// BankAccountContains applies the Contains predicate on the "bank_account" field.
+func BankAccountContains(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContains(FieldBankAccount, v))
+}
+
+// BankAccountHasPrefix applies the HasPrefix predicate on the "bank_account" field.
+func BankAccountHasPrefix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasPrefix(FieldBankAccount, v))
+}
+
+// BankAccountHasSuffix applies the HasSuffix predicate on the "bank_account" field.
+func BankAccountHasSuffix(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldHasSuffix(FieldBankAccount, v))
+}
+
+// BankAccountIsNil applies the IsNil predicate on the "bank_account" field.
+func BankAccountIsNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldIsNull(FieldBankAccount))
+}
+
+// BankAccountNotNil applies the NotNil predicate on the "bank_account" field.
+func BankAccountNotNil() predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotNull(FieldBankAccount))
+}
+
+// BankAccountEqualFold applies the EqualFold predicate on the "bank_account" field.
+func BankAccountEqualFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEqualFold(FieldBankAccount, v))
+}
+
+// BankAccountContainsFold applies the ContainsFold predicate on the "bank_account" field.
+func BankAccountContainsFold(v string) predicate.Supplier {
+	return predicate.Supplier(sql.FieldContainsFold(FieldBankAccount, v))
+}
+
+// PriceToleranceEQ applies the EQ predicate on the "price_tolerance" field.
+func PriceToleranceEQ(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldPriceTolerance, v))
+}
+
+// PriceToleranceNEQ applies the NEQ predicate on the "price_tolerance" field.
+func PriceToleranceNEQ(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldPriceTolerance, v))
+}
+
+// PriceToleranceIn applies the In predicate on the "price_tolerance" field.
+func PriceToleranceIn(vs ...decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldPriceTolerance, vs...))
+}
+
+// PriceToleranceNotIn applies the NotIn predicate on the "price_tolerance" field.
+func PriceToleranceNotIn(vs ...decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldPriceTolerance, vs...))
+}
+
+// PriceToleranceGT applies the GT predicate on the "price_tolerance" field.
+func PriceToleranceGT(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldPriceTolerance, v))
+}
+
+// PriceToleranceGTE applies the GTE predicate on the "price_tolerance" field.
+func PriceToleranceGTE(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldPriceTolerance, v))
+}
+
+// PriceToleranceLT applies the LT predicate on the "price_tolerance" field.
+func PriceToleranceLT(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldPriceTolerance, v))
+}
+
+// PriceToleranceLTE applies the LTE predicate on the "price_tolerance" field.
+func PriceToleranceLTE(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldPriceTolerance, v))
+}
+
+// QuantityToleranceEQ applies the EQ predicate on the "quantity_tolerance" field.
+func QuantityToleranceEQ(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldEQ(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceNEQ applies the NEQ predicate on the "quantity_tolerance" field.
+func QuantityToleranceNEQ(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNEQ(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceIn applies the In predicate on the "quantity_tolerance" field.
+func QuantityToleranceIn(vs ...decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldIn(FieldQuantityTolerance, vs...))
+}
+
+// QuantityToleranceNotIn applies the NotIn predicate on the "quantity_tolerance" field.
+func QuantityToleranceNotIn(vs ...decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldNotIn(FieldQuantityTolerance, vs...))
+}
+
+// QuantityToleranceGT applies the GT predicate on the "quantity_tolerance" field.
+func QuantityToleranceGT(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGT(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceGTE applies the GTE predicate on the "quantity_tolerance" field.
+func QuantityToleranceGTE(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldGTE(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceLT applies the LT predicate on the "quantity_tolerance" field.
+func QuantityToleranceLT(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLT(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceLTE applies the LTE predicate on the "quantity_tolerance" field.
+func QuantityToleranceLTE(v decimal.Decimal) predicate.Supplier {
+	return predicate.Supplier(sql.FieldLTE(FieldQuantityTolerance, v))
+}
+
 // HasTenant applies the HasEdge predicate on the "tenant" edge.
 func HasTenant() predicate.Supplier {
 	return predicate.Supplier(func(s *sql.Selector) {
@@ -593,6 +1174,29 @@ func HasPurchaseOrdersWith(preds ...predicate.PurchaseOrder) predicate.Supplier
 	})
 }
 
+// HasBills applies the HasEdge predicate on the "bills" edge.
+func HasBills() predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BillsTable, BillsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillsWith applies the HasEdge predicate on the "bills" edge with a given conditions (other predicates).
+func HasBillsWith(preds ...predicate.SupplierBill) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		step := newBillsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Supplier) predicate.Supplier {
 	return predicate.Supplier(sql.AndPredicates(predicates...))
@@ -9,10 +9,12 @@ import (
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
 	"sent/ent/tenant"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // SupplierCreate is the builder for creating a Supplier entity.
@@ -98,6 +100,132 @@ func (_c *SupplierCreate) SetNillableWebsite(v *string) *SupplierCreate {
 	return _c
 }
 
+// SetTaxID sets the "tax_id" field.
+func (_c *SupplierCreate) SetTaxID(v string) *SupplierCreate {
+	_c.mutation.SetTaxID(v)
+	return _c
+}
+
+// SetNillableTaxID sets the "tax_id" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillableTaxID(v *string) *SupplierCreate {
+	if v != nil {
+		_c.SetTaxID(*v)
+	}
+	return _c
+}
+
+// SetCountryCode sets the "country_code" field.
+func (_c *SupplierCreate) SetCountryCode(v string) *SupplierCreate {
+	_c.mutation.SetCountryCode(v)
+	return _c
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillableCountryCode(v *string) *SupplierCreate {
+	if v != nil {
+		_c.SetCountryCode(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *SupplierCreate) SetCurrency(v string) *SupplierCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillableCurrency(v *string) *SupplierCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_c *SupplierCreate) SetPaymentTermsDays(v int) *SupplierCreate {
+	_c.mutation.SetPaymentTermsDays(v)
+	return _c
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillablePaymentTermsDays(v *int) *SupplierCreate {
+	if v != nil {
+		_c.SetPaymentTermsDays(*v)
+	}
+	return _c
+}
+
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (_c *SupplierCreate) SetLeadTimeDays(v int) *SupplierCreate {
+	_c.mutation.SetLeadTimeDays(v)
+	return _c
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillableLeadTimeDays(v *int) *SupplierCreate {
+	if v != nil {
+		_c.SetLeadTimeDays(*v)
+	}
+	return _c
+}
+
+// SetBankName sets the "bank_name" field.
+func (_c *SupplierCreate) SetBankName(v string) *SupplierCreate {
+	_c.mutation.SetBankName(v)
+	return _c
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillableBankName(v *string) *SupplierCreate {
+	if v != nil {
+		_c.SetBankName(*v)
+	}
+	return _c
+}
+
+// SetBankAccount sets the "bank_account" field.
+func (_c *SupplierCreate) SetBankAccount(v string) *SupplierCreate {
+	_c.mutation.SetBankAccount(v)
+	return _c
+}
+
+// SetNillableBankAccount sets the "bank_account" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillableBankAccount(v *string) *SupplierCreate {
+	if v != nil {
+		_c.SetBankAccount(*v)
+	}
+	return _c
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (_c *SupplierCreate) SetPriceTolerance(v decimal.Decimal) *SupplierCreate {
+	_c.mutation.SetPriceTolerance(v)
+	return _c
+}
+
+// SetNillablePriceTolerance sets the "price_tolerance" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillablePriceTolerance(v *decimal.Decimal) *SupplierCreate {
+	if v != nil {
+		_c.SetPriceTolerance(*v)
+	}
+	return _c
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (_c *SupplierCreate) SetQuantityTolerance(v decimal.Decimal) *SupplierCreate {
+	_c.mutation.SetQuantityTolerance(v)
+	return _c
+}
+
+// SetNillableQuantityTolerance sets the "quantity_tolerance" field if the given value is not nil.
+func (_c *SupplierCreate) SetNillableQuantityTolerance(v *decimal.Decimal) *SupplierCreate {
+	if v != nil {
+		_c.SetQuantityTolerance(*v)
+	}
+	return _c
+}
+
 // SetTenantID sets the "tenant" edge to the Tenant entity by ID.
 func (_c *SupplierCreate) SetTenantID(id int) *SupplierCreate {
 	_c.mutation.SetTenantID(id)
@@ -139,6 +267,21 @@ func (_c *SupplierCreate) AddPurchaseOrders(v ...*PurchaseOrder) *SupplierCreate
 	return _c.AddPurchaseOrderIDs(ids...)
 }
 
+// AddBillIDs adds the "bills" edge to the SupplierBill entity by IDs.
+func (_c *SupplierCreate) AddBillIDs(ids ...int) *SupplierCreate {
+	_c.mutation.AddBillIDs(ids...)
+	return _c
+}
+
+// AddBills adds the "bills" edges to the SupplierBill entity.
+func (_c *SupplierCreate) AddBills(v ...*SupplierBill) *SupplierCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBillIDs(ids...)
+}
+
 // Mutation returns the SupplierMutation object of the builder.
 func (_c *SupplierCreate) Mutation() *SupplierMutation {
 	return _c.mutation
@@ -146,6 +289,7 @@ func (_c *SupplierCreate) Mutation() *SupplierMutation {
 
 // Save creates the Supplier in the database.
 func (_c *SupplierCreate) Save(ctx context.Context) (*Supplier, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -171,6 +315,26 @@ func (_c *SupplierCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *SupplierCreate) defaults() {
+	if _, ok := _c.mutation.PaymentTermsDays(); !ok {
+		v := supplier.DefaultPaymentTermsDays
+		_c.mutation.SetPaymentTermsDays(v)
+	}
+	if _, ok := _c.mutation.LeadTimeDays(); !ok {
+		v := supplier.DefaultLeadTimeDays
+		_c.mutation.SetLeadTimeDays(v)
+	}
+	if _, ok := _c.mutation.PriceTolerance(); !ok {
+		v := supplier.DefaultPriceTolerance
+		_c.mutation.SetPriceTolerance(v)
+	}
+	if _, ok := _c.mutation.QuantityTolerance(); !ok {
+		v := supplier.DefaultQuantityTolerance
+		_c.mutation.SetQuantityTolerance(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *SupplierCreate) check() error {
 	if _, ok := _c.mutation.Name(); !ok {
@@ -181,6 +345,23 @@ func (_c *SupplierCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Supplier.name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.PaymentTermsDays(); !ok {
+		return &ValidationError{Name: "payment_terms_days", err: errors.New(`ent: missing required field "Supplier.payment_terms_days"`)}
+	}
+	if _, ok := _c.mutation.LeadTimeDays(); !ok {
+		return &ValidationError{Name: "lead_time_days", err: errors.New(`ent: missing required field "Supplier.lead_time_days"`)}
+	}
+	if v, ok := _c.mutation.LeadTimeDays(); ok {
+		if err := supplier.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "Supplier.lead_time_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PriceTolerance(); !ok {
+		return &ValidationError{Name: "price_tolerance", err: errors.New(`ent: missing required field "Supplier.price_tolerance"`)}
+	}
+	if _, ok := _c.mutation.QuantityTolerance(); !ok {
+		return &ValidationError{Name: "quantity_tolerance", err: errors.New(`ent: missing required field "Supplier.quantity_tolerance"`)}
+	}
 	if len(_c.mutation.TenantIDs()) == 0 {
 		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "Supplier.tenant"`)}
 	}
@@ -234,6 +415,42 @@ func (_c *SupplierCreate) createSpec() (*Supplier, *sqlgraph.CreateSpec) {
 		_spec.SetField(supplier.FieldWebsite, field.TypeString, value)
 		_node.Website = value
 	}
+	if value, ok := _c.mutation.TaxID(); ok {
+		_spec.SetField(supplier.FieldTaxID, field.TypeString, value)
+		_node.TaxID = value
+	}
+	if value, ok := _c.mutation.CountryCode(); ok {
+		_spec.SetField(supplier.FieldCountryCode, field.TypeString, value)
+		_node.CountryCode = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(supplier.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(supplier.FieldPaymentTermsDays, field.TypeInt, value)
+		_node.PaymentTermsDays = value
+	}
+	if value, ok := _c.mutation.LeadTimeDays(); ok {
+		_spec.SetField(supplier.FieldLeadTimeDays, field.TypeInt, value)
+		_node.LeadTimeDays = value
+	}
+	if value, ok := _c.mutation.BankName(); ok {
+		_spec.SetField(supplier.FieldBankName, field.TypeString, value)
+		_node.BankName = value
+	}
+	if value, ok := _c.mutation.BankAccount(); ok {
+		_spec.SetField(supplier.FieldBankAccount, field.TypeString, value)
+		_node.BankAccount = value
+	}
+	if value, ok := _c.mutation.PriceTolerance(); ok {
+		_spec.SetField(supplier.FieldPriceTolerance, field.TypeOther, value)
+		_node.PriceTolerance = value
+	}
+	if value, ok := _c.mutation.QuantityTolerance(); ok {
+		_spec.SetField(supplier.FieldQuantityTolerance, field.TypeOther, value)
+		_node.QuantityTolerance = value
+	}
 	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -283,6 +500,22 @@ func (_c *SupplierCreate) createSpec() (*Supplier, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplier.BillsTable,
+			Columns: []string{supplier.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -304,6 +537,7 @@ func (_c *SupplierCreateBulk) Save(ctx context.Context) ([]*Supplier, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SupplierMutation)
 				if !ok {
@@ -11,6 +11,7 @@ import (
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
 	"sent/ent/tenant"
 
 	"entgo.io/ent"
@@ -29,6 +30,7 @@ type SupplierQuery struct {
 	withTenant         *TenantQuery
 	withProducts       *ProductQuery
 	withPurchaseOrders *PurchaseOrderQuery
+	withBills          *SupplierBillQuery
 	withFKs            bool
 	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -133,6 +135,28 @@ func (_q *SupplierQuery) QueryPurchaseOrders() *PurchaseOrderQuery {
 	return query
 }
 
+// QueryBills chains the current query on the "bills" edge.
+func (_q *SupplierQuery) QueryBills() *SupplierBillQuery {
+	query := (&SupplierBillClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplier.Table, supplier.FieldID, selector),
+			sqlgraph.To(supplierbill.Table, supplierbill.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, supplier.BillsTable, supplier.BillsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Supplier entity from the query.
 // Returns a *NotFoundError when no Supplier was found.
 func (_q *SupplierQuery) First(ctx context.Context) (*Supplier, error) {
@@ -328,6 +352,7 @@ func (_q *SupplierQuery) Clone() *SupplierQuery {
 		withTenant:         _q.withTenant.Clone(),
 		withProducts:       _q.withProducts.Clone(),
 		withPurchaseOrders: _q.withPurchaseOrders.Clone(),
+		withBills:          _q.withBills.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -368,6 +393,17 @@ func (_q *SupplierQuery) WithPurchaseOrders(opts ...func(*PurchaseOrderQuery)) *
 	return _q
 }
 
+// WithBills tells the query-builder to eager-load the nodes that are connected to
+// the "bills" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierQuery) WithBills(opts ...func(*SupplierBillQuery)) *SupplierQuery {
+	query := (&SupplierBillClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBills = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -447,10 +483,11 @@ func (_q *SupplierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sup
 		nodes       = []*Supplier{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withTenant != nil,
 			_q.withProducts != nil,
 			_q.withPurchaseOrders != nil,
+			_q.withBills != nil,
 		}
 	)
 	if _q.withTenant != nil {
@@ -500,6 +537,13 @@ func (_q *SupplierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sup
 			return nil, err
 		}
 	}
+	if query := _q.withBills; query != nil {
+		if err := _q.loadBills(ctx, query, nodes,
+			func(n *Supplier) { n.Edges.Bills = []*SupplierBill{} },
+			func(n *Supplier, e *SupplierBill) { n.Edges.Bills = append(n.Edges.Bills, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -597,6 +641,37 @@ func (_q *SupplierQuery) loadPurchaseOrders(ctx context.Context, query *Purchase
 	}
 	return nil
 }
+func (_q *SupplierQuery) loadBills(ctx context.Context, query *SupplierBillQuery, nodes []*Supplier, init func(*Supplier), assign func(*Supplier, *SupplierBill)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Supplier)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SupplierBill(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(supplier.BillsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.supplier_bills
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "supplier_bills" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "supplier_bills" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *SupplierQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
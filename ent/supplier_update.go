@@ -10,11 +10,13 @@ import (
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
 	"sent/ent/tenant"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // SupplierUpdate is the builder for updating Supplier entities.
@@ -145,6 +147,176 @@ func (_u *SupplierUpdate) ClearWebsite() *SupplierUpdate {
 	return _u
 }
 
+// SetTaxID sets the "tax_id" field.
+func (_u *SupplierUpdate) SetTaxID(v string) *SupplierUpdate {
+	_u.mutation.SetTaxID(v)
+	return _u
+}
+
+// SetNillableTaxID sets the "tax_id" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillableTaxID(v *string) *SupplierUpdate {
+	if v != nil {
+		_u.SetTaxID(*v)
+	}
+	return _u
+}
+
+// ClearTaxID clears the value of the "tax_id" field.
+func (_u *SupplierUpdate) ClearTaxID() *SupplierUpdate {
+	_u.mutation.ClearTaxID()
+	return _u
+}
+
+// SetCountryCode sets the "country_code" field.
+func (_u *SupplierUpdate) SetCountryCode(v string) *SupplierUpdate {
+	_u.mutation.SetCountryCode(v)
+	return _u
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillableCountryCode(v *string) *SupplierUpdate {
+	if v != nil {
+		_u.SetCountryCode(*v)
+	}
+	return _u
+}
+
+// ClearCountryCode clears the value of the "country_code" field.
+func (_u *SupplierUpdate) ClearCountryCode() *SupplierUpdate {
+	_u.mutation.ClearCountryCode()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SupplierUpdate) SetCurrency(v string) *SupplierUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillableCurrency(v *string) *SupplierUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *SupplierUpdate) ClearCurrency() *SupplierUpdate {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_u *SupplierUpdate) SetPaymentTermsDays(v int) *SupplierUpdate {
+	_u.mutation.ResetPaymentTermsDays()
+	_u.mutation.SetPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillablePaymentTermsDays(v *int) *SupplierUpdate {
+	if v != nil {
+		_u.SetPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentTermsDays adds value to the "payment_terms_days" field.
+func (_u *SupplierUpdate) AddPaymentTermsDays(v int) *SupplierUpdate {
+	_u.mutation.AddPaymentTermsDays(v)
+	return _u
+}
+
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (_u *SupplierUpdate) SetLeadTimeDays(v int) *SupplierUpdate {
+	_u.mutation.ResetLeadTimeDays()
+	_u.mutation.SetLeadTimeDays(v)
+	return _u
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillableLeadTimeDays(v *int) *SupplierUpdate {
+	if v != nil {
+		_u.SetLeadTimeDays(*v)
+	}
+	return _u
+}
+
+// AddLeadTimeDays adds value to the "lead_time_days" field.
+func (_u *SupplierUpdate) AddLeadTimeDays(v int) *SupplierUpdate {
+	_u.mutation.AddLeadTimeDays(v)
+	return _u
+}
+
+// SetBankName sets the "bank_name" field.
+func (_u *SupplierUpdate) SetBankName(v string) *SupplierUpdate {
+	_u.mutation.SetBankName(v)
+	return _u
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillableBankName(v *string) *SupplierUpdate {
+	if v != nil {
+		_u.SetBankName(*v)
+	}
+	return _u
+}
+
+// ClearBankName clears the value of the "bank_name" field.
+func (_u *SupplierUpdate) ClearBankName() *SupplierUpdate {
+	_u.mutation.ClearBankName()
+	return _u
+}
+
+// SetBankAccount sets the "bank_account" field.
+func (_u *SupplierUpdate) SetBankAccount(v string) *SupplierUpdate {
+	_u.mutation.SetBankAccount(v)
+	return _u
+}
+
+// SetNillableBankAccount sets the "bank_account" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillableBankAccount(v *string) *SupplierUpdate {
+	if v != nil {
+		_u.SetBankAccount(*v)
+	}
+	return _u
+}
+
+// ClearBankAccount clears the value of the "bank_account" field.
+func (_u *SupplierUpdate) ClearBankAccount() *SupplierUpdate {
+	_u.mutation.ClearBankAccount()
+	return _u
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (_u *SupplierUpdate) SetPriceTolerance(v decimal.Decimal) *SupplierUpdate {
+	_u.mutation.SetPriceTolerance(v)
+	return _u
+}
+
+// SetNillablePriceTolerance sets the "price_tolerance" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillablePriceTolerance(v *decimal.Decimal) *SupplierUpdate {
+	if v != nil {
+		_u.SetPriceTolerance(*v)
+	}
+	return _u
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (_u *SupplierUpdate) SetQuantityTolerance(v decimal.Decimal) *SupplierUpdate {
+	_u.mutation.SetQuantityTolerance(v)
+	return _u
+}
+
+// SetNillableQuantityTolerance sets the "quantity_tolerance" field if the given value is not nil.
+func (_u *SupplierUpdate) SetNillableQuantityTolerance(v *decimal.Decimal) *SupplierUpdate {
+	if v != nil {
+		_u.SetQuantityTolerance(*v)
+	}
+	return _u
+}
+
 // SetTenantID sets the "tenant" edge to the Tenant entity by ID.
 func (_u *SupplierUpdate) SetTenantID(id int) *SupplierUpdate {
 	_u.mutation.SetTenantID(id)
@@ -186,6 +358,21 @@ func (_u *SupplierUpdate) AddPurchaseOrders(v ...*PurchaseOrder) *SupplierUpdate
 	return _u.AddPurchaseOrderIDs(ids...)
 }
 
+// AddBillIDs adds the "bills" edge to the SupplierBill entity by IDs.
+func (_u *SupplierUpdate) AddBillIDs(ids ...int) *SupplierUpdate {
+	_u.mutation.AddBillIDs(ids...)
+	return _u
+}
+
+// AddBills adds the "bills" edges to the SupplierBill entity.
+func (_u *SupplierUpdate) AddBills(v ...*SupplierBill) *SupplierUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillIDs(ids...)
+}
+
 // Mutation returns the SupplierMutation object of the builder.
 func (_u *SupplierUpdate) Mutation() *SupplierMutation {
 	return _u.mutation
@@ -239,6 +426,27 @@ func (_u *SupplierUpdate) RemovePurchaseOrders(v ...*PurchaseOrder) *SupplierUpd
 	return _u.RemovePurchaseOrderIDs(ids...)
 }
 
+// ClearBills clears all "bills" edges to the SupplierBill entity.
+func (_u *SupplierUpdate) ClearBills() *SupplierUpdate {
+	_u.mutation.ClearBills()
+	return _u
+}
+
+// RemoveBillIDs removes the "bills" edge to SupplierBill entities by IDs.
+func (_u *SupplierUpdate) RemoveBillIDs(ids ...int) *SupplierUpdate {
+	_u.mutation.RemoveBillIDs(ids...)
+	return _u
+}
+
+// RemoveBills removes "bills" edges to SupplierBill entities.
+func (_u *SupplierUpdate) RemoveBills(v ...*SupplierBill) *SupplierUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SupplierUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -273,6 +481,11 @@ func (_u *SupplierUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Supplier.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LeadTimeDays(); ok {
+		if err := supplier.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "Supplier.lead_time_days": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Supplier.tenant"`)
 	}
@@ -330,6 +543,54 @@ func (_u *SupplierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.WebsiteCleared() {
 		_spec.ClearField(supplier.FieldWebsite, field.TypeString)
 	}
+	if value, ok := _u.mutation.TaxID(); ok {
+		_spec.SetField(supplier.FieldTaxID, field.TypeString, value)
+	}
+	if _u.mutation.TaxIDCleared() {
+		_spec.ClearField(supplier.FieldTaxID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CountryCode(); ok {
+		_spec.SetField(supplier.FieldCountryCode, field.TypeString, value)
+	}
+	if _u.mutation.CountryCodeCleared() {
+		_spec.ClearField(supplier.FieldCountryCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(supplier.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(supplier.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(supplier.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentTermsDays(); ok {
+		_spec.AddField(supplier.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LeadTimeDays(); ok {
+		_spec.SetField(supplier.FieldLeadTimeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadTimeDays(); ok {
+		_spec.AddField(supplier.FieldLeadTimeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BankName(); ok {
+		_spec.SetField(supplier.FieldBankName, field.TypeString, value)
+	}
+	if _u.mutation.BankNameCleared() {
+		_spec.ClearField(supplier.FieldBankName, field.TypeString)
+	}
+	if value, ok := _u.mutation.BankAccount(); ok {
+		_spec.SetField(supplier.FieldBankAccount, field.TypeString, value)
+	}
+	if _u.mutation.BankAccountCleared() {
+		_spec.ClearField(supplier.FieldBankAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.PriceTolerance(); ok {
+		_spec.SetField(supplier.FieldPriceTolerance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.QuantityTolerance(); ok {
+		_spec.SetField(supplier.FieldQuantityTolerance, field.TypeOther, value)
+	}
 	if _u.mutation.TenantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -449,6 +710,51 @@ func (_u *SupplierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplier.BillsTable,
+			Columns: []string{supplier.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillsIDs(); len(nodes) > 0 && !_u.mutation.BillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplier.BillsTable,
+			Columns: []string{supplier.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplier.BillsTable,
+			Columns: []string{supplier.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -585,6 +891,176 @@ func (_u *SupplierUpdateOne) ClearWebsite() *SupplierUpdateOne {
 	return _u
 }
 
+// SetTaxID sets the "tax_id" field.
+func (_u *SupplierUpdateOne) SetTaxID(v string) *SupplierUpdateOne {
+	_u.mutation.SetTaxID(v)
+	return _u
+}
+
+// SetNillableTaxID sets the "tax_id" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillableTaxID(v *string) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetTaxID(*v)
+	}
+	return _u
+}
+
+// ClearTaxID clears the value of the "tax_id" field.
+func (_u *SupplierUpdateOne) ClearTaxID() *SupplierUpdateOne {
+	_u.mutation.ClearTaxID()
+	return _u
+}
+
+// SetCountryCode sets the "country_code" field.
+func (_u *SupplierUpdateOne) SetCountryCode(v string) *SupplierUpdateOne {
+	_u.mutation.SetCountryCode(v)
+	return _u
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillableCountryCode(v *string) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetCountryCode(*v)
+	}
+	return _u
+}
+
+// ClearCountryCode clears the value of the "country_code" field.
+func (_u *SupplierUpdateOne) ClearCountryCode() *SupplierUpdateOne {
+	_u.mutation.ClearCountryCode()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SupplierUpdateOne) SetCurrency(v string) *SupplierUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillableCurrency(v *string) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *SupplierUpdateOne) ClearCurrency() *SupplierUpdateOne {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_u *SupplierUpdateOne) SetPaymentTermsDays(v int) *SupplierUpdateOne {
+	_u.mutation.ResetPaymentTermsDays()
+	_u.mutation.SetPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillablePaymentTermsDays(v *int) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentTermsDays adds value to the "payment_terms_days" field.
+func (_u *SupplierUpdateOne) AddPaymentTermsDays(v int) *SupplierUpdateOne {
+	_u.mutation.AddPaymentTermsDays(v)
+	return _u
+}
+
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (_u *SupplierUpdateOne) SetLeadTimeDays(v int) *SupplierUpdateOne {
+	_u.mutation.ResetLeadTimeDays()
+	_u.mutation.SetLeadTimeDays(v)
+	return _u
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillableLeadTimeDays(v *int) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetLeadTimeDays(*v)
+	}
+	return _u
+}
+
+// AddLeadTimeDays adds value to the "lead_time_days" field.
+func (_u *SupplierUpdateOne) AddLeadTimeDays(v int) *SupplierUpdateOne {
+	_u.mutation.AddLeadTimeDays(v)
+	return _u
+}
+
+// SetBankName sets the "bank_name" field.
+func (_u *SupplierUpdateOne) SetBankName(v string) *SupplierUpdateOne {
+	_u.mutation.SetBankName(v)
+	return _u
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillableBankName(v *string) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetBankName(*v)
+	}
+	return _u
+}
+
+// ClearBankName clears the value of the "bank_name" field.
+func (_u *SupplierUpdateOne) ClearBankName() *SupplierUpdateOne {
+	_u.mutation.ClearBankName()
+	return _u
+}
+
+// SetBankAccount sets the "bank_account" field.
+func (_u *SupplierUpdateOne) SetBankAccount(v string) *SupplierUpdateOne {
+	_u.mutation.SetBankAccount(v)
+	return _u
+}
+
+// SetNillableBankAccount sets the "bank_account" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillableBankAccount(v *string) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetBankAccount(*v)
+	}
+	return _u
+}
+
+// ClearBankAccount clears the value of the "bank_account" field.
+func (_u *SupplierUpdateOne) ClearBankAccount() *SupplierUpdateOne {
+	_u.mutation.ClearBankAccount()
+	return _u
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (_u *SupplierUpdateOne) SetPriceTolerance(v decimal.Decimal) *SupplierUpdateOne {
+	_u.mutation.SetPriceTolerance(v)
+	return _u
+}
+
+// SetNillablePriceTolerance sets the "price_tolerance" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillablePriceTolerance(v *decimal.Decimal) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetPriceTolerance(*v)
+	}
+	return _u
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (_u *SupplierUpdateOne) SetQuantityTolerance(v decimal.Decimal) *SupplierUpdateOne {
+	_u.mutation.SetQuantityTolerance(v)
+	return _u
+}
+
+// SetNillableQuantityTolerance sets the "quantity_tolerance" field if the given value is not nil.
+func (_u *SupplierUpdateOne) SetNillableQuantityTolerance(v *decimal.Decimal) *SupplierUpdateOne {
+	if v != nil {
+		_u.SetQuantityTolerance(*v)
+	}
+	return _u
+}
+
 // SetTenantID sets the "tenant" edge to the Tenant entity by ID.
 func (_u *SupplierUpdateOne) SetTenantID(id int) *SupplierUpdateOne {
 	_u.mutation.SetTenantID(id)
@@ -626,6 +1102,21 @@ func (_u *SupplierUpdateOne) AddPurchaseOrders(v ...*PurchaseOrder) *SupplierUpd
 	return _u.AddPurchaseOrderIDs(ids...)
 }
 
+// AddBillIDs adds the "bills" edge to the SupplierBill entity by IDs.
+func (_u *SupplierUpdateOne) AddBillIDs(ids ...int) *SupplierUpdateOne {
+	_u.mutation.AddBillIDs(ids...)
+	return _u
+}
+
+// AddBills adds the "bills" edges to the SupplierBill entity.
+func (_u *SupplierUpdateOne) AddBills(v ...*SupplierBill) *SupplierUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillIDs(ids...)
+}
+
 // Mutation returns the SupplierMutation object of the builder.
 func (_u *SupplierUpdateOne) Mutation() *SupplierMutation {
 	return _u.mutation
@@ -679,6 +1170,27 @@ func (_u *SupplierUpdateOne) RemovePurchaseOrders(v ...*PurchaseOrder) *Supplier
 	return _u.RemovePurchaseOrderIDs(ids...)
 }
 
+// ClearBills clears all "bills" edges to the SupplierBill entity.
+func (_u *SupplierUpdateOne) ClearBills() *SupplierUpdateOne {
+	_u.mutation.ClearBills()
+	return _u
+}
+
+// RemoveBillIDs removes the "bills" edge to SupplierBill entities by IDs.
+func (_u *SupplierUpdateOne) RemoveBillIDs(ids ...int) *SupplierUpdateOne {
+	_u.mutation.RemoveBillIDs(ids...)
+	return _u
+}
+
+// RemoveBills removes "bills" edges to SupplierBill entities.
+func (_u *SupplierUpdateOne) RemoveBills(v ...*SupplierBill) *SupplierUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillIDs(ids...)
+}
+
 // Where appends a list predicates to the SupplierUpdate builder.
 func (_u *SupplierUpdateOne) Where(ps ...predicate.Supplier) *SupplierUpdateOne {
 	_u.mutation.Where(ps...)
@@ -726,6 +1238,11 @@ func (_u *SupplierUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Supplier.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LeadTimeDays(); ok {
+		if err := supplier.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "Supplier.lead_time_days": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Supplier.tenant"`)
 	}
@@ -800,6 +1317,54 @@ func (_u *SupplierUpdateOne) sqlSave(ctx context.Context) (_node *Supplier, err
 	if _u.mutation.WebsiteCleared() {
 		_spec.ClearField(supplier.FieldWebsite, field.TypeString)
 	}
+	if value, ok := _u.mutation.TaxID(); ok {
+		_spec.SetField(supplier.FieldTaxID, field.TypeString, value)
+	}
+	if _u.mutation.TaxIDCleared() {
+		_spec.ClearField(supplier.FieldTaxID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CountryCode(); ok {
+		_spec.SetField(supplier.FieldCountryCode, field.TypeString, value)
+	}
+	if _u.mutation.CountryCodeCleared() {
+		_spec.ClearField(supplier.FieldCountryCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(supplier.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(supplier.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(supplier.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentTermsDays(); ok {
+		_spec.AddField(supplier.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LeadTimeDays(); ok {
+		_spec.SetField(supplier.FieldLeadTimeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadTimeDays(); ok {
+		_spec.AddField(supplier.FieldLeadTimeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BankName(); ok {
+		_spec.SetField(supplier.FieldBankName, field.TypeString, value)
+	}
+	if _u.mutation.BankNameCleared() {
+		_spec.ClearField(supplier.FieldBankName, field.TypeString)
+	}
+	if value, ok := _u.mutation.BankAccount(); ok {
+		_spec.SetField(supplier.FieldBankAccount, field.TypeString, value)
+	}
+	if _u.mutation.BankAccountCleared() {
+		_spec.ClearField(supplier.FieldBankAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.PriceTolerance(); ok {
+		_spec.SetField(supplier.FieldPriceTolerance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.QuantityTolerance(); ok {
+		_spec.SetField(supplier.FieldQuantityTolerance, field.TypeOther, value)
+	}
 	if _u.mutation.TenantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -919,6 +1484,51 @@ func (_u *SupplierUpdateOne) sqlSave(ctx context.Context) (_node *Supplier, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplier.BillsTable,
+			Columns: []string{supplier.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillsIDs(); len(nodes) > 0 && !_u.mutation.BillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplier.BillsTable,
+			Columns: []string{supplier.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplier.BillsTable,
+			Columns: []string{supplier.BillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Supplier{config: _u.config}
 	_spec.Assign = _node.assignValues
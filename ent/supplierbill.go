@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/purchaseorder"
+	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierBill is the model entity for the SupplierBill schema.
+type SupplierBill struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// SupplierReference holds the value of the "supplier_reference" field.
+	SupplierReference string `json:"supplier_reference,omitempty"`
+	// Status holds the value of the "status" field.
+	Status supplierbill.Status `json:"status,omitempty"`
+	// BillDate holds the value of the "bill_date" field.
+	BillDate time.Time `json:"bill_date,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate decimal.Decimal `json:"exchange_rate,omitempty"`
+	// Subtotal holds the value of the "subtotal" field.
+	Subtotal decimal.Decimal `json:"subtotal,omitempty"`
+	// TaxTotal holds the value of the "tax_total" field.
+	TaxTotal decimal.Decimal `json:"tax_total,omitempty"`
+	// Total holds the value of the "total" field.
+	Total decimal.Decimal `json:"total,omitempty"`
+	// AmountPaid holds the value of the "amount_paid" field.
+	AmountPaid decimal.Decimal `json:"amount_paid,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// ApprovedBy holds the value of the "approved_by" field.
+	ApprovedBy string `json:"approved_by,omitempty"`
+	// ApprovedAt holds the value of the "approved_at" field.
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SupplierBillQuery when eager-loading is set.
+	Edges                     SupplierBillEdges `json:"edges"`
+	purchase_order_bills      *int
+	supplier_bills            *int
+	supplier_bill_transaction *int
+	tenant_supplier_bills     *int
+	selectValues              sql.SelectValues
+}
+
+// SupplierBillEdges holds the relations/edges for other nodes in the graph.
+type SupplierBillEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Supplier holds the value of the supplier edge.
+	Supplier *Supplier `json:"supplier,omitempty"`
+	// PurchaseOrder holds the value of the purchase_order edge.
+	PurchaseOrder *PurchaseOrder `json:"purchase_order,omitempty"`
+	// Lines holds the value of the lines edge.
+	Lines []*SupplierBillLine `json:"lines,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// PaymentLines holds the value of the payment_lines edge.
+	PaymentLines []*PaymentRunLine `json:"payment_lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupplierBillEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// SupplierOrErr returns the Supplier value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupplierBillEdges) SupplierOrErr() (*Supplier, error) {
+	if e.Supplier != nil {
+		return e.Supplier, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: supplier.Label}
+	}
+	return nil, &NotLoadedError{edge: "supplier"}
+}
+
+// PurchaseOrderOrErr returns the PurchaseOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupplierBillEdges) PurchaseOrderOrErr() (*PurchaseOrder, error) {
+	if e.PurchaseOrder != nil {
+		return e.PurchaseOrder, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: purchaseorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "purchase_order"}
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e SupplierBillEdges) LinesOrErr() ([]*SupplierBillLine, error) {
+	if e.loadedTypes[3] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupplierBillEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// PaymentLinesOrErr returns the PaymentLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e SupplierBillEdges) PaymentLinesOrErr() ([]*PaymentRunLine, error) {
+	if e.loadedTypes[5] {
+		return e.PaymentLines, nil
+	}
+	return nil, &NotLoadedError{edge: "payment_lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SupplierBill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case supplierbill.FieldExchangeRate, supplierbill.FieldSubtotal, supplierbill.FieldTaxTotal, supplierbill.FieldTotal, supplierbill.FieldAmountPaid:
+			values[i] = new(decimal.Decimal)
+		case supplierbill.FieldID:
+			values[i] = new(sql.NullInt64)
+		case supplierbill.FieldNumber, supplierbill.FieldSupplierReference, supplierbill.FieldStatus, supplierbill.FieldCurrency, supplierbill.FieldNotes, supplierbill.FieldApprovedBy:
+			values[i] = new(sql.NullString)
+		case supplierbill.FieldBillDate, supplierbill.FieldDueDate, supplierbill.FieldApprovedAt, supplierbill.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case supplierbill.ForeignKeys[0]: // purchase_order_bills
+			values[i] = new(sql.NullInt64)
+		case supplierbill.ForeignKeys[1]: // supplier_bills
+			values[i] = new(sql.NullInt64)
+		case supplierbill.ForeignKeys[2]: // supplier_bill_transaction
+			values[i] = new(sql.NullInt64)
+		case supplierbill.ForeignKeys[3]: // tenant_supplier_bills
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SupplierBill fields.
+func (_m *SupplierBill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case supplierbill.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case supplierbill.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case supplierbill.FieldSupplierReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field supplier_reference", values[i])
+			} else if value.Valid {
+				_m.SupplierReference = value.String
+			}
+		case supplierbill.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = supplierbill.Status(value.String)
+			}
+		case supplierbill.FieldBillDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_date", values[i])
+			} else if value.Valid {
+				_m.BillDate = value.Time
+			}
+		case supplierbill.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				_m.DueDate = value.Time
+			}
+		case supplierbill.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case supplierbill.FieldExchangeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value != nil {
+				_m.ExchangeRate = *value
+			}
+		case supplierbill.FieldSubtotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value != nil {
+				_m.Subtotal = *value
+			}
+		case supplierbill.FieldTaxTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_total", values[i])
+			} else if value != nil {
+				_m.TaxTotal = *value
+			}
+		case supplierbill.FieldTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value != nil {
+				_m.Total = *value
+			}
+		case supplierbill.FieldAmountPaid:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_paid", values[i])
+			} else if value != nil {
+				_m.AmountPaid = *value
+			}
+		case supplierbill.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case supplierbill.FieldApprovedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value.Valid {
+				_m.ApprovedBy = value.String
+			}
+		case supplierbill.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				_m.ApprovedAt = new(time.Time)
+				*_m.ApprovedAt = value.Time
+			}
+		case supplierbill.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case supplierbill.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field purchase_order_bills", value)
+			} else if value.Valid {
+				_m.purchase_order_bills = new(int)
+				*_m.purchase_order_bills = int(value.Int64)
+			}
+		case supplierbill.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field supplier_bills", value)
+			} else if value.Valid {
+				_m.supplier_bills = new(int)
+				*_m.supplier_bills = int(value.Int64)
+			}
+		case supplierbill.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field supplier_bill_transaction", value)
+			} else if value.Valid {
+				_m.supplier_bill_transaction = new(int)
+				*_m.supplier_bill_transaction = int(value.Int64)
+			}
+		case supplierbill.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_supplier_bills", value)
+			} else if value.Valid {
+				_m.tenant_supplier_bills = new(int)
+				*_m.tenant_supplier_bills = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SupplierBill.
+// This includes values selected through modifiers, order, etc.
+func (_m *SupplierBill) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the SupplierBill entity.
+func (_m *SupplierBill) QueryTenant() *TenantQuery {
+	return NewSupplierBillClient(_m.config).QueryTenant(_m)
+}
+
+// QuerySupplier queries the "supplier" edge of the SupplierBill entity.
+func (_m *SupplierBill) QuerySupplier() *SupplierQuery {
+	return NewSupplierBillClient(_m.config).QuerySupplier(_m)
+}
+
+// QueryPurchaseOrder queries the "purchase_order" edge of the SupplierBill entity.
+func (_m *SupplierBill) QueryPurchaseOrder() *PurchaseOrderQuery {
+	return NewSupplierBillClient(_m.config).QueryPurchaseOrder(_m)
+}
+
+// QueryLines queries the "lines" edge of the SupplierBill entity.
+func (_m *SupplierBill) QueryLines() *SupplierBillLineQuery {
+	return NewSupplierBillClient(_m.config).QueryLines(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the SupplierBill entity.
+func (_m *SupplierBill) QueryTransaction() *TransactionQuery {
+	return NewSupplierBillClient(_m.config).QueryTransaction(_m)
+}
+
+// QueryPaymentLines queries the "payment_lines" edge of the SupplierBill entity.
+func (_m *SupplierBill) QueryPaymentLines() *PaymentRunLineQuery {
+	return NewSupplierBillClient(_m.config).QueryPaymentLines(_m)
+}
+
+// Update returns a builder for updating this SupplierBill.
+// Note that you need to call SupplierBill.Unwrap() before calling this method if this SupplierBill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SupplierBill) Update() *SupplierBillUpdateOne {
+	return NewSupplierBillClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SupplierBill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SupplierBill) Unwrap() *SupplierBill {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SupplierBill is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SupplierBill) String() string {
+	var builder strings.Builder
+	builder.WriteString("SupplierBill(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("supplier_reference=")
+	builder.WriteString(_m.SupplierReference)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("bill_date=")
+	builder.WriteString(_m.BillDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(_m.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExchangeRate))
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("tax_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxTotal))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("amount_paid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountPaid))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("approved_by=")
+	builder.WriteString(_m.ApprovedBy)
+	builder.WriteString(", ")
+	if v := _m.ApprovedAt; v != nil {
+		builder.WriteString("approved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SupplierBills is a parsable slice of SupplierBill.
+type SupplierBills []*SupplierBill
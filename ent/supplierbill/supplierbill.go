@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package supplierbill
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the supplierbill type in the database.
+	Label = "supplier_bill"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldSupplierReference holds the string denoting the supplier_reference field in the database.
+	FieldSupplierReference = "supplier_reference"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldBillDate holds the string denoting the bill_date field in the database.
+	FieldBillDate = "bill_date"
+	// FieldDueDate holds the string denoting the due_date field in the database.
+	FieldDueDate = "due_date"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldTaxTotal holds the string denoting the tax_total field in the database.
+	FieldTaxTotal = "tax_total"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldAmountPaid holds the string denoting the amount_paid field in the database.
+	FieldAmountPaid = "amount_paid"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldApprovedBy holds the string denoting the approved_by field in the database.
+	FieldApprovedBy = "approved_by"
+	// FieldApprovedAt holds the string denoting the approved_at field in the database.
+	FieldApprovedAt = "approved_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeSupplier holds the string denoting the supplier edge name in mutations.
+	EdgeSupplier = "supplier"
+	// EdgePurchaseOrder holds the string denoting the purchase_order edge name in mutations.
+	EdgePurchaseOrder = "purchase_order"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
+	// EdgeTransaction holds the string denoting the transaction edge name in mutations.
+	EdgeTransaction = "transaction"
+	// EdgePaymentLines holds the string denoting the payment_lines edge name in mutations.
+	EdgePaymentLines = "payment_lines"
+	// Table holds the table name of the supplierbill in the database.
+	Table = "supplier_bills"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "supplier_bills"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_supplier_bills"
+	// SupplierTable is the table that holds the supplier relation/edge.
+	SupplierTable = "supplier_bills"
+	// SupplierInverseTable is the table name for the Supplier entity.
+	// It exists in this package in order to avoid circular dependency with the "supplier" package.
+	SupplierInverseTable = "suppliers"
+	// SupplierColumn is the table column denoting the supplier relation/edge.
+	SupplierColumn = "supplier_bills"
+	// PurchaseOrderTable is the table that holds the purchase_order relation/edge.
+	PurchaseOrderTable = "supplier_bills"
+	// PurchaseOrderInverseTable is the table name for the PurchaseOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "purchaseorder" package.
+	PurchaseOrderInverseTable = "purchase_orders"
+	// PurchaseOrderColumn is the table column denoting the purchase_order relation/edge.
+	PurchaseOrderColumn = "purchase_order_bills"
+	// LinesTable is the table that holds the lines relation/edge.
+	LinesTable = "supplier_bill_lines"
+	// LinesInverseTable is the table name for the SupplierBillLine entity.
+	// It exists in this package in order to avoid circular dependency with the "supplierbillline" package.
+	LinesInverseTable = "supplier_bill_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "supplier_bill_lines"
+	// TransactionTable is the table that holds the transaction relation/edge.
+	TransactionTable = "supplier_bills"
+	// TransactionInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionInverseTable = "transactions"
+	// TransactionColumn is the table column denoting the transaction relation/edge.
+	TransactionColumn = "supplier_bill_transaction"
+	// PaymentLinesTable is the table that holds the payment_lines relation/edge.
+	PaymentLinesTable = "payment_run_lines"
+	// PaymentLinesInverseTable is the table name for the PaymentRunLine entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentrunline" package.
+	PaymentLinesInverseTable = "payment_run_lines"
+	// PaymentLinesColumn is the table column denoting the payment_lines relation/edge.
+	PaymentLinesColumn = "supplier_bill_payment_lines"
+)
+
+// Columns holds all SQL columns for supplierbill fields.
+var Columns = []string{
+	FieldID,
+	FieldNumber,
+	FieldSupplierReference,
+	FieldStatus,
+	FieldBillDate,
+	FieldDueDate,
+	FieldCurrency,
+	FieldExchangeRate,
+	FieldSubtotal,
+	FieldTaxTotal,
+	FieldTotal,
+	FieldAmountPaid,
+	FieldNotes,
+	FieldApprovedBy,
+	FieldApprovedAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "supplier_bills"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"purchase_order_bills",
+	"supplier_bills",
+	"supplier_bill_transaction",
+	"tenant_supplier_bills",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultBillDate holds the default value on creation for the "bill_date" field.
+	DefaultBillDate func() time.Time
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate decimal.Decimal
+	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
+	DefaultSubtotal decimal.Decimal
+	// DefaultTaxTotal holds the default value on creation for the "tax_total" field.
+	DefaultTaxTotal decimal.Decimal
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal decimal.Decimal
+	// DefaultAmountPaid holds the default value on creation for the "amount_paid" field.
+	DefaultAmountPaid decimal.Decimal
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusException is the default value of the Status enum.
+const DefaultStatus = StatusException
+
+// Status values.
+const (
+	StatusException Status = "exception"
+	StatusMatched   Status = "matched"
+	StatusApproved  Status = "approved"
+	StatusPaid      Status = "paid"
+	StatusVoid      Status = "void"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusException, StatusMatched, StatusApproved, StatusPaid, StatusVoid:
+		return nil
+	default:
+		return fmt.Errorf("supplierbill: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SupplierBill queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// BySupplierReference orders the results by the supplier_reference field.
+func BySupplierReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSupplierReference, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByBillDate orders the results by the bill_date field.
+func ByBillDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillDate, opts...).ToFunc()
+}
+
+// ByDueDate orders the results by the due_date field.
+func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
+// BySubtotal orders the results by the subtotal field.
+func BySubtotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotal, opts...).ToFunc()
+}
+
+// ByTaxTotal orders the results by the tax_total field.
+func ByTaxTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxTotal, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByAmountPaid orders the results by the amount_paid field.
+func ByAmountPaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountPaid, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByApprovedBy orders the results by the approved_by field.
+func ByApprovedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedBy, opts...).ToFunc()
+}
+
+// ByApprovedAt orders the results by the approved_at field.
+func ByApprovedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySupplierField orders the results by supplier field.
+func BySupplierField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSupplierStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPurchaseOrderField orders the results by purchase_order field.
+func ByPurchaseOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPurchaseOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLinesCount orders the results by lines count.
+func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinesStep(), opts...)
+	}
+}
+
+// ByLines orders the results by lines terms.
+func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransactionField orders the results by transaction field.
+func ByTransactionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentLinesCount orders the results by payment_lines count.
+func ByPaymentLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentLinesStep(), opts...)
+	}
+}
+
+// ByPaymentLines orders the results by payment_lines terms.
+func ByPaymentLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newSupplierStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SupplierInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SupplierTable, SupplierColumn),
+	)
+}
+func newPurchaseOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PurchaseOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PurchaseOrderTable, PurchaseOrderColumn),
+	)
+}
+func newLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+	)
+}
+func newTransactionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+	)
+}
+func newPaymentLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentLinesTable, PaymentLinesColumn),
+	)
+}
@@ -0,0 +1,1015 @@
+// Code generated by ent, DO NOT EDIT.
+
+package supplierbill
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldID, id))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldNumber, v))
+}
+
+// SupplierReference applies equality check predicate on the "supplier_reference" field. It's identical to SupplierReferenceEQ.
+func SupplierReference(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldSupplierReference, v))
+}
+
+// BillDate applies equality check predicate on the "bill_date" field. It's identical to BillDateEQ.
+func BillDate(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldBillDate, v))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldDueDate, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// TaxTotal applies equality check predicate on the "tax_total" field. It's identical to TaxTotalEQ.
+func TaxTotal(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldTaxTotal, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldTotal, v))
+}
+
+// AmountPaid applies equality check predicate on the "amount_paid" field. It's identical to AmountPaidEQ.
+func AmountPaid(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldNotes, v))
+}
+
+// ApprovedBy applies equality check predicate on the "approved_by" field. It's identical to ApprovedByEQ.
+func ApprovedBy(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldNumber, v))
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContains(FieldNumber, v))
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasPrefix(FieldNumber, v))
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasSuffix(FieldNumber, v))
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEqualFold(FieldNumber, v))
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContainsFold(FieldNumber, v))
+}
+
+// SupplierReferenceEQ applies the EQ predicate on the "supplier_reference" field.
+func SupplierReferenceEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldSupplierReference, v))
+}
+
+// SupplierReferenceNEQ applies the NEQ predicate on the "supplier_reference" field.
+func SupplierReferenceNEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldSupplierReference, v))
+}
+
+// SupplierReferenceIn applies the In predicate on the "supplier_reference" field.
+func SupplierReferenceIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldSupplierReference, vs...))
+}
+
+// SupplierReferenceNotIn applies the NotIn predicate on the "supplier_reference" field.
+func SupplierReferenceNotIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldSupplierReference, vs...))
+}
+
+// SupplierReferenceGT applies the GT predicate on the "supplier_reference" field.
+func SupplierReferenceGT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldSupplierReference, v))
+}
+
+// SupplierReferenceGTE applies the GTE predicate on the "supplier_reference" field.
+func SupplierReferenceGTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldSupplierReference, v))
+}
+
+// SupplierReferenceLT applies the LT predicate on the "supplier_reference" field.
+func SupplierReferenceLT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldSupplierReference, v))
+}
+
+// SupplierReferenceLTE applies the LTE predicate on the "supplier_reference" field.
+func SupplierReferenceLTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldSupplierReference, v))
+}
+
+// SupplierReferenceContains applies the Contains predicate on the "supplier_reference" field.
+func SupplierReferenceContains(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContains(FieldSupplierReference, v))
+}
+
+// SupplierReferenceHasPrefix applies the HasPrefix predicate on the "supplier_reference" field.
+func SupplierReferenceHasPrefix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasPrefix(FieldSupplierReference, v))
+}
+
+// SupplierReferenceHasSuffix applies the HasSuffix predicate on the "supplier_reference" field.
+func SupplierReferenceHasSuffix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasSuffix(FieldSupplierReference, v))
+}
+
+// SupplierReferenceEqualFold applies the EqualFold predicate on the "supplier_reference" field.
+func SupplierReferenceEqualFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEqualFold(FieldSupplierReference, v))
+}
+
+// SupplierReferenceContainsFold applies the ContainsFold predicate on the "supplier_reference" field.
+func SupplierReferenceContainsFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContainsFold(FieldSupplierReference, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// BillDateEQ applies the EQ predicate on the "bill_date" field.
+func BillDateEQ(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldBillDate, v))
+}
+
+// BillDateNEQ applies the NEQ predicate on the "bill_date" field.
+func BillDateNEQ(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldBillDate, v))
+}
+
+// BillDateIn applies the In predicate on the "bill_date" field.
+func BillDateIn(vs ...time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldBillDate, vs...))
+}
+
+// BillDateNotIn applies the NotIn predicate on the "bill_date" field.
+func BillDateNotIn(vs ...time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldBillDate, vs...))
+}
+
+// BillDateGT applies the GT predicate on the "bill_date" field.
+func BillDateGT(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldBillDate, v))
+}
+
+// BillDateGTE applies the GTE predicate on the "bill_date" field.
+func BillDateGTE(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldBillDate, v))
+}
+
+// BillDateLT applies the LT predicate on the "bill_date" field.
+func BillDateLT(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldBillDate, v))
+}
+
+// BillDateLTE applies the LTE predicate on the "bill_date" field.
+func BillDateLTE(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldBillDate, v))
+}
+
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldDueDate, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldExchangeRate, v))
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldExchangeRate, v))
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldExchangeRate, v))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldSubtotal, v))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldSubtotal, vs...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldSubtotal, vs...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldSubtotal, v))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldSubtotal, v))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldSubtotal, v))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldSubtotal, v))
+}
+
+// TaxTotalEQ applies the EQ predicate on the "tax_total" field.
+func TaxTotalEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldTaxTotal, v))
+}
+
+// TaxTotalNEQ applies the NEQ predicate on the "tax_total" field.
+func TaxTotalNEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldTaxTotal, v))
+}
+
+// TaxTotalIn applies the In predicate on the "tax_total" field.
+func TaxTotalIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldTaxTotal, vs...))
+}
+
+// TaxTotalNotIn applies the NotIn predicate on the "tax_total" field.
+func TaxTotalNotIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldTaxTotal, vs...))
+}
+
+// TaxTotalGT applies the GT predicate on the "tax_total" field.
+func TaxTotalGT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldTaxTotal, v))
+}
+
+// TaxTotalGTE applies the GTE predicate on the "tax_total" field.
+func TaxTotalGTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldTaxTotal, v))
+}
+
+// TaxTotalLT applies the LT predicate on the "tax_total" field.
+func TaxTotalLT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldTaxTotal, v))
+}
+
+// TaxTotalLTE applies the LTE predicate on the "tax_total" field.
+func TaxTotalLTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldTaxTotal, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldTotal, v))
+}
+
+// AmountPaidEQ applies the EQ predicate on the "amount_paid" field.
+func AmountPaidEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidNEQ applies the NEQ predicate on the "amount_paid" field.
+func AmountPaidNEQ(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidIn applies the In predicate on the "amount_paid" field.
+func AmountPaidIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidNotIn applies the NotIn predicate on the "amount_paid" field.
+func AmountPaidNotIn(vs ...decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidGT applies the GT predicate on the "amount_paid" field.
+func AmountPaidGT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldAmountPaid, v))
+}
+
+// AmountPaidGTE applies the GTE predicate on the "amount_paid" field.
+func AmountPaidGTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldAmountPaid, v))
+}
+
+// AmountPaidLT applies the LT predicate on the "amount_paid" field.
+func AmountPaidLT(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldAmountPaid, v))
+}
+
+// AmountPaidLTE applies the LTE predicate on the "amount_paid" field.
+func AmountPaidLTE(v decimal.Decimal) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldAmountPaid, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// ApprovedByEQ applies the EQ predicate on the "approved_by" field.
+func ApprovedByEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByNEQ applies the NEQ predicate on the "approved_by" field.
+func ApprovedByNEQ(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByIn applies the In predicate on the "approved_by" field.
+func ApprovedByIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByNotIn applies the NotIn predicate on the "approved_by" field.
+func ApprovedByNotIn(vs ...string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByGT applies the GT predicate on the "approved_by" field.
+func ApprovedByGT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldApprovedBy, v))
+}
+
+// ApprovedByGTE applies the GTE predicate on the "approved_by" field.
+func ApprovedByGTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldApprovedBy, v))
+}
+
+// ApprovedByLT applies the LT predicate on the "approved_by" field.
+func ApprovedByLT(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldApprovedBy, v))
+}
+
+// ApprovedByLTE applies the LTE predicate on the "approved_by" field.
+func ApprovedByLTE(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldApprovedBy, v))
+}
+
+// ApprovedByContains applies the Contains predicate on the "approved_by" field.
+func ApprovedByContains(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContains(FieldApprovedBy, v))
+}
+
+// ApprovedByHasPrefix applies the HasPrefix predicate on the "approved_by" field.
+func ApprovedByHasPrefix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasPrefix(FieldApprovedBy, v))
+}
+
+// ApprovedByHasSuffix applies the HasSuffix predicate on the "approved_by" field.
+func ApprovedByHasSuffix(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldHasSuffix(FieldApprovedBy, v))
+}
+
+// ApprovedByIsNil applies the IsNil predicate on the "approved_by" field.
+func ApprovedByIsNil() predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIsNull(FieldApprovedBy))
+}
+
+// ApprovedByNotNil applies the NotNil predicate on the "approved_by" field.
+func ApprovedByNotNil() predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotNull(FieldApprovedBy))
+}
+
+// ApprovedByEqualFold applies the EqualFold predicate on the "approved_by" field.
+func ApprovedByEqualFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEqualFold(FieldApprovedBy, v))
+}
+
+// ApprovedByContainsFold applies the ContainsFold predicate on the "approved_by" field.
+func ApprovedByContainsFold(v string) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldContainsFold(FieldApprovedBy, v))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotNull(FieldApprovedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSupplier applies the HasEdge predicate on the "supplier" edge.
+func HasSupplier() predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SupplierTable, SupplierColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSupplierWith applies the HasEdge predicate on the "supplier" edge with a given conditions (other predicates).
+func HasSupplierWith(preds ...predicate.Supplier) predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := newSupplierStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPurchaseOrder applies the HasEdge predicate on the "purchase_order" edge.
+func HasPurchaseOrder() predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PurchaseOrderTable, PurchaseOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPurchaseOrderWith applies the HasEdge predicate on the "purchase_order" edge with a given conditions (other predicates).
+func HasPurchaseOrderWith(preds ...predicate.PurchaseOrder) predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := newPurchaseOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLines applies the HasEdge predicate on the "lines" edge.
+func HasLines() predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinesWith applies the HasEdge predicate on the "lines" edge with a given conditions (other predicates).
+func HasLinesWith(preds ...predicate.SupplierBillLine) predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := newLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaymentLines applies the HasEdge predicate on the "payment_lines" edge.
+func HasPaymentLines() predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentLinesTable, PaymentLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentLinesWith applies the HasEdge predicate on the "payment_lines" edge with a given conditions (other predicates).
+func HasPaymentLinesWith(preds ...predicate.PaymentRunLine) predicate.SupplierBill {
+	return predicate.SupplierBill(func(s *sql.Selector) {
+		step := newPaymentLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SupplierBill) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SupplierBill) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SupplierBill) predicate.SupplierBill {
+	return predicate.SupplierBill(sql.NotPredicates(p))
+}
@@ -0,0 +1,703 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/paymentrunline"
+	"sent/ent/purchaseorder"
+	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierBillCreate is the builder for creating a SupplierBill entity.
+type SupplierBillCreate struct {
+	config
+	mutation *SupplierBillMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (_c *SupplierBillCreate) SetNumber(v string) *SupplierBillCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetSupplierReference sets the "supplier_reference" field.
+func (_c *SupplierBillCreate) SetSupplierReference(v string) *SupplierBillCreate {
+	_c.mutation.SetSupplierReference(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SupplierBillCreate) SetStatus(v supplierbill.Status) *SupplierBillCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableStatus(v *supplierbill.Status) *SupplierBillCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetBillDate sets the "bill_date" field.
+func (_c *SupplierBillCreate) SetBillDate(v time.Time) *SupplierBillCreate {
+	_c.mutation.SetBillDate(v)
+	return _c
+}
+
+// SetNillableBillDate sets the "bill_date" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableBillDate(v *time.Time) *SupplierBillCreate {
+	if v != nil {
+		_c.SetBillDate(*v)
+	}
+	return _c
+}
+
+// SetDueDate sets the "due_date" field.
+func (_c *SupplierBillCreate) SetDueDate(v time.Time) *SupplierBillCreate {
+	_c.mutation.SetDueDate(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *SupplierBillCreate) SetCurrency(v string) *SupplierBillCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableCurrency(v *string) *SupplierBillCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *SupplierBillCreate) SetExchangeRate(v decimal.Decimal) *SupplierBillCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableExchangeRate(v *decimal.Decimal) *SupplierBillCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_c *SupplierBillCreate) SetSubtotal(v decimal.Decimal) *SupplierBillCreate {
+	_c.mutation.SetSubtotal(v)
+	return _c
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableSubtotal(v *decimal.Decimal) *SupplierBillCreate {
+	if v != nil {
+		_c.SetSubtotal(*v)
+	}
+	return _c
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_c *SupplierBillCreate) SetTaxTotal(v decimal.Decimal) *SupplierBillCreate {
+	_c.mutation.SetTaxTotal(v)
+	return _c
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableTaxTotal(v *decimal.Decimal) *SupplierBillCreate {
+	if v != nil {
+		_c.SetTaxTotal(*v)
+	}
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *SupplierBillCreate) SetTotal(v decimal.Decimal) *SupplierBillCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableTotal(v *decimal.Decimal) *SupplierBillCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (_c *SupplierBillCreate) SetAmountPaid(v decimal.Decimal) *SupplierBillCreate {
+	_c.mutation.SetAmountPaid(v)
+	return _c
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableAmountPaid(v *decimal.Decimal) *SupplierBillCreate {
+	if v != nil {
+		_c.SetAmountPaid(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *SupplierBillCreate) SetNotes(v string) *SupplierBillCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableNotes(v *string) *SupplierBillCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_c *SupplierBillCreate) SetApprovedBy(v string) *SupplierBillCreate {
+	_c.mutation.SetApprovedBy(v)
+	return _c
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableApprovedBy(v *string) *SupplierBillCreate {
+	if v != nil {
+		_c.SetApprovedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *SupplierBillCreate) SetApprovedAt(v time.Time) *SupplierBillCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableApprovedAt(v *time.Time) *SupplierBillCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SupplierBillCreate) SetCreatedAt(v time.Time) *SupplierBillCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableCreatedAt(v *time.Time) *SupplierBillCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *SupplierBillCreate) SetTenantID(id int) *SupplierBillCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *SupplierBillCreate) SetTenant(v *Tenant) *SupplierBillCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetSupplierID sets the "supplier" edge to the Supplier entity by ID.
+func (_c *SupplierBillCreate) SetSupplierID(id int) *SupplierBillCreate {
+	_c.mutation.SetSupplierID(id)
+	return _c
+}
+
+// SetSupplier sets the "supplier" edge to the Supplier entity.
+func (_c *SupplierBillCreate) SetSupplier(v *Supplier) *SupplierBillCreate {
+	return _c.SetSupplierID(v.ID)
+}
+
+// SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
+func (_c *SupplierBillCreate) SetPurchaseOrderID(id int) *SupplierBillCreate {
+	_c.mutation.SetPurchaseOrderID(id)
+	return _c
+}
+
+// SetNillablePurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillablePurchaseOrderID(id *int) *SupplierBillCreate {
+	if id != nil {
+		_c = _c.SetPurchaseOrderID(*id)
+	}
+	return _c
+}
+
+// SetPurchaseOrder sets the "purchase_order" edge to the PurchaseOrder entity.
+func (_c *SupplierBillCreate) SetPurchaseOrder(v *PurchaseOrder) *SupplierBillCreate {
+	return _c.SetPurchaseOrderID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the SupplierBillLine entity by IDs.
+func (_c *SupplierBillCreate) AddLineIDs(ids ...int) *SupplierBillCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the SupplierBillLine entity.
+func (_c *SupplierBillCreate) AddLines(v ...*SupplierBillLine) *SupplierBillCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *SupplierBillCreate) SetTransactionID(id int) *SupplierBillCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_c *SupplierBillCreate) SetNillableTransactionID(id *int) *SupplierBillCreate {
+	if id != nil {
+		_c = _c.SetTransactionID(*id)
+	}
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *SupplierBillCreate) SetTransaction(v *Transaction) *SupplierBillCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// AddPaymentLineIDs adds the "payment_lines" edge to the PaymentRunLine entity by IDs.
+func (_c *SupplierBillCreate) AddPaymentLineIDs(ids ...int) *SupplierBillCreate {
+	_c.mutation.AddPaymentLineIDs(ids...)
+	return _c
+}
+
+// AddPaymentLines adds the "payment_lines" edges to the PaymentRunLine entity.
+func (_c *SupplierBillCreate) AddPaymentLines(v ...*PaymentRunLine) *SupplierBillCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaymentLineIDs(ids...)
+}
+
+// Mutation returns the SupplierBillMutation object of the builder.
+func (_c *SupplierBillCreate) Mutation() *SupplierBillMutation {
+	return _c.mutation
+}
+
+// Save creates the SupplierBill in the database.
+func (_c *SupplierBillCreate) Save(ctx context.Context) (*SupplierBill, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SupplierBillCreate) SaveX(ctx context.Context) *SupplierBill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupplierBillCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupplierBillCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SupplierBillCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := supplierbill.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.BillDate(); !ok {
+		v := supplierbill.DefaultBillDate()
+		_c.mutation.SetBillDate(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := supplierbill.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		v := supplierbill.DefaultExchangeRate
+		_c.mutation.SetExchangeRate(v)
+	}
+	if _, ok := _c.mutation.Subtotal(); !ok {
+		v := supplierbill.DefaultSubtotal
+		_c.mutation.SetSubtotal(v)
+	}
+	if _, ok := _c.mutation.TaxTotal(); !ok {
+		v := supplierbill.DefaultTaxTotal
+		_c.mutation.SetTaxTotal(v)
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		v := supplierbill.DefaultTotal
+		_c.mutation.SetTotal(v)
+	}
+	if _, ok := _c.mutation.AmountPaid(); !ok {
+		v := supplierbill.DefaultAmountPaid
+		_c.mutation.SetAmountPaid(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := supplierbill.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SupplierBillCreate) check() error {
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "SupplierBill.number"`)}
+	}
+	if _, ok := _c.mutation.SupplierReference(); !ok {
+		return &ValidationError{Name: "supplier_reference", err: errors.New(`ent: missing required field "SupplierBill.supplier_reference"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SupplierBill.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := supplierbill.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SupplierBill.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BillDate(); !ok {
+		return &ValidationError{Name: "bill_date", err: errors.New(`ent: missing required field "SupplierBill.bill_date"`)}
+	}
+	if _, ok := _c.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "SupplierBill.due_date"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "SupplierBill.currency"`)}
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "SupplierBill.exchange_rate"`)}
+	}
+	if _, ok := _c.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "SupplierBill.subtotal"`)}
+	}
+	if _, ok := _c.mutation.TaxTotal(); !ok {
+		return &ValidationError{Name: "tax_total", err: errors.New(`ent: missing required field "SupplierBill.tax_total"`)}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "SupplierBill.total"`)}
+	}
+	if _, ok := _c.mutation.AmountPaid(); !ok {
+		return &ValidationError{Name: "amount_paid", err: errors.New(`ent: missing required field "SupplierBill.amount_paid"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SupplierBill.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "SupplierBill.tenant"`)}
+	}
+	if len(_c.mutation.SupplierIDs()) == 0 {
+		return &ValidationError{Name: "supplier", err: errors.New(`ent: missing required edge "SupplierBill.supplier"`)}
+	}
+	return nil
+}
+
+func (_c *SupplierBillCreate) sqlSave(ctx context.Context) (*SupplierBill, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SupplierBillCreate) createSpec() (*SupplierBill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SupplierBill{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(supplierbill.Table, sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(supplierbill.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.SupplierReference(); ok {
+		_spec.SetField(supplierbill.FieldSupplierReference, field.TypeString, value)
+		_node.SupplierReference = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(supplierbill.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.BillDate(); ok {
+		_spec.SetField(supplierbill.FieldBillDate, field.TypeTime, value)
+		_node.BillDate = value
+	}
+	if value, ok := _c.mutation.DueDate(); ok {
+		_spec.SetField(supplierbill.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(supplierbill.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(supplierbill.FieldExchangeRate, field.TypeOther, value)
+		_node.ExchangeRate = value
+	}
+	if value, ok := _c.mutation.Subtotal(); ok {
+		_spec.SetField(supplierbill.FieldSubtotal, field.TypeOther, value)
+		_node.Subtotal = value
+	}
+	if value, ok := _c.mutation.TaxTotal(); ok {
+		_spec.SetField(supplierbill.FieldTaxTotal, field.TypeOther, value)
+		_node.TaxTotal = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(supplierbill.FieldTotal, field.TypeOther, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.AmountPaid(); ok {
+		_spec.SetField(supplierbill.FieldAmountPaid, field.TypeOther, value)
+		_node.AmountPaid = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(supplierbill.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.ApprovedBy(); ok {
+		_spec.SetField(supplierbill.FieldApprovedBy, field.TypeString, value)
+		_node.ApprovedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(supplierbill.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(supplierbill.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.TenantTable,
+			Columns: []string{supplierbill.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_supplier_bills = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SupplierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.SupplierTable,
+			Columns: []string{supplierbill.SupplierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.supplier_bills = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PurchaseOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.PurchaseOrderTable,
+			Columns: []string{supplierbill.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.purchase_order_bills = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.LinesTable,
+			Columns: []string{supplierbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbill.TransactionTable,
+			Columns: []string{supplierbill.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.supplier_bill_transaction = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.PaymentLinesTable,
+			Columns: []string{supplierbill.PaymentLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SupplierBillCreateBulk is the builder for creating many SupplierBill entities in bulk.
+type SupplierBillCreateBulk struct {
+	config
+	err      error
+	builders []*SupplierBillCreate
+}
+
+// Save creates the SupplierBill entities in the database.
+func (_c *SupplierBillCreateBulk) Save(ctx context.Context) ([]*SupplierBill, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SupplierBill, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SupplierBillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SupplierBillCreateBulk) SaveX(ctx context.Context) []*SupplierBill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupplierBillCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupplierBillCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1013 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"sent/ent/paymentrunline"
+	"sent/ent/predicate"
+	"sent/ent/purchaseorder"
+	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SupplierBillQuery is the builder for querying SupplierBill entities.
+type SupplierBillQuery struct {
+	config
+	ctx               *QueryContext
+	order             []supplierbill.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.SupplierBill
+	withTenant        *TenantQuery
+	withSupplier      *SupplierQuery
+	withPurchaseOrder *PurchaseOrderQuery
+	withLines         *SupplierBillLineQuery
+	withTransaction   *TransactionQuery
+	withPaymentLines  *PaymentRunLineQuery
+	withFKs           bool
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SupplierBillQuery builder.
+func (_q *SupplierBillQuery) Where(ps ...predicate.SupplierBill) *SupplierBillQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *SupplierBillQuery) Limit(limit int) *SupplierBillQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *SupplierBillQuery) Offset(offset int) *SupplierBillQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *SupplierBillQuery) Unique(unique bool) *SupplierBillQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *SupplierBillQuery) Order(o ...supplierbill.OrderOption) *SupplierBillQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryTenant chains the current query on the "tenant" edge.
+func (_q *SupplierBillQuery) QueryTenant() *TenantQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbill.Table, supplierbill.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, supplierbill.TenantTable, supplierbill.TenantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySupplier chains the current query on the "supplier" edge.
+func (_q *SupplierBillQuery) QuerySupplier() *SupplierQuery {
+	query := (&SupplierClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbill.Table, supplierbill.FieldID, selector),
+			sqlgraph.To(supplier.Table, supplier.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, supplierbill.SupplierTable, supplierbill.SupplierColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPurchaseOrder chains the current query on the "purchase_order" edge.
+func (_q *SupplierBillQuery) QueryPurchaseOrder() *PurchaseOrderQuery {
+	query := (&PurchaseOrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbill.Table, supplierbill.FieldID, selector),
+			sqlgraph.To(purchaseorder.Table, purchaseorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, supplierbill.PurchaseOrderTable, supplierbill.PurchaseOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLines chains the current query on the "lines" edge.
+func (_q *SupplierBillQuery) QueryLines() *SupplierBillLineQuery {
+	query := (&SupplierBillLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbill.Table, supplierbill.FieldID, selector),
+			sqlgraph.To(supplierbillline.Table, supplierbillline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, supplierbill.LinesTable, supplierbill.LinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransaction chains the current query on the "transaction" edge.
+func (_q *SupplierBillQuery) QueryTransaction() *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbill.Table, supplierbill.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, supplierbill.TransactionTable, supplierbill.TransactionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentLines chains the current query on the "payment_lines" edge.
+func (_q *SupplierBillQuery) QueryPaymentLines() *PaymentRunLineQuery {
+	query := (&PaymentRunLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbill.Table, supplierbill.FieldID, selector),
+			sqlgraph.To(paymentrunline.Table, paymentrunline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, supplierbill.PaymentLinesTable, supplierbill.PaymentLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SupplierBill entity from the query.
+// Returns a *NotFoundError when no SupplierBill was found.
+func (_q *SupplierBillQuery) First(ctx context.Context) (*SupplierBill, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{supplierbill.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *SupplierBillQuery) FirstX(ctx context.Context) *SupplierBill {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SupplierBill ID from the query.
+// Returns a *NotFoundError when no SupplierBill ID was found.
+func (_q *SupplierBillQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{supplierbill.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *SupplierBillQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SupplierBill entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SupplierBill entity is found.
+// Returns a *NotFoundError when no SupplierBill entities are found.
+func (_q *SupplierBillQuery) Only(ctx context.Context) (*SupplierBill, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{supplierbill.Label}
+	default:
+		return nil, &NotSingularError{supplierbill.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *SupplierBillQuery) OnlyX(ctx context.Context) *SupplierBill {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SupplierBill ID in the query.
+// Returns a *NotSingularError when more than one SupplierBill ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *SupplierBillQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{supplierbill.Label}
+	default:
+		err = &NotSingularError{supplierbill.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *SupplierBillQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SupplierBills.
+func (_q *SupplierBillQuery) All(ctx context.Context) ([]*SupplierBill, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SupplierBill, *SupplierBillQuery]()
+	return withInterceptors[[]*SupplierBill](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *SupplierBillQuery) AllX(ctx context.Context) []*SupplierBill {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SupplierBill IDs.
+func (_q *SupplierBillQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(supplierbill.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *SupplierBillQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *SupplierBillQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*SupplierBillQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *SupplierBillQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *SupplierBillQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *SupplierBillQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SupplierBillQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *SupplierBillQuery) Clone() *SupplierBillQuery {
+	if _q == nil {
+		return nil
+	}
+	return &SupplierBillQuery{
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]supplierbill.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.SupplierBill{}, _q.predicates...),
+		withTenant:        _q.withTenant.Clone(),
+		withSupplier:      _q.withSupplier.Clone(),
+		withPurchaseOrder: _q.withPurchaseOrder.Clone(),
+		withLines:         _q.withLines.Clone(),
+		withTransaction:   _q.withTransaction.Clone(),
+		withPaymentLines:  _q.withPaymentLines.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithTenant tells the query-builder to eager-load the nodes that are connected to
+// the "tenant" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillQuery) WithTenant(opts ...func(*TenantQuery)) *SupplierBillQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTenant = query
+	return _q
+}
+
+// WithSupplier tells the query-builder to eager-load the nodes that are connected to
+// the "supplier" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillQuery) WithSupplier(opts ...func(*SupplierQuery)) *SupplierBillQuery {
+	query := (&SupplierClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSupplier = query
+	return _q
+}
+
+// WithPurchaseOrder tells the query-builder to eager-load the nodes that are connected to
+// the "purchase_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillQuery) WithPurchaseOrder(opts ...func(*PurchaseOrderQuery)) *SupplierBillQuery {
+	query := (&PurchaseOrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPurchaseOrder = query
+	return _q
+}
+
+// WithLines tells the query-builder to eager-load the nodes that are connected to
+// the "lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillQuery) WithLines(opts ...func(*SupplierBillLineQuery)) *SupplierBillQuery {
+	query := (&SupplierBillLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLines = query
+	return _q
+}
+
+// WithTransaction tells the query-builder to eager-load the nodes that are connected to
+// the "transaction" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillQuery) WithTransaction(opts ...func(*TransactionQuery)) *SupplierBillQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransaction = query
+	return _q
+}
+
+// WithPaymentLines tells the query-builder to eager-load the nodes that are connected to
+// the "payment_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillQuery) WithPaymentLines(opts ...func(*PaymentRunLineQuery)) *SupplierBillQuery {
+	query := (&PaymentRunLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPaymentLines = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Number string `json:"number,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SupplierBill.Query().
+//		GroupBy(supplierbill.FieldNumber).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *SupplierBillQuery) GroupBy(field string, fields ...string) *SupplierBillGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SupplierBillGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = supplierbill.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Number string `json:"number,omitempty"`
+//	}
+//
+//	client.SupplierBill.Query().
+//		Select(supplierbill.FieldNumber).
+//		Scan(ctx, &v)
+func (_q *SupplierBillQuery) Select(fields ...string) *SupplierBillSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &SupplierBillSelect{SupplierBillQuery: _q}
+	sbuild.label = supplierbill.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SupplierBillSelect configured with the given aggregations.
+func (_q *SupplierBillQuery) Aggregate(fns ...AggregateFunc) *SupplierBillSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *SupplierBillQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !supplierbill.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *SupplierBillQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SupplierBill, error) {
+	var (
+		nodes       = []*SupplierBill{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [6]bool{
+			_q.withTenant != nil,
+			_q.withSupplier != nil,
+			_q.withPurchaseOrder != nil,
+			_q.withLines != nil,
+			_q.withTransaction != nil,
+			_q.withPaymentLines != nil,
+		}
+	)
+	if _q.withTenant != nil || _q.withSupplier != nil || _q.withPurchaseOrder != nil || _q.withTransaction != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, supplierbill.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SupplierBill).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SupplierBill{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withTenant; query != nil {
+		if err := _q.loadTenant(ctx, query, nodes, nil,
+			func(n *SupplierBill, e *Tenant) { n.Edges.Tenant = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSupplier; query != nil {
+		if err := _q.loadSupplier(ctx, query, nodes, nil,
+			func(n *SupplierBill, e *Supplier) { n.Edges.Supplier = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPurchaseOrder; query != nil {
+		if err := _q.loadPurchaseOrder(ctx, query, nodes, nil,
+			func(n *SupplierBill, e *PurchaseOrder) { n.Edges.PurchaseOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLines; query != nil {
+		if err := _q.loadLines(ctx, query, nodes,
+			func(n *SupplierBill) { n.Edges.Lines = []*SupplierBillLine{} },
+			func(n *SupplierBill, e *SupplierBillLine) { n.Edges.Lines = append(n.Edges.Lines, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransaction; query != nil {
+		if err := _q.loadTransaction(ctx, query, nodes, nil,
+			func(n *SupplierBill, e *Transaction) { n.Edges.Transaction = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPaymentLines; query != nil {
+		if err := _q.loadPaymentLines(ctx, query, nodes,
+			func(n *SupplierBill) { n.Edges.PaymentLines = []*PaymentRunLine{} },
+			func(n *SupplierBill, e *PaymentRunLine) { n.Edges.PaymentLines = append(n.Edges.PaymentLines, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *SupplierBillQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []*SupplierBill, init func(*SupplierBill), assign func(*SupplierBill, *Tenant)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SupplierBill)
+	for i := range nodes {
+		if nodes[i].tenant_supplier_bills == nil {
+			continue
+		}
+		fk := *nodes[i].tenant_supplier_bills
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tenant_supplier_bills" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SupplierBillQuery) loadSupplier(ctx context.Context, query *SupplierQuery, nodes []*SupplierBill, init func(*SupplierBill), assign func(*SupplierBill, *Supplier)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SupplierBill)
+	for i := range nodes {
+		if nodes[i].supplier_bills == nil {
+			continue
+		}
+		fk := *nodes[i].supplier_bills
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(supplier.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "supplier_bills" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SupplierBillQuery) loadPurchaseOrder(ctx context.Context, query *PurchaseOrderQuery, nodes []*SupplierBill, init func(*SupplierBill), assign func(*SupplierBill, *PurchaseOrder)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SupplierBill)
+	for i := range nodes {
+		if nodes[i].purchase_order_bills == nil {
+			continue
+		}
+		fk := *nodes[i].purchase_order_bills
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(purchaseorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "purchase_order_bills" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SupplierBillQuery) loadLines(ctx context.Context, query *SupplierBillLineQuery, nodes []*SupplierBill, init func(*SupplierBill), assign func(*SupplierBill, *SupplierBillLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SupplierBill)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SupplierBillLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(supplierbill.LinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.supplier_bill_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "supplier_bill_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "supplier_bill_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SupplierBillQuery) loadTransaction(ctx context.Context, query *TransactionQuery, nodes []*SupplierBill, init func(*SupplierBill), assign func(*SupplierBill, *Transaction)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SupplierBill)
+	for i := range nodes {
+		if nodes[i].supplier_bill_transaction == nil {
+			continue
+		}
+		fk := *nodes[i].supplier_bill_transaction
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "supplier_bill_transaction" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SupplierBillQuery) loadPaymentLines(ctx context.Context, query *PaymentRunLineQuery, nodes []*SupplierBill, init func(*SupplierBill), assign func(*SupplierBill, *PaymentRunLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SupplierBill)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PaymentRunLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(supplierbill.PaymentLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.supplier_bill_payment_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "supplier_bill_payment_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "supplier_bill_payment_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *SupplierBillQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *SupplierBillQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(supplierbill.Table, supplierbill.Columns, sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, supplierbill.FieldID)
+		for i := range fields {
+			if fields[i] != supplierbill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *SupplierBillQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(supplierbill.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = supplierbill.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SupplierBillQuery) Modify(modifiers ...func(s *sql.Selector)) *SupplierBillSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// SupplierBillGroupBy is the group-by builder for SupplierBill entities.
+type SupplierBillGroupBy struct {
+	selector
+	build *SupplierBillQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *SupplierBillGroupBy) Aggregate(fns ...AggregateFunc) *SupplierBillGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *SupplierBillGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SupplierBillQuery, *SupplierBillGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *SupplierBillGroupBy) sqlScan(ctx context.Context, root *SupplierBillQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SupplierBillSelect is the builder for selecting fields of SupplierBill entities.
+type SupplierBillSelect struct {
+	*SupplierBillQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *SupplierBillSelect) Aggregate(fns ...AggregateFunc) *SupplierBillSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *SupplierBillSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SupplierBillQuery, *SupplierBillSelect](ctx, _s.SupplierBillQuery, _s, _s.inters, v)
+}
+
+func (_s *SupplierBillSelect) sqlScan(ctx context.Context, root *SupplierBillQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SupplierBillSelect) Modify(modifiers ...func(s *sql.Selector)) *SupplierBillSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,1491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/paymentrunline"
+	"sent/ent/predicate"
+	"sent/ent/purchaseorder"
+	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierBillUpdate is the builder for updating SupplierBill entities.
+type SupplierBillUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SupplierBillMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SupplierBillUpdate builder.
+func (_u *SupplierBillUpdate) Where(ps ...predicate.SupplierBill) *SupplierBillUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *SupplierBillUpdate) SetNumber(v string) *SupplierBillUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableNumber(v *string) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetSupplierReference sets the "supplier_reference" field.
+func (_u *SupplierBillUpdate) SetSupplierReference(v string) *SupplierBillUpdate {
+	_u.mutation.SetSupplierReference(v)
+	return _u
+}
+
+// SetNillableSupplierReference sets the "supplier_reference" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableSupplierReference(v *string) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetSupplierReference(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SupplierBillUpdate) SetStatus(v supplierbill.Status) *SupplierBillUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableStatus(v *supplierbill.Status) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetBillDate sets the "bill_date" field.
+func (_u *SupplierBillUpdate) SetBillDate(v time.Time) *SupplierBillUpdate {
+	_u.mutation.SetBillDate(v)
+	return _u
+}
+
+// SetNillableBillDate sets the "bill_date" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableBillDate(v *time.Time) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetBillDate(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *SupplierBillUpdate) SetDueDate(v time.Time) *SupplierBillUpdate {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableDueDate(v *time.Time) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SupplierBillUpdate) SetCurrency(v string) *SupplierBillUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableCurrency(v *string) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *SupplierBillUpdate) SetExchangeRate(v decimal.Decimal) *SupplierBillUpdate {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableExchangeRate(v *decimal.Decimal) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_u *SupplierBillUpdate) SetSubtotal(v decimal.Decimal) *SupplierBillUpdate {
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableSubtotal(v *decimal.Decimal) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_u *SupplierBillUpdate) SetTaxTotal(v decimal.Decimal) *SupplierBillUpdate {
+	_u.mutation.SetTaxTotal(v)
+	return _u
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableTaxTotal(v *decimal.Decimal) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetTaxTotal(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *SupplierBillUpdate) SetTotal(v decimal.Decimal) *SupplierBillUpdate {
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableTotal(v *decimal.Decimal) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (_u *SupplierBillUpdate) SetAmountPaid(v decimal.Decimal) *SupplierBillUpdate {
+	_u.mutation.SetAmountPaid(v)
+	return _u
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableAmountPaid(v *decimal.Decimal) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetAmountPaid(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *SupplierBillUpdate) SetNotes(v string) *SupplierBillUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableNotes(v *string) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *SupplierBillUpdate) ClearNotes() *SupplierBillUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *SupplierBillUpdate) SetApprovedBy(v string) *SupplierBillUpdate {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableApprovedBy(v *string) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *SupplierBillUpdate) ClearApprovedBy() *SupplierBillUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *SupplierBillUpdate) SetApprovedAt(v time.Time) *SupplierBillUpdate {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableApprovedAt(v *time.Time) *SupplierBillUpdate {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *SupplierBillUpdate) ClearApprovedAt() *SupplierBillUpdate {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *SupplierBillUpdate) SetTenantID(id int) *SupplierBillUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *SupplierBillUpdate) SetTenant(v *Tenant) *SupplierBillUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetSupplierID sets the "supplier" edge to the Supplier entity by ID.
+func (_u *SupplierBillUpdate) SetSupplierID(id int) *SupplierBillUpdate {
+	_u.mutation.SetSupplierID(id)
+	return _u
+}
+
+// SetSupplier sets the "supplier" edge to the Supplier entity.
+func (_u *SupplierBillUpdate) SetSupplier(v *Supplier) *SupplierBillUpdate {
+	return _u.SetSupplierID(v.ID)
+}
+
+// SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
+func (_u *SupplierBillUpdate) SetPurchaseOrderID(id int) *SupplierBillUpdate {
+	_u.mutation.SetPurchaseOrderID(id)
+	return _u
+}
+
+// SetNillablePurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillablePurchaseOrderID(id *int) *SupplierBillUpdate {
+	if id != nil {
+		_u = _u.SetPurchaseOrderID(*id)
+	}
+	return _u
+}
+
+// SetPurchaseOrder sets the "purchase_order" edge to the PurchaseOrder entity.
+func (_u *SupplierBillUpdate) SetPurchaseOrder(v *PurchaseOrder) *SupplierBillUpdate {
+	return _u.SetPurchaseOrderID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the SupplierBillLine entity by IDs.
+func (_u *SupplierBillUpdate) AddLineIDs(ids ...int) *SupplierBillUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the SupplierBillLine entity.
+func (_u *SupplierBillUpdate) AddLines(v ...*SupplierBillLine) *SupplierBillUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *SupplierBillUpdate) SetTransactionID(id int) *SupplierBillUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *SupplierBillUpdate) SetNillableTransactionID(id *int) *SupplierBillUpdate {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *SupplierBillUpdate) SetTransaction(v *Transaction) *SupplierBillUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// AddPaymentLineIDs adds the "payment_lines" edge to the PaymentRunLine entity by IDs.
+func (_u *SupplierBillUpdate) AddPaymentLineIDs(ids ...int) *SupplierBillUpdate {
+	_u.mutation.AddPaymentLineIDs(ids...)
+	return _u
+}
+
+// AddPaymentLines adds the "payment_lines" edges to the PaymentRunLine entity.
+func (_u *SupplierBillUpdate) AddPaymentLines(v ...*PaymentRunLine) *SupplierBillUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentLineIDs(ids...)
+}
+
+// Mutation returns the SupplierBillMutation object of the builder.
+func (_u *SupplierBillUpdate) Mutation() *SupplierBillMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *SupplierBillUpdate) ClearTenant() *SupplierBillUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearSupplier clears the "supplier" edge to the Supplier entity.
+func (_u *SupplierBillUpdate) ClearSupplier() *SupplierBillUpdate {
+	_u.mutation.ClearSupplier()
+	return _u
+}
+
+// ClearPurchaseOrder clears the "purchase_order" edge to the PurchaseOrder entity.
+func (_u *SupplierBillUpdate) ClearPurchaseOrder() *SupplierBillUpdate {
+	_u.mutation.ClearPurchaseOrder()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the SupplierBillLine entity.
+func (_u *SupplierBillUpdate) ClearLines() *SupplierBillUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to SupplierBillLine entities by IDs.
+func (_u *SupplierBillUpdate) RemoveLineIDs(ids ...int) *SupplierBillUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to SupplierBillLine entities.
+func (_u *SupplierBillUpdate) RemoveLines(v ...*SupplierBillLine) *SupplierBillUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *SupplierBillUpdate) ClearTransaction() *SupplierBillUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearPaymentLines clears all "payment_lines" edges to the PaymentRunLine entity.
+func (_u *SupplierBillUpdate) ClearPaymentLines() *SupplierBillUpdate {
+	_u.mutation.ClearPaymentLines()
+	return _u
+}
+
+// RemovePaymentLineIDs removes the "payment_lines" edge to PaymentRunLine entities by IDs.
+func (_u *SupplierBillUpdate) RemovePaymentLineIDs(ids ...int) *SupplierBillUpdate {
+	_u.mutation.RemovePaymentLineIDs(ids...)
+	return _u
+}
+
+// RemovePaymentLines removes "payment_lines" edges to PaymentRunLine entities.
+func (_u *SupplierBillUpdate) RemovePaymentLines(v ...*PaymentRunLine) *SupplierBillUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SupplierBillUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SupplierBillUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SupplierBillUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SupplierBillUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SupplierBillUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := supplierbill.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SupplierBill.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupplierBill.tenant"`)
+	}
+	if _u.mutation.SupplierCleared() && len(_u.mutation.SupplierIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupplierBill.supplier"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SupplierBillUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SupplierBillUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SupplierBillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(supplierbill.Table, supplierbill.Columns, sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(supplierbill.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SupplierReference(); ok {
+		_spec.SetField(supplierbill.FieldSupplierReference, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(supplierbill.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.BillDate(); ok {
+		_spec.SetField(supplierbill.FieldBillDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(supplierbill.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(supplierbill.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(supplierbill.FieldExchangeRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(supplierbill.FieldSubtotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxTotal(); ok {
+		_spec.SetField(supplierbill.FieldTaxTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(supplierbill.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AmountPaid(); ok {
+		_spec.SetField(supplierbill.FieldAmountPaid, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(supplierbill.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(supplierbill.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(supplierbill.FieldApprovedBy, field.TypeString, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(supplierbill.FieldApprovedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(supplierbill.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(supplierbill.FieldApprovedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.TenantTable,
+			Columns: []string{supplierbill.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.TenantTable,
+			Columns: []string{supplierbill.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SupplierCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.SupplierTable,
+			Columns: []string{supplierbill.SupplierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SupplierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.SupplierTable,
+			Columns: []string{supplierbill.SupplierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchaseOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.PurchaseOrderTable,
+			Columns: []string{supplierbill.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchaseOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.PurchaseOrderTable,
+			Columns: []string{supplierbill.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.LinesTable,
+			Columns: []string{supplierbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.LinesTable,
+			Columns: []string{supplierbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.LinesTable,
+			Columns: []string{supplierbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbill.TransactionTable,
+			Columns: []string{supplierbill.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbill.TransactionTable,
+			Columns: []string{supplierbill.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.PaymentLinesTable,
+			Columns: []string{supplierbill.PaymentLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentLinesIDs(); len(nodes) > 0 && !_u.mutation.PaymentLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.PaymentLinesTable,
+			Columns: []string{supplierbill.PaymentLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.PaymentLinesTable,
+			Columns: []string{supplierbill.PaymentLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{supplierbill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SupplierBillUpdateOne is the builder for updating a single SupplierBill entity.
+type SupplierBillUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SupplierBillMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetNumber sets the "number" field.
+func (_u *SupplierBillUpdateOne) SetNumber(v string) *SupplierBillUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableNumber(v *string) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetSupplierReference sets the "supplier_reference" field.
+func (_u *SupplierBillUpdateOne) SetSupplierReference(v string) *SupplierBillUpdateOne {
+	_u.mutation.SetSupplierReference(v)
+	return _u
+}
+
+// SetNillableSupplierReference sets the "supplier_reference" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableSupplierReference(v *string) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetSupplierReference(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SupplierBillUpdateOne) SetStatus(v supplierbill.Status) *SupplierBillUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableStatus(v *supplierbill.Status) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetBillDate sets the "bill_date" field.
+func (_u *SupplierBillUpdateOne) SetBillDate(v time.Time) *SupplierBillUpdateOne {
+	_u.mutation.SetBillDate(v)
+	return _u
+}
+
+// SetNillableBillDate sets the "bill_date" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableBillDate(v *time.Time) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetBillDate(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *SupplierBillUpdateOne) SetDueDate(v time.Time) *SupplierBillUpdateOne {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableDueDate(v *time.Time) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SupplierBillUpdateOne) SetCurrency(v string) *SupplierBillUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableCurrency(v *string) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *SupplierBillUpdateOne) SetExchangeRate(v decimal.Decimal) *SupplierBillUpdateOne {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableExchangeRate(v *decimal.Decimal) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_u *SupplierBillUpdateOne) SetSubtotal(v decimal.Decimal) *SupplierBillUpdateOne {
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableSubtotal(v *decimal.Decimal) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_u *SupplierBillUpdateOne) SetTaxTotal(v decimal.Decimal) *SupplierBillUpdateOne {
+	_u.mutation.SetTaxTotal(v)
+	return _u
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableTaxTotal(v *decimal.Decimal) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetTaxTotal(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *SupplierBillUpdateOne) SetTotal(v decimal.Decimal) *SupplierBillUpdateOne {
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableTotal(v *decimal.Decimal) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (_u *SupplierBillUpdateOne) SetAmountPaid(v decimal.Decimal) *SupplierBillUpdateOne {
+	_u.mutation.SetAmountPaid(v)
+	return _u
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableAmountPaid(v *decimal.Decimal) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetAmountPaid(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *SupplierBillUpdateOne) SetNotes(v string) *SupplierBillUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableNotes(v *string) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *SupplierBillUpdateOne) ClearNotes() *SupplierBillUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *SupplierBillUpdateOne) SetApprovedBy(v string) *SupplierBillUpdateOne {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableApprovedBy(v *string) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *SupplierBillUpdateOne) ClearApprovedBy() *SupplierBillUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *SupplierBillUpdateOne) SetApprovedAt(v time.Time) *SupplierBillUpdateOne {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableApprovedAt(v *time.Time) *SupplierBillUpdateOne {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *SupplierBillUpdateOne) ClearApprovedAt() *SupplierBillUpdateOne {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *SupplierBillUpdateOne) SetTenantID(id int) *SupplierBillUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *SupplierBillUpdateOne) SetTenant(v *Tenant) *SupplierBillUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetSupplierID sets the "supplier" edge to the Supplier entity by ID.
+func (_u *SupplierBillUpdateOne) SetSupplierID(id int) *SupplierBillUpdateOne {
+	_u.mutation.SetSupplierID(id)
+	return _u
+}
+
+// SetSupplier sets the "supplier" edge to the Supplier entity.
+func (_u *SupplierBillUpdateOne) SetSupplier(v *Supplier) *SupplierBillUpdateOne {
+	return _u.SetSupplierID(v.ID)
+}
+
+// SetPurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID.
+func (_u *SupplierBillUpdateOne) SetPurchaseOrderID(id int) *SupplierBillUpdateOne {
+	_u.mutation.SetPurchaseOrderID(id)
+	return _u
+}
+
+// SetNillablePurchaseOrderID sets the "purchase_order" edge to the PurchaseOrder entity by ID if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillablePurchaseOrderID(id *int) *SupplierBillUpdateOne {
+	if id != nil {
+		_u = _u.SetPurchaseOrderID(*id)
+	}
+	return _u
+}
+
+// SetPurchaseOrder sets the "purchase_order" edge to the PurchaseOrder entity.
+func (_u *SupplierBillUpdateOne) SetPurchaseOrder(v *PurchaseOrder) *SupplierBillUpdateOne {
+	return _u.SetPurchaseOrderID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the SupplierBillLine entity by IDs.
+func (_u *SupplierBillUpdateOne) AddLineIDs(ids ...int) *SupplierBillUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the SupplierBillLine entity.
+func (_u *SupplierBillUpdateOne) AddLines(v ...*SupplierBillLine) *SupplierBillUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *SupplierBillUpdateOne) SetTransactionID(id int) *SupplierBillUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *SupplierBillUpdateOne) SetNillableTransactionID(id *int) *SupplierBillUpdateOne {
+	if id != nil {
+		_u = _u.SetTransactionID(*id)
+	}
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *SupplierBillUpdateOne) SetTransaction(v *Transaction) *SupplierBillUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// AddPaymentLineIDs adds the "payment_lines" edge to the PaymentRunLine entity by IDs.
+func (_u *SupplierBillUpdateOne) AddPaymentLineIDs(ids ...int) *SupplierBillUpdateOne {
+	_u.mutation.AddPaymentLineIDs(ids...)
+	return _u
+}
+
+// AddPaymentLines adds the "payment_lines" edges to the PaymentRunLine entity.
+func (_u *SupplierBillUpdateOne) AddPaymentLines(v ...*PaymentRunLine) *SupplierBillUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentLineIDs(ids...)
+}
+
+// Mutation returns the SupplierBillMutation object of the builder.
+func (_u *SupplierBillUpdateOne) Mutation() *SupplierBillMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *SupplierBillUpdateOne) ClearTenant() *SupplierBillUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearSupplier clears the "supplier" edge to the Supplier entity.
+func (_u *SupplierBillUpdateOne) ClearSupplier() *SupplierBillUpdateOne {
+	_u.mutation.ClearSupplier()
+	return _u
+}
+
+// ClearPurchaseOrder clears the "purchase_order" edge to the PurchaseOrder entity.
+func (_u *SupplierBillUpdateOne) ClearPurchaseOrder() *SupplierBillUpdateOne {
+	_u.mutation.ClearPurchaseOrder()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the SupplierBillLine entity.
+func (_u *SupplierBillUpdateOne) ClearLines() *SupplierBillUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to SupplierBillLine entities by IDs.
+func (_u *SupplierBillUpdateOne) RemoveLineIDs(ids ...int) *SupplierBillUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to SupplierBillLine entities.
+func (_u *SupplierBillUpdateOne) RemoveLines(v ...*SupplierBillLine) *SupplierBillUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *SupplierBillUpdateOne) ClearTransaction() *SupplierBillUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearPaymentLines clears all "payment_lines" edges to the PaymentRunLine entity.
+func (_u *SupplierBillUpdateOne) ClearPaymentLines() *SupplierBillUpdateOne {
+	_u.mutation.ClearPaymentLines()
+	return _u
+}
+
+// RemovePaymentLineIDs removes the "payment_lines" edge to PaymentRunLine entities by IDs.
+func (_u *SupplierBillUpdateOne) RemovePaymentLineIDs(ids ...int) *SupplierBillUpdateOne {
+	_u.mutation.RemovePaymentLineIDs(ids...)
+	return _u
+}
+
+// RemovePaymentLines removes "payment_lines" edges to PaymentRunLine entities.
+func (_u *SupplierBillUpdateOne) RemovePaymentLines(v ...*PaymentRunLine) *SupplierBillUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentLineIDs(ids...)
+}
+
+// Where appends a list predicates to the SupplierBillUpdate builder.
+func (_u *SupplierBillUpdateOne) Where(ps ...predicate.SupplierBill) *SupplierBillUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SupplierBillUpdateOne) Select(field string, fields ...string) *SupplierBillUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SupplierBill entity.
+func (_u *SupplierBillUpdateOne) Save(ctx context.Context) (*SupplierBill, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SupplierBillUpdateOne) SaveX(ctx context.Context) *SupplierBill {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SupplierBillUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SupplierBillUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SupplierBillUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := supplierbill.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SupplierBill.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupplierBill.tenant"`)
+	}
+	if _u.mutation.SupplierCleared() && len(_u.mutation.SupplierIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupplierBill.supplier"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SupplierBillUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SupplierBillUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SupplierBillUpdateOne) sqlSave(ctx context.Context) (_node *SupplierBill, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(supplierbill.Table, supplierbill.Columns, sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SupplierBill.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, supplierbill.FieldID)
+		for _, f := range fields {
+			if !supplierbill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != supplierbill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(supplierbill.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SupplierReference(); ok {
+		_spec.SetField(supplierbill.FieldSupplierReference, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(supplierbill.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.BillDate(); ok {
+		_spec.SetField(supplierbill.FieldBillDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(supplierbill.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(supplierbill.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(supplierbill.FieldExchangeRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(supplierbill.FieldSubtotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxTotal(); ok {
+		_spec.SetField(supplierbill.FieldTaxTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(supplierbill.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AmountPaid(); ok {
+		_spec.SetField(supplierbill.FieldAmountPaid, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(supplierbill.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(supplierbill.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(supplierbill.FieldApprovedBy, field.TypeString, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(supplierbill.FieldApprovedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(supplierbill.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(supplierbill.FieldApprovedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.TenantTable,
+			Columns: []string{supplierbill.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.TenantTable,
+			Columns: []string{supplierbill.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SupplierCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.SupplierTable,
+			Columns: []string{supplierbill.SupplierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SupplierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.SupplierTable,
+			Columns: []string{supplierbill.SupplierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchaseOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.PurchaseOrderTable,
+			Columns: []string{supplierbill.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchaseOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbill.PurchaseOrderTable,
+			Columns: []string{supplierbill.PurchaseOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.LinesTable,
+			Columns: []string{supplierbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.LinesTable,
+			Columns: []string{supplierbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.LinesTable,
+			Columns: []string{supplierbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbill.TransactionTable,
+			Columns: []string{supplierbill.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbill.TransactionTable,
+			Columns: []string{supplierbill.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.PaymentLinesTable,
+			Columns: []string{supplierbill.PaymentLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentLinesIDs(); len(nodes) > 0 && !_u.mutation.PaymentLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.PaymentLinesTable,
+			Columns: []string{supplierbill.PaymentLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   supplierbill.PaymentLinesTable,
+			Columns: []string{supplierbill.PaymentLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SupplierBill{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{supplierbill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/purchaseorderline"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierBillLine is the model entity for the SupplierBillLine schema.
+type SupplierBillLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice decimal.Decimal `json:"unit_price,omitempty"`
+	// TaxCode holds the value of the "tax_code" field.
+	TaxCode string `json:"tax_code,omitempty"`
+	// TaxRate holds the value of the "tax_rate" field.
+	TaxRate decimal.Decimal `json:"tax_rate,omitempty"`
+	// NetAmount holds the value of the "net_amount" field.
+	NetAmount decimal.Decimal `json:"net_amount,omitempty"`
+	// MatchStatus holds the value of the "match_status" field.
+	MatchStatus supplierbillline.MatchStatus `json:"match_status,omitempty"`
+	// QuantityVariance holds the value of the "quantity_variance" field.
+	QuantityVariance decimal.Decimal `json:"quantity_variance,omitempty"`
+	// PriceVariance holds the value of the "price_variance" field.
+	PriceVariance decimal.Decimal `json:"price_variance,omitempty"`
+	// MatchNote holds the value of the "match_note" field.
+	MatchNote string `json:"match_note,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SupplierBillLineQuery when eager-loading is set.
+	Edges                          SupplierBillLineEdges `json:"edges"`
+	purchase_order_line_bill_lines *int
+	supplier_bill_lines            *int
+	supplier_bill_line_account     *int
+	selectValues                   sql.SelectValues
+}
+
+// SupplierBillLineEdges holds the relations/edges for other nodes in the graph.
+type SupplierBillLineEdges struct {
+	// Bill holds the value of the bill edge.
+	Bill *SupplierBill `json:"bill,omitempty"`
+	// PurchaseOrderLine holds the value of the purchase_order_line edge.
+	PurchaseOrderLine *PurchaseOrderLine `json:"purchase_order_line,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// BillOrErr returns the Bill value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupplierBillLineEdges) BillOrErr() (*SupplierBill, error) {
+	if e.Bill != nil {
+		return e.Bill, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: supplierbill.Label}
+	}
+	return nil, &NotLoadedError{edge: "bill"}
+}
+
+// PurchaseOrderLineOrErr returns the PurchaseOrderLine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupplierBillLineEdges) PurchaseOrderLineOrErr() (*PurchaseOrderLine, error) {
+	if e.PurchaseOrderLine != nil {
+		return e.PurchaseOrderLine, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: purchaseorderline.Label}
+	}
+	return nil, &NotLoadedError{edge: "purchase_order_line"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupplierBillLineEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SupplierBillLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case supplierbillline.FieldQuantity, supplierbillline.FieldUnitPrice, supplierbillline.FieldTaxRate, supplierbillline.FieldNetAmount, supplierbillline.FieldQuantityVariance, supplierbillline.FieldPriceVariance:
+			values[i] = new(decimal.Decimal)
+		case supplierbillline.FieldID, supplierbillline.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case supplierbillline.FieldDescription, supplierbillline.FieldTaxCode, supplierbillline.FieldMatchStatus, supplierbillline.FieldMatchNote:
+			values[i] = new(sql.NullString)
+		case supplierbillline.ForeignKeys[0]: // purchase_order_line_bill_lines
+			values[i] = new(sql.NullInt64)
+		case supplierbillline.ForeignKeys[1]: // supplier_bill_lines
+			values[i] = new(sql.NullInt64)
+		case supplierbillline.ForeignKeys[2]: // supplier_bill_line_account
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SupplierBillLine fields.
+func (_m *SupplierBillLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case supplierbillline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case supplierbillline.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case supplierbillline.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case supplierbillline.FieldUnitPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil {
+				_m.UnitPrice = *value
+			}
+		case supplierbillline.FieldTaxCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_code", values[i])
+			} else if value.Valid {
+				_m.TaxCode = value.String
+			}
+		case supplierbillline.FieldTaxRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value != nil {
+				_m.TaxRate = *value
+			}
+		case supplierbillline.FieldNetAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field net_amount", values[i])
+			} else if value != nil {
+				_m.NetAmount = *value
+			}
+		case supplierbillline.FieldMatchStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field match_status", values[i])
+			} else if value.Valid {
+				_m.MatchStatus = supplierbillline.MatchStatus(value.String)
+			}
+		case supplierbillline.FieldQuantityVariance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity_variance", values[i])
+			} else if value != nil {
+				_m.QuantityVariance = *value
+			}
+		case supplierbillline.FieldPriceVariance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price_variance", values[i])
+			} else if value != nil {
+				_m.PriceVariance = *value
+			}
+		case supplierbillline.FieldMatchNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field match_note", values[i])
+			} else if value.Valid {
+				_m.MatchNote = value.String
+			}
+		case supplierbillline.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case supplierbillline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field purchase_order_line_bill_lines", value)
+			} else if value.Valid {
+				_m.purchase_order_line_bill_lines = new(int)
+				*_m.purchase_order_line_bill_lines = int(value.Int64)
+			}
+		case supplierbillline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field supplier_bill_lines", value)
+			} else if value.Valid {
+				_m.supplier_bill_lines = new(int)
+				*_m.supplier_bill_lines = int(value.Int64)
+			}
+		case supplierbillline.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field supplier_bill_line_account", value)
+			} else if value.Valid {
+				_m.supplier_bill_line_account = new(int)
+				*_m.supplier_bill_line_account = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SupplierBillLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *SupplierBillLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBill queries the "bill" edge of the SupplierBillLine entity.
+func (_m *SupplierBillLine) QueryBill() *SupplierBillQuery {
+	return NewSupplierBillLineClient(_m.config).QueryBill(_m)
+}
+
+// QueryPurchaseOrderLine queries the "purchase_order_line" edge of the SupplierBillLine entity.
+func (_m *SupplierBillLine) QueryPurchaseOrderLine() *PurchaseOrderLineQuery {
+	return NewSupplierBillLineClient(_m.config).QueryPurchaseOrderLine(_m)
+}
+
+// QueryAccount queries the "account" edge of the SupplierBillLine entity.
+func (_m *SupplierBillLine) QueryAccount() *AccountQuery {
+	return NewSupplierBillLineClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this SupplierBillLine.
+// Note that you need to call SupplierBillLine.Unwrap() before calling this method if this SupplierBillLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SupplierBillLine) Update() *SupplierBillLineUpdateOne {
+	return NewSupplierBillLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SupplierBillLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SupplierBillLine) Unwrap() *SupplierBillLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SupplierBillLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SupplierBillLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("SupplierBillLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteString(", ")
+	builder.WriteString("tax_code=")
+	builder.WriteString(_m.TaxCode)
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("net_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("match_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MatchStatus))
+	builder.WriteString(", ")
+	builder.WriteString("quantity_variance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuantityVariance))
+	builder.WriteString(", ")
+	builder.WriteString("price_variance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceVariance))
+	builder.WriteString(", ")
+	builder.WriteString("match_note=")
+	builder.WriteString(_m.MatchNote)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SupplierBillLines is a parsable slice of SupplierBillLine.
+type SupplierBillLines []*SupplierBillLine
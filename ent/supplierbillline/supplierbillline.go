@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package supplierbillline
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the supplierbillline type in the database.
+	Label = "supplier_bill_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
+	// FieldTaxCode holds the string denoting the tax_code field in the database.
+	FieldTaxCode = "tax_code"
+	// FieldTaxRate holds the string denoting the tax_rate field in the database.
+	FieldTaxRate = "tax_rate"
+	// FieldNetAmount holds the string denoting the net_amount field in the database.
+	FieldNetAmount = "net_amount"
+	// FieldMatchStatus holds the string denoting the match_status field in the database.
+	FieldMatchStatus = "match_status"
+	// FieldQuantityVariance holds the string denoting the quantity_variance field in the database.
+	FieldQuantityVariance = "quantity_variance"
+	// FieldPriceVariance holds the string denoting the price_variance field in the database.
+	FieldPriceVariance = "price_variance"
+	// FieldMatchNote holds the string denoting the match_note field in the database.
+	FieldMatchNote = "match_note"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// EdgeBill holds the string denoting the bill edge name in mutations.
+	EdgeBill = "bill"
+	// EdgePurchaseOrderLine holds the string denoting the purchase_order_line edge name in mutations.
+	EdgePurchaseOrderLine = "purchase_order_line"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the supplierbillline in the database.
+	Table = "supplier_bill_lines"
+	// BillTable is the table that holds the bill relation/edge.
+	BillTable = "supplier_bill_lines"
+	// BillInverseTable is the table name for the SupplierBill entity.
+	// It exists in this package in order to avoid circular dependency with the "supplierbill" package.
+	BillInverseTable = "supplier_bills"
+	// BillColumn is the table column denoting the bill relation/edge.
+	BillColumn = "supplier_bill_lines"
+	// PurchaseOrderLineTable is the table that holds the purchase_order_line relation/edge.
+	PurchaseOrderLineTable = "supplier_bill_lines"
+	// PurchaseOrderLineInverseTable is the table name for the PurchaseOrderLine entity.
+	// It exists in this package in order to avoid circular dependency with the "purchaseorderline" package.
+	PurchaseOrderLineInverseTable = "purchase_order_lines"
+	// PurchaseOrderLineColumn is the table column denoting the purchase_order_line relation/edge.
+	PurchaseOrderLineColumn = "purchase_order_line_bill_lines"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "supplier_bill_lines"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "supplier_bill_line_account"
+)
+
+// Columns holds all SQL columns for supplierbillline fields.
+var Columns = []string{
+	FieldID,
+	FieldDescription,
+	FieldQuantity,
+	FieldUnitPrice,
+	FieldTaxCode,
+	FieldTaxRate,
+	FieldNetAmount,
+	FieldMatchStatus,
+	FieldQuantityVariance,
+	FieldPriceVariance,
+	FieldMatchNote,
+	FieldPosition,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "supplier_bill_lines"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"purchase_order_line_bill_lines",
+	"supplier_bill_lines",
+	"supplier_bill_line_account",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultQuantity holds the default value on creation for the "quantity" field.
+	DefaultQuantity decimal.Decimal
+	// DefaultUnitPrice holds the default value on creation for the "unit_price" field.
+	DefaultUnitPrice decimal.Decimal
+	// DefaultTaxRate holds the default value on creation for the "tax_rate" field.
+	DefaultTaxRate decimal.Decimal
+	// DefaultNetAmount holds the default value on creation for the "net_amount" field.
+	DefaultNetAmount decimal.Decimal
+	// DefaultQuantityVariance holds the default value on creation for the "quantity_variance" field.
+	DefaultQuantityVariance decimal.Decimal
+	// DefaultPriceVariance holds the default value on creation for the "price_variance" field.
+	DefaultPriceVariance decimal.Decimal
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+)
+
+// MatchStatus defines the type for the "match_status" enum field.
+type MatchStatus string
+
+// MatchStatusNoOrder is the default value of the MatchStatus enum.
+const DefaultMatchStatus = MatchStatusNoOrder
+
+// MatchStatus values.
+const (
+	MatchStatusMatched  MatchStatus = "matched"
+	MatchStatusVariance MatchStatus = "variance"
+	MatchStatusNoOrder  MatchStatus = "no_order"
+)
+
+func (ms MatchStatus) String() string {
+	return string(ms)
+}
+
+// MatchStatusValidator is a validator for the "match_status" field enum values. It is called by the builders before save.
+func MatchStatusValidator(ms MatchStatus) error {
+	switch ms {
+	case MatchStatusMatched, MatchStatusVariance, MatchStatusNoOrder:
+		return nil
+	default:
+		return fmt.Errorf("supplierbillline: invalid enum value for match_status field: %q", ms)
+	}
+}
+
+// OrderOption defines the ordering options for the SupplierBillLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByUnitPrice orders the results by the unit_price field.
+func ByUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitPrice, opts...).ToFunc()
+}
+
+// ByTaxCode orders the results by the tax_code field.
+func ByTaxCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxCode, opts...).ToFunc()
+}
+
+// ByTaxRate orders the results by the tax_rate field.
+func ByTaxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxRate, opts...).ToFunc()
+}
+
+// ByNetAmount orders the results by the net_amount field.
+func ByNetAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetAmount, opts...).ToFunc()
+}
+
+// ByMatchStatus orders the results by the match_status field.
+func ByMatchStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchStatus, opts...).ToFunc()
+}
+
+// ByQuantityVariance orders the results by the quantity_variance field.
+func ByQuantityVariance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantityVariance, opts...).ToFunc()
+}
+
+// ByPriceVariance orders the results by the price_variance field.
+func ByPriceVariance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceVariance, opts...).ToFunc()
+}
+
+// ByMatchNote orders the results by the match_note field.
+func ByMatchNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchNote, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByBillField orders the results by bill field.
+func ByBillField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPurchaseOrderLineField orders the results by purchase_order_line field.
+func ByPurchaseOrderLineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPurchaseOrderLineStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBillStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BillTable, BillColumn),
+	)
+}
+func newPurchaseOrderLineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PurchaseOrderLineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PurchaseOrderLineTable, PurchaseOrderLineColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+	)
+}
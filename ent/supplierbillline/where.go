@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package supplierbillline
+
+import (
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldID, id))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// TaxCode applies equality check predicate on the "tax_code" field. It's identical to TaxCodeEQ.
+func TaxCode(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldTaxCode, v))
+}
+
+// TaxRate applies equality check predicate on the "tax_rate" field. It's identical to TaxRateEQ.
+func TaxRate(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldTaxRate, v))
+}
+
+// NetAmount applies equality check predicate on the "net_amount" field. It's identical to NetAmountEQ.
+func NetAmount(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldNetAmount, v))
+}
+
+// QuantityVariance applies equality check predicate on the "quantity_variance" field. It's identical to QuantityVarianceEQ.
+func QuantityVariance(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldQuantityVariance, v))
+}
+
+// PriceVariance applies equality check predicate on the "price_variance" field. It's identical to PriceVarianceEQ.
+func PriceVariance(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldPriceVariance, v))
+}
+
+// MatchNote applies equality check predicate on the "match_note" field. It's identical to MatchNoteEQ.
+func MatchNote(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldMatchNote, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldPosition, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// TaxCodeEQ applies the EQ predicate on the "tax_code" field.
+func TaxCodeEQ(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldTaxCode, v))
+}
+
+// TaxCodeNEQ applies the NEQ predicate on the "tax_code" field.
+func TaxCodeNEQ(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldTaxCode, v))
+}
+
+// TaxCodeIn applies the In predicate on the "tax_code" field.
+func TaxCodeIn(vs ...string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldTaxCode, vs...))
+}
+
+// TaxCodeNotIn applies the NotIn predicate on the "tax_code" field.
+func TaxCodeNotIn(vs ...string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldTaxCode, vs...))
+}
+
+// TaxCodeGT applies the GT predicate on the "tax_code" field.
+func TaxCodeGT(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldTaxCode, v))
+}
+
+// TaxCodeGTE applies the GTE predicate on the "tax_code" field.
+func TaxCodeGTE(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldTaxCode, v))
+}
+
+// TaxCodeLT applies the LT predicate on the "tax_code" field.
+func TaxCodeLT(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldTaxCode, v))
+}
+
+// TaxCodeLTE applies the LTE predicate on the "tax_code" field.
+func TaxCodeLTE(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldTaxCode, v))
+}
+
+// TaxCodeContains applies the Contains predicate on the "tax_code" field.
+func TaxCodeContains(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldContains(FieldTaxCode, v))
+}
+
+// TaxCodeHasPrefix applies the HasPrefix predicate on the "tax_code" field.
+func TaxCodeHasPrefix(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldHasPrefix(FieldTaxCode, v))
+}
+
+// TaxCodeHasSuffix applies the HasSuffix predicate on the "tax_code" field.
+func TaxCodeHasSuffix(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldHasSuffix(FieldTaxCode, v))
+}
+
+// TaxCodeIsNil applies the IsNil predicate on the "tax_code" field.
+func TaxCodeIsNil() predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIsNull(FieldTaxCode))
+}
+
+// TaxCodeNotNil applies the NotNil predicate on the "tax_code" field.
+func TaxCodeNotNil() predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotNull(FieldTaxCode))
+}
+
+// TaxCodeEqualFold applies the EqualFold predicate on the "tax_code" field.
+func TaxCodeEqualFold(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEqualFold(FieldTaxCode, v))
+}
+
+// TaxCodeContainsFold applies the ContainsFold predicate on the "tax_code" field.
+func TaxCodeContainsFold(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldContainsFold(FieldTaxCode, v))
+}
+
+// TaxRateEQ applies the EQ predicate on the "tax_rate" field.
+func TaxRateEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldTaxRate, v))
+}
+
+// TaxRateNEQ applies the NEQ predicate on the "tax_rate" field.
+func TaxRateNEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldTaxRate, v))
+}
+
+// TaxRateIn applies the In predicate on the "tax_rate" field.
+func TaxRateIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldTaxRate, vs...))
+}
+
+// TaxRateNotIn applies the NotIn predicate on the "tax_rate" field.
+func TaxRateNotIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldTaxRate, vs...))
+}
+
+// TaxRateGT applies the GT predicate on the "tax_rate" field.
+func TaxRateGT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldTaxRate, v))
+}
+
+// TaxRateGTE applies the GTE predicate on the "tax_rate" field.
+func TaxRateGTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldTaxRate, v))
+}
+
+// TaxRateLT applies the LT predicate on the "tax_rate" field.
+func TaxRateLT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldTaxRate, v))
+}
+
+// TaxRateLTE applies the LTE predicate on the "tax_rate" field.
+func TaxRateLTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldTaxRate, v))
+}
+
+// NetAmountEQ applies the EQ predicate on the "net_amount" field.
+func NetAmountEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldNetAmount, v))
+}
+
+// NetAmountNEQ applies the NEQ predicate on the "net_amount" field.
+func NetAmountNEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldNetAmount, v))
+}
+
+// NetAmountIn applies the In predicate on the "net_amount" field.
+func NetAmountIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldNetAmount, vs...))
+}
+
+// NetAmountNotIn applies the NotIn predicate on the "net_amount" field.
+func NetAmountNotIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldNetAmount, vs...))
+}
+
+// NetAmountGT applies the GT predicate on the "net_amount" field.
+func NetAmountGT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldNetAmount, v))
+}
+
+// NetAmountGTE applies the GTE predicate on the "net_amount" field.
+func NetAmountGTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldNetAmount, v))
+}
+
+// NetAmountLT applies the LT predicate on the "net_amount" field.
+func NetAmountLT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldNetAmount, v))
+}
+
+// NetAmountLTE applies the LTE predicate on the "net_amount" field.
+func NetAmountLTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldNetAmount, v))
+}
+
+// MatchStatusEQ applies the EQ predicate on the "match_status" field.
+func MatchStatusEQ(v MatchStatus) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldMatchStatus, v))
+}
+
+// MatchStatusNEQ applies the NEQ predicate on the "match_status" field.
+func MatchStatusNEQ(v MatchStatus) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldMatchStatus, v))
+}
+
+// MatchStatusIn applies the In predicate on the "match_status" field.
+func MatchStatusIn(vs ...MatchStatus) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldMatchStatus, vs...))
+}
+
+// MatchStatusNotIn applies the NotIn predicate on the "match_status" field.
+func MatchStatusNotIn(vs ...MatchStatus) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldMatchStatus, vs...))
+}
+
+// QuantityVarianceEQ applies the EQ predicate on the "quantity_variance" field.
+func QuantityVarianceEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldQuantityVariance, v))
+}
+
+// QuantityVarianceNEQ applies the NEQ predicate on the "quantity_variance" field.
+func QuantityVarianceNEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldQuantityVariance, v))
+}
+
+// QuantityVarianceIn applies the In predicate on the "quantity_variance" field.
+func QuantityVarianceIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldQuantityVariance, vs...))
+}
+
+// QuantityVarianceNotIn applies the NotIn predicate on the "quantity_variance" field.
+func QuantityVarianceNotIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldQuantityVariance, vs...))
+}
+
+// QuantityVarianceGT applies the GT predicate on the "quantity_variance" field.
+func QuantityVarianceGT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldQuantityVariance, v))
+}
+
+// QuantityVarianceGTE applies the GTE predicate on the "quantity_variance" field.
+func QuantityVarianceGTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldQuantityVariance, v))
+}
+
+// QuantityVarianceLT applies the LT predicate on the "quantity_variance" field.
+func QuantityVarianceLT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldQuantityVariance, v))
+}
+
+// QuantityVarianceLTE applies the LTE predicate on the "quantity_variance" field.
+func QuantityVarianceLTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldQuantityVariance, v))
+}
+
+// PriceVarianceEQ applies the EQ predicate on the "price_variance" field.
+func PriceVarianceEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldPriceVariance, v))
+}
+
+// PriceVarianceNEQ applies the NEQ predicate on the "price_variance" field.
+func PriceVarianceNEQ(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldPriceVariance, v))
+}
+
+// PriceVarianceIn applies the In predicate on the "price_variance" field.
+func PriceVarianceIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldPriceVariance, vs...))
+}
+
+// PriceVarianceNotIn applies the NotIn predicate on the "price_variance" field.
+func PriceVarianceNotIn(vs ...decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldPriceVariance, vs...))
+}
+
+// PriceVarianceGT applies the GT predicate on the "price_variance" field.
+func PriceVarianceGT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldPriceVariance, v))
+}
+
+// PriceVarianceGTE applies the GTE predicate on the "price_variance" field.
+func PriceVarianceGTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldPriceVariance, v))
+}
+
+// PriceVarianceLT applies the LT predicate on the "price_variance" field.
+func PriceVarianceLT(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldPriceVariance, v))
+}
+
+// PriceVarianceLTE applies the LTE predicate on the "price_variance" field.
+func PriceVarianceLTE(v decimal.Decimal) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldPriceVariance, v))
+}
+
+// MatchNoteEQ applies the EQ predicate on the "match_note" field.
+func MatchNoteEQ(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldMatchNote, v))
+}
+
+// MatchNoteNEQ applies the NEQ predicate on the "match_note" field.
+func MatchNoteNEQ(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldMatchNote, v))
+}
+
+// MatchNoteIn applies the In predicate on the "match_note" field.
+func MatchNoteIn(vs ...string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldMatchNote, vs...))
+}
+
+// MatchNoteNotIn applies the NotIn predicate on the "match_note" field.
+func MatchNoteNotIn(vs ...string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldMatchNote, vs...))
+}
+
+// MatchNoteGT applies the GT predicate on the "match_note" field.
+func MatchNoteGT(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldMatchNote, v))
+}
+
+// MatchNoteGTE applies the GTE predicate on the "match_note" field.
+func MatchNoteGTE(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldMatchNote, v))
+}
+
+// MatchNoteLT applies the LT predicate on the "match_note" field.
+func MatchNoteLT(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldMatchNote, v))
+}
+
+// MatchNoteLTE applies the LTE predicate on the "match_note" field.
+func MatchNoteLTE(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldMatchNote, v))
+}
+
+// MatchNoteContains applies the Contains predicate on the "match_note" field.
+func MatchNoteContains(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldContains(FieldMatchNote, v))
+}
+
+// MatchNoteHasPrefix applies the HasPrefix predicate on the "match_note" field.
+func MatchNoteHasPrefix(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldHasPrefix(FieldMatchNote, v))
+}
+
+// MatchNoteHasSuffix applies the HasSuffix predicate on the "match_note" field.
+func MatchNoteHasSuffix(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldHasSuffix(FieldMatchNote, v))
+}
+
+// MatchNoteIsNil applies the IsNil predicate on the "match_note" field.
+func MatchNoteIsNil() predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIsNull(FieldMatchNote))
+}
+
+// MatchNoteNotNil applies the NotNil predicate on the "match_note" field.
+func MatchNoteNotNil() predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotNull(FieldMatchNote))
+}
+
+// MatchNoteEqualFold applies the EqualFold predicate on the "match_note" field.
+func MatchNoteEqualFold(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEqualFold(FieldMatchNote, v))
+}
+
+// MatchNoteContainsFold applies the ContainsFold predicate on the "match_note" field.
+func MatchNoteContainsFold(v string) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldContainsFold(FieldMatchNote, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.FieldLTE(FieldPosition, v))
+}
+
+// HasBill applies the HasEdge predicate on the "bill" edge.
+func HasBill() predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BillTable, BillColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillWith applies the HasEdge predicate on the "bill" edge with a given conditions (other predicates).
+func HasBillWith(preds ...predicate.SupplierBill) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(func(s *sql.Selector) {
+		step := newBillStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPurchaseOrderLine applies the HasEdge predicate on the "purchase_order_line" edge.
+func HasPurchaseOrderLine() predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PurchaseOrderLineTable, PurchaseOrderLineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPurchaseOrderLineWith applies the HasEdge predicate on the "purchase_order_line" edge with a given conditions (other predicates).
+func HasPurchaseOrderLineWith(preds ...predicate.PurchaseOrderLine) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(func(s *sql.Selector) {
+		step := newPurchaseOrderLineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SupplierBillLine) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SupplierBillLine) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SupplierBillLine) predicate.SupplierBillLine {
+	return predicate.SupplierBillLine(sql.NotPredicates(p))
+}
@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/purchaseorderline"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierBillLineCreate is the builder for creating a SupplierBillLine entity.
+type SupplierBillLineCreate struct {
+	config
+	mutation *SupplierBillLineMutation
+	hooks    []Hook
+}
+
+// SetDescription sets the "description" field.
+func (_c *SupplierBillLineCreate) SetDescription(v string) *SupplierBillLineCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *SupplierBillLineCreate) SetQuantity(v decimal.Decimal) *SupplierBillLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableQuantity(v *decimal.Decimal) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *SupplierBillLineCreate) SetUnitPrice(v decimal.Decimal) *SupplierBillLineCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableUnitPrice(v *decimal.Decimal) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_c *SupplierBillLineCreate) SetTaxCode(v string) *SupplierBillLineCreate {
+	_c.mutation.SetTaxCode(v)
+	return _c
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableTaxCode(v *string) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetTaxCode(*v)
+	}
+	return _c
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_c *SupplierBillLineCreate) SetTaxRate(v decimal.Decimal) *SupplierBillLineCreate {
+	_c.mutation.SetTaxRate(v)
+	return _c
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableTaxRate(v *decimal.Decimal) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetTaxRate(*v)
+	}
+	return _c
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_c *SupplierBillLineCreate) SetNetAmount(v decimal.Decimal) *SupplierBillLineCreate {
+	_c.mutation.SetNetAmount(v)
+	return _c
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableNetAmount(v *decimal.Decimal) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetNetAmount(*v)
+	}
+	return _c
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_c *SupplierBillLineCreate) SetMatchStatus(v supplierbillline.MatchStatus) *SupplierBillLineCreate {
+	_c.mutation.SetMatchStatus(v)
+	return _c
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableMatchStatus(v *supplierbillline.MatchStatus) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetMatchStatus(*v)
+	}
+	return _c
+}
+
+// SetQuantityVariance sets the "quantity_variance" field.
+func (_c *SupplierBillLineCreate) SetQuantityVariance(v decimal.Decimal) *SupplierBillLineCreate {
+	_c.mutation.SetQuantityVariance(v)
+	return _c
+}
+
+// SetNillableQuantityVariance sets the "quantity_variance" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableQuantityVariance(v *decimal.Decimal) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetQuantityVariance(*v)
+	}
+	return _c
+}
+
+// SetPriceVariance sets the "price_variance" field.
+func (_c *SupplierBillLineCreate) SetPriceVariance(v decimal.Decimal) *SupplierBillLineCreate {
+	_c.mutation.SetPriceVariance(v)
+	return _c
+}
+
+// SetNillablePriceVariance sets the "price_variance" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillablePriceVariance(v *decimal.Decimal) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetPriceVariance(*v)
+	}
+	return _c
+}
+
+// SetMatchNote sets the "match_note" field.
+func (_c *SupplierBillLineCreate) SetMatchNote(v string) *SupplierBillLineCreate {
+	_c.mutation.SetMatchNote(v)
+	return _c
+}
+
+// SetNillableMatchNote sets the "match_note" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableMatchNote(v *string) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetMatchNote(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *SupplierBillLineCreate) SetPosition(v int) *SupplierBillLineCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillablePosition(v *int) *SupplierBillLineCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetBillID sets the "bill" edge to the SupplierBill entity by ID.
+func (_c *SupplierBillLineCreate) SetBillID(id int) *SupplierBillLineCreate {
+	_c.mutation.SetBillID(id)
+	return _c
+}
+
+// SetBill sets the "bill" edge to the SupplierBill entity.
+func (_c *SupplierBillLineCreate) SetBill(v *SupplierBill) *SupplierBillLineCreate {
+	return _c.SetBillID(v.ID)
+}
+
+// SetPurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID.
+func (_c *SupplierBillLineCreate) SetPurchaseOrderLineID(id int) *SupplierBillLineCreate {
+	_c.mutation.SetPurchaseOrderLineID(id)
+	return _c
+}
+
+// SetNillablePurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillablePurchaseOrderLineID(id *int) *SupplierBillLineCreate {
+	if id != nil {
+		_c = _c.SetPurchaseOrderLineID(*id)
+	}
+	return _c
+}
+
+// SetPurchaseOrderLine sets the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_c *SupplierBillLineCreate) SetPurchaseOrderLine(v *PurchaseOrderLine) *SupplierBillLineCreate {
+	return _c.SetPurchaseOrderLineID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *SupplierBillLineCreate) SetAccountID(id int) *SupplierBillLineCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetNillableAccountID sets the "account" edge to the Account entity by ID if the given value is not nil.
+func (_c *SupplierBillLineCreate) SetNillableAccountID(id *int) *SupplierBillLineCreate {
+	if id != nil {
+		_c = _c.SetAccountID(*id)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *SupplierBillLineCreate) SetAccount(v *Account) *SupplierBillLineCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the SupplierBillLineMutation object of the builder.
+func (_c *SupplierBillLineCreate) Mutation() *SupplierBillLineMutation {
+	return _c.mutation
+}
+
+// Save creates the SupplierBillLine in the database.
+func (_c *SupplierBillLineCreate) Save(ctx context.Context) (*SupplierBillLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SupplierBillLineCreate) SaveX(ctx context.Context) *SupplierBillLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupplierBillLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupplierBillLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SupplierBillLineCreate) defaults() {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := supplierbillline.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		v := supplierbillline.DefaultUnitPrice
+		_c.mutation.SetUnitPrice(v)
+	}
+	if _, ok := _c.mutation.TaxRate(); !ok {
+		v := supplierbillline.DefaultTaxRate
+		_c.mutation.SetTaxRate(v)
+	}
+	if _, ok := _c.mutation.NetAmount(); !ok {
+		v := supplierbillline.DefaultNetAmount
+		_c.mutation.SetNetAmount(v)
+	}
+	if _, ok := _c.mutation.MatchStatus(); !ok {
+		v := supplierbillline.DefaultMatchStatus
+		_c.mutation.SetMatchStatus(v)
+	}
+	if _, ok := _c.mutation.QuantityVariance(); !ok {
+		v := supplierbillline.DefaultQuantityVariance
+		_c.mutation.SetQuantityVariance(v)
+	}
+	if _, ok := _c.mutation.PriceVariance(); !ok {
+		v := supplierbillline.DefaultPriceVariance
+		_c.mutation.SetPriceVariance(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := supplierbillline.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SupplierBillLineCreate) check() error {
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "SupplierBillLine.description"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "SupplierBillLine.quantity"`)}
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "SupplierBillLine.unit_price"`)}
+	}
+	if _, ok := _c.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "SupplierBillLine.tax_rate"`)}
+	}
+	if _, ok := _c.mutation.NetAmount(); !ok {
+		return &ValidationError{Name: "net_amount", err: errors.New(`ent: missing required field "SupplierBillLine.net_amount"`)}
+	}
+	if _, ok := _c.mutation.MatchStatus(); !ok {
+		return &ValidationError{Name: "match_status", err: errors.New(`ent: missing required field "SupplierBillLine.match_status"`)}
+	}
+	if v, ok := _c.mutation.MatchStatus(); ok {
+		if err := supplierbillline.MatchStatusValidator(v); err != nil {
+			return &ValidationError{Name: "match_status", err: fmt.Errorf(`ent: validator failed for field "SupplierBillLine.match_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.QuantityVariance(); !ok {
+		return &ValidationError{Name: "quantity_variance", err: errors.New(`ent: missing required field "SupplierBillLine.quantity_variance"`)}
+	}
+	if _, ok := _c.mutation.PriceVariance(); !ok {
+		return &ValidationError{Name: "price_variance", err: errors.New(`ent: missing required field "SupplierBillLine.price_variance"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "SupplierBillLine.position"`)}
+	}
+	if len(_c.mutation.BillIDs()) == 0 {
+		return &ValidationError{Name: "bill", err: errors.New(`ent: missing required edge "SupplierBillLine.bill"`)}
+	}
+	return nil
+}
+
+func (_c *SupplierBillLineCreate) sqlSave(ctx context.Context) (*SupplierBillLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SupplierBillLineCreate) createSpec() (*SupplierBillLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SupplierBillLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(supplierbillline.Table, sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(supplierbillline.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(supplierbillline.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(supplierbillline.FieldUnitPrice, field.TypeOther, value)
+		_node.UnitPrice = value
+	}
+	if value, ok := _c.mutation.TaxCode(); ok {
+		_spec.SetField(supplierbillline.FieldTaxCode, field.TypeString, value)
+		_node.TaxCode = value
+	}
+	if value, ok := _c.mutation.TaxRate(); ok {
+		_spec.SetField(supplierbillline.FieldTaxRate, field.TypeOther, value)
+		_node.TaxRate = value
+	}
+	if value, ok := _c.mutation.NetAmount(); ok {
+		_spec.SetField(supplierbillline.FieldNetAmount, field.TypeOther, value)
+		_node.NetAmount = value
+	}
+	if value, ok := _c.mutation.MatchStatus(); ok {
+		_spec.SetField(supplierbillline.FieldMatchStatus, field.TypeEnum, value)
+		_node.MatchStatus = value
+	}
+	if value, ok := _c.mutation.QuantityVariance(); ok {
+		_spec.SetField(supplierbillline.FieldQuantityVariance, field.TypeOther, value)
+		_node.QuantityVariance = value
+	}
+	if value, ok := _c.mutation.PriceVariance(); ok {
+		_spec.SetField(supplierbillline.FieldPriceVariance, field.TypeOther, value)
+		_node.PriceVariance = value
+	}
+	if value, ok := _c.mutation.MatchNote(); ok {
+		_spec.SetField(supplierbillline.FieldMatchNote, field.TypeString, value)
+		_node.MatchNote = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(supplierbillline.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := _c.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.BillTable,
+			Columns: []string{supplierbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.supplier_bill_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PurchaseOrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.PurchaseOrderLineTable,
+			Columns: []string{supplierbillline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.purchase_order_line_bill_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbillline.AccountTable,
+			Columns: []string{supplierbillline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.supplier_bill_line_account = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SupplierBillLineCreateBulk is the builder for creating many SupplierBillLine entities in bulk.
+type SupplierBillLineCreateBulk struct {
+	config
+	err      error
+	builders []*SupplierBillLineCreate
+}
+
+// Save creates the SupplierBillLine entities in the database.
+func (_c *SupplierBillLineCreateBulk) Save(ctx context.Context) ([]*SupplierBillLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SupplierBillLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SupplierBillLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SupplierBillLineCreateBulk) SaveX(ctx context.Context) []*SupplierBillLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupplierBillLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupplierBillLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,787 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sent/ent/account"
+	"sent/ent/predicate"
+	"sent/ent/purchaseorderline"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SupplierBillLineQuery is the builder for querying SupplierBillLine entities.
+type SupplierBillLineQuery struct {
+	config
+	ctx                   *QueryContext
+	order                 []supplierbillline.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.SupplierBillLine
+	withBill              *SupplierBillQuery
+	withPurchaseOrderLine *PurchaseOrderLineQuery
+	withAccount           *AccountQuery
+	withFKs               bool
+	modifiers             []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SupplierBillLineQuery builder.
+func (_q *SupplierBillLineQuery) Where(ps ...predicate.SupplierBillLine) *SupplierBillLineQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *SupplierBillLineQuery) Limit(limit int) *SupplierBillLineQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *SupplierBillLineQuery) Offset(offset int) *SupplierBillLineQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *SupplierBillLineQuery) Unique(unique bool) *SupplierBillLineQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *SupplierBillLineQuery) Order(o ...supplierbillline.OrderOption) *SupplierBillLineQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryBill chains the current query on the "bill" edge.
+func (_q *SupplierBillLineQuery) QueryBill() *SupplierBillQuery {
+	query := (&SupplierBillClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbillline.Table, supplierbillline.FieldID, selector),
+			sqlgraph.To(supplierbill.Table, supplierbill.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, supplierbillline.BillTable, supplierbillline.BillColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPurchaseOrderLine chains the current query on the "purchase_order_line" edge.
+func (_q *SupplierBillLineQuery) QueryPurchaseOrderLine() *PurchaseOrderLineQuery {
+	query := (&PurchaseOrderLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbillline.Table, supplierbillline.FieldID, selector),
+			sqlgraph.To(purchaseorderline.Table, purchaseorderline.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, supplierbillline.PurchaseOrderLineTable, supplierbillline.PurchaseOrderLineColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (_q *SupplierBillLineQuery) QueryAccount() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(supplierbillline.Table, supplierbillline.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, supplierbillline.AccountTable, supplierbillline.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SupplierBillLine entity from the query.
+// Returns a *NotFoundError when no SupplierBillLine was found.
+func (_q *SupplierBillLineQuery) First(ctx context.Context) (*SupplierBillLine, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{supplierbillline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *SupplierBillLineQuery) FirstX(ctx context.Context) *SupplierBillLine {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SupplierBillLine ID from the query.
+// Returns a *NotFoundError when no SupplierBillLine ID was found.
+func (_q *SupplierBillLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{supplierbillline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *SupplierBillLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SupplierBillLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SupplierBillLine entity is found.
+// Returns a *NotFoundError when no SupplierBillLine entities are found.
+func (_q *SupplierBillLineQuery) Only(ctx context.Context) (*SupplierBillLine, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{supplierbillline.Label}
+	default:
+		return nil, &NotSingularError{supplierbillline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *SupplierBillLineQuery) OnlyX(ctx context.Context) *SupplierBillLine {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SupplierBillLine ID in the query.
+// Returns a *NotSingularError when more than one SupplierBillLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *SupplierBillLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{supplierbillline.Label}
+	default:
+		err = &NotSingularError{supplierbillline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *SupplierBillLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SupplierBillLines.
+func (_q *SupplierBillLineQuery) All(ctx context.Context) ([]*SupplierBillLine, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SupplierBillLine, *SupplierBillLineQuery]()
+	return withInterceptors[[]*SupplierBillLine](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *SupplierBillLineQuery) AllX(ctx context.Context) []*SupplierBillLine {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SupplierBillLine IDs.
+func (_q *SupplierBillLineQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(supplierbillline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *SupplierBillLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *SupplierBillLineQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*SupplierBillLineQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *SupplierBillLineQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *SupplierBillLineQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *SupplierBillLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SupplierBillLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *SupplierBillLineQuery) Clone() *SupplierBillLineQuery {
+	if _q == nil {
+		return nil
+	}
+	return &SupplierBillLineQuery{
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]supplierbillline.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.SupplierBillLine{}, _q.predicates...),
+		withBill:              _q.withBill.Clone(),
+		withPurchaseOrderLine: _q.withPurchaseOrderLine.Clone(),
+		withAccount:           _q.withAccount.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithBill tells the query-builder to eager-load the nodes that are connected to
+// the "bill" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillLineQuery) WithBill(opts ...func(*SupplierBillQuery)) *SupplierBillLineQuery {
+	query := (&SupplierBillClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBill = query
+	return _q
+}
+
+// WithPurchaseOrderLine tells the query-builder to eager-load the nodes that are connected to
+// the "purchase_order_line" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillLineQuery) WithPurchaseOrderLine(opts ...func(*PurchaseOrderLineQuery)) *SupplierBillLineQuery {
+	query := (&PurchaseOrderLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPurchaseOrderLine = query
+	return _q
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SupplierBillLineQuery) WithAccount(opts ...func(*AccountQuery)) *SupplierBillLineQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccount = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Description string `json:"description,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SupplierBillLine.Query().
+//		GroupBy(supplierbillline.FieldDescription).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *SupplierBillLineQuery) GroupBy(field string, fields ...string) *SupplierBillLineGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SupplierBillLineGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = supplierbillline.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Description string `json:"description,omitempty"`
+//	}
+//
+//	client.SupplierBillLine.Query().
+//		Select(supplierbillline.FieldDescription).
+//		Scan(ctx, &v)
+func (_q *SupplierBillLineQuery) Select(fields ...string) *SupplierBillLineSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &SupplierBillLineSelect{SupplierBillLineQuery: _q}
+	sbuild.label = supplierbillline.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SupplierBillLineSelect configured with the given aggregations.
+func (_q *SupplierBillLineQuery) Aggregate(fns ...AggregateFunc) *SupplierBillLineSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *SupplierBillLineQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !supplierbillline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *SupplierBillLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SupplierBillLine, error) {
+	var (
+		nodes       = []*SupplierBillLine{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withBill != nil,
+			_q.withPurchaseOrderLine != nil,
+			_q.withAccount != nil,
+		}
+	)
+	if _q.withBill != nil || _q.withPurchaseOrderLine != nil || _q.withAccount != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, supplierbillline.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SupplierBillLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SupplierBillLine{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withBill; query != nil {
+		if err := _q.loadBill(ctx, query, nodes, nil,
+			func(n *SupplierBillLine, e *SupplierBill) { n.Edges.Bill = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPurchaseOrderLine; query != nil {
+		if err := _q.loadPurchaseOrderLine(ctx, query, nodes, nil,
+			func(n *SupplierBillLine, e *PurchaseOrderLine) { n.Edges.PurchaseOrderLine = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccount; query != nil {
+		if err := _q.loadAccount(ctx, query, nodes, nil,
+			func(n *SupplierBillLine, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *SupplierBillLineQuery) loadBill(ctx context.Context, query *SupplierBillQuery, nodes []*SupplierBillLine, init func(*SupplierBillLine), assign func(*SupplierBillLine, *SupplierBill)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SupplierBillLine)
+	for i := range nodes {
+		if nodes[i].supplier_bill_lines == nil {
+			continue
+		}
+		fk := *nodes[i].supplier_bill_lines
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(supplierbill.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "supplier_bill_lines" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SupplierBillLineQuery) loadPurchaseOrderLine(ctx context.Context, query *PurchaseOrderLineQuery, nodes []*SupplierBillLine, init func(*SupplierBillLine), assign func(*SupplierBillLine, *PurchaseOrderLine)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SupplierBillLine)
+	for i := range nodes {
+		if nodes[i].purchase_order_line_bill_lines == nil {
+			continue
+		}
+		fk := *nodes[i].purchase_order_line_bill_lines
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(purchaseorderline.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "purchase_order_line_bill_lines" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SupplierBillLineQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*SupplierBillLine, init func(*SupplierBillLine), assign func(*SupplierBillLine, *Account)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SupplierBillLine)
+	for i := range nodes {
+		if nodes[i].supplier_bill_line_account == nil {
+			continue
+		}
+		fk := *nodes[i].supplier_bill_line_account
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "supplier_bill_line_account" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *SupplierBillLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *SupplierBillLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(supplierbillline.Table, supplierbillline.Columns, sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, supplierbillline.FieldID)
+		for i := range fields {
+			if fields[i] != supplierbillline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *SupplierBillLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(supplierbillline.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = supplierbillline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SupplierBillLineQuery) Modify(modifiers ...func(s *sql.Selector)) *SupplierBillLineSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// SupplierBillLineGroupBy is the group-by builder for SupplierBillLine entities.
+type SupplierBillLineGroupBy struct {
+	selector
+	build *SupplierBillLineQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *SupplierBillLineGroupBy) Aggregate(fns ...AggregateFunc) *SupplierBillLineGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *SupplierBillLineGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SupplierBillLineQuery, *SupplierBillLineGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *SupplierBillLineGroupBy) sqlScan(ctx context.Context, root *SupplierBillLineQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SupplierBillLineSelect is the builder for selecting fields of SupplierBillLine entities.
+type SupplierBillLineSelect struct {
+	*SupplierBillLineQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *SupplierBillLineSelect) Aggregate(fns ...AggregateFunc) *SupplierBillLineSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *SupplierBillLineSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SupplierBillLineQuery, *SupplierBillLineSelect](ctx, _s.SupplierBillLineQuery, _s, _s.inters, v)
+}
+
+func (_s *SupplierBillLineSelect) sqlScan(ctx context.Context, root *SupplierBillLineQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SupplierBillLineSelect) Modify(modifiers ...func(s *sql.Selector)) *SupplierBillLineSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/account"
+	"sent/ent/predicate"
+	"sent/ent/purchaseorderline"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierBillLineUpdate is the builder for updating SupplierBillLine entities.
+type SupplierBillLineUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SupplierBillLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SupplierBillLineUpdate builder.
+func (_u *SupplierBillLineUpdate) Where(ps ...predicate.SupplierBillLine) *SupplierBillLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SupplierBillLineUpdate) SetDescription(v string) *SupplierBillLineUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableDescription(v *string) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *SupplierBillLineUpdate) SetQuantity(v decimal.Decimal) *SupplierBillLineUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableQuantity(v *decimal.Decimal) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *SupplierBillLineUpdate) SetUnitPrice(v decimal.Decimal) *SupplierBillLineUpdate {
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableUnitPrice(v *decimal.Decimal) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *SupplierBillLineUpdate) SetTaxCode(v string) *SupplierBillLineUpdate {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableTaxCode(v *string) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (_u *SupplierBillLineUpdate) ClearTaxCode() *SupplierBillLineUpdate {
+	_u.mutation.ClearTaxCode()
+	return _u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_u *SupplierBillLineUpdate) SetTaxRate(v decimal.Decimal) *SupplierBillLineUpdate {
+	_u.mutation.SetTaxRate(v)
+	return _u
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableTaxRate(v *decimal.Decimal) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetTaxRate(*v)
+	}
+	return _u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_u *SupplierBillLineUpdate) SetNetAmount(v decimal.Decimal) *SupplierBillLineUpdate {
+	_u.mutation.SetNetAmount(v)
+	return _u
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableNetAmount(v *decimal.Decimal) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetNetAmount(*v)
+	}
+	return _u
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_u *SupplierBillLineUpdate) SetMatchStatus(v supplierbillline.MatchStatus) *SupplierBillLineUpdate {
+	_u.mutation.SetMatchStatus(v)
+	return _u
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableMatchStatus(v *supplierbillline.MatchStatus) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetMatchStatus(*v)
+	}
+	return _u
+}
+
+// SetQuantityVariance sets the "quantity_variance" field.
+func (_u *SupplierBillLineUpdate) SetQuantityVariance(v decimal.Decimal) *SupplierBillLineUpdate {
+	_u.mutation.SetQuantityVariance(v)
+	return _u
+}
+
+// SetNillableQuantityVariance sets the "quantity_variance" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableQuantityVariance(v *decimal.Decimal) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetQuantityVariance(*v)
+	}
+	return _u
+}
+
+// SetPriceVariance sets the "price_variance" field.
+func (_u *SupplierBillLineUpdate) SetPriceVariance(v decimal.Decimal) *SupplierBillLineUpdate {
+	_u.mutation.SetPriceVariance(v)
+	return _u
+}
+
+// SetNillablePriceVariance sets the "price_variance" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillablePriceVariance(v *decimal.Decimal) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetPriceVariance(*v)
+	}
+	return _u
+}
+
+// SetMatchNote sets the "match_note" field.
+func (_u *SupplierBillLineUpdate) SetMatchNote(v string) *SupplierBillLineUpdate {
+	_u.mutation.SetMatchNote(v)
+	return _u
+}
+
+// SetNillableMatchNote sets the "match_note" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableMatchNote(v *string) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetMatchNote(*v)
+	}
+	return _u
+}
+
+// ClearMatchNote clears the value of the "match_note" field.
+func (_u *SupplierBillLineUpdate) ClearMatchNote() *SupplierBillLineUpdate {
+	_u.mutation.ClearMatchNote()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *SupplierBillLineUpdate) SetPosition(v int) *SupplierBillLineUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillablePosition(v *int) *SupplierBillLineUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *SupplierBillLineUpdate) AddPosition(v int) *SupplierBillLineUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetBillID sets the "bill" edge to the SupplierBill entity by ID.
+func (_u *SupplierBillLineUpdate) SetBillID(id int) *SupplierBillLineUpdate {
+	_u.mutation.SetBillID(id)
+	return _u
+}
+
+// SetBill sets the "bill" edge to the SupplierBill entity.
+func (_u *SupplierBillLineUpdate) SetBill(v *SupplierBill) *SupplierBillLineUpdate {
+	return _u.SetBillID(v.ID)
+}
+
+// SetPurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID.
+func (_u *SupplierBillLineUpdate) SetPurchaseOrderLineID(id int) *SupplierBillLineUpdate {
+	_u.mutation.SetPurchaseOrderLineID(id)
+	return _u
+}
+
+// SetNillablePurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillablePurchaseOrderLineID(id *int) *SupplierBillLineUpdate {
+	if id != nil {
+		_u = _u.SetPurchaseOrderLineID(*id)
+	}
+	return _u
+}
+
+// SetPurchaseOrderLine sets the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_u *SupplierBillLineUpdate) SetPurchaseOrderLine(v *PurchaseOrderLine) *SupplierBillLineUpdate {
+	return _u.SetPurchaseOrderLineID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *SupplierBillLineUpdate) SetAccountID(id int) *SupplierBillLineUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetNillableAccountID sets the "account" edge to the Account entity by ID if the given value is not nil.
+func (_u *SupplierBillLineUpdate) SetNillableAccountID(id *int) *SupplierBillLineUpdate {
+	if id != nil {
+		_u = _u.SetAccountID(*id)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *SupplierBillLineUpdate) SetAccount(v *Account) *SupplierBillLineUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the SupplierBillLineMutation object of the builder.
+func (_u *SupplierBillLineUpdate) Mutation() *SupplierBillLineMutation {
+	return _u.mutation
+}
+
+// ClearBill clears the "bill" edge to the SupplierBill entity.
+func (_u *SupplierBillLineUpdate) ClearBill() *SupplierBillLineUpdate {
+	_u.mutation.ClearBill()
+	return _u
+}
+
+// ClearPurchaseOrderLine clears the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_u *SupplierBillLineUpdate) ClearPurchaseOrderLine() *SupplierBillLineUpdate {
+	_u.mutation.ClearPurchaseOrderLine()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *SupplierBillLineUpdate) ClearAccount() *SupplierBillLineUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SupplierBillLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SupplierBillLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SupplierBillLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SupplierBillLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SupplierBillLineUpdate) check() error {
+	if v, ok := _u.mutation.MatchStatus(); ok {
+		if err := supplierbillline.MatchStatusValidator(v); err != nil {
+			return &ValidationError{Name: "match_status", err: fmt.Errorf(`ent: validator failed for field "SupplierBillLine.match_status": %w`, err)}
+		}
+	}
+	if _u.mutation.BillCleared() && len(_u.mutation.BillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupplierBillLine.bill"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SupplierBillLineUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SupplierBillLineUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SupplierBillLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(supplierbillline.Table, supplierbillline.Columns, sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(supplierbillline.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(supplierbillline.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(supplierbillline.FieldUnitPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(supplierbillline.FieldTaxCode, field.TypeString, value)
+	}
+	if _u.mutation.TaxCodeCleared() {
+		_spec.ClearField(supplierbillline.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxRate(); ok {
+		_spec.SetField(supplierbillline.FieldTaxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.NetAmount(); ok {
+		_spec.SetField(supplierbillline.FieldNetAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.MatchStatus(); ok {
+		_spec.SetField(supplierbillline.FieldMatchStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QuantityVariance(); ok {
+		_spec.SetField(supplierbillline.FieldQuantityVariance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.PriceVariance(); ok {
+		_spec.SetField(supplierbillline.FieldPriceVariance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.MatchNote(); ok {
+		_spec.SetField(supplierbillline.FieldMatchNote, field.TypeString, value)
+	}
+	if _u.mutation.MatchNoteCleared() {
+		_spec.ClearField(supplierbillline.FieldMatchNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(supplierbillline.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(supplierbillline.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.BillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.BillTable,
+			Columns: []string{supplierbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.BillTable,
+			Columns: []string{supplierbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchaseOrderLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.PurchaseOrderLineTable,
+			Columns: []string{supplierbillline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchaseOrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.PurchaseOrderLineTable,
+			Columns: []string{supplierbillline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbillline.AccountTable,
+			Columns: []string{supplierbillline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbillline.AccountTable,
+			Columns: []string{supplierbillline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{supplierbillline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SupplierBillLineUpdateOne is the builder for updating a single SupplierBillLine entity.
+type SupplierBillLineUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SupplierBillLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDescription sets the "description" field.
+func (_u *SupplierBillLineUpdateOne) SetDescription(v string) *SupplierBillLineUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableDescription(v *string) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *SupplierBillLineUpdateOne) SetQuantity(v decimal.Decimal) *SupplierBillLineUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableQuantity(v *decimal.Decimal) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *SupplierBillLineUpdateOne) SetUnitPrice(v decimal.Decimal) *SupplierBillLineUpdateOne {
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableUnitPrice(v *decimal.Decimal) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *SupplierBillLineUpdateOne) SetTaxCode(v string) *SupplierBillLineUpdateOne {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableTaxCode(v *string) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (_u *SupplierBillLineUpdateOne) ClearTaxCode() *SupplierBillLineUpdateOne {
+	_u.mutation.ClearTaxCode()
+	return _u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_u *SupplierBillLineUpdateOne) SetTaxRate(v decimal.Decimal) *SupplierBillLineUpdateOne {
+	_u.mutation.SetTaxRate(v)
+	return _u
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableTaxRate(v *decimal.Decimal) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetTaxRate(*v)
+	}
+	return _u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_u *SupplierBillLineUpdateOne) SetNetAmount(v decimal.Decimal) *SupplierBillLineUpdateOne {
+	_u.mutation.SetNetAmount(v)
+	return _u
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableNetAmount(v *decimal.Decimal) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetNetAmount(*v)
+	}
+	return _u
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_u *SupplierBillLineUpdateOne) SetMatchStatus(v supplierbillline.MatchStatus) *SupplierBillLineUpdateOne {
+	_u.mutation.SetMatchStatus(v)
+	return _u
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableMatchStatus(v *supplierbillline.MatchStatus) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetMatchStatus(*v)
+	}
+	return _u
+}
+
+// SetQuantityVariance sets the "quantity_variance" field.
+func (_u *SupplierBillLineUpdateOne) SetQuantityVariance(v decimal.Decimal) *SupplierBillLineUpdateOne {
+	_u.mutation.SetQuantityVariance(v)
+	return _u
+}
+
+// SetNillableQuantityVariance sets the "quantity_variance" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableQuantityVariance(v *decimal.Decimal) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetQuantityVariance(*v)
+	}
+	return _u
+}
+
+// SetPriceVariance sets the "price_variance" field.
+func (_u *SupplierBillLineUpdateOne) SetPriceVariance(v decimal.Decimal) *SupplierBillLineUpdateOne {
+	_u.mutation.SetPriceVariance(v)
+	return _u
+}
+
+// SetNillablePriceVariance sets the "price_variance" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillablePriceVariance(v *decimal.Decimal) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetPriceVariance(*v)
+	}
+	return _u
+}
+
+// SetMatchNote sets the "match_note" field.
+func (_u *SupplierBillLineUpdateOne) SetMatchNote(v string) *SupplierBillLineUpdateOne {
+	_u.mutation.SetMatchNote(v)
+	return _u
+}
+
+// SetNillableMatchNote sets the "match_note" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableMatchNote(v *string) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetMatchNote(*v)
+	}
+	return _u
+}
+
+// ClearMatchNote clears the value of the "match_note" field.
+func (_u *SupplierBillLineUpdateOne) ClearMatchNote() *SupplierBillLineUpdateOne {
+	_u.mutation.ClearMatchNote()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *SupplierBillLineUpdateOne) SetPosition(v int) *SupplierBillLineUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillablePosition(v *int) *SupplierBillLineUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *SupplierBillLineUpdateOne) AddPosition(v int) *SupplierBillLineUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetBillID sets the "bill" edge to the SupplierBill entity by ID.
+func (_u *SupplierBillLineUpdateOne) SetBillID(id int) *SupplierBillLineUpdateOne {
+	_u.mutation.SetBillID(id)
+	return _u
+}
+
+// SetBill sets the "bill" edge to the SupplierBill entity.
+func (_u *SupplierBillLineUpdateOne) SetBill(v *SupplierBill) *SupplierBillLineUpdateOne {
+	return _u.SetBillID(v.ID)
+}
+
+// SetPurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID.
+func (_u *SupplierBillLineUpdateOne) SetPurchaseOrderLineID(id int) *SupplierBillLineUpdateOne {
+	_u.mutation.SetPurchaseOrderLineID(id)
+	return _u
+}
+
+// SetNillablePurchaseOrderLineID sets the "purchase_order_line" edge to the PurchaseOrderLine entity by ID if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillablePurchaseOrderLineID(id *int) *SupplierBillLineUpdateOne {
+	if id != nil {
+		_u = _u.SetPurchaseOrderLineID(*id)
+	}
+	return _u
+}
+
+// SetPurchaseOrderLine sets the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_u *SupplierBillLineUpdateOne) SetPurchaseOrderLine(v *PurchaseOrderLine) *SupplierBillLineUpdateOne {
+	return _u.SetPurchaseOrderLineID(v.ID)
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *SupplierBillLineUpdateOne) SetAccountID(id int) *SupplierBillLineUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetNillableAccountID sets the "account" edge to the Account entity by ID if the given value is not nil.
+func (_u *SupplierBillLineUpdateOne) SetNillableAccountID(id *int) *SupplierBillLineUpdateOne {
+	if id != nil {
+		_u = _u.SetAccountID(*id)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *SupplierBillLineUpdateOne) SetAccount(v *Account) *SupplierBillLineUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the SupplierBillLineMutation object of the builder.
+func (_u *SupplierBillLineUpdateOne) Mutation() *SupplierBillLineMutation {
+	return _u.mutation
+}
+
+// ClearBill clears the "bill" edge to the SupplierBill entity.
+func (_u *SupplierBillLineUpdateOne) ClearBill() *SupplierBillLineUpdateOne {
+	_u.mutation.ClearBill()
+	return _u
+}
+
+// ClearPurchaseOrderLine clears the "purchase_order_line" edge to the PurchaseOrderLine entity.
+func (_u *SupplierBillLineUpdateOne) ClearPurchaseOrderLine() *SupplierBillLineUpdateOne {
+	_u.mutation.ClearPurchaseOrderLine()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *SupplierBillLineUpdateOne) ClearAccount() *SupplierBillLineUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the SupplierBillLineUpdate builder.
+func (_u *SupplierBillLineUpdateOne) Where(ps ...predicate.SupplierBillLine) *SupplierBillLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SupplierBillLineUpdateOne) Select(field string, fields ...string) *SupplierBillLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SupplierBillLine entity.
+func (_u *SupplierBillLineUpdateOne) Save(ctx context.Context) (*SupplierBillLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SupplierBillLineUpdateOne) SaveX(ctx context.Context) *SupplierBillLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SupplierBillLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SupplierBillLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SupplierBillLineUpdateOne) check() error {
+	if v, ok := _u.mutation.MatchStatus(); ok {
+		if err := supplierbillline.MatchStatusValidator(v); err != nil {
+			return &ValidationError{Name: "match_status", err: fmt.Errorf(`ent: validator failed for field "SupplierBillLine.match_status": %w`, err)}
+		}
+	}
+	if _u.mutation.BillCleared() && len(_u.mutation.BillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupplierBillLine.bill"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SupplierBillLineUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SupplierBillLineUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SupplierBillLineUpdateOne) sqlSave(ctx context.Context) (_node *SupplierBillLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(supplierbillline.Table, supplierbillline.Columns, sqlgraph.NewFieldSpec(supplierbillline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SupplierBillLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, supplierbillline.FieldID)
+		for _, f := range fields {
+			if !supplierbillline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != supplierbillline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(supplierbillline.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(supplierbillline.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(supplierbillline.FieldUnitPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(supplierbillline.FieldTaxCode, field.TypeString, value)
+	}
+	if _u.mutation.TaxCodeCleared() {
+		_spec.ClearField(supplierbillline.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxRate(); ok {
+		_spec.SetField(supplierbillline.FieldTaxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.NetAmount(); ok {
+		_spec.SetField(supplierbillline.FieldNetAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.MatchStatus(); ok {
+		_spec.SetField(supplierbillline.FieldMatchStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QuantityVariance(); ok {
+		_spec.SetField(supplierbillline.FieldQuantityVariance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.PriceVariance(); ok {
+		_spec.SetField(supplierbillline.FieldPriceVariance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.MatchNote(); ok {
+		_spec.SetField(supplierbillline.FieldMatchNote, field.TypeString, value)
+	}
+	if _u.mutation.MatchNoteCleared() {
+		_spec.ClearField(supplierbillline.FieldMatchNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(supplierbillline.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(supplierbillline.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.BillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.BillTable,
+			Columns: []string{supplierbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.BillTable,
+			Columns: []string{supplierbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchaseOrderLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.PurchaseOrderLineTable,
+			Columns: []string{supplierbillline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchaseOrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   supplierbillline.PurchaseOrderLineTable,
+			Columns: []string{supplierbillline.PurchaseOrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbillline.AccountTable,
+			Columns: []string{supplierbillline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supplierbillline.AccountTable,
+			Columns: []string{supplierbillline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SupplierBillLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{supplierbillline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/tenant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TaxJurisdiction is the model entity for the TaxJurisdiction schema.
+type TaxJurisdiction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Rounding holds the value of the "rounding" field.
+	Rounding taxjurisdiction.Rounding `json:"rounding,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaxJurisdictionQuery when eager-loading is set.
+	Edges                    TaxJurisdictionEdges `json:"edges"`
+	tenant_tax_jurisdictions *int
+	selectValues             sql.SelectValues
+}
+
+// TaxJurisdictionEdges holds the relations/edges for other nodes in the graph.
+type TaxJurisdictionEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Rates holds the value of the rates edge.
+	Rates []*TaxRate `json:"rates,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaxJurisdictionEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// RatesOrErr returns the Rates value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaxJurisdictionEdges) RatesOrErr() ([]*TaxRate, error) {
+	if e.loadedTypes[1] {
+		return e.Rates, nil
+	}
+	return nil, &NotLoadedError{edge: "rates"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaxJurisdiction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taxjurisdiction.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case taxjurisdiction.FieldID:
+			values[i] = new(sql.NullInt64)
+		case taxjurisdiction.FieldCode, taxjurisdiction.FieldName, taxjurisdiction.FieldRounding:
+			values[i] = new(sql.NullString)
+		case taxjurisdiction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taxjurisdiction.ForeignKeys[0]: // tenant_tax_jurisdictions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaxJurisdiction fields.
+func (_m *TaxJurisdiction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taxjurisdiction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case taxjurisdiction.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case taxjurisdiction.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case taxjurisdiction.FieldRounding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rounding", values[i])
+			} else if value.Valid {
+				_m.Rounding = taxjurisdiction.Rounding(value.String)
+			}
+		case taxjurisdiction.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case taxjurisdiction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case taxjurisdiction.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_tax_jurisdictions", value)
+			} else if value.Valid {
+				_m.tenant_tax_jurisdictions = new(int)
+				*_m.tenant_tax_jurisdictions = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaxJurisdiction.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaxJurisdiction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the TaxJurisdiction entity.
+func (_m *TaxJurisdiction) QueryTenant() *TenantQuery {
+	return NewTaxJurisdictionClient(_m.config).QueryTenant(_m)
+}
+
+// QueryRates queries the "rates" edge of the TaxJurisdiction entity.
+func (_m *TaxJurisdiction) QueryRates() *TaxRateQuery {
+	return NewTaxJurisdictionClient(_m.config).QueryRates(_m)
+}
+
+// Update returns a builder for updating this TaxJurisdiction.
+// Note that you need to call TaxJurisdiction.Unwrap() before calling this method if this TaxJurisdiction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaxJurisdiction) Update() *TaxJurisdictionUpdateOne {
+	return NewTaxJurisdictionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaxJurisdiction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaxJurisdiction) Unwrap() *TaxJurisdiction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaxJurisdiction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaxJurisdiction) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaxJurisdiction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("rounding=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rounding))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaxJurisdictions is a parsable slice of TaxJurisdiction.
+type TaxJurisdictions []*TaxJurisdiction
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taxjurisdiction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the taxjurisdiction type in the database.
+	Label = "tax_jurisdiction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldRounding holds the string denoting the rounding field in the database.
+	FieldRounding = "rounding"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeRates holds the string denoting the rates edge name in mutations.
+	EdgeRates = "rates"
+	// Table holds the table name of the taxjurisdiction in the database.
+	Table = "tax_jurisdictions"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "tax_jurisdictions"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_tax_jurisdictions"
+	// RatesTable is the table that holds the rates relation/edge.
+	RatesTable = "tax_rates"
+	// RatesInverseTable is the table name for the TaxRate entity.
+	// It exists in this package in order to avoid circular dependency with the "taxrate" package.
+	RatesInverseTable = "tax_rates"
+	// RatesColumn is the table column denoting the rates relation/edge.
+	RatesColumn = "tax_jurisdiction_rates"
+)
+
+// Columns holds all SQL columns for taxjurisdiction fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldName,
+	FieldRounding,
+	FieldIsActive,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "tax_jurisdictions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tenant_tax_jurisdictions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Rounding defines the type for the "rounding" enum field.
+type Rounding string
+
+// RoundingHalfUp is the default value of the Rounding enum.
+const DefaultRounding = RoundingHalfUp
+
+// Rounding values.
+const (
+	RoundingHalfUp   Rounding = "half_up"
+	RoundingHalfEven Rounding = "half_even"
+)
+
+func (r Rounding) String() string {
+	return string(r)
+}
+
+// RoundingValidator is a validator for the "rounding" field enum values. It is called by the builders before save.
+func RoundingValidator(r Rounding) error {
+	switch r {
+	case RoundingHalfUp, RoundingHalfEven:
+		return nil
+	default:
+		return fmt.Errorf("taxjurisdiction: invalid enum value for rounding field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the TaxJurisdiction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByRounding orders the results by the rounding field.
+func ByRounding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRounding, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRatesCount orders the results by rates count.
+func ByRatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRatesStep(), opts...)
+	}
+}
+
+// ByRates orders the results by rates terms.
+func ByRates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newRatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RatesTable, RatesColumn),
+	)
+}
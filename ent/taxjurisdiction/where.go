@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taxjurisdiction
+
+import (
+	"sent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldName, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldContainsFold(FieldName, v))
+}
+
+// RoundingEQ applies the EQ predicate on the "rounding" field.
+func RoundingEQ(v Rounding) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldRounding, v))
+}
+
+// RoundingNEQ applies the NEQ predicate on the "rounding" field.
+func RoundingNEQ(v Rounding) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNEQ(FieldRounding, v))
+}
+
+// RoundingIn applies the In predicate on the "rounding" field.
+func RoundingIn(vs ...Rounding) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldIn(FieldRounding, vs...))
+}
+
+// RoundingNotIn applies the NotIn predicate on the "rounding" field.
+func RoundingNotIn(vs ...Rounding) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNotIn(FieldRounding, vs...))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRates applies the HasEdge predicate on the "rates" edge.
+func HasRates() predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RatesTable, RatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRatesWith applies the HasEdge predicate on the "rates" edge with a given conditions (other predicates).
+func HasRatesWith(preds ...predicate.TaxRate) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(func(s *sql.Selector) {
+		step := newRatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaxJurisdiction) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaxJurisdiction) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaxJurisdiction) predicate.TaxJurisdiction {
+	return predicate.TaxJurisdiction(sql.NotPredicates(p))
+}
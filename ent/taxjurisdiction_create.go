@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
+	"sent/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaxJurisdictionCreate is the builder for creating a TaxJurisdiction entity.
+type TaxJurisdictionCreate struct {
+	config
+	mutation *TaxJurisdictionMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *TaxJurisdictionCreate) SetCode(v string) *TaxJurisdictionCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TaxJurisdictionCreate) SetName(v string) *TaxJurisdictionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetRounding sets the "rounding" field.
+func (_c *TaxJurisdictionCreate) SetRounding(v taxjurisdiction.Rounding) *TaxJurisdictionCreate {
+	_c.mutation.SetRounding(v)
+	return _c
+}
+
+// SetNillableRounding sets the "rounding" field if the given value is not nil.
+func (_c *TaxJurisdictionCreate) SetNillableRounding(v *taxjurisdiction.Rounding) *TaxJurisdictionCreate {
+	if v != nil {
+		_c.SetRounding(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *TaxJurisdictionCreate) SetIsActive(v bool) *TaxJurisdictionCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *TaxJurisdictionCreate) SetNillableIsActive(v *bool) *TaxJurisdictionCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaxJurisdictionCreate) SetCreatedAt(v time.Time) *TaxJurisdictionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaxJurisdictionCreate) SetNillableCreatedAt(v *time.Time) *TaxJurisdictionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *TaxJurisdictionCreate) SetTenantID(id int) *TaxJurisdictionCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *TaxJurisdictionCreate) SetTenant(v *Tenant) *TaxJurisdictionCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// AddRateIDs adds the "rates" edge to the TaxRate entity by IDs.
+func (_c *TaxJurisdictionCreate) AddRateIDs(ids ...int) *TaxJurisdictionCreate {
+	_c.mutation.AddRateIDs(ids...)
+	return _c
+}
+
+// AddRates adds the "rates" edges to the TaxRate entity.
+func (_c *TaxJurisdictionCreate) AddRates(v ...*TaxRate) *TaxJurisdictionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRateIDs(ids...)
+}
+
+// Mutation returns the TaxJurisdictionMutation object of the builder.
+func (_c *TaxJurisdictionCreate) Mutation() *TaxJurisdictionMutation {
+	return _c.mutation
+}
+
+// Save creates the TaxJurisdiction in the database.
+func (_c *TaxJurisdictionCreate) Save(ctx context.Context) (*TaxJurisdiction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaxJurisdictionCreate) SaveX(ctx context.Context) *TaxJurisdiction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaxJurisdictionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaxJurisdictionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaxJurisdictionCreate) defaults() {
+	if _, ok := _c.mutation.Rounding(); !ok {
+		v := taxjurisdiction.DefaultRounding
+		_c.mutation.SetRounding(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := taxjurisdiction.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := taxjurisdiction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaxJurisdictionCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "TaxJurisdiction.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := taxjurisdiction.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TaxJurisdiction.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := taxjurisdiction.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rounding(); !ok {
+		return &ValidationError{Name: "rounding", err: errors.New(`ent: missing required field "TaxJurisdiction.rounding"`)}
+	}
+	if v, ok := _c.mutation.Rounding(); ok {
+		if err := taxjurisdiction.RoundingValidator(v); err != nil {
+			return &ValidationError{Name: "rounding", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.rounding": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "TaxJurisdiction.is_active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaxJurisdiction.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "TaxJurisdiction.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *TaxJurisdictionCreate) sqlSave(ctx context.Context) (*TaxJurisdiction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaxJurisdictionCreate) createSpec() (*TaxJurisdiction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaxJurisdiction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taxjurisdiction.Table, sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(taxjurisdiction.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(taxjurisdiction.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Rounding(); ok {
+		_spec.SetField(taxjurisdiction.FieldRounding, field.TypeEnum, value)
+		_node.Rounding = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(taxjurisdiction.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taxjurisdiction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxjurisdiction.TenantTable,
+			Columns: []string{taxjurisdiction.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_tax_jurisdictions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxjurisdiction.RatesTable,
+			Columns: []string{taxjurisdiction.RatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaxJurisdictionCreateBulk is the builder for creating many TaxJurisdiction entities in bulk.
+type TaxJurisdictionCreateBulk struct {
+	config
+	err      error
+	builders []*TaxJurisdictionCreate
+}
+
+// Save creates the TaxJurisdiction entities in the database.
+func (_c *TaxJurisdictionCreateBulk) Save(ctx context.Context) ([]*TaxJurisdiction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaxJurisdiction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaxJurisdictionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaxJurisdictionCreateBulk) SaveX(ctx context.Context) []*TaxJurisdiction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaxJurisdictionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaxJurisdictionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
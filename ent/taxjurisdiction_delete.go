@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sent/ent/predicate"
+	"sent/ent/taxjurisdiction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaxJurisdictionDelete is the builder for deleting a TaxJurisdiction entity.
+type TaxJurisdictionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaxJurisdictionMutation
+}
+
+// Where appends a list predicates to the TaxJurisdictionDelete builder.
+func (_d *TaxJurisdictionDelete) Where(ps ...predicate.TaxJurisdiction) *TaxJurisdictionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TaxJurisdictionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TaxJurisdictionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TaxJurisdictionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taxjurisdiction.Table, sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TaxJurisdictionDeleteOne is the builder for deleting a single TaxJurisdiction entity.
+type TaxJurisdictionDeleteOne struct {
+	_d *TaxJurisdictionDelete
+}
+
+// Where appends a list predicates to the TaxJurisdictionDelete builder.
+func (_d *TaxJurisdictionDeleteOne) Where(ps ...predicate.TaxJurisdiction) *TaxJurisdictionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TaxJurisdictionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taxjurisdiction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TaxJurisdictionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
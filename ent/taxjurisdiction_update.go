@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/predicate"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
+	"sent/ent/tenant"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaxJurisdictionUpdate is the builder for updating TaxJurisdiction entities.
+type TaxJurisdictionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaxJurisdictionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaxJurisdictionUpdate builder.
+func (_u *TaxJurisdictionUpdate) Where(ps ...predicate.TaxJurisdiction) *TaxJurisdictionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *TaxJurisdictionUpdate) SetCode(v string) *TaxJurisdictionUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *TaxJurisdictionUpdate) SetNillableCode(v *string) *TaxJurisdictionUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TaxJurisdictionUpdate) SetName(v string) *TaxJurisdictionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TaxJurisdictionUpdate) SetNillableName(v *string) *TaxJurisdictionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRounding sets the "rounding" field.
+func (_u *TaxJurisdictionUpdate) SetRounding(v taxjurisdiction.Rounding) *TaxJurisdictionUpdate {
+	_u.mutation.SetRounding(v)
+	return _u
+}
+
+// SetNillableRounding sets the "rounding" field if the given value is not nil.
+func (_u *TaxJurisdictionUpdate) SetNillableRounding(v *taxjurisdiction.Rounding) *TaxJurisdictionUpdate {
+	if v != nil {
+		_u.SetRounding(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *TaxJurisdictionUpdate) SetIsActive(v bool) *TaxJurisdictionUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *TaxJurisdictionUpdate) SetNillableIsActive(v *bool) *TaxJurisdictionUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *TaxJurisdictionUpdate) SetTenantID(id int) *TaxJurisdictionUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *TaxJurisdictionUpdate) SetTenant(v *Tenant) *TaxJurisdictionUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddRateIDs adds the "rates" edge to the TaxRate entity by IDs.
+func (_u *TaxJurisdictionUpdate) AddRateIDs(ids ...int) *TaxJurisdictionUpdate {
+	_u.mutation.AddRateIDs(ids...)
+	return _u
+}
+
+// AddRates adds the "rates" edges to the TaxRate entity.
+func (_u *TaxJurisdictionUpdate) AddRates(v ...*TaxRate) *TaxJurisdictionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRateIDs(ids...)
+}
+
+// Mutation returns the TaxJurisdictionMutation object of the builder.
+func (_u *TaxJurisdictionUpdate) Mutation() *TaxJurisdictionMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TaxJurisdictionUpdate) ClearTenant() *TaxJurisdictionUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearRates clears all "rates" edges to the TaxRate entity.
+func (_u *TaxJurisdictionUpdate) ClearRates() *TaxJurisdictionUpdate {
+	_u.mutation.ClearRates()
+	return _u
+}
+
+// RemoveRateIDs removes the "rates" edge to TaxRate entities by IDs.
+func (_u *TaxJurisdictionUpdate) RemoveRateIDs(ids ...int) *TaxJurisdictionUpdate {
+	_u.mutation.RemoveRateIDs(ids...)
+	return _u
+}
+
+// RemoveRates removes "rates" edges to TaxRate entities.
+func (_u *TaxJurisdictionUpdate) RemoveRates(v ...*TaxRate) *TaxJurisdictionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRateIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaxJurisdictionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaxJurisdictionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaxJurisdictionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaxJurisdictionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaxJurisdictionUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := taxjurisdiction.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := taxjurisdiction.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rounding(); ok {
+		if err := taxjurisdiction.RoundingValidator(v); err != nil {
+			return &ValidationError{Name: "rounding", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.rounding": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaxJurisdiction.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaxJurisdictionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaxJurisdictionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaxJurisdictionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taxjurisdiction.Table, taxjurisdiction.Columns, sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(taxjurisdiction.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(taxjurisdiction.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rounding(); ok {
+		_spec.SetField(taxjurisdiction.FieldRounding, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(taxjurisdiction.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxjurisdiction.TenantTable,
+			Columns: []string{taxjurisdiction.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxjurisdiction.TenantTable,
+			Columns: []string{taxjurisdiction.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxjurisdiction.RatesTable,
+			Columns: []string{taxjurisdiction.RatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRatesIDs(); len(nodes) > 0 && !_u.mutation.RatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxjurisdiction.RatesTable,
+			Columns: []string{taxjurisdiction.RatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxjurisdiction.RatesTable,
+			Columns: []string{taxjurisdiction.RatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxjurisdiction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaxJurisdictionUpdateOne is the builder for updating a single TaxJurisdiction entity.
+type TaxJurisdictionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaxJurisdictionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCode sets the "code" field.
+func (_u *TaxJurisdictionUpdateOne) SetCode(v string) *TaxJurisdictionUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *TaxJurisdictionUpdateOne) SetNillableCode(v *string) *TaxJurisdictionUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TaxJurisdictionUpdateOne) SetName(v string) *TaxJurisdictionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TaxJurisdictionUpdateOne) SetNillableName(v *string) *TaxJurisdictionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRounding sets the "rounding" field.
+func (_u *TaxJurisdictionUpdateOne) SetRounding(v taxjurisdiction.Rounding) *TaxJurisdictionUpdateOne {
+	_u.mutation.SetRounding(v)
+	return _u
+}
+
+// SetNillableRounding sets the "rounding" field if the given value is not nil.
+func (_u *TaxJurisdictionUpdateOne) SetNillableRounding(v *taxjurisdiction.Rounding) *TaxJurisdictionUpdateOne {
+	if v != nil {
+		_u.SetRounding(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *TaxJurisdictionUpdateOne) SetIsActive(v bool) *TaxJurisdictionUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *TaxJurisdictionUpdateOne) SetNillableIsActive(v *bool) *TaxJurisdictionUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *TaxJurisdictionUpdateOne) SetTenantID(id int) *TaxJurisdictionUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *TaxJurisdictionUpdateOne) SetTenant(v *Tenant) *TaxJurisdictionUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddRateIDs adds the "rates" edge to the TaxRate entity by IDs.
+func (_u *TaxJurisdictionUpdateOne) AddRateIDs(ids ...int) *TaxJurisdictionUpdateOne {
+	_u.mutation.AddRateIDs(ids...)
+	return _u
+}
+
+// AddRates adds the "rates" edges to the TaxRate entity.
+func (_u *TaxJurisdictionUpdateOne) AddRates(v ...*TaxRate) *TaxJurisdictionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRateIDs(ids...)
+}
+
+// Mutation returns the TaxJurisdictionMutation object of the builder.
+func (_u *TaxJurisdictionUpdateOne) Mutation() *TaxJurisdictionMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TaxJurisdictionUpdateOne) ClearTenant() *TaxJurisdictionUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearRates clears all "rates" edges to the TaxRate entity.
+func (_u *TaxJurisdictionUpdateOne) ClearRates() *TaxJurisdictionUpdateOne {
+	_u.mutation.ClearRates()
+	return _u
+}
+
+// RemoveRateIDs removes the "rates" edge to TaxRate entities by IDs.
+func (_u *TaxJurisdictionUpdateOne) RemoveRateIDs(ids ...int) *TaxJurisdictionUpdateOne {
+	_u.mutation.RemoveRateIDs(ids...)
+	return _u
+}
+
+// RemoveRates removes "rates" edges to TaxRate entities.
+func (_u *TaxJurisdictionUpdateOne) RemoveRates(v ...*TaxRate) *TaxJurisdictionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRateIDs(ids...)
+}
+
+// Where appends a list predicates to the TaxJurisdictionUpdate builder.
+func (_u *TaxJurisdictionUpdateOne) Where(ps ...predicate.TaxJurisdiction) *TaxJurisdictionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaxJurisdictionUpdateOne) Select(field string, fields ...string) *TaxJurisdictionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaxJurisdiction entity.
+func (_u *TaxJurisdictionUpdateOne) Save(ctx context.Context) (*TaxJurisdiction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaxJurisdictionUpdateOne) SaveX(ctx context.Context) *TaxJurisdiction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaxJurisdictionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaxJurisdictionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaxJurisdictionUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := taxjurisdiction.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := taxjurisdiction.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rounding(); ok {
+		if err := taxjurisdiction.RoundingValidator(v); err != nil {
+			return &ValidationError{Name: "rounding", err: fmt.Errorf(`ent: validator failed for field "TaxJurisdiction.rounding": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaxJurisdiction.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaxJurisdictionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaxJurisdictionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaxJurisdictionUpdateOne) sqlSave(ctx context.Context) (_node *TaxJurisdiction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taxjurisdiction.Table, taxjurisdiction.Columns, sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaxJurisdiction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taxjurisdiction.FieldID)
+		for _, f := range fields {
+			if !taxjurisdiction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taxjurisdiction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(taxjurisdiction.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(taxjurisdiction.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rounding(); ok {
+		_spec.SetField(taxjurisdiction.FieldRounding, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(taxjurisdiction.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxjurisdiction.TenantTable,
+			Columns: []string{taxjurisdiction.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxjurisdiction.TenantTable,
+			Columns: []string{taxjurisdiction.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxjurisdiction.RatesTable,
+			Columns: []string{taxjurisdiction.RatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRatesIDs(); len(nodes) > 0 && !_u.mutation.RatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxjurisdiction.RatesTable,
+			Columns: []string{taxjurisdiction.RatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxjurisdiction.RatesTable,
+			Columns: []string{taxjurisdiction.RatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TaxJurisdiction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxjurisdiction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// TaxRate is the model entity for the TaxRate schema.
+type TaxRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// EffectiveFrom holds the value of the "effective_from" field.
+	EffectiveFrom time.Time `json:"effective_from,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaxRateQuery when eager-loading is set.
+	Edges                  TaxRateEdges `json:"edges"`
+	tax_jurisdiction_rates *int
+	selectValues           sql.SelectValues
+}
+
+// TaxRateEdges holds the relations/edges for other nodes in the graph.
+type TaxRateEdges struct {
+	// Jurisdiction holds the value of the jurisdiction edge.
+	Jurisdiction *TaxJurisdiction `json:"jurisdiction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// JurisdictionOrErr returns the Jurisdiction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaxRateEdges) JurisdictionOrErr() (*TaxJurisdiction, error) {
+	if e.Jurisdiction != nil {
+		return e.Jurisdiction, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: taxjurisdiction.Label}
+	}
+	return nil, &NotLoadedError{edge: "jurisdiction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaxRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taxrate.FieldRate:
+			values[i] = new(decimal.Decimal)
+		case taxrate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case taxrate.FieldNotes:
+			values[i] = new(sql.NullString)
+		case taxrate.FieldEffectiveFrom, taxrate.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taxrate.ForeignKeys[0]: // tax_jurisdiction_rates
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaxRate fields.
+func (_m *TaxRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taxrate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case taxrate.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				_m.Rate = *value
+			}
+		case taxrate.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				_m.EffectiveFrom = value.Time
+			}
+		case taxrate.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case taxrate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case taxrate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tax_jurisdiction_rates", value)
+			} else if value.Valid {
+				_m.tax_jurisdiction_rates = new(int)
+				*_m.tax_jurisdiction_rates = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaxRate.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaxRate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryJurisdiction queries the "jurisdiction" edge of the TaxRate entity.
+func (_m *TaxRate) QueryJurisdiction() *TaxJurisdictionQuery {
+	return NewTaxRateClient(_m.config).QueryJurisdiction(_m)
+}
+
+// Update returns a builder for updating this TaxRate.
+// Note that you need to call TaxRate.Unwrap() before calling this method if this TaxRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaxRate) Update() *TaxRateUpdateOne {
+	return NewTaxRateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaxRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaxRate) Unwrap() *TaxRate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaxRate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaxRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaxRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(_m.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaxRates is a parsable slice of TaxRate.
+type TaxRates []*TaxRate
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taxrate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the taxrate type in the database.
+	Label = "tax_rate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldEffectiveFrom holds the string denoting the effective_from field in the database.
+	FieldEffectiveFrom = "effective_from"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeJurisdiction holds the string denoting the jurisdiction edge name in mutations.
+	EdgeJurisdiction = "jurisdiction"
+	// Table holds the table name of the taxrate in the database.
+	Table = "tax_rates"
+	// JurisdictionTable is the table that holds the jurisdiction relation/edge.
+	JurisdictionTable = "tax_rates"
+	// JurisdictionInverseTable is the table name for the TaxJurisdiction entity.
+	// It exists in this package in order to avoid circular dependency with the "taxjurisdiction" package.
+	JurisdictionInverseTable = "tax_jurisdictions"
+	// JurisdictionColumn is the table column denoting the jurisdiction relation/edge.
+	JurisdictionColumn = "tax_jurisdiction_rates"
+)
+
+// Columns holds all SQL columns for taxrate fields.
+var Columns = []string{
+	FieldID,
+	FieldRate,
+	FieldEffectiveFrom,
+	FieldNotes,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "tax_rates"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tax_jurisdiction_rates",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRate holds the default value on creation for the "rate" field.
+	DefaultRate decimal.Decimal
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TaxRate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByEffectiveFrom orders the results by the effective_from field.
+func ByEffectiveFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveFrom, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByJurisdictionField orders the results by jurisdiction field.
+func ByJurisdictionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJurisdictionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newJurisdictionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JurisdictionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, JurisdictionTable, JurisdictionColumn),
+	)
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// TaxRateCreate is the builder for creating a TaxRate entity.
+type TaxRateCreate struct {
+	config
+	mutation *TaxRateMutation
+	hooks    []Hook
+}
+
+// SetRate sets the "rate" field.
+func (_c *TaxRateCreate) SetRate(v decimal.Decimal) *TaxRateCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_c *TaxRateCreate) SetNillableRate(v *decimal.Decimal) *TaxRateCreate {
+	if v != nil {
+		_c.SetRate(*v)
+	}
+	return _c
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_c *TaxRateCreate) SetEffectiveFrom(v time.Time) *TaxRateCreate {
+	_c.mutation.SetEffectiveFrom(v)
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *TaxRateCreate) SetNotes(v string) *TaxRateCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *TaxRateCreate) SetNillableNotes(v *string) *TaxRateCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaxRateCreate) SetCreatedAt(v time.Time) *TaxRateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaxRateCreate) SetNillableCreatedAt(v *time.Time) *TaxRateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetJurisdictionID sets the "jurisdiction" edge to the TaxJurisdiction entity by ID.
+func (_c *TaxRateCreate) SetJurisdictionID(id int) *TaxRateCreate {
+	_c.mutation.SetJurisdictionID(id)
+	return _c
+}
+
+// SetJurisdiction sets the "jurisdiction" edge to the TaxJurisdiction entity.
+func (_c *TaxRateCreate) SetJurisdiction(v *TaxJurisdiction) *TaxRateCreate {
+	return _c.SetJurisdictionID(v.ID)
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (_c *TaxRateCreate) Mutation() *TaxRateMutation {
+	return _c.mutation
+}
+
+// Save creates the TaxRate in the database.
+func (_c *TaxRateCreate) Save(ctx context.Context) (*TaxRate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaxRateCreate) SaveX(ctx context.Context) *TaxRate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaxRateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaxRateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaxRateCreate) defaults() {
+	if _, ok := _c.mutation.Rate(); !ok {
+		v := taxrate.DefaultRate
+		_c.mutation.SetRate(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := taxrate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaxRateCreate) check() error {
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "TaxRate.rate"`)}
+	}
+	if _, ok := _c.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "TaxRate.effective_from"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaxRate.created_at"`)}
+	}
+	if len(_c.mutation.JurisdictionIDs()) == 0 {
+		return &ValidationError{Name: "jurisdiction", err: errors.New(`ent: missing required edge "TaxRate.jurisdiction"`)}
+	}
+	return nil
+}
+
+func (_c *TaxRateCreate) sqlSave(ctx context.Context) (*TaxRate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaxRateCreate) createSpec() (*TaxRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaxRate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taxrate.Table, sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(taxrate.FieldRate, field.TypeOther, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.EffectiveFrom(); ok {
+		_spec.SetField(taxrate.FieldEffectiveFrom, field.TypeTime, value)
+		_node.EffectiveFrom = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(taxrate.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taxrate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.JurisdictionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.JurisdictionTable,
+			Columns: []string{taxrate.JurisdictionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tax_jurisdiction_rates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaxRateCreateBulk is the builder for creating many TaxRate entities in bulk.
+type TaxRateCreateBulk struct {
+	config
+	err      error
+	builders []*TaxRateCreate
+}
+
+// Save creates the TaxRate entities in the database.
+func (_c *TaxRateCreateBulk) Save(ctx context.Context) ([]*TaxRate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaxRate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaxRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaxRateCreateBulk) SaveX(ctx context.Context) []*TaxRate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaxRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaxRateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
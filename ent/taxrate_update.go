@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/predicate"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// TaxRateUpdate is the builder for updating TaxRate entities.
+type TaxRateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaxRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaxRateUpdate builder.
+func (_u *TaxRateUpdate) Where(ps ...predicate.TaxRate) *TaxRateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *TaxRateUpdate) SetRate(v decimal.Decimal) *TaxRateUpdate {
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *TaxRateUpdate) SetNillableRate(v *decimal.Decimal) *TaxRateUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *TaxRateUpdate) SetEffectiveFrom(v time.Time) *TaxRateUpdate {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *TaxRateUpdate) SetNillableEffectiveFrom(v *time.Time) *TaxRateUpdate {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *TaxRateUpdate) SetNotes(v string) *TaxRateUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *TaxRateUpdate) SetNillableNotes(v *string) *TaxRateUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *TaxRateUpdate) ClearNotes() *TaxRateUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetJurisdictionID sets the "jurisdiction" edge to the TaxJurisdiction entity by ID.
+func (_u *TaxRateUpdate) SetJurisdictionID(id int) *TaxRateUpdate {
+	_u.mutation.SetJurisdictionID(id)
+	return _u
+}
+
+// SetJurisdiction sets the "jurisdiction" edge to the TaxJurisdiction entity.
+func (_u *TaxRateUpdate) SetJurisdiction(v *TaxJurisdiction) *TaxRateUpdate {
+	return _u.SetJurisdictionID(v.ID)
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (_u *TaxRateUpdate) Mutation() *TaxRateMutation {
+	return _u.mutation
+}
+
+// ClearJurisdiction clears the "jurisdiction" edge to the TaxJurisdiction entity.
+func (_u *TaxRateUpdate) ClearJurisdiction() *TaxRateUpdate {
+	_u.mutation.ClearJurisdiction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaxRateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaxRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaxRateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaxRateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaxRateUpdate) check() error {
+	if _u.mutation.JurisdictionCleared() && len(_u.mutation.JurisdictionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaxRate.jurisdiction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaxRateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaxRateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaxRateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taxrate.Table, taxrate.Columns, sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(taxrate.FieldRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(taxrate.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(taxrate.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(taxrate.FieldNotes, field.TypeString)
+	}
+	if _u.mutation.JurisdictionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.JurisdictionTable,
+			Columns: []string{taxrate.JurisdictionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JurisdictionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.JurisdictionTable,
+			Columns: []string{taxrate.JurisdictionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaxRateUpdateOne is the builder for updating a single TaxRate entity.
+type TaxRateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaxRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRate sets the "rate" field.
+func (_u *TaxRateUpdateOne) SetRate(v decimal.Decimal) *TaxRateUpdateOne {
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *TaxRateUpdateOne) SetNillableRate(v *decimal.Decimal) *TaxRateUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *TaxRateUpdateOne) SetEffectiveFrom(v time.Time) *TaxRateUpdateOne {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *TaxRateUpdateOne) SetNillableEffectiveFrom(v *time.Time) *TaxRateUpdateOne {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *TaxRateUpdateOne) SetNotes(v string) *TaxRateUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *TaxRateUpdateOne) SetNillableNotes(v *string) *TaxRateUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *TaxRateUpdateOne) ClearNotes() *TaxRateUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetJurisdictionID sets the "jurisdiction" edge to the TaxJurisdiction entity by ID.
+func (_u *TaxRateUpdateOne) SetJurisdictionID(id int) *TaxRateUpdateOne {
+	_u.mutation.SetJurisdictionID(id)
+	return _u
+}
+
+// SetJurisdiction sets the "jurisdiction" edge to the TaxJurisdiction entity.
+func (_u *TaxRateUpdateOne) SetJurisdiction(v *TaxJurisdiction) *TaxRateUpdateOne {
+	return _u.SetJurisdictionID(v.ID)
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (_u *TaxRateUpdateOne) Mutation() *TaxRateMutation {
+	return _u.mutation
+}
+
+// ClearJurisdiction clears the "jurisdiction" edge to the TaxJurisdiction entity.
+func (_u *TaxRateUpdateOne) ClearJurisdiction() *TaxRateUpdateOne {
+	_u.mutation.ClearJurisdiction()
+	return _u
+}
+
+// Where appends a list predicates to the TaxRateUpdate builder.
+func (_u *TaxRateUpdateOne) Where(ps ...predicate.TaxRate) *TaxRateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaxRateUpdateOne) Select(field string, fields ...string) *TaxRateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaxRate entity.
+func (_u *TaxRateUpdateOne) Save(ctx context.Context) (*TaxRate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaxRateUpdateOne) SaveX(ctx context.Context) *TaxRate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaxRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaxRateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaxRateUpdateOne) check() error {
+	if _u.mutation.JurisdictionCleared() && len(_u.mutation.JurisdictionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaxRate.jurisdiction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaxRateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaxRateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaxRateUpdateOne) sqlSave(ctx context.Context) (_node *TaxRate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taxrate.Table, taxrate.Columns, sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaxRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taxrate.FieldID)
+		for _, f := range fields {
+			if !taxrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(taxrate.FieldRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(taxrate.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(taxrate.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(taxrate.FieldNotes, field.TypeString)
+	}
+	if _u.mutation.JurisdictionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.JurisdictionTable,
+			Columns: []string{taxrate.JurisdictionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JurisdictionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.JurisdictionTable,
+			Columns: []string{taxrate.JurisdictionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TaxRate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
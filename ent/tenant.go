@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/agentauthority"
 	"sent/ent/tenant"
 	"strings"
 	"time"
@@ -29,6 +30,14 @@ type Tenant struct {
 	Active bool `json:"active,omitempty"`
 	// TransactionLimit holds the value of the "transaction_limit" field.
 	TransactionLimit decimal.Decimal `json:"transaction_limit,omitempty"`
+	// FunctionalCurrency holds the value of the "functional_currency" field.
+	FunctionalCurrency string `json:"functional_currency,omitempty"`
+	// FiscalYearStartMonth holds the value of the "fiscal_year_start_month" field.
+	FiscalYearStartMonth int `json:"fiscal_year_start_month,omitempty"`
+	// TaxNumber holds the value of the "tax_number" field.
+	TaxNumber string `json:"tax_number,omitempty"`
+	// Logo holds the value of the "logo" field.
+	Logo []byte `json:"logo,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TenantQuery when eager-loading is set.
 	Edges                   TenantEdges `json:"edges"`
@@ -109,6 +118,34 @@ type TenantEdges struct {
 	JournalEntries []*JournalEntry `json:"journal_entries,omitempty"`
 	// RecurringInvoices holds the value of the recurring_invoices edge.
 	RecurringInvoices []*RecurringInvoice `json:"recurring_invoices,omitempty"`
+	// ExchangeRates holds the value of the exchange_rates edge.
+	ExchangeRates []*ExchangeRate `json:"exchange_rates,omitempty"`
+	// FiscalPeriods holds the value of the fiscal_periods edge.
+	FiscalPeriods []*FiscalPeriod `json:"fiscal_periods,omitempty"`
+	// BalanceSnapshots holds the value of the balance_snapshots edge.
+	BalanceSnapshots []*AccountBalanceSnapshot `json:"balance_snapshots,omitempty"`
+	// BankStatements holds the value of the bank_statements edge.
+	BankStatements []*BankStatement `json:"bank_statements,omitempty"`
+	// BankStatementLines holds the value of the bank_statement_lines edge.
+	BankStatementLines []*BankStatementLine `json:"bank_statement_lines,omitempty"`
+	// BankRules holds the value of the bank_rules edge.
+	BankRules []*BankRule `json:"bank_rules,omitempty"`
+	// Customers holds the value of the customers edge.
+	Customers []*Customer `json:"customers,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
+	// CustomerPayments holds the value of the customer_payments edge.
+	CustomerPayments []*CustomerPayment `json:"customer_payments,omitempty"`
+	// SupplierBills holds the value of the supplier_bills edge.
+	SupplierBills []*SupplierBill `json:"supplier_bills,omitempty"`
+	// PaymentRuns holds the value of the payment_runs edge.
+	PaymentRuns []*PaymentRun `json:"payment_runs,omitempty"`
+	// GoodsReceipts holds the value of the goods_receipts edge.
+	GoodsReceipts []*GoodsReceipt `json:"goods_receipts,omitempty"`
+	// StockLevels holds the value of the stock_levels edge.
+	StockLevels []*StockLevel `json:"stock_levels,omitempty"`
+	// TransferOrders holds the value of the transfer_orders edge.
+	TransferOrders []*TransferOrder `json:"transfer_orders,omitempty"`
 	// InventoryReservations holds the value of the inventory_reservations edge.
 	InventoryReservations []*InventoryReservation `json:"inventory_reservations,omitempty"`
 	// Departments holds the value of the departments edge.
@@ -189,9 +226,29 @@ type TenantEdges struct {
 	BenefitPlans []*BenefitPlan `json:"benefit_plans,omitempty"`
 	// BenefitEnrollments holds the value of the benefit_enrollments edge.
 	BenefitEnrollments []*BenefitEnrollment `json:"benefit_enrollments,omitempty"`
+	// PosShifts holds the value of the pos_shifts edge.
+	PosShifts []*PosShift `json:"pos_shifts,omitempty"`
+	// LabelTemplates holds the value of the label_templates edge.
+	LabelTemplates []*LabelTemplate `json:"label_templates,omitempty"`
+	// TaxJurisdictions holds the value of the tax_jurisdictions edge.
+	TaxJurisdictions []*TaxJurisdiction `json:"tax_jurisdictions,omitempty"`
+	// EinvoiceCredentials holds the value of the einvoice_credentials edge.
+	EinvoiceCredentials []*EInvoiceCredential `json:"einvoice_credentials,omitempty"`
+	// Einvoices holds the value of the einvoices edge.
+	Einvoices []*EInvoice `json:"einvoices,omitempty"`
+	// AgentEnrollmentTokens holds the value of the agent_enrollment_tokens edge.
+	AgentEnrollmentTokens []*AgentEnrollmentToken `json:"agent_enrollment_tokens,omitempty"`
+	// AgentAuthority holds the value of the agent_authority edge.
+	AgentAuthority *AgentAuthority `json:"agent_authority,omitempty"`
+	// DeviceGroups holds the value of the device_groups edge.
+	DeviceGroups []*DeviceGroup `json:"device_groups,omitempty"`
+	// PatchPolicies holds the value of the patch_policies edge.
+	PatchPolicies []*PatchPolicy `json:"patch_policies,omitempty"`
+	// AlertRules holds the value of the alert_rules edge.
+	AlertRules []*AlertRule `json:"alert_rules,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [75]bool
+	loadedTypes [99]bool
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -511,10 +568,136 @@ func (e TenantEdges) RecurringInvoicesOrErr() ([]*RecurringInvoice, error) {
 	return nil, &NotLoadedError{edge: "recurring_invoices"}
 }
 
+// ExchangeRatesOrErr returns the ExchangeRates value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ExchangeRatesOrErr() ([]*ExchangeRate, error) {
+	if e.loadedTypes[35] {
+		return e.ExchangeRates, nil
+	}
+	return nil, &NotLoadedError{edge: "exchange_rates"}
+}
+
+// FiscalPeriodsOrErr returns the FiscalPeriods value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) FiscalPeriodsOrErr() ([]*FiscalPeriod, error) {
+	if e.loadedTypes[36] {
+		return e.FiscalPeriods, nil
+	}
+	return nil, &NotLoadedError{edge: "fiscal_periods"}
+}
+
+// BalanceSnapshotsOrErr returns the BalanceSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) BalanceSnapshotsOrErr() ([]*AccountBalanceSnapshot, error) {
+	if e.loadedTypes[37] {
+		return e.BalanceSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "balance_snapshots"}
+}
+
+// BankStatementsOrErr returns the BankStatements value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) BankStatementsOrErr() ([]*BankStatement, error) {
+	if e.loadedTypes[38] {
+		return e.BankStatements, nil
+	}
+	return nil, &NotLoadedError{edge: "bank_statements"}
+}
+
+// BankStatementLinesOrErr returns the BankStatementLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) BankStatementLinesOrErr() ([]*BankStatementLine, error) {
+	if e.loadedTypes[39] {
+		return e.BankStatementLines, nil
+	}
+	return nil, &NotLoadedError{edge: "bank_statement_lines"}
+}
+
+// BankRulesOrErr returns the BankRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) BankRulesOrErr() ([]*BankRule, error) {
+	if e.loadedTypes[40] {
+		return e.BankRules, nil
+	}
+	return nil, &NotLoadedError{edge: "bank_rules"}
+}
+
+// CustomersOrErr returns the Customers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) CustomersOrErr() ([]*Customer, error) {
+	if e.loadedTypes[41] {
+		return e.Customers, nil
+	}
+	return nil, &NotLoadedError{edge: "customers"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[42] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// CustomerPaymentsOrErr returns the CustomerPayments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) CustomerPaymentsOrErr() ([]*CustomerPayment, error) {
+	if e.loadedTypes[43] {
+		return e.CustomerPayments, nil
+	}
+	return nil, &NotLoadedError{edge: "customer_payments"}
+}
+
+// SupplierBillsOrErr returns the SupplierBills value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) SupplierBillsOrErr() ([]*SupplierBill, error) {
+	if e.loadedTypes[44] {
+		return e.SupplierBills, nil
+	}
+	return nil, &NotLoadedError{edge: "supplier_bills"}
+}
+
+// PaymentRunsOrErr returns the PaymentRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) PaymentRunsOrErr() ([]*PaymentRun, error) {
+	if e.loadedTypes[45] {
+		return e.PaymentRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "payment_runs"}
+}
+
+// GoodsReceiptsOrErr returns the GoodsReceipts value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) GoodsReceiptsOrErr() ([]*GoodsReceipt, error) {
+	if e.loadedTypes[46] {
+		return e.GoodsReceipts, nil
+	}
+	return nil, &NotLoadedError{edge: "goods_receipts"}
+}
+
+// StockLevelsOrErr returns the StockLevels value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) StockLevelsOrErr() ([]*StockLevel, error) {
+	if e.loadedTypes[47] {
+		return e.StockLevels, nil
+	}
+	return nil, &NotLoadedError{edge: "stock_levels"}
+}
+
+// TransferOrdersOrErr returns the TransferOrders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) TransferOrdersOrErr() ([]*TransferOrder, error) {
+	if e.loadedTypes[48] {
+		return e.TransferOrders, nil
+	}
+	return nil, &NotLoadedError{edge: "transfer_orders"}
+}
+
 // InventoryReservationsOrErr returns the InventoryReservations value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) InventoryReservationsOrErr() ([]*InventoryReservation, error) {
-	if e.loadedTypes[35] {
+	if e.loadedTypes[49] {
 		return e.InventoryReservations, nil
 	}
 	return nil, &NotLoadedError{edge: "inventory_reservations"}
@@ -523,7 +706,7 @@ func (e TenantEdges) InventoryReservationsOrErr() ([]*InventoryReservation, erro
 // DepartmentsOrErr returns the Departments value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) DepartmentsOrErr() ([]*Department, error) {
-	if e.loadedTypes[36] {
+	if e.loadedTypes[50] {
 		return e.Departments, nil
 	}
 	return nil, &NotLoadedError{edge: "departments"}
@@ -532,7 +715,7 @@ func (e TenantEdges) DepartmentsOrErr() ([]*Department, error) {
 // PermissionsOrErr returns the Permissions value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) PermissionsOrErr() ([]*Permission, error) {
-	if e.loadedTypes[37] {
+	if e.loadedTypes[51] {
 		return e.Permissions, nil
 	}
 	return nil, &NotLoadedError{edge: "permissions"}
@@ -541,7 +724,7 @@ func (e TenantEdges) PermissionsOrErr() ([]*Permission, error) {
 // AssetTypesOrErr returns the AssetTypes value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) AssetTypesOrErr() ([]*AssetType, error) {
-	if e.loadedTypes[38] {
+	if e.loadedTypes[52] {
 		return e.AssetTypes, nil
 	}
 	return nil, &NotLoadedError{edge: "asset_types"}
@@ -550,7 +733,7 @@ func (e TenantEdges) AssetTypesOrErr() ([]*AssetType, error) {
 // DetectionEventsOrErr returns the DetectionEvents value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) DetectionEventsOrErr() ([]*DetectionEvent, error) {
-	if e.loadedTypes[39] {
+	if e.loadedTypes[53] {
 		return e.DetectionEvents, nil
 	}
 	return nil, &NotLoadedError{edge: "detection_events"}
@@ -559,7 +742,7 @@ func (e TenantEdges) DetectionEventsOrErr() ([]*DetectionEvent, error) {
 // SaasIdentitiesOrErr returns the SaasIdentities value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) SaasIdentitiesOrErr() ([]*SaaSIdentity, error) {
-	if e.loadedTypes[40] {
+	if e.loadedTypes[54] {
 		return e.SaasIdentities, nil
 	}
 	return nil, &NotLoadedError{edge: "saas_identities"}
@@ -568,7 +751,7 @@ func (e TenantEdges) SaasIdentitiesOrErr() ([]*SaaSIdentity, error) {
 // SaasUsagesOrErr returns the SaasUsages value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) SaasUsagesOrErr() ([]*SaaSUsage, error) {
-	if e.loadedTypes[41] {
+	if e.loadedTypes[55] {
 		return e.SaasUsages, nil
 	}
 	return nil, &NotLoadedError{edge: "saas_usages"}
@@ -577,7 +760,7 @@ func (e TenantEdges) SaasUsagesOrErr() ([]*SaaSUsage, error) {
 // RecordingsOrErr returns the Recordings value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) RecordingsOrErr() ([]*Recording, error) {
-	if e.loadedTypes[42] {
+	if e.loadedTypes[56] {
 		return e.Recordings, nil
 	}
 	return nil, &NotLoadedError{edge: "recordings"}
@@ -586,7 +769,7 @@ func (e TenantEdges) RecordingsOrErr() ([]*Recording, error) {
 // NetworkLinksOrErr returns the NetworkLinks value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) NetworkLinksOrErr() ([]*NetworkLink, error) {
-	if e.loadedTypes[43] {
+	if e.loadedTypes[57] {
 		return e.NetworkLinks, nil
 	}
 	return nil, &NotLoadedError{edge: "network_links"}
@@ -595,7 +778,7 @@ func (e TenantEdges) NetworkLinksOrErr() ([]*NetworkLink, error) {
 // NetworkPortsOrErr returns the NetworkPorts value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) NetworkPortsOrErr() ([]*NetworkPort, error) {
-	if e.loadedTypes[44] {
+	if e.loadedTypes[58] {
 		return e.NetworkPorts, nil
 	}
 	return nil, &NotLoadedError{edge: "network_ports"}
@@ -604,7 +787,7 @@ func (e TenantEdges) NetworkPortsOrErr() ([]*NetworkPort, error) {
 // NexusAuditsOrErr returns the NexusAudits value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) NexusAuditsOrErr() ([]*NexusAudit, error) {
-	if e.loadedTypes[45] {
+	if e.loadedTypes[59] {
 		return e.NexusAudits, nil
 	}
 	return nil, &NotLoadedError{edge: "nexus_audits"}
@@ -613,7 +796,7 @@ func (e TenantEdges) NexusAuditsOrErr() ([]*NexusAudit, error) {
 // SuccessionMapsOrErr returns the SuccessionMaps value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) SuccessionMapsOrErr() ([]*SuccessionMap, error) {
-	if e.loadedTypes[46] {
+	if e.loadedTypes[60] {
 		return e.SuccessionMaps, nil
 	}
 	return nil, &NotLoadedError{edge: "succession_maps"}
@@ -624,7 +807,7 @@ func (e TenantEdges) SuccessionMapsOrErr() ([]*SuccessionMap, error) {
 func (e TenantEdges) CustomerAccountOrErr() (*Account, error) {
 	if e.CustomerAccount != nil {
 		return e.CustomerAccount, nil
-	} else if e.loadedTypes[47] {
+	} else if e.loadedTypes[61] {
 		return nil, &NotFoundError{label: account.Label}
 	}
 	return nil, &NotLoadedError{edge: "customer_account"}
@@ -633,7 +816,7 @@ func (e TenantEdges) CustomerAccountOrErr() (*Account, error) {
 // ScriptsOrErr returns the Scripts value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) ScriptsOrErr() ([]*Script, error) {
-	if e.loadedTypes[48] {
+	if e.loadedTypes[62] {
 		return e.Scripts, nil
 	}
 	return nil, &NotLoadedError{edge: "scripts"}
@@ -642,7 +825,7 @@ func (e TenantEdges) ScriptsOrErr() ([]*Script, error) {
 // JobsOrErr returns the Jobs value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) JobsOrErr() ([]*Job, error) {
-	if e.loadedTypes[49] {
+	if e.loadedTypes[63] {
 		return e.Jobs, nil
 	}
 	return nil, &NotLoadedError{edge: "jobs"}
@@ -651,7 +834,7 @@ func (e TenantEdges) JobsOrErr() ([]*Job, error) {
 // TimeOffRequestsOrErr returns the TimeOffRequests value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) TimeOffRequestsOrErr() ([]*TimeOffRequest, error) {
-	if e.loadedTypes[50] {
+	if e.loadedTypes[64] {
 		return e.TimeOffRequests, nil
 	}
 	return nil, &NotLoadedError{edge: "time_off_requests"}
@@ -660,7 +843,7 @@ func (e TenantEdges) TimeOffRequestsOrErr() ([]*TimeOffRequest, error) {
 // TimeOffPoliciesOrErr returns the TimeOffPolicies value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) TimeOffPoliciesOrErr() ([]*TimeOffPolicy, error) {
-	if e.loadedTypes[51] {
+	if e.loadedTypes[65] {
 		return e.TimeOffPolicies, nil
 	}
 	return nil, &NotLoadedError{edge: "time_off_policies"}
@@ -669,7 +852,7 @@ func (e TenantEdges) TimeOffPoliciesOrErr() ([]*TimeOffPolicy, error) {
 // TimeOffBalancesOrErr returns the TimeOffBalances value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) TimeOffBalancesOrErr() ([]*TimeOffBalance, error) {
-	if e.loadedTypes[52] {
+	if e.loadedTypes[66] {
 		return e.TimeOffBalances, nil
 	}
 	return nil, &NotLoadedError{edge: "time_off_balances"}
@@ -678,7 +861,7 @@ func (e TenantEdges) TimeOffBalancesOrErr() ([]*TimeOffBalance, error) {
 // ReviewCyclesOrErr returns the ReviewCycles value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) ReviewCyclesOrErr() ([]*ReviewCycle, error) {
-	if e.loadedTypes[53] {
+	if e.loadedTypes[67] {
 		return e.ReviewCycles, nil
 	}
 	return nil, &NotLoadedError{edge: "review_cycles"}
@@ -687,7 +870,7 @@ func (e TenantEdges) ReviewCyclesOrErr() ([]*ReviewCycle, error) {
 // PerformanceReviewsOrErr returns the PerformanceReviews value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) PerformanceReviewsOrErr() ([]*PerformanceReview, error) {
-	if e.loadedTypes[54] {
+	if e.loadedTypes[68] {
 		return e.PerformanceReviews, nil
 	}
 	return nil, &NotLoadedError{edge: "performance_reviews"}
@@ -696,7 +879,7 @@ func (e TenantEdges) PerformanceReviewsOrErr() ([]*PerformanceReview, error) {
 // GoalsOrErr returns the Goals value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) GoalsOrErr() ([]*Goal, error) {
-	if e.loadedTypes[55] {
+	if e.loadedTypes[69] {
 		return e.Goals, nil
 	}
 	return nil, &NotLoadedError{edge: "goals"}
@@ -705,7 +888,7 @@ func (e TenantEdges) GoalsOrErr() ([]*Goal, error) {
 // SuppliersOrErr returns the Suppliers value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) SuppliersOrErr() ([]*Supplier, error) {
-	if e.loadedTypes[56] {
+	if e.loadedTypes[70] {
 		return e.Suppliers, nil
 	}
 	return nil, &NotLoadedError{edge: "suppliers"}
@@ -714,7 +897,7 @@ func (e TenantEdges) SuppliersOrErr() ([]*Supplier, error) {
 // CategoriesOrErr returns the Categories value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) CategoriesOrErr() ([]*Category, error) {
-	if e.loadedTypes[57] {
+	if e.loadedTypes[71] {
 		return e.Categories, nil
 	}
 	return nil, &NotLoadedError{edge: "categories"}
@@ -723,7 +906,7 @@ func (e TenantEdges) CategoriesOrErr() ([]*Category, error) {
 // WarehousesOrErr returns the Warehouses value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) WarehousesOrErr() ([]*Warehouse, error) {
-	if e.loadedTypes[58] {
+	if e.loadedTypes[72] {
 		return e.Warehouses, nil
 	}
 	return nil, &NotLoadedError{edge: "warehouses"}
@@ -732,7 +915,7 @@ func (e TenantEdges) WarehousesOrErr() ([]*Warehouse, error) {
 // AssetAssignmentsOrErr returns the AssetAssignments value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) AssetAssignmentsOrErr() ([]*AssetAssignment, error) {
-	if e.loadedTypes[59] {
+	if e.loadedTypes[73] {
 		return e.AssetAssignments, nil
 	}
 	return nil, &NotLoadedError{edge: "asset_assignments"}
@@ -741,7 +924,7 @@ func (e TenantEdges) AssetAssignmentsOrErr() ([]*AssetAssignment, error) {
 // ContactsOrErr returns the Contacts value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) ContactsOrErr() ([]*Contact, error) {
-	if e.loadedTypes[60] {
+	if e.loadedTypes[74] {
 		return e.Contacts, nil
 	}
 	return nil, &NotLoadedError{edge: "contacts"}
@@ -750,7 +933,7 @@ func (e TenantEdges) ContactsOrErr() ([]*Contact, error) {
 // LegalHoldsOrErr returns the LegalHolds value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) LegalHoldsOrErr() ([]*LegalHold, error) {
-	if e.loadedTypes[61] {
+	if e.loadedTypes[75] {
 		return e.LegalHolds, nil
 	}
 	return nil, &NotLoadedError{edge: "legal_holds"}
@@ -759,7 +942,7 @@ func (e TenantEdges) LegalHoldsOrErr() ([]*LegalHold, error) {
 // RetentionPoliciesOrErr returns the RetentionPolicies value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) RetentionPoliciesOrErr() ([]*RetentionPolicy, error) {
-	if e.loadedTypes[62] {
+	if e.loadedTypes[76] {
 		return e.RetentionPolicies, nil
 	}
 	return nil, &NotLoadedError{edge: "retention_policies"}
@@ -768,7 +951,7 @@ func (e TenantEdges) RetentionPoliciesOrErr() ([]*RetentionPolicy, error) {
 // VaultTemplatesOrErr returns the VaultTemplates value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) VaultTemplatesOrErr() ([]*VaultTemplate, error) {
-	if e.loadedTypes[63] {
+	if e.loadedTypes[77] {
 		return e.VaultTemplates, nil
 	}
 	return nil, &NotLoadedError{edge: "vault_templates"}
@@ -777,7 +960,7 @@ func (e TenantEdges) VaultTemplatesOrErr() ([]*VaultTemplate, error) {
 // StockAuditLogsOrErr returns the StockAuditLogs value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) StockAuditLogsOrErr() ([]*StockAuditLog, error) {
-	if e.loadedTypes[64] {
+	if e.loadedTypes[78] {
 		return e.StockAuditLogs, nil
 	}
 	return nil, &NotLoadedError{edge: "stock_audit_logs"}
@@ -786,7 +969,7 @@ func (e TenantEdges) StockAuditLogsOrErr() ([]*StockAuditLog, error) {
 // MaintenanceSchedulesOrErr returns the MaintenanceSchedules value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) MaintenanceSchedulesOrErr() ([]*MaintenanceSchedule, error) {
-	if e.loadedTypes[65] {
+	if e.loadedTypes[79] {
 		return e.MaintenanceSchedules, nil
 	}
 	return nil, &NotLoadedError{edge: "maintenance_schedules"}
@@ -795,7 +978,7 @@ func (e TenantEdges) MaintenanceSchedulesOrErr() ([]*MaintenanceSchedule, error)
 // StockAlertsOrErr returns the StockAlerts value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) StockAlertsOrErr() ([]*StockAlert, error) {
-	if e.loadedTypes[66] {
+	if e.loadedTypes[80] {
 		return e.StockAlerts, nil
 	}
 	return nil, &NotLoadedError{edge: "stock_alerts"}
@@ -804,7 +987,7 @@ func (e TenantEdges) StockAlertsOrErr() ([]*StockAlert, error) {
 // PurchaseOrdersOrErr returns the PurchaseOrders value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) PurchaseOrdersOrErr() ([]*PurchaseOrder, error) {
-	if e.loadedTypes[67] {
+	if e.loadedTypes[81] {
 		return e.PurchaseOrders, nil
 	}
 	return nil, &NotLoadedError{edge: "purchase_orders"}
@@ -813,7 +996,7 @@ func (e TenantEdges) PurchaseOrdersOrErr() ([]*PurchaseOrder, error) {
 // InventoryCountsOrErr returns the InventoryCounts value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) InventoryCountsOrErr() ([]*InventoryCount, error) {
-	if e.loadedTypes[68] {
+	if e.loadedTypes[82] {
 		return e.InventoryCounts, nil
 	}
 	return nil, &NotLoadedError{edge: "inventory_counts"}
@@ -822,7 +1005,7 @@ func (e TenantEdges) InventoryCountsOrErr() ([]*InventoryCount, error) {
 // JobPostingsOrErr returns the JobPostings value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) JobPostingsOrErr() ([]*JobPosting, error) {
-	if e.loadedTypes[69] {
+	if e.loadedTypes[83] {
 		return e.JobPostings, nil
 	}
 	return nil, &NotLoadedError{edge: "job_postings"}
@@ -831,7 +1014,7 @@ func (e TenantEdges) JobPostingsOrErr() ([]*JobPosting, error) {
 // CandidatesOrErr returns the Candidates value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) CandidatesOrErr() ([]*Candidate, error) {
-	if e.loadedTypes[70] {
+	if e.loadedTypes[84] {
 		return e.Candidates, nil
 	}
 	return nil, &NotLoadedError{edge: "candidates"}
@@ -840,7 +1023,7 @@ func (e TenantEdges) CandidatesOrErr() ([]*Candidate, error) {
 // ApplicationsOrErr returns the Applications value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) ApplicationsOrErr() ([]*Application, error) {
-	if e.loadedTypes[71] {
+	if e.loadedTypes[85] {
 		return e.Applications, nil
 	}
 	return nil, &NotLoadedError{edge: "applications"}
@@ -849,7 +1032,7 @@ func (e TenantEdges) ApplicationsOrErr() ([]*Application, error) {
 // InterviewsOrErr returns the Interviews value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) InterviewsOrErr() ([]*Interview, error) {
-	if e.loadedTypes[72] {
+	if e.loadedTypes[86] {
 		return e.Interviews, nil
 	}
 	return nil, &NotLoadedError{edge: "interviews"}
@@ -858,7 +1041,7 @@ func (e TenantEdges) InterviewsOrErr() ([]*Interview, error) {
 // BenefitPlansOrErr returns the BenefitPlans value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) BenefitPlansOrErr() ([]*BenefitPlan, error) {
-	if e.loadedTypes[73] {
+	if e.loadedTypes[87] {
 		return e.BenefitPlans, nil
 	}
 	return nil, &NotLoadedError{edge: "benefit_plans"}
@@ -867,24 +1050,118 @@ func (e TenantEdges) BenefitPlansOrErr() ([]*BenefitPlan, error) {
 // BenefitEnrollmentsOrErr returns the BenefitEnrollments value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) BenefitEnrollmentsOrErr() ([]*BenefitEnrollment, error) {
-	if e.loadedTypes[74] {
+	if e.loadedTypes[88] {
 		return e.BenefitEnrollments, nil
 	}
 	return nil, &NotLoadedError{edge: "benefit_enrollments"}
 }
 
+// PosShiftsOrErr returns the PosShifts value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) PosShiftsOrErr() ([]*PosShift, error) {
+	if e.loadedTypes[89] {
+		return e.PosShifts, nil
+	}
+	return nil, &NotLoadedError{edge: "pos_shifts"}
+}
+
+// LabelTemplatesOrErr returns the LabelTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) LabelTemplatesOrErr() ([]*LabelTemplate, error) {
+	if e.loadedTypes[90] {
+		return e.LabelTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "label_templates"}
+}
+
+// TaxJurisdictionsOrErr returns the TaxJurisdictions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) TaxJurisdictionsOrErr() ([]*TaxJurisdiction, error) {
+	if e.loadedTypes[91] {
+		return e.TaxJurisdictions, nil
+	}
+	return nil, &NotLoadedError{edge: "tax_jurisdictions"}
+}
+
+// EinvoiceCredentialsOrErr returns the EinvoiceCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) EinvoiceCredentialsOrErr() ([]*EInvoiceCredential, error) {
+	if e.loadedTypes[92] {
+		return e.EinvoiceCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "einvoice_credentials"}
+}
+
+// EinvoicesOrErr returns the Einvoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) EinvoicesOrErr() ([]*EInvoice, error) {
+	if e.loadedTypes[93] {
+		return e.Einvoices, nil
+	}
+	return nil, &NotLoadedError{edge: "einvoices"}
+}
+
+// AgentEnrollmentTokensOrErr returns the AgentEnrollmentTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) AgentEnrollmentTokensOrErr() ([]*AgentEnrollmentToken, error) {
+	if e.loadedTypes[94] {
+		return e.AgentEnrollmentTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "agent_enrollment_tokens"}
+}
+
+// AgentAuthorityOrErr returns the AgentAuthority value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantEdges) AgentAuthorityOrErr() (*AgentAuthority, error) {
+	if e.AgentAuthority != nil {
+		return e.AgentAuthority, nil
+	} else if e.loadedTypes[95] {
+		return nil, &NotFoundError{label: agentauthority.Label}
+	}
+	return nil, &NotLoadedError{edge: "agent_authority"}
+}
+
+// DeviceGroupsOrErr returns the DeviceGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) DeviceGroupsOrErr() ([]*DeviceGroup, error) {
+	if e.loadedTypes[96] {
+		return e.DeviceGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "device_groups"}
+}
+
+// PatchPoliciesOrErr returns the PatchPolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) PatchPoliciesOrErr() ([]*PatchPolicy, error) {
+	if e.loadedTypes[97] {
+		return e.PatchPolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "patch_policies"}
+}
+
+// AlertRulesOrErr returns the AlertRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) AlertRulesOrErr() ([]*AlertRule, error) {
+	if e.loadedTypes[98] {
+		return e.AlertRules, nil
+	}
+	return nil, &NotLoadedError{edge: "alert_rules"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case tenant.FieldLogo:
+			values[i] = new([]byte)
 		case tenant.FieldTransactionLimit:
 			values[i] = new(decimal.Decimal)
 		case tenant.FieldActive:
 			values[i] = new(sql.NullBool)
-		case tenant.FieldID:
+		case tenant.FieldID, tenant.FieldFiscalYearStartMonth:
 			values[i] = new(sql.NullInt64)
-		case tenant.FieldName, tenant.FieldDomain:
+		case tenant.FieldName, tenant.FieldDomain, tenant.FieldFunctionalCurrency, tenant.FieldTaxNumber:
 			values[i] = new(sql.NullString)
 		case tenant.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -943,6 +1220,30 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.TransactionLimit = *value
 			}
+		case tenant.FieldFunctionalCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field functional_currency", values[i])
+			} else if value.Valid {
+				_m.FunctionalCurrency = value.String
+			}
+		case tenant.FieldFiscalYearStartMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fiscal_year_start_month", values[i])
+			} else if value.Valid {
+				_m.FiscalYearStartMonth = int(value.Int64)
+			}
+		case tenant.FieldTaxNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_number", values[i])
+			} else if value.Valid {
+				_m.TaxNumber = value.String
+			}
+		case tenant.FieldLogo:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field logo", values[i])
+			} else if value != nil {
+				_m.Logo = *value
+			}
 		case tenant.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field tenant_children", value)
@@ -1145,6 +1446,76 @@ func (_m *Tenant) QueryRecurringInvoices() *RecurringInvoiceQuery {
 	return NewTenantClient(_m.config).QueryRecurringInvoices(_m)
 }
 
+// QueryExchangeRates queries the "exchange_rates" edge of the Tenant entity.
+func (_m *Tenant) QueryExchangeRates() *ExchangeRateQuery {
+	return NewTenantClient(_m.config).QueryExchangeRates(_m)
+}
+
+// QueryFiscalPeriods queries the "fiscal_periods" edge of the Tenant entity.
+func (_m *Tenant) QueryFiscalPeriods() *FiscalPeriodQuery {
+	return NewTenantClient(_m.config).QueryFiscalPeriods(_m)
+}
+
+// QueryBalanceSnapshots queries the "balance_snapshots" edge of the Tenant entity.
+func (_m *Tenant) QueryBalanceSnapshots() *AccountBalanceSnapshotQuery {
+	return NewTenantClient(_m.config).QueryBalanceSnapshots(_m)
+}
+
+// QueryBankStatements queries the "bank_statements" edge of the Tenant entity.
+func (_m *Tenant) QueryBankStatements() *BankStatementQuery {
+	return NewTenantClient(_m.config).QueryBankStatements(_m)
+}
+
+// QueryBankStatementLines queries the "bank_statement_lines" edge of the Tenant entity.
+func (_m *Tenant) QueryBankStatementLines() *BankStatementLineQuery {
+	return NewTenantClient(_m.config).QueryBankStatementLines(_m)
+}
+
+// QueryBankRules queries the "bank_rules" edge of the Tenant entity.
+func (_m *Tenant) QueryBankRules() *BankRuleQuery {
+	return NewTenantClient(_m.config).QueryBankRules(_m)
+}
+
+// QueryCustomers queries the "customers" edge of the Tenant entity.
+func (_m *Tenant) QueryCustomers() *CustomerQuery {
+	return NewTenantClient(_m.config).QueryCustomers(_m)
+}
+
+// QueryInvoices queries the "invoices" edge of the Tenant entity.
+func (_m *Tenant) QueryInvoices() *InvoiceQuery {
+	return NewTenantClient(_m.config).QueryInvoices(_m)
+}
+
+// QueryCustomerPayments queries the "customer_payments" edge of the Tenant entity.
+func (_m *Tenant) QueryCustomerPayments() *CustomerPaymentQuery {
+	return NewTenantClient(_m.config).QueryCustomerPayments(_m)
+}
+
+// QuerySupplierBills queries the "supplier_bills" edge of the Tenant entity.
+func (_m *Tenant) QuerySupplierBills() *SupplierBillQuery {
+	return NewTenantClient(_m.config).QuerySupplierBills(_m)
+}
+
+// QueryPaymentRuns queries the "payment_runs" edge of the Tenant entity.
+func (_m *Tenant) QueryPaymentRuns() *PaymentRunQuery {
+	return NewTenantClient(_m.config).QueryPaymentRuns(_m)
+}
+
+// QueryGoodsReceipts queries the "goods_receipts" edge of the Tenant entity.
+func (_m *Tenant) QueryGoodsReceipts() *GoodsReceiptQuery {
+	return NewTenantClient(_m.config).QueryGoodsReceipts(_m)
+}
+
+// QueryStockLevels queries the "stock_levels" edge of the Tenant entity.
+func (_m *Tenant) QueryStockLevels() *StockLevelQuery {
+	return NewTenantClient(_m.config).QueryStockLevels(_m)
+}
+
+// QueryTransferOrders queries the "transfer_orders" edge of the Tenant entity.
+func (_m *Tenant) QueryTransferOrders() *TransferOrderQuery {
+	return NewTenantClient(_m.config).QueryTransferOrders(_m)
+}
+
 // QueryInventoryReservations queries the "inventory_reservations" edge of the Tenant entity.
 func (_m *Tenant) QueryInventoryReservations() *InventoryReservationQuery {
 	return NewTenantClient(_m.config).QueryInventoryReservations(_m)
@@ -1345,6 +1716,56 @@ func (_m *Tenant) QueryBenefitEnrollments() *BenefitEnrollmentQuery {
 	return NewTenantClient(_m.config).QueryBenefitEnrollments(_m)
 }
 
+// QueryPosShifts queries the "pos_shifts" edge of the Tenant entity.
+func (_m *Tenant) QueryPosShifts() *PosShiftQuery {
+	return NewTenantClient(_m.config).QueryPosShifts(_m)
+}
+
+// QueryLabelTemplates queries the "label_templates" edge of the Tenant entity.
+func (_m *Tenant) QueryLabelTemplates() *LabelTemplateQuery {
+	return NewTenantClient(_m.config).QueryLabelTemplates(_m)
+}
+
+// QueryTaxJurisdictions queries the "tax_jurisdictions" edge of the Tenant entity.
+func (_m *Tenant) QueryTaxJurisdictions() *TaxJurisdictionQuery {
+	return NewTenantClient(_m.config).QueryTaxJurisdictions(_m)
+}
+
+// QueryEinvoiceCredentials queries the "einvoice_credentials" edge of the Tenant entity.
+func (_m *Tenant) QueryEinvoiceCredentials() *EInvoiceCredentialQuery {
+	return NewTenantClient(_m.config).QueryEinvoiceCredentials(_m)
+}
+
+// QueryEinvoices queries the "einvoices" edge of the Tenant entity.
+func (_m *Tenant) QueryEinvoices() *EInvoiceQuery {
+	return NewTenantClient(_m.config).QueryEinvoices(_m)
+}
+
+// QueryAgentEnrollmentTokens queries the "agent_enrollment_tokens" edge of the Tenant entity.
+func (_m *Tenant) QueryAgentEnrollmentTokens() *AgentEnrollmentTokenQuery {
+	return NewTenantClient(_m.config).QueryAgentEnrollmentTokens(_m)
+}
+
+// QueryAgentAuthority queries the "agent_authority" edge of the Tenant entity.
+func (_m *Tenant) QueryAgentAuthority() *AgentAuthorityQuery {
+	return NewTenantClient(_m.config).QueryAgentAuthority(_m)
+}
+
+// QueryDeviceGroups queries the "device_groups" edge of the Tenant entity.
+func (_m *Tenant) QueryDeviceGroups() *DeviceGroupQuery {
+	return NewTenantClient(_m.config).QueryDeviceGroups(_m)
+}
+
+// QueryPatchPolicies queries the "patch_policies" edge of the Tenant entity.
+func (_m *Tenant) QueryPatchPolicies() *PatchPolicyQuery {
+	return NewTenantClient(_m.config).QueryPatchPolicies(_m)
+}
+
+// QueryAlertRules queries the "alert_rules" edge of the Tenant entity.
+func (_m *Tenant) QueryAlertRules() *AlertRuleQuery {
+	return NewTenantClient(_m.config).QueryAlertRules(_m)
+}
+
 // Update returns a builder for updating this Tenant.
 // Note that you need to call Tenant.Unwrap() before calling this method if this Tenant
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -1382,6 +1803,18 @@ func (_m *Tenant) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("transaction_limit=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TransactionLimit))
+	builder.WriteString(", ")
+	builder.WriteString("functional_currency=")
+	builder.WriteString(_m.FunctionalCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("fiscal_year_start_month=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FiscalYearStartMonth))
+	builder.WriteString(", ")
+	builder.WriteString("tax_number=")
+	builder.WriteString(_m.TaxNumber)
+	builder.WriteString(", ")
+	builder.WriteString("logo=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Logo))
 	builder.WriteByte(')')
 	return builder.String()
 }
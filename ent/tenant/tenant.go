@@ -25,6 +25,14 @@ const (
 	FieldActive = "active"
 	// FieldTransactionLimit holds the string denoting the transaction_limit field in the database.
 	FieldTransactionLimit = "transaction_limit"
+	// FieldFunctionalCurrency holds the string denoting the functional_currency field in the database.
+	FieldFunctionalCurrency = "functional_currency"
+	// FieldFiscalYearStartMonth holds the string denoting the fiscal_year_start_month field in the database.
+	FieldFiscalYearStartMonth = "fiscal_year_start_month"
+	// FieldTaxNumber holds the string denoting the tax_number field in the database.
+	FieldTaxNumber = "tax_number"
+	// FieldLogo holds the string denoting the logo field in the database.
+	FieldLogo = "logo"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -95,6 +103,34 @@ const (
 	EdgeJournalEntries = "journal_entries"
 	// EdgeRecurringInvoices holds the string denoting the recurring_invoices edge name in mutations.
 	EdgeRecurringInvoices = "recurring_invoices"
+	// EdgeExchangeRates holds the string denoting the exchange_rates edge name in mutations.
+	EdgeExchangeRates = "exchange_rates"
+	// EdgeFiscalPeriods holds the string denoting the fiscal_periods edge name in mutations.
+	EdgeFiscalPeriods = "fiscal_periods"
+	// EdgeBalanceSnapshots holds the string denoting the balance_snapshots edge name in mutations.
+	EdgeBalanceSnapshots = "balance_snapshots"
+	// EdgeBankStatements holds the string denoting the bank_statements edge name in mutations.
+	EdgeBankStatements = "bank_statements"
+	// EdgeBankStatementLines holds the string denoting the bank_statement_lines edge name in mutations.
+	EdgeBankStatementLines = "bank_statement_lines"
+	// EdgeBankRules holds the string denoting the bank_rules edge name in mutations.
+	EdgeBankRules = "bank_rules"
+	// EdgeCustomers holds the string denoting the customers edge name in mutations.
+	EdgeCustomers = "customers"
+	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
+	EdgeInvoices = "invoices"
+	// EdgeCustomerPayments holds the string denoting the customer_payments edge name in mutations.
+	EdgeCustomerPayments = "customer_payments"
+	// EdgeSupplierBills holds the string denoting the supplier_bills edge name in mutations.
+	EdgeSupplierBills = "supplier_bills"
+	// EdgePaymentRuns holds the string denoting the payment_runs edge name in mutations.
+	EdgePaymentRuns = "payment_runs"
+	// EdgeGoodsReceipts holds the string denoting the goods_receipts edge name in mutations.
+	EdgeGoodsReceipts = "goods_receipts"
+	// EdgeStockLevels holds the string denoting the stock_levels edge name in mutations.
+	EdgeStockLevels = "stock_levels"
+	// EdgeTransferOrders holds the string denoting the transfer_orders edge name in mutations.
+	EdgeTransferOrders = "transfer_orders"
 	// EdgeInventoryReservations holds the string denoting the inventory_reservations edge name in mutations.
 	EdgeInventoryReservations = "inventory_reservations"
 	// EdgeDepartments holds the string denoting the departments edge name in mutations.
@@ -175,6 +211,26 @@ const (
 	EdgeBenefitPlans = "benefit_plans"
 	// EdgeBenefitEnrollments holds the string denoting the benefit_enrollments edge name in mutations.
 	EdgeBenefitEnrollments = "benefit_enrollments"
+	// EdgePosShifts holds the string denoting the pos_shifts edge name in mutations.
+	EdgePosShifts = "pos_shifts"
+	// EdgeLabelTemplates holds the string denoting the label_templates edge name in mutations.
+	EdgeLabelTemplates = "label_templates"
+	// EdgeTaxJurisdictions holds the string denoting the tax_jurisdictions edge name in mutations.
+	EdgeTaxJurisdictions = "tax_jurisdictions"
+	// EdgeEinvoiceCredentials holds the string denoting the einvoice_credentials edge name in mutations.
+	EdgeEinvoiceCredentials = "einvoice_credentials"
+	// EdgeEinvoices holds the string denoting the einvoices edge name in mutations.
+	EdgeEinvoices = "einvoices"
+	// EdgeAgentEnrollmentTokens holds the string denoting the agent_enrollment_tokens edge name in mutations.
+	EdgeAgentEnrollmentTokens = "agent_enrollment_tokens"
+	// EdgeAgentAuthority holds the string denoting the agent_authority edge name in mutations.
+	EdgeAgentAuthority = "agent_authority"
+	// EdgeDeviceGroups holds the string denoting the device_groups edge name in mutations.
+	EdgeDeviceGroups = "device_groups"
+	// EdgePatchPolicies holds the string denoting the patch_policies edge name in mutations.
+	EdgePatchPolicies = "patch_policies"
+	// EdgeAlertRules holds the string denoting the alert_rules edge name in mutations.
+	EdgeAlertRules = "alert_rules"
 	// Table holds the table name of the tenant in the database.
 	Table = "tenants"
 	// ParentTable is the table that holds the parent relation/edge.
@@ -416,6 +472,104 @@ const (
 	RecurringInvoicesInverseTable = "recurring_invoices"
 	// RecurringInvoicesColumn is the table column denoting the recurring_invoices relation/edge.
 	RecurringInvoicesColumn = "tenant_recurring_invoices"
+	// ExchangeRatesTable is the table that holds the exchange_rates relation/edge.
+	ExchangeRatesTable = "exchange_rates"
+	// ExchangeRatesInverseTable is the table name for the ExchangeRate entity.
+	// It exists in this package in order to avoid circular dependency with the "exchangerate" package.
+	ExchangeRatesInverseTable = "exchange_rates"
+	// ExchangeRatesColumn is the table column denoting the exchange_rates relation/edge.
+	ExchangeRatesColumn = "tenant_exchange_rates"
+	// FiscalPeriodsTable is the table that holds the fiscal_periods relation/edge.
+	FiscalPeriodsTable = "fiscal_periods"
+	// FiscalPeriodsInverseTable is the table name for the FiscalPeriod entity.
+	// It exists in this package in order to avoid circular dependency with the "fiscalperiod" package.
+	FiscalPeriodsInverseTable = "fiscal_periods"
+	// FiscalPeriodsColumn is the table column denoting the fiscal_periods relation/edge.
+	FiscalPeriodsColumn = "tenant_fiscal_periods"
+	// BalanceSnapshotsTable is the table that holds the balance_snapshots relation/edge.
+	BalanceSnapshotsTable = "account_balance_snapshots"
+	// BalanceSnapshotsInverseTable is the table name for the AccountBalanceSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "accountbalancesnapshot" package.
+	BalanceSnapshotsInverseTable = "account_balance_snapshots"
+	// BalanceSnapshotsColumn is the table column denoting the balance_snapshots relation/edge.
+	BalanceSnapshotsColumn = "tenant_balance_snapshots"
+	// BankStatementsTable is the table that holds the bank_statements relation/edge.
+	BankStatementsTable = "bank_statements"
+	// BankStatementsInverseTable is the table name for the BankStatement entity.
+	// It exists in this package in order to avoid circular dependency with the "bankstatement" package.
+	BankStatementsInverseTable = "bank_statements"
+	// BankStatementsColumn is the table column denoting the bank_statements relation/edge.
+	BankStatementsColumn = "tenant_bank_statements"
+	// BankStatementLinesTable is the table that holds the bank_statement_lines relation/edge.
+	BankStatementLinesTable = "bank_statement_lines"
+	// BankStatementLinesInverseTable is the table name for the BankStatementLine entity.
+	// It exists in this package in order to avoid circular dependency with the "bankstatementline" package.
+	BankStatementLinesInverseTable = "bank_statement_lines"
+	// BankStatementLinesColumn is the table column denoting the bank_statement_lines relation/edge.
+	BankStatementLinesColumn = "tenant_bank_statement_lines"
+	// BankRulesTable is the table that holds the bank_rules relation/edge.
+	BankRulesTable = "bank_rules"
+	// BankRulesInverseTable is the table name for the BankRule entity.
+	// It exists in this package in order to avoid circular dependency with the "bankrule" package.
+	BankRulesInverseTable = "bank_rules"
+	// BankRulesColumn is the table column denoting the bank_rules relation/edge.
+	BankRulesColumn = "tenant_bank_rules"
+	// CustomersTable is the table that holds the customers relation/edge.
+	CustomersTable = "customers"
+	// CustomersInverseTable is the table name for the Customer entity.
+	// It exists in this package in order to avoid circular dependency with the "customer" package.
+	CustomersInverseTable = "customers"
+	// CustomersColumn is the table column denoting the customers relation/edge.
+	CustomersColumn = "tenant_customers"
+	// InvoicesTable is the table that holds the invoices relation/edge.
+	InvoicesTable = "invoices"
+	// InvoicesInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoicesInverseTable = "invoices"
+	// InvoicesColumn is the table column denoting the invoices relation/edge.
+	InvoicesColumn = "tenant_invoices"
+	// CustomerPaymentsTable is the table that holds the customer_payments relation/edge.
+	CustomerPaymentsTable = "customer_payments"
+	// CustomerPaymentsInverseTable is the table name for the CustomerPayment entity.
+	// It exists in this package in order to avoid circular dependency with the "customerpayment" package.
+	CustomerPaymentsInverseTable = "customer_payments"
+	// CustomerPaymentsColumn is the table column denoting the customer_payments relation/edge.
+	CustomerPaymentsColumn = "tenant_customer_payments"
+	// SupplierBillsTable is the table that holds the supplier_bills relation/edge.
+	SupplierBillsTable = "supplier_bills"
+	// SupplierBillsInverseTable is the table name for the SupplierBill entity.
+	// It exists in this package in order to avoid circular dependency with the "supplierbill" package.
+	SupplierBillsInverseTable = "supplier_bills"
+	// SupplierBillsColumn is the table column denoting the supplier_bills relation/edge.
+	SupplierBillsColumn = "tenant_supplier_bills"
+	// PaymentRunsTable is the table that holds the payment_runs relation/edge.
+	PaymentRunsTable = "payment_runs"
+	// PaymentRunsInverseTable is the table name for the PaymentRun entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentrun" package.
+	PaymentRunsInverseTable = "payment_runs"
+	// PaymentRunsColumn is the table column denoting the payment_runs relation/edge.
+	PaymentRunsColumn = "tenant_payment_runs"
+	// GoodsReceiptsTable is the table that holds the goods_receipts relation/edge.
+	GoodsReceiptsTable = "goods_receipts"
+	// GoodsReceiptsInverseTable is the table name for the GoodsReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "goodsreceipt" package.
+	GoodsReceiptsInverseTable = "goods_receipts"
+	// GoodsReceiptsColumn is the table column denoting the goods_receipts relation/edge.
+	GoodsReceiptsColumn = "tenant_goods_receipts"
+	// StockLevelsTable is the table that holds the stock_levels relation/edge.
+	StockLevelsTable = "stock_levels"
+	// StockLevelsInverseTable is the table name for the StockLevel entity.
+	// It exists in this package in order to avoid circular dependency with the "stocklevel" package.
+	StockLevelsInverseTable = "stock_levels"
+	// StockLevelsColumn is the table column denoting the stock_levels relation/edge.
+	StockLevelsColumn = "tenant_stock_levels"
+	// TransferOrdersTable is the table that holds the transfer_orders relation/edge.
+	TransferOrdersTable = "transfer_orders"
+	// TransferOrdersInverseTable is the table name for the TransferOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "transferorder" package.
+	TransferOrdersInverseTable = "transfer_orders"
+	// TransferOrdersColumn is the table column denoting the transfer_orders relation/edge.
+	TransferOrdersColumn = "tenant_transfer_orders"
 	// InventoryReservationsTable is the table that holds the inventory_reservations relation/edge.
 	InventoryReservationsTable = "inventory_reservations"
 	// InventoryReservationsInverseTable is the table name for the InventoryReservation entity.
@@ -696,6 +850,76 @@ const (
 	BenefitEnrollmentsInverseTable = "benefit_enrollments"
 	// BenefitEnrollmentsColumn is the table column denoting the benefit_enrollments relation/edge.
 	BenefitEnrollmentsColumn = "tenant_benefit_enrollments"
+	// PosShiftsTable is the table that holds the pos_shifts relation/edge.
+	PosShiftsTable = "pos_shifts"
+	// PosShiftsInverseTable is the table name for the PosShift entity.
+	// It exists in this package in order to avoid circular dependency with the "posshift" package.
+	PosShiftsInverseTable = "pos_shifts"
+	// PosShiftsColumn is the table column denoting the pos_shifts relation/edge.
+	PosShiftsColumn = "tenant_pos_shifts"
+	// LabelTemplatesTable is the table that holds the label_templates relation/edge.
+	LabelTemplatesTable = "label_templates"
+	// LabelTemplatesInverseTable is the table name for the LabelTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "labeltemplate" package.
+	LabelTemplatesInverseTable = "label_templates"
+	// LabelTemplatesColumn is the table column denoting the label_templates relation/edge.
+	LabelTemplatesColumn = "tenant_label_templates"
+	// TaxJurisdictionsTable is the table that holds the tax_jurisdictions relation/edge.
+	TaxJurisdictionsTable = "tax_jurisdictions"
+	// TaxJurisdictionsInverseTable is the table name for the TaxJurisdiction entity.
+	// It exists in this package in order to avoid circular dependency with the "taxjurisdiction" package.
+	TaxJurisdictionsInverseTable = "tax_jurisdictions"
+	// TaxJurisdictionsColumn is the table column denoting the tax_jurisdictions relation/edge.
+	TaxJurisdictionsColumn = "tenant_tax_jurisdictions"
+	// EinvoiceCredentialsTable is the table that holds the einvoice_credentials relation/edge.
+	EinvoiceCredentialsTable = "einvoice_credentials"
+	// EinvoiceCredentialsInverseTable is the table name for the EInvoiceCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "einvoicecredential" package.
+	EinvoiceCredentialsInverseTable = "einvoice_credentials"
+	// EinvoiceCredentialsColumn is the table column denoting the einvoice_credentials relation/edge.
+	EinvoiceCredentialsColumn = "tenant_einvoice_credentials"
+	// EinvoicesTable is the table that holds the einvoices relation/edge.
+	EinvoicesTable = "einvoices"
+	// EinvoicesInverseTable is the table name for the EInvoice entity.
+	// It exists in this package in order to avoid circular dependency with the "einvoice" package.
+	EinvoicesInverseTable = "einvoices"
+	// EinvoicesColumn is the table column denoting the einvoices relation/edge.
+	EinvoicesColumn = "tenant_einvoices"
+	// AgentEnrollmentTokensTable is the table that holds the agent_enrollment_tokens relation/edge.
+	AgentEnrollmentTokensTable = "agent_enrollment_tokens"
+	// AgentEnrollmentTokensInverseTable is the table name for the AgentEnrollmentToken entity.
+	// It exists in this package in order to avoid circular dependency with the "agentenrollmenttoken" package.
+	AgentEnrollmentTokensInverseTable = "agent_enrollment_tokens"
+	// AgentEnrollmentTokensColumn is the table column denoting the agent_enrollment_tokens relation/edge.
+	AgentEnrollmentTokensColumn = "tenant_agent_enrollment_tokens"
+	// AgentAuthorityTable is the table that holds the agent_authority relation/edge.
+	AgentAuthorityTable = "agent_authorities"
+	// AgentAuthorityInverseTable is the table name for the AgentAuthority entity.
+	// It exists in this package in order to avoid circular dependency with the "agentauthority" package.
+	AgentAuthorityInverseTable = "agent_authorities"
+	// AgentAuthorityColumn is the table column denoting the agent_authority relation/edge.
+	AgentAuthorityColumn = "tenant_agent_authority"
+	// DeviceGroupsTable is the table that holds the device_groups relation/edge.
+	DeviceGroupsTable = "device_groups"
+	// DeviceGroupsInverseTable is the table name for the DeviceGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "devicegroup" package.
+	DeviceGroupsInverseTable = "device_groups"
+	// DeviceGroupsColumn is the table column denoting the device_groups relation/edge.
+	DeviceGroupsColumn = "tenant_device_groups"
+	// PatchPoliciesTable is the table that holds the patch_policies relation/edge.
+	PatchPoliciesTable = "patch_policies"
+	// PatchPoliciesInverseTable is the table name for the PatchPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "patchpolicy" package.
+	PatchPoliciesInverseTable = "patch_policies"
+	// PatchPoliciesColumn is the table column denoting the patch_policies relation/edge.
+	PatchPoliciesColumn = "tenant_patch_policies"
+	// AlertRulesTable is the table that holds the alert_rules relation/edge.
+	AlertRulesTable = "alert_rules"
+	// AlertRulesInverseTable is the table name for the AlertRule entity.
+	// It exists in this package in order to avoid circular dependency with the "alertrule" package.
+	AlertRulesInverseTable = "alert_rules"
+	// AlertRulesColumn is the table column denoting the alert_rules relation/edge.
+	AlertRulesColumn = "tenant_alert_rules"
 )
 
 // Columns holds all SQL columns for tenant fields.
@@ -706,6 +930,10 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldActive,
 	FieldTransactionLimit,
+	FieldFunctionalCurrency,
+	FieldFiscalYearStartMonth,
+	FieldTaxNumber,
+	FieldLogo,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "tenants"
@@ -737,6 +965,12 @@ var (
 	DefaultActive bool
 	// DefaultTransactionLimit holds the default value on creation for the "transaction_limit" field.
 	DefaultTransactionLimit decimal.Decimal
+	// DefaultFunctionalCurrency holds the default value on creation for the "functional_currency" field.
+	DefaultFunctionalCurrency string
+	// DefaultFiscalYearStartMonth holds the default value on creation for the "fiscal_year_start_month" field.
+	DefaultFiscalYearStartMonth int
+	// FiscalYearStartMonthValidator is a validator for the "fiscal_year_start_month" field. It is called by the builders before save.
+	FiscalYearStartMonthValidator func(int) error
 )
 
 // OrderOption defines the ordering options for the Tenant queries.
@@ -772,6 +1006,21 @@ func ByTransactionLimit(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTransactionLimit, opts...).ToFunc()
 }
 
+// ByFunctionalCurrency orders the results by the functional_currency field.
+func ByFunctionalCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFunctionalCurrency, opts...).ToFunc()
+}
+
+// ByFiscalYearStartMonth orders the results by the fiscal_year_start_month field.
+func ByFiscalYearStartMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFiscalYearStartMonth, opts...).ToFunc()
+}
+
+// ByTaxNumber orders the results by the tax_number field.
+func ByTaxNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxNumber, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -1255,6 +1504,202 @@ func ByRecurringInvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 	}
 }
 
+// ByExchangeRatesCount orders the results by exchange_rates count.
+func ByExchangeRatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExchangeRatesStep(), opts...)
+	}
+}
+
+// ByExchangeRates orders the results by exchange_rates terms.
+func ByExchangeRates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExchangeRatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFiscalPeriodsCount orders the results by fiscal_periods count.
+func ByFiscalPeriodsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFiscalPeriodsStep(), opts...)
+	}
+}
+
+// ByFiscalPeriods orders the results by fiscal_periods terms.
+func ByFiscalPeriods(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFiscalPeriodsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBalanceSnapshotsCount orders the results by balance_snapshots count.
+func ByBalanceSnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBalanceSnapshotsStep(), opts...)
+	}
+}
+
+// ByBalanceSnapshots orders the results by balance_snapshots terms.
+func ByBalanceSnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBalanceSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBankStatementsCount orders the results by bank_statements count.
+func ByBankStatementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBankStatementsStep(), opts...)
+	}
+}
+
+// ByBankStatements orders the results by bank_statements terms.
+func ByBankStatements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBankStatementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBankStatementLinesCount orders the results by bank_statement_lines count.
+func ByBankStatementLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBankStatementLinesStep(), opts...)
+	}
+}
+
+// ByBankStatementLines orders the results by bank_statement_lines terms.
+func ByBankStatementLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBankStatementLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBankRulesCount orders the results by bank_rules count.
+func ByBankRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBankRulesStep(), opts...)
+	}
+}
+
+// ByBankRules orders the results by bank_rules terms.
+func ByBankRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBankRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCustomersCount orders the results by customers count.
+func ByCustomersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCustomersStep(), opts...)
+	}
+}
+
+// ByCustomers orders the results by customers terms.
+func ByCustomers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvoicesCount orders the results by invoices count.
+func ByInvoicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvoicesStep(), opts...)
+	}
+}
+
+// ByInvoices orders the results by invoices terms.
+func ByInvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCustomerPaymentsCount orders the results by customer_payments count.
+func ByCustomerPaymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCustomerPaymentsStep(), opts...)
+	}
+}
+
+// ByCustomerPayments orders the results by customer_payments terms.
+func ByCustomerPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomerPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySupplierBillsCount orders the results by supplier_bills count.
+func BySupplierBillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSupplierBillsStep(), opts...)
+	}
+}
+
+// BySupplierBills orders the results by supplier_bills terms.
+func BySupplierBills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSupplierBillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPaymentRunsCount orders the results by payment_runs count.
+func ByPaymentRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentRunsStep(), opts...)
+	}
+}
+
+// ByPaymentRuns orders the results by payment_runs terms.
+func ByPaymentRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGoodsReceiptsCount orders the results by goods_receipts count.
+func ByGoodsReceiptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGoodsReceiptsStep(), opts...)
+	}
+}
+
+// ByGoodsReceipts orders the results by goods_receipts terms.
+func ByGoodsReceipts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGoodsReceiptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStockLevelsCount orders the results by stock_levels count.
+func ByStockLevelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStockLevelsStep(), opts...)
+	}
+}
+
+// ByStockLevels orders the results by stock_levels terms.
+func ByStockLevels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockLevelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransferOrdersCount orders the results by transfer_orders count.
+func ByTransferOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransferOrdersStep(), opts...)
+	}
+}
+
+// ByTransferOrders orders the results by transfer_orders terms.
+func ByTransferOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransferOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByInventoryReservationsCount orders the results by inventory_reservations count.
 func ByInventoryReservationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -1807,6 +2252,139 @@ func ByBenefitEnrollments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newBenefitEnrollmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPosShiftsCount orders the results by pos_shifts count.
+func ByPosShiftsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPosShiftsStep(), opts...)
+	}
+}
+
+// ByPosShifts orders the results by pos_shifts terms.
+func ByPosShifts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPosShiftsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLabelTemplatesCount orders the results by label_templates count.
+func ByLabelTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLabelTemplatesStep(), opts...)
+	}
+}
+
+// ByLabelTemplates orders the results by label_templates terms.
+func ByLabelTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLabelTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTaxJurisdictionsCount orders the results by tax_jurisdictions count.
+func ByTaxJurisdictionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaxJurisdictionsStep(), opts...)
+	}
+}
+
+// ByTaxJurisdictions orders the results by tax_jurisdictions terms.
+func ByTaxJurisdictions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaxJurisdictionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEinvoiceCredentialsCount orders the results by einvoice_credentials count.
+func ByEinvoiceCredentialsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEinvoiceCredentialsStep(), opts...)
+	}
+}
+
+// ByEinvoiceCredentials orders the results by einvoice_credentials terms.
+func ByEinvoiceCredentials(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEinvoiceCredentialsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEinvoicesCount orders the results by einvoices count.
+func ByEinvoicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEinvoicesStep(), opts...)
+	}
+}
+
+// ByEinvoices orders the results by einvoices terms.
+func ByEinvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEinvoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAgentEnrollmentTokensCount orders the results by agent_enrollment_tokens count.
+func ByAgentEnrollmentTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAgentEnrollmentTokensStep(), opts...)
+	}
+}
+
+// ByAgentEnrollmentTokens orders the results by agent_enrollment_tokens terms.
+func ByAgentEnrollmentTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAgentEnrollmentTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAgentAuthorityField orders the results by agent_authority field.
+func ByAgentAuthorityField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAgentAuthorityStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDeviceGroupsCount orders the results by device_groups count.
+func ByDeviceGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeviceGroupsStep(), opts...)
+	}
+}
+
+// ByDeviceGroups orders the results by device_groups terms.
+func ByDeviceGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPatchPoliciesCount orders the results by patch_policies count.
+func ByPatchPoliciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPatchPoliciesStep(), opts...)
+	}
+}
+
+// ByPatchPolicies orders the results by patch_policies terms.
+func ByPatchPolicies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPatchPoliciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAlertRulesCount orders the results by alert_rules count.
+func ByAlertRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAlertRulesStep(), opts...)
+	}
+}
+
+// ByAlertRules orders the results by alert_rules terms.
+func ByAlertRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAlertRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -2052,6 +2630,104 @@ func newRecurringInvoicesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RecurringInvoicesTable, RecurringInvoicesColumn),
 	)
 }
+func newExchangeRatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExchangeRatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExchangeRatesTable, ExchangeRatesColumn),
+	)
+}
+func newFiscalPeriodsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FiscalPeriodsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FiscalPeriodsTable, FiscalPeriodsColumn),
+	)
+}
+func newBalanceSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BalanceSnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+	)
+}
+func newBankStatementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BankStatementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BankStatementsTable, BankStatementsColumn),
+	)
+}
+func newBankStatementLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BankStatementLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BankStatementLinesTable, BankStatementLinesColumn),
+	)
+}
+func newBankRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BankRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BankRulesTable, BankRulesColumn),
+	)
+}
+func newCustomersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CustomersTable, CustomersColumn),
+	)
+}
+func newInvoicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+	)
+}
+func newCustomerPaymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomerPaymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CustomerPaymentsTable, CustomerPaymentsColumn),
+	)
+}
+func newSupplierBillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SupplierBillsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SupplierBillsTable, SupplierBillsColumn),
+	)
+}
+func newPaymentRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentRunsTable, PaymentRunsColumn),
+	)
+}
+func newGoodsReceiptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GoodsReceiptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GoodsReceiptsTable, GoodsReceiptsColumn),
+	)
+}
+func newStockLevelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockLevelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StockLevelsTable, StockLevelsColumn),
+	)
+}
+func newTransferOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransferOrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransferOrdersTable, TransferOrdersColumn),
+	)
+}
 func newInventoryReservationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -2332,3 +3008,73 @@ func newBenefitEnrollmentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, BenefitEnrollmentsTable, BenefitEnrollmentsColumn),
 	)
 }
+func newPosShiftsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PosShiftsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PosShiftsTable, PosShiftsColumn),
+	)
+}
+func newLabelTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LabelTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LabelTemplatesTable, LabelTemplatesColumn),
+	)
+}
+func newTaxJurisdictionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaxJurisdictionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TaxJurisdictionsTable, TaxJurisdictionsColumn),
+	)
+}
+func newEinvoiceCredentialsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EinvoiceCredentialsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EinvoiceCredentialsTable, EinvoiceCredentialsColumn),
+	)
+}
+func newEinvoicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EinvoicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EinvoicesTable, EinvoicesColumn),
+	)
+}
+func newAgentEnrollmentTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AgentEnrollmentTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AgentEnrollmentTokensTable, AgentEnrollmentTokensColumn),
+	)
+}
+func newAgentAuthorityStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AgentAuthorityInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AgentAuthorityTable, AgentAuthorityColumn),
+	)
+}
+func newDeviceGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceGroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeviceGroupsTable, DeviceGroupsColumn),
+	)
+}
+func newPatchPoliciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PatchPoliciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PatchPoliciesTable, PatchPoliciesColumn),
+	)
+}
+func newAlertRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AlertRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AlertRulesTable, AlertRulesColumn),
+	)
+}
@@ -81,6 +81,26 @@ func TransactionLimit(v decimal.Decimal) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldTransactionLimit, v))
 }
 
+// FunctionalCurrency applies equality check predicate on the "functional_currency" field. It's identical to FunctionalCurrencyEQ.
+func FunctionalCurrency(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldFunctionalCurrency, v))
+}
+
+// FiscalYearStartMonth applies equality check predicate on the "fiscal_year_start_month" field. It's identical to FiscalYearStartMonthEQ.
+func FiscalYearStartMonth(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldFiscalYearStartMonth, v))
+}
+
+// TaxNumber applies equality check predicate on the "tax_number" field. It's identical to TaxNumberEQ.
+func TaxNumber(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldTaxNumber, v))
+}
+
+// Logo applies equality check predicate on the "logo" field. It's identical to LogoEQ.
+func Logo(v []byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldLogo, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldName, v))
@@ -301,6 +321,236 @@ func TransactionLimitLTE(v decimal.Decimal) predicate.Tenant {
 	return predicate.Tenant(sql.FieldLTE(FieldTransactionLimit, v))
 }
 
+// FunctionalCurrencyEQ applies the EQ predicate on the "functional_currency" field.
+func FunctionalCurrencyEQ(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyNEQ applies the NEQ predicate on the "functional_currency" field.
+func FunctionalCurrencyNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyIn applies the In predicate on the "functional_currency" field.
+func FunctionalCurrencyIn(vs ...string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldFunctionalCurrency, vs...))
+}
+
+// FunctionalCurrencyNotIn applies the NotIn predicate on the "functional_currency" field.
+func FunctionalCurrencyNotIn(vs ...string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldFunctionalCurrency, vs...))
+}
+
+// FunctionalCurrencyGT applies the GT predicate on the "functional_currency" field.
+func FunctionalCurrencyGT(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyGTE applies the GTE predicate on the "functional_currency" field.
+func FunctionalCurrencyGTE(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyLT applies the LT predicate on the "functional_currency" field.
+func FunctionalCurrencyLT(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyLTE applies the LTE predicate on the "functional_currency" field.
+func FunctionalCurrencyLTE(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyContains applies the Contains predicate on the "functional_currency" field.
+func FunctionalCurrencyContains(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldContains(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyHasPrefix applies the HasPrefix predicate on the "functional_currency" field.
+func FunctionalCurrencyHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldHasPrefix(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyHasSuffix applies the HasSuffix predicate on the "functional_currency" field.
+func FunctionalCurrencyHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldHasSuffix(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyEqualFold applies the EqualFold predicate on the "functional_currency" field.
+func FunctionalCurrencyEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEqualFold(FieldFunctionalCurrency, v))
+}
+
+// FunctionalCurrencyContainsFold applies the ContainsFold predicate on the "functional_currency" field.
+func FunctionalCurrencyContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldContainsFold(FieldFunctionalCurrency, v))
+}
+
+// FiscalYearStartMonthEQ applies the EQ predicate on the "fiscal_year_start_month" field.
+func FiscalYearStartMonthEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldFiscalYearStartMonth, v))
+}
+
+// FiscalYearStartMonthNEQ applies the NEQ predicate on the "fiscal_year_start_month" field.
+func FiscalYearStartMonthNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldFiscalYearStartMonth, v))
+}
+
+// FiscalYearStartMonthIn applies the In predicate on the "fiscal_year_start_month" field.
+func FiscalYearStartMonthIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldFiscalYearStartMonth, vs...))
+}
+
+// FiscalYearStartMonthNotIn applies the NotIn predicate on the "fiscal_year_start_month" field.
+func FiscalYearStartMonthNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldFiscalYearStartMonth, vs...))
+}
+
+// FiscalYearStartMonthGT applies the GT predicate on the "fiscal_year_start_month" field.
+func FiscalYearStartMonthGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldFiscalYearStartMonth, v))
+}
+
+// FiscalYearStartMonthGTE applies the GTE predicate on the "fiscal_year_start_month" field.
+func FiscalYearStartMonthGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldFiscalYearStartMonth, v))
+}
+
+// FiscalYearStartMonthLT applies the LT predicate on the "fiscal_year_start_month" field.
+func FiscalYearStartMonthLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldFiscalYearStartMonth, v))
+}
+
+// FiscalYearStartMonthLTE applies the LTE predicate on the "fiscal_year_start_month" field.
+func FiscalYearStartMonthLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldFiscalYearStartMonth, v))
+}
+
+// TaxNumberEQ applies the EQ predicate on the "tax_number" field.
+func TaxNumberEQ(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldTaxNumber, v))
+}
+
+// TaxNumberNEQ applies the NEQ predicate on the "tax_number" field.
+func TaxNumberNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldTaxNumber, v))
+}
+
+// TaxNumberIn applies the In predicate on the "tax_number" field.
+func TaxNumberIn(vs ...string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldTaxNumber, vs...))
+}
+
+// TaxNumberNotIn applies the NotIn predicate on the "tax_number" field.
+func TaxNumberNotIn(vs ...string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldTaxNumber, vs...))
+}
+
+// TaxNumberGT applies the GT predicate on the "tax_number" field.
+func TaxNumberGT(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldTaxNumber, v))
+}
+
+// TaxNumberGTE applies the GTE predicate on the "tax_number" field.
+func TaxNumberGTE(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldTaxNumber, v))
+}
+
+// TaxNumberLT applies the LT predicate on the "tax_number" field.
+func TaxNumberLT(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldTaxNumber, v))
+}
+
+// TaxNumberLTE applies the LTE predicate on the "tax_number" field.
+func TaxNumberLTE(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldTaxNumber, v))
+}
+
+// TaxNumberContains applies the Contains predicate on the "tax_number" field.
+func TaxNumberContains(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldContains(FieldTaxNumber, v))
+}
+
+// TaxNumberHasPrefix applies the HasPrefix predicate on the "tax_number" field.
+func TaxNumberHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldHasPrefix(FieldTaxNumber, v))
+}
+
+// TaxNumberHasSuffix applies the HasSuffix predicate on the "tax_number" field.
+func TaxNumberHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldHasSuffix(FieldTaxNumber, v))
+}
+
+// TaxNumberIsNil applies the IsNil predicate on the "tax_number" field.
+func TaxNumberIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldTaxNumber))
+}
+
+// TaxNumberNotNil applies the NotNil predicate on the "tax_number" field.
+func TaxNumberNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldTaxNumber))
+}
+
+// TaxNumberEqualFold applies the EqualFold predicate on the "tax_number" field.
+func TaxNumberEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEqualFold(FieldTaxNumber, v))
+}
+
+// TaxNumberContainsFold applies the ContainsFold predicate on the "tax_number" field.
+func TaxNumberContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldContainsFold(FieldTaxNumber, v))
+}
+
+// LogoEQ applies the EQ predicate on the "logo" field.
+func LogoEQ(v []byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldLogo, v))
+}
+
+// LogoNEQ applies the NEQ predicate on the "logo" field.
+func LogoNEQ(v []byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldLogo, v))
+}
+
+// LogoIn applies the In predicate on the "logo" field.
+func LogoIn(vs ...[]byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldLogo, vs...))
+}
+
+// LogoNotIn applies the NotIn predicate on the "logo" field.
+func LogoNotIn(vs ...[]byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldLogo, vs...))
+}
+
+// LogoGT applies the GT predicate on the "logo" field.
+func LogoGT(v []byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldLogo, v))
+}
+
+// LogoGTE applies the GTE predicate on the "logo" field.
+func LogoGTE(v []byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldLogo, v))
+}
+
+// LogoLT applies the LT predicate on the "logo" field.
+func LogoLT(v []byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldLogo, v))
+}
+
+// LogoLTE applies the LTE predicate on the "logo" field.
+func LogoLTE(v []byte) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldLogo, v))
+}
+
+// LogoIsNil applies the IsNil predicate on the "logo" field.
+func LogoIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldLogo))
+}
+
+// LogoNotNil applies the NotNil predicate on the "logo" field.
+func LogoNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldLogo))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Tenant {
 	return predicate.Tenant(func(s *sql.Selector) {
@@ -1106,6 +1356,328 @@ func HasRecurringInvoicesWith(preds ...predicate.RecurringInvoice) predicate.Ten
 	})
 }
 
+// HasExchangeRates applies the HasEdge predicate on the "exchange_rates" edge.
+func HasExchangeRates() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExchangeRatesTable, ExchangeRatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExchangeRatesWith applies the HasEdge predicate on the "exchange_rates" edge with a given conditions (other predicates).
+func HasExchangeRatesWith(preds ...predicate.ExchangeRate) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newExchangeRatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFiscalPeriods applies the HasEdge predicate on the "fiscal_periods" edge.
+func HasFiscalPeriods() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FiscalPeriodsTable, FiscalPeriodsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFiscalPeriodsWith applies the HasEdge predicate on the "fiscal_periods" edge with a given conditions (other predicates).
+func HasFiscalPeriodsWith(preds ...predicate.FiscalPeriod) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newFiscalPeriodsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBalanceSnapshots applies the HasEdge predicate on the "balance_snapshots" edge.
+func HasBalanceSnapshots() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalanceSnapshotsWith applies the HasEdge predicate on the "balance_snapshots" edge with a given conditions (other predicates).
+func HasBalanceSnapshotsWith(preds ...predicate.AccountBalanceSnapshot) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newBalanceSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBankStatements applies the HasEdge predicate on the "bank_statements" edge.
+func HasBankStatements() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BankStatementsTable, BankStatementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBankStatementsWith applies the HasEdge predicate on the "bank_statements" edge with a given conditions (other predicates).
+func HasBankStatementsWith(preds ...predicate.BankStatement) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newBankStatementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBankStatementLines applies the HasEdge predicate on the "bank_statement_lines" edge.
+func HasBankStatementLines() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BankStatementLinesTable, BankStatementLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBankStatementLinesWith applies the HasEdge predicate on the "bank_statement_lines" edge with a given conditions (other predicates).
+func HasBankStatementLinesWith(preds ...predicate.BankStatementLine) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newBankStatementLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBankRules applies the HasEdge predicate on the "bank_rules" edge.
+func HasBankRules() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BankRulesTable, BankRulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBankRulesWith applies the HasEdge predicate on the "bank_rules" edge with a given conditions (other predicates).
+func HasBankRulesWith(preds ...predicate.BankRule) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newBankRulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomers applies the HasEdge predicate on the "customers" edge.
+func HasCustomers() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CustomersTable, CustomersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomersWith applies the HasEdge predicate on the "customers" edge with a given conditions (other predicates).
+func HasCustomersWith(preds ...predicate.Customer) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newCustomersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoices applies the HasEdge predicate on the "invoices" edge.
+func HasInvoices() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoicesWith applies the HasEdge predicate on the "invoices" edge with a given conditions (other predicates).
+func HasInvoicesWith(preds ...predicate.Invoice) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newInvoicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomerPayments applies the HasEdge predicate on the "customer_payments" edge.
+func HasCustomerPayments() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CustomerPaymentsTable, CustomerPaymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomerPaymentsWith applies the HasEdge predicate on the "customer_payments" edge with a given conditions (other predicates).
+func HasCustomerPaymentsWith(preds ...predicate.CustomerPayment) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newCustomerPaymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSupplierBills applies the HasEdge predicate on the "supplier_bills" edge.
+func HasSupplierBills() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SupplierBillsTable, SupplierBillsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSupplierBillsWith applies the HasEdge predicate on the "supplier_bills" edge with a given conditions (other predicates).
+func HasSupplierBillsWith(preds ...predicate.SupplierBill) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newSupplierBillsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaymentRuns applies the HasEdge predicate on the "payment_runs" edge.
+func HasPaymentRuns() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentRunsTable, PaymentRunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentRunsWith applies the HasEdge predicate on the "payment_runs" edge with a given conditions (other predicates).
+func HasPaymentRunsWith(preds ...predicate.PaymentRun) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newPaymentRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGoodsReceipts applies the HasEdge predicate on the "goods_receipts" edge.
+func HasGoodsReceipts() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GoodsReceiptsTable, GoodsReceiptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGoodsReceiptsWith applies the HasEdge predicate on the "goods_receipts" edge with a given conditions (other predicates).
+func HasGoodsReceiptsWith(preds ...predicate.GoodsReceipt) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newGoodsReceiptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStockLevels applies the HasEdge predicate on the "stock_levels" edge.
+func HasStockLevels() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StockLevelsTable, StockLevelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStockLevelsWith applies the HasEdge predicate on the "stock_levels" edge with a given conditions (other predicates).
+func HasStockLevelsWith(preds ...predicate.StockLevel) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newStockLevelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransferOrders applies the HasEdge predicate on the "transfer_orders" edge.
+func HasTransferOrders() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransferOrdersTable, TransferOrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransferOrdersWith applies the HasEdge predicate on the "transfer_orders" edge with a given conditions (other predicates).
+func HasTransferOrdersWith(preds ...predicate.TransferOrder) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newTransferOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasInventoryReservations applies the HasEdge predicate on the "inventory_reservations" edge.
 func HasInventoryReservations() predicate.Tenant {
 	return predicate.Tenant(func(s *sql.Selector) {
@@ -2026,6 +2598,236 @@ func HasBenefitEnrollmentsWith(preds ...predicate.BenefitEnrollment) predicate.T
 	})
 }
 
+// HasPosShifts applies the HasEdge predicate on the "pos_shifts" edge.
+func HasPosShifts() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PosShiftsTable, PosShiftsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPosShiftsWith applies the HasEdge predicate on the "pos_shifts" edge with a given conditions (other predicates).
+func HasPosShiftsWith(preds ...predicate.PosShift) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newPosShiftsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLabelTemplates applies the HasEdge predicate on the "label_templates" edge.
+func HasLabelTemplates() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LabelTemplatesTable, LabelTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLabelTemplatesWith applies the HasEdge predicate on the "label_templates" edge with a given conditions (other predicates).
+func HasLabelTemplatesWith(preds ...predicate.LabelTemplate) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newLabelTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTaxJurisdictions applies the HasEdge predicate on the "tax_jurisdictions" edge.
+func HasTaxJurisdictions() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TaxJurisdictionsTable, TaxJurisdictionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaxJurisdictionsWith applies the HasEdge predicate on the "tax_jurisdictions" edge with a given conditions (other predicates).
+func HasTaxJurisdictionsWith(preds ...predicate.TaxJurisdiction) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newTaxJurisdictionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEinvoiceCredentials applies the HasEdge predicate on the "einvoice_credentials" edge.
+func HasEinvoiceCredentials() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EinvoiceCredentialsTable, EinvoiceCredentialsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEinvoiceCredentialsWith applies the HasEdge predicate on the "einvoice_credentials" edge with a given conditions (other predicates).
+func HasEinvoiceCredentialsWith(preds ...predicate.EInvoiceCredential) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newEinvoiceCredentialsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEinvoices applies the HasEdge predicate on the "einvoices" edge.
+func HasEinvoices() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EinvoicesTable, EinvoicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEinvoicesWith applies the HasEdge predicate on the "einvoices" edge with a given conditions (other predicates).
+func HasEinvoicesWith(preds ...predicate.EInvoice) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newEinvoicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAgentEnrollmentTokens applies the HasEdge predicate on the "agent_enrollment_tokens" edge.
+func HasAgentEnrollmentTokens() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AgentEnrollmentTokensTable, AgentEnrollmentTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAgentEnrollmentTokensWith applies the HasEdge predicate on the "agent_enrollment_tokens" edge with a given conditions (other predicates).
+func HasAgentEnrollmentTokensWith(preds ...predicate.AgentEnrollmentToken) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newAgentEnrollmentTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAgentAuthority applies the HasEdge predicate on the "agent_authority" edge.
+func HasAgentAuthority() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, AgentAuthorityTable, AgentAuthorityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAgentAuthorityWith applies the HasEdge predicate on the "agent_authority" edge with a given conditions (other predicates).
+func HasAgentAuthorityWith(preds ...predicate.AgentAuthority) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newAgentAuthorityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeviceGroups applies the HasEdge predicate on the "device_groups" edge.
+func HasDeviceGroups() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeviceGroupsTable, DeviceGroupsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceGroupsWith applies the HasEdge predicate on the "device_groups" edge with a given conditions (other predicates).
+func HasDeviceGroupsWith(preds ...predicate.DeviceGroup) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newDeviceGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPatchPolicies applies the HasEdge predicate on the "patch_policies" edge.
+func HasPatchPolicies() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PatchPoliciesTable, PatchPoliciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPatchPoliciesWith applies the HasEdge predicate on the "patch_policies" edge with a given conditions (other predicates).
+func HasPatchPoliciesWith(preds ...predicate.PatchPolicy) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newPatchPoliciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAlertRules applies the HasEdge predicate on the "alert_rules" edge.
+func HasAlertRules() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AlertRulesTable, AlertRulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAlertRulesWith applies the HasEdge predicate on the "alert_rules" edge with a given conditions (other predicates).
+func HasAlertRulesWith(preds ...predicate.AlertRule) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newAlertRulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tenant) predicate.Tenant {
 	return predicate.Tenant(sql.AndPredicates(predicates...))
@@ -7,12 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
 	"sent/ent/agent"
+	"sent/ent/agentauthority"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/alertrule"
 	"sent/ent/application"
 	"sent/ent/asset"
 	"sent/ent/assetassignment"
 	"sent/ent/assettype"
 	"sent/ent/auditlog"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
 	"sent/ent/benefitenrollment"
 	"sent/ent/benefitplan"
 	"sent/ent/budgetforecast"
@@ -24,19 +31,29 @@ import (
 	"sent/ent/contact"
 	"sent/ent/contract"
 	"sent/ent/credential"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
 	"sent/ent/department"
 	"sent/ent/detectionevent"
+	"sent/ent/devicegroup"
 	"sent/ent/discoveryentry"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
 	"sent/ent/employee"
+	"sent/ent/exchangerate"
+	"sent/ent/fiscalperiod"
 	"sent/ent/goal"
+	"sent/ent/goodsreceipt"
 	"sent/ent/healthscoresnapshot"
 	"sent/ent/interview"
 	"sent/ent/inventorycount"
 	"sent/ent/inventoryreservation"
+	"sent/ent/invoice"
 	"sent/ent/ivrflow"
 	"sent/ent/job"
 	"sent/ent/jobposting"
 	"sent/ent/journalentry"
+	"sent/ent/labeltemplate"
 	"sent/ent/ledgerentry"
 	"sent/ent/legalhold"
 	"sent/ent/maintenanceschedule"
@@ -46,8 +63,11 @@ import (
 	"sent/ent/networkport"
 	"sent/ent/nexusaudit"
 	"sent/ent/onetimelink"
+	"sent/ent/patchpolicy"
+	"sent/ent/paymentrun"
 	"sent/ent/performancereview"
 	"sent/ent/permission"
+	"sent/ent/posshift"
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
 	"sent/ent/recording"
@@ -63,16 +83,20 @@ import (
 	"sent/ent/sop"
 	"sent/ent/stockalert"
 	"sent/ent/stockauditlog"
+	"sent/ent/stocklevel"
 	"sent/ent/stockmovement"
 	"sent/ent/strategicroadmap"
 	"sent/ent/successionmap"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/taxjurisdiction"
 	"sent/ent/tenant"
 	"sent/ent/ticket"
 	"sent/ent/timeoffbalance"
 	"sent/ent/timeoffpolicy"
 	"sent/ent/timeoffrequest"
 	"sent/ent/transaction"
+	"sent/ent/transferorder"
 	"sent/ent/user"
 	"sent/ent/vaultitem"
 	"sent/ent/vaultsharelink"
@@ -147,6 +171,54 @@ func (_c *TenantCreate) SetNillableTransactionLimit(v *decimal.Decimal) *TenantC
 	return _c
 }
 
+// SetFunctionalCurrency sets the "functional_currency" field.
+func (_c *TenantCreate) SetFunctionalCurrency(v string) *TenantCreate {
+	_c.mutation.SetFunctionalCurrency(v)
+	return _c
+}
+
+// SetNillableFunctionalCurrency sets the "functional_currency" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableFunctionalCurrency(v *string) *TenantCreate {
+	if v != nil {
+		_c.SetFunctionalCurrency(*v)
+	}
+	return _c
+}
+
+// SetFiscalYearStartMonth sets the "fiscal_year_start_month" field.
+func (_c *TenantCreate) SetFiscalYearStartMonth(v int) *TenantCreate {
+	_c.mutation.SetFiscalYearStartMonth(v)
+	return _c
+}
+
+// SetNillableFiscalYearStartMonth sets the "fiscal_year_start_month" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableFiscalYearStartMonth(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetFiscalYearStartMonth(*v)
+	}
+	return _c
+}
+
+// SetTaxNumber sets the "tax_number" field.
+func (_c *TenantCreate) SetTaxNumber(v string) *TenantCreate {
+	_c.mutation.SetTaxNumber(v)
+	return _c
+}
+
+// SetNillableTaxNumber sets the "tax_number" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableTaxNumber(v *string) *TenantCreate {
+	if v != nil {
+		_c.SetTaxNumber(*v)
+	}
+	return _c
+}
+
+// SetLogo sets the "logo" field.
+func (_c *TenantCreate) SetLogo(v []byte) *TenantCreate {
+	_c.mutation.SetLogo(v)
+	return _c
+}
+
 // SetParentID sets the "parent" edge to the Tenant entity by ID.
 func (_c *TenantCreate) SetParentID(id int) *TenantCreate {
 	_c.mutation.SetParentID(id)
@@ -676,6 +748,216 @@ func (_c *TenantCreate) AddRecurringInvoices(v ...*RecurringInvoice) *TenantCrea
 	return _c.AddRecurringInvoiceIDs(ids...)
 }
 
+// AddExchangeRateIDs adds the "exchange_rates" edge to the ExchangeRate entity by IDs.
+func (_c *TenantCreate) AddExchangeRateIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddExchangeRateIDs(ids...)
+	return _c
+}
+
+// AddExchangeRates adds the "exchange_rates" edges to the ExchangeRate entity.
+func (_c *TenantCreate) AddExchangeRates(v ...*ExchangeRate) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExchangeRateIDs(ids...)
+}
+
+// AddFiscalPeriodIDs adds the "fiscal_periods" edge to the FiscalPeriod entity by IDs.
+func (_c *TenantCreate) AddFiscalPeriodIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddFiscalPeriodIDs(ids...)
+	return _c
+}
+
+// AddFiscalPeriods adds the "fiscal_periods" edges to the FiscalPeriod entity.
+func (_c *TenantCreate) AddFiscalPeriods(v ...*FiscalPeriod) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFiscalPeriodIDs(ids...)
+}
+
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the AccountBalanceSnapshot entity by IDs.
+func (_c *TenantCreate) AddBalanceSnapshotIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddBalanceSnapshotIDs(ids...)
+	return _c
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_c *TenantCreate) AddBalanceSnapshots(v ...*AccountBalanceSnapshot) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBalanceSnapshotIDs(ids...)
+}
+
+// AddBankStatementIDs adds the "bank_statements" edge to the BankStatement entity by IDs.
+func (_c *TenantCreate) AddBankStatementIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddBankStatementIDs(ids...)
+	return _c
+}
+
+// AddBankStatements adds the "bank_statements" edges to the BankStatement entity.
+func (_c *TenantCreate) AddBankStatements(v ...*BankStatement) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBankStatementIDs(ids...)
+}
+
+// AddBankStatementLineIDs adds the "bank_statement_lines" edge to the BankStatementLine entity by IDs.
+func (_c *TenantCreate) AddBankStatementLineIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddBankStatementLineIDs(ids...)
+	return _c
+}
+
+// AddBankStatementLines adds the "bank_statement_lines" edges to the BankStatementLine entity.
+func (_c *TenantCreate) AddBankStatementLines(v ...*BankStatementLine) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBankStatementLineIDs(ids...)
+}
+
+// AddBankRuleIDs adds the "bank_rules" edge to the BankRule entity by IDs.
+func (_c *TenantCreate) AddBankRuleIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddBankRuleIDs(ids...)
+	return _c
+}
+
+// AddBankRules adds the "bank_rules" edges to the BankRule entity.
+func (_c *TenantCreate) AddBankRules(v ...*BankRule) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBankRuleIDs(ids...)
+}
+
+// AddCustomerIDs adds the "customers" edge to the Customer entity by IDs.
+func (_c *TenantCreate) AddCustomerIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddCustomerIDs(ids...)
+	return _c
+}
+
+// AddCustomers adds the "customers" edges to the Customer entity.
+func (_c *TenantCreate) AddCustomers(v ...*Customer) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCustomerIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_c *TenantCreate) AddInvoiceIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddInvoiceIDs(ids...)
+	return _c
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_c *TenantCreate) AddInvoices(v ...*Invoice) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvoiceIDs(ids...)
+}
+
+// AddCustomerPaymentIDs adds the "customer_payments" edge to the CustomerPayment entity by IDs.
+func (_c *TenantCreate) AddCustomerPaymentIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddCustomerPaymentIDs(ids...)
+	return _c
+}
+
+// AddCustomerPayments adds the "customer_payments" edges to the CustomerPayment entity.
+func (_c *TenantCreate) AddCustomerPayments(v ...*CustomerPayment) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCustomerPaymentIDs(ids...)
+}
+
+// AddSupplierBillIDs adds the "supplier_bills" edge to the SupplierBill entity by IDs.
+func (_c *TenantCreate) AddSupplierBillIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddSupplierBillIDs(ids...)
+	return _c
+}
+
+// AddSupplierBills adds the "supplier_bills" edges to the SupplierBill entity.
+func (_c *TenantCreate) AddSupplierBills(v ...*SupplierBill) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSupplierBillIDs(ids...)
+}
+
+// AddPaymentRunIDs adds the "payment_runs" edge to the PaymentRun entity by IDs.
+func (_c *TenantCreate) AddPaymentRunIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddPaymentRunIDs(ids...)
+	return _c
+}
+
+// AddPaymentRuns adds the "payment_runs" edges to the PaymentRun entity.
+func (_c *TenantCreate) AddPaymentRuns(v ...*PaymentRun) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaymentRunIDs(ids...)
+}
+
+// AddGoodsReceiptIDs adds the "goods_receipts" edge to the GoodsReceipt entity by IDs.
+func (_c *TenantCreate) AddGoodsReceiptIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddGoodsReceiptIDs(ids...)
+	return _c
+}
+
+// AddGoodsReceipts adds the "goods_receipts" edges to the GoodsReceipt entity.
+func (_c *TenantCreate) AddGoodsReceipts(v ...*GoodsReceipt) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGoodsReceiptIDs(ids...)
+}
+
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_c *TenantCreate) AddStockLevelIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddStockLevelIDs(ids...)
+	return _c
+}
+
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_c *TenantCreate) AddStockLevels(v ...*StockLevel) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStockLevelIDs(ids...)
+}
+
+// AddTransferOrderIDs adds the "transfer_orders" edge to the TransferOrder entity by IDs.
+func (_c *TenantCreate) AddTransferOrderIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddTransferOrderIDs(ids...)
+	return _c
+}
+
+// AddTransferOrders adds the "transfer_orders" edges to the TransferOrder entity.
+func (_c *TenantCreate) AddTransferOrders(v ...*TransferOrder) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransferOrderIDs(ids...)
+}
+
 // AddInventoryReservationIDs adds the "inventory_reservations" edge to the InventoryReservation entity by IDs.
 func (_c *TenantCreate) AddInventoryReservationIDs(ids ...int) *TenantCreate {
 	_c.mutation.AddInventoryReservationIDs(ids...)
@@ -1280,6 +1562,160 @@ func (_c *TenantCreate) AddBenefitEnrollments(v ...*BenefitEnrollment) *TenantCr
 	return _c.AddBenefitEnrollmentIDs(ids...)
 }
 
+// AddPosShiftIDs adds the "pos_shifts" edge to the PosShift entity by IDs.
+func (_c *TenantCreate) AddPosShiftIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddPosShiftIDs(ids...)
+	return _c
+}
+
+// AddPosShifts adds the "pos_shifts" edges to the PosShift entity.
+func (_c *TenantCreate) AddPosShifts(v ...*PosShift) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPosShiftIDs(ids...)
+}
+
+// AddLabelTemplateIDs adds the "label_templates" edge to the LabelTemplate entity by IDs.
+func (_c *TenantCreate) AddLabelTemplateIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddLabelTemplateIDs(ids...)
+	return _c
+}
+
+// AddLabelTemplates adds the "label_templates" edges to the LabelTemplate entity.
+func (_c *TenantCreate) AddLabelTemplates(v ...*LabelTemplate) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLabelTemplateIDs(ids...)
+}
+
+// AddTaxJurisdictionIDs adds the "tax_jurisdictions" edge to the TaxJurisdiction entity by IDs.
+func (_c *TenantCreate) AddTaxJurisdictionIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddTaxJurisdictionIDs(ids...)
+	return _c
+}
+
+// AddTaxJurisdictions adds the "tax_jurisdictions" edges to the TaxJurisdiction entity.
+func (_c *TenantCreate) AddTaxJurisdictions(v ...*TaxJurisdiction) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTaxJurisdictionIDs(ids...)
+}
+
+// AddEinvoiceCredentialIDs adds the "einvoice_credentials" edge to the EInvoiceCredential entity by IDs.
+func (_c *TenantCreate) AddEinvoiceCredentialIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddEinvoiceCredentialIDs(ids...)
+	return _c
+}
+
+// AddEinvoiceCredentials adds the "einvoice_credentials" edges to the EInvoiceCredential entity.
+func (_c *TenantCreate) AddEinvoiceCredentials(v ...*EInvoiceCredential) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEinvoiceCredentialIDs(ids...)
+}
+
+// AddEinvoiceIDs adds the "einvoices" edge to the EInvoice entity by IDs.
+func (_c *TenantCreate) AddEinvoiceIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddEinvoiceIDs(ids...)
+	return _c
+}
+
+// AddEinvoices adds the "einvoices" edges to the EInvoice entity.
+func (_c *TenantCreate) AddEinvoices(v ...*EInvoice) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEinvoiceIDs(ids...)
+}
+
+// AddAgentEnrollmentTokenIDs adds the "agent_enrollment_tokens" edge to the AgentEnrollmentToken entity by IDs.
+func (_c *TenantCreate) AddAgentEnrollmentTokenIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddAgentEnrollmentTokenIDs(ids...)
+	return _c
+}
+
+// AddAgentEnrollmentTokens adds the "agent_enrollment_tokens" edges to the AgentEnrollmentToken entity.
+func (_c *TenantCreate) AddAgentEnrollmentTokens(v ...*AgentEnrollmentToken) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAgentEnrollmentTokenIDs(ids...)
+}
+
+// SetAgentAuthorityID sets the "agent_authority" edge to the AgentAuthority entity by ID.
+func (_c *TenantCreate) SetAgentAuthorityID(id int) *TenantCreate {
+	_c.mutation.SetAgentAuthorityID(id)
+	return _c
+}
+
+// SetNillableAgentAuthorityID sets the "agent_authority" edge to the AgentAuthority entity by ID if the given value is not nil.
+func (_c *TenantCreate) SetNillableAgentAuthorityID(id *int) *TenantCreate {
+	if id != nil {
+		_c = _c.SetAgentAuthorityID(*id)
+	}
+	return _c
+}
+
+// SetAgentAuthority sets the "agent_authority" edge to the AgentAuthority entity.
+func (_c *TenantCreate) SetAgentAuthority(v *AgentAuthority) *TenantCreate {
+	return _c.SetAgentAuthorityID(v.ID)
+}
+
+// AddDeviceGroupIDs adds the "device_groups" edge to the DeviceGroup entity by IDs.
+func (_c *TenantCreate) AddDeviceGroupIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddDeviceGroupIDs(ids...)
+	return _c
+}
+
+// AddDeviceGroups adds the "device_groups" edges to the DeviceGroup entity.
+func (_c *TenantCreate) AddDeviceGroups(v ...*DeviceGroup) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeviceGroupIDs(ids...)
+}
+
+// AddPatchPolicyIDs adds the "patch_policies" edge to the PatchPolicy entity by IDs.
+func (_c *TenantCreate) AddPatchPolicyIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddPatchPolicyIDs(ids...)
+	return _c
+}
+
+// AddPatchPolicies adds the "patch_policies" edges to the PatchPolicy entity.
+func (_c *TenantCreate) AddPatchPolicies(v ...*PatchPolicy) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPatchPolicyIDs(ids...)
+}
+
+// AddAlertRuleIDs adds the "alert_rules" edge to the AlertRule entity by IDs.
+func (_c *TenantCreate) AddAlertRuleIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddAlertRuleIDs(ids...)
+	return _c
+}
+
+// AddAlertRules adds the "alert_rules" edges to the AlertRule entity.
+func (_c *TenantCreate) AddAlertRules(v ...*AlertRule) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAlertRuleIDs(ids...)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_c *TenantCreate) Mutation() *TenantMutation {
 	return _c.mutation
@@ -1327,6 +1763,14 @@ func (_c *TenantCreate) defaults() {
 		v := tenant.DefaultTransactionLimit
 		_c.mutation.SetTransactionLimit(v)
 	}
+	if _, ok := _c.mutation.FunctionalCurrency(); !ok {
+		v := tenant.DefaultFunctionalCurrency
+		_c.mutation.SetFunctionalCurrency(v)
+	}
+	if _, ok := _c.mutation.FiscalYearStartMonth(); !ok {
+		v := tenant.DefaultFiscalYearStartMonth
+		_c.mutation.SetFiscalYearStartMonth(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -1346,6 +1790,17 @@ func (_c *TenantCreate) check() error {
 	if _, ok := _c.mutation.TransactionLimit(); !ok {
 		return &ValidationError{Name: "transaction_limit", err: errors.New(`ent: missing required field "Tenant.transaction_limit"`)}
 	}
+	if _, ok := _c.mutation.FunctionalCurrency(); !ok {
+		return &ValidationError{Name: "functional_currency", err: errors.New(`ent: missing required field "Tenant.functional_currency"`)}
+	}
+	if _, ok := _c.mutation.FiscalYearStartMonth(); !ok {
+		return &ValidationError{Name: "fiscal_year_start_month", err: errors.New(`ent: missing required field "Tenant.fiscal_year_start_month"`)}
+	}
+	if v, ok := _c.mutation.FiscalYearStartMonth(); ok {
+		if err := tenant.FiscalYearStartMonthValidator(v); err != nil {
+			return &ValidationError{Name: "fiscal_year_start_month", err: fmt.Errorf(`ent: validator failed for field "Tenant.fiscal_year_start_month": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1392,6 +1847,22 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldTransactionLimit, field.TypeOther, value)
 		_node.TransactionLimit = value
 	}
+	if value, ok := _c.mutation.FunctionalCurrency(); ok {
+		_spec.SetField(tenant.FieldFunctionalCurrency, field.TypeString, value)
+		_node.FunctionalCurrency = value
+	}
+	if value, ok := _c.mutation.FiscalYearStartMonth(); ok {
+		_spec.SetField(tenant.FieldFiscalYearStartMonth, field.TypeInt, value)
+		_node.FiscalYearStartMonth = value
+	}
+	if value, ok := _c.mutation.TaxNumber(); ok {
+		_spec.SetField(tenant.FieldTaxNumber, field.TypeString, value)
+		_node.TaxNumber = value
+	}
+	if value, ok := _c.mutation.Logo(); ok {
+		_spec.SetField(tenant.FieldLogo, field.TypeBytes, value)
+		_node.Logo = value
+	}
 	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1953,11 +2424,235 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.InventoryReservationsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.ExchangeRatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryReservationsTable,
+			Table:   tenant.ExchangeRatesTable,
+			Columns: []string{tenant.ExchangeRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FiscalPeriodsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.FiscalPeriodsTable,
+			Columns: []string{tenant.FiscalPeriodsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BalanceSnapshotsTable,
+			Columns: []string{tenant.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BankStatementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BankStatementsTable,
+			Columns: []string{tenant.BankStatementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BankStatementLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BankStatementLinesTable,
+			Columns: []string{tenant.BankStatementLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BankRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BankRulesTable,
+			Columns: []string{tenant.BankRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CustomersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CustomersTable,
+			Columns: []string{tenant.CustomersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InvoicesTable,
+			Columns: []string{tenant.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CustomerPaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CustomerPaymentsTable,
+			Columns: []string{tenant.CustomerPaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SupplierBillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SupplierBillsTable,
+			Columns: []string{tenant.SupplierBillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PaymentRunsTable,
+			Columns: []string{tenant.PaymentRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GoodsReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.GoodsReceiptsTable,
+			Columns: []string{tenant.GoodsReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockLevelsTable,
+			Columns: []string{tenant.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransferOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TransferOrdersTable,
+			Columns: []string{tenant.TransferOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InventoryReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InventoryReservationsTable,
 			Columns: []string{tenant.InventoryReservationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
@@ -2594,6 +3289,166 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PosShiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PosShiftsTable,
+			Columns: []string{tenant.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LabelTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LabelTemplatesTable,
+			Columns: []string{tenant.LabelTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TaxJurisdictionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TaxJurisdictionsTable,
+			Columns: []string{tenant.TaxJurisdictionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EinvoiceCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.EinvoiceCredentialsTable,
+			Columns: []string{tenant.EinvoiceCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EinvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.EinvoicesTable,
+			Columns: []string{tenant.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AgentEnrollmentTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AgentEnrollmentTokensTable,
+			Columns: []string{tenant.AgentEnrollmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AgentAuthorityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   tenant.AgentAuthorityTable,
+			Columns: []string{tenant.AgentAuthorityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentauthority.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeviceGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DeviceGroupsTable,
+			Columns: []string{tenant.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PatchPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PatchPoliciesTable,
+			Columns: []string{tenant.PatchPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AlertRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AlertRulesTable,
+			Columns: []string{tenant.AlertRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,12 +8,19 @@ import (
 	"fmt"
 	"math"
 	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
 	"sent/ent/agent"
+	"sent/ent/agentauthority"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/alertrule"
 	"sent/ent/application"
 	"sent/ent/asset"
 	"sent/ent/assetassignment"
 	"sent/ent/assettype"
 	"sent/ent/auditlog"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
 	"sent/ent/benefitenrollment"
 	"sent/ent/benefitplan"
 	"sent/ent/budgetforecast"
@@ -25,19 +32,29 @@ import (
 	"sent/ent/contact"
 	"sent/ent/contract"
 	"sent/ent/credential"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
 	"sent/ent/department"
 	"sent/ent/detectionevent"
+	"sent/ent/devicegroup"
 	"sent/ent/discoveryentry"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
 	"sent/ent/employee"
+	"sent/ent/exchangerate"
+	"sent/ent/fiscalperiod"
 	"sent/ent/goal"
+	"sent/ent/goodsreceipt"
 	"sent/ent/healthscoresnapshot"
 	"sent/ent/interview"
 	"sent/ent/inventorycount"
 	"sent/ent/inventoryreservation"
+	"sent/ent/invoice"
 	"sent/ent/ivrflow"
 	"sent/ent/job"
 	"sent/ent/jobposting"
 	"sent/ent/journalentry"
+	"sent/ent/labeltemplate"
 	"sent/ent/ledgerentry"
 	"sent/ent/legalhold"
 	"sent/ent/maintenanceschedule"
@@ -47,8 +64,11 @@ import (
 	"sent/ent/networkport"
 	"sent/ent/nexusaudit"
 	"sent/ent/onetimelink"
+	"sent/ent/patchpolicy"
+	"sent/ent/paymentrun"
 	"sent/ent/performancereview"
 	"sent/ent/permission"
+	"sent/ent/posshift"
 	"sent/ent/predicate"
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
@@ -65,16 +85,20 @@ import (
 	"sent/ent/sop"
 	"sent/ent/stockalert"
 	"sent/ent/stockauditlog"
+	"sent/ent/stocklevel"
 	"sent/ent/stockmovement"
 	"sent/ent/strategicroadmap"
 	"sent/ent/successionmap"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/taxjurisdiction"
 	"sent/ent/tenant"
 	"sent/ent/ticket"
 	"sent/ent/timeoffbalance"
 	"sent/ent/timeoffpolicy"
 	"sent/ent/timeoffrequest"
 	"sent/ent/transaction"
+	"sent/ent/transferorder"
 	"sent/ent/user"
 	"sent/ent/vaultitem"
 	"sent/ent/vaultsharelink"
@@ -130,6 +154,20 @@ type TenantQuery struct {
 	withVaultShareLinks        *VaultShareLinkQuery
 	withJournalEntries         *JournalEntryQuery
 	withRecurringInvoices      *RecurringInvoiceQuery
+	withExchangeRates          *ExchangeRateQuery
+	withFiscalPeriods          *FiscalPeriodQuery
+	withBalanceSnapshots       *AccountBalanceSnapshotQuery
+	withBankStatements         *BankStatementQuery
+	withBankStatementLines     *BankStatementLineQuery
+	withBankRules              *BankRuleQuery
+	withCustomers              *CustomerQuery
+	withInvoices               *InvoiceQuery
+	withCustomerPayments       *CustomerPaymentQuery
+	withSupplierBills          *SupplierBillQuery
+	withPaymentRuns            *PaymentRunQuery
+	withGoodsReceipts          *GoodsReceiptQuery
+	withStockLevels            *StockLevelQuery
+	withTransferOrders         *TransferOrderQuery
 	withInventoryReservations  *InventoryReservationQuery
 	withDepartments            *DepartmentQuery
 	withPermissions            *PermissionQuery
@@ -170,6 +208,16 @@ type TenantQuery struct {
 	withInterviews             *InterviewQuery
 	withBenefitPlans           *BenefitPlanQuery
 	withBenefitEnrollments     *BenefitEnrollmentQuery
+	withPosShifts              *PosShiftQuery
+	withLabelTemplates         *LabelTemplateQuery
+	withTaxJurisdictions       *TaxJurisdictionQuery
+	withEinvoiceCredentials    *EInvoiceCredentialQuery
+	withEinvoices              *EInvoiceQuery
+	withAgentEnrollmentTokens  *AgentEnrollmentTokenQuery
+	withAgentAuthority         *AgentAuthorityQuery
+	withDeviceGroups           *DeviceGroupQuery
+	withPatchPolicies          *PatchPolicyQuery
+	withAlertRules             *AlertRuleQuery
 	withFKs                    bool
 	modifiers                  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -978,6 +1026,314 @@ func (_q *TenantQuery) QueryRecurringInvoices() *RecurringInvoiceQuery {
 	return query
 }
 
+// QueryExchangeRates chains the current query on the "exchange_rates" edge.
+func (_q *TenantQuery) QueryExchangeRates() *ExchangeRateQuery {
+	query := (&ExchangeRateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(exchangerate.Table, exchangerate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ExchangeRatesTable, tenant.ExchangeRatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFiscalPeriods chains the current query on the "fiscal_periods" edge.
+func (_q *TenantQuery) QueryFiscalPeriods() *FiscalPeriodQuery {
+	query := (&FiscalPeriodClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(fiscalperiod.Table, fiscalperiod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.FiscalPeriodsTable, tenant.FiscalPeriodsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBalanceSnapshots chains the current query on the "balance_snapshots" edge.
+func (_q *TenantQuery) QueryBalanceSnapshots() *AccountBalanceSnapshotQuery {
+	query := (&AccountBalanceSnapshotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(accountbalancesnapshot.Table, accountbalancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.BalanceSnapshotsTable, tenant.BalanceSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBankStatements chains the current query on the "bank_statements" edge.
+func (_q *TenantQuery) QueryBankStatements() *BankStatementQuery {
+	query := (&BankStatementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(bankstatement.Table, bankstatement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.BankStatementsTable, tenant.BankStatementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBankStatementLines chains the current query on the "bank_statement_lines" edge.
+func (_q *TenantQuery) QueryBankStatementLines() *BankStatementLineQuery {
+	query := (&BankStatementLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(bankstatementline.Table, bankstatementline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.BankStatementLinesTable, tenant.BankStatementLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBankRules chains the current query on the "bank_rules" edge.
+func (_q *TenantQuery) QueryBankRules() *BankRuleQuery {
+	query := (&BankRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(bankrule.Table, bankrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.BankRulesTable, tenant.BankRulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomers chains the current query on the "customers" edge.
+func (_q *TenantQuery) QueryCustomers() *CustomerQuery {
+	query := (&CustomerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.CustomersTable, tenant.CustomersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoices chains the current query on the "invoices" edge.
+func (_q *TenantQuery) QueryInvoices() *InvoiceQuery {
+	query := (&InvoiceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.InvoicesTable, tenant.InvoicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomerPayments chains the current query on the "customer_payments" edge.
+func (_q *TenantQuery) QueryCustomerPayments() *CustomerPaymentQuery {
+	query := (&CustomerPaymentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(customerpayment.Table, customerpayment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.CustomerPaymentsTable, tenant.CustomerPaymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySupplierBills chains the current query on the "supplier_bills" edge.
+func (_q *TenantQuery) QuerySupplierBills() *SupplierBillQuery {
+	query := (&SupplierBillClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(supplierbill.Table, supplierbill.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.SupplierBillsTable, tenant.SupplierBillsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentRuns chains the current query on the "payment_runs" edge.
+func (_q *TenantQuery) QueryPaymentRuns() *PaymentRunQuery {
+	query := (&PaymentRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(paymentrun.Table, paymentrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.PaymentRunsTable, tenant.PaymentRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGoodsReceipts chains the current query on the "goods_receipts" edge.
+func (_q *TenantQuery) QueryGoodsReceipts() *GoodsReceiptQuery {
+	query := (&GoodsReceiptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(goodsreceipt.Table, goodsreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.GoodsReceiptsTable, tenant.GoodsReceiptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStockLevels chains the current query on the "stock_levels" edge.
+func (_q *TenantQuery) QueryStockLevels() *StockLevelQuery {
+	query := (&StockLevelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(stocklevel.Table, stocklevel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.StockLevelsTable, tenant.StockLevelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransferOrders chains the current query on the "transfer_orders" edge.
+func (_q *TenantQuery) QueryTransferOrders() *TransferOrderQuery {
+	query := (&TransferOrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(transferorder.Table, transferorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.TransferOrdersTable, tenant.TransferOrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryInventoryReservations chains the current query on the "inventory_reservations" edge.
 func (_q *TenantQuery) QueryInventoryReservations() *InventoryReservationQuery {
 	query := (&InventoryReservationClient{config: _q.config}).Query()
@@ -1858,24 +2214,244 @@ func (_q *TenantQuery) QueryBenefitEnrollments() *BenefitEnrollmentQuery {
 	return query
 }
 
-// First returns the first Tenant entity from the query.
-// Returns a *NotFoundError when no Tenant was found.
-func (_q *TenantQuery) First(ctx context.Context) (*Tenant, error) {
-	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
-	if err != nil {
-		return nil, err
-	}
-	if len(nodes) == 0 {
-		return nil, &NotFoundError{tenant.Label}
-	}
-	return nodes[0], nil
-}
-
-// FirstX is like First, but panics if an error occurs.
-func (_q *TenantQuery) FirstX(ctx context.Context) *Tenant {
-	node, err := _q.First(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryPosShifts chains the current query on the "pos_shifts" edge.
+func (_q *TenantQuery) QueryPosShifts() *PosShiftQuery {
+	query := (&PosShiftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(posshift.Table, posshift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.PosShiftsTable, tenant.PosShiftsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLabelTemplates chains the current query on the "label_templates" edge.
+func (_q *TenantQuery) QueryLabelTemplates() *LabelTemplateQuery {
+	query := (&LabelTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(labeltemplate.Table, labeltemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.LabelTemplatesTable, tenant.LabelTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTaxJurisdictions chains the current query on the "tax_jurisdictions" edge.
+func (_q *TenantQuery) QueryTaxJurisdictions() *TaxJurisdictionQuery {
+	query := (&TaxJurisdictionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(taxjurisdiction.Table, taxjurisdiction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.TaxJurisdictionsTable, tenant.TaxJurisdictionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEinvoiceCredentials chains the current query on the "einvoice_credentials" edge.
+func (_q *TenantQuery) QueryEinvoiceCredentials() *EInvoiceCredentialQuery {
+	query := (&EInvoiceCredentialClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(einvoicecredential.Table, einvoicecredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.EinvoiceCredentialsTable, tenant.EinvoiceCredentialsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEinvoices chains the current query on the "einvoices" edge.
+func (_q *TenantQuery) QueryEinvoices() *EInvoiceQuery {
+	query := (&EInvoiceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(einvoice.Table, einvoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.EinvoicesTable, tenant.EinvoicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAgentEnrollmentTokens chains the current query on the "agent_enrollment_tokens" edge.
+func (_q *TenantQuery) QueryAgentEnrollmentTokens() *AgentEnrollmentTokenQuery {
+	query := (&AgentEnrollmentTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(agentenrollmenttoken.Table, agentenrollmenttoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.AgentEnrollmentTokensTable, tenant.AgentEnrollmentTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAgentAuthority chains the current query on the "agent_authority" edge.
+func (_q *TenantQuery) QueryAgentAuthority() *AgentAuthorityQuery {
+	query := (&AgentAuthorityClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(agentauthority.Table, agentauthority.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, tenant.AgentAuthorityTable, tenant.AgentAuthorityColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeviceGroups chains the current query on the "device_groups" edge.
+func (_q *TenantQuery) QueryDeviceGroups() *DeviceGroupQuery {
+	query := (&DeviceGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(devicegroup.Table, devicegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.DeviceGroupsTable, tenant.DeviceGroupsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPatchPolicies chains the current query on the "patch_policies" edge.
+func (_q *TenantQuery) QueryPatchPolicies() *PatchPolicyQuery {
+	query := (&PatchPolicyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(patchpolicy.Table, patchpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.PatchPoliciesTable, tenant.PatchPoliciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAlertRules chains the current query on the "alert_rules" edge.
+func (_q *TenantQuery) QueryAlertRules() *AlertRuleQuery {
+	query := (&AlertRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(alertrule.Table, alertrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.AlertRulesTable, tenant.AlertRulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Tenant entity from the query.
+// Returns a *NotFoundError when no Tenant was found.
+func (_q *TenantQuery) First(ctx context.Context) (*Tenant, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tenant.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *TenantQuery) FirstX(ctx context.Context) *Tenant {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
 	}
 	return node
 }
@@ -2085,6 +2661,20 @@ func (_q *TenantQuery) Clone() *TenantQuery {
 		withVaultShareLinks:        _q.withVaultShareLinks.Clone(),
 		withJournalEntries:         _q.withJournalEntries.Clone(),
 		withRecurringInvoices:      _q.withRecurringInvoices.Clone(),
+		withExchangeRates:          _q.withExchangeRates.Clone(),
+		withFiscalPeriods:          _q.withFiscalPeriods.Clone(),
+		withBalanceSnapshots:       _q.withBalanceSnapshots.Clone(),
+		withBankStatements:         _q.withBankStatements.Clone(),
+		withBankStatementLines:     _q.withBankStatementLines.Clone(),
+		withBankRules:              _q.withBankRules.Clone(),
+		withCustomers:              _q.withCustomers.Clone(),
+		withInvoices:               _q.withInvoices.Clone(),
+		withCustomerPayments:       _q.withCustomerPayments.Clone(),
+		withSupplierBills:          _q.withSupplierBills.Clone(),
+		withPaymentRuns:            _q.withPaymentRuns.Clone(),
+		withGoodsReceipts:          _q.withGoodsReceipts.Clone(),
+		withStockLevels:            _q.withStockLevels.Clone(),
+		withTransferOrders:         _q.withTransferOrders.Clone(),
 		withInventoryReservations:  _q.withInventoryReservations.Clone(),
 		withDepartments:            _q.withDepartments.Clone(),
 		withPermissions:            _q.withPermissions.Clone(),
@@ -2125,6 +2715,16 @@ func (_q *TenantQuery) Clone() *TenantQuery {
 		withInterviews:             _q.withInterviews.Clone(),
 		withBenefitPlans:           _q.withBenefitPlans.Clone(),
 		withBenefitEnrollments:     _q.withBenefitEnrollments.Clone(),
+		withPosShifts:              _q.withPosShifts.Clone(),
+		withLabelTemplates:         _q.withLabelTemplates.Clone(),
+		withTaxJurisdictions:       _q.withTaxJurisdictions.Clone(),
+		withEinvoiceCredentials:    _q.withEinvoiceCredentials.Clone(),
+		withEinvoices:              _q.withEinvoices.Clone(),
+		withAgentEnrollmentTokens:  _q.withAgentEnrollmentTokens.Clone(),
+		withAgentAuthority:         _q.withAgentAuthority.Clone(),
+		withDeviceGroups:           _q.withDeviceGroups.Clone(),
+		withPatchPolicies:          _q.withPatchPolicies.Clone(),
+		withAlertRules:             _q.withAlertRules.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -2517,152 +3117,306 @@ func (_q *TenantQuery) WithRecurringInvoices(opts ...func(*RecurringInvoiceQuery
 	return _q
 }
 
-// WithInventoryReservations tells the query-builder to eager-load the nodes that are connected to
-// the "inventory_reservations" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithInventoryReservations(opts ...func(*InventoryReservationQuery)) *TenantQuery {
-	query := (&InventoryReservationClient{config: _q.config}).Query()
+// WithExchangeRates tells the query-builder to eager-load the nodes that are connected to
+// the "exchange_rates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithExchangeRates(opts ...func(*ExchangeRateQuery)) *TenantQuery {
+	query := (&ExchangeRateClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withInventoryReservations = query
+	_q.withExchangeRates = query
 	return _q
 }
 
-// WithDepartments tells the query-builder to eager-load the nodes that are connected to
-// the "departments" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithDepartments(opts ...func(*DepartmentQuery)) *TenantQuery {
-	query := (&DepartmentClient{config: _q.config}).Query()
+// WithFiscalPeriods tells the query-builder to eager-load the nodes that are connected to
+// the "fiscal_periods" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithFiscalPeriods(opts ...func(*FiscalPeriodQuery)) *TenantQuery {
+	query := (&FiscalPeriodClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withDepartments = query
+	_q.withFiscalPeriods = query
 	return _q
 }
 
-// WithPermissions tells the query-builder to eager-load the nodes that are connected to
-// the "permissions" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithPermissions(opts ...func(*PermissionQuery)) *TenantQuery {
-	query := (&PermissionClient{config: _q.config}).Query()
+// WithBalanceSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "balance_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithBalanceSnapshots(opts ...func(*AccountBalanceSnapshotQuery)) *TenantQuery {
+	query := (&AccountBalanceSnapshotClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withPermissions = query
+	_q.withBalanceSnapshots = query
 	return _q
 }
 
-// WithAssetTypes tells the query-builder to eager-load the nodes that are connected to
-// the "asset_types" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithAssetTypes(opts ...func(*AssetTypeQuery)) *TenantQuery {
-	query := (&AssetTypeClient{config: _q.config}).Query()
+// WithBankStatements tells the query-builder to eager-load the nodes that are connected to
+// the "bank_statements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithBankStatements(opts ...func(*BankStatementQuery)) *TenantQuery {
+	query := (&BankStatementClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withAssetTypes = query
+	_q.withBankStatements = query
 	return _q
 }
 
-// WithDetectionEvents tells the query-builder to eager-load the nodes that are connected to
-// the "detection_events" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithDetectionEvents(opts ...func(*DetectionEventQuery)) *TenantQuery {
-	query := (&DetectionEventClient{config: _q.config}).Query()
+// WithBankStatementLines tells the query-builder to eager-load the nodes that are connected to
+// the "bank_statement_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithBankStatementLines(opts ...func(*BankStatementLineQuery)) *TenantQuery {
+	query := (&BankStatementLineClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withDetectionEvents = query
+	_q.withBankStatementLines = query
 	return _q
 }
 
-// WithSaasIdentities tells the query-builder to eager-load the nodes that are connected to
-// the "saas_identities" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithSaasIdentities(opts ...func(*SaaSIdentityQuery)) *TenantQuery {
-	query := (&SaaSIdentityClient{config: _q.config}).Query()
+// WithBankRules tells the query-builder to eager-load the nodes that are connected to
+// the "bank_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithBankRules(opts ...func(*BankRuleQuery)) *TenantQuery {
+	query := (&BankRuleClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withSaasIdentities = query
+	_q.withBankRules = query
 	return _q
 }
 
-// WithSaasUsages tells the query-builder to eager-load the nodes that are connected to
-// the "saas_usages" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithSaasUsages(opts ...func(*SaaSUsageQuery)) *TenantQuery {
-	query := (&SaaSUsageClient{config: _q.config}).Query()
+// WithCustomers tells the query-builder to eager-load the nodes that are connected to
+// the "customers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithCustomers(opts ...func(*CustomerQuery)) *TenantQuery {
+	query := (&CustomerClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withSaasUsages = query
+	_q.withCustomers = query
 	return _q
 }
 
-// WithRecordings tells the query-builder to eager-load the nodes that are connected to
-// the "recordings" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithRecordings(opts ...func(*RecordingQuery)) *TenantQuery {
-	query := (&RecordingClient{config: _q.config}).Query()
+// WithInvoices tells the query-builder to eager-load the nodes that are connected to
+// the "invoices" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithInvoices(opts ...func(*InvoiceQuery)) *TenantQuery {
+	query := (&InvoiceClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withRecordings = query
+	_q.withInvoices = query
 	return _q
 }
 
-// WithNetworkLinks tells the query-builder to eager-load the nodes that are connected to
-// the "network_links" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithNetworkLinks(opts ...func(*NetworkLinkQuery)) *TenantQuery {
-	query := (&NetworkLinkClient{config: _q.config}).Query()
+// WithCustomerPayments tells the query-builder to eager-load the nodes that are connected to
+// the "customer_payments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithCustomerPayments(opts ...func(*CustomerPaymentQuery)) *TenantQuery {
+	query := (&CustomerPaymentClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withNetworkLinks = query
+	_q.withCustomerPayments = query
 	return _q
 }
 
-// WithNetworkPorts tells the query-builder to eager-load the nodes that are connected to
-// the "network_ports" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithNetworkPorts(opts ...func(*NetworkPortQuery)) *TenantQuery {
-	query := (&NetworkPortClient{config: _q.config}).Query()
+// WithSupplierBills tells the query-builder to eager-load the nodes that are connected to
+// the "supplier_bills" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithSupplierBills(opts ...func(*SupplierBillQuery)) *TenantQuery {
+	query := (&SupplierBillClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withNetworkPorts = query
+	_q.withSupplierBills = query
 	return _q
 }
 
-// WithNexusAudits tells the query-builder to eager-load the nodes that are connected to
-// the "nexus_audits" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithNexusAudits(opts ...func(*NexusAuditQuery)) *TenantQuery {
-	query := (&NexusAuditClient{config: _q.config}).Query()
+// WithPaymentRuns tells the query-builder to eager-load the nodes that are connected to
+// the "payment_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithPaymentRuns(opts ...func(*PaymentRunQuery)) *TenantQuery {
+	query := (&PaymentRunClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withNexusAudits = query
+	_q.withPaymentRuns = query
 	return _q
 }
 
-// WithSuccessionMaps tells the query-builder to eager-load the nodes that are connected to
-// the "succession_maps" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithSuccessionMaps(opts ...func(*SuccessionMapQuery)) *TenantQuery {
-	query := (&SuccessionMapClient{config: _q.config}).Query()
+// WithGoodsReceipts tells the query-builder to eager-load the nodes that are connected to
+// the "goods_receipts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithGoodsReceipts(opts ...func(*GoodsReceiptQuery)) *TenantQuery {
+	query := (&GoodsReceiptClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withSuccessionMaps = query
+	_q.withGoodsReceipts = query
 	return _q
 }
 
-// WithCustomerAccount tells the query-builder to eager-load the nodes that are connected to
-// the "customer_account" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithCustomerAccount(opts ...func(*AccountQuery)) *TenantQuery {
-	query := (&AccountClient{config: _q.config}).Query()
+// WithStockLevels tells the query-builder to eager-load the nodes that are connected to
+// the "stock_levels" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithStockLevels(opts ...func(*StockLevelQuery)) *TenantQuery {
+	query := (&StockLevelClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withCustomerAccount = query
+	_q.withStockLevels = query
 	return _q
 }
 
-// WithScripts tells the query-builder to eager-load the nodes that are connected to
-// the "scripts" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TenantQuery) WithScripts(opts ...func(*ScriptQuery)) *TenantQuery {
+// WithTransferOrders tells the query-builder to eager-load the nodes that are connected to
+// the "transfer_orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithTransferOrders(opts ...func(*TransferOrderQuery)) *TenantQuery {
+	query := (&TransferOrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransferOrders = query
+	return _q
+}
+
+// WithInventoryReservations tells the query-builder to eager-load the nodes that are connected to
+// the "inventory_reservations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithInventoryReservations(opts ...func(*InventoryReservationQuery)) *TenantQuery {
+	query := (&InventoryReservationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInventoryReservations = query
+	return _q
+}
+
+// WithDepartments tells the query-builder to eager-load the nodes that are connected to
+// the "departments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithDepartments(opts ...func(*DepartmentQuery)) *TenantQuery {
+	query := (&DepartmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDepartments = query
+	return _q
+}
+
+// WithPermissions tells the query-builder to eager-load the nodes that are connected to
+// the "permissions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithPermissions(opts ...func(*PermissionQuery)) *TenantQuery {
+	query := (&PermissionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPermissions = query
+	return _q
+}
+
+// WithAssetTypes tells the query-builder to eager-load the nodes that are connected to
+// the "asset_types" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithAssetTypes(opts ...func(*AssetTypeQuery)) *TenantQuery {
+	query := (&AssetTypeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssetTypes = query
+	return _q
+}
+
+// WithDetectionEvents tells the query-builder to eager-load the nodes that are connected to
+// the "detection_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithDetectionEvents(opts ...func(*DetectionEventQuery)) *TenantQuery {
+	query := (&DetectionEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDetectionEvents = query
+	return _q
+}
+
+// WithSaasIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "saas_identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithSaasIdentities(opts ...func(*SaaSIdentityQuery)) *TenantQuery {
+	query := (&SaaSIdentityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSaasIdentities = query
+	return _q
+}
+
+// WithSaasUsages tells the query-builder to eager-load the nodes that are connected to
+// the "saas_usages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithSaasUsages(opts ...func(*SaaSUsageQuery)) *TenantQuery {
+	query := (&SaaSUsageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSaasUsages = query
+	return _q
+}
+
+// WithRecordings tells the query-builder to eager-load the nodes that are connected to
+// the "recordings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithRecordings(opts ...func(*RecordingQuery)) *TenantQuery {
+	query := (&RecordingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecordings = query
+	return _q
+}
+
+// WithNetworkLinks tells the query-builder to eager-load the nodes that are connected to
+// the "network_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithNetworkLinks(opts ...func(*NetworkLinkQuery)) *TenantQuery {
+	query := (&NetworkLinkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNetworkLinks = query
+	return _q
+}
+
+// WithNetworkPorts tells the query-builder to eager-load the nodes that are connected to
+// the "network_ports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithNetworkPorts(opts ...func(*NetworkPortQuery)) *TenantQuery {
+	query := (&NetworkPortClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNetworkPorts = query
+	return _q
+}
+
+// WithNexusAudits tells the query-builder to eager-load the nodes that are connected to
+// the "nexus_audits" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithNexusAudits(opts ...func(*NexusAuditQuery)) *TenantQuery {
+	query := (&NexusAuditClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNexusAudits = query
+	return _q
+}
+
+// WithSuccessionMaps tells the query-builder to eager-load the nodes that are connected to
+// the "succession_maps" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithSuccessionMaps(opts ...func(*SuccessionMapQuery)) *TenantQuery {
+	query := (&SuccessionMapClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSuccessionMaps = query
+	return _q
+}
+
+// WithCustomerAccount tells the query-builder to eager-load the nodes that are connected to
+// the "customer_account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithCustomerAccount(opts ...func(*AccountQuery)) *TenantQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCustomerAccount = query
+	return _q
+}
+
+// WithScripts tells the query-builder to eager-load the nodes that are connected to
+// the "scripts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithScripts(opts ...func(*ScriptQuery)) *TenantQuery {
 	query := (&ScriptClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
@@ -2957,6 +3711,116 @@ func (_q *TenantQuery) WithBenefitEnrollments(opts ...func(*BenefitEnrollmentQue
 	return _q
 }
 
+// WithPosShifts tells the query-builder to eager-load the nodes that are connected to
+// the "pos_shifts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithPosShifts(opts ...func(*PosShiftQuery)) *TenantQuery {
+	query := (&PosShiftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPosShifts = query
+	return _q
+}
+
+// WithLabelTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "label_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithLabelTemplates(opts ...func(*LabelTemplateQuery)) *TenantQuery {
+	query := (&LabelTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLabelTemplates = query
+	return _q
+}
+
+// WithTaxJurisdictions tells the query-builder to eager-load the nodes that are connected to
+// the "tax_jurisdictions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithTaxJurisdictions(opts ...func(*TaxJurisdictionQuery)) *TenantQuery {
+	query := (&TaxJurisdictionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTaxJurisdictions = query
+	return _q
+}
+
+// WithEinvoiceCredentials tells the query-builder to eager-load the nodes that are connected to
+// the "einvoice_credentials" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithEinvoiceCredentials(opts ...func(*EInvoiceCredentialQuery)) *TenantQuery {
+	query := (&EInvoiceCredentialClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEinvoiceCredentials = query
+	return _q
+}
+
+// WithEinvoices tells the query-builder to eager-load the nodes that are connected to
+// the "einvoices" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithEinvoices(opts ...func(*EInvoiceQuery)) *TenantQuery {
+	query := (&EInvoiceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEinvoices = query
+	return _q
+}
+
+// WithAgentEnrollmentTokens tells the query-builder to eager-load the nodes that are connected to
+// the "agent_enrollment_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithAgentEnrollmentTokens(opts ...func(*AgentEnrollmentTokenQuery)) *TenantQuery {
+	query := (&AgentEnrollmentTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAgentEnrollmentTokens = query
+	return _q
+}
+
+// WithAgentAuthority tells the query-builder to eager-load the nodes that are connected to
+// the "agent_authority" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithAgentAuthority(opts ...func(*AgentAuthorityQuery)) *TenantQuery {
+	query := (&AgentAuthorityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAgentAuthority = query
+	return _q
+}
+
+// WithDeviceGroups tells the query-builder to eager-load the nodes that are connected to
+// the "device_groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithDeviceGroups(opts ...func(*DeviceGroupQuery)) *TenantQuery {
+	query := (&DeviceGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDeviceGroups = query
+	return _q
+}
+
+// WithPatchPolicies tells the query-builder to eager-load the nodes that are connected to
+// the "patch_policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithPatchPolicies(opts ...func(*PatchPolicyQuery)) *TenantQuery {
+	query := (&PatchPolicyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPatchPolicies = query
+	return _q
+}
+
+// WithAlertRules tells the query-builder to eager-load the nodes that are connected to
+// the "alert_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithAlertRules(opts ...func(*AlertRuleQuery)) *TenantQuery {
+	query := (&AlertRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAlertRules = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -3036,7 +3900,7 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 		nodes       = []*Tenant{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [75]bool{
+		loadedTypes = [99]bool{
 			_q.withParent != nil,
 			_q.withChildren != nil,
 			_q.withUsers != nil,
@@ -3072,6 +3936,20 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			_q.withVaultShareLinks != nil,
 			_q.withJournalEntries != nil,
 			_q.withRecurringInvoices != nil,
+			_q.withExchangeRates != nil,
+			_q.withFiscalPeriods != nil,
+			_q.withBalanceSnapshots != nil,
+			_q.withBankStatements != nil,
+			_q.withBankStatementLines != nil,
+			_q.withBankRules != nil,
+			_q.withCustomers != nil,
+			_q.withInvoices != nil,
+			_q.withCustomerPayments != nil,
+			_q.withSupplierBills != nil,
+			_q.withPaymentRuns != nil,
+			_q.withGoodsReceipts != nil,
+			_q.withStockLevels != nil,
+			_q.withTransferOrders != nil,
 			_q.withInventoryReservations != nil,
 			_q.withDepartments != nil,
 			_q.withPermissions != nil,
@@ -3112,6 +3990,16 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			_q.withInterviews != nil,
 			_q.withBenefitPlans != nil,
 			_q.withBenefitEnrollments != nil,
+			_q.withPosShifts != nil,
+			_q.withLabelTemplates != nil,
+			_q.withTaxJurisdictions != nil,
+			_q.withEinvoiceCredentials != nil,
+			_q.withEinvoices != nil,
+			_q.withAgentEnrollmentTokens != nil,
+			_q.withAgentAuthority != nil,
+			_q.withDeviceGroups != nil,
+			_q.withPatchPolicies != nil,
+			_q.withAlertRules != nil,
 		}
 	)
 	if _q.withParent != nil || _q.withCustomerAccount != nil {
@@ -3387,6 +4275,108 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			return nil, err
 		}
 	}
+	if query := _q.withExchangeRates; query != nil {
+		if err := _q.loadExchangeRates(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.ExchangeRates = []*ExchangeRate{} },
+			func(n *Tenant, e *ExchangeRate) { n.Edges.ExchangeRates = append(n.Edges.ExchangeRates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFiscalPeriods; query != nil {
+		if err := _q.loadFiscalPeriods(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.FiscalPeriods = []*FiscalPeriod{} },
+			func(n *Tenant, e *FiscalPeriod) { n.Edges.FiscalPeriods = append(n.Edges.FiscalPeriods, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBalanceSnapshots; query != nil {
+		if err := _q.loadBalanceSnapshots(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.BalanceSnapshots = []*AccountBalanceSnapshot{} },
+			func(n *Tenant, e *AccountBalanceSnapshot) {
+				n.Edges.BalanceSnapshots = append(n.Edges.BalanceSnapshots, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBankStatements; query != nil {
+		if err := _q.loadBankStatements(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.BankStatements = []*BankStatement{} },
+			func(n *Tenant, e *BankStatement) { n.Edges.BankStatements = append(n.Edges.BankStatements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBankStatementLines; query != nil {
+		if err := _q.loadBankStatementLines(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.BankStatementLines = []*BankStatementLine{} },
+			func(n *Tenant, e *BankStatementLine) {
+				n.Edges.BankStatementLines = append(n.Edges.BankStatementLines, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBankRules; query != nil {
+		if err := _q.loadBankRules(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.BankRules = []*BankRule{} },
+			func(n *Tenant, e *BankRule) { n.Edges.BankRules = append(n.Edges.BankRules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCustomers; query != nil {
+		if err := _q.loadCustomers(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Customers = []*Customer{} },
+			func(n *Tenant, e *Customer) { n.Edges.Customers = append(n.Edges.Customers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvoices; query != nil {
+		if err := _q.loadInvoices(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Invoices = []*Invoice{} },
+			func(n *Tenant, e *Invoice) { n.Edges.Invoices = append(n.Edges.Invoices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCustomerPayments; query != nil {
+		if err := _q.loadCustomerPayments(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.CustomerPayments = []*CustomerPayment{} },
+			func(n *Tenant, e *CustomerPayment) { n.Edges.CustomerPayments = append(n.Edges.CustomerPayments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSupplierBills; query != nil {
+		if err := _q.loadSupplierBills(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.SupplierBills = []*SupplierBill{} },
+			func(n *Tenant, e *SupplierBill) { n.Edges.SupplierBills = append(n.Edges.SupplierBills, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPaymentRuns; query != nil {
+		if err := _q.loadPaymentRuns(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.PaymentRuns = []*PaymentRun{} },
+			func(n *Tenant, e *PaymentRun) { n.Edges.PaymentRuns = append(n.Edges.PaymentRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGoodsReceipts; query != nil {
+		if err := _q.loadGoodsReceipts(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.GoodsReceipts = []*GoodsReceipt{} },
+			func(n *Tenant, e *GoodsReceipt) { n.Edges.GoodsReceipts = append(n.Edges.GoodsReceipts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStockLevels; query != nil {
+		if err := _q.loadStockLevels(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.StockLevels = []*StockLevel{} },
+			func(n *Tenant, e *StockLevel) { n.Edges.StockLevels = append(n.Edges.StockLevels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransferOrders; query != nil {
+		if err := _q.loadTransferOrders(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.TransferOrders = []*TransferOrder{} },
+			func(n *Tenant, e *TransferOrder) { n.Edges.TransferOrders = append(n.Edges.TransferOrders, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withInventoryReservations; query != nil {
 		if err := _q.loadInventoryReservations(ctx, query, nodes,
 			func(n *Tenant) { n.Edges.InventoryReservations = []*InventoryReservation{} },
@@ -3674,6 +4664,79 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			return nil, err
 		}
 	}
+	if query := _q.withPosShifts; query != nil {
+		if err := _q.loadPosShifts(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.PosShifts = []*PosShift{} },
+			func(n *Tenant, e *PosShift) { n.Edges.PosShifts = append(n.Edges.PosShifts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLabelTemplates; query != nil {
+		if err := _q.loadLabelTemplates(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.LabelTemplates = []*LabelTemplate{} },
+			func(n *Tenant, e *LabelTemplate) { n.Edges.LabelTemplates = append(n.Edges.LabelTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTaxJurisdictions; query != nil {
+		if err := _q.loadTaxJurisdictions(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.TaxJurisdictions = []*TaxJurisdiction{} },
+			func(n *Tenant, e *TaxJurisdiction) { n.Edges.TaxJurisdictions = append(n.Edges.TaxJurisdictions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEinvoiceCredentials; query != nil {
+		if err := _q.loadEinvoiceCredentials(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.EinvoiceCredentials = []*EInvoiceCredential{} },
+			func(n *Tenant, e *EInvoiceCredential) {
+				n.Edges.EinvoiceCredentials = append(n.Edges.EinvoiceCredentials, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEinvoices; query != nil {
+		if err := _q.loadEinvoices(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Einvoices = []*EInvoice{} },
+			func(n *Tenant, e *EInvoice) { n.Edges.Einvoices = append(n.Edges.Einvoices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAgentEnrollmentTokens; query != nil {
+		if err := _q.loadAgentEnrollmentTokens(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.AgentEnrollmentTokens = []*AgentEnrollmentToken{} },
+			func(n *Tenant, e *AgentEnrollmentToken) {
+				n.Edges.AgentEnrollmentTokens = append(n.Edges.AgentEnrollmentTokens, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAgentAuthority; query != nil {
+		if err := _q.loadAgentAuthority(ctx, query, nodes, nil,
+			func(n *Tenant, e *AgentAuthority) { n.Edges.AgentAuthority = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDeviceGroups; query != nil {
+		if err := _q.loadDeviceGroups(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.DeviceGroups = []*DeviceGroup{} },
+			func(n *Tenant, e *DeviceGroup) { n.Edges.DeviceGroups = append(n.Edges.DeviceGroups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPatchPolicies; query != nil {
+		if err := _q.loadPatchPolicies(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.PatchPolicies = []*PatchPolicy{} },
+			func(n *Tenant, e *PatchPolicy) { n.Edges.PatchPolicies = append(n.Edges.PatchPolicies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAlertRules; query != nil {
+		if err := _q.loadAlertRules(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.AlertRules = []*AlertRule{} },
+			func(n *Tenant, e *AlertRule) { n.Edges.AlertRules = append(n.Edges.AlertRules, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -4720,19 +5783,453 @@ func (_q *TenantQuery) loadJournalEntries(ctx context.Context, query *JournalEnt
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.tenant_journal_entries
+		fk := n.tenant_journal_entries
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_journal_entries" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_journal_entries" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadRecurringInvoices(ctx context.Context, query *RecurringInvoiceQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *RecurringInvoice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RecurringInvoice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.RecurringInvoicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_recurring_invoices
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_recurring_invoices" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_recurring_invoices" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadExchangeRates(ctx context.Context, query *ExchangeRateQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *ExchangeRate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.ExchangeRatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_exchange_rates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_exchange_rates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_exchange_rates" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadFiscalPeriods(ctx context.Context, query *FiscalPeriodQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *FiscalPeriod)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.FiscalPeriod(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.FiscalPeriodsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_fiscal_periods
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_fiscal_periods" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_fiscal_periods" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadBalanceSnapshots(ctx context.Context, query *AccountBalanceSnapshotQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *AccountBalanceSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AccountBalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.BalanceSnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_balance_snapshots
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_balance_snapshots" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_balance_snapshots" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadBankStatements(ctx context.Context, query *BankStatementQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *BankStatement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BankStatement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.BankStatementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_bank_statements
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_bank_statements" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_bank_statements" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadBankStatementLines(ctx context.Context, query *BankStatementLineQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *BankStatementLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BankStatementLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.BankStatementLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_bank_statement_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_bank_statement_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_bank_statement_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadBankRules(ctx context.Context, query *BankRuleQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *BankRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BankRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.BankRulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_bank_rules
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_bank_rules" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_bank_rules" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadCustomers(ctx context.Context, query *CustomerQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Customer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.CustomersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_customers
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_customers" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_customers" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadInvoices(ctx context.Context, query *InvoiceQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Invoice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.InvoicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_invoices
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_invoices" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_invoices" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadCustomerPayments(ctx context.Context, query *CustomerPaymentQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *CustomerPayment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.CustomerPayment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.CustomerPaymentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_customer_payments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_customer_payments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_customer_payments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadSupplierBills(ctx context.Context, query *SupplierBillQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *SupplierBill)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SupplierBill(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.SupplierBillsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_supplier_bills
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_supplier_bills" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_supplier_bills" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadPaymentRuns(ctx context.Context, query *PaymentRunQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *PaymentRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PaymentRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.PaymentRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_payment_runs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_payment_runs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_payment_runs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadGoodsReceipts(ctx context.Context, query *GoodsReceiptQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *GoodsReceipt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GoodsReceipt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.GoodsReceiptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_goods_receipts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_goods_receipts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_goods_receipts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadStockLevels(ctx context.Context, query *StockLevelQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *StockLevel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StockLevel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.StockLevelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_stock_levels
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "tenant_journal_entries" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "tenant_stock_levels" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "tenant_journal_entries" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_stock_levels" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *TenantQuery) loadRecurringInvoices(ctx context.Context, query *RecurringInvoiceQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *RecurringInvoice)) error {
+func (_q *TenantQuery) loadTransferOrders(ctx context.Context, query *TransferOrderQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *TransferOrder)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Tenant)
 	for i := range nodes {
@@ -4743,21 +6240,21 @@ func (_q *TenantQuery) loadRecurringInvoices(ctx context.Context, query *Recurri
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.RecurringInvoice(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(tenant.RecurringInvoicesColumn), fks...))
+	query.Where(predicate.TransferOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.TransferOrdersColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.tenant_recurring_invoices
+		fk := n.tenant_transfer_orders
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "tenant_recurring_invoices" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "tenant_transfer_orders" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "tenant_recurring_invoices" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_transfer_orders" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -6004,6 +7501,313 @@ func (_q *TenantQuery) loadBenefitEnrollments(ctx context.Context, query *Benefi
 	}
 	return nil
 }
+func (_q *TenantQuery) loadPosShifts(ctx context.Context, query *PosShiftQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *PosShift)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PosShift(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.PosShiftsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_pos_shifts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_pos_shifts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_pos_shifts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadLabelTemplates(ctx context.Context, query *LabelTemplateQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *LabelTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.LabelTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.LabelTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_label_templates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_label_templates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_label_templates" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadTaxJurisdictions(ctx context.Context, query *TaxJurisdictionQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *TaxJurisdiction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TaxJurisdiction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.TaxJurisdictionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_tax_jurisdictions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_tax_jurisdictions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_tax_jurisdictions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadEinvoiceCredentials(ctx context.Context, query *EInvoiceCredentialQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *EInvoiceCredential)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.EInvoiceCredential(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.EinvoiceCredentialsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_einvoice_credentials
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_einvoice_credentials" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_einvoice_credentials" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadEinvoices(ctx context.Context, query *EInvoiceQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *EInvoice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.EInvoice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.EinvoicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_einvoices
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_einvoices" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_einvoices" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadAgentEnrollmentTokens(ctx context.Context, query *AgentEnrollmentTokenQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *AgentEnrollmentToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AgentEnrollmentToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.AgentEnrollmentTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_agent_enrollment_tokens
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_agent_enrollment_tokens" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_agent_enrollment_tokens" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadAgentAuthority(ctx context.Context, query *AgentAuthorityQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *AgentAuthority)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.AgentAuthority(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.AgentAuthorityColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_agent_authority
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_agent_authority" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_agent_authority" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadDeviceGroups(ctx context.Context, query *DeviceGroupQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *DeviceGroup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.DeviceGroup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.DeviceGroupsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_device_groups
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_device_groups" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_device_groups" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadPatchPolicies(ctx context.Context, query *PatchPolicyQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *PatchPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PatchPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.PatchPoliciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_patch_policies
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_patch_policies" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_patch_policies" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadAlertRules(ctx context.Context, query *AlertRuleQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *AlertRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AlertRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.AlertRulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tenant_alert_rules
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_alert_rules" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_alert_rules" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TenantQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -7,12 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
 	"sent/ent/agent"
+	"sent/ent/agentauthority"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/alertrule"
 	"sent/ent/application"
 	"sent/ent/asset"
 	"sent/ent/assetassignment"
 	"sent/ent/assettype"
 	"sent/ent/auditlog"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
 	"sent/ent/benefitenrollment"
 	"sent/ent/benefitplan"
 	"sent/ent/budgetforecast"
@@ -24,19 +31,29 @@ import (
 	"sent/ent/contact"
 	"sent/ent/contract"
 	"sent/ent/credential"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
 	"sent/ent/department"
 	"sent/ent/detectionevent"
+	"sent/ent/devicegroup"
 	"sent/ent/discoveryentry"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
 	"sent/ent/employee"
+	"sent/ent/exchangerate"
+	"sent/ent/fiscalperiod"
 	"sent/ent/goal"
+	"sent/ent/goodsreceipt"
 	"sent/ent/healthscoresnapshot"
 	"sent/ent/interview"
 	"sent/ent/inventorycount"
 	"sent/ent/inventoryreservation"
+	"sent/ent/invoice"
 	"sent/ent/ivrflow"
 	"sent/ent/job"
 	"sent/ent/jobposting"
 	"sent/ent/journalentry"
+	"sent/ent/labeltemplate"
 	"sent/ent/ledgerentry"
 	"sent/ent/legalhold"
 	"sent/ent/maintenanceschedule"
@@ -46,8 +63,11 @@ import (
 	"sent/ent/networkport"
 	"sent/ent/nexusaudit"
 	"sent/ent/onetimelink"
+	"sent/ent/patchpolicy"
+	"sent/ent/paymentrun"
 	"sent/ent/performancereview"
 	"sent/ent/permission"
+	"sent/ent/posshift"
 	"sent/ent/predicate"
 	"sent/ent/product"
 	"sent/ent/purchaseorder"
@@ -64,16 +84,20 @@ import (
 	"sent/ent/sop"
 	"sent/ent/stockalert"
 	"sent/ent/stockauditlog"
+	"sent/ent/stocklevel"
 	"sent/ent/stockmovement"
 	"sent/ent/strategicroadmap"
 	"sent/ent/successionmap"
 	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/taxjurisdiction"
 	"sent/ent/tenant"
 	"sent/ent/ticket"
 	"sent/ent/timeoffbalance"
 	"sent/ent/timeoffpolicy"
 	"sent/ent/timeoffrequest"
 	"sent/ent/transaction"
+	"sent/ent/transferorder"
 	"sent/ent/user"
 	"sent/ent/vaultitem"
 	"sent/ent/vaultsharelink"
@@ -172,6 +196,73 @@ func (_u *TenantUpdate) SetNillableTransactionLimit(v *decimal.Decimal) *TenantU
 	return _u
 }
 
+// SetFunctionalCurrency sets the "functional_currency" field.
+func (_u *TenantUpdate) SetFunctionalCurrency(v string) *TenantUpdate {
+	_u.mutation.SetFunctionalCurrency(v)
+	return _u
+}
+
+// SetNillableFunctionalCurrency sets the "functional_currency" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableFunctionalCurrency(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetFunctionalCurrency(*v)
+	}
+	return _u
+}
+
+// SetFiscalYearStartMonth sets the "fiscal_year_start_month" field.
+func (_u *TenantUpdate) SetFiscalYearStartMonth(v int) *TenantUpdate {
+	_u.mutation.ResetFiscalYearStartMonth()
+	_u.mutation.SetFiscalYearStartMonth(v)
+	return _u
+}
+
+// SetNillableFiscalYearStartMonth sets the "fiscal_year_start_month" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableFiscalYearStartMonth(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetFiscalYearStartMonth(*v)
+	}
+	return _u
+}
+
+// AddFiscalYearStartMonth adds value to the "fiscal_year_start_month" field.
+func (_u *TenantUpdate) AddFiscalYearStartMonth(v int) *TenantUpdate {
+	_u.mutation.AddFiscalYearStartMonth(v)
+	return _u
+}
+
+// SetTaxNumber sets the "tax_number" field.
+func (_u *TenantUpdate) SetTaxNumber(v string) *TenantUpdate {
+	_u.mutation.SetTaxNumber(v)
+	return _u
+}
+
+// SetNillableTaxNumber sets the "tax_number" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableTaxNumber(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetTaxNumber(*v)
+	}
+	return _u
+}
+
+// ClearTaxNumber clears the value of the "tax_number" field.
+func (_u *TenantUpdate) ClearTaxNumber() *TenantUpdate {
+	_u.mutation.ClearTaxNumber()
+	return _u
+}
+
+// SetLogo sets the "logo" field.
+func (_u *TenantUpdate) SetLogo(v []byte) *TenantUpdate {
+	_u.mutation.SetLogo(v)
+	return _u
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (_u *TenantUpdate) ClearLogo() *TenantUpdate {
+	_u.mutation.ClearLogo()
+	return _u
+}
+
 // SetParentID sets the "parent" edge to the Tenant entity by ID.
 func (_u *TenantUpdate) SetParentID(id int) *TenantUpdate {
 	_u.mutation.SetParentID(id)
@@ -701,6 +792,216 @@ func (_u *TenantUpdate) AddRecurringInvoices(v ...*RecurringInvoice) *TenantUpda
 	return _u.AddRecurringInvoiceIDs(ids...)
 }
 
+// AddExchangeRateIDs adds the "exchange_rates" edge to the ExchangeRate entity by IDs.
+func (_u *TenantUpdate) AddExchangeRateIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddExchangeRateIDs(ids...)
+	return _u
+}
+
+// AddExchangeRates adds the "exchange_rates" edges to the ExchangeRate entity.
+func (_u *TenantUpdate) AddExchangeRates(v ...*ExchangeRate) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExchangeRateIDs(ids...)
+}
+
+// AddFiscalPeriodIDs adds the "fiscal_periods" edge to the FiscalPeriod entity by IDs.
+func (_u *TenantUpdate) AddFiscalPeriodIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddFiscalPeriodIDs(ids...)
+	return _u
+}
+
+// AddFiscalPeriods adds the "fiscal_periods" edges to the FiscalPeriod entity.
+func (_u *TenantUpdate) AddFiscalPeriods(v ...*FiscalPeriod) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFiscalPeriodIDs(ids...)
+}
+
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the AccountBalanceSnapshot entity by IDs.
+func (_u *TenantUpdate) AddBalanceSnapshotIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_u *TenantUpdate) AddBalanceSnapshots(v ...*AccountBalanceSnapshot) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBalanceSnapshotIDs(ids...)
+}
+
+// AddBankStatementIDs adds the "bank_statements" edge to the BankStatement entity by IDs.
+func (_u *TenantUpdate) AddBankStatementIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddBankStatementIDs(ids...)
+	return _u
+}
+
+// AddBankStatements adds the "bank_statements" edges to the BankStatement entity.
+func (_u *TenantUpdate) AddBankStatements(v ...*BankStatement) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBankStatementIDs(ids...)
+}
+
+// AddBankStatementLineIDs adds the "bank_statement_lines" edge to the BankStatementLine entity by IDs.
+func (_u *TenantUpdate) AddBankStatementLineIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddBankStatementLineIDs(ids...)
+	return _u
+}
+
+// AddBankStatementLines adds the "bank_statement_lines" edges to the BankStatementLine entity.
+func (_u *TenantUpdate) AddBankStatementLines(v ...*BankStatementLine) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBankStatementLineIDs(ids...)
+}
+
+// AddBankRuleIDs adds the "bank_rules" edge to the BankRule entity by IDs.
+func (_u *TenantUpdate) AddBankRuleIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddBankRuleIDs(ids...)
+	return _u
+}
+
+// AddBankRules adds the "bank_rules" edges to the BankRule entity.
+func (_u *TenantUpdate) AddBankRules(v ...*BankRule) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBankRuleIDs(ids...)
+}
+
+// AddCustomerIDs adds the "customers" edge to the Customer entity by IDs.
+func (_u *TenantUpdate) AddCustomerIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddCustomerIDs(ids...)
+	return _u
+}
+
+// AddCustomers adds the "customers" edges to the Customer entity.
+func (_u *TenantUpdate) AddCustomers(v ...*Customer) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCustomerIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *TenantUpdate) AddInvoiceIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddInvoiceIDs(ids...)
+	return _u
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *TenantUpdate) AddInvoices(v ...*Invoice) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceIDs(ids...)
+}
+
+// AddCustomerPaymentIDs adds the "customer_payments" edge to the CustomerPayment entity by IDs.
+func (_u *TenantUpdate) AddCustomerPaymentIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddCustomerPaymentIDs(ids...)
+	return _u
+}
+
+// AddCustomerPayments adds the "customer_payments" edges to the CustomerPayment entity.
+func (_u *TenantUpdate) AddCustomerPayments(v ...*CustomerPayment) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCustomerPaymentIDs(ids...)
+}
+
+// AddSupplierBillIDs adds the "supplier_bills" edge to the SupplierBill entity by IDs.
+func (_u *TenantUpdate) AddSupplierBillIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddSupplierBillIDs(ids...)
+	return _u
+}
+
+// AddSupplierBills adds the "supplier_bills" edges to the SupplierBill entity.
+func (_u *TenantUpdate) AddSupplierBills(v ...*SupplierBill) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSupplierBillIDs(ids...)
+}
+
+// AddPaymentRunIDs adds the "payment_runs" edge to the PaymentRun entity by IDs.
+func (_u *TenantUpdate) AddPaymentRunIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddPaymentRunIDs(ids...)
+	return _u
+}
+
+// AddPaymentRuns adds the "payment_runs" edges to the PaymentRun entity.
+func (_u *TenantUpdate) AddPaymentRuns(v ...*PaymentRun) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentRunIDs(ids...)
+}
+
+// AddGoodsReceiptIDs adds the "goods_receipts" edge to the GoodsReceipt entity by IDs.
+func (_u *TenantUpdate) AddGoodsReceiptIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddGoodsReceiptIDs(ids...)
+	return _u
+}
+
+// AddGoodsReceipts adds the "goods_receipts" edges to the GoodsReceipt entity.
+func (_u *TenantUpdate) AddGoodsReceipts(v ...*GoodsReceipt) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoodsReceiptIDs(ids...)
+}
+
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_u *TenantUpdate) AddStockLevelIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddStockLevelIDs(ids...)
+	return _u
+}
+
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_u *TenantUpdate) AddStockLevels(v ...*StockLevel) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockLevelIDs(ids...)
+}
+
+// AddTransferOrderIDs adds the "transfer_orders" edge to the TransferOrder entity by IDs.
+func (_u *TenantUpdate) AddTransferOrderIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddTransferOrderIDs(ids...)
+	return _u
+}
+
+// AddTransferOrders adds the "transfer_orders" edges to the TransferOrder entity.
+func (_u *TenantUpdate) AddTransferOrders(v ...*TransferOrder) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransferOrderIDs(ids...)
+}
+
 // AddInventoryReservationIDs adds the "inventory_reservations" edge to the InventoryReservation entity by IDs.
 func (_u *TenantUpdate) AddInventoryReservationIDs(ids ...int) *TenantUpdate {
 	_u.mutation.AddInventoryReservationIDs(ids...)
@@ -1305,6 +1606,160 @@ func (_u *TenantUpdate) AddBenefitEnrollments(v ...*BenefitEnrollment) *TenantUp
 	return _u.AddBenefitEnrollmentIDs(ids...)
 }
 
+// AddPosShiftIDs adds the "pos_shifts" edge to the PosShift entity by IDs.
+func (_u *TenantUpdate) AddPosShiftIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddPosShiftIDs(ids...)
+	return _u
+}
+
+// AddPosShifts adds the "pos_shifts" edges to the PosShift entity.
+func (_u *TenantUpdate) AddPosShifts(v ...*PosShift) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPosShiftIDs(ids...)
+}
+
+// AddLabelTemplateIDs adds the "label_templates" edge to the LabelTemplate entity by IDs.
+func (_u *TenantUpdate) AddLabelTemplateIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddLabelTemplateIDs(ids...)
+	return _u
+}
+
+// AddLabelTemplates adds the "label_templates" edges to the LabelTemplate entity.
+func (_u *TenantUpdate) AddLabelTemplates(v ...*LabelTemplate) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLabelTemplateIDs(ids...)
+}
+
+// AddTaxJurisdictionIDs adds the "tax_jurisdictions" edge to the TaxJurisdiction entity by IDs.
+func (_u *TenantUpdate) AddTaxJurisdictionIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddTaxJurisdictionIDs(ids...)
+	return _u
+}
+
+// AddTaxJurisdictions adds the "tax_jurisdictions" edges to the TaxJurisdiction entity.
+func (_u *TenantUpdate) AddTaxJurisdictions(v ...*TaxJurisdiction) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaxJurisdictionIDs(ids...)
+}
+
+// AddEinvoiceCredentialIDs adds the "einvoice_credentials" edge to the EInvoiceCredential entity by IDs.
+func (_u *TenantUpdate) AddEinvoiceCredentialIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddEinvoiceCredentialIDs(ids...)
+	return _u
+}
+
+// AddEinvoiceCredentials adds the "einvoice_credentials" edges to the EInvoiceCredential entity.
+func (_u *TenantUpdate) AddEinvoiceCredentials(v ...*EInvoiceCredential) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEinvoiceCredentialIDs(ids...)
+}
+
+// AddEinvoiceIDs adds the "einvoices" edge to the EInvoice entity by IDs.
+func (_u *TenantUpdate) AddEinvoiceIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddEinvoiceIDs(ids...)
+	return _u
+}
+
+// AddEinvoices adds the "einvoices" edges to the EInvoice entity.
+func (_u *TenantUpdate) AddEinvoices(v ...*EInvoice) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEinvoiceIDs(ids...)
+}
+
+// AddAgentEnrollmentTokenIDs adds the "agent_enrollment_tokens" edge to the AgentEnrollmentToken entity by IDs.
+func (_u *TenantUpdate) AddAgentEnrollmentTokenIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddAgentEnrollmentTokenIDs(ids...)
+	return _u
+}
+
+// AddAgentEnrollmentTokens adds the "agent_enrollment_tokens" edges to the AgentEnrollmentToken entity.
+func (_u *TenantUpdate) AddAgentEnrollmentTokens(v ...*AgentEnrollmentToken) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAgentEnrollmentTokenIDs(ids...)
+}
+
+// SetAgentAuthorityID sets the "agent_authority" edge to the AgentAuthority entity by ID.
+func (_u *TenantUpdate) SetAgentAuthorityID(id int) *TenantUpdate {
+	_u.mutation.SetAgentAuthorityID(id)
+	return _u
+}
+
+// SetNillableAgentAuthorityID sets the "agent_authority" edge to the AgentAuthority entity by ID if the given value is not nil.
+func (_u *TenantUpdate) SetNillableAgentAuthorityID(id *int) *TenantUpdate {
+	if id != nil {
+		_u = _u.SetAgentAuthorityID(*id)
+	}
+	return _u
+}
+
+// SetAgentAuthority sets the "agent_authority" edge to the AgentAuthority entity.
+func (_u *TenantUpdate) SetAgentAuthority(v *AgentAuthority) *TenantUpdate {
+	return _u.SetAgentAuthorityID(v.ID)
+}
+
+// AddDeviceGroupIDs adds the "device_groups" edge to the DeviceGroup entity by IDs.
+func (_u *TenantUpdate) AddDeviceGroupIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddDeviceGroupIDs(ids...)
+	return _u
+}
+
+// AddDeviceGroups adds the "device_groups" edges to the DeviceGroup entity.
+func (_u *TenantUpdate) AddDeviceGroups(v ...*DeviceGroup) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeviceGroupIDs(ids...)
+}
+
+// AddPatchPolicyIDs adds the "patch_policies" edge to the PatchPolicy entity by IDs.
+func (_u *TenantUpdate) AddPatchPolicyIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddPatchPolicyIDs(ids...)
+	return _u
+}
+
+// AddPatchPolicies adds the "patch_policies" edges to the PatchPolicy entity.
+func (_u *TenantUpdate) AddPatchPolicies(v ...*PatchPolicy) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPatchPolicyIDs(ids...)
+}
+
+// AddAlertRuleIDs adds the "alert_rules" edge to the AlertRule entity by IDs.
+func (_u *TenantUpdate) AddAlertRuleIDs(ids ...int) *TenantUpdate {
+	_u.mutation.AddAlertRuleIDs(ids...)
+	return _u
+}
+
+// AddAlertRules adds the "alert_rules" edges to the AlertRule entity.
+func (_u *TenantUpdate) AddAlertRules(v ...*AlertRule) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAlertRuleIDs(ids...)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_u *TenantUpdate) Mutation() *TenantMutation {
 	return _u.mutation
@@ -2030,9 +2485,303 @@ func (_u *TenantUpdate) RemoveRecurringInvoices(v ...*RecurringInvoice) *TenantU
 	return _u.RemoveRecurringInvoiceIDs(ids...)
 }
 
-// ClearInventoryReservations clears all "inventory_reservations" edges to the InventoryReservation entity.
-func (_u *TenantUpdate) ClearInventoryReservations() *TenantUpdate {
-	_u.mutation.ClearInventoryReservations()
+// ClearExchangeRates clears all "exchange_rates" edges to the ExchangeRate entity.
+func (_u *TenantUpdate) ClearExchangeRates() *TenantUpdate {
+	_u.mutation.ClearExchangeRates()
+	return _u
+}
+
+// RemoveExchangeRateIDs removes the "exchange_rates" edge to ExchangeRate entities by IDs.
+func (_u *TenantUpdate) RemoveExchangeRateIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveExchangeRateIDs(ids...)
+	return _u
+}
+
+// RemoveExchangeRates removes "exchange_rates" edges to ExchangeRate entities.
+func (_u *TenantUpdate) RemoveExchangeRates(v ...*ExchangeRate) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExchangeRateIDs(ids...)
+}
+
+// ClearFiscalPeriods clears all "fiscal_periods" edges to the FiscalPeriod entity.
+func (_u *TenantUpdate) ClearFiscalPeriods() *TenantUpdate {
+	_u.mutation.ClearFiscalPeriods()
+	return _u
+}
+
+// RemoveFiscalPeriodIDs removes the "fiscal_periods" edge to FiscalPeriod entities by IDs.
+func (_u *TenantUpdate) RemoveFiscalPeriodIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveFiscalPeriodIDs(ids...)
+	return _u
+}
+
+// RemoveFiscalPeriods removes "fiscal_periods" edges to FiscalPeriod entities.
+func (_u *TenantUpdate) RemoveFiscalPeriods(v ...*FiscalPeriod) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFiscalPeriodIDs(ids...)
+}
+
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_u *TenantUpdate) ClearBalanceSnapshots() *TenantUpdate {
+	_u.mutation.ClearBalanceSnapshots()
+	return _u
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to AccountBalanceSnapshot entities by IDs.
+func (_u *TenantUpdate) RemoveBalanceSnapshotIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to AccountBalanceSnapshot entities.
+func (_u *TenantUpdate) RemoveBalanceSnapshots(v ...*AccountBalanceSnapshot) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearBankStatements clears all "bank_statements" edges to the BankStatement entity.
+func (_u *TenantUpdate) ClearBankStatements() *TenantUpdate {
+	_u.mutation.ClearBankStatements()
+	return _u
+}
+
+// RemoveBankStatementIDs removes the "bank_statements" edge to BankStatement entities by IDs.
+func (_u *TenantUpdate) RemoveBankStatementIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveBankStatementIDs(ids...)
+	return _u
+}
+
+// RemoveBankStatements removes "bank_statements" edges to BankStatement entities.
+func (_u *TenantUpdate) RemoveBankStatements(v ...*BankStatement) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankStatementIDs(ids...)
+}
+
+// ClearBankStatementLines clears all "bank_statement_lines" edges to the BankStatementLine entity.
+func (_u *TenantUpdate) ClearBankStatementLines() *TenantUpdate {
+	_u.mutation.ClearBankStatementLines()
+	return _u
+}
+
+// RemoveBankStatementLineIDs removes the "bank_statement_lines" edge to BankStatementLine entities by IDs.
+func (_u *TenantUpdate) RemoveBankStatementLineIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveBankStatementLineIDs(ids...)
+	return _u
+}
+
+// RemoveBankStatementLines removes "bank_statement_lines" edges to BankStatementLine entities.
+func (_u *TenantUpdate) RemoveBankStatementLines(v ...*BankStatementLine) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankStatementLineIDs(ids...)
+}
+
+// ClearBankRules clears all "bank_rules" edges to the BankRule entity.
+func (_u *TenantUpdate) ClearBankRules() *TenantUpdate {
+	_u.mutation.ClearBankRules()
+	return _u
+}
+
+// RemoveBankRuleIDs removes the "bank_rules" edge to BankRule entities by IDs.
+func (_u *TenantUpdate) RemoveBankRuleIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveBankRuleIDs(ids...)
+	return _u
+}
+
+// RemoveBankRules removes "bank_rules" edges to BankRule entities.
+func (_u *TenantUpdate) RemoveBankRules(v ...*BankRule) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankRuleIDs(ids...)
+}
+
+// ClearCustomers clears all "customers" edges to the Customer entity.
+func (_u *TenantUpdate) ClearCustomers() *TenantUpdate {
+	_u.mutation.ClearCustomers()
+	return _u
+}
+
+// RemoveCustomerIDs removes the "customers" edge to Customer entities by IDs.
+func (_u *TenantUpdate) RemoveCustomerIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveCustomerIDs(ids...)
+	return _u
+}
+
+// RemoveCustomers removes "customers" edges to Customer entities.
+func (_u *TenantUpdate) RemoveCustomers(v ...*Customer) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCustomerIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *TenantUpdate) ClearInvoices() *TenantUpdate {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *TenantUpdate) RemoveInvoiceIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *TenantUpdate) RemoveInvoices(v ...*Invoice) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// ClearCustomerPayments clears all "customer_payments" edges to the CustomerPayment entity.
+func (_u *TenantUpdate) ClearCustomerPayments() *TenantUpdate {
+	_u.mutation.ClearCustomerPayments()
+	return _u
+}
+
+// RemoveCustomerPaymentIDs removes the "customer_payments" edge to CustomerPayment entities by IDs.
+func (_u *TenantUpdate) RemoveCustomerPaymentIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveCustomerPaymentIDs(ids...)
+	return _u
+}
+
+// RemoveCustomerPayments removes "customer_payments" edges to CustomerPayment entities.
+func (_u *TenantUpdate) RemoveCustomerPayments(v ...*CustomerPayment) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCustomerPaymentIDs(ids...)
+}
+
+// ClearSupplierBills clears all "supplier_bills" edges to the SupplierBill entity.
+func (_u *TenantUpdate) ClearSupplierBills() *TenantUpdate {
+	_u.mutation.ClearSupplierBills()
+	return _u
+}
+
+// RemoveSupplierBillIDs removes the "supplier_bills" edge to SupplierBill entities by IDs.
+func (_u *TenantUpdate) RemoveSupplierBillIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveSupplierBillIDs(ids...)
+	return _u
+}
+
+// RemoveSupplierBills removes "supplier_bills" edges to SupplierBill entities.
+func (_u *TenantUpdate) RemoveSupplierBills(v ...*SupplierBill) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSupplierBillIDs(ids...)
+}
+
+// ClearPaymentRuns clears all "payment_runs" edges to the PaymentRun entity.
+func (_u *TenantUpdate) ClearPaymentRuns() *TenantUpdate {
+	_u.mutation.ClearPaymentRuns()
+	return _u
+}
+
+// RemovePaymentRunIDs removes the "payment_runs" edge to PaymentRun entities by IDs.
+func (_u *TenantUpdate) RemovePaymentRunIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemovePaymentRunIDs(ids...)
+	return _u
+}
+
+// RemovePaymentRuns removes "payment_runs" edges to PaymentRun entities.
+func (_u *TenantUpdate) RemovePaymentRuns(v ...*PaymentRun) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentRunIDs(ids...)
+}
+
+// ClearGoodsReceipts clears all "goods_receipts" edges to the GoodsReceipt entity.
+func (_u *TenantUpdate) ClearGoodsReceipts() *TenantUpdate {
+	_u.mutation.ClearGoodsReceipts()
+	return _u
+}
+
+// RemoveGoodsReceiptIDs removes the "goods_receipts" edge to GoodsReceipt entities by IDs.
+func (_u *TenantUpdate) RemoveGoodsReceiptIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveGoodsReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveGoodsReceipts removes "goods_receipts" edges to GoodsReceipt entities.
+func (_u *TenantUpdate) RemoveGoodsReceipts(v ...*GoodsReceipt) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoodsReceiptIDs(ids...)
+}
+
+// ClearStockLevels clears all "stock_levels" edges to the StockLevel entity.
+func (_u *TenantUpdate) ClearStockLevels() *TenantUpdate {
+	_u.mutation.ClearStockLevels()
+	return _u
+}
+
+// RemoveStockLevelIDs removes the "stock_levels" edge to StockLevel entities by IDs.
+func (_u *TenantUpdate) RemoveStockLevelIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveStockLevelIDs(ids...)
+	return _u
+}
+
+// RemoveStockLevels removes "stock_levels" edges to StockLevel entities.
+func (_u *TenantUpdate) RemoveStockLevels(v ...*StockLevel) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockLevelIDs(ids...)
+}
+
+// ClearTransferOrders clears all "transfer_orders" edges to the TransferOrder entity.
+func (_u *TenantUpdate) ClearTransferOrders() *TenantUpdate {
+	_u.mutation.ClearTransferOrders()
+	return _u
+}
+
+// RemoveTransferOrderIDs removes the "transfer_orders" edge to TransferOrder entities by IDs.
+func (_u *TenantUpdate) RemoveTransferOrderIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveTransferOrderIDs(ids...)
+	return _u
+}
+
+// RemoveTransferOrders removes "transfer_orders" edges to TransferOrder entities.
+func (_u *TenantUpdate) RemoveTransferOrders(v ...*TransferOrder) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransferOrderIDs(ids...)
+}
+
+// ClearInventoryReservations clears all "inventory_reservations" edges to the InventoryReservation entity.
+func (_u *TenantUpdate) ClearInventoryReservations() *TenantUpdate {
+	_u.mutation.ClearInventoryReservations()
 	return _u
 }
 
@@ -2855,65 +3604,294 @@ func (_u *TenantUpdate) RemoveBenefitEnrollments(v ...*BenefitEnrollment) *Tenan
 	return _u.RemoveBenefitEnrollmentIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *TenantUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearPosShifts clears all "pos_shifts" edges to the PosShift entity.
+func (_u *TenantUpdate) ClearPosShifts() *TenantUpdate {
+	_u.mutation.ClearPosShifts()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *TenantUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemovePosShiftIDs removes the "pos_shifts" edge to PosShift entities by IDs.
+func (_u *TenantUpdate) RemovePosShiftIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemovePosShiftIDs(ids...)
+	return _u
+}
+
+// RemovePosShifts removes "pos_shifts" edges to PosShift entities.
+func (_u *TenantUpdate) RemovePosShifts(v ...*PosShift) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemovePosShiftIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *TenantUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearLabelTemplates clears all "label_templates" edges to the LabelTemplate entity.
+func (_u *TenantUpdate) ClearLabelTemplates() *TenantUpdate {
+	_u.mutation.ClearLabelTemplates()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *TenantUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// RemoveLabelTemplateIDs removes the "label_templates" edge to LabelTemplate entities by IDs.
+func (_u *TenantUpdate) RemoveLabelTemplateIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveLabelTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveLabelTemplates removes "label_templates" edges to LabelTemplate entities.
+func (_u *TenantUpdate) RemoveLabelTemplates(v ...*LabelTemplate) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveLabelTemplateIDs(ids...)
 }
 
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *TenantUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantUpdate {
-	_u.modifiers = append(_u.modifiers, modifiers...)
+// ClearTaxJurisdictions clears all "tax_jurisdictions" edges to the TaxJurisdiction entity.
+func (_u *TenantUpdate) ClearTaxJurisdictions() *TenantUpdate {
+	_u.mutation.ClearTaxJurisdictions()
 	return _u
 }
 
-func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(tenant.FieldName, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Domain(); ok {
-		_spec.SetField(tenant.FieldDomain, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
+// RemoveTaxJurisdictionIDs removes the "tax_jurisdictions" edge to TaxJurisdiction entities by IDs.
+func (_u *TenantUpdate) RemoveTaxJurisdictionIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveTaxJurisdictionIDs(ids...)
+	return _u
+}
+
+// RemoveTaxJurisdictions removes "tax_jurisdictions" edges to TaxJurisdiction entities.
+func (_u *TenantUpdate) RemoveTaxJurisdictions(v ...*TaxJurisdiction) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Active(); ok {
-		_spec.SetField(tenant.FieldActive, field.TypeBool, value)
+	return _u.RemoveTaxJurisdictionIDs(ids...)
+}
+
+// ClearEinvoiceCredentials clears all "einvoice_credentials" edges to the EInvoiceCredential entity.
+func (_u *TenantUpdate) ClearEinvoiceCredentials() *TenantUpdate {
+	_u.mutation.ClearEinvoiceCredentials()
+	return _u
+}
+
+// RemoveEinvoiceCredentialIDs removes the "einvoice_credentials" edge to EInvoiceCredential entities by IDs.
+func (_u *TenantUpdate) RemoveEinvoiceCredentialIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveEinvoiceCredentialIDs(ids...)
+	return _u
+}
+
+// RemoveEinvoiceCredentials removes "einvoice_credentials" edges to EInvoiceCredential entities.
+func (_u *TenantUpdate) RemoveEinvoiceCredentials(v ...*EInvoiceCredential) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.TransactionLimit(); ok {
-		_spec.SetField(tenant.FieldTransactionLimit, field.TypeOther, value)
+	return _u.RemoveEinvoiceCredentialIDs(ids...)
+}
+
+// ClearEinvoices clears all "einvoices" edges to the EInvoice entity.
+func (_u *TenantUpdate) ClearEinvoices() *TenantUpdate {
+	_u.mutation.ClearEinvoices()
+	return _u
+}
+
+// RemoveEinvoiceIDs removes the "einvoices" edge to EInvoice entities by IDs.
+func (_u *TenantUpdate) RemoveEinvoiceIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveEinvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveEinvoices removes "einvoices" edges to EInvoice entities.
+func (_u *TenantUpdate) RemoveEinvoices(v ...*EInvoice) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.ParentCleared() {
-		edge := &sqlgraph.EdgeSpec{
+	return _u.RemoveEinvoiceIDs(ids...)
+}
+
+// ClearAgentEnrollmentTokens clears all "agent_enrollment_tokens" edges to the AgentEnrollmentToken entity.
+func (_u *TenantUpdate) ClearAgentEnrollmentTokens() *TenantUpdate {
+	_u.mutation.ClearAgentEnrollmentTokens()
+	return _u
+}
+
+// RemoveAgentEnrollmentTokenIDs removes the "agent_enrollment_tokens" edge to AgentEnrollmentToken entities by IDs.
+func (_u *TenantUpdate) RemoveAgentEnrollmentTokenIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveAgentEnrollmentTokenIDs(ids...)
+	return _u
+}
+
+// RemoveAgentEnrollmentTokens removes "agent_enrollment_tokens" edges to AgentEnrollmentToken entities.
+func (_u *TenantUpdate) RemoveAgentEnrollmentTokens(v ...*AgentEnrollmentToken) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAgentEnrollmentTokenIDs(ids...)
+}
+
+// ClearAgentAuthority clears the "agent_authority" edge to the AgentAuthority entity.
+func (_u *TenantUpdate) ClearAgentAuthority() *TenantUpdate {
+	_u.mutation.ClearAgentAuthority()
+	return _u
+}
+
+// ClearDeviceGroups clears all "device_groups" edges to the DeviceGroup entity.
+func (_u *TenantUpdate) ClearDeviceGroups() *TenantUpdate {
+	_u.mutation.ClearDeviceGroups()
+	return _u
+}
+
+// RemoveDeviceGroupIDs removes the "device_groups" edge to DeviceGroup entities by IDs.
+func (_u *TenantUpdate) RemoveDeviceGroupIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveDeviceGroupIDs(ids...)
+	return _u
+}
+
+// RemoveDeviceGroups removes "device_groups" edges to DeviceGroup entities.
+func (_u *TenantUpdate) RemoveDeviceGroups(v ...*DeviceGroup) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeviceGroupIDs(ids...)
+}
+
+// ClearPatchPolicies clears all "patch_policies" edges to the PatchPolicy entity.
+func (_u *TenantUpdate) ClearPatchPolicies() *TenantUpdate {
+	_u.mutation.ClearPatchPolicies()
+	return _u
+}
+
+// RemovePatchPolicyIDs removes the "patch_policies" edge to PatchPolicy entities by IDs.
+func (_u *TenantUpdate) RemovePatchPolicyIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemovePatchPolicyIDs(ids...)
+	return _u
+}
+
+// RemovePatchPolicies removes "patch_policies" edges to PatchPolicy entities.
+func (_u *TenantUpdate) RemovePatchPolicies(v ...*PatchPolicy) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePatchPolicyIDs(ids...)
+}
+
+// ClearAlertRules clears all "alert_rules" edges to the AlertRule entity.
+func (_u *TenantUpdate) ClearAlertRules() *TenantUpdate {
+	_u.mutation.ClearAlertRules()
+	return _u
+}
+
+// RemoveAlertRuleIDs removes the "alert_rules" edge to AlertRule entities by IDs.
+func (_u *TenantUpdate) RemoveAlertRuleIDs(ids ...int) *TenantUpdate {
+	_u.mutation.RemoveAlertRuleIDs(ids...)
+	return _u
+}
+
+// RemoveAlertRules removes "alert_rules" edges to AlertRule entities.
+func (_u *TenantUpdate) RemoveAlertRules(v ...*AlertRule) *TenantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAlertRuleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantUpdate) check() error {
+	if v, ok := _u.mutation.FiscalYearStartMonth(); ok {
+		if err := tenant.FiscalYearStartMonthValidator(v); err != nil {
+			return &ValidationError{Name: "fiscal_year_start_month", err: fmt.Errorf(`ent: validator failed for field "Tenant.fiscal_year_start_month": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(tenant.FieldDomain, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(tenant.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TransactionLimit(); ok {
+		_spec.SetField(tenant.FieldTransactionLimit, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FunctionalCurrency(); ok {
+		_spec.SetField(tenant.FieldFunctionalCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FiscalYearStartMonth(); ok {
+		_spec.SetField(tenant.FieldFiscalYearStartMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFiscalYearStartMonth(); ok {
+		_spec.AddField(tenant.FieldFiscalYearStartMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TaxNumber(); ok {
+		_spec.SetField(tenant.FieldTaxNumber, field.TypeString, value)
+	}
+	if _u.mutation.TaxNumberCleared() {
+		_spec.ClearField(tenant.FieldTaxNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.Logo(); ok {
+		_spec.SetField(tenant.FieldLogo, field.TypeBytes, value)
+	}
+	if _u.mutation.LogoCleared() {
+		_spec.ClearField(tenant.FieldLogo, field.TypeBytes)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   tenant.ParentTable,
@@ -4471,28 +5449,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InventoryReservationsCleared() {
+	if _u.mutation.ExchangeRatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryReservationsTable,
-			Columns: []string{tenant.InventoryReservationsColumn},
+			Table:   tenant.ExchangeRatesTable,
+			Columns: []string{tenant.ExchangeRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInventoryReservationsIDs(); len(nodes) > 0 && !_u.mutation.InventoryReservationsCleared() {
+	if nodes := _u.mutation.RemovedExchangeRatesIDs(); len(nodes) > 0 && !_u.mutation.ExchangeRatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryReservationsTable,
-			Columns: []string{tenant.InventoryReservationsColumn},
+			Table:   tenant.ExchangeRatesTable,
+			Columns: []string{tenant.ExchangeRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4500,15 +5478,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InventoryReservationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ExchangeRatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryReservationsTable,
-			Columns: []string{tenant.InventoryReservationsColumn},
+			Table:   tenant.ExchangeRatesTable,
+			Columns: []string{tenant.ExchangeRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4516,28 +5494,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DepartmentsCleared() {
+	if _u.mutation.FiscalPeriodsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DepartmentsTable,
-			Columns: []string{tenant.DepartmentsColumn},
+			Table:   tenant.FiscalPeriodsTable,
+			Columns: []string{tenant.FiscalPeriodsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedDepartmentsIDs(); len(nodes) > 0 && !_u.mutation.DepartmentsCleared() {
+	if nodes := _u.mutation.RemovedFiscalPeriodsIDs(); len(nodes) > 0 && !_u.mutation.FiscalPeriodsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DepartmentsTable,
-			Columns: []string{tenant.DepartmentsColumn},
+			Table:   tenant.FiscalPeriodsTable,
+			Columns: []string{tenant.FiscalPeriodsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4545,15 +5523,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.DepartmentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.FiscalPeriodsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DepartmentsTable,
-			Columns: []string{tenant.DepartmentsColumn},
+			Table:   tenant.FiscalPeriodsTable,
+			Columns: []string{tenant.FiscalPeriodsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4561,28 +5539,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PermissionsCleared() {
+	if _u.mutation.BalanceSnapshotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PermissionsTable,
-			Columns: []string{tenant.PermissionsColumn},
+			Table:   tenant.BalanceSnapshotsTable,
+			Columns: []string{tenant.BalanceSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !_u.mutation.PermissionsCleared() {
+	if nodes := _u.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.BalanceSnapshotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PermissionsTable,
-			Columns: []string{tenant.PermissionsColumn},
+			Table:   tenant.BalanceSnapshotsTable,
+			Columns: []string{tenant.BalanceSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4590,15 +5568,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PermissionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PermissionsTable,
-			Columns: []string{tenant.PermissionsColumn},
+			Table:   tenant.BalanceSnapshotsTable,
+			Columns: []string{tenant.BalanceSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4606,28 +5584,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssetTypesCleared() {
+	if _u.mutation.BankStatementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetTypesTable,
-			Columns: []string{tenant.AssetTypesColumn},
+			Table:   tenant.BankStatementsTable,
+			Columns: []string{tenant.BankStatementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssetTypesIDs(); len(nodes) > 0 && !_u.mutation.AssetTypesCleared() {
+	if nodes := _u.mutation.RemovedBankStatementsIDs(); len(nodes) > 0 && !_u.mutation.BankStatementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetTypesTable,
-			Columns: []string{tenant.AssetTypesColumn},
+			Table:   tenant.BankStatementsTable,
+			Columns: []string{tenant.BankStatementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4635,15 +5613,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssetTypesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BankStatementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetTypesTable,
-			Columns: []string{tenant.AssetTypesColumn},
+			Table:   tenant.BankStatementsTable,
+			Columns: []string{tenant.BankStatementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4651,28 +5629,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DetectionEventsCleared() {
+	if _u.mutation.BankStatementLinesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DetectionEventsTable,
-			Columns: []string{tenant.DetectionEventsColumn},
+			Table:   tenant.BankStatementLinesTable,
+			Columns: []string{tenant.BankStatementLinesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedDetectionEventsIDs(); len(nodes) > 0 && !_u.mutation.DetectionEventsCleared() {
+	if nodes := _u.mutation.RemovedBankStatementLinesIDs(); len(nodes) > 0 && !_u.mutation.BankStatementLinesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DetectionEventsTable,
-			Columns: []string{tenant.DetectionEventsColumn},
+			Table:   tenant.BankStatementLinesTable,
+			Columns: []string{tenant.BankStatementLinesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4680,15 +5658,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.DetectionEventsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BankStatementLinesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DetectionEventsTable,
-			Columns: []string{tenant.DetectionEventsColumn},
+			Table:   tenant.BankStatementLinesTable,
+			Columns: []string{tenant.BankStatementLinesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4696,28 +5674,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SaasIdentitiesCleared() {
+	if _u.mutation.BankRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasIdentitiesTable,
-			Columns: []string{tenant.SaasIdentitiesColumn},
+			Table:   tenant.BankRulesTable,
+			Columns: []string{tenant.BankRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSaasIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.SaasIdentitiesCleared() {
+	if nodes := _u.mutation.RemovedBankRulesIDs(); len(nodes) > 0 && !_u.mutation.BankRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasIdentitiesTable,
-			Columns: []string{tenant.SaasIdentitiesColumn},
+			Table:   tenant.BankRulesTable,
+			Columns: []string{tenant.BankRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4725,15 +5703,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SaasIdentitiesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BankRulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasIdentitiesTable,
-			Columns: []string{tenant.SaasIdentitiesColumn},
+			Table:   tenant.BankRulesTable,
+			Columns: []string{tenant.BankRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4741,28 +5719,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SaasUsagesCleared() {
+	if _u.mutation.CustomersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasUsagesTable,
-			Columns: []string{tenant.SaasUsagesColumn},
+			Table:   tenant.CustomersTable,
+			Columns: []string{tenant.CustomersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSaasUsagesIDs(); len(nodes) > 0 && !_u.mutation.SaasUsagesCleared() {
+	if nodes := _u.mutation.RemovedCustomersIDs(); len(nodes) > 0 && !_u.mutation.CustomersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasUsagesTable,
-			Columns: []string{tenant.SaasUsagesColumn},
+			Table:   tenant.CustomersTable,
+			Columns: []string{tenant.CustomersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4770,15 +5748,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SaasUsagesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CustomersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasUsagesTable,
-			Columns: []string{tenant.SaasUsagesColumn},
+			Table:   tenant.CustomersTable,
+			Columns: []string{tenant.CustomersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4786,28 +5764,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RecordingsCleared() {
+	if _u.mutation.InvoicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecordingsTable,
-			Columns: []string{tenant.RecordingsColumn},
+			Table:   tenant.InvoicesTable,
+			Columns: []string{tenant.InvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRecordingsIDs(); len(nodes) > 0 && !_u.mutation.RecordingsCleared() {
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecordingsTable,
-			Columns: []string{tenant.RecordingsColumn},
+			Table:   tenant.InvoicesTable,
+			Columns: []string{tenant.InvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4815,15 +5793,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RecordingsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecordingsTable,
-			Columns: []string{tenant.RecordingsColumn},
+			Table:   tenant.InvoicesTable,
+			Columns: []string{tenant.InvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4831,28 +5809,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NetworkLinksCleared() {
+	if _u.mutation.CustomerPaymentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkLinksTable,
-			Columns: []string{tenant.NetworkLinksColumn},
+			Table:   tenant.CustomerPaymentsTable,
+			Columns: []string{tenant.CustomerPaymentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNetworkLinksIDs(); len(nodes) > 0 && !_u.mutation.NetworkLinksCleared() {
+	if nodes := _u.mutation.RemovedCustomerPaymentsIDs(); len(nodes) > 0 && !_u.mutation.CustomerPaymentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkLinksTable,
-			Columns: []string{tenant.NetworkLinksColumn},
+			Table:   tenant.CustomerPaymentsTable,
+			Columns: []string{tenant.CustomerPaymentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4860,15 +5838,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NetworkLinksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CustomerPaymentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkLinksTable,
-			Columns: []string{tenant.NetworkLinksColumn},
+			Table:   tenant.CustomerPaymentsTable,
+			Columns: []string{tenant.CustomerPaymentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4876,28 +5854,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NetworkPortsCleared() {
+	if _u.mutation.SupplierBillsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkPortsTable,
-			Columns: []string{tenant.NetworkPortsColumn},
+			Table:   tenant.SupplierBillsTable,
+			Columns: []string{tenant.SupplierBillsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNetworkPortsIDs(); len(nodes) > 0 && !_u.mutation.NetworkPortsCleared() {
+	if nodes := _u.mutation.RemovedSupplierBillsIDs(); len(nodes) > 0 && !_u.mutation.SupplierBillsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkPortsTable,
-			Columns: []string{tenant.NetworkPortsColumn},
+			Table:   tenant.SupplierBillsTable,
+			Columns: []string{tenant.SupplierBillsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4905,15 +5883,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NetworkPortsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SupplierBillsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkPortsTable,
-			Columns: []string{tenant.NetworkPortsColumn},
+			Table:   tenant.SupplierBillsTable,
+			Columns: []string{tenant.SupplierBillsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4921,28 +5899,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NexusAuditsCleared() {
+	if _u.mutation.PaymentRunsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NexusAuditsTable,
-			Columns: []string{tenant.NexusAuditsColumn},
+			Table:   tenant.PaymentRunsTable,
+			Columns: []string{tenant.PaymentRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNexusAuditsIDs(); len(nodes) > 0 && !_u.mutation.NexusAuditsCleared() {
+	if nodes := _u.mutation.RemovedPaymentRunsIDs(); len(nodes) > 0 && !_u.mutation.PaymentRunsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NexusAuditsTable,
-			Columns: []string{tenant.NexusAuditsColumn},
+			Table:   tenant.PaymentRunsTable,
+			Columns: []string{tenant.PaymentRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4950,15 +5928,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NexusAuditsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PaymentRunsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NexusAuditsTable,
-			Columns: []string{tenant.NexusAuditsColumn},
+			Table:   tenant.PaymentRunsTable,
+			Columns: []string{tenant.PaymentRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4966,28 +5944,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SuccessionMapsCleared() {
+	if _u.mutation.GoodsReceiptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuccessionMapsTable,
-			Columns: []string{tenant.SuccessionMapsColumn},
+			Table:   tenant.GoodsReceiptsTable,
+			Columns: []string{tenant.GoodsReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSuccessionMapsIDs(); len(nodes) > 0 && !_u.mutation.SuccessionMapsCleared() {
+	if nodes := _u.mutation.RemovedGoodsReceiptsIDs(); len(nodes) > 0 && !_u.mutation.GoodsReceiptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuccessionMapsTable,
-			Columns: []string{tenant.SuccessionMapsColumn},
+			Table:   tenant.GoodsReceiptsTable,
+			Columns: []string{tenant.GoodsReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4995,15 +5973,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SuccessionMapsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.GoodsReceiptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuccessionMapsTable,
-			Columns: []string{tenant.SuccessionMapsColumn},
+			Table:   tenant.GoodsReceiptsTable,
+			Columns: []string{tenant.GoodsReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5011,28 +5989,44 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CustomerAccountCleared() {
+	if _u.mutation.StockLevelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CustomerAccountTable,
-			Columns: []string{tenant.CustomerAccountColumn},
+			Table:   tenant.StockLevelsTable,
+			Columns: []string{tenant.StockLevelsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CustomerAccountIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedStockLevelsIDs(); len(nodes) > 0 && !_u.mutation.StockLevelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CustomerAccountTable,
-			Columns: []string{tenant.CustomerAccountColumn},
+			Table:   tenant.StockLevelsTable,
+			Columns: []string{tenant.StockLevelsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockLevelsTable,
+			Columns: []string{tenant.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5040,28 +6034,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ScriptsCleared() {
+	if _u.mutation.TransferOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScriptsTable,
-			Columns: []string{tenant.ScriptsColumn},
+			Table:   tenant.TransferOrdersTable,
+			Columns: []string{tenant.TransferOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedScriptsIDs(); len(nodes) > 0 && !_u.mutation.ScriptsCleared() {
+	if nodes := _u.mutation.RemovedTransferOrdersIDs(); len(nodes) > 0 && !_u.mutation.TransferOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScriptsTable,
-			Columns: []string{tenant.ScriptsColumn},
+			Table:   tenant.TransferOrdersTable,
+			Columns: []string{tenant.TransferOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5069,15 +6063,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ScriptsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TransferOrdersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScriptsTable,
-			Columns: []string{tenant.ScriptsColumn},
+			Table:   tenant.TransferOrdersTable,
+			Columns: []string{tenant.TransferOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5085,28 +6079,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.JobsCleared() {
+	if _u.mutation.InventoryReservationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobsTable,
-			Columns: []string{tenant.JobsColumn},
+			Table:   tenant.InventoryReservationsTable,
+			Columns: []string{tenant.InventoryReservationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+	if nodes := _u.mutation.RemovedInventoryReservationsIDs(); len(nodes) > 0 && !_u.mutation.InventoryReservationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobsTable,
-			Columns: []string{tenant.JobsColumn},
+			Table:   tenant.InventoryReservationsTable,
+			Columns: []string{tenant.InventoryReservationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5114,15 +6108,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.InventoryReservationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobsTable,
-			Columns: []string{tenant.JobsColumn},
+			Table:   tenant.InventoryReservationsTable,
+			Columns: []string{tenant.InventoryReservationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5130,28 +6124,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TimeOffRequestsCleared() {
+	if _u.mutation.DepartmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffRequestsTable,
-			Columns: []string{tenant.TimeOffRequestsColumn},
+			Table:   tenant.DepartmentsTable,
+			Columns: []string{tenant.DepartmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTimeOffRequestsIDs(); len(nodes) > 0 && !_u.mutation.TimeOffRequestsCleared() {
+	if nodes := _u.mutation.RemovedDepartmentsIDs(); len(nodes) > 0 && !_u.mutation.DepartmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffRequestsTable,
-			Columns: []string{tenant.TimeOffRequestsColumn},
+			Table:   tenant.DepartmentsTable,
+			Columns: []string{tenant.DepartmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5159,15 +6153,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TimeOffRequestsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DepartmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffRequestsTable,
-			Columns: []string{tenant.TimeOffRequestsColumn},
+			Table:   tenant.DepartmentsTable,
+			Columns: []string{tenant.DepartmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5175,28 +6169,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TimeOffPoliciesCleared() {
+	if _u.mutation.PermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffPoliciesTable,
-			Columns: []string{tenant.TimeOffPoliciesColumn},
+			Table:   tenant.PermissionsTable,
+			Columns: []string{tenant.PermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTimeOffPoliciesIDs(); len(nodes) > 0 && !_u.mutation.TimeOffPoliciesCleared() {
+	if nodes := _u.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !_u.mutation.PermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffPoliciesTable,
-			Columns: []string{tenant.TimeOffPoliciesColumn},
+			Table:   tenant.PermissionsTable,
+			Columns: []string{tenant.PermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5204,15 +6198,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TimeOffPoliciesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffPoliciesTable,
-			Columns: []string{tenant.TimeOffPoliciesColumn},
+			Table:   tenant.PermissionsTable,
+			Columns: []string{tenant.PermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5220,28 +6214,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TimeOffBalancesCleared() {
+	if _u.mutation.AssetTypesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffBalancesTable,
-			Columns: []string{tenant.TimeOffBalancesColumn},
+			Table:   tenant.AssetTypesTable,
+			Columns: []string{tenant.AssetTypesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTimeOffBalancesIDs(); len(nodes) > 0 && !_u.mutation.TimeOffBalancesCleared() {
+	if nodes := _u.mutation.RemovedAssetTypesIDs(); len(nodes) > 0 && !_u.mutation.AssetTypesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffBalancesTable,
-			Columns: []string{tenant.TimeOffBalancesColumn},
+			Table:   tenant.AssetTypesTable,
+			Columns: []string{tenant.AssetTypesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5249,15 +6243,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TimeOffBalancesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AssetTypesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffBalancesTable,
-			Columns: []string{tenant.TimeOffBalancesColumn},
+			Table:   tenant.AssetTypesTable,
+			Columns: []string{tenant.AssetTypesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5265,28 +6259,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReviewCyclesCleared() {
+	if _u.mutation.DetectionEventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ReviewCyclesTable,
-			Columns: []string{tenant.ReviewCyclesColumn},
+			Table:   tenant.DetectionEventsTable,
+			Columns: []string{tenant.DetectionEventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReviewCyclesIDs(); len(nodes) > 0 && !_u.mutation.ReviewCyclesCleared() {
+	if nodes := _u.mutation.RemovedDetectionEventsIDs(); len(nodes) > 0 && !_u.mutation.DetectionEventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ReviewCyclesTable,
-			Columns: []string{tenant.ReviewCyclesColumn},
+			Table:   tenant.DetectionEventsTable,
+			Columns: []string{tenant.DetectionEventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5294,15 +6288,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReviewCyclesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DetectionEventsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ReviewCyclesTable,
-			Columns: []string{tenant.ReviewCyclesColumn},
+			Table:   tenant.DetectionEventsTable,
+			Columns: []string{tenant.DetectionEventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5310,28 +6304,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PerformanceReviewsCleared() {
+	if _u.mutation.SaasIdentitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PerformanceReviewsTable,
-			Columns: []string{tenant.PerformanceReviewsColumn},
+			Table:   tenant.SaasIdentitiesTable,
+			Columns: []string{tenant.SaasIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPerformanceReviewsIDs(); len(nodes) > 0 && !_u.mutation.PerformanceReviewsCleared() {
+	if nodes := _u.mutation.RemovedSaasIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.SaasIdentitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PerformanceReviewsTable,
-			Columns: []string{tenant.PerformanceReviewsColumn},
+			Table:   tenant.SaasIdentitiesTable,
+			Columns: []string{tenant.SaasIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5339,15 +6333,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PerformanceReviewsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SaasIdentitiesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PerformanceReviewsTable,
-			Columns: []string{tenant.PerformanceReviewsColumn},
+			Table:   tenant.SaasIdentitiesTable,
+			Columns: []string{tenant.SaasIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5355,28 +6349,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.GoalsCleared() {
+	if _u.mutation.SaasUsagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.GoalsTable,
-			Columns: []string{tenant.GoalsColumn},
+			Table:   tenant.SaasUsagesTable,
+			Columns: []string{tenant.SaasUsagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !_u.mutation.GoalsCleared() {
+	if nodes := _u.mutation.RemovedSaasUsagesIDs(); len(nodes) > 0 && !_u.mutation.SaasUsagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.GoalsTable,
-			Columns: []string{tenant.GoalsColumn},
+			Table:   tenant.SaasUsagesTable,
+			Columns: []string{tenant.SaasUsagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5384,15 +6378,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.GoalsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SaasUsagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.GoalsTable,
-			Columns: []string{tenant.GoalsColumn},
+			Table:   tenant.SaasUsagesTable,
+			Columns: []string{tenant.SaasUsagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5400,28 +6394,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SuppliersCleared() {
+	if _u.mutation.RecordingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuppliersTable,
-			Columns: []string{tenant.SuppliersColumn},
+			Table:   tenant.RecordingsTable,
+			Columns: []string{tenant.RecordingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSuppliersIDs(); len(nodes) > 0 && !_u.mutation.SuppliersCleared() {
+	if nodes := _u.mutation.RemovedRecordingsIDs(); len(nodes) > 0 && !_u.mutation.RecordingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuppliersTable,
-			Columns: []string{tenant.SuppliersColumn},
+			Table:   tenant.RecordingsTable,
+			Columns: []string{tenant.RecordingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5429,15 +6423,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SuppliersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RecordingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuppliersTable,
-			Columns: []string{tenant.SuppliersColumn},
+			Table:   tenant.RecordingsTable,
+			Columns: []string{tenant.RecordingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5445,28 +6439,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CategoriesCleared() {
+	if _u.mutation.NetworkLinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CategoriesTable,
-			Columns: []string{tenant.CategoriesColumn},
+			Table:   tenant.NetworkLinksTable,
+			Columns: []string{tenant.NetworkLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
+	if nodes := _u.mutation.RemovedNetworkLinksIDs(); len(nodes) > 0 && !_u.mutation.NetworkLinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CategoriesTable,
-			Columns: []string{tenant.CategoriesColumn},
+			Table:   tenant.NetworkLinksTable,
+			Columns: []string{tenant.NetworkLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5474,15 +6468,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NetworkLinksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CategoriesTable,
-			Columns: []string{tenant.CategoriesColumn},
+			Table:   tenant.NetworkLinksTable,
+			Columns: []string{tenant.NetworkLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5490,28 +6484,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.WarehousesCleared() {
+	if _u.mutation.NetworkPortsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.WarehousesTable,
-			Columns: []string{tenant.WarehousesColumn},
+			Table:   tenant.NetworkPortsTable,
+			Columns: []string{tenant.NetworkPortsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedWarehousesIDs(); len(nodes) > 0 && !_u.mutation.WarehousesCleared() {
+	if nodes := _u.mutation.RemovedNetworkPortsIDs(); len(nodes) > 0 && !_u.mutation.NetworkPortsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.WarehousesTable,
-			Columns: []string{tenant.WarehousesColumn},
+			Table:   tenant.NetworkPortsTable,
+			Columns: []string{tenant.NetworkPortsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5519,15 +6513,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.WarehousesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NetworkPortsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.WarehousesTable,
-			Columns: []string{tenant.WarehousesColumn},
+			Table:   tenant.NetworkPortsTable,
+			Columns: []string{tenant.NetworkPortsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5535,28 +6529,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssetAssignmentsCleared() {
+	if _u.mutation.NexusAuditsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetAssignmentsTable,
-			Columns: []string{tenant.AssetAssignmentsColumn},
+			Table:   tenant.NexusAuditsTable,
+			Columns: []string{tenant.NexusAuditsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssetAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssetAssignmentsCleared() {
+	if nodes := _u.mutation.RemovedNexusAuditsIDs(); len(nodes) > 0 && !_u.mutation.NexusAuditsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetAssignmentsTable,
-			Columns: []string{tenant.AssetAssignmentsColumn},
+			Table:   tenant.NexusAuditsTable,
+			Columns: []string{tenant.NexusAuditsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5564,15 +6558,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssetAssignmentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NexusAuditsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetAssignmentsTable,
-			Columns: []string{tenant.AssetAssignmentsColumn},
+			Table:   tenant.NexusAuditsTable,
+			Columns: []string{tenant.NexusAuditsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5580,28 +6574,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ContactsCleared() {
+	if _u.mutation.SuccessionMapsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContactsTable,
-			Columns: []string{tenant.ContactsColumn},
+			Table:   tenant.SuccessionMapsTable,
+			Columns: []string{tenant.SuccessionMapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedContactsIDs(); len(nodes) > 0 && !_u.mutation.ContactsCleared() {
+	if nodes := _u.mutation.RemovedSuccessionMapsIDs(); len(nodes) > 0 && !_u.mutation.SuccessionMapsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContactsTable,
-			Columns: []string{tenant.ContactsColumn},
+			Table:   tenant.SuccessionMapsTable,
+			Columns: []string{tenant.SuccessionMapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5609,15 +6603,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ContactsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SuccessionMapsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContactsTable,
-			Columns: []string{tenant.ContactsColumn},
+			Table:   tenant.SuccessionMapsTable,
+			Columns: []string{tenant.SuccessionMapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5625,44 +6619,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LegalHoldsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   tenant.LegalHoldsTable,
-			Columns: []string{tenant.LegalHoldsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedLegalHoldsIDs(); len(nodes) > 0 && !_u.mutation.LegalHoldsCleared() {
+	if _u.mutation.CustomerAccountCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   tenant.LegalHoldsTable,
-			Columns: []string{tenant.LegalHoldsColumn},
+			Table:   tenant.CustomerAccountTable,
+			Columns: []string{tenant.CustomerAccountColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CustomerAccountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   tenant.LegalHoldsTable,
-			Columns: []string{tenant.LegalHoldsColumn},
+			Table:   tenant.CustomerAccountTable,
+			Columns: []string{tenant.CustomerAccountColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5670,28 +6648,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RetentionPoliciesCleared() {
+	if _u.mutation.ScriptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RetentionPoliciesTable,
-			Columns: []string{tenant.RetentionPoliciesColumn},
+			Table:   tenant.ScriptsTable,
+			Columns: []string{tenant.ScriptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRetentionPoliciesIDs(); len(nodes) > 0 && !_u.mutation.RetentionPoliciesCleared() {
+	if nodes := _u.mutation.RemovedScriptsIDs(); len(nodes) > 0 && !_u.mutation.ScriptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RetentionPoliciesTable,
-			Columns: []string{tenant.RetentionPoliciesColumn},
+			Table:   tenant.ScriptsTable,
+			Columns: []string{tenant.ScriptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5699,15 +6677,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RetentionPoliciesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ScriptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RetentionPoliciesTable,
-			Columns: []string{tenant.RetentionPoliciesColumn},
+			Table:   tenant.ScriptsTable,
+			Columns: []string{tenant.ScriptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5715,28 +6693,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.VaultTemplatesCleared() {
+	if _u.mutation.JobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultTemplatesTable,
-			Columns: []string{tenant.VaultTemplatesColumn},
+			Table:   tenant.JobsTable,
+			Columns: []string{tenant.JobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedVaultTemplatesIDs(); len(nodes) > 0 && !_u.mutation.VaultTemplatesCleared() {
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultTemplatesTable,
-			Columns: []string{tenant.VaultTemplatesColumn},
+			Table:   tenant.JobsTable,
+			Columns: []string{tenant.JobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5744,15 +6722,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.VaultTemplatesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultTemplatesTable,
-			Columns: []string{tenant.VaultTemplatesColumn},
+			Table:   tenant.JobsTable,
+			Columns: []string{tenant.JobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5760,28 +6738,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.StockAuditLogsCleared() {
+	if _u.mutation.TimeOffRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAuditLogsTable,
-			Columns: []string{tenant.StockAuditLogsColumn},
+			Table:   tenant.TimeOffRequestsTable,
+			Columns: []string{tenant.TimeOffRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedStockAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.StockAuditLogsCleared() {
+	if nodes := _u.mutation.RemovedTimeOffRequestsIDs(); len(nodes) > 0 && !_u.mutation.TimeOffRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAuditLogsTable,
-			Columns: []string{tenant.StockAuditLogsColumn},
+			Table:   tenant.TimeOffRequestsTable,
+			Columns: []string{tenant.TimeOffRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5789,15 +6767,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.StockAuditLogsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TimeOffRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAuditLogsTable,
-			Columns: []string{tenant.StockAuditLogsColumn},
+			Table:   tenant.TimeOffRequestsTable,
+			Columns: []string{tenant.TimeOffRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5805,28 +6783,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MaintenanceSchedulesCleared() {
+	if _u.mutation.TimeOffPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MaintenanceSchedulesTable,
-			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Table:   tenant.TimeOffPoliciesTable,
+			Columns: []string{tenant.TimeOffPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMaintenanceSchedulesIDs(); len(nodes) > 0 && !_u.mutation.MaintenanceSchedulesCleared() {
+	if nodes := _u.mutation.RemovedTimeOffPoliciesIDs(); len(nodes) > 0 && !_u.mutation.TimeOffPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MaintenanceSchedulesTable,
-			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Table:   tenant.TimeOffPoliciesTable,
+			Columns: []string{tenant.TimeOffPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5834,15 +6812,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MaintenanceSchedulesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TimeOffPoliciesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MaintenanceSchedulesTable,
-			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Table:   tenant.TimeOffPoliciesTable,
+			Columns: []string{tenant.TimeOffPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5850,28 +6828,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.StockAlertsCleared() {
+	if _u.mutation.TimeOffBalancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAlertsTable,
-			Columns: []string{tenant.StockAlertsColumn},
+			Table:   tenant.TimeOffBalancesTable,
+			Columns: []string{tenant.TimeOffBalancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedStockAlertsIDs(); len(nodes) > 0 && !_u.mutation.StockAlertsCleared() {
+	if nodes := _u.mutation.RemovedTimeOffBalancesIDs(); len(nodes) > 0 && !_u.mutation.TimeOffBalancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAlertsTable,
-			Columns: []string{tenant.StockAlertsColumn},
+			Table:   tenant.TimeOffBalancesTable,
+			Columns: []string{tenant.TimeOffBalancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5879,15 +6857,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.StockAlertsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TimeOffBalancesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAlertsTable,
-			Columns: []string{tenant.StockAlertsColumn},
+			Table:   tenant.TimeOffBalancesTable,
+			Columns: []string{tenant.TimeOffBalancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5895,28 +6873,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PurchaseOrdersCleared() {
+	if _u.mutation.ReviewCyclesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PurchaseOrdersTable,
-			Columns: []string{tenant.PurchaseOrdersColumn},
+			Table:   tenant.ReviewCyclesTable,
+			Columns: []string{tenant.ReviewCyclesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPurchaseOrdersIDs(); len(nodes) > 0 && !_u.mutation.PurchaseOrdersCleared() {
+	if nodes := _u.mutation.RemovedReviewCyclesIDs(); len(nodes) > 0 && !_u.mutation.ReviewCyclesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PurchaseOrdersTable,
-			Columns: []string{tenant.PurchaseOrdersColumn},
+			Table:   tenant.ReviewCyclesTable,
+			Columns: []string{tenant.ReviewCyclesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5924,15 +6902,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PurchaseOrdersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReviewCyclesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PurchaseOrdersTable,
-			Columns: []string{tenant.PurchaseOrdersColumn},
+			Table:   tenant.ReviewCyclesTable,
+			Columns: []string{tenant.ReviewCyclesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5940,28 +6918,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InventoryCountsCleared() {
+	if _u.mutation.PerformanceReviewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryCountsTable,
-			Columns: []string{tenant.InventoryCountsColumn},
+			Table:   tenant.PerformanceReviewsTable,
+			Columns: []string{tenant.PerformanceReviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInventoryCountsIDs(); len(nodes) > 0 && !_u.mutation.InventoryCountsCleared() {
+	if nodes := _u.mutation.RemovedPerformanceReviewsIDs(); len(nodes) > 0 && !_u.mutation.PerformanceReviewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryCountsTable,
-			Columns: []string{tenant.InventoryCountsColumn},
+			Table:   tenant.PerformanceReviewsTable,
+			Columns: []string{tenant.PerformanceReviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5969,15 +6947,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InventoryCountsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PerformanceReviewsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryCountsTable,
-			Columns: []string{tenant.InventoryCountsColumn},
+			Table:   tenant.PerformanceReviewsTable,
+			Columns: []string{tenant.PerformanceReviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5985,28 +6963,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.JobPostingsCleared() {
+	if _u.mutation.GoalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobPostingsTable,
-			Columns: []string{tenant.JobPostingsColumn},
+			Table:   tenant.GoalsTable,
+			Columns: []string{tenant.GoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedJobPostingsIDs(); len(nodes) > 0 && !_u.mutation.JobPostingsCleared() {
+	if nodes := _u.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !_u.mutation.GoalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobPostingsTable,
-			Columns: []string{tenant.JobPostingsColumn},
+			Table:   tenant.GoalsTable,
+			Columns: []string{tenant.GoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6014,15 +6992,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.JobPostingsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.GoalsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobPostingsTable,
-			Columns: []string{tenant.JobPostingsColumn},
+			Table:   tenant.GoalsTable,
+			Columns: []string{tenant.GoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6030,28 +7008,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CandidatesCleared() {
+	if _u.mutation.SuppliersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CandidatesTable,
-			Columns: []string{tenant.CandidatesColumn},
+			Table:   tenant.SuppliersTable,
+			Columns: []string{tenant.SuppliersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCandidatesIDs(); len(nodes) > 0 && !_u.mutation.CandidatesCleared() {
+	if nodes := _u.mutation.RemovedSuppliersIDs(); len(nodes) > 0 && !_u.mutation.SuppliersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CandidatesTable,
-			Columns: []string{tenant.CandidatesColumn},
+			Table:   tenant.SuppliersTable,
+			Columns: []string{tenant.SuppliersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6059,15 +7037,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CandidatesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SuppliersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CandidatesTable,
-			Columns: []string{tenant.CandidatesColumn},
+			Table:   tenant.SuppliersTable,
+			Columns: []string{tenant.SuppliersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6075,28 +7053,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ApplicationsCleared() {
+	if _u.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ApplicationsTable,
-			Columns: []string{tenant.ApplicationsColumn},
+			Table:   tenant.CategoriesTable,
+			Columns: []string{tenant.CategoriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedApplicationsIDs(); len(nodes) > 0 && !_u.mutation.ApplicationsCleared() {
+	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ApplicationsTable,
-			Columns: []string{tenant.ApplicationsColumn},
+			Table:   tenant.CategoriesTable,
+			Columns: []string{tenant.CategoriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6104,15 +7082,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ApplicationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ApplicationsTable,
-			Columns: []string{tenant.ApplicationsColumn},
+			Table:   tenant.CategoriesTable,
+			Columns: []string{tenant.CategoriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6120,28 +7098,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InterviewsCleared() {
+	if _u.mutation.WarehousesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InterviewsTable,
-			Columns: []string{tenant.InterviewsColumn},
+			Table:   tenant.WarehousesTable,
+			Columns: []string{tenant.WarehousesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInterviewsIDs(); len(nodes) > 0 && !_u.mutation.InterviewsCleared() {
+	if nodes := _u.mutation.RemovedWarehousesIDs(); len(nodes) > 0 && !_u.mutation.WarehousesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InterviewsTable,
-			Columns: []string{tenant.InterviewsColumn},
+			Table:   tenant.WarehousesTable,
+			Columns: []string{tenant.WarehousesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6149,15 +7127,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InterviewsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WarehousesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InterviewsTable,
-			Columns: []string{tenant.InterviewsColumn},
+			Table:   tenant.WarehousesTable,
+			Columns: []string{tenant.WarehousesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6165,28 +7143,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BenefitPlansCleared() {
+	if _u.mutation.AssetAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitPlansTable,
-			Columns: []string{tenant.BenefitPlansColumn},
+			Table:   tenant.AssetAssignmentsTable,
+			Columns: []string{tenant.AssetAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBenefitPlansIDs(); len(nodes) > 0 && !_u.mutation.BenefitPlansCleared() {
+	if nodes := _u.mutation.RemovedAssetAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssetAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitPlansTable,
-			Columns: []string{tenant.BenefitPlansColumn},
+			Table:   tenant.AssetAssignmentsTable,
+			Columns: []string{tenant.AssetAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6194,15 +7172,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BenefitPlansIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AssetAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitPlansTable,
-			Columns: []string{tenant.BenefitPlansColumn},
+			Table:   tenant.AssetAssignmentsTable,
+			Columns: []string{tenant.AssetAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6210,28 +7188,28 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BenefitEnrollmentsCleared() {
+	if _u.mutation.ContactsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitEnrollmentsTable,
-			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Table:   tenant.ContactsTable,
+			Columns: []string{tenant.ContactsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBenefitEnrollmentsIDs(); len(nodes) > 0 && !_u.mutation.BenefitEnrollmentsCleared() {
+	if nodes := _u.mutation.RemovedContactsIDs(); len(nodes) > 0 && !_u.mutation.ContactsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitEnrollmentsTable,
-			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Table:   tenant.ContactsTable,
+			Columns: []string{tenant.ContactsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6239,15 +7217,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BenefitEnrollmentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ContactsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitEnrollmentsTable,
-			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Table:   tenant.ContactsTable,
+			Columns: []string{tenant.ContactsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6255,2890 +7233,5988 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tenant.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LegalHoldsTable,
+			Columns: []string{tenant.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// TenantUpdateOne is the builder for updating a single Tenant entity.
-type TenantUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *TenantMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetName sets the "name" field.
-func (_u *TenantUpdateOne) SetName(v string) *TenantUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableName(v *string) *TenantUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if nodes := _u.mutation.RemovedLegalHoldsIDs(); len(nodes) > 0 && !_u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LegalHoldsTable,
+			Columns: []string{tenant.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDomain sets the "domain" field.
-func (_u *TenantUpdateOne) SetDomain(v string) *TenantUpdateOne {
-	_u.mutation.SetDomain(v)
-	return _u
-}
-
-// SetNillableDomain sets the "domain" field if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableDomain(v *string) *TenantUpdateOne {
-	if v != nil {
-		_u.SetDomain(*v)
+	if nodes := _u.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LegalHoldsTable,
+			Columns: []string{tenant.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (_u *TenantUpdateOne) SetCreatedAt(v time.Time) *TenantUpdateOne {
-	_u.mutation.SetCreatedAt(v)
-	return _u
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableCreatedAt(v *time.Time) *TenantUpdateOne {
-	if v != nil {
-		_u.SetCreatedAt(*v)
+	if _u.mutation.RetentionPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RetentionPoliciesTable,
+			Columns: []string{tenant.RetentionPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetActive sets the "active" field.
-func (_u *TenantUpdateOne) SetActive(v bool) *TenantUpdateOne {
-	_u.mutation.SetActive(v)
-	return _u
-}
-
-// SetNillableActive sets the "active" field if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableActive(v *bool) *TenantUpdateOne {
-	if v != nil {
-		_u.SetActive(*v)
+	if nodes := _u.mutation.RemovedRetentionPoliciesIDs(); len(nodes) > 0 && !_u.mutation.RetentionPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RetentionPoliciesTable,
+			Columns: []string{tenant.RetentionPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetTransactionLimit sets the "transaction_limit" field.
-func (_u *TenantUpdateOne) SetTransactionLimit(v decimal.Decimal) *TenantUpdateOne {
-	_u.mutation.SetTransactionLimit(v)
-	return _u
-}
-
-// SetNillableTransactionLimit sets the "transaction_limit" field if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableTransactionLimit(v *decimal.Decimal) *TenantUpdateOne {
-	if v != nil {
-		_u.SetTransactionLimit(*v)
+	if nodes := _u.mutation.RetentionPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RetentionPoliciesTable,
+			Columns: []string{tenant.RetentionPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetParentID sets the "parent" edge to the Tenant entity by ID.
-func (_u *TenantUpdateOne) SetParentID(id int) *TenantUpdateOne {
-	_u.mutation.SetParentID(id)
-	return _u
-}
-
-// SetNillableParentID sets the "parent" edge to the Tenant entity by ID if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableParentID(id *int) *TenantUpdateOne {
-	if id != nil {
-		_u = _u.SetParentID(*id)
+	if _u.mutation.VaultTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.VaultTemplatesTable,
+			Columns: []string{tenant.VaultTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetParent sets the "parent" edge to the Tenant entity.
-func (_u *TenantUpdateOne) SetParent(v *Tenant) *TenantUpdateOne {
-	return _u.SetParentID(v.ID)
-}
-
-// AddChildIDs adds the "children" edge to the Tenant entity by IDs.
-func (_u *TenantUpdateOne) AddChildIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddChildIDs(ids...)
-	return _u
-}
-
-// AddChildren adds the "children" edges to the Tenant entity.
-func (_u *TenantUpdateOne) AddChildren(v ...*Tenant) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedVaultTemplatesIDs(); len(nodes) > 0 && !_u.mutation.VaultTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.VaultTemplatesTable,
+			Columns: []string{tenant.VaultTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddChildIDs(ids...)
-}
-
-// AddUserIDs adds the "users" edge to the User entity by IDs.
-func (_u *TenantUpdateOne) AddUserIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddUserIDs(ids...)
-	return _u
-}
-
-// AddUsers adds the "users" edges to the User entity.
-func (_u *TenantUpdateOne) AddUsers(v ...*User) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.VaultTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.VaultTemplatesTable,
+			Columns: []string{tenant.VaultTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddUserIDs(ids...)
-}
-
-// AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
-func (_u *TenantUpdateOne) AddAccountIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddAccountIDs(ids...)
-	return _u
-}
-
-// AddAccounts adds the "accounts" edges to the Account entity.
-func (_u *TenantUpdateOne) AddAccounts(v ...*Account) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.StockAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockAuditLogsTable,
+			Columns: []string{tenant.StockAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddAccountIDs(ids...)
-}
-
-// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
-func (_u *TenantUpdateOne) AddTransactionIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddTransactionIDs(ids...)
-	return _u
-}
-
-// AddTransactions adds the "transactions" edges to the Transaction entity.
-func (_u *TenantUpdateOne) AddTransactions(v ...*Transaction) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedStockAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.StockAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockAuditLogsTable,
+			Columns: []string{tenant.StockAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddTransactionIDs(ids...)
-}
-
-// AddLedgerEntryIDs adds the "ledger_entries" edge to the LedgerEntry entity by IDs.
-func (_u *TenantUpdateOne) AddLedgerEntryIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddLedgerEntryIDs(ids...)
-	return _u
-}
-
-// AddLedgerEntries adds the "ledger_entries" edges to the LedgerEntry entity.
-func (_u *TenantUpdateOne) AddLedgerEntries(v ...*LedgerEntry) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.StockAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockAuditLogsTable,
+			Columns: []string{tenant.StockAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddLedgerEntryIDs(ids...)
-}
-
-// AddProductIDs adds the "products" edge to the Product entity by IDs.
-func (_u *TenantUpdateOne) AddProductIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddProductIDs(ids...)
-	return _u
-}
-
-// AddProducts adds the "products" edges to the Product entity.
-func (_u *TenantUpdateOne) AddProducts(v ...*Product) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.MaintenanceSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceSchedulesTable,
+			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddProductIDs(ids...)
-}
-
-// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
-func (_u *TenantUpdateOne) AddStockMovementIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddStockMovementIDs(ids...)
-	return _u
-}
-
-// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
-func (_u *TenantUpdateOne) AddStockMovements(v ...*StockMovement) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedMaintenanceSchedulesIDs(); len(nodes) > 0 && !_u.mutation.MaintenanceSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceSchedulesTable,
+			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddStockMovementIDs(ids...)
-}
-
-// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
-func (_u *TenantUpdateOne) AddAuditLogIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddAuditLogIDs(ids...)
-	return _u
-}
-
-// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
-func (_u *TenantUpdateOne) AddAuditLogs(v ...*AuditLog) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.MaintenanceSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceSchedulesTable,
+			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddAuditLogIDs(ids...)
-}
-
-// AddAgentIDs adds the "agents" edge to the Agent entity by IDs.
-func (_u *TenantUpdateOne) AddAgentIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddAgentIDs(ids...)
-	return _u
-}
-
-// AddAgents adds the "agents" edges to the Agent entity.
-func (_u *TenantUpdateOne) AddAgents(v ...*Agent) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.StockAlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockAlertsTable,
+			Columns: []string{tenant.StockAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddAgentIDs(ids...)
-}
-
-// AddDiscoveryEntryIDs adds the "discovery_entries" edge to the DiscoveryEntry entity by IDs.
-func (_u *TenantUpdateOne) AddDiscoveryEntryIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddDiscoveryEntryIDs(ids...)
-	return _u
-}
-
-// AddDiscoveryEntries adds the "discovery_entries" edges to the DiscoveryEntry entity.
-func (_u *TenantUpdateOne) AddDiscoveryEntries(v ...*DiscoveryEntry) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedStockAlertsIDs(); len(nodes) > 0 && !_u.mutation.StockAlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockAlertsTable,
+			Columns: []string{tenant.StockAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddDiscoveryEntryIDs(ids...)
-}
-
-// AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
-func (_u *TenantUpdateOne) AddAssetIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddAssetIDs(ids...)
-	return _u
-}
-
-// AddAssets adds the "assets" edges to the Asset entity.
-func (_u *TenantUpdateOne) AddAssets(v ...*Asset) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.StockAlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockAlertsTable,
+			Columns: []string{tenant.StockAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddAssetIDs(ids...)
-}
-
-// AddCredentialIDs adds the "credentials" edge to the Credential entity by IDs.
-func (_u *TenantUpdateOne) AddCredentialIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddCredentialIDs(ids...)
-	return _u
-}
-
-// AddCredentials adds the "credentials" edges to the Credential entity.
-func (_u *TenantUpdateOne) AddCredentials(v ...*Credential) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.PurchaseOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PurchaseOrdersTable,
+			Columns: []string{tenant.PurchaseOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddCredentialIDs(ids...)
-}
-
-// AddOneTimeLinkIDs adds the "one_time_links" edge to the OneTimeLink entity by IDs.
-func (_u *TenantUpdateOne) AddOneTimeLinkIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddOneTimeLinkIDs(ids...)
-	return _u
-}
-
-// AddOneTimeLinks adds the "one_time_links" edges to the OneTimeLink entity.
-func (_u *TenantUpdateOne) AddOneTimeLinks(v ...*OneTimeLink) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedPurchaseOrdersIDs(); len(nodes) > 0 && !_u.mutation.PurchaseOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PurchaseOrdersTable,
+			Columns: []string{tenant.PurchaseOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddOneTimeLinkIDs(ids...)
-}
-
-// AddSopIDs adds the "sops" edge to the SOP entity by IDs.
-func (_u *TenantUpdateOne) AddSopIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddSopIDs(ids...)
-	return _u
-}
-
-// AddSops adds the "sops" edges to the SOP entity.
-func (_u *TenantUpdateOne) AddSops(v ...*SOP) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.PurchaseOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PurchaseOrdersTable,
+			Columns: []string{tenant.PurchaseOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddSopIDs(ids...)
-}
-
-// AddCameraIDs adds the "cameras" edge to the Camera entity by IDs.
-func (_u *TenantUpdateOne) AddCameraIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddCameraIDs(ids...)
-	return _u
-}
-
-// AddCameras adds the "cameras" edges to the Camera entity.
-func (_u *TenantUpdateOne) AddCameras(v ...*Camera) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.InventoryCountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InventoryCountsTable,
+			Columns: []string{tenant.InventoryCountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddCameraIDs(ids...)
-}
-
-// AddTicketIDs adds the "tickets" edge to the Ticket entity by IDs.
-func (_u *TenantUpdateOne) AddTicketIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddTicketIDs(ids...)
-	return _u
-}
-
-// AddTickets adds the "tickets" edges to the Ticket entity.
-func (_u *TenantUpdateOne) AddTickets(v ...*Ticket) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedInventoryCountsIDs(); len(nodes) > 0 && !_u.mutation.InventoryCountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InventoryCountsTable,
+			Columns: []string{tenant.InventoryCountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddTicketIDs(ids...)
-}
-
-// AddContractIDs adds the "contracts" edge to the Contract entity by IDs.
-func (_u *TenantUpdateOne) AddContractIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddContractIDs(ids...)
-	return _u
-}
-
-// AddContracts adds the "contracts" edges to the Contract entity.
-func (_u *TenantUpdateOne) AddContracts(v ...*Contract) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.InventoryCountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InventoryCountsTable,
+			Columns: []string{tenant.InventoryCountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddContractIDs(ids...)
-}
-
-// AddSaasAppIDs adds the "saas_apps" edge to the SaaSApp entity by IDs.
-func (_u *TenantUpdateOne) AddSaasAppIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddSaasAppIDs(ids...)
-	return _u
-}
-
-// AddSaasApps adds the "saas_apps" edges to the SaaSApp entity.
-func (_u *TenantUpdateOne) AddSaasApps(v ...*SaaSApp) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.JobPostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.JobPostingsTable,
+			Columns: []string{tenant.JobPostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddSaasAppIDs(ids...)
-}
-
-// AddSaasFilterIDs adds the "saas_filters" edge to the SaaSFilter entity by IDs.
-func (_u *TenantUpdateOne) AddSaasFilterIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddSaasFilterIDs(ids...)
-	return _u
-}
-
-// AddSaasFilters adds the "saas_filters" edges to the SaaSFilter entity.
-func (_u *TenantUpdateOne) AddSaasFilters(v ...*SaaSFilter) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedJobPostingsIDs(); len(nodes) > 0 && !_u.mutation.JobPostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.JobPostingsTable,
+			Columns: []string{tenant.JobPostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddSaasFilterIDs(ids...)
-}
-
-// AddCallLogIDs adds the "call_logs" edge to the CallLog entity by IDs.
-func (_u *TenantUpdateOne) AddCallLogIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddCallLogIDs(ids...)
-	return _u
-}
-
-// AddCallLogs adds the "call_logs" edges to the CallLog entity.
-func (_u *TenantUpdateOne) AddCallLogs(v ...*CallLog) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.JobPostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.JobPostingsTable,
+			Columns: []string{tenant.JobPostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddCallLogIDs(ids...)
-}
-
-// AddIvrFlowIDs adds the "ivr_flows" edge to the IVRFlow entity by IDs.
-func (_u *TenantUpdateOne) AddIvrFlowIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddIvrFlowIDs(ids...)
-	return _u
-}
-
-// AddIvrFlows adds the "ivr_flows" edges to the IVRFlow entity.
-func (_u *TenantUpdateOne) AddIvrFlows(v ...*IVRFlow) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.CandidatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CandidatesTable,
+			Columns: []string{tenant.CandidatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddIvrFlowIDs(ids...)
-}
-
-// AddVoicemailIDs adds the "voicemails" edge to the Voicemail entity by IDs.
-func (_u *TenantUpdateOne) AddVoicemailIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddVoicemailIDs(ids...)
-	return _u
-}
-
-// AddVoicemails adds the "voicemails" edges to the Voicemail entity.
-func (_u *TenantUpdateOne) AddVoicemails(v ...*Voicemail) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedCandidatesIDs(); len(nodes) > 0 && !_u.mutation.CandidatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CandidatesTable,
+			Columns: []string{tenant.CandidatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddVoicemailIDs(ids...)
-}
-
-// AddHealthSnapshotIDs adds the "health_snapshots" edge to the HealthScoreSnapshot entity by IDs.
-func (_u *TenantUpdateOne) AddHealthSnapshotIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddHealthSnapshotIDs(ids...)
-	return _u
-}
-
-// AddHealthSnapshots adds the "health_snapshots" edges to the HealthScoreSnapshot entity.
-func (_u *TenantUpdateOne) AddHealthSnapshots(v ...*HealthScoreSnapshot) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.CandidatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CandidatesTable,
+			Columns: []string{tenant.CandidatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddHealthSnapshotIDs(ids...)
-}
-
-// AddRoadmapIDs adds the "roadmaps" edge to the StrategicRoadmap entity by IDs.
-func (_u *TenantUpdateOne) AddRoadmapIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddRoadmapIDs(ids...)
-	return _u
-}
-
-// AddRoadmaps adds the "roadmaps" edges to the StrategicRoadmap entity.
-func (_u *TenantUpdateOne) AddRoadmaps(v ...*StrategicRoadmap) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddRoadmapIDs(ids...)
-}
-
-// AddServiceRateIDs adds the "service_rates" edge to the ServiceRate entity by IDs.
-func (_u *TenantUpdateOne) AddServiceRateIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddServiceRateIDs(ids...)
-	return _u
-}
-
-// AddServiceRates adds the "service_rates" edges to the ServiceRate entity.
-func (_u *TenantUpdateOne) AddServiceRates(v ...*ServiceRate) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.ApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ApplicationsTable,
+			Columns: []string{tenant.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddServiceRateIDs(ids...)
-}
-
-// AddNetworkDeviceIDs adds the "network_devices" edge to the NetworkDevice entity by IDs.
-func (_u *TenantUpdateOne) AddNetworkDeviceIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddNetworkDeviceIDs(ids...)
-	return _u
-}
-
-// AddNetworkDevices adds the "network_devices" edges to the NetworkDevice entity.
-func (_u *TenantUpdateOne) AddNetworkDevices(v ...*NetworkDevice) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedApplicationsIDs(); len(nodes) > 0 && !_u.mutation.ApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ApplicationsTable,
+			Columns: []string{tenant.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddNetworkDeviceIDs(ids...)
-}
-
-// AddNetworkBackupIDs adds the "network_backups" edge to the NetworkBackup entity by IDs.
-func (_u *TenantUpdateOne) AddNetworkBackupIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddNetworkBackupIDs(ids...)
-	return _u
-}
-
-// AddNetworkBackups adds the "network_backups" edges to the NetworkBackup entity.
-func (_u *TenantUpdateOne) AddNetworkBackups(v ...*NetworkBackup) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.ApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ApplicationsTable,
+			Columns: []string{tenant.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddNetworkBackupIDs(ids...)
-}
-
-// AddBudgetForecastIDs adds the "budget_forecasts" edge to the BudgetForecast entity by IDs.
-func (_u *TenantUpdateOne) AddBudgetForecastIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddBudgetForecastIDs(ids...)
-	return _u
-}
-
-// AddBudgetForecasts adds the "budget_forecasts" edges to the BudgetForecast entity.
-func (_u *TenantUpdateOne) AddBudgetForecasts(v ...*BudgetForecast) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.InterviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InterviewsTable,
+			Columns: []string{tenant.InterviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddBudgetForecastIDs(ids...)
-}
-
-// AddEmployeeIDs adds the "employees" edge to the Employee entity by IDs.
-func (_u *TenantUpdateOne) AddEmployeeIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddEmployeeIDs(ids...)
-	return _u
-}
-
-// AddEmployees adds the "employees" edges to the Employee entity.
-func (_u *TenantUpdateOne) AddEmployees(v ...*Employee) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedInterviewsIDs(); len(nodes) > 0 && !_u.mutation.InterviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InterviewsTable,
+			Columns: []string{tenant.InterviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddEmployeeIDs(ids...)
-}
-
-// AddCompensationAgreementIDs adds the "compensation_agreements" edge to the CompensationAgreement entity by IDs.
-func (_u *TenantUpdateOne) AddCompensationAgreementIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddCompensationAgreementIDs(ids...)
-	return _u
-}
-
-// AddCompensationAgreements adds the "compensation_agreements" edges to the CompensationAgreement entity.
-func (_u *TenantUpdateOne) AddCompensationAgreements(v ...*CompensationAgreement) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.InterviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InterviewsTable,
+			Columns: []string{tenant.InterviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddCompensationAgreementIDs(ids...)
-}
-
-// AddVaultItemIDs adds the "vault_items" edge to the VaultItem entity by IDs.
-func (_u *TenantUpdateOne) AddVaultItemIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddVaultItemIDs(ids...)
-	return _u
-}
-
-// AddVaultItems adds the "vault_items" edges to the VaultItem entity.
-func (_u *TenantUpdateOne) AddVaultItems(v ...*VaultItem) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.BenefitPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BenefitPlansTable,
+			Columns: []string{tenant.BenefitPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddVaultItemIDs(ids...)
-}
-
-// AddVaultShareLinkIDs adds the "vault_share_links" edge to the VaultShareLink entity by IDs.
-func (_u *TenantUpdateOne) AddVaultShareLinkIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddVaultShareLinkIDs(ids...)
-	return _u
-}
-
-// AddVaultShareLinks adds the "vault_share_links" edges to the VaultShareLink entity.
-func (_u *TenantUpdateOne) AddVaultShareLinks(v ...*VaultShareLink) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedBenefitPlansIDs(); len(nodes) > 0 && !_u.mutation.BenefitPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BenefitPlansTable,
+			Columns: []string{tenant.BenefitPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddVaultShareLinkIDs(ids...)
-}
-
-// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by IDs.
-func (_u *TenantUpdateOne) AddJournalEntryIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddJournalEntryIDs(ids...)
-	return _u
-}
-
-// AddJournalEntries adds the "journal_entries" edges to the JournalEntry entity.
-func (_u *TenantUpdateOne) AddJournalEntries(v ...*JournalEntry) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.BenefitPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BenefitPlansTable,
+			Columns: []string{tenant.BenefitPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddJournalEntryIDs(ids...)
-}
-
-// AddRecurringInvoiceIDs adds the "recurring_invoices" edge to the RecurringInvoice entity by IDs.
-func (_u *TenantUpdateOne) AddRecurringInvoiceIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddRecurringInvoiceIDs(ids...)
-	return _u
-}
-
-// AddRecurringInvoices adds the "recurring_invoices" edges to the RecurringInvoice entity.
-func (_u *TenantUpdateOne) AddRecurringInvoices(v ...*RecurringInvoice) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.BenefitEnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BenefitEnrollmentsTable,
+			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddRecurringInvoiceIDs(ids...)
-}
-
-// AddInventoryReservationIDs adds the "inventory_reservations" edge to the InventoryReservation entity by IDs.
-func (_u *TenantUpdateOne) AddInventoryReservationIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddInventoryReservationIDs(ids...)
-	return _u
-}
-
-// AddInventoryReservations adds the "inventory_reservations" edges to the InventoryReservation entity.
-func (_u *TenantUpdateOne) AddInventoryReservations(v ...*InventoryReservation) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedBenefitEnrollmentsIDs(); len(nodes) > 0 && !_u.mutation.BenefitEnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BenefitEnrollmentsTable,
+			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddInventoryReservationIDs(ids...)
-}
-
-// AddDepartmentIDs adds the "departments" edge to the Department entity by IDs.
-func (_u *TenantUpdateOne) AddDepartmentIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddDepartmentIDs(ids...)
-	return _u
-}
-
-// AddDepartments adds the "departments" edges to the Department entity.
-func (_u *TenantUpdateOne) AddDepartments(v ...*Department) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.BenefitEnrollmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BenefitEnrollmentsTable,
+			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddDepartmentIDs(ids...)
-}
-
-// AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
-func (_u *TenantUpdateOne) AddPermissionIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddPermissionIDs(ids...)
-	return _u
-}
-
-// AddPermissions adds the "permissions" edges to the Permission entity.
-func (_u *TenantUpdateOne) AddPermissions(v ...*Permission) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.PosShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PosShiftsTable,
+			Columns: []string{tenant.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddPermissionIDs(ids...)
-}
-
-// AddAssetTypeIDs adds the "asset_types" edge to the AssetType entity by IDs.
-func (_u *TenantUpdateOne) AddAssetTypeIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddAssetTypeIDs(ids...)
-	return _u
-}
-
-// AddAssetTypes adds the "asset_types" edges to the AssetType entity.
-func (_u *TenantUpdateOne) AddAssetTypes(v ...*AssetType) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedPosShiftsIDs(); len(nodes) > 0 && !_u.mutation.PosShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PosShiftsTable,
+			Columns: []string{tenant.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddAssetTypeIDs(ids...)
-}
-
-// AddDetectionEventIDs adds the "detection_events" edge to the DetectionEvent entity by IDs.
-func (_u *TenantUpdateOne) AddDetectionEventIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddDetectionEventIDs(ids...)
-	return _u
-}
-
-// AddDetectionEvents adds the "detection_events" edges to the DetectionEvent entity.
-func (_u *TenantUpdateOne) AddDetectionEvents(v ...*DetectionEvent) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.PosShiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PosShiftsTable,
+			Columns: []string{tenant.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddDetectionEventIDs(ids...)
-}
-
-// AddSaasIdentityIDs adds the "saas_identities" edge to the SaaSIdentity entity by IDs.
-func (_u *TenantUpdateOne) AddSaasIdentityIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddSaasIdentityIDs(ids...)
-	return _u
-}
-
-// AddSaasIdentities adds the "saas_identities" edges to the SaaSIdentity entity.
-func (_u *TenantUpdateOne) AddSaasIdentities(v ...*SaaSIdentity) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.LabelTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LabelTemplatesTable,
+			Columns: []string{tenant.LabelTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddSaasIdentityIDs(ids...)
-}
-
-// AddSaasUsageIDs adds the "saas_usages" edge to the SaaSUsage entity by IDs.
-func (_u *TenantUpdateOne) AddSaasUsageIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddSaasUsageIDs(ids...)
-	return _u
-}
-
-// AddSaasUsages adds the "saas_usages" edges to the SaaSUsage entity.
-func (_u *TenantUpdateOne) AddSaasUsages(v ...*SaaSUsage) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedLabelTemplatesIDs(); len(nodes) > 0 && !_u.mutation.LabelTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LabelTemplatesTable,
+			Columns: []string{tenant.LabelTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddSaasUsageIDs(ids...)
-}
-
-// AddRecordingIDs adds the "recordings" edge to the Recording entity by IDs.
-func (_u *TenantUpdateOne) AddRecordingIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddRecordingIDs(ids...)
-	return _u
-}
-
-// AddRecordings adds the "recordings" edges to the Recording entity.
-func (_u *TenantUpdateOne) AddRecordings(v ...*Recording) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.LabelTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LabelTemplatesTable,
+			Columns: []string{tenant.LabelTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddRecordingIDs(ids...)
-}
-
-// AddNetworkLinkIDs adds the "network_links" edge to the NetworkLink entity by IDs.
-func (_u *TenantUpdateOne) AddNetworkLinkIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddNetworkLinkIDs(ids...)
-	return _u
-}
-
-// AddNetworkLinks adds the "network_links" edges to the NetworkLink entity.
-func (_u *TenantUpdateOne) AddNetworkLinks(v ...*NetworkLink) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.TaxJurisdictionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TaxJurisdictionsTable,
+			Columns: []string{tenant.TaxJurisdictionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddNetworkLinkIDs(ids...)
-}
-
-// AddNetworkPortIDs adds the "network_ports" edge to the NetworkPort entity by IDs.
-func (_u *TenantUpdateOne) AddNetworkPortIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddNetworkPortIDs(ids...)
-	return _u
-}
-
-// AddNetworkPorts adds the "network_ports" edges to the NetworkPort entity.
-func (_u *TenantUpdateOne) AddNetworkPorts(v ...*NetworkPort) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddNetworkPortIDs(ids...)
-}
-
-// AddNexusAuditIDs adds the "nexus_audits" edge to the NexusAudit entity by IDs.
-func (_u *TenantUpdateOne) AddNexusAuditIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddNexusAuditIDs(ids...)
-	return _u
-}
-
-// AddNexusAudits adds the "nexus_audits" edges to the NexusAudit entity.
-func (_u *TenantUpdateOne) AddNexusAudits(v ...*NexusAudit) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedTaxJurisdictionsIDs(); len(nodes) > 0 && !_u.mutation.TaxJurisdictionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TaxJurisdictionsTable,
+			Columns: []string{tenant.TaxJurisdictionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddNexusAuditIDs(ids...)
-}
-
-// AddSuccessionMapIDs adds the "succession_maps" edge to the SuccessionMap entity by IDs.
-func (_u *TenantUpdateOne) AddSuccessionMapIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddSuccessionMapIDs(ids...)
-	return _u
-}
-
-// AddSuccessionMaps adds the "succession_maps" edges to the SuccessionMap entity.
-func (_u *TenantUpdateOne) AddSuccessionMaps(v ...*SuccessionMap) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.TaxJurisdictionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TaxJurisdictionsTable,
+			Columns: []string{tenant.TaxJurisdictionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddSuccessionMapIDs(ids...)
-}
-
-// SetCustomerAccountID sets the "customer_account" edge to the Account entity by ID.
-func (_u *TenantUpdateOne) SetCustomerAccountID(id int) *TenantUpdateOne {
-	_u.mutation.SetCustomerAccountID(id)
-	return _u
-}
-
-// SetNillableCustomerAccountID sets the "customer_account" edge to the Account entity by ID if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableCustomerAccountID(id *int) *TenantUpdateOne {
-	if id != nil {
-		_u = _u.SetCustomerAccountID(*id)
+	if _u.mutation.EinvoiceCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.EinvoiceCredentialsTable,
+			Columns: []string{tenant.EinvoiceCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetCustomerAccount sets the "customer_account" edge to the Account entity.
-func (_u *TenantUpdateOne) SetCustomerAccount(v *Account) *TenantUpdateOne {
-	return _u.SetCustomerAccountID(v.ID)
-}
-
-// AddScriptIDs adds the "scripts" edge to the Script entity by IDs.
-func (_u *TenantUpdateOne) AddScriptIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddScriptIDs(ids...)
-	return _u
-}
-
-// AddScripts adds the "scripts" edges to the Script entity.
-func (_u *TenantUpdateOne) AddScripts(v ...*Script) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedEinvoiceCredentialsIDs(); len(nodes) > 0 && !_u.mutation.EinvoiceCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.EinvoiceCredentialsTable,
+			Columns: []string{tenant.EinvoiceCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddScriptIDs(ids...)
-}
-
-// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
-func (_u *TenantUpdateOne) AddJobIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddJobIDs(ids...)
-	return _u
-}
-
-// AddJobs adds the "jobs" edges to the Job entity.
-func (_u *TenantUpdateOne) AddJobs(v ...*Job) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.EinvoiceCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.EinvoiceCredentialsTable,
+			Columns: []string{tenant.EinvoiceCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddJobIDs(ids...)
-}
-
-// AddTimeOffRequestIDs adds the "time_off_requests" edge to the TimeOffRequest entity by IDs.
-func (_u *TenantUpdateOne) AddTimeOffRequestIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddTimeOffRequestIDs(ids...)
-	return _u
-}
-
-// AddTimeOffRequests adds the "time_off_requests" edges to the TimeOffRequest entity.
-func (_u *TenantUpdateOne) AddTimeOffRequests(v ...*TimeOffRequest) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.EinvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.EinvoicesTable,
+			Columns: []string{tenant.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddTimeOffRequestIDs(ids...)
-}
-
-// AddTimeOffPolicyIDs adds the "time_off_policies" edge to the TimeOffPolicy entity by IDs.
-func (_u *TenantUpdateOne) AddTimeOffPolicyIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddTimeOffPolicyIDs(ids...)
-	return _u
-}
-
-// AddTimeOffPolicies adds the "time_off_policies" edges to the TimeOffPolicy entity.
-func (_u *TenantUpdateOne) AddTimeOffPolicies(v ...*TimeOffPolicy) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedEinvoicesIDs(); len(nodes) > 0 && !_u.mutation.EinvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.EinvoicesTable,
+			Columns: []string{tenant.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddTimeOffPolicyIDs(ids...)
-}
-
-// AddTimeOffBalanceIDs adds the "time_off_balances" edge to the TimeOffBalance entity by IDs.
-func (_u *TenantUpdateOne) AddTimeOffBalanceIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddTimeOffBalanceIDs(ids...)
-	return _u
-}
-
-// AddTimeOffBalances adds the "time_off_balances" edges to the TimeOffBalance entity.
-func (_u *TenantUpdateOne) AddTimeOffBalances(v ...*TimeOffBalance) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.EinvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.EinvoicesTable,
+			Columns: []string{tenant.EinvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddTimeOffBalanceIDs(ids...)
-}
-
-// AddReviewCycleIDs adds the "review_cycles" edge to the ReviewCycle entity by IDs.
-func (_u *TenantUpdateOne) AddReviewCycleIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddReviewCycleIDs(ids...)
-	return _u
-}
-
-// AddReviewCycles adds the "review_cycles" edges to the ReviewCycle entity.
-func (_u *TenantUpdateOne) AddReviewCycles(v ...*ReviewCycle) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.AgentEnrollmentTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AgentEnrollmentTokensTable,
+			Columns: []string{tenant.AgentEnrollmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddReviewCycleIDs(ids...)
-}
-
-// AddPerformanceReviewIDs adds the "performance_reviews" edge to the PerformanceReview entity by IDs.
-func (_u *TenantUpdateOne) AddPerformanceReviewIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddPerformanceReviewIDs(ids...)
-	return _u
-}
-
-// AddPerformanceReviews adds the "performance_reviews" edges to the PerformanceReview entity.
-func (_u *TenantUpdateOne) AddPerformanceReviews(v ...*PerformanceReview) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedAgentEnrollmentTokensIDs(); len(nodes) > 0 && !_u.mutation.AgentEnrollmentTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AgentEnrollmentTokensTable,
+			Columns: []string{tenant.AgentEnrollmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddPerformanceReviewIDs(ids...)
-}
+	if nodes := _u.mutation.AgentEnrollmentTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AgentEnrollmentTokensTable,
+			Columns: []string{tenant.AgentEnrollmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AgentAuthorityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   tenant.AgentAuthorityTable,
+			Columns: []string{tenant.AgentAuthorityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentauthority.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentAuthorityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   tenant.AgentAuthorityTable,
+			Columns: []string{tenant.AgentAuthorityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentauthority.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeviceGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DeviceGroupsTable,
+			Columns: []string{tenant.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeviceGroupsIDs(); len(nodes) > 0 && !_u.mutation.DeviceGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DeviceGroupsTable,
+			Columns: []string{tenant.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeviceGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DeviceGroupsTable,
+			Columns: []string{tenant.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PatchPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PatchPoliciesTable,
+			Columns: []string{tenant.PatchPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPatchPoliciesIDs(); len(nodes) > 0 && !_u.mutation.PatchPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PatchPoliciesTable,
+			Columns: []string{tenant.PatchPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatchPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.PatchPoliciesTable,
+			Columns: []string{tenant.PatchPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AlertRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AlertRulesTable,
+			Columns: []string{tenant.AlertRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAlertRulesIDs(); len(nodes) > 0 && !_u.mutation.AlertRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AlertRulesTable,
+			Columns: []string{tenant.AlertRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AlertRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AlertRulesTable,
+			Columns: []string{tenant.AlertRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
 
-// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
-func (_u *TenantUpdateOne) AddGoalIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddGoalIDs(ids...)
+// TenantUpdateOne is the builder for updating a single Tenant entity.
+type TenantUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *TenantUpdateOne) SetName(v string) *TenantUpdateOne {
+	_u.mutation.SetName(v)
 	return _u
 }
 
-// AddGoals adds the "goals" edges to the Goal entity.
-func (_u *TenantUpdateOne) AddGoals(v ...*Goal) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableName(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
 	}
-	return _u.AddGoalIDs(ids...)
+	return _u
 }
 
-// AddSupplierIDs adds the "suppliers" edge to the Supplier entity by IDs.
-func (_u *TenantUpdateOne) AddSupplierIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddSupplierIDs(ids...)
+// SetDomain sets the "domain" field.
+func (_u *TenantUpdateOne) SetDomain(v string) *TenantUpdateOne {
+	_u.mutation.SetDomain(v)
 	return _u
 }
 
-// AddSuppliers adds the "suppliers" edges to the Supplier entity.
-func (_u *TenantUpdateOne) AddSuppliers(v ...*Supplier) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableDomain(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetDomain(*v)
 	}
-	return _u.AddSupplierIDs(ids...)
+	return _u
 }
 
-// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
-func (_u *TenantUpdateOne) AddCategoryIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddCategoryIDs(ids...)
+// SetCreatedAt sets the "created_at" field.
+func (_u *TenantUpdateOne) SetCreatedAt(v time.Time) *TenantUpdateOne {
+	_u.mutation.SetCreatedAt(v)
 	return _u
 }
 
-// AddCategories adds the "categories" edges to the Category entity.
-func (_u *TenantUpdateOne) AddCategories(v ...*Category) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableCreatedAt(v *time.Time) *TenantUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
 	}
-	return _u.AddCategoryIDs(ids...)
+	return _u
 }
 
-// AddWarehouseIDs adds the "warehouses" edge to the Warehouse entity by IDs.
-func (_u *TenantUpdateOne) AddWarehouseIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddWarehouseIDs(ids...)
+// SetActive sets the "active" field.
+func (_u *TenantUpdateOne) SetActive(v bool) *TenantUpdateOne {
+	_u.mutation.SetActive(v)
 	return _u
 }
 
-// AddWarehouses adds the "warehouses" edges to the Warehouse entity.
-func (_u *TenantUpdateOne) AddWarehouses(v ...*Warehouse) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableActive(v *bool) *TenantUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
 	}
-	return _u.AddWarehouseIDs(ids...)
+	return _u
 }
 
-// AddAssetAssignmentIDs adds the "asset_assignments" edge to the AssetAssignment entity by IDs.
-func (_u *TenantUpdateOne) AddAssetAssignmentIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddAssetAssignmentIDs(ids...)
+// SetTransactionLimit sets the "transaction_limit" field.
+func (_u *TenantUpdateOne) SetTransactionLimit(v decimal.Decimal) *TenantUpdateOne {
+	_u.mutation.SetTransactionLimit(v)
 	return _u
 }
 
-// AddAssetAssignments adds the "asset_assignments" edges to the AssetAssignment entity.
-func (_u *TenantUpdateOne) AddAssetAssignments(v ...*AssetAssignment) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableTransactionLimit sets the "transaction_limit" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableTransactionLimit(v *decimal.Decimal) *TenantUpdateOne {
+	if v != nil {
+		_u.SetTransactionLimit(*v)
 	}
-	return _u.AddAssetAssignmentIDs(ids...)
+	return _u
 }
 
-// AddContactIDs adds the "contacts" edge to the Contact entity by IDs.
-func (_u *TenantUpdateOne) AddContactIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddContactIDs(ids...)
+// SetFunctionalCurrency sets the "functional_currency" field.
+func (_u *TenantUpdateOne) SetFunctionalCurrency(v string) *TenantUpdateOne {
+	_u.mutation.SetFunctionalCurrency(v)
 	return _u
 }
 
-// AddContacts adds the "contacts" edges to the Contact entity.
-func (_u *TenantUpdateOne) AddContacts(v ...*Contact) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableFunctionalCurrency sets the "functional_currency" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableFunctionalCurrency(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetFunctionalCurrency(*v)
 	}
-	return _u.AddContactIDs(ids...)
+	return _u
 }
 
-// AddLegalHoldIDs adds the "legal_holds" edge to the LegalHold entity by IDs.
-func (_u *TenantUpdateOne) AddLegalHoldIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddLegalHoldIDs(ids...)
+// SetFiscalYearStartMonth sets the "fiscal_year_start_month" field.
+func (_u *TenantUpdateOne) SetFiscalYearStartMonth(v int) *TenantUpdateOne {
+	_u.mutation.ResetFiscalYearStartMonth()
+	_u.mutation.SetFiscalYearStartMonth(v)
 	return _u
 }
 
-// AddLegalHolds adds the "legal_holds" edges to the LegalHold entity.
-func (_u *TenantUpdateOne) AddLegalHolds(v ...*LegalHold) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableFiscalYearStartMonth sets the "fiscal_year_start_month" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableFiscalYearStartMonth(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetFiscalYearStartMonth(*v)
 	}
-	return _u.AddLegalHoldIDs(ids...)
+	return _u
 }
 
-// AddRetentionPolicyIDs adds the "retention_policies" edge to the RetentionPolicy entity by IDs.
-func (_u *TenantUpdateOne) AddRetentionPolicyIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddRetentionPolicyIDs(ids...)
+// AddFiscalYearStartMonth adds value to the "fiscal_year_start_month" field.
+func (_u *TenantUpdateOne) AddFiscalYearStartMonth(v int) *TenantUpdateOne {
+	_u.mutation.AddFiscalYearStartMonth(v)
 	return _u
 }
 
-// AddRetentionPolicies adds the "retention_policies" edges to the RetentionPolicy entity.
-func (_u *TenantUpdateOne) AddRetentionPolicies(v ...*RetentionPolicy) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetTaxNumber sets the "tax_number" field.
+func (_u *TenantUpdateOne) SetTaxNumber(v string) *TenantUpdateOne {
+	_u.mutation.SetTaxNumber(v)
+	return _u
+}
+
+// SetNillableTaxNumber sets the "tax_number" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableTaxNumber(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetTaxNumber(*v)
 	}
-	return _u.AddRetentionPolicyIDs(ids...)
+	return _u
 }
 
-// AddVaultTemplateIDs adds the "vault_templates" edge to the VaultTemplate entity by IDs.
-func (_u *TenantUpdateOne) AddVaultTemplateIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddVaultTemplateIDs(ids...)
+// ClearTaxNumber clears the value of the "tax_number" field.
+func (_u *TenantUpdateOne) ClearTaxNumber() *TenantUpdateOne {
+	_u.mutation.ClearTaxNumber()
 	return _u
 }
 
-// AddVaultTemplates adds the "vault_templates" edges to the VaultTemplate entity.
-func (_u *TenantUpdateOne) AddVaultTemplates(v ...*VaultTemplate) *TenantUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetLogo sets the "logo" field.
+func (_u *TenantUpdateOne) SetLogo(v []byte) *TenantUpdateOne {
+	_u.mutation.SetLogo(v)
+	return _u
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (_u *TenantUpdateOne) ClearLogo() *TenantUpdateOne {
+	_u.mutation.ClearLogo()
+	return _u
+}
+
+// SetParentID sets the "parent" edge to the Tenant entity by ID.
+func (_u *TenantUpdateOne) SetParentID(id int) *TenantUpdateOne {
+	_u.mutation.SetParentID(id)
+	return _u
+}
+
+// SetNillableParentID sets the "parent" edge to the Tenant entity by ID if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableParentID(id *int) *TenantUpdateOne {
+	if id != nil {
+		_u = _u.SetParentID(*id)
 	}
-	return _u.AddVaultTemplateIDs(ids...)
+	return _u
 }
 
-// AddStockAuditLogIDs adds the "stock_audit_logs" edge to the StockAuditLog entity by IDs.
-func (_u *TenantUpdateOne) AddStockAuditLogIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddStockAuditLogIDs(ids...)
+// SetParent sets the "parent" edge to the Tenant entity.
+func (_u *TenantUpdateOne) SetParent(v *Tenant) *TenantUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Tenant entity by IDs.
+func (_u *TenantUpdateOne) AddChildIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
 	return _u
 }
 
-// AddStockAuditLogs adds the "stock_audit_logs" edges to the StockAuditLog entity.
-func (_u *TenantUpdateOne) AddStockAuditLogs(v ...*StockAuditLog) *TenantUpdateOne {
+// AddChildren adds the "children" edges to the Tenant entity.
+func (_u *TenantUpdateOne) AddChildren(v ...*Tenant) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddStockAuditLogIDs(ids...)
+	return _u.AddChildIDs(ids...)
 }
 
-// AddMaintenanceScheduleIDs adds the "maintenance_schedules" edge to the MaintenanceSchedule entity by IDs.
-func (_u *TenantUpdateOne) AddMaintenanceScheduleIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddMaintenanceScheduleIDs(ids...)
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *TenantUpdateOne) AddUserIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddUserIDs(ids...)
 	return _u
 }
 
-// AddMaintenanceSchedules adds the "maintenance_schedules" edges to the MaintenanceSchedule entity.
-func (_u *TenantUpdateOne) AddMaintenanceSchedules(v ...*MaintenanceSchedule) *TenantUpdateOne {
+// AddUsers adds the "users" edges to the User entity.
+func (_u *TenantUpdateOne) AddUsers(v ...*User) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddMaintenanceScheduleIDs(ids...)
+	return _u.AddUserIDs(ids...)
 }
 
-// AddStockAlertIDs adds the "stock_alerts" edge to the StockAlert entity by IDs.
-func (_u *TenantUpdateOne) AddStockAlertIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddStockAlertIDs(ids...)
+// AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
+func (_u *TenantUpdateOne) AddAccountIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddAccountIDs(ids...)
 	return _u
 }
 
-// AddStockAlerts adds the "stock_alerts" edges to the StockAlert entity.
-func (_u *TenantUpdateOne) AddStockAlerts(v ...*StockAlert) *TenantUpdateOne {
+// AddAccounts adds the "accounts" edges to the Account entity.
+func (_u *TenantUpdateOne) AddAccounts(v ...*Account) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddStockAlertIDs(ids...)
+	return _u.AddAccountIDs(ids...)
 }
 
-// AddPurchaseOrderIDs adds the "purchase_orders" edge to the PurchaseOrder entity by IDs.
-func (_u *TenantUpdateOne) AddPurchaseOrderIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddPurchaseOrderIDs(ids...)
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *TenantUpdateOne) AddTransactionIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddTransactionIDs(ids...)
 	return _u
 }
 
-// AddPurchaseOrders adds the "purchase_orders" edges to the PurchaseOrder entity.
-func (_u *TenantUpdateOne) AddPurchaseOrders(v ...*PurchaseOrder) *TenantUpdateOne {
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *TenantUpdateOne) AddTransactions(v ...*Transaction) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddPurchaseOrderIDs(ids...)
+	return _u.AddTransactionIDs(ids...)
 }
 
-// AddInventoryCountIDs adds the "inventory_counts" edge to the InventoryCount entity by IDs.
-func (_u *TenantUpdateOne) AddInventoryCountIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddInventoryCountIDs(ids...)
+// AddLedgerEntryIDs adds the "ledger_entries" edge to the LedgerEntry entity by IDs.
+func (_u *TenantUpdateOne) AddLedgerEntryIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddLedgerEntryIDs(ids...)
 	return _u
 }
 
-// AddInventoryCounts adds the "inventory_counts" edges to the InventoryCount entity.
-func (_u *TenantUpdateOne) AddInventoryCounts(v ...*InventoryCount) *TenantUpdateOne {
+// AddLedgerEntries adds the "ledger_entries" edges to the LedgerEntry entity.
+func (_u *TenantUpdateOne) AddLedgerEntries(v ...*LedgerEntry) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddInventoryCountIDs(ids...)
+	return _u.AddLedgerEntryIDs(ids...)
 }
 
-// AddJobPostingIDs adds the "job_postings" edge to the JobPosting entity by IDs.
-func (_u *TenantUpdateOne) AddJobPostingIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddJobPostingIDs(ids...)
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (_u *TenantUpdateOne) AddProductIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddProductIDs(ids...)
 	return _u
 }
 
-// AddJobPostings adds the "job_postings" edges to the JobPosting entity.
-func (_u *TenantUpdateOne) AddJobPostings(v ...*JobPosting) *TenantUpdateOne {
+// AddProducts adds the "products" edges to the Product entity.
+func (_u *TenantUpdateOne) AddProducts(v ...*Product) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddJobPostingIDs(ids...)
+	return _u.AddProductIDs(ids...)
 }
 
-// AddCandidateIDs adds the "candidates" edge to the Candidate entity by IDs.
-func (_u *TenantUpdateOne) AddCandidateIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddCandidateIDs(ids...)
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *TenantUpdateOne) AddStockMovementIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddStockMovementIDs(ids...)
 	return _u
 }
 
-// AddCandidates adds the "candidates" edges to the Candidate entity.
-func (_u *TenantUpdateOne) AddCandidates(v ...*Candidate) *TenantUpdateOne {
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *TenantUpdateOne) AddStockMovements(v ...*StockMovement) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddCandidateIDs(ids...)
+	return _u.AddStockMovementIDs(ids...)
 }
 
-// AddApplicationIDs adds the "applications" edge to the Application entity by IDs.
-func (_u *TenantUpdateOne) AddApplicationIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddApplicationIDs(ids...)
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (_u *TenantUpdateOne) AddAuditLogIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddAuditLogIDs(ids...)
 	return _u
 }
 
-// AddApplications adds the "applications" edges to the Application entity.
-func (_u *TenantUpdateOne) AddApplications(v ...*Application) *TenantUpdateOne {
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (_u *TenantUpdateOne) AddAuditLogs(v ...*AuditLog) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddApplicationIDs(ids...)
+	return _u.AddAuditLogIDs(ids...)
 }
 
-// AddInterviewIDs adds the "interviews" edge to the Interview entity by IDs.
-func (_u *TenantUpdateOne) AddInterviewIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddInterviewIDs(ids...)
+// AddAgentIDs adds the "agents" edge to the Agent entity by IDs.
+func (_u *TenantUpdateOne) AddAgentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddAgentIDs(ids...)
 	return _u
 }
 
-// AddInterviews adds the "interviews" edges to the Interview entity.
-func (_u *TenantUpdateOne) AddInterviews(v ...*Interview) *TenantUpdateOne {
+// AddAgents adds the "agents" edges to the Agent entity.
+func (_u *TenantUpdateOne) AddAgents(v ...*Agent) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddInterviewIDs(ids...)
+	return _u.AddAgentIDs(ids...)
 }
 
-// AddBenefitPlanIDs adds the "benefit_plans" edge to the BenefitPlan entity by IDs.
-func (_u *TenantUpdateOne) AddBenefitPlanIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddBenefitPlanIDs(ids...)
+// AddDiscoveryEntryIDs adds the "discovery_entries" edge to the DiscoveryEntry entity by IDs.
+func (_u *TenantUpdateOne) AddDiscoveryEntryIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddDiscoveryEntryIDs(ids...)
 	return _u
 }
 
-// AddBenefitPlans adds the "benefit_plans" edges to the BenefitPlan entity.
-func (_u *TenantUpdateOne) AddBenefitPlans(v ...*BenefitPlan) *TenantUpdateOne {
+// AddDiscoveryEntries adds the "discovery_entries" edges to the DiscoveryEntry entity.
+func (_u *TenantUpdateOne) AddDiscoveryEntries(v ...*DiscoveryEntry) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddBenefitPlanIDs(ids...)
+	return _u.AddDiscoveryEntryIDs(ids...)
 }
 
-// AddBenefitEnrollmentIDs adds the "benefit_enrollments" edge to the BenefitEnrollment entity by IDs.
-func (_u *TenantUpdateOne) AddBenefitEnrollmentIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.AddBenefitEnrollmentIDs(ids...)
+// AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
+func (_u *TenantUpdateOne) AddAssetIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddAssetIDs(ids...)
 	return _u
 }
 
-// AddBenefitEnrollments adds the "benefit_enrollments" edges to the BenefitEnrollment entity.
-func (_u *TenantUpdateOne) AddBenefitEnrollments(v ...*BenefitEnrollment) *TenantUpdateOne {
+// AddAssets adds the "assets" edges to the Asset entity.
+func (_u *TenantUpdateOne) AddAssets(v ...*Asset) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddBenefitEnrollmentIDs(ids...)
-}
-
-// Mutation returns the TenantMutation object of the builder.
-func (_u *TenantUpdateOne) Mutation() *TenantMutation {
-	return _u.mutation
+	return _u.AddAssetIDs(ids...)
 }
 
-// ClearParent clears the "parent" edge to the Tenant entity.
-func (_u *TenantUpdateOne) ClearParent() *TenantUpdateOne {
-	_u.mutation.ClearParent()
+// AddCredentialIDs adds the "credentials" edge to the Credential entity by IDs.
+func (_u *TenantUpdateOne) AddCredentialIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddCredentialIDs(ids...)
 	return _u
 }
 
-// ClearChildren clears all "children" edges to the Tenant entity.
-func (_u *TenantUpdateOne) ClearChildren() *TenantUpdateOne {
-	_u.mutation.ClearChildren()
-	return _u
+// AddCredentials adds the "credentials" edges to the Credential entity.
+func (_u *TenantUpdateOne) AddCredentials(v ...*Credential) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCredentialIDs(ids...)
 }
 
-// RemoveChildIDs removes the "children" edge to Tenant entities by IDs.
-func (_u *TenantUpdateOne) RemoveChildIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveChildIDs(ids...)
+// AddOneTimeLinkIDs adds the "one_time_links" edge to the OneTimeLink entity by IDs.
+func (_u *TenantUpdateOne) AddOneTimeLinkIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddOneTimeLinkIDs(ids...)
 	return _u
 }
 
-// RemoveChildren removes "children" edges to Tenant entities.
-func (_u *TenantUpdateOne) RemoveChildren(v ...*Tenant) *TenantUpdateOne {
+// AddOneTimeLinks adds the "one_time_links" edges to the OneTimeLink entity.
+func (_u *TenantUpdateOne) AddOneTimeLinks(v ...*OneTimeLink) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveChildIDs(ids...)
-}
-
-// ClearUsers clears all "users" edges to the User entity.
-func (_u *TenantUpdateOne) ClearUsers() *TenantUpdateOne {
-	_u.mutation.ClearUsers()
-	return _u
+	return _u.AddOneTimeLinkIDs(ids...)
 }
 
-// RemoveUserIDs removes the "users" edge to User entities by IDs.
-func (_u *TenantUpdateOne) RemoveUserIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveUserIDs(ids...)
+// AddSopIDs adds the "sops" edge to the SOP entity by IDs.
+func (_u *TenantUpdateOne) AddSopIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddSopIDs(ids...)
 	return _u
 }
 
-// RemoveUsers removes "users" edges to User entities.
-func (_u *TenantUpdateOne) RemoveUsers(v ...*User) *TenantUpdateOne {
+// AddSops adds the "sops" edges to the SOP entity.
+func (_u *TenantUpdateOne) AddSops(v ...*SOP) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveUserIDs(ids...)
-}
-
-// ClearAccounts clears all "accounts" edges to the Account entity.
-func (_u *TenantUpdateOne) ClearAccounts() *TenantUpdateOne {
-	_u.mutation.ClearAccounts()
-	return _u
+	return _u.AddSopIDs(ids...)
 }
 
-// RemoveAccountIDs removes the "accounts" edge to Account entities by IDs.
-func (_u *TenantUpdateOne) RemoveAccountIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveAccountIDs(ids...)
+// AddCameraIDs adds the "cameras" edge to the Camera entity by IDs.
+func (_u *TenantUpdateOne) AddCameraIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddCameraIDs(ids...)
 	return _u
 }
 
-// RemoveAccounts removes "accounts" edges to Account entities.
-func (_u *TenantUpdateOne) RemoveAccounts(v ...*Account) *TenantUpdateOne {
+// AddCameras adds the "cameras" edges to the Camera entity.
+func (_u *TenantUpdateOne) AddCameras(v ...*Camera) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAccountIDs(ids...)
+	return _u.AddCameraIDs(ids...)
 }
 
-// ClearTransactions clears all "transactions" edges to the Transaction entity.
-func (_u *TenantUpdateOne) ClearTransactions() *TenantUpdateOne {
-	_u.mutation.ClearTransactions()
+// AddTicketIDs adds the "tickets" edge to the Ticket entity by IDs.
+func (_u *TenantUpdateOne) AddTicketIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddTicketIDs(ids...)
 	return _u
 }
 
-// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
-func (_u *TenantUpdateOne) RemoveTransactionIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveTransactionIDs(ids...)
-	return _u
-}
-
-// RemoveTransactions removes "transactions" edges to Transaction entities.
-func (_u *TenantUpdateOne) RemoveTransactions(v ...*Transaction) *TenantUpdateOne {
+// AddTickets adds the "tickets" edges to the Ticket entity.
+func (_u *TenantUpdateOne) AddTickets(v ...*Ticket) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTransactionIDs(ids...)
-}
-
-// ClearLedgerEntries clears all "ledger_entries" edges to the LedgerEntry entity.
-func (_u *TenantUpdateOne) ClearLedgerEntries() *TenantUpdateOne {
-	_u.mutation.ClearLedgerEntries()
-	return _u
+	return _u.AddTicketIDs(ids...)
 }
 
-// RemoveLedgerEntryIDs removes the "ledger_entries" edge to LedgerEntry entities by IDs.
-func (_u *TenantUpdateOne) RemoveLedgerEntryIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveLedgerEntryIDs(ids...)
+// AddContractIDs adds the "contracts" edge to the Contract entity by IDs.
+func (_u *TenantUpdateOne) AddContractIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddContractIDs(ids...)
 	return _u
 }
 
-// RemoveLedgerEntries removes "ledger_entries" edges to LedgerEntry entities.
-func (_u *TenantUpdateOne) RemoveLedgerEntries(v ...*LedgerEntry) *TenantUpdateOne {
+// AddContracts adds the "contracts" edges to the Contract entity.
+func (_u *TenantUpdateOne) AddContracts(v ...*Contract) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLedgerEntryIDs(ids...)
-}
-
-// ClearProducts clears all "products" edges to the Product entity.
-func (_u *TenantUpdateOne) ClearProducts() *TenantUpdateOne {
-	_u.mutation.ClearProducts()
-	return _u
+	return _u.AddContractIDs(ids...)
 }
 
-// RemoveProductIDs removes the "products" edge to Product entities by IDs.
-func (_u *TenantUpdateOne) RemoveProductIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveProductIDs(ids...)
+// AddSaasAppIDs adds the "saas_apps" edge to the SaaSApp entity by IDs.
+func (_u *TenantUpdateOne) AddSaasAppIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddSaasAppIDs(ids...)
 	return _u
 }
 
-// RemoveProducts removes "products" edges to Product entities.
-func (_u *TenantUpdateOne) RemoveProducts(v ...*Product) *TenantUpdateOne {
+// AddSaasApps adds the "saas_apps" edges to the SaaSApp entity.
+func (_u *TenantUpdateOne) AddSaasApps(v ...*SaaSApp) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveProductIDs(ids...)
-}
-
-// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
-func (_u *TenantUpdateOne) ClearStockMovements() *TenantUpdateOne {
-	_u.mutation.ClearStockMovements()
-	return _u
+	return _u.AddSaasAppIDs(ids...)
 }
 
-// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
-func (_u *TenantUpdateOne) RemoveStockMovementIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveStockMovementIDs(ids...)
+// AddSaasFilterIDs adds the "saas_filters" edge to the SaaSFilter entity by IDs.
+func (_u *TenantUpdateOne) AddSaasFilterIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddSaasFilterIDs(ids...)
 	return _u
 }
 
-// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
-func (_u *TenantUpdateOne) RemoveStockMovements(v ...*StockMovement) *TenantUpdateOne {
+// AddSaasFilters adds the "saas_filters" edges to the SaaSFilter entity.
+func (_u *TenantUpdateOne) AddSaasFilters(v ...*SaaSFilter) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveStockMovementIDs(ids...)
-}
-
-// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
-func (_u *TenantUpdateOne) ClearAuditLogs() *TenantUpdateOne {
-	_u.mutation.ClearAuditLogs()
-	return _u
+	return _u.AddSaasFilterIDs(ids...)
 }
 
-// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
-func (_u *TenantUpdateOne) RemoveAuditLogIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveAuditLogIDs(ids...)
+// AddCallLogIDs adds the "call_logs" edge to the CallLog entity by IDs.
+func (_u *TenantUpdateOne) AddCallLogIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddCallLogIDs(ids...)
 	return _u
 }
 
-// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
-func (_u *TenantUpdateOne) RemoveAuditLogs(v ...*AuditLog) *TenantUpdateOne {
+// AddCallLogs adds the "call_logs" edges to the CallLog entity.
+func (_u *TenantUpdateOne) AddCallLogs(v ...*CallLog) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAuditLogIDs(ids...)
-}
-
-// ClearAgents clears all "agents" edges to the Agent entity.
-func (_u *TenantUpdateOne) ClearAgents() *TenantUpdateOne {
-	_u.mutation.ClearAgents()
-	return _u
+	return _u.AddCallLogIDs(ids...)
 }
 
-// RemoveAgentIDs removes the "agents" edge to Agent entities by IDs.
-func (_u *TenantUpdateOne) RemoveAgentIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveAgentIDs(ids...)
+// AddIvrFlowIDs adds the "ivr_flows" edge to the IVRFlow entity by IDs.
+func (_u *TenantUpdateOne) AddIvrFlowIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddIvrFlowIDs(ids...)
 	return _u
 }
 
-// RemoveAgents removes "agents" edges to Agent entities.
-func (_u *TenantUpdateOne) RemoveAgents(v ...*Agent) *TenantUpdateOne {
+// AddIvrFlows adds the "ivr_flows" edges to the IVRFlow entity.
+func (_u *TenantUpdateOne) AddIvrFlows(v ...*IVRFlow) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAgentIDs(ids...)
-}
-
-// ClearDiscoveryEntries clears all "discovery_entries" edges to the DiscoveryEntry entity.
-func (_u *TenantUpdateOne) ClearDiscoveryEntries() *TenantUpdateOne {
-	_u.mutation.ClearDiscoveryEntries()
-	return _u
+	return _u.AddIvrFlowIDs(ids...)
 }
 
-// RemoveDiscoveryEntryIDs removes the "discovery_entries" edge to DiscoveryEntry entities by IDs.
-func (_u *TenantUpdateOne) RemoveDiscoveryEntryIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveDiscoveryEntryIDs(ids...)
+// AddVoicemailIDs adds the "voicemails" edge to the Voicemail entity by IDs.
+func (_u *TenantUpdateOne) AddVoicemailIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddVoicemailIDs(ids...)
 	return _u
 }
 
-// RemoveDiscoveryEntries removes "discovery_entries" edges to DiscoveryEntry entities.
-func (_u *TenantUpdateOne) RemoveDiscoveryEntries(v ...*DiscoveryEntry) *TenantUpdateOne {
+// AddVoicemails adds the "voicemails" edges to the Voicemail entity.
+func (_u *TenantUpdateOne) AddVoicemails(v ...*Voicemail) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveDiscoveryEntryIDs(ids...)
-}
-
-// ClearAssets clears all "assets" edges to the Asset entity.
-func (_u *TenantUpdateOne) ClearAssets() *TenantUpdateOne {
-	_u.mutation.ClearAssets()
-	return _u
+	return _u.AddVoicemailIDs(ids...)
 }
 
-// RemoveAssetIDs removes the "assets" edge to Asset entities by IDs.
-func (_u *TenantUpdateOne) RemoveAssetIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveAssetIDs(ids...)
+// AddHealthSnapshotIDs adds the "health_snapshots" edge to the HealthScoreSnapshot entity by IDs.
+func (_u *TenantUpdateOne) AddHealthSnapshotIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddHealthSnapshotIDs(ids...)
 	return _u
 }
 
-// RemoveAssets removes "assets" edges to Asset entities.
-func (_u *TenantUpdateOne) RemoveAssets(v ...*Asset) *TenantUpdateOne {
+// AddHealthSnapshots adds the "health_snapshots" edges to the HealthScoreSnapshot entity.
+func (_u *TenantUpdateOne) AddHealthSnapshots(v ...*HealthScoreSnapshot) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAssetIDs(ids...)
-}
-
-// ClearCredentials clears all "credentials" edges to the Credential entity.
-func (_u *TenantUpdateOne) ClearCredentials() *TenantUpdateOne {
-	_u.mutation.ClearCredentials()
-	return _u
+	return _u.AddHealthSnapshotIDs(ids...)
 }
 
-// RemoveCredentialIDs removes the "credentials" edge to Credential entities by IDs.
-func (_u *TenantUpdateOne) RemoveCredentialIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveCredentialIDs(ids...)
+// AddRoadmapIDs adds the "roadmaps" edge to the StrategicRoadmap entity by IDs.
+func (_u *TenantUpdateOne) AddRoadmapIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddRoadmapIDs(ids...)
 	return _u
 }
 
-// RemoveCredentials removes "credentials" edges to Credential entities.
-func (_u *TenantUpdateOne) RemoveCredentials(v ...*Credential) *TenantUpdateOne {
+// AddRoadmaps adds the "roadmaps" edges to the StrategicRoadmap entity.
+func (_u *TenantUpdateOne) AddRoadmaps(v ...*StrategicRoadmap) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCredentialIDs(ids...)
-}
-
-// ClearOneTimeLinks clears all "one_time_links" edges to the OneTimeLink entity.
-func (_u *TenantUpdateOne) ClearOneTimeLinks() *TenantUpdateOne {
-	_u.mutation.ClearOneTimeLinks()
-	return _u
+	return _u.AddRoadmapIDs(ids...)
 }
 
-// RemoveOneTimeLinkIDs removes the "one_time_links" edge to OneTimeLink entities by IDs.
-func (_u *TenantUpdateOne) RemoveOneTimeLinkIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveOneTimeLinkIDs(ids...)
+// AddServiceRateIDs adds the "service_rates" edge to the ServiceRate entity by IDs.
+func (_u *TenantUpdateOne) AddServiceRateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddServiceRateIDs(ids...)
 	return _u
 }
 
-// RemoveOneTimeLinks removes "one_time_links" edges to OneTimeLink entities.
-func (_u *TenantUpdateOne) RemoveOneTimeLinks(v ...*OneTimeLink) *TenantUpdateOne {
+// AddServiceRates adds the "service_rates" edges to the ServiceRate entity.
+func (_u *TenantUpdateOne) AddServiceRates(v ...*ServiceRate) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveOneTimeLinkIDs(ids...)
-}
-
-// ClearSops clears all "sops" edges to the SOP entity.
-func (_u *TenantUpdateOne) ClearSops() *TenantUpdateOne {
-	_u.mutation.ClearSops()
-	return _u
+	return _u.AddServiceRateIDs(ids...)
 }
 
-// RemoveSopIDs removes the "sops" edge to SOP entities by IDs.
-func (_u *TenantUpdateOne) RemoveSopIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveSopIDs(ids...)
+// AddNetworkDeviceIDs adds the "network_devices" edge to the NetworkDevice entity by IDs.
+func (_u *TenantUpdateOne) AddNetworkDeviceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddNetworkDeviceIDs(ids...)
 	return _u
 }
 
-// RemoveSops removes "sops" edges to SOP entities.
-func (_u *TenantUpdateOne) RemoveSops(v ...*SOP) *TenantUpdateOne {
+// AddNetworkDevices adds the "network_devices" edges to the NetworkDevice entity.
+func (_u *TenantUpdateOne) AddNetworkDevices(v ...*NetworkDevice) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSopIDs(ids...)
-}
-
-// ClearCameras clears all "cameras" edges to the Camera entity.
-func (_u *TenantUpdateOne) ClearCameras() *TenantUpdateOne {
-	_u.mutation.ClearCameras()
-	return _u
+	return _u.AddNetworkDeviceIDs(ids...)
 }
 
-// RemoveCameraIDs removes the "cameras" edge to Camera entities by IDs.
-func (_u *TenantUpdateOne) RemoveCameraIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveCameraIDs(ids...)
+// AddNetworkBackupIDs adds the "network_backups" edge to the NetworkBackup entity by IDs.
+func (_u *TenantUpdateOne) AddNetworkBackupIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddNetworkBackupIDs(ids...)
 	return _u
 }
 
-// RemoveCameras removes "cameras" edges to Camera entities.
-func (_u *TenantUpdateOne) RemoveCameras(v ...*Camera) *TenantUpdateOne {
+// AddNetworkBackups adds the "network_backups" edges to the NetworkBackup entity.
+func (_u *TenantUpdateOne) AddNetworkBackups(v ...*NetworkBackup) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCameraIDs(ids...)
-}
-
-// ClearTickets clears all "tickets" edges to the Ticket entity.
-func (_u *TenantUpdateOne) ClearTickets() *TenantUpdateOne {
-	_u.mutation.ClearTickets()
-	return _u
+	return _u.AddNetworkBackupIDs(ids...)
 }
 
-// RemoveTicketIDs removes the "tickets" edge to Ticket entities by IDs.
-func (_u *TenantUpdateOne) RemoveTicketIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveTicketIDs(ids...)
+// AddBudgetForecastIDs adds the "budget_forecasts" edge to the BudgetForecast entity by IDs.
+func (_u *TenantUpdateOne) AddBudgetForecastIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddBudgetForecastIDs(ids...)
 	return _u
 }
 
-// RemoveTickets removes "tickets" edges to Ticket entities.
-func (_u *TenantUpdateOne) RemoveTickets(v ...*Ticket) *TenantUpdateOne {
+// AddBudgetForecasts adds the "budget_forecasts" edges to the BudgetForecast entity.
+func (_u *TenantUpdateOne) AddBudgetForecasts(v ...*BudgetForecast) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTicketIDs(ids...)
-}
-
-// ClearContracts clears all "contracts" edges to the Contract entity.
-func (_u *TenantUpdateOne) ClearContracts() *TenantUpdateOne {
-	_u.mutation.ClearContracts()
-	return _u
+	return _u.AddBudgetForecastIDs(ids...)
 }
 
-// RemoveContractIDs removes the "contracts" edge to Contract entities by IDs.
-func (_u *TenantUpdateOne) RemoveContractIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveContractIDs(ids...)
+// AddEmployeeIDs adds the "employees" edge to the Employee entity by IDs.
+func (_u *TenantUpdateOne) AddEmployeeIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddEmployeeIDs(ids...)
 	return _u
 }
 
-// RemoveContracts removes "contracts" edges to Contract entities.
-func (_u *TenantUpdateOne) RemoveContracts(v ...*Contract) *TenantUpdateOne {
+// AddEmployees adds the "employees" edges to the Employee entity.
+func (_u *TenantUpdateOne) AddEmployees(v ...*Employee) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveContractIDs(ids...)
+	return _u.AddEmployeeIDs(ids...)
 }
 
-// ClearSaasApps clears all "saas_apps" edges to the SaaSApp entity.
-func (_u *TenantUpdateOne) ClearSaasApps() *TenantUpdateOne {
-	_u.mutation.ClearSaasApps()
+// AddCompensationAgreementIDs adds the "compensation_agreements" edge to the CompensationAgreement entity by IDs.
+func (_u *TenantUpdateOne) AddCompensationAgreementIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddCompensationAgreementIDs(ids...)
 	return _u
 }
 
-// RemoveSaasAppIDs removes the "saas_apps" edge to SaaSApp entities by IDs.
-func (_u *TenantUpdateOne) RemoveSaasAppIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveSaasAppIDs(ids...)
-	return _u
-}
-
-// RemoveSaasApps removes "saas_apps" edges to SaaSApp entities.
-func (_u *TenantUpdateOne) RemoveSaasApps(v ...*SaaSApp) *TenantUpdateOne {
+// AddCompensationAgreements adds the "compensation_agreements" edges to the CompensationAgreement entity.
+func (_u *TenantUpdateOne) AddCompensationAgreements(v ...*CompensationAgreement) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSaasAppIDs(ids...)
-}
-
-// ClearSaasFilters clears all "saas_filters" edges to the SaaSFilter entity.
-func (_u *TenantUpdateOne) ClearSaasFilters() *TenantUpdateOne {
-	_u.mutation.ClearSaasFilters()
-	return _u
+	return _u.AddCompensationAgreementIDs(ids...)
 }
 
-// RemoveSaasFilterIDs removes the "saas_filters" edge to SaaSFilter entities by IDs.
-func (_u *TenantUpdateOne) RemoveSaasFilterIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveSaasFilterIDs(ids...)
+// AddVaultItemIDs adds the "vault_items" edge to the VaultItem entity by IDs.
+func (_u *TenantUpdateOne) AddVaultItemIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddVaultItemIDs(ids...)
 	return _u
 }
 
-// RemoveSaasFilters removes "saas_filters" edges to SaaSFilter entities.
-func (_u *TenantUpdateOne) RemoveSaasFilters(v ...*SaaSFilter) *TenantUpdateOne {
+// AddVaultItems adds the "vault_items" edges to the VaultItem entity.
+func (_u *TenantUpdateOne) AddVaultItems(v ...*VaultItem) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSaasFilterIDs(ids...)
-}
-
-// ClearCallLogs clears all "call_logs" edges to the CallLog entity.
-func (_u *TenantUpdateOne) ClearCallLogs() *TenantUpdateOne {
-	_u.mutation.ClearCallLogs()
-	return _u
+	return _u.AddVaultItemIDs(ids...)
 }
 
-// RemoveCallLogIDs removes the "call_logs" edge to CallLog entities by IDs.
-func (_u *TenantUpdateOne) RemoveCallLogIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveCallLogIDs(ids...)
+// AddVaultShareLinkIDs adds the "vault_share_links" edge to the VaultShareLink entity by IDs.
+func (_u *TenantUpdateOne) AddVaultShareLinkIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddVaultShareLinkIDs(ids...)
 	return _u
 }
 
-// RemoveCallLogs removes "call_logs" edges to CallLog entities.
-func (_u *TenantUpdateOne) RemoveCallLogs(v ...*CallLog) *TenantUpdateOne {
+// AddVaultShareLinks adds the "vault_share_links" edges to the VaultShareLink entity.
+func (_u *TenantUpdateOne) AddVaultShareLinks(v ...*VaultShareLink) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCallLogIDs(ids...)
-}
-
-// ClearIvrFlows clears all "ivr_flows" edges to the IVRFlow entity.
-func (_u *TenantUpdateOne) ClearIvrFlows() *TenantUpdateOne {
-	_u.mutation.ClearIvrFlows()
-	return _u
+	return _u.AddVaultShareLinkIDs(ids...)
 }
 
-// RemoveIvrFlowIDs removes the "ivr_flows" edge to IVRFlow entities by IDs.
-func (_u *TenantUpdateOne) RemoveIvrFlowIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveIvrFlowIDs(ids...)
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by IDs.
+func (_u *TenantUpdateOne) AddJournalEntryIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddJournalEntryIDs(ids...)
 	return _u
 }
 
-// RemoveIvrFlows removes "ivr_flows" edges to IVRFlow entities.
-func (_u *TenantUpdateOne) RemoveIvrFlows(v ...*IVRFlow) *TenantUpdateOne {
+// AddJournalEntries adds the "journal_entries" edges to the JournalEntry entity.
+func (_u *TenantUpdateOne) AddJournalEntries(v ...*JournalEntry) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveIvrFlowIDs(ids...)
-}
-
-// ClearVoicemails clears all "voicemails" edges to the Voicemail entity.
-func (_u *TenantUpdateOne) ClearVoicemails() *TenantUpdateOne {
-	_u.mutation.ClearVoicemails()
-	return _u
+	return _u.AddJournalEntryIDs(ids...)
 }
 
-// RemoveVoicemailIDs removes the "voicemails" edge to Voicemail entities by IDs.
-func (_u *TenantUpdateOne) RemoveVoicemailIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveVoicemailIDs(ids...)
+// AddRecurringInvoiceIDs adds the "recurring_invoices" edge to the RecurringInvoice entity by IDs.
+func (_u *TenantUpdateOne) AddRecurringInvoiceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddRecurringInvoiceIDs(ids...)
 	return _u
 }
 
-// RemoveVoicemails removes "voicemails" edges to Voicemail entities.
-func (_u *TenantUpdateOne) RemoveVoicemails(v ...*Voicemail) *TenantUpdateOne {
+// AddRecurringInvoices adds the "recurring_invoices" edges to the RecurringInvoice entity.
+func (_u *TenantUpdateOne) AddRecurringInvoices(v ...*RecurringInvoice) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveVoicemailIDs(ids...)
-}
-
-// ClearHealthSnapshots clears all "health_snapshots" edges to the HealthScoreSnapshot entity.
-func (_u *TenantUpdateOne) ClearHealthSnapshots() *TenantUpdateOne {
-	_u.mutation.ClearHealthSnapshots()
-	return _u
+	return _u.AddRecurringInvoiceIDs(ids...)
 }
 
-// RemoveHealthSnapshotIDs removes the "health_snapshots" edge to HealthScoreSnapshot entities by IDs.
-func (_u *TenantUpdateOne) RemoveHealthSnapshotIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveHealthSnapshotIDs(ids...)
+// AddExchangeRateIDs adds the "exchange_rates" edge to the ExchangeRate entity by IDs.
+func (_u *TenantUpdateOne) AddExchangeRateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddExchangeRateIDs(ids...)
 	return _u
 }
 
-// RemoveHealthSnapshots removes "health_snapshots" edges to HealthScoreSnapshot entities.
-func (_u *TenantUpdateOne) RemoveHealthSnapshots(v ...*HealthScoreSnapshot) *TenantUpdateOne {
+// AddExchangeRates adds the "exchange_rates" edges to the ExchangeRate entity.
+func (_u *TenantUpdateOne) AddExchangeRates(v ...*ExchangeRate) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveHealthSnapshotIDs(ids...)
-}
-
-// ClearRoadmaps clears all "roadmaps" edges to the StrategicRoadmap entity.
-func (_u *TenantUpdateOne) ClearRoadmaps() *TenantUpdateOne {
-	_u.mutation.ClearRoadmaps()
-	return _u
+	return _u.AddExchangeRateIDs(ids...)
 }
 
-// RemoveRoadmapIDs removes the "roadmaps" edge to StrategicRoadmap entities by IDs.
-func (_u *TenantUpdateOne) RemoveRoadmapIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveRoadmapIDs(ids...)
+// AddFiscalPeriodIDs adds the "fiscal_periods" edge to the FiscalPeriod entity by IDs.
+func (_u *TenantUpdateOne) AddFiscalPeriodIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddFiscalPeriodIDs(ids...)
 	return _u
 }
 
-// RemoveRoadmaps removes "roadmaps" edges to StrategicRoadmap entities.
-func (_u *TenantUpdateOne) RemoveRoadmaps(v ...*StrategicRoadmap) *TenantUpdateOne {
+// AddFiscalPeriods adds the "fiscal_periods" edges to the FiscalPeriod entity.
+func (_u *TenantUpdateOne) AddFiscalPeriods(v ...*FiscalPeriod) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveRoadmapIDs(ids...)
-}
-
-// ClearServiceRates clears all "service_rates" edges to the ServiceRate entity.
-func (_u *TenantUpdateOne) ClearServiceRates() *TenantUpdateOne {
-	_u.mutation.ClearServiceRates()
-	return _u
+	return _u.AddFiscalPeriodIDs(ids...)
 }
 
-// RemoveServiceRateIDs removes the "service_rates" edge to ServiceRate entities by IDs.
-func (_u *TenantUpdateOne) RemoveServiceRateIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveServiceRateIDs(ids...)
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the AccountBalanceSnapshot entity by IDs.
+func (_u *TenantUpdateOne) AddBalanceSnapshotIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddBalanceSnapshotIDs(ids...)
 	return _u
 }
 
-// RemoveServiceRates removes "service_rates" edges to ServiceRate entities.
-func (_u *TenantUpdateOne) RemoveServiceRates(v ...*ServiceRate) *TenantUpdateOne {
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_u *TenantUpdateOne) AddBalanceSnapshots(v ...*AccountBalanceSnapshot) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveServiceRateIDs(ids...)
-}
-
-// ClearNetworkDevices clears all "network_devices" edges to the NetworkDevice entity.
-func (_u *TenantUpdateOne) ClearNetworkDevices() *TenantUpdateOne {
-	_u.mutation.ClearNetworkDevices()
-	return _u
+	return _u.AddBalanceSnapshotIDs(ids...)
 }
 
-// RemoveNetworkDeviceIDs removes the "network_devices" edge to NetworkDevice entities by IDs.
-func (_u *TenantUpdateOne) RemoveNetworkDeviceIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveNetworkDeviceIDs(ids...)
+// AddBankStatementIDs adds the "bank_statements" edge to the BankStatement entity by IDs.
+func (_u *TenantUpdateOne) AddBankStatementIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddBankStatementIDs(ids...)
 	return _u
 }
 
-// RemoveNetworkDevices removes "network_devices" edges to NetworkDevice entities.
-func (_u *TenantUpdateOne) RemoveNetworkDevices(v ...*NetworkDevice) *TenantUpdateOne {
+// AddBankStatements adds the "bank_statements" edges to the BankStatement entity.
+func (_u *TenantUpdateOne) AddBankStatements(v ...*BankStatement) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNetworkDeviceIDs(ids...)
-}
-
-// ClearNetworkBackups clears all "network_backups" edges to the NetworkBackup entity.
-func (_u *TenantUpdateOne) ClearNetworkBackups() *TenantUpdateOne {
-	_u.mutation.ClearNetworkBackups()
-	return _u
+	return _u.AddBankStatementIDs(ids...)
 }
 
-// RemoveNetworkBackupIDs removes the "network_backups" edge to NetworkBackup entities by IDs.
-func (_u *TenantUpdateOne) RemoveNetworkBackupIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveNetworkBackupIDs(ids...)
+// AddBankStatementLineIDs adds the "bank_statement_lines" edge to the BankStatementLine entity by IDs.
+func (_u *TenantUpdateOne) AddBankStatementLineIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddBankStatementLineIDs(ids...)
 	return _u
 }
 
-// RemoveNetworkBackups removes "network_backups" edges to NetworkBackup entities.
-func (_u *TenantUpdateOne) RemoveNetworkBackups(v ...*NetworkBackup) *TenantUpdateOne {
+// AddBankStatementLines adds the "bank_statement_lines" edges to the BankStatementLine entity.
+func (_u *TenantUpdateOne) AddBankStatementLines(v ...*BankStatementLine) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNetworkBackupIDs(ids...)
-}
-
-// ClearBudgetForecasts clears all "budget_forecasts" edges to the BudgetForecast entity.
-func (_u *TenantUpdateOne) ClearBudgetForecasts() *TenantUpdateOne {
-	_u.mutation.ClearBudgetForecasts()
-	return _u
+	return _u.AddBankStatementLineIDs(ids...)
 }
 
-// RemoveBudgetForecastIDs removes the "budget_forecasts" edge to BudgetForecast entities by IDs.
-func (_u *TenantUpdateOne) RemoveBudgetForecastIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveBudgetForecastIDs(ids...)
+// AddBankRuleIDs adds the "bank_rules" edge to the BankRule entity by IDs.
+func (_u *TenantUpdateOne) AddBankRuleIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddBankRuleIDs(ids...)
 	return _u
 }
 
-// RemoveBudgetForecasts removes "budget_forecasts" edges to BudgetForecast entities.
-func (_u *TenantUpdateOne) RemoveBudgetForecasts(v ...*BudgetForecast) *TenantUpdateOne {
+// AddBankRules adds the "bank_rules" edges to the BankRule entity.
+func (_u *TenantUpdateOne) AddBankRules(v ...*BankRule) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBudgetForecastIDs(ids...)
-}
-
-// ClearEmployees clears all "employees" edges to the Employee entity.
-func (_u *TenantUpdateOne) ClearEmployees() *TenantUpdateOne {
-	_u.mutation.ClearEmployees()
-	return _u
+	return _u.AddBankRuleIDs(ids...)
 }
 
-// RemoveEmployeeIDs removes the "employees" edge to Employee entities by IDs.
-func (_u *TenantUpdateOne) RemoveEmployeeIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveEmployeeIDs(ids...)
+// AddCustomerIDs adds the "customers" edge to the Customer entity by IDs.
+func (_u *TenantUpdateOne) AddCustomerIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddCustomerIDs(ids...)
 	return _u
 }
 
-// RemoveEmployees removes "employees" edges to Employee entities.
-func (_u *TenantUpdateOne) RemoveEmployees(v ...*Employee) *TenantUpdateOne {
+// AddCustomers adds the "customers" edges to the Customer entity.
+func (_u *TenantUpdateOne) AddCustomers(v ...*Customer) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEmployeeIDs(ids...)
-}
-
-// ClearCompensationAgreements clears all "compensation_agreements" edges to the CompensationAgreement entity.
-func (_u *TenantUpdateOne) ClearCompensationAgreements() *TenantUpdateOne {
-	_u.mutation.ClearCompensationAgreements()
-	return _u
+	return _u.AddCustomerIDs(ids...)
 }
 
-// RemoveCompensationAgreementIDs removes the "compensation_agreements" edge to CompensationAgreement entities by IDs.
-func (_u *TenantUpdateOne) RemoveCompensationAgreementIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveCompensationAgreementIDs(ids...)
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *TenantUpdateOne) AddInvoiceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddInvoiceIDs(ids...)
 	return _u
 }
 
-// RemoveCompensationAgreements removes "compensation_agreements" edges to CompensationAgreement entities.
-func (_u *TenantUpdateOne) RemoveCompensationAgreements(v ...*CompensationAgreement) *TenantUpdateOne {
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *TenantUpdateOne) AddInvoices(v ...*Invoice) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCompensationAgreementIDs(ids...)
-}
-
-// ClearVaultItems clears all "vault_items" edges to the VaultItem entity.
-func (_u *TenantUpdateOne) ClearVaultItems() *TenantUpdateOne {
-	_u.mutation.ClearVaultItems()
-	return _u
+	return _u.AddInvoiceIDs(ids...)
 }
 
-// RemoveVaultItemIDs removes the "vault_items" edge to VaultItem entities by IDs.
-func (_u *TenantUpdateOne) RemoveVaultItemIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveVaultItemIDs(ids...)
+// AddCustomerPaymentIDs adds the "customer_payments" edge to the CustomerPayment entity by IDs.
+func (_u *TenantUpdateOne) AddCustomerPaymentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddCustomerPaymentIDs(ids...)
 	return _u
 }
 
-// RemoveVaultItems removes "vault_items" edges to VaultItem entities.
-func (_u *TenantUpdateOne) RemoveVaultItems(v ...*VaultItem) *TenantUpdateOne {
+// AddCustomerPayments adds the "customer_payments" edges to the CustomerPayment entity.
+func (_u *TenantUpdateOne) AddCustomerPayments(v ...*CustomerPayment) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveVaultItemIDs(ids...)
-}
-
-// ClearVaultShareLinks clears all "vault_share_links" edges to the VaultShareLink entity.
-func (_u *TenantUpdateOne) ClearVaultShareLinks() *TenantUpdateOne {
-	_u.mutation.ClearVaultShareLinks()
-	return _u
+	return _u.AddCustomerPaymentIDs(ids...)
 }
 
-// RemoveVaultShareLinkIDs removes the "vault_share_links" edge to VaultShareLink entities by IDs.
-func (_u *TenantUpdateOne) RemoveVaultShareLinkIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveVaultShareLinkIDs(ids...)
+// AddSupplierBillIDs adds the "supplier_bills" edge to the SupplierBill entity by IDs.
+func (_u *TenantUpdateOne) AddSupplierBillIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddSupplierBillIDs(ids...)
 	return _u
 }
 
-// RemoveVaultShareLinks removes "vault_share_links" edges to VaultShareLink entities.
-func (_u *TenantUpdateOne) RemoveVaultShareLinks(v ...*VaultShareLink) *TenantUpdateOne {
+// AddSupplierBills adds the "supplier_bills" edges to the SupplierBill entity.
+func (_u *TenantUpdateOne) AddSupplierBills(v ...*SupplierBill) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveVaultShareLinkIDs(ids...)
-}
-
-// ClearJournalEntries clears all "journal_entries" edges to the JournalEntry entity.
-func (_u *TenantUpdateOne) ClearJournalEntries() *TenantUpdateOne {
-	_u.mutation.ClearJournalEntries()
-	return _u
+	return _u.AddSupplierBillIDs(ids...)
 }
 
-// RemoveJournalEntryIDs removes the "journal_entries" edge to JournalEntry entities by IDs.
-func (_u *TenantUpdateOne) RemoveJournalEntryIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveJournalEntryIDs(ids...)
+// AddPaymentRunIDs adds the "payment_runs" edge to the PaymentRun entity by IDs.
+func (_u *TenantUpdateOne) AddPaymentRunIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddPaymentRunIDs(ids...)
 	return _u
 }
 
-// RemoveJournalEntries removes "journal_entries" edges to JournalEntry entities.
-func (_u *TenantUpdateOne) RemoveJournalEntries(v ...*JournalEntry) *TenantUpdateOne {
+// AddPaymentRuns adds the "payment_runs" edges to the PaymentRun entity.
+func (_u *TenantUpdateOne) AddPaymentRuns(v ...*PaymentRun) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveJournalEntryIDs(ids...)
-}
-
-// ClearRecurringInvoices clears all "recurring_invoices" edges to the RecurringInvoice entity.
-func (_u *TenantUpdateOne) ClearRecurringInvoices() *TenantUpdateOne {
-	_u.mutation.ClearRecurringInvoices()
-	return _u
+	return _u.AddPaymentRunIDs(ids...)
 }
 
-// RemoveRecurringInvoiceIDs removes the "recurring_invoices" edge to RecurringInvoice entities by IDs.
-func (_u *TenantUpdateOne) RemoveRecurringInvoiceIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveRecurringInvoiceIDs(ids...)
+// AddGoodsReceiptIDs adds the "goods_receipts" edge to the GoodsReceipt entity by IDs.
+func (_u *TenantUpdateOne) AddGoodsReceiptIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddGoodsReceiptIDs(ids...)
 	return _u
 }
 
-// RemoveRecurringInvoices removes "recurring_invoices" edges to RecurringInvoice entities.
-func (_u *TenantUpdateOne) RemoveRecurringInvoices(v ...*RecurringInvoice) *TenantUpdateOne {
+// AddGoodsReceipts adds the "goods_receipts" edges to the GoodsReceipt entity.
+func (_u *TenantUpdateOne) AddGoodsReceipts(v ...*GoodsReceipt) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveRecurringInvoiceIDs(ids...)
-}
-
-// ClearInventoryReservations clears all "inventory_reservations" edges to the InventoryReservation entity.
-func (_u *TenantUpdateOne) ClearInventoryReservations() *TenantUpdateOne {
-	_u.mutation.ClearInventoryReservations()
-	return _u
+	return _u.AddGoodsReceiptIDs(ids...)
 }
 
-// RemoveInventoryReservationIDs removes the "inventory_reservations" edge to InventoryReservation entities by IDs.
-func (_u *TenantUpdateOne) RemoveInventoryReservationIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveInventoryReservationIDs(ids...)
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_u *TenantUpdateOne) AddStockLevelIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddStockLevelIDs(ids...)
 	return _u
 }
 
-// RemoveInventoryReservations removes "inventory_reservations" edges to InventoryReservation entities.
-func (_u *TenantUpdateOne) RemoveInventoryReservations(v ...*InventoryReservation) *TenantUpdateOne {
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_u *TenantUpdateOne) AddStockLevels(v ...*StockLevel) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveInventoryReservationIDs(ids...)
-}
-
-// ClearDepartments clears all "departments" edges to the Department entity.
-func (_u *TenantUpdateOne) ClearDepartments() *TenantUpdateOne {
-	_u.mutation.ClearDepartments()
-	return _u
+	return _u.AddStockLevelIDs(ids...)
 }
 
-// RemoveDepartmentIDs removes the "departments" edge to Department entities by IDs.
-func (_u *TenantUpdateOne) RemoveDepartmentIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveDepartmentIDs(ids...)
+// AddTransferOrderIDs adds the "transfer_orders" edge to the TransferOrder entity by IDs.
+func (_u *TenantUpdateOne) AddTransferOrderIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddTransferOrderIDs(ids...)
 	return _u
 }
 
-// RemoveDepartments removes "departments" edges to Department entities.
-func (_u *TenantUpdateOne) RemoveDepartments(v ...*Department) *TenantUpdateOne {
+// AddTransferOrders adds the "transfer_orders" edges to the TransferOrder entity.
+func (_u *TenantUpdateOne) AddTransferOrders(v ...*TransferOrder) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveDepartmentIDs(ids...)
+	return _u.AddTransferOrderIDs(ids...)
 }
 
-// ClearPermissions clears all "permissions" edges to the Permission entity.
-func (_u *TenantUpdateOne) ClearPermissions() *TenantUpdateOne {
-	_u.mutation.ClearPermissions()
+// AddInventoryReservationIDs adds the "inventory_reservations" edge to the InventoryReservation entity by IDs.
+func (_u *TenantUpdateOne) AddInventoryReservationIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddInventoryReservationIDs(ids...)
 	return _u
 }
 
-// RemovePermissionIDs removes the "permissions" edge to Permission entities by IDs.
-func (_u *TenantUpdateOne) RemovePermissionIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemovePermissionIDs(ids...)
-	return _u
-}
-
-// RemovePermissions removes "permissions" edges to Permission entities.
-func (_u *TenantUpdateOne) RemovePermissions(v ...*Permission) *TenantUpdateOne {
+// AddInventoryReservations adds the "inventory_reservations" edges to the InventoryReservation entity.
+func (_u *TenantUpdateOne) AddInventoryReservations(v ...*InventoryReservation) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePermissionIDs(ids...)
-}
-
-// ClearAssetTypes clears all "asset_types" edges to the AssetType entity.
-func (_u *TenantUpdateOne) ClearAssetTypes() *TenantUpdateOne {
-	_u.mutation.ClearAssetTypes()
-	return _u
+	return _u.AddInventoryReservationIDs(ids...)
 }
 
-// RemoveAssetTypeIDs removes the "asset_types" edge to AssetType entities by IDs.
-func (_u *TenantUpdateOne) RemoveAssetTypeIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveAssetTypeIDs(ids...)
+// AddDepartmentIDs adds the "departments" edge to the Department entity by IDs.
+func (_u *TenantUpdateOne) AddDepartmentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddDepartmentIDs(ids...)
 	return _u
 }
 
-// RemoveAssetTypes removes "asset_types" edges to AssetType entities.
-func (_u *TenantUpdateOne) RemoveAssetTypes(v ...*AssetType) *TenantUpdateOne {
+// AddDepartments adds the "departments" edges to the Department entity.
+func (_u *TenantUpdateOne) AddDepartments(v ...*Department) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAssetTypeIDs(ids...)
-}
-
-// ClearDetectionEvents clears all "detection_events" edges to the DetectionEvent entity.
-func (_u *TenantUpdateOne) ClearDetectionEvents() *TenantUpdateOne {
-	_u.mutation.ClearDetectionEvents()
-	return _u
+	return _u.AddDepartmentIDs(ids...)
 }
 
-// RemoveDetectionEventIDs removes the "detection_events" edge to DetectionEvent entities by IDs.
-func (_u *TenantUpdateOne) RemoveDetectionEventIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveDetectionEventIDs(ids...)
+// AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
+func (_u *TenantUpdateOne) AddPermissionIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddPermissionIDs(ids...)
 	return _u
 }
 
-// RemoveDetectionEvents removes "detection_events" edges to DetectionEvent entities.
-func (_u *TenantUpdateOne) RemoveDetectionEvents(v ...*DetectionEvent) *TenantUpdateOne {
+// AddPermissions adds the "permissions" edges to the Permission entity.
+func (_u *TenantUpdateOne) AddPermissions(v ...*Permission) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveDetectionEventIDs(ids...)
-}
-
-// ClearSaasIdentities clears all "saas_identities" edges to the SaaSIdentity entity.
-func (_u *TenantUpdateOne) ClearSaasIdentities() *TenantUpdateOne {
-	_u.mutation.ClearSaasIdentities()
-	return _u
+	return _u.AddPermissionIDs(ids...)
 }
 
-// RemoveSaasIdentityIDs removes the "saas_identities" edge to SaaSIdentity entities by IDs.
-func (_u *TenantUpdateOne) RemoveSaasIdentityIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveSaasIdentityIDs(ids...)
+// AddAssetTypeIDs adds the "asset_types" edge to the AssetType entity by IDs.
+func (_u *TenantUpdateOne) AddAssetTypeIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddAssetTypeIDs(ids...)
 	return _u
 }
 
-// RemoveSaasIdentities removes "saas_identities" edges to SaaSIdentity entities.
-func (_u *TenantUpdateOne) RemoveSaasIdentities(v ...*SaaSIdentity) *TenantUpdateOne {
+// AddAssetTypes adds the "asset_types" edges to the AssetType entity.
+func (_u *TenantUpdateOne) AddAssetTypes(v ...*AssetType) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSaasIdentityIDs(ids...)
-}
-
-// ClearSaasUsages clears all "saas_usages" edges to the SaaSUsage entity.
-func (_u *TenantUpdateOne) ClearSaasUsages() *TenantUpdateOne {
-	_u.mutation.ClearSaasUsages()
-	return _u
+	return _u.AddAssetTypeIDs(ids...)
 }
 
-// RemoveSaasUsageIDs removes the "saas_usages" edge to SaaSUsage entities by IDs.
-func (_u *TenantUpdateOne) RemoveSaasUsageIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveSaasUsageIDs(ids...)
+// AddDetectionEventIDs adds the "detection_events" edge to the DetectionEvent entity by IDs.
+func (_u *TenantUpdateOne) AddDetectionEventIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddDetectionEventIDs(ids...)
 	return _u
 }
 
-// RemoveSaasUsages removes "saas_usages" edges to SaaSUsage entities.
-func (_u *TenantUpdateOne) RemoveSaasUsages(v ...*SaaSUsage) *TenantUpdateOne {
+// AddDetectionEvents adds the "detection_events" edges to the DetectionEvent entity.
+func (_u *TenantUpdateOne) AddDetectionEvents(v ...*DetectionEvent) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSaasUsageIDs(ids...)
-}
-
-// ClearRecordings clears all "recordings" edges to the Recording entity.
-func (_u *TenantUpdateOne) ClearRecordings() *TenantUpdateOne {
-	_u.mutation.ClearRecordings()
-	return _u
+	return _u.AddDetectionEventIDs(ids...)
 }
 
-// RemoveRecordingIDs removes the "recordings" edge to Recording entities by IDs.
-func (_u *TenantUpdateOne) RemoveRecordingIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveRecordingIDs(ids...)
+// AddSaasIdentityIDs adds the "saas_identities" edge to the SaaSIdentity entity by IDs.
+func (_u *TenantUpdateOne) AddSaasIdentityIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddSaasIdentityIDs(ids...)
 	return _u
 }
 
-// RemoveRecordings removes "recordings" edges to Recording entities.
-func (_u *TenantUpdateOne) RemoveRecordings(v ...*Recording) *TenantUpdateOne {
+// AddSaasIdentities adds the "saas_identities" edges to the SaaSIdentity entity.
+func (_u *TenantUpdateOne) AddSaasIdentities(v ...*SaaSIdentity) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveRecordingIDs(ids...)
-}
-
-// ClearNetworkLinks clears all "network_links" edges to the NetworkLink entity.
-func (_u *TenantUpdateOne) ClearNetworkLinks() *TenantUpdateOne {
-	_u.mutation.ClearNetworkLinks()
-	return _u
+	return _u.AddSaasIdentityIDs(ids...)
 }
 
-// RemoveNetworkLinkIDs removes the "network_links" edge to NetworkLink entities by IDs.
-func (_u *TenantUpdateOne) RemoveNetworkLinkIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveNetworkLinkIDs(ids...)
+// AddSaasUsageIDs adds the "saas_usages" edge to the SaaSUsage entity by IDs.
+func (_u *TenantUpdateOne) AddSaasUsageIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddSaasUsageIDs(ids...)
 	return _u
 }
 
-// RemoveNetworkLinks removes "network_links" edges to NetworkLink entities.
-func (_u *TenantUpdateOne) RemoveNetworkLinks(v ...*NetworkLink) *TenantUpdateOne {
+// AddSaasUsages adds the "saas_usages" edges to the SaaSUsage entity.
+func (_u *TenantUpdateOne) AddSaasUsages(v ...*SaaSUsage) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNetworkLinkIDs(ids...)
-}
-
-// ClearNetworkPorts clears all "network_ports" edges to the NetworkPort entity.
-func (_u *TenantUpdateOne) ClearNetworkPorts() *TenantUpdateOne {
-	_u.mutation.ClearNetworkPorts()
-	return _u
+	return _u.AddSaasUsageIDs(ids...)
 }
 
-// RemoveNetworkPortIDs removes the "network_ports" edge to NetworkPort entities by IDs.
-func (_u *TenantUpdateOne) RemoveNetworkPortIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveNetworkPortIDs(ids...)
+// AddRecordingIDs adds the "recordings" edge to the Recording entity by IDs.
+func (_u *TenantUpdateOne) AddRecordingIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddRecordingIDs(ids...)
 	return _u
 }
 
-// RemoveNetworkPorts removes "network_ports" edges to NetworkPort entities.
-func (_u *TenantUpdateOne) RemoveNetworkPorts(v ...*NetworkPort) *TenantUpdateOne {
+// AddRecordings adds the "recordings" edges to the Recording entity.
+func (_u *TenantUpdateOne) AddRecordings(v ...*Recording) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNetworkPortIDs(ids...)
+	return _u.AddRecordingIDs(ids...)
 }
 
-// ClearNexusAudits clears all "nexus_audits" edges to the NexusAudit entity.
-func (_u *TenantUpdateOne) ClearNexusAudits() *TenantUpdateOne {
-	_u.mutation.ClearNexusAudits()
+// AddNetworkLinkIDs adds the "network_links" edge to the NetworkLink entity by IDs.
+func (_u *TenantUpdateOne) AddNetworkLinkIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddNetworkLinkIDs(ids...)
 	return _u
 }
 
-// RemoveNexusAuditIDs removes the "nexus_audits" edge to NexusAudit entities by IDs.
-func (_u *TenantUpdateOne) RemoveNexusAuditIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveNexusAuditIDs(ids...)
+// AddNetworkLinks adds the "network_links" edges to the NetworkLink entity.
+func (_u *TenantUpdateOne) AddNetworkLinks(v ...*NetworkLink) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNetworkLinkIDs(ids...)
+}
+
+// AddNetworkPortIDs adds the "network_ports" edge to the NetworkPort entity by IDs.
+func (_u *TenantUpdateOne) AddNetworkPortIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddNetworkPortIDs(ids...)
 	return _u
 }
 
-// RemoveNexusAudits removes "nexus_audits" edges to NexusAudit entities.
-func (_u *TenantUpdateOne) RemoveNexusAudits(v ...*NexusAudit) *TenantUpdateOne {
+// AddNetworkPorts adds the "network_ports" edges to the NetworkPort entity.
+func (_u *TenantUpdateOne) AddNetworkPorts(v ...*NetworkPort) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNexusAuditIDs(ids...)
+	return _u.AddNetworkPortIDs(ids...)
 }
 
-// ClearSuccessionMaps clears all "succession_maps" edges to the SuccessionMap entity.
-func (_u *TenantUpdateOne) ClearSuccessionMaps() *TenantUpdateOne {
-	_u.mutation.ClearSuccessionMaps()
+// AddNexusAuditIDs adds the "nexus_audits" edge to the NexusAudit entity by IDs.
+func (_u *TenantUpdateOne) AddNexusAuditIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddNexusAuditIDs(ids...)
 	return _u
 }
 
-// RemoveSuccessionMapIDs removes the "succession_maps" edge to SuccessionMap entities by IDs.
-func (_u *TenantUpdateOne) RemoveSuccessionMapIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveSuccessionMapIDs(ids...)
+// AddNexusAudits adds the "nexus_audits" edges to the NexusAudit entity.
+func (_u *TenantUpdateOne) AddNexusAudits(v ...*NexusAudit) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNexusAuditIDs(ids...)
+}
+
+// AddSuccessionMapIDs adds the "succession_maps" edge to the SuccessionMap entity by IDs.
+func (_u *TenantUpdateOne) AddSuccessionMapIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddSuccessionMapIDs(ids...)
 	return _u
 }
 
-// RemoveSuccessionMaps removes "succession_maps" edges to SuccessionMap entities.
-func (_u *TenantUpdateOne) RemoveSuccessionMaps(v ...*SuccessionMap) *TenantUpdateOne {
+// AddSuccessionMaps adds the "succession_maps" edges to the SuccessionMap entity.
+func (_u *TenantUpdateOne) AddSuccessionMaps(v ...*SuccessionMap) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSuccessionMapIDs(ids...)
+	return _u.AddSuccessionMapIDs(ids...)
 }
 
-// ClearCustomerAccount clears the "customer_account" edge to the Account entity.
-func (_u *TenantUpdateOne) ClearCustomerAccount() *TenantUpdateOne {
-	_u.mutation.ClearCustomerAccount()
+// SetCustomerAccountID sets the "customer_account" edge to the Account entity by ID.
+func (_u *TenantUpdateOne) SetCustomerAccountID(id int) *TenantUpdateOne {
+	_u.mutation.SetCustomerAccountID(id)
 	return _u
 }
 
-// ClearScripts clears all "scripts" edges to the Script entity.
-func (_u *TenantUpdateOne) ClearScripts() *TenantUpdateOne {
-	_u.mutation.ClearScripts()
+// SetNillableCustomerAccountID sets the "customer_account" edge to the Account entity by ID if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableCustomerAccountID(id *int) *TenantUpdateOne {
+	if id != nil {
+		_u = _u.SetCustomerAccountID(*id)
+	}
 	return _u
 }
 
-// RemoveScriptIDs removes the "scripts" edge to Script entities by IDs.
-func (_u *TenantUpdateOne) RemoveScriptIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveScriptIDs(ids...)
+// SetCustomerAccount sets the "customer_account" edge to the Account entity.
+func (_u *TenantUpdateOne) SetCustomerAccount(v *Account) *TenantUpdateOne {
+	return _u.SetCustomerAccountID(v.ID)
+}
+
+// AddScriptIDs adds the "scripts" edge to the Script entity by IDs.
+func (_u *TenantUpdateOne) AddScriptIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddScriptIDs(ids...)
 	return _u
 }
 
-// RemoveScripts removes "scripts" edges to Script entities.
-func (_u *TenantUpdateOne) RemoveScripts(v ...*Script) *TenantUpdateOne {
+// AddScripts adds the "scripts" edges to the Script entity.
+func (_u *TenantUpdateOne) AddScripts(v ...*Script) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveScriptIDs(ids...)
-}
-
-// ClearJobs clears all "jobs" edges to the Job entity.
-func (_u *TenantUpdateOne) ClearJobs() *TenantUpdateOne {
-	_u.mutation.ClearJobs()
-	return _u
+	return _u.AddScriptIDs(ids...)
 }
 
-// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
-func (_u *TenantUpdateOne) RemoveJobIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveJobIDs(ids...)
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (_u *TenantUpdateOne) AddJobIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddJobIDs(ids...)
 	return _u
 }
 
-// RemoveJobs removes "jobs" edges to Job entities.
-func (_u *TenantUpdateOne) RemoveJobs(v ...*Job) *TenantUpdateOne {
+// AddJobs adds the "jobs" edges to the Job entity.
+func (_u *TenantUpdateOne) AddJobs(v ...*Job) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveJobIDs(ids...)
-}
-
-// ClearTimeOffRequests clears all "time_off_requests" edges to the TimeOffRequest entity.
-func (_u *TenantUpdateOne) ClearTimeOffRequests() *TenantUpdateOne {
-	_u.mutation.ClearTimeOffRequests()
-	return _u
+	return _u.AddJobIDs(ids...)
 }
 
-// RemoveTimeOffRequestIDs removes the "time_off_requests" edge to TimeOffRequest entities by IDs.
-func (_u *TenantUpdateOne) RemoveTimeOffRequestIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveTimeOffRequestIDs(ids...)
+// AddTimeOffRequestIDs adds the "time_off_requests" edge to the TimeOffRequest entity by IDs.
+func (_u *TenantUpdateOne) AddTimeOffRequestIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddTimeOffRequestIDs(ids...)
 	return _u
 }
 
-// RemoveTimeOffRequests removes "time_off_requests" edges to TimeOffRequest entities.
-func (_u *TenantUpdateOne) RemoveTimeOffRequests(v ...*TimeOffRequest) *TenantUpdateOne {
+// AddTimeOffRequests adds the "time_off_requests" edges to the TimeOffRequest entity.
+func (_u *TenantUpdateOne) AddTimeOffRequests(v ...*TimeOffRequest) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTimeOffRequestIDs(ids...)
+	return _u.AddTimeOffRequestIDs(ids...)
 }
 
-// ClearTimeOffPolicies clears all "time_off_policies" edges to the TimeOffPolicy entity.
-func (_u *TenantUpdateOne) ClearTimeOffPolicies() *TenantUpdateOne {
-	_u.mutation.ClearTimeOffPolicies()
-	return _u
-}
-
-// RemoveTimeOffPolicyIDs removes the "time_off_policies" edge to TimeOffPolicy entities by IDs.
-func (_u *TenantUpdateOne) RemoveTimeOffPolicyIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveTimeOffPolicyIDs(ids...)
+// AddTimeOffPolicyIDs adds the "time_off_policies" edge to the TimeOffPolicy entity by IDs.
+func (_u *TenantUpdateOne) AddTimeOffPolicyIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddTimeOffPolicyIDs(ids...)
 	return _u
 }
 
-// RemoveTimeOffPolicies removes "time_off_policies" edges to TimeOffPolicy entities.
-func (_u *TenantUpdateOne) RemoveTimeOffPolicies(v ...*TimeOffPolicy) *TenantUpdateOne {
+// AddTimeOffPolicies adds the "time_off_policies" edges to the TimeOffPolicy entity.
+func (_u *TenantUpdateOne) AddTimeOffPolicies(v ...*TimeOffPolicy) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTimeOffPolicyIDs(ids...)
-}
-
-// ClearTimeOffBalances clears all "time_off_balances" edges to the TimeOffBalance entity.
-func (_u *TenantUpdateOne) ClearTimeOffBalances() *TenantUpdateOne {
-	_u.mutation.ClearTimeOffBalances()
-	return _u
+	return _u.AddTimeOffPolicyIDs(ids...)
 }
 
-// RemoveTimeOffBalanceIDs removes the "time_off_balances" edge to TimeOffBalance entities by IDs.
-func (_u *TenantUpdateOne) RemoveTimeOffBalanceIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveTimeOffBalanceIDs(ids...)
+// AddTimeOffBalanceIDs adds the "time_off_balances" edge to the TimeOffBalance entity by IDs.
+func (_u *TenantUpdateOne) AddTimeOffBalanceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddTimeOffBalanceIDs(ids...)
 	return _u
 }
 
-// RemoveTimeOffBalances removes "time_off_balances" edges to TimeOffBalance entities.
-func (_u *TenantUpdateOne) RemoveTimeOffBalances(v ...*TimeOffBalance) *TenantUpdateOne {
+// AddTimeOffBalances adds the "time_off_balances" edges to the TimeOffBalance entity.
+func (_u *TenantUpdateOne) AddTimeOffBalances(v ...*TimeOffBalance) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTimeOffBalanceIDs(ids...)
-}
-
-// ClearReviewCycles clears all "review_cycles" edges to the ReviewCycle entity.
-func (_u *TenantUpdateOne) ClearReviewCycles() *TenantUpdateOne {
-	_u.mutation.ClearReviewCycles()
-	return _u
+	return _u.AddTimeOffBalanceIDs(ids...)
 }
 
-// RemoveReviewCycleIDs removes the "review_cycles" edge to ReviewCycle entities by IDs.
-func (_u *TenantUpdateOne) RemoveReviewCycleIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveReviewCycleIDs(ids...)
+// AddReviewCycleIDs adds the "review_cycles" edge to the ReviewCycle entity by IDs.
+func (_u *TenantUpdateOne) AddReviewCycleIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddReviewCycleIDs(ids...)
 	return _u
 }
 
-// RemoveReviewCycles removes "review_cycles" edges to ReviewCycle entities.
-func (_u *TenantUpdateOne) RemoveReviewCycles(v ...*ReviewCycle) *TenantUpdateOne {
+// AddReviewCycles adds the "review_cycles" edges to the ReviewCycle entity.
+func (_u *TenantUpdateOne) AddReviewCycles(v ...*ReviewCycle) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReviewCycleIDs(ids...)
-}
-
-// ClearPerformanceReviews clears all "performance_reviews" edges to the PerformanceReview entity.
-func (_u *TenantUpdateOne) ClearPerformanceReviews() *TenantUpdateOne {
-	_u.mutation.ClearPerformanceReviews()
-	return _u
+	return _u.AddReviewCycleIDs(ids...)
 }
 
-// RemovePerformanceReviewIDs removes the "performance_reviews" edge to PerformanceReview entities by IDs.
-func (_u *TenantUpdateOne) RemovePerformanceReviewIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemovePerformanceReviewIDs(ids...)
+// AddPerformanceReviewIDs adds the "performance_reviews" edge to the PerformanceReview entity by IDs.
+func (_u *TenantUpdateOne) AddPerformanceReviewIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddPerformanceReviewIDs(ids...)
 	return _u
 }
 
-// RemovePerformanceReviews removes "performance_reviews" edges to PerformanceReview entities.
-func (_u *TenantUpdateOne) RemovePerformanceReviews(v ...*PerformanceReview) *TenantUpdateOne {
+// AddPerformanceReviews adds the "performance_reviews" edges to the PerformanceReview entity.
+func (_u *TenantUpdateOne) AddPerformanceReviews(v ...*PerformanceReview) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePerformanceReviewIDs(ids...)
-}
-
-// ClearGoals clears all "goals" edges to the Goal entity.
-func (_u *TenantUpdateOne) ClearGoals() *TenantUpdateOne {
-	_u.mutation.ClearGoals()
-	return _u
+	return _u.AddPerformanceReviewIDs(ids...)
 }
 
-// RemoveGoalIDs removes the "goals" edge to Goal entities by IDs.
-func (_u *TenantUpdateOne) RemoveGoalIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveGoalIDs(ids...)
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (_u *TenantUpdateOne) AddGoalIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddGoalIDs(ids...)
 	return _u
 }
 
-// RemoveGoals removes "goals" edges to Goal entities.
-func (_u *TenantUpdateOne) RemoveGoals(v ...*Goal) *TenantUpdateOne {
+// AddGoals adds the "goals" edges to the Goal entity.
+func (_u *TenantUpdateOne) AddGoals(v ...*Goal) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveGoalIDs(ids...)
-}
-
-// ClearSuppliers clears all "suppliers" edges to the Supplier entity.
-func (_u *TenantUpdateOne) ClearSuppliers() *TenantUpdateOne {
-	_u.mutation.ClearSuppliers()
-	return _u
+	return _u.AddGoalIDs(ids...)
 }
 
-// RemoveSupplierIDs removes the "suppliers" edge to Supplier entities by IDs.
-func (_u *TenantUpdateOne) RemoveSupplierIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveSupplierIDs(ids...)
+// AddSupplierIDs adds the "suppliers" edge to the Supplier entity by IDs.
+func (_u *TenantUpdateOne) AddSupplierIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddSupplierIDs(ids...)
 	return _u
 }
 
-// RemoveSuppliers removes "suppliers" edges to Supplier entities.
-func (_u *TenantUpdateOne) RemoveSuppliers(v ...*Supplier) *TenantUpdateOne {
+// AddSuppliers adds the "suppliers" edges to the Supplier entity.
+func (_u *TenantUpdateOne) AddSuppliers(v ...*Supplier) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSupplierIDs(ids...)
-}
-
-// ClearCategories clears all "categories" edges to the Category entity.
-func (_u *TenantUpdateOne) ClearCategories() *TenantUpdateOne {
-	_u.mutation.ClearCategories()
-	return _u
+	return _u.AddSupplierIDs(ids...)
 }
 
-// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
-func (_u *TenantUpdateOne) RemoveCategoryIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveCategoryIDs(ids...)
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_u *TenantUpdateOne) AddCategoryIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddCategoryIDs(ids...)
 	return _u
 }
 
-// RemoveCategories removes "categories" edges to Category entities.
-func (_u *TenantUpdateOne) RemoveCategories(v ...*Category) *TenantUpdateOne {
+// AddCategories adds the "categories" edges to the Category entity.
+func (_u *TenantUpdateOne) AddCategories(v ...*Category) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCategoryIDs(ids...)
-}
-
-// ClearWarehouses clears all "warehouses" edges to the Warehouse entity.
-func (_u *TenantUpdateOne) ClearWarehouses() *TenantUpdateOne {
-	_u.mutation.ClearWarehouses()
-	return _u
+	return _u.AddCategoryIDs(ids...)
 }
 
-// RemoveWarehouseIDs removes the "warehouses" edge to Warehouse entities by IDs.
-func (_u *TenantUpdateOne) RemoveWarehouseIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveWarehouseIDs(ids...)
+// AddWarehouseIDs adds the "warehouses" edge to the Warehouse entity by IDs.
+func (_u *TenantUpdateOne) AddWarehouseIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddWarehouseIDs(ids...)
 	return _u
 }
 
-// RemoveWarehouses removes "warehouses" edges to Warehouse entities.
-func (_u *TenantUpdateOne) RemoveWarehouses(v ...*Warehouse) *TenantUpdateOne {
+// AddWarehouses adds the "warehouses" edges to the Warehouse entity.
+func (_u *TenantUpdateOne) AddWarehouses(v ...*Warehouse) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveWarehouseIDs(ids...)
-}
-
-// ClearAssetAssignments clears all "asset_assignments" edges to the AssetAssignment entity.
-func (_u *TenantUpdateOne) ClearAssetAssignments() *TenantUpdateOne {
-	_u.mutation.ClearAssetAssignments()
-	return _u
+	return _u.AddWarehouseIDs(ids...)
 }
 
-// RemoveAssetAssignmentIDs removes the "asset_assignments" edge to AssetAssignment entities by IDs.
-func (_u *TenantUpdateOne) RemoveAssetAssignmentIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveAssetAssignmentIDs(ids...)
+// AddAssetAssignmentIDs adds the "asset_assignments" edge to the AssetAssignment entity by IDs.
+func (_u *TenantUpdateOne) AddAssetAssignmentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddAssetAssignmentIDs(ids...)
 	return _u
 }
 
-// RemoveAssetAssignments removes "asset_assignments" edges to AssetAssignment entities.
-func (_u *TenantUpdateOne) RemoveAssetAssignments(v ...*AssetAssignment) *TenantUpdateOne {
+// AddAssetAssignments adds the "asset_assignments" edges to the AssetAssignment entity.
+func (_u *TenantUpdateOne) AddAssetAssignments(v ...*AssetAssignment) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAssetAssignmentIDs(ids...)
-}
-
-// ClearContacts clears all "contacts" edges to the Contact entity.
-func (_u *TenantUpdateOne) ClearContacts() *TenantUpdateOne {
-	_u.mutation.ClearContacts()
-	return _u
+	return _u.AddAssetAssignmentIDs(ids...)
 }
 
-// RemoveContactIDs removes the "contacts" edge to Contact entities by IDs.
-func (_u *TenantUpdateOne) RemoveContactIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveContactIDs(ids...)
+// AddContactIDs adds the "contacts" edge to the Contact entity by IDs.
+func (_u *TenantUpdateOne) AddContactIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddContactIDs(ids...)
 	return _u
 }
 
-// RemoveContacts removes "contacts" edges to Contact entities.
-func (_u *TenantUpdateOne) RemoveContacts(v ...*Contact) *TenantUpdateOne {
+// AddContacts adds the "contacts" edges to the Contact entity.
+func (_u *TenantUpdateOne) AddContacts(v ...*Contact) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveContactIDs(ids...)
-}
-
-// ClearLegalHolds clears all "legal_holds" edges to the LegalHold entity.
-func (_u *TenantUpdateOne) ClearLegalHolds() *TenantUpdateOne {
-	_u.mutation.ClearLegalHolds()
-	return _u
+	return _u.AddContactIDs(ids...)
 }
 
-// RemoveLegalHoldIDs removes the "legal_holds" edge to LegalHold entities by IDs.
-func (_u *TenantUpdateOne) RemoveLegalHoldIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveLegalHoldIDs(ids...)
+// AddLegalHoldIDs adds the "legal_holds" edge to the LegalHold entity by IDs.
+func (_u *TenantUpdateOne) AddLegalHoldIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddLegalHoldIDs(ids...)
 	return _u
 }
 
-// RemoveLegalHolds removes "legal_holds" edges to LegalHold entities.
-func (_u *TenantUpdateOne) RemoveLegalHolds(v ...*LegalHold) *TenantUpdateOne {
+// AddLegalHolds adds the "legal_holds" edges to the LegalHold entity.
+func (_u *TenantUpdateOne) AddLegalHolds(v ...*LegalHold) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLegalHoldIDs(ids...)
-}
-
-// ClearRetentionPolicies clears all "retention_policies" edges to the RetentionPolicy entity.
-func (_u *TenantUpdateOne) ClearRetentionPolicies() *TenantUpdateOne {
-	_u.mutation.ClearRetentionPolicies()
-	return _u
+	return _u.AddLegalHoldIDs(ids...)
 }
 
-// RemoveRetentionPolicyIDs removes the "retention_policies" edge to RetentionPolicy entities by IDs.
-func (_u *TenantUpdateOne) RemoveRetentionPolicyIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveRetentionPolicyIDs(ids...)
+// AddRetentionPolicyIDs adds the "retention_policies" edge to the RetentionPolicy entity by IDs.
+func (_u *TenantUpdateOne) AddRetentionPolicyIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddRetentionPolicyIDs(ids...)
 	return _u
 }
 
-// RemoveRetentionPolicies removes "retention_policies" edges to RetentionPolicy entities.
-func (_u *TenantUpdateOne) RemoveRetentionPolicies(v ...*RetentionPolicy) *TenantUpdateOne {
+// AddRetentionPolicies adds the "retention_policies" edges to the RetentionPolicy entity.
+func (_u *TenantUpdateOne) AddRetentionPolicies(v ...*RetentionPolicy) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveRetentionPolicyIDs(ids...)
-}
-
-// ClearVaultTemplates clears all "vault_templates" edges to the VaultTemplate entity.
-func (_u *TenantUpdateOne) ClearVaultTemplates() *TenantUpdateOne {
-	_u.mutation.ClearVaultTemplates()
-	return _u
+	return _u.AddRetentionPolicyIDs(ids...)
 }
 
-// RemoveVaultTemplateIDs removes the "vault_templates" edge to VaultTemplate entities by IDs.
-func (_u *TenantUpdateOne) RemoveVaultTemplateIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveVaultTemplateIDs(ids...)
+// AddVaultTemplateIDs adds the "vault_templates" edge to the VaultTemplate entity by IDs.
+func (_u *TenantUpdateOne) AddVaultTemplateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddVaultTemplateIDs(ids...)
 	return _u
 }
 
-// RemoveVaultTemplates removes "vault_templates" edges to VaultTemplate entities.
-func (_u *TenantUpdateOne) RemoveVaultTemplates(v ...*VaultTemplate) *TenantUpdateOne {
+// AddVaultTemplates adds the "vault_templates" edges to the VaultTemplate entity.
+func (_u *TenantUpdateOne) AddVaultTemplates(v ...*VaultTemplate) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveVaultTemplateIDs(ids...)
-}
-
-// ClearStockAuditLogs clears all "stock_audit_logs" edges to the StockAuditLog entity.
-func (_u *TenantUpdateOne) ClearStockAuditLogs() *TenantUpdateOne {
-	_u.mutation.ClearStockAuditLogs()
-	return _u
+	return _u.AddVaultTemplateIDs(ids...)
 }
 
-// RemoveStockAuditLogIDs removes the "stock_audit_logs" edge to StockAuditLog entities by IDs.
-func (_u *TenantUpdateOne) RemoveStockAuditLogIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveStockAuditLogIDs(ids...)
+// AddStockAuditLogIDs adds the "stock_audit_logs" edge to the StockAuditLog entity by IDs.
+func (_u *TenantUpdateOne) AddStockAuditLogIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddStockAuditLogIDs(ids...)
 	return _u
 }
 
-// RemoveStockAuditLogs removes "stock_audit_logs" edges to StockAuditLog entities.
-func (_u *TenantUpdateOne) RemoveStockAuditLogs(v ...*StockAuditLog) *TenantUpdateOne {
+// AddStockAuditLogs adds the "stock_audit_logs" edges to the StockAuditLog entity.
+func (_u *TenantUpdateOne) AddStockAuditLogs(v ...*StockAuditLog) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveStockAuditLogIDs(ids...)
+	return _u.AddStockAuditLogIDs(ids...)
 }
 
-// ClearMaintenanceSchedules clears all "maintenance_schedules" edges to the MaintenanceSchedule entity.
-func (_u *TenantUpdateOne) ClearMaintenanceSchedules() *TenantUpdateOne {
-	_u.mutation.ClearMaintenanceSchedules()
-	return _u
+// AddMaintenanceScheduleIDs adds the "maintenance_schedules" edge to the MaintenanceSchedule entity by IDs.
+func (_u *TenantUpdateOne) AddMaintenanceScheduleIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddMaintenanceScheduleIDs(ids...)
+	return _u
 }
 
-// RemoveMaintenanceScheduleIDs removes the "maintenance_schedules" edge to MaintenanceSchedule entities by IDs.
-func (_u *TenantUpdateOne) RemoveMaintenanceScheduleIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveMaintenanceScheduleIDs(ids...)
+// AddMaintenanceSchedules adds the "maintenance_schedules" edges to the MaintenanceSchedule entity.
+func (_u *TenantUpdateOne) AddMaintenanceSchedules(v ...*MaintenanceSchedule) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMaintenanceScheduleIDs(ids...)
+}
+
+// AddStockAlertIDs adds the "stock_alerts" edge to the StockAlert entity by IDs.
+func (_u *TenantUpdateOne) AddStockAlertIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddStockAlertIDs(ids...)
 	return _u
 }
 
-// RemoveMaintenanceSchedules removes "maintenance_schedules" edges to MaintenanceSchedule entities.
-func (_u *TenantUpdateOne) RemoveMaintenanceSchedules(v ...*MaintenanceSchedule) *TenantUpdateOne {
+// AddStockAlerts adds the "stock_alerts" edges to the StockAlert entity.
+func (_u *TenantUpdateOne) AddStockAlerts(v ...*StockAlert) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveMaintenanceScheduleIDs(ids...)
+	return _u.AddStockAlertIDs(ids...)
 }
 
-// ClearStockAlerts clears all "stock_alerts" edges to the StockAlert entity.
-func (_u *TenantUpdateOne) ClearStockAlerts() *TenantUpdateOne {
-	_u.mutation.ClearStockAlerts()
+// AddPurchaseOrderIDs adds the "purchase_orders" edge to the PurchaseOrder entity by IDs.
+func (_u *TenantUpdateOne) AddPurchaseOrderIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddPurchaseOrderIDs(ids...)
 	return _u
 }
 
-// RemoveStockAlertIDs removes the "stock_alerts" edge to StockAlert entities by IDs.
-func (_u *TenantUpdateOne) RemoveStockAlertIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveStockAlertIDs(ids...)
+// AddPurchaseOrders adds the "purchase_orders" edges to the PurchaseOrder entity.
+func (_u *TenantUpdateOne) AddPurchaseOrders(v ...*PurchaseOrder) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPurchaseOrderIDs(ids...)
+}
+
+// AddInventoryCountIDs adds the "inventory_counts" edge to the InventoryCount entity by IDs.
+func (_u *TenantUpdateOne) AddInventoryCountIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddInventoryCountIDs(ids...)
 	return _u
 }
 
-// RemoveStockAlerts removes "stock_alerts" edges to StockAlert entities.
-func (_u *TenantUpdateOne) RemoveStockAlerts(v ...*StockAlert) *TenantUpdateOne {
+// AddInventoryCounts adds the "inventory_counts" edges to the InventoryCount entity.
+func (_u *TenantUpdateOne) AddInventoryCounts(v ...*InventoryCount) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveStockAlertIDs(ids...)
+	return _u.AddInventoryCountIDs(ids...)
 }
 
-// ClearPurchaseOrders clears all "purchase_orders" edges to the PurchaseOrder entity.
-func (_u *TenantUpdateOne) ClearPurchaseOrders() *TenantUpdateOne {
-	_u.mutation.ClearPurchaseOrders()
+// AddJobPostingIDs adds the "job_postings" edge to the JobPosting entity by IDs.
+func (_u *TenantUpdateOne) AddJobPostingIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddJobPostingIDs(ids...)
 	return _u
 }
 
-// RemovePurchaseOrderIDs removes the "purchase_orders" edge to PurchaseOrder entities by IDs.
-func (_u *TenantUpdateOne) RemovePurchaseOrderIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemovePurchaseOrderIDs(ids...)
+// AddJobPostings adds the "job_postings" edges to the JobPosting entity.
+func (_u *TenantUpdateOne) AddJobPostings(v ...*JobPosting) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobPostingIDs(ids...)
+}
+
+// AddCandidateIDs adds the "candidates" edge to the Candidate entity by IDs.
+func (_u *TenantUpdateOne) AddCandidateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddCandidateIDs(ids...)
 	return _u
 }
 
-// RemovePurchaseOrders removes "purchase_orders" edges to PurchaseOrder entities.
-func (_u *TenantUpdateOne) RemovePurchaseOrders(v ...*PurchaseOrder) *TenantUpdateOne {
+// AddCandidates adds the "candidates" edges to the Candidate entity.
+func (_u *TenantUpdateOne) AddCandidates(v ...*Candidate) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePurchaseOrderIDs(ids...)
+	return _u.AddCandidateIDs(ids...)
 }
 
-// ClearInventoryCounts clears all "inventory_counts" edges to the InventoryCount entity.
-func (_u *TenantUpdateOne) ClearInventoryCounts() *TenantUpdateOne {
-	_u.mutation.ClearInventoryCounts()
+// AddApplicationIDs adds the "applications" edge to the Application entity by IDs.
+func (_u *TenantUpdateOne) AddApplicationIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddApplicationIDs(ids...)
 	return _u
 }
 
-// RemoveInventoryCountIDs removes the "inventory_counts" edge to InventoryCount entities by IDs.
-func (_u *TenantUpdateOne) RemoveInventoryCountIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveInventoryCountIDs(ids...)
+// AddApplications adds the "applications" edges to the Application entity.
+func (_u *TenantUpdateOne) AddApplications(v ...*Application) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddApplicationIDs(ids...)
+}
+
+// AddInterviewIDs adds the "interviews" edge to the Interview entity by IDs.
+func (_u *TenantUpdateOne) AddInterviewIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddInterviewIDs(ids...)
 	return _u
 }
 
-// RemoveInventoryCounts removes "inventory_counts" edges to InventoryCount entities.
-func (_u *TenantUpdateOne) RemoveInventoryCounts(v ...*InventoryCount) *TenantUpdateOne {
+// AddInterviews adds the "interviews" edges to the Interview entity.
+func (_u *TenantUpdateOne) AddInterviews(v ...*Interview) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveInventoryCountIDs(ids...)
+	return _u.AddInterviewIDs(ids...)
 }
 
-// ClearJobPostings clears all "job_postings" edges to the JobPosting entity.
-func (_u *TenantUpdateOne) ClearJobPostings() *TenantUpdateOne {
-	_u.mutation.ClearJobPostings()
+// AddBenefitPlanIDs adds the "benefit_plans" edge to the BenefitPlan entity by IDs.
+func (_u *TenantUpdateOne) AddBenefitPlanIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddBenefitPlanIDs(ids...)
 	return _u
 }
 
-// RemoveJobPostingIDs removes the "job_postings" edge to JobPosting entities by IDs.
-func (_u *TenantUpdateOne) RemoveJobPostingIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveJobPostingIDs(ids...)
+// AddBenefitPlans adds the "benefit_plans" edges to the BenefitPlan entity.
+func (_u *TenantUpdateOne) AddBenefitPlans(v ...*BenefitPlan) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBenefitPlanIDs(ids...)
+}
+
+// AddBenefitEnrollmentIDs adds the "benefit_enrollments" edge to the BenefitEnrollment entity by IDs.
+func (_u *TenantUpdateOne) AddBenefitEnrollmentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddBenefitEnrollmentIDs(ids...)
 	return _u
 }
 
-// RemoveJobPostings removes "job_postings" edges to JobPosting entities.
-func (_u *TenantUpdateOne) RemoveJobPostings(v ...*JobPosting) *TenantUpdateOne {
+// AddBenefitEnrollments adds the "benefit_enrollments" edges to the BenefitEnrollment entity.
+func (_u *TenantUpdateOne) AddBenefitEnrollments(v ...*BenefitEnrollment) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveJobPostingIDs(ids...)
+	return _u.AddBenefitEnrollmentIDs(ids...)
 }
 
-// ClearCandidates clears all "candidates" edges to the Candidate entity.
-func (_u *TenantUpdateOne) ClearCandidates() *TenantUpdateOne {
-	_u.mutation.ClearCandidates()
+// AddPosShiftIDs adds the "pos_shifts" edge to the PosShift entity by IDs.
+func (_u *TenantUpdateOne) AddPosShiftIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddPosShiftIDs(ids...)
 	return _u
 }
 
-// RemoveCandidateIDs removes the "candidates" edge to Candidate entities by IDs.
-func (_u *TenantUpdateOne) RemoveCandidateIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveCandidateIDs(ids...)
+// AddPosShifts adds the "pos_shifts" edges to the PosShift entity.
+func (_u *TenantUpdateOne) AddPosShifts(v ...*PosShift) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPosShiftIDs(ids...)
+}
+
+// AddLabelTemplateIDs adds the "label_templates" edge to the LabelTemplate entity by IDs.
+func (_u *TenantUpdateOne) AddLabelTemplateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddLabelTemplateIDs(ids...)
 	return _u
 }
 
-// RemoveCandidates removes "candidates" edges to Candidate entities.
-func (_u *TenantUpdateOne) RemoveCandidates(v ...*Candidate) *TenantUpdateOne {
+// AddLabelTemplates adds the "label_templates" edges to the LabelTemplate entity.
+func (_u *TenantUpdateOne) AddLabelTemplates(v ...*LabelTemplate) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCandidateIDs(ids...)
+	return _u.AddLabelTemplateIDs(ids...)
 }
 
-// ClearApplications clears all "applications" edges to the Application entity.
-func (_u *TenantUpdateOne) ClearApplications() *TenantUpdateOne {
-	_u.mutation.ClearApplications()
+// AddTaxJurisdictionIDs adds the "tax_jurisdictions" edge to the TaxJurisdiction entity by IDs.
+func (_u *TenantUpdateOne) AddTaxJurisdictionIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddTaxJurisdictionIDs(ids...)
 	return _u
 }
 
-// RemoveApplicationIDs removes the "applications" edge to Application entities by IDs.
-func (_u *TenantUpdateOne) RemoveApplicationIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveApplicationIDs(ids...)
+// AddTaxJurisdictions adds the "tax_jurisdictions" edges to the TaxJurisdiction entity.
+func (_u *TenantUpdateOne) AddTaxJurisdictions(v ...*TaxJurisdiction) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaxJurisdictionIDs(ids...)
+}
+
+// AddEinvoiceCredentialIDs adds the "einvoice_credentials" edge to the EInvoiceCredential entity by IDs.
+func (_u *TenantUpdateOne) AddEinvoiceCredentialIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddEinvoiceCredentialIDs(ids...)
 	return _u
 }
 
-// RemoveApplications removes "applications" edges to Application entities.
-func (_u *TenantUpdateOne) RemoveApplications(v ...*Application) *TenantUpdateOne {
+// AddEinvoiceCredentials adds the "einvoice_credentials" edges to the EInvoiceCredential entity.
+func (_u *TenantUpdateOne) AddEinvoiceCredentials(v ...*EInvoiceCredential) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveApplicationIDs(ids...)
+	return _u.AddEinvoiceCredentialIDs(ids...)
 }
 
-// ClearInterviews clears all "interviews" edges to the Interview entity.
-func (_u *TenantUpdateOne) ClearInterviews() *TenantUpdateOne {
-	_u.mutation.ClearInterviews()
+// AddEinvoiceIDs adds the "einvoices" edge to the EInvoice entity by IDs.
+func (_u *TenantUpdateOne) AddEinvoiceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddEinvoiceIDs(ids...)
 	return _u
 }
 
-// RemoveInterviewIDs removes the "interviews" edge to Interview entities by IDs.
-func (_u *TenantUpdateOne) RemoveInterviewIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveInterviewIDs(ids...)
+// AddEinvoices adds the "einvoices" edges to the EInvoice entity.
+func (_u *TenantUpdateOne) AddEinvoices(v ...*EInvoice) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEinvoiceIDs(ids...)
+}
+
+// AddAgentEnrollmentTokenIDs adds the "agent_enrollment_tokens" edge to the AgentEnrollmentToken entity by IDs.
+func (_u *TenantUpdateOne) AddAgentEnrollmentTokenIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddAgentEnrollmentTokenIDs(ids...)
 	return _u
 }
 
-// RemoveInterviews removes "interviews" edges to Interview entities.
-func (_u *TenantUpdateOne) RemoveInterviews(v ...*Interview) *TenantUpdateOne {
+// AddAgentEnrollmentTokens adds the "agent_enrollment_tokens" edges to the AgentEnrollmentToken entity.
+func (_u *TenantUpdateOne) AddAgentEnrollmentTokens(v ...*AgentEnrollmentToken) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveInterviewIDs(ids...)
+	return _u.AddAgentEnrollmentTokenIDs(ids...)
 }
 
-// ClearBenefitPlans clears all "benefit_plans" edges to the BenefitPlan entity.
-func (_u *TenantUpdateOne) ClearBenefitPlans() *TenantUpdateOne {
-	_u.mutation.ClearBenefitPlans()
+// SetAgentAuthorityID sets the "agent_authority" edge to the AgentAuthority entity by ID.
+func (_u *TenantUpdateOne) SetAgentAuthorityID(id int) *TenantUpdateOne {
+	_u.mutation.SetAgentAuthorityID(id)
 	return _u
 }
 
-// RemoveBenefitPlanIDs removes the "benefit_plans" edge to BenefitPlan entities by IDs.
-func (_u *TenantUpdateOne) RemoveBenefitPlanIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveBenefitPlanIDs(ids...)
+// SetNillableAgentAuthorityID sets the "agent_authority" edge to the AgentAuthority entity by ID if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableAgentAuthorityID(id *int) *TenantUpdateOne {
+	if id != nil {
+		_u = _u.SetAgentAuthorityID(*id)
+	}
 	return _u
 }
 
-// RemoveBenefitPlans removes "benefit_plans" edges to BenefitPlan entities.
-func (_u *TenantUpdateOne) RemoveBenefitPlans(v ...*BenefitPlan) *TenantUpdateOne {
+// SetAgentAuthority sets the "agent_authority" edge to the AgentAuthority entity.
+func (_u *TenantUpdateOne) SetAgentAuthority(v *AgentAuthority) *TenantUpdateOne {
+	return _u.SetAgentAuthorityID(v.ID)
+}
+
+// AddDeviceGroupIDs adds the "device_groups" edge to the DeviceGroup entity by IDs.
+func (_u *TenantUpdateOne) AddDeviceGroupIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddDeviceGroupIDs(ids...)
+	return _u
+}
+
+// AddDeviceGroups adds the "device_groups" edges to the DeviceGroup entity.
+func (_u *TenantUpdateOne) AddDeviceGroups(v ...*DeviceGroup) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBenefitPlanIDs(ids...)
+	return _u.AddDeviceGroupIDs(ids...)
 }
 
-// ClearBenefitEnrollments clears all "benefit_enrollments" edges to the BenefitEnrollment entity.
-func (_u *TenantUpdateOne) ClearBenefitEnrollments() *TenantUpdateOne {
-	_u.mutation.ClearBenefitEnrollments()
+// AddPatchPolicyIDs adds the "patch_policies" edge to the PatchPolicy entity by IDs.
+func (_u *TenantUpdateOne) AddPatchPolicyIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddPatchPolicyIDs(ids...)
 	return _u
 }
 
-// RemoveBenefitEnrollmentIDs removes the "benefit_enrollments" edge to BenefitEnrollment entities by IDs.
-func (_u *TenantUpdateOne) RemoveBenefitEnrollmentIDs(ids ...int) *TenantUpdateOne {
-	_u.mutation.RemoveBenefitEnrollmentIDs(ids...)
+// AddPatchPolicies adds the "patch_policies" edges to the PatchPolicy entity.
+func (_u *TenantUpdateOne) AddPatchPolicies(v ...*PatchPolicy) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPatchPolicyIDs(ids...)
+}
+
+// AddAlertRuleIDs adds the "alert_rules" edge to the AlertRule entity by IDs.
+func (_u *TenantUpdateOne) AddAlertRuleIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.AddAlertRuleIDs(ids...)
 	return _u
 }
 
-// RemoveBenefitEnrollments removes "benefit_enrollments" edges to BenefitEnrollment entities.
-func (_u *TenantUpdateOne) RemoveBenefitEnrollments(v ...*BenefitEnrollment) *TenantUpdateOne {
+// AddAlertRules adds the "alert_rules" edges to the AlertRule entity.
+func (_u *TenantUpdateOne) AddAlertRules(v ...*AlertRule) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAlertRuleIDs(ids...)
+}
+
+// Mutation returns the TenantMutation object of the builder.
+func (_u *TenantUpdateOne) Mutation() *TenantMutation {
+	return _u.mutation
+}
+
+// ClearParent clears the "parent" edge to the Tenant entity.
+func (_u *TenantUpdateOne) ClearParent() *TenantUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Tenant entity.
+func (_u *TenantUpdateOne) ClearChildren() *TenantUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Tenant entities by IDs.
+func (_u *TenantUpdateOne) RemoveChildIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Tenant entities.
+func (_u *TenantUpdateOne) RemoveChildren(v ...*Tenant) *TenantUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBenefitEnrollmentIDs(ids...)
-}
-
-// Where appends a list predicates to the TenantUpdate builder.
-func (_u *TenantUpdateOne) Where(ps ...predicate.Tenant) *TenantUpdateOne {
-	_u.mutation.Where(ps...)
-	return _u
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *TenantUpdateOne) Select(field string, fields ...string) *TenantUpdateOne {
-	_u.fields = append([]string{field}, fields...)
-	return _u
-}
-
-// Save executes the query and returns the updated Tenant entity.
-func (_u *TenantUpdateOne) Save(ctx context.Context) (*Tenant, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (_u *TenantUpdateOne) SaveX(ctx context.Context) *Tenant {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *TenantUpdateOne) ClearUsers() *TenantUpdateOne {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *TenantUpdateOne) RemoveUserIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *TenantUpdateOne) RemoveUsers(v ...*User) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// ClearAccounts clears all "accounts" edges to the Account entity.
+func (_u *TenantUpdateOne) ClearAccounts() *TenantUpdateOne {
+	_u.mutation.ClearAccounts()
+	return _u
+}
+
+// RemoveAccountIDs removes the "accounts" edge to Account entities by IDs.
+func (_u *TenantUpdateOne) RemoveAccountIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveAccountIDs(ids...)
+	return _u
+}
+
+// RemoveAccounts removes "accounts" edges to Account entities.
+func (_u *TenantUpdateOne) RemoveAccounts(v ...*Account) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccountIDs(ids...)
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *TenantUpdateOne) ClearTransactions() *TenantUpdateOne {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *TenantUpdateOne) RemoveTransactionIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *TenantUpdateOne) RemoveTransactions(v ...*Transaction) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// ClearLedgerEntries clears all "ledger_entries" edges to the LedgerEntry entity.
+func (_u *TenantUpdateOne) ClearLedgerEntries() *TenantUpdateOne {
+	_u.mutation.ClearLedgerEntries()
+	return _u
+}
+
+// RemoveLedgerEntryIDs removes the "ledger_entries" edge to LedgerEntry entities by IDs.
+func (_u *TenantUpdateOne) RemoveLedgerEntryIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveLedgerEntryIDs(ids...)
+	return _u
+}
+
+// RemoveLedgerEntries removes "ledger_entries" edges to LedgerEntry entities.
+func (_u *TenantUpdateOne) RemoveLedgerEntries(v ...*LedgerEntry) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLedgerEntryIDs(ids...)
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (_u *TenantUpdateOne) ClearProducts() *TenantUpdateOne {
+	_u.mutation.ClearProducts()
+	return _u
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (_u *TenantUpdateOne) RemoveProductIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveProductIDs(ids...)
+	return _u
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (_u *TenantUpdateOne) RemoveProducts(v ...*Product) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProductIDs(ids...)
+}
+
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *TenantUpdateOne) ClearStockMovements() *TenantUpdateOne {
+	_u.mutation.ClearStockMovements()
+	return _u
+}
+
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *TenantUpdateOne) RemoveStockMovementIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveStockMovementIDs(ids...)
+	return _u
+}
+
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *TenantUpdateOne) RemoveStockMovements(v ...*StockMovement) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockMovementIDs(ids...)
+}
+
+// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
+func (_u *TenantUpdateOne) ClearAuditLogs() *TenantUpdateOne {
+	_u.mutation.ClearAuditLogs()
+	return _u
+}
+
+// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
+func (_u *TenantUpdateOne) RemoveAuditLogIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveAuditLogIDs(ids...)
+	return _u
+}
+
+// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
+func (_u *TenantUpdateOne) RemoveAuditLogs(v ...*AuditLog) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuditLogIDs(ids...)
+}
+
+// ClearAgents clears all "agents" edges to the Agent entity.
+func (_u *TenantUpdateOne) ClearAgents() *TenantUpdateOne {
+	_u.mutation.ClearAgents()
+	return _u
+}
+
+// RemoveAgentIDs removes the "agents" edge to Agent entities by IDs.
+func (_u *TenantUpdateOne) RemoveAgentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveAgentIDs(ids...)
+	return _u
+}
+
+// RemoveAgents removes "agents" edges to Agent entities.
+func (_u *TenantUpdateOne) RemoveAgents(v ...*Agent) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAgentIDs(ids...)
+}
+
+// ClearDiscoveryEntries clears all "discovery_entries" edges to the DiscoveryEntry entity.
+func (_u *TenantUpdateOne) ClearDiscoveryEntries() *TenantUpdateOne {
+	_u.mutation.ClearDiscoveryEntries()
+	return _u
+}
+
+// RemoveDiscoveryEntryIDs removes the "discovery_entries" edge to DiscoveryEntry entities by IDs.
+func (_u *TenantUpdateOne) RemoveDiscoveryEntryIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveDiscoveryEntryIDs(ids...)
+	return _u
+}
+
+// RemoveDiscoveryEntries removes "discovery_entries" edges to DiscoveryEntry entities.
+func (_u *TenantUpdateOne) RemoveDiscoveryEntries(v ...*DiscoveryEntry) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDiscoveryEntryIDs(ids...)
+}
+
+// ClearAssets clears all "assets" edges to the Asset entity.
+func (_u *TenantUpdateOne) ClearAssets() *TenantUpdateOne {
+	_u.mutation.ClearAssets()
+	return _u
+}
+
+// RemoveAssetIDs removes the "assets" edge to Asset entities by IDs.
+func (_u *TenantUpdateOne) RemoveAssetIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveAssetIDs(ids...)
+	return _u
+}
+
+// RemoveAssets removes "assets" edges to Asset entities.
+func (_u *TenantUpdateOne) RemoveAssets(v ...*Asset) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssetIDs(ids...)
+}
+
+// ClearCredentials clears all "credentials" edges to the Credential entity.
+func (_u *TenantUpdateOne) ClearCredentials() *TenantUpdateOne {
+	_u.mutation.ClearCredentials()
+	return _u
+}
+
+// RemoveCredentialIDs removes the "credentials" edge to Credential entities by IDs.
+func (_u *TenantUpdateOne) RemoveCredentialIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveCredentialIDs(ids...)
+	return _u
+}
+
+// RemoveCredentials removes "credentials" edges to Credential entities.
+func (_u *TenantUpdateOne) RemoveCredentials(v ...*Credential) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCredentialIDs(ids...)
+}
+
+// ClearOneTimeLinks clears all "one_time_links" edges to the OneTimeLink entity.
+func (_u *TenantUpdateOne) ClearOneTimeLinks() *TenantUpdateOne {
+	_u.mutation.ClearOneTimeLinks()
+	return _u
+}
+
+// RemoveOneTimeLinkIDs removes the "one_time_links" edge to OneTimeLink entities by IDs.
+func (_u *TenantUpdateOne) RemoveOneTimeLinkIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveOneTimeLinkIDs(ids...)
+	return _u
+}
+
+// RemoveOneTimeLinks removes "one_time_links" edges to OneTimeLink entities.
+func (_u *TenantUpdateOne) RemoveOneTimeLinks(v ...*OneTimeLink) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOneTimeLinkIDs(ids...)
+}
+
+// ClearSops clears all "sops" edges to the SOP entity.
+func (_u *TenantUpdateOne) ClearSops() *TenantUpdateOne {
+	_u.mutation.ClearSops()
+	return _u
+}
+
+// RemoveSopIDs removes the "sops" edge to SOP entities by IDs.
+func (_u *TenantUpdateOne) RemoveSopIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveSopIDs(ids...)
+	return _u
+}
+
+// RemoveSops removes "sops" edges to SOP entities.
+func (_u *TenantUpdateOne) RemoveSops(v ...*SOP) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSopIDs(ids...)
+}
+
+// ClearCameras clears all "cameras" edges to the Camera entity.
+func (_u *TenantUpdateOne) ClearCameras() *TenantUpdateOne {
+	_u.mutation.ClearCameras()
+	return _u
+}
+
+// RemoveCameraIDs removes the "cameras" edge to Camera entities by IDs.
+func (_u *TenantUpdateOne) RemoveCameraIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveCameraIDs(ids...)
+	return _u
+}
+
+// RemoveCameras removes "cameras" edges to Camera entities.
+func (_u *TenantUpdateOne) RemoveCameras(v ...*Camera) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCameraIDs(ids...)
+}
+
+// ClearTickets clears all "tickets" edges to the Ticket entity.
+func (_u *TenantUpdateOne) ClearTickets() *TenantUpdateOne {
+	_u.mutation.ClearTickets()
+	return _u
+}
+
+// RemoveTicketIDs removes the "tickets" edge to Ticket entities by IDs.
+func (_u *TenantUpdateOne) RemoveTicketIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveTicketIDs(ids...)
+	return _u
+}
+
+// RemoveTickets removes "tickets" edges to Ticket entities.
+func (_u *TenantUpdateOne) RemoveTickets(v ...*Ticket) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTicketIDs(ids...)
+}
+
+// ClearContracts clears all "contracts" edges to the Contract entity.
+func (_u *TenantUpdateOne) ClearContracts() *TenantUpdateOne {
+	_u.mutation.ClearContracts()
+	return _u
+}
+
+// RemoveContractIDs removes the "contracts" edge to Contract entities by IDs.
+func (_u *TenantUpdateOne) RemoveContractIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveContractIDs(ids...)
+	return _u
+}
+
+// RemoveContracts removes "contracts" edges to Contract entities.
+func (_u *TenantUpdateOne) RemoveContracts(v ...*Contract) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContractIDs(ids...)
+}
+
+// ClearSaasApps clears all "saas_apps" edges to the SaaSApp entity.
+func (_u *TenantUpdateOne) ClearSaasApps() *TenantUpdateOne {
+	_u.mutation.ClearSaasApps()
+	return _u
+}
+
+// RemoveSaasAppIDs removes the "saas_apps" edge to SaaSApp entities by IDs.
+func (_u *TenantUpdateOne) RemoveSaasAppIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveSaasAppIDs(ids...)
+	return _u
+}
+
+// RemoveSaasApps removes "saas_apps" edges to SaaSApp entities.
+func (_u *TenantUpdateOne) RemoveSaasApps(v ...*SaaSApp) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSaasAppIDs(ids...)
+}
+
+// ClearSaasFilters clears all "saas_filters" edges to the SaaSFilter entity.
+func (_u *TenantUpdateOne) ClearSaasFilters() *TenantUpdateOne {
+	_u.mutation.ClearSaasFilters()
+	return _u
+}
+
+// RemoveSaasFilterIDs removes the "saas_filters" edge to SaaSFilter entities by IDs.
+func (_u *TenantUpdateOne) RemoveSaasFilterIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveSaasFilterIDs(ids...)
+	return _u
+}
+
+// RemoveSaasFilters removes "saas_filters" edges to SaaSFilter entities.
+func (_u *TenantUpdateOne) RemoveSaasFilters(v ...*SaaSFilter) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSaasFilterIDs(ids...)
+}
+
+// ClearCallLogs clears all "call_logs" edges to the CallLog entity.
+func (_u *TenantUpdateOne) ClearCallLogs() *TenantUpdateOne {
+	_u.mutation.ClearCallLogs()
+	return _u
+}
+
+// RemoveCallLogIDs removes the "call_logs" edge to CallLog entities by IDs.
+func (_u *TenantUpdateOne) RemoveCallLogIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveCallLogIDs(ids...)
+	return _u
+}
+
+// RemoveCallLogs removes "call_logs" edges to CallLog entities.
+func (_u *TenantUpdateOne) RemoveCallLogs(v ...*CallLog) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCallLogIDs(ids...)
+}
+
+// ClearIvrFlows clears all "ivr_flows" edges to the IVRFlow entity.
+func (_u *TenantUpdateOne) ClearIvrFlows() *TenantUpdateOne {
+	_u.mutation.ClearIvrFlows()
+	return _u
+}
+
+// RemoveIvrFlowIDs removes the "ivr_flows" edge to IVRFlow entities by IDs.
+func (_u *TenantUpdateOne) RemoveIvrFlowIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveIvrFlowIDs(ids...)
+	return _u
+}
+
+// RemoveIvrFlows removes "ivr_flows" edges to IVRFlow entities.
+func (_u *TenantUpdateOne) RemoveIvrFlows(v ...*IVRFlow) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIvrFlowIDs(ids...)
+}
+
+// ClearVoicemails clears all "voicemails" edges to the Voicemail entity.
+func (_u *TenantUpdateOne) ClearVoicemails() *TenantUpdateOne {
+	_u.mutation.ClearVoicemails()
+	return _u
+}
+
+// RemoveVoicemailIDs removes the "voicemails" edge to Voicemail entities by IDs.
+func (_u *TenantUpdateOne) RemoveVoicemailIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveVoicemailIDs(ids...)
+	return _u
+}
+
+// RemoveVoicemails removes "voicemails" edges to Voicemail entities.
+func (_u *TenantUpdateOne) RemoveVoicemails(v ...*Voicemail) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoicemailIDs(ids...)
+}
+
+// ClearHealthSnapshots clears all "health_snapshots" edges to the HealthScoreSnapshot entity.
+func (_u *TenantUpdateOne) ClearHealthSnapshots() *TenantUpdateOne {
+	_u.mutation.ClearHealthSnapshots()
+	return _u
+}
+
+// RemoveHealthSnapshotIDs removes the "health_snapshots" edge to HealthScoreSnapshot entities by IDs.
+func (_u *TenantUpdateOne) RemoveHealthSnapshotIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveHealthSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveHealthSnapshots removes "health_snapshots" edges to HealthScoreSnapshot entities.
+func (_u *TenantUpdateOne) RemoveHealthSnapshots(v ...*HealthScoreSnapshot) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHealthSnapshotIDs(ids...)
+}
+
+// ClearRoadmaps clears all "roadmaps" edges to the StrategicRoadmap entity.
+func (_u *TenantUpdateOne) ClearRoadmaps() *TenantUpdateOne {
+	_u.mutation.ClearRoadmaps()
+	return _u
+}
+
+// RemoveRoadmapIDs removes the "roadmaps" edge to StrategicRoadmap entities by IDs.
+func (_u *TenantUpdateOne) RemoveRoadmapIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveRoadmapIDs(ids...)
+	return _u
+}
+
+// RemoveRoadmaps removes "roadmaps" edges to StrategicRoadmap entities.
+func (_u *TenantUpdateOne) RemoveRoadmaps(v ...*StrategicRoadmap) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoadmapIDs(ids...)
+}
+
+// ClearServiceRates clears all "service_rates" edges to the ServiceRate entity.
+func (_u *TenantUpdateOne) ClearServiceRates() *TenantUpdateOne {
+	_u.mutation.ClearServiceRates()
+	return _u
+}
+
+// RemoveServiceRateIDs removes the "service_rates" edge to ServiceRate entities by IDs.
+func (_u *TenantUpdateOne) RemoveServiceRateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveServiceRateIDs(ids...)
+	return _u
+}
+
+// RemoveServiceRates removes "service_rates" edges to ServiceRate entities.
+func (_u *TenantUpdateOne) RemoveServiceRates(v ...*ServiceRate) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveServiceRateIDs(ids...)
+}
+
+// ClearNetworkDevices clears all "network_devices" edges to the NetworkDevice entity.
+func (_u *TenantUpdateOne) ClearNetworkDevices() *TenantUpdateOne {
+	_u.mutation.ClearNetworkDevices()
+	return _u
+}
+
+// RemoveNetworkDeviceIDs removes the "network_devices" edge to NetworkDevice entities by IDs.
+func (_u *TenantUpdateOne) RemoveNetworkDeviceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveNetworkDeviceIDs(ids...)
+	return _u
+}
+
+// RemoveNetworkDevices removes "network_devices" edges to NetworkDevice entities.
+func (_u *TenantUpdateOne) RemoveNetworkDevices(v ...*NetworkDevice) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNetworkDeviceIDs(ids...)
+}
+
+// ClearNetworkBackups clears all "network_backups" edges to the NetworkBackup entity.
+func (_u *TenantUpdateOne) ClearNetworkBackups() *TenantUpdateOne {
+	_u.mutation.ClearNetworkBackups()
+	return _u
+}
+
+// RemoveNetworkBackupIDs removes the "network_backups" edge to NetworkBackup entities by IDs.
+func (_u *TenantUpdateOne) RemoveNetworkBackupIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveNetworkBackupIDs(ids...)
+	return _u
+}
+
+// RemoveNetworkBackups removes "network_backups" edges to NetworkBackup entities.
+func (_u *TenantUpdateOne) RemoveNetworkBackups(v ...*NetworkBackup) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNetworkBackupIDs(ids...)
+}
+
+// ClearBudgetForecasts clears all "budget_forecasts" edges to the BudgetForecast entity.
+func (_u *TenantUpdateOne) ClearBudgetForecasts() *TenantUpdateOne {
+	_u.mutation.ClearBudgetForecasts()
+	return _u
+}
+
+// RemoveBudgetForecastIDs removes the "budget_forecasts" edge to BudgetForecast entities by IDs.
+func (_u *TenantUpdateOne) RemoveBudgetForecastIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveBudgetForecastIDs(ids...)
+	return _u
+}
+
+// RemoveBudgetForecasts removes "budget_forecasts" edges to BudgetForecast entities.
+func (_u *TenantUpdateOne) RemoveBudgetForecasts(v ...*BudgetForecast) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBudgetForecastIDs(ids...)
+}
+
+// ClearEmployees clears all "employees" edges to the Employee entity.
+func (_u *TenantUpdateOne) ClearEmployees() *TenantUpdateOne {
+	_u.mutation.ClearEmployees()
+	return _u
+}
+
+// RemoveEmployeeIDs removes the "employees" edge to Employee entities by IDs.
+func (_u *TenantUpdateOne) RemoveEmployeeIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveEmployeeIDs(ids...)
+	return _u
+}
+
+// RemoveEmployees removes "employees" edges to Employee entities.
+func (_u *TenantUpdateOne) RemoveEmployees(v ...*Employee) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmployeeIDs(ids...)
+}
+
+// ClearCompensationAgreements clears all "compensation_agreements" edges to the CompensationAgreement entity.
+func (_u *TenantUpdateOne) ClearCompensationAgreements() *TenantUpdateOne {
+	_u.mutation.ClearCompensationAgreements()
+	return _u
+}
+
+// RemoveCompensationAgreementIDs removes the "compensation_agreements" edge to CompensationAgreement entities by IDs.
+func (_u *TenantUpdateOne) RemoveCompensationAgreementIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveCompensationAgreementIDs(ids...)
+	return _u
+}
+
+// RemoveCompensationAgreements removes "compensation_agreements" edges to CompensationAgreement entities.
+func (_u *TenantUpdateOne) RemoveCompensationAgreements(v ...*CompensationAgreement) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCompensationAgreementIDs(ids...)
+}
+
+// ClearVaultItems clears all "vault_items" edges to the VaultItem entity.
+func (_u *TenantUpdateOne) ClearVaultItems() *TenantUpdateOne {
+	_u.mutation.ClearVaultItems()
+	return _u
+}
+
+// RemoveVaultItemIDs removes the "vault_items" edge to VaultItem entities by IDs.
+func (_u *TenantUpdateOne) RemoveVaultItemIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveVaultItemIDs(ids...)
+	return _u
+}
+
+// RemoveVaultItems removes "vault_items" edges to VaultItem entities.
+func (_u *TenantUpdateOne) RemoveVaultItems(v ...*VaultItem) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVaultItemIDs(ids...)
+}
+
+// ClearVaultShareLinks clears all "vault_share_links" edges to the VaultShareLink entity.
+func (_u *TenantUpdateOne) ClearVaultShareLinks() *TenantUpdateOne {
+	_u.mutation.ClearVaultShareLinks()
+	return _u
+}
+
+// RemoveVaultShareLinkIDs removes the "vault_share_links" edge to VaultShareLink entities by IDs.
+func (_u *TenantUpdateOne) RemoveVaultShareLinkIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveVaultShareLinkIDs(ids...)
+	return _u
+}
+
+// RemoveVaultShareLinks removes "vault_share_links" edges to VaultShareLink entities.
+func (_u *TenantUpdateOne) RemoveVaultShareLinks(v ...*VaultShareLink) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVaultShareLinkIDs(ids...)
+}
+
+// ClearJournalEntries clears all "journal_entries" edges to the JournalEntry entity.
+func (_u *TenantUpdateOne) ClearJournalEntries() *TenantUpdateOne {
+	_u.mutation.ClearJournalEntries()
+	return _u
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to JournalEntry entities by IDs.
+func (_u *TenantUpdateOne) RemoveJournalEntryIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveJournalEntryIDs(ids...)
+	return _u
+}
+
+// RemoveJournalEntries removes "journal_entries" edges to JournalEntry entities.
+func (_u *TenantUpdateOne) RemoveJournalEntries(v ...*JournalEntry) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJournalEntryIDs(ids...)
+}
+
+// ClearRecurringInvoices clears all "recurring_invoices" edges to the RecurringInvoice entity.
+func (_u *TenantUpdateOne) ClearRecurringInvoices() *TenantUpdateOne {
+	_u.mutation.ClearRecurringInvoices()
+	return _u
+}
+
+// RemoveRecurringInvoiceIDs removes the "recurring_invoices" edge to RecurringInvoice entities by IDs.
+func (_u *TenantUpdateOne) RemoveRecurringInvoiceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveRecurringInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveRecurringInvoices removes "recurring_invoices" edges to RecurringInvoice entities.
+func (_u *TenantUpdateOne) RemoveRecurringInvoices(v ...*RecurringInvoice) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurringInvoiceIDs(ids...)
+}
+
+// ClearExchangeRates clears all "exchange_rates" edges to the ExchangeRate entity.
+func (_u *TenantUpdateOne) ClearExchangeRates() *TenantUpdateOne {
+	_u.mutation.ClearExchangeRates()
+	return _u
+}
+
+// RemoveExchangeRateIDs removes the "exchange_rates" edge to ExchangeRate entities by IDs.
+func (_u *TenantUpdateOne) RemoveExchangeRateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveExchangeRateIDs(ids...)
+	return _u
+}
+
+// RemoveExchangeRates removes "exchange_rates" edges to ExchangeRate entities.
+func (_u *TenantUpdateOne) RemoveExchangeRates(v ...*ExchangeRate) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExchangeRateIDs(ids...)
+}
+
+// ClearFiscalPeriods clears all "fiscal_periods" edges to the FiscalPeriod entity.
+func (_u *TenantUpdateOne) ClearFiscalPeriods() *TenantUpdateOne {
+	_u.mutation.ClearFiscalPeriods()
+	return _u
+}
+
+// RemoveFiscalPeriodIDs removes the "fiscal_periods" edge to FiscalPeriod entities by IDs.
+func (_u *TenantUpdateOne) RemoveFiscalPeriodIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveFiscalPeriodIDs(ids...)
+	return _u
+}
+
+// RemoveFiscalPeriods removes "fiscal_periods" edges to FiscalPeriod entities.
+func (_u *TenantUpdateOne) RemoveFiscalPeriods(v ...*FiscalPeriod) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFiscalPeriodIDs(ids...)
+}
+
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the AccountBalanceSnapshot entity.
+func (_u *TenantUpdateOne) ClearBalanceSnapshots() *TenantUpdateOne {
+	_u.mutation.ClearBalanceSnapshots()
+	return _u
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to AccountBalanceSnapshot entities by IDs.
+func (_u *TenantUpdateOne) RemoveBalanceSnapshotIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to AccountBalanceSnapshot entities.
+func (_u *TenantUpdateOne) RemoveBalanceSnapshots(v ...*AccountBalanceSnapshot) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearBankStatements clears all "bank_statements" edges to the BankStatement entity.
+func (_u *TenantUpdateOne) ClearBankStatements() *TenantUpdateOne {
+	_u.mutation.ClearBankStatements()
+	return _u
+}
+
+// RemoveBankStatementIDs removes the "bank_statements" edge to BankStatement entities by IDs.
+func (_u *TenantUpdateOne) RemoveBankStatementIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveBankStatementIDs(ids...)
+	return _u
+}
+
+// RemoveBankStatements removes "bank_statements" edges to BankStatement entities.
+func (_u *TenantUpdateOne) RemoveBankStatements(v ...*BankStatement) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankStatementIDs(ids...)
+}
+
+// ClearBankStatementLines clears all "bank_statement_lines" edges to the BankStatementLine entity.
+func (_u *TenantUpdateOne) ClearBankStatementLines() *TenantUpdateOne {
+	_u.mutation.ClearBankStatementLines()
+	return _u
+}
+
+// RemoveBankStatementLineIDs removes the "bank_statement_lines" edge to BankStatementLine entities by IDs.
+func (_u *TenantUpdateOne) RemoveBankStatementLineIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveBankStatementLineIDs(ids...)
+	return _u
+}
+
+// RemoveBankStatementLines removes "bank_statement_lines" edges to BankStatementLine entities.
+func (_u *TenantUpdateOne) RemoveBankStatementLines(v ...*BankStatementLine) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankStatementLineIDs(ids...)
+}
+
+// ClearBankRules clears all "bank_rules" edges to the BankRule entity.
+func (_u *TenantUpdateOne) ClearBankRules() *TenantUpdateOne {
+	_u.mutation.ClearBankRules()
+	return _u
+}
+
+// RemoveBankRuleIDs removes the "bank_rules" edge to BankRule entities by IDs.
+func (_u *TenantUpdateOne) RemoveBankRuleIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveBankRuleIDs(ids...)
+	return _u
+}
+
+// RemoveBankRules removes "bank_rules" edges to BankRule entities.
+func (_u *TenantUpdateOne) RemoveBankRules(v ...*BankRule) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBankRuleIDs(ids...)
+}
+
+// ClearCustomers clears all "customers" edges to the Customer entity.
+func (_u *TenantUpdateOne) ClearCustomers() *TenantUpdateOne {
+	_u.mutation.ClearCustomers()
+	return _u
+}
+
+// RemoveCustomerIDs removes the "customers" edge to Customer entities by IDs.
+func (_u *TenantUpdateOne) RemoveCustomerIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveCustomerIDs(ids...)
+	return _u
+}
+
+// RemoveCustomers removes "customers" edges to Customer entities.
+func (_u *TenantUpdateOne) RemoveCustomers(v ...*Customer) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCustomerIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *TenantUpdateOne) ClearInvoices() *TenantUpdateOne {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *TenantUpdateOne) RemoveInvoiceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *TenantUpdateOne) RemoveInvoices(v ...*Invoice) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// ClearCustomerPayments clears all "customer_payments" edges to the CustomerPayment entity.
+func (_u *TenantUpdateOne) ClearCustomerPayments() *TenantUpdateOne {
+	_u.mutation.ClearCustomerPayments()
+	return _u
+}
+
+// RemoveCustomerPaymentIDs removes the "customer_payments" edge to CustomerPayment entities by IDs.
+func (_u *TenantUpdateOne) RemoveCustomerPaymentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveCustomerPaymentIDs(ids...)
+	return _u
+}
+
+// RemoveCustomerPayments removes "customer_payments" edges to CustomerPayment entities.
+func (_u *TenantUpdateOne) RemoveCustomerPayments(v ...*CustomerPayment) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCustomerPaymentIDs(ids...)
+}
+
+// ClearSupplierBills clears all "supplier_bills" edges to the SupplierBill entity.
+func (_u *TenantUpdateOne) ClearSupplierBills() *TenantUpdateOne {
+	_u.mutation.ClearSupplierBills()
+	return _u
+}
+
+// RemoveSupplierBillIDs removes the "supplier_bills" edge to SupplierBill entities by IDs.
+func (_u *TenantUpdateOne) RemoveSupplierBillIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveSupplierBillIDs(ids...)
+	return _u
+}
+
+// RemoveSupplierBills removes "supplier_bills" edges to SupplierBill entities.
+func (_u *TenantUpdateOne) RemoveSupplierBills(v ...*SupplierBill) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSupplierBillIDs(ids...)
+}
+
+// ClearPaymentRuns clears all "payment_runs" edges to the PaymentRun entity.
+func (_u *TenantUpdateOne) ClearPaymentRuns() *TenantUpdateOne {
+	_u.mutation.ClearPaymentRuns()
+	return _u
+}
+
+// RemovePaymentRunIDs removes the "payment_runs" edge to PaymentRun entities by IDs.
+func (_u *TenantUpdateOne) RemovePaymentRunIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemovePaymentRunIDs(ids...)
+	return _u
+}
+
+// RemovePaymentRuns removes "payment_runs" edges to PaymentRun entities.
+func (_u *TenantUpdateOne) RemovePaymentRuns(v ...*PaymentRun) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentRunIDs(ids...)
+}
+
+// ClearGoodsReceipts clears all "goods_receipts" edges to the GoodsReceipt entity.
+func (_u *TenantUpdateOne) ClearGoodsReceipts() *TenantUpdateOne {
+	_u.mutation.ClearGoodsReceipts()
+	return _u
+}
+
+// RemoveGoodsReceiptIDs removes the "goods_receipts" edge to GoodsReceipt entities by IDs.
+func (_u *TenantUpdateOne) RemoveGoodsReceiptIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveGoodsReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveGoodsReceipts removes "goods_receipts" edges to GoodsReceipt entities.
+func (_u *TenantUpdateOne) RemoveGoodsReceipts(v ...*GoodsReceipt) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoodsReceiptIDs(ids...)
+}
+
+// ClearStockLevels clears all "stock_levels" edges to the StockLevel entity.
+func (_u *TenantUpdateOne) ClearStockLevels() *TenantUpdateOne {
+	_u.mutation.ClearStockLevels()
+	return _u
+}
+
+// RemoveStockLevelIDs removes the "stock_levels" edge to StockLevel entities by IDs.
+func (_u *TenantUpdateOne) RemoveStockLevelIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveStockLevelIDs(ids...)
+	return _u
+}
+
+// RemoveStockLevels removes "stock_levels" edges to StockLevel entities.
+func (_u *TenantUpdateOne) RemoveStockLevels(v ...*StockLevel) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockLevelIDs(ids...)
+}
+
+// ClearTransferOrders clears all "transfer_orders" edges to the TransferOrder entity.
+func (_u *TenantUpdateOne) ClearTransferOrders() *TenantUpdateOne {
+	_u.mutation.ClearTransferOrders()
+	return _u
+}
+
+// RemoveTransferOrderIDs removes the "transfer_orders" edge to TransferOrder entities by IDs.
+func (_u *TenantUpdateOne) RemoveTransferOrderIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveTransferOrderIDs(ids...)
+	return _u
+}
+
+// RemoveTransferOrders removes "transfer_orders" edges to TransferOrder entities.
+func (_u *TenantUpdateOne) RemoveTransferOrders(v ...*TransferOrder) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransferOrderIDs(ids...)
+}
+
+// ClearInventoryReservations clears all "inventory_reservations" edges to the InventoryReservation entity.
+func (_u *TenantUpdateOne) ClearInventoryReservations() *TenantUpdateOne {
+	_u.mutation.ClearInventoryReservations()
+	return _u
+}
+
+// RemoveInventoryReservationIDs removes the "inventory_reservations" edge to InventoryReservation entities by IDs.
+func (_u *TenantUpdateOne) RemoveInventoryReservationIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveInventoryReservationIDs(ids...)
+	return _u
+}
+
+// RemoveInventoryReservations removes "inventory_reservations" edges to InventoryReservation entities.
+func (_u *TenantUpdateOne) RemoveInventoryReservations(v ...*InventoryReservation) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInventoryReservationIDs(ids...)
+}
+
+// ClearDepartments clears all "departments" edges to the Department entity.
+func (_u *TenantUpdateOne) ClearDepartments() *TenantUpdateOne {
+	_u.mutation.ClearDepartments()
+	return _u
+}
+
+// RemoveDepartmentIDs removes the "departments" edge to Department entities by IDs.
+func (_u *TenantUpdateOne) RemoveDepartmentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveDepartmentIDs(ids...)
+	return _u
+}
+
+// RemoveDepartments removes "departments" edges to Department entities.
+func (_u *TenantUpdateOne) RemoveDepartments(v ...*Department) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDepartmentIDs(ids...)
+}
+
+// ClearPermissions clears all "permissions" edges to the Permission entity.
+func (_u *TenantUpdateOne) ClearPermissions() *TenantUpdateOne {
+	_u.mutation.ClearPermissions()
+	return _u
+}
+
+// RemovePermissionIDs removes the "permissions" edge to Permission entities by IDs.
+func (_u *TenantUpdateOne) RemovePermissionIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemovePermissionIDs(ids...)
+	return _u
+}
+
+// RemovePermissions removes "permissions" edges to Permission entities.
+func (_u *TenantUpdateOne) RemovePermissions(v ...*Permission) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePermissionIDs(ids...)
+}
+
+// ClearAssetTypes clears all "asset_types" edges to the AssetType entity.
+func (_u *TenantUpdateOne) ClearAssetTypes() *TenantUpdateOne {
+	_u.mutation.ClearAssetTypes()
+	return _u
+}
+
+// RemoveAssetTypeIDs removes the "asset_types" edge to AssetType entities by IDs.
+func (_u *TenantUpdateOne) RemoveAssetTypeIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveAssetTypeIDs(ids...)
+	return _u
+}
+
+// RemoveAssetTypes removes "asset_types" edges to AssetType entities.
+func (_u *TenantUpdateOne) RemoveAssetTypes(v ...*AssetType) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssetTypeIDs(ids...)
+}
+
+// ClearDetectionEvents clears all "detection_events" edges to the DetectionEvent entity.
+func (_u *TenantUpdateOne) ClearDetectionEvents() *TenantUpdateOne {
+	_u.mutation.ClearDetectionEvents()
+	return _u
+}
+
+// RemoveDetectionEventIDs removes the "detection_events" edge to DetectionEvent entities by IDs.
+func (_u *TenantUpdateOne) RemoveDetectionEventIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveDetectionEventIDs(ids...)
+	return _u
+}
+
+// RemoveDetectionEvents removes "detection_events" edges to DetectionEvent entities.
+func (_u *TenantUpdateOne) RemoveDetectionEvents(v ...*DetectionEvent) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDetectionEventIDs(ids...)
+}
+
+// ClearSaasIdentities clears all "saas_identities" edges to the SaaSIdentity entity.
+func (_u *TenantUpdateOne) ClearSaasIdentities() *TenantUpdateOne {
+	_u.mutation.ClearSaasIdentities()
+	return _u
+}
+
+// RemoveSaasIdentityIDs removes the "saas_identities" edge to SaaSIdentity entities by IDs.
+func (_u *TenantUpdateOne) RemoveSaasIdentityIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveSaasIdentityIDs(ids...)
+	return _u
+}
+
+// RemoveSaasIdentities removes "saas_identities" edges to SaaSIdentity entities.
+func (_u *TenantUpdateOne) RemoveSaasIdentities(v ...*SaaSIdentity) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSaasIdentityIDs(ids...)
+}
+
+// ClearSaasUsages clears all "saas_usages" edges to the SaaSUsage entity.
+func (_u *TenantUpdateOne) ClearSaasUsages() *TenantUpdateOne {
+	_u.mutation.ClearSaasUsages()
+	return _u
+}
+
+// RemoveSaasUsageIDs removes the "saas_usages" edge to SaaSUsage entities by IDs.
+func (_u *TenantUpdateOne) RemoveSaasUsageIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveSaasUsageIDs(ids...)
+	return _u
+}
+
+// RemoveSaasUsages removes "saas_usages" edges to SaaSUsage entities.
+func (_u *TenantUpdateOne) RemoveSaasUsages(v ...*SaaSUsage) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSaasUsageIDs(ids...)
+}
+
+// ClearRecordings clears all "recordings" edges to the Recording entity.
+func (_u *TenantUpdateOne) ClearRecordings() *TenantUpdateOne {
+	_u.mutation.ClearRecordings()
+	return _u
+}
+
+// RemoveRecordingIDs removes the "recordings" edge to Recording entities by IDs.
+func (_u *TenantUpdateOne) RemoveRecordingIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveRecordingIDs(ids...)
+	return _u
+}
+
+// RemoveRecordings removes "recordings" edges to Recording entities.
+func (_u *TenantUpdateOne) RemoveRecordings(v ...*Recording) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecordingIDs(ids...)
+}
+
+// ClearNetworkLinks clears all "network_links" edges to the NetworkLink entity.
+func (_u *TenantUpdateOne) ClearNetworkLinks() *TenantUpdateOne {
+	_u.mutation.ClearNetworkLinks()
+	return _u
+}
+
+// RemoveNetworkLinkIDs removes the "network_links" edge to NetworkLink entities by IDs.
+func (_u *TenantUpdateOne) RemoveNetworkLinkIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveNetworkLinkIDs(ids...)
+	return _u
+}
+
+// RemoveNetworkLinks removes "network_links" edges to NetworkLink entities.
+func (_u *TenantUpdateOne) RemoveNetworkLinks(v ...*NetworkLink) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNetworkLinkIDs(ids...)
+}
+
+// ClearNetworkPorts clears all "network_ports" edges to the NetworkPort entity.
+func (_u *TenantUpdateOne) ClearNetworkPorts() *TenantUpdateOne {
+	_u.mutation.ClearNetworkPorts()
+	return _u
+}
+
+// RemoveNetworkPortIDs removes the "network_ports" edge to NetworkPort entities by IDs.
+func (_u *TenantUpdateOne) RemoveNetworkPortIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveNetworkPortIDs(ids...)
+	return _u
+}
+
+// RemoveNetworkPorts removes "network_ports" edges to NetworkPort entities.
+func (_u *TenantUpdateOne) RemoveNetworkPorts(v ...*NetworkPort) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNetworkPortIDs(ids...)
+}
+
+// ClearNexusAudits clears all "nexus_audits" edges to the NexusAudit entity.
+func (_u *TenantUpdateOne) ClearNexusAudits() *TenantUpdateOne {
+	_u.mutation.ClearNexusAudits()
+	return _u
+}
+
+// RemoveNexusAuditIDs removes the "nexus_audits" edge to NexusAudit entities by IDs.
+func (_u *TenantUpdateOne) RemoveNexusAuditIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveNexusAuditIDs(ids...)
+	return _u
+}
+
+// RemoveNexusAudits removes "nexus_audits" edges to NexusAudit entities.
+func (_u *TenantUpdateOne) RemoveNexusAudits(v ...*NexusAudit) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNexusAuditIDs(ids...)
+}
+
+// ClearSuccessionMaps clears all "succession_maps" edges to the SuccessionMap entity.
+func (_u *TenantUpdateOne) ClearSuccessionMaps() *TenantUpdateOne {
+	_u.mutation.ClearSuccessionMaps()
+	return _u
+}
+
+// RemoveSuccessionMapIDs removes the "succession_maps" edge to SuccessionMap entities by IDs.
+func (_u *TenantUpdateOne) RemoveSuccessionMapIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveSuccessionMapIDs(ids...)
+	return _u
+}
+
+// RemoveSuccessionMaps removes "succession_maps" edges to SuccessionMap entities.
+func (_u *TenantUpdateOne) RemoveSuccessionMaps(v ...*SuccessionMap) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSuccessionMapIDs(ids...)
+}
+
+// ClearCustomerAccount clears the "customer_account" edge to the Account entity.
+func (_u *TenantUpdateOne) ClearCustomerAccount() *TenantUpdateOne {
+	_u.mutation.ClearCustomerAccount()
+	return _u
+}
+
+// ClearScripts clears all "scripts" edges to the Script entity.
+func (_u *TenantUpdateOne) ClearScripts() *TenantUpdateOne {
+	_u.mutation.ClearScripts()
+	return _u
+}
+
+// RemoveScriptIDs removes the "scripts" edge to Script entities by IDs.
+func (_u *TenantUpdateOne) RemoveScriptIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveScriptIDs(ids...)
+	return _u
+}
+
+// RemoveScripts removes "scripts" edges to Script entities.
+func (_u *TenantUpdateOne) RemoveScripts(v ...*Script) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScriptIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (_u *TenantUpdateOne) ClearJobs() *TenantUpdateOne {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (_u *TenantUpdateOne) RemoveJobIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (_u *TenantUpdateOne) RemoveJobs(v ...*Job) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
+// ClearTimeOffRequests clears all "time_off_requests" edges to the TimeOffRequest entity.
+func (_u *TenantUpdateOne) ClearTimeOffRequests() *TenantUpdateOne {
+	_u.mutation.ClearTimeOffRequests()
+	return _u
+}
+
+// RemoveTimeOffRequestIDs removes the "time_off_requests" edge to TimeOffRequest entities by IDs.
+func (_u *TenantUpdateOne) RemoveTimeOffRequestIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveTimeOffRequestIDs(ids...)
+	return _u
+}
+
+// RemoveTimeOffRequests removes "time_off_requests" edges to TimeOffRequest entities.
+func (_u *TenantUpdateOne) RemoveTimeOffRequests(v ...*TimeOffRequest) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTimeOffRequestIDs(ids...)
+}
+
+// ClearTimeOffPolicies clears all "time_off_policies" edges to the TimeOffPolicy entity.
+func (_u *TenantUpdateOne) ClearTimeOffPolicies() *TenantUpdateOne {
+	_u.mutation.ClearTimeOffPolicies()
+	return _u
+}
+
+// RemoveTimeOffPolicyIDs removes the "time_off_policies" edge to TimeOffPolicy entities by IDs.
+func (_u *TenantUpdateOne) RemoveTimeOffPolicyIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveTimeOffPolicyIDs(ids...)
+	return _u
+}
+
+// RemoveTimeOffPolicies removes "time_off_policies" edges to TimeOffPolicy entities.
+func (_u *TenantUpdateOne) RemoveTimeOffPolicies(v ...*TimeOffPolicy) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTimeOffPolicyIDs(ids...)
+}
+
+// ClearTimeOffBalances clears all "time_off_balances" edges to the TimeOffBalance entity.
+func (_u *TenantUpdateOne) ClearTimeOffBalances() *TenantUpdateOne {
+	_u.mutation.ClearTimeOffBalances()
+	return _u
+}
+
+// RemoveTimeOffBalanceIDs removes the "time_off_balances" edge to TimeOffBalance entities by IDs.
+func (_u *TenantUpdateOne) RemoveTimeOffBalanceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveTimeOffBalanceIDs(ids...)
+	return _u
+}
+
+// RemoveTimeOffBalances removes "time_off_balances" edges to TimeOffBalance entities.
+func (_u *TenantUpdateOne) RemoveTimeOffBalances(v ...*TimeOffBalance) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTimeOffBalanceIDs(ids...)
+}
+
+// ClearReviewCycles clears all "review_cycles" edges to the ReviewCycle entity.
+func (_u *TenantUpdateOne) ClearReviewCycles() *TenantUpdateOne {
+	_u.mutation.ClearReviewCycles()
+	return _u
+}
+
+// RemoveReviewCycleIDs removes the "review_cycles" edge to ReviewCycle entities by IDs.
+func (_u *TenantUpdateOne) RemoveReviewCycleIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveReviewCycleIDs(ids...)
+	return _u
+}
+
+// RemoveReviewCycles removes "review_cycles" edges to ReviewCycle entities.
+func (_u *TenantUpdateOne) RemoveReviewCycles(v ...*ReviewCycle) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewCycleIDs(ids...)
+}
+
+// ClearPerformanceReviews clears all "performance_reviews" edges to the PerformanceReview entity.
+func (_u *TenantUpdateOne) ClearPerformanceReviews() *TenantUpdateOne {
+	_u.mutation.ClearPerformanceReviews()
+	return _u
+}
+
+// RemovePerformanceReviewIDs removes the "performance_reviews" edge to PerformanceReview entities by IDs.
+func (_u *TenantUpdateOne) RemovePerformanceReviewIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemovePerformanceReviewIDs(ids...)
+	return _u
+}
+
+// RemovePerformanceReviews removes "performance_reviews" edges to PerformanceReview entities.
+func (_u *TenantUpdateOne) RemovePerformanceReviews(v ...*PerformanceReview) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePerformanceReviewIDs(ids...)
+}
+
+// ClearGoals clears all "goals" edges to the Goal entity.
+func (_u *TenantUpdateOne) ClearGoals() *TenantUpdateOne {
+	_u.mutation.ClearGoals()
+	return _u
+}
+
+// RemoveGoalIDs removes the "goals" edge to Goal entities by IDs.
+func (_u *TenantUpdateOne) RemoveGoalIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveGoalIDs(ids...)
+	return _u
+}
+
+// RemoveGoals removes "goals" edges to Goal entities.
+func (_u *TenantUpdateOne) RemoveGoals(v ...*Goal) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoalIDs(ids...)
+}
+
+// ClearSuppliers clears all "suppliers" edges to the Supplier entity.
+func (_u *TenantUpdateOne) ClearSuppliers() *TenantUpdateOne {
+	_u.mutation.ClearSuppliers()
+	return _u
+}
+
+// RemoveSupplierIDs removes the "suppliers" edge to Supplier entities by IDs.
+func (_u *TenantUpdateOne) RemoveSupplierIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveSupplierIDs(ids...)
+	return _u
+}
+
+// RemoveSuppliers removes "suppliers" edges to Supplier entities.
+func (_u *TenantUpdateOne) RemoveSuppliers(v ...*Supplier) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSupplierIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (_u *TenantUpdateOne) ClearCategories() *TenantUpdateOne {
+	_u.mutation.ClearCategories()
+	return _u
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (_u *TenantUpdateOne) RemoveCategoryIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveCategoryIDs(ids...)
+	return _u
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (_u *TenantUpdateOne) RemoveCategories(v ...*Category) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCategoryIDs(ids...)
+}
+
+// ClearWarehouses clears all "warehouses" edges to the Warehouse entity.
+func (_u *TenantUpdateOne) ClearWarehouses() *TenantUpdateOne {
+	_u.mutation.ClearWarehouses()
+	return _u
+}
+
+// RemoveWarehouseIDs removes the "warehouses" edge to Warehouse entities by IDs.
+func (_u *TenantUpdateOne) RemoveWarehouseIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveWarehouseIDs(ids...)
+	return _u
+}
+
+// RemoveWarehouses removes "warehouses" edges to Warehouse entities.
+func (_u *TenantUpdateOne) RemoveWarehouses(v ...*Warehouse) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWarehouseIDs(ids...)
+}
+
+// ClearAssetAssignments clears all "asset_assignments" edges to the AssetAssignment entity.
+func (_u *TenantUpdateOne) ClearAssetAssignments() *TenantUpdateOne {
+	_u.mutation.ClearAssetAssignments()
+	return _u
+}
+
+// RemoveAssetAssignmentIDs removes the "asset_assignments" edge to AssetAssignment entities by IDs.
+func (_u *TenantUpdateOne) RemoveAssetAssignmentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveAssetAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveAssetAssignments removes "asset_assignments" edges to AssetAssignment entities.
+func (_u *TenantUpdateOne) RemoveAssetAssignments(v ...*AssetAssignment) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssetAssignmentIDs(ids...)
+}
+
+// ClearContacts clears all "contacts" edges to the Contact entity.
+func (_u *TenantUpdateOne) ClearContacts() *TenantUpdateOne {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// RemoveContactIDs removes the "contacts" edge to Contact entities by IDs.
+func (_u *TenantUpdateOne) RemoveContactIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveContactIDs(ids...)
+	return _u
+}
+
+// RemoveContacts removes "contacts" edges to Contact entities.
+func (_u *TenantUpdateOne) RemoveContacts(v ...*Contact) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContactIDs(ids...)
+}
+
+// ClearLegalHolds clears all "legal_holds" edges to the LegalHold entity.
+func (_u *TenantUpdateOne) ClearLegalHolds() *TenantUpdateOne {
+	_u.mutation.ClearLegalHolds()
+	return _u
+}
+
+// RemoveLegalHoldIDs removes the "legal_holds" edge to LegalHold entities by IDs.
+func (_u *TenantUpdateOne) RemoveLegalHoldIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveLegalHoldIDs(ids...)
+	return _u
+}
+
+// RemoveLegalHolds removes "legal_holds" edges to LegalHold entities.
+func (_u *TenantUpdateOne) RemoveLegalHolds(v ...*LegalHold) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLegalHoldIDs(ids...)
+}
+
+// ClearRetentionPolicies clears all "retention_policies" edges to the RetentionPolicy entity.
+func (_u *TenantUpdateOne) ClearRetentionPolicies() *TenantUpdateOne {
+	_u.mutation.ClearRetentionPolicies()
+	return _u
+}
+
+// RemoveRetentionPolicyIDs removes the "retention_policies" edge to RetentionPolicy entities by IDs.
+func (_u *TenantUpdateOne) RemoveRetentionPolicyIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveRetentionPolicyIDs(ids...)
+	return _u
+}
+
+// RemoveRetentionPolicies removes "retention_policies" edges to RetentionPolicy entities.
+func (_u *TenantUpdateOne) RemoveRetentionPolicies(v ...*RetentionPolicy) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRetentionPolicyIDs(ids...)
+}
+
+// ClearVaultTemplates clears all "vault_templates" edges to the VaultTemplate entity.
+func (_u *TenantUpdateOne) ClearVaultTemplates() *TenantUpdateOne {
+	_u.mutation.ClearVaultTemplates()
+	return _u
+}
+
+// RemoveVaultTemplateIDs removes the "vault_templates" edge to VaultTemplate entities by IDs.
+func (_u *TenantUpdateOne) RemoveVaultTemplateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveVaultTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveVaultTemplates removes "vault_templates" edges to VaultTemplate entities.
+func (_u *TenantUpdateOne) RemoveVaultTemplates(v ...*VaultTemplate) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVaultTemplateIDs(ids...)
+}
+
+// ClearStockAuditLogs clears all "stock_audit_logs" edges to the StockAuditLog entity.
+func (_u *TenantUpdateOne) ClearStockAuditLogs() *TenantUpdateOne {
+	_u.mutation.ClearStockAuditLogs()
+	return _u
+}
+
+// RemoveStockAuditLogIDs removes the "stock_audit_logs" edge to StockAuditLog entities by IDs.
+func (_u *TenantUpdateOne) RemoveStockAuditLogIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveStockAuditLogIDs(ids...)
+	return _u
+}
+
+// RemoveStockAuditLogs removes "stock_audit_logs" edges to StockAuditLog entities.
+func (_u *TenantUpdateOne) RemoveStockAuditLogs(v ...*StockAuditLog) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockAuditLogIDs(ids...)
+}
+
+// ClearMaintenanceSchedules clears all "maintenance_schedules" edges to the MaintenanceSchedule entity.
+func (_u *TenantUpdateOne) ClearMaintenanceSchedules() *TenantUpdateOne {
+	_u.mutation.ClearMaintenanceSchedules()
+	return _u
+}
+
+// RemoveMaintenanceScheduleIDs removes the "maintenance_schedules" edge to MaintenanceSchedule entities by IDs.
+func (_u *TenantUpdateOne) RemoveMaintenanceScheduleIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveMaintenanceScheduleIDs(ids...)
+	return _u
+}
+
+// RemoveMaintenanceSchedules removes "maintenance_schedules" edges to MaintenanceSchedule entities.
+func (_u *TenantUpdateOne) RemoveMaintenanceSchedules(v ...*MaintenanceSchedule) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMaintenanceScheduleIDs(ids...)
+}
+
+// ClearStockAlerts clears all "stock_alerts" edges to the StockAlert entity.
+func (_u *TenantUpdateOne) ClearStockAlerts() *TenantUpdateOne {
+	_u.mutation.ClearStockAlerts()
+	return _u
+}
+
+// RemoveStockAlertIDs removes the "stock_alerts" edge to StockAlert entities by IDs.
+func (_u *TenantUpdateOne) RemoveStockAlertIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveStockAlertIDs(ids...)
+	return _u
+}
+
+// RemoveStockAlerts removes "stock_alerts" edges to StockAlert entities.
+func (_u *TenantUpdateOne) RemoveStockAlerts(v ...*StockAlert) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockAlertIDs(ids...)
+}
+
+// ClearPurchaseOrders clears all "purchase_orders" edges to the PurchaseOrder entity.
+func (_u *TenantUpdateOne) ClearPurchaseOrders() *TenantUpdateOne {
+	_u.mutation.ClearPurchaseOrders()
+	return _u
+}
+
+// RemovePurchaseOrderIDs removes the "purchase_orders" edge to PurchaseOrder entities by IDs.
+func (_u *TenantUpdateOne) RemovePurchaseOrderIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemovePurchaseOrderIDs(ids...)
+	return _u
+}
+
+// RemovePurchaseOrders removes "purchase_orders" edges to PurchaseOrder entities.
+func (_u *TenantUpdateOne) RemovePurchaseOrders(v ...*PurchaseOrder) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePurchaseOrderIDs(ids...)
+}
+
+// ClearInventoryCounts clears all "inventory_counts" edges to the InventoryCount entity.
+func (_u *TenantUpdateOne) ClearInventoryCounts() *TenantUpdateOne {
+	_u.mutation.ClearInventoryCounts()
+	return _u
+}
+
+// RemoveInventoryCountIDs removes the "inventory_counts" edge to InventoryCount entities by IDs.
+func (_u *TenantUpdateOne) RemoveInventoryCountIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveInventoryCountIDs(ids...)
+	return _u
+}
+
+// RemoveInventoryCounts removes "inventory_counts" edges to InventoryCount entities.
+func (_u *TenantUpdateOne) RemoveInventoryCounts(v ...*InventoryCount) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInventoryCountIDs(ids...)
+}
+
+// ClearJobPostings clears all "job_postings" edges to the JobPosting entity.
+func (_u *TenantUpdateOne) ClearJobPostings() *TenantUpdateOne {
+	_u.mutation.ClearJobPostings()
+	return _u
+}
+
+// RemoveJobPostingIDs removes the "job_postings" edge to JobPosting entities by IDs.
+func (_u *TenantUpdateOne) RemoveJobPostingIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveJobPostingIDs(ids...)
+	return _u
+}
+
+// RemoveJobPostings removes "job_postings" edges to JobPosting entities.
+func (_u *TenantUpdateOne) RemoveJobPostings(v ...*JobPosting) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobPostingIDs(ids...)
+}
+
+// ClearCandidates clears all "candidates" edges to the Candidate entity.
+func (_u *TenantUpdateOne) ClearCandidates() *TenantUpdateOne {
+	_u.mutation.ClearCandidates()
+	return _u
+}
+
+// RemoveCandidateIDs removes the "candidates" edge to Candidate entities by IDs.
+func (_u *TenantUpdateOne) RemoveCandidateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveCandidateIDs(ids...)
+	return _u
+}
+
+// RemoveCandidates removes "candidates" edges to Candidate entities.
+func (_u *TenantUpdateOne) RemoveCandidates(v ...*Candidate) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCandidateIDs(ids...)
+}
+
+// ClearApplications clears all "applications" edges to the Application entity.
+func (_u *TenantUpdateOne) ClearApplications() *TenantUpdateOne {
+	_u.mutation.ClearApplications()
+	return _u
+}
+
+// RemoveApplicationIDs removes the "applications" edge to Application entities by IDs.
+func (_u *TenantUpdateOne) RemoveApplicationIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveApplicationIDs(ids...)
+	return _u
+}
+
+// RemoveApplications removes "applications" edges to Application entities.
+func (_u *TenantUpdateOne) RemoveApplications(v ...*Application) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveApplicationIDs(ids...)
+}
+
+// ClearInterviews clears all "interviews" edges to the Interview entity.
+func (_u *TenantUpdateOne) ClearInterviews() *TenantUpdateOne {
+	_u.mutation.ClearInterviews()
+	return _u
+}
+
+// RemoveInterviewIDs removes the "interviews" edge to Interview entities by IDs.
+func (_u *TenantUpdateOne) RemoveInterviewIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveInterviewIDs(ids...)
+	return _u
+}
+
+// RemoveInterviews removes "interviews" edges to Interview entities.
+func (_u *TenantUpdateOne) RemoveInterviews(v ...*Interview) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInterviewIDs(ids...)
+}
+
+// ClearBenefitPlans clears all "benefit_plans" edges to the BenefitPlan entity.
+func (_u *TenantUpdateOne) ClearBenefitPlans() *TenantUpdateOne {
+	_u.mutation.ClearBenefitPlans()
+	return _u
+}
+
+// RemoveBenefitPlanIDs removes the "benefit_plans" edge to BenefitPlan entities by IDs.
+func (_u *TenantUpdateOne) RemoveBenefitPlanIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveBenefitPlanIDs(ids...)
+	return _u
+}
+
+// RemoveBenefitPlans removes "benefit_plans" edges to BenefitPlan entities.
+func (_u *TenantUpdateOne) RemoveBenefitPlans(v ...*BenefitPlan) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBenefitPlanIDs(ids...)
+}
+
+// ClearBenefitEnrollments clears all "benefit_enrollments" edges to the BenefitEnrollment entity.
+func (_u *TenantUpdateOne) ClearBenefitEnrollments() *TenantUpdateOne {
+	_u.mutation.ClearBenefitEnrollments()
+	return _u
+}
+
+// RemoveBenefitEnrollmentIDs removes the "benefit_enrollments" edge to BenefitEnrollment entities by IDs.
+func (_u *TenantUpdateOne) RemoveBenefitEnrollmentIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveBenefitEnrollmentIDs(ids...)
+	return _u
+}
+
+// RemoveBenefitEnrollments removes "benefit_enrollments" edges to BenefitEnrollment entities.
+func (_u *TenantUpdateOne) RemoveBenefitEnrollments(v ...*BenefitEnrollment) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBenefitEnrollmentIDs(ids...)
+}
+
+// ClearPosShifts clears all "pos_shifts" edges to the PosShift entity.
+func (_u *TenantUpdateOne) ClearPosShifts() *TenantUpdateOne {
+	_u.mutation.ClearPosShifts()
+	return _u
+}
+
+// RemovePosShiftIDs removes the "pos_shifts" edge to PosShift entities by IDs.
+func (_u *TenantUpdateOne) RemovePosShiftIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemovePosShiftIDs(ids...)
+	return _u
+}
+
+// RemovePosShifts removes "pos_shifts" edges to PosShift entities.
+func (_u *TenantUpdateOne) RemovePosShifts(v ...*PosShift) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePosShiftIDs(ids...)
+}
+
+// ClearLabelTemplates clears all "label_templates" edges to the LabelTemplate entity.
+func (_u *TenantUpdateOne) ClearLabelTemplates() *TenantUpdateOne {
+	_u.mutation.ClearLabelTemplates()
+	return _u
+}
+
+// RemoveLabelTemplateIDs removes the "label_templates" edge to LabelTemplate entities by IDs.
+func (_u *TenantUpdateOne) RemoveLabelTemplateIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveLabelTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveLabelTemplates removes "label_templates" edges to LabelTemplate entities.
+func (_u *TenantUpdateOne) RemoveLabelTemplates(v ...*LabelTemplate) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLabelTemplateIDs(ids...)
+}
+
+// ClearTaxJurisdictions clears all "tax_jurisdictions" edges to the TaxJurisdiction entity.
+func (_u *TenantUpdateOne) ClearTaxJurisdictions() *TenantUpdateOne {
+	_u.mutation.ClearTaxJurisdictions()
+	return _u
+}
+
+// RemoveTaxJurisdictionIDs removes the "tax_jurisdictions" edge to TaxJurisdiction entities by IDs.
+func (_u *TenantUpdateOne) RemoveTaxJurisdictionIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveTaxJurisdictionIDs(ids...)
+	return _u
+}
+
+// RemoveTaxJurisdictions removes "tax_jurisdictions" edges to TaxJurisdiction entities.
+func (_u *TenantUpdateOne) RemoveTaxJurisdictions(v ...*TaxJurisdiction) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaxJurisdictionIDs(ids...)
+}
+
+// ClearEinvoiceCredentials clears all "einvoice_credentials" edges to the EInvoiceCredential entity.
+func (_u *TenantUpdateOne) ClearEinvoiceCredentials() *TenantUpdateOne {
+	_u.mutation.ClearEinvoiceCredentials()
+	return _u
+}
+
+// RemoveEinvoiceCredentialIDs removes the "einvoice_credentials" edge to EInvoiceCredential entities by IDs.
+func (_u *TenantUpdateOne) RemoveEinvoiceCredentialIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveEinvoiceCredentialIDs(ids...)
+	return _u
+}
+
+// RemoveEinvoiceCredentials removes "einvoice_credentials" edges to EInvoiceCredential entities.
+func (_u *TenantUpdateOne) RemoveEinvoiceCredentials(v ...*EInvoiceCredential) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEinvoiceCredentialIDs(ids...)
+}
+
+// ClearEinvoices clears all "einvoices" edges to the EInvoice entity.
+func (_u *TenantUpdateOne) ClearEinvoices() *TenantUpdateOne {
+	_u.mutation.ClearEinvoices()
+	return _u
+}
+
+// RemoveEinvoiceIDs removes the "einvoices" edge to EInvoice entities by IDs.
+func (_u *TenantUpdateOne) RemoveEinvoiceIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveEinvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveEinvoices removes "einvoices" edges to EInvoice entities.
+func (_u *TenantUpdateOne) RemoveEinvoices(v ...*EInvoice) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEinvoiceIDs(ids...)
+}
+
+// ClearAgentEnrollmentTokens clears all "agent_enrollment_tokens" edges to the AgentEnrollmentToken entity.
+func (_u *TenantUpdateOne) ClearAgentEnrollmentTokens() *TenantUpdateOne {
+	_u.mutation.ClearAgentEnrollmentTokens()
+	return _u
+}
+
+// RemoveAgentEnrollmentTokenIDs removes the "agent_enrollment_tokens" edge to AgentEnrollmentToken entities by IDs.
+func (_u *TenantUpdateOne) RemoveAgentEnrollmentTokenIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveAgentEnrollmentTokenIDs(ids...)
+	return _u
+}
+
+// RemoveAgentEnrollmentTokens removes "agent_enrollment_tokens" edges to AgentEnrollmentToken entities.
+func (_u *TenantUpdateOne) RemoveAgentEnrollmentTokens(v ...*AgentEnrollmentToken) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAgentEnrollmentTokenIDs(ids...)
+}
+
+// ClearAgentAuthority clears the "agent_authority" edge to the AgentAuthority entity.
+func (_u *TenantUpdateOne) ClearAgentAuthority() *TenantUpdateOne {
+	_u.mutation.ClearAgentAuthority()
+	return _u
+}
+
+// ClearDeviceGroups clears all "device_groups" edges to the DeviceGroup entity.
+func (_u *TenantUpdateOne) ClearDeviceGroups() *TenantUpdateOne {
+	_u.mutation.ClearDeviceGroups()
+	return _u
+}
+
+// RemoveDeviceGroupIDs removes the "device_groups" edge to DeviceGroup entities by IDs.
+func (_u *TenantUpdateOne) RemoveDeviceGroupIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveDeviceGroupIDs(ids...)
+	return _u
+}
+
+// RemoveDeviceGroups removes "device_groups" edges to DeviceGroup entities.
+func (_u *TenantUpdateOne) RemoveDeviceGroups(v ...*DeviceGroup) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeviceGroupIDs(ids...)
+}
+
+// ClearPatchPolicies clears all "patch_policies" edges to the PatchPolicy entity.
+func (_u *TenantUpdateOne) ClearPatchPolicies() *TenantUpdateOne {
+	_u.mutation.ClearPatchPolicies()
+	return _u
+}
+
+// RemovePatchPolicyIDs removes the "patch_policies" edge to PatchPolicy entities by IDs.
+func (_u *TenantUpdateOne) RemovePatchPolicyIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemovePatchPolicyIDs(ids...)
+	return _u
+}
+
+// RemovePatchPolicies removes "patch_policies" edges to PatchPolicy entities.
+func (_u *TenantUpdateOne) RemovePatchPolicies(v ...*PatchPolicy) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePatchPolicyIDs(ids...)
+}
+
+// ClearAlertRules clears all "alert_rules" edges to the AlertRule entity.
+func (_u *TenantUpdateOne) ClearAlertRules() *TenantUpdateOne {
+	_u.mutation.ClearAlertRules()
+	return _u
+}
+
+// RemoveAlertRuleIDs removes the "alert_rules" edge to AlertRule entities by IDs.
+func (_u *TenantUpdateOne) RemoveAlertRuleIDs(ids ...int) *TenantUpdateOne {
+	_u.mutation.RemoveAlertRuleIDs(ids...)
+	return _u
+}
+
+// RemoveAlertRules removes "alert_rules" edges to AlertRule entities.
+func (_u *TenantUpdateOne) RemoveAlertRules(v ...*AlertRule) *TenantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAlertRuleIDs(ids...)
+}
+
+// Where appends a list predicates to the TenantUpdate builder.
+func (_u *TenantUpdateOne) Where(ps ...predicate.Tenant) *TenantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantUpdateOne) Select(field string, fields ...string) *TenantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Tenant entity.
+func (_u *TenantUpdateOne) Save(ctx context.Context) (*Tenant, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantUpdateOne) SaveX(ctx context.Context) *Tenant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantUpdateOne) check() error {
+	if v, ok := _u.mutation.FiscalYearStartMonth(); ok {
+		if err := tenant.FiscalYearStartMonthValidator(v); err != nil {
+			return &ValidationError{Name: "fiscal_year_start_month", err: fmt.Errorf(`ent: validator failed for field "Tenant.fiscal_year_start_month": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tenant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenant.FieldID)
+		for _, f := range fields {
+			if !tenant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(tenant.FieldDomain, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(tenant.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TransactionLimit(); ok {
+		_spec.SetField(tenant.FieldTransactionLimit, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FunctionalCurrency(); ok {
+		_spec.SetField(tenant.FieldFunctionalCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FiscalYearStartMonth(); ok {
+		_spec.SetField(tenant.FieldFiscalYearStartMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFiscalYearStartMonth(); ok {
+		_spec.AddField(tenant.FieldFiscalYearStartMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TaxNumber(); ok {
+		_spec.SetField(tenant.FieldTaxNumber, field.TypeString, value)
+	}
+	if _u.mutation.TaxNumberCleared() {
+		_spec.ClearField(tenant.FieldTaxNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.Logo(); ok {
+		_spec.SetField(tenant.FieldLogo, field.TypeBytes, value)
+	}
+	if _u.mutation.LogoCleared() {
+		_spec.ClearField(tenant.FieldLogo, field.TypeBytes)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.ParentTable,
+			Columns: []string{tenant.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.ParentTable,
+			Columns: []string{tenant.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.UsersTable,
+			Columns: []string{tenant.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.UsersTable,
+			Columns: []string{tenant.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.UsersTable,
+			Columns: []string{tenant.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccountsTable,
+			Columns: []string{tenant.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccountsIDs(); len(nodes) > 0 && !_u.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccountsTable,
+			Columns: []string{tenant.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccountsTable,
+			Columns: []string{tenant.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TransactionsTable,
+			Columns: []string{tenant.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TransactionsTable,
+			Columns: []string{tenant.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TransactionsTable,
+			Columns: []string{tenant.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedgerEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LedgerEntriesTable,
+			Columns: []string{tenant.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLedgerEntriesIDs(); len(nodes) > 0 && !_u.mutation.LedgerEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LedgerEntriesTable,
+			Columns: []string{tenant.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.LedgerEntriesTable,
+			Columns: []string{tenant.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ProductsTable,
+			Columns: []string{tenant.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedProductsIDs(); len(nodes) > 0 && !_u.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ProductsTable,
+			Columns: []string{tenant.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ProductsTable,
+			Columns: []string{tenant.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockMovementsTable,
+			Columns: []string{tenant.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockMovementsTable,
+			Columns: []string{tenant.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StockMovementsTable,
+			Columns: []string{tenant.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AuditLogsTable,
+			Columns: []string{tenant.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AuditLogsTable,
+			Columns: []string{tenant.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AuditLogsTable,
+			Columns: []string{tenant.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AgentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AgentsTable,
+			Columns: []string{tenant.AgentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAgentsIDs(); len(nodes) > 0 && !_u.mutation.AgentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AgentsTable,
+			Columns: []string{tenant.AgentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AgentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AgentsTable,
+			Columns: []string{tenant.AgentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DiscoveryEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DiscoveryEntriesTable,
+			Columns: []string{tenant.DiscoveryEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoveryentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDiscoveryEntriesIDs(); len(nodes) > 0 && !_u.mutation.DiscoveryEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DiscoveryEntriesTable,
+			Columns: []string{tenant.DiscoveryEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoveryentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DiscoveryEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DiscoveryEntriesTable,
+			Columns: []string{tenant.DiscoveryEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discoveryentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AssetsTable,
+			Columns: []string{tenant.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssetsIDs(); len(nodes) > 0 && !_u.mutation.AssetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AssetsTable,
+			Columns: []string{tenant.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AssetsTable,
+			Columns: []string{tenant.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CredentialsTable,
+			Columns: []string{tenant.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCredentialsIDs(); len(nodes) > 0 && !_u.mutation.CredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CredentialsTable,
+			Columns: []string{tenant.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CredentialsTable,
+			Columns: []string{tenant.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OneTimeLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OneTimeLinksTable,
+			Columns: []string{tenant.OneTimeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOneTimeLinksIDs(); len(nodes) > 0 && !_u.mutation.OneTimeLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OneTimeLinksTable,
+			Columns: []string{tenant.OneTimeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OneTimeLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OneTimeLinksTable,
+			Columns: []string{tenant.OneTimeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SopsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SopsTable,
+			Columns: []string{tenant.SopsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sop.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSopsIDs(); len(nodes) > 0 && !_u.mutation.SopsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SopsTable,
+			Columns: []string{tenant.SopsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sop.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SopsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SopsTable,
+			Columns: []string{tenant.SopsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sop.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CamerasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CamerasTable,
+			Columns: []string{tenant.CamerasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(camera.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCamerasIDs(); len(nodes) > 0 && !_u.mutation.CamerasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CamerasTable,
+			Columns: []string{tenant.CamerasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(camera.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CamerasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CamerasTable,
+			Columns: []string{tenant.CamerasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(camera.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TicketsTable,
+			Columns: []string{tenant.TicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTicketsIDs(); len(nodes) > 0 && !_u.mutation.TicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TicketsTable,
+			Columns: []string{tenant.TicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TicketsTable,
+			Columns: []string{tenant.TicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContractsTable,
+			Columns: []string{tenant.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contract.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContractsIDs(); len(nodes) > 0 && !_u.mutation.ContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContractsTable,
+			Columns: []string{tenant.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contract.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContractsTable,
+			Columns: []string{tenant.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contract.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SaasAppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SaasAppsTable,
+			Columns: []string{tenant.SaasAppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saasapp.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSaasAppsIDs(); len(nodes) > 0 && !_u.mutation.SaasAppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SaasAppsTable,
+			Columns: []string{tenant.SaasAppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saasapp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SaasAppsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SaasAppsTable,
+			Columns: []string{tenant.SaasAppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saasapp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SaasFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SaasFiltersTable,
+			Columns: []string{tenant.SaasFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saasfilter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSaasFiltersIDs(); len(nodes) > 0 && !_u.mutation.SaasFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SaasFiltersTable,
+			Columns: []string{tenant.SaasFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saasfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SaasFiltersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SaasFiltersTable,
+			Columns: []string{tenant.SaasFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saasfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CallLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CallLogsTable,
+			Columns: []string{tenant.CallLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCallLogsIDs(); len(nodes) > 0 && !_u.mutation.CallLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CallLogsTable,
+			Columns: []string{tenant.CallLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CallLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CallLogsTable,
+			Columns: []string{tenant.CallLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (_u *TenantUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *TenantUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+	if _u.mutation.IvrFlowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.IvrFlowsTable,
+			Columns: []string{tenant.IvrFlowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ivrflow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *TenantUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantUpdateOne {
-	_u.modifiers = append(_u.modifiers, modifiers...)
-	return _u
-}
-
-func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err error) {
-	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tenant.id" for update`)}
+	if nodes := _u.mutation.RemovedIvrFlowsIDs(); len(nodes) > 0 && !_u.mutation.IvrFlowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.IvrFlowsTable,
+			Columns: []string{tenant.IvrFlowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ivrflow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, tenant.FieldID)
-		for _, f := range fields {
-			if !tenant.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != tenant.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := _u.mutation.IvrFlowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.IvrFlowsTable,
+			Columns: []string{tenant.IvrFlowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ivrflow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if _u.mutation.VoicemailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.VoicemailsTable,
+			Columns: []string{tenant.VoicemailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voicemail.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(tenant.FieldName, field.TypeString, value)
+	if nodes := _u.mutation.RemovedVoicemailsIDs(); len(nodes) > 0 && !_u.mutation.VoicemailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.VoicemailsTable,
+			Columns: []string{tenant.VoicemailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voicemail.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Domain(); ok {
-		_spec.SetField(tenant.FieldDomain, field.TypeString, value)
+	if nodes := _u.mutation.VoicemailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.VoicemailsTable,
+			Columns: []string{tenant.VoicemailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voicemail.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
+	if _u.mutation.HealthSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.HealthSnapshotsTable,
+			Columns: []string{tenant.HealthSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(healthscoresnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Active(); ok {
-		_spec.SetField(tenant.FieldActive, field.TypeBool, value)
+	if nodes := _u.mutation.RemovedHealthSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.HealthSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.HealthSnapshotsTable,
+			Columns: []string{tenant.HealthSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(healthscoresnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.TransactionLimit(); ok {
-		_spec.SetField(tenant.FieldTransactionLimit, field.TypeOther, value)
+	if nodes := _u.mutation.HealthSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.HealthSnapshotsTable,
+			Columns: []string{tenant.HealthSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(healthscoresnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ParentCleared() {
+	if _u.mutation.RoadmapsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   tenant.ParentTable,
-			Columns: []string{tenant.ParentColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RoadmapsTable,
+			Columns: []string{tenant.RoadmapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(strategicroadmap.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedRoadmapsIDs(); len(nodes) > 0 && !_u.mutation.RoadmapsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   tenant.ParentTable,
-			Columns: []string{tenant.ParentColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RoadmapsTable,
+			Columns: []string{tenant.RoadmapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategicroadmap.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoadmapsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RoadmapsTable,
+			Columns: []string{tenant.RoadmapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(strategicroadmap.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9146,28 +13222,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ChildrenCleared() {
+	if _u.mutation.ServiceRatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ChildrenTable,
-			Columns: []string{tenant.ChildrenColumn},
+			Table:   tenant.ServiceRatesTable,
+			Columns: []string{tenant.ServiceRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(servicerate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+	if nodes := _u.mutation.RemovedServiceRatesIDs(); len(nodes) > 0 && !_u.mutation.ServiceRatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ChildrenTable,
-			Columns: []string{tenant.ChildrenColumn},
+			Table:   tenant.ServiceRatesTable,
+			Columns: []string{tenant.ServiceRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(servicerate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9175,15 +13251,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ServiceRatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ChildrenTable,
-			Columns: []string{tenant.ChildrenColumn},
+			Table:   tenant.ServiceRatesTable,
+			Columns: []string{tenant.ServiceRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(servicerate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9191,28 +13267,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UsersCleared() {
+	if _u.mutation.NetworkDevicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.UsersTable,
-			Columns: []string{tenant.UsersColumn},
+			Table:   tenant.NetworkDevicesTable,
+			Columns: []string{tenant.NetworkDevicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+	if nodes := _u.mutation.RemovedNetworkDevicesIDs(); len(nodes) > 0 && !_u.mutation.NetworkDevicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.UsersTable,
-			Columns: []string{tenant.UsersColumn},
+			Table:   tenant.NetworkDevicesTable,
+			Columns: []string{tenant.NetworkDevicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9220,15 +13296,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NetworkDevicesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.UsersTable,
-			Columns: []string{tenant.UsersColumn},
+			Table:   tenant.NetworkDevicesTable,
+			Columns: []string{tenant.NetworkDevicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9236,28 +13312,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AccountsCleared() {
+	if _u.mutation.NetworkBackupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AccountsTable,
-			Columns: []string{tenant.AccountsColumn},
+			Table:   tenant.NetworkBackupsTable,
+			Columns: []string{tenant.NetworkBackupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkbackup.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAccountsIDs(); len(nodes) > 0 && !_u.mutation.AccountsCleared() {
+	if nodes := _u.mutation.RemovedNetworkBackupsIDs(); len(nodes) > 0 && !_u.mutation.NetworkBackupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AccountsTable,
-			Columns: []string{tenant.AccountsColumn},
+			Table:   tenant.NetworkBackupsTable,
+			Columns: []string{tenant.NetworkBackupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkbackup.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9265,15 +13341,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AccountsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NetworkBackupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AccountsTable,
-			Columns: []string{tenant.AccountsColumn},
+			Table:   tenant.NetworkBackupsTable,
+			Columns: []string{tenant.NetworkBackupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkbackup.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9281,28 +13357,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TransactionsCleared() {
+	if _u.mutation.BudgetForecastsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TransactionsTable,
-			Columns: []string{tenant.TransactionsColumn},
+			Table:   tenant.BudgetForecastsTable,
+			Columns: []string{tenant.BudgetForecastsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(budgetforecast.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+	if nodes := _u.mutation.RemovedBudgetForecastsIDs(); len(nodes) > 0 && !_u.mutation.BudgetForecastsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TransactionsTable,
-			Columns: []string{tenant.TransactionsColumn},
+			Table:   tenant.BudgetForecastsTable,
+			Columns: []string{tenant.BudgetForecastsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(budgetforecast.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9310,15 +13386,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BudgetForecastsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TransactionsTable,
-			Columns: []string{tenant.TransactionsColumn},
+			Table:   tenant.BudgetForecastsTable,
+			Columns: []string{tenant.BudgetForecastsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(budgetforecast.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9326,28 +13402,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LedgerEntriesCleared() {
+	if _u.mutation.EmployeesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.LedgerEntriesTable,
-			Columns: []string{tenant.LedgerEntriesColumn},
+			Table:   tenant.EmployeesTable,
+			Columns: []string{tenant.EmployeesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLedgerEntriesIDs(); len(nodes) > 0 && !_u.mutation.LedgerEntriesCleared() {
+	if nodes := _u.mutation.RemovedEmployeesIDs(); len(nodes) > 0 && !_u.mutation.EmployeesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.LedgerEntriesTable,
-			Columns: []string{tenant.LedgerEntriesColumn},
+			Table:   tenant.EmployeesTable,
+			Columns: []string{tenant.EmployeesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9355,15 +13431,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LedgerEntriesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EmployeesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.LedgerEntriesTable,
-			Columns: []string{tenant.LedgerEntriesColumn},
+			Table:   tenant.EmployeesTable,
+			Columns: []string{tenant.EmployeesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9371,28 +13447,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ProductsCleared() {
+	if _u.mutation.CompensationAgreementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ProductsTable,
-			Columns: []string{tenant.ProductsColumn},
+			Table:   tenant.CompensationAgreementsTable,
+			Columns: []string{tenant.CompensationAgreementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(compensationagreement.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedProductsIDs(); len(nodes) > 0 && !_u.mutation.ProductsCleared() {
+	if nodes := _u.mutation.RemovedCompensationAgreementsIDs(); len(nodes) > 0 && !_u.mutation.CompensationAgreementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ProductsTable,
-			Columns: []string{tenant.ProductsColumn},
+			Table:   tenant.CompensationAgreementsTable,
+			Columns: []string{tenant.CompensationAgreementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(compensationagreement.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9400,15 +13476,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ProductsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CompensationAgreementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ProductsTable,
-			Columns: []string{tenant.ProductsColumn},
+			Table:   tenant.CompensationAgreementsTable,
+			Columns: []string{tenant.CompensationAgreementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(compensationagreement.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9416,28 +13492,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.StockMovementsCleared() {
+	if _u.mutation.VaultItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockMovementsTable,
-			Columns: []string{tenant.StockMovementsColumn},
+			Table:   tenant.VaultItemsTable,
+			Columns: []string{tenant.VaultItemsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaultitem.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
+	if nodes := _u.mutation.RemovedVaultItemsIDs(); len(nodes) > 0 && !_u.mutation.VaultItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockMovementsTable,
-			Columns: []string{tenant.StockMovementsColumn},
+			Table:   tenant.VaultItemsTable,
+			Columns: []string{tenant.VaultItemsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaultitem.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9445,15 +13521,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.VaultItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockMovementsTable,
-			Columns: []string{tenant.StockMovementsColumn},
+			Table:   tenant.VaultItemsTable,
+			Columns: []string{tenant.VaultItemsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaultitem.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9461,28 +13537,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AuditLogsCleared() {
+	if _u.mutation.VaultShareLinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AuditLogsTable,
-			Columns: []string{tenant.AuditLogsColumn},
+			Table:   tenant.VaultShareLinksTable,
+			Columns: []string{tenant.VaultShareLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaultsharelink.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.AuditLogsCleared() {
+	if nodes := _u.mutation.RemovedVaultShareLinksIDs(); len(nodes) > 0 && !_u.mutation.VaultShareLinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AuditLogsTable,
-			Columns: []string{tenant.AuditLogsColumn},
+			Table:   tenant.VaultShareLinksTable,
+			Columns: []string{tenant.VaultShareLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaultsharelink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9490,15 +13566,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AuditLogsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.VaultShareLinksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AuditLogsTable,
-			Columns: []string{tenant.AuditLogsColumn},
+			Table:   tenant.VaultShareLinksTable,
+			Columns: []string{tenant.VaultShareLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaultsharelink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9506,28 +13582,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AgentsCleared() {
+	if _u.mutation.JournalEntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AgentsTable,
-			Columns: []string{tenant.AgentsColumn},
+			Table:   tenant.JournalEntriesTable,
+			Columns: []string{tenant.JournalEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAgentsIDs(); len(nodes) > 0 && !_u.mutation.AgentsCleared() {
+	if nodes := _u.mutation.RemovedJournalEntriesIDs(); len(nodes) > 0 && !_u.mutation.JournalEntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AgentsTable,
-			Columns: []string{tenant.AgentsColumn},
+			Table:   tenant.JournalEntriesTable,
+			Columns: []string{tenant.JournalEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9535,15 +13611,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AgentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.JournalEntriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AgentsTable,
-			Columns: []string{tenant.AgentsColumn},
+			Table:   tenant.JournalEntriesTable,
+			Columns: []string{tenant.JournalEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(agent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9551,28 +13627,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DiscoveryEntriesCleared() {
+	if _u.mutation.RecurringInvoicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DiscoveryEntriesTable,
-			Columns: []string{tenant.DiscoveryEntriesColumn},
+			Table:   tenant.RecurringInvoicesTable,
+			Columns: []string{tenant.RecurringInvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoveryentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recurringinvoice.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedDiscoveryEntriesIDs(); len(nodes) > 0 && !_u.mutation.DiscoveryEntriesCleared() {
+	if nodes := _u.mutation.RemovedRecurringInvoicesIDs(); len(nodes) > 0 && !_u.mutation.RecurringInvoicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DiscoveryEntriesTable,
-			Columns: []string{tenant.DiscoveryEntriesColumn},
+			Table:   tenant.RecurringInvoicesTable,
+			Columns: []string{tenant.RecurringInvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoveryentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recurringinvoice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9580,15 +13656,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.DiscoveryEntriesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RecurringInvoicesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DiscoveryEntriesTable,
-			Columns: []string{tenant.DiscoveryEntriesColumn},
+			Table:   tenant.RecurringInvoicesTable,
+			Columns: []string{tenant.RecurringInvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discoveryentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recurringinvoice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9596,28 +13672,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssetsCleared() {
+	if _u.mutation.ExchangeRatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetsTable,
-			Columns: []string{tenant.AssetsColumn},
+			Table:   tenant.ExchangeRatesTable,
+			Columns: []string{tenant.ExchangeRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssetsIDs(); len(nodes) > 0 && !_u.mutation.AssetsCleared() {
+	if nodes := _u.mutation.RemovedExchangeRatesIDs(); len(nodes) > 0 && !_u.mutation.ExchangeRatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   tenant.AssetsTable,
-			Columns: []string{tenant.AssetsColumn},
+			Inverse: false,
+			Table:   tenant.ExchangeRatesTable,
+			Columns: []string{tenant.ExchangeRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9625,15 +13701,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssetsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ExchangeRatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetsTable,
-			Columns: []string{tenant.AssetsColumn},
+			Table:   tenant.ExchangeRatesTable,
+			Columns: []string{tenant.ExchangeRatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9641,28 +13717,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CredentialsCleared() {
+	if _u.mutation.FiscalPeriodsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CredentialsTable,
-			Columns: []string{tenant.CredentialsColumn},
+			Table:   tenant.FiscalPeriodsTable,
+			Columns: []string{tenant.FiscalPeriodsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCredentialsIDs(); len(nodes) > 0 && !_u.mutation.CredentialsCleared() {
+	if nodes := _u.mutation.RemovedFiscalPeriodsIDs(); len(nodes) > 0 && !_u.mutation.FiscalPeriodsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CredentialsTable,
-			Columns: []string{tenant.CredentialsColumn},
+			Table:   tenant.FiscalPeriodsTable,
+			Columns: []string{tenant.FiscalPeriodsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9670,15 +13746,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CredentialsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.FiscalPeriodsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CredentialsTable,
-			Columns: []string{tenant.CredentialsColumn},
+			Table:   tenant.FiscalPeriodsTable,
+			Columns: []string{tenant.FiscalPeriodsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(fiscalperiod.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9686,28 +13762,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.OneTimeLinksCleared() {
+	if _u.mutation.BalanceSnapshotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.OneTimeLinksTable,
-			Columns: []string{tenant.OneTimeLinksColumn},
+			Table:   tenant.BalanceSnapshotsTable,
+			Columns: []string{tenant.BalanceSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(onetimelink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedOneTimeLinksIDs(); len(nodes) > 0 && !_u.mutation.OneTimeLinksCleared() {
+	if nodes := _u.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.BalanceSnapshotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.OneTimeLinksTable,
-			Columns: []string{tenant.OneTimeLinksColumn},
+			Table:   tenant.BalanceSnapshotsTable,
+			Columns: []string{tenant.BalanceSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(onetimelink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9715,15 +13791,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.OneTimeLinksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.OneTimeLinksTable,
-			Columns: []string{tenant.OneTimeLinksColumn},
+			Table:   tenant.BalanceSnapshotsTable,
+			Columns: []string{tenant.BalanceSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(onetimelink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(accountbalancesnapshot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9731,28 +13807,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SopsCleared() {
+	if _u.mutation.BankStatementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SopsTable,
-			Columns: []string{tenant.SopsColumn},
+			Table:   tenant.BankStatementsTable,
+			Columns: []string{tenant.BankStatementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(sop.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSopsIDs(); len(nodes) > 0 && !_u.mutation.SopsCleared() {
+	if nodes := _u.mutation.RemovedBankStatementsIDs(); len(nodes) > 0 && !_u.mutation.BankStatementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SopsTable,
-			Columns: []string{tenant.SopsColumn},
+			Table:   tenant.BankStatementsTable,
+			Columns: []string{tenant.BankStatementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(sop.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9760,15 +13836,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SopsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BankStatementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SopsTable,
-			Columns: []string{tenant.SopsColumn},
+			Table:   tenant.BankStatementsTable,
+			Columns: []string{tenant.BankStatementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(sop.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9776,28 +13852,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CamerasCleared() {
+	if _u.mutation.BankStatementLinesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CamerasTable,
-			Columns: []string{tenant.CamerasColumn},
+			Table:   tenant.BankStatementLinesTable,
+			Columns: []string{tenant.BankStatementLinesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(camera.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCamerasIDs(); len(nodes) > 0 && !_u.mutation.CamerasCleared() {
+	if nodes := _u.mutation.RemovedBankStatementLinesIDs(); len(nodes) > 0 && !_u.mutation.BankStatementLinesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CamerasTable,
-			Columns: []string{tenant.CamerasColumn},
+			Table:   tenant.BankStatementLinesTable,
+			Columns: []string{tenant.BankStatementLinesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(camera.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9805,15 +13881,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CamerasIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BankStatementLinesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CamerasTable,
-			Columns: []string{tenant.CamerasColumn},
+			Table:   tenant.BankStatementLinesTable,
+			Columns: []string{tenant.BankStatementLinesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(camera.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankstatementline.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9821,28 +13897,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TicketsCleared() {
+	if _u.mutation.BankRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TicketsTable,
-			Columns: []string{tenant.TicketsColumn},
+			Table:   tenant.BankRulesTable,
+			Columns: []string{tenant.BankRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTicketsIDs(); len(nodes) > 0 && !_u.mutation.TicketsCleared() {
+	if nodes := _u.mutation.RemovedBankRulesIDs(); len(nodes) > 0 && !_u.mutation.BankRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TicketsTable,
-			Columns: []string{tenant.TicketsColumn},
+			Table:   tenant.BankRulesTable,
+			Columns: []string{tenant.BankRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9850,15 +13926,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TicketsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BankRulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TicketsTable,
-			Columns: []string{tenant.TicketsColumn},
+			Table:   tenant.BankRulesTable,
+			Columns: []string{tenant.BankRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bankrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9866,28 +13942,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ContractsCleared() {
+	if _u.mutation.CustomersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContractsTable,
-			Columns: []string{tenant.ContractsColumn},
+			Table:   tenant.CustomersTable,
+			Columns: []string{tenant.CustomersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contract.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedContractsIDs(); len(nodes) > 0 && !_u.mutation.ContractsCleared() {
+	if nodes := _u.mutation.RemovedCustomersIDs(); len(nodes) > 0 && !_u.mutation.CustomersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContractsTable,
-			Columns: []string{tenant.ContractsColumn},
+			Table:   tenant.CustomersTable,
+			Columns: []string{tenant.CustomersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contract.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9895,15 +13971,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ContractsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CustomersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContractsTable,
-			Columns: []string{tenant.ContractsColumn},
+			Table:   tenant.CustomersTable,
+			Columns: []string{tenant.CustomersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contract.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9911,28 +13987,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SaasAppsCleared() {
+	if _u.mutation.InvoicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasAppsTable,
-			Columns: []string{tenant.SaasAppsColumn},
+			Table:   tenant.InvoicesTable,
+			Columns: []string{tenant.InvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasapp.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSaasAppsIDs(); len(nodes) > 0 && !_u.mutation.SaasAppsCleared() {
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasAppsTable,
-			Columns: []string{tenant.SaasAppsColumn},
+			Table:   tenant.InvoicesTable,
+			Columns: []string{tenant.InvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasapp.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9940,15 +14016,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SaasAppsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasAppsTable,
-			Columns: []string{tenant.SaasAppsColumn},
+			Table:   tenant.InvoicesTable,
+			Columns: []string{tenant.InvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasapp.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9956,28 +14032,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SaasFiltersCleared() {
+	if _u.mutation.CustomerPaymentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasFiltersTable,
-			Columns: []string{tenant.SaasFiltersColumn},
+			Table:   tenant.CustomerPaymentsTable,
+			Columns: []string{tenant.CustomerPaymentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasfilter.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSaasFiltersIDs(); len(nodes) > 0 && !_u.mutation.SaasFiltersCleared() {
+	if nodes := _u.mutation.RemovedCustomerPaymentsIDs(); len(nodes) > 0 && !_u.mutation.CustomerPaymentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasFiltersTable,
-			Columns: []string{tenant.SaasFiltersColumn},
+			Table:   tenant.CustomerPaymentsTable,
+			Columns: []string{tenant.CustomerPaymentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasfilter.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9985,15 +14061,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SaasFiltersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CustomerPaymentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasFiltersTable,
-			Columns: []string{tenant.SaasFiltersColumn},
+			Table:   tenant.CustomerPaymentsTable,
+			Columns: []string{tenant.CustomerPaymentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasfilter.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(customerpayment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10001,28 +14077,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CallLogsCleared() {
+	if _u.mutation.SupplierBillsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CallLogsTable,
-			Columns: []string{tenant.CallLogsColumn},
+			Table:   tenant.SupplierBillsTable,
+			Columns: []string{tenant.SupplierBillsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCallLogsIDs(); len(nodes) > 0 && !_u.mutation.CallLogsCleared() {
+	if nodes := _u.mutation.RemovedSupplierBillsIDs(); len(nodes) > 0 && !_u.mutation.SupplierBillsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CallLogsTable,
-			Columns: []string{tenant.CallLogsColumn},
+			Table:   tenant.SupplierBillsTable,
+			Columns: []string{tenant.SupplierBillsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10030,15 +14106,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CallLogsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SupplierBillsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CallLogsTable,
-			Columns: []string{tenant.CallLogsColumn},
+			Table:   tenant.SupplierBillsTable,
+			Columns: []string{tenant.SupplierBillsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplierbill.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10046,28 +14122,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.IvrFlowsCleared() {
+	if _u.mutation.PaymentRunsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.IvrFlowsTable,
-			Columns: []string{tenant.IvrFlowsColumn},
+			Table:   tenant.PaymentRunsTable,
+			Columns: []string{tenant.PaymentRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ivrflow.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedIvrFlowsIDs(); len(nodes) > 0 && !_u.mutation.IvrFlowsCleared() {
+	if nodes := _u.mutation.RemovedPaymentRunsIDs(); len(nodes) > 0 && !_u.mutation.PaymentRunsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.IvrFlowsTable,
-			Columns: []string{tenant.IvrFlowsColumn},
+			Table:   tenant.PaymentRunsTable,
+			Columns: []string{tenant.PaymentRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ivrflow.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10075,15 +14151,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.IvrFlowsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PaymentRunsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.IvrFlowsTable,
-			Columns: []string{tenant.IvrFlowsColumn},
+			Table:   tenant.PaymentRunsTable,
+			Columns: []string{tenant.PaymentRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(ivrflow.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10091,28 +14167,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.VoicemailsCleared() {
+	if _u.mutation.GoodsReceiptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VoicemailsTable,
-			Columns: []string{tenant.VoicemailsColumn},
+			Table:   tenant.GoodsReceiptsTable,
+			Columns: []string{tenant.GoodsReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(voicemail.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedVoicemailsIDs(); len(nodes) > 0 && !_u.mutation.VoicemailsCleared() {
+	if nodes := _u.mutation.RemovedGoodsReceiptsIDs(); len(nodes) > 0 && !_u.mutation.GoodsReceiptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VoicemailsTable,
-			Columns: []string{tenant.VoicemailsColumn},
+			Table:   tenant.GoodsReceiptsTable,
+			Columns: []string{tenant.GoodsReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(voicemail.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10120,15 +14196,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.VoicemailsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.GoodsReceiptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VoicemailsTable,
-			Columns: []string{tenant.VoicemailsColumn},
+			Table:   tenant.GoodsReceiptsTable,
+			Columns: []string{tenant.GoodsReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(voicemail.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10136,28 +14212,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.HealthSnapshotsCleared() {
+	if _u.mutation.StockLevelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.HealthSnapshotsTable,
-			Columns: []string{tenant.HealthSnapshotsColumn},
+			Table:   tenant.StockLevelsTable,
+			Columns: []string{tenant.StockLevelsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(healthscoresnapshot.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedHealthSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.HealthSnapshotsCleared() {
+	if nodes := _u.mutation.RemovedStockLevelsIDs(); len(nodes) > 0 && !_u.mutation.StockLevelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.HealthSnapshotsTable,
-			Columns: []string{tenant.HealthSnapshotsColumn},
+			Table:   tenant.StockLevelsTable,
+			Columns: []string{tenant.StockLevelsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(healthscoresnapshot.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10165,15 +14241,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.HealthSnapshotsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.StockLevelsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.HealthSnapshotsTable,
-			Columns: []string{tenant.HealthSnapshotsColumn},
+			Table:   tenant.StockLevelsTable,
+			Columns: []string{tenant.StockLevelsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(healthscoresnapshot.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10181,28 +14257,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RoadmapsCleared() {
+	if _u.mutation.TransferOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RoadmapsTable,
-			Columns: []string{tenant.RoadmapsColumn},
+			Table:   tenant.TransferOrdersTable,
+			Columns: []string{tenant.TransferOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(strategicroadmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRoadmapsIDs(); len(nodes) > 0 && !_u.mutation.RoadmapsCleared() {
+	if nodes := _u.mutation.RemovedTransferOrdersIDs(); len(nodes) > 0 && !_u.mutation.TransferOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RoadmapsTable,
-			Columns: []string{tenant.RoadmapsColumn},
+			Table:   tenant.TransferOrdersTable,
+			Columns: []string{tenant.TransferOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(strategicroadmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10210,15 +14286,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RoadmapsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TransferOrdersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RoadmapsTable,
-			Columns: []string{tenant.RoadmapsColumn},
+			Table:   tenant.TransferOrdersTable,
+			Columns: []string{tenant.TransferOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(strategicroadmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10226,28 +14302,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ServiceRatesCleared() {
+	if _u.mutation.InventoryReservationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ServiceRatesTable,
-			Columns: []string{tenant.ServiceRatesColumn},
+			Table:   tenant.InventoryReservationsTable,
+			Columns: []string{tenant.InventoryReservationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(servicerate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedServiceRatesIDs(); len(nodes) > 0 && !_u.mutation.ServiceRatesCleared() {
+	if nodes := _u.mutation.RemovedInventoryReservationsIDs(); len(nodes) > 0 && !_u.mutation.InventoryReservationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ServiceRatesTable,
-			Columns: []string{tenant.ServiceRatesColumn},
+			Table:   tenant.InventoryReservationsTable,
+			Columns: []string{tenant.InventoryReservationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(servicerate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10255,15 +14331,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ServiceRatesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.InventoryReservationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ServiceRatesTable,
-			Columns: []string{tenant.ServiceRatesColumn},
+			Table:   tenant.InventoryReservationsTable,
+			Columns: []string{tenant.InventoryReservationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(servicerate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10271,28 +14347,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NetworkDevicesCleared() {
+	if _u.mutation.DepartmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkDevicesTable,
-			Columns: []string{tenant.NetworkDevicesColumn},
+			Table:   tenant.DepartmentsTable,
+			Columns: []string{tenant.DepartmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNetworkDevicesIDs(); len(nodes) > 0 && !_u.mutation.NetworkDevicesCleared() {
+	if nodes := _u.mutation.RemovedDepartmentsIDs(); len(nodes) > 0 && !_u.mutation.DepartmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkDevicesTable,
-			Columns: []string{tenant.NetworkDevicesColumn},
+			Table:   tenant.DepartmentsTable,
+			Columns: []string{tenant.DepartmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10300,15 +14376,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NetworkDevicesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DepartmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkDevicesTable,
-			Columns: []string{tenant.NetworkDevicesColumn},
+			Table:   tenant.DepartmentsTable,
+			Columns: []string{tenant.DepartmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10316,28 +14392,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NetworkBackupsCleared() {
+	if _u.mutation.PermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkBackupsTable,
-			Columns: []string{tenant.NetworkBackupsColumn},
+			Table:   tenant.PermissionsTable,
+			Columns: []string{tenant.PermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkbackup.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNetworkBackupsIDs(); len(nodes) > 0 && !_u.mutation.NetworkBackupsCleared() {
+	if nodes := _u.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !_u.mutation.PermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkBackupsTable,
-			Columns: []string{tenant.NetworkBackupsColumn},
+			Table:   tenant.PermissionsTable,
+			Columns: []string{tenant.PermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkbackup.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10345,15 +14421,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NetworkBackupsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkBackupsTable,
-			Columns: []string{tenant.NetworkBackupsColumn},
+			Table:   tenant.PermissionsTable,
+			Columns: []string{tenant.PermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkbackup.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10361,28 +14437,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BudgetForecastsCleared() {
+	if _u.mutation.AssetTypesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BudgetForecastsTable,
-			Columns: []string{tenant.BudgetForecastsColumn},
+			Table:   tenant.AssetTypesTable,
+			Columns: []string{tenant.AssetTypesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(budgetforecast.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBudgetForecastsIDs(); len(nodes) > 0 && !_u.mutation.BudgetForecastsCleared() {
+	if nodes := _u.mutation.RemovedAssetTypesIDs(); len(nodes) > 0 && !_u.mutation.AssetTypesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BudgetForecastsTable,
-			Columns: []string{tenant.BudgetForecastsColumn},
+			Table:   tenant.AssetTypesTable,
+			Columns: []string{tenant.AssetTypesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(budgetforecast.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10390,15 +14466,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BudgetForecastsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AssetTypesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BudgetForecastsTable,
-			Columns: []string{tenant.BudgetForecastsColumn},
+			Table:   tenant.AssetTypesTable,
+			Columns: []string{tenant.AssetTypesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(budgetforecast.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10406,28 +14482,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EmployeesCleared() {
+	if _u.mutation.DetectionEventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.EmployeesTable,
-			Columns: []string{tenant.EmployeesColumn},
+			Table:   tenant.DetectionEventsTable,
+			Columns: []string{tenant.DetectionEventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEmployeesIDs(); len(nodes) > 0 && !_u.mutation.EmployeesCleared() {
+	if nodes := _u.mutation.RemovedDetectionEventsIDs(); len(nodes) > 0 && !_u.mutation.DetectionEventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.EmployeesTable,
-			Columns: []string{tenant.EmployeesColumn},
+			Table:   tenant.DetectionEventsTable,
+			Columns: []string{tenant.DetectionEventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10435,15 +14511,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EmployeesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DetectionEventsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.EmployeesTable,
-			Columns: []string{tenant.EmployeesColumn},
+			Table:   tenant.DetectionEventsTable,
+			Columns: []string{tenant.DetectionEventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10451,28 +14527,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CompensationAgreementsCleared() {
+	if _u.mutation.SaasIdentitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CompensationAgreementsTable,
-			Columns: []string{tenant.CompensationAgreementsColumn},
+			Table:   tenant.SaasIdentitiesTable,
+			Columns: []string{tenant.SaasIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(compensationagreement.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCompensationAgreementsIDs(); len(nodes) > 0 && !_u.mutation.CompensationAgreementsCleared() {
+	if nodes := _u.mutation.RemovedSaasIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.SaasIdentitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CompensationAgreementsTable,
-			Columns: []string{tenant.CompensationAgreementsColumn},
+			Table:   tenant.SaasIdentitiesTable,
+			Columns: []string{tenant.SaasIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(compensationagreement.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10480,15 +14556,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CompensationAgreementsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SaasIdentitiesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CompensationAgreementsTable,
-			Columns: []string{tenant.CompensationAgreementsColumn},
+			Table:   tenant.SaasIdentitiesTable,
+			Columns: []string{tenant.SaasIdentitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(compensationagreement.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10496,28 +14572,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.VaultItemsCleared() {
+	if _u.mutation.SaasUsagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultItemsTable,
-			Columns: []string{tenant.VaultItemsColumn},
+			Table:   tenant.SaasUsagesTable,
+			Columns: []string{tenant.SaasUsagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaultitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedVaultItemsIDs(); len(nodes) > 0 && !_u.mutation.VaultItemsCleared() {
+	if nodes := _u.mutation.RemovedSaasUsagesIDs(); len(nodes) > 0 && !_u.mutation.SaasUsagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultItemsTable,
-			Columns: []string{tenant.VaultItemsColumn},
+			Table:   tenant.SaasUsagesTable,
+			Columns: []string{tenant.SaasUsagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaultitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10525,15 +14601,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.VaultItemsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SaasUsagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultItemsTable,
-			Columns: []string{tenant.VaultItemsColumn},
+			Table:   tenant.SaasUsagesTable,
+			Columns: []string{tenant.SaasUsagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaultitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10541,28 +14617,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.VaultShareLinksCleared() {
+	if _u.mutation.RecordingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultShareLinksTable,
-			Columns: []string{tenant.VaultShareLinksColumn},
+			Table:   tenant.RecordingsTable,
+			Columns: []string{tenant.RecordingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaultsharelink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedVaultShareLinksIDs(); len(nodes) > 0 && !_u.mutation.VaultShareLinksCleared() {
+	if nodes := _u.mutation.RemovedRecordingsIDs(); len(nodes) > 0 && !_u.mutation.RecordingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultShareLinksTable,
-			Columns: []string{tenant.VaultShareLinksColumn},
+			Table:   tenant.RecordingsTable,
+			Columns: []string{tenant.RecordingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaultsharelink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10570,15 +14646,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.VaultShareLinksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RecordingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultShareLinksTable,
-			Columns: []string{tenant.VaultShareLinksColumn},
+			Table:   tenant.RecordingsTable,
+			Columns: []string{tenant.RecordingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaultsharelink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10586,28 +14662,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.JournalEntriesCleared() {
+	if _u.mutation.NetworkLinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JournalEntriesTable,
-			Columns: []string{tenant.JournalEntriesColumn},
+			Table:   tenant.NetworkLinksTable,
+			Columns: []string{tenant.NetworkLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedJournalEntriesIDs(); len(nodes) > 0 && !_u.mutation.JournalEntriesCleared() {
+	if nodes := _u.mutation.RemovedNetworkLinksIDs(); len(nodes) > 0 && !_u.mutation.NetworkLinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JournalEntriesTable,
-			Columns: []string{tenant.JournalEntriesColumn},
+			Table:   tenant.NetworkLinksTable,
+			Columns: []string{tenant.NetworkLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10615,15 +14691,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.JournalEntriesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NetworkLinksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JournalEntriesTable,
-			Columns: []string{tenant.JournalEntriesColumn},
+			Table:   tenant.NetworkLinksTable,
+			Columns: []string{tenant.NetworkLinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10631,28 +14707,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RecurringInvoicesCleared() {
+	if _u.mutation.NetworkPortsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecurringInvoicesTable,
-			Columns: []string{tenant.RecurringInvoicesColumn},
+			Table:   tenant.NetworkPortsTable,
+			Columns: []string{tenant.NetworkPortsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recurringinvoice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRecurringInvoicesIDs(); len(nodes) > 0 && !_u.mutation.RecurringInvoicesCleared() {
+	if nodes := _u.mutation.RemovedNetworkPortsIDs(); len(nodes) > 0 && !_u.mutation.NetworkPortsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecurringInvoicesTable,
-			Columns: []string{tenant.RecurringInvoicesColumn},
+			Table:   tenant.NetworkPortsTable,
+			Columns: []string{tenant.NetworkPortsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recurringinvoice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10660,15 +14736,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RecurringInvoicesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NetworkPortsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecurringInvoicesTable,
-			Columns: []string{tenant.RecurringInvoicesColumn},
+			Table:   tenant.NetworkPortsTable,
+			Columns: []string{tenant.NetworkPortsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recurringinvoice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10676,28 +14752,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InventoryReservationsCleared() {
+	if _u.mutation.NexusAuditsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryReservationsTable,
-			Columns: []string{tenant.InventoryReservationsColumn},
+			Table:   tenant.NexusAuditsTable,
+			Columns: []string{tenant.NexusAuditsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInventoryReservationsIDs(); len(nodes) > 0 && !_u.mutation.InventoryReservationsCleared() {
+	if nodes := _u.mutation.RemovedNexusAuditsIDs(); len(nodes) > 0 && !_u.mutation.NexusAuditsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryReservationsTable,
-			Columns: []string{tenant.InventoryReservationsColumn},
+			Table:   tenant.NexusAuditsTable,
+			Columns: []string{tenant.NexusAuditsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10705,15 +14781,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InventoryReservationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NexusAuditsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryReservationsTable,
-			Columns: []string{tenant.InventoryReservationsColumn},
+			Table:   tenant.NexusAuditsTable,
+			Columns: []string{tenant.NexusAuditsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10721,28 +14797,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DepartmentsCleared() {
+	if _u.mutation.SuccessionMapsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DepartmentsTable,
-			Columns: []string{tenant.DepartmentsColumn},
+			Table:   tenant.SuccessionMapsTable,
+			Columns: []string{tenant.SuccessionMapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedDepartmentsIDs(); len(nodes) > 0 && !_u.mutation.DepartmentsCleared() {
+	if nodes := _u.mutation.RemovedSuccessionMapsIDs(); len(nodes) > 0 && !_u.mutation.SuccessionMapsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DepartmentsTable,
-			Columns: []string{tenant.DepartmentsColumn},
+			Table:   tenant.SuccessionMapsTable,
+			Columns: []string{tenant.SuccessionMapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10750,15 +14826,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.DepartmentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SuccessionMapsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DepartmentsTable,
-			Columns: []string{tenant.DepartmentsColumn},
+			Table:   tenant.SuccessionMapsTable,
+			Columns: []string{tenant.SuccessionMapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10766,44 +14842,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PermissionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   tenant.PermissionsTable,
-			Columns: []string{tenant.PermissionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !_u.mutation.PermissionsCleared() {
+	if _u.mutation.CustomerAccountCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   tenant.PermissionsTable,
-			Columns: []string{tenant.PermissionsColumn},
+			Table:   tenant.CustomerAccountTable,
+			Columns: []string{tenant.CustomerAccountColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PermissionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CustomerAccountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   tenant.PermissionsTable,
-			Columns: []string{tenant.PermissionsColumn},
+			Table:   tenant.CustomerAccountTable,
+			Columns: []string{tenant.CustomerAccountColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(permission.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10811,28 +14871,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssetTypesCleared() {
+	if _u.mutation.ScriptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetTypesTable,
-			Columns: []string{tenant.AssetTypesColumn},
+			Table:   tenant.ScriptsTable,
+			Columns: []string{tenant.ScriptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssetTypesIDs(); len(nodes) > 0 && !_u.mutation.AssetTypesCleared() {
+	if nodes := _u.mutation.RemovedScriptsIDs(); len(nodes) > 0 && !_u.mutation.ScriptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetTypesTable,
-			Columns: []string{tenant.AssetTypesColumn},
+			Table:   tenant.ScriptsTable,
+			Columns: []string{tenant.ScriptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10840,15 +14900,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssetTypesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ScriptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetTypesTable,
-			Columns: []string{tenant.AssetTypesColumn},
+			Table:   tenant.ScriptsTable,
+			Columns: []string{tenant.ScriptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assettype.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10856,28 +14916,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DetectionEventsCleared() {
+	if _u.mutation.JobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DetectionEventsTable,
-			Columns: []string{tenant.DetectionEventsColumn},
+			Table:   tenant.JobsTable,
+			Columns: []string{tenant.JobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedDetectionEventsIDs(); len(nodes) > 0 && !_u.mutation.DetectionEventsCleared() {
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DetectionEventsTable,
-			Columns: []string{tenant.DetectionEventsColumn},
+			Table:   tenant.JobsTable,
+			Columns: []string{tenant.JobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10885,15 +14945,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.DetectionEventsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DetectionEventsTable,
-			Columns: []string{tenant.DetectionEventsColumn},
+			Table:   tenant.JobsTable,
+			Columns: []string{tenant.JobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(detectionevent.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10901,28 +14961,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SaasIdentitiesCleared() {
+	if _u.mutation.TimeOffRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasIdentitiesTable,
-			Columns: []string{tenant.SaasIdentitiesColumn},
+			Table:   tenant.TimeOffRequestsTable,
+			Columns: []string{tenant.TimeOffRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSaasIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.SaasIdentitiesCleared() {
+	if nodes := _u.mutation.RemovedTimeOffRequestsIDs(); len(nodes) > 0 && !_u.mutation.TimeOffRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasIdentitiesTable,
-			Columns: []string{tenant.SaasIdentitiesColumn},
+			Table:   tenant.TimeOffRequestsTable,
+			Columns: []string{tenant.TimeOffRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10930,15 +14990,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SaasIdentitiesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TimeOffRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasIdentitiesTable,
-			Columns: []string{tenant.SaasIdentitiesColumn},
+			Table:   tenant.TimeOffRequestsTable,
+			Columns: []string{tenant.TimeOffRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasidentity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10946,28 +15006,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SaasUsagesCleared() {
+	if _u.mutation.TimeOffPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasUsagesTable,
-			Columns: []string{tenant.SaasUsagesColumn},
+			Table:   tenant.TimeOffPoliciesTable,
+			Columns: []string{tenant.TimeOffPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSaasUsagesIDs(); len(nodes) > 0 && !_u.mutation.SaasUsagesCleared() {
+	if nodes := _u.mutation.RemovedTimeOffPoliciesIDs(); len(nodes) > 0 && !_u.mutation.TimeOffPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasUsagesTable,
-			Columns: []string{tenant.SaasUsagesColumn},
+			Table:   tenant.TimeOffPoliciesTable,
+			Columns: []string{tenant.TimeOffPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10975,15 +15035,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SaasUsagesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TimeOffPoliciesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SaasUsagesTable,
-			Columns: []string{tenant.SaasUsagesColumn},
+			Table:   tenant.TimeOffPoliciesTable,
+			Columns: []string{tenant.TimeOffPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(saasusage.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -10991,28 +15051,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RecordingsCleared() {
+	if _u.mutation.TimeOffBalancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecordingsTable,
-			Columns: []string{tenant.RecordingsColumn},
+			Table:   tenant.TimeOffBalancesTable,
+			Columns: []string{tenant.TimeOffBalancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRecordingsIDs(); len(nodes) > 0 && !_u.mutation.RecordingsCleared() {
+	if nodes := _u.mutation.RemovedTimeOffBalancesIDs(); len(nodes) > 0 && !_u.mutation.TimeOffBalancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecordingsTable,
-			Columns: []string{tenant.RecordingsColumn},
+			Table:   tenant.TimeOffBalancesTable,
+			Columns: []string{tenant.TimeOffBalancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11020,15 +15080,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RecordingsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TimeOffBalancesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RecordingsTable,
-			Columns: []string{tenant.RecordingsColumn},
+			Table:   tenant.TimeOffBalancesTable,
+			Columns: []string{tenant.TimeOffBalancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(recording.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11036,28 +15096,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NetworkLinksCleared() {
+	if _u.mutation.ReviewCyclesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkLinksTable,
-			Columns: []string{tenant.NetworkLinksColumn},
+			Table:   tenant.ReviewCyclesTable,
+			Columns: []string{tenant.ReviewCyclesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNetworkLinksIDs(); len(nodes) > 0 && !_u.mutation.NetworkLinksCleared() {
+	if nodes := _u.mutation.RemovedReviewCyclesIDs(); len(nodes) > 0 && !_u.mutation.ReviewCyclesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkLinksTable,
-			Columns: []string{tenant.NetworkLinksColumn},
+			Table:   tenant.ReviewCyclesTable,
+			Columns: []string{tenant.ReviewCyclesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11065,15 +15125,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NetworkLinksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReviewCyclesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkLinksTable,
-			Columns: []string{tenant.NetworkLinksColumn},
+			Table:   tenant.ReviewCyclesTable,
+			Columns: []string{tenant.ReviewCyclesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networklink.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11081,28 +15141,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NetworkPortsCleared() {
+	if _u.mutation.PerformanceReviewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkPortsTable,
-			Columns: []string{tenant.NetworkPortsColumn},
+			Table:   tenant.PerformanceReviewsTable,
+			Columns: []string{tenant.PerformanceReviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNetworkPortsIDs(); len(nodes) > 0 && !_u.mutation.NetworkPortsCleared() {
+	if nodes := _u.mutation.RemovedPerformanceReviewsIDs(); len(nodes) > 0 && !_u.mutation.PerformanceReviewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkPortsTable,
-			Columns: []string{tenant.NetworkPortsColumn},
+			Table:   tenant.PerformanceReviewsTable,
+			Columns: []string{tenant.PerformanceReviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11110,15 +15170,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NetworkPortsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PerformanceReviewsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NetworkPortsTable,
-			Columns: []string{tenant.NetworkPortsColumn},
+			Table:   tenant.PerformanceReviewsTable,
+			Columns: []string{tenant.PerformanceReviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(networkport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11126,28 +15186,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NexusAuditsCleared() {
+	if _u.mutation.GoalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NexusAuditsTable,
-			Columns: []string{tenant.NexusAuditsColumn},
+			Table:   tenant.GoalsTable,
+			Columns: []string{tenant.GoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNexusAuditsIDs(); len(nodes) > 0 && !_u.mutation.NexusAuditsCleared() {
+	if nodes := _u.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !_u.mutation.GoalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NexusAuditsTable,
-			Columns: []string{tenant.NexusAuditsColumn},
+			Table:   tenant.GoalsTable,
+			Columns: []string{tenant.GoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11155,15 +15215,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NexusAuditsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.GoalsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.NexusAuditsTable,
-			Columns: []string{tenant.NexusAuditsColumn},
+			Table:   tenant.GoalsTable,
+			Columns: []string{tenant.GoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(nexusaudit.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11171,28 +15231,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SuccessionMapsCleared() {
+	if _u.mutation.SuppliersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuccessionMapsTable,
-			Columns: []string{tenant.SuccessionMapsColumn},
+			Table:   tenant.SuppliersTable,
+			Columns: []string{tenant.SuppliersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSuccessionMapsIDs(); len(nodes) > 0 && !_u.mutation.SuccessionMapsCleared() {
+	if nodes := _u.mutation.RemovedSuppliersIDs(); len(nodes) > 0 && !_u.mutation.SuppliersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuccessionMapsTable,
-			Columns: []string{tenant.SuccessionMapsColumn},
+			Table:   tenant.SuppliersTable,
+			Columns: []string{tenant.SuppliersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11200,15 +15260,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SuccessionMapsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SuppliersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuccessionMapsTable,
-			Columns: []string{tenant.SuccessionMapsColumn},
+			Table:   tenant.SuppliersTable,
+			Columns: []string{tenant.SuppliersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(successionmap.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11216,28 +15276,44 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CustomerAccountCleared() {
+	if _u.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CustomerAccountTable,
-			Columns: []string{tenant.CustomerAccountColumn},
+			Table:   tenant.CategoriesTable,
+			Columns: []string{tenant.CategoriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CustomerAccountIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CustomerAccountTable,
-			Columns: []string{tenant.CustomerAccountColumn},
+			Table:   tenant.CategoriesTable,
+			Columns: []string{tenant.CategoriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CategoriesTable,
+			Columns: []string{tenant.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11245,28 +15321,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ScriptsCleared() {
+	if _u.mutation.WarehousesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScriptsTable,
-			Columns: []string{tenant.ScriptsColumn},
+			Table:   tenant.WarehousesTable,
+			Columns: []string{tenant.WarehousesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedScriptsIDs(); len(nodes) > 0 && !_u.mutation.ScriptsCleared() {
+	if nodes := _u.mutation.RemovedWarehousesIDs(); len(nodes) > 0 && !_u.mutation.WarehousesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScriptsTable,
-			Columns: []string{tenant.ScriptsColumn},
+			Table:   tenant.WarehousesTable,
+			Columns: []string{tenant.WarehousesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11274,15 +15350,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ScriptsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WarehousesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScriptsTable,
-			Columns: []string{tenant.ScriptsColumn},
+			Table:   tenant.WarehousesTable,
+			Columns: []string{tenant.WarehousesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(script.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11290,28 +15366,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.JobsCleared() {
+	if _u.mutation.AssetAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobsTable,
-			Columns: []string{tenant.JobsColumn},
+			Table:   tenant.AssetAssignmentsTable,
+			Columns: []string{tenant.AssetAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+	if nodes := _u.mutation.RemovedAssetAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssetAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobsTable,
-			Columns: []string{tenant.JobsColumn},
+			Table:   tenant.AssetAssignmentsTable,
+			Columns: []string{tenant.AssetAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11319,15 +15395,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AssetAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobsTable,
-			Columns: []string{tenant.JobsColumn},
+			Table:   tenant.AssetAssignmentsTable,
+			Columns: []string{tenant.AssetAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11335,28 +15411,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TimeOffRequestsCleared() {
+	if _u.mutation.ContactsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffRequestsTable,
-			Columns: []string{tenant.TimeOffRequestsColumn},
+			Table:   tenant.ContactsTable,
+			Columns: []string{tenant.ContactsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTimeOffRequestsIDs(); len(nodes) > 0 && !_u.mutation.TimeOffRequestsCleared() {
+	if nodes := _u.mutation.RemovedContactsIDs(); len(nodes) > 0 && !_u.mutation.ContactsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffRequestsTable,
-			Columns: []string{tenant.TimeOffRequestsColumn},
+			Table:   tenant.ContactsTable,
+			Columns: []string{tenant.ContactsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11364,15 +15440,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TimeOffRequestsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ContactsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffRequestsTable,
-			Columns: []string{tenant.TimeOffRequestsColumn},
+			Table:   tenant.ContactsTable,
+			Columns: []string{tenant.ContactsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffrequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11380,28 +15456,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TimeOffPoliciesCleared() {
+	if _u.mutation.LegalHoldsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffPoliciesTable,
-			Columns: []string{tenant.TimeOffPoliciesColumn},
+			Table:   tenant.LegalHoldsTable,
+			Columns: []string{tenant.LegalHoldsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTimeOffPoliciesIDs(); len(nodes) > 0 && !_u.mutation.TimeOffPoliciesCleared() {
+	if nodes := _u.mutation.RemovedLegalHoldsIDs(); len(nodes) > 0 && !_u.mutation.LegalHoldsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffPoliciesTable,
-			Columns: []string{tenant.TimeOffPoliciesColumn},
+			Table:   tenant.LegalHoldsTable,
+			Columns: []string{tenant.LegalHoldsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11409,15 +15485,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TimeOffPoliciesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LegalHoldsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffPoliciesTable,
-			Columns: []string{tenant.TimeOffPoliciesColumn},
+			Table:   tenant.LegalHoldsTable,
+			Columns: []string{tenant.LegalHoldsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11425,28 +15501,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TimeOffBalancesCleared() {
+	if _u.mutation.RetentionPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffBalancesTable,
-			Columns: []string{tenant.TimeOffBalancesColumn},
+			Table:   tenant.RetentionPoliciesTable,
+			Columns: []string{tenant.RetentionPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTimeOffBalancesIDs(); len(nodes) > 0 && !_u.mutation.TimeOffBalancesCleared() {
+	if nodes := _u.mutation.RemovedRetentionPoliciesIDs(); len(nodes) > 0 && !_u.mutation.RetentionPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffBalancesTable,
-			Columns: []string{tenant.TimeOffBalancesColumn},
+			Table:   tenant.RetentionPoliciesTable,
+			Columns: []string{tenant.RetentionPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11454,15 +15530,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TimeOffBalancesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RetentionPoliciesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.TimeOffBalancesTable,
-			Columns: []string{tenant.TimeOffBalancesColumn},
+			Table:   tenant.RetentionPoliciesTable,
+			Columns: []string{tenant.RetentionPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(timeoffbalance.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11470,28 +15546,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReviewCyclesCleared() {
+	if _u.mutation.VaultTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ReviewCyclesTable,
-			Columns: []string{tenant.ReviewCyclesColumn},
+			Table:   tenant.VaultTemplatesTable,
+			Columns: []string{tenant.VaultTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReviewCyclesIDs(); len(nodes) > 0 && !_u.mutation.ReviewCyclesCleared() {
+	if nodes := _u.mutation.RemovedVaultTemplatesIDs(); len(nodes) > 0 && !_u.mutation.VaultTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ReviewCyclesTable,
-			Columns: []string{tenant.ReviewCyclesColumn},
+			Table:   tenant.VaultTemplatesTable,
+			Columns: []string{tenant.VaultTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11499,15 +15575,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReviewCyclesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.VaultTemplatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ReviewCyclesTable,
-			Columns: []string{tenant.ReviewCyclesColumn},
+			Table:   tenant.VaultTemplatesTable,
+			Columns: []string{tenant.VaultTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(reviewcycle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11515,28 +15591,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PerformanceReviewsCleared() {
+	if _u.mutation.StockAuditLogsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PerformanceReviewsTable,
-			Columns: []string{tenant.PerformanceReviewsColumn},
+			Table:   tenant.StockAuditLogsTable,
+			Columns: []string{tenant.StockAuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPerformanceReviewsIDs(); len(nodes) > 0 && !_u.mutation.PerformanceReviewsCleared() {
+	if nodes := _u.mutation.RemovedStockAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.StockAuditLogsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PerformanceReviewsTable,
-			Columns: []string{tenant.PerformanceReviewsColumn},
+			Table:   tenant.StockAuditLogsTable,
+			Columns: []string{tenant.StockAuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11544,15 +15620,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PerformanceReviewsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.StockAuditLogsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PerformanceReviewsTable,
-			Columns: []string{tenant.PerformanceReviewsColumn},
+			Table:   tenant.StockAuditLogsTable,
+			Columns: []string{tenant.StockAuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(performancereview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11560,28 +15636,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.GoalsCleared() {
+	if _u.mutation.MaintenanceSchedulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.GoalsTable,
-			Columns: []string{tenant.GoalsColumn},
+			Table:   tenant.MaintenanceSchedulesTable,
+			Columns: []string{tenant.MaintenanceSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !_u.mutation.GoalsCleared() {
+	if nodes := _u.mutation.RemovedMaintenanceSchedulesIDs(); len(nodes) > 0 && !_u.mutation.MaintenanceSchedulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.GoalsTable,
-			Columns: []string{tenant.GoalsColumn},
+			Table:   tenant.MaintenanceSchedulesTable,
+			Columns: []string{tenant.MaintenanceSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11589,15 +15665,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.GoalsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MaintenanceSchedulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.GoalsTable,
-			Columns: []string{tenant.GoalsColumn},
+			Table:   tenant.MaintenanceSchedulesTable,
+			Columns: []string{tenant.MaintenanceSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11605,28 +15681,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SuppliersCleared() {
+	if _u.mutation.StockAlertsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuppliersTable,
-			Columns: []string{tenant.SuppliersColumn},
+			Table:   tenant.StockAlertsTable,
+			Columns: []string{tenant.StockAlertsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSuppliersIDs(); len(nodes) > 0 && !_u.mutation.SuppliersCleared() {
+	if nodes := _u.mutation.RemovedStockAlertsIDs(); len(nodes) > 0 && !_u.mutation.StockAlertsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuppliersTable,
-			Columns: []string{tenant.SuppliersColumn},
+			Table:   tenant.StockAlertsTable,
+			Columns: []string{tenant.StockAlertsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11634,15 +15710,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SuppliersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.StockAlertsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.SuppliersTable,
-			Columns: []string{tenant.SuppliersColumn},
+			Table:   tenant.StockAlertsTable,
+			Columns: []string{tenant.StockAlertsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(supplier.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11650,28 +15726,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CategoriesCleared() {
+	if _u.mutation.PurchaseOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CategoriesTable,
-			Columns: []string{tenant.CategoriesColumn},
+			Table:   tenant.PurchaseOrdersTable,
+			Columns: []string{tenant.PurchaseOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
+	if nodes := _u.mutation.RemovedPurchaseOrdersIDs(); len(nodes) > 0 && !_u.mutation.PurchaseOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CategoriesTable,
-			Columns: []string{tenant.CategoriesColumn},
+			Table:   tenant.PurchaseOrdersTable,
+			Columns: []string{tenant.PurchaseOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11679,15 +15755,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PurchaseOrdersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CategoriesTable,
-			Columns: []string{tenant.CategoriesColumn},
+			Table:   tenant.PurchaseOrdersTable,
+			Columns: []string{tenant.PurchaseOrdersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11695,28 +15771,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.WarehousesCleared() {
+	if _u.mutation.InventoryCountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.WarehousesTable,
-			Columns: []string{tenant.WarehousesColumn},
+			Table:   tenant.InventoryCountsTable,
+			Columns: []string{tenant.InventoryCountsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedWarehousesIDs(); len(nodes) > 0 && !_u.mutation.WarehousesCleared() {
+	if nodes := _u.mutation.RemovedInventoryCountsIDs(); len(nodes) > 0 && !_u.mutation.InventoryCountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.WarehousesTable,
-			Columns: []string{tenant.WarehousesColumn},
+			Table:   tenant.InventoryCountsTable,
+			Columns: []string{tenant.InventoryCountsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11724,15 +15800,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.WarehousesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.InventoryCountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.WarehousesTable,
-			Columns: []string{tenant.WarehousesColumn},
+			Table:   tenant.InventoryCountsTable,
+			Columns: []string{tenant.InventoryCountsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11740,28 +15816,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssetAssignmentsCleared() {
+	if _u.mutation.JobPostingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetAssignmentsTable,
-			Columns: []string{tenant.AssetAssignmentsColumn},
+			Table:   tenant.JobPostingsTable,
+			Columns: []string{tenant.JobPostingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssetAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssetAssignmentsCleared() {
+	if nodes := _u.mutation.RemovedJobPostingsIDs(); len(nodes) > 0 && !_u.mutation.JobPostingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetAssignmentsTable,
-			Columns: []string{tenant.AssetAssignmentsColumn},
+			Table:   tenant.JobPostingsTable,
+			Columns: []string{tenant.JobPostingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11769,15 +15845,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssetAssignmentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.JobPostingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.AssetAssignmentsTable,
-			Columns: []string{tenant.AssetAssignmentsColumn},
+			Table:   tenant.JobPostingsTable,
+			Columns: []string{tenant.JobPostingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assetassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11785,28 +15861,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ContactsCleared() {
+	if _u.mutation.CandidatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContactsTable,
-			Columns: []string{tenant.ContactsColumn},
+			Table:   tenant.CandidatesTable,
+			Columns: []string{tenant.CandidatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedContactsIDs(); len(nodes) > 0 && !_u.mutation.ContactsCleared() {
+	if nodes := _u.mutation.RemovedCandidatesIDs(); len(nodes) > 0 && !_u.mutation.CandidatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContactsTable,
-			Columns: []string{tenant.ContactsColumn},
+			Table:   tenant.CandidatesTable,
+			Columns: []string{tenant.CandidatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11814,15 +15890,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ContactsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CandidatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ContactsTable,
-			Columns: []string{tenant.ContactsColumn},
+			Table:   tenant.CandidatesTable,
+			Columns: []string{tenant.CandidatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11830,28 +15906,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LegalHoldsCleared() {
+	if _u.mutation.ApplicationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.LegalHoldsTable,
-			Columns: []string{tenant.LegalHoldsColumn},
+			Table:   tenant.ApplicationsTable,
+			Columns: []string{tenant.ApplicationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLegalHoldsIDs(); len(nodes) > 0 && !_u.mutation.LegalHoldsCleared() {
+	if nodes := _u.mutation.RemovedApplicationsIDs(); len(nodes) > 0 && !_u.mutation.ApplicationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.LegalHoldsTable,
-			Columns: []string{tenant.LegalHoldsColumn},
+			Table:   tenant.ApplicationsTable,
+			Columns: []string{tenant.ApplicationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11859,15 +15935,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ApplicationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.LegalHoldsTable,
-			Columns: []string{tenant.LegalHoldsColumn},
+			Table:   tenant.ApplicationsTable,
+			Columns: []string{tenant.ApplicationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11875,28 +15951,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RetentionPoliciesCleared() {
+	if _u.mutation.InterviewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RetentionPoliciesTable,
-			Columns: []string{tenant.RetentionPoliciesColumn},
+			Table:   tenant.InterviewsTable,
+			Columns: []string{tenant.InterviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRetentionPoliciesIDs(); len(nodes) > 0 && !_u.mutation.RetentionPoliciesCleared() {
+	if nodes := _u.mutation.RemovedInterviewsIDs(); len(nodes) > 0 && !_u.mutation.InterviewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RetentionPoliciesTable,
-			Columns: []string{tenant.RetentionPoliciesColumn},
+			Table:   tenant.InterviewsTable,
+			Columns: []string{tenant.InterviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11904,15 +15980,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RetentionPoliciesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.InterviewsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.RetentionPoliciesTable,
-			Columns: []string{tenant.RetentionPoliciesColumn},
+			Table:   tenant.InterviewsTable,
+			Columns: []string{tenant.InterviewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11920,28 +15996,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.VaultTemplatesCleared() {
+	if _u.mutation.BenefitPlansCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultTemplatesTable,
-			Columns: []string{tenant.VaultTemplatesColumn},
+			Table:   tenant.BenefitPlansTable,
+			Columns: []string{tenant.BenefitPlansColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedVaultTemplatesIDs(); len(nodes) > 0 && !_u.mutation.VaultTemplatesCleared() {
+	if nodes := _u.mutation.RemovedBenefitPlansIDs(); len(nodes) > 0 && !_u.mutation.BenefitPlansCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultTemplatesTable,
-			Columns: []string{tenant.VaultTemplatesColumn},
+			Table:   tenant.BenefitPlansTable,
+			Columns: []string{tenant.BenefitPlansColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11949,15 +16025,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.VaultTemplatesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BenefitPlansIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.VaultTemplatesTable,
-			Columns: []string{tenant.VaultTemplatesColumn},
+			Table:   tenant.BenefitPlansTable,
+			Columns: []string{tenant.BenefitPlansColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vaulttemplate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11965,28 +16041,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.StockAuditLogsCleared() {
+	if _u.mutation.BenefitEnrollmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAuditLogsTable,
-			Columns: []string{tenant.StockAuditLogsColumn},
+			Table:   tenant.BenefitEnrollmentsTable,
+			Columns: []string{tenant.BenefitEnrollmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedStockAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.StockAuditLogsCleared() {
+	if nodes := _u.mutation.RemovedBenefitEnrollmentsIDs(); len(nodes) > 0 && !_u.mutation.BenefitEnrollmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAuditLogsTable,
-			Columns: []string{tenant.StockAuditLogsColumn},
+			Table:   tenant.BenefitEnrollmentsTable,
+			Columns: []string{tenant.BenefitEnrollmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -11994,15 +16070,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.StockAuditLogsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BenefitEnrollmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAuditLogsTable,
-			Columns: []string{tenant.StockAuditLogsColumn},
+			Table:   tenant.BenefitEnrollmentsTable,
+			Columns: []string{tenant.BenefitEnrollmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockauditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12010,28 +16086,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MaintenanceSchedulesCleared() {
+	if _u.mutation.PosShiftsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MaintenanceSchedulesTable,
-			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Table:   tenant.PosShiftsTable,
+			Columns: []string{tenant.PosShiftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMaintenanceSchedulesIDs(); len(nodes) > 0 && !_u.mutation.MaintenanceSchedulesCleared() {
+	if nodes := _u.mutation.RemovedPosShiftsIDs(); len(nodes) > 0 && !_u.mutation.PosShiftsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MaintenanceSchedulesTable,
-			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Table:   tenant.PosShiftsTable,
+			Columns: []string{tenant.PosShiftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12039,15 +16115,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MaintenanceSchedulesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PosShiftsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MaintenanceSchedulesTable,
-			Columns: []string{tenant.MaintenanceSchedulesColumn},
+			Table:   tenant.PosShiftsTable,
+			Columns: []string{tenant.PosShiftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12055,28 +16131,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.StockAlertsCleared() {
+	if _u.mutation.LabelTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAlertsTable,
-			Columns: []string{tenant.StockAlertsColumn},
+			Table:   tenant.LabelTemplatesTable,
+			Columns: []string{tenant.LabelTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedStockAlertsIDs(); len(nodes) > 0 && !_u.mutation.StockAlertsCleared() {
+	if nodes := _u.mutation.RemovedLabelTemplatesIDs(); len(nodes) > 0 && !_u.mutation.LabelTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAlertsTable,
-			Columns: []string{tenant.StockAlertsColumn},
+			Table:   tenant.LabelTemplatesTable,
+			Columns: []string{tenant.LabelTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12084,15 +16160,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.StockAlertsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LabelTemplatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.StockAlertsTable,
-			Columns: []string{tenant.StockAlertsColumn},
+			Table:   tenant.LabelTemplatesTable,
+			Columns: []string{tenant.LabelTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(stockalert.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12100,28 +16176,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PurchaseOrdersCleared() {
+	if _u.mutation.TaxJurisdictionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PurchaseOrdersTable,
-			Columns: []string{tenant.PurchaseOrdersColumn},
+			Table:   tenant.TaxJurisdictionsTable,
+			Columns: []string{tenant.TaxJurisdictionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPurchaseOrdersIDs(); len(nodes) > 0 && !_u.mutation.PurchaseOrdersCleared() {
+	if nodes := _u.mutation.RemovedTaxJurisdictionsIDs(); len(nodes) > 0 && !_u.mutation.TaxJurisdictionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PurchaseOrdersTable,
-			Columns: []string{tenant.PurchaseOrdersColumn},
+			Table:   tenant.TaxJurisdictionsTable,
+			Columns: []string{tenant.TaxJurisdictionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12129,15 +16205,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PurchaseOrdersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TaxJurisdictionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.PurchaseOrdersTable,
-			Columns: []string{tenant.PurchaseOrdersColumn},
+			Table:   tenant.TaxJurisdictionsTable,
+			Columns: []string{tenant.TaxJurisdictionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(purchaseorder.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taxjurisdiction.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12145,28 +16221,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InventoryCountsCleared() {
+	if _u.mutation.EinvoiceCredentialsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryCountsTable,
-			Columns: []string{tenant.InventoryCountsColumn},
+			Table:   tenant.EinvoiceCredentialsTable,
+			Columns: []string{tenant.EinvoiceCredentialsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInventoryCountsIDs(); len(nodes) > 0 && !_u.mutation.InventoryCountsCleared() {
+	if nodes := _u.mutation.RemovedEinvoiceCredentialsIDs(); len(nodes) > 0 && !_u.mutation.EinvoiceCredentialsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryCountsTable,
-			Columns: []string{tenant.InventoryCountsColumn},
+			Table:   tenant.EinvoiceCredentialsTable,
+			Columns: []string{tenant.EinvoiceCredentialsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12174,15 +16250,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InventoryCountsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EinvoiceCredentialsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InventoryCountsTable,
-			Columns: []string{tenant.InventoryCountsColumn},
+			Table:   tenant.EinvoiceCredentialsTable,
+			Columns: []string{tenant.EinvoiceCredentialsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(inventorycount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(einvoicecredential.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12190,28 +16266,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.JobPostingsCleared() {
+	if _u.mutation.EinvoicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobPostingsTable,
-			Columns: []string{tenant.JobPostingsColumn},
+			Table:   tenant.EinvoicesTable,
+			Columns: []string{tenant.EinvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedJobPostingsIDs(); len(nodes) > 0 && !_u.mutation.JobPostingsCleared() {
+	if nodes := _u.mutation.RemovedEinvoicesIDs(); len(nodes) > 0 && !_u.mutation.EinvoicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobPostingsTable,
-			Columns: []string{tenant.JobPostingsColumn},
+			Table:   tenant.EinvoicesTable,
+			Columns: []string{tenant.EinvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12219,15 +16295,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.JobPostingsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EinvoicesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.JobPostingsTable,
-			Columns: []string{tenant.JobPostingsColumn},
+			Table:   tenant.EinvoicesTable,
+			Columns: []string{tenant.EinvoicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(jobposting.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(einvoice.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12235,28 +16311,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CandidatesCleared() {
+	if _u.mutation.AgentEnrollmentTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CandidatesTable,
-			Columns: []string{tenant.CandidatesColumn},
+			Table:   tenant.AgentEnrollmentTokensTable,
+			Columns: []string{tenant.AgentEnrollmentTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCandidatesIDs(); len(nodes) > 0 && !_u.mutation.CandidatesCleared() {
+	if nodes := _u.mutation.RemovedAgentEnrollmentTokensIDs(); len(nodes) > 0 && !_u.mutation.AgentEnrollmentTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CandidatesTable,
-			Columns: []string{tenant.CandidatesColumn},
+			Table:   tenant.AgentEnrollmentTokensTable,
+			Columns: []string{tenant.AgentEnrollmentTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12264,15 +16340,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CandidatesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AgentEnrollmentTokensIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.CandidatesTable,
-			Columns: []string{tenant.CandidatesColumn},
+			Table:   tenant.AgentEnrollmentTokensTable,
+			Columns: []string{tenant.AgentEnrollmentTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(candidate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(agentenrollmenttoken.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12280,44 +16356,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ApplicationsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   tenant.ApplicationsTable,
-			Columns: []string{tenant.ApplicationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedApplicationsIDs(); len(nodes) > 0 && !_u.mutation.ApplicationsCleared() {
+	if _u.mutation.AgentAuthorityCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.O2O,
 			Inverse: false,
-			Table:   tenant.ApplicationsTable,
-			Columns: []string{tenant.ApplicationsColumn},
+			Table:   tenant.AgentAuthorityTable,
+			Columns: []string{tenant.AgentAuthorityColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(agentauthority.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ApplicationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AgentAuthorityIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.O2O,
 			Inverse: false,
-			Table:   tenant.ApplicationsTable,
-			Columns: []string{tenant.ApplicationsColumn},
+			Table:   tenant.AgentAuthorityTable,
+			Columns: []string{tenant.AgentAuthorityColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(agentauthority.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12325,28 +16385,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InterviewsCleared() {
+	if _u.mutation.DeviceGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InterviewsTable,
-			Columns: []string{tenant.InterviewsColumn},
+			Table:   tenant.DeviceGroupsTable,
+			Columns: []string{tenant.DeviceGroupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInterviewsIDs(); len(nodes) > 0 && !_u.mutation.InterviewsCleared() {
+	if nodes := _u.mutation.RemovedDeviceGroupsIDs(); len(nodes) > 0 && !_u.mutation.DeviceGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InterviewsTable,
-			Columns: []string{tenant.InterviewsColumn},
+			Table:   tenant.DeviceGroupsTable,
+			Columns: []string{tenant.DeviceGroupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12354,15 +16414,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InterviewsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DeviceGroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.InterviewsTable,
-			Columns: []string{tenant.InterviewsColumn},
+			Table:   tenant.DeviceGroupsTable,
+			Columns: []string{tenant.DeviceGroupsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(interview.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12370,28 +16430,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BenefitPlansCleared() {
+	if _u.mutation.PatchPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitPlansTable,
-			Columns: []string{tenant.BenefitPlansColumn},
+			Table:   tenant.PatchPoliciesTable,
+			Columns: []string{tenant.PatchPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBenefitPlansIDs(); len(nodes) > 0 && !_u.mutation.BenefitPlansCleared() {
+	if nodes := _u.mutation.RemovedPatchPoliciesIDs(); len(nodes) > 0 && !_u.mutation.PatchPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitPlansTable,
-			Columns: []string{tenant.BenefitPlansColumn},
+			Table:   tenant.PatchPoliciesTable,
+			Columns: []string{tenant.PatchPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12399,15 +16459,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BenefitPlansIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PatchPoliciesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitPlansTable,
-			Columns: []string{tenant.BenefitPlansColumn},
+			Table:   tenant.PatchPoliciesTable,
+			Columns: []string{tenant.PatchPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitplan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(patchpolicy.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12415,28 +16475,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BenefitEnrollmentsCleared() {
+	if _u.mutation.AlertRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitEnrollmentsTable,
-			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Table:   tenant.AlertRulesTable,
+			Columns: []string{tenant.AlertRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBenefitEnrollmentsIDs(); len(nodes) > 0 && !_u.mutation.BenefitEnrollmentsCleared() {
+	if nodes := _u.mutation.RemovedAlertRulesIDs(); len(nodes) > 0 && !_u.mutation.AlertRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitEnrollmentsTable,
-			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Table:   tenant.AlertRulesTable,
+			Columns: []string{tenant.AlertRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12444,15 +16504,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BenefitEnrollmentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AlertRulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.BenefitEnrollmentsTable,
-			Columns: []string{tenant.BenefitEnrollmentsColumn},
+			Table:   tenant.AlertRulesTable,
+			Columns: []string{tenant.AlertRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(benefitenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -3,8 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"sent/ent/customer"
+	"sent/ent/posshift"
 	"sent/ent/recording"
+	"sent/ent/schema"
 	"sent/ent/tenant"
 	"sent/ent/transaction"
 	"sent/ent/user"
@@ -29,6 +33,10 @@ type Transaction struct {
 	TotalAmount decimal.Decimal `json:"total_amount,omitempty"`
 	// TaxAmount holds the value of the "tax_amount" field.
 	TaxAmount decimal.Decimal `json:"tax_amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate decimal.Decimal `json:"exchange_rate,omitempty"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
 	// Reference holds the value of the "reference" field.
@@ -41,11 +49,20 @@ type Transaction struct {
 	ApprovalStatus transaction.ApprovalStatus `json:"approval_status,omitempty"`
 	// IsIntercompany holds the value of the "is_intercompany" field.
 	IsIntercompany bool `json:"is_intercompany,omitempty"`
+	// ClearanceStatus holds the value of the "clearance_status" field.
+	ClearanceStatus *transaction.ClearanceStatus `json:"clearance_status,omitempty"`
+	// ClearanceMessages holds the value of the "clearance_messages" field.
+	ClearanceMessages []schema.ClearanceMessage `json:"clearance_messages,omitempty"`
+	// ClearedAt holds the value of the "cleared_at" field.
+	ClearedAt *time.Time `json:"cleared_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TransactionQuery when eager-loading is set.
 	Edges                   TransactionEdges `json:"edges"`
+	customer_sales          *int
+	pos_shift_transactions  *int
 	tenant_transactions     *int
 	transaction_approved_by *int
+	transaction_refunds     *int
 	selectValues            sql.SelectValues
 }
 
@@ -61,9 +78,23 @@ type TransactionEdges struct {
 	Recording *Recording `json:"recording,omitempty"`
 	// ApprovedBy holds the value of the approved_by edge.
 	ApprovedBy *User `json:"approved_by,omitempty"`
+	// StockMovements holds the value of the stock_movements edge.
+	StockMovements []*StockMovement `json:"stock_movements,omitempty"`
+	// Customer holds the value of the customer edge.
+	Customer *Customer `json:"customer,omitempty"`
+	// PosLines holds the value of the pos_lines edge.
+	PosLines []*PosSaleLine `json:"pos_lines,omitempty"`
+	// Tenders holds the value of the tenders edge.
+	Tenders []*PosTender `json:"tenders,omitempty"`
+	// Shift holds the value of the shift edge.
+	Shift *PosShift `json:"shift,omitempty"`
+	// RefundedSale holds the value of the refunded_sale edge.
+	RefundedSale *Transaction `json:"refunded_sale,omitempty"`
+	// Refunds holds the value of the refunds edge.
+	Refunds []*Transaction `json:"refunds,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [12]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -117,24 +148,101 @@ func (e TransactionEdges) ApprovedByOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "approved_by"}
 }
 
+// StockMovementsOrErr returns the StockMovements value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) StockMovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[5] {
+		return e.StockMovements, nil
+	}
+	return nil, &NotLoadedError{edge: "stock_movements"}
+}
+
+// CustomerOrErr returns the Customer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) CustomerOrErr() (*Customer, error) {
+	if e.Customer != nil {
+		return e.Customer, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: customer.Label}
+	}
+	return nil, &NotLoadedError{edge: "customer"}
+}
+
+// PosLinesOrErr returns the PosLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) PosLinesOrErr() ([]*PosSaleLine, error) {
+	if e.loadedTypes[7] {
+		return e.PosLines, nil
+	}
+	return nil, &NotLoadedError{edge: "pos_lines"}
+}
+
+// TendersOrErr returns the Tenders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) TendersOrErr() ([]*PosTender, error) {
+	if e.loadedTypes[8] {
+		return e.Tenders, nil
+	}
+	return nil, &NotLoadedError{edge: "tenders"}
+}
+
+// ShiftOrErr returns the Shift value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) ShiftOrErr() (*PosShift, error) {
+	if e.Shift != nil {
+		return e.Shift, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: posshift.Label}
+	}
+	return nil, &NotLoadedError{edge: "shift"}
+}
+
+// RefundedSaleOrErr returns the RefundedSale value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) RefundedSaleOrErr() (*Transaction, error) {
+	if e.RefundedSale != nil {
+		return e.RefundedSale, nil
+	} else if e.loadedTypes[10] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "refunded_sale"}
+}
+
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) RefundsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[11] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case transaction.FieldTotalAmount, transaction.FieldTaxAmount:
+		case transaction.FieldClearanceMessages:
+			values[i] = new([]byte)
+		case transaction.FieldTotalAmount, transaction.FieldTaxAmount, transaction.FieldExchangeRate:
 			values[i] = new(decimal.Decimal)
 		case transaction.FieldIsIntercompany:
 			values[i] = new(sql.NullBool)
 		case transaction.FieldID, transaction.FieldRecordingID:
 			values[i] = new(sql.NullInt64)
-		case transaction.FieldDescription, transaction.FieldType, transaction.FieldReference, transaction.FieldUUID, transaction.FieldApprovalStatus:
+		case transaction.FieldDescription, transaction.FieldCurrency, transaction.FieldType, transaction.FieldReference, transaction.FieldUUID, transaction.FieldApprovalStatus, transaction.FieldClearanceStatus:
 			values[i] = new(sql.NullString)
-		case transaction.FieldDate:
+		case transaction.FieldDate, transaction.FieldClearedAt:
 			values[i] = new(sql.NullTime)
-		case transaction.ForeignKeys[0]: // tenant_transactions
+		case transaction.ForeignKeys[0]: // customer_sales
+			values[i] = new(sql.NullInt64)
+		case transaction.ForeignKeys[1]: // pos_shift_transactions
 			values[i] = new(sql.NullInt64)
-		case transaction.ForeignKeys[1]: // transaction_approved_by
+		case transaction.ForeignKeys[2]: // tenant_transactions
+			values[i] = new(sql.NullInt64)
+		case transaction.ForeignKeys[3]: // transaction_approved_by
+			values[i] = new(sql.NullInt64)
+		case transaction.ForeignKeys[4]: // transaction_refunds
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -181,6 +289,18 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.TaxAmount = *value
 			}
+		case transaction.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case transaction.FieldExchangeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value != nil {
+				_m.ExchangeRate = *value
+			}
 		case transaction.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -218,20 +338,63 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsIntercompany = value.Bool
 			}
+		case transaction.FieldClearanceStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field clearance_status", values[i])
+			} else if value.Valid {
+				_m.ClearanceStatus = new(transaction.ClearanceStatus)
+				*_m.ClearanceStatus = transaction.ClearanceStatus(value.String)
+			}
+		case transaction.FieldClearanceMessages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field clearance_messages", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClearanceMessages); err != nil {
+					return fmt.Errorf("unmarshal field clearance_messages: %w", err)
+				}
+			}
+		case transaction.FieldClearedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cleared_at", values[i])
+			} else if value.Valid {
+				_m.ClearedAt = new(time.Time)
+				*_m.ClearedAt = value.Time
+			}
 		case transaction.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field customer_sales", value)
+			} else if value.Valid {
+				_m.customer_sales = new(int)
+				*_m.customer_sales = int(value.Int64)
+			}
+		case transaction.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field pos_shift_transactions", value)
+			} else if value.Valid {
+				_m.pos_shift_transactions = new(int)
+				*_m.pos_shift_transactions = int(value.Int64)
+			}
+		case transaction.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field tenant_transactions", value)
 			} else if value.Valid {
 				_m.tenant_transactions = new(int)
 				*_m.tenant_transactions = int(value.Int64)
 			}
-		case transaction.ForeignKeys[1]:
+		case transaction.ForeignKeys[3]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field transaction_approved_by", value)
 			} else if value.Valid {
 				_m.transaction_approved_by = new(int)
 				*_m.transaction_approved_by = int(value.Int64)
 			}
+		case transaction.ForeignKeys[4]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field transaction_refunds", value)
+			} else if value.Valid {
+				_m.transaction_refunds = new(int)
+				*_m.transaction_refunds = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -270,6 +433,41 @@ func (_m *Transaction) QueryApprovedBy() *UserQuery {
 	return NewTransactionClient(_m.config).QueryApprovedBy(_m)
 }
 
+// QueryStockMovements queries the "stock_movements" edge of the Transaction entity.
+func (_m *Transaction) QueryStockMovements() *StockMovementQuery {
+	return NewTransactionClient(_m.config).QueryStockMovements(_m)
+}
+
+// QueryCustomer queries the "customer" edge of the Transaction entity.
+func (_m *Transaction) QueryCustomer() *CustomerQuery {
+	return NewTransactionClient(_m.config).QueryCustomer(_m)
+}
+
+// QueryPosLines queries the "pos_lines" edge of the Transaction entity.
+func (_m *Transaction) QueryPosLines() *PosSaleLineQuery {
+	return NewTransactionClient(_m.config).QueryPosLines(_m)
+}
+
+// QueryTenders queries the "tenders" edge of the Transaction entity.
+func (_m *Transaction) QueryTenders() *PosTenderQuery {
+	return NewTransactionClient(_m.config).QueryTenders(_m)
+}
+
+// QueryShift queries the "shift" edge of the Transaction entity.
+func (_m *Transaction) QueryShift() *PosShiftQuery {
+	return NewTransactionClient(_m.config).QueryShift(_m)
+}
+
+// QueryRefundedSale queries the "refunded_sale" edge of the Transaction entity.
+func (_m *Transaction) QueryRefundedSale() *TransactionQuery {
+	return NewTransactionClient(_m.config).QueryRefundedSale(_m)
+}
+
+// QueryRefunds queries the "refunds" edge of the Transaction entity.
+func (_m *Transaction) QueryRefunds() *TransactionQuery {
+	return NewTransactionClient(_m.config).QueryRefunds(_m)
+}
+
 // Update returns a builder for updating this Transaction.
 // Note that you need to call Transaction.Unwrap() before calling this method if this Transaction
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -305,6 +503,12 @@ func (_m *Transaction) String() string {
 	builder.WriteString("tax_amount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TaxAmount))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExchangeRate))
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(_m.Type)
 	builder.WriteString(", ")
@@ -324,6 +528,19 @@ func (_m *Transaction) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_intercompany=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsIntercompany))
+	builder.WriteString(", ")
+	if v := _m.ClearanceStatus; v != nil {
+		builder.WriteString("clearance_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("clearance_messages=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClearanceMessages))
+	builder.WriteString(", ")
+	if v := _m.ClearedAt; v != nil {
+		builder.WriteString("cleared_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -24,6 +24,10 @@ const (
 	FieldTotalAmount = "total_amount"
 	// FieldTaxAmount holds the string denoting the tax_amount field in the database.
 	FieldTaxAmount = "tax_amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldReference holds the string denoting the reference field in the database.
@@ -36,6 +40,12 @@ const (
 	FieldApprovalStatus = "approval_status"
 	// FieldIsIntercompany holds the string denoting the is_intercompany field in the database.
 	FieldIsIntercompany = "is_intercompany"
+	// FieldClearanceStatus holds the string denoting the clearance_status field in the database.
+	FieldClearanceStatus = "clearance_status"
+	// FieldClearanceMessages holds the string denoting the clearance_messages field in the database.
+	FieldClearanceMessages = "clearance_messages"
+	// FieldClearedAt holds the string denoting the cleared_at field in the database.
+	FieldClearedAt = "cleared_at"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
 	// EdgeLedgerEntries holds the string denoting the ledger_entries edge name in mutations.
@@ -46,6 +56,20 @@ const (
 	EdgeRecording = "recording"
 	// EdgeApprovedBy holds the string denoting the approved_by edge name in mutations.
 	EdgeApprovedBy = "approved_by"
+	// EdgeStockMovements holds the string denoting the stock_movements edge name in mutations.
+	EdgeStockMovements = "stock_movements"
+	// EdgeCustomer holds the string denoting the customer edge name in mutations.
+	EdgeCustomer = "customer"
+	// EdgePosLines holds the string denoting the pos_lines edge name in mutations.
+	EdgePosLines = "pos_lines"
+	// EdgeTenders holds the string denoting the tenders edge name in mutations.
+	EdgeTenders = "tenders"
+	// EdgeShift holds the string denoting the shift edge name in mutations.
+	EdgeShift = "shift"
+	// EdgeRefundedSale holds the string denoting the refunded_sale edge name in mutations.
+	EdgeRefundedSale = "refunded_sale"
+	// EdgeRefunds holds the string denoting the refunds edge name in mutations.
+	EdgeRefunds = "refunds"
 	// Table holds the table name of the transaction in the database.
 	Table = "transactions"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -83,6 +107,49 @@ const (
 	ApprovedByInverseTable = "users"
 	// ApprovedByColumn is the table column denoting the approved_by relation/edge.
 	ApprovedByColumn = "transaction_approved_by"
+	// StockMovementsTable is the table that holds the stock_movements relation/edge.
+	StockMovementsTable = "stock_movements"
+	// StockMovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	StockMovementsInverseTable = "stock_movements"
+	// StockMovementsColumn is the table column denoting the stock_movements relation/edge.
+	StockMovementsColumn = "transaction_stock_movements"
+	// CustomerTable is the table that holds the customer relation/edge.
+	CustomerTable = "transactions"
+	// CustomerInverseTable is the table name for the Customer entity.
+	// It exists in this package in order to avoid circular dependency with the "customer" package.
+	CustomerInverseTable = "customers"
+	// CustomerColumn is the table column denoting the customer relation/edge.
+	CustomerColumn = "customer_sales"
+	// PosLinesTable is the table that holds the pos_lines relation/edge.
+	PosLinesTable = "pos_sale_lines"
+	// PosLinesInverseTable is the table name for the PosSaleLine entity.
+	// It exists in this package in order to avoid circular dependency with the "possaleline" package.
+	PosLinesInverseTable = "pos_sale_lines"
+	// PosLinesColumn is the table column denoting the pos_lines relation/edge.
+	PosLinesColumn = "transaction_pos_lines"
+	// TendersTable is the table that holds the tenders relation/edge.
+	TendersTable = "pos_tenders"
+	// TendersInverseTable is the table name for the PosTender entity.
+	// It exists in this package in order to avoid circular dependency with the "postender" package.
+	TendersInverseTable = "pos_tenders"
+	// TendersColumn is the table column denoting the tenders relation/edge.
+	TendersColumn = "transaction_tenders"
+	// ShiftTable is the table that holds the shift relation/edge.
+	ShiftTable = "transactions"
+	// ShiftInverseTable is the table name for the PosShift entity.
+	// It exists in this package in order to avoid circular dependency with the "posshift" package.
+	ShiftInverseTable = "pos_shifts"
+	// ShiftColumn is the table column denoting the shift relation/edge.
+	ShiftColumn = "pos_shift_transactions"
+	// RefundedSaleTable is the table that holds the refunded_sale relation/edge.
+	RefundedSaleTable = "transactions"
+	// RefundedSaleColumn is the table column denoting the refunded_sale relation/edge.
+	RefundedSaleColumn = "transaction_refunds"
+	// RefundsTable is the table that holds the refunds relation/edge.
+	RefundsTable = "transactions"
+	// RefundsColumn is the table column denoting the refunds relation/edge.
+	RefundsColumn = "transaction_refunds"
 )
 
 // Columns holds all SQL columns for transaction fields.
@@ -92,19 +159,27 @@ var Columns = []string{
 	FieldDate,
 	FieldTotalAmount,
 	FieldTaxAmount,
+	FieldCurrency,
+	FieldExchangeRate,
 	FieldType,
 	FieldReference,
 	FieldUUID,
 	FieldRecordingID,
 	FieldApprovalStatus,
 	FieldIsIntercompany,
+	FieldClearanceStatus,
+	FieldClearanceMessages,
+	FieldClearedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "transactions"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"customer_sales",
+	"pos_shift_transactions",
 	"tenant_transactions",
 	"transaction_approved_by",
+	"transaction_refunds",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -129,6 +204,10 @@ var (
 	DefaultTotalAmount decimal.Decimal
 	// DefaultTaxAmount holds the default value on creation for the "tax_amount" field.
 	DefaultTaxAmount decimal.Decimal
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate decimal.Decimal
 	// DefaultUUID holds the default value on creation for the "uuid" field.
 	DefaultUUID func() string
 	// DefaultIsIntercompany holds the default value on creation for the "is_intercompany" field.
@@ -163,6 +242,32 @@ func ApprovalStatusValidator(as ApprovalStatus) error {
 	}
 }
 
+// ClearanceStatus defines the type for the "clearance_status" enum field.
+type ClearanceStatus string
+
+// ClearanceStatus values.
+const (
+	ClearanceStatusPending  ClearanceStatus = "pending"
+	ClearanceStatusCleared  ClearanceStatus = "cleared"
+	ClearanceStatusReported ClearanceStatus = "reported"
+	ClearanceStatusRejected ClearanceStatus = "rejected"
+	ClearanceStatusFailed   ClearanceStatus = "failed"
+)
+
+func (cs ClearanceStatus) String() string {
+	return string(cs)
+}
+
+// ClearanceStatusValidator is a validator for the "clearance_status" field enum values. It is called by the builders before save.
+func ClearanceStatusValidator(cs ClearanceStatus) error {
+	switch cs {
+	case ClearanceStatusPending, ClearanceStatusCleared, ClearanceStatusReported, ClearanceStatusRejected, ClearanceStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("transaction: invalid enum value for clearance_status field: %q", cs)
+	}
+}
+
 // OrderOption defines the ordering options for the Transaction queries.
 type OrderOption func(*sql.Selector)
 
@@ -191,6 +296,16 @@ func ByTaxAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTaxAmount, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -221,6 +336,16 @@ func ByIsIntercompany(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsIntercompany, opts...).ToFunc()
 }
 
+// ByClearanceStatus orders the results by the clearance_status field.
+func ByClearanceStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClearanceStatus, opts...).ToFunc()
+}
+
+// ByClearedAt orders the results by the cleared_at field.
+func ByClearedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClearedAt, opts...).ToFunc()
+}
+
 // ByTenantField orders the results by tenant field.
 func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -269,6 +394,83 @@ func ByApprovedByField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newApprovedByStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByStockMovementsCount orders the results by stock_movements count.
+func ByStockMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStockMovementsStep(), opts...)
+	}
+}
+
+// ByStockMovements orders the results by stock_movements terms.
+func ByStockMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCustomerField orders the results by customer field.
+func ByCustomerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPosLinesCount orders the results by pos_lines count.
+func ByPosLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPosLinesStep(), opts...)
+	}
+}
+
+// ByPosLines orders the results by pos_lines terms.
+func ByPosLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPosLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTendersCount orders the results by tenders count.
+func ByTendersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTendersStep(), opts...)
+	}
+}
+
+// ByTenders orders the results by tenders terms.
+func ByTenders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTendersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShiftField orders the results by shift field.
+func ByShiftField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShiftStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRefundedSaleField orders the results by refunded_sale field.
+func ByRefundedSaleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefundedSaleStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRefundsCount orders the results by refunds count.
+func ByRefundsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefundsStep(), opts...)
+	}
+}
+
+// ByRefunds orders the results by refunds terms.
+func ByRefunds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefundsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -304,3 +506,52 @@ func newApprovedByStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, ApprovedByTable, ApprovedByColumn),
 	)
 }
+func newStockMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockMovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+	)
+}
+func newCustomerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+	)
+}
+func newPosLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PosLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PosLinesTable, PosLinesColumn),
+	)
+}
+func newTendersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TendersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TendersTable, TendersColumn),
+	)
+}
+func newShiftStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShiftInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ShiftTable, ShiftColumn),
+	)
+}
+func newRefundedSaleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RefundedSaleTable, RefundedSaleColumn),
+	)
+}
+func newRefundsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+	)
+}
@@ -76,6 +76,16 @@ func TaxAmount(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldTaxAmount, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRate, v))
+}
+
 // Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
 func Type(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldType, v))
@@ -101,6 +111,11 @@ func IsIntercompany(v bool) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldIsIntercompany, v))
 }
 
+// ClearedAt applies equality check predicate on the "cleared_at" field. It's identical to ClearedAtEQ.
+func ClearedAt(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldClearedAt, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldDescription, v))
@@ -286,6 +301,111 @@ func TaxAmountLTE(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldTaxAmount, v))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExchangeRate, v))
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExchangeRate, v))
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExchangeRate, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldType, v))
@@ -561,6 +681,96 @@ func IsIntercompanyNEQ(v bool) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNEQ(FieldIsIntercompany, v))
 }
 
+// ClearanceStatusEQ applies the EQ predicate on the "clearance_status" field.
+func ClearanceStatusEQ(v ClearanceStatus) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldClearanceStatus, v))
+}
+
+// ClearanceStatusNEQ applies the NEQ predicate on the "clearance_status" field.
+func ClearanceStatusNEQ(v ClearanceStatus) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldClearanceStatus, v))
+}
+
+// ClearanceStatusIn applies the In predicate on the "clearance_status" field.
+func ClearanceStatusIn(vs ...ClearanceStatus) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldClearanceStatus, vs...))
+}
+
+// ClearanceStatusNotIn applies the NotIn predicate on the "clearance_status" field.
+func ClearanceStatusNotIn(vs ...ClearanceStatus) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldClearanceStatus, vs...))
+}
+
+// ClearanceStatusIsNil applies the IsNil predicate on the "clearance_status" field.
+func ClearanceStatusIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldClearanceStatus))
+}
+
+// ClearanceStatusNotNil applies the NotNil predicate on the "clearance_status" field.
+func ClearanceStatusNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldClearanceStatus))
+}
+
+// ClearanceMessagesIsNil applies the IsNil predicate on the "clearance_messages" field.
+func ClearanceMessagesIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldClearanceMessages))
+}
+
+// ClearanceMessagesNotNil applies the NotNil predicate on the "clearance_messages" field.
+func ClearanceMessagesNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldClearanceMessages))
+}
+
+// ClearedAtEQ applies the EQ predicate on the "cleared_at" field.
+func ClearedAtEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldClearedAt, v))
+}
+
+// ClearedAtNEQ applies the NEQ predicate on the "cleared_at" field.
+func ClearedAtNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldClearedAt, v))
+}
+
+// ClearedAtIn applies the In predicate on the "cleared_at" field.
+func ClearedAtIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldClearedAt, vs...))
+}
+
+// ClearedAtNotIn applies the NotIn predicate on the "cleared_at" field.
+func ClearedAtNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldClearedAt, vs...))
+}
+
+// ClearedAtGT applies the GT predicate on the "cleared_at" field.
+func ClearedAtGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldClearedAt, v))
+}
+
+// ClearedAtGTE applies the GTE predicate on the "cleared_at" field.
+func ClearedAtGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldClearedAt, v))
+}
+
+// ClearedAtLT applies the LT predicate on the "cleared_at" field.
+func ClearedAtLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldClearedAt, v))
+}
+
+// ClearedAtLTE applies the LTE predicate on the "cleared_at" field.
+func ClearedAtLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldClearedAt, v))
+}
+
+// ClearedAtIsNil applies the IsNil predicate on the "cleared_at" field.
+func ClearedAtIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldClearedAt))
+}
+
+// ClearedAtNotNil applies the NotNil predicate on the "cleared_at" field.
+func ClearedAtNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldClearedAt))
+}
+
 // HasTenant applies the HasEdge predicate on the "tenant" edge.
 func HasTenant() predicate.Transaction {
 	return predicate.Transaction(func(s *sql.Selector) {
@@ -676,6 +886,167 @@ func HasApprovedByWith(preds ...predicate.User) predicate.Transaction {
 	})
 }
 
+// HasStockMovements applies the HasEdge predicate on the "stock_movements" edge.
+func HasStockMovements() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStockMovementsWith applies the HasEdge predicate on the "stock_movements" edge with a given conditions (other predicates).
+func HasStockMovementsWith(preds ...predicate.StockMovement) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newStockMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomer applies the HasEdge predicate on the "customer" edge.
+func HasCustomer() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomerWith applies the HasEdge predicate on the "customer" edge with a given conditions (other predicates).
+func HasCustomerWith(preds ...predicate.Customer) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newCustomerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPosLines applies the HasEdge predicate on the "pos_lines" edge.
+func HasPosLines() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PosLinesTable, PosLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPosLinesWith applies the HasEdge predicate on the "pos_lines" edge with a given conditions (other predicates).
+func HasPosLinesWith(preds ...predicate.PosSaleLine) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newPosLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTenders applies the HasEdge predicate on the "tenders" edge.
+func HasTenders() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TendersTable, TendersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTendersWith applies the HasEdge predicate on the "tenders" edge with a given conditions (other predicates).
+func HasTendersWith(preds ...predicate.PosTender) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newTendersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShift applies the HasEdge predicate on the "shift" edge.
+func HasShift() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ShiftTable, ShiftColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShiftWith applies the HasEdge predicate on the "shift" edge with a given conditions (other predicates).
+func HasShiftWith(preds ...predicate.PosShift) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newShiftStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRefundedSale applies the HasEdge predicate on the "refunded_sale" edge.
+func HasRefundedSale() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RefundedSaleTable, RefundedSaleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefundedSaleWith applies the HasEdge predicate on the "refunded_sale" edge with a given conditions (other predicates).
+func HasRefundedSaleWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newRefundedSaleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRefunds applies the HasEdge predicate on the "refunds" edge.
+func HasRefunds() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefundsWith applies the HasEdge predicate on the "refunds" edge with a given conditions (other predicates).
+func HasRefundsWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newRefundsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Transaction) predicate.Transaction {
 	return predicate.Transaction(sql.AndPredicates(predicates...))
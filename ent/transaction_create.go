@@ -6,9 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sent/ent/customer"
 	"sent/ent/journalentry"
 	"sent/ent/ledgerentry"
+	"sent/ent/possaleline"
+	"sent/ent/posshift"
+	"sent/ent/postender"
 	"sent/ent/recording"
+	"sent/ent/schema"
+	"sent/ent/stockmovement"
 	"sent/ent/tenant"
 	"sent/ent/transaction"
 	"sent/ent/user"
@@ -74,6 +80,34 @@ func (_c *TransactionCreate) SetNillableTaxAmount(v *decimal.Decimal) *Transacti
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *TransactionCreate) SetCurrency(v string) *TransactionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCurrency(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *TransactionCreate) SetExchangeRate(v decimal.Decimal) *TransactionCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableExchangeRate(v *decimal.Decimal) *TransactionCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
 // SetType sets the "type" field.
 func (_c *TransactionCreate) SetType(v string) *TransactionCreate {
 	_c.mutation.SetType(v)
@@ -158,6 +192,40 @@ func (_c *TransactionCreate) SetNillableIsIntercompany(v *bool) *TransactionCrea
 	return _c
 }
 
+// SetClearanceStatus sets the "clearance_status" field.
+func (_c *TransactionCreate) SetClearanceStatus(v transaction.ClearanceStatus) *TransactionCreate {
+	_c.mutation.SetClearanceStatus(v)
+	return _c
+}
+
+// SetNillableClearanceStatus sets the "clearance_status" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableClearanceStatus(v *transaction.ClearanceStatus) *TransactionCreate {
+	if v != nil {
+		_c.SetClearanceStatus(*v)
+	}
+	return _c
+}
+
+// SetClearanceMessages sets the "clearance_messages" field.
+func (_c *TransactionCreate) SetClearanceMessages(v []schema.ClearanceMessage) *TransactionCreate {
+	_c.mutation.SetClearanceMessages(v)
+	return _c
+}
+
+// SetClearedAt sets the "cleared_at" field.
+func (_c *TransactionCreate) SetClearedAt(v time.Time) *TransactionCreate {
+	_c.mutation.SetClearedAt(v)
+	return _c
+}
+
+// SetNillableClearedAt sets the "cleared_at" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableClearedAt(v *time.Time) *TransactionCreate {
+	if v != nil {
+		_c.SetClearedAt(*v)
+	}
+	return _c
+}
+
 // SetTenantID sets the "tenant" edge to the Tenant entity by ID.
 func (_c *TransactionCreate) SetTenantID(id int) *TransactionCreate {
 	_c.mutation.SetTenantID(id)
@@ -223,6 +291,123 @@ func (_c *TransactionCreate) SetApprovedBy(v *User) *TransactionCreate {
 	return _c.SetApprovedByID(v.ID)
 }
 
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_c *TransactionCreate) AddStockMovementIDs(ids ...int) *TransactionCreate {
+	_c.mutation.AddStockMovementIDs(ids...)
+	return _c
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_c *TransactionCreate) AddStockMovements(v ...*StockMovement) *TransactionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStockMovementIDs(ids...)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_c *TransactionCreate) SetCustomerID(id int) *TransactionCreate {
+	_c.mutation.SetCustomerID(id)
+	return _c
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCustomerID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetCustomerID(*id)
+	}
+	return _c
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_c *TransactionCreate) SetCustomer(v *Customer) *TransactionCreate {
+	return _c.SetCustomerID(v.ID)
+}
+
+// AddPosLineIDs adds the "pos_lines" edge to the PosSaleLine entity by IDs.
+func (_c *TransactionCreate) AddPosLineIDs(ids ...int) *TransactionCreate {
+	_c.mutation.AddPosLineIDs(ids...)
+	return _c
+}
+
+// AddPosLines adds the "pos_lines" edges to the PosSaleLine entity.
+func (_c *TransactionCreate) AddPosLines(v ...*PosSaleLine) *TransactionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPosLineIDs(ids...)
+}
+
+// AddTenderIDs adds the "tenders" edge to the PosTender entity by IDs.
+func (_c *TransactionCreate) AddTenderIDs(ids ...int) *TransactionCreate {
+	_c.mutation.AddTenderIDs(ids...)
+	return _c
+}
+
+// AddTenders adds the "tenders" edges to the PosTender entity.
+func (_c *TransactionCreate) AddTenders(v ...*PosTender) *TransactionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTenderIDs(ids...)
+}
+
+// SetShiftID sets the "shift" edge to the PosShift entity by ID.
+func (_c *TransactionCreate) SetShiftID(id int) *TransactionCreate {
+	_c.mutation.SetShiftID(id)
+	return _c
+}
+
+// SetNillableShiftID sets the "shift" edge to the PosShift entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillableShiftID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetShiftID(*id)
+	}
+	return _c
+}
+
+// SetShift sets the "shift" edge to the PosShift entity.
+func (_c *TransactionCreate) SetShift(v *PosShift) *TransactionCreate {
+	return _c.SetShiftID(v.ID)
+}
+
+// SetRefundedSaleID sets the "refunded_sale" edge to the Transaction entity by ID.
+func (_c *TransactionCreate) SetRefundedSaleID(id int) *TransactionCreate {
+	_c.mutation.SetRefundedSaleID(id)
+	return _c
+}
+
+// SetNillableRefundedSaleID sets the "refunded_sale" edge to the Transaction entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillableRefundedSaleID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetRefundedSaleID(*id)
+	}
+	return _c
+}
+
+// SetRefundedSale sets the "refunded_sale" edge to the Transaction entity.
+func (_c *TransactionCreate) SetRefundedSale(v *Transaction) *TransactionCreate {
+	return _c.SetRefundedSaleID(v.ID)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Transaction entity by IDs.
+func (_c *TransactionCreate) AddRefundIDs(ids ...int) *TransactionCreate {
+	_c.mutation.AddRefundIDs(ids...)
+	return _c
+}
+
+// AddRefunds adds the "refunds" edges to the Transaction entity.
+func (_c *TransactionCreate) AddRefunds(v ...*Transaction) *TransactionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRefundIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_c *TransactionCreate) Mutation() *TransactionMutation {
 	return _c.mutation
@@ -270,6 +455,14 @@ func (_c *TransactionCreate) defaults() {
 		v := transaction.DefaultTaxAmount
 		_c.mutation.SetTaxAmount(v)
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := transaction.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		v := transaction.DefaultExchangeRate
+		_c.mutation.SetExchangeRate(v)
+	}
 	if _, ok := _c.mutation.UUID(); !ok {
 		v := transaction.DefaultUUID()
 		_c.mutation.SetUUID(v)
@@ -298,6 +491,12 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.TaxAmount(); !ok {
 		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "Transaction.tax_amount"`)}
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Transaction.currency"`)}
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "Transaction.exchange_rate"`)}
+	}
 	if _, ok := _c.mutation.UUID(); !ok {
 		return &ValidationError{Name: "uuid", err: errors.New(`ent: missing required field "Transaction.uuid"`)}
 	}
@@ -312,6 +511,11 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.IsIntercompany(); !ok {
 		return &ValidationError{Name: "is_intercompany", err: errors.New(`ent: missing required field "Transaction.is_intercompany"`)}
 	}
+	if v, ok := _c.mutation.ClearanceStatus(); ok {
+		if err := transaction.ClearanceStatusValidator(v); err != nil {
+			return &ValidationError{Name: "clearance_status", err: fmt.Errorf(`ent: validator failed for field "Transaction.clearance_status": %w`, err)}
+		}
+	}
 	if len(_c.mutation.TenantIDs()) == 0 {
 		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "Transaction.tenant"`)}
 	}
@@ -357,6 +561,14 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldTaxAmount, field.TypeOther, value)
 		_node.TaxAmount = value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeOther, value)
+		_node.ExchangeRate = value
+	}
 	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(transaction.FieldType, field.TypeString, value)
 		_node.Type = value
@@ -377,6 +589,18 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldIsIntercompany, field.TypeBool, value)
 		_node.IsIntercompany = value
 	}
+	if value, ok := _c.mutation.ClearanceStatus(); ok {
+		_spec.SetField(transaction.FieldClearanceStatus, field.TypeEnum, value)
+		_node.ClearanceStatus = &value
+	}
+	if value, ok := _c.mutation.ClearanceMessages(); ok {
+		_spec.SetField(transaction.FieldClearanceMessages, field.TypeJSON, value)
+		_node.ClearanceMessages = value
+	}
+	if value, ok := _c.mutation.ClearedAt(); ok {
+		_spec.SetField(transaction.FieldClearedAt, field.TypeTime, value)
+		_node.ClearedAt = &value
+	}
 	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -460,6 +684,121 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.transaction_approved_by = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.StockMovementsTable,
+			Columns: []string{transaction.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CustomerTable,
+			Columns: []string{transaction.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.customer_sales = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PosLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.PosLinesTable,
+			Columns: []string{transaction.PosLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TendersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.TendersTable,
+			Columns: []string{transaction.TendersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ShiftTable,
+			Columns: []string{transaction.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pos_shift_transactions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RefundedSaleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.RefundedSaleTable,
+			Columns: []string{transaction.RefundedSaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transaction_refunds = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.RefundsTable,
+			Columns: []string{transaction.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,10 +7,15 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"sent/ent/customer"
 	"sent/ent/journalentry"
 	"sent/ent/ledgerentry"
+	"sent/ent/possaleline"
+	"sent/ent/posshift"
+	"sent/ent/postender"
 	"sent/ent/predicate"
 	"sent/ent/recording"
+	"sent/ent/stockmovement"
 	"sent/ent/tenant"
 	"sent/ent/transaction"
 	"sent/ent/user"
@@ -33,6 +38,13 @@ type TransactionQuery struct {
 	withJournalEntries *JournalEntryQuery
 	withRecording      *RecordingQuery
 	withApprovedBy     *UserQuery
+	withStockMovements *StockMovementQuery
+	withCustomer       *CustomerQuery
+	withPosLines       *PosSaleLineQuery
+	withTenders        *PosTenderQuery
+	withShift          *PosShiftQuery
+	withRefundedSale   *TransactionQuery
+	withRefunds        *TransactionQuery
 	withFKs            bool
 	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -181,6 +193,160 @@ func (_q *TransactionQuery) QueryApprovedBy() *UserQuery {
 	return query
 }
 
+// QueryStockMovements chains the current query on the "stock_movements" edge.
+func (_q *TransactionQuery) QueryStockMovements() *StockMovementQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.StockMovementsTable, transaction.StockMovementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomer chains the current query on the "customer" edge.
+func (_q *TransactionQuery) QueryCustomer() *CustomerQuery {
+	query := (&CustomerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.CustomerTable, transaction.CustomerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPosLines chains the current query on the "pos_lines" edge.
+func (_q *TransactionQuery) QueryPosLines() *PosSaleLineQuery {
+	query := (&PosSaleLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(possaleline.Table, possaleline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.PosLinesTable, transaction.PosLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTenders chains the current query on the "tenders" edge.
+func (_q *TransactionQuery) QueryTenders() *PosTenderQuery {
+	query := (&PosTenderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(postender.Table, postender.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.TendersTable, transaction.TendersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShift chains the current query on the "shift" edge.
+func (_q *TransactionQuery) QueryShift() *PosShiftQuery {
+	query := (&PosShiftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(posshift.Table, posshift.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.ShiftTable, transaction.ShiftColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefundedSale chains the current query on the "refunded_sale" edge.
+func (_q *TransactionQuery) QueryRefundedSale() *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.RefundedSaleTable, transaction.RefundedSaleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefunds chains the current query on the "refunds" edge.
+func (_q *TransactionQuery) QueryRefunds() *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.RefundsTable, transaction.RefundsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Transaction entity from the query.
 // Returns a *NotFoundError when no Transaction was found.
 func (_q *TransactionQuery) First(ctx context.Context) (*Transaction, error) {
@@ -378,6 +544,13 @@ func (_q *TransactionQuery) Clone() *TransactionQuery {
 		withJournalEntries: _q.withJournalEntries.Clone(),
 		withRecording:      _q.withRecording.Clone(),
 		withApprovedBy:     _q.withApprovedBy.Clone(),
+		withStockMovements: _q.withStockMovements.Clone(),
+		withCustomer:       _q.withCustomer.Clone(),
+		withPosLines:       _q.withPosLines.Clone(),
+		withTenders:        _q.withTenders.Clone(),
+		withShift:          _q.withShift.Clone(),
+		withRefundedSale:   _q.withRefundedSale.Clone(),
+		withRefunds:        _q.withRefunds.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -440,6 +613,83 @@ func (_q *TransactionQuery) WithApprovedBy(opts ...func(*UserQuery)) *Transactio
 	return _q
 }
 
+// WithStockMovements tells the query-builder to eager-load the nodes that are connected to
+// the "stock_movements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithStockMovements(opts ...func(*StockMovementQuery)) *TransactionQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStockMovements = query
+	return _q
+}
+
+// WithCustomer tells the query-builder to eager-load the nodes that are connected to
+// the "customer" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithCustomer(opts ...func(*CustomerQuery)) *TransactionQuery {
+	query := (&CustomerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCustomer = query
+	return _q
+}
+
+// WithPosLines tells the query-builder to eager-load the nodes that are connected to
+// the "pos_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithPosLines(opts ...func(*PosSaleLineQuery)) *TransactionQuery {
+	query := (&PosSaleLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPosLines = query
+	return _q
+}
+
+// WithTenders tells the query-builder to eager-load the nodes that are connected to
+// the "tenders" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithTenders(opts ...func(*PosTenderQuery)) *TransactionQuery {
+	query := (&PosTenderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTenders = query
+	return _q
+}
+
+// WithShift tells the query-builder to eager-load the nodes that are connected to
+// the "shift" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithShift(opts ...func(*PosShiftQuery)) *TransactionQuery {
+	query := (&PosShiftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShift = query
+	return _q
+}
+
+// WithRefundedSale tells the query-builder to eager-load the nodes that are connected to
+// the "refunded_sale" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithRefundedSale(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRefundedSale = query
+	return _q
+}
+
+// WithRefunds tells the query-builder to eager-load the nodes that are connected to
+// the "refunds" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithRefunds(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRefunds = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -519,15 +769,22 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*Transaction{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [12]bool{
 			_q.withTenant != nil,
 			_q.withLedgerEntries != nil,
 			_q.withJournalEntries != nil,
 			_q.withRecording != nil,
 			_q.withApprovedBy != nil,
+			_q.withStockMovements != nil,
+			_q.withCustomer != nil,
+			_q.withPosLines != nil,
+			_q.withTenders != nil,
+			_q.withShift != nil,
+			_q.withRefundedSale != nil,
+			_q.withRefunds != nil,
 		}
 	)
-	if _q.withTenant != nil || _q.withApprovedBy != nil {
+	if _q.withTenant != nil || _q.withApprovedBy != nil || _q.withCustomer != nil || _q.withShift != nil || _q.withRefundedSale != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -586,6 +843,52 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withStockMovements; query != nil {
+		if err := _q.loadStockMovements(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.StockMovements = []*StockMovement{} },
+			func(n *Transaction, e *StockMovement) { n.Edges.StockMovements = append(n.Edges.StockMovements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCustomer; query != nil {
+		if err := _q.loadCustomer(ctx, query, nodes, nil,
+			func(n *Transaction, e *Customer) { n.Edges.Customer = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPosLines; query != nil {
+		if err := _q.loadPosLines(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.PosLines = []*PosSaleLine{} },
+			func(n *Transaction, e *PosSaleLine) { n.Edges.PosLines = append(n.Edges.PosLines, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTenders; query != nil {
+		if err := _q.loadTenders(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Tenders = []*PosTender{} },
+			func(n *Transaction, e *PosTender) { n.Edges.Tenders = append(n.Edges.Tenders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShift; query != nil {
+		if err := _q.loadShift(ctx, query, nodes, nil,
+			func(n *Transaction, e *PosShift) { n.Edges.Shift = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRefundedSale; query != nil {
+		if err := _q.loadRefundedSale(ctx, query, nodes, nil,
+			func(n *Transaction, e *Transaction) { n.Edges.RefundedSale = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRefunds; query != nil {
+		if err := _q.loadRefunds(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Refunds = []*Transaction{} },
+			func(n *Transaction, e *Transaction) { n.Edges.Refunds = append(n.Edges.Refunds, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -747,6 +1050,226 @@ func (_q *TransactionQuery) loadApprovedBy(ctx context.Context, query *UserQuery
 	}
 	return nil
 }
+func (_q *TransactionQuery) loadStockMovements(ctx context.Context, query *StockMovementQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *StockMovement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.StockMovementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.transaction_stock_movements
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "transaction_stock_movements" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_stock_movements" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadCustomer(ctx context.Context, query *CustomerQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Customer)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].customer_sales == nil {
+			continue
+		}
+		fk := *nodes[i].customer_sales
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(customer.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "customer_sales" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadPosLines(ctx context.Context, query *PosSaleLineQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *PosSaleLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PosSaleLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.PosLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.transaction_pos_lines
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "transaction_pos_lines" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_pos_lines" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadTenders(ctx context.Context, query *PosTenderQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *PosTender)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PosTender(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.TendersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.transaction_tenders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "transaction_tenders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_tenders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadShift(ctx context.Context, query *PosShiftQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *PosShift)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].pos_shift_transactions == nil {
+			continue
+		}
+		fk := *nodes[i].pos_shift_transactions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(posshift.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pos_shift_transactions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadRefundedSale(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].transaction_refunds == nil {
+			continue
+		}
+		fk := *nodes[i].transaction_refunds
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transaction_refunds" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadRefunds(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Transaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.RefundsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.transaction_refunds
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "transaction_refunds" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_refunds" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TransactionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
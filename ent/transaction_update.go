@@ -6,10 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sent/ent/customer"
 	"sent/ent/journalentry"
 	"sent/ent/ledgerentry"
+	"sent/ent/possaleline"
+	"sent/ent/posshift"
+	"sent/ent/postender"
 	"sent/ent/predicate"
 	"sent/ent/recording"
+	"sent/ent/schema"
+	"sent/ent/stockmovement"
 	"sent/ent/tenant"
 	"sent/ent/transaction"
 	"sent/ent/user"
@@ -17,6 +23,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/shopspring/decimal"
 )
@@ -91,6 +98,34 @@ func (_u *TransactionUpdate) SetNillableTaxAmount(v *decimal.Decimal) *Transacti
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *TransactionUpdate) SetCurrency(v string) *TransactionUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableCurrency(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *TransactionUpdate) SetExchangeRate(v decimal.Decimal) *TransactionUpdate {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableExchangeRate(v *decimal.Decimal) *TransactionUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
 // SetType sets the "type" field.
 func (_u *TransactionUpdate) SetType(v string) *TransactionUpdate {
 	_u.mutation.SetType(v)
@@ -193,6 +228,64 @@ func (_u *TransactionUpdate) SetNillableIsIntercompany(v *bool) *TransactionUpda
 	return _u
 }
 
+// SetClearanceStatus sets the "clearance_status" field.
+func (_u *TransactionUpdate) SetClearanceStatus(v transaction.ClearanceStatus) *TransactionUpdate {
+	_u.mutation.SetClearanceStatus(v)
+	return _u
+}
+
+// SetNillableClearanceStatus sets the "clearance_status" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableClearanceStatus(v *transaction.ClearanceStatus) *TransactionUpdate {
+	if v != nil {
+		_u.SetClearanceStatus(*v)
+	}
+	return _u
+}
+
+// ClearClearanceStatus clears the value of the "clearance_status" field.
+func (_u *TransactionUpdate) ClearClearanceStatus() *TransactionUpdate {
+	_u.mutation.ClearClearanceStatus()
+	return _u
+}
+
+// SetClearanceMessages sets the "clearance_messages" field.
+func (_u *TransactionUpdate) SetClearanceMessages(v []schema.ClearanceMessage) *TransactionUpdate {
+	_u.mutation.SetClearanceMessages(v)
+	return _u
+}
+
+// AppendClearanceMessages appends value to the "clearance_messages" field.
+func (_u *TransactionUpdate) AppendClearanceMessages(v []schema.ClearanceMessage) *TransactionUpdate {
+	_u.mutation.AppendClearanceMessages(v)
+	return _u
+}
+
+// ClearClearanceMessages clears the value of the "clearance_messages" field.
+func (_u *TransactionUpdate) ClearClearanceMessages() *TransactionUpdate {
+	_u.mutation.ClearClearanceMessages()
+	return _u
+}
+
+// SetClearedAt sets the "cleared_at" field.
+func (_u *TransactionUpdate) SetClearedAt(v time.Time) *TransactionUpdate {
+	_u.mutation.SetClearedAt(v)
+	return _u
+}
+
+// SetNillableClearedAt sets the "cleared_at" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableClearedAt(v *time.Time) *TransactionUpdate {
+	if v != nil {
+		_u.SetClearedAt(*v)
+	}
+	return _u
+}
+
+// ClearClearedAt clears the value of the "cleared_at" field.
+func (_u *TransactionUpdate) ClearClearedAt() *TransactionUpdate {
+	_u.mutation.ClearClearedAt()
+	return _u
+}
+
 // SetTenantID sets the "tenant" edge to the Tenant entity by ID.
 func (_u *TransactionUpdate) SetTenantID(id int) *TransactionUpdate {
 	_u.mutation.SetTenantID(id)
@@ -258,6 +351,123 @@ func (_u *TransactionUpdate) SetApprovedBy(v *User) *TransactionUpdate {
 	return _u.SetApprovedByID(v.ID)
 }
 
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *TransactionUpdate) AddStockMovementIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.AddStockMovementIDs(ids...)
+	return _u
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *TransactionUpdate) AddStockMovements(v ...*StockMovement) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockMovementIDs(ids...)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_u *TransactionUpdate) SetCustomerID(id int) *TransactionUpdate {
+	_u.mutation.SetCustomerID(id)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableCustomerID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetCustomerID(*id)
+	}
+	return _u
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_u *TransactionUpdate) SetCustomer(v *Customer) *TransactionUpdate {
+	return _u.SetCustomerID(v.ID)
+}
+
+// AddPosLineIDs adds the "pos_lines" edge to the PosSaleLine entity by IDs.
+func (_u *TransactionUpdate) AddPosLineIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.AddPosLineIDs(ids...)
+	return _u
+}
+
+// AddPosLines adds the "pos_lines" edges to the PosSaleLine entity.
+func (_u *TransactionUpdate) AddPosLines(v ...*PosSaleLine) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPosLineIDs(ids...)
+}
+
+// AddTenderIDs adds the "tenders" edge to the PosTender entity by IDs.
+func (_u *TransactionUpdate) AddTenderIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.AddTenderIDs(ids...)
+	return _u
+}
+
+// AddTenders adds the "tenders" edges to the PosTender entity.
+func (_u *TransactionUpdate) AddTenders(v ...*PosTender) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTenderIDs(ids...)
+}
+
+// SetShiftID sets the "shift" edge to the PosShift entity by ID.
+func (_u *TransactionUpdate) SetShiftID(id int) *TransactionUpdate {
+	_u.mutation.SetShiftID(id)
+	return _u
+}
+
+// SetNillableShiftID sets the "shift" edge to the PosShift entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableShiftID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetShiftID(*id)
+	}
+	return _u
+}
+
+// SetShift sets the "shift" edge to the PosShift entity.
+func (_u *TransactionUpdate) SetShift(v *PosShift) *TransactionUpdate {
+	return _u.SetShiftID(v.ID)
+}
+
+// SetRefundedSaleID sets the "refunded_sale" edge to the Transaction entity by ID.
+func (_u *TransactionUpdate) SetRefundedSaleID(id int) *TransactionUpdate {
+	_u.mutation.SetRefundedSaleID(id)
+	return _u
+}
+
+// SetNillableRefundedSaleID sets the "refunded_sale" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableRefundedSaleID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetRefundedSaleID(*id)
+	}
+	return _u
+}
+
+// SetRefundedSale sets the "refunded_sale" edge to the Transaction entity.
+func (_u *TransactionUpdate) SetRefundedSale(v *Transaction) *TransactionUpdate {
+	return _u.SetRefundedSaleID(v.ID)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Transaction entity by IDs.
+func (_u *TransactionUpdate) AddRefundIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the Transaction entity.
+func (_u *TransactionUpdate) AddRefunds(v ...*Transaction) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_u *TransactionUpdate) Mutation() *TransactionMutation {
 	return _u.mutation
@@ -323,6 +533,108 @@ func (_u *TransactionUpdate) ClearApprovedBy() *TransactionUpdate {
 	return _u
 }
 
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *TransactionUpdate) ClearStockMovements() *TransactionUpdate {
+	_u.mutation.ClearStockMovements()
+	return _u
+}
+
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *TransactionUpdate) RemoveStockMovementIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.RemoveStockMovementIDs(ids...)
+	return _u
+}
+
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *TransactionUpdate) RemoveStockMovements(v ...*StockMovement) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockMovementIDs(ids...)
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (_u *TransactionUpdate) ClearCustomer() *TransactionUpdate {
+	_u.mutation.ClearCustomer()
+	return _u
+}
+
+// ClearPosLines clears all "pos_lines" edges to the PosSaleLine entity.
+func (_u *TransactionUpdate) ClearPosLines() *TransactionUpdate {
+	_u.mutation.ClearPosLines()
+	return _u
+}
+
+// RemovePosLineIDs removes the "pos_lines" edge to PosSaleLine entities by IDs.
+func (_u *TransactionUpdate) RemovePosLineIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.RemovePosLineIDs(ids...)
+	return _u
+}
+
+// RemovePosLines removes "pos_lines" edges to PosSaleLine entities.
+func (_u *TransactionUpdate) RemovePosLines(v ...*PosSaleLine) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePosLineIDs(ids...)
+}
+
+// ClearTenders clears all "tenders" edges to the PosTender entity.
+func (_u *TransactionUpdate) ClearTenders() *TransactionUpdate {
+	_u.mutation.ClearTenders()
+	return _u
+}
+
+// RemoveTenderIDs removes the "tenders" edge to PosTender entities by IDs.
+func (_u *TransactionUpdate) RemoveTenderIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.RemoveTenderIDs(ids...)
+	return _u
+}
+
+// RemoveTenders removes "tenders" edges to PosTender entities.
+func (_u *TransactionUpdate) RemoveTenders(v ...*PosTender) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTenderIDs(ids...)
+}
+
+// ClearShift clears the "shift" edge to the PosShift entity.
+func (_u *TransactionUpdate) ClearShift() *TransactionUpdate {
+	_u.mutation.ClearShift()
+	return _u
+}
+
+// ClearRefundedSale clears the "refunded_sale" edge to the Transaction entity.
+func (_u *TransactionUpdate) ClearRefundedSale() *TransactionUpdate {
+	_u.mutation.ClearRefundedSale()
+	return _u
+}
+
+// ClearRefunds clears all "refunds" edges to the Transaction entity.
+func (_u *TransactionUpdate) ClearRefunds() *TransactionUpdate {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Transaction entities by IDs.
+func (_u *TransactionUpdate) RemoveRefundIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.RemoveRefundIDs(ids...)
+	return _u
+}
+
+// RemoveRefunds removes "refunds" edges to Transaction entities.
+func (_u *TransactionUpdate) RemoveRefunds(v ...*Transaction) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TransactionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -357,6 +669,11 @@ func (_u *TransactionUpdate) check() error {
 			return &ValidationError{Name: "approval_status", err: fmt.Errorf(`ent: validator failed for field "Transaction.approval_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ClearanceStatus(); ok {
+		if err := transaction.ClearanceStatusValidator(v); err != nil {
+			return &ValidationError{Name: "clearance_status", err: fmt.Errorf(`ent: validator failed for field "Transaction.clearance_status": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Transaction.tenant"`)
 	}
@@ -393,6 +710,12 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.TaxAmount(); ok {
 		_spec.SetField(transaction.FieldTaxAmount, field.TypeOther, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeOther, value)
+	}
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(transaction.FieldType, field.TypeString, value)
 	}
@@ -414,6 +737,29 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.IsIntercompany(); ok {
 		_spec.SetField(transaction.FieldIsIntercompany, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ClearanceStatus(); ok {
+		_spec.SetField(transaction.FieldClearanceStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ClearanceStatusCleared() {
+		_spec.ClearField(transaction.FieldClearanceStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ClearanceMessages(); ok {
+		_spec.SetField(transaction.FieldClearanceMessages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClearanceMessages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transaction.FieldClearanceMessages, value)
+		})
+	}
+	if _u.mutation.ClearanceMessagesCleared() {
+		_spec.ClearField(transaction.FieldClearanceMessages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClearedAt(); ok {
+		_spec.SetField(transaction.FieldClearedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClearedAtCleared() {
+		_spec.ClearField(transaction.FieldClearedAt, field.TypeTime)
+	}
 	if _u.mutation.TenantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -591,56 +937,323 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{transaction.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.StockMovementsTable,
+			Columns: []string{transaction.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// TransactionUpdateOne is the builder for updating a single Transaction entity.
-type TransactionUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *TransactionMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetDescription sets the "description" field.
-func (_u *TransactionUpdateOne) SetDescription(v string) *TransactionUpdateOne {
-	_u.mutation.SetDescription(v)
-	return _u
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableDescription(v *string) *TransactionUpdateOne {
-	if v != nil {
-		_u.SetDescription(*v)
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.StockMovementsTable,
+			Columns: []string{transaction.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDate sets the "date" field.
-func (_u *TransactionUpdateOne) SetDate(v time.Time) *TransactionUpdateOne {
-	_u.mutation.SetDate(v)
-	return _u
-}
-
-// SetNillableDate sets the "date" field if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableDate(v *time.Time) *TransactionUpdateOne {
-	if v != nil {
-		_u.SetDate(*v)
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.StockMovementsTable,
+			Columns: []string{transaction.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
+	if _u.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CustomerTable,
+			Columns: []string{transaction.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CustomerTable,
+			Columns: []string{transaction.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PosLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.PosLinesTable,
+			Columns: []string{transaction.PosLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPosLinesIDs(); len(nodes) > 0 && !_u.mutation.PosLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.PosLinesTable,
+			Columns: []string{transaction.PosLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PosLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.PosLinesTable,
+			Columns: []string{transaction.PosLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TendersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.TendersTable,
+			Columns: []string{transaction.TendersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTendersIDs(); len(nodes) > 0 && !_u.mutation.TendersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.TendersTable,
+			Columns: []string{transaction.TendersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TendersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.TendersTable,
+			Columns: []string{transaction.TendersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShiftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ShiftTable,
+			Columns: []string{transaction.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ShiftTable,
+			Columns: []string{transaction.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RefundedSaleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.RefundedSaleTable,
+			Columns: []string{transaction.RefundedSaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundedSaleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.RefundedSaleTable,
+			Columns: []string{transaction.RefundedSaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.RefundsTable,
+			Columns: []string{transaction.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.RefundsTable,
+			Columns: []string{transaction.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.RefundsTable,
+			Columns: []string{transaction.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransactionUpdateOne is the builder for updating a single Transaction entity.
+type TransactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDescription sets the "description" field.
+func (_u *TransactionUpdateOne) SetDescription(v string) *TransactionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableDescription(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *TransactionUpdateOne) SetDate(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableDate(v *time.Time) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
 // SetTotalAmount sets the "total_amount" field.
 func (_u *TransactionUpdateOne) SetTotalAmount(v decimal.Decimal) *TransactionUpdateOne {
 	_u.mutation.SetTotalAmount(v)
@@ -669,6 +1282,34 @@ func (_u *TransactionUpdateOne) SetNillableTaxAmount(v *decimal.Decimal) *Transa
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *TransactionUpdateOne) SetCurrency(v string) *TransactionUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCurrency(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *TransactionUpdateOne) SetExchangeRate(v decimal.Decimal) *TransactionUpdateOne {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableExchangeRate(v *decimal.Decimal) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
 // SetType sets the "type" field.
 func (_u *TransactionUpdateOne) SetType(v string) *TransactionUpdateOne {
 	_u.mutation.SetType(v)
@@ -771,6 +1412,64 @@ func (_u *TransactionUpdateOne) SetNillableIsIntercompany(v *bool) *TransactionU
 	return _u
 }
 
+// SetClearanceStatus sets the "clearance_status" field.
+func (_u *TransactionUpdateOne) SetClearanceStatus(v transaction.ClearanceStatus) *TransactionUpdateOne {
+	_u.mutation.SetClearanceStatus(v)
+	return _u
+}
+
+// SetNillableClearanceStatus sets the "clearance_status" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableClearanceStatus(v *transaction.ClearanceStatus) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetClearanceStatus(*v)
+	}
+	return _u
+}
+
+// ClearClearanceStatus clears the value of the "clearance_status" field.
+func (_u *TransactionUpdateOne) ClearClearanceStatus() *TransactionUpdateOne {
+	_u.mutation.ClearClearanceStatus()
+	return _u
+}
+
+// SetClearanceMessages sets the "clearance_messages" field.
+func (_u *TransactionUpdateOne) SetClearanceMessages(v []schema.ClearanceMessage) *TransactionUpdateOne {
+	_u.mutation.SetClearanceMessages(v)
+	return _u
+}
+
+// AppendClearanceMessages appends value to the "clearance_messages" field.
+func (_u *TransactionUpdateOne) AppendClearanceMessages(v []schema.ClearanceMessage) *TransactionUpdateOne {
+	_u.mutation.AppendClearanceMessages(v)
+	return _u
+}
+
+// ClearClearanceMessages clears the value of the "clearance_messages" field.
+func (_u *TransactionUpdateOne) ClearClearanceMessages() *TransactionUpdateOne {
+	_u.mutation.ClearClearanceMessages()
+	return _u
+}
+
+// SetClearedAt sets the "cleared_at" field.
+func (_u *TransactionUpdateOne) SetClearedAt(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetClearedAt(v)
+	return _u
+}
+
+// SetNillableClearedAt sets the "cleared_at" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableClearedAt(v *time.Time) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetClearedAt(*v)
+	}
+	return _u
+}
+
+// ClearClearedAt clears the value of the "cleared_at" field.
+func (_u *TransactionUpdateOne) ClearClearedAt() *TransactionUpdateOne {
+	_u.mutation.ClearClearedAt()
+	return _u
+}
+
 // SetTenantID sets the "tenant" edge to the Tenant entity by ID.
 func (_u *TransactionUpdateOne) SetTenantID(id int) *TransactionUpdateOne {
 	_u.mutation.SetTenantID(id)
@@ -831,76 +1530,295 @@ func (_u *TransactionUpdateOne) SetNillableApprovedByID(id *int) *TransactionUpd
 	return _u
 }
 
-// SetApprovedBy sets the "approved_by" edge to the User entity.
-func (_u *TransactionUpdateOne) SetApprovedBy(v *User) *TransactionUpdateOne {
-	return _u.SetApprovedByID(v.ID)
+// SetApprovedBy sets the "approved_by" edge to the User entity.
+func (_u *TransactionUpdateOne) SetApprovedBy(v *User) *TransactionUpdateOne {
+	return _u.SetApprovedByID(v.ID)
+}
+
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *TransactionUpdateOne) AddStockMovementIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.AddStockMovementIDs(ids...)
+	return _u
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *TransactionUpdateOne) AddStockMovements(v ...*StockMovement) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockMovementIDs(ids...)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (_u *TransactionUpdateOne) SetCustomerID(id int) *TransactionUpdateOne {
+	_u.mutation.SetCustomerID(id)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCustomerID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetCustomerID(*id)
+	}
+	return _u
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (_u *TransactionUpdateOne) SetCustomer(v *Customer) *TransactionUpdateOne {
+	return _u.SetCustomerID(v.ID)
+}
+
+// AddPosLineIDs adds the "pos_lines" edge to the PosSaleLine entity by IDs.
+func (_u *TransactionUpdateOne) AddPosLineIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.AddPosLineIDs(ids...)
+	return _u
+}
+
+// AddPosLines adds the "pos_lines" edges to the PosSaleLine entity.
+func (_u *TransactionUpdateOne) AddPosLines(v ...*PosSaleLine) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPosLineIDs(ids...)
+}
+
+// AddTenderIDs adds the "tenders" edge to the PosTender entity by IDs.
+func (_u *TransactionUpdateOne) AddTenderIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.AddTenderIDs(ids...)
+	return _u
+}
+
+// AddTenders adds the "tenders" edges to the PosTender entity.
+func (_u *TransactionUpdateOne) AddTenders(v ...*PosTender) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTenderIDs(ids...)
+}
+
+// SetShiftID sets the "shift" edge to the PosShift entity by ID.
+func (_u *TransactionUpdateOne) SetShiftID(id int) *TransactionUpdateOne {
+	_u.mutation.SetShiftID(id)
+	return _u
+}
+
+// SetNillableShiftID sets the "shift" edge to the PosShift entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableShiftID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetShiftID(*id)
+	}
+	return _u
+}
+
+// SetShift sets the "shift" edge to the PosShift entity.
+func (_u *TransactionUpdateOne) SetShift(v *PosShift) *TransactionUpdateOne {
+	return _u.SetShiftID(v.ID)
+}
+
+// SetRefundedSaleID sets the "refunded_sale" edge to the Transaction entity by ID.
+func (_u *TransactionUpdateOne) SetRefundedSaleID(id int) *TransactionUpdateOne {
+	_u.mutation.SetRefundedSaleID(id)
+	return _u
+}
+
+// SetNillableRefundedSaleID sets the "refunded_sale" edge to the Transaction entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableRefundedSaleID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetRefundedSaleID(*id)
+	}
+	return _u
+}
+
+// SetRefundedSale sets the "refunded_sale" edge to the Transaction entity.
+func (_u *TransactionUpdateOne) SetRefundedSale(v *Transaction) *TransactionUpdateOne {
+	return _u.SetRefundedSaleID(v.ID)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Transaction entity by IDs.
+func (_u *TransactionUpdateOne) AddRefundIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the Transaction entity.
+func (_u *TransactionUpdateOne) AddRefunds(v ...*Transaction) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// Mutation returns the TransactionMutation object of the builder.
+func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TransactionUpdateOne) ClearTenant() *TransactionUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearLedgerEntries clears all "ledger_entries" edges to the LedgerEntry entity.
+func (_u *TransactionUpdateOne) ClearLedgerEntries() *TransactionUpdateOne {
+	_u.mutation.ClearLedgerEntries()
+	return _u
+}
+
+// RemoveLedgerEntryIDs removes the "ledger_entries" edge to LedgerEntry entities by IDs.
+func (_u *TransactionUpdateOne) RemoveLedgerEntryIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemoveLedgerEntryIDs(ids...)
+	return _u
+}
+
+// RemoveLedgerEntries removes "ledger_entries" edges to LedgerEntry entities.
+func (_u *TransactionUpdateOne) RemoveLedgerEntries(v ...*LedgerEntry) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLedgerEntryIDs(ids...)
+}
+
+// ClearJournalEntries clears all "journal_entries" edges to the JournalEntry entity.
+func (_u *TransactionUpdateOne) ClearJournalEntries() *TransactionUpdateOne {
+	_u.mutation.ClearJournalEntries()
+	return _u
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to JournalEntry entities by IDs.
+func (_u *TransactionUpdateOne) RemoveJournalEntryIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemoveJournalEntryIDs(ids...)
+	return _u
+}
+
+// RemoveJournalEntries removes "journal_entries" edges to JournalEntry entities.
+func (_u *TransactionUpdateOne) RemoveJournalEntries(v ...*JournalEntry) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJournalEntryIDs(ids...)
+}
+
+// ClearRecording clears the "recording" edge to the Recording entity.
+func (_u *TransactionUpdateOne) ClearRecording() *TransactionUpdateOne {
+	_u.mutation.ClearRecording()
+	return _u
+}
+
+// ClearApprovedBy clears the "approved_by" edge to the User entity.
+func (_u *TransactionUpdateOne) ClearApprovedBy() *TransactionUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *TransactionUpdateOne) ClearStockMovements() *TransactionUpdateOne {
+	_u.mutation.ClearStockMovements()
+	return _u
+}
+
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *TransactionUpdateOne) RemoveStockMovementIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemoveStockMovementIDs(ids...)
+	return _u
 }
 
-// Mutation returns the TransactionMutation object of the builder.
-func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
-	return _u.mutation
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *TransactionUpdateOne) RemoveStockMovements(v ...*StockMovement) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockMovementIDs(ids...)
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (_u *TransactionUpdateOne) ClearTenant() *TransactionUpdateOne {
-	_u.mutation.ClearTenant()
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (_u *TransactionUpdateOne) ClearCustomer() *TransactionUpdateOne {
+	_u.mutation.ClearCustomer()
 	return _u
 }
 
-// ClearLedgerEntries clears all "ledger_entries" edges to the LedgerEntry entity.
-func (_u *TransactionUpdateOne) ClearLedgerEntries() *TransactionUpdateOne {
-	_u.mutation.ClearLedgerEntries()
+// ClearPosLines clears all "pos_lines" edges to the PosSaleLine entity.
+func (_u *TransactionUpdateOne) ClearPosLines() *TransactionUpdateOne {
+	_u.mutation.ClearPosLines()
 	return _u
 }
 
-// RemoveLedgerEntryIDs removes the "ledger_entries" edge to LedgerEntry entities by IDs.
-func (_u *TransactionUpdateOne) RemoveLedgerEntryIDs(ids ...int) *TransactionUpdateOne {
-	_u.mutation.RemoveLedgerEntryIDs(ids...)
+// RemovePosLineIDs removes the "pos_lines" edge to PosSaleLine entities by IDs.
+func (_u *TransactionUpdateOne) RemovePosLineIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemovePosLineIDs(ids...)
 	return _u
 }
 
-// RemoveLedgerEntries removes "ledger_entries" edges to LedgerEntry entities.
-func (_u *TransactionUpdateOne) RemoveLedgerEntries(v ...*LedgerEntry) *TransactionUpdateOne {
+// RemovePosLines removes "pos_lines" edges to PosSaleLine entities.
+func (_u *TransactionUpdateOne) RemovePosLines(v ...*PosSaleLine) *TransactionUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLedgerEntryIDs(ids...)
+	return _u.RemovePosLineIDs(ids...)
 }
 
-// ClearJournalEntries clears all "journal_entries" edges to the JournalEntry entity.
-func (_u *TransactionUpdateOne) ClearJournalEntries() *TransactionUpdateOne {
-	_u.mutation.ClearJournalEntries()
+// ClearTenders clears all "tenders" edges to the PosTender entity.
+func (_u *TransactionUpdateOne) ClearTenders() *TransactionUpdateOne {
+	_u.mutation.ClearTenders()
 	return _u
 }
 
-// RemoveJournalEntryIDs removes the "journal_entries" edge to JournalEntry entities by IDs.
-func (_u *TransactionUpdateOne) RemoveJournalEntryIDs(ids ...int) *TransactionUpdateOne {
-	_u.mutation.RemoveJournalEntryIDs(ids...)
+// RemoveTenderIDs removes the "tenders" edge to PosTender entities by IDs.
+func (_u *TransactionUpdateOne) RemoveTenderIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemoveTenderIDs(ids...)
 	return _u
 }
 
-// RemoveJournalEntries removes "journal_entries" edges to JournalEntry entities.
-func (_u *TransactionUpdateOne) RemoveJournalEntries(v ...*JournalEntry) *TransactionUpdateOne {
+// RemoveTenders removes "tenders" edges to PosTender entities.
+func (_u *TransactionUpdateOne) RemoveTenders(v ...*PosTender) *TransactionUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveJournalEntryIDs(ids...)
+	return _u.RemoveTenderIDs(ids...)
 }
 
-// ClearRecording clears the "recording" edge to the Recording entity.
-func (_u *TransactionUpdateOne) ClearRecording() *TransactionUpdateOne {
-	_u.mutation.ClearRecording()
+// ClearShift clears the "shift" edge to the PosShift entity.
+func (_u *TransactionUpdateOne) ClearShift() *TransactionUpdateOne {
+	_u.mutation.ClearShift()
 	return _u
 }
 
-// ClearApprovedBy clears the "approved_by" edge to the User entity.
-func (_u *TransactionUpdateOne) ClearApprovedBy() *TransactionUpdateOne {
-	_u.mutation.ClearApprovedBy()
+// ClearRefundedSale clears the "refunded_sale" edge to the Transaction entity.
+func (_u *TransactionUpdateOne) ClearRefundedSale() *TransactionUpdateOne {
+	_u.mutation.ClearRefundedSale()
+	return _u
+}
+
+// ClearRefunds clears all "refunds" edges to the Transaction entity.
+func (_u *TransactionUpdateOne) ClearRefunds() *TransactionUpdateOne {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Transaction entities by IDs.
+func (_u *TransactionUpdateOne) RemoveRefundIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemoveRefundIDs(ids...)
 	return _u
 }
 
+// RemoveRefunds removes "refunds" edges to Transaction entities.
+func (_u *TransactionUpdateOne) RemoveRefunds(v ...*Transaction) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
 // Where appends a list predicates to the TransactionUpdate builder.
 func (_u *TransactionUpdateOne) Where(ps ...predicate.Transaction) *TransactionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -948,6 +1866,11 @@ func (_u *TransactionUpdateOne) check() error {
 			return &ValidationError{Name: "approval_status", err: fmt.Errorf(`ent: validator failed for field "Transaction.approval_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ClearanceStatus(); ok {
+		if err := transaction.ClearanceStatusValidator(v); err != nil {
+			return &ValidationError{Name: "clearance_status", err: fmt.Errorf(`ent: validator failed for field "Transaction.clearance_status": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Transaction.tenant"`)
 	}
@@ -1001,6 +1924,12 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.TaxAmount(); ok {
 		_spec.SetField(transaction.FieldTaxAmount, field.TypeOther, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeOther, value)
+	}
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(transaction.FieldType, field.TypeString, value)
 	}
@@ -1022,6 +1951,29 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.IsIntercompany(); ok {
 		_spec.SetField(transaction.FieldIsIntercompany, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ClearanceStatus(); ok {
+		_spec.SetField(transaction.FieldClearanceStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ClearanceStatusCleared() {
+		_spec.ClearField(transaction.FieldClearanceStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ClearanceMessages(); ok {
+		_spec.SetField(transaction.FieldClearanceMessages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClearanceMessages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transaction.FieldClearanceMessages, value)
+		})
+	}
+	if _u.mutation.ClearanceMessagesCleared() {
+		_spec.ClearField(transaction.FieldClearanceMessages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClearedAt(); ok {
+		_spec.SetField(transaction.FieldClearedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClearedAtCleared() {
+		_spec.ClearField(transaction.FieldClearedAt, field.TypeTime)
+	}
 	if _u.mutation.TenantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1199,6 +2151,273 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.StockMovementsTable,
+			Columns: []string{transaction.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.StockMovementsTable,
+			Columns: []string{transaction.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.StockMovementsTable,
+			Columns: []string{transaction.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CustomerTable,
+			Columns: []string{transaction.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CustomerTable,
+			Columns: []string{transaction.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PosLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.PosLinesTable,
+			Columns: []string{transaction.PosLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPosLinesIDs(); len(nodes) > 0 && !_u.mutation.PosLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.PosLinesTable,
+			Columns: []string{transaction.PosLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PosLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.PosLinesTable,
+			Columns: []string{transaction.PosLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(possaleline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TendersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.TendersTable,
+			Columns: []string{transaction.TendersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTendersIDs(); len(nodes) > 0 && !_u.mutation.TendersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.TendersTable,
+			Columns: []string{transaction.TendersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TendersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.TendersTable,
+			Columns: []string{transaction.TendersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postender.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShiftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ShiftTable,
+			Columns: []string{transaction.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ShiftTable,
+			Columns: []string{transaction.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RefundedSaleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.RefundedSaleTable,
+			Columns: []string{transaction.RefundedSaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundedSaleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.RefundedSaleTable,
+			Columns: []string{transaction.RefundedSaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.RefundsTable,
+			Columns: []string{transaction.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.RefundsTable,
+			Columns: []string{transaction.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.RefundsTable,
+			Columns: []string{transaction.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Transaction{config: _u.config}
 	_spec.Assign = _node.assignValues
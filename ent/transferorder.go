@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/tenant"
+	"sent/ent/transferorder"
+	"sent/ent/warehouse"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TransferOrder is the model entity for the TransferOrder schema.
+type TransferOrder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// Status holds the value of the "status" field.
+	Status transferorder.Status `json:"status,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// ShippedAt holds the value of the "shipped_at" field.
+	ShippedAt *time.Time `json:"shipped_at,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TransferOrderQuery when eager-loading is set.
+	Edges                   TransferOrderEdges `json:"edges"`
+	tenant_transfer_orders  *int
+	warehouse_transfers_out *int
+	warehouse_transfers_in  *int
+	selectValues            sql.SelectValues
+}
+
+// TransferOrderEdges holds the relations/edges for other nodes in the graph.
+type TransferOrderEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Source holds the value of the source edge.
+	Source *Warehouse `json:"source,omitempty"`
+	// Destination holds the value of the destination edge.
+	Destination *Warehouse `json:"destination,omitempty"`
+	// Lines holds the value of the lines edge.
+	Lines []*TransferOrderLine `json:"lines,omitempty"`
+	// Movements holds the value of the movements edge.
+	Movements []*StockMovement `json:"movements,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferOrderEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferOrderEdges) SourceOrErr() (*Warehouse, error) {
+	if e.Source != nil {
+		return e.Source, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
+// DestinationOrErr returns the Destination value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferOrderEdges) DestinationOrErr() (*Warehouse, error) {
+	if e.Destination != nil {
+		return e.Destination, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "destination"}
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransferOrderEdges) LinesOrErr() ([]*TransferOrderLine, error) {
+	if e.loadedTypes[3] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransferOrderEdges) MovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[4] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TransferOrder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transferorder.FieldID:
+			values[i] = new(sql.NullInt64)
+		case transferorder.FieldNumber, transferorder.FieldStatus, transferorder.FieldNotes, transferorder.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case transferorder.FieldShippedAt, transferorder.FieldReceivedAt, transferorder.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case transferorder.ForeignKeys[0]: // tenant_transfer_orders
+			values[i] = new(sql.NullInt64)
+		case transferorder.ForeignKeys[1]: // warehouse_transfers_out
+			values[i] = new(sql.NullInt64)
+		case transferorder.ForeignKeys[2]: // warehouse_transfers_in
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TransferOrder fields.
+func (_m *TransferOrder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transferorder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case transferorder.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case transferorder.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = transferorder.Status(value.String)
+			}
+		case transferorder.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case transferorder.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case transferorder.FieldShippedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field shipped_at", values[i])
+			} else if value.Valid {
+				_m.ShippedAt = new(time.Time)
+				*_m.ShippedAt = value.Time
+			}
+		case transferorder.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = new(time.Time)
+				*_m.ReceivedAt = value.Time
+			}
+		case transferorder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case transferorder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tenant_transfer_orders", value)
+			} else if value.Valid {
+				_m.tenant_transfer_orders = new(int)
+				*_m.tenant_transfer_orders = int(value.Int64)
+			}
+		case transferorder.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_transfers_out", value)
+			} else if value.Valid {
+				_m.warehouse_transfers_out = new(int)
+				*_m.warehouse_transfers_out = int(value.Int64)
+			}
+		case transferorder.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_transfers_in", value)
+			} else if value.Valid {
+				_m.warehouse_transfers_in = new(int)
+				*_m.warehouse_transfers_in = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TransferOrder.
+// This includes values selected through modifiers, order, etc.
+func (_m *TransferOrder) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the TransferOrder entity.
+func (_m *TransferOrder) QueryTenant() *TenantQuery {
+	return NewTransferOrderClient(_m.config).QueryTenant(_m)
+}
+
+// QuerySource queries the "source" edge of the TransferOrder entity.
+func (_m *TransferOrder) QuerySource() *WarehouseQuery {
+	return NewTransferOrderClient(_m.config).QuerySource(_m)
+}
+
+// QueryDestination queries the "destination" edge of the TransferOrder entity.
+func (_m *TransferOrder) QueryDestination() *WarehouseQuery {
+	return NewTransferOrderClient(_m.config).QueryDestination(_m)
+}
+
+// QueryLines queries the "lines" edge of the TransferOrder entity.
+func (_m *TransferOrder) QueryLines() *TransferOrderLineQuery {
+	return NewTransferOrderClient(_m.config).QueryLines(_m)
+}
+
+// QueryMovements queries the "movements" edge of the TransferOrder entity.
+func (_m *TransferOrder) QueryMovements() *StockMovementQuery {
+	return NewTransferOrderClient(_m.config).QueryMovements(_m)
+}
+
+// Update returns a builder for updating this TransferOrder.
+// Note that you need to call TransferOrder.Unwrap() before calling this method if this TransferOrder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TransferOrder) Update() *TransferOrderUpdateOne {
+	return NewTransferOrderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TransferOrder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TransferOrder) Unwrap() *TransferOrder {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TransferOrder is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TransferOrder) String() string {
+	var builder strings.Builder
+	builder.WriteString("TransferOrder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	if v := _m.ShippedAt; v != nil {
+		builder.WriteString("shipped_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReceivedAt; v != nil {
+		builder.WriteString("received_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TransferOrders is a parsable slice of TransferOrder.
+type TransferOrders []*TransferOrder
@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/stockmovement"
+	"sent/ent/tenant"
+	"sent/ent/transferorder"
+	"sent/ent/transferorderline"
+	"sent/ent/warehouse"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferOrderCreate is the builder for creating a TransferOrder entity.
+type TransferOrderCreate struct {
+	config
+	mutation *TransferOrderMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (_c *TransferOrderCreate) SetNumber(v string) *TransferOrderCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TransferOrderCreate) SetStatus(v transferorder.Status) *TransferOrderCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TransferOrderCreate) SetNillableStatus(v *transferorder.Status) *TransferOrderCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *TransferOrderCreate) SetNotes(v string) *TransferOrderCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *TransferOrderCreate) SetNillableNotes(v *string) *TransferOrderCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *TransferOrderCreate) SetCreatedBy(v string) *TransferOrderCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *TransferOrderCreate) SetNillableCreatedBy(v *string) *TransferOrderCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (_c *TransferOrderCreate) SetShippedAt(v time.Time) *TransferOrderCreate {
+	_c.mutation.SetShippedAt(v)
+	return _c
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (_c *TransferOrderCreate) SetNillableShippedAt(v *time.Time) *TransferOrderCreate {
+	if v != nil {
+		_c.SetShippedAt(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *TransferOrderCreate) SetReceivedAt(v time.Time) *TransferOrderCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *TransferOrderCreate) SetNillableReceivedAt(v *time.Time) *TransferOrderCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TransferOrderCreate) SetCreatedAt(v time.Time) *TransferOrderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TransferOrderCreate) SetNillableCreatedAt(v *time.Time) *TransferOrderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_c *TransferOrderCreate) SetTenantID(id int) *TransferOrderCreate {
+	_c.mutation.SetTenantID(id)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *TransferOrderCreate) SetTenant(v *Tenant) *TransferOrderCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetSourceID sets the "source" edge to the Warehouse entity by ID.
+func (_c *TransferOrderCreate) SetSourceID(id int) *TransferOrderCreate {
+	_c.mutation.SetSourceID(id)
+	return _c
+}
+
+// SetSource sets the "source" edge to the Warehouse entity.
+func (_c *TransferOrderCreate) SetSource(v *Warehouse) *TransferOrderCreate {
+	return _c.SetSourceID(v.ID)
+}
+
+// SetDestinationID sets the "destination" edge to the Warehouse entity by ID.
+func (_c *TransferOrderCreate) SetDestinationID(id int) *TransferOrderCreate {
+	_c.mutation.SetDestinationID(id)
+	return _c
+}
+
+// SetDestination sets the "destination" edge to the Warehouse entity.
+func (_c *TransferOrderCreate) SetDestination(v *Warehouse) *TransferOrderCreate {
+	return _c.SetDestinationID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the TransferOrderLine entity by IDs.
+func (_c *TransferOrderCreate) AddLineIDs(ids ...int) *TransferOrderCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the TransferOrderLine entity.
+func (_c *TransferOrderCreate) AddLines(v ...*TransferOrderLine) *TransferOrderCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_c *TransferOrderCreate) AddMovementIDs(ids ...int) *TransferOrderCreate {
+	_c.mutation.AddMovementIDs(ids...)
+	return _c
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_c *TransferOrderCreate) AddMovements(v ...*StockMovement) *TransferOrderCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMovementIDs(ids...)
+}
+
+// Mutation returns the TransferOrderMutation object of the builder.
+func (_c *TransferOrderCreate) Mutation() *TransferOrderMutation {
+	return _c.mutation
+}
+
+// Save creates the TransferOrder in the database.
+func (_c *TransferOrderCreate) Save(ctx context.Context) (*TransferOrder, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransferOrderCreate) SaveX(ctx context.Context) *TransferOrder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransferOrderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransferOrderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransferOrderCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := transferorder.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := transferorder.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransferOrderCreate) check() error {
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "TransferOrder.number"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TransferOrder.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := transferorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TransferOrder.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TransferOrder.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "TransferOrder.tenant"`)}
+	}
+	if len(_c.mutation.SourceIDs()) == 0 {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required edge "TransferOrder.source"`)}
+	}
+	if len(_c.mutation.DestinationIDs()) == 0 {
+		return &ValidationError{Name: "destination", err: errors.New(`ent: missing required edge "TransferOrder.destination"`)}
+	}
+	return nil
+}
+
+func (_c *TransferOrderCreate) sqlSave(ctx context.Context) (*TransferOrder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransferOrderCreate) createSpec() (*TransferOrder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TransferOrder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transferorder.Table, sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(transferorder.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(transferorder.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(transferorder.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(transferorder.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.ShippedAt(); ok {
+		_spec.SetField(transferorder.FieldShippedAt, field.TypeTime, value)
+		_node.ShippedAt = &value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(transferorder.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transferorder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.TenantTable,
+			Columns: []string{transferorder.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tenant_transfer_orders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.SourceTable,
+			Columns: []string{transferorder.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_transfers_out = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DestinationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.DestinationTable,
+			Columns: []string{transferorder.DestinationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_transfers_in = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.LinesTable,
+			Columns: []string{transferorder.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.MovementsTable,
+			Columns: []string{transferorder.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TransferOrderCreateBulk is the builder for creating many TransferOrder entities in bulk.
+type TransferOrderCreateBulk struct {
+	config
+	err      error
+	builders []*TransferOrderCreate
+}
+
+// Save creates the TransferOrder entities in the database.
+func (_c *TransferOrderCreateBulk) Save(ctx context.Context) ([]*TransferOrder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TransferOrder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransferOrderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransferOrderCreateBulk) SaveX(ctx context.Context) []*TransferOrder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransferOrderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransferOrderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/predicate"
+	"sent/ent/stockmovement"
+	"sent/ent/tenant"
+	"sent/ent/transferorder"
+	"sent/ent/transferorderline"
+	"sent/ent/warehouse"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferOrderUpdate is the builder for updating TransferOrder entities.
+type TransferOrderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TransferOrderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TransferOrderUpdate builder.
+func (_u *TransferOrderUpdate) Where(ps ...predicate.TransferOrder) *TransferOrderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *TransferOrderUpdate) SetNumber(v string) *TransferOrderUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *TransferOrderUpdate) SetNillableNumber(v *string) *TransferOrderUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TransferOrderUpdate) SetStatus(v transferorder.Status) *TransferOrderUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TransferOrderUpdate) SetNillableStatus(v *transferorder.Status) *TransferOrderUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *TransferOrderUpdate) SetNotes(v string) *TransferOrderUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *TransferOrderUpdate) SetNillableNotes(v *string) *TransferOrderUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *TransferOrderUpdate) ClearNotes() *TransferOrderUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TransferOrderUpdate) SetCreatedBy(v string) *TransferOrderUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TransferOrderUpdate) SetNillableCreatedBy(v *string) *TransferOrderUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TransferOrderUpdate) ClearCreatedBy() *TransferOrderUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (_u *TransferOrderUpdate) SetShippedAt(v time.Time) *TransferOrderUpdate {
+	_u.mutation.SetShippedAt(v)
+	return _u
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (_u *TransferOrderUpdate) SetNillableShippedAt(v *time.Time) *TransferOrderUpdate {
+	if v != nil {
+		_u.SetShippedAt(*v)
+	}
+	return _u
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (_u *TransferOrderUpdate) ClearShippedAt() *TransferOrderUpdate {
+	_u.mutation.ClearShippedAt()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *TransferOrderUpdate) SetReceivedAt(v time.Time) *TransferOrderUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *TransferOrderUpdate) SetNillableReceivedAt(v *time.Time) *TransferOrderUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (_u *TransferOrderUpdate) ClearReceivedAt() *TransferOrderUpdate {
+	_u.mutation.ClearReceivedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *TransferOrderUpdate) SetTenantID(id int) *TransferOrderUpdate {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *TransferOrderUpdate) SetTenant(v *Tenant) *TransferOrderUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetSourceID sets the "source" edge to the Warehouse entity by ID.
+func (_u *TransferOrderUpdate) SetSourceID(id int) *TransferOrderUpdate {
+	_u.mutation.SetSourceID(id)
+	return _u
+}
+
+// SetSource sets the "source" edge to the Warehouse entity.
+func (_u *TransferOrderUpdate) SetSource(v *Warehouse) *TransferOrderUpdate {
+	return _u.SetSourceID(v.ID)
+}
+
+// SetDestinationID sets the "destination" edge to the Warehouse entity by ID.
+func (_u *TransferOrderUpdate) SetDestinationID(id int) *TransferOrderUpdate {
+	_u.mutation.SetDestinationID(id)
+	return _u
+}
+
+// SetDestination sets the "destination" edge to the Warehouse entity.
+func (_u *TransferOrderUpdate) SetDestination(v *Warehouse) *TransferOrderUpdate {
+	return _u.SetDestinationID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the TransferOrderLine entity by IDs.
+func (_u *TransferOrderUpdate) AddLineIDs(ids ...int) *TransferOrderUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the TransferOrderLine entity.
+func (_u *TransferOrderUpdate) AddLines(v ...*TransferOrderLine) *TransferOrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *TransferOrderUpdate) AddMovementIDs(ids ...int) *TransferOrderUpdate {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *TransferOrderUpdate) AddMovements(v ...*StockMovement) *TransferOrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// Mutation returns the TransferOrderMutation object of the builder.
+func (_u *TransferOrderUpdate) Mutation() *TransferOrderMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TransferOrderUpdate) ClearTenant() *TransferOrderUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearSource clears the "source" edge to the Warehouse entity.
+func (_u *TransferOrderUpdate) ClearSource() *TransferOrderUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// ClearDestination clears the "destination" edge to the Warehouse entity.
+func (_u *TransferOrderUpdate) ClearDestination() *TransferOrderUpdate {
+	_u.mutation.ClearDestination()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the TransferOrderLine entity.
+func (_u *TransferOrderUpdate) ClearLines() *TransferOrderUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to TransferOrderLine entities by IDs.
+func (_u *TransferOrderUpdate) RemoveLineIDs(ids ...int) *TransferOrderUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to TransferOrderLine entities.
+func (_u *TransferOrderUpdate) RemoveLines(v ...*TransferOrderLine) *TransferOrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *TransferOrderUpdate) ClearMovements() *TransferOrderUpdate {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *TransferOrderUpdate) RemoveMovementIDs(ids ...int) *TransferOrderUpdate {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *TransferOrderUpdate) RemoveMovements(v ...*StockMovement) *TransferOrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransferOrderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransferOrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransferOrderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransferOrderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransferOrderUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := transferorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TransferOrder.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrder.tenant"`)
+	}
+	if _u.mutation.SourceCleared() && len(_u.mutation.SourceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrder.source"`)
+	}
+	if _u.mutation.DestinationCleared() && len(_u.mutation.DestinationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrder.destination"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransferOrderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransferOrderUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransferOrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transferorder.Table, transferorder.Columns, sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(transferorder.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(transferorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(transferorder.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(transferorder.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(transferorder.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(transferorder.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShippedAt(); ok {
+		_spec.SetField(transferorder.FieldShippedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ShippedAtCleared() {
+		_spec.ClearField(transferorder.FieldShippedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(transferorder.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReceivedAtCleared() {
+		_spec.ClearField(transferorder.FieldReceivedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.TenantTable,
+			Columns: []string{transferorder.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.TenantTable,
+			Columns: []string{transferorder.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.SourceTable,
+			Columns: []string{transferorder.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.SourceTable,
+			Columns: []string{transferorder.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DestinationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.DestinationTable,
+			Columns: []string{transferorder.DestinationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DestinationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.DestinationTable,
+			Columns: []string{transferorder.DestinationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.LinesTable,
+			Columns: []string{transferorder.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.LinesTable,
+			Columns: []string{transferorder.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.LinesTable,
+			Columns: []string{transferorder.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.MovementsTable,
+			Columns: []string{transferorder.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.MovementsTable,
+			Columns: []string{transferorder.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.MovementsTable,
+			Columns: []string{transferorder.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transferorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransferOrderUpdateOne is the builder for updating a single TransferOrder entity.
+type TransferOrderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransferOrderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetNumber sets the "number" field.
+func (_u *TransferOrderUpdateOne) SetNumber(v string) *TransferOrderUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *TransferOrderUpdateOne) SetNillableNumber(v *string) *TransferOrderUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TransferOrderUpdateOne) SetStatus(v transferorder.Status) *TransferOrderUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TransferOrderUpdateOne) SetNillableStatus(v *transferorder.Status) *TransferOrderUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *TransferOrderUpdateOne) SetNotes(v string) *TransferOrderUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *TransferOrderUpdateOne) SetNillableNotes(v *string) *TransferOrderUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *TransferOrderUpdateOne) ClearNotes() *TransferOrderUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TransferOrderUpdateOne) SetCreatedBy(v string) *TransferOrderUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TransferOrderUpdateOne) SetNillableCreatedBy(v *string) *TransferOrderUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TransferOrderUpdateOne) ClearCreatedBy() *TransferOrderUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (_u *TransferOrderUpdateOne) SetShippedAt(v time.Time) *TransferOrderUpdateOne {
+	_u.mutation.SetShippedAt(v)
+	return _u
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (_u *TransferOrderUpdateOne) SetNillableShippedAt(v *time.Time) *TransferOrderUpdateOne {
+	if v != nil {
+		_u.SetShippedAt(*v)
+	}
+	return _u
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (_u *TransferOrderUpdateOne) ClearShippedAt() *TransferOrderUpdateOne {
+	_u.mutation.ClearShippedAt()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *TransferOrderUpdateOne) SetReceivedAt(v time.Time) *TransferOrderUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *TransferOrderUpdateOne) SetNillableReceivedAt(v *time.Time) *TransferOrderUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (_u *TransferOrderUpdateOne) ClearReceivedAt() *TransferOrderUpdateOne {
+	_u.mutation.ClearReceivedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant" edge to the Tenant entity by ID.
+func (_u *TransferOrderUpdateOne) SetTenantID(id int) *TransferOrderUpdateOne {
+	_u.mutation.SetTenantID(id)
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *TransferOrderUpdateOne) SetTenant(v *Tenant) *TransferOrderUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetSourceID sets the "source" edge to the Warehouse entity by ID.
+func (_u *TransferOrderUpdateOne) SetSourceID(id int) *TransferOrderUpdateOne {
+	_u.mutation.SetSourceID(id)
+	return _u
+}
+
+// SetSource sets the "source" edge to the Warehouse entity.
+func (_u *TransferOrderUpdateOne) SetSource(v *Warehouse) *TransferOrderUpdateOne {
+	return _u.SetSourceID(v.ID)
+}
+
+// SetDestinationID sets the "destination" edge to the Warehouse entity by ID.
+func (_u *TransferOrderUpdateOne) SetDestinationID(id int) *TransferOrderUpdateOne {
+	_u.mutation.SetDestinationID(id)
+	return _u
+}
+
+// SetDestination sets the "destination" edge to the Warehouse entity.
+func (_u *TransferOrderUpdateOne) SetDestination(v *Warehouse) *TransferOrderUpdateOne {
+	return _u.SetDestinationID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the TransferOrderLine entity by IDs.
+func (_u *TransferOrderUpdateOne) AddLineIDs(ids ...int) *TransferOrderUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the TransferOrderLine entity.
+func (_u *TransferOrderUpdateOne) AddLines(v ...*TransferOrderLine) *TransferOrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *TransferOrderUpdateOne) AddMovementIDs(ids ...int) *TransferOrderUpdateOne {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *TransferOrderUpdateOne) AddMovements(v ...*StockMovement) *TransferOrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// Mutation returns the TransferOrderMutation object of the builder.
+func (_u *TransferOrderUpdateOne) Mutation() *TransferOrderMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TransferOrderUpdateOne) ClearTenant() *TransferOrderUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearSource clears the "source" edge to the Warehouse entity.
+func (_u *TransferOrderUpdateOne) ClearSource() *TransferOrderUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// ClearDestination clears the "destination" edge to the Warehouse entity.
+func (_u *TransferOrderUpdateOne) ClearDestination() *TransferOrderUpdateOne {
+	_u.mutation.ClearDestination()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the TransferOrderLine entity.
+func (_u *TransferOrderUpdateOne) ClearLines() *TransferOrderUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to TransferOrderLine entities by IDs.
+func (_u *TransferOrderUpdateOne) RemoveLineIDs(ids ...int) *TransferOrderUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to TransferOrderLine entities.
+func (_u *TransferOrderUpdateOne) RemoveLines(v ...*TransferOrderLine) *TransferOrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *TransferOrderUpdateOne) ClearMovements() *TransferOrderUpdateOne {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *TransferOrderUpdateOne) RemoveMovementIDs(ids ...int) *TransferOrderUpdateOne {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *TransferOrderUpdateOne) RemoveMovements(v ...*StockMovement) *TransferOrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// Where appends a list predicates to the TransferOrderUpdate builder.
+func (_u *TransferOrderUpdateOne) Where(ps ...predicate.TransferOrder) *TransferOrderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransferOrderUpdateOne) Select(field string, fields ...string) *TransferOrderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TransferOrder entity.
+func (_u *TransferOrderUpdateOne) Save(ctx context.Context) (*TransferOrder, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransferOrderUpdateOne) SaveX(ctx context.Context) *TransferOrder {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransferOrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransferOrderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransferOrderUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := transferorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TransferOrder.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrder.tenant"`)
+	}
+	if _u.mutation.SourceCleared() && len(_u.mutation.SourceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrder.source"`)
+	}
+	if _u.mutation.DestinationCleared() && len(_u.mutation.DestinationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrder.destination"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransferOrderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransferOrderUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransferOrderUpdateOne) sqlSave(ctx context.Context) (_node *TransferOrder, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transferorder.Table, transferorder.Columns, sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TransferOrder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transferorder.FieldID)
+		for _, f := range fields {
+			if !transferorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transferorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(transferorder.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(transferorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(transferorder.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(transferorder.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(transferorder.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(transferorder.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShippedAt(); ok {
+		_spec.SetField(transferorder.FieldShippedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ShippedAtCleared() {
+		_spec.ClearField(transferorder.FieldShippedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(transferorder.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReceivedAtCleared() {
+		_spec.ClearField(transferorder.FieldReceivedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.TenantTable,
+			Columns: []string{transferorder.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.TenantTable,
+			Columns: []string{transferorder.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.SourceTable,
+			Columns: []string{transferorder.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.SourceTable,
+			Columns: []string{transferorder.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DestinationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.DestinationTable,
+			Columns: []string{transferorder.DestinationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DestinationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorder.DestinationTable,
+			Columns: []string{transferorder.DestinationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.LinesTable,
+			Columns: []string{transferorder.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.LinesTable,
+			Columns: []string{transferorder.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.LinesTable,
+			Columns: []string{transferorder.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.MovementsTable,
+			Columns: []string{transferorder.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.MovementsTable,
+			Columns: []string{transferorder.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transferorder.MovementsTable,
+			Columns: []string{transferorder.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TransferOrder{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transferorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sent/ent/product"
+	"sent/ent/transferorder"
+	"sent/ent/transferorderline"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// TransferOrderLine is the model entity for the TransferOrderLine schema.
+type TransferOrderLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// FromBin holds the value of the "from_bin" field.
+	FromBin string `json:"from_bin,omitempty"`
+	// ToBin holds the value of the "to_bin" field.
+	ToBin string `json:"to_bin,omitempty"`
+	// LotNumber holds the value of the "lot_number" field.
+	LotNumber string `json:"lot_number,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TransferOrderLineQuery when eager-loading is set.
+	Edges                  TransferOrderLineEdges `json:"edges"`
+	product_transfer_lines *int
+	transfer_order_lines   *int
+	selectValues           sql.SelectValues
+}
+
+// TransferOrderLineEdges holds the relations/edges for other nodes in the graph.
+type TransferOrderLineEdges struct {
+	// Transfer holds the value of the transfer edge.
+	Transfer *TransferOrder `json:"transfer,omitempty"`
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TransferOrErr returns the Transfer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferOrderLineEdges) TransferOrErr() (*TransferOrder, error) {
+	if e.Transfer != nil {
+		return e.Transfer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: transferorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "transfer"}
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferOrderLineEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TransferOrderLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transferorderline.FieldQuantity:
+			values[i] = new(decimal.Decimal)
+		case transferorderline.FieldID:
+			values[i] = new(sql.NullInt64)
+		case transferorderline.FieldFromBin, transferorderline.FieldToBin, transferorderline.FieldLotNumber:
+			values[i] = new(sql.NullString)
+		case transferorderline.ForeignKeys[0]: // product_transfer_lines
+			values[i] = new(sql.NullInt64)
+		case transferorderline.ForeignKeys[1]: // transfer_order_lines
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TransferOrderLine fields.
+func (_m *TransferOrderLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transferorderline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case transferorderline.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case transferorderline.FieldFromBin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_bin", values[i])
+			} else if value.Valid {
+				_m.FromBin = value.String
+			}
+		case transferorderline.FieldToBin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_bin", values[i])
+			} else if value.Valid {
+				_m.ToBin = value.String
+			}
+		case transferorderline.FieldLotNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_number", values[i])
+			} else if value.Valid {
+				_m.LotNumber = value.String
+			}
+		case transferorderline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field product_transfer_lines", value)
+			} else if value.Valid {
+				_m.product_transfer_lines = new(int)
+				*_m.product_transfer_lines = int(value.Int64)
+			}
+		case transferorderline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field transfer_order_lines", value)
+			} else if value.Valid {
+				_m.transfer_order_lines = new(int)
+				*_m.transfer_order_lines = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TransferOrderLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *TransferOrderLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTransfer queries the "transfer" edge of the TransferOrderLine entity.
+func (_m *TransferOrderLine) QueryTransfer() *TransferOrderQuery {
+	return NewTransferOrderLineClient(_m.config).QueryTransfer(_m)
+}
+
+// QueryProduct queries the "product" edge of the TransferOrderLine entity.
+func (_m *TransferOrderLine) QueryProduct() *ProductQuery {
+	return NewTransferOrderLineClient(_m.config).QueryProduct(_m)
+}
+
+// Update returns a builder for updating this TransferOrderLine.
+// Note that you need to call TransferOrderLine.Unwrap() before calling this method if this TransferOrderLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TransferOrderLine) Update() *TransferOrderLineUpdateOne {
+	return NewTransferOrderLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TransferOrderLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TransferOrderLine) Unwrap() *TransferOrderLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TransferOrderLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TransferOrderLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("TransferOrderLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("from_bin=")
+	builder.WriteString(_m.FromBin)
+	builder.WriteString(", ")
+	builder.WriteString("to_bin=")
+	builder.WriteString(_m.ToBin)
+	builder.WriteString(", ")
+	builder.WriteString("lot_number=")
+	builder.WriteString(_m.LotNumber)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TransferOrderLines is a parsable slice of TransferOrderLine.
+type TransferOrderLines []*TransferOrderLine
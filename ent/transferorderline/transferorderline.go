@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transferorderline
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the transferorderline type in the database.
+	Label = "transfer_order_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldFromBin holds the string denoting the from_bin field in the database.
+	FieldFromBin = "from_bin"
+	// FieldToBin holds the string denoting the to_bin field in the database.
+	FieldToBin = "to_bin"
+	// FieldLotNumber holds the string denoting the lot_number field in the database.
+	FieldLotNumber = "lot_number"
+	// EdgeTransfer holds the string denoting the transfer edge name in mutations.
+	EdgeTransfer = "transfer"
+	// EdgeProduct holds the string denoting the product edge name in mutations.
+	EdgeProduct = "product"
+	// Table holds the table name of the transferorderline in the database.
+	Table = "transfer_order_lines"
+	// TransferTable is the table that holds the transfer relation/edge.
+	TransferTable = "transfer_order_lines"
+	// TransferInverseTable is the table name for the TransferOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "transferorder" package.
+	TransferInverseTable = "transfer_orders"
+	// TransferColumn is the table column denoting the transfer relation/edge.
+	TransferColumn = "transfer_order_lines"
+	// ProductTable is the table that holds the product relation/edge.
+	ProductTable = "transfer_order_lines"
+	// ProductInverseTable is the table name for the Product entity.
+	// It exists in this package in order to avoid circular dependency with the "product" package.
+	ProductInverseTable = "products"
+	// ProductColumn is the table column denoting the product relation/edge.
+	ProductColumn = "product_transfer_lines"
+)
+
+// Columns holds all SQL columns for transferorderline fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+	FieldFromBin,
+	FieldToBin,
+	FieldLotNumber,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "transfer_order_lines"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"product_transfer_lines",
+	"transfer_order_lines",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultQuantity holds the default value on creation for the "quantity" field.
+	DefaultQuantity decimal.Decimal
+)
+
+// OrderOption defines the ordering options for the TransferOrderLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByFromBin orders the results by the from_bin field.
+func ByFromBin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromBin, opts...).ToFunc()
+}
+
+// ByToBin orders the results by the to_bin field.
+func ByToBin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToBin, opts...).ToFunc()
+}
+
+// ByLotNumber orders the results by the lot_number field.
+func ByLotNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLotNumber, opts...).ToFunc()
+}
+
+// ByTransferField orders the results by transfer field.
+func ByTransferField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransferStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByProductField orders the results by product field.
+func ByProductField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProductStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTransferStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransferInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TransferTable, TransferColumn),
+	)
+}
+func newProductStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProductInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+	)
+}
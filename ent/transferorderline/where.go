@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transferorderline
+
+import (
+	"sent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLTE(FieldID, id))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// FromBin applies equality check predicate on the "from_bin" field. It's identical to FromBinEQ.
+func FromBin(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldFromBin, v))
+}
+
+// ToBin applies equality check predicate on the "to_bin" field. It's identical to ToBinEQ.
+func ToBin(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldToBin, v))
+}
+
+// LotNumber applies equality check predicate on the "lot_number" field. It's identical to LotNumberEQ.
+func LotNumber(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldLotNumber, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLTE(FieldQuantity, v))
+}
+
+// FromBinEQ applies the EQ predicate on the "from_bin" field.
+func FromBinEQ(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldFromBin, v))
+}
+
+// FromBinNEQ applies the NEQ predicate on the "from_bin" field.
+func FromBinNEQ(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNEQ(FieldFromBin, v))
+}
+
+// FromBinIn applies the In predicate on the "from_bin" field.
+func FromBinIn(vs ...string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldIn(FieldFromBin, vs...))
+}
+
+// FromBinNotIn applies the NotIn predicate on the "from_bin" field.
+func FromBinNotIn(vs ...string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNotIn(FieldFromBin, vs...))
+}
+
+// FromBinGT applies the GT predicate on the "from_bin" field.
+func FromBinGT(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGT(FieldFromBin, v))
+}
+
+// FromBinGTE applies the GTE predicate on the "from_bin" field.
+func FromBinGTE(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGTE(FieldFromBin, v))
+}
+
+// FromBinLT applies the LT predicate on the "from_bin" field.
+func FromBinLT(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLT(FieldFromBin, v))
+}
+
+// FromBinLTE applies the LTE predicate on the "from_bin" field.
+func FromBinLTE(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLTE(FieldFromBin, v))
+}
+
+// FromBinContains applies the Contains predicate on the "from_bin" field.
+func FromBinContains(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldContains(FieldFromBin, v))
+}
+
+// FromBinHasPrefix applies the HasPrefix predicate on the "from_bin" field.
+func FromBinHasPrefix(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldHasPrefix(FieldFromBin, v))
+}
+
+// FromBinHasSuffix applies the HasSuffix predicate on the "from_bin" field.
+func FromBinHasSuffix(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldHasSuffix(FieldFromBin, v))
+}
+
+// FromBinIsNil applies the IsNil predicate on the "from_bin" field.
+func FromBinIsNil() predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldIsNull(FieldFromBin))
+}
+
+// FromBinNotNil applies the NotNil predicate on the "from_bin" field.
+func FromBinNotNil() predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNotNull(FieldFromBin))
+}
+
+// FromBinEqualFold applies the EqualFold predicate on the "from_bin" field.
+func FromBinEqualFold(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEqualFold(FieldFromBin, v))
+}
+
+// FromBinContainsFold applies the ContainsFold predicate on the "from_bin" field.
+func FromBinContainsFold(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldContainsFold(FieldFromBin, v))
+}
+
+// ToBinEQ applies the EQ predicate on the "to_bin" field.
+func ToBinEQ(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldToBin, v))
+}
+
+// ToBinNEQ applies the NEQ predicate on the "to_bin" field.
+func ToBinNEQ(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNEQ(FieldToBin, v))
+}
+
+// ToBinIn applies the In predicate on the "to_bin" field.
+func ToBinIn(vs ...string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldIn(FieldToBin, vs...))
+}
+
+// ToBinNotIn applies the NotIn predicate on the "to_bin" field.
+func ToBinNotIn(vs ...string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNotIn(FieldToBin, vs...))
+}
+
+// ToBinGT applies the GT predicate on the "to_bin" field.
+func ToBinGT(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGT(FieldToBin, v))
+}
+
+// ToBinGTE applies the GTE predicate on the "to_bin" field.
+func ToBinGTE(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGTE(FieldToBin, v))
+}
+
+// ToBinLT applies the LT predicate on the "to_bin" field.
+func ToBinLT(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLT(FieldToBin, v))
+}
+
+// ToBinLTE applies the LTE predicate on the "to_bin" field.
+func ToBinLTE(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLTE(FieldToBin, v))
+}
+
+// ToBinContains applies the Contains predicate on the "to_bin" field.
+func ToBinContains(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldContains(FieldToBin, v))
+}
+
+// ToBinHasPrefix applies the HasPrefix predicate on the "to_bin" field.
+func ToBinHasPrefix(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldHasPrefix(FieldToBin, v))
+}
+
+// ToBinHasSuffix applies the HasSuffix predicate on the "to_bin" field.
+func ToBinHasSuffix(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldHasSuffix(FieldToBin, v))
+}
+
+// ToBinIsNil applies the IsNil predicate on the "to_bin" field.
+func ToBinIsNil() predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldIsNull(FieldToBin))
+}
+
+// ToBinNotNil applies the NotNil predicate on the "to_bin" field.
+func ToBinNotNil() predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNotNull(FieldToBin))
+}
+
+// ToBinEqualFold applies the EqualFold predicate on the "to_bin" field.
+func ToBinEqualFold(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEqualFold(FieldToBin, v))
+}
+
+// ToBinContainsFold applies the ContainsFold predicate on the "to_bin" field.
+func ToBinContainsFold(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldContainsFold(FieldToBin, v))
+}
+
+// LotNumberEQ applies the EQ predicate on the "lot_number" field.
+func LotNumberEQ(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEQ(FieldLotNumber, v))
+}
+
+// LotNumberNEQ applies the NEQ predicate on the "lot_number" field.
+func LotNumberNEQ(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNEQ(FieldLotNumber, v))
+}
+
+// LotNumberIn applies the In predicate on the "lot_number" field.
+func LotNumberIn(vs ...string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldIn(FieldLotNumber, vs...))
+}
+
+// LotNumberNotIn applies the NotIn predicate on the "lot_number" field.
+func LotNumberNotIn(vs ...string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNotIn(FieldLotNumber, vs...))
+}
+
+// LotNumberGT applies the GT predicate on the "lot_number" field.
+func LotNumberGT(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGT(FieldLotNumber, v))
+}
+
+// LotNumberGTE applies the GTE predicate on the "lot_number" field.
+func LotNumberGTE(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldGTE(FieldLotNumber, v))
+}
+
+// LotNumberLT applies the LT predicate on the "lot_number" field.
+func LotNumberLT(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLT(FieldLotNumber, v))
+}
+
+// LotNumberLTE applies the LTE predicate on the "lot_number" field.
+func LotNumberLTE(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldLTE(FieldLotNumber, v))
+}
+
+// LotNumberContains applies the Contains predicate on the "lot_number" field.
+func LotNumberContains(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldContains(FieldLotNumber, v))
+}
+
+// LotNumberHasPrefix applies the HasPrefix predicate on the "lot_number" field.
+func LotNumberHasPrefix(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldHasPrefix(FieldLotNumber, v))
+}
+
+// LotNumberHasSuffix applies the HasSuffix predicate on the "lot_number" field.
+func LotNumberHasSuffix(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldHasSuffix(FieldLotNumber, v))
+}
+
+// LotNumberIsNil applies the IsNil predicate on the "lot_number" field.
+func LotNumberIsNil() predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldIsNull(FieldLotNumber))
+}
+
+// LotNumberNotNil applies the NotNil predicate on the "lot_number" field.
+func LotNumberNotNil() predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldNotNull(FieldLotNumber))
+}
+
+// LotNumberEqualFold applies the EqualFold predicate on the "lot_number" field.
+func LotNumberEqualFold(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldEqualFold(FieldLotNumber, v))
+}
+
+// LotNumberContainsFold applies the ContainsFold predicate on the "lot_number" field.
+func LotNumberContainsFold(v string) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.FieldContainsFold(FieldLotNumber, v))
+}
+
+// HasTransfer applies the HasEdge predicate on the "transfer" edge.
+func HasTransfer() predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TransferTable, TransferColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransferWith applies the HasEdge predicate on the "transfer" edge with a given conditions (other predicates).
+func HasTransferWith(preds ...predicate.TransferOrder) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(func(s *sql.Selector) {
+		step := newTransferStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TransferOrderLine) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TransferOrderLine) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TransferOrderLine) predicate.TransferOrderLine {
+	return predicate.TransferOrderLine(sql.NotPredicates(p))
+}
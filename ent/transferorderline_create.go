@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/product"
+	"sent/ent/transferorder"
+	"sent/ent/transferorderline"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// TransferOrderLineCreate is the builder for creating a TransferOrderLine entity.
+type TransferOrderLineCreate struct {
+	config
+	mutation *TransferOrderLineMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *TransferOrderLineCreate) SetQuantity(v decimal.Decimal) *TransferOrderLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *TransferOrderLineCreate) SetNillableQuantity(v *decimal.Decimal) *TransferOrderLineCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetFromBin sets the "from_bin" field.
+func (_c *TransferOrderLineCreate) SetFromBin(v string) *TransferOrderLineCreate {
+	_c.mutation.SetFromBin(v)
+	return _c
+}
+
+// SetNillableFromBin sets the "from_bin" field if the given value is not nil.
+func (_c *TransferOrderLineCreate) SetNillableFromBin(v *string) *TransferOrderLineCreate {
+	if v != nil {
+		_c.SetFromBin(*v)
+	}
+	return _c
+}
+
+// SetToBin sets the "to_bin" field.
+func (_c *TransferOrderLineCreate) SetToBin(v string) *TransferOrderLineCreate {
+	_c.mutation.SetToBin(v)
+	return _c
+}
+
+// SetNillableToBin sets the "to_bin" field if the given value is not nil.
+func (_c *TransferOrderLineCreate) SetNillableToBin(v *string) *TransferOrderLineCreate {
+	if v != nil {
+		_c.SetToBin(*v)
+	}
+	return _c
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_c *TransferOrderLineCreate) SetLotNumber(v string) *TransferOrderLineCreate {
+	_c.mutation.SetLotNumber(v)
+	return _c
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_c *TransferOrderLineCreate) SetNillableLotNumber(v *string) *TransferOrderLineCreate {
+	if v != nil {
+		_c.SetLotNumber(*v)
+	}
+	return _c
+}
+
+// SetTransferID sets the "transfer" edge to the TransferOrder entity by ID.
+func (_c *TransferOrderLineCreate) SetTransferID(id int) *TransferOrderLineCreate {
+	_c.mutation.SetTransferID(id)
+	return _c
+}
+
+// SetTransfer sets the "transfer" edge to the TransferOrder entity.
+func (_c *TransferOrderLineCreate) SetTransfer(v *TransferOrder) *TransferOrderLineCreate {
+	return _c.SetTransferID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_c *TransferOrderLineCreate) SetProductID(id int) *TransferOrderLineCreate {
+	_c.mutation.SetProductID(id)
+	return _c
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_c *TransferOrderLineCreate) SetProduct(v *Product) *TransferOrderLineCreate {
+	return _c.SetProductID(v.ID)
+}
+
+// Mutation returns the TransferOrderLineMutation object of the builder.
+func (_c *TransferOrderLineCreate) Mutation() *TransferOrderLineMutation {
+	return _c.mutation
+}
+
+// Save creates the TransferOrderLine in the database.
+func (_c *TransferOrderLineCreate) Save(ctx context.Context) (*TransferOrderLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransferOrderLineCreate) SaveX(ctx context.Context) *TransferOrderLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransferOrderLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransferOrderLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransferOrderLineCreate) defaults() {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := transferorderline.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransferOrderLineCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "TransferOrderLine.quantity"`)}
+	}
+	if len(_c.mutation.TransferIDs()) == 0 {
+		return &ValidationError{Name: "transfer", err: errors.New(`ent: missing required edge "TransferOrderLine.transfer"`)}
+	}
+	if len(_c.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "TransferOrderLine.product"`)}
+	}
+	return nil
+}
+
+func (_c *TransferOrderLineCreate) sqlSave(ctx context.Context) (*TransferOrderLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransferOrderLineCreate) createSpec() (*TransferOrderLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TransferOrderLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transferorderline.Table, sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(transferorderline.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.FromBin(); ok {
+		_spec.SetField(transferorderline.FieldFromBin, field.TypeString, value)
+		_node.FromBin = value
+	}
+	if value, ok := _c.mutation.ToBin(); ok {
+		_spec.SetField(transferorderline.FieldToBin, field.TypeString, value)
+		_node.ToBin = value
+	}
+	if value, ok := _c.mutation.LotNumber(); ok {
+		_spec.SetField(transferorderline.FieldLotNumber, field.TypeString, value)
+		_node.LotNumber = value
+	}
+	if nodes := _c.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.TransferTable,
+			Columns: []string{transferorderline.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transfer_order_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.ProductTable,
+			Columns: []string{transferorderline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.product_transfer_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TransferOrderLineCreateBulk is the builder for creating many TransferOrderLine entities in bulk.
+type TransferOrderLineCreateBulk struct {
+	config
+	err      error
+	builders []*TransferOrderLineCreate
+}
+
+// Save creates the TransferOrderLine entities in the database.
+func (_c *TransferOrderLineCreateBulk) Save(ctx context.Context) ([]*TransferOrderLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TransferOrderLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransferOrderLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransferOrderLineCreateBulk) SaveX(ctx context.Context) []*TransferOrderLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransferOrderLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransferOrderLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sent/ent/predicate"
+	"sent/ent/product"
+	"sent/ent/transferorder"
+	"sent/ent/transferorderline"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// TransferOrderLineUpdate is the builder for updating TransferOrderLine entities.
+type TransferOrderLineUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TransferOrderLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TransferOrderLineUpdate builder.
+func (_u *TransferOrderLineUpdate) Where(ps ...predicate.TransferOrderLine) *TransferOrderLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *TransferOrderLineUpdate) SetQuantity(v decimal.Decimal) *TransferOrderLineUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *TransferOrderLineUpdate) SetNillableQuantity(v *decimal.Decimal) *TransferOrderLineUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetFromBin sets the "from_bin" field.
+func (_u *TransferOrderLineUpdate) SetFromBin(v string) *TransferOrderLineUpdate {
+	_u.mutation.SetFromBin(v)
+	return _u
+}
+
+// SetNillableFromBin sets the "from_bin" field if the given value is not nil.
+func (_u *TransferOrderLineUpdate) SetNillableFromBin(v *string) *TransferOrderLineUpdate {
+	if v != nil {
+		_u.SetFromBin(*v)
+	}
+	return _u
+}
+
+// ClearFromBin clears the value of the "from_bin" field.
+func (_u *TransferOrderLineUpdate) ClearFromBin() *TransferOrderLineUpdate {
+	_u.mutation.ClearFromBin()
+	return _u
+}
+
+// SetToBin sets the "to_bin" field.
+func (_u *TransferOrderLineUpdate) SetToBin(v string) *TransferOrderLineUpdate {
+	_u.mutation.SetToBin(v)
+	return _u
+}
+
+// SetNillableToBin sets the "to_bin" field if the given value is not nil.
+func (_u *TransferOrderLineUpdate) SetNillableToBin(v *string) *TransferOrderLineUpdate {
+	if v != nil {
+		_u.SetToBin(*v)
+	}
+	return _u
+}
+
+// ClearToBin clears the value of the "to_bin" field.
+func (_u *TransferOrderLineUpdate) ClearToBin() *TransferOrderLineUpdate {
+	_u.mutation.ClearToBin()
+	return _u
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_u *TransferOrderLineUpdate) SetLotNumber(v string) *TransferOrderLineUpdate {
+	_u.mutation.SetLotNumber(v)
+	return _u
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_u *TransferOrderLineUpdate) SetNillableLotNumber(v *string) *TransferOrderLineUpdate {
+	if v != nil {
+		_u.SetLotNumber(*v)
+	}
+	return _u
+}
+
+// ClearLotNumber clears the value of the "lot_number" field.
+func (_u *TransferOrderLineUpdate) ClearLotNumber() *TransferOrderLineUpdate {
+	_u.mutation.ClearLotNumber()
+	return _u
+}
+
+// SetTransferID sets the "transfer" edge to the TransferOrder entity by ID.
+func (_u *TransferOrderLineUpdate) SetTransferID(id int) *TransferOrderLineUpdate {
+	_u.mutation.SetTransferID(id)
+	return _u
+}
+
+// SetTransfer sets the "transfer" edge to the TransferOrder entity.
+func (_u *TransferOrderLineUpdate) SetTransfer(v *TransferOrder) *TransferOrderLineUpdate {
+	return _u.SetTransferID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_u *TransferOrderLineUpdate) SetProductID(id int) *TransferOrderLineUpdate {
+	_u.mutation.SetProductID(id)
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *TransferOrderLineUpdate) SetProduct(v *Product) *TransferOrderLineUpdate {
+	return _u.SetProductID(v.ID)
+}
+
+// Mutation returns the TransferOrderLineMutation object of the builder.
+func (_u *TransferOrderLineUpdate) Mutation() *TransferOrderLineMutation {
+	return _u.mutation
+}
+
+// ClearTransfer clears the "transfer" edge to the TransferOrder entity.
+func (_u *TransferOrderLineUpdate) ClearTransfer() *TransferOrderLineUpdate {
+	_u.mutation.ClearTransfer()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *TransferOrderLineUpdate) ClearProduct() *TransferOrderLineUpdate {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransferOrderLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransferOrderLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransferOrderLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransferOrderLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransferOrderLineUpdate) check() error {
+	if _u.mutation.TransferCleared() && len(_u.mutation.TransferIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrderLine.transfer"`)
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrderLine.product"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransferOrderLineUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransferOrderLineUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransferOrderLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transferorderline.Table, transferorderline.Columns, sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(transferorderline.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FromBin(); ok {
+		_spec.SetField(transferorderline.FieldFromBin, field.TypeString, value)
+	}
+	if _u.mutation.FromBinCleared() {
+		_spec.ClearField(transferorderline.FieldFromBin, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToBin(); ok {
+		_spec.SetField(transferorderline.FieldToBin, field.TypeString, value)
+	}
+	if _u.mutation.ToBinCleared() {
+		_spec.ClearField(transferorderline.FieldToBin, field.TypeString)
+	}
+	if value, ok := _u.mutation.LotNumber(); ok {
+		_spec.SetField(transferorderline.FieldLotNumber, field.TypeString, value)
+	}
+	if _u.mutation.LotNumberCleared() {
+		_spec.ClearField(transferorderline.FieldLotNumber, field.TypeString)
+	}
+	if _u.mutation.TransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.TransferTable,
+			Columns: []string{transferorderline.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.TransferTable,
+			Columns: []string{transferorderline.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.ProductTable,
+			Columns: []string{transferorderline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.ProductTable,
+			Columns: []string{transferorderline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transferorderline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransferOrderLineUpdateOne is the builder for updating a single TransferOrderLine entity.
+type TransferOrderLineUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransferOrderLineMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *TransferOrderLineUpdateOne) SetQuantity(v decimal.Decimal) *TransferOrderLineUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *TransferOrderLineUpdateOne) SetNillableQuantity(v *decimal.Decimal) *TransferOrderLineUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetFromBin sets the "from_bin" field.
+func (_u *TransferOrderLineUpdateOne) SetFromBin(v string) *TransferOrderLineUpdateOne {
+	_u.mutation.SetFromBin(v)
+	return _u
+}
+
+// SetNillableFromBin sets the "from_bin" field if the given value is not nil.
+func (_u *TransferOrderLineUpdateOne) SetNillableFromBin(v *string) *TransferOrderLineUpdateOne {
+	if v != nil {
+		_u.SetFromBin(*v)
+	}
+	return _u
+}
+
+// ClearFromBin clears the value of the "from_bin" field.
+func (_u *TransferOrderLineUpdateOne) ClearFromBin() *TransferOrderLineUpdateOne {
+	_u.mutation.ClearFromBin()
+	return _u
+}
+
+// SetToBin sets the "to_bin" field.
+func (_u *TransferOrderLineUpdateOne) SetToBin(v string) *TransferOrderLineUpdateOne {
+	_u.mutation.SetToBin(v)
+	return _u
+}
+
+// SetNillableToBin sets the "to_bin" field if the given value is not nil.
+func (_u *TransferOrderLineUpdateOne) SetNillableToBin(v *string) *TransferOrderLineUpdateOne {
+	if v != nil {
+		_u.SetToBin(*v)
+	}
+	return _u
+}
+
+// ClearToBin clears the value of the "to_bin" field.
+func (_u *TransferOrderLineUpdateOne) ClearToBin() *TransferOrderLineUpdateOne {
+	_u.mutation.ClearToBin()
+	return _u
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (_u *TransferOrderLineUpdateOne) SetLotNumber(v string) *TransferOrderLineUpdateOne {
+	_u.mutation.SetLotNumber(v)
+	return _u
+}
+
+// SetNillableLotNumber sets the "lot_number" field if the given value is not nil.
+func (_u *TransferOrderLineUpdateOne) SetNillableLotNumber(v *string) *TransferOrderLineUpdateOne {
+	if v != nil {
+		_u.SetLotNumber(*v)
+	}
+	return _u
+}
+
+// ClearLotNumber clears the value of the "lot_number" field.
+func (_u *TransferOrderLineUpdateOne) ClearLotNumber() *TransferOrderLineUpdateOne {
+	_u.mutation.ClearLotNumber()
+	return _u
+}
+
+// SetTransferID sets the "transfer" edge to the TransferOrder entity by ID.
+func (_u *TransferOrderLineUpdateOne) SetTransferID(id int) *TransferOrderLineUpdateOne {
+	_u.mutation.SetTransferID(id)
+	return _u
+}
+
+// SetTransfer sets the "transfer" edge to the TransferOrder entity.
+func (_u *TransferOrderLineUpdateOne) SetTransfer(v *TransferOrder) *TransferOrderLineUpdateOne {
+	return _u.SetTransferID(v.ID)
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (_u *TransferOrderLineUpdateOne) SetProductID(id int) *TransferOrderLineUpdateOne {
+	_u.mutation.SetProductID(id)
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *TransferOrderLineUpdateOne) SetProduct(v *Product) *TransferOrderLineUpdateOne {
+	return _u.SetProductID(v.ID)
+}
+
+// Mutation returns the TransferOrderLineMutation object of the builder.
+func (_u *TransferOrderLineUpdateOne) Mutation() *TransferOrderLineMutation {
+	return _u.mutation
+}
+
+// ClearTransfer clears the "transfer" edge to the TransferOrder entity.
+func (_u *TransferOrderLineUpdateOne) ClearTransfer() *TransferOrderLineUpdateOne {
+	_u.mutation.ClearTransfer()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *TransferOrderLineUpdateOne) ClearProduct() *TransferOrderLineUpdateOne {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// Where appends a list predicates to the TransferOrderLineUpdate builder.
+func (_u *TransferOrderLineUpdateOne) Where(ps ...predicate.TransferOrderLine) *TransferOrderLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransferOrderLineUpdateOne) Select(field string, fields ...string) *TransferOrderLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TransferOrderLine entity.
+func (_u *TransferOrderLineUpdateOne) Save(ctx context.Context) (*TransferOrderLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransferOrderLineUpdateOne) SaveX(ctx context.Context) *TransferOrderLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransferOrderLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransferOrderLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransferOrderLineUpdateOne) check() error {
+	if _u.mutation.TransferCleared() && len(_u.mutation.TransferIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrderLine.transfer"`)
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransferOrderLine.product"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransferOrderLineUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransferOrderLineUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransferOrderLineUpdateOne) sqlSave(ctx context.Context) (_node *TransferOrderLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transferorderline.Table, transferorderline.Columns, sqlgraph.NewFieldSpec(transferorderline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TransferOrderLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transferorderline.FieldID)
+		for _, f := range fields {
+			if !transferorderline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transferorderline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(transferorderline.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FromBin(); ok {
+		_spec.SetField(transferorderline.FieldFromBin, field.TypeString, value)
+	}
+	if _u.mutation.FromBinCleared() {
+		_spec.ClearField(transferorderline.FieldFromBin, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToBin(); ok {
+		_spec.SetField(transferorderline.FieldToBin, field.TypeString, value)
+	}
+	if _u.mutation.ToBinCleared() {
+		_spec.ClearField(transferorderline.FieldToBin, field.TypeString)
+	}
+	if value, ok := _u.mutation.LotNumber(); ok {
+		_spec.SetField(transferorderline.FieldLotNumber, field.TypeString, value)
+	}
+	if _u.mutation.LotNumberCleared() {
+		_spec.ClearField(transferorderline.FieldLotNumber, field.TypeString)
+	}
+	if _u.mutation.TransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.TransferTable,
+			Columns: []string{transferorderline.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.TransferTable,
+			Columns: []string{transferorderline.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.ProductTable,
+			Columns: []string{transferorderline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transferorderline.ProductTable,
+			Columns: []string{transferorderline.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TransferOrderLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transferorderline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
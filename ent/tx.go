@@ -14,8 +14,22 @@ type Tx struct {
 	config
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// AccountBalanceSnapshot is the client for interacting with the AccountBalanceSnapshot builders.
+	AccountBalanceSnapshot *AccountBalanceSnapshotClient
 	// Agent is the client for interacting with the Agent builders.
 	Agent *AgentClient
+	// AgentAlert is the client for interacting with the AgentAlert builders.
+	AgentAlert *AgentAlertClient
+	// AgentAuthority is the client for interacting with the AgentAuthority builders.
+	AgentAuthority *AgentAuthorityClient
+	// AgentEnrollmentToken is the client for interacting with the AgentEnrollmentToken builders.
+	AgentEnrollmentToken *AgentEnrollmentTokenClient
+	// AgentInventory is the client for interacting with the AgentInventory builders.
+	AgentInventory *AgentInventoryClient
+	// AgentSoftware is the client for interacting with the AgentSoftware builders.
+	AgentSoftware *AgentSoftwareClient
+	// AlertRule is the client for interacting with the AlertRule builders.
+	AlertRule *AlertRuleClient
 	// Application is the client for interacting with the Application builders.
 	Application *ApplicationClient
 	// Asset is the client for interacting with the Asset builders.
@@ -26,6 +40,12 @@ type Tx struct {
 	AssetType *AssetTypeClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// BankRule is the client for interacting with the BankRule builders.
+	BankRule *BankRuleClient
+	// BankStatement is the client for interacting with the BankStatement builders.
+	BankStatement *BankStatementClient
+	// BankStatementLine is the client for interacting with the BankStatementLine builders.
+	BankStatementLine *BankStatementLineClient
 	// BenefitEnrollment is the client for interacting with the BenefitEnrollment builders.
 	BenefitEnrollment *BenefitEnrollmentClient
 	// BenefitPlan is the client for interacting with the BenefitPlan builders.
@@ -48,16 +68,34 @@ type Tx struct {
 	Contract *ContractClient
 	// Credential is the client for interacting with the Credential builders.
 	Credential *CredentialClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// CustomerPayment is the client for interacting with the CustomerPayment builders.
+	CustomerPayment *CustomerPaymentClient
 	// Department is the client for interacting with the Department builders.
 	Department *DepartmentClient
 	// DetectionEvent is the client for interacting with the DetectionEvent builders.
 	DetectionEvent *DetectionEventClient
+	// DeviceGroup is the client for interacting with the DeviceGroup builders.
+	DeviceGroup *DeviceGroupClient
 	// DiscoveryEntry is the client for interacting with the DiscoveryEntry builders.
 	DiscoveryEntry *DiscoveryEntryClient
+	// EInvoice is the client for interacting with the EInvoice builders.
+	EInvoice *EInvoiceClient
+	// EInvoiceCredential is the client for interacting with the EInvoiceCredential builders.
+	EInvoiceCredential *EInvoiceCredentialClient
 	// Employee is the client for interacting with the Employee builders.
 	Employee *EmployeeClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
+	// FiscalPeriod is the client for interacting with the FiscalPeriod builders.
+	FiscalPeriod *FiscalPeriodClient
 	// Goal is the client for interacting with the Goal builders.
 	Goal *GoalClient
+	// GoodsReceipt is the client for interacting with the GoodsReceipt builders.
+	GoodsReceipt *GoodsReceiptClient
+	// GoodsReceiptLine is the client for interacting with the GoodsReceiptLine builders.
+	GoodsReceiptLine *GoodsReceiptLineClient
 	// HealthScoreSnapshot is the client for interacting with the HealthScoreSnapshot builders.
 	HealthScoreSnapshot *HealthScoreSnapshotClient
 	// IVRFlow is the client for interacting with the IVRFlow builders.
@@ -68,6 +106,12 @@ type Tx struct {
 	InventoryCount *InventoryCountClient
 	// InventoryReservation is the client for interacting with the InventoryReservation builders.
 	InventoryReservation *InventoryReservationClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceLine is the client for interacting with the InvoiceLine builders.
+	InvoiceLine *InvoiceLineClient
+	// InvoiceTaxLine is the client for interacting with the InvoiceTaxLine builders.
+	InvoiceTaxLine *InvoiceTaxLineClient
 	// Job is the client for interacting with the Job builders.
 	Job *JobClient
 	// JobExecution is the client for interacting with the JobExecution builders.
@@ -76,6 +120,8 @@ type Tx struct {
 	JobPosting *JobPostingClient
 	// JournalEntry is the client for interacting with the JournalEntry builders.
 	JournalEntry *JournalEntryClient
+	// LabelTemplate is the client for interacting with the LabelTemplate builders.
+	LabelTemplate *LabelTemplateClient
 	// LedgerEntry is the client for interacting with the LedgerEntry builders.
 	LedgerEntry *LedgerEntryClient
 	// LegalHold is the client for interacting with the LegalHold builders.
@@ -94,10 +140,24 @@ type Tx struct {
 	NexusAudit *NexusAuditClient
 	// OneTimeLink is the client for interacting with the OneTimeLink builders.
 	OneTimeLink *OneTimeLinkClient
+	// PatchPolicy is the client for interacting with the PatchPolicy builders.
+	PatchPolicy *PatchPolicyClient
+	// PaymentAllocation is the client for interacting with the PaymentAllocation builders.
+	PaymentAllocation *PaymentAllocationClient
+	// PaymentRun is the client for interacting with the PaymentRun builders.
+	PaymentRun *PaymentRunClient
+	// PaymentRunLine is the client for interacting with the PaymentRunLine builders.
+	PaymentRunLine *PaymentRunLineClient
 	// PerformanceReview is the client for interacting with the PerformanceReview builders.
 	PerformanceReview *PerformanceReviewClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
+	// PosSaleLine is the client for interacting with the PosSaleLine builders.
+	PosSaleLine *PosSaleLineClient
+	// PosShift is the client for interacting with the PosShift builders.
+	PosShift *PosShiftClient
+	// PosTender is the client for interacting with the PosTender builders.
+	PosTender *PosTenderClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
 	// ProductVariant is the client for interacting with the ProductVariant builders.
@@ -134,6 +194,8 @@ type Tx struct {
 	StockAlert *StockAlertClient
 	// StockAuditLog is the client for interacting with the StockAuditLog builders.
 	StockAuditLog *StockAuditLogClient
+	// StockLevel is the client for interacting with the StockLevel builders.
+	StockLevel *StockLevelClient
 	// StockMovement is the client for interacting with the StockMovement builders.
 	StockMovement *StockMovementClient
 	// StrategicRoadmap is the client for interacting with the StrategicRoadmap builders.
@@ -142,6 +204,14 @@ type Tx struct {
 	SuccessionMap *SuccessionMapClient
 	// Supplier is the client for interacting with the Supplier builders.
 	Supplier *SupplierClient
+	// SupplierBill is the client for interacting with the SupplierBill builders.
+	SupplierBill *SupplierBillClient
+	// SupplierBillLine is the client for interacting with the SupplierBillLine builders.
+	SupplierBillLine *SupplierBillLineClient
+	// TaxJurisdiction is the client for interacting with the TaxJurisdiction builders.
+	TaxJurisdiction *TaxJurisdictionClient
+	// TaxRate is the client for interacting with the TaxRate builders.
+	TaxRate *TaxRateClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// Ticket is the client for interacting with the Ticket builders.
@@ -156,6 +226,10 @@ type Tx struct {
 	TimeOffRequest *TimeOffRequestClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
+	// TransferOrder is the client for interacting with the TransferOrder builders.
+	TransferOrder *TransferOrderClient
+	// TransferOrderLine is the client for interacting with the TransferOrderLine builders.
+	TransferOrderLine *TransferOrderLineClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// VaultComment is the client for interacting with the VaultComment builders.
@@ -308,12 +382,22 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
+	tx.AccountBalanceSnapshot = NewAccountBalanceSnapshotClient(tx.config)
 	tx.Agent = NewAgentClient(tx.config)
+	tx.AgentAlert = NewAgentAlertClient(tx.config)
+	tx.AgentAuthority = NewAgentAuthorityClient(tx.config)
+	tx.AgentEnrollmentToken = NewAgentEnrollmentTokenClient(tx.config)
+	tx.AgentInventory = NewAgentInventoryClient(tx.config)
+	tx.AgentSoftware = NewAgentSoftwareClient(tx.config)
+	tx.AlertRule = NewAlertRuleClient(tx.config)
 	tx.Application = NewApplicationClient(tx.config)
 	tx.Asset = NewAssetClient(tx.config)
 	tx.AssetAssignment = NewAssetAssignmentClient(tx.config)
 	tx.AssetType = NewAssetTypeClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.BankRule = NewBankRuleClient(tx.config)
+	tx.BankStatement = NewBankStatementClient(tx.config)
+	tx.BankStatementLine = NewBankStatementLineClient(tx.config)
 	tx.BenefitEnrollment = NewBenefitEnrollmentClient(tx.config)
 	tx.BenefitPlan = NewBenefitPlanClient(tx.config)
 	tx.BudgetForecast = NewBudgetForecastClient(tx.config)
@@ -325,20 +409,33 @@ func (tx *Tx) init() {
 	tx.Contact = NewContactClient(tx.config)
 	tx.Contract = NewContractClient(tx.config)
 	tx.Credential = NewCredentialClient(tx.config)
+	tx.Customer = NewCustomerClient(tx.config)
+	tx.CustomerPayment = NewCustomerPaymentClient(tx.config)
 	tx.Department = NewDepartmentClient(tx.config)
 	tx.DetectionEvent = NewDetectionEventClient(tx.config)
+	tx.DeviceGroup = NewDeviceGroupClient(tx.config)
 	tx.DiscoveryEntry = NewDiscoveryEntryClient(tx.config)
+	tx.EInvoice = NewEInvoiceClient(tx.config)
+	tx.EInvoiceCredential = NewEInvoiceCredentialClient(tx.config)
 	tx.Employee = NewEmployeeClient(tx.config)
+	tx.ExchangeRate = NewExchangeRateClient(tx.config)
+	tx.FiscalPeriod = NewFiscalPeriodClient(tx.config)
 	tx.Goal = NewGoalClient(tx.config)
+	tx.GoodsReceipt = NewGoodsReceiptClient(tx.config)
+	tx.GoodsReceiptLine = NewGoodsReceiptLineClient(tx.config)
 	tx.HealthScoreSnapshot = NewHealthScoreSnapshotClient(tx.config)
 	tx.IVRFlow = NewIVRFlowClient(tx.config)
 	tx.Interview = NewInterviewClient(tx.config)
 	tx.InventoryCount = NewInventoryCountClient(tx.config)
 	tx.InventoryReservation = NewInventoryReservationClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoiceLine = NewInvoiceLineClient(tx.config)
+	tx.InvoiceTaxLine = NewInvoiceTaxLineClient(tx.config)
 	tx.Job = NewJobClient(tx.config)
 	tx.JobExecution = NewJobExecutionClient(tx.config)
 	tx.JobPosting = NewJobPostingClient(tx.config)
 	tx.JournalEntry = NewJournalEntryClient(tx.config)
+	tx.LabelTemplate = NewLabelTemplateClient(tx.config)
 	tx.LedgerEntry = NewLedgerEntryClient(tx.config)
 	tx.LegalHold = NewLegalHoldClient(tx.config)
 	tx.MaintenanceSchedule = NewMaintenanceScheduleClient(tx.config)
@@ -348,8 +445,15 @@ func (tx *Tx) init() {
 	tx.NetworkPort = NewNetworkPortClient(tx.config)
 	tx.NexusAudit = NewNexusAuditClient(tx.config)
 	tx.OneTimeLink = NewOneTimeLinkClient(tx.config)
+	tx.PatchPolicy = NewPatchPolicyClient(tx.config)
+	tx.PaymentAllocation = NewPaymentAllocationClient(tx.config)
+	tx.PaymentRun = NewPaymentRunClient(tx.config)
+	tx.PaymentRunLine = NewPaymentRunLineClient(tx.config)
 	tx.PerformanceReview = NewPerformanceReviewClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
+	tx.PosSaleLine = NewPosSaleLineClient(tx.config)
+	tx.PosShift = NewPosShiftClient(tx.config)
+	tx.PosTender = NewPosTenderClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
 	tx.ProductVariant = NewProductVariantClient(tx.config)
 	tx.PurchaseOrder = NewPurchaseOrderClient(tx.config)
@@ -368,10 +472,15 @@ func (tx *Tx) init() {
 	tx.ServiceRate = NewServiceRateClient(tx.config)
 	tx.StockAlert = NewStockAlertClient(tx.config)
 	tx.StockAuditLog = NewStockAuditLogClient(tx.config)
+	tx.StockLevel = NewStockLevelClient(tx.config)
 	tx.StockMovement = NewStockMovementClient(tx.config)
 	tx.StrategicRoadmap = NewStrategicRoadmapClient(tx.config)
 	tx.SuccessionMap = NewSuccessionMapClient(tx.config)
 	tx.Supplier = NewSupplierClient(tx.config)
+	tx.SupplierBill = NewSupplierBillClient(tx.config)
+	tx.SupplierBillLine = NewSupplierBillLineClient(tx.config)
+	tx.TaxJurisdiction = NewTaxJurisdictionClient(tx.config)
+	tx.TaxRate = NewTaxRateClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
 	tx.Ticket = NewTicketClient(tx.config)
 	tx.TimeEntry = NewTimeEntryClient(tx.config)
@@ -379,6 +488,8 @@ func (tx *Tx) init() {
 	tx.TimeOffPolicy = NewTimeOffPolicyClient(tx.config)
 	tx.TimeOffRequest = NewTimeOffRequestClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
+	tx.TransferOrder = NewTransferOrderClient(tx.config)
+	tx.TransferOrderLine = NewTransferOrderLineClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.VaultComment = NewVaultCommentClient(tx.config)
 	tx.VaultFavorite = NewVaultFavoriteClient(tx.config)
@@ -38,9 +38,23 @@ type WarehouseEdges struct {
 	Tenant *Tenant `json:"tenant,omitempty"`
 	// Products holds the value of the products edge.
 	Products []*Product `json:"products,omitempty"`
+	// GoodsReceipts holds the value of the goods_receipts edge.
+	GoodsReceipts []*GoodsReceipt `json:"goods_receipts,omitempty"`
+	// StockLevels holds the value of the stock_levels edge.
+	StockLevels []*StockLevel `json:"stock_levels,omitempty"`
+	// Movements holds the value of the movements edge.
+	Movements []*StockMovement `json:"movements,omitempty"`
+	// Reservations holds the value of the reservations edge.
+	Reservations []*InventoryReservation `json:"reservations,omitempty"`
+	// TransfersOut holds the value of the transfers_out edge.
+	TransfersOut []*TransferOrder `json:"transfers_out,omitempty"`
+	// TransfersIn holds the value of the transfers_in edge.
+	TransfersIn []*TransferOrder `json:"transfers_in,omitempty"`
+	// PosShifts holds the value of the pos_shifts edge.
+	PosShifts []*PosShift `json:"pos_shifts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -63,6 +77,69 @@ func (e WarehouseEdges) ProductsOrErr() ([]*Product, error) {
 	return nil, &NotLoadedError{edge: "products"}
 }
 
+// GoodsReceiptsOrErr returns the GoodsReceipts value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) GoodsReceiptsOrErr() ([]*GoodsReceipt, error) {
+	if e.loadedTypes[2] {
+		return e.GoodsReceipts, nil
+	}
+	return nil, &NotLoadedError{edge: "goods_receipts"}
+}
+
+// StockLevelsOrErr returns the StockLevels value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) StockLevelsOrErr() ([]*StockLevel, error) {
+	if e.loadedTypes[3] {
+		return e.StockLevels, nil
+	}
+	return nil, &NotLoadedError{edge: "stock_levels"}
+}
+
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) MovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[4] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// ReservationsOrErr returns the Reservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) ReservationsOrErr() ([]*InventoryReservation, error) {
+	if e.loadedTypes[5] {
+		return e.Reservations, nil
+	}
+	return nil, &NotLoadedError{edge: "reservations"}
+}
+
+// TransfersOutOrErr returns the TransfersOut value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) TransfersOutOrErr() ([]*TransferOrder, error) {
+	if e.loadedTypes[6] {
+		return e.TransfersOut, nil
+	}
+	return nil, &NotLoadedError{edge: "transfers_out"}
+}
+
+// TransfersInOrErr returns the TransfersIn value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) TransfersInOrErr() ([]*TransferOrder, error) {
+	if e.loadedTypes[7] {
+		return e.TransfersIn, nil
+	}
+	return nil, &NotLoadedError{edge: "transfers_in"}
+}
+
+// PosShiftsOrErr returns the PosShifts value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) PosShiftsOrErr() ([]*PosShift, error) {
+	if e.loadedTypes[8] {
+		return e.PosShifts, nil
+	}
+	return nil, &NotLoadedError{edge: "pos_shifts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Warehouse) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -151,6 +228,41 @@ func (_m *Warehouse) QueryProducts() *ProductQuery {
 	return NewWarehouseClient(_m.config).QueryProducts(_m)
 }
 
+// QueryGoodsReceipts queries the "goods_receipts" edge of the Warehouse entity.
+func (_m *Warehouse) QueryGoodsReceipts() *GoodsReceiptQuery {
+	return NewWarehouseClient(_m.config).QueryGoodsReceipts(_m)
+}
+
+// QueryStockLevels queries the "stock_levels" edge of the Warehouse entity.
+func (_m *Warehouse) QueryStockLevels() *StockLevelQuery {
+	return NewWarehouseClient(_m.config).QueryStockLevels(_m)
+}
+
+// QueryMovements queries the "movements" edge of the Warehouse entity.
+func (_m *Warehouse) QueryMovements() *StockMovementQuery {
+	return NewWarehouseClient(_m.config).QueryMovements(_m)
+}
+
+// QueryReservations queries the "reservations" edge of the Warehouse entity.
+func (_m *Warehouse) QueryReservations() *InventoryReservationQuery {
+	return NewWarehouseClient(_m.config).QueryReservations(_m)
+}
+
+// QueryTransfersOut queries the "transfers_out" edge of the Warehouse entity.
+func (_m *Warehouse) QueryTransfersOut() *TransferOrderQuery {
+	return NewWarehouseClient(_m.config).QueryTransfersOut(_m)
+}
+
+// QueryTransfersIn queries the "transfers_in" edge of the Warehouse entity.
+func (_m *Warehouse) QueryTransfersIn() *TransferOrderQuery {
+	return NewWarehouseClient(_m.config).QueryTransfersIn(_m)
+}
+
+// QueryPosShifts queries the "pos_shifts" edge of the Warehouse entity.
+func (_m *Warehouse) QueryPosShifts() *PosShiftQuery {
+	return NewWarehouseClient(_m.config).QueryPosShifts(_m)
+}
+
 // Update returns a builder for updating this Warehouse.
 // Note that you need to call Warehouse.Unwrap() before calling this method if this Warehouse
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -24,6 +24,20 @@ const (
 	EdgeTenant = "tenant"
 	// EdgeProducts holds the string denoting the products edge name in mutations.
 	EdgeProducts = "products"
+	// EdgeGoodsReceipts holds the string denoting the goods_receipts edge name in mutations.
+	EdgeGoodsReceipts = "goods_receipts"
+	// EdgeStockLevels holds the string denoting the stock_levels edge name in mutations.
+	EdgeStockLevels = "stock_levels"
+	// EdgeMovements holds the string denoting the movements edge name in mutations.
+	EdgeMovements = "movements"
+	// EdgeReservations holds the string denoting the reservations edge name in mutations.
+	EdgeReservations = "reservations"
+	// EdgeTransfersOut holds the string denoting the transfers_out edge name in mutations.
+	EdgeTransfersOut = "transfers_out"
+	// EdgeTransfersIn holds the string denoting the transfers_in edge name in mutations.
+	EdgeTransfersIn = "transfers_in"
+	// EdgePosShifts holds the string denoting the pos_shifts edge name in mutations.
+	EdgePosShifts = "pos_shifts"
 	// Table holds the table name of the warehouse in the database.
 	Table = "warehouses"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -40,6 +54,55 @@ const (
 	ProductsInverseTable = "products"
 	// ProductsColumn is the table column denoting the products relation/edge.
 	ProductsColumn = "warehouse_products"
+	// GoodsReceiptsTable is the table that holds the goods_receipts relation/edge.
+	GoodsReceiptsTable = "goods_receipts"
+	// GoodsReceiptsInverseTable is the table name for the GoodsReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "goodsreceipt" package.
+	GoodsReceiptsInverseTable = "goods_receipts"
+	// GoodsReceiptsColumn is the table column denoting the goods_receipts relation/edge.
+	GoodsReceiptsColumn = "warehouse_goods_receipts"
+	// StockLevelsTable is the table that holds the stock_levels relation/edge.
+	StockLevelsTable = "stock_levels"
+	// StockLevelsInverseTable is the table name for the StockLevel entity.
+	// It exists in this package in order to avoid circular dependency with the "stocklevel" package.
+	StockLevelsInverseTable = "stock_levels"
+	// StockLevelsColumn is the table column denoting the stock_levels relation/edge.
+	StockLevelsColumn = "warehouse_stock_levels"
+	// MovementsTable is the table that holds the movements relation/edge.
+	MovementsTable = "stock_movements"
+	// MovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	MovementsInverseTable = "stock_movements"
+	// MovementsColumn is the table column denoting the movements relation/edge.
+	MovementsColumn = "warehouse_movements"
+	// ReservationsTable is the table that holds the reservations relation/edge.
+	ReservationsTable = "inventory_reservations"
+	// ReservationsInverseTable is the table name for the InventoryReservation entity.
+	// It exists in this package in order to avoid circular dependency with the "inventoryreservation" package.
+	ReservationsInverseTable = "inventory_reservations"
+	// ReservationsColumn is the table column denoting the reservations relation/edge.
+	ReservationsColumn = "warehouse_reservations"
+	// TransfersOutTable is the table that holds the transfers_out relation/edge.
+	TransfersOutTable = "transfer_orders"
+	// TransfersOutInverseTable is the table name for the TransferOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "transferorder" package.
+	TransfersOutInverseTable = "transfer_orders"
+	// TransfersOutColumn is the table column denoting the transfers_out relation/edge.
+	TransfersOutColumn = "warehouse_transfers_out"
+	// TransfersInTable is the table that holds the transfers_in relation/edge.
+	TransfersInTable = "transfer_orders"
+	// TransfersInInverseTable is the table name for the TransferOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "transferorder" package.
+	TransfersInInverseTable = "transfer_orders"
+	// TransfersInColumn is the table column denoting the transfers_in relation/edge.
+	TransfersInColumn = "warehouse_transfers_in"
+	// PosShiftsTable is the table that holds the pos_shifts relation/edge.
+	PosShiftsTable = "pos_shifts"
+	// PosShiftsInverseTable is the table name for the PosShift entity.
+	// It exists in this package in order to avoid circular dependency with the "posshift" package.
+	PosShiftsInverseTable = "pos_shifts"
+	// PosShiftsColumn is the table column denoting the pos_shifts relation/edge.
+	PosShiftsColumn = "warehouse_pos_shifts"
 )
 
 // Columns holds all SQL columns for warehouse fields.
@@ -127,6 +190,104 @@ func ByProducts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProductsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByGoodsReceiptsCount orders the results by goods_receipts count.
+func ByGoodsReceiptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGoodsReceiptsStep(), opts...)
+	}
+}
+
+// ByGoodsReceipts orders the results by goods_receipts terms.
+func ByGoodsReceipts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGoodsReceiptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStockLevelsCount orders the results by stock_levels count.
+func ByStockLevelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStockLevelsStep(), opts...)
+	}
+}
+
+// ByStockLevels orders the results by stock_levels terms.
+func ByStockLevels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockLevelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMovementsCount orders the results by movements count.
+func ByMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMovementsStep(), opts...)
+	}
+}
+
+// ByMovements orders the results by movements terms.
+func ByMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReservationsCount orders the results by reservations count.
+func ByReservationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReservationsStep(), opts...)
+	}
+}
+
+// ByReservations orders the results by reservations terms.
+func ByReservations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReservationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransfersOutCount orders the results by transfers_out count.
+func ByTransfersOutCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransfersOutStep(), opts...)
+	}
+}
+
+// ByTransfersOut orders the results by transfers_out terms.
+func ByTransfersOut(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransfersOutStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransfersInCount orders the results by transfers_in count.
+func ByTransfersInCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransfersInStep(), opts...)
+	}
+}
+
+// ByTransfersIn orders the results by transfers_in terms.
+func ByTransfersIn(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransfersInStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPosShiftsCount orders the results by pos_shifts count.
+func ByPosShiftsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPosShiftsStep(), opts...)
+	}
+}
+
+// ByPosShifts orders the results by pos_shifts terms.
+func ByPosShifts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPosShiftsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -141,3 +302,52 @@ func newProductsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ProductsTable, ProductsColumn),
 	)
 }
+func newGoodsReceiptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GoodsReceiptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GoodsReceiptsTable, GoodsReceiptsColumn),
+	)
+}
+func newStockLevelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockLevelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StockLevelsTable, StockLevelsColumn),
+	)
+}
+func newMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MovementsTable, MovementsColumn),
+	)
+}
+func newReservationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReservationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+	)
+}
+func newTransfersOutStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransfersOutInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransfersOutTable, TransfersOutColumn),
+	)
+}
+func newTransfersInStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransfersInInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransfersInTable, TransfersInColumn),
+	)
+}
+func newPosShiftsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PosShiftsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PosShiftsTable, PosShiftsColumn),
+	)
+}
@@ -335,6 +335,167 @@ func HasProductsWith(preds ...predicate.Product) predicate.Warehouse {
 	})
 }
 
+// HasGoodsReceipts applies the HasEdge predicate on the "goods_receipts" edge.
+func HasGoodsReceipts() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GoodsReceiptsTable, GoodsReceiptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGoodsReceiptsWith applies the HasEdge predicate on the "goods_receipts" edge with a given conditions (other predicates).
+func HasGoodsReceiptsWith(preds ...predicate.GoodsReceipt) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newGoodsReceiptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStockLevels applies the HasEdge predicate on the "stock_levels" edge.
+func HasStockLevels() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StockLevelsTable, StockLevelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStockLevelsWith applies the HasEdge predicate on the "stock_levels" edge with a given conditions (other predicates).
+func HasStockLevelsWith(preds ...predicate.StockLevel) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newStockLevelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMovements applies the HasEdge predicate on the "movements" edge.
+func HasMovements() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MovementsTable, MovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMovementsWith applies the HasEdge predicate on the "movements" edge with a given conditions (other predicates).
+func HasMovementsWith(preds ...predicate.StockMovement) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReservations applies the HasEdge predicate on the "reservations" edge.
+func HasReservations() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReservationsWith applies the HasEdge predicate on the "reservations" edge with a given conditions (other predicates).
+func HasReservationsWith(preds ...predicate.InventoryReservation) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newReservationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransfersOut applies the HasEdge predicate on the "transfers_out" edge.
+func HasTransfersOut() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransfersOutTable, TransfersOutColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransfersOutWith applies the HasEdge predicate on the "transfers_out" edge with a given conditions (other predicates).
+func HasTransfersOutWith(preds ...predicate.TransferOrder) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newTransfersOutStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransfersIn applies the HasEdge predicate on the "transfers_in" edge.
+func HasTransfersIn() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransfersInTable, TransfersInColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransfersInWith applies the HasEdge predicate on the "transfers_in" edge with a given conditions (other predicates).
+func HasTransfersInWith(preds ...predicate.TransferOrder) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newTransfersInStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPosShifts applies the HasEdge predicate on the "pos_shifts" edge.
+func HasPosShifts() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PosShiftsTable, PosShiftsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPosShiftsWith applies the HasEdge predicate on the "pos_shifts" edge with a given conditions (other predicates).
+func HasPosShiftsWith(preds ...predicate.PosShift) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newPosShiftsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Warehouse) predicate.Warehouse {
 	return predicate.Warehouse(sql.AndPredicates(predicates...))
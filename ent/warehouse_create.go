@@ -6,8 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sent/ent/goodsreceipt"
+	"sent/ent/inventoryreservation"
+	"sent/ent/posshift"
 	"sent/ent/product"
+	"sent/ent/stocklevel"
+	"sent/ent/stockmovement"
 	"sent/ent/tenant"
+	"sent/ent/transferorder"
 	"sent/ent/warehouse"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -87,6 +93,111 @@ func (_c *WarehouseCreate) AddProducts(v ...*Product) *WarehouseCreate {
 	return _c.AddProductIDs(ids...)
 }
 
+// AddGoodsReceiptIDs adds the "goods_receipts" edge to the GoodsReceipt entity by IDs.
+func (_c *WarehouseCreate) AddGoodsReceiptIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddGoodsReceiptIDs(ids...)
+	return _c
+}
+
+// AddGoodsReceipts adds the "goods_receipts" edges to the GoodsReceipt entity.
+func (_c *WarehouseCreate) AddGoodsReceipts(v ...*GoodsReceipt) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGoodsReceiptIDs(ids...)
+}
+
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_c *WarehouseCreate) AddStockLevelIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddStockLevelIDs(ids...)
+	return _c
+}
+
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_c *WarehouseCreate) AddStockLevels(v ...*StockLevel) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStockLevelIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_c *WarehouseCreate) AddMovementIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddMovementIDs(ids...)
+	return _c
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_c *WarehouseCreate) AddMovements(v ...*StockMovement) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMovementIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the InventoryReservation entity by IDs.
+func (_c *WarehouseCreate) AddReservationIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddReservationIDs(ids...)
+	return _c
+}
+
+// AddReservations adds the "reservations" edges to the InventoryReservation entity.
+func (_c *WarehouseCreate) AddReservations(v ...*InventoryReservation) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReservationIDs(ids...)
+}
+
+// AddTransfersOutIDs adds the "transfers_out" edge to the TransferOrder entity by IDs.
+func (_c *WarehouseCreate) AddTransfersOutIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddTransfersOutIDs(ids...)
+	return _c
+}
+
+// AddTransfersOut adds the "transfers_out" edges to the TransferOrder entity.
+func (_c *WarehouseCreate) AddTransfersOut(v ...*TransferOrder) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransfersOutIDs(ids...)
+}
+
+// AddTransfersInIDs adds the "transfers_in" edge to the TransferOrder entity by IDs.
+func (_c *WarehouseCreate) AddTransfersInIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddTransfersInIDs(ids...)
+	return _c
+}
+
+// AddTransfersIn adds the "transfers_in" edges to the TransferOrder entity.
+func (_c *WarehouseCreate) AddTransfersIn(v ...*TransferOrder) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransfersInIDs(ids...)
+}
+
+// AddPosShiftIDs adds the "pos_shifts" edge to the PosShift entity by IDs.
+func (_c *WarehouseCreate) AddPosShiftIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddPosShiftIDs(ids...)
+	return _c
+}
+
+// AddPosShifts adds the "pos_shifts" edges to the PosShift entity.
+func (_c *WarehouseCreate) AddPosShifts(v ...*PosShift) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPosShiftIDs(ids...)
+}
+
 // Mutation returns the WarehouseMutation object of the builder.
 func (_c *WarehouseCreate) Mutation() *WarehouseMutation {
 	return _c.mutation
@@ -222,6 +333,118 @@ func (_c *WarehouseCreate) createSpec() (*Warehouse, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.GoodsReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.GoodsReceiptsTable,
+			Columns: []string{warehouse.GoodsReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.StockLevelsTable,
+			Columns: []string{warehouse.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.MovementsTable,
+			Columns: []string{warehouse.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.ReservationsTable,
+			Columns: []string{warehouse.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransfersOutIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersOutTable,
+			Columns: []string{warehouse.TransfersOutColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransfersInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersInTable,
+			Columns: []string{warehouse.TransfersInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PosShiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.PosShiftsTable,
+			Columns: []string{warehouse.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,9 +7,15 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"sent/ent/goodsreceipt"
+	"sent/ent/inventoryreservation"
+	"sent/ent/posshift"
 	"sent/ent/predicate"
 	"sent/ent/product"
+	"sent/ent/stocklevel"
+	"sent/ent/stockmovement"
 	"sent/ent/tenant"
+	"sent/ent/transferorder"
 	"sent/ent/warehouse"
 
 	"entgo.io/ent"
@@ -21,14 +27,21 @@ import (
 // WarehouseQuery is the builder for querying Warehouse entities.
 type WarehouseQuery struct {
 	config
-	ctx          *QueryContext
-	order        []warehouse.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Warehouse
-	withTenant   *TenantQuery
-	withProducts *ProductQuery
-	withFKs      bool
-	modifiers    []func(*sql.Selector)
+	ctx               *QueryContext
+	order             []warehouse.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Warehouse
+	withTenant        *TenantQuery
+	withProducts      *ProductQuery
+	withGoodsReceipts *GoodsReceiptQuery
+	withStockLevels   *StockLevelQuery
+	withMovements     *StockMovementQuery
+	withReservations  *InventoryReservationQuery
+	withTransfersOut  *TransferOrderQuery
+	withTransfersIn   *TransferOrderQuery
+	withPosShifts     *PosShiftQuery
+	withFKs           bool
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,6 +122,160 @@ func (_q *WarehouseQuery) QueryProducts() *ProductQuery {
 	return query
 }
 
+// QueryGoodsReceipts chains the current query on the "goods_receipts" edge.
+func (_q *WarehouseQuery) QueryGoodsReceipts() *GoodsReceiptQuery {
+	query := (&GoodsReceiptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(goodsreceipt.Table, goodsreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.GoodsReceiptsTable, warehouse.GoodsReceiptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStockLevels chains the current query on the "stock_levels" edge.
+func (_q *WarehouseQuery) QueryStockLevels() *StockLevelQuery {
+	query := (&StockLevelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(stocklevel.Table, stocklevel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.StockLevelsTable, warehouse.StockLevelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMovements chains the current query on the "movements" edge.
+func (_q *WarehouseQuery) QueryMovements() *StockMovementQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.MovementsTable, warehouse.MovementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReservations chains the current query on the "reservations" edge.
+func (_q *WarehouseQuery) QueryReservations() *InventoryReservationQuery {
+	query := (&InventoryReservationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(inventoryreservation.Table, inventoryreservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.ReservationsTable, warehouse.ReservationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransfersOut chains the current query on the "transfers_out" edge.
+func (_q *WarehouseQuery) QueryTransfersOut() *TransferOrderQuery {
+	query := (&TransferOrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(transferorder.Table, transferorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.TransfersOutTable, warehouse.TransfersOutColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransfersIn chains the current query on the "transfers_in" edge.
+func (_q *WarehouseQuery) QueryTransfersIn() *TransferOrderQuery {
+	query := (&TransferOrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(transferorder.Table, transferorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.TransfersInTable, warehouse.TransfersInColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPosShifts chains the current query on the "pos_shifts" edge.
+func (_q *WarehouseQuery) QueryPosShifts() *PosShiftQuery {
+	query := (&PosShiftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(posshift.Table, posshift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.PosShiftsTable, warehouse.PosShiftsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Warehouse entity from the query.
 // Returns a *NotFoundError when no Warehouse was found.
 func (_q *WarehouseQuery) First(ctx context.Context) (*Warehouse, error) {
@@ -296,13 +463,20 @@ func (_q *WarehouseQuery) Clone() *WarehouseQuery {
 		return nil
 	}
 	return &WarehouseQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]warehouse.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Warehouse{}, _q.predicates...),
-		withTenant:   _q.withTenant.Clone(),
-		withProducts: _q.withProducts.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]warehouse.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.Warehouse{}, _q.predicates...),
+		withTenant:        _q.withTenant.Clone(),
+		withProducts:      _q.withProducts.Clone(),
+		withGoodsReceipts: _q.withGoodsReceipts.Clone(),
+		withStockLevels:   _q.withStockLevels.Clone(),
+		withMovements:     _q.withMovements.Clone(),
+		withReservations:  _q.withReservations.Clone(),
+		withTransfersOut:  _q.withTransfersOut.Clone(),
+		withTransfersIn:   _q.withTransfersIn.Clone(),
+		withPosShifts:     _q.withPosShifts.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -332,6 +506,83 @@ func (_q *WarehouseQuery) WithProducts(opts ...func(*ProductQuery)) *WarehouseQu
 	return _q
 }
 
+// WithGoodsReceipts tells the query-builder to eager-load the nodes that are connected to
+// the "goods_receipts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithGoodsReceipts(opts ...func(*GoodsReceiptQuery)) *WarehouseQuery {
+	query := (&GoodsReceiptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGoodsReceipts = query
+	return _q
+}
+
+// WithStockLevels tells the query-builder to eager-load the nodes that are connected to
+// the "stock_levels" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithStockLevels(opts ...func(*StockLevelQuery)) *WarehouseQuery {
+	query := (&StockLevelClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStockLevels = query
+	return _q
+}
+
+// WithMovements tells the query-builder to eager-load the nodes that are connected to
+// the "movements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithMovements(opts ...func(*StockMovementQuery)) *WarehouseQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMovements = query
+	return _q
+}
+
+// WithReservations tells the query-builder to eager-load the nodes that are connected to
+// the "reservations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithReservations(opts ...func(*InventoryReservationQuery)) *WarehouseQuery {
+	query := (&InventoryReservationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReservations = query
+	return _q
+}
+
+// WithTransfersOut tells the query-builder to eager-load the nodes that are connected to
+// the "transfers_out" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithTransfersOut(opts ...func(*TransferOrderQuery)) *WarehouseQuery {
+	query := (&TransferOrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransfersOut = query
+	return _q
+}
+
+// WithTransfersIn tells the query-builder to eager-load the nodes that are connected to
+// the "transfers_in" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithTransfersIn(opts ...func(*TransferOrderQuery)) *WarehouseQuery {
+	query := (&TransferOrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransfersIn = query
+	return _q
+}
+
+// WithPosShifts tells the query-builder to eager-load the nodes that are connected to
+// the "pos_shifts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithPosShifts(opts ...func(*PosShiftQuery)) *WarehouseQuery {
+	query := (&PosShiftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPosShifts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -411,9 +662,16 @@ func (_q *WarehouseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wa
 		nodes       = []*Warehouse{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [9]bool{
 			_q.withTenant != nil,
 			_q.withProducts != nil,
+			_q.withGoodsReceipts != nil,
+			_q.withStockLevels != nil,
+			_q.withMovements != nil,
+			_q.withReservations != nil,
+			_q.withTransfersOut != nil,
+			_q.withTransfersIn != nil,
+			_q.withPosShifts != nil,
 		}
 	)
 	if _q.withTenant != nil {
@@ -456,6 +714,55 @@ func (_q *WarehouseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wa
 			return nil, err
 		}
 	}
+	if query := _q.withGoodsReceipts; query != nil {
+		if err := _q.loadGoodsReceipts(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.GoodsReceipts = []*GoodsReceipt{} },
+			func(n *Warehouse, e *GoodsReceipt) { n.Edges.GoodsReceipts = append(n.Edges.GoodsReceipts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStockLevels; query != nil {
+		if err := _q.loadStockLevels(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.StockLevels = []*StockLevel{} },
+			func(n *Warehouse, e *StockLevel) { n.Edges.StockLevels = append(n.Edges.StockLevels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMovements; query != nil {
+		if err := _q.loadMovements(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.Movements = []*StockMovement{} },
+			func(n *Warehouse, e *StockMovement) { n.Edges.Movements = append(n.Edges.Movements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReservations; query != nil {
+		if err := _q.loadReservations(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.Reservations = []*InventoryReservation{} },
+			func(n *Warehouse, e *InventoryReservation) { n.Edges.Reservations = append(n.Edges.Reservations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransfersOut; query != nil {
+		if err := _q.loadTransfersOut(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.TransfersOut = []*TransferOrder{} },
+			func(n *Warehouse, e *TransferOrder) { n.Edges.TransfersOut = append(n.Edges.TransfersOut, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransfersIn; query != nil {
+		if err := _q.loadTransfersIn(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.TransfersIn = []*TransferOrder{} },
+			func(n *Warehouse, e *TransferOrder) { n.Edges.TransfersIn = append(n.Edges.TransfersIn, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPosShifts; query != nil {
+		if err := _q.loadPosShifts(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.PosShifts = []*PosShift{} },
+			func(n *Warehouse, e *PosShift) { n.Edges.PosShifts = append(n.Edges.PosShifts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -522,6 +829,223 @@ func (_q *WarehouseQuery) loadProducts(ctx context.Context, query *ProductQuery,
 	}
 	return nil
 }
+func (_q *WarehouseQuery) loadGoodsReceipts(ctx context.Context, query *GoodsReceiptQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *GoodsReceipt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GoodsReceipt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.GoodsReceiptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_goods_receipts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_goods_receipts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_goods_receipts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WarehouseQuery) loadStockLevels(ctx context.Context, query *StockLevelQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *StockLevel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StockLevel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.StockLevelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_stock_levels
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_stock_levels" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_stock_levels" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WarehouseQuery) loadMovements(ctx context.Context, query *StockMovementQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *StockMovement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.MovementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_movements
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_movements" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_movements" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WarehouseQuery) loadReservations(ctx context.Context, query *InventoryReservationQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *InventoryReservation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.InventoryReservation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.ReservationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_reservations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_reservations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_reservations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WarehouseQuery) loadTransfersOut(ctx context.Context, query *TransferOrderQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *TransferOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TransferOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.TransfersOutColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_transfers_out
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_transfers_out" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_transfers_out" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WarehouseQuery) loadTransfersIn(ctx context.Context, query *TransferOrderQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *TransferOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TransferOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.TransfersInColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_transfers_in
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_transfers_in" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_transfers_in" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WarehouseQuery) loadPosShifts(ctx context.Context, query *PosShiftQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *PosShift)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PosShift(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.PosShiftsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_pos_shifts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_pos_shifts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_pos_shifts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *WarehouseQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,9 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sent/ent/goodsreceipt"
+	"sent/ent/inventoryreservation"
+	"sent/ent/posshift"
 	"sent/ent/predicate"
 	"sent/ent/product"
+	"sent/ent/stocklevel"
+	"sent/ent/stockmovement"
 	"sent/ent/tenant"
+	"sent/ent/transferorder"
 	"sent/ent/warehouse"
 
 	"entgo.io/ent/dialect/sql"
@@ -118,6 +124,111 @@ func (_u *WarehouseUpdate) AddProducts(v ...*Product) *WarehouseUpdate {
 	return _u.AddProductIDs(ids...)
 }
 
+// AddGoodsReceiptIDs adds the "goods_receipts" edge to the GoodsReceipt entity by IDs.
+func (_u *WarehouseUpdate) AddGoodsReceiptIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddGoodsReceiptIDs(ids...)
+	return _u
+}
+
+// AddGoodsReceipts adds the "goods_receipts" edges to the GoodsReceipt entity.
+func (_u *WarehouseUpdate) AddGoodsReceipts(v ...*GoodsReceipt) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoodsReceiptIDs(ids...)
+}
+
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_u *WarehouseUpdate) AddStockLevelIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddStockLevelIDs(ids...)
+	return _u
+}
+
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_u *WarehouseUpdate) AddStockLevels(v ...*StockLevel) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockLevelIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *WarehouseUpdate) AddMovementIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *WarehouseUpdate) AddMovements(v ...*StockMovement) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the InventoryReservation entity by IDs.
+func (_u *WarehouseUpdate) AddReservationIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddReservationIDs(ids...)
+	return _u
+}
+
+// AddReservations adds the "reservations" edges to the InventoryReservation entity.
+func (_u *WarehouseUpdate) AddReservations(v ...*InventoryReservation) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReservationIDs(ids...)
+}
+
+// AddTransfersOutIDs adds the "transfers_out" edge to the TransferOrder entity by IDs.
+func (_u *WarehouseUpdate) AddTransfersOutIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddTransfersOutIDs(ids...)
+	return _u
+}
+
+// AddTransfersOut adds the "transfers_out" edges to the TransferOrder entity.
+func (_u *WarehouseUpdate) AddTransfersOut(v ...*TransferOrder) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransfersOutIDs(ids...)
+}
+
+// AddTransfersInIDs adds the "transfers_in" edge to the TransferOrder entity by IDs.
+func (_u *WarehouseUpdate) AddTransfersInIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddTransfersInIDs(ids...)
+	return _u
+}
+
+// AddTransfersIn adds the "transfers_in" edges to the TransferOrder entity.
+func (_u *WarehouseUpdate) AddTransfersIn(v ...*TransferOrder) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransfersInIDs(ids...)
+}
+
+// AddPosShiftIDs adds the "pos_shifts" edge to the PosShift entity by IDs.
+func (_u *WarehouseUpdate) AddPosShiftIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddPosShiftIDs(ids...)
+	return _u
+}
+
+// AddPosShifts adds the "pos_shifts" edges to the PosShift entity.
+func (_u *WarehouseUpdate) AddPosShifts(v ...*PosShift) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPosShiftIDs(ids...)
+}
+
 // Mutation returns the WarehouseMutation object of the builder.
 func (_u *WarehouseUpdate) Mutation() *WarehouseMutation {
 	return _u.mutation
@@ -150,6 +261,153 @@ func (_u *WarehouseUpdate) RemoveProducts(v ...*Product) *WarehouseUpdate {
 	return _u.RemoveProductIDs(ids...)
 }
 
+// ClearGoodsReceipts clears all "goods_receipts" edges to the GoodsReceipt entity.
+func (_u *WarehouseUpdate) ClearGoodsReceipts() *WarehouseUpdate {
+	_u.mutation.ClearGoodsReceipts()
+	return _u
+}
+
+// RemoveGoodsReceiptIDs removes the "goods_receipts" edge to GoodsReceipt entities by IDs.
+func (_u *WarehouseUpdate) RemoveGoodsReceiptIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemoveGoodsReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveGoodsReceipts removes "goods_receipts" edges to GoodsReceipt entities.
+func (_u *WarehouseUpdate) RemoveGoodsReceipts(v ...*GoodsReceipt) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoodsReceiptIDs(ids...)
+}
+
+// ClearStockLevels clears all "stock_levels" edges to the StockLevel entity.
+func (_u *WarehouseUpdate) ClearStockLevels() *WarehouseUpdate {
+	_u.mutation.ClearStockLevels()
+	return _u
+}
+
+// RemoveStockLevelIDs removes the "stock_levels" edge to StockLevel entities by IDs.
+func (_u *WarehouseUpdate) RemoveStockLevelIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemoveStockLevelIDs(ids...)
+	return _u
+}
+
+// RemoveStockLevels removes "stock_levels" edges to StockLevel entities.
+func (_u *WarehouseUpdate) RemoveStockLevels(v ...*StockLevel) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockLevelIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *WarehouseUpdate) ClearMovements() *WarehouseUpdate {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *WarehouseUpdate) RemoveMovementIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *WarehouseUpdate) RemoveMovements(v ...*StockMovement) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// ClearReservations clears all "reservations" edges to the InventoryReservation entity.
+func (_u *WarehouseUpdate) ClearReservations() *WarehouseUpdate {
+	_u.mutation.ClearReservations()
+	return _u
+}
+
+// RemoveReservationIDs removes the "reservations" edge to InventoryReservation entities by IDs.
+func (_u *WarehouseUpdate) RemoveReservationIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemoveReservationIDs(ids...)
+	return _u
+}
+
+// RemoveReservations removes "reservations" edges to InventoryReservation entities.
+func (_u *WarehouseUpdate) RemoveReservations(v ...*InventoryReservation) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReservationIDs(ids...)
+}
+
+// ClearTransfersOut clears all "transfers_out" edges to the TransferOrder entity.
+func (_u *WarehouseUpdate) ClearTransfersOut() *WarehouseUpdate {
+	_u.mutation.ClearTransfersOut()
+	return _u
+}
+
+// RemoveTransfersOutIDs removes the "transfers_out" edge to TransferOrder entities by IDs.
+func (_u *WarehouseUpdate) RemoveTransfersOutIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemoveTransfersOutIDs(ids...)
+	return _u
+}
+
+// RemoveTransfersOut removes "transfers_out" edges to TransferOrder entities.
+func (_u *WarehouseUpdate) RemoveTransfersOut(v ...*TransferOrder) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransfersOutIDs(ids...)
+}
+
+// ClearTransfersIn clears all "transfers_in" edges to the TransferOrder entity.
+func (_u *WarehouseUpdate) ClearTransfersIn() *WarehouseUpdate {
+	_u.mutation.ClearTransfersIn()
+	return _u
+}
+
+// RemoveTransfersInIDs removes the "transfers_in" edge to TransferOrder entities by IDs.
+func (_u *WarehouseUpdate) RemoveTransfersInIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemoveTransfersInIDs(ids...)
+	return _u
+}
+
+// RemoveTransfersIn removes "transfers_in" edges to TransferOrder entities.
+func (_u *WarehouseUpdate) RemoveTransfersIn(v ...*TransferOrder) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransfersInIDs(ids...)
+}
+
+// ClearPosShifts clears all "pos_shifts" edges to the PosShift entity.
+func (_u *WarehouseUpdate) ClearPosShifts() *WarehouseUpdate {
+	_u.mutation.ClearPosShifts()
+	return _u
+}
+
+// RemovePosShiftIDs removes the "pos_shifts" edge to PosShift entities by IDs.
+func (_u *WarehouseUpdate) RemovePosShiftIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemovePosShiftIDs(ids...)
+	return _u
+}
+
+// RemovePosShifts removes "pos_shifts" edges to PosShift entities.
+func (_u *WarehouseUpdate) RemovePosShifts(v ...*PosShift) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePosShiftIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *WarehouseUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -297,83 +555,398 @@ func (_u *WarehouseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{warehouse.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.GoodsReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.GoodsReceiptsTable,
+			Columns: []string{warehouse.GoodsReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// WarehouseUpdateOne is the builder for updating a single Warehouse entity.
-type WarehouseUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *WarehouseMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetName sets the "name" field.
-func (_u *WarehouseUpdateOne) SetName(v string) *WarehouseUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *WarehouseUpdateOne) SetNillableName(v *string) *WarehouseUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if nodes := _u.mutation.RemovedGoodsReceiptsIDs(); len(nodes) > 0 && !_u.mutation.GoodsReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.GoodsReceiptsTable,
+			Columns: []string{warehouse.GoodsReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetLocationCode sets the "location_code" field.
-func (_u *WarehouseUpdateOne) SetLocationCode(v string) *WarehouseUpdateOne {
-	_u.mutation.SetLocationCode(v)
-	return _u
-}
-
-// SetNillableLocationCode sets the "location_code" field if the given value is not nil.
-func (_u *WarehouseUpdateOne) SetNillableLocationCode(v *string) *WarehouseUpdateOne {
-	if v != nil {
-		_u.SetLocationCode(*v)
+	if nodes := _u.mutation.GoodsReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.GoodsReceiptsTable,
+			Columns: []string{warehouse.GoodsReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetAddress sets the "address" field.
-func (_u *WarehouseUpdateOne) SetAddress(v string) *WarehouseUpdateOne {
-	_u.mutation.SetAddress(v)
-	return _u
-}
-
-// SetNillableAddress sets the "address" field if the given value is not nil.
-func (_u *WarehouseUpdateOne) SetNillableAddress(v *string) *WarehouseUpdateOne {
-	if v != nil {
-		_u.SetAddress(*v)
+	if _u.mutation.StockLevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.StockLevelsTable,
+			Columns: []string{warehouse.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearAddress clears the value of the "address" field.
-func (_u *WarehouseUpdateOne) ClearAddress() *WarehouseUpdateOne {
-	_u.mutation.ClearAddress()
-	return _u
-}
-
-// SetIsActive sets the "is_active" field.
-func (_u *WarehouseUpdateOne) SetIsActive(v bool) *WarehouseUpdateOne {
-	_u.mutation.SetIsActive(v)
-	return _u
-}
-
-// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+	if nodes := _u.mutation.RemovedStockLevelsIDs(); len(nodes) > 0 && !_u.mutation.StockLevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.StockLevelsTable,
+			Columns: []string{warehouse.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.StockLevelsTable,
+			Columns: []string{warehouse.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.MovementsTable,
+			Columns: []string{warehouse.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.MovementsTable,
+			Columns: []string{warehouse.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.MovementsTable,
+			Columns: []string{warehouse.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.ReservationsTable,
+			Columns: []string{warehouse.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !_u.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.ReservationsTable,
+			Columns: []string{warehouse.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.ReservationsTable,
+			Columns: []string{warehouse.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransfersOutCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersOutTable,
+			Columns: []string{warehouse.TransfersOutColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransfersOutIDs(); len(nodes) > 0 && !_u.mutation.TransfersOutCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersOutTable,
+			Columns: []string{warehouse.TransfersOutColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransfersOutIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersOutTable,
+			Columns: []string{warehouse.TransfersOutColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransfersInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersInTable,
+			Columns: []string{warehouse.TransfersInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransfersInIDs(); len(nodes) > 0 && !_u.mutation.TransfersInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersInTable,
+			Columns: []string{warehouse.TransfersInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransfersInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersInTable,
+			Columns: []string{warehouse.TransfersInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PosShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.PosShiftsTable,
+			Columns: []string{warehouse.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPosShiftsIDs(); len(nodes) > 0 && !_u.mutation.PosShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.PosShiftsTable,
+			Columns: []string{warehouse.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PosShiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.PosShiftsTable,
+			Columns: []string{warehouse.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{warehouse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WarehouseUpdateOne is the builder for updating a single Warehouse entity.
+type WarehouseUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WarehouseMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *WarehouseUpdateOne) SetName(v string) *WarehouseUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WarehouseUpdateOne) SetNillableName(v *string) *WarehouseUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLocationCode sets the "location_code" field.
+func (_u *WarehouseUpdateOne) SetLocationCode(v string) *WarehouseUpdateOne {
+	_u.mutation.SetLocationCode(v)
+	return _u
+}
+
+// SetNillableLocationCode sets the "location_code" field if the given value is not nil.
+func (_u *WarehouseUpdateOne) SetNillableLocationCode(v *string) *WarehouseUpdateOne {
+	if v != nil {
+		_u.SetLocationCode(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *WarehouseUpdateOne) SetAddress(v string) *WarehouseUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *WarehouseUpdateOne) SetNillableAddress(v *string) *WarehouseUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (_u *WarehouseUpdateOne) ClearAddress() *WarehouseUpdateOne {
+	_u.mutation.ClearAddress()
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *WarehouseUpdateOne) SetIsActive(v bool) *WarehouseUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
 func (_u *WarehouseUpdateOne) SetNillableIsActive(v *bool) *WarehouseUpdateOne {
 	if v != nil {
 		_u.SetIsActive(*v)
@@ -398,45 +971,297 @@ func (_u *WarehouseUpdateOne) AddProductIDs(ids ...int) *WarehouseUpdateOne {
 	return _u
 }
 
-// AddProducts adds the "products" edges to the Product entity.
-func (_u *WarehouseUpdateOne) AddProducts(v ...*Product) *WarehouseUpdateOne {
+// AddProducts adds the "products" edges to the Product entity.
+func (_u *WarehouseUpdateOne) AddProducts(v ...*Product) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProductIDs(ids...)
+}
+
+// AddGoodsReceiptIDs adds the "goods_receipts" edge to the GoodsReceipt entity by IDs.
+func (_u *WarehouseUpdateOne) AddGoodsReceiptIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddGoodsReceiptIDs(ids...)
+	return _u
+}
+
+// AddGoodsReceipts adds the "goods_receipts" edges to the GoodsReceipt entity.
+func (_u *WarehouseUpdateOne) AddGoodsReceipts(v ...*GoodsReceipt) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoodsReceiptIDs(ids...)
+}
+
+// AddStockLevelIDs adds the "stock_levels" edge to the StockLevel entity by IDs.
+func (_u *WarehouseUpdateOne) AddStockLevelIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddStockLevelIDs(ids...)
+	return _u
+}
+
+// AddStockLevels adds the "stock_levels" edges to the StockLevel entity.
+func (_u *WarehouseUpdateOne) AddStockLevels(v ...*StockLevel) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockLevelIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *WarehouseUpdateOne) AddMovementIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *WarehouseUpdateOne) AddMovements(v ...*StockMovement) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the InventoryReservation entity by IDs.
+func (_u *WarehouseUpdateOne) AddReservationIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddReservationIDs(ids...)
+	return _u
+}
+
+// AddReservations adds the "reservations" edges to the InventoryReservation entity.
+func (_u *WarehouseUpdateOne) AddReservations(v ...*InventoryReservation) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReservationIDs(ids...)
+}
+
+// AddTransfersOutIDs adds the "transfers_out" edge to the TransferOrder entity by IDs.
+func (_u *WarehouseUpdateOne) AddTransfersOutIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddTransfersOutIDs(ids...)
+	return _u
+}
+
+// AddTransfersOut adds the "transfers_out" edges to the TransferOrder entity.
+func (_u *WarehouseUpdateOne) AddTransfersOut(v ...*TransferOrder) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransfersOutIDs(ids...)
+}
+
+// AddTransfersInIDs adds the "transfers_in" edge to the TransferOrder entity by IDs.
+func (_u *WarehouseUpdateOne) AddTransfersInIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddTransfersInIDs(ids...)
+	return _u
+}
+
+// AddTransfersIn adds the "transfers_in" edges to the TransferOrder entity.
+func (_u *WarehouseUpdateOne) AddTransfersIn(v ...*TransferOrder) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransfersInIDs(ids...)
+}
+
+// AddPosShiftIDs adds the "pos_shifts" edge to the PosShift entity by IDs.
+func (_u *WarehouseUpdateOne) AddPosShiftIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddPosShiftIDs(ids...)
+	return _u
+}
+
+// AddPosShifts adds the "pos_shifts" edges to the PosShift entity.
+func (_u *WarehouseUpdateOne) AddPosShifts(v ...*PosShift) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPosShiftIDs(ids...)
+}
+
+// Mutation returns the WarehouseMutation object of the builder.
+func (_u *WarehouseUpdateOne) Mutation() *WarehouseMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *WarehouseUpdateOne) ClearTenant() *WarehouseUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (_u *WarehouseUpdateOne) ClearProducts() *WarehouseUpdateOne {
+	_u.mutation.ClearProducts()
+	return _u
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveProductIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveProductIDs(ids...)
+	return _u
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (_u *WarehouseUpdateOne) RemoveProducts(v ...*Product) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProductIDs(ids...)
+}
+
+// ClearGoodsReceipts clears all "goods_receipts" edges to the GoodsReceipt entity.
+func (_u *WarehouseUpdateOne) ClearGoodsReceipts() *WarehouseUpdateOne {
+	_u.mutation.ClearGoodsReceipts()
+	return _u
+}
+
+// RemoveGoodsReceiptIDs removes the "goods_receipts" edge to GoodsReceipt entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveGoodsReceiptIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveGoodsReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveGoodsReceipts removes "goods_receipts" edges to GoodsReceipt entities.
+func (_u *WarehouseUpdateOne) RemoveGoodsReceipts(v ...*GoodsReceipt) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoodsReceiptIDs(ids...)
+}
+
+// ClearStockLevels clears all "stock_levels" edges to the StockLevel entity.
+func (_u *WarehouseUpdateOne) ClearStockLevels() *WarehouseUpdateOne {
+	_u.mutation.ClearStockLevels()
+	return _u
+}
+
+// RemoveStockLevelIDs removes the "stock_levels" edge to StockLevel entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveStockLevelIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveStockLevelIDs(ids...)
+	return _u
+}
+
+// RemoveStockLevels removes "stock_levels" edges to StockLevel entities.
+func (_u *WarehouseUpdateOne) RemoveStockLevels(v ...*StockLevel) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockLevelIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *WarehouseUpdateOne) ClearMovements() *WarehouseUpdateOne {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveMovementIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *WarehouseUpdateOne) RemoveMovements(v ...*StockMovement) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// ClearReservations clears all "reservations" edges to the InventoryReservation entity.
+func (_u *WarehouseUpdateOne) ClearReservations() *WarehouseUpdateOne {
+	_u.mutation.ClearReservations()
+	return _u
+}
+
+// RemoveReservationIDs removes the "reservations" edge to InventoryReservation entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveReservationIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveReservationIDs(ids...)
+	return _u
+}
+
+// RemoveReservations removes "reservations" edges to InventoryReservation entities.
+func (_u *WarehouseUpdateOne) RemoveReservations(v ...*InventoryReservation) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReservationIDs(ids...)
+}
+
+// ClearTransfersOut clears all "transfers_out" edges to the TransferOrder entity.
+func (_u *WarehouseUpdateOne) ClearTransfersOut() *WarehouseUpdateOne {
+	_u.mutation.ClearTransfersOut()
+	return _u
+}
+
+// RemoveTransfersOutIDs removes the "transfers_out" edge to TransferOrder entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveTransfersOutIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveTransfersOutIDs(ids...)
+	return _u
+}
+
+// RemoveTransfersOut removes "transfers_out" edges to TransferOrder entities.
+func (_u *WarehouseUpdateOne) RemoveTransfersOut(v ...*TransferOrder) *WarehouseUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddProductIDs(ids...)
+	return _u.RemoveTransfersOutIDs(ids...)
 }
 
-// Mutation returns the WarehouseMutation object of the builder.
-func (_u *WarehouseUpdateOne) Mutation() *WarehouseMutation {
-	return _u.mutation
+// ClearTransfersIn clears all "transfers_in" edges to the TransferOrder entity.
+func (_u *WarehouseUpdateOne) ClearTransfersIn() *WarehouseUpdateOne {
+	_u.mutation.ClearTransfersIn()
+	return _u
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (_u *WarehouseUpdateOne) ClearTenant() *WarehouseUpdateOne {
-	_u.mutation.ClearTenant()
+// RemoveTransfersInIDs removes the "transfers_in" edge to TransferOrder entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveTransfersInIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveTransfersInIDs(ids...)
 	return _u
 }
 
-// ClearProducts clears all "products" edges to the Product entity.
-func (_u *WarehouseUpdateOne) ClearProducts() *WarehouseUpdateOne {
-	_u.mutation.ClearProducts()
+// RemoveTransfersIn removes "transfers_in" edges to TransferOrder entities.
+func (_u *WarehouseUpdateOne) RemoveTransfersIn(v ...*TransferOrder) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransfersInIDs(ids...)
+}
+
+// ClearPosShifts clears all "pos_shifts" edges to the PosShift entity.
+func (_u *WarehouseUpdateOne) ClearPosShifts() *WarehouseUpdateOne {
+	_u.mutation.ClearPosShifts()
 	return _u
 }
 
-// RemoveProductIDs removes the "products" edge to Product entities by IDs.
-func (_u *WarehouseUpdateOne) RemoveProductIDs(ids ...int) *WarehouseUpdateOne {
-	_u.mutation.RemoveProductIDs(ids...)
+// RemovePosShiftIDs removes the "pos_shifts" edge to PosShift entities by IDs.
+func (_u *WarehouseUpdateOne) RemovePosShiftIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemovePosShiftIDs(ids...)
 	return _u
 }
 
-// RemoveProducts removes "products" edges to Product entities.
-func (_u *WarehouseUpdateOne) RemoveProducts(v ...*Product) *WarehouseUpdateOne {
+// RemovePosShifts removes "pos_shifts" edges to PosShift entities.
+func (_u *WarehouseUpdateOne) RemovePosShifts(v ...*PosShift) *WarehouseUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveProductIDs(ids...)
+	return _u.RemovePosShiftIDs(ids...)
 }
 
 // Where appends a list predicates to the WarehouseUpdate builder.
@@ -616,6 +1441,321 @@ func (_u *WarehouseUpdateOne) sqlSave(ctx context.Context) (_node *Warehouse, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GoodsReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.GoodsReceiptsTable,
+			Columns: []string{warehouse.GoodsReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGoodsReceiptsIDs(); len(nodes) > 0 && !_u.mutation.GoodsReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.GoodsReceiptsTable,
+			Columns: []string{warehouse.GoodsReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoodsReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.GoodsReceiptsTable,
+			Columns: []string{warehouse.GoodsReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StockLevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.StockLevelsTable,
+			Columns: []string{warehouse.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStockLevelsIDs(); len(nodes) > 0 && !_u.mutation.StockLevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.StockLevelsTable,
+			Columns: []string{warehouse.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.StockLevelsTable,
+			Columns: []string{warehouse.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocklevel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.MovementsTable,
+			Columns: []string{warehouse.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.MovementsTable,
+			Columns: []string{warehouse.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.MovementsTable,
+			Columns: []string{warehouse.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.ReservationsTable,
+			Columns: []string{warehouse.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !_u.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.ReservationsTable,
+			Columns: []string{warehouse.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.ReservationsTable,
+			Columns: []string{warehouse.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryreservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransfersOutCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersOutTable,
+			Columns: []string{warehouse.TransfersOutColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransfersOutIDs(); len(nodes) > 0 && !_u.mutation.TransfersOutCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersOutTable,
+			Columns: []string{warehouse.TransfersOutColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransfersOutIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersOutTable,
+			Columns: []string{warehouse.TransfersOutColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransfersInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersInTable,
+			Columns: []string{warehouse.TransfersInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransfersInIDs(); len(nodes) > 0 && !_u.mutation.TransfersInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersInTable,
+			Columns: []string{warehouse.TransfersInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransfersInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.TransfersInTable,
+			Columns: []string{warehouse.TransfersInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transferorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PosShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.PosShiftsTable,
+			Columns: []string{warehouse.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPosShiftsIDs(); len(nodes) > 0 && !_u.mutation.PosShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.PosShiftsTable,
+			Columns: []string{warehouse.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PosShiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.PosShiftsTable,
+			Columns: []string{warehouse.PosShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posshift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Warehouse{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -25,6 +25,7 @@ import {
   Activity,
   Users,
   Server,
+  Boxes,
   FileCode,
   Calendar,
   History,
@@ -54,6 +55,7 @@ import {
   MonitorPlay,
   FolderSync,
   BarChart3,
+  Tag,
 } from "lucide-react";
 import { useState } from "react";
 import { useAppStore } from "@/store/useAppStore";
@@ -68,6 +70,7 @@ const SUB_PAGES: Record<string, { id: string; name: string; icon: any }[]> = {
     // Monitoring
     { id: "dashboard", name: "Dashboard", icon: LayoutDashboard },
     { id: "devices", name: "Devices", icon: Server },
+    { id: "groups", name: "Device Groups", icon: Boxes },
     { id: "alerts", name: "Alerts", icon: Bell },
     // Management
     { id: "patches", name: "Patching", icon: Shield },
@@ -89,6 +92,7 @@ const SUB_PAGES: Record<string, { id: string; name: string; icon: any }[]> = {
     { id: "categories", name: "Categories", icon: Layers },
     { id: "reports", name: "Valuation", icon: TrendingUp },
     { id: "maintenance", name: "Asset Health", icon: Wrench },
+    { id: "labels", name: "Labels", icon: Tag },
   ],
   erp: [
     { id: "overview", name: "General Ledger", icon: Briefcase },
@@ -0,0 +1,502 @@
+import { useEffect, useState } from "react";
+import {
+  Card,
+  CardContent,
+  CardHeader,
+  CardTitle,
+  CardDescription,
+} from "@/components/ui/card";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Switch } from "@/components/ui/switch";
+import { Badge } from "@/components/ui/badge";
+import { Plus, Printer, Save, Trash2, Eye, Tag } from "lucide-react";
+import { toast } from "sonner";
+import {
+  GetLabelTemplates,
+  SaveLabelTemplate,
+  DeleteLabelTemplate,
+  PreviewLabel,
+  PrintLabels,
+} from "../../../wailsjs/go/stock/StockBridge";
+import { ListDevices } from "../../../wailsjs/go/peripherals/PeripheralsBridge";
+import { stock, schema } from "../../../wailsjs/go/models";
+
+interface LabelDesignerProps {
+  products: any[];
+  purchaseOrders: any[];
+}
+
+const emptyTemplate = (): stock.LabelTemplateDTO =>
+  stock.LabelTemplateDTO.createFrom({
+    id: 0,
+    name: "",
+    width: 50,
+    height: 25,
+    elements: [
+      { kind: "text", x: 3, y: 2, height: 4, content: "{{name}}" },
+      {
+        kind: "barcode",
+        x: 3,
+        y: 9,
+        height: 10,
+        symbology: "code128",
+        content: "{{sku}}",
+      },
+    ],
+    isDefault: false,
+  });
+
+const downloadPDF = (b64: string, name: string) => {
+  const link = document.createElement("a");
+  link.href = `data:application/pdf;base64,${b64}`;
+  link.download = name;
+  link.click();
+};
+
+/**
+ * LabelDesigner lays out the tenant's label templates and prints batches of labels for a
+ * received purchase order or a set of products, on a label printer or an A4 sheet.
+ */
+export function LabelDesigner({ products, purchaseOrders }: LabelDesignerProps) {
+  const [templates, setTemplates] = useState<stock.LabelTemplateDTO[]>([]);
+  const [editing, setEditing] = useState<stock.LabelTemplateDTO>(emptyTemplate());
+  const [printers, setPrinters] = useState<any[]>([]);
+  const [previewProduct, setPreviewProduct] = useState<number>(0);
+
+  // Batch
+  const [source, setSource] = useState<"po" | "products">("po");
+  const [poId, setPoId] = useState<number>(0);
+  const [templateId, setTemplateId] = useState<number>(0);
+  const [printerId, setPrinterId] = useState<string>("");
+  const [search, setSearch] = useState("");
+  const [copies, setCopies] = useState<number>(1);
+  const [quantities, setQuantities] = useState<Record<number, number>>({});
+  const [printing, setPrinting] = useState(false);
+
+  const fetchTemplates = async () => {
+    try {
+      setTemplates((await GetLabelTemplates()) || []);
+    } catch (err: any) {
+      toast.error(String(err));
+    }
+  };
+
+  useEffect(() => {
+    fetchTemplates();
+    ListDevices()
+      .then((d) => setPrinters((d || []).filter((x) => x.type === "printer")))
+      .catch(() => setPrinters([]));
+  }, []);
+
+  const updateElement = (i: number, patch: Partial<schema.LabelElement>) => {
+    const elements = editing.elements.map((e, j) =>
+      j === i ? schema.LabelElement.createFrom({ ...e, ...patch }) : e,
+    );
+    setEditing(stock.LabelTemplateDTO.createFrom({ ...editing, elements }));
+  };
+
+  const addElement = (kind: string) => {
+    const e = schema.LabelElement.createFrom({
+      kind,
+      x: 2,
+      y: 2,
+      height: kind === "text" ? 3 : 8,
+      symbology: kind === "barcode" ? "code128" : undefined,
+      content: kind === "text" ? "{{price}}" : "{{barcode}}",
+    });
+    setEditing(
+      stock.LabelTemplateDTO.createFrom({
+        ...editing,
+        elements: [...editing.elements, e],
+      }),
+    );
+  };
+
+  const removeElement = (i: number) => {
+    setEditing(
+      stock.LabelTemplateDTO.createFrom({
+        ...editing,
+        elements: editing.elements.filter((_, j) => j !== i),
+      }),
+    );
+  };
+
+  const handleSave = async () => {
+    try {
+      const id = await SaveLabelTemplate(editing);
+      setEditing(stock.LabelTemplateDTO.createFrom({ ...editing, id }));
+      toast.success(`Template ${editing.name} saved`);
+      fetchTemplates();
+    } catch (err: any) {
+      toast.error(String(err));
+    }
+  };
+
+  const handleDelete = async (id: number) => {
+    try {
+      await DeleteLabelTemplate(id);
+      if (editing.id === id) setEditing(emptyTemplate());
+      fetchTemplates();
+    } catch (err: any) {
+      toast.error(String(err));
+    }
+  };
+
+  const handlePreview = async () => {
+    if (!previewProduct) {
+      toast.error("Pick a product to preview the label with");
+      return;
+    }
+    try {
+      const pdf = await PreviewLabel(editing, previewProduct);
+      downloadPDF(pdf, `label-preview.pdf`);
+    } catch (err: any) {
+      toast.error(String(err));
+    }
+  };
+
+  const filtered = products.filter(
+    (p) =>
+      !search ||
+      p.name.toLowerCase().includes(search.toLowerCase()) ||
+      p.sku.toLowerCase().includes(search.toLowerCase()),
+  );
+
+  const handlePrint = async () => {
+    setPrinting(true);
+    try {
+      const req = stock.LabelPrintRequest.createFrom({
+        templateId,
+        printerId,
+        copies,
+        quantities,
+        ...(source === "po"
+          ? { purchaseOrderId: poId }
+          : { filter: { productIds: filtered.map((p) => p.id) } }),
+      });
+      const res = await PrintLabels(req);
+      if (res.pdf) {
+        downloadPDF(res.pdf, `labels-${new Date().toISOString().split("T")[0]}.pdf`);
+      }
+      toast.success(`${res.labels} labels rendered as ${res.language.toUpperCase()}`);
+    } catch (err: any) {
+      toast.error(String(err));
+    } finally {
+      setPrinting(false);
+    }
+  };
+
+  return (
+    <div className="grid gap-4 lg:grid-cols-2">
+      <Card>
+        <CardHeader className="flex flex-row items-center justify-between">
+          <div>
+            <CardTitle className="text-xl font-black uppercase tracking-tighter italic">
+              Label Templates
+            </CardTitle>
+            <CardDescription>
+              Placeholders: {"{{name}} {{sku}} {{barcode}} {{price}} {{lot}} {{expiry}} {{attr.color}}"}
+            </CardDescription>
+          </div>
+          <Button size="sm" variant="outline" onClick={() => setEditing(emptyTemplate())}>
+            <Plus className="mr-2 h-4 w-4" /> New
+          </Button>
+        </CardHeader>
+        <CardContent className="space-y-4">
+          <div className="flex flex-wrap gap-2">
+            {templates.map((t) => (
+              <Badge
+                key={t.id}
+                variant={t.id === editing.id ? "default" : "outline"}
+                className="cursor-pointer gap-1"
+                onClick={() => setEditing(stock.LabelTemplateDTO.createFrom(t))}
+              >
+                <Tag className="h-3 w-3" /> {t.name}
+                {t.isDefault && " (default)"}
+                <Trash2
+                  className="h-3 w-3 ml-1 opacity-60 hover:opacity-100"
+                  onClick={(e) => {
+                    e.stopPropagation();
+                    handleDelete(t.id);
+                  }}
+                />
+              </Badge>
+            ))}
+          </div>
+
+          <div className="grid grid-cols-3 gap-2">
+            <div className="col-span-3 space-y-1">
+              <Label>Name</Label>
+              <Input
+                value={editing.name}
+                onChange={(e) =>
+                  setEditing(stock.LabelTemplateDTO.createFrom({ ...editing, name: e.target.value }))
+                }
+              />
+            </div>
+            <div className="space-y-1">
+              <Label>Width (mm)</Label>
+              <Input
+                type="number"
+                value={editing.width}
+                onChange={(e) =>
+                  setEditing(stock.LabelTemplateDTO.createFrom({ ...editing, width: Number(e.target.value) }))
+                }
+              />
+            </div>
+            <div className="space-y-1">
+              <Label>Height (mm)</Label>
+              <Input
+                type="number"
+                value={editing.height}
+                onChange={(e) =>
+                  setEditing(stock.LabelTemplateDTO.createFrom({ ...editing, height: Number(e.target.value) }))
+                }
+              />
+            </div>
+            <div className="flex items-end gap-2 pb-2">
+              <Switch
+                checked={editing.isDefault}
+                onCheckedChange={(v) =>
+                  setEditing(stock.LabelTemplateDTO.createFrom({ ...editing, isDefault: v }))
+                }
+              />
+              <Label>Default</Label>
+            </div>
+          </div>
+
+          <Table>
+            <TableHeader>
+              <TableRow>
+                <TableHead>Kind</TableHead>
+                <TableHead>X</TableHead>
+                <TableHead>Y</TableHead>
+                <TableHead>H</TableHead>
+                <TableHead>Content</TableHead>
+                <TableHead />
+              </TableRow>
+            </TableHeader>
+            <TableBody>
+              {editing.elements.map((e, i) => (
+                <TableRow key={i}>
+                  <TableCell className="w-32">
+                    {e.kind === "text" ? (
+                      <span className="text-xs font-bold uppercase">Text</span>
+                    ) : (
+                      <Select
+                        value={e.symbology}
+                        onValueChange={(v) => updateElement(i, { symbology: v })}
+                      >
+                        <SelectTrigger className="h-8 text-xs">
+                          <SelectValue />
+                        </SelectTrigger>
+                        <SelectContent>
+                          <SelectItem value="code128">Code128</SelectItem>
+                          <SelectItem value="ean13">EAN-13</SelectItem>
+                          <SelectItem value="qr">QR</SelectItem>
+                          <SelectItem value="datamatrix">DataMatrix</SelectItem>
+                        </SelectContent>
+                      </Select>
+                    )}
+                  </TableCell>
+                  {(["x", "y", "height"] as const).map((k) => (
+                    <TableCell key={k} className="w-16">
+                      <Input
+                        type="number"
+                        className="h-8 text-xs"
+                        value={e[k]}
+                        onChange={(ev) =>
+                          updateElement(i, { [k]: Number(ev.target.value) } as Partial<schema.LabelElement>)
+                        }
+                      />
+                    </TableCell>
+                  ))}
+                  <TableCell>
+                    <Input
+                      className="h-8 text-xs font-mono"
+                      value={e.content}
+                      onChange={(ev) => updateElement(i, { content: ev.target.value })}
+                    />
+                  </TableCell>
+                  <TableCell>
+                    <Button size="icon" variant="ghost" onClick={() => removeElement(i)}>
+                      <Trash2 className="h-4 w-4" />
+                    </Button>
+                  </TableCell>
+                </TableRow>
+              ))}
+            </TableBody>
+          </Table>
+
+          <div className="flex flex-wrap gap-2">
+            <Button size="sm" variant="outline" onClick={() => addElement("text")}>
+              <Plus className="mr-1 h-4 w-4" /> Text
+            </Button>
+            <Button size="sm" variant="outline" onClick={() => addElement("barcode")}>
+              <Plus className="mr-1 h-4 w-4" /> Barcode
+            </Button>
+            <Select
+              value={previewProduct ? String(previewProduct) : ""}
+              onValueChange={(v) => setPreviewProduct(Number(v))}
+            >
+              <SelectTrigger className="h-9 w-48 text-xs">
+                <SelectValue placeholder="Preview with..." />
+              </SelectTrigger>
+              <SelectContent>
+                {products.map((p) => (
+                  <SelectItem key={p.id} value={String(p.id)}>
+                    {p.sku} {p.name}
+                  </SelectItem>
+                ))}
+              </SelectContent>
+            </Select>
+            <Button size="sm" variant="outline" onClick={handlePreview}>
+              <Eye className="mr-1 h-4 w-4" /> Preview
+            </Button>
+            <Button size="sm" onClick={handleSave}>
+              <Save className="mr-1 h-4 w-4" /> Save
+            </Button>
+          </div>
+        </CardContent>
+      </Card>
+
+      <Card>
+        <CardHeader>
+          <CardTitle className="text-xl font-black uppercase tracking-tighter italic">
+            Batch Printing
+          </CardTitle>
+          <CardDescription>
+            Label printers get ZPL or TSPL; without a printer the labels come as an A4 PDF sheet.
+          </CardDescription>
+        </CardHeader>
+        <CardContent className="space-y-4">
+          <div className="grid grid-cols-2 gap-2">
+            <div className="space-y-1">
+              <Label>Template</Label>
+              <Select value={String(templateId)} onValueChange={(v) => setTemplateId(Number(v))}>
+                <SelectTrigger>
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="0">Default</SelectItem>
+                  {templates.map((t) => (
+                    <SelectItem key={t.id} value={String(t.id)}>
+                      {t.name}
+                    </SelectItem>
+                  ))}
+                </SelectContent>
+              </Select>
+            </div>
+            <div className="space-y-1">
+              <Label>Printer</Label>
+              <Select value={printerId || "pdf"} onValueChange={(v) => setPrinterId(v === "pdf" ? "" : v)}>
+                <SelectTrigger>
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="pdf">A4 sheet (PDF)</SelectItem>
+                  {printers.map((p) => (
+                    <SelectItem key={p.id} value={p.id}>
+                      {p.name}
+                    </SelectItem>
+                  ))}
+                </SelectContent>
+              </Select>
+            </div>
+            <div className="space-y-1">
+              <Label>Labels for</Label>
+              <Select value={source} onValueChange={(v) => setSource(v as "po" | "products")}>
+                <SelectTrigger>
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="po">Received purchase order</SelectItem>
+                  <SelectItem value="products">Products</SelectItem>
+                </SelectContent>
+              </Select>
+            </div>
+            {source === "po" ? (
+              <div className="space-y-1">
+                <Label>Purchase order</Label>
+                <Select value={poId ? String(poId) : ""} onValueChange={(v) => setPoId(Number(v))}>
+                  <SelectTrigger>
+                    <SelectValue placeholder="Pick a PO" />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {purchaseOrders
+                      .filter((po) => po.status === "received" || po.status === "partially_received")
+                      .map((po) => (
+                        <SelectItem key={po.id} value={String(po.id)}>
+                          {po.poNumber} {po.supplierName}
+                        </SelectItem>
+                      ))}
+                  </SelectContent>
+                </Select>
+              </div>
+            ) : (
+              <div className="space-y-1">
+                <Label>Copies each</Label>
+                <Input type="number" min={1} value={copies} onChange={(e) => setCopies(Number(e.target.value))} />
+              </div>
+            )}
+          </div>
+
+          {source === "products" && (
+            <>
+              <Input placeholder="Filter by SKU or Name..." value={search} onChange={(e) => setSearch(e.target.value)} />
+              <div className="max-h-72 overflow-auto">
+                <Table>
+                  <TableHeader>
+                    <TableRow>
+                      <TableHead>SKU</TableHead>
+                      <TableHead>Product</TableHead>
+                      <TableHead className="text-right w-24">Labels</TableHead>
+                    </TableRow>
+                  </TableHeader>
+                  <TableBody>
+                    {filtered.map((p) => (
+                      <TableRow key={p.id}>
+                        <TableCell className="font-mono text-xs">{p.sku}</TableCell>
+                        <TableCell>{p.name}</TableCell>
+                        <TableCell className="text-right">
+                          <Input
+                            type="number"
+                            min={0}
+                            className="h-8 text-xs"
+                            value={quantities[p.id] ?? copies}
+                            onChange={(e) => setQuantities({ ...quantities, [p.id]: Number(e.target.value) })}
+                          />
+                        </TableCell>
+                      </TableRow>
+                    ))}
+                  </TableBody>
+                </Table>
+              </div>
+            </>
+          )}
+
+          <Button className="w-full" disabled={printing || (source === "po" && !poId)} onClick={handlePrint}>
+            <Printer className="mr-2 h-4 w-4" /> Print Labels
+          </Button>
+        </CardContent>
+      </Card>
+    </div>
+  );
+}
@@ -0,0 +1,313 @@
+import { useEffect, useState } from "react";
+import {
+  Card,
+  CardContent,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui/card";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableRow,
+} from "@/components/ui/table";
+import { Button } from "@/components/ui/button";
+import { Badge } from "@/components/ui/badge";
+import { Input } from "@/components/ui/input";
+import { Textarea } from "@/components/ui/textarea";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { FileCode, KeyRound } from "lucide-react";
+import { toast } from "sonner";
+import {
+  GetEInvoiceCredential,
+  GetEInvoiceXML,
+  GetEInvoices,
+  OnboardEInvoicing,
+  SaveEInvoiceCredential,
+} from "../../../wailsjs/go/tax/TaxBridge";
+import { tax } from "../../../wailsjs/go/models";
+
+const emptyDraft = {
+  authority: "zatca",
+  environment: "production",
+  otp: "",
+  clientId: "",
+  certificate: "",
+  privateKey: "",
+  secret: "",
+  registrationNumber: "",
+  street: "",
+  buildingNumber: "",
+  district: "",
+  city: "",
+  postalCode: "",
+};
+
+const clearanceColors: Record<string, string> = {
+  pending: "text-muted-foreground",
+  cleared: "text-emerald-600 border-emerald-600/40",
+  reported: "text-emerald-600 border-emerald-600/40",
+  rejected: "text-destructive border-destructive/40",
+  failed: "text-destructive border-destructive/40",
+};
+
+// EInvoicing holds the credentials invoices and POS sales are signed and cleared with, and
+// the chain of signed e-invoices with the portal's verdict on each. Onboarding obtains a CSID
+// from ZATCA with the OTP from the Fatoora portal; an existing CSID can be pasted instead.
+// Replacing the CSID keeps the chain going; the counter and hash carry on.
+export function EInvoicing() {
+  const [credential, setCredential] = useState<tax.EInvoiceCredentialDTO | null>(null);
+  const [einvoices, setEInvoices] = useState<tax.EInvoiceDTO[]>([]);
+  const [editing, setEditing] = useState(false);
+  const [manual, setManual] = useState(false);
+  const [busy, setBusy] = useState(false);
+  const [draft, setDraft] = useState(emptyDraft);
+
+  const load = async () => {
+    try {
+      const [cred, list] = await Promise.all([GetEInvoiceCredential(), GetEInvoices(20)]);
+      setCredential(cred);
+      setEInvoices(list || []);
+    } catch (err) {
+      toast.error(`Failed to load e-invoicing: ${err}`);
+    }
+  };
+
+  useEffect(() => {
+    load();
+  }, []);
+
+  const handleEdit = () => {
+    setDraft(
+      credential
+        ? { ...emptyDraft, ...credential, certificate: "", privateKey: "", secret: "", otp: "" }
+        : emptyDraft,
+    );
+    setManual(false);
+    setEditing(true);
+  };
+
+  const handleOnboard = async () => {
+    setBusy(true);
+    try {
+      await OnboardEInvoicing(tax.EInvoiceCredentialDTO.createFrom(draft));
+      setEditing(false);
+      setDraft(emptyDraft);
+      toast.success("Onboarded. New invoices are cleared with these credentials.");
+      load();
+    } catch (err) {
+      toast.error(`${err}`);
+    } finally {
+      setBusy(false);
+    }
+  };
+
+  const handleSave = async () => {
+    try {
+      await SaveEInvoiceCredential(tax.EInvoiceCredentialDTO.createFrom(draft));
+      setEditing(false);
+      setDraft(emptyDraft);
+      toast.success("CSID saved. New invoices are signed with it.");
+      load();
+    } catch (err) {
+      toast.error(`${err}`);
+    }
+  };
+
+  const handleDownload = async (e: tax.EInvoiceDTO) => {
+    try {
+      const xml = await GetEInvoiceXML(e.id);
+      const url = URL.createObjectURL(new Blob([xml], { type: "application/xml" }));
+      const a = document.createElement("a");
+      a.href = url;
+      a.download = `${e.number}.xml`;
+      a.click();
+      URL.revokeObjectURL(url);
+    } catch (err) {
+      toast.error(`${err}`);
+    }
+  };
+
+  const field = (key: keyof typeof emptyDraft, placeholder: string, className = "") => (
+    <Input
+      placeholder={placeholder}
+      type={key === "secret" ? "password" : "text"}
+      className={`h-8 text-xs ${className}`}
+      value={draft[key]}
+      onChange={(e) => setDraft({ ...draft, [key]: e.target.value })}
+    />
+  );
+
+  return (
+    <Card className="border-none shadow-xl overflow-hidden bg-muted/20">
+      <CardHeader className="bg-muted/40 border-b">
+        <CardTitle className="flex items-center gap-2 text-xs font-black uppercase tracking-widest">
+          <KeyRound className="h-3 w-3 text-erp" /> E-Invoicing
+        </CardTitle>
+      </CardHeader>
+      <CardContent className="p-0">
+        {credential && !editing && (
+          <div className="p-4 space-y-1 text-xs">
+            <p className="flex items-center gap-2 font-bold">
+              {credential.authority === "jofotara" ? `JoFotara client ${credential.clientId}` : credential.subject}
+              <Badge variant="outline" className="text-[8px] font-black h-5 uppercase">
+                {credential.environment}
+              </Badge>
+            </p>
+            {credential.issuer && (
+              <>
+                <p className="text-muted-foreground">Issued by {credential.issuer}</p>
+                <p className="font-mono text-[10px] text-muted-foreground">
+                  Serial {credential.serialNumber} · valid until {String(credential.notAfter).slice(0, 10)}
+                </p>
+              </>
+            )}
+          </div>
+        )}
+        {!credential && !editing && (
+          <p className="p-4 text-xs text-muted-foreground">
+            Not onboarded. Invoices are issued with a Phase 1 QR code only.
+          </p>
+        )}
+
+        {editing ? (
+          <div className="border-t p-4 space-y-2">
+            <div className="grid grid-cols-2 gap-2">
+              <Select
+                value={draft.authority}
+                onValueChange={(v) => setDraft({ ...draft, authority: v, environment: "production" })}
+                disabled={manual}
+              >
+                <SelectTrigger className="h-8 text-xs">
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="zatca">ZATCA (Saudi Arabia)</SelectItem>
+                  <SelectItem value="jofotara">JoFotara (Jordan)</SelectItem>
+                </SelectContent>
+              </Select>
+              <Select value={draft.environment} onValueChange={(v) => setDraft({ ...draft, environment: v })}>
+                <SelectTrigger className="h-8 text-xs">
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="production">Production</SelectItem>
+                  {draft.authority === "zatca" && <SelectItem value="simulation">Simulation</SelectItem>}
+                  {draft.authority === "zatca" && <SelectItem value="sandbox">Developer sandbox</SelectItem>}
+                  <SelectItem value="local">Local sandbox (offline)</SelectItem>
+                </SelectContent>
+              </Select>
+            </div>
+            {manual ? (
+              <>
+                <Textarea
+                  placeholder="CSID certificate (PEM or base64)"
+                  className="text-[10px] font-mono h-20"
+                  value={draft.certificate}
+                  onChange={(e) => setDraft({ ...draft, certificate: e.target.value })}
+                />
+                <Textarea
+                  placeholder="Private key (PEM)"
+                  className="text-[10px] font-mono h-20"
+                  value={draft.privateKey}
+                  onChange={(e) => setDraft({ ...draft, privateKey: e.target.value })}
+                />
+                {field("secret", "API secret (optional)")}
+              </>
+            ) : draft.authority === "jofotara" ? (
+              <div className="grid grid-cols-2 gap-2">
+                {field("clientId", "Client ID")}
+                {field("secret", "Secret key")}
+              </div>
+            ) : (
+              field("otp", "OTP from the Fatoora portal")
+            )}
+            {field("registrationNumber", "Commercial registration number")}
+            <div className="grid grid-cols-[1fr_80px] gap-2">
+              {field("street", "Street")}
+              {field("buildingNumber", "Building")}
+              {field("district", "District")}
+              {field("postalCode", "Postal code")}
+            </div>
+            {field("city", "City")}
+            <div className="flex justify-end gap-2">
+              {draft.authority === "zatca" && (
+                <Button size="sm" variant="link" className="h-8 mr-auto px-0 text-xs" onClick={() => setManual(!manual)}>
+                  {manual ? "Onboard with an OTP instead" : "Paste an existing CSID"}
+                </Button>
+              )}
+              <Button size="sm" variant="ghost" className="h-8" onClick={() => setEditing(false)}>
+                Cancel
+              </Button>
+              {manual ? (
+                <Button
+                  size="sm"
+                  className="h-8"
+                  disabled={!draft.certificate || !draft.privateKey}
+                  onClick={handleSave}
+                >
+                  Save CSID
+                </Button>
+              ) : (
+                <Button
+                  size="sm"
+                  className="h-8"
+                  disabled={busy || (draft.authority === "zatca" ? !draft.otp : !draft.clientId || !draft.secret)}
+                  onClick={handleOnboard}
+                >
+                  {busy ? "Onboarding..." : "Onboard"}
+                </Button>
+              )}
+            </div>
+          </div>
+        ) : (
+          <div className="border-t p-4 flex justify-end">
+            <Button size="sm" variant="outline" className="h-8" onClick={handleEdit}>
+              {credential ? "Onboard again" : "Onboard"}
+            </Button>
+          </div>
+        )}
+
+        {einvoices.length > 0 && (
+          <Table className="border-t">
+            <TableBody>
+              {einvoices.map((e) => (
+                <TableRow key={e.id} className="hover:bg-muted/30 border-none">
+                  <TableCell className="font-mono text-[10px] pl-6">#{e.counter}</TableCell>
+                  <TableCell className="text-xs font-bold">{e.number}</TableCell>
+                  <TableCell>
+                    <Badge variant="outline" className="text-[8px] font-black h-5 uppercase">
+                      {e.kind === "credit_note" ? "Credit" : e.profile}
+                    </Badge>
+                  </TableCell>
+                  <TableCell title={(e.clearanceMessages || []).map((m) => `${m.code}: ${m.message}`).join("\n")}>
+                    {e.clearanceStatus && (
+                      <Badge
+                        variant="outline"
+                        className={`text-[8px] font-black h-5 uppercase ${clearanceColors[e.clearanceStatus] || ""}`}
+                      >
+                        {e.clearanceStatus}
+                        {(e.clearanceMessages || []).some((m) => m.type === "warning") && " ⚠"}
+                      </Badge>
+                    )}
+                  </TableCell>
+                  <TableCell className="text-right pr-6">
+                    <Button variant="ghost" size="icon" className="h-6 w-6" onClick={() => handleDownload(e)}>
+                      <FileCode className="h-3 w-3" />
+                    </Button>
+                  </TableCell>
+                </TableRow>
+              ))}
+            </TableBody>
+          </Table>
+        )}
+      </CardContent>
+    </Card>
+  );
+}
@@ -0,0 +1,248 @@
+import { useEffect, useState } from "react";
+import {
+  Card,
+  CardContent,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui/card";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Button } from "@/components/ui/button";
+import { Badge } from "@/components/ui/badge";
+import { Input } from "@/components/ui/input";
+import { Switch } from "@/components/ui/switch";
+import { Globe, Plus, Trash2 } from "lucide-react";
+import { toast } from "sonner";
+import {
+  AddTaxRate,
+  DeleteTaxRate,
+  GetTaxConfig,
+  GetTaxJurisdictions,
+  SaveTaxJurisdiction,
+} from "../../../wailsjs/go/tax/TaxBridge";
+import { tax } from "../../../wailsjs/go/models";
+
+const formatRate = (rate: number) => `${(rate * 100).toFixed(2).replace(/\.?0+$/, "")}%`;
+
+// TaxJurisdictions lists where the tenant charges VAT. Statutory rates apply to the countries
+// SENT ships with until a jurisdiction is set up with its own rate history.
+export function TaxJurisdictions() {
+  const [jurisdictions, setJurisdictions] = useState<tax.TaxJurisdictionDTO[]>([]);
+  const [statutory, setStatutory] = useState<tax.TaxConfig[]>([]);
+  const [selected, setSelected] = useState<number | null>(null);
+  const [draft, setDraft] = useState({ code: "", name: "", rounding: "half_up" });
+  const [rate, setRate] = useState({ percent: "", from: "", notes: "" });
+
+  const load = async () => {
+    try {
+      const [jurs, configs] = await Promise.all([GetTaxJurisdictions(), GetTaxConfig()]);
+      setJurisdictions(jurs || []);
+      const own = new Set((jurs || []).map((j) => j.code));
+      setStatutory((configs || []).filter((c) => !own.has(c.countryCode)));
+    } catch (err) {
+      toast.error(`Failed to load tax jurisdictions: ${err}`);
+    }
+  };
+
+  useEffect(() => {
+    load();
+  }, []);
+
+  const current = jurisdictions.find((j) => j.id === selected);
+
+  const handleCreate = async () => {
+    try {
+      const id = await SaveTaxJurisdiction(
+        tax.TaxJurisdictionDTO.createFrom({ ...draft, isActive: true, rates: [] }),
+      );
+      setDraft({ code: "", name: "", rounding: "half_up" });
+      setSelected(id);
+      toast.success("Jurisdiction added. Give it a rate before charging tax in it.");
+      load();
+    } catch (err) {
+      toast.error(`${err}`);
+    }
+  };
+
+  const handleUpdate = async (j: tax.TaxJurisdictionDTO, change: Partial<tax.TaxJurisdictionDTO>) => {
+    try {
+      await SaveTaxJurisdiction(tax.TaxJurisdictionDTO.createFrom({ ...j, ...change }));
+      load();
+    } catch (err) {
+      toast.error(`${err}`);
+    }
+  };
+
+  const handleAddRate = async () => {
+    if (!current) return;
+    try {
+      await AddTaxRate(current.id, Number(rate.percent) / 100, new Date(rate.from) as any, rate.notes);
+      setRate({ percent: "", from: "", notes: "" });
+      toast.success(`Rate recorded for ${current.code}`);
+      load();
+    } catch (err) {
+      toast.error(`${err}`);
+    }
+  };
+
+  const handleDeleteRate = async (id: number) => {
+    try {
+      await DeleteTaxRate(id);
+      load();
+    } catch (err) {
+      toast.error(`${err}`);
+    }
+  };
+
+  return (
+    <Card className="border-none shadow-xl overflow-hidden bg-muted/20">
+      <CardHeader className="bg-muted/40 border-b">
+        <CardTitle className="flex items-center gap-2 text-xs font-black uppercase tracking-widest">
+          <Globe className="h-3 w-3 text-erp" /> Tax Jurisdictions
+        </CardTitle>
+      </CardHeader>
+      <CardContent className="p-0">
+        <Table>
+          <TableBody>
+            {jurisdictions.map((j) => (
+              <TableRow
+                key={j.id}
+                className={`hover:bg-muted/30 border-none transition-colors cursor-pointer ${selected === j.id ? "bg-erp/5" : ""}`}
+                onClick={() => setSelected(selected === j.id ? null : j.id)}
+              >
+                <TableCell className="font-bold pl-6 text-erp">{j.code}</TableCell>
+                <TableCell className="text-xs">{j.name}</TableCell>
+                <TableCell className="font-mono text-xs">
+                  {j.rates.length ? formatRate(j.currentRate) : "No rate"}
+                </TableCell>
+                <TableCell className="text-right pr-6">
+                  {!j.isActive && (
+                    <Badge variant="outline" className="text-[8px] font-black h-5">
+                      INACTIVE
+                    </Badge>
+                  )}
+                </TableCell>
+              </TableRow>
+            ))}
+            {statutory.map((c) => (
+              <TableRow key={c.countryCode} className="border-none opacity-60">
+                <TableCell className="font-bold pl-6">{c.countryCode}</TableCell>
+                <TableCell className="text-xs italic">Statutory</TableCell>
+                <TableCell className="font-mono text-xs">{formatRate(c.defaultRate)}</TableCell>
+                <TableCell />
+              </TableRow>
+            ))}
+          </TableBody>
+        </Table>
+
+        {current && (
+          <div className="border-t p-4 space-y-3">
+            <div className="flex items-center justify-between gap-2">
+              <Select
+                value={current.rounding}
+                onValueChange={(v) => handleUpdate(current, { rounding: v })}
+              >
+                <SelectTrigger className="h-8 text-xs w-[150px]">
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="half_up">Round half up</SelectItem>
+                  <SelectItem value="half_even">Round half even</SelectItem>
+                </SelectContent>
+              </Select>
+              <div className="flex items-center gap-2 text-[10px] font-black uppercase">
+                Active
+                <Switch
+                  checked={current.isActive}
+                  onCheckedChange={(v) => handleUpdate(current, { isActive: v })}
+                />
+              </div>
+            </div>
+            <Table>
+              <TableHeader>
+                <TableRow className="hover:bg-transparent">
+                  <TableHead className="text-[10px] font-black uppercase">From</TableHead>
+                  <TableHead className="text-[10px] font-black uppercase">Rate</TableHead>
+                  <TableHead className="text-[10px] font-black uppercase">Notes</TableHead>
+                  <TableHead />
+                </TableRow>
+              </TableHeader>
+              <TableBody>
+                {current.rates.map((r) => (
+                  <TableRow key={r.id}>
+                    <TableCell className="font-mono text-xs">
+                      {String(r.effectiveFrom).slice(0, 10)}
+                    </TableCell>
+                    <TableCell className="font-mono text-xs">{formatRate(r.rate)}</TableCell>
+                    <TableCell className="text-xs text-muted-foreground">{r.notes}</TableCell>
+                    <TableCell className="text-right">
+                      <Button variant="ghost" size="icon" className="h-6 w-6" onClick={() => handleDeleteRate(r.id)}>
+                        <Trash2 className="h-3 w-3" />
+                      </Button>
+                    </TableCell>
+                  </TableRow>
+                ))}
+              </TableBody>
+            </Table>
+            <div className="grid grid-cols-[1fr_1fr_auto] gap-2">
+              <Input
+                type="date"
+                className="h-8 text-xs"
+                value={rate.from}
+                onChange={(e) => setRate({ ...rate, from: e.target.value })}
+              />
+              <Input
+                type="number"
+                placeholder="Rate %"
+                className="h-8 text-xs"
+                value={rate.percent}
+                onChange={(e) => setRate({ ...rate, percent: e.target.value })}
+              />
+              <Button size="sm" className="h-8" disabled={!rate.from || rate.percent === ""} onClick={handleAddRate}>
+                <Plus className="h-3 w-3" />
+              </Button>
+              <Input
+                placeholder="Notes, e.g. the decree"
+                className="h-8 text-xs col-span-3"
+                value={rate.notes}
+                onChange={(e) => setRate({ ...rate, notes: e.target.value })}
+              />
+            </div>
+          </div>
+        )}
+
+        <div className="border-t p-4 grid grid-cols-[60px_1fr_auto] gap-2">
+          <Input
+            placeholder="JO"
+            maxLength={2}
+            className="h-8 text-xs uppercase"
+            value={draft.code}
+            onChange={(e) => setDraft({ ...draft, code: e.target.value })}
+          />
+          <Input
+            placeholder="Jurisdiction name"
+            className="h-8 text-xs"
+            value={draft.name}
+            onChange={(e) => setDraft({ ...draft, name: e.target.value })}
+          />
+          <Button size="sm" className="h-8 gap-1" disabled={!draft.code || !draft.name} onClick={handleCreate}>
+            <Plus className="h-3 w-3" /> Add
+          </Button>
+        </div>
+      </CardContent>
+    </Card>
+  );
+}
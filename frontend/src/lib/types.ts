@@ -51,6 +51,10 @@ export interface Product {
     isDisposed?: boolean;
     currentValue?: number;
     location?: string;
+    soldByWeight?: boolean;
+    pricePerKg?: number;
+    tareWeight?: number;
+    plu?: string;
 }
 
 export interface ProductVariant {
@@ -1,11 +1,23 @@
-import { useState, useEffect } from "react";
+import { useState, useEffect, useRef } from "react";
 import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
 import { Button } from "@/components/ui/button";
 import { GetProducts } from "../../wailsjs/go/stock/StockBridge";
 import {
   Checkout,
+  CloseShift,
+  FixOfflineSale,
+  GetCurrentShift,
+  GetOfflineSales,
+  GetSale,
+  GetZReport,
   OpenDrawer,
+  OpenShift,
   PrintReceipt,
+  ProcessReturn,
+  ScanBarcode,
+  UpdateCustomerDisplay,
+  VoidOfflineSale,
+  Weigh,
 } from "../../wailsjs/go/stock/KioskBridge";
 import {
   ShoppingCart,
@@ -56,15 +68,20 @@ import {
   ShieldAlert,
   LogOut,
   LogIn,
+  CloudOff,
 } from "lucide-react";
 
 // CartItem extends Product with sales-specific fields
 interface CartItem extends Product {
-  qty: number;
+  qty: number; // kg for goods sold by weight
   variant?: ProductVariant;
   note?: string;
+  amount?: number; // Line total printed on a price label
 }
 
+// lineTotal is what a cart line costs before discounts.
+const lineTotal = (item: CartItem) => item.amount ?? item.qty * item.unitCost;
+
 /**
  * Kiosk page handles Point of Sale (POS) operations.
  * Supports cart management, discounts, holding carts, and checkout.
@@ -131,9 +148,15 @@ export function Kiosk() {
     "percent",
   );
   const [discountValue, setDiscountValue] = useState("");
+  const [basketDiscount, setBasketDiscount] = useState(0);
 
-  // Return Mode State
-  const [isReturnMode, setIsReturnMode] = useState(false);
+  // Return State: a return is looked up from the sale ID on its receipt
+  const [isReturnOpen, setIsReturnOpen] = useState(false);
+  const [returnSaleId, setReturnSaleId] = useState("");
+  const [returnSale, setReturnSale] = useState<any | null>(null);
+  const [returnQty, setReturnQty] = useState<Record<number, number>>({});
+  const [returnReason, setReturnReason] = useState("");
+  const [returnId, setReturnId] = useState(() => crypto.randomUUID());
 
   // Modifiers State
   const [selectedProductForModifier, setSelectedProductForModifier] =
@@ -144,14 +167,110 @@ export function Kiosk() {
   const [barcodeBuffer, setBarcodeBuffer] = useState("");
   const [lastKeyTime, setLastKeyTime] = useState(0);
 
-  // Shift/Customer State (Placeholder for now)
-  const [shiftActive, setShiftActive] = useState(false);
+  // Shift State. The close is blind: the cashier counts the drawer before seeing what was
+  // expected.
+  const [shift, setShift] = useState<any | null>(null);
+  const [isShiftOpen, setIsShiftOpen] = useState(false);
+  const [shiftCash, setShiftCash] = useState("");
+  const [closedShift, setClosedShift] = useState<any | null>(null);
+
+  // Customer State (Placeholder for now)
   const [customer, setCustomer] = useState<string | null>(null);
 
+  // Sale ID for the cart being rung up. It only changes after a sale succeeds, so retrying a
+  // checkout that timed out can never record the sale twice.
+  const [saleId, setSaleId] = useState(() => crypto.randomUUID());
+
+  // Offline sales waiting for sync or for a manager to fix or void them
+  const [offlineSales, setOfflineSales] = useState<any[]>([]);
+  const [isOfflineOpen, setIsOfflineOpen] = useState(false);
+  const [voidReasons, setVoidReasons] = useState<Record<number, string>>({});
+
   useEffect(() => {
     fetchProducts();
+    fetchOfflineSales();
+    fetchShift();
+    const timer = setInterval(fetchOfflineSales, 30000);
+    return () => clearInterval(timer);
   }, []);
 
+  const fetchOfflineSales = async () => {
+    try {
+      const sales = await GetOfflineSales();
+      setOfflineSales(sales || []);
+    } catch (err) {
+      // The offline buffer is optional; no queue to show.
+    }
+  };
+
+  const fetchShift = async () => {
+    try {
+      setShift(await GetCurrentShift());
+    } catch (err) {
+      setShift(null);
+    }
+  };
+
+  const handleShift = async () => {
+    const amount = parseFloat(shiftCash);
+    if (isNaN(amount) || amount < 0) {
+      toast.error("Enter the cash in the drawer");
+      return;
+    }
+    try {
+      if (shift) {
+        const closed = await CloseShift(amount);
+        setShift(null);
+        setClosedShift(closed);
+        toast.success("Shift closed");
+      } else {
+        setShift(await OpenShift(amount));
+        toast.success("Shift opened");
+      }
+      setIsShiftOpen(false);
+      setShiftCash("");
+    } catch (err: any) {
+      toast.error(err.toString());
+    }
+  };
+
+  const updateOfflineQty = (id: number, index: number, qty: number) => {
+    setOfflineSales((prev) =>
+      prev.map((o) => {
+        if (o.id !== id) return o;
+        const items = o.sale.items
+          .map((it: any, i: number) => (i === index ? { ...it, quantity: qty } : it))
+          .filter((it: any) => it.quantity > 0);
+        const total = items.reduce(
+          (acc: number, it: any) => acc + it.price * it.quantity,
+          0,
+        );
+        return { ...o, sale: { ...o.sale, items, total } };
+      }),
+    );
+  };
+
+  const handleFixOfflineSale = async (o: any) => {
+    try {
+      // @ts-ignore - bridge types
+      const msg = await FixOfflineSale(o.id, o.sale);
+      toast.success(msg);
+    } catch (err: any) {
+      toast.error(err.toString());
+    }
+    fetchOfflineSales();
+  };
+
+  const handleVoidOfflineSale = async (id: number) => {
+    try {
+      await VoidOfflineSale(id, voidReasons[id] || "");
+      toast.success("Offline sale voided");
+    } catch (err: any) {
+      toast.error(err.toString());
+    }
+    fetchOfflineSales();
+  };
+
   // Barcode Scanner Listener
   useEffect(() => {
     const handleKeyDown = (e: KeyboardEvent) => {
@@ -183,13 +302,22 @@ export function Kiosk() {
     return () => window.removeEventListener("keydown", handleKeyDown);
   }, [barcodeBuffer, lastKeyTime, products]);
 
-  const handleScan = (code: string) => {
+  const handleScan = async (code: string) => {
     const product = products.find((p) => p.sku === code || p.barcode === code);
     if (product) {
       handleProductCheck(product);
       toast.success(`Scanned: ${product.name}`);
-    } else {
-      toast.error(`Unknown barcode: ${code}`);
+      return;
+    }
+    // Price and weight labels from the shop's scales name the product by its PLU.
+    try {
+      const line = await ScanBarcode(code);
+      const scanned = products.find((p) => p.id === line.productId);
+      if (!scanned) throw new Error(`Unknown barcode: ${code}`);
+      addWeighedLine(scanned, line.quantity, line.price, line.amount);
+      toast.success(`Scanned: ${line.name}`);
+    } catch (err: any) {
+      toast.error(err.toString());
     }
   };
 
@@ -248,20 +376,16 @@ export function Kiosk() {
       newCart[existingIndex].qty += 1;
       setCart(newCart);
     } else {
-      let price = variant
+      const price = variant
         ? product.unitCost + variant.priceAdjustment
         : product.unitCost;
-      // Invert price if in return mode
-      if (isReturnMode) {
-        price = -Math.abs(price);
-      }
 
       const sku = variant ? variant.sku : product.sku;
       const name = variant ? `${product.name} (${variant.name})` : product.name;
 
       const newItem: CartItem = {
         ...product,
-        qty: isReturnMode ? -1 : 1, // Negative qty for returns
+        qty: 1,
         variant: variant || undefined,
         unitCost: price, // Override price
         sku: sku, // Override SKU
@@ -275,13 +399,33 @@ export function Kiosk() {
     processAddToCart(product, variant);
   };
 
+  // Each weighing is its own line, with the weight as the quantity and the price per kg.
+  const addWeighedLine = (
+    product: Product,
+    qty: number,
+    price: number,
+    amount?: number,
+  ) => {
+    setCart((prev) => [
+      ...prev,
+      { ...product, qty, unitCost: price, amount: amount || undefined },
+    ]);
+  };
+
+  const weighProduct = async (product: Product) => {
+    try {
+      const w = await Weigh(product.id);
+      addWeighedLine(product, w.net, w.pricePerKg);
+      toast.success(`${w.name}: ${w.net.toFixed(3)} kg`);
+    } catch (err: any) {
+      toast.error(err.toString());
+    }
+  };
+
   const decrementCart = (index: number) => {
     const newCart = [...cart];
-    if (Math.abs(newCart[index].qty) > 1) {
-      newCart[index].qty =
-        newCart[index].qty > 0
-          ? newCart[index].qty - 1
-          : newCart[index].qty + 1;
+    if (!newCart[index].soldByWeight && newCart[index].qty > 1) {
+      newCart[index].qty -= 1;
       setCart(newCart);
     } else {
       removeFromCart(index);
@@ -295,7 +439,9 @@ export function Kiosk() {
   };
 
   const handleProductCheck = (product: Product) => {
-    if (product.hasVariants) {
+    if (product.soldByWeight) {
+      weighProduct(product);
+    } else if (product.hasVariants) {
       setSelectedProductForModifier(product);
       setIsModifierOpen(true);
     } else {
@@ -307,25 +453,16 @@ export function Kiosk() {
     const val = parseFloat(discountValue);
     if (isNaN(val) || val <= 0) return;
 
-    let newCart = [...cart];
-    if (discountType === "percent") {
-      newCart = newCart.map((item) => ({
-        ...item,
-        unitCost: item.unitCost * (1 - val / 100),
-      }));
-    } else {
-      // Distribute amount discount across items proportionally
-      const totalBefore = cart.reduce((acc, i) => acc + i.unitCost * i.qty, 0);
-      if (totalBefore > 0) {
-        newCart = newCart.map((item) => ({
-          ...item,
-          unitCost:
-            item.unitCost -
-            (val * ((item.unitCost * item.qty) / totalBefore)) / item.qty,
-        }));
-      }
+    // The server shares a basket discount across the lines, so the cart keeps its prices.
+    const amount =
+      discountType === "percent"
+        ? Math.round(subtotal * val) / 100
+        : Math.round(val * 100) / 100;
+    if (amount > subtotal) {
+      toast.error("Discount exceeds the order total");
+      return;
     }
-    setCart(newCart);
+    setBasketDiscount(amount);
     setIsDiscountOpen(false);
     setDiscountValue("");
     toast.success("Discount applied");
@@ -383,7 +520,54 @@ export function Kiosk() {
     setDrawerAmount("");
   };
 
-  const total = cart.reduce((acc, item) => acc + item.unitCost * item.qty, 0);
+  const subtotal = cart.reduce((acc, item) => acc + lineTotal(item), 0);
+  const total = Math.round((subtotal - basketDiscount) * 100) / 100;
+
+  const salePayload = (tenders: { method: string; amount: number }[]) => ({
+    uuid: saleId,
+    items: cart.map((i) => ({
+      productId: i.id,
+      quantity: i.qty,
+      price: i.unitCost,
+      amount: i.amount,
+      name: i.name,
+    })),
+    total: total,
+    discount: basketDiscount,
+    paymentMethod: tenders.length === 1 ? tenders[0].method : "split",
+    tenders: tenders,
+  });
+
+  // The customer display follows the basket; a display fault never holds up the till.
+  const justPaid = useRef(false);
+  const showOnDisplay = (payload: any, stage: string) => {
+    // @ts-ignore - bridge types
+    UpdateCustomerDisplay(payload, stage).catch(() => {});
+  };
+
+  useEffect(() => {
+    // The thank-you screen stays up until the next customer's first item.
+    if (cart.length === 0 && justPaid.current) return;
+    justPaid.current = false;
+    const paying = isCashOpen || isSplitOpen || isProcessing;
+    showOnDisplay(salePayload([]), paying ? "payment" : "cart");
+  }, [cart, basketDiscount, isCashOpen, isSplitOpen, isProcessing]);
+
+  // The sale is recorded by now; a printer fault only costs the paper receipt.
+  const finishSale = async (payload: any) => {
+    justPaid.current = true;
+    showOnDisplay(payload, "paid");
+    setCart([]);
+    setBasketDiscount(0);
+    setSaleId(crypto.randomUUID());
+    fetchProducts();
+    try {
+      setReceipt(await PrintReceipt(payload));
+      setIsReceiptOpen(true);
+    } catch (err: any) {
+      toast.error(err.toString());
+    }
+  };
 
   const initiateCheckout = (method: string) => {
     if (cart.length === 0) return;
@@ -399,29 +583,13 @@ export function Kiosk() {
   const handleCheckout = async (method: string) => {
     setIsProcessing(true);
     try {
-      const payload = {
-        items: cart.map((i) => ({
-          productId: i.id,
-          quantity: i.qty,
-          price: i.unitCost,
-        })),
-        total: total,
-        paymentMethod: method,
-      };
+      const payload = salePayload([{ method, amount: total }]);
 
       // @ts-ignore - bridge types
       await Checkout(payload);
-      // @ts-ignore - bridge types
-      const text = await PrintReceipt(payload);
-
-      setReceipt(text);
-      setIsReceiptOpen(true);
-      setCart([]);
+      await finishSale(payload);
       setIsCashOpen(false);
 
-      // Refresh inventory
-      fetchProducts();
-
       if (method === "cash") await OpenDrawer();
       toast.success("Sale successful");
     } catch (err: any) {
@@ -439,30 +607,28 @@ export function Kiosk() {
       return;
     }
 
+    // Cash handed over beyond the total is change, not takings.
+    const tenders = splitPayments.map((p) => ({ ...p }));
+    const over = Math.round((paid - total) * 100) / 100;
+    const cash = tenders.find((p) => p.method === "cash");
+    if (over > 0) {
+      if (!cash || cash.amount < over) {
+        toast.error("Only cash can be overpaid");
+        return;
+      }
+      cash.amount = Math.round((cash.amount - over) * 100) / 100;
+    }
+
     setIsProcessing(true);
     try {
-      // Mocking checkout for split payments as bridge might not support it yet
-      // In production, we'd send the 'payments' array to a new bridge endpoint
-      const payload = {
-        items: cart.map((i) => ({
-          productId: i.id,
-          quantity: i.qty,
-          price: i.unitCost,
-        })),
-        total: total,
-        paymentMethod: "split", // Simplified for now
-      };
+      const payload = salePayload(tenders.filter((p) => p.amount > 0));
 
-      // @ts-ignore
+      // @ts-ignore - bridge types
       await Checkout(payload);
-      // @ts-ignore
-      const text = await PrintReceipt(payload);
-      setReceipt(text);
-      setIsReceiptOpen(true);
-      setCart([]);
+      await finishSale(payload);
       setIsSplitOpen(false);
       setSplitPayments([]);
-      fetchProducts();
+      if (cash) await OpenDrawer();
       toast.success("Split Payment Successful");
     } catch (err: any) {
       toast.error(err.toString());
@@ -478,26 +644,100 @@ export function Kiosk() {
     setSplitAmount("");
   };
 
+  const lookupReturnSale = async () => {
+    try {
+      const sale = await GetSale(returnSaleId.trim());
+      setReturnSale(sale);
+      setReturnQty({});
+    } catch (err: any) {
+      setReturnSale(null);
+      toast.error(err.toString());
+    }
+  };
+
+  const handleReturn = async () => {
+    const lines = Object.entries(returnQty)
+      .filter(([, qty]) => qty > 0)
+      .map(([id, qty]) => ({ lineId: Number(id), quantity: qty }));
+    if (lines.length === 0) {
+      toast.error("Choose what is being returned");
+      return;
+    }
+    setIsProcessing(true);
+    try {
+      // @ts-ignore - bridge types
+      const msg = await ProcessReturn({
+        uuid: returnId,
+        saleUuid: returnSale.uuid,
+        lines,
+        reason: returnReason,
+      });
+      toast.success(msg);
+      setReturnId(crypto.randomUUID());
+      setReturnSale(null);
+      setReturnSaleId("");
+      setReturnReason("");
+      setIsReturnOpen(false);
+      fetchProducts();
+      if (returnSale.tenders?.[0]?.method === "cash") await OpenDrawer();
+    } catch (err: any) {
+      toast.error(err.toString());
+    } finally {
+      setIsProcessing(false);
+    }
+  };
+
   /* Z-Report is now protected */
-  const handleZReport = () => {
-    // Mock Z-Report
-    const date = new Date().toLocaleDateString();
-    const report = `
-      === Z-REPORT ===
-      Date: ${date}
-      Shift ID: SHIFT-${Math.floor(Math.random() * 1000)}
-      
-      Total Sales: $${(Math.random() * 5000).toFixed(2)}
-      Cash in Drawer: $${(Math.random() * 1000).toFixed(2)}
-      Card Sales: $${(Math.random() * 4000).toFixed(2)}
-      
-      Returns: $0.00
-      Discounts: $0.00
-      
-      --- END REPORT ---
-    `;
-    setReceipt(report);
-    setIsReceiptOpen(true);
+  const handleZReport = async () => {
+    const today = new Date();
+    const pad = (n: number) => String(n).padStart(2, "0");
+    const date = `${today.getFullYear()}-${pad(today.getMonth() + 1)}-${pad(today.getDate())}`;
+    try {
+      const r = await GetZReport(date);
+      const money = (n: number) => `$${n.toFixed(2)}`;
+      const report = [
+        "=== Z-REPORT ===",
+        `Date: ${r.date}`,
+        `Sales: ${r.sales}   Refunds: ${r.refunds}`,
+        "",
+        `Gross Sales: ${money(r.grossSales)}`,
+        `Discounts:   ${money(r.discounts)}`,
+        `Returns:     ${money(r.returns)}`,
+        `Net Sales:   ${money(r.netSales)}`,
+        `Tax:         ${money(r.tax)}`,
+        `Over/Short:  ${money(r.overShort)}`,
+        "",
+        "--- BY TENDER ---",
+        ...(r.tenders || []).map(
+          (t) =>
+            `${t.method.toUpperCase()}: ${money(t.sales)} - ${money(t.refunds)} = ${money(t.net)}`,
+        ),
+        "",
+        "--- BY TAX ---",
+        ...(r.taxes || []).map(
+          (t) =>
+            `${t.code || "NO TAX"} ${(t.rate * 100).toFixed(0)}%: ${money(t.taxable)} + ${money(t.tax)}`,
+        ),
+        "",
+        "--- BY CASHIER ---",
+        ...(r.cashiers || []).map(
+          (c) =>
+            `${c.cashier} (${c.shifts} shifts): ${money(c.net)}, over/short ${money(c.overShort)}`,
+        ),
+        ...(r.openShifts > 0
+          ? [
+              "",
+              `${r.openShifts} shift(s) still open; their cash is not counted`,
+            ]
+          : []),
+        "",
+        "--- END REPORT ---",
+      ].join("\n");
+      setReceipt(report);
+      setIsReceiptOpen(true);
+    } catch (err: any) {
+      toast.error(err.toString());
+    }
   };
 
   const breadcrumbs = [{ label: "Business" }, { label: "SENTkiosk POS" }];
@@ -509,7 +749,22 @@ export function Kiosk() {
         description="Authoritative Point of Sale & Retail Ingest"
         icon={Banknote}
         breadcrumbs={breadcrumbs}
-      />
+      >
+        {offlineSales.length > 0 && (
+          <Button
+            variant="outline"
+            size="sm"
+            className="gap-2 text-amber-700 border-amber-200"
+            onClick={() => setIsOfflineOpen(true)}
+          >
+            <CloudOff className="h-4 w-4" /> Offline Sales (
+            {offlineSales.length})
+            {offlineSales.some((o) => o.status === "quarantined") && (
+              <Badge variant="destructive">Review</Badge>
+            )}
+          </Button>
+        )}
+      </PageHeader>
 
       {loading && products.length === 0 ? (
         <div className="flex flex-col lg:flex-row flex-1 gap-6 overflow-hidden">
@@ -599,18 +854,25 @@ export function Kiosk() {
                   </span>
                 </div>
                 <Badge variant="outline">
-                  {cart.reduce((acc, i) => acc + i.qty, 0)} Items
+                  {cart.reduce(
+                    (acc, i) => acc + (i.soldByWeight ? 1 : i.qty),
+                    0,
+                  )}{" "}
+                  Items
                 </Badge>
               </CardTitle>
               <div className="flex gap-2 mt-2">
                 <Button
-                  variant={shiftActive ? "default" : "outline"}
+                  variant={shift ? "default" : "outline"}
                   size="sm"
                   className="flex-1 text-[10px] uppercase font-bold"
-                  onClick={() => setShiftActive(!shiftActive)}
+                  onClick={() => {
+                    setShiftCash("");
+                    setIsShiftOpen(true);
+                  }}
                 >
                   <Clock className="h-3 w-3 mr-1" />
-                  {shiftActive ? "Clocked In" : "Clock In"}
+                  {shift ? `Shift #${shift.id}` : "Open Shift"}
                 </Button>
                 <Button
                   variant={customer ? "default" : "outline"}
@@ -649,6 +911,7 @@ export function Kiosk() {
                           </p>
                           <p className="text-[10px] font-mono text-muted-foreground">
                             ${item.unitCost.toFixed(2)}
+                            {item.soldByWeight && " / kg"}
                             {item.note && (
                               <span className="block text-amber-500 italic">
                                 Note: {item.note}
@@ -662,30 +925,36 @@ export function Kiosk() {
                           </p>
                         </div>
                         <div className="flex items-center gap-3">
-                          <div className="flex items-center bg-muted rounded-md p-0.5">
-                            <Button
-                              variant="ghost"
-                              size="icon"
-                              className="h-6 w-6 rounded-sm hover:bg-background"
-                              onClick={() => decrementCart(i)}
-                            >
-                              -
-                            </Button>
-                            <span className="font-mono w-6 text-center text-[10px] font-black">
-                              {item.qty}
+                          {item.soldByWeight ? (
+                            <span className="font-mono text-[10px] font-black">
+                              {item.qty.toFixed(3)} kg
                             </span>
-                            <Button
-                              variant="ghost"
-                              size="icon"
-                              className="h-6 w-6 rounded-sm hover:bg-background"
-                              onClick={() => addToCart(item, item.variant)}
-                            >
-                              +
-                            </Button>
-                          </div>
+                          ) : (
+                            <div className="flex items-center bg-muted rounded-md p-0.5">
+                              <Button
+                                variant="ghost"
+                                size="icon"
+                                className="h-6 w-6 rounded-sm hover:bg-background"
+                                onClick={() => decrementCart(i)}
+                              >
+                                -
+                              </Button>
+                              <span className="font-mono w-6 text-center text-[10px] font-black">
+                                {item.qty}
+                              </span>
+                              <Button
+                                variant="ghost"
+                                size="icon"
+                                className="h-6 w-6 rounded-sm hover:bg-background"
+                                onClick={() => addToCart(item, item.variant)}
+                              >
+                                +
+                              </Button>
+                            </div>
+                          )}
                           <div className="w-16 text-right">
                             <p className="font-mono font-black text-xs text-erp">
-                              ${(item.qty * item.unitCost).toLocaleString()}
+                              ${lineTotal(item).toLocaleString()}
                             </p>
                           </div>
                         </div>
@@ -702,11 +971,24 @@ export function Kiosk() {
                   </span>
                   <span className="font-mono font-bold text-sm">
                     $
-                    {total.toLocaleString(undefined, {
+                    {subtotal.toLocaleString(undefined, {
                       minimumFractionDigits: 2,
                     })}
                   </span>
                 </div>
+                {basketDiscount > 0 && (
+                  <div className="flex justify-between items-center">
+                    <span className="text-[10px] font-black uppercase tracking-[0.2em] text-muted-foreground">
+                      Discount
+                    </span>
+                    <button
+                      className="font-mono font-bold text-sm text-destructive"
+                      onClick={() => setBasketDiscount(0)}
+                    >
+                      -${basketDiscount.toFixed(2)}
+                    </button>
+                  </div>
+                )}
                 <div className="flex justify-between items-center text-3xl font-black tracking-tighter">
                   <span>TOTAL</span>
                   <span className={total < 0 ? "text-destructive" : "text-erp"}>
@@ -719,12 +1001,12 @@ export function Kiosk() {
 
                 <div className="grid grid-cols-2 gap-2">
                   <Button
-                    variant={isReturnMode ? "destructive" : "secondary"}
+                    variant="secondary"
                     size="sm"
                     className="text-[9px] uppercase font-black tracking-widest"
-                    onClick={() => setIsReturnMode(!isReturnMode)}
+                    onClick={() => setIsReturnOpen(true)}
                   >
-                    {isReturnMode ? "Return Mode Review" : "Return Mode"}
+                    Return
                   </Button>
                   <Button
                     variant="outline"
@@ -1084,6 +1366,102 @@ export function Kiosk() {
         </DialogContent>
       </Dialog>
 
+      {/* Offline Sales Modal */}
+      <Dialog open={isOfflineOpen} onOpenChange={setIsOfflineOpen}>
+        <DialogContent className="max-w-2xl">
+          <DialogHeader>
+            <DialogTitle>Offline Sales</DialogTitle>
+          </DialogHeader>
+          <ScrollArea className="max-h-[60vh] pr-2">
+            <div className="space-y-3 py-2">
+              {offlineSales.length === 0 && (
+                <p className="text-center text-muted-foreground opacity-50">
+                  All sales are synced.
+                </p>
+              )}
+              {offlineSales.map((o) => (
+                <div key={o.id} className="p-3 border rounded-lg bg-card space-y-2">
+                  <div className="flex justify-between items-center">
+                    <div>
+                      <p className="font-bold font-mono text-xs">{o.sale.uuid}</p>
+                      <p className="text-xs text-muted-foreground">
+                        {o.sale.soldAt
+                          ? new Date(o.sale.soldAt).toLocaleString()
+                          : ""}{" "}
+                        · {o.attempts} attempt(s)
+                      </p>
+                    </div>
+                    <Badge
+                      variant={
+                        o.status === "quarantined" ? "destructive" : "outline"
+                      }
+                    >
+                      {o.status}
+                    </Badge>
+                  </div>
+                  {o.lastError && (
+                    <p className="text-xs text-red-600">{o.lastError}</p>
+                  )}
+                  {o.status === "quarantined" && (
+                    <>
+                      {o.sale.items.map((it: any, i: number) => (
+                        <div key={i} className="flex items-center gap-2 text-sm">
+                          <span className="flex-1">
+                            {products.find((p) => p.id === it.productId)
+                              ?.name || `Item ${it.productId}`}{" "}
+                            @ ${it.price.toFixed(2)}
+                          </span>
+                          <Input
+                            type="number"
+                            className="w-20 h-8"
+                            value={it.quantity}
+                            onChange={(e) =>
+                              updateOfflineQty(o.id, i, Number(e.target.value))
+                            }
+                          />
+                        </div>
+                      ))}
+                      <div className="flex justify-between text-sm font-bold">
+                        <span>Total</span>
+                        <span>${o.sale.total.toFixed(2)}</span>
+                      </div>
+                      <div className="flex gap-2">
+                        <Input
+                          placeholder="Reason to void"
+                          className="h-8"
+                          value={voidReasons[o.id] || ""}
+                          onChange={(e) =>
+                            setVoidReasons((prev) => ({
+                              ...prev,
+                              [o.id]: e.target.value,
+                            }))
+                          }
+                        />
+                        <Button
+                          variant="destructive"
+                          size="sm"
+                          disabled={!voidReasons[o.id]}
+                          onClick={() => handleVoidOfflineSale(o.id)}
+                        >
+                          Void
+                        </Button>
+                        <Button
+                          size="sm"
+                          disabled={o.sale.items.length === 0}
+                          onClick={() => handleFixOfflineSale(o)}
+                        >
+                          Retry
+                        </Button>
+                      </div>
+                    </>
+                  )}
+                </div>
+              ))}
+            </div>
+          </ScrollArea>
+        </DialogContent>
+      </Dialog>
+
       {/* PIN Modal */}
       <Dialog open={isPinOpen} onOpenChange={setIsPinOpen}>
         <DialogContent className="max-w-xs">
@@ -1134,6 +1512,132 @@ export function Kiosk() {
         </DialogContent>
       </Dialog>
 
+      {/* Shift Modal */}
+      <Dialog open={isShiftOpen} onOpenChange={setIsShiftOpen}>
+        <DialogContent className="max-w-sm">
+          <DialogHeader>
+            <DialogTitle>{shift ? "Close Shift" : "Open Shift"}</DialogTitle>
+          </DialogHeader>
+          <Label>
+            {shift ? "Counted cash in the drawer" : "Opening float"}
+          </Label>
+          <Input
+            type="number"
+            placeholder="Amount"
+            value={shiftCash}
+            onChange={(e) => setShiftCash(e.target.value)}
+            className="text-2xl font-black h-14"
+            autoFocus
+          />
+          <Button
+            className="w-full h-12 text-lg font-black uppercase mt-4"
+            onClick={handleShift}
+          >
+            {shift ? "Close Shift" : "Open Shift"}
+          </Button>
+        </DialogContent>
+      </Dialog>
+
+      {/* Shift Result Modal, shown once the count is in */}
+      <Dialog open={!!closedShift} onOpenChange={() => setClosedShift(null)}>
+        <DialogContent className="max-w-sm">
+          <DialogHeader>
+            <DialogTitle>Shift #{closedShift?.id} Closed</DialogTitle>
+          </DialogHeader>
+          {closedShift && (
+            <div className="space-y-2 font-mono text-sm">
+              <div className="flex justify-between">
+                <span>Expected</span>
+                <span>${closedShift.expectedCash?.toFixed(2)}</span>
+              </div>
+              <div className="flex justify-between">
+                <span>Counted</span>
+                <span>${closedShift.countedCash?.toFixed(2)}</span>
+              </div>
+              <Separator />
+              <div
+                className={`flex justify-between font-bold ${closedShift.overShort < 0 ? "text-destructive" : ""}`}
+              >
+                <span>{closedShift.overShort < 0 ? "Short" : "Over"}</span>
+                <span>${Math.abs(closedShift.overShort ?? 0).toFixed(2)}</span>
+              </div>
+            </div>
+          )}
+        </DialogContent>
+      </Dialog>
+
+      {/* Return Modal */}
+      <Dialog open={isReturnOpen} onOpenChange={setIsReturnOpen}>
+        <DialogContent className="max-w-lg">
+          <DialogHeader>
+            <DialogTitle>Return Items</DialogTitle>
+          </DialogHeader>
+          <div className="flex gap-2">
+            <Input
+              placeholder="Sale ID from the receipt"
+              value={returnSaleId}
+              onChange={(e) => setReturnSaleId(e.target.value)}
+              onKeyDown={(e) => e.key === "Enter" && lookupReturnSale()}
+              autoFocus
+            />
+            <Button variant="outline" onClick={lookupReturnSale}>
+              <QrCode className="h-4 w-4" />
+            </Button>
+          </div>
+          {returnSale && (
+            <div className="space-y-3">
+              <ScrollArea className="max-h-[300px]">
+                {returnSale.lines.map((l: any) => (
+                  <div
+                    key={l.id}
+                    className="flex items-center justify-between gap-2 py-1 text-sm"
+                  >
+                    <div className="flex-1">
+                      <div className="font-bold">{l.productName}</div>
+                      <div className="text-muted-foreground text-xs">
+                        {l.quantity} @ ${l.unitPrice.toFixed(2)}, paid $
+                        {l.total.toFixed(2)}
+                        {l.returned > 0 && `, ${l.returned} returned`}
+                      </div>
+                    </div>
+                    <Input
+                      type="number"
+                      className="w-20"
+                      min={0}
+                      max={l.quantity - l.returned}
+                      disabled={l.returned >= l.quantity}
+                      value={returnQty[l.id] ?? ""}
+                      onChange={(e) =>
+                        setReturnQty({
+                          ...returnQty,
+                          [l.id]: Math.min(
+                            parseFloat(e.target.value) || 0,
+                            l.quantity - l.returned,
+                          ),
+                        })
+                      }
+                    />
+                  </div>
+                ))}
+              </ScrollArea>
+              <Input
+                placeholder="Reason for the return"
+                value={returnReason}
+                onChange={(e) => setReturnReason(e.target.value)}
+              />
+              <Button
+                variant="destructive"
+                className="w-full h-12 font-black uppercase"
+                disabled={!returnReason.trim() || isProcessing}
+                onClick={handleReturn}
+              >
+                Refund to {returnSale.tenders?.[0]?.method || "original tender"}
+              </Button>
+            </div>
+          )}
+        </DialogContent>
+      </Dialog>
+
       {/* Drawer Ops Modal */}
       <Dialog open={isDrawerOpsOpen} onOpenChange={setIsDrawerOpsOpen}>
         <DialogContent className="max-w-sm">
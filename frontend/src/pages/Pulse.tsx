@@ -18,6 +18,10 @@ import {
   Terminal,
   MoreHorizontal,
   Server,
+  KeyRound,
+  ShieldOff,
+  UserPlus,
+  Tags,
 } from "lucide-react";
 import { toast } from "sonner";
 import { formatDistanceToNow } from "date-fns";
@@ -36,6 +40,7 @@ import DashboardPage from "./pulse/DashboardPage";
 import AlertsPage from "./pulse/AlertsPage";
 import PoliciesPage from "./pulse/PoliciesPage";
 import ExecutionHistoryPage from "./pulse/ExecutionHistoryPage";
+import DeviceGroupsPage from "./pulse/DeviceGroupsPage";
 import ReportsPage from "./pulse/ReportsPage";
 import PatchesTab from "./pulse/PatchesTab";
 import SoftwareTab from "./pulse/SoftwareTab";
@@ -49,6 +54,9 @@ interface Agent {
   ip: string;
   status: "online" | "offline" | "warning";
   last_seen: string;
+  revoked_at?: string;
+  rekey_requested?: boolean;
+  tags?: string[];
   cpu?: number;
   memory?: number;
   disk?: number;
@@ -121,10 +129,70 @@ const PulsePage = () => {
     fetchAgents();
   }, []);
 
+  const createEnrollmentToken = async () => {
+    try {
+      const w = window as any;
+      const res = await w.go.bridge.PulseBridge.CreateEnrollmentToken(24);
+      await navigator.clipboard.writeText(res.token);
+      toast.success("Enrollment token copied", {
+        description:
+          "Set SENT_AGENT_ENROLL_TOKEN to it on the device. It enrols one device within 24 hours.",
+      });
+    } catch (err: any) {
+      toast.error(err?.toString() || "Failed to create enrollment token");
+    }
+  };
+
+  const rekeyAgent = async (agent: Agent) => {
+    try {
+      const w = window as any;
+      await w.go.bridge.PulseBridge.RekeyAgent(agent.id);
+      toast.success(`${agent.hostname} will replace its key when it next connects`);
+      fetchAgents();
+    } catch (err: any) {
+      toast.error(err?.toString() || "Failed to rekey agent");
+    }
+  };
+
+  const revokeAgent = async (agent: Agent) => {
+    if (!confirm(`Revoke ${agent.hostname}? It will need a new enrollment token to reconnect.`)) {
+      return;
+    }
+    try {
+      const w = window as any;
+      await w.go.bridge.PulseBridge.RevokeAgent(agent.id);
+      toast.success(`${agent.hostname} revoked`);
+      fetchAgents();
+    } catch (err: any) {
+      toast.error(err?.toString() || "Failed to revoke agent");
+    }
+  };
+
+  const editTags = async (agent: Agent) => {
+    const input = prompt(
+      `Tags for ${agent.hostname}, comma separated:`,
+      (agent.tags || []).join(", "),
+    );
+    if (input === null) return;
+    try {
+      const w = window as any;
+      const tags = input
+        .split(",")
+        .map((t) => t.trim())
+        .filter(Boolean);
+      await w.go.bridge.PulseBridge.SetAgentTags(agent.id, tags);
+      toast.success(`Tags updated for ${agent.hostname}`);
+      fetchAgents();
+    } catch (err: any) {
+      toast.error(err?.toString() || "Failed to update tags");
+    }
+  };
+
   const filteredAgents = agents.filter(
     (a) =>
       a.hostname.toLowerCase().includes(searchTerm.toLowerCase()) ||
-      a.ip.includes(searchTerm),
+      a.ip.includes(searchTerm) ||
+      (a.tags || []).some((t) => t.includes(searchTerm.toLowerCase())),
   );
 
   if (selectedDevice) {
@@ -165,7 +233,7 @@ const PulsePage = () => {
             <div className="flex items-center space-x-2">
               <Search className="h-4 w-4 text-muted-foreground" />
               <Input
-                placeholder="Search devices by hostname or IP..."
+                placeholder="Search devices by hostname, IP or tag..."
                 value={searchTerm}
                 onChange={(e) => setSearchTerm(e.target.value)}
                 className="max-w-sm"
@@ -180,6 +248,7 @@ const PulsePage = () => {
                     <TableHead>OS</TableHead>
                     <TableHead>IP Address</TableHead>
                     <TableHead>Status</TableHead>
+                    <TableHead>Tags</TableHead>
                     <TableHead>Last Seen</TableHead>
                     <TableHead className="text-right">Actions</TableHead>
                   </TableRow>
@@ -204,11 +273,26 @@ const PulsePage = () => {
                       <TableCell>
                         <Badge
                           variant="outline"
-                          className={getStatusColor(agent.status)}
+                          className={getStatusColor(
+                            agent.revoked_at ? "revoked" : agent.status,
+                          )}
                         >
-                          {agent.status}
+                          {agent.revoked_at ? "revoked" : agent.status}
                         </Badge>
                       </TableCell>
+                      <TableCell>
+                        <div className="flex flex-wrap gap-1">
+                          {(agent.tags || []).map((t) => (
+                            <Badge
+                              key={t}
+                              variant="secondary"
+                              className="font-mono text-[10px]"
+                            >
+                              {t}
+                            </Badge>
+                          ))}
+                        </div>
+                      </TableCell>
                       <TableCell className="text-muted-foreground text-xs">
                         {formatDistanceToNow(new Date(agent.last_seen), {
                           addSuffix: true,
@@ -234,10 +318,29 @@ const PulsePage = () => {
                               <Terminal className="mr-2 h-4 w-4" /> Connect
                               Terminal
                             </DropdownMenuItem>
+                            <DropdownMenuItem onClick={() => editTags(agent)}>
+                              <Tags className="mr-2 h-4 w-4" /> Edit Tags
+                            </DropdownMenuItem>
                             <DropdownMenuSeparator />
                             <DropdownMenuItem className="text-red-600">
                               <Power className="mr-2 h-4 w-4" /> Reboot Device
                             </DropdownMenuItem>
+                            <DropdownMenuSeparator />
+                            <DropdownMenuLabel>Identity</DropdownMenuLabel>
+                            <DropdownMenuItem
+                              disabled={!!agent.revoked_at}
+                              onClick={() => rekeyAgent(agent)}
+                            >
+                              <KeyRound className="mr-2 h-4 w-4" /> Rekey Agent
+                            </DropdownMenuItem>
+                            <DropdownMenuItem
+                              className="text-red-600"
+                              disabled={!!agent.revoked_at}
+                              onClick={() => revokeAgent(agent)}
+                            >
+                              <ShieldOff className="mr-2 h-4 w-4" /> Revoke
+                              Agent
+                            </DropdownMenuItem>
                           </DropdownMenuContent>
                         </DropdownMenu>
                       </TableCell>
@@ -264,6 +367,8 @@ const PulsePage = () => {
         return <PoliciesPage />;
       case "history":
         return <ExecutionHistoryPage />;
+      case "groups":
+        return <DeviceGroupsPage />;
       case "remote":
         return (
           <div className="p-6 text-muted-foreground">
@@ -293,6 +398,10 @@ const PulsePage = () => {
           </p>
         </div>
         <div className="flex gap-2">
+          <Button variant="outline" onClick={createEnrollmentToken}>
+            <UserPlus className="mr-2 h-4 w-4" />
+            Enroll Device
+          </Button>
           <Button variant="outline" onClick={fetchAgents} disabled={loading}>
             <RefreshCw
               className={`mr-2 h-4 w-4 ${loading ? "animate-spin" : ""}`}
@@ -30,6 +30,8 @@ import {
   CreatePurchaseOrder,
   GetPurchaseOrders,
   ReceivePurchaseOrder,
+  SubmitPurchaseOrder,
+  RunReorder,
   RecordCount,
   DisposeAsset,
   ImportProducts,
@@ -93,6 +95,7 @@ import { Checkbox } from "@/components/ui/checkbox";
 import { BarcodeScanner } from "@/components/inventory/BarcodeScanner";
 import { CheckoutDialog } from "@/components/stock/CheckoutDialog";
 import { ReorderPane } from "@/components/stock/ReorderPane";
+import { LabelDesigner } from "@/components/stock/LabelDesigner";
 import { Suppliers } from "@/pages/Suppliers";
 import { Categories } from "@/pages/Categories";
 import { toast } from "sonner";
@@ -759,9 +762,18 @@ export function Stock() {
                   Generated procurement manifests for strategic suppliers.
                 </CardDescription>
               </div>
-              <Button size="sm" onClick={() => setIsPODialogOpen(true)}>
-                <Plus className="mr-2 h-4 w-4" /> Create PO
-              </Button>
+              <div className="flex gap-2">
+                <Button
+                  size="sm"
+                  variant="outline"
+                  onClick={() => RunReorder().then(fetchPOs)}
+                >
+                  <TrendingUp className="mr-2 h-4 w-4" /> Run Reorder
+                </Button>
+                <Button size="sm" onClick={() => setIsPODialogOpen(true)}>
+                  <Plus className="mr-2 h-4 w-4" /> Create PO
+                </Button>
+              </div>
             </CardHeader>
             <CardContent>
               <Table>
@@ -802,15 +814,32 @@ export function Stock() {
                           >
                             {po.status}
                           </Badge>
+                          {po.autoGenerated && (
+                            <Badge variant="outline" className="ml-2">
+                              auto
+                            </Badge>
+                          )}
                         </TableCell>
                         <TableCell className="text-right">
-                          {po.status !== "received" && (
+                          {po.status === "draft" && (
+                            <Button
+                              size="sm"
+                              variant="ghost"
+                              onClick={() =>
+                                SubmitPurchaseOrder(po.id).then(fetchPOs)
+                              }
+                            >
+                              <Truck className="h-4 w-4 mr-1" /> Submit
+                            </Button>
+                          )}
+                          {(po.status === "submitted" ||
+                            po.status === "partially_received") && (
                             <Button
                               size="sm"
                               variant="ghost"
                               className="hover:bg-emerald-500/10 hover:text-emerald-600"
                               onClick={() =>
-                                ReceivePurchaseOrder(po.id).then(fetchPOs)
+                                ReceivePurchaseOrder(po.id, 0).then(fetchPOs)
                               }
                             >
                               <Package className="h-4 w-4 mr-1" /> Receive
@@ -824,6 +853,8 @@ export function Stock() {
               </Table>
             </CardContent>
           </Card>
+        ) : activeTab === "labels" ? (
+          <LabelDesigner products={products} purchaseOrders={purchaseOrders} />
         ) : activeTab === "maintenance" ? (
           <Card>
             <CardHeader className="flex flex-row items-center justify-between">
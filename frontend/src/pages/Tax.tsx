@@ -14,11 +14,17 @@ import {
   TableHeader,
   TableRow,
 } from "@/components/ui/table";
-import { GetTaxConfig, GetTaxSummary } from "../../wailsjs/go/tax/TaxBridge";
+import {
+  ExportTaxReport,
+  GetTaxRegister,
+  GetTaxSummary,
+} from "../../wailsjs/go/tax/TaxBridge";
+import { tax } from "../../wailsjs/go/models";
+import { TaxJurisdictions } from "@/components/tax/TaxJurisdictions";
+import { EInvoicing } from "@/components/tax/EInvoicing";
 import {
   Scale,
   ShieldCheck,
-  Globe,
   FileText,
   Lock,
   Unlock,
@@ -52,12 +58,6 @@ import {
 import { Input } from "@/components/ui/input";
 import { Skeleton } from "@/components/ui/skeleton";
 
-interface TaxConfig {
-  countryCode: string;
-  defaultRate: number;
-  isFiscal: boolean;
-}
-
 interface TaxBox {
   id: string;
   label: string;
@@ -66,108 +66,80 @@ interface TaxBox {
   category: "output" | "input" | "adjustment" | "total";
 }
 
-interface Transaction {
-  id: string;
-  date: string;
-  reference: string;
-  entity: string;
-  amount: number;
-  taxAmount: number;
-  taxCode: string;
-}
-
-const MOCK_TRANSACTIONS: Record<string, Transaction[]> = {
-  box1: [
-    {
-      id: "TX-1001",
-      date: "2026-01-15",
-      reference: "INV-2026-001",
-      entity: "Acme Corp",
-      amount: 10000,
-      taxAmount: 1600,
-      taxCode: "JO-S16",
-    },
-    {
-      id: "TX-1002",
-      date: "2026-01-18",
-      reference: "INV-2026-002",
-      entity: "Global Tech",
-      amount: 5000,
-      taxAmount: 800,
-      taxCode: "JO-S16",
-    },
-    {
-      id: "TX-1003",
-      date: "2026-02-05",
-      reference: "INV-2026-015",
-      entity: "Retail Giant",
-      amount: 25000,
-      taxAmount: 4000,
-      taxCode: "JO-S16",
-    },
-  ],
-  box4: [
-    {
-      id: "TX-2001",
-      date: "2026-01-10",
-      reference: "PUR-2026-005",
-      entity: "Server Provider",
-      amount: 2000,
-      taxAmount: 320,
-      taxCode: "JO-P16",
-    },
-    {
-      id: "TX-2002",
-      date: "2026-02-12",
-      reference: "PUR-2026-012",
-      entity: "Office Supplies",
-      amount: 500,
-      taxAmount: 80,
-      taxCode: "JO-P16",
-    },
-  ],
+// Boxes whose amounts come from the sales or the purchase register.
+const boxRegister: Record<string, "sales" | "purchases"> = {
+  box1: "sales",
+  box3: "sales",
+  box6: "sales",
+  box4: "purchases",
+  box7: "purchases",
 };
 
+const filings: { report: string; label: string; formats: string[] }[] = [
+  { report: "vat_return", label: "VAT return", formats: ["pdf", "csv"] },
+  { report: "sales_register", label: "Sales register", formats: ["pdf", "csv"] },
+  { report: "purchase_register", label: "Purchase register", formats: ["pdf", "csv"] },
+  { report: "audit_file", label: "Audit file (SAF-T)", formats: ["xml"] },
+];
+
 export function Tax() {
-  const [configs, setConfigs] = useState<TaxConfig[]>([]);
   const [period, setPeriod] = useState("2026-Q1");
   const [status, setStatus] = useState<"open" | "locked" | "filed">("open");
   const [selectedBox, setSelectedBox] = useState<TaxBox | null>(null);
   const [searchQuery, setSearchQuery] = useState("");
   const [loading, setLoading] = useState(true);
   const [summary, setSummary] = useState<any>(null);
+  const [register, setRegister] = useState<tax.TaxRegisterLineDTO[]>([]);
+  const [exporting, setExporting] = useState<string | null>(null);
 
   const fetchSummary = async () => {
     try {
       const res = await GetTaxSummary(period);
       setSummary(res);
     } catch (err) {
-      console.error("Failed to load tax summary:", err);
+      setSummary(null);
+      toast.error(`Failed to build the VAT return: ${err}`);
+    } finally {
+      setLoading(false);
     }
   };
 
   useEffect(() => {
-    fetchConfigs();
     fetchSummary();
   }, [period]);
 
-  const fetchConfigs = async () => {
+  useEffect(() => {
+    const kind = selectedBox ? boxRegister[selectedBox.id] : undefined;
+    setRegister([]);
+    if (!kind) return;
+    GetTaxRegister(kind, period)
+      .then((lines) => setRegister(lines || []))
+      .catch((err) => toast.error(`Failed to load the ${kind} register: ${err}`));
+  }, [selectedBox, period]);
+
+  const handleExport = async (report: string, format: string) => {
+    setExporting(`${report}.${format}`);
     try {
-      setLoading(true);
-      const res = await GetTaxConfig();
-      setConfigs(res || []);
+      const path = await ExportTaxReport(report, period, format);
+      toast.success(`Filed in SENTvault: ${path}`);
     } catch (err) {
-      toast.error("Failed to load tax configurations");
+      toast.error(`${err}`);
     } finally {
-      setLoading(false);
+      setExporting(null);
     }
   };
 
+  const visibleRegister = register.filter(
+    (l) =>
+      l.document.toLowerCase().includes(searchQuery.toLowerCase()) ||
+      (l.counterparty || "").toLowerCase().includes(searchQuery.toLowerCase()),
+  );
+
   const boxes: TaxBox[] = [
     {
       id: "box1",
       label: "Box 1",
-      description: "Tax due in this period on sales and other outputs",
+      description: `Tax due in this period on sales and other outputs, after ${(summary?.outputAdjustments || 0).toFixed(2)} on credit notes and refunds`,
       amount: summary?.box1 || 0,
       category: "output",
     },
@@ -188,7 +160,7 @@ export function Tax() {
     {
       id: "box4",
       label: "Box 4",
-      description: "VAT reclaimed in this period on purchases and other inputs",
+      description: `VAT reclaimed in this period on purchases and other inputs${summary?.otherInputTax ? `, ${summary.otherInputTax.toFixed(2)} of it by journal` : ""}`,
       amount: summary?.box4 || 0,
       category: "input",
     },
@@ -200,6 +172,23 @@ export function Tax() {
       amount: summary?.box5 || 0,
       category: "total",
     },
+    {
+      id: "box6",
+      label: "Box 6",
+      description: `Total sales excluding VAT: standard ${(summary?.standardSales || 0).toFixed(2)}, zero-rated ${(summary?.zeroRatedSales || 0).toFixed(2)}, exempt ${(summary?.exemptSales || 0).toFixed(2)}, of which ${(summary?.salesAdjustments || 0).toFixed(2)} credit notes and refunds`,
+      amount:
+        (summary?.standardSales || 0) +
+        (summary?.zeroRatedSales || 0) +
+        (summary?.exemptSales || 0),
+      category: "adjustment",
+    },
+    {
+      id: "box7",
+      label: "Box 7",
+      description: "Total purchases excluding VAT",
+      amount: summary?.purchases || 0,
+      category: "adjustment",
+    },
   ];
 
   const handleLock = () => {
@@ -255,6 +244,9 @@ export function Tax() {
                     <SelectItem value="2025-Q4">2025 Q4</SelectItem>
                     <SelectItem value="2026-Q1">2026 Q1</SelectItem>
                     <SelectItem value="2026-Q2">2026 Q2</SelectItem>
+                    <SelectItem value="2026-Q3">2026 Q3</SelectItem>
+                    <SelectItem value="2026-Q4">2026 Q4</SelectItem>
+                    <SelectItem value="2026">2026</SelectItem>
                   </SelectContent>
                 </Select>
               </div>
@@ -424,38 +416,38 @@ export function Tax() {
 
             {/* Right Column: Configs & Tools */}
             <div className="space-y-6">
-              <Card className="border-none shadow-xl overflow-hidden bg-muted/20">
+              <TaxJurisdictions />
+              <EInvoicing />
+
+              <Card className="border-none shadow-xl bg-muted/20 overflow-hidden">
                 <CardHeader className="bg-muted/40 border-b">
                   <CardTitle className="flex items-center gap-2 text-xs font-black uppercase tracking-widest">
-                    <Globe className="h-3 w-3 text-erp" /> Active Tax
-                    Jurisdictions
+                    <FileText className="h-3 w-3 text-erp" /> Filing Artefacts
                   </CardTitle>
+                  <CardDescription className="text-[10px]">
+                    Exports for {period} are filed in SENTvault under tax/{period}.
+                  </CardDescription>
                 </CardHeader>
-                <CardContent className="p-0">
-                  <Table>
-                    <TableBody>
-                      {configs.map((c) => (
-                        <TableRow
-                          key={c.countryCode}
-                          className="hover:bg-muted/30 border-none transition-colors"
-                        >
-                          <TableCell className="font-bold pl-6 text-erp">
-                            {c.countryCode}
-                          </TableCell>
-                          <TableCell className="font-mono text-xs">
-                            {(c.defaultRate * 100).toFixed(0)}% Rate
-                          </TableCell>
-                          <TableCell className="text-right pr-6">
-                            {c.isFiscal && (
-                              <Badge className="bg-erp/10 text-erp border-erp/20 text-[8px] font-black h-5">
-                                ZATCA/JO
-                              </Badge>
-                            )}
-                          </TableCell>
-                        </TableRow>
-                      ))}
-                    </TableBody>
-                  </Table>
+                <CardContent className="p-4 space-y-2">
+                  {filings.map((e) => (
+                    <div key={e.report} className="flex items-center justify-between gap-2">
+                      <span className="text-[11px] font-bold">{e.label}</span>
+                      <div className="flex gap-1">
+                        {e.formats.map((f) => (
+                          <Button
+                            key={f}
+                            size="sm"
+                            variant="outline"
+                            className="h-6 px-2 text-[9px] font-black uppercase"
+                            disabled={exporting !== null}
+                            onClick={() => handleExport(e.report, f)}
+                          >
+                            {exporting === `${e.report}.${f}` ? "..." : f}
+                          </Button>
+                        ))}
+                      </div>
+                    </div>
+                  ))}
                 </CardContent>
               </Card>
 
@@ -565,8 +557,17 @@ export function Tax() {
                   onChange={(e) => setSearchQuery(e.target.value)}
                 />
               </div>
-              <Button variant="outline" size="sm" className="h-9 px-3 gap-2">
-                <FileText className="h-3.5 w-3.5" /> Export Manifest
+              <Button
+                variant="outline"
+                size="sm"
+                className="h-9 px-3 gap-2"
+                disabled={!selectedBox || !boxRegister[selectedBox.id] || exporting !== null}
+                onClick={() =>
+                  selectedBox &&
+                  handleExport(boxRegister[selectedBox.id] === "sales" ? "sales_register" : "purchase_register", "csv")
+                }
+              >
+                <FileText className="h-3.5 w-3.5" /> Export Register
               </Button>
             </div>
           </div>
@@ -588,54 +589,44 @@ export function Tax() {
                     Base Amt
                   </TableHead>
                   <TableHead className="text-[10px] font-black uppercase py-2 text-right pr-6">
-                    VAT (16%)
+                    VAT
                   </TableHead>
                 </TableRow>
               </TableHeader>
               <TableBody>
-                {MOCK_TRANSACTIONS[selectedBox?.id || ""] ? (
-                  MOCK_TRANSACTIONS[selectedBox?.id || ""]
-                    .filter(
-                      (tx) =>
-                        tx.reference
-                          .toLowerCase()
-                          .includes(searchQuery.toLowerCase()) ||
-                        tx.entity
-                          .toLowerCase()
-                          .includes(searchQuery.toLowerCase()),
-                    )
-                    .map((tx) => (
-                      <TableRow
-                        key={tx.id}
-                        className="hover:bg-muted/30 transition-colors border-b/5 cursor-default"
-                      >
-                        <TableCell className="pl-6">
-                          <div className="space-y-0.5">
-                            <p className="text-xs font-bold">{tx.reference}</p>
-                            <p className="text-[9px] text-muted-foreground mono-audit">
-                              {tx.date}
-                            </p>
-                          </div>
-                        </TableCell>
-                        <TableCell className="text-xs font-medium">
-                          {tx.entity}
-                        </TableCell>
-                        <TableCell className="text-right">
-                          <Badge
-                            variant="secondary"
-                            className="text-[8px] font-black font-mono px-1.5 h-4"
-                          >
-                            {tx.taxCode}
-                          </Badge>
-                        </TableCell>
-                        <TableCell className="text-right mono-audit text-xs">
-                          ${tx.amount.toFixed(2)}
-                        </TableCell>
-                        <TableCell className="text-right pr-6 mono-audit text-xs font-bold text-erp">
-                          ${tx.taxAmount.toFixed(4)}
-                        </TableCell>
-                      </TableRow>
-                    ))
+                {visibleRegister.length > 0 ? (
+                  visibleRegister.map((tx, i) => (
+                    <TableRow
+                      key={`${tx.document}-${tx.taxCode}-${i}`}
+                      className="hover:bg-muted/30 transition-colors border-b/5 cursor-default"
+                    >
+                      <TableCell className="pl-6">
+                        <div className="space-y-0.5">
+                          <p className="text-xs font-bold">{tx.document}</p>
+                          <p className="text-[9px] text-muted-foreground mono-audit">
+                            {String(tx.date).slice(0, 10)}
+                          </p>
+                        </div>
+                      </TableCell>
+                      <TableCell className="text-xs font-medium">
+                        {tx.counterparty || "Walk-in"}
+                      </TableCell>
+                      <TableCell className="text-right">
+                        <Badge
+                          variant="secondary"
+                          className="text-[8px] font-black font-mono px-1.5 h-4"
+                        >
+                          {tx.taxCode}
+                        </Badge>
+                      </TableCell>
+                      <TableCell className="text-right mono-audit text-xs">
+                        {tx.netFunctional.toFixed(2)}
+                      </TableCell>
+                      <TableCell className="text-right pr-6 mono-audit text-xs font-bold text-erp">
+                        {tx.taxFunctional.toFixed(2)}
+                      </TableCell>
+                    </TableRow>
+                  ))
                 ) : (
                   <TableRow>
                     <TableCell colSpan={5} className="h-64 text-center">
@@ -660,7 +651,7 @@ export function Tax() {
               <span className="text-muted-foreground font-black uppercase">
                 Total Records:{" "}
                 <span className="text-foreground">
-                  {MOCK_TRANSACTIONS[selectedBox?.id || ""]?.length || 0}
+                  {register.length}
                 </span>
               </span>
               <span className="text-muted-foreground font-black uppercase">
@@ -17,59 +17,163 @@ import {
   XCircle,
   Search,
   Filter,
+  Plus,
+  Settings,
+  Trash2,
 } from "lucide-react";
 import { formatDistanceToNow } from "date-fns";
+import { toast } from "sonner";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Label } from "@/components/ui/label";
+import { Switch } from "@/components/ui/switch";
 
 interface Alert {
   id: number;
-  device: string;
+  ruleId: number;
+  ruleName: string;
+  agentId: number;
+  hostname: string;
   severity: "critical" | "warning" | "info";
   message: string;
-  timestamp: string;
-  acknowledged: boolean;
+  value: number;
+  raisedAt: string;
+  resolvedAt?: string;
+  acknowledgedAt?: string;
+  acknowledgedBy: string;
+}
+
+interface AlertRule {
+  id: number;
+  name: string;
+  targets: string[];
+  metric: "cpu" | "memory" | "offline";
+  threshold: number;
+  severity: string;
+  enabled: boolean;
+  targetCount: number;
+  openAlerts: number;
 }
 
+interface DeviceGroup {
+  id: number;
+  name: string;
+  memberCount: number;
+}
+
+const emptyForm = {
+  name: "",
+  target: "all", // all, group, tag, or custom for the agent IDs in agentIds
+  groupId: "",
+  tag: "",
+  agentIds: "",
+  metric: "cpu",
+  threshold: "90",
+  severity: "warning",
+  enabled: true,
+};
+
+// Turns a rule's targets back into the form's target picker.
+const targetForm = (targets: string[]) => {
+  const [first] = targets;
+  if (targets.length === 1 && first === "all") return { target: "all" };
+  if (targets.length === 1 && first.startsWith("group:"))
+    return { target: "group", groupId: first.slice(6) };
+  if (targets.length === 1 && first.startsWith("tag:"))
+    return { target: "tag", tag: first.slice(4) };
+  return {
+    target: "custom",
+    agentIds: targets.map((t) => t.replace(/^agent:/, "")).join(", "),
+  };
+};
+
+const describeCondition = (rule: AlertRule) => {
+  switch (rule.metric) {
+    case "offline":
+      return `Offline for ${rule.threshold} min`;
+    case "memory":
+      return `Memory ≥ ${rule.threshold}%`;
+    default:
+      return `CPU ≥ ${rule.threshold}%`;
+  }
+};
+
 const AlertsPage: React.FC = () => {
   const [alerts, setAlerts] = useState<Alert[]>([]);
+  const [rules, setRules] = useState<AlertRule[]>([]);
+  const [groups, setGroups] = useState<DeviceGroup[]>([]);
+  const [tags, setTags] = useState<string[]>([]);
   const [searchTerm, setSearchTerm] = useState("");
+  const [showResolved, setShowResolved] = useState(false);
+  const [isModalOpen, setIsModalOpen] = useState(false);
+  const [editing, setEditing] = useState<AlertRule | null>(null);
+  const [formData, setFormData] = useState(emptyForm);
+
+  const fetchAlerts = async () => {
+    try {
+      const w = window as any;
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        const res = await w.go.bridge.PulseBridge.ListAlerts(showResolved, 200);
+        setAlerts(res || []);
+        const ruleRes = await w.go.bridge.PulseBridge.ListAlertRules();
+        setRules(ruleRes || []);
+
+        // And the groups and tags rules can target
+        const groupRes = await w.go.bridge.PulseBridge.ListDeviceGroups();
+        setGroups(groupRes || []);
+        const tagRes = await w.go.bridge.PulseBridge.ListAgentTags();
+        setTags(tagRes || []);
+      } else {
+        // Mock data
+        setAlerts([
+          {
+            id: 1,
+            ruleId: 1,
+            ruleName: "High CPU",
+            agentId: 1,
+            hostname: "SRV-DC01",
+            severity: "critical",
+            message: "CPU usage on SRV-DC01 is 97.0% (threshold 95%)",
+            value: 97,
+            raisedAt: new Date().toISOString(),
+            acknowledgedBy: "",
+          },
+        ]);
+        setRules([
+          {
+            id: 1,
+            name: "High CPU",
+            targets: ["all"],
+            metric: "cpu",
+            threshold: 95,
+            severity: "critical",
+            enabled: true,
+            targetCount: 50,
+            openAlerts: 1,
+          },
+        ]);
+      }
+    } catch (err) {
+      toast.error("Failed to fetch alerts");
+    }
+  };
 
   useEffect(() => {
-    // Mock data
-    setAlerts([
-      {
-        id: 1,
-        device: "SRV-DC01",
-        severity: "critical",
-        message: "CPU usage exceeded 95%",
-        timestamp: new Date().toISOString(),
-        acknowledged: false,
-      },
-      {
-        id: 2,
-        device: "WKST-HR-04",
-        severity: "warning",
-        message: "Disk space below 20%",
-        timestamp: new Date(Date.now() - 3600000).toISOString(),
-        acknowledged: false,
-      },
-      {
-        id: 3,
-        device: "DB-PROD-01",
-        severity: "info",
-        message: "Patch installation completed",
-        timestamp: new Date(Date.now() - 7200000).toISOString(),
-        acknowledged: true,
-      },
-      {
-        id: 4,
-        device: "SRV-FILE01",
-        severity: "critical",
-        message: "Service 'Spooler' stopped unexpectedly",
-        timestamp: new Date(Date.now() - 1800000).toISOString(),
-        acknowledged: false,
-      },
-    ]);
-  }, []);
+    fetchAlerts();
+  }, [showResolved]);
 
   const getSeverityStyle = (severity: string) => {
     switch (severity) {
@@ -93,9 +197,132 @@ const AlertsPage: React.FC = () => {
     }
   };
 
+  const handleAcknowledge = async (alert: Alert) => {
+    try {
+      const w = window as any;
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        await w.go.bridge.PulseBridge.AcknowledgeAlert(alert.id);
+        fetchAlerts();
+      }
+    } catch (err) {
+      toast.error(`Failed to acknowledge alert: ${err}`);
+    }
+  };
+
+  const handleAcknowledgeAll = async () => {
+    try {
+      const w = window as any;
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        const n: number =
+          await w.go.bridge.PulseBridge.AcknowledgeAllAlerts();
+        toast.success(`${n} alert${n === 1 ? "" : "s"} acknowledged`);
+        fetchAlerts();
+      }
+    } catch (err) {
+      toast.error(`Failed to acknowledge alerts: ${err}`);
+    }
+  };
+
+  const openCreate = () => {
+    setEditing(null);
+    setFormData(emptyForm);
+    setIsModalOpen(true);
+  };
+
+  const openEdit = (rule: AlertRule) => {
+    setEditing(rule);
+    setFormData({
+      ...emptyForm,
+      ...targetForm(rule.targets),
+      name: rule.name,
+      metric: rule.metric,
+      threshold: rule.threshold.toString(),
+      severity: rule.severity,
+      enabled: rule.enabled,
+    });
+    setIsModalOpen(true);
+  };
+
+  const handleSave = async () => {
+    try {
+      const w = window as any;
+      let targets: string[];
+      switch (formData.target) {
+        case "all":
+          targets = ["all"];
+          break;
+        case "group":
+          targets = [`group:${formData.groupId}`];
+          break;
+        case "tag":
+          targets = [`tag:${formData.tag}`];
+          break;
+        default:
+          targets = formData.agentIds
+            .split(",")
+            .map((s) => s.trim())
+            .filter(Boolean)
+            .map((id) => `agent:${id}`);
+      }
+      const args = [
+        formData.name,
+        targets,
+        formData.metric,
+        parseFloat(formData.threshold) || 0,
+        formData.severity,
+        formData.enabled,
+      ];
+
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        if (editing) {
+          await w.go.bridge.PulseBridge.UpdateAlertRule(editing.id, ...args);
+          toast.success("Rule updated");
+        } else {
+          await w.go.bridge.PulseBridge.CreateAlertRule(...args);
+          toast.success("Rule created");
+        }
+        fetchAlerts();
+        setIsModalOpen(false);
+      } else {
+        toast.success("Mock: Rule saved");
+        setIsModalOpen(false);
+      }
+    } catch (err) {
+      toast.error(`Failed to save rule: ${err}`);
+      console.error(err);
+    }
+  };
+
+  const handleDelete = async (rule: AlertRule) => {
+    if (!confirm(`Delete rule "${rule.name}" and its alerts?`)) return;
+    try {
+      const w = window as any;
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        await w.go.bridge.PulseBridge.DeleteAlertRule(rule.id);
+        toast.success("Rule deleted");
+        fetchAlerts();
+      }
+    } catch (err) {
+      toast.error(`Failed to delete rule: ${err}`);
+    }
+  };
+
+  const describeTarget = (t: string) => {
+    if (t === "all") return "All devices";
+    const [kind, value] = t.includes(":") ? t.split(/:(.*)/) : ["agent", t];
+    switch (kind) {
+      case "group":
+        return `Group: ${groups.find((g) => g.id.toString() === value)?.name || value}`;
+      case "tag":
+        return `Tag: ${value}`;
+      default:
+        return `Device #${value}`;
+    }
+  };
+
   const filteredAlerts = alerts.filter(
     (a) =>
-      a.device.toLowerCase().includes(searchTerm.toLowerCase()) ||
+      a.hostname.toLowerCase().includes(searchTerm.toLowerCase()) ||
       a.message.toLowerCase().includes(searchTerm.toLowerCase()),
   );
 
@@ -109,10 +336,15 @@ const AlertsPage: React.FC = () => {
           </p>
         </div>
         <div className="flex gap-2">
-          <Button variant="outline" size="sm">
-            <Filter className="mr-2 h-4 w-4" /> Filter
+          <Button
+            variant="outline"
+            size="sm"
+            onClick={() => setShowResolved(!showResolved)}
+          >
+            <Filter className="mr-2 h-4 w-4" />
+            {showResolved ? "Hide Resolved" : "Show Resolved"}
           </Button>
-          <Button size="sm">
+          <Button size="sm" onClick={handleAcknowledgeAll}>
             <CheckCircle className="mr-2 h-4 w-4" /> Acknowledge All
           </Button>
         </div>
@@ -141,42 +373,308 @@ const AlertsPage: React.FC = () => {
             </TableRow>
           </TableHeader>
           <TableBody>
-            {filteredAlerts.map((alert) => (
-              <TableRow key={alert.id}>
-                <TableCell>{getSeverityIcon(alert.severity)}</TableCell>
-                <TableCell className="font-medium">{alert.device}</TableCell>
-                <TableCell>
-                  <Badge
-                    variant="outline"
-                    className={getSeverityStyle(alert.severity)}
-                  >
-                    {alert.severity}
-                  </Badge>
-                </TableCell>
-                <TableCell className="max-w-md truncate">
-                  {alert.message}
+            {filteredAlerts.length === 0 ? (
+              <TableRow>
+                <TableCell
+                  colSpan={6}
+                  className="h-24 text-center text-muted-foreground"
+                >
+                  No alerts.
                 </TableCell>
-                <TableCell className="text-muted-foreground text-xs">
-                  {formatDistanceToNow(new Date(alert.timestamp), {
-                    addSuffix: true,
-                  })}
-                </TableCell>
-                <TableCell>
-                  {alert.acknowledged ? (
+              </TableRow>
+            ) : (
+              filteredAlerts.map((alert) => (
+                <TableRow key={alert.id}>
+                  <TableCell>{getSeverityIcon(alert.severity)}</TableCell>
+                  <TableCell className="font-medium">
+                    {alert.hostname}
+                  </TableCell>
+                  <TableCell>
                     <Badge
                       variant="outline"
-                      className="bg-emerald-500/10 text-emerald-500"
+                      className={getSeverityStyle(alert.severity)}
                     >
-                      Ack'd
+                      {alert.severity}
                     </Badge>
-                  ) : (
-                    <Button variant="ghost" size="sm">
-                      Acknowledge
-                    </Button>
-                  )}
+                  </TableCell>
+                  <TableCell className="max-w-md truncate">
+                    {alert.message}
+                    <div className="text-xs text-muted-foreground">
+                      {alert.ruleName}
+                    </div>
+                  </TableCell>
+                  <TableCell className="text-muted-foreground text-xs">
+                    {formatDistanceToNow(new Date(alert.raisedAt), {
+                      addSuffix: true,
+                    })}
+                  </TableCell>
+                  <TableCell>
+                    {alert.resolvedAt ? (
+                      <Badge
+                        variant="outline"
+                        className="bg-slate-500/10 text-slate-500"
+                      >
+                        Resolved
+                      </Badge>
+                    ) : alert.acknowledgedAt ? (
+                      <Badge
+                        variant="outline"
+                        className="bg-emerald-500/10 text-emerald-500"
+                      >
+                        Ack'd
+                      </Badge>
+                    ) : (
+                      <Button
+                        variant="ghost"
+                        size="sm"
+                        onClick={() => handleAcknowledge(alert)}
+                      >
+                        Acknowledge
+                      </Button>
+                    )}
+                  </TableCell>
+                </TableRow>
+              ))
+            )}
+          </TableBody>
+        </Table>
+      </div>
+
+      <div className="flex justify-between items-center">
+        <div>
+          <h3 className="text-lg font-semibold tracking-tight">Alert Rules</h3>
+          <p className="text-muted-foreground text-sm">
+            Groups and tags are resolved each time the rules are checked.
+          </p>
+        </div>
+        <Button size="sm" onClick={openCreate}>
+          <Plus className="mr-2 h-4 w-4" /> Create Rule
+        </Button>
+      </div>
+
+      <Dialog open={isModalOpen} onOpenChange={setIsModalOpen}>
+        <DialogContent className="sm:max-w-[500px]">
+          <DialogHeader>
+            <DialogTitle>
+              {editing ? "Edit Alert Rule" : "Create Alert Rule"}
+            </DialogTitle>
+            <DialogDescription>
+              Raise an alert when a device passes a threshold.
+            </DialogDescription>
+          </DialogHeader>
+          <div className="space-y-4 py-4">
+            <div className="space-y-2">
+              <Label>Rule Name</Label>
+              <Input
+                value={formData.name}
+                onChange={(e) =>
+                  setFormData({ ...formData, name: e.target.value })
+                }
+                placeholder="e.g. High CPU on servers"
+              />
+            </div>
+            <div className="space-y-2">
+              <Label>Target Devices</Label>
+              <Select
+                value={formData.target}
+                onValueChange={(v) => setFormData({ ...formData, target: v })}
+              >
+                <SelectTrigger>
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="all">All Devices</SelectItem>
+                  <SelectItem value="group">Device Group</SelectItem>
+                  <SelectItem value="tag">Tag</SelectItem>
+                  <SelectItem value="custom">Specific Devices</SelectItem>
+                </SelectContent>
+              </Select>
+              {formData.target === "group" && (
+                <Select
+                  value={formData.groupId}
+                  onValueChange={(v) => setFormData({ ...formData, groupId: v })}
+                >
+                  <SelectTrigger>
+                    <SelectValue placeholder="Select a group..." />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {groups.map((g) => (
+                      <SelectItem key={g.id} value={g.id.toString()}>
+                        {g.name} ({g.memberCount})
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              )}
+              {formData.target === "tag" && (
+                <Select
+                  value={formData.tag}
+                  onValueChange={(v) => setFormData({ ...formData, tag: v })}
+                >
+                  <SelectTrigger>
+                    <SelectValue placeholder="Select a tag..." />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {tags.map((t) => (
+                      <SelectItem key={t} value={t}>
+                        {t}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              )}
+              {formData.target === "custom" && (
+                <Input
+                  value={formData.agentIds}
+                  onChange={(e) =>
+                    setFormData({ ...formData, agentIds: e.target.value })
+                  }
+                  placeholder="Agent IDs, comma separated (e.g. 3, 7)"
+                />
+              )}
+            </div>
+            <div className="grid grid-cols-2 gap-4">
+              <div className="space-y-2">
+                <Label>Metric</Label>
+                <Select
+                  value={formData.metric}
+                  onValueChange={(v) => setFormData({ ...formData, metric: v })}
+                >
+                  <SelectTrigger>
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    <SelectItem value="cpu">CPU usage (%)</SelectItem>
+                    <SelectItem value="memory">Memory usage (%)</SelectItem>
+                    <SelectItem value="offline">Offline (minutes)</SelectItem>
+                  </SelectContent>
+                </Select>
+              </div>
+              <div className="space-y-2">
+                <Label>Threshold</Label>
+                <Input
+                  type="number"
+                  min={1}
+                  value={formData.threshold}
+                  onChange={(e) =>
+                    setFormData({ ...formData, threshold: e.target.value })
+                  }
+                />
+              </div>
+            </div>
+            <div className="space-y-2">
+              <Label>Severity</Label>
+              <Select
+                value={formData.severity}
+                onValueChange={(v) => setFormData({ ...formData, severity: v })}
+              >
+                <SelectTrigger>
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="info">Info</SelectItem>
+                  <SelectItem value="warning">Warning</SelectItem>
+                  <SelectItem value="critical">Critical</SelectItem>
+                </SelectContent>
+              </Select>
+            </div>
+            <div className="flex items-center justify-between">
+              <Label>Enabled</Label>
+              <Switch
+                checked={formData.enabled}
+                onCheckedChange={(v) =>
+                  setFormData({ ...formData, enabled: v })
+                }
+              />
+            </div>
+          </div>
+          <DialogFooter>
+            <Button variant="outline" onClick={() => setIsModalOpen(false)}>
+              Cancel
+            </Button>
+            <Button onClick={handleSave}>
+              {editing ? "Save Rule" : "Create Rule"}
+            </Button>
+          </DialogFooter>
+        </DialogContent>
+      </Dialog>
+
+      <div className="border rounded-md">
+        <Table>
+          <TableHeader>
+            <TableRow>
+              <TableHead>Rule</TableHead>
+              <TableHead>Condition</TableHead>
+              <TableHead>Targets</TableHead>
+              <TableHead>Severity</TableHead>
+              <TableHead>Open</TableHead>
+              <TableHead className="text-right">Actions</TableHead>
+            </TableRow>
+          </TableHeader>
+          <TableBody>
+            {rules.length === 0 ? (
+              <TableRow>
+                <TableCell
+                  colSpan={6}
+                  className="h-24 text-center text-muted-foreground"
+                >
+                  No alert rules yet.
                 </TableCell>
               </TableRow>
-            ))}
+            ) : (
+              rules.map((rule) => (
+                <TableRow key={rule.id}>
+                  <TableCell className="font-medium">
+                    {rule.name}
+                    {!rule.enabled && (
+                      <Badge
+                        variant="outline"
+                        className="ml-2 bg-slate-500/10 text-slate-500"
+                      >
+                        Disabled
+                      </Badge>
+                    )}
+                  </TableCell>
+                  <TableCell className="text-xs">
+                    {describeCondition(rule)}
+                  </TableCell>
+                  <TableCell className="text-xs">
+                    {rule.targets.map(describeTarget).join(", ")}
+                    <div className="text-muted-foreground">
+                      {rule.targetCount} device
+                      {rule.targetCount === 1 ? "" : "s"}
+                    </div>
+                  </TableCell>
+                  <TableCell>
+                    <Badge
+                      variant="outline"
+                      className={getSeverityStyle(rule.severity)}
+                    >
+                      {rule.severity}
+                    </Badge>
+                  </TableCell>
+                  <TableCell>{rule.openAlerts}</TableCell>
+                  <TableCell className="text-right">
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      title="Edit"
+                      onClick={() => openEdit(rule)}
+                    >
+                      <Settings className="h-4 w-4" />
+                    </Button>
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      title="Delete"
+                      onClick={() => handleDelete(rule)}
+                    >
+                      <Trash2 className="h-4 w-4 text-red-500" />
+                    </Button>
+                  </TableCell>
+                </TableRow>
+              ))
+            )}
           </TableBody>
         </Table>
       </div>
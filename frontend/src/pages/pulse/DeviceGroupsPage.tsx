@@ -0,0 +1,391 @@
+import React, { useEffect, useState } from "react";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Textarea } from "@/components/ui/textarea";
+import { Checkbox } from "@/components/ui/checkbox";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Boxes, Filter, Pencil, Plus, Search, Trash2 } from "lucide-react";
+import { toast } from "sonner";
+
+interface DeviceGroup {
+  id: number;
+  name: string;
+  description: string;
+  filter: string;
+  dynamic: boolean;
+  agentIds: number[];
+  memberCount: number;
+}
+
+interface Agent {
+  id: number;
+  hostname: string;
+  os: string;
+  tags?: string[];
+}
+
+const emptyForm = {
+  name: "",
+  description: "",
+  kind: "static" as "static" | "dynamic",
+  filter: "",
+  agentIds: [] as number[],
+};
+
+const DeviceGroupsPage: React.FC = () => {
+  const [groups, setGroups] = useState<DeviceGroup[]>([]);
+  const [agents, setAgents] = useState<Agent[]>([]);
+  const [searchTerm, setSearchTerm] = useState("");
+  const [editing, setEditing] = useState<DeviceGroup | null>(null);
+  const [isModalOpen, setIsModalOpen] = useState(false);
+  const [formData, setFormData] = useState(emptyForm);
+  const [preview, setPreview] = useState<Agent[] | null>(null);
+
+  const bridge = () => (window as any).go?.bridge?.PulseBridge;
+
+  const fetchGroups = async () => {
+    if (!bridge()) return;
+    try {
+      const [g, a] = await Promise.all([
+        bridge().ListDeviceGroups(),
+        bridge().GetAgents(),
+      ]);
+      setGroups(g || []);
+      setAgents(a || []);
+    } catch (err) {
+      toast.error("Failed to load device groups");
+    }
+  };
+
+  useEffect(() => {
+    fetchGroups();
+  }, []);
+
+  const openCreate = () => {
+    setEditing(null);
+    setFormData(emptyForm);
+    setPreview(null);
+    setIsModalOpen(true);
+  };
+
+  const openEdit = (g: DeviceGroup) => {
+    setEditing(g);
+    setFormData({
+      name: g.name,
+      description: g.description,
+      kind: g.dynamic ? "dynamic" : "static",
+      filter: g.filter,
+      agentIds: g.agentIds,
+    });
+    setPreview(null);
+    setIsModalOpen(true);
+  };
+
+  const toggleAgent = (id: number) => {
+    setFormData((f) => ({
+      ...f,
+      agentIds: f.agentIds.includes(id)
+        ? f.agentIds.filter((a) => a !== id)
+        : [...f.agentIds, id],
+    }));
+  };
+
+  const handlePreview = async () => {
+    try {
+      const res = await bridge().PreviewFilter(formData.filter);
+      setPreview(res || []);
+    } catch (err) {
+      setPreview(null);
+      toast.error(`${err}`);
+    }
+  };
+
+  const handleSave = async () => {
+    const filter = formData.kind === "dynamic" ? formData.filter : "";
+    const agentIds = formData.kind === "static" ? formData.agentIds : [];
+    try {
+      if (editing) {
+        await bridge().UpdateDeviceGroup(
+          editing.id,
+          formData.name,
+          formData.description,
+          filter,
+          agentIds,
+        );
+        toast.success("Group updated");
+      } else {
+        await bridge().CreateDeviceGroup(
+          formData.name,
+          formData.description,
+          filter,
+          agentIds,
+        );
+        toast.success("Group created");
+      }
+      setIsModalOpen(false);
+      fetchGroups();
+    } catch (err) {
+      toast.error(`Failed to save group: ${err}`);
+    }
+  };
+
+  const handleDelete = async (g: DeviceGroup) => {
+    if (!confirm(`Delete the group ${g.name}?`)) return;
+    try {
+      await bridge().DeleteDeviceGroup(g.id);
+      toast.success("Group deleted");
+      fetchGroups();
+    } catch (err) {
+      toast.error(`${err}`);
+    }
+  };
+
+  const filteredGroups = groups.filter((g) =>
+    g.name.toLowerCase().includes(searchTerm.toLowerCase()),
+  );
+
+  return (
+    <div className="p-6 space-y-6 fade-in">
+      <div className="flex justify-between items-center">
+        <div>
+          <h2 className="text-2xl font-bold tracking-tight">Device Groups</h2>
+          <p className="text-muted-foreground text-sm">
+            Static and dynamic sets of devices for jobs to target
+          </p>
+        </div>
+        <Button onClick={openCreate}>
+          <Plus className="mr-2 h-4 w-4" /> New Group
+        </Button>
+      </div>
+
+      <div className="flex items-center space-x-2">
+        <Search className="h-4 w-4 text-muted-foreground" />
+        <Input
+          placeholder="Search groups..."
+          value={searchTerm}
+          onChange={(e) => setSearchTerm(e.target.value)}
+          className="max-w-sm"
+        />
+      </div>
+
+      <div className="border rounded-md">
+        <Table>
+          <TableHeader>
+            <TableRow>
+              <TableHead>Name</TableHead>
+              <TableHead>Type</TableHead>
+              <TableHead>Membership</TableHead>
+              <TableHead>Devices</TableHead>
+              <TableHead className="text-right">Actions</TableHead>
+            </TableRow>
+          </TableHeader>
+          <TableBody>
+            {filteredGroups.length === 0 ? (
+              <TableRow>
+                <TableCell
+                  colSpan={5}
+                  className="h-24 text-center text-muted-foreground"
+                >
+                  No device groups yet.
+                </TableCell>
+              </TableRow>
+            ) : (
+              filteredGroups.map((g) => (
+                <TableRow key={g.id}>
+                  <TableCell className="font-medium">
+                    <div className="flex items-center gap-2">
+                      <Boxes className="h-4 w-4 text-slate-500" />
+                      {g.name}
+                    </div>
+                    {g.description && (
+                      <p className="text-xs text-muted-foreground">
+                        {g.description}
+                      </p>
+                    )}
+                  </TableCell>
+                  <TableCell>
+                    <Badge variant={g.dynamic ? "default" : "secondary"}>
+                      {g.dynamic ? "Dynamic" : "Static"}
+                    </Badge>
+                  </TableCell>
+                  <TableCell className="font-mono text-xs text-muted-foreground">
+                    {g.dynamic
+                      ? g.filter
+                      : `${g.agentIds.length} listed device${g.agentIds.length === 1 ? "" : "s"}`}
+                  </TableCell>
+                  <TableCell>{g.memberCount}</TableCell>
+                  <TableCell className="text-right">
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      onClick={() => openEdit(g)}
+                    >
+                      <Pencil className="h-4 w-4" />
+                    </Button>
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      onClick={() => handleDelete(g)}
+                    >
+                      <Trash2 className="h-4 w-4 text-red-500" />
+                    </Button>
+                  </TableCell>
+                </TableRow>
+              ))
+            )}
+          </TableBody>
+        </Table>
+      </div>
+
+      <Dialog open={isModalOpen} onOpenChange={setIsModalOpen}>
+        <DialogContent className="sm:max-w-[560px]">
+          <DialogHeader>
+            <DialogTitle>
+              {editing ? `Edit ${editing.name}` : "New Device Group"}
+            </DialogTitle>
+            <DialogDescription>
+              Jobs targeting a group run on whoever is in it when they run.
+            </DialogDescription>
+          </DialogHeader>
+          <div className="space-y-4 py-2">
+            <div className="space-y-2">
+              <Label>Name</Label>
+              <Input
+                value={formData.name}
+                onChange={(e) =>
+                  setFormData({ ...formData, name: e.target.value })
+                }
+                placeholder="e.g. Store POS terminals"
+              />
+            </div>
+            <div className="space-y-2">
+              <Label>Description</Label>
+              <Input
+                value={formData.description}
+                onChange={(e) =>
+                  setFormData({ ...formData, description: e.target.value })
+                }
+              />
+            </div>
+            <div className="space-y-2">
+              <Label>Membership</Label>
+              <Select
+                value={formData.kind}
+                onValueChange={(v: any) => {
+                  setFormData({ ...formData, kind: v });
+                  setPreview(null);
+                }}
+              >
+                <SelectTrigger>
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="static">Static: chosen devices</SelectItem>
+                  <SelectItem value="dynamic">
+                    Dynamic: devices matching a filter
+                  </SelectItem>
+                </SelectContent>
+              </Select>
+            </div>
+            {formData.kind === "dynamic" ? (
+              <div className="space-y-2">
+                <Label>Filter</Label>
+                <Textarea
+                  className="font-mono text-xs"
+                  value={formData.filter}
+                  onChange={(e) => {
+                    setFormData({ ...formData, filter: e.target.value });
+                    setPreview(null);
+                  }}
+                  placeholder={'os = linux AND hostname ~ "pos-*" AND last_seen < 1h'}
+                />
+                <p className="text-xs text-muted-foreground">
+                  Fields: hostname, os, arch, ip, mac, version, status, tag,
+                  last_seen. Operators: = != ~ (glob) !~, and &lt; &gt; for
+                  last_seen. Combine with AND, OR, NOT and parentheses.
+                </p>
+                <Button variant="outline" size="sm" onClick={handlePreview}>
+                  <Filter className="mr-2 h-4 w-4" /> Preview
+                </Button>
+                {preview && (
+                  <div className="max-h-32 overflow-auto rounded-md border p-2 text-xs">
+                    {preview.length === 0
+                      ? "No devices match right now."
+                      : preview.map((a) => (
+                          <div key={a.id}>
+                            {a.hostname}{" "}
+                            <span className="text-muted-foreground">
+                              ({a.os})
+                            </span>
+                          </div>
+                        ))}
+                  </div>
+                )}
+              </div>
+            ) : (
+              <div className="space-y-2">
+                <Label>Devices</Label>
+                <div className="max-h-48 overflow-auto rounded-md border p-2 space-y-1">
+                  {agents.length === 0 && (
+                    <p className="text-xs text-muted-foreground">
+                      No devices enrolled.
+                    </p>
+                  )}
+                  {agents.map((a) => (
+                    <label
+                      key={a.id}
+                      className="flex items-center gap-2 text-sm cursor-pointer"
+                    >
+                      <Checkbox
+                        checked={formData.agentIds.includes(a.id)}
+                        onCheckedChange={() => toggleAgent(a.id)}
+                      />
+                      {a.hostname}
+                      <span className="text-xs text-muted-foreground uppercase">
+                        {a.os}
+                      </span>
+                    </label>
+                  ))}
+                </div>
+              </div>
+            )}
+          </div>
+          <DialogFooter>
+            <Button variant="outline" onClick={() => setIsModalOpen(false)}>
+              Cancel
+            </Button>
+            <Button onClick={handleSave}>
+              {editing ? "Save" : "Create Group"}
+            </Button>
+          </DialogFooter>
+        </DialogContent>
+      </Dialog>
+    </div>
+  );
+};
+
+export default DeviceGroupsPage;
@@ -11,76 +11,117 @@ import { Badge } from "@/components/ui/badge";
 import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import {
-  History,
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import {
   Search,
   Eye,
   CheckCircle,
   XCircle,
   Clock,
+  Ban,
+  Timer,
+  Square,
+  RefreshCw,
 } from "lucide-react";
 import { formatDistanceToNow } from "date-fns";
+import { toast } from "sonner";
 
 interface Execution {
   id: number;
-  scriptName: string;
-  device: string;
-  status: "success" | "failed" | "running";
-  exitCode: number | null;
-  startTime: string;
-  duration: string;
+  jobId: number;
+  jobName: string;
+  agentId: number;
+  hostname: string;
+  status: "pending" | "running" | "success" | "failed" | "cancelled" | "timeout";
+  exitCode?: number;
+  output: string;
+  outputTruncated: boolean;
+  createdAt: string;
+  startedAt?: string;
+  completedAt?: string;
 }
 
+// How often the log is refreshed while something is still running.
+const POLL_INTERVAL = 3000;
+
+const isActive = (e: Execution) =>
+  e.status === "pending" || e.status === "running";
+
+const formatDuration = (e: Execution) => {
+  if (!e.startedAt) return "-";
+  const end = e.completedAt ? new Date(e.completedAt) : new Date();
+  const ms = end.getTime() - new Date(e.startedAt).getTime();
+  if (ms < 60000) return `${(ms / 1000).toFixed(1)}s`;
+  const mins = Math.floor(ms / 60000);
+  const secs = Math.floor((ms % 60000) / 1000);
+  return `${mins}m ${secs}s${e.completedAt ? "" : "+"}`;
+};
+
 const ExecutionHistoryPage: React.FC = () => {
   const [executions, setExecutions] = useState<Execution[]>([]);
   const [searchTerm, setSearchTerm] = useState("");
+  const [loading, setLoading] = useState(false);
+  const [viewing, setViewing] = useState<Execution | null>(null);
+
+  const fetchExecutions = async () => {
+    const w = window as any;
+    if (!(w.go && w.go.bridge && w.go.bridge.PulseBridge)) return;
+    setLoading(true);
+    try {
+      const res = await w.go.bridge.PulseBridge.ListExecutions(0, 200);
+      setExecutions(res || []);
+    } catch (err) {
+      toast.error("Failed to load execution history");
+    } finally {
+      setLoading(false);
+    }
+  };
 
   useEffect(() => {
-    setExecutions([
-      {
-        id: 1,
-        scriptName: "Get-SystemInfo.ps1",
-        device: "SRV-DC01",
-        status: "success",
-        exitCode: 0,
-        startTime: new Date().toISOString(),
-        duration: "2.4s",
-      },
-      {
-        id: 2,
-        scriptName: "Cleanup-TempFiles.ps1",
-        device: "WKST-HR-04",
-        status: "success",
-        exitCode: 0,
-        startTime: new Date(Date.now() - 3600000).toISOString(),
-        duration: "5.1s",
-      },
-      {
-        id: 3,
-        scriptName: "Install-Updates.ps1",
-        device: "DB-PROD-01",
-        status: "failed",
-        exitCode: 1,
-        startTime: new Date(Date.now() - 7200000).toISOString(),
-        duration: "45.2s",
-      },
-      {
-        id: 4,
-        scriptName: "Check-ServiceHealth.sh",
-        device: "SRV-FILE01",
-        status: "running",
-        exitCode: null,
-        startTime: new Date(Date.now() - 60000).toISOString(),
-        duration: "1m+",
-      },
-    ]);
+    fetchExecutions();
   }, []);
 
+  // Keep polling while runs are in flight so output and status stay live.
+  const anyActive = executions.some(isActive);
+  useEffect(() => {
+    if (!anyActive) return;
+    const timer = setInterval(fetchExecutions, POLL_INTERVAL);
+    return () => clearInterval(timer);
+  }, [anyActive]);
+
+  // Follow the open output as it grows.
+  useEffect(() => {
+    if (!viewing) return;
+    const latest = executions.find((e) => e.id === viewing.id);
+    if (latest && latest !== viewing) setViewing(latest);
+  }, [executions]);
+
+  const handleCancel = async (exec: Execution) => {
+    try {
+      const w = window as any;
+      await w.go.bridge.PulseBridge.CancelExecution(exec.id);
+      toast.success(`Cancelling ${exec.jobName} on ${exec.hostname}`);
+      fetchExecutions();
+    } catch (err) {
+      toast.error(`Failed to cancel: ${err}`);
+    }
+  };
+
   const getStatusStyle = (status: string) => {
     switch (status) {
       case "success":
         return "bg-emerald-500/10 text-emerald-500 border-emerald-500/20";
       case "failed":
         return "bg-red-500/10 text-red-500 border-red-500/20";
+      case "timeout":
+        return "bg-orange-500/10 text-orange-500 border-orange-500/20";
+      case "cancelled":
+        return "bg-zinc-500/10 text-zinc-400 border-zinc-500/20";
       default:
         return "bg-blue-500/10 text-blue-500 border-blue-500/20";
     }
@@ -92,6 +133,10 @@ const ExecutionHistoryPage: React.FC = () => {
         return <CheckCircle className="h-4 w-4 text-emerald-500" />;
       case "failed":
         return <XCircle className="h-4 w-4 text-red-500" />;
+      case "timeout":
+        return <Timer className="h-4 w-4 text-orange-500" />;
+      case "cancelled":
+        return <Ban className="h-4 w-4 text-zinc-400" />;
       default:
         return <Clock className="h-4 w-4 text-blue-500 animate-pulse" />;
     }
@@ -99,8 +144,8 @@ const ExecutionHistoryPage: React.FC = () => {
 
   const filteredExecutions = executions.filter(
     (e) =>
-      e.scriptName.toLowerCase().includes(searchTerm.toLowerCase()) ||
-      e.device.toLowerCase().includes(searchTerm.toLowerCase()),
+      e.jobName.toLowerCase().includes(searchTerm.toLowerCase()) ||
+      e.hostname.toLowerCase().includes(searchTerm.toLowerCase()),
   );
 
   return (
@@ -112,12 +157,18 @@ const ExecutionHistoryPage: React.FC = () => {
             Script execution history and results
           </p>
         </div>
+        <Button variant="outline" size="sm" onClick={fetchExecutions}>
+          <RefreshCw
+            className={`mr-2 h-4 w-4 ${loading ? "animate-spin" : ""}`}
+          />
+          Refresh
+        </Button>
       </div>
 
       <div className="flex items-center space-x-2">
         <Search className="h-4 w-4 text-muted-foreground" />
         <Input
-          placeholder="Search by script or device..."
+          placeholder="Search by job or device..."
           value={searchTerm}
           onChange={(e) => setSearchTerm(e.target.value)}
           className="max-w-sm"
@@ -129,7 +180,7 @@ const ExecutionHistoryPage: React.FC = () => {
           <TableHeader>
             <TableRow>
               <TableHead className="w-10"></TableHead>
-              <TableHead>Script</TableHead>
+              <TableHead>Job</TableHead>
               <TableHead>Device</TableHead>
               <TableHead>Status</TableHead>
               <TableHead>Exit Code</TableHead>
@@ -139,42 +190,94 @@ const ExecutionHistoryPage: React.FC = () => {
             </TableRow>
           </TableHeader>
           <TableBody>
-            {filteredExecutions.map((exec) => (
-              <TableRow key={exec.id}>
-                <TableCell>{getStatusIcon(exec.status)}</TableCell>
-                <TableCell className="font-medium font-mono text-sm">
-                  {exec.scriptName}
-                </TableCell>
-                <TableCell>{exec.device}</TableCell>
-                <TableCell>
-                  <Badge
-                    variant="outline"
-                    className={getStatusStyle(exec.status)}
-                  >
-                    {exec.status}
-                  </Badge>
-                </TableCell>
-                <TableCell className="font-mono text-xs">
-                  {exec.exitCode !== null ? exec.exitCode : "-"}
-                </TableCell>
-                <TableCell className="text-muted-foreground text-xs">
-                  {exec.duration}
-                </TableCell>
-                <TableCell className="text-muted-foreground text-xs">
-                  {formatDistanceToNow(new Date(exec.startTime), {
-                    addSuffix: true,
-                  })}
-                </TableCell>
-                <TableCell className="text-right">
-                  <Button variant="ghost" size="sm">
-                    <Eye className="mr-2 h-4 w-4" /> View Output
-                  </Button>
+            {filteredExecutions.length === 0 ? (
+              <TableRow>
+                <TableCell
+                  colSpan={8}
+                  className="h-24 text-center text-muted-foreground"
+                >
+                  No executions yet.
                 </TableCell>
               </TableRow>
-            ))}
+            ) : (
+              filteredExecutions.map((exec) => (
+                <TableRow key={exec.id}>
+                  <TableCell>{getStatusIcon(exec.status)}</TableCell>
+                  <TableCell className="font-medium font-mono text-sm">
+                    {exec.jobName}
+                  </TableCell>
+                  <TableCell>{exec.hostname}</TableCell>
+                  <TableCell>
+                    <Badge
+                      variant="outline"
+                      className={getStatusStyle(exec.status)}
+                    >
+                      {exec.status}
+                    </Badge>
+                  </TableCell>
+                  <TableCell className="font-mono text-xs">
+                    {exec.exitCode !== undefined && exec.exitCode !== null
+                      ? exec.exitCode
+                      : "-"}
+                  </TableCell>
+                  <TableCell className="text-muted-foreground text-xs">
+                    {formatDuration(exec)}
+                  </TableCell>
+                  <TableCell className="text-muted-foreground text-xs">
+                    {formatDistanceToNow(
+                      new Date(exec.startedAt || exec.createdAt),
+                      { addSuffix: true },
+                    )}
+                  </TableCell>
+                  <TableCell className="text-right space-x-1">
+                    {isActive(exec) && (
+                      <Button
+                        variant="ghost"
+                        size="sm"
+                        onClick={() => handleCancel(exec)}
+                      >
+                        <Square className="mr-2 h-4 w-4 text-red-500" /> Cancel
+                      </Button>
+                    )}
+                    <Button
+                      variant="ghost"
+                      size="sm"
+                      onClick={() => setViewing(exec)}
+                    >
+                      <Eye className="mr-2 h-4 w-4" /> View Output
+                    </Button>
+                  </TableCell>
+                </TableRow>
+              ))
+            )}
           </TableBody>
         </Table>
       </div>
+
+      <Dialog open={!!viewing} onOpenChange={(o) => !o && setViewing(null)}>
+        <DialogContent className="sm:max-w-[800px]">
+          <DialogHeader>
+            <DialogTitle>
+              {viewing?.jobName} on {viewing?.hostname}
+            </DialogTitle>
+            <DialogDescription>
+              {viewing?.status}
+              {viewing?.exitCode !== undefined && viewing?.exitCode !== null
+                ? ` · exit code ${viewing?.exitCode}`
+                : ""}
+              {viewing?.outputTruncated
+                ? " · output truncated to the first 1 MB"
+                : ""}
+            </DialogDescription>
+          </DialogHeader>
+          <pre className="max-h-[60vh] overflow-auto rounded-md bg-black p-4 font-mono text-xs text-zinc-100 whitespace-pre-wrap">
+            {viewing?.output ||
+              (viewing && isActive(viewing)
+                ? "Waiting for output..."
+                : "No output.")}
+          </pre>
+        </DialogContent>
+      </Dialog>
     </div>
   );
 };
@@ -39,26 +39,46 @@ interface Job {
   script_id: number;
   last_run: string;
   next_run: string;
+  parameters: Record<string, string> | null;
+  timeout: number;
 }
 
 interface Script {
   id: number;
   name: string;
+  parameters: string[] | null;
+}
+
+interface DeviceGroup {
+  id: number;
+  name: string;
+  memberCount: number;
 }
 
 const JobScheduler: React.FC = () => {
   const [jobs, setJobs] = useState<Job[]>([]);
   const [scripts, setScripts] = useState<Script[]>([]);
+  const [groups, setGroups] = useState<DeviceGroup[]>([]);
+  const [tags, setTags] = useState<string[]>([]);
   const [loading, setLoading] = useState(false);
   const [isModalOpen, setIsModalOpen] = useState(false);
 
   const [formData, setFormData] = useState({
     name: "",
     scriptId: "",
-    target: "all", // MVP: all or specific (comma separated)
+    target: "all", // all, group, tag, or custom for the agent IDs in agentIds
+    groupId: "",
+    tag: "",
+    agentIds: "",
     schedule: "", // Cron expression
+    timeout: "600", // Seconds
+    parameters: {} as Record<string, string>,
   });
 
+  const selectedScript = scripts.find(
+    (s) => s.id.toString() === formData.scriptId,
+  );
+
   const fetchJobs = async () => {
     setLoading(true);
     try {
@@ -70,6 +90,12 @@ const JobScheduler: React.FC = () => {
         // Also fetch scripts for the dropdown
         const scriptRes = await w.go.bridge.PulseBridge.ListScripts();
         setScripts(scriptRes || []);
+
+        // And the groups and tags jobs can target
+        const groupRes = await w.go.bridge.PulseBridge.ListDeviceGroups();
+        setGroups(groupRes || []);
+        const tagRes = await w.go.bridge.PulseBridge.ListAgentTags();
+        setTags(tagRes || []);
       } else {
         // Mock data
         setJobs([
@@ -82,11 +108,13 @@ const JobScheduler: React.FC = () => {
             script_id: 2,
             last_run: "Yesterday",
             next_run: "Today",
+            parameters: null,
+            timeout: 600,
           },
         ]);
         setScripts([
-          { id: 1, name: "Get-SystemInfo" },
-          { id: 2, name: "Cleanup-Temp" },
+          { id: 1, name: "Get-SystemInfo", parameters: null },
+          { id: 2, name: "Cleanup-Temp", parameters: ["path"] },
         ]);
       }
     } catch (err) {
@@ -103,10 +131,28 @@ const JobScheduler: React.FC = () => {
   const handleSave = async () => {
     try {
       const w = window as any;
-      const targets =
-        formData.target === "all"
-          ? ["all"]
-          : formData.target.split(",").map((s) => s.trim());
+      let targets: string[];
+      switch (formData.target) {
+        case "all":
+          targets = ["all"];
+          break;
+        case "group":
+          targets = [`group:${formData.groupId}`];
+          break;
+        case "tag":
+          targets = [`tag:${formData.tag}`];
+          break;
+        default:
+          targets = formData.agentIds
+            .split(",")
+            .map((s) => s.trim())
+            .filter(Boolean)
+            .map((id) => `agent:${id}`);
+      }
+      const parameters: Record<string, string> = {};
+      for (const name of selectedScript?.parameters || []) {
+        parameters[name] = formData.parameters[name] || "";
+      }
 
       if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
         await w.go.bridge.PulseBridge.CreateJob(
@@ -114,6 +160,8 @@ const JobScheduler: React.FC = () => {
           parseInt(formData.scriptId),
           targets,
           formData.schedule,
+          parameters,
+          parseInt(formData.timeout) || 0,
         );
         toast.success("Job scheduled");
         fetchJobs();
@@ -123,11 +171,41 @@ const JobScheduler: React.FC = () => {
         setIsModalOpen(false);
       }
     } catch (err) {
-      toast.error("Failed to create job");
+      toast.error(`Failed to create job: ${err}`);
       console.error(err);
     }
   };
 
+  const describeTarget = (t: string) => {
+    if (t === "all") return "All devices";
+    const [kind, value] = t.includes(":") ? t.split(/:(.*)/) : ["agent", t];
+    switch (kind) {
+      case "group":
+        return `Group: ${groups.find((g) => g.id.toString() === value)?.name || value}`;
+      case "tag":
+        return `Tag: ${value}`;
+      default:
+        return `Device #${value}`;
+    }
+  };
+
+  const handleRunNow = async (job: Job) => {
+    try {
+      const w = window as any;
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        const ids: number[] = await w.go.bridge.PulseBridge.RunJobNow(job.id);
+        toast.success(
+          `${job.name} started on ${ids.length} device${ids.length === 1 ? "" : "s"}`,
+        );
+        fetchJobs();
+      } else {
+        toast.success(`Mock: ${job.name} started`);
+      }
+    } catch (err) {
+      toast.error(`Failed to start job: ${err}`);
+    }
+  };
+
   return (
     <div className="p-6 space-y-6 fade-in h-4/5">
       <div className="flex justify-between items-center">
@@ -167,7 +245,7 @@ const JobScheduler: React.FC = () => {
                 <Select
                   value={formData.scriptId}
                   onValueChange={(v) =>
-                    setFormData({ ...formData, scriptId: v })
+                    setFormData({ ...formData, scriptId: v, parameters: {} })
                   }
                 >
                   <SelectTrigger>
@@ -182,6 +260,24 @@ const JobScheduler: React.FC = () => {
                   </SelectContent>
                 </Select>
               </div>
+              {(selectedScript?.parameters || []).map((name) => (
+                <div key={name} className="space-y-2">
+                  <Label className="font-mono text-xs">{name}</Label>
+                  <Input
+                    value={formData.parameters[name] || ""}
+                    onChange={(e) =>
+                      setFormData({
+                        ...formData,
+                        parameters: {
+                          ...formData.parameters,
+                          [name]: e.target.value,
+                        },
+                      })
+                    }
+                    placeholder={`Value for {{${name}}}`}
+                  />
+                </div>
+              ))}
               <div className="space-y-2">
                 <Label>Target Devices</Label>
                 <Select
@@ -193,9 +289,56 @@ const JobScheduler: React.FC = () => {
                   </SelectTrigger>
                   <SelectContent>
                     <SelectItem value="all">All Devices</SelectItem>
-                    {/* Feature: Add groups later */}
+                    <SelectItem value="group">Device Group</SelectItem>
+                    <SelectItem value="tag">Tag</SelectItem>
+                    <SelectItem value="custom">Specific Devices</SelectItem>
                   </SelectContent>
                 </Select>
+                {formData.target === "group" && (
+                  <Select
+                    value={formData.groupId}
+                    onValueChange={(v) =>
+                      setFormData({ ...formData, groupId: v })
+                    }
+                  >
+                    <SelectTrigger>
+                      <SelectValue placeholder="Select a group..." />
+                    </SelectTrigger>
+                    <SelectContent>
+                      {groups.map((g) => (
+                        <SelectItem key={g.id} value={g.id.toString()}>
+                          {g.name} ({g.memberCount})
+                        </SelectItem>
+                      ))}
+                    </SelectContent>
+                  </Select>
+                )}
+                {formData.target === "tag" && (
+                  <Select
+                    value={formData.tag}
+                    onValueChange={(v) => setFormData({ ...formData, tag: v })}
+                  >
+                    <SelectTrigger>
+                      <SelectValue placeholder="Select a tag..." />
+                    </SelectTrigger>
+                    <SelectContent>
+                      {tags.map((t) => (
+                        <SelectItem key={t} value={t}>
+                          {t}
+                        </SelectItem>
+                      ))}
+                    </SelectContent>
+                  </Select>
+                )}
+                {formData.target === "custom" && (
+                  <Input
+                    value={formData.agentIds}
+                    onChange={(e) =>
+                      setFormData({ ...formData, agentIds: e.target.value })
+                    }
+                    placeholder="Agent IDs, comma separated (e.g. 3, 7)"
+                  />
+                )}
               </div>
               <div className="space-y-2">
                 <Label>Schedule (Cron)</Label>
@@ -210,6 +353,17 @@ const JobScheduler: React.FC = () => {
                   Type "manual" for manual execution only.
                 </p>
               </div>
+              <div className="space-y-2">
+                <Label>Timeout (seconds)</Label>
+                <Input
+                  type="number"
+                  min={1}
+                  value={formData.timeout}
+                  onChange={(e) =>
+                    setFormData({ ...formData, timeout: e.target.value })
+                  }
+                />
+              </div>
             </div>
             <DialogFooter>
               <Button variant="outline" onClick={() => setIsModalOpen(false)}>
@@ -253,16 +407,23 @@ const JobScheduler: React.FC = () => {
                     </Badge>
                   </TableCell>
                   <TableCell className="font-mono text-xs text-muted-foreground">
-                    {j.schedule || "Manual"}
+                    {j.schedule && j.schedule !== "manual"
+                      ? j.schedule
+                      : "Manual"}
                   </TableCell>
                   <TableCell className="text-xs">
-                    {j.targets.join(", ")}
+                    {j.targets.map(describeTarget).join(", ")}
                   </TableCell>
                   <TableCell className="text-xs text-muted-foreground">
                     {j.last_run ? j.last_run : "Never"}
                   </TableCell>
                   <TableCell className="text-right">
-                    <Button variant="ghost" size="icon">
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      title="Run now"
+                      onClick={() => handleRunNow(j)}
+                    >
                       <Play className="h-4 w-4 text-green-500" />
                     </Button>
                   </TableCell>
@@ -10,68 +10,262 @@ import {
 import { Badge } from "@/components/ui/badge";
 import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
-import { ScrollText, Plus, Search, Settings, Shield } from "lucide-react";
+import {
+  ScrollText,
+  Plus,
+  Search,
+  Settings,
+  Play,
+  Trash2,
+} from "lucide-react";
+import { toast } from "sonner";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Label } from "@/components/ui/label";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Switch } from "@/components/ui/switch";
+import { formatDistanceToNow } from "date-fns";
 
 interface Policy {
   id: number;
   name: string;
-  type: "patch" | "security" | "software" | "monitoring";
-  targets: number;
+  description: string;
+  targets: string[];
+  schedule: string;
+  severities: string[] | null;
+  reboot: boolean;
   enabled: boolean;
-  lastModified: string;
+  targetCount: number;
+  nextRun?: string;
+  lastRun?: string;
+  updatedAt: string;
+}
+
+interface DeviceGroup {
+  id: number;
+  name: string;
+  memberCount: number;
 }
 
+const emptyForm = {
+  name: "",
+  description: "",
+  target: "all", // all, group, tag, or custom for the agent IDs in agentIds
+  groupId: "",
+  tag: "",
+  agentIds: "",
+  schedule: "",
+  severities: [] as string[],
+  reboot: false,
+  enabled: true,
+};
+
+// Turns a policy's targets back into the form's target picker.
+const targetForm = (targets: string[]) => {
+  const [first] = targets;
+  if (targets.length === 1 && first === "all") return { target: "all" };
+  if (targets.length === 1 && first.startsWith("group:"))
+    return { target: "group", groupId: first.slice(6) };
+  if (targets.length === 1 && first.startsWith("tag:"))
+    return { target: "tag", tag: first.slice(4) };
+  return {
+    target: "custom",
+    agentIds: targets.map((t) => t.replace(/^agent:/, "")).join(", "),
+  };
+};
+
 const PoliciesPage: React.FC = () => {
   const [policies, setPolicies] = useState<Policy[]>([]);
+  const [groups, setGroups] = useState<DeviceGroup[]>([]);
+  const [tags, setTags] = useState<string[]>([]);
+  const [severities, setSeverities] = useState<string[]>([
+    "Critical",
+    "Important",
+    "Moderate",
+    "Low",
+  ]);
   const [searchTerm, setSearchTerm] = useState("");
+  const [isModalOpen, setIsModalOpen] = useState(false);
+  const [editing, setEditing] = useState<Policy | null>(null);
+  const [formData, setFormData] = useState(emptyForm);
+
+  const fetchPolicies = async () => {
+    try {
+      const w = window as any;
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        const res = await w.go.bridge.PulseBridge.ListPatchPolicies();
+        setPolicies(res || []);
+
+        // And the groups and tags policies can target
+        const groupRes = await w.go.bridge.PulseBridge.ListDeviceGroups();
+        setGroups(groupRes || []);
+        const tagRes = await w.go.bridge.PulseBridge.ListAgentTags();
+        setTags(tagRes || []);
+        const sevRes = await w.go.bridge.PulseBridge.PatchSeverities();
+        if (sevRes) setSeverities(sevRes);
+      } else {
+        // Mock data
+        setPolicies([
+          {
+            id: 1,
+            name: "Auto-Patch Workstations",
+            description: "Security fixes every Sunday night",
+            targets: ["tag:workstation"],
+            schedule: "0 2 * * 0",
+            severities: ["Critical", "Important"],
+            reboot: true,
+            enabled: true,
+            targetCount: 120,
+            updatedAt: new Date().toISOString(),
+          },
+        ]);
+      }
+    } catch (err) {
+      toast.error("Failed to fetch policies");
+    }
+  };
 
   useEffect(() => {
-    setPolicies([
-      {
-        id: 1,
-        name: "Windows Security Baseline",
-        type: "security",
-        targets: 45,
-        enabled: true,
-        lastModified: "2024-01-15",
-      },
-      {
-        id: 2,
-        name: "Auto-Patch Workstations",
-        type: "patch",
-        targets: 120,
-        enabled: true,
-        lastModified: "2024-01-20",
-      },
-      {
-        id: 3,
-        name: "Block Unauthorized Software",
-        type: "software",
-        targets: 200,
-        enabled: false,
-        lastModified: "2024-01-10",
-      },
-      {
-        id: 4,
-        name: "CPU Alert Threshold 90%",
-        type: "monitoring",
-        targets: 50,
-        enabled: true,
-        lastModified: "2024-01-25",
-      },
-    ]);
+    fetchPolicies();
   }, []);
 
-  const getTypeStyle = (type: string) => {
-    switch (type) {
-      case "security":
-        return "bg-red-500/10 text-red-500 border-red-500/20";
-      case "patch":
-        return "bg-blue-500/10 text-blue-500 border-blue-500/20";
-      case "software":
-        return "bg-purple-500/10 text-purple-500 border-purple-500/20";
+  const openCreate = () => {
+    setEditing(null);
+    setFormData(emptyForm);
+    setIsModalOpen(true);
+  };
+
+  const openEdit = (policy: Policy) => {
+    setEditing(policy);
+    setFormData({
+      ...emptyForm,
+      ...targetForm(policy.targets),
+      name: policy.name,
+      description: policy.description,
+      schedule: policy.schedule,
+      severities: policy.severities || [],
+      reboot: policy.reboot,
+      enabled: policy.enabled,
+    });
+    setIsModalOpen(true);
+  };
+
+  const toggleSeverity = (s: string) => {
+    setFormData({
+      ...formData,
+      severities: formData.severities.includes(s)
+        ? formData.severities.filter((x) => x !== s)
+        : [...formData.severities, s],
+    });
+  };
+
+  const handleSave = async () => {
+    try {
+      const w = window as any;
+      let targets: string[];
+      switch (formData.target) {
+        case "all":
+          targets = ["all"];
+          break;
+        case "group":
+          targets = [`group:${formData.groupId}`];
+          break;
+        case "tag":
+          targets = [`tag:${formData.tag}`];
+          break;
+        default:
+          targets = formData.agentIds
+            .split(",")
+            .map((s) => s.trim())
+            .filter(Boolean)
+            .map((id) => `agent:${id}`);
+      }
+      const args = [
+        formData.name,
+        formData.description,
+        targets,
+        formData.schedule,
+        formData.severities,
+        formData.reboot,
+        formData.enabled,
+      ];
+
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        if (editing) {
+          await w.go.bridge.PulseBridge.UpdatePatchPolicy(editing.id, ...args);
+          toast.success("Policy updated");
+        } else {
+          await w.go.bridge.PulseBridge.CreatePatchPolicy(...args);
+          toast.success("Policy created");
+        }
+        fetchPolicies();
+        setIsModalOpen(false);
+      } else {
+        toast.success("Mock: Policy saved");
+        setIsModalOpen(false);
+      }
+    } catch (err) {
+      toast.error(`Failed to save policy: ${err}`);
+      console.error(err);
+    }
+  };
+
+  const handleDelete = async (policy: Policy) => {
+    if (!confirm(`Delete policy "${policy.name}"?`)) return;
+    try {
+      const w = window as any;
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        await w.go.bridge.PulseBridge.DeletePatchPolicy(policy.id);
+        toast.success("Policy deleted");
+        fetchPolicies();
+      }
+    } catch (err) {
+      toast.error(`Failed to delete policy: ${err}`);
+    }
+  };
+
+  const handleRunNow = async (policy: Policy) => {
+    try {
+      const w = window as any;
+      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
+        const ids: number[] = await w.go.bridge.PulseBridge.RunPatchPolicyNow(
+          policy.id,
+        );
+        toast.success(
+          `${policy.name} started on ${ids.length} device${ids.length === 1 ? "" : "s"}`,
+        );
+        fetchPolicies();
+      } else {
+        toast.success(`Mock: ${policy.name} started`);
+      }
+    } catch (err) {
+      toast.error(`Failed to start policy: ${err}`);
+    }
+  };
+
+  const describeTarget = (t: string) => {
+    if (t === "all") return "All devices";
+    const [kind, value] = t.includes(":") ? t.split(/:(.*)/) : ["agent", t];
+    switch (kind) {
+      case "group":
+        return `Group: ${groups.find((g) => g.id.toString() === value)?.name || value}`;
+      case "tag":
+        return `Tag: ${value}`;
       default:
-        return "bg-amber-500/10 text-amber-500 border-amber-500/20";
+        return `Device #${value}`;
     }
   };
 
@@ -85,14 +279,163 @@ const PoliciesPage: React.FC = () => {
         <div>
           <h2 className="text-2xl font-bold tracking-tight">Policies</h2>
           <p className="text-muted-foreground text-sm">
-            Configuration policies for endpoints
+            Patch policies for endpoints
           </p>
         </div>
-        <Button>
+        <Button onClick={openCreate}>
           <Plus className="mr-2 h-4 w-4" /> Create Policy
         </Button>
       </div>
 
+      <Dialog open={isModalOpen} onOpenChange={setIsModalOpen}>
+        <DialogContent className="sm:max-w-[500px]">
+          <DialogHeader>
+            <DialogTitle>
+              {editing ? "Edit Patch Policy" : "Create Patch Policy"}
+            </DialogTitle>
+            <DialogDescription>
+              Install pending patches on a schedule. Groups and tags are
+              resolved each time the policy runs.
+            </DialogDescription>
+          </DialogHeader>
+          <div className="space-y-4 py-4">
+            <div className="space-y-2">
+              <Label>Policy Name</Label>
+              <Input
+                value={formData.name}
+                onChange={(e) =>
+                  setFormData({ ...formData, name: e.target.value })
+                }
+                placeholder="e.g. Auto-Patch Workstations"
+              />
+            </div>
+            <div className="space-y-2">
+              <Label>Description</Label>
+              <Input
+                value={formData.description}
+                onChange={(e) =>
+                  setFormData({ ...formData, description: e.target.value })
+                }
+              />
+            </div>
+            <div className="space-y-2">
+              <Label>Target Devices</Label>
+              <Select
+                value={formData.target}
+                onValueChange={(v) => setFormData({ ...formData, target: v })}
+              >
+                <SelectTrigger>
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="all">All Devices</SelectItem>
+                  <SelectItem value="group">Device Group</SelectItem>
+                  <SelectItem value="tag">Tag</SelectItem>
+                  <SelectItem value="custom">Specific Devices</SelectItem>
+                </SelectContent>
+              </Select>
+              {formData.target === "group" && (
+                <Select
+                  value={formData.groupId}
+                  onValueChange={(v) => setFormData({ ...formData, groupId: v })}
+                >
+                  <SelectTrigger>
+                    <SelectValue placeholder="Select a group..." />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {groups.map((g) => (
+                      <SelectItem key={g.id} value={g.id.toString()}>
+                        {g.name} ({g.memberCount})
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              )}
+              {formData.target === "tag" && (
+                <Select
+                  value={formData.tag}
+                  onValueChange={(v) => setFormData({ ...formData, tag: v })}
+                >
+                  <SelectTrigger>
+                    <SelectValue placeholder="Select a tag..." />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {tags.map((t) => (
+                      <SelectItem key={t} value={t}>
+                        {t}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              )}
+              {formData.target === "custom" && (
+                <Input
+                  value={formData.agentIds}
+                  onChange={(e) =>
+                    setFormData({ ...formData, agentIds: e.target.value })
+                  }
+                  placeholder="Agent IDs, comma separated (e.g. 3, 7)"
+                />
+              )}
+            </div>
+            <div className="space-y-2">
+              <Label>Severities</Label>
+              <div className="flex flex-wrap gap-4">
+                {severities.map((s) => (
+                  <label key={s} className="flex items-center gap-2 text-sm">
+                    <Checkbox
+                      checked={formData.severities.includes(s)}
+                      onCheckedChange={() => toggleSeverity(s)}
+                    />
+                    {s}
+                  </label>
+                ))}
+              </div>
+              <p className="text-xs text-muted-foreground">
+                Leave all unchecked to install every pending patch.
+              </p>
+            </div>
+            <div className="space-y-2">
+              <Label>Schedule (Cron)</Label>
+              <Input
+                value={formData.schedule}
+                onChange={(e) =>
+                  setFormData({ ...formData, schedule: e.target.value })
+                }
+                placeholder="0 2 * * 0 (Sundays at 2am)"
+              />
+              <p className="text-xs text-muted-foreground">
+                Type "manual" to run it only from here.
+              </p>
+            </div>
+            <div className="flex items-center justify-between">
+              <Label>Restart after installing</Label>
+              <Switch
+                checked={formData.reboot}
+                onCheckedChange={(v) => setFormData({ ...formData, reboot: v })}
+              />
+            </div>
+            <div className="flex items-center justify-between">
+              <Label>Enabled</Label>
+              <Switch
+                checked={formData.enabled}
+                onCheckedChange={(v) =>
+                  setFormData({ ...formData, enabled: v })
+                }
+              />
+            </div>
+          </div>
+          <DialogFooter>
+            <Button variant="outline" onClick={() => setIsModalOpen(false)}>
+              Cancel
+            </Button>
+            <Button onClick={handleSave}>
+              {editing ? "Save Policy" : "Create Policy"}
+            </Button>
+          </DialogFooter>
+        </DialogContent>
+      </Dialog>
+
       <div className="flex items-center space-x-2">
         <Search className="h-4 w-4 text-muted-foreground" />
         <Input
@@ -108,51 +451,113 @@ const PoliciesPage: React.FC = () => {
           <TableHeader>
             <TableRow>
               <TableHead>Policy Name</TableHead>
-              <TableHead>Type</TableHead>
+              <TableHead>Patches</TableHead>
               <TableHead>Targets</TableHead>
+              <TableHead>Schedule</TableHead>
               <TableHead>Status</TableHead>
-              <TableHead>Last Modified</TableHead>
+              <TableHead>Last Run</TableHead>
               <TableHead className="text-right">Actions</TableHead>
             </TableRow>
           </TableHeader>
           <TableBody>
-            {filteredPolicies.map((policy) => (
-              <TableRow key={policy.id}>
-                <TableCell className="font-medium flex items-center gap-2">
-                  <ScrollText className="h-4 w-4 text-muted-foreground" />
-                  {policy.name}
-                </TableCell>
-                <TableCell>
-                  <Badge
-                    variant="outline"
-                    className={getTypeStyle(policy.type)}
-                  >
-                    {policy.type}
-                  </Badge>
-                </TableCell>
-                <TableCell>{policy.targets} devices</TableCell>
-                <TableCell>
-                  <Badge
-                    variant="outline"
-                    className={
-                      policy.enabled
-                        ? "bg-emerald-500/10 text-emerald-500"
-                        : "bg-slate-500/10 text-slate-500"
-                    }
-                  >
-                    {policy.enabled ? "Enabled" : "Disabled"}
-                  </Badge>
-                </TableCell>
-                <TableCell className="text-muted-foreground text-xs">
-                  {policy.lastModified}
-                </TableCell>
-                <TableCell className="text-right">
-                  <Button variant="ghost" size="icon">
-                    <Settings className="h-4 w-4" />
-                  </Button>
+            {filteredPolicies.length === 0 ? (
+              <TableRow>
+                <TableCell
+                  colSpan={7}
+                  className="h-24 text-center text-muted-foreground"
+                >
+                  No policies yet.
                 </TableCell>
               </TableRow>
-            ))}
+            ) : (
+              filteredPolicies.map((policy) => (
+                <TableRow key={policy.id}>
+                  <TableCell className="font-medium">
+                    <div className="flex items-center gap-2">
+                      <ScrollText className="h-4 w-4 text-muted-foreground" />
+                      {policy.name}
+                    </div>
+                    {policy.description && (
+                      <div className="text-xs text-muted-foreground">
+                        {policy.description}
+                      </div>
+                    )}
+                  </TableCell>
+                  <TableCell>
+                    <Badge
+                      variant="outline"
+                      className="bg-blue-500/10 text-blue-500 border-blue-500/20"
+                    >
+                      {policy.severities && policy.severities.length > 0
+                        ? policy.severities.join(", ")
+                        : "All"}
+                    </Badge>
+                    {policy.reboot && (
+                      <span className="ml-2 text-xs text-muted-foreground">
+                        + restart
+                      </span>
+                    )}
+                  </TableCell>
+                  <TableCell className="text-xs">
+                    {policy.targets.map(describeTarget).join(", ")}
+                    <div className="text-muted-foreground">
+                      {policy.targetCount} device
+                      {policy.targetCount === 1 ? "" : "s"}
+                    </div>
+                  </TableCell>
+                  <TableCell className="font-mono text-xs text-muted-foreground">
+                    {policy.schedule && policy.schedule !== "manual"
+                      ? policy.schedule
+                      : "Manual"}
+                  </TableCell>
+                  <TableCell>
+                    <Badge
+                      variant="outline"
+                      className={
+                        policy.enabled
+                          ? "bg-emerald-500/10 text-emerald-500"
+                          : "bg-slate-500/10 text-slate-500"
+                      }
+                    >
+                      {policy.enabled ? "Enabled" : "Disabled"}
+                    </Badge>
+                  </TableCell>
+                  <TableCell className="text-muted-foreground text-xs">
+                    {policy.lastRun
+                      ? formatDistanceToNow(new Date(policy.lastRun), {
+                          addSuffix: true,
+                        })
+                      : "Never"}
+                  </TableCell>
+                  <TableCell className="text-right">
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      title="Run now"
+                      onClick={() => handleRunNow(policy)}
+                    >
+                      <Play className="h-4 w-4 text-green-500" />
+                    </Button>
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      title="Edit"
+                      onClick={() => openEdit(policy)}
+                    >
+                      <Settings className="h-4 w-4" />
+                    </Button>
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      title="Delete"
+                      onClick={() => handleDelete(policy)}
+                    >
+                      <Trash2 className="h-4 w-4 text-red-500" />
+                    </Button>
+                  </TableCell>
+                </TableRow>
+              ))
+            )}
           </TableBody>
         </Table>
       </div>
@@ -7,9 +7,19 @@ import {
   TableHeader,
   TableRow,
 } from "@/components/ui/table";
-import { Input } from "@/components/ui/input";
+import { Badge } from "@/components/ui/badge";
 import { Button } from "@/components/ui/button";
-import { Package, Search, Trash2, RefreshCw } from "lucide-react";
+import { Input } from "@/components/ui/input";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Tabs, TabsContent, TabsList, TabsTrigger } from "@/components/ui/tabs";
+import { Package, Search, RefreshCw } from "lucide-react";
+import { formatDistanceToNow } from "date-fns";
 import { toast } from "sonner";
 
 interface SoftwareInfo {
@@ -19,73 +29,333 @@ interface SoftwareInfo {
   install_date: string;
 }
 
+interface Inventory {
+  version: number;
+  collectedAt: string;
+  confirmedAt: string;
+  hardware: {
+    manufacturer?: string;
+    model?: string;
+    serial_number?: string;
+    cpu_model?: string;
+    cpu_cores?: number;
+    memory_total?: number;
+  };
+  software: SoftwareInfo[] | null;
+  changes?: {
+    software_added?: SoftwareInfo[];
+    software_removed?: SoftwareInfo[];
+    software_updated?: { name: string }[];
+    services_changed?: { name: string }[];
+    patches_pending?: { id: string }[];
+    patches_cleared?: { id: string }[];
+    hardware_changed?: boolean;
+  };
+}
+
+interface SoftwareEvent {
+  agentId: number;
+  hostname: string;
+  change: "installed" | "removed" | "updated";
+  name: string;
+  version: string;
+  oldVersion: string;
+  at: string;
+}
+
+interface SoftwareMatch {
+  agentId: number;
+  hostname: string;
+  os: string;
+  name: string;
+  version: string;
+  publisher: string;
+  firstSeen: string;
+}
+
 interface SoftwareTabProps {
   deviceId: string;
 }
 
+const periods = [
+  { value: "24", label: "Last 24 hours" },
+  { value: "168", label: "Last 7 days" },
+  { value: "720", label: "Last 30 days" },
+  { value: "2160", label: "Last 90 days" },
+];
+
+const changeBadge: Record<SoftwareEvent["change"], string> = {
+  installed: "bg-emerald-500/10 text-emerald-500 border-emerald-500/20",
+  removed: "bg-red-500/10 text-red-500 border-red-500/20",
+  updated: "bg-blue-500/10 text-blue-500 border-blue-500/20",
+};
+
+const ago = (t: string) => formatDistanceToNow(new Date(t), { addSuffix: true });
+
+const summarize = (inv: Inventory) => {
+  const c = inv.changes;
+  if (!c) return "First inventory";
+  const parts: string[] = [];
+  const count = (n: number | undefined, what: string) => {
+    if (n) parts.push(`${n} ${what}`);
+  };
+  count(c.software_added?.length, "installed");
+  count(c.software_removed?.length, "removed");
+  count(c.software_updated?.length, "updated");
+  count(c.services_changed?.length, "services changed");
+  count(c.patches_pending?.length, "new patches");
+  count(c.patches_cleared?.length, "patches cleared");
+  if (c.hardware_changed) parts.push("hardware changed");
+  return parts.length ? parts.join(", ") : "Host details changed";
+};
+
+const ChangesTable: React.FC<{ events: SoftwareEvent[]; fleet: boolean }> = ({
+  events,
+  fleet,
+}) => (
+  <div className="rounded-md border border-zinc-800 bg-zinc-900/30 overflow-hidden">
+    <Table>
+      <TableHeader className="bg-zinc-900/50">
+        <TableRow className="border-zinc-800 hover:bg-zinc-900/50">
+          <TableHead>When</TableHead>
+          {fleet && <TableHead>Device</TableHead>}
+          <TableHead>Change</TableHead>
+          <TableHead>Software</TableHead>
+          <TableHead>Version</TableHead>
+        </TableRow>
+      </TableHeader>
+      <TableBody>
+        {events.length === 0 ? (
+          <TableRow>
+            <TableCell
+              colSpan={fleet ? 5 : 4}
+              className="text-center h-24 text-muted-foreground"
+            >
+              No software changes in this period.
+            </TableCell>
+          </TableRow>
+        ) : (
+          events.map((e, i) => (
+            <TableRow key={i} className="border-zinc-800 hover:bg-zinc-900/50">
+              <TableCell className="text-xs text-muted-foreground">
+                {ago(e.at)}
+              </TableCell>
+              {fleet && <TableCell>{e.hostname}</TableCell>}
+              <TableCell>
+                <Badge variant="outline" className={changeBadge[e.change]}>
+                  {e.change}
+                </Badge>
+              </TableCell>
+              <TableCell className="font-medium">{e.name}</TableCell>
+              <TableCell className="font-mono text-xs">
+                {e.change === "updated"
+                  ? `${e.oldVersion} → ${e.version}`
+                  : e.version}
+              </TableCell>
+            </TableRow>
+          ))
+        )}
+      </TableBody>
+    </Table>
+  </div>
+);
+
 const SoftwareTab: React.FC<SoftwareTabProps> = ({ deviceId }) => {
+  const fleet = deviceId === "fleet";
+  const agentId = fleet ? 0 : Number(deviceId);
+
   const [searchTerm, setSearchTerm] = useState("");
-  const [software, setSoftware] = useState<SoftwareInfo[]>([]);
+  const [versionTerm, setVersionTerm] = useState("");
+  const [inventory, setInventory] = useState<Inventory | null>(null);
+  const [history, setHistory] = useState<Inventory[]>([]);
+  const [events, setEvents] = useState<SoftwareEvent[]>([]);
+  const [matches, setMatches] = useState<SoftwareMatch[] | null>(null);
+  const [period, setPeriod] = useState("168");
   const [loading, setLoading] = useState(false);
 
-  const fetchSoftware = async () => {
+  const bridge = () => (window as any).go?.bridge?.PulseBridge;
+
+  const fetchInventory = async () => {
+    if (!bridge() || fleet) return;
+    try {
+      const [inv, hist] = await Promise.all([
+        bridge().GetInventory(agentId),
+        bridge().ListInventoryHistory(agentId, 50),
+      ]);
+      setInventory(inv || null);
+      setHistory(hist || []);
+    } catch (err) {
+      toast.error("Failed to load software inventory");
+    }
+  };
+
+  const fetchChanges = async () => {
+    if (!bridge()) return;
+    try {
+      const res = await bridge().GetSoftwareChanges(agentId, Number(period));
+      setEvents(res || []);
+    } catch (err) {
+      toast.error("Failed to load software changes");
+    }
+  };
+
+  useEffect(() => {
+    fetchInventory();
+  }, [deviceId]);
+
+  useEffect(() => {
+    fetchChanges();
+  }, [deviceId, period]);
+
+  const collectNow = async () => {
     setLoading(true);
     try {
-      const w = window as any;
-      if (w.go && w.go.bridge && w.go.bridge.PulseBridge) {
-        const res =
-          await w.go.bridge.PulseBridge.GetSoftwareInventory(deviceId);
-        setSoftware(
-          res && res.length > 0
-            ? res
-            : [
-                {
-                  name: "Google Chrome",
-                  version: "120.0.6099.109",
-                  publisher: "Google LLC",
-                  install_date: "2023-12-01",
-                },
-                {
-                  name: "Visual Studio Code",
-                  version: "1.85.1",
-                  publisher: "Microsoft Corporation",
-                  install_date: "2023-11-15",
-                },
-              ],
-        );
-      } else {
-        // Mock data for browser audit
-        setSoftware([
-          {
-            name: "Google Chrome",
-            version: "120.0.6099.109",
-            publisher: "Google LLC",
-            install_date: "2023-12-01",
-          },
-          {
-            name: "Visual Studio Code",
-            version: "1.85.1",
-            publisher: "Microsoft Corporation",
-            install_date: "2023-11-15",
-          },
-        ]);
-      }
+      await bridge().CollectInventory(agentId);
+      toast.success("Inventory updated");
+      await Promise.all([fetchInventory(), fetchChanges()]);
     } catch (err) {
-      toast.error("Failed to fetch software inventory");
+      toast.error(`Failed to collect inventory: ${err}`);
     } finally {
       setLoading(false);
     }
   };
 
-  useEffect(() => {
-    fetchSoftware();
-  }, [deviceId]);
+  const searchFleet = async () => {
+    if (!searchTerm.trim()) return;
+    setLoading(true);
+    try {
+      const res = await bridge().SearchSoftware(searchTerm, versionTerm);
+      setMatches(res || []);
+    } catch (err) {
+      toast.error(`${err}`);
+    } finally {
+      setLoading(false);
+    }
+  };
+
+  const periodSelect = (
+    <Select value={period} onValueChange={setPeriod}>
+      <SelectTrigger className="w-44">
+        <SelectValue />
+      </SelectTrigger>
+      <SelectContent>
+        {periods.map((p) => (
+          <SelectItem key={p.value} value={p.value}>
+            {p.label}
+          </SelectItem>
+        ))}
+      </SelectContent>
+    </Select>
+  );
+
+  if (fleet) {
+    return (
+      <div className="p-6 space-y-6 fade-in">
+        <div>
+          <h2 className="text-2xl font-bold tracking-tight">Software</h2>
+          <p className="text-muted-foreground text-sm">
+            What is installed across the fleet, from each device's inventory
+          </p>
+        </div>
+        <Tabs defaultValue="search">
+          <TabsList>
+            <TabsTrigger value="search">Find Software</TabsTrigger>
+            <TabsTrigger value="changes">Recent Changes</TabsTrigger>
+          </TabsList>
+          <TabsContent value="search" className="space-y-4">
+            <div className="flex items-center gap-2">
+              <Input
+                placeholder="Software name, e.g. OpenSSL"
+                value={searchTerm}
+                onChange={(e) => setSearchTerm(e.target.value)}
+                onKeyDown={(e) => e.key === "Enter" && searchFleet()}
+                className="max-w-xs"
+              />
+              <Input
+                placeholder="Version, e.g. 1.1"
+                value={versionTerm}
+                onChange={(e) => setVersionTerm(e.target.value)}
+                onKeyDown={(e) => e.key === "Enter" && searchFleet()}
+                className="max-w-[160px]"
+              />
+              <Button onClick={searchFleet} disabled={loading}>
+                <Search className="mr-2 h-4 w-4" /> Search
+              </Button>
+            </div>
+            {matches && (
+              <div className="rounded-md border border-zinc-800 bg-zinc-900/30 overflow-hidden">
+                <Table>
+                  <TableHeader className="bg-zinc-900/50">
+                    <TableRow className="border-zinc-800 hover:bg-zinc-900/50">
+                      <TableHead>Device</TableHead>
+                      <TableHead>OS</TableHead>
+                      <TableHead>Software</TableHead>
+                      <TableHead>Version</TableHead>
+                      <TableHead>Publisher</TableHead>
+                      <TableHead>First Seen</TableHead>
+                    </TableRow>
+                  </TableHeader>
+                  <TableBody>
+                    {matches.length === 0 ? (
+                      <TableRow>
+                        <TableCell
+                          colSpan={6}
+                          className="text-center h-24 text-muted-foreground"
+                        >
+                          No device has matching software installed.
+                        </TableCell>
+                      </TableRow>
+                    ) : (
+                      matches.map((m, i) => (
+                        <TableRow
+                          key={i}
+                          className="border-zinc-800 hover:bg-zinc-900/50"
+                        >
+                          <TableCell className="font-medium">
+                            {m.hostname}
+                          </TableCell>
+                          <TableCell className="uppercase text-xs text-muted-foreground">
+                            {m.os}
+                          </TableCell>
+                          <TableCell>{m.name}</TableCell>
+                          <TableCell className="font-mono text-xs">
+                            {m.version}
+                          </TableCell>
+                          <TableCell className="text-muted-foreground">
+                            {m.publisher}
+                          </TableCell>
+                          <TableCell className="text-xs text-muted-foreground">
+                            {ago(m.firstSeen)}
+                          </TableCell>
+                        </TableRow>
+                      ))
+                    )}
+                  </TableBody>
+                </Table>
+              </div>
+            )}
+            {matches && (
+              <div className="text-xs text-muted-foreground">
+                {new Set(matches.map((m) => m.agentId)).size} device(s) found
+              </div>
+            )}
+          </TabsContent>
+          <TabsContent value="changes" className="space-y-4">
+            {periodSelect}
+            <ChangesTable events={events} fleet />
+          </TabsContent>
+        </Tabs>
+      </div>
+    );
+  }
 
+  const software = inventory?.software || [];
   const filteredSoftware = software.filter(
     (app) =>
       app.name.toLowerCase().includes(searchTerm.toLowerCase()) ||
-      app.publisher.toLowerCase().includes(searchTerm.toLowerCase()),
+      (app.publisher || "").toLowerCase().includes(searchTerm.toLowerCase()),
   );
+  const hw = inventory?.hardware;
 
   return (
     <div className="space-y-4 fade-in">
@@ -100,11 +370,17 @@ const SoftwareTab: React.FC<SoftwareTabProps> = ({ deviceId }) => {
           />
         </div>
 
-        <div className="flex gap-2">
+        <div className="flex items-center gap-3">
+          {inventory && (
+            <span className="text-xs text-muted-foreground">
+              Version {inventory.version}, last reported{" "}
+              {ago(inventory.confirmedAt)}
+            </span>
+          )}
           <Button
             variant="outline"
             size="sm"
-            onClick={fetchSoftware}
+            onClick={collectNow}
             disabled={loading}
           >
             <RefreshCw
@@ -115,71 +391,148 @@ const SoftwareTab: React.FC<SoftwareTabProps> = ({ deviceId }) => {
         </div>
       </div>
 
-      <div className="rounded-md border border-zinc-800 bg-zinc-900/30 overflow-hidden min-h-[400px]">
-        <Table>
-          <TableHeader className="bg-zinc-900/50">
-            <TableRow className="border-zinc-800 hover:bg-zinc-900/50">
-              <TableHead className="w-[300px]">Software Name</TableHead>
-              <TableHead>Version</TableHead>
-              <TableHead>Publisher</TableHead>
-              <TableHead>Install Date</TableHead>
-              <TableHead className="text-right">Actions</TableHead>
-            </TableRow>
-          </TableHeader>
-          <TableBody>
-            {loading ? (
-              <TableRow>
-                <TableCell
-                  colSpan={5}
-                  className="text-center h-24 text-muted-foreground"
-                >
-                  Scanning for software...
-                </TableCell>
-              </TableRow>
-            ) : filteredSoftware.length === 0 ? (
-              <TableRow>
-                <TableCell
-                  colSpan={5}
-                  className="text-center h-24 text-muted-foreground"
-                >
-                  No software found matching "{searchTerm}"
-                </TableCell>
-              </TableRow>
-            ) : (
-              filteredSoftware.map((app, index) => (
-                <TableRow
-                  key={index}
-                  className="border-zinc-800 hover:bg-zinc-900/50"
-                >
-                  <TableCell className="font-medium flex items-center gap-2">
-                    <Package className="w-4 h-4 text-zinc-500" />
-                    {app.name}
-                  </TableCell>
-                  <TableCell>{app.version}</TableCell>
-                  <TableCell className="text-muted-foreground">
-                    {app.publisher}
-                  </TableCell>
-                  <TableCell className="text-muted-foreground text-xs">
-                    {app.install_date || "--"}
-                  </TableCell>
-                  <TableCell className="text-right">
-                    <Button
-                      variant="ghost"
-                      size="icon"
-                      className="hover:text-red-400 hover:bg-red-400/10 h-8 w-8"
+      {hw && (hw.manufacturer || hw.cpu_model) && (
+        <div className="flex flex-wrap gap-x-6 gap-y-1 text-xs text-muted-foreground">
+          {hw.manufacturer && (
+            <span>
+              {hw.manufacturer} {hw.model}
+            </span>
+          )}
+          {hw.serial_number && <span>S/N {hw.serial_number}</span>}
+          {hw.cpu_model && (
+            <span>
+              {hw.cpu_model}
+              {hw.cpu_cores ? `, ${hw.cpu_cores} cores` : ""}
+            </span>
+          )}
+          {hw.memory_total ? (
+            <span>{(hw.memory_total / 1024 ** 3).toFixed(1)} GB RAM</span>
+          ) : null}
+        </div>
+      )}
+
+      <Tabs defaultValue="installed">
+        <TabsList>
+          <TabsTrigger value="installed">Installed</TabsTrigger>
+          <TabsTrigger value="changes">Changes</TabsTrigger>
+          <TabsTrigger value="history">Inventory History</TabsTrigger>
+        </TabsList>
+
+        <TabsContent value="installed" className="space-y-4">
+          <div className="rounded-md border border-zinc-800 bg-zinc-900/30 overflow-hidden min-h-[400px]">
+            <Table>
+              <TableHeader className="bg-zinc-900/50">
+                <TableRow className="border-zinc-800 hover:bg-zinc-900/50">
+                  <TableHead className="w-[300px]">Software Name</TableHead>
+                  <TableHead>Version</TableHead>
+                  <TableHead>Publisher</TableHead>
+                  <TableHead>Install Date</TableHead>
+                </TableRow>
+              </TableHeader>
+              <TableBody>
+                {loading && !inventory ? (
+                  <TableRow>
+                    <TableCell
+                      colSpan={4}
+                      className="text-center h-24 text-muted-foreground"
+                    >
+                      Collecting inventory...
+                    </TableCell>
+                  </TableRow>
+                ) : !inventory ? (
+                  <TableRow>
+                    <TableCell
+                      colSpan={4}
+                      className="text-center h-24 text-muted-foreground"
                     >
-                      <Trash2 className="w-4 h-4" />
-                    </Button>
-                  </TableCell>
+                      This device has not reported its inventory yet.
+                    </TableCell>
+                  </TableRow>
+                ) : filteredSoftware.length === 0 ? (
+                  <TableRow>
+                    <TableCell
+                      colSpan={4}
+                      className="text-center h-24 text-muted-foreground"
+                    >
+                      No software found matching "{searchTerm}"
+                    </TableCell>
+                  </TableRow>
+                ) : (
+                  filteredSoftware.map((app, index) => (
+                    <TableRow
+                      key={index}
+                      className="border-zinc-800 hover:bg-zinc-900/50"
+                    >
+                      <TableCell className="font-medium flex items-center gap-2">
+                        <Package className="w-4 h-4 text-zinc-500" />
+                        {app.name}
+                      </TableCell>
+                      <TableCell>{app.version}</TableCell>
+                      <TableCell className="text-muted-foreground">
+                        {app.publisher}
+                      </TableCell>
+                      <TableCell className="text-muted-foreground text-xs">
+                        {app.install_date || "--"}
+                      </TableCell>
+                    </TableRow>
+                  ))
+                )}
+              </TableBody>
+            </Table>
+          </div>
+          <div className="text-xs text-muted-foreground text-center">
+            Showing {filteredSoftware.length} of {software.length} applications
+          </div>
+        </TabsContent>
+
+        <TabsContent value="changes" className="space-y-4">
+          {periodSelect}
+          <ChangesTable events={events} fleet={false} />
+        </TabsContent>
+
+        <TabsContent value="history">
+          <div className="rounded-md border border-zinc-800 bg-zinc-900/30 overflow-hidden">
+            <Table>
+              <TableHeader className="bg-zinc-900/50">
+                <TableRow className="border-zinc-800 hover:bg-zinc-900/50">
+                  <TableHead>Version</TableHead>
+                  <TableHead>Collected</TableHead>
+                  <TableHead>Last Reported</TableHead>
+                  <TableHead>Changes</TableHead>
                 </TableRow>
-              ))
-            )}
-          </TableBody>
-        </Table>
-      </div>
-      <div className="text-xs text-muted-foreground text-center">
-        Showing {filteredSoftware.length} applications installed on {deviceId}
-      </div>
+              </TableHeader>
+              <TableBody>
+                {history.length === 0 ? (
+                  <TableRow>
+                    <TableCell
+                      colSpan={4}
+                      className="text-center h-24 text-muted-foreground"
+                    >
+                      No inventory recorded yet.
+                    </TableCell>
+                  </TableRow>
+                ) : (
+                  history.map((h) => (
+                    <TableRow
+                      key={h.version}
+                      className="border-zinc-800 hover:bg-zinc-900/50"
+                    >
+                      <TableCell className="font-mono">v{h.version}</TableCell>
+                      <TableCell className="text-xs text-muted-foreground">
+                        {new Date(h.collectedAt).toLocaleString()}
+                      </TableCell>
+                      <TableCell className="text-xs text-muted-foreground">
+                        {ago(h.confirmedAt)}
+                      </TableCell>
+                      <TableCell className="text-sm">{summarize(h)}</TableCell>
+                    </TableRow>
+                  ))
+                )}
+              </TableBody>
+            </Table>
+          </div>
+        </TabsContent>
+      </Tabs>
     </div>
   );
 };
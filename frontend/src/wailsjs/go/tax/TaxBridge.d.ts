@@ -1,2 +1,2 @@
-export function CalculateTax(amount:number, countryCode:string):Promise<any>;
+export function CalculateTax(amount:number, taxCode:string, inclusive:boolean):Promise<any>;
 export function GetTaxConfig():Promise<any[]>;
@@ -1,5 +1,5 @@
-export function CalculateTax(amount, countryCode) {
-  return window['go']['tax']['TaxBridge']['CalculateTax'](amount, countryCode);
+export function CalculateTax(amount, taxCode, inclusive) {
+  return window['go']['tax']['TaxBridge']['CalculateTax'](amount, taxCode, inclusive);
 }
 
 export function GetTaxConfig() {
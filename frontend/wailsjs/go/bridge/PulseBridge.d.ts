@@ -8,27 +8,55 @@ import {http} from '../models';
 import {river} from '../models';
 import {context} from '../models';
 
+export function AcknowledgeAlert(arg1:number):Promise<void>;
+
+export function AcknowledgeAllAlerts():Promise<number>;
+
+export function CancelExecution(arg1:number):Promise<void>;
+
+export function CollectInventory(arg1:number):Promise<bridge.InventoryDTO>;
+
 export function ControlService(arg1:string,arg2:string,arg3:string):Promise<void>;
 
-export function CreateJob(arg1:string,arg2:number,arg3:Array<string>,arg4:string):Promise<bridge.JobDTO>;
+export function CreateAlertRule(arg1:string,arg2:Array<string>,arg3:string,arg4:number,arg5:string,arg6:boolean):Promise<bridge.AlertRuleDTO>;
+
+export function CreateDeviceGroup(arg1:string,arg2:string,arg3:string,arg4:Array<number>):Promise<bridge.DeviceGroupDTO>;
+
+export function CreateEnrollmentToken(arg1:number):Promise<bridge.EnrollmentTokenDTO>;
+
+export function CreateJob(arg1:string,arg2:number,arg3:Array<string>,arg4:string,arg5:Record<string, string>,arg6:number):Promise<bridge.JobDTO>;
+
+export function CreatePatchPolicy(arg1:string,arg2:string,arg3:Array<string>,arg4:string,arg5:Array<string>,arg6:boolean,arg7:boolean):Promise<bridge.PatchPolicyDTO>;
 
 export function CreateScript(arg1:string,arg2:string,arg3:string,arg4:string):Promise<bridge.ScriptDTO>;
 
+export function DeleteAlertRule(arg1:number):Promise<void>;
+
+export function DeleteDeviceGroup(arg1:number):Promise<void>;
+
 export function DeleteFile(arg1:string,arg2:string):Promise<void>;
 
+export function DeletePatchPolicy(arg1:number):Promise<void>;
+
 export function DeleteScript(arg1:number):Promise<void>;
 
 export function DownloadFile(arg1:string,arg2:string):Promise<Array<number>>;
 
 export function GetAgents():Promise<Array<ent.Agent>>;
 
+export function GetDeviceGroupMembers(arg1:number):Promise<Array<ent.Agent>>;
+
 export function GetEnvVars(arg1:string):Promise<Array<agent.EnvVar>>;
 
 export function GetEventLogs(arg1:string):Promise<Array<agent.LogEntry>>;
 
+export function GetInventory(arg1:number):Promise<bridge.InventoryDTO>;
+
 export function GetProcesses(arg1:string):Promise<Array<agent.ProcessInfo>>;
 
-export function GetServices(arg1:string):Promise<Array<agent.ServiceInfo>>;
+export function GetServices(arg1:string):Promise<Array<common.ServiceInfo>>;
+
+export function GetSoftwareChanges(arg1:number,arg2:number):Promise<Array<bridge.SoftwareEventDTO>>;
 
 export function GetSoftwareInventory(arg1:string):Promise<Array<common.SoftwareInfo>>;
 
@@ -42,22 +70,58 @@ export function InstallPatches(arg1:string,arg2:Array<string>):Promise<void>;
 
 export function KillProcess(arg1:string,arg2:number):Promise<void>;
 
+export function ListAgentTags():Promise<Array<string>>;
+
+export function ListAlertRules():Promise<Array<bridge.AlertRuleDTO>>;
+
+export function ListAlerts(arg1:boolean,arg2:number):Promise<Array<bridge.AlertDTO>>;
+
+export function ListDeviceGroups():Promise<Array<bridge.DeviceGroupDTO>>;
+
+export function ListExecutions(arg1:number,arg2:number):Promise<Array<bridge.ExecutionDTO>>;
+
 export function ListFiles(arg1:string,arg2:string):Promise<Array<agent.FileInfo>>;
 
+export function ListInventoryHistory(arg1:number,arg2:number):Promise<Array<bridge.InventoryDTO>>;
+
 export function ListJobs():Promise<Array<bridge.JobDTO>>;
 
+export function ListPatchPolicies():Promise<Array<bridge.PatchPolicyDTO>>;
+
 export function ListScripts():Promise<Array<bridge.ScriptDTO>>;
 
+export function PatchSeverities():Promise<Array<string>>;
+
+export function PreviewFilter(arg1:string):Promise<Array<ent.Agent>>;
+
 export function RebootDevice(arg1:string):Promise<void>;
 
-export function ScanPatches(arg1:string):Promise<Array<agent.PatchInfo>>;
+export function RekeyAgent(arg1:number):Promise<void>;
+
+export function RevokeAgent(arg1:number):Promise<void>;
+
+export function RunJobNow(arg1:number):Promise<Array<number>>;
+
+export function RunPatchPolicyNow(arg1:number):Promise<Array<number>>;
+
+export function ScanPatches(arg1:string):Promise<Array<common.PatchInfo>>;
+
+export function SearchSoftware(arg1:string,arg2:string):Promise<Array<bridge.SoftwareMatchDTO>>;
 
 export function SendCommand(arg1:string,arg2:string):Promise<void>;
 
+export function SetAgentTags(arg1:number,arg2:Array<string>):Promise<Array<string>>;
+
 export function SetRiverClient(arg1:river.Client_github_com_jackc_pgx_v5_Tx_):Promise<void>;
 
 export function ShutdownDevice(arg1:string):Promise<void>;
 
 export function Startup(arg1:context.Context):Promise<void>;
 
+export function UpdateAlertRule(arg1:number,arg2:string,arg3:Array<string>,arg4:string,arg5:number,arg6:string,arg7:boolean):Promise<bridge.AlertRuleDTO>;
+
+export function UpdateDeviceGroup(arg1:number,arg2:string,arg3:string,arg4:string,arg5:Array<number>):Promise<bridge.DeviceGroupDTO>;
+
+export function UpdatePatchPolicy(arg1:number,arg2:string,arg3:string,arg4:Array<string>,arg5:string,arg6:Array<string>,arg7:boolean,arg8:boolean):Promise<bridge.PatchPolicyDTO>;
+
 export function UpdateScript(arg1:number,arg2:string):Promise<bridge.ScriptDTO>;
@@ -2,22 +2,66 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AcknowledgeAlert(arg1) {
+  return window['go']['bridge']['PulseBridge']['AcknowledgeAlert'](arg1);
+}
+
+export function AcknowledgeAllAlerts() {
+  return window['go']['bridge']['PulseBridge']['AcknowledgeAllAlerts']();
+}
+
+export function CancelExecution(arg1) {
+  return window['go']['bridge']['PulseBridge']['CancelExecution'](arg1);
+}
+
+export function CollectInventory(arg1) {
+  return window['go']['bridge']['PulseBridge']['CollectInventory'](arg1);
+}
+
 export function ControlService(arg1, arg2, arg3) {
   return window['go']['bridge']['PulseBridge']['ControlService'](arg1, arg2, arg3);
 }
 
-export function CreateJob(arg1, arg2, arg3, arg4) {
-  return window['go']['bridge']['PulseBridge']['CreateJob'](arg1, arg2, arg3, arg4);
+export function CreateAlertRule(arg1, arg2, arg3, arg4, arg5, arg6) {
+  return window['go']['bridge']['PulseBridge']['CreateAlertRule'](arg1, arg2, arg3, arg4, arg5, arg6);
+}
+
+export function CreateDeviceGroup(arg1, arg2, arg3, arg4) {
+  return window['go']['bridge']['PulseBridge']['CreateDeviceGroup'](arg1, arg2, arg3, arg4);
+}
+
+export function CreateEnrollmentToken(arg1) {
+  return window['go']['bridge']['PulseBridge']['CreateEnrollmentToken'](arg1);
+}
+
+export function CreateJob(arg1, arg2, arg3, arg4, arg5, arg6) {
+  return window['go']['bridge']['PulseBridge']['CreateJob'](arg1, arg2, arg3, arg4, arg5, arg6);
+}
+
+export function CreatePatchPolicy(arg1, arg2, arg3, arg4, arg5, arg6, arg7) {
+  return window['go']['bridge']['PulseBridge']['CreatePatchPolicy'](arg1, arg2, arg3, arg4, arg5, arg6, arg7);
 }
 
 export function CreateScript(arg1, arg2, arg3, arg4) {
   return window['go']['bridge']['PulseBridge']['CreateScript'](arg1, arg2, arg3, arg4);
 }
 
+export function DeleteAlertRule(arg1) {
+  return window['go']['bridge']['PulseBridge']['DeleteAlertRule'](arg1);
+}
+
+export function DeleteDeviceGroup(arg1) {
+  return window['go']['bridge']['PulseBridge']['DeleteDeviceGroup'](arg1);
+}
+
 export function DeleteFile(arg1, arg2) {
   return window['go']['bridge']['PulseBridge']['DeleteFile'](arg1, arg2);
 }
 
+export function DeletePatchPolicy(arg1) {
+  return window['go']['bridge']['PulseBridge']['DeletePatchPolicy'](arg1);
+}
+
 export function DeleteScript(arg1) {
   return window['go']['bridge']['PulseBridge']['DeleteScript'](arg1);
 }
@@ -30,6 +74,10 @@ export function GetAgents() {
   return window['go']['bridge']['PulseBridge']['GetAgents']();
 }
 
+export function GetDeviceGroupMembers(arg1) {
+  return window['go']['bridge']['PulseBridge']['GetDeviceGroupMembers'](arg1);
+}
+
 export function GetEnvVars(arg1) {
   return window['go']['bridge']['PulseBridge']['GetEnvVars'](arg1);
 }
@@ -38,6 +86,10 @@ export function GetEventLogs(arg1) {
   return window['go']['bridge']['PulseBridge']['GetEventLogs'](arg1);
 }
 
+export function GetInventory(arg1) {
+  return window['go']['bridge']['PulseBridge']['GetInventory'](arg1);
+}
+
 export function GetProcesses(arg1) {
   return window['go']['bridge']['PulseBridge']['GetProcesses'](arg1);
 }
@@ -46,6 +98,10 @@ export function GetServices(arg1) {
   return window['go']['bridge']['PulseBridge']['GetServices'](arg1);
 }
 
+export function GetSoftwareChanges(arg1, arg2) {
+  return window['go']['bridge']['PulseBridge']['GetSoftwareChanges'](arg1, arg2);
+}
+
 export function GetSoftwareInventory(arg1) {
   return window['go']['bridge']['PulseBridge']['GetSoftwareInventory'](arg1);
 }
@@ -70,30 +126,90 @@ export function KillProcess(arg1, arg2) {
   return window['go']['bridge']['PulseBridge']['KillProcess'](arg1, arg2);
 }
 
+export function ListAgentTags() {
+  return window['go']['bridge']['PulseBridge']['ListAgentTags']();
+}
+
+export function ListAlertRules() {
+  return window['go']['bridge']['PulseBridge']['ListAlertRules']();
+}
+
+export function ListAlerts(arg1, arg2) {
+  return window['go']['bridge']['PulseBridge']['ListAlerts'](arg1, arg2);
+}
+
+export function ListDeviceGroups() {
+  return window['go']['bridge']['PulseBridge']['ListDeviceGroups']();
+}
+
+export function ListExecutions(arg1, arg2) {
+  return window['go']['bridge']['PulseBridge']['ListExecutions'](arg1, arg2);
+}
+
 export function ListFiles(arg1, arg2) {
   return window['go']['bridge']['PulseBridge']['ListFiles'](arg1, arg2);
 }
 
+export function ListInventoryHistory(arg1, arg2) {
+  return window['go']['bridge']['PulseBridge']['ListInventoryHistory'](arg1, arg2);
+}
+
 export function ListJobs() {
   return window['go']['bridge']['PulseBridge']['ListJobs']();
 }
 
+export function ListPatchPolicies() {
+  return window['go']['bridge']['PulseBridge']['ListPatchPolicies']();
+}
+
 export function ListScripts() {
   return window['go']['bridge']['PulseBridge']['ListScripts']();
 }
 
+export function PatchSeverities() {
+  return window['go']['bridge']['PulseBridge']['PatchSeverities']();
+}
+
+export function PreviewFilter(arg1) {
+  return window['go']['bridge']['PulseBridge']['PreviewFilter'](arg1);
+}
+
 export function RebootDevice(arg1) {
   return window['go']['bridge']['PulseBridge']['RebootDevice'](arg1);
 }
 
+export function RekeyAgent(arg1) {
+  return window['go']['bridge']['PulseBridge']['RekeyAgent'](arg1);
+}
+
+export function RevokeAgent(arg1) {
+  return window['go']['bridge']['PulseBridge']['RevokeAgent'](arg1);
+}
+
+export function RunJobNow(arg1) {
+  return window['go']['bridge']['PulseBridge']['RunJobNow'](arg1);
+}
+
+export function RunPatchPolicyNow(arg1) {
+  return window['go']['bridge']['PulseBridge']['RunPatchPolicyNow'](arg1);
+}
+
 export function ScanPatches(arg1) {
   return window['go']['bridge']['PulseBridge']['ScanPatches'](arg1);
 }
 
+export function SearchSoftware(arg1, arg2) {
+  return window['go']['bridge']['PulseBridge']['SearchSoftware'](arg1, arg2);
+}
+
 export function SendCommand(arg1, arg2) {
   return window['go']['bridge']['PulseBridge']['SendCommand'](arg1, arg2);
 }
 
+export function SetAgentTags(arg1, arg2) {
+  return window['go']['bridge']['PulseBridge']['SetAgentTags'](arg1, arg2);
+}
+
 export function SetRiverClient(arg1) {
   return window['go']['bridge']['PulseBridge']['SetRiverClient'](arg1);
 }
@@ -106,6 +222,18 @@ export function Startup(arg1) {
   return window['go']['bridge']['PulseBridge']['Startup'](arg1);
 }
 
+export function UpdateAlertRule(arg1, arg2, arg3, arg4, arg5, arg6, arg7) {
+  return window['go']['bridge']['PulseBridge']['UpdateAlertRule'](arg1, arg2, arg3, arg4, arg5, arg6, arg7);
+}
+
+export function UpdateDeviceGroup(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['bridge']['PulseBridge']['UpdateDeviceGroup'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function UpdatePatchPolicy(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8) {
+  return window['go']['bridge']['PulseBridge']['UpdatePatchPolicy'](arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8);
+}
+
 export function UpdateScript(arg1, arg2) {
   return window['go']['bridge']['PulseBridge']['UpdateScript'](arg1, arg2);
 }
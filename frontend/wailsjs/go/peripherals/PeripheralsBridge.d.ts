@@ -3,8 +3,22 @@
 import {peripherals} from '../models';
 import {context} from '../models';
 
+export function AddPrinter(arg1:peripherals.DeviceInfo):Promise<void>;
+
+export function AddScale(arg1:peripherals.DeviceInfo):Promise<void>;
+
+export function GetPrinterStatus(arg1:string):Promise<string>;
+
+export function LabelLanguage(arg1:string):Promise<string>;
+
 export function ListDevices():Promise<Array<peripherals.DeviceInfo>>;
 
 export function PrintProductLabel(arg1:number,arg2:string):Promise<void>;
 
+export function ReadScale(arg1:string):Promise<number>;
+
+export function RemoveDevice(arg1:string):Promise<void>;
+
 export function Startup(arg1:context.Context):Promise<void>;
+
+export function TestPrinter(arg1:string):Promise<void>;
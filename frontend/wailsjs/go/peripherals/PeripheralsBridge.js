@@ -2,6 +2,22 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AddPrinter(arg1) {
+  return window['go']['peripherals']['PeripheralsBridge']['AddPrinter'](arg1);
+}
+
+export function AddScale(arg1) {
+  return window['go']['peripherals']['PeripheralsBridge']['AddScale'](arg1);
+}
+
+export function GetPrinterStatus(arg1) {
+  return window['go']['peripherals']['PeripheralsBridge']['GetPrinterStatus'](arg1);
+}
+
+export function LabelLanguage(arg1) {
+  return window['go']['peripherals']['PeripheralsBridge']['LabelLanguage'](arg1);
+}
+
 export function ListDevices() {
   return window['go']['peripherals']['PeripheralsBridge']['ListDevices']();
 }
@@ -10,6 +26,18 @@ export function PrintProductLabel(arg1, arg2) {
   return window['go']['peripherals']['PeripheralsBridge']['PrintProductLabel'](arg1, arg2);
 }
 
+export function ReadScale(arg1) {
+  return window['go']['peripherals']['PeripheralsBridge']['ReadScale'](arg1);
+}
+
+export function RemoveDevice(arg1) {
+  return window['go']['peripherals']['PeripheralsBridge']['RemoveDevice'](arg1);
+}
+
 export function Startup(arg1) {
   return window['go']['peripherals']['PeripheralsBridge']['Startup'](arg1);
 }
+
+export function TestPrinter(arg1) {
+  return window['go']['peripherals']['PeripheralsBridge']['TestPrinter'](arg1);
+}
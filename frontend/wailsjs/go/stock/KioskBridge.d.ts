@@ -5,12 +5,66 @@ import {context} from '../models';
 
 export function Checkout(arg1:stock.SaleRequest):Promise<string>;
 
+export function CloseShift(arg1:number):Promise<stock.ShiftDTO>;
+
+export function FixOfflineSale(arg1:number,arg2:stock.SaleRequest):Promise<string>;
+
+export function GetBarcodeFormats():Promise<Array<stock.EmbeddedFormat>>;
+
+export function GetCurrentShift():Promise<stock.ShiftDTO>;
+
+export function GetCustomerDisplay():Promise<stock.DisplayConfig>;
+
+export function GetOfflineSales():Promise<Array<stock.OfflineSale>>;
+
+export function GetReceiptPrinter():Promise<string>;
+
+export function GetSale(arg1:string):Promise<stock.SaleDTO>;
+
+export function GetTaxCode():Promise<string>;
+
+export function GetWarehouse():Promise<number>;
+
+export function GetWeighingScale():Promise<string>;
+
+export function GetZReport(arg1:string):Promise<stock.ZReportDTO>;
+
 export function OpenDrawer():Promise<void>;
 
 export function OpenDrawerNoSale(arg1:string):Promise<void>;
 
+export function OpenShift(arg1:number):Promise<stock.ShiftDTO>;
+
 export function PrintReceipt(arg1:stock.SaleRequest):Promise<string>;
 
+export function ProcessReturn(arg1:stock.ReturnRequest):Promise<string>;
+
+export function RefreshDisplaySlides():Promise<void>;
+
 export function ReserveStock(arg1:number,arg2:number):Promise<number>;
 
+export function ScanBarcode(arg1:string):Promise<stock.ScanResult>;
+
+export function SetBarcodeFormats(arg1:Array<stock.EmbeddedFormat>):Promise<void>;
+
+export function SetCustomerDisplay(arg1:stock.DisplayConfig):Promise<void>;
+
+export function SetReceiptLogo(arg1:string):Promise<void>;
+
+export function SetReceiptPrinter(arg1:string):Promise<void>;
+
+export function SetTaxCountry(arg1:string):Promise<void>;
+
+export function SetWarehouse(arg1:number):Promise<void>;
+
+export function SetWeighingScale(arg1:string):Promise<void>;
+
 export function Startup(arg1:context.Context):Promise<void>;
+
+export function UpdateCustomerDisplay(arg1:stock.SaleRequest,arg2:string):Promise<void>;
+
+export function VoidOfflineSale(arg1:number,arg2:string):Promise<void>;
+
+export function Weigh(arg1:number):Promise<stock.WeighingDTO>;
+
+export function ZeroScale():Promise<void>;
@@ -6,6 +6,54 @@ export function Checkout(arg1) {
   return window['go']['stock']['KioskBridge']['Checkout'](arg1);
 }
 
+export function CloseShift(arg1) {
+  return window['go']['stock']['KioskBridge']['CloseShift'](arg1);
+}
+
+export function FixOfflineSale(arg1, arg2) {
+  return window['go']['stock']['KioskBridge']['FixOfflineSale'](arg1, arg2);
+}
+
+export function GetBarcodeFormats() {
+  return window['go']['stock']['KioskBridge']['GetBarcodeFormats']();
+}
+
+export function GetCurrentShift() {
+  return window['go']['stock']['KioskBridge']['GetCurrentShift']();
+}
+
+export function GetCustomerDisplay() {
+  return window['go']['stock']['KioskBridge']['GetCustomerDisplay']();
+}
+
+export function GetOfflineSales() {
+  return window['go']['stock']['KioskBridge']['GetOfflineSales']();
+}
+
+export function GetReceiptPrinter() {
+  return window['go']['stock']['KioskBridge']['GetReceiptPrinter']();
+}
+
+export function GetSale(arg1) {
+  return window['go']['stock']['KioskBridge']['GetSale'](arg1);
+}
+
+export function GetTaxCode() {
+  return window['go']['stock']['KioskBridge']['GetTaxCode']();
+}
+
+export function GetWarehouse() {
+  return window['go']['stock']['KioskBridge']['GetWarehouse']();
+}
+
+export function GetWeighingScale() {
+  return window['go']['stock']['KioskBridge']['GetWeighingScale']();
+}
+
+export function GetZReport(arg1) {
+  return window['go']['stock']['KioskBridge']['GetZReport'](arg1);
+}
+
 export function OpenDrawer() {
   return window['go']['stock']['KioskBridge']['OpenDrawer']();
 }
@@ -14,14 +62,74 @@ export function OpenDrawerNoSale(arg1) {
   return window['go']['stock']['KioskBridge']['OpenDrawerNoSale'](arg1);
 }
 
+export function OpenShift(arg1) {
+  return window['go']['stock']['KioskBridge']['OpenShift'](arg1);
+}
+
 export function PrintReceipt(arg1) {
   return window['go']['stock']['KioskBridge']['PrintReceipt'](arg1);
 }
 
+export function ProcessReturn(arg1) {
+  return window['go']['stock']['KioskBridge']['ProcessReturn'](arg1);
+}
+
+export function RefreshDisplaySlides() {
+  return window['go']['stock']['KioskBridge']['RefreshDisplaySlides']();
+}
+
 export function ReserveStock(arg1, arg2) {
   return window['go']['stock']['KioskBridge']['ReserveStock'](arg1, arg2);
 }
 
+export function ScanBarcode(arg1) {
+  return window['go']['stock']['KioskBridge']['ScanBarcode'](arg1);
+}
+
+export function SetBarcodeFormats(arg1) {
+  return window['go']['stock']['KioskBridge']['SetBarcodeFormats'](arg1);
+}
+
+export function SetCustomerDisplay(arg1) {
+  return window['go']['stock']['KioskBridge']['SetCustomerDisplay'](arg1);
+}
+
+export function SetReceiptLogo(arg1) {
+  return window['go']['stock']['KioskBridge']['SetReceiptLogo'](arg1);
+}
+
+export function SetReceiptPrinter(arg1) {
+  return window['go']['stock']['KioskBridge']['SetReceiptPrinter'](arg1);
+}
+
+export function SetTaxCountry(arg1) {
+  return window['go']['stock']['KioskBridge']['SetTaxCountry'](arg1);
+}
+
+export function SetWarehouse(arg1) {
+  return window['go']['stock']['KioskBridge']['SetWarehouse'](arg1);
+}
+
+export function SetWeighingScale(arg1) {
+  return window['go']['stock']['KioskBridge']['SetWeighingScale'](arg1);
+}
+
 export function Startup(arg1) {
   return window['go']['stock']['KioskBridge']['Startup'](arg1);
 }
+
+export function UpdateCustomerDisplay(arg1, arg2) {
+  return window['go']['stock']['KioskBridge']['UpdateCustomerDisplay'](arg1, arg2);
+}
+
+export function VoidOfflineSale(arg1, arg2) {
+  return window['go']['stock']['KioskBridge']['VoidOfflineSale'](arg1, arg2);
+}
+
+export function Weigh(arg1) {
+  return window['go']['stock']['KioskBridge']['Weigh'](arg1);
+}
+
+export function ZeroScale() {
+  return window['go']['stock']['KioskBridge']['ZeroScale']();
+}
@@ -20,6 +20,8 @@ export function CreatePurchaseOrder(arg1:number,arg2:Array<stock.PurchaseOrderLi
 
 export function CreateSupplier(arg1:string):Promise<string>;
 
+export function DeleteLabelTemplate(arg1:number):Promise<void>;
+
 export function DisposeAsset(arg1:number,arg2:string):Promise<void>;
 
 export function GenerateBarcode(arg1:string):Promise<string>;
@@ -36,6 +38,8 @@ export function GetExpiringWarranties(arg1:number):Promise<Array<stock.ProductDT
 
 export function GetHistory():Promise<Array<stock.StockMovementDTO>>;
 
+export function GetLabelTemplates():Promise<Array<stock.LabelTemplateDTO>>;
+
 export function GetPendingMaintenance():Promise<Array<stock.MaintenanceScheduleDTO>>;
 
 export function GetProductAssignments(arg1:number):Promise<Array<stock.AssignmentDTO>>;
@@ -50,14 +54,28 @@ export function ImportProducts(arg1:string):Promise<string>;
 
 export function MarkAlertRead(arg1:number):Promise<void>;
 
-export function ReceivePurchaseOrder(arg1:number):Promise<void>;
+export function PreviewLabel(arg1:stock.LabelTemplateDTO,arg2:number):Promise<string>;
+
+export function PrintLabels(arg1:stock.LabelPrintRequest):Promise<stock.LabelPrintResult>;
+
+export function ReceivePurchaseOrder(arg1:number,arg2:number):Promise<void>;
 
 export function RecordCount(arg1:number,arg2:number):Promise<void>;
 
 export function ReturnAsset(arg1:number,arg2:string):Promise<string>;
 
+export function RunReorder():Promise<Array<number>>;
+
+export function SaveLabelTemplate(arg1:stock.LabelTemplateDTO):Promise<number>;
+
 export function ScheduleMaintenance(arg1:number,arg2:time.Time,arg3:string):Promise<void>;
 
+export function SetProductTaxCategory(arg1:number,arg2:string):Promise<void>;
+
+export function SetSoldByWeight(arg1:number,arg2:number,arg3:number,arg4:string):Promise<void>;
+
 export function Startup(arg1:context.Context):Promise<void>;
 
+export function SubmitPurchaseOrder(arg1:number):Promise<void>;
+
 export function UpdateLocation(arg1:number,arg2:string):Promise<string>;
@@ -34,6 +34,10 @@ export function CreateSupplier(arg1) {
   return window['go']['stock']['StockBridge']['CreateSupplier'](arg1);
 }
 
+export function DeleteLabelTemplate(arg1) {
+  return window['go']['stock']['StockBridge']['DeleteLabelTemplate'](arg1);
+}
+
 export function DisposeAsset(arg1, arg2) {
   return window['go']['stock']['StockBridge']['DisposeAsset'](arg1, arg2);
 }
@@ -66,6 +70,10 @@ export function GetHistory() {
   return window['go']['stock']['StockBridge']['GetHistory']();
 }
 
+export function GetLabelTemplates() {
+  return window['go']['stock']['StockBridge']['GetLabelTemplates']();
+}
+
 export function GetPendingMaintenance() {
   return window['go']['stock']['StockBridge']['GetPendingMaintenance']();
 }
@@ -94,8 +102,16 @@ export function MarkAlertRead(arg1) {
   return window['go']['stock']['StockBridge']['MarkAlertRead'](arg1);
 }
 
-export function ReceivePurchaseOrder(arg1) {
-  return window['go']['stock']['StockBridge']['ReceivePurchaseOrder'](arg1);
+export function PreviewLabel(arg1, arg2) {
+  return window['go']['stock']['StockBridge']['PreviewLabel'](arg1, arg2);
+}
+
+export function PrintLabels(arg1) {
+  return window['go']['stock']['StockBridge']['PrintLabels'](arg1);
+}
+
+export function ReceivePurchaseOrder(arg1, arg2) {
+  return window['go']['stock']['StockBridge']['ReceivePurchaseOrder'](arg1, arg2);
 }
 
 export function RecordCount(arg1, arg2) {
@@ -106,14 +122,34 @@ export function ReturnAsset(arg1, arg2) {
   return window['go']['stock']['StockBridge']['ReturnAsset'](arg1, arg2);
 }
 
+export function RunReorder() {
+  return window['go']['stock']['StockBridge']['RunReorder']();
+}
+
+export function SaveLabelTemplate(arg1) {
+  return window['go']['stock']['StockBridge']['SaveLabelTemplate'](arg1);
+}
+
 export function ScheduleMaintenance(arg1, arg2, arg3) {
   return window['go']['stock']['StockBridge']['ScheduleMaintenance'](arg1, arg2, arg3);
 }
 
+export function SetProductTaxCategory(arg1, arg2) {
+  return window['go']['stock']['StockBridge']['SetProductTaxCategory'](arg1, arg2);
+}
+
+export function SetSoldByWeight(arg1, arg2, arg3, arg4) {
+  return window['go']['stock']['StockBridge']['SetSoldByWeight'](arg1, arg2, arg3, arg4);
+}
+
 export function Startup(arg1) {
   return window['go']['stock']['StockBridge']['Startup'](arg1);
 }
 
+export function SubmitPurchaseOrder(arg1) {
+  return window['go']['stock']['StockBridge']['SubmitPurchaseOrder'](arg1);
+}
+
 export function UpdateLocation(arg1, arg2) {
   return window['go']['stock']['StockBridge']['UpdateLocation'](arg1, arg2);
 }
@@ -1,14 +1,39 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {tax} from '../models';
+import {time} from '../models';
 import {context} from '../models';
 
-export function CalculateTax(arg1:number,arg2:string):Promise<tax.TaxResult>;
+export function AddTaxRate(arg1:number,arg2:number,arg3:time.Time,arg4:string):Promise<number>;
+
+export function CalculateTax(arg1:number,arg2:string,arg3:boolean):Promise<tax.TaxResult>;
+
+export function DeleteTaxRate(arg1:number):Promise<void>;
+
+export function ExportTaxReport(arg1:string,arg2:string,arg3:string):Promise<string>;
+
+export function GetEInvoiceCredential():Promise<tax.EInvoiceCredentialDTO>;
+
+export function GetEInvoiceXML(arg1:number):Promise<string>;
+
+export function GetEInvoices(arg1:number):Promise<Array<tax.EInvoiceDTO>>;
 
 export function GetTaxConfig():Promise<Array<tax.TaxConfig>>;
 
+export function GetTaxJurisdictions():Promise<Array<tax.TaxJurisdictionDTO>>;
+
+export function GetTaxRegister(arg1:string,arg2:string):Promise<Array<tax.TaxRegisterLineDTO>>;
+
 export function GetTaxSummary(arg1:string):Promise<tax.TaxSummaryDTO>;
 
+export function OnboardEInvoicing(arg1:tax.EInvoiceCredentialDTO):Promise<tax.EInvoiceCredentialDTO>;
+
+export function SaveEInvoiceCredential(arg1:tax.EInvoiceCredentialDTO):Promise<void>;
+
+export function SaveTaxJurisdiction(arg1:tax.TaxJurisdictionDTO):Promise<number>;
+
+export function SetTaxNumber(arg1:string):Promise<void>;
+
 export function SignInvoice(arg1:string):Promise<string>;
 
 export function Startup(arg1:context.Context):Promise<void>;
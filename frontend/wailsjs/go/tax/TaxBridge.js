@@ -2,18 +2,66 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
-export function CalculateTax(arg1, arg2) {
-  return window['go']['tax']['TaxBridge']['CalculateTax'](arg1, arg2);
+export function AddTaxRate(arg1, arg2, arg3, arg4) {
+  return window['go']['tax']['TaxBridge']['AddTaxRate'](arg1, arg2, arg3, arg4);
+}
+
+export function CalculateTax(arg1, arg2, arg3) {
+  return window['go']['tax']['TaxBridge']['CalculateTax'](arg1, arg2, arg3);
+}
+
+export function DeleteTaxRate(arg1) {
+  return window['go']['tax']['TaxBridge']['DeleteTaxRate'](arg1);
+}
+
+export function ExportTaxReport(arg1, arg2, arg3) {
+  return window['go']['tax']['TaxBridge']['ExportTaxReport'](arg1, arg2, arg3);
+}
+
+export function GetEInvoiceCredential() {
+  return window['go']['tax']['TaxBridge']['GetEInvoiceCredential']();
+}
+
+export function GetEInvoiceXML(arg1) {
+  return window['go']['tax']['TaxBridge']['GetEInvoiceXML'](arg1);
+}
+
+export function GetEInvoices(arg1) {
+  return window['go']['tax']['TaxBridge']['GetEInvoices'](arg1);
 }
 
 export function GetTaxConfig() {
   return window['go']['tax']['TaxBridge']['GetTaxConfig']();
 }
 
+export function GetTaxJurisdictions() {
+  return window['go']['tax']['TaxBridge']['GetTaxJurisdictions']();
+}
+
+export function GetTaxRegister(arg1, arg2) {
+  return window['go']['tax']['TaxBridge']['GetTaxRegister'](arg1, arg2);
+}
+
 export function GetTaxSummary(arg1) {
   return window['go']['tax']['TaxBridge']['GetTaxSummary'](arg1);
 }
 
+export function OnboardEInvoicing(arg1) {
+  return window['go']['tax']['TaxBridge']['OnboardEInvoicing'](arg1);
+}
+
+export function SaveEInvoiceCredential(arg1) {
+  return window['go']['tax']['TaxBridge']['SaveEInvoiceCredential'](arg1);
+}
+
+export function SaveTaxJurisdiction(arg1) {
+  return window['go']['tax']['TaxBridge']['SaveTaxJurisdiction'](arg1);
+}
+
+export function SetTaxNumber(arg1) {
+  return window['go']['tax']['TaxBridge']['SetTaxNumber'](arg1);
+}
+
 export function SignInvoice(arg1) {
   return window['go']['tax']['TaxBridge']['SignInvoice'](arg1);
 }
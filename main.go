@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"embed"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"os"
@@ -45,6 +46,11 @@ var assets embed.FS
 var (
 	mode    string
 	service string
+
+	ledgerTenant int
+	ledgerFix    bool
+
+	displayFeed string
 )
 
 // main is the entry point of the SENT application.
@@ -69,8 +75,31 @@ func main() {
 		Run:   runSeeding,
 	}
 
+	ledgerCmd := &cobra.Command{
+		Use:   "ledger",
+		Short: "General ledger maintenance",
+	}
+
+	ledgerVerifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Report drift between stored account balances and journal entries",
+		Run:   runLedgerVerify,
+	}
+	ledgerVerifyCmd.Flags().IntVar(&ledgerTenant, "tenant", 0, "Tenant ID to verify (default: all tenants)")
+	ledgerVerifyCmd.Flags().BoolVar(&ledgerFix, "fix", false, "Reset drifting balances to the ledger-derived figure")
+	ledgerCmd.AddCommand(ledgerVerifyCmd)
+
+	displayCmd := &cobra.Command{
+		Use:   "display",
+		Short: "Open the customer display of a kiosk in a window of its own",
+		Run:   runCustomerDisplay,
+	}
+	displayCmd.Flags().StringVar(&displayFeed, "feed", "http://"+stock.DefaultDisplayListen, "Customer display server of the kiosk")
+
 	rootCmd.AddCommand(validateCmd)
 	rootCmd.AddCommand(seedCmd)
+	rootCmd.AddCommand(ledgerCmd)
+	rootCmd.AddCommand(displayCmd)
 	rootCmd.PersistentFlags().StringVar(&mode, "mode", "client", "Application mode: 'client' (GUI) or 'worker' (Headless)")
 	rootCmd.PersistentFlags().StringVar(&service, "service", "", "Specific service to run in worker mode")
 
@@ -97,6 +126,63 @@ func runSeeding(cmd *cobra.Command, args []string) {
 	}
 }
 
+func runLedgerVerify(cmd *cobra.Command, args []string) {
+	dbClient := database.NewPostgresClient()
+	defer dbClient.Close()
+
+	ctx := context.Background()
+	tenantIDs := []int{ledgerTenant}
+	if ledgerTenant == 0 {
+		ids, err := dbClient.Tenant.Query().IDs(ctx)
+		if err != nil {
+			log.Fatalf("Failed to list tenants: %v", err)
+		}
+		tenantIDs = ids
+	}
+
+	total := 0
+	for _, id := range tenantIDs {
+		drifts, err := capital.VerifyBalances(ctx, dbClient, id, ledgerFix)
+		if err != nil {
+			log.Fatalf("Verification failed for tenant %d: %v", id, err)
+		}
+		for _, d := range drifts {
+			fmt.Printf("tenant %d  %-8s %-30s stored %14s  ledger %14s  drift %14s\n",
+				d.TenantID, d.AccountNumber, d.AccountName, d.Stored.StringFixed(2), d.Derived.StringFixed(2), d.Drift.StringFixed(2))
+		}
+		total += len(drifts)
+	}
+
+	switch {
+	case total == 0:
+		fmt.Printf("[LEDGER] %d tenant(s) verified. No drift found.\n", len(tenantIDs))
+	case ledgerFix:
+		fmt.Printf("[LEDGER] Corrected %d account balance(s).\n", total)
+	default:
+		fmt.Printf("[LEDGER] %d account(s) drift from the ledger. Re-run with --fix to correct.\n", total)
+		os.Exit(1)
+	}
+}
+
+// runCustomerDisplay shows a kiosk's customer display full screen, for the till's second
+// screen. It needs no database: everything comes from the kiosk's display server.
+func runCustomerDisplay(cmd *cobra.Command, args []string) {
+	err := wails.Run(&options.App{
+		Title:      "SENT Customer Display",
+		Width:      1280,
+		Height:     800,
+		Fullscreen: true,
+		Frameless:  true,
+		AssetServer: &assetserver.Options{
+			Handler: stock.DisplayWindowHandler(displayFeed),
+		},
+		BackgroundColour: &options.RGBA{R: 15, G: 23, B: 42, A: 255},
+	})
+	if err != nil {
+		log.Fatal("Error during customer display run: " + err.Error())
+	}
+}
+
 // runApp initializes core dependencies and starts the application in the selected mode.
 func runApp(cmd *cobra.Command, args []string) {
 	if mode == "agent" {
@@ -180,11 +266,18 @@ func runClient(db *ent.Client) {
 	kioskBridge := stock.NewKioskBridge(db, centralOrchestrator.GetClient(), securityAuditBridge, authBridge)
 	adminBridge := admin.NewAdminBridge(db, authBridge)
 	taxBridge := tax.NewTaxBridge(db, authBridge)
-	pulseBridge := bridge.NewPulseBridge(db)
+	taxBridge.SetCurrencyPlaces(capital.CurrencyPlaces)
+	pulseBridge := bridge.NewPulseBridge(db, authBridge)
 	
 	// Set River Client for Vault and Pulse
 	vaultBridge.SetRiverClient(centralOrchestrator.GetClient())
 	pulseBridge.SetRiverClient(centralOrchestrator.GetClient())
+	centralOrchestrator.SetAgentTransport(pulseBridge.AgentTransport())
+
+	// Invoices, statements and tax filings are filed in SENTvault
+	capitalBridge.SetDocumentStore(vaultBridge)
+	centralOrchestrator.SetDocumentStore(vaultBridge)
+	taxBridge.SetDocumentStore(vaultBridge)
 	
 	opticBridge := optic.NewOpticBridge(db, authBridge)
 	pilotBridge := pilot.NewPilotBridge(db, authBridge)
@@ -192,7 +285,49 @@ func runClient(db *ent.Client) {
 	nexusBridge := nexus.NewNexusBridge(db, authBridge)
 	horizonBridge := horizon.NewHorizonBridge(db, authBridge, vaultBridge)
 	waveBridge := wave.NewWaveBridge(db, authBridge)
-	peripheralsBridge := peripherals.NewPeripheralsBridge(db)
+	devices := peripherals.NewRegistry()
+	peripheralsBridge := peripherals.NewPeripheralsBridge(db, devices)
+
+	// Receipts and the cash drawer go through the configured printers
+	kioskBridge.SetPrinterLookup(func(id string) (stock.ReceiptPrinter, error) {
+		return devices.GetPrinter(id)
+	})
+	// Product labels go to the configured label printers in their own language
+	stockBridge.SetLabelPrinterLookup(func(id string) (stock.ReceiptPrinter, stock.LabelLanguage, error) {
+		p, err := devices.GetPrinter(id)
+		if err != nil {
+			return nil, "", err
+		}
+		lang, err := peripheralsBridge.LabelLanguage(id)
+		return p, lang, err
+	})
+	// Goods sold by weight are weighed on the configured scales
+	kioskBridge.SetScaleLookup(func(id string) (stock.WeighingScale, error) {
+		return devices.GetScale(id)
+	})
+	// The customer display's idle slideshow is a SENTvault folder
+	kioskBridge.SetSlideSource(func(folder string) ([]stock.Slide, error) {
+		files, err := vaultBridge.ListFiles(folder)
+		if err != nil {
+			return nil, err
+		}
+		var slides []stock.Slide
+		for _, f := range files {
+			if f.IsDir {
+				continue
+			}
+			content, err := vaultBridge.ReadFile(f.Path)
+			if err != nil {
+				return nil, err
+			}
+			data, err := base64.StdEncoding.DecodeString(content)
+			if err != nil {
+				return nil, err
+			}
+			slides = append(slides, stock.Slide{Name: f.Name, Data: data})
+		}
+		return slides, nil
+	})
 
 	// Configure and run the Wails application
 	err := wails.Run(&options.App{
@@ -209,6 +344,13 @@ func runClient(db *ent.Client) {
 			// Register Workers (KillSwitchWorker is already registered in control.RegisterStockHooks)
 			river.AddWorker(centralOrchestrator.Workers(), vault.NewOCRWorker(db))
 			river.AddWorker(centralOrchestrator.Workers(), stock.NewReservationReleaseWorker(db))
+			river.AddWorker(centralOrchestrator.Workers(), stock.NewReorderWorker(db))
+			if rc := centralOrchestrator.GetClient(); rc != nil {
+				// Nightly replenishment drafts POs for buyers to review in the morning
+				rc.PeriodicJobs().Add(river.NewPeriodicJob(stock.NightlySchedule{}, func() (river.JobArgs, *river.InsertOpts) {
+					return stock.ReorderArgs{}, nil
+				}, nil))
+			}
 
 			centralOrchestrator.Start(ctx)
 			systemBridge.Startup(ctx)
@@ -235,6 +377,9 @@ func runClient(db *ent.Client) {
 			fifoWorker := stock.NewFIFOWorker(db)
 			go fifoWorker.Run(ctx)
 
+			expiryWorker := stock.NewExpiryWorker(db)
+			go expiryWorker.Run(ctx)
+
 			opticStorage := optic.NewStorageManager(db)
 			auditObserver := optic.NewAuditObserver(db, opticStorage)
 			go auditObserver.Run(ctx)
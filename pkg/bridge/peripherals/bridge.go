@@ -2,11 +2,19 @@ package peripherals
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"sent/ent"
+	"sent/pkg/bridge/peripherals/escpos"
 	"sent/pkg/stock"
+	"time"
 )
 
+// devicesFile keeps the printers and scales configured on this terminal.
+const devicesFile = "peripherals.json"
+
 type PeripheralsBridge struct {
 	ctx            context.Context
 	db             *ent.Client
@@ -14,10 +22,12 @@ type PeripheralsBridge struct {
 	labelGenerator *stock.LabelGenerator
 }
 
-func NewPeripheralsBridge(db *ent.Client) *PeripheralsBridge {
+// NewPeripheralsBridge creates the bridge over a registry it shares with the till, which
+// prints receipts and kicks the drawer through it.
+func NewPeripheralsBridge(db *ent.Client, registry *Registry) *PeripheralsBridge {
 	return &PeripheralsBridge{
 		db:             db,
-		registry:       NewRegistry(),
+		registry:       registry,
 		labelGenerator: stock.NewLabelGenerator(),
 	}
 }
@@ -26,32 +36,156 @@ func (b *PeripheralsBridge) Startup(ctx context.Context) {
 	b.ctx = ctx
 	// Register a mock printer for industrialization testing
 	b.registry.RegisterPrinter(DeviceInfo{
-		ID:   "label-01",
-		Name: "Warehouse Zebra ZT411",
-		Type: DeviceTypePrinter,
-		Port: "/dev/ttyUSB0",
+		ID:       "label-01",
+		Name:     "Warehouse Zebra ZT411",
+		Type:     DeviceTypePrinter,
+		Port:     "/dev/ttyUSB0",
+		Language: LanguageZPL,
 	}, &MockSerialPrinter{Port: "/dev/ttyUSB0"})
+
+	if err := b.loadDevices(); err != nil {
+		fmt.Printf("[PERIPHERALS] Warning: failed to load %s: %v\n", devicesFile, err)
+	}
+}
+
+func (b *PeripheralsBridge) loadDevices() error {
+	data, err := os.ReadFile(devicesFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var devices []DeviceInfo
+	if err := json.Unmarshal(data, &devices); err != nil {
+		return err
+	}
+	for _, d := range devices {
+		add := b.registry.AddPrinter
+		if d.Type == DeviceTypeScale {
+			add = b.registry.AddScale
+		}
+		if err := add(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveDevices writes the configured devices; built-in devices have no transport and stay out.
+func (b *PeripheralsBridge) saveDevices() error {
+	var devices []DeviceInfo
+	for _, d := range b.registry.ListDevices() {
+		if d.Transport != "" {
+			devices = append(devices, d)
+		}
+	}
+	data, err := json.MarshalIndent(devices, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(devicesFile, data, 0o644)
+}
+
+// AddPrinter configures a serial or network printer on this terminal, replacing one with the
+// same ID.
+func (b *PeripheralsBridge) AddPrinter(info DeviceInfo) error {
+	if err := b.registry.AddPrinter(info); err != nil {
+		return err
+	}
+	return b.saveDevices()
+}
+
+// AddScale configures a serial scale on this terminal, replacing one with the same ID.
+func (b *PeripheralsBridge) AddScale(info DeviceInfo) error {
+	if err := b.registry.AddScale(info); err != nil {
+		return err
+	}
+	return b.saveDevices()
+}
+
+// RemoveDevice forgets a configured device.
+func (b *PeripheralsBridge) RemoveDevice(id string) error {
+	b.registry.Remove(id)
+	return b.saveDevices()
+}
+
+// GetPrinterStatus reports whether a printer is online.
+func (b *PeripheralsBridge) GetPrinterStatus(id string) (string, error) {
+	p, err := b.registry.GetPrinter(id)
+	if err != nil {
+		return "", err
+	}
+	return p.GetStatus()
+}
+
+// TestPrinter prints a short page to check the connection and the paper width.
+func (b *PeripheralsBridge) TestPrinter(id string) error {
+	p, err := b.registry.GetPrinter(id)
+	if err != nil {
+		return err
+	}
+	w := escpos.NewWriter().
+		Align(escpos.AlignCenter).
+		Bold(true).Line("SENT TEST PAGE").Bold(false).
+		Line(time.Now().Format("2006-01-02 15:04")).
+		Align(escpos.AlignLeft).
+		Line("123456789012345678901234567890123456789012345678").
+		Feed(3).
+		Cut()
+	return p.Print(w.Bytes())
+}
+
+// ReadScale returns the stable weight on a scale in kg, to check its connection and calibration.
+func (b *PeripheralsBridge) ReadScale(id string) (float64, error) {
+	s, err := b.registry.GetScale(id)
+	if err != nil {
+		return 0, err
+	}
+	return s.ReadWeight()
 }
 
 func (b *PeripheralsBridge) ListDevices() []DeviceInfo {
 	return b.registry.ListDevices()
 }
 
+// PrintProductLabel prints a product's label with its tenant's default template, in the
+// printer's language.
 func (b *PeripheralsBridge) PrintProductLabel(productID int, printerID string) error {
-	p, err := b.db.Product.Get(b.ctx, productID)
+	printer, err := b.registry.GetPrinter(printerID)
 	if err != nil {
 		return err
 	}
-
-	printer, err := b.registry.GetPrinter(printerID)
+	lang, err := b.LabelLanguage(printerID)
 	if err != nil {
 		return err
 	}
+	layout, data, err := stock.ProductLabel(b.ctx, b.db, productID)
+	if err != nil {
+		return err
+	}
+	job, err := b.labelGenerator.Render(layout, []stock.LabelCopies{{Data: data, Copies: 1}}, lang)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("[PERIPHERALS] Sending %s label to %s\n", lang, printerID)
+	return printer.Print(job)
+}
 
-	zpl := b.labelGenerator.GenerateZPL(p)
-	fmt.Printf("[PERIPHERALS] Sending ZPL to %s:\n%s\n", printerID, zpl)
-	
-	return printer.Print([]byte(zpl))
+// LabelLanguage returns the language labels are rendered in for a printer. Receipt printers
+// do not print labels.
+func (b *PeripheralsBridge) LabelLanguage(printerID string) (stock.LabelLanguage, error) {
+	lang, err := b.registry.Language(printerID)
+	if err != nil {
+		return "", err
+	}
+	switch lang {
+	case LanguageZPL:
+		return stock.LabelZPL, nil
+	case LanguageTSPL:
+		return stock.LabelTSPL, nil
+	}
+	return "", fmt.Errorf("printer %s prints receipts, not labels; set its language to zpl or tspl", printerID)
 }
 
 // MockSerialPrinter simulates a serial connection to an industrial printer
@@ -0,0 +1,208 @@
+// Package escpos encodes jobs for receipt printers speaking Epson's ESC/POS command set,
+// which nearly all thermal receipt printers understand.
+package escpos
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+)
+
+const (
+	esc = 0x1b
+	gs  = 0x1d
+	fs  = 0x1c
+	dle = 0x10
+	eot = 0x04
+)
+
+// Alignment of the following lines.
+const (
+	AlignLeft   = 0
+	AlignCenter = 1
+	AlignRight  = 2
+)
+
+// Paper is the printable width of a roll.
+type Paper struct {
+	Columns int // Characters per line in the default font
+	Dots    int // Dots per line, for images
+}
+
+var (
+	Paper80 = Paper{Columns: 48, Dots: 576}
+	Paper58 = Paper{Columns: 32, Dots: 384}
+)
+
+// StatusRequest asks the printer for its real-time status; it answers with one byte.
+var StatusRequest = []byte{dle, eot, 1}
+
+// Offline reports whether a status byte sent in reply to StatusRequest says the printer is
+// offline, e.g. with its cover open or out of paper.
+func Offline(status byte) bool {
+	return status&0x08 != 0
+}
+
+// Writer builds a print job. Its methods append commands and return the writer so a job
+// reads top to bottom.
+type Writer struct {
+	buf bytes.Buffer
+}
+
+// NewWriter starts a job with the printer reset and text sent as UTF-8.
+func NewWriter() *Writer {
+	w := &Writer{}
+	w.buf.Write([]byte{esc, '@'})
+	// FS ( C: select UTF-8; printers without it keep their code page.
+	w.buf.Write([]byte{fs, '(', 'C', 2, 0, 48, 2})
+	return w
+}
+
+// Bytes returns the job.
+func (w *Writer) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+// Align sets the alignment of the following lines.
+func (w *Writer) Align(a byte) *Writer {
+	w.buf.Write([]byte{esc, 'a', a})
+	return w
+}
+
+// Bold turns emphasized text on or off.
+func (w *Writer) Bold(on bool) *Writer {
+	w.buf.Write([]byte{esc, 'E', flag(on)})
+	return w
+}
+
+// Large turns double width and height on or off.
+func (w *Writer) Large(on bool) *Writer {
+	var n byte
+	if on {
+		n = 0x11
+	}
+	w.buf.Write([]byte{gs, '!', n})
+	return w
+}
+
+// Line prints a line of text.
+func (w *Writer) Line(s string) *Writer {
+	w.buf.WriteString(s)
+	w.buf.WriteByte('\n')
+	return w
+}
+
+// Feed advances the paper n lines.
+func (w *Writer) Feed(n int) *Writer {
+	w.buf.Write([]byte{esc, 'd', byte(n)})
+	return w
+}
+
+// Cut feeds the paper past the cutter and makes a partial cut.
+func (w *Writer) Cut() *Writer {
+	w.buf.Write([]byte{gs, 'V', 66, 0})
+	return w
+}
+
+// QR prints a QR code with the given module size in dots (1-16) and error correction M.
+func (w *Writer) QR(data string, size int) *Writer {
+	if size < 1 || size > 16 {
+		size = 6
+	}
+	w.buf.Write([]byte{gs, '(', 'k', 4, 0, 49, 65, 50, 0})      // Model 2
+	w.buf.Write([]byte{gs, '(', 'k', 3, 0, 49, 67, byte(size)}) // Module size
+	w.buf.Write([]byte{gs, '(', 'k', 3, 0, 49, 69, 49})         // Error correction M
+
+	// Store the data, then print it.
+	n := len(data) + 3
+	w.buf.Write([]byte{gs, '(', 'k', byte(n), byte(n >> 8), 49, 80, 48})
+	w.buf.WriteString(data)
+	w.buf.Write([]byte{gs, '(', 'k', 3, 0, 49, 81, 48})
+	return w
+}
+
+// Code128 prints a CODE128 barcode with its text underneath. Data is limited to 253 ASCII
+// characters; at the narrowest bar a 32-character code is about 400 dots wide.
+func (w *Writer) Code128(data string, height int) *Writer {
+	if len(data) > 253 {
+		data = data[:253]
+	}
+	w.buf.Write([]byte{gs, 'h', byte(height)}) // Bar height in dots
+	w.buf.Write([]byte{gs, 'w', 1})            // Narrowest bar, so UUIDs fit 80 mm paper
+	w.buf.Write([]byte{gs, 'H', 2})            // Text below the bars
+	w.buf.Write([]byte{gs, 'k', 73, byte(len(data) + 2), '{', 'B'})
+	w.buf.WriteString(data)
+	return w
+}
+
+// Image prints a picture as a raster bit image, scaled down to at most maxDots wide. Pixels
+// darker than mid grey are printed.
+func (w *Writer) Image(img image.Image, maxDots int) *Writer {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width == 0 || height == 0 {
+		return w
+	}
+	if width > maxDots {
+		height = height * maxDots / width
+		width = maxDots
+	}
+	rowBytes := (width + 7) / 8
+	w.buf.Write([]byte{gs, 'v', '0', 0, byte(rowBytes), byte(rowBytes >> 8), byte(height), byte(height >> 8)})
+	row := make([]byte, rowBytes)
+	for y := 0; y < height; y++ {
+		clear(row)
+		for x := 0; x < width; x++ {
+			src := img.At(b.Min.X+x*b.Dx()/width, b.Min.Y+y*b.Dy()/height)
+			if dark(src) {
+				row[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+		w.buf.Write(row)
+	}
+	return w
+}
+
+// DrawerKick returns the pulse that opens a cash drawer plugged into the printer, on
+// connector pin 2 or 5.
+func DrawerKick(pin int) []byte {
+	m := byte(0)
+	if pin == 5 {
+		m = 1
+	}
+	// 50 ms on, 500 ms off, in units of 2 ms.
+	return []byte{esc, 'p', m, 25, 250}
+}
+
+// Columns lays out a line with left text and right text at the edges of the given width,
+// cutting the left text short when both do not fit.
+func Columns(left, right string, width int) string {
+	l, r := []rune(left), []rune(right)
+	space := width - len(l) - len(r)
+	if space < 1 {
+		keep := width - len(r) - 1
+		if keep < 0 {
+			keep = 0
+		}
+		l = l[:min(keep, len(l))]
+		space = width - len(l) - len(r)
+		if space < 1 {
+			space = 1
+		}
+	}
+	return fmt.Sprintf("%s%*s%s", string(l), space, "", string(r))
+}
+
+func flag(on bool) byte {
+	if on {
+		return 1
+	}
+	return 0
+}
+
+func dark(c color.Color) bool {
+	g := color.GrayModel.Convert(c).(color.Gray)
+	_, _, _, a := c.RGBA()
+	return a > 0x7fff && g.Y < 128
+}
@@ -0,0 +1,63 @@
+package escpos
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	job := NewWriter().QR("hello", 4).Code128("ab12", 60).Bytes()
+
+	if !bytes.HasPrefix(job, []byte{esc, '@'}) {
+		t.Errorf("job does not reset the printer: % x", job[:2])
+	}
+	// The stored QR data is prefixed with its length plus three.
+	if !bytes.Contains(job, append([]byte{gs, '(', 'k', 8, 0, 49, 80, 48}, "hello"...)) {
+		t.Errorf("QR data not stored: % x", job)
+	}
+	if !bytes.Contains(job, append([]byte{gs, 'k', 73, 6, '{', 'B'}, "ab12"...)) {
+		t.Errorf("CODE128 not encoded in code set B: % x", job)
+	}
+}
+
+func TestImage(t *testing.T) {
+	// 10x2: the first row is black on the left half, the second is white.
+	img := image.NewGray(image.Rect(0, 0, 10, 2))
+	for x := 0; x < 10; x++ {
+		img.SetGray(x, 1, color.Gray{Y: 255})
+		if x < 5 {
+			img.SetGray(x, 0, color.Gray{})
+		} else {
+			img.SetGray(x, 0, color.Gray{Y: 255})
+		}
+	}
+
+	w := &Writer{}
+	got := w.Image(img, 576).Bytes()
+	want := []byte{gs, 'v', '0', 0, 2, 0, 2, 0, 0xf8, 0x00, 0x00, 0x00}
+	if !bytes.Equal(got, want) {
+		t.Errorf("raster = % x, want % x", got, want)
+	}
+
+	// Wider than the paper: halved to 5 dots by 1, keeping the black left half.
+	w = &Writer{}
+	got = w.Image(img, 5).Bytes()
+	want = []byte{gs, 'v', '0', 0, 1, 0, 1, 0, 0xe0}
+	if !bytes.Equal(got, want) {
+		t.Errorf("scaled raster = % x, want % x", got, want)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	if got := Columns("TOTAL", "14.40", 12); got != "TOTAL  14.40" {
+		t.Errorf("Columns = %q", got)
+	}
+	if got := Columns("Chocolate bar", "3.00", 12); got != "Chocola 3.00" {
+		t.Errorf("long left = %q", got)
+	}
+	if got := Columns("زيت", "1.00", 8); got != "زيت 1.00" {
+		t.Errorf("Arabic = %q, want runes counted, not bytes", got)
+	}
+}
@@ -0,0 +1,77 @@
+package peripherals
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"sent/pkg/bridge/peripherals/escpos"
+)
+
+// RawPrintPort is the port network printers take raw jobs on (JetDirect / AppSocket).
+const RawPrintPort = "9100"
+
+const networkTimeout = 5 * time.Second
+
+// NetworkPrinter sends jobs to a printer on the network over a raw TCP connection.
+type NetworkPrinter struct {
+	Addr    string // host or host:port; the port defaults to 9100
+	Timeout time.Duration
+}
+
+func (p *NetworkPrinter) dial() (net.Conn, error) {
+	addr := p.Addr
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, RawPrintPort)
+	}
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = networkTimeout
+	}
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("printer %s unreachable: %w", addr, err)
+	}
+	conn.SetDeadline(time.Now().Add(timeout))
+	return conn, nil
+}
+
+// Print sends one job. The printer starts on it once the connection closes.
+func (p *NetworkPrinter) Print(data []byte) error {
+	conn, err := p.dial()
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Write(data); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to send job to %s: %w", p.Addr, err)
+	}
+	return conn.Close()
+}
+
+// GetStatus asks the printer for its real-time status. A printer that accepts the connection
+// but does not answer is taken to be online.
+func (p *NetworkPrinter) GetStatus() (string, error) {
+	conn, err := p.dial()
+	if err != nil {
+		return "Offline", err
+	}
+	defer conn.Close()
+	if _, err := conn.Write(escpos.StatusRequest); err != nil {
+		return "Offline", err
+	}
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	status := make([]byte, 1)
+	if _, err := conn.Read(status); err != nil {
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return "Online", nil
+		}
+		return "Offline", err
+	}
+	if escpos.Offline(status[0]) {
+		return "Offline", nil
+	}
+	return "Online", nil
+}
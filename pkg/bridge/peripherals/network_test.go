@@ -0,0 +1,97 @@
+package peripherals
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"sent/pkg/bridge/peripherals/escpos"
+)
+
+// fakePrinter listens like a network printer, one connection at a time, records each job and
+// answers status requests with the given byte.
+func fakePrinter(t *testing.T, status byte) (string, <-chan []byte) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	jobs := make(chan []byte, 4)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.SetDeadline(time.Now().Add(5 * time.Second))
+			head := make([]byte, len(escpos.StatusRequest))
+			n, _ := io.ReadFull(conn, head)
+			if bytes.Equal(head[:n], escpos.StatusRequest) {
+				conn.Write([]byte{status})
+			} else {
+				rest, _ := io.ReadAll(conn)
+				jobs <- append(head[:n], rest...)
+			}
+			conn.Close()
+		}
+	}()
+	return ln.Addr().String(), jobs
+}
+
+func TestNetworkPrinter(t *testing.T) {
+	addr, jobs := fakePrinter(t, 0x12)
+	r := NewRegistry()
+	if err := r.AddPrinter(DeviceInfo{ID: "receipt", Transport: TransportNetwork, Port: addr}); err != nil {
+		t.Fatal(err)
+	}
+	p, err := r.GetPrinter("receipt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := escpos.NewWriter().Line("TOTAL 14.40").Cut().Bytes()
+	if err := p.Print(job); err != nil {
+		t.Fatalf("Print: %v", err)
+	}
+	if err := p.Print(escpos.DrawerKick(2)); err != nil {
+		t.Fatalf("drawer kick: %v", err)
+	}
+	for _, want := range [][]byte{job, escpos.DrawerKick(2)} {
+		select {
+		case got := <-jobs:
+			if !bytes.Equal(got, want) {
+				t.Errorf("printer received % x, want % x", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("printer received nothing")
+		}
+	}
+
+	if status, err := p.GetStatus(); err != nil || status != "Online" {
+		t.Errorf("status = %q, %v", status, err)
+	}
+}
+
+func TestNetworkPrinterOffline(t *testing.T) {
+	// Cover open: the printer answers with the offline bit set.
+	addr, _ := fakePrinter(t, 0x1a)
+	p := &NetworkPrinter{Addr: addr}
+	if status, _ := p.GetStatus(); status != "Offline" {
+		t.Errorf("status = %q, want Offline", status)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := ln.Addr().String()
+	ln.Close()
+	p = &NetworkPrinter{Addr: closed, Timeout: time.Second}
+	if err := p.Print([]byte("x")); err == nil {
+		t.Error("printing to a closed port succeeded")
+	}
+}
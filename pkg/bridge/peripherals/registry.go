@@ -2,6 +2,7 @@ package peripherals
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 )
 
@@ -12,13 +13,32 @@ const (
 	DeviceTypeScale   DeviceType = "scale"
 )
 
+// Transport is how a device is connected.
+type Transport string
+
+const (
+	TransportSerial  Transport = "serial"
+	TransportNetwork Transport = "network"
+)
+
 type DeviceInfo struct {
-	ID   string     `json:"id"`
-	Name string     `json:"name"`
-	Type DeviceType `json:"type"`
-	Port string     `json:"port"` // /dev/ttyUSB0 or COM1
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Type      DeviceType `json:"type"`
+	Transport Transport  `json:"transport,omitempty"` // Serial when empty
+	Port      string     `json:"port"`                // /dev/ttyUSB0 or COM1, or host:port on the network
+	Baud      int        `json:"baud,omitempty"`      // Serial speed, 9600 when empty
+	Protocol  string     `json:"protocol,omitempty"`  // Scales: cas or toledo
+	Language  string     `json:"language,omitempty"`  // Printers: escpos, zpl or tspl; worked out from the name when empty
 }
 
+// Printer languages.
+const (
+	LanguageESCPOS = "escpos"
+	LanguageZPL    = "zpl"
+	LanguageTSPL   = "tspl"
+)
+
 type Printer interface {
 	Print(data []byte) error
 	GetStatus() (string, error)
@@ -30,8 +50,8 @@ type Scale interface {
 }
 
 type Registry struct {
-	mu      sync.RWMutex
-	devices map[string]DeviceInfo
+	mu       sync.RWMutex
+	devices  map[string]DeviceInfo
 	printers map[string]Printer
 	scales   map[string]Scale
 }
@@ -51,6 +71,38 @@ func (r *Registry) RegisterPrinter(info DeviceInfo, p Printer) {
 	r.printers[info.ID] = p
 }
 
+// AddPrinter registers a printer on the backend its transport calls for.
+func (r *Registry) AddPrinter(info DeviceInfo) error {
+	if info.ID == "" || info.Port == "" {
+		return fmt.Errorf("a printer needs an ID and a port")
+	}
+	info.Type = DeviceTypePrinter
+	switch info.Language {
+	case "", LanguageESCPOS, LanguageZPL, LanguageTSPL:
+	default:
+		return fmt.Errorf("unknown printer language %q", info.Language)
+	}
+	switch info.Transport {
+	case TransportNetwork:
+		r.RegisterPrinter(info, &NetworkPrinter{Addr: info.Port})
+	case TransportSerial, "":
+		info.Transport = TransportSerial
+		r.RegisterPrinter(info, &SerialPrinter{Port: info.Port, Baud: info.Baud})
+	default:
+		return fmt.Errorf("unknown transport %q", info.Transport)
+	}
+	return nil
+}
+
+// Remove forgets a device.
+func (r *Registry) Remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.devices, id)
+	delete(r.printers, id)
+	delete(r.scales, id)
+}
+
 func (r *Registry) RegisterScale(info DeviceInfo, s Scale) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -58,6 +110,25 @@ func (r *Registry) RegisterScale(info DeviceInfo, s Scale) {
 	r.scales[info.ID] = s
 }
 
+// AddScale registers a scale that streams its weight over a serial port.
+func (r *Registry) AddScale(info DeviceInfo) error {
+	if info.ID == "" || info.Port == "" {
+		return fmt.Errorf("a scale needs an ID and a port")
+	}
+	switch info.Protocol {
+	case ProtocolCAS, ProtocolToledo:
+	default:
+		return fmt.Errorf("unknown scale protocol %q", info.Protocol)
+	}
+	if info.Transport != "" && info.Transport != TransportSerial {
+		return fmt.Errorf("scales are read over a serial port")
+	}
+	info.Type = DeviceTypeScale
+	info.Transport = TransportSerial
+	r.RegisterScale(info, &SerialScale{Port: info.Port, Baud: info.Baud, Protocol: info.Protocol})
+	return nil
+}
+
 func (r *Registry) GetPrinter(id string) (Printer, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -68,6 +139,38 @@ func (r *Registry) GetPrinter(id string) (Printer, error) {
 	return p, nil
 }
 
+// Language returns the language a printer speaks: the configured one, or else ZPL for Zebra
+// and TSPL for TSC printers by name, and ESC/POS for the rest.
+func (r *Registry) Language(id string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, ok := r.devices[id]
+	if !ok || d.Type != DeviceTypePrinter {
+		return "", fmt.Errorf("printer %s not found", id)
+	}
+	if d.Language != "" {
+		return d.Language, nil
+	}
+	name := strings.ToLower(d.Name)
+	switch {
+	case strings.Contains(name, "zebra"):
+		return LanguageZPL, nil
+	case strings.Contains(name, "tsc"):
+		return LanguageTSPL, nil
+	}
+	return LanguageESCPOS, nil
+}
+
+func (r *Registry) GetScale(id string) (Scale, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.scales[id]
+	if !ok {
+		return nil, fmt.Errorf("scale %s not found", id)
+	}
+	return s, nil
+}
+
 func (r *Registry) ListDevices() []DeviceInfo {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
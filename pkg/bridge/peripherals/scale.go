@@ -0,0 +1,212 @@
+package peripherals
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Scale protocols: the continuous output formats the driver reads.
+const (
+	ProtocolCAS    = "cas"    // CAS ASCII lines, e.g. "ST,GS,+  1.234kg"
+	ProtocolToledo = "toledo" // Mettler Toledo continuous output: STX, three status bytes, weight, tare, CR
+)
+
+var (
+	// ErrUnstable means the goods did not settle on the scale in time.
+	ErrUnstable = errors.New("weight is not stable")
+	// ErrOverload means the load is off the scale's range, over capacity or below zero.
+	ErrOverload = errors.New("weight out of range")
+)
+
+const scaleTimeout = 3 * time.Second
+
+// reading is one weight a scale reported.
+type reading struct {
+	kg     float64
+	stable bool
+}
+
+// SerialScale reads a scale that streams its weight over a serial port.
+type SerialScale struct {
+	Port     string
+	Baud     int
+	Protocol string        // cas or toledo
+	Timeout  time.Duration // How long to wait for the weight to settle
+}
+
+func (s *SerialScale) open() (io.ReadWriteCloser, error) {
+	baud := s.Baud
+	if baud == 0 {
+		baud = DefaultBaud
+	}
+	port, err := openSerial(s.Port, baud)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", s.Port, err)
+	}
+	return port, nil
+}
+
+// ReadWeight returns the weight in kg once the scale reports it stable.
+func (s *SerialScale) ReadWeight() (float64, error) {
+	port, err := s.open()
+	if err != nil {
+		return 0, err
+	}
+	defer port.Close()
+	timeout := s.Timeout
+	if timeout == 0 {
+		timeout = scaleTimeout
+	}
+	return readStableWeight(port, s.Protocol, time.Now().Add(timeout))
+}
+
+// Zero sets the empty scale to zero.
+func (s *SerialScale) Zero() error {
+	port, err := s.open()
+	if err != nil {
+		return err
+	}
+	defer port.Close()
+	// Both protocols take Z as the zero command.
+	if _, err := port.Write([]byte("Z\r\n")); err != nil {
+		return fmt.Errorf("failed to zero scale on %s: %w", s.Port, err)
+	}
+	return nil
+}
+
+// readStableWeight reads frames until two stable readings in a row agree, so a weight caught
+// the moment the scale reports it settled is confirmed by the next frame.
+func readStableWeight(r io.Reader, protocol string, deadline time.Time) (float64, error) {
+	br := bufio.NewReader(&waitReader{r: r, deadline: deadline})
+	var last *reading
+	for time.Now().Before(deadline) {
+		frame, err := readFrame(br, protocol)
+		if err == io.EOF {
+			return 0, ErrUnstable
+		}
+		if err != nil {
+			return 0, err
+		}
+		rd, err := parseFrame(frame, protocol)
+		if err != nil {
+			return 0, err
+		}
+		if !rd.stable {
+			last = nil
+			continue
+		}
+		if last != nil && last.kg == rd.kg {
+			return rd.kg, nil
+		}
+		last = &rd
+	}
+	return 0, ErrUnstable
+}
+
+// waitReader retries reads that time out empty, which the port reports as EOF, until the
+// deadline, so a frame split across them is read whole.
+type waitReader struct {
+	r        io.Reader
+	deadline time.Time
+}
+
+func (w *waitReader) Read(p []byte) (int, error) {
+	for {
+		n, err := w.r.Read(p)
+		if n > 0 || err != io.EOF || !time.Now().Before(w.deadline) {
+			return n, err
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// readFrame reads up to the end of the next frame. Toledo frames end in CR and may carry a
+// checksum byte after it, which is left for the next frame's search for STX to skip.
+func readFrame(br *bufio.Reader, protocol string) ([]byte, error) {
+	switch protocol {
+	case ProtocolToledo:
+		if _, err := br.ReadBytes(0x02); err != nil {
+			return nil, err
+		}
+		return br.ReadBytes('\r')
+	case ProtocolCAS, "":
+		return br.ReadBytes('\n')
+	}
+	return nil, fmt.Errorf("unknown scale protocol %q", protocol)
+}
+
+func parseFrame(frame []byte, protocol string) (reading, error) {
+	if protocol == ProtocolToledo {
+		return parseToledo(frame)
+	}
+	return parseCAS(frame)
+}
+
+// parseCAS reads "ST,GS,+  1.234kg": stable (ST) or unstable (US) or overload (OL), gross
+// (GS) or net (NT), then the signed weight and its unit.
+func parseCAS(frame []byte) (reading, error) {
+	fields := strings.Split(strings.TrimSpace(string(frame)), ",")
+	if len(fields) < 3 {
+		return reading{}, fmt.Errorf("unreadable scale output %q", frame)
+	}
+	if fields[0] == "OL" {
+		return reading{}, ErrOverload
+	}
+	value := strings.ReplaceAll(fields[len(fields)-1], " ", "")
+	unit := strings.TrimLeft(value, "+-0123456789.")
+	kg, err := strconv.ParseFloat(strings.TrimSuffix(value, unit), 64)
+	if err != nil {
+		return reading{}, fmt.Errorf("unreadable scale weight %q", value)
+	}
+	switch strings.ToLower(unit) {
+	case "kg", "":
+	case "g":
+		kg /= 1000
+	case "lb":
+		kg *= 0.45359237
+	default:
+		return reading{}, fmt.Errorf("unknown scale unit %q", unit)
+	}
+	return reading{kg: kg, stable: fields[0] == "ST"}, nil
+}
+
+// parseToledo reads the frame after STX: status words A, B and C, six digits of weight and
+// six of tare, then CR. Word A holds the decimal point position; word B the sign, range,
+// motion and unit flags.
+func parseToledo(frame []byte) (reading, error) {
+	frame = bytes.TrimSuffix(frame, []byte{'\r'})
+	if len(frame) < 9 {
+		return reading{}, fmt.Errorf("short scale frame % x", frame)
+	}
+	swa, swb := frame[0], frame[1]
+	if swb&0x04 != 0 {
+		return reading{}, ErrOverload
+	}
+	digits := strings.TrimSpace(string(frame[3:9]))
+	raw, err := strconv.Atoi(digits)
+	if err != nil {
+		return reading{}, fmt.Errorf("unreadable scale weight %q", digits)
+	}
+	// Codes 0 to 7 put the decimal point from two places right of the digits (×100) to five
+	// places into them (×0.00001).
+	kg := float64(raw)
+	for code := int(swa & 0x07); code < 2; code++ {
+		kg *= 10
+	}
+	for code := int(swa & 0x07); code > 2; code-- {
+		kg /= 10
+	}
+	if swb&0x02 != 0 {
+		kg = -kg
+	}
+	if swb&0x10 == 0 {
+		kg *= 0.45359237 // Weighing in pounds
+	}
+	return reading{kg: kg, stable: swb&0x08 == 0}, nil
+}
@@ -0,0 +1,52 @@
+package peripherals
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadStableWeightCAS(t *testing.T) {
+	// The goods settle after a moment: the first stable frame is confirmed by the next one.
+	out := "US,GS,+  0.870kg\r\nST,GS,+  1.240kg\r\nUS,GS,+  1.236kg\r\nST,GS,+  1.234kg\r\nST,GS,+  1.234kg\r\n"
+	kg, err := readStableWeight(strings.NewReader(out), ProtocolCAS, time.Now().Add(time.Second))
+	if err != nil || kg != 1.234 {
+		t.Errorf("weight = %v, %v; want 1.234", kg, err)
+	}
+
+	kg, err = readStableWeight(strings.NewReader("ST,NT,+   850 g\r\nST,NT,+   850 g\r\n"), ProtocolCAS, time.Now().Add(time.Second))
+	if err != nil || kg != 0.85 {
+		t.Errorf("grams = %v, %v; want 0.85", kg, err)
+	}
+
+	_, err = readStableWeight(strings.NewReader("US,GS,+  1.2kg\r\nUS,GS,+  1.3kg\r\n"), ProtocolCAS, time.Now().Add(50*time.Millisecond))
+	if !errors.Is(err, ErrUnstable) {
+		t.Errorf("moving scale: err = %v, want ErrUnstable", err)
+	}
+
+	_, err = readStableWeight(strings.NewReader("OL,GS,+  ------kg\r\n"), ProtocolCAS, time.Now().Add(time.Second))
+	if !errors.Is(err, ErrOverload) {
+		t.Errorf("overload: err = %v, want ErrOverload", err)
+	}
+}
+
+func TestParseToledo(t *testing.T) {
+	// Word A 0x25: three decimals. Word B 0x30: gross, positive, in range, still, kg.
+	frame := "\x02\x25\x30\x20001234000000\r"
+	kg, err := readStableWeight(strings.NewReader(frame+frame), ProtocolToledo, time.Now().Add(time.Second))
+	if err != nil || kg != 1.234 {
+		t.Errorf("weight = %v, %v; want 1.234", kg, err)
+	}
+
+	// In motion, in pounds with two decimals.
+	rd, err := parseToledo([]byte("\x24\x28\x20000250000000\r"))
+	if err != nil || rd.stable || math.Abs(rd.kg-2.5*0.45359237) > 1e-9 {
+		t.Errorf("reading = %+v, %v", rd, err)
+	}
+
+	if _, err := parseToledo([]byte("\x25\x34\x20999999000000\r")); !errors.Is(err, ErrOverload) {
+		t.Errorf("out of range: err = %v, want ErrOverload", err)
+	}
+}
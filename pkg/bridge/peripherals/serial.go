@@ -0,0 +1,55 @@
+package peripherals
+
+import (
+	"fmt"
+
+	"sent/pkg/bridge/peripherals/escpos"
+)
+
+// DefaultBaud is the speed receipt printers ship with on their serial port.
+const DefaultBaud = 9600
+
+// SerialPrinter sends jobs to a printer on a serial port, 8N1. The port is opened for each
+// job so another program can use the printer in between.
+type SerialPrinter struct {
+	Port string // /dev/ttyUSB0 or COM1
+	Baud int
+}
+
+func (p *SerialPrinter) baud() int {
+	if p.Baud == 0 {
+		return DefaultBaud
+	}
+	return p.Baud
+}
+
+// Print writes one job to the port.
+func (p *SerialPrinter) Print(data []byte) error {
+	port, err := openSerial(p.Port, p.baud())
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", p.Port, err)
+	}
+	if _, err := port.Write(data); err != nil {
+		port.Close()
+		return fmt.Errorf("failed to send job to %s: %w", p.Port, err)
+	}
+	return port.Close()
+}
+
+// GetStatus asks the printer for its real-time status. Printers wired without the receive
+// line never answer; those are taken to be online if the port opens.
+func (p *SerialPrinter) GetStatus() (string, error) {
+	port, err := openSerial(p.Port, p.baud())
+	if err != nil {
+		return "Offline", err
+	}
+	defer port.Close()
+	if _, err := port.Write(escpos.StatusRequest); err != nil {
+		return "Offline", err
+	}
+	status := make([]byte, 1)
+	if n, _ := port.Read(status); n == 1 && escpos.Offline(status[0]) {
+		return "Offline", nil
+	}
+	return "Online", nil
+}
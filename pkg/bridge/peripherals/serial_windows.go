@@ -0,0 +1,51 @@
+//go:build windows
+
+package peripherals
+
+import (
+	"io"
+	"os"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// openSerial opens a COM port at 8N1. Reads give up after a second without data.
+func openSerial(port string, baud int) (io.ReadWriteCloser, error) {
+	// COM10 and up are only reachable through the device namespace.
+	path := port
+	if !strings.HasPrefix(path, `\\.\`) {
+		path = `\\.\` + path
+	}
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+	h, err := windows.CreateFile(name, windows.GENERIC_READ|windows.GENERIC_WRITE, 0, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var dcb windows.DCB
+	dcb.DCBlength = uint32(unsafe.Sizeof(dcb))
+	if err := windows.GetCommState(h, &dcb); err != nil {
+		windows.CloseHandle(h)
+		return nil, err
+	}
+	dcb.BaudRate = uint32(baud)
+	dcb.Flags = 1 // fBinary, no flow control
+	dcb.ByteSize = 8
+	dcb.Parity = windows.NOPARITY
+	dcb.StopBits = windows.ONESTOPBIT
+	if err := windows.SetCommState(h, &dcb); err != nil {
+		windows.CloseHandle(h)
+		return nil, err
+	}
+	timeouts := windows.CommTimeouts{ReadTotalTimeoutConstant: 1000, WriteTotalTimeoutConstant: 5000}
+	if err := windows.SetCommTimeouts(h, &timeouts); err != nil {
+		windows.CloseHandle(h)
+		return nil, err
+	}
+	return os.NewFile(uintptr(h), port), nil
+}
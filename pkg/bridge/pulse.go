@@ -2,11 +2,30 @@ package bridge
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
     "net/http"
+	"os"
+	"strconv"
+	"time"
+
 	"sent/ent"
+	entagent "sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/job"
+	"sent/ent/jobexecution"
+	"sent/ent/tenant"
+	"sent/pkg/auth"
+	"sent/pkg/database"
 	"sent/pkg/pulse"
 	"sent/pkg/pulse/agent"
     "sent/pkg/pulse/common"
+	"sent/pkg/pulse/enrollment"
+	"sent/pkg/pulse/inventory"
+	"sent/pkg/pulse/patching"
+	"sent/pkg/pulse/rpc"
+	"sent/pkg/pulse/scheduler"
+	"sent/pkg/pulse/targeting"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
@@ -19,9 +38,22 @@ type PulseBridge struct {
 	worker  *pulse.PulseWorker
     scripts *pulse.ScriptManager
     jobs    *pulse.JobManager
+	groups  *pulse.GroupManager
+	// policies and alerts are patch policies and alert rules, which target agents like jobs.
+	policies *pulse.PatchPolicyManager
+	alerts   *pulse.AlertManager
+	// agents carries device actions to the agent they are for.
+	agents *rpc.Caller
+	// enrollment admits agents and issues their credentials; nil, with enrollmentErr saying
+	// why, when its secrets are not configured.
+	enrollment    *enrollment.Service
+	enrollmentErr error
+	auth          *auth.AuthBridge
+	// river queues job runs.
+	river *river.Client[pgx.Tx]
 }
 
-func NewPulseBridge(db *ent.Client) *PulseBridge {
+func NewPulseBridge(db *ent.Client, authBridge *auth.AuthBridge) *PulseBridge {
 	// For MVP, we pass nil for river client if not easily available in bridge layer.
 	// In production, this should be initialized in main and passed down.
 	manager := pulse.NewPulseManager(db, nil)
@@ -29,6 +61,10 @@ func NewPulseBridge(db *ent.Client) *PulseBridge {
 	
     scripts := pulse.NewScriptManager(db)
     jobs := pulse.NewJobManager(db)
+	enrol, enrolErr := enrollment.NewServiceFromEnv(db)
+	if enrolErr != nil {
+		fmt.Printf("[BRIDGE] Pulse agent enrollment disabled: %v\n", enrolErr)
+	}
 	
 	return &PulseBridge{
 		db:      db,
@@ -36,15 +72,27 @@ func NewPulseBridge(db *ent.Client) *PulseBridge {
 		worker:  worker,
         scripts: scripts,
         jobs:    jobs,
+		groups:  pulse.NewGroupManager(db),
+		policies: pulse.NewPatchPolicyManager(db),
+		alerts:   pulse.NewAlertManager(db),
+		agents:  rpc.NewCaller(worker.Transport()),
+		enrollment:    enrol,
+		enrollmentErr: enrolErr,
+		auth:          authBridge,
 	}
 }
 
 func (b *PulseBridge) SetRiverClient(r *river.Client[pgx.Tx]) {
+	b.river = r
 	if b.manager != nil {
 		b.manager.SetRiverClient(r)
 	}
 }
 
+// AgentTransport is the console's connection to the agent hub, for workers that talk to agents.
+func (b *PulseBridge) AgentTransport() rpc.Transport {
+	return b.worker.Transport()
+}
 
 func (b *PulseBridge) Startup(ctx context.Context) {
 	b.ctx = ctx
@@ -57,37 +105,200 @@ func (b *PulseBridge) Startup(ctx context.Context) {
         mux.HandleFunc("/api/pulse/terminal/", b.HandleTerminalConnect)
         mux.HandleFunc("/rdp/stream", b.HandleRDPStream)
         mux.HandleFunc("/rdp/view", b.HandleRDPView)
-        if err := http.ListenAndServe(":8000", mux); err != nil {
-            // Log but don't crash main app
-           // fmt.Printf("[BRIDGE] WS Server failed: %v\n", err)
-        }
+		if b.enrollment != nil {
+			agents := b.enrollment.Handler()
+			mux.Handle("/api/pulse/enroll", agents)
+			mux.Handle("/api/pulse/agent/", agents)
+		}
+		if err := b.listen(mux); err != nil {
+			fmt.Printf("[BRIDGE] Pulse server failed: %v\n", err)
+		}
     }()
 }
 
-// GetAgents returns a list of registered agents.
+// listen serves agents on :8000, over TLS asking for their certificates when
+// SENT_PULSE_TLS_CERT and SENT_PULSE_TLS_KEY name the server's certificate and key.
+func (b *PulseBridge) listen(handler http.Handler) error {
+	certFile, keyFile := os.Getenv("SENT_PULSE_TLS_CERT"), os.Getenv("SENT_PULSE_TLS_KEY")
+	if certFile == "" || keyFile == "" {
+		return http.ListenAndServe(":8000", handler)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return err
+	}
+	config := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if b.enrollment != nil {
+		config = b.enrollment.TLSConfig(cert)
+	}
+	srv := &http.Server{Addr: ":8000", Handler: handler, TLSConfig: config}
+	return srv.ListenAndServeTLS("", "")
+}
+
+// enrollmentService is the enrollment service, or why there is none.
+func (b *PulseBridge) enrollmentService() (*enrollment.Service, error) {
+	if b.enrollment == nil {
+		return nil, b.enrollmentErr
+	}
+	return b.enrollment, nil
+}
+
+// GetAgents returns the agents registered to the user's tenant.
 func (b *PulseBridge) GetAgents() ([]*ent.Agent, error) {
-	return b.db.Agent.Query().All(b.ctx)
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	return b.db.Agent.Query().Where(entagent.HasTenantWith(tenant.ID(profile.TenantID))).All(b.ctx)
+}
+
+// EnrollmentTokenDTO is a new enrollment token. It is only shown once.
+type EnrollmentTokenDTO struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// CreateEnrollmentToken issues a single-use token that enrols one agent into the tenant.
+func (b *PulseBridge) CreateEnrollmentToken(validHours int) (*EnrollmentTokenDTO, error) {
+	if !b.auth.HasRole("admin") {
+		return nil, fmt.Errorf("permission denied: only admins can enrol agents")
+	}
+	if validHours <= 0 || validHours > 24*30 {
+		return nil, fmt.Errorf("an enrollment token is valid for 1 hour to 30 days")
+	}
+	enrol, err := b.enrollmentService()
+	if err != nil {
+		return nil, err
+	}
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	token, expires, err := enrol.GenerateToken(b.ctx, profile.TenantID, time.Duration(validHours)*time.Hour, profile.Subject)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "agent_enrollment_token_created", profile.Subject, map[string]interface{}{
+		"expires_at": expires,
+	})
+	return &EnrollmentTokenDTO{Token: token, ExpiresAt: expires}, nil
+}
+
+// RevokeAgent stops an agent connecting. It has to be enrolled again to come back.
+func (b *PulseBridge) RevokeAgent(agentID int) error {
+	if !b.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can revoke agents")
+	}
+	enrol, err := b.enrollmentService()
+	if err != nil {
+		return err
+	}
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if err := enrol.Revoke(b.ctx, profile.TenantID, agentID); err != nil {
+		return err
+	}
+	// Drop it off the hub now rather than when its token lapses.
+	b.worker.PublishCommand(strconv.Itoa(agentID), "revoked")
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "agent_revoked", profile.Subject, map[string]interface{}{
+		"agent_id": agentID,
+	})
+	return nil
+}
+
+// RekeyAgent has an agent replace its key and certificate when it next connects.
+func (b *PulseBridge) RekeyAgent(agentID int) error {
+	if !b.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can rekey agents")
+	}
+	enrol, err := b.enrollmentService()
+	if err != nil {
+		return err
+	}
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if err := enrol.RequestRekey(b.ctx, profile.TenantID, agentID); err != nil {
+		return err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "agent_rekey_requested", profile.Subject, map[string]interface{}{
+		"agent_id": agentID,
+	})
+	return nil
+}
+
+// deviceAgent checks that an agent, by the ID it has on the hub, is enrolled in the signed-in
+// user's tenant. Actions that change a device (action is not empty) also need an admin or
+// operator, and are audited.
+func (b *PulseBridge) deviceAgent(agentID, action string, details map[string]interface{}) (*ent.Agent, error) {
+	if action != "" && !b.auth.HasRole("operator") {
+		return nil, fmt.Errorf("permission denied: only admins and operators can %s", action)
+	}
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(agentID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid agent ID %q", agentID)
+	}
+	a, err := b.db.Agent.Query().
+		Where(entagent.ID(id), entagent.HasTenantWith(tenant.ID(profile.TenantID))).
+		Only(b.ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("agent %s not found", agentID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if action != "" {
+		if details == nil {
+			details = map[string]interface{}{}
+		}
+		details["agent_id"] = a.ID
+		details["action"] = action
+		database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "agent_action", profile.Subject, details)
+	}
+	return a, nil
 }
 
-// SendCommand sends a remote command to an agent.
 // SendCommand sends a remote command to an agent.
 func (b *PulseBridge) SendCommand(agentID string, cmd string) error {
+	if _, err := b.deviceAgent(agentID, "send commands to devices", map[string]interface{}{"command": cmd}); err != nil {
+		return err
+	}
 	return b.worker.PublishCommand(agentID, cmd)
 }
 
 // GetServices fetches services from agent
 func (b *PulseBridge) GetServices(agentID string) ([]agent.ServiceInfo, error) {
-    return agent.GetServices()
+	if _, err := b.deviceAgent(agentID, "", nil); err != nil {
+		return nil, err
+	}
+	var services []agent.ServiceInfo
+	err := b.agents.Call(b.ctx, agentID, rpc.MethodListServices, nil, &services)
+	return services, err
 }
 
-// ControlService changes service state
+// ControlService changes service state: start, stop or restart
 func (b *PulseBridge) ControlService(agentID string, name string, action string) error {
-    return agent.ControlService(name, action)
+	if _, err := b.deviceAgent(agentID, "control services", map[string]interface{}{"service": name, "service_action": action}); err != nil {
+		return err
+	}
+	return b.agents.Call(b.ctx, agentID, rpc.MethodControlSvc, rpc.ServiceParams{Name: name, Action: action}, nil)
 }
 
 // ListFiles fetches files from agent
 func (b *PulseBridge) ListFiles(agentID string, path string) ([]agent.FileInfo, error) {
-    return agent.ListFiles(path)
+	if _, err := b.deviceAgent(agentID, "", nil); err != nil {
+		return nil, err
+	}
+	var files []agent.FileInfo
+	err := b.agents.Call(b.ctx, agentID, rpc.MethodListFiles, rpc.PathParams{Path: path}, &files)
+	return files, err
 }
 
 // --- Script Management ---
@@ -98,6 +309,7 @@ type ScriptDTO struct {
     Description string `json:"description"`
     Content     string `json:"content"`
     Type        string `json:"type"`
+    Parameters  []string `json:"parameters"`
 }
 
 func (b *PulseBridge) CreateScript(name, description, content, scriptType string) (*ScriptDTO, error) {
@@ -112,6 +324,7 @@ func (b *PulseBridge) CreateScript(name, description, content, scriptType string
         Description: s.Description,
         Content:     s.Content,
         Type:        string(s.Type),
+        Parameters:  s.Parameters,
     }, nil
 }
 
@@ -129,6 +342,7 @@ func (b *PulseBridge) ListScripts() ([]*ScriptDTO, error) {
             Description: s.Description,
             Content:     s.Content,
             Type:        string(s.Type),
+        Parameters:  s.Parameters,
         })
     }
     return dtos, nil
@@ -145,6 +359,7 @@ func (b *PulseBridge) UpdateScript(id int, content string) (*ScriptDTO, error) {
         Description: s.Description,
         Content:     s.Content,
         Type:        string(s.Type),
+        Parameters:  s.Parameters,
     }, nil
 }
 
@@ -163,106 +378,964 @@ type JobDTO struct {
     ScriptID    int    `json:"script_id"`
     LastRun     string `json:"last_run"`
     NextRun     string `json:"next_run"`
+    Parameters  map[string]string `json:"parameters"`
+    Timeout     int    `json:"timeout"` // Seconds
 }
 
-func (b *PulseBridge) CreateJob(name string, scriptID int, targets []string, schedule string) (*JobDTO, error) {
-    j, err := b.jobs.CreateJob(b.ctx, "Acuative Corporation", name, scriptID, targets, schedule)
-    if err != nil {
-        return nil, err
-    }
-    return &JobDTO{
-        ID:       j.ID,
-        Name:     j.Name,
-        Schedule: j.CronSchedule,
-        Targets:  j.Targets,
-    }, nil
+func toJobDTO(j *ent.Job) *JobDTO {
+	dto := &JobDTO{
+		ID:         j.ID,
+		Name:       j.Name,
+		Schedule:   j.CronSchedule,
+		Targets:    j.Targets,
+		Parameters: j.Parameters,
+		Timeout:    j.TimeoutSeconds,
+	}
+	if j.Edges.Script != nil {
+		dto.ScriptName = j.Edges.Script.Name
+		dto.ScriptID = j.Edges.Script.ID
+	}
+	if !j.LastRun.IsZero() {
+		dto.LastRun = j.LastRun.String()
+	}
+	if !j.NextRun.IsZero() {
+		dto.NextRun = j.NextRun.String()
+	}
+	return dto
+}
+
+// CreateJob schedules a script on the targets: "all", "agent:<id>", "group:<id>" or
+// "tag:<name>", resolved each time the job runs. An empty or "manual" schedule makes a job
+// that only runs when started with RunJobNow.
+func (b *PulseBridge) CreateJob(name string, scriptID int, targets []string, schedule string, parameters map[string]string, timeoutSeconds int) (*JobDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	j, err := b.jobs.CreateJob(b.ctx, profile.TenantID, pulse.JobSpec{
+		Name:       name,
+		ScriptID:   scriptID,
+		Targets:    targets,
+		Schedule:   schedule,
+		Parameters: parameters,
+		Timeout:    timeoutSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "job_created", profile.Subject, map[string]interface{}{
+		"job_id":    j.ID,
+		"script_id": scriptID,
+		"targets":   targets,
+		"schedule":  schedule,
+	})
+	return toJobDTO(j), nil
 }
 
 func (b *PulseBridge) ListJobs() ([]*JobDTO, error) {
-    jobs, err := b.jobs.ListJobs(b.ctx, "Acuative Corporation")
-    if err != nil {
-        return nil, err
-    }
-    var dtos []*JobDTO
-    for _, j := range jobs {
-        dto := &JobDTO{
-            ID:       j.ID,
-            Name:     j.Name,
-            Schedule: j.CronSchedule,
-            Targets:  j.Targets,
-        }
-        if j.Edges.Script != nil {
-            dto.ScriptName = j.Edges.Script.Name
-            dto.ScriptID = j.Edges.Script.ID
-        }
-        if !j.LastRun.IsZero() {
-            dto.LastRun = j.LastRun.String()
-        }
-        if !j.NextRun.IsZero() {
-            dto.NextRun = j.NextRun.String()
-        }
-        dtos = append(dtos, dto)
-    }
-    return dtos, nil
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := b.jobs.ListJobs(b.ctx, profile.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	var dtos []*JobDTO
+	for _, j := range jobs {
+		dtos = append(dtos, toJobDTO(j))
+	}
+	return dtos, nil
+}
+
+// RunJobNow starts a run of a job outside its schedule and returns its executions' IDs.
+func (b *PulseBridge) RunJobNow(jobID int) ([]int, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := b.db.Job.Query().Where(job.ID(jobID), job.HasTenantWith(tenant.ID(profile.TenantID))).Exist(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("job %d not found", jobID)
+	}
+	ids, err := scheduler.Dispatch(b.ctx, b.db, b.river, jobID)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "job_run_started", profile.Subject, map[string]interface{}{
+		"job_id":     jobID,
+		"executions": ids,
+	})
+	return ids, nil
+}
+
+// ExecutionDTO is one agent's run of a job.
+type ExecutionDTO struct {
+	ID              int        `json:"id"`
+	JobID           int        `json:"jobId"`
+	JobName         string     `json:"jobName"`
+	AgentID         int        `json:"agentId"`
+	Hostname        string     `json:"hostname"`
+	Status          string     `json:"status"`
+	ExitCode        *int       `json:"exitCode"`
+	Output          string     `json:"output"`
+	OutputTruncated bool       `json:"outputTruncated"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	StartedAt       *time.Time `json:"startedAt"`
+	CompletedAt     *time.Time `json:"completedAt"`
+}
+
+// ListExecutions returns the latest runs of the tenant's jobs, newest first; of one job if
+// jobID is not zero.
+func (b *PulseBridge) ListExecutions(jobID int, limit int) ([]*ExecutionDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	q := b.db.JobExecution.Query().Where(jobexecution.HasJobWith(job.HasTenantWith(tenant.ID(profile.TenantID))))
+	if jobID != 0 {
+		q = q.Where(jobexecution.HasJobWith(job.ID(jobID)))
+	}
+	execs, err := q.WithJob().WithAgent().
+		Order(ent.Desc(jobexecution.FieldCreatedAt), ent.Desc(jobexecution.FieldID)).
+		Limit(limit).
+		All(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*ExecutionDTO, 0, len(execs))
+	for _, e := range execs {
+		dto := &ExecutionDTO{
+			ID:              e.ID,
+			Status:          string(e.Status),
+			ExitCode:        e.ExitCode,
+			Output:          e.Output,
+			OutputTruncated: e.OutputTruncated,
+			CreatedAt:       e.CreatedAt,
+		}
+		if j := e.Edges.Job; j != nil {
+			dto.JobID, dto.JobName = j.ID, j.Name
+		}
+		if a := e.Edges.Agent; a != nil {
+			dto.AgentID, dto.Hostname = a.ID, a.Hostname
+		}
+		if !e.StartedAt.IsZero() {
+			dto.StartedAt = &e.StartedAt
+		}
+		if !e.CompletedAt.IsZero() {
+			dto.CompletedAt = &e.CompletedAt
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+// CancelExecution stops one agent's run of a job.
+func (b *PulseBridge) CancelExecution(executionID int) error {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if err := scheduler.CancelExecution(b.ctx, b.db, b.agents, profile.TenantID, executionID); err != nil {
+		return err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "job_execution_cancelled", profile.Subject, map[string]interface{}{
+		"execution_id": executionID,
+	})
+	return nil
+}
+
+// --- Device Groups ---
+
+// DeviceGroupDTO is a device group and how many agents are in it now.
+type DeviceGroupDTO struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Filter      string `json:"filter"`
+	Dynamic     bool   `json:"dynamic"`
+	AgentIDs    []int  `json:"agentIds"`
+	MemberCount int    `json:"memberCount"`
+}
+
+func (b *PulseBridge) toDeviceGroupDTO(tenantID int, g *ent.DeviceGroup) (*DeviceGroupDTO, error) {
+	members, err := targeting.Members(b.ctx, b.db, tenantID, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	dto := &DeviceGroupDTO{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: g.Description,
+		Filter:      g.Filter,
+		Dynamic:     g.Filter != "",
+		AgentIDs:    []int{},
+		MemberCount: len(members),
+	}
+	for _, a := range g.Edges.Agents {
+		dto.AgentIDs = append(dto.AgentIDs, a.ID)
+	}
+	return dto, nil
+}
+
+// ListDeviceGroups returns the tenant's device groups.
+func (b *PulseBridge) ListDeviceGroups() ([]*DeviceGroupDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := b.groups.ListGroups(b.ctx, profile.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*DeviceGroupDTO, 0, len(groups))
+	for _, g := range groups {
+		dto, err := b.toDeviceGroupDTO(profile.TenantID, g)
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+// CreateDeviceGroup creates a group: static with the listed agents, or dynamic with a filter
+// such as: os = linux AND hostname ~ "pos-*" AND last_seen < 1h
+func (b *PulseBridge) CreateDeviceGroup(name, description, filter string, agentIDs []int) (*DeviceGroupDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	spec := pulse.GroupSpec{Name: name, Description: description, Filter: filter, AgentIDs: agentIDs}
+	g, err := b.groups.CreateGroup(b.ctx, profile.TenantID, spec, profile.Subject)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "device_group_created", profile.Subject, map[string]interface{}{
+		"group_id": g.ID,
+		"name":     g.Name,
+		"filter":   g.Filter,
+		"agents":   agentIDs,
+	})
+	g.Edges.Agents, _ = g.QueryAgents().All(b.ctx)
+	return b.toDeviceGroupDTO(profile.TenantID, g)
+}
+
+// UpdateDeviceGroup replaces a group's definition. Jobs targeting it pick up the change on
+// their next run.
+func (b *PulseBridge) UpdateDeviceGroup(id int, name, description, filter string, agentIDs []int) (*DeviceGroupDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	spec := pulse.GroupSpec{Name: name, Description: description, Filter: filter, AgentIDs: agentIDs}
+	g, err := b.groups.UpdateGroup(b.ctx, profile.TenantID, id, spec)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "device_group_updated", profile.Subject, map[string]interface{}{
+		"group_id": g.ID,
+		"name":     g.Name,
+		"filter":   g.Filter,
+		"agents":   agentIDs,
+	})
+	g.Edges.Agents, _ = g.QueryAgents().All(b.ctx)
+	return b.toDeviceGroupDTO(profile.TenantID, g)
+}
+
+// DeleteDeviceGroup deletes a group that no job targets.
+func (b *PulseBridge) DeleteDeviceGroup(id int) error {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if err := b.groups.DeleteGroup(b.ctx, profile.TenantID, id); err != nil {
+		return err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "device_group_deleted", profile.Subject, map[string]interface{}{
+		"group_id": id,
+	})
+	return nil
+}
+
+// GetDeviceGroupMembers returns the agents in a group now.
+func (b *PulseBridge) GetDeviceGroupMembers(id int) ([]*ent.Agent, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	return targeting.Members(b.ctx, b.db, profile.TenantID, id)
+}
+
+// PreviewFilter returns the agents a dynamic group filter would pick now.
+func (b *PulseBridge) PreviewFilter(filter string) ([]*ent.Agent, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	f, err := targeting.ParseFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	return targeting.Matching(b.ctx, b.db, profile.TenantID, f)
+}
+
+// SetAgentTags replaces an agent's tags and returns them as stored.
+func (b *PulseBridge) SetAgentTags(agentID int, tags []string) ([]string, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	tags, err = b.groups.SetAgentTags(b.ctx, profile.TenantID, agentID, tags)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "agent_tags_updated", profile.Subject, map[string]interface{}{
+		"agent_id": agentID,
+		"tags":     tags,
+	})
+	return tags, nil
+}
+
+// ListAgentTags returns every tag used on the tenant's agents.
+func (b *PulseBridge) ListAgentTags() ([]string, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	return b.groups.ListTags(b.ctx, profile.TenantID)
+}
+
+// --- Patch Policies ---
+
+// PatchPolicyDTO is a patch policy and how many agents it covers now.
+type PatchPolicyDTO struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Targets     []string   `json:"targets"`
+	Schedule    string     `json:"schedule"`
+	Severities  []string   `json:"severities"`
+	Reboot      bool       `json:"reboot"`
+	Enabled     bool       `json:"enabled"`
+	TargetCount int        `json:"targetCount"`
+	NextRun     *time.Time `json:"nextRun"`
+	LastRun     *time.Time `json:"lastRun"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+func (b *PulseBridge) toPatchPolicyDTO(tenantID int, p *ent.PatchPolicy) (*PatchPolicyDTO, error) {
+	agents, err := targeting.Resolve(b.ctx, b.db, tenantID, p.Targets)
+	if err != nil {
+		return nil, err
+	}
+	return &PatchPolicyDTO{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Targets:     p.Targets,
+		Schedule:    p.CronSchedule,
+		Severities:  p.Severities,
+		Reboot:      p.Reboot,
+		Enabled:     p.Enabled,
+		TargetCount: len(agents),
+		NextRun:     p.NextRun,
+		LastRun:     p.LastRun,
+		UpdatedAt:   p.UpdatedAt,
+	}, nil
+}
+
+// ListPatchPolicies returns the tenant's patch policies.
+func (b *PulseBridge) ListPatchPolicies() ([]*PatchPolicyDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	policies, err := b.policies.ListPatchPolicies(b.ctx, profile.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*PatchPolicyDTO, 0, len(policies))
+	for _, p := range policies {
+		dto, err := b.toPatchPolicyDTO(profile.TenantID, p)
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+// PatchSeverities lists the severities a patch policy can be limited to.
+func (b *PulseBridge) PatchSeverities() []string {
+	return patching.Severities
+}
+
+// CreatePatchPolicy has the targets install their pending patches, of the given severities or
+// all of them, on a schedule. Targets are resolved each time the policy runs, like a job's.
+func (b *PulseBridge) CreatePatchPolicy(name, description string, targets []string, schedule string, severities []string, reboot, enabled bool) (*PatchPolicyDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	spec := pulse.PatchPolicySpec{
+		Name:        name,
+		Description: description,
+		Targets:     targets,
+		Schedule:    schedule,
+		Severities:  severities,
+		Reboot:      reboot,
+		Enabled:     enabled,
+	}
+	p, err := b.policies.CreatePatchPolicy(b.ctx, profile.TenantID, spec, profile.Subject)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "patch_policy_created", profile.Subject, map[string]interface{}{
+		"policy_id":  p.ID,
+		"targets":    p.Targets,
+		"schedule":   p.CronSchedule,
+		"severities": p.Severities,
+		"reboot":     p.Reboot,
+	})
+	return b.toPatchPolicyDTO(profile.TenantID, p)
+}
+
+// UpdatePatchPolicy replaces a patch policy's definition.
+func (b *PulseBridge) UpdatePatchPolicy(id int, name, description string, targets []string, schedule string, severities []string, reboot, enabled bool) (*PatchPolicyDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	spec := pulse.PatchPolicySpec{
+		Name:        name,
+		Description: description,
+		Targets:     targets,
+		Schedule:    schedule,
+		Severities:  severities,
+		Reboot:      reboot,
+		Enabled:     enabled,
+	}
+	p, err := b.policies.UpdatePatchPolicy(b.ctx, profile.TenantID, id, spec)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "patch_policy_updated", profile.Subject, map[string]interface{}{
+		"policy_id":  p.ID,
+		"targets":    p.Targets,
+		"schedule":   p.CronSchedule,
+		"severities": p.Severities,
+		"reboot":     p.Reboot,
+		"enabled":    p.Enabled,
+	})
+	return b.toPatchPolicyDTO(profile.TenantID, p)
+}
+
+// DeletePatchPolicy deletes a patch policy.
+func (b *PulseBridge) DeletePatchPolicy(id int) error {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if err := b.policies.DeletePatchPolicy(b.ctx, profile.TenantID, id); err != nil {
+		return err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "patch_policy_deleted", profile.Subject, map[string]interface{}{
+		"policy_id": id,
+	})
+	return nil
+}
+
+// RunPatchPolicyNow starts a run of a patch policy outside its schedule and returns the IDs of
+// the agents it patches.
+func (b *PulseBridge) RunPatchPolicyNow(id int) ([]int, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := b.policies.GetPatchPolicy(b.ctx, profile.TenantID, id); err != nil {
+		return nil, err
+	}
+	ids, err := patching.Dispatch(b.ctx, b.db, b.river, id)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "patch_policy_run_started", profile.Subject, map[string]interface{}{
+		"policy_id": id,
+		"agents":    ids,
+	})
+	return ids, nil
+}
+
+// --- Alerts ---
+
+// AlertRuleDTO is an alert rule, how many agents it covers now and its open alerts.
+type AlertRuleDTO struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Targets     []string `json:"targets"`
+	Metric      string   `json:"metric"`
+	Threshold   float64  `json:"threshold"`
+	Severity    string   `json:"severity"`
+	Enabled     bool     `json:"enabled"`
+	TargetCount int      `json:"targetCount"`
+	OpenAlerts  int      `json:"openAlerts"`
+}
+
+func (b *PulseBridge) toAlertRuleDTO(tenantID int, r *ent.AlertRule) (*AlertRuleDTO, error) {
+	agents, err := targeting.Resolve(b.ctx, b.db, tenantID, r.Targets)
+	if err != nil {
+		return nil, err
+	}
+	open, err := r.QueryAlerts().Where(agentalert.ResolvedAtIsNil()).Count(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AlertRuleDTO{
+		ID:          r.ID,
+		Name:        r.Name,
+		Targets:     r.Targets,
+		Metric:      string(r.Metric),
+		Threshold:   r.Threshold,
+		Severity:    string(r.Severity),
+		Enabled:     r.Enabled,
+		TargetCount: len(agents),
+		OpenAlerts:  open,
+	}, nil
+}
+
+// ListAlertRules returns the tenant's alert rules.
+func (b *PulseBridge) ListAlertRules() ([]*AlertRuleDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	rules, err := b.alerts.ListRules(b.ctx, profile.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*AlertRuleDTO, 0, len(rules))
+	for _, r := range rules {
+		dto, err := b.toAlertRuleDTO(profile.TenantID, r)
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+// CreateAlertRule alerts when a metric of the targets passes a threshold: cpu or memory usage
+// in percent, or offline for minutes unseen. Targets are resolved each time rules are
+// evaluated.
+func (b *PulseBridge) CreateAlertRule(name string, targets []string, metric string, threshold float64, severity string, enabled bool) (*AlertRuleDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	spec := pulse.AlertRuleSpec{Name: name, Targets: targets, Metric: metric, Threshold: threshold, Severity: severity, Enabled: enabled}
+	r, err := b.alerts.CreateRule(b.ctx, profile.TenantID, spec, profile.Subject)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "alert_rule_created", profile.Subject, map[string]interface{}{
+		"rule_id":   r.ID,
+		"targets":   r.Targets,
+		"metric":    r.Metric,
+		"threshold": r.Threshold,
+	})
+	return b.toAlertRuleDTO(profile.TenantID, r)
+}
+
+// UpdateAlertRule replaces an alert rule's definition.
+func (b *PulseBridge) UpdateAlertRule(id int, name string, targets []string, metric string, threshold float64, severity string, enabled bool) (*AlertRuleDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	spec := pulse.AlertRuleSpec{Name: name, Targets: targets, Metric: metric, Threshold: threshold, Severity: severity, Enabled: enabled}
+	r, err := b.alerts.UpdateRule(b.ctx, profile.TenantID, id, spec)
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "alert_rule_updated", profile.Subject, map[string]interface{}{
+		"rule_id":   r.ID,
+		"targets":   r.Targets,
+		"metric":    r.Metric,
+		"threshold": r.Threshold,
+		"enabled":   r.Enabled,
+	})
+	return b.toAlertRuleDTO(profile.TenantID, r)
+}
+
+// DeleteAlertRule deletes an alert rule and its alerts.
+func (b *PulseBridge) DeleteAlertRule(id int) error {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if err := b.alerts.DeleteRule(b.ctx, profile.TenantID, id); err != nil {
+		return err
+	}
+	database.LogAuditRecord(b.ctx, b.db, profile.TenantID, "SENTpulse", "alert_rule_deleted", profile.Subject, map[string]interface{}{
+		"rule_id": id,
+	})
+	return nil
+}
+
+// AlertDTO is an alert an alert rule raised for an agent.
+type AlertDTO struct {
+	ID             int        `json:"id"`
+	RuleID         int        `json:"ruleId"`
+	RuleName       string     `json:"ruleName"`
+	AgentID        int        `json:"agentId"`
+	Hostname       string     `json:"hostname"`
+	Severity       string     `json:"severity"`
+	Message        string     `json:"message"`
+	Value          float64    `json:"value"`
+	RaisedAt       time.Time  `json:"raisedAt"`
+	ResolvedAt     *time.Time `json:"resolvedAt"`
+	AcknowledgedAt *time.Time `json:"acknowledgedAt"`
+	AcknowledgedBy string     `json:"acknowledgedBy"`
+}
+
+// ListAlerts returns the tenant's open alerts, newest first, and with includeResolved those
+// that have cleared too.
+func (b *PulseBridge) ListAlerts(includeResolved bool, limit int) ([]*AlertDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 200
+	}
+	alerts, err := b.alerts.ListAlerts(b.ctx, profile.TenantID, includeResolved, limit)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*AlertDTO, 0, len(alerts))
+	for _, a := range alerts {
+		dto := &AlertDTO{
+			ID:             a.ID,
+			Severity:       string(a.Severity),
+			Message:        a.Message,
+			Value:          a.Value,
+			RaisedAt:       a.RaisedAt,
+			ResolvedAt:     a.ResolvedAt,
+			AcknowledgedAt: a.AcknowledgedAt,
+			AcknowledgedBy: a.AcknowledgedBy,
+		}
+		if r := a.Edges.Rule; r != nil {
+			dto.RuleID, dto.RuleName = r.ID, r.Name
+		}
+		if ag := a.Edges.Agent; ag != nil {
+			dto.AgentID, dto.Hostname = ag.ID, ag.Hostname
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+// AcknowledgeAlert marks an alert as seen.
+func (b *PulseBridge) AcknowledgeAlert(id int) error {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	n, err := b.alerts.Acknowledge(b.ctx, profile.TenantID, []int{id}, profile.Subject)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("alert %d not found or already acknowledged", id)
+	}
+	return nil
+}
+
+// AcknowledgeAllAlerts marks every alert not yet seen as seen and returns how many there were.
+func (b *PulseBridge) AcknowledgeAllAlerts() (int, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return 0, err
+	}
+	return b.alerts.Acknowledge(b.ctx, profile.TenantID, nil, profile.Subject)
+}
+
+// --- Inventory ---
+
+// InventoryDTO is a version of an agent's inventory.
+type InventoryDTO struct {
+	Version     int                      `json:"version"`
+	CollectedAt time.Time                `json:"collectedAt"`
+	ConfirmedAt time.Time                `json:"confirmedAt"` // Last reported unchanged
+	Host        common.HostInfo          `json:"host"`
+	Hardware    common.HardwareInfo      `json:"hardware"`
+	Software    []common.SoftwareInfo    `json:"software"`
+	Services    []common.ServiceInfo     `json:"services"`
+	Patches     []common.PatchInfo       `json:"patches"`
+	Changes     *common.InventoryChanges `json:"changes"` // Since the previous version; unset on the first
+}
+
+func toInventoryDTO(s *ent.AgentInventory) *InventoryDTO {
+	dto := &InventoryDTO{
+		Version:     s.Version,
+		CollectedAt: s.CollectedAt,
+		ConfirmedAt: s.ConfirmedAt,
+		Host:        s.Host,
+		Hardware:    s.Hardware,
+		Software:    s.Software,
+		Services:    s.Services,
+		Patches:     s.Patches,
+	}
+	if s.Version > 1 {
+		dto.Changes = &s.Changes
+	}
+	return dto
+}
+
+// GetInventory returns an agent's current inventory, or nil if it has not reported one.
+func (b *PulseBridge) GetInventory(agentID int) (*InventoryDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	s, err := inventory.Latest(b.ctx, b.db, profile.TenantID, agentID)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	return toInventoryDTO(s), nil
+}
+
+// ListInventoryHistory returns an agent's latest inventory versions, newest first, with what
+// changed in each; the full lists are left out.
+func (b *PulseBridge) ListInventoryHistory(agentID int, limit int) ([]*InventoryDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 50
+	}
+	versions, err := inventory.History(b.ctx, b.db, profile.TenantID, agentID, limit)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*InventoryDTO, 0, len(versions))
+	for _, v := range versions {
+		dtos = append(dtos, toInventoryDTO(v))
+	}
+	return dtos, nil
+}
+
+// CollectInventory asks an agent for its inventory now, records it and returns it.
+func (b *PulseBridge) CollectInventory(agentID int) (*InventoryDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := b.db.Agent.Query().
+		Where(entagent.ID(agentID), entagent.HasTenantWith(tenant.ID(profile.TenantID))).
+		Exist(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("agent %d not found", agentID)
+	}
+	s, err := inventory.Collect(b.ctx, b.db, b.agents, agentID)
+	if err != nil {
+		return nil, err
+	}
+	return toInventoryDTO(s), nil
+}
+
+// SoftwareEventDTO is software installed, removed or updated on an agent.
+type SoftwareEventDTO struct {
+	AgentID    int       `json:"agentId"`
+	Hostname   string    `json:"hostname"`
+	Change     string    `json:"change"` // installed, removed or updated
+	Name       string    `json:"name"`
+	Version    string    `json:"version"`
+	OldVersion string    `json:"oldVersion"`
+	Publisher  string    `json:"publisher"`
+	At         time.Time `json:"at"`
+}
+
+// GetSoftwareChanges returns the software installed, removed or updated in the last
+// sinceHours hours, newest first, on one agent or, with agentID 0, across the tenant.
+func (b *PulseBridge) GetSoftwareChanges(agentID int, sinceHours int) ([]*SoftwareEventDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if sinceHours <= 0 {
+		sinceHours = 24 * 7
+	}
+	since := time.Now().Add(-time.Duration(sinceHours) * time.Hour)
+	events, err := inventory.SoftwareChanges(b.ctx, b.db, profile.TenantID, agentID, since)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*SoftwareEventDTO, 0, len(events))
+	for _, e := range events {
+		dtos = append(dtos, &SoftwareEventDTO{
+			AgentID:    e.AgentID,
+			Hostname:   e.Hostname,
+			Change:     e.Change,
+			Name:       e.Name,
+			Version:    e.Version,
+			OldVersion: e.OldVersion,
+			Publisher:  e.Publisher,
+			At:         e.At,
+		})
+	}
+	return dtos, nil
+}
+
+// SoftwareMatchDTO is a package installed on an agent, found by a fleet search.
+type SoftwareMatchDTO struct {
+	AgentID   int       `json:"agentId"`
+	Hostname  string    `json:"hostname"`
+	OS        string    `json:"os"`
+	Name      string    `json:"name"`
+	Version   string    `json:"version"`
+	Publisher string    `json:"publisher"`
+	FirstSeen time.Time `json:"firstSeen"`
+}
+
+// SearchSoftware finds the tenant's agents with software whose name contains name, in a
+// version starting with version if one is given (e.g. "OpenSSL", "1.1").
+func (b *PulseBridge) SearchSoftware(name string, version string) ([]*SoftwareMatchDTO, error) {
+	profile, err := b.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := inventory.SearchSoftware(b.ctx, b.db, profile.TenantID, name, version)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*SoftwareMatchDTO, 0, len(rows))
+	for _, r := range rows {
+		a := r.Edges.Agent
+		dtos = append(dtos, &SoftwareMatchDTO{
+			AgentID:   a.ID,
+			Hostname:  a.Hostname,
+			OS:        a.Os,
+			Name:      r.Name,
+			Version:   r.Version,
+			Publisher: r.Publisher,
+			FirstSeen: r.FirstSeen,
+		})
+	}
+	return dtos, nil
 }
 
 // --- Patch Management ---
 
 // ScanPatches triggers a patch scan on the agent and returns pending patches
 func (b *PulseBridge) ScanPatches(agentID string) ([]agent.PatchInfo, error) {
-    return agent.GetPendingPatches()
+	if _, err := b.deviceAgent(agentID, "", nil); err != nil {
+		return nil, err
+	}
+	var patches []agent.PatchInfo
+	err := b.agents.Call(b.ctx, agentID, rpc.MethodScanPatches, nil, &patches)
+	return patches, err
 }
 
 // InstallPatches triggers patch installation on the agent
 func (b *PulseBridge) InstallPatches(agentID string, patchIDs []string) error {
-    return agent.InstallPatches(patchIDs)
+	if _, err := b.deviceAgent(agentID, "install patches", map[string]interface{}{"patches": patchIDs}); err != nil {
+		return err
+	}
+	return b.agents.Call(b.ctx, agentID, rpc.MethodInstallPatch, rpc.PatchParams{IDs: patchIDs}, nil)
 }
 
 // --- New Core Features ---
 
-// RebootDevice restarts the agent machine
+// RebootDevice restarts the agent machine. The agent answers before it goes down.
 func (b *PulseBridge) RebootDevice(agentID string) error {
-    // In a real distributed system, we would publish a "reboot" command via Redis/Centrifugo.
-    // For local dev/MVP where Bridge runs alongside Agent logic (monolith simulation):
-    return agent.RebootSystem()
+	if _, err := b.deviceAgent(agentID, "restart devices", nil); err != nil {
+		return err
+	}
+	return b.agents.Call(b.ctx, agentID, rpc.MethodReboot, nil, nil)
 }
 
 // ShutdownDevice shuts down the agent machine
 func (b *PulseBridge) ShutdownDevice(agentID string) error {
-    return agent.ShutdownSystem()
+	if _, err := b.deviceAgent(agentID, "shut down devices", nil); err != nil {
+		return err
+	}
+	return b.agents.Call(b.ctx, agentID, rpc.MethodShutdown, nil, nil)
 }
 
 // GetEventLogs fetches system logs
 func (b *PulseBridge) GetEventLogs(agentID string) ([]agent.LogEntry, error) {
-    return agent.GetSystemLogs()
+	if _, err := b.deviceAgent(agentID, "", nil); err != nil {
+		return nil, err
+	}
+	var logs []agent.LogEntry
+	err := b.agents.Call(b.ctx, agentID, rpc.MethodEventLogs, nil, &logs)
+	return logs, err
 }
 
 // GetEnvVars fetches environment variables
 func (b *PulseBridge) GetEnvVars(agentID string) ([]agent.EnvVar, error) {
-    return agent.GetEnvironmentVariables(), nil
+	if _, err := b.deviceAgent(agentID, "", nil); err != nil {
+		return nil, err
+	}
+	var vars []agent.EnvVar
+	err := b.agents.Call(b.ctx, agentID, rpc.MethodEnvVars, nil, &vars)
+	return vars, err
 }
 
 // GetProcesses fetches running processes
 func (b *PulseBridge) GetProcesses(agentID string) ([]agent.ProcessInfo, error) {
-    return agent.GetProcesses()
+	if _, err := b.deviceAgent(agentID, "", nil); err != nil {
+		return nil, err
+	}
+	var procs []agent.ProcessInfo
+	err := b.agents.Call(b.ctx, agentID, rpc.MethodListProcesses, nil, &procs)
+	return procs, err
 }
 
 // KillProcess terminates a process
 func (b *PulseBridge) KillProcess(agentID string, pid int32) error {
-    return agent.KillProcess(pid)
+	if _, err := b.deviceAgent(agentID, "kill processes", map[string]interface{}{"pid": pid}); err != nil {
+		return err
+	}
+	return b.agents.Call(b.ctx, agentID, rpc.MethodKillProcess, rpc.PIDParams{PID: pid}, nil)
 }
 
-// DownloadFile reads a file from the agent
+// DownloadFile reads a file from the agent. It comes over in chunks.
 func (b *PulseBridge) DownloadFile(agentID string, path string) ([]byte, error) {
-    return agent.ReadFile(path)
+	if _, err := b.deviceAgent(agentID, "", nil); err != nil {
+		return nil, err
+	}
+	var data []byte
+	err := b.agents.Call(b.ctx, agentID, rpc.MethodReadFile, rpc.PathParams{Path: path}, &data)
+	return data, err
 }
 
 // DeleteFile deletes a file from the agent
 func (b *PulseBridge) DeleteFile(agentID string, path string) error {
-    return agent.DeleteFile(path)
+	if _, err := b.deviceAgent(agentID, "delete files", map[string]interface{}{"path": path}); err != nil {
+		return err
+	}
+	return b.agents.Call(b.ctx, agentID, rpc.MethodDeleteFile, rpc.PathParams{Path: path}, nil)
 }
 
 // GetSoftwareInventory fetches installed software
 func (b *PulseBridge) GetSoftwareInventory(agentID string) ([]common.SoftwareInfo, error) {
-    return agent.GetInstalledSoftware(), nil
+	if _, err := b.deviceAgent(agentID, "", nil); err != nil {
+		return nil, err
+	}
+	var software []common.SoftwareInfo
+	err := b.agents.Call(b.ctx, agentID, rpc.MethodSoftware, nil, &software)
+	return software, err
 }
\ No newline at end of file
@@ -5,28 +5,37 @@ import (
 	"testing"
 	"time"
 
+	"sent/ent/account"
 	"sent/ent/enttest"
-	_ "github.com/mattn/go-sqlite3"
-	"sent/ent/transaction"
 	"sent/ent/journalentry"
-	"sent/ent/account"
+	"sent/ent/transaction"
 	"sent/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestNeedsApproval(t *testing.T) {
+	limit := decimal.NewFromInt(1000)
+	assert.False(t, needsApproval(decimal.NewFromInt(500), limit, "Junior Accountant"))
+	assert.False(t, needsApproval(limit, limit, "Junior Accountant"))
+	assert.True(t, needsApproval(decimal.NewFromInt(10000), limit, "Junior Accountant"))
+	assert.False(t, needsApproval(decimal.NewFromInt(10000), limit, "Finance Manager"))
+	assert.False(t, needsApproval(decimal.NewFromInt(10000), limit, "admin"))
+}
+
 func TestTransactionApprovalLimitsAndRoles(t *testing.T) {
-	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	client := enttest.Open(t, "sqlite3", "file:approval?mode=memory&cache=shared&_fk=1")
 	defer client.Close()
 	ctx := context.Background()
-
-	bridge := NewCapitalBridge(client)
-	bridge.Startup(ctx)
+	ledger := NewLedgerService()
 
 	// 1. Setup Tenant and Accounts
 	tenant, err := client.Tenant.Create().
 		SetName("Test Tenant").
 		SetDomain("test.com").
-		SetTransactionLimit(1000.0).
+		SetTransactionLimit(decimal.NewFromInt(1000)).
 		Save(ctx)
 	assert.NoError(t, err)
 
@@ -55,7 +64,7 @@ func TestTransactionApprovalLimitsAndRoles(t *testing.T) {
 		SetNumber("1001").
 		SetType(account.TypeAsset).
 		SetTenant(tenant).
-		SetBalance(50000.0).
+		SetBalance(decimal.NewFromInt(50000)).
 		Save(ctx)
 	assert.NoError(t, err)
 
@@ -64,78 +73,68 @@ func TestTransactionApprovalLimitsAndRoles(t *testing.T) {
 		SetNumber("4001").
 		SetType(account.TypeRevenue).
 		SetTenant(tenant).
-		SetBalance(0.0).
+		SetBalance(decimal.Zero).
 		Save(ctx)
 	assert.NoError(t, err)
 
-	// 2. Test Small Transaction by Junior (Auto-Approved)
-	smallReq := TransactionRequest{
-		Description: "Small Sale",
-		Date:        time.Now(),
-		UserID:      junior.ID,
-		Entries: []EntryRequest{
-			{AccountID: cashAcc.ID, Amount: 500.0, Direction: "debit"},
-			{AccountID: revAcc.ID, Amount: 500.0, Direction: "credit"},
-		},
+	// post records a sale the way CreateTransaction does, staged when the user's role cannot
+	// bypass the tenant's limit.
+	post := func(description string, amount int64, role string) string {
+		total := decimal.NewFromInt(amount)
+		status := "APPROVED"
+		if needsApproval(total, tenant.TransactionLimit, role) {
+			status = "STAGED"
+		}
+		tx, err := client.Tx(ctx)
+		assert.NoError(t, err)
+		_, err = ledger.Post(ctx, tx, Posting{
+			TenantID:       tenant.ID,
+			Date:           time.Now(),
+			Description:    description,
+			ApprovalStatus: status,
+			Lines: []PostingLine{
+				{AccountID: cashAcc.ID, Direction: "debit", Amount: total},
+				{AccountID: revAcc.ID, Direction: "credit", Amount: total},
+			},
+		})
+		if !assert.NoError(t, err) {
+			tx.Rollback()
+			return ""
+		}
+		assert.NoError(t, tx.Commit())
+		return status
 	}
-
-	msg, err := bridge.CreateTransaction(smallReq)
-	assert.NoError(t, err)
-	assert.Contains(t, msg, "posted")
-
-	// Verify balance updated
-	updatedCash, _ := client.Account.Get(ctx, cashAcc.ID)
-	assert.Equal(t, 50500.0, updatedCash.Balance)
-
-	// 3. Test Large Transaction by Junior (Staged)
-	largeJuniorReq := TransactionRequest{
-		Description: "Junior Large Sale",
-		Date:        time.Now(),
-		UserID:      junior.ID,
-		Entries: []EntryRequest{
-			{AccountID: cashAcc.ID, Amount: 10000.0, Direction: "debit"},
-			{AccountID: revAcc.ID, Amount: 10000.0, Direction: "credit"},
-		},
+	cash := func() decimal.Decimal {
+		acc, err := client.Account.Get(ctx, cashAcc.ID)
+		assert.NoError(t, err)
+		return acc.Balance
 	}
 
-	msg, err = bridge.CreateTransaction(largeJuniorReq)
-	assert.NoError(t, err)
-	assert.Contains(t, msg, "STAGED")
+	// 2. Test Small Transaction by Junior (Auto-Approved)
+	assert.Equal(t, "APPROVED", post("Small Sale", 500, junior.Role))
+	assert.True(t, cash().Equal(decimal.NewFromInt(50500)), "cash = %s", cash())
 
-	// Verify balance NOT updated
-	stagedCash, _ := client.Account.Get(ctx, cashAcc.ID)
-	assert.Equal(t, 50500.0, stagedCash.Balance)
+	// 3. Test Large Transaction by Junior (Staged)
+	assert.Equal(t, "STAGED", post("Junior Large Sale", 10000, junior.Role))
+	assert.True(t, cash().Equal(decimal.NewFromInt(50500)), "staged sale moved cash to %s", cash())
 
 	// 4. Test Large Transaction by Manager (Auto-Approved - Bypass)
-	largeManagerReq := TransactionRequest{
-		Description: "Manager Large Sale",
-		Date:        time.Now(),
-		UserID:      manager.ID,
-		Entries: []EntryRequest{
-			{AccountID: cashAcc.ID, Amount: 10000.0, Direction: "debit"},
-			{AccountID: revAcc.ID, Amount: 10000.0, Direction: "credit"},
-		},
-	}
-
-	msg, err = bridge.CreateTransaction(largeManagerReq)
-	assert.NoError(t, err)
-	assert.Contains(t, msg, "posted")
-
-	// Verify balance updated (50500 + 10000)
-	finalCash, _ := client.Account.Get(ctx, cashAcc.ID)
-	assert.Equal(t, 60500.0, finalCash.Balance)
+	assert.Equal(t, "APPROVED", post("Manager Large Sale", 10000, manager.Role))
+	assert.True(t, cash().Equal(decimal.NewFromInt(60500)), "cash = %s", cash())
 
 	// 5. Approve the Staged Transaction
-	txnStaged, _ := client.Transaction.Query().Where(transaction.Description("Junior Large Sale")).Only(ctx)
-	msg, err = bridge.ApproveTransaction(txnStaged.ID)
+	txnStaged, err := client.Transaction.Query().Where(transaction.Description("Junior Large Sale")).Only(ctx)
+	assert.NoError(t, err)
+	tx, err := client.Tx(ctx)
 	assert.NoError(t, err)
-	assert.Contains(t, msg, "approved")
+	assert.NoError(t, ledger.Approve(ctx, tx, txnStaged, canPostToSoftClosed(manager.Role)))
+	assert.NoError(t, tx.Commit())
 
 	// Verify balance updated (60500 + 10000)
-	approvedCash, _ := client.Account.Get(ctx, cashAcc.ID)
-	assert.Equal(t, 70500.0, approvedCash.Balance)
+	assert.True(t, cash().Equal(decimal.NewFromInt(70500)), "cash = %s", cash())
 
 	// Verify JournalEntry status is now APPROVED
-	je, _ := client.JournalEntry.Query().Where(journalentry.Description("Junior Large Sale")).First(ctx)
+	je, err := client.JournalEntry.Query().Where(journalentry.Description("Junior Large Sale")).First(ctx)
+	assert.NoError(t, err)
 	assert.Equal(t, journalentry.ApprovalStatusAPPROVED, je.ApprovalStatus)
 }
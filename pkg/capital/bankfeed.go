@@ -0,0 +1,480 @@
+package capital
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ParsedStatement is a bank statement in a format-independent shape, ready for staging.
+type ParsedStatement struct {
+	Format         string
+	StatementRef   string
+	Currency       string
+	PeriodStart    *time.Time
+	PeriodEnd      *time.Time
+	OpeningBalance *decimal.Decimal
+	ClosingBalance *decimal.Decimal
+	Lines          []ParsedStatementLine
+}
+
+// ParsedStatementLine is a single booked transaction. Amount is positive for money in.
+type ParsedStatementLine struct {
+	ExternalID   string
+	BookingDate  time.Time
+	ValueDate    *time.Time
+	Amount       decimal.Decimal
+	Currency     string
+	Description  string
+	Reference    string
+	Counterparty string
+}
+
+// CSVMapping tells the CSV parser which header names hold which value. Banks either use a
+// single signed amount column or separate debit (money out) and credit (money in) columns.
+type CSVMapping struct {
+	Date         string `json:"date"`
+	Amount       string `json:"amount"`
+	Debit        string `json:"debit"`
+	Credit       string `json:"credit"`
+	Description  string `json:"description"`
+	Reference    string `json:"reference"`
+	Counterparty string `json:"counterparty"`
+	ExternalID   string `json:"external_id"`
+	DateFormat   string `json:"date_format"`   // Go layout; defaults to 2006-01-02
+	Delimiter    string `json:"delimiter"`     // Defaults to ","
+	DecimalComma bool   `json:"decimal_comma"` // "1.234,56" style amounts
+}
+
+// DefaultCSVMapping is used when no mapping is configured.
+func DefaultCSVMapping() CSVMapping {
+	return CSVMapping{
+		Date:        "Date",
+		Amount:      "Amount",
+		Description: "Description",
+		Reference:   "Reference",
+		DateFormat:  "2006-01-02",
+		Delimiter:   ",",
+	}
+}
+
+// ParseStatementCSV parses a CSV export with a header row according to the mapping.
+func ParseStatementCSV(r io.Reader, m CSVMapping) (*ParsedStatement, error) {
+	if m.DateFormat == "" {
+		m.DateFormat = "2006-01-02"
+	}
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	if m.Delimiter != "" {
+		reader.Comma = []rune(m.Delimiter)[0]
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("csv parsing failed: %w", err)
+	}
+	if len(records) < 1 {
+		return nil, fmt.Errorf("csv statement is empty")
+	}
+
+	cols := make(map[string]int)
+	for i, h := range records[0] {
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	col := func(rec []string, name string) string {
+		if name == "" {
+			return ""
+		}
+		i, ok := cols[strings.ToLower(name)]
+		if !ok || i >= len(rec) {
+			return ""
+		}
+		return strings.TrimSpace(rec[i])
+	}
+
+	if _, ok := cols[strings.ToLower(m.Date)]; !ok {
+		return nil, fmt.Errorf("date column %q not found in header", m.Date)
+	}
+
+	stmt := &ParsedStatement{Format: "csv"}
+	for n, rec := range records[1:] {
+		row := n + 2
+		if len(strings.Join(rec, "")) == 0 {
+			continue
+		}
+
+		date, err := time.Parse(m.DateFormat, col(rec, m.Date))
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid date %q", row, col(rec, m.Date))
+		}
+
+		var amount decimal.Decimal
+		if m.Amount != "" && col(rec, m.Amount) != "" {
+			amount, err = parseBankAmount(col(rec, m.Amount), m.DecimalComma)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", row, err)
+			}
+		} else {
+			in, err := parseBankAmount(col(rec, m.Credit), m.DecimalComma)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", row, err)
+			}
+			out, err := parseBankAmount(col(rec, m.Debit), m.DecimalComma)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", row, err)
+			}
+			amount = in.Abs().Sub(out.Abs())
+		}
+
+		line := ParsedStatementLine{
+			ExternalID:   col(rec, m.ExternalID),
+			BookingDate:  date,
+			Amount:       amount,
+			Description:  col(rec, m.Description),
+			Reference:    col(rec, m.Reference),
+			Counterparty: col(rec, m.Counterparty),
+		}
+		if line.ExternalID == "" {
+			line.ExternalID = rowFingerprint(row, rec)
+		}
+		stmt.Lines = append(stmt.Lines, line)
+	}
+
+	stmt.setPeriodFromLines()
+	return stmt, nil
+}
+
+// rowFingerprint gives CSV rows without a bank id a stable identity so re-imports are skipped.
+// The row number is part of it because two identical payments on one day are legitimate.
+func rowFingerprint(row int, rec []string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d|%s", row, strings.Join(rec, "|"))))
+	return "csv:" + hex.EncodeToString(sum[:8])
+}
+
+func parseBankAmount(s string, decimalComma bool) (decimal.Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return decimal.Zero, nil
+	}
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = strings.Trim(s, "()")
+	}
+	s = strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || r == '.' || r == ',' || r == '-' || r == '+' {
+			return r
+		}
+		return -1 // drop currency symbols and spaces
+	}, s)
+	if decimalComma {
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.ReplaceAll(s, ",", ".")
+	} else {
+		s = strings.ReplaceAll(s, ",", "")
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", s)
+	}
+	if negative {
+		d = d.Neg()
+	}
+	return d, nil
+}
+
+var ofxTag = regexp.MustCompile(`<([A-Za-z0-9.]+)>([^<\r\n]*)`)
+
+// ParseOFX parses OFX 1.x (SGML, unclosed tags) and OFX 2.x (XML) bank statements; QFX is OFX.
+func ParseOFX(r io.Reader) (*ParsedStatement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	body := string(data)
+	start := strings.Index(strings.ToUpper(body), "<OFX>")
+	if start < 0 {
+		return nil, fmt.Errorf("not an OFX document")
+	}
+	body = body[start:]
+
+	stmt := &ParsedStatement{Format: "ofx"}
+	var cur *ParsedStatementLine
+	inLedgerBal := false
+
+	for _, m := range ofxTag.FindAllStringSubmatch(body, -1) {
+		tag, val := strings.ToUpper(m[1]), strings.TrimSpace(m[2])
+		switch tag {
+		case "CURDEF":
+			stmt.Currency = val
+		case "DTSTART":
+			if t, err := parseOFXDate(val); err == nil {
+				stmt.PeriodStart = &t
+			}
+		case "DTEND":
+			if t, err := parseOFXDate(val); err == nil {
+				stmt.PeriodEnd = &t
+			}
+		case "LEDGERBAL":
+			inLedgerBal = true
+		case "BALAMT":
+			if inLedgerBal {
+				if d, err := decimal.NewFromString(val); err == nil {
+					stmt.ClosingBalance = &d
+				}
+				inLedgerBal = false
+			}
+		case "STMTTRN":
+			stmt.Lines = append(stmt.Lines, ParsedStatementLine{Currency: stmt.Currency})
+			cur = &stmt.Lines[len(stmt.Lines)-1]
+		}
+
+		if cur == nil {
+			continue
+		}
+		switch tag {
+		case "DTPOSTED":
+			t, err := parseOFXDate(val)
+			if err != nil {
+				return nil, err
+			}
+			cur.BookingDate = t
+		case "DTUSER":
+			if t, err := parseOFXDate(val); err == nil {
+				cur.ValueDate = &t
+			}
+		case "TRNAMT":
+			d, err := parseBankAmount(val, false)
+			if err != nil {
+				return nil, err
+			}
+			cur.Amount = d
+		case "FITID":
+			cur.ExternalID = val
+		case "NAME", "PAYEE":
+			cur.Counterparty = val
+		case "MEMO":
+			cur.Description = val
+		case "CHECKNUM", "REFNUM":
+			cur.Reference = val
+		}
+	}
+
+	for i := range stmt.Lines {
+		l := &stmt.Lines[i]
+		if l.Description == "" {
+			l.Description = l.Counterparty
+		}
+		if l.ExternalID == "" {
+			return nil, fmt.Errorf("OFX transaction %d has no FITID", i+1)
+		}
+	}
+	if stmt.PeriodStart == nil {
+		stmt.setPeriodFromLines()
+	}
+	return stmt, nil
+}
+
+// parseOFXDate accepts YYYYMMDD[HHMMSS[.XXX]][[offset:TZ]].
+func parseOFXDate(s string) (time.Time, error) {
+	if i := strings.IndexAny(s, "[."); i >= 0 {
+		s = s[:i]
+	}
+	switch len(s) {
+	case 8:
+		return time.Parse("20060102", s)
+	case 12:
+		return time.Parse("200601021504", s)
+	case 14:
+		return time.Parse("20060102150405", s)
+	}
+	return time.Time{}, fmt.Errorf("invalid OFX date %q", s)
+}
+
+// camt053Document mirrors the parts of ISO 20022 camt.053 (BankToCustomerStatement) we use.
+type camt053Document struct {
+	Statements []struct {
+		ID       string `xml:"Id"`
+		Currency string `xml:"Acct>Ccy"`
+		FrToDt   struct {
+			From string `xml:"FrDtTm"`
+			To   string `xml:"ToDtTm"`
+		} `xml:"FrToDt"`
+		Balances []struct {
+			Code   string     `xml:"Tp>CdOrPrtry>Cd"`
+			Amount camtAmount `xml:"Amt"`
+			CdtDbt string     `xml:"CdtDbtInd"`
+		} `xml:"Bal"`
+		Entries []struct {
+			Amount      camtAmount `xml:"Amt"`
+			CdtDbt      string     `xml:"CdtDbtInd"`
+			Status      camtStatus `xml:"Sts"`
+			BookingDate camtDate   `xml:"BookgDt"`
+			ValueDate   camtDate   `xml:"ValDt"`
+			ServicerRef string     `xml:"AcctSvcrRef"`
+			AddtlInfo   string     `xml:"AddtlNtryInf"`
+			Details     []struct {
+				EndToEndID string   `xml:"Refs>EndToEndId"`
+				Unstruct   []string `xml:"RmtInf>Ustrd"`
+				Debtor     string   `xml:"RltdPties>Dbtr>Nm"`
+				Creditor   string   `xml:"RltdPties>Cdtr>Nm"`
+			} `xml:"NtryDtls>TxDtls"`
+		} `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// camtStatus is a plain code in camt.053.001.02 and a <Cd> element from version .08 on.
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Code  string `xml:"Cd"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+func (d camtDate) parse() (time.Time, bool) {
+	if d.Date != "" {
+		t, err := time.Parse("2006-01-02", d.Date)
+		return t, err == nil
+	}
+	if d.DateTime != "" {
+		t, err := time.Parse(time.RFC3339, d.DateTime)
+		if err != nil {
+			t, err = time.Parse("2006-01-02T15:04:05", d.DateTime)
+		}
+		return t, err == nil
+	}
+	return time.Time{}, false
+}
+
+// ParseCamt053 parses an ISO 20022 camt.053 statement. Only booked entries are staged.
+func ParseCamt053(r io.Reader) (*ParsedStatement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc camt053Document
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("camt.053 parsing failed: %w", err)
+	}
+	if len(doc.Statements) == 0 {
+		return nil, fmt.Errorf("no statement found in camt.053 document")
+	}
+
+	// A file normally carries one statement per account; we stage the first.
+	s := doc.Statements[0]
+	stmt := &ParsedStatement{Format: "camt053", StatementRef: s.ID, Currency: s.Currency}
+
+	if t, err := time.Parse(time.RFC3339, s.FrToDt.From); err == nil {
+		stmt.PeriodStart = &t
+	}
+	if t, err := time.Parse(time.RFC3339, s.FrToDt.To); err == nil {
+		stmt.PeriodEnd = &t
+	}
+
+	for _, b := range s.Balances {
+		amt, err := decimal.NewFromString(strings.TrimSpace(b.Amount.Value))
+		if err != nil {
+			continue
+		}
+		if b.CdtDbt == "DBIT" {
+			amt = amt.Neg()
+		}
+		switch b.Code {
+		case "OPBD", "PRCD":
+			stmt.OpeningBalance = &amt
+		case "CLBD":
+			stmt.ClosingBalance = &amt
+		}
+		if stmt.Currency == "" {
+			stmt.Currency = b.Amount.Currency
+		}
+	}
+
+	for i, e := range s.Entries {
+		status := e.Status.Code
+		if status == "" {
+			status = strings.TrimSpace(e.Status.Value)
+		}
+		if status != "" && status != "BOOK" {
+			continue
+		}
+
+		amt, err := decimal.NewFromString(strings.TrimSpace(e.Amount.Value))
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: invalid amount %q", i+1, e.Amount.Value)
+		}
+		if e.CdtDbt == "DBIT" {
+			amt = amt.Neg()
+		}
+		booked, ok := e.BookingDate.parse()
+		if !ok {
+			return nil, fmt.Errorf("entry %d: missing booking date", i+1)
+		}
+
+		line := ParsedStatementLine{
+			ExternalID:  e.ServicerRef,
+			BookingDate: booked,
+			Amount:      amt,
+			Currency:    e.Amount.Currency,
+			Description: e.AddtlInfo,
+		}
+		if vd, ok := e.ValueDate.parse(); ok {
+			line.ValueDate = &vd
+		}
+		if len(e.Details) > 0 {
+			d := e.Details[0]
+			if d.EndToEndID != "NOTPROVIDED" {
+				line.Reference = d.EndToEndID
+			}
+			if len(d.Unstruct) > 0 {
+				line.Description = strings.Join(d.Unstruct, " ")
+			}
+			// The counterparty is the creditor for outgoing and the debtor for incoming payments.
+			line.Counterparty = d.Debtor
+			if e.CdtDbt == "DBIT" {
+				line.Counterparty = d.Creditor
+			}
+		}
+		if line.ExternalID == "" {
+			line.ExternalID = fmt.Sprintf("%s:%d", s.ID, i+1)
+		}
+		stmt.Lines = append(stmt.Lines, line)
+	}
+
+	if stmt.PeriodStart == nil {
+		stmt.setPeriodFromLines()
+	}
+	return stmt, nil
+}
+
+func (s *ParsedStatement) setPeriodFromLines() {
+	for _, l := range s.Lines {
+		d := l.BookingDate
+		if s.PeriodStart == nil || d.Before(*s.PeriodStart) {
+			s.PeriodStart = &d
+		}
+		if s.PeriodEnd == nil || d.After(*s.PeriodEnd) {
+			end := d
+			s.PeriodEnd = &end
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package capital
+
+import (
+	"strings"
+	"testing"
+
+	"sent/ent"
+	"sent/ent/bankrule"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseStatementCSVSignedAmount(t *testing.T) {
+	data := "Date,Description,Amount,Reference\n" +
+		"2024-03-01,AWS EMEA,-120.50,INV-1\n" +
+		"2024-03-02,Customer payment,\"1,000.00\",SO-42\n"
+
+	stmt, err := ParseStatementCSV(strings.NewReader(data), DefaultCSVMapping())
+	if err != nil {
+		t.Fatalf("ParseStatementCSV: %v", err)
+	}
+	if len(stmt.Lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(stmt.Lines))
+	}
+	if !stmt.Lines[0].Amount.Equal(decimal.RequireFromString("-120.50")) {
+		t.Errorf("line 1 amount = %s", stmt.Lines[0].Amount)
+	}
+	if !stmt.Lines[1].Amount.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("line 2 amount = %s", stmt.Lines[1].Amount)
+	}
+	if stmt.Lines[0].ExternalID == "" || stmt.Lines[0].ExternalID == stmt.Lines[1].ExternalID {
+		t.Errorf("expected distinct generated external ids, got %q and %q", stmt.Lines[0].ExternalID, stmt.Lines[1].ExternalID)
+	}
+	if !stmt.PeriodStart.Equal(date("2024-03-01")) || !stmt.PeriodEnd.Equal(date("2024-03-02")) {
+		t.Errorf("period = %v..%v", stmt.PeriodStart, stmt.PeriodEnd)
+	}
+
+	again, _ := ParseStatementCSV(strings.NewReader(data), DefaultCSVMapping())
+	if again.Lines[0].ExternalID != stmt.Lines[0].ExternalID {
+		t.Error("generated external ids must be stable across imports")
+	}
+}
+
+func TestParseStatementCSVDebitCreditColumns(t *testing.T) {
+	data := "Buchungstag;Text;Soll;Haben\n" +
+		"01.03.2024;Miete;1.500,00;\n" +
+		"02.03.2024;Zahlung;;2.000,50\n"
+	m := CSVMapping{
+		Date:         "Buchungstag",
+		Description:  "Text",
+		Debit:        "Soll",
+		Credit:       "Haben",
+		DateFormat:   "02.01.2006",
+		Delimiter:    ";",
+		DecimalComma: true,
+	}
+
+	stmt, err := ParseStatementCSV(strings.NewReader(data), m)
+	if err != nil {
+		t.Fatalf("ParseStatementCSV: %v", err)
+	}
+	if !stmt.Lines[0].Amount.Equal(decimal.NewFromInt(-1500)) {
+		t.Errorf("debit line amount = %s", stmt.Lines[0].Amount)
+	}
+	if !stmt.Lines[1].Amount.Equal(decimal.RequireFromString("2000.50")) {
+		t.Errorf("credit line amount = %s", stmt.Lines[1].Amount)
+	}
+}
+
+func TestParseOFXSGML(t *testing.T) {
+	data := `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKTRANLIST>
+<DTSTART>20240301
+<DTEND>20240331
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240305120000[-5:EST]
+<TRNAMT>-49.99
+<FITID>20240305001
+<NAME>AWS
+<MEMO>AWS cloud services
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240306
+<TRNAMT>250.00
+<FITID>20240306001
+<NAME>ACME Corp
+<CHECKNUM>1042
+</STMTTRN>
+</BANKTRANLIST>
+<LEDGERBAL><BALAMT>1200.01<DTASOF>20240331</LEDGERBAL>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+	stmt, err := ParseOFX(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseOFX: %v", err)
+	}
+	if stmt.Currency != "USD" || len(stmt.Lines) != 2 {
+		t.Fatalf("currency=%q lines=%d", stmt.Currency, len(stmt.Lines))
+	}
+	l := stmt.Lines[0]
+	if l.ExternalID != "20240305001" || !l.Amount.Equal(decimal.RequireFromString("-49.99")) || l.Description != "AWS cloud services" || l.Counterparty != "AWS" {
+		t.Errorf("unexpected first line %+v", l)
+	}
+	if !l.BookingDate.Equal(date("2024-03-05").Add(12 * 60 * 60 * 1e9)) {
+		t.Errorf("booking date = %v", l.BookingDate)
+	}
+	if stmt.Lines[1].Reference != "1042" || stmt.Lines[1].Description != "ACME Corp" {
+		t.Errorf("unexpected second line %+v", stmt.Lines[1])
+	}
+	if stmt.ClosingBalance == nil || !stmt.ClosingBalance.Equal(decimal.RequireFromString("1200.01")) {
+		t.Errorf("closing balance = %v", stmt.ClosingBalance)
+	}
+	if !stmt.PeriodEnd.Equal(date("2024-03-31")) {
+		t.Errorf("period end = %v", stmt.PeriodEnd)
+	}
+}
+
+func TestParseCamt053(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+ <BkToCstmrStmt>
+  <Stmt>
+   <Id>STMT-2024-03</Id>
+   <Acct><Id><IBAN>DE89370400440532013000</IBAN></Id><Ccy>EUR</Ccy></Acct>
+   <Bal><Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">500.00</Amt><CdtDbtInd>CRDT</CdtDbtInd></Bal>
+   <Bal><Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">380.00</Amt><CdtDbtInd>CRDT</CdtDbtInd></Bal>
+   <Ntry>
+    <Amt Ccy="EUR">120.00</Amt>
+    <CdtDbtInd>DBIT</CdtDbtInd>
+    <Sts>BOOK</Sts>
+    <BookgDt><Dt>2024-03-04</Dt></BookgDt>
+    <ValDt><Dt>2024-03-05</Dt></ValDt>
+    <AcctSvcrRef>REF-001</AcctSvcrRef>
+    <NtryDtls><TxDtls>
+     <Refs><EndToEndId>E2E-77</EndToEndId></Refs>
+     <RltdPties><Cdtr><Nm>Amazon Web Services</Nm></Cdtr></RltdPties>
+     <RmtInf><Ustrd>AWS invoice 77</Ustrd></RmtInf>
+    </TxDtls></NtryDtls>
+   </Ntry>
+   <Ntry>
+    <Amt Ccy="EUR">10.00</Amt>
+    <CdtDbtInd>CRDT</CdtDbtInd>
+    <Sts>PDNG</Sts>
+    <BookgDt><Dt>2024-03-06</Dt></BookgDt>
+   </Ntry>
+  </Stmt>
+ </BkToCstmrStmt>
+</Document>`
+
+	stmt, err := ParseCamt053(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseCamt053: %v", err)
+	}
+	if stmt.StatementRef != "STMT-2024-03" || stmt.Currency != "EUR" {
+		t.Errorf("ref=%q currency=%q", stmt.StatementRef, stmt.Currency)
+	}
+	if len(stmt.Lines) != 1 {
+		t.Fatalf("expected pending entry to be skipped, got %d lines", len(stmt.Lines))
+	}
+	l := stmt.Lines[0]
+	if !l.Amount.Equal(decimal.NewFromInt(-120)) || l.ExternalID != "REF-001" || l.Reference != "E2E-77" ||
+		l.Counterparty != "Amazon Web Services" || l.Description != "AWS invoice 77" {
+		t.Errorf("unexpected line %+v", l)
+	}
+	if stmt.OpeningBalance == nil || !stmt.OpeningBalance.Equal(decimal.NewFromInt(500)) {
+		t.Errorf("opening balance = %v", stmt.OpeningBalance)
+	}
+	if stmt.ClosingBalance == nil || !stmt.ClosingBalance.Equal(decimal.NewFromInt(380)) {
+		t.Errorf("closing balance = %v", stmt.ClosingBalance)
+	}
+}
+
+func TestPickMatch(t *testing.T) {
+	line := ParsedStatementLine{BookingDate: date("2024-03-10"), Amount: decimal.NewFromInt(-100), Description: "Payment INV-2024-7"}
+	candidates := []matchCandidate{
+		{EntryID: 1, Date: date("2024-03-10"), Amount: decimal.NewFromInt(100)},                           // wrong sign
+		{EntryID: 2, Date: date("2024-03-20"), Amount: decimal.NewFromInt(-100)},                          // outside window
+		{EntryID: 3, Date: date("2024-03-09"), Amount: decimal.NewFromInt(-100)},                          // date only
+		{EntryID: 4, Date: date("2024-03-12"), Amount: decimal.NewFromInt(-100), Reference: "INV-2024-7"}, // date and reference
+	}
+
+	best, score := pickMatch(line, candidates)
+	if best.EntryID != 4 {
+		t.Errorf("expected entry 4, got %d (score %d)", best.EntryID, score)
+	}
+	if score < minMatchScore {
+		t.Errorf("reference match should be auto-matched, score %d", score)
+	}
+
+	_, score = pickMatch(line, candidates[:3])
+	if score != 55 {
+		t.Errorf("date-only match one day apart should score 55, got %d", score)
+	}
+	if _, score := pickMatch(line, candidates[:2]); score != 0 {
+		t.Errorf("expected no match, got score %d", score)
+	}
+}
+
+func TestFirstRule(t *testing.T) {
+	rules := []*ent.BankRule{
+		{ID: 1, Pattern: "aws", MatchField: bankrule.MatchFieldDescription, Direction: bankrule.DirectionInflow, IsActive: true},
+		{ID: 2, Pattern: "AWS", MatchField: bankrule.MatchFieldDescription, Direction: bankrule.DirectionOutflow, IsActive: true},
+		{ID: 3, Pattern: "", MatchField: bankrule.MatchFieldDescription, Direction: bankrule.DirectionAny, IsActive: true},
+	}
+	line := ParsedStatementLine{Amount: decimal.NewFromInt(-20), Description: "Aws Emea Sarl"}
+
+	if r := firstRule(rules, line); r == nil || r.ID != 2 {
+		t.Errorf("expected outflow rule 2 to apply, got %+v", r)
+	}
+
+	rules[1].IsActive = false
+	if r := firstRule(rules, line); r != nil {
+		t.Errorf("expected no rule, got %d", r.ID)
+	}
+}
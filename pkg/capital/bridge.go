@@ -4,13 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
-	"encoding/csv"
 	"fmt"
 	"time"
 
 	"sent/ent"
 	"sent/ent/account"
-	"sent/ent/journalentry"
+	"sent/ent/exchangerate"
 	"sent/ent/ledgerentry"
 	"sent/ent/tenant"
 	"sent/ent/transaction"
@@ -23,9 +22,12 @@ import (
 // CapitalBridge serves as the interface for financial operations.
 // It handles accounts, transactions, and reporting.
 type CapitalBridge struct {
-	ctx  context.Context
-	db   *ent.Client
-	auth *auth.AuthBridge
+	ctx      context.Context
+	db       *ent.Client
+	auth     *auth.AuthBridge
+	exchange *ExchangeService
+	ledger   *LedgerService
+	docs     DocumentStore
 }
 
 // AccountDTO represents a financial account.
@@ -33,28 +35,34 @@ type AccountDTO struct {
 	ID      int     `json:"id"`
 	Name    string  `json:"name"`
 	Number  string  `json:"number"`
-	Type    string  `json:"type"`
-	Balance float64 `json:"balance"`
+	Type     string  `json:"type"`
+	Balance  float64 `json:"balance"`  // In the tenant's functional currency
+	Currency string  `json:"currency"` // Denomination; empty means functional currency
 }
 
 // EntryRequest represents a single ledger line item (debit or credit).
 type EntryRequest struct {
 	AccountID int     `json:"account_id"`
-	Amount    float64 `json:"amount"`
+	Amount    float64 `json:"amount"`    // In the transaction currency
 	Direction string  `json:"direction"` // "debit" or "credit"
+	// ExchangeRate overrides the transaction rate for this line, e.g. to relieve a receivable
+	// at its booking rate. The resulting difference is posted as realized FX gain/loss.
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
 }
 
 // TransactionRequest represents a complete multi-entry journal transaction.
 type TransactionRequest struct {
-	Description string         `json:"description"`
-	Date        time.Time      `json:"date"`
-	Entries     []EntryRequest `json:"entries"`
-	UserID      int            `json:"user_id"`
+	Description  string         `json:"description"`
+	Date         time.Time      `json:"date"`
+	Entries      []EntryRequest `json:"entries"`
+	UserID       int            `json:"user_id"`
+	Currency     string         `json:"currency"`      // Empty means functional currency
+	ExchangeRate float64        `json:"exchange_rate"` // Zero means look up the dated rate
 }
 
 // NewCapitalBridge initializes a new CapitalBridge.
 func NewCapitalBridge(db *ent.Client, auth *auth.AuthBridge) *CapitalBridge {
-	return &CapitalBridge{db: db, auth: auth}
+	return &CapitalBridge{db: db, auth: auth, exchange: NewExchangeService(db), ledger: NewLedgerService()}
 }
 
 // Startup initializes the bridge context.
@@ -62,6 +70,11 @@ func (c *CapitalBridge) Startup(ctx context.Context) {
 	c.ctx = ctx
 }
 
+// SetDocumentStore sets where generated invoices and statements are stored.
+func (c *CapitalBridge) SetDocumentStore(docs DocumentStore) {
+	c.docs = docs
+}
+
 // GetAccounts retrieves all financial accounts.
 func (c *CapitalBridge) GetAccounts() ([]AccountDTO, error) {
 	profile, err := c.auth.GetUserProfile()
@@ -96,15 +109,21 @@ func (c *CapitalBridge) GetAccounts() ([]AccountDTO, error) {
 		}
 	}
 
+	balances, err := BalancesAsOf(c.ctx, c.db, tenantID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive balances: %w", err)
+	}
+
 	dtos := make([]AccountDTO, len(accounts))
 	for i, a := range accounts {
-		bal, _ := a.Balance.Float64()
+		bal, _ := balances[a.ID].Float64()
 		dtos[i] = AccountDTO{
-			ID:      a.ID,
-			Name:    a.Name,
-			Number:  a.Number,
-			Type:    string(a.Type),
-			Balance: bal,
+			ID:       a.ID,
+			Name:     a.Name,
+			Number:   a.Number,
+			Type:     string(a.Type),
+			Balance:  bal,
+			Currency: a.Currency,
 		}
 	}
 	return dtos, nil
@@ -118,17 +137,41 @@ func (c *CapitalBridge) seedDefaultAccounts(t *ent.Tenant) error {
 		return nil
 	}
 
+	tx, err := c.db.Tx(c.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	// Create default accounts
 	bulk := []*ent.AccountCreate{
-		c.db.Account.Create().SetName("Operating Cash").SetNumber("1000").SetType(account.TypeAsset).SetTenant(t).SetBalance(decimal.NewFromInt(10000)),
-		c.db.Account.Create().SetName("Revenue").SetNumber("4000").SetType(account.TypeRevenue).SetTenant(t),
-		c.db.Account.Create().SetName("Rent Expense").SetNumber("5000").SetType(account.TypeExpense).SetTenant(t),
+		tx.Account.Create().SetName("Operating Cash").SetNumber("1000").SetType(account.TypeAsset).SetTenant(t),
+		tx.Account.Create().SetName("Opening Balance Equity").SetNumber("3000").SetType(account.TypeEquity).SetTenant(t),
+		tx.Account.Create().SetName("Revenue").SetNumber("4000").SetType(account.TypeRevenue).SetTenant(t),
+		tx.Account.Create().SetName("Rent Expense").SetNumber("5000").SetType(account.TypeExpense).SetTenant(t),
 	}
 	
-	if _, err := c.db.Account.CreateBulk(bulk...).Save(c.ctx); err != nil {
+	accounts, err := tx.Account.CreateBulk(bulk...).Save(c.ctx)
+	if err != nil {
 		return fmt.Errorf("failed to seed accounts: %w", err)
 	}
-	return nil
+
+	// The opening cash balance goes through the ledger so it is backed by journal entries.
+	opening := decimal.NewFromInt(10000)
+	_, err = c.ledger.Post(c.ctx, tx, Posting{
+		TenantID:    t.ID,
+		Description: "Opening balance",
+		Type:        "opening_balance",
+		Lines: []PostingLine{
+			{AccountID: accounts[0].ID, Direction: "debit", Amount: opening},
+			{AccountID: accounts[1].ID, Direction: "credit", Amount: opening},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to post opening balance: %w", err)
+	}
+
+	return tx.Commit()
 }
 
 // TransactionDTO represents a summary of a recorded transaction.
@@ -194,61 +237,17 @@ func (c *CapitalBridge) ApproveTransaction(transactionID int) (string, error) {
 			transaction.ID(transactionID),
 			transaction.HasTenantWith(tenant.ID(prof.TenantID)),
 		).
-		WithLedgerEntries(func(q *ent.LedgerEntryQuery) {
-			q.WithAccount()
-		}).
 		Only(c.ctx)
 	if err != nil {
 		tx.Rollback()
 		return "", fmt.Errorf("transaction not found or access denied: %w", err)
 	}
 
-	if txn.ApprovalStatus != transaction.ApprovalStatusSTAGED {
-		tx.Rollback()
-		return "", fmt.Errorf("transaction is not in a stageable status (STAGED)")
-	}
-
-	// 1. Update status
-	err = tx.Transaction.UpdateOne(txn).
-		SetApprovalStatus(transaction.ApprovalStatusAPPROVED).
-		Exec(c.ctx)
-	if err != nil {
+	if err := c.ledger.Approve(c.ctx, tx, txn, canPostToSoftClosed(prof.Role)); err != nil {
 		tx.Rollback()
 		return "", err
 	}
 
-	// Also update JournalEntries status
-	_, err = tx.JournalEntry.Update().
-		Where(journalentry.HasTransactionWith(transaction.ID(txn.ID))).
-		SetApprovalStatus(journalentry.ApprovalStatusAPPROVED).
-		Save(c.ctx)
-	if err != nil {
-		tx.Rollback()
-		return "", err
-	}
-
-	// 2. Update balances now that it's approved
-	for _, e := range txn.Edges.LedgerEntries {
-		acc := e.Edges.Account
-		if acc == nil {
-			tx.Rollback()
-			return "", fmt.Errorf("ledger entry %d missing account edge", e.ID)
-		}
-
-		var newBalance decimal.Decimal
-		if e.Direction == ledgerentry.DirectionDebit {
-			newBalance = acc.Balance.Add(e.Amount)
-		} else {
-			newBalance = acc.Balance.Sub(e.Amount)
-		}
-
-		err = tx.Account.UpdateOne(acc).SetBalance(newBalance).Exec(c.ctx)
-		if err != nil {
-			tx.Rollback()
-			return "", fmt.Errorf("failed to update account balance: %w", err)
-		}
-	}
-
 	if err := tx.Commit(); err != nil {
 		return "", err
 	}
@@ -278,6 +277,7 @@ func (c *CapitalBridge) CreateTransaction(req TransactionRequest) (string, error
 
 	prof, err := c.auth.GetUserProfile()
 	if err != nil {
+		tx.Rollback()
 		return "", err
 	}
 	tenantID := prof.TenantID
@@ -299,93 +299,85 @@ func (c *CapitalBridge) CreateTransaction(req TransactionRequest) (string, error
 		return "", fmt.Errorf("unauthorized user context: %w", err)
 	}
 
-	// Calculate total amount for limit validation (sum of debits)
-	totalAmount := decimal.Zero
-	for _, e := range req.Entries {
-		if e.Direction == "debit" {
-			totalAmount = totalAmount.Add(decimal.NewFromFloat(e.Amount))
-		}
+	// Convert every line into the functional currency before any limit or balance logic runs.
+	functional := normalizeCurrency(tnt.FunctionalCurrency)
+	currency := normalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = functional
 	}
-
-	approvalStatus := "APPROVED"
-	// Financial Fraud Prevention: Intercept if amount exceeds tenant limit
-	if totalAmount.GreaterThan(tnt.TransactionLimit) {
-		// Only "Finance Manager" or "admin" can bypass the limit
-		if usr.Role != "Finance Manager" && usr.Role != "admin" {
-			approvalStatus = "STAGED"
-			// Notify SENTchat manager channel (Simulation)
-			fmt.Printf("[SENTchat] ALERT: Transaction '%s' ($%s) created by %s (%s) exceeds limit ($%s). Staging for manager approval.\n", 
-				req.Description, totalAmount, usr.Email, usr.Role, tnt.TransactionLimit)
+	rate := decimal.NewFromFloat(req.ExchangeRate)
+	if !rate.IsPositive() {
+		rate, err = c.exchange.GetExchangeRate(c.ctx, tenantID, currency, functional, req.Date)
+		if err != nil {
+			tx.Rollback()
+			return "", fmt.Errorf("cannot post in %s: %w", currency, err)
 		}
 	}
 
-	// 1. Create Header
-	txn, err := tx.Transaction.Create().
-		SetDescription(req.Description).
-		SetDate(req.Date).
-		SetTenant(tnt).
-		SetTotalAmount(totalAmount).
-		SetApprovalStatus(transaction.ApprovalStatus(approvalStatus)).
-		Save(c.ctx)
-	if err != nil {
-		tx.Rollback()
-		return "", fmt.Errorf("failed to save transaction header: %w", err)
-	}
-
-		// 2. Create Entries and Update Balances
+	lines := make([]PostingLine, 0, len(req.Entries)+1)
+	totalAmount := decimal.Zero
+	fxDiff := decimal.Zero // functional debits minus credits
 	for _, e := range req.Entries {
-		decimalAmount := decimal.NewFromFloat(e.Amount)
-		// Create JournalEntry (The specific entity for auditing)
-		_, err := tx.JournalEntry.Create().
-			SetAmount(decimalAmount).
-			SetDirection(journalentry.Direction(e.Direction)).
-			SetAccountID(e.AccountID).
-			SetTransaction(txn).
-			SetTenant(tnt).
-			SetDescription(req.Description).
-			SetApprovalStatus(journalentry.ApprovalStatus(approvalStatus)).
-			Save(c.ctx)
-		if err != nil {
-			tx.Rollback()
-			return "", fmt.Errorf("failed to save journal entry: %w", err)
+		lineRate := rate
+		if e.ExchangeRate > 0 {
+			lineRate = decimal.NewFromFloat(e.ExchangeRate)
 		}
-
-		// Also create LedgerEntry for legacy/backward compatibility if needed, 
-		// but JournalEntry is the new source of truth for approvals.
-		_, err = tx.LedgerEntry.Create().
-			SetAmount(decimalAmount).
-			SetDirection(ledgerentry.Direction(e.Direction)).
-			SetAccountID(e.AccountID).
-			SetTransaction(txn).
-			SetTenant(tnt).
-			Save(c.ctx)
+		original := decimal.NewFromFloat(e.Amount)
+		amount := RoundCurrency(original.Mul(lineRate), functional)
+		if e.Direction == "debit" {
+			totalAmount = totalAmount.Add(amount)
+			fxDiff = fxDiff.Add(amount)
+		} else {
+			fxDiff = fxDiff.Sub(amount)
+		}
+		lines = append(lines, PostingLine{
+			AccountID:      e.AccountID,
+			Direction:      e.Direction,
+			Amount:         amount,
+			Currency:       currency,
+			CurrencyAmount: original,
+			Rate:           lineRate,
+		})
+	}
+
+	// Lines balanced in transaction currency can drift apart in functional currency when
+	// they are converted at different rates (or through rounding); that is realized FX.
+	if !fxDiff.IsZero() {
+		fxAcc, err := EnsureAccount(c.ctx, tx, tnt, AccountRealizedFX, "Realized FX Gain/Loss", account.TypeExpense)
 		if err != nil {
 			tx.Rollback()
-			return "", fmt.Errorf("failed to save ledger entry: %w", err)
+			return "", err
 		}
-
-		// Only update balances if APPROVED
-		if approvalStatus == "APPROVED" {
-			// Update Account Balance (Denormalization)
-			acc, err := tx.Account.Get(c.ctx, e.AccountID)
-			if err != nil {
-				tx.Rollback()
-				return "", err
-			}
-
-			var newBalance decimal.Decimal
-			if e.Direction == "debit" {
-				newBalance = acc.Balance.Add(decimalAmount)
-			} else {
-				newBalance = acc.Balance.Sub(decimalAmount)
-			}
-
-			err = tx.Account.UpdateOne(acc).SetBalance(newBalance).Exec(c.ctx)
-			if err != nil {
-				tx.Rollback()
-				return "", fmt.Errorf("failed to update account balance: %w", err)
-			}
+		direction := "credit" // excess debits are a gain
+		if fxDiff.IsNegative() {
+			direction = "debit"
+			totalAmount = totalAmount.Add(fxDiff.Abs())
 		}
+		lines = append(lines, PostingLine{AccountID: fxAcc.ID, Direction: direction, Amount: fxDiff.Abs()})
+	}
+
+	approvalStatus := "APPROVED"
+	// Financial Fraud Prevention: Intercept if amount exceeds tenant limit
+	if needsApproval(totalAmount, tnt.TransactionLimit, usr.Role) {
+		approvalStatus = "STAGED"
+		// Notify SENTchat manager channel (Simulation)
+		fmt.Printf("[SENTchat] ALERT: Transaction '%s' (%s %s) created by %s (%s) exceeds limit (%s %s). Staging for manager approval.\n",
+			req.Description, functional, totalAmount, usr.Email, usr.Role, functional, tnt.TransactionLimit)
+	}
+
+	_, err = c.ledger.Post(c.ctx, tx, Posting{
+		TenantID:        tenantID,
+		Date:            req.Date,
+		Description:     req.Description,
+		Currency:        currency,
+		ExchangeRate:    rate,
+		ApprovalStatus:  approvalStatus,
+		AllowSoftClosed: canPostToSoftClosed(usr.Role),
+		Lines:           lines,
+	})
+	if err != nil {
+		tx.Rollback()
+		return "", err
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -398,6 +390,12 @@ func (c *CapitalBridge) CreateTransaction(req TransactionRequest) (string, error
 	return fmt.Sprintf("Transaction '%s' posted.", req.Description), nil
 }
 
+// needsApproval reports whether a transaction of total must be staged for a manager: it is
+// over the tenant's limit, and only "Finance Manager" or "admin" can bypass the limit.
+func needsApproval(total, limit decimal.Decimal, role string) bool {
+	return total.GreaterThan(limit) && role != "Finance Manager" && role != "admin"
+}
+
 func validateBalance(entries []EntryRequest) error {
 	totalDebit := decimal.Zero
 	totalCredit := decimal.Zero
@@ -417,35 +415,171 @@ func validateBalance(entries []EntryRequest) error {
 	return nil
 }
 
-// ImportCSV parses a base64 encoded CSV string and logs the count of valid records.
-// TODO: Implement actual import logic.
+// ImportCSV stages a base64 encoded bank CSV (Date, Description, Amount, Reference columns)
+// against the Operating Cash account and auto-matches it to the ledger.
 func (c *CapitalBridge) ImportCSV(base64Content string) (string, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+
+	cash, err := c.db.Account.Query().
+		Where(account.Number("1000"), account.HasTenantWith(tenant.ID(profile.TenantID))).
+		Only(c.ctx)
+	if err != nil {
+		return "", fmt.Errorf("operating cash account not found: %w", err)
+	}
+
+	res, err := c.ImportBankStatement(cash.ID, "csv", "", base64Content, nil)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("CSV Processed. Imported %d transactions (%d duplicates skipped, %d matched, %d suggested).",
+		res.Imported, res.Duplicates, res.Matched, res.Suggested), nil
+}
+
+// reportingAccounts returns the current tenant's accounts, their balances as of now derived
+// from the ledger, and the functional currency those balances are carried in.
+func (c *CapitalBridge) reportingAccounts() ([]*ent.Account, map[int]decimal.Decimal, string, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, nil, "", err
+	}
+	functional, err := c.exchange.FunctionalCurrency(c.ctx, profile.TenantID)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	accounts, err := c.db.Account.Query().
+		Where(account.HasTenantWith(tenant.ID(profile.TenantID))).
+		Order(ent.Asc(account.FieldNumber)).
+		All(c.ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	balances, err := BalancesAsOf(c.ctx, c.db, profile.TenantID, time.Now())
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return accounts, balances, functional, nil
+}
+
+// ExchangeRateDTO represents a stored dated rate.
+type ExchangeRateDTO struct {
+	ID            int       `json:"id"`
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	Rate          float64   `json:"rate"`
+	EffectiveDate time.Time `json:"effective_date"`
+	Source        string    `json:"source"`
+}
+
+// ImportExchangeRates loads a base64 encoded rate file into the tenant's rate table.
+// Format is "csv" (date,base,quote,rate) or "ecb" (eurofxref XML).
+func (c *CapitalBridge) ImportExchangeRates(format, base64Content string) (string, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+
 	data, err := base64.StdEncoding.DecodeString(base64Content)
 	if err != nil {
 		return "", fmt.Errorf("invalid base64 content: %w", err)
 	}
 
-	reader := csv.NewReader(bytes.NewReader(data))
-	records, err := reader.ReadAll()
+	var quotes []RateQuote
+	switch format {
+	case "csv":
+		quotes, err = ParseRatesCSV(bytes.NewReader(data))
+	case "ecb":
+		quotes, err = ParseECBRates(bytes.NewReader(data))
+	default:
+		return "", fmt.Errorf("unsupported rate format: %s", format)
+	}
 	if err != nil {
-		return "", fmt.Errorf("csv parsing failed: %w", err)
+		return "", err
 	}
 
-	count := 0
-	for i, record := range records {
-		// Skip header and empty rows
-		if i == 0 || len(record) < 3 {
-			continue
+	n, err := c.exchange.StoreRates(c.ctx, profile.TenantID, quotes)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Imported %d exchange rates.", n), nil
+}
+
+// GetExchangeRates lists the tenant's stored rates, newest first.
+func (c *CapitalBridge) GetExchangeRates() ([]ExchangeRateDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.ExchangeRate.Query().
+		Where(exchangerate.HasTenantWith(tenant.ID(profile.TenantID))).
+		Order(ent.Desc(exchangerate.FieldEffectiveDate), ent.Asc(exchangerate.FieldQuoteCurrency)).
+		All(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query exchange rates: %w", err)
+	}
+
+	dtos := make([]ExchangeRateDTO, len(rows))
+	for i, r := range rows {
+		rate, _ := r.Rate.Float64()
+		dtos[i] = ExchangeRateDTO{
+			ID:            r.ID,
+			BaseCurrency:  r.BaseCurrency,
+			QuoteCurrency: r.QuoteCurrency,
+			Rate:          rate,
+			EffectiveDate: r.EffectiveDate,
+			Source:        r.Source,
 		}
-		count++
+	}
+	return dtos, nil
+}
+
+// RunRevaluation posts the unrealized FX journal for the current tenant at the given period end.
+func (c *CapitalBridge) RunRevaluation(periodEnd time.Time) (*RevaluationResult, error) {
+	prof, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+	if prof.Role != "admin" && prof.Role != "Finance Manager" {
+		return nil, fmt.Errorf("insufficient permissions: revaluation requires Finance Manager role")
+	}
+	return NewRevaluationService(c.db, c.exchange).Revalue(c.ctx, prof.TenantID, periodEnd)
+}
+
+// SetFunctionalCurrency changes the tenant's reporting currency.
+// It is refused once anything has been posted, since existing amounts would be misstated.
+func (c *CapitalBridge) SetFunctionalCurrency(currency string) error {
+	prof, err := c.auth.GetUserProfile()
+	if err != nil {
+		return fmt.Errorf("authentication required: %w", err)
+	}
+	if prof.Role != "admin" {
+		return fmt.Errorf("insufficient permissions: admin role required")
+	}
+
+	currency = normalizeCurrency(currency)
+	if len(currency) != 3 {
+		return fmt.Errorf("invalid ISO 4217 currency code: %q", currency)
+	}
+
+	posted, err := c.db.LedgerEntry.Query().
+		Where(ledgerentry.HasTenantWith(tenant.ID(prof.TenantID))).
+		Exist(c.ctx)
+	if err != nil {
+		return err
+	}
+	if posted {
+		return fmt.Errorf("functional currency cannot be changed after entries have been posted")
 	}
 
-	return fmt.Sprintf("CSV Processed. Found %d valid transactions.", count), nil
+	return c.db.Tenant.UpdateOneID(prof.TenantID).SetFunctionalCurrency(currency).Exec(c.ctx)
 }
 
-// ExportTrialBalance generates a PDF of the current trial balance.
+// ExportTrialBalance generates a PDF of the current trial balance in the functional currency.
 func (c *CapitalBridge) ExportTrialBalance() (string, error) {
-	accounts, err := c.db.Account.Query().All(c.ctx)
+	accounts, balances, functional, err := c.reportingAccounts()
 	if err != nil {
 		return "", err
 	}
@@ -460,7 +594,7 @@ func (c *CapitalBridge) ExportTrialBalance() (string, error) {
 	pdf.SetFont("Arial", "B", 12)
 	pdf.CellFormat(30, 10, "Number", "1", 0, "C", false, 0, "")
 	pdf.CellFormat(100, 10, "Account Name", "1", 0, "C", false, 0, "")
-	pdf.CellFormat(40, 10, "Balance", "1", 0, "C", false, 0, "")
+	pdf.CellFormat(40, 10, fmt.Sprintf("Balance (%s)", functional), "1", 0, "C", false, 0, "")
 	pdf.Ln(10)
 	
 	// Table Body
@@ -468,7 +602,7 @@ func (c *CapitalBridge) ExportTrialBalance() (string, error) {
 	for _, a := range accounts {
 		pdf.CellFormat(30, 10, a.Number, "1", 0, "L", false, 0, "")
 		pdf.CellFormat(100, 10, a.Name, "1", 0, "L", false, 0, "")
-		pdf.CellFormat(40, 10, a.Balance.StringFixed(2), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(40, 10, balances[a.ID].StringFixed(2), "1", 0, "R", false, 0, "")
 		pdf.Ln(10)
 	}
 
@@ -479,9 +613,9 @@ func (c *CapitalBridge) ExportTrialBalance() (string, error) {
 	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
 }
 
-// ExportProfitLoss generates a P&L Statement PDF.
+// ExportProfitLoss generates a P&L Statement PDF in the functional currency.
 func (c *CapitalBridge) ExportProfitLoss() (string, error) {
-	accounts, err := c.db.Account.Query().All(c.ctx)
+	accounts, balances, functional, err := c.reportingAccounts()
 	if err != nil {
 		return "", err
 	}
@@ -494,10 +628,10 @@ func (c *CapitalBridge) ExportProfitLoss() (string, error) {
 	for _, a := range accounts {
 		if a.Type == account.TypeRevenue {
 			revenues = append(revenues, a)
-			totalRev = totalRev.Add(a.Balance)
+			totalRev = totalRev.Add(balances[a.ID])
 		} else if a.Type == account.TypeExpense {
 			expenses = append(expenses, a)
-			totalExp = totalExp.Add(a.Balance)
+			totalExp = totalExp.Add(balances[a.ID])
 		}
 	}
 
@@ -505,24 +639,24 @@ func (c *CapitalBridge) ExportProfitLoss() (string, error) {
 	pdf := gofpdf.New("P", "mm", "A4", "")
 	pdf.AddPage()
 	pdf.SetFont("Arial", "B", 16)
-	pdf.Cell(40, 10, "Profit & Loss Statement")
+	pdf.Cell(40, 10, fmt.Sprintf("Profit & Loss Statement (%s)", functional))
 	pdf.Ln(15)
 
 	// Revenue Section
-	c.renderPdfSection(pdf, "REVENUE", revenues)
-	c.renderPdfTotal(pdf, "Total Revenue", totalRev)
+	c.renderPdfSection(pdf, "REVENUE", revenues, balances)
+	c.renderPdfTotal(pdf, "Total Revenue", totalRev, functional)
 	pdf.Ln(15)
 
 	// Expense Section
-	c.renderPdfSection(pdf, "EXPENSES", expenses)
-	c.renderPdfTotal(pdf, "Total Expenses", totalExp)
+	c.renderPdfSection(pdf, "EXPENSES", expenses, balances)
+	c.renderPdfTotal(pdf, "Total Expenses", totalExp, functional)
 	pdf.Ln(20)
 
 	// Net Income
 	pdf.SetFont("Arial", "B", 14)
 	pdf.SetFillColor(240, 240, 240)
 	pdf.CellFormat(100, 12, "NET INCOME", "1", 0, "L", true, 0, "")
-	pdf.CellFormat(40, 12, fmt.Sprintf("$%s", totalRev.Sub(totalExp).StringFixed(2)), "1", 0, "R", true, 0, "")
+	pdf.CellFormat(40, 12, fmt.Sprintf("%s %s", functional, totalRev.Sub(totalExp).StringFixed(2)), "1", 0, "R", true, 0, "")
 
 	var buf bytes.Buffer
 	if err := pdf.Output(&buf); err != nil {
@@ -532,21 +666,21 @@ func (c *CapitalBridge) ExportProfitLoss() (string, error) {
 }
 
 // Helper to render a section of accounts in the PDF.
-func (c *CapitalBridge) renderPdfSection(pdf *gofpdf.Fpdf, title string, accounts []*ent.Account) {
+func (c *CapitalBridge) renderPdfSection(pdf *gofpdf.Fpdf, title string, accounts []*ent.Account, balances map[int]decimal.Decimal) {
 	pdf.SetFont("Arial", "B", 12)
 	pdf.Cell(40, 10, title)
 	pdf.Ln(8)
 	pdf.SetFont("Arial", "", 10)
 	for _, a := range accounts {
 		pdf.CellFormat(100, 8, a.Name, "", 0, "L", false, 0, "")
-		pdf.CellFormat(40, 8, a.Balance.StringFixed(2), "", 0, "R", false, 0, "")
+		pdf.CellFormat(40, 8, balances[a.ID].StringFixed(2), "", 0, "R", false, 0, "")
 		pdf.Ln(6)
 	}
 }
 
 // Helper to render a total line in the PDF.
-func (c *CapitalBridge) renderPdfTotal(pdf *gofpdf.Fpdf, label string, amount decimal.Decimal) {
+func (c *CapitalBridge) renderPdfTotal(pdf *gofpdf.Fpdf, label string, amount decimal.Decimal, currency string) {
 	pdf.SetFont("Arial", "B", 10)
 	pdf.CellFormat(100, 10, label, "T", 0, "L", false, 0, "")
-	pdf.CellFormat(40, 10, fmt.Sprintf("$%s", amount.StringFixed(2)), "T", 0, "R", false, 0, "")
+	pdf.CellFormat(40, 10, fmt.Sprintf("%s %s", currency, amount.StringFixed(2)), "T", 0, "R", false, 0, "")
 }
\ No newline at end of file
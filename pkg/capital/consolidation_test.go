@@ -3,12 +3,14 @@ package capital
 import (
 	"context"
 	"testing"
+	"time"
 
+	"sent/ent/account"
 	"sent/ent/enttest"
 	"sent/ent/migrate"
-	"sent/ent/account"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
 )
 
 func TestConsolidatedTrialBalance(t *testing.T) {
@@ -31,16 +33,43 @@ func TestConsolidatedTrialBalance(t *testing.T) {
 	icPayB := client.Account.Create().SetTenant(compB).SetName("Due to SENT UK").SetNumber("2200").SetType(account.TypeLiability).SetIsIntercompany(true).SaveX(ctx)
 
 	// 3. Record Inter-company Transaction ($100 sale from A to B)
+	hundred := decimal.NewFromInt(100)
+	post := func(tenantID, debit, credit int) {
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = NewLedgerService().Post(ctx, tx, Posting{
+			TenantID:    tenantID,
+			Date:        time.Now().Add(-time.Hour),
+			Description: "Inter-company IT services",
+			Lines: []PostingLine{
+				{AccountID: debit, Direction: "debit", Amount: hundred},
+				{AccountID: credit, Direction: "credit", Amount: hundred},
+			},
+		})
+		if err != nil {
+			tx.Rollback()
+			t.Fatalf("posting: %v", err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
 	// Company A Side
-	client.Account.UpdateOne(revA).SetBalance(100).ExecX(ctx)
-	client.Account.UpdateOne(icRecA).SetBalance(100).ExecX(ctx)
-
+	post(compA.ID, icRecA.ID, revA.ID)
 	// Company B Side
-	client.Account.UpdateOne(expB).SetBalance(100).ExecX(ctx)
-	client.Account.UpdateOne(icPayB).SetBalance(100).ExecX(ctx)
+	post(compB.ID, expB.ID, icPayB.ID)
+	// A drifted cached balance must not reach the consolidated view.
+	client.Account.UpdateOne(revA).SetBalance(decimal.NewFromInt(999)).ExecX(ctx)
 
 	worker := NewConsolidationWorker(client)
-	balances, err := worker.GenerateConsolidatedTrialBalance(ctx, parent.ID)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	balances, err := worker.GenerateConsolidatedTrialBalance(ctx, parent.ID, tx)
 	if err != nil {
 		t.Fatalf("failed to generate consolidated balance: %v", err)
 	}
@@ -59,14 +88,14 @@ func TestConsolidatedTrialBalance(t *testing.T) {
 	for _, b := range balances {
 		if b.AccountNumber == "4000" {
 			foundRev = true
-			if b.TotalBalance != 100 {
-				t.Errorf("Expected consolidated revenue 100, got %f", b.TotalBalance)
+			if !b.TotalBalance.Equal(hundred) {
+				t.Errorf("Expected consolidated revenue 100, got %s", b.TotalBalance)
 			}
 		}
 		if b.AccountNumber == "5000" {
 			foundExp = true
-			if b.TotalBalance != 100 {
-				t.Errorf("Expected consolidated expense 100, got %f", b.TotalBalance)
+			if !b.TotalBalance.Equal(hundred) {
+				t.Errorf("Expected consolidated expense 100, got %s", b.TotalBalance)
 			}
 		}
 	}
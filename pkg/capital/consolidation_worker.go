@@ -105,6 +105,19 @@ func (w *ConsolidationWorker) GenerateConsolidatedTrialBalance(ctx context.Conte
 		return nil, err
 	}
 
+	// Balances come from each company's ledger rather than the cached Account.Balance
+	now := time.Now()
+	balances := make(map[int]decimal.Decimal)
+	for _, id := range tenantIDs {
+		derived, err := BalancesAsOf(ctx, tx.Client(), id, now)
+		if err != nil {
+			return nil, err
+		}
+		for accID, bal := range derived {
+			balances[accID] = bal
+		}
+	}
+
 	// Aggregate balances by account number
 	// Eliminating inter-company accounts
 	agg := make(map[string]*ConsolidatedBalance)
@@ -121,7 +134,7 @@ func (w *ConsolidationWorker) GenerateConsolidatedTrialBalance(ctx context.Conte
 				TotalBalance:  decimal.Zero,
 			}
 		}
-		agg[acc.Number].TotalBalance = agg[acc.Number].TotalBalance.Add(acc.Balance)
+		agg[acc.Number].TotalBalance = agg[acc.Number].TotalBalance.Add(balances[acc.ID])
 	}
 
 	result := make([]ConsolidatedBalance, 0, len(agg))
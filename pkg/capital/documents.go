@@ -0,0 +1,208 @@
+package capital
+
+import (
+	"fmt"
+	"time"
+
+	"sent/ent"
+	"sent/ent/invoice"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/shopspring/decimal"
+)
+
+var mutedText = &props.Color{Red: 128, Green: 128, Blue: 128}
+
+// renderInvoicePDF renders an invoice or credit note loaded with its tenant, customer, lines,
+// tax lines and (for credit notes) original invoice. qr is the payload of its e-invoice, if
+// it has one.
+func renderInvoicePDF(inv *ent.Invoice, qr string) ([]byte, error) {
+	m := maroto.New(config.NewBuilder().Build())
+
+	title := "INVOICE"
+	if inv.Kind == invoice.KindCreditNote {
+		title = "CREDIT NOTE"
+	}
+	m.AddRows(row.New(20).Add(
+		text.NewCol(12, title, props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center}),
+	))
+
+	seller := ""
+	if inv.Edges.Tenant != nil {
+		seller = inv.Edges.Tenant.Name
+	}
+	cust := inv.Edges.Customer
+	m.AddRows(
+		row.New(6).Add(
+			text.NewCol(6, seller, props.Text{Size: 10, Style: fontstyle.Bold}),
+			text.NewCol(6, fmt.Sprintf("Number: %s", inv.Number), props.Text{Size: 10, Align: align.Right}),
+		),
+		row.New(6).Add(
+			text.NewCol(6, fmt.Sprintf("Bill to: %s (%s)", cust.Name, cust.Code), props.Text{Size: 10}),
+			text.NewCol(6, fmt.Sprintf("Date: %s", inv.IssueDate.Format("2006-01-02")), props.Text{Size: 10, Align: align.Right}),
+		),
+		row.New(6).Add(
+			text.NewCol(6, cust.Address, props.Text{Size: 9}),
+			text.NewCol(6, fmt.Sprintf("Due: %s", inv.DueDate.Format("2006-01-02")), props.Text{Size: 10, Align: align.Right}),
+		),
+	)
+	if cust.TaxID != "" {
+		m.AddRows(row.New(6).Add(text.NewCol(12, fmt.Sprintf("Customer VAT no.: %s", cust.TaxID), props.Text{Size: 9})))
+	}
+	if orig := inv.Edges.OriginalInvoice; orig != nil {
+		m.AddRows(row.New(6).Add(text.NewCol(12, fmt.Sprintf("Credits invoice %s of %s", orig.Number, orig.IssueDate.Format("2006-01-02")), props.Text{Size: 9})))
+	}
+
+	m.AddRows(row.New(10).Add(
+		text.NewCol(6, "Description", props.Text{Size: 9, Style: fontstyle.Bold, Top: 4}),
+		text.NewCol(1, "Qty", props.Text{Size: 9, Style: fontstyle.Bold, Top: 4, Align: align.Right}),
+		text.NewCol(2, "Unit price", props.Text{Size: 9, Style: fontstyle.Bold, Top: 4, Align: align.Right}),
+		text.NewCol(1, "Tax", props.Text{Size: 9, Style: fontstyle.Bold, Top: 4, Align: align.Right}),
+		text.NewCol(2, "Net", props.Text{Size: 9, Style: fontstyle.Bold, Top: 4, Align: align.Right}),
+	))
+	for _, l := range inv.Edges.Lines {
+		m.AddRows(row.New(6).Add(
+			text.NewCol(6, l.Description, props.Text{Size: 9}),
+			text.NewCol(1, l.Quantity.String(), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, l.UnitPrice.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(1, percent(l.TaxRate), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, l.NetAmount.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+		))
+	}
+
+	m.AddRows(totalRow("Subtotal", inv.Subtotal, inv.Currency, false))
+	for _, t := range inv.Edges.TaxLines {
+		label := fmt.Sprintf("%s %s on %s", t.TaxCode, percent(t.Rate), t.TaxableAmount.StringFixed(2))
+		m.AddRows(totalRow(label, t.TaxAmount, inv.Currency, false))
+	}
+	m.AddRows(totalRow("Total", inv.Total, inv.Currency, true))
+
+	if inv.Notes != "" {
+		m.AddRows(row.New(12).Add(text.NewCol(12, inv.Notes, props.Text{Size: 9, Top: 6})))
+	}
+	if qr != "" {
+		m.AddRows(row.New(40).Add(code.NewQrCol(3, qr, props.Rect{Top: 4, Percent: 100})))
+	}
+	m.AddRows(footerRow())
+
+	return documentBytes(m)
+}
+
+// renderStatementPDF renders a customer statement with its aging summary.
+func renderStatementPDF(st *CustomerStatement) ([]byte, error) {
+	m := maroto.New(config.NewBuilder().Build())
+
+	m.AddRows(row.New(20).Add(
+		text.NewCol(12, "CUSTOMER STATEMENT", props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center}),
+	))
+	m.AddRows(
+		row.New(6).Add(
+			text.NewCol(6, st.TenantName, props.Text{Size: 10, Style: fontstyle.Bold}),
+			text.NewCol(6, fmt.Sprintf("Period: %s to %s", st.From.Format("2006-01-02"), st.To.Format("2006-01-02")), props.Text{Size: 10, Align: align.Right}),
+		),
+		row.New(6).Add(
+			text.NewCol(6, fmt.Sprintf("%s (%s)", st.CustomerName, st.CustomerCode), props.Text{Size: 10}),
+			text.NewCol(6, fmt.Sprintf("Currency: %s", st.Currency), props.Text{Size: 10, Align: align.Right}),
+		),
+		row.New(6).Add(text.NewCol(12, st.Address, props.Text{Size: 9})),
+	)
+
+	header := props.Text{Size: 9, Style: fontstyle.Bold, Top: 4}
+	headerRight := props.Text{Size: 9, Style: fontstyle.Bold, Top: 4, Align: align.Right}
+	m.AddRows(row.New(10).Add(
+		text.NewCol(2, "Date", header),
+		text.NewCol(2, "Type", header),
+		text.NewCol(2, "Reference", header),
+		text.NewCol(2, "Debit", headerRight),
+		text.NewCol(2, "Credit", headerRight),
+		text.NewCol(2, "Balance", headerRight),
+	))
+	m.AddRows(row.New(6).Add(
+		text.NewCol(6, "Opening balance", props.Text{Size: 9}),
+		text.NewCol(6, st.OpeningBalance.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+	))
+	for _, l := range st.Lines {
+		m.AddRows(row.New(6).Add(
+			text.NewCol(2, l.Date.Format("2006-01-02"), props.Text{Size: 9}),
+			text.NewCol(2, l.Type, props.Text{Size: 9}),
+			text.NewCol(2, l.Reference, props.Text{Size: 9}),
+			text.NewCol(2, blankZero(l.Debit), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, blankZero(l.Credit), props.Text{Size: 9, Align: align.Right}),
+			text.NewCol(2, l.Balance.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+		))
+	}
+	m.AddRows(totalRow("Amount due", st.ClosingBalance, st.Currency, true))
+
+	a := st.Aging
+	m.AddRows(row.New(10).Add(
+		text.NewCol(2, "Current", headerRight),
+		text.NewCol(2, "1-30", headerRight),
+		text.NewCol(2, "31-60", headerRight),
+		text.NewCol(2, "61-90", headerRight),
+		text.NewCol(2, "90+", headerRight),
+		text.NewCol(2, "Unapplied", headerRight),
+	))
+	m.AddRows(row.New(6).Add(
+		text.NewCol(2, a.Current.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+		text.NewCol(2, a.Days30.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+		text.NewCol(2, a.Days60.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+		text.NewCol(2, a.Days90.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+		text.NewCol(2, a.Over90.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+		text.NewCol(2, a.Unapplied.StringFixed(2), props.Text{Size: 9, Align: align.Right}),
+	))
+	m.AddRows(footerRow())
+
+	return documentBytes(m)
+}
+
+func totalRow(label string, amount decimal.Decimal, currency string, bold bool) core.Row {
+	style := fontstyle.Normal
+	if bold {
+		style = fontstyle.Bold
+	}
+	return row.New(7).Add(
+		text.NewCol(8, label, props.Text{Size: 10, Style: style, Align: align.Right}),
+		text.NewCol(4, fmt.Sprintf("%s %s", amount.StringFixed(2), currency), props.Text{Size: 10, Style: style, Align: align.Right}),
+	)
+}
+
+func footerRow() core.Row {
+	return row.New(14).Add(
+		text.NewCol(12, fmt.Sprintf("Generated on %s | SENTcapital", time.Now().Format("Jan 02, 2006")), props.Text{
+			Size:  8,
+			Top:   8,
+			Align: align.Center,
+			Color: mutedText,
+		}),
+	)
+}
+
+func documentBytes(m core.Maroto) ([]byte, error) {
+	doc, err := m.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("pdf generation failed: %w", err)
+	}
+	return doc.GetBytes(), nil
+}
+
+func percent(rate decimal.Decimal) string {
+	if rate.IsZero() {
+		return "-"
+	}
+	return rate.Mul(decimal.NewFromInt(100)).String() + "%"
+}
+
+func blankZero(d decimal.Decimal) string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.StringFixed(2)
+}
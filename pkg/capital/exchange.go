@@ -1,49 +1,336 @@
 package capital
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/exchangerate"
+	"sent/ent/tenant"
+
 	"github.com/shopspring/decimal"
 )
 
-// ExchangeService handles currency conversions.
-type ExchangeService struct{}
+// ErrRateNotFound is returned when no dated rate can be resolved for a currency pair.
+var ErrRateNotFound = errors.New("exchange rate not found")
 
-// NewExchangeService initializes a new ExchangeService.
-func NewExchangeService() *ExchangeService {
-	return &ExchangeService{}
+// RateQuote is a single dated rate: one unit of Base buys Rate units of Quote.
+type RateQuote struct {
+	Base   string          `json:"base"`
+	Quote  string          `json:"quote"`
+	Rate   decimal.Decimal `json:"rate"`
+	Date   time.Time       `json:"date"`
+	Source string          `json:"source"`
 }
 
-// GetExchangeRate returns the exchange rate from fromCurrency to toCurrency.
-// In a real scenario, this would fetch from an API or database.
-func (s *ExchangeService) GetExchangeRate(from, to string) (decimal.Decimal, error) {
-	// Mock implementation
-	rates := map[string]map[string]float64{
-		"USD": {
-			"JOD": 0.709,
-			"SAR": 3.75,
-			"USD": 1.0,
-		},
-		"JOD": {
-			"USD": 1.41,
-			"SAR": 5.29,
-			"JOD": 1.0,
-		},
+// RateTable resolves rates from a set of dated quotes. A pair is looked up
+// directly, then inverted, then triangulated through any shared currency
+// (ECB publishes everything against EUR, for example).
+type RateTable struct {
+	quotes []RateQuote
+}
+
+// NewRateTable builds a table from the given quotes.
+func NewRateTable(quotes []RateQuote) *RateTable {
+	sorted := make([]RateQuote, len(quotes))
+	copy(sorted, quotes)
+	// Newest first so the first match on or before a date wins.
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Date.After(sorted[j].Date) })
+	return &RateTable{quotes: sorted}
+}
+
+// Rate returns the rate from one currency to another effective on the given date.
+func (t *RateTable) Rate(from, to string, on time.Time) (decimal.Decimal, error) {
+	from, to = normalizeCurrency(from), normalizeCurrency(to)
+	if from == to {
+		return decimal.NewFromInt(1), nil
+	}
+	if rate, ok := t.pair(from, to, on); ok {
+		return rate, nil
 	}
 
-	if fromRates, ok := rates[from]; ok {
-		if rate, ok := fromRates[to]; ok {
-			return decimal.NewFromFloat(rate), nil
+	for _, pivot := range t.currencies() {
+		if pivot == from || pivot == to {
+			continue
+		}
+		leg1, ok := t.pair(from, pivot, on)
+		if !ok {
+			continue
+		}
+		leg2, ok := t.pair(pivot, to, on)
+		if !ok {
+			continue
+		}
+		return leg1.Mul(leg2), nil
+	}
+
+	return decimal.Zero, fmt.Errorf("%w: %s/%s on %s", ErrRateNotFound, from, to, on.Format("2006-01-02"))
+}
+
+// pair resolves a direct or inverted quote for the pair on the given date.
+func (t *RateTable) pair(from, to string, on time.Time) (decimal.Decimal, bool) {
+	cutoff := endOfDay(on)
+	for _, q := range t.quotes {
+		if q.Date.After(cutoff) || q.Rate.IsZero() {
+			continue
+		}
+		if q.Base == from && q.Quote == to {
+			return q.Rate, true
+		}
+		if q.Base == to && q.Quote == from {
+			return decimal.NewFromInt(1).DivRound(q.Rate, 10), true
+		}
+	}
+	return decimal.Zero, false
+}
+
+func (t *RateTable) currencies() []string {
+	seen := make(map[string]bool)
+	var list []string
+	for _, q := range t.quotes {
+		for _, c := range []string{q.Base, q.Quote} {
+			if !seen[c] {
+				seen[c] = true
+				list = append(list, c)
+			}
 		}
 	}
+	sort.Strings(list)
+	return list
+}
+
+// ExchangeService handles currency conversions against the tenant's dated rate table.
+type ExchangeService struct {
+	db *ent.Client
+}
 
-	// Fallback/Default
-	return decimal.NewFromFloat(1.0), nil
+// NewExchangeService initializes a new ExchangeService.
+func NewExchangeService(db *ent.Client) *ExchangeService {
+	return &ExchangeService{db: db}
 }
 
-// Convert converts an amount from one currency to another.
-func (s *ExchangeService) Convert(amount decimal.Decimal, from, to string) (decimal.Decimal, error) {
-	rate, err := s.GetExchangeRate(from, to)
+// GetExchangeRate returns the rate from fromCurrency to toCurrency effective on the given date.
+// It never falls back to parity: a missing rate is an error the caller must surface.
+func (s *ExchangeService) GetExchangeRate(ctx context.Context, tenantID int, from, to string, on time.Time) (decimal.Decimal, error) {
+	from, to = normalizeCurrency(from), normalizeCurrency(to)
+	if from == to {
+		return decimal.NewFromInt(1), nil
+	}
+
+	// Every usable quote (direct, inverse or a triangulation leg) touches one of the two currencies.
+	rows, err := s.db.ExchangeRate.Query().
+		Where(
+			exchangerate.HasTenantWith(tenant.ID(tenantID)),
+			exchangerate.EffectiveDateLTE(endOfDay(on)),
+			exchangerate.Or(
+				exchangerate.BaseCurrencyIn(from, to),
+				exchangerate.QuoteCurrencyIn(from, to),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to query exchange rates: %w", err)
+	}
+
+	quotes := make([]RateQuote, len(rows))
+	for i, r := range rows {
+		quotes[i] = RateQuote{Base: r.BaseCurrency, Quote: r.QuoteCurrency, Rate: r.Rate, Date: r.EffectiveDate, Source: r.Source}
+	}
+	return NewRateTable(quotes).Rate(from, to, on)
+}
+
+// Convert converts an amount from one currency to another at the rate effective on the given date,
+// rounded to the minor units of the target currency.
+func (s *ExchangeService) Convert(ctx context.Context, tenantID int, amount decimal.Decimal, from, to string, on time.Time) (decimal.Decimal, error) {
+	rate, err := s.GetExchangeRate(ctx, tenantID, from, to, on)
 	if err != nil {
 		return decimal.Zero, err
 	}
-	return amount.Mul(rate).RoundBank(2), nil
+	return RoundCurrency(amount.Mul(rate), to), nil
+}
+
+// FunctionalCurrency returns the reporting currency configured for a tenant.
+func (s *ExchangeService) FunctionalCurrency(ctx context.Context, tenantID int) (string, error) {
+	t, err := s.db.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load tenant %d: %w", tenantID, err)
+	}
+	return normalizeCurrency(t.FunctionalCurrency), nil
+}
+
+// StoreRates upserts quotes into the tenant's rate table and returns how many rows were written.
+func (s *ExchangeService) StoreRates(ctx context.Context, tenantID int, quotes []RateQuote) (int, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	written := 0
+	for _, q := range quotes {
+		day := startOfDay(q.Date)
+		existing, err := tx.ExchangeRate.Query().
+			Where(
+				exchangerate.HasTenantWith(tenant.ID(tenantID)),
+				exchangerate.BaseCurrency(q.Base),
+				exchangerate.QuoteCurrency(q.Quote),
+				exchangerate.EffectiveDate(day),
+			).
+			Only(ctx)
+		switch {
+		case err == nil:
+			err = tx.ExchangeRate.UpdateOne(existing).SetRate(q.Rate).SetSource(q.Source).Exec(ctx)
+		case ent.IsNotFound(err):
+			err = tx.ExchangeRate.Create().
+				SetTenantID(tenantID).
+				SetBaseCurrency(q.Base).
+				SetQuoteCurrency(q.Quote).
+				SetRate(q.Rate).
+				SetEffectiveDate(day).
+				SetSource(q.Source).
+				Exec(ctx)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to store %s/%s rate for %s: %w", q.Base, q.Quote, day.Format("2006-01-02"), err)
+		}
+		written++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return written, nil
+}
+
+// ParseRatesCSV reads "date,base,quote,rate" rows. A header row is skipped if present.
+func ParseRatesCSV(r io.Reader) ([]RateQuote, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("csv parsing failed: %w", err)
+	}
+
+	var quotes []RateQuote
+	for i, rec := range records {
+		if len(rec) < 4 {
+			continue
+		}
+		if i == 0 && strings.EqualFold(strings.TrimSpace(rec[0]), "date") {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(rec[0]))
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid date %q", i+1, rec[0])
+		}
+		rate, err := decimal.NewFromString(strings.TrimSpace(rec[3]))
+		if err != nil || !rate.IsPositive() {
+			return nil, fmt.Errorf("row %d: invalid rate %q", i+1, rec[3])
+		}
+		quotes = append(quotes, RateQuote{
+			Base:   normalizeCurrency(rec[1]),
+			Quote:  normalizeCurrency(rec[2]),
+			Rate:   rate,
+			Date:   date,
+			Source: "csv",
+		})
+	}
+	return quotes, nil
+}
+
+// ecbEnvelope mirrors the eurofxref daily/historical XML published by the ECB.
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECBRates reads the ECB reference-rate XML. All quotes are EUR-based.
+func ParseECBRates(r io.Reader) ([]RateQuote, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var env ecbEnvelope
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&env); err != nil {
+		return nil, fmt.Errorf("ecb xml parsing failed: %w", err)
+	}
+
+	var quotes []RateQuote
+	for _, day := range env.Days {
+		date, err := time.Parse("2006-01-02", day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ECB date %q", day.Time)
+		}
+		for _, c := range day.Rates {
+			rate, err := decimal.NewFromString(c.Rate)
+			if err != nil || !rate.IsPositive() {
+				return nil, fmt.Errorf("invalid ECB rate %q for %s", c.Rate, c.Currency)
+			}
+			quotes = append(quotes, RateQuote{
+				Base:   "EUR",
+				Quote:  normalizeCurrency(c.Currency),
+				Rate:   rate,
+				Date:   date,
+				Source: "ecb",
+			})
+		}
+	}
+	if len(quotes) == 0 {
+		return nil, fmt.Errorf("no rates found in ECB document")
+	}
+	return quotes, nil
+}
+
+// currencyMinorUnits lists ISO 4217 currencies that do not use two decimal places.
+var currencyMinorUnits = map[string]int32{
+	"BHD": 3,
+	"IQD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"JPY": 0,
+	"KRW": 0,
+}
+
+// RoundCurrency rounds an amount to the minor units of the given currency using banker's rounding.
+func RoundCurrency(amount decimal.Decimal, currency string) decimal.Decimal {
+	return amount.RoundBank(CurrencyPlaces(currency))
+}
+
+// CurrencyPlaces returns the number of decimal places of the currency's minor unit.
+func CurrencyPlaces(currency string) int32 {
+	places, ok := currencyMinorUnits[normalizeCurrency(currency)]
+	if !ok {
+		places = 2
+	}
+	return places
+}
+
+func normalizeCurrency(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func endOfDay(t time.Time) time.Time {
+	return startOfDay(t).AddDate(0, 0, 1).Add(-time.Nanosecond)
 }
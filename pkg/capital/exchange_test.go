@@ -0,0 +1,135 @@
+package capital
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func TestRateTableLookup(t *testing.T) {
+	table := NewRateTable([]RateQuote{
+		{Base: "USD", Quote: "JOD", Rate: decimal.RequireFromString("0.709"), Date: date("2024-01-01")},
+		{Base: "USD", Quote: "JOD", Rate: decimal.RequireFromString("0.710"), Date: date("2024-02-01")},
+		{Base: "USD", Quote: "SAR", Rate: decimal.RequireFromString("3.75"), Date: date("2024-01-01")},
+	})
+
+	tests := []struct {
+		name     string
+		from, to string
+		on       string
+		want     string
+	}{
+		{"same currency", "JOD", "jod", "2024-01-15", "1"},
+		{"direct, older quote", "USD", "JOD", "2024-01-31", "0.709"},
+		{"direct, newest quote", "USD", "JOD", "2024-03-01", "0.71"},
+		{"inverse", "SAR", "USD", "2024-01-15", "0.2666666667"},
+		{"triangulated through USD", "SAR", "JOD", "2024-01-15", "0.1890666667"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := table.Rate(tt.from, tt.to, date(tt.on))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Round(10).Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("Rate(%s, %s) = %s, want %s", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateTableNoFallback(t *testing.T) {
+	table := NewRateTable([]RateQuote{
+		{Base: "USD", Quote: "JOD", Rate: decimal.RequireFromString("0.709"), Date: date("2024-01-01")},
+	})
+
+	if _, err := table.Rate("USD", "JOD", date("2023-12-31")); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("expected ErrRateNotFound before the first quote, got %v", err)
+	}
+	if _, err := table.Rate("USD", "EUR", date("2024-01-15")); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("expected ErrRateNotFound for unknown pair, got %v", err)
+	}
+}
+
+func TestParseRatesCSV(t *testing.T) {
+	input := "date,base,quote,rate\n2024-01-01, usd, jod, 0.709\n2024-01-01,USD,SAR,3.75\n"
+	quotes, err := ParseRatesCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(quotes) != 2 {
+		t.Fatalf("expected 2 quotes, got %d", len(quotes))
+	}
+	if quotes[0].Base != "USD" || quotes[0].Quote != "JOD" || quotes[0].Source != "csv" {
+		t.Errorf("unexpected first quote: %+v", quotes[0])
+	}
+
+	if _, err := ParseRatesCSV(strings.NewReader("2024-01-01,USD,JOD,-1\n")); err == nil {
+		t.Error("expected error for negative rate")
+	}
+}
+
+func TestParseECBRates(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2024-01-02">
+			<Cube currency="USD" rate="1.0956"/>
+			<Cube currency="JPY" rate="155.52"/>
+		</Cube>
+		<Cube time="2024-01-01">
+			<Cube currency="USD" rate="1.1050"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+	quotes, err := ParseECBRates(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(quotes) != 3 {
+		t.Fatalf("expected 3 quotes, got %d", len(quotes))
+	}
+
+	rate, err := NewRateTable(quotes).Rate("EUR", "USD", date("2024-01-02"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !rate.Equal(decimal.RequireFromString("1.0956")) {
+		t.Errorf("expected 1.0956, got %s", rate)
+	}
+}
+
+func TestRoundCurrency(t *testing.T) {
+	amount := decimal.RequireFromString("10.12345")
+	if got := RoundCurrency(amount, "JOD"); !got.Equal(decimal.RequireFromString("10.123")) {
+		t.Errorf("JOD should round to 3 places, got %s", got)
+	}
+	if got := RoundCurrency(amount, "SAR"); !got.Equal(decimal.RequireFromString("10.12")) {
+		t.Errorf("SAR should round to 2 places, got %s", got)
+	}
+	if got := RoundCurrency(amount, "JPY"); !got.Equal(decimal.RequireFromString("10")) {
+		t.Errorf("JPY should round to 0 places, got %s", got)
+	}
+}
+
+func TestMonthEndSchedule(t *testing.T) {
+	next := MonthEndSchedule{}.Next(time.Date(2024, 12, 15, 10, 0, 0, 0, time.UTC))
+	if !next.Equal(time.Date(2025, 1, 1, 0, 5, 0, 0, time.UTC)) {
+		t.Errorf("unexpected next run: %s", next)
+	}
+	end := previousMonthEnd(next)
+	if end.Format("2006-01-02") != "2024-12-31" {
+		t.Errorf("unexpected period end: %s", end)
+	}
+}
@@ -0,0 +1,538 @@
+package capital
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/accountbalancesnapshot"
+	"sent/ent/journalentry"
+	"sent/ent/ledgerentry"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+
+	"github.com/riverqueue/river"
+	"github.com/shopspring/decimal"
+)
+
+// ErrUnbalancedPosting is returned when functional-currency debits and credits differ.
+var ErrUnbalancedPosting = errors.New("posting out of balance")
+
+// PostingLine is a single debit or credit of a posting.
+// Amount is always in the tenant's functional currency. When Currency is empty the line is
+// a plain functional-currency line; otherwise CurrencyAmount and Rate describe the original figures.
+type PostingLine struct {
+	AccountID      int
+	Direction      string // "debit" or "credit"
+	Amount         decimal.Decimal
+	Currency       string
+	CurrencyAmount decimal.Decimal
+	Rate           decimal.Decimal
+	Description    string
+}
+
+// Posting is a complete balanced journal to be written to the general ledger.
+type Posting struct {
+	TenantID        int
+	Date            time.Time
+	Description     string
+	Type            string // sale, refund, manual_adjustment, fx_revaluation, ...
+	Reference       string
+	UUID            string          // Idempotency key, e.g. a client-generated sale ID; empty generates one
+	Currency        string          // Transaction currency; empty means functional currency
+	ExchangeRate    decimal.Decimal // Transaction rate to functional; zero means 1
+	ApprovalStatus  string          // APPROVED (default) or STAGED
+	AllowSoftClosed bool            // Adjustments by finance managers or period-end jobs
+	Lines           []PostingLine
+}
+
+// LedgerService is the single entry point for writing to the general ledger. Every module
+// posts through it so that period locks, balancing and the balance cache are applied the same way.
+type LedgerService struct{}
+
+// NewLedgerService initializes a new LedgerService.
+func NewLedgerService() *LedgerService {
+	return &LedgerService{}
+}
+
+// Post writes the Transaction header and a JournalEntry/LedgerEntry pair per line inside the
+// caller's database transaction. Approved postings are applied to the account balance cache.
+func (s *LedgerService) Post(ctx context.Context, tx *ent.Tx, p Posting) (*ent.Transaction, error) {
+	if len(p.Lines) == 0 {
+		return nil, fmt.Errorf("posting %q has no lines", p.Description)
+	}
+	if p.Date.IsZero() {
+		p.Date = time.Now()
+	}
+	if p.ApprovalStatus == "" {
+		p.ApprovalStatus = string(transaction.ApprovalStatusAPPROVED)
+	}
+
+	tnt, err := tx.Tenant.Get(ctx, p.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %d not found: %w", p.TenantID, err)
+	}
+
+	if err := EnsurePostingAllowed(ctx, tx.FiscalPeriod, p.TenantID, p.Date, p.AllowSoftClosed); err != nil {
+		return nil, err
+	}
+
+	functional := normalizeCurrency(tnt.FunctionalCurrency)
+	if p.Currency == "" {
+		p.Currency = functional
+	}
+	if !p.ExchangeRate.IsPositive() {
+		p.ExchangeRate = decimal.NewFromInt(1)
+	}
+
+	total, err := checkBalanced(p.Lines)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", p.Description, err)
+	}
+
+	create := tx.Transaction.Create().
+		SetDescription(p.Description).
+		SetDate(p.Date).
+		SetTenant(tnt).
+		SetTotalAmount(total).
+		SetCurrency(normalizeCurrency(p.Currency)).
+		SetExchangeRate(p.ExchangeRate).
+		SetApprovalStatus(transaction.ApprovalStatus(p.ApprovalStatus))
+	if p.Type != "" {
+		create.SetType(p.Type)
+	}
+	if p.Reference != "" {
+		create.SetReference(p.Reference)
+	}
+	if p.UUID != "" {
+		create.SetUUID(p.UUID)
+	}
+	txn, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save transaction header: %w", err)
+	}
+
+	approved := p.ApprovalStatus == string(transaction.ApprovalStatusAPPROVED)
+	for _, l := range p.Lines {
+		if l.Currency == "" {
+			l.Currency, l.CurrencyAmount, l.Rate = functional, l.Amount, decimal.NewFromInt(1)
+		}
+		if l.Description == "" {
+			l.Description = p.Description
+		}
+
+		_, err := tx.JournalEntry.Create().
+			SetAmount(l.Amount).
+			SetDirection(journalentry.Direction(l.Direction)).
+			SetCurrency(l.Currency).
+			SetCurrencyAmount(l.CurrencyAmount).
+			SetExchangeRate(l.Rate).
+			SetAccountID(l.AccountID).
+			SetTransaction(txn).
+			SetTenant(tnt).
+			SetDescription(l.Description).
+			SetApprovalStatus(journalentry.ApprovalStatus(p.ApprovalStatus)).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save journal entry: %w", err)
+		}
+
+		// LedgerEntry is kept for the reporting paths that still read it.
+		_, err = tx.LedgerEntry.Create().
+			SetAmount(l.Amount).
+			SetDirection(ledgerentry.Direction(l.Direction)).
+			SetCurrency(l.Currency).
+			SetCurrencyAmount(l.CurrencyAmount).
+			SetExchangeRate(l.Rate).
+			SetAccountID(l.AccountID).
+			SetTransaction(txn).
+			SetTenant(tnt).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save ledger entry: %w", err)
+		}
+
+		if approved {
+			if err := applyToBalance(ctx, tx, l.AccountID, l.Direction, l.Amount); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if approved {
+		if err := invalidateSnapshots(ctx, tx, p.TenantID, p.Date); err != nil {
+			return nil, err
+		}
+	}
+
+	return txn, nil
+}
+
+// Approve moves a STAGED transaction to APPROVED and applies its entries to the balance cache.
+func (s *LedgerService) Approve(ctx context.Context, tx *ent.Tx, txn *ent.Transaction, allowSoftClosed bool) error {
+	if txn.ApprovalStatus != transaction.ApprovalStatusSTAGED {
+		return fmt.Errorf("transaction is not in a stageable status (STAGED)")
+	}
+
+	tenantID, err := txn.QueryTenant().OnlyID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := EnsurePostingAllowed(ctx, tx.FiscalPeriod, tenantID, txn.Date, allowSoftClosed); err != nil {
+		return err
+	}
+
+	if err := tx.Transaction.UpdateOne(txn).SetApprovalStatus(transaction.ApprovalStatusAPPROVED).Exec(ctx); err != nil {
+		return err
+	}
+
+	entries, err := tx.JournalEntry.Query().
+		Where(journalentry.HasTransactionWith(transaction.ID(txn.ID))).
+		WithAccount().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if e.Edges.Account == nil {
+			return fmt.Errorf("journal entry %d missing account edge", e.ID)
+		}
+		if err := applyToBalance(ctx, tx, e.Edges.Account.ID, string(e.Direction), e.Amount); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.JournalEntry.Update().
+		Where(journalentry.HasTransactionWith(transaction.ID(txn.ID))).
+		SetApprovalStatus(journalentry.ApprovalStatusAPPROVED).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return invalidateSnapshots(ctx, tx, tenantID, txn.Date)
+}
+
+// checkBalanced validates the lines and returns the total of the debits.
+func checkBalanced(lines []PostingLine) (decimal.Decimal, error) {
+	debits, credits := decimal.Zero, decimal.Zero
+	for _, l := range lines {
+		if l.Amount.IsNegative() {
+			return decimal.Zero, fmt.Errorf("negative amount %s on account %d", l.Amount, l.AccountID)
+		}
+		switch l.Direction {
+		case "debit":
+			debits = debits.Add(l.Amount)
+		case "credit":
+			credits = credits.Add(l.Amount)
+		default:
+			return decimal.Zero, fmt.Errorf("invalid direction %q on account %d", l.Direction, l.AccountID)
+		}
+	}
+	if !debits.Equal(credits) {
+		return decimal.Zero, fmt.Errorf("%w: Debits (%s) != Credits (%s)", ErrUnbalancedPosting, debits, credits)
+	}
+	return debits, nil
+}
+
+// NormalSideDelta returns the effect of an entry on an account's balance as seen from its
+// normal side: debits increase assets and expenses, credits increase liabilities, equity and revenue.
+func NormalSideDelta(accType account.Type, direction string, amount decimal.Decimal) decimal.Decimal {
+	debitNormal := accType == account.TypeAsset || accType == account.TypeExpense
+	if (direction == "debit") == debitNormal {
+		return amount
+	}
+	return amount.Neg()
+}
+
+func applyToBalance(ctx context.Context, tx *ent.Tx, accountID int, direction string, amount decimal.Decimal) error {
+	acc, err := tx.Account.Get(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	delta := NormalSideDelta(acc.Type, direction, amount)
+	if err := tx.Account.UpdateOne(acc).SetBalance(acc.Balance.Add(delta)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update account balance: %w", err)
+	}
+	return nil
+}
+
+// invalidateSnapshots drops snapshots that a posting dated on or before their period end makes stale.
+func invalidateSnapshots(ctx context.Context, tx *ent.Tx, tenantID int, date time.Time) error {
+	_, err := tx.AccountBalanceSnapshot.Delete().
+		Where(
+			accountbalancesnapshot.HasTenantWith(tenant.ID(tenantID)),
+			accountbalancesnapshot.PeriodEndGTE(date),
+		).
+		Exec(ctx)
+	return err
+}
+
+// EnsureAccount returns the tenant's account with the given number, creating it if missing.
+func EnsureAccount(ctx context.Context, tx *ent.Tx, tnt *ent.Tenant, number, name string, typ account.Type) (*ent.Account, error) {
+	acc, err := tx.Account.Query().
+		Where(account.Number(number), account.HasTenantWith(tenant.ID(tnt.ID))).
+		Only(ctx)
+	if err == nil {
+		return acc, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+	acc, err = tx.Account.Create().
+		SetName(name).
+		SetNumber(number).
+		SetType(typ).
+		SetTenant(tnt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account %s: %w", number, err)
+	}
+	return acc, nil
+}
+
+// BalancesAsOf derives every account balance of a tenant on its normal side at the given date,
+// starting from the latest snapshot on or before that date and adding the approved entries after it.
+func BalancesAsOf(ctx context.Context, db *ent.Client, tenantID int, asOf time.Time) (map[int]decimal.Decimal, error) {
+	balances := make(map[int]decimal.Decimal)
+	var since time.Time
+
+	latest, err := db.AccountBalanceSnapshot.Query().
+		Where(
+			accountbalancesnapshot.HasTenantWith(tenant.ID(tenantID)),
+			accountbalancesnapshot.PeriodEndLTE(asOf),
+		).
+		Order(ent.Desc(accountbalancesnapshot.FieldPeriodEnd)).
+		First(ctx)
+	switch {
+	case err == nil:
+		since = latest.PeriodEnd
+		snaps, err := db.AccountBalanceSnapshot.Query().
+			Where(
+				accountbalancesnapshot.HasTenantWith(tenant.ID(tenantID)),
+				accountbalancesnapshot.PeriodEnd(latest.PeriodEnd),
+			).
+			WithAccount().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range snaps {
+			balances[s.Edges.Account.ID] = s.ClosingBalance
+		}
+	case !ent.IsNotFound(err):
+		return nil, err
+	}
+
+	activity, err := periodActivity(ctx, db, tenantID, since, asOf)
+	if err != nil {
+		return nil, err
+	}
+	for id, a := range activity {
+		balances[id] = balances[id].Add(a.net)
+	}
+	return balances, nil
+}
+
+type accountActivity struct {
+	debits, credits, net decimal.Decimal
+}
+
+// periodActivity sums approved journal entries dated after from (exclusive; zero means the
+// beginning of time) up to and including to.
+func periodActivity(ctx context.Context, db *ent.Client, tenantID int, from, to time.Time) (map[int]*accountActivity, error) {
+	q := db.JournalEntry.Query().
+		Where(
+			journalentry.HasTenantWith(tenant.ID(tenantID)),
+			journalentry.ApprovalStatusEQ(journalentry.ApprovalStatusAPPROVED),
+		).
+		WithAccount()
+	if from.IsZero() {
+		q = q.Where(journalentry.HasTransactionWith(transaction.DateLTE(to)))
+	} else {
+		q = q.Where(journalentry.HasTransactionWith(transaction.DateGT(from), transaction.DateLTE(to)))
+	}
+
+	entries, err := q.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load journal entries: %w", err)
+	}
+
+	activity := make(map[int]*accountActivity)
+	for _, e := range entries {
+		acc := e.Edges.Account
+		a, ok := activity[acc.ID]
+		if !ok {
+			a = &accountActivity{debits: decimal.Zero, credits: decimal.Zero, net: decimal.Zero}
+			activity[acc.ID] = a
+		}
+		if e.Direction == journalentry.DirectionDebit {
+			a.debits = a.debits.Add(e.Amount)
+		} else {
+			a.credits = a.credits.Add(e.Amount)
+		}
+		a.net = a.net.Add(NormalSideDelta(acc.Type, string(e.Direction), e.Amount))
+	}
+	return activity, nil
+}
+
+// SnapshotPeriod materializes the closing balance of every account of the tenant for the period.
+func SnapshotPeriod(ctx context.Context, db *ent.Client, tenantID int, periodStart, periodEnd time.Time) (int, error) {
+	opening, err := BalancesAsOf(ctx, db, tenantID, periodStart.Add(-time.Nanosecond))
+	if err != nil {
+		return 0, err
+	}
+	activity, err := periodActivity(ctx, db, tenantID, periodStart.Add(-time.Nanosecond), periodEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	accounts, err := db.Account.Query().Where(account.HasTenantWith(tenant.ID(tenantID))).All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.AccountBalanceSnapshot.Delete().
+		Where(
+			accountbalancesnapshot.HasTenantWith(tenant.ID(tenantID)),
+			accountbalancesnapshot.PeriodEnd(periodEnd),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	bulk := make([]*ent.AccountBalanceSnapshotCreate, 0, len(accounts))
+	for _, acc := range accounts {
+		a := activity[acc.ID]
+		if a == nil {
+			a = &accountActivity{debits: decimal.Zero, credits: decimal.Zero, net: decimal.Zero}
+		}
+		bulk = append(bulk, tx.AccountBalanceSnapshot.Create().
+			SetTenantID(tenantID).
+			SetAccount(acc).
+			SetPeriodStart(periodStart).
+			SetPeriodEnd(periodEnd).
+			SetDebitTotal(a.debits).
+			SetCreditTotal(a.credits).
+			SetClosingBalance(opening[acc.ID].Add(a.net)))
+	}
+	if _, err := tx.AccountBalanceSnapshot.CreateBulk(bulk...).Save(ctx); err != nil {
+		return 0, fmt.Errorf("failed to save balance snapshots: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(bulk), nil
+}
+
+// BalanceDrift reports an account whose cached balance disagrees with its journal entries.
+type BalanceDrift struct {
+	TenantID      int             `json:"tenant_id"`
+	AccountID     int             `json:"account_id"`
+	AccountNumber string          `json:"account_number"`
+	AccountName   string          `json:"account_name"`
+	Stored        decimal.Decimal `json:"stored"`
+	Derived       decimal.Decimal `json:"derived"`
+	Drift         decimal.Decimal `json:"drift"`
+}
+
+// VerifyBalances compares every cached Account.balance of the tenant with the full sum of its
+// approved journal entries. Snapshots are deliberately not used so the check is independent.
+// With fix set, drifting balances are reset to the derived figure.
+func VerifyBalances(ctx context.Context, db *ent.Client, tenantID int, fix bool) ([]BalanceDrift, error) {
+	activity, err := periodActivity(ctx, db, tenantID, time.Time{}, time.Now().AddDate(100, 0, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := db.Account.Query().
+		Where(account.HasTenantWith(tenant.ID(tenantID))).
+		Order(ent.Asc(account.FieldNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts []BalanceDrift
+	for _, acc := range accounts {
+		derived := decimal.Zero
+		if a := activity[acc.ID]; a != nil {
+			derived = a.net
+		}
+		if acc.Balance.Equal(derived) {
+			continue
+		}
+		drifts = append(drifts, BalanceDrift{
+			TenantID:      tenantID,
+			AccountID:     acc.ID,
+			AccountNumber: acc.Number,
+			AccountName:   acc.Name,
+			Stored:        acc.Balance,
+			Derived:       derived,
+			Drift:         acc.Balance.Sub(derived),
+		})
+		if fix {
+			if err := db.Account.UpdateOne(acc).SetBalance(derived).Exec(ctx); err != nil {
+				return drifts, fmt.Errorf("failed to correct account %s: %w", acc.Number, err)
+			}
+		}
+	}
+	return drifts, nil
+}
+
+// LedgerSnapshotArgs defines the arguments for the periodic balance snapshot job.
+// A zero TenantID snapshots every tenant; a zero PeriodEnd means the previous calendar month.
+type LedgerSnapshotArgs struct {
+	TenantID    int       `json:"tenant_id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+func (LedgerSnapshotArgs) Kind() string { return "capital:ledger_snapshot" }
+
+// LedgerSnapshotWorker materializes per-period balance snapshots.
+type LedgerSnapshotWorker struct {
+	river.WorkerDefaults[LedgerSnapshotArgs]
+	db *ent.Client
+}
+
+func NewLedgerSnapshotWorker(db *ent.Client) *LedgerSnapshotWorker {
+	return &LedgerSnapshotWorker{db: db}
+}
+
+func (w *LedgerSnapshotWorker) Work(ctx context.Context, job *river.Job[LedgerSnapshotArgs]) error {
+	start, end := job.Args.PeriodStart, job.Args.PeriodEnd
+	if end.IsZero() {
+		end = previousMonthEnd(time.Now())
+		start = time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, end.Location())
+	}
+
+	tenantIDs := []int{job.Args.TenantID}
+	if job.Args.TenantID == 0 {
+		ids, err := w.db.Tenant.Query().Where(tenant.Active(true)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		tenantIDs = ids
+	}
+
+	var failed []error
+	for _, id := range tenantIDs {
+		if _, err := SnapshotPeriod(ctx, w.db, id, start, end); err != nil {
+			failed = append(failed, fmt.Errorf("tenant %d: %w", id, err))
+		}
+	}
+	return errors.Join(failed...)
+}
@@ -0,0 +1,62 @@
+package capital
+
+import (
+	"errors"
+	"testing"
+
+	"sent/ent/account"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNormalSideDelta(t *testing.T) {
+	amount := decimal.NewFromInt(100)
+	tests := []struct {
+		accType   account.Type
+		direction string
+		want      int64
+	}{
+		{account.TypeAsset, "debit", 100},
+		{account.TypeAsset, "credit", -100},
+		{account.TypeExpense, "debit", 100},
+		{account.TypeLiability, "debit", -100},
+		{account.TypeLiability, "credit", 100},
+		{account.TypeEquity, "credit", 100},
+		{account.TypeRevenue, "credit", 100},
+		{account.TypeRevenue, "debit", -100},
+	}
+
+	for _, tt := range tests {
+		got := NormalSideDelta(tt.accType, tt.direction, amount)
+		if !got.Equal(decimal.NewFromInt(tt.want)) {
+			t.Errorf("NormalSideDelta(%s, %s) = %s, want %d", tt.accType, tt.direction, got, tt.want)
+		}
+	}
+}
+
+func TestCheckBalanced(t *testing.T) {
+	total, err := checkBalanced([]PostingLine{
+		{AccountID: 1, Direction: "debit", Amount: decimal.RequireFromString("70.50")},
+		{AccountID: 2, Direction: "debit", Amount: decimal.RequireFromString("29.50")},
+		{AccountID: 3, Direction: "credit", Amount: decimal.NewFromInt(100)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !total.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("expected total 100, got %s", total)
+	}
+
+	_, err = checkBalanced([]PostingLine{
+		{AccountID: 1, Direction: "debit", Amount: decimal.NewFromInt(100)},
+		{AccountID: 2, Direction: "credit", Amount: decimal.NewFromInt(99)},
+	})
+	if !errors.Is(err, ErrUnbalancedPosting) {
+		t.Errorf("expected ErrUnbalancedPosting, got %v", err)
+	}
+
+	_, err = checkBalanced([]PostingLine{{AccountID: 1, Direction: "sideways", Amount: decimal.Zero}})
+	if err == nil {
+		t.Error("expected error for invalid direction")
+	}
+}
@@ -0,0 +1,1260 @@
+package capital
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/paymentrun"
+	"sent/ent/paymentrunline"
+	"sent/ent/purchaseorder"
+	"sent/ent/purchaseorderline"
+	"sent/ent/supplier"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+	"sent/ent/tenant"
+	"sent/pkg/database"
+	"sent/pkg/tax"
+
+	"github.com/shopspring/decimal"
+)
+
+// Accounts payable side of the chart. All are created on demand.
+const (
+	AccountInventory  = "1300"
+	AccountInputTax   = tax.AccountInputTax
+	AccountPayable    = "2000"
+	AccountGRNI       = "2150" // Goods received not invoiced
+	AccountLandedCost = "2160" // Freight, duty and insurance capitalized but not yet billed
+)
+
+var (
+	// ErrMatchException is returned when a bill with unresolved variances is approved without override.
+	ErrMatchException = errors.New("bill has unresolved match exceptions")
+	// ErrDuplicateBill is returned when a supplier invoice number has already been entered.
+	ErrDuplicateBill = errors.New("supplier invoice already recorded")
+)
+
+var hundred = decimal.NewFromInt(100)
+
+// MatchTolerance is how far a bill may deviate from the order and receipts before it is flagged.
+// Both values are percentages.
+type MatchTolerance struct {
+	PricePercent    decimal.Decimal
+	QuantityPercent decimal.Decimal
+}
+
+// matchInput is one bill line seen against its purchase order line. Costs are per unit in
+// functional currency.
+type matchInput struct {
+	Ordered          decimal.Decimal
+	Received         decimal.Decimal
+	PreviouslyBilled decimal.Decimal // On other bills that are not void
+	Quantity         decimal.Decimal
+	OrderedCost      decimal.Decimal
+	BilledCost       decimal.Decimal
+}
+
+// lineMatch is the outcome of the three-way match for one line.
+type lineMatch struct {
+	Status           supplierbillline.MatchStatus
+	QuantityVariance decimal.Decimal
+	PriceVariance    decimal.Decimal
+	Note             string
+}
+
+// matchLine compares billed quantity with what was received and ordered, and the billed unit
+// cost with the ordered cost. Variances are always recorded; the line is only flagged when they
+// exceed the tolerance. Billing below the ordered cost is never flagged.
+func matchLine(in matchInput, tol MatchTolerance) lineMatch {
+	m := lineMatch{Status: supplierbillline.MatchStatusMatched}
+	var notes []string
+
+	billed := in.PreviouslyBilled.Add(in.Quantity)
+	if over := billed.Sub(in.Received); over.IsPositive() {
+		m.QuantityVariance = over
+		if over.GreaterThan(in.Received.Mul(tol.QuantityPercent).Div(hundred)) {
+			notes = append(notes, fmt.Sprintf("billed %s but received %s", billed, in.Received))
+		}
+	}
+	if billed.GreaterThan(in.Ordered.Add(in.Ordered.Mul(tol.QuantityPercent).Div(hundred))) {
+		notes = append(notes, fmt.Sprintf("billed %s but ordered %s", billed, in.Ordered))
+	}
+
+	diff := in.BilledCost.Sub(in.OrderedCost)
+	m.PriceVariance = diff.Mul(in.Quantity).Round(4)
+	if diff.GreaterThan(in.OrderedCost.Mul(tol.PricePercent).Div(hundred)) {
+		notes = append(notes, fmt.Sprintf("unit cost %s exceeds ordered %s", in.BilledCost.StringFixed(4), in.OrderedCost.StringFixed(4)))
+	}
+
+	if len(notes) > 0 {
+		m.Status = supplierbillline.MatchStatusVariance
+		m.Note = strings.Join(notes, "; ")
+	}
+	return m
+}
+
+// PostGoodsReceipt values received stock into inventory. The goods are accrued against goods
+// received not invoiced at the ordered cost, which the supplier bill clears on approval. Landed
+// costs are accrued separately; forwarder and customs bills are coded to that accrual account.
+// It runs inside the caller's transaction.
+func PostGoodsReceipt(ctx context.Context, tx *ent.Tx, tenantID int, date time.Time, reference string, goods, landed decimal.Decimal) (*ent.Transaction, error) {
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	invAcc, err := EnsureAccount(ctx, tx, tnt, AccountInventory, "Inventory", account.TypeAsset)
+	if err != nil {
+		return nil, err
+	}
+	grniAcc, err := EnsureAccount(ctx, tx, tnt, AccountGRNI, "Goods Received Not Invoiced", account.TypeLiability)
+	if err != nil {
+		return nil, err
+	}
+	goods = RoundCurrency(goods, tnt.FunctionalCurrency)
+	landed = RoundCurrency(landed, tnt.FunctionalCurrency)
+
+	lines := []PostingLine{
+		{AccountID: invAcc.ID, Direction: "debit", Amount: goods.Add(landed)},
+		{AccountID: grniAcc.ID, Direction: "credit", Amount: goods},
+	}
+	if landed.IsPositive() {
+		accrual, err := EnsureAccount(ctx, tx, tnt, AccountLandedCost, "Landed Cost Accrual", account.TypeLiability)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, PostingLine{AccountID: accrual.ID, Direction: "credit", Amount: landed})
+	}
+	return NewLedgerService().Post(ctx, tx, Posting{
+		TenantID:    tenantID,
+		Date:        date,
+		Description: fmt.Sprintf("Goods receipt %s", reference),
+		Type:        "goods_receipt",
+		Reference:   reference,
+		Lines:       lines,
+	})
+}
+
+// BillLineRequest is a line of a supplier bill. Amounts are in the bill currency.
+type BillLineRequest struct {
+	PurchaseOrderLineID int     `json:"purchase_order_line_id"` // Zero for lines without an order
+	AccountID           int     `json:"account_id"`             // Expense account, required without an order line
+	Description         string  `json:"description"`
+	Quantity            float64 `json:"quantity"`
+	UnitPrice           float64 `json:"unit_price"`
+	TaxCode             string  `json:"tax_code"` // Empty means the supplier's country default
+	TaxExempt           bool    `json:"tax_exempt"`
+}
+
+// BillRequest describes a supplier bill to record. With a purchase order and no lines, the bill
+// covers everything received on the order that has not been billed yet, at the ordered cost.
+type BillRequest struct {
+	SupplierID        int               `json:"supplier_id"`
+	PurchaseOrderID   int               `json:"purchase_order_id"`
+	SupplierReference string            `json:"supplier_reference"`
+	BillDate          time.Time         `json:"bill_date"` // Zero means today
+	DueDate           time.Time         `json:"due_date"`  // Zero means bill date plus the supplier's terms
+	Currency          string            `json:"currency"`  // Empty means the supplier's currency
+	Notes             string            `json:"notes"`
+	Lines             []BillLineRequest `json:"lines"`
+}
+
+// PaymentRunRequest selects the approved bills to pay.
+type PaymentRunRequest struct {
+	BankAccountID int       `json:"bank_account_id"` // Zero means 1000 Operating Cash
+	PaymentDate   time.Time `json:"payment_date"`    // Zero means today
+	DueBy         time.Time `json:"due_by"`          // Bills due on or before; zero means the payment date
+	Currency      string    `json:"currency"`        // Empty means functional currency
+	SupplierIDs   []int     `json:"supplier_ids"`    // Empty means all suppliers
+}
+
+// PayablesService maintains the accounts payable subledger: bills matched against purchase
+// orders and receipts, their approval into AP, and payment runs that clear it.
+type PayablesService struct {
+	db       *ent.Client
+	ledger   *LedgerService
+	exchange *ExchangeService
+	docs     DocumentStore
+}
+
+// NewPayablesService initializes a new PayablesService. docs may be nil, in which case payment
+// files are generated on request but not stored.
+func NewPayablesService(db *ent.Client, docs DocumentStore) *PayablesService {
+	return &PayablesService{db: db, ledger: NewLedgerService(), exchange: NewExchangeService(db), docs: docs}
+}
+
+// RecordBill enters a supplier bill and runs the three-way match on it.
+func (s *PayablesService) RecordBill(ctx context.Context, tenantID int, req BillRequest) (*ent.SupplierBill, error) {
+	ref := strings.TrimSpace(req.SupplierReference)
+	if ref == "" {
+		return nil, fmt.Errorf("supplier invoice number is required")
+	}
+	sup, err := s.db.Supplier.Query().
+		Where(supplier.ID(req.SupplierID), supplier.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("supplier %d not found: %w", req.SupplierID, err)
+	}
+	dup, err := s.db.SupplierBill.Query().
+		Where(
+			supplierbill.HasSupplierWith(supplier.ID(sup.ID)),
+			supplierbill.SupplierReference(ref),
+			supplierbill.StatusNEQ(supplierbill.StatusVoid),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dup {
+		return nil, fmt.Errorf("%s from %s: %w", ref, sup.Name, ErrDuplicateBill)
+	}
+
+	var po *ent.PurchaseOrder
+	if req.PurchaseOrderID != 0 {
+		po, err = s.db.PurchaseOrder.Query().
+			Where(
+				purchaseorder.ID(req.PurchaseOrderID),
+				purchaseorder.HasTenantWith(tenant.ID(tenantID)),
+				purchaseorder.HasSupplierWith(supplier.ID(sup.ID)),
+			).
+			WithLines(func(q *ent.PurchaseOrderLineQuery) {
+				q.WithProduct().WithBillLines(func(bq *ent.SupplierBillLineQuery) {
+					bq.Where(supplierbillline.HasBillWith(supplierbill.StatusNEQ(supplierbill.StatusVoid)))
+				})
+			}).
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("purchase order %d not found for %s: %w", req.PurchaseOrderID, sup.Name, err)
+		}
+		if po.Status == purchaseorder.StatusCancelled {
+			return nil, fmt.Errorf("purchase order %s is cancelled", po.PoNumber)
+		}
+	}
+
+	functional, err := s.exchange.FunctionalCurrency(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	currency := normalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = normalizeCurrency(sup.Currency)
+	}
+	if currency == "" {
+		currency = functional
+	}
+	billDate := req.BillDate
+	if billDate.IsZero() {
+		billDate = time.Now()
+	}
+	due := req.DueDate
+	if due.IsZero() {
+		due = billDate.AddDate(0, 0, sup.PaymentTermsDays)
+	}
+	rate, err := s.exchange.GetExchangeRate(ctx, tenantID, currency, functional, billDate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot record a bill in %s: %w", currency, err)
+	}
+
+	reqs := req.Lines
+	if len(reqs) == 0 && po != nil {
+		reqs = unbilledLines(po, currency == functional)
+	}
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("bill has no lines")
+	}
+
+	poLines := make(map[int]*ent.PurchaseOrderLine)
+	if po != nil {
+		for _, l := range po.Edges.Lines {
+			poLines[l.ID] = l
+		}
+	}
+	taxReqs := make([]InvoiceLineRequest, len(reqs))
+	for i, l := range reqs {
+		if l.PurchaseOrderLineID != 0 {
+			pl, ok := poLines[l.PurchaseOrderLineID]
+			if !ok {
+				return nil, fmt.Errorf("line %d: order line %d is not on the purchase order", i+1, l.PurchaseOrderLineID)
+			}
+			if l.Description == "" {
+				reqs[i].Description = pl.Edges.Product.Name
+			}
+		} else if l.AccountID == 0 {
+			return nil, fmt.Errorf("line %d: an expense account is required for lines without an order", i+1)
+		}
+		taxReqs[i] = InvoiceLineRequest{
+			Description: reqs[i].Description,
+			Quantity:    l.Quantity,
+			UnitPrice:   l.UnitPrice,
+			AccountID:   l.AccountID,
+			TaxCode:     l.TaxCode,
+			TaxExempt:   l.TaxExempt,
+		}
+	}
+	lines, err := requestLines(ctx, tax.NewResolver(s.db, tenantID), taxReqs, sup.CountryCode, false, billDate)
+	if err != nil {
+		return nil, err
+	}
+	subtotal, taxTotal, _ := computeDocument(lines, currency)
+	total := subtotal.Add(taxTotal)
+	if !total.IsPositive() {
+		return nil, fmt.Errorf("bill total must be positive")
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.SupplierBill.Query().Where(supplierbill.HasTenantWith(tenant.ID(tenantID))).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	create := tx.SupplierBill.Create().
+		SetTenantID(tenantID).
+		SetSupplier(sup).
+		SetNumber(fmt.Sprintf("BILL-%05d", n+1)).
+		SetSupplierReference(ref).
+		SetBillDate(billDate).
+		SetDueDate(due).
+		SetCurrency(currency).
+		SetExchangeRate(rate).
+		SetSubtotal(subtotal).
+		SetTaxTotal(taxTotal).
+		SetTotal(total).
+		SetNotes(req.Notes)
+	if po != nil {
+		create.SetPurchaseOrder(po)
+	}
+	bill, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save bill: %w", err)
+	}
+
+	for i, l := range lines {
+		lc := tx.SupplierBillLine.Create().
+			SetBill(bill).
+			SetDescription(l.Description).
+			SetQuantity(l.Quantity).
+			SetUnitPrice(l.UnitPrice).
+			SetTaxCode(l.TaxCode).
+			SetTaxRate(l.TaxRate).
+			SetNetAmount(l.Net).
+			SetPosition(i + 1)
+		if id := reqs[i].PurchaseOrderLineID; id != 0 {
+			lc.SetPurchaseOrderLineID(id)
+		} else {
+			lc.SetAccountID(l.AccountID)
+		}
+		if err := lc.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to save bill line: %w", err)
+		}
+	}
+
+	bill, err = s.match(ctx, tx, bill.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	database.LogAuditRecord(ctx, s.db, tenantID, "SENTcapital", "supplier_bill_recorded", "system", map[string]interface{}{
+		"bill_id":            bill.ID,
+		"number":             bill.Number,
+		"supplier_reference": ref,
+		"total":              total.String(),
+		"currency":           currency,
+		"status":             string(bill.Status),
+	})
+	return bill, nil
+}
+
+// unbilledLines proposes bill lines for what has been received on an order but not billed yet.
+// Prices are the ordered cost, which is only meaningful when billing in functional currency.
+func unbilledLines(po *ent.PurchaseOrder, functional bool) []BillLineRequest {
+	var out []BillLineRequest
+	for _, l := range po.Edges.Lines {
+		billed := decimal.Zero
+		for _, bl := range l.Edges.BillLines {
+			billed = billed.Add(bl.Quantity)
+		}
+		open := decimal.NewFromInt(int64(l.ReceivedQty)).Sub(billed)
+		if !open.IsPositive() {
+			continue
+		}
+		r := BillLineRequest{PurchaseOrderLineID: l.ID, Quantity: open.InexactFloat64()}
+		if functional {
+			r.UnitPrice = l.UnitCost.InexactFloat64()
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// MatchBill re-runs the three-way match, for example after further goods were received.
+func (s *PayablesService) MatchBill(ctx context.Context, tenantID, billID int) (*ent.SupplierBill, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := s.tenantBill(ctx, tx, tenantID, billID); err != nil {
+		return nil, err
+	}
+	bill, err := s.match(ctx, tx, billID)
+	if err != nil {
+		return nil, err
+	}
+	return bill, tx.Commit()
+}
+
+// match evaluates every order line of an unapproved bill and sets the bill to matched or exception.
+func (s *PayablesService) match(ctx context.Context, tx *ent.Tx, billID int) (*ent.SupplierBill, error) {
+	bill, err := tx.SupplierBill.Query().
+		Where(supplierbill.ID(billID)).
+		WithSupplier().
+		WithLines(func(q *ent.SupplierBillLineQuery) { q.WithPurchaseOrderLine() }).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bill.Status != supplierbill.StatusException && bill.Status != supplierbill.StatusMatched {
+		return nil, fmt.Errorf("bill %s is %s and cannot be rematched", bill.Number, bill.Status)
+	}
+	tol := MatchTolerance{
+		PricePercent:    bill.Edges.Supplier.PriceTolerance,
+		QuantityPercent: bill.Edges.Supplier.QuantityTolerance,
+	}
+
+	status := supplierbill.StatusMatched
+	for _, l := range bill.Edges.Lines {
+		pl := l.Edges.PurchaseOrderLine
+		if pl == nil {
+			continue
+		}
+		others, err := tx.SupplierBillLine.Query().
+			Where(
+				supplierbillline.HasPurchaseOrderLineWith(purchaseorderline.ID(pl.ID)),
+				supplierbillline.HasBillWith(supplierbill.IDNEQ(bill.ID), supplierbill.StatusNEQ(supplierbill.StatusVoid)),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		previously := decimal.Zero
+		for _, o := range others {
+			previously = previously.Add(o.Quantity)
+		}
+
+		m := matchLine(matchInput{
+			Ordered:          decimal.NewFromInt(int64(pl.Quantity)),
+			Received:         decimal.NewFromInt(int64(pl.ReceivedQty)),
+			PreviouslyBilled: previously,
+			Quantity:         l.Quantity,
+			OrderedCost:      pl.UnitCost,
+			BilledCost:       l.UnitPrice.Mul(bill.ExchangeRate),
+		}, tol)
+		if m.Status != supplierbillline.MatchStatusMatched {
+			status = supplierbill.StatusException
+		}
+		err = tx.SupplierBillLine.UpdateOne(l).
+			SetMatchStatus(m.Status).
+			SetQuantityVariance(m.QuantityVariance).
+			SetPriceVariance(m.PriceVariance).
+			SetMatchNote(m.Note).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx.SupplierBill.UpdateOne(bill).SetStatus(status).Save(ctx)
+}
+
+// ApproveBill posts a matched bill. Order lines clear the goods received accrual at the ordered
+// cost and put any price difference on inventory; other lines are expensed to their account.
+// A bill with exceptions needs override, which is recorded in the audit log.
+func (s *PayablesService) ApproveBill(ctx context.Context, tenantID, billID int, approver string, override bool) (*ent.SupplierBill, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	bill, err := s.tenantBill(ctx, tx, tenantID, billID)
+	if err != nil {
+		return nil, err
+	}
+	switch bill.Status {
+	case supplierbill.StatusMatched:
+	case supplierbill.StatusException:
+		if !override {
+			return nil, fmt.Errorf("bill %s: %w", bill.Number, ErrMatchException)
+		}
+	default:
+		return nil, fmt.Errorf("bill %s is %s and cannot be approved", bill.Number, bill.Status)
+	}
+	bill, err = tx.SupplierBill.Query().
+		Where(supplierbill.ID(bill.ID)).
+		WithSupplier().
+		WithLines(func(q *ent.SupplierBillLineQuery) { q.WithPurchaseOrderLine().WithAccount() }).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	functional := normalizeCurrency(tnt.FunctionalCurrency)
+	apAcc, err := EnsureAccount(ctx, tx, tnt, AccountPayable, "Accounts Payable", account.TypeLiability)
+	if err != nil {
+		return nil, err
+	}
+
+	byAccount := make(map[int]decimal.Decimal)
+	var order []int
+	add := func(accID int, amt decimal.Decimal) {
+		if _, ok := byAccount[accID]; !ok {
+			order = append(order, accID)
+		}
+		byAccount[accID] = byAccount[accID].Add(amt)
+	}
+
+	var grniID, inventoryID int
+	for _, l := range bill.Edges.Lines {
+		net := RoundCurrency(l.NetAmount.Mul(bill.ExchangeRate), functional)
+		pl := l.Edges.PurchaseOrderLine
+		if pl == nil {
+			add(l.Edges.Account.ID, net)
+			continue
+		}
+		if grniID == 0 {
+			grni, err := EnsureAccount(ctx, tx, tnt, AccountGRNI, "Goods Received Not Invoiced", account.TypeLiability)
+			if err != nil {
+				return nil, err
+			}
+			inv, err := EnsureAccount(ctx, tx, tnt, AccountInventory, "Inventory", account.TypeAsset)
+			if err != nil {
+				return nil, err
+			}
+			grniID, inventoryID = grni.ID, inv.ID
+		}
+		accrued := RoundCurrency(pl.UnitCost.Mul(l.Quantity), functional)
+		add(grniID, accrued)
+		add(inventoryID, net.Sub(accrued))
+	}
+	if !bill.TaxTotal.IsZero() {
+		taxAcc, err := EnsureAccount(ctx, tx, tnt, AccountInputTax, "Input VAT", account.TypeAsset)
+		if err != nil {
+			return nil, err
+		}
+		add(taxAcc.ID, RoundCurrency(bill.TaxTotal.Mul(bill.ExchangeRate), functional))
+	}
+
+	var postingLines []PostingLine
+	apFunctional := decimal.Zero
+	for _, accID := range order {
+		amt := byAccount[accID]
+		apFunctional = apFunctional.Add(amt)
+		switch {
+		case amt.IsPositive():
+			postingLines = append(postingLines, PostingLine{AccountID: accID, Direction: "debit", Amount: amt})
+		case amt.IsNegative():
+			postingLines = append(postingLines, PostingLine{AccountID: accID, Direction: "credit", Amount: amt.Neg()})
+		}
+	}
+	postCurrency := bill.Currency
+	if postCurrency == functional {
+		postCurrency = ""
+	}
+	postingLines = append(postingLines, PostingLine{
+		AccountID: apAcc.ID, Direction: "credit", Amount: apFunctional,
+		Currency: postCurrency, CurrencyAmount: bill.Total, Rate: bill.ExchangeRate,
+	})
+
+	txn, err := s.ledger.Post(ctx, tx, Posting{
+		TenantID:     tenantID,
+		Date:         bill.BillDate,
+		Description:  fmt.Sprintf("Bill %s (%s) - %s", bill.Number, bill.SupplierReference, bill.Edges.Supplier.Name),
+		Type:         "supplier_bill",
+		Reference:    bill.Number,
+		Currency:     postCurrency,
+		ExchangeRate: bill.ExchangeRate,
+		Lines:        postingLines,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("bill %s not posted: %w", bill.Number, err)
+	}
+
+	exception := bill.Status == supplierbill.StatusException
+	bill, err = tx.SupplierBill.UpdateOne(bill).
+		SetStatus(supplierbill.StatusApproved).
+		SetApprovedBy(approver).
+		SetApprovedAt(time.Now()).
+		SetTransaction(txn).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	database.LogAuditRecord(ctx, s.db, tenantID, "SENTcapital", "supplier_bill_approved", approver, map[string]interface{}{
+		"bill_id":   bill.ID,
+		"number":    bill.Number,
+		"total":     bill.Total.String(),
+		"currency":  bill.Currency,
+		"overrides": exception,
+	})
+	return bill, nil
+}
+
+// VoidBill cancels a bill that has not been approved. Approved bills are already in AP.
+func (s *PayablesService) VoidBill(ctx context.Context, tenantID, billID int, reason string) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	bill, err := s.tenantBill(ctx, tx, tenantID, billID)
+	if err != nil {
+		return err
+	}
+	if bill.Status != supplierbill.StatusException && bill.Status != supplierbill.StatusMatched {
+		return fmt.Errorf("bill %s is %s and cannot be voided", bill.Number, bill.Status)
+	}
+	if err := tx.SupplierBill.UpdateOne(bill).SetStatus(supplierbill.StatusVoid).Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	database.LogAuditRecord(ctx, s.db, tenantID, "SENTcapital", "supplier_bill_voided", "system", map[string]interface{}{
+		"bill_id": bill.ID,
+		"number":  bill.Number,
+		"reason":  reason,
+	})
+	return nil
+}
+
+func (s *PayablesService) tenantBill(ctx context.Context, tx *ent.Tx, tenantID, billID int) (*ent.SupplierBill, error) {
+	bill, err := tx.SupplierBill.Query().
+		Where(supplierbill.ID(billID), supplierbill.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bill %d not found: %w", billID, err)
+	}
+	return bill, nil
+}
+
+// ProposePaymentRun collects approved, unpaid bills due by the cut-off into a new run. Bills
+// already in another proposed run are left out.
+func (s *PayablesService) ProposePaymentRun(ctx context.Context, tenantID int, req PaymentRunRequest, createdBy string) (*ent.PaymentRun, error) {
+	functional, err := s.exchange.FunctionalCurrency(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	currency := normalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = functional
+	}
+	payDate := req.PaymentDate
+	if payDate.IsZero() {
+		payDate = time.Now()
+	}
+	dueBy := req.DueBy
+	if dueBy.IsZero() {
+		dueBy = payDate
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	bankID := req.BankAccountID
+	if bankID == 0 {
+		cash, err := EnsureAccount(ctx, tx, tnt, "1000", "Operating Cash", account.TypeAsset)
+		if err != nil {
+			return nil, err
+		}
+		bankID = cash.ID
+	} else {
+		ok, err := tx.Account.Query().
+			Where(account.ID(bankID), account.HasTenantWith(tenant.ID(tenantID)), account.TypeEQ(account.TypeAsset)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("bank account %d not found", bankID)
+		}
+	}
+
+	q := tx.SupplierBill.Query().
+		Where(
+			supplierbill.HasTenantWith(tenant.ID(tenantID)),
+			supplierbill.StatusEQ(supplierbill.StatusApproved),
+			supplierbill.Currency(currency),
+			supplierbill.DueDateLTE(endOfDay(dueBy)),
+			supplierbill.Not(supplierbill.HasPaymentLinesWith(
+				paymentrunline.HasRunWith(paymentrun.StatusEQ(paymentrun.StatusProposed)),
+			)),
+		)
+	if len(req.SupplierIDs) > 0 {
+		q = q.Where(supplierbill.HasSupplierWith(supplier.IDIn(req.SupplierIDs...)))
+	}
+	bills, err := q.Order(ent.Asc(supplierbill.FieldDueDate), ent.Asc(supplierbill.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(bills) == 0 {
+		return nil, fmt.Errorf("no approved %s bills are due by %s", currency, dueBy.Format("2006-01-02"))
+	}
+
+	n, err := tx.PaymentRun.Query().Where(paymentrun.HasTenantWith(tenant.ID(tenantID))).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	run, err := tx.PaymentRun.Create().
+		SetTenant(tnt).
+		SetNumber(fmt.Sprintf("PR-%05d", n+1)).
+		SetPaymentDate(payDate).
+		SetCurrency(currency).
+		SetBankAccountID(bankID).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save payment run: %w", err)
+	}
+
+	total := decimal.Zero
+	for _, b := range bills {
+		open := b.Total.Sub(b.AmountPaid)
+		if !open.IsPositive() {
+			continue
+		}
+		if err := tx.PaymentRunLine.Create().SetRun(run).SetBill(b).SetAmount(open).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to save payment run line: %w", err)
+		}
+		total = total.Add(open)
+	}
+	run, err = tx.PaymentRun.UpdateOne(run).SetTotal(total).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return run, tx.Commit()
+}
+
+// CancelPaymentRun discards a proposed run and releases its bills.
+func (s *PayablesService) CancelPaymentRun(ctx context.Context, tenantID, runID int) error {
+	n, err := s.db.PaymentRun.Update().
+		Where(
+			paymentrun.ID(runID),
+			paymentrun.HasTenantWith(tenant.ID(tenantID)),
+			paymentrun.StatusEQ(paymentrun.StatusProposed),
+		).
+		SetStatus(paymentrun.StatusCancelled).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("proposed payment run %d not found", runID)
+	}
+	return nil
+}
+
+// ExecutePaymentRun pays every bill in a proposed run: each payment debits AP at the bill rate and
+// credits the bank at the payment date rate, with the difference realized as FX. The payment
+// file for the bank is stored in SENTvault.
+func (s *PayablesService) ExecutePaymentRun(ctx context.Context, tenantID, runID int, actor string) (*ent.PaymentRun, error) {
+	run, err := s.loadRun(ctx, tenantID, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run.Status != paymentrun.StatusProposed {
+		return nil, fmt.Errorf("payment run %s is %s", run.Number, run.Status)
+	}
+	if len(run.Edges.Lines) == 0 {
+		return nil, fmt.Errorf("payment run %s has no bills", run.Number)
+	}
+	file, err := renderPaymentFile(run)
+	if err != nil {
+		return nil, err
+	}
+
+	functional, err := s.exchange.FunctionalCurrency(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := s.exchange.GetExchangeRate(ctx, tenantID, run.Currency, functional, run.PaymentDate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot pay in %s: %w", run.Currency, err)
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	apAcc, err := EnsureAccount(ctx, tx, tnt, AccountPayable, "Accounts Payable", account.TypeLiability)
+	if err != nil {
+		return nil, err
+	}
+	postCurrency := run.Currency
+	if postCurrency == functional {
+		postCurrency = ""
+	}
+
+	for _, l := range run.Edges.Lines {
+		bill, err := tx.SupplierBill.Get(ctx, l.Edges.Bill.ID)
+		if err != nil {
+			return nil, err
+		}
+		if bill.Status != supplierbill.StatusApproved {
+			return nil, fmt.Errorf("bill %s is %s", bill.Number, bill.Status)
+		}
+		if l.Amount.GreaterThan(bill.Total.Sub(bill.AmountPaid)) {
+			return nil, fmt.Errorf("bill %s: %w", bill.Number, ErrOverAllocation)
+		}
+
+		carried := RoundCurrency(l.Amount.Mul(bill.ExchangeRate), functional)
+		paid := RoundCurrency(l.Amount.Mul(rate), functional)
+		lines := []PostingLine{
+			{AccountID: apAcc.ID, Direction: "debit", Amount: carried, Currency: postCurrency, CurrencyAmount: l.Amount, Rate: bill.ExchangeRate},
+			{AccountID: run.Edges.BankAccount.ID, Direction: "credit", Amount: paid, Currency: postCurrency, CurrencyAmount: l.Amount, Rate: rate},
+		}
+		fx := carried.Sub(paid)
+		if !fx.IsZero() {
+			fxAcc, err := EnsureAccount(ctx, tx, tnt, AccountRealizedFX, "Realized FX Gain/Loss", account.TypeExpense)
+			if err != nil {
+				return nil, err
+			}
+			dir := "credit"
+			if fx.IsNegative() {
+				dir = "debit"
+			}
+			lines = append(lines, PostingLine{AccountID: fxAcc.ID, Direction: dir, Amount: fx.Abs()})
+		}
+
+		txn, err := s.ledger.Post(ctx, tx, Posting{
+			TenantID:     tenantID,
+			Date:         run.PaymentDate,
+			Description:  fmt.Sprintf("Payment of %s (%s) - %s", bill.Number, bill.SupplierReference, l.Edges.Bill.Edges.Supplier.Name),
+			Type:         "supplier_payment",
+			Reference:    run.Number,
+			Currency:     postCurrency,
+			ExchangeRate: rate,
+			Lines:        lines,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("payment of %s not posted: %w", bill.Number, err)
+		}
+		if err := tx.PaymentRunLine.UpdateOne(l).SetTransaction(txn).SetFxDifference(fx).Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		paidSoFar := bill.AmountPaid.Add(l.Amount)
+		upd := tx.SupplierBill.UpdateOne(bill).SetAmountPaid(paidSoFar)
+		if paidSoFar.GreaterThanOrEqual(bill.Total) {
+			upd.SetStatus(supplierbill.StatusPaid)
+		}
+		if err := upd.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	run, err = tx.PaymentRun.UpdateOne(run).
+		SetStatus(paymentrun.StatusCompleted).
+		SetCompletedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	database.LogAuditRecord(ctx, s.db, tenantID, "SENTcapital", "payment_run_executed", actor, map[string]interface{}{
+		"run_id":   run.ID,
+		"number":   run.Number,
+		"total":    run.Total.String(),
+		"currency": run.Currency,
+	})
+
+	// The payments are booked; a storage failure only means the file has to be exported again.
+	if s.docs != nil {
+		path := fmt.Sprintf("finance/payments/%s.csv", run.Number)
+		if err := s.docs.SaveFileAsTenant(path, base64.StdEncoding.EncodeToString(file), tenantID); err != nil {
+			fmt.Printf("[SENTcapital] Warning: failed to store payment file %s: %v\n", run.Number, err)
+			return run, nil
+		}
+		return s.db.PaymentRun.UpdateOne(run).SetFilePath(path).Save(ctx)
+	}
+	return run, nil
+}
+
+// PaymentFile regenerates the bank payment file of a run.
+func (s *PayablesService) PaymentFile(ctx context.Context, tenantID, runID int) ([]byte, error) {
+	run, err := s.loadRun(ctx, tenantID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return renderPaymentFile(run)
+}
+
+func (s *PayablesService) loadRun(ctx context.Context, tenantID, runID int) (*ent.PaymentRun, error) {
+	run, err := s.db.PaymentRun.Query().
+		Where(paymentrun.ID(runID), paymentrun.HasTenantWith(tenant.ID(tenantID))).
+		WithBankAccount().
+		WithLines(func(q *ent.PaymentRunLineQuery) {
+			q.WithBill(func(bq *ent.SupplierBillQuery) { bq.WithSupplier() })
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("payment run %d not found: %w", runID, err)
+	}
+	return run, nil
+}
+
+// paymentFileHeader is the column layout of the generic bank transfer CSV.
+var paymentFileHeader = []string{"payment_date", "beneficiary", "bank_name", "bank_account", "amount", "currency", "remittance"}
+
+// renderPaymentFile writes one transfer per supplier, with the paid invoice numbers as the
+// remittance information. Every supplier must have bank details.
+func renderPaymentFile(run *ent.PaymentRun) ([]byte, error) {
+	type transfer struct {
+		supplier *ent.Supplier
+		amount   decimal.Decimal
+		refs     []string
+	}
+	bySupplier := make(map[int]*transfer)
+	for _, l := range run.Edges.Lines {
+		sup := l.Edges.Bill.Edges.Supplier
+		t, ok := bySupplier[sup.ID]
+		if !ok {
+			if strings.TrimSpace(sup.BankAccount) == "" {
+				return nil, fmt.Errorf("supplier %s has no bank account", sup.Name)
+			}
+			t = &transfer{supplier: sup}
+			bySupplier[sup.ID] = t
+		}
+		t.amount = t.amount.Add(l.Amount)
+		t.refs = append(t.refs, l.Edges.Bill.SupplierReference)
+	}
+	transfers := make([]*transfer, 0, len(bySupplier))
+	for _, t := range bySupplier {
+		transfers = append(transfers, t)
+	}
+	sort.Slice(transfers, func(i, j int) bool { return transfers[i].supplier.Name < transfers[j].supplier.Name })
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(paymentFileHeader); err != nil {
+		return nil, err
+	}
+	places := CurrencyPlaces(run.Currency)
+	for _, t := range transfers {
+		err := w.Write([]string{
+			run.PaymentDate.Format("2006-01-02"),
+			t.supplier.Name,
+			t.supplier.BankName,
+			strings.ReplaceAll(t.supplier.BankAccount, " ", ""),
+			t.amount.StringFixed(places),
+			run.Currency,
+			fmt.Sprintf("%s %s", run.Number, strings.Join(t.refs, " ")),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// SupplierBillLineDTO represents a bill line with its match result.
+type SupplierBillLineDTO struct {
+	ID                  int     `json:"id"`
+	PurchaseOrderLineID int     `json:"purchase_order_line_id"`
+	Description         string  `json:"description"`
+	Quantity            float64 `json:"quantity"`
+	UnitPrice           float64 `json:"unit_price"`
+	NetAmount           float64 `json:"net_amount"`
+	TaxCode             string  `json:"tax_code"`
+	MatchStatus         string  `json:"match_status"`
+	QuantityVariance    float64 `json:"quantity_variance"`
+	PriceVariance       float64 `json:"price_variance"`
+	MatchNote           string  `json:"match_note"`
+}
+
+// SupplierBillDTO represents a supplier bill.
+type SupplierBillDTO struct {
+	ID                int                   `json:"id"`
+	Number            string                `json:"number"`
+	SupplierReference string                `json:"supplier_reference"`
+	Status            string                `json:"status"`
+	SupplierID        int                   `json:"supplier_id"`
+	SupplierName      string                `json:"supplier_name"`
+	PurchaseOrder     string                `json:"purchase_order"`
+	BillDate          time.Time             `json:"bill_date"`
+	DueDate           time.Time             `json:"due_date"`
+	Currency          string                `json:"currency"`
+	Subtotal          float64               `json:"subtotal"`
+	TaxTotal          float64               `json:"tax_total"`
+	Total             float64               `json:"total"`
+	AmountPaid        float64               `json:"amount_paid"`
+	Lines             []SupplierBillLineDTO `json:"lines"`
+}
+
+func toSupplierBillDTO(b *ent.SupplierBill) SupplierBillDTO {
+	dto := SupplierBillDTO{
+		ID:                b.ID,
+		Number:            b.Number,
+		SupplierReference: b.SupplierReference,
+		Status:            string(b.Status),
+		BillDate:          b.BillDate,
+		DueDate:           b.DueDate,
+		Currency:          b.Currency,
+		Subtotal:          b.Subtotal.InexactFloat64(),
+		TaxTotal:          b.TaxTotal.InexactFloat64(),
+		Total:             b.Total.InexactFloat64(),
+		AmountPaid:        b.AmountPaid.InexactFloat64(),
+		Lines:             []SupplierBillLineDTO{},
+	}
+	if sup := b.Edges.Supplier; sup != nil {
+		dto.SupplierID, dto.SupplierName = sup.ID, sup.Name
+	}
+	if po := b.Edges.PurchaseOrder; po != nil {
+		dto.PurchaseOrder = po.PoNumber
+	}
+	for _, l := range b.Edges.Lines {
+		ld := SupplierBillLineDTO{
+			ID:               l.ID,
+			Description:      l.Description,
+			Quantity:         l.Quantity.InexactFloat64(),
+			UnitPrice:        l.UnitPrice.InexactFloat64(),
+			NetAmount:        l.NetAmount.InexactFloat64(),
+			TaxCode:          l.TaxCode,
+			MatchStatus:      string(l.MatchStatus),
+			QuantityVariance: l.QuantityVariance.InexactFloat64(),
+			PriceVariance:    l.PriceVariance.InexactFloat64(),
+			MatchNote:        l.MatchNote,
+		}
+		if pl := l.Edges.PurchaseOrderLine; pl != nil {
+			ld.PurchaseOrderLineID = pl.ID
+		}
+		dto.Lines = append(dto.Lines, ld)
+	}
+	return dto
+}
+
+// PaymentRunDTO represents a payment run.
+type PaymentRunDTO struct {
+	ID          int       `json:"id"`
+	Number      string    `json:"number"`
+	Status      string    `json:"status"`
+	PaymentDate time.Time `json:"payment_date"`
+	Currency    string    `json:"currency"`
+	Total       float64   `json:"total"`
+	BillCount   int       `json:"bill_count"`
+	FilePath    string    `json:"file_path"`
+}
+
+func toPaymentRunDTO(r *ent.PaymentRun) PaymentRunDTO {
+	return PaymentRunDTO{
+		ID:          r.ID,
+		Number:      r.Number,
+		Status:      string(r.Status),
+		PaymentDate: r.PaymentDate,
+		Currency:    r.Currency,
+		Total:       r.Total.InexactFloat64(),
+		BillCount:   len(r.Edges.Lines),
+		FilePath:    r.FilePath,
+	}
+}
+
+// payables returns the AP service bound to the bridge's document store.
+func (c *CapitalBridge) payables() *PayablesService {
+	return NewPayablesService(c.db, c.docs)
+}
+
+// GetSupplierBills lists supplier bills, optionally for one supplier and status.
+func (c *CapitalBridge) GetSupplierBills(supplierID int, status string) ([]SupplierBillDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	q := c.db.SupplierBill.Query().Where(supplierbill.HasTenantWith(tenant.ID(profile.TenantID)))
+	if supplierID != 0 {
+		q = q.Where(supplierbill.HasSupplierWith(supplier.ID(supplierID)))
+	}
+	if status != "" {
+		q = q.Where(supplierbill.StatusEQ(supplierbill.Status(status)))
+	}
+	bills, err := q.
+		WithSupplier().
+		WithPurchaseOrder().
+		WithLines(func(lq *ent.SupplierBillLineQuery) {
+			lq.WithPurchaseOrderLine().Order(ent.Asc(supplierbillline.FieldPosition))
+		}).
+		Order(ent.Desc(supplierbill.FieldBillDate), ent.Desc(supplierbill.FieldID)).
+		All(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bills: %w", err)
+	}
+
+	dtos := make([]SupplierBillDTO, len(bills))
+	for i, b := range bills {
+		dtos[i] = toSupplierBillDTO(b)
+	}
+	return dtos, nil
+}
+
+// CreateSupplierBill records a supplier bill and returns it with its match result.
+func (c *CapitalBridge) CreateSupplierBill(req BillRequest) (*SupplierBillDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	bill, err := c.payables().RecordBill(c.ctx, profile.TenantID, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.supplierBillDTO(bill.ID)
+}
+
+// RematchSupplierBill re-runs the three-way match on an unapproved bill.
+func (c *CapitalBridge) RematchSupplierBill(billID int) (*SupplierBillDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.payables().MatchBill(c.ctx, profile.TenantID, billID); err != nil {
+		return nil, err
+	}
+	return c.supplierBillDTO(billID)
+}
+
+// ApproveSupplierBill posts a bill to AP. Bills with match exceptions need override.
+func (c *CapitalBridge) ApproveSupplierBill(billID int, override bool) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	_, err = c.payables().ApproveBill(c.ctx, profile.TenantID, billID, profile.Email, override)
+	return err
+}
+
+// VoidSupplierBill cancels an unapproved bill.
+func (c *CapitalBridge) VoidSupplierBill(billID int, reason string) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	return c.payables().VoidBill(c.ctx, profile.TenantID, billID, reason)
+}
+
+func (c *CapitalBridge) supplierBillDTO(billID int) (*SupplierBillDTO, error) {
+	bill, err := c.db.SupplierBill.Query().
+		Where(supplierbill.ID(billID)).
+		WithSupplier().
+		WithPurchaseOrder().
+		WithLines(func(lq *ent.SupplierBillLineQuery) {
+			lq.WithPurchaseOrderLine().Order(ent.Asc(supplierbillline.FieldPosition))
+		}).
+		Only(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto := toSupplierBillDTO(bill)
+	return &dto, nil
+}
+
+// GetPaymentRuns lists the tenant's payment runs, newest first.
+func (c *CapitalBridge) GetPaymentRuns() ([]PaymentRunDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	runs, err := c.db.PaymentRun.Query().
+		Where(paymentrun.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithLines().
+		Order(ent.Desc(paymentrun.FieldCreatedAt)).
+		All(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query payment runs: %w", err)
+	}
+	dtos := make([]PaymentRunDTO, len(runs))
+	for i, r := range runs {
+		dtos[i] = toPaymentRunDTO(r)
+	}
+	return dtos, nil
+}
+
+// ProposePaymentRun creates a run with the approved bills due by the cut-off.
+func (c *CapitalBridge) ProposePaymentRun(req PaymentRunRequest) (*PaymentRunDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	run, err := c.payables().ProposePaymentRun(c.ctx, profile.TenantID, req, profile.Email)
+	if err != nil {
+		return nil, err
+	}
+	run, err = c.db.PaymentRun.Query().Where(paymentrun.ID(run.ID)).WithLines().Only(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto := toPaymentRunDTO(run)
+	return &dto, nil
+}
+
+// ExecutePaymentRun pays a proposed run and returns the SENTvault path of its payment file.
+func (c *CapitalBridge) ExecutePaymentRun(runID int) (string, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	run, err := c.payables().ExecutePaymentRun(c.ctx, profile.TenantID, runID, profile.Email)
+	if err != nil {
+		return "", err
+	}
+	return run.FilePath, nil
+}
+
+// CancelPaymentRun discards a proposed run.
+func (c *CapitalBridge) CancelPaymentRun(runID int) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	return c.payables().CancelPaymentRun(c.ctx, profile.TenantID, runID)
+}
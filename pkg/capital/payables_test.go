@@ -0,0 +1,103 @@
+package capital
+
+import (
+	"strings"
+	"testing"
+
+	"sent/ent"
+	"sent/ent/supplierbillline"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMatchLine(t *testing.T) {
+	tol := MatchTolerance{PricePercent: decimal.NewFromInt(2), QuantityPercent: decimal.Zero}
+	base := matchInput{
+		Ordered:     decimal.NewFromInt(10),
+		Received:    decimal.NewFromInt(10),
+		Quantity:    decimal.NewFromInt(10),
+		OrderedCost: decimal.NewFromInt(50),
+		BilledCost:  decimal.NewFromInt(50),
+	}
+
+	if m := matchLine(base, tol); m.Status != supplierbillline.MatchStatusMatched || !m.PriceVariance.IsZero() {
+		t.Errorf("exact match flagged: %+v", m)
+	}
+
+	within := base
+	within.BilledCost = decimal.NewFromInt(51) // 2% over
+	m := matchLine(within, tol)
+	if m.Status != supplierbillline.MatchStatusMatched {
+		t.Errorf("price within tolerance flagged: %s", m.Note)
+	}
+	if !m.PriceVariance.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("price variance = %s, want 10", m.PriceVariance)
+	}
+
+	over := base
+	over.BilledCost = decimal.RequireFromString("51.50")
+	if m := matchLine(over, tol); m.Status != supplierbillline.MatchStatusVariance || !strings.Contains(m.Note, "unit cost") {
+		t.Errorf("price beyond tolerance not flagged: %+v", m)
+	}
+
+	cheaper := base
+	cheaper.BilledCost = decimal.NewFromInt(40)
+	if m := matchLine(cheaper, tol); m.Status != supplierbillline.MatchStatusMatched || !m.PriceVariance.Equal(decimal.NewFromInt(-100)) {
+		t.Errorf("billing below cost should pass with a negative variance: %+v", m)
+	}
+
+	partial := base
+	partial.Received = decimal.NewFromInt(6)
+	partial.PreviouslyBilled = decimal.NewFromInt(2)
+	partial.Quantity = decimal.NewFromInt(5)
+	m = matchLine(partial, tol)
+	if m.Status != supplierbillline.MatchStatusVariance || !m.QuantityVariance.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("billing beyond receipts not flagged: %+v", m)
+	}
+	if !strings.Contains(m.Note, "billed 7 but received 6") {
+		t.Errorf("note = %q", m.Note)
+	}
+
+	loose := MatchTolerance{PricePercent: decimal.NewFromInt(2), QuantityPercent: decimal.NewFromInt(20)}
+	if m := matchLine(partial, loose); m.Status != supplierbillline.MatchStatusMatched {
+		t.Errorf("quantity within tolerance flagged: %s", m.Note)
+	}
+}
+
+func TestRenderPaymentFile(t *testing.T) {
+	acme := &ent.Supplier{ID: 1, Name: "Acme Supplies", BankName: "Arab Bank", BankAccount: "JO94 CBJO 0010 0000 0000 0131 0003 02"}
+	zeta := &ent.Supplier{ID: 2, Name: "Zeta Parts", BankAccount: "JO71CBJO0000000000001234567890"}
+	bill := func(sup *ent.Supplier, ref string) *ent.SupplierBill {
+		return &ent.SupplierBill{SupplierReference: ref, Edges: ent.SupplierBillEdges{Supplier: sup}}
+	}
+	run := &ent.PaymentRun{
+		Number:      "PR-00003",
+		PaymentDate: date("2024-05-31"),
+		Currency:    "JOD",
+		Edges: ent.PaymentRunEdges{Lines: []*ent.PaymentRunLine{
+			{Amount: decimal.RequireFromString("120.5"), Edges: ent.PaymentRunLineEdges{Bill: bill(zeta, "Z-9")}},
+			{Amount: decimal.NewFromInt(100), Edges: ent.PaymentRunLineEdges{Bill: bill(acme, "A-1")}},
+			{Amount: decimal.RequireFromString("0.25"), Edges: ent.PaymentRunLineEdges{Bill: bill(acme, "A-2")}},
+		}},
+	}
+
+	out, err := renderPaymentFile(run)
+	if err != nil {
+		t.Fatalf("renderPaymentFile: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and one transfer per supplier, got %d lines:\n%s", len(lines), out)
+	}
+	if lines[1] != "2024-05-31,Acme Supplies,Arab Bank,JO94CBJO0010000000000131000302,100.250,JOD,PR-00003 A-1 A-2" {
+		t.Errorf("acme transfer = %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "2024-05-31,Zeta Parts,,JO71CBJO0000000000001234567890,120.500,JOD,") {
+		t.Errorf("zeta transfer = %q", lines[2])
+	}
+
+	zeta.BankAccount = ""
+	if _, err := renderPaymentFile(run); err == nil {
+		t.Error("expected an error for a supplier without bank details")
+	}
+}
@@ -0,0 +1,415 @@
+package capital
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/fiscalperiod"
+	"sent/ent/journalentry"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/pkg/database"
+
+	"github.com/shopspring/decimal"
+)
+
+// AccountRetainedEarnings receives the net result of the year at year-end close.
+const AccountRetainedEarnings = "3100"
+
+var (
+	// ErrPeriodSoftClosed is returned when a regular posting targets a soft-closed period.
+	ErrPeriodSoftClosed = errors.New("posting period is soft-closed")
+	// ErrPeriodHardClosed is returned for any posting into a hard-closed period.
+	ErrPeriodHardClosed = errors.New("posting period is hard-closed")
+)
+
+// EnsurePostingAllowed checks a posting date against the tenant's fiscal calendar.
+// Every writer of JournalEntry or LedgerEntry rows must call it inside its transaction.
+// Dates not covered by any period are treated as open so tenants without a calendar keep working.
+func EnsurePostingAllowed(ctx context.Context, periods *ent.FiscalPeriodClient, tenantID int, date time.Time, allowSoftClosed bool) error {
+	p, err := periods.Query().
+		Where(
+			fiscalperiod.HasTenantWith(tenant.ID(tenantID)),
+			fiscalperiod.StartDateLTE(date),
+			fiscalperiod.EndDateGTE(date),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to resolve fiscal period: %w", err)
+	}
+
+	switch p.Status {
+	case fiscalperiod.StatusHardClosed:
+		return fmt.Errorf("%w: %s (%s)", ErrPeriodHardClosed, p.Name, date.Format("2006-01-02"))
+	case fiscalperiod.StatusSoftClosed:
+		if !allowSoftClosed {
+			return fmt.Errorf("%w: %s (%s)", ErrPeriodSoftClosed, p.Name, date.Format("2006-01-02"))
+		}
+	}
+	return nil
+}
+
+// canPostToSoftClosed reports whether a role may post adjustments into soft-closed periods.
+func canPostToSoftClosed(role string) bool {
+	return role == "admin" || role == "Finance Manager"
+}
+
+// PeriodSpan is one period of a fiscal year before it is persisted.
+type PeriodSpan struct {
+	Name   string
+	Number int
+	Start  time.Time
+	End    time.Time
+}
+
+// BuildFiscalYear splits a fiscal year into twelve monthly periods.
+// Fiscal year N starts on the first day of startMonth in calendar year N.
+func BuildFiscalYear(year, startMonth int, loc *time.Location) []PeriodSpan {
+	spans := make([]PeriodSpan, 12)
+	for i := 0; i < 12; i++ {
+		start := time.Date(year, time.Month(startMonth+i), 1, 0, 0, 0, 0, loc)
+		spans[i] = PeriodSpan{
+			Name:   fmt.Sprintf("FY%d-%02d", year, i+1),
+			Number: i + 1,
+			Start:  start,
+			End:    start.AddDate(0, 1, 0).Add(-time.Nanosecond),
+		}
+	}
+	return spans
+}
+
+// FiscalPeriodDTO represents a period of the fiscal calendar.
+type FiscalPeriodDTO struct {
+	ID           int        `json:"id"`
+	Name         string     `json:"name"`
+	FiscalYear   int        `json:"fiscal_year"`
+	PeriodNumber int        `json:"period_number"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      time.Time  `json:"end_date"`
+	Status       string     `json:"status"`
+	ClosedAt     *time.Time `json:"closed_at"`
+	ClosedBy     string     `json:"closed_by"`
+}
+
+// GetFiscalPeriods lists the periods of a fiscal year for the current tenant.
+func (c *CapitalBridge) GetFiscalPeriods(year int) ([]FiscalPeriodDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	periods, err := c.db.FiscalPeriod.Query().
+		Where(
+			fiscalperiod.HasTenantWith(tenant.ID(profile.TenantID)),
+			fiscalperiod.FiscalYear(year),
+		).
+		Order(ent.Asc(fiscalperiod.FieldPeriodNumber)).
+		All(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query fiscal periods: %w", err)
+	}
+
+	dtos := make([]FiscalPeriodDTO, len(periods))
+	for i, p := range periods {
+		dtos[i] = FiscalPeriodDTO{
+			ID:           p.ID,
+			Name:         p.Name,
+			FiscalYear:   p.FiscalYear,
+			PeriodNumber: p.PeriodNumber,
+			StartDate:    p.StartDate,
+			EndDate:      p.EndDate,
+			Status:       string(p.Status),
+			ClosedAt:     p.ClosedAt,
+			ClosedBy:     p.ClosedBy,
+		}
+	}
+	return dtos, nil
+}
+
+// OpenFiscalYear creates the twelve open periods of a fiscal year for the current tenant.
+func (c *CapitalBridge) OpenFiscalYear(year int) (string, error) {
+	prof, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", fmt.Errorf("authentication required: %w", err)
+	}
+	if !canPostToSoftClosed(prof.Role) {
+		return "", fmt.Errorf("insufficient permissions: fiscal calendar requires Finance Manager role")
+	}
+
+	tnt, err := c.db.Tenant.Get(c.ctx, prof.TenantID)
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := c.db.FiscalPeriod.Query().
+		Where(fiscalperiod.HasTenantWith(tenant.ID(tnt.ID)), fiscalperiod.FiscalYear(year)).
+		Exist(c.ctx)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", fmt.Errorf("fiscal year %d already exists", year)
+	}
+
+	spans := BuildFiscalYear(year, tnt.FiscalYearStartMonth, time.Local)
+	bulk := make([]*ent.FiscalPeriodCreate, len(spans))
+	for i, s := range spans {
+		bulk[i] = c.db.FiscalPeriod.Create().
+			SetTenant(tnt).
+			SetName(s.Name).
+			SetFiscalYear(year).
+			SetPeriodNumber(s.Number).
+			SetStartDate(s.Start).
+			SetEndDate(s.End)
+	}
+	if _, err := c.db.FiscalPeriod.CreateBulk(bulk...).Save(c.ctx); err != nil {
+		return "", fmt.Errorf("failed to create fiscal periods: %w", err)
+	}
+
+	return fmt.Sprintf("Fiscal year %d opened with %d periods.", year, len(spans)), nil
+}
+
+// SetPeriodStatus moves a period between open, soft_closed and hard_closed.
+// Finance managers may soft-close and reopen; only admins may hard-close, and a hard close is final.
+func (c *CapitalBridge) SetPeriodStatus(periodID int, status string) (string, error) {
+	prof, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", fmt.Errorf("authentication required: %w", err)
+	}
+	if !canPostToSoftClosed(prof.Role) {
+		return "", fmt.Errorf("insufficient permissions: period close requires Finance Manager role")
+	}
+
+	target := fiscalperiod.Status(status)
+	if err := fiscalperiod.StatusValidator(target); err != nil {
+		return "", err
+	}
+	if target == fiscalperiod.StatusHardClosed && prof.Role != "admin" {
+		return "", fmt.Errorf("insufficient permissions: hard close requires admin role")
+	}
+
+	p, err := c.db.FiscalPeriod.Query().
+		Where(fiscalperiod.ID(periodID), fiscalperiod.HasTenantWith(tenant.ID(prof.TenantID))).
+		Only(c.ctx)
+	if err != nil {
+		return "", fmt.Errorf("fiscal period not found or access denied: %w", err)
+	}
+	if p.Status == fiscalperiod.StatusHardClosed {
+		return "", fmt.Errorf("period %s is hard-closed and cannot be changed", p.Name)
+	}
+	if target == fiscalperiod.StatusHardClosed {
+		// The last period takes the year-end closing entry, so only CloseYear hard-closes it.
+		later, err := c.db.FiscalPeriod.Query().
+			Where(
+				fiscalperiod.HasTenantWith(tenant.ID(prof.TenantID)),
+				fiscalperiod.FiscalYear(p.FiscalYear),
+				fiscalperiod.PeriodNumberGT(p.PeriodNumber),
+			).
+			Exist(c.ctx)
+		if err != nil {
+			return "", err
+		}
+		if !later {
+			return "", fmt.Errorf("period %s is the last of fiscal year %d and is hard-closed by the year-end close", p.Name, p.FiscalYear)
+		}
+	}
+
+	upd := c.db.FiscalPeriod.UpdateOne(p).SetStatus(target)
+	if target == fiscalperiod.StatusOpen {
+		upd.ClearClosedAt().SetClosedBy("")
+	} else {
+		upd.SetClosedAt(time.Now()).SetClosedBy(prof.Email)
+	}
+	if err := upd.Exec(c.ctx); err != nil {
+		return "", err
+	}
+
+	// A closed period no longer changes, so its balances can be materialized.
+	if target != fiscalperiod.StatusOpen {
+		if _, err := SnapshotPeriod(c.ctx, c.db, prof.TenantID, p.StartDate, p.EndDate); err != nil {
+			fmt.Printf("[CAPITAL] Balance snapshot for %s failed: %v\n", p.Name, err)
+		}
+	}
+
+	database.LogAuditRecord(c.ctx, c.db, prof.TenantID, "SENTcapital", "fiscal_period_status_changed", prof.Email, map[string]interface{}{
+		"period": p.Name,
+		"from":   string(p.Status),
+		"to":     status,
+	})
+
+	return fmt.Sprintf("Period %s is now %s.", p.Name, status), nil
+}
+
+// CloseFiscalYear runs the year-end close for the current tenant.
+func (c *CapitalBridge) CloseFiscalYear(year int) (string, error) {
+	prof, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", fmt.Errorf("authentication required: %w", err)
+	}
+	if prof.Role != "admin" {
+		return "", fmt.Errorf("insufficient permissions: year-end close requires admin role")
+	}
+
+	txnID, err := CloseYear(c.ctx, c.db, prof.TenantID, year, prof.Email)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Fiscal year %d closed. Closing entry: transaction %d.", year, txnID), nil
+}
+
+// CloseYear zeroes every revenue and expense account for the fiscal year into retained
+// earnings with a single closing entry dated on the last day of the year, then hard-closes
+// all periods of the year. Every period must already be at least soft-closed, and the last
+// one must not be hard-closed.
+func CloseYear(ctx context.Context, db *ent.Client, tenantID, year int, closedBy string) (int, error) {
+	reference := fmt.Sprintf("YEAR-END-%d", year)
+
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	periods, err := tx.FiscalPeriod.Query().
+		Where(fiscalperiod.HasTenantWith(tenant.ID(tenantID)), fiscalperiod.FiscalYear(year)).
+		Order(ent.Asc(fiscalperiod.FieldPeriodNumber)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(periods) == 0 {
+		return 0, fmt.Errorf("fiscal year %d has no periods", year)
+	}
+
+	done, err := tx.Transaction.Query().
+		Where(transaction.HasTenantWith(tenant.ID(tenantID)), transaction.Reference(reference)).
+		Exist(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if done {
+		return 0, fmt.Errorf("fiscal year %d is already closed", year)
+	}
+	for _, p := range periods {
+		if p.Status == fiscalperiod.StatusOpen {
+			return 0, fmt.Errorf("period %s is still open; close all periods before year-end", p.Name)
+		}
+	}
+	// The closing entry is dated in the last period, which must still accept adjustments.
+	if last := periods[len(periods)-1]; last.Status == fiscalperiod.StatusHardClosed {
+		return 0, fmt.Errorf("%w: %s cannot take the closing entry of fiscal year %d; it must be soft-closed, not hard-closed",
+			ErrPeriodHardClosed, last.Name, year)
+	}
+
+	yearStart, yearEnd := periods[0].StartDate, periods[len(periods)-1].EndDate
+	entries, err := tx.JournalEntry.Query().
+		Where(
+			journalentry.HasTenantWith(tenant.ID(tenantID)),
+			journalentry.ApprovalStatusEQ(journalentry.ApprovalStatusAPPROVED),
+			journalentry.HasAccountWith(account.TypeIn(account.TypeRevenue, account.TypeExpense)),
+			journalentry.HasTransactionWith(
+				transaction.DateGTE(yearStart),
+				transaction.DateLTE(yearEnd),
+			),
+		).
+		WithAccount().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load income statement entries: %w", err)
+	}
+
+	// Net debit balance per account over the year.
+	net := make(map[int]decimal.Decimal)
+	var order []int
+	for _, e := range entries {
+		id := e.Edges.Account.ID
+		if _, seen := net[id]; !seen {
+			order = append(order, id)
+			net[id] = decimal.Zero
+		}
+		if e.Direction == journalentry.DirectionDebit {
+			net[id] = net[id].Add(e.Amount)
+		} else {
+			net[id] = net[id].Sub(e.Amount)
+		}
+	}
+
+	re, err := EnsureAccount(ctx, tx, tnt, AccountRetainedEarnings, "Retained Earnings", account.TypeEquity)
+	if err != nil {
+		return 0, err
+	}
+
+	var lines []PostingLine
+	result := decimal.Zero // net debit of all closed accounts; negative is a profit
+	for _, id := range order {
+		bal := net[id]
+		if bal.IsZero() {
+			continue
+		}
+		direction := "credit"
+		if bal.IsNegative() {
+			direction = "debit"
+		}
+		lines = append(lines, PostingLine{AccountID: id, Direction: direction, Amount: bal.Abs()})
+		result = result.Add(bal)
+	}
+	if !result.IsZero() {
+		direction := "debit" // a loss reduces retained earnings
+		if result.IsNegative() {
+			direction = "credit"
+		}
+		lines = append(lines, PostingLine{AccountID: re.ID, Direction: direction, Amount: result.Abs()})
+	}
+
+	txnID := 0
+	if len(lines) > 0 {
+		txn, err := NewLedgerService().Post(ctx, tx, Posting{
+			TenantID:        tenantID,
+			Date:            yearEnd,
+			Description:     fmt.Sprintf("Year-end close FY%d", year),
+			Type:            "year_end_close",
+			Reference:       reference,
+			AllowSoftClosed: true,
+			Lines:           lines,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to post closing entry: %w", err)
+		}
+		txnID = txn.ID
+	}
+
+	now := time.Now()
+	_, err = tx.FiscalPeriod.Update().
+		Where(fiscalperiod.HasTenantWith(tenant.ID(tenantID)), fiscalperiod.FiscalYear(year)).
+		SetStatus(fiscalperiod.StatusHardClosed).
+		SetClosedAt(now).
+		SetClosedBy(closedBy).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to hard-close periods: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	database.LogAuditRecord(ctx, db, tenantID, "SENTcapital", "fiscal_year_closed", closedBy, map[string]interface{}{
+		"fiscal_year":    year,
+		"transaction_id": txnID,
+		"net_result":     result.Neg().String(),
+	})
+
+	return txnID, nil
+}
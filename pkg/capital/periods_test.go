@@ -0,0 +1,108 @@
+package capital
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"sent/ent/account"
+	"sent/ent/enttest"
+	"sent/ent/fiscalperiod"
+	"sent/ent/tenant"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+)
+
+func TestBuildFiscalYear(t *testing.T) {
+	// A July fiscal year runs Jul 2024 - Jun 2025.
+	spans := BuildFiscalYear(2024, 7, time.UTC)
+	if len(spans) != 12 {
+		t.Fatalf("expected 12 periods, got %d", len(spans))
+	}
+
+	first, last := spans[0], spans[11]
+	if first.Name != "FY2024-01" || !first.Start.Equal(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected first period: %+v", first)
+	}
+	if last.Number != 12 || last.End.Format("2006-01-02") != "2025-06-30" {
+		t.Errorf("unexpected last period: %+v", last)
+	}
+
+	// Periods must be contiguous with no gaps or overlaps.
+	for i := 1; i < len(spans); i++ {
+		if !spans[i].Start.Equal(spans[i-1].End.Add(time.Nanosecond)) {
+			t.Errorf("gap between %s and %s", spans[i-1].Name, spans[i].Name)
+		}
+	}
+}
+
+func TestCloseYearWithHardClosedLastPeriod(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:closeyear?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	tnt := client.Tenant.Create().SetName("Test Tenant").SetDomain("test.com").SaveX(ctx)
+	spans := BuildFiscalYear(2024, 1, time.UTC)
+	for _, s := range spans {
+		client.FiscalPeriod.Create().
+			SetTenant(tnt).
+			SetName(s.Name).
+			SetFiscalYear(2024).
+			SetPeriodNumber(s.Number).
+			SetStartDate(s.Start).
+			SetEndDate(s.End).
+			SaveX(ctx)
+	}
+	cash := client.Account.Create().SetTenant(tnt).SetName("Cash").SetNumber("1001").SetType(account.TypeAsset).SaveX(ctx)
+	rev := client.Account.Create().SetTenant(tnt).SetName("Revenue").SetNumber("4001").SetType(account.TypeRevenue).SaveX(ctx)
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewLedgerService().Post(ctx, tx, Posting{
+		TenantID:    tnt.ID,
+		Date:        time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		Description: "March sale",
+		Lines: []PostingLine{
+			{AccountID: cash.ID, Direction: "debit", Amount: decimal.NewFromInt(250)},
+			{AccountID: rev.ID, Direction: "credit", Amount: decimal.NewFromInt(250)},
+		},
+	})
+	if err != nil {
+		tx.Rollback()
+		t.Fatalf("posting sale: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every period is closed, but December is hard-closed and cannot take the closing entry.
+	client.FiscalPeriod.Update().Where(fiscalperiod.HasTenantWith(tenant.ID(tnt.ID)), fiscalperiod.FiscalYear(2024)).
+		SetStatus(fiscalperiod.StatusSoftClosed).ExecX(ctx)
+	client.FiscalPeriod.Update().Where(fiscalperiod.HasTenantWith(tenant.ID(tnt.ID)), fiscalperiod.PeriodNumber(12)).
+		SetStatus(fiscalperiod.StatusHardClosed).ExecX(ctx)
+
+	if _, err := CloseYear(ctx, client, tnt.ID, 2024, "admin@test.com"); !errors.Is(err, ErrPeriodHardClosed) {
+		t.Fatalf("expected ErrPeriodHardClosed, got %v", err)
+	}
+	if n := client.Transaction.Query().CountX(ctx); n != 1 {
+		t.Errorf("rejected close left %d transactions, want 1", n)
+	}
+
+	// Once December is only soft-closed the year closes into retained earnings.
+	client.FiscalPeriod.Update().Where(fiscalperiod.HasTenantWith(tenant.ID(tnt.ID)), fiscalperiod.PeriodNumber(12)).
+		SetStatus(fiscalperiod.StatusSoftClosed).ExecX(ctx)
+	txnID, err := CloseYear(ctx, client, tnt.ID, 2024, "admin@test.com")
+	if err != nil {
+		t.Fatalf("closing year: %v", err)
+	}
+	if txnID == 0 {
+		t.Error("expected a closing entry")
+	}
+	if n := client.FiscalPeriod.Query().Where(fiscalperiod.StatusEQ(fiscalperiod.StatusHardClosed)).CountX(ctx); n != 12 {
+		t.Errorf("expected 12 hard-closed periods, got %d", n)
+	}
+}
@@ -0,0 +1,1505 @@
+package capital
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/customer"
+	"sent/ent/customerpayment"
+	"sent/ent/einvoice"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+	"sent/ent/paymentallocation"
+	"sent/ent/tenant"
+	"sent/pkg/database"
+	"sent/pkg/tax"
+
+	"github.com/shopspring/decimal"
+)
+
+// Accounts receivable control and output tax accounts. Both are created on demand.
+const (
+	AccountReceivable = "1200"
+	AccountOutputTax  = tax.AccountOutputTax
+)
+
+// ErrOverAllocation is returned when an allocation exceeds the open amount of either side.
+var ErrOverAllocation = errors.New("allocation exceeds open amount")
+
+// DocumentStore persists generated documents. It is satisfied by the SENTvault bridge, which
+// capital cannot import directly.
+type DocumentStore interface {
+	SaveFileAsTenant(path string, base64Content string, tenantID int) error
+}
+
+// InvoiceLineRequest is a line of a new invoice or credit note. Amounts are in the document currency.
+type InvoiceLineRequest struct {
+	Description string  `json:"description"`
+	Quantity    float64 `json:"quantity"`
+	UnitPrice   float64 `json:"unit_price"`
+	AccountID   int     `json:"account_id"` // Revenue account; zero means 4000 Revenue
+	TaxCode     string  `json:"tax_code"`   // Empty means the customer's country default; VAT-JO-Z is zero-rated
+	TaxExempt   bool    `json:"tax_exempt"`
+}
+
+// InvoiceRequest describes a customer invoice to issue.
+type InvoiceRequest struct {
+	CustomerID int                  `json:"customer_id"`
+	IssueDate  time.Time            `json:"issue_date"` // Zero means today
+	DueDate    time.Time            `json:"due_date"`   // Zero means issue date plus the customer's terms
+	Currency   string               `json:"currency"`   // Empty means the customer's billing currency
+	Notes      string               `json:"notes"`
+	Lines      []InvoiceLineRequest `json:"lines"`
+}
+
+// AllocationRequest settles part of an invoice.
+type AllocationRequest struct {
+	InvoiceID int     `json:"invoice_id"`
+	Amount    float64 `json:"amount"`
+}
+
+// PaymentRequest records money received from a customer. Without allocations the payment is
+// applied to the customer's open invoices in due date order.
+type PaymentRequest struct {
+	CustomerID       int                 `json:"customer_id"`
+	Date             time.Time           `json:"date"`
+	Amount           float64             `json:"amount"`
+	Currency         string              `json:"currency"`
+	DepositAccountID int                 `json:"deposit_account_id"` // Zero means 1000 Operating Cash
+	Method           string              `json:"method"`
+	Reference        string              `json:"reference"`
+	Allocations      []AllocationRequest `json:"allocations"`
+}
+
+// computedLine is an invoice line with its amounts worked out.
+type computedLine struct {
+	Description string
+	Quantity    decimal.Decimal
+	UnitPrice   decimal.Decimal
+	AccountID   int
+	TaxCode     string
+	TaxRate     decimal.Decimal
+	TaxRounding tax.Rounding
+	Net         decimal.Decimal
+}
+
+// computeDocument works out line nets, tax lines and totals rounded to the document currency.
+func computeDocument(lines []computedLine, currency string) (subtotal, taxTotal decimal.Decimal, taxLines []tax.Line) {
+	places := CurrencyPlaces(currency)
+	taxable := make([]tax.TaxableLine, 0, len(lines))
+	for i := range lines {
+		lines[i].Net = lines[i].Quantity.Mul(lines[i].UnitPrice).Round(places)
+		subtotal = subtotal.Add(lines[i].Net)
+		taxable = append(taxable, tax.TaxableLine{Code: lines[i].TaxCode, Rate: lines[i].TaxRate, Rounding: lines[i].TaxRounding, Net: lines[i].Net})
+	}
+	taxLines = tax.Summarize(taxable, places)
+	for _, t := range taxLines {
+		taxTotal = taxTotal.Add(t.Tax)
+	}
+	return subtotal, taxTotal, taxLines
+}
+
+// requestLines validates requested lines and resolves their tax code and its rate on the document
+// date. Lines without an explicit code take the default code of the counterparty's country: the
+// exempt code for tax-exempt customers, otherwise the standard one.
+func requestLines(ctx context.Context, rates *tax.Resolver, reqs []InvoiceLineRequest, countryCode string, exempt bool, on time.Time) ([]computedLine, error) {
+	lines := make([]computedLine, 0, len(reqs))
+	for i, l := range reqs {
+		if l.Quantity <= 0 || l.UnitPrice < 0 {
+			return nil, fmt.Errorf("line %d: quantity must be positive and price not negative", i+1)
+		}
+		cl := computedLine{
+			Description: l.Description,
+			Quantity:    decimal.NewFromFloat(l.Quantity),
+			UnitPrice:   decimal.NewFromFloat(l.UnitPrice),
+			AccountID:   l.AccountID,
+		}
+		if !l.TaxExempt {
+			cl.TaxCode = l.TaxCode
+			if cl.TaxCode == "" && countryCode != "" {
+				cat := tax.Standard
+				if exempt {
+					cat = tax.Exempt
+				}
+				cl.TaxCode = tax.CodeFor(countryCode, cat)
+			}
+			if cl.TaxCode != "" {
+				r, err := rates.Resolve(ctx, cl.TaxCode, on)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", i+1, err)
+				}
+				cl.TaxCode, cl.TaxRate, cl.TaxRounding = r.Code, r.Rate, r.Rounding
+			}
+		}
+		lines = append(lines, cl)
+	}
+	return lines, nil
+}
+
+// outstanding is the unsettled part of an invoice or the unapplied part of a credit note.
+func outstanding(inv *ent.Invoice) decimal.Decimal {
+	return inv.Total.Sub(inv.AmountSettled)
+}
+
+// settlementStatus derives the status from the settled amount.
+func settlementStatus(total, settled decimal.Decimal) invoice.Status {
+	switch {
+	case settled.GreaterThanOrEqual(total):
+		return invoice.StatusPaid
+	case settled.IsPositive():
+		return invoice.StatusPartiallyPaid
+	}
+	return invoice.StatusIssued
+}
+
+// ReceivablesService maintains the accounts receivable subledger and keeps the AR control
+// account in the general ledger in step with it.
+type ReceivablesService struct {
+	db       *ent.Client
+	ledger   *LedgerService
+	exchange *ExchangeService
+	docs     DocumentStore
+}
+
+// NewReceivablesService initializes a new ReceivablesService. docs may be nil, in which case
+// documents are rendered but not stored.
+func NewReceivablesService(db *ent.Client, docs DocumentStore) *ReceivablesService {
+	return &ReceivablesService{db: db, ledger: NewLedgerService(), exchange: NewExchangeService(db), docs: docs}
+}
+
+// IssueInvoice creates an invoice with its lines and tax lines, posts it to AR and stores its PDF.
+func (s *ReceivablesService) IssueInvoice(ctx context.Context, tenantID int, req InvoiceRequest) (*ent.Invoice, error) {
+	if len(req.Lines) == 0 {
+		return nil, fmt.Errorf("invoice has no lines")
+	}
+	cust, err := s.db.Customer.Query().
+		Where(customer.ID(req.CustomerID), customer.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("customer %d not found: %w", req.CustomerID, err)
+	}
+	if !cust.IsActive {
+		return nil, fmt.Errorf("customer %s is inactive", cust.Code)
+	}
+
+	functional, err := s.exchange.FunctionalCurrency(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	currency := normalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = normalizeCurrency(cust.Currency)
+	}
+	if currency == "" {
+		currency = functional
+	}
+
+	issue := req.IssueDate
+	if issue.IsZero() {
+		issue = time.Now()
+	}
+	due := req.DueDate
+	if due.IsZero() {
+		due = issue.AddDate(0, 0, cust.PaymentTermsDays)
+	}
+	if due.Before(startOfDay(issue)) {
+		return nil, fmt.Errorf("due date is before the issue date")
+	}
+
+	rate, err := s.exchange.GetExchangeRate(ctx, tenantID, currency, functional, issue)
+	if err != nil {
+		return nil, fmt.Errorf("cannot invoice in %s: %w", currency, err)
+	}
+
+	lines, err := requestLines(ctx, tax.NewResolver(s.db, tenantID), req.Lines, cust.CountryCode, cust.TaxExempt, issue)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	inv, err := s.createDocument(ctx, tx, tenantID, documentSpec{
+		Kind:     invoice.KindInvoice,
+		Customer: cust,
+		Issue:    issue,
+		Due:      due,
+		Currency: currency,
+		Rate:     rate,
+		Notes:    req.Notes,
+		Lines:    lines,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	database.LogAuditRecord(ctx, s.db, tenantID, "SENTcapital", "invoice_issued", "system", map[string]interface{}{
+		"invoice_id": inv.ID,
+		"number":     inv.Number,
+		"total":      inv.Total.String(),
+		"currency":   inv.Currency,
+	})
+
+	return s.storeInvoicePDF(ctx, tenantID, inv.ID)
+}
+
+// CreditNote issues a credit note against an invoice and reverses the original posting at the
+// original exchange rate. With no lines, the whole invoice is credited. The credit is applied to
+// what is still open on the original; any excess stays on the customer account as unapplied credit.
+func (s *ReceivablesService) CreditNote(ctx context.Context, tenantID, invoiceID int, lines []InvoiceLineRequest, reason string) (*ent.Invoice, error) {
+	orig, err := s.db.Invoice.Query().
+		Where(invoice.ID(invoiceID), invoice.HasTenantWith(tenant.ID(tenantID)), invoice.KindEQ(invoice.KindInvoice)).
+		WithCustomer().
+		WithLines(func(q *ent.InvoiceLineQuery) { q.WithAccount() }).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invoice %d not found: %w", invoiceID, err)
+	}
+	if orig.Status == invoice.StatusDraft || orig.Status == invoice.StatusVoid {
+		return nil, fmt.Errorf("invoice %s is %s and cannot be credited", orig.Number, orig.Status)
+	}
+
+	// Credits carry the tax of the original invoice, whatever the rate is now.
+	rates := tax.NewResolver(s.db, tenantID)
+	var computed []computedLine
+	if len(lines) == 0 {
+		for _, l := range orig.Edges.Lines {
+			cl := computedLine{
+				Description: l.Description,
+				Quantity:    l.Quantity,
+				UnitPrice:   l.UnitPrice,
+				AccountID:   l.Edges.Account.ID,
+				TaxCode:     l.TaxCode,
+				TaxRate:     l.TaxRate,
+			}
+			if l.TaxCode != "" {
+				if r, err := rates.Resolve(ctx, l.TaxCode, orig.IssueDate); err == nil {
+					cl.TaxRounding = r.Rounding
+				}
+			}
+			computed = append(computed, cl)
+		}
+	} else if computed, err = requestLines(ctx, rates, lines, orig.Edges.Customer.CountryCode, orig.Edges.Customer.TaxExempt, orig.IssueDate); err != nil {
+		return nil, err
+	}
+
+	// Credited so far may not exceed the original invoice.
+	credited, err := orig.QueryCreditNotes().
+		Where(invoice.StatusNEQ(invoice.StatusVoid)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	already := decimal.Zero
+	for _, cn := range credited {
+		already = already.Add(cn.Total)
+	}
+	sub, taxTotal, _ := computeDocument(append([]computedLine(nil), computed...), orig.Currency)
+	if already.Add(sub).Add(taxTotal).GreaterThan(orig.Total) {
+		return nil, fmt.Errorf("credit of %s exceeds the uncredited amount of invoice %s", sub.Add(taxTotal).StringFixed(2), orig.Number)
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	notes := fmt.Sprintf("Credit for invoice %s", orig.Number)
+	if reason != "" {
+		notes += ": " + reason
+	}
+	cn, err := s.createDocument(ctx, tx, tenantID, documentSpec{
+		Kind:     invoice.KindCreditNote,
+		Customer: orig.Edges.Customer,
+		Issue:    now,
+		Due:      now,
+		Currency: orig.Currency,
+		Rate:     orig.ExchangeRate,
+		Notes:    notes,
+		Lines:    computed,
+		Original: orig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	open := outstanding(orig)
+	if apply := decimal.Min(open, cn.Total); apply.IsPositive() {
+		if err := s.allocate(ctx, tx, tenantID, orig.ID, apply, nil, &cn.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	database.LogAuditRecord(ctx, s.db, tenantID, "SENTcapital", "credit_note_issued", "system", map[string]interface{}{
+		"credit_note_id": cn.ID,
+		"invoice_id":     orig.ID,
+		"total":          cn.Total.String(),
+		"reason":         reason,
+	})
+
+	return s.storeInvoicePDF(ctx, tenantID, cn.ID)
+}
+
+// documentSpec carries everything createDocument needs to write and post an invoice or credit note.
+type documentSpec struct {
+	Kind     invoice.Kind
+	Customer *ent.Customer
+	Issue    time.Time
+	Due      time.Time
+	Currency string
+	Rate     decimal.Decimal
+	Notes    string
+	Lines    []computedLine
+	Original *ent.Invoice
+}
+
+// createDocument writes the document, its lines and tax lines, and posts it. Invoices debit AR
+// and credit revenue and output tax; credit notes post the exact reverse.
+func (s *ReceivablesService) createDocument(ctx context.Context, tx *ent.Tx, tenantID int, d documentSpec) (*ent.Invoice, error) {
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	functional := normalizeCurrency(tnt.FunctionalCurrency)
+
+	arAcc, err := EnsureAccount(ctx, tx, tnt, AccountReceivable, "Accounts Receivable", account.TypeAsset)
+	if err != nil {
+		return nil, err
+	}
+	taxAcc, err := EnsureAccount(ctx, tx, tnt, AccountOutputTax, "Output VAT", account.TypeLiability)
+	if err != nil {
+		return nil, err
+	}
+	var revenueID int
+	for i := range d.Lines {
+		if d.Lines[i].AccountID != 0 {
+			continue
+		}
+		if revenueID == 0 {
+			rev, err := EnsureAccount(ctx, tx, tnt, "4000", "Revenue", account.TypeRevenue)
+			if err != nil {
+				return nil, err
+			}
+			revenueID = rev.ID
+		}
+		d.Lines[i].AccountID = revenueID
+	}
+
+	subtotal, taxTotal, taxLines := computeDocument(d.Lines, d.Currency)
+	total := subtotal.Add(taxTotal)
+	if !total.IsPositive() {
+		return nil, fmt.Errorf("document total must be positive")
+	}
+
+	prefix := "INV"
+	if d.Kind == invoice.KindCreditNote {
+		prefix = "CN"
+	}
+	n, err := tx.Invoice.Query().
+		Where(invoice.HasTenantWith(tenant.ID(tenantID)), invoice.KindEQ(d.Kind)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tx.Invoice.Create().
+		SetTenant(tnt).
+		SetCustomer(d.Customer).
+		SetNumber(fmt.Sprintf("%s-%05d", prefix, n+1)).
+		SetKind(d.Kind).
+		SetStatus(invoice.StatusIssued).
+		SetIssueDate(d.Issue).
+		SetDueDate(d.Due).
+		SetCurrency(d.Currency).
+		SetExchangeRate(d.Rate).
+		SetSubtotal(subtotal).
+		SetTaxTotal(taxTotal).
+		SetTotal(total).
+		SetNotes(d.Notes)
+	if d.Original != nil {
+		create.SetOriginalInvoice(d.Original)
+	}
+	inv, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save invoice: %w", err)
+	}
+
+	for i, l := range d.Lines {
+		err := tx.InvoiceLine.Create().
+			SetInvoice(inv).
+			SetDescription(l.Description).
+			SetQuantity(l.Quantity).
+			SetUnitPrice(l.UnitPrice).
+			SetTaxCode(l.TaxCode).
+			SetTaxRate(l.TaxRate).
+			SetNetAmount(l.Net).
+			SetPosition(i + 1).
+			SetAccountID(l.AccountID).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save invoice line: %w", err)
+		}
+	}
+	for _, t := range taxLines {
+		err := tx.InvoiceTaxLine.Create().
+			SetInvoice(inv).
+			SetTaxCode(t.Code).
+			SetRate(t.Rate).
+			SetTaxableAmount(t.Taxable).
+			SetTaxAmount(t.Tax).
+			SetAccount(taxAcc).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save tax line: %w", err)
+		}
+	}
+
+	// Income side, grouped by account, converted at the document rate.
+	arDir, incomeDir := "debit", "credit"
+	if d.Kind == invoice.KindCreditNote {
+		arDir, incomeDir = "credit", "debit"
+	}
+	postCurrency := d.Currency
+	if postCurrency == functional {
+		postCurrency = ""
+	}
+	byAccount := make(map[int]decimal.Decimal)
+	var order []int
+	for _, l := range d.Lines {
+		if _, ok := byAccount[l.AccountID]; !ok {
+			order = append(order, l.AccountID)
+		}
+		byAccount[l.AccountID] = byAccount[l.AccountID].Add(l.Net)
+	}
+	if !taxTotal.IsZero() {
+		order = append(order, taxAcc.ID)
+		byAccount[taxAcc.ID] = byAccount[taxAcc.ID].Add(taxTotal)
+	}
+
+	var postingLines []PostingLine
+	arFunctional := decimal.Zero
+	for _, accID := range order {
+		amt := byAccount[accID]
+		if amt.IsZero() {
+			continue
+		}
+		f := RoundCurrency(amt.Mul(d.Rate), functional)
+		arFunctional = arFunctional.Add(f)
+		postingLines = append(postingLines, PostingLine{
+			AccountID: accID, Direction: incomeDir, Amount: f,
+			Currency: postCurrency, CurrencyAmount: amt, Rate: d.Rate,
+		})
+	}
+	postingLines = append([]PostingLine{{
+		AccountID: arAcc.ID, Direction: arDir, Amount: arFunctional,
+		Currency: postCurrency, CurrencyAmount: total, Rate: d.Rate,
+	}}, postingLines...)
+
+	label := "Invoice"
+	typ := "invoice"
+	if d.Kind == invoice.KindCreditNote {
+		label, typ = "Credit note", "credit_note"
+	}
+	txn, err := s.ledger.Post(ctx, tx, Posting{
+		TenantID:     tenantID,
+		Date:         d.Issue,
+		Description:  fmt.Sprintf("%s %s - %s", label, inv.Number, d.Customer.Name),
+		Type:         typ,
+		Reference:    inv.Number,
+		Currency:     postCurrency,
+		ExchangeRate: d.Rate,
+		Lines:        postingLines,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s %s not posted: %w", strings.ToLower(label), inv.Number, err)
+	}
+
+	inv, err = tx.Invoice.UpdateOne(inv).SetTransaction(txn).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// In KSA the signed e-invoice is part of issuing: no CSID, no chain entry, no document.
+	if _, err := tax.IssueInvoiceEInvoice(ctx, tx.Client(), tenantID, inv.ID); err != nil {
+		return nil, fmt.Errorf("%s %s not issued: %w", strings.ToLower(label), inv.Number, err)
+	}
+	return inv, nil
+}
+
+// RecordPayment posts a customer payment to the deposit account and AR, then allocates it.
+func (s *ReceivablesService) RecordPayment(ctx context.Context, tenantID int, req PaymentRequest) (*ent.CustomerPayment, error) {
+	amount := decimal.NewFromFloat(req.Amount)
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("payment amount must be positive")
+	}
+	cust, err := s.db.Customer.Query().
+		Where(customer.ID(req.CustomerID), customer.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("customer %d not found: %w", req.CustomerID, err)
+	}
+
+	functional, err := s.exchange.FunctionalCurrency(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	currency := normalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = normalizeCurrency(cust.Currency)
+	}
+	if currency == "" {
+		currency = functional
+	}
+	amount = RoundCurrency(amount, currency)
+
+	date := req.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	rate, err := s.exchange.GetExchangeRate(ctx, tenantID, currency, functional, date)
+	if err != nil {
+		return nil, fmt.Errorf("cannot receive %s: %w", currency, err)
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	arAcc, err := EnsureAccount(ctx, tx, tnt, AccountReceivable, "Accounts Receivable", account.TypeAsset)
+	if err != nil {
+		return nil, err
+	}
+	depositID := req.DepositAccountID
+	if depositID == 0 {
+		cash, err := EnsureAccount(ctx, tx, tnt, "1000", "Operating Cash", account.TypeAsset)
+		if err != nil {
+			return nil, err
+		}
+		depositID = cash.ID
+	} else {
+		ok, err := tx.Account.Query().
+			Where(account.ID(depositID), account.HasTenantWith(tenant.ID(tenantID)), account.TypeEQ(account.TypeAsset)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("deposit account %d not found", depositID)
+		}
+	}
+
+	postCurrency := currency
+	if postCurrency == functional {
+		postCurrency = ""
+	}
+	f := RoundCurrency(amount.Mul(rate), functional)
+	txn, err := s.ledger.Post(ctx, tx, Posting{
+		TenantID:     tenantID,
+		Date:         date,
+		Description:  fmt.Sprintf("Payment from %s", cust.Name),
+		Type:         "customer_payment",
+		Reference:    req.Reference,
+		Currency:     postCurrency,
+		ExchangeRate: rate,
+		Lines: []PostingLine{
+			{AccountID: depositID, Direction: "debit", Amount: f, Currency: postCurrency, CurrencyAmount: amount, Rate: rate},
+			{AccountID: arAcc.ID, Direction: "credit", Amount: f, Currency: postCurrency, CurrencyAmount: amount, Rate: rate},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pay, err := tx.CustomerPayment.Create().
+		SetTenant(tnt).
+		SetCustomer(cust).
+		SetDate(date).
+		SetAmount(amount).
+		SetCurrency(currency).
+		SetExchangeRate(rate).
+		SetUnallocated(amount).
+		SetMethod(req.Method).
+		SetReference(req.Reference).
+		SetDepositAccountID(depositID).
+		SetTransaction(txn).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save payment: %w", err)
+	}
+
+	allocs := req.Allocations
+	if len(allocs) == 0 {
+		allocs, err = s.autoAllocations(ctx, tx, cust.ID, currency, amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, a := range allocs {
+		if err := s.allocate(ctx, tx, tenantID, a.InvoiceID, decimal.NewFromFloat(a.Amount), &pay.ID, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	database.LogAuditRecord(ctx, s.db, tenantID, "SENTcapital", "customer_payment_recorded", "system", map[string]interface{}{
+		"payment_id":  pay.ID,
+		"customer_id": cust.ID,
+		"amount":      amount.String(),
+		"currency":    currency,
+	})
+
+	return s.db.CustomerPayment.Get(ctx, pay.ID)
+}
+
+// autoAllocations spreads an amount over the customer's open invoices, oldest due date first.
+func (s *ReceivablesService) autoAllocations(ctx context.Context, tx *ent.Tx, customerID int, currency string, amount decimal.Decimal) ([]AllocationRequest, error) {
+	open, err := tx.Invoice.Query().
+		Where(
+			invoice.HasCustomerWith(customer.ID(customerID)),
+			invoice.KindEQ(invoice.KindInvoice),
+			invoice.StatusIn(invoice.StatusIssued, invoice.StatusPartiallyPaid),
+			invoice.Currency(currency),
+		).
+		Order(ent.Asc(invoice.FieldDueDate), ent.Asc(invoice.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []AllocationRequest
+	remaining := amount
+	for _, inv := range open {
+		if !remaining.IsPositive() {
+			break
+		}
+		apply := decimal.Min(remaining, outstanding(inv))
+		if !apply.IsPositive() {
+			continue
+		}
+		out = append(out, AllocationRequest{InvoiceID: inv.ID, Amount: apply.InexactFloat64()})
+		remaining = remaining.Sub(apply)
+	}
+	return out, nil
+}
+
+// AllocatePayment applies unallocated payment money to invoices.
+func (s *ReceivablesService) AllocatePayment(ctx context.Context, tenantID, paymentID int, allocs []AllocationRequest) error {
+	pay, err := s.db.CustomerPayment.Query().
+		Where(customerpayment.ID(paymentID), customerpayment.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("payment %d not found: %w", paymentID, err)
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, a := range allocs {
+		if err := s.allocate(ctx, tx, tenantID, a.InvoiceID, decimal.NewFromFloat(a.Amount), &pay.ID, nil); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ApplyCreditNote applies unapplied credit to another open invoice of the same customer.
+func (s *ReceivablesService) ApplyCreditNote(ctx context.Context, tenantID, creditNoteID, invoiceID int, amount decimal.Decimal) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := s.allocate(ctx, tx, tenantID, invoiceID, amount, nil, &creditNoteID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// allocate settles amount of an invoice from a payment or a credit note. When the source was
+// booked at a different rate than the invoice, the difference on AR is realized as FX gain/loss.
+func (s *ReceivablesService) allocate(ctx context.Context, tx *ent.Tx, tenantID, invoiceID int, amount decimal.Decimal, paymentID, creditNoteID *int) error {
+	inv, err := tx.Invoice.Query().
+		Where(invoice.ID(invoiceID), invoice.HasTenantWith(tenant.ID(tenantID)), invoice.KindEQ(invoice.KindInvoice)).
+		WithCustomer().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("invoice %d not found: %w", invoiceID, err)
+	}
+	if inv.Status == invoice.StatusDraft || inv.Status == invoice.StatusVoid {
+		return fmt.Errorf("invoice %s is %s", inv.Number, inv.Status)
+	}
+	amount = RoundCurrency(amount, inv.Currency)
+	if !amount.IsPositive() {
+		return fmt.Errorf("allocation amount must be positive")
+	}
+	if amount.GreaterThan(outstanding(inv)) {
+		return fmt.Errorf("invoice %s: %w", inv.Number, ErrOverAllocation)
+	}
+
+	alloc := tx.PaymentAllocation.Create().SetInvoice(inv).SetAmount(amount)
+	var sourceRate decimal.Decimal
+	var sourceCustomer int
+	var sourceCurrency string
+
+	switch {
+	case paymentID != nil:
+		pay, err := tx.CustomerPayment.Query().
+			Where(customerpayment.ID(*paymentID), customerpayment.HasTenantWith(tenant.ID(tenantID))).
+			WithCustomer().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("payment %d not found: %w", *paymentID, err)
+		}
+		if amount.GreaterThan(pay.Unallocated) {
+			return fmt.Errorf("payment %d: %w", pay.ID, ErrOverAllocation)
+		}
+		sourceRate, sourceCustomer, sourceCurrency = pay.ExchangeRate, pay.Edges.Customer.ID, pay.Currency
+		if err := tx.CustomerPayment.UpdateOne(pay).SetUnallocated(pay.Unallocated.Sub(amount)).Exec(ctx); err != nil {
+			return err
+		}
+		alloc.SetPayment(pay)
+	case creditNoteID != nil:
+		cn, err := tx.Invoice.Query().
+			Where(invoice.ID(*creditNoteID), invoice.HasTenantWith(tenant.ID(tenantID)), invoice.KindEQ(invoice.KindCreditNote)).
+			WithCustomer().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("credit note %d not found: %w", *creditNoteID, err)
+		}
+		if amount.GreaterThan(outstanding(cn)) {
+			return fmt.Errorf("credit note %s: %w", cn.Number, ErrOverAllocation)
+		}
+		sourceRate, sourceCustomer, sourceCurrency = cn.ExchangeRate, cn.Edges.Customer.ID, cn.Currency
+		settled := cn.AmountSettled.Add(amount)
+		err = tx.Invoice.UpdateOne(cn).
+			SetAmountSettled(settled).
+			SetStatus(settlementStatus(cn.Total, settled)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		alloc.SetCreditNote(cn)
+	default:
+		return fmt.Errorf("allocation needs a payment or a credit note")
+	}
+
+	if sourceCustomer != inv.Edges.Customer.ID {
+		return fmt.Errorf("invoice %s belongs to another customer", inv.Number)
+	}
+	if normalizeCurrency(sourceCurrency) != normalizeCurrency(inv.Currency) {
+		return fmt.Errorf("invoice %s is in %s, not %s", inv.Number, inv.Currency, sourceCurrency)
+	}
+
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	functional := normalizeCurrency(tnt.FunctionalCurrency)
+	fx := RoundCurrency(amount.Mul(sourceRate), functional).Sub(RoundCurrency(amount.Mul(inv.ExchangeRate), functional))
+	if !fx.IsZero() {
+		if err := s.postRealizedFX(ctx, tx, tnt, inv, fx); err != nil {
+			return err
+		}
+		alloc.SetFxDifference(fx)
+	}
+
+	if err := alloc.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save allocation: %w", err)
+	}
+
+	settled := inv.AmountSettled.Add(amount)
+	return tx.Invoice.UpdateOne(inv).
+		SetAmountSettled(settled).
+		SetStatus(settlementStatus(inv.Total, settled)).
+		Exec(ctx)
+}
+
+// postRealizedFX clears the AR difference left when an invoice is settled at another rate.
+// A positive difference means AR was credited for more than it carried: a gain.
+func (s *ReceivablesService) postRealizedFX(ctx context.Context, tx *ent.Tx, tnt *ent.Tenant, inv *ent.Invoice, fx decimal.Decimal) error {
+	arAcc, err := EnsureAccount(ctx, tx, tnt, AccountReceivable, "Accounts Receivable", account.TypeAsset)
+	if err != nil {
+		return err
+	}
+	fxAcc, err := EnsureAccount(ctx, tx, tnt, AccountRealizedFX, "Realized FX Gain/Loss", account.TypeExpense)
+	if err != nil {
+		return err
+	}
+
+	arDir, fxDir := "debit", "credit"
+	if fx.IsNegative() {
+		arDir, fxDir = "credit", "debit"
+	}
+	amt := fx.Abs()
+	_, err = s.ledger.Post(ctx, tx, Posting{
+		TenantID:    tnt.ID,
+		Date:        time.Now(),
+		Description: fmt.Sprintf("Realized FX on settlement of %s", inv.Number),
+		Type:        "fx_realized",
+		Reference:   inv.Number,
+		Lines: []PostingLine{
+			{AccountID: arAcc.ID, Direction: arDir, Amount: amt},
+			{AccountID: fxAcc.ID, Direction: fxDir, Amount: amt},
+		},
+	})
+	return err
+}
+
+// VoidInvoice cancels an issued invoice that nothing has been allocated to by crediting it in full.
+func (s *ReceivablesService) VoidInvoice(ctx context.Context, tenantID, invoiceID int, reason string) error {
+	inv, err := s.db.Invoice.Query().
+		Where(invoice.ID(invoiceID), invoice.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("invoice %d not found: %w", invoiceID, err)
+	}
+	if !inv.AmountSettled.IsZero() {
+		return fmt.Errorf("invoice %s has settlements; issue a credit note instead", inv.Number)
+	}
+	cn, err := s.CreditNote(ctx, tenantID, invoiceID, nil, reason)
+	if err != nil {
+		return err
+	}
+	// Both documents are fully settled against each other; mark the pair void for reporting.
+	return s.db.Invoice.Update().
+		Where(invoice.IDIn(inv.ID, cn.ID)).
+		SetStatus(invoice.StatusVoid).
+		Exec(ctx)
+}
+
+// Aging buckets, by days past due.
+const (
+	BucketCurrent = iota
+	Bucket30
+	Bucket60
+	Bucket90
+	BucketOver90
+)
+
+// agingBucket returns the bucket of an item that fell due on due, as seen on asOf.
+func agingBucket(due, asOf time.Time) int {
+	days := int(startOfDay(asOf).Sub(startOfDay(due)).Hours() / 24)
+	switch {
+	case days <= 0:
+		return BucketCurrent
+	case days <= 30:
+		return Bucket30
+	case days <= 60:
+		return Bucket60
+	case days <= 90:
+		return Bucket90
+	}
+	return BucketOver90
+}
+
+// AgingRow is one customer's open balance split by age, in functional currency.
+type AgingRow struct {
+	CustomerID   int             `json:"customer_id"`
+	CustomerCode string          `json:"customer_code"`
+	CustomerName string          `json:"customer_name"`
+	Current      decimal.Decimal `json:"current"`
+	Days30       decimal.Decimal `json:"days_1_30"`
+	Days60       decimal.Decimal `json:"days_31_60"`
+	Days90       decimal.Decimal `json:"days_61_90"`
+	Over90       decimal.Decimal `json:"over_90"`
+	Unapplied    decimal.Decimal `json:"unapplied"` // Payments and credit notes not yet allocated, negative
+	Total        decimal.Decimal `json:"total"`
+}
+
+func (r *AgingRow) add(bucket int, amt decimal.Decimal) {
+	switch bucket {
+	case BucketCurrent:
+		r.Current = r.Current.Add(amt)
+	case Bucket30:
+		r.Days30 = r.Days30.Add(amt)
+	case Bucket60:
+		r.Days60 = r.Days60.Add(amt)
+	case Bucket90:
+		r.Days90 = r.Days90.Add(amt)
+	default:
+		r.Over90 = r.Over90.Add(amt)
+	}
+	r.Total = r.Total.Add(amt)
+}
+
+func (r *AgingRow) addRow(o AgingRow) {
+	r.Current = r.Current.Add(o.Current)
+	r.Days30 = r.Days30.Add(o.Days30)
+	r.Days60 = r.Days60.Add(o.Days60)
+	r.Days90 = r.Days90.Add(o.Days90)
+	r.Over90 = r.Over90.Add(o.Over90)
+	r.Unapplied = r.Unapplied.Add(o.Unapplied)
+	r.Total = r.Total.Add(o.Total)
+}
+
+// AgingReport is the AR aging at a date.
+type AgingReport struct {
+	AsOf     time.Time  `json:"as_of"`
+	Currency string     `json:"currency"`
+	Rows     []AgingRow `json:"rows"`
+	Totals   AgingRow   `json:"totals"`
+}
+
+// Aging builds the AR aging as of a date. Open amounts are reconstructed from allocations made
+// up to that date and converted at each document's booking rate, so the total agrees with AR.
+// customerID zero means all customers.
+func (s *ReceivablesService) Aging(ctx context.Context, tenantID, customerID int, asOf time.Time) (*AgingReport, error) {
+	functional, err := s.exchange.FunctionalCurrency(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	asOf = endOfDay(asOf)
+
+	custQuery := s.db.Customer.Query().Where(customer.HasTenantWith(tenant.ID(tenantID)))
+	if customerID != 0 {
+		custQuery = custQuery.Where(customer.ID(customerID))
+	}
+	customers, err := custQuery.Order(ent.Asc(customer.FieldCode)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AgingReport{AsOf: asOf, Currency: functional, Rows: []AgingRow{}}
+	for _, c := range customers {
+		row := AgingRow{CustomerID: c.ID, CustomerCode: c.Code, CustomerName: c.Name}
+
+		docs, err := c.QueryInvoices().
+			Where(invoice.IssueDateLTE(asOf), invoice.StatusNEQ(invoice.StatusDraft)).
+			WithAllocations(func(q *ent.PaymentAllocationQuery) { q.Where(paymentallocation.CreatedAtLTE(asOf)) }).
+			WithCreditApplications(func(q *ent.PaymentAllocationQuery) { q.Where(paymentallocation.CreatedAtLTE(asOf)) }).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range docs {
+			open := d.Total
+			if d.Kind == invoice.KindInvoice {
+				for _, a := range d.Edges.Allocations {
+					open = open.Sub(a.Amount)
+				}
+				if open.IsPositive() {
+					row.add(agingBucket(d.DueDate, asOf), RoundCurrency(open.Mul(d.ExchangeRate), functional))
+				}
+				continue
+			}
+			for _, a := range d.Edges.CreditApplications {
+				open = open.Sub(a.Amount)
+			}
+			if open.IsPositive() {
+				amt := RoundCurrency(open.Mul(d.ExchangeRate), functional).Neg()
+				row.Unapplied = row.Unapplied.Add(amt)
+				row.Total = row.Total.Add(amt)
+			}
+		}
+
+		payments, err := c.QueryPayments().
+			Where(customerpayment.DateLTE(asOf)).
+			WithAllocations(func(q *ent.PaymentAllocationQuery) { q.Where(paymentallocation.CreatedAtLTE(asOf)) }).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range payments {
+			open := p.Amount
+			for _, a := range p.Edges.Allocations {
+				open = open.Sub(a.Amount)
+			}
+			if open.IsPositive() {
+				amt := RoundCurrency(open.Mul(p.ExchangeRate), functional).Neg()
+				row.Unapplied = row.Unapplied.Add(amt)
+				row.Total = row.Total.Add(amt)
+			}
+		}
+
+		if row.Total.IsZero() && row.Unapplied.IsZero() {
+			continue
+		}
+		report.Rows = append(report.Rows, row)
+		report.Totals.addRow(row)
+	}
+	return report, nil
+}
+
+// StatementLine is one movement on a customer statement.
+type StatementLine struct {
+	Date      time.Time       `json:"date"`
+	Type      string          `json:"type"` // invoice, credit_note, payment
+	Reference string          `json:"reference"`
+	DueDate   *time.Time      `json:"due_date,omitempty"`
+	Debit     decimal.Decimal `json:"debit"`
+	Credit    decimal.Decimal `json:"credit"`
+	Balance   decimal.Decimal `json:"balance"`
+}
+
+// CustomerStatement lists a customer's documents and payments in the billing currency.
+type CustomerStatement struct {
+	TenantName     string          `json:"tenant_name"`
+	CustomerID     int             `json:"customer_id"`
+	CustomerCode   string          `json:"customer_code"`
+	CustomerName   string          `json:"customer_name"`
+	Address        string          `json:"address"`
+	Currency       string          `json:"currency"`
+	From           time.Time       `json:"from"`
+	To             time.Time       `json:"to"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	Lines          []StatementLine `json:"lines"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+	Aging          AgingRow        `json:"aging"`
+}
+
+// Statement builds a customer statement for the period. Only documents in the customer's
+// billing currency are included; the aging summary is in functional currency.
+func (s *ReceivablesService) Statement(ctx context.Context, tenantID, customerID int, from, to time.Time) (*CustomerStatement, error) {
+	cust, err := s.db.Customer.Query().
+		Where(customer.ID(customerID), customer.HasTenantWith(tenant.ID(tenantID))).
+		WithTenant().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("customer %d not found: %w", customerID, err)
+	}
+	currency := normalizeCurrency(cust.Currency)
+	if currency == "" {
+		currency = normalizeCurrency(cust.Edges.Tenant.FunctionalCurrency)
+	}
+	from, to = startOfDay(from), endOfDay(to)
+
+	docs, err := cust.QueryInvoices().
+		Where(
+			invoice.Currency(currency),
+			invoice.IssueDateLTE(to),
+			invoice.StatusNEQ(invoice.StatusDraft),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := cust.QueryPayments().
+		Where(customerpayment.Currency(currency), customerpayment.DateLTE(to)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var moves []StatementLine
+	for _, d := range docs {
+		l := StatementLine{Date: d.IssueDate, Reference: d.Number}
+		if d.Kind == invoice.KindInvoice {
+			due := d.DueDate
+			l.Type, l.Debit, l.DueDate = "invoice", d.Total, &due
+		} else {
+			l.Type, l.Credit = "credit_note", d.Total
+		}
+		moves = append(moves, l)
+	}
+	for _, p := range payments {
+		ref := p.Reference
+		if ref == "" {
+			ref = fmt.Sprintf("PAY-%05d", p.ID)
+		}
+		moves = append(moves, StatementLine{Date: p.Date, Type: "payment", Reference: ref, Credit: p.Amount})
+	}
+	sort.SliceStable(moves, func(i, j int) bool { return moves[i].Date.Before(moves[j].Date) })
+
+	st := &CustomerStatement{
+		TenantName:   cust.Edges.Tenant.Name,
+		CustomerID:   cust.ID,
+		CustomerCode: cust.Code,
+		CustomerName: cust.Name,
+		Address:      cust.Address,
+		Currency:     currency,
+		From:         from,
+		To:           to,
+		Lines:        []StatementLine{},
+	}
+	balance := decimal.Zero
+	for _, m := range moves {
+		balance = balance.Add(m.Debit).Sub(m.Credit)
+		if m.Date.Before(from) {
+			st.OpeningBalance = balance
+			continue
+		}
+		m.Balance = balance
+		st.Lines = append(st.Lines, m)
+	}
+	st.ClosingBalance = balance
+
+	aging, err := s.Aging(ctx, tenantID, cust.ID, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(aging.Rows) == 1 {
+		st.Aging = aging.Rows[0]
+	}
+	return st, nil
+}
+
+// SaveStatementPDF renders a customer statement and stores it in SENTvault. It returns the path.
+func (s *ReceivablesService) SaveStatementPDF(ctx context.Context, tenantID, customerID int, from, to time.Time) (string, error) {
+	if s.docs == nil {
+		return "", fmt.Errorf("document storage is not configured")
+	}
+	st, err := s.Statement(ctx, tenantID, customerID, from, to)
+	if err != nil {
+		return "", err
+	}
+	pdf, err := renderStatementPDF(st)
+	if err != nil {
+		return "", err
+	}
+	path := fmt.Sprintf("finance/statements/%s/%s_%s.pdf", st.CustomerCode, st.CustomerCode, st.To.Format("2006-01-02"))
+	if err := s.docs.SaveFileAsTenant(path, base64.StdEncoding.EncodeToString(pdf), tenantID); err != nil {
+		return "", fmt.Errorf("vault storage failed: %w", err)
+	}
+	return path, nil
+}
+
+// storeInvoicePDF renders an issued document and records where it was stored. Storage
+// failures are reported but do not undo the posting; the PDF can be regenerated.
+func (s *ReceivablesService) storeInvoicePDF(ctx context.Context, tenantID, invoiceID int) (*ent.Invoice, error) {
+	inv, err := s.db.Invoice.Query().
+		Where(invoice.ID(invoiceID)).
+		WithTenant().
+		WithCustomer().
+		WithLines(func(q *ent.InvoiceLineQuery) { q.Order(ent.Asc(invoiceline.FieldPosition)) }).
+		WithTaxLines().
+		WithOriginalInvoice().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.docs == nil {
+		return inv, nil
+	}
+
+	var qr string
+	if e, err := s.db.EInvoice.Query().Where(einvoice.HasInvoiceWith(invoice.ID(inv.ID))).Only(ctx); err == nil {
+		qr = e.Qr
+	}
+	pdf, err := renderInvoicePDF(inv, qr)
+	if err != nil {
+		fmt.Printf("[SENTcapital] Warning: failed to render %s: %v\n", inv.Number, err)
+		return inv, nil
+	}
+	path := fmt.Sprintf("finance/invoices/%s.pdf", inv.Number)
+	if err := s.docs.SaveFileAsTenant(path, base64.StdEncoding.EncodeToString(pdf), tenantID); err != nil {
+		fmt.Printf("[SENTcapital] Warning: failed to store %s: %v\n", inv.Number, err)
+		return inv, nil
+	}
+	if err := s.db.Invoice.UpdateOne(inv).SetPdfPath(path).Exec(ctx); err != nil {
+		return nil, err
+	}
+	inv.PdfPath = path
+	return inv, nil
+}
+
+// CustomerDTO represents a customer.
+type CustomerDTO struct {
+	ID               int     `json:"id"`
+	Code             string  `json:"code"`
+	Name             string  `json:"name"`
+	Email            string  `json:"email"`
+	Phone            string  `json:"phone"`
+	Address          string  `json:"address"`
+	TaxID            string  `json:"tax_id"`
+	CountryCode      string  `json:"country_code"`
+	TaxExempt        bool    `json:"tax_exempt"`
+	ExemptionRef     string  `json:"exemption_reference"`
+	Currency         string  `json:"currency"`
+	PaymentTermsDays int     `json:"payment_terms_days"`
+	IsActive         bool    `json:"is_active"`
+	Balance          float64 `json:"balance"` // Open AR in functional currency
+}
+
+// InvoiceDTO represents an invoice or credit note.
+type InvoiceDTO struct {
+	ID            int       `json:"id"`
+	Number        string    `json:"number"`
+	Kind          string    `json:"kind"`
+	Status        string    `json:"status"`
+	CustomerID    int       `json:"customer_id"`
+	CustomerName  string    `json:"customer_name"`
+	IssueDate     time.Time `json:"issue_date"`
+	DueDate       time.Time `json:"due_date"`
+	Currency      string    `json:"currency"`
+	Subtotal      float64   `json:"subtotal"`
+	TaxTotal      float64   `json:"tax_total"`
+	Total         float64   `json:"total"`
+	AmountSettled float64   `json:"amount_settled"`
+	Outstanding   float64   `json:"outstanding"`
+	PdfPath       string    `json:"pdf_path"`
+}
+
+func toInvoiceDTO(inv *ent.Invoice) InvoiceDTO {
+	dto := InvoiceDTO{
+		ID:            inv.ID,
+		Number:        inv.Number,
+		Kind:          string(inv.Kind),
+		Status:        string(inv.Status),
+		IssueDate:     inv.IssueDate,
+		DueDate:       inv.DueDate,
+		Currency:      inv.Currency,
+		Subtotal:      inv.Subtotal.InexactFloat64(),
+		TaxTotal:      inv.TaxTotal.InexactFloat64(),
+		Total:         inv.Total.InexactFloat64(),
+		AmountSettled: inv.AmountSettled.InexactFloat64(),
+		Outstanding:   outstanding(inv).InexactFloat64(),
+		PdfPath:       inv.PdfPath,
+	}
+	if c := inv.Edges.Customer; c != nil {
+		dto.CustomerID, dto.CustomerName = c.ID, c.Name
+	}
+	return dto
+}
+
+// receivables returns the AR service bound to the bridge's document store.
+func (c *CapitalBridge) receivables() *ReceivablesService {
+	return NewReceivablesService(c.db, c.docs)
+}
+
+// GetCustomers lists the tenant's customers with their open AR balance.
+func (c *CapitalBridge) GetCustomers() ([]CustomerDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	customers, err := c.db.Customer.Query().
+		Where(customer.HasTenantWith(tenant.ID(profile.TenantID))).
+		Order(ent.Asc(customer.FieldCode)).
+		All(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query customers: %w", err)
+	}
+	aging, err := c.receivables().Aging(c.ctx, profile.TenantID, 0, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[int]decimal.Decimal, len(aging.Rows))
+	for _, r := range aging.Rows {
+		balances[r.CustomerID] = r.Total
+	}
+
+	dtos := make([]CustomerDTO, len(customers))
+	for i, cu := range customers {
+		dtos[i] = CustomerDTO{
+			ID:               cu.ID,
+			Code:             cu.Code,
+			Name:             cu.Name,
+			Email:            cu.Email,
+			Phone:            cu.Phone,
+			Address:          cu.Address,
+			TaxID:            cu.TaxID,
+			CountryCode:      cu.CountryCode,
+			TaxExempt:        cu.TaxExempt,
+			ExemptionRef:     cu.ExemptionReference,
+			Currency:         cu.Currency,
+			PaymentTermsDays: cu.PaymentTermsDays,
+			IsActive:         cu.IsActive,
+			Balance:          balances[cu.ID].InexactFloat64(),
+		}
+	}
+	return dtos, nil
+}
+
+// SaveCustomer creates a customer, or updates it when ID is set.
+func (c *CapitalBridge) SaveCustomer(dto CustomerDTO) (int, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(dto.Code) == "" || strings.TrimSpace(dto.Name) == "" {
+		return 0, fmt.Errorf("customer code and name are required")
+	}
+	if dto.PaymentTermsDays < 0 {
+		return 0, fmt.Errorf("payment terms cannot be negative")
+	}
+	if dto.TaxExempt && strings.TrimSpace(dto.CountryCode) == "" {
+		return 0, fmt.Errorf("a tax-exempt customer needs a country, whose exempt code its invoices carry")
+	}
+
+	if dto.ID == 0 {
+		cu, err := c.db.Customer.Create().
+			SetTenantID(profile.TenantID).
+			SetCode(strings.TrimSpace(dto.Code)).
+			SetName(dto.Name).
+			SetEmail(dto.Email).
+			SetPhone(dto.Phone).
+			SetAddress(dto.Address).
+			SetTaxID(dto.TaxID).
+			SetCountryCode(strings.ToUpper(dto.CountryCode)).
+			SetTaxExempt(dto.TaxExempt).
+			SetExemptionReference(strings.TrimSpace(dto.ExemptionRef)).
+			SetCurrency(normalizeCurrency(dto.Currency)).
+			SetPaymentTermsDays(dto.PaymentTermsDays).
+			SetIsActive(true).
+			Save(c.ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create customer: %w", err)
+		}
+		return cu.ID, nil
+	}
+
+	n, err := c.db.Customer.Update().
+		Where(customer.ID(dto.ID), customer.HasTenantWith(tenant.ID(profile.TenantID))).
+		SetCode(strings.TrimSpace(dto.Code)).
+		SetName(dto.Name).
+		SetEmail(dto.Email).
+		SetPhone(dto.Phone).
+		SetAddress(dto.Address).
+		SetTaxID(dto.TaxID).
+		SetCountryCode(strings.ToUpper(dto.CountryCode)).
+		SetTaxExempt(dto.TaxExempt).
+		SetExemptionReference(strings.TrimSpace(dto.ExemptionRef)).
+		SetCurrency(normalizeCurrency(dto.Currency)).
+		SetPaymentTermsDays(dto.PaymentTermsDays).
+		SetIsActive(dto.IsActive).
+		Save(c.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update customer: %w", err)
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("customer %d not found", dto.ID)
+	}
+	return dto.ID, nil
+}
+
+// GetInvoices lists invoices and credit notes, optionally for one customer and status.
+func (c *CapitalBridge) GetInvoices(customerID int, status string) ([]InvoiceDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	q := c.db.Invoice.Query().Where(invoice.HasTenantWith(tenant.ID(profile.TenantID)))
+	if customerID != 0 {
+		q = q.Where(invoice.HasCustomerWith(customer.ID(customerID)))
+	}
+	if status != "" {
+		q = q.Where(invoice.StatusEQ(invoice.Status(status)))
+	}
+	invs, err := q.WithCustomer().Order(ent.Desc(invoice.FieldIssueDate), ent.Desc(invoice.FieldID)).All(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invoices: %w", err)
+	}
+
+	dtos := make([]InvoiceDTO, len(invs))
+	for i, inv := range invs {
+		dtos[i] = toInvoiceDTO(inv)
+	}
+	return dtos, nil
+}
+
+// CreateInvoice issues and posts a customer invoice.
+func (c *CapitalBridge) CreateInvoice(req InvoiceRequest) (*InvoiceDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	inv, err := c.receivables().IssueInvoice(c.ctx, profile.TenantID, req)
+	if err != nil {
+		return nil, err
+	}
+	dto := toInvoiceDTO(inv)
+	return &dto, nil
+}
+
+// IssueCreditNote credits an invoice in full (no lines) or in part.
+func (c *CapitalBridge) IssueCreditNote(invoiceID int, lines []InvoiceLineRequest, reason string) (*InvoiceDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	cn, err := c.receivables().CreditNote(c.ctx, profile.TenantID, invoiceID, lines, reason)
+	if err != nil {
+		return nil, err
+	}
+	dto := toInvoiceDTO(cn)
+	return &dto, nil
+}
+
+// VoidInvoice cancels an unpaid invoice.
+func (c *CapitalBridge) VoidInvoice(invoiceID int, reason string) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	return c.receivables().VoidInvoice(c.ctx, profile.TenantID, invoiceID, reason)
+}
+
+// RecordCustomerPayment posts a customer payment and allocates it.
+func (c *CapitalBridge) RecordCustomerPayment(req PaymentRequest) (string, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	pay, err := c.receivables().RecordPayment(c.ctx, profile.TenantID, req)
+	if err != nil {
+		return "", err
+	}
+	if pay.Unallocated.IsPositive() {
+		return fmt.Sprintf("Payment recorded. %s %s left unallocated.", pay.Unallocated.StringFixed(2), pay.Currency), nil
+	}
+	return "Payment recorded and fully allocated.", nil
+}
+
+// AllocateCustomerPayment applies the unallocated part of a payment to invoices.
+func (c *CapitalBridge) AllocateCustomerPayment(paymentID int, allocations []AllocationRequest) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	return c.receivables().AllocatePayment(c.ctx, profile.TenantID, paymentID, allocations)
+}
+
+// GetARAging returns the receivables aging as of a date.
+func (c *CapitalBridge) GetARAging(asOf time.Time) (*AgingReport, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	return c.receivables().Aging(c.ctx, profile.TenantID, 0, asOf)
+}
+
+// ExportCustomerStatement saves a customer statement PDF to SENTvault and returns its path.
+func (c *CapitalBridge) ExportCustomerStatement(customerID int, from, to time.Time) (string, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	return c.receivables().SaveStatementPDF(c.ctx, profile.TenantID, customerID, from, to)
+}
@@ -0,0 +1,112 @@
+package capital
+
+import (
+	"bytes"
+	"testing"
+
+	"sent/ent"
+	"sent/ent/invoice"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestComputeDocumentGroupsTaxByCode(t *testing.T) {
+	lines := []computedLine{
+		{Quantity: decimal.NewFromInt(3), UnitPrice: decimal.RequireFromString("9.99"), TaxCode: "VAT-SA", TaxRate: decimal.RequireFromString("0.15")},
+		{Quantity: decimal.NewFromInt(1), UnitPrice: decimal.RequireFromString("0.05"), TaxCode: "VAT-SA", TaxRate: decimal.RequireFromString("0.15")},
+		{Quantity: decimal.NewFromInt(2), UnitPrice: decimal.NewFromInt(50), TaxCode: "VAT-AE", TaxRate: decimal.RequireFromString("0.05")},
+		{Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(10)}, // exempt
+	}
+
+	subtotal, taxTotal, taxLines := computeDocument(lines, "SAR")
+
+	if !subtotal.Equal(decimal.RequireFromString("140.02")) {
+		t.Errorf("subtotal = %s, want 140.02", subtotal)
+	}
+	if len(taxLines) != 2 {
+		t.Fatalf("expected 2 tax lines, got %d", len(taxLines))
+	}
+	// 30.02 * 15% = 4.503 rounded once per group, not per line
+	if taxLines[1].Code != "VAT-SA" || !taxLines[1].Taxable.Equal(decimal.RequireFromString("30.02")) || !taxLines[1].Tax.Equal(decimal.RequireFromString("4.5")) {
+		t.Errorf("unexpected SA tax line %+v", taxLines[1])
+	}
+	if !taxTotal.Equal(decimal.RequireFromString("9.5")) {
+		t.Errorf("tax total = %s, want 9.50", taxTotal)
+	}
+	if !lines[0].Net.Equal(decimal.RequireFromString("29.97")) {
+		t.Errorf("line net not written back: %s", lines[0].Net)
+	}
+}
+
+func TestComputeDocumentRoundsToCurrency(t *testing.T) {
+	lines := []computedLine{
+		{Quantity: decimal.RequireFromString("1.5"), UnitPrice: decimal.RequireFromString("3.3333"), TaxCode: "VAT-JO", TaxRate: decimal.RequireFromString("0.16")},
+	}
+	subtotal, taxTotal, _ := computeDocument(lines, "JOD")
+	if !subtotal.Equal(decimal.RequireFromString("5")) || !taxTotal.Equal(decimal.RequireFromString("0.8")) {
+		t.Errorf("JOD uses 3 decimals: subtotal=%s tax=%s", subtotal, taxTotal)
+	}
+}
+
+func TestSettlementStatus(t *testing.T) {
+	total := decimal.NewFromInt(100)
+	cases := []struct {
+		settled string
+		want    invoice.Status
+	}{
+		{"0", invoice.StatusIssued},
+		{"40", invoice.StatusPartiallyPaid},
+		{"100", invoice.StatusPaid},
+	}
+	for _, c := range cases {
+		if got := settlementStatus(total, decimal.RequireFromString(c.settled)); got != c.want {
+			t.Errorf("settled %s: got %s, want %s", c.settled, got, c.want)
+		}
+	}
+}
+
+func TestAgingBucket(t *testing.T) {
+	asOf := date("2024-06-30")
+	cases := []struct {
+		due  string
+		want int
+	}{
+		{"2024-07-15", BucketCurrent},
+		{"2024-06-30", BucketCurrent},
+		{"2024-06-29", Bucket30},
+		{"2024-05-31", Bucket30},
+		{"2024-05-30", Bucket60},
+		{"2024-04-01", Bucket90},
+		{"2024-03-31", BucketOver90},
+	}
+	for _, c := range cases {
+		if got := agingBucket(date(c.due), asOf); got != c.want {
+			t.Errorf("due %s: bucket %d, want %d", c.due, got, c.want)
+		}
+	}
+}
+
+func TestRenderInvoicePDF(t *testing.T) {
+	inv := &ent.Invoice{
+		Number:    "INV-00001",
+		Kind:      invoice.KindInvoice,
+		IssueDate: date("2024-06-01"),
+		DueDate:   date("2024-07-01"),
+		Currency:  "USD",
+		Subtotal:  decimal.NewFromInt(100),
+		TaxTotal:  decimal.NewFromInt(8),
+		Total:     decimal.NewFromInt(108),
+	}
+	inv.Edges.Tenant = &ent.Tenant{Name: "Acme"}
+	inv.Edges.Customer = &ent.Customer{Code: "C001", Name: "Globex"}
+	inv.Edges.Lines = []*ent.InvoiceLine{{Description: "Consulting", Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(100), NetAmount: decimal.NewFromInt(100), TaxRate: decimal.RequireFromString("0.08")}}
+	inv.Edges.TaxLines = []*ent.InvoiceTaxLine{{TaxCode: "VAT-US", Rate: decimal.RequireFromString("0.08"), TaxableAmount: decimal.NewFromInt(100), TaxAmount: decimal.NewFromInt(8)}}
+
+	pdf, err := renderInvoicePDF(inv, "")
+	if err != nil {
+		t.Fatalf("renderInvoicePDF: %v", err)
+	}
+	if !bytes.HasPrefix(pdf, []byte("%PDF")) {
+		t.Error("output is not a PDF")
+	}
+}
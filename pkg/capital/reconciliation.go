@@ -0,0 +1,1022 @@
+package capital
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/bankrule"
+	"sent/ent/bankstatement"
+	"sent/ent/bankstatementline"
+	"sent/ent/ledgerentry"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+
+	"github.com/shopspring/decimal"
+)
+
+// MatchWindowDays is how far a ledger entry's date may be from the bank booking date.
+const MatchWindowDays = 3
+
+// minMatchScore is the score from which a candidate is matched without review.
+const minMatchScore = 70
+
+// matchCandidate is a cash-account ledger entry that has not been reconciled yet.
+type matchCandidate struct {
+	EntryID   int
+	Date      time.Time
+	Amount    decimal.Decimal // Signed from the bank's point of view: debit to cash is money in
+	Reference string
+	Memo      string
+}
+
+// scoreMatch rates how well a ledger entry explains a statement line; 0 means not at all.
+// The amount and sign must agree exactly. Closer dates and a shared reference score higher.
+func scoreMatch(line ParsedStatementLine, c matchCandidate) int {
+	if !line.Amount.Equal(c.Amount) {
+		return 0
+	}
+	days := int(line.BookingDate.Sub(c.Date).Hours() / 24)
+	if days < 0 {
+		days = -days
+	}
+	if days > MatchWindowDays {
+		return 0
+	}
+
+	score := 60 - days*5
+	if refMatches(line, c) {
+		score += 40
+	}
+	return score
+}
+
+func refMatches(line ParsedStatementLine, c matchCandidate) bool {
+	ref := strings.ToLower(strings.TrimSpace(c.Reference))
+	if len(ref) < 3 {
+		return false
+	}
+	for _, s := range []string{line.Reference, line.Description} {
+		if strings.Contains(strings.ToLower(s), ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// pickMatch returns the best candidate and its score. Ties go to the closest date, then the
+// lowest entry id, so the outcome does not depend on query order.
+func pickMatch(line ParsedStatementLine, candidates []matchCandidate) (matchCandidate, int) {
+	var best matchCandidate
+	bestScore := 0
+	for _, c := range candidates {
+		s := scoreMatch(line, c)
+		if s == 0 {
+			continue
+		}
+		if s > bestScore || (s == bestScore && c.EntryID < best.EntryID) {
+			best, bestScore = c, s
+		}
+	}
+	return best, bestScore
+}
+
+// ruleMatches reports whether a bank rule applies to the statement line.
+func ruleMatches(r *ent.BankRule, line ParsedStatementLine) bool {
+	if !r.IsActive || strings.TrimSpace(r.Pattern) == "" {
+		return false
+	}
+	switch r.Direction {
+	case bankrule.DirectionInflow:
+		if !line.Amount.IsPositive() {
+			return false
+		}
+	case bankrule.DirectionOutflow:
+		if !line.Amount.IsNegative() {
+			return false
+		}
+	}
+
+	var subject string
+	switch r.MatchField {
+	case bankrule.MatchFieldReference:
+		subject = line.Reference
+	case bankrule.MatchFieldCounterparty:
+		subject = line.Counterparty
+	default:
+		subject = line.Description
+	}
+	return strings.Contains(strings.ToLower(subject), strings.ToLower(strings.TrimSpace(r.Pattern)))
+}
+
+// firstRule returns the first matching rule; rules must already be sorted by priority.
+func firstRule(rules []*ent.BankRule, line ParsedStatementLine) *ent.BankRule {
+	for _, r := range rules {
+		if ruleMatches(r, line) {
+			return r
+		}
+	}
+	return nil
+}
+
+// ParseStatement dispatches to the parser for the given format ("csv", "ofx", "qfx", "camt053").
+func ParseStatement(format string, data []byte, mapping *CSVMapping) (*ParsedStatement, error) {
+	switch strings.ToLower(format) {
+	case "csv":
+		m := DefaultCSVMapping()
+		if mapping != nil {
+			m = *mapping
+		}
+		return ParseStatementCSV(bytes.NewReader(data), m)
+	case "ofx", "qfx":
+		return ParseOFX(bytes.NewReader(data))
+	case "camt053", "camt.053", "xml":
+		return ParseCamt053(bytes.NewReader(data))
+	}
+	return nil, fmt.Errorf("unsupported statement format %q", format)
+}
+
+// ImportResult summarizes a staged statement.
+type ImportResult struct {
+	StatementID int `json:"statement_id"`
+	Imported    int `json:"imported"`
+	Duplicates  int `json:"duplicates"`
+	Matched     int `json:"matched"`
+	Suggested   int `json:"suggested"`
+}
+
+// MatchResult summarizes an auto-match run.
+type MatchResult struct {
+	Matched   int `json:"matched"`
+	Suggested int `json:"suggested"`
+	Unmatched int `json:"unmatched"`
+}
+
+// BankReconciliationService stages bank statements and reconciles them against the ledger.
+type BankReconciliationService struct {
+	db     *ent.Client
+	ledger *LedgerService
+}
+
+// NewBankReconciliationService initializes a new BankReconciliationService.
+func NewBankReconciliationService(db *ent.Client) *BankReconciliationService {
+	return &BankReconciliationService{db: db, ledger: NewLedgerService()}
+}
+
+// ImportStatement stages a parsed statement for a cash account and runs auto-matching on it.
+// Lines already imported for the account (same external id) are skipped.
+func (s *BankReconciliationService) ImportStatement(ctx context.Context, tenantID, accountID int, stmt *ParsedStatement, fileName, importedBy string) (*ImportResult, error) {
+	acc, err := s.db.Account.Query().
+		Where(account.ID(accountID), account.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("account %d not found: %w", accountID, err)
+	}
+	if acc.Type != account.TypeAsset {
+		return nil, fmt.Errorf("account %s is not a cash account", acc.Number)
+	}
+
+	currency := normalizeCurrency(stmt.Currency)
+	if currency == "" {
+		currency = normalizeCurrency(acc.Currency)
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	create := tx.BankStatement.Create().
+		SetTenantID(tenantID).
+		SetAccount(acc).
+		SetFormat(bankstatement.Format(stmt.Format)).
+		SetStatementRef(stmt.StatementRef).
+		SetFileName(fileName).
+		SetCurrency(currency).
+		SetNillablePeriodStart(stmt.PeriodStart).
+		SetNillablePeriodEnd(stmt.PeriodEnd).
+		SetNillableOpeningBalance(stmt.OpeningBalance).
+		SetNillableClosingBalance(stmt.ClosingBalance).
+		SetImportedBy(importedBy)
+	bs, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save statement: %w", err)
+	}
+
+	res := &ImportResult{StatementID: bs.ID}
+	for _, l := range stmt.Lines {
+		exists, err := tx.BankStatementLine.Query().
+			Where(
+				bankstatementline.ExternalID(l.ExternalID),
+				bankstatementline.HasAccountWith(account.ID(acc.ID)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			res.Duplicates++
+			continue
+		}
+
+		lineCurrency := normalizeCurrency(l.Currency)
+		if lineCurrency == "" {
+			lineCurrency = currency
+		}
+		_, err = tx.BankStatementLine.Create().
+			SetTenantID(tenantID).
+			SetStatement(bs).
+			SetAccount(acc).
+			SetExternalID(l.ExternalID).
+			SetBookingDate(l.BookingDate).
+			SetNillableValueDate(l.ValueDate).
+			SetAmount(l.Amount).
+			SetCurrency(lineCurrency).
+			SetDescription(l.Description).
+			SetReference(l.Reference).
+			SetCounterparty(l.Counterparty).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save statement line %s: %w", l.ExternalID, err)
+		}
+		res.Imported++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	m, err := s.AutoMatch(ctx, tenantID, bs.ID)
+	if err != nil {
+		return res, err
+	}
+	res.Matched, res.Suggested = m.Matched, m.Suggested
+	return res, nil
+}
+
+// AutoMatch links unmatched lines of a statement to unreconciled ledger entries on the same
+// account. Lines without a ledger match get a contra-account suggestion from the bank rules.
+func (s *BankReconciliationService) AutoMatch(ctx context.Context, tenantID, statementID int) (*MatchResult, error) {
+	bs, err := s.db.BankStatement.Query().
+		Where(bankstatement.ID(statementID), bankstatement.HasTenantWith(tenant.ID(tenantID))).
+		WithAccount().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("statement %d not found: %w", statementID, err)
+	}
+
+	lines, err := bs.QueryLines().
+		Where(bankstatementline.StatusIn(bankstatementline.StatusUnmatched, bankstatementline.StatusSuggested)).
+		Order(ent.Asc(bankstatementline.FieldBookingDate), ent.Asc(bankstatementline.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.db.BankRule.Query().
+		Where(bankrule.HasTenantWith(tenant.ID(tenantID)), bankrule.IsActive(true)).
+		WithAccount().
+		Order(ent.Asc(bankrule.FieldPriority), ent.Asc(bankrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &MatchResult{}
+	if len(lines) == 0 {
+		return res, nil
+	}
+
+	from := lines[0].BookingDate.AddDate(0, 0, -MatchWindowDays)
+	to := lines[len(lines)-1].BookingDate.AddDate(0, 0, MatchWindowDays)
+	candidates, err := s.unreconciledEntries(ctx, tenantID, bs.Edges.Account.ID, startOfDay(from), endOfDay(to))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for _, l := range lines {
+		pl := lineToParsed(l)
+
+		if best, score := pickMatch(pl, candidates); score >= minMatchScore {
+			err := tx.BankStatementLine.UpdateOne(l).
+				SetStatus(bankstatementline.StatusMatched).
+				SetMatchScore(score).
+				SetLedgerEntryID(best.EntryID).
+				ClearSuggestedAccount().
+				ClearSuggestedRule().
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+			candidates = removeCandidate(candidates, best.EntryID)
+			res.Matched++
+			continue
+		}
+
+		if r := firstRule(rules, pl); r != nil && r.Edges.Account != nil {
+			err := tx.BankStatementLine.UpdateOne(l).
+				SetStatus(bankstatementline.StatusSuggested).
+				SetSuggestedAccountID(r.Edges.Account.ID).
+				SetSuggestedRuleID(r.ID).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res.Suggested++
+			continue
+		}
+		res.Unmatched++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func removeCandidate(candidates []matchCandidate, entryID int) []matchCandidate {
+	for i, c := range candidates {
+		if c.EntryID == entryID {
+			return append(candidates[:i], candidates[i+1:]...)
+		}
+	}
+	return candidates
+}
+
+func lineToParsed(l *ent.BankStatementLine) ParsedStatementLine {
+	return ParsedStatementLine{
+		ExternalID:   l.ExternalID,
+		BookingDate:  l.BookingDate,
+		ValueDate:    l.ValueDate,
+		Amount:       l.Amount,
+		Currency:     l.Currency,
+		Description:  l.Description,
+		Reference:    l.Reference,
+		Counterparty: l.Counterparty,
+	}
+}
+
+// unreconciledEntries lists approved ledger entries on the account in the date range that no
+// statement line has claimed yet. Amounts are in the account's own currency.
+func (s *BankReconciliationService) unreconciledEntries(ctx context.Context, tenantID, accountID int, from, to time.Time) ([]matchCandidate, error) {
+	entries, err := s.db.LedgerEntry.Query().
+		Where(
+			ledgerentry.HasTenantWith(tenant.ID(tenantID)),
+			ledgerentry.HasAccountWith(account.ID(accountID)),
+			ledgerentry.Not(ledgerentry.HasBankStatementLine()),
+			ledgerentry.HasTransactionWith(
+				transaction.DateGTE(from),
+				transaction.DateLTE(to),
+				transaction.ApprovalStatusEQ(transaction.ApprovalStatusAPPROVED),
+			),
+		).
+		WithTransaction().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]matchCandidate, 0, len(entries))
+	for _, e := range entries {
+		amt := e.CurrencyAmount
+		if e.Direction == ledgerentry.DirectionCredit {
+			amt = amt.Neg()
+		}
+		c := matchCandidate{EntryID: e.ID, Amount: amt}
+		if t := e.Edges.Transaction; t != nil {
+			c.Date, c.Reference, c.Memo = t.Date, t.Reference, t.Description
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+// MatchLine manually links a statement line to a ledger entry on the same account.
+func (s *BankReconciliationService) MatchLine(ctx context.Context, tenantID, lineID, entryID int) error {
+	line, err := s.tenantLine(ctx, tenantID, lineID)
+	if err != nil {
+		return err
+	}
+	if line.Status == bankstatementline.StatusMatched || line.Status == bankstatementline.StatusPosted {
+		return fmt.Errorf("statement line %d is already reconciled", lineID)
+	}
+
+	entry, err := s.db.LedgerEntry.Query().
+		Where(
+			ledgerentry.ID(entryID),
+			ledgerentry.HasTenantWith(tenant.ID(tenantID)),
+			ledgerentry.HasAccountWith(account.ID(line.Edges.Account.ID)),
+		).
+		WithBankStatementLine().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("ledger entry %d not found on the statement account: %w", entryID, err)
+	}
+	if entry.Edges.BankStatementLine != nil {
+		return fmt.Errorf("ledger entry %d is already reconciled", entryID)
+	}
+
+	return s.db.BankStatementLine.UpdateOne(line).
+		SetStatus(bankstatementline.StatusMatched).
+		SetMatchScore(100).
+		SetLedgerEntryID(entryID).
+		ClearSuggestedAccount().
+		ClearSuggestedRule().
+		Exec(ctx)
+}
+
+// AcceptSuggestion posts a statement line that has no ledger counterpart yet. The contra
+// account is the rule suggestion unless overrideAccountID is set. Money in debits the bank
+// account; money out credits it.
+func (s *BankReconciliationService) AcceptSuggestion(ctx context.Context, tenantID, lineID, overrideAccountID int) (*ent.Transaction, error) {
+	line, err := s.tenantLine(ctx, tenantID, lineID)
+	if err != nil {
+		return nil, err
+	}
+	if line.Status == bankstatementline.StatusMatched || line.Status == bankstatementline.StatusPosted {
+		return nil, fmt.Errorf("statement line %d is already reconciled", lineID)
+	}
+
+	contraID := overrideAccountID
+	if contraID == 0 {
+		if line.Edges.SuggestedAccount == nil {
+			return nil, fmt.Errorf("statement line %d has no suggested account", lineID)
+		}
+		contraID = line.Edges.SuggestedAccount.ID
+	}
+	contraOK, err := s.db.Account.Query().
+		Where(account.ID(contraID), account.HasTenantWith(tenant.ID(tenantID))).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !contraOK {
+		return nil, fmt.Errorf("account %d not found", contraID)
+	}
+
+	bankAcc := line.Edges.Account
+	ex := NewExchangeService(s.db)
+	functional, err := ex.FunctionalCurrency(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	abs := line.Amount.Abs()
+	amount, rate := abs, decimal.NewFromInt(1)
+	currency := normalizeCurrency(line.Currency)
+	if currency != "" && currency != functional {
+		amount, err = ex.Convert(ctx, tenantID, abs, currency, functional, line.BookingDate)
+		if err != nil {
+			return nil, err
+		}
+		if abs.IsPositive() {
+			rate = amount.Div(abs)
+		}
+	} else {
+		currency = ""
+	}
+
+	bankDir, contraDir := "debit", "credit"
+	if line.Amount.IsNegative() {
+		bankDir, contraDir = "credit", "debit"
+	}
+
+	desc := line.Description
+	if desc == "" {
+		desc = line.Counterparty
+	}
+	if desc == "" {
+		desc = "Bank statement line " + line.ExternalID
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	txn, err := s.ledger.Post(ctx, tx, Posting{
+		TenantID:     tenantID,
+		Date:         line.BookingDate,
+		Description:  desc,
+		Type:         "bank_feed",
+		Reference:    line.Reference,
+		Currency:     currency,
+		ExchangeRate: rate,
+		Lines: []PostingLine{
+			{AccountID: bankAcc.ID, Direction: bankDir, Amount: amount, Currency: currency, CurrencyAmount: abs, Rate: rate},
+			{AccountID: contraID, Direction: contraDir, Amount: amount, Currency: currency, CurrencyAmount: abs, Rate: rate},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bankEntry, err := tx.LedgerEntry.Query().
+		Where(
+			ledgerentry.HasTransactionWith(transaction.ID(txn.ID)),
+			ledgerentry.HasAccountWith(account.ID(bankAcc.ID)),
+		).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.BankStatementLine.UpdateOne(line).
+		SetStatus(bankstatementline.StatusPosted).
+		SetMatchScore(100).
+		SetLedgerEntry(bankEntry).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// IgnoreLine excludes a line from reconciliation, e.g. a bank-internal transfer already netted.
+func (s *BankReconciliationService) IgnoreLine(ctx context.Context, tenantID, lineID int) error {
+	line, err := s.tenantLine(ctx, tenantID, lineID)
+	if err != nil {
+		return err
+	}
+	if line.Status == bankstatementline.StatusPosted {
+		return fmt.Errorf("statement line %d has been posted", lineID)
+	}
+	return s.db.BankStatementLine.UpdateOne(line).
+		SetStatus(bankstatementline.StatusIgnored).
+		ClearLedgerEntry().
+		ClearSuggestedAccount().
+		ClearSuggestedRule().
+		Exec(ctx)
+}
+
+// UnmatchLine releases a matched line so it can be matched again.
+func (s *BankReconciliationService) UnmatchLine(ctx context.Context, tenantID, lineID int) error {
+	line, err := s.tenantLine(ctx, tenantID, lineID)
+	if err != nil {
+		return err
+	}
+	if line.Status == bankstatementline.StatusPosted {
+		return fmt.Errorf("statement line %d has been posted; reverse the transaction instead", lineID)
+	}
+	return s.db.BankStatementLine.UpdateOne(line).
+		SetStatus(bankstatementline.StatusUnmatched).
+		SetMatchScore(0).
+		ClearLedgerEntry().
+		Exec(ctx)
+}
+
+func (s *BankReconciliationService) tenantLine(ctx context.Context, tenantID, lineID int) (*ent.BankStatementLine, error) {
+	line, err := s.db.BankStatementLine.Query().
+		Where(bankstatementline.ID(lineID), bankstatementline.HasTenantWith(tenant.ID(tenantID))).
+		WithAccount().
+		WithSuggestedAccount().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("statement line %d not found: %w", lineID, err)
+	}
+	return line, nil
+}
+
+// ReconciliationItem is a single outstanding item on the reconciliation report.
+type ReconciliationItem struct {
+	ID          int             `json:"id"`
+	Date        time.Time       `json:"date"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+	Reference   string          `json:"reference"`
+	Status      string          `json:"status,omitempty"`
+}
+
+// ReconciliationReport compares the bank statement with the books for one account and period.
+// Amounts are signed from the bank's point of view and in the account's currency.
+type ReconciliationReport struct {
+	AccountID         int                  `json:"account_id"`
+	AccountNumber     string               `json:"account_number"`
+	AccountName       string               `json:"account_name"`
+	From              time.Time            `json:"from"`
+	To                time.Time            `json:"to"`
+	StatementLines    int                  `json:"statement_lines"`
+	Reconciled        int                  `json:"reconciled"`
+	StatementTotal    decimal.Decimal      `json:"statement_total"`
+	StatementClosing  *decimal.Decimal     `json:"statement_closing,omitempty"`
+	BookBalance       decimal.Decimal      `json:"book_balance"`
+	UnmatchedLines    []ReconciliationItem `json:"unmatched_lines"`
+	UnreconciledBooks []ReconciliationItem `json:"unreconciled_books"`
+	Difference        *decimal.Decimal     `json:"difference,omitempty"` // Statement closing minus book balance, when the bank gave one
+}
+
+// Report builds the reconciliation report for an account between from and to inclusive.
+func (s *BankReconciliationService) Report(ctx context.Context, tenantID, accountID int, from, to time.Time) (*ReconciliationReport, error) {
+	acc, err := s.db.Account.Query().
+		Where(account.ID(accountID), account.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("account %d not found: %w", accountID, err)
+	}
+	from, to = startOfDay(from), endOfDay(to)
+
+	rep := &ReconciliationReport{
+		AccountID:         acc.ID,
+		AccountNumber:     acc.Number,
+		AccountName:       acc.Name,
+		From:              from,
+		To:                to,
+		UnmatchedLines:    []ReconciliationItem{},
+		UnreconciledBooks: []ReconciliationItem{},
+	}
+
+	lines, err := s.db.BankStatementLine.Query().
+		Where(
+			bankstatementline.HasAccountWith(account.ID(acc.ID)),
+			bankstatementline.BookingDateGTE(from),
+			bankstatementline.BookingDateLTE(to),
+		).
+		Order(ent.Asc(bankstatementline.FieldBookingDate), ent.Asc(bankstatementline.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		rep.StatementLines++
+		rep.StatementTotal = rep.StatementTotal.Add(l.Amount)
+		switch l.Status {
+		case bankstatementline.StatusMatched, bankstatementline.StatusPosted, bankstatementline.StatusIgnored:
+			rep.Reconciled++
+		default:
+			rep.UnmatchedLines = append(rep.UnmatchedLines, ReconciliationItem{
+				ID:          l.ID,
+				Date:        l.BookingDate,
+				Amount:      l.Amount,
+				Description: l.Description,
+				Reference:   l.Reference,
+				Status:      string(l.Status),
+			})
+		}
+	}
+
+	open, err := s.unreconciledEntries(ctx, tenantID, acc.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(open, func(i, j int) bool { return open[i].Date.Before(open[j].Date) })
+	for _, c := range open {
+		rep.UnreconciledBooks = append(rep.UnreconciledBooks, ReconciliationItem{
+			ID:          c.EntryID,
+			Date:        c.Date,
+			Amount:      c.Amount,
+			Description: c.Memo,
+			Reference:   c.Reference,
+		})
+	}
+
+	// Book balance at period end, in account currency, from the entries themselves.
+	entries, err := s.db.LedgerEntry.Query().
+		Where(
+			ledgerentry.HasAccountWith(account.ID(acc.ID)),
+			ledgerentry.HasTransactionWith(
+				transaction.DateLTE(to),
+				transaction.ApprovalStatusEQ(transaction.ApprovalStatusAPPROVED),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.Direction == ledgerentry.DirectionDebit {
+			rep.BookBalance = rep.BookBalance.Add(e.CurrencyAmount)
+		} else {
+			rep.BookBalance = rep.BookBalance.Sub(e.CurrencyAmount)
+		}
+	}
+
+	last, err := s.db.BankStatement.Query().
+		Where(
+			bankstatement.HasAccountWith(account.ID(acc.ID)),
+			bankstatement.ClosingBalanceNotNil(),
+			bankstatement.PeriodEndLTE(to),
+			bankstatement.PeriodEndGTE(from),
+		).
+		Order(ent.Desc(bankstatement.FieldPeriodEnd)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if last != nil && last.ClosingBalance != nil {
+		closing := *last.ClosingBalance
+		diff := closing.Sub(rep.BookBalance)
+		rep.StatementClosing, rep.Difference = &closing, &diff
+	}
+
+	return rep, nil
+}
+
+// BankStatementLineDTO represents a staged statement line.
+type BankStatementLineDTO struct {
+	ID                 int       `json:"id"`
+	StatementID        int       `json:"statement_id"`
+	BookingDate        time.Time `json:"booking_date"`
+	Amount             float64   `json:"amount"` // Positive for money in
+	Currency           string    `json:"currency"`
+	Description        string    `json:"description"`
+	Reference          string    `json:"reference"`
+	Counterparty       string    `json:"counterparty"`
+	Status             string    `json:"status"`
+	MatchScore         int       `json:"match_score"`
+	LedgerEntryID      int       `json:"ledger_entry_id,omitempty"`
+	SuggestedAccountID int       `json:"suggested_account_id,omitempty"`
+	SuggestedRule      string    `json:"suggested_rule,omitempty"`
+}
+
+// BankRuleDTO represents a bank rule.
+type BankRuleDTO struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	MatchField    string `json:"match_field"` // description, reference or counterparty
+	Pattern       string `json:"pattern"`
+	Direction     string `json:"direction"` // any, inflow or outflow
+	Priority      int    `json:"priority"`
+	IsActive      bool   `json:"is_active"`
+	AccountID     int    `json:"account_id"`
+	AccountNumber string `json:"account_number"`
+}
+
+// ImportBankStatement stages a base64 encoded statement for a cash account and auto-matches it.
+// Format is "csv", "ofx", "qfx" or "camt053"; mapping is only used for CSV and may be nil.
+func (c *CapitalBridge) ImportBankStatement(accountID int, format, fileName, base64Content string, mapping *CSVMapping) (*ImportResult, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(base64Content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 content: %w", err)
+	}
+	stmt, err := ParseStatement(format, data, mapping)
+	if err != nil {
+		return nil, err
+	}
+	return NewBankReconciliationService(c.db).ImportStatement(c.ctx, profile.TenantID, accountID, stmt, fileName, profile.Email)
+}
+
+// GetStatementLines lists staged lines for a cash account, optionally filtered by status.
+func (c *CapitalBridge) GetStatementLines(accountID int, status string) ([]BankStatementLineDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	q := c.db.BankStatementLine.Query().
+		Where(
+			bankstatementline.HasTenantWith(tenant.ID(profile.TenantID)),
+			bankstatementline.HasAccountWith(account.ID(accountID)),
+		)
+	if status != "" {
+		q = q.Where(bankstatementline.StatusEQ(bankstatementline.Status(status)))
+	}
+	lines, err := q.
+		WithStatement().
+		WithLedgerEntry().
+		WithSuggestedAccount().
+		WithSuggestedRule().
+		Order(ent.Desc(bankstatementline.FieldBookingDate), ent.Desc(bankstatementline.FieldID)).
+		All(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query statement lines: %w", err)
+	}
+
+	dtos := make([]BankStatementLineDTO, len(lines))
+	for i, l := range lines {
+		amount, _ := l.Amount.Float64()
+		dto := BankStatementLineDTO{
+			ID:           l.ID,
+			BookingDate:  l.BookingDate,
+			Amount:       amount,
+			Currency:     l.Currency,
+			Description:  l.Description,
+			Reference:    l.Reference,
+			Counterparty: l.Counterparty,
+			Status:       string(l.Status),
+			MatchScore:   l.MatchScore,
+		}
+		if l.Edges.Statement != nil {
+			dto.StatementID = l.Edges.Statement.ID
+		}
+		if l.Edges.LedgerEntry != nil {
+			dto.LedgerEntryID = l.Edges.LedgerEntry.ID
+		}
+		if l.Edges.SuggestedAccount != nil {
+			dto.SuggestedAccountID = l.Edges.SuggestedAccount.ID
+		}
+		if l.Edges.SuggestedRule != nil {
+			dto.SuggestedRule = l.Edges.SuggestedRule.Name
+		}
+		dtos[i] = dto
+	}
+	return dtos, nil
+}
+
+// RunAutoMatch re-runs matching on a statement, e.g. after new postings or rules.
+func (c *CapitalBridge) RunAutoMatch(statementID int) (*MatchResult, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	return NewBankReconciliationService(c.db).AutoMatch(c.ctx, profile.TenantID, statementID)
+}
+
+// AcceptBankSuggestion posts a statement line against its suggested (or the given) account.
+func (c *CapitalBridge) AcceptBankSuggestion(lineID, accountID int) (string, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	txn, err := NewBankReconciliationService(c.db).AcceptSuggestion(c.ctx, profile.TenantID, lineID, accountID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Posted transaction %d.", txn.ID), nil
+}
+
+// MatchStatementLine manually reconciles a statement line with a ledger entry.
+func (c *CapitalBridge) MatchStatementLine(lineID, ledgerEntryID int) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	return NewBankReconciliationService(c.db).MatchLine(c.ctx, profile.TenantID, lineID, ledgerEntryID)
+}
+
+// UnmatchStatementLine releases a matched statement line.
+func (c *CapitalBridge) UnmatchStatementLine(lineID int) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	return NewBankReconciliationService(c.db).UnmatchLine(c.ctx, profile.TenantID, lineID)
+}
+
+// IgnoreStatementLine excludes a statement line from reconciliation.
+func (c *CapitalBridge) IgnoreStatementLine(lineID int) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	return NewBankReconciliationService(c.db).IgnoreLine(c.ctx, profile.TenantID, lineID)
+}
+
+// GetBankRules lists the tenant's bank rules in evaluation order.
+func (c *CapitalBridge) GetBankRules() ([]BankRuleDTO, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := c.db.BankRule.Query().
+		Where(bankrule.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithAccount().
+		Order(ent.Asc(bankrule.FieldPriority), ent.Asc(bankrule.FieldID)).
+		All(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bank rules: %w", err)
+	}
+
+	dtos := make([]BankRuleDTO, len(rules))
+	for i, r := range rules {
+		dtos[i] = BankRuleDTO{
+			ID:         r.ID,
+			Name:       r.Name,
+			MatchField: string(r.MatchField),
+			Pattern:    r.Pattern,
+			Direction:  string(r.Direction),
+			Priority:   r.Priority,
+			IsActive:   r.IsActive,
+		}
+		if r.Edges.Account != nil {
+			dtos[i].AccountID = r.Edges.Account.ID
+			dtos[i].AccountNumber = r.Edges.Account.Number
+		}
+	}
+	return dtos, nil
+}
+
+// SaveBankRule creates a rule, or updates it when ID is set.
+func (c *CapitalBridge) SaveBankRule(rule BankRuleDTO) (int, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(rule.Pattern) == "" {
+		return 0, fmt.Errorf("rule pattern is required")
+	}
+	if rule.MatchField == "" {
+		rule.MatchField = string(bankrule.MatchFieldDescription)
+	}
+	if rule.Direction == "" {
+		rule.Direction = string(bankrule.DirectionAny)
+	}
+	if err := bankrule.MatchFieldValidator(bankrule.MatchField(rule.MatchField)); err != nil {
+		return 0, err
+	}
+	if err := bankrule.DirectionValidator(bankrule.Direction(rule.Direction)); err != nil {
+		return 0, err
+	}
+
+	ok, err := c.db.Account.Query().
+		Where(account.ID(rule.AccountID), account.HasTenantWith(tenant.ID(profile.TenantID))).
+		Exist(c.ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("account %d not found", rule.AccountID)
+	}
+
+	if rule.ID == 0 {
+		r, err := c.db.BankRule.Create().
+			SetTenantID(profile.TenantID).
+			SetName(rule.Name).
+			SetMatchField(bankrule.MatchField(rule.MatchField)).
+			SetPattern(rule.Pattern).
+			SetDirection(bankrule.Direction(rule.Direction)).
+			SetPriority(rule.Priority).
+			SetIsActive(rule.IsActive).
+			SetAccountID(rule.AccountID).
+			Save(c.ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create bank rule: %w", err)
+		}
+		return r.ID, nil
+	}
+
+	n, err := c.db.BankRule.Update().
+		Where(bankrule.ID(rule.ID), bankrule.HasTenantWith(tenant.ID(profile.TenantID))).
+		SetName(rule.Name).
+		SetMatchField(bankrule.MatchField(rule.MatchField)).
+		SetPattern(rule.Pattern).
+		SetDirection(bankrule.Direction(rule.Direction)).
+		SetPriority(rule.Priority).
+		SetIsActive(rule.IsActive).
+		SetAccountID(rule.AccountID).
+		Save(c.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update bank rule: %w", err)
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("bank rule %d not found", rule.ID)
+	}
+	return rule.ID, nil
+}
+
+// DeleteBankRule removes a rule. Lines it suggested keep their suggestion until re-matched.
+func (c *CapitalBridge) DeleteBankRule(ruleID int) error {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	_, err = c.db.BankStatementLine.Update().
+		Where(bankstatementline.HasSuggestedRuleWith(bankrule.ID(ruleID))).
+		ClearSuggestedRule().
+		Save(c.ctx)
+	if err != nil {
+		return err
+	}
+	n, err := c.db.BankRule.Delete().
+		Where(bankrule.ID(ruleID), bankrule.HasTenantWith(tenant.ID(profile.TenantID))).
+		Exec(c.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete bank rule: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("bank rule %d not found", ruleID)
+	}
+	return nil
+}
+
+// GetReconciliationReport returns the bank reconciliation for a cash account and period.
+func (c *CapitalBridge) GetReconciliationReport(accountID int, from, to time.Time) (*ReconciliationReport, error) {
+	profile, err := c.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	return NewBankReconciliationService(c.db).Report(c.ctx, profile.TenantID, accountID, from, to)
+}
@@ -2,14 +2,13 @@ package capital
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"time"
 
 	"sent/ent"
 	"sent/ent/recurringinvoice"
 	"sent/ent/account"
-	"sent/ent/journalentry"
-	"sent/ent/ledgerentry"
 	"sent/pkg/database"
 
 	"github.com/riverqueue/river"
@@ -33,19 +32,26 @@ func (RecurringInvoiceArgs) Kind() string { return "capital:recurring_invoice" }
 // RecurringInvoiceWorker handles the generation of invoices and ledger entries.
 type RecurringInvoiceWorker struct {
 	river.WorkerDefaults[RecurringInvoiceArgs]
-	db *ent.Client
+	db   *ent.Client
+	docs DocumentStore
 }
 
 func NewRecurringInvoiceWorker(db *ent.Client) *RecurringInvoiceWorker {
 	return &RecurringInvoiceWorker{db: db}
 }
 
+// SetDocumentStore enables storing the generated invoice PDFs in SENTvault.
+func (w *RecurringInvoiceWorker) SetDocumentStore(docs DocumentStore) {
+	w.docs = docs
+}
+
 func (w *RecurringInvoiceWorker) Work(ctx context.Context, job *river.Job[RecurringInvoiceArgs]) error {
 	// 1. Fetch the recurring invoice configuration
 	ri, err := w.db.RecurringInvoice.Query().
 		Where(recurringinvoice.ID(job.Args.RecurringInvoiceID)).
 		WithAccount().
 		WithTenant().
+		WithCustomer().
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to fetch recurring invoice: %w", err)
@@ -55,6 +61,11 @@ func (w *RecurringInvoiceWorker) Work(ctx context.Context, job *river.Job[Recurr
 		return nil
 	}
 
+	// Schedules with a customer produce a full AR invoice in the subledger.
+	if ri.Edges.Customer != nil {
+		return w.issueCustomerInvoice(ctx, ri)
+	}
+
 	// 2. Start database transaction
 	tx, err := w.db.Tx(ctx)
 	if err != nil {
@@ -62,94 +73,65 @@ func (w *RecurringInvoiceWorker) Work(ctx context.Context, job *river.Job[Recurr
 	}
 	defer tx.Rollback()
 
-	// 3. Precision Math
-	amount := ri.Amount
-	
-	// 4. Generate Journal Entry
-	arAcc, err := tx.Account.Query().Where(account.Number("1200")).Only(ctx)
-	if err != nil {
-		return fmt.Errorf("accounts receivable (1200) not found: %w", err)
-	}
+	now := time.Now()
 
-	revAcc := ri.Edges.Account
-
-	txn, err := tx.Transaction.Create().
-		SetDescription(fmt.Sprintf("Recurring Invoice: %s", ri.Description)).
-		SetDate(time.Now()).
-		SetTenant(ri.Edges.Tenant).
-		Save(ctx)
-	if err != nil {
-		return err
+	// 3. Precision Math: the invoice is billed in its own currency, the ledger in the functional one
+	functional := normalizeCurrency(ri.Edges.Tenant.FunctionalCurrency)
+	currency := normalizeCurrency(ri.Currency)
+	if currency == "" {
+		currency = functional
 	}
-
-	// Debit AR
-	_, err = tx.JournalEntry.Create().
-		SetAmount(ri.Amount).
-		SetDirection(journalentry.DirectionDebit).
-		SetAccount(arAcc).
-		SetTransaction(txn).
-		SetTenant(ri.Edges.Tenant).
-		Save(ctx)
+	exchange := NewExchangeService(w.db)
+	rate, err := exchange.GetExchangeRate(ctx, ri.Edges.Tenant.ID, currency, functional, now)
 	if err != nil {
-		return err
+		return fmt.Errorf("cannot bill recurring invoice %d in %s: %w", ri.ID, currency, err)
 	}
+	amount := RoundCurrency(ri.Amount.Mul(rate), functional)
 
-	_, err = tx.LedgerEntry.Create().
-		SetAmount(ri.Amount).
-		SetDirection(ledgerentry.DirectionDebit).
-		SetAccount(arAcc).
-		SetTransaction(txn).
-		SetTenant(ri.Edges.Tenant).
-		Save(ctx)
+	// 4. Generate Journal Entry
+	arAcc, err := EnsureAccount(ctx, tx, ri.Edges.Tenant, AccountReceivable, "Accounts Receivable", account.TypeAsset)
 	if err != nil {
 		return err
 	}
 
-	// Credit Revenue
-	_, err = tx.JournalEntry.Create().
-		SetAmount(ri.Amount).
-		SetDirection(journalentry.DirectionCredit).
-		SetAccount(revAcc).
-		SetTransaction(txn).
-		SetTenant(ri.Edges.Tenant).
-		Save(ctx)
-	if err != nil {
-		return err
-	}
+	revAcc := ri.Edges.Account
 
-	_, err = tx.LedgerEntry.Create().
-		SetAmount(ri.Amount).
-		SetDirection(ledgerentry.DirectionCredit).
-		SetAccount(revAcc).
-		SetTransaction(txn).
-		SetTenant(ri.Edges.Tenant).
-		Save(ctx)
+	_, err = NewLedgerService().Post(ctx, tx, Posting{
+		TenantID:     ri.Edges.Tenant.ID,
+		Date:         now,
+		Description:  fmt.Sprintf("Recurring Invoice: %s", ri.Description),
+		Currency:     currency,
+		ExchangeRate: rate,
+		Lines: []PostingLine{
+			// Debit AR, Credit Revenue
+			{AccountID: arAcc.ID, Direction: "debit", Amount: amount, Currency: currency, CurrencyAmount: ri.Amount, Rate: rate},
+			{AccountID: revAcc.ID, Direction: "credit", Amount: amount, Currency: currency, CurrencyAmount: ri.Amount, Rate: rate},
+		},
+	})
 	if err != nil {
-		return err
+		return fmt.Errorf("recurring invoice %d not posted: %w", ri.ID, err)
 	}
 
-	// Update Account Balances
-	tx.Account.UpdateOne(arAcc).SetBalance(arAcc.Balance.Add(amount)).Exec(ctx)
-	tx.Account.UpdateOne(revAcc).SetBalance(revAcc.Balance.Add(amount)).Exec(ctx)
-
 	// 5. Update Recurring Invoice state
 	nextRun := w.calculateNextRun(ri.NextRunDate, ri.Frequency)
 	err = tx.RecurringInvoice.UpdateOne(ri).
-		SetLastRunDate(time.Now()).
+		SetLastRunDate(now).
 		SetNextRunDate(nextRun).
 		Exec(ctx)
 	if err != nil {
 		return err
 	}
 
-	// 6. Generate PDF Invoice
-	_ = w.generatePDFInvoice(ri, amount)
-
-	// 7. Commit transaction
+	// 6. Commit transaction
 	if err := tx.Commit(); err != nil {
 		return err
 	}
 
+	// 7. Generate PDF Invoice; the posting stands even if storage fails
+	if err := w.storePDFInvoice(ri, ri.Amount, now); err != nil {
+		fmt.Printf("[SENTcapital] Warning: recurring invoice %d PDF not stored: %v\n", ri.ID, err)
+	}
+
 	// 8. Log Audit
 	database.LogAuditRecord(ctx, w.db, ri.Edges.Tenant.ID, "SENTcapital", "recurring_invoice_generated", "system", map[string]interface{}{
 		"invoice_id": ri.ID,
@@ -170,10 +152,59 @@ func (w *RecurringInvoiceWorker) calculateNextRun(current time.Time, frequency s
 	}
 }
 
-func (w *RecurringInvoiceWorker) generatePDFInvoice(ri *ent.RecurringInvoice, amount decimal.Decimal) error {
+// issueCustomerInvoice bills one period of a customer schedule through the receivables service,
+// which posts AR, tax and revenue and stores the PDF.
+func (w *RecurringInvoiceWorker) issueCustomerInvoice(ctx context.Context, ri *ent.RecurringInvoice) error {
+	now := time.Now()
+	inv, err := NewReceivablesService(w.db, w.docs).IssueInvoice(ctx, ri.Edges.Tenant.ID, InvoiceRequest{
+		CustomerID: ri.Edges.Customer.ID,
+		IssueDate:  now,
+		Currency:   ri.Currency,
+		Notes:      fmt.Sprintf("Recurring %s billing", ri.Frequency),
+		Lines: []InvoiceLineRequest{{
+			Description: ri.Description,
+			Quantity:    1,
+			UnitPrice:   ri.Amount.InexactFloat64(),
+			AccountID:   ri.Edges.Account.ID,
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("recurring invoice %d not issued: %w", ri.ID, err)
+	}
+
+	err = w.db.RecurringInvoice.UpdateOne(ri).
+		SetLastRunDate(now).
+		SetNextRunDate(w.calculateNextRun(ri.NextRunDate, ri.Frequency)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	database.LogAuditRecord(ctx, w.db, ri.Edges.Tenant.ID, "SENTcapital", "recurring_invoice_generated", "system", map[string]interface{}{
+		"invoice_id": ri.ID,
+		"number":     inv.Number,
+		"amount":     inv.Total,
+	})
+	return nil
+}
+
+// storePDFInvoice renders a schedule-only invoice (no customer) and saves it to SENTvault.
+func (w *RecurringInvoiceWorker) storePDFInvoice(ri *ent.RecurringInvoice, amount decimal.Decimal, date time.Time) error {
+	if w.docs == nil {
+		return nil
+	}
+	pdf, err := w.generatePDFInvoice(ri, amount, date)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("finance/invoices/recurring-%d-%s.pdf", ri.ID, date.Format("2006-01-02"))
+	return w.docs.SaveFileAsTenant(path, base64.StdEncoding.EncodeToString(pdf), ri.Edges.Tenant.ID)
+}
+
+func (w *RecurringInvoiceWorker) generatePDFInvoice(ri *ent.RecurringInvoice, amount decimal.Decimal, date time.Time) ([]byte, error) {
 	cfg := config.NewBuilder().Build()
 	m := maroto.New(cfg)
-	
+
 	m.AddRows(row.New(20).Add(
 		text.NewCol(12, "INVOICE", props.Text{
 			Size:  16,
@@ -184,7 +215,7 @@ func (w *RecurringInvoiceWorker) generatePDFInvoice(ri *ent.RecurringInvoice, am
 
 	m.AddRows(row.New(10).Add(
 		text.NewCol(6, fmt.Sprintf("Description: %s", ri.Description), props.Text{Size: 10}),
-		text.NewCol(6, fmt.Sprintf("Date: %s", time.Now().Format("2006-01-02")), props.Text{Size: 10, Align: align.Right}),
+		text.NewCol(6, fmt.Sprintf("Date: %s", date.Format("2006-01-02")), props.Text{Size: 10, Align: align.Right}),
 	))
 
 	m.AddRows(row.New(10).Add(
@@ -195,5 +226,5 @@ func (w *RecurringInvoiceWorker) generatePDFInvoice(ri *ent.RecurringInvoice, am
 		}),
 	))
 
-	return nil
-}
\ No newline at end of file
+	return documentBytes(m)
+}
@@ -5,14 +5,14 @@ import (
 	"testing"
 	"time"
 
-	"sent/ent/enttest"
 	"sent/ent/account"
+	"sent/ent/enttest"
 	"sent/ent/journalentry"
 	"sent/ent/transaction"
 
-	"github.com/shopspring/decimal"
-	"github.com/riverqueue/river"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/riverqueue/river"
+	"github.com/shopspring/decimal"
 )
 
 func TestRecurringInvoicePrecision(t *testing.T) {
@@ -22,13 +22,13 @@ func TestRecurringInvoicePrecision(t *testing.T) {
 
 	// 1. Setup Tenant and Accounts
 	tenant := client.Tenant.Create().SetName("TestCorp").SetDomain("test.sent").SaveX(ctx)
-	
+
 	arAcc := client.Account.Create().
 		SetTenant(tenant).
 		SetName("Accounts Receivable").
 		SetNumber("1200").
 		SetType(account.TypeAsset).
-		SetBalance(0).
+		SetBalance(decimal.Zero).
 		SaveX(ctx)
 
 	revAcc := client.Account.Create().
@@ -36,13 +36,13 @@ func TestRecurringInvoicePrecision(t *testing.T) {
 		SetName("SaaS Subscription Revenue").
 		SetNumber("4000").
 		SetType(account.TypeRevenue).
-		SetBalance(0).
+		SetBalance(decimal.Zero).
 		SaveX(ctx)
 
 	// 2. Create Recurring Invoice Config
 	// Set amount to a complex decimal to test precision over cycles
 	// 99.99 * 1000 should be exactly 99990.00
-	amount := 99.99
+	amount := decimal.RequireFromString("99.99")
 	ri := client.RecurringInvoice.Create().
 		SetTenant(tenant).
 		SetAccount(revAcc).
@@ -60,7 +60,7 @@ func TestRecurringInvoicePrecision(t *testing.T) {
 		job := &river.Job[RecurringInvoiceArgs]{
 			Args: RecurringInvoiceArgs{RecurringInvoiceID: ri.ID},
 		}
-		
+
 		err := worker.Work(ctx, job)
 		if err != nil {
 			t.Fatalf("Worker failed at iteration %d: %v", i, err)
@@ -68,13 +68,13 @@ func TestRecurringInvoicePrecision(t *testing.T) {
 	}
 
 	// 4. Verify Final Balances with Decimal Math
-	expectedTotal := decimal.NewFromFloat(amount).Mul(decimal.NewFromInt(int64(iterations)))
-	
+	expectedTotal := amount.Mul(decimal.NewFromInt(int64(iterations)))
+
 	finalArAcc := client.Account.GetX(ctx, arAcc.ID)
 	finalRevAcc := client.Account.GetX(ctx, revAcc.ID)
 
-	actualArBalance := decimal.NewFromFloat(finalArAcc.Balance)
-	actualRevBalance := decimal.NewFromFloat(finalRevAcc.Balance)
+	actualArBalance := finalArAcc.Balance
+	actualRevBalance := finalRevAcc.Balance
 
 	if !actualArBalance.Equal(expectedTotal) {
 		t.Errorf("AR Balance Drift! Expected %s, got %s", expectedTotal, actualArBalance)
@@ -94,8 +94,8 @@ func TestRecurringInvoicePrecision(t *testing.T) {
 	entries := client.JournalEntry.Query().
 		Where(journalentry.HasTransactionWith(transaction.DescriptionHasPrefix("Recurring Invoice"))).
 		AllX(ctx)
-	
-	if len(entries) != iterations * 2 {
-		t.Errorf("Expected %d journal entries, got %d", iterations * 2, len(entries))
+
+	if len(entries) != iterations*2 {
+		t.Errorf("Expected %d journal entries, got %d", iterations*2, len(entries))
 	}
 }
@@ -0,0 +1,296 @@
+package capital
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/ledgerentry"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/pkg/database"
+
+	"github.com/riverqueue/river"
+	"github.com/shopspring/decimal"
+)
+
+// Foreign exchange gain/loss accounts. Both are created on demand.
+const (
+	AccountRealizedFX   = "7100"
+	AccountUnrealizedFX = "7200"
+)
+
+// ErrAlreadyRevalued is returned when a revaluation journal already exists for the period end.
+var ErrAlreadyRevalued = errors.New("period already revalued")
+
+// RevaluationLine describes the adjustment computed for one monetary account.
+type RevaluationLine struct {
+	AccountID      int             `json:"account_id"`
+	AccountNumber  string          `json:"account_number"`
+	AccountName    string          `json:"account_name"`
+	Currency       string          `json:"currency"`
+	ForeignBalance decimal.Decimal `json:"foreign_balance"`
+	ClosingRate    decimal.Decimal `json:"closing_rate"`
+	Carrying       decimal.Decimal `json:"carrying"`
+	Revalued       decimal.Decimal `json:"revalued"`
+	Adjustment     decimal.Decimal `json:"adjustment"`
+}
+
+// RevaluationResult summarizes a period-end revaluation run for one tenant.
+type RevaluationResult struct {
+	TenantID      int               `json:"tenant_id"`
+	PeriodEnd     time.Time         `json:"period_end"`
+	TransactionID int               `json:"transaction_id"`
+	Lines         []RevaluationLine `json:"lines"`
+	NetGainLoss   decimal.Decimal   `json:"net_gain_loss"` // positive is a gain
+}
+
+// RevaluationService restates foreign-currency monetary accounts at the closing rate.
+type RevaluationService struct {
+	db       *ent.Client
+	exchange *ExchangeService
+	ledger   *LedgerService
+}
+
+// NewRevaluationService initializes a new RevaluationService.
+func NewRevaluationService(db *ent.Client, exchange *ExchangeService) *RevaluationService {
+	return &RevaluationService{db: db, exchange: exchange, ledger: NewLedgerService()}
+}
+
+// Revalue posts an unrealized FX gain/loss journal for every open monetary account of the
+// tenant that is denominated in a foreign currency. The journal carries a per-date reference,
+// so a period can only be revalued once; because adjustments are computed against the
+// carrying amount (which includes earlier revaluations) no reversal entry is needed.
+func (s *RevaluationService) Revalue(ctx context.Context, tenantID int, periodEnd time.Time) (*RevaluationResult, error) {
+	periodEnd = endOfDay(periodEnd)
+	reference := fmt.Sprintf("FX-REVAL-%s", periodEnd.Format("2006-01-02"))
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tnt, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %d not found: %w", tenantID, err)
+	}
+	functional := normalizeCurrency(tnt.FunctionalCurrency)
+
+	done, err := tx.Transaction.Query().
+		Where(transaction.HasTenantWith(tenant.ID(tenantID)), transaction.Reference(reference)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if done {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyRevalued, periodEnd.Format("2006-01-02"))
+	}
+
+	accounts, err := tx.Account.Query().
+		Where(
+			account.HasTenantWith(tenant.ID(tenantID)),
+			account.TypeIn(account.TypeAsset, account.TypeLiability),
+			account.CurrencyNotNil(),
+			account.CurrencyNEQ(""),
+			account.CurrencyNEQ(functional),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query monetary accounts: %w", err)
+	}
+
+	result := &RevaluationResult{TenantID: tenantID, PeriodEnd: periodEnd, NetGainLoss: decimal.Zero}
+	for _, acc := range accounts {
+		line, err := s.revalueAccount(ctx, tx, tnt, acc, functional, periodEnd)
+		if err != nil {
+			return nil, err
+		}
+		if line != nil {
+			result.Lines = append(result.Lines, *line)
+			result.NetGainLoss = result.NetGainLoss.Add(line.Adjustment)
+		}
+	}
+
+	if len(result.Lines) == 0 {
+		return result, nil
+	}
+
+	fxAcc, err := EnsureAccount(ctx, tx, tnt, AccountUnrealizedFX, "Unrealized FX Gain/Loss", account.TypeExpense)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []PostingLine
+	for _, l := range result.Lines {
+		// A positive adjustment raises the debit balance of the monetary account.
+		direction := "debit"
+		if l.Adjustment.IsNegative() {
+			direction = "credit"
+		}
+		lines = append(lines, PostingLine{
+			AccountID:      l.AccountID,
+			Direction:      direction,
+			Amount:         l.Adjustment.Abs(),
+			Currency:       l.Currency,
+			CurrencyAmount: decimal.Zero,
+			Rate:           l.ClosingRate,
+		})
+	}
+
+	// Net gain is a credit to the FX account, net loss a debit.
+	if !result.NetGainLoss.IsZero() {
+		direction := "credit"
+		if result.NetGainLoss.IsNegative() {
+			direction = "debit"
+		}
+		lines = append(lines, PostingLine{AccountID: fxAcc.ID, Direction: direction, Amount: result.NetGainLoss.Abs()})
+	}
+
+	// Revaluation is a period-end adjustment, so it may still post into a soft-closed period.
+	txn, err := s.ledger.Post(ctx, tx, Posting{
+		TenantID:        tenantID,
+		Date:            periodEnd,
+		Description:     fmt.Sprintf("FX revaluation at %s", periodEnd.Format("2006-01-02")),
+		Type:            "fx_revaluation",
+		Reference:       reference,
+		AllowSoftClosed: true,
+		Lines:           lines,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to post revaluation: %w", err)
+	}
+	result.TransactionID = txn.ID
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	database.LogAuditRecord(ctx, s.db, tenantID, "SENTcapital", "fx_revaluation_posted", "system", map[string]interface{}{
+		"transaction_id": txn.ID,
+		"period_end":     periodEnd.Format("2006-01-02"),
+		"net_gain_loss":  result.NetGainLoss.String(),
+	})
+
+	return result, nil
+}
+
+// revalueAccount returns nil when the account needs no adjustment.
+func (s *RevaluationService) revalueAccount(ctx context.Context, tx *ent.Tx, tnt *ent.Tenant, acc *ent.Account, functional string, periodEnd time.Time) (*RevaluationLine, error) {
+	entries, err := tx.LedgerEntry.Query().
+		Where(
+			ledgerentry.HasAccountWith(account.ID(acc.ID)),
+			ledgerentry.HasTransactionWith(
+				transaction.DateLTE(periodEnd),
+				transaction.ApprovalStatusEQ(transaction.ApprovalStatusAPPROVED),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load entries for account %s: %w", acc.Number, err)
+	}
+
+	currency := normalizeCurrency(acc.Currency)
+	foreign, carrying := decimal.Zero, decimal.Zero
+	for _, e := range entries {
+		sign := decimal.NewFromInt(1)
+		if e.Direction == ledgerentry.DirectionCredit {
+			sign = sign.Neg()
+		}
+		carrying = carrying.Add(e.Amount.Mul(sign))
+		if normalizeCurrency(e.Currency) == currency {
+			foreign = foreign.Add(e.CurrencyAmount.Mul(sign))
+		}
+	}
+
+	rate, err := s.exchange.GetExchangeRate(ctx, tnt.ID, currency, functional, periodEnd)
+	if err != nil {
+		return nil, fmt.Errorf("cannot revalue account %s: %w", acc.Number, err)
+	}
+
+	revalued := RoundCurrency(foreign.Mul(rate), functional)
+	adjustment := revalued.Sub(carrying)
+	if adjustment.IsZero() {
+		return nil, nil
+	}
+
+	return &RevaluationLine{
+		AccountID:      acc.ID,
+		AccountNumber:  acc.Number,
+		AccountName:    acc.Name,
+		Currency:       currency,
+		ForeignBalance: foreign,
+		ClosingRate:    rate,
+		Carrying:       carrying,
+		Revalued:       revalued,
+		Adjustment:     adjustment,
+	}, nil
+}
+
+// RevaluationArgs defines the arguments for the period-end FX revaluation job.
+// A zero TenantID revalues every tenant; a zero PeriodEnd means the end of the previous month.
+type RevaluationArgs struct {
+	TenantID  int       `json:"tenant_id"`
+	PeriodEnd time.Time `json:"period_end"`
+}
+
+func (RevaluationArgs) Kind() string { return "capital:fx_revaluation" }
+
+// RevaluationWorker runs the period-end revaluation from River.
+type RevaluationWorker struct {
+	river.WorkerDefaults[RevaluationArgs]
+	db *ent.Client
+}
+
+func NewRevaluationWorker(db *ent.Client) *RevaluationWorker {
+	return &RevaluationWorker{db: db}
+}
+
+func (w *RevaluationWorker) Work(ctx context.Context, job *river.Job[RevaluationArgs]) error {
+	periodEnd := job.Args.PeriodEnd
+	if periodEnd.IsZero() {
+		periodEnd = previousMonthEnd(time.Now())
+	}
+
+	tenantIDs := []int{job.Args.TenantID}
+	if job.Args.TenantID == 0 {
+		ids, err := w.db.Tenant.Query().Where(tenant.Active(true)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		tenantIDs = ids
+	}
+
+	svc := NewRevaluationService(w.db, NewExchangeService(w.db))
+	var failed []error
+	for _, id := range tenantIDs {
+		res, err := svc.Revalue(ctx, id, periodEnd)
+		if errors.Is(err, ErrAlreadyRevalued) {
+			continue
+		}
+		if err != nil {
+			failed = append(failed, fmt.Errorf("tenant %d: %w", id, err))
+			continue
+		}
+		fmt.Printf("[CAPITAL] FX revaluation for tenant %d at %s: %d accounts, net %s\n",
+			id, periodEnd.Format("2006-01-02"), len(res.Lines), res.NetGainLoss.StringFixed(2))
+	}
+	return errors.Join(failed...)
+}
+
+// MonthEndSchedule fires shortly after midnight on the first day of each month,
+// so the revaluation job sees the closing rates of the month that just ended.
+type MonthEndSchedule struct{}
+
+func (MonthEndSchedule) Next(current time.Time) time.Time {
+	y, m, _ := current.Date()
+	return time.Date(y, m+1, 1, 0, 5, 0, 0, current.Location())
+}
+
+func previousMonthEnd(now time.Time) time.Time {
+	y, m, _ := now.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, now.Location()).Add(-time.Nanosecond)
+}
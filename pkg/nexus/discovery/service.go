@@ -20,12 +20,15 @@ func NewService(client *ent.Client) *Service {
 
 // TelemetryData represents the data coming from SENTpulse for discovery reconciliation
 type TelemetryData struct {
-	TenantID   int
-	Hostname   string
-	IP         string
-	MAC        string
-	OS         string
-	Metadata   map[string]interface{}
+	TenantID int
+	Hostname string
+	IP       string
+	MAC      string
+	OS       string
+	Metadata map[string]interface{}
+	// Reported by agents' inventory; copied onto the asset where it has none
+	Manufacturer string
+	SerialNumber string
 }
 
 // Reconcile matches incoming telemetry against the existing asset graph
@@ -37,11 +40,21 @@ func (s *Service) Reconcile(ctx context.Context, data TelemetryData) error {
 
 	if err == nil {
 		// Asset exists, update metadata and timestamp
-		return s.client.Asset.UpdateOne(existing).
+		upd := s.client.Asset.UpdateOne(existing).
 			SetName(data.Hostname).
 			SetMetadata(data.Metadata).
-			SetUpdatedAt(time.Now()).
-			Exec(ctx)
+			SetUpdatedAt(time.Now())
+		if existing.Manufacturer == "" && data.Manufacturer != "" {
+			upd.SetManufacturer(data.Manufacturer)
+		}
+		if existing.SerialNumber == "" {
+			serial, err := s.freeSerial(ctx, nonEmpty(data.SerialNumber))
+			if err != nil {
+				return err
+			}
+			upd.SetNillableSerialNumber(serial)
+		}
+		return upd.Exec(ctx)
 	}
 
 	if !ent.IsNotFound(err) {
@@ -85,6 +98,11 @@ func (s *Service) ApproveDiscovery(ctx context.Context, entryID int, assetTypeID
 		return nil, err
 	}
 
+	serial, err := s.freeSerial(ctx, metadataString(entry.Metadata, "serial_number"))
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the Asset
 	newAsset, err := s.client.Asset.Create().
 		SetTenantID(entry.Edges.Tenant.ID).
@@ -92,6 +110,8 @@ func (s *Service) ApproveDiscovery(ctx context.Context, entryID int, assetTypeID
 		SetHardwareID(entry.HardwareID).
 		SetName(entry.Name).
 		SetMetadata(entry.Metadata).
+		SetNillableManufacturer(metadataString(entry.Metadata, "manufacturer")).
+		SetNillableSerialNumber(serial).
 		Save(ctx)
 	if err != nil {
 		return nil, err
@@ -104,3 +124,34 @@ func (s *Service) ApproveDiscovery(ctx context.Context, entryID int, assetTypeID
 
 	return newAsset, err
 }
+
+// metadataString returns a non-empty string kept in discovery metadata, or nil.
+func metadataString(metadata map[string]interface{}, key string) *string {
+	if v, ok := metadata[key].(string); ok && v != "" {
+		return &v
+	}
+	return nil
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// freeSerial returns a serial number no asset has yet, or nil. Serial numbers are unique
+// across assets, and some machines report placeholder ones.
+func (s *Service) freeSerial(ctx context.Context, serial *string) (*string, error) {
+	if serial == nil {
+		return nil, nil
+	}
+	taken, err := s.client.Asset.Query().Where(asset.SerialNumberEQ(*serial)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query asset: %w", err)
+	}
+	if taken {
+		return nil, nil
+	}
+	return serial, nil
+}
@@ -5,7 +5,13 @@ import (
 	"log"
 	"sent/ent"
 	"sent/pkg/capital"
+	"sent/pkg/pulse/alerting"
+	"sent/pkg/pulse/inventory"
+	"sent/pkg/pulse/patching"
+	"sent/pkg/pulse/rpc"
+	"sent/pkg/pulse/scheduler"
 	"sent/pkg/tax"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -19,6 +25,10 @@ type Orchestrator struct {
 	pool        *pgxpool.Pool
 	riverClient *river.Client[pgx.Tx]
 	registry    *river.Workers
+	recurringW  *capital.RecurringInvoiceWorker
+	scriptW     *scheduler.RunScriptWorker
+	inventoryW  *inventory.CollectWorker
+	patchW      *patching.ApplyWorker
 }
 
 func NewOrchestrator(db *ent.Client) *Orchestrator {
@@ -32,8 +42,12 @@ func NewOrchestrator(db *ent.Client) *Orchestrator {
 	workers := river.NewWorkers()
 
 	// Register Core Workers
-	river.AddWorker(workers, capital.NewRecurringInvoiceWorker(db))
+	recurringW := capital.NewRecurringInvoiceWorker(db)
+	river.AddWorker(workers, recurringW)
+	river.AddWorker(workers, capital.NewRevaluationWorker(db))
+	river.AddWorker(workers, capital.NewLedgerSnapshotWorker(db))
 	river.AddWorker(workers, tax.NewTaxSubmissionWorker(db))
+	river.AddWorker(workers, tax.NewClearanceSweepWorker(db))
 	
 	// Register Orchestrator-specific workers
 	terminationW := &TerminationWorker{db: db}
@@ -45,9 +59,51 @@ func NewOrchestrator(db *ent.Client) *Orchestrator {
 	river.AddWorker(workers, &HealthUpdateWorker{db: db})
 	river.AddWorker(workers, &RemediationWorker{db: db})
 	river.AddWorker(workers, &PulseDiscoveryWorker{db: db})
+	river.AddWorker(workers, scheduler.NewJobSweepWorker(db))
+	scriptW := scheduler.NewRunScriptWorker(db)
+	river.AddWorker(workers, scriptW)
+	river.AddWorker(workers, inventory.NewSweepWorker(db))
+	inventoryW := inventory.NewCollectWorker(db)
+	river.AddWorker(workers, inventoryW)
+	river.AddWorker(workers, patching.NewSweepWorker(db))
+	patchW := patching.NewApplyWorker(db)
+	river.AddWorker(workers, patchW)
+	river.AddWorker(workers, alerting.NewEvaluateWorker(db, alerting.NewTelemetryMetrics(pool)))
+
+	periodicJobs := []*river.PeriodicJob{
+		// Month-end FX revaluation for every tenant
+		river.NewPeriodicJob(capital.MonthEndSchedule{}, func() (river.JobArgs, *river.InsertOpts) {
+			return capital.RevaluationArgs{}, nil
+		}, nil),
+		// Month-end balance snapshots for every tenant
+		river.NewPeriodicJob(capital.MonthEndSchedule{}, func() (river.JobArgs, *river.InsertOpts) {
+			return capital.LedgerSnapshotArgs{}, nil
+		}, nil),
+		// E-invoices waiting for clearance or reporting
+		river.NewPeriodicJob(river.PeriodicInterval(time.Minute), func() (river.JobArgs, *river.InsertOpts) {
+			return tax.ClearanceSweepArgs{}, nil
+		}, &river.PeriodicJobOpts{RunOnStart: true}),
+		// Pulse jobs whose scheduled run has come
+		river.NewPeriodicJob(river.PeriodicInterval(time.Minute), func() (river.JobArgs, *river.InsertOpts) {
+			return scheduler.JobSweepArgs{}, nil
+		}, &river.PeriodicJobOpts{RunOnStart: true}),
+		// Agents' inventory is refreshed when it is older than inventory.MaxAge
+		river.NewPeriodicJob(river.PeriodicInterval(time.Hour), func() (river.JobArgs, *river.InsertOpts) {
+			return inventory.SweepArgs{}, nil
+		}, &river.PeriodicJobOpts{RunOnStart: true}),
+		// Patch policies whose scheduled run has come
+		river.NewPeriodicJob(river.PeriodicInterval(time.Minute), func() (river.JobArgs, *river.InsertOpts) {
+			return patching.SweepArgs{}, nil
+		}, &river.PeriodicJobOpts{RunOnStart: true}),
+		// Alert rules, against the agents they target now
+		river.NewPeriodicJob(river.PeriodicInterval(time.Minute), func() (river.JobArgs, *river.InsertOpts) {
+			return alerting.EvaluateArgs{}, nil
+		}, &river.PeriodicJobOpts{RunOnStart: true}),
+	}
 
 	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
-		Workers: workers,
+		Workers:      workers,
+		PeriodicJobs: periodicJobs,
 	})
 	if err != nil {
 		log.Printf("Warning: Failed to create River client: %v", err)
@@ -61,9 +117,26 @@ func NewOrchestrator(db *ent.Client) *Orchestrator {
 		pool:        pool,
 		riverClient: riverClient,
 		registry:    workers,
+		recurringW:  recurringW,
+		scriptW:     scriptW,
+		inventoryW:  inventoryW,
+		patchW:      patchW,
 	}
 }
 
+// SetDocumentStore lets workers that generate documents store them, e.g. in SENTvault.
+func (o *Orchestrator) SetDocumentStore(docs capital.DocumentStore) {
+	o.recurringW.SetDocumentStore(docs)
+}
+
+// SetAgentTransport lets workers reach Pulse agents, e.g. to run job scripts on them, collect
+// their inventory or patch them.
+func (o *Orchestrator) SetAgentTransport(t rpc.Transport) {
+	o.scriptW.SetTransport(t)
+	o.inventoryW.SetTransport(t)
+	o.patchW.SetTransport(t)
+}
+
 func (o *Orchestrator) Workers() *river.Workers {
 	return o.registry
 }
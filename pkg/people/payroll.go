@@ -3,6 +3,8 @@ package people
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"sent/ent"
 	"sent/ent/compensationagreement"
 	"sent/ent/employee"
@@ -54,8 +56,13 @@ func (e *PayrollEngine) CalculateMonthlyPayout(ctx context.Context, empID int, l
 	baseSalary := agreement.BaseSalary
 	baseCurrency := agreement.Currency
 
+	tenantID, err := emp.QueryTenant().OnlyID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("employee %d has no tenant: %w", empID, err)
+	}
+
 	// 1. Convert to local payout currency
-	grossPayLocal, err := e.exchangeService.Convert(baseSalary, baseCurrency, localCurrency)
+	grossPayLocal, err := e.exchangeService.Convert(ctx, tenantID, baseSalary, baseCurrency, localCurrency, time.Now())
 	if err != nil {
 		return nil, fmt.Errorf("currency conversion failed: %w", err)
 	}
@@ -10,8 +10,8 @@ import (
 	"sent/ent/servicerate"
 	"sent/ent/contract"
 	"sent/ent/account"
-	"sent/ent/ledgerentry"
 	"sent/ent/tenant"
+	"sent/pkg/capital"
 
 	"github.com/riverqueue/river"
 	"github.com/shopspring/decimal"
@@ -107,49 +107,19 @@ func (w *BillingSyncWorker) Work(ctx context.Context, job *river.Job[BillingSync
 		return fmt.Errorf("account %s not found: %w", revAccNum, err)
 	}
 
-	txn, err := tx.Transaction.Create().
-		SetDescription(description).
-		SetDate(time.Now()).
-		SetTenant(tenantObj).
-		Save(ctx)
+	// The ledger applies each side by account type, so a debit to Unearned Revenue
+	// (liability) reduces it while a debit to AR (asset) increases it.
+	txn, err := capital.NewLedgerService().Post(ctx, tx, capital.Posting{
+		TenantID:    tenantObj.ID,
+		Date:        time.Now(),
+		Description: description,
+		Lines: []capital.PostingLine{
+			{AccountID: arAcc.ID, Direction: "debit", Amount: amount},
+			{AccountID: revAcc.ID, Direction: "credit", Amount: amount},
+		},
+	})
 	if err != nil {
-		return err
-	}
-
-	// Ledger Entry 1 (Debit)
-	direction1 := ledgerentry.DirectionDebit
-	if cnt != nil {
-		// For liability (Unearned Revenue), Debit decreases balance
-		tx.Account.UpdateOne(arAcc).SetBalance(arAcc.Balance.Sub(amount)).Exec(ctx)
-	} else {
-		// For asset (AR), Debit increases balance
-		tx.Account.UpdateOne(arAcc).SetBalance(arAcc.Balance.Add(amount)).Exec(ctx)
-	}
-
-	_, err = tx.LedgerEntry.Create().
-		SetAmount(amount).
-		SetDirection(direction1).
-		SetAccount(arAcc).
-		SetTransaction(txn).
-		SetTenant(tenantObj).
-		Save(ctx)
-	if err != nil {
-		return err
-	}
-
-	// Ledger Entry 2 (Credit)
-	direction2 := ledgerentry.DirectionCredit
-	tx.Account.UpdateOne(revAcc).SetBalance(revAcc.Balance.Add(amount)).Exec(ctx)
-
-	_, err = tx.LedgerEntry.Create().
-		SetAmount(amount).
-		SetDirection(direction2).
-		SetAccount(revAcc).
-		SetTransaction(txn).
-		SetTenant(tenantObj).
-		Save(ctx)
-	if err != nil {
-		return err
+		return fmt.Errorf("time entry %d not billed: %w", te.ID, err)
 	}
 
 	// 7. Update TimeEntry
@@ -2,10 +2,11 @@ package agent
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
-	"fmt"
 	"log"
 	"sent/pkg/pulse/common"
+	"sent/pkg/pulse/rpc"
 
 	"github.com/centrifugal/centrifuge-go"
 )
@@ -15,9 +16,12 @@ type PulseClient struct {
 	agentID string
 }
 
-func NewPulseClient(wsURL string, agentID string, token string) *PulseClient {
+// NewPulseClient connects as agentID, getting connection tokens from getToken each time it
+// connects and presenting the client certificate in tlsConfig to a TLS hub.
+func NewPulseClient(wsURL string, agentID string, getToken func(centrifuge.ConnectionTokenEvent) (string, error), tlsConfig *tls.Config) *PulseClient {
 	c := centrifuge.NewJsonClient(wsURL, centrifuge.Config{
-		Token: token,
+		GetToken:  getToken,
+		TLSConfig: tlsConfig,
 	})
 
 	return &PulseClient{
@@ -41,13 +45,17 @@ func (pc *PulseClient) PublishTelemetry(metrics *common.SystemMetrics) error {
 		return err
 	}
 
-	channel := fmt.Sprintf("pulse:telemetry:%s", pc.agentID)
-	_, err = pc.client.Publish(context.Background(), channel, data)
+	_, err = pc.client.Publish(context.Background(), rpc.TelemetryChannel(pc.agentID), data)
 	return err
 }
 
+// Transport is the connection for answering the console's requests.
+func (pc *PulseClient) Transport() rpc.Transport {
+	return rpc.NewCentrifugeTransport(pc.client)
+}
+
 func (pc *PulseClient) SubscribeControl(handler func(cmd string)) error {
-	channel := fmt.Sprintf("pulse:control:%s", pc.agentID)
+	channel := rpc.ControlChannel(pc.agentID)
 	sub, err := pc.client.NewSubscription(channel)
 	if err != nil {
 		return err
@@ -37,7 +37,7 @@ func (c *Collector) GetHostInfo() (*common.HostInfo, error) {
 		PlatformVersion: info.OS.Version,
 		KernelVersion:   info.KernelVersion,
 		Arch:            info.Architecture,
-		AgentVersion:    "0.0.1", // TODO: Get from build info
+		AgentVersion:    Version,
 		MAC:             info.MACs,
         IP:              info.IPs,
         AvName:          detectAntivirusName(),
@@ -0,0 +1,96 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"sent/pkg/pulse/rpc"
+)
+
+// powerDelay leaves time to answer a reboot or shutdown before the machine goes down.
+const powerDelay = 3 * time.Second
+
+// serviceActions are the changes the console may make to a service.
+var serviceActions = map[string]bool{"start": true, "stop": true, "restart": true}
+
+// NewRPCServer answers the console's requests on this machine.
+func NewRPCServer(t rpc.Transport, agentID string) *rpc.Server {
+	s := rpc.NewServer(t, agentID)
+
+	s.Handle(rpc.MethodListProcesses, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return GetProcesses()
+	})
+	s.Handle(rpc.MethodKillProcess, rpc.Typed(func(ctx context.Context, p rpc.PIDParams) (any, error) {
+		return nil, KillProcess(p.PID)
+	}))
+
+	s.Handle(rpc.MethodListServices, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return GetServices()
+	})
+	s.Handle(rpc.MethodControlSvc, rpc.Typed(func(ctx context.Context, p rpc.ServiceParams) (any, error) {
+		if !serviceActions[p.Action] {
+			return nil, fmt.Errorf("unsupported service action %q", p.Action)
+		}
+		return nil, ControlService(p.Name, p.Action)
+	}))
+
+	s.Handle(rpc.MethodListFiles, rpc.Typed(func(ctx context.Context, p rpc.PathParams) (any, error) {
+		return ListFiles(p.Path)
+	}))
+	s.Handle(rpc.MethodReadFile, rpc.Typed(func(ctx context.Context, p rpc.PathParams) (any, error) {
+		return ReadFile(p.Path)
+	}))
+	s.Handle(rpc.MethodDeleteFile, rpc.Typed(func(ctx context.Context, p rpc.PathParams) (any, error) {
+		return nil, DeleteFile(p.Path)
+	}))
+
+	s.Handle(rpc.MethodScanPatches, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return GetPendingPatches()
+	})
+	s.Handle(rpc.MethodInstallPatch, rpc.Typed(func(ctx context.Context, p rpc.PatchParams) (any, error) {
+		return nil, InstallPatches(p.IDs)
+	}))
+
+	s.Handle(rpc.MethodReboot, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return nil, afterAnswer("reboot", RebootSystem)
+	})
+	s.Handle(rpc.MethodShutdown, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return nil, afterAnswer("shutdown", ShutdownSystem)
+	})
+
+	s.Handle(rpc.MethodEventLogs, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return GetSystemLogs()
+	})
+	s.Handle(rpc.MethodEnvVars, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return GetEnvironmentVariables(), nil
+	})
+	s.Handle(rpc.MethodSoftware, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return GetInstalledSoftware(), nil
+	})
+	s.Handle(rpc.MethodInventory, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return NewCollector().CollectInventory()
+	})
+
+	scripts := NewScriptRunner(t, agentID)
+	s.Handle(rpc.MethodRunScript, rpc.Typed(func(ctx context.Context, p rpc.ScriptParams) (any, error) {
+		return scripts.Run(ctx, p)
+	}))
+	s.Handle(rpc.MethodCancelScript, rpc.Typed(func(ctx context.Context, p rpc.ExecutionParams) (any, error) {
+		return nil, scripts.Cancel(p.ExecutionID)
+	}))
+	return s
+}
+
+// afterAnswer runs a power action once the request has been answered.
+func afterAnswer(name string, action func() error) error {
+	log.Printf("[AGENT] %s requested by the console", name)
+	time.AfterFunc(powerDelay, func() {
+		if err := action(); err != nil {
+			log.Printf("[AGENT] %s failed: %v", name, err)
+		}
+	})
+	return nil
+}
@@ -0,0 +1,29 @@
+package agent
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sent/pkg/pulse/common"
+)
+
+// dmiPath is where the kernel exposes the firmware's system identity.
+const dmiPath = "/sys/class/dmi/id"
+
+// readSystemIdentity reads the manufacturer, model and serial number from DMI. The serial
+// number is only readable as root.
+func readSystemIdentity() common.HardwareInfo {
+	read := func(name string) string {
+		b, err := os.ReadFile(filepath.Join(dmiPath, name))
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(b))
+	}
+	return common.HardwareInfo{
+		Manufacturer: read("sys_vendor"),
+		Model:        read("product_name"),
+		SerialNumber: read("product_serial"),
+	}
+}
@@ -0,0 +1,32 @@
+package agent
+
+import (
+	"encoding/json"
+	"os/exec"
+	"strings"
+
+	"sent/pkg/pulse/common"
+)
+
+// readSystemIdentity asks CIM for the manufacturer, model and BIOS serial number.
+func readSystemIdentity() common.HardwareInfo {
+	script := `$cs = Get-CimInstance Win32_ComputerSystem; $bios = Get-CimInstance Win32_BIOS; ` +
+		`@{Manufacturer=$cs.Manufacturer; Model=$cs.Model; Serial=$bios.SerialNumber} | ConvertTo-Json -Compress`
+	out, err := exec.Command("powershell", "-NoProfile", "-Command", script).Output()
+	if err != nil {
+		return common.HardwareInfo{}
+	}
+	var raw struct {
+		Manufacturer string
+		Model        string
+		Serial       string
+	}
+	if err := json.Unmarshal(out, &raw); err != nil {
+		return common.HardwareInfo{}
+	}
+	return common.HardwareInfo{
+		Manufacturer: strings.TrimSpace(raw.Manufacturer),
+		Model:        strings.TrimSpace(raw.Model),
+		SerialNumber: strings.TrimSpace(raw.Serial),
+	}
+}
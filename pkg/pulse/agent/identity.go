@@ -0,0 +1,347 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"sent/pkg/pulse/common"
+
+	"github.com/centrifugal/centrifuge-go"
+)
+
+// Version is the agent's release, reported when it enrols and with its host info.
+const Version = "0.0.1"
+
+// Where the agent finds the console. The enrollment token is only needed the first time.
+const (
+	envServer      = "SENT_AGENT_SERVER"
+	envHub         = "SENT_AGENT_HUB"
+	envEnrollToken = "SENT_AGENT_ENROLL_TOKEN"
+	envServerCA    = "SENT_AGENT_SERVER_CA" // PEM file, for a console with a private certificate
+
+	defaultServer = "http://localhost:8000"
+	defaultHub    = "ws://localhost:8000/connection/websocket"
+)
+
+// apiError is the console refusing a request.
+type apiError struct {
+	Status  int
+	Message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("console answered %d: %s", e.Status, e.Message)
+}
+
+// identity is what the agent keeps from its enrollment. The file holds its private key, so
+// only the agent's user can read it.
+type identity struct {
+	AgentID       string `json:"agent_id"`
+	TenantID      int    `json:"tenant_id"`
+	Key           string `json:"key"`         // PEM EC private key
+	Certificate   string `json:"certificate"` // PEM, issued by the tenant's agent authority
+	CACertificate string `json:"ca_certificate"`
+}
+
+func identityPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "sent-agent", "identity.json"), nil
+}
+
+// credentials hold the agent's identity and get it hub tokens from the console, over mutual
+// TLS when the console serves HTTPS.
+type credentials struct {
+	mu     sync.Mutex
+	id     identity
+	path   string
+	server string
+	http   *http.Client
+}
+
+func newCredentials(server, path string) (*credentials, error) {
+	c := &credentials{server: server, path: path}
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	c.http = &http.Client{Timeout: 30 * time.Second, Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	return c, nil
+}
+
+// loadCredentials reads the agent's identity, enrolling with token if it has none.
+func loadCredentials(server, token string, collector *Collector) (*credentials, error) {
+	path, err := identityPath()
+	if err != nil {
+		return nil, err
+	}
+	c, err := newCredentials(server, path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err == nil {
+		if err := json.Unmarshal(data, &c.id); err != nil {
+			return nil, fmt.Errorf("identity file %s is damaged: %w", path, err)
+		}
+		return c, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if token == "" {
+		return nil, fmt.Errorf("agent is not enrolled; set %s to an enrollment token from the console", envEnrollToken)
+	}
+	host, err := collector.GetHostInfo()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.enroll(context.Background(), token, host); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *credentials) AgentID() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.id.AgentID
+}
+
+func (c *credentials) save() error {
+	data, err := json.MarshalIndent(c.id, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+// newKey makes a key pair and a request for a certificate for it.
+func newKey(hostname string) (keyPEM, csrPEM string, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: hostname},
+	}, key)
+	if err != nil {
+		return "", "", err
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})), nil
+}
+
+func (c *credentials) enroll(ctx context.Context, token string, host *common.HostInfo) error {
+	keyPEM, csrPEM, err := newKey(host.Hostname)
+	if err != nil {
+		return err
+	}
+	req := common.EnrollRequest{
+		Token:    token,
+		Hostname: host.Hostname,
+		OS:       host.OS,
+		Arch:     host.Arch,
+		Version:  Version,
+		CSR:      csrPEM,
+	}
+	if len(host.IP) > 0 {
+		req.IP = host.IP[0]
+	}
+	if len(host.MAC) > 0 {
+		req.MAC = host.MAC[0]
+	}
+	var resp common.EnrollResponse
+	if err := c.post(ctx, "/api/pulse/enroll", req, &resp); err != nil {
+		return fmt.Errorf("enrollment failed: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.id = identity{
+		AgentID:       resp.AgentID,
+		TenantID:      resp.TenantID,
+		Key:           keyPEM,
+		Certificate:   resp.Certificate,
+		CACertificate: resp.CACertificate,
+	}
+	if err := c.save(); err != nil {
+		return fmt.Errorf("failed to save identity: %w", err)
+	}
+	log.Printf("[AGENT] Enrolled as agent %s", resp.AgentID)
+	return nil
+}
+
+// proof signs the current time and a fresh nonce with the agent's key, for purpose and the
+// payload the request carries.
+func (c *credentials) proof(purpose, payload string) (common.TokenRequest, error) {
+	c.mu.Lock()
+	id := c.id
+	c.mu.Unlock()
+	key, err := parseKey(id.Key)
+	if err != nil {
+		return common.TokenRequest{}, err
+	}
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return common.TokenRequest{}, err
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(raw)
+	ts := time.Now().Unix()
+	digest := sha256.Sum256(common.ProofMessage(purpose, id.AgentID, ts, nonce, payload))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		return common.TokenRequest{}, err
+	}
+	return common.TokenRequest{AgentID: id.AgentID, Timestamp: ts, Nonce: nonce, Signature: sig}, nil
+}
+
+func parseKey(keyPEM string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("agent key is not PEM")
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// HubToken gets a token to connect to the hub with, replacing the agent's key first when the
+// console asks for it.
+func (c *credentials) HubToken(ctx context.Context) (string, error) {
+	req, err := c.proof(common.ProofToken, "")
+	if err != nil {
+		return "", err
+	}
+	var resp common.TokenResponse
+	if err := c.post(ctx, "/api/pulse/agent/token", req, &resp); err != nil {
+		return "", err
+	}
+	if resp.Rekey {
+		if err := c.rekey(ctx); err != nil {
+			log.Printf("[AGENT] Rekey failed, keeping the current key: %v", err)
+		}
+	}
+	return resp.Token, nil
+}
+
+func (c *credentials) rekey(ctx context.Context) error {
+	hostname, _ := os.Hostname()
+	keyPEM, csrPEM, err := newKey(hostname)
+	if err != nil {
+		return err
+	}
+	// Signed with the current key over the new CSR, so the proof cannot carry another key.
+	proof, err := c.proof(common.ProofRekey, csrPEM)
+	if err != nil {
+		return err
+	}
+	var resp common.EnrollResponse
+	if err := c.post(ctx, "/api/pulse/agent/rekey", common.RekeyRequest{TokenRequest: proof, CSR: csrPEM}, &resp); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.id.Key = keyPEM
+	c.id.Certificate = resp.Certificate
+	c.id.CACertificate = resp.CACertificate
+	if err := c.save(); err != nil {
+		return err
+	}
+	log.Println("[AGENT] Key replaced at the console's request")
+	return nil
+}
+
+// GetToken is the hub client's token callback. A revoked agent stops reconnecting.
+func (c *credentials) GetToken(centrifuge.ConnectionTokenEvent) (string, error) {
+	token, err := c.HubToken(context.Background())
+	var refused *apiError
+	if errors.As(err, &refused) && refused.Status == http.StatusForbidden {
+		log.Printf("[AGENT] Revoked by the console (%s); enrol again with a new token", refused.Message)
+		return "", centrifuge.ErrUnauthorized
+	}
+	return token, err
+}
+
+// tlsConfig presents the agent's current certificate to the console and the hub.
+func (c *credentials) tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if path := os.Getenv(envServerCA); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("%s has no certificates", path)
+		}
+		cfg.RootCAs = pool
+	}
+	cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.id.Certificate == "" {
+			return &tls.Certificate{}, nil // Not enrolled yet
+		}
+		cert, err := tls.X509KeyPair([]byte(c.id.Certificate), []byte(c.id.Key))
+		if err != nil {
+			return nil, err
+		}
+		return &cert, nil
+	}
+	return cfg, nil
+}
+
+func (c *credentials) post(ctx context.Context, path string, in, out any) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.server+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&e)
+		return &apiError{Status: resp.StatusCode, Message: e.Error}
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
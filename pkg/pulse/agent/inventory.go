@@ -0,0 +1,72 @@
+package agent
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"sent/pkg/pulse/common"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+// CollectInventory gathers what this machine has installed and running for the console's
+// inventory. Parts that cannot be read are logged and left empty rather than failing the
+// whole report.
+func (c *Collector) CollectInventory() (*common.InventoryReport, error) {
+	host, err := c.GetHostInfo()
+	if err != nil {
+		return nil, err
+	}
+	software := host.Software
+	host.Software = nil
+
+	services, err := GetServices()
+	if err != nil {
+		log.Printf("Inventory: listing services: %v", err)
+	}
+	patches, err := GetPendingPatches()
+	if err != nil {
+		log.Printf("Inventory: scanning patches: %v", err)
+	}
+
+	sort.Slice(software, func(i, j int) bool { return software[i].Name < software[j].Name })
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+	sort.Slice(patches, func(i, j int) bool { return patches[i].ID < patches[j].ID })
+
+	return &common.InventoryReport{
+		CollectedAt: time.Now().UTC(),
+		Host:        *host,
+		Hardware:    collectHardware(),
+		Software:    software,
+		Services:    services,
+		Patches:     patches,
+	}, nil
+}
+
+func collectHardware() common.HardwareInfo {
+	hw := readSystemIdentity()
+
+	if infos, err := cpu.Info(); err == nil && len(infos) > 0 {
+		hw.CPUModel = infos[0].ModelName
+	}
+	if n, err := cpu.Counts(false); err == nil {
+		hw.CPUCores = n
+	}
+	if vm, err := mem.VirtualMemory(); err == nil {
+		hw.MemoryTotal = vm.Total
+	}
+	if parts, err := disk.Partitions(false); err == nil {
+		for _, p := range parts {
+			usage, err := disk.Usage(p.Mountpoint)
+			if err != nil || usage.Total == 0 {
+				continue
+			}
+			hw.Disks = append(hw.Disks, common.DiskInfo{Mount: p.Mountpoint, FSType: p.Fstype, Total: usage.Total})
+		}
+		sort.Slice(hw.Disks, func(i, j int) bool { return hw.Disks[i].Mount < hw.Disks[j].Mount })
+	}
+	return hw
+}
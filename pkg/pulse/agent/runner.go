@@ -7,8 +7,6 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 // Global state for RDP session
@@ -18,12 +16,16 @@ var currentRDP *RemoteDesktopSession
 func Run() {
 	fmt.Println("[AGENT] Starting SENT pulse agent...")
 
-	// 1. Identify Agent (For MVP, generate or load UUID)
-	agentID := getAgentID()
+	// 1. Identify Agent, enrolling it with the console the first time
+	collector := NewCollector()
+	creds, err := loadCredentials(envOr(envServer, defaultServer), os.Getenv(envEnrollToken), collector)
+	if err != nil {
+		log.Fatalf("[AGENT] %v", err)
+	}
+	agentID := creds.AgentID()
 	log.Printf("[AGENT] Agent ID: %s", agentID)
 
 	// 2. Initialize Components
-	collector := NewCollector()
 	cache, err := NewLocalCache("agent_cache.db")
 	if err != nil {
 		log.Fatalf("[AGENT] Failed to initialize cache: %v", err)
@@ -31,17 +33,27 @@ func Run() {
 	defer cache.Close()
 
 	// 3. Connect to Real-time Hub
-	// TODO: Get token from backend or use static for MVP
-	client := NewPulseClient("ws://localhost:8000/connection/websocket", agentID, "")
+	tlsConfig, err := creds.tlsConfig()
+	if err != nil {
+		log.Fatalf("[AGENT] %v", err)
+	}
+	client := NewPulseClient(envOr(envHub, defaultHub), agentID, creds.GetToken, tlsConfig)
 	if err := client.Connect(); err != nil {
 		log.Printf("[AGENT] Warning: Failed to connect to hub: %v. Data will be cached.", err)
 	}
 	defer client.Close()
 
-	// 4. Setup Control Handler
+	// 4. Setup Control Handler: requests from the console, and the plain commands before them
+	server := NewRPCServer(client.Transport(), agentID)
 	client.SubscribeControl(func(cmd string) {
+		if server.Serve([]byte(cmd)) {
+			return
+		}
 		log.Printf("[AGENT] Received remote command: %s", cmd)
 		switch cmd {
+		case "revoked":
+			log.Println("[AGENT] Revoked by the console; disconnecting. Enrol again with a new token.")
+			go client.Close()
 		case "reboot":
 			log.Println("[AGENT] Rebooting system via command...")
 			// exec.Command("reboot").Run() // Commented for safety in MVP
@@ -124,16 +136,11 @@ func Run() {
 	fmt.Println("[AGENT] Shutdown signal received.")
 }
 
-func getAgentID() string {
-	// For MVP: try reading from a local file, otherwise generate
-	idFile := ".agent_id"
-	data, err := os.ReadFile(idFile)
-	if err == nil {
-		return string(data)
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
 	}
-	id := uuid.New().String()
-	_ = os.WriteFile(idFile, []byte(id), 0644)
-	return id
+	return fallback
 }
 
 func drainCache(cache *LocalCache, client *PulseClient) {
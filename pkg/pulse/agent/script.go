@@ -0,0 +1,247 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"sent/pkg/pulse/rpc"
+)
+
+// outputFlush is how often a running script's output is sent to the console.
+const outputFlush = 500 * time.Millisecond
+
+// ScriptRunner runs the console's scripts, one process tree per execution, and streams their
+// output back on the agent's output channel.
+//
+// Scripts are sandboxed from the agent: each runs in a private temporary directory that is
+// removed afterwards, with a minimal environment that leaves out the agent's own (e.g. its
+// enrollment token), no standard input, and its whole process tree killed when it times out
+// or is cancelled.
+type ScriptRunner struct {
+	transport rpc.Transport
+	agentID   string
+
+	mu      sync.Mutex
+	running map[int]context.CancelFunc
+}
+
+func NewScriptRunner(t rpc.Transport, agentID string) *ScriptRunner {
+	return &ScriptRunner{transport: t, agentID: agentID, running: make(map[int]context.CancelFunc)}
+}
+
+// errCancelled marks a run stopped by the console.
+var errCancelled = errors.New("cancelled")
+
+// Run runs a script to the end, its timeout or its cancellation.
+func (r *ScriptRunner) Run(ctx context.Context, p rpc.ScriptParams) (*rpc.ScriptResult, error) {
+	interpreter, ext, err := interpreterFor(p.Type)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.Duration(p.Timeout) * time.Second
+	if timeout <= 0 || timeout > rpc.MaxScriptTimeout {
+		timeout = rpc.MaxScriptTimeout
+	}
+
+	dir, err := os.MkdirTemp("", "sent-script-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "script"+ext)
+	if err := os.WriteFile(path, []byte(p.Content), 0o700); err != nil {
+		return nil, err
+	}
+
+	// The console's request deadline is not the script's; only the timeout and a cancel stop it.
+	runCtx, cancel := context.WithTimeoutCause(context.WithoutCancel(ctx), timeout, context.DeadlineExceeded)
+	defer cancel()
+	stop, cancelled := context.WithCancelCause(runCtx)
+	defer cancelled(nil)
+	if !r.track(p.ExecutionID, func() { cancelled(errCancelled) }) {
+		return nil, fmt.Errorf("execution %d is already running", p.ExecutionID)
+	}
+	defer r.untrack(p.ExecutionID)
+
+	cmd := exec.Command(interpreter[0], append(interpreter[1:], path)...)
+	cmd.Dir = dir
+	cmd.Env = scriptEnv(dir, p.ExecutionID)
+	cmd.Stdin = nil
+	sandbox(cmd)
+
+	out := &outputStream{runner: r, executionID: p.ExecutionID}
+	cmd.Stdout = out.writer("stdout")
+	cmd.Stderr = out.writer("stderr")
+
+	log.Printf("[AGENT] Running %s script for execution %d", p.Type, p.ExecutionID)
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %s: %w", interpreter[0], err)
+	}
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	var waitErr error
+	select {
+	case waitErr = <-done:
+	case <-stop.Done():
+		killTree(cmd)
+		waitErr = <-done
+	}
+	chunks, truncated := out.close()
+
+	result := &rpc.ScriptResult{Chunks: chunks, Truncated: truncated}
+	switch {
+	case errors.Is(context.Cause(stop), errCancelled):
+		result.Cancelled = true
+		result.ExitCode = -1
+	case errors.Is(context.Cause(stop), context.DeadlineExceeded):
+		result.TimedOut = true
+		result.ExitCode = -1
+	case waitErr != nil:
+		var exit *exec.ExitError
+		if !errors.As(waitErr, &exit) {
+			return nil, waitErr
+		}
+		result.ExitCode = exit.ExitCode()
+	}
+	return result, nil
+}
+
+// Cancel stops a running execution.
+func (r *ScriptRunner) Cancel(executionID int) error {
+	r.mu.Lock()
+	cancel, ok := r.running[executionID]
+	r.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("execution %d is not running", executionID)
+	}
+	cancel()
+	return nil
+}
+
+func (r *ScriptRunner) track(id int, cancel context.CancelFunc) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.running[id]; ok {
+		return false
+	}
+	r.running[id] = cancel
+	return true
+}
+
+func (r *ScriptRunner) untrack(id int) {
+	r.mu.Lock()
+	delete(r.running, id)
+	r.mu.Unlock()
+}
+
+// scriptEnv is all a script gets of the environment: enough to find programs and a place to
+// write.
+func scriptEnv(dir string, executionID int) []string {
+	env := []string{
+		"HOME=" + dir,
+		"TMPDIR=" + dir,
+		"TEMP=" + dir,
+		"TMP=" + dir,
+		fmt.Sprintf("SENT_EXECUTION_ID=%d", executionID),
+	}
+	for _, key := range inheritedEnv {
+		if v, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+v)
+		}
+	}
+	return env
+}
+
+// outputStream batches a script's stdout and stderr and publishes them in order.
+type outputStream struct {
+	runner      *ScriptRunner
+	executionID int
+
+	mu        sync.Mutex
+	pending   bytes.Buffer
+	stream    string // Of what is pending
+	seq       int
+	sent      int
+	truncated bool
+	timer     *time.Timer
+}
+
+type streamWriter struct {
+	out  *outputStream
+	name string
+}
+
+func (w streamWriter) Write(p []byte) (int, error) {
+	w.out.write(w.name, p)
+	return len(p), nil
+}
+
+func (o *outputStream) writer(name string) io.Writer {
+	return streamWriter{out: o, name: name}
+}
+
+func (o *outputStream) write(stream string, p []byte) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.stream != stream && o.pending.Len() > 0 {
+		o.flushLocked()
+	}
+	o.stream = stream
+	if room := rpc.MaxScriptOutput - o.sent - o.pending.Len(); len(p) > room {
+		p = p[:max(room, 0)]
+		o.truncated = true
+	}
+	o.pending.Write(p)
+	if o.pending.Len() >= rpc.ChunkSize/2 {
+		o.flushLocked()
+		return
+	}
+	if o.timer == nil && o.pending.Len() > 0 {
+		o.timer = time.AfterFunc(outputFlush, func() {
+			o.mu.Lock()
+			defer o.mu.Unlock()
+			o.timer = nil
+			o.flushLocked()
+		})
+	}
+}
+
+func (o *outputStream) flushLocked() {
+	if o.pending.Len() == 0 {
+		return
+	}
+	msg := rpc.ScriptOutput{ExecutionID: o.executionID, Seq: o.seq, Stream: o.stream, Data: o.pending.String()}
+	o.sent += o.pending.Len()
+	o.pending.Reset()
+	o.seq++
+	data, _ := json.Marshal(msg)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := o.runner.transport.Publish(ctx, rpc.OutputChannel(o.runner.agentID), data); err != nil {
+		log.Printf("[AGENT] Failed to send output of execution %d: %v", o.executionID, err)
+	}
+}
+
+// close sends what is left and returns how many pieces were sent and whether any output was
+// dropped.
+func (o *outputStream) close() (int, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.timer != nil {
+		o.timer.Stop()
+		o.timer = nil
+	}
+	o.flushLocked()
+	return o.seq, o.truncated
+}
@@ -0,0 +1,40 @@
+//go:build !windows
+
+package agent
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// inheritedEnv is what a script keeps of the agent's environment.
+var inheritedEnv = []string{"PATH", "LANG", "LC_ALL", "TZ"}
+
+func interpreterFor(scriptType string) ([]string, string, error) {
+	switch scriptType {
+	case "sh":
+		return []string{"/bin/sh"}, ".sh", nil
+	case "ps1":
+		pwsh, err := exec.LookPath("pwsh")
+		if err != nil {
+			return nil, "", fmt.Errorf("PowerShell (pwsh) is not installed on this machine")
+		}
+		return []string{pwsh, "-NoProfile", "-NonInteractive", "-File"}, ".ps1", nil
+	}
+	return nil, "", fmt.Errorf("unsupported script type %q", scriptType)
+}
+
+// sandbox starts the script in a process group of its own, so it can be killed with all it
+// started.
+func sandbox(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.WaitDelay = 5 * time.Second
+}
+
+func killTree(cmd *exec.Cmd) {
+	if cmd.Process != nil {
+		syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
@@ -0,0 +1,47 @@
+//go:build windows
+
+package agent
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// inheritedEnv is what a script keeps of the agent's environment.
+var inheritedEnv = []string{
+	"PATH", "PATHEXT", "SystemRoot", "SystemDrive", "windir", "ComSpec",
+	"PROCESSOR_ARCHITECTURE", "NUMBER_OF_PROCESSORS", "OS",
+	"ProgramFiles", "ProgramFiles(x86)", "ProgramData", "PSModulePath",
+}
+
+func interpreterFor(scriptType string) ([]string, string, error) {
+	switch scriptType {
+	case "ps1":
+		return []string{"powershell.exe", "-NoProfile", "-NonInteractive", "-ExecutionPolicy", "Bypass", "-File"}, ".ps1", nil
+	case "sh":
+		sh, err := exec.LookPath("sh")
+		if err != nil {
+			return nil, "", fmt.Errorf("no sh on this machine; install Git for Windows or use a ps1 script")
+		}
+		return []string{sh}, ".sh", nil
+	}
+	return nil, "", fmt.Errorf("unsupported script type %q", scriptType)
+}
+
+// sandbox starts the script hidden, in a process group of its own.
+func sandbox(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		HideWindow:    true,
+		CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP,
+	}
+	cmd.WaitDelay = 5 * time.Second
+}
+
+func killTree(cmd *exec.Cmd) {
+	if cmd.Process != nil {
+		exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+	}
+}
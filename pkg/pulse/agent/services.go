@@ -5,13 +5,11 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+
+	"sent/pkg/pulse/common"
 )
 
-type ServiceInfo struct {
-	Name        string `json:"name"`
-	DisplayName string `json:"displayName"`
-	Status      string `json:"status"` // "running", "stopped", "unknown"
-}
+type ServiceInfo = common.ServiceInfo
 
 // GetServices returns a list of installed services
 // Note: This is an MVP implementation. A full production version would use
@@ -0,0 +1,210 @@
+// Package alerting evaluates Pulse alert rules. Each rule's targets are resolved every time
+// the rules are evaluated; an agent past the rule's threshold gets an open alert, which is
+// resolved once it is back under it or no longer targeted.
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"sent/ent"
+	"sent/ent/agentalert"
+	"sent/ent/alertrule"
+	"sent/pkg/pulse/targeting"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+// sampleMaxAge is how recent an agent's telemetry has to be for its CPU and memory to count.
+const sampleMaxAge = 10 * time.Minute
+
+// Sample is an agent's latest telemetry.
+type Sample struct {
+	At            time.Time
+	CPUPercent    float64
+	MemoryPercent float64
+}
+
+// Metrics gives agents' latest telemetry since a time, by agent ID.
+type Metrics interface {
+	Latest(ctx context.Context, agentIDs []int, since time.Time) (map[int]Sample, error)
+}
+
+// Reading is what a rule measured on an agent.
+type Reading struct {
+	Value   float64
+	Firing  bool
+	Message string
+}
+
+// Check measures a rule's metric on an agent. It reports false when there is nothing to
+// measure, e.g. no recent telemetry; any alert open for the agent then stays as it is.
+func Check(rule *ent.AlertRule, a *ent.Agent, sample *Sample, now time.Time) (Reading, bool) {
+	var r Reading
+	switch rule.Metric {
+	case alertrule.MetricOffline:
+		r.Value = now.Sub(a.LastSeen).Minutes()
+		r.Firing = r.Value >= rule.Threshold
+		r.Message = fmt.Sprintf("%s has not been seen for %s (threshold %.0f minutes)", a.Hostname, now.Sub(a.LastSeen).Round(time.Minute), rule.Threshold)
+		return r, true
+	case alertrule.MetricCPU:
+		if sample == nil {
+			return r, false
+		}
+		r.Value = sample.CPUPercent
+		r.Message = fmt.Sprintf("CPU usage on %s is %.1f%% (threshold %.0f%%)", a.Hostname, r.Value, rule.Threshold)
+	case alertrule.MetricMemory:
+		if sample == nil {
+			return r, false
+		}
+		r.Value = sample.MemoryPercent
+		r.Message = fmt.Sprintf("Memory usage on %s is %.1f%% (threshold %.0f%%)", a.Hostname, r.Value, rule.Threshold)
+	default:
+		return r, false
+	}
+	r.Firing = r.Value >= rule.Threshold
+	return r, true
+}
+
+// EvaluateArgs evaluates every alert rule.
+type EvaluateArgs struct{}
+
+func (EvaluateArgs) Kind() string { return "pulse:alert_evaluate" }
+
+// EvaluateWorker raises and resolves the alerts of every rule for the agents it targets now.
+type EvaluateWorker struct {
+	river.WorkerDefaults[EvaluateArgs]
+	db      *ent.Client
+	metrics Metrics
+}
+
+// NewEvaluateWorker evaluates the rules with telemetry from metrics; without it only offline
+// rules can fire.
+func NewEvaluateWorker(db *ent.Client, metrics Metrics) *EvaluateWorker {
+	return &EvaluateWorker{db: db, metrics: metrics}
+}
+
+func (w *EvaluateWorker) Work(ctx context.Context, j *river.Job[EvaluateArgs]) error {
+	rules, err := w.db.AlertRule.Query().WithTenant().All(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, r := range rules {
+		if err := w.evaluate(ctx, r, now); err != nil {
+			log.Printf("[PULSE] Alert rule %d (%s) not evaluated: %v", r.ID, r.Name, err)
+		}
+	}
+	return nil
+}
+
+func (w *EvaluateWorker) evaluate(ctx context.Context, rule *ent.AlertRule, now time.Time) error {
+	open, err := rule.QueryAlerts().Where(agentalert.ResolvedAtIsNil()).WithAgent().All(ctx)
+	if err != nil {
+		return err
+	}
+	openFor := make(map[int]*ent.AgentAlert, len(open))
+	for _, a := range open {
+		if a.Edges.Agent != nil {
+			openFor[a.Edges.Agent.ID] = a
+		}
+	}
+
+	var agents []*ent.Agent
+	if rule.Enabled {
+		// Groups and tags are resolved now, so the rule covers whoever is in them today.
+		if agents, err = targeting.Resolve(ctx, w.db, rule.Edges.Tenant.ID, rule.Targets); err != nil {
+			return err
+		}
+	}
+	samples := map[int]Sample{}
+	if rule.Metric != alertrule.MetricOffline && len(agents) > 0 && w.metrics != nil {
+		ids := make([]int, len(agents))
+		for i, a := range agents {
+			ids[i] = a.ID
+		}
+		if samples, err = w.metrics.Latest(ctx, ids, now.Add(-sampleMaxAge)); err != nil {
+			return err
+		}
+	}
+
+	for _, a := range agents {
+		alert := openFor[a.ID]
+		delete(openFor, a.ID)
+		var sample *Sample
+		if s, ok := samples[a.ID]; ok {
+			sample = &s
+		}
+		reading, ok := Check(rule, a, sample, now)
+		switch {
+		case !ok:
+		case reading.Firing && alert == nil:
+			err = w.db.AgentAlert.Create().
+				SetRule(rule).
+				SetAgent(a).
+				SetSeverity(agentalert.Severity(rule.Severity)).
+				SetMessage(reading.Message).
+				SetValue(reading.Value).
+				Exec(ctx)
+		case !reading.Firing && alert != nil:
+			err = w.db.AgentAlert.UpdateOne(alert).SetResolvedAt(now).Exec(ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	// The rule was disabled, or these agents are no longer among its targets.
+	for _, alert := range openFor {
+		if err := w.db.AgentAlert.UpdateOne(alert).SetResolvedAt(now).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TelemetryMetrics reads agents' latest telemetry from the pulse_telemetry table.
+type TelemetryMetrics struct {
+	pool *pgxpool.Pool
+}
+
+func NewTelemetryMetrics(pool *pgxpool.Pool) *TelemetryMetrics {
+	return &TelemetryMetrics{pool: pool}
+}
+
+func (m *TelemetryMetrics) Latest(ctx context.Context, agentIDs []int, since time.Time) (map[int]Sample, error) {
+	// Telemetry is keyed by the agent's ID on the hub.
+	keys := make([]string, len(agentIDs))
+	for i, id := range agentIDs {
+		keys[i] = strconv.Itoa(id)
+	}
+	rows, err := m.pool.Query(ctx, `
+		SELECT DISTINCT ON (agent_id) agent_id::text, time, coalesce(cpu_percent, 0),
+			coalesce(mem_used, 0), coalesce(mem_total, 0)
+		FROM pulse_telemetry
+		WHERE agent_id::text = ANY($1) AND time >= $2
+		ORDER BY agent_id, time DESC`, keys, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[int]Sample)
+	for rows.Next() {
+		var key string
+		var s Sample
+		var used, total int64
+		if err := rows.Scan(&key, &s.At, &s.CPUPercent, &used, &total); err != nil {
+			return nil, err
+		}
+		if total > 0 {
+			s.MemoryPercent = float64(used) / float64(total) * 100
+		}
+		if id, err := strconv.Atoi(key); err == nil {
+			out[id] = s
+		}
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,43 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+
+	"sent/ent"
+	"sent/ent/alertrule"
+)
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	a := &ent.Agent{Hostname: "srv-01", LastSeen: now.Add(-20 * time.Minute)}
+	sample := &Sample{At: now, CPUPercent: 92.5, MemoryPercent: 40}
+
+	cases := []struct {
+		metric    alertrule.Metric
+		threshold float64
+		sample    *Sample
+		ok        bool
+		firing    bool
+		value     float64
+	}{
+		{alertrule.MetricCPU, 90, sample, true, true, 92.5},
+		{alertrule.MetricCPU, 95, sample, true, false, 92.5},
+		{alertrule.MetricMemory, 40, sample, true, true, 40},
+		// No recent telemetry: nothing to measure.
+		{alertrule.MetricCPU, 90, nil, false, false, 0},
+		// Offline is measured from when the agent was last seen, telemetry or not.
+		{alertrule.MetricOffline, 15, nil, true, true, 20},
+		{alertrule.MetricOffline, 30, sample, true, false, 20},
+	}
+	for _, c := range cases {
+		rule := &ent.AlertRule{Metric: c.metric, Threshold: c.threshold}
+		r, ok := Check(rule, a, c.sample, now)
+		if ok != c.ok || r.Firing != c.firing || (ok && r.Value != c.value) {
+			t.Errorf("%s >= %v: got %+v, %v; want value %v, firing %v, %v", c.metric, c.threshold, r, ok, c.value, c.firing, c.ok)
+		}
+		if ok && r.Message == "" {
+			t.Errorf("%s >= %v: no message", c.metric, c.threshold)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package pulse
+
+import (
+	"context"
+	"fmt"
+	"sent/ent"
+	"sent/ent/agent"
+	"sent/ent/agentalert"
+	"sent/ent/alertrule"
+	"sent/ent/tenant"
+	"sent/pkg/pulse/targeting"
+	"strings"
+	"time"
+)
+
+// AlertManager handles alert rules and the alerts they raise
+type AlertManager struct {
+	client *ent.Client
+}
+
+func NewAlertManager(client *ent.Client) *AlertManager {
+	return &AlertManager{client: client}
+}
+
+// AlertRuleSpec is what a rule watches, on which agents, and how loudly it alerts.
+type AlertRuleSpec struct {
+	Name      string
+	Targets   []string
+	Metric    string  // cpu, memory or offline
+	Threshold float64 // Percent for cpu and memory; minutes unseen for offline
+	Severity  string  // info, warning or critical
+	Enabled   bool
+}
+
+func (m *AlertManager) validate(ctx context.Context, tenantID int, spec *AlertRuleSpec) error {
+	spec.Name = strings.TrimSpace(spec.Name)
+	if spec.Name == "" {
+		return fmt.Errorf("rule name is required")
+	}
+	if len(spec.Targets) == 0 {
+		return fmt.Errorf("a rule needs at least one target")
+	}
+	if err := alertrule.MetricValidator(alertrule.Metric(spec.Metric)); err != nil {
+		return fmt.Errorf("unknown metric %q", spec.Metric)
+	}
+	if spec.Severity == "" {
+		spec.Severity = string(alertrule.DefaultSeverity)
+	}
+	if err := alertrule.SeverityValidator(alertrule.Severity(spec.Severity)); err != nil {
+		return fmt.Errorf("unknown severity %q", spec.Severity)
+	}
+	switch {
+	case spec.Metric == string(alertrule.MetricOffline) && spec.Threshold < 1:
+		return fmt.Errorf("an offline rule needs at least 1 minute")
+	case spec.Metric != string(alertrule.MetricOffline) && (spec.Threshold <= 0 || spec.Threshold > 100):
+		return fmt.Errorf("a usage threshold is a percentage between 0 and 100")
+	}
+	return targeting.Validate(ctx, m.client, tenantID, spec.Targets)
+}
+
+// CreateRule creates an alert rule for the tenant
+func (m *AlertManager) CreateRule(ctx context.Context, tenantID int, spec AlertRuleSpec, createdBy string) (*ent.AlertRule, error) {
+	if err := m.validate(ctx, tenantID, &spec); err != nil {
+		return nil, err
+	}
+	r, err := m.client.AlertRule.Create().
+		SetTenantID(tenantID).
+		SetName(spec.Name).
+		SetTargets(spec.Targets).
+		SetMetric(alertrule.Metric(spec.Metric)).
+		SetThreshold(spec.Threshold).
+		SetSeverity(alertrule.Severity(spec.Severity)).
+		SetEnabled(spec.Enabled).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("a rule named %q already exists", spec.Name)
+	}
+	return r, err
+}
+
+// UpdateRule replaces a rule's definition. Its open alerts are settled at the next evaluation.
+func (m *AlertManager) UpdateRule(ctx context.Context, tenantID, id int, spec AlertRuleSpec) (*ent.AlertRule, error) {
+	if err := m.validate(ctx, tenantID, &spec); err != nil {
+		return nil, err
+	}
+	r, err := m.rule(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	r, err = r.Update().
+		SetName(spec.Name).
+		SetTargets(spec.Targets).
+		SetMetric(alertrule.Metric(spec.Metric)).
+		SetThreshold(spec.Threshold).
+		SetSeverity(alertrule.Severity(spec.Severity)).
+		SetEnabled(spec.Enabled).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("a rule named %q already exists", spec.Name)
+	}
+	return r, err
+}
+
+// DeleteRule deletes a rule and the alerts it raised
+func (m *AlertManager) DeleteRule(ctx context.Context, tenantID, id int) error {
+	r, err := m.rule(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	tx, err := m.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.AgentAlert.Delete().Where(agentalert.HasRuleWith(alertrule.ID(r.ID))).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.AlertRule.DeleteOneID(r.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// ListRules returns the tenant's alert rules
+func (m *AlertManager) ListRules(ctx context.Context, tenantID int) ([]*ent.AlertRule, error) {
+	return m.client.AlertRule.Query().
+		Where(alertrule.HasTenantWith(tenant.ID(tenantID))).
+		Order(ent.Asc(alertrule.FieldName)).
+		All(ctx)
+}
+
+// ListAlerts returns the tenant's alerts, newest first: the open ones, or with resolved also
+// those that have cleared
+func (m *AlertManager) ListAlerts(ctx context.Context, tenantID int, resolved bool, limit int) ([]*ent.AgentAlert, error) {
+	q := m.client.AgentAlert.Query().Where(agentalert.HasRuleWith(alertrule.HasTenantWith(tenant.ID(tenantID))))
+	if !resolved {
+		q = q.Where(agentalert.ResolvedAtIsNil())
+	}
+	return q.WithRule().
+		WithAgent(func(q *ent.AgentQuery) { q.Select(agent.FieldHostname) }).
+		Order(ent.Desc(agentalert.FieldRaisedAt), ent.Desc(agentalert.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// Acknowledge marks the tenant's alerts with the given IDs as seen, or all that are not yet
+// when ids is empty. It returns how many it acknowledged.
+func (m *AlertManager) Acknowledge(ctx context.Context, tenantID int, ids []int, by string) (int, error) {
+	upd := m.client.AgentAlert.Update().
+		Where(
+			agentalert.HasRuleWith(alertrule.HasTenantWith(tenant.ID(tenantID))),
+			agentalert.AcknowledgedAtIsNil(),
+		)
+	if len(ids) > 0 {
+		upd.Where(agentalert.IDIn(ids...))
+	}
+	return upd.SetAcknowledgedAt(time.Now()).SetAcknowledgedBy(by).Save(ctx)
+}
+
+func (m *AlertManager) rule(ctx context.Context, tenantID, id int) (*ent.AlertRule, error) {
+	r, err := m.client.AlertRule.Query().
+		Where(alertrule.ID(id), alertrule.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("alert rule %d not found", id)
+	}
+	return r, err
+}
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+)
+
+// EnrollRequest is what a new agent sends to enrol, with the enrollment token an admin issued.
+// The agent keeps its private key; the CSR proves it holds it.
+type EnrollRequest struct {
+	Token    string `json:"token"`
+	Hostname string `json:"hostname"`
+	OS       string `json:"os"`
+	Arch     string `json:"arch"`
+	IP       string `json:"ip"`
+	MAC      string `json:"mac"`
+	Version  string `json:"version"`
+	CSR      string `json:"csr"` // PEM certificate request signed with the agent's new key
+}
+
+// EnrollResponse is the identity issued to an enrolled agent.
+type EnrollResponse struct {
+	AgentID       string `json:"agent_id"`
+	TenantID      int    `json:"tenant_id"`
+	Certificate   string `json:"certificate"`    // PEM client certificate
+	CACertificate string `json:"ca_certificate"` // PEM of the tenant's agent authority
+}
+
+// TokenRequest asks for a hub token. The signature, by the agent's key, covers ProofMessage.
+type TokenRequest struct {
+	AgentID   string `json:"agent_id"`
+	Timestamp int64  `json:"timestamp"` // Unix seconds
+	Nonce     string `json:"nonce"`     // Random; the console accepts each proof once
+	Signature []byte `json:"signature"` // ASN.1 ECDSA signature of the SHA-256 of ProofMessage
+}
+
+// TokenResponse carries a hub token.
+type TokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Rekey     bool      `json:"rekey,omitempty"` // The console wants the agent to replace its key
+}
+
+// RekeyRequest replaces an agent's key. It is signed with the key being replaced.
+type RekeyRequest struct {
+	TokenRequest
+	CSR string `json:"csr"` // PEM certificate request signed with the new key
+}
+
+// What a proof is for; a proof made for one cannot be used for the other.
+const (
+	ProofToken = "token"
+	ProofRekey = "rekey"
+)
+
+// ProofMessage is what an agent signs to prove it holds its key: what the proof is for, the
+// moment and nonce that make it single-use, and the digest of what the request carries (the
+// new CSR of a rekey; empty for a token).
+func ProofMessage(purpose, agentID string, timestamp int64, nonce, payload string) []byte {
+	return []byte(fmt.Sprintf("sent-pulse:%s:%s:%d:%s:%x", purpose, agentID, timestamp, nonce, sha256.Sum256([]byte(payload))))
+}
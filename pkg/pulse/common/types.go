@@ -39,3 +39,84 @@ type SystemMetrics struct {
 	Load15      float64   `json:"load_15"`
     ActiveUser  string    `json:"active_user,omitempty"`
 }
+
+// ServiceInfo is an installed service and whether it is running.
+type ServiceInfo struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Status      string `json:"status"` // "running", "stopped", "unknown"
+}
+
+// PatchInfo represents a pending or installed patch
+type PatchInfo struct {
+	ID        string `json:"id"`        // KB number or package name
+	Title     string `json:"title"`     // Description
+	Severity  string `json:"severity"`  // Critical, Important, Moderate, Low
+	Size      int64  `json:"size"`      // Bytes
+	Published string `json:"published"` // Date string
+	Installed bool   `json:"installed"`
+}
+
+// DiskInfo is a mounted filesystem.
+type DiskInfo struct {
+	Mount  string `json:"mount"`
+	FSType string `json:"fstype"`
+	Total  uint64 `json:"total"`
+}
+
+// HardwareInfo describes the machine an agent runs on.
+type HardwareInfo struct {
+	Manufacturer string     `json:"manufacturer,omitempty"`
+	Model        string     `json:"model,omitempty"`
+	SerialNumber string     `json:"serial_number,omitempty"`
+	CPUModel     string     `json:"cpu_model,omitempty"`
+	CPUCores     int        `json:"cpu_cores,omitempty"`
+	MemoryTotal  uint64     `json:"memory_total,omitempty"`
+	Disks        []DiskInfo `json:"disks,omitempty"`
+}
+
+// InventoryReport is what an agent has installed and running, as reported for inventory.
+// Host.Software is left empty; the software list is Software.
+type InventoryReport struct {
+	CollectedAt time.Time      `json:"collected_at"`
+	Host        HostInfo       `json:"host"`
+	Hardware    HardwareInfo   `json:"hardware"`
+	Software    []SoftwareInfo `json:"software"`
+	Services    []ServiceInfo  `json:"services"`
+	Patches     []PatchInfo    `json:"patches"`
+}
+
+// SoftwareChange is a package whose version changed between two inventory reports.
+type SoftwareChange struct {
+	Name       string `json:"name"`
+	OldVersion string `json:"old_version"`
+	NewVersion string `json:"new_version"`
+}
+
+// ServiceChange is a service whose status changed between two inventory reports.
+type ServiceChange struct {
+	Name      string `json:"name"`
+	OldStatus string `json:"old_status"`
+	NewStatus string `json:"new_status"`
+}
+
+// InventoryChanges is what changed from one inventory report to the next.
+type InventoryChanges struct {
+	SoftwareAdded   []SoftwareInfo   `json:"software_added,omitempty"`
+	SoftwareRemoved []SoftwareInfo   `json:"software_removed,omitempty"`
+	SoftwareUpdated []SoftwareChange `json:"software_updated,omitempty"`
+	ServicesAdded   []ServiceInfo    `json:"services_added,omitempty"`
+	ServicesRemoved []ServiceInfo    `json:"services_removed,omitempty"`
+	ServicesChanged []ServiceChange  `json:"services_changed,omitempty"`
+	PatchesPending  []PatchInfo      `json:"patches_pending,omitempty"`
+	PatchesCleared  []PatchInfo      `json:"patches_cleared,omitempty"`
+	HardwareChanged bool             `json:"hardware_changed,omitempty"`
+	HostChanged     bool             `json:"host_changed,omitempty"`
+}
+
+// Empty reports whether nothing changed.
+func (c InventoryChanges) Empty() bool {
+	return len(c.SoftwareAdded) == 0 && len(c.SoftwareRemoved) == 0 && len(c.SoftwareUpdated) == 0 &&
+		len(c.ServicesAdded) == 0 && len(c.ServicesRemoved) == 0 && len(c.ServicesChanged) == 0 &&
+		len(c.PatchesPending) == 0 && len(c.PatchesCleared) == 0 && !c.HardwareChanged && !c.HostChanged
+}
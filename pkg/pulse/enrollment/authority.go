@@ -0,0 +1,214 @@
+package enrollment
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"sent/ent"
+	"sent/ent/agentauthority"
+	"sent/ent/tenant"
+	"sent/pkg/crypto"
+)
+
+const (
+	authorityValidity   = 10 * 365 * 24 * time.Hour
+	certificateValidity = 365 * 24 * time.Hour
+)
+
+var (
+	ErrBadCSR             = errors.New("certificate request is invalid")
+	ErrCertificateExpired = errors.New("agent certificate has expired; enrol again")
+)
+
+// authority is a tenant's agent certificate authority.
+type authority struct {
+	cert        *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certificate string // PEM
+}
+
+// issuedCertificate is a client certificate signed for an agent.
+type issuedCertificate struct {
+	certificate string // PEM
+	publicKey   string // PEM
+	serial      string
+	expires     time.Time
+}
+
+func randomSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+// newAuthority creates a tenant's certificate authority, returning its key as PEM too.
+func newAuthority(tenantID int, now time.Time) (*authority, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, "", err
+	}
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, "", err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("SENT Pulse agents, tenant %d", tenantID), Organization: []string{tenantOrg(tenantID)}},
+		NotBefore:             now.Add(-5 * time.Minute),
+		NotAfter:              now.Add(authorityValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, "", err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, "", err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, "", err
+	}
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	return &authority{cert: cert, key: key, certificate: certPEM}, keyPEM, nil
+}
+
+func tenantOrg(tenantID int) string {
+	return "tenant-" + strconv.Itoa(tenantID)
+}
+
+func parseAuthority(certPEM, keyPEM string) (*authority, error) {
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("agent authority key is not PEM")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &authority{cert: cert, key: key, certificate: certPEM}, nil
+}
+
+func parseCertificate(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("certificate is not PEM")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// parseCSR reads a certificate request and checks it is signed by the P-256 key it is for.
+func parseCSR(csrPEM string) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, ErrBadCSR
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadCSR, err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadCSR, err)
+	}
+	if pub, ok := csr.PublicKey.(*ecdsa.PublicKey); !ok || pub.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("%w: the key must be ECDSA P-256", ErrBadCSR)
+	}
+	return csr, nil
+}
+
+// issue signs a client certificate for an agent's key. The subject is the agent and its
+// tenant, whatever the request asked for.
+func (a *authority) issue(csr *x509.CertificateRequest, agentID string, tenantID int, now time.Time) (*issuedCertificate, error) {
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, err
+	}
+	expires := now.Add(certificateValidity)
+	if expires.After(a.cert.NotAfter) {
+		expires = a.cert.NotAfter
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: agentID, Organization: []string{tenantOrg(tenantID)}},
+		NotBefore:    now.Add(-5 * time.Minute),
+		NotAfter:     expires,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, a.cert, csr.PublicKey, a.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign agent certificate: %w", err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(csr.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &issuedCertificate{
+		certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		publicKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})),
+		serial:      serial.Text(16),
+		expires:     expires,
+	}, nil
+}
+
+// verify checks an agent certificate was issued by this authority and is current.
+func (a *authority) verify(cert *x509.Certificate, now time.Time) error {
+	pool := x509.NewCertPool()
+	pool.AddCert(a.cert)
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:       pool,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
+
+// authority loads a tenant's agent authority, creating it with the tenant's first agent.
+func (s *Service) authority(ctx context.Context, tx *ent.Tx, tenantID int) (*authority, error) {
+	rec, err := tx.AgentAuthority.Query().Where(agentauthority.HasTenantWith(tenant.ID(tenantID))).Only(ctx)
+	if err == nil {
+		keyPEM, err := crypto.Decrypt(rec.PrivateKeyEncrypted)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt agent authority key: %w", err)
+		}
+		return parseAuthority(rec.Certificate, keyPEM)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	ca, keyPEM, err := newAuthority(tenantID, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create agent authority: %w", err)
+	}
+	encrypted, err := crypto.Encrypt(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.AgentAuthority.Create().
+		SetTenantID(tenantID).
+		SetCertificate(ca.certificate).
+		SetPrivateKeyEncrypted(encrypted).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store agent authority: %w", err)
+	}
+	return ca, nil
+}
@@ -0,0 +1,213 @@
+package enrollment
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"sent/ent"
+	"sent/pkg/pulse/common"
+	"sent/pkg/pulse/rpc"
+)
+
+func TestEnrollmentToken(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	token, err := signToken(secret, 7, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tenantID, err := parseToken(secret, token, now); err != nil || tenantID != 7 {
+		t.Fatalf("parse = %d, %v", tenantID, err)
+	}
+	if _, err := parseToken(secret, token, now.Add(2*time.Hour)); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("expired token = %v", err)
+	}
+	if _, err := parseToken([]byte("other"), token, now); !errors.Is(err, ErrTokenInvalid) {
+		t.Errorf("token under another secret = %v", err)
+	}
+	// Pointing a token at another tenant breaks its signature.
+	if _, err := parseToken(secret, "8"+strings.TrimPrefix(token, "7"), now); !errors.Is(err, ErrTokenInvalid) {
+		t.Errorf("tampered token = %v", err)
+	}
+	again, _ := signToken(secret, 7, now.Add(time.Hour))
+	if again == token || hashToken(again) == hashToken(token) {
+		t.Error("tokens are not unique")
+	}
+}
+
+func TestLiveIdentity(t *testing.T) {
+	now := time.Now()
+	later, earlier := now.Add(time.Hour), now.Add(-time.Hour)
+	cases := []struct {
+		name string
+		a    ent.Agent
+		live bool
+	}{
+		{"enrolled", ent.Agent{Certificate: "pem", CertificateExpiresAt: &later}, true},
+		{"revoked", ent.Agent{Certificate: "pem", CertificateExpiresAt: &later, RevokedAt: &earlier}, false},
+		{"expired", ent.Agent{Certificate: "pem", CertificateExpiresAt: &earlier}, false},
+		{"never enrolled", ent.Agent{}, false},
+	}
+	for _, c := range cases {
+		if got := hasLiveIdentity(&c.a, now); got != c.live {
+			t.Errorf("%s: live = %v", c.name, got)
+		}
+	}
+}
+
+func newCSR(t *testing.T) (*ecdsa.PrivateKey, *x509.CertificateRequest) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "till-01", Organization: []string{"tenant-1"}},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := parseCSR(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, csr
+}
+
+func TestIssueAndProve(t *testing.T) {
+	now := time.Now()
+	ca, keyPEM, err := newAuthority(2, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parseAuthority(ca.certificate, keyPEM); err != nil {
+		t.Fatalf("stored authority: %v", err)
+	}
+	key, csr := newCSR(t)
+	issued, err := ca.issue(csr, "42", 2, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := parseCertificate(issued.certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The subject is the agent and the tenant of the token, not what the request claimed.
+	if cert.Subject.CommonName != "42" || cert.Subject.Organization[0] != "tenant-2" {
+		t.Errorf("subject = %v", cert.Subject)
+	}
+	if cert.SerialNumber.Text(16) != issued.serial {
+		t.Error("serial not recorded")
+	}
+	if err := ca.verify(cert, now); err != nil {
+		t.Errorf("verify: %v", err)
+	}
+	other, _, _ := newAuthority(3, now)
+	if err := other.verify(cert, now); err == nil {
+		t.Error("certificate verified under another tenant's authority")
+	}
+
+	sign := func(agentID string, ts int64, k *ecdsa.PrivateKey) common.TokenRequest {
+		digest := sha256.Sum256(common.ProofMessage(common.ProofToken, agentID, ts, "n1", ""))
+		sig, _ := ecdsa.SignASN1(rand.Reader, k, digest[:])
+		return common.TokenRequest{AgentID: "42", Timestamp: ts, Nonce: "n1", Signature: sig}
+	}
+	if err := verifyProof(cert, sign("42", now.Unix(), key), common.ProofToken, "", now); err != nil {
+		t.Errorf("proof: %v", err)
+	}
+	if err := verifyProof(cert, sign("42", now.Add(-time.Hour).Unix(), key), common.ProofToken, "", now); !errors.Is(err, ErrBadProof) {
+		t.Errorf("replayed proof = %v", err)
+	}
+	if err := verifyProof(cert, sign("43", now.Unix(), key), common.ProofToken, "", now); !errors.Is(err, ErrBadProof) {
+		t.Errorf("proof for another agent = %v", err)
+	}
+	stranger, _ := newCSR(t)
+	if err := verifyProof(cert, sign("42", now.Unix(), stranger), common.ProofToken, "", now); !errors.Is(err, ErrBadProof) {
+		t.Errorf("proof with another key = %v", err)
+	}
+	// A proof is for one request: a hub token proof does not rekey, nor sign another CSR.
+	if err := verifyProof(cert, sign("42", now.Unix(), key), common.ProofRekey, "", now); !errors.Is(err, ErrBadProof) {
+		t.Errorf("hub token proof used to rekey = %v", err)
+	}
+	if err := verifyProof(cert, sign("42", now.Unix(), key), common.ProofToken, "csr", now); !errors.Is(err, ErrBadProof) {
+		t.Errorf("proof with another payload = %v", err)
+	}
+	unsigned := sign("42", now.Unix(), key)
+	unsigned.Nonce = ""
+	if err := verifyProof(cert, unsigned, common.ProofToken, "", now); !errors.Is(err, ErrBadProof) {
+		t.Errorf("proof without a nonce = %v", err)
+	}
+
+	// Each proof is accepted once.
+	s := &Service{proofs: &usedProofs{}}
+	proof := sign("42", now.Unix(), key)
+	if err := s.checkProof(cert, proof, common.ProofToken, "", now); err != nil {
+		t.Errorf("first use: %v", err)
+	}
+	if err := s.checkProof(cert, proof, common.ProofToken, "", now.Add(time.Minute)); !errors.Is(err, ErrProofUsed) {
+		t.Errorf("second use = %v", err)
+	}
+
+	if _, err := parseCSR("not a csr"); !errors.Is(err, ErrBadCSR) {
+		t.Errorf("bad csr = %v", err)
+	}
+}
+
+func TestHubToken(t *testing.T) {
+	secret := []byte("hub")
+	now := time.Unix(1_700_000_000, 0)
+	token, expires, err := signHubToken(secret, "42", 2, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("token has %d parts", len(parts))
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if sig, _ := base64.RawURLEncoding.DecodeString(parts[2]); !hmac.Equal(sig, mac.Sum(nil)) {
+		t.Error("signature does not verify")
+	}
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	var claims hubClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatal(err)
+	}
+	if claims.Sub != "42" || claims.Exp != expires.Unix() || expires.Sub(now) != TokenTTL {
+		t.Errorf("claims = %+v", claims)
+	}
+	// The agent may only listen on its own control channel and publish on its own channels.
+	allowed := map[string]string{}
+	for _, c := range claims.Caps {
+		for _, ch := range c.Channels {
+			allowed[ch] = strings.Join(c.Allow, ",")
+		}
+	}
+	want := map[string]string{
+		rpc.ControlChannel("42"):   "sub",
+		rpc.ReplyChannel("42"):     "pub",
+		rpc.TelemetryChannel("42"): "pub",
+		rpc.OutputChannel("42"):    "pub",
+	}
+	if len(allowed) != len(want) {
+		t.Errorf("caps = %v", allowed)
+	}
+	for ch, allow := range want {
+		if allowed[ch] != allow {
+			t.Errorf("%s allows %q, want %q", ch, allowed[ch], allow)
+		}
+	}
+}
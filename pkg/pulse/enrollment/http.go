@@ -0,0 +1,145 @@
+package enrollment
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+
+	"sent/pkg/pulse/common"
+)
+
+// Handler serves enrollment, hub tokens and rekeying to agents:
+//
+//	POST /api/pulse/enroll        EnrollRequest  -> EnrollResponse
+//	POST /api/pulse/agent/token   TokenRequest   -> TokenResponse
+//	POST /api/pulse/agent/rekey   RekeyRequest   -> EnrollResponse
+func (s *Service) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/pulse/enroll", func(w http.ResponseWriter, r *http.Request) {
+		var req common.EnrollRequest
+		if !readJSON(w, r, &req) {
+			return
+		}
+		req.IP = remoteIP(r, req.IP)
+		respond(w, func() (any, error) { return s.Enroll(r.Context(), req) })
+	})
+	mux.HandleFunc("POST /api/pulse/agent/token", func(w http.ResponseWriter, r *http.Request) {
+		var req common.TokenRequest
+		if !readJSON(w, r, &req) {
+			return
+		}
+		respond(w, func() (any, error) { return s.IssueHubToken(r.Context(), req, peerCertificate(r)) })
+	})
+	mux.HandleFunc("POST /api/pulse/agent/rekey", func(w http.ResponseWriter, r *http.Request) {
+		var req common.RekeyRequest
+		if !readJSON(w, r, &req) {
+			return
+		}
+		respond(w, func() (any, error) { return s.Rekey(r.Context(), req, peerCertificate(r)) })
+	})
+	return mux
+}
+
+// TLSConfig serves with cert and asks agents for their client certificates, trusting every
+// tenant's agent authority. Enrolling agents have none yet, so presenting one stays optional
+// and the token endpoints hold the agent to it when it does.
+func (s *Service) TLSConfig(cert tls.Certificate) *tls.Config {
+	base := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		MinVersion:   tls.VersionTLS12,
+	}
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		pool, err := s.authorities(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		c := base.Clone()
+		c.GetConfigForClient = nil
+		c.ClientCAs = pool
+		return c, nil
+	}
+	return base
+}
+
+// authorities pools the agent authorities of all tenants.
+func (s *Service) authorities(ctx context.Context) (*x509.CertPool, error) {
+	recs, err := s.client.AgentAuthority.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	for _, rec := range recs {
+		pool.AppendCertsFromPEM([]byte(rec.Certificate))
+	}
+	return pool, nil
+}
+
+func peerCertificate(r *http.Request) *x509.Certificate {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil
+	}
+	return r.TLS.PeerCertificates[0]
+}
+
+// remoteIP prefers the address the agent reported, which is its own view of the LAN.
+func remoteIP(r *http.Request, reported string) string {
+	if reported != "" {
+		return reported
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(v); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+		return false
+	}
+	return true
+}
+
+func respond(w http.ResponseWriter, fn func() (any, error)) {
+	body, err := fn()
+	if err != nil {
+		status := statusOf(err)
+		if status == http.StatusInternalServerError {
+			log.Printf("[PULSE] Enrollment error: %v", err)
+			err = errors.New("internal error")
+		}
+		writeJSON(w, status, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, ErrTokenInvalid), errors.Is(err, ErrTokenExpired), errors.Is(err, ErrBadProof),
+		errors.Is(err, ErrNotEnrolled), errors.Is(err, ErrCertificateExpired), errors.Is(err, ErrCertificateMismatch),
+		errors.Is(err, ErrProofUsed):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrTokenUsed), errors.Is(err, ErrRevoked):
+		return http.StatusForbidden
+	case errors.Is(err, ErrAlreadyEnrolled), errors.Is(err, ErrAmbiguousMachine):
+		return http.StatusConflict
+	case errors.Is(err, ErrBadCSR), errors.Is(err, ErrIncomplete):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	data, _ := json.Marshal(body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
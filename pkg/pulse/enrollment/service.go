@@ -3,67 +3,333 @@ package enrollment
 import (
 	"context"
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"sent/ent"
 	"sent/ent/agent"
+	"sent/ent/agentenrollmenttoken"
+	"sent/ent/tenant"
+	"sent/pkg/pulse/common"
+)
+
+var (
+	ErrTokenInvalid = errors.New("enrollment token is invalid")
+	ErrTokenExpired = errors.New("enrollment token has expired")
+	ErrTokenUsed    = errors.New("enrollment token has already been used")
+	ErrIncomplete   = errors.New("hostname, os, arch and version are required")
 
-	"github.com/google/uuid"
+	// An enrolled agent is only enrolled again once an admin has revoked it.
+	ErrAlreadyEnrolled = errors.New("machine is already enrolled; revoke it before enrolling it again")
+	// More than one agent of the tenant has the machine's hostname and MAC, so it is not clear
+	// which one it is.
+	ErrAmbiguousMachine = errors.New("more than one agent matches this machine; remove the duplicates before enrolling it again")
 )
 
+// The secrets come from the environment. The development ones are public, so they are only
+// used when SENT_PULSE_DEV is set.
+const (
+	envEnrollmentSecret = "SENT_PULSE_ENROLLMENT_SECRET"
+	envHubSecret        = "SENT_PULSE_HUB_SECRET" // Centrifugo's token_hmac_secret_key
+	envDevMode          = "SENT_PULSE_DEV"
+	devEnrollmentSecret = "sent-dev-enrollment-secret"
+	devHubSecret        = "sent-dev-hub-secret"
+)
+
+// ErrNotConfigured is returned when the enrollment and hub secrets are not set.
+var ErrNotConfigured = fmt.Errorf("%s and %s must be set to enrol agents (or %s=1 for development)",
+	envEnrollmentSecret, envHubSecret, envDevMode)
+
 type Service struct {
 	client *ent.Client
 	secret []byte
+	// hubSecret signs the tokens agents connect to the hub with.
+	hubSecret []byte
+	// proofs are the agents' proofs of identity already accepted.
+	proofs *usedProofs
+	now    func() time.Time
 }
 
-func NewService(client *ent.Client, secret string) *Service {
+func NewService(client *ent.Client, secret, hubSecret string) *Service {
 	return &Service{
-		client: client,
-		secret: []byte(secret),
+		client:    client,
+		secret:    []byte(secret),
+		hubSecret: []byte(hubSecret),
+		proofs:    &usedProofs{},
+		now:       time.Now,
 	}
 }
 
-// GenerateToken creates a short-lived HMAC enrollment token
-func (s *Service) GenerateToken(tenantID int, duration time.Duration) string {
-	expires := time.Now().Add(duration).Unix()
-	msg := fmt.Sprintf("%d:%d", tenantID, expires)
+// NewServiceFromEnv takes its secrets from the environment. Without them it refuses, unless
+// development mode allows the built-in ones.
+func NewServiceFromEnv(client *ent.Client) (*Service, error) {
+	secret, hubSecret := os.Getenv(envEnrollmentSecret), os.Getenv(envHubSecret)
+	if secret == "" || hubSecret == "" {
+		if dev, _ := strconv.ParseBool(os.Getenv(envDevMode)); !dev {
+			return nil, ErrNotConfigured
+		}
+		fmt.Println("[WARNING] Using development Pulse enrollment secrets. Do not use in production!")
+	}
+	if secret == "" {
+		secret = devEnrollmentSecret
+	}
+	if hubSecret == "" {
+		hubSecret = devHubSecret
+	}
+	return NewService(client, secret, hubSecret), nil
+}
 
-	mac := hmac.New(sha256.New, s.secret)
+// signToken makes an enrollment token for a tenant: tenant.expires.nonce.signature, signed
+// with HMAC-SHA256.
+func signToken(secret []byte, tenantID int, expires time.Time) (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	msg := fmt.Sprintf("%d.%d.%s", tenantID, expires.Unix(), base64.RawURLEncoding.EncodeToString(nonce))
+	mac := hmac.New(sha256.New, secret)
 	mac.Write([]byte(msg))
-	signature := hex.EncodeToString(mac.Sum(nil))
+	return msg + "." + hex.EncodeToString(mac.Sum(nil)), nil
+}
 
-	return fmt.Sprintf("%s:%d:%s", signature, expires, msg)
+// parseToken checks a token's signature and expiry and returns the tenant it enrols into.
+func parseToken(secret []byte, token string, now time.Time) (int, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return 0, ErrTokenInvalid
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strings.Join(parts[:3], ".")))
+	sig, err := hex.DecodeString(parts[3])
+	if err != nil || !hmac.Equal(sig, mac.Sum(nil)) {
+		return 0, ErrTokenInvalid
+	}
+	tenantID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, ErrTokenInvalid
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, ErrTokenInvalid
+	}
+	if !now.Before(time.Unix(expires, 0)) {
+		return 0, ErrTokenExpired
+	}
+	return tenantID, nil
 }
 
-// Enroll validates the token and registers a new agent
-func (s *Service) Enroll(ctx context.Context, token string, hostname, os, arch, ip, macAddr string) (*ent.Agent, error) {
-	// Simple token parsing: signature:expires:payload
-	// In production, use a more robust parsing logic
-
-	agentID := uuid.New().String()
-
-	// Create Agent in DB
-	newAgent, err := s.client.Agent.Create().
-		SetHostname(hostname).
-		SetOs(os).
-		SetArch(arch).
-		SetIP(ip).
-		SetMAC(macAddr).
-		SetVersion("1.0.0").
-		SetStatus(agent.StatusOnline).
-		SetLastSeen(time.Now()).
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateToken creates a single-use enrollment token for a tenant, valid for duration.
+func (s *Service) GenerateToken(ctx context.Context, tenantID int, duration time.Duration, createdBy string) (string, time.Time, error) {
+	expires := s.now().Add(duration)
+	token, err := signToken(s.secret, tenantID, expires)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	err = s.client.AgentEnrollmentToken.Create().
+		SetTenantID(tenantID).
+		SetTokenHash(hashToken(token)).
+		SetExpiresAt(expires).
+		SetCreatedBy(createdBy).
+		Exec(ctx)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to store enrollment token: %w", err)
+	}
+	return token, expires, nil
+}
+
+// Enroll validates the token and registers the agent with the tenant it was issued for,
+// signing a client certificate for the key in its CSR. A machine enrolling again keeps its
+// agent record and gets a new identity, but only once it has no live one: the agent was
+// revoked, or its certificate expired. Otherwise a token holder could take over an enrolled
+// agent by enrolling under its hostname and MAC.
+func (s *Service) Enroll(ctx context.Context, req common.EnrollRequest) (*common.EnrollResponse, error) {
+	now := s.now()
+	tenantID, err := parseToken(s.secret, req.Token, now)
+	if err != nil {
+		return nil, err
+	}
+	if req.Hostname == "" || req.OS == "" || req.Arch == "" || req.Version == "" {
+		return nil, ErrIncomplete
+	}
+	if req.IP == "" {
+		req.IP = "unknown"
+	}
+	if req.MAC == "" {
+		req.MAC = "unknown"
+	}
+	csr, err := parseCSR(req.CSR)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tok, err := tx.AgentEnrollmentToken.Query().
+		Where(agentenrollmenttoken.TokenHash(hashToken(req.Token)), agentenrollmenttoken.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrTokenInvalid // Signed, but never issued or since deleted
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Claiming the token and enrolling commit together; of two agents racing on one token,
+	// one gets it.
+	n, err := tx.AgentEnrollmentToken.Update().
+		Where(agentenrollmenttoken.ID(tok.ID), agentenrollmenttoken.UsedAtIsNil()).
+		SetUsedAt(now).
 		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrTokenUsed
+	}
 
+	a, err := findMachine(ctx, tx, tenantID, req.Hostname, req.MAC)
+	switch {
+	case err != nil:
+		return nil, err
+	case a == nil:
+		a, err = tx.Agent.Create().
+			SetTenantID(tenantID).
+			SetHostname(req.Hostname).
+			SetOs(req.OS).
+			SetArch(req.Arch).
+			SetIP(req.IP).
+			SetMAC(req.MAC).
+			SetVersion(req.Version).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create agent record: %w", err)
+		}
+	case hasLiveIdentity(a, now):
+		return nil, ErrAlreadyEnrolled
+	}
+
+	ca, err := s.authority(ctx, tx, tenantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create agent record: %w", err)
+		return nil, err
+	}
+	agentID := strconv.Itoa(a.ID)
+	issued, err := ca.issue(csr, agentID, tenantID, now)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.Agent.UpdateOne(a).
+		SetTenantID(tenantID).
+		SetOs(req.OS).
+		SetArch(req.Arch).
+		SetIP(req.IP).
+		SetVersion(req.Version).
+		SetStatus(agent.StatusOnline).
+		SetLastSeen(now).
+		SetPublicKey(issued.publicKey).
+		SetCertificate(issued.certificate).
+		SetCertificateSerial(issued.serial).
+		SetCertificateExpiresAt(issued.expires).
+		SetEnrolledAt(now).
+		ClearRevokedAt().
+		SetRekeyRequested(false).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record agent identity: %w", err)
+	}
+	if err := tx.AgentEnrollmentToken.UpdateOne(tok).SetAgentID(a.ID).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
 
-	// Silent Handshake with SENTnexus: Create Asset record
-	// This would typically call pkg/nexus/discovery
-	fmt.Printf("[PULSE] Agent %s enrolled. ID: %s\n", hostname, agentID)
+	fmt.Printf("[PULSE] Agent %s enrolled with tenant %d. ID: %s\n", req.Hostname, tenantID, agentID)
+	return &common.EnrollResponse{
+		AgentID:       agentID,
+		TenantID:      tenantID,
+		Certificate:   issued.certificate,
+		CACertificate: ca.certificate,
+	}, nil
+}
 
-	return newAgent, nil
-}
\ No newline at end of file
+// findMachine returns the tenant's agent record for a machine enrolling again, or nil for a
+// new machine. Machines are matched on hostname and MAC within the tenant only; one that
+// reported no MAC cannot be told apart from others of the same name, so it is always new.
+func findMachine(ctx context.Context, tx *ent.Tx, tenantID int, hostname, mac string) (*ent.Agent, error) {
+	if mac == "unknown" {
+		return nil, nil
+	}
+	matches, err := tx.Agent.Query().
+		Where(agent.Hostname(hostname), agent.MAC(mac), agent.HasTenantWith(tenant.ID(tenantID))).
+		Limit(2).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	}
+	return nil, ErrAmbiguousMachine
+}
+
+// hasLiveIdentity reports whether an agent holds a certificate it can still get hub tokens
+// with.
+func hasLiveIdentity(a *ent.Agent, now time.Time) bool {
+	if a.RevokedAt != nil || a.Certificate == "" {
+		return false
+	}
+	return a.CertificateExpiresAt == nil || a.CertificateExpiresAt.After(now)
+}
+
+// Revoke stops an agent getting hub tokens. Its current token lapses within TokenTTL; it has
+// to enrol again with a new token to come back.
+func (s *Service) Revoke(ctx context.Context, tenantID, agentID int) error {
+	n, err := s.client.Agent.Update().
+		Where(agent.ID(agentID), agent.HasTenantWith(tenant.ID(tenantID))).
+		SetRevokedAt(s.now()).
+		SetRekeyRequested(false).
+		SetStatus(agent.StatusOffline).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("agent %d not found", agentID)
+	}
+	return nil
+}
+
+// RequestRekey has an agent replace its key and certificate the next time it asks for a hub
+// token.
+func (s *Service) RequestRekey(ctx context.Context, tenantID, agentID int) error {
+	n, err := s.client.Agent.Update().
+		Where(agent.ID(agentID), agent.HasTenantWith(tenant.ID(tenantID)), agent.RevokedAtIsNil()).
+		SetRekeyRequested(true).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("agent %d not found or revoked", agentID)
+	}
+	return nil
+}
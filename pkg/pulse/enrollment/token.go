@@ -0,0 +1,238 @@
+package enrollment
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"sent/ent"
+	"sent/ent/agent"
+	"sent/pkg/pulse/common"
+	"sent/pkg/pulse/rpc"
+)
+
+// TokenTTL is how long a hub token lasts. Agents fetch a new one to reconnect, so revoking an
+// agent cuts it off within this time.
+const TokenTTL = 15 * time.Minute
+
+// proofSkew is how far an agent's clock may be from ours.
+const proofSkew = 5 * time.Minute
+
+var (
+	ErrNotEnrolled         = errors.New("agent is not enrolled")
+	ErrRevoked             = errors.New("agent has been revoked")
+	ErrBadProof            = errors.New("agent proof of identity is invalid")
+	ErrCertificateMismatch = errors.New("client certificate does not belong to the agent")
+	ErrProofUsed           = errors.New("agent proof of identity has already been used")
+)
+
+// hubClaims are the claims of a Centrifugo connection token. Caps limit the agent to its own
+// channels: it takes requests on its control channel and publishes telemetry, replies and
+// script output.
+type hubClaims struct {
+	Sub  string         `json:"sub"`
+	Iat  int64          `json:"iat"`
+	Exp  int64          `json:"exp"`
+	Info map[string]any `json:"info"`
+	Caps []hubCap       `json:"caps"`
+}
+
+type hubCap struct {
+	Channels []string `json:"channels"`
+	Allow    []string `json:"allow"`
+}
+
+// signHubToken makes an HS256 JWT with Centrifugo's token_hmac_secret_key.
+func signHubToken(secret []byte, agentID string, tenantID int, now time.Time) (string, time.Time, error) {
+	expires := now.Add(TokenTTL)
+	claims := hubClaims{
+		Sub:  agentID,
+		Iat:  now.Unix(),
+		Exp:  expires.Unix(),
+		Info: map[string]any{"tenant_id": tenantID},
+		Caps: []hubCap{
+			{Channels: []string{rpc.ControlChannel(agentID)}, Allow: []string{"sub"}},
+			{Channels: []string{rpc.TelemetryChannel(agentID), rpc.ReplyChannel(agentID), rpc.OutputChannel(agentID)}, Allow: []string{"pub"}},
+		},
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	enc := base64.RawURLEncoding
+	msg := enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + enc.EncodeToString(payload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(msg))
+	return msg + "." + enc.EncodeToString(mac.Sum(nil)), expires, nil
+}
+
+// verifyProof checks an agent signed ProofMessage for purpose and payload, at a recent moment,
+// with the key in cert.
+func verifyProof(cert *x509.Certificate, req common.TokenRequest, purpose, payload string, now time.Time) error {
+	at := time.Unix(req.Timestamp, 0)
+	if at.Before(now.Add(-proofSkew)) || at.After(now.Add(proofSkew)) {
+		return fmt.Errorf("%w: timestamp is out of range", ErrBadProof)
+	}
+	if req.Nonce == "" || len(req.Nonce) > 64 {
+		return fmt.Errorf("%w: nonce is missing", ErrBadProof)
+	}
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return ErrBadProof
+	}
+	digest := sha256.Sum256(common.ProofMessage(purpose, req.AgentID, req.Timestamp, req.Nonce, payload))
+	if !ecdsa.VerifyASN1(pub, digest[:], req.Signature) {
+		return ErrBadProof
+	}
+	return nil
+}
+
+// usedProofs remembers the proofs accepted while their timestamps are still in range, so each
+// is accepted once.
+type usedProofs struct {
+	mu   sync.Mutex
+	seen map[string]time.Time // Agent ID and nonce -> when the proof goes out of range
+}
+
+// claim records a proof, and reports false if it was already used.
+func (u *usedProofs) claim(req common.TokenRequest, now time.Time) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.seen == nil {
+		u.seen = make(map[string]time.Time)
+	}
+	for k, until := range u.seen {
+		if now.After(until) {
+			delete(u.seen, k)
+		}
+	}
+	key := req.AgentID + ":" + req.Nonce
+	if _, used := u.seen[key]; used {
+		return false
+	}
+	u.seen[key] = time.Unix(req.Timestamp, 0).Add(proofSkew)
+	return true
+}
+
+// checkProof verifies a proof and spends it.
+func (s *Service) checkProof(cert *x509.Certificate, req common.TokenRequest, purpose, payload string, now time.Time) error {
+	if err := verifyProof(cert, req, purpose, payload, now); err != nil {
+		return err
+	}
+	if !s.proofs.claim(req, now) {
+		return ErrProofUsed
+	}
+	return nil
+}
+
+// identify finds the agent a request is from and checks it proved it holds the agent's key,
+// for purpose and payload. When the request came over mutual TLS, peer is the certificate
+// presented and has to be the agent's current one.
+func (s *Service) identify(ctx context.Context, req common.TokenRequest, purpose, payload string, peer *x509.Certificate) (*ent.Agent, *x509.Certificate, error) {
+	id, err := strconv.Atoi(req.AgentID)
+	if err != nil {
+		return nil, nil, ErrNotEnrolled
+	}
+	a, err := s.client.Agent.Query().Where(agent.ID(id)).WithTenant().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, ErrNotEnrolled
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if a.RevokedAt != nil {
+		return nil, nil, ErrRevoked
+	}
+	if a.Certificate == "" || a.Edges.Tenant == nil {
+		return nil, nil, ErrNotEnrolled
+	}
+	cert, err := parseCertificate(a.Certificate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("stored certificate for agent %d: %w", id, err)
+	}
+	now := s.now()
+	if now.After(cert.NotAfter) {
+		return nil, nil, ErrCertificateExpired
+	}
+	if peer != nil && peer.SerialNumber.Text(16) != a.CertificateSerial {
+		return nil, nil, ErrCertificateMismatch
+	}
+	if err := s.checkProof(cert, req, purpose, payload, now); err != nil {
+		return nil, nil, err
+	}
+	return a, cert, nil
+}
+
+// IssueHubToken gives an enrolled agent a token to connect to the hub with.
+func (s *Service) IssueHubToken(ctx context.Context, req common.TokenRequest, peer *x509.Certificate) (*common.TokenResponse, error) {
+	a, _, err := s.identify(ctx, req, common.ProofToken, "", peer)
+	if err != nil {
+		return nil, err
+	}
+	token, expires, err := signHubToken(s.hubSecret, req.AgentID, a.Edges.Tenant.ID, s.now())
+	if err != nil {
+		return nil, err
+	}
+	return &common.TokenResponse{Token: token, ExpiresAt: expires, Rekey: a.RekeyRequested}, nil
+}
+
+// Rekey replaces an agent's key and certificate. The request is signed with the old key.
+func (s *Service) Rekey(ctx context.Context, req common.RekeyRequest, peer *x509.Certificate) (*common.EnrollResponse, error) {
+	a, _, err := s.identify(ctx, req.TokenRequest, common.ProofRekey, req.CSR, peer)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := parseCSR(req.CSR)
+	if err != nil {
+		return nil, err
+	}
+	tenantID := a.Edges.Tenant.ID
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	ca, err := s.authority(ctx, tx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	issued, err := ca.issue(csr, req.AgentID, tenantID, s.now())
+	if err != nil {
+		return nil, err
+	}
+	// Only the certificate the request was proved against is replaced; a concurrent rekey or
+	// revocation wins.
+	n, err := tx.Agent.Update().
+		Where(agent.ID(a.ID), agent.CertificateSerial(a.CertificateSerial), agent.RevokedAtIsNil()).
+		SetPublicKey(issued.publicKey).
+		SetCertificate(issued.certificate).
+		SetCertificateSerial(issued.serial).
+		SetCertificateExpiresAt(issued.expires).
+		SetRekeyRequested(false).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrCertificateMismatch
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &common.EnrollResponse{
+		AgentID:       req.AgentID,
+		TenantID:      tenantID,
+		Certificate:   issued.certificate,
+		CACertificate: ca.certificate,
+	}, nil
+}
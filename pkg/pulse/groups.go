@@ -0,0 +1,202 @@
+package pulse
+
+import (
+	"context"
+	"fmt"
+	"sent/ent"
+	"sent/ent/agent"
+	"sent/ent/alertrule"
+	"sent/ent/devicegroup"
+	"sent/ent/job"
+	"sent/ent/patchpolicy"
+	"sent/ent/tenant"
+	"sent/pkg/pulse/targeting"
+	"strings"
+)
+
+// GroupManager handles device groups and agent tags
+type GroupManager struct {
+	client *ent.Client
+}
+
+func NewGroupManager(client *ent.Client) *GroupManager {
+	return &GroupManager{client: client}
+}
+
+// GroupSpec describes a device group: static with the agents it lists, or dynamic with a
+// filter.
+type GroupSpec struct {
+	Name        string
+	Description string
+	Filter      string
+	AgentIDs    []int
+}
+
+func (m *GroupManager) validate(ctx context.Context, tenantID int, spec *GroupSpec) error {
+	spec.Name = strings.TrimSpace(spec.Name)
+	spec.Filter = strings.TrimSpace(spec.Filter)
+	if spec.Name == "" {
+		return fmt.Errorf("group name is required")
+	}
+	if spec.Filter != "" {
+		if len(spec.AgentIDs) > 0 {
+			return fmt.Errorf("a group either lists its devices or has a filter, not both")
+		}
+		if _, err := targeting.ParseFilter(spec.Filter); err != nil {
+			return err
+		}
+		return nil
+	}
+	n, err := m.client.Agent.Query().
+		Where(agent.IDIn(spec.AgentIDs...), agent.HasTenantWith(tenant.ID(tenantID))).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if n != len(spec.AgentIDs) {
+		return fmt.Errorf("some of the devices were not found")
+	}
+	return nil
+}
+
+// CreateGroup creates a device group for the tenant
+func (m *GroupManager) CreateGroup(ctx context.Context, tenantID int, spec GroupSpec, createdBy string) (*ent.DeviceGroup, error) {
+	if err := m.validate(ctx, tenantID, &spec); err != nil {
+		return nil, err
+	}
+	g, err := m.client.DeviceGroup.Create().
+		SetTenantID(tenantID).
+		SetName(spec.Name).
+		SetDescription(spec.Description).
+		SetFilter(spec.Filter).
+		AddAgentIDs(spec.AgentIDs...).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("a group named %q already exists", spec.Name)
+	}
+	return g, err
+}
+
+// UpdateGroup replaces a group's definition
+func (m *GroupManager) UpdateGroup(ctx context.Context, tenantID, id int, spec GroupSpec) (*ent.DeviceGroup, error) {
+	if err := m.validate(ctx, tenantID, &spec); err != nil {
+		return nil, err
+	}
+	g, err := m.group(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	g, err = g.Update().
+		SetName(spec.Name).
+		SetDescription(spec.Description).
+		SetFilter(spec.Filter).
+		ClearAgents().
+		AddAgentIDs(spec.AgentIDs...).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("a group named %q already exists", spec.Name)
+	}
+	return g, err
+}
+
+// DeleteGroup deletes a group no job, patch policy or alert rule targets
+func (m *GroupManager) DeleteGroup(ctx context.Context, tenantID, id int) error {
+	g, err := m.group(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	target := targeting.Target{Kind: targeting.KindGroup, ID: id}.String()
+	jobs, err := m.client.Job.Query().Where(job.HasTenantWith(tenant.ID(tenantID))).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, j := range jobs {
+		if targets(j.Targets, target) {
+			return fmt.Errorf("group %q is targeted by job %q", g.Name, j.Name)
+		}
+	}
+	policies, err := m.client.PatchPolicy.Query().Where(patchpolicy.HasTenantWith(tenant.ID(tenantID))).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		if targets(p.Targets, target) {
+			return fmt.Errorf("group %q is targeted by patch policy %q", g.Name, p.Name)
+		}
+	}
+	rules, err := m.client.AlertRule.Query().Where(alertrule.HasTenantWith(tenant.ID(tenantID))).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range rules {
+		if targets(r.Targets, target) {
+			return fmt.Errorf("group %q is targeted by alert rule %q", g.Name, r.Name)
+		}
+	}
+	return m.client.DeviceGroup.DeleteOne(g).Exec(ctx)
+}
+
+// targets reports whether a target list includes target.
+func targets(list []string, target string) bool {
+	for _, t := range list {
+		if strings.EqualFold(strings.TrimSpace(t), target) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListGroups returns the tenant's groups, with the agents static groups list
+func (m *GroupManager) ListGroups(ctx context.Context, tenantID int) ([]*ent.DeviceGroup, error) {
+	return m.client.DeviceGroup.Query().
+		Where(devicegroup.HasTenantWith(tenant.ID(tenantID))).
+		WithAgents(func(q *ent.AgentQuery) { q.Select(agent.FieldID) }).
+		Order(ent.Asc(devicegroup.FieldName)).
+		All(ctx)
+}
+
+func (m *GroupManager) group(ctx context.Context, tenantID, id int) (*ent.DeviceGroup, error) {
+	g, err := m.client.DeviceGroup.Query().
+		Where(devicegroup.ID(id), devicegroup.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("group %d not found", id)
+	}
+	return g, err
+}
+
+// SetAgentTags replaces an agent's tags
+func (m *GroupManager) SetAgentTags(ctx context.Context, tenantID, agentID int, tags []string) ([]string, error) {
+	tags, err := targeting.NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	n, err := m.client.Agent.Update().
+		Where(agent.ID(agentID), agent.HasTenantWith(tenant.ID(tenantID))).
+		SetTags(tags).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("agent %d not found", agentID)
+	}
+	return tags, nil
+}
+
+// ListTags returns every tag in use on the tenant's agents
+func (m *GroupManager) ListTags(ctx context.Context, tenantID int) ([]string, error) {
+	agents, err := m.client.Agent.Query().
+		Where(agent.HasTenantWith(tenant.ID(tenantID))).
+		Select(agent.FieldTags).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var all []string
+	for _, a := range agents {
+		all = append(all, a.Tags...)
+	}
+	return targeting.NormalizeTags(all)
+}
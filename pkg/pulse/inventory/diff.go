@@ -0,0 +1,144 @@
+// Package inventory keeps what Pulse agents have: the hardware, software, services and pending
+// patches each reports, as versioned snapshots per agent. A report is compared with the
+// agent's latest snapshot and only becomes a new version when something changed, recording
+// what did, so the history answers what was installed or removed since a given time. The
+// software each agent has now is also kept one row per package, for fleet-wide searches.
+package inventory
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	"sent/pkg/pulse/common"
+)
+
+// Hash fingerprints a report's contents, leaving out when it was collected, so two reports
+// of an unchanged machine hash alike.
+func Hash(r *common.InventoryReport) string {
+	c := normalize(r)
+	b, _ := json.Marshal(struct {
+		Host     common.HostInfo
+		Hardware common.HardwareInfo
+		Software []common.SoftwareInfo
+		Services []common.ServiceInfo
+		Patches  []common.PatchInfo
+	}{c.Host, c.Hardware, c.Software, c.Services, c.Patches})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalize returns a copy of a report in a canonical order, without the software list the
+// host info may carry.
+func normalize(r *common.InventoryReport) common.InventoryReport {
+	c := *r
+	c.Host.Software = nil
+	c.Software = append([]common.SoftwareInfo(nil), r.Software...)
+	c.Services = append([]common.ServiceInfo(nil), r.Services...)
+	c.Patches = append([]common.PatchInfo(nil), r.Patches...)
+	sort.Slice(c.Software, func(i, j int) bool {
+		if c.Software[i].Name != c.Software[j].Name {
+			return c.Software[i].Name < c.Software[j].Name
+		}
+		return c.Software[i].Version < c.Software[j].Version
+	})
+	sort.Slice(c.Services, func(i, j int) bool { return c.Services[i].Name < c.Services[j].Name })
+	sort.Slice(c.Patches, func(i, j int) bool { return c.Patches[i].ID < c.Patches[j].ID })
+	return c
+}
+
+func softwareKey(s common.SoftwareInfo) string { return s.Name + "\x00" + s.Version }
+
+// Diff works out what changed from one report to the next. A package that lost one version
+// and gained another is reported as updated rather than removed and added.
+func Diff(prev, next *common.InventoryReport) common.InventoryChanges {
+	var ch common.InventoryChanges
+	p, n := normalize(prev), normalize(next)
+
+	before := make(map[string]bool, len(p.Software))
+	for _, s := range p.Software {
+		before[softwareKey(s)] = true
+	}
+	after := make(map[string]bool, len(n.Software))
+	for _, s := range n.Software {
+		after[softwareKey(s)] = true
+	}
+	var added, removed []common.SoftwareInfo
+	addedByName, removedByName := map[string]int{}, map[string]int{}
+	for _, s := range n.Software {
+		if !before[softwareKey(s)] {
+			added = append(added, s)
+			addedByName[s.Name]++
+		}
+	}
+	for _, s := range p.Software {
+		if !after[softwareKey(s)] {
+			removed = append(removed, s)
+			removedByName[s.Name]++
+		}
+	}
+	oldVersion := make(map[string]string)
+	for _, s := range removed {
+		if addedByName[s.Name] == 1 && removedByName[s.Name] == 1 {
+			oldVersion[s.Name] = s.Version
+		} else {
+			ch.SoftwareRemoved = append(ch.SoftwareRemoved, s)
+		}
+	}
+	for _, s := range added {
+		if old, ok := oldVersion[s.Name]; ok {
+			ch.SoftwareUpdated = append(ch.SoftwareUpdated, common.SoftwareChange{Name: s.Name, OldVersion: old, NewVersion: s.Version})
+		} else {
+			ch.SoftwareAdded = append(ch.SoftwareAdded, s)
+		}
+	}
+
+	oldServices := make(map[string]common.ServiceInfo, len(p.Services))
+	for _, s := range p.Services {
+		oldServices[s.Name] = s
+	}
+	newServices := make(map[string]bool, len(n.Services))
+	for _, s := range n.Services {
+		newServices[s.Name] = true
+		old, ok := oldServices[s.Name]
+		switch {
+		case !ok:
+			ch.ServicesAdded = append(ch.ServicesAdded, s)
+		case old.Status != s.Status:
+			ch.ServicesChanged = append(ch.ServicesChanged, common.ServiceChange{Name: s.Name, OldStatus: old.Status, NewStatus: s.Status})
+		}
+	}
+	for _, s := range p.Services {
+		if !newServices[s.Name] {
+			ch.ServicesRemoved = append(ch.ServicesRemoved, s)
+		}
+	}
+
+	oldPatches := make(map[string]bool, len(p.Patches))
+	for _, pt := range p.Patches {
+		oldPatches[pt.ID] = true
+	}
+	newPatches := make(map[string]bool, len(n.Patches))
+	for _, pt := range n.Patches {
+		newPatches[pt.ID] = true
+		if !oldPatches[pt.ID] {
+			ch.PatchesPending = append(ch.PatchesPending, pt)
+		}
+	}
+	for _, pt := range p.Patches {
+		if !newPatches[pt.ID] {
+			ch.PatchesCleared = append(ch.PatchesCleared, pt)
+		}
+	}
+
+	ch.HardwareChanged = !sameJSON(p.Hardware, n.Hardware)
+	ch.HostChanged = !sameJSON(p.Host, n.Host)
+	return ch
+}
+
+func sameJSON(a, b any) bool {
+	x, _ := json.Marshal(a)
+	y, _ := json.Marshal(b)
+	return string(x) == string(y)
+}
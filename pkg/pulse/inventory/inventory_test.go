@@ -0,0 +1,121 @@
+package inventory
+
+import (
+	"testing"
+	"time"
+
+	"sent/pkg/pulse/common"
+
+	"github.com/riverqueue/river/rivertype"
+)
+
+func report() *common.InventoryReport {
+	return &common.InventoryReport{
+		CollectedAt: time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC),
+		Host:        common.HostInfo{Hostname: "pos-12", OS: "linux"},
+		Hardware:    common.HardwareInfo{Manufacturer: "Dell Inc.", SerialNumber: "ABC123", MemoryTotal: 8 << 30},
+		Software: []common.SoftwareInfo{
+			{Name: "openssl", Version: "1.1.1w"},
+			{Name: "curl", Version: "7.88.1"},
+			{Name: "linux-image", Version: "6.1.0-25"},
+			{Name: "linux-image", Version: "6.1.0-26"},
+		},
+		Services: []common.ServiceInfo{{Name: "sshd", Status: "running"}, {Name: "cups", Status: "running"}},
+		Patches:  []common.PatchInfo{{ID: "curl"}},
+	}
+}
+
+func TestHash(t *testing.T) {
+	a, b := report(), report()
+	b.CollectedAt = b.CollectedAt.Add(6 * time.Hour)
+	b.Software[0], b.Software[1] = b.Software[1], b.Software[0]
+	b.Host.Software = b.Software
+	if Hash(a) != Hash(b) {
+		t.Error("the same inventory collected later, listed in another order, hashes differently")
+	}
+	b.Services[1].Status = "stopped"
+	if Hash(a) == Hash(b) {
+		t.Error("a service stopping did not change the hash")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	prev, next := report(), report()
+	next.Software = []common.SoftwareInfo{
+		{Name: "openssl", Version: "3.0.14"},                    // Updated
+		{Name: "linux-image", Version: "6.1.0-26"},              // Kept
+		{Name: "linux-image", Version: "6.1.0-27"},              // Updated from 6.1.0-25
+		{Name: "nginx", Version: "1.22.1", Publisher: "Debian"}, // Added; curl removed
+	}
+	next.Services = []common.ServiceInfo{{Name: "sshd", Status: "stopped"}, {Name: "nginx", Status: "running"}}
+	next.Patches = []common.PatchInfo{{ID: "nginx"}}
+
+	ch := Diff(prev, next)
+	want := []common.SoftwareChange{
+		{Name: "linux-image", OldVersion: "6.1.0-25", NewVersion: "6.1.0-27"},
+		{Name: "openssl", OldVersion: "1.1.1w", NewVersion: "3.0.14"},
+	}
+	if len(ch.SoftwareUpdated) != 2 || ch.SoftwareUpdated[0] != want[0] || ch.SoftwareUpdated[1] != want[1] {
+		t.Errorf("updated = %+v", ch.SoftwareUpdated)
+	}
+	if len(ch.SoftwareAdded) != 1 || ch.SoftwareAdded[0].Name != "nginx" {
+		t.Errorf("added = %+v", ch.SoftwareAdded)
+	}
+	if len(ch.SoftwareRemoved) != 1 || ch.SoftwareRemoved[0].Name != "curl" {
+		t.Errorf("removed = %+v", ch.SoftwareRemoved)
+	}
+	if len(ch.ServicesAdded) != 1 || len(ch.ServicesRemoved) != 1 || ch.ServicesRemoved[0].Name != "cups" {
+		t.Errorf("services added %+v, removed %+v", ch.ServicesAdded, ch.ServicesRemoved)
+	}
+	if len(ch.ServicesChanged) != 1 || ch.ServicesChanged[0].NewStatus != "stopped" {
+		t.Errorf("services changed = %+v", ch.ServicesChanged)
+	}
+	if len(ch.PatchesPending) != 1 || ch.PatchesPending[0].ID != "nginx" || len(ch.PatchesCleared) != 1 || ch.PatchesCleared[0].ID != "curl" {
+		t.Errorf("patches pending %+v, cleared %+v", ch.PatchesPending, ch.PatchesCleared)
+	}
+	if ch.HardwareChanged || ch.HostChanged {
+		t.Error("hardware or host reported changed")
+	}
+
+	if ch := Diff(prev, report()); !ch.Empty() {
+		t.Errorf("an unchanged inventory has changes: %+v", ch)
+	}
+}
+
+func TestVersionMatches(t *testing.T) {
+	cases := []struct {
+		version, query string
+		want           bool
+	}{
+		{"1.1.1w", "1.1", true},
+		{"1.1.1w", "1.1.1", true},
+		{"1.1.1w", "1.1.1W", true},
+		{"1.1", "1.1", true},
+		{"1.1-3ubuntu1", "1.1", true},
+		{"1.10.2", "1.1", false},
+		{"1.10.2", "1.1.", false},
+		{"1.1.0", "1.1.", true},
+		{"3.0.14", "1.1", false},
+		{"3.0.14", "", true},
+	}
+	for _, c := range cases {
+		if got := VersionMatches(c.version, c.query); got != c.want {
+			t.Errorf("VersionMatches(%q, %q) = %v", c.version, c.query, got)
+		}
+	}
+}
+
+func TestCollectOptsAllowsRecollection(t *testing.T) {
+	opts := CollectOpts()
+	if !opts.UniqueOpts.ByArgs {
+		t.Error("collections are not unique per agent")
+	}
+	for _, s := range opts.UniqueOpts.ByState {
+		if s == rivertype.JobStateCompleted || s == rivertype.JobStateDiscarded || s == rivertype.JobStateCancelled {
+			t.Errorf("a %s collection blocks the next one", s)
+		}
+	}
+	if len(opts.UniqueOpts.ByState) == 0 {
+		t.Error("ByState is empty, so River's default blocks on completed collections")
+	}
+}
@@ -0,0 +1,174 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"sent/ent"
+	"sent/ent/agent"
+	"sent/ent/agentinventory"
+	"sent/ent/agentsoftware"
+	"sent/ent/tenant"
+)
+
+// maxMatches caps a fleet software search.
+const maxMatches = 1000
+
+// Latest returns an agent's current inventory; nil if it has not reported one.
+func Latest(ctx context.Context, db *ent.Client, tenantID, agentID int) (*ent.AgentInventory, error) {
+	s, err := db.AgentInventory.Query().
+		Where(agentinventory.HasAgentWith(agent.ID(agentID), agent.HasTenantWith(tenant.ID(tenantID)))).
+		Order(ent.Desc(agentinventory.FieldVersion)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return s, err
+}
+
+// History returns an agent's most recent inventory versions, newest first, with what changed
+// in each but not the full lists.
+func History(ctx context.Context, db *ent.Client, tenantID, agentID, limit int) ([]*ent.AgentInventory, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	return db.AgentInventory.Query().
+		Where(agentinventory.HasAgentWith(agent.ID(agentID), agent.HasTenantWith(tenant.ID(tenantID)))).
+		Order(ent.Desc(agentinventory.FieldVersion)).
+		Limit(limit).
+		Select(
+			agentinventory.FieldVersion,
+			agentinventory.FieldCollectedAt,
+			agentinventory.FieldConfirmedAt,
+			agentinventory.FieldChanges,
+		).
+		All(ctx)
+}
+
+// Software change kinds.
+const (
+	ChangeInstalled = "installed"
+	ChangeRemoved   = "removed"
+	ChangeUpdated   = "updated"
+)
+
+// SoftwareEvent is a package installed, removed or updated on an agent, as first seen in its
+// inventory.
+type SoftwareEvent struct {
+	AgentID    int
+	Hostname   string
+	Change     string
+	Name       string
+	Version    string
+	OldVersion string // Of an update
+	Publisher  string
+	At         time.Time
+}
+
+// SoftwareChanges returns the software installed, removed or updated since a time, newest
+// first, on one agent of the tenant or, with agentID 0, on all of them.
+func SoftwareChanges(ctx context.Context, db *ent.Client, tenantID, agentID int, since time.Time) ([]SoftwareEvent, error) {
+	q := db.AgentInventory.Query().
+		Where(
+			agentinventory.CollectedAtGTE(since),
+			agentinventory.VersionGT(1),
+		).
+		WithAgent().
+		Select(agentinventory.FieldCollectedAt, agentinventory.FieldChanges)
+	if agentID != 0 {
+		q.Where(agentinventory.HasAgentWith(agent.ID(agentID), agent.HasTenantWith(tenant.ID(tenantID))))
+	} else {
+		q.Where(agentinventory.HasAgentWith(agent.HasTenantWith(tenant.ID(tenantID))))
+	}
+	snaps, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []SoftwareEvent
+	for _, s := range snaps {
+		a := s.Edges.Agent
+		if a == nil {
+			continue
+		}
+		event := func(change, name, version, publisher string) SoftwareEvent {
+			return SoftwareEvent{AgentID: a.ID, Hostname: a.Hostname, Change: change, Name: name, Version: version, Publisher: publisher, At: s.CollectedAt}
+		}
+		for _, sw := range s.Changes.SoftwareAdded {
+			events = append(events, event(ChangeInstalled, sw.Name, sw.Version, sw.Publisher))
+		}
+		for _, sw := range s.Changes.SoftwareRemoved {
+			events = append(events, event(ChangeRemoved, sw.Name, sw.Version, sw.Publisher))
+		}
+		for _, sw := range s.Changes.SoftwareUpdated {
+			e := event(ChangeUpdated, sw.Name, sw.NewVersion, "")
+			e.OldVersion = sw.OldVersion
+			events = append(events, e)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].At.Equal(events[j].At) {
+			return events[i].At.After(events[j].At)
+		}
+		if events[i].Hostname != events[j].Hostname {
+			return events[i].Hostname < events[j].Hostname
+		}
+		return events[i].Name < events[j].Name
+	})
+	return events, nil
+}
+
+// SearchSoftware finds the tenant's agents that have software installed now whose name
+// contains name, in a version matching version (see VersionMatches). It returns the matching
+// rows with their agents, by host.
+func SearchSoftware(ctx context.Context, db *ent.Client, tenantID int, name, version string) ([]*ent.AgentSoftware, error) {
+	name, version = strings.TrimSpace(name), strings.TrimSpace(version)
+	if name == "" {
+		return nil, fmt.Errorf("a software name is required")
+	}
+	rows, err := db.AgentSoftware.Query().
+		Where(
+			agentsoftware.NameContainsFold(name),
+			agentsoftware.RemovedAtIsNil(),
+			agentsoftware.HasAgentWith(agent.HasTenantWith(tenant.ID(tenantID)), agent.RevokedAtIsNil()),
+		).
+		WithAgent().
+		Order(ent.Asc(agentsoftware.FieldName), ent.Asc(agentsoftware.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []*ent.AgentSoftware
+	for _, r := range rows {
+		if VersionMatches(r.Version, version) {
+			out = append(out, r)
+			if len(out) == maxMatches {
+				break
+			}
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Edges.Agent.Hostname < out[j].Edges.Agent.Hostname
+	})
+	return out, nil
+}
+
+// VersionMatches reports whether a version is the one asked for, or one of its releases:
+// 1.1 matches 1.1, 1.1.1w and 1.1-3 but not 1.10. An empty query matches every version.
+func VersionMatches(version, query string) bool {
+	version, query = strings.ToLower(strings.TrimSpace(version)), strings.ToLower(strings.TrimSpace(query))
+	if query == "" || version == query {
+		return true
+	}
+	if !strings.HasPrefix(version, query) {
+		return false
+	}
+	next := rune(version[len(query)])
+	last := rune(query[len(query)-1])
+	// 1.1 may not run on into 1.10; 1.1. is already a whole part.
+	return !unicode.IsDigit(next) || !unicode.IsDigit(last)
+}
@@ -0,0 +1,185 @@
+package inventory
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"sent/ent"
+	"sent/ent/agent"
+	"sent/ent/agentinventory"
+	"sent/ent/agentsoftware"
+	"sent/pkg/nexus/discovery"
+	"sent/pkg/pulse/common"
+)
+
+// Record stores an agent's inventory report. A report matching the agent's latest snapshot
+// only confirms it; otherwise it becomes the next version, with what changed since the last.
+// The agent's software rows follow the report, and its SENTnexus asset is updated.
+func Record(ctx context.Context, db *ent.Client, agentID int, report *common.InventoryReport) (*ent.AgentInventory, error) {
+	a, err := db.Agent.Query().Where(agent.ID(agentID)).WithTenant().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := normalize(report)
+	if r.CollectedAt.IsZero() {
+		r.CollectedAt = time.Now()
+	}
+	hash := Hash(&r)
+
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	latest, err := tx.AgentInventory.Query().
+		Where(agentinventory.HasAgentWith(agent.ID(agentID))).
+		Order(ent.Desc(agentinventory.FieldVersion)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	var snap *ent.AgentInventory
+	if latest != nil && latest.Hash == hash {
+		snap, err = tx.AgentInventory.UpdateOne(latest).SetConfirmedAt(r.CollectedAt).Save(ctx)
+	} else {
+		create := tx.AgentInventory.Create().
+			SetAgentID(agentID).
+			SetVersion(1).
+			SetHash(hash).
+			SetCollectedAt(r.CollectedAt).
+			SetConfirmedAt(r.CollectedAt).
+			SetHost(r.Host).
+			SetHardware(r.Hardware).
+			SetSoftware(r.Software).
+			SetServices(r.Services).
+			SetPatches(r.Patches)
+		// The first snapshot is where the history starts, not a change.
+		if latest != nil {
+			create.SetVersion(latest.Version + 1).SetChanges(Diff(Report(latest), &r))
+		}
+		if snap, err = create.Save(ctx); err != nil {
+			return nil, err
+		}
+		err = syncSoftware(ctx, tx, agentID, r.Software, r.CollectedAt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Agent.UpdateOneID(agentID).SetInventoryAt(r.CollectedAt).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if a.Edges.Tenant != nil {
+		if err := discovery.NewService(db).Reconcile(ctx, assetData(a, snap)); err != nil {
+			log.Printf("[PULSE] Inventory of agent %d not passed to Nexus: %v", agentID, err)
+		}
+	}
+	return snap, nil
+}
+
+// Report turns a snapshot back into the report it was made from.
+func Report(s *ent.AgentInventory) *common.InventoryReport {
+	return &common.InventoryReport{
+		CollectedAt: s.CollectedAt,
+		Host:        s.Host,
+		Hardware:    s.Hardware,
+		Software:    s.Software,
+		Services:    s.Services,
+		Patches:     s.Patches,
+	}
+}
+
+// syncSoftware marks the agent's packages that are gone as removed and adds rows for the new
+// ones.
+func syncSoftware(ctx context.Context, tx *ent.Tx, agentID int, software []common.SoftwareInfo, at time.Time) error {
+	rows, err := tx.AgentSoftware.Query().
+		Where(agentsoftware.HasAgentWith(agent.ID(agentID)), agentsoftware.RemovedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	installed := make(map[string]bool, len(software))
+	for _, s := range software {
+		installed[softwareKey(s)] = true
+	}
+	have := make(map[string]bool, len(rows))
+	var gone []int
+	for _, row := range rows {
+		key := softwareKey(common.SoftwareInfo{Name: row.Name, Version: row.Version})
+		have[key] = true
+		if !installed[key] {
+			gone = append(gone, row.ID)
+		}
+	}
+	if len(gone) > 0 {
+		if err := tx.AgentSoftware.Update().Where(agentsoftware.IDIn(gone...)).SetRemovedAt(at).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	var create []*ent.AgentSoftwareCreate
+	for _, s := range software {
+		key := softwareKey(s)
+		if have[key] || s.Name == "" {
+			continue
+		}
+		have[key] = true
+		create = append(create, tx.AgentSoftware.Create().
+			SetAgentID(agentID).
+			SetName(s.Name).
+			SetVersion(s.Version).
+			SetPublisher(s.Publisher).
+			SetInstallDate(s.InstallDate).
+			SetFirstSeen(at))
+	}
+	// Batches stay well under the database's bind parameter limit.
+	for len(create) > 0 {
+		n := min(len(create), 500)
+		if err := tx.AgentSoftware.CreateBulk(create[:n]...).Exec(ctx); err != nil {
+			return err
+		}
+		create = create[n:]
+	}
+	return nil
+}
+
+// assetData is what SENTnexus learns about an agent's machine from its inventory.
+func assetData(a *ent.Agent, s *ent.AgentInventory) discovery.TelemetryData {
+	hw := s.Hardware
+	return discovery.TelemetryData{
+		TenantID:     a.Edges.Tenant.ID,
+		Hostname:     a.Hostname,
+		IP:           a.IP,
+		MAC:          a.MAC,
+		OS:           a.Os,
+		Manufacturer: hw.Manufacturer,
+		SerialNumber: hw.SerialNumber,
+		Metadata: map[string]interface{}{
+			"source":            "SENTpulse",
+			"agent_id":          a.ID,
+			"os":                s.Host.OS,
+			"platform":          s.Host.Platform,
+			"platform_version":  s.Host.PlatformVersion,
+			"kernel_version":    s.Host.KernelVersion,
+			"arch":              s.Host.Arch,
+			"manufacturer":      hw.Manufacturer,
+			"model":             hw.Model,
+			"serial_number":     hw.SerialNumber,
+			"cpu_model":         hw.CPUModel,
+			"cpu_cores":         hw.CPUCores,
+			"memory_total":      hw.MemoryTotal,
+			"av_name":           s.Host.AvName,
+			"av_status":         s.Host.AvStatus,
+			"software_count":    len(s.Software),
+			"pending_patches":   len(s.Patches),
+			"inventory_version": s.Version,
+			"inventory_at":      s.ConfirmedAt,
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"sent/ent"
+	"sent/ent/agent"
+	"sent/pkg/pulse/common"
+	"sent/pkg/pulse/rpc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// MaxAge is how old an agent's inventory may get before the sweep asks for a new one.
+const MaxAge = 6 * time.Hour
+
+// SweepArgs asks the agents whose inventory is out of date for a new one.
+type SweepArgs struct{}
+
+func (SweepArgs) Kind() string { return "pulse:inventory_sweep" }
+
+// SweepWorker queues an inventory collection for every online agent that has not reported
+// its inventory within MaxAge.
+type SweepWorker struct {
+	river.WorkerDefaults[SweepArgs]
+	db *ent.Client
+}
+
+func NewSweepWorker(db *ent.Client) *SweepWorker {
+	return &SweepWorker{db: db}
+}
+
+func (w *SweepWorker) Work(ctx context.Context, j *river.Job[SweepArgs]) error {
+	ids, err := w.db.Agent.Query().
+		Where(
+			agent.RevokedAtIsNil(),
+			agent.StatusNEQ(agent.StatusOffline),
+			agent.Or(agent.InventoryAtIsNil(), agent.InventoryAtLT(time.Now().Add(-MaxAge))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	client := river.ClientFromContext[pgx.Tx](ctx)
+	for _, id := range ids {
+		if _, err := client.Insert(ctx, CollectArgs{AgentID: id}, CollectOpts()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CollectArgs asks one agent for its inventory and records it.
+type CollectArgs struct {
+	AgentID int `json:"agent_id"`
+}
+
+func (CollectArgs) Kind() string { return "pulse:inventory_collect" }
+
+// CollectOpts queues a collection at most once per agent at a time, and does not retry it:
+// the next sweep asks again. Finished collections are left out of the uniqueness check, or
+// they would keep the agent from ever being collected again.
+func CollectOpts() *river.InsertOpts {
+	return &river.InsertOpts{
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+			ByState: []rivertype.JobState{
+				rivertype.JobStateAvailable,
+				rivertype.JobStatePending,
+				rivertype.JobStateRunning,
+				rivertype.JobStateScheduled,
+			},
+		},
+	}
+}
+
+// CollectWorker asks an agent for its inventory and records it.
+type CollectWorker struct {
+	river.WorkerDefaults[CollectArgs]
+	db     *ent.Client
+	caller *rpc.Caller
+}
+
+func NewCollectWorker(db *ent.Client) *CollectWorker {
+	return &CollectWorker{db: db}
+}
+
+// SetTransport connects the worker to the agents, through the console's hub connection.
+func (w *CollectWorker) SetTransport(t rpc.Transport) {
+	w.caller = rpc.NewCaller(t)
+}
+
+func (w *CollectWorker) Timeout(j *river.Job[CollectArgs]) time.Duration {
+	return rpc.Timeout(rpc.MethodInventory) + time.Minute
+}
+
+func (w *CollectWorker) Work(ctx context.Context, j *river.Job[CollectArgs]) error {
+	if w.caller == nil {
+		return errors.New("the console is not connected to the agent hub")
+	}
+	_, err := Collect(ctx, w.db, w.caller, j.Args.AgentID)
+	if errors.Is(err, rpc.ErrTimeout) {
+		log.Printf("[PULSE] Agent %d did not answer for its inventory; it may be offline", j.Args.AgentID)
+		return nil
+	}
+	return err
+}
+
+// Collect asks an agent for its inventory now and records it.
+func Collect(ctx context.Context, db *ent.Client, caller *rpc.Caller, agentID int) (*ent.AgentInventory, error) {
+	var report common.InventoryReport
+	if err := caller.Call(ctx, strconv.Itoa(agentID), rpc.MethodInventory, nil, &report); err != nil {
+		return nil, err
+	}
+	return Record(ctx, db, agentID, &report)
+}
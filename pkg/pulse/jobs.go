@@ -4,57 +4,79 @@ import (
 	"context"
 	"fmt"
 	"sent/ent"
-    "sent/ent/script"
-    "sent/ent/tenant"
-    "time"
+	"sent/ent/job"
+	"sent/ent/script"
+	"sent/ent/tenant"
+	"sent/pkg/pulse/rpc"
+	"sent/pkg/pulse/scheduler"
+	"sent/pkg/pulse/targeting"
+	"time"
 )
 
 // JobManager handles job scheduling
 type JobManager struct {
-    client *ent.Client
+	client *ent.Client
 }
 
 func NewJobManager(client *ent.Client) *JobManager {
-    return &JobManager{client: client}
+	return &JobManager{client: client}
 }
 
-// CreateJob creates a new job
-func (m *JobManager) CreateJob(ctx context.Context, tenantID string, name string, scriptID int, targets []string, schedule string) (*ent.Job, error) {
-    if name == "" {
-        return nil, fmt.Errorf("job name is required")
-    }
-
-    t, err := m.client.Tenant.Query().Where(tenant.NameEQ(tenantID)).Only(ctx)
-    if err != nil {
-         // Fallback logic
-        var tErr error
-        t, tErr = m.client.Tenant.Query().Only(ctx)
-        if tErr != nil {
-             return nil, fmt.Errorf("tenant failed: %w", err)
-        }
-    }
-
-    s, err := m.client.Script.Query().Where(script.IDEQ(scriptID)).Only(ctx)
-    if err != nil {
-        return nil, fmt.Errorf("script not found")
-    }
-
-    return m.client.Job.Create().
-        SetTenant(t).
-        SetName(name).
-        SetScript(s).
-        SetTargets(targets).
-        SetCronSchedule(schedule).
-        SetNextRun(time.Now()). // Immediate run if empty, or calculate based on cron
-        Save(ctx)
+// JobSpec is what a job runs, where and when.
+type JobSpec struct {
+	Name       string
+	ScriptID   int
+	Targets    []string
+	Schedule   string // Cron expression, or empty / "manual" to run only by hand
+	Parameters map[string]string
+	Timeout    int // Seconds; zero for the default
 }
 
-// ListJobs returns all jobs
-func (m *JobManager) ListJobs(ctx context.Context, tenantID string) ([]*ent.Job, error) {
-     return m.client.Job.Query().
-        WithScript().
-        WithExecutions(func(q *ent.JobExecutionQuery) {
-            q.Order(ent.Desc("created_at")).Limit(5) // Get recent executions
-        }).
-        All(ctx)
+// CreateJob creates a new job for the tenant, first due at its schedule's next run.
+func (m *JobManager) CreateJob(ctx context.Context, tenantID int, spec JobSpec) (*ent.Job, error) {
+	if spec.Name == "" {
+		return nil, fmt.Errorf("job name is required")
+	}
+	if spec.Timeout < 0 || time.Duration(spec.Timeout)*time.Second > rpc.MaxScriptTimeout {
+		return nil, fmt.Errorf("timeout must be between 1 second and %s", rpc.MaxScriptTimeout)
+	}
+	nextRun, err := scheduler.NextRun(spec.Schedule, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := m.client.Script.Query().Where(script.IDEQ(spec.ScriptID)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("script not found")
+	}
+	if err := scheduler.ValidateParameters(s.Parameters, spec.Parameters); err != nil {
+		return nil, err
+	}
+	if err := targeting.Validate(ctx, m.client, tenantID, spec.Targets); err != nil {
+		return nil, err
+	}
+
+	create := m.client.Job.Create().
+		SetTenantID(tenantID).
+		SetName(spec.Name).
+		SetScript(s).
+		SetTargets(spec.Targets).
+		SetCronSchedule(spec.Schedule).
+		SetParameters(spec.Parameters)
+	if spec.Timeout > 0 {
+		create.SetTimeoutSeconds(spec.Timeout)
+	}
+	if !nextRun.IsZero() {
+		create.SetNextRun(nextRun)
+	}
+	return create.Save(ctx)
+}
+
+// ListJobs returns the tenant's jobs
+func (m *JobManager) ListJobs(ctx context.Context, tenantID int) ([]*ent.Job, error) {
+	return m.client.Job.Query().
+		Where(job.HasTenantWith(tenant.ID(tenantID))).
+		WithScript().
+		Order(ent.Asc(job.FieldID)).
+		All(ctx)
 }
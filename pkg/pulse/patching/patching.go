@@ -0,0 +1,253 @@
+// Package patching runs patch policies: on each policy's schedule it resolves the agents the
+// policy targets, and has each of them install its pending patches.
+package patching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/agent"
+	"sent/ent/patchpolicy"
+	"sent/ent/tenant"
+	"sent/pkg/pulse/common"
+	"sent/pkg/pulse/rpc"
+	"sent/pkg/pulse/scheduler"
+	"sent/pkg/pulse/targeting"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// Severities are the patch severities agents report, which a policy can limit itself to.
+var Severities = []string{"Critical", "Important", "Moderate", "Low"}
+
+// NormalizeSeverities checks a policy's severities and spells them as agents report them.
+func NormalizeSeverities(severities []string) ([]string, error) {
+	var out []string
+	seen := make(map[string]bool)
+	for _, s := range severities {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		known := ""
+		for _, k := range Severities {
+			if strings.EqualFold(s, k) {
+				known = k
+			}
+		}
+		if known == "" {
+			return nil, fmt.Errorf("unknown patch severity %q", s)
+		}
+		if !seen[known] {
+			seen[known] = true
+			out = append(out, known)
+		}
+	}
+	return out, nil
+}
+
+// Select returns the IDs of the pending patches a policy installs: those of its severities,
+// or all of them when it names none.
+func Select(patches []common.PatchInfo, severities []string) []string {
+	var ids []string
+	for _, p := range patches {
+		if p.Installed || p.ID == "" {
+			continue
+		}
+		wanted := len(severities) == 0
+		for _, s := range severities {
+			if strings.EqualFold(p.Severity, s) {
+				wanted = true
+			}
+		}
+		if wanted {
+			ids = append(ids, p.ID)
+		}
+	}
+	return ids
+}
+
+// SweepArgs starts the patch policies that are due.
+type SweepArgs struct{}
+
+func (SweepArgs) Kind() string { return "pulse:patch_policy_sweep" }
+
+// SweepWorker starts every enabled policy whose next run has come, then moves it to its next
+// run.
+type SweepWorker struct {
+	river.WorkerDefaults[SweepArgs]
+	db *ent.Client
+}
+
+func NewSweepWorker(db *ent.Client) *SweepWorker {
+	return &SweepWorker{db: db}
+}
+
+func (w *SweepWorker) Work(ctx context.Context, j *river.Job[SweepArgs]) error {
+	now := time.Now()
+	due, err := w.db.PatchPolicy.Query().
+		Where(patchpolicy.Enabled(true), patchpolicy.NextRunLTE(now)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	client := river.ClientFromContext[pgx.Tx](ctx)
+	for _, p := range due {
+		claimed, err := claim(ctx, w.db, p, now)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue // Another sweep has it
+		}
+		if _, err := Dispatch(ctx, w.db, client, p.ID); err != nil {
+			log.Printf("[PULSE] Patch policy %d (%s) did not start: %v", p.ID, p.Name, err)
+		}
+	}
+	return nil
+}
+
+// claim moves a due policy on to its next run, so each run starts once however many sweeps
+// see it.
+func claim(ctx context.Context, db *ent.Client, p *ent.PatchPolicy, now time.Time) (bool, error) {
+	upd := db.PatchPolicy.Update().Where(patchpolicy.ID(p.ID), patchpolicy.NextRunEQ(*p.NextRun))
+	if next, err := scheduler.NextRun(p.CronSchedule, now); err != nil || next.IsZero() {
+		log.Printf("[PULSE] Patch policy %d has no valid schedule, not running it again: %v", p.ID, err)
+		upd.ClearNextRun()
+	} else {
+		upd.SetNextRun(next)
+	}
+	n, err := upd.Save(ctx)
+	return n == 1, err
+}
+
+// ApplyArgs has one agent install the patches a policy calls for.
+type ApplyArgs struct {
+	PolicyID int `json:"policy_id"`
+	AgentID  int `json:"agent_id"`
+}
+
+func (ApplyArgs) Kind() string { return "pulse:patch_policy_apply" }
+
+// ApplyOpts queues a policy's patching of an agent at most once at a time. A failed run is
+// not retried: an install may have been half done, and the next run picks up what is left.
+func ApplyOpts() *river.InsertOpts {
+	return &river.InsertOpts{
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+			ByState: []rivertype.JobState{
+				rivertype.JobStateAvailable,
+				rivertype.JobStatePending,
+				rivertype.JobStateRunning,
+				rivertype.JobStateScheduled,
+			},
+		},
+	}
+}
+
+// Dispatch starts a run of a policy: each agent it targets now is queued to install its
+// patches. It returns the agents' IDs.
+func Dispatch(ctx context.Context, db *ent.Client, client *river.Client[pgx.Tx], policyID int) ([]int, error) {
+	if client == nil {
+		return nil, fmt.Errorf("job queue is not available")
+	}
+	p, err := db.PatchPolicy.Query().Where(patchpolicy.ID(policyID)).WithTenant().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Groups and tags are resolved now, so the run reaches whoever is in them today.
+	agents, err := targeting.Resolve(ctx, db, p.Edges.Tenant.ID, p.Targets)
+	if err != nil {
+		return nil, err
+	}
+	if len(agents) == 0 {
+		return nil, fmt.Errorf("patch policy %q has no agents to run on", p.Name)
+	}
+	ids := make([]int, 0, len(agents))
+	for _, a := range agents {
+		if _, err := client.Insert(ctx, ApplyArgs{PolicyID: p.ID, AgentID: a.ID}, ApplyOpts()); err != nil {
+			return ids, err
+		}
+		ids = append(ids, a.ID)
+	}
+	db.PatchPolicy.UpdateOneID(p.ID).SetLastRun(time.Now()).Exec(ctx)
+	return ids, nil
+}
+
+// ApplyWorker has an agent scan for patches and install those its policy calls for.
+type ApplyWorker struct {
+	river.WorkerDefaults[ApplyArgs]
+	db     *ent.Client
+	caller *rpc.Caller
+}
+
+func NewApplyWorker(db *ent.Client) *ApplyWorker {
+	return &ApplyWorker{db: db}
+}
+
+// SetTransport connects the worker to the agents, through the console's hub connection.
+func (w *ApplyWorker) SetTransport(t rpc.Transport) {
+	w.caller = rpc.NewCaller(t)
+}
+
+func (w *ApplyWorker) Timeout(j *river.Job[ApplyArgs]) time.Duration {
+	return rpc.Timeout(rpc.MethodScanPatches) + rpc.Timeout(rpc.MethodInstallPatch) + time.Minute
+}
+
+func (w *ApplyWorker) Work(ctx context.Context, j *river.Job[ApplyArgs]) error {
+	if w.caller == nil {
+		return errors.New("the console is not connected to the agent hub")
+	}
+	p, err := w.db.PatchPolicy.Query().
+		Where(patchpolicy.ID(j.Args.PolicyID), patchpolicy.HasTenantWith(tenant.HasAgentsWith(agent.ID(j.Args.AgentID)))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil // The policy or the agent is gone
+	}
+	if err != nil {
+		return err
+	}
+	installed, err := Apply(ctx, w.caller, strconv.Itoa(j.Args.AgentID), p.Severities, p.Reboot)
+	if errors.Is(err, rpc.ErrTimeout) {
+		log.Printf("[PULSE] Agent %d did not answer for patch policy %q; it may be offline", j.Args.AgentID, p.Name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("patch policy %q on agent %d: %w", p.Name, j.Args.AgentID, err)
+	}
+	if len(installed) > 0 {
+		log.Printf("[PULSE] Patch policy %q installed %d patches on agent %d", p.Name, len(installed), j.Args.AgentID)
+	}
+	return nil
+}
+
+// Apply has an agent scan for its pending patches and install those of the given severities,
+// then restart if asked to and anything was installed. It returns the patches installed.
+func Apply(ctx context.Context, caller *rpc.Caller, agentID string, severities []string, reboot bool) ([]string, error) {
+	var patches []common.PatchInfo
+	if err := caller.Call(ctx, agentID, rpc.MethodScanPatches, nil, &patches); err != nil {
+		return nil, err
+	}
+	ids := Select(patches, severities)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if err := caller.Call(ctx, agentID, rpc.MethodInstallPatch, rpc.PatchParams{IDs: ids}, nil); err != nil {
+		return nil, err
+	}
+	if reboot {
+		if err := caller.Call(ctx, agentID, rpc.MethodReboot, nil, nil); err != nil {
+			return ids, fmt.Errorf("patches installed but the restart failed: %w", err)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,45 @@
+package patching
+
+import (
+	"reflect"
+	"testing"
+
+	"sent/pkg/pulse/common"
+)
+
+func TestNormalizeSeverities(t *testing.T) {
+	got, err := NormalizeSeverities([]string{" critical", "IMPORTANT", "", "Critical"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Critical", "Important"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if _, err := NormalizeSeverities([]string{"Urgent"}); err == nil {
+		t.Error("an unknown severity was accepted")
+	}
+}
+
+func TestSelect(t *testing.T) {
+	patches := []common.PatchInfo{
+		{ID: "KB1", Severity: "Critical"},
+		{ID: "KB2", Severity: "Low"},
+		{ID: "KB3", Severity: "critical", Installed: true},
+		{ID: "", Severity: "Critical"},
+		{ID: "KB4", Severity: "Important"},
+	}
+	cases := []struct {
+		severities []string
+		want       []string
+	}{
+		{nil, []string{"KB1", "KB2", "KB4"}},
+		{[]string{"Critical"}, []string{"KB1"}},
+		{[]string{"Critical", "Important"}, []string{"KB1", "KB4"}},
+		{[]string{"Moderate"}, nil},
+	}
+	for _, c := range cases {
+		if got := Select(patches, c.severities); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Select(%v) = %v, want %v", c.severities, got, c.want)
+		}
+	}
+}
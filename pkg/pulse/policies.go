@@ -0,0 +1,141 @@
+package pulse
+
+import (
+	"context"
+	"fmt"
+	"sent/ent"
+	"sent/ent/patchpolicy"
+	"sent/ent/tenant"
+	"sent/pkg/pulse/patching"
+	"sent/pkg/pulse/scheduler"
+	"sent/pkg/pulse/targeting"
+	"strings"
+	"time"
+)
+
+// PatchPolicyManager handles patch policies
+type PatchPolicyManager struct {
+	client *ent.Client
+}
+
+func NewPatchPolicyManager(client *ent.Client) *PatchPolicyManager {
+	return &PatchPolicyManager{client: client}
+}
+
+// PatchPolicySpec is which patches a policy installs, where and when.
+type PatchPolicySpec struct {
+	Name        string
+	Description string
+	Targets     []string
+	Schedule    string   // Cron expression, or empty / "manual" to run only by hand
+	Severities  []string // Empty for every pending patch
+	Reboot      bool
+	Enabled     bool
+}
+
+func (m *PatchPolicyManager) validate(ctx context.Context, tenantID int, spec *PatchPolicySpec) (time.Time, error) {
+	spec.Name = strings.TrimSpace(spec.Name)
+	if spec.Name == "" {
+		return time.Time{}, fmt.Errorf("policy name is required")
+	}
+	if len(spec.Targets) == 0 {
+		return time.Time{}, fmt.Errorf("a policy needs at least one target")
+	}
+	severities, err := patching.NormalizeSeverities(spec.Severities)
+	if err != nil {
+		return time.Time{}, err
+	}
+	spec.Severities = severities
+	if err := targeting.Validate(ctx, m.client, tenantID, spec.Targets); err != nil {
+		return time.Time{}, err
+	}
+	return scheduler.NextRun(spec.Schedule, time.Now())
+}
+
+// CreatePatchPolicy creates a policy for the tenant, first due at its schedule's next run
+func (m *PatchPolicyManager) CreatePatchPolicy(ctx context.Context, tenantID int, spec PatchPolicySpec, createdBy string) (*ent.PatchPolicy, error) {
+	nextRun, err := m.validate(ctx, tenantID, &spec)
+	if err != nil {
+		return nil, err
+	}
+	create := m.client.PatchPolicy.Create().
+		SetTenantID(tenantID).
+		SetName(spec.Name).
+		SetDescription(spec.Description).
+		SetTargets(spec.Targets).
+		SetCronSchedule(spec.Schedule).
+		SetSeverities(spec.Severities).
+		SetReboot(spec.Reboot).
+		SetEnabled(spec.Enabled).
+		SetCreatedBy(createdBy)
+	if spec.Enabled && !nextRun.IsZero() {
+		create.SetNextRun(nextRun)
+	}
+	p, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("a policy named %q already exists", spec.Name)
+	}
+	return p, err
+}
+
+// UpdatePatchPolicy replaces a policy's definition; its next run follows the new schedule
+func (m *PatchPolicyManager) UpdatePatchPolicy(ctx context.Context, tenantID, id int, spec PatchPolicySpec) (*ent.PatchPolicy, error) {
+	nextRun, err := m.validate(ctx, tenantID, &spec)
+	if err != nil {
+		return nil, err
+	}
+	p, err := m.policy(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	upd := p.Update().
+		SetName(spec.Name).
+		SetDescription(spec.Description).
+		SetTargets(spec.Targets).
+		SetCronSchedule(spec.Schedule).
+		SetSeverities(spec.Severities).
+		SetReboot(spec.Reboot).
+		SetEnabled(spec.Enabled)
+	if spec.Enabled && !nextRun.IsZero() {
+		upd.SetNextRun(nextRun)
+	} else {
+		upd.ClearNextRun()
+	}
+	p, err = upd.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("a policy named %q already exists", spec.Name)
+	}
+	return p, err
+}
+
+// DeletePatchPolicy deletes a policy
+func (m *PatchPolicyManager) DeletePatchPolicy(ctx context.Context, tenantID, id int) error {
+	p, err := m.policy(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	return m.client.PatchPolicy.DeleteOne(p).Exec(ctx)
+}
+
+// ListPatchPolicies returns the tenant's patch policies
+func (m *PatchPolicyManager) ListPatchPolicies(ctx context.Context, tenantID int) ([]*ent.PatchPolicy, error) {
+	return m.client.PatchPolicy.Query().
+		Where(patchpolicy.HasTenantWith(tenant.ID(tenantID))).
+		Order(ent.Asc(patchpolicy.FieldName)).
+		All(ctx)
+}
+
+// GetPatchPolicy returns one of the tenant's patch policies
+func (m *PatchPolicyManager) GetPatchPolicy(ctx context.Context, tenantID, id int) (*ent.PatchPolicy, error) {
+	return m.policy(ctx, tenantID, id)
+}
+
+func (m *PatchPolicyManager) policy(ctx context.Context, tenantID, id int) (*ent.PatchPolicy, error) {
+	p, err := m.client.PatchPolicy.Query().
+		Where(patchpolicy.ID(id), patchpolicy.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("patch policy %d not found", id)
+	}
+	return p, err
+}
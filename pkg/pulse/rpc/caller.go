@@ -0,0 +1,163 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Caller sends requests to agents and waits for their answers.
+type Caller struct {
+	transport Transport
+
+	mu      sync.Mutex
+	pending map[string]*pendingCall
+
+	// subMu serializes subscribing, apart from mu so replies are received while the hub
+	// confirms a subscription.
+	subMu   sync.Mutex
+	replies map[string]bool // Reply channels subscribed to, per agent
+}
+
+// pendingCall collects the replies to one request.
+type pendingCall struct {
+	done   chan Response
+	chunks [][]byte
+	got    int
+}
+
+// NewCaller creates a caller that reaches agents through t.
+func NewCaller(t Transport) *Caller {
+	return &Caller{
+		transport: t,
+		pending:   make(map[string]*pendingCall),
+		replies:   make(map[string]bool),
+	}
+}
+
+// Call runs method on an agent with params and decodes its result into result, which may be
+// nil for methods that return nothing. It waits until the agent answers, the method's timeout
+// passes or ctx ends, whichever is first.
+func (c *Caller) Call(ctx context.Context, agentID, method string, params, result any) error {
+	if agentID == "" {
+		return fmt.Errorf("no agent given for %s", method)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := c.subscribe(agentID); err != nil {
+		return err
+	}
+
+	req := Request{ID: uuid.New().String(), Method: method, Deadline: time.Now().Add(Timeout(method))}
+	if d, ok := ctx.Deadline(); ok && d.Before(req.Deadline) {
+		req.Deadline = d
+	}
+	if params != nil {
+		raw, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s request: %w", method, err)
+		}
+		req.Params = raw
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	call := &pendingCall{done: make(chan Response, 1)}
+	c.mu.Lock()
+	c.pending[req.ID] = call
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, req.ID)
+		c.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithDeadline(ctx, req.Deadline)
+	defer cancel()
+	if err := c.transport.Publish(ctx, ControlChannel(agentID), data); err != nil {
+		return fmt.Errorf("failed to send %s to agent %s: %w", method, agentID, err)
+	}
+
+	select {
+	case res := <-call.done:
+		if res.Error != "" {
+			return &RemoteError{AgentID: agentID, Method: method, Message: res.Error}
+		}
+		if result == nil || len(res.Result) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal(res.Result, result); err != nil {
+			return fmt.Errorf("agent %s: %s: unreadable result: %w", agentID, method, err)
+		}
+		return nil
+	case <-ctx.Done():
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("agent %s: %s: %w", agentID, method, ErrTimeout)
+		}
+		return ctx.Err()
+	}
+}
+
+// subscribe listens on an agent's reply channel, once per agent. It is only marked as done
+// once the hub has confirmed it, so a failed subscription is tried again on the next call.
+func (c *Caller) subscribe(agentID string) error {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	if c.replies[agentID] {
+		return nil
+	}
+	if err := c.transport.Subscribe(ReplyChannel(agentID), c.receive); err != nil {
+		return fmt.Errorf("failed to listen to agent %s: %w", agentID, err)
+	}
+	c.replies[agentID] = true
+	return nil
+}
+
+// receive matches a reply to its request, putting chunked results back together.
+func (c *Caller) receive(data []byte) {
+	var res Response
+	if err := json.Unmarshal(data, &res); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	call, ok := c.pending[res.ID]
+	if !ok {
+		return // Answer to a request that timed out
+	}
+	if res.Total <= 1 || res.Error != "" {
+		call.deliver(res)
+		return
+	}
+	if res.Total > MaxChunks {
+		call.deliver(Response{ID: res.ID, Error: fmt.Sprintf("result in %d parts is larger than the %d allowed", res.Total, MaxChunks)})
+		return
+	}
+	if call.chunks == nil {
+		call.chunks = make([][]byte, res.Total)
+	}
+	if len(call.chunks) != res.Total || res.Seq < 0 || res.Seq >= len(call.chunks) || call.chunks[res.Seq] != nil {
+		return
+	}
+	call.chunks[res.Seq] = res.Chunk
+	call.got++
+	if call.got == len(call.chunks) {
+		call.deliver(Response{ID: res.ID, Result: bytes.Join(call.chunks, nil)})
+	}
+}
+
+func (p *pendingCall) deliver(res Response) {
+	select {
+	case p.done <- res:
+	default:
+	}
+}
@@ -0,0 +1,177 @@
+// Package rpc carries requests from the console to Pulse agents over the real-time hub and
+// their answers back. Requests go out on the agent's control channel, pulse:control:<agentID>,
+// and answers come back on its reply channel, pulse:reply:<agentID>, matched to the request by
+// its correlation ID. Answers too large for one publication are split into chunks.
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Methods agents answer.
+const (
+	MethodListProcesses = "process.list"
+	MethodKillProcess   = "process.kill"
+	MethodListServices  = "service.list"
+	MethodControlSvc    = "service.control"
+	MethodListFiles     = "file.list"
+	MethodReadFile      = "file.read"
+	MethodDeleteFile    = "file.delete"
+	MethodScanPatches   = "patch.scan"
+	MethodInstallPatch  = "patch.install"
+	MethodReboot        = "power.reboot"
+	MethodShutdown      = "power.shutdown"
+	MethodEventLogs     = "system.logs"
+	MethodEnvVars       = "system.env"
+	MethodSoftware      = "software.list"
+	MethodRunScript     = "script.run"
+	MethodCancelScript  = "script.cancel"
+	MethodInventory     = "inventory.collect"
+)
+
+// DefaultTimeout is how long a request waits for its answer unless its method needs longer or
+// the caller's context says otherwise.
+const DefaultTimeout = 30 * time.Second
+
+// methodTimeouts are the methods that take longer than DefaultTimeout.
+var methodTimeouts = map[string]time.Duration{
+	MethodReadFile:     2 * time.Minute,
+	MethodScanPatches:  5 * time.Minute,
+	MethodInstallPatch: 30 * time.Minute,
+	MethodSoftware:     2 * time.Minute,
+	MethodInventory:    5 * time.Minute,
+	MethodRunScript:    MaxScriptTimeout + time.Minute,
+}
+
+// Timeout returns how long a method is given to answer.
+func Timeout(method string) time.Duration {
+	if d, ok := methodTimeouts[method]; ok {
+		return d
+	}
+	return DefaultTimeout
+}
+
+// ChunkSize is the most result bytes one reply carries, well inside the hub's message limit.
+const ChunkSize = 32 * 1024
+
+// MaxChunks is the most replies a result may be split into, so results stay under 16 MiB and
+// a caller never sets aside more than that for one.
+const MaxChunks = 512
+
+var (
+	// ErrTimeout is returned when an agent does not answer in time, e.g. because it is offline.
+	ErrTimeout = errors.New("agent did not answer in time")
+	// ErrUnknownMethod is returned by agents for methods they do not have.
+	ErrUnknownMethod = errors.New("unknown method")
+)
+
+// Request asks an agent to do something.
+type Request struct {
+	ID       string          `json:"id"` // Correlation ID, echoed on every reply
+	Method   string          `json:"method"`
+	Params   json.RawMessage `json:"params,omitempty"`
+	Deadline time.Time       `json:"deadline"` // The caller has given up after this; late requests are dropped
+}
+
+// Response answers a request. A result larger than ChunkSize is sent as Total responses, each
+// carrying the Seq-th piece of it in Chunk; a smaller one comes whole in Result.
+type Response struct {
+	ID     string          `json:"id"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	Seq    int             `json:"seq,omitempty"`
+	Total  int             `json:"total,omitempty"`
+	Chunk  []byte          `json:"chunk,omitempty"`
+}
+
+// RemoteError is an error returned by the agent.
+type RemoteError struct {
+	AgentID string
+	Method  string
+	Message string
+}
+
+func (e *RemoteError) Error() string {
+	return fmt.Sprintf("agent %s: %s: %s", e.AgentID, e.Method, e.Message)
+}
+
+// Params of the methods that take any.
+type (
+	PIDParams struct {
+		PID int32 `json:"pid"`
+	}
+	PathParams struct {
+		Path string `json:"path"`
+	}
+	ServiceParams struct {
+		Name   string `json:"name"`
+		Action string `json:"action"` // start, stop or restart
+	}
+	PatchParams struct {
+		IDs []string `json:"ids"`
+	}
+	ScriptParams struct {
+		ExecutionID int    `json:"execution_id"`
+		Type        string `json:"type"`    // sh or ps1
+		Content     string `json:"content"` // With its parameters filled in
+		Timeout     int    `json:"timeout"` // Seconds
+	}
+	ExecutionParams struct {
+		ExecutionID int `json:"execution_id"`
+	}
+)
+
+// MaxScriptTimeout is the longest a script may run.
+const MaxScriptTimeout = 2 * time.Hour
+
+// MaxScriptOutput is the most output kept from one run; the rest is dropped.
+const MaxScriptOutput = 1 << 20
+
+// ScriptResult is how a script run ended. Its output was published before it, in Chunks
+// pieces.
+type ScriptResult struct {
+	ExitCode  int  `json:"exit_code"`
+	Cancelled bool `json:"cancelled,omitempty"`
+	TimedOut  bool `json:"timed_out,omitempty"`
+	Truncated bool `json:"truncated,omitempty"`
+	Chunks    int  `json:"chunks"`
+}
+
+// ScriptOutput is a piece of a running script's output, published on the agent's output
+// channel as it is written.
+type ScriptOutput struct {
+	ExecutionID int    `json:"execution_id"`
+	Seq         int    `json:"seq"`
+	Stream      string `json:"stream"` // stdout or stderr
+	Data        string `json:"data"`
+}
+
+// ControlChannel is where an agent takes requests and commands.
+func ControlChannel(agentID string) string {
+	return "pulse:control:" + agentID
+}
+
+// ReplyChannel is where an agent answers requests.
+func ReplyChannel(agentID string) string {
+	return "pulse:reply:" + agentID
+}
+
+// TelemetryChannel is where an agent publishes its metrics.
+func TelemetryChannel(agentID string) string {
+	return "pulse:telemetry:" + agentID
+}
+
+// OutputChannel is where an agent streams the output of the scripts it runs.
+func OutputChannel(agentID string) string {
+	return "pulse:output:" + agentID
+}
+
+// Transport publishes to and subscribes to channels of the real-time hub.
+type Transport interface {
+	Publish(ctx context.Context, channel string, data []byte) error
+	Subscribe(channel string, handler func(data []byte)) error
+}
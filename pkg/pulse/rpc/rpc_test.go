@@ -0,0 +1,177 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// agentOn serves requests for one agent on an in-memory hub.
+func agentOn(t *MemoryTransport, agentID string) *Server {
+	s := NewServer(t, agentID)
+	t.Subscribe(ControlChannel(agentID), func(data []byte) { s.Serve(data) })
+	return s
+}
+
+func TestCallRoundTrip(t *testing.T) {
+	hub := NewMemoryTransport()
+	a := agentOn(hub, "a1")
+	b := agentOn(hub, "b2")
+	a.Handle(MethodReadFile, Typed(func(ctx context.Context, p PathParams) (any, error) {
+		return []byte("a1:" + p.Path), nil
+	}))
+	b.Handle(MethodReadFile, Typed(func(ctx context.Context, p PathParams) (any, error) {
+		return []byte("b2:" + p.Path), nil
+	}))
+	a.Handle(MethodKillProcess, Typed(func(ctx context.Context, p PIDParams) (any, error) {
+		return nil, fmt.Errorf("process %d not found", p.PID)
+	}))
+
+	c := NewCaller(hub)
+	// Each request reaches the agent it names.
+	for _, id := range []string{"a1", "b2"} {
+		var data []byte
+		if err := c.Call(context.Background(), id, MethodReadFile, PathParams{Path: "/etc/hosts"}, &data); err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != id+":/etc/hosts" {
+			t.Errorf("%s answered %q", id, data)
+		}
+	}
+
+	err := c.Call(context.Background(), "a1", MethodKillProcess, PIDParams{PID: 42}, nil)
+	var remote *RemoteError
+	if !errors.As(err, &remote) || remote.Message != "process 42 not found" || remote.AgentID != "a1" {
+		t.Errorf("remote error = %v", err)
+	}
+	if err := c.Call(context.Background(), "a1", MethodReboot, nil, nil); err == nil || !errors.As(err, &remote) {
+		t.Errorf("unknown method = %v", err)
+	}
+}
+
+func TestChunkedResult(t *testing.T) {
+	hub := NewMemoryTransport()
+	file := bytes.Repeat([]byte("0123456789abcdef"), ChunkSize/4) // Several chunks once encoded
+	var replies int
+	var mu sync.Mutex
+	hub.Subscribe(ReplyChannel("a1"), func(data []byte) {
+		mu.Lock()
+		replies++
+		mu.Unlock()
+	})
+	agentOn(hub, "a1").Handle(MethodReadFile, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return file, nil
+	})
+
+	var data []byte
+	if err := NewCaller(hub).Call(context.Background(), "a1", MethodReadFile, PathParams{Path: "big"}, &data); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, file) {
+		t.Errorf("got %d bytes, want %d", len(data), len(file))
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if replies < 2 {
+		t.Errorf("result sent in %d replies", replies)
+	}
+}
+
+func TestOversizedResultRejected(t *testing.T) {
+	hub := NewMemoryTransport()
+	// A reply claiming more parts than allowed fails the call instead of being collected.
+	hub.Subscribe(ControlChannel("a1"), func(data []byte) {
+		var req Request
+		json.Unmarshal(data, &req)
+		res, _ := json.Marshal(Response{ID: req.ID, Seq: 0, Total: 1 << 30, Chunk: []byte("x")})
+		hub.Publish(context.Background(), ReplyChannel("a1"), res)
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var remote *RemoteError
+	if err := NewCaller(hub).Call(ctx, "a1", MethodReadFile, nil, nil); !errors.As(err, &remote) {
+		t.Errorf("oversized reply = %v", err)
+	}
+}
+
+// flakyTransport fails the first subscription to each channel.
+type flakyTransport struct {
+	*MemoryTransport
+	tried map[string]bool
+}
+
+func (t *flakyTransport) Subscribe(channel string, handler func(data []byte)) error {
+	if !t.tried[channel] {
+		t.tried[channel] = true
+		return errors.New("hub did not confirm the subscription")
+	}
+	return t.MemoryTransport.Subscribe(channel, handler)
+}
+
+func TestSubscribeRetriedAfterFailure(t *testing.T) {
+	hub := &flakyTransport{MemoryTransport: NewMemoryTransport(), tried: make(map[string]bool)}
+	agentOn(hub.MemoryTransport, "a1").Handle(MethodListProcesses, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		return []string{"init"}, nil
+	})
+
+	c := NewCaller(hub)
+	if err := c.Call(context.Background(), "a1", MethodListProcesses, nil, nil); err == nil {
+		t.Fatal("call went through without a subscription")
+	}
+	var procs []string
+	if err := c.Call(context.Background(), "a1", MethodListProcesses, nil, &procs); err != nil || len(procs) != 1 {
+		t.Errorf("call after the subscription failed once = %v, %v", procs, err)
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	hub := NewMemoryTransport()
+	release := make(chan struct{})
+	agentOn(hub, "a1").Handle(MethodListProcesses, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		<-release
+		return []string{"late"}, nil
+	})
+	defer close(release)
+
+	c := NewCaller(hub)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := c.Call(ctx, "a1", MethodListProcesses, nil, nil); !errors.Is(err, ErrTimeout) {
+		t.Errorf("offline agent = %v", err)
+	}
+	// Nobody listens on an unknown agent's channel either.
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel2()
+	if err := c.Call(ctx2, "nobody", MethodListProcesses, nil, nil); !errors.Is(err, ErrTimeout) {
+		t.Errorf("unknown agent = %v", err)
+	}
+}
+
+func TestServeIgnoresCommandsAndLateRequests(t *testing.T) {
+	hub := NewMemoryTransport()
+	s := NewServer(hub, "a1")
+	ran := false
+	s.Handle(MethodReboot, func(ctx context.Context, _ json.RawMessage) (any, error) {
+		ran = true
+		return nil, nil
+	})
+	if s.Serve([]byte("start_rdp")) {
+		t.Error("plain command taken as a request")
+	}
+	late, _ := json.Marshal(Request{ID: "r1", Method: MethodReboot, Deadline: time.Now().Add(-time.Second)})
+	if !s.Serve(late) {
+		t.Error("late request not recognised")
+	}
+	time.Sleep(10 * time.Millisecond)
+	if ran {
+		t.Error("request past its deadline was run")
+	}
+	if Timeout(MethodInstallPatch) <= DefaultTimeout || Timeout(MethodKillProcess) != DefaultTimeout {
+		t.Error("method timeouts")
+	}
+}
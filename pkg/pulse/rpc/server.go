@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Handler runs a method on the agent.
+type Handler func(ctx context.Context, params json.RawMessage) (any, error)
+
+// Typed adapts a handler taking decoded params.
+func Typed[P any](fn func(ctx context.Context, params P) (any, error)) Handler {
+	return func(ctx context.Context, raw json.RawMessage) (any, error) {
+		var p P
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &p); err != nil {
+				return nil, fmt.Errorf("invalid params: %w", err)
+			}
+		}
+		return fn(ctx, p)
+	}
+}
+
+// Server answers the requests sent to one agent.
+type Server struct {
+	transport Transport
+	agentID   string
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewServer creates the request server of an agent, answering through t.
+func NewServer(t Transport, agentID string) *Server {
+	return &Server{transport: t, agentID: agentID, handlers: make(map[string]Handler)}
+}
+
+// Handle registers the handler of a method.
+func (s *Server) Handle(method string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method] = h
+}
+
+// Serve takes a publication from the control channel. It reports whether it was a request;
+// anything else, such as the plain commands sent before requests existed, is left to the
+// caller. Each request runs on its own, until its deadline.
+func (s *Server) Serve(data []byte) bool {
+	var req Request
+	if err := json.Unmarshal(data, &req); err != nil || req.ID == "" || req.Method == "" {
+		return false
+	}
+	if !req.Deadline.IsZero() && time.Now().After(req.Deadline) {
+		log.Printf("[AGENT] Dropped %s request %s: the caller has given up", req.Method, req.ID)
+		return true
+	}
+	go s.run(req)
+	return true
+}
+
+func (s *Server) run(req Request) {
+	ctx := context.Background()
+	if !req.Deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, req.Deadline)
+		defer cancel()
+	}
+
+	s.mu.RLock()
+	h, ok := s.handlers[req.Method]
+	s.mu.RUnlock()
+
+	var result any
+	var err error
+	if ok {
+		result, err = h(ctx, req.Params)
+	} else {
+		err = fmt.Errorf("%w %q", ErrUnknownMethod, req.Method)
+	}
+	if err := s.reply(ctx, req.ID, result, err); err != nil {
+		log.Printf("[AGENT] Failed to answer %s request %s: %v", req.Method, req.ID, err)
+	}
+}
+
+// reply sends a result, in chunks if it is large, or an error.
+func (s *Server) reply(ctx context.Context, id string, result any, callErr error) error {
+	channel := ReplyChannel(s.agentID)
+	if callErr != nil {
+		return s.publish(ctx, channel, Response{ID: id, Error: callErr.Error()})
+	}
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return s.publish(ctx, channel, Response{ID: id, Error: fmt.Sprintf("failed to encode result: %v", err)})
+	}
+	if len(raw) <= ChunkSize {
+		return s.publish(ctx, channel, Response{ID: id, Result: raw})
+	}
+	total := (len(raw) + ChunkSize - 1) / ChunkSize
+	if total > MaxChunks {
+		return s.publish(ctx, channel, Response{ID: id, Error: fmt.Sprintf("result of %d bytes is larger than the %d allowed", len(raw), MaxChunks*ChunkSize)})
+	}
+	for seq := 0; seq < total; seq++ {
+		end := min((seq+1)*ChunkSize, len(raw))
+		if err := s.publish(ctx, channel, Response{ID: id, Seq: seq, Total: total, Chunk: raw[seq*ChunkSize : end]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) publish(ctx context.Context, channel string, res Response) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return s.transport.Publish(ctx, channel, data)
+}
@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/centrifugal/centrifuge-go"
+)
+
+// CentrifugeTransport goes through a Centrifugo connection.
+type CentrifugeTransport struct {
+	client *centrifuge.Client
+}
+
+// NewCentrifugeTransport uses a Centrifugo client; connecting it is left to its owner.
+func NewCentrifugeTransport(client *centrifuge.Client) *CentrifugeTransport {
+	return &CentrifugeTransport{client: client}
+}
+
+func (t *CentrifugeTransport) Publish(ctx context.Context, channel string, data []byte) error {
+	_, err := t.client.Publish(ctx, channel, data)
+	return err
+}
+
+// subscribeTimeout is how long Subscribe waits for the hub to confirm a subscription.
+const subscribeTimeout = 10 * time.Second
+
+// Subscribe returns once the hub has confirmed the subscription. One that fails or is not
+// confirmed in time is dropped, so it can be tried again.
+func (t *CentrifugeTransport) Subscribe(channel string, handler func(data []byte)) error {
+	sub, err := t.client.NewSubscription(channel)
+	if err != nil {
+		return err
+	}
+	sub.OnPublication(func(e centrifuge.PublicationEvent) {
+		handler(e.Data)
+	})
+	done := make(chan error, 1)
+	sub.OnSubscribed(func(centrifuge.SubscribedEvent) {
+		select {
+		case done <- nil:
+		default:
+		}
+	})
+	sub.OnError(func(e centrifuge.SubscriptionErrorEvent) {
+		select {
+		case done <- e.Error:
+		default:
+		}
+	})
+	sub.OnUnsubscribed(func(e centrifuge.UnsubscribedEvent) {
+		select {
+		case done <- fmt.Errorf("unsubscribed from %s: %s", channel, e.Reason):
+		default:
+		}
+	})
+
+	err = sub.Subscribe()
+	if err == nil {
+		select {
+		case err = <-done:
+		case <-time.After(subscribeTimeout):
+			err = fmt.Errorf("hub did not confirm the subscription to %s", channel)
+		}
+	}
+	if err != nil {
+		sub.Unsubscribe()
+		t.client.RemoveSubscription(sub)
+		return err
+	}
+	return nil
+}
+
+// MemoryTransport delivers publications in process, for tests and for an agent running in the
+// same process as the console.
+type MemoryTransport struct {
+	mu       sync.RWMutex
+	handlers map[string][]func([]byte)
+}
+
+// NewMemoryTransport creates an empty in-process hub.
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{handlers: make(map[string][]func([]byte))}
+}
+
+func (t *MemoryTransport) Publish(ctx context.Context, channel string, data []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	t.mu.RLock()
+	handlers := t.handlers[channel]
+	t.mu.RUnlock()
+	for _, h := range handlers {
+		h(append([]byte(nil), data...))
+	}
+	return nil
+}
+
+func (t *MemoryTransport) Subscribe(channel string, handler func(data []byte)) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handlers[channel] = append(t.handlers[channel], handler)
+	return nil
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// placeholder is how a script refers to a parameter: {{name}}.
+var placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+var paramName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ScriptParameters lists the parameters a script's {{name}} placeholders refer to, in the order
+// they first appear.
+func ScriptParameters(content string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, m := range placeholder.FindAllStringSubmatch(content, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
+
+// ValidateParameters checks a job supplies every parameter its script declares, and no others.
+func ValidateParameters(declared []string, values map[string]string) error {
+	known := make(map[string]bool, len(declared))
+	var missing []string
+	for _, name := range declared {
+		if !paramName.MatchString(name) {
+			return fmt.Errorf("script parameter %q is not a valid name", name)
+		}
+		known[name] = true
+		if _, ok := values[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing values for script parameters: %s", strings.Join(missing, ", "))
+	}
+	var unknown []string
+	for name := range values {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("the script has no parameters named %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// RenderScript fills in a script's {{name}} placeholders with the job's values, each quoted as
+// a literal for the script's shell so a value cannot run commands of its own. Placeholders for
+// names the script does not declare are left alone.
+func RenderScript(scriptType, content string, declared []string, values map[string]string) (string, error) {
+	if err := ValidateParameters(declared, values); err != nil {
+		return "", err
+	}
+	quote, err := quoter(scriptType)
+	if err != nil {
+		return "", err
+	}
+	known := make(map[string]bool, len(declared))
+	for _, name := range declared {
+		known[name] = true
+	}
+	return placeholder.ReplaceAllStringFunc(content, func(m string) string {
+		name := placeholder.FindStringSubmatch(m)[1]
+		if !known[name] {
+			return m
+		}
+		return quote(values[name])
+	}), nil
+}
+
+func quoter(scriptType string) (func(string) string, error) {
+	switch scriptType {
+	case "sh":
+		// 'it'\''s'
+		return func(v string) string { return "'" + strings.ReplaceAll(v, "'", `'\''`) + "'" }, nil
+	case "ps1":
+		// 'it''s'; PowerShell also closes single quotes with the typographic ones.
+		r := strings.NewReplacer("'", "''", "‘", "‘‘", "’", "’’", "‚", "‚‚", "‛", "‛‛")
+		return func(v string) string { return "'" + r.Replace(v) + "'" }, nil
+	}
+	return nil, fmt.Errorf("unsupported script type %q", scriptType)
+}
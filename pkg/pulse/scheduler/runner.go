@@ -0,0 +1,184 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"sent/pkg/pulse/rpc"
+)
+
+// flushInterval is how often a running script's output is passed on.
+const flushInterval = time.Second
+
+// outputWait is how long to wait, once a script has finished, for output still on its way.
+const outputWait = 2 * time.Second
+
+// Outcome is how a script run ended and what it printed.
+type Outcome struct {
+	Result    rpc.ScriptResult
+	Output    string
+	Truncated bool
+}
+
+// Runner runs scripts on agents, gathering the output they stream while the script runs.
+type Runner struct {
+	caller    *rpc.Caller
+	transport rpc.Transport
+
+	mu   sync.Mutex
+	runs map[int]*outputLog
+
+	// subMu serializes subscribing, apart from mu so output is received while the hub
+	// confirms a subscription.
+	subMu   sync.Mutex
+	outputs map[string]bool // Output channels subscribed to, per agent
+}
+
+// NewRunner reaches agents through t.
+func NewRunner(t rpc.Transport) *Runner {
+	return &Runner{
+		caller:    rpc.NewCaller(t),
+		transport: t,
+		outputs:   make(map[string]bool),
+		runs:      make(map[int]*outputLog),
+	}
+}
+
+// Run runs a script on an agent and waits for it to finish, passing the output so far to
+// progress as it arrives.
+func (r *Runner) Run(ctx context.Context, agentID string, p rpc.ScriptParams, progress func(output string)) (Outcome, error) {
+	if err := r.subscribe(agentID); err != nil {
+		return Outcome{}, err
+	}
+	log := newOutputLog()
+	r.mu.Lock()
+	r.runs[p.ExecutionID] = log
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.runs, p.ExecutionID)
+		r.mu.Unlock()
+	}()
+
+	stop := make(chan struct{})
+	flushed := make(chan struct{})
+	go func() {
+		defer close(flushed)
+		ticker := time.NewTicker(flushInterval)
+		defer ticker.Stop()
+		last := 0
+		for {
+			select {
+			case <-ticker.C:
+				if text, _, n := log.snapshot(); n != last {
+					last = n
+					progress(text)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	var result rpc.ScriptResult
+	err := r.caller.Call(ctx, agentID, rpc.MethodRunScript, p, &result)
+	close(stop)
+	<-flushed
+	if err == nil {
+		log.wait(result.Chunks, outputWait)
+	}
+	text, truncated, _ := log.snapshot()
+	return Outcome{Result: result, Output: text, Truncated: truncated}, err
+}
+
+// subscribe listens on an agent's output channel, once per agent.
+func (r *Runner) subscribe(agentID string) error {
+	r.subMu.Lock()
+	defer r.subMu.Unlock()
+	if r.outputs[agentID] {
+		return nil
+	}
+	if err := r.transport.Subscribe(rpc.OutputChannel(agentID), r.receive); err != nil {
+		return err
+	}
+	r.outputs[agentID] = true
+	return nil
+}
+
+func (r *Runner) receive(data []byte) {
+	var o rpc.ScriptOutput
+	if err := json.Unmarshal(data, &o); err != nil {
+		return
+	}
+	r.mu.Lock()
+	log := r.runs[o.ExecutionID]
+	r.mu.Unlock()
+	if log != nil {
+		log.add(o.Seq, o.Data)
+	}
+}
+
+// outputLog puts a run's output back in order, keeping up to rpc.MaxScriptOutput of it.
+type outputLog struct {
+	mu        sync.Mutex
+	changed   *sync.Cond
+	text      strings.Builder
+	next      int
+	early     map[int]string // Pieces that arrived before the ones they follow
+	truncated bool
+}
+
+func newOutputLog() *outputLog {
+	l := &outputLog{early: make(map[int]string)}
+	l.changed = sync.NewCond(&l.mu)
+	return l
+}
+
+func (l *outputLog) add(seq int, data string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if seq < l.next {
+		return // Duplicate
+	}
+	l.early[seq] = data
+	for {
+		piece, ok := l.early[l.next]
+		if !ok {
+			break
+		}
+		delete(l.early, l.next)
+		l.next++
+		if room := rpc.MaxScriptOutput - l.text.Len(); len(piece) > room {
+			piece = piece[:max(room, 0)]
+			l.truncated = true
+		}
+		l.text.WriteString(piece)
+	}
+	l.changed.Broadcast()
+}
+
+// wait waits up to d for the first n pieces.
+func (l *outputLog) wait(n int, d time.Duration) {
+	timer := time.AfterFunc(d, func() {
+		l.mu.Lock()
+		l.changed.Broadcast()
+		l.mu.Unlock()
+	})
+	defer timer.Stop()
+	deadline := time.Now().Add(d)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.next < n && time.Now().Before(deadline) {
+		l.changed.Wait()
+	}
+}
+
+// snapshot returns the output so far, whether any was dropped and how many pieces it has.
+func (l *outputLog) snapshot() (string, bool, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.text.String(), l.truncated, l.next
+}
@@ -0,0 +1,141 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"sent/pkg/pulse/rpc"
+)
+
+func TestScheduleNext(t *testing.T) {
+	utc := func(s string) time.Time {
+		v, err := time.Parse("2006-01-02 15:04", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	cases := []struct {
+		expr, from, want string
+	}{
+		{"*/15 * * * *", "2026-03-10 10:07", "2026-03-10 10:15"},
+		{"0 0 * * *", "2026-03-10 10:07", "2026-03-11 00:00"},
+		{"@hourly", "2026-03-10 10:00", "2026-03-10 11:00"},
+		{"30 2 * * mon-fri", "2026-03-13 03:00", "2026-03-16 02:30"}, // Friday to Monday
+		{"0 9 1 jan,jul *", "2026-03-10 10:07", "2026-07-01 09:00"},
+		{"0 0 29 2 *", "2026-03-10 10:07", "2028-02-29 00:00"},
+		// Either day field matches when both are restricted: the 13th, or a Friday.
+		{"0 12 13 * 5", "2026-03-10 10:07", "2026-03-13 12:00"},
+		{"0 12 13 * 5", "2026-03-13 12:00", "2026-03-20 12:00"},
+		// Sunday is 0 or 7.
+		{"0 8 * * 7", "2026-03-10 10:07", "2026-03-15 08:00"},
+		{"@every 90m", "2026-03-10 10:07", "2026-03-10 11:37"},
+	}
+	for _, c := range cases {
+		s, err := ParseSchedule(c.expr)
+		if err != nil {
+			t.Errorf("%q: %v", c.expr, err)
+			continue
+		}
+		if got := s.Next(utc(c.from)); !got.Equal(utc(c.want)) {
+			t.Errorf("%q from %s = %s, want %s", c.expr, c.from, got.Format("2006-01-02 15:04"), c.want)
+		}
+	}
+
+	for _, expr := range []string{"", "manual", "Manual"} {
+		if s, err := ParseSchedule(expr); s != nil || err != nil {
+			t.Errorf("%q = %v, %v; want no schedule", expr, s, err)
+		}
+	}
+	for _, expr := range []string{"* * * *", "60 * * * *", "* 24 * * *", "5-1 * * * *", "*/0 * * * *", "@every 10s", "@fortnightly"} {
+		if _, err := ParseSchedule(expr); err == nil {
+			t.Errorf("%q parsed", expr)
+		}
+	}
+	if _, err := NextRun("0 0 30 2 *", time.Now()); err == nil {
+		t.Error("a schedule that never runs was accepted")
+	}
+}
+
+func TestRenderScript(t *testing.T) {
+	content := "echo {{ greeting }} {{name}}\nrm -rf {{path}}\necho {{undeclared}}"
+	declared := ScriptParameters(content)
+	if strings.Join(declared, ",") != "greeting,name,path,undeclared" {
+		t.Fatalf("parameters = %v", declared)
+	}
+	declared = declared[:3]
+
+	values := map[string]string{
+		"greeting": "it's",
+		"name":     "$(reboot)",
+		"path":     "/tmp/x; rm -rf /",
+	}
+	got, err := RenderScript("sh", content, declared, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "echo 'it'\\''s' '$(reboot)'\nrm -rf '/tmp/x; rm -rf /'\necho {{undeclared}}"
+	if got != want {
+		t.Errorf("sh:\n%s\nwant:\n%s", got, want)
+	}
+
+	got, err = RenderScript("ps1", "Write-Output {{greeting}}", declared[:1], map[string]string{"greeting": "it’s $env:PATH"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Write-Output 'it’’s $env:PATH'"; got != want {
+		t.Errorf("ps1 = %s, want %s", got, want)
+	}
+
+	if _, err := RenderScript("sh", content, declared, map[string]string{"greeting": "hi"}); err == nil {
+		t.Error("missing parameters were accepted")
+	}
+	values["extra"] = "x"
+	if _, err := RenderScript("sh", content, declared, values); err == nil {
+		t.Error("an undeclared parameter was accepted")
+	}
+	if _, err := RenderScript("bat", "echo", nil, nil); err == nil {
+		t.Error("an unknown script type was accepted")
+	}
+}
+
+func TestRunnerGathersOutput(t *testing.T) {
+	hub := rpc.NewMemoryTransport()
+	agent := rpc.NewServer(hub, "7")
+	hub.Subscribe(rpc.ControlChannel("7"), func(data []byte) { agent.Serve(data) })
+
+	publish := func(o rpc.ScriptOutput) {
+		data, _ := json.Marshal(o)
+		hub.Publish(context.Background(), rpc.OutputChannel("7"), data)
+	}
+	agent.Handle(rpc.MethodRunScript, rpc.Typed(func(ctx context.Context, p rpc.ScriptParams) (any, error) {
+		if p.Content != "echo hi" || p.Timeout != 30 {
+			t.Errorf("agent got %+v", p)
+		}
+		// Pieces may arrive out of order, and output of other runs is not this one's.
+		publish(rpc.ScriptOutput{ExecutionID: p.ExecutionID, Seq: 1, Stream: "stderr", Data: "world\n"})
+		publish(rpc.ScriptOutput{ExecutionID: p.ExecutionID + 1, Seq: 0, Stream: "stdout", Data: "other\n"})
+		publish(rpc.ScriptOutput{ExecutionID: p.ExecutionID, Seq: 0, Stream: "stdout", Data: "hello\n"})
+		// The last piece lands after the result.
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			publish(rpc.ScriptOutput{ExecutionID: p.ExecutionID, Seq: 2, Stream: "stdout", Data: "done\n"})
+		}()
+		return rpc.ScriptResult{ExitCode: 3, Chunks: 3}, nil
+	}))
+
+	r := NewRunner(hub)
+	out, err := r.Run(context.Background(), "7", rpc.ScriptParams{ExecutionID: 12, Type: "sh", Content: "echo hi", Timeout: 30}, func(string) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Result.ExitCode != 3 || out.Truncated {
+		t.Errorf("result = %+v", out)
+	}
+	if out.Output != "hello\nworld\ndone\n" {
+		t.Errorf("output = %q", out.Output)
+	}
+}
@@ -0,0 +1,286 @@
+// Package scheduler runs Pulse jobs: it starts them on their cron schedule, runs their script
+// on each target agent and records each agent's run as a JobExecution, with the output the
+// agent streams back while the script runs.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"sent/ent"
+	"sent/ent/job"
+	"sent/ent/jobexecution"
+	"sent/ent/tenant"
+	"sent/pkg/pulse/rpc"
+	"sent/pkg/pulse/targeting"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// runGrace is how long past a script's timeout the console waits for the agent's answer.
+const runGrace = time.Minute
+
+// JobSweepArgs starts the jobs that are due.
+type JobSweepArgs struct{}
+
+func (JobSweepArgs) Kind() string { return "pulse:job_sweep" }
+
+// JobSweepWorker starts every job whose next run has come, then moves it to its next run.
+type JobSweepWorker struct {
+	river.WorkerDefaults[JobSweepArgs]
+	db *ent.Client
+}
+
+func NewJobSweepWorker(db *ent.Client) *JobSweepWorker {
+	return &JobSweepWorker{db: db}
+}
+
+func (w *JobSweepWorker) Work(ctx context.Context, j *river.Job[JobSweepArgs]) error {
+	now := time.Now()
+	due, err := w.db.Job.Query().Where(job.NextRunLTE(now)).All(ctx)
+	if err != nil {
+		return err
+	}
+	client := river.ClientFromContext[pgx.Tx](ctx)
+	for _, jb := range due {
+		claimed, err := claim(ctx, w.db, jb, now)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue // Another sweep has it
+		}
+		if _, err := Dispatch(ctx, w.db, client, jb.ID); err != nil {
+			log.Printf("[PULSE] Job %d (%s) did not start: %v", jb.ID, jb.Name, err)
+		}
+	}
+	return nil
+}
+
+// claim moves a due job on to its next run, so each run starts once however many sweeps see
+// it. A job without a schedule ran once and has no next run.
+func claim(ctx context.Context, db *ent.Client, jb *ent.Job, now time.Time) (bool, error) {
+	upd := db.Job.Update().
+		Where(job.ID(jb.ID), job.NextRun(jb.NextRun)).
+		SetLastRun(now)
+	schedule, err := ParseSchedule(jb.CronSchedule)
+	if err != nil {
+		log.Printf("[PULSE] Job %d has an invalid schedule, not running it again: %v", jb.ID, err)
+	}
+	if next := nextRun(schedule, now); !next.IsZero() {
+		upd.SetNextRun(next)
+	} else {
+		upd.ClearNextRun()
+	}
+	n, err := upd.Save(ctx)
+	return n == 1, err
+}
+
+// NextRun is when a job on a schedule first runs after now; zero for jobs run by hand.
+func NextRun(cronSchedule string, now time.Time) (time.Time, error) {
+	schedule, err := ParseSchedule(cronSchedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	next := nextRun(schedule, now)
+	if schedule != nil && next.IsZero() {
+		return time.Time{}, fmt.Errorf("schedule %q never runs", cronSchedule)
+	}
+	return next, nil
+}
+
+func nextRun(schedule Schedule, now time.Time) time.Time {
+	if schedule == nil {
+		return time.Time{}
+	}
+	return schedule.Next(now)
+}
+
+// Dispatch starts a run of a job: an execution for each agent it targets, each queued to run
+// its script. It returns the executions' IDs.
+func Dispatch(ctx context.Context, db *ent.Client, client *river.Client[pgx.Tx], jobID int) ([]int, error) {
+	if client == nil {
+		return nil, fmt.Errorf("job queue is not available")
+	}
+	jb, err := db.Job.Query().Where(job.ID(jobID)).WithScript().WithTenant().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := jb.Edges.Script
+	// A job whose parameters no longer fit its script fails here rather than on every agent.
+	if _, err := RenderScript(string(s.Type), s.Content, s.Parameters, jb.Parameters); err != nil {
+		return nil, err
+	}
+	// Groups and tags are resolved now, so the run reaches whoever is in them today.
+	agents, err := targeting.Resolve(ctx, db, jb.Edges.Tenant.ID, jb.Targets)
+	if err != nil {
+		return nil, err
+	}
+	if len(agents) == 0 {
+		return nil, fmt.Errorf("job %q has no agents to run on", jb.Name)
+	}
+
+	ids := make([]int, 0, len(agents))
+	for _, a := range agents {
+		e, err := db.JobExecution.Create().SetJobID(jb.ID).SetAgentID(a.ID).Save(ctx)
+		if err != nil {
+			return ids, err
+		}
+		// A script may not be safe to run twice, so a run that fails is not retried.
+		_, err = client.Insert(ctx, RunScriptArgs{ExecutionID: e.ID, Timeout: jb.TimeoutSeconds}, &river.InsertOpts{MaxAttempts: 1})
+		if err != nil {
+			db.JobExecution.UpdateOne(e).
+				SetStatus(jobexecution.StatusFailed).
+				SetOutput("could not be queued: " + err.Error()).
+				SetCompletedAt(time.Now()).
+				Exec(ctx)
+			return ids, err
+		}
+		ids = append(ids, e.ID)
+	}
+	db.Job.UpdateOneID(jb.ID).SetLastRun(time.Now()).Exec(ctx)
+	return ids, nil
+}
+
+// RunScriptArgs runs a job's script on one agent.
+type RunScriptArgs struct {
+	ExecutionID int `json:"execution_id"`
+	Timeout     int `json:"timeout"` // Seconds
+}
+
+func (RunScriptArgs) Kind() string { return "pulse:run_script" }
+
+// RunScriptWorker runs an execution's script on its agent and records how it went.
+type RunScriptWorker struct {
+	river.WorkerDefaults[RunScriptArgs]
+	db     *ent.Client
+	runner *Runner
+}
+
+func NewRunScriptWorker(db *ent.Client) *RunScriptWorker {
+	return &RunScriptWorker{db: db}
+}
+
+// SetTransport connects the worker to the agents, through the console's hub connection.
+func (w *RunScriptWorker) SetTransport(t rpc.Transport) {
+	w.runner = NewRunner(t)
+}
+
+func (w *RunScriptWorker) Timeout(j *river.Job[RunScriptArgs]) time.Duration {
+	return scriptTimeout(j.Args.Timeout) + runGrace
+}
+
+func scriptTimeout(seconds int) time.Duration {
+	d := time.Duration(seconds) * time.Second
+	if d <= 0 || d > rpc.MaxScriptTimeout {
+		return rpc.MaxScriptTimeout
+	}
+	return d
+}
+
+func (w *RunScriptWorker) Work(ctx context.Context, j *river.Job[RunScriptArgs]) error {
+	e, err := w.db.JobExecution.Query().
+		Where(jobexecution.ID(j.Args.ExecutionID)).
+		WithAgent().
+		WithJob(func(q *ent.JobQuery) { q.WithScript() }).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if e.Status != jobexecution.StatusPending {
+		return nil // Cancelled before it started
+	}
+	fail := func(msg string) error {
+		return w.finish(ctx, e.ID, jobexecution.StatusFailed, msg, nil, false)
+	}
+	if w.runner == nil {
+		return fail("the console is not connected to the agent hub")
+	}
+	jb, s := e.Edges.Job, e.Edges.Job.Edges.Script
+	content, err := RenderScript(string(s.Type), s.Content, s.Parameters, jb.Parameters)
+	if err != nil {
+		return fail(err.Error())
+	}
+
+	n, err := w.db.JobExecution.Update().
+		Where(jobexecution.ID(e.ID), jobexecution.StatusEQ(jobexecution.StatusPending)).
+		SetStatus(jobexecution.StatusRunning).
+		SetStartedAt(time.Now()).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return err
+	}
+
+	timeout := scriptTimeout(j.Args.Timeout)
+	runCtx, cancel := context.WithTimeout(ctx, timeout+runGrace)
+	defer cancel()
+	params := rpc.ScriptParams{ExecutionID: e.ID, Type: string(s.Type), Content: content, Timeout: int(timeout / time.Second)}
+	out, err := w.runner.Run(runCtx, strconv.Itoa(e.Edges.Agent.ID), params, func(output string) {
+		w.db.JobExecution.UpdateOneID(e.ID).SetOutput(output).Exec(ctx)
+	})
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, rpc.ErrTimeout) {
+			msg = "the agent did not answer; it may be offline"
+		}
+		if out.Output != "" {
+			msg = out.Output + "\n" + msg
+		}
+		return w.finish(ctx, e.ID, jobexecution.StatusFailed, msg, nil, out.Truncated)
+	}
+
+	status := jobexecution.StatusSuccess
+	switch {
+	case out.Result.Cancelled:
+		status = jobexecution.StatusCancelled
+	case out.Result.TimedOut:
+		status = jobexecution.StatusTimeout
+	case out.Result.ExitCode != 0:
+		status = jobexecution.StatusFailed
+	}
+	code := out.Result.ExitCode
+	return w.finish(ctx, e.ID, status, out.Output, &code, out.Truncated || out.Result.Truncated)
+}
+
+func (w *RunScriptWorker) finish(ctx context.Context, id int, status jobexecution.Status, output string, exitCode *int, truncated bool) error {
+	return w.db.JobExecution.UpdateOneID(id).
+		SetStatus(status).
+		SetOutput(output).
+		SetNillableExitCode(exitCode).
+		SetOutputTruncated(truncated).
+		SetCompletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// CancelExecution stops a run. One that has not started is marked cancelled; a running one is
+// stopped on its agent, which reports it cancelled.
+func CancelExecution(ctx context.Context, db *ent.Client, caller *rpc.Caller, tenantID, executionID int) error {
+	e, err := db.JobExecution.Query().
+		Where(jobexecution.ID(executionID), jobexecution.HasJobWith(job.HasTenantWith(tenant.ID(tenantID)))).
+		WithAgent().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	switch e.Status {
+	case jobexecution.StatusPending:
+		n, err := db.JobExecution.Update().
+			Where(jobexecution.ID(e.ID), jobexecution.StatusEQ(jobexecution.StatusPending)).
+			SetStatus(jobexecution.StatusCancelled).
+			SetCompletedAt(time.Now()).
+			Save(ctx)
+		if err != nil || n == 1 {
+			return err
+		}
+		// It started meanwhile
+	case jobexecution.StatusRunning:
+	default:
+		return fmt.Errorf("execution %d has already finished", executionID)
+	}
+	return caller.Call(ctx, strconv.Itoa(e.Edges.Agent.ID), rpc.MethodCancelScript, rpc.ExecutionParams{ExecutionID: e.ID}, nil)
+}
@@ -6,6 +6,7 @@ import (
 	"sent/ent"
 	"sent/ent/script"
     "sent/ent/tenant"
+	"sent/pkg/pulse/scheduler"
 )
 
 // ScriptManager handles script CRUD
@@ -42,6 +43,7 @@ func (m *ScriptManager) CreateScript(ctx context.Context, tenantID string, name,
         SetName(name).
         SetDescription(description).
         SetContent(content).
+        SetParameters(scheduler.ScriptParameters(content)).
         SetType(script.Type(scriptType)). // ps1 or sh
         Save(ctx)
 }
@@ -57,6 +59,7 @@ func (m *ScriptManager) ListScripts(ctx context.Context, tenantID string) ([]*en
 func (m *ScriptManager) UpdateScript(ctx context.Context, id int, content string) (*ent.Script, error) {
     return m.client.Script.UpdateOneID(id).
         SetContent(content).
+        SetParameters(scheduler.ScriptParameters(content)).
         Save(ctx)
 }
 
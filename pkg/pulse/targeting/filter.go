@@ -0,0 +1,337 @@
+package targeting
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"sent/ent"
+)
+
+// Filter picks agents by their attributes, for dynamic groups.
+//
+// A filter is conditions joined with AND, OR and NOT, grouped with parentheses:
+//
+//	os = linux AND hostname ~ "pos-*" AND last_seen < 1h
+//
+// String fields (hostname, os, arch, ip, mac, version, status) compare with = and != and match
+// globs with ~ and !~, ignoring case. tag = x holds when the agent has the tag x, and tag ~ glob
+// when it has one matching. last_seen compares how long ago the agent was last seen with a
+// duration: last_seen < 1h is an agent seen within the hour. Durations take s, m, h, d and w.
+type Filter interface {
+	Match(a *ent.Agent, now time.Time) bool
+	String() string
+}
+
+// ParseFilter reads a filter expression.
+func ParseFilter(expr string) (Filter, error) {
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("filter is empty")
+	}
+	p := &parser{tokens: tokens}
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in filter", p.tokens[p.pos].text)
+	}
+	return f, nil
+}
+
+type tokenKind int
+
+const (
+	tokWord tokenKind = iota
+	tokString
+	tokOp
+	tokOpen
+	tokClose
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func lex(expr string) ([]token, error) {
+	var tokens []token
+	r := []rune(expr)
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokOpen, "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokClose, ")"})
+			i++
+		case c == '"':
+			var sb strings.Builder
+			i++
+			for ; i < len(r) && r[i] != '"'; i++ {
+				if r[i] == '\\' && i+1 < len(r) {
+					i++
+				}
+				sb.WriteRune(r[i])
+			}
+			if i == len(r) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			i++
+			tokens = append(tokens, token{tokString, sb.String()})
+		case strings.ContainsRune("=!~<>", c):
+			op := string(c)
+			if i+1 < len(r) && (r[i+1] == '=' && c != '=' && c != '~' || r[i+1] == '~' && c == '!') {
+				op += string(r[i+1])
+			}
+			i += len([]rune(op))
+			tokens = append(tokens, token{tokOp, op})
+		default:
+			start := i
+			for i < len(r) && !unicode.IsSpace(r[i]) && !strings.ContainsRune(`()"=!~<>`, r[i]) {
+				i++
+			}
+			tokens = append(tokens, token{tokWord, string(r[start:i])})
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peekKeyword(kw string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokWord && strings.EqualFold(p.tokens[p.pos].text, kw)
+}
+
+func (p *parser) or() (Filter, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (Filter, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (Filter, error) {
+	if p.pos == len(p.tokens) {
+		return nil, fmt.Errorf("filter ends too soon")
+	}
+	if p.peekKeyword("not") {
+		p.pos++
+		f, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{f}, nil
+	}
+	if p.tokens[p.pos].kind == tokOpen {
+		p.pos++
+		f, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos == len(p.tokens) || p.tokens[p.pos].kind != tokClose {
+			return nil, fmt.Errorf("missing ) in filter")
+		}
+		p.pos++
+		return f, nil
+	}
+	return p.condition()
+}
+
+func (p *parser) condition() (Filter, error) {
+	if p.pos+3 > len(p.tokens) {
+		return nil, fmt.Errorf("filter ends too soon")
+	}
+	field, op, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	if field.kind != tokWord {
+		return nil, fmt.Errorf("expected a field name, got %q", field.text)
+	}
+	if op.kind != tokOp {
+		return nil, fmt.Errorf("expected an operator after %s, got %q", field.text, op.text)
+	}
+	if value.kind != tokWord && value.kind != tokString {
+		return nil, fmt.Errorf("expected a value after %s %s, got %q", field.text, op.text, value.text)
+	}
+	p.pos += 3
+
+	name := strings.ToLower(field.text)
+	switch name {
+	case "last_seen":
+		switch op.text {
+		case "<", "<=", ">", ">=":
+		default:
+			return nil, fmt.Errorf("last_seen compares with <, <=, > or >=")
+		}
+		d, err := parseAge(value.text)
+		if err != nil {
+			return nil, err
+		}
+		return ageFilter{op: op.text, age: d}, nil
+	case "tag":
+	default:
+		if _, ok := stringFields[name]; !ok {
+			return nil, fmt.Errorf("unknown field %q", field.text)
+		}
+	}
+	switch op.text {
+	case "=", "!=", "~", "!~":
+	default:
+		return nil, fmt.Errorf("%s compares with =, !=, ~ or !~", name)
+	}
+	pattern := strings.ToLower(value.text)
+	if strings.Contains(op.text, "~") {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("bad pattern %q", value.text)
+		}
+	}
+	return fieldFilter{field: name, op: op.text, value: pattern}, nil
+}
+
+// stringFields are the agent attributes a filter compares as strings.
+var stringFields = map[string]func(a *ent.Agent) string{
+	"hostname": func(a *ent.Agent) string { return a.Hostname },
+	"os":       func(a *ent.Agent) string { return a.Os },
+	"arch":     func(a *ent.Agent) string { return a.Arch },
+	"ip":       func(a *ent.Agent) string { return a.IP },
+	"mac":      func(a *ent.Agent) string { return a.MAC },
+	"version":  func(a *ent.Agent) string { return a.Version },
+	"status":   func(a *ent.Agent) string { return string(a.Status) },
+}
+
+// parseAge reads a duration, also in days (7d) and weeks (2w).
+func parseAge(s string) (time.Duration, error) {
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+	if unit != 0 {
+		n, err := strconv.ParseFloat(s[:len(s)-1], 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("bad duration %q", s)
+		}
+		return time.Duration(n * float64(unit)), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("bad duration %q", s)
+	}
+	return d, nil
+}
+
+type andFilter struct{ left, right Filter }
+
+func (f andFilter) Match(a *ent.Agent, now time.Time) bool {
+	return f.left.Match(a, now) && f.right.Match(a, now)
+}
+
+func (f andFilter) String() string { return "(" + f.left.String() + " AND " + f.right.String() + ")" }
+
+type orFilter struct{ left, right Filter }
+
+func (f orFilter) Match(a *ent.Agent, now time.Time) bool {
+	return f.left.Match(a, now) || f.right.Match(a, now)
+}
+
+func (f orFilter) String() string { return "(" + f.left.String() + " OR " + f.right.String() + ")" }
+
+type notFilter struct{ f Filter }
+
+func (f notFilter) Match(a *ent.Agent, now time.Time) bool { return !f.f.Match(a, now) }
+
+func (f notFilter) String() string { return "NOT " + f.f.String() }
+
+type fieldFilter struct {
+	field, op, value string
+}
+
+func (f fieldFilter) Match(a *ent.Agent, now time.Time) bool {
+	var values []string
+	if f.field == "tag" {
+		values = a.Tags
+	} else {
+		values = []string{stringFields[f.field](a)}
+	}
+	found := false
+	for _, v := range values {
+		if f.matches(strings.ToLower(v)) {
+			found = true
+			break
+		}
+	}
+	if strings.HasPrefix(f.op, "!") {
+		return !found
+	}
+	return found
+}
+
+func (f fieldFilter) matches(v string) bool {
+	if strings.HasSuffix(f.op, "~") {
+		ok, _ := path.Match(f.value, v)
+		return ok
+	}
+	return v == f.value
+}
+
+func (f fieldFilter) String() string { return fmt.Sprintf("%s %s %q", f.field, f.op, f.value) }
+
+type ageFilter struct {
+	op  string
+	age time.Duration
+}
+
+func (f ageFilter) Match(a *ent.Agent, now time.Time) bool {
+	age := now.Sub(a.LastSeen)
+	switch f.op {
+	case "<":
+		return age < f.age
+	case "<=":
+		return age <= f.age
+	case ">":
+		return age > f.age
+	case ">=":
+		return age >= f.age
+	}
+	return false
+}
+
+func (f ageFilter) String() string { return fmt.Sprintf("last_seen %s %s", f.op, f.age) }
@@ -0,0 +1,273 @@
+// Package targeting resolves what Pulse work is aimed at (every agent, single agents, device
+// groups and tags) to the agents it covers. Targets are resolved when the work runs, so a
+// job on a group or a dynamic filter reaches the agents that belong to it at that moment.
+package targeting
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/agent"
+	"sent/ent/devicegroup"
+	"sent/ent/tenant"
+)
+
+// All targets every agent of the tenant.
+const All = "all"
+
+// Kind is what a target names.
+type Kind string
+
+const (
+	KindAll   Kind = "all"
+	KindAgent Kind = "agent"
+	KindGroup Kind = "group"
+	KindTag   Kind = "tag"
+)
+
+// Target is one entry of a target list: "all", "agent:<id>" (or a bare agent ID),
+// "group:<id>" or "tag:<name>".
+type Target struct {
+	Kind Kind
+	ID   int    // Of the agent or group
+	Tag  string // Of a tag target
+}
+
+func (t Target) String() string {
+	switch t.Kind {
+	case KindAgent, KindGroup:
+		return fmt.Sprintf("%s:%d", t.Kind, t.ID)
+	case KindTag:
+		return "tag:" + t.Tag
+	}
+	return All
+}
+
+// ParseTarget reads one target.
+func ParseTarget(s string) (Target, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, All) {
+		return Target{Kind: KindAll}, nil
+	}
+	kind, value, ok := strings.Cut(s, ":")
+	if !ok {
+		kind, value = string(KindAgent), s
+	}
+	switch Kind(strings.ToLower(kind)) {
+	case KindAgent, KindGroup:
+		id, err := strconv.Atoi(value)
+		if err != nil || id <= 0 {
+			return Target{}, fmt.Errorf("unknown target %q", s)
+		}
+		return Target{Kind: Kind(strings.ToLower(kind)), ID: id}, nil
+	case KindTag:
+		tag, err := NormalizeTag(value)
+		if err != nil {
+			return Target{}, err
+		}
+		return Target{Kind: KindTag, Tag: tag}, nil
+	}
+	return Target{}, fmt.Errorf("unknown target %q", s)
+}
+
+// ParseTargets reads a target list, which must not be empty.
+func ParseTargets(targets []string) ([]Target, error) {
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no targets given")
+	}
+	parsed := make([]Target, 0, len(targets))
+	for _, s := range targets {
+		t, err := ParseTarget(s)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, t)
+	}
+	return parsed, nil
+}
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._:/-]{0,62}$`)
+
+// NormalizeTag lower-cases a tag and checks it is a usable label: up to 63 letters, digits
+// and . _ : / -, starting with a letter or digit.
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if !tagPattern.MatchString(tag) {
+		return "", fmt.Errorf("invalid tag %q", tag)
+	}
+	return tag, nil
+}
+
+// NormalizeTags normalizes a set of tags, dropping duplicates, and sorts them.
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		tag, err := NormalizeTag(t)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			out = append(out, tag)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// Validate checks a target list names only agents and groups of the tenant.
+func Validate(ctx context.Context, db *ent.Client, tenantID int, targets []string) error {
+	parsed, err := ParseTargets(targets)
+	if err != nil {
+		return err
+	}
+	for _, t := range parsed {
+		var exists bool
+		switch t.Kind {
+		case KindAgent:
+			exists, err = db.Agent.Query().Where(agent.ID(t.ID), agent.HasTenantWith(tenant.ID(tenantID))).Exist(ctx)
+		case KindGroup:
+			exists, err = db.DeviceGroup.Query().Where(devicegroup.ID(t.ID), devicegroup.HasTenantWith(tenant.ID(tenantID))).Exist(ctx)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("%s %d not found", t.Kind, t.ID)
+		}
+	}
+	return nil
+}
+
+// Resolve finds the agents a target list covers within the tenant, now. Revoked agents are
+// left out.
+func Resolve(ctx context.Context, db *ent.Client, tenantID int, targets []string) ([]*ent.Agent, error) {
+	parsed, err := ParseTargets(targets)
+	if err != nil {
+		return nil, err
+	}
+	agents, err := tenantAgents(ctx, db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	chosen := make(map[int]bool)
+	for _, t := range parsed {
+		switch t.Kind {
+		case KindAll:
+			return agents, nil
+		case KindAgent:
+			chosen[t.ID] = true
+		case KindTag:
+			for _, a := range agents {
+				if hasTag(a, t.Tag) {
+					chosen[a.ID] = true
+				}
+			}
+		case KindGroup:
+			members, err := groupMembers(ctx, db, tenantID, t.ID, agents, now)
+			if err != nil {
+				return nil, err
+			}
+			for _, a := range members {
+				chosen[a.ID] = true
+			}
+		}
+	}
+	var out []*ent.Agent
+	for _, a := range agents {
+		if chosen[a.ID] {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// Members returns the agents in a device group now.
+func Members(ctx context.Context, db *ent.Client, tenantID, groupID int) ([]*ent.Agent, error) {
+	agents, err := tenantAgents(ctx, db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return groupMembers(ctx, db, tenantID, groupID, agents, time.Now())
+}
+
+// Matching returns the tenant's agents a filter picks now.
+func Matching(ctx context.Context, db *ent.Client, tenantID int, filter Filter) ([]*ent.Agent, error) {
+	agents, err := tenantAgents(ctx, db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return match(agents, filter, time.Now()), nil
+}
+
+func tenantAgents(ctx context.Context, db *ent.Client, tenantID int) ([]*ent.Agent, error) {
+	return db.Agent.Query().
+		Where(agent.HasTenantWith(tenant.ID(tenantID)), agent.RevokedAtIsNil()).
+		Order(ent.Asc(agent.FieldID)).
+		All(ctx)
+}
+
+// groupMembers picks a group's members out of the tenant's agents: those matching its filter
+// if it is dynamic, otherwise those it lists.
+func groupMembers(ctx context.Context, db *ent.Client, tenantID, groupID int, agents []*ent.Agent, now time.Time) ([]*ent.Agent, error) {
+	g, err := db.DeviceGroup.Query().
+		Where(devicegroup.ID(groupID), devicegroup.HasTenantWith(tenant.ID(tenantID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("group %d not found", groupID)
+		}
+		return nil, err
+	}
+	if g.Filter != "" {
+		filter, err := ParseFilter(g.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("group %q has an invalid filter: %w", g.Name, err)
+		}
+		return match(agents, filter, now), nil
+	}
+	ids, err := g.QueryAgents().IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	listed := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		listed[id] = true
+	}
+	var out []*ent.Agent
+	for _, a := range agents {
+		if listed[a.ID] {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func match(agents []*ent.Agent, filter Filter, now time.Time) []*ent.Agent {
+	var out []*ent.Agent
+	for _, a := range agents {
+		if filter.Match(a, now) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+func hasTag(a *ent.Agent, tag string) bool {
+	for _, t := range a.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
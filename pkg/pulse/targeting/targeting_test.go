@@ -0,0 +1,99 @@
+package targeting
+
+import (
+	"testing"
+	"time"
+
+	"sent/ent"
+	"sent/ent/agent"
+)
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+	pos := &ent.Agent{Hostname: "POS-Store-12", Os: "linux", Arch: "arm64", Status: agent.StatusOnline,
+		Tags: []string{"pos", "store:12"}, LastSeen: now.Add(-10 * time.Minute)}
+	dc := &ent.Agent{Hostname: "srv-dc01", Os: "windows", Arch: "amd64", Status: agent.StatusOffline,
+		Tags: []string{"critical"}, LastSeen: now.Add(-3 * 24 * time.Hour)}
+
+	cases := []struct {
+		filter  string
+		pos, dc bool
+	}{
+		{`os = linux AND hostname ~ "pos-*" AND last_seen < 1h`, true, false},
+		{`os = Linux`, true, false},
+		{`hostname !~ "pos-*"`, false, true},
+		{`tag = pos`, true, false},
+		{`tag ~ "store:*"`, true, false},
+		{`tag != pos`, false, true},
+		{`last_seen > 2d`, false, true},
+		{`last_seen >= 1w`, false, false},
+		{`os = windows OR tag = pos`, true, true},
+		{`NOT (os = windows OR tag = pos)`, false, false},
+		{`status = offline and arch = amd64`, false, true},
+		// AND binds tighter than OR.
+		{`os = windows AND tag = pos OR arch = arm64`, true, false},
+	}
+	for _, c := range cases {
+		f, err := ParseFilter(c.filter)
+		if err != nil {
+			t.Errorf("%s: %v", c.filter, err)
+			continue
+		}
+		if got := f.Match(pos, now); got != c.pos {
+			t.Errorf("%s on pos = %v", c.filter, got)
+		}
+		if got := f.Match(dc, now); got != c.dc {
+			t.Errorf("%s on dc = %v", c.filter, got)
+		}
+	}
+
+	for _, bad := range []string{
+		``,
+		`os`,
+		`os = `,
+		`owner = bob`,
+		`last_seen = 1h`,
+		`last_seen < soon`,
+		`os < linux`,
+		`(os = linux`,
+		`os = linux)`,
+		`os = linux AND`,
+		`hostname ~ "[pos"`,
+		`hostname = "unterminated`,
+	} {
+		if _, err := ParseFilter(bad); err == nil {
+			t.Errorf("%q parsed", bad)
+		}
+	}
+}
+
+func TestParseTarget(t *testing.T) {
+	cases := map[string]Target{
+		"all":         {Kind: KindAll},
+		"ALL":         {Kind: KindAll},
+		"12":          {Kind: KindAgent, ID: 12},
+		"agent:12":    {Kind: KindAgent, ID: 12},
+		"group:3":     {Kind: KindGroup, ID: 3},
+		"tag:POS":     {Kind: KindTag, Tag: "pos"},
+		" tag:site/a": {Kind: KindTag, Tag: "site/a"},
+	}
+	for s, want := range cases {
+		got, err := ParseTarget(s)
+		if err != nil || got != want {
+			t.Errorf("%q = %+v, %v; want %+v", s, got, err, want)
+		}
+	}
+	for _, bad := range []string{"", "group:x", "agent:-1", "tag:", "tag:-x", "site:1", "pos"} {
+		if _, err := ParseTarget(bad); err == nil {
+			t.Errorf("%q parsed", bad)
+		}
+	}
+	if _, err := ParseTargets(nil); err == nil {
+		t.Error("an empty target list was accepted")
+	}
+
+	tags, err := NormalizeTags([]string{"POS", " store:12", "pos"})
+	if err != nil || len(tags) != 2 || tags[0] != "pos" || tags[1] != "store:12" {
+		t.Errorf("tags = %v, %v", tags, err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log"
 	"sent/pkg/pulse/common"
+	"sent/pkg/pulse/rpc"
 
 	"github.com/centrifugal/centrifuge-go"
 )
@@ -64,10 +65,13 @@ func (w *PulseWorker) Start(ctx context.Context) error {
 	return sub.Subscribe()
 }
 
+// Transport is the worker's hub connection, for requests to agents.
+func (w *PulseWorker) Transport() rpc.Transport {
+	return rpc.NewCentrifugeTransport(w.client)
+}
+
 // PublishCommand sends a command to a specific agent channel.
 func (w *PulseWorker) PublishCommand(agentID string, cmd string) error {
-	// Channel format: pulse:control:<agentID>
-	channel := "pulse:control:" + agentID
-	_, err := w.client.Publish(context.Background(), channel, []byte(cmd))
+	_, err := w.client.Publish(context.Background(), rpc.ControlChannel(agentID), []byte(cmd))
 	return err
 }
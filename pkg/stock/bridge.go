@@ -18,6 +18,7 @@ import (
 	"sent/ent/maintenanceschedule"
 	"sent/ent/stockalert"
 	"sent/ent/purchaseorder"
+	"sent/ent/purchaseorderline"
 	"sent/pkg/auth"
 	"github.com/shopspring/decimal"
 )
@@ -34,6 +35,8 @@ type StockBridge struct {
 	ctx  context.Context
 	db   *ent.Client
 	auth *auth.AuthBridge
+	// labelPrinters finds a label printer, and its language, among the configured peripherals.
+	labelPrinters LabelPrinterLookup
 }
 
 // ProductDTO represents a product in the catalog.
@@ -58,6 +61,11 @@ type ProductDTO struct {
 	IsDisposed    bool `json:"isDisposed"`
 	CurrentValue  float64 `json:"currentValue"`
 	Location      string `json:"location"`
+	SoldByWeight  bool    `json:"soldByWeight"`
+	PricePerKg    float64 `json:"pricePerKg"`
+	TareWeight    float64 `json:"tareWeight"`
+	PLU           string  `json:"plu"`
+	TaxCategory   string  `json:"taxCategory"` // standard, zero_rated or exempt
 }
 
 type SupplierDTO struct {
@@ -100,6 +108,7 @@ type StockMovementDTO struct {
 	Reason      string    `json:"reason"`
 	Date        time.Time `json:"date"`
 	ProductName string    `json:"productName"`
+	LotNumber   string    `json:"lotNumber"`
 }
 
 type AuditLogDTO struct {
@@ -140,6 +149,7 @@ type PurchaseOrderDTO struct {
 	OrderDate    time.Time `json:"orderDate"`
 	ExpectedDate time.Time `json:"expectedDate"`
 	TotalAmount  float64   `json:"totalAmount"`
+	AutoGenerated bool     `json:"autoGenerated"`
 	Lines        []PurchaseOrderLineDTO `json:"lines"`
 }
 
@@ -150,6 +160,7 @@ type PurchaseOrderLineDTO struct {
 	Quantity    int     `json:"quantity"`
 	UnitCost    float64 `json:"unitCost"`
 	ReceivedQty int     `json:"receivedQty"`
+	Status      string  `json:"status"`
 }
 
 type InventoryCountDTO struct {
@@ -244,6 +255,11 @@ func (s *StockBridge) GetProducts() ([]ProductDTO, error) {
 			UsefulLifeMonths: p.UsefulLifeMonths,
 			IsDisposed:   p.IsDisposed,
 			Location:     p.Location,
+			SoldByWeight: p.SoldByWeight,
+			PricePerKg:   p.PricePerKg.InexactFloat64(),
+			TareWeight:   p.TareWeight.InexactFloat64(),
+			PLU:          p.Plu,
+			TaxCategory:  string(p.TaxCategory),
 		}
 		
 		if !p.PurchasePrice.IsZero() {
@@ -452,20 +468,60 @@ func (s *StockBridge) CreateProduct(p ProductDTO) (int, error) {
 		return 0, fmt.Errorf("tenant session invalid: %w", err)
 	}
 
-	res, err := s.db.Product.Create().
+	create := s.db.Product.Create().
 		SetSku(p.SKU).
 		SetName(p.Name).
 		SetDescription(p.Description).
 		SetUnitCost(decimal.NewFromFloat(p.UnitCost)).
 		SetQuantity(decimal.NewFromFloat(p.Quantity)).
-		SetTenant(tnt).
-		Save(s.ctx)
+		SetSoldByWeight(p.SoldByWeight && p.PricePerKg > 0).
+		SetPricePerKg(decimal.NewFromFloat(p.PricePerKg)).
+		SetTareWeight(decimal.NewFromFloat(p.TareWeight)).
+		SetTenant(tnt)
+	if p.PLU != "" {
+		create.SetPlu(p.PLU)
+	}
+	if p.TaxCategory != "" {
+		if err := product.TaxCategoryValidator(product.TaxCategory(p.TaxCategory)); err != nil {
+			return 0, fmt.Errorf("unknown tax category %q", p.TaxCategory)
+		}
+		create.SetTaxCategory(product.TaxCategory(p.TaxCategory))
+	}
+	res, err := create.Save(s.ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to create product: %w", err)
 	}
 	return res.ID, nil
 }
 
+// SetProductTaxCategory sets whether a product is taxed at the standard rate, zero-rated or
+// exempt. Sales already made keep the tax they were charged.
+func (s *StockBridge) SetProductTaxCategory(productID int, category string) error {
+	if !s.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change tax categories")
+	}
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	cat := product.TaxCategory(category)
+	if err := product.TaxCategoryValidator(cat); err != nil {
+		return fmt.Errorf("unknown tax category %q: use standard, zero_rated or exempt", category)
+	}
+	n, err := s.db.Product.Update().
+		Where(product.ID(productID), product.HasTenantWith(tenant.ID(profile.TenantID))).
+		SetTaxCategory(cat).
+		Save(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("product %d not found", productID)
+	}
+	s.logAudit("set_tax_category", "product", productID, map[string]interface{}{"category": category})
+	return nil
+}
+
 // AdjustStock creates a stock movement and updates the product quantity.
 func (s *StockBridge) AdjustStock(adj StockAdjustment) (string, error) {
 	profile, err := s.auth.GetUserProfile()
@@ -551,6 +607,7 @@ func (s *StockBridge) GetHistory() ([]StockMovementDTO, error) {
 			Reason:      m.Reason,
 			Date:        m.CreatedAt,
 			ProductName: productName,
+			LotNumber:   m.LotNumber,
 		}
 	}
 	return dtos, nil
@@ -995,10 +1052,13 @@ func (s *StockBridge) GetPurchaseOrders() ([]PurchaseOrderDTO, error) {
 		dtos[i] = PurchaseOrderDTO{
 			ID: po.ID,
 			PONumber: po.PoNumber,
+			SupplierID: po.Edges.Supplier.ID,
 			SupplierName: po.Edges.Supplier.Name,
 			Status: string(po.Status),
 			OrderDate: po.OrderDate,
+			ExpectedDate: po.ExpectedDate,
 			TotalAmount: amt,
+			AutoGenerated: po.AutoGenerated,
 		}
 		for _, l := range po.Edges.Lines {
 			cost, _ := l.UnitCost.Float64()
@@ -1009,20 +1069,25 @@ func (s *StockBridge) GetPurchaseOrders() ([]PurchaseOrderDTO, error) {
 				Quantity: l.Quantity,
 				UnitCost: cost,
 				ReceivedQty: l.ReceivedQty,
+				Status: string(l.Status),
 			})
 		}
 	}
 	return dtos, nil
 }
 
-// ReceivePurchaseOrder fulfills stock from a PO.
-func (s *StockBridge) ReceivePurchaseOrder(poID int) error {
+// ReceivePurchaseOrder receives everything still open on a PO in one shipment into the given
+// warehouse, or the tenant's first active warehouse when warehouseID is zero. Use ReceiveGoods
+// for partial shipments, bins and landed costs.
+func (s *StockBridge) ReceivePurchaseOrder(poID, warehouseID int) error {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+
 	po, err := s.db.PurchaseOrder.Query().
-		Where(purchaseorder.ID(poID)).
-		WithSupplier().
-		WithLines(func(q *ent.PurchaseOrderLineQuery) {
-			q.WithProduct()
-		}).
+		Where(purchaseorder.ID(poID), purchaseorder.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithLines().
 		Only(s.ctx)
 	if err != nil {
 		return err
@@ -1031,31 +1096,26 @@ func (s *StockBridge) ReceivePurchaseOrder(poID int) error {
 	if po.Status == purchaseorder.StatusReceived {
 		return fmt.Errorf("PO already received")
 	}
-	
-	profile, _ := s.auth.GetUserProfile()
 
-	for _, l := range po.Edges.Lines {
-		// Update product quantity
-		newQty := l.Edges.Product.Quantity.Add(decimal.NewFromInt(int64(l.Quantity)))
-		err = s.db.Product.UpdateOne(l.Edges.Product).
-			SetQuantity(newQty).
-			Exec(s.ctx)
+	if warehouseID == 0 {
+		wh, err := defaultWarehouse(s.ctx, s.db.Warehouse, profile.TenantID)
 		if err != nil {
 			return err
 		}
-			
-		// Log movement
-		_, _ = s.db.StockMovement.Create().
-			SetTenantID(profile.TenantID).
-			SetProduct(l.Edges.Product).
-			SetQuantity(decimal.NewFromInt(int64(l.Quantity))).
-			SetMovementType(stockmovement.MovementTypeIncoming).
-			SetReason(fmt.Sprintf("PO Receipt: %s", po.PoNumber)).
-			Save(s.ctx)
+		if wh == nil {
+			return fmt.Errorf("no active warehouse to receive into")
+		}
+		warehouseID = wh.ID
 	}
-	
-	_ = s.db.PurchaseOrder.UpdateOne(po).SetStatus(purchaseorder.StatusReceived).Exec(s.ctx)
-	return nil
+
+	req := GoodsReceiptRequest{PurchaseOrderID: po.ID, WarehouseID: warehouseID}
+	for _, l := range po.Edges.Lines {
+		if open := l.Quantity - l.ReceivedQty; open > 0 && l.Status != purchaseorderline.StatusClosed {
+			req.Lines = append(req.Lines, ReceiptLineRequest{PurchaseOrderLineID: l.ID, Quantity: open})
+		}
+	}
+	_, err = s.ReceiveGoods(req)
+	return err
 }
 
 // RecordCount logs a physical stock count.
@@ -0,0 +1,612 @@
+package stock
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/png"
+	"io/fs"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"sent/pkg/capital"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/qr"
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+)
+
+// Stages of the customer display.
+const (
+	DisplayIdle    = "idle"    // Slideshow between customers
+	DisplayCart    = "cart"    // Items being rung up
+	DisplayPayment = "payment" // Waiting for the customer to pay
+	DisplayPaid    = "paid"    // Thank-you screen after checkout
+)
+
+const (
+	kioskDisplaySetting = "customer_display" // Customer display settings, JSON
+	// displaySlideDir caches the slideshow next to the offline buffer, so it keeps playing
+	// when the server cannot be reached.
+	displaySlideDir = "customer_display_slides"
+	// slideRefreshInterval is how often the sync worker pulls the slideshow folder again.
+	slideRefreshInterval = 15 * time.Minute
+)
+
+//go:embed display
+var displayPage embed.FS
+
+// DisplayConfig sets up the customer display of a till. The display is a page served by the
+// till itself: a second Wails window on the till's second screen, or a tablet's browser.
+type DisplayConfig struct {
+	Enabled      bool     `json:"enabled"`
+	Listen       string   `json:"listen"`       // Address of the display page; 0.0.0.0:8787 lets a tablet on the shop network reach it
+	SlideFolder  string   `json:"slideFolder"`  // SENTvault folder of idle slideshow images
+	SlideSeconds int      `json:"slideSeconds"` // How long each slide is shown
+	IdleSeconds  int      `json:"idleSeconds"`  // How long the thank-you screen stays before the slideshow
+	PaymentQR    string   `json:"paymentQr"`    // Payment link shown as a QR code; {amount} and {reference} are filled in
+	Promotions   []string `json:"promotions"`   // Messages shown under the basket
+}
+
+// DefaultDisplayListen is where the display page is served unless configured otherwise: on the
+// till only, for a second screen.
+const DefaultDisplayListen = "127.0.0.1:8787"
+
+func (c *DisplayConfig) defaults() {
+	if c.Listen == "" {
+		c.Listen = DefaultDisplayListen
+	}
+	if c.SlideSeconds <= 0 {
+		c.SlideSeconds = 8
+	}
+	if c.IdleSeconds <= 0 {
+		c.IdleSeconds = 10
+	}
+}
+
+// DisplayLine is a basket line as the customer sees it. Amounts are formatted in the
+// functional currency and include tax, as on the shelf.
+type DisplayLine struct {
+	Name      string `json:"name"`
+	Quantity  string `json:"quantity"`
+	UnitPrice string `json:"unitPrice"`
+	Discount  string `json:"discount,omitempty"`
+	Total     string `json:"total"`
+}
+
+// DisplayTender is a payment taken for the sale.
+type DisplayTender struct {
+	Method string `json:"method"`
+	Amount string `json:"amount"`
+}
+
+// DisplayState is what the customer display shows. It is pushed to every connected display
+// whenever the till's basket changes.
+type DisplayState struct {
+	Stage        string          `json:"stage"`
+	Seller       string          `json:"seller"`
+	Currency     string          `json:"currency"`
+	Lines        []DisplayLine   `json:"lines"`
+	Savings      string          `json:"savings,omitempty"` // Line and basket discounts together
+	Total        string          `json:"total"`
+	Tenders      []DisplayTender `json:"tenders,omitempty"`
+	Promotions   []string        `json:"promotions,omitempty"`
+	PaymentQR    string          `json:"paymentQr,omitempty"` // PNG data URL
+	Slides       []string        `json:"slides"`              // Paths of the slideshow images on the display server
+	SlideSeconds int             `json:"slideSeconds"`
+	IdleSeconds  int             `json:"idleSeconds"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+}
+
+// Slide is an image of the idle slideshow.
+type Slide struct {
+	Name string
+	Data []byte
+}
+
+// SlideSource reads the images of a SENTvault folder.
+type SlideSource func(folder string) ([]Slide, error)
+
+// slideExtensions are the images browsers show without help.
+var slideExtensions = map[string]bool{".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".webp": true}
+
+// CustomerDisplay serves the customer display page and streams the till's display state to it
+// over a WebSocket. It needs nothing but the till, so it carries on while the till is offline.
+type CustomerDisplay struct {
+	slideDir string
+
+	mu      sync.Mutex
+	state   DisplayState
+	clients map[chan []byte]struct{}
+	server  *http.Server
+}
+
+// NewCustomerDisplay creates a display whose slideshow is cached in slideDir.
+func NewCustomerDisplay(slideDir string) *CustomerDisplay {
+	return &CustomerDisplay{
+		slideDir: slideDir,
+		state:    DisplayState{Stage: DisplayIdle},
+		clients:  make(map[chan []byte]struct{}),
+	}
+}
+
+// Publish shows a new state on every connected display.
+func (d *CustomerDisplay) Publish(s DisplayState) {
+	s.Slides = d.slides()
+	if s.UpdatedAt.IsZero() {
+		s.UpdatedAt = time.Now()
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.state = s
+	for ch := range d.clients {
+		// A display that has not taken the last state only needs the newest one.
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- data
+	}
+}
+
+// State returns what the display shows now.
+func (d *CustomerDisplay) State() DisplayState {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state
+}
+
+func (d *CustomerDisplay) subscribe() (chan []byte, []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	ch := make(chan []byte, 1)
+	d.clients[ch] = struct{}{}
+	data, _ := json.Marshal(d.state)
+	return ch, data
+}
+
+func (d *CustomerDisplay) unsubscribe(ch chan []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.clients, ch)
+}
+
+// slides lists the cached slideshow images in name order.
+func (d *CustomerDisplay) slides() []string {
+	entries, err := os.ReadDir(d.slideDir)
+	if err != nil {
+		return []string{}
+	}
+	out := []string{}
+	for _, e := range entries {
+		if !e.IsDir() && slideExtensions[strings.ToLower(filepath.Ext(e.Name()))] {
+			out = append(out, "slides/"+e.Name())
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ReplaceSlides caches a new slideshow, dropping images no longer in it.
+func (d *CustomerDisplay) ReplaceSlides(slides []Slide) error {
+	if err := os.MkdirAll(d.slideDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create slideshow cache: %w", err)
+	}
+	keep := make(map[string]bool)
+	for _, s := range slides {
+		name := filepath.Base(s.Name)
+		if !slideExtensions[strings.ToLower(filepath.Ext(name))] {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(d.slideDir, name), s.Data, 0o644); err != nil {
+			return fmt.Errorf("failed to cache slide %s: %w", name, err)
+		}
+		keep[name] = true
+	}
+	entries, err := os.ReadDir(d.slideDir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if !keep[e.Name()] {
+			os.Remove(filepath.Join(d.slideDir, e.Name()))
+		}
+	}
+
+	// Displays pick up the new slideshow with the current basket.
+	d.Publish(d.State())
+	return nil
+}
+
+var displayUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// ServeHTTP serves the display page, its feed and the slideshow images.
+func (d *CustomerDisplay) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/ws":
+		d.serveFeed(w, r)
+	case r.URL.Path == "/state":
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(d.State())
+	case r.URL.Path == "/feed":
+		// The page is on the display server itself; its feed is where it came from.
+		w.Write(nil)
+	case strings.HasPrefix(r.URL.Path, "/slides/"):
+		http.ServeFile(w, r, filepath.Join(d.slideDir, filepath.Base(r.URL.Path)))
+	default:
+		displayPageHandler().ServeHTTP(w, r)
+	}
+}
+
+func (d *CustomerDisplay) serveFeed(w http.ResponseWriter, r *http.Request) {
+	conn, err := displayUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("[KIOSK] Customer display failed to connect: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	ch, current := d.subscribe()
+	defer d.unsubscribe(ch)
+
+	// The page sends nothing; reading only notices it going away.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	if err := conn.WriteMessage(websocket.TextMessage, current); err != nil {
+		return
+	}
+	for {
+		select {
+		case <-closed:
+			return
+		case data := <-ch:
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func displayPageHandler() http.Handler {
+	page, _ := fs.Sub(displayPage, "display")
+	return http.FileServer(http.FS(page))
+}
+
+// DisplayWindowHandler serves the display page to a Wails window of its own, for a second
+// screen on the till. The page is fed from the till's display server at feed, e.g.
+// http://127.0.0.1:8787.
+func DisplayWindowHandler(feed string) http.Handler {
+	page := displayPageHandler()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/feed" {
+			w.Write([]byte(strings.TrimRight(feed, "/")))
+			return
+		}
+		page.ServeHTTP(w, r)
+	})
+}
+
+// Start serves the display on addr until Stop.
+func (d *CustomerDisplay) Start(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to serve customer display on %s: %w", addr, err)
+	}
+	srv := &http.Server{Handler: d, ReadHeaderTimeout: 10 * time.Second}
+
+	d.mu.Lock()
+	d.server = srv
+	d.mu.Unlock()
+
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("[KIOSK] Customer display stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Stop closes the display server and its feeds.
+func (d *CustomerDisplay) Stop() {
+	d.mu.Lock()
+	srv := d.server
+	d.server = nil
+	d.mu.Unlock()
+	if srv != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		srv.Shutdown(ctx)
+		srv.Close() // Hijacked feeds are not closed by Shutdown
+	}
+}
+
+// paymentQR fills in a payment link and renders it as a PNG data URL.
+func paymentQR(link string, amount decimal.Decimal, places int32, reference string) (string, error) {
+	link = strings.NewReplacer("{amount}", amount.StringFixed(places), "{reference}", reference).Replace(link)
+	code, err := qr.Encode(link, qr.M, qr.Auto)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode payment QR: %w", err)
+	}
+	scaled, err := barcode.Scale(code, 320, 320)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, scaled); err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// displayState lays out a basket for the customer. Prices include tax, so the basket is priced
+// without resolving rates: the display keeps up with the till while it is offline.
+func displayState(req SaleRequest, stage string, cfg DisplayConfig, places int32) (DisplayState, error) {
+	s := DisplayState{
+		Stage:        stage,
+		Lines:        []DisplayLine{},
+		Total:        decimal.Zero.StringFixed(places),
+		Promotions:   cfg.Promotions,
+		SlideSeconds: cfg.SlideSeconds,
+		IdleSeconds:  cfg.IdleSeconds,
+	}
+	if stage == DisplayIdle || len(req.Items) == 0 {
+		s.Stage = DisplayIdle
+		return s, nil
+	}
+
+	items := make([]SaleItem, len(req.Items))
+	for i, it := range req.Items {
+		it.TaxCode = ""
+		items[i] = it
+	}
+	lines, total, err := priceSale(items, req.Discount, places, nil)
+	if err != nil {
+		return s, err
+	}
+	savings := decimal.Zero
+	for i, l := range lines {
+		name := req.Items[i].Name
+		if name == "" {
+			name = fmt.Sprintf("Item %d", req.Items[i].ProductID)
+		}
+		dl := DisplayLine{
+			Name:      name,
+			Quantity:  l.Quantity.String(),
+			UnitPrice: l.UnitPrice.StringFixed(places),
+			Total:     l.Total.StringFixed(places),
+		}
+		if l.Discount.IsPositive() {
+			dl.Discount = l.Discount.StringFixed(places)
+			savings = savings.Add(l.Discount)
+		}
+		s.Lines = append(s.Lines, dl)
+	}
+	if savings.IsPositive() {
+		s.Savings = savings.StringFixed(places)
+	}
+	s.Total = total.StringFixed(places)
+
+	switch stage {
+	case DisplayPayment:
+		if cfg.PaymentQR != "" {
+			if s.PaymentQR, err = paymentQR(cfg.PaymentQR, total, places, req.UUID); err != nil {
+				return s, err
+			}
+		}
+	case DisplayPaid:
+		tenders, err := resolveTenders(req.Tenders, req.PaymentMethod, total, places)
+		if err == nil {
+			for _, t := range tenders {
+				s.Tenders = append(s.Tenders, DisplayTender{Method: t.Method, Amount: t.Amount.StringFixed(places)})
+			}
+		}
+	}
+	return s, nil
+}
+
+// SetSlideSource connects the customer display to the SENTvault folders of its slideshow.
+func (k *KioskBridge) SetSlideSource(source SlideSource) {
+	k.slideSource = source
+}
+
+// GetCustomerDisplay returns the customer display settings of this terminal.
+func (k *KioskBridge) GetCustomerDisplay() DisplayConfig {
+	return k.displaySettings()
+}
+
+// displaySettings returns the display settings; the sync worker reads them too.
+func (k *KioskBridge) displaySettings() DisplayConfig {
+	k.displayMu.Lock()
+	defer k.displayMu.Unlock()
+	return k.displayConfig
+}
+
+// SetCustomerDisplay configures the customer display of this terminal and restarts it. The
+// slideshow is pulled from SENTvault straight away, so a folder that cannot be read is
+// reported now rather than found empty on the shop floor.
+func (k *KioskBridge) SetCustomerDisplay(cfg DisplayConfig) error {
+	if !k.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change the customer display")
+	}
+	if k.buffer == nil {
+		return fmt.Errorf("offline buffer not initialized")
+	}
+	cfg.defaults()
+	if _, _, err := net.SplitHostPort(cfg.Listen); err != nil {
+		return fmt.Errorf("invalid display address %q: %w", cfg.Listen, err)
+	}
+	if cfg.PaymentQR != "" {
+		if _, err := paymentQR(cfg.PaymentQR, decimal.Zero, 2, ""); err != nil {
+			return err
+		}
+	}
+	var promotions []string
+	for _, p := range cfg.Promotions {
+		if p = strings.TrimSpace(p); p != "" {
+			promotions = append(promotions, p)
+		}
+	}
+	cfg.Promotions = promotions
+
+	if cfg.SlideFolder != "" {
+		if err := k.pullSlides(cfg.SlideFolder); err != nil {
+			return err
+		}
+	} else if err := k.display.ReplaceSlides(nil); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err := k.buffer.SetSetting(kioskDisplaySetting, string(data)); err != nil {
+		return fmt.Errorf("failed to save customer display: %w", err)
+	}
+	k.displayMu.Lock()
+	k.displayConfig = cfg
+	k.displayMu.Unlock()
+
+	k.display.Stop()
+	if cfg.Enabled {
+		if err := k.display.Start(cfg.Listen); err != nil {
+			return err
+		}
+	}
+	return k.UpdateCustomerDisplay(SaleRequest{}, DisplayIdle)
+}
+
+// UpdateCustomerDisplay shows the basket being rung up, the payment QR or the thank-you screen
+// on the customer display. An empty basket shows the slideshow.
+func (k *KioskBridge) UpdateCustomerDisplay(req SaleRequest, stage string) error {
+	switch stage {
+	case DisplayIdle, DisplayCart, DisplayPayment, DisplayPaid:
+	default:
+		return fmt.Errorf("unknown display stage %q", stage)
+	}
+	if k.displaySeller == "" {
+		k.loadDisplaySeller()
+	}
+	s, err := displayState(req, stage, k.displaySettings(), k.displayPlaces)
+	if err != nil {
+		return err
+	}
+	s.Seller, s.Currency = k.displaySeller, k.displayCurrency
+	k.display.Publish(s)
+	return nil
+}
+
+// loadDisplaySeller reads the tenant's name and currency for the display. Offline, the display
+// carries on without them until the server is back.
+func (k *KioskBridge) loadDisplaySeller() {
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return
+	}
+	tnt, err := k.db.Tenant.Get(k.ctx, profile.TenantID)
+	if err != nil {
+		return
+	}
+	k.displaySeller, k.displayCurrency = tnt.Name, tnt.FunctionalCurrency
+	k.displayPlaces = capital.CurrencyPlaces(tnt.FunctionalCurrency)
+}
+
+// RefreshDisplaySlides pulls the slideshow folder from SENTvault again.
+func (k *KioskBridge) RefreshDisplaySlides() error {
+	folder := k.displaySettings().SlideFolder
+	if folder == "" {
+		return nil
+	}
+	return k.pullSlides(folder)
+}
+
+func (k *KioskBridge) pullSlides(folder string) error {
+	if k.slideSource == nil {
+		return fmt.Errorf("SENTvault is not connected to the customer display")
+	}
+	slides, err := k.slideSource(folder)
+	if err != nil {
+		return fmt.Errorf("failed to read slideshow folder %s: %w", folder, err)
+	}
+	if err := k.display.ReplaceSlides(slides); err != nil {
+		return err
+	}
+	k.displayMu.Lock()
+	k.slidesPulledAt = time.Now()
+	k.displayMu.Unlock()
+	return nil
+}
+
+// refreshSlidesIfDue keeps the cached slideshow in step with SENTvault. While offline the pull
+// fails and the cached slides keep playing.
+func (k *KioskBridge) refreshSlidesIfDue() {
+	k.displayMu.Lock()
+	due := k.displayConfig.SlideFolder != "" && time.Since(k.slidesPulledAt) >= slideRefreshInterval
+	k.displayMu.Unlock()
+	if !due {
+		return
+	}
+	if err := k.RefreshDisplaySlides(); err != nil {
+		fmt.Printf("[KIOSK] Slideshow not refreshed: %v\n", err)
+	}
+}
+
+// loadDisplay reads the display settings kept in the local buffer.
+func (k *KioskBridge) loadDisplay() {
+	k.displayPlaces = 2
+	k.display = NewCustomerDisplay(displaySlideDir)
+	var cfg DisplayConfig
+	if k.buffer != nil {
+		if v, err := k.buffer.GetSetting(kioskDisplaySetting); err == nil && v != "" {
+			json.Unmarshal([]byte(v), &cfg)
+		}
+	}
+	cfg.defaults()
+	k.displayMu.Lock()
+	k.displayConfig = cfg
+	k.displayMu.Unlock()
+}
+
+// startDisplay serves the customer display, if enabled, until ctx ends.
+func (k *KioskBridge) startDisplay(ctx context.Context) {
+	cfg := k.displaySettings()
+	if !cfg.Enabled {
+		return
+	}
+	if err := k.display.Start(cfg.Listen); err != nil {
+		fmt.Printf("[KIOSK] Warning: %v\n", err)
+		return
+	}
+	k.display.Publish(DisplayState{Stage: DisplayIdle, Lines: []DisplayLine{}, SlideSeconds: cfg.SlideSeconds, IdleSeconds: cfg.IdleSeconds})
+	go func() {
+		<-ctx.Done()
+		k.display.Stop()
+	}()
+}
@@ -0,0 +1,131 @@
+package stock
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestDisplayState(t *testing.T) {
+	cfg := DisplayConfig{PaymentQR: "https://pay.example/shop?amount={amount}&ref={reference}", Promotions: []string{"2 for 1 on bread"}}
+	cfg.defaults()
+	req := SaleRequest{
+		UUID: "sale-1",
+		Items: []SaleItem{
+			{ProductID: 1, Name: "Milk", Quantity: 2, Price: 1.5, Discount: 0.5, TaxCode: "VAT-JO"},
+			{ProductID: 2, Quantity: 1, Price: 4},
+		},
+		Discount: 1,
+	}
+
+	// The basket is priced without rates, as the till may be offline.
+	s, err := displayState(req, DisplayCart, cfg, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Stage != DisplayCart || len(s.Lines) != 2 || s.Total != "5.50" || s.Savings != "1.50" || s.PaymentQR != "" {
+		t.Errorf("cart = %+v", s)
+	}
+	if s.Lines[0].Name != "Milk" || s.Lines[0].UnitPrice != "1.50" || s.Lines[1].Name != "Item 2" {
+		t.Errorf("lines = %+v", s.Lines)
+	}
+	if len(s.Promotions) != 1 || s.SlideSeconds != 8 {
+		t.Errorf("settings not shown: %+v", s)
+	}
+
+	s, err = displayState(req, DisplayPayment, cfg, 2)
+	if err != nil || !strings.HasPrefix(s.PaymentQR, "data:image/png;base64,") {
+		t.Errorf("payment QR = %.40s, %v", s.PaymentQR, err)
+	}
+
+	req.Tenders = []Tender{{Method: "cash", Amount: 2}, {Method: "card", Amount: 3.5}}
+	s, err = displayState(req, DisplayPaid, cfg, 2)
+	if err != nil || len(s.Tenders) != 2 || s.Tenders[1].Amount != "3.50" {
+		t.Errorf("paid = %+v, %v", s.Tenders, err)
+	}
+
+	s, err = displayState(SaleRequest{}, DisplayCart, cfg, 2)
+	if err != nil || s.Stage != DisplayIdle || s.Lines == nil {
+		t.Errorf("empty basket = %+v, %v", s, err)
+	}
+}
+
+func TestCustomerDisplayFeed(t *testing.T) {
+	d := NewCustomerDisplay(filepath.Join(t.TempDir(), "slides"))
+	if err := d.ReplaceSlides([]Slide{{Name: "a.png", Data: []byte("png")}, {Name: "notes.txt", Data: []byte("x")}}); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	// A display gets what is showing as soon as it connects, then every change.
+	var s DisplayState
+	if err := conn.ReadJSON(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Stage != DisplayIdle || len(s.Slides) != 1 || s.Slides[0] != "slides/a.png" {
+		t.Errorf("first state = %+v", s)
+	}
+	d.Publish(DisplayState{Stage: DisplayCart, Total: "3.00"})
+	if err := conn.ReadJSON(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Stage != DisplayCart || s.Total != "3.00" || len(s.Slides) != 1 {
+		t.Errorf("pushed state = %+v", s)
+	}
+
+	res, err := http.Get(srv.URL + "/slides/a.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != "png" {
+		t.Errorf("slide = %q", body)
+	}
+	res, err = http.Get(srv.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ = io.ReadAll(res.Body)
+	res.Body.Close()
+	if !strings.Contains(string(body), "Customer Display") {
+		t.Error("display page not served")
+	}
+
+	// Slides gone from the folder are dropped from the cache.
+	if err := d.ReplaceSlides(nil); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := os.ReadDir(d.slideDir); len(entries) != 0 {
+		t.Errorf("cache kept %d files", len(entries))
+	}
+}
+
+func TestDisplayWindowHandler(t *testing.T) {
+	srv := httptest.NewServer(DisplayWindowHandler("http://127.0.0.1:8787/"))
+	defer srv.Close()
+	res, err := http.Get(srv.URL + "/feed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != "http://127.0.0.1:8787" {
+		t.Errorf("feed = %q", body)
+	}
+}
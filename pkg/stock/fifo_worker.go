@@ -3,6 +3,7 @@ package stock
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"sent/ent"
@@ -40,9 +41,11 @@ func (w *FIFOWorker) Run(ctx context.Context) error {
 func (w *FIFOWorker) ReconcileCOGS(ctx context.Context) error {
 	// Fetch all outgoing movements. We filter for nil/zero COGS in memory
 	// to avoid potential SQL generation issues with IsNil on custom decimal types.
+	// Transfer legs move stock between warehouses and never consume cost layers.
 	allPending, err := w.db.StockMovement.Query().
 		Where(
 			stockmovement.MovementTypeEQ(stockmovement.MovementTypeOutgoing),
+			stockmovement.Not(stockmovement.HasTransfer()),
 		).
 		WithProduct().
 		WithTenant().
@@ -79,7 +82,7 @@ func (w *FIFOWorker) processMovement(ctx context.Context, m *ent.StockMovement)
 	remainingToConsume := m.Quantity
 	prodID := m.Edges.Product.ID
 
-	// Find oldest incoming batches for this product
+	// Find incoming batches for this product; the sold lot is costed from its own receipt.
 	batches, err := tx.StockMovement.Query().
 		Where(
 			stockmovement.HasProductWith(product.ID(prodID)),
@@ -94,6 +97,8 @@ func (w *FIFOWorker) processMovement(ctx context.Context, m *ent.StockMovement)
 		return err
 	}
 
+	orderBatches(batches, m.LotNumber)
+
 	for _, batch := range batches {
 		if remainingToConsume.IsZero() {
 			break
@@ -128,4 +133,23 @@ func (w *FIFOWorker) processMovement(ctx context.Context, m *ent.StockMovement)
 	}
 
 	return tx.Commit()
-}
\ No newline at end of file
+}
+// orderBatches puts batches of the given lot first and the rest first-expired-first-out,
+// keeping receipt order (created_at) among equals. Batches without an expiry go last.
+func orderBatches(batches []*ent.StockMovement, lot string) {
+	sort.SliceStable(batches, func(i, j int) bool {
+		a, b := batches[i], batches[j]
+		if lot != "" && (a.LotNumber == lot) != (b.LotNumber == lot) {
+			return a.LotNumber == lot
+		}
+		switch {
+		case a.ExpiryDate != nil && b.ExpiryDate == nil:
+			return true
+		case a.ExpiryDate == nil && b.ExpiryDate != nil:
+			return false
+		case a.ExpiryDate != nil:
+			return a.ExpiryDate.Before(*b.ExpiryDate)
+		}
+		return false
+	})
+}
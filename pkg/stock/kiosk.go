@@ -1,19 +1,32 @@
 package stock
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"image"
+	"strconv"
+	"sync"
 	"time"
 
 	"sent/ent"
-	"sent/ent/account"
+	"sent/ent/customer"
 	"sent/ent/inventoryreservation"
-	"sent/ent/ledgerentry"
+	"sent/ent/posshift"
 	"sent/ent/product"
+	"sent/ent/schema"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/ent/warehouse"
 	"sent/pkg/auth"
+	"sent/pkg/bridge/peripherals/escpos"
+	"sent/pkg/capital"
 	"sent/pkg/orchestrator"
+	"sent/pkg/tax"
 	"github.com/shopspring/decimal"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
 )
@@ -32,23 +45,73 @@ type KioskBridge struct {
 	river  *river.Client[pgx.Tx]
 	audit  AuditEmitter
 	auth   *auth.AuthBridge
+	ledger *capital.LedgerService
+
+	// warehouseID is the warehouse this terminal sells from; zero means the tenant default.
+	warehouseID int
+	// taxCode is applied to sale lines that do not carry their own, e.g. VAT-JO.
+	taxCode string
+	// rates holds the tax rates last resolved per code, for receipts printed while offline.
+	// The sync worker prices sales too, so it is only used under ratesMu.
+	ratesMu sync.Mutex
+	rates   map[string]tax.Rate
+	// shiftID is the shift open on this terminal; sales are only taken while one is open.
+	shiftID int
+	// printers finds the receipt printer, printerID, among the configured peripherals.
+	printers  PrinterLookup
+	printerID string
+	// scales finds the scale goods sold by weight are weighed on, scaleID.
+	scales  ScaleLookup
+	scaleID string
+	// display shows the basket to the customer, as set up by displayConfig; the seller,
+	// currency and places are those last read while online.
+	display         *CustomerDisplay
+	displayConfig   DisplayConfig
+	displaySeller   string
+	displayCurrency string
+	displayPlaces   int32
+	// slideSource reads the slideshow from SENTvault; slidesPulledAt is when it last did.
+	slideSource    SlideSource
+	slidesPulledAt time.Time
+	// displayMu guards displayConfig and slidesPulledAt, which the sync worker reads to keep
+	// the slideshow fresh while the till may be changing them.
+	displayMu sync.Mutex
 }
 
-// SaleItem represents a single line item in a sales transaction.
+// SaleItem represents a single line item in a sales transaction. Prices include tax.
 type SaleItem struct {
 	ProductID     int     `json:"productId"`
 	Quantity      float64 `json:"quantity"`
 	Price         float64 `json:"price"`
+	Discount      float64 `json:"discount,omitempty"` // Amount off the line
+	TaxCode       string  `json:"taxCode,omitempty"`  // Empty takes the terminal's tax code
+	Name          string  `json:"name,omitempty"`     // Printed on receipts of sales not recorded yet
+	Amount        float64 `json:"amount,omitempty"`   // Line total from a price label, charged instead of quantity times price
 	ReservationID *int    `json:"reservationId,omitempty"`
 }
 
 // SaleRequest encapsulates the details of a sales transaction.
 type SaleRequest struct {
+	UUID          string     `json:"uuid"`   // Generated by the terminal; the server records each sale once
+	SoldAt        time.Time  `json:"soldAt"` // When the customer paid, kept when an offline sale syncs later
 	Items         []SaleItem `json:"items"`
 	Total         float64    `json:"total"`
-	PaymentMethod string     `json:"paymentMethod"`
+	Discount      float64    `json:"discount,omitempty"` // Amount off the basket, shared across lines
+	PaymentMethod string     `json:"paymentMethod"`      // Single tender when Tenders is empty
+	Tenders       []Tender   `json:"tenders,omitempty"`
+	ShiftID       int        `json:"shiftId,omitempty"`
+	WarehouseID   int        `json:"warehouseId,omitempty"`
+	CustomerID    int        `json:"customerId,omitempty"` // Known customer, for receipts and recall tracing
 }
 
+// Terminal settings kept in the local buffer.
+const (
+	kioskWarehouseSetting = "warehouse_id" // Warehouse it sells from
+	kioskTaxCodeSetting   = "tax_code"     // Default tax code of sale lines
+	kioskShiftSetting     = "shift_id"     // Shift open on the terminal
+	kioskPrinterSetting   = "printer_id"   // Receipt printer, with the cash drawer behind it
+)
+
 // NewKioskBridge initializes a new KioskBridge with the given database client and river client.
 func NewKioskBridge(db *ent.Client, river *river.Client[pgx.Tx], audit AuditEmitter, auth *auth.AuthBridge) *KioskBridge {
 	buffer, err := NewLocalPOSBuffer("pos_offline.db")
@@ -56,15 +119,186 @@ func NewKioskBridge(db *ent.Client, river *river.Client[pgx.Tx], audit AuditEmit
 		fmt.Printf("[KIOSK] Warning: failed to initialize offline buffer: %v\n", err)
 	}
 
-	return &KioskBridge{
+	k := &KioskBridge{
 		db:     db,
 		buffer: buffer,
 		river:  river,
 		audit:  audit,
 		auth:   auth,
+		ledger: capital.NewLedgerService(),
+		rates:  make(map[string]tax.Rate),
+	}
+	if buffer != nil {
+		if v, err := buffer.GetSetting(kioskWarehouseSetting); err == nil && v != "" {
+			k.warehouseID, _ = strconv.Atoi(v)
+		}
+		k.taxCode, _ = buffer.GetSetting(kioskTaxCodeSetting)
+		if v, err := buffer.GetSetting(kioskShiftSetting); err == nil && v != "" {
+			k.shiftID, _ = strconv.Atoi(v)
+		}
+		k.printerID, _ = buffer.GetSetting(kioskPrinterSetting)
+		k.scaleID, _ = buffer.GetSetting(kioskScaleSetting)
+	}
+	k.loadDisplay()
+	return k
+}
+
+// SetWarehouse assigns the warehouse this terminal sells from. It is stored locally so the
+// terminal keeps selling from its own shop after a restart.
+func (k *KioskBridge) SetWarehouse(warehouseID int) error {
+	if !k.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change the kiosk warehouse")
+	}
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	ok, err := k.db.Warehouse.Query().
+		Where(warehouse.ID(warehouseID), warehouse.HasTenantWith(tenant.ID(profile.TenantID)), warehouse.IsActive(true)).
+		Exist(k.ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("warehouse %d not found", warehouseID)
+	}
+	if k.buffer != nil {
+		if err := k.buffer.SetSetting(kioskWarehouseSetting, strconv.Itoa(warehouseID)); err != nil {
+			return fmt.Errorf("failed to save kiosk warehouse: %w", err)
+		}
+	}
+	k.warehouseID = warehouseID
+	return nil
+}
+
+// GetWarehouse returns the warehouse this terminal sells from, zero when it uses the tenant default.
+func (k *KioskBridge) GetWarehouse() int {
+	return k.warehouseID
+}
+
+// SetTaxCountry sets the tax charged on this terminal's sales to the standard rate of a
+// country, e.g. "JO"; an empty country stops charging tax. Products that are zero-rated or
+// exempt are sold under the matching code of the same country.
+func (k *KioskBridge) SetTaxCountry(countryCode string) error {
+	if !k.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change the kiosk tax")
+	}
+	code := ""
+	if countryCode != "" {
+		profile, err := k.auth.GetUserProfile()
+		if err != nil {
+			return err
+		}
+		code = tax.CodeForCountry(countryCode)
+		if _, err := tax.NewResolver(k.db, profile.TenantID).Resolve(k.ctx, code, time.Now()); err != nil {
+			return err
+		}
+	}
+	if k.buffer != nil {
+		if err := k.buffer.SetSetting(kioskTaxCodeSetting, code); err != nil {
+			return fmt.Errorf("failed to save kiosk tax code: %w", err)
+		}
+	}
+	k.taxCode = code
+	return nil
+}
+
+// GetTaxCode returns the tax code applied to this terminal's sales, empty when it charges none.
+func (k *KioskBridge) GetTaxCode() string {
+	return k.taxCode
+}
+
+// SetPrinterLookup connects the till to the configured printers.
+func (k *KioskBridge) SetPrinterLookup(lookup PrinterLookup) {
+	k.printers = lookup
+}
+
+// SetReceiptPrinter chooses the printer receipts go to; an empty ID stops printing.
+func (k *KioskBridge) SetReceiptPrinter(printerID string) error {
+	if !k.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change the receipt printer")
+	}
+	if printerID != "" {
+		if k.printers == nil {
+			return fmt.Errorf("no printers are configured")
+		}
+		if _, err := k.printers(printerID); err != nil {
+			return err
+		}
+	}
+	if k.buffer != nil {
+		if err := k.buffer.SetSetting(kioskPrinterSetting, printerID); err != nil {
+			return fmt.Errorf("failed to save receipt printer: %w", err)
+		}
+	}
+	k.printerID = printerID
+	return nil
+}
+
+// GetReceiptPrinter returns the ID of the receipt printer, empty when receipts are not printed.
+func (k *KioskBridge) GetReceiptPrinter() string {
+	return k.printerID
+}
+
+// receiptPrinter returns the receipt printer, or nil when the terminal has none.
+func (k *KioskBridge) receiptPrinter() (ReceiptPrinter, error) {
+	if k.printerID == "" || k.printers == nil {
+		return nil, nil
 	}
+	return k.printers(k.printerID)
 }
 
+// SetReceiptLogo sets the logo printed at the top of receipts, a base64 PNG or JPEG; empty
+// removes it.
+func (k *KioskBridge) SetReceiptLogo(logoBase64 string) error {
+	if !k.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change the receipt logo")
+	}
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if logoBase64 == "" {
+		return k.db.Tenant.UpdateOneID(profile.TenantID).ClearLogo().Exec(k.ctx)
+	}
+	logo, err := base64.StdEncoding.DecodeString(logoBase64)
+	if err != nil {
+		return fmt.Errorf("invalid logo: %w", err)
+	}
+	if _, _, err := image.DecodeConfig(bytes.NewReader(logo)); err != nil {
+		return fmt.Errorf("logo must be a PNG or JPEG image: %w", err)
+	}
+	return k.db.Tenant.UpdateOneID(profile.TenantID).SetLogo(logo).Exec(k.ctx)
+}
+
+// saleWarehouse resolves the warehouse a sale takes stock from: the one on the request, the
+// terminal's own, or the tenant's default. Zero means the tenant has no warehouses and stock
+// is only tracked as a company-wide total.
+func (k *KioskBridge) saleWarehouse(tx *ent.Tx, tenantID, requested int) (int, error) {
+	if requested == 0 {
+		requested = k.warehouseID
+	}
+	if requested != 0 {
+		ok, err := tx.Warehouse.Query().
+			Where(warehouse.ID(requested), warehouse.HasTenantWith(tenant.ID(tenantID))).
+			Exist(k.ctx)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return 0, fmt.Errorf("%w: warehouse %d not found", ErrSaleRejected, requested)
+		}
+		return requested, nil
+	}
+	wh, err := defaultWarehouse(k.ctx, tx.Warehouse, tenantID)
+	if err != nil || wh == nil {
+		return 0, err
+	}
+	return wh.ID, nil
+}
+
+
+
 // ReserveStock triggers a 15-minute "Soft Lock" on stock.
 func (k *KioskBridge) ReserveStock(productID int, quantity float64) (int, error) {
 	tx, err := k.db.Tx(k.ctx)
@@ -87,6 +321,27 @@ func (k *KioskBridge) ReserveStock(productID int, quantity float64) (int, error)
 		return 0, fmt.Errorf("insufficient stock")
 	}
 
+	tenant, err := tx.Tenant.Query().First(k.ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	// Only stock held in this terminal's warehouse can be reserved.
+	whID, err := k.saleWarehouse(tx, tenant.ID, 0)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	var takes []schema.StockTake
+	if whID != 0 {
+		takes, err = takeStock(k.ctx, tx, tenant.ID, prod, whID, sellableToday(), decimalQty)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
 	err = tx.Product.UpdateOne(prod).
 		SetQuantity(prod.Quantity.Sub(decimalQty)).
 		Exec(k.ctx)
@@ -96,19 +351,16 @@ func (k *KioskBridge) ReserveStock(productID int, quantity float64) (int, error)
 	}
 
 	// 2. Create reservation record
-	tenant, err := tx.Tenant.Query().First(k.ctx)
-	if err != nil {
-		tx.Rollback()
-		return 0, fmt.Errorf("failed to get tenant: %w", err)
-	}
-
-	res, err := tx.InventoryReservation.Create().
+	create := tx.InventoryReservation.Create().
 		SetProduct(prod).
 		SetQuantity(decimalQty).
 		SetExpiresAt(time.Now().Add(15 * time.Minute)).
 		SetStatus(inventoryreservation.StatusActive).
-		SetTenant(tenant).
-		Save(k.ctx)
+		SetTenant(tenant)
+	if whID != 0 {
+		create.SetWarehouseID(whID).SetTakes(takes)
+	}
+	res, err := create.Save(k.ctx)
 	if err != nil {
 		tx.Rollback()
 		return 0, fmt.Errorf("failed to create reservation: %w", err)
@@ -139,6 +391,7 @@ func (k *KioskBridge) ReserveStock(productID int, quantity float64) (int, error)
 // Startup initializes the bridge with the application context.
 func (k *KioskBridge) Startup(ctx context.Context) {
 	k.ctx = ctx
+	k.startDisplay(ctx)
 }
 
 // OpenDrawerNoSale opens the cash drawer without a sale and emits a security event.
@@ -167,30 +420,39 @@ func (k *KioskBridge) OpenDrawerNoSale(actorID string) error {
 	return nil
 }
 
-// OpenDrawer sends a signal to the hardware cash drawer to open.
+// OpenDrawer sends the kick pulse to the cash drawer through the receipt printer it is
+// plugged into. Without a receipt printer there is no drawer to open.
 func (k *KioskBridge) OpenDrawer() error {
-	// Implementation would go here.
+	p, err := k.receiptPrinter()
+	if err != nil || p == nil {
+		return err
+	}
+	if err := p.Print(escpos.DrawerKick(2)); err != nil {
+		return fmt.Errorf("failed to open cash drawer: %w", err)
+	}
 	return nil
 }
 
-// PrintReceipt generates a formatted string receipt for a completed sale.
+// PrintReceipt prints the receipt of a sale on the receipt printer, if the terminal has one,
+// and returns it as text for the screen.
 //
 // @param req - The sale details.
 // @returns A string representation of the receipt.
 func (k *KioskBridge) PrintReceipt(req SaleRequest) (string, error) {
-	receipt := "--- SENTkiosk RECEIPT ---\n"
-	receipt += fmt.Sprintf("Date: %s\n", time.Now().Format(time.RFC822))
-	receipt += "-------------------------\n"
-	
-	for _, item := range req.Items {
-		receipt += fmt.Sprintf("Item %d  x%.0f  $%.2f\n", item.ProductID, item.Quantity, item.Price)
-	}
-	
-	receipt += "-------------------------\n"
-	receipt += fmt.Sprintf("TOTAL: $%.2f\n", req.Total)
-	receipt += "--- THANK YOU ---\n"
-
-	return receipt, nil
+	r, err := k.buildReceipt(req)
+	if err != nil {
+		return "", err
+	}
+	p, err := k.receiptPrinter()
+	if err != nil {
+		return "", err
+	}
+	if p != nil {
+		if err := p.Print(r.ESCPOS(receiptPaper)); err != nil {
+			return "", fmt.Errorf("failed to print receipt: %w", err)
+		}
+	}
+	return r.Text(receiptPaper), nil
 }
 
 // Checkout processes a sales transaction.
@@ -205,9 +467,37 @@ func (k *KioskBridge) PrintReceipt(req SaleRequest) (string, error) {
 // @param req - The sale request details.
 // @returns A success message or an error.
 func (k *KioskBridge) Checkout(req SaleRequest) (string, error) {
+	if k.shiftID == 0 {
+		return "", fmt.Errorf("no shift is open on this terminal")
+	}
+
+	// Pin the sale to this terminal's warehouse, shift and tax so a buffered sale syncs as it was rung up.
+	if req.WarehouseID == 0 {
+		req.WarehouseID = k.warehouseID
+	}
+	req.ShiftID = k.shiftID
+	for i := range req.Items {
+		if req.Items[i].TaxCode == "" {
+			req.Items[i].TaxCode = k.taxCode
+		}
+	}
+
+	if req.UUID == "" {
+		req.UUID = uuid.New().String()
+	}
+	if req.SoldAt.IsZero() {
+		req.SoldAt = time.Now()
+	}
+
 	// Try primary DB first
 	msg, err := k.performCheckout(req)
 	if err != nil {
+		// A sale the server refuses, e.g. for lack of stock, would be refused again on sync;
+		// the cashier has to deal with it now.
+		if isBusinessFailure(err) {
+			return "", err
+		}
+
 		// Log error and fallback to buffer if available
 		fmt.Printf("[KIOSK] Primary checkout failed: %v. Attempting offline buffer...\n", err)
 		
@@ -224,7 +514,23 @@ func (k *KioskBridge) Checkout(req SaleRequest) (string, error) {
 	return msg, nil
 }
 
+// saleAlreadyRecorded is the result of replaying a sale the server already has.
+const saleAlreadyRecorded = "Sale already recorded."
+
+// performCheckout records a sale once. Replaying a sale whose ID is already on the ledger, e.g.
+// a buffered sale that synced before the terminal could remove it, succeeds without posting again.
 func (k *KioskBridge) performCheckout(req SaleRequest) (string, error) {
+	msg, err := k.recordSale(req)
+	if err != nil && req.UUID != "" && ent.IsConstraintError(err) {
+		// Another replay of the same sale won the race.
+		if ok, qErr := k.db.Transaction.Query().Where(transaction.UUID(req.UUID)).Exist(k.ctx); qErr == nil && ok {
+			return saleAlreadyRecorded, nil
+		}
+	}
+	return msg, err
+}
+
+func (k *KioskBridge) recordSale(req SaleRequest) (string, error) {
 	// Start a database transaction.
 	tx, err := k.db.Tx(k.ctx)
 	if err != nil {
@@ -240,31 +546,107 @@ func (k *KioskBridge) performCheckout(req SaleRequest) (string, error) {
 	}()
 
 	// 1. Retrieve the default tenant.
-	tenant, err := tx.Tenant.Query().First(k.ctx)
+	tnt, err := tx.Tenant.Query().First(k.ctx)
 	if err != nil {
 		tx.Rollback()
 		return "", fmt.Errorf("failed to retrieve tenant: %w", err)
 	}
 
-	// 2. Create the main transaction record.
-	txn, err := tx.Transaction.Create().
-		SetDescription("Retail Sale - POS").
-		SetDate(time.Now()).
-		SetTenant(tenant).
-		Save(k.ctx)
+	if req.UUID != "" {
+		exists, err := tx.Transaction.Query().Where(transaction.UUID(req.UUID)).Exist(k.ctx)
+		if err != nil {
+			tx.Rollback()
+			return "", err
+		}
+		if exists {
+			tx.Rollback()
+			return saleAlreadyRecorded, nil
+		}
+	}
+	soldAt := req.SoldAt
+	if soldAt.IsZero() {
+		soldAt = time.Now()
+	}
+
+	if req.ShiftID != 0 {
+		ok, err := tx.PosShift.Query().Where(posshift.ID(req.ShiftID), posshift.HasTenantWith(tenant.ID(tnt.ID))).Exist(k.ctx)
+		if err != nil {
+			tx.Rollback()
+			return "", err
+		}
+		if !ok {
+			tx.Rollback()
+			return "", fmt.Errorf("%w: shift %d not found", ErrSaleRejected, req.ShiftID)
+		}
+	}
+
+	// 2. Price the lines and check the tenders pay for them.
+	places := capital.CurrencyPlaces(tnt.FunctionalCurrency)
+	rates, err := k.saleRates(tx.Client(), tnt.ID, req.Items, soldAt)
 	if err != nil {
 		tx.Rollback()
-		return "", fmt.Errorf("failed to create transaction record: %w", err)
+		return "", err
+	}
+	lines, total, err := priceSale(req.Items, req.Discount, places, rates)
+	if err != nil {
+		tx.Rollback()
+		return "", err
+	}
+	if !decimal.NewFromFloat(req.Total).Round(places).Equal(total) {
+		tx.Rollback()
+		return "", fmt.Errorf("%w: total %.2f does not match the lines, which come to %s", ErrSaleRejected, req.Total, total)
+	}
+	tenders, err := resolveTenders(req.Tenders, req.PaymentMethod, total, places)
+	if err != nil {
+		tx.Rollback()
+		return "", err
 	}
 
-	// 3. Process each item: update inventory and record movement.
-	if err := k.processSaleItems(tx, tenant, txn, req.Items); err != nil {
+	// 3. Post the sale to the ledger; this also creates the main transaction record.
+	txn, err := k.postTill(tx, tnt, false, req.UUID, soldAt, lines, tenders)
+	if err != nil {
 		tx.Rollback()
 		return "", err
 	}
 
-	// 4. Update financial ledgers.
-	if err := k.updateFinancials(tx, tenant, txn, req.Total); err != nil {
+	update := tx.Transaction.UpdateOne(txn).SetTaxAmount(totalTax(lines))
+	if req.ShiftID != 0 {
+		update.SetShiftID(req.ShiftID)
+	}
+	if req.CustomerID != 0 {
+		ok, err := tnt.QueryCustomers().Where(customer.ID(req.CustomerID)).Exist(k.ctx)
+		if err != nil {
+			tx.Rollback()
+			return "", err
+		}
+		if !ok {
+			tx.Rollback()
+			return "", fmt.Errorf("%w: customer %d not found", ErrSaleRejected, req.CustomerID)
+		}
+		update.SetCustomerID(req.CustomerID)
+	}
+	if err := update.Exec(k.ctx); err != nil {
+		tx.Rollback()
+		return "", fmt.Errorf("failed to update sale: %w", err)
+	}
+
+	// 4. Process each item: update inventory and record movement.
+	whID, err := k.saleWarehouse(tx, tnt.ID, req.WarehouseID)
+	if err != nil {
+		tx.Rollback()
+		return "", err
+	}
+	if err := k.processSaleItems(tx, tnt, txn, whID, req.Items); err != nil {
+		tx.Rollback()
+		return "", err
+	}
+	for i, item := range req.Items {
+		if err := posLineCreate(tx, txn, item.ProductID, lines[i]).Exec(k.ctx); err != nil {
+			tx.Rollback()
+			return "", fmt.Errorf("failed to record sale line: %w", err)
+		}
+	}
+	if _, err := tax.IssueSaleEInvoice(k.ctx, tx.Client(), tnt.ID, txn.ID, ""); err != nil {
 		tx.Rollback()
 		return "", err
 	}
@@ -277,8 +659,10 @@ func (k *KioskBridge) performCheckout(req SaleRequest) (string, error) {
 	return "Sale completed. Inventory and Ledger synchronized.", nil
 }
 
-// processSaleItems iterates through items to update stock and record movements.
-func (k *KioskBridge) processSaleItems(tx *ent.Tx, tenant *ent.Tenant, txn *ent.Transaction, items []SaleItem) error {
+// processSaleItems iterates through items to update stock and record movements. With a
+// warehouse, stock comes out of that warehouse only, first-expired-first-out and never past its
+// expiry, with one movement per bin and lot so a recall can trace the sale.
+func (k *KioskBridge) processSaleItems(tx *ent.Tx, tenant *ent.Tenant, txn *ent.Transaction, warehouseID int, items []SaleItem) error {
 	for _, item := range items {
 		// Lock and retrieve the product to ensure consistency.
 		prod, err := tx.Product.Query().
@@ -288,6 +672,10 @@ func (k *KioskBridge) processSaleItems(tx *ent.Tx, tenant *ent.Tenant, txn *ent.
 			return fmt.Errorf("product not found (ID: %d): %w", item.ProductID, err)
 		}
 
+		decimalQty := decimal.NewFromFloat(item.Quantity)
+		takes := []schema.StockTake{{Quantity: decimalQty}}
+		moveWarehouse := warehouseID
+
 		// Handle Reservation if provided
 		if item.ReservationID != nil {
 			res, err := tx.InventoryReservation.Query().
@@ -296,6 +684,7 @@ func (k *KioskBridge) processSaleItems(tx *ent.Tx, tenant *ent.Tenant, txn *ent.
 					inventoryreservation.HasProductWith(product.ID(prod.ID)),
 					inventoryreservation.StatusEQ(inventoryreservation.StatusActive),
 				).
+				WithWarehouse().
 				Only(k.ctx)
 			if err != nil {
 				return fmt.Errorf("active reservation %d not found for product %d: %w", *item.ReservationID, prod.ID, err)
@@ -310,11 +699,21 @@ func (k *KioskBridge) processSaleItems(tx *ent.Tx, tenant *ent.Tenant, txn *ent.
 			}
 			
 			// Stock was already decremented during ReserveStock, so we skip it here.
+			moveWarehouse = 0
+			if res.Edges.Warehouse != nil {
+				moveWarehouse = res.Edges.Warehouse.ID
+				takes = res.Takes
+			}
 		} else {
 			// Normal checkout without reservation: decrement stock now.
-			decimalQty := decimal.NewFromFloat(item.Quantity)
 			if prod.Quantity.LessThan(decimalQty) {
-				return fmt.Errorf("insufficient stock for %s (Requested: %.0f, Available: %s)", prod.Name, item.Quantity, prod.Quantity.StringFixed(0))
+				return fmt.Errorf("%w for %s (Requested: %s, Available: %s)", ErrInsufficientStock, prod.Name, decimalQty.String(), prod.Quantity.String())
+			}
+			if warehouseID != 0 {
+				takes, err = takeStock(k.ctx, tx, tenant.ID, prod, warehouseID, sellableToday(), decimalQty)
+				if err != nil {
+					return err
+				}
 			}
 
 			// Update product quantity.
@@ -326,86 +725,25 @@ func (k *KioskBridge) processSaleItems(tx *ent.Tx, tenant *ent.Tenant, txn *ent.
 			}
 		}
 
-		// Create stock movement record.
-		_, err = tx.StockMovement.Create().
-			SetQuantity(decimal.NewFromFloat(item.Quantity)).
-			SetMovementType("outgoing").
-			SetReason("Retail Sale").
-			SetProduct(prod).
-			SetTenant(tenant).
-			Save(k.ctx)
-		if err != nil {
-			return fmt.Errorf("failed to create stock movement for %s: %w", prod.Name, err)
+		// Create stock movement records.
+		for _, t := range takes {
+			create := tx.StockMovement.Create().
+				SetQuantity(t.Quantity).
+				SetMovementType("outgoing").
+				SetReason("Retail Sale").
+				SetProduct(prod).
+				SetTenant(tenant).
+				SetTransaction(txn)
+			if moveWarehouse != 0 {
+				create.SetWarehouseID(moveWarehouse).
+					SetBin(t.Bin).
+					SetLotNumber(t.LotNumber).
+					SetNillableExpiryDate(t.ExpiryDate)
+			}
+			if _, err := create.Save(k.ctx); err != nil {
+				return fmt.Errorf("failed to create stock movement for %s: %w", prod.Name, err)
+			}
 		}
 	}
 	return nil
 }
-
-// updateFinancials handles the double-entry bookkeeping for the sale.
-func (k *KioskBridge) updateFinancials(tx *ent.Tx, tenant *ent.Tenant, txn *ent.Transaction, totalAmount float64) error {
-	// Identify accounts. Hardcoded for now per business logic, but should ideally be configurable.
-	const (
-		cashAccountNum    = "1000"
-		revenueAccountNum = "4000"
-	)
-
-	cashAcc, err := tx.Account.Query().Where(account.Number(cashAccountNum)).Only(k.ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			// Soft fail or log? For strict accounting, this is a critical error.
-			return fmt.Errorf("cash account (%s) not found", cashAccountNum)
-		}
-		return err
-	}
-
-	revAcc, err := tx.Account.Query().Where(account.Number(revenueAccountNum)).Only(k.ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return fmt.Errorf("revenue account (%s) not found", revenueAccountNum)
-		}
-		return err
-	}
-
-	decimalTotal := decimal.NewFromFloat(totalAmount)
-	// Debit Cash
-	_, err = tx.LedgerEntry.Create().
-		SetAmount(decimalTotal).
-		SetDirection(ledgerentry.DirectionDebit).
-		SetAccount(cashAcc).
-		SetTransaction(txn).
-		SetTenant(tenant).
-		Save(k.ctx)
-	if err != nil {
-		return fmt.Errorf("failed to create debit entry: %w", err)
-	}
-
-	// Credit Revenue
-	_, err = tx.LedgerEntry.Create().
-		SetAmount(decimalTotal).
-		SetDirection(ledgerentry.DirectionCredit).
-		SetAccount(revAcc).
-		SetTransaction(txn).
-		SetTenant(tenant).
-		Save(k.ctx)
-	if err != nil {
-		return fmt.Errorf("failed to create credit entry: %w", err)
-	}
-
-	// Update Account Balances (Denormalization for performance)
-	// Note: This logic assumes 'Debit' increases Asset (Cash) and 'Credit' increases Income (Revenue).
-	// This is a simplified view. Real accounting systems might handle this differently based on account type.
-	err = tx.Account.UpdateOne(cashAcc).SetBalance(cashAcc.Balance.Add(decimalTotal)).Exec(k.ctx)
-	if err != nil {
-		return fmt.Errorf("failed to update cash balance: %w", err)
-	}
-
-	err = tx.Account.UpdateOne(revAcc).SetBalance(revAcc.Balance.Add(decimalTotal)).Exec(k.ctx)
-	if err != nil {
-		return fmt.Errorf("failed to update revenue balance: %w", err)
-	}
-
-		return nil
-
-	}
-
-	
\ No newline at end of file
@@ -4,9 +4,21 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
 	_ "modernc.org/sqlite"
 )
 
+// Buffered sale states. Pending sales are replayed by the sync worker; quarantined sales were
+// rejected by the server and wait for a manager to fix or void them.
+const (
+	saleStatusPending     = "pending"
+	saleStatusQuarantined = "quarantined"
+	saleStatusVoided      = "voided"
+)
+
 type LocalPOSBuffer struct {
 	db *sql.DB
 }
@@ -28,44 +40,224 @@ func NewLocalPOSBuffer(dbPath string) (*LocalPOSBuffer, error) {
 		return nil, fmt.Errorf("failed to create pos buffer table: %w", err)
 	}
 
+	// Columns added after the first release; older terminals get them on upgrade.
+	columns := []struct{ name, def string }{
+		{"uuid", "TEXT NOT NULL DEFAULT ''"},
+		{"status", "TEXT NOT NULL DEFAULT 'pending'"},
+		{"attempts", "INTEGER NOT NULL DEFAULT 0"},
+		{"last_error", "TEXT NOT NULL DEFAULT ''"},
+		{"resolution", "TEXT NOT NULL DEFAULT ''"},
+	}
+	for _, c := range columns {
+		if err := ensureColumn(db, "buffered_sales", c.name, c.def); err != nil {
+			return nil, fmt.Errorf("failed to migrate pos buffer table: %w", err)
+		}
+	}
+	query = `CREATE UNIQUE INDEX IF NOT EXISTS buffered_sales_uuid ON buffered_sales (uuid) WHERE uuid <> '';`
+	if _, err := db.Exec(query); err != nil {
+		return nil, fmt.Errorf("failed to create pos buffer index: %w", err)
+	}
+
+	// Per-terminal settings that must survive restarts, such as the warehouse it sells from.
+	query = `
+	CREATE TABLE IF NOT EXISTS settings (
+		key TEXT PRIMARY KEY,
+		value TEXT NOT NULL
+	);`
+	if _, err := db.Exec(query); err != nil {
+		return nil, fmt.Errorf("failed to create pos settings table: %w", err)
+	}
+
 	return &LocalPOSBuffer{db: db}, nil
 }
 
+// ensureColumn adds a column to an existing table unless it is already there.
+func ensureColumn(db *sql.DB, table, name, def string) error {
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var col string
+		if err := rows.Scan(&col); err != nil {
+			return err
+		}
+		if col == name {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, name, def))
+	return err
+}
+
+// BufferSale stores a sale for later sync. A sale whose ID is already buffered, e.g. a
+// double-tapped checkout, is stored once.
 func (b *LocalPOSBuffer) BufferSale(req SaleRequest) error {
 	data, err := json.Marshal(req)
 	if err != nil {
 		return err
 	}
 
-	_, err = b.db.Exec("INSERT INTO buffered_sales (data) VALUES (?)", string(data))
+	_, err = b.db.Exec("INSERT OR IGNORE INTO buffered_sales (uuid, data) VALUES (?, ?)", req.UUID, string(data))
 	return err
 }
 
+// GetPending returns the oldest sales waiting for sync. Sales buffered before sale IDs existed
+// are given one here, so every later replay of them is recognised by the server.
 func (b *LocalPOSBuffer) GetPending(limit int) ([]SaleRequest, []int64, error) {
-	rows, err := b.db.Query("SELECT id, data FROM buffered_sales ORDER BY id ASC LIMIT ?", limit)
+	rows, err := b.db.Query("SELECT id, data, strftime('%s', timestamp) FROM buffered_sales WHERE status = ? ORDER BY id ASC LIMIT ?", saleStatusPending, limit)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer rows.Close()
 
 	var sales []SaleRequest
 	var ids []int64
+	var legacy []int
 	for rows.Next() {
 		var id int64
 		var dataStr string
-		if err := rows.Scan(&id, &dataStr); err != nil {
+		var unix sql.NullString
+		if err := rows.Scan(&id, &dataStr, &unix); err != nil {
 			continue
 		}
 		var s SaleRequest
 		if err := json.Unmarshal([]byte(dataStr), &s); err != nil {
 			continue
 		}
+		if s.UUID == "" {
+			s.UUID = uuid.New().String()
+			if sec, err := strconv.ParseInt(unix.String, 10, 64); err == nil && s.SoldAt.IsZero() {
+				s.SoldAt = time.Unix(sec, 0)
+			}
+			legacy = append(legacy, len(sales))
+		}
 		sales = append(sales, s)
 		ids = append(ids, id)
 	}
+	rows.Close()
+
+	for _, i := range legacy {
+		data, err := json.Marshal(sales[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := b.db.Exec("UPDATE buffered_sales SET uuid = ?, data = ? WHERE id = ?", sales[i].UUID, string(data), ids[i]); err != nil {
+			return nil, nil, err
+		}
+	}
 	return sales, ids, nil
 }
 
+// RecordFailure counts a failed sync attempt. After maxAttempts the sale is quarantined so it
+// no longer holds up the sales behind it.
+func (b *LocalPOSBuffer) RecordFailure(id int64, reason string, maxAttempts int) error {
+	_, err := b.db.Exec(`UPDATE buffered_sales
+		SET attempts = attempts + 1, last_error = ?,
+			status = CASE WHEN attempts + 1 >= ? THEN ? ELSE status END
+		WHERE id = ?`, reason, maxAttempts, saleStatusQuarantined, id)
+	return err
+}
+
+// Quarantine parks a sale the server rejected until someone fixes or voids it.
+func (b *LocalPOSBuffer) Quarantine(id int64, reason string) error {
+	_, err := b.db.Exec("UPDATE buffered_sales SET status = ?, attempts = attempts + 1, last_error = ? WHERE id = ?", saleStatusQuarantined, reason, id)
+	return err
+}
+
+// OfflineSale is a buffered sale with its sync state.
+type OfflineSale struct {
+	ID         int64       `json:"id"`
+	Status     string      `json:"status"`
+	Attempts   int         `json:"attempts"`
+	LastError  string      `json:"lastError"`
+	Resolution string      `json:"resolution"`
+	Sale       SaleRequest `json:"sale"`
+}
+
+// GetOfflineSale returns one buffered sale.
+func (b *LocalPOSBuffer) GetOfflineSale(id int64) (*OfflineSale, error) {
+	var o OfflineSale
+	var data string
+	err := b.db.QueryRow("SELECT id, status, attempts, last_error, resolution, data FROM buffered_sales WHERE id = ?", id).
+		Scan(&o.ID, &o.Status, &o.Attempts, &o.LastError, &o.Resolution, &data)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("offline sale %d not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(data), &o.Sale); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// ListUnsynced returns the sales still waiting for sync or for review, oldest first.
+func (b *LocalPOSBuffer) ListUnsynced() ([]OfflineSale, error) {
+	rows, err := b.db.Query("SELECT id, status, attempts, last_error, resolution, data FROM buffered_sales WHERE status <> ? ORDER BY id ASC", saleStatusVoided)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sales []OfflineSale
+	for rows.Next() {
+		var o OfflineSale
+		var data string
+		if err := rows.Scan(&o.ID, &o.Status, &o.Attempts, &o.LastError, &o.Resolution, &data); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(data), &o.Sale); err != nil {
+			return nil, err
+		}
+		sales = append(sales, o)
+	}
+	return sales, rows.Err()
+}
+
+// UpdateSale replaces a quarantined sale with a corrected one and queues it for sync again.
+// The status is checked in the same statement, so a sale the sync worker is replaying, or one
+// voided meanwhile, is never overwritten.
+func (b *LocalPOSBuffer) UpdateSale(id int64, req SaleRequest, resolution string) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	res, err := b.db.Exec("UPDATE buffered_sales SET data = ?, status = ?, attempts = 0, last_error = '', resolution = ? WHERE id = ? AND status = ?",
+		string(data), saleStatusPending, resolution, id, saleStatusQuarantined)
+	if err != nil {
+		return err
+	}
+	return requireQuarantined(res, id)
+}
+
+// VoidSale drops a quarantined sale from sync. The row is kept as a record of what was voided
+// and why.
+func (b *LocalPOSBuffer) VoidSale(id int64, resolution string) error {
+	res, err := b.db.Exec("UPDATE buffered_sales SET status = ?, resolution = ? WHERE id = ? AND status = ?",
+		saleStatusVoided, resolution, id, saleStatusQuarantined)
+	if err != nil {
+		return err
+	}
+	return requireQuarantined(res, id)
+}
+
+// requireQuarantined fails an update that matched no quarantined sale.
+func requireQuarantined(res sql.Result, id int64) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("offline sale %d is not quarantined", id)
+	}
+	return nil
+}
+
 func (b *LocalPOSBuffer) DeleteBuffered(ids []int64) error {
 	if len(ids) == 0 {
 		return nil
@@ -79,6 +271,22 @@ func (b *LocalPOSBuffer) DeleteBuffered(ids []int64) error {
 	return nil
 }
 
+// GetSetting returns a terminal setting, or "" when it has never been set.
+func (b *LocalPOSBuffer) GetSetting(key string) (string, error) {
+	var value string
+	err := b.db.QueryRow("SELECT value FROM settings WHERE key = ?", key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return value, err
+}
+
+// SetSetting stores a terminal setting.
+func (b *LocalPOSBuffer) SetSetting(key, value string) error {
+	_, err := b.db.Exec("INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = excluded.value", key, value)
+	return err
+}
+
 func (b *LocalPOSBuffer) Close() error {
 	return b.db.Close()
 }
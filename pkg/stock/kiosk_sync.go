@@ -2,16 +2,85 @@ package stock
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"strings"
+	"syscall"
 	"time"
 
 	"sent/ent"
+	"sent/pkg/capital"
+	"sent/pkg/orchestrator"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrSaleRejected is returned when the server refuses a sale for reasons a retry cannot fix,
+// such as an unknown customer or a missing ledger account.
+var ErrSaleRejected = errors.New("sale rejected")
+
+// maxSyncAttempts is how often a sale failing for unexplained reasons is retried before it is
+// quarantined for review.
+const maxSyncAttempts = 10
+
+// The worker syncs every syncInterval. While the server cannot be reached it waits twice as
+// long after each failed try, up to maxSyncBackoff.
+const (
+	syncInterval   = 30 * time.Second
+	maxSyncBackoff = 10 * time.Minute
 )
 
+// isBusinessFailure tells a sale the server refused from one it could not process right now.
+// Refused sales are reported rather than retried; everything else, e.g. a lost connection,
+// is worth another attempt.
+func isBusinessFailure(err error) bool {
+	switch {
+	case errors.Is(err, ErrSaleRejected),
+		errors.Is(err, ErrInsufficientStock),
+		errors.Is(err, capital.ErrPeriodSoftClosed),
+		errors.Is(err, capital.ErrPeriodHardClosed),
+		errors.Is(err, capital.ErrUnbalancedPosting):
+		return true
+	}
+	return ent.IsNotFound(err) || ent.IsValidationError(err) || ent.IsConstraintError(err)
+}
+
+// isConnectionFailure tells a server that could not be reached, or lost the connection, from
+// one that answered with an error. The sale is not at fault, so the failure does not count
+// towards its quarantine.
+func isConnectionFailure(err error) bool {
+	var netErr net.Error
+	var connErr *pgconn.ConnectError
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, driver.ErrBadConn),
+		errors.Is(err, sql.ErrConnDone),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.As(err, &netErr),
+		errors.As(err, &connErr):
+		return true
+	case errors.As(err, &pgErr):
+		// Class 08 is a connection exception; 57P01-03 the server shutting down or starting.
+		return strings.HasPrefix(pgErr.Code, "08") || strings.HasPrefix(pgErr.Code, "57P")
+	}
+	return pgconn.SafeToRetry(err) || pgconn.Timeout(err)
+}
+
 type KioskSyncWorker struct {
 	db     *ent.Client
 	bridge *KioskBridge
 	buffer *LocalPOSBuffer
+	// backoff is how long the worker waits, until retryAt, since the server was last unreachable.
+	backoff time.Duration
+	retryAt time.Time
 }
 
 func NewKioskSyncWorker(db *ent.Client, bridge *KioskBridge) *KioskSyncWorker {
@@ -23,7 +92,7 @@ func NewKioskSyncWorker(db *ent.Client, bridge *KioskBridge) *KioskSyncWorker {
 }
 
 func (w *KioskSyncWorker) Run(ctx context.Context) error {
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := time.NewTicker(syncInterval)
 	defer ticker.Stop()
 
 	fmt.Println("[KIOSK] Sync worker started.")
@@ -32,10 +101,15 @@ func (w *KioskSyncWorker) Run(ctx context.Context) error {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-ticker.C:
-			if err := w.SyncPending(ctx); err != nil {
-				fmt.Printf("[KIOSK] Sync error: %v\n", err)
+		case now := <-ticker.C:
+			if !now.Before(w.retryAt) {
+				err := w.SyncPending(ctx)
+				if err != nil {
+					fmt.Printf("[KIOSK] Sync error: %v\n", err)
+				}
+				w.backOff(now, err)
 			}
+			w.bridge.refreshSlidesIfDue()
 		}
 	}
 }
@@ -58,12 +132,27 @@ func (w *KioskSyncWorker) SyncPending(ctx context.Context) error {
 
 	for i, sale := range sales {
 		_, err := w.bridge.performCheckout(sale)
+		if err != nil && isBusinessFailure(err) {
+			// The server will never accept this sale as it stands; park it and carry on.
+			fmt.Printf("[KIOSK] Sale %s rejected, quarantined for review: %v\n", sale.UUID, err)
+			if qErr := w.buffer.Quarantine(ids[i], err.Error()); qErr != nil {
+				return fmt.Errorf("failed to quarantine sale %d: %w", ids[i], qErr)
+			}
+			continue
+		}
+		if err != nil && isConnectionFailure(err) {
+			// The server is unreachable; stop syncing and back off before the next try.
+			return fmt.Errorf("server unreachable: %w", err)
+		}
 		if err != nil {
-			// If it fails, we stop syncing and try again later
+			// The server failed on this sale without saying why; it may only be retried so often.
+			if rErr := w.buffer.RecordFailure(ids[i], err.Error(), maxSyncAttempts); rErr != nil {
+				fmt.Printf("[KIOSK] Warning: failed to record sync failure for sale %d: %v\n", ids[i], rErr)
+			}
 			return fmt.Errorf("failed to sync sale %d: %w", ids[i], err)
 		}
-		
-		// Successfully synced, remove from buffer
+
+		// Synced, or already on the server from an earlier attempt; remove from buffer
 		if err := w.buffer.DeleteBuffered([]int64{ids[i]}); err != nil {
 			fmt.Printf("[KIOSK] Warning: failed to delete synced sale %d from buffer: %v\n", ids[i], err)
 		}
@@ -72,3 +161,123 @@ func (w *KioskSyncWorker) SyncPending(ctx context.Context) error {
 	fmt.Println("[KIOSK] Sync batch completed.")
 	return nil
 }
+
+// backOff sets when to sync next after a sync at now that ended with err: at the next tick,
+// unless the server was unreachable, when the wait doubles from syncInterval to maxSyncBackoff.
+func (w *KioskSyncWorker) backOff(now time.Time, err error) {
+	if err == nil || !isConnectionFailure(err) {
+		w.backoff, w.retryAt = 0, time.Time{}
+		return
+	}
+	w.backoff = min(max(2*w.backoff, syncInterval), maxSyncBackoff)
+	// Half a tick early, so that the tick due then is not missed.
+	w.retryAt = now.Add(w.backoff - syncInterval/2)
+	fmt.Printf("[KIOSK] Server unreachable, next sync in %s.\n", w.backoff)
+}
+
+// GetOfflineSales lists the sales on this terminal that have not reached the server yet,
+// including those quarantined for review.
+func (k *KioskBridge) GetOfflineSales() ([]OfflineSale, error) {
+	if k.buffer == nil {
+		return nil, fmt.Errorf("offline buffer unavailable")
+	}
+	return k.buffer.ListUnsynced()
+}
+
+// FixOfflineSale replaces a quarantined sale with a corrected one and tries to sync it at once.
+// The sale keeps its ID, time and shift, so it can never be recorded twice.
+func (k *KioskBridge) FixOfflineSale(id int64, sale SaleRequest) (string, error) {
+	if !k.auth.HasRole("admin") {
+		return "", fmt.Errorf("permission denied: only managers can fix offline sales")
+	}
+	if k.buffer == nil {
+		return "", fmt.Errorf("offline buffer unavailable")
+	}
+	o, err := k.buffer.GetOfflineSale(id)
+	if err != nil {
+		return "", err
+	}
+	if o.Status != saleStatusQuarantined {
+		return "", fmt.Errorf("only quarantined sales can be fixed")
+	}
+	if len(sale.Items) == 0 {
+		return "", fmt.Errorf("a sale needs at least one item")
+	}
+	sale.UUID = o.Sale.UUID
+	sale.SoldAt = o.Sale.SoldAt
+	if sale.WarehouseID == 0 {
+		sale.WarehouseID = o.Sale.WarehouseID
+	}
+	sale.ShiftID = o.Sale.ShiftID
+
+	actor := k.actor()
+	if err := k.buffer.UpdateSale(id, sale, "fixed by "+actor); err != nil {
+		return "", err
+	}
+
+	msg, err := k.performCheckout(sale)
+	switch {
+	case err == nil:
+		if dErr := k.buffer.DeleteBuffered([]int64{id}); dErr != nil {
+			fmt.Printf("[KIOSK] Warning: failed to delete synced sale %d from buffer: %v\n", id, dErr)
+		}
+		return msg, nil
+	case isBusinessFailure(err):
+		if qErr := k.buffer.Quarantine(id, err.Error()); qErr != nil {
+			return "", qErr
+		}
+		return "", err
+	}
+	return "Server unavailable. The corrected sale will sync automatically.", nil
+}
+
+// VoidOfflineSale abandons a quarantined sale, e.g. when the goods were never handed over.
+// The sale is kept on the terminal with the reason and the void is reported as a security event.
+func (k *KioskBridge) VoidOfflineSale(id int64, reason string) error {
+	if !k.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only managers can void offline sales")
+	}
+	if k.buffer == nil {
+		return fmt.Errorf("offline buffer unavailable")
+	}
+	if reason == "" {
+		return fmt.Errorf("a reason is required to void a sale")
+	}
+	o, err := k.buffer.GetOfflineSale(id)
+	if err != nil {
+		return err
+	}
+	if o.Status != saleStatusQuarantined {
+		return fmt.Errorf("only quarantined sales can be voided")
+	}
+
+	actor := k.actor()
+	if err := k.buffer.VoidSale(id, fmt.Sprintf("voided by %s: %s", actor, reason)); err != nil {
+		return err
+	}
+
+	if k.audit != nil {
+		err := k.audit.EmitEvent(orchestrator.SecurityAuditEvent{
+			Type:      "offline_sale_void",
+			KioskID:   1, // In a real app, this would be the actual ID
+			Timestamp: time.Now().Unix(),
+			ActorID:   actor,
+		})
+		if err != nil {
+			fmt.Printf("[KIOSK] Warning: failed to emit security audit event: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// actor names the signed-in user for offline sale resolutions.
+func (k *KioskBridge) actor() string {
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return "unknown"
+	}
+	if profile.Email != "" {
+		return profile.Email
+	}
+	return profile.Name
+}
@@ -0,0 +1,171 @@
+package stock
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"sent/ent"
+	"sent/pkg/capital"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestIsBusinessFailure(t *testing.T) {
+	business := []error{
+		fmt.Errorf("Milk: %w", ErrInsufficientStock),
+		fmt.Errorf("%w: customer 7 not found", ErrSaleRejected),
+		fmt.Errorf("failed to post sale: %w", fmt.Errorf("%w: Oct 2026", capital.ErrPeriodHardClosed)),
+		fmt.Errorf("product not found (ID: 3): %w", &ent.NotFoundError{}),
+	}
+	for _, err := range business {
+		if !isBusinessFailure(err) {
+			t.Errorf("%v should be reported, not retried", err)
+		}
+	}
+
+	transient := []error{
+		errors.New("failed to start transaction: dial tcp 10.0.0.5:5432: connect: connection refused"),
+		fmt.Errorf("failed to commit transaction: %w", context.DeadlineExceeded),
+	}
+	for _, err := range transient {
+		if isBusinessFailure(err) {
+			t.Errorf("%v should be retried", err)
+		}
+	}
+}
+
+func TestIsConnectionFailure(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	unreachable := []error{
+		fmt.Errorf("failed to start transaction: %w", &pgconn.ConnectError{Config: &pgconn.Config{}}),
+		fmt.Errorf("failed to start transaction: %w", refused),
+		fmt.Errorf("failed to commit transaction: %w", driver.ErrBadConn),
+		fmt.Errorf("failed to post sale: %w", &pgconn.PgError{Code: "57P01"}),
+	}
+	for _, err := range unreachable {
+		if !isConnectionFailure(err) {
+			t.Errorf("%v should not count against the sale", err)
+		}
+	}
+
+	answered := []error{
+		fmt.Errorf("failed to post sale: %w", &pgconn.PgError{Code: "XX000"}),
+		errors.New("failed to build sale posting"),
+		fmt.Errorf("%w: customer 7 not found", ErrSaleRejected),
+	}
+	for _, err := range answered {
+		if isConnectionFailure(err) {
+			t.Errorf("%v should count against the sale", err)
+		}
+	}
+}
+
+func TestSyncBackoff(t *testing.T) {
+	var w KioskSyncWorker
+	now := time.Now()
+	down := fmt.Errorf("server unreachable: %w", driver.ErrBadConn)
+	for _, want := range []time.Duration{syncInterval, 2 * syncInterval, 4 * syncInterval} {
+		w.backOff(now, down)
+		if w.backoff != want || !w.retryAt.After(now) {
+			t.Fatalf("backoff = %s until %s, want %s", w.backoff, w.retryAt, want)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		w.backOff(now, down)
+	}
+	if w.backoff != maxSyncBackoff {
+		t.Errorf("backoff = %s, want at most %s", w.backoff, maxSyncBackoff)
+	}
+	w.backOff(now, errors.New("failed to post sale"))
+	if w.backoff != 0 || now.Before(w.retryAt) {
+		t.Errorf("an answering server still backed off: %s until %s", w.backoff, w.retryAt)
+	}
+}
+
+func TestLocalPOSBuffer(t *testing.T) {
+	b, err := NewLocalPOSBuffer(filepath.Join(t.TempDir(), "pos.db"))
+	if err != nil {
+		t.Fatalf("NewLocalPOSBuffer: %v", err)
+	}
+	defer b.Close()
+
+	sale := SaleRequest{UUID: "a1", Total: 5, Items: []SaleItem{{ProductID: 1, Quantity: 1, Price: 5}}}
+	if err := b.BufferSale(sale); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.BufferSale(sale); err != nil {
+		t.Fatal(err)
+	}
+	// A sale from before sale IDs existed.
+	if _, err := b.db.Exec(`INSERT INTO buffered_sales (data) VALUES ('{"total":3}')`); err != nil {
+		t.Fatal(err)
+	}
+
+	sales, ids, err := b.GetPending(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sales) != 2 {
+		t.Fatalf("pending = %d, want the duplicate stored once plus the legacy sale", len(sales))
+	}
+	legacy := sales[1].UUID
+	if legacy == "" || sales[1].SoldAt.IsZero() {
+		t.Errorf("legacy sale not given an ID and time: %+v", sales[1])
+	}
+	if again, _, _ := b.GetPending(10); again[1].UUID != legacy {
+		t.Errorf("legacy sale ID changed between replays: %s, %s", legacy, again[1].UUID)
+	}
+
+	// A sale that keeps failing is quarantined after the last attempt.
+	for i := 0; i < 3; i++ {
+		if err := b.RecordFailure(ids[0], "connection refused", 3); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.Quarantine(ids[1], "insufficient stock"); err != nil {
+		t.Fatal(err)
+	}
+	if pending, _, _ := b.GetPending(10); len(pending) != 0 {
+		t.Errorf("quarantined sales still pending: %+v", pending)
+	}
+
+	o, err := b.GetOfflineSale(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != saleStatusQuarantined || o.Attempts != 3 || o.LastError != "connection refused" {
+		t.Errorf("after repeated failures = %+v", o)
+	}
+
+	if err := b.UpdateSale(ids[0], o.Sale, "fixed"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.VoidSale(ids[1], "goods returned"); err != nil {
+		t.Fatal(err)
+	}
+	// Only quarantined sales are fixed or voided: not one queued again, nor one voided.
+	if err := b.UpdateSale(ids[0], o.Sale, "fixed twice"); err == nil {
+		t.Error("fixed a sale that is pending again")
+	}
+	if err := b.UpdateSale(ids[1], o.Sale, "fixed after void"); err == nil {
+		t.Error("fixed a voided sale")
+	}
+	if err := b.VoidSale(ids[0], "changed mind"); err == nil {
+		t.Error("voided a sale that is pending again")
+	}
+	list, err := b.ListUnsynced()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Status != saleStatusPending || list[0].Attempts != 0 {
+		t.Errorf("unsynced = %+v, want only the fixed sale, pending again", list)
+	}
+}
@@ -1,46 +1,356 @@
 package stock
 
 import (
+	"bytes"
 	"fmt"
-	"sent/ent"
+	"image"
+	"image/draw"
+	"image/png"
+	"math"
+	"regexp"
+	"strings"
+
+	"sent/ent/schema"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/datamatrix"
+	"github.com/boombuler/barcode/ean"
+	"github.com/boombuler/barcode/qr"
+	"github.com/jung-kurt/gofpdf/v2"
+)
+
+// LabelLanguage is what labels are rendered in: the command language of a label printer, or a
+// PDF sheet for label stock in an office printer.
+type LabelLanguage string
+
+const (
+	LabelZPL  LabelLanguage = "zpl"  // Zebra
+	LabelTSPL LabelLanguage = "tspl" // TSC and most desktop label printers
+	LabelPDF  LabelLanguage = "pdf"  // A4 label stock
+)
+
+// Label element kinds.
+const (
+	ElementText    = "text"
+	ElementBarcode = "barcode"
+)
+
+// Barcode symbologies a label can carry.
+const (
+	SymbologyCode128    = "code128"
+	SymbologyEAN13      = "ean13"
+	SymbologyQR         = "qr"
+	SymbologyDataMatrix = "datamatrix"
 )
 
+// dotsPerMM is the resolution of label printers, 203 dpi.
+const dotsPerMM = 8
+
+// labelSheet is A4 label stock: labels in a grid inside the page margin, a gap apart.
+var labelSheet = struct{ Width, Height, Margin, Gap float64 }{210, 297, 8, 2}
+
+// LabelLayout is a label template ready to render, sized in mm.
+type LabelLayout struct {
+	Width    float64
+	Height   float64
+	Elements []schema.LabelElement
+}
+
+// defaultLabelLayout is printed for tenants with no default template: the name, SKU and price
+// over a Code128 of the SKU.
+var defaultLabelLayout = LabelLayout{
+	Width:  50,
+	Height: 25,
+	Elements: []schema.LabelElement{
+		{Kind: ElementText, X: 3, Y: 2, Height: 4, Content: "{{name}}"},
+		{Kind: ElementText, X: 3, Y: 7, Height: 3, Content: "SKU: {{sku}}   {{price}}"},
+		{Kind: ElementBarcode, X: 3, Y: 12, Height: 8, Symbology: SymbologyCode128, Content: "{{sku}}"},
+	},
+}
+
+// LabelData holds the values of a label's placeholders by name.
+type LabelData map[string]string
+
+// LabelCopies is a label and how many of it to print.
+type LabelCopies struct {
+	Data   LabelData
+	Copies int
+}
+
 type LabelGenerator struct{}
 
 func NewLabelGenerator() *LabelGenerator {
 	return &LabelGenerator{}
 }
 
-// GenerateZPL creates a Zebra Programming Language string for a product label
-func (g *LabelGenerator) GenerateZPL(p *ent.Product) string {
-	// Simple ZPL template
-	// ^XA - Start Format
-	// ^FO - Field Origin
-	// ^A0 - Font
-	// ^FD - Field Data
-	// ^BY - Barcode Width
-	// ^BC - Barcode 128
-	// ^XZ - End Format
-	return fmt.Sprintf(`
-^XA
-^FO50,50^A0N,40,40^FD%s^FS
-^FO50,100^A0N,25,25^FDSKU: %s^FS
-^FO50,150^BY2
-^BCN,100,Y,N,N
-^FD%s^FS
-^XZ
-`, p.Name, p.Sku, p.Sku)
-}
-
-// GenerateTSPL creates a TSPL string for TSC-compatible printers
-func (g *LabelGenerator) GenerateTSPL(p *ent.Product) string {
-	return fmt.Sprintf(`
-SIZE 4, 2
-GAP 0, 0
-DIRECTION 1
-CLS
-TEXT 50,50,"ROMAN.TTF",0,12,12,"%s"
-BARCODE 50,100,"128",80,1,0,2,2,"%s"
-PRINT 1,1
-`, p.Name, p.Sku)
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([\w.]+)\s*\}\}`)
+
+// fillPlaceholders puts the label's values in content; unknown names are left blank.
+func fillPlaceholders(content string, data LabelData) string {
+	return placeholderPattern.ReplaceAllStringFunc(content, func(m string) string {
+		return data[placeholderPattern.FindStringSubmatch(m)[1]]
+	})
+}
+
+// validateLayout checks a template before it is saved or rendered.
+func validateLayout(l LabelLayout) error {
+	if l.Width <= 0 || l.Height <= 0 {
+		return fmt.Errorf("label size must be positive")
+	}
+	for i, e := range l.Elements {
+		if e.X < 0 || e.Y < 0 || e.X >= l.Width || e.Y >= l.Height {
+			return fmt.Errorf("element %d is off the label", i+1)
+		}
+		if e.Height <= 0 || e.Y+e.Height > l.Height {
+			return fmt.Errorf("element %d must have a height that fits on the label", i+1)
+		}
+		switch e.Kind {
+		case ElementText:
+		case ElementBarcode:
+			switch e.Symbology {
+			case SymbologyCode128, SymbologyEAN13, SymbologyQR, SymbologyDataMatrix:
+			default:
+				return fmt.Errorf("element %d: unknown symbology %q", i+1, e.Symbology)
+			}
+		default:
+			return fmt.Errorf("element %d: kind must be text or barcode", i+1)
+		}
+	}
+	return nil
+}
+
+// placedElement is an element with its placeholders filled in.
+type placedElement struct {
+	schema.LabelElement
+	code barcode.Barcode // Encoded barcode, for its module count
+}
+
+// placeElements fills in one label. Barcodes left empty, as for a product with no barcode, are
+// dropped; barcodes their symbology cannot carry are an error.
+func placeElements(l LabelLayout, data LabelData) ([]placedElement, error) {
+	var out []placedElement
+	for _, e := range l.Elements {
+		e.Content = fillPlaceholders(e.Content, data)
+		if e.Kind == ElementText {
+			out = append(out, placedElement{LabelElement: e})
+			continue
+		}
+		if e.Content == "" {
+			continue
+		}
+		var code barcode.Barcode
+		var err error
+		switch e.Symbology {
+		case SymbologyCode128:
+			code, err = code128.Encode(e.Content)
+		case SymbologyEAN13:
+			if len(e.Content) == 13 && !validEAN13(e.Content) {
+				return nil, fmt.Errorf("EAN-13 %s has a wrong check digit", e.Content)
+			}
+			if len(e.Content) != 12 && len(e.Content) != 13 {
+				return nil, fmt.Errorf("EAN-13 needs 12 or 13 digits, not %q", e.Content)
+			}
+			code, err = ean.Encode(e.Content)
+		case SymbologyQR:
+			code, err = qr.Encode(e.Content, qr.M, qr.Auto)
+		case SymbologyDataMatrix:
+			code, err = datamatrix.Encode(e.Content)
+		default:
+			err = fmt.Errorf("unknown symbology %q", e.Symbology)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode %q as %s: %w", e.Content, e.Symbology, err)
+		}
+		out = append(out, placedElement{LabelElement: e, code: code})
+	}
+	return out, nil
+}
+
+// Render lays out labels in a printer language, or on an A4 sheet as a PDF.
+func (g *LabelGenerator) Render(l LabelLayout, labels []LabelCopies, lang LabelLanguage) ([]byte, error) {
+	if err := validateLayout(l); err != nil {
+		return nil, err
+	}
+	placed := make([][]placedElement, len(labels))
+	for i, lc := range labels {
+		els, err := placeElements(l, lc.Data)
+		if err != nil {
+			return nil, fmt.Errorf("label %d: %w", i+1, err)
+		}
+		placed[i] = els
+	}
+	switch lang {
+	case LabelZPL:
+		return renderZPL(l, labels, placed), nil
+	case LabelTSPL:
+		return renderTSPL(l, labels, placed), nil
+	case LabelPDF:
+		return renderLabelSheet(l, labels, placed)
+	default:
+		return nil, fmt.Errorf("labels cannot be rendered in %q", lang)
+	}
+}
+
+func dots(mm float64) int {
+	return int(math.Round(mm * dotsPerMM))
+}
+
+// moduleDots is the size of a 2D code's modules that best fills its side.
+func moduleDots(e placedElement, max int) int {
+	n := e.code.Bounds().Dx()
+	m := dots(e.Height) / n
+	if m < 1 {
+		return 1
+	}
+	if m > max {
+		return max
+	}
+	return m
+}
+
+// zplField escapes ^ and ~ in field data, which is sent after ^FH.
+func zplField(s string) string {
+	return strings.NewReplacer(`\`, `\5C`, "^", `\5E`, "~", `\7E`).Replace(s)
+}
+
+func renderZPL(l LabelLayout, labels []LabelCopies, placed [][]placedElement) []byte {
+	var b strings.Builder
+	for i, lc := range labels {
+		if lc.Copies <= 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "^XA\n^CI28\n^PW%d\n^LL%d\n", dots(l.Width), dots(l.Height))
+		for _, e := range placed[i] {
+			fmt.Fprintf(&b, "^FO%d,%d", dots(e.X), dots(e.Y))
+			h := dots(e.Height)
+			switch {
+			case e.Kind == ElementText:
+				fmt.Fprintf(&b, "^A0N,%d,%d^FH\\^FD%s^FS\n", h, h, zplField(e.Content))
+			case e.Symbology == SymbologyCode128:
+				fmt.Fprintf(&b, "^BY2^BCN,%d,Y,N,N^FH\\^FD%s^FS\n", h, zplField(e.Content))
+			case e.Symbology == SymbologyEAN13:
+				// The printer works out the check digit from the first 12.
+				fmt.Fprintf(&b, "^BY2^BEN,%d,Y,N^FD%s^FS\n", h, e.Content[:12])
+			case e.Symbology == SymbologyQR:
+				fmt.Fprintf(&b, "^BQN,2,%d^FH\\^FDQA,%s^FS\n", moduleDots(e, 10), zplField(e.Content))
+			case e.Symbology == SymbologyDataMatrix:
+				fmt.Fprintf(&b, "^BXN,%d,200^FH\\^FD%s^FS\n", moduleDots(e, h), zplField(e.Content))
+			}
+		}
+		fmt.Fprintf(&b, "^PQ%d\n^XZ\n", lc.Copies)
+	}
+	return []byte(b.String())
+}
+
+// tsplString quotes a string for TSPL, which escapes quotes as \["].
+func tsplString(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\["]`) + `"`
+}
+
+func renderTSPL(l LabelLayout, labels []LabelCopies, placed [][]placedElement) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SIZE %g mm,%g mm\nGAP 2 mm,0 mm\nDIRECTION 1\nCODEPAGE UTF-8\n", l.Width, l.Height)
+	for i, lc := range labels {
+		if lc.Copies <= 0 {
+			continue
+		}
+		b.WriteString("CLS\n")
+		for _, e := range placed[i] {
+			x, y, h := dots(e.X), dots(e.Y), dots(e.Height)
+			switch {
+			case e.Kind == ElementText:
+				// The scalable font is sized in points.
+				pt := int(math.Max(1, math.Round(e.Height*72/25.4)))
+				fmt.Fprintf(&b, "TEXT %d,%d,\"0\",0,%d,%d,%s\n", x, y, pt, pt, tsplString(e.Content))
+			case e.Symbology == SymbologyCode128:
+				fmt.Fprintf(&b, "BARCODE %d,%d,\"128\",%d,1,0,2,2,%s\n", x, y, h, tsplString(e.Content))
+			case e.Symbology == SymbologyEAN13:
+				fmt.Fprintf(&b, "BARCODE %d,%d,\"EAN13\",%d,1,0,2,2,\"%s\"\n", x, y, h, e.Content[:12])
+			case e.Symbology == SymbologyQR:
+				fmt.Fprintf(&b, "QRCODE %d,%d,M,%d,A,0,%s\n", x, y, moduleDots(e, 10), tsplString(e.Content))
+			case e.Symbology == SymbologyDataMatrix:
+				fmt.Fprintf(&b, "DMATRIX %d,%d,%d,%d,x%d,%s\n", x, y, h, h, moduleDots(e, h), tsplString(e.Content))
+			}
+		}
+		fmt.Fprintf(&b, "PRINT 1,%d\n", lc.Copies)
+	}
+	return []byte(b.String())
+}
+
+// barWidthMM is the width of a linear barcode's narrowest bar, as on a full-size EAN-13.
+const barWidthMM = 0.33
+
+// renderLabelSheet lays the labels out in a grid on A4 pages, a copy to a cell.
+func renderLabelSheet(l LabelLayout, labels []LabelCopies, placed [][]placedElement) ([]byte, error) {
+	cols := int((labelSheet.Width - 2*labelSheet.Margin + labelSheet.Gap) / (l.Width + labelSheet.Gap))
+	rows := int((labelSheet.Height - 2*labelSheet.Margin + labelSheet.Gap) / (l.Height + labelSheet.Gap))
+	if cols < 1 || rows < 1 {
+		return nil, fmt.Errorf("a %gx%g mm label does not fit on an A4 sheet", l.Width, l.Height)
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	cell := 0
+	for i, lc := range labels {
+		for c := 0; c < lc.Copies; c++ {
+			if cell%(cols*rows) == 0 {
+				pdf.AddPage()
+			}
+			col, row := cell%cols, (cell/cols)%rows
+			ox := labelSheet.Margin + float64(col)*(l.Width+labelSheet.Gap)
+			oy := labelSheet.Margin + float64(row)*(l.Height+labelSheet.Gap)
+			for _, e := range placed[i] {
+				if err := drawLabelElement(pdf, tr, l, e, ox, oy); err != nil {
+					return nil, err
+				}
+			}
+			cell++
+		}
+	}
+	if cell == 0 {
+		return nil, fmt.Errorf("no labels to print")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func drawLabelElement(pdf *gofpdf.Fpdf, tr func(string) string, l LabelLayout, e placedElement, ox, oy float64) error {
+	x, y := ox+e.X, oy+e.Y
+	if e.Kind == ElementText {
+		pdf.SetFont("Helvetica", "", e.Height*72/25.4)
+		// Text is placed by its baseline; capitals stand about 0.8 of the height above it.
+		pdf.Text(x, y+e.Height*0.8, tr(e.Content))
+		return nil
+	}
+
+	n := e.code.Bounds().Dx()
+	w, h, scaleW, scaleH := e.Height, e.Height, n*4, n*4
+	if e.Symbology == SymbologyCode128 || e.Symbology == SymbologyEAN13 {
+		w = math.Min(float64(n)*barWidthMM, l.Width-e.X)
+		scaleW, scaleH = n*2, 40
+	}
+	name := e.Symbology + ":" + e.Content
+	if pdf.GetImageInfo(name) == nil {
+		scaled, err := barcode.Scale(e.code, scaleW, scaleH)
+		if err != nil {
+			return err
+		}
+		// Barcodes come out 16-bit, which PDFs cannot embed from a PNG.
+		img := image.NewGray(scaled.Bounds())
+		draw.Draw(img, img.Bounds(), scaled, scaled.Bounds().Min, draw.Src)
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return err
+		}
+		pdf.RegisterImageOptionsReader(name, gofpdf.ImageOptions{ImageType: "PNG"}, &buf)
+	}
+	pdf.ImageOptions(name, x, y, w, h, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+	return pdf.Error()
 }
@@ -0,0 +1,102 @@
+package stock
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"sent/ent/schema"
+)
+
+func TestFillPlaceholders(t *testing.T) {
+	data := LabelData{"name": "Olive Oil", "price": "12.50", "attr.origin": "Jordan"}
+	got := fillPlaceholders("{{name}} from {{ attr.origin }}: {{price}} {{lot}}", data)
+	if got != "Olive Oil from Jordan: 12.50 " {
+		t.Errorf("filled = %q", got)
+	}
+}
+
+func TestValidateLayout(t *testing.T) {
+	if err := validateLayout(defaultLabelLayout); err != nil {
+		t.Errorf("default layout rejected: %v", err)
+	}
+	bad := []schema.LabelElement{
+		{Kind: ElementText, X: 60, Y: 2, Height: 4},
+		{Kind: ElementText, X: 2, Y: 22, Height: 4},
+		{Kind: ElementBarcode, X: 2, Y: 2, Height: 4, Symbology: "upca"},
+		{Kind: "image", X: 2, Y: 2, Height: 4},
+	}
+	for _, e := range bad {
+		if err := validateLayout(LabelLayout{Width: 50, Height: 25, Elements: []schema.LabelElement{e}}); err == nil {
+			t.Errorf("%+v accepted", e)
+		}
+	}
+}
+
+func TestRenderLabels(t *testing.T) {
+	layout := LabelLayout{Width: 50, Height: 30, Elements: []schema.LabelElement{
+		{Kind: ElementText, X: 2, Y: 2, Height: 3, Content: "{{name}} ^{{lot}}"},
+		{Kind: ElementBarcode, X: 2, Y: 6, Height: 8, Symbology: SymbologyEAN13, Content: "{{barcode}}"},
+		{Kind: ElementBarcode, X: 30, Y: 6, Height: 15, Symbology: SymbologyQR, Content: "{{sku}}"},
+		{Kind: ElementBarcode, X: 2, Y: 16, Height: 10, Symbology: SymbologyDataMatrix, Content: "{{sku}}|{{expiry}}"},
+	}}
+	labels := []LabelCopies{
+		{Data: LabelData{"name": "Milk \"1L\"", "lot": "L7", "barcode": "5901234123457", "sku": "MLK-1", "expiry": "2026-11-01"}, Copies: 3},
+		{Data: LabelData{"name": "Bread", "sku": "BRD-1"}, Copies: 1}, // No barcode: the EAN-13 is left off
+	}
+	g := NewLabelGenerator()
+
+	zpl, err := g.Render(layout, labels, LabelZPL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"^PW400", "^FO16,16^A0N,24,24^FH\\^FDMilk \"1L\" \\5EL7^FS", "^BEN,64,Y,N^FD590123412345^FS", "^FDQA,MLK-1^FS", "^BXN,", "^PQ3", "^PQ1"} {
+		if !bytes.Contains(zpl, []byte(want)) {
+			t.Errorf("ZPL lacks %q:\n%s", want, zpl)
+		}
+	}
+	if n := bytes.Count(zpl, []byte("^BEN")); n != 1 {
+		t.Errorf("ZPL has %d EAN-13 codes, want 1", n)
+	}
+
+	tspl, err := g.Render(layout, labels, LabelTSPL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"SIZE 50 mm,30 mm", `"Milk \["]1L\["] ^L7"`, `BARCODE 16,48,"EAN13",64,1,0,2,2,"590123412345"`, `QRCODE 240,48,M,`, "DMATRIX 16,128,80,80,x", "PRINT 1,3", "PRINT 1,1"} {
+		if !bytes.Contains(tspl, []byte(want)) {
+			t.Errorf("TSPL lacks %q:\n%s", want, tspl)
+		}
+	}
+
+	pdf, err := g.Render(layout, labels, LabelPDF)
+	if err != nil || !bytes.HasPrefix(pdf, []byte("%PDF")) {
+		t.Errorf("PDF = %.20q, %v", pdf, err)
+	}
+
+	if _, err := g.Render(layout, labels, "escpos"); err == nil {
+		t.Error("label rendered in ESC/POS")
+	}
+	labels[0].Data["barcode"] = "5901234123458"
+	if _, err := g.Render(layout, labels, LabelZPL); err == nil || !strings.Contains(err.Error(), "check digit") {
+		t.Errorf("wrong check digit: %v", err)
+	}
+}
+
+func TestLabelSheetPages(t *testing.T) {
+	// 50 x 25 mm labels fit 3 across and 10 down inside the margins of an A4 sheet.
+	layout := defaultLabelLayout
+	labels := []LabelCopies{{Data: LabelData{"name": "Tea", "sku": "TEA-1", "price": "3.00"}, Copies: 31}}
+	pdf, err := NewLabelGenerator().Render(layout, labels, LabelPDF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := bytes.Count(pdf, []byte("/Type /Page\n")); n != 2 {
+		t.Errorf("31 labels on %d pages, want 2", n)
+	}
+
+	big := LabelLayout{Width: 300, Height: 20}
+	if _, err := NewLabelGenerator().Render(big, labels, LabelPDF); err == nil {
+		t.Error("label wider than A4 rendered")
+	}
+}
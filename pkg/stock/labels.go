@@ -0,0 +1,437 @@
+package stock
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"sent/ent"
+	"sent/ent/category"
+	"sent/ent/goodsreceipt"
+	"sent/ent/goodsreceiptline"
+	"sent/ent/labeltemplate"
+	"sent/ent/product"
+	"sent/ent/purchaseorder"
+	"sent/ent/schema"
+	"sent/ent/supplier"
+	"sent/ent/tenant"
+	"sent/pkg/capital"
+)
+
+// LabelPrinterLookup finds a configured label printer by ID and the language it speaks.
+type LabelPrinterLookup func(id string) (ReceiptPrinter, LabelLanguage, error)
+
+// LabelTemplateDTO is a label template as laid out in the designer.
+type LabelTemplateDTO struct {
+	ID        int                   `json:"id"`
+	Name      string                `json:"name"`
+	Width     float64               `json:"width"`  // mm
+	Height    float64               `json:"height"` // mm
+	Elements  []schema.LabelElement `json:"elements"`
+	IsDefault bool                  `json:"isDefault"`
+}
+
+func (t LabelTemplateDTO) layout() LabelLayout {
+	return LabelLayout{Width: t.Width, Height: t.Height, Elements: t.Elements}
+}
+
+// LabelFilter picks the products to label.
+type LabelFilter struct {
+	ProductIDs []int  `json:"productIds,omitempty"`
+	CategoryID int    `json:"categoryId,omitempty"`
+	SupplierID int    `json:"supplierId,omitempty"`
+	Search     string `json:"search,omitempty"` // Part of the name or SKU
+}
+
+// LabelPrintRequest is a batch of labels, for the goods received on a purchase order or for
+// the products matching a filter.
+type LabelPrintRequest struct {
+	TemplateID      int          `json:"templateId"`                // The tenant's default when zero
+	PrinterID       string       `json:"printerId"`                 // Rendered as a PDF sheet when empty
+	PurchaseOrderID int          `json:"purchaseOrderId,omitempty"` // A label per unit received
+	Filter          *LabelFilter `json:"filter,omitempty"`
+	Copies          int          `json:"copies"`               // Labels per filtered product; 1 when zero
+	Quantities      map[int]int  `json:"quantities,omitempty"` // Labels per product ID, overriding the above; 0 leaves it out
+}
+
+// LabelPrintResult is a printed batch.
+type LabelPrintResult struct {
+	Labels   int           `json:"labels"`
+	Language LabelLanguage `json:"language"`
+	PDF      string        `json:"pdf,omitempty"` // Base64, when no printer was named
+}
+
+// SetLabelPrinterLookup connects label printing to the configured printers.
+func (s *StockBridge) SetLabelPrinterLookup(lookup LabelPrinterLookup) {
+	s.labelPrinters = lookup
+}
+
+func labelTemplateDTO(t *ent.LabelTemplate) LabelTemplateDTO {
+	return LabelTemplateDTO{
+		ID:        t.ID,
+		Name:      t.Name,
+		Width:     t.WidthMm,
+		Height:    t.HeightMm,
+		Elements:  t.Elements,
+		IsDefault: t.IsDefault,
+	}
+}
+
+// GetLabelTemplates lists the tenant's label templates.
+func (s *StockBridge) GetLabelTemplates() ([]LabelTemplateDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.db.LabelTemplate.Query().
+		Where(labeltemplate.HasTenantWith(tenant.ID(profile.TenantID))).
+		Order(ent.Asc(labeltemplate.FieldName)).
+		All(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]LabelTemplateDTO, len(templates))
+	for i, t := range templates {
+		dtos[i] = labelTemplateDTO(t)
+	}
+	return dtos, nil
+}
+
+// SaveLabelTemplate creates a label template, or updates the one with the DTO's ID. A default
+// template replaces the tenant's previous default.
+func (s *StockBridge) SaveLabelTemplate(t LabelTemplateDTO) (int, error) {
+	if !s.auth.HasRole("admin") {
+		return 0, fmt.Errorf("permission denied: only admins can change label templates")
+	}
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(t.Name) == "" {
+		return 0, fmt.Errorf("a label template needs a name")
+	}
+	if err := validateLayout(t.layout()); err != nil {
+		return 0, err
+	}
+	if t.Elements == nil {
+		t.Elements = []schema.LabelElement{}
+	}
+
+	tx, err := s.db.Tx(s.ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if t.IsDefault {
+		if _, err := tx.LabelTemplate.Update().
+			Where(labeltemplate.HasTenantWith(tenant.ID(profile.TenantID)), labeltemplate.IDNEQ(t.ID)).
+			SetIsDefault(false).
+			Save(s.ctx); err != nil {
+			return 0, err
+		}
+	}
+	id := t.ID
+	if id == 0 {
+		saved, err := tx.LabelTemplate.Create().
+			SetTenantID(profile.TenantID).
+			SetName(t.Name).
+			SetWidthMm(t.Width).
+			SetHeightMm(t.Height).
+			SetElements(t.Elements).
+			SetIsDefault(t.IsDefault).
+			Save(s.ctx)
+		if ent.IsConstraintError(err) {
+			return 0, fmt.Errorf("a label template named %s already exists", t.Name)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to save label template: %w", err)
+		}
+		id = saved.ID
+	} else {
+		n, err := tx.LabelTemplate.Update().
+			Where(labeltemplate.ID(t.ID), labeltemplate.HasTenantWith(tenant.ID(profile.TenantID))).
+			SetName(t.Name).
+			SetWidthMm(t.Width).
+			SetHeightMm(t.Height).
+			SetElements(t.Elements).
+			SetIsDefault(t.IsDefault).
+			Save(s.ctx)
+		if ent.IsConstraintError(err) {
+			return 0, fmt.Errorf("a label template named %s already exists", t.Name)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to save label template: %w", err)
+		}
+		if n == 0 {
+			return 0, fmt.Errorf("label template %d not found", t.ID)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	s.logAudit("label_template_saved", "label_template", id, map[string]interface{}{"name": t.Name, "default": t.IsDefault})
+	return id, nil
+}
+
+// DeleteLabelTemplate removes a label template.
+func (s *StockBridge) DeleteLabelTemplate(id int) error {
+	if !s.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change label templates")
+	}
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	n, err := s.db.LabelTemplate.Delete().
+		Where(labeltemplate.ID(id), labeltemplate.HasTenantWith(tenant.ID(profile.TenantID))).
+		Exec(s.ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("label template %d not found", id)
+	}
+	s.logAudit("label_template_deleted", "label_template", id, nil)
+	return nil
+}
+
+// PreviewLabel renders a template, saved or not, for one product as a base64 PDF.
+func (s *StockBridge) PreviewLabel(t LabelTemplateDTO, productID int) (string, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	p, err := s.db.Product.Query().
+		Where(product.ID(productID), product.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithCategory().
+		WithSupplier().
+		WithTenant().
+		Only(s.ctx)
+	if err != nil {
+		return "", fmt.Errorf("product not found: %w", err)
+	}
+	data := ProductLabelData(p, capital.CurrencyPlaces(p.Edges.Tenant.FunctionalCurrency))
+	pdf, err := NewLabelGenerator().Render(t.layout(), []LabelCopies{{Data: data, Copies: 1}}, LabelPDF)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(pdf), nil
+}
+
+// LoadLabelLayout returns a tenant's label template, its default when templateID is zero, or
+// the built-in layout when it has none.
+func LoadLabelLayout(ctx context.Context, db *ent.Client, tenantID, templateID int) (LabelLayout, error) {
+	q := db.LabelTemplate.Query().Where(labeltemplate.HasTenantWith(tenant.ID(tenantID)))
+	if templateID != 0 {
+		q.Where(labeltemplate.ID(templateID))
+	} else {
+		q.Where(labeltemplate.IsDefault(true))
+	}
+	t, err := q.First(ctx)
+	if ent.IsNotFound(err) && templateID == 0 {
+		return defaultLabelLayout, nil
+	}
+	if err != nil {
+		return LabelLayout{}, fmt.Errorf("label template %d not found: %w", templateID, err)
+	}
+	return labelTemplateDTO(t).layout(), nil
+}
+
+// ProductLabelData gives the placeholders of a product's label: its fields, its price in the
+// currency's places, and each attribute as attr.<name>. Category and supplier names are filled
+// in when those edges are loaded.
+func ProductLabelData(p *ent.Product, places int32) LabelData {
+	price := p.UnitCost
+	if p.SoldByWeight {
+		price = p.PricePerKg
+	}
+	data := LabelData{
+		"name":         p.Name,
+		"sku":          p.Sku,
+		"barcode":      p.Barcode,
+		"description":  p.Description,
+		"plu":          p.Plu,
+		"location":     p.Location,
+		"price":        price.StringFixed(places),
+		"price_per_kg": p.PricePerKg.StringFixed(places),
+	}
+	if c := p.Edges.Category; c != nil {
+		data["category"] = c.Name
+	}
+	if sup := p.Edges.Supplier; sup != nil {
+		data["supplier"] = sup.Name
+	}
+	for k, v := range p.Attributes {
+		if v != nil {
+			data["attr."+k] = fmt.Sprint(v)
+		}
+	}
+	return data
+}
+
+// ProductLabel returns the default label of a product, for printing it on its own.
+func ProductLabel(ctx context.Context, db *ent.Client, productID int) (LabelLayout, LabelData, error) {
+	p, err := db.Product.Query().
+		Where(product.ID(productID)).
+		WithCategory().
+		WithSupplier().
+		WithTenant().
+		Only(ctx)
+	if err != nil {
+		return LabelLayout{}, nil, fmt.Errorf("product not found: %w", err)
+	}
+	l, err := LoadLabelLayout(ctx, db, p.Edges.Tenant.ID, 0)
+	if err != nil {
+		return LabelLayout{}, nil, err
+	}
+	return l, ProductLabelData(p, capital.CurrencyPlaces(p.Edges.Tenant.FunctionalCurrency)), nil
+}
+
+// PrintLabels prints a batch of labels on a label printer in its language, or renders them on
+// an A4 sheet when no printer is named. Labels for a purchase order carry the lot and expiry of
+// each receipt line.
+func (s *StockBridge) PrintLabels(req LabelPrintRequest) (*LabelPrintResult, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if (req.PurchaseOrderID == 0) == (req.Filter == nil) {
+		return nil, fmt.Errorf("labels are printed for either a purchase order or a product filter")
+	}
+	for id, n := range req.Quantities {
+		if n < 0 {
+			return nil, fmt.Errorf("product %d: label quantity must not be negative", id)
+		}
+	}
+	tnt, err := s.db.Tenant.Get(s.ctx, profile.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	places := capital.CurrencyPlaces(tnt.FunctionalCurrency)
+
+	layout, err := LoadLabelLayout(s.ctx, s.db, profile.TenantID, req.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	var labels []LabelCopies
+	if req.PurchaseOrderID != 0 {
+		labels, err = s.receivedLabels(profile.TenantID, req.PurchaseOrderID, req.Quantities, places)
+	} else {
+		labels, err = s.filteredLabels(profile.TenantID, req.Filter, req.Copies, req.Quantities, places)
+	}
+	if err != nil {
+		return nil, err
+	}
+	total := 0
+	for _, l := range labels {
+		total += l.Copies
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("no labels to print")
+	}
+
+	lang := LabelPDF
+	var printer ReceiptPrinter
+	if req.PrinterID != "" {
+		if s.labelPrinters == nil {
+			return nil, fmt.Errorf("no printers are configured")
+		}
+		printer, lang, err = s.labelPrinters(req.PrinterID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	job, err := NewLabelGenerator().Render(layout, labels, lang)
+	if err != nil {
+		return nil, err
+	}
+	result := &LabelPrintResult{Labels: total, Language: lang}
+	if printer == nil {
+		result.PDF = base64.StdEncoding.EncodeToString(job)
+		return result, nil
+	}
+	if err := printer.Print(job); err != nil {
+		return nil, fmt.Errorf("failed to print labels: %w", err)
+	}
+	return result, nil
+}
+
+// receivedLabels labels every unit received on a purchase order, a receipt line at a time.
+func (s *StockBridge) receivedLabels(tenantID, poID int, quantities map[int]int, places int32) ([]LabelCopies, error) {
+	lines, err := s.db.GoodsReceiptLine.Query().
+		Where(goodsreceiptline.HasReceiptWith(
+			goodsreceipt.HasTenantWith(tenant.ID(tenantID)),
+			goodsreceipt.HasPurchaseOrderWith(purchaseorder.ID(poID)),
+		)).
+		WithPurchaseOrderLine(func(q *ent.PurchaseOrderLineQuery) {
+			q.WithProduct(func(q *ent.ProductQuery) {
+				q.WithCategory().WithSupplier()
+			})
+		}).
+		WithStockMovement().
+		Order(ent.Asc(goodsreceiptline.FieldID)).
+		All(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("nothing has been received on purchase order %d", poID)
+	}
+	labels := make([]LabelCopies, 0, len(lines))
+	for _, l := range lines {
+		p := l.Edges.PurchaseOrderLine.Edges.Product
+		copies := l.Quantity
+		if n, ok := quantities[p.ID]; ok {
+			copies = n
+		}
+		data := ProductLabelData(p, places)
+		if m := l.Edges.StockMovement; m != nil {
+			data["lot"] = m.LotNumber
+			if m.ExpiryDate != nil {
+				data["expiry"] = m.ExpiryDate.Format("2006-01-02")
+			}
+		}
+		labels = append(labels, LabelCopies{Data: data, Copies: copies})
+	}
+	return labels, nil
+}
+
+// filteredLabels labels the products matching a filter, in SKU order.
+func (s *StockBridge) filteredLabels(tenantID int, f *LabelFilter, copies int, quantities map[int]int, places int32) ([]LabelCopies, error) {
+	if copies <= 0 {
+		copies = 1
+	}
+	q := s.db.Product.Query().
+		Where(product.HasTenantWith(tenant.ID(tenantID)), product.IsDisposed(false)).
+		WithCategory().
+		WithSupplier()
+	if len(f.ProductIDs) > 0 {
+		q.Where(product.IDIn(f.ProductIDs...))
+	}
+	if f.CategoryID != 0 {
+		q.Where(product.HasCategoryWith(category.ID(f.CategoryID)))
+	}
+	if f.SupplierID != 0 {
+		q.Where(product.HasSupplierWith(supplier.ID(f.SupplierID)))
+	}
+	if search := strings.TrimSpace(f.Search); search != "" {
+		q.Where(product.Or(product.NameContainsFold(search), product.SkuContainsFold(search)))
+	}
+	products, err := q.All(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(products, func(i, j int) bool { return products[i].Sku < products[j].Sku })
+	labels := make([]LabelCopies, 0, len(products))
+	for _, p := range products {
+		n := copies
+		if q, ok := quantities[p.ID]; ok {
+			n = q
+		}
+		labels = append(labels, LabelCopies{Data: ProductLabelData(p, places), Copies: n})
+	}
+	return labels, nil
+}
@@ -0,0 +1,311 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"sent/ent"
+	"sent/ent/product"
+	"sent/ent/schema"
+	"sent/ent/stocklevel"
+	"sent/ent/tenant"
+	"sent/ent/warehouse"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// ErrInsufficientStock is returned when a warehouse does not hold enough of a product.
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+// pickRule narrows which stock a take may use.
+type pickRule struct {
+	Bin           *string   // Only this bin; nil allows any bin
+	LotNumber     string    // Only this lot; empty allows any lot
+	ExpiredBefore time.Time // Skip lots that expire before this; zero allows expired stock
+}
+
+// sellableToday is the rule for selling: any bin or lot that has not expired yet.
+func sellableToday() pickRule {
+	y, m, d := time.Now().Date()
+	return pickRule{ExpiredBefore: time.Date(y, m, d, 0, 0, 0, 0, time.Local)}
+}
+
+// planBinTakes decides which bins and lots to take qty from, first-expired-first-out. Lots
+// without an expiry go last; ties take the unassigned area first and then bins in name order.
+func planBinTakes(levels []schema.StockTake, qty decimal.Decimal, rule pickRule) ([]schema.StockTake, error) {
+	candidates := make([]schema.StockTake, 0, len(levels))
+	available, expired := decimal.Zero, decimal.Zero
+	for _, l := range levels {
+		if rule.Bin != nil && l.Bin != *rule.Bin {
+			continue
+		}
+		if rule.LotNumber != "" && l.LotNumber != rule.LotNumber {
+			continue
+		}
+		if !l.Quantity.IsPositive() {
+			continue
+		}
+		if !rule.ExpiredBefore.IsZero() && l.ExpiryDate != nil && l.ExpiryDate.Before(rule.ExpiredBefore) {
+			expired = expired.Add(l.Quantity)
+			continue
+		}
+		candidates = append(candidates, l)
+		available = available.Add(l.Quantity)
+	}
+	if available.LessThan(qty) {
+		if expired.IsPositive() {
+			return nil, fmt.Errorf("%w: requested %s, available %s (%s expired)", ErrInsufficientStock, qty.String(), available.String(), expired.String())
+		}
+		return nil, fmt.Errorf("%w: requested %s, available %s", ErrInsufficientStock, qty.String(), available.String())
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		switch {
+		case a.ExpiryDate != nil && b.ExpiryDate == nil:
+			return true
+		case a.ExpiryDate == nil && b.ExpiryDate != nil:
+			return false
+		case a.ExpiryDate != nil && !a.ExpiryDate.Equal(*b.ExpiryDate):
+			return a.ExpiryDate.Before(*b.ExpiryDate)
+		case a.Bin != b.Bin:
+			return a.Bin < b.Bin
+		}
+		return a.LotNumber < b.LotNumber
+	})
+
+	var takes []schema.StockTake
+	remaining := qty
+	for _, c := range candidates {
+		if !remaining.IsPositive() {
+			break
+		}
+		take := c
+		take.Quantity = decimal.Min(c.Quantity, remaining)
+		takes = append(takes, take)
+		remaining = remaining.Sub(take.Quantity)
+	}
+	return takes, nil
+}
+
+// defaultWarehouse returns the tenant's first active warehouse, or nil when it has none.
+func defaultWarehouse(ctx context.Context, warehouses *ent.WarehouseClient, tenantID int) (*ent.Warehouse, error) {
+	wh, err := warehouses.Query().
+		Where(warehouse.HasTenantWith(tenant.ID(tenantID)), warehouse.IsActive(true)).
+		Order(ent.Asc(warehouse.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return wh, err
+}
+
+// seedLegacyLevel places stock recorded before warehouses were tracked in the default warehouse,
+// the first time the product is moved. Products that already have levels are left alone.
+func seedLegacyLevel(ctx context.Context, tx *ent.Tx, tenantID int, prod *ent.Product) error {
+	if !prod.Quantity.IsPositive() {
+		return nil
+	}
+	has, err := tx.StockLevel.Query().Where(stocklevel.HasProductWith(product.ID(prod.ID))).Exist(ctx)
+	if err != nil || has {
+		return err
+	}
+	// Stock in transit is part of Product.quantity too, but nothing can be in transit without levels.
+	wh, err := defaultWarehouse(ctx, tx.Warehouse, tenantID)
+	if err != nil || wh == nil {
+		return err
+	}
+	return tx.StockLevel.Create().
+		SetTenantID(tenantID).
+		SetProductID(prod.ID).
+		SetWarehouseID(wh.ID).
+		SetQuantity(prod.Quantity).
+		Exec(ctx)
+}
+
+// addStock puts stock into a bin and lot, creating the level on first use.
+func addStock(ctx context.Context, tx *ent.Tx, tenantID, productID, warehouseID int, t schema.StockTake) error {
+	lvl, err := tx.StockLevel.Query().
+		Where(
+			stocklevel.HasProductWith(product.ID(productID)),
+			stocklevel.HasWarehouseWith(warehouse.ID(warehouseID)),
+			stocklevel.Bin(t.Bin),
+			stocklevel.LotNumber(t.LotNumber),
+		).
+		Modify(func(s *sql.Selector) {
+			s.ForUpdate()
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return tx.StockLevel.Create().
+			SetTenantID(tenantID).
+			SetProductID(productID).
+			SetWarehouseID(warehouseID).
+			SetBin(t.Bin).
+			SetLotNumber(t.LotNumber).
+			SetNillableExpiryDate(t.ExpiryDate).
+			SetQuantity(t.Quantity).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.StockLevel.UpdateOne(lvl).SetQuantity(lvl.Quantity.Add(t.Quantity)).Exec(ctx)
+}
+
+// takeStock removes qty of a product from a warehouse as the rule allows and returns what was
+// taken from which bin and lot. Product.quantity is left to the caller.
+func takeStock(ctx context.Context, tx *ent.Tx, tenantID int, prod *ent.Product, warehouseID int, rule pickRule, qty decimal.Decimal) ([]schema.StockTake, error) {
+	if err := seedLegacyLevel(ctx, tx, tenantID, prod); err != nil {
+		return nil, err
+	}
+	levels, err := tx.StockLevel.Query().
+		Where(
+			stocklevel.HasProductWith(product.ID(prod.ID)),
+			stocklevel.HasWarehouseWith(warehouse.ID(warehouseID)),
+		).
+		Modify(func(s *sql.Selector) {
+			s.ForUpdate()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type binLot struct{ bin, lot string }
+	byKey := make(map[binLot]*ent.StockLevel, len(levels))
+	current := make([]schema.StockTake, len(levels))
+	for i, l := range levels {
+		byKey[binLot{l.Bin, l.LotNumber}] = l
+		current[i] = schema.StockTake{Bin: l.Bin, LotNumber: l.LotNumber, ExpiryDate: l.ExpiryDate, Quantity: l.Quantity}
+	}
+	takes, err := planBinTakes(current, qty, rule)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", prod.Name, err)
+	}
+	for _, t := range takes {
+		l := byKey[binLot{t.Bin, t.LotNumber}]
+		if err := tx.StockLevel.UpdateOne(l).SetQuantity(l.Quantity.Sub(t.Quantity)).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return takes, nil
+}
+
+// StockLevelDTO is the quantity of a product in one bin of a warehouse.
+type StockLevelDTO struct {
+	WarehouseID   int        `json:"warehouseID"`
+	WarehouseName string     `json:"warehouseName"`
+	Bin           string     `json:"bin"`
+	LotNumber     string     `json:"lotNumber"`
+	ExpiryDate    *time.Time `json:"expiryDate,omitempty"`
+	Quantity      float64    `json:"quantity"`
+}
+
+// GetStockLevels lists where a product is held, by warehouse and bin.
+func (s *StockBridge) GetStockLevels(productID int) ([]StockLevelDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := s.db.StockLevel.Query().
+		Where(
+			stocklevel.HasTenantWith(tenant.ID(profile.TenantID)),
+			stocklevel.HasProductWith(product.ID(productID)),
+		).
+		WithWarehouse().
+		All(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]StockLevelDTO, 0, len(levels))
+	for _, l := range levels {
+		if l.Quantity.IsZero() {
+			continue
+		}
+		dtos = append(dtos, StockLevelDTO{
+			WarehouseID:   l.Edges.Warehouse.ID,
+			WarehouseName: l.Edges.Warehouse.Name,
+			Bin:           l.Bin,
+			LotNumber:     l.LotNumber,
+			ExpiryDate:    l.ExpiryDate,
+			Quantity:      l.Quantity.InexactFloat64(),
+		})
+	}
+	sort.Slice(dtos, func(i, j int) bool {
+		if dtos[i].WarehouseName != dtos[j].WarehouseName {
+			return dtos[i].WarehouseName < dtos[j].WarehouseName
+		}
+		if dtos[i].Bin != dtos[j].Bin {
+			return dtos[i].Bin < dtos[j].Bin
+		}
+		return dtos[i].LotNumber < dtos[j].LotNumber
+	})
+	return dtos, nil
+}
+
+// MoveBin moves stock between bins of the same warehouse, keeping its lots. An empty lot
+// number moves the first-expiring lots first.
+func (s *StockBridge) MoveBin(productID, warehouseID int, fromBin, toBin, lotNumber string, quantity float64) error {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	qty := decimal.NewFromFloat(quantity)
+	if !qty.IsPositive() {
+		return fmt.Errorf("quantity must be positive")
+	}
+	if fromBin == toBin {
+		return nil
+	}
+
+	prod, err := s.db.Product.Query().
+		Where(product.ID(productID), product.HasTenantWith(tenant.ID(profile.TenantID))).
+		Only(s.ctx)
+	if err != nil {
+		return fmt.Errorf("product not found or access denied: %w", err)
+	}
+	ok, err := s.db.Warehouse.Query().
+		Where(warehouse.ID(warehouseID), warehouse.HasTenantWith(tenant.ID(profile.TenantID))).
+		Exist(s.ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("warehouse %d not found", warehouseID)
+	}
+
+	tx, err := s.db.Tx(s.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	takes, err := takeStock(s.ctx, tx, profile.TenantID, prod, warehouseID, pickRule{Bin: &fromBin, LotNumber: lotNumber}, qty)
+	if err != nil {
+		return err
+	}
+	for _, t := range takes {
+		t.Bin = toBin
+		if err := addStock(s.ctx, tx, profile.TenantID, prod.ID, warehouseID, t); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.logAudit("move_bin", "product", prod.ID, map[string]interface{}{
+		"warehouse": warehouseID,
+		"from":      fromBin,
+		"to":        toBin,
+		"lot":       lotNumber,
+		"quantity":  qty.String(),
+	})
+	return nil
+}
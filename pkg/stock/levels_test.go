@@ -0,0 +1,128 @@
+package stock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"sent/ent"
+	"sent/ent/schema"
+	"sent/ent/stockalert"
+
+	"github.com/shopspring/decimal"
+)
+
+func date(s string) *time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return &t
+}
+
+func TestPlanBinTakes(t *testing.T) {
+	levels := []schema.StockTake{
+		{Bin: "B-02", Quantity: decimal.NewFromInt(5)},
+		{Bin: "A-01", Quantity: decimal.NewFromInt(3)},
+		{Bin: "", Quantity: decimal.NewFromInt(2)},
+		{Bin: "C-09", Quantity: decimal.Zero},
+	}
+
+	takes, err := planBinTakes(levels, decimal.NewFromInt(6), pickRule{})
+	if err != nil {
+		t.Fatalf("planBinTakes: %v", err)
+	}
+	want := []schema.StockTake{
+		{Bin: "", Quantity: decimal.NewFromInt(2)},
+		{Bin: "A-01", Quantity: decimal.NewFromInt(3)},
+		{Bin: "B-02", Quantity: decimal.NewFromInt(1)},
+	}
+	if len(takes) != len(want) {
+		t.Fatalf("takes = %v, want %v", takes, want)
+	}
+	for i := range want {
+		if takes[i].Bin != want[i].Bin || !takes[i].Quantity.Equal(want[i].Quantity) {
+			t.Errorf("take %d = %v, want %v", i, takes[i], want[i])
+		}
+	}
+
+	bin := "B-02"
+	takes, err = planBinTakes(levels, decimal.NewFromInt(4), pickRule{Bin: &bin})
+	if err != nil || len(takes) != 1 || takes[0].Bin != "B-02" {
+		t.Errorf("taking from one bin = %v, %v", takes, err)
+	}
+	if _, err := planBinTakes(levels, decimal.NewFromInt(6), pickRule{Bin: &bin}); !errors.Is(err, ErrInsufficientStock) {
+		t.Errorf("overdrawing a bin = %v", err)
+	}
+
+	// Stock that exists only in another warehouse never reaches the plan.
+	if _, err := planBinTakes(nil, decimal.NewFromInt(1), pickRule{}); !errors.Is(err, ErrInsufficientStock) {
+		t.Errorf("empty warehouse = %v", err)
+	}
+}
+
+func TestPlanBinTakesFEFO(t *testing.T) {
+	levels := []schema.StockTake{
+		{Bin: "", LotNumber: "L3", Quantity: decimal.NewFromInt(10)},
+		{Bin: "A-01", LotNumber: "L2", ExpiryDate: date("2026-12-01"), Quantity: decimal.NewFromInt(4)},
+		{Bin: "B-01", LotNumber: "L1", ExpiryDate: date("2026-11-01"), Quantity: decimal.NewFromInt(3)},
+		{Bin: "A-01", LotNumber: "L0", ExpiryDate: date("2026-10-01"), Quantity: decimal.NewFromInt(5)},
+	}
+
+	takes, err := planBinTakes(levels, decimal.NewFromInt(8), pickRule{ExpiredBefore: *date("2026-10-18")})
+	if err != nil {
+		t.Fatalf("planBinTakes: %v", err)
+	}
+	if len(takes) != 3 || takes[0].LotNumber != "L1" || takes[1].LotNumber != "L2" || takes[2].LotNumber != "L3" {
+		t.Fatalf("takes = %+v, want L1, L2 then the undated L3", takes)
+	}
+	if !takes[2].Quantity.Equal(decimal.NewFromInt(1)) || takes[0].ExpiryDate == nil {
+		t.Errorf("last take = %+v", takes[2])
+	}
+
+	// The expired lot is never sold, and the error says why stock looks short.
+	_, err = planBinTakes(levels, decimal.NewFromInt(20), pickRule{ExpiredBefore: *date("2026-10-18")})
+	if !errors.Is(err, ErrInsufficientStock) || err.Error() != "insufficient stock: requested 20, available 17 (5 expired)" {
+		t.Errorf("err = %v", err)
+	}
+
+	// Without an expiry rule, e.g. shipping to a returns warehouse, it goes first.
+	takes, err = planBinTakes(levels, decimal.NewFromInt(2), pickRule{LotNumber: "L0"})
+	if err != nil || len(takes) != 1 || takes[0].LotNumber != "L0" {
+		t.Errorf("picking an expired lot by number = %v, %v", takes, err)
+	}
+}
+
+func TestOrderBatches(t *testing.T) {
+	batches := []*ent.StockMovement{
+		{ID: 1},
+		{ID: 2, LotNumber: "L2", ExpiryDate: date("2026-12-01")},
+		{ID: 3, LotNumber: "L1", ExpiryDate: date("2026-11-01")},
+		{ID: 4, LotNumber: "L2", ExpiryDate: date("2026-12-01")},
+	}
+	orderBatches(batches, "")
+	if batches[0].ID != 3 || batches[1].ID != 2 || batches[2].ID != 4 || batches[3].ID != 1 {
+		t.Errorf("FEFO order = %d %d %d %d", batches[0].ID, batches[1].ID, batches[2].ID, batches[3].ID)
+	}
+	orderBatches(batches, "L2")
+	if batches[0].ID != 2 || batches[1].ID != 4 || batches[2].ID != 3 {
+		t.Errorf("sold lot first = %d %d %d", batches[0].ID, batches[1].ID, batches[2].ID)
+	}
+}
+
+func TestExpiryAlertType(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+	cases := []struct {
+		expiry string
+		want   stockalert.AlertType
+		due    bool
+	}{
+		{"2026-10-17", stockalert.AlertTypeLotExpired, true},
+		{"2026-10-18", stockalert.AlertTypeLotExpiring, true}, // Last sellable day
+		{"2026-11-17", stockalert.AlertTypeLotExpiring, true},
+		{"2026-11-18", "", false},
+	}
+	for _, c := range cases {
+		got, due := expiryAlertType(*date(c.expiry), now, 30)
+		if got != c.want || due != c.due {
+			t.Errorf("expiry %s = %q %v, want %q %v", c.expiry, got, due, c.want, c.due)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"sent/ent"
+	"sent/ent/product"
+	"sent/ent/stockalert"
+	"sent/ent/stocklevel"
+	"sent/ent/stockmovement"
+	"sent/ent/tenant"
+
+	"github.com/shopspring/decimal"
+)
+
+// expiryAlertType decides whether a lot expiring on expiry needs an alert on now. A lot may be
+// sold up to and including its expiry date.
+func expiryAlertType(expiry, now time.Time, leadDays int) (stockalert.AlertType, bool) {
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	switch {
+	case expiry.Before(today):
+		return stockalert.AlertTypeLotExpired, true
+	case expiry.Before(today.AddDate(0, 0, leadDays+1)):
+		return stockalert.AlertTypeLotExpiring, true
+	}
+	return "", false
+}
+
+// ExpiryWorker raises StockAlerts for lots on hand that are about to expire or have expired.
+type ExpiryWorker struct {
+	db *ent.Client
+}
+
+func NewExpiryWorker(db *ent.Client) *ExpiryWorker {
+	return &ExpiryWorker{db: db}
+}
+
+func (w *ExpiryWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	fmt.Println("[STOCK] Expiry worker started.")
+
+	for {
+		if err := w.ScanExpiries(ctx); err != nil {
+			fmt.Printf("[STOCK] Expiry scan error: %v\n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScanExpiries raises one alert per lot and stage; a dismissed alert is not raised again.
+func (w *ExpiryWorker) ScanExpiries(ctx context.Context) error {
+	levels, err := w.db.StockLevel.Query().
+		Where(stocklevel.ExpiryDateNotNil(), stocklevel.QuantityGT(decimal.Zero)).
+		WithProduct().
+		WithTenant().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	type lotKey struct {
+		tenantID, productID int
+		lot                 string
+	}
+	type lotStock struct {
+		product  *ent.Product
+		expiry   time.Time
+		quantity decimal.Decimal
+	}
+	lots := make(map[lotKey]*lotStock)
+	var keys []lotKey
+	for _, l := range levels {
+		k := lotKey{l.Edges.Tenant.ID, l.Edges.Product.ID, l.LotNumber}
+		ls, ok := lots[k]
+		if !ok {
+			ls = &lotStock{product: l.Edges.Product, expiry: *l.ExpiryDate}
+			lots[k] = ls
+			keys = append(keys, k)
+		}
+		ls.quantity = ls.quantity.Add(l.Quantity)
+	}
+
+	now := time.Now()
+	for _, k := range keys {
+		ls := lots[k]
+		alertType, due := expiryAlertType(ls.expiry, now, ls.product.ExpiryAlertDays)
+		if !due {
+			continue
+		}
+		exists, err := w.db.StockAlert.Query().
+			Where(
+				stockalert.HasTenantWith(tenant.ID(k.tenantID)),
+				stockalert.HasProductWith(product.ID(k.productID)),
+				stockalert.LotNumber(k.lot),
+				stockalert.AlertTypeEQ(alertType),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		msg := fmt.Sprintf("Lot %s of %s (%s on hand) expires on %s", k.lot, ls.product.Name, ls.quantity.String(), ls.expiry.Format("2006-01-02"))
+		if alertType == stockalert.AlertTypeLotExpired {
+			msg = fmt.Sprintf("Lot %s of %s expired on %s; %s still on hand", k.lot, ls.product.Name, ls.expiry.Format("2006-01-02"), ls.quantity.String())
+		}
+		err = w.db.StockAlert.Create().
+			SetTenantID(k.tenantID).
+			SetProductID(k.productID).
+			SetAlertType(alertType).
+			SetLotNumber(k.lot).
+			SetMessage(msg).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LotMovementDTO is one movement of a lot in a recall trace.
+type LotMovementDTO struct {
+	Date          time.Time `json:"date"`
+	ProductID     int       `json:"productID"`
+	ProductName   string    `json:"productName"`
+	SKU           string    `json:"sku"`
+	WarehouseName string    `json:"warehouseName"`
+	Bin           string    `json:"bin"`
+	Quantity      float64   `json:"quantity"`
+	Reason        string    `json:"reason"`
+	Reference     string    `json:"reference"` // Sale transaction UUID or transfer number
+	CustomerID    int       `json:"customerID,omitempty"`
+	CustomerName  string    `json:"customerName,omitempty"`
+	CustomerEmail string    `json:"customerEmail,omitempty"`
+	CustomerPhone string    `json:"customerPhone,omitempty"`
+}
+
+// LotTraceDTO is the recall trace of a lot: where it came from, who received it and what is
+// still on the shelves.
+type LotTraceDTO struct {
+	LotNumber  string           `json:"lotNumber"`
+	ExpiryDate *time.Time       `json:"expiryDate,omitempty"`
+	Received   []LotMovementDTO `json:"received"`
+	Transfers  []LotMovementDTO `json:"transfers"`
+	Sold       []LotMovementDTO `json:"sold"`
+	OnHand     []StockLevelDTO  `json:"onHand"`
+}
+
+// TraceLot lists every receipt, transfer and sale of a lot, with the customer where known.
+func (s *StockBridge) TraceLot(lotNumber string) (*LotTraceDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if lotNumber == "" {
+		return nil, fmt.Errorf("lot number is required")
+	}
+
+	moves, err := s.db.StockMovement.Query().
+		Where(
+			stockmovement.HasTenantWith(tenant.ID(profile.TenantID)),
+			stockmovement.LotNumber(lotNumber),
+		).
+		WithProduct().
+		WithWarehouse().
+		WithTransfer().
+		WithTransaction(func(q *ent.TransactionQuery) { q.WithCustomer() }).
+		Order(ent.Asc(stockmovement.FieldCreatedAt)).
+		All(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	trace := &LotTraceDTO{LotNumber: lotNumber}
+	for _, m := range moves {
+		if trace.ExpiryDate == nil {
+			trace.ExpiryDate = m.ExpiryDate
+		}
+		dto := LotMovementDTO{
+			Date:        m.CreatedAt,
+			ProductID:   m.Edges.Product.ID,
+			ProductName: m.Edges.Product.Name,
+			SKU:         m.Edges.Product.Sku,
+			Bin:         m.Bin,
+			Quantity:    m.Quantity.InexactFloat64(),
+			Reason:      m.Reason,
+		}
+		if m.Edges.Warehouse != nil {
+			dto.WarehouseName = m.Edges.Warehouse.Name
+		}
+		if txn := m.Edges.Transaction; txn != nil {
+			dto.Reference = txn.UUID
+			if c := txn.Edges.Customer; c != nil {
+				dto.CustomerID = c.ID
+				dto.CustomerName = c.Name
+				dto.CustomerEmail = c.Email
+				dto.CustomerPhone = c.Phone
+			}
+		}
+
+		switch {
+		case m.Edges.Transfer != nil:
+			dto.Reference = m.Edges.Transfer.Number
+			trace.Transfers = append(trace.Transfers, dto)
+		case m.MovementType == stockmovement.MovementTypeIncoming:
+			trace.Received = append(trace.Received, dto)
+		case m.MovementType == stockmovement.MovementTypeOutgoing:
+			trace.Sold = append(trace.Sold, dto)
+		}
+	}
+
+	levels, err := s.db.StockLevel.Query().
+		Where(
+			stocklevel.HasTenantWith(tenant.ID(profile.TenantID)),
+			stocklevel.LotNumber(lotNumber),
+			stocklevel.QuantityGT(decimal.Zero),
+		).
+		WithWarehouse().
+		All(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range levels {
+		trace.OnHand = append(trace.OnHand, StockLevelDTO{
+			WarehouseID:   l.Edges.Warehouse.ID,
+			WarehouseName: l.Edges.Warehouse.Name,
+			Bin:           l.Bin,
+			LotNumber:     l.LotNumber,
+			ExpiryDate:    l.ExpiryDate,
+			Quantity:      l.Quantity.InexactFloat64(),
+		})
+	}
+	sort.Slice(trace.OnHand, func(i, j int) bool {
+		return trace.OnHand[i].WarehouseName < trace.OnHand[j].WarehouseName
+	})
+	return trace, nil
+}
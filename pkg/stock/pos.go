@@ -0,0 +1,325 @@
+package stock
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/product"
+	"sent/ent/tenant"
+	"sent/pkg/capital"
+	"sent/pkg/tax"
+
+	"github.com/shopspring/decimal"
+)
+
+// Ledger accounts of the till. Card and wallet takings sit in clearing accounts until the
+// acquirer settles; all are created on demand except revenue, which the chart must have.
+const (
+	AccountTillCash       = "1000"
+	AccountCardClearing   = "1110"
+	AccountMobileClearing = "1120"
+	AccountSalesRevenue   = "4000"
+	AccountCashOverShort  = "6900"
+)
+
+// tenderAccounts maps each tender method to the account it is paid into.
+var tenderAccounts = map[string]struct{ number, name string }{
+	"cash":   {AccountTillCash, "Operating Cash"},
+	"card":   {AccountCardClearing, "Card Clearing"},
+	"mobile": {AccountMobileClearing, "Mobile Wallet Clearing"},
+}
+
+// Tender is one payment towards a sale, or one refund paid out.
+type Tender struct {
+	Method    string  `json:"method"` // cash, card or mobile
+	Amount    float64 `json:"amount"`
+	Reference string  `json:"reference,omitempty"` // Card authorization or wallet payment ID
+}
+
+// tenderLine is a validated tender.
+type tenderLine struct {
+	Method    string
+	Reference string
+	Amount    decimal.Decimal
+}
+
+// pricedLine is a till line with its discount and tax worked out. Total is what the customer
+// pays for the line; Net and Tax add up to it.
+type pricedLine struct {
+	Quantity  decimal.Decimal
+	UnitPrice decimal.Decimal
+	Discount  decimal.Decimal
+	Total     decimal.Decimal
+	Net       decimal.Decimal
+	TaxCode   string
+	TaxRate   decimal.Decimal
+	Tax       decimal.Decimal
+}
+
+// priceSale works out what each line costs after its own discount and its share of the basket
+// discount, then splits it into net and tax at the rate of its code, as resolved by saleRates.
+// Till prices include tax, as on the shelf. The basket discount is shared in proportion to line
+// totals, with the rounding on the last line, or on the others when the last line cannot take
+// it all. A line with an amount, rung up from a price label, costs that amount whatever its
+// quantity.
+func priceSale(items []SaleItem, basketDiscount float64, places int32, rates map[string]tax.Rate) ([]pricedLine, decimal.Decimal, error) {
+	if len(items) == 0 {
+		return nil, decimal.Zero, fmt.Errorf("%w: a sale needs at least one item", ErrSaleRejected)
+	}
+	lines := make([]pricedLine, len(items))
+	subtotal := decimal.Zero
+	for i, it := range items {
+		qty := decimal.NewFromFloat(it.Quantity)
+		price := decimal.NewFromFloat(it.Price)
+		disc := decimal.NewFromFloat(it.Discount).Round(places)
+		if !qty.IsPositive() || price.IsNegative() || disc.IsNegative() {
+			return nil, decimal.Zero, fmt.Errorf("%w: line %d: quantity must be positive and price and discount not negative", ErrSaleRejected, i+1)
+		}
+		gross := qty.Mul(price).Round(places)
+		if it.Amount > 0 {
+			gross = decimal.NewFromFloat(it.Amount).Round(places)
+		}
+		if disc.GreaterThan(gross) {
+			return nil, decimal.Zero, fmt.Errorf("%w: line %d: discount %s exceeds the line total %s", ErrSaleRejected, i+1, disc, gross)
+		}
+		lines[i] = pricedLine{Quantity: qty, UnitPrice: price, Discount: disc, Total: gross.Sub(disc), TaxCode: it.TaxCode}
+		subtotal = subtotal.Add(lines[i].Total)
+	}
+
+	basket := decimal.NewFromFloat(basketDiscount).Round(places)
+	if basket.IsNegative() || basket.GreaterThan(subtotal) {
+		return nil, decimal.Zero, fmt.Errorf("%w: basket discount %s is not between 0 and %s", ErrSaleRejected, basket, subtotal)
+	}
+	if basket.IsPositive() {
+		remaining := basket
+		for i := range lines {
+			share := remaining
+			if i < len(lines)-1 {
+				share = basket.Mul(lines[i].Total).Div(subtotal).Round(places)
+			}
+			share = decimal.Min(share, lines[i].Total)
+			lines[i].Discount = lines[i].Discount.Add(share)
+			lines[i].Total = lines[i].Total.Sub(share)
+			remaining = remaining.Sub(share)
+		}
+		// Rounding up the earlier shares can leave the last line less than what is left; the
+		// rest goes on the lines that still have something to take it from. The basket is at
+		// most the subtotal, so it all fits.
+		for i := range lines {
+			if !remaining.IsPositive() {
+				break
+			}
+			share := decimal.Min(remaining, lines[i].Total)
+			lines[i].Discount = lines[i].Discount.Add(share)
+			lines[i].Total = lines[i].Total.Sub(share)
+			remaining = remaining.Sub(share)
+		}
+	}
+
+	total := decimal.Zero
+	for i := range lines {
+		lines[i].Net = lines[i].Total
+		if lines[i].TaxCode != "" {
+			r, ok := rates[lines[i].TaxCode]
+			if !ok {
+				return nil, decimal.Zero, fmt.Errorf("%w: line %d: no rate for tax code %s", ErrSaleRejected, i+1, lines[i].TaxCode)
+			}
+			lines[i].TaxRate = r.Rate
+			lines[i].Net, lines[i].Tax = r.Inclusive(lines[i].Total, places)
+		}
+		total = total.Add(lines[i].Total)
+	}
+	return lines, total, nil
+}
+
+// saleRates gives each line the code of its product's tax category in the jurisdiction of its
+// code, e.g. VAT-JO-Z for a zero-rated product on a VAT-JO till, and resolves the codes at the
+// date of sale. Rates resolved while online are kept, so receipts of sales rung up offline
+// still show their tax.
+func (k *KioskBridge) saleRates(db *ent.Client, tenantID int, items []SaleItem, on time.Time) (map[string]tax.Rate, error) {
+	var ids []int
+	for _, it := range items {
+		if it.TaxCode != "" {
+			ids = append(ids, it.ProductID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	categories := make(map[int]tax.Category)
+	prods, _ := db.Product.Query().
+		Where(product.IDIn(ids...), product.HasTenantWith(tenant.ID(tenantID))).
+		Select(product.FieldTaxCategory).
+		All(k.ctx)
+	for _, p := range prods {
+		categories[p.ID] = tax.Category(p.TaxCategory)
+	}
+
+	rates := make(map[string]tax.Rate)
+	resolver := tax.NewResolver(db, tenantID)
+	for i, it := range items {
+		if it.TaxCode == "" {
+			continue
+		}
+		if jur, cat, pErr := tax.ParseCode(it.TaxCode); pErr == nil && cat == tax.Standard {
+			if c, ok := categories[it.ProductID]; ok {
+				items[i].TaxCode = tax.CodeFor(jur, c)
+			}
+		}
+		code := items[i].TaxCode
+		if _, ok := rates[code]; ok {
+			continue
+		}
+		r, err := resolver.Resolve(k.ctx, code, on)
+		if errors.Is(err, tax.ErrUnknownCode) || errors.Is(err, tax.ErrNoRate) {
+			return nil, fmt.Errorf("%w: %w", ErrSaleRejected, err)
+		}
+		if err != nil {
+			// Only a lost connection falls back to what was resolved before.
+			k.ratesMu.Lock()
+			cached, ok := k.rates[code]
+			k.ratesMu.Unlock()
+			if !ok {
+				return nil, err
+			}
+			r = cached
+		}
+		rates[code] = r
+		k.ratesMu.Lock()
+		k.rates[code] = r
+		k.ratesMu.Unlock()
+	}
+	return rates, nil
+}
+
+// totalTax adds up the tax of priced lines.
+func totalTax(lines []pricedLine) decimal.Decimal {
+	sum := decimal.Zero
+	for _, l := range lines {
+		sum = sum.Add(l.Tax)
+	}
+	return sum
+}
+
+// resolveTenders checks that the tenders pay exactly the total. A request without tenders is
+// paid in full by its payment method, as sales were before split tenders.
+func resolveTenders(tenders []Tender, paymentMethod string, total decimal.Decimal, places int32) ([]tenderLine, error) {
+	if len(tenders) == 0 {
+		if paymentMethod == "" {
+			paymentMethod = "cash"
+		}
+		tenders = []Tender{{Method: paymentMethod, Amount: total.InexactFloat64()}}
+	}
+	out := make([]tenderLine, 0, len(tenders))
+	paid := decimal.Zero
+	for _, t := range tenders {
+		method := strings.ToLower(strings.TrimSpace(t.Method))
+		if _, ok := tenderAccounts[method]; !ok {
+			return nil, fmt.Errorf("%w: unknown tender %q", ErrSaleRejected, t.Method)
+		}
+		amount := decimal.NewFromFloat(t.Amount).Round(places)
+		if !amount.IsPositive() {
+			return nil, fmt.Errorf("%w: %s tender must be positive", ErrSaleRejected, method)
+		}
+		out = append(out, tenderLine{Method: method, Reference: t.Reference, Amount: amount})
+		paid = paid.Add(amount)
+	}
+	if !paid.Equal(total) {
+		return nil, fmt.Errorf("%w: tenders of %s do not match the total of %s", ErrSaleRejected, paid, total)
+	}
+	return out, nil
+}
+
+// postTill posts a sale or refund through the ledger service, which also enforces the
+// posting-period lock, and records its tenders. A sale debits the tender accounts and credits
+// revenue and output tax; a refund reverses that.
+func (k *KioskBridge) postTill(tx *ent.Tx, tnt *ent.Tenant, refund bool, saleID string, date time.Time, lines []pricedLine, tenders []tenderLine) (*ent.Transaction, error) {
+	revAcc, err := tx.Account.Query().Where(account.Number(AccountSalesRevenue), account.HasTenantWith(tenant.ID(tnt.ID))).Only(k.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: revenue account (%s) not found", ErrSaleRejected, AccountSalesRevenue)
+		}
+		return nil, err
+	}
+
+	in, out := "debit", "credit"
+	description, typ := "Retail Sale - POS", "sale"
+	if refund {
+		in, out = out, in
+		description, typ = "Retail Refund - POS", "refund"
+	}
+
+	var postLines []capital.PostingLine
+	tenderAcc := make([]*ent.Account, len(tenders))
+	for i, t := range tenders {
+		a := tenderAccounts[t.Method]
+		acc, err := capital.EnsureAccount(k.ctx, tx, tnt, a.number, a.name, account.TypeAsset)
+		if err != nil {
+			return nil, err
+		}
+		tenderAcc[i] = acc
+		postLines = append(postLines, capital.PostingLine{AccountID: acc.ID, Direction: in, Amount: t.Amount})
+	}
+
+	net, taxTotal := decimal.Zero, decimal.Zero
+	for _, l := range lines {
+		net = net.Add(l.Net)
+		taxTotal = taxTotal.Add(l.Tax)
+	}
+	if net.IsPositive() {
+		postLines = append(postLines, capital.PostingLine{AccountID: revAcc.ID, Direction: out, Amount: net})
+	}
+	if taxTotal.IsPositive() {
+		taxAcc, err := capital.EnsureAccount(k.ctx, tx, tnt, capital.AccountOutputTax, "Output VAT", account.TypeLiability)
+		if err != nil {
+			return nil, err
+		}
+		postLines = append(postLines, capital.PostingLine{AccountID: taxAcc.ID, Direction: out, Amount: taxTotal})
+	}
+
+	txn, err := k.ledger.Post(k.ctx, tx, capital.Posting{
+		TenantID:    tnt.ID,
+		Date:        date,
+		Description: description,
+		Type:        typ,
+		UUID:        saleID,
+		Lines:       postLines,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to post %s: %w", typ, err)
+	}
+
+	for i, t := range tenders {
+		err := tx.PosTender.Create().
+			SetTransaction(txn).
+			SetMethod(t.Method).
+			SetAmount(t.Amount).
+			SetReference(t.Reference).
+			SetAccount(tenderAcc[i]).
+			Exec(k.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record %s tender: %w", t.Method, err)
+		}
+	}
+	return txn, nil
+}
+
+// posLineCreate prepares a priced line of a sale or refund for saving.
+func posLineCreate(tx *ent.Tx, txn *ent.Transaction, productID int, l pricedLine) *ent.PosSaleLineCreate {
+	return tx.PosSaleLine.Create().
+		SetTransaction(txn).
+		SetProductID(productID).
+		SetQuantity(l.Quantity).
+		SetUnitPrice(l.UnitPrice).
+		SetDiscount(l.Discount).
+		SetTotal(l.Total).
+		SetNetAmount(l.Net).
+		SetTaxCode(l.TaxCode).
+		SetTaxRate(l.TaxRate).
+		SetTaxAmount(l.Tax)
+}
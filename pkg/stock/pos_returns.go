@@ -0,0 +1,340 @@
+package stock
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/product"
+	"sent/ent/schema"
+	"sent/ent/stockmovement"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/pkg/capital"
+	"sent/pkg/orchestrator"
+	"sent/pkg/tax"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SaleLineDTO is a line of a POS sale, with how much of it can still be returned.
+type SaleLineDTO struct {
+	ID          int     `json:"id"`
+	ProductID   int     `json:"productId"`
+	ProductName string  `json:"productName"`
+	Quantity    float64 `json:"quantity"`
+	Returned    float64 `json:"returned"`
+	UnitPrice   float64 `json:"unitPrice"`
+	Discount    float64 `json:"discount"`
+	Total       float64 `json:"total"`
+	TaxCode     string  `json:"taxCode"`
+	Tax         float64 `json:"tax"`
+}
+
+// SaleDTO is a POS sale as looked up from its receipt for a return.
+type SaleDTO struct {
+	UUID    string        `json:"uuid"`
+	Date    time.Time     `json:"date"`
+	Total   float64       `json:"total"`
+	Tax     float64       `json:"tax"`
+	Lines   []SaleLineDTO `json:"lines"`
+	Tenders []Tender      `json:"tenders"`
+}
+
+// GetSale looks up a POS sale by the ID printed on its receipt.
+func (k *KioskBridge) GetSale(saleUUID string) (*SaleDTO, error) {
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	// The receipt barcode carries the ID without dashes.
+	if id, err := uuid.Parse(strings.TrimSpace(saleUUID)); err == nil {
+		saleUUID = id.String()
+	}
+	sale, err := k.db.Transaction.Query().
+		Where(
+			transaction.UUID(saleUUID),
+			transaction.TypeEQ("sale"),
+			transaction.HasTenantWith(tenant.ID(profile.TenantID)),
+		).
+		WithPosLines(func(q *ent.PosSaleLineQuery) { q.WithProduct() }).
+		WithTenders().
+		Only(k.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sale %s not found: %w", saleUUID, err)
+	}
+
+	dto := &SaleDTO{UUID: sale.UUID, Date: sale.Date, Total: sale.TotalAmount.InexactFloat64(), Tax: sale.TaxAmount.InexactFloat64()}
+	for _, l := range sale.Edges.PosLines {
+		dto.Lines = append(dto.Lines, SaleLineDTO{
+			ID:          l.ID,
+			ProductID:   l.Edges.Product.ID,
+			ProductName: l.Edges.Product.Name,
+			Quantity:    l.Quantity.InexactFloat64(),
+			Returned:    l.ReturnedQuantity.InexactFloat64(),
+			UnitPrice:   l.UnitPrice.InexactFloat64(),
+			Discount:    l.Discount.InexactFloat64(),
+			Total:       l.Total.InexactFloat64(),
+			TaxCode:     l.TaxCode,
+			Tax:         l.TaxAmount.InexactFloat64(),
+		})
+	}
+	for _, t := range sale.Edges.Tenders {
+		dto.Tenders = append(dto.Tenders, Tender{Method: t.Method, Amount: t.Amount.InexactFloat64(), Reference: t.Reference})
+	}
+	return dto, nil
+}
+
+// ReturnLineRequest returns part of a sale line.
+type ReturnLineRequest struct {
+	LineID   int     `json:"lineId"`
+	Quantity float64 `json:"quantity"`
+}
+
+// ReturnRequest refunds goods brought back from an earlier sale.
+type ReturnRequest struct {
+	UUID     string              `json:"uuid"`     // Generated by the terminal, like a sale's
+	SaleUUID string              `json:"saleUuid"` // The sale being returned, from its receipt
+	Lines    []ReturnLineRequest `json:"lines"`
+	Tenders  []Tender            `json:"tenders,omitempty"` // Empty refunds to the sale's first tender
+	Reason   string              `json:"reason"`
+}
+
+// returnShare is the part of a sale line amount that returning qty more of it gives back,
+// when returned was already given back. Working from the running total means the last return
+// takes whatever rounding is left, and a full return refunds exactly what was paid.
+func returnShare(amount, quantity, returned, qty decimal.Decimal, places int32) decimal.Decimal {
+	before := amount.Mul(returned).Div(quantity).Round(places)
+	after := amount.Mul(returned.Add(qty)).Div(quantity).Round(places)
+	return after.Sub(before)
+}
+
+// ProcessReturn refunds returned goods against their original sale and puts them back into
+// stock in the bins and lots they were sold from. Returns need the server; they are not
+// buffered offline.
+func (k *KioskBridge) ProcessReturn(req ReturnRequest) (string, error) {
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	if k.shiftID == 0 {
+		return "", fmt.Errorf("no shift is open on this terminal")
+	}
+	if req.Reason == "" {
+		return "", fmt.Errorf("a reason is required for a return")
+	}
+	if len(req.Lines) == 0 {
+		return "", fmt.Errorf("nothing to return")
+	}
+	if req.UUID == "" {
+		req.UUID = uuid.New().String()
+	}
+
+	tx, err := k.db.Tx(k.ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tnt, err := tx.Tenant.Get(k.ctx, profile.TenantID)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve tenant: %w", err)
+	}
+	exists, err := tx.Transaction.Query().Where(transaction.UUID(req.UUID)).Exist(k.ctx)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "Return already recorded.", nil
+	}
+
+	sale, err := tx.Transaction.Query().
+		Where(
+			transaction.UUID(req.SaleUUID),
+			transaction.TypeEQ("sale"),
+			transaction.HasTenantWith(tenant.ID(tnt.ID)),
+		).
+		WithPosLines(func(q *ent.PosSaleLineQuery) { q.WithProduct() }).
+		WithTenders().
+		WithCustomer().
+		WithStockMovements(func(q *ent.StockMovementQuery) {
+			q.WithProduct().WithWarehouse().Order(ent.Asc(stockmovement.FieldID))
+		}).
+		Only(k.ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w: sale %s not found", ErrSaleRejected, req.SaleUUID)
+	}
+
+	places := capital.CurrencyPlaces(tnt.FunctionalCurrency)
+	saleLines := make(map[int]*ent.PosSaleLine, len(sale.Edges.PosLines))
+	for _, l := range sale.Edges.PosLines {
+		saleLines[l.ID] = l
+	}
+
+	type returnLine struct {
+		line   *ent.PosSaleLine
+		qty    decimal.Decimal
+		priced pricedLine
+	}
+	var returns []returnLine
+	total := decimal.Zero
+	for _, r := range req.Lines {
+		l, ok := saleLines[r.LineID]
+		if !ok {
+			return "", fmt.Errorf("%w: line %d is not on sale %s or is listed twice", ErrSaleRejected, r.LineID, req.SaleUUID)
+		}
+		delete(saleLines, r.LineID) // Each line once per return
+		qty := decimal.NewFromFloat(r.Quantity)
+		left := l.Quantity.Sub(l.ReturnedQuantity)
+		if !qty.IsPositive() || qty.GreaterThan(left) {
+			return "", fmt.Errorf("%w: %s: can return up to %s", ErrSaleRejected, l.Edges.Product.Name, left)
+		}
+		p := pricedLine{
+			Quantity:  qty,
+			UnitPrice: l.UnitPrice,
+			Discount:  returnShare(l.Discount, l.Quantity, l.ReturnedQuantity, qty, places),
+			Total:     returnShare(l.Total, l.Quantity, l.ReturnedQuantity, qty, places),
+			TaxCode:   l.TaxCode,
+			TaxRate:   l.TaxRate,
+			Tax:       returnShare(l.TaxAmount, l.Quantity, l.ReturnedQuantity, qty, places),
+		}
+		p.Net = p.Total.Sub(p.Tax)
+		returns = append(returns, returnLine{line: l, qty: qty, priced: p})
+		total = total.Add(p.Total)
+	}
+
+	tenders := req.Tenders
+	if len(tenders) == 0 && len(sale.Edges.Tenders) > 0 {
+		tenders = []Tender{{Method: sale.Edges.Tenders[0].Method, Amount: total.InexactFloat64()}}
+	}
+	refundTenders, err := resolveTenders(tenders, "", total, places)
+	if err != nil {
+		return "", err
+	}
+
+	priced := make([]pricedLine, len(returns))
+	for i, r := range returns {
+		priced[i] = r.priced
+	}
+	refund, err := k.postTill(tx, tnt, true, req.UUID, time.Now(), priced, refundTenders)
+	if err != nil {
+		return "", err
+	}
+	update := tx.Transaction.UpdateOne(refund).
+		SetTaxAmount(totalTax(priced)).
+		SetShiftID(k.shiftID).
+		SetRefundedSale(sale)
+	if sale.Edges.Customer != nil {
+		update.SetCustomer(sale.Edges.Customer)
+	}
+	if err := update.Exec(k.ctx); err != nil {
+		return "", fmt.Errorf("failed to update refund: %w", err)
+	}
+
+	for _, r := range returns {
+		err := posLineCreate(tx, refund, r.line.Edges.Product.ID, r.priced).
+			SetReturnedLine(r.line).
+			Exec(k.ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to record return line: %w", err)
+		}
+		err = tx.PosSaleLine.UpdateOne(r.line).
+			SetReturnedQuantity(r.line.ReturnedQuantity.Add(r.qty)).
+			Exec(k.ctx)
+		if err != nil {
+			return "", err
+		}
+		if err := k.restock(tx, tnt, sale, refund, r.line, r.qty); err != nil {
+			return "", err
+		}
+	}
+	if _, err := tax.IssueSaleEInvoice(k.ctx, tx.Client(), tnt.ID, refund.ID, req.Reason); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if k.audit != nil {
+		err := k.audit.EmitEvent(orchestrator.SecurityAuditEvent{
+			Type:      "refund",
+			KioskID:   1, // In a real app, this would be the actual ID
+			Timestamp: time.Now().Unix(),
+			ActorID:   k.actor(),
+		})
+		if err != nil {
+			fmt.Printf("[KIOSK] Warning: failed to emit security audit event: %v\n", err)
+		}
+	}
+	return fmt.Sprintf("Refunded %s.", total.StringFixed(places)), nil
+}
+
+// restock puts returned goods back where the sale took them from. Earlier returns of the line
+// are assumed to have gone back to the first bins and lots taken, so this return continues from
+// there; stock sold before warehouses were tracked only goes back on the company-wide total.
+func (k *KioskBridge) restock(tx *ent.Tx, tnt *ent.Tenant, sale, refund *ent.Transaction, line *ent.PosSaleLine, qty decimal.Decimal) error {
+	prod, err := tx.Product.Query().Where(product.ID(line.Edges.Product.ID)).Only(k.ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.Product.UpdateOne(prod).SetQuantity(prod.Quantity.Add(qty)).Exec(k.ctx); err != nil {
+		return fmt.Errorf("failed to restock %s: %w", prod.Name, err)
+	}
+
+	skip, remaining := line.ReturnedQuantity, qty
+	for _, m := range sale.Edges.StockMovements {
+		if m.Edges.Product.ID != prod.ID || m.Edges.Warehouse == nil || !remaining.IsPositive() {
+			continue
+		}
+		avail := m.Quantity
+		if skip.IsPositive() {
+			used := decimal.Min(skip, avail)
+			skip, avail = skip.Sub(used), avail.Sub(used)
+		}
+		if !avail.IsPositive() {
+			continue
+		}
+		put := decimal.Min(avail, remaining)
+		take := schema.StockTake{Bin: m.Bin, LotNumber: m.LotNumber, ExpiryDate: m.ExpiryDate, Quantity: put}
+		if err := addStock(k.ctx, tx, tnt.ID, prod.ID, m.Edges.Warehouse.ID, take); err != nil {
+			return err
+		}
+		// Goods come back at the cost they went out at, once the FIFO worker has costed the sale.
+		unitCost := prod.UnitCost
+		if m.CalculatedCogs.IsPositive() {
+			unitCost = m.CalculatedCogs.Div(m.Quantity)
+		}
+		if err := k.returnMovement(tx, tnt, refund, prod, put, unitCost).
+			SetWarehouseID(m.Edges.Warehouse.ID).
+			SetBin(m.Bin).
+			SetLotNumber(m.LotNumber).
+			SetNillableExpiryDate(m.ExpiryDate).
+			Exec(k.ctx); err != nil {
+			return fmt.Errorf("failed to create stock movement for %s: %w", prod.Name, err)
+		}
+		remaining = remaining.Sub(put)
+	}
+	if remaining.IsPositive() {
+		if err := k.returnMovement(tx, tnt, refund, prod, remaining, prod.UnitCost).Exec(k.ctx); err != nil {
+			return fmt.Errorf("failed to create stock movement for %s: %w", prod.Name, err)
+		}
+	}
+	return nil
+}
+
+// returnMovement prepares the incoming movement of returned goods, which the FIFO worker can
+// sell again as a cost layer of its own.
+func (k *KioskBridge) returnMovement(tx *ent.Tx, tnt *ent.Tenant, refund *ent.Transaction, prod *ent.Product, qty, unitCost decimal.Decimal) *ent.StockMovementCreate {
+	return tx.StockMovement.Create().
+		SetQuantity(qty).
+		SetRemainingQuantity(qty).
+		SetUnitCost(unitCost).
+		SetMovementType("incoming").
+		SetReason("Retail Return").
+		SetProduct(prod).
+		SetTenant(tnt).
+		SetTransaction(refund)
+}
@@ -0,0 +1,431 @@
+package stock
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/posshift"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/pkg/capital"
+
+	"github.com/shopspring/decimal"
+)
+
+// ShiftDTO is a cashier shift. Expected cash and over/short are only known once the shift is
+// closed, so a cashier cannot count towards a number.
+type ShiftDTO struct {
+	ID           int        `json:"id"`
+	Cashier      string     `json:"cashier"`
+	Status       string     `json:"status"`
+	WarehouseID  int        `json:"warehouseId,omitempty"`
+	OpeningFloat float64    `json:"openingFloat"`
+	OpenedAt     time.Time  `json:"openedAt"`
+	ClosedAt     *time.Time `json:"closedAt,omitempty"`
+	CountedCash  *float64   `json:"countedCash,omitempty"`
+	ExpectedCash *float64   `json:"expectedCash,omitempty"`
+	OverShort    *float64   `json:"overShort,omitempty"`
+}
+
+func shiftDTO(s *ent.PosShift) *ShiftDTO {
+	dto := &ShiftDTO{
+		ID:           s.ID,
+		Cashier:      s.Cashier,
+		Status:       string(s.Status),
+		OpeningFloat: s.OpeningFloat.InexactFloat64(),
+		OpenedAt:     s.OpenedAt,
+		ClosedAt:     s.ClosedAt,
+	}
+	if s.Edges.Warehouse != nil {
+		dto.WarehouseID = s.Edges.Warehouse.ID
+	}
+	if s.Status == posshift.StatusClosed {
+		expected, overShort := s.ExpectedCash.InexactFloat64(), s.OverShort.InexactFloat64()
+		dto.ExpectedCash, dto.OverShort = &expected, &overShort
+		if s.CountedCash != nil {
+			counted := s.CountedCash.InexactFloat64()
+			dto.CountedCash = &counted
+		}
+	}
+	return dto
+}
+
+// OpenShift starts the signed-in cashier's shift on this terminal with the float in the drawer.
+func (k *KioskBridge) OpenShift(openingFloat float64) (*ShiftDTO, error) {
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if k.shiftID != 0 {
+		return nil, fmt.Errorf("shift %d is still open on this terminal", k.shiftID)
+	}
+	float := decimal.NewFromFloat(openingFloat)
+	if float.IsNegative() {
+		return nil, fmt.Errorf("opening float cannot be negative")
+	}
+
+	create := k.db.PosShift.Create().
+		SetTenantID(profile.TenantID).
+		SetCashier(profile.Name).
+		SetCashierEmail(profile.Email).
+		SetOpeningFloat(float)
+	if k.warehouseID != 0 {
+		create.SetWarehouseID(k.warehouseID)
+	}
+	shift, err := create.Save(k.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open shift: %w", err)
+	}
+	if k.buffer != nil {
+		if err := k.buffer.SetSetting(kioskShiftSetting, strconv.Itoa(shift.ID)); err != nil {
+			return nil, fmt.Errorf("failed to save kiosk shift: %w", err)
+		}
+	}
+	k.shiftID = shift.ID
+	return shiftDTO(shift), nil
+}
+
+// GetCurrentShift returns the shift open on this terminal, or nil when there is none.
+func (k *KioskBridge) GetCurrentShift() (*ShiftDTO, error) {
+	if k.shiftID == 0 {
+		return nil, nil
+	}
+	shift, err := k.db.PosShift.Query().Where(posshift.ID(k.shiftID)).WithWarehouse().Only(k.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return shiftDTO(shift), nil
+}
+
+// CloseShift closes this terminal's shift with the cashier's blind count of the drawer. Any
+// difference from the expected cash is posted to cash over/short. The shift cannot close while
+// its sales are still waiting to sync, as the expected cash would be wrong.
+func (k *KioskBridge) CloseShift(countedCash float64) (*ShiftDTO, error) {
+	if k.shiftID == 0 {
+		return nil, fmt.Errorf("no shift is open on this terminal")
+	}
+	counted := decimal.NewFromFloat(countedCash)
+	if counted.IsNegative() {
+		return nil, fmt.Errorf("counted cash cannot be negative")
+	}
+	if k.buffer != nil {
+		unsynced, err := k.buffer.ListUnsynced()
+		if err != nil {
+			return nil, err
+		}
+		if n := countShiftSales(unsynced, k.shiftID); n > 0 {
+			return nil, fmt.Errorf("%d offline sale(s) of this shift have not synced yet", n)
+		}
+	}
+
+	tx, err := k.db.Tx(k.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	shift, err := tx.PosShift.Query().
+		Where(posshift.ID(k.shiftID), posshift.StatusEQ(posshift.StatusOpen)).
+		WithTenant().
+		WithWarehouse().
+		WithTransactions(func(q *ent.TransactionQuery) {
+			q.Where(transaction.TypeIn("sale", "refund")).WithTenders()
+		}).
+		Only(k.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("shift %d is not open: %w", k.shiftID, err)
+	}
+	tnt := shift.Edges.Tenant
+
+	expected := shift.OpeningFloat.Add(shiftCash(shift.Edges.Transactions))
+	overShort := counted.Sub(expected)
+	now := time.Now()
+
+	if !overShort.IsZero() {
+		cash, err := capital.EnsureAccount(k.ctx, tx, tnt, AccountTillCash, "Operating Cash", account.TypeAsset)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := capital.EnsureAccount(k.ctx, tx, tnt, AccountCashOverShort, "Cash Over/Short", account.TypeExpense)
+		if err != nil {
+			return nil, err
+		}
+		cashDir, diffDir := "debit", "credit"
+		if overShort.IsNegative() {
+			cashDir, diffDir = diffDir, cashDir
+		}
+		txn, err := k.ledger.Post(k.ctx, tx, capital.Posting{
+			TenantID:    tnt.ID,
+			Date:        now,
+			Description: fmt.Sprintf("Cash over/short - %s shift %d", shift.Cashier, shift.ID),
+			Type:        "cash_over_short",
+			Reference:   fmt.Sprintf("SHIFT-%d", shift.ID),
+			Lines: []capital.PostingLine{
+				{AccountID: cash.ID, Direction: cashDir, Amount: overShort.Abs()},
+				{AccountID: diff.ID, Direction: diffDir, Amount: overShort.Abs()},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to post cash over/short: %w", err)
+		}
+		if err := tx.PosShift.UpdateOne(shift).AddTransactions(txn).Exec(k.ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	closed, err := tx.PosShift.UpdateOne(shift).
+		SetStatus(posshift.StatusClosed).
+		SetClosedAt(now).
+		SetCountedCash(counted).
+		SetExpectedCash(expected).
+		SetOverShort(overShort).
+		Save(k.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close shift: %w", err)
+	}
+	closed.Edges.Warehouse = shift.Edges.Warehouse
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if k.buffer != nil {
+		if err := k.buffer.SetSetting(kioskShiftSetting, ""); err != nil {
+			fmt.Printf("[KIOSK] Warning: failed to clear kiosk shift: %v\n", err)
+		}
+	}
+	k.shiftID = 0
+	return shiftDTO(closed), nil
+}
+
+// countShiftSales counts the unsynced sales taken during a shift.
+func countShiftSales(sales []OfflineSale, shiftID int) int {
+	n := 0
+	for _, o := range sales {
+		if o.Sale.ShiftID == shiftID {
+			n++
+		}
+	}
+	return n
+}
+
+// shiftCash is the cash taken less the cash refunded by a shift's sales and refunds.
+func shiftCash(txns []*ent.Transaction) decimal.Decimal {
+	sum := decimal.Zero
+	for _, t := range txns {
+		for _, td := range t.Edges.Tenders {
+			if td.Method != "cash" {
+				continue
+			}
+			if t.Type == "refund" {
+				sum = sum.Sub(td.Amount)
+			} else {
+				sum = sum.Add(td.Amount)
+			}
+		}
+	}
+	return sum
+}
+
+// ZReportTenderDTO is what one tender method took in and paid out.
+type ZReportTenderDTO struct {
+	Method  string  `json:"method"`
+	Sales   float64 `json:"sales"`
+	Refunds float64 `json:"refunds"`
+	Net     float64 `json:"net"`
+}
+
+// ZReportTaxDTO is the tax collected at one code and rate, net of refunds.
+type ZReportTaxDTO struct {
+	Code    string  `json:"code"`
+	Rate    float64 `json:"rate"`
+	Taxable float64 `json:"taxable"`
+	Tax     float64 `json:"tax"`
+}
+
+// ZReportCashierDTO is one cashier's day.
+type ZReportCashierDTO struct {
+	Cashier   string  `json:"cashier"`
+	Shifts    int     `json:"shifts"`
+	Sales     int     `json:"sales"`
+	Refunds   int     `json:"refunds"`
+	Net       float64 `json:"net"`
+	OverShort float64 `json:"overShort"`
+}
+
+// ZReportDTO is the end-of-day report of the tills.
+type ZReportDTO struct {
+	Date       string              `json:"date"`
+	Sales      int                 `json:"sales"`
+	Refunds    int                 `json:"refunds"`
+	GrossSales float64             `json:"grossSales"` // Before discounts
+	Discounts  float64             `json:"discounts"`
+	Returns    float64             `json:"returns"`
+	NetSales   float64             `json:"netSales"` // Taken less refunded, tax included
+	Tax        float64             `json:"tax"`
+	OverShort  float64             `json:"overShort"`
+	OpenShifts int                 `json:"openShifts"` // Shifts not closed yet; their cash is not counted
+	Tenders    []ZReportTenderDTO  `json:"tenders"`
+	Taxes      []ZReportTaxDTO     `json:"taxes"`
+	Cashiers   []ZReportCashierDTO `json:"cashiers"`
+}
+
+// GetZReport reports the tills for a day (YYYY-MM-DD) from the shifts opened that day.
+func (k *KioskBridge) GetZReport(date string) (*ZReportDTO, error) {
+	if !k.auth.HasRole("admin") {
+		return nil, fmt.Errorf("permission denied: only managers can run the Z-report")
+	}
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	day, err := time.ParseInLocation("2006-01-02", date, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+
+	shifts, err := k.db.PosShift.Query().
+		Where(
+			posshift.HasTenantWith(tenant.ID(profile.TenantID)),
+			posshift.OpenedAtGTE(day),
+			posshift.OpenedAtLT(day.AddDate(0, 0, 1)),
+		).
+		WithTransactions(func(q *ent.TransactionQuery) {
+			q.Where(transaction.TypeIn("sale", "refund")).WithTenders().WithPosLines()
+		}).
+		All(k.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildZReport(date, shifts), nil
+}
+
+// buildZReport totals shifts by tender, tax and cashier. Refunds count against the day they
+// are given on, whichever day the sale was.
+func buildZReport(date string, shifts []*ent.PosShift) *ZReportDTO {
+	r := &ZReportDTO{Date: date}
+	var gross, discounts, returns, taxTotal, overShort decimal.Decimal
+
+	type tenderSum struct{ sales, refunds decimal.Decimal }
+	tenders := make(map[string]*tenderSum)
+	type taxKey struct{ code, rate string }
+	type taxSum struct {
+		rate         decimal.Decimal
+		taxable, tax decimal.Decimal
+	}
+	taxes := make(map[taxKey]*taxSum)
+	type cashierSum struct {
+		shifts, sales, refunds int
+		net, overShort         decimal.Decimal
+	}
+	cashiers := make(map[string]*cashierSum)
+
+	for _, s := range shifts {
+		c, ok := cashiers[s.Cashier]
+		if !ok {
+			c = &cashierSum{}
+			cashiers[s.Cashier] = c
+		}
+		c.shifts++
+		if s.Status == posshift.StatusOpen {
+			r.OpenShifts++
+		} else {
+			c.overShort = c.overShort.Add(s.OverShort)
+			overShort = overShort.Add(s.OverShort)
+		}
+
+		for _, t := range s.Edges.Transactions {
+			refund := t.Type == "refund"
+			sign := decimal.NewFromInt(1)
+			if refund {
+				sign = sign.Neg()
+				r.Refunds++
+				c.refunds++
+			} else {
+				r.Sales++
+				c.sales++
+			}
+			for _, td := range t.Edges.Tenders {
+				ts, ok := tenders[td.Method]
+				if !ok {
+					ts = &tenderSum{}
+					tenders[td.Method] = ts
+				}
+				if refund {
+					ts.refunds = ts.refunds.Add(td.Amount)
+				} else {
+					ts.sales = ts.sales.Add(td.Amount)
+				}
+			}
+			for _, l := range t.Edges.PosLines {
+				if refund {
+					returns = returns.Add(l.Total)
+				} else {
+					gross = gross.Add(l.Total.Add(l.Discount))
+					discounts = discounts.Add(l.Discount)
+				}
+				c.net = c.net.Add(l.Total.Mul(sign))
+				taxTotal = taxTotal.Add(l.TaxAmount.Mul(sign))
+				if l.TaxCode == "" {
+					continue
+				}
+				k := taxKey{l.TaxCode, l.TaxRate.String()}
+				ts, ok := taxes[k]
+				if !ok {
+					ts = &taxSum{rate: l.TaxRate}
+					taxes[k] = ts
+				}
+				ts.taxable = ts.taxable.Add(l.NetAmount.Mul(sign))
+				ts.tax = ts.tax.Add(l.TaxAmount.Mul(sign))
+			}
+		}
+	}
+
+	r.GrossSales = gross.InexactFloat64()
+	r.Discounts = discounts.InexactFloat64()
+	r.Returns = returns.InexactFloat64()
+	r.NetSales = gross.Sub(discounts).Sub(returns).InexactFloat64()
+	r.Tax = taxTotal.InexactFloat64()
+	r.OverShort = overShort.InexactFloat64()
+
+	for method, ts := range tenders {
+		r.Tenders = append(r.Tenders, ZReportTenderDTO{
+			Method:  method,
+			Sales:   ts.sales.InexactFloat64(),
+			Refunds: ts.refunds.InexactFloat64(),
+			Net:     ts.sales.Sub(ts.refunds).InexactFloat64(),
+		})
+	}
+	sort.Slice(r.Tenders, func(i, j int) bool { return r.Tenders[i].Method < r.Tenders[j].Method })
+
+	for k, ts := range taxes {
+		r.Taxes = append(r.Taxes, ZReportTaxDTO{
+			Code:    k.code,
+			Rate:    ts.rate.InexactFloat64(),
+			Taxable: ts.taxable.InexactFloat64(),
+			Tax:     ts.tax.InexactFloat64(),
+		})
+	}
+	sort.Slice(r.Taxes, func(i, j int) bool {
+		if r.Taxes[i].Code != r.Taxes[j].Code {
+			return r.Taxes[i].Code < r.Taxes[j].Code
+		}
+		return r.Taxes[i].Rate < r.Taxes[j].Rate
+	})
+
+	for name, c := range cashiers {
+		r.Cashiers = append(r.Cashiers, ZReportCashierDTO{
+			Cashier:   name,
+			Shifts:    c.shifts,
+			Sales:     c.sales,
+			Refunds:   c.refunds,
+			Net:       c.net.InexactFloat64(),
+			OverShort: c.overShort.InexactFloat64(),
+		})
+	}
+	sort.Slice(r.Cashiers, func(i, j int) bool { return r.Cashiers[i].Cashier < r.Cashiers[j].Cashier })
+	return r
+}
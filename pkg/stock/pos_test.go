@@ -0,0 +1,200 @@
+package stock
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"sent/ent"
+	"sent/ent/posshift"
+	"sent/pkg/bridge/peripherals/escpos"
+	"sent/pkg/tax"
+
+	"github.com/shopspring/decimal"
+)
+
+func dec(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+// joRates is what saleRates resolves for a Jordanian till without tenant rates.
+var joRates = map[string]tax.Rate{"VAT-JO": {Code: "VAT-JO", Jurisdiction: "JO", Category: tax.Standard, Rate: dec("0.16")}}
+
+func TestPriceSale(t *testing.T) {
+	items := []SaleItem{
+		{ProductID: 1, Quantity: 2, Price: 5, TaxCode: "VAT-JO"},
+		{ProductID: 2, Quantity: 1, Price: 6, Discount: 1, TaxCode: "VAT-JO"},
+		{ProductID: 3, Quantity: 1, Price: 3},
+	}
+	// Line totals before the basket discount are 10, 5 and 3; the 3.60 basket discount is
+	// shared 2.00, 1.00 and the remaining 0.60.
+	lines, total, err := priceSale(items, 3.6, 2, joRates)
+	if err != nil {
+		t.Fatalf("priceSale: %v", err)
+	}
+	if !total.Equal(dec("14.4")) {
+		t.Errorf("total = %s, want 14.40", total)
+	}
+	want := []struct{ discount, total, net, tax string }{
+		{"2", "8", "6.9", "1.1"},
+		{"2", "4", "3.45", "0.55"},
+		{"0.6", "2.4", "2.4", "0"},
+	}
+	for i, w := range want {
+		l := lines[i]
+		if !l.Discount.Equal(dec(w.discount)) || !l.Total.Equal(dec(w.total)) || !l.Net.Equal(dec(w.net)) || !l.Tax.Equal(dec(w.tax)) {
+			t.Errorf("line %d = discount %s total %s net %s tax %s, want %v", i+1, l.Discount, l.Total, l.Net, l.Tax, w)
+		}
+	}
+	if !lines[0].TaxRate.Equal(dec("0.16")) {
+		t.Errorf("tax rate = %s", lines[0].TaxRate)
+	}
+
+	if _, _, err := priceSale([]SaleItem{{Quantity: 1, Price: 5, Discount: 6}}, 0, 2, joRates); !errors.Is(err, ErrSaleRejected) {
+		t.Errorf("discount above the line = %v", err)
+	}
+	if _, _, err := priceSale(items, 50, 2, joRates); !errors.Is(err, ErrSaleRejected) {
+		t.Errorf("basket discount above the total = %v", err)
+	}
+	if _, _, err := priceSale(items, 0, 2, nil); !errors.Is(err, ErrSaleRejected) {
+		t.Errorf("unresolved tax code = %v", err)
+	}
+
+	// In a currency without minor units, 5 off 2, 2, 2 and 1 rounds the first shares down to
+	// 1 each, leaving 2 for a last line of 1: the rest goes on the other lines.
+	small := []SaleItem{{Quantity: 1, Price: 2}, {Quantity: 1, Price: 2}, {Quantity: 1, Price: 2}, {Quantity: 1, Price: 1}}
+	lines, total, err = priceSale(small, 5, 0, nil)
+	if err != nil {
+		t.Fatalf("priceSale: %v", err)
+	}
+	if !total.Equal(dec("2")) {
+		t.Errorf("total = %s, want 2", total)
+	}
+	discount := decimal.Zero
+	for i, l := range lines {
+		if l.Total.IsNegative() {
+			t.Errorf("line %d total = %s", i+1, l.Total)
+		}
+		discount = discount.Add(l.Discount)
+	}
+	if !discount.Equal(dec("5")) {
+		t.Errorf("discounts add up to %s, want 5", discount)
+	}
+}
+
+func TestResolveTenders(t *testing.T) {
+	tenders, err := resolveTenders([]Tender{{Method: "Card", Amount: 10}, {Method: "cash", Amount: 4.4}}, "", dec("14.4"), 2)
+	if err != nil || len(tenders) != 2 || tenders[0].Method != "card" {
+		t.Errorf("split tender = %+v, %v", tenders, err)
+	}
+	if _, err := resolveTenders([]Tender{{Method: "cash", Amount: 10}}, "", dec("14.4"), 2); !errors.Is(err, ErrSaleRejected) {
+		t.Errorf("short payment = %v", err)
+	}
+	if _, err := resolveTenders([]Tender{{Method: "cheque", Amount: 14.4}}, "", dec("14.4"), 2); !errors.Is(err, ErrSaleRejected) {
+		t.Errorf("unknown tender = %v", err)
+	}
+
+	// Sales buffered before split tenders pay everything by their payment method.
+	tenders, err = resolveTenders(nil, "card", dec("14.4"), 2)
+	if err != nil || len(tenders) != 1 || !tenders[0].Amount.Equal(dec("14.4")) {
+		t.Errorf("legacy payment method = %+v, %v", tenders, err)
+	}
+}
+
+func TestReturnShare(t *testing.T) {
+	// 10.00 for 3: returning one at a time gives back 3.33, 3.34 and 3.33.
+	amount, qty, one := dec("10"), dec("3"), dec("1")
+	sum := decimal.Zero
+	for returned := decimal.Zero; returned.LessThan(qty); returned = returned.Add(one) {
+		sum = sum.Add(returnShare(amount, qty, returned, one, 2))
+	}
+	if !sum.Equal(amount) {
+		t.Errorf("returning every unit gives back %s, want %s", sum, amount)
+	}
+	if got := returnShare(amount, qty, decimal.Zero, one, 2); !got.Equal(dec("3.33")) {
+		t.Errorf("first unit = %s", got)
+	}
+}
+
+func TestBuildZReport(t *testing.T) {
+	sale := &ent.Transaction{Type: "sale"}
+	sale.Edges.Tenders = []*ent.PosTender{
+		{Method: "cash", Amount: dec("10")},
+		{Method: "card", Amount: dec("4.4")},
+	}
+	sale.Edges.PosLines = []*ent.PosSaleLine{
+		{Total: dec("8"), Discount: dec("2"), NetAmount: dec("6.9"), TaxCode: "VAT-JO", TaxRate: dec("0.16"), TaxAmount: dec("1.1")},
+		{Total: dec("6.4"), Discount: dec("0"), NetAmount: dec("6.4")},
+	}
+	refund := &ent.Transaction{Type: "refund"}
+	refund.Edges.Tenders = []*ent.PosTender{{Method: "cash", Amount: dec("4")}}
+	refund.Edges.PosLines = []*ent.PosSaleLine{
+		{Total: dec("4"), Discount: dec("1"), NetAmount: dec("3.45"), TaxCode: "VAT-JO", TaxRate: dec("0.16"), TaxAmount: dec("0.55")},
+	}
+
+	closed := &ent.PosShift{Cashier: "Rana", Status: posshift.StatusClosed, OverShort: dec("-0.5")}
+	closed.Edges.Transactions = []*ent.Transaction{sale, refund}
+	open := &ent.PosShift{Cashier: "Omar", Status: posshift.StatusOpen}
+
+	r := buildZReport("2026-10-18", []*ent.PosShift{closed, open})
+	if r.Sales != 1 || r.Refunds != 1 || r.OpenShifts != 1 {
+		t.Errorf("counts = %d sales, %d refunds, %d open", r.Sales, r.Refunds, r.OpenShifts)
+	}
+	if r.GrossSales != 16.4 || r.Discounts != 2 || r.Returns != 4 || r.NetSales != 10.4 {
+		t.Errorf("totals = gross %v discounts %v returns %v net %v", r.GrossSales, r.Discounts, r.Returns, r.NetSales)
+	}
+	if len(r.Tenders) != 2 || r.Tenders[1].Method != "cash" || r.Tenders[1].Net != 6 {
+		t.Errorf("tenders = %+v", r.Tenders)
+	}
+	if len(r.Taxes) != 1 || r.Taxes[0].Tax != 0.55 || r.Taxes[0].Taxable != 3.45 {
+		t.Errorf("taxes = %+v", r.Taxes)
+	}
+	if len(r.Cashiers) != 2 || r.Cashiers[1].Cashier != "Rana" || r.Cashiers[1].Net != 10.4 || r.Cashiers[1].OverShort != -0.5 {
+		t.Errorf("cashiers = %+v", r.Cashiers)
+	}
+	if got := shiftCash(closed.Edges.Transactions); !got.Equal(dec("6")) {
+		t.Errorf("shift cash = %s, want 6", got)
+	}
+}
+
+func TestReceipt(t *testing.T) {
+	items := []SaleItem{
+		{ProductID: 1, Quantity: 2, Price: 5, Discount: 2, TaxCode: "VAT-JO"},
+		{ProductID: 2, Quantity: 1, Price: 6.4},
+	}
+	lines, total, err := priceSale(items, 0, 2, joRates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tenders, err := resolveTenders(nil, "cash", total, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := newReceipt(lines, []string{"Milk 1L", "Bread"}, tenders, 2)
+	r.Seller, r.TaxNumber, r.SaleID = "Corner Shop", "300000000000003", "5b3c1e4a-9f0d-4a59-a7f4-2f6f3f0c1d2e"
+	r.FiscalQR = "AQtDb3JuZXIgU2hvcA=="
+
+	text := r.Text(escpos.Paper58)
+	for _, want := range []string{
+		"Milk 1L\n",
+		"  2 x 5.00                 10.00\n",
+		"  Discount                 -2.00\n",
+		"TOTAL                      14.40\n",
+		"VAT-JO 16% on 6.90          1.10\n",
+		"CASH                       14.40\n",
+		"VAT No. 300000000000003\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("receipt is missing %q:\n%s", want, text)
+		}
+	}
+
+	job := r.ESCPOS(escpos.Paper58)
+	if !bytes.Contains(job, []byte(r.FiscalQR)) {
+		t.Error("printed receipt has no fiscal QR code")
+	}
+	if !bytes.Contains(job, []byte("{B5b3c1e4a9f0d4a59a7f42f6f3f0c1d2e")) {
+		t.Error("printed receipt has no barcode of the sale ID")
+	}
+}
@@ -0,0 +1,250 @@
+package stock
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/einvoice"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/pkg/bridge/peripherals/escpos"
+	"sent/pkg/capital"
+	"sent/pkg/tax"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReceiptPrinter takes ESC/POS jobs. The printers of the peripherals registry are ones; the
+// cash drawer is plugged into the receipt printer and opened through it.
+type ReceiptPrinter interface {
+	Print(data []byte) error
+}
+
+// PrinterLookup finds a configured printer by ID.
+type PrinterLookup func(id string) (ReceiptPrinter, error)
+
+// receiptPaper is the roll the till prints on.
+var receiptPaper = escpos.Paper80
+
+// ReceiptLine is a line of a receipt.
+type ReceiptLine struct {
+	Name      string
+	Quantity  decimal.Decimal
+	UnitPrice decimal.Decimal
+	Discount  decimal.Decimal
+	Total     decimal.Decimal
+}
+
+// Receipt is what the customer is handed for a sale.
+type Receipt struct {
+	Seller    string
+	TaxNumber string
+	Logo      []byte // PNG or JPEG
+	SaleID    string
+	Date      time.Time
+	Cashier   string
+	Places    int32
+	Lines     []ReceiptLine
+	Taxes     []tax.Line
+	Total     decimal.Decimal
+	Tenders   []tenderLine
+	FiscalQR  string // Empty until the sale is recorded
+	Pending   bool   // Buffered offline, not recorded yet
+}
+
+// newReceipt lays out priced lines; names holds the product name of each line.
+func newReceipt(lines []pricedLine, names []string, tenders []tenderLine, places int32) *Receipt {
+	r := &Receipt{Places: places, Tenders: tenders, Total: decimal.Zero}
+	type key struct{ code, rate string }
+	taxes := make(map[key]int)
+	for i, l := range lines {
+		r.Lines = append(r.Lines, ReceiptLine{Name: names[i], Quantity: l.Quantity, UnitPrice: l.UnitPrice, Discount: l.Discount, Total: l.Total})
+		r.Total = r.Total.Add(l.Total)
+		if l.TaxCode == "" {
+			continue
+		}
+		// Taxes are summed as charged on each line so the receipt agrees with the ledger.
+		k := key{l.TaxCode, l.TaxRate.String()}
+		if j, ok := taxes[k]; ok {
+			r.Taxes[j].Taxable = r.Taxes[j].Taxable.Add(l.Net)
+			r.Taxes[j].Tax = r.Taxes[j].Tax.Add(l.Tax)
+			continue
+		}
+		taxes[k] = len(r.Taxes)
+		r.Taxes = append(r.Taxes, tax.Line{Code: l.TaxCode, Rate: l.TaxRate, Taxable: l.Net, Tax: l.Tax})
+	}
+	return r
+}
+
+func (r *Receipt) money(d decimal.Decimal) string {
+	return d.StringFixed(r.Places)
+}
+
+// body is the part of the receipt shared by the printed and on-screen versions.
+func (r *Receipt) body(cols int) []string {
+	rule := strings.Repeat("-", cols)
+	out := []string{
+		escpos.Columns(r.Date.Format("2006-01-02 15:04"), r.Cashier, cols),
+		rule,
+	}
+	for _, l := range r.Lines {
+		out = append(out, l.Name)
+		out = append(out, escpos.Columns(fmt.Sprintf("  %s x %s", l.Quantity.String(), r.money(l.UnitPrice)), r.money(l.Total.Add(l.Discount)), cols))
+		if l.Discount.IsPositive() {
+			out = append(out, escpos.Columns("  Discount", "-"+r.money(l.Discount), cols))
+		}
+	}
+	out = append(out, rule, escpos.Columns("TOTAL", r.money(r.Total), cols))
+	for _, t := range r.Taxes {
+		label := fmt.Sprintf("%s %s%% on %s", t.Code, t.Rate.Shift(2).String(), r.money(t.Taxable))
+		out = append(out, escpos.Columns(label, r.money(t.Tax), cols))
+	}
+	if len(r.Tenders) > 0 {
+		out = append(out, rule)
+		for _, t := range r.Tenders {
+			out = append(out, escpos.Columns(strings.ToUpper(t.Method), r.money(t.Amount), cols))
+		}
+	}
+	out = append(out, rule)
+	if r.Pending {
+		out = append(out, "Offline sale: recorded once the till reconnects")
+	}
+	return out
+}
+
+// header is the seller's name and tax number.
+func (r *Receipt) header() []string {
+	var out []string
+	if r.Seller != "" {
+		out = append(out, r.Seller)
+	}
+	if r.TaxNumber != "" {
+		out = append(out, "VAT No. "+r.TaxNumber)
+	}
+	return out
+}
+
+// Text renders the receipt for the screen.
+func (r *Receipt) Text(paper escpos.Paper) string {
+	lines := append(r.header(), r.body(paper.Columns)...)
+	lines = append(lines, "Sale "+r.SaleID, "--- THANK YOU ---")
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// ESCPOS renders the receipt for a receipt printer: the logo, the receipt, the fiscal QR code
+// and a barcode of the sale ID, which the till scans to look the sale up for a return.
+func (r *Receipt) ESCPOS(paper escpos.Paper) []byte {
+	w := escpos.NewWriter().Align(escpos.AlignCenter)
+	if len(r.Logo) > 0 {
+		if img, _, err := image.Decode(bytes.NewReader(r.Logo)); err == nil {
+			w.Image(img, paper.Dots)
+		}
+	}
+	for i, l := range r.header() {
+		if i == 0 {
+			w.Bold(true).Large(true).Line(l).Large(false).Bold(false)
+			continue
+		}
+		w.Line(l)
+	}
+
+	w.Align(escpos.AlignLeft)
+	for _, l := range r.body(paper.Columns) {
+		w.Line(l)
+	}
+
+	w.Align(escpos.AlignCenter)
+	if r.FiscalQR != "" {
+		w.QR(r.FiscalQR, 6).Feed(1)
+	}
+	if id, err := uuid.Parse(r.SaleID); err == nil {
+		// Without dashes the ID fits 80 mm paper; GetSale takes it either way.
+		w.Code128(strings.ReplaceAll(id.String(), "-", ""), 60).Feed(1)
+	}
+	return w.Line("THANK YOU").Feed(3).Cut().Bytes()
+}
+
+// buildReceipt assembles the receipt of a sale. A recorded sale is printed as recorded, with
+// its fiscal QR code; one still in the offline buffer is priced from the request, as it will
+// be when it syncs.
+func (k *KioskBridge) buildReceipt(req SaleRequest) (*Receipt, error) {
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	var tnt *ent.Tenant
+	places := int32(2)
+	if tnt, err = k.db.Tenant.Get(k.ctx, profile.TenantID); err == nil {
+		places = capital.CurrencyPlaces(tnt.FunctionalCurrency)
+	}
+
+	var r *Receipt
+	sale, err := k.db.Transaction.Query().
+		Where(transaction.UUID(req.UUID), transaction.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithPosLines(func(q *ent.PosSaleLineQuery) { q.WithProduct() }).
+		WithTenders().
+		Only(k.ctx)
+	if err == nil {
+		lines := make([]pricedLine, len(sale.Edges.PosLines))
+		names := make([]string, len(lines))
+		for i, l := range sale.Edges.PosLines {
+			lines[i] = pricedLine{Quantity: l.Quantity, UnitPrice: l.UnitPrice, Discount: l.Discount, Total: l.Total, Net: l.NetAmount, TaxCode: l.TaxCode, TaxRate: l.TaxRate, Tax: l.TaxAmount}
+			names[i] = l.Edges.Product.Name
+		}
+		var tenders []tenderLine
+		for _, t := range sale.Edges.Tenders {
+			tenders = append(tenders, tenderLine{Method: t.Method, Reference: t.Reference, Amount: t.Amount})
+		}
+		r = newReceipt(lines, names, tenders, places)
+		r.Date = sale.Date
+		if e, err := k.db.EInvoice.Query().Where(einvoice.HasTransactionWith(transaction.ID(sale.ID))).Only(k.ctx); err == nil {
+			r.FiscalQR = e.Qr
+		} else if tnt != nil && tnt.TaxNumber != "" {
+			r.FiscalQR = tax.InvoiceQR{Seller: tnt.Name, TaxNumber: tnt.TaxNumber, Issued: sale.Date, Total: sale.TotalAmount, Tax: sale.TaxAmount}.Encode()
+		}
+	} else {
+		items := make([]SaleItem, len(req.Items))
+		names := make([]string, len(req.Items))
+		for i, it := range req.Items {
+			if it.TaxCode == "" {
+				it.TaxCode = k.taxCode
+			}
+			items[i] = it
+			names[i] = it.Name
+			if names[i] == "" {
+				names[i] = fmt.Sprintf("Item %d", it.ProductID)
+			}
+		}
+		soldAt := req.SoldAt
+		if soldAt.IsZero() {
+			soldAt = time.Now()
+		}
+		rates, err := k.saleRates(k.db, profile.TenantID, items, soldAt)
+		if err != nil {
+			return nil, err
+		}
+		lines, total, err := priceSale(items, req.Discount, places, rates)
+		if err != nil {
+			return nil, err
+		}
+		tenders, _ := resolveTenders(req.Tenders, req.PaymentMethod, total, places)
+		r = newReceipt(lines, names, tenders, places)
+		r.Date = soldAt
+		r.Pending = true
+	}
+
+	r.SaleID = req.UUID
+	r.Cashier = profile.Name
+	if tnt != nil {
+		r.Seller, r.TaxNumber, r.Logo = tnt.Name, tnt.TaxNumber, tnt.Logo
+	}
+	return r, nil
+}
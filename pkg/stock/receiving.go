@@ -0,0 +1,529 @@
+package stock
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/goodsreceipt"
+	"sent/ent/purchaseorder"
+	"sent/ent/purchaseorderline"
+	"sent/ent/schema"
+	"sent/ent/stockmovement"
+	"sent/ent/tenant"
+	"sent/ent/warehouse"
+	"sent/pkg/capital"
+
+	"github.com/shopspring/decimal"
+)
+
+// Landed cost allocation methods.
+const (
+	AllocateByValue  = "value"
+	AllocateByWeight = "weight"
+)
+
+// ReceiptLineRequest is the quantity of one PO line arriving in a shipment.
+type ReceiptLineRequest struct {
+	PurchaseOrderLineID int        `json:"purchaseOrderLineID"`
+	Quantity            int        `json:"quantity"`
+	Weight              float64    `json:"weight"`     // Total kg; zero means the product weight times quantity
+	Bin                 string     `json:"bin"`        // Put-away bin in the receiving warehouse; empty for unassigned
+	LotNumber           string     `json:"lotNumber"`  // Required for lot-tracked products
+	ExpiryDate          *time.Time `json:"expiryDate"` // Last day the lot may be sold
+}
+
+// GoodsReceiptRequest records one shipment against a purchase order.
+type GoodsReceiptRequest struct {
+	PurchaseOrderID  int                  `json:"purchaseOrderID"`
+	WarehouseID      int                  `json:"warehouseID"`
+	Reference        string               `json:"reference"`
+	Notes            string               `json:"notes"`
+	ReceivedAt       time.Time            `json:"receivedAt"` // Zero means now
+	Freight          float64              `json:"freight"`
+	Duty             float64              `json:"duty"`
+	Insurance        float64              `json:"insurance"`
+	AllocationMethod string               `json:"allocationMethod"` // value (default) or weight
+	Lines            []ReceiptLineRequest `json:"lines"`
+}
+
+// GoodsReceiptLineDTO represents a received line with its landed cost.
+type GoodsReceiptLineDTO struct {
+	ID             int     `json:"id"`
+	ProductName    string  `json:"productName"`
+	Quantity       int     `json:"quantity"`
+	UnitCost       float64 `json:"unitCost"`
+	LandedCost     float64 `json:"landedCost"`
+	LandedUnitCost float64 `json:"landedUnitCost"`
+}
+
+// GoodsReceiptDTO represents a goods receipt document.
+type GoodsReceiptDTO struct {
+	ID               int                   `json:"id"`
+	Number           string                `json:"number"`
+	PONumber         string                `json:"poNumber"`
+	WarehouseName    string                `json:"warehouseName"`
+	Reference        string                `json:"reference"`
+	ReceivedAt       time.Time             `json:"receivedAt"`
+	ReceivedBy       string                `json:"receivedBy"`
+	Freight          float64               `json:"freight"`
+	Duty             float64               `json:"duty"`
+	Insurance        float64               `json:"insurance"`
+	AllocationMethod string                `json:"allocationMethod"`
+	Lines            []GoodsReceiptLineDTO `json:"lines"`
+}
+
+// WarehouseDTO represents a warehouse.
+type WarehouseDTO struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	LocationCode string `json:"locationCode"`
+	Address      string `json:"address"`
+	IsActive     bool   `json:"isActive"`
+}
+
+// allocateLandedCost splits amount over lines in proportion to their bases, to 4 decimal places.
+// The rounding remainder goes to the line with the largest base so the shares add up exactly.
+func allocateLandedCost(bases []decimal.Decimal, amount decimal.Decimal) ([]decimal.Decimal, error) {
+	shares := make([]decimal.Decimal, len(bases))
+	if amount.IsZero() || len(bases) == 0 {
+		return shares, nil
+	}
+	total := decimal.Zero
+	largest := 0
+	for i, b := range bases {
+		if b.IsNegative() {
+			return nil, fmt.Errorf("allocation base cannot be negative")
+		}
+		total = total.Add(b)
+		if b.GreaterThan(bases[largest]) {
+			largest = i
+		}
+	}
+	if total.IsZero() {
+		return nil, fmt.Errorf("cannot allocate %s over lines with no value or weight", amount.StringFixed(2))
+	}
+
+	allocated := decimal.Zero
+	for i, b := range bases {
+		shares[i] = amount.Mul(b).Div(total).Round(4)
+		allocated = allocated.Add(shares[i])
+	}
+	shares[largest] = shares[largest].Add(amount.Sub(allocated))
+	return shares, nil
+}
+
+// lineStatus derives a PO line's receiving status. A line closed short stays closed.
+func lineStatus(ordered, received int, current purchaseorderline.Status) purchaseorderline.Status {
+	switch {
+	case current == purchaseorderline.StatusClosed || received >= ordered:
+		return purchaseorderline.StatusClosed
+	case received > 0:
+		return purchaseorderline.StatusPartiallyReceived
+	}
+	return purchaseorderline.StatusOpen
+}
+
+// orderStatus derives the PO status from its lines: received once every line is closed.
+func orderStatus(lines []*ent.PurchaseOrderLine, current purchaseorder.Status) purchaseorder.Status {
+	closed, touched := 0, false
+	for _, l := range lines {
+		if l.Status == purchaseorderline.StatusClosed {
+			closed++
+		}
+		if l.ReceivedQty > 0 {
+			touched = true
+		}
+	}
+	switch {
+	case len(lines) > 0 && closed == len(lines):
+		return purchaseorder.StatusReceived
+	case touched:
+		return purchaseorder.StatusPartiallyReceived
+	}
+	return current
+}
+
+// ReceiveGoods books one shipment against a purchase order into a warehouse. Each received line
+// becomes a FIFO batch whose unit cost includes its share of freight, duty and insurance.
+func (s *StockBridge) ReceiveGoods(req GoodsReceiptRequest) (*GoodsReceiptDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	po, err := s.db.PurchaseOrder.Query().
+		Where(purchaseorder.ID(req.PurchaseOrderID), purchaseorder.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithLines(func(q *ent.PurchaseOrderLineQuery) {
+			q.WithProduct()
+		}).
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("purchase order %d not found: %w", req.PurchaseOrderID, err)
+	}
+	if po.Status == purchaseorder.StatusReceived || po.Status == purchaseorder.StatusCancelled || po.Status == purchaseorder.StatusDraft {
+		return nil, fmt.Errorf("PO %s is %s", po.PoNumber, po.Status)
+	}
+
+	wh, err := s.db.Warehouse.Query().
+		Where(warehouse.ID(req.WarehouseID), warehouse.HasTenantWith(tenant.ID(profile.TenantID)), warehouse.IsActive(true)).
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("warehouse %d not found: %w", req.WarehouseID, err)
+	}
+
+	method := goodsreceipt.AllocationMethod(req.AllocationMethod)
+	if method == "" {
+		method = goodsreceipt.AllocationMethodValue
+	}
+	if err := goodsreceipt.AllocationMethodValidator(method); err != nil {
+		return nil, err
+	}
+	freight, duty, insurance := decimal.NewFromFloat(req.Freight), decimal.NewFromFloat(req.Duty), decimal.NewFromFloat(req.Insurance)
+	if freight.IsNegative() || duty.IsNegative() || insurance.IsNegative() {
+		return nil, fmt.Errorf("landed costs cannot be negative")
+	}
+	landed := freight.Add(duty).Add(insurance)
+
+	poLines := make(map[int]*ent.PurchaseOrderLine, len(po.Edges.Lines))
+	for _, l := range po.Edges.Lines {
+		poLines[l.ID] = l
+	}
+	if len(req.Lines) == 0 {
+		return nil, fmt.Errorf("receipt has no lines")
+	}
+	seen := make(map[int]bool)
+	bases := make([]decimal.Decimal, len(req.Lines))
+	weights := make([]decimal.Decimal, len(req.Lines))
+	for i, rl := range req.Lines {
+		l, ok := poLines[rl.PurchaseOrderLineID]
+		if !ok {
+			return nil, fmt.Errorf("line %d is not on PO %s", rl.PurchaseOrderLineID, po.PoNumber)
+		}
+		if seen[l.ID] {
+			return nil, fmt.Errorf("line %d is listed twice", l.ID)
+		}
+		seen[l.ID] = true
+		if l.Status == purchaseorderline.StatusClosed {
+			return nil, fmt.Errorf("%s is closed on PO %s", l.Edges.Product.Name, po.PoNumber)
+		}
+		if l.Edges.Product.TrackLots && strings.TrimSpace(rl.LotNumber) == "" {
+			return nil, fmt.Errorf("%s is lot-tracked; enter the lot number", l.Edges.Product.Name)
+		}
+		if rl.Quantity <= 0 || rl.Quantity > l.Quantity-l.ReceivedQty {
+			return nil, fmt.Errorf("%s: quantity %d exceeds the %d still open", l.Edges.Product.Name, rl.Quantity, l.Quantity-l.ReceivedQty)
+		}
+
+		qty := decimal.NewFromInt(int64(rl.Quantity))
+		weights[i] = decimal.NewFromFloat(rl.Weight)
+		if weights[i].IsZero() {
+			weights[i] = l.Edges.Product.Weight.Mul(qty)
+		}
+		if method == goodsreceipt.AllocationMethodWeight {
+			bases[i] = weights[i]
+		} else {
+			bases[i] = l.UnitCost.Mul(qty)
+		}
+	}
+	shares, err := allocateLandedCost(bases, landed)
+	if err != nil {
+		return nil, err
+	}
+
+	receivedAt := req.ReceivedAt
+	if receivedAt.IsZero() {
+		receivedAt = time.Now()
+	}
+	// Receipts value inventory on the receipt date, so they are subject to the period lock.
+	if err := capital.EnsurePostingAllowed(s.ctx, s.db.FiscalPeriod, profile.TenantID, receivedAt, false); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.GoodsReceipt.Query().Where(goodsreceipt.HasTenantWith(tenant.ID(profile.TenantID))).Count(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := tx.GoodsReceipt.Create().
+		SetTenantID(profile.TenantID).
+		SetPurchaseOrder(po).
+		SetWarehouse(wh).
+		SetNumber(fmt.Sprintf("GRN-%05d", n+1)).
+		SetReference(strings.TrimSpace(req.Reference)).
+		SetReceivedAt(receivedAt).
+		SetReceivedBy(profile.Name).
+		SetFreight(freight).
+		SetDuty(duty).
+		SetInsurance(insurance).
+		SetAllocationMethod(method).
+		SetNotes(req.Notes).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save goods receipt: %w", err)
+	}
+
+	goods := decimal.Zero
+	for i, rl := range req.Lines {
+		l := poLines[rl.PurchaseOrderLineID]
+		qty := decimal.NewFromInt(int64(rl.Quantity))
+		value := l.UnitCost.Mul(qty)
+		goods = goods.Add(value)
+		landedUnit := value.Add(shares[i]).Div(qty).Round(4)
+
+		// Seed any pre-warehouse stock first so it is not mistaken for part of this receipt.
+		if err := seedLegacyLevel(s.ctx, tx, profile.TenantID, l.Edges.Product); err != nil {
+			return nil, err
+		}
+		put := schema.StockTake{Bin: rl.Bin, LotNumber: rl.LotNumber, ExpiryDate: rl.ExpiryDate, Quantity: qty}
+		if err := addStock(s.ctx, tx, profile.TenantID, l.Edges.Product.ID, wh.ID, put); err != nil {
+			return nil, fmt.Errorf("failed to update stock level: %w", err)
+		}
+		newQty := l.Edges.Product.Quantity.Add(qty)
+		if err := tx.Product.UpdateOne(l.Edges.Product).SetQuantity(newQty).Exec(s.ctx); err != nil {
+			return nil, err
+		}
+		l.Edges.Product.Quantity = newQty
+
+		mv, err := tx.StockMovement.Create().
+			SetTenantID(profile.TenantID).
+			SetProduct(l.Edges.Product).
+			SetQuantity(qty).
+			SetMovementType(stockmovement.MovementTypeIncoming).
+			SetReason(fmt.Sprintf("PO Receipt: %s (%s)", po.PoNumber, receipt.Number)).
+			SetUnitCost(landedUnit).
+			SetRemainingQuantity(qty).
+			SetWarehouseID(wh.ID).
+			SetBin(rl.Bin).
+			SetLotNumber(rl.LotNumber).
+			SetNillableExpiryDate(rl.ExpiryDate).
+			SetMetadata(map[string]interface{}{
+				"purchase_order_id":      po.ID,
+				"purchase_order_line_id": l.ID,
+				"goods_receipt_id":       receipt.ID,
+				"warehouse_id":           wh.ID,
+			}).
+			Save(s.ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = tx.GoodsReceiptLine.Create().
+			SetReceipt(receipt).
+			SetPurchaseOrderLine(l).
+			SetQuantity(rl.Quantity).
+			SetUnitCost(l.UnitCost).
+			SetWeight(weights[i]).
+			SetLandedCost(shares[i]).
+			SetLandedUnitCost(landedUnit).
+			SetStockMovement(mv).
+			Exec(s.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save receipt line: %w", err)
+		}
+
+		l.ReceivedQty += rl.Quantity
+		l.Status = lineStatus(l.Quantity, l.ReceivedQty, l.Status)
+		err = tx.PurchaseOrderLine.UpdateOne(l).
+			SetReceivedQty(l.ReceivedQty).
+			SetStatus(l.Status).
+			Exec(s.ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txn, err := capital.PostGoodsReceipt(s.ctx, tx, profile.TenantID, receivedAt, receipt.Number, goods, landed)
+	if err != nil {
+		return nil, fmt.Errorf("receipt not posted: %w", err)
+	}
+	if err := tx.GoodsReceipt.UpdateOne(receipt).SetTransaction(txn).Exec(s.ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.PurchaseOrder.UpdateOne(po).SetStatus(orderStatus(po.Edges.Lines, po.Status)).Exec(s.ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.logAudit("receive_goods", "purchase_order", po.ID, map[string]interface{}{
+		"receipt":   receipt.Number,
+		"warehouse": wh.Name,
+		"lines":     len(req.Lines),
+		"landed":    landed.String(),
+	})
+	return s.goodsReceiptDTO(receipt.ID)
+}
+
+// CloseOrderLine closes a PO line short when the supplier will not deliver the rest.
+func (s *StockBridge) CloseOrderLine(lineID int) error {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+
+	l, err := s.db.PurchaseOrderLine.Query().
+		Where(
+			purchaseorderline.ID(lineID),
+			purchaseorderline.HasPurchaseOrderWith(purchaseorder.HasTenantWith(tenant.ID(profile.TenantID))),
+		).
+		WithPurchaseOrder(func(q *ent.PurchaseOrderQuery) { q.WithLines() }).
+		Only(s.ctx)
+	if err != nil {
+		return fmt.Errorf("PO line %d not found: %w", lineID, err)
+	}
+	if l.Status == purchaseorderline.StatusClosed {
+		return nil
+	}
+
+	tx, err := s.db.Tx(s.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.PurchaseOrderLine.UpdateOne(l).SetStatus(purchaseorderline.StatusClosed).Exec(s.ctx); err != nil {
+		return err
+	}
+	po := l.Edges.PurchaseOrder
+	for _, pl := range po.Edges.Lines {
+		if pl.ID == l.ID {
+			pl.Status = purchaseorderline.StatusClosed
+		}
+	}
+	if err := tx.PurchaseOrder.UpdateOne(po).SetStatus(orderStatus(po.Edges.Lines, po.Status)).Exec(s.ctx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.logAudit("close_po_line", "purchase_order", po.ID, map[string]interface{}{
+		"line":     l.ID,
+		"ordered":  l.Quantity,
+		"received": l.ReceivedQty,
+	})
+	return nil
+}
+
+// GetGoodsReceipts lists the receipts booked against a purchase order.
+func (s *StockBridge) GetGoodsReceipts(poID int) ([]GoodsReceiptDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.db.GoodsReceipt.Query().
+		Where(
+			goodsreceipt.HasTenantWith(tenant.ID(profile.TenantID)),
+			goodsreceipt.HasPurchaseOrderWith(purchaseorder.ID(poID)),
+		).
+		Order(ent.Asc(goodsreceipt.FieldReceivedAt)).
+		IDs(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]GoodsReceiptDTO, 0, len(ids))
+	for _, id := range ids {
+		dto, err := s.goodsReceiptDTO(id)
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, *dto)
+	}
+	return dtos, nil
+}
+
+func (s *StockBridge) goodsReceiptDTO(id int) (*GoodsReceiptDTO, error) {
+	r, err := s.db.GoodsReceipt.Query().
+		Where(goodsreceipt.ID(id)).
+		WithPurchaseOrder().
+		WithWarehouse().
+		WithLines(func(q *ent.GoodsReceiptLineQuery) {
+			q.WithPurchaseOrderLine(func(lq *ent.PurchaseOrderLineQuery) { lq.WithProduct() })
+		}).
+		Only(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &GoodsReceiptDTO{
+		ID:               r.ID,
+		Number:           r.Number,
+		PONumber:         r.Edges.PurchaseOrder.PoNumber,
+		WarehouseName:    r.Edges.Warehouse.Name,
+		Reference:        r.Reference,
+		ReceivedAt:       r.ReceivedAt,
+		ReceivedBy:       r.ReceivedBy,
+		Freight:          r.Freight.InexactFloat64(),
+		Duty:             r.Duty.InexactFloat64(),
+		Insurance:        r.Insurance.InexactFloat64(),
+		AllocationMethod: string(r.AllocationMethod),
+	}
+	for _, l := range r.Edges.Lines {
+		dto.Lines = append(dto.Lines, GoodsReceiptLineDTO{
+			ID:             l.ID,
+			ProductName:    l.Edges.PurchaseOrderLine.Edges.Product.Name,
+			Quantity:       l.Quantity,
+			UnitCost:       l.UnitCost.InexactFloat64(),
+			LandedCost:     l.LandedCost.InexactFloat64(),
+			LandedUnitCost: l.LandedUnitCost.InexactFloat64(),
+		})
+	}
+	return dto, nil
+}
+
+// GetWarehouses lists the tenant's warehouses.
+func (s *StockBridge) GetWarehouses() ([]WarehouseDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	whs, err := s.db.Warehouse.Query().
+		Where(warehouse.HasTenantWith(tenant.ID(profile.TenantID))).
+		Order(ent.Asc(warehouse.FieldLocationCode)).
+		All(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]WarehouseDTO, len(whs))
+	for i, w := range whs {
+		dtos[i] = WarehouseDTO{
+			ID:           w.ID,
+			Name:         w.Name,
+			LocationCode: w.LocationCode,
+			Address:      w.Address,
+			IsActive:     w.IsActive,
+		}
+	}
+	return dtos, nil
+}
+
+// CreateWarehouse adds a warehouse that goods can be received into.
+func (s *StockBridge) CreateWarehouse(name, locationCode, address string) (int, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return 0, err
+	}
+
+	w, err := s.db.Warehouse.Create().
+		SetTenantID(profile.TenantID).
+		SetName(name).
+		SetLocationCode(strings.ToUpper(strings.TrimSpace(locationCode))).
+		SetAddress(address).
+		Save(s.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create warehouse: %w", err)
+	}
+	s.logAudit("create_warehouse", "warehouse", w.ID, map[string]interface{}{"name": name, "code": w.LocationCode})
+	return w.ID, nil
+}
@@ -0,0 +1,76 @@
+package stock
+
+import (
+	"testing"
+
+	"sent/ent"
+	"sent/ent/purchaseorder"
+	"sent/ent/purchaseorderline"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAllocateLandedCost(t *testing.T) {
+	bases := []decimal.Decimal{decimal.NewFromInt(100), decimal.NewFromInt(200), decimal.NewFromInt(0)}
+	shares, err := allocateLandedCost(bases, decimal.NewFromInt(30))
+	if err != nil {
+		t.Fatalf("allocateLandedCost: %v", err)
+	}
+	if !shares[0].Equal(decimal.NewFromInt(10)) || !shares[1].Equal(decimal.NewFromInt(20)) || !shares[2].IsZero() {
+		t.Errorf("shares = %v", shares)
+	}
+
+	// Thirds do not divide evenly; the remainder lands on the first largest line and the total holds.
+	thirds := []decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.NewFromInt(1)}
+	shares, err = allocateLandedCost(thirds, decimal.NewFromInt(1))
+	if err != nil {
+		t.Fatalf("allocateLandedCost: %v", err)
+	}
+	sum := decimal.Zero
+	for _, s := range shares {
+		sum = sum.Add(s)
+	}
+	if !sum.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("shares add up to %s", sum)
+	}
+	if !shares[0].Equal(decimal.RequireFromString("0.3334")) || !shares[2].Equal(decimal.RequireFromString("0.3333")) {
+		t.Errorf("shares = %v", shares)
+	}
+
+	if _, err := allocateLandedCost([]decimal.Decimal{decimal.Zero}, decimal.NewFromInt(5)); err == nil {
+		t.Error("expected an error allocating by weight with no weights")
+	}
+	if shares, err := allocateLandedCost([]decimal.Decimal{decimal.Zero}, decimal.Zero); err != nil || !shares[0].IsZero() {
+		t.Errorf("nothing to allocate should succeed, got %v %v", shares, err)
+	}
+}
+
+func TestReceivingStatus(t *testing.T) {
+	if s := lineStatus(10, 0, purchaseorderline.StatusOpen); s != purchaseorderline.StatusOpen {
+		t.Errorf("unreceived line = %s", s)
+	}
+	if s := lineStatus(10, 4, purchaseorderline.StatusOpen); s != purchaseorderline.StatusPartiallyReceived {
+		t.Errorf("partial line = %s", s)
+	}
+	if s := lineStatus(10, 10, purchaseorderline.StatusPartiallyReceived); s != purchaseorderline.StatusClosed {
+		t.Errorf("full line = %s", s)
+	}
+	if s := lineStatus(10, 4, purchaseorderline.StatusClosed); s != purchaseorderline.StatusClosed {
+		t.Errorf("line closed short reopened as %s", s)
+	}
+
+	lines := []*ent.PurchaseOrderLine{
+		{Quantity: 10, ReceivedQty: 10, Status: purchaseorderline.StatusClosed},
+		{Quantity: 5, ReceivedQty: 0, Status: purchaseorderline.StatusOpen},
+	}
+	if s := orderStatus(lines, purchaseorder.StatusSubmitted); s != purchaseorder.StatusPartiallyReceived {
+		t.Errorf("order with one line received = %s", s)
+	}
+	lines[1].Status = purchaseorderline.StatusClosed
+	if s := orderStatus(lines, purchaseorder.StatusPartiallyReceived); s != purchaseorder.StatusReceived {
+		t.Errorf("order with every line closed = %s", s)
+	}
+	if s := orderStatus(lines[1:], purchaseorder.StatusSubmitted); s != purchaseorder.StatusReceived {
+		t.Errorf("order closed short without receipts = %s", s)
+	}
+}
@@ -0,0 +1,367 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"sent/ent"
+	"sent/ent/product"
+	"sent/ent/purchaseorder"
+	"sent/ent/purchaseorderline"
+	"sent/ent/stockalert"
+	"sent/ent/stockmovement"
+	"sent/ent/tenant"
+
+	"github.com/riverqueue/river"
+	"github.com/shopspring/decimal"
+)
+
+// reorderWindowDays is how far back sales are averaged into a daily velocity.
+const reorderWindowDays = 30
+
+// reorderPoint is the stock needed to cover sales over the supplier's lead time, plus the
+// product's minimum level as safety stock.
+func reorderPoint(sold decimal.Decimal, windowDays, leadDays, minLevel int) int {
+	if windowDays <= 0 {
+		return minLevel
+	}
+	velocity := sold.Div(decimal.NewFromInt(int64(windowDays)))
+	return int(velocity.Mul(decimal.NewFromInt(int64(leadDays))).Ceil().IntPart()) + minLevel
+}
+
+// orderQuantity is what to order when stock plus open orders fall below the reorder point: enough
+// to reach the max level, or the reorder point when no max level is set.
+func orderQuantity(position decimal.Decimal, rop, maxLevel int) int {
+	if !position.LessThan(decimal.NewFromInt(int64(rop))) {
+		return 0
+	}
+	target := maxLevel
+	if target < rop {
+		target = rop
+	}
+	return int(decimal.NewFromInt(int64(target)).Sub(position).Ceil().IntPart())
+}
+
+// RunReorder drafts one purchase order per preferred supplier for the tenant's products that
+// fell below their reorder point. Products without a supplier get a low-stock alert instead.
+// It returns the IDs of the drafted orders.
+func RunReorder(ctx context.Context, db *ent.Client, tenantID int, now time.Time) ([]int, error) {
+	products, err := db.Product.Query().
+		Where(
+			product.HasTenantWith(tenant.ID(tenantID)),
+			product.IsDisposed(false),
+			product.IsVariantParent(false),
+		).
+		WithSupplier().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sold := make(map[int]decimal.Decimal)
+	moves, err := db.StockMovement.Query().
+		Where(
+			stockmovement.HasTenantWith(tenant.ID(tenantID)),
+			stockmovement.MovementTypeEQ(stockmovement.MovementTypeOutgoing),
+			stockmovement.Not(stockmovement.HasTransfer()),
+			stockmovement.CreatedAtGTE(now.AddDate(0, 0, -reorderWindowDays)),
+		).
+		WithProduct().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range moves {
+		sold[m.Edges.Product.ID] = sold[m.Edges.Product.ID].Add(m.Quantity)
+	}
+
+	onOrder := make(map[int]decimal.Decimal)
+	open, err := db.PurchaseOrderLine.Query().
+		Where(
+			purchaseorderline.HasPurchaseOrderWith(
+				purchaseorder.HasTenantWith(tenant.ID(tenantID)),
+				purchaseorder.StatusIn(purchaseorder.StatusDraft, purchaseorder.StatusSubmitted, purchaseorder.StatusPartiallyReceived),
+			),
+			purchaseorderline.StatusNEQ(purchaseorderline.StatusClosed),
+		).
+		WithProduct().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range open {
+		onOrder[l.Edges.Product.ID] = onOrder[l.Edges.Product.ID].Add(decimal.NewFromInt(int64(l.Quantity - l.ReceivedQty)))
+	}
+
+	type shortfall struct {
+		product  *ent.Product
+		quantity int
+	}
+	bySupplier := make(map[int][]shortfall)
+	suppliers := make(map[int]*ent.Supplier)
+	for _, p := range products {
+		lead := 0
+		if p.Edges.Supplier != nil {
+			lead = p.Edges.Supplier.LeadTimeDays
+		}
+		rop := reorderPoint(sold[p.ID], reorderWindowDays, lead, p.MinStockLevel)
+		if rop != p.ReorderPoint {
+			if err := db.Product.UpdateOne(p).SetReorderPoint(rop).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		qty := orderQuantity(p.Quantity.Add(onOrder[p.ID]), rop, p.MaxStockLevel)
+		if qty <= 0 {
+			continue
+		}
+		if p.Edges.Supplier == nil {
+			if err := raiseLowStock(ctx, db, tenantID, p, rop); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		sup := p.Edges.Supplier
+		suppliers[sup.ID] = sup
+		bySupplier[sup.ID] = append(bySupplier[sup.ID], shortfall{p, qty})
+	}
+
+	supplierIDs := make([]int, 0, len(bySupplier))
+	for id := range bySupplier {
+		supplierIDs = append(supplierIDs, id)
+	}
+	sort.Slice(supplierIDs, func(i, j int) bool { return suppliers[supplierIDs[i]].Name < suppliers[supplierIDs[j]].Name })
+
+	var drafted []int
+	for _, supID := range supplierIDs {
+		sup := suppliers[supID]
+		lines := bySupplier[supID]
+
+		tx, err := db.Tx(ctx)
+		if err != nil {
+			return drafted, err
+		}
+		po, err := tx.PurchaseOrder.Create().
+			SetTenantID(tenantID).
+			SetSupplier(sup).
+			SetPoNumber(fmt.Sprintf("PO-%d-%d", now.Unix(), sup.ID)).
+			SetStatus(purchaseorder.StatusDraft).
+			SetOrderDate(now).
+			SetExpectedDate(now.AddDate(0, 0, sup.LeadTimeDays)).
+			SetAutoGenerated(true).
+			SetNotes(fmt.Sprintf("Drafted by the reorder job from %d-day sales velocity and a %d-day lead time.", reorderWindowDays, sup.LeadTimeDays)).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return drafted, fmt.Errorf("failed to draft PO for %s: %w", sup.Name, err)
+		}
+		total := decimal.Zero
+		for _, l := range lines {
+			err := tx.PurchaseOrderLine.Create().
+				SetPurchaseOrder(po).
+				SetProduct(l.product).
+				SetQuantity(l.quantity).
+				SetUnitCost(l.product.UnitCost).
+				Exec(ctx)
+			if err != nil {
+				tx.Rollback()
+				return drafted, err
+			}
+			total = total.Add(l.product.UnitCost.Mul(decimal.NewFromInt(int64(l.quantity))))
+		}
+		if err := tx.PurchaseOrder.UpdateOne(po).SetTotalAmount(total).Exec(ctx); err != nil {
+			tx.Rollback()
+			return drafted, err
+		}
+		if err := tx.Commit(); err != nil {
+			return drafted, err
+		}
+		drafted = append(drafted, po.ID)
+	}
+	return drafted, nil
+}
+
+// raiseLowStock alerts about a product that needs reordering but has no supplier to order from.
+func raiseLowStock(ctx context.Context, db *ent.Client, tenantID int, p *ent.Product, rop int) error {
+	exists, err := db.StockAlert.Query().
+		Where(
+			stockalert.HasTenantWith(tenant.ID(tenantID)),
+			stockalert.HasProductWith(product.ID(p.ID)),
+			stockalert.AlertTypeEQ(stockalert.AlertTypeLowStock),
+			stockalert.IsRead(false),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	return db.StockAlert.Create().
+		SetTenantID(tenantID).
+		SetProduct(p).
+		SetAlertType(stockalert.AlertTypeLowStock).
+		SetMessage(fmt.Sprintf("%s is below its reorder point of %d (%s on hand) and has no supplier to reorder from", p.Name, rop, p.Quantity.String())).
+		Exec(ctx)
+}
+
+// ReorderArgs runs the reorder job for one tenant, or every active tenant when TenantID is zero.
+type ReorderArgs struct {
+	TenantID int `json:"tenant_id"`
+}
+
+func (ReorderArgs) Kind() string { return "stock.reorder" }
+
+// ReorderWorker drafts replenishment purchase orders from River.
+type ReorderWorker struct {
+	river.WorkerDefaults[ReorderArgs]
+	db *ent.Client
+}
+
+func NewReorderWorker(db *ent.Client) *ReorderWorker {
+	return &ReorderWorker{db: db}
+}
+
+func (w *ReorderWorker) Work(ctx context.Context, job *river.Job[ReorderArgs]) error {
+	tenantIDs := []int{job.Args.TenantID}
+	if job.Args.TenantID == 0 {
+		ids, err := w.db.Tenant.Query().Where(tenant.Active(true)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		tenantIDs = ids
+	}
+
+	var failed []error
+	for _, id := range tenantIDs {
+		drafted, err := RunReorder(ctx, w.db, id, time.Now())
+		if err != nil {
+			failed = append(failed, fmt.Errorf("tenant %d: %w", id, err))
+		}
+		if len(drafted) > 0 {
+			fmt.Printf("[STOCK] Reorder for tenant %d drafted %d purchase orders\n", id, len(drafted))
+		}
+	}
+	return errors.Join(failed...)
+}
+
+// NightlySchedule fires at 02:00 every day, after the day's sales are in.
+type NightlySchedule struct{}
+
+func (NightlySchedule) Next(current time.Time) time.Time {
+	y, m, d := current.Date()
+	next := time.Date(y, m, d, 2, 0, 0, 0, current.Location())
+	if !next.After(current) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// RunReorder runs the reorder job for the current tenant now instead of waiting for the night.
+func (s *StockBridge) RunReorder() ([]int, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	drafted, err := RunReorder(s.ctx, s.db, profile.TenantID, time.Now())
+	if err != nil {
+		return drafted, err
+	}
+	s.logAudit("run_reorder", "purchase_order", 0, map[string]interface{}{"drafted": drafted})
+	return drafted, nil
+}
+
+// UpdateDraftOrderLine changes the quantity on a draft PO line; zero removes the line.
+func (s *StockBridge) UpdateDraftOrderLine(lineID, quantity int) error {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if quantity < 0 {
+		return fmt.Errorf("quantity cannot be negative")
+	}
+
+	l, err := s.db.PurchaseOrderLine.Query().
+		Where(
+			purchaseorderline.ID(lineID),
+			purchaseorderline.HasPurchaseOrderWith(purchaseorder.HasTenantWith(tenant.ID(profile.TenantID))),
+		).
+		WithPurchaseOrder().
+		Only(s.ctx)
+	if err != nil {
+		return fmt.Errorf("purchase order line %d not found: %w", lineID, err)
+	}
+	po := l.Edges.PurchaseOrder
+	if po.Status != purchaseorder.StatusDraft {
+		return fmt.Errorf("PO %s is %s; only drafts can be edited", po.PoNumber, po.Status)
+	}
+
+	tx, err := s.db.Tx(s.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if quantity == 0 {
+		err = tx.PurchaseOrderLine.DeleteOne(l).Exec(s.ctx)
+	} else {
+		err = tx.PurchaseOrderLine.UpdateOne(l).SetQuantity(quantity).Exec(s.ctx)
+	}
+	if err != nil {
+		return err
+	}
+	lines, err := tx.PurchaseOrderLine.Query().
+		Where(purchaseorderline.HasPurchaseOrderWith(purchaseorder.ID(po.ID))).
+		All(s.ctx)
+	if err != nil {
+		return err
+	}
+	total := decimal.Zero
+	for _, pl := range lines {
+		total = total.Add(pl.UnitCost.Mul(decimal.NewFromInt(int64(pl.Quantity))))
+	}
+	if err := tx.PurchaseOrder.UpdateOneID(po.ID).SetTotalAmount(total).Exec(s.ctx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.logAudit("update_draft_po_line", "purchase_order", po.ID, map[string]interface{}{"line": lineID, "quantity": quantity})
+	return nil
+}
+
+// SubmitPurchaseOrder sends a reviewed draft PO to the supplier.
+func (s *StockBridge) SubmitPurchaseOrder(poID int) error {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+
+	po, err := s.db.PurchaseOrder.Query().
+		Where(purchaseorder.ID(poID), purchaseorder.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithLines().
+		Only(s.ctx)
+	if err != nil {
+		return fmt.Errorf("purchase order %d not found: %w", poID, err)
+	}
+	if po.Status != purchaseorder.StatusDraft {
+		return fmt.Errorf("PO %s is %s", po.PoNumber, po.Status)
+	}
+	if len(po.Edges.Lines) == 0 {
+		return fmt.Errorf("PO %s has no lines", po.PoNumber)
+	}
+	err = s.db.PurchaseOrder.UpdateOne(po).
+		SetStatus(purchaseorder.StatusSubmitted).
+		SetOrderDate(time.Now()).
+		Exec(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	s.logAudit("submit_purchase_order", "purchase_order", po.ID, map[string]interface{}{
+		"number":         po.PoNumber,
+		"auto_generated": po.AutoGenerated,
+	})
+	return nil
+}
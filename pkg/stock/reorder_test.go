@@ -0,0 +1,42 @@
+package stock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestReorderPoint(t *testing.T) {
+	// 45 sold in 30 days is 1.5 a day; a 7-day lead time needs 10.5, rounded up, plus 5 safety stock.
+	if rop := reorderPoint(decimal.NewFromInt(45), 30, 7, 5); rop != 16 {
+		t.Errorf("reorder point = %d, want 16", rop)
+	}
+	if rop := reorderPoint(decimal.Zero, 30, 7, 3); rop != 3 {
+		t.Errorf("no sales should fall back to the minimum level, got %d", rop)
+	}
+
+	if q := orderQuantity(decimal.NewFromInt(16), 16, 40); q != 0 {
+		t.Errorf("stock at the reorder point should not order, got %d", q)
+	}
+	if q := orderQuantity(decimal.NewFromInt(10), 16, 40); q != 30 {
+		t.Errorf("order up to max = %d, want 30", q)
+	}
+	if q := orderQuantity(decimal.RequireFromString("2.5"), 16, 0); q != 14 {
+		t.Errorf("without a max level order up to the reorder point, got %d", q)
+	}
+	if q := orderQuantity(decimal.Zero, 0, 50); q != 0 {
+		t.Errorf("a product with no reorder point should not order, got %d", q)
+	}
+}
+
+func TestNightlySchedule(t *testing.T) {
+	before := time.Date(2026, 10, 18, 1, 30, 0, 0, time.UTC)
+	if next := (NightlySchedule{}).Next(before); !next.Equal(time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("next = %s", next)
+	}
+	at := time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC)
+	if next := (NightlySchedule{}).Next(at); !next.Equal(time.Date(2026, 10, 19, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("next after a run = %s", next)
+	}
+}
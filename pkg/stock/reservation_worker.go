@@ -29,6 +29,8 @@ func (w *ReservationReleaseWorker) Work(ctx context.Context, job *river.Job[Rese
 	res, err := tx.InventoryReservation.Query().
 		Where(inventoryreservation.ID(job.Args.ReservationID)).
 		WithProduct().
+		WithTenant().
+		WithWarehouse().
 		Modify(func(s *sql.Selector) {
 			s.ForUpdate()
 		}).
@@ -66,5 +68,16 @@ func (w *ReservationReleaseWorker) Work(ctx context.Context, job *river.Job[Rese
 		return fmt.Errorf("failed to restore product quantity: %w", err)
 	}
 
+	// 3. Put the stock back into the bins and lots it was taken from.
+	if res.Edges.Warehouse != nil {
+		for _, t := range res.Takes {
+			err := addStock(ctx, tx, res.Edges.Tenant.ID, res.Edges.Product.ID, res.Edges.Warehouse.ID, t)
+			if err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to restore stock level: %w", err)
+			}
+		}
+	}
+
 	return tx.Commit()
 }
@@ -0,0 +1,405 @@
+package stock
+
+import (
+	"fmt"
+	"time"
+
+	"sent/ent"
+	"sent/ent/product"
+	"sent/ent/schema"
+	"sent/ent/stockmovement"
+	"sent/ent/tenant"
+	"sent/ent/transferorder"
+	"sent/ent/warehouse"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// TransferLineRequest is one product on a transfer order.
+type TransferLineRequest struct {
+	ProductID int     `json:"productID"`
+	Quantity  float64 `json:"quantity"`
+	FromBin   string  `json:"fromBin"` // Empty takes from any bin
+	ToBin     string  `json:"toBin"`
+	LotNumber string  `json:"lotNumber"` // Empty ships the first-expiring lots
+}
+
+// TransferRequest moves stock from one warehouse to another.
+type TransferRequest struct {
+	SourceID      int                   `json:"sourceID"`
+	DestinationID int                   `json:"destinationID"`
+	Notes         string                `json:"notes"`
+	Lines         []TransferLineRequest `json:"lines"`
+}
+
+// TransferLineDTO is a transfer order line for the UI.
+type TransferLineDTO struct {
+	ProductID   int     `json:"productID"`
+	ProductName string  `json:"productName"`
+	SKU         string  `json:"sku"`
+	Quantity    float64 `json:"quantity"`
+	FromBin     string  `json:"fromBin"`
+	ToBin       string  `json:"toBin"`
+	LotNumber   string  `json:"lotNumber"`
+}
+
+// TransferOrderDTO is a transfer order for the UI.
+type TransferOrderDTO struct {
+	ID              int               `json:"id"`
+	Number          string            `json:"number"`
+	Status          string            `json:"status"`
+	SourceID        int               `json:"sourceID"`
+	SourceName      string            `json:"sourceName"`
+	DestinationID   int               `json:"destinationID"`
+	DestinationName string            `json:"destinationName"`
+	Notes           string            `json:"notes"`
+	CreatedBy       string            `json:"createdBy"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	ShippedAt       *time.Time        `json:"shippedAt,omitempty"`
+	ReceivedAt      *time.Time        `json:"receivedAt,omitempty"`
+	Lines           []TransferLineDTO `json:"lines"`
+}
+
+// CreateTransfer drafts a transfer order. Nothing moves until it is shipped.
+func (s *StockBridge) CreateTransfer(req TransferRequest) (*TransferOrderDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if req.SourceID == req.DestinationID {
+		return nil, fmt.Errorf("source and destination must be different warehouses")
+	}
+	if len(req.Lines) == 0 {
+		return nil, fmt.Errorf("a transfer needs at least one line")
+	}
+	for _, id := range []int{req.SourceID, req.DestinationID} {
+		ok, err := s.db.Warehouse.Query().
+			Where(warehouse.ID(id), warehouse.HasTenantWith(tenant.ID(profile.TenantID)), warehouse.IsActive(true)).
+			Exist(s.ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("warehouse %d not found", id)
+		}
+	}
+	for _, l := range req.Lines {
+		if l.Quantity <= 0 {
+			return nil, fmt.Errorf("quantity must be positive")
+		}
+		ok, err := s.db.Product.Query().
+			Where(product.ID(l.ProductID), product.HasTenantWith(tenant.ID(profile.TenantID))).
+			Exist(s.ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("product %d not found", l.ProductID)
+		}
+	}
+
+	tx, err := s.db.Tx(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.TransferOrder.Query().Where(transferorder.HasTenantWith(tenant.ID(profile.TenantID))).Count(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	to, err := tx.TransferOrder.Create().
+		SetTenantID(profile.TenantID).
+		SetNumber(fmt.Sprintf("TRF-%05d", n+1)).
+		SetSourceID(req.SourceID).
+		SetDestinationID(req.DestinationID).
+		SetNotes(req.Notes).
+		SetCreatedBy(profile.Name).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save transfer: %w", err)
+	}
+	for _, l := range req.Lines {
+		err := tx.TransferOrderLine.Create().
+			SetTransfer(to).
+			SetProductID(l.ProductID).
+			SetQuantity(decimal.NewFromFloat(l.Quantity)).
+			SetFromBin(l.FromBin).
+			SetToBin(l.ToBin).
+			SetLotNumber(l.LotNumber).
+			Exec(s.ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.logAudit("create_transfer", "transfer_order", to.ID, map[string]interface{}{
+		"number":      to.Number,
+		"source":      req.SourceID,
+		"destination": req.DestinationID,
+		"lines":       len(req.Lines),
+	})
+	return s.transferDTO(to.ID)
+}
+
+// ShipTransfer takes the stock out of the source warehouse and puts the order in transit.
+// Product totals do not change; in-transit stock is the difference between Product.quantity
+// and the sum of its levels.
+func (s *StockBridge) ShipTransfer(id int) (*TransferOrderDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	to, err := s.lockTransfer(tx, profile.TenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if to.Status != transferorder.StatusDraft {
+		return nil, fmt.Errorf("transfer %s is %s", to.Number, to.Status)
+	}
+
+	for _, l := range to.Edges.Lines {
+		// Expired lots may still be shipped, e.g. to a returns warehouse.
+		rule := pickRule{LotNumber: l.LotNumber}
+		if l.FromBin != "" {
+			rule.Bin = &l.FromBin
+		}
+		takes, err := takeStock(s.ctx, tx, profile.TenantID, l.Edges.Product, to.Edges.Source.ID, rule, l.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("cannot ship %s from %s: %w", to.Number, to.Edges.Source.Name, err)
+		}
+		for _, t := range takes {
+			err := tx.StockMovement.Create().
+				SetTenantID(profile.TenantID).
+				SetProduct(l.Edges.Product).
+				SetQuantity(t.Quantity).
+				SetMovementType(stockmovement.MovementTypeOutgoing).
+				SetReason(fmt.Sprintf("Transfer %s to %s", to.Number, to.Edges.Destination.Name)).
+				SetWarehouse(to.Edges.Source).
+				SetBin(t.Bin).
+				SetLotNumber(t.LotNumber).
+				SetNillableExpiryDate(t.ExpiryDate).
+				SetTransfer(to).
+				SetMetadata(map[string]interface{}{"transfer_line_id": l.ID}).
+				Exec(s.ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = tx.TransferOrder.UpdateOne(to).
+		SetStatus(transferorder.StatusInTransit).
+		SetShippedAt(time.Now()).
+		Exec(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.logAudit("ship_transfer", "transfer_order", to.ID, map[string]interface{}{"number": to.Number})
+	return s.transferDTO(to.ID)
+}
+
+// ReceiveTransfer books in-transit stock into the destination warehouse.
+func (s *StockBridge) ReceiveTransfer(id int) (*TransferOrderDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	to, err := s.lockTransfer(tx, profile.TenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if to.Status != transferorder.StatusInTransit {
+		return nil, fmt.Errorf("transfer %s is %s", to.Number, to.Status)
+	}
+
+	// Each outgoing leg arrives as it left, keeping its lot and expiry.
+	legs, err := to.QueryMovements().
+		Where(stockmovement.MovementTypeEQ(stockmovement.MovementTypeOutgoing)).
+		WithProduct().
+		All(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	toBin := make(map[int]string, len(to.Edges.Lines))
+	for _, l := range to.Edges.Lines {
+		toBin[l.ID] = l.ToBin
+	}
+	for _, leg := range legs {
+		lineID, _ := leg.Metadata["transfer_line_id"].(float64)
+		put := schema.StockTake{
+			Bin:        toBin[int(lineID)],
+			LotNumber:  leg.LotNumber,
+			ExpiryDate: leg.ExpiryDate,
+			Quantity:   leg.Quantity,
+		}
+		if err := addStock(s.ctx, tx, profile.TenantID, leg.Edges.Product.ID, to.Edges.Destination.ID, put); err != nil {
+			return nil, fmt.Errorf("failed to update stock level: %w", err)
+		}
+		// No remaining quantity: the cost layer stays on the original receipt.
+		err := tx.StockMovement.Create().
+			SetTenantID(profile.TenantID).
+			SetProduct(leg.Edges.Product).
+			SetQuantity(leg.Quantity).
+			SetMovementType(stockmovement.MovementTypeIncoming).
+			SetReason(fmt.Sprintf("Transfer %s from %s", to.Number, to.Edges.Source.Name)).
+			SetWarehouse(to.Edges.Destination).
+			SetBin(put.Bin).
+			SetLotNumber(put.LotNumber).
+			SetNillableExpiryDate(put.ExpiryDate).
+			SetTransfer(to).
+			Exec(s.ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.TransferOrder.UpdateOne(to).
+		SetStatus(transferorder.StatusReceived).
+		SetReceivedAt(time.Now()).
+		Exec(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.logAudit("receive_transfer", "transfer_order", to.ID, map[string]interface{}{"number": to.Number})
+	return s.transferDTO(to.ID)
+}
+
+// CancelTransfer cancels a draft transfer. Shipped transfers have to be received.
+func (s *StockBridge) CancelTransfer(id int) error {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+
+	to, err := s.db.TransferOrder.Query().
+		Where(transferorder.ID(id), transferorder.HasTenantWith(tenant.ID(profile.TenantID))).
+		Only(s.ctx)
+	if err != nil {
+		return fmt.Errorf("transfer %d not found: %w", id, err)
+	}
+	if to.Status != transferorder.StatusDraft {
+		return fmt.Errorf("transfer %s is %s and cannot be cancelled", to.Number, to.Status)
+	}
+	if err := s.db.TransferOrder.UpdateOne(to).SetStatus(transferorder.StatusCancelled).Exec(s.ctx); err != nil {
+		return err
+	}
+
+	s.logAudit("cancel_transfer", "transfer_order", to.ID, map[string]interface{}{"number": to.Number})
+	return nil
+}
+
+// GetTransfers lists the tenant's transfer orders, newest first.
+func (s *StockBridge) GetTransfers() ([]TransferOrderDTO, error) {
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.db.TransferOrder.Query().
+		Where(transferorder.HasTenantWith(tenant.ID(profile.TenantID))).
+		Order(ent.Desc(transferorder.FieldCreatedAt)).
+		IDs(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]TransferOrderDTO, 0, len(ids))
+	for _, id := range ids {
+		dto, err := s.transferDTO(id)
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, *dto)
+	}
+	return dtos, nil
+}
+
+// lockTransfer loads a transfer with its warehouses and lines, holding the row until the tx ends.
+func (s *StockBridge) lockTransfer(tx *ent.Tx, tenantID, id int) (*ent.TransferOrder, error) {
+	to, err := tx.TransferOrder.Query().
+		Where(transferorder.ID(id), transferorder.HasTenantWith(tenant.ID(tenantID))).
+		Modify(func(s *sql.Selector) {
+			s.ForUpdate()
+		}).
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("transfer %d not found: %w", id, err)
+	}
+	// Edges are loaded separately; FOR UPDATE cannot apply to the eager-load queries.
+	if to.Edges.Source, err = to.QuerySource().Only(s.ctx); err != nil {
+		return nil, err
+	}
+	if to.Edges.Destination, err = to.QueryDestination().Only(s.ctx); err != nil {
+		return nil, err
+	}
+	if to.Edges.Lines, err = to.QueryLines().WithProduct().All(s.ctx); err != nil {
+		return nil, err
+	}
+	return to, nil
+}
+
+func (s *StockBridge) transferDTO(id int) (*TransferOrderDTO, error) {
+	to, err := s.db.TransferOrder.Query().
+		Where(transferorder.ID(id)).
+		WithSource().
+		WithDestination().
+		WithLines(func(q *ent.TransferOrderLineQuery) { q.WithProduct() }).
+		Only(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &TransferOrderDTO{
+		ID:              to.ID,
+		Number:          to.Number,
+		Status:          string(to.Status),
+		SourceID:        to.Edges.Source.ID,
+		SourceName:      to.Edges.Source.Name,
+		DestinationID:   to.Edges.Destination.ID,
+		DestinationName: to.Edges.Destination.Name,
+		Notes:           to.Notes,
+		CreatedBy:       to.CreatedBy,
+		CreatedAt:       to.CreatedAt,
+		ShippedAt:       to.ShippedAt,
+		ReceivedAt:      to.ReceivedAt,
+	}
+	for _, l := range to.Edges.Lines {
+		dto.Lines = append(dto.Lines, TransferLineDTO{
+			ProductID:   l.Edges.Product.ID,
+			ProductName: l.Edges.Product.Name,
+			SKU:         l.Edges.Product.Sku,
+			Quantity:    l.Quantity.InexactFloat64(),
+			FromBin:     l.FromBin,
+			ToBin:       l.ToBin,
+			LotNumber:   l.LotNumber,
+		})
+	}
+	return dto, nil
+}
@@ -0,0 +1,367 @@
+package stock
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"sent/ent"
+	"sent/ent/product"
+	"sent/ent/tenant"
+	"sent/pkg/capital"
+
+	"github.com/shopspring/decimal"
+)
+
+// WeighingScale weighs goods sold by weight. The scales of the peripherals registry are ones.
+type WeighingScale interface {
+	ReadWeight() (float64, error) // Stable weight in kg
+	Zero() error
+}
+
+// ScaleLookup finds a configured scale by ID.
+type ScaleLookup func(id string) (WeighingScale, error)
+
+// Terminal settings of weighed goods, kept in the local buffer.
+const (
+	kioskScaleSetting          = "scale_id"        // Scale at the till
+	kioskBarcodeFormatsSetting = "barcode_formats" // Price and weight barcode layouts, JSON
+)
+
+// EmbeddedFormat is the layout of an EAN-13 barcode that carries a price or a weight, as
+// printed by shop scales and labelling machines: the prefix, the item's PLU, the value and the
+// check digit.
+type EmbeddedFormat struct {
+	Prefix     string `json:"prefix"`     // Leading digits, e.g. 20
+	ItemDigits int    `json:"itemDigits"` // Length of the PLU after the prefix
+	Value      string `json:"value"`      // price or weight
+	Decimals   int    `json:"decimals"`   // Implied decimals of the value
+}
+
+// defaultEmbeddedFormats are the GS1 in-store prefixes as most shops set them up: 20 to 22
+// carry a price in units of 0.01, 23 and 24 a weight in grams.
+var defaultEmbeddedFormats = []EmbeddedFormat{
+	{Prefix: "20", ItemDigits: 5, Value: "price", Decimals: 2},
+	{Prefix: "21", ItemDigits: 5, Value: "price", Decimals: 2},
+	{Prefix: "22", ItemDigits: 5, Value: "price", Decimals: 2},
+	{Prefix: "23", ItemDigits: 5, Value: "weight", Decimals: 3},
+	{Prefix: "24", ItemDigits: 5, Value: "weight", Decimals: 3},
+}
+
+// embeddedCode is what a price or weight barcode says.
+type embeddedCode struct {
+	PLU    string
+	Format EmbeddedFormat
+	Value  decimal.Decimal
+}
+
+// validEAN13 checks the check digit of a 13-digit code.
+func validEAN13(code string) bool {
+	if len(code) != 13 {
+		return false
+	}
+	sum := 0
+	for i, c := range code[:12] {
+		if c < '0' || c > '9' {
+			return false
+		}
+		d := int(c - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return int(code[12]-'0') == (10-sum%10)%10
+}
+
+// parseEmbedded reads a price or weight barcode. ok is false for codes no format matches,
+// which are ordinary product barcodes.
+func parseEmbedded(code string, formats []EmbeddedFormat) (c *embeddedCode, ok bool, err error) {
+	if len(code) != 13 {
+		return nil, false, nil
+	}
+	if _, err := strconv.ParseUint(code, 10, 64); err != nil {
+		return nil, false, nil
+	}
+	for _, f := range formats {
+		if f.Prefix == "" || len(code) < len(f.Prefix) || code[:len(f.Prefix)] != f.Prefix {
+			continue
+		}
+		valueStart := len(f.Prefix) + f.ItemDigits
+		if f.ItemDigits <= 0 || valueStart >= 12 {
+			return nil, true, fmt.Errorf("barcode format %s has no room for a value", f.Prefix)
+		}
+		if !validEAN13(code) {
+			return nil, true, fmt.Errorf("barcode %s has a wrong check digit", code)
+		}
+		v, _ := strconv.ParseInt(code[valueStart:12], 10, 64)
+		return &embeddedCode{
+			PLU:    code[len(f.Prefix):valueStart],
+			Format: f,
+			Value:  decimal.New(v, int32(-f.Decimals)),
+		}, true, nil
+	}
+	return nil, false, nil
+}
+
+// SetScaleLookup connects the till to the configured scales.
+func (k *KioskBridge) SetScaleLookup(lookup ScaleLookup) {
+	k.scales = lookup
+}
+
+// SetWeighingScale chooses the scale goods sold by weight are weighed on; an empty ID removes it.
+func (k *KioskBridge) SetWeighingScale(scaleID string) error {
+	if !k.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change the scale")
+	}
+	if scaleID != "" {
+		if k.scales == nil {
+			return fmt.Errorf("no scales are configured")
+		}
+		if _, err := k.scales(scaleID); err != nil {
+			return err
+		}
+	}
+	if k.buffer != nil {
+		if err := k.buffer.SetSetting(kioskScaleSetting, scaleID); err != nil {
+			return fmt.Errorf("failed to save scale: %w", err)
+		}
+	}
+	k.scaleID = scaleID
+	return nil
+}
+
+// GetWeighingScale returns the ID of the till's scale, empty when it has none.
+func (k *KioskBridge) GetWeighingScale() string {
+	return k.scaleID
+}
+
+func (k *KioskBridge) weighingScale() (WeighingScale, error) {
+	if k.scaleID == "" || k.scales == nil {
+		return nil, fmt.Errorf("no scale is connected to this terminal")
+	}
+	return k.scales(k.scaleID)
+}
+
+// ZeroScale zeroes the empty scale.
+func (k *KioskBridge) ZeroScale() error {
+	s, err := k.weighingScale()
+	if err != nil {
+		return err
+	}
+	return s.Zero()
+}
+
+// WeighingDTO is a product weighed at the till.
+type WeighingDTO struct {
+	ProductID  int     `json:"productId"`
+	Name       string  `json:"name"`
+	Gross      float64 `json:"gross"` // kg on the scale
+	Tare       float64 `json:"tare"`  // kg of the container
+	Net        float64 `json:"net"`   // kg sold
+	PricePerKg float64 `json:"pricePerKg"`
+	Amount     float64 `json:"amount"` // Net times the price per kg, rounded to the currency
+}
+
+// Weigh reads the stable weight of a product sold by weight, less the weight of its container,
+// and prices it.
+func (k *KioskBridge) Weigh(productID int) (*WeighingDTO, error) {
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	p, err := k.db.Product.Query().
+		Where(product.ID(productID), product.HasTenantWith(tenant.ID(profile.TenantID))).
+		Only(k.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("product not found: %w", err)
+	}
+	if !p.SoldByWeight {
+		return nil, fmt.Errorf("%s is not sold by weight", p.Name)
+	}
+	s, err := k.weighingScale()
+	if err != nil {
+		return nil, err
+	}
+	kg, err := s.ReadWeight()
+	if err != nil {
+		return nil, fmt.Errorf("failed to weigh %s: %w", p.Name, err)
+	}
+
+	places := int32(2)
+	if tnt, err := k.db.Tenant.Get(k.ctx, profile.TenantID); err == nil {
+		places = capital.CurrencyPlaces(tnt.FunctionalCurrency)
+	}
+	gross := decimal.NewFromFloat(kg).Round(3)
+	net := gross.Sub(p.TareWeight)
+	if !net.IsPositive() {
+		return nil, fmt.Errorf("nothing on the scale: %s kg is no more than the %s kg tare", gross, p.TareWeight)
+	}
+	return &WeighingDTO{
+		ProductID:  p.ID,
+		Name:       p.Name,
+		Gross:      gross.InexactFloat64(),
+		Tare:       p.TareWeight.InexactFloat64(),
+		Net:        net.InexactFloat64(),
+		PricePerKg: p.PricePerKg.InexactFloat64(),
+		Amount:     net.Mul(p.PricePerKg).Round(places).InexactFloat64(),
+	}, nil
+}
+
+// embeddedFormats returns the terminal's price and weight barcode layouts.
+func (k *KioskBridge) embeddedFormats() []EmbeddedFormat {
+	if k.buffer == nil {
+		return defaultEmbeddedFormats
+	}
+	v, err := k.buffer.GetSetting(kioskBarcodeFormatsSetting)
+	if err != nil || v == "" {
+		return defaultEmbeddedFormats
+	}
+	var formats []EmbeddedFormat
+	if err := json.Unmarshal([]byte(v), &formats); err != nil {
+		return defaultEmbeddedFormats
+	}
+	return formats
+}
+
+// GetBarcodeFormats returns the layouts of the price and weight barcodes the till reads.
+func (k *KioskBridge) GetBarcodeFormats() []EmbeddedFormat {
+	return k.embeddedFormats()
+}
+
+// SetBarcodeFormats sets the layouts of the price and weight barcodes the till reads, to match
+// the shop's scales; none restores the defaults.
+func (k *KioskBridge) SetBarcodeFormats(formats []EmbeddedFormat) error {
+	if !k.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change barcode formats")
+	}
+	if k.buffer == nil {
+		return fmt.Errorf("offline buffer not initialized")
+	}
+	for _, f := range formats {
+		if f.Prefix == "" || f.ItemDigits <= 0 || len(f.Prefix)+f.ItemDigits >= 12 {
+			return fmt.Errorf("barcode format %q: the prefix and PLU must leave room for a value", f.Prefix)
+		}
+		if f.Value != "price" && f.Value != "weight" {
+			return fmt.Errorf("barcode format %q: value must be price or weight", f.Prefix)
+		}
+		if f.Decimals < 0 || f.Decimals > 4 {
+			return fmt.Errorf("barcode format %q: decimals must be 0 to 4", f.Prefix)
+		}
+	}
+	v := ""
+	if len(formats) > 0 {
+		data, err := json.Marshal(formats)
+		if err != nil {
+			return err
+		}
+		v = string(data)
+	}
+	return k.buffer.SetSetting(kioskBarcodeFormatsSetting, v)
+}
+
+// ScanResult is the till line a scanned barcode rings up.
+type ScanResult struct {
+	ProductID int     `json:"productId"`
+	Name      string  `json:"name"`
+	Quantity  float64 `json:"quantity"` // kg for goods sold by weight
+	Price     float64 `json:"price"`    // Per unit, or per kg
+	Amount    float64 `json:"amount,omitempty"`
+}
+
+// ScanBarcode resolves a barcode to a till line. Price and weight barcodes name the product by
+// its PLU; the line keeps the price printed on the label, or prices the weight on it. Other
+// codes are looked up by barcode or SKU for one unit.
+func (k *KioskBridge) ScanBarcode(code string) (*ScanResult, error) {
+	profile, err := k.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	ec, ok, err := parseEmbedded(code, k.embeddedFormats())
+	if err != nil {
+		return nil, err
+	}
+	q := k.db.Product.Query().Where(product.HasTenantWith(tenant.ID(profile.TenantID)))
+	if ok {
+		q.Where(product.Plu(ec.PLU))
+	} else {
+		q.Where(product.Or(product.Barcode(code), product.Sku(code)))
+	}
+	p, err := q.First(k.ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("unknown barcode: %s", code)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &ScanResult{ProductID: p.ID, Name: p.Name, Quantity: 1, Price: p.UnitCost.InexactFloat64()}, nil
+	}
+	return embeddedLine(p, ec)
+}
+
+// embeddedLine rings up a product from its price or weight barcode.
+func embeddedLine(p *ent.Product, ec *embeddedCode) (*ScanResult, error) {
+	r := &ScanResult{ProductID: p.ID, Name: p.Name}
+	switch {
+	case ec.Format.Value == "weight":
+		if !p.SoldByWeight {
+			return nil, fmt.Errorf("%s is not sold by weight", p.Name)
+		}
+		r.Quantity = ec.Value.InexactFloat64()
+		r.Price = p.PricePerKg.InexactFloat64()
+	case p.SoldByWeight && p.PricePerKg.IsPositive():
+		// The label's price is charged as printed; the weight it stands for moves the stock.
+		r.Quantity = ec.Value.Div(p.PricePerKg).Round(3).InexactFloat64()
+		r.Price = p.PricePerKg.InexactFloat64()
+		r.Amount = ec.Value.InexactFloat64()
+	default:
+		r.Quantity = 1
+		r.Price = ec.Value.InexactFloat64()
+	}
+	if r.Quantity <= 0 {
+		return nil, fmt.Errorf("barcode of %s carries no quantity", p.Name)
+	}
+	return r, nil
+}
+
+// SetSoldByWeight sells a product loose by weight at a price per kg, tax included, less the
+// tare of the container it is weighed in. The PLU names it in price and weight barcodes. A
+// price of zero sells it by the unit again.
+func (s *StockBridge) SetSoldByWeight(productID int, pricePerKg, tareWeight float64, plu string) error {
+	if !s.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change how products are sold")
+	}
+	profile, err := s.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	if pricePerKg < 0 || tareWeight < 0 {
+		return fmt.Errorf("price and tare must not be negative")
+	}
+	if _, err := strconv.ParseUint(plu, 10, 64); plu != "" && err != nil {
+		return fmt.Errorf("PLU must be digits")
+	}
+	update := s.db.Product.Update().
+		Where(product.ID(productID), product.HasTenantWith(tenant.ID(profile.TenantID))).
+		SetSoldByWeight(pricePerKg > 0).
+		SetPricePerKg(decimal.NewFromFloat(pricePerKg)).
+		SetTareWeight(decimal.NewFromFloat(tareWeight))
+	if plu == "" {
+		update.ClearPlu()
+	} else {
+		update.SetPlu(plu)
+	}
+	n, err := update.Save(s.ctx)
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("PLU %s is already used by another product", plu)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("product %d not found", productID)
+	}
+	s.logAudit("sold_by_weight", "product", productID, map[string]interface{}{"price_per_kg": pricePerKg, "tare_weight": tareWeight, "plu": plu})
+	return nil
+}
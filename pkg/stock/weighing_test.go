@@ -0,0 +1,54 @@
+package stock
+
+import (
+	"testing"
+
+	"sent/ent"
+)
+
+func TestParseEmbedded(t *testing.T) {
+	c, ok, err := parseEmbedded("2012345012509", defaultEmbeddedFormats)
+	if err != nil || !ok || c.PLU != "12345" || c.Format.Value != "price" || !c.Value.Equal(dec("12.5")) {
+		t.Errorf("price code = %+v, %v, %v", c, ok, err)
+	}
+	c, ok, err = parseEmbedded("2300042012343", defaultEmbeddedFormats)
+	if err != nil || !ok || c.PLU != "00042" || c.Format.Value != "weight" || !c.Value.Equal(dec("1.234")) {
+		t.Errorf("weight code = %+v, %v, %v", c, ok, err)
+	}
+
+	// Ordinary product barcodes are left for the catalog.
+	for _, code := range []string{"5901234123457", "12345", "SKU-0001"} {
+		if _, ok, err := parseEmbedded(code, defaultEmbeddedFormats); ok || err != nil {
+			t.Errorf("%s read as embedded: %v, %v", code, ok, err)
+		}
+	}
+	if _, _, err := parseEmbedded("2012345012508", defaultEmbeddedFormats); err == nil {
+		t.Error("wrong check digit accepted")
+	}
+}
+
+func TestEmbeddedLine(t *testing.T) {
+	cheese := &ent.Product{ID: 7, Name: "Cheese", SoldByWeight: true, PricePerKg: dec("8")}
+
+	// A 12.50 label on cheese at 8.00/kg is 1.5625 kg, charged as printed.
+	c, _, _ := parseEmbedded("2012345012509", defaultEmbeddedFormats)
+	r, err := embeddedLine(cheese, c)
+	if err != nil || r.Quantity != 1.563 || r.Price != 8 || r.Amount != 12.5 {
+		t.Errorf("price label = %+v, %v", r, err)
+	}
+	lines, total, err := priceSale([]SaleItem{{ProductID: r.ProductID, Quantity: r.Quantity, Price: r.Price, Amount: r.Amount}}, 0, 2, nil)
+	if err != nil || !total.Equal(dec("12.5")) || !lines[0].Quantity.Equal(dec("1.563")) {
+		t.Errorf("priced label = %s, %v", total, err)
+	}
+
+	c, _, _ = parseEmbedded("2300042012343", defaultEmbeddedFormats)
+	r, err = embeddedLine(cheese, c)
+	if err != nil || r.Quantity != 1.234 || r.Price != 8 || r.Amount != 0 {
+		t.Errorf("weight label = %+v, %v", r, err)
+	}
+
+	bread := &ent.Product{ID: 8, Name: "Bread"}
+	if _, err := embeddedLine(bread, c); err == nil {
+		t.Error("weight label accepted for goods sold by the unit")
+	}
+}
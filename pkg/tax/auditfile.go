@@ -0,0 +1,463 @@
+package tax
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/customer"
+	"sent/ent/ledgerentry"
+	"sent/ent/supplier"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+
+	"github.com/shopspring/decimal"
+)
+
+// The audit file follows the OECD Standard Audit File for Tax (SAF-T) 2.0: the company, its
+// chart of accounts, customers, suppliers and tax codes, every posted journal of the period
+// and the sales and purchase documents behind the tax registers. Authorities that take a
+// national SAF-T variant map it from this one.
+const safTNamespace = "urn:OECD:StandardAuditFile-Tax:2.00"
+
+// AuditFile is the root of a SAF-T document.
+type AuditFile struct {
+	XMLName              xml.Name             `xml:"AuditFile"`
+	Namespace            string               `xml:"xmlns,attr"`
+	Header               AuditHeader          `xml:"Header"`
+	MasterFiles          AuditMasterFiles     `xml:"MasterFiles"`
+	GeneralLedgerEntries AuditLedgerEntries   `xml:"GeneralLedgerEntries"`
+	SourceDocuments      AuditSourceDocuments `xml:"SourceDocuments"`
+}
+
+type AuditHeader struct {
+	AuditFileVersion     string         `xml:"AuditFileVersion"`
+	AuditFileCountry     string         `xml:"AuditFileCountry,omitempty"`
+	AuditFileDateCreated string         `xml:"AuditFileDateCreated"`
+	SoftwareCompanyName  string         `xml:"SoftwareCompanyName"`
+	SoftwareID           string         `xml:"SoftwareID"`
+	SoftwareVersion      string         `xml:"SoftwareVersion"`
+	Company              AuditCompany   `xml:"Company"`
+	DefaultCurrencyCode  string         `xml:"DefaultCurrencyCode"`
+	SelectionCriteria    AuditSelection `xml:"SelectionCriteria"`
+	TaxAccountingBasis   string         `xml:"TaxAccountingBasis"`
+}
+
+type AuditCompany struct {
+	RegistrationNumber    string `xml:"RegistrationNumber"`
+	Name                  string `xml:"Name"`
+	TaxRegistrationNumber string `xml:"TaxRegistration>TaxRegistrationNumber,omitempty"`
+}
+
+type AuditSelection struct {
+	SelectionStartDate string `xml:"SelectionStartDate"`
+	SelectionEndDate   string `xml:"SelectionEndDate"`
+}
+
+type AuditMasterFiles struct {
+	Accounts  []AuditAccount  `xml:"GeneralLedgerAccounts>Account"`
+	Customers []AuditCustomer `xml:"Customers>Customer"`
+	Suppliers []AuditSupplier `xml:"Suppliers>Supplier"`
+	TaxTable  AuditTaxTable   `xml:"TaxTable>TaxTableEntry"`
+}
+
+type AuditAccount struct {
+	AccountID            string `xml:"AccountID"`
+	AccountDescription   string `xml:"AccountDescription"`
+	AccountType          string `xml:"AccountType"`
+	OpeningDebitBalance  string `xml:"OpeningDebitBalance,omitempty"`
+	OpeningCreditBalance string `xml:"OpeningCreditBalance,omitempty"`
+	ClosingDebitBalance  string `xml:"ClosingDebitBalance,omitempty"`
+	ClosingCreditBalance string `xml:"ClosingCreditBalance,omitempty"`
+}
+
+type AuditCustomer struct {
+	CustomerID            string `xml:"CustomerID"`
+	Name                  string `xml:"Name"`
+	TaxRegistrationNumber string `xml:"TaxRegistration>TaxRegistrationNumber,omitempty"`
+	Country               string `xml:"Address>Country,omitempty"`
+}
+
+type AuditSupplier struct {
+	SupplierID            string `xml:"SupplierID"`
+	Name                  string `xml:"Name"`
+	TaxRegistrationNumber string `xml:"TaxRegistration>TaxRegistrationNumber,omitempty"`
+	Country               string `xml:"Address>Country,omitempty"`
+}
+
+type AuditTaxTable struct {
+	TaxType     string         `xml:"TaxType"`
+	Description string         `xml:"Description"`
+	Codes       []AuditTaxCode `xml:"TaxCodeDetails"`
+}
+
+type AuditTaxCode struct {
+	TaxCode       string `xml:"TaxCode"`
+	Description   string `xml:"Description"`
+	TaxPercentage string `xml:"TaxPercentage"`
+	Country       string `xml:"Country,omitempty"`
+}
+
+type AuditLedgerEntries struct {
+	NumberOfEntries int          `xml:"NumberOfEntries"`
+	TotalDebit      string       `xml:"TotalDebit"`
+	TotalCredit     string       `xml:"TotalCredit"`
+	Journal         AuditJournal `xml:"Journal"`
+}
+
+type AuditJournal struct {
+	JournalID    string             `xml:"JournalID"`
+	Description  string             `xml:"Description"`
+	Transactions []AuditTransaction `xml:"Transaction"`
+}
+
+type AuditTransaction struct {
+	TransactionID    string            `xml:"TransactionID"`
+	Period           int               `xml:"Period"`
+	PeriodYear       int               `xml:"PeriodYear"`
+	TransactionDate  string            `xml:"TransactionDate"`
+	SourceDocumentID string            `xml:"SourceDocumentID,omitempty"`
+	Description      string            `xml:"Description"`
+	GLPostingDate    string            `xml:"GLPostingDate"`
+	Lines            []AuditLedgerLine `xml:"Line"`
+}
+
+type AuditLedgerLine struct {
+	RecordID     string       `xml:"RecordID"`
+	AccountID    string       `xml:"AccountID"`
+	DebitAmount  *AuditAmount `xml:"DebitAmount,omitempty"`
+	CreditAmount *AuditAmount `xml:"CreditAmount,omitempty"`
+}
+
+type AuditAmount struct {
+	Amount         string `xml:"Amount"`
+	CurrencyCode   string `xml:"CurrencyCode,omitempty"`
+	CurrencyAmount string `xml:"CurrencyAmount,omitempty"`
+	ExchangeRate   string `xml:"ExchangeRate,omitempty"`
+}
+
+type AuditSourceDocuments struct {
+	SalesInvoices    AuditInvoices `xml:"SalesInvoices"`
+	PurchaseInvoices AuditInvoices `xml:"PurchaseInvoices"`
+}
+
+type AuditInvoices struct {
+	NumberOfEntries int            `xml:"NumberOfEntries"`
+	TotalDebit      string         `xml:"TotalDebit"`
+	TotalCredit     string         `xml:"TotalCredit"`
+	Invoices        []AuditInvoice `xml:"Invoice"`
+}
+
+type AuditInvoice struct {
+	InvoiceNo      string             `xml:"InvoiceNo"`
+	CustomerID     string             `xml:"CustomerInfo>CustomerID,omitempty"`
+	SupplierID     string             `xml:"SupplierInfo>SupplierID,omitempty"`
+	InvoiceDate    string             `xml:"InvoiceDate"`
+	InvoiceType    string             `xml:"InvoiceType"`
+	Lines          []AuditInvoiceLine `xml:"Line"`
+	DocumentTotals AuditTotals        `xml:"DocumentTotals"`
+}
+
+type AuditInvoiceLine struct {
+	LineNumber    int         `xml:"LineNumber"`
+	TaxType       string      `xml:"TaxInformation>TaxType"`
+	TaxCode       string      `xml:"TaxInformation>TaxCode"`
+	TaxPercentage string      `xml:"TaxInformation>TaxPercentage"`
+	TaxBase       string      `xml:"TaxInformation>TaxBase"`
+	TaxAmount     AuditAmount `xml:"TaxInformation>TaxAmount"`
+}
+
+type AuditTotals struct {
+	TaxPayable string `xml:"TaxPayable"`
+	NetTotal   string `xml:"NetTotal"`
+	GrossTotal string `xml:"GrossTotal"`
+}
+
+// BuildAuditFile assembles the audit file of [from, to). Amounts are in the functional
+// currency with the document currency alongside.
+func BuildAuditFile(ctx context.Context, db *ent.Client, tenantID int, from, to time.Time, places int32) (*AuditFile, error) {
+	tnt, err := db.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	sales, err := SalesRegister(ctx, db, tenantID, from, to, places)
+	if err != nil {
+		return nil, err
+	}
+	purchases, err := PurchaseRegister(ctx, db, tenantID, from, to, places)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &AuditFile{
+		Namespace: safTNamespace,
+		Header: AuditHeader{
+			AuditFileVersion:     "2.00",
+			AuditFileCountry:     auditCountry(sales, purchases),
+			AuditFileDateCreated: time.Now().Format("2006-01-02"),
+			SoftwareCompanyName:  "SENT",
+			SoftwareID:           "SENTcapital",
+			SoftwareVersion:      "1.0",
+			Company: AuditCompany{
+				RegistrationNumber:    strconv.Itoa(tnt.ID),
+				Name:                  tnt.Name,
+				TaxRegistrationNumber: tnt.TaxNumber,
+			},
+			DefaultCurrencyCode: tnt.FunctionalCurrency,
+			SelectionCriteria: AuditSelection{
+				SelectionStartDate: from.Format("2006-01-02"),
+				SelectionEndDate:   to.Add(-time.Nanosecond).Format("2006-01-02"),
+			},
+			TaxAccountingBasis: "Invoice",
+		},
+		SourceDocuments: AuditSourceDocuments{
+			SalesInvoices:    auditInvoices(sales, true),
+			PurchaseInvoices: auditInvoices(purchases, false),
+		},
+		MasterFiles: AuditMasterFiles{TaxTable: AuditTaxTable{
+			TaxType:     "VAT",
+			Description: "Value added tax",
+			Codes:       auditTaxTable(sales, purchases),
+		}},
+	}
+
+	if err := addLedger(ctx, db, f, tenantID, from, to); err != nil {
+		return nil, err
+	}
+
+	customers, err := db.Customer.Query().Where(customer.HasTenantWith(tenant.ID(tenantID))).Order(ent.Asc(customer.FieldCode)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range customers {
+		f.MasterFiles.Customers = append(f.MasterFiles.Customers, AuditCustomer{CustomerID: c.Code, Name: c.Name, TaxRegistrationNumber: c.TaxID, Country: c.CountryCode})
+	}
+	suppliers, err := db.Supplier.Query().Where(supplier.HasTenantWith(tenant.ID(tenantID))).Order(ent.Asc(supplier.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range suppliers {
+		f.MasterFiles.Suppliers = append(f.MasterFiles.Suppliers, AuditSupplier{SupplierID: strconv.Itoa(s.ID), Name: s.Name, TaxRegistrationNumber: s.TaxID, Country: s.CountryCode})
+	}
+	return f, nil
+}
+
+// addLedger adds the chart of accounts with its opening and closing balances, and the
+// approved transactions of the period with their entries.
+func addLedger(ctx context.Context, db *ent.Client, f *AuditFile, tenantID int, from, to time.Time) error {
+	accounts, err := db.Account.Query().Where(account.HasTenantWith(tenant.ID(tenantID))).Order(ent.Asc(account.FieldNumber)).All(ctx)
+	if err != nil {
+		return err
+	}
+	entries, err := db.LedgerEntry.Query().
+		Where(
+			ledgerentry.HasTenantWith(tenant.ID(tenantID)),
+			ledgerentry.HasTransactionWith(
+				transaction.ApprovalStatusEQ(transaction.ApprovalStatusAPPROVED),
+				transaction.DateLT(to),
+			),
+		).
+		WithAccount().
+		WithTransaction().
+		Order(ent.Asc(ledgerentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	opening := make(map[int]decimal.Decimal)
+	closing := make(map[int]decimal.Decimal)
+	txns := make(map[int]*AuditTransaction)
+	var order []int
+	debits, credits := decimal.Zero, decimal.Zero
+	for _, e := range entries {
+		txn, acc := e.Edges.Transaction, e.Edges.Account
+		if acc == nil {
+			continue
+		}
+		signed := e.Amount
+		if e.Direction == ledgerentry.DirectionCredit {
+			signed = signed.Neg()
+		}
+		closing[acc.ID] = closing[acc.ID].Add(signed)
+		if txn.Date.Before(from) {
+			opening[acc.ID] = opening[acc.ID].Add(signed)
+			continue
+		}
+
+		t, ok := txns[txn.ID]
+		if !ok {
+			t = &AuditTransaction{
+				TransactionID:    strconv.Itoa(txn.ID),
+				Period:           int(txn.Date.Month()),
+				PeriodYear:       txn.Date.Year(),
+				TransactionDate:  txn.Date.Format("2006-01-02"),
+				SourceDocumentID: txn.Reference,
+				Description:      txn.Description,
+				GLPostingDate:    txn.Date.Format("2006-01-02"),
+			}
+			txns[txn.ID] = t
+			order = append(order, txn.ID)
+		}
+		amount := &AuditAmount{Amount: e.Amount.String()}
+		if e.Currency != "" && e.Currency != f.Header.DefaultCurrencyCode {
+			amount.CurrencyCode = e.Currency
+			amount.CurrencyAmount = e.CurrencyAmount.String()
+			amount.ExchangeRate = e.ExchangeRate.String()
+		}
+		line := AuditLedgerLine{RecordID: strconv.Itoa(e.ID), AccountID: acc.Number}
+		if e.Direction == ledgerentry.DirectionDebit {
+			line.DebitAmount = amount
+			debits = debits.Add(e.Amount)
+		} else {
+			line.CreditAmount = amount
+			credits = credits.Add(e.Amount)
+		}
+		t.Lines = append(t.Lines, line)
+	}
+
+	for _, a := range accounts {
+		acc := AuditAccount{AccountID: a.Number, AccountDescription: a.Name, AccountType: string(a.Type)}
+		acc.OpeningDebitBalance, acc.OpeningCreditBalance = debitCredit(opening[a.ID])
+		acc.ClosingDebitBalance, acc.ClosingCreditBalance = debitCredit(closing[a.ID])
+		f.MasterFiles.Accounts = append(f.MasterFiles.Accounts, acc)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return txns[order[i]].TransactionDate < txns[order[j]].TransactionDate
+	})
+	gl := &f.GeneralLedgerEntries
+	gl.Journal = AuditJournal{JournalID: "GL", Description: "General ledger"}
+	for _, id := range order {
+		gl.Journal.Transactions = append(gl.Journal.Transactions, *txns[id])
+	}
+	gl.NumberOfEntries = len(order)
+	gl.TotalDebit, gl.TotalCredit = debits.String(), credits.String()
+	return nil
+}
+
+// debitCredit writes a balance, debits less credits, on the side it falls.
+func debitCredit(balance decimal.Decimal) (debit, credit string) {
+	if balance.IsNegative() {
+		return "", balance.Neg().String()
+	}
+	return balance.String(), ""
+}
+
+// auditInvoices groups register lines into documents, one tax line each. Sales are credits
+// and their adjustments debits; purchases the reverse.
+func auditInvoices(lines []RegisterLine, sales bool) AuditInvoices {
+	out := AuditInvoices{}
+	debits, credits := decimal.Zero, decimal.Zero
+	index := make(map[string]int)
+	for _, l := range lines {
+		i, ok := index[l.Document]
+		if !ok {
+			inv := AuditInvoice{InvoiceNo: l.Document, InvoiceDate: l.Date.Format("2006-01-02"), InvoiceType: l.Kind}
+			if sales {
+				inv.CustomerID = l.CounterpartyID
+			} else {
+				inv.SupplierID = l.CounterpartyID
+			}
+			out.Invoices = append(out.Invoices, inv)
+			i = len(out.Invoices) - 1
+			index[l.Document] = i
+		}
+		inv := &out.Invoices[i]
+		amount := AuditAmount{Amount: l.TaxFunctional.String()}
+		if l.ExchangeRate.Cmp(decimal.NewFromInt(1)) != 0 {
+			amount.CurrencyCode = l.Currency
+			amount.CurrencyAmount = l.Tax.String()
+			amount.ExchangeRate = l.ExchangeRate.String()
+		}
+		inv.Lines = append(inv.Lines, AuditInvoiceLine{
+			LineNumber:    len(inv.Lines) + 1,
+			TaxType:       "VAT",
+			TaxCode:       l.TaxCode,
+			TaxPercentage: l.Rate.Mul(decimal.NewFromInt(100)).String(),
+			TaxBase:       l.NetFunctional.String(),
+			TaxAmount:     amount,
+		})
+
+		gross := l.NetFunctional.Add(l.TaxFunctional)
+		if gross.IsNegative() == sales {
+			debits = debits.Add(gross.Abs())
+		} else {
+			credits = credits.Add(gross.Abs())
+		}
+	}
+	for i := range out.Invoices {
+		inv := &out.Invoices[i]
+		net, tax := decimal.Zero, decimal.Zero
+		for _, l := range inv.Lines {
+			net = net.Add(decimal.RequireFromString(l.TaxBase))
+			tax = tax.Add(decimal.RequireFromString(l.TaxAmount.Amount))
+		}
+		inv.DocumentTotals = AuditTotals{TaxPayable: tax.String(), NetTotal: net.String(), GrossTotal: net.Add(tax).String()}
+	}
+	out.NumberOfEntries = len(out.Invoices)
+	out.TotalDebit, out.TotalCredit = debits.String(), credits.String()
+	return out
+}
+
+// auditTaxTable lists the codes and rates the registers use.
+func auditTaxTable(registers ...[]RegisterLine) []AuditTaxCode {
+	seen := make(map[string]bool)
+	var out []AuditTaxCode
+	for _, lines := range registers {
+		for _, l := range lines {
+			pct := l.Rate.Mul(decimal.NewFromInt(100)).String()
+			if seen[l.TaxCode+"@"+pct] {
+				continue
+			}
+			seen[l.TaxCode+"@"+pct] = true
+			jurisdiction, _, _ := ParseCode(l.TaxCode)
+			out = append(out, AuditTaxCode{
+				TaxCode:       l.TaxCode,
+				Description:   fmt.Sprintf("VAT %s%%, %s", pct, l.Category),
+				TaxPercentage: pct,
+				Country:       jurisdiction,
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].TaxCode != out[j].TaxCode {
+			return out[i].TaxCode < out[j].TaxCode
+		}
+		return out[i].TaxPercentage < out[j].TaxPercentage
+	})
+	return out
+}
+
+// auditCountry is the jurisdiction most of the period's tax lines belong to.
+func auditCountry(registers ...[]RegisterLine) string {
+	counts := make(map[string]int)
+	best := ""
+	for _, lines := range registers {
+		for _, l := range lines {
+			j, _, err := ParseCode(l.TaxCode)
+			if err != nil {
+				continue
+			}
+			counts[j]++
+			if counts[j] > counts[best] || (counts[j] == counts[best] && j < best) {
+				best = j
+			}
+		}
+	}
+	return best
+}
+
+// Marshal returns the audit file as an XML document.
+func (f *AuditFile) Marshal() ([]byte, error) {
+	out, err := xml.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
@@ -2,13 +2,13 @@ package tax
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"sent/ent"
+	"sent/ent/taxjurisdiction"
 	"sent/ent/tenant"
-	"sent/ent/transaction"
 	"sent/pkg/auth"
+	"strings"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
@@ -27,10 +27,14 @@ type TaxBridge struct {
 	ctx  context.Context
 	db   *ent.Client
 	auth *auth.AuthBridge
+	// places gives the decimals of a currency; capital knows them but imports this package.
+	places func(currency string) int32
+	docs   DocumentStore
 }
 
 // TaxResult represents the breakdown of a tax calculation.
 type TaxResult struct {
+	Code      string  `json:"code"`
 	Subtotal  float64 `json:"subtotal"`
 	TaxRate   float64 `json:"taxRate"`
 	TaxAmount float64 `json:"taxAmount"`
@@ -46,9 +50,22 @@ type TaxConfig struct {
 
 // TaxSummaryDTO represents the data for a VAT-100 form.
 type TaxSummaryDTO struct {
-	Box1 float64 `json:"box1"` // Output Tax
-	Box4 float64 `json:"box4"` // Input Tax
-	Box5 float64 `json:"box5"` // Net Payable
+	Period         string    `json:"period"`
+	From           time.Time `json:"from"`
+	To             time.Time `json:"to"`
+	Box1           float64   `json:"box1"` // Output Tax
+	Box4           float64   `json:"box4"` // Input Tax
+	Box5           float64   `json:"box5"` // Net Payable
+	StandardSales  float64   `json:"standardSales"`
+	ZeroRatedSales float64   `json:"zeroRatedSales"`
+	ExemptSales    float64   `json:"exemptSales"`
+	Purchases      float64   `json:"purchases"`
+	// Credit notes and refunds, included in the sales above, and their tax.
+	SalesAdjustments  float64 `json:"salesAdjustments"`
+	OutputAdjustments float64 `json:"outputAdjustments"`
+	// VAT posted by journals rather than documents, included in Box1 and Box4.
+	OtherOutputTax float64 `json:"otherOutputTax"`
+	OtherInputTax  float64 `json:"otherInputTax"`
 }
 
 // NewTaxBridge initializes a new TaxBridge.
@@ -61,87 +78,171 @@ func (t *TaxBridge) Startup(ctx context.Context) {
 	t.ctx = ctx
 }
 
-// SignInvoice generates a cryptographic signature for an invoice XML.
-// This is required for compliance with ZATCA (KSA) and JoFotara (Jordan).
+// SetCurrencyPlaces connects the bridge to the currency table amounts are rounded by.
+func (t *TaxBridge) SetCurrencyPlaces(places func(currency string) int32) {
+	t.places = places
+}
+
+// currencyPlaces returns the decimals of a currency, two until SetCurrencyPlaces is called.
+func (t *TaxBridge) currencyPlaces(currency string) int32 {
+	if t.places == nil {
+		return 2
+	}
+	return t.places(currency)
+}
+
+// SignInvoice signs an unsigned UBL 2.1 invoice with the tenant's CSID, as ZATCA requires
+// in KSA. The document must already carry its ICV counter and PIH; invoices issued through
+// SENTcapital and the POS are chained and signed on their own.
 //
-// @param xmlData - The invoice data in XML format.
-// @returns A signature string.
+// @param xmlData - The unsigned invoice XML.
+// @returns The signed invoice XML, with its QR code embedded.
 func (t *TaxBridge) SignInvoice(xmlData string) (string, error) {
-	// TODO: In production, integrate with a real HSM or Key Management Service.
-	hash := sha256.Sum256([]byte(xmlData))
-	signature := hex.EncodeToString(hash[:])
-	return fmt.Sprintf("SENT-SIGNED-%s", signature), nil
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	cred, signer, err := activeSigner(t.ctx, t.db, profile.TenantID)
+	if err != nil {
+		return "", err
+	}
+	if cred == nil {
+		return "", fmt.Errorf("no e-invoicing certificate is set up")
+	}
+	signed, err := signer.Sign([]byte(xmlData), time.Now())
+	if err != nil {
+		return "", err
+	}
+	return string(signed.XML), nil
 }
 
-// CalculateTax computes the tax amount based on the country code.
+// CalculateTax computes the tax on an amount under a tax code, e.g. "VAT-JO" or "VAT-SA-Z",
+// at today's rate. An inclusive amount, such as a shelf price, is split into net and tax;
+// otherwise tax is added on top.
 //
-// @param amount - The base amount.
-// @param countryCode - The ISO country code (e.g., "JO", "SA").
+// @param amount - The base amount, net or tax-inclusive.
+// @param taxCode - The tax code, or a bare country code for its standard rate.
+// @param inclusive - Whether the amount already includes tax.
 // @returns The tax calculation result.
-func (t *TaxBridge) CalculateTax(amount float64, countryCode string) (TaxResult, error) {
-	rate := TaxRateJO // Default fallback
-
-	switch countryCode {
-	case "SA":
-		rate = TaxRateSA
-	case "AE":
-		rate = TaxRateAE
-	case "US":
-		rate = TaxRateUS
-	case "GB":
-		rate = TaxRateGB
-	}
-
-	taxAmount := amount * rate
-	
+func (t *TaxBridge) CalculateTax(amount float64, taxCode string, inclusive bool) (TaxResult, error) {
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return TaxResult{}, err
+	}
+	tnt, err := t.db.Tenant.Get(t.ctx, profile.TenantID)
+	if err != nil {
+		return TaxResult{}, err
+	}
+	if !strings.Contains(taxCode, "-") {
+		taxCode = CodeForCountry(taxCode)
+	}
+	rate, err := NewResolver(t.db, tnt.ID).Resolve(t.ctx, taxCode, time.Now())
+	if err != nil {
+		return TaxResult{}, err
+	}
+
+	places := t.currencyPlaces(tnt.FunctionalCurrency)
+	var net, tax decimal.Decimal
+	if inclusive {
+		net, tax = rate.Inclusive(decimal.NewFromFloat(amount).Round(places), places)
+	} else {
+		net = decimal.NewFromFloat(amount).Round(places)
+		tax = rate.Exclusive(net, places)
+	}
 	return TaxResult{
-		Subtotal:  amount,
-		TaxRate:   rate,
-		TaxAmount: taxAmount,
-		Total:     amount + taxAmount,
+		Code:      rate.Code,
+		Subtotal:  net.InexactFloat64(),
+		TaxRate:   rate.Rate.InexactFloat64(),
+		TaxAmount: tax.InexactFloat64(),
+		Total:     net.Add(tax).InexactFloat64(),
 	}, nil
 }
 
-// GetTaxConfig retrieves the supported tax configurations for various regions.
+// GetTaxConfig lists the jurisdictions the tenant can charge tax in with today's standard
+// rate: those it has set up, then the statutory ones it has not overridden.
 func (t *TaxBridge) GetTaxConfig() ([]TaxConfig, error) {
-	return []TaxConfig{
-		{CountryCode: "JO", DefaultRate: TaxRateJO, IsFiscal: true},
-		{CountryCode: "SA", DefaultRate: TaxRateSA, IsFiscal: true},
-		{CountryCode: "AE", DefaultRate: TaxRateAE, IsFiscal: true},
-		{CountryCode: "US", DefaultRate: TaxRateUS, IsFiscal: false},
-		{CountryCode: "GB", DefaultRate: TaxRateGB, IsFiscal: true},
-	}, nil
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	jurs, err := t.db.TaxJurisdiction.Query().
+		Where(taxjurisdiction.HasTenantWith(tenant.ID(profile.TenantID)), taxjurisdiction.IsActive(true)).
+		Order(ent.Asc(taxjurisdiction.FieldCode)).
+		All(t.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := NewResolver(t.db, profile.TenantID)
+	now := time.Now()
+	var out []TaxConfig
+	seen := make(map[string]bool)
+	for _, j := range jurs {
+		seen[j.Code] = true
+		cfg := TaxConfig{CountryCode: j.Code, IsFiscal: true}
+		if r, err := rates.Resolve(t.ctx, CodeForCountry(j.Code), now); err == nil {
+			cfg.DefaultRate = r.Rate.InexactFloat64()
+		}
+		out = append(out, cfg)
+	}
+	for _, code := range []string{"JO", "SA", "AE", "US", "GB"} {
+		if !seen[code] {
+			out = append(out, TaxConfig{CountryCode: code, DefaultRate: statutoryRates[code], IsFiscal: code != "US"})
+		}
+	}
+	return out, nil
 }
 
-// GetTaxSummary calculates the VAT boxes for the given period.
+// GetTaxSummary builds the VAT return for a period. Tax is what was posted to the output and
+// input VAT accounts in the period.
 func (t *TaxBridge) GetTaxSummary(period string) (*TaxSummaryDTO, error) {
 	profile, err := t.auth.GetUserProfile()
 	if err != nil {
 		return nil, err
 	}
-
-	// For simplicity, we'll just query all transactions for now.
-	// In a real app, we'd parse the period (e.g., 2026-Q1) to date ranges.
-	txs, err := t.db.Transaction.Query().
-		Where(transaction.HasTenantWith(tenant.ID(profile.TenantID))).
-		All(t.ctx)
+	tnt, err := t.db.Tenant.Get(t.ctx, profile.TenantID)
 	if err != nil {
 		return nil, err
 	}
 
-	var box1, box4 decimal.Decimal
-
-	for _, tx := range txs {
-		if tx.Type == "sale" {
-			box1 = box1.Add(tx.TaxAmount)
-		} else if tx.Type == "purchase" {
-			box4 = box4.Add(tx.TaxAmount)
-		}
+	period = strings.TrimSpace(period)
+	from, to, err := t.periodRange(tnt.ID, period)
+	if err != nil {
+		return nil, err
 	}
 
+	r, err := BuildVATReturn(t.ctx, t.db, tnt.ID, from, to, t.currencyPlaces(tnt.FunctionalCurrency))
+	if err != nil {
+		return nil, err
+	}
 	return &TaxSummaryDTO{
-		Box1: box1.InexactFloat64(),
-		Box4: box4.InexactFloat64(),
-		Box5: box1.Sub(box4).InexactFloat64(),
+		Period:         period,
+		From:           r.From,
+		To:             r.To.Add(-time.Nanosecond),
+		Box1:           r.OutputTax.InexactFloat64(),
+		Box4:           r.InputTax.InexactFloat64(),
+		Box5:           r.NetPayable().InexactFloat64(),
+		StandardSales:  r.StandardSales.InexactFloat64(),
+		ZeroRatedSales: r.ZeroRatedSales.InexactFloat64(),
+		ExemptSales:    r.ExemptSales.InexactFloat64(),
+		Purchases:      r.Purchases.InexactFloat64(),
+
+		SalesAdjustments:  r.SalesAdjustments.InexactFloat64(),
+		OutputAdjustments: r.OutputAdjustments.InexactFloat64(),
+		OtherOutputTax:    r.OtherOutputTax.InexactFloat64(),
+		OtherInputTax:     r.OtherInputTax.InexactFloat64(),
 	}, nil
 }
+
+// SetTaxNumber records the tenant's VAT registration number, printed on its invoices and
+// receipts and encoded in their QR codes.
+func (t *TaxBridge) SetTaxNumber(taxNumber string) error {
+	if !t.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change the tax number")
+	}
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	return t.db.Tenant.UpdateOneID(profile.TenantID).SetTaxNumber(strings.TrimSpace(taxNumber)).Exec(t.ctx)
+}
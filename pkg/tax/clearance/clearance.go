@@ -0,0 +1,221 @@
+// Package clearance submits signed e-invoices to government portals: ZATCA's Fatoora in
+// Saudi Arabia and ISTD's JoFotara in Jordan. Each portal is a Connector; Sandbox stands in
+// for both so the whole flow runs offline.
+package clearance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Authorities.
+const (
+	ZATCA    = "zatca"
+	JoFotara = "jofotara"
+)
+
+// EnvLocal is the in-process Sandbox, next to the portals' own environments.
+const EnvLocal = "local"
+
+// Status is what the portal made of a document.
+type Status string
+
+const (
+	StatusCleared  Status = "cleared"  // Approved before it goes to the buyer
+	StatusReported Status = "reported" // Recorded after the sale
+	StatusRejected Status = "rejected" // Failed validation; fix and issue a new document
+)
+
+// Message is one validation result the portal returned.
+type Message struct {
+	Type     string `json:"type"` // error, warning or info
+	Code     string `json:"code"`
+	Category string `json:"category,omitempty"`
+	Text     string `json:"message"`
+}
+
+// Result is the portal's answer to a submission.
+type Result struct {
+	Status    Status
+	Reference string    // The portal's ID for the document, where it gives one
+	Cleared   []byte    // The document as the authority stamped it; what the buyer gets
+	QR        string    // The authority's QR payload, where it replaces the seller's
+	Messages  []Message // Errors, warnings and notes, in the order the portal gave them
+}
+
+// Warnings returns the warning messages of a result.
+func (r *Result) Warnings() []Message {
+	var out []Message
+	for _, m := range r.Messages {
+		if m.Type == "warning" {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// Document is a signed e-invoice ready for the portal.
+type Document struct {
+	Number     string
+	UUID       string
+	Hash       string // Base64 SHA-256, as the document's signature carries it
+	XML        []byte
+	Simplified bool // Reported after the sale rather than cleared before it
+}
+
+// Credentials authenticate a taxpayer's unit with a portal.
+type Credentials struct {
+	Token     string // ZATCA: the binarySecurityToken of the CSID. JoFotara: the client ID.
+	Secret    string
+	RequestID string // ZATCA: the compliance request the production CSID was issued for
+}
+
+// Onboarding is what a unit brings to the portal to get credentials.
+type Onboarding struct {
+	CSR string // Base64 PEM request, from zatca.CreateCSR
+	OTP string // One-time password from the taxpayer's portal account
+
+	// Samples returns the compliance documents, signed with the compliance CSID it is
+	// given, that the portal checks before it issues a production CSID.
+	Samples func(cert string) ([]Document, error)
+
+	ClientID, Secret string // JoFotara issues these on its portal; there is no CSR
+}
+
+// Connector submits documents to one authority's portal.
+type Connector interface {
+	Authority() string
+	// Onboard exchanges a unit's request for the credentials it submits with.
+	Onboard(ctx context.Context, o Onboarding) (*Credentials, error)
+	// Submit clears or reports a document. Rejections are a Result, not an error; errors
+	// mean the portal never judged the document, and IsTransient says whether to retry.
+	Submit(ctx context.Context, c Credentials, doc Document) (*Result, error)
+}
+
+// For returns the connector of an authority in an environment. The local environment is
+// served by Local.
+func For(authority, environment string) (Connector, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	switch authority {
+	case ZATCA:
+		if environment == EnvLocal {
+			return NewZATCA(Local.URL()+"/zatca", Local.Client()), nil
+		}
+		base, ok := zatcaPortals[environment]
+		if !ok {
+			return nil, fmt.Errorf("ZATCA has no %q environment", environment)
+		}
+		return NewZATCA(base, client), nil
+	case JoFotara:
+		switch environment {
+		case EnvLocal:
+			return NewJoFotara(Local.URL()+"/jofotara", Local.Client()), nil
+		case "production":
+			return NewJoFotara(jofotaraPortal, client), nil
+		}
+		return nil, fmt.Errorf("JoFotara has no %q environment", environment)
+	}
+	return nil, fmt.Errorf("unknown e-invoicing authority %q", authority)
+}
+
+// Error is a submission the portal did not judge: it could not be reached, refused the
+// credentials, or failed on its side.
+type Error struct {
+	StatusCode int           // Zero when no response came back
+	Transient  bool          // Worth retrying as is
+	RetryAfter time.Duration // How long the portal asked to be left alone, if it said
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("portal answered %d: %v", e.StatusCode, e.Err)
+	}
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// IsTransient reports whether a failed submission may go through if retried unchanged:
+// timeouts, dropped connections, throttling and server errors. Everything else needs
+// someone to act first.
+func IsTransient(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Transient
+}
+
+// RetryAfter returns how long the portal asked to wait before retrying, or zero.
+func RetryAfter(err error) time.Duration {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.RetryAfter
+	}
+	return 0
+}
+
+// statusError classifies an HTTP status the connector has no other use for.
+func statusError(resp *http.Response, body []byte) *Error {
+	e := &Error{StatusCode: resp.StatusCode, Err: fmt.Errorf("%s", bytes.TrimSpace(truncate(body, 300)))}
+	switch resp.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests,
+		http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		e.Transient = true
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s > 0 {
+			e.RetryAfter = time.Duration(s) * time.Second
+		}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		e.Err = fmt.Errorf("credentials refused; onboard the unit again")
+	}
+	return e
+}
+
+func truncate(b []byte, n int) []byte {
+	if len(b) > n {
+		return b[:n]
+	}
+	return b
+}
+
+// post sends a JSON request and returns the response with its body read.
+func post(ctx context.Context, client *http.Client, endpoint string, header http.Header, body any) (*http.Response, []byte, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		// The request may or may not have arrived; a retry carries the same UUID, so the portal
+		// can tell it is the same document. A cancelled context is a worker shutting down.
+		inner := err
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			inner = urlErr.Err
+		}
+		var netErr net.Error
+		transient := errors.As(inner, &netErr) || errors.Is(inner, io.EOF) || errors.Is(inner, io.ErrUnexpectedEOF) ||
+			errors.Is(inner, context.DeadlineExceeded) || errors.Is(inner, context.Canceled)
+		return nil, nil, &Error{Transient: transient, Err: err}
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 10<<20))
+	if err != nil {
+		return nil, nil, &Error{StatusCode: resp.StatusCode, Transient: true, Err: err}
+	}
+	return resp, data, nil
+}
@@ -0,0 +1,208 @@
+package clearance
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"sent/pkg/tax/zatca"
+)
+
+var seller = zatca.Party{
+	Name: "Maximum Speed Tech Supply LTD", VATNumber: "399999999900003", RegistrationNumber: "1010010000",
+	Address: zatca.Address{Street: "Prince Sultan", Building: "2322", District: "Al-Murabba", City: "Riyadh", PostalCode: "23333", Country: "SA"},
+}
+
+// signAll signs documents as a chain, the way a unit issues them.
+func signAll(t *testing.T, cert, key string, docs []*zatca.Document, pih string) []Document {
+	t.Helper()
+	signer, err := zatca.NewSigner(cert, key)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	out := make([]Document, len(docs))
+	for i, d := range docs {
+		d.PreviousHash = pih
+		unsigned, err := d.Render()
+		if err != nil {
+			t.Fatalf("Render %s: %v", d.Number, err)
+		}
+		signed, err := signer.Sign(unsigned, d.Issued)
+		if err != nil {
+			t.Fatalf("Sign %s: %v", d.Number, err)
+		}
+		out[i] = Document{Number: d.Number, UUID: d.UUID, Hash: signed.Hash, XML: signed.XML, Simplified: d.Simplified}
+		pih = signed.Hash
+	}
+	return out
+}
+
+// onboard takes a fresh unit through the sandbox and returns its production credentials
+// and key.
+func onboard(t *testing.T, z *ZATCAConnector) (*Credentials, string) {
+	t.Helper()
+	key, err := zatca.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := zatca.CreateCSR(zatca.Unit{
+		CommonName: "TST-1", SerialNumber: "1-SENT|2-1.0|3-test", VATNumber: seller.VATNumber,
+		Organization: seller.Name, InvoiceType: "1100", Location: "Riyadh", Industry: "Retail",
+		Environment: zatca.EnvSandbox,
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := z.Onboard(context.Background(), Onboarding{CSR: csr, OTP: SandboxOTP, Samples: func(cert string) ([]Document, error) {
+		docs, err := zatca.ComplianceSamples(seller, "1100", time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return signAll(t, cert, key, docs, zatca.InitialHash), nil
+	}})
+	if err != nil {
+		t.Fatalf("Onboard: %v", err)
+	}
+	return creds, key
+}
+
+func TestZATCAOnboarding(t *testing.T) {
+	sb := NewSandbox("http://sandbox.test")
+	z := NewZATCA(sb.URL()+"/zatca", sb.Client())
+	ctx := context.Background()
+
+	key, _ := zatca.GenerateKey()
+	csr, _ := zatca.CreateCSR(zatca.Unit{
+		CommonName: "TST-1", SerialNumber: "1-SENT|2-1.0|3-test", VATNumber: seller.VATNumber,
+		Organization: seller.Name, InvoiceType: "1100", Environment: zatca.EnvSandbox,
+	}, key)
+	if _, err := z.Onboard(ctx, Onboarding{CSR: csr, OTP: "000000"}); err == nil || !strings.Contains(err.Error(), "OTP") {
+		t.Errorf("Onboard with a wrong OTP = %v", err)
+	}
+	if _, err := z.Onboard(ctx, Onboarding{CSR: csr, OTP: SandboxOTP}); err == nil || !strings.Contains(err.Error(), "compliance checks") {
+		t.Errorf("Onboard without compliance samples = %v", err)
+	}
+
+	creds, key := onboard(t, z)
+	cert, err := zatca.ParseCertificate(creds.Token)
+	if err != nil {
+		t.Fatalf("production CSID: %v", err)
+	}
+	if cert.Issuer != "CN=SENT-Sandbox-SubCA" || !strings.Contains(cert.Subject, "CN=TST-1") {
+		t.Errorf("production CSID issued by %q to %q", cert.Issuer, cert.Subject)
+	}
+	if _, err := zatca.NewSigner(creds.Token, key); err != nil {
+		t.Errorf("production CSID does not match the key: %v", err)
+	}
+}
+
+func TestZATCASubmit(t *testing.T) {
+	sb := NewSandbox("http://sandbox.test")
+	z := NewZATCA(sb.URL()+"/zatca", sb.Client())
+	ctx := context.Background()
+	creds, key := onboard(t, z)
+
+	samples, _ := zatca.ComplianceSamples(seller, "1100", time.Now())
+	docs := signAll(t, creds.Token, key, []*zatca.Document{samples[0], samples[3]}, zatca.InitialHash)
+	standard, simplified := docs[0], docs[1]
+
+	res, err := z.Submit(ctx, *creds, standard)
+	if err != nil || res.Status != StatusCleared || !bytes.Equal(res.Cleared, standard.XML) {
+		t.Fatalf("clearance = %+v, %v", res, err)
+	}
+	res, err = z.Submit(ctx, *creds, simplified)
+	if err != nil || res.Status != StatusReported || len(res.Warnings()) != 0 {
+		t.Fatalf("reporting = %+v, %v", res, err)
+	}
+	// A retry of a document that went through gets the same answer.
+	if res, err = z.Submit(ctx, *creds, simplified); err != nil || res.Status != StatusReported {
+		t.Errorf("resubmission = %+v, %v", res, err)
+	}
+
+	// Out of chain: accepted with a warning.
+	more, _ := zatca.ComplianceSamples(seller, "0100", time.Now())
+	stray := signAll(t, creds.Token, key, more[:1], zatca.InitialHash)[0]
+	res, err = z.Submit(ctx, *creds, stray)
+	if err != nil || res.Status != StatusReported || len(res.Warnings()) != 1 || res.Warnings()[0].Code != "KSA-13" {
+		t.Errorf("broken chain = %+v, %v", res, err)
+	}
+
+	// Tampered: rejected with reasons, not an error.
+	forged := stray
+	forged.UUID = "00000000-0000-0000-0000-000000000000"
+	forged.XML = bytes.Replace(stray.XML, []byte(stray.UUID), []byte(forged.UUID), 1)
+	res, err = z.Submit(ctx, *creds, forged)
+	if err != nil || res.Status != StatusRejected || !strings.Contains(summary(res.Messages), "invoiceHash") {
+		t.Errorf("tampered = %+v, %v", res, err)
+	}
+
+	// Clearance switched off: standard invoices are reported.
+	sb.SetClearance(false)
+	late := signAll(t, creds.Token, key, []*zatca.Document{samples[1]}, stray.Hash)[0]
+	if res, err = z.Submit(ctx, *creds, late); err != nil || res.Status != StatusReported {
+		t.Errorf("with clearance off = %+v, %v", res, err)
+	}
+}
+
+func TestRetryClassification(t *testing.T) {
+	sb := NewSandbox("http://sandbox.test")
+	z := NewZATCA(sb.URL()+"/zatca", sb.Client())
+	ctx := context.Background()
+	doc := Document{UUID: "x", Hash: "y", XML: []byte("<Invoice/>")}
+
+	cases := []struct {
+		status    int
+		transient bool
+	}{
+		{http.StatusServiceUnavailable, true},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusUnauthorized, false},
+		{http.StatusNotFound, false},
+	}
+	for _, c := range cases {
+		sb.Fail(c.status)
+		_, err := z.Submit(ctx, Credentials{}, doc)
+		if err == nil || IsTransient(err) != c.transient {
+			t.Errorf("answer %d: err = %v, transient %v", c.status, err, IsTransient(err))
+		}
+		if c.status == http.StatusTooManyRequests && RetryAfter(err) != 2*time.Second {
+			t.Errorf("answer 429: retry after %s", RetryAfter(err))
+		}
+	}
+
+	// No portal at all.
+	down := NewZATCA("http://127.0.0.1:1", &http.Client{Timeout: time.Second})
+	if _, err := down.Submit(ctx, Credentials{}, doc); !IsTransient(err) {
+		t.Errorf("unreachable portal: err = %v, want transient", err)
+	}
+}
+
+func TestJoFotaraSubmit(t *testing.T) {
+	sb := NewSandbox("http://sandbox.test")
+	sb.AddJoFotaraClient("client", "secret")
+	j := NewJoFotara(sb.URL()+"/jofotara", sb.Client())
+	ctx := context.Background()
+
+	creds, err := j.Onboard(ctx, Onboarding{ClientID: "client", Secret: "secret"})
+	if err != nil {
+		t.Fatalf("Onboard: %v", err)
+	}
+	doc := Document{
+		Number: "INV-1", UUID: "6d5e1c7a-0d1f-4b3e-9c84-b6bf0a5ad2f1",
+		XML: []byte(`<Invoice xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"><cbc:ID>INV-1</cbc:ID><cbc:UUID>6d5e1c7a-0d1f-4b3e-9c84-b6bf0a5ad2f1</cbc:UUID></Invoice>`),
+	}
+	res, err := j.Submit(ctx, *creds, doc)
+	if err != nil || res.Status != StatusCleared || res.QR == "" || res.Reference != "INV-1" {
+		t.Fatalf("Submit = %+v, %v", res, err)
+	}
+	if res, err = j.Submit(ctx, *creds, doc); err != nil || res.Status != StatusRejected || res.Messages[0].Code != "DUPLICATE_INVOICE" {
+		t.Errorf("duplicate = %+v, %v", res, err)
+	}
+	if _, err := j.Submit(ctx, Credentials{Token: "client", Secret: "wrong"}, doc); err == nil || IsTransient(err) {
+		t.Errorf("wrong secret: err = %v, want permanent", err)
+	}
+}
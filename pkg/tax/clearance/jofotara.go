@@ -0,0 +1,99 @@
+package clearance
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const jofotaraPortal = "https://backend.jofotara.gov.jo"
+
+// JoFotaraConnector speaks to Jordan's national e-invoicing system. JoFotara clears every
+// document it accepts, signs it itself and returns it with its QR code.
+type JoFotaraConnector struct {
+	base   string
+	client *http.Client
+}
+
+// NewJoFotara returns a connector for the JoFotara API at base.
+func NewJoFotara(base string, client *http.Client) *JoFotaraConnector {
+	return &JoFotaraConnector{base: strings.TrimRight(base, "/"), client: client}
+}
+
+func (j *JoFotaraConnector) Authority() string { return JoFotara }
+
+type jofotaraMessage struct {
+	Type     string `json:"type"`
+	Status   string `json:"status"`
+	Code     string `json:"EINV_CODE"`
+	Category string `json:"EINV_CATEGORY"`
+	Message  string `json:"EINV_MESSAGE"`
+}
+
+type jofotaraResponse struct {
+	Results struct {
+		Status   string            `json:"status"`
+		Info     []jofotaraMessage `json:"INFO"`
+		Warnings []jofotaraMessage `json:"WARNINGS"`
+		Errors   []jofotaraMessage `json:"ERRORS"`
+	} `json:"EINV_RESULTS"`
+	Status string `json:"EINV_STATUS"`
+	Signed string `json:"EINV_SINGED_INVOICE"` // Sic
+	QR     string `json:"EINV_QR"`
+	UUID   string `json:"EINV_INV_UUID"`
+	Number string `json:"EINV_NUM"`
+}
+
+// Onboard checks the credentials are there. JoFotara has no CSR step: the taxpayer
+// generates the client ID and secret key for the device on the JoFotara portal.
+func (j *JoFotaraConnector) Onboard(ctx context.Context, o Onboarding) (*Credentials, error) {
+	if o.ClientID == "" || o.Secret == "" {
+		return nil, fmt.Errorf("enter the client ID and secret key from the JoFotara portal")
+	}
+	return &Credentials{Token: o.ClientID, Secret: o.Secret}, nil
+}
+
+// Submit sends a document for clearance.
+func (j *JoFotaraConnector) Submit(ctx context.Context, c Credentials, doc Document) (*Result, error) {
+	h := http.Header{}
+	h.Set("Client-Id", c.Token)
+	h.Set("Secret-Key", c.Secret)
+	body := map[string]string{"invoice": base64.StdEncoding.EncodeToString(doc.XML)}
+	resp, data, err := post(ctx, j.client, j.base+"/core/invoices/", h, body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusBadRequest:
+	default:
+		return nil, statusError(resp, data)
+	}
+
+	var out jofotaraResponse
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, &Error{StatusCode: resp.StatusCode, Err: fmt.Errorf("unexpected answer: %s", truncate(data, 300))}
+	}
+	res := &Result{Status: StatusCleared, Reference: out.Number, QR: out.QR}
+	r := out.Results
+	for _, group := range []struct {
+		kind string
+		msgs []jofotaraMessage
+	}{{"error", r.Errors}, {"warning", r.Warnings}, {"info", r.Info}} {
+		for _, m := range group.msgs {
+			res.Messages = append(res.Messages, Message{Type: group.kind, Code: m.Code, Category: m.Category, Text: m.Message})
+		}
+	}
+	if resp.StatusCode == http.StatusBadRequest || out.Status != "SUBMITTED" {
+		res.Status = StatusRejected
+		return res, nil
+	}
+	if out.Signed != "" {
+		if res.Cleared, err = base64.StdEncoding.DecodeString(out.Signed); err != nil {
+			return nil, &Error{StatusCode: resp.StatusCode, Err: fmt.Errorf("signed invoice is not base64")}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,460 @@
+package clearance
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"sent/pkg/tax/zatca"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// SandboxOTP is the one-time password the sandbox takes, the same one ZATCA's developer
+// portal does.
+const SandboxOTP = "123345"
+
+// Local is the process's own sandbox, behind the "local" environment. It keeps what it is
+// sent in memory, so a restart starts it afresh.
+var Local = NewSandbox("http://sandbox.local")
+
+// Sandbox stands in for the Fatoora and JoFotara portals. It issues CSIDs from its own CA
+// and checks documents the way the portals do where it can: signatures, hashes, the PIH
+// chain and duplicate UUIDs. Nothing it accepts has legal effect.
+type Sandbox struct {
+	url string
+	ca  *secp256k1.PrivateKey
+
+	mu           sync.Mutex
+	serial       int64
+	units        map[string]*sandboxUnit  // By binarySecurityToken
+	submitted    map[string]sandboxAnswer // By document UUID
+	lastHash     map[string]string        // By VAT number
+	jofotara     map[string]string        // Client ID to secret; nil takes any
+	faults       []int
+	clearanceOff bool
+}
+
+type sandboxUnit struct {
+	secret     string
+	cert       *zatca.Certificate
+	csr        *zatca.CSR
+	compliance bool
+	requestID  string
+	checked    int // Compliance documents that passed
+}
+
+type sandboxAnswer struct {
+	hash   string
+	status int
+	body   []byte
+}
+
+// NewSandbox returns an empty sandbox that calls itself url.
+func NewSandbox(url string) *Sandbox {
+	ca, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		panic(err)
+	}
+	return &Sandbox{
+		url:       url,
+		ca:        ca,
+		serial:    1000,
+		units:     make(map[string]*sandboxUnit),
+		submitted: make(map[string]sandboxAnswer),
+		lastHash:  make(map[string]string),
+	}
+}
+
+// URL is the base of the sandbox's Fatoora (/zatca) and JoFotara (/jofotara) APIs.
+func (s *Sandbox) URL() string { return s.url }
+
+// Client returns an HTTP client that serves requests from the sandbox in process.
+func (s *Sandbox) Client() *http.Client {
+	return &http.Client{Transport: sandboxTransport{s}}
+}
+
+type sandboxTransport struct{ s *Sandbox }
+
+func (t sandboxTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := r.Context().Err(); err != nil {
+		return nil, err
+	}
+	rec := httptest.NewRecorder()
+	t.s.ServeHTTP(rec, r)
+	return rec.Result(), nil
+}
+
+// Fail makes the sandbox answer its next requests with these statuses, in order.
+func (s *Sandbox) Fail(statuses ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, statuses...)
+}
+
+// SetClearance turns clearance on or off. With it off, standard invoices sent for clearance
+// are answered 303, as the portal does when ZATCA suspends clearance.
+func (s *Sandbox) SetClearance(on bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clearanceOff = !on
+}
+
+// AddJoFotaraClient registers JoFotara credentials. Until the first is added, any client ID
+// and secret are taken.
+func (s *Sandbox) AddJoFotaraClient(id, secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.jofotara == nil {
+		s.jofotara = make(map[string]string)
+	}
+	s.jofotara[id] = secret
+}
+
+func (s *Sandbox) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.faults) > 0 {
+		status := s.faults[0]
+		s.faults = s.faults[1:]
+		if status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+			w.Header().Set("Retry-After", "2")
+		}
+		writeJSON(w, status, map[string]string{"message": "sandbox fault"})
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"message": "POST only"})
+		return
+	}
+	switch r.URL.Path {
+	case "/zatca/compliance":
+		s.complianceCSID(w, r)
+	case "/zatca/production/csids":
+		s.productionCSID(w, r)
+	case "/zatca/compliance/invoices":
+		s.zatcaSubmit(w, r, "compliance")
+	case "/zatca/invoices/clearance/single":
+		s.zatcaSubmit(w, r, "clearance")
+	case "/zatca/invoices/reporting/single":
+		s.zatcaSubmit(w, r, "reporting")
+	case "/jofotara/core/invoices/":
+		s.jofotaraSubmit(w, r)
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "no such endpoint"})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	data, _ := json.Marshal(body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+func randomSecret() string {
+	b := make([]byte, 24)
+	rand.Read(b)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// issue signs a CSID for a request and registers the unit it belongs to.
+func (s *Sandbox) issue(csr *zatca.CSR, compliance bool) (*sandboxUnit, string, error) {
+	s.serial++
+	now := time.Now()
+	cert, err := zatca.IssueCertificate(csr, "SENT-Sandbox-SubCA", s.ca, big.NewInt(s.serial), now.Add(-time.Hour), now.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, "", err
+	}
+	u := &sandboxUnit{secret: randomSecret(), cert: cert, csr: csr, compliance: compliance, requestID: fmt.Sprint(s.serial)}
+	token := base64.StdEncoding.EncodeToString([]byte(cert.Base64()))
+	s.units[token] = u
+	return u, token, nil
+}
+
+// unit returns the unit whose CSID authenticated the request.
+func (s *Sandbox) unit(r *http.Request) *sandboxUnit {
+	token, secret, ok := r.BasicAuth()
+	if !ok {
+		return nil
+	}
+	if u := s.units[token]; u != nil && u.secret == secret {
+		return u
+	}
+	return nil
+}
+
+func (s *Sandbox) complianceCSID(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("OTP") != SandboxOTP {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"code": "Invalid-OTP", "message": "The provided OTP is invalid"})
+		return
+	}
+	var body struct {
+		CSR string `json:"csr"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"code": "Invalid-Request", "message": err.Error()})
+		return
+	}
+	csr, err := zatca.ParseCSR(body.CSR)
+	if err == nil && (csr.Template == "" || csr.VATNumber == "") {
+		err = fmt.Errorf("the CSR names no certificate template or VAT number")
+	}
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"code": "Invalid-CSR", "message": err.Error()})
+		return
+	}
+	u, token, err := s.issue(csr, true)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"message": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"requestID":           json.Number(u.requestID),
+		"dispositionMessage":  "ISSUED",
+		"binarySecurityToken": token,
+		"secret":              u.secret,
+	})
+}
+
+func (s *Sandbox) productionCSID(w http.ResponseWriter, r *http.Request) {
+	u := s.unit(r)
+	if u == nil || !u.compliance {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "Unauthorized"})
+		return
+	}
+	var body struct {
+		RequestID string `json:"compliance_request_id"`
+	}
+	json.NewDecoder(r.Body).Decode(&body)
+	switch {
+	case body.RequestID != u.requestID:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"code": "Invalid-Request-ID", "message": "No compliance request with this ID"})
+		return
+	case u.checked == 0:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"code": "Missing-ComplianceSteps", "message": "The compliance checks have not been completed"})
+		return
+	}
+	prod, token, err := s.issue(u.csr, false)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"message": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"requestID":           json.Number(prod.requestID),
+		"dispositionMessage":  "ISSUED",
+		"binarySecurityToken": token,
+		"secret":              prod.secret,
+	})
+}
+
+// sandboxInvoice is what the sandbox reads of a UBL document.
+type sandboxInvoice struct {
+	ID       string `xml:"ID"`
+	UUID     string `xml:"UUID"`
+	TypeCode struct {
+		Name string `xml:"name,attr"`
+	} `xml:"InvoiceTypeCode"`
+	References []struct {
+		ID     string `xml:"ID"`
+		Object string `xml:"Attachment>EmbeddedDocumentBinaryObject"`
+	} `xml:"AdditionalDocumentReference"`
+}
+
+func (inv *sandboxInvoice) reference(id string) string {
+	for _, r := range inv.References {
+		if r.ID == id {
+			return strings.TrimSpace(r.Object)
+		}
+	}
+	return ""
+}
+
+type sandboxMessage struct {
+	Type     string `json:"type"`
+	Code     string `json:"code"`
+	Category string `json:"category"`
+	Message  string `json:"message"`
+	Status   string `json:"status"`
+}
+
+func (s *Sandbox) zatcaSubmit(w http.ResponseWriter, r *http.Request, mode string) {
+	u := s.unit(r)
+	if u == nil || u.compliance != (mode == "compliance") {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "Unauthorized"})
+		return
+	}
+	var body struct {
+		Hash    string `json:"invoiceHash"`
+		UUID    string `json:"uuid"`
+		Invoice string `json:"invoice"`
+	}
+	json.NewDecoder(r.Body).Decode(&body)
+
+	var errs, warnings []sandboxMessage
+	fail := func(code, category, msg string) {
+		errs = append(errs, sandboxMessage{"ERROR", code, category, msg, "ERROR"})
+	}
+	var inv sandboxInvoice
+	doc, err := base64.StdEncoding.DecodeString(body.Invoice)
+	if err == nil {
+		err = xml.Unmarshal(doc, &inv)
+	}
+	if err != nil {
+		fail("invalid-invoice", "XSD validation", "The invoice is not base64 encoded UBL XML")
+	}
+	simplified := strings.HasPrefix(inv.TypeCode.Name, "02")
+
+	if err == nil {
+		switch {
+		case mode == "clearance" && simplified:
+			fail("invoiceTypeCode", "BR_KSA", "Simplified invoices are reported, not cleared")
+		case mode == "reporting" && !simplified && !s.clearanceOff:
+			fail("invoiceTypeCode", "BR_KSA", "Standard invoices are cleared, not reported")
+		case mode == "clearance" && s.clearanceOff:
+			writeJSON(w, http.StatusSeeOther, map[string]string{"message": "Clearance is deactivated. Please use the reporting endpoint"})
+			return
+		}
+		if inv.UUID != body.UUID {
+			fail("uuid", "BR_KSA", "The uuid does not match the invoice's UUID")
+		}
+		if hash, err := zatca.InvoiceHash(doc); err != nil || hash != body.Hash {
+			fail("invoiceHash", "BR_KSA", "The invoiceHash does not match the hash of the invoice")
+		}
+		if err := zatca.Verify(doc); err != nil {
+			fail("signature", "BR_KSA", err.Error())
+		}
+		if !bytes.Contains(doc, []byte(u.cert.Base64())) {
+			fail("certificate", "BR_KSA", "The invoice is not signed with the CSID it was sent with")
+		}
+		if mode != "compliance" {
+			if last := s.lastHash[u.csr.VATNumber]; last != "" && inv.reference("PIH") != last {
+				warnings = append(warnings, sandboxMessage{"WARNING", "KSA-13", "BR_KSA", "The PIH is not the hash of the previous invoice", "WARNING"})
+			}
+		}
+	}
+
+	if mode != "compliance" && len(errs) == 0 {
+		if prev, ok := s.submitted[body.UUID]; ok {
+			if prev.hash == body.Hash {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(prev.status)
+				w.Write(prev.body)
+				return
+			}
+			writeJSON(w, http.StatusConflict, map[string]any{"validationResults": map[string]any{
+				"status":        "ERROR",
+				"errorMessages": []sandboxMessage{{"ERROR", "uuid", "BR_KSA", "A different invoice was already submitted with this UUID", "ERROR"}},
+			}})
+			return
+		}
+	}
+
+	status, verdict := http.StatusOK, "PASS"
+	switch {
+	case len(errs) > 0:
+		status, verdict = http.StatusBadRequest, "ERROR"
+	case len(warnings) > 0:
+		status, verdict = http.StatusAccepted, "WARNING"
+	}
+	answer := map[string]any{"validationResults": map[string]any{
+		"infoMessages":    []sandboxMessage{{"INFO", "XSD_ZATCA_VALID", "XSD validation", "Complied with UBL 2.1 standards in line with ZATCA specifications", "PASS"}},
+		"warningMessages": warnings,
+		"errorMessages":   errs,
+		"status":          verdict,
+	}}
+	cleared := mode == "clearance" || (mode == "compliance" && !simplified)
+	switch {
+	case cleared && len(errs) > 0:
+		answer["clearanceStatus"] = "NOT_CLEARED"
+	case cleared:
+		answer["clearanceStatus"] = "CLEARED"
+		answer["clearedInvoice"] = body.Invoice
+	case len(errs) > 0:
+		answer["reportingStatus"] = "NOT_REPORTED"
+	default:
+		answer["reportingStatus"] = "REPORTED"
+	}
+	data, _ := json.Marshal(answer)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+
+	if len(errs) > 0 {
+		return
+	}
+	if mode == "compliance" {
+		u.checked++
+		return
+	}
+	s.submitted[body.UUID] = sandboxAnswer{hash: body.Hash, status: status, body: data}
+	s.lastHash[u.csr.VATNumber] = body.Hash
+}
+
+type jofotaraMessageOut struct {
+	Type     string `json:"type"`
+	Status   string `json:"status"`
+	Code     string `json:"EINV_CODE"`
+	Category string `json:"EINV_CATEGORY"`
+	Message  string `json:"EINV_MESSAGE"`
+}
+
+func (s *Sandbox) jofotaraSubmit(w http.ResponseWriter, r *http.Request) {
+	id, secret := r.Header.Get("Client-Id"), r.Header.Get("Secret-Key")
+	if id == "" || secret == "" || (s.jofotara != nil && s.jofotara[id] != secret) {
+		writeJSON(w, http.StatusForbidden, map[string]string{"message": "Invalid Client-Id or Secret-Key"})
+		return
+	}
+	var body struct {
+		Invoice string `json:"invoice"`
+	}
+	json.NewDecoder(r.Body).Decode(&body)
+
+	var errs []jofotaraMessageOut
+	var inv sandboxInvoice
+	doc, err := base64.StdEncoding.DecodeString(body.Invoice)
+	if err == nil {
+		err = xml.Unmarshal(doc, &inv)
+	}
+	switch {
+	case err != nil:
+		errs = append(errs, jofotaraMessageOut{"ERROR", "ERROR", "XSD_INVALID", "XSD validation", "The invoice is not base64 encoded UBL XML"})
+	case inv.UUID == "":
+		errs = append(errs, jofotaraMessageOut{"ERROR", "ERROR", "UUID_MISSING", "Invoice", "The invoice has no UUID"})
+	default:
+		if _, dup := s.submitted[inv.UUID]; dup {
+			errs = append(errs, jofotaraMessageOut{"ERROR", "ERROR", "DUPLICATE_INVOICE", "Invoice", "An invoice with this UUID was already submitted"})
+		}
+	}
+
+	results := map[string]any{
+		"status":   "PASS",
+		"INFO":     []jofotaraMessageOut{{"INFO", "PASS", "XSD_VALID", "XSD validation", "Complied with UBL 2.1 standards"}},
+		"WARNINGS": []jofotaraMessageOut{},
+		"ERRORS":   errs,
+	}
+	if len(errs) > 0 {
+		results["status"] = "ERROR"
+		writeJSON(w, http.StatusBadRequest, map[string]any{"EINV_RESULTS": results, "EINV_STATUS": "NOT_SUBMITTED"})
+		return
+	}
+	s.submitted[inv.UUID] = sandboxAnswer{}
+	qr := base64.StdEncoding.EncodeToString([]byte("JoFotara sandbox " + inv.UUID))
+	writeJSON(w, http.StatusOK, map[string]any{
+		"EINV_RESULTS":        results,
+		"EINV_STATUS":         "SUBMITTED",
+		"EINV_SINGED_INVOICE": body.Invoice,
+		"EINV_QR":             qr,
+		"EINV_NUM":            inv.ID,
+		"EINV_INV_UUID":       inv.UUID,
+	})
+}
@@ -0,0 +1,198 @@
+package clearance
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// The Fatoora portal's environments. The developer portal takes any well-formed request and
+// OTP 123345; simulation behaves like production without legal effect.
+var zatcaPortals = map[string]string{
+	"sandbox":    "https://gw-fatoora.zatca.gov.sa/e-invoicing/developer-portal",
+	"simulation": "https://gw-fatoora.zatca.gov.sa/e-invoicing/simulation",
+	"production": "https://gw-fatoora.zatca.gov.sa/e-invoicing/core",
+}
+
+// ZATCAConnector speaks version 2 of the Fatoora e-invoicing API.
+type ZATCAConnector struct {
+	base   string
+	client *http.Client
+}
+
+// NewZATCA returns a connector for the Fatoora API at base.
+func NewZATCA(base string, client *http.Client) *ZATCAConnector {
+	return &ZATCAConnector{base: strings.TrimRight(base, "/"), client: client}
+}
+
+func (z *ZATCAConnector) Authority() string { return ZATCA }
+
+type zatcaCSIDResponse struct {
+	RequestID           json.Number `json:"requestID"`
+	DispositionMessage  string      `json:"dispositionMessage"`
+	BinarySecurityToken string      `json:"binarySecurityToken"`
+	Secret              string      `json:"secret"`
+}
+
+type zatcaValidation struct {
+	Type     string `json:"type"`
+	Code     string `json:"code"`
+	Category string `json:"category"`
+	Message  string `json:"message"`
+}
+
+type zatcaSubmitResponse struct {
+	ValidationResults struct {
+		InfoMessages    []zatcaValidation `json:"infoMessages"`
+		WarningMessages []zatcaValidation `json:"warningMessages"`
+		ErrorMessages   []zatcaValidation `json:"errorMessages"`
+		Status          string            `json:"status"`
+	} `json:"validationResults"`
+	ClearanceStatus string `json:"clearanceStatus"`
+	ReportingStatus string `json:"reportingStatus"`
+	ClearedInvoice  string `json:"clearedInvoice"`
+}
+
+func (z *ZATCAConnector) header(c *Credentials) http.Header {
+	h := http.Header{}
+	h.Set("Accept-Version", "V2")
+	h.Set("Accept-Language", "en")
+	if c != nil {
+		basic := base64.StdEncoding.EncodeToString([]byte(c.Token + ":" + c.Secret))
+		h.Set("Authorization", "Basic "+basic)
+	}
+	return h
+}
+
+// Onboard runs ZATCA's onboarding: the CSR and OTP get a compliance CSID, the samples are
+// checked with it, and the compliance request is then exchanged for a production CSID.
+func (z *ZATCAConnector) Onboard(ctx context.Context, o Onboarding) (*Credentials, error) {
+	if o.CSR == "" || o.OTP == "" {
+		return nil, fmt.Errorf("onboarding needs a CSR and the OTP from the Fatoora portal")
+	}
+	h := z.header(nil)
+	h.Set("OTP", o.OTP)
+	compliance, err := z.csid(ctx, "/compliance", h, map[string]string{"csr": o.CSR})
+	if err != nil {
+		return nil, fmt.Errorf("compliance CSID not issued: %w", err)
+	}
+
+	if o.Samples != nil {
+		docs, err := o.Samples(compliance.Token)
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			res, err := z.submit(ctx, "/compliance/invoices", compliance, doc, false)
+			if err != nil {
+				return nil, fmt.Errorf("compliance check of %s failed: %w", doc.Number, err)
+			}
+			if res.Status == StatusRejected {
+				return nil, fmt.Errorf("compliance check of %s failed: %s", doc.Number, summary(res.Messages))
+			}
+		}
+	}
+
+	prod, err := z.csid(ctx, "/production/csids", z.header(compliance), map[string]string{"compliance_request_id": compliance.RequestID})
+	if err != nil {
+		return nil, fmt.Errorf("production CSID not issued: %w", err)
+	}
+	return prod, nil
+}
+
+func (z *ZATCAConnector) csid(ctx context.Context, path string, h http.Header, body any) (*Credentials, error) {
+	resp, data, err := post(ctx, z.client, z.base+path, h, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusBadRequest {
+		return nil, fmt.Errorf("%s", strings.TrimSpace(string(truncate(data, 300))))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, statusError(resp, data)
+	}
+	var out zatcaCSIDResponse
+	if err := json.Unmarshal(data, &out); err != nil || out.BinarySecurityToken == "" {
+		return nil, fmt.Errorf("unexpected answer: %s", truncate(data, 300))
+	}
+	return &Credentials{Token: out.BinarySecurityToken, Secret: out.Secret, RequestID: out.RequestID.String()}, nil
+}
+
+// Submit clears a standard invoice or reports a simplified one. When clearance is switched
+// off for the taxpayer the portal answers 303, and standard invoices are reported instead.
+func (z *ZATCAConnector) Submit(ctx context.Context, c Credentials, doc Document) (*Result, error) {
+	if doc.Simplified {
+		return z.submit(ctx, "/invoices/reporting/single", &c, doc, false)
+	}
+	res, err := z.submit(ctx, "/invoices/clearance/single", &c, doc, true)
+	if e, ok := err.(*Error); ok && e.StatusCode == http.StatusSeeOther {
+		return z.submit(ctx, "/invoices/reporting/single", &c, doc, false)
+	}
+	return res, err
+}
+
+func (z *ZATCAConnector) submit(ctx context.Context, path string, c *Credentials, doc Document, clearance bool) (*Result, error) {
+	h := z.header(c)
+	if clearance {
+		h.Set("Clearance-Status", "1")
+	}
+	body := map[string]string{
+		"invoiceHash": doc.Hash,
+		"uuid":        doc.UUID,
+		"invoice":     base64.StdEncoding.EncodeToString(doc.XML),
+	}
+	resp, data, err := post(ctx, z.client, z.base+path, h, body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusBadRequest, http.StatusConflict:
+	default:
+		return nil, statusError(resp, data)
+	}
+
+	var out zatcaSubmitResponse
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, &Error{StatusCode: resp.StatusCode, Err: fmt.Errorf("unexpected answer: %s", truncate(data, 300))}
+	}
+	res := &Result{Status: StatusReported}
+	v := out.ValidationResults
+	for _, group := range []struct {
+		kind string
+		msgs []zatcaValidation
+	}{{"error", v.ErrorMessages}, {"warning", v.WarningMessages}, {"info", v.InfoMessages}} {
+		for _, m := range group.msgs {
+			res.Messages = append(res.Messages, Message{Type: group.kind, Code: m.Code, Category: m.Category, Text: m.Message})
+		}
+	}
+	switch {
+	case resp.StatusCode == http.StatusBadRequest, resp.StatusCode == http.StatusConflict,
+		out.ClearanceStatus == "NOT_CLEARED", out.ReportingStatus == "NOT_REPORTED":
+		res.Status = StatusRejected
+	case out.ClearanceStatus == "CLEARED":
+		res.Status = StatusCleared
+	}
+	if out.ClearedInvoice != "" {
+		if res.Cleared, err = base64.StdEncoding.DecodeString(out.ClearedInvoice); err != nil {
+			return nil, &Error{StatusCode: resp.StatusCode, Err: fmt.Errorf("cleared invoice is not base64")}
+		}
+	}
+	return res, nil
+}
+
+// summary joins the errors of a rejection into one line.
+func summary(msgs []Message) string {
+	var parts []string
+	for _, m := range msgs {
+		if m.Type == "error" {
+			parts = append(parts, fmt.Sprintf("%s: %s", m.Code, m.Text))
+		}
+	}
+	if len(parts) == 0 {
+		return "rejected without a reason"
+	}
+	return strings.Join(parts, "; ")
+}
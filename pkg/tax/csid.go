@@ -0,0 +1,459 @@
+package tax
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
+	"sent/ent/schema"
+	"sent/ent/tenant"
+	"sent/pkg/crypto"
+	"sent/pkg/database"
+	"sent/pkg/tax/clearance"
+	"sent/pkg/tax/zatca"
+
+	"github.com/google/uuid"
+)
+
+var (
+	saudiVATNumber = regexp.MustCompile(`^3\d{13}3$`)
+	buildingNumber = regexp.MustCompile(`^\d{4}$`)
+	postalCode     = regexp.MustCompile(`^\d{5}$`)
+)
+
+// EInvoiceCredentialDTO is how the tenant authenticates with its e-invoicing portal: for
+// ZATCA the CSID issued for its signing key, for JoFotara the device's client ID and secret
+// key, along with the seller's national address printed on every e-invoice. The key, secret
+// and OTP are write-only; reads return the certificate's details instead.
+type EInvoiceCredentialDTO struct {
+	Authority          string    `json:"authority"`
+	Environment        string    `json:"environment"`
+	Certificate        string    `json:"certificate"`
+	PrivateKey         string    `json:"privateKey,omitempty"`
+	Secret             string    `json:"secret,omitempty"`
+	ClientID           string    `json:"clientId"`
+	OTP                string    `json:"otp,omitempty"`
+	RegistrationNumber string    `json:"registrationNumber"`
+	Street             string    `json:"street"`
+	BuildingNumber     string    `json:"buildingNumber"`
+	District           string    `json:"district"`
+	City               string    `json:"city"`
+	PostalCode         string    `json:"postalCode"`
+	Subject            string    `json:"subject"`
+	Issuer             string    `json:"issuer"`
+	SerialNumber       string    `json:"serialNumber"`
+	NotAfter           time.Time `json:"notAfter"`
+	CreatedAt          time.Time `json:"createdAt"`
+}
+
+// EInvoiceDTO is a signed e-invoice in the tenant's chain.
+type EInvoiceDTO struct {
+	ID            int       `json:"id"`
+	Kind          string    `json:"kind"`
+	Profile       string    `json:"profile"`
+	Number        string    `json:"number"`
+	UUID          string    `json:"uuid"`
+	Counter       int       `json:"counter"`
+	InvoiceHash   string    `json:"invoiceHash"`
+	PreviousHash  string    `json:"previousHash"`
+	QR            string    `json:"qr"`
+	SignedAt      time.Time `json:"signedAt"`
+	InvoiceID     int       `json:"invoiceId,omitempty"`
+	TransactionID int       `json:"transactionId,omitempty"`
+	// Where the portal stands on it: pending, cleared, reported, rejected or failed; empty
+	// for documents no portal has to see.
+	ClearanceStatus   string                    `json:"clearanceStatus"`
+	ClearanceMessages []schema.ClearanceMessage `json:"clearanceMessages"`
+	ClearedAt         *time.Time                `json:"clearedAt,omitempty"`
+}
+
+// SaveEInvoiceCredential stores a CSID obtained outside SENT and makes it the one invoices
+// are signed with. The previous CSID is kept, inactive, so invoices signed with it can
+// still be traced to it.
+func (t *TaxBridge) SaveEInvoiceCredential(dto EInvoiceCredentialDTO) error {
+	if !t.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change the e-invoicing certificate")
+	}
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	tnt, err := t.db.Tenant.Get(t.ctx, profile.TenantID)
+	if err != nil {
+		return err
+	}
+	if !saudiVATNumber.MatchString(tnt.TaxNumber) {
+		return fmt.Errorf("set the company's 15-digit VAT number before e-invoicing")
+	}
+	signer, err := zatca.NewSigner(dto.Certificate, dto.PrivateKey)
+	if err != nil {
+		return err
+	}
+	if time.Now().After(signer.Cert.NotAfter) {
+		return fmt.Errorf("the certificate expired on %s", signer.Cert.NotAfter.Format("2006-01-02"))
+	}
+	if err := validateAddress(dto); err != nil {
+		return err
+	}
+	env := dto.Environment
+	if env == "" {
+		env = string(einvoicecredential.EnvironmentProduction)
+	}
+	if err := einvoicecredential.EnvironmentValidator(einvoicecredential.Environment(env)); err != nil {
+		return fmt.Errorf("unknown environment %q", env)
+	}
+	key, err := crypto.Encrypt(dto.PrivateKey)
+	if err != nil {
+		return err
+	}
+	var secret string
+	if dto.Secret != "" {
+		if secret, err = crypto.Encrypt(dto.Secret); err != nil {
+			return err
+		}
+	}
+
+	err = t.replaceCredential(tnt.ID, einvoicecredential.AuthorityZatca, func(c *ent.EInvoiceCredentialCreate) {
+		c.SetEnvironment(einvoicecredential.Environment(env)).
+			SetCertificate(signer.Cert.Base64()).
+			SetPrivateKeyEncrypted(key)
+		if secret != "" {
+			c.SetSecretEncrypted(secret)
+		}
+		setAddress(c, dto)
+	})
+	if err != nil {
+		return err
+	}
+
+	database.LogAuditRecord(t.ctx, t.db, tnt.ID, "SENTcapital", "einvoice_credential_saved", profile.Email, map[string]interface{}{
+		"serial":    signer.Cert.SerialNumber.String(),
+		"subject":   signer.Cert.Subject,
+		"not_after": signer.Cert.NotAfter.Format("2006-01-02"),
+	})
+	return nil
+}
+
+// OnboardEInvoicing registers this installation with the tenant's e-invoicing portal. For
+// ZATCA it generates a key and CSR, trades the CSR and the OTP from the Fatoora portal for a
+// compliance CSID, passes the compliance checks with signed samples and stores the
+// production CSID it gets back. For JoFotara it checks and stores the client ID and secret
+// key. The "local" environment onboards against SENT's built-in sandbox.
+func (t *TaxBridge) OnboardEInvoicing(dto EInvoiceCredentialDTO) (*EInvoiceCredentialDTO, error) {
+	if !t.auth.HasRole("admin") {
+		return nil, fmt.Errorf("permission denied: only admins can onboard e-invoicing")
+	}
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	tnt, err := t.db.Tenant.Get(t.ctx, profile.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	authority := einvoicecredential.Authority(dto.Authority)
+	if err := einvoicecredential.AuthorityValidator(authority); err != nil {
+		return nil, fmt.Errorf("unknown e-invoicing authority %q", dto.Authority)
+	}
+	env := einvoicecredential.Environment(dto.Environment)
+	if err := einvoicecredential.EnvironmentValidator(env); err != nil {
+		return nil, fmt.Errorf("unknown environment %q", dto.Environment)
+	}
+	if err := validateAddress(dto); err != nil {
+		return nil, err
+	}
+	conn, err := clearance.For(string(authority), string(env))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		create func(*ent.EInvoiceCredentialCreate)
+		audit  = map[string]interface{}{"authority": string(authority), "environment": string(env)}
+	)
+	switch authority {
+	case einvoicecredential.AuthorityZatca:
+		if !saudiVATNumber.MatchString(tnt.TaxNumber) {
+			return nil, fmt.Errorf("set the company's 15-digit VAT number before e-invoicing")
+		}
+		if strings.TrimSpace(dto.OTP) == "" {
+			return nil, fmt.Errorf("enter the OTP generated on the Fatoora portal")
+		}
+		key, err := zatca.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		template := string(env)
+		if env == einvoicecredential.EnvironmentLocal {
+			template = zatca.EnvSandbox
+		}
+		csr, err := zatca.CreateCSR(zatca.Unit{
+			CommonName:   fmt.Sprintf("SENT-%d", tnt.ID),
+			SerialNumber: "1-SENT|2-1|3-" + uuid.New().String(),
+			VATNumber:    tnt.TaxNumber,
+			Organization: tnt.Name,
+			InvoiceType:  "1100",
+			Location:     strings.TrimSpace(dto.City),
+			Industry:     "Retail",
+			Environment:  template,
+		}, key)
+		if err != nil {
+			return nil, err
+		}
+		party := zatca.Party{
+			Name:               tnt.Name,
+			VATNumber:          tnt.TaxNumber,
+			RegistrationNumber: strings.TrimSpace(dto.RegistrationNumber),
+			Address: zatca.Address{
+				Street: strings.TrimSpace(dto.Street), Building: dto.BuildingNumber, District: strings.TrimSpace(dto.District),
+				City: strings.TrimSpace(dto.City), PostalCode: dto.PostalCode, Country: "SA",
+			},
+		}
+		creds, err := conn.Onboard(t.ctx, clearance.Onboarding{
+			CSR: csr,
+			OTP: strings.TrimSpace(dto.OTP),
+			Samples: func(cert string) ([]clearance.Document, error) {
+				return complianceSamples(party, cert, key)
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		cert, err := zatca.ParseCertificate(creds.Token)
+		if err != nil {
+			return nil, fmt.Errorf("the portal issued an unreadable CSID: %w", err)
+		}
+		keyEnc, err := crypto.Encrypt(key)
+		if err != nil {
+			return nil, err
+		}
+		secret, err := crypto.Encrypt(creds.Secret)
+		if err != nil {
+			return nil, err
+		}
+		create = func(c *ent.EInvoiceCredentialCreate) {
+			c.SetCertificate(cert.Base64()).
+				SetPrivateKeyEncrypted(keyEnc).
+				SetSecretEncrypted(secret).
+				SetComplianceRequestID(creds.RequestID)
+		}
+		audit["serial"] = cert.SerialNumber.String()
+		audit["not_after"] = cert.NotAfter.Format("2006-01-02")
+	case einvoicecredential.AuthorityJofotara:
+		creds, err := conn.Onboard(t.ctx, clearance.Onboarding{
+			ClientID: strings.TrimSpace(dto.ClientID),
+			Secret:   strings.TrimSpace(dto.Secret),
+		})
+		if err != nil {
+			return nil, err
+		}
+		secret, err := crypto.Encrypt(creds.Secret)
+		if err != nil {
+			return nil, err
+		}
+		create = func(c *ent.EInvoiceCredentialCreate) {
+			c.SetClientID(creds.Token).SetSecretEncrypted(secret)
+		}
+		audit["client_id"] = creds.Token
+	}
+
+	err = t.replaceCredential(tnt.ID, authority, func(c *ent.EInvoiceCredentialCreate) {
+		c.SetEnvironment(env)
+		setAddress(c, dto)
+		create(c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	database.LogAuditRecord(t.ctx, t.db, tnt.ID, "SENTcapital", "einvoice_onboarded", profile.Email, audit)
+	return t.GetEInvoiceCredential()
+}
+
+// complianceSamples signs the compliance samples with the compliance CSID, chained from the
+// initial hash as a new unit's first documents are.
+func complianceSamples(seller zatca.Party, cert, key string) ([]clearance.Document, error) {
+	signer, err := zatca.NewSigner(cert, key)
+	if err != nil {
+		return nil, fmt.Errorf("the compliance CSID does not match the key: %w", err)
+	}
+	docs, err := zatca.ComplianceSamples(seller, "1100", time.Now())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]clearance.Document, len(docs))
+	pih := zatca.InitialHash
+	for i, d := range docs {
+		d.PreviousHash = pih
+		unsigned, err := d.Render()
+		if err != nil {
+			return nil, err
+		}
+		signed, err := signer.Sign(unsigned, d.Issued)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = clearance.Document{Number: d.Number, UUID: d.UUID, Hash: signed.Hash, XML: signed.XML, Simplified: d.Simplified}
+		pih = signed.Hash
+	}
+	return out, nil
+}
+
+// validateAddress checks the seller's national address in the shape ZATCA requires.
+func validateAddress(dto EInvoiceCredentialDTO) error {
+	if !buildingNumber.MatchString(dto.BuildingNumber) {
+		return fmt.Errorf("the building number must be 4 digits")
+	}
+	if !postalCode.MatchString(dto.PostalCode) {
+		return fmt.Errorf("the postal code must be 5 digits")
+	}
+	if strings.TrimSpace(dto.Street) == "" || strings.TrimSpace(dto.City) == "" || strings.TrimSpace(dto.District) == "" {
+		return fmt.Errorf("street, district and city are required")
+	}
+	return nil
+}
+
+func setAddress(c *ent.EInvoiceCredentialCreate, dto EInvoiceCredentialDTO) {
+	c.SetRegistrationNumber(strings.TrimSpace(dto.RegistrationNumber)).
+		SetStreet(strings.TrimSpace(dto.Street)).
+		SetBuildingNumber(dto.BuildingNumber).
+		SetDistrict(strings.TrimSpace(dto.District)).
+		SetCity(strings.TrimSpace(dto.City)).
+		SetPostalCode(dto.PostalCode)
+}
+
+// replaceCredential deactivates the tenant's credentials for an authority and creates the
+// new active one.
+func (t *TaxBridge) replaceCredential(tenantID int, authority einvoicecredential.Authority, set func(*ent.EInvoiceCredentialCreate)) error {
+	tx, err := t.db.Tx(t.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.EInvoiceCredential.Update().
+		Where(
+			einvoicecredential.HasTenantWith(tenant.ID(tenantID)),
+			einvoicecredential.AuthorityEQ(authority),
+			einvoicecredential.IsActive(true),
+		).
+		SetIsActive(false).
+		Save(t.ctx); err != nil {
+		return err
+	}
+	create := tx.EInvoiceCredential.Create().SetTenantID(tenantID).SetAuthority(authority)
+	set(create)
+	if _, err := create.Save(t.ctx); err != nil {
+		return fmt.Errorf("failed to save the credentials: %w", err)
+	}
+	return tx.Commit()
+}
+
+// GetEInvoiceCredential returns the latest active credentials, or nil when the tenant does
+// not e-invoice.
+func (t *TaxBridge) GetEInvoiceCredential() (*EInvoiceCredentialDTO, error) {
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	cred, err := t.db.EInvoiceCredential.Query().
+		Where(einvoicecredential.HasTenantWith(tenant.ID(profile.TenantID)), einvoicecredential.IsActive(true)).
+		Order(ent.Desc(einvoicecredential.FieldCreatedAt)).
+		First(t.ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	dto := &EInvoiceCredentialDTO{
+		Authority:          string(cred.Authority),
+		Environment:        string(cred.Environment),
+		Certificate:        cred.Certificate,
+		ClientID:           cred.ClientID,
+		RegistrationNumber: cred.RegistrationNumber,
+		Street:             cred.Street,
+		BuildingNumber:     cred.BuildingNumber,
+		District:           cred.District,
+		City:               cred.City,
+		PostalCode:         cred.PostalCode,
+		CreatedAt:          cred.CreatedAt,
+	}
+	// JoFotara credentials carry no certificate.
+	if cred.Certificate != "" {
+		cert, err := zatca.ParseCertificate(cred.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		dto.Subject = cert.Subject
+		dto.Issuer = cert.Issuer
+		dto.SerialNumber = cert.SerialNumber.String()
+		dto.NotAfter = cert.NotAfter
+	}
+	return dto, nil
+}
+
+// GetEInvoices lists the tenant's latest e-invoices, newest first.
+func (t *TaxBridge) GetEInvoices(limit int) ([]EInvoiceDTO, error) {
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	rows, err := t.db.EInvoice.Query().
+		Where(einvoice.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithInvoice().
+		WithTransaction().
+		Order(ent.Desc(einvoice.FieldCounter)).
+		Limit(limit).
+		All(t.ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]EInvoiceDTO, len(rows))
+	for i, e := range rows {
+		out[i] = EInvoiceDTO{
+			ID:           e.ID,
+			Kind:         string(e.Kind),
+			Profile:      string(e.Profile),
+			Number:       e.Number,
+			UUID:         e.UUID,
+			Counter:      e.Counter,
+			InvoiceHash:  e.InvoiceHash,
+			PreviousHash: e.PreviousHash,
+			QR:           e.Qr,
+			SignedAt:     e.SignedAt,
+		}
+		if e.Edges.Invoice != nil {
+			out[i].InvoiceID = e.Edges.Invoice.ID
+		}
+		if txn := e.Edges.Transaction; txn != nil {
+			out[i].TransactionID = txn.ID
+			if txn.ClearanceStatus != nil {
+				out[i].ClearanceStatus = string(*txn.ClearanceStatus)
+			}
+			out[i].ClearanceMessages = txn.ClearanceMessages
+			out[i].ClearedAt = txn.ClearedAt
+		}
+	}
+	return out, nil
+}
+
+// GetEInvoiceXML returns the signed UBL of an e-invoice.
+func (t *TaxBridge) GetEInvoiceXML(id int) (string, error) {
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	e, err := t.db.EInvoice.Query().
+		Where(einvoice.ID(id), einvoice.HasTenantWith(tenant.ID(profile.TenantID))).
+		Only(t.ctx)
+	if err != nil {
+		return "", fmt.Errorf("e-invoice %d not found: %w", id, err)
+	}
+	return e.XML, nil
+}
@@ -0,0 +1,349 @@
+package tax
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sent/ent"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
+	"sent/ent/invoice"
+	"sent/ent/invoiceline"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+	"sent/pkg/crypto"
+	"sent/pkg/tax/zatca"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// exemptions are the ZATCA reason codes given for supplies that carry no VAT. Zero-rated
+// supplies are taken to be exports of goods and exempt ones financial services, the common
+// cases; ZATCA's code list has the others.
+var exemptions = map[string][2]string{
+	zatca.CategoryZeroRated:  {"VATEX-SA-32", "Export of goods"},
+	zatca.CategoryExempt:     {"VATEX-SA-29", "Financial services mentioned in Article 29 of the VAT Regulations"},
+	zatca.CategoryOutOfScope: {"VATEX-SA-OOS", "Not subject to VAT"},
+}
+
+var zatcaCategories = map[Category]string{
+	Standard:  zatca.CategoryStandard,
+	ZeroRated: zatca.CategoryZeroRated,
+	Exempt:    zatca.CategoryExempt,
+}
+
+// zatcaCategory returns the UNCL 5305 category of a tax code. Untaxed lines are out of scope.
+func zatcaCategory(code string) string {
+	if code == "" {
+		return zatca.CategoryOutOfScope
+	}
+	_, cat, err := ParseCode(code)
+	if err != nil {
+		return zatca.CategoryStandard
+	}
+	return zatcaCategories[cat]
+}
+
+// taxGroups sums lines into the breakdown of the document, one group per category and rate.
+func taxGroups(lines []zatca.Line, reason string) []zatca.TaxGroup {
+	var groups []zatca.TaxGroup
+	index := make(map[string]int)
+	for _, l := range lines {
+		key := l.Category + "/" + l.Percent.String()
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			g := zatca.TaxGroup{Category: l.Category, Percent: l.Percent}
+			if ex, ok := exemptions[l.Category]; ok {
+				g.ExemptionCode, g.ExemptionReason = ex[0], ex[1]
+				if reason != "" && l.Category == zatca.CategoryExempt {
+					g.ExemptionReason = reason
+				}
+			}
+			groups = append(groups, g)
+		}
+		groups[i].Taxable = groups[i].Taxable.Add(l.Net)
+		groups[i].Tax = groups[i].Tax.Add(l.Tax)
+	}
+	return groups
+}
+
+// activeSigner returns the tenant's active CSID and a signer for it, or nil when the tenant
+// does not e-invoice.
+func activeSigner(ctx context.Context, db *ent.Client, tenantID int) (*ent.EInvoiceCredential, *zatca.Signer, error) {
+	cred, err := db.EInvoiceCredential.Query().
+		Where(
+			einvoicecredential.HasTenantWith(tenant.ID(tenantID)),
+			einvoicecredential.AuthorityEQ(einvoicecredential.AuthorityZatca),
+			einvoicecredential.IsActive(true),
+		).
+		Order(ent.Desc(einvoicecredential.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := crypto.Decrypt(cred.PrivateKeyEncrypted)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read the CSID key: %w", err)
+	}
+	signer, err := zatca.NewSigner(cred.Certificate, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("stored CSID is unusable: %w", err)
+	}
+	return cred, signer, nil
+}
+
+// seller returns the tenant as the seller of its e-invoices.
+func seller(tnt *ent.Tenant, cred *ent.EInvoiceCredential) zatca.Party {
+	return zatca.Party{
+		Name:               tnt.Name,
+		VATNumber:          tnt.TaxNumber,
+		RegistrationNumber: cred.RegistrationNumber,
+		Address: zatca.Address{
+			Street:     cred.Street,
+			Building:   cred.BuildingNumber,
+			District:   cred.District,
+			City:       cred.City,
+			PostalCode: cred.PostalCode,
+			Country:    "SA",
+		},
+	}
+}
+
+// IssueInvoiceEInvoice signs an issued AR invoice or credit note and adds it to the tenant's
+// e-invoice chain. Customers with a VAT number get a standard (B2B) invoice, others a
+// simplified one. Tenants without an active CSID get nil. Pass tx.Client() so the invoice
+// and its e-invoice are committed together.
+func IssueInvoiceEInvoice(ctx context.Context, db *ent.Client, tenantID, invoiceID int) (*ent.EInvoice, error) {
+	cred, signer, err := activeSigner(ctx, db, tenantID)
+	if err != nil || cred == nil {
+		return nil, err
+	}
+	inv, err := db.Invoice.Query().
+		Where(invoice.ID(invoiceID), invoice.HasTenantWith(tenant.ID(tenantID))).
+		WithTenant().
+		WithCustomer().
+		WithLines(func(q *ent.InvoiceLineQuery) { q.Order(ent.Asc(invoiceline.FieldPosition)) }).
+		WithOriginalInvoice().
+		WithTransaction().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invoice %d not found: %w", invoiceID, err)
+	}
+	if inv.Edges.Transaction == nil {
+		return nil, fmt.Errorf("invoice %s is not posted", inv.Number)
+	}
+
+	cust := inv.Edges.Customer
+	country := cust.CountryCode
+	if country == "" {
+		country = "SA"
+	}
+	doc := &zatca.Document{
+		Number:     inv.Number,
+		UUID:       uuid.New().String(),
+		TypeCode:   zatca.TypeInvoice,
+		Simplified: cust.TaxID == "",
+		Issued:     inv.IssueDate,
+		Currency:   inv.Currency,
+		TaxRate:    inv.ExchangeRate,
+		Seller:     seller(inv.Edges.Tenant, cred),
+		Buyer: &zatca.Party{
+			Name:      cust.Name,
+			VATNumber: cust.TaxID,
+			Address:   zatca.Address{Street: cust.Address, Country: country},
+		},
+		Net:   inv.Subtotal,
+		Tax:   inv.TaxTotal,
+		Total: inv.Total,
+	}
+	if inv.Kind == invoice.KindCreditNote {
+		doc.TypeCode = zatca.TypeCreditNote
+		doc.Reason = inv.Notes
+		if orig := inv.Edges.OriginalInvoice; orig != nil {
+			doc.Original = orig.Number
+		}
+	}
+	for _, l := range inv.Edges.Lines {
+		doc.Lines = append(doc.Lines, zatca.Line{
+			Name:     l.Description,
+			Quantity: l.Quantity,
+			Price:    l.UnitPrice,
+			Net:      l.NetAmount,
+			Tax:      l.NetAmount.Mul(l.TaxRate).Round(2),
+			Category: zatcaCategory(l.TaxCode),
+			Percent:  l.TaxRate.Mul(decimal.NewFromInt(100)),
+		})
+	}
+	doc.Taxes = taxGroups(doc.Lines, cust.ExemptionReference)
+	// The document's tax is what was posted; line taxes are rounded apart and may differ by a
+	// cent, so the groups take the posted split.
+	groupTax := decimal.Zero
+	for _, g := range doc.Taxes {
+		groupTax = groupTax.Add(g.Tax)
+	}
+	if diff := inv.TaxTotal.Sub(groupTax); !diff.IsZero() {
+		for i := range doc.Taxes {
+			if doc.Taxes[i].Tax.IsPositive() {
+				doc.Taxes[i].Tax = doc.Taxes[i].Tax.Add(diff)
+				break
+			}
+		}
+	}
+
+	kind := einvoice.KindInvoice
+	if inv.Kind == invoice.KindCreditNote {
+		kind = einvoice.KindCreditNote
+	}
+	return issueEInvoice(ctx, db, tenantID, cred, signer, doc, kind, inv.Edges.Transaction.ID, func(c *ent.EInvoiceCreate) { c.SetInvoice(inv) })
+}
+
+// IssueSaleEInvoice signs a POS sale as a simplified invoice, or a refund as a simplified
+// credit note against its sale, and adds it to the tenant's e-invoice chain. Tenants without
+// an active CSID get nil. Pass tx.Client() so the sale and its e-invoice commit together.
+func IssueSaleEInvoice(ctx context.Context, db *ent.Client, tenantID, transactionID int, reason string) (*ent.EInvoice, error) {
+	cred, signer, err := activeSigner(ctx, db, tenantID)
+	if err != nil || cred == nil {
+		return nil, err
+	}
+	txn, err := db.Transaction.Query().
+		Where(transaction.ID(transactionID), transaction.HasTenantWith(tenant.ID(tenantID))).
+		WithTenant().
+		WithCustomer().
+		WithPosLines(func(q *ent.PosSaleLineQuery) { q.WithProduct() }).
+		WithTenders().
+		WithRefundedSale().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sale %d not found: %w", transactionID, err)
+	}
+
+	doc := &zatca.Document{
+		Number:     SaleNumber(txn),
+		UUID:       txn.UUID,
+		TypeCode:   zatca.TypeInvoice,
+		Simplified: true,
+		Issued:     txn.Date,
+		Currency:   txn.Currency,
+		TaxRate:    txn.ExchangeRate,
+		Seller:     seller(txn.Edges.Tenant, cred),
+	}
+	if c := txn.Edges.Customer; c != nil {
+		doc.Buyer = &zatca.Party{Name: c.Name, VATNumber: c.TaxID, Address: zatca.Address{Street: c.Address, Country: "SA"}}
+	}
+	if t := txn.Edges.Tenders; len(t) > 0 {
+		doc.PaymentMeans = zatca.PaymentCash
+		if t[0].Method != "cash" {
+			doc.PaymentMeans = zatca.PaymentCard
+		}
+	}
+	kind := einvoice.KindInvoice
+	if sale := txn.Edges.RefundedSale; sale != nil {
+		kind = einvoice.KindCreditNote
+		doc.TypeCode = zatca.TypeCreditNote
+		doc.Original = SaleNumber(sale)
+		doc.Reason = reason
+		if doc.Reason == "" {
+			doc.Reason = "Goods returned"
+		}
+	}
+
+	for _, l := range txn.Edges.PosLines {
+		price := decimal.Zero
+		if !l.Quantity.IsZero() {
+			price = l.NetAmount.DivRound(l.Quantity, 6)
+		}
+		doc.Lines = append(doc.Lines, zatca.Line{
+			Name:     l.Edges.Product.Name,
+			Quantity: l.Quantity,
+			Price:    price,
+			Net:      l.NetAmount,
+			Tax:      l.TaxAmount,
+			Category: zatcaCategory(l.TaxCode),
+			Percent:  l.TaxRate.Mul(decimal.NewFromInt(100)),
+		})
+		doc.Net = doc.Net.Add(l.NetAmount)
+		doc.Tax = doc.Tax.Add(l.TaxAmount)
+	}
+	doc.Total = doc.Net.Add(doc.Tax)
+	doc.Taxes = taxGroups(doc.Lines, "")
+
+	return issueEInvoice(ctx, db, tenantID, cred, signer, doc, kind, txn.ID, nil)
+}
+
+// SaleNumber is the invoice number of a POS sale or refund on its e-invoice.
+func SaleNumber(txn *ent.Transaction) string {
+	if txn.Type == "refund" {
+		return fmt.Sprintf("POS-R%06d", txn.ID)
+	}
+	return fmt.Sprintf("POS-%06d", txn.ID)
+}
+
+// issueEInvoice chains, renders and signs a document, saves the result against the
+// transaction that posted it and queues it for clearance. The counter and previous hash come
+// from the tenant's last e-invoice; two documents racing for the same counter fail on its
+// unique index rather than fork the chain.
+func issueEInvoice(ctx context.Context, db *ent.Client, tenantID int, cred *ent.EInvoiceCredential, signer *zatca.Signer, doc *zatca.Document, kind einvoice.Kind, transactionID int, link func(*ent.EInvoiceCreate)) (*ent.EInvoice, error) {
+	doc.Counter, doc.PreviousHash = 1, zatca.InitialHash
+	last, err := db.EInvoice.Query().
+		Where(einvoice.HasTenantWith(tenant.ID(tenantID))).
+		Order(ent.Desc(einvoice.FieldCounter)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if last != nil {
+		doc.Counter, doc.PreviousHash = last.Counter+1, last.InvoiceHash
+	}
+
+	unsigned, err := doc.Render()
+	if err != nil {
+		return nil, fmt.Errorf("e-invoice %s: %w", doc.Number, err)
+	}
+	signed, err := signer.Sign(unsigned, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("e-invoice %s not signed: %w", doc.Number, err)
+	}
+
+	profile := einvoice.ProfileStandard
+	if doc.Simplified {
+		profile = einvoice.ProfileSimplified
+	}
+	create := db.EInvoice.Create().
+		SetTenantID(tenantID).
+		SetCredential(cred).
+		SetKind(kind).
+		SetProfile(profile).
+		SetNumber(doc.Number).
+		SetUUID(doc.UUID).
+		SetCounter(doc.Counter).
+		SetInvoiceHash(signed.Hash).
+		SetPreviousHash(doc.PreviousHash).
+		SetXML(string(signed.XML)).
+		SetQr(signed.QR).
+		SetTransactionID(transactionID)
+	if link != nil {
+		link(create)
+	}
+	e, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("e-invoice %s: another document took counter %d; try again", doc.Number, doc.Counter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save e-invoice %s: %w", doc.Number, err)
+	}
+	// ClearanceSweepWorker picks pending transactions up.
+	err = db.Transaction.UpdateOneID(transactionID).
+		SetClearanceStatus(transaction.ClearanceStatusPending).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,80 @@
+package tax
+
+import (
+	"context"
+	"testing"
+
+	"sent/pkg/tax/clearance"
+	"sent/pkg/tax/zatca"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestZatcaCategory(t *testing.T) {
+	cases := map[string]string{
+		"VAT-SA":   zatca.CategoryStandard,
+		"VAT-SA-Z": zatca.CategoryZeroRated,
+		"VAT-SA-E": zatca.CategoryExempt,
+		"":         zatca.CategoryOutOfScope,
+	}
+	for code, want := range cases {
+		if got := zatcaCategory(code); got != want {
+			t.Errorf("zatcaCategory(%q) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestTaxGroups(t *testing.T) {
+	d := decimal.RequireFromString
+	lines := []zatca.Line{
+		{Net: d("100"), Tax: d("15"), Category: zatca.CategoryStandard, Percent: d("15")},
+		{Net: d("40"), Tax: d("0"), Category: zatca.CategoryExempt, Percent: d("0")},
+		{Net: d("20"), Tax: d("3"), Category: zatca.CategoryStandard, Percent: d("15")},
+	}
+	groups := taxGroups(lines, "Interest on loans")
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(groups))
+	}
+	if s := groups[0]; !s.Taxable.Equal(d("120")) || !s.Tax.Equal(d("18")) || s.ExemptionCode != "" {
+		t.Errorf("standard group = %+v", s)
+	}
+	if e := groups[1]; e.ExemptionCode != "VATEX-SA-29" || e.ExemptionReason != "Interest on loans" || !e.Taxable.Equal(d("40")) {
+		t.Errorf("exempt group = %+v", e)
+	}
+}
+
+func TestComplianceSamplesOnboard(t *testing.T) {
+	sb := clearance.NewSandbox("http://sandbox.test")
+	z := clearance.NewZATCA(sb.URL()+"/zatca", sb.Client())
+	party := zatca.Party{
+		Name: "Maximum Speed Tech Supply LTD", VATNumber: "399999999900003", RegistrationNumber: "1010010000",
+		Address: zatca.Address{Street: "Prince Sultan", Building: "2322", District: "Al-Murabba", City: "Riyadh", PostalCode: "23333", Country: "SA"},
+	}
+	key, err := zatca.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := zatca.CreateCSR(zatca.Unit{
+		CommonName: "SENT-1", SerialNumber: "1-SENT|2-1|3-test", VATNumber: party.VATNumber,
+		Organization: party.Name, InvoiceType: "1100", Environment: zatca.EnvSandbox,
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := z.Onboard(context.Background(), clearance.Onboarding{CSR: csr, OTP: clearance.SandboxOTP, Samples: func(cert string) ([]clearance.Document, error) {
+		return complianceSamples(party, cert, key)
+	}})
+	if err != nil {
+		t.Fatalf("Onboard: %v", err)
+	}
+	if _, err := zatca.ParseCertificate(creds.Token); err != nil {
+		t.Errorf("production CSID: %v", err)
+	}
+}
+
+func TestClearanceMessages(t *testing.T) {
+	got := clearanceMessages([]clearance.Message{{Type: "warning", Code: "KSA-13", Category: "KSA", Text: "PIH mismatch"}})
+	if len(got) != 1 || got[0].Code != "KSA-13" || got[0].Message != "PIH mismatch" || got[0].Type != "warning" {
+		t.Errorf("clearanceMessages = %+v", got)
+	}
+}
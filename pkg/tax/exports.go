@@ -0,0 +1,206 @@
+package tax
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/shopspring/decimal"
+)
+
+// returnBox is a line of the VAT return as filed.
+type returnBox struct {
+	Box         string
+	Description string
+	Amount      decimal.Decimal
+}
+
+// boxes lays the return out in the order of the VAT-100 form.
+func (r *VATReturn) boxes() []returnBox {
+	return []returnBox{
+		{"1", "Output tax on sales and other outputs", r.OutputTax},
+		{"1a", "of which on credit notes and refunds", r.OutputAdjustments},
+		{"1b", "of which posted by journal", r.OtherOutputTax},
+		{"4", "Input tax reclaimed on purchases", r.InputTax},
+		{"4b", "of which posted by journal", r.OtherInputTax},
+		{"5", "Net tax payable (reclaimable if negative)", r.NetPayable()},
+		{"6", "Standard-rated sales, net", r.StandardSales},
+		{"6a", "Zero-rated sales, net", r.ZeroRatedSales},
+		{"6b", "Exempt sales, net", r.ExemptSales},
+		{"6c", "Credit notes and refunds included above", r.SalesAdjustments},
+		{"7", "Purchases, net", r.Purchases},
+	}
+}
+
+var registerHeader = []string{
+	"Date", "Document", "Kind", "Counterparty ID", "Counterparty", "Tax ID", "Tax code", "Category",
+	"Rate", "Currency", "Exchange rate", "Net", "Tax", "Net (functional)", "Tax (functional)",
+}
+
+// registerCSV writes a register with one row per line, amounts unformatted.
+func registerCSV(lines []RegisterLine) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(registerHeader); err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		err := w.Write([]string{
+			l.Date.Format("2006-01-02"), l.Document, l.Kind, l.CounterpartyID, l.Counterparty, l.TaxID,
+			l.TaxCode, string(l.Category), l.Rate.String(), l.Currency, l.ExchangeRate.String(),
+			l.Net.String(), l.Tax.String(), l.NetFunctional.String(), l.TaxFunctional.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// returnCSV writes the return's boxes.
+func returnCSV(r *VATReturn, places int32) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"Box", "Description", "Amount"}); err != nil {
+		return nil, err
+	}
+	for _, b := range r.boxes() {
+		if err := w.Write([]string{b.Box, b.Description, b.Amount.StringFixed(places)}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+var mutedText = &props.Color{Red: 128, Green: 128, Blue: 128}
+
+// returnPDF renders the VAT return.
+func returnPDF(r *VATReturn, company, taxNumber, currency, period string, places int32) ([]byte, error) {
+	m := maroto.New(config.NewBuilder().Build())
+	m.AddRows(titleRows("VAT RETURN", company, taxNumber, currency, period, r.From, r.To)...)
+
+	header := props.Text{Size: 9, Style: fontstyle.Bold, Top: 4}
+	m.AddRows(row.New(10).Add(
+		text.NewCol(1, "Box", header),
+		text.NewCol(8, "Description", header),
+		text.NewCol(3, "Amount", props.Text{Size: 9, Style: fontstyle.Bold, Top: 4, Align: align.Right}),
+	))
+	for _, b := range r.boxes() {
+		style := fontstyle.Normal
+		if b.Box == "5" {
+			style = fontstyle.Bold
+		}
+		m.AddRows(row.New(6).Add(
+			text.NewCol(1, b.Box, props.Text{Size: 9, Style: style}),
+			text.NewCol(8, b.Description, props.Text{Size: 9, Style: style}),
+			text.NewCol(3, b.Amount.StringFixed(places), props.Text{Size: 9, Style: style, Align: align.Right}),
+		))
+	}
+	m.AddRows(generatedRow())
+	return pdfBytes(m)
+}
+
+// registerPDF renders a register in landscape, with totals per tax code at the end.
+func registerPDF(title string, lines []RegisterLine, company, taxNumber, currency, period string, from, to time.Time, places int32) ([]byte, error) {
+	m := maroto.New(config.NewBuilder().WithOrientation(orientation.Horizontal).Build())
+	m.AddRows(titleRows(title, company, taxNumber, currency, period, from, to)...)
+
+	header := props.Text{Size: 8, Style: fontstyle.Bold, Top: 4}
+	headerRight := props.Text{Size: 8, Style: fontstyle.Bold, Top: 4, Align: align.Right}
+	m.AddRows(row.New(10).Add(
+		text.NewCol(1, "Date", header),
+		text.NewCol(2, "Document", header),
+		text.NewCol(3, "Counterparty", header),
+		text.NewCol(2, "Tax ID", header),
+		text.NewCol(1, "Code", header),
+		text.NewCol(1, "Net", headerRight),
+		text.NewCol(1, "Tax", headerRight),
+		text.NewCol(1, "Gross", headerRight),
+	))
+	cell := props.Text{Size: 8}
+	right := props.Text{Size: 8, Align: align.Right}
+	type total struct{ net, tax decimal.Decimal }
+	totals := make(map[string]*total)
+	var codes []string
+	for _, l := range lines {
+		m.AddRows(row.New(5).Add(
+			text.NewCol(1, l.Date.Format("2006-01-02"), cell),
+			text.NewCol(2, l.Document, cell),
+			text.NewCol(3, l.Counterparty, cell),
+			text.NewCol(2, l.TaxID, cell),
+			text.NewCol(1, l.TaxCode, cell),
+			text.NewCol(1, l.NetFunctional.StringFixed(places), right),
+			text.NewCol(1, l.TaxFunctional.StringFixed(places), right),
+			text.NewCol(1, l.NetFunctional.Add(l.TaxFunctional).StringFixed(places), right),
+		))
+		t, ok := totals[l.TaxCode]
+		if !ok {
+			t = &total{}
+			totals[l.TaxCode] = t
+			codes = append(codes, l.TaxCode)
+		}
+		t.net, t.tax = t.net.Add(l.NetFunctional), t.tax.Add(l.TaxFunctional)
+	}
+	bold := props.Text{Size: 8, Style: fontstyle.Bold, Top: 2}
+	boldRight := props.Text{Size: 8, Style: fontstyle.Bold, Top: 2, Align: align.Right}
+	sort.Strings(codes)
+	for _, code := range codes {
+		t := totals[code]
+		m.AddRows(row.New(7).Add(
+			text.NewCol(8, "Total "+code, bold),
+			text.NewCol(1, "", bold),
+			text.NewCol(1, t.net.StringFixed(places), boldRight),
+			text.NewCol(1, t.tax.StringFixed(places), boldRight),
+			text.NewCol(1, t.net.Add(t.tax).StringFixed(places), boldRight),
+		))
+	}
+	m.AddRows(generatedRow())
+	return pdfBytes(m)
+}
+
+// titleRows heads a report with the company, its VAT number and the period.
+func titleRows(title, company, taxNumber, currency, period string, from, to time.Time) []core.Row {
+	return []core.Row{
+		row.New(20).Add(text.NewCol(12, title, props.Text{Size: 16, Style: fontstyle.Bold, Align: align.Center})),
+		row.New(6).Add(
+			text.NewCol(6, company, props.Text{Size: 10, Style: fontstyle.Bold}),
+			text.NewCol(6, fmt.Sprintf("Period %s: %s to %s", period, from.Format("2006-01-02"), to.Add(-time.Nanosecond).Format("2006-01-02")), props.Text{Size: 10, Align: align.Right}),
+		),
+		row.New(6).Add(
+			text.NewCol(6, "VAT number: "+taxNumber, props.Text{Size: 10}),
+			text.NewCol(6, "Amounts in "+currency, props.Text{Size: 10, Align: align.Right}),
+		),
+	}
+}
+
+func generatedRow() core.Row {
+	return row.New(14).Add(
+		text.NewCol(12, fmt.Sprintf("Generated on %s | SENTtax", time.Now().Format("Jan 02, 2006")), props.Text{
+			Size:  8,
+			Top:   8,
+			Align: align.Center,
+			Color: mutedText,
+		}),
+	)
+}
+
+func pdfBytes(m core.Maroto) ([]byte, error) {
+	doc, err := m.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("pdf generation failed: %w", err)
+	}
+	return doc.GetBytes(), nil
+}
@@ -0,0 +1,210 @@
+package tax
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
+	"sent/ent/tenant"
+	"sent/pkg/database"
+
+	"github.com/shopspring/decimal"
+)
+
+// TaxRateDTO is a standard rate of a jurisdiction and the date it took effect.
+type TaxRateDTO struct {
+	ID            int       `json:"id"`
+	Rate          float64   `json:"rate"` // 0.16 for 16%
+	EffectiveFrom time.Time `json:"effectiveFrom"`
+	Notes         string    `json:"notes"`
+}
+
+// TaxJurisdictionDTO is a jurisdiction the tenant charges tax in, with its rate history.
+type TaxJurisdictionDTO struct {
+	ID          int          `json:"id"`
+	Code        string       `json:"code"`
+	Name        string       `json:"name"`
+	Rounding    string       `json:"rounding"` // half_up or half_even
+	IsActive    bool         `json:"isActive"`
+	CurrentRate float64      `json:"currentRate"`
+	Rates       []TaxRateDTO `json:"rates"` // Latest first
+}
+
+// GetTaxJurisdictions lists the tenant's jurisdictions with their rates.
+func (t *TaxBridge) GetTaxJurisdictions() ([]TaxJurisdictionDTO, error) {
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	jurs, err := t.db.TaxJurisdiction.Query().
+		Where(taxjurisdiction.HasTenantWith(tenant.ID(profile.TenantID))).
+		WithRates(func(q *ent.TaxRateQuery) { q.Order(ent.Desc(taxrate.FieldEffectiveFrom)) }).
+		Order(ent.Asc(taxjurisdiction.FieldCode)).
+		All(t.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tax jurisdictions: %w", err)
+	}
+
+	now := time.Now()
+	dtos := make([]TaxJurisdictionDTO, len(jurs))
+	for i, j := range jurs {
+		dtos[i] = TaxJurisdictionDTO{
+			ID:       j.ID,
+			Code:     j.Code,
+			Name:     j.Name,
+			Rounding: string(j.Rounding),
+			IsActive: j.IsActive,
+			Rates:    make([]TaxRateDTO, len(j.Edges.Rates)),
+		}
+		current := false
+		for k, r := range j.Edges.Rates {
+			dtos[i].Rates[k] = TaxRateDTO{ID: r.ID, Rate: r.Rate.InexactFloat64(), EffectiveFrom: r.EffectiveFrom, Notes: r.Notes}
+			if !current && !r.EffectiveFrom.After(now) {
+				dtos[i].CurrentRate, current = r.Rate.InexactFloat64(), true
+			}
+		}
+	}
+	return dtos, nil
+}
+
+// SaveTaxJurisdiction creates a jurisdiction, or updates it when ID is set. A new jurisdiction
+// takes over from the statutory rate of its country, so it needs a rate before it is used.
+func (t *TaxBridge) SaveTaxJurisdiction(dto TaxJurisdictionDTO) (int, error) {
+	if !t.auth.HasRole("admin") {
+		return 0, fmt.Errorf("permission denied: only admins can change tax jurisdictions")
+	}
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return 0, err
+	}
+	code := strings.ToUpper(strings.TrimSpace(dto.Code))
+	if _, _, err := ParseCode(CodeForCountry(code)); err != nil {
+		return 0, fmt.Errorf("jurisdiction code must be a two-letter country code, got %q", dto.Code)
+	}
+	if strings.TrimSpace(dto.Name) == "" {
+		return 0, fmt.Errorf("jurisdiction name is required")
+	}
+	if dto.Rounding == "" {
+		dto.Rounding = string(taxjurisdiction.RoundingHalfUp)
+	}
+	rounding := taxjurisdiction.Rounding(dto.Rounding)
+	if err := taxjurisdiction.RoundingValidator(rounding); err != nil {
+		return 0, fmt.Errorf("unknown rounding %q: use half_up or half_even", dto.Rounding)
+	}
+
+	id := dto.ID
+	if id == 0 {
+		j, err := t.db.TaxJurisdiction.Create().
+			SetTenantID(profile.TenantID).
+			SetCode(code).
+			SetName(strings.TrimSpace(dto.Name)).
+			SetRounding(rounding).
+			SetIsActive(true).
+			Save(t.ctx)
+		if ent.IsConstraintError(err) {
+			return 0, fmt.Errorf("jurisdiction %s already exists", code)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to create tax jurisdiction: %w", err)
+		}
+		id = j.ID
+	} else {
+		n, err := t.db.TaxJurisdiction.Update().
+			Where(taxjurisdiction.ID(dto.ID), taxjurisdiction.HasTenantWith(tenant.ID(profile.TenantID))).
+			SetName(strings.TrimSpace(dto.Name)).
+			SetRounding(rounding).
+			SetIsActive(dto.IsActive).
+			Save(t.ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to update tax jurisdiction: %w", err)
+		}
+		if n == 0 {
+			return 0, fmt.Errorf("tax jurisdiction %d not found", dto.ID)
+		}
+	}
+
+	database.LogAuditRecord(t.ctx, t.db, profile.TenantID, "SENTcapital", "tax_jurisdiction_saved", profile.Email, map[string]interface{}{
+		"jurisdiction_id": id,
+		"code":            code,
+		"rounding":        dto.Rounding,
+		"is_active":       dto.ID == 0 || dto.IsActive,
+	})
+	return id, nil
+}
+
+// AddTaxRate records the standard rate of a jurisdiction from a date. Documents dated before
+// it keep the rate they were issued at.
+func (t *TaxBridge) AddTaxRate(jurisdictionID int, rate float64, effectiveFrom time.Time, notes string) (int, error) {
+	if !t.auth.HasRole("admin") {
+		return 0, fmt.Errorf("permission denied: only admins can change tax rates")
+	}
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return 0, err
+	}
+	if rate < 0 || rate >= 1 {
+		return 0, fmt.Errorf("rate must be a fraction between 0 and 1, e.g. 0.16 for 16%%")
+	}
+	if effectiveFrom.IsZero() {
+		return 0, fmt.Errorf("effective date is required")
+	}
+	j, err := t.db.TaxJurisdiction.Query().
+		Where(taxjurisdiction.ID(jurisdictionID), taxjurisdiction.HasTenantWith(tenant.ID(profile.TenantID))).
+		Only(t.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("tax jurisdiction %d not found", jurisdictionID)
+	}
+
+	y, m, d := effectiveFrom.Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+	r, err := t.db.TaxRate.Create().
+		SetJurisdiction(j).
+		SetRate(decimal.NewFromFloat(rate)).
+		SetEffectiveFrom(from).
+		SetNotes(strings.TrimSpace(notes)).
+		Save(t.ctx)
+	if ent.IsConstraintError(err) {
+		return 0, fmt.Errorf("%s already has a rate from %s", j.Code, from.Format("2006-01-02"))
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to add tax rate: %w", err)
+	}
+
+	database.LogAuditRecord(t.ctx, t.db, profile.TenantID, "SENTcapital", "tax_rate_added", profile.Email, map[string]interface{}{
+		"jurisdiction": j.Code,
+		"rate":         r.Rate.String(),
+		"from":         from.Format("2006-01-02"),
+	})
+	return r.ID, nil
+}
+
+// DeleteTaxRate removes a rate entered in error. The previous rate applies again from its date.
+func (t *TaxBridge) DeleteTaxRate(rateID int) error {
+	if !t.auth.HasRole("admin") {
+		return fmt.Errorf("permission denied: only admins can change tax rates")
+	}
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return err
+	}
+	r, err := t.db.TaxRate.Query().
+		Where(taxrate.ID(rateID), taxrate.HasJurisdictionWith(taxjurisdiction.HasTenantWith(tenant.ID(profile.TenantID)))).
+		WithJurisdiction().
+		Only(t.ctx)
+	if err != nil {
+		return fmt.Errorf("tax rate %d not found", rateID)
+	}
+	if err := t.db.TaxRate.DeleteOne(r).Exec(t.ctx); err != nil {
+		return fmt.Errorf("failed to delete tax rate: %w", err)
+	}
+
+	database.LogAuditRecord(t.ctx, t.db, profile.TenantID, "SENTcapital", "tax_rate_deleted", profile.Email, map[string]interface{}{
+		"jurisdiction": r.Edges.Jurisdiction.Code,
+		"rate":         r.Rate.String(),
+		"from":         r.EffectiveFrom.Format("2006-01-02"),
+	})
+	return nil
+}
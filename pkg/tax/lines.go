@@ -0,0 +1,82 @@
+package tax
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// CodeForCountry returns the standard VAT code for a country, e.g. "VAT-SA".
+func CodeForCountry(countryCode string) string {
+	return "VAT-" + strings.ToUpper(strings.TrimSpace(countryCode))
+}
+
+// TaxableLine is a document line as seen by the tax calculation.
+type TaxableLine struct {
+	Code     string          // Empty means not taxable
+	Rate     decimal.Decimal // Rate applied to the line
+	Rounding Rounding        // Of the code's jurisdiction
+	Net      decimal.Decimal
+}
+
+// Line is the tax total for one code and rate on a document.
+type Line struct {
+	Code    string
+	Rate    decimal.Decimal
+	Taxable decimal.Decimal
+	Tax     decimal.Decimal
+}
+
+// Summarize groups document lines into tax lines, one per code and rate. Tax is rounded
+// once per group to the given number of decimals, by the rounding of the group's jurisdiction,
+// so the document total matches its lines.
+func Summarize(lines []TaxableLine, places int32) []Line {
+	type key struct{ code, rate string }
+	groups := make(map[key]*Line)
+	rounding := make(map[key]Rounding)
+	var order []key
+
+	for _, l := range lines {
+		if l.Code == "" {
+			continue
+		}
+		k := key{l.Code, l.Rate.String()}
+		g, ok := groups[k]
+		if !ok {
+			g = &Line{Code: l.Code, Rate: l.Rate}
+			groups[k] = g
+			rounding[k] = l.Rounding
+			order = append(order, k)
+		}
+		g.Taxable = g.Taxable.Add(l.Net)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		if order[i].code != order[j].code {
+			return order[i].code < order[j].code
+		}
+		return order[i].rate < order[j].rate
+	})
+
+	out := make([]Line, 0, len(order))
+	for _, k := range order {
+		g := groups[k]
+		g.Tax = rounding[k].Round(g.Taxable.Mul(g.Rate), places)
+		out = append(out, *g)
+	}
+	return out
+}
+
+// SplitInclusive splits a tax-inclusive amount, such as a shelf price, into its net and tax
+// parts. Tax is rounded to the given number of decimals and the net takes the remainder, so
+// the parts always add back up to gross.
+func SplitInclusive(gross, rate decimal.Decimal, places int32, rounding Rounding) (net, tax decimal.Decimal) {
+	if !rate.IsPositive() {
+		return gross, decimal.Zero
+	}
+	// Tax is gross x rate / (1 + rate); dividing last keeps the quotient's truncation, at 16
+	// decimals, far below the rounding the halves are decided at.
+	tax = rounding.Round(gross.Mul(rate).DivRound(decimal.NewFromInt(1).Add(rate), 16), places)
+	return gross.Sub(tax), tax
+}
@@ -0,0 +1,38 @@
+package tax
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceQR is what the QR code on a simplified (B2C) tax invoice says about it.
+type InvoiceQR struct {
+	Seller    string
+	TaxNumber string
+	Issued    time.Time
+	Total     decimal.Decimal // Tax included
+	Tax       decimal.Decimal
+}
+
+// Encode returns the QR payload: the fields as tag-length-value records (tags 1 to 5, as
+// ZATCA and JoFotara read them), base64 encoded.
+func (q InvoiceQR) Encode() string {
+	var b []byte
+	b = appendTLV(b, 1, q.Seller)
+	b = appendTLV(b, 2, q.TaxNumber)
+	b = appendTLV(b, 3, q.Issued.UTC().Format("2006-01-02T15:04:05Z"))
+	b = appendTLV(b, 4, q.Total.StringFixed(2))
+	b = appendTLV(b, 5, q.Tax.StringFixed(2))
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// appendTLV appends one record. Values are cut at 255 bytes, the most a record can hold.
+func appendTLV(b []byte, tag byte, value string) []byte {
+	if len(value) > 255 {
+		value = value[:255]
+	}
+	b = append(b, tag, byte(len(value)))
+	return append(b, value...)
+}
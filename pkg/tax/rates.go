@@ -0,0 +1,170 @@
+package tax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"sent/ent"
+	"sent/ent/taxjurisdiction"
+	"sent/ent/taxrate"
+	"sent/ent/tenant"
+
+	"github.com/shopspring/decimal"
+)
+
+// Ledger accounts tax is posted to. Capital creates them on demand; the VAT return reads them.
+const (
+	AccountOutputTax = "2200" // Output VAT, a liability
+	AccountInputTax  = "1400" // Input VAT, an asset
+)
+
+// Category is how a product or supply is treated for VAT.
+type Category string
+
+const (
+	Standard  Category = "standard"   // Taxed at the jurisdiction's rate
+	ZeroRated Category = "zero_rated" // Taxable at 0%; input tax stays recoverable
+	Exempt    Category = "exempt"     // Outside VAT; reported apart from taxable supplies
+)
+
+// Rounding is how an authority rounds tax to the currency's smallest unit.
+type Rounding string
+
+const (
+	RoundHalfUp   Rounding = "half_up"   // Halves round away from zero
+	RoundHalfEven Rounding = "half_even" // Halves round to the even digit (banker's rounding)
+)
+
+// Round rounds an amount to the given number of decimals. The zero value rounds half up.
+func (r Rounding) Round(d decimal.Decimal, places int32) decimal.Decimal {
+	if r == RoundHalfEven {
+		return d.RoundBank(places)
+	}
+	return d.Round(places)
+}
+
+// Errors returned when a tax code cannot be resolved.
+var (
+	ErrUnknownCode = errors.New("unknown tax code")
+	ErrNoRate      = errors.New("no tax rate in effect")
+)
+
+// statutoryRates are the standard rates of the countries SENT ships with. They apply until a
+// tenant sets up the jurisdiction itself; any other country must be set up before use.
+var statutoryRates = map[string]float64{
+	"JO": TaxRateJO,
+	"SA": TaxRateSA,
+	"AE": TaxRateAE,
+	"US": TaxRateUS,
+	"GB": TaxRateGB,
+}
+
+// category code suffixes; standard codes have none.
+var categorySuffix = map[Category]string{ZeroRated: "-Z", Exempt: "-E"}
+
+// CodeFor returns the tax code of a category in a jurisdiction: VAT-JO, VAT-JO-Z or VAT-JO-E.
+func CodeFor(jurisdiction string, c Category) string {
+	return CodeForCountry(jurisdiction) + categorySuffix[c]
+}
+
+// ParseCode splits a tax code produced by CodeFor into its jurisdiction and category.
+func ParseCode(code string) (jurisdiction string, c Category, err error) {
+	rest, ok := strings.CutPrefix(strings.ToUpper(strings.TrimSpace(code)), "VAT-")
+	if !ok || rest == "" {
+		return "", "", fmt.Errorf("%w: %q", ErrUnknownCode, code)
+	}
+	c = Standard
+	for cat, suffix := range categorySuffix {
+		if s, ok := strings.CutSuffix(rest, suffix); ok {
+			rest, c = s, cat
+			break
+		}
+	}
+	if len(rest) != 2 || strings.ContainsFunc(rest, func(r rune) bool { return r < 'A' || r > 'Z' }) {
+		return "", "", fmt.Errorf("%w: %q", ErrUnknownCode, code)
+	}
+	return rest, c, nil
+}
+
+// Rate is a tax code resolved for a date.
+type Rate struct {
+	Code         string
+	Jurisdiction string
+	Category     Category
+	Rate         decimal.Decimal // Zero for zero-rated and exempt codes
+	Rounding     Rounding
+}
+
+// Exclusive returns the tax on a net amount.
+func (r Rate) Exclusive(net decimal.Decimal, places int32) decimal.Decimal {
+	return r.Rounding.Round(net.Mul(r.Rate), places)
+}
+
+// Inclusive splits a tax-inclusive amount into its net and tax parts.
+func (r Rate) Inclusive(gross decimal.Decimal, places int32) (net, tax decimal.Decimal) {
+	return SplitInclusive(gross, r.Rate, places, r.Rounding)
+}
+
+// Resolver finds the rates of a tenant's tax codes. It keeps the jurisdictions it has loaded,
+// so one resolver serves all the lines of a document.
+type Resolver struct {
+	db            *ent.Client
+	tenantID      int
+	jurisdictions map[string]*ent.TaxJurisdiction // nil entries: the tenant has not set it up
+}
+
+// NewResolver returns a resolver for the tenant's codes. Pass tx.Client() to read inside a transaction.
+func NewResolver(db *ent.Client, tenantID int) *Resolver {
+	return &Resolver{db: db, tenantID: tenantID, jurisdictions: make(map[string]*ent.TaxJurisdiction)}
+}
+
+// Resolve returns the rate of a code on a date. Codes of jurisdictions the tenant has not set
+// up resolve to the statutory rate only for the countries SENT ships with.
+func (r *Resolver) Resolve(ctx context.Context, code string, on time.Time) (Rate, error) {
+	jur, cat, err := ParseCode(code)
+	if err != nil {
+		return Rate{}, err
+	}
+	out := Rate{Code: CodeFor(jur, cat), Jurisdiction: jur, Category: cat, Rounding: RoundHalfUp}
+
+	j, ok := r.jurisdictions[jur]
+	if !ok {
+		j, err = r.db.TaxJurisdiction.Query().
+			Where(taxjurisdiction.Code(jur), taxjurisdiction.HasTenantWith(tenant.ID(r.tenantID))).
+			WithRates(func(q *ent.TaxRateQuery) { q.Order(ent.Desc(taxrate.FieldEffectiveFrom)) }).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return Rate{}, err
+		}
+		r.jurisdictions[jur] = j
+	}
+
+	if j == nil {
+		std, ok := statutoryRates[jur]
+		if !ok {
+			return Rate{}, fmt.Errorf("%w: %s: jurisdiction %s is not set up", ErrUnknownCode, code, jur)
+		}
+		if cat == Standard {
+			out.Rate = decimal.NewFromFloat(std)
+		}
+		return out, nil
+	}
+
+	if !j.IsActive {
+		return Rate{}, fmt.Errorf("%w: %s: jurisdiction %s is inactive", ErrUnknownCode, code, jur)
+	}
+	out.Rounding = Rounding(j.Rounding)
+	if cat != Standard {
+		return out, nil
+	}
+	for _, tr := range j.Edges.Rates { // Latest first
+		if !tr.EffectiveFrom.After(on) {
+			out.Rate = tr.Rate
+			return out, nil
+		}
+	}
+	return Rate{}, fmt.Errorf("%w: %s on %s", ErrNoRate, code, on.Format("2006-01-02"))
+}
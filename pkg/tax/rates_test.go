@@ -0,0 +1,79 @@
+package tax
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func dec(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func TestParseCode(t *testing.T) {
+	cases := []struct {
+		code string
+		jur  string
+		cat  Category
+	}{
+		{"VAT-JO", "JO", Standard},
+		{"vat-sa-z", "SA", ZeroRated},
+		{"VAT-GB-E", "GB", Exempt},
+	}
+	for _, c := range cases {
+		jur, cat, err := ParseCode(c.code)
+		if err != nil || jur != c.jur || cat != c.cat {
+			t.Errorf("ParseCode(%q) = %s, %s, %v", c.code, jur, cat, err)
+		}
+		if got := CodeFor(jur, cat); got != CodeFor(c.jur, c.cat) {
+			t.Errorf("CodeFor(%s, %s) = %s", jur, cat, got)
+		}
+	}
+	for _, bad := range []string{"", "GST-AU", "VAT-", "VAT-JOR", "VAT-J1", "VAT-JO-X"} {
+		if _, _, err := ParseCode(bad); !errors.Is(err, ErrUnknownCode) {
+			t.Errorf("ParseCode(%q) = %v", bad, err)
+		}
+	}
+}
+
+func TestRounding(t *testing.T) {
+	// 0.125 sits exactly between two cents.
+	if got := RoundHalfUp.Round(dec("0.125"), 2); !got.Equal(dec("0.13")) {
+		t.Errorf("half up = %s", got)
+	}
+	if got := RoundHalfEven.Round(dec("0.125"), 2); !got.Equal(dec("0.12")) {
+		t.Errorf("half even = %s", got)
+	}
+
+	r := Rate{Rate: dec("0.25"), Rounding: RoundHalfEven}
+	if got := r.Exclusive(dec("0.50"), 2); !got.Equal(dec("0.12")) {
+		t.Errorf("exclusive = %s", got)
+	}
+	// 0.625 gross at 25% holds 0.125 of tax.
+	net, tax := r.Inclusive(dec("0.625"), 3)
+	if !tax.Equal(dec("0.125")) || !net.Equal(dec("0.5")) {
+		t.Errorf("inclusive = %s + %s", net, tax)
+	}
+	r.Rounding = RoundHalfUp
+	if net, tax := r.Inclusive(dec("0.63"), 2); !tax.Equal(dec("0.13")) || !net.Add(tax).Equal(dec("0.63")) {
+		t.Errorf("inclusive half up = %s + %s", net, tax)
+	}
+
+	zero := Rate{Rate: decimal.Zero}
+	if net, tax := zero.Inclusive(dec("9.99"), 2); !net.Equal(dec("9.99")) || !tax.IsZero() {
+		t.Errorf("zero-rated = %s + %s", net, tax)
+	}
+}
+
+func TestSummarizeRounding(t *testing.T) {
+	lines := []TaxableLine{
+		{Code: "VAT-XX", Rate: dec("0.05"), Rounding: RoundHalfEven, Net: dec("0.30")},
+		{Code: "VAT-XX", Rate: dec("0.05"), Rounding: RoundHalfEven, Net: dec("0.20")},
+	}
+	// 0.50 x 5% = 0.025: banker's rounding keeps 0.02 where half up gives 0.03.
+	got := Summarize(lines, 2)
+	if len(got) != 1 || !got[0].Tax.Equal(dec("0.02")) {
+		t.Errorf("summary = %+v", got)
+	}
+}
@@ -0,0 +1,220 @@
+package tax
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"sent/ent"
+	"sent/ent/invoice"
+	"sent/ent/invoicetaxline"
+	"sent/ent/possaleline"
+	"sent/ent/supplierbill"
+	"sent/ent/supplierbillline"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+
+	"github.com/shopspring/decimal"
+)
+
+// Kinds of document in the tax registers.
+const (
+	KindInvoice    = "invoice"
+	KindCreditNote = "credit_note"
+	KindPOSSale    = "pos_sale"
+	KindPOSRefund  = "pos_refund"
+	KindBill       = "bill"
+)
+
+// RegisterLine is the tax on one document for one code and rate. Credit notes and refunds
+// are negative. Functional amounts are rounded per line, so a register adds up to its return.
+type RegisterLine struct {
+	Date           time.Time
+	Document       string
+	Kind           string
+	CounterpartyID string // Customer code or supplier ID; empty for walk-in sales
+	Counterparty   string
+	TaxID          string
+	TaxCode        string
+	Category       Category
+	Rate           decimal.Decimal
+	Currency       string
+	ExchangeRate   decimal.Decimal
+	Net            decimal.Decimal // Document currency
+	Tax            decimal.Decimal
+	NetFunctional  decimal.Decimal
+	TaxFunctional  decimal.Decimal
+}
+
+// Adjustment reports whether the line reverses an earlier supply.
+func (l RegisterLine) Adjustment() bool {
+	return l.Kind == KindCreditNote || l.Kind == KindPOSRefund
+}
+
+// registerLine fills in the category and functional amounts of a line. Codes that no longer
+// parse count as standard, as all codes did before categories.
+func registerLine(l RegisterLine, places int32) RegisterLine {
+	if _, cat, err := ParseCode(l.TaxCode); err == nil {
+		l.Category = cat
+	} else {
+		l.Category = Standard
+	}
+	l.NetFunctional = l.Net.Mul(l.ExchangeRate).Round(places)
+	l.TaxFunctional = l.Tax.Mul(l.ExchangeRate).Round(places)
+	return l
+}
+
+func sortRegister(lines []RegisterLine) {
+	sort.SliceStable(lines, func(i, j int) bool {
+		if !lines[i].Date.Equal(lines[j].Date) {
+			return lines[i].Date.Before(lines[j].Date)
+		}
+		if lines[i].Document != lines[j].Document {
+			return lines[i].Document < lines[j].Document
+		}
+		return lines[i].TaxCode < lines[j].TaxCode
+	})
+}
+
+// SalesRegister lists the tax lines of the invoices, credit notes and POS sales and refunds
+// dated in [from, to). POS sales are in the functional currency.
+func SalesRegister(ctx context.Context, db *ent.Client, tenantID int, from, to time.Time, places int32) ([]RegisterLine, error) {
+	tnt, err := db.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	var out []RegisterLine
+
+	invLines, err := db.InvoiceTaxLine.Query().
+		Where(invoicetaxline.HasInvoiceWith(
+			invoice.HasTenantWith(tenant.ID(tenantID)),
+			invoice.StatusNotIn(invoice.StatusDraft, invoice.StatusVoid),
+			invoice.IssueDateGTE(from), invoice.IssueDateLT(to),
+		)).
+		WithInvoice(func(q *ent.InvoiceQuery) { q.WithCustomer() }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range invLines {
+		inv := l.Edges.Invoice
+		line := RegisterLine{
+			Date:         inv.IssueDate,
+			Document:     inv.Number,
+			Kind:         KindInvoice,
+			TaxCode:      l.TaxCode,
+			Rate:         l.Rate,
+			Currency:     inv.Currency,
+			ExchangeRate: inv.ExchangeRate,
+			Net:          l.TaxableAmount,
+			Tax:          l.TaxAmount,
+		}
+		if inv.Kind == invoice.KindCreditNote {
+			line.Kind = KindCreditNote
+			line.Net, line.Tax = line.Net.Neg(), line.Tax.Neg()
+		}
+		if c := inv.Edges.Customer; c != nil {
+			line.CounterpartyID, line.Counterparty, line.TaxID = c.Code, c.Name, c.TaxID
+		}
+		out = append(out, registerLine(line, places))
+	}
+
+	posLines, err := db.PosSaleLine.Query().
+		Where(possaleline.HasTransactionWith(
+			transaction.HasTenantWith(tenant.ID(tenantID)),
+			transaction.ApprovalStatusEQ(transaction.ApprovalStatusAPPROVED),
+			transaction.DateGTE(from), transaction.DateLT(to),
+		), possaleline.TaxCodeNEQ("")).
+		WithTransaction(func(q *ent.TransactionQuery) { q.WithRefundedSale().WithCustomer() }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// A POS document is its transaction; lines are grouped per code and rate like the tax
+	// lines of an invoice.
+	type key struct {
+		txn        int
+		code, rate string
+	}
+	groups := make(map[key]*RegisterLine)
+	var order []key
+	for _, l := range posLines {
+		txn := l.Edges.Transaction
+		k := key{txn.ID, l.TaxCode, l.TaxRate.String()}
+		g, ok := groups[k]
+		if !ok {
+			g = &RegisterLine{
+				Date:         txn.Date,
+				Document:     SaleNumber(txn),
+				Kind:         KindPOSSale,
+				TaxCode:      l.TaxCode,
+				Rate:         l.TaxRate,
+				Currency:     tnt.FunctionalCurrency,
+				ExchangeRate: decimal.NewFromInt(1),
+			}
+			if txn.Edges.RefundedSale != nil {
+				g.Kind = KindPOSRefund
+			}
+			if c := txn.Edges.Customer; c != nil {
+				g.CounterpartyID, g.Counterparty, g.TaxID = c.Code, c.Name, c.TaxID
+			}
+			groups[k] = g
+			order = append(order, k)
+		}
+		net, tax := l.NetAmount, l.TaxAmount
+		if g.Kind == KindPOSRefund {
+			net, tax = net.Neg(), tax.Neg()
+		}
+		g.Net, g.Tax = g.Net.Add(net), g.Tax.Add(tax)
+	}
+	for _, k := range order {
+		out = append(out, registerLine(*groups[k], places))
+	}
+
+	sortRegister(out)
+	return out, nil
+}
+
+// PurchaseRegister lists the tax of the supplier bills dated in [from, to), per code and
+// rate. Bills keep no tax per line, so it is summarized from their lines as the bill was.
+func PurchaseRegister(ctx context.Context, db *ent.Client, tenantID int, from, to time.Time, places int32) ([]RegisterLine, error) {
+	bills, err := db.SupplierBill.Query().
+		Where(
+			supplierbill.HasTenantWith(tenant.ID(tenantID)),
+			supplierbill.StatusNEQ(supplierbill.StatusVoid),
+			supplierbill.BillDateGTE(from), supplierbill.BillDateLT(to),
+		).
+		WithSupplier().
+		WithLines(func(q *ent.SupplierBillLineQuery) { q.Where(supplierbillline.TaxCodeNEQ("")) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []RegisterLine
+	for _, b := range bills {
+		taxable := make([]TaxableLine, len(b.Edges.Lines))
+		for i, l := range b.Edges.Lines {
+			taxable[i] = TaxableLine{Code: l.TaxCode, Rate: l.TaxRate, Net: l.NetAmount}
+		}
+		for _, tl := range Summarize(taxable, places) {
+			line := RegisterLine{
+				Date:         b.BillDate,
+				Document:     b.Number,
+				Kind:         KindBill,
+				TaxCode:      tl.Code,
+				Rate:         tl.Rate,
+				Currency:     b.Currency,
+				ExchangeRate: b.ExchangeRate,
+				Net:          tl.Taxable,
+				Tax:          tl.Tax,
+			}
+			if s := b.Edges.Supplier; s != nil {
+				line.CounterpartyID, line.Counterparty, line.TaxID = strconv.Itoa(s.ID), s.Name, s.TaxID
+			}
+			out = append(out, registerLine(line, places))
+		}
+	}
+	sortRegister(out)
+	return out, nil
+}
@@ -0,0 +1,131 @@
+package tax
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func testRegisters() (sales, purchases []RegisterLine) {
+	d := decimal.RequireFromString
+	day := time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)
+	line := func(doc, kind, code, rate, net, tax, fx string) RegisterLine {
+		return registerLine(RegisterLine{
+			Date: day, Document: doc, Kind: kind, CounterpartyID: "C1", Counterparty: "Acme, Inc.",
+			TaxCode: code, Rate: d(rate), Currency: "EUR", ExchangeRate: d(fx), Net: d(net), Tax: d(tax),
+		}, 2)
+	}
+	sales = []RegisterLine{
+		line("INV-1", KindInvoice, "VAT-SA", "0.15", "100", "15", "1"),
+		line("INV-1", KindInvoice, "VAT-SA-Z", "0", "40", "0", "1"),
+		line("INV-2", KindInvoice, "VAT-SA-E", "0", "10.005", "0", "2"),
+		line("CN-1", KindCreditNote, "VAT-SA", "0.15", "-20", "-3", "1"),
+		line("POS-R000001", KindPOSRefund, "LEGACY", "0.15", "-10", "-1.5", "1"),
+	}
+	purchases = []RegisterLine{
+		line("BILL-1", KindBill, "VAT-SA", "0.15", "50", "7.5", "1"),
+	}
+	return sales, purchases
+}
+
+func TestVATReturnRegisters(t *testing.T) {
+	sales, purchases := testRegisters()
+	if sales[2].NetFunctional.String() != "20.01" {
+		t.Errorf("functional net = %s, want 20.01", sales[2].NetFunctional)
+	}
+	if sales[4].Category != Standard {
+		t.Errorf("unparseable code counted as %s", sales[4].Category)
+	}
+
+	r := &VATReturn{OutputTax: decimal.NewFromInt(12), InputTax: decimal.NewFromInt(10)}
+	r.addRegisters(sales, purchases)
+	want := map[string]string{
+		"standard":   r.StandardSales.String(),
+		"zero":       r.ZeroRatedSales.String(),
+		"exempt":     r.ExemptSales.String(),
+		"adjust":     r.SalesAdjustments.String(),
+		"adjust tax": r.OutputAdjustments.String(),
+		"other out":  r.OtherOutputTax.String(),
+		"purchases":  r.Purchases.String(),
+		"other in":   r.OtherInputTax.String(),
+	}
+	for k, v := range map[string]string{
+		"standard": "70", "zero": "40", "exempt": "20.01", "adjust": "-30", "adjust tax": "-4.5",
+		"other out": "1.5", "purchases": "50", "other in": "2.5",
+	} {
+		if want[k] != v {
+			t.Errorf("%s = %s, want %s", k, want[k], v)
+		}
+	}
+}
+
+func TestRegisterCSV(t *testing.T) {
+	sales, _ := testRegisters()
+	out, err := registerCSV(sales)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(rows) != len(sales)+1 || !strings.HasPrefix(rows[0], "Date,Document,Kind") {
+		t.Fatalf("csv has %d rows: %q", len(rows), rows[0])
+	}
+	if !strings.Contains(rows[1], `"Acme, Inc."`) || !strings.HasSuffix(rows[1], ",100,15") {
+		t.Errorf("first row = %q", rows[1])
+	}
+}
+
+func TestAuditInvoices(t *testing.T) {
+	sales, purchases := testRegisters()
+	inv := auditInvoices(sales, true)
+	if inv.NumberOfEntries != 4 || len(inv.Invoices[0].Lines) != 2 {
+		t.Fatalf("sales documents = %d, first has %d lines", inv.NumberOfEntries, len(inv.Invoices[0].Lines))
+	}
+	if got := inv.Invoices[0].DocumentTotals; got.NetTotal != "140" || got.TaxPayable != "15" || got.GrossTotal != "155" {
+		t.Errorf("INV-1 totals = %+v", got)
+	}
+	if inv.TotalCredit != "175.01" || inv.TotalDebit != "34.5" {
+		t.Errorf("sales credit %s debit %s", inv.TotalCredit, inv.TotalDebit)
+	}
+	if amt := inv.Invoices[1].Lines[0].TaxAmount; amt.CurrencyCode != "EUR" || amt.ExchangeRate != "2" {
+		t.Errorf("foreign-currency tax amount = %+v", amt)
+	}
+	if country := auditCountry(sales, purchases); country != "SA" {
+		t.Errorf("country = %q", country)
+	}
+
+	f := &AuditFile{Namespace: safTNamespace, SourceDocuments: AuditSourceDocuments{SalesInvoices: inv, PurchaseInvoices: auditInvoices(purchases, false)}}
+	f.MasterFiles.TaxTable.Codes = auditTaxTable(sales, purchases)
+	data, err := f.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back AuditFile
+	if err := xml.Unmarshal(data, &back); err != nil {
+		t.Fatalf("audit file does not parse: %v", err)
+	}
+	if len(back.MasterFiles.TaxTable.Codes) != 4 || back.SourceDocuments.PurchaseInvoices.Invoices[0].SupplierID != "C1" {
+		t.Errorf("round trip lost data: %d tax codes", len(back.MasterFiles.TaxTable.Codes))
+	}
+}
+
+func TestReportPDFs(t *testing.T) {
+	sales, purchases := testRegisters()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 3, 0)
+	r := &VATReturn{From: from, To: to, OutputTax: decimal.NewFromInt(12), InputTax: decimal.NewFromInt(10)}
+	r.addRegisters(sales, purchases)
+
+	if pdf, err := returnPDF(r, "Acme", "300000000000003", "SAR", "2026-Q1", 2); err != nil || !strings.HasPrefix(string(pdf), "%PDF") {
+		t.Errorf("returnPDF: %v", err)
+	}
+	if pdf, err := registerPDF("SALES TAX REGISTER", sales, "Acme", "300000000000003", "SAR", "2026-Q1", from, to, 2); err != nil || !strings.HasPrefix(string(pdf), "%PDF") {
+		t.Errorf("registerPDF: %v", err)
+	}
+	out, err := returnCSV(r, 2)
+	if err != nil || !strings.Contains(string(out), "5,Net tax payable (reclaimable if negative),2.00") {
+		t.Errorf("returnCSV = %s, %v", out, err)
+	}
+}
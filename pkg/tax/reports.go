@@ -0,0 +1,202 @@
+package tax
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"sent/ent/fiscalperiod"
+	"sent/ent/tenant"
+	"sent/pkg/database"
+)
+
+// DocumentStore persists generated documents. It is satisfied by the SENTvault bridge.
+type DocumentStore interface {
+	SaveFileAsTenant(path string, base64Content string, tenantID int) error
+}
+
+// SetDocumentStore sets where exported filings are stored.
+func (t *TaxBridge) SetDocumentStore(docs DocumentStore) {
+	t.docs = docs
+}
+
+// Reports that can be exported, and the formats each comes in.
+var reportFormats = map[string][]string{
+	"vat_return":        {"pdf", "csv"},
+	"sales_register":    {"pdf", "csv"},
+	"purchase_register": {"pdf", "csv"},
+	"audit_file":        {"xml"},
+}
+
+// TaxRegisterLineDTO is a line of the sales or purchase register.
+type TaxRegisterLineDTO struct {
+	Date          time.Time `json:"date"`
+	Document      string    `json:"document"`
+	Kind          string    `json:"kind"`
+	Counterparty  string    `json:"counterparty"`
+	TaxID         string    `json:"taxId"`
+	TaxCode       string    `json:"taxCode"`
+	Category      string    `json:"category"`
+	Rate          float64   `json:"rate"`
+	Currency      string    `json:"currency"`
+	Net           float64   `json:"net"`
+	Tax           float64   `json:"tax"`
+	NetFunctional float64   `json:"netFunctional"`
+	TaxFunctional float64   `json:"taxFunctional"`
+}
+
+// periodRange resolves a period: "2026-Q1", "2026-03", "2026", or the name of a fiscal period
+// such as "FY2026-03". To is exclusive.
+func (t *TaxBridge) periodRange(tenantID int, period string) (from, to time.Time, err error) {
+	from, to, err = ParsePeriod(period, time.Local)
+	if err == nil {
+		return from, to, nil
+	}
+	fp, fpErr := t.db.FiscalPeriod.Query().
+		Where(fiscalperiod.Name(period), fiscalperiod.HasTenantWith(tenant.ID(tenantID))).
+		Only(t.ctx)
+	if fpErr != nil {
+		return from, to, err
+	}
+	return fp.StartDate, fp.EndDate.Add(time.Nanosecond), nil
+}
+
+// GetTaxRegister lists the sales or purchase register of a period.
+func (t *TaxBridge) GetTaxRegister(kind, period string) ([]TaxRegisterLineDTO, error) {
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return nil, err
+	}
+	tnt, err := t.db.Tenant.Get(t.ctx, profile.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := t.periodRange(tnt.ID, strings.TrimSpace(period))
+	if err != nil {
+		return nil, err
+	}
+	places := t.currencyPlaces(tnt.FunctionalCurrency)
+	var lines []RegisterLine
+	switch kind {
+	case "sales":
+		lines, err = SalesRegister(t.ctx, t.db, tnt.ID, from, to, places)
+	case "purchases":
+		lines, err = PurchaseRegister(t.ctx, t.db, tnt.ID, from, to, places)
+	default:
+		return nil, fmt.Errorf("unknown register %q: use sales or purchases", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := make([]TaxRegisterLineDTO, len(lines))
+	for i, l := range lines {
+		out[i] = TaxRegisterLineDTO{
+			Date:          l.Date,
+			Document:      l.Document,
+			Kind:          l.Kind,
+			Counterparty:  l.Counterparty,
+			TaxID:         l.TaxID,
+			TaxCode:       l.TaxCode,
+			Category:      string(l.Category),
+			Rate:          l.Rate.InexactFloat64(),
+			Currency:      l.Currency,
+			Net:           l.Net.InexactFloat64(),
+			Tax:           l.Tax.InexactFloat64(),
+			NetFunctional: l.NetFunctional.InexactFloat64(),
+			TaxFunctional: l.TaxFunctional.InexactFloat64(),
+		}
+	}
+	return out, nil
+}
+
+// ExportTaxReport renders a report of a period and files it in SENTvault under
+// tax/<period>/, where the accountant picks up exactly what was filed. Report is vat_return,
+// sales_register, purchase_register or audit_file; format is pdf or csv, or xml for the
+// audit file. It returns the path.
+func (t *TaxBridge) ExportTaxReport(report, period, format string) (string, error) {
+	if !t.auth.HasRole("admin") {
+		return "", fmt.Errorf("permission denied: only admins can export tax filings")
+	}
+	if t.docs == nil {
+		return "", fmt.Errorf("document storage is not configured")
+	}
+	formats, ok := reportFormats[report]
+	if !ok {
+		return "", fmt.Errorf("unknown report %q", report)
+	}
+	format = strings.ToLower(format)
+	if !slices.Contains(formats, format) {
+		return "", fmt.Errorf("%s is exported as %s", strings.ReplaceAll(report, "_", " "), strings.Join(formats, " or "))
+	}
+	profile, err := t.auth.GetUserProfile()
+	if err != nil {
+		return "", err
+	}
+	tnt, err := t.db.Tenant.Get(t.ctx, profile.TenantID)
+	if err != nil {
+		return "", err
+	}
+	period = strings.TrimSpace(period)
+	from, to, err := t.periodRange(tnt.ID, period)
+	if err != nil {
+		return "", err
+	}
+	places := t.currencyPlaces(tnt.FunctionalCurrency)
+	currency := tnt.FunctionalCurrency
+
+	var file []byte
+	switch report {
+	case "vat_return":
+		r, err := BuildVATReturn(t.ctx, t.db, tnt.ID, from, to, places)
+		if err != nil {
+			return "", err
+		}
+		if format == "csv" {
+			file, err = returnCSV(r, places)
+		} else {
+			file, err = returnPDF(r, tnt.Name, tnt.TaxNumber, currency, period, places)
+		}
+		if err != nil {
+			return "", err
+		}
+	case "sales_register", "purchase_register":
+		build, title := SalesRegister, "SALES TAX REGISTER"
+		if report == "purchase_register" {
+			build, title = PurchaseRegister, "PURCHASE TAX REGISTER"
+		}
+		lines, err := build(t.ctx, t.db, tnt.ID, from, to, places)
+		if err != nil {
+			return "", err
+		}
+		if format == "csv" {
+			file, err = registerCSV(lines)
+		} else {
+			file, err = registerPDF(title, lines, tnt.Name, tnt.TaxNumber, currency, period, from, to, places)
+		}
+		if err != nil {
+			return "", err
+		}
+	case "audit_file":
+		f, err := BuildAuditFile(t.ctx, t.db, tnt.ID, from, to, places)
+		if err != nil {
+			return "", err
+		}
+		if file, err = f.Marshal(); err != nil {
+			return "", err
+		}
+	}
+
+	path := fmt.Sprintf("tax/%s/%s_%s.%s", period, report, period, format)
+	if err := t.docs.SaveFileAsTenant(path, base64.StdEncoding.EncodeToString(file), tnt.ID); err != nil {
+		return "", fmt.Errorf("vault storage failed: %w", err)
+	}
+	database.LogAuditRecord(t.ctx, t.db, tnt.ID, "SENTcapital", "tax_report_exported", profile.Email, map[string]interface{}{
+		"report": report,
+		"period": period,
+		"format": format,
+		"path":   path,
+	})
+	return path, nil
+}
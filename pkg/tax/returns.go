@@ -0,0 +1,154 @@
+package tax
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"sent/ent"
+	"sent/ent/account"
+	"sent/ent/ledgerentry"
+	"sent/ent/tenant"
+	"sent/ent/transaction"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	quarterPeriod = regexp.MustCompile(`^(\d{4})-Q([1-4])$`)
+	monthPeriod   = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+	yearPeriod    = regexp.MustCompile(`^(\d{4})$`)
+)
+
+// ParsePeriod turns a return period, "2026-Q1", "2026-03" or "2026", into the dates it spans.
+// To is exclusive: the first moment after the period.
+func ParsePeriod(period string, loc *time.Location) (from, to time.Time, err error) {
+	if m := quarterPeriod.FindStringSubmatch(period); m != nil {
+		y, _ := strconv.Atoi(m[1])
+		q, _ := strconv.Atoi(m[2])
+		from = time.Date(y, time.Month(3*q-2), 1, 0, 0, 0, 0, loc)
+		return from, from.AddDate(0, 3, 0), nil
+	}
+	if m := monthPeriod.FindStringSubmatch(period); m != nil {
+		y, _ := strconv.Atoi(m[1])
+		mo, _ := strconv.Atoi(m[2])
+		if mo >= 1 && mo <= 12 {
+			from = time.Date(y, time.Month(mo), 1, 0, 0, 0, 0, loc)
+			return from, from.AddDate(0, 1, 0), nil
+		}
+	}
+	if m := yearPeriod.FindStringSubmatch(period); m != nil {
+		y, _ := strconv.Atoi(m[1])
+		from = time.Date(y, 1, 1, 0, 0, 0, 0, loc)
+		return from, from.AddDate(1, 0, 0), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("unrecognised period %q: use 2026-Q1, 2026-03 or 2026", period)
+}
+
+// VATReturn is what a tenant owes for a period. Tax comes from what was posted to the output
+// and input VAT accounts; the supplies behind it come from the sales and purchase registers.
+// All amounts are in the functional currency.
+type VATReturn struct {
+	From           time.Time
+	To             time.Time // Exclusive
+	OutputTax      decimal.Decimal
+	InputTax       decimal.Decimal
+	StandardSales  decimal.Decimal // Net of credit notes and refunds
+	ZeroRatedSales decimal.Decimal
+	ExemptSales    decimal.Decimal
+	Purchases      decimal.Decimal // Net purchases that carried a tax code
+	// Credit notes and POS refunds in the period, already netted off the sales above.
+	SalesAdjustments  decimal.Decimal
+	OutputAdjustments decimal.Decimal
+	// Tax posted to the VAT accounts other than by the registers' documents: journals,
+	// corrections and rounding. Included in OutputTax and InputTax.
+	OtherOutputTax decimal.Decimal
+	OtherInputTax  decimal.Decimal
+}
+
+// NetPayable is output less input tax; negative means a refund is due.
+func (r *VATReturn) NetPayable() decimal.Decimal {
+	return r.OutputTax.Sub(r.InputTax)
+}
+
+// BuildVATReturn builds the return for [from, to) from approved transactions and issued documents.
+func BuildVATReturn(ctx context.Context, db *ent.Client, tenantID int, from, to time.Time, places int32) (*VATReturn, error) {
+	r := &VATReturn{From: from, To: to}
+
+	var err error
+	if r.OutputTax, err = accountMovement(ctx, db, tenantID, AccountOutputTax, from, to); err != nil {
+		return nil, err
+	}
+	if r.InputTax, err = accountMovement(ctx, db, tenantID, AccountInputTax, from, to); err != nil {
+		return nil, err
+	}
+	r.OutputTax = r.OutputTax.Neg() // A liability grows with credits
+
+	sales, err := SalesRegister(ctx, db, tenantID, from, to, places)
+	if err != nil {
+		return nil, err
+	}
+	purchases, err := PurchaseRegister(ctx, db, tenantID, from, to, places)
+	if err != nil {
+		return nil, err
+	}
+	r.addRegisters(sales, purchases)
+	return r, nil
+}
+
+// addRegisters totals the registers into the return, against the tax already read from the
+// ledger.
+func (r *VATReturn) addRegisters(sales, purchases []RegisterLine) {
+	supplies := map[Category]*decimal.Decimal{
+		Standard:  &r.StandardSales,
+		ZeroRated: &r.ZeroRatedSales,
+		Exempt:    &r.ExemptSales,
+	}
+	documented := decimal.Zero
+	for _, l := range sales {
+		if total, ok := supplies[l.Category]; ok {
+			*total = total.Add(l.NetFunctional)
+		}
+		if l.Adjustment() {
+			r.SalesAdjustments = r.SalesAdjustments.Add(l.NetFunctional)
+			r.OutputAdjustments = r.OutputAdjustments.Add(l.TaxFunctional)
+		}
+		documented = documented.Add(l.TaxFunctional)
+	}
+	r.OtherOutputTax = r.OutputTax.Sub(documented)
+
+	documented = decimal.Zero
+	for _, l := range purchases {
+		r.Purchases = r.Purchases.Add(l.NetFunctional)
+		documented = documented.Add(l.TaxFunctional)
+	}
+	r.OtherInputTax = r.InputTax.Sub(documented)
+}
+
+// accountMovement returns debits less credits on an account over [from, to).
+func accountMovement(ctx context.Context, db *ent.Client, tenantID int, number string, from, to time.Time) (decimal.Decimal, error) {
+	entries, err := db.LedgerEntry.Query().
+		Where(
+			ledgerentry.HasTenantWith(tenant.ID(tenantID)),
+			ledgerentry.HasAccountWith(account.Number(number)),
+			ledgerentry.HasTransactionWith(
+				transaction.ApprovalStatusEQ(transaction.ApprovalStatusAPPROVED),
+				transaction.DateGTE(from), transaction.DateLT(to),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	sum := decimal.Zero
+	for _, e := range entries {
+		if e.Direction == ledgerentry.DirectionDebit {
+			sum = sum.Add(e.Amount)
+		} else {
+			sum = sum.Sub(e.Amount)
+		}
+	}
+	return sum, nil
+}
@@ -0,0 +1,29 @@
+package tax
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	cases := []struct {
+		period   string
+		from, to string
+	}{
+		{"2026-Q1", "2026-01-01", "2026-04-01"},
+		{"2026-Q4", "2026-10-01", "2027-01-01"},
+		{"2026-02", "2026-02-01", "2026-03-01"},
+		{"2026", "2026-01-01", "2027-01-01"},
+	}
+	for _, c := range cases {
+		from, to, err := ParsePeriod(c.period, time.UTC)
+		if err != nil || from.Format("2006-01-02") != c.from || to.Format("2006-01-02") != c.to {
+			t.Errorf("ParsePeriod(%q) = %s..%s, %v", c.period, from, to, err)
+		}
+	}
+	for _, bad := range []string{"", "2026-Q5", "2026-13", "Q1-2026", "FY2026-03"} {
+		if _, _, err := ParsePeriod(bad, time.UTC); err == nil {
+			t.Errorf("ParsePeriod(%q) accepted", bad)
+		}
+	}
+}
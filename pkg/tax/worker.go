@@ -2,11 +2,20 @@ package tax
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"time"
+
 	"sent/ent"
+	"sent/ent/einvoice"
+	"sent/ent/einvoicecredential"
+	"sent/ent/schema"
 	"sent/ent/transaction"
+	"sent/pkg/crypto"
+	"sent/pkg/database"
+	"sent/pkg/tax/clearance"
 
-	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
 )
 
@@ -17,56 +26,188 @@ type TaxSubmissionArgs struct {
 
 func (TaxSubmissionArgs) Kind() string { return "tax:submission" }
 
-// TaxSubmissionWorker handles the resilient submission of invoices to government portals.
+// TaxSubmissionWorker clears or reports the e-invoice of a transaction with the portal of
+// the authority that issued its CSID, and records the verdict on the transaction.
 type TaxSubmissionWorker struct {
 	river.WorkerDefaults[TaxSubmissionArgs]
 	db *ent.Client
+	// connect picks the portal; tests point it at a sandbox.
+	connect func(authority, environment string) (clearance.Connector, error)
 }
 
 func NewTaxSubmissionWorker(db *ent.Client) *TaxSubmissionWorker {
-	return &TaxSubmissionWorker{db: db}
+	return &TaxSubmissionWorker{db: db, connect: clearance.For}
 }
 
+// Work submits the document. Only transient failures are returned for River to retry with
+// backoff; a portal that asks for time gets it through a snooze. Anything else cancels the
+// job and marks the transaction failed, since retrying would fail the same way.
 func (w *TaxSubmissionWorker) Work(ctx context.Context, job *river.Job[TaxSubmissionArgs]) error {
-	// 1. Fetch transaction
 	txn, err := w.db.Transaction.Query().
 		Where(transaction.ID(job.Args.TransactionID)).
+		WithTenant().
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to fetch transaction: %w", err)
 	}
-
-	// 2. Strict Idempotency Check (Local)
-	// If the transaction already has a government reference, it was already successfully submitted.
-	if txn.Reference != "" {
-		fmt.Printf("[TAX-WORKER] Transaction %d already cleared with reference %s. Skipping.\n", txn.ID, txn.Reference)
+	// Idempotency: only pending documents go out. A retry after the verdict was saved stops here.
+	if txn.ClearanceStatus == nil || *txn.ClearanceStatus != transaction.ClearanceStatusPending {
 		return nil
 	}
 
-	// 3. Simulate Government API Call with Exponential Backoff (handled by River)
-	// We use the Transaction UUID as the idempotency key for the government portal.
-	err = w.submitToGovernmentPortal(ctx, txn)
+	e, err := w.db.EInvoice.Query().
+		Where(einvoice.HasTransactionWith(transaction.ID(txn.ID))).
+		WithCredential().
+		Only(ctx)
 	if err != nil {
-		// Returning an error here triggers River's exponential backoff retry mechanism.
-		return fmt.Errorf("government portal submission failed: %w", err)
+		return w.fail(ctx, txn, "e-invoice", fmt.Errorf("no e-invoice to submit: %w", err))
+	}
+	cred := e.Edges.Credential
+	conn, err := w.connect(string(cred.Authority), string(cred.Environment))
+	if err != nil {
+		return w.fail(ctx, txn, "configuration", err)
+	}
+	creds, err := portalCredentials(cred)
+	if err != nil {
+		return w.fail(ctx, txn, "credentials", err)
 	}
 
-	// 4. Update Transaction metadata on success
-	err = w.db.Transaction.UpdateOneID(txn.ID).
-		SetReference(fmt.Sprintf("GOV-CLEARED-%s", uuid.New().String())).
-		Exec(ctx)
+	res, err := conn.Submit(ctx, creds, clearance.Document{
+		Number:     e.Number,
+		UUID:       e.UUID,
+		Hash:       e.InvoiceHash,
+		XML:        []byte(e.XML),
+		Simplified: e.Profile == einvoice.ProfileSimplified,
+	})
+	if err != nil {
+		if !clearance.IsTransient(err) {
+			return w.fail(ctx, txn, "submission", err)
+		}
+		if d := clearance.RetryAfter(err); d > 0 {
+			return river.JobSnooze(d)
+		}
+		return fmt.Errorf("e-invoice %s not submitted: %w", e.Number, err)
+	}
+
+	status := transaction.ClearanceStatus(res.Status)
+	tx, err := w.db.Tx(ctx)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
+	// JoFotara signs what it clears, so its QR replaces the one printed at the counter.
+	if len(res.Cleared) > 0 || res.QR != "" {
+		eu := tx.EInvoice.UpdateOne(e)
+		if len(res.Cleared) > 0 {
+			eu.SetClearedXML(string(res.Cleared))
+		}
+		if res.QR != "" {
+			eu.SetQr(res.QR)
+		}
+		if err := eu.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	update := tx.Transaction.UpdateOne(txn).
+		SetClearanceStatus(status).
+		SetClearanceMessages(clearanceMessages(res.Messages))
+	if res.Status != clearance.StatusRejected {
+		update.SetClearedAt(time.Now())
+	}
+	if err := update.Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
 
+	if res.Status == clearance.StatusRejected {
+		database.LogAuditRecord(ctx, w.db, txn.Edges.Tenant.ID, "SENTcapital", "einvoice_rejected", "system", map[string]interface{}{
+			"transaction_id": txn.ID,
+			"number":         e.Number,
+			"authority":      string(cred.Authority),
+		})
+	}
 	return nil
 }
 
-func (w *TaxSubmissionWorker) submitToGovernmentPortal(ctx context.Context, txn *ent.Transaction) error {
-	// In a real implementation, this would be an HTTP call to ZATCA/JoFotara/etc.
-	// We pass txn.UUID as an 'Idempotency-Key' header.
-	fmt.Printf("[TAX-WORKER] Submitting Transaction %d to gov portal. Idempotency Key: %s\n", txn.ID, txn.UUID)
-	
-	// Mock success
+// fail records why a document could not be submitted and stops retries.
+func (w *TaxSubmissionWorker) fail(ctx context.Context, txn *ent.Transaction, code string, cause error) error {
+	err := w.db.Transaction.UpdateOne(txn).
+		SetClearanceStatus(transaction.ClearanceStatusFailed).
+		SetClearanceMessages([]schema.ClearanceMessage{{Type: "error", Code: code, Message: cause.Error()}}).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return river.JobCancel(cause)
+}
+
+// portalCredentials decrypts what the portal authenticates a unit with. ZATCA takes the
+// CSID as its binarySecurityToken: the base64 of the base64 certificate.
+func portalCredentials(cred *ent.EInvoiceCredential) (clearance.Credentials, error) {
+	var c clearance.Credentials
+	if cred.SecretEncrypted != "" {
+		secret, err := crypto.Decrypt(cred.SecretEncrypted)
+		if err != nil {
+			return c, fmt.Errorf("cannot read the API secret: %w", err)
+		}
+		c.Secret = secret
+	}
+	switch cred.Authority {
+	case einvoicecredential.AuthorityJofotara:
+		c.Token = cred.ClientID
+	default:
+		c.Token = base64.StdEncoding.EncodeToString([]byte(cred.Certificate))
+		c.RequestID = cred.ComplianceRequestID
+	}
+	if c.Secret == "" {
+		return c, fmt.Errorf("the %s credentials have no API secret; onboard the unit again", cred.Authority)
+	}
+	return c, nil
+}
+
+func clearanceMessages(msgs []clearance.Message) []schema.ClearanceMessage {
+	out := make([]schema.ClearanceMessage, len(msgs))
+	for i, m := range msgs {
+		out[i] = schema.ClearanceMessage{Type: m.Type, Code: m.Code, Category: m.Category, Message: m.Text}
+	}
+	return out
+}
+
+// ClearanceSweepArgs starts a sweep for e-invoices waiting to be submitted.
+type ClearanceSweepArgs struct{}
+
+func (ClearanceSweepArgs) Kind() string { return "tax:clearance_sweep" }
+
+// ClearanceSweepWorker queues a submission for every transaction whose e-invoice is pending.
+// Documents are signed inside the posting's database transaction, which River's client does
+// not share, so they are picked up here rather than queued as they are issued.
+type ClearanceSweepWorker struct {
+	river.WorkerDefaults[ClearanceSweepArgs]
+	db *ent.Client
+}
+
+func NewClearanceSweepWorker(db *ent.Client) *ClearanceSweepWorker {
+	return &ClearanceSweepWorker{db: db}
+}
+
+func (w *ClearanceSweepWorker) Work(ctx context.Context, job *river.Job[ClearanceSweepArgs]) error {
+	ids, err := w.db.Transaction.Query().
+		Where(transaction.ClearanceStatusEQ(transaction.ClearanceStatusPending)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	client := river.ClientFromContext[pgx.Tx](ctx)
+	for _, id := range ids {
+		// One job per transaction, however many sweeps see it pending.
+		_, err := client.Insert(ctx, TaxSubmissionArgs{TransactionID: id}, &river.InsertOpts{
+			UniqueOpts: river.UniqueOpts{ByArgs: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to queue e-invoice of transaction %d: %w", id, err)
+		}
+	}
 	return nil
-}
\ No newline at end of file
+}
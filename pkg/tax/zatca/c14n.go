@@ -0,0 +1,264 @@
+package zatca
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+const nsXML = "http://www.w3.org/XML/1998/namespace"
+
+// element is a parsed XML element. Names keep the prefix they were written with, for output,
+// and the namespace it resolves to, for matching.
+type element struct {
+	prefix, local, uri string
+	attrs              []attribute
+	scope              map[string]string // Namespaces in scope, by prefix, its own declarations included
+	children           []any             // *element or string
+	parent             *element
+
+	// Byte offsets into the source of where the start tag begins and ends.
+	start, startEnd int64
+}
+
+type attribute struct {
+	prefix, local, uri, value string
+}
+
+// parseXML reads a document into a tree. Comments, the XML declaration and the DOCTYPE are
+// dropped; canonical XML leaves them out too.
+func parseXML(data []byte) (*element, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var root, cur *element
+	for {
+		offset := d.InputOffset()
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid XML: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			el := &element{prefix: t.Name.Space, local: t.Name.Local, parent: cur, start: offset, startEnd: d.InputOffset()}
+			el.scope = make(map[string]string)
+			if cur != nil {
+				for p, u := range cur.scope {
+					el.scope[p] = u
+				}
+			}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					el.declare("", a.Value)
+				case a.Name.Space == "xmlns":
+					el.declare(a.Name.Local, a.Value)
+				default:
+					el.attrs = append(el.attrs, attribute{prefix: a.Name.Space, local: a.Name.Local, value: a.Value})
+				}
+			}
+			var ok bool
+			if el.uri, ok = el.resolve(el.prefix); !ok {
+				return nil, fmt.Errorf("invalid XML: undeclared prefix %q on <%s>", el.prefix, el.local)
+			}
+			for i, a := range el.attrs {
+				if a.prefix == "" {
+					continue // Unprefixed attributes are in no namespace
+				}
+				if el.attrs[i].uri, ok = el.resolve(a.prefix); !ok {
+					return nil, fmt.Errorf("invalid XML: undeclared prefix %q on attribute %s", a.prefix, a.local)
+				}
+			}
+			if cur == nil {
+				if root != nil {
+					return nil, fmt.Errorf("invalid XML: more than one root element")
+				}
+				root = el
+			} else {
+				cur.children = append(cur.children, el)
+			}
+			cur = el
+		case xml.EndElement:
+			if cur == nil {
+				return nil, fmt.Errorf("invalid XML: unexpected </%s>", t.Name.Local)
+			}
+			cur = cur.parent
+		case xml.CharData:
+			if cur != nil {
+				cur.children = append(cur.children, string(t))
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("invalid XML: no root element")
+	}
+	if cur != nil {
+		return nil, fmt.Errorf("invalid XML: <%s> is not closed", cur.local)
+	}
+	return root, nil
+}
+
+func (e *element) declare(prefix, uri string) {
+	e.scope[prefix] = uri
+}
+
+func (e *element) resolve(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return nsXML, true
+	}
+	uri, ok := e.scope[prefix]
+	return uri, ok || prefix == ""
+}
+
+// is reports whether the element has the given namespace and local name.
+func (e *element) is(uri, local string) bool {
+	return e.uri == uri && e.local == local
+}
+
+// child returns the first child element with the given namespace and local name. Lookups on
+// a missing element find nothing, so paths can be followed without checks at each step.
+func (e *element) child(uri, local string) *element {
+	if e == nil {
+		return nil
+	}
+	for _, c := range e.children {
+		if el, ok := c.(*element); ok && el.is(uri, local) {
+			return el
+		}
+	}
+	return nil
+}
+
+// path follows child elements down by local name, each in the namespace given before it.
+func (e *element) path(steps ...string) *element {
+	for i := 0; e != nil && i+1 < len(steps); i += 2 {
+		e = e.child(steps[i], steps[i+1])
+	}
+	return e
+}
+
+// text returns the character data directly inside the element.
+func (e *element) text() string {
+	if e == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, c := range e.children {
+		if s, ok := c.(string); ok {
+			b.WriteString(s)
+		}
+	}
+	return b.String()
+}
+
+// attr returns the value of an unprefixed attribute.
+func (e *element) attr(local string) string {
+	if e == nil {
+		return ""
+	}
+	for _, a := range e.attrs {
+		if a.prefix == "" && a.local == local {
+			return a.value
+		}
+	}
+	return ""
+}
+
+// find returns the first element, depth first, that match accepts.
+func (e *element) find(match func(*element) bool) *element {
+	if e == nil {
+		return nil
+	}
+	if match(e) {
+		return e
+	}
+	for _, c := range e.children {
+		if el, ok := c.(*element); ok {
+			if f := el.find(match); f != nil {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// canonicalize writes an element and its descendants as Canonical XML 1.1 without comments,
+// leaving out the subtrees drop matches. The element is written as the apex of a document
+// subset: every namespace in scope is declared on it.
+func canonicalize(e *element, drop func(*element) bool) []byte {
+	var b bytes.Buffer
+	writeCanonical(&b, e, nil, drop)
+	return b.Bytes()
+}
+
+func writeCanonical(b *bytes.Buffer, e *element, rendered map[string]string, drop func(*element) bool) {
+	b.WriteByte('<')
+	writeQName(b, e.prefix, e.local)
+
+	// Namespace declarations that differ from the nearest written ancestor, default first.
+	prefixes := make([]string, 0, len(e.scope))
+	for p, uri := range e.scope {
+		if rendered[p] != uri {
+			prefixes = append(prefixes, p)
+		}
+	}
+	slices.Sort(prefixes)
+	for _, p := range prefixes {
+		if p == "" {
+			b.WriteString(` xmlns="`)
+		} else {
+			b.WriteString(` xmlns:` + p + `="`)
+		}
+		b.WriteString(escapeAttr(e.scope[p]))
+		b.WriteByte('"')
+	}
+
+	// Attributes by namespace, then local name; unqualified ones come first.
+	attrs := slices.Clone(e.attrs)
+	slices.SortFunc(attrs, func(x, y attribute) int {
+		if c := strings.Compare(x.uri, y.uri); c != 0 {
+			return c
+		}
+		return strings.Compare(x.local, y.local)
+	})
+	for _, a := range attrs {
+		b.WriteByte(' ')
+		writeQName(b, a.prefix, a.local)
+		b.WriteString(`="` + escapeAttr(a.value) + `"`)
+	}
+	b.WriteByte('>')
+
+	for _, c := range e.children {
+		switch c := c.(type) {
+		case string:
+			b.WriteString(escapeText(c))
+		case *element:
+			if drop == nil || !drop(c) {
+				writeCanonical(b, c, e.scope, drop)
+			}
+		}
+	}
+
+	b.WriteString("</")
+	writeQName(b, e.prefix, e.local)
+	b.WriteByte('>')
+}
+
+func writeQName(b *bytes.Buffer, prefix, local string) {
+	if prefix != "" {
+		b.WriteString(prefix + ":")
+	}
+	b.WriteString(local)
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+)
+
+func escapeText(s string) string { return textEscaper.Replace(s) }
+func escapeAttr(s string) string { return attrEscaper.Replace(s) }
@@ -0,0 +1,193 @@
+package zatca
+
+import (
+	"bytes"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// crypto/x509 cannot read these certificates: Go does not support the secp256k1 curve ZATCA
+// issues them on. The parts signing needs are read with encoding/asn1 instead.
+
+var (
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1      = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// Certificate is a compliance or production CSID (Cryptographic Stamp Identifier): the
+// certificate ZATCA issues to an onboarded e-invoicing unit.
+type Certificate struct {
+	Raw           []byte // DER
+	SerialNumber  *big.Int
+	Issuer        string // RFC 2253, as X509IssuerName wants it
+	Subject       string
+	NotBefore     time.Time
+	NotAfter      time.Time
+	PublicKey     *secp256k1.PublicKey
+	PublicKeyInfo []byte // DER SubjectPublicKeyInfo; QR tag 8
+	Signature     []byte // The issuer's signature over the certificate; QR tag 9
+}
+
+type certificateASN1 struct {
+	TBS                asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+}
+
+type tbsCertificateASN1 struct {
+	Raw                asn1.RawContent
+	Version            int `asn1:"optional,explicit,default:0,tag:0"`
+	SerialNumber       *big.Int
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Issuer             asn1.RawValue
+	Validity           validityASN1
+	Subject            asn1.RawValue
+	PublicKey          publicKeyInfoASN1
+	IssuerUniqueID     asn1.BitString  `asn1:"optional,tag:1"`
+	SubjectUniqueID    asn1.BitString  `asn1:"optional,tag:2"`
+	Extensions         []asn1.RawValue `asn1:"optional,explicit,tag:3"`
+}
+
+type validityASN1 struct {
+	NotBefore, NotAfter time.Time
+}
+
+type publicKeyInfoASN1 struct {
+	Raw       asn1.RawContent
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// ParseCertificate reads a CSID given as PEM, as base64 DER (the X509Certificate of a signed
+// invoice) or as the binarySecurityToken the onboarding API returns, which is base64 of that.
+func ParseCertificate(s string) (*Certificate, error) {
+	der, err := decodeDER(s, "CERTIFICATE")
+	if err != nil {
+		return nil, err
+	}
+	if inner, err := base64.StdEncoding.DecodeString(string(der)); err == nil {
+		der = inner // A binarySecurityToken
+	}
+
+	var c certificateASN1
+	if rest, err := asn1.Unmarshal(der, &c); err != nil || len(rest) > 0 {
+		return nil, fmt.Errorf("invalid certificate: not DER")
+	}
+	var tbs tbsCertificateASN1
+	if _, err := asn1.Unmarshal(c.TBS.FullBytes, &tbs); err != nil {
+		return nil, fmt.Errorf("invalid certificate: %w", err)
+	}
+	if !tbs.PublicKey.Algorithm.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, fmt.Errorf("certificate key is not an EC key")
+	}
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(tbs.PublicKey.Algorithm.Parameters.FullBytes, &curve); err != nil || !curve.Equal(oidSecp256k1) {
+		return nil, fmt.Errorf("certificate key is not on secp256k1")
+	}
+	pub, err := secp256k1.ParsePubKey(tbs.PublicKey.PublicKey.RightAlign())
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate key: %w", err)
+	}
+
+	cert := &Certificate{
+		Raw:           der,
+		SerialNumber:  tbs.SerialNumber,
+		NotBefore:     tbs.Validity.NotBefore,
+		NotAfter:      tbs.Validity.NotAfter,
+		PublicKey:     pub,
+		PublicKeyInfo: tbs.PublicKey.Raw,
+		Signature:     c.Signature.RightAlign(),
+	}
+	if cert.Issuer, err = nameString(tbs.Issuer.FullBytes); err != nil {
+		return nil, fmt.Errorf("invalid certificate issuer: %w", err)
+	}
+	if cert.Subject, err = nameString(tbs.Subject.FullBytes); err != nil {
+		return nil, fmt.Errorf("invalid certificate subject: %w", err)
+	}
+	return cert, nil
+}
+
+// Base64 returns the certificate as it goes into X509Certificate.
+func (c *Certificate) Base64() string {
+	return base64.StdEncoding.EncodeToString(c.Raw)
+}
+
+func nameString(der []byte) (string, error) {
+	var rdn pkix.RDNSequence
+	if _, err := asn1.Unmarshal(der, &rdn); err != nil {
+		return "", err
+	}
+	return rdn.String(), nil
+}
+
+type ecPrivateKeyASN1 struct {
+	Version    int
+	PrivateKey []byte
+	Curve      asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey  asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+type pkcs8ASN1 struct {
+	Version    int
+	Algorithm  pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// ParsePrivateKey reads the key the CSID was requested with: an SEC 1 "EC PRIVATE KEY" as
+// openssl writes it, a PKCS #8 "PRIVATE KEY", or either without its PEM armour.
+func ParsePrivateKey(s string) (*secp256k1.PrivateKey, error) {
+	der, err := decodeDER(s, "EC PRIVATE KEY", "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+	var p8 pkcs8ASN1
+	if _, err := asn1.Unmarshal(der, &p8); err == nil && p8.Algorithm.Algorithm.Equal(oidPublicKeyECDSA) {
+		der = p8.PrivateKey
+	}
+	var k ecPrivateKeyASN1
+	if _, err := asn1.Unmarshal(der, &k); err != nil || k.Version != 1 {
+		return nil, fmt.Errorf("invalid private key: not an EC private key")
+	}
+	if len(k.Curve) > 0 && !k.Curve.Equal(oidSecp256k1) {
+		return nil, fmt.Errorf("private key is not on secp256k1")
+	}
+	if len(k.PrivateKey) == 0 || len(k.PrivateKey) > 32 {
+		return nil, fmt.Errorf("invalid private key length %d", len(k.PrivateKey))
+	}
+	return secp256k1.PrivKeyFromBytes(k.PrivateKey), nil
+}
+
+// decodeDER strips PEM armour of one of the given types, or decodes bare base64.
+func decodeDER(s string, types ...string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, errors.New("empty certificate or key")
+	}
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		for _, t := range types {
+			if block.Type == t {
+				return block.Bytes, nil
+			}
+		}
+		return nil, fmt.Errorf("unexpected PEM block %q", block.Type)
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+	if err != nil {
+		return nil, fmt.Errorf("neither PEM nor base64: %w", err)
+	}
+	return der, nil
+}
+
+// matches reports whether the key is the private half of the certificate's key.
+func (c *Certificate) matches(key *secp256k1.PrivateKey) bool {
+	return bytes.Equal(key.PubKey().SerializeCompressed(), c.PublicKey.SerializeCompressed())
+}
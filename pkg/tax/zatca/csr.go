@@ -0,0 +1,323 @@
+package zatca
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+var (
+	oidECDSAWithSHA256    = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidExtensionRequest   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 14}
+	oidSubjectAltName     = asn1.ObjectIdentifier{2, 5, 29, 17}
+	oidCertificateTmpl    = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2}
+	oidSurname            = asn1.ObjectIdentifier{2, 5, 4, 4}
+	oidUserID             = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+	oidTitle              = asn1.ObjectIdentifier{2, 5, 4, 12}
+	oidRegisteredAddress  = asn1.ObjectIdentifier{2, 5, 4, 26}
+	oidBusinessCategory   = asn1.ObjectIdentifier{2, 5, 4, 15}
+	sigAlgECDSAWithSHA256 = pkix.AlgorithmIdentifier{Algorithm: oidECDSAWithSHA256}
+)
+
+// Environments of the Fatoora portal. Each issues CSIDs from its own template; a CSR names
+// the one it is meant for.
+const (
+	EnvSandbox    = "sandbox"
+	EnvSimulation = "simulation"
+	EnvProduction = "production"
+)
+
+var templates = map[string]string{
+	EnvSandbox:    "TSTZATCA-Code-Signing",
+	EnvSimulation: "PREZATCA-Code-Signing",
+	EnvProduction: "ZATCA-Code-Signing",
+}
+
+// Unit describes the e-invoicing unit a CSID is requested for: one device or solution
+// instance of a taxpayer, as ZATCA's onboarding guide lays out the CSR.
+type Unit struct {
+	CommonName   string // Unique name of the unit
+	SerialNumber string // 1-<solution>|2-<version>|3-<unit id>
+	VATNumber    string
+	Branch       string // Organization unit; the TIN of a VAT group member
+	Organization string
+	Country      string // Defaults to SA
+	InvoiceType  string // Four flags: standard, simplified, and two reserved; e.g. 1100
+	Location     string // Registered address of the branch
+	Industry     string
+	Environment  string // EnvSandbox, EnvSimulation or EnvProduction
+}
+
+// CSR is a certificate signing request for a CSID.
+type CSR struct {
+	Raw           []byte // DER
+	Subject       string
+	PublicKey     *secp256k1.PublicKey
+	PublicKeyInfo []byte // DER SubjectPublicKeyInfo
+	VATNumber     string
+	Template      string
+	subject       []byte // DER Name
+	extensions    []pkix.Extension
+}
+
+type csrInfoASN1 struct {
+	Raw        asn1.RawContent
+	Version    int
+	Subject    asn1.RawValue
+	PublicKey  publicKeyInfoASN1
+	Attributes []asn1.RawValue `asn1:"tag:0"`
+}
+
+type csrASN1 struct {
+	Info               asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+}
+
+type attributeASN1 struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// GenerateKey returns a new secp256k1 key as an SEC 1 PEM block.
+func GenerateKey() (string, error) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return "", err
+	}
+	der, err := asn1.Marshal(ecPrivateKeyASN1{Version: 1, PrivateKey: key.Serialize(), Curve: oidSecp256k1})
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+}
+
+// CreateCSR requests a CSID for a unit, signed with its key. The result is the PEM request,
+// base64 encoded again, which is how the compliance API takes it.
+func CreateCSR(u Unit, key string) (string, error) {
+	k, err := ParsePrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	tmpl, ok := templates[u.Environment]
+	if !ok {
+		return "", fmt.Errorf("unknown environment %q", u.Environment)
+	}
+	if u.CommonName == "" || u.SerialNumber == "" || u.VATNumber == "" || u.Organization == "" {
+		return "", fmt.Errorf("a CSR needs a common name, serial number, VAT number and organization")
+	}
+	if u.Country == "" {
+		u.Country = "SA"
+	}
+	if u.Branch == "" {
+		u.Branch = u.Organization
+	}
+
+	subject, err := asn1.Marshal(pkix.Name{
+		Country:            []string{u.Country},
+		Organization:       []string{u.Organization},
+		OrganizationalUnit: []string{u.Branch},
+		CommonName:         u.CommonName,
+	}.ToRDNSequence())
+	if err != nil {
+		return "", err
+	}
+	exts, err := unitExtensions(u, tmpl)
+	if err != nil {
+		return "", err
+	}
+	extDER, err := asn1.Marshal(exts)
+	if err != nil {
+		return "", err
+	}
+	attr, err := asn1.Marshal(attributeASN1{Type: oidExtensionRequest, Values: []asn1.RawValue{{FullBytes: extDER}}})
+	if err != nil {
+		return "", err
+	}
+	info, err := asn1.Marshal(csrInfoASN1{
+		Subject:    asn1.RawValue{FullBytes: subject},
+		PublicKey:  publicKeyInfo(k.PubKey()),
+		Attributes: []asn1.RawValue{{FullBytes: attr}},
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(info)
+	sig := ecdsa.Sign(k, sum[:]).Serialize()
+	der, err := asn1.Marshal(csrASN1{
+		Info:               asn1.RawValue{FullBytes: info},
+		SignatureAlgorithm: sigAlgECDSAWithSHA256,
+		Signature:          asn1.BitString{Bytes: sig, BitLength: len(sig) * 8},
+	})
+	if err != nil {
+		return "", err
+	}
+	block := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+	return base64.StdEncoding.EncodeToString(block), nil
+}
+
+// unitExtensions are what a ZATCA CSR asks for: the certificate template of the environment
+// and the unit's details as a directory name in the subject alternative name.
+func unitExtensions(u Unit, tmpl string) ([]pkix.Extension, error) {
+	name, err := asn1.MarshalWithParams(tmpl, "printable")
+	if err != nil {
+		return nil, err
+	}
+	dir, err := asn1.Marshal(pkix.RDNSequence{
+		{{Type: oidSurname, Value: u.SerialNumber}},
+		{{Type: oidUserID, Value: u.VATNumber}},
+		{{Type: oidTitle, Value: u.InvoiceType}},
+		{{Type: oidRegisteredAddress, Value: u.Location}},
+		{{Type: oidBusinessCategory, Value: u.Industry}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// GeneralNames holding one directoryName; Name is a CHOICE, so its [4] tag is explicit.
+	san, err := asn1.Marshal([]asn1.RawValue{{Class: asn1.ClassContextSpecific, Tag: 4, IsCompound: true, Bytes: dir}})
+	if err != nil {
+		return nil, err
+	}
+	return []pkix.Extension{
+		{Id: oidCertificateTmpl, Value: name},
+		{Id: oidSubjectAltName, Value: san},
+	}, nil
+}
+
+func publicKeyInfo(pub *secp256k1.PublicKey) publicKeyInfoASN1 {
+	curve, _ := asn1.Marshal(oidSecp256k1)
+	return publicKeyInfoASN1{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: curve}},
+		PublicKey: asn1.BitString{Bytes: pub.SerializeUncompressed(), BitLength: 65 * 8},
+	}
+}
+
+// ParseCSR reads a request in any form CreateCSR or openssl writes it, and checks that it
+// is signed by the key it asks a certificate for.
+func ParseCSR(s string) (*CSR, error) {
+	s = strings.TrimSpace(s)
+	if inner, err := base64.StdEncoding.DecodeString(s); err == nil && bytes.HasPrefix(inner, []byte("-----BEGIN")) {
+		s = string(inner)
+	}
+	der, err := decodeDER(s, "CERTIFICATE REQUEST", "NEW CERTIFICATE REQUEST")
+	if err != nil {
+		return nil, err
+	}
+	var req csrASN1
+	if rest, err := asn1.Unmarshal(der, &req); err != nil || len(rest) > 0 {
+		return nil, fmt.Errorf("invalid CSR: not DER")
+	}
+	var info csrInfoASN1
+	if _, err := asn1.Unmarshal(req.Info.FullBytes, &info); err != nil {
+		return nil, fmt.Errorf("invalid CSR: %w", err)
+	}
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(info.PublicKey.Algorithm.Parameters.FullBytes, &curve); err != nil || !curve.Equal(oidSecp256k1) {
+		return nil, fmt.Errorf("CSR key is not on secp256k1")
+	}
+	pub, err := secp256k1.ParsePubKey(info.PublicKey.PublicKey.RightAlign())
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSR key: %w", err)
+	}
+	sig, err := ecdsa.ParseDERSignature(req.Signature.RightAlign())
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSR signature: %w", err)
+	}
+	sum := sha256.Sum256(req.Info.FullBytes)
+	if !sig.Verify(sum[:], pub) {
+		return nil, fmt.Errorf("CSR is not signed by its own key")
+	}
+
+	csr := &CSR{Raw: der, PublicKey: pub, PublicKeyInfo: info.PublicKey.Raw, subject: info.Subject.FullBytes}
+	if csr.Subject, err = nameString(info.Subject.FullBytes); err != nil {
+		return nil, fmt.Errorf("invalid CSR subject: %w", err)
+	}
+	for _, raw := range info.Attributes {
+		var attr attributeASN1
+		if _, err := asn1.Unmarshal(raw.FullBytes, &attr); err != nil || !attr.Type.Equal(oidExtensionRequest) || len(attr.Values) == 0 {
+			continue
+		}
+		if _, err := asn1.Unmarshal(attr.Values[0].FullBytes, &csr.extensions); err != nil {
+			return nil, fmt.Errorf("invalid CSR extensions: %w", err)
+		}
+	}
+	for _, ext := range csr.extensions {
+		switch {
+		case ext.Id.Equal(oidCertificateTmpl):
+			asn1.Unmarshal(ext.Value, &csr.Template)
+		case ext.Id.Equal(oidSubjectAltName):
+			csr.VATNumber = sanValue(ext.Value, oidUserID)
+		}
+	}
+	return csr, nil
+}
+
+// sanValue finds an attribute in the directory name of a subject alternative name.
+func sanValue(san []byte, oid asn1.ObjectIdentifier) string {
+	var names []asn1.RawValue
+	if _, err := asn1.Unmarshal(san, &names); err != nil {
+		return ""
+	}
+	for _, n := range names {
+		if n.Class != asn1.ClassContextSpecific || n.Tag != 4 {
+			continue
+		}
+		var rdn pkix.RDNSequence
+		if _, err := asn1.Unmarshal(n.Bytes, &rdn); err != nil {
+			continue
+		}
+		for _, set := range rdn {
+			for _, atv := range set {
+				if s, ok := atv.Value.(string); ok && atv.Type.Equal(oid) {
+					return s
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// IssueCertificate signs a CSID for a request, carrying over the extensions it asked for.
+// It is what the portal does at onboarding; SENT only needs it to stand in for the portal.
+func IssueCertificate(csr *CSR, issuer string, key *secp256k1.PrivateKey, serial *big.Int, notBefore, notAfter time.Time) (*Certificate, error) {
+	issuerName, err := asn1.Marshal(pkix.Name{CommonName: issuer}.ToRDNSequence())
+	if err != nil {
+		return nil, err
+	}
+	tbs, err := asn1.Marshal(struct {
+		Version      int `asn1:"explicit,tag:0"`
+		SerialNumber *big.Int
+		SigAlg       pkix.AlgorithmIdentifier
+		Issuer       asn1.RawValue
+		Validity     validityASN1
+		Subject      asn1.RawValue
+		PublicKey    asn1.RawValue
+		Extensions   []pkix.Extension `asn1:"optional,explicit,tag:3"`
+	}{2, serial, sigAlgECDSAWithSHA256, asn1.RawValue{FullBytes: issuerName},
+		validityASN1{notBefore.UTC(), notAfter.UTC()},
+		asn1.RawValue{FullBytes: csr.subject}, asn1.RawValue{FullBytes: csr.PublicKeyInfo}, csr.extensions})
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(tbs)
+	sig := ecdsa.Sign(key, sum[:]).Serialize()
+	der, err := asn1.Marshal(certificateASN1{
+		TBS:                asn1.RawValue{FullBytes: tbs},
+		SignatureAlgorithm: sigAlgECDSAWithSHA256,
+		Signature:          asn1.BitString{Bytes: sig, BitLength: len(sig) * 8},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ParseCertificate(base64.StdEncoding.EncodeToString(der))
+}
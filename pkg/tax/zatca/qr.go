@@ -0,0 +1,76 @@
+package zatca
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// QR is what the QR code of a Phase 2 invoice carries. Tags 1 to 5 are the Phase 1 fields;
+// 6 to 9 tie the code to the signed XML so a reader can check it offline.
+type QR struct {
+	Seller        string // 1
+	VATNumber     string // 2
+	Timestamp     string // 3: issue date and time, 2006-01-02T15:04:05
+	Total         string // 4: with VAT
+	VAT           string // 5
+	Hash          string // 6: the invoice hash, base64
+	Signature     string // 7: the ECDSA signature, base64
+	PublicKey     []byte // 8: DER SubjectPublicKeyInfo of the CSID
+	CertSignature []byte // 9: the CSID's own signature; simplified invoices only
+}
+
+// Encode returns the QR payload: tag-length-value records, base64 encoded.
+func (q QR) Encode() string {
+	var b []byte
+	for i, v := range [][]byte{
+		[]byte(q.Seller), []byte(q.VATNumber), []byte(q.Timestamp), []byte(q.Total), []byte(q.VAT),
+		[]byte(q.Hash), []byte(q.Signature), q.PublicKey, q.CertSignature,
+	} {
+		if len(v) == 0 && i >= 5 {
+			continue
+		}
+		if len(v) > 255 {
+			v = v[:255] // The most a one-byte length can say
+		}
+		b = append(b, byte(i+1), byte(len(v)))
+		b = append(b, v...)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// DecodeQR reads a QR payload back into its fields.
+func DecodeQR(payload string) (QR, error) {
+	b, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return QR{}, fmt.Errorf("QR payload is not base64: %w", err)
+	}
+	var q QR
+	for len(b) > 0 {
+		if len(b) < 2 || len(b) < 2+int(b[1]) {
+			return QR{}, fmt.Errorf("QR payload is truncated")
+		}
+		tag, v := b[0], b[2:2+int(b[1])]
+		b = b[2+int(b[1]):]
+		switch tag {
+		case 1:
+			q.Seller = string(v)
+		case 2:
+			q.VATNumber = string(v)
+		case 3:
+			q.Timestamp = string(v)
+		case 4:
+			q.Total = string(v)
+		case 5:
+			q.VAT = string(v)
+		case 6:
+			q.Hash = string(v)
+		case 7:
+			q.Signature = string(v)
+		case 8:
+			q.PublicKey = v
+		case 9:
+			q.CertSignature = v
+		}
+	}
+	return q, nil
+}
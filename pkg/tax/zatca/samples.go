@@ -0,0 +1,67 @@
+package zatca
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// sampleBuyer is the buyer of the standard compliance samples, a VAT number of the shape
+// ZATCA's own samples use.
+var sampleBuyer = Party{
+	Name:      "Fatoora Samples LTD",
+	VATNumber: "399999999800003",
+	Address:   Address{Street: "Salah Al-Din", Building: "1111", District: "Al-Murooj", City: "Riyadh", PostalCode: "12222", Country: "SA"},
+}
+
+// ComplianceSamples returns the documents the compliance step checks before a production
+// CSID is issued: an invoice, a credit note and a debit note for each kind of invoice the
+// unit issues, as the first two flags of invoiceType say (1100 for both). The caller signs
+// them in order, each with the hash of the one before as its PIH.
+func ComplianceSamples(seller Party, invoiceType string, at time.Time) ([]*Document, error) {
+	if len(invoiceType) != 4 || (invoiceType[0] != '1' && invoiceType[1] != '1') {
+		return nil, fmt.Errorf("invoice type %q issues neither standard nor simplified invoices", invoiceType)
+	}
+	net, tax := decimal.NewFromInt(100), decimal.NewFromInt(15)
+	var docs []*Document
+	for i, simplified := range []bool{false, true} {
+		if invoiceType[i] != '1' {
+			continue
+		}
+		var original string
+		for _, code := range []string{TypeInvoice, TypeCreditNote, TypeDebitNote} {
+			d := &Document{
+				Number:       fmt.Sprintf("SME%05d", len(docs)+1),
+				UUID:         uuid.New().String(),
+				TypeCode:     code,
+				Simplified:   simplified,
+				Issued:       at,
+				Currency:     "SAR",
+				Counter:      len(docs) + 1,
+				PaymentMeans: PaymentCash,
+				Seller:       seller,
+				Lines: []Line{{
+					Name: "Compliance sample", Quantity: decimal.NewFromInt(1), Price: net,
+					Net: net, Tax: tax, Category: CategoryStandard, Percent: decimal.NewFromInt(15),
+				}},
+				Taxes: []TaxGroup{{Category: CategoryStandard, Percent: decimal.NewFromInt(15), Taxable: net, Tax: tax}},
+				Net:   net,
+				Tax:   tax,
+				Total: net.Add(tax),
+			}
+			if !simplified {
+				buyer := sampleBuyer
+				d.Buyer = &buyer
+			}
+			if code == TypeInvoice {
+				original = d.Number
+			} else {
+				d.Original, d.Reason = original, "Compliance sample adjustment"
+			}
+			docs = append(docs, d)
+		}
+	}
+	return docs, nil
+}
@@ -0,0 +1,387 @@
+package zatca
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+const (
+	nsSIG   = "urn:oasis:names:specification:ubl:schema:xsd:CommonSignatureComponents-2"
+	nsSAC   = "urn:oasis:names:specification:ubl:schema:xsd:SignatureAggregateComponents-2"
+	nsSBC   = "urn:oasis:names:specification:ubl:schema:xsd:SignatureBasicComponents-2"
+	nsDS    = "http://www.w3.org/2000/09/xmldsig#"
+	nsXAdES = "http://uri.etsi.org/01903/v1.3.2#"
+
+	algC14N11  = "http://www.w3.org/2006/12/xml-c14n11"
+	algXPath   = "http://www.w3.org/TR/1999/REC-xpath-19991116"
+	algECDSA   = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+	algSHA256  = "http://www.w3.org/2001/04/xmlenc#sha256"
+	propsID    = "xadesSignedProperties"
+	markExt    = "@@sent-ubl-extensions@@"
+	markQR     = "@@sent-qr@@"
+	markProps  = "@@sent-signed-properties@@"
+	signedTime = "2006-01-02T15:04:05"
+)
+
+// ErrInvalidSignature is returned by Verify for a document that does not check out.
+var ErrInvalidSignature = errors.New("invalid e-invoice signature")
+
+// hashExcluded matches what the invoice hash leaves out: the signature extension, the
+// cac:Signature reference and the QR code, none of which exist until the invoice is signed.
+func hashExcluded(e *element) bool {
+	switch {
+	case e.is(nsEXT, "UBLExtensions"), e.is(nsCAC, "Signature"):
+		return true
+	case e.is(nsCAC, "AdditionalDocumentReference"):
+		return e.child(nsCBC, "ID").text() == "QR"
+	}
+	return false
+}
+
+// InvoiceHash returns the hash of an invoice: the base64 SHA-256 of its canonical form
+// without the parts hashExcluded matches. It is the DigestValue of the signed invoice and
+// the PIH of the next one.
+func InvoiceHash(doc []byte) (string, error) {
+	root, err := parseXML(doc)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonicalize(root, hashExcluded))
+	return base64.StdEncoding.EncodeToString(sum[:]), nil
+}
+
+// Signer signs invoices with a CSID and the key it was issued for.
+type Signer struct {
+	Cert *Certificate
+	key  *secp256k1.PrivateKey
+}
+
+// NewSigner reads a CSID and its private key, and checks that they belong together.
+func NewSigner(cert, key string) (*Signer, error) {
+	c, err := ParseCertificate(cert)
+	if err != nil {
+		return nil, err
+	}
+	k, err := ParsePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !c.matches(k) {
+		return nil, fmt.Errorf("the private key does not belong to the certificate")
+	}
+	return &Signer{Cert: c, key: k}, nil
+}
+
+// Signed is a signed invoice.
+type Signed struct {
+	XML       []byte
+	Hash      string // The next invoice's PIH
+	Signature string
+	QR        string
+}
+
+// Sign signs an unsigned invoice such as Render writes: it hashes it, signs the hash, and adds
+// the XAdES signature as a UBL extension and the QR code as an additional document reference.
+//
+// ZATCA departs from XMLDSig in what it signs: the signature value is over the invoice hash,
+// not over SignedInfo, and the certificate and SignedProperties digests are base64 of the
+// hex SHA-256. Both are followed here because its validators expect them.
+func (s *Signer) Sign(unsigned []byte, at time.Time) (*Signed, error) {
+	root, err := parseXML(unsigned)
+	if err != nil {
+		return nil, err
+	}
+	if !root.is(nsInvoice, "Invoice") {
+		return nil, fmt.Errorf("not a UBL invoice: root element is <%s>", root.local)
+	}
+	if root.child(nsEXT, "UBLExtensions") != nil || root.find(isQRReference) != nil {
+		return nil, fmt.Errorf("invoice is already signed")
+	}
+	if root.scope["cac"] != nsCAC || root.scope["cbc"] != nsCBC {
+		return nil, fmt.Errorf("invoice must declare the cac and cbc prefixes on its root")
+	}
+	sigRef := root.child(nsCAC, "Signature")
+	if sigRef == nil {
+		return nil, fmt.Errorf("invoice has no cac:Signature")
+	}
+
+	// Make room for the extension and the QR code; they are left out of the hash, so it can
+	// be taken before they are filled in.
+	extDecl := ""
+	if root.scope["ext"] != nsEXT {
+		extDecl = ` xmlns:ext="` + nsEXT + `"`
+	}
+	depth := indentDepth(root)
+	ext := "\n" + strings.Repeat("    ", depth) + "<ext:UBLExtensions" + extDecl + ">" + markExt + "</ext:UBLExtensions>"
+	w := &ublWriter{depth: indentDepth(sigRef)}
+	w.open("cac:AdditionalDocumentReference")
+	w.leaf("cbc:ID", "QR")
+	w.open("cac:Attachment")
+	w.leaf("cbc:EmbeddedDocumentBinaryObject", markQR, "mimeCode=text/plain")
+	w.close("cac:Attachment")
+	w.close("cac:AdditionalDocumentReference")
+	qr := strings.TrimLeft(w.b.String(), " ") + strings.Repeat("    ", w.depth)
+
+	var doc []byte
+	doc = append(doc, unsigned[:root.startEnd]...)
+	doc = append(doc, ext...)
+	doc = append(doc, unsigned[root.startEnd:sigRef.start]...)
+	doc = append(doc, qr...)
+	doc = append(doc, unsigned[sigRef.start:]...)
+
+	hash, err := InvoiceHash(doc)
+	if err != nil {
+		return nil, err
+	}
+	hashBytes, _ := base64.StdEncoding.DecodeString(hash)
+	digest := sha256.Sum256(hashBytes)
+	signature := base64.StdEncoding.EncodeToString(ecdsa.Sign(s.key, digest[:]).Serialize())
+
+	doc = bytes.Replace(doc, []byte(markExt), []byte(s.extension(depth, hash, signature, at)), 1)
+	signed, err := parseXML(doc)
+	if err != nil {
+		return nil, err
+	}
+	doc = bytes.Replace(doc, []byte(markProps), []byte(propertiesDigest(signed)), 1)
+
+	q := QR{
+		Seller:    signed.path(nsCAC, "AccountingSupplierParty", nsCAC, "Party", nsCAC, "PartyLegalEntity", nsCBC, "RegistrationName").text(),
+		VATNumber: signed.path(nsCAC, "AccountingSupplierParty", nsCAC, "Party", nsCAC, "PartyTaxScheme", nsCBC, "CompanyID").text(),
+		Timestamp: signed.child(nsCBC, "IssueDate").text() + "T" + signed.child(nsCBC, "IssueTime").text(),
+		Total:     signed.path(nsCAC, "LegalMonetaryTotal", nsCBC, "TaxInclusiveAmount").text(),
+		VAT:       documentTax(signed),
+		Hash:      hash,
+		Signature: signature,
+		PublicKey: s.Cert.PublicKeyInfo,
+	}
+	if strings.HasPrefix(signed.child(nsCBC, "InvoiceTypeCode").attr("name"), "02") {
+		q.CertSignature = s.Cert.Signature
+	}
+	payload := q.Encode()
+	doc = bytes.Replace(doc, []byte(markQR), []byte(payload), 1)
+
+	if check, err := InvoiceHash(doc); err != nil || check != hash {
+		return nil, fmt.Errorf("invoice hash changed while signing")
+	}
+	return &Signed{XML: doc, Hash: hash, Signature: signature, QR: payload}, nil
+}
+
+// extension writes the UBL extension holding the XAdES signature, at the given depth.
+func (s *Signer) extension(depth int, hash, signature string, at time.Time) string {
+	certB64 := s.Cert.Base64()
+	certSum := sha256.Sum256([]byte(certB64))
+
+	w := &ublWriter{depth: depth + 1}
+	w.open("ext:UBLExtension")
+	w.leaf("ext:ExtensionURI", "urn:oasis:names:specification:ubl:dsig:enveloped:xades")
+	w.open("ext:ExtensionContent")
+	w.open("sig:UBLDocumentSignatures", "xmlns:sig="+nsSIG, "xmlns:sac="+nsSAC, "xmlns:sbc="+nsSBC)
+	w.open("sac:SignatureInformation")
+	w.leaf("cbc:ID", "urn:oasis:names:specification:ubl:signature:1")
+	w.leaf("sbc:ReferencedSignatureID", "urn:oasis:names:specification:ubl:signature:Invoice")
+	w.open("ds:Signature", "xmlns:ds="+nsDS, "Id=signature")
+	w.open("ds:SignedInfo")
+	w.leaf("ds:CanonicalizationMethod", "", "Algorithm="+algC14N11)
+	w.leaf("ds:SignatureMethod", "", "Algorithm="+algECDSA)
+	w.open("ds:Reference", "Id=invoiceSignedData", "URI=")
+	w.open("ds:Transforms")
+	for _, xpath := range []string{
+		"not(//ancestor-or-self::ext:UBLExtensions)",
+		"not(//ancestor-or-self::cac:Signature)",
+		"not(//ancestor-or-self::cac:AdditionalDocumentReference[cbc:ID='QR'])",
+	} {
+		w.open("ds:Transform", "Algorithm="+algXPath)
+		w.leaf("ds:XPath", xpath)
+		w.close("ds:Transform")
+	}
+	w.leaf("ds:Transform", "", "Algorithm="+algC14N11)
+	w.close("ds:Transforms")
+	w.leaf("ds:DigestMethod", "", "Algorithm="+algSHA256)
+	w.leaf("ds:DigestValue", hash)
+	w.close("ds:Reference")
+	w.open("ds:Reference", "Type=http://www.w3.org/2000/09/xmldsig#SignatureProperties", "URI=#"+propsID)
+	w.leaf("ds:DigestMethod", "", "Algorithm="+algSHA256)
+	w.leaf("ds:DigestValue", markProps)
+	w.close("ds:Reference")
+	w.close("ds:SignedInfo")
+	w.leaf("ds:SignatureValue", signature)
+	w.open("ds:KeyInfo")
+	w.open("ds:X509Data")
+	w.leaf("ds:X509Certificate", certB64)
+	w.close("ds:X509Data")
+	w.close("ds:KeyInfo")
+	w.open("ds:Object")
+	w.open("xades:QualifyingProperties", "xmlns:xades="+nsXAdES, "Target=signature")
+	w.open("xades:SignedProperties", "Id="+propsID)
+	w.open("xades:SignedSignatureProperties")
+	w.leaf("xades:SigningTime", at.In(Riyadh).Format(signedTime))
+	w.open("xades:SigningCertificate")
+	w.open("xades:Cert")
+	w.open("xades:CertDigest")
+	w.leaf("ds:DigestMethod", "", "Algorithm="+algSHA256)
+	w.leaf("ds:DigestValue", hexDigest(certSum[:]))
+	w.close("xades:CertDigest")
+	w.open("xades:IssuerSerial")
+	w.leaf("ds:X509IssuerName", s.Cert.Issuer)
+	w.leaf("ds:X509SerialNumber", s.Cert.SerialNumber.String())
+	w.close("xades:IssuerSerial")
+	w.close("xades:Cert")
+	w.close("xades:SigningCertificate")
+	w.close("xades:SignedSignatureProperties")
+	w.close("xades:SignedProperties")
+	w.close("xades:QualifyingProperties")
+	w.close("ds:Object")
+	w.close("ds:Signature")
+	w.close("sac:SignatureInformation")
+	w.close("sig:UBLDocumentSignatures")
+	w.close("ext:ExtensionContent")
+	w.close("ext:UBLExtension")
+	return "\n" + w.b.String() + strings.Repeat("    ", depth)
+}
+
+// Verify checks a signed invoice: the invoice hash, the signature over it, the digests of the
+// certificate and signed properties, and the QR code's copy of the hash and signature.
+func Verify(signed []byte) error {
+	root, err := parseXML(signed)
+	if err != nil {
+		return err
+	}
+	sig := root.path(nsEXT, "UBLExtensions", nsEXT, "UBLExtension", nsEXT, "ExtensionContent",
+		nsSIG, "UBLDocumentSignatures", nsSAC, "SignatureInformation", nsDS, "Signature")
+	if sig == nil {
+		return fmt.Errorf("%w: no signature", ErrInvalidSignature)
+	}
+
+	hash, err := InvoiceHash(signed)
+	if err != nil {
+		return err
+	}
+	var invoiceDigest, propsDigest string
+	signedInfo := sig.child(nsDS, "SignedInfo")
+	if signedInfo == nil {
+		return fmt.Errorf("%w: no SignedInfo", ErrInvalidSignature)
+	}
+	for _, c := range signedInfo.children {
+		if ref, ok := c.(*element); ok && ref.is(nsDS, "Reference") {
+			switch {
+			case ref.attr("Id") == "invoiceSignedData":
+				invoiceDigest = ref.child(nsDS, "DigestValue").text()
+			case ref.attr("URI") == "#"+propsID:
+				propsDigest = ref.child(nsDS, "DigestValue").text()
+			}
+		}
+	}
+	if invoiceDigest != hash {
+		return fmt.Errorf("%w: invoice hash is %s, the signature says %s", ErrInvalidSignature, hash, invoiceDigest)
+	}
+	if got := propertiesDigest(root); got != propsDigest {
+		return fmt.Errorf("%w: signed properties digest does not match", ErrInvalidSignature)
+	}
+
+	certB64 := strings.TrimSpace(sig.path(nsDS, "KeyInfo", nsDS, "X509Data", nsDS, "X509Certificate").text())
+	cert, err := ParseCertificate(certB64)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	certSum := sha256.Sum256([]byte(certB64))
+	certDigest := root.find(func(e *element) bool { return e.is(nsXAdES, "CertDigest") }).child(nsDS, "DigestValue").text()
+	if certDigest != hexDigest(certSum[:]) {
+		return fmt.Errorf("%w: certificate digest does not match", ErrInvalidSignature)
+	}
+
+	signature := strings.TrimSpace(sig.child(nsDS, "SignatureValue").text())
+	der, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("%w: signature value is not base64", ErrInvalidSignature)
+	}
+	parsed, err := ecdsa.ParseDERSignature(der)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	hashBytes, _ := base64.StdEncoding.DecodeString(hash)
+	digest := sha256.Sum256(hashBytes)
+	if !parsed.Verify(digest[:], cert.PublicKey) {
+		return fmt.Errorf("%w: signature does not verify against the certificate", ErrInvalidSignature)
+	}
+
+	qrRef := root.find(isQRReference)
+	if qrRef == nil {
+		return fmt.Errorf("%w: no QR code", ErrInvalidSignature)
+	}
+	q, err := DecodeQR(strings.TrimSpace(qrRef.path(nsCAC, "Attachment", nsCBC, "EmbeddedDocumentBinaryObject").text()))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if q.Hash != hash || q.Signature != signature {
+		return fmt.Errorf("%w: QR code does not match the signature", ErrInvalidSignature)
+	}
+	return nil
+}
+
+func isQRReference(e *element) bool {
+	return e.is(nsCAC, "AdditionalDocumentReference") && e.child(nsCBC, "ID").text() == "QR"
+}
+
+// propertiesDigest returns the digest of the signed properties in a signed document.
+func propertiesDigest(root *element) string {
+	props := root.find(func(e *element) bool { return e.is(nsXAdES, "SignedProperties") && e.attr("Id") == propsID })
+	if props == nil {
+		return ""
+	}
+	sum := sha256.Sum256(canonicalize(props, nil))
+	return hexDigest(sum[:])
+}
+
+// documentTax returns the VAT total in the document currency: the TaxTotal with the breakdown.
+func documentTax(root *element) string {
+	for _, c := range root.children {
+		if t, ok := c.(*element); ok && t.is(nsCAC, "TaxTotal") && t.child(nsCAC, "TaxSubtotal") != nil {
+			return t.child(nsCBC, "TaxAmount").text()
+		}
+	}
+	return root.path(nsCAC, "TaxTotal", nsCBC, "TaxAmount").text()
+}
+
+// hexDigest is ZATCA's encoding of the certificate and signed properties digests.
+func hexDigest(sum []byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(hex.EncodeToString(sum)))
+}
+
+// indentDepth guesses the depth of an element from the whitespace before it, in four-space
+// steps, so inserted elements line up with their neighbours. It is cosmetic only.
+func indentDepth(e *element) int {
+	siblings := []any{}
+	if e.parent != nil {
+		siblings = e.parent.children
+	} else {
+		// The root: line up with its first child.
+		for _, c := range e.children {
+			if s, ok := c.(string); ok {
+				if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+					return (len(s) - i - 1) / 4
+				}
+			}
+			break
+		}
+		return 1
+	}
+	for i, c := range siblings {
+		if c == any(e) && i > 0 {
+			if s, ok := siblings[i-1].(string); ok {
+				if j := strings.LastIndexByte(s, '\n'); j >= 0 {
+					return (len(s) - j - 1) / 4
+				}
+			}
+		}
+	}
+	return 1
+}
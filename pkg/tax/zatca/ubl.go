@@ -0,0 +1,360 @@
+package zatca
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// UBL 2.1 namespaces.
+const (
+	nsInvoice = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	nsCAC     = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	nsCBC     = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+	nsEXT     = "urn:oasis:names:specification:ubl:schema:xsd:CommonExtensionComponents-2"
+)
+
+// Document type codes (UNTDID 1001). ZATCA writes all three as an Invoice element.
+const (
+	TypeInvoice    = "388"
+	TypeDebitNote  = "383"
+	TypeCreditNote = "381"
+)
+
+// VAT category codes (UNCL 5305).
+const (
+	CategoryStandard   = "S"
+	CategoryZeroRated  = "Z"
+	CategoryExempt     = "E"
+	CategoryOutOfScope = "O"
+)
+
+// Payment means codes (UNCL 4461).
+const (
+	PaymentCash     = "10"
+	PaymentTransfer = "30"
+	PaymentCard     = "48"
+)
+
+// Riyadh is the time invoices are dated in. The kingdom keeps no daylight saving.
+var Riyadh = time.FixedZone("AST", 3*60*60)
+
+// Address is a national address. Building numbers and postal codes are four and five digits.
+type Address struct {
+	Street     string
+	Building   string
+	District   string
+	City       string
+	PostalCode string
+	Country    string // ISO 3166 alpha-2
+}
+
+// Party is the seller or the buyer of a document.
+type Party struct {
+	Name               string
+	VATNumber          string
+	RegistrationNumber string // Commercial registration (CRN)
+	Address            Address
+}
+
+// Line is one line of a document. Price and the amounts are net of VAT.
+type Line struct {
+	Name     string
+	Quantity decimal.Decimal
+	Price    decimal.Decimal
+	Net      decimal.Decimal
+	Tax      decimal.Decimal
+	Category string
+	Percent  decimal.Decimal // 15 for 15%
+}
+
+// TaxGroup is the tax of one category and rate. Zero-rated and exempt groups need the reason
+// code of the exemption, VATEX-SA-29 and so on.
+type TaxGroup struct {
+	Category        string
+	Percent         decimal.Decimal
+	Taxable         decimal.Decimal
+	Tax             decimal.Decimal
+	ExemptionCode   string
+	ExemptionReason string
+}
+
+// Document is a tax invoice, credit note or debit note to be rendered as UBL 2.1 in the
+// profile ZATCA's Phase 2 (integration) requires. Amounts are in Currency.
+type Document struct {
+	Number       string
+	UUID         string
+	TypeCode     string
+	Simplified   bool // B2C: reported after the fact rather than cleared
+	Issued       time.Time
+	Currency     string
+	TaxRate      decimal.Decimal // Currency to SAR, the tax currency; zero means 1
+	Counter      int             // ICV: the unit's running invoice count
+	PreviousHash string          // PIH: hash of the unit's previous invoice
+	Original     string          // Credit and debit notes: number of the invoice adjusted
+	Reason       string          // Credit and debit notes: why
+	PaymentMeans string
+	Seller       Party
+	Buyer        *Party // Optional on simplified invoices
+	Lines        []Line
+	Taxes        []TaxGroup
+	Net          decimal.Decimal
+	Tax          decimal.Decimal
+	Total        decimal.Decimal
+}
+
+// InitialHash is the PIH of a unit's first invoice: the base64 of the hex SHA-256 of "0".
+const InitialHash = "NWZlY2ViNjZmZmM4NmYzOGQ5NTI3ODZjNmQ2OTZjNzljMmRiYzIzOWRkNGU5MWI0NjcyOWQ3M2EyN2ZiNTdlOQ=="
+
+// Render writes the document as unsigned UBL. Sign adds the signature and the QR code.
+func (d *Document) Render() ([]byte, error) {
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+	issued := d.Issued.In(Riyadh)
+	rate := d.TaxRate
+	if rate.IsZero() {
+		rate = decimal.NewFromInt(1)
+	}
+	subtype := "01"
+	if d.Simplified {
+		subtype = "02"
+	}
+	cur := "currencyID=" + d.Currency
+
+	w := &ublWriter{}
+	w.b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	w.open("Invoice", "xmlns="+nsInvoice, "xmlns:cac="+nsCAC, "xmlns:cbc="+nsCBC, "xmlns:ext="+nsEXT)
+	w.leaf("cbc:ProfileID", "reporting:1.0")
+	w.leaf("cbc:ID", d.Number)
+	w.leaf("cbc:UUID", d.UUID)
+	w.leaf("cbc:IssueDate", issued.Format("2006-01-02"))
+	w.leaf("cbc:IssueTime", issued.Format("15:04:05"))
+	w.leaf("cbc:InvoiceTypeCode", d.TypeCode, "name="+subtype+"00000")
+	w.leaf("cbc:DocumentCurrencyCode", d.Currency)
+	w.leaf("cbc:TaxCurrencyCode", "SAR")
+	if d.Original != "" {
+		w.open("cac:BillingReference")
+		w.open("cac:InvoiceDocumentReference")
+		w.leaf("cbc:ID", d.Original)
+		w.close("cac:InvoiceDocumentReference")
+		w.close("cac:BillingReference")
+	}
+	w.open("cac:AdditionalDocumentReference")
+	w.leaf("cbc:ID", "ICV")
+	w.leaf("cbc:UUID", fmt.Sprint(d.Counter))
+	w.close("cac:AdditionalDocumentReference")
+	w.open("cac:AdditionalDocumentReference")
+	w.leaf("cbc:ID", "PIH")
+	w.open("cac:Attachment")
+	w.leaf("cbc:EmbeddedDocumentBinaryObject", d.PreviousHash, "mimeCode=text/plain")
+	w.close("cac:Attachment")
+	w.close("cac:AdditionalDocumentReference")
+	w.open("cac:Signature")
+	w.leaf("cbc:ID", "urn:oasis:names:specification:ubl:signature:Invoice")
+	w.leaf("cbc:SignatureMethod", "urn:oasis:names:specification:ubl:dsig:enveloped:xades")
+	w.close("cac:Signature")
+
+	w.open("cac:AccountingSupplierParty")
+	w.party(d.Seller)
+	w.close("cac:AccountingSupplierParty")
+	w.open("cac:AccountingCustomerParty")
+	if d.Buyer != nil {
+		w.party(*d.Buyer)
+	}
+	w.close("cac:AccountingCustomerParty")
+	if !d.Simplified {
+		w.open("cac:Delivery")
+		w.leaf("cbc:ActualDeliveryDate", issued.Format("2006-01-02"))
+		w.close("cac:Delivery")
+	}
+	if d.PaymentMeans != "" || d.Reason != "" {
+		means := d.PaymentMeans
+		if means == "" {
+			means = PaymentTransfer
+		}
+		w.open("cac:PaymentMeans")
+		w.leaf("cbc:PaymentMeansCode", means)
+		if d.Reason != "" {
+			w.leaf("cbc:InstructionNote", d.Reason)
+		}
+		w.close("cac:PaymentMeans")
+	}
+
+	// Tax in SAR, then in the document currency with the breakdown.
+	w.open("cac:TaxTotal")
+	w.leaf("cbc:TaxAmount", amount(d.Tax.Mul(rate)), "currencyID=SAR")
+	w.close("cac:TaxTotal")
+	w.open("cac:TaxTotal")
+	w.leaf("cbc:TaxAmount", amount(d.Tax), cur)
+	for _, t := range d.Taxes {
+		w.open("cac:TaxSubtotal")
+		w.leaf("cbc:TaxableAmount", amount(t.Taxable), cur)
+		w.leaf("cbc:TaxAmount", amount(t.Tax), cur)
+		w.open("cac:TaxCategory")
+		w.leaf("cbc:ID", t.Category, "schemeAgencyID=6", "schemeID=UN/ECE 5305")
+		w.leaf("cbc:Percent", t.Percent.StringFixed(2))
+		if t.ExemptionCode != "" {
+			w.leaf("cbc:TaxExemptionReasonCode", t.ExemptionCode)
+			w.leaf("cbc:TaxExemptionReason", t.ExemptionReason)
+		}
+		w.taxScheme()
+		w.close("cac:TaxCategory")
+		w.close("cac:TaxSubtotal")
+	}
+	w.close("cac:TaxTotal")
+
+	w.open("cac:LegalMonetaryTotal")
+	w.leaf("cbc:LineExtensionAmount", amount(d.Net), cur)
+	w.leaf("cbc:TaxExclusiveAmount", amount(d.Net), cur)
+	w.leaf("cbc:TaxInclusiveAmount", amount(d.Total), cur)
+	w.leaf("cbc:AllowanceTotalAmount", "0.00", cur)
+	w.leaf("cbc:PrepaidAmount", "0.00", cur)
+	w.leaf("cbc:PayableAmount", amount(d.Total), cur)
+	w.close("cac:LegalMonetaryTotal")
+
+	for i, l := range d.Lines {
+		w.open("cac:InvoiceLine")
+		w.leaf("cbc:ID", fmt.Sprint(i+1))
+		w.leaf("cbc:InvoicedQuantity", l.Quantity.String(), "unitCode=PCE")
+		w.leaf("cbc:LineExtensionAmount", amount(l.Net), cur)
+		w.open("cac:TaxTotal")
+		w.leaf("cbc:TaxAmount", amount(l.Tax), cur)
+		w.leaf("cbc:RoundingAmount", amount(l.Net.Add(l.Tax)), cur)
+		w.close("cac:TaxTotal")
+		w.open("cac:Item")
+		w.leaf("cbc:Name", l.Name)
+		w.open("cac:ClassifiedTaxCategory")
+		w.leaf("cbc:ID", l.Category)
+		w.leaf("cbc:Percent", l.Percent.StringFixed(2))
+		w.taxScheme()
+		w.close("cac:ClassifiedTaxCategory")
+		w.close("cac:Item")
+		w.open("cac:Price")
+		w.leaf("cbc:PriceAmount", price(l.Price), cur)
+		w.close("cac:Price")
+		w.close("cac:InvoiceLine")
+	}
+	w.close("Invoice")
+	return w.b.Bytes(), nil
+}
+
+func (d *Document) validate() error {
+	switch {
+	case d.Number == "" || d.UUID == "":
+		return fmt.Errorf("e-invoice needs a number and a UUID")
+	case d.TypeCode != TypeInvoice && d.TypeCode != TypeCreditNote && d.TypeCode != TypeDebitNote:
+		return fmt.Errorf("unknown invoice type code %q", d.TypeCode)
+	case d.TypeCode != TypeInvoice && (d.Original == "" || d.Reason == ""):
+		return fmt.Errorf("credit and debit notes need the original invoice and a reason")
+	case d.Counter < 1:
+		return fmt.Errorf("invoice counter must start at 1")
+	case d.PreviousHash == "":
+		return fmt.Errorf("previous invoice hash is required; use InitialHash for the first invoice")
+	case d.Seller.VATNumber == "":
+		return fmt.Errorf("seller VAT number is required")
+	case !d.Simplified && d.Buyer == nil:
+		return fmt.Errorf("standard invoices need a buyer")
+	case len(d.Lines) == 0:
+		return fmt.Errorf("e-invoice has no lines")
+	}
+	return nil
+}
+
+// amount formats a monetary amount with the two decimals ZATCA expects.
+func amount(d decimal.Decimal) string {
+	return d.StringFixed(2)
+}
+
+// price keeps the decimals a unit price needs, with at least two.
+func price(d decimal.Decimal) string {
+	if d.Exponent() >= -2 {
+		return d.StringFixed(2)
+	}
+	return d.Round(6).String()
+}
+
+// ublWriter writes indented XML. Elements are never self-closing, so the output is already
+// close to its canonical form.
+type ublWriter struct {
+	b     bytes.Buffer
+	depth int
+}
+
+func (w *ublWriter) indent() {
+	w.b.WriteString(strings.Repeat("    ", w.depth))
+}
+
+// tag writes a start tag; attrs are name=value pairs.
+func (w *ublWriter) tag(name string, attrs []string) {
+	w.b.WriteString("<" + name)
+	for _, a := range attrs {
+		k, v, _ := strings.Cut(a, "=")
+		w.b.WriteString(" " + k + `="` + escapeAttr(v) + `"`)
+	}
+	w.b.WriteString(">")
+}
+
+func (w *ublWriter) open(name string, attrs ...string) {
+	w.indent()
+	w.tag(name, attrs)
+	w.b.WriteString("\n")
+	w.depth++
+}
+
+func (w *ublWriter) close(name string) {
+	w.depth--
+	w.indent()
+	w.b.WriteString("</" + name + ">\n")
+}
+
+func (w *ublWriter) leaf(name, value string, attrs ...string) {
+	w.indent()
+	w.tag(name, attrs)
+	w.b.WriteString(escapeText(value) + "</" + name + ">\n")
+}
+
+func (w *ublWriter) taxScheme() {
+	w.open("cac:TaxScheme")
+	w.leaf("cbc:ID", "VAT")
+	w.close("cac:TaxScheme")
+}
+
+func (w *ublWriter) party(p Party) {
+	w.open("cac:Party")
+	if p.RegistrationNumber != "" {
+		w.open("cac:PartyIdentification")
+		w.leaf("cbc:ID", p.RegistrationNumber, "schemeID=CRN")
+		w.close("cac:PartyIdentification")
+	}
+	a := p.Address
+	w.open("cac:PostalAddress")
+	for _, f := range [][2]string{
+		{"cbc:StreetName", a.Street},
+		{"cbc:BuildingNumber", a.Building},
+		{"cbc:CitySubdivisionName", a.District},
+		{"cbc:CityName", a.City},
+		{"cbc:PostalZone", a.PostalCode},
+	} {
+		if f[1] != "" {
+			w.leaf(f[0], f[1])
+		}
+	}
+	w.open("cac:Country")
+	w.leaf("cbc:IdentificationCode", a.Country)
+	w.close("cac:Country")
+	w.close("cac:PostalAddress")
+	if p.VATNumber != "" {
+		w.open("cac:PartyTaxScheme")
+		w.leaf("cbc:CompanyID", p.VATNumber)
+		w.taxScheme()
+		w.close("cac:PartyTaxScheme")
+	}
+	w.open("cac:PartyLegalEntity")
+	w.leaf("cbc:RegistrationName", p.Name)
+	w.close("cac:PartyLegalEntity")
+	w.close("cac:Party")
+}
@@ -0,0 +1,344 @@
+package zatca
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/shopspring/decimal"
+)
+
+// testCSID returns a PEM certificate and SEC 1 key on secp256k1, self-signed the way the
+// compliance CSID of a simulation unit is shaped.
+func testCSID(t *testing.T) (certPEM, keyPEM string) {
+	t.Helper()
+	key := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{7}, 32))
+	curve, _ := asn1.Marshal(oidSecp256k1)
+	spki := publicKeyInfoASN1{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: curve}},
+		PublicKey: asn1.BitString{Bytes: key.PubKey().SerializeUncompressed(), BitLength: 65 * 8},
+	}
+	name, _ := asn1.Marshal(pkix.Name{CommonName: "TSZEINVOICE-SubCA-1", Organization: []string{"SENT Test"}}.ToRDNSequence())
+	sigAlg := pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}}
+	tbs, err := asn1.Marshal(struct {
+		Version      int `asn1:"explicit,tag:0"`
+		SerialNumber *big.Int
+		SigAlg       pkix.AlgorithmIdentifier
+		Issuer       asn1.RawValue
+		Validity     validityASN1
+		Subject      asn1.RawValue
+		PublicKey    publicKeyInfoASN1
+	}{2, big.NewInt(1416425487264183839), sigAlg, asn1.RawValue{FullBytes: name},
+		validityASN1{time.Date(2022, 6, 12, 0, 0, 0, 0, time.UTC), time.Date(2027, 6, 11, 0, 0, 0, 0, time.UTC)},
+		asn1.RawValue{FullBytes: name}, spki})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(tbs)
+	sig := ecdsa.Sign(key, sum[:]).Serialize()
+	der, err := asn1.Marshal(certificateASN1{
+		TBS:                asn1.RawValue{FullBytes: tbs},
+		SignatureAlgorithm: sigAlg,
+		Signature:          asn1.BitString{Bytes: sig, BitLength: len(sig) * 8},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sec1, _ := asn1.Marshal(ecPrivateKeyASN1{Version: 1, PrivateKey: key.Serialize(), Curve: oidSecp256k1})
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1}))
+	return certPEM, keyPEM
+}
+
+func testSigner(t *testing.T) *Signer {
+	t.Helper()
+	cert, key := testCSID(t)
+	s, err := NewSigner(cert, key)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	return s
+}
+
+func TestCanonicalize(t *testing.T) {
+	// Example 3.3 of the Canonical XML recommendation, without the DTD default attribute.
+	in := `<?xml version="1.0"?>
+<doc>
+   <e1   />
+   <e2   ></e2>
+   <e3   name = "elem3"   id="elem3"   />
+   <e4   name="elem4"   id="elem4"   ></e4>
+   <e5 a:attr="out" b:attr="sorted" attr2="all" attr="I'm"
+      xmlns:b="http://www.ietf.org"
+      xmlns:a="http://www.w3.org"
+      xmlns="http://example.org"/>
+   <e6 xmlns="" xmlns:a="http://www.w3.org">
+      <e7 xmlns="http://www.ietf.org">
+         <e8 xmlns="" xmlns:a="http://www.w3.org">
+            <e9 xmlns="" xmlns:a="http://www.ietf.org"/>
+         </e8>
+      </e7>
+   </e6>
+   <!-- comments go -->
+   <e10 attr="&quot;a&amp;b&quot;">1 &lt; 2 &amp; 3 > 2</e10>
+</doc>`
+	want := `<doc>
+   <e1></e1>
+   <e2></e2>
+   <e3 id="elem3" name="elem3"></e3>
+   <e4 id="elem4" name="elem4"></e4>
+   <e5 xmlns="http://example.org" xmlns:a="http://www.w3.org" xmlns:b="http://www.ietf.org" attr="I'm" attr2="all" b:attr="sorted" a:attr="out"></e5>
+   <e6 xmlns:a="http://www.w3.org">
+      <e7 xmlns="http://www.ietf.org">
+         <e8 xmlns="">
+            <e9 xmlns:a="http://www.ietf.org"></e9>
+         </e8>
+      </e7>
+   </e6>
+` + "   " /* where the comment was */ + `
+   <e10 attr="&quot;a&amp;b&quot;">1 &lt; 2 &amp; 3 &gt; 2</e10>
+</doc>`
+	root, err := parseXML([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(canonicalize(root, nil)); got != want {
+		t.Errorf("canonical form:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestInitialHash(t *testing.T) {
+	sum := sha256.Sum256([]byte("0"))
+	if got := base64.StdEncoding.EncodeToString([]byte(hex.EncodeToString(sum[:]))); got != InitialHash {
+		t.Errorf("InitialHash = %s, want %s", InitialHash, got)
+	}
+}
+
+func TestInvoiceHashExclusions(t *testing.T) {
+	sample, err := os.ReadFile("testdata/simplified_invoice.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, _ := parseXML(sample)
+	c := string(canonicalize(root, hashExcluded))
+	if !strings.HasPrefix(c, `<Invoice xmlns="`+nsInvoice+`" xmlns:cac="`+nsCAC+`" xmlns:cbc="`+nsCBC+`" xmlns:ext="`+nsEXT+`">`) {
+		t.Errorf("canonical root: %.200s", c)
+	}
+	if strings.Contains(c, "<?xml") || strings.Contains(c, "cac:Signature") || !strings.Contains(c, ">PIH<") {
+		t.Error("hash input should drop the declaration and cac:Signature, and keep PIH")
+	}
+
+	// The signature and QR code can change without changing the hash; the invoice cannot.
+	base, _ := InvoiceHash(sample)
+	withQR := bytes.Replace(sample, []byte("<cac:Signature>"),
+		[]byte(`<cac:AdditionalDocumentReference><cbc:ID>QR</cbc:ID></cac:AdditionalDocumentReference><cac:Signature>`), 1)
+	if h, _ := InvoiceHash(withQR); h != base {
+		t.Error("QR reference changed the hash")
+	}
+	changed := bytes.Replace(sample, []byte(">4.60<"), []byte(">4.61<"), 1)
+	if h, _ := InvoiceHash(changed); h == base {
+		t.Error("a changed total kept the hash")
+	}
+}
+
+func TestSignSample(t *testing.T) {
+	sample, err := os.ReadFile("testdata/simplified_invoice.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := testSigner(t)
+	at := time.Date(2022, 8, 17, 17, 41, 8, 0, Riyadh)
+	signed, err := s.Sign(sample, at)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if err := Verify(signed.XML); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if h, _ := InvoiceHash(signed.XML); h != signed.Hash {
+		t.Errorf("hash of the signed invoice is %s, Sign said %s", h, signed.Hash)
+	}
+	for _, want := range []string{"<xades:SigningTime>2022-08-17T17:41:08</xades:SigningTime>", "<ds:X509IssuerName>", "<cbc:ID>QR</cbc:ID>"} {
+		if !bytes.Contains(signed.XML, []byte(want)) {
+			t.Errorf("signed invoice lacks %s", want)
+		}
+	}
+	if _, err := s.Sign(signed.XML, at); err == nil {
+		t.Error("signing a signed invoice should fail")
+	}
+
+	q, err := DecodeQR(signed.QR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.VATNumber != "399999999900003" || q.Timestamp != "2022-08-17T17:41:08" || q.Total != "4.60" || q.VAT != "0.60" {
+		t.Errorf("QR fields: %+v", q)
+	}
+	if !strings.HasSuffix(q.Seller, "Maximum Speed Tech Supply LTD") {
+		t.Errorf("QR seller = %q", q.Seller)
+	}
+	if q.Hash != signed.Hash || q.Signature != signed.Signature {
+		t.Error("QR hash and signature should be the invoice's")
+	}
+	if !bytes.Equal(q.PublicKey, s.Cert.PublicKeyInfo) || !bytes.Equal(q.CertSignature, s.Cert.Signature) {
+		t.Error("simplified invoice QR should carry the CSID key and certificate signature")
+	}
+
+	// Tampering with the invoice or the QR code fails verification.
+	tampered := bytes.Replace(signed.XML, []byte(">4.60<"), []byte(">4.06<"), 1)
+	if err := Verify(tampered); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Verify(tampered total) = %v", err)
+	}
+	forged := QR{Seller: q.Seller, VATNumber: q.VATNumber, Timestamp: q.Timestamp, Total: q.Total, VAT: q.VAT, Hash: InitialHash, Signature: q.Signature}
+	tampered = bytes.Replace(signed.XML, []byte(signed.QR), []byte(forged.Encode()), 1)
+	if err := Verify(tampered); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Verify(forged QR) = %v", err)
+	}
+}
+
+func TestRenderCreditNote(t *testing.T) {
+	seller := Party{
+		Name: "Maximum Speed Tech Supply LTD", VATNumber: "399999999900003", RegistrationNumber: "1010010000",
+		Address: Address{Street: "Prince Sultan", Building: "2322", District: "Al-Murabba", City: "Riyadh", PostalCode: "23333", Country: "SA"},
+	}
+	buyer := Party{
+		Name: "Fatoora Samples LTD", VATNumber: "399999999800003",
+		Address: Address{Street: "Salah Al-Din", Building: "1111", District: "Al-Murooj", City: "Riyadh", PostalCode: "12222", Country: "SA"},
+	}
+	d := &Document{
+		Number: "CN-00001", UUID: "3cf5ee18-ee25-44ea-a444-2c37ba7f28be", TypeCode: TypeCreditNote,
+		Issued: time.Date(2022, 9, 13, 9, 30, 0, 0, time.UTC), Currency: "SAR",
+		Counter: 2, PreviousHash: InitialHash, Original: "INV-00001", Reason: "Returned items",
+		Seller: seller, Buyer: &buyer,
+		Lines: []Line{{Name: "Book & pen", Quantity: decimal.NewFromInt(2), Price: decimal.RequireFromString("10.5"),
+			Net: decimal.NewFromInt(21), Tax: decimal.RequireFromString("3.15"), Category: CategoryStandard, Percent: decimal.NewFromInt(15)}},
+		Taxes: []TaxGroup{{Category: CategoryStandard, Percent: decimal.NewFromInt(15), Taxable: decimal.NewFromInt(21), Tax: decimal.RequireFromString("3.15")}},
+		Net:   decimal.NewFromInt(21), Tax: decimal.RequireFromString("3.15"), Total: decimal.RequireFromString("24.15"),
+	}
+	unsigned, err := d.Render()
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	for _, want := range []string{
+		`<cbc:InvoiceTypeCode name="0100000">381</cbc:InvoiceTypeCode>`,
+		"<cbc:IssueTime>12:30:00</cbc:IssueTime>", // Riyadh time
+		"<cbc:UUID>2</cbc:UUID>",
+		"<cbc:ID>INV-00001</cbc:ID>",
+		"<cbc:InstructionNote>Returned items</cbc:InstructionNote>",
+		"<cbc:Name>Book &amp; pen</cbc:Name>",
+		`<cbc:PriceAmount currencyID="SAR">10.50</cbc:PriceAmount>`,
+	} {
+		if !bytes.Contains(unsigned, []byte(want)) {
+			t.Errorf("rendered credit note lacks %s", want)
+		}
+	}
+
+	signed, err := testSigner(t).Sign(unsigned, d.Issued)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if err := Verify(signed.XML); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if q, _ := DecodeQR(signed.QR); q.CertSignature != nil || q.Total != "24.15" {
+		t.Errorf("standard credit note QR: %+v", q)
+	}
+
+	d.Reason = ""
+	if _, err := d.Render(); err == nil {
+		t.Error("a credit note without a reason should not render")
+	}
+}
+
+func TestParseKeyForms(t *testing.T) {
+	certPEM, keyPEM := testCSID(t)
+	block, _ := pem.Decode([]byte(certPEM))
+	b64 := base64.StdEncoding.EncodeToString(block.Bytes)
+	token := base64.StdEncoding.EncodeToString([]byte(b64)) // As the onboarding API returns it
+	for _, c := range []string{certPEM, b64, token} {
+		if _, err := ParseCertificate(c); err != nil {
+			t.Errorf("ParseCertificate(%.20s...): %v", c, err)
+		}
+	}
+
+	kb, _ := pem.Decode([]byte(keyPEM))
+	curve, _ := asn1.Marshal(oidSecp256k1)
+	p8, _ := asn1.Marshal(pkcs8ASN1{
+		Algorithm:  pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: curve}},
+		PrivateKey: kb.Bytes,
+	})
+	for _, k := range []string{
+		keyPEM,
+		base64.StdEncoding.EncodeToString(kb.Bytes),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: p8})),
+	} {
+		if _, err := NewSigner(certPEM, k); err != nil {
+			t.Errorf("NewSigner with key %.20s...: %v", k, err)
+		}
+	}
+
+	other, _ := asn1.Marshal(ecPrivateKeyASN1{Version: 1, PrivateKey: bytes.Repeat([]byte{9}, 32), Curve: oidSecp256k1})
+	if _, err := NewSigner(certPEM, base64.StdEncoding.EncodeToString(other)); err == nil {
+		t.Error("a key from another certificate should be refused")
+	}
+}
+
+func TestCSRRoundTrip(t *testing.T) {
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unit := Unit{
+		CommonName:   "TST-886431145-399999999900003",
+		SerialNumber: "1-SENT|2-1.0|3-ed22f1d8-e6a2-1118-9b58-d9a8f11e445f",
+		VATNumber:    "399999999900003",
+		Organization: "Maximum Speed Tech Supply LTD",
+		InvoiceType:  "1100",
+		Location:     "RRRD2929",
+		Industry:     "Supply activities",
+		Environment:  EnvSandbox,
+	}
+	req, err := CreateCSR(unit, key)
+	if err != nil {
+		t.Fatalf("CreateCSR: %v", err)
+	}
+	csr, err := ParseCSR(req)
+	if err != nil {
+		t.Fatalf("ParseCSR: %v", err)
+	}
+	if csr.VATNumber != unit.VATNumber || csr.Template != "TSTZATCA-Code-Signing" {
+		t.Errorf("CSR carries VAT %q, template %q", csr.VATNumber, csr.Template)
+	}
+	if !strings.Contains(csr.Subject, "CN="+unit.CommonName) || !strings.Contains(csr.Subject, "C=SA") {
+		t.Errorf("CSR subject = %q", csr.Subject)
+	}
+
+	ca := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{9}, 32))
+	now := time.Now()
+	cert, err := IssueCertificate(csr, "TSZEINVOICE-SubCA-1", ca, big.NewInt(42), now, now.AddDate(1, 0, 0))
+	if err != nil {
+		t.Fatalf("IssueCertificate: %v", err)
+	}
+	if cert.Issuer != "CN=TSZEINVOICE-SubCA-1" || cert.SerialNumber.Int64() != 42 {
+		t.Errorf("certificate issued by %q with serial %s", cert.Issuer, cert.SerialNumber)
+	}
+	if _, err := NewSigner(cert.Base64(), key); err != nil {
+		t.Errorf("issued certificate does not match its key: %v", err)
+	}
+
+	if _, err := CreateCSR(Unit{CommonName: "x", Environment: "staging"}, key); err == nil {
+		t.Error("CreateCSR accepted an unknown environment")
+	}
+}
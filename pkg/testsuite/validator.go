@@ -140,7 +140,7 @@ func validateModules(db *ent.Client, ctx context.Context) {
 
 	fmt.Print(" - SENTpeople (Payroll Precision): ")
 
-	exchange := capital.NewExchangeService()
+	exchange := capital.NewExchangeService(db)
 
 	payroll := people.NewPayrollEngine(db, exchange)
 